@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/client"
+)
+
+// agent renders the templates and re-renders them when a secret they read
+// gets a new version
+type agent struct {
+	cfg       *Config
+	client    *client.Client
+	log       *log.Helper
+	cache     *secretCache
+	renderers []*renderer
+	proc      *process
+}
+
+func newAgent(cfg *Config, c *client.Client, l *log.Helper) *agent {
+	a := &agent{
+		cfg:    cfg,
+		client: c,
+		log:    l,
+		cache:  newSecretCache(c),
+	}
+	for i, t := range cfg.Templates {
+		name := t.Destination
+		if name == "" {
+			name = fmt.Sprintf("env-%d", i)
+		}
+		a.renderers = append(a.renderers, newRenderer(t, name))
+	}
+	if cfg.Exec != nil {
+		a.proc = newProcess(cfg.Exec, l)
+	}
+	return a
+}
+
+// renderChanges reports what a render changed
+type renderChanges struct {
+	files bool
+	env   bool
+}
+
+// render renders every template and writes the files whose output
+// changed. On the first render every error is returned; later a template
+// that fails keeps its previous output.
+func (a *agent) render(ctx context.Context, initial bool) (renderChanges, error) {
+	var changes renderChanges
+	for _, r := range a.renderers {
+		out, err := r.render(ctx, a.cache)
+		if err != nil {
+			if initial {
+				return changes, fmt.Errorf("render %s: %w", r.name, err)
+			}
+			a.log.Errorf("render %s failed, keeping the previous output: %v", r.name, err)
+			continue
+		}
+		if r.last != nil && bytes.Equal(out, r.last) {
+			continue
+		}
+		r.last = out
+
+		if r.cfg.Destination == "" {
+			changes.env = true
+			continue
+		}
+		if err := writeFile(r.cfg.Destination, out, r.cfg.mode); err != nil {
+			if initial {
+				return changes, fmt.Errorf("write %s: %w", r.cfg.Destination, err)
+			}
+			a.log.Errorf("write %s failed: %v", r.cfg.Destination, err)
+			continue
+		}
+		changes.files = true
+		a.log.Infof("rendered %s", r.cfg.Destination)
+
+		if r.cfg.Command != "" {
+			if err := runHook(ctx, r.cfg.Command); err != nil {
+				a.log.Errorf("command of %s failed: %v", r.cfg.Destination, err)
+			}
+		}
+	}
+	return changes, nil
+}
+
+// env returns the variables of the environment templates
+func (a *agent) env() []string {
+	var env []string
+	for _, r := range a.renderers {
+		if r.cfg.Destination == "" {
+			env = append(env, parseEnv(r.last)...)
+		}
+	}
+	return env
+}
+
+// poll compares the versions of the secrets read with the service and
+// drops the changed ones from the cache, reporting whether any changed
+func (a *agent) poll(ctx context.Context) bool {
+	changed := false
+	for path, version := range a.cache.versions {
+		current := int32(0)
+		secret, err := a.client.SecretByPath(ctx, path)
+		switch {
+		case err == nil:
+			current = secret.CurrentVersion
+		case !errors.Is(err, client.ErrNotFound):
+			a.log.Warnf("check %s failed: %v", path, err)
+			continue
+		}
+
+		if current != version {
+			a.log.Infof("%s changed from version %d to %d", path, version, current)
+			a.cache.invalidate(path)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// run renders the templates, starts the exec process and keeps both up to
+// date until ctx is done or the process exits. With once it returns after
+// the first render.
+func (a *agent) run(ctx context.Context, once bool) error {
+	if _, err := a.render(ctx, true); err != nil {
+		return err
+	}
+	if once {
+		return nil
+	}
+
+	var exited <-chan error
+	if a.proc != nil {
+		if err := a.proc.start(a.env()); err != nil {
+			return err
+		}
+		defer a.proc.stop()
+		exited = a.proc.exited
+	}
+
+	ticker := time.NewTicker(a.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-exited:
+			return err
+		case <-ticker.C:
+		}
+
+		if !a.poll(ctx) {
+			continue
+		}
+		changes, _ := a.render(ctx, false)
+		if a.proc == nil {
+			continue
+		}
+
+		var err error
+		switch {
+		case changes.env, changes.files && a.cfg.Exec.reloadSignal == 0:
+			err = a.proc.restart(a.env())
+		case changes.files:
+			err = a.proc.signal(a.cfg.Exec.reloadSignal)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/client"
+)
+
+const (
+	defaultPollInterval = 30 * time.Second
+	defaultKillTimeout  = 10 * time.Second
+	defaultPerms        = "0600"
+)
+
+// Config is the agent configuration file
+type Config struct {
+	// Warden is the connection to the service, as for pkg/client
+	Warden client.Config `yaml:"warden"`
+	// TokenFile is read for the bearer token, e.g. a projected service
+	// account token, and re-read on every start
+	TokenFile string `yaml:"token_file"`
+
+	// PollInterval is how often the versions of the rendered secrets are
+	// checked
+	PollInterval time.Duration `yaml:"poll_interval"`
+
+	Templates []*TemplateConfig `yaml:"templates"`
+	Exec      *ExecConfig       `yaml:"exec"`
+}
+
+// TemplateConfig is a Go template rendered with the secrets it references
+type TemplateConfig struct {
+	// Source is the template file, or Contents the template itself
+	Source   string `yaml:"source"`
+	Contents string `yaml:"contents"`
+
+	// Destination is the file written; templates without one are
+	// environment templates, KEY=VALUE lines added to the environment of
+	// the exec process
+	Destination string `yaml:"destination"`
+	Perms       string `yaml:"perms"`
+
+	// Command runs with /bin/sh -c after the file changed
+	Command string `yaml:"command"`
+
+	// ErrorOnMissing fails when a referenced secret does not exist instead
+	// of rendering an empty value
+	ErrorOnMissing bool `yaml:"error_on_missing"`
+
+	mode os.FileMode
+}
+
+// ExecConfig is a process the agent supervises: it is started once the
+// templates are rendered and signalled or restarted when they change
+type ExecConfig struct {
+	Command []string `yaml:"command"`
+	// ReloadSignal is sent when a file template changed, e.g. SIGHUP; the
+	// process is restarted when empty, and always when an environment
+	// template changed
+	ReloadSignal string        `yaml:"reload_signal"`
+	KillTimeout  time.Duration `yaml:"kill_timeout"`
+
+	reloadSignal syscall.Signal
+}
+
+func loadConfig(path string) (*Config, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{Warden: *client.DefaultConfig()}
+	if err := yaml.Unmarshal(raw, cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) validate() error {
+	if c.PollInterval <= 0 {
+		c.PollInterval = defaultPollInterval
+	}
+	if c.TokenFile != "" {
+		token, err := os.ReadFile(c.TokenFile)
+		if err != nil {
+			return fmt.Errorf("read token: %w", err)
+		}
+		c.Warden.Token = strings.TrimSpace(string(token))
+	}
+	if len(c.Templates) == 0 {
+		return errors.New("no templates configured")
+	}
+
+	for i, t := range c.Templates {
+		if (t.Source == "") == (t.Contents == "") {
+			return fmt.Errorf("template %d: set either source or contents", i)
+		}
+		if t.Source != "" {
+			raw, err := os.ReadFile(t.Source)
+			if err != nil {
+				return fmt.Errorf("template %d: %w", i, err)
+			}
+			t.Contents = string(raw)
+		}
+		if t.Destination == "" && c.Exec == nil {
+			return fmt.Errorf("template %d: environment templates need exec", i)
+		}
+		if t.Perms == "" {
+			t.Perms = defaultPerms
+		}
+		mode, err := strconv.ParseUint(t.Perms, 8, 32)
+		if err != nil {
+			return fmt.Errorf("template %d: invalid perms %q", i, t.Perms)
+		}
+		t.mode = os.FileMode(mode)
+	}
+
+	if c.Exec != nil {
+		if len(c.Exec.Command) == 0 {
+			return errors.New("exec: command is required")
+		}
+		if c.Exec.KillTimeout <= 0 {
+			c.Exec.KillTimeout = defaultKillTimeout
+		}
+		if c.Exec.ReloadSignal != "" {
+			sig, err := parseSignal(c.Exec.ReloadSignal)
+			if err != nil {
+				return fmt.Errorf("exec: %w", err)
+			}
+			c.Exec.reloadSignal = sig
+		}
+	}
+	return nil
+}
+
+var signals = map[string]syscall.Signal{
+	"HUP":  syscall.SIGHUP,
+	"INT":  syscall.SIGINT,
+	"QUIT": syscall.SIGQUIT,
+	"TERM": syscall.SIGTERM,
+	"USR1": syscall.SIGUSR1,
+	"USR2": syscall.SIGUSR2,
+}
+
+func parseSignal(name string) (syscall.Signal, error) {
+	sig, ok := signals[strings.TrimPrefix(strings.ToUpper(name), "SIG")]
+	if !ok {
+		return 0, fmt.Errorf("unsupported signal %q", name)
+	}
+	return sig, nil
+}
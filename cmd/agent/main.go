@@ -0,0 +1,66 @@
+// Command warden-agent delivers secrets to an application as files or
+// environment variables. It renders Go templates with the secrets they
+// reference, polls Warden for new versions and re-renders, running a
+// command, signalling or restarting the application when they change.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/client"
+)
+
+// go build -ldflags "-X main.version=x.y.z"
+var version = "1.0.0"
+
+func main() {
+	configPath := flag.String("config", envOr("WARDEN_AGENT_CONFIG", "/etc/warden-agent/config.yaml"), "agent configuration file")
+	once := flag.Bool("once", false, "render the templates once and exit, e.g. in an init container")
+	showVersion := flag.Bool("version", false, "print the version and exit")
+	flag.Parse()
+
+	if *showVersion {
+		fmt.Println(version)
+		return
+	}
+
+	logger := log.With(log.NewStdLogger(os.Stderr), "ts", log.DefaultTimestamp, "module", "warden/agent")
+	l := log.NewHelper(logger)
+
+	if err := run(*configPath, *once, logger); err != nil {
+		l.Error(err)
+		os.Exit(1)
+	}
+}
+
+func run(configPath string, once bool, logger log.Logger) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.NewClient(&cfg.Warden, logger)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	return newAgent(cfg, c, log.NewHelper(logger)).run(ctx, once)
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// hookTimeout bounds the template commands
+const hookTimeout = time.Minute
+
+// process supervises the exec process. It reports on exited when the
+// process ends without the agent stopping it.
+type process struct {
+	cfg *ExecConfig
+	log *log.Helper
+
+	mu      sync.Mutex
+	cmd     *exec.Cmd
+	done    chan struct{}
+	stopped bool
+
+	exited chan error
+}
+
+func newProcess(cfg *ExecConfig, l *log.Helper) *process {
+	return &process{cfg: cfg, log: l, exited: make(chan error, 1)}
+}
+
+// start runs the command with the agent's environment and env added
+func (p *process) start(env []string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cmd := exec.Command(p.cfg.Command[0], p.cfg.Command[1:]...)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start %s: %w", p.cfg.Command[0], err)
+	}
+
+	done := make(chan struct{})
+	p.cmd, p.done, p.stopped = cmd, done, false
+	p.log.Infof("started %s (pid %d)", p.cfg.Command[0], cmd.Process.Pid)
+
+	go func() {
+		err := cmd.Wait()
+		close(done)
+
+		p.mu.Lock()
+		stopped := p.stopped && p.cmd == cmd
+		p.mu.Unlock()
+		if !stopped {
+			if err == nil {
+				err = errors.New("exited")
+			}
+			p.exited <- fmt.Errorf("%s: %w", p.cfg.Command[0], err)
+		}
+	}()
+	return nil
+}
+
+// signal sends sig to the process
+func (p *process) signal(sig syscall.Signal) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.cmd == nil {
+		return nil
+	}
+	p.log.Infof("sending %s to %s", sig, p.cfg.Command[0])
+	return p.cmd.Process.Signal(sig)
+}
+
+// stop terminates the process, killing it after the kill timeout
+func (p *process) stop() {
+	p.mu.Lock()
+	cmd, done := p.cmd, p.done
+	p.stopped = true
+	p.mu.Unlock()
+	if cmd == nil {
+		return
+	}
+
+	_ = cmd.Process.Signal(syscall.SIGTERM)
+	select {
+	case <-done:
+	case <-time.After(p.cfg.KillTimeout):
+		p.log.Warnf("%s did not stop within %s, killing it", p.cfg.Command[0], p.cfg.KillTimeout)
+		_ = cmd.Process.Kill()
+		<-done
+	}
+}
+
+// restart stops the process and starts it again with env
+func (p *process) restart(env []string) error {
+	p.log.Infof("restarting %s", p.cfg.Command[0])
+	p.stop()
+	return p.start(env)
+}
+
+// runHook runs a template command with /bin/sh -c
+func runHook(ctx context.Context, command string) error {
+	ctx, cancel := context.WithTimeout(ctx, hookTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	return cmd.Run()
+}
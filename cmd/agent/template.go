@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/client"
+)
+
+// Secret is what templates get for a secret path
+type Secret struct {
+	ID       string
+	Password string
+	Username string
+	URL      string
+	Version  int32
+	Metadata map[string]string
+}
+
+// secretCache holds the secrets read for rendering, by path and metadata
+// keys, and the versions the watcher compares against
+type secretCache struct {
+	client  *client.Client
+	entries map[string]*Secret
+	// versions is the version of every path read, 0 for missing secrets
+	versions map[string]int32
+}
+
+func newSecretCache(c *client.Client) *secretCache {
+	return &secretCache{
+		client:   c,
+		entries:  make(map[string]*Secret),
+		versions: make(map[string]int32),
+	}
+}
+
+// get reads a secret, from the cache when it was read before. Missing
+// secrets return nil.
+func (s *secretCache) get(ctx context.Context, path string, keys []string) (*Secret, error) {
+	sorted := append([]string(nil), keys...)
+	sort.Strings(sorted)
+	key := path + "\x00" + strings.Join(sorted, ",")
+	if secret, ok := s.entries[key]; ok {
+		return secret, nil
+	}
+
+	resp, err := s.client.GetSecretByPath(ctx, path, keys...)
+	if errors.Is(err, client.ErrNotFound) {
+		s.entries[key] = nil
+		s.versions[path] = 0
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &Secret{
+		ID:       resp.Id,
+		Password: resp.Password,
+		Username: resp.Username,
+		URL:      resp.HostUrl,
+		Version:  resp.Version,
+		Metadata: resp.Metadata,
+	}
+	s.entries[key] = secret
+	s.versions[path] = secret.Version
+	return secret, nil
+}
+
+// invalidate drops the cached values of a path
+func (s *secretCache) invalidate(path string) {
+	for key := range s.entries {
+		if strings.HasPrefix(key, path+"\x00") {
+			delete(s.entries, key)
+		}
+	}
+	delete(s.versions, path)
+}
+
+// renderer renders one template and remembers the paths it read
+type renderer struct {
+	name  string
+	cfg   *TemplateConfig
+	paths map[string]struct{}
+	last  []byte
+}
+
+func newRenderer(cfg *TemplateConfig, name string) *renderer {
+	return &renderer{name: name, cfg: cfg}
+}
+
+// render executes the template, returning its output
+func (r *renderer) render(ctx context.Context, cache *secretCache) ([]byte, error) {
+	paths := make(map[string]struct{})
+
+	lookup := func(path string, keys []string) (*Secret, error) {
+		paths[path] = struct{}{}
+		secret, err := cache.get(ctx, path, keys)
+		if err != nil {
+			return nil, err
+		}
+		if secret == nil {
+			if r.cfg.ErrorOnMissing {
+				return nil, fmt.Errorf("secret %s not found", path)
+			}
+			return &Secret{}, nil
+		}
+		return secret, nil
+	}
+
+	tmpl, err := template.New(r.name).Option("missingkey=zero").Funcs(template.FuncMap{
+		"secret": func(path string, keys ...string) (*Secret, error) {
+			return lookup(path, keys)
+		},
+		"password": func(path string) (string, error) {
+			secret, err := lookup(path, nil)
+			if err != nil {
+				return "", err
+			}
+			return secret.Password, nil
+		},
+		"env":          os.Getenv,
+		"base64Encode": func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+		"toJSON": func(v any) (string, error) {
+			raw, err := json.Marshal(v)
+			return string(raw), err
+		},
+		// quote escapes a value for shell and env files
+		"quote": func(s string) string { return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'" },
+	}).Parse(r.cfg.Contents)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, nil); err != nil {
+		return nil, err
+	}
+	r.paths = paths
+	return buf.Bytes(), nil
+}
+
+// uses reports whether the template read a path on its last render
+func (r *renderer) uses(path string) bool {
+	_, ok := r.paths[path]
+	return ok
+}
+
+// writeFile replaces a file atomically, so readers never see a partial
+// file
+func writeFile(path string, data []byte, mode os.FileMode) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// parseEnv reads the KEY=VALUE lines of an environment template, skipping
+// blank lines and comments
+func parseEnv(data []byte) []string {
+	var env []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || !strings.Contains(line, "=") {
+			continue
+		}
+		env = append(env, strings.TrimPrefix(line, "export "))
+	}
+	return env
+}
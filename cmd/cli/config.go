@@ -0,0 +1,122 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/client"
+)
+
+// Context is a named Warden endpoint with the credentials to call it
+type Context struct {
+	Endpoint   string   `yaml:"endpoint"`
+	CAFile     string   `yaml:"ca_file,omitempty"`
+	CertFile   string   `yaml:"cert_file,omitempty"`
+	KeyFile    string   `yaml:"key_file,omitempty"`
+	ServerName string   `yaml:"server_name,omitempty"`
+	Insecure   bool     `yaml:"insecure,omitempty"`
+	TenantID   uint32   `yaml:"tenant_id,omitempty"`
+	UserID     uint32   `yaml:"user_id,omitempty"`
+	Username   string   `yaml:"username,omitempty"`
+	Roles      []string `yaml:"roles,omitempty"`
+	Token      string   `yaml:"token,omitempty"`
+}
+
+// Config is the CLI configuration file with the login contexts
+type Config struct {
+	CurrentContext string              `yaml:"current_context"`
+	Contexts       map[string]*Context `yaml:"contexts"`
+
+	path string
+}
+
+// defaultConfigPath is $WARDEN_CONFIG, or warden/config.yaml in the user's
+// configuration directory
+func defaultConfigPath() string {
+	if p := os.Getenv("WARDEN_CONFIG"); p != "" {
+		return p
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ".warden.yaml"
+	}
+	return filepath.Join(dir, "warden", "config.yaml")
+}
+
+// loadConfig reads the configuration file, returning an empty one when it
+// does not exist yet
+func loadConfig(path string) (*Config, error) {
+	cfg := &Config{Contexts: make(map[string]*Context), path: path}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(raw, cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if cfg.Contexts == nil {
+		cfg.Contexts = make(map[string]*Context)
+	}
+	return cfg, nil
+}
+
+// save writes the configuration readable by the user only, as it may hold
+// a token
+func (c *Config) save() error {
+	raw, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, raw, 0o600)
+}
+
+// context returns the named context, or the current one for an empty name
+func (c *Config) context(name string) (*Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return nil, errors.New("no context selected, run `warden-cli login` first")
+	}
+	ctx, ok := c.Contexts[name]
+	if !ok {
+		return nil, fmt.Errorf("context %q does not exist", name)
+	}
+	return ctx, nil
+}
+
+// clientConfig returns the SDK configuration of the context. WARDEN_TOKEN
+// overrides the stored token, for CI pipelines.
+func (c *Context) clientConfig() *client.Config {
+	cfg := client.DefaultConfig()
+	cfg.Endpoint = c.Endpoint
+	cfg.CAFile = c.CAFile
+	cfg.CertFile = c.CertFile
+	cfg.KeyFile = c.KeyFile
+	if c.ServerName != "" {
+		cfg.ServerName = c.ServerName
+	}
+	cfg.Insecure = c.Insecure
+	cfg.Identity = client.Identity{
+		TenantID: c.TenantID,
+		UserID:   c.UserID,
+		Username: c.Username,
+		Roles:    c.Roles,
+	}
+	cfg.Token = c.Token
+	if token := os.Getenv("WARDEN_TOKEN"); token != "" {
+		cfg.Token = token
+	}
+	return cfg
+}
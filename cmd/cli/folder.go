@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/client"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+func (a *app) newFolderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "folder",
+		Short: "List and create folders",
+	}
+
+	var depth int32
+	tree := &cobra.Command{
+		Use:   "tree [path]",
+		Short: "Print the folder tree, from the root or a folder path",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				req := &wardenV1.GetFolderTreeRequest{IncludeCounts: true}
+				if depth > 0 {
+					req.MaxDepth = &depth
+				}
+				if len(args) > 0 && args[0] != "/" {
+					folder, err := c.FolderByPath(ctx, args[0])
+					if err != nil {
+						return err
+					}
+					req.RootId = &folder.Id
+				}
+
+				resp, err := c.Folders.GetFolderTree(ctx, req)
+				if err != nil {
+					return err
+				}
+				if a.jsonOutput {
+					return printJSON(resp)
+				}
+				printFolderTree(resp.Roots, "")
+				return nil
+			})
+		},
+	}
+	tree.Flags().Int32Var(&depth, "depth", 0, "maximum depth to print (1-20)")
+
+	create := &cobra.Command{
+		Use:   "create <path>",
+		Short: "Create a folder path, including missing parents",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				folder, err := c.EnsureFolder(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				if a.jsonOutput {
+					return printJSON(folder)
+				}
+				fmt.Println(folder.Id)
+				return nil
+			})
+		},
+	}
+
+	cmd.AddCommand(tree, create)
+	return cmd
+}
+
+func printFolderTree(nodes []*wardenV1.FolderTreeNode, indent string) {
+	for i, node := range nodes {
+		branch, next := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, next = "└── ", "    "
+		}
+		f := node.GetFolder()
+		fmt.Printf("%s%s%s (%d secrets)\n", indent, branch, f.GetName(), f.GetSecretCount())
+		printFolderTree(node.Children, indent+next)
+	}
+}
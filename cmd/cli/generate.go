@@ -0,0 +1,59 @@
+package main
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const (
+	lowerChars  = "abcdefghijkmnopqrstuvwxyz"
+	upperChars  = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	digitChars  = "23456789"
+	symbolChars = "!#$%&*+-=?@^_~"
+)
+
+// generatePassword returns a random password of length characters with at
+// least one of every class used. Look-alike characters are left out.
+func generatePassword(length int, symbols bool) (string, error) {
+	classes := []string{lowerChars, upperChars, digitChars}
+	if symbols {
+		classes = append(classes, symbolChars)
+	}
+	if length < len(classes) {
+		return "", errors.New("password is too short to contain every character class")
+	}
+
+	all := strings.Join(classes, "")
+	out := make([]byte, length)
+	for i := range out {
+		set := all
+		if i < len(classes) {
+			set = classes[i]
+		}
+		c, err := randomIndex(len(set))
+		if err != nil {
+			return "", err
+		}
+		out[i] = set[c]
+	}
+
+	// Shuffle so the required classes are not always in front
+	for i := len(out) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out), nil
+}
+
+func randomIndex(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(v.Int64()), nil
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/spf13/cobra"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/client"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// newLoginCmd returns the login command saving a context and making it the
+// current one
+func (a *app) newLoginCmd() *cobra.Command {
+	var (
+		c        Context
+		roles    string
+		noVerify bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "login <context>",
+		Short: "Save the endpoint and credentials of a context and switch to it",
+		Long: `Save the endpoint and credentials of a context and switch to it.
+
+The tenant, user and roles are sent as identity headers; on servers with
+AUTH_MODE=jwt pass --token instead, or set WARDEN_TOKEN when running commands.
+The credentials are checked by listing the root folders unless --no-verify is
+set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if c.Endpoint == "" {
+				return fmt.Errorf("--endpoint is required")
+			}
+			if c.Token == "-" {
+				token, err := readStdin()
+				if err != nil {
+					return err
+				}
+				c.Token = token
+			}
+			if roles != "" {
+				c.Roles = strings.Split(roles, ",")
+			}
+
+			if !noVerify {
+				if err := verifyContext(cmd.Context(), &c); err != nil {
+					return fmt.Errorf("login failed: %w", err)
+				}
+			}
+
+			cfg, err := loadConfig(a.configPath)
+			if err != nil {
+				return err
+			}
+			cfg.Contexts[args[0]] = &c
+			cfg.CurrentContext = args[0]
+			if err := cfg.save(); err != nil {
+				return err
+			}
+
+			fmt.Printf("logged in to %s as context %q\n", c.Endpoint, args[0])
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&c.Endpoint, "endpoint", "", "gRPC address of the service, e.g. warden-service:9300")
+	flags.StringVar(&c.CAFile, "ca", "", "CA certificate verifying the server (system roots when empty)")
+	flags.StringVar(&c.CertFile, "cert", "", "client certificate for mTLS")
+	flags.StringVar(&c.KeyFile, "key", "", "client key for mTLS")
+	flags.StringVar(&c.ServerName, "server-name", "", "expected server name of the certificate (default warden-service)")
+	flags.BoolVar(&c.Insecure, "insecure", false, "connect without TLS (dev only)")
+	flags.Uint32Var(&c.TenantID, "tenant", 0, "tenant ID")
+	flags.Uint32Var(&c.UserID, "user", 0, "user ID")
+	flags.StringVar(&c.Username, "username", "", "username")
+	flags.StringVar(&roles, "roles", "", "comma-separated roles, e.g. platform:admin")
+	flags.StringVar(&c.Token, "token", "", "bearer token, - to read it from stdin")
+	flags.BoolVar(&noVerify, "no-verify", false, "save the context without checking the credentials")
+
+	return cmd
+}
+
+// verifyContext makes a call that needs a valid identity
+func verifyContext(ctx context.Context, c *Context) error {
+	logger := log.NewFilter(log.NewStdLogger(os.Stderr), log.FilterLevel(log.LevelWarn))
+	cl, err := client.NewClient(c.clientConfig(), logger)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	_, err = cl.Folders.ListFolders(ctx, &wardenV1.ListFoldersRequest{CountOnly: true})
+	return err
+}
+
+// newContextCmd returns the commands listing and switching contexts
+func (a *app) newContextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "context",
+		Short: "List, switch and remove login contexts",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "list",
+			Short: "List the contexts, the current one marked with *",
+			Args:  cobra.NoArgs,
+			RunE: func(*cobra.Command, []string) error {
+				cfg, err := loadConfig(a.configPath)
+				if err != nil {
+					return err
+				}
+
+				names := make([]string, 0, len(cfg.Contexts))
+				for name := range cfg.Contexts {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+
+				tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(tw, "CURRENT\tNAME\tENDPOINT\tTENANT\tUSER")
+				for _, name := range names {
+					c, current := cfg.Contexts[name], ""
+					if name == cfg.CurrentContext {
+						current = "*"
+					}
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\n", current, name, c.Endpoint, c.TenantID, c.Username)
+				}
+				return tw.Flush()
+			},
+		},
+		&cobra.Command{
+			Use:   "use <context>",
+			Short: "Switch to a context",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(_ *cobra.Command, args []string) error {
+				cfg, err := loadConfig(a.configPath)
+				if err != nil {
+					return err
+				}
+				if _, err := cfg.context(args[0]); err != nil {
+					return err
+				}
+				cfg.CurrentContext = args[0]
+				return cfg.save()
+			},
+		},
+		&cobra.Command{
+			Use:   "delete <context>",
+			Short: "Remove a context and its credentials",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(_ *cobra.Command, args []string) error {
+				cfg, err := loadConfig(a.configPath)
+				if err != nil {
+					return err
+				}
+				if _, err := cfg.context(args[0]); err != nil {
+					return err
+				}
+				delete(cfg.Contexts, args[0])
+				if cfg.CurrentContext == args[0] {
+					cfg.CurrentContext = ""
+				}
+				return cfg.save()
+			},
+		},
+	)
+
+	return cmd
+}
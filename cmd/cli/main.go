@@ -0,0 +1,114 @@
+// Command warden-cli calls the Warden gRPC API for operators and CI
+// pipelines: folders, secrets, imports and exports, and permissions.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/client"
+)
+
+// go build -ldflags "-X main.version=x.y.z"
+var version = "1.0.0"
+
+// app holds the global flags and opens the client of the selected context
+type app struct {
+	configPath  string
+	contextName string
+	jsonOutput  bool
+
+	client *client.Client
+}
+
+func main() {
+	a := &app{}
+
+	root := &cobra.Command{
+		Use:           "warden-cli",
+		Short:         "Command-line client of the Warden secret service",
+		Version:       version,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPostRun: func(*cobra.Command, []string) {
+			if a.client != nil {
+				_ = a.client.Close()
+			}
+		},
+	}
+	root.PersistentFlags().StringVar(&a.configPath, "config", defaultConfigPath(), "configuration file with the login contexts")
+	root.PersistentFlags().StringVar(&a.contextName, "context", os.Getenv("WARDEN_CONTEXT"), "context to use instead of the current one")
+	root.PersistentFlags().BoolVar(&a.jsonOutput, "json", false, "print results as JSON")
+
+	root.AddCommand(
+		a.newLoginCmd(),
+		a.newContextCmd(),
+		a.newFolderCmd(),
+		a.newSecretCmd(),
+		a.newImportCmd(),
+		a.newExportCmd(),
+		a.newPermissionCmd(),
+	)
+
+	if err := root.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+// connect opens the client of the selected context
+func (a *app) connect() (*client.Client, error) {
+	if a.client != nil {
+		return a.client, nil
+	}
+
+	cfg, err := loadConfig(a.configPath)
+	if err != nil {
+		return nil, err
+	}
+	c, err := cfg.context(a.contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only warnings reach the terminal, e.g. about retries
+	logger := log.NewFilter(log.NewStdLogger(os.Stderr), log.FilterLevel(log.LevelWarn))
+	a.client, err = client.NewClient(c.clientConfig(), logger)
+	if err != nil {
+		return nil, err
+	}
+	return a.client, nil
+}
+
+// run opens the client and calls fn with it
+func (a *app) run(cmd *cobra.Command, fn func(ctx context.Context, c *client.Client) error) error {
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	return fn(cmd.Context(), c)
+}
+
+// printJSON prints v indented, for --json. API messages keep the field
+// names of the HTTP API.
+func printJSON(v any) error {
+	if m, ok := v.(proto.Message); ok {
+		raw, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(m)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Println(string(raw))
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/client"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// tenantSubjectID is the subject ID of tenant-wide grants
+const tenantSubjectID = "all"
+
+// subjectFlags select who a permission is granted to
+type subjectFlags struct {
+	user   string
+	role   string
+	tenant bool
+}
+
+func (s *subjectFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&s.user, "user", "", "user ID")
+	cmd.Flags().StringVar(&s.role, "role", "", "role code")
+	cmd.Flags().BoolVar(&s.tenant, "tenant", false, "everyone in the tenant")
+}
+
+func (s *subjectFlags) subject() (wardenV1.SubjectType, string, error) {
+	switch {
+	case s.user != "" && s.role == "" && !s.tenant:
+		return wardenV1.SubjectType_SUBJECT_TYPE_USER, s.user, nil
+	case s.role != "" && s.user == "" && !s.tenant:
+		return wardenV1.SubjectType_SUBJECT_TYPE_ROLE, s.role, nil
+	case s.tenant && s.user == "" && s.role == "":
+		return wardenV1.SubjectType_SUBJECT_TYPE_TENANT, tenantSubjectID, nil
+	}
+	return 0, "", errors.New("pass exactly one of --user, --role or --tenant")
+}
+
+func parseRelation(s string) (wardenV1.Relation, error) {
+	v, ok := wardenV1.Relation_value["RELATION_"+strings.ToUpper(s)]
+	if !ok || v == 0 {
+		return 0, fmt.Errorf("invalid relation %q, use owner, editor, viewer or sharer", s)
+	}
+	return wardenV1.Relation(v), nil
+}
+
+// resolveResource returns the type and ID of a folder or secret given by
+// path, or by ID when it does not start with /
+func resolveResource(ctx context.Context, c *client.Client, kind, ref string) (wardenV1.ResourceType, string, error) {
+	switch kind {
+	case "folder":
+		if !strings.HasPrefix(ref, "/") {
+			return wardenV1.ResourceType_RESOURCE_TYPE_FOLDER, ref, nil
+		}
+		folder, err := c.FolderByPath(ctx, ref)
+		if err != nil {
+			return 0, "", err
+		}
+		return wardenV1.ResourceType_RESOURCE_TYPE_FOLDER, folder.Id, nil
+	case "secret":
+		if !strings.HasPrefix(ref, "/") {
+			return wardenV1.ResourceType_RESOURCE_TYPE_SECRET, ref, nil
+		}
+		secret, err := c.SecretByPath(ctx, ref)
+		if err != nil {
+			return 0, "", err
+		}
+		return wardenV1.ResourceType_RESOURCE_TYPE_SECRET, secret.Id, nil
+	}
+	return 0, "", fmt.Errorf("invalid resource kind %q, use folder or secret", kind)
+}
+
+func (a *app) newPermissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permission",
+		Short: "Grant, revoke and list permissions on folders and secrets",
+	}
+
+	var (
+		grantSubject subjectFlags
+		expiresIn    time.Duration
+	)
+	grant := &cobra.Command{
+		Use:   "grant <folder|secret> <path|id> <owner|editor|viewer|sharer>",
+		Short: "Grant a relation on a folder or secret to a user, role or the tenant",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			relation, err := parseRelation(args[2])
+			if err != nil {
+				return err
+			}
+			subjectType, subjectID, err := grantSubject.subject()
+			if err != nil {
+				return err
+			}
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				resourceType, resourceID, err := resolveResource(ctx, c, args[0], args[1])
+				if err != nil {
+					return err
+				}
+				req := &wardenV1.GrantAccessRequest{
+					ResourceType: resourceType,
+					ResourceId:   resourceID,
+					Relation:     relation,
+					SubjectType:  subjectType,
+					SubjectId:    subjectID,
+				}
+				if expiresIn > 0 {
+					req.ExpiresAt = timestamppb.New(time.Now().Add(expiresIn))
+				}
+				resp, err := c.Permissions.GrantAccess(ctx, req)
+				if err != nil {
+					return err
+				}
+				if a.jsonOutput {
+					return printJSON(resp)
+				}
+				fmt.Printf("granted %s on %s\n", args[2], args[1])
+				return nil
+			})
+		},
+	}
+	grantSubject.register(grant)
+	grant.Flags().DurationVar(&expiresIn, "expires-in", 0, "revoke the grant automatically after this duration")
+
+	var revokeSubject subjectFlags
+	revoke := &cobra.Command{
+		Use:   "revoke <folder|secret> <path|id> [relation]",
+		Short: "Revoke a relation, or all of them, on a folder or secret from a user, role or the tenant",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &wardenV1.RevokeAccessRequest{}
+			if len(args) == 3 {
+				relation, err := parseRelation(args[2])
+				if err != nil {
+					return err
+				}
+				req.Relation = &relation
+			}
+			var err error
+			if req.SubjectType, req.SubjectId, err = revokeSubject.subject(); err != nil {
+				return err
+			}
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				if req.ResourceType, req.ResourceId, err = resolveResource(ctx, c, args[0], args[1]); err != nil {
+					return err
+				}
+				if _, err := c.Permissions.RevokeAccess(ctx, req); err != nil {
+					return err
+				}
+				fmt.Printf("revoked access on %s\n", args[1])
+				return nil
+			})
+		},
+	}
+	revokeSubject.register(revoke)
+
+	list := &cobra.Command{
+		Use:   "list <folder|secret> <path|id>",
+		Short: "List the permissions on a folder or secret",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				resourceType, resourceID, err := resolveResource(ctx, c, args[0], args[1])
+				if err != nil {
+					return err
+				}
+
+				var tuples []*wardenV1.PermissionTuple
+				req := &wardenV1.ListPermissionsRequest{ResourceType: &resourceType, ResourceId: &resourceID}
+				for {
+					resp, err := c.Permissions.ListPermissions(ctx, req)
+					if err != nil {
+						return err
+					}
+					tuples = append(tuples, resp.Permissions...)
+					if resp.NextCursor == "" {
+						break
+					}
+					req.Cursor = &resp.NextCursor
+				}
+
+				if a.jsonOutput {
+					return printJSON(&wardenV1.ListPermissionsResponse{Permissions: tuples, Total: uint32(len(tuples))})
+				}
+				tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(tw, "RELATION\tSUBJECT\tID\tEXPIRES")
+				for _, t := range tuples {
+					expires := "-"
+					if t.ExpiresAt != nil {
+						expires = t.ExpiresAt.AsTime().Format(time.RFC3339)
+					}
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
+						strings.ToLower(strings.TrimPrefix(t.Relation.String(), "RELATION_")),
+						strings.ToLower(strings.TrimPrefix(t.SubjectType.String(), "SUBJECT_TYPE_")),
+						t.SubjectId, expires)
+				}
+				return tw.Flush()
+			})
+		},
+	}
+
+	cmd.AddCommand(grant, revoke, list)
+	return cmd
+}
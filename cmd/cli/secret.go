@@ -0,0 +1,250 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/client"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// secretFields are the values of a secret `secret set` can change
+type secretFields struct {
+	username    string
+	hostURL     string
+	description string
+	comment     string
+}
+
+func (f *secretFields) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.username, "username", "", "username of the secret")
+	cmd.Flags().StringVar(&f.hostURL, "url", "", "host URL of the secret")
+	cmd.Flags().StringVar(&f.description, "description", "", "description of the secret")
+	cmd.Flags().StringVar(&f.comment, "comment", "", "comment of the new version")
+}
+
+func (a *app) newSecretCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret",
+		Short: "Read, write and generate secrets by path",
+	}
+	cmd.AddCommand(a.newSecretGetCmd(), a.newSecretSetCmd(), a.newSecretGenerateCmd())
+	return cmd
+}
+
+func (a *app) newSecretGetCmd() *cobra.Command {
+	var (
+		field        string
+		metadataKeys []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "get <path>",
+		Short: "Print the password, or another field, of the secret at a path such as /Team/Infra/db",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				resp, err := c.GetSecretByPath(ctx, args[0], metadataKeys...)
+				if err != nil {
+					return err
+				}
+				if a.jsonOutput {
+					return printJSON(resp)
+				}
+
+				switch field {
+				case "password":
+					fmt.Println(resp.Password)
+				case "username":
+					fmt.Println(resp.Username)
+				case "url":
+					fmt.Println(resp.HostUrl)
+				case "id":
+					fmt.Println(resp.Id)
+				case "version":
+					fmt.Println(resp.Version)
+				default:
+					value, ok := resp.Metadata[field]
+					if !ok {
+						return fmt.Errorf("unknown field %q, pass metadata keys with --metadata", field)
+					}
+					fmt.Println(value)
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringVarP(&field, "field", "f", "password", "field to print: password, username, url, id, version or a metadata key")
+	cmd.Flags().StringSliceVar(&metadataKeys, "metadata", nil, "metadata keys to read")
+
+	return cmd
+}
+
+func (a *app) newSecretSetCmd() *cobra.Command {
+	var (
+		fields   secretFields
+		fromFile string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "set <path>",
+		Short: "Create the secret at a path, or add a new password version, reading the password from stdin",
+		Long: `Create the secret at a path, or add a new password version to it.
+
+The password is read from stdin, or from a file with --from-file, so it does
+not end up in the shell history. Missing folders are created.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			password, err := readPassword(fromFile)
+			if err != nil {
+				return err
+			}
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				return a.setSecret(ctx, c, args[0], password, &fields)
+			})
+		},
+	}
+	fields.register(cmd)
+	cmd.Flags().StringVar(&fromFile, "from-file", "", "read the password from a file instead of stdin")
+
+	return cmd
+}
+
+func (a *app) newSecretGenerateCmd() *cobra.Command {
+	var (
+		fields    secretFields
+		length    int
+		noSymbols bool
+		printIt   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "generate <path>",
+		Short: "Store a random password as the secret at a path",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			password, err := generatePassword(length, !noSymbols)
+			if err != nil {
+				return err
+			}
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				if err := a.setSecret(ctx, c, args[0], password, &fields); err != nil {
+					return err
+				}
+				if printIt {
+					fmt.Println(password)
+				}
+				return nil
+			})
+		},
+	}
+	fields.register(cmd)
+	cmd.Flags().IntVar(&length, "length", 32, "password length")
+	cmd.Flags().BoolVar(&noSymbols, "no-symbols", false, "only use letters and digits")
+	cmd.Flags().BoolVar(&printIt, "print", false, "print the generated password")
+
+	return cmd
+}
+
+// setSecret updates the password of the secret at path, or creates it
+func (a *app) setSecret(ctx context.Context, c *client.Client, path, password string, f *secretFields) error {
+	secret, err := c.SecretByPath(ctx, path)
+	if errors.Is(err, client.ErrNotFound) {
+		return a.createSecret(ctx, c, path, password, f)
+	}
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Secrets.UpdateSecretPassword(ctx, &wardenV1.UpdateSecretPasswordRequest{
+		Id:       secret.Id,
+		Password: password,
+		Comment:  f.comment,
+	})
+	if err != nil {
+		return err
+	}
+
+	if f.username != "" || f.hostURL != "" || f.description != "" {
+		update := &wardenV1.UpdateSecretRequest{Id: secret.Id}
+		if f.username != "" {
+			update.Username = &f.username
+		}
+		if f.hostURL != "" {
+			update.HostUrl = &f.hostURL
+		}
+		if f.description != "" {
+			update.Description = &f.description
+		}
+		if _, err := c.Secrets.UpdateSecret(ctx, update); err != nil {
+			return err
+		}
+	}
+
+	if a.jsonOutput {
+		return printJSON(resp)
+	}
+	fmt.Fprintf(os.Stderr, "updated %s to version %d\n", path, resp.GetVersion().GetVersionNumber())
+	return nil
+}
+
+func (a *app) createSecret(ctx context.Context, c *client.Client, path, password string, f *secretFields) error {
+	folderPath, name := client.SplitPath(path)
+	req := &wardenV1.CreateSecretRequest{
+		Name:           name,
+		Username:       f.username,
+		Password:       password,
+		HostUrl:        f.hostURL,
+		Description:    f.description,
+		VersionComment: f.comment,
+	}
+	if folderPath != "/" {
+		folder, err := c.EnsureFolder(ctx, folderPath)
+		if err != nil {
+			return err
+		}
+		req.FolderId = &folder.Id
+	}
+
+	resp, err := c.Secrets.CreateSecret(ctx, req)
+	if err != nil {
+		return err
+	}
+	if a.jsonOutput {
+		return printJSON(resp)
+	}
+	fmt.Fprintf(os.Stderr, "created %s\n", path)
+	return nil
+}
+
+// readPassword reads a password from a file, or from stdin for an empty
+// path, without the trailing newline
+func readPassword(path string) (string, error) {
+	if path != "" {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(raw), "\r\n"), nil
+	}
+	return readStdin()
+}
+
+func readStdin() (string, error) {
+	raw, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", err
+	}
+	value := strings.TrimRight(string(raw), "\r\n")
+	if value == "" {
+		return "", errors.New("nothing on stdin")
+	}
+	return value, nil
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/client"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// importOptions are the flags shared by the import commands
+type importOptions struct {
+	folder          string
+	flatten         bool
+	duplicates      string
+	matchURLAndUser bool
+}
+
+func (o *importOptions) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.folder, "folder", "", "folder path to import into, created when missing (default root)")
+	cmd.Flags().BoolVar(&o.flatten, "flatten", false, "put everything into the target folder instead of recreating the folders")
+	cmd.Flags().StringVar(&o.duplicates, "duplicates", "skip", "how to handle existing secrets: skip, rename, overwrite or merge")
+	cmd.Flags().BoolVar(&o.matchURLAndUser, "match-url", false, "match existing secrets by URL and username instead of name")
+}
+
+func (o *importOptions) duplicateHandling() (wardenV1.DuplicateHandling, error) {
+	v, ok := wardenV1.DuplicateHandling_value["DUPLICATE_HANDLING_"+strings.ToUpper(o.duplicates)]
+	if !ok || v == 0 {
+		return 0, fmt.Errorf("invalid --duplicates %q", o.duplicates)
+	}
+	return wardenV1.DuplicateHandling(v), nil
+}
+
+func (o *importOptions) duplicateMatch() wardenV1.DuplicateMatch {
+	if o.matchURLAndUser {
+		return wardenV1.DuplicateMatch_DUPLICATE_MATCH_URL_USERNAME
+	}
+	return wardenV1.DuplicateMatch_DUPLICATE_MATCH_NAME
+}
+
+// targetFolder returns the ID of the import folder, nil for the root
+func (o *importOptions) targetFolder(ctx context.Context, c *client.Client) (*string, error) {
+	if o.folder == "" || o.folder == "/" {
+		return nil, nil
+	}
+	folder, err := c.EnsureFolder(ctx, o.folder)
+	if err != nil {
+		return nil, err
+	}
+	return &folder.Id, nil
+}
+
+func (a *app) newImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import secrets from a Bitwarden or CSV export",
+	}
+
+	var bwOpts importOptions
+	bitwarden := &cobra.Command{
+		Use:   "bitwarden <file>",
+		Short: "Import an unencrypted Bitwarden JSON export, - for stdin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := readInput(args[0])
+			if err != nil {
+				return err
+			}
+			handling, err := bwOpts.duplicateHandling()
+			if err != nil {
+				return err
+			}
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				folderID, err := bwOpts.targetFolder(ctx, c)
+				if err != nil {
+					return err
+				}
+				resp, err := c.Bitwarden.ImportFromBitwarden(ctx, &wardenV1.ImportFromBitwardenRequest{
+					JsonData:          data,
+					TargetFolderId:    folderID,
+					DuplicateHandling: handling,
+					PreserveFolders:   !bwOpts.flatten,
+					DuplicateMatch:    bwOpts.duplicateMatch(),
+				})
+				if err != nil {
+					return err
+				}
+				if a.jsonOutput {
+					return printJSON(resp)
+				}
+				fmt.Printf("%d folders created, %d imported, %d updated, %d skipped, %d failed\n",
+					resp.FoldersCreated, resp.ItemsImported, resp.ItemsUpdated, resp.ItemsSkipped, resp.ItemsFailed)
+				for _, e := range resp.Errors {
+					fmt.Printf("  %s: %s: %s\n", e.ItemName, e.ErrorType, e.Message)
+				}
+				return nil
+			})
+		},
+	}
+	bwOpts.register(bitwarden)
+
+	var (
+		csvOpts importOptions
+		format  string
+	)
+	csv := &cobra.Command{
+		Use:   "csv <file>",
+		Short: "Import a LastPass or Chromium CSV export, - for stdin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := readInput(args[0])
+			if err != nil {
+				return err
+			}
+			handling, err := csvOpts.duplicateHandling()
+			if err != nil {
+				return err
+			}
+			csvFormat, ok := wardenV1.CsvFormat_value["CSV_FORMAT_"+strings.ToUpper(format)]
+			if !ok || csvFormat == 0 || wardenV1.CsvFormat(csvFormat) == wardenV1.CsvFormat_CSV_FORMAT_CUSTOM {
+				return fmt.Errorf("invalid --format %q, use lastpass or chromium", format)
+			}
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				folderID, err := csvOpts.targetFolder(ctx, c)
+				if err != nil {
+					return err
+				}
+				resp, err := c.Csv.ImportFromCsv(ctx, &wardenV1.ImportFromCsvRequest{
+					CsvData:           data,
+					Format:            wardenV1.CsvFormat(csvFormat),
+					TargetFolderId:    folderID,
+					DuplicateHandling: handling,
+					PreserveFolders:   !csvOpts.flatten,
+					DuplicateMatch:    csvOpts.duplicateMatch(),
+				})
+				if err != nil {
+					return err
+				}
+				if a.jsonOutput {
+					return printJSON(resp)
+				}
+				fmt.Printf("%d rows, %d folders created, %d imported, %d updated, %d skipped, %d failed\n",
+					resp.RowsTotal, resp.FoldersCreated, resp.ItemsImported, resp.ItemsUpdated, resp.ItemsSkipped, resp.ItemsFailed)
+				for _, e := range resp.Errors {
+					fmt.Printf("  line %d %s: %s: %s\n", e.Line, e.ItemName, e.ErrorType, e.Message)
+				}
+				return nil
+			})
+		},
+	}
+	csvOpts.register(csv)
+	csv.Flags().StringVar(&format, "format", "lastpass", "CSV layout: lastpass or chromium")
+
+	cmd.AddCommand(bitwarden, csv)
+	return cmd
+}
+
+func (a *app) newExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export secrets to Bitwarden JSON or CSV",
+	}
+
+	var (
+		bwFolder     string
+		noSubfolders bool
+		passwordFile string
+		bwOutput     string
+	)
+	bitwarden := &cobra.Command{
+		Use:   "bitwarden",
+		Short: "Export to Bitwarden JSON, optionally password protected",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req := &wardenV1.ExportToBitwardenRequest{IncludeSubfolders: !noSubfolders}
+			if passwordFile != "" {
+				password, err := readPassword(passwordFile)
+				if err != nil {
+					return err
+				}
+				req.ExportPassword = &password
+			}
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				if bwFolder != "" && bwFolder != "/" {
+					folder, err := c.FolderByPath(ctx, bwFolder)
+					if err != nil {
+						return err
+					}
+					req.FolderId = &folder.Id
+				}
+				resp, err := c.Bitwarden.ExportToBitwarden(ctx, req)
+				if err != nil {
+					return err
+				}
+				if err := writeOutput(bwOutput, resp.JsonData); err != nil {
+					return err
+				}
+				fmt.Fprintf(os.Stderr, "%d folders and %d items exported, %d skipped, %d excluded by policy\n",
+					resp.FoldersExported, resp.ItemsExported, resp.ItemsSkipped, resp.ItemsExcludedByPolicy)
+				return nil
+			})
+		},
+	}
+	bitwarden.Flags().StringVar(&bwFolder, "folder", "", "folder path to export (default everything)")
+	bitwarden.Flags().BoolVar(&noSubfolders, "no-subfolders", false, "leave out the subfolders of --folder")
+	bitwarden.Flags().StringVar(&passwordFile, "password-file", "", "file with the password to encrypt the export with")
+	bitwarden.Flags().StringVarP(&bwOutput, "output", "o", "-", "file to write, - for stdout")
+
+	var (
+		csvFolder string
+		subtree   bool
+		columns   []string
+		csvOutput string
+	)
+	csv := &cobra.Command{
+		Use:   "csv",
+		Short: "Export to CSV",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req := &wardenV1.ExportToCsvRequest{Scope: wardenV1.CsvExportScope_CSV_EXPORT_SCOPE_TENANT}
+			for _, col := range columns {
+				v, ok := wardenV1.CsvColumn_value["CSV_COLUMN_"+strings.ToUpper(col)]
+				if !ok || v == 0 {
+					return fmt.Errorf("invalid column %q", col)
+				}
+				req.Columns = append(req.Columns, wardenV1.CsvColumn(v))
+			}
+			return a.run(cmd, func(ctx context.Context, c *client.Client) error {
+				if csvFolder != "" && csvFolder != "/" {
+					folder, err := c.FolderByPath(ctx, csvFolder)
+					if err != nil {
+						return err
+					}
+					req.FolderId = &folder.Id
+					req.Scope = wardenV1.CsvExportScope_CSV_EXPORT_SCOPE_FOLDER
+					if subtree {
+						req.Scope = wardenV1.CsvExportScope_CSV_EXPORT_SCOPE_SUBTREE
+					}
+				}
+				resp, err := c.Csv.ExportToCsv(ctx, req)
+				if err != nil {
+					return err
+				}
+				if err := writeOutput(csvOutput, resp.CsvData); err != nil {
+					return err
+				}
+				fmt.Fprintf(os.Stderr, "%d items exported, %d skipped, %d excluded by policy\n",
+					resp.ItemsExported, resp.ItemsSkipped, resp.ItemsExcludedByPolicy)
+				return nil
+			})
+		},
+	}
+	csv.Flags().StringVar(&csvFolder, "folder", "", "folder path to export (default every secret of the tenant)")
+	csv.Flags().BoolVar(&subtree, "subtree", false, "include the subfolders of --folder")
+	csv.Flags().StringSliceVar(&columns, "columns", []string{"name", "username", "password", "url", "folder_path"}, "columns in output order: name, username, password, url, folder_path, tags, notes")
+	csv.Flags().StringVarP(&csvOutput, "output", "o", "-", "file to write, - for stdout")
+
+	cmd.AddCommand(bitwarden, csv)
+	return cmd
+}
+
+// readInput reads a file, or stdin for -
+func readInput(path string) (string, error) {
+	if path == "-" {
+		raw, err := io.ReadAll(os.Stdin)
+		return string(raw), err
+	}
+	raw, err := os.ReadFile(path)
+	return string(raw), err
+}
+
+// writeOutput writes an export to a file readable by the user only, or to
+// stdout for -
+func writeOutput(path, data string) error {
+	if path == "-" {
+		_, err := io.WriteString(os.Stdout, data)
+		return err
+	}
+	return os.WriteFile(path, []byte(data), 0o600)
+}
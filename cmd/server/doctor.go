@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/spf13/cobra"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	bConfig "github.com/tx7do/kratos-bootstrap/config"
+
+	"github.com/go-tangra/go-tangra-warden/internal/doctor"
+)
+
+const defaultDoctorTimeout = 10 * time.Second
+
+// newDoctorCmd returns the doctor command. It loads the configuration the
+// server would, checks the database, Vault, the certificates and Redis,
+// prints a report and exits with status 1 when a check failed.
+func newDoctorCmd(ctx *bootstrap.Context) *cobra.Command {
+	var (
+		jsonOutput bool
+		timeout    time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:          "doctor",
+		Short:        "Check the service's dependencies and exit",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			confPath, err := cmd.Flags().GetString("conf")
+			if err != nil {
+				return err
+			}
+			if err := bConfig.LoadBootstrapConfig(confPath); err != nil {
+				return err
+			}
+
+			// Only errors are logged, so they do not drown the report
+			logger := log.NewFilter(log.NewStdLogger(os.Stderr), log.FilterLevel(log.LevelError))
+			dctx := bootstrap.NewContextWithParam(context.Background(), ctx.GetAppInfo(), bConfig.GetBootstrapConfig(), logger)
+
+			report := doctor.Run(dctx, timeout)
+			if jsonOutput {
+				err = report.WriteJSON(os.Stdout)
+			} else {
+				err = report.WriteText(os.Stdout)
+			}
+			if err != nil {
+				return err
+			}
+			if report.Failed() {
+				os.Exit(1)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "print the report as JSON")
+	cmd.Flags().DurationVar(&timeout, "timeout", defaultDoctorTimeout, "time limit of each check")
+
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	kratosHttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/spf13/cobra"
 
 	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
@@ -14,6 +15,10 @@ import (
 	"github.com/go-tangra/go-tangra-common/registration"
 	"github.com/go-tangra/go-tangra-common/service"
 	"github.com/go-tangra/go-tangra-warden/cmd/server/assets"
+	"github.com/go-tangra/go-tangra-warden/internal/cert"
+	"github.com/go-tangra/go-tangra-warden/internal/job"
+	"github.com/go-tangra/go-tangra-warden/internal/siem"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
 )
 
 var (
@@ -24,23 +29,32 @@ var (
 	description = "Enterprise secret and credential management service with Vault integration"
 )
 
-// Global registration helper for cleanup
-var globalRegHelper *registration.RegistrationHelper
-
 // go build -ldflags "-X main.version=x.y.z"
 
 func newApp(
 	ctx *bootstrap.Context,
 	gs *grpc.Server,
 	hs *kratosHttp.Server,
+	auditRetentionJob *job.AuditRetentionJob,
+	anomalyDetectionJob *job.AnomalyDetectionJob,
+	auditForwarder *siem.Forwarder,
+	webhookDispatcher *webhook.Dispatcher,
+	healthMonitor *job.HealthMonitor,
+	certReloader *cert.Reloader,
+	outboxWorker *job.OutboxWorker,
+	accessTracker *job.AccessTracker,
+	folderCountReconciler *job.FolderCountReconciler,
+	emergencyAccessGranter *job.EmergencyAccessGranter,
+	usageAggregationJob *job.UsageAggregationJob,
 ) *kratos.App {
-	globalRegHelper = registration.StartRegistration(ctx, ctx.GetLogger(), &registration.Config{
+	regHelper := registration.StartRegistration(ctx, ctx.GetLogger(), &registration.Config{
 		ModuleID:          moduleID,
 		ModuleName:        moduleName,
 		Version:           version,
 		Description:       description,
 		GRPCEndpoint:      registration.GetGRPCAdvertiseAddr(ctx, "0.0.0.0:9300"),
 		AdminEndpoint:     registration.GetEnvOrDefault("ADMIN_GRPC_ENDPOINT", ""),
+		AuthToken:         registration.GetEnvOrDefault("ADMIN_AUTH_TOKEN", ""),
 		FrontendEntryUrl:  registration.GetEnvOrDefault("FRONTEND_ENTRY_URL", ""),
 		HttpEndpoint:      registration.GetEnvOrDefault("HTTP_ADVERTISE_ADDR", ""),
 		OpenapiSpec:       assets.OpenApiData,
@@ -51,7 +65,10 @@ func newApp(
 		MaxRetries:        60,
 	})
 
-	return bootstrap.NewApp(ctx, gs, hs)
+	// Stop the registration before the gRPC server drains
+	drainingGS := newDrainingGRPCServer(ctx, gs, regHelper)
+
+	return bootstrap.NewApp(ctx, drainingGS, hs, auditRetentionJob, anomalyDetectionJob, auditForwarder, webhookDispatcher, healthMonitor, certReloader, outboxWorker, accessTracker, folderCountReconciler, emergencyAccessGranter, usageAggregationJob)
 }
 
 func runApp() error {
@@ -64,10 +81,9 @@ func runApp() error {
 		},
 	)
 
-	// Ensure registration cleanup on exit
-	defer globalRegHelper.Stop()
-
-	return bootstrap.RunApp(ctx, initApp)
+	return bootstrap.RunApp(ctx, initApp, func(root *cobra.Command) {
+		root.AddCommand(newDoctorCmd(ctx), newMigrateCmd(ctx))
+	})
 }
 
 func main() {
@@ -75,4 +91,3 @@ func main() {
 		panic(err)
 	}
 }
-
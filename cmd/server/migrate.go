@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/spf13/cobra"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	bConfig "github.com/tx7do/kratos-bootstrap/config"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+)
+
+var migrationNamePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// newMigrateCmd returns the migrate command managing the versioned schema
+// migrations of the configured database
+func newMigrateCmd(ctx *bootstrap.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage the database schema migrations",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:          "up [N]",
+			Short:        "Apply all pending migrations, or the next N",
+			Args:         cobra.MaximumNArgs(1),
+			SilenceUsage: true,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				steps, err := migrationSteps(args, 0)
+				if err != nil {
+					return err
+				}
+				return withMigrator(cmd, ctx, func(m *data.Migrator) error {
+					n, err := m.Up(context.Background(), steps)
+					fmt.Printf("%d migrations applied\n", n)
+					return err
+				})
+			},
+		},
+		&cobra.Command{
+			Use:          "down [N]",
+			Short:        "Revert the last migration, or the last N",
+			Args:         cobra.MaximumNArgs(1),
+			SilenceUsage: true,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				steps, err := migrationSteps(args, 1)
+				if err != nil {
+					return err
+				}
+				return withMigrator(cmd, ctx, func(m *data.Migrator) error {
+					n, err := m.Down(context.Background(), steps)
+					fmt.Printf("%d migrations reverted\n", n)
+					return err
+				})
+			},
+		},
+		&cobra.Command{
+			Use:          "status",
+			Short:        "List the migrations and whether they are applied",
+			Args:         cobra.NoArgs,
+			SilenceUsage: true,
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				return withMigrator(cmd, ctx, func(m *data.Migrator) error {
+					status, err := m.Status(context.Background())
+					if err != nil {
+						return err
+					}
+					return printMigrationStatus(status)
+				})
+			},
+		},
+		newMigrateDiffCmd(ctx),
+	)
+
+	return cmd
+}
+
+// newMigrateDiffCmd returns the developer command writing the next migration
+// from the difference between the database and the ent schema of this build
+func newMigrateDiffCmd(ctx *bootstrap.Context) *cobra.Command {
+	var dir string
+
+	cmd := &cobra.Command{
+		Use:          "diff <name>",
+		Short:        "Write the next migration from the ent schema (development)",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if !migrationNamePattern.MatchString(name) {
+				return errors.New("migration name must be lowercase letters, digits and underscores")
+			}
+
+			return withMigrator(cmd, ctx, func(m *data.Migrator) error {
+				mig, err := m.Diff(context.Background(), name)
+				if err != nil {
+					return err
+				}
+				if mig == nil {
+					fmt.Println("schema is up to date, no migration written")
+					return nil
+				}
+
+				target := dir
+				if target == "" {
+					target = filepath.Join("internal", "data", "migrations", m.Dir())
+				}
+				base := filepath.Join(target, fmt.Sprintf("%06d_%s", mig.Version, mig.Name))
+				if err := os.WriteFile(base+".up.sql", []byte(mig.Up), 0o644); err != nil {
+					return err
+				}
+				if err := os.WriteFile(base+".down.sql", []byte(mig.Down), 0o644); err != nil {
+					return err
+				}
+
+				fmt.Printf("wrote %s.up.sql and %s.down.sql, fill in the down migration\n", base, base)
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringVar(&dir, "dir", "", "directory to write to (default internal/data/migrations/<dialect>)")
+
+	return cmd
+}
+
+// withMigrator loads the configuration, opens the database and runs fn
+func withMigrator(cmd *cobra.Command, ctx *bootstrap.Context, fn func(m *data.Migrator) error) error {
+	confPath, err := cmd.Flags().GetString("conf")
+	if err != nil {
+		return err
+	}
+	if err := bConfig.LoadBootstrapConfig(confPath); err != nil {
+		return err
+	}
+
+	logger := log.NewStdLogger(os.Stderr)
+	mctx := bootstrap.NewContextWithParam(context.Background(), ctx.GetAppInfo(), bConfig.GetBootstrapConfig(), logger)
+
+	drv, err := data.OpenDriver(mctx)
+	if err != nil {
+		return err
+	}
+	defer drv.Close()
+
+	m, err := data.NewMigrator(drv, mctx.NewLoggerHelper("warden/migrate"))
+	if err != nil {
+		return err
+	}
+	return fn(m)
+}
+
+func printMigrationStatus(status *data.SchemaStatus) error {
+	if !status.Tracked {
+		fmt.Println("database is not under versioned migrations yet, `migrate up` adopts it")
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tNAME\tAPPLIED")
+	for _, s := range status.Migrations {
+		name, applied := s.Name, "pending"
+		if name == "" {
+			name = "(unknown to this build)"
+		}
+		if s.AppliedAt != nil {
+			applied = s.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(tw, "%06d\t%s\t%s\n", s.Version, name, applied)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nversion %d, %d pending\n", status.Version, status.Pending)
+	return nil
+}
+
+// migrationSteps parses the optional step count argument
+func migrationSteps(args []string, def int) (int, error) {
+	if len(args) == 0 {
+		return def, nil
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid number of migrations %q", args[0])
+	}
+	return n, nil
+}
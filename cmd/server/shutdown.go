@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/registration"
+)
+
+// drainingGRPCServer orders the shutdown of the gRPC server. Kratos stops all
+// servers concurrently, so the registration is stopped here first: the admin
+// gateway stops routing to this instance and heartbeats end before in-flight
+// requests are drained. Database, Vault and Redis connections are closed by
+// the wire cleanup, which runs only after every server has stopped.
+type drainingGRPCServer struct {
+	*grpc.Server
+
+	log       *log.Helper
+	regHelper *registration.RegistrationHelper
+}
+
+func newDrainingGRPCServer(ctx *bootstrap.Context, gs *grpc.Server, regHelper *registration.RegistrationHelper) *drainingGRPCServer {
+	return &drainingGRPCServer{
+		Server:    gs,
+		log:       ctx.NewLoggerHelper("warden/shutdown"),
+		regHelper: regHelper,
+	}
+}
+
+// Stop unregisters the module, then gracefully stops the gRPC server. Requests
+// still running when ctx expires are cancelled.
+func (s *drainingGRPCServer) Stop(ctx context.Context) error {
+	if s.regHelper != nil {
+		s.log.Info("Unregistering from admin gateway")
+		s.regHelper.Stop()
+	}
+
+	s.log.Info("Draining in-flight gRPC requests")
+	if err := s.Server.Stop(ctx); err != nil {
+		s.log.Errorf("gRPC server stop failed: %v", err)
+		return err
+	}
+	s.log.Info("gRPC server stopped")
+	return nil
+}
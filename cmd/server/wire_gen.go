@@ -8,13 +8,17 @@ package main
 
 import (
 	"github.com/go-kratos/kratos/v2"
+	"github.com/go-tangra/go-tangra-warden/internal/authn"
 	"github.com/go-tangra/go-tangra-warden/internal/cert"
 	"github.com/go-tangra/go-tangra-warden/internal/client"
 	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/job"
 	"github.com/go-tangra/go-tangra-warden/internal/metrics"
 	"github.com/go-tangra/go-tangra-warden/internal/server"
 	"github.com/go-tangra/go-tangra-warden/internal/service"
 	"github.com/go-tangra/go-tangra-warden/internal/service/providers"
+	"github.com/go-tangra/go-tangra-warden/internal/siem"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 )
 
@@ -26,52 +30,140 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	authenticator, err := authn.NewAuthenticator(context)
+	if err != nil {
+		return nil, nil, err
+	}
 	collector := metrics.NewCollector(context)
+	reloader := cert.NewReloader(context, certManager, collector)
 	entClient, cleanup, err := data.NewEntClient(context)
 	if err != nil {
 		return nil, nil, err
 	}
-	auditLogRepo := data.NewAuditLogRepo(context, entClient)
-	folderRepo := data.NewFolderRepo(context, entClient)
-	secretRepo := data.NewSecretRepo(context, entClient)
-	secretVersionRepo := data.NewSecretVersionRepo(context, entClient)
+	readReplica, cleanup2, err := data.NewReadReplica(context)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	auditLogRepo := data.NewAuditLogRepo(context, entClient, readReplica)
+	forwarder := siem.NewForwarder(context)
+	folderRepo := data.NewFolderRepo(context, entClient, readReplica)
+	secretRepo := data.NewSecretRepo(context, entClient, readReplica)
+	secretVersionRepo := data.NewSecretVersionRepo(context, entClient, readReplica)
 	permissionRepo := data.NewPermissionRepo(context, entClient)
-	vaultClient, cleanup2, err := data.NewVaultClient(context)
+	vaultClient, cleanup3, err := data.NewVaultClient(context)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tenantSettingRepo := data.NewTenantSettingRepo(context, entClient)
+	kvStore, err := data.NewVaultKVStore(context, vaultClient, collector, tenantSettingRepo)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	fieldCipher, err := data.NewFieldCipher(context, entClient, readReplica, vaultClient)
 	if err != nil {
+		cleanup3()
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	kvStore := data.NewVaultKVStore(vaultClient)
+	pendingOperationRepo := data.NewPendingOperationRepo(context, entClient, kvStore)
 	permissionStore := providers.ProvidePermissionStore(permissionRepo)
-	resourceLookup := providers.ProvideResourceLookup(folderRepo, secretRepo)
-	engine := providers.ProvideAuthzEngine(permissionStore, resourceLookup, context)
+	collectionRepo := data.NewCollectionRepo(context, entClient, readReplica)
+	resourceLookup := providers.ProvideResourceLookup(folderRepo, secretRepo, collectionRepo)
+	engine := providers.ProvideAuthzEngine(permissionStore, resourceLookup, context, collector)
 	checker := providers.ProvideAuthzChecker(engine)
-	folderService := service.NewFolderService(context, folderRepo, secretRepo, secretVersionRepo, permissionRepo, kvStore, checker, collector)
-	secretService := service.NewSecretService(context, secretRepo, secretVersionRepo, folderRepo, permissionRepo, kvStore, checker, collector)
-	permissionService := service.NewPermissionService(context, permissionRepo, folderRepo, secretRepo, engine, checker)
-	statisticsRepo := data.NewStatisticsRepo(context, entClient)
-	sharingClient, cleanup3, err := client.NewSharingClient(context, certManager)
+	webhookRepo := data.NewWebhookRepo(context, entClient)
+	webhookDeliveryRepo := data.NewWebhookDeliveryRepo(context, entClient)
+	dispatcher := webhook.NewDispatcher(context, webhookRepo, webhookDeliveryRepo, secretRepo)
+	transactor := data.NewTransactor(context, entClient)
+	secretUsageRepo := data.NewSecretUsageRepo(context, entClient, readReplica)
+	versionPinRepo := data.NewVersionPinRepo(context, entClient)
+	folderChangeLogRepo := data.NewFolderChangeLogRepo(context, entClient, readReplica)
+	folderService := service.NewFolderService(context, folderRepo, secretRepo, secretVersionRepo, permissionRepo, kvStore, checker, collector, secretUsageRepo, tenantSettingRepo, folderChangeLogRepo, transactor)
+	accessTracker := job.NewAccessTracker(context, secretRepo)
+	payloadLimits := service.NewPayloadLimits(context)
+	redisClient, cleanup4, err := data.NewRedisClient(context)
 	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	retrievalTokenStore := data.NewRetrievalTokenStore(context, redisClient)
+	stepUpPolicy := service.NewStepUpPolicy(context)
+	securityAlertRepo := data.NewSecurityAlertRepo(context, entClient)
+	canaryAlarm := service.NewCanaryAlarm(context, securityAlertRepo, dispatcher)
+	secretService := service.NewSecretService(context, secretRepo, secretVersionRepo, folderRepo, permissionRepo, kvStore, checker, collector, tenantSettingRepo, transactor, pendingOperationRepo, accessTracker, dispatcher, payloadLimits, retrievalTokenStore, stepUpPolicy, canaryAlarm, secretUsageRepo, versionPinRepo, collectionRepo, auditLogRepo)
+	permissionService := service.NewPermissionService(context, permissionRepo, folderRepo, secretRepo, collectionRepo, engine, checker, dispatcher)
+	statisticsRepo := data.NewStatisticsRepo(context, entClient, readReplica)
+	sharingClient, cleanup5, err := client.NewSharingClient(context, certManager)
+	if err != nil {
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	systemService := service.NewSystemService(context, vaultClient, statisticsRepo, sharingClient)
-	bitwardenTransferService := service.NewBitwardenTransferService(context, secretRepo, folderRepo, secretVersionRepo, permissionRepo, kvStore, checker, collector)
-	backupService := service.NewBackupService(context, entClient, kvStore)
+	systemService := service.NewSystemService(context, vaultClient, statisticsRepo, secretRepo, sharingClient, reloader, payloadLimits)
+	bitwardenTransferService := service.NewBitwardenTransferService(context, secretRepo, folderRepo, secretVersionRepo, permissionRepo, collectionRepo, kvStore, checker, collector, dispatcher, tenantSettingRepo, payloadLimits, transactor, pendingOperationRepo, stepUpPolicy, canaryAlarm)
+	backupService := service.NewBackupService(context, entClient, kvStore, dispatcher, tenantSettingRepo, payloadLimits)
 	sqlBackupService := service.NewSqlBackupService(context, entClient, kvStore)
-	adminClient, cleanup4, err := client.NewAdminClient(context, certManager)
+	adminClient, cleanup6, err := client.NewAdminClient(context, certManager)
 	if err != nil {
+		cleanup5()
+		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	userService := service.NewUserService(context, adminClient)
-	grpcServer := server.NewGRPCServer(context, certManager, collector, auditLogRepo, folderService, secretService, permissionService, systemService, bitwardenTransferService, backupService, sqlBackupService, userService)
+	auditRetentionJob := job.NewAuditRetentionJob(context, auditLogRepo, tenantSettingRepo)
+	auditService := service.NewAuditService(context, auditLogRepo, tenantSettingRepo, auditRetentionJob, securityAlertRepo, checker)
+	webhookService := service.NewWebhookService(context, webhookRepo, webhookDeliveryRepo)
+	csvTransferService := service.NewCsvTransferService(context, secretRepo, folderRepo, secretVersionRepo, permissionRepo, kvStore, checker, collector, dispatcher, tenantSettingRepo, payloadLimits, transactor, pendingOperationRepo, stepUpPolicy, canaryAlarm)
+	wardenClient, cleanup7, err := client.NewWardenClient(context, certManager)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tenantTransferService := service.NewTenantTransferService(context, secretRepo, folderRepo, secretVersionRepo, permissionRepo, kvStore, collector, dispatcher, wardenClient)
+	exportPolicyService := service.NewExportPolicyService(context, tenantSettingRepo, folderRepo)
+	geoPolicyService := service.NewGeoPolicyService(context, tenantSettingRepo)
+	passwordPolicyService := service.NewPasswordPolicyService(context, tenantSettingRepo, secretRepo, secretVersionRepo, checker, kvStore)
+	maintenanceRepo := data.NewMaintenanceRepo(context, entClient)
+	maintenanceService := service.NewMaintenanceService(context, maintenanceRepo, secretRepo, secretVersionRepo, permissionRepo, statisticsRepo, kvStore, collector, fieldCipher)
+	emergencyAccessRepo := data.NewEmergencyAccessRepo(context, entClient)
+	emergencyAccessService := service.NewEmergencyAccessService(context, emergencyAccessRepo, folderRepo, checker)
+	configExportService := service.NewConfigExportService(context, secretRepo, folderRepo, kvStore, checker, tenantSettingRepo, stepUpPolicy, canaryAlarm)
+	versionRetentionService := service.NewVersionRetentionService(context, tenantSettingRepo, maintenanceRepo, kvStore)
+	deletionRequestRepo := data.NewDeletionRequestRepo(context, entClient)
+	deletionRequestService := service.NewDeletionRequestService(context, deletionRequestRepo, secretRepo, folderRepo, checker, secretService, folderService)
+	collectionService := service.NewCollectionService(context, collectionRepo, secretRepo, permissionRepo, checker, transactor)
+	healthMonitor := job.NewHealthMonitor(context, entClient, vaultClient, redisClient)
+	grpcServer := server.NewGRPCServer(context, certManager, reloader, authenticator, collector, auditLogRepo, forwarder, tenantSettingRepo, folderService, secretService, permissionService, systemService, bitwardenTransferService, backupService, sqlBackupService, userService, auditService, webhookService, csvTransferService, tenantTransferService, exportPolicyService, maintenanceService, passwordPolicyService, emergencyAccessService, configExportService, geoPolicyService, versionRetentionService, deletionRequestService, collectionService, healthMonitor, payloadLimits)
 	httpServer := server.NewHTTPServer(context)
-	app := newApp(context, grpcServer, httpServer)
+	anomalyDetectionJob := job.NewAnomalyDetectionJob(context, auditLogRepo, securityAlertRepo)
+	outboxWorker := job.NewOutboxWorker(context, pendingOperationRepo)
+	folderCountReconciler := job.NewFolderCountReconciler(context, maintenanceRepo)
+	emergencyAccessGranter := job.NewEmergencyAccessGranter(context, emergencyAccessRepo, auditLogRepo)
+	usageAggregationJob := job.NewUsageAggregationJob(context, secretUsageRepo)
+	app := newApp(context, grpcServer, httpServer, auditRetentionJob, anomalyDetectionJob, forwarder, dispatcher, healthMonitor, reloader, outboxWorker, accessTracker, folderCountReconciler, emergencyAccessGranter, usageAggregationJob)
 	return app, func() {
+		cleanup7()
+		cleanup6()
+		cleanup5()
 		cleanup4()
 		cleanup3()
 		cleanup2()
@@ -0,0 +1,1714 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/audit.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Kind of audit chain verification failure
+type AuditChainIssueKind int32
+
+const (
+	AuditChainIssueKind_AUDIT_CHAIN_ISSUE_KIND_UNSPECIFIED AuditChainIssueKind = 0
+	AuditChainIssueKind_AUDIT_CHAIN_ISSUE_KIND_GAP         AuditChainIssueKind = 1 // Entries are missing from the chain
+	AuditChainIssueKind_AUDIT_CHAIN_ISSUE_KIND_BROKEN_LINK AuditChainIssueKind = 2 // prev_hash does not match the previous entry
+	AuditChainIssueKind_AUDIT_CHAIN_ISSUE_KIND_MODIFIED    AuditChainIssueKind = 3 // Row content does not match its hash
+)
+
+// Enum value maps for AuditChainIssueKind.
+var (
+	AuditChainIssueKind_name = map[int32]string{
+		0: "AUDIT_CHAIN_ISSUE_KIND_UNSPECIFIED",
+		1: "AUDIT_CHAIN_ISSUE_KIND_GAP",
+		2: "AUDIT_CHAIN_ISSUE_KIND_BROKEN_LINK",
+		3: "AUDIT_CHAIN_ISSUE_KIND_MODIFIED",
+	}
+	AuditChainIssueKind_value = map[string]int32{
+		"AUDIT_CHAIN_ISSUE_KIND_UNSPECIFIED": 0,
+		"AUDIT_CHAIN_ISSUE_KIND_GAP":         1,
+		"AUDIT_CHAIN_ISSUE_KIND_BROKEN_LINK": 2,
+		"AUDIT_CHAIN_ISSUE_KIND_MODIFIED":    3,
+	}
+)
+
+func (x AuditChainIssueKind) Enum() *AuditChainIssueKind {
+	p := new(AuditChainIssueKind)
+	*p = x
+	return p
+}
+
+func (x AuditChainIssueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditChainIssueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_audit_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditChainIssueKind) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_audit_proto_enumTypes[0]
+}
+
+func (x AuditChainIssueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditChainIssueKind.Descriptor instead.
+func (AuditChainIssueKind) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+// Kind of detected anomaly
+type AlertKind int32
+
+const (
+	AlertKind_ALERT_KIND_UNSPECIFIED      AlertKind = 0
+	AlertKind_ALERT_KIND_EXCESSIVE_READS  AlertKind = 1 // Abnormal number of password reads in the window
+	AlertKind_ALERT_KIND_NEW_PEER_ADDRESS AlertKind = 2 // Password read from an address not seen before
+	AlertKind_ALERT_KIND_NEW_GEO_LOCATION AlertKind = 3 // Password read from a country not seen before
+	AlertKind_ALERT_KIND_CANARY_READ      AlertKind = 4 // Password of a canary (decoy) secret read
+)
+
+// Enum value maps for AlertKind.
+var (
+	AlertKind_name = map[int32]string{
+		0: "ALERT_KIND_UNSPECIFIED",
+		1: "ALERT_KIND_EXCESSIVE_READS",
+		2: "ALERT_KIND_NEW_PEER_ADDRESS",
+		3: "ALERT_KIND_NEW_GEO_LOCATION",
+		4: "ALERT_KIND_CANARY_READ",
+	}
+	AlertKind_value = map[string]int32{
+		"ALERT_KIND_UNSPECIFIED":      0,
+		"ALERT_KIND_EXCESSIVE_READS":  1,
+		"ALERT_KIND_NEW_PEER_ADDRESS": 2,
+		"ALERT_KIND_NEW_GEO_LOCATION": 3,
+		"ALERT_KIND_CANARY_READ":      4,
+	}
+)
+
+func (x AlertKind) Enum() *AlertKind {
+	p := new(AlertKind)
+	*p = x
+	return p
+}
+
+func (x AlertKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_audit_proto_enumTypes[1].Descriptor()
+}
+
+func (AlertKind) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_audit_proto_enumTypes[1]
+}
+
+func (x AlertKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertKind.Descriptor instead.
+func (AlertKind) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+type AlertSeverity int32
+
+const (
+	AlertSeverity_ALERT_SEVERITY_UNSPECIFIED AlertSeverity = 0
+	AlertSeverity_ALERT_SEVERITY_LOW         AlertSeverity = 1
+	AlertSeverity_ALERT_SEVERITY_MEDIUM      AlertSeverity = 2
+	AlertSeverity_ALERT_SEVERITY_HIGH        AlertSeverity = 3
+)
+
+// Enum value maps for AlertSeverity.
+var (
+	AlertSeverity_name = map[int32]string{
+		0: "ALERT_SEVERITY_UNSPECIFIED",
+		1: "ALERT_SEVERITY_LOW",
+		2: "ALERT_SEVERITY_MEDIUM",
+		3: "ALERT_SEVERITY_HIGH",
+	}
+	AlertSeverity_value = map[string]int32{
+		"ALERT_SEVERITY_UNSPECIFIED": 0,
+		"ALERT_SEVERITY_LOW":         1,
+		"ALERT_SEVERITY_MEDIUM":      2,
+		"ALERT_SEVERITY_HIGH":        3,
+	}
+)
+
+func (x AlertSeverity) Enum() *AlertSeverity {
+	p := new(AlertSeverity)
+	*p = x
+	return p
+}
+
+func (x AlertSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_audit_proto_enumTypes[2].Descriptor()
+}
+
+func (AlertSeverity) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_audit_proto_enumTypes[2]
+}
+
+func (x AlertSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertSeverity.Descriptor instead.
+func (AlertSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+// Audit log entry
+type AuditLog struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId     uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	AuditId      string                 `protobuf:"bytes,3,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	RequestId    string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Operation    string                 `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	ClientId     string                 `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Success      bool                   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorCode    *int32                 `protobuf:"varint,8,opt,name=error_code,json=errorCode,proto3,oneof" json:"error_code,omitempty"`
+	ErrorMessage string                 `protobuf:"bytes,9,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	LatencyMs    int64                  `protobuf:"varint,10,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	PeerAddress  string                 `protobuf:"bytes,11,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
+	// Semantic domain event (e.g. "secret.password_read")
+	EventType     string                 `protobuf:"bytes,12,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,13,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,14,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,15,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditLog) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLog) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditLog) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AuditLog) GetErrorCode() int32 {
+	if x != nil && x.ErrorCode != nil {
+		return *x.ErrorCode
+	}
+	return 0
+}
+
+func (x *AuditLog) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *AuditLog) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *AuditLog) GetPeerAddress() string {
+	if x != nil {
+		return x.PeerAddress
+	}
+	return ""
+}
+
+func (x *AuditLog) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *AuditLog) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditLog) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AuditLog) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListAuditLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant ID (platform admins only; defaults to the caller's tenant)
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Pagination
+	Page     *uint32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Filter by semantic event (e.g. "secret.password_read")
+	EventType *string `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`
+	// Filter by resource ("secret" or "folder") and ID
+	ResourceType *string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`
+	ResourceId   *string `protobuf:"bytes,6,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	// Filter by RPC operation (substring match)
+	Operation *string                `protobuf:"bytes,7,opt,name=operation,proto3,oneof" json:"operation,omitempty"`
+	Success   *bool                  `protobuf:"varint,8,opt,name=success,proto3,oneof" json:"success,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	// Opaque next_cursor of the previous page; continues after it and ignores page
+	Cursor        *string `protobuf:"bytes,11,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsRequest) ProtoMessage() {}
+
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAuditLogsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetEventType() string {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetOperation() string {
+	if x != nil && x.Operation != nil {
+		return *x.Operation
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *ListAuditLogsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditLogsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListAuditLogsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type ListAuditLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Logs  []*AuditLog            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Total uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Cursor of the next page (empty on the last page)
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsResponse) ProtoMessage() {}
+
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAuditLogsResponse) GetLogs() []*AuditLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAuditLogsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Audit retention of a tenant
+type AuditRetention struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Retention override in days (0 = deployment default)
+	RetentionDays int32 `protobuf:"varint,2,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
+	// Deployment default retention in days (0 = keep forever)
+	DefaultRetentionDays int32 `protobuf:"varint,3,opt,name=default_retention_days,json=defaultRetentionDays,proto3" json:"default_retention_days,omitempty"`
+	// Retention actually applied to the tenant (0 = keep forever)
+	EffectiveRetentionDays int32                  `protobuf:"varint,4,opt,name=effective_retention_days,json=effectiveRetentionDays,proto3" json:"effective_retention_days,omitempty"`
+	UpdateTime             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AuditRetention) Reset() {
+	*x = AuditRetention{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRetention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRetention) ProtoMessage() {}
+
+func (x *AuditRetention) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRetention.ProtoReflect.Descriptor instead.
+func (*AuditRetention) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuditRetention) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *AuditRetention) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *AuditRetention) GetDefaultRetentionDays() int32 {
+	if x != nil {
+		return x.DefaultRetentionDays
+	}
+	return 0
+}
+
+func (x *AuditRetention) GetEffectiveRetentionDays() int32 {
+	if x != nil {
+		return x.EffectiveRetentionDays
+	}
+	return 0
+}
+
+func (x *AuditRetention) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type GetAuditRetentionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant ID (platform admins only; defaults to the caller's tenant)
+	TenantId      *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditRetentionRequest) Reset() {
+	*x = GetAuditRetentionRequest{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditRetentionRequest) ProtoMessage() {}
+
+func (x *GetAuditRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditRetentionRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAuditRetentionRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type SetAuditRetentionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant ID (defaults to the caller's tenant)
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Retention in days (0 = use the deployment default)
+	RetentionDays int32 `protobuf:"varint,2,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAuditRetentionRequest) Reset() {
+	*x = SetAuditRetentionRequest{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAuditRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAuditRetentionRequest) ProtoMessage() {}
+
+func (x *SetAuditRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAuditRetentionRequest.ProtoReflect.Descriptor instead.
+func (*SetAuditRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetAuditRetentionRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *SetAuditRetentionRequest) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+type PruneAuditLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Prune only this tenant (omit to prune every tenant)
+	TenantId      *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PruneAuditLogsRequest) Reset() {
+	*x = PruneAuditLogsRequest{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PruneAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneAuditLogsRequest) ProtoMessage() {}
+
+func (x *PruneAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*PruneAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PruneAuditLogsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+// Deleted entries per tenant (tenant_id 0 = all tenants on the default retention)
+type TenantPruneResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	RetentionDays int32                  `protobuf:"varint,2,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
+	Deleted       int64                  `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantPruneResult) Reset() {
+	*x = TenantPruneResult{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantPruneResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantPruneResult) ProtoMessage() {}
+
+func (x *TenantPruneResult) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantPruneResult.ProtoReflect.Descriptor instead.
+func (*TenantPruneResult) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TenantPruneResult) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *TenantPruneResult) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *TenantPruneResult) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type PruneAuditLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*TenantPruneResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	TotalDeleted  int64                  `protobuf:"varint,2,opt,name=total_deleted,json=totalDeleted,proto3" json:"total_deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PruneAuditLogsResponse) Reset() {
+	*x = PruneAuditLogsResponse{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PruneAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneAuditLogsResponse) ProtoMessage() {}
+
+func (x *PruneAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*PruneAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PruneAuditLogsResponse) GetResults() []*TenantPruneResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *PruneAuditLogsResponse) GetTotalDeleted() int64 {
+	if x != nil {
+		return x.TotalDeleted
+	}
+	return 0
+}
+
+type VerifyAuditChainRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant ID (platform admins only; defaults to the caller's tenant)
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Restrict verification to entries created in this window
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditChainRequest) Reset() {
+	*x = VerifyAuditChainRequest{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditChainRequest) ProtoMessage() {}
+
+func (x *VerifyAuditChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditChainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAuditChainRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VerifyAuditChainRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *VerifyAuditChainRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *VerifyAuditChainRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type AuditChainIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          AuditChainIssueKind    `protobuf:"varint,1,opt,name=kind,proto3,enum=warden.service.v1.AuditChainIssueKind" json:"kind,omitempty"`
+	AuditId       string                 `protobuf:"bytes,2,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	ChainSeq      int64                  `protobuf:"varint,3,opt,name=chain_seq,json=chainSeq,proto3" json:"chain_seq,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditChainIssue) Reset() {
+	*x = AuditChainIssue{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditChainIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditChainIssue) ProtoMessage() {}
+
+func (x *AuditChainIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditChainIssue.ProtoReflect.Descriptor instead.
+func (*AuditChainIssue) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AuditChainIssue) GetKind() AuditChainIssueKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AuditChainIssueKind_AUDIT_CHAIN_ISSUE_KIND_UNSPECIFIED
+}
+
+func (x *AuditChainIssue) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+func (x *AuditChainIssue) GetChainSeq() int64 {
+	if x != nil {
+		return x.ChainSeq
+	}
+	return 0
+}
+
+func (x *AuditChainIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type VerifyAuditChainResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True when no issues were found
+	Valid   bool  `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Checked int64 `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	// Entries written before chaining was enabled
+	Unchained     int64              `protobuf:"varint,3,opt,name=unchained,proto3" json:"unchained,omitempty"`
+	FirstSeq      int64              `protobuf:"varint,4,opt,name=first_seq,json=firstSeq,proto3" json:"first_seq,omitempty"`
+	LastSeq       int64              `protobuf:"varint,5,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`
+	Issues        []*AuditChainIssue `protobuf:"bytes,6,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditChainResponse) Reset() {
+	*x = VerifyAuditChainResponse{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditChainResponse) ProtoMessage() {}
+
+func (x *VerifyAuditChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditChainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditChainResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VerifyAuditChainResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyAuditChainResponse) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *VerifyAuditChainResponse) GetUnchained() int64 {
+	if x != nil {
+		return x.Unchained
+	}
+	return 0
+}
+
+func (x *VerifyAuditChainResponse) GetFirstSeq() int64 {
+	if x != nil {
+		return x.FirstSeq
+	}
+	return 0
+}
+
+func (x *VerifyAuditChainResponse) GetLastSeq() int64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+func (x *VerifyAuditChainResponse) GetIssues() []*AuditChainIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+// Security alert
+type SecurityAlert struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Kind     AlertKind              `protobuf:"varint,3,opt,name=kind,proto3,enum=warden.service.v1.AlertKind" json:"kind,omitempty"`
+	Severity AlertSeverity          `protobuf:"varint,4,opt,name=severity,proto3,enum=warden.service.v1.AlertSeverity" json:"severity,omitempty"`
+	// User whose activity triggered the alert
+	UserId string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Secret involved, if any
+	ResourceId     string                 `protobuf:"bytes,6,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Message        string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Details        map[string]string      `protobuf:"bytes,8,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Acknowledged   bool                   `protobuf:"varint,9,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	AcknowledgedBy *uint32                `protobuf:"varint,10,opt,name=acknowledged_by,json=acknowledgedBy,proto3,oneof" json:"acknowledged_by,omitempty"`
+	AcknowledgedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=acknowledged_at,json=acknowledgedAt,proto3,oneof" json:"acknowledged_at,omitempty"`
+	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SecurityAlert) Reset() {
+	*x = SecurityAlert{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityAlert) ProtoMessage() {}
+
+func (x *SecurityAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityAlert.ProtoReflect.Descriptor instead.
+func (*SecurityAlert) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SecurityAlert) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SecurityAlert) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *SecurityAlert) GetKind() AlertKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AlertKind_ALERT_KIND_UNSPECIFIED
+}
+
+func (x *SecurityAlert) GetSeverity() AlertSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return AlertSeverity_ALERT_SEVERITY_UNSPECIFIED
+}
+
+func (x *SecurityAlert) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *SecurityAlert) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+func (x *SecurityAlert) GetAcknowledgedBy() uint32 {
+	if x != nil && x.AcknowledgedBy != nil {
+		return *x.AcknowledgedBy
+	}
+	return 0
+}
+
+func (x *SecurityAlert) GetAcknowledgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return nil
+}
+
+func (x *SecurityAlert) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListSecurityAlertsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter by tenant (omit for all tenants)
+	TenantId     *uint32    `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Kind         *AlertKind `protobuf:"varint,2,opt,name=kind,proto3,enum=warden.service.v1.AlertKind,oneof" json:"kind,omitempty"`
+	UserId       *string    `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Acknowledged *bool      `protobuf:"varint,4,opt,name=acknowledged,proto3,oneof" json:"acknowledged,omitempty"`
+	// Pagination
+	Page          *uint32 `protobuf:"varint,5,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecurityAlertsRequest) Reset() {
+	*x = ListSecurityAlertsRequest{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecurityAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecurityAlertsRequest) ProtoMessage() {}
+
+func (x *ListSecurityAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecurityAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecurityAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListSecurityAlertsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *ListSecurityAlertsRequest) GetKind() AlertKind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return AlertKind_ALERT_KIND_UNSPECIFIED
+}
+
+func (x *ListSecurityAlertsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ListSecurityAlertsRequest) GetAcknowledged() bool {
+	if x != nil && x.Acknowledged != nil {
+		return *x.Acknowledged
+	}
+	return false
+}
+
+func (x *ListSecurityAlertsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListSecurityAlertsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListSecurityAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*SecurityAlert       `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecurityAlertsResponse) Reset() {
+	*x = ListSecurityAlertsResponse{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecurityAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecurityAlertsResponse) ProtoMessage() {}
+
+func (x *ListSecurityAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecurityAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecurityAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSecurityAlertsResponse) GetAlerts() []*SecurityAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *ListSecurityAlertsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AcknowledgeSecurityAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeSecurityAlertRequest) Reset() {
+	*x = AcknowledgeSecurityAlertRequest{}
+	mi := &file_warden_service_v1_audit_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeSecurityAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeSecurityAlertRequest) ProtoMessage() {}
+
+func (x *AcknowledgeSecurityAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_audit_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeSecurityAlertRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeSecurityAlertRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_audit_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AcknowledgeSecurityAlertRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_warden_service_v1_audit_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x1dwarden/service/v1/audit.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x86\x05\n" +
+	"\bAuditLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x19\n" +
+	"\baudit_id\x18\x03 \x01(\tR\aauditId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\x12\x1c\n" +
+	"\toperation\x18\x05 \x01(\tR\toperation\x12\x1b\n" +
+	"\tclient_id\x18\x06 \x01(\tR\bclientId\x12\x18\n" +
+	"\asuccess\x18\a \x01(\bR\asuccess\x12\"\n" +
+	"\n" +
+	"error_code\x18\b \x01(\x05H\x00R\terrorCode\x88\x01\x01\x12#\n" +
+	"\rerror_message\x18\t \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\n" +
+	" \x01(\x03R\tlatencyMs\x12!\n" +
+	"\fpeer_address\x18\v \x01(\tR\vpeerAddress\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\f \x01(\tR\teventType\x12#\n" +
+	"\rresource_type\x18\r \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x0e \x01(\tR\n" +
+	"resourceId\x12E\n" +
+	"\bmetadata\x18\x0f \x03(\v2).warden.service.v1.AuditLog.MetadataEntryR\bmetadata\x12;\n" +
+	"\vcreate_time\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
+	"\v_error_code\"\xed\x04\n" +
+	"\x14ListAuditLogsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\rH\x01R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xf4\x03H\x02R\bpageSize\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tH\x03R\teventType\x88\x01\x01\x12(\n" +
+	"\rresource_type\x18\x05 \x01(\tH\x04R\fresourceType\x88\x01\x01\x12$\n" +
+	"\vresource_id\x18\x06 \x01(\tH\x05R\n" +
+	"resourceId\x88\x01\x01\x12!\n" +
+	"\toperation\x18\a \x01(\tH\x06R\toperation\x88\x01\x01\x12\x1d\n" +
+	"\asuccess\x18\b \x01(\bH\aR\asuccess\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\bR\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\tR\aendTime\x88\x01\x01\x12%\n" +
+	"\x06cursor\x18\v \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04H\n" +
+	"R\x06cursor\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\r\n" +
+	"\v_event_typeB\x10\n" +
+	"\x0e_resource_typeB\x0e\n" +
+	"\f_resource_idB\f\n" +
+	"\n" +
+	"_operationB\n" +
+	"\n" +
+	"\b_successB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\t\n" +
+	"\a_cursor\"\x7f\n" +
+	"\x15ListAuditLogsResponse\x12/\n" +
+	"\x04logs\x18\x01 \x03(\v2\x1b.warden.service.v1.AuditLogR\x04logs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\x96\x02\n" +
+	"\x0eAuditRetention\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12%\n" +
+	"\x0eretention_days\x18\x02 \x01(\x05R\rretentionDays\x124\n" +
+	"\x16default_retention_days\x18\x03 \x01(\x05R\x14defaultRetentionDays\x128\n" +
+	"\x18effective_retention_days\x18\x04 \x01(\x05R\x16effectiveRetentionDays\x12@\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"updateTime\x88\x01\x01B\x0e\n" +
+	"\f_update_time\"J\n" +
+	"\x18GetAuditRetentionRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"}\n" +
+	"\x18SetAuditRetentionRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x121\n" +
+	"\x0eretention_days\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc2\x1c(\x00R\rretentionDaysB\f\n" +
+	"\n" +
+	"_tenant_id\"G\n" +
+	"\x15PruneAuditLogsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"q\n" +
+	"\x11TenantPruneResult\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12%\n" +
+	"\x0eretention_days\x18\x02 \x01(\x05R\rretentionDays\x12\x18\n" +
+	"\adeleted\x18\x03 \x01(\x03R\adeleted\"}\n" +
+	"\x16PruneAuditLogsResponse\x12>\n" +
+	"\aresults\x18\x01 \x03(\v2$.warden.service.v1.TenantPruneResultR\aresults\x12#\n" +
+	"\rtotal_deleted\x18\x02 \x01(\x03R\ftotalDeleted\"\xe1\x01\n" +
+	"\x17VerifyAuditChainRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\aendTime\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time\"\x9f\x01\n" +
+	"\x0fAuditChainIssue\x12:\n" +
+	"\x04kind\x18\x01 \x01(\x0e2&.warden.service.v1.AuditChainIssueKindR\x04kind\x12\x19\n" +
+	"\baudit_id\x18\x02 \x01(\tR\aauditId\x12\x1b\n" +
+	"\tchain_seq\x18\x03 \x01(\x03R\bchainSeq\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xdc\x01\n" +
+	"\x18VerifyAuditChainResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\achecked\x18\x02 \x01(\x03R\achecked\x12\x1c\n" +
+	"\tunchained\x18\x03 \x01(\x03R\tunchained\x12\x1b\n" +
+	"\tfirst_seq\x18\x04 \x01(\x03R\bfirstSeq\x12\x19\n" +
+	"\blast_seq\x18\x05 \x01(\x03R\alastSeq\x12:\n" +
+	"\x06issues\x18\x06 \x03(\v2\".warden.service.v1.AuditChainIssueR\x06issues\"\x86\x05\n" +
+	"\rSecurityAlert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x120\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1c.warden.service.v1.AlertKindR\x04kind\x12<\n" +
+	"\bseverity\x18\x04 \x01(\x0e2 .warden.service.v1.AlertSeverityR\bseverity\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vresource_id\x18\x06 \x01(\tR\n" +
+	"resourceId\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12G\n" +
+	"\adetails\x18\b \x03(\v2-.warden.service.v1.SecurityAlert.DetailsEntryR\adetails\x12\"\n" +
+	"\facknowledged\x18\t \x01(\bR\facknowledged\x12,\n" +
+	"\x0facknowledged_by\x18\n" +
+	" \x01(\rH\x00R\x0eacknowledgedBy\x88\x01\x01\x12H\n" +
+	"\x0facknowledged_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x0eacknowledgedAt\x88\x01\x01\x12;\n" +
+	"\vcreate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x12\n" +
+	"\x10_acknowledged_byB\x12\n" +
+	"\x10_acknowledged_at\"\xcb\x02\n" +
+	"\x19ListSecurityAlertsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x125\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1c.warden.service.v1.AlertKindH\x01R\x04kind\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\tH\x02R\x06userId\x88\x01\x01\x12'\n" +
+	"\facknowledged\x18\x04 \x01(\bH\x03R\facknowledged\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x05 \x01(\rH\x04R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x06 \x01(\rB\b\xbaH\x05*\x03\x18\xf4\x03H\x05R\bpageSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\a\n" +
+	"\x05_kindB\n" +
+	"\n" +
+	"\b_user_idB\x0f\n" +
+	"\r_acknowledgedB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"l\n" +
+	"\x1aListSecurityAlertsResponse\x128\n" +
+	"\x06alerts\x18\x01 \x03(\v2 .warden.service.v1.SecurityAlertR\x06alerts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\":\n" +
+	"\x1fAcknowledgeSecurityAlertRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x02id*\xaa\x01\n" +
+	"\x13AuditChainIssueKind\x12&\n" +
+	"\"AUDIT_CHAIN_ISSUE_KIND_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aAUDIT_CHAIN_ISSUE_KIND_GAP\x10\x01\x12&\n" +
+	"\"AUDIT_CHAIN_ISSUE_KIND_BROKEN_LINK\x10\x02\x12#\n" +
+	"\x1fAUDIT_CHAIN_ISSUE_KIND_MODIFIED\x10\x03*\xa5\x01\n" +
+	"\tAlertKind\x12\x1a\n" +
+	"\x16ALERT_KIND_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aALERT_KIND_EXCESSIVE_READS\x10\x01\x12\x1f\n" +
+	"\x1bALERT_KIND_NEW_PEER_ADDRESS\x10\x02\x12\x1f\n" +
+	"\x1bALERT_KIND_NEW_GEO_LOCATION\x10\x03\x12\x1a\n" +
+	"\x16ALERT_KIND_CANARY_READ\x10\x04*{\n" +
+	"\rAlertSeverity\x12\x1e\n" +
+	"\x1aALERT_SEVERITY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ALERT_SEVERITY_LOW\x10\x01\x12\x19\n" +
+	"\x15ALERT_SEVERITY_MEDIUM\x10\x02\x12\x17\n" +
+	"\x13ALERT_SEVERITY_HIGH\x10\x032\xd4\a\n" +
+	"\x12WardenAuditService\x12z\n" +
+	"\rListAuditLogs\x12'.warden.service.v1.ListAuditLogsRequest\x1a(.warden.service.v1.ListAuditLogsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/audit/logs\x12\x80\x01\n" +
+	"\x11GetAuditRetention\x12+.warden.service.v1.GetAuditRetentionRequest\x1a!.warden.service.v1.AuditRetention\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/audit/retention\x12\x83\x01\n" +
+	"\x11SetAuditRetention\x12+.warden.service.v1.SetAuditRetentionRequest\x1a!.warden.service.v1.AuditRetention\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/audit/retention\x12\x81\x01\n" +
+	"\x0ePruneAuditLogs\x12(.warden.service.v1.PruneAuditLogsRequest\x1a).warden.service.v1.PruneAuditLogsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/audit/prune\x12\x85\x01\n" +
+	"\x10VerifyAuditChain\x12*.warden.service.v1.VerifyAuditChainRequest\x1a+.warden.service.v1.VerifyAuditChainResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/audit/verify\x12\x8b\x01\n" +
+	"\x12ListSecurityAlerts\x12,.warden.service.v1.ListSecurityAlertsRequest\x1a-.warden.service.v1.ListSecurityAlertsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/audit/alerts\x12\x9e\x01\n" +
+	"\x18AcknowledgeSecurityAlert\x122.warden.service.v1.AcknowledgeSecurityAlertRequest\x1a .warden.service.v1.SecurityAlert\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/audit/alerts/{id}/acknowledgeB\xd2\x01\n" +
+	"\x15com.warden.service.v1B\n" +
+	"AuditProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_audit_proto_rawDescOnce sync.Once
+	file_warden_service_v1_audit_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_audit_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_audit_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_audit_proto_rawDesc), len(file_warden_service_v1_audit_proto_rawDesc)))
+	})
+	return file_warden_service_v1_audit_proto_rawDescData
+}
+
+var file_warden_service_v1_audit_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_warden_service_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_warden_service_v1_audit_proto_goTypes = []any{
+	(AuditChainIssueKind)(0),                // 0: warden.service.v1.AuditChainIssueKind
+	(AlertKind)(0),                          // 1: warden.service.v1.AlertKind
+	(AlertSeverity)(0),                      // 2: warden.service.v1.AlertSeverity
+	(*AuditLog)(nil),                        // 3: warden.service.v1.AuditLog
+	(*ListAuditLogsRequest)(nil),            // 4: warden.service.v1.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil),           // 5: warden.service.v1.ListAuditLogsResponse
+	(*AuditRetention)(nil),                  // 6: warden.service.v1.AuditRetention
+	(*GetAuditRetentionRequest)(nil),        // 7: warden.service.v1.GetAuditRetentionRequest
+	(*SetAuditRetentionRequest)(nil),        // 8: warden.service.v1.SetAuditRetentionRequest
+	(*PruneAuditLogsRequest)(nil),           // 9: warden.service.v1.PruneAuditLogsRequest
+	(*TenantPruneResult)(nil),               // 10: warden.service.v1.TenantPruneResult
+	(*PruneAuditLogsResponse)(nil),          // 11: warden.service.v1.PruneAuditLogsResponse
+	(*VerifyAuditChainRequest)(nil),         // 12: warden.service.v1.VerifyAuditChainRequest
+	(*AuditChainIssue)(nil),                 // 13: warden.service.v1.AuditChainIssue
+	(*VerifyAuditChainResponse)(nil),        // 14: warden.service.v1.VerifyAuditChainResponse
+	(*SecurityAlert)(nil),                   // 15: warden.service.v1.SecurityAlert
+	(*ListSecurityAlertsRequest)(nil),       // 16: warden.service.v1.ListSecurityAlertsRequest
+	(*ListSecurityAlertsResponse)(nil),      // 17: warden.service.v1.ListSecurityAlertsResponse
+	(*AcknowledgeSecurityAlertRequest)(nil), // 18: warden.service.v1.AcknowledgeSecurityAlertRequest
+	nil,                                     // 19: warden.service.v1.AuditLog.MetadataEntry
+	nil,                                     // 20: warden.service.v1.SecurityAlert.DetailsEntry
+	(*timestamppb.Timestamp)(nil),           // 21: google.protobuf.Timestamp
+}
+var file_warden_service_v1_audit_proto_depIdxs = []int32{
+	19, // 0: warden.service.v1.AuditLog.metadata:type_name -> warden.service.v1.AuditLog.MetadataEntry
+	21, // 1: warden.service.v1.AuditLog.create_time:type_name -> google.protobuf.Timestamp
+	21, // 2: warden.service.v1.ListAuditLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	21, // 3: warden.service.v1.ListAuditLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	3,  // 4: warden.service.v1.ListAuditLogsResponse.logs:type_name -> warden.service.v1.AuditLog
+	21, // 5: warden.service.v1.AuditRetention.update_time:type_name -> google.protobuf.Timestamp
+	10, // 6: warden.service.v1.PruneAuditLogsResponse.results:type_name -> warden.service.v1.TenantPruneResult
+	21, // 7: warden.service.v1.VerifyAuditChainRequest.start_time:type_name -> google.protobuf.Timestamp
+	21, // 8: warden.service.v1.VerifyAuditChainRequest.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 9: warden.service.v1.AuditChainIssue.kind:type_name -> warden.service.v1.AuditChainIssueKind
+	13, // 10: warden.service.v1.VerifyAuditChainResponse.issues:type_name -> warden.service.v1.AuditChainIssue
+	1,  // 11: warden.service.v1.SecurityAlert.kind:type_name -> warden.service.v1.AlertKind
+	2,  // 12: warden.service.v1.SecurityAlert.severity:type_name -> warden.service.v1.AlertSeverity
+	20, // 13: warden.service.v1.SecurityAlert.details:type_name -> warden.service.v1.SecurityAlert.DetailsEntry
+	21, // 14: warden.service.v1.SecurityAlert.acknowledged_at:type_name -> google.protobuf.Timestamp
+	21, // 15: warden.service.v1.SecurityAlert.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 16: warden.service.v1.ListSecurityAlertsRequest.kind:type_name -> warden.service.v1.AlertKind
+	15, // 17: warden.service.v1.ListSecurityAlertsResponse.alerts:type_name -> warden.service.v1.SecurityAlert
+	4,  // 18: warden.service.v1.WardenAuditService.ListAuditLogs:input_type -> warden.service.v1.ListAuditLogsRequest
+	7,  // 19: warden.service.v1.WardenAuditService.GetAuditRetention:input_type -> warden.service.v1.GetAuditRetentionRequest
+	8,  // 20: warden.service.v1.WardenAuditService.SetAuditRetention:input_type -> warden.service.v1.SetAuditRetentionRequest
+	9,  // 21: warden.service.v1.WardenAuditService.PruneAuditLogs:input_type -> warden.service.v1.PruneAuditLogsRequest
+	12, // 22: warden.service.v1.WardenAuditService.VerifyAuditChain:input_type -> warden.service.v1.VerifyAuditChainRequest
+	16, // 23: warden.service.v1.WardenAuditService.ListSecurityAlerts:input_type -> warden.service.v1.ListSecurityAlertsRequest
+	18, // 24: warden.service.v1.WardenAuditService.AcknowledgeSecurityAlert:input_type -> warden.service.v1.AcknowledgeSecurityAlertRequest
+	5,  // 25: warden.service.v1.WardenAuditService.ListAuditLogs:output_type -> warden.service.v1.ListAuditLogsResponse
+	6,  // 26: warden.service.v1.WardenAuditService.GetAuditRetention:output_type -> warden.service.v1.AuditRetention
+	6,  // 27: warden.service.v1.WardenAuditService.SetAuditRetention:output_type -> warden.service.v1.AuditRetention
+	11, // 28: warden.service.v1.WardenAuditService.PruneAuditLogs:output_type -> warden.service.v1.PruneAuditLogsResponse
+	14, // 29: warden.service.v1.WardenAuditService.VerifyAuditChain:output_type -> warden.service.v1.VerifyAuditChainResponse
+	17, // 30: warden.service.v1.WardenAuditService.ListSecurityAlerts:output_type -> warden.service.v1.ListSecurityAlertsResponse
+	15, // 31: warden.service.v1.WardenAuditService.AcknowledgeSecurityAlert:output_type -> warden.service.v1.SecurityAlert
+	25, // [25:32] is the sub-list for method output_type
+	18, // [18:25] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_audit_proto_init() }
+func file_warden_service_v1_audit_proto_init() {
+	if File_warden_service_v1_audit_proto != nil {
+		return
+	}
+	file_warden_service_v1_audit_proto_msgTypes[0].OneofWrappers = []any{}
+	file_warden_service_v1_audit_proto_msgTypes[1].OneofWrappers = []any{}
+	file_warden_service_v1_audit_proto_msgTypes[3].OneofWrappers = []any{}
+	file_warden_service_v1_audit_proto_msgTypes[4].OneofWrappers = []any{}
+	file_warden_service_v1_audit_proto_msgTypes[5].OneofWrappers = []any{}
+	file_warden_service_v1_audit_proto_msgTypes[6].OneofWrappers = []any{}
+	file_warden_service_v1_audit_proto_msgTypes[9].OneofWrappers = []any{}
+	file_warden_service_v1_audit_proto_msgTypes[12].OneofWrappers = []any{}
+	file_warden_service_v1_audit_proto_msgTypes[13].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_audit_proto_rawDesc), len(file_warden_service_v1_audit_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_audit_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_audit_proto_depIdxs,
+		EnumInfos:         file_warden_service_v1_audit_proto_enumTypes,
+		MessageInfos:      file_warden_service_v1_audit_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_audit_proto = out.File
+	file_warden_service_v1_audit_proto_goTypes = nil
+	file_warden_service_v1_audit_proto_depIdxs = nil
+}
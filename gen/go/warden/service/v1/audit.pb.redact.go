@@ -0,0 +1,404 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/audit.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ timestamppb.Timestamp
+)
+
+// RegisterRedactedWardenAuditServiceServer wraps the WardenAuditServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenAuditServiceServer(s grpc.ServiceRegistrar, srv WardenAuditServiceServer, bypass redact.Bypass) {
+	RegisterWardenAuditServiceServer(s, RedactedWardenAuditServiceServer(srv, bypass))
+}
+
+func RedactedWardenAuditServiceServer(srv WardenAuditServiceServer, bypass redact.Bypass) WardenAuditServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenAuditServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenAuditServiceServer struct {
+	UnsafeWardenAuditServiceServer
+	srv    WardenAuditServiceServer
+	bypass redact.Bypass
+}
+
+// ListAuditLogs is the redacted wrapper for the actual WardenAuditServiceServer.ListAuditLogs method
+// Unary RPC
+func (s *redactedWardenAuditServiceServer) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	res, err := s.srv.ListAuditLogs(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetAuditRetention is the redacted wrapper for the actual WardenAuditServiceServer.GetAuditRetention method
+// Unary RPC
+func (s *redactedWardenAuditServiceServer) GetAuditRetention(ctx context.Context, in *GetAuditRetentionRequest) (*AuditRetention, error) {
+	res, err := s.srv.GetAuditRetention(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SetAuditRetention is the redacted wrapper for the actual WardenAuditServiceServer.SetAuditRetention method
+// Unary RPC
+func (s *redactedWardenAuditServiceServer) SetAuditRetention(ctx context.Context, in *SetAuditRetentionRequest) (*AuditRetention, error) {
+	res, err := s.srv.SetAuditRetention(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PruneAuditLogs is the redacted wrapper for the actual WardenAuditServiceServer.PruneAuditLogs method
+// Unary RPC
+func (s *redactedWardenAuditServiceServer) PruneAuditLogs(ctx context.Context, in *PruneAuditLogsRequest) (*PruneAuditLogsResponse, error) {
+	res, err := s.srv.PruneAuditLogs(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// VerifyAuditChain is the redacted wrapper for the actual WardenAuditServiceServer.VerifyAuditChain method
+// Unary RPC
+func (s *redactedWardenAuditServiceServer) VerifyAuditChain(ctx context.Context, in *VerifyAuditChainRequest) (*VerifyAuditChainResponse, error) {
+	res, err := s.srv.VerifyAuditChain(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListSecurityAlerts is the redacted wrapper for the actual WardenAuditServiceServer.ListSecurityAlerts method
+// Unary RPC
+func (s *redactedWardenAuditServiceServer) ListSecurityAlerts(ctx context.Context, in *ListSecurityAlertsRequest) (*ListSecurityAlertsResponse, error) {
+	res, err := s.srv.ListSecurityAlerts(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// AcknowledgeSecurityAlert is the redacted wrapper for the actual WardenAuditServiceServer.AcknowledgeSecurityAlert method
+// Unary RPC
+func (s *redactedWardenAuditServiceServer) AcknowledgeSecurityAlert(ctx context.Context, in *AcknowledgeSecurityAlertRequest) (*SecurityAlert, error) {
+	res, err := s.srv.AcknowledgeSecurityAlert(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for AuditLog
+func (x *AuditLog) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TenantId
+
+	// Safe field: AuditId
+
+	// Safe field: RequestId
+
+	// Safe field: Operation
+
+	// Safe field: ClientId
+
+	// Safe field: Success
+
+	// Safe field: ErrorCode
+
+	// Safe field: ErrorMessage
+
+	// Safe field: LatencyMs
+
+	// Safe field: PeerAddress
+
+	// Safe field: EventType
+
+	// Safe field: ResourceType
+
+	// Safe field: ResourceId
+
+	// Safe field: Metadata
+
+	// Safe field: CreateTime
+	return x.String()
+}
+
+// Redact method implementation for ListAuditLogsRequest
+func (x *ListAuditLogsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+
+	// Safe field: EventType
+
+	// Safe field: ResourceType
+
+	// Safe field: ResourceId
+
+	// Safe field: Operation
+
+	// Safe field: Success
+
+	// Safe field: StartTime
+
+	// Safe field: EndTime
+
+	// Safe field: Cursor
+	return x.String()
+}
+
+// Redact method implementation for ListAuditLogsResponse
+func (x *ListAuditLogsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Logs
+
+	// Safe field: Total
+
+	// Safe field: NextCursor
+	return x.String()
+}
+
+// Redact method implementation for AuditRetention
+func (x *AuditRetention) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: RetentionDays
+
+	// Safe field: DefaultRetentionDays
+
+	// Safe field: EffectiveRetentionDays
+
+	// Safe field: UpdateTime
+	return x.String()
+}
+
+// Redact method implementation for GetAuditRetentionRequest
+func (x *GetAuditRetentionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+	return x.String()
+}
+
+// Redact method implementation for SetAuditRetentionRequest
+func (x *SetAuditRetentionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: RetentionDays
+	return x.String()
+}
+
+// Redact method implementation for PruneAuditLogsRequest
+func (x *PruneAuditLogsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+	return x.String()
+}
+
+// Redact method implementation for TenantPruneResult
+func (x *TenantPruneResult) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: RetentionDays
+
+	// Safe field: Deleted
+	return x.String()
+}
+
+// Redact method implementation for PruneAuditLogsResponse
+func (x *PruneAuditLogsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Results
+
+	// Safe field: TotalDeleted
+	return x.String()
+}
+
+// Redact method implementation for VerifyAuditChainRequest
+func (x *VerifyAuditChainRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: StartTime
+
+	// Safe field: EndTime
+	return x.String()
+}
+
+// Redact method implementation for AuditChainIssue
+func (x *AuditChainIssue) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Kind
+
+	// Safe field: AuditId
+
+	// Safe field: ChainSeq
+
+	// Safe field: Message
+	return x.String()
+}
+
+// Redact method implementation for VerifyAuditChainResponse
+func (x *VerifyAuditChainResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Valid
+
+	// Safe field: Checked
+
+	// Safe field: Unchained
+
+	// Safe field: FirstSeq
+
+	// Safe field: LastSeq
+
+	// Safe field: Issues
+	return x.String()
+}
+
+// Redact method implementation for SecurityAlert
+func (x *SecurityAlert) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TenantId
+
+	// Safe field: Kind
+
+	// Safe field: Severity
+
+	// Safe field: UserId
+
+	// Safe field: ResourceId
+
+	// Safe field: Message
+
+	// Safe field: Details
+
+	// Safe field: Acknowledged
+
+	// Safe field: AcknowledgedBy
+
+	// Safe field: AcknowledgedAt
+
+	// Safe field: CreateTime
+	return x.String()
+}
+
+// Redact method implementation for ListSecurityAlertsRequest
+func (x *ListSecurityAlertsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: Kind
+
+	// Safe field: UserId
+
+	// Safe field: Acknowledged
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListSecurityAlertsResponse
+func (x *ListSecurityAlertsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Alerts
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for AcknowledgeSecurityAlertRequest
+func (x *AcknowledgeSecurityAlertRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
@@ -0,0 +1,2226 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/audit.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AuditLog with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditLog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditLog with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditLogMultiError, or nil
+// if none found.
+func (m *AuditLog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditLog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for AuditId
+
+	// no validation rules for RequestId
+
+	// no validation rules for Operation
+
+	// no validation rules for ClientId
+
+	// no validation rules for Success
+
+	// no validation rules for ErrorMessage
+
+	// no validation rules for LatencyMs
+
+	// no validation rules for PeerAddress
+
+	// no validation rules for EventType
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceId
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditLogValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditLogValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditLogValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ErrorCode != nil {
+		// no validation rules for ErrorCode
+	}
+
+	if len(errors) > 0 {
+		return AuditLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditLogMultiError is an error wrapping multiple validation errors returned
+// by AuditLog.ValidateAll() if the designated constraints aren't met.
+type AuditLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditLogMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditLogMultiError) AllErrors() []error { return m }
+
+// AuditLogValidationError is the validation error returned by
+// AuditLog.Validate if the designated constraints aren't met.
+type AuditLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditLogValidationError) ErrorName() string { return "AuditLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditLogValidationError{}
+
+// Validate checks the field values on ListAuditLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditLogsRequestMultiError, or nil if none found.
+func (m *ListAuditLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.EventType != nil {
+		// no validation rules for EventType
+	}
+
+	if m.ResourceType != nil {
+		// no validation rules for ResourceType
+	}
+
+	if m.ResourceId != nil {
+		// no validation rules for ResourceId
+	}
+
+	if m.Operation != nil {
+		// no validation rules for Operation
+	}
+
+	if m.Success != nil {
+		// no validation rules for Success
+	}
+
+	if m.StartTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditLogsRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditLogsRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditLogsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.EndTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditLogsRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditLogsRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditLogsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if len(errors) > 0 {
+		return ListAuditLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditLogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditLogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditLogsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditLogsRequestValidationError is the validation error returned by
+// ListAuditLogsRequest.Validate if the designated constraints aren't met.
+type ListAuditLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditLogsRequestValidationError) ErrorName() string {
+	return "ListAuditLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditLogsRequestValidationError{}
+
+// Validate checks the field values on ListAuditLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditLogsResponseMultiError, or nil if none found.
+func (m *ListAuditLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLogs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditLogsResponseValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditLogsResponseValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditLogsResponseValidationError{
+					field:  fmt.Sprintf("Logs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListAuditLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditLogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditLogsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditLogsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditLogsResponseValidationError is the validation error returned by
+// ListAuditLogsResponse.Validate if the designated constraints aren't met.
+type ListAuditLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditLogsResponseValidationError) ErrorName() string {
+	return "ListAuditLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditLogsResponseValidationError{}
+
+// Validate checks the field values on AuditRetention with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditRetention) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditRetention with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditRetentionMultiError,
+// or nil if none found.
+func (m *AuditRetention) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditRetention) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for RetentionDays
+
+	// no validation rules for DefaultRetentionDays
+
+	// no validation rules for EffectiveRetentionDays
+
+	if m.UpdateTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdateTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditRetentionValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditRetentionValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditRetentionValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuditRetentionMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditRetentionMultiError is an error wrapping multiple validation errors
+// returned by AuditRetention.ValidateAll() if the designated constraints
+// aren't met.
+type AuditRetentionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditRetentionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditRetentionMultiError) AllErrors() []error { return m }
+
+// AuditRetentionValidationError is the validation error returned by
+// AuditRetention.Validate if the designated constraints aren't met.
+type AuditRetentionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditRetentionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditRetentionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditRetentionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditRetentionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditRetentionValidationError) ErrorName() string { return "AuditRetentionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditRetentionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditRetention.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditRetentionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditRetentionValidationError{}
+
+// Validate checks the field values on GetAuditRetentionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuditRetentionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuditRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuditRetentionRequestMultiError, or nil if none found.
+func (m *GetAuditRetentionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuditRetentionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return GetAuditRetentionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuditRetentionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAuditRetentionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuditRetentionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuditRetentionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuditRetentionRequestMultiError) AllErrors() []error { return m }
+
+// GetAuditRetentionRequestValidationError is the validation error returned by
+// GetAuditRetentionRequest.Validate if the designated constraints aren't met.
+type GetAuditRetentionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuditRetentionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuditRetentionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuditRetentionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuditRetentionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuditRetentionRequestValidationError) ErrorName() string {
+	return "GetAuditRetentionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuditRetentionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuditRetentionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuditRetentionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuditRetentionRequestValidationError{}
+
+// Validate checks the field values on SetAuditRetentionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAuditRetentionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetAuditRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetAuditRetentionRequestMultiError, or nil if none found.
+func (m *SetAuditRetentionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetAuditRetentionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RetentionDays
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return SetAuditRetentionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetAuditRetentionRequestMultiError is an error wrapping multiple validation
+// errors returned by SetAuditRetentionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetAuditRetentionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetAuditRetentionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetAuditRetentionRequestMultiError) AllErrors() []error { return m }
+
+// SetAuditRetentionRequestValidationError is the validation error returned by
+// SetAuditRetentionRequest.Validate if the designated constraints aren't met.
+type SetAuditRetentionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetAuditRetentionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetAuditRetentionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetAuditRetentionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetAuditRetentionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetAuditRetentionRequestValidationError) ErrorName() string {
+	return "SetAuditRetentionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetAuditRetentionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetAuditRetentionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetAuditRetentionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetAuditRetentionRequestValidationError{}
+
+// Validate checks the field values on PruneAuditLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PruneAuditLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PruneAuditLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PruneAuditLogsRequestMultiError, or nil if none found.
+func (m *PruneAuditLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PruneAuditLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return PruneAuditLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PruneAuditLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by PruneAuditLogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PruneAuditLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PruneAuditLogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PruneAuditLogsRequestMultiError) AllErrors() []error { return m }
+
+// PruneAuditLogsRequestValidationError is the validation error returned by
+// PruneAuditLogsRequest.Validate if the designated constraints aren't met.
+type PruneAuditLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PruneAuditLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PruneAuditLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PruneAuditLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PruneAuditLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PruneAuditLogsRequestValidationError) ErrorName() string {
+	return "PruneAuditLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PruneAuditLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPruneAuditLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PruneAuditLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PruneAuditLogsRequestValidationError{}
+
+// Validate checks the field values on TenantPruneResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantPruneResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantPruneResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantPruneResultMultiError, or nil if none found.
+func (m *TenantPruneResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantPruneResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for RetentionDays
+
+	// no validation rules for Deleted
+
+	if len(errors) > 0 {
+		return TenantPruneResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantPruneResultMultiError is an error wrapping multiple validation errors
+// returned by TenantPruneResult.ValidateAll() if the designated constraints
+// aren't met.
+type TenantPruneResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantPruneResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantPruneResultMultiError) AllErrors() []error { return m }
+
+// TenantPruneResultValidationError is the validation error returned by
+// TenantPruneResult.Validate if the designated constraints aren't met.
+type TenantPruneResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantPruneResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantPruneResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantPruneResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantPruneResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantPruneResultValidationError) ErrorName() string {
+	return "TenantPruneResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantPruneResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantPruneResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantPruneResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantPruneResultValidationError{}
+
+// Validate checks the field values on PruneAuditLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PruneAuditLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PruneAuditLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PruneAuditLogsResponseMultiError, or nil if none found.
+func (m *PruneAuditLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PruneAuditLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PruneAuditLogsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PruneAuditLogsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PruneAuditLogsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalDeleted
+
+	if len(errors) > 0 {
+		return PruneAuditLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PruneAuditLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by PruneAuditLogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PruneAuditLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PruneAuditLogsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PruneAuditLogsResponseMultiError) AllErrors() []error { return m }
+
+// PruneAuditLogsResponseValidationError is the validation error returned by
+// PruneAuditLogsResponse.Validate if the designated constraints aren't met.
+type PruneAuditLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PruneAuditLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PruneAuditLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PruneAuditLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PruneAuditLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PruneAuditLogsResponseValidationError) ErrorName() string {
+	return "PruneAuditLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PruneAuditLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPruneAuditLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PruneAuditLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PruneAuditLogsResponseValidationError{}
+
+// Validate checks the field values on VerifyAuditChainRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyAuditChainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyAuditChainRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyAuditChainRequestMultiError, or nil if none found.
+func (m *VerifyAuditChainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyAuditChainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.StartTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VerifyAuditChainRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VerifyAuditChainRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VerifyAuditChainRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.EndTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VerifyAuditChainRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VerifyAuditChainRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VerifyAuditChainRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VerifyAuditChainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyAuditChainRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyAuditChainRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyAuditChainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyAuditChainRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyAuditChainRequestMultiError) AllErrors() []error { return m }
+
+// VerifyAuditChainRequestValidationError is the validation error returned by
+// VerifyAuditChainRequest.Validate if the designated constraints aren't met.
+type VerifyAuditChainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyAuditChainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyAuditChainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyAuditChainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyAuditChainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyAuditChainRequestValidationError) ErrorName() string {
+	return "VerifyAuditChainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyAuditChainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyAuditChainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyAuditChainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyAuditChainRequestValidationError{}
+
+// Validate checks the field values on AuditChainIssue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuditChainIssue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditChainIssue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditChainIssueMultiError, or nil if none found.
+func (m *AuditChainIssue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditChainIssue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for AuditId
+
+	// no validation rules for ChainSeq
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return AuditChainIssueMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditChainIssueMultiError is an error wrapping multiple validation errors
+// returned by AuditChainIssue.ValidateAll() if the designated constraints
+// aren't met.
+type AuditChainIssueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditChainIssueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditChainIssueMultiError) AllErrors() []error { return m }
+
+// AuditChainIssueValidationError is the validation error returned by
+// AuditChainIssue.Validate if the designated constraints aren't met.
+type AuditChainIssueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditChainIssueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditChainIssueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditChainIssueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditChainIssueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditChainIssueValidationError) ErrorName() string { return "AuditChainIssueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditChainIssueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditChainIssue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditChainIssueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditChainIssueValidationError{}
+
+// Validate checks the field values on VerifyAuditChainResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyAuditChainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyAuditChainResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyAuditChainResponseMultiError, or nil if none found.
+func (m *VerifyAuditChainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyAuditChainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Valid
+
+	// no validation rules for Checked
+
+	// no validation rules for Unchained
+
+	// no validation rules for FirstSeq
+
+	// no validation rules for LastSeq
+
+	for idx, item := range m.GetIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VerifyAuditChainResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VerifyAuditChainResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VerifyAuditChainResponseValidationError{
+					field:  fmt.Sprintf("Issues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VerifyAuditChainResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyAuditChainResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyAuditChainResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyAuditChainResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyAuditChainResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyAuditChainResponseMultiError) AllErrors() []error { return m }
+
+// VerifyAuditChainResponseValidationError is the validation error returned by
+// VerifyAuditChainResponse.Validate if the designated constraints aren't met.
+type VerifyAuditChainResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyAuditChainResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyAuditChainResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyAuditChainResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyAuditChainResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyAuditChainResponseValidationError) ErrorName() string {
+	return "VerifyAuditChainResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyAuditChainResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyAuditChainResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyAuditChainResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyAuditChainResponseValidationError{}
+
+// Validate checks the field values on SecurityAlert with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecurityAlert) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecurityAlert with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecurityAlertMultiError, or
+// nil if none found.
+func (m *SecurityAlert) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecurityAlert) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for Kind
+
+	// no validation rules for Severity
+
+	// no validation rules for UserId
+
+	// no validation rules for ResourceId
+
+	// no validation rules for Message
+
+	// no validation rules for Details
+
+	// no validation rules for Acknowledged
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecurityAlertValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecurityAlertValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecurityAlertValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.AcknowledgedBy != nil {
+		// no validation rules for AcknowledgedBy
+	}
+
+	if m.AcknowledgedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetAcknowledgedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityAlertValidationError{
+						field:  "AcknowledgedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecurityAlertValidationError{
+						field:  "AcknowledgedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAcknowledgedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecurityAlertValidationError{
+					field:  "AcknowledgedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SecurityAlertMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecurityAlertMultiError is an error wrapping multiple validation errors
+// returned by SecurityAlert.ValidateAll() if the designated constraints
+// aren't met.
+type SecurityAlertMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecurityAlertMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecurityAlertMultiError) AllErrors() []error { return m }
+
+// SecurityAlertValidationError is the validation error returned by
+// SecurityAlert.Validate if the designated constraints aren't met.
+type SecurityAlertValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecurityAlertValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecurityAlertValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecurityAlertValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecurityAlertValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecurityAlertValidationError) ErrorName() string { return "SecurityAlertValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecurityAlertValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecurityAlert.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecurityAlertValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecurityAlertValidationError{}
+
+// Validate checks the field values on ListSecurityAlertsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSecurityAlertsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecurityAlertsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecurityAlertsRequestMultiError, or nil if none found.
+func (m *ListSecurityAlertsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecurityAlertsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.Kind != nil {
+		// no validation rules for Kind
+	}
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if m.Acknowledged != nil {
+		// no validation rules for Acknowledged
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return ListSecurityAlertsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecurityAlertsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSecurityAlertsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListSecurityAlertsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecurityAlertsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecurityAlertsRequestMultiError) AllErrors() []error { return m }
+
+// ListSecurityAlertsRequestValidationError is the validation error returned by
+// ListSecurityAlertsRequest.Validate if the designated constraints aren't met.
+type ListSecurityAlertsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecurityAlertsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecurityAlertsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecurityAlertsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecurityAlertsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecurityAlertsRequestValidationError) ErrorName() string {
+	return "ListSecurityAlertsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSecurityAlertsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecurityAlertsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecurityAlertsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecurityAlertsRequestValidationError{}
+
+// Validate checks the field values on ListSecurityAlertsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSecurityAlertsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecurityAlertsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecurityAlertsResponseMultiError, or nil if none found.
+func (m *ListSecurityAlertsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecurityAlertsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAlerts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSecurityAlertsResponseValidationError{
+						field:  fmt.Sprintf("Alerts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSecurityAlertsResponseValidationError{
+						field:  fmt.Sprintf("Alerts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSecurityAlertsResponseValidationError{
+					field:  fmt.Sprintf("Alerts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListSecurityAlertsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecurityAlertsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListSecurityAlertsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListSecurityAlertsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecurityAlertsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecurityAlertsResponseMultiError) AllErrors() []error { return m }
+
+// ListSecurityAlertsResponseValidationError is the validation error returned
+// by ListSecurityAlertsResponse.Validate if the designated constraints aren't met.
+type ListSecurityAlertsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecurityAlertsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecurityAlertsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecurityAlertsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecurityAlertsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecurityAlertsResponseValidationError) ErrorName() string {
+	return "ListSecurityAlertsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSecurityAlertsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecurityAlertsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecurityAlertsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecurityAlertsResponseValidationError{}
+
+// Validate checks the field values on AcknowledgeSecurityAlertRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcknowledgeSecurityAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcknowledgeSecurityAlertRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AcknowledgeSecurityAlertRequestMultiError, or nil if none found.
+func (m *AcknowledgeSecurityAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcknowledgeSecurityAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AcknowledgeSecurityAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcknowledgeSecurityAlertRequestMultiError is an error wrapping multiple
+// validation errors returned by AcknowledgeSecurityAlertRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AcknowledgeSecurityAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcknowledgeSecurityAlertRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcknowledgeSecurityAlertRequestMultiError) AllErrors() []error { return m }
+
+// AcknowledgeSecurityAlertRequestValidationError is the validation error
+// returned by AcknowledgeSecurityAlertRequest.Validate if the designated
+// constraints aren't met.
+type AcknowledgeSecurityAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcknowledgeSecurityAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcknowledgeSecurityAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcknowledgeSecurityAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcknowledgeSecurityAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcknowledgeSecurityAlertRequestValidationError) ErrorName() string {
+	return "AcknowledgeSecurityAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcknowledgeSecurityAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcknowledgeSecurityAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcknowledgeSecurityAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcknowledgeSecurityAlertRequestValidationError{}
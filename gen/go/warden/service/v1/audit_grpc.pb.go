@@ -0,0 +1,367 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/audit.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenAuditService_ListAuditLogs_FullMethodName            = "/warden.service.v1.WardenAuditService/ListAuditLogs"
+	WardenAuditService_GetAuditRetention_FullMethodName        = "/warden.service.v1.WardenAuditService/GetAuditRetention"
+	WardenAuditService_SetAuditRetention_FullMethodName        = "/warden.service.v1.WardenAuditService/SetAuditRetention"
+	WardenAuditService_PruneAuditLogs_FullMethodName           = "/warden.service.v1.WardenAuditService/PruneAuditLogs"
+	WardenAuditService_VerifyAuditChain_FullMethodName         = "/warden.service.v1.WardenAuditService/VerifyAuditChain"
+	WardenAuditService_ListSecurityAlerts_FullMethodName       = "/warden.service.v1.WardenAuditService/ListSecurityAlerts"
+	WardenAuditService_AcknowledgeSecurityAlert_FullMethodName = "/warden.service.v1.WardenAuditService/AcknowledgeSecurityAlert"
+)
+
+// WardenAuditServiceClient is the client API for WardenAuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Audit Service - audit log administration
+type WardenAuditServiceClient interface {
+	// List audit log entries, filterable by semantic event and resource
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
+	// Get the effective audit log retention of a tenant
+	GetAuditRetention(ctx context.Context, in *GetAuditRetentionRequest, opts ...grpc.CallOption) (*AuditRetention, error)
+	// Set the audit log retention override of a tenant
+	SetAuditRetention(ctx context.Context, in *SetAuditRetentionRequest, opts ...grpc.CallOption) (*AuditRetention, error)
+	// Delete audit logs outside the retention window now
+	PruneAuditLogs(ctx context.Context, in *PruneAuditLogsRequest, opts ...grpc.CallOption) (*PruneAuditLogsResponse, error)
+	// Verify the tamper-evident hash chain of a tenant's audit log
+	VerifyAuditChain(ctx context.Context, in *VerifyAuditChainRequest, opts ...grpc.CallOption) (*VerifyAuditChainResponse, error)
+	// List security alerts raised by anomaly detection (platform admins only)
+	ListSecurityAlerts(ctx context.Context, in *ListSecurityAlertsRequest, opts ...grpc.CallOption) (*ListSecurityAlertsResponse, error)
+	// Acknowledge a security alert (platform admins only)
+	AcknowledgeSecurityAlert(ctx context.Context, in *AcknowledgeSecurityAlertRequest, opts ...grpc.CallOption) (*SecurityAlert, error)
+}
+
+type wardenAuditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenAuditServiceClient(cc grpc.ClientConnInterface) WardenAuditServiceClient {
+	return &wardenAuditServiceClient{cc}
+}
+
+func (c *wardenAuditServiceClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, WardenAuditService_ListAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenAuditServiceClient) GetAuditRetention(ctx context.Context, in *GetAuditRetentionRequest, opts ...grpc.CallOption) (*AuditRetention, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditRetention)
+	err := c.cc.Invoke(ctx, WardenAuditService_GetAuditRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenAuditServiceClient) SetAuditRetention(ctx context.Context, in *SetAuditRetentionRequest, opts ...grpc.CallOption) (*AuditRetention, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditRetention)
+	err := c.cc.Invoke(ctx, WardenAuditService_SetAuditRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenAuditServiceClient) PruneAuditLogs(ctx context.Context, in *PruneAuditLogsRequest, opts ...grpc.CallOption) (*PruneAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PruneAuditLogsResponse)
+	err := c.cc.Invoke(ctx, WardenAuditService_PruneAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenAuditServiceClient) VerifyAuditChain(ctx context.Context, in *VerifyAuditChainRequest, opts ...grpc.CallOption) (*VerifyAuditChainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditChainResponse)
+	err := c.cc.Invoke(ctx, WardenAuditService_VerifyAuditChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenAuditServiceClient) ListSecurityAlerts(ctx context.Context, in *ListSecurityAlertsRequest, opts ...grpc.CallOption) (*ListSecurityAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSecurityAlertsResponse)
+	err := c.cc.Invoke(ctx, WardenAuditService_ListSecurityAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenAuditServiceClient) AcknowledgeSecurityAlert(ctx context.Context, in *AcknowledgeSecurityAlertRequest, opts ...grpc.CallOption) (*SecurityAlert, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecurityAlert)
+	err := c.cc.Invoke(ctx, WardenAuditService_AcknowledgeSecurityAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenAuditServiceServer is the server API for WardenAuditService service.
+// All implementations must embed UnimplementedWardenAuditServiceServer
+// for forward compatibility.
+//
+// Audit Service - audit log administration
+type WardenAuditServiceServer interface {
+	// List audit log entries, filterable by semantic event and resource
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
+	// Get the effective audit log retention of a tenant
+	GetAuditRetention(context.Context, *GetAuditRetentionRequest) (*AuditRetention, error)
+	// Set the audit log retention override of a tenant
+	SetAuditRetention(context.Context, *SetAuditRetentionRequest) (*AuditRetention, error)
+	// Delete audit logs outside the retention window now
+	PruneAuditLogs(context.Context, *PruneAuditLogsRequest) (*PruneAuditLogsResponse, error)
+	// Verify the tamper-evident hash chain of a tenant's audit log
+	VerifyAuditChain(context.Context, *VerifyAuditChainRequest) (*VerifyAuditChainResponse, error)
+	// List security alerts raised by anomaly detection (platform admins only)
+	ListSecurityAlerts(context.Context, *ListSecurityAlertsRequest) (*ListSecurityAlertsResponse, error)
+	// Acknowledge a security alert (platform admins only)
+	AcknowledgeSecurityAlert(context.Context, *AcknowledgeSecurityAlertRequest) (*SecurityAlert, error)
+	mustEmbedUnimplementedWardenAuditServiceServer()
+}
+
+// UnimplementedWardenAuditServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenAuditServiceServer struct{}
+
+func (UnimplementedWardenAuditServiceServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
+func (UnimplementedWardenAuditServiceServer) GetAuditRetention(context.Context, *GetAuditRetentionRequest) (*AuditRetention, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAuditRetention not implemented")
+}
+func (UnimplementedWardenAuditServiceServer) SetAuditRetention(context.Context, *SetAuditRetentionRequest) (*AuditRetention, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAuditRetention not implemented")
+}
+func (UnimplementedWardenAuditServiceServer) PruneAuditLogs(context.Context, *PruneAuditLogsRequest) (*PruneAuditLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PruneAuditLogs not implemented")
+}
+func (UnimplementedWardenAuditServiceServer) VerifyAuditChain(context.Context, *VerifyAuditChainRequest) (*VerifyAuditChainResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyAuditChain not implemented")
+}
+func (UnimplementedWardenAuditServiceServer) ListSecurityAlerts(context.Context, *ListSecurityAlertsRequest) (*ListSecurityAlertsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSecurityAlerts not implemented")
+}
+func (UnimplementedWardenAuditServiceServer) AcknowledgeSecurityAlert(context.Context, *AcknowledgeSecurityAlertRequest) (*SecurityAlert, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcknowledgeSecurityAlert not implemented")
+}
+func (UnimplementedWardenAuditServiceServer) mustEmbedUnimplementedWardenAuditServiceServer() {}
+func (UnimplementedWardenAuditServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeWardenAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenAuditServiceServer will
+// result in compilation errors.
+type UnsafeWardenAuditServiceServer interface {
+	mustEmbedUnimplementedWardenAuditServiceServer()
+}
+
+func RegisterWardenAuditServiceServer(s grpc.ServiceRegistrar, srv WardenAuditServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenAuditService_ServiceDesc, srv)
+}
+
+func _WardenAuditService_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenAuditServiceServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenAuditService_ListAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenAuditServiceServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenAuditService_GetAuditRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenAuditServiceServer).GetAuditRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenAuditService_GetAuditRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenAuditServiceServer).GetAuditRetention(ctx, req.(*GetAuditRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenAuditService_SetAuditRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAuditRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenAuditServiceServer).SetAuditRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenAuditService_SetAuditRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenAuditServiceServer).SetAuditRetention(ctx, req.(*SetAuditRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenAuditService_PruneAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenAuditServiceServer).PruneAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenAuditService_PruneAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenAuditServiceServer).PruneAuditLogs(ctx, req.(*PruneAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenAuditService_VerifyAuditChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenAuditServiceServer).VerifyAuditChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenAuditService_VerifyAuditChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenAuditServiceServer).VerifyAuditChain(ctx, req.(*VerifyAuditChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenAuditService_ListSecurityAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecurityAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenAuditServiceServer).ListSecurityAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenAuditService_ListSecurityAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenAuditServiceServer).ListSecurityAlerts(ctx, req.(*ListSecurityAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenAuditService_AcknowledgeSecurityAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeSecurityAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenAuditServiceServer).AcknowledgeSecurityAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenAuditService_AcknowledgeSecurityAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenAuditServiceServer).AcknowledgeSecurityAlert(ctx, req.(*AcknowledgeSecurityAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenAuditService_ServiceDesc is the grpc.ServiceDesc for WardenAuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenAuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenAuditService",
+	HandlerType: (*WardenAuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _WardenAuditService_ListAuditLogs_Handler,
+		},
+		{
+			MethodName: "GetAuditRetention",
+			Handler:    _WardenAuditService_GetAuditRetention_Handler,
+		},
+		{
+			MethodName: "SetAuditRetention",
+			Handler:    _WardenAuditService_SetAuditRetention_Handler,
+		},
+		{
+			MethodName: "PruneAuditLogs",
+			Handler:    _WardenAuditService_PruneAuditLogs_Handler,
+		},
+		{
+			MethodName: "VerifyAuditChain",
+			Handler:    _WardenAuditService_VerifyAuditChain_Handler,
+		},
+		{
+			MethodName: "ListSecurityAlerts",
+			Handler:    _WardenAuditService_ListSecurityAlerts_Handler,
+		},
+		{
+			MethodName: "AcknowledgeSecurityAlert",
+			Handler:    _WardenAuditService_AcknowledgeSecurityAlert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/audit.proto",
+}
@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/audit.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenAuditServiceAcknowledgeSecurityAlert = "/warden.service.v1.WardenAuditService/AcknowledgeSecurityAlert"
+const OperationWardenAuditServiceGetAuditRetention = "/warden.service.v1.WardenAuditService/GetAuditRetention"
+const OperationWardenAuditServiceListAuditLogs = "/warden.service.v1.WardenAuditService/ListAuditLogs"
+const OperationWardenAuditServiceListSecurityAlerts = "/warden.service.v1.WardenAuditService/ListSecurityAlerts"
+const OperationWardenAuditServicePruneAuditLogs = "/warden.service.v1.WardenAuditService/PruneAuditLogs"
+const OperationWardenAuditServiceSetAuditRetention = "/warden.service.v1.WardenAuditService/SetAuditRetention"
+const OperationWardenAuditServiceVerifyAuditChain = "/warden.service.v1.WardenAuditService/VerifyAuditChain"
+
+type WardenAuditServiceHTTPServer interface {
+	// AcknowledgeSecurityAlert Acknowledge a security alert (platform admins only)
+	AcknowledgeSecurityAlert(context.Context, *AcknowledgeSecurityAlertRequest) (*SecurityAlert, error)
+	// GetAuditRetention Get the effective audit log retention of a tenant
+	GetAuditRetention(context.Context, *GetAuditRetentionRequest) (*AuditRetention, error)
+	// ListAuditLogs List audit log entries, filterable by semantic event and resource
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
+	// ListSecurityAlerts List security alerts raised by anomaly detection (platform admins only)
+	ListSecurityAlerts(context.Context, *ListSecurityAlertsRequest) (*ListSecurityAlertsResponse, error)
+	// PruneAuditLogs Delete audit logs outside the retention window now
+	PruneAuditLogs(context.Context, *PruneAuditLogsRequest) (*PruneAuditLogsResponse, error)
+	// SetAuditRetention Set the audit log retention override of a tenant
+	SetAuditRetention(context.Context, *SetAuditRetentionRequest) (*AuditRetention, error)
+	// VerifyAuditChain Verify the tamper-evident hash chain of a tenant's audit log
+	VerifyAuditChain(context.Context, *VerifyAuditChainRequest) (*VerifyAuditChainResponse, error)
+}
+
+func RegisterWardenAuditServiceHTTPServer(s *http.Server, srv WardenAuditServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/audit/logs", _WardenAuditService_ListAuditLogs0_HTTP_Handler(srv))
+	r.GET("/v1/audit/retention", _WardenAuditService_GetAuditRetention0_HTTP_Handler(srv))
+	r.PUT("/v1/audit/retention", _WardenAuditService_SetAuditRetention0_HTTP_Handler(srv))
+	r.POST("/v1/audit/prune", _WardenAuditService_PruneAuditLogs0_HTTP_Handler(srv))
+	r.GET("/v1/audit/verify", _WardenAuditService_VerifyAuditChain0_HTTP_Handler(srv))
+	r.GET("/v1/audit/alerts", _WardenAuditService_ListSecurityAlerts0_HTTP_Handler(srv))
+	r.POST("/v1/audit/alerts/{id}/acknowledge", _WardenAuditService_AcknowledgeSecurityAlert0_HTTP_Handler(srv))
+}
+
+func _WardenAuditService_ListAuditLogs0_HTTP_Handler(srv WardenAuditServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAuditLogsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenAuditServiceListAuditLogs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAuditLogsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenAuditService_GetAuditRetention0_HTTP_Handler(srv WardenAuditServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAuditRetentionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenAuditServiceGetAuditRetention)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAuditRetention(ctx, req.(*GetAuditRetentionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuditRetention)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenAuditService_SetAuditRetention0_HTTP_Handler(srv WardenAuditServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetAuditRetentionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenAuditServiceSetAuditRetention)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetAuditRetention(ctx, req.(*SetAuditRetentionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuditRetention)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenAuditService_PruneAuditLogs0_HTTP_Handler(srv WardenAuditServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PruneAuditLogsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenAuditServicePruneAuditLogs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PruneAuditLogs(ctx, req.(*PruneAuditLogsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PruneAuditLogsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenAuditService_VerifyAuditChain0_HTTP_Handler(srv WardenAuditServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyAuditChainRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenAuditServiceVerifyAuditChain)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyAuditChain(ctx, req.(*VerifyAuditChainRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyAuditChainResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenAuditService_ListSecurityAlerts0_HTTP_Handler(srv WardenAuditServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSecurityAlertsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenAuditServiceListSecurityAlerts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSecurityAlerts(ctx, req.(*ListSecurityAlertsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSecurityAlertsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenAuditService_AcknowledgeSecurityAlert0_HTTP_Handler(srv WardenAuditServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcknowledgeSecurityAlertRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenAuditServiceAcknowledgeSecurityAlert)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcknowledgeSecurityAlert(ctx, req.(*AcknowledgeSecurityAlertRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SecurityAlert)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenAuditServiceHTTPClient interface {
+	// AcknowledgeSecurityAlert Acknowledge a security alert (platform admins only)
+	AcknowledgeSecurityAlert(ctx context.Context, req *AcknowledgeSecurityAlertRequest, opts ...http.CallOption) (rsp *SecurityAlert, err error)
+	// GetAuditRetention Get the effective audit log retention of a tenant
+	GetAuditRetention(ctx context.Context, req *GetAuditRetentionRequest, opts ...http.CallOption) (rsp *AuditRetention, err error)
+	// ListAuditLogs List audit log entries, filterable by semantic event and resource
+	ListAuditLogs(ctx context.Context, req *ListAuditLogsRequest, opts ...http.CallOption) (rsp *ListAuditLogsResponse, err error)
+	// ListSecurityAlerts List security alerts raised by anomaly detection (platform admins only)
+	ListSecurityAlerts(ctx context.Context, req *ListSecurityAlertsRequest, opts ...http.CallOption) (rsp *ListSecurityAlertsResponse, err error)
+	// PruneAuditLogs Delete audit logs outside the retention window now
+	PruneAuditLogs(ctx context.Context, req *PruneAuditLogsRequest, opts ...http.CallOption) (rsp *PruneAuditLogsResponse, err error)
+	// SetAuditRetention Set the audit log retention override of a tenant
+	SetAuditRetention(ctx context.Context, req *SetAuditRetentionRequest, opts ...http.CallOption) (rsp *AuditRetention, err error)
+	// VerifyAuditChain Verify the tamper-evident hash chain of a tenant's audit log
+	VerifyAuditChain(ctx context.Context, req *VerifyAuditChainRequest, opts ...http.CallOption) (rsp *VerifyAuditChainResponse, err error)
+}
+
+type WardenAuditServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenAuditServiceHTTPClient(client *http.Client) WardenAuditServiceHTTPClient {
+	return &WardenAuditServiceHTTPClientImpl{client}
+}
+
+// AcknowledgeSecurityAlert Acknowledge a security alert (platform admins only)
+func (c *WardenAuditServiceHTTPClientImpl) AcknowledgeSecurityAlert(ctx context.Context, in *AcknowledgeSecurityAlertRequest, opts ...http.CallOption) (*SecurityAlert, error) {
+	var out SecurityAlert
+	pattern := "/v1/audit/alerts/{id}/acknowledge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenAuditServiceAcknowledgeSecurityAlert))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAuditRetention Get the effective audit log retention of a tenant
+func (c *WardenAuditServiceHTTPClientImpl) GetAuditRetention(ctx context.Context, in *GetAuditRetentionRequest, opts ...http.CallOption) (*AuditRetention, error) {
+	var out AuditRetention
+	pattern := "/v1/audit/retention"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenAuditServiceGetAuditRetention))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAuditLogs List audit log entries, filterable by semantic event and resource
+func (c *WardenAuditServiceHTTPClientImpl) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...http.CallOption) (*ListAuditLogsResponse, error) {
+	var out ListAuditLogsResponse
+	pattern := "/v1/audit/logs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenAuditServiceListAuditLogs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSecurityAlerts List security alerts raised by anomaly detection (platform admins only)
+func (c *WardenAuditServiceHTTPClientImpl) ListSecurityAlerts(ctx context.Context, in *ListSecurityAlertsRequest, opts ...http.CallOption) (*ListSecurityAlertsResponse, error) {
+	var out ListSecurityAlertsResponse
+	pattern := "/v1/audit/alerts"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenAuditServiceListSecurityAlerts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PruneAuditLogs Delete audit logs outside the retention window now
+func (c *WardenAuditServiceHTTPClientImpl) PruneAuditLogs(ctx context.Context, in *PruneAuditLogsRequest, opts ...http.CallOption) (*PruneAuditLogsResponse, error) {
+	var out PruneAuditLogsResponse
+	pattern := "/v1/audit/prune"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenAuditServicePruneAuditLogs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetAuditRetention Set the audit log retention override of a tenant
+func (c *WardenAuditServiceHTTPClientImpl) SetAuditRetention(ctx context.Context, in *SetAuditRetentionRequest, opts ...http.CallOption) (*AuditRetention, error) {
+	var out AuditRetention
+	pattern := "/v1/audit/retention"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenAuditServiceSetAuditRetention))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// VerifyAuditChain Verify the tamper-evident hash chain of a tenant's audit log
+func (c *WardenAuditServiceHTTPClientImpl) VerifyAuditChain(ctx context.Context, in *VerifyAuditChainRequest, opts ...http.CallOption) (*VerifyAuditChainResponse, error) {
+	var out VerifyAuditChainResponse
+	pattern := "/v1/audit/verify"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenAuditServiceVerifyAuditChain))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -130,8 +130,10 @@ type ExportBackupResponse struct {
 	TenantId      uint32                 `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	EntityCounts  map[string]int64       `protobuf:"bytes,6,rep,name=entity_counts,json=entityCounts,proto3" json:"entity_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	SchemaVersion int32                  `protobuf:"varint,7,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Secrets (with their versions and permissions) left out by tenant export policies
+	ExcludedByPolicy int32 `protobuf:"varint,8,opt,name=excluded_by_policy,json=excludedByPolicy,proto3" json:"excluded_by_policy,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ExportBackupResponse) Reset() {
@@ -213,10 +215,24 @@ func (x *ExportBackupResponse) GetSchemaVersion() int32 {
 	return 0
 }
 
+func (x *ExportBackupResponse) GetExcludedByPolicy() int32 {
+	if x != nil {
+		return x.ExcludedByPolicy
+	}
+	return 0
+}
+
 type ImportBackupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Mode          RestoreMode            `protobuf:"varint,2,opt,name=mode,proto3,enum=warden.service.v1.RestoreMode" json:"mode,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Mode  RestoreMode            `protobuf:"varint,2,opt,name=mode,proto3,enum=warden.service.v1.RestoreMode" json:"mode,omitempty"`
+	// Entity types to restore: folders, secrets, secretVersions, permissions.
+	EntityTypes []string `protobuf:"bytes,3,rep,name=entity_types,json=entityTypes,proto3" json:"entity_types,omitempty"`
+	// Restore only the folder subtrees rooted at these paths (e.g. "/infra/db").
+	// Ancestor folders are restored as needed so the subtree can be attached.
+	FolderPaths []string `protobuf:"bytes,4,rep,name=folder_paths,json=folderPaths,proto3" json:"folder_paths,omitempty"`
+	// Restore only these secrets (combined with folder_paths as a union).
+	SecretIds     []string `protobuf:"bytes,5,rep,name=secret_ids,json=secretIds,proto3" json:"secret_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -265,6 +281,27 @@ func (x *ImportBackupRequest) GetMode() RestoreMode {
 	return RestoreMode_RESTORE_MODE_SKIP
 }
 
+func (x *ImportBackupRequest) GetEntityTypes() []string {
+	if x != nil {
+		return x.EntityTypes
+	}
+	return nil
+}
+
+func (x *ImportBackupRequest) GetFolderPaths() []string {
+	if x != nil {
+		return x.FolderPaths
+	}
+	return nil
+}
+
+func (x *ImportBackupRequest) GetSecretIds() []string {
+	if x != nil {
+		return x.SecretIds
+	}
+	return nil
+}
+
 type ImportBackupResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Success           bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -442,7 +479,7 @@ const file_warden_service_v1_backup_proto_rawDesc = "" +
 	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12'\n" +
 	"\x0finclude_secrets\x18\x02 \x01(\bR\x0eincludeSecretsB\f\n" +
 	"\n" +
-	"_tenant_id\"\xfe\x02\n" +
+	"_tenant_id\"\xac\x03\n" +
 	"\x14ExportBackupResponse\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12\x16\n" +
 	"\x06module\x18\x02 \x01(\tR\x06module\x12\x18\n" +
@@ -451,13 +488,18 @@ const file_warden_service_v1_backup_proto_rawDesc = "" +
 	"exportedAt\x12\x1b\n" +
 	"\ttenant_id\x18\x05 \x01(\rR\btenantId\x12^\n" +
 	"\rentity_counts\x18\x06 \x03(\v29.warden.service.v1.ExportBackupResponse.EntityCountsEntryR\fentityCounts\x12%\n" +
-	"\x0eschema_version\x18\a \x01(\x05R\rschemaVersion\x1a?\n" +
+	"\x0eschema_version\x18\a \x01(\x05R\rschemaVersion\x12,\n" +
+	"\x12excluded_by_policy\x18\b \x01(\x05R\x10excludedByPolicy\x1a?\n" +
 	"\x11EntityCountsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"]\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xc2\x01\n" +
 	"\x13ImportBackupRequest\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x122\n" +
-	"\x04mode\x18\x02 \x01(\x0e2\x1e.warden.service.v1.RestoreModeR\x04mode\"\x8a\x02\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x1e.warden.service.v1.RestoreModeR\x04mode\x12!\n" +
+	"\fentity_types\x18\x03 \x03(\tR\ventityTypes\x12!\n" +
+	"\ffolder_paths\x18\x04 \x03(\tR\vfolderPaths\x12\x1d\n" +
+	"\n" +
+	"secret_ids\x18\x05 \x03(\tR\tsecretIds\"\x8a\x02\n" +
 	"\x14ImportBackupResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12?\n" +
 	"\aresults\x18\x02 \x03(\v2%.warden.service.v1.EntityImportResultR\aresults\x12\x1a\n" +
@@ -93,6 +93,8 @@ func (x *ExportBackupResponse) Redact() string {
 	// Safe field: EntityCounts
 
 	// Safe field: SchemaVersion
+
+	// Safe field: ExcludedByPolicy
 	return x.String()
 }
 
@@ -105,6 +107,12 @@ func (x *ImportBackupRequest) Redact() string {
 	// Safe field: Data
 
 	// Safe field: Mode
+
+	// Safe field: EntityTypes
+
+	// Safe field: FolderPaths
+
+	// Safe field: SecretIds
 	return x.String()
 }
 
@@ -206,6 +206,8 @@ func (m *ExportBackupResponse) validate(all bool) error {
 
 	// no validation rules for SchemaVersion
 
+	// no validation rules for ExcludedByPolicy
+
 	if len(errors) > 0 {
 		return ExportBackupResponseMultiError(errors)
 	}
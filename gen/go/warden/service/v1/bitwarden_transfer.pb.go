@@ -88,7 +88,8 @@ const (
 	DuplicateHandling_DUPLICATE_HANDLING_UNSPECIFIED DuplicateHandling = 0
 	DuplicateHandling_DUPLICATE_HANDLING_SKIP        DuplicateHandling = 1 // Skip items with duplicate names
 	DuplicateHandling_DUPLICATE_HANDLING_RENAME      DuplicateHandling = 2 // Rename with suffix (e.g., "name (1)")
-	DuplicateHandling_DUPLICATE_HANDLING_OVERWRITE   DuplicateHandling = 3 // Replace existing
+	DuplicateHandling_DUPLICATE_HANDLING_OVERWRITE   DuplicateHandling = 3 // Update existing, keeping the old password as a previous version
+	DuplicateHandling_DUPLICATE_HANDLING_MERGE       DuplicateHandling = 4 // Add the imported password as a new version, leave everything else as is
 )
 
 // Enum value maps for DuplicateHandling.
@@ -98,12 +99,14 @@ var (
 		1: "DUPLICATE_HANDLING_SKIP",
 		2: "DUPLICATE_HANDLING_RENAME",
 		3: "DUPLICATE_HANDLING_OVERWRITE",
+		4: "DUPLICATE_HANDLING_MERGE",
 	}
 	DuplicateHandling_value = map[string]int32{
 		"DUPLICATE_HANDLING_UNSPECIFIED": 0,
 		"DUPLICATE_HANDLING_SKIP":        1,
 		"DUPLICATE_HANDLING_RENAME":      2,
 		"DUPLICATE_HANDLING_OVERWRITE":   3,
+		"DUPLICATE_HANDLING_MERGE":       4,
 	}
 )
 
@@ -134,6 +137,56 @@ func (DuplicateHandling) EnumDescriptor() ([]byte, []int) {
 	return file_warden_service_v1_bitwarden_transfer_proto_rawDescGZIP(), []int{1}
 }
 
+// How imported items are matched against existing secrets
+type DuplicateMatch int32
+
+const (
+	DuplicateMatch_DUPLICATE_MATCH_UNSPECIFIED  DuplicateMatch = 0 // Same as DUPLICATE_MATCH_NAME
+	DuplicateMatch_DUPLICATE_MATCH_NAME         DuplicateMatch = 1 // Case-insensitive name
+	DuplicateMatch_DUPLICATE_MATCH_URL_USERNAME DuplicateMatch = 2 // Normalized host URL and username; items without a URL fall back to the name
+)
+
+// Enum value maps for DuplicateMatch.
+var (
+	DuplicateMatch_name = map[int32]string{
+		0: "DUPLICATE_MATCH_UNSPECIFIED",
+		1: "DUPLICATE_MATCH_NAME",
+		2: "DUPLICATE_MATCH_URL_USERNAME",
+	}
+	DuplicateMatch_value = map[string]int32{
+		"DUPLICATE_MATCH_UNSPECIFIED":  0,
+		"DUPLICATE_MATCH_NAME":         1,
+		"DUPLICATE_MATCH_URL_USERNAME": 2,
+	}
+)
+
+func (x DuplicateMatch) Enum() *DuplicateMatch {
+	p := new(DuplicateMatch)
+	*p = x
+	return p
+}
+
+func (x DuplicateMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuplicateMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_bitwarden_transfer_proto_enumTypes[2].Descriptor()
+}
+
+func (DuplicateMatch) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_bitwarden_transfer_proto_enumTypes[2]
+}
+
+func (x DuplicateMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DuplicateMatch.Descriptor instead.
+func (DuplicateMatch) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_bitwarden_transfer_proto_rawDescGZIP(), []int{2}
+}
+
 // Bitwarden folder structure
 type BitwardenFolder struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -616,8 +669,14 @@ type ExportToBitwardenRequest struct {
 	FolderId *string `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
 	// Include secrets from subfolders (default: true)
 	IncludeSubfolders bool `protobuf:"varint,2,opt,name=include_subfolders,json=includeSubfolders,proto3" json:"include_subfolders,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Encrypt the export with this password (Bitwarden "password protected" format).
+	// Required when the server sets BITWARDEN_EXPORT_REQUIRE_PASSWORD.
+	ExportPassword *string `protobuf:"bytes,3,opt,name=export_password,json=exportPassword,proto3,oneof" json:"export_password,omitempty"`
+	// Export the readable collections of the exported secrets as Bitwarden
+	// organization collections, with collectionIds on each item
+	IncludeCollections bool `protobuf:"varint,4,opt,name=include_collections,json=includeCollections,proto3" json:"include_collections,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ExportToBitwardenRequest) Reset() {
@@ -664,6 +723,20 @@ func (x *ExportToBitwardenRequest) GetIncludeSubfolders() bool {
 	return false
 }
 
+func (x *ExportToBitwardenRequest) GetExportPassword() string {
+	if x != nil && x.ExportPassword != nil {
+		return *x.ExportPassword
+	}
+	return ""
+}
+
+func (x *ExportToBitwardenRequest) GetIncludeCollections() bool {
+	if x != nil {
+		return x.IncludeCollections
+	}
+	return false
+}
+
 type ExportToBitwardenResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// JSON string in Bitwarden format
@@ -674,8 +747,14 @@ type ExportToBitwardenResponse struct {
 	ItemsSkipped    int32 `protobuf:"varint,4,opt,name=items_skipped,json=itemsSkipped,proto3" json:"items_skipped,omitempty"`
 	// Filename suggestion
 	SuggestedFilename string `protobuf:"bytes,5,opt,name=suggested_filename,json=suggestedFilename,proto3" json:"suggested_filename,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Whether json_data is password protected
+	PasswordProtected bool `protobuf:"varint,6,opt,name=password_protected,json=passwordProtected,proto3" json:"password_protected,omitempty"`
+	// Secrets left out by the tenant's export policy
+	ItemsExcludedByPolicy int32 `protobuf:"varint,7,opt,name=items_excluded_by_policy,json=itemsExcludedByPolicy,proto3" json:"items_excluded_by_policy,omitempty"`
+	// Collections written with include_collections
+	CollectionsExported int32 `protobuf:"varint,8,opt,name=collections_exported,json=collectionsExported,proto3" json:"collections_exported,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ExportToBitwardenResponse) Reset() {
@@ -743,6 +822,27 @@ func (x *ExportToBitwardenResponse) GetSuggestedFilename() string {
 	return ""
 }
 
+func (x *ExportToBitwardenResponse) GetPasswordProtected() bool {
+	if x != nil {
+		return x.PasswordProtected
+	}
+	return false
+}
+
+func (x *ExportToBitwardenResponse) GetItemsExcludedByPolicy() int32 {
+	if x != nil {
+		return x.ItemsExcludedByPolicy
+	}
+	return 0
+}
+
+func (x *ExportToBitwardenResponse) GetCollectionsExported() int32 {
+	if x != nil {
+		return x.CollectionsExported
+	}
+	return 0
+}
+
 // Permission rule to apply to all imported items
 type ImportPermissionRule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -817,8 +917,17 @@ type ImportFromBitwardenRequest struct {
 	PreserveFolders bool `protobuf:"varint,4,opt,name=preserve_folders,json=preserveFolders,proto3" json:"preserve_folders,omitempty"`
 	// Permission rules to apply to all imported folders and secrets
 	PermissionRules []*ImportPermissionRule `protobuf:"bytes,5,rep,name=permission_rules,json=permissionRules,proto3" json:"permission_rules,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// How items are matched against existing secrets
+	DuplicateMatch DuplicateMatch `protobuf:"varint,6,opt,name=duplicate_match,json=duplicateMatch,proto3,enum=warden.service.v1.DuplicateMatch" json:"duplicate_match,omitempty"`
+	// Import the collections of an organization export as Warden collections
+	// (matched by name) instead of folders
+	ImportCollections bool `protobuf:"varint,7,opt,name=import_collections,json=importCollections,proto3" json:"import_collections,omitempty"`
+	// With preserve_folders, put Bitwarden folders (by ID) into existing Warden
+	// folders (by ID) instead of creating them. Folders nested below a mapped
+	// one by name go below its Warden folder.
+	FolderMapping map[string]string `protobuf:"bytes,8,rep,name=folder_mapping,json=folderMapping,proto3" json:"folder_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ImportFromBitwardenRequest) Reset() {
@@ -886,6 +995,27 @@ func (x *ImportFromBitwardenRequest) GetPermissionRules() []*ImportPermissionRul
 	return nil
 }
 
+func (x *ImportFromBitwardenRequest) GetDuplicateMatch() DuplicateMatch {
+	if x != nil {
+		return x.DuplicateMatch
+	}
+	return DuplicateMatch_DUPLICATE_MATCH_UNSPECIFIED
+}
+
+func (x *ImportFromBitwardenRequest) GetImportCollections() bool {
+	if x != nil {
+		return x.ImportCollections
+	}
+	return false
+}
+
+func (x *ImportFromBitwardenRequest) GetFolderMapping() map[string]string {
+	if x != nil {
+		return x.FolderMapping
+	}
+	return nil
+}
+
 type ImportFromBitwardenResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Import statistics
@@ -898,8 +1028,14 @@ type ImportFromBitwardenResponse struct {
 	// Mapping of Bitwarden IDs to Warden IDs
 	FolderIdMapping map[string]string `protobuf:"bytes,6,rep,name=folder_id_mapping,json=folderIdMapping,proto3" json:"folder_id_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	ItemIdMapping   map[string]string `protobuf:"bytes,7,rep,name=item_id_mapping,json=itemIdMapping,proto3" json:"item_id_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Existing secrets updated by DUPLICATE_HANDLING_OVERWRITE or DUPLICATE_HANDLING_MERGE
+	ItemsUpdated int32 `protobuf:"varint,8,opt,name=items_updated,json=itemsUpdated,proto3" json:"items_updated,omitempty"`
+	// Collections created with import_collections, and the Warden collection
+	// each Bitwarden collection was mapped to
+	CollectionsCreated  int32             `protobuf:"varint,9,opt,name=collections_created,json=collectionsCreated,proto3" json:"collections_created,omitempty"`
+	CollectionIdMapping map[string]string `protobuf:"bytes,10,rep,name=collection_id_mapping,json=collectionIdMapping,proto3" json:"collection_id_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ImportFromBitwardenResponse) Reset() {
@@ -981,6 +1117,27 @@ func (x *ImportFromBitwardenResponse) GetItemIdMapping() map[string]string {
 	return nil
 }
 
+func (x *ImportFromBitwardenResponse) GetItemsUpdated() int32 {
+	if x != nil {
+		return x.ItemsUpdated
+	}
+	return 0
+}
+
+func (x *ImportFromBitwardenResponse) GetCollectionsCreated() int32 {
+	if x != nil {
+		return x.CollectionsCreated
+	}
+	return 0
+}
+
+func (x *ImportFromBitwardenResponse) GetCollectionIdMapping() map[string]string {
+	if x != nil {
+		return x.CollectionIdMapping
+	}
+	return nil
+}
+
 type ImportError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BitwardenId   string                 `protobuf:"bytes,1,opt,name=bitwarden_id,json=bitwardenId,proto3" json:"bitwarden_id,omitempty"`
@@ -1051,12 +1208,18 @@ func (x *ImportError) GetMessage() string {
 
 // Validation request (dry-run)
 type ValidateBitwardenImportRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	JsonData        string                 `protobuf:"bytes,1,opt,name=json_data,json=jsonData,proto3" json:"json_data,omitempty"`
-	TargetFolderId  *string                `protobuf:"bytes,2,opt,name=target_folder_id,json=targetFolderId,proto3,oneof" json:"target_folder_id,omitempty"`
-	PreserveFolders bool                   `protobuf:"varint,3,opt,name=preserve_folders,json=preserveFolders,proto3" json:"preserve_folders,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	JsonData          string                 `protobuf:"bytes,1,opt,name=json_data,json=jsonData,proto3" json:"json_data,omitempty"`
+	TargetFolderId    *string                `protobuf:"bytes,2,opt,name=target_folder_id,json=targetFolderId,proto3,oneof" json:"target_folder_id,omitempty"`
+	PreserveFolders   bool                   `protobuf:"varint,3,opt,name=preserve_folders,json=preserveFolders,proto3" json:"preserve_folders,omitempty"`
+	DuplicateMatch    DuplicateMatch         `protobuf:"varint,4,opt,name=duplicate_match,json=duplicateMatch,proto3,enum=warden.service.v1.DuplicateMatch" json:"duplicate_match,omitempty"`
+	ImportCollections bool                   `protobuf:"varint,5,opt,name=import_collections,json=importCollections,proto3" json:"import_collections,omitempty"`
+	// With preserve_folders, put Bitwarden folders (by ID) into existing Warden
+	// folders (by ID) instead of creating them. Folders nested below a mapped
+	// one by name go below its Warden folder.
+	FolderMapping map[string]string `protobuf:"bytes,6,rep,name=folder_mapping,json=folderMapping,proto3" json:"folder_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ValidateBitwardenImportRequest) Reset() {
@@ -1110,6 +1273,27 @@ func (x *ValidateBitwardenImportRequest) GetPreserveFolders() bool {
 	return false
 }
 
+func (x *ValidateBitwardenImportRequest) GetDuplicateMatch() DuplicateMatch {
+	if x != nil {
+		return x.DuplicateMatch
+	}
+	return DuplicateMatch_DUPLICATE_MATCH_UNSPECIFIED
+}
+
+func (x *ValidateBitwardenImportRequest) GetImportCollections() bool {
+	if x != nil {
+		return x.ImportCollections
+	}
+	return false
+}
+
+func (x *ValidateBitwardenImportRequest) GetFolderMapping() map[string]string {
+	if x != nil {
+		return x.FolderMapping
+	}
+	return nil
+}
+
 type ValidateBitwardenImportResponse struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	IsValid bool                   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
@@ -1122,8 +1306,15 @@ type ValidateBitwardenImportResponse struct {
 	Errors   []string `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
 	// Duplicate detection
 	DuplicateNames []string `protobuf:"bytes,7,rep,name=duplicate_names,json=duplicateNames,proto3" json:"duplicate_names,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Organization collections in the export; without import_collections they
+	// are counted as folders too
+	CollectionsFound int32 `protobuf:"varint,8,opt,name=collections_found,json=collectionsFound,proto3" json:"collections_found,omitempty"`
+	// Where the import would put each Bitwarden folder and item, with
+	// preserve_folders and the folder_mapping of the request applied
+	FolderMappings  []*BitwardenFolderMapping  `protobuf:"bytes,9,rep,name=folder_mappings,json=folderMappings,proto3" json:"folder_mappings,omitempty"`
+	ItemAssignments []*BitwardenItemAssignment `protobuf:"bytes,10,rep,name=item_assignments,json=itemAssignments,proto3" json:"item_assignments,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ValidateBitwardenImportResponse) Reset() {
@@ -1205,6 +1396,196 @@ func (x *ValidateBitwardenImportResponse) GetDuplicateNames() []string {
 	return nil
 }
 
+func (x *ValidateBitwardenImportResponse) GetCollectionsFound() int32 {
+	if x != nil {
+		return x.CollectionsFound
+	}
+	return 0
+}
+
+func (x *ValidateBitwardenImportResponse) GetFolderMappings() []*BitwardenFolderMapping {
+	if x != nil {
+		return x.FolderMappings
+	}
+	return nil
+}
+
+func (x *ValidateBitwardenImportResponse) GetItemAssignments() []*BitwardenItemAssignment {
+	if x != nil {
+		return x.ItemAssignments
+	}
+	return nil
+}
+
+// Where an import puts a Bitwarden folder
+type BitwardenFolderMapping struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	BitwardenFolderId string                 `protobuf:"bytes,1,opt,name=bitwarden_folder_id,json=bitwardenFolderId,proto3" json:"bitwarden_folder_id,omitempty"`
+	BitwardenName     string                 `protobuf:"bytes,2,opt,name=bitwarden_name,json=bitwardenName,proto3" json:"bitwarden_name,omitempty"`
+	// Path of the Warden folder
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// The existing Warden folder, unset when the import creates it
+	FolderId *string `protobuf:"bytes,4,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	// Chosen by the folder_mapping of the request
+	Mapped bool `protobuf:"varint,5,opt,name=mapped,proto3" json:"mapped,omitempty"`
+	// Items in the folder
+	ItemCount     int32 `protobuf:"varint,6,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BitwardenFolderMapping) Reset() {
+	*x = BitwardenFolderMapping{}
+	mi := &file_warden_service_v1_bitwarden_transfer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitwardenFolderMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitwardenFolderMapping) ProtoMessage() {}
+
+func (x *BitwardenFolderMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_bitwarden_transfer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitwardenFolderMapping.ProtoReflect.Descriptor instead.
+func (*BitwardenFolderMapping) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_bitwarden_transfer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BitwardenFolderMapping) GetBitwardenFolderId() string {
+	if x != nil {
+		return x.BitwardenFolderId
+	}
+	return ""
+}
+
+func (x *BitwardenFolderMapping) GetBitwardenName() string {
+	if x != nil {
+		return x.BitwardenName
+	}
+	return ""
+}
+
+func (x *BitwardenFolderMapping) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *BitwardenFolderMapping) GetFolderId() string {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return ""
+}
+
+func (x *BitwardenFolderMapping) GetMapped() bool {
+	if x != nil {
+		return x.Mapped
+	}
+	return false
+}
+
+func (x *BitwardenFolderMapping) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+// Where an import puts a Bitwarden item
+type BitwardenItemAssignment struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	BitwardenItemId   string                 `protobuf:"bytes,1,opt,name=bitwarden_item_id,json=bitwardenItemId,proto3" json:"bitwarden_item_id,omitempty"`
+	ItemName          string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	BitwardenFolderId *string                `protobuf:"bytes,3,opt,name=bitwarden_folder_id,json=bitwardenFolderId,proto3,oneof" json:"bitwarden_folder_id,omitempty"`
+	// Path of the Warden folder (empty for the root level)
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// The existing Warden folder, unset for the root level or when the import
+	// creates the folder
+	FolderId      *string `protobuf:"bytes,5,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BitwardenItemAssignment) Reset() {
+	*x = BitwardenItemAssignment{}
+	mi := &file_warden_service_v1_bitwarden_transfer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitwardenItemAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitwardenItemAssignment) ProtoMessage() {}
+
+func (x *BitwardenItemAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_bitwarden_transfer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitwardenItemAssignment.ProtoReflect.Descriptor instead.
+func (*BitwardenItemAssignment) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_bitwarden_transfer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BitwardenItemAssignment) GetBitwardenItemId() string {
+	if x != nil {
+		return x.BitwardenItemId
+	}
+	return ""
+}
+
+func (x *BitwardenItemAssignment) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *BitwardenItemAssignment) GetBitwardenFolderId() string {
+	if x != nil && x.BitwardenFolderId != nil {
+		return *x.BitwardenFolderId
+	}
+	return ""
+}
+
+func (x *BitwardenItemAssignment) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *BitwardenItemAssignment) GetFolderId() string {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return ""
+}
+
 var File_warden_service_v1_bitwarden_transfer_proto protoreflect.FileDescriptor
 
 const file_warden_service_v1_bitwarden_transfer_proto_rawDesc = "" +
@@ -1216,20 +1597,20 @@ const file_warden_service_v1_bitwarden_transfer_proto_rawDesc = "" +
 	"\fBitwardenUri\x12\x10\n" +
 	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x19\n" +
 	"\x05match\x18\x02 \x01(\x05H\x00R\x05match\x88\x01\x01B\b\n" +
-	"\x06_match\"\xaf\x01\n" +
+	"\x06_match\"\xb5\x01\n" +
 	"\x0eBitwardenLogin\x123\n" +
 	"\x04uris\x18\x01 \x03(\v2\x1f.warden.service.v1.BitwardenUriR\x04uris\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\"\n" +
-	"\bpassword\x18\x03 \x01(\tB\x06ڶ\x1a\x02z\x00R\bpassword\x12\x1f\n" +
-	"\x04totp\x18\x04 \x01(\tB\x06ڶ\x1a\x02z\x00H\x00R\x04totp\x88\x01\x01B\a\n" +
-	"\x05_totp\"V\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12%\n" +
+	"\bpassword\x18\x03 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\bpassword\x12\"\n" +
+	"\x04totp\x18\x04 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01H\x00R\x04totp\x88\x01\x01B\a\n" +
+	"\x05_totp\"Y\n" +
 	"\x0eBitwardenField\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
-	"\x05value\x18\x02 \x01(\tB\x06ڶ\x1a\x02z\x00R\x05value\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\x05R\x04type\"d\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\x05value\x18\x02 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\x05value\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\x05R\x04type\"g\n" +
 	"\x18BitwardenPasswordHistory\x12$\n" +
-	"\x0elast_used_date\x18\x01 \x01(\tR\flastUsedDate\x12\"\n" +
-	"\bpassword\x18\x02 \x01(\tB\x06ڶ\x1a\x02z\x00R\bpassword\"\x8b\x04\n" +
+	"\x0elast_used_date\x18\x01 \x01(\tR\flastUsedDate\x12%\n" +
+	"\bpassword\x18\x02 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\bpassword\"\x8b\x04\n" +
 	"\rBitwardenItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
 	"\tfolder_id\x18\x02 \x01(\tH\x00R\bfolderId\x88\x01\x01\x12\x12\n" +
@@ -1252,30 +1633,42 @@ const file_warden_service_v1_bitwarden_transfer_proto_rawDesc = "" +
 	"\x0fBitwardenExport\x12\x1c\n" +
 	"\tencrypted\x18\x01 \x01(\bR\tencrypted\x12<\n" +
 	"\afolders\x18\x02 \x03(\v2\".warden.service.v1.BitwardenFolderR\afolders\x126\n" +
-	"\x05items\x18\x03 \x03(\v2 .warden.service.v1.BitwardenItemR\x05items\"\x94\x01\n" +
+	"\x05items\x18\x03 \x03(\v2 .warden.service.v1.BitwardenItemR\x05items\"\x9c\x02\n" +
 	"\x18ExportToBitwardenRequest\x12;\n" +
 	"\tfolder_id\x18\x01 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\bfolderId\x88\x01\x01\x12-\n" +
-	"\x12include_subfolders\x18\x02 \x01(\bR\x11includeSubfoldersB\f\n" +
+	"\x12include_subfolders\x18\x02 \x01(\bR\x11includeSubfolders\x12A\n" +
+	"\x0fexport_password\x18\x03 \x01(\tB\x13\xbaH\ar\x05\x10\b\x18\x80\bڶ\x1a\x02z\x00\x80\x01\x01H\x01R\x0eexportPassword\x88\x01\x01\x12/\n" +
+	"\x13include_collections\x18\x04 \x01(\bR\x12includeCollectionsB\f\n" +
 	"\n" +
-	"_folder_id\"\xe6\x01\n" +
-	"\x19ExportToBitwardenResponse\x12#\n" +
-	"\tjson_data\x18\x01 \x01(\tB\x06ڶ\x1a\x02z\x00R\bjsonData\x12)\n" +
+	"_folder_idB\x12\n" +
+	"\x10_export_password\"\x84\x03\n" +
+	"\x19ExportToBitwardenResponse\x12&\n" +
+	"\tjson_data\x18\x01 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\bjsonData\x12)\n" +
 	"\x10folders_exported\x18\x02 \x01(\x05R\x0ffoldersExported\x12%\n" +
 	"\x0eitems_exported\x18\x03 \x01(\x05R\ritemsExported\x12#\n" +
 	"\ritems_skipped\x18\x04 \x01(\x05R\fitemsSkipped\x12-\n" +
-	"\x12suggested_filename\x18\x05 \x01(\tR\x11suggestedFilename\"\xb1\x01\n" +
+	"\x12suggested_filename\x18\x05 \x01(\tR\x11suggestedFilename\x12-\n" +
+	"\x12password_protected\x18\x06 \x01(\bR\x11passwordProtected\x127\n" +
+	"\x18items_excluded_by_policy\x18\a \x01(\x05R\x15itemsExcludedByPolicy\x121\n" +
+	"\x14collections_exported\x18\b \x01(\x05R\x13collectionsExported\"\xb1\x01\n" +
 	"\x14ImportPermissionRule\x12A\n" +
 	"\fsubject_type\x18\x01 \x01(\x0e2\x1e.warden.service.v1.SubjectTypeR\vsubjectType\x12\x1d\n" +
 	"\n" +
 	"subject_id\x18\x02 \x01(\tR\tsubjectId\x127\n" +
-	"\brelation\x18\x03 \x01(\x0e2\x1b.warden.service.v1.RelationR\brelation\"\x83\x03\n" +
-	"\x1aImportFromBitwardenRequest\x122\n" +
-	"\tjson_data\x18\x01 \x01(\tB\x15\xe0A\x02\xbaH\tr\a\x10\x02\x18\x80\x80\x80\x05ڶ\x1a\x02z\x00R\bjsonData\x12H\n" +
+	"\brelation\x18\x03 \x01(\x0e2\x1b.warden.service.v1.RelationR\brelation\"\xd6\x05\n" +
+	"\x1aImportFromBitwardenRequest\x120\n" +
+	"\tjson_data\x18\x01 \x01(\tB\x13\xe0A\x02\xbaH\x04r\x02\x10\x02ڶ\x1a\x02z\x00\x80\x01\x01R\bjsonData\x12H\n" +
 	"\x10target_folder_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\x0etargetFolderId\x88\x01\x01\x12S\n" +
 	"\x12duplicate_handling\x18\x03 \x01(\x0e2$.warden.service.v1.DuplicateHandlingR\x11duplicateHandling\x12)\n" +
 	"\x10preserve_folders\x18\x04 \x01(\bR\x0fpreserveFolders\x12R\n" +
-	"\x10permission_rules\x18\x05 \x03(\v2'.warden.service.v1.ImportPermissionRuleR\x0fpermissionRulesB\x13\n" +
-	"\x11_target_folder_id\"\xcf\x04\n" +
+	"\x10permission_rules\x18\x05 \x03(\v2'.warden.service.v1.ImportPermissionRuleR\x0fpermissionRules\x12J\n" +
+	"\x0fduplicate_match\x18\x06 \x01(\x0e2!.warden.service.v1.DuplicateMatchR\x0eduplicateMatch\x12-\n" +
+	"\x12import_collections\x18\a \x01(\bR\x11importCollections\x12\x95\x01\n" +
+	"\x0efolder_mapping\x18\b \x03(\v2@.warden.service.v1.ImportFromBitwardenRequest.FolderMappingEntryB,\xbaH)\x9a\x01&\x10\x90N\"\ar\x05\x10\x01\x18\xff\x01*\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\rfolderMapping\x1a@\n" +
+	"\x12FolderMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x13\n" +
+	"\x11_target_folder_id\"\xea\x06\n" +
 	"\x1bImportFromBitwardenResponse\x12'\n" +
 	"\x0ffolders_created\x18\x01 \x01(\x05R\x0efoldersCreated\x12%\n" +
 	"\x0eitems_imported\x18\x02 \x01(\x05R\ritemsImported\x12#\n" +
@@ -1283,24 +1676,37 @@ const file_warden_service_v1_bitwarden_transfer_proto_rawDesc = "" +
 	"\fitems_failed\x18\x04 \x01(\x05R\vitemsFailed\x126\n" +
 	"\x06errors\x18\x05 \x03(\v2\x1e.warden.service.v1.ImportErrorR\x06errors\x12o\n" +
 	"\x11folder_id_mapping\x18\x06 \x03(\v2C.warden.service.v1.ImportFromBitwardenResponse.FolderIdMappingEntryR\x0ffolderIdMapping\x12i\n" +
-	"\x0fitem_id_mapping\x18\a \x03(\v2A.warden.service.v1.ImportFromBitwardenResponse.ItemIdMappingEntryR\ritemIdMapping\x1aB\n" +
+	"\x0fitem_id_mapping\x18\a \x03(\v2A.warden.service.v1.ImportFromBitwardenResponse.ItemIdMappingEntryR\ritemIdMapping\x12#\n" +
+	"\ritems_updated\x18\b \x01(\x05R\fitemsUpdated\x12/\n" +
+	"\x13collections_created\x18\t \x01(\x05R\x12collectionsCreated\x12{\n" +
+	"\x15collection_id_mapping\x18\n" +
+	" \x03(\v2G.warden.service.v1.ImportFromBitwardenResponse.CollectionIdMappingEntryR\x13collectionIdMapping\x1aB\n" +
 	"\x14FolderIdMappingEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
 	"\x12ItemIdMappingEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aF\n" +
+	"\x18CollectionIdMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x86\x01\n" +
 	"\vImportError\x12!\n" +
 	"\fbitwarden_id\x18\x01 \x01(\tR\vbitwardenId\x12\x1b\n" +
 	"\titem_name\x18\x02 \x01(\tR\bitemName\x12\x1d\n" +
 	"\n" +
 	"error_type\x18\x03 \x01(\tR\terrorType\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"\xde\x01\n" +
-	"\x1eValidateBitwardenImportRequest\x122\n" +
-	"\tjson_data\x18\x01 \x01(\tB\x15\xe0A\x02\xbaH\tr\a\x10\x02\x18\x80\x80\x80\x05ڶ\x1a\x02z\x00R\bjsonData\x12H\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xb5\x04\n" +
+	"\x1eValidateBitwardenImportRequest\x120\n" +
+	"\tjson_data\x18\x01 \x01(\tB\x13\xe0A\x02\xbaH\x04r\x02\x10\x02ڶ\x1a\x02z\x00\x80\x01\x01R\bjsonData\x12H\n" +
 	"\x10target_folder_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\x0etargetFolderId\x88\x01\x01\x12)\n" +
-	"\x10preserve_folders\x18\x03 \x01(\bR\x0fpreserveFoldersB\x13\n" +
-	"\x11_target_folder_id\"\x96\x02\n" +
+	"\x10preserve_folders\x18\x03 \x01(\bR\x0fpreserveFolders\x12J\n" +
+	"\x0fduplicate_match\x18\x04 \x01(\x0e2!.warden.service.v1.DuplicateMatchR\x0eduplicateMatch\x12-\n" +
+	"\x12import_collections\x18\x05 \x01(\bR\x11importCollections\x12\x99\x01\n" +
+	"\x0efolder_mapping\x18\x06 \x03(\v2D.warden.service.v1.ValidateBitwardenImportRequest.FolderMappingEntryB,\xbaH)\x9a\x01&\x10\x90N\"\ar\x05\x10\x01\x18\xff\x01*\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\rfolderMapping\x1a@\n" +
+	"\x12FolderMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x13\n" +
+	"\x11_target_folder_id\"\xee\x03\n" +
 	"\x1fValidateBitwardenImportResponse\x12\x19\n" +
 	"\bis_valid\x18\x01 \x01(\bR\aisValid\x12#\n" +
 	"\rfolders_found\x18\x02 \x01(\x05R\ffoldersFound\x12*\n" +
@@ -1308,18 +1714,46 @@ const file_warden_service_v1_bitwarden_transfer_proto_rawDesc = "" +
 	"\x11other_items_found\x18\x04 \x01(\x05R\x0fotherItemsFound\x12\x1a\n" +
 	"\bwarnings\x18\x05 \x03(\tR\bwarnings\x12\x16\n" +
 	"\x06errors\x18\x06 \x03(\tR\x06errors\x12'\n" +
-	"\x0fduplicate_names\x18\a \x03(\tR\x0eduplicateNames*\xbc\x01\n" +
+	"\x0fduplicate_names\x18\a \x03(\tR\x0eduplicateNames\x12+\n" +
+	"\x11collections_found\x18\b \x01(\x05R\x10collectionsFound\x12R\n" +
+	"\x0ffolder_mappings\x18\t \x03(\v2).warden.service.v1.BitwardenFolderMappingR\x0efolderMappings\x12U\n" +
+	"\x10item_assignments\x18\n" +
+	" \x03(\v2*.warden.service.v1.BitwardenItemAssignmentR\x0fitemAssignments\"\xea\x01\n" +
+	"\x16BitwardenFolderMapping\x12.\n" +
+	"\x13bitwarden_folder_id\x18\x01 \x01(\tR\x11bitwardenFolderId\x12%\n" +
+	"\x0ebitwarden_name\x18\x02 \x01(\tR\rbitwardenName\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12 \n" +
+	"\tfolder_id\x18\x04 \x01(\tH\x00R\bfolderId\x88\x01\x01\x12\x16\n" +
+	"\x06mapped\x18\x05 \x01(\bR\x06mapped\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x06 \x01(\x05R\titemCountB\f\n" +
+	"\n" +
+	"_folder_id\"\xf3\x01\n" +
+	"\x17BitwardenItemAssignment\x12*\n" +
+	"\x11bitwarden_item_id\x18\x01 \x01(\tR\x0fbitwardenItemId\x12\x1b\n" +
+	"\titem_name\x18\x02 \x01(\tR\bitemName\x123\n" +
+	"\x13bitwarden_folder_id\x18\x03 \x01(\tH\x00R\x11bitwardenFolderId\x88\x01\x01\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12 \n" +
+	"\tfolder_id\x18\x05 \x01(\tH\x01R\bfolderId\x88\x01\x01B\x16\n" +
+	"\x14_bitwarden_folder_idB\f\n" +
+	"\n" +
+	"_folder_id*\xbc\x01\n" +
 	"\x11BitwardenItemType\x12#\n" +
 	"\x1fBITWARDEN_ITEM_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19BITWARDEN_ITEM_TYPE_LOGIN\x10\x01\x12#\n" +
 	"\x1fBITWARDEN_ITEM_TYPE_SECURE_NOTE\x10\x02\x12\x1c\n" +
 	"\x18BITWARDEN_ITEM_TYPE_CARD\x10\x03\x12 \n" +
-	"\x1cBITWARDEN_ITEM_TYPE_IDENTITY\x10\x04*\x95\x01\n" +
+	"\x1cBITWARDEN_ITEM_TYPE_IDENTITY\x10\x04*\xb3\x01\n" +
 	"\x11DuplicateHandling\x12\"\n" +
 	"\x1eDUPLICATE_HANDLING_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17DUPLICATE_HANDLING_SKIP\x10\x01\x12\x1d\n" +
 	"\x19DUPLICATE_HANDLING_RENAME\x10\x02\x12 \n" +
-	"\x1cDUPLICATE_HANDLING_OVERWRITE\x10\x032\xf0\x03\n" +
+	"\x1cDUPLICATE_HANDLING_OVERWRITE\x10\x03\x12\x1c\n" +
+	"\x18DUPLICATE_HANDLING_MERGE\x10\x04*m\n" +
+	"\x0eDuplicateMatch\x12\x1f\n" +
+	"\x1bDUPLICATE_MATCH_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14DUPLICATE_MATCH_NAME\x10\x01\x12 \n" +
+	"\x1cDUPLICATE_MATCH_URL_USERNAME\x10\x022\xf0\x03\n" +
 	"\x1eWardenBitwardenTransferService\x12\x8f\x01\n" +
 	"\x11ExportToBitwarden\x12+.warden.service.v1.ExportToBitwardenRequest\x1a,.warden.service.v1.ExportToBitwardenResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/bitwarden/export\x12\x95\x01\n" +
 	"\x13ImportFromBitwarden\x12-.warden.service.v1.ImportFromBitwardenRequest\x1a..warden.service.v1.ImportFromBitwardenResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/bitwarden/import\x12\xa3\x01\n" +
@@ -1338,56 +1772,69 @@ func file_warden_service_v1_bitwarden_transfer_proto_rawDescGZIP() []byte {
 	return file_warden_service_v1_bitwarden_transfer_proto_rawDescData
 }
 
-var file_warden_service_v1_bitwarden_transfer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_warden_service_v1_bitwarden_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_warden_service_v1_bitwarden_transfer_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_warden_service_v1_bitwarden_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_warden_service_v1_bitwarden_transfer_proto_goTypes = []any{
 	(BitwardenItemType)(0),                  // 0: warden.service.v1.BitwardenItemType
 	(DuplicateHandling)(0),                  // 1: warden.service.v1.DuplicateHandling
-	(*BitwardenFolder)(nil),                 // 2: warden.service.v1.BitwardenFolder
-	(*BitwardenUri)(nil),                    // 3: warden.service.v1.BitwardenUri
-	(*BitwardenLogin)(nil),                  // 4: warden.service.v1.BitwardenLogin
-	(*BitwardenField)(nil),                  // 5: warden.service.v1.BitwardenField
-	(*BitwardenPasswordHistory)(nil),        // 6: warden.service.v1.BitwardenPasswordHistory
-	(*BitwardenItem)(nil),                   // 7: warden.service.v1.BitwardenItem
-	(*BitwardenExport)(nil),                 // 8: warden.service.v1.BitwardenExport
-	(*ExportToBitwardenRequest)(nil),        // 9: warden.service.v1.ExportToBitwardenRequest
-	(*ExportToBitwardenResponse)(nil),       // 10: warden.service.v1.ExportToBitwardenResponse
-	(*ImportPermissionRule)(nil),            // 11: warden.service.v1.ImportPermissionRule
-	(*ImportFromBitwardenRequest)(nil),      // 12: warden.service.v1.ImportFromBitwardenRequest
-	(*ImportFromBitwardenResponse)(nil),     // 13: warden.service.v1.ImportFromBitwardenResponse
-	(*ImportError)(nil),                     // 14: warden.service.v1.ImportError
-	(*ValidateBitwardenImportRequest)(nil),  // 15: warden.service.v1.ValidateBitwardenImportRequest
-	(*ValidateBitwardenImportResponse)(nil), // 16: warden.service.v1.ValidateBitwardenImportResponse
-	nil,                                     // 17: warden.service.v1.ImportFromBitwardenResponse.FolderIdMappingEntry
-	nil,                                     // 18: warden.service.v1.ImportFromBitwardenResponse.ItemIdMappingEntry
-	(SubjectType)(0),                        // 19: warden.service.v1.SubjectType
-	(Relation)(0),                           // 20: warden.service.v1.Relation
+	(DuplicateMatch)(0),                     // 2: warden.service.v1.DuplicateMatch
+	(*BitwardenFolder)(nil),                 // 3: warden.service.v1.BitwardenFolder
+	(*BitwardenUri)(nil),                    // 4: warden.service.v1.BitwardenUri
+	(*BitwardenLogin)(nil),                  // 5: warden.service.v1.BitwardenLogin
+	(*BitwardenField)(nil),                  // 6: warden.service.v1.BitwardenField
+	(*BitwardenPasswordHistory)(nil),        // 7: warden.service.v1.BitwardenPasswordHistory
+	(*BitwardenItem)(nil),                   // 8: warden.service.v1.BitwardenItem
+	(*BitwardenExport)(nil),                 // 9: warden.service.v1.BitwardenExport
+	(*ExportToBitwardenRequest)(nil),        // 10: warden.service.v1.ExportToBitwardenRequest
+	(*ExportToBitwardenResponse)(nil),       // 11: warden.service.v1.ExportToBitwardenResponse
+	(*ImportPermissionRule)(nil),            // 12: warden.service.v1.ImportPermissionRule
+	(*ImportFromBitwardenRequest)(nil),      // 13: warden.service.v1.ImportFromBitwardenRequest
+	(*ImportFromBitwardenResponse)(nil),     // 14: warden.service.v1.ImportFromBitwardenResponse
+	(*ImportError)(nil),                     // 15: warden.service.v1.ImportError
+	(*ValidateBitwardenImportRequest)(nil),  // 16: warden.service.v1.ValidateBitwardenImportRequest
+	(*ValidateBitwardenImportResponse)(nil), // 17: warden.service.v1.ValidateBitwardenImportResponse
+	(*BitwardenFolderMapping)(nil),          // 18: warden.service.v1.BitwardenFolderMapping
+	(*BitwardenItemAssignment)(nil),         // 19: warden.service.v1.BitwardenItemAssignment
+	nil,                                     // 20: warden.service.v1.ImportFromBitwardenRequest.FolderMappingEntry
+	nil,                                     // 21: warden.service.v1.ImportFromBitwardenResponse.FolderIdMappingEntry
+	nil,                                     // 22: warden.service.v1.ImportFromBitwardenResponse.ItemIdMappingEntry
+	nil,                                     // 23: warden.service.v1.ImportFromBitwardenResponse.CollectionIdMappingEntry
+	nil,                                     // 24: warden.service.v1.ValidateBitwardenImportRequest.FolderMappingEntry
+	(SubjectType)(0),                        // 25: warden.service.v1.SubjectType
+	(Relation)(0),                           // 26: warden.service.v1.Relation
 }
 var file_warden_service_v1_bitwarden_transfer_proto_depIdxs = []int32{
-	3,  // 0: warden.service.v1.BitwardenLogin.uris:type_name -> warden.service.v1.BitwardenUri
-	4,  // 1: warden.service.v1.BitwardenItem.login:type_name -> warden.service.v1.BitwardenLogin
-	5,  // 2: warden.service.v1.BitwardenItem.fields:type_name -> warden.service.v1.BitwardenField
-	6,  // 3: warden.service.v1.BitwardenItem.password_history:type_name -> warden.service.v1.BitwardenPasswordHistory
-	2,  // 4: warden.service.v1.BitwardenExport.folders:type_name -> warden.service.v1.BitwardenFolder
-	7,  // 5: warden.service.v1.BitwardenExport.items:type_name -> warden.service.v1.BitwardenItem
-	19, // 6: warden.service.v1.ImportPermissionRule.subject_type:type_name -> warden.service.v1.SubjectType
-	20, // 7: warden.service.v1.ImportPermissionRule.relation:type_name -> warden.service.v1.Relation
+	4,  // 0: warden.service.v1.BitwardenLogin.uris:type_name -> warden.service.v1.BitwardenUri
+	5,  // 1: warden.service.v1.BitwardenItem.login:type_name -> warden.service.v1.BitwardenLogin
+	6,  // 2: warden.service.v1.BitwardenItem.fields:type_name -> warden.service.v1.BitwardenField
+	7,  // 3: warden.service.v1.BitwardenItem.password_history:type_name -> warden.service.v1.BitwardenPasswordHistory
+	3,  // 4: warden.service.v1.BitwardenExport.folders:type_name -> warden.service.v1.BitwardenFolder
+	8,  // 5: warden.service.v1.BitwardenExport.items:type_name -> warden.service.v1.BitwardenItem
+	25, // 6: warden.service.v1.ImportPermissionRule.subject_type:type_name -> warden.service.v1.SubjectType
+	26, // 7: warden.service.v1.ImportPermissionRule.relation:type_name -> warden.service.v1.Relation
 	1,  // 8: warden.service.v1.ImportFromBitwardenRequest.duplicate_handling:type_name -> warden.service.v1.DuplicateHandling
-	11, // 9: warden.service.v1.ImportFromBitwardenRequest.permission_rules:type_name -> warden.service.v1.ImportPermissionRule
-	14, // 10: warden.service.v1.ImportFromBitwardenResponse.errors:type_name -> warden.service.v1.ImportError
-	17, // 11: warden.service.v1.ImportFromBitwardenResponse.folder_id_mapping:type_name -> warden.service.v1.ImportFromBitwardenResponse.FolderIdMappingEntry
-	18, // 12: warden.service.v1.ImportFromBitwardenResponse.item_id_mapping:type_name -> warden.service.v1.ImportFromBitwardenResponse.ItemIdMappingEntry
-	9,  // 13: warden.service.v1.WardenBitwardenTransferService.ExportToBitwarden:input_type -> warden.service.v1.ExportToBitwardenRequest
-	12, // 14: warden.service.v1.WardenBitwardenTransferService.ImportFromBitwarden:input_type -> warden.service.v1.ImportFromBitwardenRequest
-	15, // 15: warden.service.v1.WardenBitwardenTransferService.ValidateBitwardenImport:input_type -> warden.service.v1.ValidateBitwardenImportRequest
-	10, // 16: warden.service.v1.WardenBitwardenTransferService.ExportToBitwarden:output_type -> warden.service.v1.ExportToBitwardenResponse
-	13, // 17: warden.service.v1.WardenBitwardenTransferService.ImportFromBitwarden:output_type -> warden.service.v1.ImportFromBitwardenResponse
-	16, // 18: warden.service.v1.WardenBitwardenTransferService.ValidateBitwardenImport:output_type -> warden.service.v1.ValidateBitwardenImportResponse
-	16, // [16:19] is the sub-list for method output_type
-	13, // [13:16] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	12, // 9: warden.service.v1.ImportFromBitwardenRequest.permission_rules:type_name -> warden.service.v1.ImportPermissionRule
+	2,  // 10: warden.service.v1.ImportFromBitwardenRequest.duplicate_match:type_name -> warden.service.v1.DuplicateMatch
+	20, // 11: warden.service.v1.ImportFromBitwardenRequest.folder_mapping:type_name -> warden.service.v1.ImportFromBitwardenRequest.FolderMappingEntry
+	15, // 12: warden.service.v1.ImportFromBitwardenResponse.errors:type_name -> warden.service.v1.ImportError
+	21, // 13: warden.service.v1.ImportFromBitwardenResponse.folder_id_mapping:type_name -> warden.service.v1.ImportFromBitwardenResponse.FolderIdMappingEntry
+	22, // 14: warden.service.v1.ImportFromBitwardenResponse.item_id_mapping:type_name -> warden.service.v1.ImportFromBitwardenResponse.ItemIdMappingEntry
+	23, // 15: warden.service.v1.ImportFromBitwardenResponse.collection_id_mapping:type_name -> warden.service.v1.ImportFromBitwardenResponse.CollectionIdMappingEntry
+	2,  // 16: warden.service.v1.ValidateBitwardenImportRequest.duplicate_match:type_name -> warden.service.v1.DuplicateMatch
+	24, // 17: warden.service.v1.ValidateBitwardenImportRequest.folder_mapping:type_name -> warden.service.v1.ValidateBitwardenImportRequest.FolderMappingEntry
+	18, // 18: warden.service.v1.ValidateBitwardenImportResponse.folder_mappings:type_name -> warden.service.v1.BitwardenFolderMapping
+	19, // 19: warden.service.v1.ValidateBitwardenImportResponse.item_assignments:type_name -> warden.service.v1.BitwardenItemAssignment
+	10, // 20: warden.service.v1.WardenBitwardenTransferService.ExportToBitwarden:input_type -> warden.service.v1.ExportToBitwardenRequest
+	13, // 21: warden.service.v1.WardenBitwardenTransferService.ImportFromBitwarden:input_type -> warden.service.v1.ImportFromBitwardenRequest
+	16, // 22: warden.service.v1.WardenBitwardenTransferService.ValidateBitwardenImport:input_type -> warden.service.v1.ValidateBitwardenImportRequest
+	11, // 23: warden.service.v1.WardenBitwardenTransferService.ExportToBitwarden:output_type -> warden.service.v1.ExportToBitwardenResponse
+	14, // 24: warden.service.v1.WardenBitwardenTransferService.ImportFromBitwarden:output_type -> warden.service.v1.ImportFromBitwardenResponse
+	17, // 25: warden.service.v1.WardenBitwardenTransferService.ValidateBitwardenImport:output_type -> warden.service.v1.ValidateBitwardenImportResponse
+	23, // [23:26] is the sub-list for method output_type
+	20, // [20:23] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_warden_service_v1_bitwarden_transfer_proto_init() }
@@ -1402,13 +1849,15 @@ func file_warden_service_v1_bitwarden_transfer_proto_init() {
 	file_warden_service_v1_bitwarden_transfer_proto_msgTypes[7].OneofWrappers = []any{}
 	file_warden_service_v1_bitwarden_transfer_proto_msgTypes[10].OneofWrappers = []any{}
 	file_warden_service_v1_bitwarden_transfer_proto_msgTypes[13].OneofWrappers = []any{}
+	file_warden_service_v1_bitwarden_transfer_proto_msgTypes[15].OneofWrappers = []any{}
+	file_warden_service_v1_bitwarden_transfer_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_bitwarden_transfer_proto_rawDesc), len(file_warden_service_v1_bitwarden_transfer_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   17,
+			NumEnums:      3,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
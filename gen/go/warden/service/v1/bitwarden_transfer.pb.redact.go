@@ -202,6 +202,12 @@ func (x *ExportToBitwardenRequest) Redact() string {
 	// Safe field: FolderId
 
 	// Safe field: IncludeSubfolders
+
+	// Redacting field: ExportPassword
+	ExportPasswordTmp := ``
+	x.ExportPassword = &ExportPasswordTmp
+
+	// Safe field: IncludeCollections
 	return x.String()
 }
 
@@ -221,6 +227,12 @@ func (x *ExportToBitwardenResponse) Redact() string {
 	// Safe field: ItemsSkipped
 
 	// Safe field: SuggestedFilename
+
+	// Safe field: PasswordProtected
+
+	// Safe field: ItemsExcludedByPolicy
+
+	// Safe field: CollectionsExported
 	return x.String()
 }
 
@@ -254,6 +266,12 @@ func (x *ImportFromBitwardenRequest) Redact() string {
 	// Safe field: PreserveFolders
 
 	// Safe field: PermissionRules
+
+	// Safe field: DuplicateMatch
+
+	// Safe field: ImportCollections
+
+	// Safe field: FolderMapping
 	return x.String()
 }
 
@@ -276,6 +294,12 @@ func (x *ImportFromBitwardenResponse) Redact() string {
 	// Safe field: FolderIdMapping
 
 	// Safe field: ItemIdMapping
+
+	// Safe field: ItemsUpdated
+
+	// Safe field: CollectionsCreated
+
+	// Safe field: CollectionIdMapping
 	return x.String()
 }
 
@@ -307,6 +331,12 @@ func (x *ValidateBitwardenImportRequest) Redact() string {
 	// Safe field: TargetFolderId
 
 	// Safe field: PreserveFolders
+
+	// Safe field: DuplicateMatch
+
+	// Safe field: ImportCollections
+
+	// Safe field: FolderMapping
 	return x.String()
 }
 
@@ -329,5 +359,49 @@ func (x *ValidateBitwardenImportResponse) Redact() string {
 	// Safe field: Errors
 
 	// Safe field: DuplicateNames
+
+	// Safe field: CollectionsFound
+
+	// Safe field: FolderMappings
+
+	// Safe field: ItemAssignments
+	return x.String()
+}
+
+// Redact method implementation for BitwardenFolderMapping
+func (x *BitwardenFolderMapping) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: BitwardenFolderId
+
+	// Safe field: BitwardenName
+
+	// Safe field: Path
+
+	// Safe field: FolderId
+
+	// Safe field: Mapped
+
+	// Safe field: ItemCount
+	return x.String()
+}
+
+// Redact method implementation for BitwardenItemAssignment
+func (x *BitwardenItemAssignment) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: BitwardenItemId
+
+	// Safe field: ItemName
+
+	// Safe field: BitwardenFolderId
+
+	// Safe field: Path
+
+	// Safe field: FolderId
 	return x.String()
 }
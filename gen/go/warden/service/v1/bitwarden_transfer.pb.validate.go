@@ -1017,10 +1017,16 @@ func (m *ExportToBitwardenRequest) validate(all bool) error {
 
 	// no validation rules for IncludeSubfolders
 
+	// no validation rules for IncludeCollections
+
 	if m.FolderId != nil {
 		// no validation rules for FolderId
 	}
 
+	if m.ExportPassword != nil {
+		// no validation rules for ExportPassword
+	}
+
 	if len(errors) > 0 {
 		return ExportToBitwardenRequestMultiError(errors)
 	}
@@ -1133,6 +1139,12 @@ func (m *ExportToBitwardenResponse) validate(all bool) error {
 
 	// no validation rules for SuggestedFilename
 
+	// no validation rules for PasswordProtected
+
+	// no validation rules for ItemsExcludedByPolicy
+
+	// no validation rules for CollectionsExported
+
 	if len(errors) > 0 {
 		return ExportToBitwardenResponseMultiError(errors)
 	}
@@ -1383,6 +1395,12 @@ func (m *ImportFromBitwardenRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for DuplicateMatch
+
+	// no validation rules for ImportCollections
+
+	// no validation rules for FolderMapping
+
 	if m.TargetFolderId != nil {
 		// no validation rules for TargetFolderId
 	}
@@ -1535,6 +1553,12 @@ func (m *ImportFromBitwardenResponse) validate(all bool) error {
 
 	// no validation rules for ItemIdMapping
 
+	// no validation rules for ItemsUpdated
+
+	// no validation rules for CollectionsCreated
+
+	// no validation rules for CollectionIdMapping
+
 	if len(errors) > 0 {
 		return ImportFromBitwardenResponseMultiError(errors)
 	}
@@ -1749,6 +1773,12 @@ func (m *ValidateBitwardenImportRequest) validate(all bool) error {
 
 	// no validation rules for PreserveFolders
 
+	// no validation rules for DuplicateMatch
+
+	// no validation rules for ImportCollections
+
+	// no validation rules for FolderMapping
+
 	if m.TargetFolderId != nil {
 		// no validation rules for TargetFolderId
 	}
@@ -1864,6 +1894,76 @@ func (m *ValidateBitwardenImportResponse) validate(all bool) error {
 
 	// no validation rules for OtherItemsFound
 
+	// no validation rules for CollectionsFound
+
+	for idx, item := range m.GetFolderMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateBitwardenImportResponseValidationError{
+						field:  fmt.Sprintf("FolderMappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateBitwardenImportResponseValidationError{
+						field:  fmt.Sprintf("FolderMappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateBitwardenImportResponseValidationError{
+					field:  fmt.Sprintf("FolderMappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetItemAssignments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateBitwardenImportResponseValidationError{
+						field:  fmt.Sprintf("ItemAssignments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateBitwardenImportResponseValidationError{
+						field:  fmt.Sprintf("ItemAssignments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateBitwardenImportResponseValidationError{
+					field:  fmt.Sprintf("ItemAssignments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ValidateBitwardenImportResponseMultiError(errors)
 	}
@@ -1944,3 +2044,235 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ValidateBitwardenImportResponseValidationError{}
+
+// Validate checks the field values on BitwardenFolderMapping with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BitwardenFolderMapping) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BitwardenFolderMapping with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BitwardenFolderMappingMultiError, or nil if none found.
+func (m *BitwardenFolderMapping) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BitwardenFolderMapping) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BitwardenFolderId
+
+	// no validation rules for BitwardenName
+
+	// no validation rules for Path
+
+	// no validation rules for Mapped
+
+	// no validation rules for ItemCount
+
+	if m.FolderId != nil {
+		// no validation rules for FolderId
+	}
+
+	if len(errors) > 0 {
+		return BitwardenFolderMappingMultiError(errors)
+	}
+
+	return nil
+}
+
+// BitwardenFolderMappingMultiError is an error wrapping multiple validation
+// errors returned by BitwardenFolderMapping.ValidateAll() if the designated
+// constraints aren't met.
+type BitwardenFolderMappingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BitwardenFolderMappingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BitwardenFolderMappingMultiError) AllErrors() []error { return m }
+
+// BitwardenFolderMappingValidationError is the validation error returned by
+// BitwardenFolderMapping.Validate if the designated constraints aren't met.
+type BitwardenFolderMappingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BitwardenFolderMappingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BitwardenFolderMappingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BitwardenFolderMappingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BitwardenFolderMappingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BitwardenFolderMappingValidationError) ErrorName() string {
+	return "BitwardenFolderMappingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BitwardenFolderMappingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBitwardenFolderMapping.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BitwardenFolderMappingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BitwardenFolderMappingValidationError{}
+
+// Validate checks the field values on BitwardenItemAssignment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BitwardenItemAssignment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BitwardenItemAssignment with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BitwardenItemAssignmentMultiError, or nil if none found.
+func (m *BitwardenItemAssignment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BitwardenItemAssignment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BitwardenItemId
+
+	// no validation rules for ItemName
+
+	// no validation rules for Path
+
+	if m.BitwardenFolderId != nil {
+		// no validation rules for BitwardenFolderId
+	}
+
+	if m.FolderId != nil {
+		// no validation rules for FolderId
+	}
+
+	if len(errors) > 0 {
+		return BitwardenItemAssignmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// BitwardenItemAssignmentMultiError is an error wrapping multiple validation
+// errors returned by BitwardenItemAssignment.ValidateAll() if the designated
+// constraints aren't met.
+type BitwardenItemAssignmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BitwardenItemAssignmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BitwardenItemAssignmentMultiError) AllErrors() []error { return m }
+
+// BitwardenItemAssignmentValidationError is the validation error returned by
+// BitwardenItemAssignment.Validate if the designated constraints aren't met.
+type BitwardenItemAssignmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BitwardenItemAssignmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BitwardenItemAssignmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BitwardenItemAssignmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BitwardenItemAssignmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BitwardenItemAssignmentValidationError) ErrorName() string {
+	return "BitwardenItemAssignmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BitwardenItemAssignmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBitwardenItemAssignment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BitwardenItemAssignmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BitwardenItemAssignmentValidationError{}
@@ -0,0 +1,1123 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/collection.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Collection entity
+type Collection struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId    uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// ID of the collection in the system it was imported from
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// Number of secrets in the collection
+	SecretCount   int32                  `protobuf:"varint,6,opt,name=secret_count,json=secretCount,proto3" json:"secret_count,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	CreatedBy     *uint32                `protobuf:"varint,9,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Collection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Collection) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Collection) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *Collection) GetSecretCount() int32 {
+	if x != nil {
+		return x.SecretCount
+	}
+	return 0
+}
+
+func (x *Collection) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Collection) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Collection) GetCreatedBy() uint32 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+type CreateCollectionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Secrets to put in the collection right away
+	SecretIds     []string `protobuf:"bytes,3,rep,name=secret_ids,json=secretIds,proto3" json:"secret_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetSecretIds() []string {
+	if x != nil {
+		return x.SecretIds
+	}
+	return nil
+}
+
+type CreateCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionResponse) Reset() {
+	*x = CreateCollectionResponse{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionResponse) ProtoMessage() {}
+
+func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type GetCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionRequest) Reset() {
+	*x = GetCollectionRequest{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionRequest) ProtoMessage() {}
+
+func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionResponse) Reset() {
+	*x = GetCollectionResponse{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionResponse) ProtoMessage() {}
+
+func (x *GetCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type ListCollectionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only collections whose name contains this
+	NameFilter *string `protobuf:"bytes,1,opt,name=name_filter,json=nameFilter,proto3,oneof" json:"name_filter,omitempty"`
+	// Only collections containing this secret
+	SecretId      *string `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3,oneof" json:"secret_id,omitempty"`
+	Page          *uint32 `protobuf:"varint,3,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCollectionsRequest) GetNameFilter() string {
+	if x != nil && x.NameFilter != nil {
+		return *x.NameFilter
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetSecretId() string {
+	if x != nil && x.SecretId != nil {
+		return *x.SecretId
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ListCollectionsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type UpdateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionRequest) ProtoMessage() {}
+
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type UpdateCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionResponse) Reset() {
+	*x = UpdateCollectionResponse{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionResponse) ProtoMessage() {}
+
+func (x *UpdateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AddSecretsToCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SecretIds     []string               `protobuf:"bytes,2,rep,name=secret_ids,json=secretIds,proto3" json:"secret_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSecretsToCollectionRequest) Reset() {
+	*x = AddSecretsToCollectionRequest{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSecretsToCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSecretsToCollectionRequest) ProtoMessage() {}
+
+func (x *AddSecretsToCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSecretsToCollectionRequest.ProtoReflect.Descriptor instead.
+func (*AddSecretsToCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddSecretsToCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddSecretsToCollectionRequest) GetSecretIds() []string {
+	if x != nil {
+		return x.SecretIds
+	}
+	return nil
+}
+
+type AddSecretsToCollectionResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Collection *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// Secrets that were already in the collection
+	AlreadyPresent int32 `protobuf:"varint,2,opt,name=already_present,json=alreadyPresent,proto3" json:"already_present,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddSecretsToCollectionResponse) Reset() {
+	*x = AddSecretsToCollectionResponse{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSecretsToCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSecretsToCollectionResponse) ProtoMessage() {}
+
+func (x *AddSecretsToCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSecretsToCollectionResponse.ProtoReflect.Descriptor instead.
+func (*AddSecretsToCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddSecretsToCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *AddSecretsToCollectionResponse) GetAlreadyPresent() int32 {
+	if x != nil {
+		return x.AlreadyPresent
+	}
+	return 0
+}
+
+type RemoveSecretsFromCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SecretIds     []string               `protobuf:"bytes,2,rep,name=secret_ids,json=secretIds,proto3" json:"secret_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSecretsFromCollectionRequest) Reset() {
+	*x = RemoveSecretsFromCollectionRequest{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSecretsFromCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSecretsFromCollectionRequest) ProtoMessage() {}
+
+func (x *RemoveSecretsFromCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSecretsFromCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSecretsFromCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveSecretsFromCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveSecretsFromCollectionRequest) GetSecretIds() []string {
+	if x != nil {
+		return x.SecretIds
+	}
+	return nil
+}
+
+type RemoveSecretsFromCollectionResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Collection *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// Secrets removed (ones not in the collection are ignored)
+	Removed       int32 `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSecretsFromCollectionResponse) Reset() {
+	*x = RemoveSecretsFromCollectionResponse{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSecretsFromCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSecretsFromCollectionResponse) ProtoMessage() {}
+
+func (x *RemoveSecretsFromCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSecretsFromCollectionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSecretsFromCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveSecretsFromCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *RemoveSecretsFromCollectionResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type ListCollectionSecretsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page          *uint32                `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32                `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionSecretsRequest) Reset() {
+	*x = ListCollectionSecretsRequest{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionSecretsRequest) ProtoMessage() {}
+
+func (x *ListCollectionSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCollectionSecretsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListCollectionSecretsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListCollectionSecretsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListCollectionSecretsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Secrets of the collection the caller can read
+	Secrets []*Secret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// Secrets in the collection, readable or not
+	Total         uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionSecretsResponse) Reset() {
+	*x = ListCollectionSecretsResponse{}
+	mi := &file_warden_service_v1_collection_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionSecretsResponse) ProtoMessage() {}
+
+func (x *ListCollectionSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_collection_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_collection_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListCollectionSecretsResponse) GetSecrets() []*Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ListCollectionSecretsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_warden_service_v1_collection_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_collection_proto_rawDesc = "" +
+	"\n" +
+	"\"warden/service/v1/collection.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ewarden/service/v1/secret.proto\"\xe0\x02\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vexternal_id\x18\x05 \x01(\tR\n" +
+	"externalId\x12!\n" +
+	"\fsecret_count\x18\x06 \x01(\x05R\vsecretCount\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\"\n" +
+	"\n" +
+	"created_by\x18\t \x01(\rH\x00R\tcreatedBy\x88\x01\x01B\r\n" +
+	"\v_created_by\"\x9a\x01\n" +
+	"\x17CreateCollectionRequest\x12!\n" +
+	"\x04name\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\vdescription\x120\n" +
+	"\n" +
+	"secret_ids\x18\x03 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10\xe8\a\"\x06r\x04\x10\x01\x18$R\tsecretIds\"Y\n" +
+	"\x18CreateCollectionResponse\x12=\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x1d.warden.service.v1.CollectionR\n" +
+	"collection\"F\n" +
+	"\x14GetCollectionRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"V\n" +
+	"\x15GetCollectionResponse\x12=\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x1d.warden.service.v1.CollectionR\n" +
+	"collection\"\xec\x01\n" +
+	"\x16ListCollectionsRequest\x12.\n" +
+	"\vname_filter\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x00R\n" +
+	"nameFilter\x88\x01\x01\x12)\n" +
+	"\tsecret_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18$H\x01R\bsecretId\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x03 \x01(\rH\x02R\x04page\x88\x01\x01\x12)\n" +
+	"\tpage_size\x18\x04 \x01(\rB\a\xbaH\x04*\x02\x18dH\x03R\bpageSize\x88\x01\x01B\x0e\n" +
+	"\f_name_filterB\f\n" +
+	"\n" +
+	"_secret_idB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"p\n" +
+	"\x17ListCollectionsResponse\x12?\n" +
+	"\vcollections\x18\x01 \x03(\v2\x1d.warden.service.v1.CollectionR\vcollections\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xb8\x01\n" +
+	"\x17UpdateCollectionRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12#\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\"Y\n" +
+	"\x18UpdateCollectionResponse\x12=\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x1d.warden.service.v1.CollectionR\n" +
+	"collection\"I\n" +
+	"\x17DeleteCollectionRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"\x83\x01\n" +
+	"\x1dAddSecretsToCollectionRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x122\n" +
+	"\n" +
+	"secret_ids\x18\x02 \x03(\tB\x13\xbaH\x10\x92\x01\r\b\x01\x10\xe8\a\"\x06r\x04\x10\x01\x18$R\tsecretIds\"\x88\x01\n" +
+	"\x1eAddSecretsToCollectionResponse\x12=\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x1d.warden.service.v1.CollectionR\n" +
+	"collection\x12'\n" +
+	"\x0falready_present\x18\x02 \x01(\x05R\x0ealreadyPresent\"\x88\x01\n" +
+	"\"RemoveSecretsFromCollectionRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x122\n" +
+	"\n" +
+	"secret_ids\x18\x02 \x03(\tB\x13\xbaH\x10\x92\x01\r\b\x01\x10\xe8\a\"\x06r\x04\x10\x01\x18$R\tsecretIds\"~\n" +
+	"#RemoveSecretsFromCollectionResponse\x12=\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x1d.warden.service.v1.CollectionR\n" +
+	"collection\x12\x18\n" +
+	"\aremoved\x18\x02 \x01(\x05R\aremoved\"\xa9\x01\n" +
+	"\x1cListCollectionSecretsRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\rH\x00R\x04page\x88\x01\x01\x12)\n" +
+	"\tpage_size\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18dH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"j\n" +
+	"\x1dListCollectionSecretsResponse\x123\n" +
+	"\asecrets\x18\x01 \x03(\v2\x19.warden.service.v1.SecretR\asecrets\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total2\xba\t\n" +
+	"\x17WardenCollectionService\x12\x87\x01\n" +
+	"\x10CreateCollection\x12*.warden.service.v1.CreateCollectionRequest\x1a+.warden.service.v1.CreateCollectionResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/collections\x12\x80\x01\n" +
+	"\rGetCollection\x12'.warden.service.v1.GetCollectionRequest\x1a(.warden.service.v1.GetCollectionResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/collections/{id}\x12\x81\x01\n" +
+	"\x0fListCollections\x12).warden.service.v1.ListCollectionsRequest\x1a*.warden.service.v1.ListCollectionsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/collections\x12\x8c\x01\n" +
+	"\x10UpdateCollection\x12*.warden.service.v1.UpdateCollectionRequest\x1a+.warden.service.v1.UpdateCollectionResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/collections/{id}\x12t\n" +
+	"\x10DeleteCollection\x12*.warden.service.v1.DeleteCollectionRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/collections/{id}\x12\xa6\x01\n" +
+	"\x16AddSecretsToCollection\x120.warden.service.v1.AddSecretsToCollectionRequest\x1a1.warden.service.v1.AddSecretsToCollectionResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/collections/{id}/secrets\x12\xbc\x01\n" +
+	"\x1bRemoveSecretsFromCollection\x125.warden.service.v1.RemoveSecretsFromCollectionRequest\x1a6.warden.service.v1.RemoveSecretsFromCollectionResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/collections/{id}/secrets:remove\x12\xa0\x01\n" +
+	"\x15ListCollectionSecrets\x12/.warden.service.v1.ListCollectionSecretsRequest\x1a0.warden.service.v1.ListCollectionSecretsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/collections/{id}/secretsB\xd7\x01\n" +
+	"\x15com.warden.service.v1B\x0fCollectionProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_collection_proto_rawDescOnce sync.Once
+	file_warden_service_v1_collection_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_collection_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_collection_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_collection_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_collection_proto_rawDesc), len(file_warden_service_v1_collection_proto_rawDesc)))
+	})
+	return file_warden_service_v1_collection_proto_rawDescData
+}
+
+var file_warden_service_v1_collection_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_warden_service_v1_collection_proto_goTypes = []any{
+	(*Collection)(nil),                          // 0: warden.service.v1.Collection
+	(*CreateCollectionRequest)(nil),             // 1: warden.service.v1.CreateCollectionRequest
+	(*CreateCollectionResponse)(nil),            // 2: warden.service.v1.CreateCollectionResponse
+	(*GetCollectionRequest)(nil),                // 3: warden.service.v1.GetCollectionRequest
+	(*GetCollectionResponse)(nil),               // 4: warden.service.v1.GetCollectionResponse
+	(*ListCollectionsRequest)(nil),              // 5: warden.service.v1.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),             // 6: warden.service.v1.ListCollectionsResponse
+	(*UpdateCollectionRequest)(nil),             // 7: warden.service.v1.UpdateCollectionRequest
+	(*UpdateCollectionResponse)(nil),            // 8: warden.service.v1.UpdateCollectionResponse
+	(*DeleteCollectionRequest)(nil),             // 9: warden.service.v1.DeleteCollectionRequest
+	(*AddSecretsToCollectionRequest)(nil),       // 10: warden.service.v1.AddSecretsToCollectionRequest
+	(*AddSecretsToCollectionResponse)(nil),      // 11: warden.service.v1.AddSecretsToCollectionResponse
+	(*RemoveSecretsFromCollectionRequest)(nil),  // 12: warden.service.v1.RemoveSecretsFromCollectionRequest
+	(*RemoveSecretsFromCollectionResponse)(nil), // 13: warden.service.v1.RemoveSecretsFromCollectionResponse
+	(*ListCollectionSecretsRequest)(nil),        // 14: warden.service.v1.ListCollectionSecretsRequest
+	(*ListCollectionSecretsResponse)(nil),       // 15: warden.service.v1.ListCollectionSecretsResponse
+	(*timestamppb.Timestamp)(nil),               // 16: google.protobuf.Timestamp
+	(*Secret)(nil),                              // 17: warden.service.v1.Secret
+	(*emptypb.Empty)(nil),                       // 18: google.protobuf.Empty
+}
+var file_warden_service_v1_collection_proto_depIdxs = []int32{
+	16, // 0: warden.service.v1.Collection.create_time:type_name -> google.protobuf.Timestamp
+	16, // 1: warden.service.v1.Collection.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: warden.service.v1.CreateCollectionResponse.collection:type_name -> warden.service.v1.Collection
+	0,  // 3: warden.service.v1.GetCollectionResponse.collection:type_name -> warden.service.v1.Collection
+	0,  // 4: warden.service.v1.ListCollectionsResponse.collections:type_name -> warden.service.v1.Collection
+	0,  // 5: warden.service.v1.UpdateCollectionResponse.collection:type_name -> warden.service.v1.Collection
+	0,  // 6: warden.service.v1.AddSecretsToCollectionResponse.collection:type_name -> warden.service.v1.Collection
+	0,  // 7: warden.service.v1.RemoveSecretsFromCollectionResponse.collection:type_name -> warden.service.v1.Collection
+	17, // 8: warden.service.v1.ListCollectionSecretsResponse.secrets:type_name -> warden.service.v1.Secret
+	1,  // 9: warden.service.v1.WardenCollectionService.CreateCollection:input_type -> warden.service.v1.CreateCollectionRequest
+	3,  // 10: warden.service.v1.WardenCollectionService.GetCollection:input_type -> warden.service.v1.GetCollectionRequest
+	5,  // 11: warden.service.v1.WardenCollectionService.ListCollections:input_type -> warden.service.v1.ListCollectionsRequest
+	7,  // 12: warden.service.v1.WardenCollectionService.UpdateCollection:input_type -> warden.service.v1.UpdateCollectionRequest
+	9,  // 13: warden.service.v1.WardenCollectionService.DeleteCollection:input_type -> warden.service.v1.DeleteCollectionRequest
+	10, // 14: warden.service.v1.WardenCollectionService.AddSecretsToCollection:input_type -> warden.service.v1.AddSecretsToCollectionRequest
+	12, // 15: warden.service.v1.WardenCollectionService.RemoveSecretsFromCollection:input_type -> warden.service.v1.RemoveSecretsFromCollectionRequest
+	14, // 16: warden.service.v1.WardenCollectionService.ListCollectionSecrets:input_type -> warden.service.v1.ListCollectionSecretsRequest
+	2,  // 17: warden.service.v1.WardenCollectionService.CreateCollection:output_type -> warden.service.v1.CreateCollectionResponse
+	4,  // 18: warden.service.v1.WardenCollectionService.GetCollection:output_type -> warden.service.v1.GetCollectionResponse
+	6,  // 19: warden.service.v1.WardenCollectionService.ListCollections:output_type -> warden.service.v1.ListCollectionsResponse
+	8,  // 20: warden.service.v1.WardenCollectionService.UpdateCollection:output_type -> warden.service.v1.UpdateCollectionResponse
+	18, // 21: warden.service.v1.WardenCollectionService.DeleteCollection:output_type -> google.protobuf.Empty
+	11, // 22: warden.service.v1.WardenCollectionService.AddSecretsToCollection:output_type -> warden.service.v1.AddSecretsToCollectionResponse
+	13, // 23: warden.service.v1.WardenCollectionService.RemoveSecretsFromCollection:output_type -> warden.service.v1.RemoveSecretsFromCollectionResponse
+	15, // 24: warden.service.v1.WardenCollectionService.ListCollectionSecrets:output_type -> warden.service.v1.ListCollectionSecretsResponse
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_collection_proto_init() }
+func file_warden_service_v1_collection_proto_init() {
+	if File_warden_service_v1_collection_proto != nil {
+		return
+	}
+	file_warden_service_v1_secret_proto_init()
+	file_warden_service_v1_collection_proto_msgTypes[0].OneofWrappers = []any{}
+	file_warden_service_v1_collection_proto_msgTypes[5].OneofWrappers = []any{}
+	file_warden_service_v1_collection_proto_msgTypes[7].OneofWrappers = []any{}
+	file_warden_service_v1_collection_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_collection_proto_rawDesc), len(file_warden_service_v1_collection_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_collection_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_collection_proto_depIdxs,
+		MessageInfos:      file_warden_service_v1_collection_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_collection_proto = out.File
+	file_warden_service_v1_collection_proto_goTypes = nil
+	file_warden_service_v1_collection_proto_depIdxs = nil
+}
@@ -0,0 +1,341 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/collection.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	annotations "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ annotations.FieldBehavior
+	_ emptypb.Empty
+	_ timestamppb.Timestamp
+)
+
+// RegisterRedactedWardenCollectionServiceServer wraps the WardenCollectionServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenCollectionServiceServer(s grpc.ServiceRegistrar, srv WardenCollectionServiceServer, bypass redact.Bypass) {
+	RegisterWardenCollectionServiceServer(s, RedactedWardenCollectionServiceServer(srv, bypass))
+}
+
+func RedactedWardenCollectionServiceServer(srv WardenCollectionServiceServer, bypass redact.Bypass) WardenCollectionServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenCollectionServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenCollectionServiceServer struct {
+	UnsafeWardenCollectionServiceServer
+	srv    WardenCollectionServiceServer
+	bypass redact.Bypass
+}
+
+// CreateCollection is the redacted wrapper for the actual WardenCollectionServiceServer.CreateCollection method
+// Unary RPC
+func (s *redactedWardenCollectionServiceServer) CreateCollection(ctx context.Context, in *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	res, err := s.srv.CreateCollection(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetCollection is the redacted wrapper for the actual WardenCollectionServiceServer.GetCollection method
+// Unary RPC
+func (s *redactedWardenCollectionServiceServer) GetCollection(ctx context.Context, in *GetCollectionRequest) (*GetCollectionResponse, error) {
+	res, err := s.srv.GetCollection(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListCollections is the redacted wrapper for the actual WardenCollectionServiceServer.ListCollections method
+// Unary RPC
+func (s *redactedWardenCollectionServiceServer) ListCollections(ctx context.Context, in *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	res, err := s.srv.ListCollections(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// UpdateCollection is the redacted wrapper for the actual WardenCollectionServiceServer.UpdateCollection method
+// Unary RPC
+func (s *redactedWardenCollectionServiceServer) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
+	res, err := s.srv.UpdateCollection(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// DeleteCollection is the redacted wrapper for the actual WardenCollectionServiceServer.DeleteCollection method
+// Unary RPC
+func (s *redactedWardenCollectionServiceServer) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.DeleteCollection(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// AddSecretsToCollection is the redacted wrapper for the actual WardenCollectionServiceServer.AddSecretsToCollection method
+// Unary RPC
+func (s *redactedWardenCollectionServiceServer) AddSecretsToCollection(ctx context.Context, in *AddSecretsToCollectionRequest) (*AddSecretsToCollectionResponse, error) {
+	res, err := s.srv.AddSecretsToCollection(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RemoveSecretsFromCollection is the redacted wrapper for the actual WardenCollectionServiceServer.RemoveSecretsFromCollection method
+// Unary RPC
+func (s *redactedWardenCollectionServiceServer) RemoveSecretsFromCollection(ctx context.Context, in *RemoveSecretsFromCollectionRequest) (*RemoveSecretsFromCollectionResponse, error) {
+	res, err := s.srv.RemoveSecretsFromCollection(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListCollectionSecrets is the redacted wrapper for the actual WardenCollectionServiceServer.ListCollectionSecrets method
+// Unary RPC
+func (s *redactedWardenCollectionServiceServer) ListCollectionSecrets(ctx context.Context, in *ListCollectionSecretsRequest) (*ListCollectionSecretsResponse, error) {
+	res, err := s.srv.ListCollectionSecrets(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for Collection
+func (x *Collection) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TenantId
+
+	// Safe field: Name
+
+	// Safe field: Description
+
+	// Safe field: ExternalId
+
+	// Safe field: SecretCount
+
+	// Safe field: CreateTime
+
+	// Safe field: UpdateTime
+
+	// Safe field: CreatedBy
+	return x.String()
+}
+
+// Redact method implementation for CreateCollectionRequest
+func (x *CreateCollectionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Name
+
+	// Safe field: Description
+
+	// Safe field: SecretIds
+	return x.String()
+}
+
+// Redact method implementation for CreateCollectionResponse
+func (x *CreateCollectionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Collection
+	return x.String()
+}
+
+// Redact method implementation for GetCollectionRequest
+func (x *GetCollectionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for GetCollectionResponse
+func (x *GetCollectionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Collection
+	return x.String()
+}
+
+// Redact method implementation for ListCollectionsRequest
+func (x *ListCollectionsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: NameFilter
+
+	// Safe field: SecretId
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListCollectionsResponse
+func (x *ListCollectionsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Collections
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for UpdateCollectionRequest
+func (x *UpdateCollectionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Name
+
+	// Safe field: Description
+	return x.String()
+}
+
+// Redact method implementation for UpdateCollectionResponse
+func (x *UpdateCollectionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Collection
+	return x.String()
+}
+
+// Redact method implementation for DeleteCollectionRequest
+func (x *DeleteCollectionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for AddSecretsToCollectionRequest
+func (x *AddSecretsToCollectionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: SecretIds
+	return x.String()
+}
+
+// Redact method implementation for AddSecretsToCollectionResponse
+func (x *AddSecretsToCollectionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Collection
+
+	// Safe field: AlreadyPresent
+	return x.String()
+}
+
+// Redact method implementation for RemoveSecretsFromCollectionRequest
+func (x *RemoveSecretsFromCollectionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: SecretIds
+	return x.String()
+}
+
+// Redact method implementation for RemoveSecretsFromCollectionResponse
+func (x *RemoveSecretsFromCollectionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Collection
+
+	// Safe field: Removed
+	return x.String()
+}
+
+// Redact method implementation for ListCollectionSecretsRequest
+func (x *ListCollectionSecretsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListCollectionSecretsResponse
+func (x *ListCollectionSecretsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Secrets
+
+	// Safe field: Total
+	return x.String()
+}
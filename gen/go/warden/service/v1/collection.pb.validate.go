@@ -0,0 +1,2018 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/collection.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Collection with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Collection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Collection with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CollectionMultiError, or
+// nil if none found.
+func (m *Collection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Collection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for ExternalId
+
+	// no validation rules for SecretCount
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if len(errors) > 0 {
+		return CollectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionMultiError is an error wrapping multiple validation errors
+// returned by Collection.ValidateAll() if the designated constraints aren't met.
+type CollectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionMultiError) AllErrors() []error { return m }
+
+// CollectionValidationError is the validation error returned by
+// Collection.Validate if the designated constraints aren't met.
+type CollectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionValidationError) ErrorName() string { return "CollectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CollectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionValidationError{}
+
+// Validate checks the field values on CreateCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCollectionRequestMultiError, or nil if none found.
+func (m *CreateCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return CreateCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCollectionRequestMultiError) AllErrors() []error { return m }
+
+// CreateCollectionRequestValidationError is the validation error returned by
+// CreateCollectionRequest.Validate if the designated constraints aren't met.
+type CreateCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCollectionRequestValidationError) ErrorName() string {
+	return "CreateCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCollectionRequestValidationError{}
+
+// Validate checks the field values on CreateCollectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCollectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCollectionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCollectionResponseMultiError, or nil if none found.
+func (m *CreateCollectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCollectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCollectionResponseValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCollectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCollectionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateCollectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCollectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCollectionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCollectionResponseMultiError) AllErrors() []error { return m }
+
+// CreateCollectionResponseValidationError is the validation error returned by
+// CreateCollectionResponse.Validate if the designated constraints aren't met.
+type CreateCollectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCollectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCollectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCollectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCollectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCollectionResponseValidationError) ErrorName() string {
+	return "CreateCollectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCollectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCollectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCollectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCollectionResponseValidationError{}
+
+// Validate checks the field values on GetCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCollectionRequestMultiError, or nil if none found.
+func (m *GetCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCollectionRequestMultiError) AllErrors() []error { return m }
+
+// GetCollectionRequestValidationError is the validation error returned by
+// GetCollectionRequest.Validate if the designated constraints aren't met.
+type GetCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCollectionRequestValidationError) ErrorName() string {
+	return "GetCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCollectionRequestValidationError{}
+
+// Validate checks the field values on GetCollectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCollectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCollectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCollectionResponseMultiError, or nil if none found.
+func (m *GetCollectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCollectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCollectionResponseValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCollectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCollectionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCollectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCollectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCollectionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCollectionResponseMultiError) AllErrors() []error { return m }
+
+// GetCollectionResponseValidationError is the validation error returned by
+// GetCollectionResponse.Validate if the designated constraints aren't met.
+type GetCollectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCollectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCollectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCollectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCollectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCollectionResponseValidationError) ErrorName() string {
+	return "GetCollectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCollectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCollectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCollectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCollectionResponseValidationError{}
+
+// Validate checks the field values on ListCollectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCollectionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCollectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCollectionsRequestMultiError, or nil if none found.
+func (m *ListCollectionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCollectionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.NameFilter != nil {
+		// no validation rules for NameFilter
+	}
+
+	if m.SecretId != nil {
+		// no validation rules for SecretId
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return ListCollectionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCollectionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCollectionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCollectionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCollectionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCollectionsRequestMultiError) AllErrors() []error { return m }
+
+// ListCollectionsRequestValidationError is the validation error returned by
+// ListCollectionsRequest.Validate if the designated constraints aren't met.
+type ListCollectionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCollectionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCollectionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCollectionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCollectionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCollectionsRequestValidationError) ErrorName() string {
+	return "ListCollectionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCollectionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCollectionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCollectionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCollectionsRequestValidationError{}
+
+// Validate checks the field values on ListCollectionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCollectionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCollectionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCollectionsResponseMultiError, or nil if none found.
+func (m *ListCollectionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCollectionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCollections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCollectionsResponseValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCollectionsResponseValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCollectionsResponseValidationError{
+					field:  fmt.Sprintf("Collections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListCollectionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCollectionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCollectionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCollectionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCollectionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCollectionsResponseMultiError) AllErrors() []error { return m }
+
+// ListCollectionsResponseValidationError is the validation error returned by
+// ListCollectionsResponse.Validate if the designated constraints aren't met.
+type ListCollectionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCollectionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCollectionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCollectionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCollectionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCollectionsResponseValidationError) ErrorName() string {
+	return "ListCollectionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCollectionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCollectionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCollectionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCollectionsResponseValidationError{}
+
+// Validate checks the field values on UpdateCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCollectionRequestMultiError, or nil if none found.
+func (m *UpdateCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if len(errors) > 0 {
+		return UpdateCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCollectionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCollectionRequestValidationError is the validation error returned by
+// UpdateCollectionRequest.Validate if the designated constraints aren't met.
+type UpdateCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCollectionRequestValidationError) ErrorName() string {
+	return "UpdateCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCollectionRequestValidationError{}
+
+// Validate checks the field values on UpdateCollectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCollectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCollectionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCollectionResponseMultiError, or nil if none found.
+func (m *UpdateCollectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCollectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCollectionResponseValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCollectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCollectionResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateCollectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCollectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCollectionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCollectionResponseMultiError) AllErrors() []error { return m }
+
+// UpdateCollectionResponseValidationError is the validation error returned by
+// UpdateCollectionResponse.Validate if the designated constraints aren't met.
+type UpdateCollectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCollectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCollectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCollectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCollectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCollectionResponseValidationError) ErrorName() string {
+	return "UpdateCollectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCollectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCollectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCollectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCollectionResponseValidationError{}
+
+// Validate checks the field values on DeleteCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCollectionRequestMultiError, or nil if none found.
+func (m *DeleteCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCollectionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCollectionRequestValidationError is the validation error returned by
+// DeleteCollectionRequest.Validate if the designated constraints aren't met.
+type DeleteCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCollectionRequestValidationError) ErrorName() string {
+	return "DeleteCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCollectionRequestValidationError{}
+
+// Validate checks the field values on AddSecretsToCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddSecretsToCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddSecretsToCollectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddSecretsToCollectionRequestMultiError, or nil if none found.
+func (m *AddSecretsToCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddSecretsToCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AddSecretsToCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddSecretsToCollectionRequestMultiError is an error wrapping multiple
+// validation errors returned by AddSecretsToCollectionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AddSecretsToCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddSecretsToCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddSecretsToCollectionRequestMultiError) AllErrors() []error { return m }
+
+// AddSecretsToCollectionRequestValidationError is the validation error
+// returned by AddSecretsToCollectionRequest.Validate if the designated
+// constraints aren't met.
+type AddSecretsToCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddSecretsToCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddSecretsToCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddSecretsToCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddSecretsToCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddSecretsToCollectionRequestValidationError) ErrorName() string {
+	return "AddSecretsToCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddSecretsToCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddSecretsToCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddSecretsToCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddSecretsToCollectionRequestValidationError{}
+
+// Validate checks the field values on AddSecretsToCollectionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddSecretsToCollectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddSecretsToCollectionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddSecretsToCollectionResponseMultiError, or nil if none found.
+func (m *AddSecretsToCollectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddSecretsToCollectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddSecretsToCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddSecretsToCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddSecretsToCollectionResponseValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AlreadyPresent
+
+	if len(errors) > 0 {
+		return AddSecretsToCollectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddSecretsToCollectionResponseMultiError is an error wrapping multiple
+// validation errors returned by AddSecretsToCollectionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AddSecretsToCollectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddSecretsToCollectionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddSecretsToCollectionResponseMultiError) AllErrors() []error { return m }
+
+// AddSecretsToCollectionResponseValidationError is the validation error
+// returned by AddSecretsToCollectionResponse.Validate if the designated
+// constraints aren't met.
+type AddSecretsToCollectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddSecretsToCollectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddSecretsToCollectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddSecretsToCollectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddSecretsToCollectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddSecretsToCollectionResponseValidationError) ErrorName() string {
+	return "AddSecretsToCollectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddSecretsToCollectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddSecretsToCollectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddSecretsToCollectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddSecretsToCollectionResponseValidationError{}
+
+// Validate checks the field values on RemoveSecretsFromCollectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveSecretsFromCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveSecretsFromCollectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveSecretsFromCollectionRequestMultiError, or nil if none found.
+func (m *RemoveSecretsFromCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveSecretsFromCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RemoveSecretsFromCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveSecretsFromCollectionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveSecretsFromCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveSecretsFromCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveSecretsFromCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveSecretsFromCollectionRequestMultiError) AllErrors() []error { return m }
+
+// RemoveSecretsFromCollectionRequestValidationError is the validation error
+// returned by RemoveSecretsFromCollectionRequest.Validate if the designated
+// constraints aren't met.
+type RemoveSecretsFromCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveSecretsFromCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveSecretsFromCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveSecretsFromCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveSecretsFromCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveSecretsFromCollectionRequestValidationError) ErrorName() string {
+	return "RemoveSecretsFromCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveSecretsFromCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveSecretsFromCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveSecretsFromCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveSecretsFromCollectionRequestValidationError{}
+
+// Validate checks the field values on RemoveSecretsFromCollectionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveSecretsFromCollectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveSecretsFromCollectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveSecretsFromCollectionResponseMultiError, or nil if none found.
+func (m *RemoveSecretsFromCollectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveSecretsFromCollectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveSecretsFromCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveSecretsFromCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveSecretsFromCollectionResponseValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Removed
+
+	if len(errors) > 0 {
+		return RemoveSecretsFromCollectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveSecretsFromCollectionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveSecretsFromCollectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveSecretsFromCollectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveSecretsFromCollectionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveSecretsFromCollectionResponseMultiError) AllErrors() []error { return m }
+
+// RemoveSecretsFromCollectionResponseValidationError is the validation error
+// returned by RemoveSecretsFromCollectionResponse.Validate if the designated
+// constraints aren't met.
+type RemoveSecretsFromCollectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveSecretsFromCollectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveSecretsFromCollectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveSecretsFromCollectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveSecretsFromCollectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveSecretsFromCollectionResponseValidationError) ErrorName() string {
+	return "RemoveSecretsFromCollectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveSecretsFromCollectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveSecretsFromCollectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveSecretsFromCollectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveSecretsFromCollectionResponseValidationError{}
+
+// Validate checks the field values on ListCollectionSecretsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCollectionSecretsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCollectionSecretsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCollectionSecretsRequestMultiError, or nil if none found.
+func (m *ListCollectionSecretsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCollectionSecretsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return ListCollectionSecretsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCollectionSecretsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListCollectionSecretsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListCollectionSecretsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCollectionSecretsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCollectionSecretsRequestMultiError) AllErrors() []error { return m }
+
+// ListCollectionSecretsRequestValidationError is the validation error returned
+// by ListCollectionSecretsRequest.Validate if the designated constraints
+// aren't met.
+type ListCollectionSecretsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCollectionSecretsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCollectionSecretsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCollectionSecretsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCollectionSecretsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCollectionSecretsRequestValidationError) ErrorName() string {
+	return "ListCollectionSecretsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCollectionSecretsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCollectionSecretsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCollectionSecretsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCollectionSecretsRequestValidationError{}
+
+// Validate checks the field values on ListCollectionSecretsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCollectionSecretsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCollectionSecretsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListCollectionSecretsResponseMultiError, or nil if none found.
+func (m *ListCollectionSecretsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCollectionSecretsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCollectionSecretsResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCollectionSecretsResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCollectionSecretsResponseValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListCollectionSecretsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCollectionSecretsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListCollectionSecretsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListCollectionSecretsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCollectionSecretsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCollectionSecretsResponseMultiError) AllErrors() []error { return m }
+
+// ListCollectionSecretsResponseValidationError is the validation error
+// returned by ListCollectionSecretsResponse.Validate if the designated
+// constraints aren't met.
+type ListCollectionSecretsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCollectionSecretsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCollectionSecretsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCollectionSecretsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCollectionSecretsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCollectionSecretsResponseValidationError) ErrorName() string {
+	return "ListCollectionSecretsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCollectionSecretsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCollectionSecretsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCollectionSecretsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCollectionSecretsResponseValidationError{}
@@ -0,0 +1,413 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/collection.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenCollectionService_CreateCollection_FullMethodName            = "/warden.service.v1.WardenCollectionService/CreateCollection"
+	WardenCollectionService_GetCollection_FullMethodName               = "/warden.service.v1.WardenCollectionService/GetCollection"
+	WardenCollectionService_ListCollections_FullMethodName             = "/warden.service.v1.WardenCollectionService/ListCollections"
+	WardenCollectionService_UpdateCollection_FullMethodName            = "/warden.service.v1.WardenCollectionService/UpdateCollection"
+	WardenCollectionService_DeleteCollection_FullMethodName            = "/warden.service.v1.WardenCollectionService/DeleteCollection"
+	WardenCollectionService_AddSecretsToCollection_FullMethodName      = "/warden.service.v1.WardenCollectionService/AddSecretsToCollection"
+	WardenCollectionService_RemoveSecretsFromCollection_FullMethodName = "/warden.service.v1.WardenCollectionService/RemoveSecretsFromCollection"
+	WardenCollectionService_ListCollectionSecrets_FullMethodName       = "/warden.service.v1.WardenCollectionService/ListCollectionSecrets"
+)
+
+// WardenCollectionServiceClient is the client API for WardenCollectionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Collection Service - flat, shareable groupings of secrets. A secret lives
+// in one folder but can be in any number of collections, and permissions
+// granted on a collection apply to every secret in it.
+type WardenCollectionServiceClient interface {
+	// Create a collection, owned by the caller
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
+	// Get a collection by ID
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error)
+	// List the collections the caller can read
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	// Rename a collection or change its description
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
+	// Delete a collection and its permissions. The secrets in it are kept.
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Add secrets to a collection
+	AddSecretsToCollection(ctx context.Context, in *AddSecretsToCollectionRequest, opts ...grpc.CallOption) (*AddSecretsToCollectionResponse, error)
+	// Remove secrets from a collection
+	RemoveSecretsFromCollection(ctx context.Context, in *RemoveSecretsFromCollectionRequest, opts ...grpc.CallOption) (*RemoveSecretsFromCollectionResponse, error)
+	// List the secrets in a collection
+	ListCollectionSecrets(ctx context.Context, in *ListCollectionSecretsRequest, opts ...grpc.CallOption) (*ListCollectionSecretsResponse, error)
+}
+
+type wardenCollectionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenCollectionServiceClient(cc grpc.ClientConnInterface) WardenCollectionServiceClient {
+	return &wardenCollectionServiceClient{cc}
+}
+
+func (c *wardenCollectionServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCollectionResponse)
+	err := c.cc.Invoke(ctx, WardenCollectionService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenCollectionServiceClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionResponse)
+	err := c.cc.Invoke(ctx, WardenCollectionService_GetCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenCollectionServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, WardenCollectionService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenCollectionServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCollectionResponse)
+	err := c.cc.Invoke(ctx, WardenCollectionService_UpdateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenCollectionServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WardenCollectionService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenCollectionServiceClient) AddSecretsToCollection(ctx context.Context, in *AddSecretsToCollectionRequest, opts ...grpc.CallOption) (*AddSecretsToCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddSecretsToCollectionResponse)
+	err := c.cc.Invoke(ctx, WardenCollectionService_AddSecretsToCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenCollectionServiceClient) RemoveSecretsFromCollection(ctx context.Context, in *RemoveSecretsFromCollectionRequest, opts ...grpc.CallOption) (*RemoveSecretsFromCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveSecretsFromCollectionResponse)
+	err := c.cc.Invoke(ctx, WardenCollectionService_RemoveSecretsFromCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenCollectionServiceClient) ListCollectionSecrets(ctx context.Context, in *ListCollectionSecretsRequest, opts ...grpc.CallOption) (*ListCollectionSecretsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionSecretsResponse)
+	err := c.cc.Invoke(ctx, WardenCollectionService_ListCollectionSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenCollectionServiceServer is the server API for WardenCollectionService service.
+// All implementations must embed UnimplementedWardenCollectionServiceServer
+// for forward compatibility.
+//
+// Collection Service - flat, shareable groupings of secrets. A secret lives
+// in one folder but can be in any number of collections, and permissions
+// granted on a collection apply to every secret in it.
+type WardenCollectionServiceServer interface {
+	// Create a collection, owned by the caller
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
+	// Get a collection by ID
+	GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error)
+	// List the collections the caller can read
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	// Rename a collection or change its description
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+	// Delete a collection and its permissions. The secrets in it are kept.
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error)
+	// Add secrets to a collection
+	AddSecretsToCollection(context.Context, *AddSecretsToCollectionRequest) (*AddSecretsToCollectionResponse, error)
+	// Remove secrets from a collection
+	RemoveSecretsFromCollection(context.Context, *RemoveSecretsFromCollectionRequest) (*RemoveSecretsFromCollectionResponse, error)
+	// List the secrets in a collection
+	ListCollectionSecrets(context.Context, *ListCollectionSecretsRequest) (*ListCollectionSecretsResponse, error)
+	mustEmbedUnimplementedWardenCollectionServiceServer()
+}
+
+// UnimplementedWardenCollectionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenCollectionServiceServer struct{}
+
+func (UnimplementedWardenCollectionServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedWardenCollectionServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedWardenCollectionServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedWardenCollectionServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedWardenCollectionServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedWardenCollectionServiceServer) AddSecretsToCollection(context.Context, *AddSecretsToCollectionRequest) (*AddSecretsToCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddSecretsToCollection not implemented")
+}
+func (UnimplementedWardenCollectionServiceServer) RemoveSecretsFromCollection(context.Context, *RemoveSecretsFromCollectionRequest) (*RemoveSecretsFromCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveSecretsFromCollection not implemented")
+}
+func (UnimplementedWardenCollectionServiceServer) ListCollectionSecrets(context.Context, *ListCollectionSecretsRequest) (*ListCollectionSecretsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollectionSecrets not implemented")
+}
+func (UnimplementedWardenCollectionServiceServer) mustEmbedUnimplementedWardenCollectionServiceServer() {
+}
+func (UnimplementedWardenCollectionServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWardenCollectionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenCollectionServiceServer will
+// result in compilation errors.
+type UnsafeWardenCollectionServiceServer interface {
+	mustEmbedUnimplementedWardenCollectionServiceServer()
+}
+
+func RegisterWardenCollectionServiceServer(s grpc.ServiceRegistrar, srv WardenCollectionServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenCollectionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenCollectionService_ServiceDesc, srv)
+}
+
+func _WardenCollectionService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenCollectionServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenCollectionService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenCollectionServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenCollectionService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenCollectionServiceServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenCollectionService_GetCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenCollectionServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenCollectionService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenCollectionServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenCollectionService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenCollectionServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenCollectionService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenCollectionServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenCollectionService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenCollectionServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenCollectionService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenCollectionServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenCollectionService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenCollectionServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenCollectionService_AddSecretsToCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSecretsToCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenCollectionServiceServer).AddSecretsToCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenCollectionService_AddSecretsToCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenCollectionServiceServer).AddSecretsToCollection(ctx, req.(*AddSecretsToCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenCollectionService_RemoveSecretsFromCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSecretsFromCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenCollectionServiceServer).RemoveSecretsFromCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenCollectionService_RemoveSecretsFromCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenCollectionServiceServer).RemoveSecretsFromCollection(ctx, req.(*RemoveSecretsFromCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenCollectionService_ListCollectionSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenCollectionServiceServer).ListCollectionSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenCollectionService_ListCollectionSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenCollectionServiceServer).ListCollectionSecrets(ctx, req.(*ListCollectionSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenCollectionService_ServiceDesc is the grpc.ServiceDesc for WardenCollectionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenCollectionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenCollectionService",
+	HandlerType: (*WardenCollectionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCollection",
+			Handler:    _WardenCollectionService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "GetCollection",
+			Handler:    _WardenCollectionService_GetCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _WardenCollectionService_ListCollections_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _WardenCollectionService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _WardenCollectionService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "AddSecretsToCollection",
+			Handler:    _WardenCollectionService_AddSecretsToCollection_Handler,
+		},
+		{
+			MethodName: "RemoveSecretsFromCollection",
+			Handler:    _WardenCollectionService_RemoveSecretsFromCollection_Handler,
+		},
+		{
+			MethodName: "ListCollectionSecrets",
+			Handler:    _WardenCollectionService_ListCollectionSecrets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/collection.proto",
+}
@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/collection.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenCollectionServiceAddSecretsToCollection = "/warden.service.v1.WardenCollectionService/AddSecretsToCollection"
+const OperationWardenCollectionServiceCreateCollection = "/warden.service.v1.WardenCollectionService/CreateCollection"
+const OperationWardenCollectionServiceDeleteCollection = "/warden.service.v1.WardenCollectionService/DeleteCollection"
+const OperationWardenCollectionServiceGetCollection = "/warden.service.v1.WardenCollectionService/GetCollection"
+const OperationWardenCollectionServiceListCollectionSecrets = "/warden.service.v1.WardenCollectionService/ListCollectionSecrets"
+const OperationWardenCollectionServiceListCollections = "/warden.service.v1.WardenCollectionService/ListCollections"
+const OperationWardenCollectionServiceRemoveSecretsFromCollection = "/warden.service.v1.WardenCollectionService/RemoveSecretsFromCollection"
+const OperationWardenCollectionServiceUpdateCollection = "/warden.service.v1.WardenCollectionService/UpdateCollection"
+
+type WardenCollectionServiceHTTPServer interface {
+	// AddSecretsToCollection Add secrets to a collection
+	AddSecretsToCollection(context.Context, *AddSecretsToCollectionRequest) (*AddSecretsToCollectionResponse, error)
+	// CreateCollection Create a collection, owned by the caller
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
+	// DeleteCollection Delete a collection and its permissions. The secrets in it are kept.
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error)
+	// GetCollection Get a collection by ID
+	GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error)
+	// ListCollectionSecrets List the secrets in a collection
+	ListCollectionSecrets(context.Context, *ListCollectionSecretsRequest) (*ListCollectionSecretsResponse, error)
+	// ListCollections List the collections the caller can read
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	// RemoveSecretsFromCollection Remove secrets from a collection
+	RemoveSecretsFromCollection(context.Context, *RemoveSecretsFromCollectionRequest) (*RemoveSecretsFromCollectionResponse, error)
+	// UpdateCollection Rename a collection or change its description
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+}
+
+func RegisterWardenCollectionServiceHTTPServer(s *http.Server, srv WardenCollectionServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/collections", _WardenCollectionService_CreateCollection0_HTTP_Handler(srv))
+	r.GET("/v1/collections/{id}", _WardenCollectionService_GetCollection0_HTTP_Handler(srv))
+	r.GET("/v1/collections", _WardenCollectionService_ListCollections0_HTTP_Handler(srv))
+	r.PUT("/v1/collections/{id}", _WardenCollectionService_UpdateCollection0_HTTP_Handler(srv))
+	r.DELETE("/v1/collections/{id}", _WardenCollectionService_DeleteCollection0_HTTP_Handler(srv))
+	r.POST("/v1/collections/{id}/secrets", _WardenCollectionService_AddSecretsToCollection0_HTTP_Handler(srv))
+	r.POST("/v1/collections/{id}/secrets:remove", _WardenCollectionService_RemoveSecretsFromCollection0_HTTP_Handler(srv))
+	r.GET("/v1/collections/{id}/secrets", _WardenCollectionService_ListCollectionSecrets0_HTTP_Handler(srv))
+}
+
+func _WardenCollectionService_CreateCollection0_HTTP_Handler(srv WardenCollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCollectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenCollectionServiceCreateCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCollection(ctx, req.(*CreateCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateCollectionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenCollectionService_GetCollection0_HTTP_Handler(srv WardenCollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCollectionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenCollectionServiceGetCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollection(ctx, req.(*GetCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCollectionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenCollectionService_ListCollections0_HTTP_Handler(srv WardenCollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCollectionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenCollectionServiceListCollections)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCollections(ctx, req.(*ListCollectionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCollectionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenCollectionService_UpdateCollection0_HTTP_Handler(srv WardenCollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCollectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenCollectionServiceUpdateCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateCollectionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenCollectionService_DeleteCollection0_HTTP_Handler(srv WardenCollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCollectionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenCollectionServiceDeleteCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenCollectionService_AddSecretsToCollection0_HTTP_Handler(srv WardenCollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddSecretsToCollectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenCollectionServiceAddSecretsToCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddSecretsToCollection(ctx, req.(*AddSecretsToCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddSecretsToCollectionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenCollectionService_RemoveSecretsFromCollection0_HTTP_Handler(srv WardenCollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveSecretsFromCollectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenCollectionServiceRemoveSecretsFromCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveSecretsFromCollection(ctx, req.(*RemoveSecretsFromCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveSecretsFromCollectionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenCollectionService_ListCollectionSecrets0_HTTP_Handler(srv WardenCollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCollectionSecretsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenCollectionServiceListCollectionSecrets)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCollectionSecrets(ctx, req.(*ListCollectionSecretsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCollectionSecretsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenCollectionServiceHTTPClient interface {
+	// AddSecretsToCollection Add secrets to a collection
+	AddSecretsToCollection(ctx context.Context, req *AddSecretsToCollectionRequest, opts ...http.CallOption) (rsp *AddSecretsToCollectionResponse, err error)
+	// CreateCollection Create a collection, owned by the caller
+	CreateCollection(ctx context.Context, req *CreateCollectionRequest, opts ...http.CallOption) (rsp *CreateCollectionResponse, err error)
+	// DeleteCollection Delete a collection and its permissions. The secrets in it are kept.
+	DeleteCollection(ctx context.Context, req *DeleteCollectionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// GetCollection Get a collection by ID
+	GetCollection(ctx context.Context, req *GetCollectionRequest, opts ...http.CallOption) (rsp *GetCollectionResponse, err error)
+	// ListCollectionSecrets List the secrets in a collection
+	ListCollectionSecrets(ctx context.Context, req *ListCollectionSecretsRequest, opts ...http.CallOption) (rsp *ListCollectionSecretsResponse, err error)
+	// ListCollections List the collections the caller can read
+	ListCollections(ctx context.Context, req *ListCollectionsRequest, opts ...http.CallOption) (rsp *ListCollectionsResponse, err error)
+	// RemoveSecretsFromCollection Remove secrets from a collection
+	RemoveSecretsFromCollection(ctx context.Context, req *RemoveSecretsFromCollectionRequest, opts ...http.CallOption) (rsp *RemoveSecretsFromCollectionResponse, err error)
+	// UpdateCollection Rename a collection or change its description
+	UpdateCollection(ctx context.Context, req *UpdateCollectionRequest, opts ...http.CallOption) (rsp *UpdateCollectionResponse, err error)
+}
+
+type WardenCollectionServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenCollectionServiceHTTPClient(client *http.Client) WardenCollectionServiceHTTPClient {
+	return &WardenCollectionServiceHTTPClientImpl{client}
+}
+
+// AddSecretsToCollection Add secrets to a collection
+func (c *WardenCollectionServiceHTTPClientImpl) AddSecretsToCollection(ctx context.Context, in *AddSecretsToCollectionRequest, opts ...http.CallOption) (*AddSecretsToCollectionResponse, error) {
+	var out AddSecretsToCollectionResponse
+	pattern := "/v1/collections/{id}/secrets"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenCollectionServiceAddSecretsToCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCollection Create a collection, owned by the caller
+func (c *WardenCollectionServiceHTTPClientImpl) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...http.CallOption) (*CreateCollectionResponse, error) {
+	var out CreateCollectionResponse
+	pattern := "/v1/collections"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenCollectionServiceCreateCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteCollection Delete a collection and its permissions. The secrets in it are kept.
+func (c *WardenCollectionServiceHTTPClientImpl) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/collections/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenCollectionServiceDeleteCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCollection Get a collection by ID
+func (c *WardenCollectionServiceHTTPClientImpl) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...http.CallOption) (*GetCollectionResponse, error) {
+	var out GetCollectionResponse
+	pattern := "/v1/collections/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenCollectionServiceGetCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCollectionSecrets List the secrets in a collection
+func (c *WardenCollectionServiceHTTPClientImpl) ListCollectionSecrets(ctx context.Context, in *ListCollectionSecretsRequest, opts ...http.CallOption) (*ListCollectionSecretsResponse, error) {
+	var out ListCollectionSecretsResponse
+	pattern := "/v1/collections/{id}/secrets"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenCollectionServiceListCollectionSecrets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCollections List the collections the caller can read
+func (c *WardenCollectionServiceHTTPClientImpl) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...http.CallOption) (*ListCollectionsResponse, error) {
+	var out ListCollectionsResponse
+	pattern := "/v1/collections"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenCollectionServiceListCollections))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveSecretsFromCollection Remove secrets from a collection
+func (c *WardenCollectionServiceHTTPClientImpl) RemoveSecretsFromCollection(ctx context.Context, in *RemoveSecretsFromCollectionRequest, opts ...http.CallOption) (*RemoveSecretsFromCollectionResponse, error) {
+	var out RemoveSecretsFromCollectionResponse
+	pattern := "/v1/collections/{id}/secrets:remove"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenCollectionServiceRemoveSecretsFromCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateCollection Rename a collection or change its description
+func (c *WardenCollectionServiceHTTPClientImpl) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...http.CallOption) (*UpdateCollectionResponse, error) {
+	var out UpdateCollectionResponse
+	pattern := "/v1/collections/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenCollectionServiceUpdateCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,593 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/config_export.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Letter case applied to generated keys
+type KeyCase int32
+
+const (
+	KeyCase_KEY_CASE_UNSPECIFIED KeyCase = 0 // Upper case for dotenv, unchanged for Kubernetes
+	KeyCase_KEY_CASE_UPPER       KeyCase = 1
+	KeyCase_KEY_CASE_LOWER       KeyCase = 2
+	KeyCase_KEY_CASE_PRESERVE    KeyCase = 3
+)
+
+// Enum value maps for KeyCase.
+var (
+	KeyCase_name = map[int32]string{
+		0: "KEY_CASE_UNSPECIFIED",
+		1: "KEY_CASE_UPPER",
+		2: "KEY_CASE_LOWER",
+		3: "KEY_CASE_PRESERVE",
+	}
+	KeyCase_value = map[string]int32{
+		"KEY_CASE_UNSPECIFIED": 0,
+		"KEY_CASE_UPPER":       1,
+		"KEY_CASE_LOWER":       2,
+		"KEY_CASE_PRESERVE":    3,
+	}
+)
+
+func (x KeyCase) Enum() *KeyCase {
+	p := new(KeyCase)
+	*p = x
+	return p
+}
+
+func (x KeyCase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyCase) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_config_export_proto_enumTypes[0].Descriptor()
+}
+
+func (KeyCase) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_config_export_proto_enumTypes[0]
+}
+
+func (x KeyCase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyCase.Descriptor instead.
+func (KeyCase) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_config_export_proto_rawDescGZIP(), []int{0}
+}
+
+// How secrets are turned into keys. Characters not allowed in the output
+// format are replaced with "_".
+type KeyNamingRules struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	KeyCase KeyCase                `protobuf:"varint,1,opt,name=key_case,json=keyCase,proto3,enum=warden.service.v1.KeyCase" json:"key_case,omitempty"`
+	// Prepended to every key, e.g. "APP_"
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Joins folder path segments, the secret name and the username suffix (default "_")
+	Separator *string `protobuf:"bytes,3,opt,name=separator,proto3,oneof" json:"separator,omitempty"`
+	// Prefix keys of secrets in subfolders with their path below the exported folder
+	IncludeFolderPath bool `protobuf:"varint,4,opt,name=include_folder_path,json=includeFolderPath,proto3" json:"include_folder_path,omitempty"`
+	// Metadata key whose string value, when present, replaces the secret name
+	NameMetadataKey string `protobuf:"bytes,5,opt,name=name_metadata_key,json=nameMetadataKey,proto3" json:"name_metadata_key,omitempty"`
+	// Also emit <key><separator>USERNAME for secrets with a username
+	IncludeUsername bool `protobuf:"varint,6,opt,name=include_username,json=includeUsername,proto3" json:"include_username,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *KeyNamingRules) Reset() {
+	*x = KeyNamingRules{}
+	mi := &file_warden_service_v1_config_export_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyNamingRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyNamingRules) ProtoMessage() {}
+
+func (x *KeyNamingRules) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_config_export_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyNamingRules.ProtoReflect.Descriptor instead.
+func (*KeyNamingRules) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_config_export_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KeyNamingRules) GetKeyCase() KeyCase {
+	if x != nil {
+		return x.KeyCase
+	}
+	return KeyCase_KEY_CASE_UNSPECIFIED
+}
+
+func (x *KeyNamingRules) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *KeyNamingRules) GetSeparator() string {
+	if x != nil && x.Separator != nil {
+		return *x.Separator
+	}
+	return ""
+}
+
+func (x *KeyNamingRules) GetIncludeFolderPath() bool {
+	if x != nil {
+		return x.IncludeFolderPath
+	}
+	return false
+}
+
+func (x *KeyNamingRules) GetNameMetadataKey() string {
+	if x != nil {
+		return x.NameMetadataKey
+	}
+	return ""
+}
+
+func (x *KeyNamingRules) GetIncludeUsername() bool {
+	if x != nil {
+		return x.IncludeUsername
+	}
+	return false
+}
+
+type ExportToEnvFileRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	FolderId string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	// Include secrets of subfolders
+	Recursive     bool            `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Naming        *KeyNamingRules `protobuf:"bytes,3,opt,name=naming,proto3" json:"naming,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportToEnvFileRequest) Reset() {
+	*x = ExportToEnvFileRequest{}
+	mi := &file_warden_service_v1_config_export_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportToEnvFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportToEnvFileRequest) ProtoMessage() {}
+
+func (x *ExportToEnvFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_config_export_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportToEnvFileRequest.ProtoReflect.Descriptor instead.
+func (*ExportToEnvFileRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_config_export_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportToEnvFileRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *ExportToEnvFileRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *ExportToEnvFileRequest) GetNaming() *KeyNamingRules {
+	if x != nil {
+		return x.Naming
+	}
+	return nil
+}
+
+type ExportToEnvFileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// KEY="value" lines, sorted by key
+	EnvData               string `protobuf:"bytes,1,opt,name=env_data,json=envData,proto3" json:"env_data,omitempty"`
+	ItemsExported         int32  `protobuf:"varint,2,opt,name=items_exported,json=itemsExported,proto3" json:"items_exported,omitempty"`
+	ItemsSkipped          int32  `protobuf:"varint,3,opt,name=items_skipped,json=itemsSkipped,proto3" json:"items_skipped,omitempty"`
+	ItemsExcludedByPolicy int32  `protobuf:"varint,4,opt,name=items_excluded_by_policy,json=itemsExcludedByPolicy,proto3" json:"items_excluded_by_policy,omitempty"`
+	// Keys produced by more than one secret; only the first is kept
+	DuplicateKeys     []string `protobuf:"bytes,5,rep,name=duplicate_keys,json=duplicateKeys,proto3" json:"duplicate_keys,omitempty"`
+	SuggestedFilename string   `protobuf:"bytes,6,opt,name=suggested_filename,json=suggestedFilename,proto3" json:"suggested_filename,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExportToEnvFileResponse) Reset() {
+	*x = ExportToEnvFileResponse{}
+	mi := &file_warden_service_v1_config_export_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportToEnvFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportToEnvFileResponse) ProtoMessage() {}
+
+func (x *ExportToEnvFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_config_export_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportToEnvFileResponse.ProtoReflect.Descriptor instead.
+func (*ExportToEnvFileResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_config_export_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportToEnvFileResponse) GetEnvData() string {
+	if x != nil {
+		return x.EnvData
+	}
+	return ""
+}
+
+func (x *ExportToEnvFileResponse) GetItemsExported() int32 {
+	if x != nil {
+		return x.ItemsExported
+	}
+	return 0
+}
+
+func (x *ExportToEnvFileResponse) GetItemsSkipped() int32 {
+	if x != nil {
+		return x.ItemsSkipped
+	}
+	return 0
+}
+
+func (x *ExportToEnvFileResponse) GetItemsExcludedByPolicy() int32 {
+	if x != nil {
+		return x.ItemsExcludedByPolicy
+	}
+	return 0
+}
+
+func (x *ExportToEnvFileResponse) GetDuplicateKeys() []string {
+	if x != nil {
+		return x.DuplicateKeys
+	}
+	return nil
+}
+
+func (x *ExportToEnvFileResponse) GetSuggestedFilename() string {
+	if x != nil {
+		return x.SuggestedFilename
+	}
+	return ""
+}
+
+type ExportToKubernetesSecretRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	FolderId string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	// Include secrets of subfolders
+	Recursive bool            `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Naming    *KeyNamingRules `protobuf:"bytes,3,opt,name=naming,proto3" json:"naming,omitempty"`
+	// metadata.name of the Secret (DNS subdomain)
+	SecretName string `protobuf:"bytes,4,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	// metadata.namespace (omitted when empty)
+	Namespace     string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportToKubernetesSecretRequest) Reset() {
+	*x = ExportToKubernetesSecretRequest{}
+	mi := &file_warden_service_v1_config_export_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportToKubernetesSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportToKubernetesSecretRequest) ProtoMessage() {}
+
+func (x *ExportToKubernetesSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_config_export_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportToKubernetesSecretRequest.ProtoReflect.Descriptor instead.
+func (*ExportToKubernetesSecretRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_config_export_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportToKubernetesSecretRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *ExportToKubernetesSecretRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *ExportToKubernetesSecretRequest) GetNaming() *KeyNamingRules {
+	if x != nil {
+		return x.Naming
+	}
+	return nil
+}
+
+func (x *ExportToKubernetesSecretRequest) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *ExportToKubernetesSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ExportToKubernetesSecretResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Secret manifest (YAML) with base64-encoded data
+	Manifest              string `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	ItemsExported         int32  `protobuf:"varint,2,opt,name=items_exported,json=itemsExported,proto3" json:"items_exported,omitempty"`
+	ItemsSkipped          int32  `protobuf:"varint,3,opt,name=items_skipped,json=itemsSkipped,proto3" json:"items_skipped,omitempty"`
+	ItemsExcludedByPolicy int32  `protobuf:"varint,4,opt,name=items_excluded_by_policy,json=itemsExcludedByPolicy,proto3" json:"items_excluded_by_policy,omitempty"`
+	// Keys produced by more than one secret; only the first is kept
+	DuplicateKeys     []string `protobuf:"bytes,5,rep,name=duplicate_keys,json=duplicateKeys,proto3" json:"duplicate_keys,omitempty"`
+	SuggestedFilename string   `protobuf:"bytes,6,opt,name=suggested_filename,json=suggestedFilename,proto3" json:"suggested_filename,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExportToKubernetesSecretResponse) Reset() {
+	*x = ExportToKubernetesSecretResponse{}
+	mi := &file_warden_service_v1_config_export_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportToKubernetesSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportToKubernetesSecretResponse) ProtoMessage() {}
+
+func (x *ExportToKubernetesSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_config_export_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportToKubernetesSecretResponse.ProtoReflect.Descriptor instead.
+func (*ExportToKubernetesSecretResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_config_export_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExportToKubernetesSecretResponse) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *ExportToKubernetesSecretResponse) GetItemsExported() int32 {
+	if x != nil {
+		return x.ItemsExported
+	}
+	return 0
+}
+
+func (x *ExportToKubernetesSecretResponse) GetItemsSkipped() int32 {
+	if x != nil {
+		return x.ItemsSkipped
+	}
+	return 0
+}
+
+func (x *ExportToKubernetesSecretResponse) GetItemsExcludedByPolicy() int32 {
+	if x != nil {
+		return x.ItemsExcludedByPolicy
+	}
+	return 0
+}
+
+func (x *ExportToKubernetesSecretResponse) GetDuplicateKeys() []string {
+	if x != nil {
+		return x.DuplicateKeys
+	}
+	return nil
+}
+
+func (x *ExportToKubernetesSecretResponse) GetSuggestedFilename() string {
+	if x != nil {
+		return x.SuggestedFilename
+	}
+	return ""
+}
+
+var File_warden_service_v1_config_export_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_config_export_proto_rawDesc = "" +
+	"\n" +
+	"%warden/service/v1/config_export.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x16redact/v3/redact.proto\"\xbd\x02\n" +
+	"\x0eKeyNamingRules\x12?\n" +
+	"\bkey_case\x18\x01 \x01(\x0e2\x1a.warden.service.v1.KeyCaseB\b\xbaH\x05\x82\x01\x02\x10\x01R\akeyCase\x12\x1f\n" +
+	"\x06prefix\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18@R\x06prefix\x12*\n" +
+	"\tseparator\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18\x04H\x00R\tseparator\x88\x01\x01\x12.\n" +
+	"\x13include_folder_path\x18\x04 \x01(\bR\x11includeFolderPath\x124\n" +
+	"\x11name_metadata_key\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x0fnameMetadataKey\x12)\n" +
+	"\x10include_username\x18\x06 \x01(\bR\x0fincludeUsernameB\f\n" +
+	"\n" +
+	"_separator\"\xac\x01\n" +
+	"\x16ExportToEnvFileRequest\x129\n" +
+	"\tfolder_id\x18\x01 \x01(\tB\x1c\xe0A\x02\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]+$R\bfolderId\x12\x1c\n" +
+	"\trecursive\x18\x02 \x01(\bR\trecursive\x129\n" +
+	"\x06naming\x18\x03 \x01(\v2!.warden.service.v1.KeyNamingRulesR\x06naming\"\x9a\x02\n" +
+	"\x17ExportToEnvFileResponse\x12$\n" +
+	"\benv_data\x18\x01 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\aenvData\x12%\n" +
+	"\x0eitems_exported\x18\x02 \x01(\x05R\ritemsExported\x12#\n" +
+	"\ritems_skipped\x18\x03 \x01(\x05R\fitemsSkipped\x127\n" +
+	"\x18items_excluded_by_policy\x18\x04 \x01(\x05R\x15itemsExcludedByPolicy\x12%\n" +
+	"\x0eduplicate_keys\x18\x05 \x03(\tR\rduplicateKeys\x12-\n" +
+	"\x12suggested_filename\x18\x06 \x01(\tR\x11suggestedFilename\"\xd2\x02\n" +
+	"\x1fExportToKubernetesSecretRequest\x129\n" +
+	"\tfolder_id\x18\x01 \x01(\tB\x1c\xe0A\x02\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]+$R\bfolderId\x12\x1c\n" +
+	"\trecursive\x18\x02 \x01(\bR\trecursive\x129\n" +
+	"\x06naming\x18\x03 \x01(\v2!.warden.service.v1.KeyNamingRulesR\x06naming\x12P\n" +
+	"\vsecret_name\x18\x04 \x01(\tB/\xe0A\x02\xbaH)r'\x10\x01\x18\xfd\x012 ^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$R\n" +
+	"secretName\x12I\n" +
+	"\tnamespace\x18\x05 \x01(\tB+\xbaH(r&\x18?2\"^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$R\tnamespace\"\xa4\x02\n" +
+	" ExportToKubernetesSecretResponse\x12%\n" +
+	"\bmanifest\x18\x01 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\bmanifest\x12%\n" +
+	"\x0eitems_exported\x18\x02 \x01(\x05R\ritemsExported\x12#\n" +
+	"\ritems_skipped\x18\x03 \x01(\x05R\fitemsSkipped\x127\n" +
+	"\x18items_excluded_by_policy\x18\x04 \x01(\x05R\x15itemsExcludedByPolicy\x12%\n" +
+	"\x0eduplicate_keys\x18\x05 \x03(\tR\rduplicateKeys\x12-\n" +
+	"\x12suggested_filename\x18\x06 \x01(\tR\x11suggestedFilename*b\n" +
+	"\aKeyCase\x12\x18\n" +
+	"\x14KEY_CASE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eKEY_CASE_UPPER\x10\x01\x12\x12\n" +
+	"\x0eKEY_CASE_LOWER\x10\x02\x12\x15\n" +
+	"\x11KEY_CASE_PRESERVE\x10\x032\xd7\x02\n" +
+	"\x19WardenConfigExportService\x12\x8a\x01\n" +
+	"\x0fExportToEnvFile\x12).warden.service.v1.ExportToEnvFileRequest\x1a*.warden.service.v1.ExportToEnvFileResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/config-export/env\x12\xac\x01\n" +
+	"\x18ExportToKubernetesSecret\x122.warden.service.v1.ExportToKubernetesSecretRequest\x1a3.warden.service.v1.ExportToKubernetesSecretResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/config-export/kubernetesB\xd9\x01\n" +
+	"\x15com.warden.service.v1B\x11ConfigExportProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_config_export_proto_rawDescOnce sync.Once
+	file_warden_service_v1_config_export_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_config_export_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_config_export_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_config_export_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_config_export_proto_rawDesc), len(file_warden_service_v1_config_export_proto_rawDesc)))
+	})
+	return file_warden_service_v1_config_export_proto_rawDescData
+}
+
+var file_warden_service_v1_config_export_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_warden_service_v1_config_export_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_warden_service_v1_config_export_proto_goTypes = []any{
+	(KeyCase)(0),                             // 0: warden.service.v1.KeyCase
+	(*KeyNamingRules)(nil),                   // 1: warden.service.v1.KeyNamingRules
+	(*ExportToEnvFileRequest)(nil),           // 2: warden.service.v1.ExportToEnvFileRequest
+	(*ExportToEnvFileResponse)(nil),          // 3: warden.service.v1.ExportToEnvFileResponse
+	(*ExportToKubernetesSecretRequest)(nil),  // 4: warden.service.v1.ExportToKubernetesSecretRequest
+	(*ExportToKubernetesSecretResponse)(nil), // 5: warden.service.v1.ExportToKubernetesSecretResponse
+}
+var file_warden_service_v1_config_export_proto_depIdxs = []int32{
+	0, // 0: warden.service.v1.KeyNamingRules.key_case:type_name -> warden.service.v1.KeyCase
+	1, // 1: warden.service.v1.ExportToEnvFileRequest.naming:type_name -> warden.service.v1.KeyNamingRules
+	1, // 2: warden.service.v1.ExportToKubernetesSecretRequest.naming:type_name -> warden.service.v1.KeyNamingRules
+	2, // 3: warden.service.v1.WardenConfigExportService.ExportToEnvFile:input_type -> warden.service.v1.ExportToEnvFileRequest
+	4, // 4: warden.service.v1.WardenConfigExportService.ExportToKubernetesSecret:input_type -> warden.service.v1.ExportToKubernetesSecretRequest
+	3, // 5: warden.service.v1.WardenConfigExportService.ExportToEnvFile:output_type -> warden.service.v1.ExportToEnvFileResponse
+	5, // 6: warden.service.v1.WardenConfigExportService.ExportToKubernetesSecret:output_type -> warden.service.v1.ExportToKubernetesSecretResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_config_export_proto_init() }
+func file_warden_service_v1_config_export_proto_init() {
+	if File_warden_service_v1_config_export_proto != nil {
+		return
+	}
+	file_warden_service_v1_config_export_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_config_export_proto_rawDesc), len(file_warden_service_v1_config_export_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_config_export_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_config_export_proto_depIdxs,
+		EnumInfos:         file_warden_service_v1_config_export_proto_enumTypes,
+		MessageInfos:      file_warden_service_v1_config_export_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_config_export_proto = out.File
+	file_warden_service_v1_config_export_proto_goTypes = nil
+	file_warden_service_v1_config_export_proto_depIdxs = nil
+}
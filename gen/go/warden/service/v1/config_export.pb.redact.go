@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/config_export.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	annotations "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ annotations.FieldBehavior
+	_ redact.FieldRules
+)
+
+// RegisterRedactedWardenConfigExportServiceServer wraps the WardenConfigExportServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenConfigExportServiceServer(s grpc.ServiceRegistrar, srv WardenConfigExportServiceServer, bypass redact.Bypass) {
+	RegisterWardenConfigExportServiceServer(s, RedactedWardenConfigExportServiceServer(srv, bypass))
+}
+
+func RedactedWardenConfigExportServiceServer(srv WardenConfigExportServiceServer, bypass redact.Bypass) WardenConfigExportServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenConfigExportServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenConfigExportServiceServer struct {
+	UnsafeWardenConfigExportServiceServer
+	srv    WardenConfigExportServiceServer
+	bypass redact.Bypass
+}
+
+// ExportToEnvFile is the redacted wrapper for the actual WardenConfigExportServiceServer.ExportToEnvFile method
+// Unary RPC
+func (s *redactedWardenConfigExportServiceServer) ExportToEnvFile(ctx context.Context, in *ExportToEnvFileRequest) (*ExportToEnvFileResponse, error) {
+	res, err := s.srv.ExportToEnvFile(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ExportToKubernetesSecret is the redacted wrapper for the actual WardenConfigExportServiceServer.ExportToKubernetesSecret method
+// Unary RPC
+func (s *redactedWardenConfigExportServiceServer) ExportToKubernetesSecret(ctx context.Context, in *ExportToKubernetesSecretRequest) (*ExportToKubernetesSecretResponse, error) {
+	res, err := s.srv.ExportToKubernetesSecret(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for KeyNamingRules
+func (x *KeyNamingRules) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: KeyCase
+
+	// Safe field: Prefix
+
+	// Safe field: Separator
+
+	// Safe field: IncludeFolderPath
+
+	// Safe field: NameMetadataKey
+
+	// Safe field: IncludeUsername
+	return x.String()
+}
+
+// Redact method implementation for ExportToEnvFileRequest
+func (x *ExportToEnvFileRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FolderId
+
+	// Safe field: Recursive
+
+	// Safe field: Naming
+	return x.String()
+}
+
+// Redact method implementation for ExportToEnvFileResponse
+func (x *ExportToEnvFileResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Redacting field: EnvData
+	x.EnvData = ``
+
+	// Safe field: ItemsExported
+
+	// Safe field: ItemsSkipped
+
+	// Safe field: ItemsExcludedByPolicy
+
+	// Safe field: DuplicateKeys
+
+	// Safe field: SuggestedFilename
+	return x.String()
+}
+
+// Redact method implementation for ExportToKubernetesSecretRequest
+func (x *ExportToKubernetesSecretRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FolderId
+
+	// Safe field: Recursive
+
+	// Safe field: Naming
+
+	// Safe field: SecretName
+
+	// Safe field: Namespace
+	return x.String()
+}
+
+// Redact method implementation for ExportToKubernetesSecretResponse
+func (x *ExportToKubernetesSecretResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Redacting field: Manifest
+	x.Manifest = ``
+
+	// Safe field: ItemsExported
+
+	// Safe field: ItemsSkipped
+
+	// Safe field: ItemsExcludedByPolicy
+
+	// Safe field: DuplicateKeys
+
+	// Safe field: SuggestedFilename
+	return x.String()
+}
@@ -0,0 +1,652 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/config_export.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on KeyNamingRules with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KeyNamingRules) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeyNamingRules with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KeyNamingRulesMultiError,
+// or nil if none found.
+func (m *KeyNamingRules) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeyNamingRules) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeyCase
+
+	// no validation rules for Prefix
+
+	// no validation rules for IncludeFolderPath
+
+	// no validation rules for NameMetadataKey
+
+	// no validation rules for IncludeUsername
+
+	if m.Separator != nil {
+		// no validation rules for Separator
+	}
+
+	if len(errors) > 0 {
+		return KeyNamingRulesMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeyNamingRulesMultiError is an error wrapping multiple validation errors
+// returned by KeyNamingRules.ValidateAll() if the designated constraints
+// aren't met.
+type KeyNamingRulesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeyNamingRulesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeyNamingRulesMultiError) AllErrors() []error { return m }
+
+// KeyNamingRulesValidationError is the validation error returned by
+// KeyNamingRules.Validate if the designated constraints aren't met.
+type KeyNamingRulesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeyNamingRulesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeyNamingRulesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeyNamingRulesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeyNamingRulesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeyNamingRulesValidationError) ErrorName() string { return "KeyNamingRulesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KeyNamingRulesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeyNamingRules.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeyNamingRulesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeyNamingRulesValidationError{}
+
+// Validate checks the field values on ExportToEnvFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportToEnvFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportToEnvFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportToEnvFileRequestMultiError, or nil if none found.
+func (m *ExportToEnvFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportToEnvFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FolderId
+
+	// no validation rules for Recursive
+
+	if all {
+		switch v := interface{}(m.GetNaming()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportToEnvFileRequestValidationError{
+					field:  "Naming",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportToEnvFileRequestValidationError{
+					field:  "Naming",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNaming()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportToEnvFileRequestValidationError{
+				field:  "Naming",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportToEnvFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportToEnvFileRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportToEnvFileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportToEnvFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportToEnvFileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportToEnvFileRequestMultiError) AllErrors() []error { return m }
+
+// ExportToEnvFileRequestValidationError is the validation error returned by
+// ExportToEnvFileRequest.Validate if the designated constraints aren't met.
+type ExportToEnvFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportToEnvFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportToEnvFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportToEnvFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportToEnvFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportToEnvFileRequestValidationError) ErrorName() string {
+	return "ExportToEnvFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportToEnvFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportToEnvFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportToEnvFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportToEnvFileRequestValidationError{}
+
+// Validate checks the field values on ExportToEnvFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportToEnvFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportToEnvFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportToEnvFileResponseMultiError, or nil if none found.
+func (m *ExportToEnvFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportToEnvFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EnvData
+
+	// no validation rules for ItemsExported
+
+	// no validation rules for ItemsSkipped
+
+	// no validation rules for ItemsExcludedByPolicy
+
+	// no validation rules for SuggestedFilename
+
+	if len(errors) > 0 {
+		return ExportToEnvFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportToEnvFileResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportToEnvFileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportToEnvFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportToEnvFileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportToEnvFileResponseMultiError) AllErrors() []error { return m }
+
+// ExportToEnvFileResponseValidationError is the validation error returned by
+// ExportToEnvFileResponse.Validate if the designated constraints aren't met.
+type ExportToEnvFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportToEnvFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportToEnvFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportToEnvFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportToEnvFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportToEnvFileResponseValidationError) ErrorName() string {
+	return "ExportToEnvFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportToEnvFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportToEnvFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportToEnvFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportToEnvFileResponseValidationError{}
+
+// Validate checks the field values on ExportToKubernetesSecretRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportToKubernetesSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportToKubernetesSecretRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExportToKubernetesSecretRequestMultiError, or nil if none found.
+func (m *ExportToKubernetesSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportToKubernetesSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FolderId
+
+	// no validation rules for Recursive
+
+	if all {
+		switch v := interface{}(m.GetNaming()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportToKubernetesSecretRequestValidationError{
+					field:  "Naming",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportToKubernetesSecretRequestValidationError{
+					field:  "Naming",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNaming()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportToKubernetesSecretRequestValidationError{
+				field:  "Naming",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SecretName
+
+	// no validation rules for Namespace
+
+	if len(errors) > 0 {
+		return ExportToKubernetesSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportToKubernetesSecretRequestMultiError is an error wrapping multiple
+// validation errors returned by ExportToKubernetesSecretRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ExportToKubernetesSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportToKubernetesSecretRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportToKubernetesSecretRequestMultiError) AllErrors() []error { return m }
+
+// ExportToKubernetesSecretRequestValidationError is the validation error
+// returned by ExportToKubernetesSecretRequest.Validate if the designated
+// constraints aren't met.
+type ExportToKubernetesSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportToKubernetesSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportToKubernetesSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportToKubernetesSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportToKubernetesSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportToKubernetesSecretRequestValidationError) ErrorName() string {
+	return "ExportToKubernetesSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportToKubernetesSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportToKubernetesSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportToKubernetesSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportToKubernetesSecretRequestValidationError{}
+
+// Validate checks the field values on ExportToKubernetesSecretResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ExportToKubernetesSecretResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportToKubernetesSecretResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExportToKubernetesSecretResponseMultiError, or nil if none found.
+func (m *ExportToKubernetesSecretResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportToKubernetesSecretResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Manifest
+
+	// no validation rules for ItemsExported
+
+	// no validation rules for ItemsSkipped
+
+	// no validation rules for ItemsExcludedByPolicy
+
+	// no validation rules for SuggestedFilename
+
+	if len(errors) > 0 {
+		return ExportToKubernetesSecretResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportToKubernetesSecretResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ExportToKubernetesSecretResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportToKubernetesSecretResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportToKubernetesSecretResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportToKubernetesSecretResponseMultiError) AllErrors() []error { return m }
+
+// ExportToKubernetesSecretResponseValidationError is the validation error
+// returned by ExportToKubernetesSecretResponse.Validate if the designated
+// constraints aren't met.
+type ExportToKubernetesSecretResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportToKubernetesSecretResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportToKubernetesSecretResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportToKubernetesSecretResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportToKubernetesSecretResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportToKubernetesSecretResponseValidationError) ErrorName() string {
+	return "ExportToKubernetesSecretResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportToKubernetesSecretResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportToKubernetesSecretResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportToKubernetesSecretResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportToKubernetesSecretResponseValidationError{}
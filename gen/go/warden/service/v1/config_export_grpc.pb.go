@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/config_export.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenConfigExportService_ExportToEnvFile_FullMethodName          = "/warden.service.v1.WardenConfigExportService/ExportToEnvFile"
+	WardenConfigExportService_ExportToKubernetesSecret_FullMethodName = "/warden.service.v1.WardenConfigExportService/ExportToKubernetesSecret"
+)
+
+// WardenConfigExportServiceClient is the client API for WardenConfigExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Config Export Service - renders a folder's secrets for deploy pipelines
+type WardenConfigExportServiceClient interface {
+	// Render the passwords of a folder as a dotenv file
+	ExportToEnvFile(ctx context.Context, in *ExportToEnvFileRequest, opts ...grpc.CallOption) (*ExportToEnvFileResponse, error)
+	// Render the passwords of a folder as a Kubernetes Secret manifest
+	ExportToKubernetesSecret(ctx context.Context, in *ExportToKubernetesSecretRequest, opts ...grpc.CallOption) (*ExportToKubernetesSecretResponse, error)
+}
+
+type wardenConfigExportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenConfigExportServiceClient(cc grpc.ClientConnInterface) WardenConfigExportServiceClient {
+	return &wardenConfigExportServiceClient{cc}
+}
+
+func (c *wardenConfigExportServiceClient) ExportToEnvFile(ctx context.Context, in *ExportToEnvFileRequest, opts ...grpc.CallOption) (*ExportToEnvFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportToEnvFileResponse)
+	err := c.cc.Invoke(ctx, WardenConfigExportService_ExportToEnvFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenConfigExportServiceClient) ExportToKubernetesSecret(ctx context.Context, in *ExportToKubernetesSecretRequest, opts ...grpc.CallOption) (*ExportToKubernetesSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportToKubernetesSecretResponse)
+	err := c.cc.Invoke(ctx, WardenConfigExportService_ExportToKubernetesSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenConfigExportServiceServer is the server API for WardenConfigExportService service.
+// All implementations must embed UnimplementedWardenConfigExportServiceServer
+// for forward compatibility.
+//
+// Config Export Service - renders a folder's secrets for deploy pipelines
+type WardenConfigExportServiceServer interface {
+	// Render the passwords of a folder as a dotenv file
+	ExportToEnvFile(context.Context, *ExportToEnvFileRequest) (*ExportToEnvFileResponse, error)
+	// Render the passwords of a folder as a Kubernetes Secret manifest
+	ExportToKubernetesSecret(context.Context, *ExportToKubernetesSecretRequest) (*ExportToKubernetesSecretResponse, error)
+	mustEmbedUnimplementedWardenConfigExportServiceServer()
+}
+
+// UnimplementedWardenConfigExportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenConfigExportServiceServer struct{}
+
+func (UnimplementedWardenConfigExportServiceServer) ExportToEnvFile(context.Context, *ExportToEnvFileRequest) (*ExportToEnvFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportToEnvFile not implemented")
+}
+func (UnimplementedWardenConfigExportServiceServer) ExportToKubernetesSecret(context.Context, *ExportToKubernetesSecretRequest) (*ExportToKubernetesSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportToKubernetesSecret not implemented")
+}
+func (UnimplementedWardenConfigExportServiceServer) mustEmbedUnimplementedWardenConfigExportServiceServer() {
+}
+func (UnimplementedWardenConfigExportServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWardenConfigExportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenConfigExportServiceServer will
+// result in compilation errors.
+type UnsafeWardenConfigExportServiceServer interface {
+	mustEmbedUnimplementedWardenConfigExportServiceServer()
+}
+
+func RegisterWardenConfigExportServiceServer(s grpc.ServiceRegistrar, srv WardenConfigExportServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenConfigExportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenConfigExportService_ServiceDesc, srv)
+}
+
+func _WardenConfigExportService_ExportToEnvFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportToEnvFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenConfigExportServiceServer).ExportToEnvFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenConfigExportService_ExportToEnvFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenConfigExportServiceServer).ExportToEnvFile(ctx, req.(*ExportToEnvFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenConfigExportService_ExportToKubernetesSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportToKubernetesSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenConfigExportServiceServer).ExportToKubernetesSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenConfigExportService_ExportToKubernetesSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenConfigExportServiceServer).ExportToKubernetesSecret(ctx, req.(*ExportToKubernetesSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenConfigExportService_ServiceDesc is the grpc.ServiceDesc for WardenConfigExportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenConfigExportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenConfigExportService",
+	HandlerType: (*WardenConfigExportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportToEnvFile",
+			Handler:    _WardenConfigExportService_ExportToEnvFile_Handler,
+		},
+		{
+			MethodName: "ExportToKubernetesSecret",
+			Handler:    _WardenConfigExportService_ExportToKubernetesSecret_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/config_export.proto",
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/config_export.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenConfigExportServiceExportToEnvFile = "/warden.service.v1.WardenConfigExportService/ExportToEnvFile"
+const OperationWardenConfigExportServiceExportToKubernetesSecret = "/warden.service.v1.WardenConfigExportService/ExportToKubernetesSecret"
+
+type WardenConfigExportServiceHTTPServer interface {
+	// ExportToEnvFile Render the passwords of a folder as a dotenv file
+	ExportToEnvFile(context.Context, *ExportToEnvFileRequest) (*ExportToEnvFileResponse, error)
+	// ExportToKubernetesSecret Render the passwords of a folder as a Kubernetes Secret manifest
+	ExportToKubernetesSecret(context.Context, *ExportToKubernetesSecretRequest) (*ExportToKubernetesSecretResponse, error)
+}
+
+func RegisterWardenConfigExportServiceHTTPServer(s *http.Server, srv WardenConfigExportServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/config-export/env", _WardenConfigExportService_ExportToEnvFile0_HTTP_Handler(srv))
+	r.POST("/v1/config-export/kubernetes", _WardenConfigExportService_ExportToKubernetesSecret0_HTTP_Handler(srv))
+}
+
+func _WardenConfigExportService_ExportToEnvFile0_HTTP_Handler(srv WardenConfigExportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportToEnvFileRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenConfigExportServiceExportToEnvFile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportToEnvFile(ctx, req.(*ExportToEnvFileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportToEnvFileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenConfigExportService_ExportToKubernetesSecret0_HTTP_Handler(srv WardenConfigExportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportToKubernetesSecretRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenConfigExportServiceExportToKubernetesSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportToKubernetesSecret(ctx, req.(*ExportToKubernetesSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportToKubernetesSecretResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenConfigExportServiceHTTPClient interface {
+	// ExportToEnvFile Render the passwords of a folder as a dotenv file
+	ExportToEnvFile(ctx context.Context, req *ExportToEnvFileRequest, opts ...http.CallOption) (rsp *ExportToEnvFileResponse, err error)
+	// ExportToKubernetesSecret Render the passwords of a folder as a Kubernetes Secret manifest
+	ExportToKubernetesSecret(ctx context.Context, req *ExportToKubernetesSecretRequest, opts ...http.CallOption) (rsp *ExportToKubernetesSecretResponse, err error)
+}
+
+type WardenConfigExportServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenConfigExportServiceHTTPClient(client *http.Client) WardenConfigExportServiceHTTPClient {
+	return &WardenConfigExportServiceHTTPClientImpl{client}
+}
+
+// ExportToEnvFile Render the passwords of a folder as a dotenv file
+func (c *WardenConfigExportServiceHTTPClientImpl) ExportToEnvFile(ctx context.Context, in *ExportToEnvFileRequest, opts ...http.CallOption) (*ExportToEnvFileResponse, error) {
+	var out ExportToEnvFileResponse
+	pattern := "/v1/config-export/env"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenConfigExportServiceExportToEnvFile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportToKubernetesSecret Render the passwords of a folder as a Kubernetes Secret manifest
+func (c *WardenConfigExportServiceHTTPClientImpl) ExportToKubernetesSecret(ctx context.Context, in *ExportToKubernetesSecretRequest, opts ...http.CallOption) (*ExportToKubernetesSecretResponse, error) {
+	var out ExportToKubernetesSecretResponse
+	pattern := "/v1/config-export/kubernetes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenConfigExportServiceExportToKubernetesSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,894 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/csv_transfer.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Known CSV layouts
+type CsvFormat int32
+
+const (
+	CsvFormat_CSV_FORMAT_UNSPECIFIED CsvFormat = 0
+	// LastPass: url,username,password,totp,extra,name,grouping,fav
+	CsvFormat_CSV_FORMAT_LASTPASS CsvFormat = 1
+	// Chrome, Edge and other Chromium browsers: name,url,username,password,note
+	CsvFormat_CSV_FORMAT_CHROMIUM CsvFormat = 2
+	// Columns named by column_mapping
+	CsvFormat_CSV_FORMAT_CUSTOM CsvFormat = 3
+)
+
+// Enum value maps for CsvFormat.
+var (
+	CsvFormat_name = map[int32]string{
+		0: "CSV_FORMAT_UNSPECIFIED",
+		1: "CSV_FORMAT_LASTPASS",
+		2: "CSV_FORMAT_CHROMIUM",
+		3: "CSV_FORMAT_CUSTOM",
+	}
+	CsvFormat_value = map[string]int32{
+		"CSV_FORMAT_UNSPECIFIED": 0,
+		"CSV_FORMAT_LASTPASS":    1,
+		"CSV_FORMAT_CHROMIUM":    2,
+		"CSV_FORMAT_CUSTOM":      3,
+	}
+)
+
+func (x CsvFormat) Enum() *CsvFormat {
+	p := new(CsvFormat)
+	*p = x
+	return p
+}
+
+func (x CsvFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CsvFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_csv_transfer_proto_enumTypes[0].Descriptor()
+}
+
+func (CsvFormat) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_csv_transfer_proto_enumTypes[0]
+}
+
+func (x CsvFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CsvFormat.Descriptor instead.
+func (CsvFormat) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_csv_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+// Columns available in a CSV export
+type CsvColumn int32
+
+const (
+	CsvColumn_CSV_COLUMN_UNSPECIFIED CsvColumn = 0
+	CsvColumn_CSV_COLUMN_NAME        CsvColumn = 1
+	CsvColumn_CSV_COLUMN_USERNAME    CsvColumn = 2
+	CsvColumn_CSV_COLUMN_PASSWORD    CsvColumn = 3
+	CsvColumn_CSV_COLUMN_URL         CsvColumn = 4
+	CsvColumn_CSV_COLUMN_FOLDER_PATH CsvColumn = 5 // Full folder path, e.g. "Team/Infra"
+	CsvColumn_CSV_COLUMN_TAGS        CsvColumn = 6 // Metadata tags joined with ";"
+	CsvColumn_CSV_COLUMN_NOTES       CsvColumn = 7
+)
+
+// Enum value maps for CsvColumn.
+var (
+	CsvColumn_name = map[int32]string{
+		0: "CSV_COLUMN_UNSPECIFIED",
+		1: "CSV_COLUMN_NAME",
+		2: "CSV_COLUMN_USERNAME",
+		3: "CSV_COLUMN_PASSWORD",
+		4: "CSV_COLUMN_URL",
+		5: "CSV_COLUMN_FOLDER_PATH",
+		6: "CSV_COLUMN_TAGS",
+		7: "CSV_COLUMN_NOTES",
+	}
+	CsvColumn_value = map[string]int32{
+		"CSV_COLUMN_UNSPECIFIED": 0,
+		"CSV_COLUMN_NAME":        1,
+		"CSV_COLUMN_USERNAME":    2,
+		"CSV_COLUMN_PASSWORD":    3,
+		"CSV_COLUMN_URL":         4,
+		"CSV_COLUMN_FOLDER_PATH": 5,
+		"CSV_COLUMN_TAGS":        6,
+		"CSV_COLUMN_NOTES":       7,
+	}
+)
+
+func (x CsvColumn) Enum() *CsvColumn {
+	p := new(CsvColumn)
+	*p = x
+	return p
+}
+
+func (x CsvColumn) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CsvColumn) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_csv_transfer_proto_enumTypes[1].Descriptor()
+}
+
+func (CsvColumn) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_csv_transfer_proto_enumTypes[1]
+}
+
+func (x CsvColumn) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CsvColumn.Descriptor instead.
+func (CsvColumn) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_csv_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+// Which secrets a CSV export covers
+type CsvExportScope int32
+
+const (
+	CsvExportScope_CSV_EXPORT_SCOPE_UNSPECIFIED CsvExportScope = 0
+	CsvExportScope_CSV_EXPORT_SCOPE_TENANT      CsvExportScope = 1 // Every secret of the tenant
+	CsvExportScope_CSV_EXPORT_SCOPE_FOLDER      CsvExportScope = 2 // Secrets directly in folder_id
+	CsvExportScope_CSV_EXPORT_SCOPE_SUBTREE     CsvExportScope = 3 // Secrets in folder_id and its subfolders
+)
+
+// Enum value maps for CsvExportScope.
+var (
+	CsvExportScope_name = map[int32]string{
+		0: "CSV_EXPORT_SCOPE_UNSPECIFIED",
+		1: "CSV_EXPORT_SCOPE_TENANT",
+		2: "CSV_EXPORT_SCOPE_FOLDER",
+		3: "CSV_EXPORT_SCOPE_SUBTREE",
+	}
+	CsvExportScope_value = map[string]int32{
+		"CSV_EXPORT_SCOPE_UNSPECIFIED": 0,
+		"CSV_EXPORT_SCOPE_TENANT":      1,
+		"CSV_EXPORT_SCOPE_FOLDER":      2,
+		"CSV_EXPORT_SCOPE_SUBTREE":     3,
+	}
+)
+
+func (x CsvExportScope) Enum() *CsvExportScope {
+	p := new(CsvExportScope)
+	*p = x
+	return p
+}
+
+func (x CsvExportScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CsvExportScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_csv_transfer_proto_enumTypes[2].Descriptor()
+}
+
+func (CsvExportScope) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_csv_transfer_proto_enumTypes[2]
+}
+
+func (x CsvExportScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CsvExportScope.Descriptor instead.
+func (CsvExportScope) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_csv_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+// Header names of the columns holding each secret field (matched case-insensitively)
+type CsvColumnMapping struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url      string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Username string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Notes    string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Folder path column; nested folders are separated by folder_separator
+	Folder string `protobuf:"bytes,6,opt,name=folder,proto3" json:"folder,omitempty"`
+	Totp   string `protobuf:"bytes,7,opt,name=totp,proto3" json:"totp,omitempty"`
+	// Separator of nested folders in the folder column (default "/")
+	FolderSeparator string `protobuf:"bytes,8,opt,name=folder_separator,json=folderSeparator,proto3" json:"folder_separator,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CsvColumnMapping) Reset() {
+	*x = CsvColumnMapping{}
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsvColumnMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsvColumnMapping) ProtoMessage() {}
+
+func (x *CsvColumnMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsvColumnMapping.ProtoReflect.Descriptor instead.
+func (*CsvColumnMapping) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_csv_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CsvColumnMapping) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetTotp() string {
+	if x != nil {
+		return x.Totp
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetFolderSeparator() string {
+	if x != nil {
+		return x.FolderSeparator
+	}
+	return ""
+}
+
+type ImportFromCsvRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CSV data including the header row
+	CsvData string    `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	Format  CsvFormat `protobuf:"varint,2,opt,name=format,proto3,enum=warden.service.v1.CsvFormat" json:"format,omitempty"`
+	// Column mapping (required for CSV_FORMAT_CUSTOM)
+	ColumnMapping *CsvColumnMapping `protobuf:"bytes,3,opt,name=column_mapping,json=columnMapping,proto3,oneof" json:"column_mapping,omitempty"`
+	// Target folder to import into (null for root)
+	TargetFolderId *string `protobuf:"bytes,4,opt,name=target_folder_id,json=targetFolderId,proto3,oneof" json:"target_folder_id,omitempty"`
+	// How to handle duplicate names
+	DuplicateHandling DuplicateHandling `protobuf:"varint,5,opt,name=duplicate_handling,json=duplicateHandling,proto3,enum=warden.service.v1.DuplicateHandling" json:"duplicate_handling,omitempty"`
+	// Create folders from the grouping column (otherwise everything goes to the target folder)
+	PreserveFolders bool `protobuf:"varint,6,opt,name=preserve_folders,json=preserveFolders,proto3" json:"preserve_folders,omitempty"`
+	// Permission rules to apply to all imported folders and secrets
+	PermissionRules []*ImportPermissionRule `protobuf:"bytes,7,rep,name=permission_rules,json=permissionRules,proto3" json:"permission_rules,omitempty"`
+	// How rows are matched against existing secrets
+	DuplicateMatch DuplicateMatch `protobuf:"varint,8,opt,name=duplicate_match,json=duplicateMatch,proto3,enum=warden.service.v1.DuplicateMatch" json:"duplicate_match,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportFromCsvRequest) Reset() {
+	*x = ImportFromCsvRequest{}
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFromCsvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFromCsvRequest) ProtoMessage() {}
+
+func (x *ImportFromCsvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFromCsvRequest.ProtoReflect.Descriptor instead.
+func (*ImportFromCsvRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_csv_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportFromCsvRequest) GetCsvData() string {
+	if x != nil {
+		return x.CsvData
+	}
+	return ""
+}
+
+func (x *ImportFromCsvRequest) GetFormat() CsvFormat {
+	if x != nil {
+		return x.Format
+	}
+	return CsvFormat_CSV_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportFromCsvRequest) GetColumnMapping() *CsvColumnMapping {
+	if x != nil {
+		return x.ColumnMapping
+	}
+	return nil
+}
+
+func (x *ImportFromCsvRequest) GetTargetFolderId() string {
+	if x != nil && x.TargetFolderId != nil {
+		return *x.TargetFolderId
+	}
+	return ""
+}
+
+func (x *ImportFromCsvRequest) GetDuplicateHandling() DuplicateHandling {
+	if x != nil {
+		return x.DuplicateHandling
+	}
+	return DuplicateHandling_DUPLICATE_HANDLING_UNSPECIFIED
+}
+
+func (x *ImportFromCsvRequest) GetPreserveFolders() bool {
+	if x != nil {
+		return x.PreserveFolders
+	}
+	return false
+}
+
+func (x *ImportFromCsvRequest) GetPermissionRules() []*ImportPermissionRule {
+	if x != nil {
+		return x.PermissionRules
+	}
+	return nil
+}
+
+func (x *ImportFromCsvRequest) GetDuplicateMatch() DuplicateMatch {
+	if x != nil {
+		return x.DuplicateMatch
+	}
+	return DuplicateMatch_DUPLICATE_MATCH_UNSPECIFIED
+}
+
+// Problem with a single CSV row
+type CsvRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-based line number in the CSV (the header is line 1)
+	Line          int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	ItemName      string `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	ErrorType     string `protobuf:"bytes,3,opt,name=error_type,json=errorType,proto3" json:"error_type,omitempty"` // "parse", "validation", "duplicate", "folder_creation", ...
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CsvRowError) Reset() {
+	*x = CsvRowError{}
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsvRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsvRowError) ProtoMessage() {}
+
+func (x *CsvRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsvRowError.ProtoReflect.Descriptor instead.
+func (*CsvRowError) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_csv_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CsvRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *CsvRowError) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *CsvRowError) GetErrorType() string {
+	if x != nil {
+		return x.ErrorType
+	}
+	return ""
+}
+
+func (x *CsvRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportFromCsvResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RowsTotal      int32                  `protobuf:"varint,1,opt,name=rows_total,json=rowsTotal,proto3" json:"rows_total,omitempty"`
+	FoldersCreated int32                  `protobuf:"varint,2,opt,name=folders_created,json=foldersCreated,proto3" json:"folders_created,omitempty"`
+	ItemsImported  int32                  `protobuf:"varint,3,opt,name=items_imported,json=itemsImported,proto3" json:"items_imported,omitempty"`
+	ItemsUpdated   int32                  `protobuf:"varint,4,opt,name=items_updated,json=itemsUpdated,proto3" json:"items_updated,omitempty"`
+	ItemsSkipped   int32                  `protobuf:"varint,5,opt,name=items_skipped,json=itemsSkipped,proto3" json:"items_skipped,omitempty"`
+	ItemsFailed    int32                  `protobuf:"varint,6,opt,name=items_failed,json=itemsFailed,proto3" json:"items_failed,omitempty"`
+	// Per-row problems
+	Errors []*CsvRowError `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Folder path -> Warden folder ID
+	FolderIdMapping map[string]string `protobuf:"bytes,8,rep,name=folder_id_mapping,json=folderIdMapping,proto3" json:"folder_id_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportFromCsvResponse) Reset() {
+	*x = ImportFromCsvResponse{}
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFromCsvResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFromCsvResponse) ProtoMessage() {}
+
+func (x *ImportFromCsvResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFromCsvResponse.ProtoReflect.Descriptor instead.
+func (*ImportFromCsvResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_csv_transfer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImportFromCsvResponse) GetRowsTotal() int32 {
+	if x != nil {
+		return x.RowsTotal
+	}
+	return 0
+}
+
+func (x *ImportFromCsvResponse) GetFoldersCreated() int32 {
+	if x != nil {
+		return x.FoldersCreated
+	}
+	return 0
+}
+
+func (x *ImportFromCsvResponse) GetItemsImported() int32 {
+	if x != nil {
+		return x.ItemsImported
+	}
+	return 0
+}
+
+func (x *ImportFromCsvResponse) GetItemsUpdated() int32 {
+	if x != nil {
+		return x.ItemsUpdated
+	}
+	return 0
+}
+
+func (x *ImportFromCsvResponse) GetItemsSkipped() int32 {
+	if x != nil {
+		return x.ItemsSkipped
+	}
+	return 0
+}
+
+func (x *ImportFromCsvResponse) GetItemsFailed() int32 {
+	if x != nil {
+		return x.ItemsFailed
+	}
+	return 0
+}
+
+func (x *ImportFromCsvResponse) GetErrors() []*CsvRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportFromCsvResponse) GetFolderIdMapping() map[string]string {
+	if x != nil {
+		return x.FolderIdMapping
+	}
+	return nil
+}
+
+type ExportToCsvRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Columns in output order (header names are the lowercase column names)
+	Columns []CsvColumn    `protobuf:"varint,1,rep,packed,name=columns,proto3,enum=warden.service.v1.CsvColumn" json:"columns,omitempty"`
+	Scope   CsvExportScope `protobuf:"varint,2,opt,name=scope,proto3,enum=warden.service.v1.CsvExportScope" json:"scope,omitempty"`
+	// Folder to export (required for FOLDER and SUBTREE scopes)
+	FolderId *string `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	// Field delimiter (default ",")
+	Delimiter     *string `protobuf:"bytes,4,opt,name=delimiter,proto3,oneof" json:"delimiter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportToCsvRequest) Reset() {
+	*x = ExportToCsvRequest{}
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportToCsvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportToCsvRequest) ProtoMessage() {}
+
+func (x *ExportToCsvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportToCsvRequest.ProtoReflect.Descriptor instead.
+func (*ExportToCsvRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_csv_transfer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExportToCsvRequest) GetColumns() []CsvColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportToCsvRequest) GetScope() CsvExportScope {
+	if x != nil {
+		return x.Scope
+	}
+	return CsvExportScope_CSV_EXPORT_SCOPE_UNSPECIFIED
+}
+
+func (x *ExportToCsvRequest) GetFolderId() string {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return ""
+}
+
+func (x *ExportToCsvRequest) GetDelimiter() string {
+	if x != nil && x.Delimiter != nil {
+		return *x.Delimiter
+	}
+	return ""
+}
+
+type ExportToCsvResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CSV data including the header row
+	CsvData       string `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	ItemsExported int32  `protobuf:"varint,2,opt,name=items_exported,json=itemsExported,proto3" json:"items_exported,omitempty"`
+	ItemsSkipped  int32  `protobuf:"varint,3,opt,name=items_skipped,json=itemsSkipped,proto3" json:"items_skipped,omitempty"`
+	// Filename suggestion
+	SuggestedFilename string `protobuf:"bytes,4,opt,name=suggested_filename,json=suggestedFilename,proto3" json:"suggested_filename,omitempty"`
+	// Secrets left out by the tenant's export policy
+	ItemsExcludedByPolicy int32 `protobuf:"varint,5,opt,name=items_excluded_by_policy,json=itemsExcludedByPolicy,proto3" json:"items_excluded_by_policy,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ExportToCsvResponse) Reset() {
+	*x = ExportToCsvResponse{}
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportToCsvResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportToCsvResponse) ProtoMessage() {}
+
+func (x *ExportToCsvResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_csv_transfer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportToCsvResponse.ProtoReflect.Descriptor instead.
+func (*ExportToCsvResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_csv_transfer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExportToCsvResponse) GetCsvData() string {
+	if x != nil {
+		return x.CsvData
+	}
+	return ""
+}
+
+func (x *ExportToCsvResponse) GetItemsExported() int32 {
+	if x != nil {
+		return x.ItemsExported
+	}
+	return 0
+}
+
+func (x *ExportToCsvResponse) GetItemsSkipped() int32 {
+	if x != nil {
+		return x.ItemsSkipped
+	}
+	return 0
+}
+
+func (x *ExportToCsvResponse) GetSuggestedFilename() string {
+	if x != nil {
+		return x.SuggestedFilename
+	}
+	return ""
+}
+
+func (x *ExportToCsvResponse) GetItemsExcludedByPolicy() int32 {
+	if x != nil {
+		return x.ItemsExcludedByPolicy
+	}
+	return 0
+}
+
+var File_warden_service_v1_csv_transfer_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_csv_transfer_proto_rawDesc = "" +
+	"\n" +
+	"$warden/service/v1/csv_transfer.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x16redact/v3/redact.proto\x1a*warden/service/v1/bitwarden_transfer.proto\"\xe6\x01\n" +
+	"\x10CsvColumnMapping\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x12\x16\n" +
+	"\x06folder\x18\x06 \x01(\tR\x06folder\x12\x12\n" +
+	"\x04totp\x18\a \x01(\tR\x04totp\x122\n" +
+	"\x10folder_separator\x18\b \x01(\tB\a\xbaH\x04r\x02\x18\x04R\x0ffolderSeparator\"\xeb\x04\n" +
+	"\x14ImportFromCsvRequest\x12.\n" +
+	"\bcsv_data\x18\x01 \x01(\tB\x13\xe0A\x02\xbaH\x04r\x02\x10\x01ڶ\x1a\x02z\x00\x80\x01\x01R\acsvData\x12@\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1c.warden.service.v1.CsvFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\x12O\n" +
+	"\x0ecolumn_mapping\x18\x03 \x01(\v2#.warden.service.v1.CsvColumnMappingH\x00R\rcolumnMapping\x88\x01\x01\x12H\n" +
+	"\x10target_folder_id\x18\x04 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x01R\x0etargetFolderId\x88\x01\x01\x12S\n" +
+	"\x12duplicate_handling\x18\x05 \x01(\x0e2$.warden.service.v1.DuplicateHandlingR\x11duplicateHandling\x12)\n" +
+	"\x10preserve_folders\x18\x06 \x01(\bR\x0fpreserveFolders\x12R\n" +
+	"\x10permission_rules\x18\a \x03(\v2'.warden.service.v1.ImportPermissionRuleR\x0fpermissionRules\x12J\n" +
+	"\x0fduplicate_match\x18\b \x01(\x0e2!.warden.service.v1.DuplicateMatchR\x0eduplicateMatchB\x11\n" +
+	"\x0f_column_mappingB\x13\n" +
+	"\x11_target_folder_id\"w\n" +
+	"\vCsvRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1b\n" +
+	"\titem_name\x18\x02 \x01(\tR\bitemName\x12\x1d\n" +
+	"\n" +
+	"error_type\x18\x03 \x01(\tR\terrorType\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xda\x03\n" +
+	"\x15ImportFromCsvResponse\x12\x1d\n" +
+	"\n" +
+	"rows_total\x18\x01 \x01(\x05R\trowsTotal\x12'\n" +
+	"\x0ffolders_created\x18\x02 \x01(\x05R\x0efoldersCreated\x12%\n" +
+	"\x0eitems_imported\x18\x03 \x01(\x05R\ritemsImported\x12#\n" +
+	"\ritems_updated\x18\x04 \x01(\x05R\fitemsUpdated\x12#\n" +
+	"\ritems_skipped\x18\x05 \x01(\x05R\fitemsSkipped\x12!\n" +
+	"\fitems_failed\x18\x06 \x01(\x05R\vitemsFailed\x126\n" +
+	"\x06errors\x18\a \x03(\v2\x1e.warden.service.v1.CsvRowErrorR\x06errors\x12i\n" +
+	"\x11folder_id_mapping\x18\b \x03(\v2=.warden.service.v1.ImportFromCsvResponse.FolderIdMappingEntryR\x0ffolderIdMapping\x1aB\n" +
+	"\x14FolderIdMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xae\x02\n" +
+	"\x12ExportToCsvRequest\x12M\n" +
+	"\acolumns\x18\x01 \x03(\x0e2\x1c.warden.service.v1.CsvColumnB\x15\xbaH\x12\x92\x01\x0f\b\x01\x10\a\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\acolumns\x12C\n" +
+	"\x05scope\x18\x02 \x01(\x0e2!.warden.service.v1.CsvExportScopeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x05scope\x12;\n" +
+	"\tfolder_id\x18\x03 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\bfolderId\x88\x01\x01\x12+\n" +
+	"\tdelimiter\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x98\x01\x01H\x01R\tdelimiter\x88\x01\x01B\f\n" +
+	"\n" +
+	"_folder_idB\f\n" +
+	"\n" +
+	"_delimiter\"\xef\x01\n" +
+	"\x13ExportToCsvResponse\x12$\n" +
+	"\bcsv_data\x18\x01 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\acsvData\x12%\n" +
+	"\x0eitems_exported\x18\x02 \x01(\x05R\ritemsExported\x12#\n" +
+	"\ritems_skipped\x18\x03 \x01(\x05R\fitemsSkipped\x12-\n" +
+	"\x12suggested_filename\x18\x04 \x01(\tR\x11suggestedFilename\x127\n" +
+	"\x18items_excluded_by_policy\x18\x05 \x01(\x05R\x15itemsExcludedByPolicy*p\n" +
+	"\tCsvFormat\x12\x1a\n" +
+	"\x16CSV_FORMAT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CSV_FORMAT_LASTPASS\x10\x01\x12\x17\n" +
+	"\x13CSV_FORMAT_CHROMIUM\x10\x02\x12\x15\n" +
+	"\x11CSV_FORMAT_CUSTOM\x10\x03*\xc9\x01\n" +
+	"\tCsvColumn\x12\x1a\n" +
+	"\x16CSV_COLUMN_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fCSV_COLUMN_NAME\x10\x01\x12\x17\n" +
+	"\x13CSV_COLUMN_USERNAME\x10\x02\x12\x17\n" +
+	"\x13CSV_COLUMN_PASSWORD\x10\x03\x12\x12\n" +
+	"\x0eCSV_COLUMN_URL\x10\x04\x12\x1a\n" +
+	"\x16CSV_COLUMN_FOLDER_PATH\x10\x05\x12\x13\n" +
+	"\x0fCSV_COLUMN_TAGS\x10\x06\x12\x14\n" +
+	"\x10CSV_COLUMN_NOTES\x10\a*\x8a\x01\n" +
+	"\x0eCsvExportScope\x12 \n" +
+	"\x1cCSV_EXPORT_SCOPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17CSV_EXPORT_SCOPE_TENANT\x10\x01\x12\x1b\n" +
+	"\x17CSV_EXPORT_SCOPE_FOLDER\x10\x02\x12\x1c\n" +
+	"\x18CSV_EXPORT_SCOPE_SUBTREE\x10\x032\x92\x02\n" +
+	"\x18WardenCsvTransferService\x12}\n" +
+	"\rImportFromCsv\x12'.warden.service.v1.ImportFromCsvRequest\x1a(.warden.service.v1.ImportFromCsvResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/csv/import\x12w\n" +
+	"\vExportToCsv\x12%.warden.service.v1.ExportToCsvRequest\x1a&.warden.service.v1.ExportToCsvResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/csv/exportB\xd8\x01\n" +
+	"\x15com.warden.service.v1B\x10CsvTransferProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_csv_transfer_proto_rawDescOnce sync.Once
+	file_warden_service_v1_csv_transfer_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_csv_transfer_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_csv_transfer_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_csv_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_csv_transfer_proto_rawDesc), len(file_warden_service_v1_csv_transfer_proto_rawDesc)))
+	})
+	return file_warden_service_v1_csv_transfer_proto_rawDescData
+}
+
+var file_warden_service_v1_csv_transfer_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_warden_service_v1_csv_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_warden_service_v1_csv_transfer_proto_goTypes = []any{
+	(CsvFormat)(0),                // 0: warden.service.v1.CsvFormat
+	(CsvColumn)(0),                // 1: warden.service.v1.CsvColumn
+	(CsvExportScope)(0),           // 2: warden.service.v1.CsvExportScope
+	(*CsvColumnMapping)(nil),      // 3: warden.service.v1.CsvColumnMapping
+	(*ImportFromCsvRequest)(nil),  // 4: warden.service.v1.ImportFromCsvRequest
+	(*CsvRowError)(nil),           // 5: warden.service.v1.CsvRowError
+	(*ImportFromCsvResponse)(nil), // 6: warden.service.v1.ImportFromCsvResponse
+	(*ExportToCsvRequest)(nil),    // 7: warden.service.v1.ExportToCsvRequest
+	(*ExportToCsvResponse)(nil),   // 8: warden.service.v1.ExportToCsvResponse
+	nil,                           // 9: warden.service.v1.ImportFromCsvResponse.FolderIdMappingEntry
+	(DuplicateHandling)(0),        // 10: warden.service.v1.DuplicateHandling
+	(*ImportPermissionRule)(nil),  // 11: warden.service.v1.ImportPermissionRule
+	(DuplicateMatch)(0),           // 12: warden.service.v1.DuplicateMatch
+}
+var file_warden_service_v1_csv_transfer_proto_depIdxs = []int32{
+	0,  // 0: warden.service.v1.ImportFromCsvRequest.format:type_name -> warden.service.v1.CsvFormat
+	3,  // 1: warden.service.v1.ImportFromCsvRequest.column_mapping:type_name -> warden.service.v1.CsvColumnMapping
+	10, // 2: warden.service.v1.ImportFromCsvRequest.duplicate_handling:type_name -> warden.service.v1.DuplicateHandling
+	11, // 3: warden.service.v1.ImportFromCsvRequest.permission_rules:type_name -> warden.service.v1.ImportPermissionRule
+	12, // 4: warden.service.v1.ImportFromCsvRequest.duplicate_match:type_name -> warden.service.v1.DuplicateMatch
+	5,  // 5: warden.service.v1.ImportFromCsvResponse.errors:type_name -> warden.service.v1.CsvRowError
+	9,  // 6: warden.service.v1.ImportFromCsvResponse.folder_id_mapping:type_name -> warden.service.v1.ImportFromCsvResponse.FolderIdMappingEntry
+	1,  // 7: warden.service.v1.ExportToCsvRequest.columns:type_name -> warden.service.v1.CsvColumn
+	2,  // 8: warden.service.v1.ExportToCsvRequest.scope:type_name -> warden.service.v1.CsvExportScope
+	4,  // 9: warden.service.v1.WardenCsvTransferService.ImportFromCsv:input_type -> warden.service.v1.ImportFromCsvRequest
+	7,  // 10: warden.service.v1.WardenCsvTransferService.ExportToCsv:input_type -> warden.service.v1.ExportToCsvRequest
+	6,  // 11: warden.service.v1.WardenCsvTransferService.ImportFromCsv:output_type -> warden.service.v1.ImportFromCsvResponse
+	8,  // 12: warden.service.v1.WardenCsvTransferService.ExportToCsv:output_type -> warden.service.v1.ExportToCsvResponse
+	11, // [11:13] is the sub-list for method output_type
+	9,  // [9:11] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_csv_transfer_proto_init() }
+func file_warden_service_v1_csv_transfer_proto_init() {
+	if File_warden_service_v1_csv_transfer_proto != nil {
+		return
+	}
+	file_warden_service_v1_bitwarden_transfer_proto_init()
+	file_warden_service_v1_csv_transfer_proto_msgTypes[1].OneofWrappers = []any{}
+	file_warden_service_v1_csv_transfer_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_csv_transfer_proto_rawDesc), len(file_warden_service_v1_csv_transfer_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_csv_transfer_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_csv_transfer_proto_depIdxs,
+		EnumInfos:         file_warden_service_v1_csv_transfer_proto_enumTypes,
+		MessageInfos:      file_warden_service_v1_csv_transfer_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_csv_transfer_proto = out.File
+	file_warden_service_v1_csv_transfer_proto_goTypes = nil
+	file_warden_service_v1_csv_transfer_proto_depIdxs = nil
+}
@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/csv_transfer.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	annotations "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ annotations.FieldBehavior
+	_ redact.FieldRules
+)
+
+// RegisterRedactedWardenCsvTransferServiceServer wraps the WardenCsvTransferServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenCsvTransferServiceServer(s grpc.ServiceRegistrar, srv WardenCsvTransferServiceServer, bypass redact.Bypass) {
+	RegisterWardenCsvTransferServiceServer(s, RedactedWardenCsvTransferServiceServer(srv, bypass))
+}
+
+func RedactedWardenCsvTransferServiceServer(srv WardenCsvTransferServiceServer, bypass redact.Bypass) WardenCsvTransferServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenCsvTransferServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenCsvTransferServiceServer struct {
+	UnsafeWardenCsvTransferServiceServer
+	srv    WardenCsvTransferServiceServer
+	bypass redact.Bypass
+}
+
+// ImportFromCsv is the redacted wrapper for the actual WardenCsvTransferServiceServer.ImportFromCsv method
+// Unary RPC
+func (s *redactedWardenCsvTransferServiceServer) ImportFromCsv(ctx context.Context, in *ImportFromCsvRequest) (*ImportFromCsvResponse, error) {
+	res, err := s.srv.ImportFromCsv(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ExportToCsv is the redacted wrapper for the actual WardenCsvTransferServiceServer.ExportToCsv method
+// Unary RPC
+func (s *redactedWardenCsvTransferServiceServer) ExportToCsv(ctx context.Context, in *ExportToCsvRequest) (*ExportToCsvResponse, error) {
+	res, err := s.srv.ExportToCsv(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for CsvColumnMapping
+func (x *CsvColumnMapping) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Name
+
+	// Safe field: Url
+
+	// Safe field: Username
+
+	// Safe field: Password
+
+	// Safe field: Notes
+
+	// Safe field: Folder
+
+	// Safe field: Totp
+
+	// Safe field: FolderSeparator
+	return x.String()
+}
+
+// Redact method implementation for ImportFromCsvRequest
+func (x *ImportFromCsvRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Redacting field: CsvData
+	x.CsvData = ``
+
+	// Safe field: Format
+
+	// Safe field: ColumnMapping
+
+	// Safe field: TargetFolderId
+
+	// Safe field: DuplicateHandling
+
+	// Safe field: PreserveFolders
+
+	// Safe field: PermissionRules
+
+	// Safe field: DuplicateMatch
+	return x.String()
+}
+
+// Redact method implementation for CsvRowError
+func (x *CsvRowError) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Line
+
+	// Safe field: ItemName
+
+	// Safe field: ErrorType
+
+	// Safe field: Message
+	return x.String()
+}
+
+// Redact method implementation for ImportFromCsvResponse
+func (x *ImportFromCsvResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: RowsTotal
+
+	// Safe field: FoldersCreated
+
+	// Safe field: ItemsImported
+
+	// Safe field: ItemsUpdated
+
+	// Safe field: ItemsSkipped
+
+	// Safe field: ItemsFailed
+
+	// Safe field: Errors
+
+	// Safe field: FolderIdMapping
+	return x.String()
+}
+
+// Redact method implementation for ExportToCsvRequest
+func (x *ExportToCsvRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Columns
+
+	// Safe field: Scope
+
+	// Safe field: FolderId
+
+	// Safe field: Delimiter
+	return x.String()
+}
+
+// Redact method implementation for ExportToCsvResponse
+func (x *ExportToCsvResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Redacting field: CsvData
+	x.CsvData = ``
+
+	// Safe field: ItemsExported
+
+	// Safe field: ItemsSkipped
+
+	// Safe field: SuggestedFilename
+
+	// Safe field: ItemsExcludedByPolicy
+	return x.String()
+}
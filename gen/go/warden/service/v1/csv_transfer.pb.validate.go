@@ -0,0 +1,816 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/csv_transfer.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CsvColumnMapping with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CsvColumnMapping) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CsvColumnMapping with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CsvColumnMappingMultiError, or nil if none found.
+func (m *CsvColumnMapping) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CsvColumnMapping) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Url
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Notes
+
+	// no validation rules for Folder
+
+	// no validation rules for Totp
+
+	// no validation rules for FolderSeparator
+
+	if len(errors) > 0 {
+		return CsvColumnMappingMultiError(errors)
+	}
+
+	return nil
+}
+
+// CsvColumnMappingMultiError is an error wrapping multiple validation errors
+// returned by CsvColumnMapping.ValidateAll() if the designated constraints
+// aren't met.
+type CsvColumnMappingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CsvColumnMappingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CsvColumnMappingMultiError) AllErrors() []error { return m }
+
+// CsvColumnMappingValidationError is the validation error returned by
+// CsvColumnMapping.Validate if the designated constraints aren't met.
+type CsvColumnMappingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CsvColumnMappingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CsvColumnMappingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CsvColumnMappingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CsvColumnMappingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CsvColumnMappingValidationError) ErrorName() string { return "CsvColumnMappingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CsvColumnMappingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCsvColumnMapping.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CsvColumnMappingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CsvColumnMappingValidationError{}
+
+// Validate checks the field values on ImportFromCsvRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportFromCsvRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportFromCsvRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportFromCsvRequestMultiError, or nil if none found.
+func (m *ImportFromCsvRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportFromCsvRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CsvData
+
+	// no validation rules for Format
+
+	// no validation rules for DuplicateHandling
+
+	// no validation rules for PreserveFolders
+
+	for idx, item := range m.GetPermissionRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportFromCsvRequestValidationError{
+						field:  fmt.Sprintf("PermissionRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportFromCsvRequestValidationError{
+						field:  fmt.Sprintf("PermissionRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportFromCsvRequestValidationError{
+					field:  fmt.Sprintf("PermissionRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DuplicateMatch
+
+	if m.ColumnMapping != nil {
+
+		if all {
+			switch v := interface{}(m.GetColumnMapping()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportFromCsvRequestValidationError{
+						field:  "ColumnMapping",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportFromCsvRequestValidationError{
+						field:  "ColumnMapping",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetColumnMapping()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportFromCsvRequestValidationError{
+					field:  "ColumnMapping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TargetFolderId != nil {
+		// no validation rules for TargetFolderId
+	}
+
+	if len(errors) > 0 {
+		return ImportFromCsvRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportFromCsvRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportFromCsvRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportFromCsvRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportFromCsvRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportFromCsvRequestMultiError) AllErrors() []error { return m }
+
+// ImportFromCsvRequestValidationError is the validation error returned by
+// ImportFromCsvRequest.Validate if the designated constraints aren't met.
+type ImportFromCsvRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportFromCsvRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportFromCsvRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportFromCsvRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportFromCsvRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportFromCsvRequestValidationError) ErrorName() string {
+	return "ImportFromCsvRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportFromCsvRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportFromCsvRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportFromCsvRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportFromCsvRequestValidationError{}
+
+// Validate checks the field values on CsvRowError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CsvRowError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CsvRowError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CsvRowErrorMultiError, or
+// nil if none found.
+func (m *CsvRowError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CsvRowError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Line
+
+	// no validation rules for ItemName
+
+	// no validation rules for ErrorType
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return CsvRowErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// CsvRowErrorMultiError is an error wrapping multiple validation errors
+// returned by CsvRowError.ValidateAll() if the designated constraints aren't met.
+type CsvRowErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CsvRowErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CsvRowErrorMultiError) AllErrors() []error { return m }
+
+// CsvRowErrorValidationError is the validation error returned by
+// CsvRowError.Validate if the designated constraints aren't met.
+type CsvRowErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CsvRowErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CsvRowErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CsvRowErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CsvRowErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CsvRowErrorValidationError) ErrorName() string { return "CsvRowErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CsvRowErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCsvRowError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CsvRowErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CsvRowErrorValidationError{}
+
+// Validate checks the field values on ImportFromCsvResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportFromCsvResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportFromCsvResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportFromCsvResponseMultiError, or nil if none found.
+func (m *ImportFromCsvResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportFromCsvResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RowsTotal
+
+	// no validation rules for FoldersCreated
+
+	// no validation rules for ItemsImported
+
+	// no validation rules for ItemsUpdated
+
+	// no validation rules for ItemsSkipped
+
+	// no validation rules for ItemsFailed
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportFromCsvResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportFromCsvResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportFromCsvResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FolderIdMapping
+
+	if len(errors) > 0 {
+		return ImportFromCsvResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportFromCsvResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportFromCsvResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportFromCsvResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportFromCsvResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportFromCsvResponseMultiError) AllErrors() []error { return m }
+
+// ImportFromCsvResponseValidationError is the validation error returned by
+// ImportFromCsvResponse.Validate if the designated constraints aren't met.
+type ImportFromCsvResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportFromCsvResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportFromCsvResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportFromCsvResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportFromCsvResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportFromCsvResponseValidationError) ErrorName() string {
+	return "ImportFromCsvResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportFromCsvResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportFromCsvResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportFromCsvResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportFromCsvResponseValidationError{}
+
+// Validate checks the field values on ExportToCsvRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportToCsvRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportToCsvRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportToCsvRequestMultiError, or nil if none found.
+func (m *ExportToCsvRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportToCsvRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Scope
+
+	if m.FolderId != nil {
+		// no validation rules for FolderId
+	}
+
+	if m.Delimiter != nil {
+		// no validation rules for Delimiter
+	}
+
+	if len(errors) > 0 {
+		return ExportToCsvRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportToCsvRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportToCsvRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportToCsvRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportToCsvRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportToCsvRequestMultiError) AllErrors() []error { return m }
+
+// ExportToCsvRequestValidationError is the validation error returned by
+// ExportToCsvRequest.Validate if the designated constraints aren't met.
+type ExportToCsvRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportToCsvRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportToCsvRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportToCsvRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportToCsvRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportToCsvRequestValidationError) ErrorName() string {
+	return "ExportToCsvRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportToCsvRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportToCsvRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportToCsvRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportToCsvRequestValidationError{}
+
+// Validate checks the field values on ExportToCsvResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportToCsvResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportToCsvResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportToCsvResponseMultiError, or nil if none found.
+func (m *ExportToCsvResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportToCsvResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CsvData
+
+	// no validation rules for ItemsExported
+
+	// no validation rules for ItemsSkipped
+
+	// no validation rules for SuggestedFilename
+
+	// no validation rules for ItemsExcludedByPolicy
+
+	if len(errors) > 0 {
+		return ExportToCsvResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportToCsvResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportToCsvResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportToCsvResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportToCsvResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportToCsvResponseMultiError) AllErrors() []error { return m }
+
+// ExportToCsvResponseValidationError is the validation error returned by
+// ExportToCsvResponse.Validate if the designated constraints aren't met.
+type ExportToCsvResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportToCsvResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportToCsvResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportToCsvResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportToCsvResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportToCsvResponseValidationError) ErrorName() string {
+	return "ExportToCsvResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportToCsvResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportToCsvResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportToCsvResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportToCsvResponseValidationError{}
@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/csv_transfer.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenCsvTransferService_ImportFromCsv_FullMethodName = "/warden.service.v1.WardenCsvTransferService/ImportFromCsv"
+	WardenCsvTransferService_ExportToCsv_FullMethodName   = "/warden.service.v1.WardenCsvTransferService/ExportToCsv"
+)
+
+// WardenCsvTransferServiceClient is the client API for WardenCsvTransferService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CSV Transfer Service - imports password manager and browser CSV exports
+type WardenCsvTransferServiceClient interface {
+	// Import secrets from a CSV export (LastPass, Chrome/Edge or a custom column mapping)
+	ImportFromCsv(ctx context.Context, in *ImportFromCsvRequest, opts ...grpc.CallOption) (*ImportFromCsvResponse, error)
+	// Export secrets as CSV with a caller-chosen set of columns
+	ExportToCsv(ctx context.Context, in *ExportToCsvRequest, opts ...grpc.CallOption) (*ExportToCsvResponse, error)
+}
+
+type wardenCsvTransferServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenCsvTransferServiceClient(cc grpc.ClientConnInterface) WardenCsvTransferServiceClient {
+	return &wardenCsvTransferServiceClient{cc}
+}
+
+func (c *wardenCsvTransferServiceClient) ImportFromCsv(ctx context.Context, in *ImportFromCsvRequest, opts ...grpc.CallOption) (*ImportFromCsvResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportFromCsvResponse)
+	err := c.cc.Invoke(ctx, WardenCsvTransferService_ImportFromCsv_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenCsvTransferServiceClient) ExportToCsv(ctx context.Context, in *ExportToCsvRequest, opts ...grpc.CallOption) (*ExportToCsvResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportToCsvResponse)
+	err := c.cc.Invoke(ctx, WardenCsvTransferService_ExportToCsv_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenCsvTransferServiceServer is the server API for WardenCsvTransferService service.
+// All implementations must embed UnimplementedWardenCsvTransferServiceServer
+// for forward compatibility.
+//
+// CSV Transfer Service - imports password manager and browser CSV exports
+type WardenCsvTransferServiceServer interface {
+	// Import secrets from a CSV export (LastPass, Chrome/Edge or a custom column mapping)
+	ImportFromCsv(context.Context, *ImportFromCsvRequest) (*ImportFromCsvResponse, error)
+	// Export secrets as CSV with a caller-chosen set of columns
+	ExportToCsv(context.Context, *ExportToCsvRequest) (*ExportToCsvResponse, error)
+	mustEmbedUnimplementedWardenCsvTransferServiceServer()
+}
+
+// UnimplementedWardenCsvTransferServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenCsvTransferServiceServer struct{}
+
+func (UnimplementedWardenCsvTransferServiceServer) ImportFromCsv(context.Context, *ImportFromCsvRequest) (*ImportFromCsvResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportFromCsv not implemented")
+}
+func (UnimplementedWardenCsvTransferServiceServer) ExportToCsv(context.Context, *ExportToCsvRequest) (*ExportToCsvResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportToCsv not implemented")
+}
+func (UnimplementedWardenCsvTransferServiceServer) mustEmbedUnimplementedWardenCsvTransferServiceServer() {
+}
+func (UnimplementedWardenCsvTransferServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWardenCsvTransferServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenCsvTransferServiceServer will
+// result in compilation errors.
+type UnsafeWardenCsvTransferServiceServer interface {
+	mustEmbedUnimplementedWardenCsvTransferServiceServer()
+}
+
+func RegisterWardenCsvTransferServiceServer(s grpc.ServiceRegistrar, srv WardenCsvTransferServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenCsvTransferServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenCsvTransferService_ServiceDesc, srv)
+}
+
+func _WardenCsvTransferService_ImportFromCsv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFromCsvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenCsvTransferServiceServer).ImportFromCsv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenCsvTransferService_ImportFromCsv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenCsvTransferServiceServer).ImportFromCsv(ctx, req.(*ImportFromCsvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenCsvTransferService_ExportToCsv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportToCsvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenCsvTransferServiceServer).ExportToCsv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenCsvTransferService_ExportToCsv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenCsvTransferServiceServer).ExportToCsv(ctx, req.(*ExportToCsvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenCsvTransferService_ServiceDesc is the grpc.ServiceDesc for WardenCsvTransferService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenCsvTransferService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenCsvTransferService",
+	HandlerType: (*WardenCsvTransferServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ImportFromCsv",
+			Handler:    _WardenCsvTransferService_ImportFromCsv_Handler,
+		},
+		{
+			MethodName: "ExportToCsv",
+			Handler:    _WardenCsvTransferService_ExportToCsv_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/csv_transfer.proto",
+}
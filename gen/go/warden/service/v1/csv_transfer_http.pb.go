@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/csv_transfer.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenCsvTransferServiceExportToCsv = "/warden.service.v1.WardenCsvTransferService/ExportToCsv"
+const OperationWardenCsvTransferServiceImportFromCsv = "/warden.service.v1.WardenCsvTransferService/ImportFromCsv"
+
+type WardenCsvTransferServiceHTTPServer interface {
+	// ExportToCsv Export secrets as CSV with a caller-chosen set of columns
+	ExportToCsv(context.Context, *ExportToCsvRequest) (*ExportToCsvResponse, error)
+	// ImportFromCsv Import secrets from a CSV export (LastPass, Chrome/Edge or a custom column mapping)
+	ImportFromCsv(context.Context, *ImportFromCsvRequest) (*ImportFromCsvResponse, error)
+}
+
+func RegisterWardenCsvTransferServiceHTTPServer(s *http.Server, srv WardenCsvTransferServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/csv/import", _WardenCsvTransferService_ImportFromCsv0_HTTP_Handler(srv))
+	r.POST("/v1/csv/export", _WardenCsvTransferService_ExportToCsv0_HTTP_Handler(srv))
+}
+
+func _WardenCsvTransferService_ImportFromCsv0_HTTP_Handler(srv WardenCsvTransferServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportFromCsvRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenCsvTransferServiceImportFromCsv)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportFromCsv(ctx, req.(*ImportFromCsvRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportFromCsvResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenCsvTransferService_ExportToCsv0_HTTP_Handler(srv WardenCsvTransferServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportToCsvRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenCsvTransferServiceExportToCsv)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportToCsv(ctx, req.(*ExportToCsvRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportToCsvResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenCsvTransferServiceHTTPClient interface {
+	// ExportToCsv Export secrets as CSV with a caller-chosen set of columns
+	ExportToCsv(ctx context.Context, req *ExportToCsvRequest, opts ...http.CallOption) (rsp *ExportToCsvResponse, err error)
+	// ImportFromCsv Import secrets from a CSV export (LastPass, Chrome/Edge or a custom column mapping)
+	ImportFromCsv(ctx context.Context, req *ImportFromCsvRequest, opts ...http.CallOption) (rsp *ImportFromCsvResponse, err error)
+}
+
+type WardenCsvTransferServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenCsvTransferServiceHTTPClient(client *http.Client) WardenCsvTransferServiceHTTPClient {
+	return &WardenCsvTransferServiceHTTPClientImpl{client}
+}
+
+// ExportToCsv Export secrets as CSV with a caller-chosen set of columns
+func (c *WardenCsvTransferServiceHTTPClientImpl) ExportToCsv(ctx context.Context, in *ExportToCsvRequest, opts ...http.CallOption) (*ExportToCsvResponse, error) {
+	var out ExportToCsvResponse
+	pattern := "/v1/csv/export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenCsvTransferServiceExportToCsv))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportFromCsv Import secrets from a CSV export (LastPass, Chrome/Edge or a custom column mapping)
+func (c *WardenCsvTransferServiceHTTPClientImpl) ImportFromCsv(ctx context.Context, in *ImportFromCsvRequest, opts ...http.CallOption) (*ImportFromCsvResponse, error) {
+	var out ImportFromCsvResponse
+	pattern := "/v1/csv/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenCsvTransferServiceImportFromCsv))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,807 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/deletion_request.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Kind of resource a deletion request is for
+type DeletionResourceType int32
+
+const (
+	DeletionResourceType_DELETION_RESOURCE_TYPE_UNSPECIFIED DeletionResourceType = 0
+	DeletionResourceType_DELETION_RESOURCE_TYPE_SECRET      DeletionResourceType = 1
+	DeletionResourceType_DELETION_RESOURCE_TYPE_FOLDER      DeletionResourceType = 2
+)
+
+// Enum value maps for DeletionResourceType.
+var (
+	DeletionResourceType_name = map[int32]string{
+		0: "DELETION_RESOURCE_TYPE_UNSPECIFIED",
+		1: "DELETION_RESOURCE_TYPE_SECRET",
+		2: "DELETION_RESOURCE_TYPE_FOLDER",
+	}
+	DeletionResourceType_value = map[string]int32{
+		"DELETION_RESOURCE_TYPE_UNSPECIFIED": 0,
+		"DELETION_RESOURCE_TYPE_SECRET":      1,
+		"DELETION_RESOURCE_TYPE_FOLDER":      2,
+	}
+)
+
+func (x DeletionResourceType) Enum() *DeletionResourceType {
+	p := new(DeletionResourceType)
+	*p = x
+	return p
+}
+
+func (x DeletionResourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeletionResourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_deletion_request_proto_enumTypes[0].Descriptor()
+}
+
+func (DeletionResourceType) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_deletion_request_proto_enumTypes[0]
+}
+
+func (x DeletionResourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeletionResourceType.Descriptor instead.
+func (DeletionResourceType) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_deletion_request_proto_rawDescGZIP(), []int{0}
+}
+
+// Deletion request state
+type DeletionRequestStatus int32
+
+const (
+	DeletionRequestStatus_DELETION_REQUEST_STATUS_UNSPECIFIED DeletionRequestStatus = 0
+	DeletionRequestStatus_DELETION_REQUEST_STATUS_PENDING     DeletionRequestStatus = 1 // Waiting for a second owner
+	DeletionRequestStatus_DELETION_REQUEST_STATUS_APPROVED    DeletionRequestStatus = 2 // Approved and the resource deleted
+	DeletionRequestStatus_DELETION_REQUEST_STATUS_REJECTED    DeletionRequestStatus = 3 // Rejected by another owner
+	DeletionRequestStatus_DELETION_REQUEST_STATUS_WITHDRAWN   DeletionRequestStatus = 4 // Withdrawn by the requester
+	DeletionRequestStatus_DELETION_REQUEST_STATUS_EXPIRED     DeletionRequestStatus = 5 // Not decided in time
+)
+
+// Enum value maps for DeletionRequestStatus.
+var (
+	DeletionRequestStatus_name = map[int32]string{
+		0: "DELETION_REQUEST_STATUS_UNSPECIFIED",
+		1: "DELETION_REQUEST_STATUS_PENDING",
+		2: "DELETION_REQUEST_STATUS_APPROVED",
+		3: "DELETION_REQUEST_STATUS_REJECTED",
+		4: "DELETION_REQUEST_STATUS_WITHDRAWN",
+		5: "DELETION_REQUEST_STATUS_EXPIRED",
+	}
+	DeletionRequestStatus_value = map[string]int32{
+		"DELETION_REQUEST_STATUS_UNSPECIFIED": 0,
+		"DELETION_REQUEST_STATUS_PENDING":     1,
+		"DELETION_REQUEST_STATUS_APPROVED":    2,
+		"DELETION_REQUEST_STATUS_REJECTED":    3,
+		"DELETION_REQUEST_STATUS_WITHDRAWN":   4,
+		"DELETION_REQUEST_STATUS_EXPIRED":     5,
+	}
+)
+
+func (x DeletionRequestStatus) Enum() *DeletionRequestStatus {
+	p := new(DeletionRequestStatus)
+	*p = x
+	return p
+}
+
+func (x DeletionRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeletionRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_deletion_request_proto_enumTypes[1].Descriptor()
+}
+
+func (DeletionRequestStatus) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_deletion_request_proto_enumTypes[1]
+}
+
+func (x DeletionRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeletionRequestStatus.Descriptor instead.
+func (DeletionRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_deletion_request_proto_rawDescGZIP(), []int{1}
+}
+
+// Deletion request entity
+type DeletionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResourceType DeletionResourceType   `protobuf:"varint,2,opt,name=resource_type,json=resourceType,proto3,enum=warden.service.v1.DeletionResourceType" json:"resource_type,omitempty"`
+	ResourceId   string                 `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Secret name or folder path when the request was made
+	ResourceName string `protobuf:"bytes,4,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	// Folder deletions: delete the folder with everything in it
+	Force         bool                   `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status        DeletionRequestStatus  `protobuf:"varint,7,opt,name=status,proto3,enum=warden.service.v1.DeletionRequestStatus" json:"status,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,8,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	DecidedBy     *string                `protobuf:"bytes,9,opt,name=decided_by,json=decidedBy,proto3,oneof" json:"decided_by,omitempty"`
+	DecidedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=decided_at,json=decidedAt,proto3,oneof" json:"decided_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletionRequest) Reset() {
+	*x = DeletionRequest{}
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletionRequest) ProtoMessage() {}
+
+func (x *DeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletionRequest.ProtoReflect.Descriptor instead.
+func (*DeletionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_deletion_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeletionRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeletionRequest) GetResourceType() DeletionResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return DeletionResourceType_DELETION_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *DeletionRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *DeletionRequest) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *DeletionRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeletionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeletionRequest) GetStatus() DeletionRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeletionRequestStatus_DELETION_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *DeletionRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *DeletionRequest) GetDecidedBy() string {
+	if x != nil && x.DecidedBy != nil {
+		return *x.DecidedBy
+	}
+	return ""
+}
+
+func (x *DeletionRequest) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *DeletionRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *DeletionRequest) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type RequestDeletionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType DeletionResourceType   `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=warden.service.v1.DeletionResourceType" json:"resource_type,omitempty"`
+	ResourceId   string                 `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Folder deletions: delete the folder with everything in it
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// Why the resource should be deleted, shown to the approver
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDeletionRequest) Reset() {
+	*x = RequestDeletionRequest{}
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDeletionRequest) ProtoMessage() {}
+
+func (x *RequestDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDeletionRequest.ProtoReflect.Descriptor instead.
+func (*RequestDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_deletion_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestDeletionRequest) GetResourceType() DeletionResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return DeletionResourceType_DELETION_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *RequestDeletionRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *RequestDeletionRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *RequestDeletionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RequestDeletionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DeletionRequest *DeletionRequest       `protobuf:"bytes,1,opt,name=deletion_request,json=deletionRequest,proto3" json:"deletion_request,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestDeletionResponse) Reset() {
+	*x = RequestDeletionResponse{}
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDeletionResponse) ProtoMessage() {}
+
+func (x *RequestDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDeletionResponse.ProtoReflect.Descriptor instead.
+func (*RequestDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_deletion_request_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RequestDeletionResponse) GetDeletionRequest() *DeletionRequest {
+	if x != nil {
+		return x.DeletionRequest
+	}
+	return nil
+}
+
+type ListDeletionRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only requests in this state
+	Status *DeletionRequestStatus `protobuf:"varint,1,opt,name=status,proto3,enum=warden.service.v1.DeletionRequestStatus,oneof" json:"status,omitempty"`
+	// Only requests for this resource
+	ResourceId    *string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletionRequestsRequest) Reset() {
+	*x = ListDeletionRequestsRequest{}
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletionRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletionRequestsRequest) ProtoMessage() {}
+
+func (x *ListDeletionRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletionRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletionRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_deletion_request_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDeletionRequestsRequest) GetStatus() DeletionRequestStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return DeletionRequestStatus_DELETION_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListDeletionRequestsRequest) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
+}
+
+type ListDeletionRequestsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DeletionRequests []*DeletionRequest     `protobuf:"bytes,1,rep,name=deletion_requests,json=deletionRequests,proto3" json:"deletion_requests,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListDeletionRequestsResponse) Reset() {
+	*x = ListDeletionRequestsResponse{}
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletionRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletionRequestsResponse) ProtoMessage() {}
+
+func (x *ListDeletionRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletionRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletionRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_deletion_request_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDeletionRequestsResponse) GetDeletionRequests() []*DeletionRequest {
+	if x != nil {
+		return x.DeletionRequests
+	}
+	return nil
+}
+
+type ApproveDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveDeletionRequest) Reset() {
+	*x = ApproveDeletionRequest{}
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDeletionRequest) ProtoMessage() {}
+
+func (x *ApproveDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveDeletionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_deletion_request_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproveDeletionRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ApproveDeletionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DeletionRequest *DeletionRequest       `protobuf:"bytes,1,opt,name=deletion_request,json=deletionRequest,proto3" json:"deletion_request,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApproveDeletionResponse) Reset() {
+	*x = ApproveDeletionResponse{}
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDeletionResponse) ProtoMessage() {}
+
+func (x *ApproveDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveDeletionResponse.ProtoReflect.Descriptor instead.
+func (*ApproveDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_deletion_request_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApproveDeletionResponse) GetDeletionRequest() *DeletionRequest {
+	if x != nil {
+		return x.DeletionRequest
+	}
+	return nil
+}
+
+type RejectDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectDeletionRequest) Reset() {
+	*x = RejectDeletionRequest{}
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectDeletionRequest) ProtoMessage() {}
+
+func (x *RejectDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectDeletionRequest.ProtoReflect.Descriptor instead.
+func (*RejectDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_deletion_request_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RejectDeletionRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejectDeletionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DeletionRequest *DeletionRequest       `protobuf:"bytes,1,opt,name=deletion_request,json=deletionRequest,proto3" json:"deletion_request,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RejectDeletionResponse) Reset() {
+	*x = RejectDeletionResponse{}
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectDeletionResponse) ProtoMessage() {}
+
+func (x *RejectDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_deletion_request_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectDeletionResponse.ProtoReflect.Descriptor instead.
+func (*RejectDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_deletion_request_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectDeletionResponse) GetDeletionRequest() *DeletionRequest {
+	if x != nil {
+		return x.DeletionRequest
+	}
+	return nil
+}
+
+var File_warden_service_v1_deletion_request_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_deletion_request_proto_rawDesc = "" +
+	"\n" +
+	"(warden/service/v1/deletion_request.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc2\x04\n" +
+	"\x0fDeletionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12L\n" +
+	"\rresource_type\x18\x02 \x01(\x0e2'.warden.service.v1.DeletionResourceTypeR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\tR\n" +
+	"resourceId\x12#\n" +
+	"\rresource_name\x18\x04 \x01(\tR\fresourceName\x12\x14\n" +
+	"\x05force\x18\x05 \x01(\bR\x05force\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12@\n" +
+	"\x06status\x18\a \x01(\x0e2(.warden.service.v1.DeletionRequestStatusR\x06status\x12!\n" +
+	"\frequested_by\x18\b \x01(\tR\vrequestedBy\x12\"\n" +
+	"\n" +
+	"decided_by\x18\t \x01(\tH\x00R\tdecidedBy\x88\x01\x01\x12>\n" +
+	"\n" +
+	"decided_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tdecidedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"expires_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12;\n" +
+	"\vcreate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTimeB\r\n" +
+	"\v_decided_byB\r\n" +
+	"\v_decided_at\"\xee\x01\n" +
+	"\x16RequestDeletionRequest\x12[\n" +
+	"\rresource_type\x18\x01 \x01(\x0e2'.warden.service.v1.DeletionResourceTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\fresourceType\x12?\n" +
+	"\vresource_id\x18\x02 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\n" +
+	"resourceId\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\x12 \n" +
+	"\x06reason\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\x06reason\"h\n" +
+	"\x17RequestDeletionResponse\x12M\n" +
+	"\x10deletion_request\x18\x01 \x01(\v2\".warden.service.v1.DeletionRequestR\x0fdeletionRequest\"\xb8\x01\n" +
+	"\x1bListDeletionRequestsRequest\x12O\n" +
+	"\x06status\x18\x01 \x01(\x0e2(.warden.service.v1.DeletionRequestStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\x06status\x88\x01\x01\x12-\n" +
+	"\vresource_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18$H\x01R\n" +
+	"resourceId\x88\x01\x01B\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_resource_id\"o\n" +
+	"\x1cListDeletionRequestsResponse\x12O\n" +
+	"\x11deletion_requests\x18\x01 \x03(\v2\".warden.service.v1.DeletionRequestR\x10deletionRequests\"1\n" +
+	"\x16ApproveDeletionRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x02id\"h\n" +
+	"\x17ApproveDeletionResponse\x12M\n" +
+	"\x10deletion_request\x18\x01 \x01(\v2\".warden.service.v1.DeletionRequestR\x0fdeletionRequest\"0\n" +
+	"\x15RejectDeletionRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x02id\"g\n" +
+	"\x16RejectDeletionResponse\x12M\n" +
+	"\x10deletion_request\x18\x01 \x01(\v2\".warden.service.v1.DeletionRequestR\x0fdeletionRequest*\x84\x01\n" +
+	"\x14DeletionResourceType\x12&\n" +
+	"\"DELETION_RESOURCE_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dDELETION_RESOURCE_TYPE_SECRET\x10\x01\x12!\n" +
+	"\x1dDELETION_RESOURCE_TYPE_FOLDER\x10\x02*\xfd\x01\n" +
+	"\x15DeletionRequestStatus\x12'\n" +
+	"#DELETION_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fDELETION_REQUEST_STATUS_PENDING\x10\x01\x12$\n" +
+	" DELETION_REQUEST_STATUS_APPROVED\x10\x02\x12$\n" +
+	" DELETION_REQUEST_STATUS_REJECTED\x10\x03\x12%\n" +
+	"!DELETION_REQUEST_STATUS_WITHDRAWN\x10\x04\x12#\n" +
+	"\x1fDELETION_REQUEST_STATUS_EXPIRED\x10\x052\xf4\x04\n" +
+	"\x1cWardenDeletionRequestService\x12\x8a\x01\n" +
+	"\x0fRequestDeletion\x12).warden.service.v1.RequestDeletionRequest\x1a*.warden.service.v1.RequestDeletionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/deletion-requests\x12\x96\x01\n" +
+	"\x14ListDeletionRequests\x12..warden.service.v1.ListDeletionRequestsRequest\x1a/.warden.service.v1.ListDeletionRequestsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/deletion-requests\x12\x97\x01\n" +
+	"\x0fApproveDeletion\x12).warden.service.v1.ApproveDeletionRequest\x1a*.warden.service.v1.ApproveDeletionResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/deletion-requests/{id}:approve\x12\x93\x01\n" +
+	"\x0eRejectDeletion\x12(.warden.service.v1.RejectDeletionRequest\x1a).warden.service.v1.RejectDeletionResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/deletion-requests/{id}:rejectB\xdc\x01\n" +
+	"\x15com.warden.service.v1B\x14DeletionRequestProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_deletion_request_proto_rawDescOnce sync.Once
+	file_warden_service_v1_deletion_request_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_deletion_request_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_deletion_request_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_deletion_request_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_deletion_request_proto_rawDesc), len(file_warden_service_v1_deletion_request_proto_rawDesc)))
+	})
+	return file_warden_service_v1_deletion_request_proto_rawDescData
+}
+
+var file_warden_service_v1_deletion_request_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_warden_service_v1_deletion_request_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_warden_service_v1_deletion_request_proto_goTypes = []any{
+	(DeletionResourceType)(0),            // 0: warden.service.v1.DeletionResourceType
+	(DeletionRequestStatus)(0),           // 1: warden.service.v1.DeletionRequestStatus
+	(*DeletionRequest)(nil),              // 2: warden.service.v1.DeletionRequest
+	(*RequestDeletionRequest)(nil),       // 3: warden.service.v1.RequestDeletionRequest
+	(*RequestDeletionResponse)(nil),      // 4: warden.service.v1.RequestDeletionResponse
+	(*ListDeletionRequestsRequest)(nil),  // 5: warden.service.v1.ListDeletionRequestsRequest
+	(*ListDeletionRequestsResponse)(nil), // 6: warden.service.v1.ListDeletionRequestsResponse
+	(*ApproveDeletionRequest)(nil),       // 7: warden.service.v1.ApproveDeletionRequest
+	(*ApproveDeletionResponse)(nil),      // 8: warden.service.v1.ApproveDeletionResponse
+	(*RejectDeletionRequest)(nil),        // 9: warden.service.v1.RejectDeletionRequest
+	(*RejectDeletionResponse)(nil),       // 10: warden.service.v1.RejectDeletionResponse
+	(*timestamppb.Timestamp)(nil),        // 11: google.protobuf.Timestamp
+}
+var file_warden_service_v1_deletion_request_proto_depIdxs = []int32{
+	0,  // 0: warden.service.v1.DeletionRequest.resource_type:type_name -> warden.service.v1.DeletionResourceType
+	1,  // 1: warden.service.v1.DeletionRequest.status:type_name -> warden.service.v1.DeletionRequestStatus
+	11, // 2: warden.service.v1.DeletionRequest.decided_at:type_name -> google.protobuf.Timestamp
+	11, // 3: warden.service.v1.DeletionRequest.expires_at:type_name -> google.protobuf.Timestamp
+	11, // 4: warden.service.v1.DeletionRequest.create_time:type_name -> google.protobuf.Timestamp
+	0,  // 5: warden.service.v1.RequestDeletionRequest.resource_type:type_name -> warden.service.v1.DeletionResourceType
+	2,  // 6: warden.service.v1.RequestDeletionResponse.deletion_request:type_name -> warden.service.v1.DeletionRequest
+	1,  // 7: warden.service.v1.ListDeletionRequestsRequest.status:type_name -> warden.service.v1.DeletionRequestStatus
+	2,  // 8: warden.service.v1.ListDeletionRequestsResponse.deletion_requests:type_name -> warden.service.v1.DeletionRequest
+	2,  // 9: warden.service.v1.ApproveDeletionResponse.deletion_request:type_name -> warden.service.v1.DeletionRequest
+	2,  // 10: warden.service.v1.RejectDeletionResponse.deletion_request:type_name -> warden.service.v1.DeletionRequest
+	3,  // 11: warden.service.v1.WardenDeletionRequestService.RequestDeletion:input_type -> warden.service.v1.RequestDeletionRequest
+	5,  // 12: warden.service.v1.WardenDeletionRequestService.ListDeletionRequests:input_type -> warden.service.v1.ListDeletionRequestsRequest
+	7,  // 13: warden.service.v1.WardenDeletionRequestService.ApproveDeletion:input_type -> warden.service.v1.ApproveDeletionRequest
+	9,  // 14: warden.service.v1.WardenDeletionRequestService.RejectDeletion:input_type -> warden.service.v1.RejectDeletionRequest
+	4,  // 15: warden.service.v1.WardenDeletionRequestService.RequestDeletion:output_type -> warden.service.v1.RequestDeletionResponse
+	6,  // 16: warden.service.v1.WardenDeletionRequestService.ListDeletionRequests:output_type -> warden.service.v1.ListDeletionRequestsResponse
+	8,  // 17: warden.service.v1.WardenDeletionRequestService.ApproveDeletion:output_type -> warden.service.v1.ApproveDeletionResponse
+	10, // 18: warden.service.v1.WardenDeletionRequestService.RejectDeletion:output_type -> warden.service.v1.RejectDeletionResponse
+	15, // [15:19] is the sub-list for method output_type
+	11, // [11:15] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_deletion_request_proto_init() }
+func file_warden_service_v1_deletion_request_proto_init() {
+	if File_warden_service_v1_deletion_request_proto != nil {
+		return
+	}
+	file_warden_service_v1_deletion_request_proto_msgTypes[0].OneofWrappers = []any{}
+	file_warden_service_v1_deletion_request_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_deletion_request_proto_rawDesc), len(file_warden_service_v1_deletion_request_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_deletion_request_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_deletion_request_proto_depIdxs,
+		EnumInfos:         file_warden_service_v1_deletion_request_proto_enumTypes,
+		MessageInfos:      file_warden_service_v1_deletion_request_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_deletion_request_proto = out.File
+	file_warden_service_v1_deletion_request_proto_goTypes = nil
+	file_warden_service_v1_deletion_request_proto_depIdxs = nil
+}
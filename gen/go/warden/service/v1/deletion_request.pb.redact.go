@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/deletion_request.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	annotations "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ annotations.FieldBehavior
+	_ timestamppb.Timestamp
+)
+
+// RegisterRedactedWardenDeletionRequestServiceServer wraps the WardenDeletionRequestServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenDeletionRequestServiceServer(s grpc.ServiceRegistrar, srv WardenDeletionRequestServiceServer, bypass redact.Bypass) {
+	RegisterWardenDeletionRequestServiceServer(s, RedactedWardenDeletionRequestServiceServer(srv, bypass))
+}
+
+func RedactedWardenDeletionRequestServiceServer(srv WardenDeletionRequestServiceServer, bypass redact.Bypass) WardenDeletionRequestServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenDeletionRequestServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenDeletionRequestServiceServer struct {
+	UnsafeWardenDeletionRequestServiceServer
+	srv    WardenDeletionRequestServiceServer
+	bypass redact.Bypass
+}
+
+// RequestDeletion is the redacted wrapper for the actual WardenDeletionRequestServiceServer.RequestDeletion method
+// Unary RPC
+func (s *redactedWardenDeletionRequestServiceServer) RequestDeletion(ctx context.Context, in *RequestDeletionRequest) (*RequestDeletionResponse, error) {
+	res, err := s.srv.RequestDeletion(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListDeletionRequests is the redacted wrapper for the actual WardenDeletionRequestServiceServer.ListDeletionRequests method
+// Unary RPC
+func (s *redactedWardenDeletionRequestServiceServer) ListDeletionRequests(ctx context.Context, in *ListDeletionRequestsRequest) (*ListDeletionRequestsResponse, error) {
+	res, err := s.srv.ListDeletionRequests(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ApproveDeletion is the redacted wrapper for the actual WardenDeletionRequestServiceServer.ApproveDeletion method
+// Unary RPC
+func (s *redactedWardenDeletionRequestServiceServer) ApproveDeletion(ctx context.Context, in *ApproveDeletionRequest) (*ApproveDeletionResponse, error) {
+	res, err := s.srv.ApproveDeletion(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RejectDeletion is the redacted wrapper for the actual WardenDeletionRequestServiceServer.RejectDeletion method
+// Unary RPC
+func (s *redactedWardenDeletionRequestServiceServer) RejectDeletion(ctx context.Context, in *RejectDeletionRequest) (*RejectDeletionResponse, error) {
+	res, err := s.srv.RejectDeletion(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for DeletionRequest
+func (x *DeletionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: ResourceType
+
+	// Safe field: ResourceId
+
+	// Safe field: ResourceName
+
+	// Safe field: Force
+
+	// Safe field: Reason
+
+	// Safe field: Status
+
+	// Safe field: RequestedBy
+
+	// Safe field: DecidedBy
+
+	// Safe field: DecidedAt
+
+	// Safe field: ExpiresAt
+
+	// Safe field: CreateTime
+	return x.String()
+}
+
+// Redact method implementation for RequestDeletionRequest
+func (x *RequestDeletionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ResourceType
+
+	// Safe field: ResourceId
+
+	// Safe field: Force
+
+	// Safe field: Reason
+	return x.String()
+}
+
+// Redact method implementation for RequestDeletionResponse
+func (x *RequestDeletionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: DeletionRequest
+	return x.String()
+}
+
+// Redact method implementation for ListDeletionRequestsRequest
+func (x *ListDeletionRequestsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Status
+
+	// Safe field: ResourceId
+	return x.String()
+}
+
+// Redact method implementation for ListDeletionRequestsResponse
+func (x *ListDeletionRequestsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: DeletionRequests
+	return x.String()
+}
+
+// Redact method implementation for ApproveDeletionRequest
+func (x *ApproveDeletionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for ApproveDeletionResponse
+func (x *ApproveDeletionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: DeletionRequest
+	return x.String()
+}
+
+// Redact method implementation for RejectDeletionRequest
+func (x *RejectDeletionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for RejectDeletionResponse
+func (x *RejectDeletionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: DeletionRequest
+	return x.String()
+}
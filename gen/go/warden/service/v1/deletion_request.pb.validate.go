@@ -0,0 +1,1206 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/deletion_request.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DeletionRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeletionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletionRequestMultiError, or nil if none found.
+func (m *DeletionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceId
+
+	// no validation rules for ResourceName
+
+	// no validation rules for Force
+
+	// no validation rules for Reason
+
+	// no validation rules for Status
+
+	// no validation rules for RequestedBy
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeletionRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeletionRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletionRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeletionRequestValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeletionRequestValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletionRequestValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.DecidedBy != nil {
+		// no validation rules for DecidedBy
+	}
+
+	if m.DecidedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDecidedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeletionRequestValidationError{
+						field:  "DecidedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeletionRequestValidationError{
+						field:  "DecidedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDecidedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeletionRequestValidationError{
+					field:  "DecidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeletionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletionRequestMultiError is an error wrapping multiple validation errors
+// returned by DeletionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeletionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletionRequestMultiError) AllErrors() []error { return m }
+
+// DeletionRequestValidationError is the validation error returned by
+// DeletionRequest.Validate if the designated constraints aren't met.
+type DeletionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletionRequestValidationError) ErrorName() string { return "DeletionRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeletionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletionRequestValidationError{}
+
+// Validate checks the field values on RequestDeletionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestDeletionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestDeletionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestDeletionRequestMultiError, or nil if none found.
+func (m *RequestDeletionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestDeletionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceId
+
+	// no validation rules for Force
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return RequestDeletionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestDeletionRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestDeletionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestDeletionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestDeletionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestDeletionRequestMultiError) AllErrors() []error { return m }
+
+// RequestDeletionRequestValidationError is the validation error returned by
+// RequestDeletionRequest.Validate if the designated constraints aren't met.
+type RequestDeletionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestDeletionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestDeletionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestDeletionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestDeletionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestDeletionRequestValidationError) ErrorName() string {
+	return "RequestDeletionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestDeletionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestDeletionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestDeletionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestDeletionRequestValidationError{}
+
+// Validate checks the field values on RequestDeletionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestDeletionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestDeletionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestDeletionResponseMultiError, or nil if none found.
+func (m *RequestDeletionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestDeletionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeletionRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestDeletionResponseValidationError{
+					field:  "DeletionRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestDeletionResponseValidationError{
+					field:  "DeletionRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletionRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestDeletionResponseValidationError{
+				field:  "DeletionRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestDeletionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestDeletionResponseMultiError is an error wrapping multiple validation
+// errors returned by RequestDeletionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RequestDeletionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestDeletionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestDeletionResponseMultiError) AllErrors() []error { return m }
+
+// RequestDeletionResponseValidationError is the validation error returned by
+// RequestDeletionResponse.Validate if the designated constraints aren't met.
+type RequestDeletionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestDeletionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestDeletionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestDeletionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestDeletionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestDeletionResponseValidationError) ErrorName() string {
+	return "RequestDeletionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestDeletionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestDeletionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestDeletionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestDeletionResponseValidationError{}
+
+// Validate checks the field values on ListDeletionRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletionRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletionRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletionRequestsRequestMultiError, or nil if none found.
+func (m *ListDeletionRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletionRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.ResourceId != nil {
+		// no validation rules for ResourceId
+	}
+
+	if len(errors) > 0 {
+		return ListDeletionRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletionRequestsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListDeletionRequestsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListDeletionRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletionRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletionRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListDeletionRequestsRequestValidationError is the validation error returned
+// by ListDeletionRequestsRequest.Validate if the designated constraints
+// aren't met.
+type ListDeletionRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletionRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletionRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletionRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletionRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletionRequestsRequestValidationError) ErrorName() string {
+	return "ListDeletionRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletionRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletionRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletionRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletionRequestsRequestValidationError{}
+
+// Validate checks the field values on ListDeletionRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletionRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletionRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletionRequestsResponseMultiError, or nil if none found.
+func (m *ListDeletionRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletionRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeletionRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletionRequestsResponseValidationError{
+						field:  fmt.Sprintf("DeletionRequests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletionRequestsResponseValidationError{
+						field:  fmt.Sprintf("DeletionRequests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeletionRequestsResponseValidationError{
+					field:  fmt.Sprintf("DeletionRequests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDeletionRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletionRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDeletionRequestsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListDeletionRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletionRequestsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletionRequestsResponseMultiError) AllErrors() []error { return m }
+
+// ListDeletionRequestsResponseValidationError is the validation error returned
+// by ListDeletionRequestsResponse.Validate if the designated constraints
+// aren't met.
+type ListDeletionRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletionRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletionRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletionRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletionRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletionRequestsResponseValidationError) ErrorName() string {
+	return "ListDeletionRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletionRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletionRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletionRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletionRequestsResponseValidationError{}
+
+// Validate checks the field values on ApproveDeletionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveDeletionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveDeletionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveDeletionRequestMultiError, or nil if none found.
+func (m *ApproveDeletionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveDeletionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ApproveDeletionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveDeletionRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveDeletionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveDeletionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveDeletionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveDeletionRequestMultiError) AllErrors() []error { return m }
+
+// ApproveDeletionRequestValidationError is the validation error returned by
+// ApproveDeletionRequest.Validate if the designated constraints aren't met.
+type ApproveDeletionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveDeletionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveDeletionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveDeletionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveDeletionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveDeletionRequestValidationError) ErrorName() string {
+	return "ApproveDeletionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveDeletionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveDeletionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveDeletionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveDeletionRequestValidationError{}
+
+// Validate checks the field values on ApproveDeletionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveDeletionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveDeletionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveDeletionResponseMultiError, or nil if none found.
+func (m *ApproveDeletionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveDeletionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeletionRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveDeletionResponseValidationError{
+					field:  "DeletionRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveDeletionResponseValidationError{
+					field:  "DeletionRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletionRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveDeletionResponseValidationError{
+				field:  "DeletionRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApproveDeletionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveDeletionResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveDeletionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveDeletionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveDeletionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveDeletionResponseMultiError) AllErrors() []error { return m }
+
+// ApproveDeletionResponseValidationError is the validation error returned by
+// ApproveDeletionResponse.Validate if the designated constraints aren't met.
+type ApproveDeletionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveDeletionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveDeletionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveDeletionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveDeletionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveDeletionResponseValidationError) ErrorName() string {
+	return "ApproveDeletionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveDeletionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveDeletionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveDeletionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveDeletionResponseValidationError{}
+
+// Validate checks the field values on RejectDeletionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectDeletionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectDeletionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectDeletionRequestMultiError, or nil if none found.
+func (m *RejectDeletionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectDeletionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RejectDeletionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectDeletionRequestMultiError is an error wrapping multiple validation
+// errors returned by RejectDeletionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RejectDeletionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectDeletionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectDeletionRequestMultiError) AllErrors() []error { return m }
+
+// RejectDeletionRequestValidationError is the validation error returned by
+// RejectDeletionRequest.Validate if the designated constraints aren't met.
+type RejectDeletionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectDeletionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectDeletionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectDeletionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectDeletionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectDeletionRequestValidationError) ErrorName() string {
+	return "RejectDeletionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectDeletionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectDeletionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectDeletionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectDeletionRequestValidationError{}
+
+// Validate checks the field values on RejectDeletionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectDeletionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectDeletionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectDeletionResponseMultiError, or nil if none found.
+func (m *RejectDeletionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectDeletionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeletionRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RejectDeletionResponseValidationError{
+					field:  "DeletionRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RejectDeletionResponseValidationError{
+					field:  "DeletionRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletionRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RejectDeletionResponseValidationError{
+				field:  "DeletionRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RejectDeletionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectDeletionResponseMultiError is an error wrapping multiple validation
+// errors returned by RejectDeletionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RejectDeletionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectDeletionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectDeletionResponseMultiError) AllErrors() []error { return m }
+
+// RejectDeletionResponseValidationError is the validation error returned by
+// RejectDeletionResponse.Validate if the designated constraints aren't met.
+type RejectDeletionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectDeletionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectDeletionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectDeletionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectDeletionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectDeletionResponseValidationError) ErrorName() string {
+	return "RejectDeletionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectDeletionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectDeletionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectDeletionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectDeletionResponseValidationError{}
@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/deletion_request.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenDeletionRequestService_RequestDeletion_FullMethodName      = "/warden.service.v1.WardenDeletionRequestService/RequestDeletion"
+	WardenDeletionRequestService_ListDeletionRequests_FullMethodName = "/warden.service.v1.WardenDeletionRequestService/ListDeletionRequests"
+	WardenDeletionRequestService_ApproveDeletion_FullMethodName      = "/warden.service.v1.WardenDeletionRequestService/ApproveDeletion"
+	WardenDeletionRequestService_RejectDeletion_FullMethodName       = "/warden.service.v1.WardenDeletionRequestService/RejectDeletion"
+)
+
+// WardenDeletionRequestServiceClient is the client API for WardenDeletionRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Deletion Request Service - dual control for protected secrets and folders:
+// one owner requests the permanent deletion, a second owner approves it
+type WardenDeletionRequestServiceClient interface {
+	// Request the permanent deletion of a protected secret or folder (owners only)
+	RequestDeletion(ctx context.Context, in *RequestDeletionRequest, opts ...grpc.CallOption) (*RequestDeletionResponse, error)
+	// List deletion requests of resources the caller owns or requested
+	ListDeletionRequests(ctx context.Context, in *ListDeletionRequestsRequest, opts ...grpc.CallOption) (*ListDeletionRequestsResponse, error)
+	// Approve a pending request as a second owner, deleting the resource
+	ApproveDeletion(ctx context.Context, in *ApproveDeletionRequest, opts ...grpc.CallOption) (*ApproveDeletionResponse, error)
+	// Reject a pending request as another owner, or withdraw it as the requester
+	RejectDeletion(ctx context.Context, in *RejectDeletionRequest, opts ...grpc.CallOption) (*RejectDeletionResponse, error)
+}
+
+type wardenDeletionRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenDeletionRequestServiceClient(cc grpc.ClientConnInterface) WardenDeletionRequestServiceClient {
+	return &wardenDeletionRequestServiceClient{cc}
+}
+
+func (c *wardenDeletionRequestServiceClient) RequestDeletion(ctx context.Context, in *RequestDeletionRequest, opts ...grpc.CallOption) (*RequestDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDeletionResponse)
+	err := c.cc.Invoke(ctx, WardenDeletionRequestService_RequestDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenDeletionRequestServiceClient) ListDeletionRequests(ctx context.Context, in *ListDeletionRequestsRequest, opts ...grpc.CallOption) (*ListDeletionRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletionRequestsResponse)
+	err := c.cc.Invoke(ctx, WardenDeletionRequestService_ListDeletionRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenDeletionRequestServiceClient) ApproveDeletion(ctx context.Context, in *ApproveDeletionRequest, opts ...grpc.CallOption) (*ApproveDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveDeletionResponse)
+	err := c.cc.Invoke(ctx, WardenDeletionRequestService_ApproveDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenDeletionRequestServiceClient) RejectDeletion(ctx context.Context, in *RejectDeletionRequest, opts ...grpc.CallOption) (*RejectDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectDeletionResponse)
+	err := c.cc.Invoke(ctx, WardenDeletionRequestService_RejectDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenDeletionRequestServiceServer is the server API for WardenDeletionRequestService service.
+// All implementations must embed UnimplementedWardenDeletionRequestServiceServer
+// for forward compatibility.
+//
+// Deletion Request Service - dual control for protected secrets and folders:
+// one owner requests the permanent deletion, a second owner approves it
+type WardenDeletionRequestServiceServer interface {
+	// Request the permanent deletion of a protected secret or folder (owners only)
+	RequestDeletion(context.Context, *RequestDeletionRequest) (*RequestDeletionResponse, error)
+	// List deletion requests of resources the caller owns or requested
+	ListDeletionRequests(context.Context, *ListDeletionRequestsRequest) (*ListDeletionRequestsResponse, error)
+	// Approve a pending request as a second owner, deleting the resource
+	ApproveDeletion(context.Context, *ApproveDeletionRequest) (*ApproveDeletionResponse, error)
+	// Reject a pending request as another owner, or withdraw it as the requester
+	RejectDeletion(context.Context, *RejectDeletionRequest) (*RejectDeletionResponse, error)
+	mustEmbedUnimplementedWardenDeletionRequestServiceServer()
+}
+
+// UnimplementedWardenDeletionRequestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenDeletionRequestServiceServer struct{}
+
+func (UnimplementedWardenDeletionRequestServiceServer) RequestDeletion(context.Context, *RequestDeletionRequest) (*RequestDeletionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestDeletion not implemented")
+}
+func (UnimplementedWardenDeletionRequestServiceServer) ListDeletionRequests(context.Context, *ListDeletionRequestsRequest) (*ListDeletionRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeletionRequests not implemented")
+}
+func (UnimplementedWardenDeletionRequestServiceServer) ApproveDeletion(context.Context, *ApproveDeletionRequest) (*ApproveDeletionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveDeletion not implemented")
+}
+func (UnimplementedWardenDeletionRequestServiceServer) RejectDeletion(context.Context, *RejectDeletionRequest) (*RejectDeletionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectDeletion not implemented")
+}
+func (UnimplementedWardenDeletionRequestServiceServer) mustEmbedUnimplementedWardenDeletionRequestServiceServer() {
+}
+func (UnimplementedWardenDeletionRequestServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWardenDeletionRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenDeletionRequestServiceServer will
+// result in compilation errors.
+type UnsafeWardenDeletionRequestServiceServer interface {
+	mustEmbedUnimplementedWardenDeletionRequestServiceServer()
+}
+
+func RegisterWardenDeletionRequestServiceServer(s grpc.ServiceRegistrar, srv WardenDeletionRequestServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenDeletionRequestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenDeletionRequestService_ServiceDesc, srv)
+}
+
+func _WardenDeletionRequestService_RequestDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenDeletionRequestServiceServer).RequestDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenDeletionRequestService_RequestDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenDeletionRequestServiceServer).RequestDeletion(ctx, req.(*RequestDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenDeletionRequestService_ListDeletionRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletionRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenDeletionRequestServiceServer).ListDeletionRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenDeletionRequestService_ListDeletionRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenDeletionRequestServiceServer).ListDeletionRequests(ctx, req.(*ListDeletionRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenDeletionRequestService_ApproveDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenDeletionRequestServiceServer).ApproveDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenDeletionRequestService_ApproveDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenDeletionRequestServiceServer).ApproveDeletion(ctx, req.(*ApproveDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenDeletionRequestService_RejectDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenDeletionRequestServiceServer).RejectDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenDeletionRequestService_RejectDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenDeletionRequestServiceServer).RejectDeletion(ctx, req.(*RejectDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenDeletionRequestService_ServiceDesc is the grpc.ServiceDesc for WardenDeletionRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenDeletionRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenDeletionRequestService",
+	HandlerType: (*WardenDeletionRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestDeletion",
+			Handler:    _WardenDeletionRequestService_RequestDeletion_Handler,
+		},
+		{
+			MethodName: "ListDeletionRequests",
+			Handler:    _WardenDeletionRequestService_ListDeletionRequests_Handler,
+		},
+		{
+			MethodName: "ApproveDeletion",
+			Handler:    _WardenDeletionRequestService_ApproveDeletion_Handler,
+		},
+		{
+			MethodName: "RejectDeletion",
+			Handler:    _WardenDeletionRequestService_RejectDeletion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/deletion_request.proto",
+}
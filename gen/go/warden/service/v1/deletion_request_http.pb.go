@@ -0,0 +1,210 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/deletion_request.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenDeletionRequestServiceApproveDeletion = "/warden.service.v1.WardenDeletionRequestService/ApproveDeletion"
+const OperationWardenDeletionRequestServiceListDeletionRequests = "/warden.service.v1.WardenDeletionRequestService/ListDeletionRequests"
+const OperationWardenDeletionRequestServiceRejectDeletion = "/warden.service.v1.WardenDeletionRequestService/RejectDeletion"
+const OperationWardenDeletionRequestServiceRequestDeletion = "/warden.service.v1.WardenDeletionRequestService/RequestDeletion"
+
+type WardenDeletionRequestServiceHTTPServer interface {
+	// ApproveDeletion Approve a pending request as a second owner, deleting the resource
+	ApproveDeletion(context.Context, *ApproveDeletionRequest) (*ApproveDeletionResponse, error)
+	// ListDeletionRequests List deletion requests of resources the caller owns or requested
+	ListDeletionRequests(context.Context, *ListDeletionRequestsRequest) (*ListDeletionRequestsResponse, error)
+	// RejectDeletion Reject a pending request as another owner, or withdraw it as the requester
+	RejectDeletion(context.Context, *RejectDeletionRequest) (*RejectDeletionResponse, error)
+	// RequestDeletion Request the permanent deletion of a protected secret or folder (owners only)
+	RequestDeletion(context.Context, *RequestDeletionRequest) (*RequestDeletionResponse, error)
+}
+
+func RegisterWardenDeletionRequestServiceHTTPServer(s *http.Server, srv WardenDeletionRequestServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/deletion-requests", _WardenDeletionRequestService_RequestDeletion0_HTTP_Handler(srv))
+	r.GET("/v1/deletion-requests", _WardenDeletionRequestService_ListDeletionRequests0_HTTP_Handler(srv))
+	r.POST("/v1/deletion-requests/{id}:approve", _WardenDeletionRequestService_ApproveDeletion0_HTTP_Handler(srv))
+	r.POST("/v1/deletion-requests/{id}:reject", _WardenDeletionRequestService_RejectDeletion0_HTTP_Handler(srv))
+}
+
+func _WardenDeletionRequestService_RequestDeletion0_HTTP_Handler(srv WardenDeletionRequestServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestDeletionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenDeletionRequestServiceRequestDeletion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestDeletion(ctx, req.(*RequestDeletionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestDeletionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenDeletionRequestService_ListDeletionRequests0_HTTP_Handler(srv WardenDeletionRequestServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDeletionRequestsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenDeletionRequestServiceListDeletionRequests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDeletionRequests(ctx, req.(*ListDeletionRequestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDeletionRequestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenDeletionRequestService_ApproveDeletion0_HTTP_Handler(srv WardenDeletionRequestServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApproveDeletionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenDeletionRequestServiceApproveDeletion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveDeletion(ctx, req.(*ApproveDeletionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ApproveDeletionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenDeletionRequestService_RejectDeletion0_HTTP_Handler(srv WardenDeletionRequestServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RejectDeletionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenDeletionRequestServiceRejectDeletion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectDeletion(ctx, req.(*RejectDeletionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RejectDeletionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenDeletionRequestServiceHTTPClient interface {
+	// ApproveDeletion Approve a pending request as a second owner, deleting the resource
+	ApproveDeletion(ctx context.Context, req *ApproveDeletionRequest, opts ...http.CallOption) (rsp *ApproveDeletionResponse, err error)
+	// ListDeletionRequests List deletion requests of resources the caller owns or requested
+	ListDeletionRequests(ctx context.Context, req *ListDeletionRequestsRequest, opts ...http.CallOption) (rsp *ListDeletionRequestsResponse, err error)
+	// RejectDeletion Reject a pending request as another owner, or withdraw it as the requester
+	RejectDeletion(ctx context.Context, req *RejectDeletionRequest, opts ...http.CallOption) (rsp *RejectDeletionResponse, err error)
+	// RequestDeletion Request the permanent deletion of a protected secret or folder (owners only)
+	RequestDeletion(ctx context.Context, req *RequestDeletionRequest, opts ...http.CallOption) (rsp *RequestDeletionResponse, err error)
+}
+
+type WardenDeletionRequestServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenDeletionRequestServiceHTTPClient(client *http.Client) WardenDeletionRequestServiceHTTPClient {
+	return &WardenDeletionRequestServiceHTTPClientImpl{client}
+}
+
+// ApproveDeletion Approve a pending request as a second owner, deleting the resource
+func (c *WardenDeletionRequestServiceHTTPClientImpl) ApproveDeletion(ctx context.Context, in *ApproveDeletionRequest, opts ...http.CallOption) (*ApproveDeletionResponse, error) {
+	var out ApproveDeletionResponse
+	pattern := "/v1/deletion-requests/{id}:approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenDeletionRequestServiceApproveDeletion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListDeletionRequests List deletion requests of resources the caller owns or requested
+func (c *WardenDeletionRequestServiceHTTPClientImpl) ListDeletionRequests(ctx context.Context, in *ListDeletionRequestsRequest, opts ...http.CallOption) (*ListDeletionRequestsResponse, error) {
+	var out ListDeletionRequestsResponse
+	pattern := "/v1/deletion-requests"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenDeletionRequestServiceListDeletionRequests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RejectDeletion Reject a pending request as another owner, or withdraw it as the requester
+func (c *WardenDeletionRequestServiceHTTPClientImpl) RejectDeletion(ctx context.Context, in *RejectDeletionRequest, opts ...http.CallOption) (*RejectDeletionResponse, error) {
+	var out RejectDeletionResponse
+	pattern := "/v1/deletion-requests/{id}:reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenDeletionRequestServiceRejectDeletion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestDeletion Request the permanent deletion of a protected secret or folder (owners only)
+func (c *WardenDeletionRequestServiceHTTPClientImpl) RequestDeletion(ctx context.Context, in *RequestDeletionRequest, opts ...http.CallOption) (*RequestDeletionResponse, error) {
+	var out RequestDeletionResponse
+	pattern := "/v1/deletion-requests"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenDeletionRequestServiceRequestDeletion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,862 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/emergency_access.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Emergency access state
+type EmergencyAccessStatus int32
+
+const (
+	EmergencyAccessStatus_EMERGENCY_ACCESS_STATUS_UNSPECIFIED EmergencyAccessStatus = 0
+	EmergencyAccessStatus_EMERGENCY_ACCESS_STATUS_IDLE        EmergencyAccessStatus = 1 // Designated, no request pending
+	EmergencyAccessStatus_EMERGENCY_ACCESS_STATUS_REQUESTED   EmergencyAccessStatus = 2 // Waiting period running
+	EmergencyAccessStatus_EMERGENCY_ACCESS_STATUS_GRANTED     EmergencyAccessStatus = 3 // Grantee holds VIEWER on the folder
+)
+
+// Enum value maps for EmergencyAccessStatus.
+var (
+	EmergencyAccessStatus_name = map[int32]string{
+		0: "EMERGENCY_ACCESS_STATUS_UNSPECIFIED",
+		1: "EMERGENCY_ACCESS_STATUS_IDLE",
+		2: "EMERGENCY_ACCESS_STATUS_REQUESTED",
+		3: "EMERGENCY_ACCESS_STATUS_GRANTED",
+	}
+	EmergencyAccessStatus_value = map[string]int32{
+		"EMERGENCY_ACCESS_STATUS_UNSPECIFIED": 0,
+		"EMERGENCY_ACCESS_STATUS_IDLE":        1,
+		"EMERGENCY_ACCESS_STATUS_REQUESTED":   2,
+		"EMERGENCY_ACCESS_STATUS_GRANTED":     3,
+	}
+)
+
+func (x EmergencyAccessStatus) Enum() *EmergencyAccessStatus {
+	p := new(EmergencyAccessStatus)
+	*p = x
+	return p
+}
+
+func (x EmergencyAccessStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmergencyAccessStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_emergency_access_proto_enumTypes[0].Descriptor()
+}
+
+func (EmergencyAccessStatus) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_emergency_access_proto_enumTypes[0]
+}
+
+func (x EmergencyAccessStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmergencyAccessStatus.Descriptor instead.
+func (EmergencyAccessStatus) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{0}
+}
+
+// Emergency access entity
+type EmergencyAccess struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId    uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	GrantorId   string                 `protobuf:"bytes,3,opt,name=grantor_id,json=grantorId,proto3" json:"grantor_id,omitempty"`
+	GranteeId   string                 `protobuf:"bytes,4,opt,name=grantee_id,json=granteeId,proto3" json:"grantee_id,omitempty"`
+	FolderId    string                 `protobuf:"bytes,5,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	WaitDays    uint32                 `protobuf:"varint,6,opt,name=wait_days,json=waitDays,proto3" json:"wait_days,omitempty"`
+	Status      EmergencyAccessStatus  `protobuf:"varint,7,opt,name=status,proto3,enum=warden.service.v1.EmergencyAccessStatus" json:"status,omitempty"`
+	RequestedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=requested_at,json=requestedAt,proto3,oneof" json:"requested_at,omitempty"`
+	// When a pending request is granted automatically
+	GrantableAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=grantable_at,json=grantableAt,proto3,oneof" json:"grantable_at,omitempty"`
+	GrantedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=granted_at,json=grantedAt,proto3,oneof" json:"granted_at,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmergencyAccess) Reset() {
+	*x = EmergencyAccess{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergencyAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergencyAccess) ProtoMessage() {}
+
+func (x *EmergencyAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergencyAccess.ProtoReflect.Descriptor instead.
+func (*EmergencyAccess) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EmergencyAccess) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EmergencyAccess) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *EmergencyAccess) GetGrantorId() string {
+	if x != nil {
+		return x.GrantorId
+	}
+	return ""
+}
+
+func (x *EmergencyAccess) GetGranteeId() string {
+	if x != nil {
+		return x.GranteeId
+	}
+	return ""
+}
+
+func (x *EmergencyAccess) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *EmergencyAccess) GetWaitDays() uint32 {
+	if x != nil {
+		return x.WaitDays
+	}
+	return 0
+}
+
+func (x *EmergencyAccess) GetStatus() EmergencyAccessStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EmergencyAccessStatus_EMERGENCY_ACCESS_STATUS_UNSPECIFIED
+}
+
+func (x *EmergencyAccess) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *EmergencyAccess) GetGrantableAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GrantableAt
+	}
+	return nil
+}
+
+func (x *EmergencyAccess) GetGrantedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return nil
+}
+
+func (x *EmergencyAccess) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type CreateEmergencyAccessRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Trusted contact
+	GranteeId string `protobuf:"bytes,1,opt,name=grantee_id,json=granteeId,proto3" json:"grantee_id,omitempty"`
+	// Folder the contact may access; the caller must own it
+	FolderId string `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	// Days the grantor has to reject a request
+	WaitDays      uint32 `protobuf:"varint,3,opt,name=wait_days,json=waitDays,proto3" json:"wait_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmergencyAccessRequest) Reset() {
+	*x = CreateEmergencyAccessRequest{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmergencyAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmergencyAccessRequest) ProtoMessage() {}
+
+func (x *CreateEmergencyAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmergencyAccessRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmergencyAccessRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateEmergencyAccessRequest) GetGranteeId() string {
+	if x != nil {
+		return x.GranteeId
+	}
+	return ""
+}
+
+func (x *CreateEmergencyAccessRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *CreateEmergencyAccessRequest) GetWaitDays() uint32 {
+	if x != nil {
+		return x.WaitDays
+	}
+	return 0
+}
+
+type CreateEmergencyAccessResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyAccess *EmergencyAccess       `protobuf:"bytes,1,opt,name=emergency_access,json=emergencyAccess,proto3" json:"emergency_access,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateEmergencyAccessResponse) Reset() {
+	*x = CreateEmergencyAccessResponse{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmergencyAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmergencyAccessResponse) ProtoMessage() {}
+
+func (x *CreateEmergencyAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmergencyAccessResponse.ProtoReflect.Descriptor instead.
+func (*CreateEmergencyAccessResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateEmergencyAccessResponse) GetEmergencyAccess() *EmergencyAccess {
+	if x != nil {
+		return x.EmergencyAccess
+	}
+	return nil
+}
+
+type ListEmergencyAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmergencyAccessRequest) Reset() {
+	*x = ListEmergencyAccessRequest{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmergencyAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmergencyAccessRequest) ProtoMessage() {}
+
+func (x *ListEmergencyAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmergencyAccessRequest.ProtoReflect.Descriptor instead.
+func (*ListEmergencyAccessRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{3}
+}
+
+type ListEmergencyAccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Contacts the caller designated
+	Granted []*EmergencyAccess `protobuf:"bytes,1,rep,name=granted,proto3" json:"granted,omitempty"`
+	// Accesses where the caller is the contact
+	Trusted       []*EmergencyAccess `protobuf:"bytes,2,rep,name=trusted,proto3" json:"trusted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmergencyAccessResponse) Reset() {
+	*x = ListEmergencyAccessResponse{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmergencyAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmergencyAccessResponse) ProtoMessage() {}
+
+func (x *ListEmergencyAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmergencyAccessResponse.ProtoReflect.Descriptor instead.
+func (*ListEmergencyAccessResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListEmergencyAccessResponse) GetGranted() []*EmergencyAccess {
+	if x != nil {
+		return x.Granted
+	}
+	return nil
+}
+
+func (x *ListEmergencyAccessResponse) GetTrusted() []*EmergencyAccess {
+	if x != nil {
+		return x.Trusted
+	}
+	return nil
+}
+
+type RequestEmergencyAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmergencyAccessRequest) Reset() {
+	*x = RequestEmergencyAccessRequest{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmergencyAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmergencyAccessRequest) ProtoMessage() {}
+
+func (x *RequestEmergencyAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmergencyAccessRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmergencyAccessRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RequestEmergencyAccessRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RequestEmergencyAccessResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyAccess *EmergencyAccess       `protobuf:"bytes,1,opt,name=emergency_access,json=emergencyAccess,proto3" json:"emergency_access,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestEmergencyAccessResponse) Reset() {
+	*x = RequestEmergencyAccessResponse{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmergencyAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmergencyAccessResponse) ProtoMessage() {}
+
+func (x *RequestEmergencyAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmergencyAccessResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmergencyAccessResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RequestEmergencyAccessResponse) GetEmergencyAccess() *EmergencyAccess {
+	if x != nil {
+		return x.EmergencyAccess
+	}
+	return nil
+}
+
+type RejectEmergencyAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectEmergencyAccessRequest) Reset() {
+	*x = RejectEmergencyAccessRequest{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectEmergencyAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectEmergencyAccessRequest) ProtoMessage() {}
+
+func (x *RejectEmergencyAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectEmergencyAccessRequest.ProtoReflect.Descriptor instead.
+func (*RejectEmergencyAccessRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RejectEmergencyAccessRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejectEmergencyAccessResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyAccess *EmergencyAccess       `protobuf:"bytes,1,opt,name=emergency_access,json=emergencyAccess,proto3" json:"emergency_access,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RejectEmergencyAccessResponse) Reset() {
+	*x = RejectEmergencyAccessResponse{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectEmergencyAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectEmergencyAccessResponse) ProtoMessage() {}
+
+func (x *RejectEmergencyAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectEmergencyAccessResponse.ProtoReflect.Descriptor instead.
+func (*RejectEmergencyAccessResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectEmergencyAccessResponse) GetEmergencyAccess() *EmergencyAccess {
+	if x != nil {
+		return x.EmergencyAccess
+	}
+	return nil
+}
+
+type ApproveEmergencyAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveEmergencyAccessRequest) Reset() {
+	*x = ApproveEmergencyAccessRequest{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveEmergencyAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveEmergencyAccessRequest) ProtoMessage() {}
+
+func (x *ApproveEmergencyAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveEmergencyAccessRequest.ProtoReflect.Descriptor instead.
+func (*ApproveEmergencyAccessRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ApproveEmergencyAccessRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ApproveEmergencyAccessResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyAccess *EmergencyAccess       `protobuf:"bytes,1,opt,name=emergency_access,json=emergencyAccess,proto3" json:"emergency_access,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApproveEmergencyAccessResponse) Reset() {
+	*x = ApproveEmergencyAccessResponse{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveEmergencyAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveEmergencyAccessResponse) ProtoMessage() {}
+
+func (x *ApproveEmergencyAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveEmergencyAccessResponse.ProtoReflect.Descriptor instead.
+func (*ApproveEmergencyAccessResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ApproveEmergencyAccessResponse) GetEmergencyAccess() *EmergencyAccess {
+	if x != nil {
+		return x.EmergencyAccess
+	}
+	return nil
+}
+
+type DeleteEmergencyAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmergencyAccessRequest) Reset() {
+	*x = DeleteEmergencyAccessRequest{}
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmergencyAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmergencyAccessRequest) ProtoMessage() {}
+
+func (x *DeleteEmergencyAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_emergency_access_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmergencyAccessRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmergencyAccessRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_emergency_access_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteEmergencyAccessRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_warden_service_v1_emergency_access_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_emergency_access_proto_rawDesc = "" +
+	"\n" +
+	"(warden/service/v1/emergency_access.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xae\x04\n" +
+	"\x0fEmergencyAccess\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x1d\n" +
+	"\n" +
+	"grantor_id\x18\x03 \x01(\tR\tgrantorId\x12\x1d\n" +
+	"\n" +
+	"grantee_id\x18\x04 \x01(\tR\tgranteeId\x12\x1b\n" +
+	"\tfolder_id\x18\x05 \x01(\tR\bfolderId\x12\x1b\n" +
+	"\twait_days\x18\x06 \x01(\rR\bwaitDays\x12@\n" +
+	"\x06status\x18\a \x01(\x0e2(.warden.service.v1.EmergencyAccessStatusR\x06status\x12B\n" +
+	"\frequested_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vrequestedAt\x88\x01\x01\x12B\n" +
+	"\fgrantable_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vgrantableAt\x88\x01\x01\x12>\n" +
+	"\n" +
+	"granted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tgrantedAt\x88\x01\x01\x12;\n" +
+	"\vcreate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTimeB\x0f\n" +
+	"\r_requested_atB\x0f\n" +
+	"\r_grantable_atB\r\n" +
+	"\v_granted_at\"\xb1\x01\n" +
+	"\x1cCreateEmergencyAccessRequest\x12+\n" +
+	"\n" +
+	"grantee_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\tgranteeId\x129\n" +
+	"\tfolder_id\x18\x02 \x01(\tB\x1c\xe0A\x02\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]+$R\bfolderId\x12)\n" +
+	"\twait_days\x18\x03 \x01(\rB\f\xe0A\x02\xbaH\x06*\x04\x18Z(\x01R\bwaitDays\"n\n" +
+	"\x1dCreateEmergencyAccessResponse\x12M\n" +
+	"\x10emergency_access\x18\x01 \x01(\v2\".warden.service.v1.EmergencyAccessR\x0femergencyAccess\"\x1c\n" +
+	"\x1aListEmergencyAccessRequest\"\x99\x01\n" +
+	"\x1bListEmergencyAccessResponse\x12<\n" +
+	"\agranted\x18\x01 \x03(\v2\".warden.service.v1.EmergencyAccessR\agranted\x12<\n" +
+	"\atrusted\x18\x02 \x03(\v2\".warden.service.v1.EmergencyAccessR\atrusted\"8\n" +
+	"\x1dRequestEmergencyAccessRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x02id\"o\n" +
+	"\x1eRequestEmergencyAccessResponse\x12M\n" +
+	"\x10emergency_access\x18\x01 \x01(\v2\".warden.service.v1.EmergencyAccessR\x0femergencyAccess\"7\n" +
+	"\x1cRejectEmergencyAccessRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x02id\"n\n" +
+	"\x1dRejectEmergencyAccessResponse\x12M\n" +
+	"\x10emergency_access\x18\x01 \x01(\v2\".warden.service.v1.EmergencyAccessR\x0femergencyAccess\"8\n" +
+	"\x1dApproveEmergencyAccessRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x02id\"o\n" +
+	"\x1eApproveEmergencyAccessResponse\x12M\n" +
+	"\x10emergency_access\x18\x01 \x01(\v2\".warden.service.v1.EmergencyAccessR\x0femergencyAccess\"7\n" +
+	"\x1cDeleteEmergencyAccessRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x02id*\xae\x01\n" +
+	"\x15EmergencyAccessStatus\x12'\n" +
+	"#EMERGENCY_ACCESS_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cEMERGENCY_ACCESS_STATUS_IDLE\x10\x01\x12%\n" +
+	"!EMERGENCY_ACCESS_STATUS_REQUESTED\x10\x02\x12#\n" +
+	"\x1fEMERGENCY_ACCESS_STATUS_GRANTED\x10\x032\xdd\a\n" +
+	"\x1cWardenEmergencyAccessService\x12\x9b\x01\n" +
+	"\x15CreateEmergencyAccess\x12/.warden.service.v1.CreateEmergencyAccessRequest\x1a0.warden.service.v1.CreateEmergencyAccessResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/emergency-access\x12\x92\x01\n" +
+	"\x13ListEmergencyAccess\x12-.warden.service.v1.ListEmergencyAccessRequest\x1a..warden.service.v1.ListEmergencyAccessResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/emergency-access\x12\xab\x01\n" +
+	"\x16RequestEmergencyAccess\x120.warden.service.v1.RequestEmergencyAccessRequest\x1a1.warden.service.v1.RequestEmergencyAccessResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/emergency-access/{id}:request\x12\xa7\x01\n" +
+	"\x15RejectEmergencyAccess\x12/.warden.service.v1.RejectEmergencyAccessRequest\x1a0.warden.service.v1.RejectEmergencyAccessResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/emergency-access/{id}:reject\x12\xab\x01\n" +
+	"\x16ApproveEmergencyAccess\x120.warden.service.v1.ApproveEmergencyAccessRequest\x1a1.warden.service.v1.ApproveEmergencyAccessResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/emergency-access/{id}:approve\x12\x83\x01\n" +
+	"\x15DeleteEmergencyAccess\x12/.warden.service.v1.DeleteEmergencyAccessRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/emergency-access/{id}B\xdc\x01\n" +
+	"\x15com.warden.service.v1B\x14EmergencyAccessProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_emergency_access_proto_rawDescOnce sync.Once
+	file_warden_service_v1_emergency_access_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_emergency_access_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_emergency_access_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_emergency_access_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_emergency_access_proto_rawDesc), len(file_warden_service_v1_emergency_access_proto_rawDesc)))
+	})
+	return file_warden_service_v1_emergency_access_proto_rawDescData
+}
+
+var file_warden_service_v1_emergency_access_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_warden_service_v1_emergency_access_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_warden_service_v1_emergency_access_proto_goTypes = []any{
+	(EmergencyAccessStatus)(0),             // 0: warden.service.v1.EmergencyAccessStatus
+	(*EmergencyAccess)(nil),                // 1: warden.service.v1.EmergencyAccess
+	(*CreateEmergencyAccessRequest)(nil),   // 2: warden.service.v1.CreateEmergencyAccessRequest
+	(*CreateEmergencyAccessResponse)(nil),  // 3: warden.service.v1.CreateEmergencyAccessResponse
+	(*ListEmergencyAccessRequest)(nil),     // 4: warden.service.v1.ListEmergencyAccessRequest
+	(*ListEmergencyAccessResponse)(nil),    // 5: warden.service.v1.ListEmergencyAccessResponse
+	(*RequestEmergencyAccessRequest)(nil),  // 6: warden.service.v1.RequestEmergencyAccessRequest
+	(*RequestEmergencyAccessResponse)(nil), // 7: warden.service.v1.RequestEmergencyAccessResponse
+	(*RejectEmergencyAccessRequest)(nil),   // 8: warden.service.v1.RejectEmergencyAccessRequest
+	(*RejectEmergencyAccessResponse)(nil),  // 9: warden.service.v1.RejectEmergencyAccessResponse
+	(*ApproveEmergencyAccessRequest)(nil),  // 10: warden.service.v1.ApproveEmergencyAccessRequest
+	(*ApproveEmergencyAccessResponse)(nil), // 11: warden.service.v1.ApproveEmergencyAccessResponse
+	(*DeleteEmergencyAccessRequest)(nil),   // 12: warden.service.v1.DeleteEmergencyAccessRequest
+	(*timestamppb.Timestamp)(nil),          // 13: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                  // 14: google.protobuf.Empty
+}
+var file_warden_service_v1_emergency_access_proto_depIdxs = []int32{
+	0,  // 0: warden.service.v1.EmergencyAccess.status:type_name -> warden.service.v1.EmergencyAccessStatus
+	13, // 1: warden.service.v1.EmergencyAccess.requested_at:type_name -> google.protobuf.Timestamp
+	13, // 2: warden.service.v1.EmergencyAccess.grantable_at:type_name -> google.protobuf.Timestamp
+	13, // 3: warden.service.v1.EmergencyAccess.granted_at:type_name -> google.protobuf.Timestamp
+	13, // 4: warden.service.v1.EmergencyAccess.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 5: warden.service.v1.CreateEmergencyAccessResponse.emergency_access:type_name -> warden.service.v1.EmergencyAccess
+	1,  // 6: warden.service.v1.ListEmergencyAccessResponse.granted:type_name -> warden.service.v1.EmergencyAccess
+	1,  // 7: warden.service.v1.ListEmergencyAccessResponse.trusted:type_name -> warden.service.v1.EmergencyAccess
+	1,  // 8: warden.service.v1.RequestEmergencyAccessResponse.emergency_access:type_name -> warden.service.v1.EmergencyAccess
+	1,  // 9: warden.service.v1.RejectEmergencyAccessResponse.emergency_access:type_name -> warden.service.v1.EmergencyAccess
+	1,  // 10: warden.service.v1.ApproveEmergencyAccessResponse.emergency_access:type_name -> warden.service.v1.EmergencyAccess
+	2,  // 11: warden.service.v1.WardenEmergencyAccessService.CreateEmergencyAccess:input_type -> warden.service.v1.CreateEmergencyAccessRequest
+	4,  // 12: warden.service.v1.WardenEmergencyAccessService.ListEmergencyAccess:input_type -> warden.service.v1.ListEmergencyAccessRequest
+	6,  // 13: warden.service.v1.WardenEmergencyAccessService.RequestEmergencyAccess:input_type -> warden.service.v1.RequestEmergencyAccessRequest
+	8,  // 14: warden.service.v1.WardenEmergencyAccessService.RejectEmergencyAccess:input_type -> warden.service.v1.RejectEmergencyAccessRequest
+	10, // 15: warden.service.v1.WardenEmergencyAccessService.ApproveEmergencyAccess:input_type -> warden.service.v1.ApproveEmergencyAccessRequest
+	12, // 16: warden.service.v1.WardenEmergencyAccessService.DeleteEmergencyAccess:input_type -> warden.service.v1.DeleteEmergencyAccessRequest
+	3,  // 17: warden.service.v1.WardenEmergencyAccessService.CreateEmergencyAccess:output_type -> warden.service.v1.CreateEmergencyAccessResponse
+	5,  // 18: warden.service.v1.WardenEmergencyAccessService.ListEmergencyAccess:output_type -> warden.service.v1.ListEmergencyAccessResponse
+	7,  // 19: warden.service.v1.WardenEmergencyAccessService.RequestEmergencyAccess:output_type -> warden.service.v1.RequestEmergencyAccessResponse
+	9,  // 20: warden.service.v1.WardenEmergencyAccessService.RejectEmergencyAccess:output_type -> warden.service.v1.RejectEmergencyAccessResponse
+	11, // 21: warden.service.v1.WardenEmergencyAccessService.ApproveEmergencyAccess:output_type -> warden.service.v1.ApproveEmergencyAccessResponse
+	14, // 22: warden.service.v1.WardenEmergencyAccessService.DeleteEmergencyAccess:output_type -> google.protobuf.Empty
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_emergency_access_proto_init() }
+func file_warden_service_v1_emergency_access_proto_init() {
+	if File_warden_service_v1_emergency_access_proto != nil {
+		return
+	}
+	file_warden_service_v1_emergency_access_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_emergency_access_proto_rawDesc), len(file_warden_service_v1_emergency_access_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_emergency_access_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_emergency_access_proto_depIdxs,
+		EnumInfos:         file_warden_service_v1_emergency_access_proto_enumTypes,
+		MessageInfos:      file_warden_service_v1_emergency_access_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_emergency_access_proto = out.File
+	file_warden_service_v1_emergency_access_proto_goTypes = nil
+	file_warden_service_v1_emergency_access_proto_depIdxs = nil
+}
@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/emergency_access.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	annotations "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ annotations.FieldBehavior
+	_ emptypb.Empty
+	_ timestamppb.Timestamp
+)
+
+// RegisterRedactedWardenEmergencyAccessServiceServer wraps the WardenEmergencyAccessServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenEmergencyAccessServiceServer(s grpc.ServiceRegistrar, srv WardenEmergencyAccessServiceServer, bypass redact.Bypass) {
+	RegisterWardenEmergencyAccessServiceServer(s, RedactedWardenEmergencyAccessServiceServer(srv, bypass))
+}
+
+func RedactedWardenEmergencyAccessServiceServer(srv WardenEmergencyAccessServiceServer, bypass redact.Bypass) WardenEmergencyAccessServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenEmergencyAccessServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenEmergencyAccessServiceServer struct {
+	UnsafeWardenEmergencyAccessServiceServer
+	srv    WardenEmergencyAccessServiceServer
+	bypass redact.Bypass
+}
+
+// CreateEmergencyAccess is the redacted wrapper for the actual WardenEmergencyAccessServiceServer.CreateEmergencyAccess method
+// Unary RPC
+func (s *redactedWardenEmergencyAccessServiceServer) CreateEmergencyAccess(ctx context.Context, in *CreateEmergencyAccessRequest) (*CreateEmergencyAccessResponse, error) {
+	res, err := s.srv.CreateEmergencyAccess(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListEmergencyAccess is the redacted wrapper for the actual WardenEmergencyAccessServiceServer.ListEmergencyAccess method
+// Unary RPC
+func (s *redactedWardenEmergencyAccessServiceServer) ListEmergencyAccess(ctx context.Context, in *ListEmergencyAccessRequest) (*ListEmergencyAccessResponse, error) {
+	res, err := s.srv.ListEmergencyAccess(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RequestEmergencyAccess is the redacted wrapper for the actual WardenEmergencyAccessServiceServer.RequestEmergencyAccess method
+// Unary RPC
+func (s *redactedWardenEmergencyAccessServiceServer) RequestEmergencyAccess(ctx context.Context, in *RequestEmergencyAccessRequest) (*RequestEmergencyAccessResponse, error) {
+	res, err := s.srv.RequestEmergencyAccess(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RejectEmergencyAccess is the redacted wrapper for the actual WardenEmergencyAccessServiceServer.RejectEmergencyAccess method
+// Unary RPC
+func (s *redactedWardenEmergencyAccessServiceServer) RejectEmergencyAccess(ctx context.Context, in *RejectEmergencyAccessRequest) (*RejectEmergencyAccessResponse, error) {
+	res, err := s.srv.RejectEmergencyAccess(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ApproveEmergencyAccess is the redacted wrapper for the actual WardenEmergencyAccessServiceServer.ApproveEmergencyAccess method
+// Unary RPC
+func (s *redactedWardenEmergencyAccessServiceServer) ApproveEmergencyAccess(ctx context.Context, in *ApproveEmergencyAccessRequest) (*ApproveEmergencyAccessResponse, error) {
+	res, err := s.srv.ApproveEmergencyAccess(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// DeleteEmergencyAccess is the redacted wrapper for the actual WardenEmergencyAccessServiceServer.DeleteEmergencyAccess method
+// Unary RPC
+func (s *redactedWardenEmergencyAccessServiceServer) DeleteEmergencyAccess(ctx context.Context, in *DeleteEmergencyAccessRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.DeleteEmergencyAccess(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for EmergencyAccess
+func (x *EmergencyAccess) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TenantId
+
+	// Safe field: GrantorId
+
+	// Safe field: GranteeId
+
+	// Safe field: FolderId
+
+	// Safe field: WaitDays
+
+	// Safe field: Status
+
+	// Safe field: RequestedAt
+
+	// Safe field: GrantableAt
+
+	// Safe field: GrantedAt
+
+	// Safe field: CreateTime
+	return x.String()
+}
+
+// Redact method implementation for CreateEmergencyAccessRequest
+func (x *CreateEmergencyAccessRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: GranteeId
+
+	// Safe field: FolderId
+
+	// Safe field: WaitDays
+	return x.String()
+}
+
+// Redact method implementation for CreateEmergencyAccessResponse
+func (x *CreateEmergencyAccessResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: EmergencyAccess
+	return x.String()
+}
+
+// Redact method implementation for ListEmergencyAccessRequest
+func (x *ListEmergencyAccessRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// Redact method implementation for ListEmergencyAccessResponse
+func (x *ListEmergencyAccessResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Granted
+
+	// Safe field: Trusted
+	return x.String()
+}
+
+// Redact method implementation for RequestEmergencyAccessRequest
+func (x *RequestEmergencyAccessRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for RequestEmergencyAccessResponse
+func (x *RequestEmergencyAccessResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: EmergencyAccess
+	return x.String()
+}
+
+// Redact method implementation for RejectEmergencyAccessRequest
+func (x *RejectEmergencyAccessRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for RejectEmergencyAccessResponse
+func (x *RejectEmergencyAccessResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: EmergencyAccess
+	return x.String()
+}
+
+// Redact method implementation for ApproveEmergencyAccessRequest
+func (x *ApproveEmergencyAccessRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for ApproveEmergencyAccessResponse
+func (x *ApproveEmergencyAccessResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: EmergencyAccess
+	return x.String()
+}
+
+// Redact method implementation for DeleteEmergencyAccessRequest
+func (x *DeleteEmergencyAccessRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
@@ -0,0 +1,1608 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/emergency_access.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on EmergencyAccess with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EmergencyAccess) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmergencyAccess with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EmergencyAccessMultiError, or nil if none found.
+func (m *EmergencyAccess) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmergencyAccess) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for GrantorId
+
+	// no validation rules for GranteeId
+
+	// no validation rules for FolderId
+
+	// no validation rules for WaitDays
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EmergencyAccessValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EmergencyAccessValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EmergencyAccessValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.RequestedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetRequestedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EmergencyAccessValidationError{
+						field:  "RequestedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EmergencyAccessValidationError{
+						field:  "RequestedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRequestedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EmergencyAccessValidationError{
+					field:  "RequestedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GrantableAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetGrantableAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EmergencyAccessValidationError{
+						field:  "GrantableAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EmergencyAccessValidationError{
+						field:  "GrantableAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGrantableAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EmergencyAccessValidationError{
+					field:  "GrantableAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GrantedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetGrantedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EmergencyAccessValidationError{
+						field:  "GrantedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EmergencyAccessValidationError{
+						field:  "GrantedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGrantedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EmergencyAccessValidationError{
+					field:  "GrantedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EmergencyAccessMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmergencyAccessMultiError is an error wrapping multiple validation errors
+// returned by EmergencyAccess.ValidateAll() if the designated constraints
+// aren't met.
+type EmergencyAccessMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmergencyAccessMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmergencyAccessMultiError) AllErrors() []error { return m }
+
+// EmergencyAccessValidationError is the validation error returned by
+// EmergencyAccess.Validate if the designated constraints aren't met.
+type EmergencyAccessValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmergencyAccessValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmergencyAccessValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmergencyAccessValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmergencyAccessValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmergencyAccessValidationError) ErrorName() string { return "EmergencyAccessValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EmergencyAccessValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmergencyAccess.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmergencyAccessValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmergencyAccessValidationError{}
+
+// Validate checks the field values on CreateEmergencyAccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateEmergencyAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateEmergencyAccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateEmergencyAccessRequestMultiError, or nil if none found.
+func (m *CreateEmergencyAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateEmergencyAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GranteeId
+
+	// no validation rules for FolderId
+
+	// no validation rules for WaitDays
+
+	if len(errors) > 0 {
+		return CreateEmergencyAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateEmergencyAccessRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateEmergencyAccessRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateEmergencyAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateEmergencyAccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateEmergencyAccessRequestMultiError) AllErrors() []error { return m }
+
+// CreateEmergencyAccessRequestValidationError is the validation error returned
+// by CreateEmergencyAccessRequest.Validate if the designated constraints
+// aren't met.
+type CreateEmergencyAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateEmergencyAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateEmergencyAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateEmergencyAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateEmergencyAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateEmergencyAccessRequestValidationError) ErrorName() string {
+	return "CreateEmergencyAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateEmergencyAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateEmergencyAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateEmergencyAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateEmergencyAccessRequestValidationError{}
+
+// Validate checks the field values on CreateEmergencyAccessResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateEmergencyAccessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateEmergencyAccessResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateEmergencyAccessResponseMultiError, or nil if none found.
+func (m *CreateEmergencyAccessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateEmergencyAccessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEmergencyAccess()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateEmergencyAccessResponseValidationError{
+					field:  "EmergencyAccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateEmergencyAccessResponseValidationError{
+					field:  "EmergencyAccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmergencyAccess()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateEmergencyAccessResponseValidationError{
+				field:  "EmergencyAccess",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateEmergencyAccessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateEmergencyAccessResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateEmergencyAccessResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateEmergencyAccessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateEmergencyAccessResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateEmergencyAccessResponseMultiError) AllErrors() []error { return m }
+
+// CreateEmergencyAccessResponseValidationError is the validation error
+// returned by CreateEmergencyAccessResponse.Validate if the designated
+// constraints aren't met.
+type CreateEmergencyAccessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateEmergencyAccessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateEmergencyAccessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateEmergencyAccessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateEmergencyAccessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateEmergencyAccessResponseValidationError) ErrorName() string {
+	return "CreateEmergencyAccessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateEmergencyAccessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateEmergencyAccessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateEmergencyAccessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateEmergencyAccessResponseValidationError{}
+
+// Validate checks the field values on ListEmergencyAccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEmergencyAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEmergencyAccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEmergencyAccessRequestMultiError, or nil if none found.
+func (m *ListEmergencyAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEmergencyAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListEmergencyAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEmergencyAccessRequestMultiError is an error wrapping multiple
+// validation errors returned by ListEmergencyAccessRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListEmergencyAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEmergencyAccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEmergencyAccessRequestMultiError) AllErrors() []error { return m }
+
+// ListEmergencyAccessRequestValidationError is the validation error returned
+// by ListEmergencyAccessRequest.Validate if the designated constraints aren't met.
+type ListEmergencyAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEmergencyAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEmergencyAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEmergencyAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEmergencyAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEmergencyAccessRequestValidationError) ErrorName() string {
+	return "ListEmergencyAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEmergencyAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEmergencyAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEmergencyAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEmergencyAccessRequestValidationError{}
+
+// Validate checks the field values on ListEmergencyAccessResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEmergencyAccessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEmergencyAccessResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEmergencyAccessResponseMultiError, or nil if none found.
+func (m *ListEmergencyAccessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEmergencyAccessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGranted() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEmergencyAccessResponseValidationError{
+						field:  fmt.Sprintf("Granted[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEmergencyAccessResponseValidationError{
+						field:  fmt.Sprintf("Granted[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEmergencyAccessResponseValidationError{
+					field:  fmt.Sprintf("Granted[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTrusted() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEmergencyAccessResponseValidationError{
+						field:  fmt.Sprintf("Trusted[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEmergencyAccessResponseValidationError{
+						field:  fmt.Sprintf("Trusted[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEmergencyAccessResponseValidationError{
+					field:  fmt.Sprintf("Trusted[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListEmergencyAccessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEmergencyAccessResponseMultiError is an error wrapping multiple
+// validation errors returned by ListEmergencyAccessResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListEmergencyAccessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEmergencyAccessResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEmergencyAccessResponseMultiError) AllErrors() []error { return m }
+
+// ListEmergencyAccessResponseValidationError is the validation error returned
+// by ListEmergencyAccessResponse.Validate if the designated constraints
+// aren't met.
+type ListEmergencyAccessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEmergencyAccessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEmergencyAccessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEmergencyAccessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEmergencyAccessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEmergencyAccessResponseValidationError) ErrorName() string {
+	return "ListEmergencyAccessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEmergencyAccessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEmergencyAccessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEmergencyAccessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEmergencyAccessResponseValidationError{}
+
+// Validate checks the field values on RequestEmergencyAccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestEmergencyAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestEmergencyAccessRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RequestEmergencyAccessRequestMultiError, or nil if none found.
+func (m *RequestEmergencyAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestEmergencyAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RequestEmergencyAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestEmergencyAccessRequestMultiError is an error wrapping multiple
+// validation errors returned by RequestEmergencyAccessRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RequestEmergencyAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestEmergencyAccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestEmergencyAccessRequestMultiError) AllErrors() []error { return m }
+
+// RequestEmergencyAccessRequestValidationError is the validation error
+// returned by RequestEmergencyAccessRequest.Validate if the designated
+// constraints aren't met.
+type RequestEmergencyAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestEmergencyAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestEmergencyAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestEmergencyAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestEmergencyAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestEmergencyAccessRequestValidationError) ErrorName() string {
+	return "RequestEmergencyAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestEmergencyAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestEmergencyAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestEmergencyAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestEmergencyAccessRequestValidationError{}
+
+// Validate checks the field values on RequestEmergencyAccessResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestEmergencyAccessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestEmergencyAccessResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RequestEmergencyAccessResponseMultiError, or nil if none found.
+func (m *RequestEmergencyAccessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestEmergencyAccessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEmergencyAccess()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestEmergencyAccessResponseValidationError{
+					field:  "EmergencyAccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestEmergencyAccessResponseValidationError{
+					field:  "EmergencyAccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmergencyAccess()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestEmergencyAccessResponseValidationError{
+				field:  "EmergencyAccess",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestEmergencyAccessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestEmergencyAccessResponseMultiError is an error wrapping multiple
+// validation errors returned by RequestEmergencyAccessResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RequestEmergencyAccessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestEmergencyAccessResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestEmergencyAccessResponseMultiError) AllErrors() []error { return m }
+
+// RequestEmergencyAccessResponseValidationError is the validation error
+// returned by RequestEmergencyAccessResponse.Validate if the designated
+// constraints aren't met.
+type RequestEmergencyAccessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestEmergencyAccessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestEmergencyAccessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestEmergencyAccessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestEmergencyAccessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestEmergencyAccessResponseValidationError) ErrorName() string {
+	return "RequestEmergencyAccessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestEmergencyAccessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestEmergencyAccessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestEmergencyAccessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestEmergencyAccessResponseValidationError{}
+
+// Validate checks the field values on RejectEmergencyAccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectEmergencyAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectEmergencyAccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectEmergencyAccessRequestMultiError, or nil if none found.
+func (m *RejectEmergencyAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectEmergencyAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RejectEmergencyAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectEmergencyAccessRequestMultiError is an error wrapping multiple
+// validation errors returned by RejectEmergencyAccessRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RejectEmergencyAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectEmergencyAccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectEmergencyAccessRequestMultiError) AllErrors() []error { return m }
+
+// RejectEmergencyAccessRequestValidationError is the validation error returned
+// by RejectEmergencyAccessRequest.Validate if the designated constraints
+// aren't met.
+type RejectEmergencyAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectEmergencyAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectEmergencyAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectEmergencyAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectEmergencyAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectEmergencyAccessRequestValidationError) ErrorName() string {
+	return "RejectEmergencyAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectEmergencyAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectEmergencyAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectEmergencyAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectEmergencyAccessRequestValidationError{}
+
+// Validate checks the field values on RejectEmergencyAccessResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectEmergencyAccessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectEmergencyAccessResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RejectEmergencyAccessResponseMultiError, or nil if none found.
+func (m *RejectEmergencyAccessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectEmergencyAccessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEmergencyAccess()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RejectEmergencyAccessResponseValidationError{
+					field:  "EmergencyAccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RejectEmergencyAccessResponseValidationError{
+					field:  "EmergencyAccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmergencyAccess()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RejectEmergencyAccessResponseValidationError{
+				field:  "EmergencyAccess",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RejectEmergencyAccessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectEmergencyAccessResponseMultiError is an error wrapping multiple
+// validation errors returned by RejectEmergencyAccessResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RejectEmergencyAccessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectEmergencyAccessResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectEmergencyAccessResponseMultiError) AllErrors() []error { return m }
+
+// RejectEmergencyAccessResponseValidationError is the validation error
+// returned by RejectEmergencyAccessResponse.Validate if the designated
+// constraints aren't met.
+type RejectEmergencyAccessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectEmergencyAccessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectEmergencyAccessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectEmergencyAccessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectEmergencyAccessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectEmergencyAccessResponseValidationError) ErrorName() string {
+	return "RejectEmergencyAccessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectEmergencyAccessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectEmergencyAccessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectEmergencyAccessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectEmergencyAccessResponseValidationError{}
+
+// Validate checks the field values on ApproveEmergencyAccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveEmergencyAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveEmergencyAccessRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ApproveEmergencyAccessRequestMultiError, or nil if none found.
+func (m *ApproveEmergencyAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveEmergencyAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ApproveEmergencyAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveEmergencyAccessRequestMultiError is an error wrapping multiple
+// validation errors returned by ApproveEmergencyAccessRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ApproveEmergencyAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveEmergencyAccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveEmergencyAccessRequestMultiError) AllErrors() []error { return m }
+
+// ApproveEmergencyAccessRequestValidationError is the validation error
+// returned by ApproveEmergencyAccessRequest.Validate if the designated
+// constraints aren't met.
+type ApproveEmergencyAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveEmergencyAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveEmergencyAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveEmergencyAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveEmergencyAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveEmergencyAccessRequestValidationError) ErrorName() string {
+	return "ApproveEmergencyAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveEmergencyAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveEmergencyAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveEmergencyAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveEmergencyAccessRequestValidationError{}
+
+// Validate checks the field values on ApproveEmergencyAccessResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveEmergencyAccessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveEmergencyAccessResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ApproveEmergencyAccessResponseMultiError, or nil if none found.
+func (m *ApproveEmergencyAccessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveEmergencyAccessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEmergencyAccess()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveEmergencyAccessResponseValidationError{
+					field:  "EmergencyAccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveEmergencyAccessResponseValidationError{
+					field:  "EmergencyAccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmergencyAccess()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveEmergencyAccessResponseValidationError{
+				field:  "EmergencyAccess",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApproveEmergencyAccessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveEmergencyAccessResponseMultiError is an error wrapping multiple
+// validation errors returned by ApproveEmergencyAccessResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ApproveEmergencyAccessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveEmergencyAccessResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveEmergencyAccessResponseMultiError) AllErrors() []error { return m }
+
+// ApproveEmergencyAccessResponseValidationError is the validation error
+// returned by ApproveEmergencyAccessResponse.Validate if the designated
+// constraints aren't met.
+type ApproveEmergencyAccessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveEmergencyAccessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveEmergencyAccessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveEmergencyAccessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveEmergencyAccessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveEmergencyAccessResponseValidationError) ErrorName() string {
+	return "ApproveEmergencyAccessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveEmergencyAccessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveEmergencyAccessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveEmergencyAccessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveEmergencyAccessResponseValidationError{}
+
+// Validate checks the field values on DeleteEmergencyAccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteEmergencyAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteEmergencyAccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteEmergencyAccessRequestMultiError, or nil if none found.
+func (m *DeleteEmergencyAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteEmergencyAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteEmergencyAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteEmergencyAccessRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteEmergencyAccessRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteEmergencyAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteEmergencyAccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteEmergencyAccessRequestMultiError) AllErrors() []error { return m }
+
+// DeleteEmergencyAccessRequestValidationError is the validation error returned
+// by DeleteEmergencyAccessRequest.Validate if the designated constraints
+// aren't met.
+type DeleteEmergencyAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteEmergencyAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteEmergencyAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteEmergencyAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteEmergencyAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteEmergencyAccessRequestValidationError) ErrorName() string {
+	return "DeleteEmergencyAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteEmergencyAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteEmergencyAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteEmergencyAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteEmergencyAccessRequestValidationError{}
@@ -0,0 +1,331 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/emergency_access.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenEmergencyAccessService_CreateEmergencyAccess_FullMethodName  = "/warden.service.v1.WardenEmergencyAccessService/CreateEmergencyAccess"
+	WardenEmergencyAccessService_ListEmergencyAccess_FullMethodName    = "/warden.service.v1.WardenEmergencyAccessService/ListEmergencyAccess"
+	WardenEmergencyAccessService_RequestEmergencyAccess_FullMethodName = "/warden.service.v1.WardenEmergencyAccessService/RequestEmergencyAccess"
+	WardenEmergencyAccessService_RejectEmergencyAccess_FullMethodName  = "/warden.service.v1.WardenEmergencyAccessService/RejectEmergencyAccess"
+	WardenEmergencyAccessService_ApproveEmergencyAccess_FullMethodName = "/warden.service.v1.WardenEmergencyAccessService/ApproveEmergencyAccess"
+	WardenEmergencyAccessService_DeleteEmergencyAccess_FullMethodName  = "/warden.service.v1.WardenEmergencyAccessService/DeleteEmergencyAccess"
+)
+
+// WardenEmergencyAccessServiceClient is the client API for WardenEmergencyAccessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Emergency Access Service - trusted contacts who can request access to a
+// folder and receive it unless the owner rejects within a waiting period
+type WardenEmergencyAccessServiceClient interface {
+	// Designate a trusted contact for a folder the caller owns
+	CreateEmergencyAccess(ctx context.Context, in *CreateEmergencyAccessRequest, opts ...grpc.CallOption) (*CreateEmergencyAccessResponse, error)
+	// List the caller's trusted contacts and the accesses where the caller is a contact
+	ListEmergencyAccess(ctx context.Context, in *ListEmergencyAccessRequest, opts ...grpc.CallOption) (*ListEmergencyAccessResponse, error)
+	// Request access as the trusted contact, starting the waiting period
+	RequestEmergencyAccess(ctx context.Context, in *RequestEmergencyAccessRequest, opts ...grpc.CallOption) (*RequestEmergencyAccessResponse, error)
+	// Reject a pending request as the grantor
+	RejectEmergencyAccess(ctx context.Context, in *RejectEmergencyAccessRequest, opts ...grpc.CallOption) (*RejectEmergencyAccessResponse, error)
+	// Grant a pending request right away as the grantor
+	ApproveEmergencyAccess(ctx context.Context, in *ApproveEmergencyAccessRequest, opts ...grpc.CallOption) (*ApproveEmergencyAccessResponse, error)
+	// Remove a trusted contact (grantor or grantee); revokes access already granted
+	DeleteEmergencyAccess(ctx context.Context, in *DeleteEmergencyAccessRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type wardenEmergencyAccessServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenEmergencyAccessServiceClient(cc grpc.ClientConnInterface) WardenEmergencyAccessServiceClient {
+	return &wardenEmergencyAccessServiceClient{cc}
+}
+
+func (c *wardenEmergencyAccessServiceClient) CreateEmergencyAccess(ctx context.Context, in *CreateEmergencyAccessRequest, opts ...grpc.CallOption) (*CreateEmergencyAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, WardenEmergencyAccessService_CreateEmergencyAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenEmergencyAccessServiceClient) ListEmergencyAccess(ctx context.Context, in *ListEmergencyAccessRequest, opts ...grpc.CallOption) (*ListEmergencyAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, WardenEmergencyAccessService_ListEmergencyAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenEmergencyAccessServiceClient) RequestEmergencyAccess(ctx context.Context, in *RequestEmergencyAccessRequest, opts ...grpc.CallOption) (*RequestEmergencyAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestEmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, WardenEmergencyAccessService_RequestEmergencyAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenEmergencyAccessServiceClient) RejectEmergencyAccess(ctx context.Context, in *RejectEmergencyAccessRequest, opts ...grpc.CallOption) (*RejectEmergencyAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectEmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, WardenEmergencyAccessService_RejectEmergencyAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenEmergencyAccessServiceClient) ApproveEmergencyAccess(ctx context.Context, in *ApproveEmergencyAccessRequest, opts ...grpc.CallOption) (*ApproveEmergencyAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveEmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, WardenEmergencyAccessService_ApproveEmergencyAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenEmergencyAccessServiceClient) DeleteEmergencyAccess(ctx context.Context, in *DeleteEmergencyAccessRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WardenEmergencyAccessService_DeleteEmergencyAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenEmergencyAccessServiceServer is the server API for WardenEmergencyAccessService service.
+// All implementations must embed UnimplementedWardenEmergencyAccessServiceServer
+// for forward compatibility.
+//
+// Emergency Access Service - trusted contacts who can request access to a
+// folder and receive it unless the owner rejects within a waiting period
+type WardenEmergencyAccessServiceServer interface {
+	// Designate a trusted contact for a folder the caller owns
+	CreateEmergencyAccess(context.Context, *CreateEmergencyAccessRequest) (*CreateEmergencyAccessResponse, error)
+	// List the caller's trusted contacts and the accesses where the caller is a contact
+	ListEmergencyAccess(context.Context, *ListEmergencyAccessRequest) (*ListEmergencyAccessResponse, error)
+	// Request access as the trusted contact, starting the waiting period
+	RequestEmergencyAccess(context.Context, *RequestEmergencyAccessRequest) (*RequestEmergencyAccessResponse, error)
+	// Reject a pending request as the grantor
+	RejectEmergencyAccess(context.Context, *RejectEmergencyAccessRequest) (*RejectEmergencyAccessResponse, error)
+	// Grant a pending request right away as the grantor
+	ApproveEmergencyAccess(context.Context, *ApproveEmergencyAccessRequest) (*ApproveEmergencyAccessResponse, error)
+	// Remove a trusted contact (grantor or grantee); revokes access already granted
+	DeleteEmergencyAccess(context.Context, *DeleteEmergencyAccessRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedWardenEmergencyAccessServiceServer()
+}
+
+// UnimplementedWardenEmergencyAccessServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenEmergencyAccessServiceServer struct{}
+
+func (UnimplementedWardenEmergencyAccessServiceServer) CreateEmergencyAccess(context.Context, *CreateEmergencyAccessRequest) (*CreateEmergencyAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEmergencyAccess not implemented")
+}
+func (UnimplementedWardenEmergencyAccessServiceServer) ListEmergencyAccess(context.Context, *ListEmergencyAccessRequest) (*ListEmergencyAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEmergencyAccess not implemented")
+}
+func (UnimplementedWardenEmergencyAccessServiceServer) RequestEmergencyAccess(context.Context, *RequestEmergencyAccessRequest) (*RequestEmergencyAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestEmergencyAccess not implemented")
+}
+func (UnimplementedWardenEmergencyAccessServiceServer) RejectEmergencyAccess(context.Context, *RejectEmergencyAccessRequest) (*RejectEmergencyAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectEmergencyAccess not implemented")
+}
+func (UnimplementedWardenEmergencyAccessServiceServer) ApproveEmergencyAccess(context.Context, *ApproveEmergencyAccessRequest) (*ApproveEmergencyAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveEmergencyAccess not implemented")
+}
+func (UnimplementedWardenEmergencyAccessServiceServer) DeleteEmergencyAccess(context.Context, *DeleteEmergencyAccessRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEmergencyAccess not implemented")
+}
+func (UnimplementedWardenEmergencyAccessServiceServer) mustEmbedUnimplementedWardenEmergencyAccessServiceServer() {
+}
+func (UnimplementedWardenEmergencyAccessServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWardenEmergencyAccessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenEmergencyAccessServiceServer will
+// result in compilation errors.
+type UnsafeWardenEmergencyAccessServiceServer interface {
+	mustEmbedUnimplementedWardenEmergencyAccessServiceServer()
+}
+
+func RegisterWardenEmergencyAccessServiceServer(s grpc.ServiceRegistrar, srv WardenEmergencyAccessServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenEmergencyAccessServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenEmergencyAccessService_ServiceDesc, srv)
+}
+
+func _WardenEmergencyAccessService_CreateEmergencyAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmergencyAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenEmergencyAccessServiceServer).CreateEmergencyAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenEmergencyAccessService_CreateEmergencyAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenEmergencyAccessServiceServer).CreateEmergencyAccess(ctx, req.(*CreateEmergencyAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenEmergencyAccessService_ListEmergencyAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmergencyAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenEmergencyAccessServiceServer).ListEmergencyAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenEmergencyAccessService_ListEmergencyAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenEmergencyAccessServiceServer).ListEmergencyAccess(ctx, req.(*ListEmergencyAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenEmergencyAccessService_RequestEmergencyAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmergencyAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenEmergencyAccessServiceServer).RequestEmergencyAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenEmergencyAccessService_RequestEmergencyAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenEmergencyAccessServiceServer).RequestEmergencyAccess(ctx, req.(*RequestEmergencyAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenEmergencyAccessService_RejectEmergencyAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectEmergencyAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenEmergencyAccessServiceServer).RejectEmergencyAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenEmergencyAccessService_RejectEmergencyAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenEmergencyAccessServiceServer).RejectEmergencyAccess(ctx, req.(*RejectEmergencyAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenEmergencyAccessService_ApproveEmergencyAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveEmergencyAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenEmergencyAccessServiceServer).ApproveEmergencyAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenEmergencyAccessService_ApproveEmergencyAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenEmergencyAccessServiceServer).ApproveEmergencyAccess(ctx, req.(*ApproveEmergencyAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenEmergencyAccessService_DeleteEmergencyAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmergencyAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenEmergencyAccessServiceServer).DeleteEmergencyAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenEmergencyAccessService_DeleteEmergencyAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenEmergencyAccessServiceServer).DeleteEmergencyAccess(ctx, req.(*DeleteEmergencyAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenEmergencyAccessService_ServiceDesc is the grpc.ServiceDesc for WardenEmergencyAccessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenEmergencyAccessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenEmergencyAccessService",
+	HandlerType: (*WardenEmergencyAccessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEmergencyAccess",
+			Handler:    _WardenEmergencyAccessService_CreateEmergencyAccess_Handler,
+		},
+		{
+			MethodName: "ListEmergencyAccess",
+			Handler:    _WardenEmergencyAccessService_ListEmergencyAccess_Handler,
+		},
+		{
+			MethodName: "RequestEmergencyAccess",
+			Handler:    _WardenEmergencyAccessService_RequestEmergencyAccess_Handler,
+		},
+		{
+			MethodName: "RejectEmergencyAccess",
+			Handler:    _WardenEmergencyAccessService_RejectEmergencyAccess_Handler,
+		},
+		{
+			MethodName: "ApproveEmergencyAccess",
+			Handler:    _WardenEmergencyAccessService_ApproveEmergencyAccess_Handler,
+		},
+		{
+			MethodName: "DeleteEmergencyAccess",
+			Handler:    _WardenEmergencyAccessService_DeleteEmergencyAccess_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/emergency_access.proto",
+}
@@ -0,0 +1,298 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/emergency_access.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenEmergencyAccessServiceApproveEmergencyAccess = "/warden.service.v1.WardenEmergencyAccessService/ApproveEmergencyAccess"
+const OperationWardenEmergencyAccessServiceCreateEmergencyAccess = "/warden.service.v1.WardenEmergencyAccessService/CreateEmergencyAccess"
+const OperationWardenEmergencyAccessServiceDeleteEmergencyAccess = "/warden.service.v1.WardenEmergencyAccessService/DeleteEmergencyAccess"
+const OperationWardenEmergencyAccessServiceListEmergencyAccess = "/warden.service.v1.WardenEmergencyAccessService/ListEmergencyAccess"
+const OperationWardenEmergencyAccessServiceRejectEmergencyAccess = "/warden.service.v1.WardenEmergencyAccessService/RejectEmergencyAccess"
+const OperationWardenEmergencyAccessServiceRequestEmergencyAccess = "/warden.service.v1.WardenEmergencyAccessService/RequestEmergencyAccess"
+
+type WardenEmergencyAccessServiceHTTPServer interface {
+	// ApproveEmergencyAccess Grant a pending request right away as the grantor
+	ApproveEmergencyAccess(context.Context, *ApproveEmergencyAccessRequest) (*ApproveEmergencyAccessResponse, error)
+	// CreateEmergencyAccess Designate a trusted contact for a folder the caller owns
+	CreateEmergencyAccess(context.Context, *CreateEmergencyAccessRequest) (*CreateEmergencyAccessResponse, error)
+	// DeleteEmergencyAccess Remove a trusted contact (grantor or grantee); revokes access already granted
+	DeleteEmergencyAccess(context.Context, *DeleteEmergencyAccessRequest) (*emptypb.Empty, error)
+	// ListEmergencyAccess List the caller's trusted contacts and the accesses where the caller is a contact
+	ListEmergencyAccess(context.Context, *ListEmergencyAccessRequest) (*ListEmergencyAccessResponse, error)
+	// RejectEmergencyAccess Reject a pending request as the grantor
+	RejectEmergencyAccess(context.Context, *RejectEmergencyAccessRequest) (*RejectEmergencyAccessResponse, error)
+	// RequestEmergencyAccess Request access as the trusted contact, starting the waiting period
+	RequestEmergencyAccess(context.Context, *RequestEmergencyAccessRequest) (*RequestEmergencyAccessResponse, error)
+}
+
+func RegisterWardenEmergencyAccessServiceHTTPServer(s *http.Server, srv WardenEmergencyAccessServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/emergency-access", _WardenEmergencyAccessService_CreateEmergencyAccess0_HTTP_Handler(srv))
+	r.GET("/v1/emergency-access", _WardenEmergencyAccessService_ListEmergencyAccess0_HTTP_Handler(srv))
+	r.POST("/v1/emergency-access/{id}:request", _WardenEmergencyAccessService_RequestEmergencyAccess0_HTTP_Handler(srv))
+	r.POST("/v1/emergency-access/{id}:reject", _WardenEmergencyAccessService_RejectEmergencyAccess0_HTTP_Handler(srv))
+	r.POST("/v1/emergency-access/{id}:approve", _WardenEmergencyAccessService_ApproveEmergencyAccess0_HTTP_Handler(srv))
+	r.DELETE("/v1/emergency-access/{id}", _WardenEmergencyAccessService_DeleteEmergencyAccess0_HTTP_Handler(srv))
+}
+
+func _WardenEmergencyAccessService_CreateEmergencyAccess0_HTTP_Handler(srv WardenEmergencyAccessServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateEmergencyAccessRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenEmergencyAccessServiceCreateEmergencyAccess)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateEmergencyAccess(ctx, req.(*CreateEmergencyAccessRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateEmergencyAccessResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenEmergencyAccessService_ListEmergencyAccess0_HTTP_Handler(srv WardenEmergencyAccessServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListEmergencyAccessRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenEmergencyAccessServiceListEmergencyAccess)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListEmergencyAccess(ctx, req.(*ListEmergencyAccessRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListEmergencyAccessResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenEmergencyAccessService_RequestEmergencyAccess0_HTTP_Handler(srv WardenEmergencyAccessServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestEmergencyAccessRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenEmergencyAccessServiceRequestEmergencyAccess)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestEmergencyAccess(ctx, req.(*RequestEmergencyAccessRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestEmergencyAccessResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenEmergencyAccessService_RejectEmergencyAccess0_HTTP_Handler(srv WardenEmergencyAccessServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RejectEmergencyAccessRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenEmergencyAccessServiceRejectEmergencyAccess)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectEmergencyAccess(ctx, req.(*RejectEmergencyAccessRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RejectEmergencyAccessResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenEmergencyAccessService_ApproveEmergencyAccess0_HTTP_Handler(srv WardenEmergencyAccessServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApproveEmergencyAccessRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenEmergencyAccessServiceApproveEmergencyAccess)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveEmergencyAccess(ctx, req.(*ApproveEmergencyAccessRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ApproveEmergencyAccessResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenEmergencyAccessService_DeleteEmergencyAccess0_HTTP_Handler(srv WardenEmergencyAccessServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteEmergencyAccessRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenEmergencyAccessServiceDeleteEmergencyAccess)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteEmergencyAccess(ctx, req.(*DeleteEmergencyAccessRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenEmergencyAccessServiceHTTPClient interface {
+	// ApproveEmergencyAccess Grant a pending request right away as the grantor
+	ApproveEmergencyAccess(ctx context.Context, req *ApproveEmergencyAccessRequest, opts ...http.CallOption) (rsp *ApproveEmergencyAccessResponse, err error)
+	// CreateEmergencyAccess Designate a trusted contact for a folder the caller owns
+	CreateEmergencyAccess(ctx context.Context, req *CreateEmergencyAccessRequest, opts ...http.CallOption) (rsp *CreateEmergencyAccessResponse, err error)
+	// DeleteEmergencyAccess Remove a trusted contact (grantor or grantee); revokes access already granted
+	DeleteEmergencyAccess(ctx context.Context, req *DeleteEmergencyAccessRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ListEmergencyAccess List the caller's trusted contacts and the accesses where the caller is a contact
+	ListEmergencyAccess(ctx context.Context, req *ListEmergencyAccessRequest, opts ...http.CallOption) (rsp *ListEmergencyAccessResponse, err error)
+	// RejectEmergencyAccess Reject a pending request as the grantor
+	RejectEmergencyAccess(ctx context.Context, req *RejectEmergencyAccessRequest, opts ...http.CallOption) (rsp *RejectEmergencyAccessResponse, err error)
+	// RequestEmergencyAccess Request access as the trusted contact, starting the waiting period
+	RequestEmergencyAccess(ctx context.Context, req *RequestEmergencyAccessRequest, opts ...http.CallOption) (rsp *RequestEmergencyAccessResponse, err error)
+}
+
+type WardenEmergencyAccessServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenEmergencyAccessServiceHTTPClient(client *http.Client) WardenEmergencyAccessServiceHTTPClient {
+	return &WardenEmergencyAccessServiceHTTPClientImpl{client}
+}
+
+// ApproveEmergencyAccess Grant a pending request right away as the grantor
+func (c *WardenEmergencyAccessServiceHTTPClientImpl) ApproveEmergencyAccess(ctx context.Context, in *ApproveEmergencyAccessRequest, opts ...http.CallOption) (*ApproveEmergencyAccessResponse, error) {
+	var out ApproveEmergencyAccessResponse
+	pattern := "/v1/emergency-access/{id}:approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenEmergencyAccessServiceApproveEmergencyAccess))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateEmergencyAccess Designate a trusted contact for a folder the caller owns
+func (c *WardenEmergencyAccessServiceHTTPClientImpl) CreateEmergencyAccess(ctx context.Context, in *CreateEmergencyAccessRequest, opts ...http.CallOption) (*CreateEmergencyAccessResponse, error) {
+	var out CreateEmergencyAccessResponse
+	pattern := "/v1/emergency-access"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenEmergencyAccessServiceCreateEmergencyAccess))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteEmergencyAccess Remove a trusted contact (grantor or grantee); revokes access already granted
+func (c *WardenEmergencyAccessServiceHTTPClientImpl) DeleteEmergencyAccess(ctx context.Context, in *DeleteEmergencyAccessRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/emergency-access/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenEmergencyAccessServiceDeleteEmergencyAccess))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEmergencyAccess List the caller's trusted contacts and the accesses where the caller is a contact
+func (c *WardenEmergencyAccessServiceHTTPClientImpl) ListEmergencyAccess(ctx context.Context, in *ListEmergencyAccessRequest, opts ...http.CallOption) (*ListEmergencyAccessResponse, error) {
+	var out ListEmergencyAccessResponse
+	pattern := "/v1/emergency-access"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenEmergencyAccessServiceListEmergencyAccess))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RejectEmergencyAccess Reject a pending request as the grantor
+func (c *WardenEmergencyAccessServiceHTTPClientImpl) RejectEmergencyAccess(ctx context.Context, in *RejectEmergencyAccessRequest, opts ...http.CallOption) (*RejectEmergencyAccessResponse, error) {
+	var out RejectEmergencyAccessResponse
+	pattern := "/v1/emergency-access/{id}:reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenEmergencyAccessServiceRejectEmergencyAccess))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestEmergencyAccess Request access as the trusted contact, starting the waiting period
+func (c *WardenEmergencyAccessServiceHTTPClientImpl) RequestEmergencyAccess(ctx context.Context, in *RequestEmergencyAccessRequest, opts ...http.CallOption) (*RequestEmergencyAccessResponse, error) {
+	var out RequestEmergencyAccessResponse
+	pattern := "/v1/emergency-access/{id}:request"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenEmergencyAccessServiceRequestEmergencyAccess))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
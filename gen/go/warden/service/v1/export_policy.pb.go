@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/export_policy.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Secrets matching any rule are skipped by Bitwarden, CSV and backup exports
+type ExportPolicy struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Secrets whose metadata "tags" contain any of these (case-insensitive)
+	ExcludedTags []string `protobuf:"bytes,2,rep,name=excluded_tags,json=excludedTags,proto3" json:"excluded_tags,omitempty"`
+	// Folders whose whole subtree is excluded
+	ExcludedFolderIds []string               `protobuf:"bytes,3,rep,name=excluded_folder_ids,json=excludedFolderIds,proto3" json:"excluded_folder_ids,omitempty"`
+	UpdateTime        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExportPolicy) Reset() {
+	*x = ExportPolicy{}
+	mi := &file_warden_service_v1_export_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPolicy) ProtoMessage() {}
+
+func (x *ExportPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_export_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPolicy.ProtoReflect.Descriptor instead.
+func (*ExportPolicy) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_export_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportPolicy) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *ExportPolicy) GetExcludedTags() []string {
+	if x != nil {
+		return x.ExcludedTags
+	}
+	return nil
+}
+
+func (x *ExportPolicy) GetExcludedFolderIds() []string {
+	if x != nil {
+		return x.ExcludedFolderIds
+	}
+	return nil
+}
+
+func (x *ExportPolicy) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type GetExportPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant to read (defaults to the caller's tenant; other tenants require platform admin)
+	TenantId      *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExportPolicyRequest) Reset() {
+	*x = GetExportPolicyRequest{}
+	mi := &file_warden_service_v1_export_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExportPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportPolicyRequest) ProtoMessage() {}
+
+func (x *GetExportPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_export_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetExportPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_export_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetExportPolicyRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type SetExportPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant to configure (defaults to the caller's tenant)
+	TenantId          *uint32  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	ExcludedTags      []string `protobuf:"bytes,2,rep,name=excluded_tags,json=excludedTags,proto3" json:"excluded_tags,omitempty"`
+	ExcludedFolderIds []string `protobuf:"bytes,3,rep,name=excluded_folder_ids,json=excludedFolderIds,proto3" json:"excluded_folder_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetExportPolicyRequest) Reset() {
+	*x = SetExportPolicyRequest{}
+	mi := &file_warden_service_v1_export_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExportPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExportPolicyRequest) ProtoMessage() {}
+
+func (x *SetExportPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_export_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExportPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetExportPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_export_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetExportPolicyRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *SetExportPolicyRequest) GetExcludedTags() []string {
+	if x != nil {
+		return x.ExcludedTags
+	}
+	return nil
+}
+
+func (x *SetExportPolicyRequest) GetExcludedFolderIds() []string {
+	if x != nil {
+		return x.ExcludedFolderIds
+	}
+	return nil
+}
+
+var File_warden_service_v1_export_policy_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_export_policy_proto_rawDesc = "" +
+	"\n" +
+	"%warden/service/v1/export_policy.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd2\x01\n" +
+	"\fExportPolicy\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12#\n" +
+	"\rexcluded_tags\x18\x02 \x03(\tR\fexcludedTags\x12.\n" +
+	"\x13excluded_folder_ids\x18\x03 \x03(\tR\x11excludedFolderIds\x12@\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"updateTime\x88\x01\x01B\x0e\n" +
+	"\f_update_time\"H\n" +
+	"\x16GetExportPolicyRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"\xd3\x01\n" +
+	"\x16SetExportPolicyRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x125\n" +
+	"\rexcluded_tags\x18\x02 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10d\"\x06r\x04\x10\x01\x18@R\fexcludedTags\x12R\n" +
+	"\x13excluded_folder_ids\x18\x03 \x03(\tB\"\xbaH\x1f\x92\x01\x1c\x10d\"\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]*$R\x11excludedFolderIdsB\f\n" +
+	"\n" +
+	"_tenant_id2\x92\x02\n" +
+	"\x19WardenExportPolicyService\x12x\n" +
+	"\x0fGetExportPolicy\x12).warden.service.v1.GetExportPolicyRequest\x1a\x1f.warden.service.v1.ExportPolicy\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/export-policy\x12{\n" +
+	"\x0fSetExportPolicy\x12).warden.service.v1.SetExportPolicyRequest\x1a\x1f.warden.service.v1.ExportPolicy\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v1/export-policyB\xd9\x01\n" +
+	"\x15com.warden.service.v1B\x11ExportPolicyProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_export_policy_proto_rawDescOnce sync.Once
+	file_warden_service_v1_export_policy_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_export_policy_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_export_policy_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_export_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_export_policy_proto_rawDesc), len(file_warden_service_v1_export_policy_proto_rawDesc)))
+	})
+	return file_warden_service_v1_export_policy_proto_rawDescData
+}
+
+var file_warden_service_v1_export_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_warden_service_v1_export_policy_proto_goTypes = []any{
+	(*ExportPolicy)(nil),           // 0: warden.service.v1.ExportPolicy
+	(*GetExportPolicyRequest)(nil), // 1: warden.service.v1.GetExportPolicyRequest
+	(*SetExportPolicyRequest)(nil), // 2: warden.service.v1.SetExportPolicyRequest
+	(*timestamppb.Timestamp)(nil),  // 3: google.protobuf.Timestamp
+}
+var file_warden_service_v1_export_policy_proto_depIdxs = []int32{
+	3, // 0: warden.service.v1.ExportPolicy.update_time:type_name -> google.protobuf.Timestamp
+	1, // 1: warden.service.v1.WardenExportPolicyService.GetExportPolicy:input_type -> warden.service.v1.GetExportPolicyRequest
+	2, // 2: warden.service.v1.WardenExportPolicyService.SetExportPolicy:input_type -> warden.service.v1.SetExportPolicyRequest
+	0, // 3: warden.service.v1.WardenExportPolicyService.GetExportPolicy:output_type -> warden.service.v1.ExportPolicy
+	0, // 4: warden.service.v1.WardenExportPolicyService.SetExportPolicy:output_type -> warden.service.v1.ExportPolicy
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_export_policy_proto_init() }
+func file_warden_service_v1_export_policy_proto_init() {
+	if File_warden_service_v1_export_policy_proto != nil {
+		return
+	}
+	file_warden_service_v1_export_policy_proto_msgTypes[0].OneofWrappers = []any{}
+	file_warden_service_v1_export_policy_proto_msgTypes[1].OneofWrappers = []any{}
+	file_warden_service_v1_export_policy_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_export_policy_proto_rawDesc), len(file_warden_service_v1_export_policy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_export_policy_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_export_policy_proto_depIdxs,
+		MessageInfos:      file_warden_service_v1_export_policy_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_export_policy_proto = out.File
+	file_warden_service_v1_export_policy_proto_goTypes = nil
+	file_warden_service_v1_export_policy_proto_depIdxs = nil
+}
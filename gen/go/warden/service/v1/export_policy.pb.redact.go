@@ -0,0 +1,105 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/export_policy.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ timestamppb.Timestamp
+)
+
+// RegisterRedactedWardenExportPolicyServiceServer wraps the WardenExportPolicyServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenExportPolicyServiceServer(s grpc.ServiceRegistrar, srv WardenExportPolicyServiceServer, bypass redact.Bypass) {
+	RegisterWardenExportPolicyServiceServer(s, RedactedWardenExportPolicyServiceServer(srv, bypass))
+}
+
+func RedactedWardenExportPolicyServiceServer(srv WardenExportPolicyServiceServer, bypass redact.Bypass) WardenExportPolicyServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenExportPolicyServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenExportPolicyServiceServer struct {
+	UnsafeWardenExportPolicyServiceServer
+	srv    WardenExportPolicyServiceServer
+	bypass redact.Bypass
+}
+
+// GetExportPolicy is the redacted wrapper for the actual WardenExportPolicyServiceServer.GetExportPolicy method
+// Unary RPC
+func (s *redactedWardenExportPolicyServiceServer) GetExportPolicy(ctx context.Context, in *GetExportPolicyRequest) (*ExportPolicy, error) {
+	res, err := s.srv.GetExportPolicy(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SetExportPolicy is the redacted wrapper for the actual WardenExportPolicyServiceServer.SetExportPolicy method
+// Unary RPC
+func (s *redactedWardenExportPolicyServiceServer) SetExportPolicy(ctx context.Context, in *SetExportPolicyRequest) (*ExportPolicy, error) {
+	res, err := s.srv.SetExportPolicy(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for ExportPolicy
+func (x *ExportPolicy) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: ExcludedTags
+
+	// Safe field: ExcludedFolderIds
+
+	// Safe field: UpdateTime
+	return x.String()
+}
+
+// Redact method implementation for GetExportPolicyRequest
+func (x *GetExportPolicyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+	return x.String()
+}
+
+// Redact method implementation for SetExportPolicyRequest
+func (x *SetExportPolicyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: ExcludedTags
+
+	// Safe field: ExcludedFolderIds
+	return x.String()
+}
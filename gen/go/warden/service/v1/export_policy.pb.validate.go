@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/export_policy.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ExportPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportPolicyMultiError, or
+// nil if none found.
+func (m *ExportPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	if m.UpdateTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdateTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportPolicyValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportPolicyValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportPolicyValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExportPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPolicyMultiError is an error wrapping multiple validation errors
+// returned by ExportPolicy.ValidateAll() if the designated constraints aren't met.
+type ExportPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPolicyMultiError) AllErrors() []error { return m }
+
+// ExportPolicyValidationError is the validation error returned by
+// ExportPolicy.Validate if the designated constraints aren't met.
+type ExportPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPolicyValidationError) ErrorName() string { return "ExportPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPolicyValidationError{}
+
+// Validate checks the field values on GetExportPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExportPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExportPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExportPolicyRequestMultiError, or nil if none found.
+func (m *GetExportPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExportPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return GetExportPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExportPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by GetExportPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetExportPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExportPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExportPolicyRequestMultiError) AllErrors() []error { return m }
+
+// GetExportPolicyRequestValidationError is the validation error returned by
+// GetExportPolicyRequest.Validate if the designated constraints aren't met.
+type GetExportPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExportPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExportPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExportPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExportPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExportPolicyRequestValidationError) ErrorName() string {
+	return "GetExportPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExportPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExportPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExportPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExportPolicyRequestValidationError{}
+
+// Validate checks the field values on SetExportPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetExportPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetExportPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetExportPolicyRequestMultiError, or nil if none found.
+func (m *SetExportPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetExportPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return SetExportPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetExportPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by SetExportPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetExportPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetExportPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetExportPolicyRequestMultiError) AllErrors() []error { return m }
+
+// SetExportPolicyRequestValidationError is the validation error returned by
+// SetExportPolicyRequest.Validate if the designated constraints aren't met.
+type SetExportPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetExportPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetExportPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetExportPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetExportPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetExportPolicyRequestValidationError) ErrorName() string {
+	return "SetExportPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetExportPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetExportPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetExportPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetExportPolicyRequestValidationError{}
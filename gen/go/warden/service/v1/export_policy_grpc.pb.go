@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/export_policy.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenExportPolicyService_GetExportPolicy_FullMethodName = "/warden.service.v1.WardenExportPolicyService/GetExportPolicy"
+	WardenExportPolicyService_SetExportPolicy_FullMethodName = "/warden.service.v1.WardenExportPolicyService/SetExportPolicy"
+)
+
+// WardenExportPolicyServiceClient is the client API for WardenExportPolicyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Export Policy Service - per-tenant rules for secrets that must never be exported
+type WardenExportPolicyServiceClient interface {
+	// Get the export policy of a tenant
+	GetExportPolicy(ctx context.Context, in *GetExportPolicyRequest, opts ...grpc.CallOption) (*ExportPolicy, error)
+	// Replace the export policy of a tenant (platform admin only)
+	SetExportPolicy(ctx context.Context, in *SetExportPolicyRequest, opts ...grpc.CallOption) (*ExportPolicy, error)
+}
+
+type wardenExportPolicyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenExportPolicyServiceClient(cc grpc.ClientConnInterface) WardenExportPolicyServiceClient {
+	return &wardenExportPolicyServiceClient{cc}
+}
+
+func (c *wardenExportPolicyServiceClient) GetExportPolicy(ctx context.Context, in *GetExportPolicyRequest, opts ...grpc.CallOption) (*ExportPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportPolicy)
+	err := c.cc.Invoke(ctx, WardenExportPolicyService_GetExportPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenExportPolicyServiceClient) SetExportPolicy(ctx context.Context, in *SetExportPolicyRequest, opts ...grpc.CallOption) (*ExportPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportPolicy)
+	err := c.cc.Invoke(ctx, WardenExportPolicyService_SetExportPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenExportPolicyServiceServer is the server API for WardenExportPolicyService service.
+// All implementations must embed UnimplementedWardenExportPolicyServiceServer
+// for forward compatibility.
+//
+// Export Policy Service - per-tenant rules for secrets that must never be exported
+type WardenExportPolicyServiceServer interface {
+	// Get the export policy of a tenant
+	GetExportPolicy(context.Context, *GetExportPolicyRequest) (*ExportPolicy, error)
+	// Replace the export policy of a tenant (platform admin only)
+	SetExportPolicy(context.Context, *SetExportPolicyRequest) (*ExportPolicy, error)
+	mustEmbedUnimplementedWardenExportPolicyServiceServer()
+}
+
+// UnimplementedWardenExportPolicyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenExportPolicyServiceServer struct{}
+
+func (UnimplementedWardenExportPolicyServiceServer) GetExportPolicy(context.Context, *GetExportPolicyRequest) (*ExportPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExportPolicy not implemented")
+}
+func (UnimplementedWardenExportPolicyServiceServer) SetExportPolicy(context.Context, *SetExportPolicyRequest) (*ExportPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetExportPolicy not implemented")
+}
+func (UnimplementedWardenExportPolicyServiceServer) mustEmbedUnimplementedWardenExportPolicyServiceServer() {
+}
+func (UnimplementedWardenExportPolicyServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWardenExportPolicyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenExportPolicyServiceServer will
+// result in compilation errors.
+type UnsafeWardenExportPolicyServiceServer interface {
+	mustEmbedUnimplementedWardenExportPolicyServiceServer()
+}
+
+func RegisterWardenExportPolicyServiceServer(s grpc.ServiceRegistrar, srv WardenExportPolicyServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenExportPolicyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenExportPolicyService_ServiceDesc, srv)
+}
+
+func _WardenExportPolicyService_GetExportPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenExportPolicyServiceServer).GetExportPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenExportPolicyService_GetExportPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenExportPolicyServiceServer).GetExportPolicy(ctx, req.(*GetExportPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenExportPolicyService_SetExportPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExportPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenExportPolicyServiceServer).SetExportPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenExportPolicyService_SetExportPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenExportPolicyServiceServer).SetExportPolicy(ctx, req.(*SetExportPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenExportPolicyService_ServiceDesc is the grpc.ServiceDesc for WardenExportPolicyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenExportPolicyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenExportPolicyService",
+	HandlerType: (*WardenExportPolicyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetExportPolicy",
+			Handler:    _WardenExportPolicyService_GetExportPolicy_Handler,
+		},
+		{
+			MethodName: "SetExportPolicy",
+			Handler:    _WardenExportPolicyService_SetExportPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/export_policy.proto",
+}
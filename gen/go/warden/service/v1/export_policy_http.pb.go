@@ -0,0 +1,120 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/export_policy.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenExportPolicyServiceGetExportPolicy = "/warden.service.v1.WardenExportPolicyService/GetExportPolicy"
+const OperationWardenExportPolicyServiceSetExportPolicy = "/warden.service.v1.WardenExportPolicyService/SetExportPolicy"
+
+type WardenExportPolicyServiceHTTPServer interface {
+	// GetExportPolicy Get the export policy of a tenant
+	GetExportPolicy(context.Context, *GetExportPolicyRequest) (*ExportPolicy, error)
+	// SetExportPolicy Replace the export policy of a tenant (platform admin only)
+	SetExportPolicy(context.Context, *SetExportPolicyRequest) (*ExportPolicy, error)
+}
+
+func RegisterWardenExportPolicyServiceHTTPServer(s *http.Server, srv WardenExportPolicyServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/export-policy", _WardenExportPolicyService_GetExportPolicy0_HTTP_Handler(srv))
+	r.PUT("/v1/export-policy", _WardenExportPolicyService_SetExportPolicy0_HTTP_Handler(srv))
+}
+
+func _WardenExportPolicyService_GetExportPolicy0_HTTP_Handler(srv WardenExportPolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetExportPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenExportPolicyServiceGetExportPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetExportPolicy(ctx, req.(*GetExportPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportPolicy)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenExportPolicyService_SetExportPolicy0_HTTP_Handler(srv WardenExportPolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetExportPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenExportPolicyServiceSetExportPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetExportPolicy(ctx, req.(*SetExportPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportPolicy)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenExportPolicyServiceHTTPClient interface {
+	// GetExportPolicy Get the export policy of a tenant
+	GetExportPolicy(ctx context.Context, req *GetExportPolicyRequest, opts ...http.CallOption) (rsp *ExportPolicy, err error)
+	// SetExportPolicy Replace the export policy of a tenant (platform admin only)
+	SetExportPolicy(ctx context.Context, req *SetExportPolicyRequest, opts ...http.CallOption) (rsp *ExportPolicy, err error)
+}
+
+type WardenExportPolicyServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenExportPolicyServiceHTTPClient(client *http.Client) WardenExportPolicyServiceHTTPClient {
+	return &WardenExportPolicyServiceHTTPClientImpl{client}
+}
+
+// GetExportPolicy Get the export policy of a tenant
+func (c *WardenExportPolicyServiceHTTPClientImpl) GetExportPolicy(ctx context.Context, in *GetExportPolicyRequest, opts ...http.CallOption) (*ExportPolicy, error) {
+	var out ExportPolicy
+	pattern := "/v1/export-policy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenExportPolicyServiceGetExportPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetExportPolicy Replace the export policy of a tenant (platform admin only)
+func (c *WardenExportPolicyServiceHTTPClientImpl) SetExportPolicy(ctx context.Context, in *SetExportPolicyRequest, opts ...http.CallOption) (*ExportPolicy, error) {
+	var out ExportPolicy
+	pattern := "/v1/export-policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenExportPolicyServiceSetExportPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
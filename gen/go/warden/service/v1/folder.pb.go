@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -25,23 +26,160 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Folder status
+type FolderStatus int32
+
+const (
+	FolderStatus_FOLDER_STATUS_UNSPECIFIED FolderStatus = 0
+	FolderStatus_FOLDER_STATUS_ACTIVE      FolderStatus = 1
+	// In the trash, until restored or purged
+	FolderStatus_FOLDER_STATUS_DELETED FolderStatus = 2
+)
+
+// Enum value maps for FolderStatus.
+var (
+	FolderStatus_name = map[int32]string{
+		0: "FOLDER_STATUS_UNSPECIFIED",
+		1: "FOLDER_STATUS_ACTIVE",
+		2: "FOLDER_STATUS_DELETED",
+	}
+	FolderStatus_value = map[string]int32{
+		"FOLDER_STATUS_UNSPECIFIED": 0,
+		"FOLDER_STATUS_ACTIVE":      1,
+		"FOLDER_STATUS_DELETED":     2,
+	}
+)
+
+func (x FolderStatus) Enum() *FolderStatus {
+	p := new(FolderStatus)
+	*p = x
+	return p
+}
+
+func (x FolderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FolderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_folder_proto_enumTypes[0].Descriptor()
+}
+
+func (FolderStatus) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_folder_proto_enumTypes[0]
+}
+
+func (x FolderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FolderStatus.Descriptor instead.
+func (FolderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{0}
+}
+
+// Kind of a folder structure change
+type FolderChangeAction int32
+
+const (
+	FolderChangeAction_FOLDER_CHANGE_ACTION_UNSPECIFIED FolderChangeAction = 0
+	FolderChangeAction_FOLDER_CHANGE_ACTION_CREATED     FolderChangeAction = 1
+	FolderChangeAction_FOLDER_CHANGE_ACTION_RENAMED     FolderChangeAction = 2
+	FolderChangeAction_FOLDER_CHANGE_ACTION_MOVED       FolderChangeAction = 3
+	FolderChangeAction_FOLDER_CHANGE_ACTION_DELETED     FolderChangeAction = 4
+	FolderChangeAction_FOLDER_CHANGE_ACTION_TRASHED     FolderChangeAction = 5
+	FolderChangeAction_FOLDER_CHANGE_ACTION_RESTORED    FolderChangeAction = 6
+)
+
+// Enum value maps for FolderChangeAction.
+var (
+	FolderChangeAction_name = map[int32]string{
+		0: "FOLDER_CHANGE_ACTION_UNSPECIFIED",
+		1: "FOLDER_CHANGE_ACTION_CREATED",
+		2: "FOLDER_CHANGE_ACTION_RENAMED",
+		3: "FOLDER_CHANGE_ACTION_MOVED",
+		4: "FOLDER_CHANGE_ACTION_DELETED",
+		5: "FOLDER_CHANGE_ACTION_TRASHED",
+		6: "FOLDER_CHANGE_ACTION_RESTORED",
+	}
+	FolderChangeAction_value = map[string]int32{
+		"FOLDER_CHANGE_ACTION_UNSPECIFIED": 0,
+		"FOLDER_CHANGE_ACTION_CREATED":     1,
+		"FOLDER_CHANGE_ACTION_RENAMED":     2,
+		"FOLDER_CHANGE_ACTION_MOVED":       3,
+		"FOLDER_CHANGE_ACTION_DELETED":     4,
+		"FOLDER_CHANGE_ACTION_TRASHED":     5,
+		"FOLDER_CHANGE_ACTION_RESTORED":    6,
+	}
+)
+
+func (x FolderChangeAction) Enum() *FolderChangeAction {
+	p := new(FolderChangeAction)
+	*p = x
+	return p
+}
+
+func (x FolderChangeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FolderChangeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_folder_proto_enumTypes[1].Descriptor()
+}
+
+func (FolderChangeAction) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_folder_proto_enumTypes[1]
+}
+
+func (x FolderChangeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FolderChangeAction.Descriptor instead.
+func (FolderChangeAction) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{1}
+}
+
 // Folder entity
 type Folder struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId       uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	ParentId       *string                `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
-	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Path           string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
-	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Depth          int32                  `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
-	SecretCount    int32                  `protobuf:"varint,8,opt,name=secret_count,json=secretCount,proto3" json:"secret_count,omitempty"`
-	SubfolderCount int32                  `protobuf:"varint,9,opt,name=subfolder_count,json=subfolderCount,proto3" json:"subfolder_count,omitempty"`
-	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	CreatedBy      *uint32                `protobuf:"varint,12,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId    uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ParentId    *string                `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Name        string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Path        string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	Description string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Depth       int32                  `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Non-deleted secrets and child folders directly in this folder, filled
+	// when counts are requested
+	SecretCount      int32                  `protobuf:"varint,8,opt,name=secret_count,json=secretCount,proto3" json:"secret_count,omitempty"`
+	SubfolderCount   int32                  `protobuf:"varint,9,opt,name=subfolder_count,json=subfolderCount,proto3" json:"subfolder_count,omitempty"`
+	CreateTime       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	CreatedBy        *uint32                `protobuf:"varint,12,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_accessed_time,json=lastAccessedTime,proto3,oneof" json:"last_accessed_time,omitempty"`
+	// Incremented on every change; pass it as expected_revision to update only
+	// the version that was read
+	Revision int64 `protobuf:"varint,14,opt,name=revision,proto3" json:"revision,omitempty"`
+	// JSON Schema the metadata of secrets created or updated in this folder must satisfy
+	MetadataSchema *structpb.Struct `protobuf:"bytes,15,opt,name=metadata_schema,json=metadataSchema,proto3" json:"metadata_schema,omitempty"`
+	// Granted on every secret created in this folder, besides OWNER for the creator
+	DefaultPermissions []*InitialPermissionGrant `protobuf:"bytes,16,rep,name=default_permissions,json=defaultPermissions,proto3" json:"default_permissions,omitempty"`
+	// Network and time restrictions on access to the folder and everything in it
+	AccessPolicy *AccessPolicy `protobuf:"bytes,17,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy,omitempty"`
+	// Deleting the folder or permanently deleting anything in it needs the
+	// approval of a second owner, through a deletion request
+	Protected bool `protobuf:"varint,18,opt,name=protected,proto3" json:"protected,omitempty"`
+	// Nothing in the folder can be permanently deleted or destroyed until a
+	// platform admin lifts the hold
+	LegalHold       bool         `protobuf:"varint,19,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	LegalHoldReason string       `protobuf:"bytes,20,opt,name=legal_hold_reason,json=legalHoldReason,proto3" json:"legal_hold_reason,omitempty"`
+	Status          FolderStatus `protobuf:"varint,21,opt,name=status,proto3,enum=warden.service.v1.FolderStatus" json:"status,omitempty"`
+	// When the folder was moved to the trash
+	DeletedTime *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=deleted_time,json=deletedTime,proto3,oneof" json:"deleted_time,omitempty"`
+	// Path of a folder in the trash before it was deleted
+	OriginalPath  string `protobuf:"bytes,23,opt,name=original_path,json=originalPath,proto3" json:"original_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Folder) Reset() {
@@ -158,6 +296,83 @@ func (x *Folder) GetCreatedBy() uint32 {
 	return 0
 }
 
+func (x *Folder) GetLastAccessedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedTime
+	}
+	return nil
+}
+
+func (x *Folder) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Folder) GetMetadataSchema() *structpb.Struct {
+	if x != nil {
+		return x.MetadataSchema
+	}
+	return nil
+}
+
+func (x *Folder) GetDefaultPermissions() []*InitialPermissionGrant {
+	if x != nil {
+		return x.DefaultPermissions
+	}
+	return nil
+}
+
+func (x *Folder) GetAccessPolicy() *AccessPolicy {
+	if x != nil {
+		return x.AccessPolicy
+	}
+	return nil
+}
+
+func (x *Folder) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *Folder) GetLegalHold() bool {
+	if x != nil {
+		return x.LegalHold
+	}
+	return false
+}
+
+func (x *Folder) GetLegalHoldReason() string {
+	if x != nil {
+		return x.LegalHoldReason
+	}
+	return ""
+}
+
+func (x *Folder) GetStatus() FolderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FolderStatus_FOLDER_STATUS_UNSPECIFIED
+}
+
+func (x *Folder) GetDeletedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedTime
+	}
+	return nil
+}
+
+func (x *Folder) GetOriginalPath() string {
+	if x != nil {
+		return x.OriginalPath
+	}
+	return ""
+}
+
 // Request to create a folder
 type CreateFolderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -384,7 +599,19 @@ type ListFoldersRequest struct {
 	Page     *uint32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
 	PageSize *uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
 	// Search by name
-	NameFilter    *string `protobuf:"bytes,4,opt,name=name_filter,json=nameFilter,proto3,oneof" json:"name_filter,omitempty"`
+	NameFilter *string `protobuf:"bytes,4,opt,name=name_filter,json=nameFilter,proto3,oneof" json:"name_filter,omitempty"`
+	// Opaque next_cursor of the previous page; continues after it and ignores page
+	Cursor *string `protobuf:"bytes,5,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// Sort order; a cursor only continues the order it was returned for
+	SortBy    *ListSortField `protobuf:"varint,6,opt,name=sort_by,json=sortBy,proto3,enum=warden.service.v1.ListSortField,oneof" json:"sort_by,omitempty"`
+	SortOrder *SortOrder     `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3,enum=warden.service.v1.SortOrder,oneof" json:"sort_order,omitempty"`
+	// Return the IDs of the page in ids instead of full folders
+	IdsOnly bool `protobuf:"varint,8,opt,name=ids_only,json=idsOnly,proto3" json:"ids_only,omitempty"`
+	// Only return total, without any folders or IDs
+	CountOnly bool `protobuf:"varint,9,opt,name=count_only,json=countOnly,proto3" json:"count_only,omitempty"`
+	// List the folders in the trash instead: the deleted folders themselves,
+	// not the ones deleted along with them. parent_id is ignored.
+	Deleted       bool `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -447,10 +674,56 @@ func (x *ListFoldersRequest) GetNameFilter() string {
 	return ""
 }
 
+func (x *ListFoldersRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListFoldersRequest) GetSortBy() ListSortField {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return ListSortField_LIST_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListFoldersRequest) GetSortOrder() SortOrder {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *ListFoldersRequest) GetIdsOnly() bool {
+	if x != nil {
+		return x.IdsOnly
+	}
+	return false
+}
+
+func (x *ListFoldersRequest) GetCountOnly() bool {
+	if x != nil {
+		return x.CountOnly
+	}
+	return false
+}
+
+func (x *ListFoldersRequest) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
 type ListFoldersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Folders       []*Folder              `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Folders []*Folder              `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	Total   uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Cursor of the next page (empty on the last page)
+	NextCursor string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Folder IDs of the page, filled instead of folders with ids_only
+	Ids           []string `protobuf:"bytes,4,rep,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -499,6 +772,20 @@ func (x *ListFoldersResponse) GetTotal() uint32 {
 	return 0
 }
 
+func (x *ListFoldersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListFoldersResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
 // Request to update a folder
 type UpdateFolderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -506,7 +793,13 @@ type UpdateFolderRequest struct {
 	// New name (optional)
 	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	// New description (optional)
-	Description   *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Revision the client read; the update fails with PRECONDITION_FAILED when
+	// the folder changed since
+	ExpectedRevision *int64 `protobuf:"varint,4,opt,name=expected_revision,json=expectedRevision,proto3,oneof" json:"expected_revision,omitempty"`
+	// Require a second owner's approval for deleting the folder or anything in
+	// it (owners only; only platform admins can lift it)
+	Protected     *bool `protobuf:"varint,5,opt,name=protected,proto3,oneof" json:"protected,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -562,6 +855,20 @@ func (x *UpdateFolderRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateFolderRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+func (x *UpdateFolderRequest) GetProtected() bool {
+	if x != nil && x.Protected != nil {
+		return *x.Protected
+	}
+	return false
+}
+
 type UpdateFolderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
@@ -606,30 +913,32 @@ func (x *UpdateFolderResponse) GetFolder() *Folder {
 	return nil
 }
 
-// Request to delete a folder
-type DeleteFolderRequest struct {
+// Request to set a folder's metadata schema
+type SetFolderMetadataSchemaRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Force delete even if folder contains items
-	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// JSON Schema subset: type, required, properties, additionalProperties
+	// (boolean), enum, const, pattern, minLength, maxLength, minimum, maximum,
+	// items, minItems, maxItems. Unset removes the schema.
+	Schema        *structpb.Struct `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteFolderRequest) Reset() {
-	*x = DeleteFolderRequest{}
+func (x *SetFolderMetadataSchemaRequest) Reset() {
+	*x = SetFolderMetadataSchemaRequest{}
 	mi := &file_warden_service_v1_folder_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteFolderRequest) String() string {
+func (x *SetFolderMetadataSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFolderRequest) ProtoMessage() {}
+func (*SetFolderMetadataSchemaRequest) ProtoMessage() {}
 
-func (x *DeleteFolderRequest) ProtoReflect() protoreflect.Message {
+func (x *SetFolderMetadataSchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_warden_service_v1_folder_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -641,49 +950,46 @@ func (x *DeleteFolderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFolderRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFolderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetFolderMetadataSchemaRequest.ProtoReflect.Descriptor instead.
+func (*SetFolderMetadataSchemaRequest) Descriptor() ([]byte, []int) {
 	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteFolderRequest) GetId() string {
+func (x *SetFolderMetadataSchemaRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteFolderRequest) GetForce() bool {
+func (x *SetFolderMetadataSchemaRequest) GetSchema() *structpb.Struct {
 	if x != nil {
-		return x.Force
+		return x.Schema
 	}
-	return false
+	return nil
 }
 
-// Request to move a folder
-type MoveFolderRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// New parent folder ID (null to move to root)
-	NewParentId   *string `protobuf:"bytes,2,opt,name=new_parent_id,json=newParentId,proto3,oneof" json:"new_parent_id,omitempty"`
+type SetFolderMetadataSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveFolderRequest) Reset() {
-	*x = MoveFolderRequest{}
+func (x *SetFolderMetadataSchemaResponse) Reset() {
+	*x = SetFolderMetadataSchemaResponse{}
 	mi := &file_warden_service_v1_folder_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveFolderRequest) String() string {
+func (x *SetFolderMetadataSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveFolderRequest) ProtoMessage() {}
+func (*SetFolderMetadataSchemaResponse) ProtoMessage() {}
 
-func (x *MoveFolderRequest) ProtoReflect() protoreflect.Message {
+func (x *SetFolderMetadataSchemaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_warden_service_v1_folder_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -695,46 +1001,42 @@ func (x *MoveFolderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveFolderRequest.ProtoReflect.Descriptor instead.
-func (*MoveFolderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetFolderMetadataSchemaResponse.ProtoReflect.Descriptor instead.
+func (*SetFolderMetadataSchemaResponse) Descriptor() ([]byte, []int) {
 	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MoveFolderRequest) GetId() string {
+func (x *SetFolderMetadataSchemaResponse) GetFolder() *Folder {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *MoveFolderRequest) GetNewParentId() string {
-	if x != nil && x.NewParentId != nil {
-		return *x.NewParentId
+		return x.Folder
 	}
-	return ""
+	return nil
 }
 
-type MoveFolderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+// Request to replace a folder's default permissions
+type SetFolderDefaultPermissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Replaces the current rules; empty removes them. Existing secrets are not changed.
+	Rules         []*InitialPermissionGrant `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveFolderResponse) Reset() {
-	*x = MoveFolderResponse{}
+func (x *SetFolderDefaultPermissionsRequest) Reset() {
+	*x = SetFolderDefaultPermissionsRequest{}
 	mi := &file_warden_service_v1_folder_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveFolderResponse) String() string {
+func (x *SetFolderDefaultPermissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveFolderResponse) ProtoMessage() {}
+func (*SetFolderDefaultPermissionsRequest) ProtoMessage() {}
 
-func (x *MoveFolderResponse) ProtoReflect() protoreflect.Message {
+func (x *SetFolderDefaultPermissionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_warden_service_v1_folder_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -746,45 +1048,46 @@ func (x *MoveFolderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveFolderResponse.ProtoReflect.Descriptor instead.
-func (*MoveFolderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetFolderDefaultPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*SetFolderDefaultPermissionsRequest) Descriptor() ([]byte, []int) {
 	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *MoveFolderResponse) GetFolder() *Folder {
+func (x *SetFolderDefaultPermissionsRequest) GetId() string {
 	if x != nil {
-		return x.Folder
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetFolderDefaultPermissionsRequest) GetRules() []*InitialPermissionGrant {
+	if x != nil {
+		return x.Rules
 	}
 	return nil
 }
 
-// Request to get folder tree
-type GetFolderTreeRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Root folder ID (null for entire tree)
-	RootId *string `protobuf:"bytes,1,opt,name=root_id,json=rootId,proto3,oneof" json:"root_id,omitempty"`
-	// Maximum depth to retrieve
-	MaxDepth *int32 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3,oneof" json:"max_depth,omitempty"`
-	// Include secret counts
-	IncludeCounts bool `protobuf:"varint,3,opt,name=include_counts,json=includeCounts,proto3" json:"include_counts,omitempty"`
+type SetFolderDefaultPermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFolderTreeRequest) Reset() {
-	*x = GetFolderTreeRequest{}
+func (x *SetFolderDefaultPermissionsResponse) Reset() {
+	*x = SetFolderDefaultPermissionsResponse{}
 	mi := &file_warden_service_v1_folder_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFolderTreeRequest) String() string {
+func (x *SetFolderDefaultPermissionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFolderTreeRequest) ProtoMessage() {}
+func (*SetFolderDefaultPermissionsResponse) ProtoMessage() {}
 
-func (x *GetFolderTreeRequest) ProtoReflect() protoreflect.Message {
+func (x *SetFolderDefaultPermissionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_warden_service_v1_folder_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -796,55 +1099,41 @@ func (x *GetFolderTreeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFolderTreeRequest.ProtoReflect.Descriptor instead.
-func (*GetFolderTreeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetFolderDefaultPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*SetFolderDefaultPermissionsResponse) Descriptor() ([]byte, []int) {
 	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetFolderTreeRequest) GetRootId() string {
-	if x != nil && x.RootId != nil {
-		return *x.RootId
-	}
-	return ""
-}
-
-func (x *GetFolderTreeRequest) GetMaxDepth() int32 {
-	if x != nil && x.MaxDepth != nil {
-		return *x.MaxDepth
-	}
-	return 0
-}
-
-func (x *GetFolderTreeRequest) GetIncludeCounts() bool {
+func (x *SetFolderDefaultPermissionsResponse) GetFolder() *Folder {
 	if x != nil {
-		return x.IncludeCounts
+		return x.Folder
 	}
-	return false
+	return nil
 }
 
-// Folder tree node
-type FolderTreeNode struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
-	Children      []*FolderTreeNode      `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+type SetFolderAccessPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Replaces the current policy; unset or empty removes it
+	Policy        *AccessPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FolderTreeNode) Reset() {
-	*x = FolderTreeNode{}
+func (x *SetFolderAccessPolicyRequest) Reset() {
+	*x = SetFolderAccessPolicyRequest{}
 	mi := &file_warden_service_v1_folder_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FolderTreeNode) String() string {
+func (x *SetFolderAccessPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FolderTreeNode) ProtoMessage() {}
+func (*SetFolderAccessPolicyRequest) ProtoMessage() {}
 
-func (x *FolderTreeNode) ProtoReflect() protoreflect.Message {
+func (x *SetFolderAccessPolicyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_warden_service_v1_folder_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,9 +1145,688 @@ func (x *FolderTreeNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Use SetFolderAccessPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetFolderAccessPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetFolderAccessPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetFolderAccessPolicyRequest) GetPolicy() *AccessPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetFolderAccessPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFolderAccessPolicyResponse) Reset() {
+	*x = SetFolderAccessPolicyResponse{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFolderAccessPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFolderAccessPolicyResponse) ProtoMessage() {}
+
+func (x *SetFolderAccessPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFolderAccessPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetFolderAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetFolderAccessPolicyResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type SetFolderLegalHoldRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// true places the hold, false lifts it
+	LegalHold bool `protobuf:"varint,2,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	// Why the hold is placed or lifted, recorded in the audit log
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFolderLegalHoldRequest) Reset() {
+	*x = SetFolderLegalHoldRequest{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFolderLegalHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFolderLegalHoldRequest) ProtoMessage() {}
+
+func (x *SetFolderLegalHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFolderLegalHoldRequest.ProtoReflect.Descriptor instead.
+func (*SetFolderLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetFolderLegalHoldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetFolderLegalHoldRequest) GetLegalHold() bool {
+	if x != nil {
+		return x.LegalHold
+	}
+	return false
+}
+
+func (x *SetFolderLegalHoldRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetFolderLegalHoldResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Folder *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	// Secrets whose Vault version retention was updated
+	SecretsUpdated uint32 `protobuf:"varint,2,opt,name=secrets_updated,json=secretsUpdated,proto3" json:"secrets_updated,omitempty"`
+	// Secrets whose Vault version retention could not be updated
+	FailedSecretIds []string `protobuf:"bytes,3,rep,name=failed_secret_ids,json=failedSecretIds,proto3" json:"failed_secret_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetFolderLegalHoldResponse) Reset() {
+	*x = SetFolderLegalHoldResponse{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFolderLegalHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFolderLegalHoldResponse) ProtoMessage() {}
+
+func (x *SetFolderLegalHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFolderLegalHoldResponse.ProtoReflect.Descriptor instead.
+func (*SetFolderLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetFolderLegalHoldResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+func (x *SetFolderLegalHoldResponse) GetSecretsUpdated() uint32 {
+	if x != nil {
+		return x.SecretsUpdated
+	}
+	return 0
+}
+
+func (x *SetFolderLegalHoldResponse) GetFailedSecretIds() []string {
+	if x != nil {
+		return x.FailedSecretIds
+	}
+	return nil
+}
+
+// Request to get the usage of the secrets in a folder
+type GetFolderUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Length of the period in days, ending today (default 90)
+	Days *uint32 `protobuf:"varint,2,opt,name=days,proto3,oneof" json:"days,omitempty"`
+	// Include the secrets of all subfolders
+	IncludeSubfolders bool `protobuf:"varint,3,opt,name=include_subfolders,json=includeSubfolders,proto3" json:"include_subfolders,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetFolderUsageRequest) Reset() {
+	*x = GetFolderUsageRequest{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFolderUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolderUsageRequest) ProtoMessage() {}
+
+func (x *GetFolderUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolderUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetFolderUsageRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetFolderUsageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetFolderUsageRequest) GetDays() uint32 {
+	if x != nil && x.Days != nil {
+		return *x.Days
+	}
+	return 0
+}
+
+func (x *GetFolderUsageRequest) GetIncludeSubfolders() bool {
+	if x != nil {
+		return x.IncludeSubfolders
+	}
+	return false
+}
+
+type GetFolderUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Totals over all listed secrets
+	Reads  int64 `protobuf:"varint,1,opt,name=reads,proto3" json:"reads,omitempty"`
+	Writes int64 `protobuf:"varint,2,opt,name=writes,proto3" json:"writes,omitempty"`
+	// Secrets without any activity in the period
+	UnusedCount int32 `protobuf:"varint,3,opt,name=unused_count,json=unusedCount,proto3" json:"unused_count,omitempty"`
+	// Active and archived secrets, least used first
+	Secrets       []*SecretUsage `protobuf:"bytes,4,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFolderUsageResponse) Reset() {
+	*x = GetFolderUsageResponse{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFolderUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolderUsageResponse) ProtoMessage() {}
+
+func (x *GetFolderUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolderUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetFolderUsageResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetFolderUsageResponse) GetReads() int64 {
+	if x != nil {
+		return x.Reads
+	}
+	return 0
+}
+
+func (x *GetFolderUsageResponse) GetWrites() int64 {
+	if x != nil {
+		return x.Writes
+	}
+	return 0
+}
+
+func (x *GetFolderUsageResponse) GetUnusedCount() int32 {
+	if x != nil {
+		return x.UnusedCount
+	}
+	return 0
+}
+
+func (x *GetFolderUsageResponse) GetSecrets() []*SecretUsage {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+// Request to delete a folder
+type DeleteFolderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Force delete even if folder contains items
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// Delete permanently instead of moving to the trash
+	Permanent     bool `protobuf:"varint,3,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFolderRequest) Reset() {
+	*x = DeleteFolderRequest{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderRequest) ProtoMessage() {}
+
+func (x *DeleteFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFolderRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteFolderRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteFolderRequest) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+// Request to restore a folder from the trash
+type RestoreFolderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Restore under this name instead of the original one, e.g. when the
+	// original is taken
+	Name          *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreFolderRequest) Reset() {
+	*x = RestoreFolderRequest{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFolderRequest) ProtoMessage() {}
+
+func (x *RestoreFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFolderRequest.ProtoReflect.Descriptor instead.
+func (*RestoreFolderRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RestoreFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreFolderRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type RestoreFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreFolderResponse) Reset() {
+	*x = RestoreFolderResponse{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFolderResponse) ProtoMessage() {}
+
+func (x *RestoreFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFolderResponse.ProtoReflect.Descriptor instead.
+func (*RestoreFolderResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreFolderResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+// Request to move a folder
+type MoveFolderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New parent folder ID (null to move to root)
+	NewParentId   *string `protobuf:"bytes,2,opt,name=new_parent_id,json=newParentId,proto3,oneof" json:"new_parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveFolderRequest) Reset() {
+	*x = MoveFolderRequest{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFolderRequest) ProtoMessage() {}
+
+func (x *MoveFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFolderRequest.ProtoReflect.Descriptor instead.
+func (*MoveFolderRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MoveFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveFolderRequest) GetNewParentId() string {
+	if x != nil && x.NewParentId != nil {
+		return *x.NewParentId
+	}
+	return ""
+}
+
+type MoveFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveFolderResponse) Reset() {
+	*x = MoveFolderResponse{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFolderResponse) ProtoMessage() {}
+
+func (x *MoveFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFolderResponse.ProtoReflect.Descriptor instead.
+func (*MoveFolderResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MoveFolderResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+// Request to get folder tree
+type GetFolderTreeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Root folder ID (null for entire tree)
+	RootId *string `protobuf:"bytes,1,opt,name=root_id,json=rootId,proto3,oneof" json:"root_id,omitempty"`
+	// Maximum depth to retrieve
+	MaxDepth *int32 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3,oneof" json:"max_depth,omitempty"`
+	// Include secret counts
+	IncludeCounts bool `protobuf:"varint,3,opt,name=include_counts,json=includeCounts,proto3" json:"include_counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFolderTreeRequest) Reset() {
+	*x = GetFolderTreeRequest{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFolderTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolderTreeRequest) ProtoMessage() {}
+
+func (x *GetFolderTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolderTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetFolderTreeRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetFolderTreeRequest) GetRootId() string {
+	if x != nil && x.RootId != nil {
+		return *x.RootId
+	}
+	return ""
+}
+
+func (x *GetFolderTreeRequest) GetMaxDepth() int32 {
+	if x != nil && x.MaxDepth != nil {
+		return *x.MaxDepth
+	}
+	return 0
+}
+
+func (x *GetFolderTreeRequest) GetIncludeCounts() bool {
+	if x != nil {
+		return x.IncludeCounts
+	}
+	return false
+}
+
+// Folder tree node
+type FolderTreeNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	Children      []*FolderTreeNode      `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FolderTreeNode) Reset() {
+	*x = FolderTreeNode{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FolderTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderTreeNode) ProtoMessage() {}
+
+func (x *FolderTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
 // Deprecated: Use FolderTreeNode.ProtoReflect.Descriptor instead.
 func (*FolderTreeNode) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{13}
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *FolderTreeNode) GetFolder() *Folder {
@@ -884,7 +1852,7 @@ type GetFolderTreeResponse struct {
 
 func (x *GetFolderTreeResponse) Reset() {
 	*x = GetFolderTreeResponse{}
-	mi := &file_warden_service_v1_folder_proto_msgTypes[14]
+	mi := &file_warden_service_v1_folder_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -896,7 +1864,7 @@ func (x *GetFolderTreeResponse) String() string {
 func (*GetFolderTreeResponse) ProtoMessage() {}
 
 func (x *GetFolderTreeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_folder_proto_msgTypes[14]
+	mi := &file_warden_service_v1_folder_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1877,7 @@ func (x *GetFolderTreeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFolderTreeResponse.ProtoReflect.Descriptor instead.
 func (*GetFolderTreeResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{14}
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetFolderTreeResponse) GetRoots() []*FolderTreeNode {
@@ -919,11 +1887,287 @@ func (x *GetFolderTreeResponse) GetRoots() []*FolderTreeNode {
 	return nil
 }
 
+// A change to the folder structure
+type FolderChange struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FolderId string                 `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Action   FolderChangeAction     `protobuf:"varint,3,opt,name=action,proto3,enum=warden.service.v1.FolderChangeAction" json:"action,omitempty"`
+	// Path before the change (unset for creations)
+	OldPath string `protobuf:"bytes,4,opt,name=old_path,json=oldPath,proto3" json:"old_path,omitempty"`
+	// Path after the change (unset for deletions)
+	NewPath     string  `protobuf:"bytes,5,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	OldParentId *string `protobuf:"bytes,6,opt,name=old_parent_id,json=oldParentId,proto3,oneof" json:"old_parent_id,omitempty"`
+	NewParentId *string `protobuf:"bytes,7,opt,name=new_parent_id,json=newParentId,proto3,oneof" json:"new_parent_id,omitempty"`
+	// User who made the change
+	ActorId string `protobuf:"bytes,8,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Extra details, e.g. "force" for deletions
+	Details       map[string]string      `protobuf:"bytes,9,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FolderChange) Reset() {
+	*x = FolderChange{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FolderChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderChange) ProtoMessage() {}
+
+func (x *FolderChange) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderChange.ProtoReflect.Descriptor instead.
+func (*FolderChange) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FolderChange) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FolderChange) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *FolderChange) GetAction() FolderChangeAction {
+	if x != nil {
+		return x.Action
+	}
+	return FolderChangeAction_FOLDER_CHANGE_ACTION_UNSPECIFIED
+}
+
+func (x *FolderChange) GetOldPath() string {
+	if x != nil {
+		return x.OldPath
+	}
+	return ""
+}
+
+func (x *FolderChange) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+func (x *FolderChange) GetOldParentId() string {
+	if x != nil && x.OldParentId != nil {
+		return *x.OldParentId
+	}
+	return ""
+}
+
+func (x *FolderChange) GetNewParentId() string {
+	if x != nil && x.NewParentId != nil {
+		return *x.NewParentId
+	}
+	return ""
+}
+
+func (x *FolderChange) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *FolderChange) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *FolderChange) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListFolderHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Changes of one folder. Without it, or for a deleted folder, the call is
+	// restricted to platform admins.
+	FolderId *string `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	// Also changes of the folders below it (by path, before or after the change)
+	IncludeSubfolders bool                   `protobuf:"varint,2,opt,name=include_subfolders,json=includeSubfolders,proto3" json:"include_subfolders,omitempty"`
+	ActorId           *string                `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	Action            *FolderChangeAction    `protobuf:"varint,4,opt,name=action,proto3,enum=warden.service.v1.FolderChangeAction,oneof" json:"action,omitempty"`
+	StartTime         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	Page              *uint32                `protobuf:"varint,7,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize          *uint32                `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListFolderHistoryRequest) Reset() {
+	*x = ListFolderHistoryRequest{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFolderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFolderHistoryRequest) ProtoMessage() {}
+
+func (x *ListFolderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFolderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListFolderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListFolderHistoryRequest) GetFolderId() string {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return ""
+}
+
+func (x *ListFolderHistoryRequest) GetIncludeSubfolders() bool {
+	if x != nil {
+		return x.IncludeSubfolders
+	}
+	return false
+}
+
+func (x *ListFolderHistoryRequest) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+func (x *ListFolderHistoryRequest) GetAction() FolderChangeAction {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return FolderChangeAction_FOLDER_CHANGE_ACTION_UNSPECIFIED
+}
+
+func (x *ListFolderHistoryRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListFolderHistoryRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListFolderHistoryRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListFolderHistoryRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListFolderHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*FolderChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFolderHistoryResponse) Reset() {
+	*x = ListFolderHistoryResponse{}
+	mi := &file_warden_service_v1_folder_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFolderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFolderHistoryResponse) ProtoMessage() {}
+
+func (x *ListFolderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_folder_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFolderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListFolderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_folder_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListFolderHistoryResponse) GetChanges() []*FolderChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListFolderHistoryResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 var File_warden_service_v1_folder_proto protoreflect.FileDescriptor
 
 const file_warden_service_v1_folder_proto_rawDesc = "" +
 	"\n" +
-	"\x1ewarden/service/v1/folder.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ewarden/service/v1/secret.proto\"\xbe\x03\n" +
+	"\x1ewarden/service/v1/folder.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ewarden/service/v1/secret.proto\"\xc0\b\n" +
 	"\x06Folder\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12 \n" +
@@ -940,10 +2184,24 @@ const file_warden_service_v1_folder_proto_rawDesc = "" +
 	"\vupdate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"updateTime\x12\"\n" +
 	"\n" +
-	"created_by\x18\f \x01(\rH\x01R\tcreatedBy\x88\x01\x01B\f\n" +
+	"created_by\x18\f \x01(\rH\x01R\tcreatedBy\x88\x01\x01\x12M\n" +
+	"\x12last_accessed_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x10lastAccessedTime\x88\x01\x01\x12\x1a\n" +
+	"\brevision\x18\x0e \x01(\x03R\brevision\x12@\n" +
+	"\x0fmetadata_schema\x18\x0f \x01(\v2\x17.google.protobuf.StructR\x0emetadataSchema\x12Z\n" +
+	"\x13default_permissions\x18\x10 \x03(\v2).warden.service.v1.InitialPermissionGrantR\x12defaultPermissions\x12D\n" +
+	"\raccess_policy\x18\x11 \x01(\v2\x1f.warden.service.v1.AccessPolicyR\faccessPolicy\x12\x1c\n" +
+	"\tprotected\x18\x12 \x01(\bR\tprotected\x12\x1d\n" +
+	"\n" +
+	"legal_hold\x18\x13 \x01(\bR\tlegalHold\x12*\n" +
+	"\x11legal_hold_reason\x18\x14 \x01(\tR\x0flegalHoldReason\x127\n" +
+	"\x06status\x18\x15 \x01(\x0e2\x1f.warden.service.v1.FolderStatusR\x06status\x12B\n" +
+	"\fdeleted_time\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\vdeletedTime\x88\x01\x01\x12#\n" +
+	"\roriginal_path\x18\x17 \x01(\tR\foriginalPathB\f\n" +
 	"\n" +
 	"_parent_idB\r\n" +
-	"\v_created_by\"\xaf\x02\n" +
+	"\v_created_byB\x15\n" +
+	"\x13_last_accessed_timeB\x0f\n" +
+	"\r_deleted_time\"\xaf\x02\n" +
 	"\x13CreateFolderRequest\x12=\n" +
 	"\tparent_id\x18\x01 \x01(\tB\x1b\xbaH\x18r\x16\x10\x00\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\bparentId\x88\x01\x01\x12C\n" +
 	"\x04name\x18\x02 \x01(\tB/\xe0A\x02\xbaH)r'\x10\x01\x18\xff\x012 ^[a-zA-Z0-9][a-zA-Z0-9\\-_\\.\\s]*$R\x04name\x12*\n" +
@@ -957,33 +2215,96 @@ const file_warden_service_v1_folder_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12%\n" +
 	"\x0einclude_counts\x18\x02 \x01(\bR\rincludeCounts\"F\n" +
 	"\x11GetFolderResponse\x121\n" +
-	"\x06folder\x18\x01 \x01(\v2\x19.warden.service.v1.FolderR\x06folder\"\xe7\x01\n" +
+	"\x06folder\x18\x01 \x01(\v2\x19.warden.service.v1.FolderR\x06folder\"\x8a\x04\n" +
 	"\x12ListFoldersRequest\x12;\n" +
 	"\tparent_id\x18\x01 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\bparentId\x88\x01\x01\x12\x17\n" +
 	"\x04page\x18\x02 \x01(\rH\x01R\x04page\x88\x01\x01\x12 \n" +
 	"\tpage_size\x18\x03 \x01(\rH\x02R\bpageSize\x88\x01\x01\x12$\n" +
 	"\vname_filter\x18\x04 \x01(\tH\x03R\n" +
-	"nameFilter\x88\x01\x01B\f\n" +
+	"nameFilter\x88\x01\x01\x12%\n" +
+	"\x06cursor\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04H\x04R\x06cursor\x88\x01\x01\x12>\n" +
+	"\asort_by\x18\x06 \x01(\x0e2 .warden.service.v1.ListSortFieldH\x05R\x06sortBy\x88\x01\x01\x12@\n" +
+	"\n" +
+	"sort_order\x18\a \x01(\x0e2\x1c.warden.service.v1.SortOrderH\x06R\tsortOrder\x88\x01\x01\x12\x19\n" +
+	"\bids_only\x18\b \x01(\bR\aidsOnly\x12\x1d\n" +
+	"\n" +
+	"count_only\x18\t \x01(\bR\tcountOnly\x12\x18\n" +
+	"\adeleted\x18\n" +
+	" \x01(\bR\adeletedB\f\n" +
 	"\n" +
 	"_parent_idB\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
 	"_page_sizeB\x0e\n" +
-	"\f_name_filter\"`\n" +
+	"\f_name_filterB\t\n" +
+	"\a_cursorB\n" +
+	"\n" +
+	"\b_sort_byB\r\n" +
+	"\v_sort_order\"\x93\x01\n" +
 	"\x13ListFoldersResponse\x123\n" +
 	"\afolders\x18\x01 \x03(\v2\x19.warden.service.v1.FolderR\afolders\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\xd6\x01\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x10\n" +
+	"\x03ids\x18\x04 \x03(\tR\x03ids\"\xcf\x02\n" +
 	"\x13UpdateFolderRequest\x12.\n" +
 	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12E\n" +
 	"\x04name\x18\x02 \x01(\tB,\xbaH)r'\x10\x01\x18\xff\x012 ^[a-zA-Z0-9][a-zA-Z0-9\\-_\\.\\s]*$H\x00R\x04name\x88\x01\x01\x12/\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x01R\vdescription\x88\x01\x01\x120\n" +
+	"\x11expected_revision\x18\x04 \x01(\x03H\x02R\x10expectedRevision\x88\x01\x01\x12!\n" +
+	"\tprotected\x18\x05 \x01(\bH\x03R\tprotected\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
-	"\f_description\"I\n" +
+	"\f_descriptionB\x14\n" +
+	"\x12_expected_revisionB\f\n" +
+	"\n" +
+	"_protected\"I\n" +
 	"\x14UpdateFolderResponse\x121\n" +
-	"\x06folder\x18\x01 \x01(\v2\x19.warden.service.v1.FolderR\x06folder\"[\n" +
+	"\x06folder\x18\x01 \x01(\v2\x19.warden.service.v1.FolderR\x06folder\"\x81\x01\n" +
+	"\x1eSetFolderMetadataSchemaRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12/\n" +
+	"\x06schema\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06schema\"T\n" +
+	"\x1fSetFolderMetadataSchemaResponse\x121\n" +
+	"\x06folder\x18\x01 \x01(\v2\x19.warden.service.v1.FolderR\x06folder\"\x9f\x01\n" +
+	"\"SetFolderDefaultPermissionsRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12I\n" +
+	"\x05rules\x18\x02 \x03(\v2).warden.service.v1.InitialPermissionGrantB\b\xbaH\x05\x92\x01\x02\x102R\x05rules\"X\n" +
+	"#SetFolderDefaultPermissionsResponse\x121\n" +
+	"\x06folder\x18\x01 \x01(\v2\x19.warden.service.v1.FolderR\x06folder\"\x87\x01\n" +
+	"\x1cSetFolderAccessPolicyRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x127\n" +
+	"\x06policy\x18\x02 \x01(\v2\x1f.warden.service.v1.AccessPolicyR\x06policy\"R\n" +
+	"\x1dSetFolderAccessPolicyResponse\x121\n" +
+	"\x06folder\x18\x01 \x01(\v2\x19.warden.service.v1.FolderR\x06folder\"\x8c\x01\n" +
+	"\x19SetFolderLegalHoldRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12\x1d\n" +
+	"\n" +
+	"legal_hold\x18\x02 \x01(\bR\tlegalHold\x12 \n" +
+	"\x06reason\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\x06reason\"\xa4\x01\n" +
+	"\x1aSetFolderLegalHoldResponse\x121\n" +
+	"\x06folder\x18\x01 \x01(\v2\x19.warden.service.v1.FolderR\x06folder\x12'\n" +
+	"\x0fsecrets_updated\x18\x02 \x01(\rR\x0esecretsUpdated\x12*\n" +
+	"\x11failed_secret_ids\x18\x03 \x03(\tR\x0ffailedSecretIds\"\xa4\x01\n" +
+	"\x15GetFolderUsageRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12#\n" +
+	"\x04days\x18\x02 \x01(\rB\n" +
+	"\xbaH\a*\x05\x18\xed\x02(\x01H\x00R\x04days\x88\x01\x01\x12-\n" +
+	"\x12include_subfolders\x18\x03 \x01(\bR\x11includeSubfoldersB\a\n" +
+	"\x05_days\"\xa3\x01\n" +
+	"\x16GetFolderUsageResponse\x12\x14\n" +
+	"\x05reads\x18\x01 \x01(\x03R\x05reads\x12\x16\n" +
+	"\x06writes\x18\x02 \x01(\x03R\x06writes\x12!\n" +
+	"\funused_count\x18\x03 \x01(\x05R\vunusedCount\x128\n" +
+	"\asecrets\x18\x04 \x03(\v2\x1e.warden.service.v1.SecretUsageR\asecrets\"y\n" +
 	"\x13DeleteFolderRequest\x12.\n" +
 	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12\x14\n" +
-	"\x05force\x18\x02 \x01(\bR\x05force\"\x99\x01\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x12\x1c\n" +
+	"\tpermanent\x18\x03 \x01(\bR\tpermanent\"\x96\x01\n" +
+	"\x14RestoreFolderRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12E\n" +
+	"\x04name\x18\x02 \x01(\tB,\xbaH)r'\x10\x01\x18\xff\x012 ^[a-zA-Z0-9][a-zA-Z0-9\\-_\\.\\s]*$H\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"J\n" +
+	"\x15RestoreFolderResponse\x121\n" +
+	"\x06folder\x18\x01 \x01(\v2\x19.warden.service.v1.FolderR\x06folder\"\x99\x01\n" +
 	"\x11MoveFolderRequest\x12.\n" +
 	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12B\n" +
 	"\rnew_parent_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\vnewParentId\x88\x01\x01B\x10\n" +
@@ -1002,16 +2323,75 @@ const file_warden_service_v1_folder_proto_rawDesc = "" +
 	"\x06folder\x18\x01 \x01(\v2\x19.warden.service.v1.FolderR\x06folder\x12=\n" +
 	"\bchildren\x18\x02 \x03(\v2!.warden.service.v1.FolderTreeNodeR\bchildren\"P\n" +
 	"\x15GetFolderTreeResponse\x127\n" +
-	"\x05roots\x18\x01 \x03(\v2!.warden.service.v1.FolderTreeNodeR\x05roots2\xd6\x06\n" +
+	"\x05roots\x18\x01 \x03(\v2!.warden.service.v1.FolderTreeNodeR\x05roots\"\x82\x04\n" +
+	"\fFolderChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tfolder_id\x18\x02 \x01(\tR\bfolderId\x12=\n" +
+	"\x06action\x18\x03 \x01(\x0e2%.warden.service.v1.FolderChangeActionR\x06action\x12\x19\n" +
+	"\bold_path\x18\x04 \x01(\tR\aoldPath\x12\x19\n" +
+	"\bnew_path\x18\x05 \x01(\tR\anewPath\x12'\n" +
+	"\rold_parent_id\x18\x06 \x01(\tH\x00R\voldParentId\x88\x01\x01\x12'\n" +
+	"\rnew_parent_id\x18\a \x01(\tH\x01R\vnewParentId\x88\x01\x01\x12\x19\n" +
+	"\bactor_id\x18\b \x01(\tR\aactorId\x12F\n" +
+	"\adetails\x18\t \x03(\v2,.warden.service.v1.FolderChange.DetailsEntryR\adetails\x12;\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x10\n" +
+	"\x0e_old_parent_idB\x10\n" +
+	"\x0e_new_parent_id\"\x84\x04\n" +
+	"\x18ListFolderHistoryRequest\x12;\n" +
+	"\tfolder_id\x18\x01 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\bfolderId\x88\x01\x01\x12-\n" +
+	"\x12include_subfolders\x18\x02 \x01(\bR\x11includeSubfolders\x12\x1e\n" +
+	"\bactor_id\x18\x03 \x01(\tH\x01R\aactorId\x88\x01\x01\x12B\n" +
+	"\x06action\x18\x04 \x01(\x0e2%.warden.service.v1.FolderChangeActionH\x02R\x06action\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\aendTime\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\a \x01(\rH\x05R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\b \x01(\rB\b\xbaH\x05*\x03\x18\xf4\x03H\x06R\bpageSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_folder_idB\v\n" +
+	"\t_actor_idB\t\n" +
+	"\a_actionB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"l\n" +
+	"\x19ListFolderHistoryResponse\x129\n" +
+	"\achanges\x18\x01 \x03(\v2\x1f.warden.service.v1.FolderChangeR\achanges\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total*b\n" +
+	"\fFolderStatus\x12\x1d\n" +
+	"\x19FOLDER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14FOLDER_STATUS_ACTIVE\x10\x01\x12\x19\n" +
+	"\x15FOLDER_STATUS_DELETED\x10\x02*\x85\x02\n" +
+	"\x12FolderChangeAction\x12$\n" +
+	" FOLDER_CHANGE_ACTION_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cFOLDER_CHANGE_ACTION_CREATED\x10\x01\x12 \n" +
+	"\x1cFOLDER_CHANGE_ACTION_RENAMED\x10\x02\x12\x1e\n" +
+	"\x1aFOLDER_CHANGE_ACTION_MOVED\x10\x03\x12 \n" +
+	"\x1cFOLDER_CHANGE_ACTION_DELETED\x10\x04\x12 \n" +
+	"\x1cFOLDER_CHANGE_ACTION_TRASHED\x10\x05\x12!\n" +
+	"\x1dFOLDER_CHANGE_ACTION_RESTORED\x10\x062\xaa\x0f\n" +
 	"\x13WardenFolderService\x12w\n" +
 	"\fCreateFolder\x12&.warden.service.v1.CreateFolderRequest\x1a'.warden.service.v1.CreateFolderResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/folders\x12p\n" +
 	"\tGetFolder\x12#.warden.service.v1.GetFolderRequest\x1a$.warden.service.v1.GetFolderResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/folders/{id}\x12q\n" +
 	"\vListFolders\x12%.warden.service.v1.ListFoldersRequest\x1a&.warden.service.v1.ListFoldersResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/folders\x12|\n" +
 	"\fUpdateFolder\x12&.warden.service.v1.UpdateFolderRequest\x1a'.warden.service.v1.UpdateFolderResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/folders/{id}\x12h\n" +
-	"\fDeleteFolder\x12&.warden.service.v1.DeleteFolderRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/folders/{id}\x12{\n" +
+	"\fDeleteFolder\x12&.warden.service.v1.DeleteFolderRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/folders/{id}\x12\x87\x01\n" +
+	"\rRestoreFolder\x12'.warden.service.v1.RestoreFolderRequest\x1a(.warden.service.v1.RestoreFolderResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/folders/{id}/restore\x12{\n" +
 	"\n" +
-	"MoveFolder\x12$.warden.service.v1.MoveFolderRequest\x1a%.warden.service.v1.MoveFolderResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/folders/{id}/move\x12|\n" +
-	"\rGetFolderTree\x12'.warden.service.v1.GetFolderTreeRequest\x1a(.warden.service.v1.GetFolderTreeResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/folders/treeB\xd3\x01\n" +
+	"MoveFolder\x12$.warden.service.v1.MoveFolderRequest\x1a%.warden.service.v1.MoveFolderResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/folders/{id}/move\x12\xad\x01\n" +
+	"\x17SetFolderMetadataSchema\x121.warden.service.v1.SetFolderMetadataSchemaRequest\x1a2.warden.service.v1.SetFolderMetadataSchemaResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /v1/folders/{id}/metadata-schema\x12\xbd\x01\n" +
+	"\x1bSetFolderDefaultPermissions\x125.warden.service.v1.SetFolderDefaultPermissionsRequest\x1a6.warden.service.v1.SetFolderDefaultPermissionsResponse\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/v1/folders/{id}/default-permissions\x12\xa5\x01\n" +
+	"\x15SetFolderAccessPolicy\x12/.warden.service.v1.SetFolderAccessPolicyRequest\x1a0.warden.service.v1.SetFolderAccessPolicyResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/v1/folders/{id}/access-policy\x12\x99\x01\n" +
+	"\x12SetFolderLegalHold\x12,.warden.service.v1.SetFolderLegalHoldRequest\x1a-.warden.service.v1.SetFolderLegalHoldResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/v1/folders/{id}/legal-hold\x12\x85\x01\n" +
+	"\x0eGetFolderUsage\x12(.warden.service.v1.GetFolderUsageRequest\x1a).warden.service.v1.GetFolderUsageResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/folders/{id}/usage\x12|\n" +
+	"\rGetFolderTree\x12'.warden.service.v1.GetFolderTreeRequest\x1a(.warden.service.v1.GetFolderTreeResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/folders/tree\x12\x8b\x01\n" +
+	"\x11ListFolderHistory\x12+.warden.service.v1.ListFolderHistoryRequest\x1a,.warden.service.v1.ListFolderHistoryResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/folders/historyB\xd3\x01\n" +
 	"\x15com.warden.service.v1B\vFolderProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
 
 var (
@@ -1026,58 +2406,120 @@ func file_warden_service_v1_folder_proto_rawDescGZIP() []byte {
 	return file_warden_service_v1_folder_proto_rawDescData
 }
 
-var file_warden_service_v1_folder_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_warden_service_v1_folder_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_warden_service_v1_folder_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_warden_service_v1_folder_proto_goTypes = []any{
-	(*Folder)(nil),                 // 0: warden.service.v1.Folder
-	(*CreateFolderRequest)(nil),    // 1: warden.service.v1.CreateFolderRequest
-	(*CreateFolderResponse)(nil),   // 2: warden.service.v1.CreateFolderResponse
-	(*GetFolderRequest)(nil),       // 3: warden.service.v1.GetFolderRequest
-	(*GetFolderResponse)(nil),      // 4: warden.service.v1.GetFolderResponse
-	(*ListFoldersRequest)(nil),     // 5: warden.service.v1.ListFoldersRequest
-	(*ListFoldersResponse)(nil),    // 6: warden.service.v1.ListFoldersResponse
-	(*UpdateFolderRequest)(nil),    // 7: warden.service.v1.UpdateFolderRequest
-	(*UpdateFolderResponse)(nil),   // 8: warden.service.v1.UpdateFolderResponse
-	(*DeleteFolderRequest)(nil),    // 9: warden.service.v1.DeleteFolderRequest
-	(*MoveFolderRequest)(nil),      // 10: warden.service.v1.MoveFolderRequest
-	(*MoveFolderResponse)(nil),     // 11: warden.service.v1.MoveFolderResponse
-	(*GetFolderTreeRequest)(nil),   // 12: warden.service.v1.GetFolderTreeRequest
-	(*FolderTreeNode)(nil),         // 13: warden.service.v1.FolderTreeNode
-	(*GetFolderTreeResponse)(nil),  // 14: warden.service.v1.GetFolderTreeResponse
-	(*timestamppb.Timestamp)(nil),  // 15: google.protobuf.Timestamp
-	(*InitialPermissionGrant)(nil), // 16: warden.service.v1.InitialPermissionGrant
-	(*emptypb.Empty)(nil),          // 17: google.protobuf.Empty
+	(FolderStatus)(0),                           // 0: warden.service.v1.FolderStatus
+	(FolderChangeAction)(0),                     // 1: warden.service.v1.FolderChangeAction
+	(*Folder)(nil),                              // 2: warden.service.v1.Folder
+	(*CreateFolderRequest)(nil),                 // 3: warden.service.v1.CreateFolderRequest
+	(*CreateFolderResponse)(nil),                // 4: warden.service.v1.CreateFolderResponse
+	(*GetFolderRequest)(nil),                    // 5: warden.service.v1.GetFolderRequest
+	(*GetFolderResponse)(nil),                   // 6: warden.service.v1.GetFolderResponse
+	(*ListFoldersRequest)(nil),                  // 7: warden.service.v1.ListFoldersRequest
+	(*ListFoldersResponse)(nil),                 // 8: warden.service.v1.ListFoldersResponse
+	(*UpdateFolderRequest)(nil),                 // 9: warden.service.v1.UpdateFolderRequest
+	(*UpdateFolderResponse)(nil),                // 10: warden.service.v1.UpdateFolderResponse
+	(*SetFolderMetadataSchemaRequest)(nil),      // 11: warden.service.v1.SetFolderMetadataSchemaRequest
+	(*SetFolderMetadataSchemaResponse)(nil),     // 12: warden.service.v1.SetFolderMetadataSchemaResponse
+	(*SetFolderDefaultPermissionsRequest)(nil),  // 13: warden.service.v1.SetFolderDefaultPermissionsRequest
+	(*SetFolderDefaultPermissionsResponse)(nil), // 14: warden.service.v1.SetFolderDefaultPermissionsResponse
+	(*SetFolderAccessPolicyRequest)(nil),        // 15: warden.service.v1.SetFolderAccessPolicyRequest
+	(*SetFolderAccessPolicyResponse)(nil),       // 16: warden.service.v1.SetFolderAccessPolicyResponse
+	(*SetFolderLegalHoldRequest)(nil),           // 17: warden.service.v1.SetFolderLegalHoldRequest
+	(*SetFolderLegalHoldResponse)(nil),          // 18: warden.service.v1.SetFolderLegalHoldResponse
+	(*GetFolderUsageRequest)(nil),               // 19: warden.service.v1.GetFolderUsageRequest
+	(*GetFolderUsageResponse)(nil),              // 20: warden.service.v1.GetFolderUsageResponse
+	(*DeleteFolderRequest)(nil),                 // 21: warden.service.v1.DeleteFolderRequest
+	(*RestoreFolderRequest)(nil),                // 22: warden.service.v1.RestoreFolderRequest
+	(*RestoreFolderResponse)(nil),               // 23: warden.service.v1.RestoreFolderResponse
+	(*MoveFolderRequest)(nil),                   // 24: warden.service.v1.MoveFolderRequest
+	(*MoveFolderResponse)(nil),                  // 25: warden.service.v1.MoveFolderResponse
+	(*GetFolderTreeRequest)(nil),                // 26: warden.service.v1.GetFolderTreeRequest
+	(*FolderTreeNode)(nil),                      // 27: warden.service.v1.FolderTreeNode
+	(*GetFolderTreeResponse)(nil),               // 28: warden.service.v1.GetFolderTreeResponse
+	(*FolderChange)(nil),                        // 29: warden.service.v1.FolderChange
+	(*ListFolderHistoryRequest)(nil),            // 30: warden.service.v1.ListFolderHistoryRequest
+	(*ListFolderHistoryResponse)(nil),           // 31: warden.service.v1.ListFolderHistoryResponse
+	nil,                                         // 32: warden.service.v1.FolderChange.DetailsEntry
+	(*timestamppb.Timestamp)(nil),               // 33: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                     // 34: google.protobuf.Struct
+	(*InitialPermissionGrant)(nil),              // 35: warden.service.v1.InitialPermissionGrant
+	(*AccessPolicy)(nil),                        // 36: warden.service.v1.AccessPolicy
+	(ListSortField)(0),                          // 37: warden.service.v1.ListSortField
+	(SortOrder)(0),                              // 38: warden.service.v1.SortOrder
+	(*SecretUsage)(nil),                         // 39: warden.service.v1.SecretUsage
+	(*emptypb.Empty)(nil),                       // 40: google.protobuf.Empty
 }
 var file_warden_service_v1_folder_proto_depIdxs = []int32{
-	15, // 0: warden.service.v1.Folder.create_time:type_name -> google.protobuf.Timestamp
-	15, // 1: warden.service.v1.Folder.update_time:type_name -> google.protobuf.Timestamp
-	16, // 2: warden.service.v1.CreateFolderRequest.initial_permissions:type_name -> warden.service.v1.InitialPermissionGrant
-	0,  // 3: warden.service.v1.CreateFolderResponse.folder:type_name -> warden.service.v1.Folder
-	0,  // 4: warden.service.v1.GetFolderResponse.folder:type_name -> warden.service.v1.Folder
-	0,  // 5: warden.service.v1.ListFoldersResponse.folders:type_name -> warden.service.v1.Folder
-	0,  // 6: warden.service.v1.UpdateFolderResponse.folder:type_name -> warden.service.v1.Folder
-	0,  // 7: warden.service.v1.MoveFolderResponse.folder:type_name -> warden.service.v1.Folder
-	0,  // 8: warden.service.v1.FolderTreeNode.folder:type_name -> warden.service.v1.Folder
-	13, // 9: warden.service.v1.FolderTreeNode.children:type_name -> warden.service.v1.FolderTreeNode
-	13, // 10: warden.service.v1.GetFolderTreeResponse.roots:type_name -> warden.service.v1.FolderTreeNode
-	1,  // 11: warden.service.v1.WardenFolderService.CreateFolder:input_type -> warden.service.v1.CreateFolderRequest
-	3,  // 12: warden.service.v1.WardenFolderService.GetFolder:input_type -> warden.service.v1.GetFolderRequest
-	5,  // 13: warden.service.v1.WardenFolderService.ListFolders:input_type -> warden.service.v1.ListFoldersRequest
-	7,  // 14: warden.service.v1.WardenFolderService.UpdateFolder:input_type -> warden.service.v1.UpdateFolderRequest
-	9,  // 15: warden.service.v1.WardenFolderService.DeleteFolder:input_type -> warden.service.v1.DeleteFolderRequest
-	10, // 16: warden.service.v1.WardenFolderService.MoveFolder:input_type -> warden.service.v1.MoveFolderRequest
-	12, // 17: warden.service.v1.WardenFolderService.GetFolderTree:input_type -> warden.service.v1.GetFolderTreeRequest
-	2,  // 18: warden.service.v1.WardenFolderService.CreateFolder:output_type -> warden.service.v1.CreateFolderResponse
-	4,  // 19: warden.service.v1.WardenFolderService.GetFolder:output_type -> warden.service.v1.GetFolderResponse
-	6,  // 20: warden.service.v1.WardenFolderService.ListFolders:output_type -> warden.service.v1.ListFoldersResponse
-	8,  // 21: warden.service.v1.WardenFolderService.UpdateFolder:output_type -> warden.service.v1.UpdateFolderResponse
-	17, // 22: warden.service.v1.WardenFolderService.DeleteFolder:output_type -> google.protobuf.Empty
-	11, // 23: warden.service.v1.WardenFolderService.MoveFolder:output_type -> warden.service.v1.MoveFolderResponse
-	14, // 24: warden.service.v1.WardenFolderService.GetFolderTree:output_type -> warden.service.v1.GetFolderTreeResponse
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	33, // 0: warden.service.v1.Folder.create_time:type_name -> google.protobuf.Timestamp
+	33, // 1: warden.service.v1.Folder.update_time:type_name -> google.protobuf.Timestamp
+	33, // 2: warden.service.v1.Folder.last_accessed_time:type_name -> google.protobuf.Timestamp
+	34, // 3: warden.service.v1.Folder.metadata_schema:type_name -> google.protobuf.Struct
+	35, // 4: warden.service.v1.Folder.default_permissions:type_name -> warden.service.v1.InitialPermissionGrant
+	36, // 5: warden.service.v1.Folder.access_policy:type_name -> warden.service.v1.AccessPolicy
+	0,  // 6: warden.service.v1.Folder.status:type_name -> warden.service.v1.FolderStatus
+	33, // 7: warden.service.v1.Folder.deleted_time:type_name -> google.protobuf.Timestamp
+	35, // 8: warden.service.v1.CreateFolderRequest.initial_permissions:type_name -> warden.service.v1.InitialPermissionGrant
+	2,  // 9: warden.service.v1.CreateFolderResponse.folder:type_name -> warden.service.v1.Folder
+	2,  // 10: warden.service.v1.GetFolderResponse.folder:type_name -> warden.service.v1.Folder
+	37, // 11: warden.service.v1.ListFoldersRequest.sort_by:type_name -> warden.service.v1.ListSortField
+	38, // 12: warden.service.v1.ListFoldersRequest.sort_order:type_name -> warden.service.v1.SortOrder
+	2,  // 13: warden.service.v1.ListFoldersResponse.folders:type_name -> warden.service.v1.Folder
+	2,  // 14: warden.service.v1.UpdateFolderResponse.folder:type_name -> warden.service.v1.Folder
+	34, // 15: warden.service.v1.SetFolderMetadataSchemaRequest.schema:type_name -> google.protobuf.Struct
+	2,  // 16: warden.service.v1.SetFolderMetadataSchemaResponse.folder:type_name -> warden.service.v1.Folder
+	35, // 17: warden.service.v1.SetFolderDefaultPermissionsRequest.rules:type_name -> warden.service.v1.InitialPermissionGrant
+	2,  // 18: warden.service.v1.SetFolderDefaultPermissionsResponse.folder:type_name -> warden.service.v1.Folder
+	36, // 19: warden.service.v1.SetFolderAccessPolicyRequest.policy:type_name -> warden.service.v1.AccessPolicy
+	2,  // 20: warden.service.v1.SetFolderAccessPolicyResponse.folder:type_name -> warden.service.v1.Folder
+	2,  // 21: warden.service.v1.SetFolderLegalHoldResponse.folder:type_name -> warden.service.v1.Folder
+	39, // 22: warden.service.v1.GetFolderUsageResponse.secrets:type_name -> warden.service.v1.SecretUsage
+	2,  // 23: warden.service.v1.RestoreFolderResponse.folder:type_name -> warden.service.v1.Folder
+	2,  // 24: warden.service.v1.MoveFolderResponse.folder:type_name -> warden.service.v1.Folder
+	2,  // 25: warden.service.v1.FolderTreeNode.folder:type_name -> warden.service.v1.Folder
+	27, // 26: warden.service.v1.FolderTreeNode.children:type_name -> warden.service.v1.FolderTreeNode
+	27, // 27: warden.service.v1.GetFolderTreeResponse.roots:type_name -> warden.service.v1.FolderTreeNode
+	1,  // 28: warden.service.v1.FolderChange.action:type_name -> warden.service.v1.FolderChangeAction
+	32, // 29: warden.service.v1.FolderChange.details:type_name -> warden.service.v1.FolderChange.DetailsEntry
+	33, // 30: warden.service.v1.FolderChange.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 31: warden.service.v1.ListFolderHistoryRequest.action:type_name -> warden.service.v1.FolderChangeAction
+	33, // 32: warden.service.v1.ListFolderHistoryRequest.start_time:type_name -> google.protobuf.Timestamp
+	33, // 33: warden.service.v1.ListFolderHistoryRequest.end_time:type_name -> google.protobuf.Timestamp
+	29, // 34: warden.service.v1.ListFolderHistoryResponse.changes:type_name -> warden.service.v1.FolderChange
+	3,  // 35: warden.service.v1.WardenFolderService.CreateFolder:input_type -> warden.service.v1.CreateFolderRequest
+	5,  // 36: warden.service.v1.WardenFolderService.GetFolder:input_type -> warden.service.v1.GetFolderRequest
+	7,  // 37: warden.service.v1.WardenFolderService.ListFolders:input_type -> warden.service.v1.ListFoldersRequest
+	9,  // 38: warden.service.v1.WardenFolderService.UpdateFolder:input_type -> warden.service.v1.UpdateFolderRequest
+	21, // 39: warden.service.v1.WardenFolderService.DeleteFolder:input_type -> warden.service.v1.DeleteFolderRequest
+	22, // 40: warden.service.v1.WardenFolderService.RestoreFolder:input_type -> warden.service.v1.RestoreFolderRequest
+	24, // 41: warden.service.v1.WardenFolderService.MoveFolder:input_type -> warden.service.v1.MoveFolderRequest
+	11, // 42: warden.service.v1.WardenFolderService.SetFolderMetadataSchema:input_type -> warden.service.v1.SetFolderMetadataSchemaRequest
+	13, // 43: warden.service.v1.WardenFolderService.SetFolderDefaultPermissions:input_type -> warden.service.v1.SetFolderDefaultPermissionsRequest
+	15, // 44: warden.service.v1.WardenFolderService.SetFolderAccessPolicy:input_type -> warden.service.v1.SetFolderAccessPolicyRequest
+	17, // 45: warden.service.v1.WardenFolderService.SetFolderLegalHold:input_type -> warden.service.v1.SetFolderLegalHoldRequest
+	19, // 46: warden.service.v1.WardenFolderService.GetFolderUsage:input_type -> warden.service.v1.GetFolderUsageRequest
+	26, // 47: warden.service.v1.WardenFolderService.GetFolderTree:input_type -> warden.service.v1.GetFolderTreeRequest
+	30, // 48: warden.service.v1.WardenFolderService.ListFolderHistory:input_type -> warden.service.v1.ListFolderHistoryRequest
+	4,  // 49: warden.service.v1.WardenFolderService.CreateFolder:output_type -> warden.service.v1.CreateFolderResponse
+	6,  // 50: warden.service.v1.WardenFolderService.GetFolder:output_type -> warden.service.v1.GetFolderResponse
+	8,  // 51: warden.service.v1.WardenFolderService.ListFolders:output_type -> warden.service.v1.ListFoldersResponse
+	10, // 52: warden.service.v1.WardenFolderService.UpdateFolder:output_type -> warden.service.v1.UpdateFolderResponse
+	40, // 53: warden.service.v1.WardenFolderService.DeleteFolder:output_type -> google.protobuf.Empty
+	23, // 54: warden.service.v1.WardenFolderService.RestoreFolder:output_type -> warden.service.v1.RestoreFolderResponse
+	25, // 55: warden.service.v1.WardenFolderService.MoveFolder:output_type -> warden.service.v1.MoveFolderResponse
+	12, // 56: warden.service.v1.WardenFolderService.SetFolderMetadataSchema:output_type -> warden.service.v1.SetFolderMetadataSchemaResponse
+	14, // 57: warden.service.v1.WardenFolderService.SetFolderDefaultPermissions:output_type -> warden.service.v1.SetFolderDefaultPermissionsResponse
+	16, // 58: warden.service.v1.WardenFolderService.SetFolderAccessPolicy:output_type -> warden.service.v1.SetFolderAccessPolicyResponse
+	18, // 59: warden.service.v1.WardenFolderService.SetFolderLegalHold:output_type -> warden.service.v1.SetFolderLegalHoldResponse
+	20, // 60: warden.service.v1.WardenFolderService.GetFolderUsage:output_type -> warden.service.v1.GetFolderUsageResponse
+	28, // 61: warden.service.v1.WardenFolderService.GetFolderTree:output_type -> warden.service.v1.GetFolderTreeResponse
+	31, // 62: warden.service.v1.WardenFolderService.ListFolderHistory:output_type -> warden.service.v1.ListFolderHistoryResponse
+	49, // [49:63] is the sub-list for method output_type
+	35, // [35:49] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_warden_service_v1_folder_proto_init() }
@@ -1090,20 +2532,25 @@ func file_warden_service_v1_folder_proto_init() {
 	file_warden_service_v1_folder_proto_msgTypes[1].OneofWrappers = []any{}
 	file_warden_service_v1_folder_proto_msgTypes[5].OneofWrappers = []any{}
 	file_warden_service_v1_folder_proto_msgTypes[7].OneofWrappers = []any{}
-	file_warden_service_v1_folder_proto_msgTypes[10].OneofWrappers = []any{}
-	file_warden_service_v1_folder_proto_msgTypes[12].OneofWrappers = []any{}
+	file_warden_service_v1_folder_proto_msgTypes[17].OneofWrappers = []any{}
+	file_warden_service_v1_folder_proto_msgTypes[20].OneofWrappers = []any{}
+	file_warden_service_v1_folder_proto_msgTypes[22].OneofWrappers = []any{}
+	file_warden_service_v1_folder_proto_msgTypes[24].OneofWrappers = []any{}
+	file_warden_service_v1_folder_proto_msgTypes[27].OneofWrappers = []any{}
+	file_warden_service_v1_folder_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_folder_proto_rawDesc), len(file_warden_service_v1_folder_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      2,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_warden_service_v1_folder_proto_goTypes,
 		DependencyIndexes: file_warden_service_v1_folder_proto_depIdxs,
+		EnumInfos:         file_warden_service_v1_folder_proto_enumTypes,
 		MessageInfos:      file_warden_service_v1_folder_proto_msgTypes,
 	}.Build()
 	File_warden_service_v1_folder_proto = out.File
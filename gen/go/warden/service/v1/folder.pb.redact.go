@@ -12,6 +12,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -25,6 +26,7 @@ var (
 	_ validate.Rule
 	_ annotations.FieldBehavior
 	_ emptypb.Empty
+	_ structpb.Struct
 	_ timestamppb.Timestamp
 )
 
@@ -101,6 +103,17 @@ func (s *redactedWardenFolderServiceServer) DeleteFolder(ctx context.Context, in
 	return res, err
 }
 
+// RestoreFolder is the redacted wrapper for the actual WardenFolderServiceServer.RestoreFolder method
+// Unary RPC
+func (s *redactedWardenFolderServiceServer) RestoreFolder(ctx context.Context, in *RestoreFolderRequest) (*RestoreFolderResponse, error) {
+	res, err := s.srv.RestoreFolder(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // MoveFolder is the redacted wrapper for the actual WardenFolderServiceServer.MoveFolder method
 // Unary RPC
 func (s *redactedWardenFolderServiceServer) MoveFolder(ctx context.Context, in *MoveFolderRequest) (*MoveFolderResponse, error) {
@@ -112,6 +125,61 @@ func (s *redactedWardenFolderServiceServer) MoveFolder(ctx context.Context, in *
 	return res, err
 }
 
+// SetFolderMetadataSchema is the redacted wrapper for the actual WardenFolderServiceServer.SetFolderMetadataSchema method
+// Unary RPC
+func (s *redactedWardenFolderServiceServer) SetFolderMetadataSchema(ctx context.Context, in *SetFolderMetadataSchemaRequest) (*SetFolderMetadataSchemaResponse, error) {
+	res, err := s.srv.SetFolderMetadataSchema(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SetFolderDefaultPermissions is the redacted wrapper for the actual WardenFolderServiceServer.SetFolderDefaultPermissions method
+// Unary RPC
+func (s *redactedWardenFolderServiceServer) SetFolderDefaultPermissions(ctx context.Context, in *SetFolderDefaultPermissionsRequest) (*SetFolderDefaultPermissionsResponse, error) {
+	res, err := s.srv.SetFolderDefaultPermissions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SetFolderAccessPolicy is the redacted wrapper for the actual WardenFolderServiceServer.SetFolderAccessPolicy method
+// Unary RPC
+func (s *redactedWardenFolderServiceServer) SetFolderAccessPolicy(ctx context.Context, in *SetFolderAccessPolicyRequest) (*SetFolderAccessPolicyResponse, error) {
+	res, err := s.srv.SetFolderAccessPolicy(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SetFolderLegalHold is the redacted wrapper for the actual WardenFolderServiceServer.SetFolderLegalHold method
+// Unary RPC
+func (s *redactedWardenFolderServiceServer) SetFolderLegalHold(ctx context.Context, in *SetFolderLegalHoldRequest) (*SetFolderLegalHoldResponse, error) {
+	res, err := s.srv.SetFolderLegalHold(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetFolderUsage is the redacted wrapper for the actual WardenFolderServiceServer.GetFolderUsage method
+// Unary RPC
+func (s *redactedWardenFolderServiceServer) GetFolderUsage(ctx context.Context, in *GetFolderUsageRequest) (*GetFolderUsageResponse, error) {
+	res, err := s.srv.GetFolderUsage(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // GetFolderTree is the redacted wrapper for the actual WardenFolderServiceServer.GetFolderTree method
 // Unary RPC
 func (s *redactedWardenFolderServiceServer) GetFolderTree(ctx context.Context, in *GetFolderTreeRequest) (*GetFolderTreeResponse, error) {
@@ -123,6 +191,17 @@ func (s *redactedWardenFolderServiceServer) GetFolderTree(ctx context.Context, i
 	return res, err
 }
 
+// ListFolderHistory is the redacted wrapper for the actual WardenFolderServiceServer.ListFolderHistory method
+// Unary RPC
+func (s *redactedWardenFolderServiceServer) ListFolderHistory(ctx context.Context, in *ListFolderHistoryRequest) (*ListFolderHistoryResponse, error) {
+	res, err := s.srv.ListFolderHistory(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for Folder
 func (x *Folder) Redact() string {
 	if x == nil {
@@ -152,6 +231,28 @@ func (x *Folder) Redact() string {
 	// Safe field: UpdateTime
 
 	// Safe field: CreatedBy
+
+	// Safe field: LastAccessedTime
+
+	// Safe field: Revision
+
+	// Safe field: MetadataSchema
+
+	// Safe field: DefaultPermissions
+
+	// Safe field: AccessPolicy
+
+	// Safe field: Protected
+
+	// Safe field: LegalHold
+
+	// Safe field: LegalHoldReason
+
+	// Safe field: Status
+
+	// Safe field: DeletedTime
+
+	// Safe field: OriginalPath
 	return x.String()
 }
 
@@ -216,6 +317,18 @@ func (x *ListFoldersRequest) Redact() string {
 	// Safe field: PageSize
 
 	// Safe field: NameFilter
+
+	// Safe field: Cursor
+
+	// Safe field: SortBy
+
+	// Safe field: SortOrder
+
+	// Safe field: IdsOnly
+
+	// Safe field: CountOnly
+
+	// Safe field: Deleted
 	return x.String()
 }
 
@@ -228,6 +341,10 @@ func (x *ListFoldersResponse) Redact() string {
 	// Safe field: Folders
 
 	// Safe field: Total
+
+	// Safe field: NextCursor
+
+	// Safe field: Ids
 	return x.String()
 }
 
@@ -242,6 +359,10 @@ func (x *UpdateFolderRequest) Redact() string {
 	// Safe field: Name
 
 	// Safe field: Description
+
+	// Safe field: ExpectedRevision
+
+	// Safe field: Protected
 	return x.String()
 }
 
@@ -255,6 +376,130 @@ func (x *UpdateFolderResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for SetFolderMetadataSchemaRequest
+func (x *SetFolderMetadataSchemaRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Schema
+	return x.String()
+}
+
+// Redact method implementation for SetFolderMetadataSchemaResponse
+func (x *SetFolderMetadataSchemaResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Folder
+	return x.String()
+}
+
+// Redact method implementation for SetFolderDefaultPermissionsRequest
+func (x *SetFolderDefaultPermissionsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Rules
+	return x.String()
+}
+
+// Redact method implementation for SetFolderDefaultPermissionsResponse
+func (x *SetFolderDefaultPermissionsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Folder
+	return x.String()
+}
+
+// Redact method implementation for SetFolderAccessPolicyRequest
+func (x *SetFolderAccessPolicyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Policy
+	return x.String()
+}
+
+// Redact method implementation for SetFolderAccessPolicyResponse
+func (x *SetFolderAccessPolicyResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Folder
+	return x.String()
+}
+
+// Redact method implementation for SetFolderLegalHoldRequest
+func (x *SetFolderLegalHoldRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: LegalHold
+
+	// Safe field: Reason
+	return x.String()
+}
+
+// Redact method implementation for SetFolderLegalHoldResponse
+func (x *SetFolderLegalHoldResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Folder
+
+	// Safe field: SecretsUpdated
+
+	// Safe field: FailedSecretIds
+	return x.String()
+}
+
+// Redact method implementation for GetFolderUsageRequest
+func (x *GetFolderUsageRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Days
+
+	// Safe field: IncludeSubfolders
+	return x.String()
+}
+
+// Redact method implementation for GetFolderUsageResponse
+func (x *GetFolderUsageResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Reads
+
+	// Safe field: Writes
+
+	// Safe field: UnusedCount
+
+	// Safe field: Secrets
+	return x.String()
+}
+
 // Redact method implementation for DeleteFolderRequest
 func (x *DeleteFolderRequest) Redact() string {
 	if x == nil {
@@ -264,6 +509,30 @@ func (x *DeleteFolderRequest) Redact() string {
 	// Safe field: Id
 
 	// Safe field: Force
+
+	// Safe field: Permanent
+	return x.String()
+}
+
+// Redact method implementation for RestoreFolderRequest
+func (x *RestoreFolderRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Name
+	return x.String()
+}
+
+// Redact method implementation for RestoreFolderResponse
+func (x *RestoreFolderResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Folder
 	return x.String()
 }
 
@@ -324,3 +593,67 @@ func (x *GetFolderTreeResponse) Redact() string {
 	// Safe field: Roots
 	return x.String()
 }
+
+// Redact method implementation for FolderChange
+func (x *FolderChange) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: FolderId
+
+	// Safe field: Action
+
+	// Safe field: OldPath
+
+	// Safe field: NewPath
+
+	// Safe field: OldParentId
+
+	// Safe field: NewParentId
+
+	// Safe field: ActorId
+
+	// Safe field: Details
+
+	// Safe field: CreateTime
+	return x.String()
+}
+
+// Redact method implementation for ListFolderHistoryRequest
+func (x *ListFolderHistoryRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FolderId
+
+	// Safe field: IncludeSubfolders
+
+	// Safe field: ActorId
+
+	// Safe field: Action
+
+	// Safe field: StartTime
+
+	// Safe field: EndTime
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListFolderHistoryResponse
+func (x *ListFolderHistoryResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Changes
+
+	// Safe field: Total
+	return x.String()
+}
@@ -130,6 +130,110 @@ func (m *Folder) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Revision
+
+	if all {
+		switch v := interface{}(m.GetMetadataSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FolderValidationError{
+					field:  "MetadataSchema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FolderValidationError{
+					field:  "MetadataSchema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadataSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FolderValidationError{
+				field:  "MetadataSchema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDefaultPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FolderValidationError{
+						field:  fmt.Sprintf("DefaultPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FolderValidationError{
+						field:  fmt.Sprintf("DefaultPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FolderValidationError{
+					field:  fmt.Sprintf("DefaultPermissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAccessPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FolderValidationError{
+					field:  "AccessPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FolderValidationError{
+					field:  "AccessPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccessPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FolderValidationError{
+				field:  "AccessPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Protected
+
+	// no validation rules for LegalHold
+
+	// no validation rules for LegalHoldReason
+
+	// no validation rules for Status
+
+	// no validation rules for OriginalPath
+
 	if m.ParentId != nil {
 		// no validation rules for ParentId
 	}
@@ -138,6 +242,72 @@ func (m *Folder) validate(all bool) error {
 		// no validation rules for CreatedBy
 	}
 
+	if m.LastAccessedTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastAccessedTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FolderValidationError{
+						field:  "LastAccessedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FolderValidationError{
+						field:  "LastAccessedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastAccessedTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FolderValidationError{
+					field:  "LastAccessedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DeletedTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetDeletedTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FolderValidationError{
+						field:  "DeletedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FolderValidationError{
+						field:  "DeletedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeletedTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FolderValidationError{
+					field:  "DeletedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return FolderMultiError(errors)
 	}
@@ -747,6 +917,12 @@ func (m *ListFoldersRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for IdsOnly
+
+	// no validation rules for CountOnly
+
+	// no validation rules for Deleted
+
 	if m.ParentId != nil {
 		// no validation rules for ParentId
 	}
@@ -763,6 +939,18 @@ func (m *ListFoldersRequest) validate(all bool) error {
 		// no validation rules for NameFilter
 	}
 
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if m.SortBy != nil {
+		// no validation rules for SortBy
+	}
+
+	if m.SortOrder != nil {
+		// no validation rules for SortOrder
+	}
+
 	if len(errors) > 0 {
 		return ListFoldersRequestMultiError(errors)
 	}
@@ -901,6 +1089,8 @@ func (m *ListFoldersResponse) validate(all bool) error {
 
 	// no validation rules for Total
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return ListFoldersResponseMultiError(errors)
 	}
@@ -1013,6 +1203,14 @@ func (m *UpdateFolderRequest) validate(all bool) error {
 		// no validation rules for Description
 	}
 
+	if m.ExpectedRevision != nil {
+		// no validation rules for ExpectedRevision
+	}
+
+	if m.Protected != nil {
+		// no validation rules for Protected
+	}
+
 	if len(errors) > 0 {
 		return UpdateFolderRequestMultiError(errors)
 	}
@@ -1224,22 +1422,22 @@ var _ interface {
 	ErrorName() string
 } = UpdateFolderResponseValidationError{}
 
-// Validate checks the field values on DeleteFolderRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetFolderMetadataSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteFolderRequest) Validate() error {
+func (m *SetFolderMetadataSchemaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteFolderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteFolderRequestMultiError, or nil if none found.
-func (m *DeleteFolderRequest) ValidateAll() error {
+// ValidateAll checks the field values on SetFolderMetadataSchemaRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetFolderMetadataSchemaRequestMultiError, or nil if none found.
+func (m *SetFolderMetadataSchemaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteFolderRequest) validate(all bool) error {
+func (m *SetFolderMetadataSchemaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1248,22 +1446,49 @@ func (m *DeleteFolderRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Force
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetFolderMetadataSchemaRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetFolderMetadataSchemaRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetFolderMetadataSchemaRequestValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteFolderRequestMultiError(errors)
+		return SetFolderMetadataSchemaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteFolderRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteFolderRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteFolderRequestMultiError []error
+// SetFolderMetadataSchemaRequestMultiError is an error wrapping multiple
+// validation errors returned by SetFolderMetadataSchemaRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetFolderMetadataSchemaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteFolderRequestMultiError) Error() string {
+func (m SetFolderMetadataSchemaRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1272,11 +1497,12 @@ func (m DeleteFolderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteFolderRequestMultiError) AllErrors() []error { return m }
+func (m SetFolderMetadataSchemaRequestMultiError) AllErrors() []error { return m }
 
-// DeleteFolderRequestValidationError is the validation error returned by
-// DeleteFolderRequest.Validate if the designated constraints aren't met.
-type DeleteFolderRequestValidationError struct {
+// SetFolderMetadataSchemaRequestValidationError is the validation error
+// returned by SetFolderMetadataSchemaRequest.Validate if the designated
+// constraints aren't met.
+type SetFolderMetadataSchemaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1284,24 +1510,24 @@ type DeleteFolderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteFolderRequestValidationError) Field() string { return e.field }
+func (e SetFolderMetadataSchemaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteFolderRequestValidationError) Reason() string { return e.reason }
+func (e SetFolderMetadataSchemaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteFolderRequestValidationError) Cause() error { return e.cause }
+func (e SetFolderMetadataSchemaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteFolderRequestValidationError) Key() bool { return e.key }
+func (e SetFolderMetadataSchemaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteFolderRequestValidationError) ErrorName() string {
-	return "DeleteFolderRequestValidationError"
+func (e SetFolderMetadataSchemaRequestValidationError) ErrorName() string {
+	return "SetFolderMetadataSchemaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteFolderRequestValidationError) Error() string {
+func (e SetFolderMetadataSchemaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1313,14 +1539,14 @@ func (e DeleteFolderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteFolderRequest.%s: %s%s",
+		"invalid %sSetFolderMetadataSchemaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteFolderRequestValidationError{}
+var _ error = SetFolderMetadataSchemaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1328,50 +1554,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteFolderRequestValidationError{}
+} = SetFolderMetadataSchemaRequestValidationError{}
 
-// Validate checks the field values on MoveFolderRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MoveFolderRequest) Validate() error {
+// Validate checks the field values on SetFolderMetadataSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFolderMetadataSchemaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MoveFolderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MoveFolderRequestMultiError, or nil if none found.
-func (m *MoveFolderRequest) ValidateAll() error {
+// ValidateAll checks the field values on SetFolderMetadataSchemaResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetFolderMetadataSchemaResponseMultiError, or nil if none found.
+func (m *SetFolderMetadataSchemaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MoveFolderRequest) validate(all bool) error {
+func (m *SetFolderMetadataSchemaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if m.NewParentId != nil {
-		// no validation rules for NewParentId
+	if all {
+		switch v := interface{}(m.GetFolder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetFolderMetadataSchemaResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetFolderMetadataSchemaResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFolder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetFolderMetadataSchemaResponseValidationError{
+				field:  "Folder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return MoveFolderRequestMultiError(errors)
+		return SetFolderMetadataSchemaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MoveFolderRequestMultiError is an error wrapping multiple validation errors
-// returned by MoveFolderRequest.ValidateAll() if the designated constraints
-// aren't met.
-type MoveFolderRequestMultiError []error
+// SetFolderMetadataSchemaResponseMultiError is an error wrapping multiple
+// validation errors returned by SetFolderMetadataSchemaResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetFolderMetadataSchemaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MoveFolderRequestMultiError) Error() string {
+func (m SetFolderMetadataSchemaResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1380,11 +1629,12 @@ func (m MoveFolderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MoveFolderRequestMultiError) AllErrors() []error { return m }
+func (m SetFolderMetadataSchemaResponseMultiError) AllErrors() []error { return m }
 
-// MoveFolderRequestValidationError is the validation error returned by
-// MoveFolderRequest.Validate if the designated constraints aren't met.
-type MoveFolderRequestValidationError struct {
+// SetFolderMetadataSchemaResponseValidationError is the validation error
+// returned by SetFolderMetadataSchemaResponse.Validate if the designated
+// constraints aren't met.
+type SetFolderMetadataSchemaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1392,24 +1642,24 @@ type MoveFolderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MoveFolderRequestValidationError) Field() string { return e.field }
+func (e SetFolderMetadataSchemaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MoveFolderRequestValidationError) Reason() string { return e.reason }
+func (e SetFolderMetadataSchemaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MoveFolderRequestValidationError) Cause() error { return e.cause }
+func (e SetFolderMetadataSchemaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MoveFolderRequestValidationError) Key() bool { return e.key }
+func (e SetFolderMetadataSchemaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MoveFolderRequestValidationError) ErrorName() string {
-	return "MoveFolderRequestValidationError"
+func (e SetFolderMetadataSchemaResponseValidationError) ErrorName() string {
+	return "SetFolderMetadataSchemaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MoveFolderRequestValidationError) Error() string {
+func (e SetFolderMetadataSchemaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1421,14 +1671,14 @@ func (e MoveFolderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMoveFolderRequest.%s: %s%s",
+		"invalid %sSetFolderMetadataSchemaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MoveFolderRequestValidationError{}
+var _ error = SetFolderMetadataSchemaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1436,24 +1686,1756 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MoveFolderRequestValidationError{}
+} = SetFolderMetadataSchemaResponseValidationError{}
 
-// Validate checks the field values on MoveFolderResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetFolderDefaultPermissionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetFolderDefaultPermissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFolderDefaultPermissionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetFolderDefaultPermissionsRequestMultiError, or nil if none found.
+func (m *SetFolderDefaultPermissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFolderDefaultPermissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetFolderDefaultPermissionsRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetFolderDefaultPermissionsRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetFolderDefaultPermissionsRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetFolderDefaultPermissionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFolderDefaultPermissionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetFolderDefaultPermissionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetFolderDefaultPermissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFolderDefaultPermissionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFolderDefaultPermissionsRequestMultiError) AllErrors() []error { return m }
+
+// SetFolderDefaultPermissionsRequestValidationError is the validation error
+// returned by SetFolderDefaultPermissionsRequest.Validate if the designated
+// constraints aren't met.
+type SetFolderDefaultPermissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFolderDefaultPermissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFolderDefaultPermissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFolderDefaultPermissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFolderDefaultPermissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFolderDefaultPermissionsRequestValidationError) ErrorName() string {
+	return "SetFolderDefaultPermissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFolderDefaultPermissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFolderDefaultPermissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFolderDefaultPermissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFolderDefaultPermissionsRequestValidationError{}
+
+// Validate checks the field values on SetFolderDefaultPermissionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetFolderDefaultPermissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFolderDefaultPermissionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetFolderDefaultPermissionsResponseMultiError, or nil if none found.
+func (m *SetFolderDefaultPermissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFolderDefaultPermissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFolder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetFolderDefaultPermissionsResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetFolderDefaultPermissionsResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFolder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetFolderDefaultPermissionsResponseValidationError{
+				field:  "Folder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetFolderDefaultPermissionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFolderDefaultPermissionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetFolderDefaultPermissionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetFolderDefaultPermissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFolderDefaultPermissionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFolderDefaultPermissionsResponseMultiError) AllErrors() []error { return m }
+
+// SetFolderDefaultPermissionsResponseValidationError is the validation error
+// returned by SetFolderDefaultPermissionsResponse.Validate if the designated
+// constraints aren't met.
+type SetFolderDefaultPermissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFolderDefaultPermissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFolderDefaultPermissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFolderDefaultPermissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFolderDefaultPermissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFolderDefaultPermissionsResponseValidationError) ErrorName() string {
+	return "SetFolderDefaultPermissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFolderDefaultPermissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFolderDefaultPermissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFolderDefaultPermissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFolderDefaultPermissionsResponseValidationError{}
+
+// Validate checks the field values on SetFolderAccessPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFolderAccessPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFolderAccessPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFolderAccessPolicyRequestMultiError, or nil if none found.
+func (m *SetFolderAccessPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFolderAccessPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetFolderAccessPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetFolderAccessPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetFolderAccessPolicyRequestValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetFolderAccessPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFolderAccessPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by SetFolderAccessPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetFolderAccessPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFolderAccessPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFolderAccessPolicyRequestMultiError) AllErrors() []error { return m }
+
+// SetFolderAccessPolicyRequestValidationError is the validation error returned
+// by SetFolderAccessPolicyRequest.Validate if the designated constraints
+// aren't met.
+type SetFolderAccessPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFolderAccessPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFolderAccessPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFolderAccessPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFolderAccessPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFolderAccessPolicyRequestValidationError) ErrorName() string {
+	return "SetFolderAccessPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFolderAccessPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFolderAccessPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFolderAccessPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFolderAccessPolicyRequestValidationError{}
+
+// Validate checks the field values on SetFolderAccessPolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFolderAccessPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFolderAccessPolicyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetFolderAccessPolicyResponseMultiError, or nil if none found.
+func (m *SetFolderAccessPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFolderAccessPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFolder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetFolderAccessPolicyResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetFolderAccessPolicyResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFolder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetFolderAccessPolicyResponseValidationError{
+				field:  "Folder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetFolderAccessPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFolderAccessPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by SetFolderAccessPolicyResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetFolderAccessPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFolderAccessPolicyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFolderAccessPolicyResponseMultiError) AllErrors() []error { return m }
+
+// SetFolderAccessPolicyResponseValidationError is the validation error
+// returned by SetFolderAccessPolicyResponse.Validate if the designated
+// constraints aren't met.
+type SetFolderAccessPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFolderAccessPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFolderAccessPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFolderAccessPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFolderAccessPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFolderAccessPolicyResponseValidationError) ErrorName() string {
+	return "SetFolderAccessPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFolderAccessPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFolderAccessPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFolderAccessPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFolderAccessPolicyResponseValidationError{}
+
+// Validate checks the field values on SetFolderLegalHoldRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFolderLegalHoldRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFolderLegalHoldRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFolderLegalHoldRequestMultiError, or nil if none found.
+func (m *SetFolderLegalHoldRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFolderLegalHoldRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for LegalHold
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return SetFolderLegalHoldRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFolderLegalHoldRequestMultiError is an error wrapping multiple validation
+// errors returned by SetFolderLegalHoldRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetFolderLegalHoldRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFolderLegalHoldRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFolderLegalHoldRequestMultiError) AllErrors() []error { return m }
+
+// SetFolderLegalHoldRequestValidationError is the validation error returned by
+// SetFolderLegalHoldRequest.Validate if the designated constraints aren't met.
+type SetFolderLegalHoldRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFolderLegalHoldRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFolderLegalHoldRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFolderLegalHoldRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFolderLegalHoldRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFolderLegalHoldRequestValidationError) ErrorName() string {
+	return "SetFolderLegalHoldRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFolderLegalHoldRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFolderLegalHoldRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFolderLegalHoldRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFolderLegalHoldRequestValidationError{}
+
+// Validate checks the field values on SetFolderLegalHoldResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFolderLegalHoldResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFolderLegalHoldResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFolderLegalHoldResponseMultiError, or nil if none found.
+func (m *SetFolderLegalHoldResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFolderLegalHoldResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFolder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetFolderLegalHoldResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetFolderLegalHoldResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFolder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetFolderLegalHoldResponseValidationError{
+				field:  "Folder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SecretsUpdated
+
+	if len(errors) > 0 {
+		return SetFolderLegalHoldResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFolderLegalHoldResponseMultiError is an error wrapping multiple
+// validation errors returned by SetFolderLegalHoldResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetFolderLegalHoldResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFolderLegalHoldResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFolderLegalHoldResponseMultiError) AllErrors() []error { return m }
+
+// SetFolderLegalHoldResponseValidationError is the validation error returned
+// by SetFolderLegalHoldResponse.Validate if the designated constraints aren't met.
+type SetFolderLegalHoldResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFolderLegalHoldResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFolderLegalHoldResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFolderLegalHoldResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFolderLegalHoldResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFolderLegalHoldResponseValidationError) ErrorName() string {
+	return "SetFolderLegalHoldResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFolderLegalHoldResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFolderLegalHoldResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFolderLegalHoldResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFolderLegalHoldResponseValidationError{}
+
+// Validate checks the field values on GetFolderUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFolderUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFolderUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFolderUsageRequestMultiError, or nil if none found.
+func (m *GetFolderUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFolderUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for IncludeSubfolders
+
+	if m.Days != nil {
+		// no validation rules for Days
+	}
+
+	if len(errors) > 0 {
+		return GetFolderUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFolderUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFolderUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFolderUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFolderUsageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFolderUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetFolderUsageRequestValidationError is the validation error returned by
+// GetFolderUsageRequest.Validate if the designated constraints aren't met.
+type GetFolderUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFolderUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFolderUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFolderUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFolderUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFolderUsageRequestValidationError) ErrorName() string {
+	return "GetFolderUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFolderUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFolderUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFolderUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFolderUsageRequestValidationError{}
+
+// Validate checks the field values on GetFolderUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFolderUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFolderUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFolderUsageResponseMultiError, or nil if none found.
+func (m *GetFolderUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFolderUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reads
+
+	// no validation rules for Writes
+
+	// no validation rules for UnusedCount
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFolderUsageResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFolderUsageResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFolderUsageResponseValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetFolderUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFolderUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFolderUsageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetFolderUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFolderUsageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFolderUsageResponseMultiError) AllErrors() []error { return m }
+
+// GetFolderUsageResponseValidationError is the validation error returned by
+// GetFolderUsageResponse.Validate if the designated constraints aren't met.
+type GetFolderUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFolderUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFolderUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFolderUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFolderUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFolderUsageResponseValidationError) ErrorName() string {
+	return "GetFolderUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFolderUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFolderUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFolderUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFolderUsageResponseValidationError{}
+
+// Validate checks the field values on DeleteFolderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFolderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFolderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFolderRequestMultiError, or nil if none found.
+func (m *DeleteFolderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFolderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Force
+
+	// no validation rules for Permanent
+
+	if len(errors) > 0 {
+		return DeleteFolderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFolderRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteFolderRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFolderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFolderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFolderRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFolderRequestValidationError is the validation error returned by
+// DeleteFolderRequest.Validate if the designated constraints aren't met.
+type DeleteFolderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFolderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFolderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFolderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFolderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFolderRequestValidationError) ErrorName() string {
+	return "DeleteFolderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFolderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFolderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFolderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFolderRequestValidationError{}
+
+// Validate checks the field values on RestoreFolderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreFolderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreFolderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreFolderRequestMultiError, or nil if none found.
+func (m *RestoreFolderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreFolderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if len(errors) > 0 {
+		return RestoreFolderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreFolderRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreFolderRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreFolderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreFolderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreFolderRequestMultiError) AllErrors() []error { return m }
+
+// RestoreFolderRequestValidationError is the validation error returned by
+// RestoreFolderRequest.Validate if the designated constraints aren't met.
+type RestoreFolderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreFolderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreFolderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreFolderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreFolderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreFolderRequestValidationError) ErrorName() string {
+	return "RestoreFolderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreFolderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreFolderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreFolderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreFolderRequestValidationError{}
+
+// Validate checks the field values on RestoreFolderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreFolderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreFolderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreFolderResponseMultiError, or nil if none found.
+func (m *RestoreFolderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreFolderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFolder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreFolderResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreFolderResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFolder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreFolderResponseValidationError{
+				field:  "Folder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreFolderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreFolderResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreFolderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreFolderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreFolderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreFolderResponseMultiError) AllErrors() []error { return m }
+
+// RestoreFolderResponseValidationError is the validation error returned by
+// RestoreFolderResponse.Validate if the designated constraints aren't met.
+type RestoreFolderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreFolderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreFolderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreFolderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreFolderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreFolderResponseValidationError) ErrorName() string {
+	return "RestoreFolderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreFolderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreFolderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreFolderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreFolderResponseValidationError{}
+
+// Validate checks the field values on MoveFolderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveFolderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveFolderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveFolderRequestMultiError, or nil if none found.
+func (m *MoveFolderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveFolderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.NewParentId != nil {
+		// no validation rules for NewParentId
+	}
+
+	if len(errors) > 0 {
+		return MoveFolderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveFolderRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveFolderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveFolderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveFolderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveFolderRequestMultiError) AllErrors() []error { return m }
+
+// MoveFolderRequestValidationError is the validation error returned by
+// MoveFolderRequest.Validate if the designated constraints aren't met.
+type MoveFolderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveFolderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveFolderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveFolderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveFolderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveFolderRequestValidationError) ErrorName() string {
+	return "MoveFolderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveFolderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveFolderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveFolderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveFolderRequestValidationError{}
+
+// Validate checks the field values on MoveFolderResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
 func (m *MoveFolderResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MoveFolderResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MoveFolderResponseMultiError, or nil if none found.
-func (m *MoveFolderResponse) ValidateAll() error {
+// ValidateAll checks the field values on MoveFolderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveFolderResponseMultiError, or nil if none found.
+func (m *MoveFolderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveFolderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFolder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MoveFolderResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MoveFolderResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFolder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MoveFolderResponseValidationError{
+				field:  "Folder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MoveFolderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveFolderResponseMultiError is an error wrapping multiple validation errors
+// returned by MoveFolderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MoveFolderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveFolderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveFolderResponseMultiError) AllErrors() []error { return m }
+
+// MoveFolderResponseValidationError is the validation error returned by
+// MoveFolderResponse.Validate if the designated constraints aren't met.
+type MoveFolderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveFolderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveFolderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveFolderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveFolderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveFolderResponseValidationError) ErrorName() string {
+	return "MoveFolderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveFolderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveFolderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveFolderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveFolderResponseValidationError{}
+
+// Validate checks the field values on GetFolderTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFolderTreeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFolderTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFolderTreeRequestMultiError, or nil if none found.
+func (m *GetFolderTreeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFolderTreeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeCounts
+
+	if m.RootId != nil {
+		// no validation rules for RootId
+	}
+
+	if m.MaxDepth != nil {
+		// no validation rules for MaxDepth
+	}
+
+	if len(errors) > 0 {
+		return GetFolderTreeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFolderTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFolderTreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFolderTreeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFolderTreeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFolderTreeRequestMultiError) AllErrors() []error { return m }
+
+// GetFolderTreeRequestValidationError is the validation error returned by
+// GetFolderTreeRequest.Validate if the designated constraints aren't met.
+type GetFolderTreeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFolderTreeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFolderTreeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFolderTreeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFolderTreeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFolderTreeRequestValidationError) ErrorName() string {
+	return "GetFolderTreeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFolderTreeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFolderTreeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFolderTreeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFolderTreeRequestValidationError{}
+
+// Validate checks the field values on FolderTreeNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FolderTreeNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FolderTreeNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FolderTreeNodeMultiError,
+// or nil if none found.
+func (m *FolderTreeNode) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MoveFolderResponse) validate(all bool) error {
+func (m *FolderTreeNode) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1464,7 +3446,7 @@ func (m *MoveFolderResponse) validate(all bool) error {
 		switch v := interface{}(m.GetFolder()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MoveFolderResponseValidationError{
+				errors = append(errors, FolderTreeNodeValidationError{
 					field:  "Folder",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1472,7 +3454,7 @@ func (m *MoveFolderResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MoveFolderResponseValidationError{
+				errors = append(errors, FolderTreeNodeValidationError{
 					field:  "Folder",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1481,7 +3463,7 @@ func (m *MoveFolderResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetFolder()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MoveFolderResponseValidationError{
+			return FolderTreeNodeValidationError{
 				field:  "Folder",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1489,20 +3471,188 @@ func (m *MoveFolderResponse) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FolderTreeNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FolderTreeNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FolderTreeNodeValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FolderTreeNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// FolderTreeNodeMultiError is an error wrapping multiple validation errors
+// returned by FolderTreeNode.ValidateAll() if the designated constraints
+// aren't met.
+type FolderTreeNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FolderTreeNodeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FolderTreeNodeMultiError) AllErrors() []error { return m }
+
+// FolderTreeNodeValidationError is the validation error returned by
+// FolderTreeNode.Validate if the designated constraints aren't met.
+type FolderTreeNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FolderTreeNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FolderTreeNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FolderTreeNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FolderTreeNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FolderTreeNodeValidationError) ErrorName() string { return "FolderTreeNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FolderTreeNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFolderTreeNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FolderTreeNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FolderTreeNodeValidationError{}
+
+// Validate checks the field values on GetFolderTreeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFolderTreeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFolderTreeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFolderTreeResponseMultiError, or nil if none found.
+func (m *GetFolderTreeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFolderTreeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFolderTreeResponseValidationError{
+						field:  fmt.Sprintf("Roots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFolderTreeResponseValidationError{
+						field:  fmt.Sprintf("Roots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFolderTreeResponseValidationError{
+					field:  fmt.Sprintf("Roots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return MoveFolderResponseMultiError(errors)
+		return GetFolderTreeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MoveFolderResponseMultiError is an error wrapping multiple validation errors
-// returned by MoveFolderResponse.ValidateAll() if the designated constraints
-// aren't met.
-type MoveFolderResponseMultiError []error
+// GetFolderTreeResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFolderTreeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetFolderTreeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MoveFolderResponseMultiError) Error() string {
+func (m GetFolderTreeResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1511,11 +3661,11 @@ func (m MoveFolderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MoveFolderResponseMultiError) AllErrors() []error { return m }
+func (m GetFolderTreeResponseMultiError) AllErrors() []error { return m }
 
-// MoveFolderResponseValidationError is the validation error returned by
-// MoveFolderResponse.Validate if the designated constraints aren't met.
-type MoveFolderResponseValidationError struct {
+// GetFolderTreeResponseValidationError is the validation error returned by
+// GetFolderTreeResponse.Validate if the designated constraints aren't met.
+type GetFolderTreeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1523,24 +3673,24 @@ type MoveFolderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MoveFolderResponseValidationError) Field() string { return e.field }
+func (e GetFolderTreeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MoveFolderResponseValidationError) Reason() string { return e.reason }
+func (e GetFolderTreeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MoveFolderResponseValidationError) Cause() error { return e.cause }
+func (e GetFolderTreeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MoveFolderResponseValidationError) Key() bool { return e.key }
+func (e GetFolderTreeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MoveFolderResponseValidationError) ErrorName() string {
-	return "MoveFolderResponseValidationError"
+func (e GetFolderTreeResponseValidationError) ErrorName() string {
+	return "GetFolderTreeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MoveFolderResponseValidationError) Error() string {
+func (e GetFolderTreeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1552,14 +3702,14 @@ func (e MoveFolderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMoveFolderResponse.%s: %s%s",
+		"invalid %sGetFolderTreeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MoveFolderResponseValidationError{}
+var _ error = GetFolderTreeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1567,54 +3717,94 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MoveFolderResponseValidationError{}
+} = GetFolderTreeResponseValidationError{}
 
-// Validate checks the field values on GetFolderTreeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFolderTreeRequest) Validate() error {
+// Validate checks the field values on FolderChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FolderChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFolderTreeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetFolderTreeRequestMultiError, or nil if none found.
-func (m *GetFolderTreeRequest) ValidateAll() error {
+// ValidateAll checks the field values on FolderChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FolderChangeMultiError, or
+// nil if none found.
+func (m *FolderChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFolderTreeRequest) validate(all bool) error {
+func (m *FolderChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IncludeCounts
+	// no validation rules for Id
 
-	if m.RootId != nil {
-		// no validation rules for RootId
+	// no validation rules for FolderId
+
+	// no validation rules for Action
+
+	// no validation rules for OldPath
+
+	// no validation rules for NewPath
+
+	// no validation rules for ActorId
+
+	// no validation rules for Details
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FolderChangeValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FolderChangeValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FolderChangeValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	if m.MaxDepth != nil {
-		// no validation rules for MaxDepth
+	if m.OldParentId != nil {
+		// no validation rules for OldParentId
+	}
+
+	if m.NewParentId != nil {
+		// no validation rules for NewParentId
 	}
 
 	if len(errors) > 0 {
-		return GetFolderTreeRequestMultiError(errors)
+		return FolderChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFolderTreeRequestMultiError is an error wrapping multiple validation
-// errors returned by GetFolderTreeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetFolderTreeRequestMultiError []error
+// FolderChangeMultiError is an error wrapping multiple validation errors
+// returned by FolderChange.ValidateAll() if the designated constraints aren't met.
+type FolderChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFolderTreeRequestMultiError) Error() string {
+func (m FolderChangeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1623,11 +3813,11 @@ func (m GetFolderTreeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFolderTreeRequestMultiError) AllErrors() []error { return m }
+func (m FolderChangeMultiError) AllErrors() []error { return m }
 
-// GetFolderTreeRequestValidationError is the validation error returned by
-// GetFolderTreeRequest.Validate if the designated constraints aren't met.
-type GetFolderTreeRequestValidationError struct {
+// FolderChangeValidationError is the validation error returned by
+// FolderChange.Validate if the designated constraints aren't met.
+type FolderChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1635,24 +3825,22 @@ type GetFolderTreeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFolderTreeRequestValidationError) Field() string { return e.field }
+func (e FolderChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFolderTreeRequestValidationError) Reason() string { return e.reason }
+func (e FolderChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFolderTreeRequestValidationError) Cause() error { return e.cause }
+func (e FolderChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFolderTreeRequestValidationError) Key() bool { return e.key }
+func (e FolderChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFolderTreeRequestValidationError) ErrorName() string {
-	return "GetFolderTreeRequestValidationError"
-}
+func (e FolderChangeValidationError) ErrorName() string { return "FolderChangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetFolderTreeRequestValidationError) Error() string {
+func (e FolderChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1664,14 +3852,14 @@ func (e GetFolderTreeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFolderTreeRequest.%s: %s%s",
+		"invalid %sFolderChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFolderTreeRequestValidationError{}
+var _ error = FolderChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -1679,85 +3867,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFolderTreeRequestValidationError{}
+} = FolderChangeValidationError{}
 
-// Validate checks the field values on FolderTreeNode with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *FolderTreeNode) Validate() error {
+// Validate checks the field values on ListFolderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFolderHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FolderTreeNode with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in FolderTreeNodeMultiError,
-// or nil if none found.
-func (m *FolderTreeNode) ValidateAll() error {
+// ValidateAll checks the field values on ListFolderHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFolderHistoryRequestMultiError, or nil if none found.
+func (m *ListFolderHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FolderTreeNode) validate(all bool) error {
+func (m *ListFolderHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetFolder()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, FolderTreeNodeValidationError{
-					field:  "Folder",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for IncludeSubfolders
+
+	if m.FolderId != nil {
+		// no validation rules for FolderId
+	}
+
+	if m.ActorId != nil {
+		// no validation rules for ActorId
+	}
+
+	if m.Action != nil {
+		// no validation rules for Action
+	}
+
+	if m.StartTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFolderHistoryRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFolderHistoryRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, FolderTreeNodeValidationError{
-					field:  "Folder",
+				return ListFolderHistoryRequestValidationError{
+					field:  "StartTime",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFolder()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return FolderTreeNodeValidationError{
-				field:  "Folder",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	for idx, item := range m.GetChildren() {
-		_, _ = idx, item
+	if m.EndTime != nil {
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetEndTime()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, FolderTreeNodeValidationError{
-						field:  fmt.Sprintf("Children[%v]", idx),
+					errors = append(errors, ListFolderHistoryRequestValidationError{
+						field:  "EndTime",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, FolderTreeNodeValidationError{
-						field:  fmt.Sprintf("Children[%v]", idx),
+					errors = append(errors, ListFolderHistoryRequestValidationError{
+						field:  "EndTime",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return FolderTreeNodeValidationError{
-					field:  fmt.Sprintf("Children[%v]", idx),
+				return ListFolderHistoryRequestValidationError{
+					field:  "EndTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1766,20 +3971,28 @@ func (m *FolderTreeNode) validate(all bool) error {
 
 	}
 
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
 	if len(errors) > 0 {
-		return FolderTreeNodeMultiError(errors)
+		return ListFolderHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// FolderTreeNodeMultiError is an error wrapping multiple validation errors
-// returned by FolderTreeNode.ValidateAll() if the designated constraints
-// aren't met.
-type FolderTreeNodeMultiError []error
+// ListFolderHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFolderHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFolderHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FolderTreeNodeMultiError) Error() string {
+func (m ListFolderHistoryRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1788,11 +4001,11 @@ func (m FolderTreeNodeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FolderTreeNodeMultiError) AllErrors() []error { return m }
+func (m ListFolderHistoryRequestMultiError) AllErrors() []error { return m }
 
-// FolderTreeNodeValidationError is the validation error returned by
-// FolderTreeNode.Validate if the designated constraints aren't met.
-type FolderTreeNodeValidationError struct {
+// ListFolderHistoryRequestValidationError is the validation error returned by
+// ListFolderHistoryRequest.Validate if the designated constraints aren't met.
+type ListFolderHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1800,22 +4013,24 @@ type FolderTreeNodeValidationError struct {
 }
 
 // Field function returns field value.
-func (e FolderTreeNodeValidationError) Field() string { return e.field }
+func (e ListFolderHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FolderTreeNodeValidationError) Reason() string { return e.reason }
+func (e ListFolderHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FolderTreeNodeValidationError) Cause() error { return e.cause }
+func (e ListFolderHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FolderTreeNodeValidationError) Key() bool { return e.key }
+func (e ListFolderHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FolderTreeNodeValidationError) ErrorName() string { return "FolderTreeNodeValidationError" }
+func (e ListFolderHistoryRequestValidationError) ErrorName() string {
+	return "ListFolderHistoryRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e FolderTreeNodeValidationError) Error() string {
+func (e ListFolderHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1827,14 +4042,14 @@ func (e FolderTreeNodeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFolderTreeNode.%s: %s%s",
+		"invalid %sListFolderHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FolderTreeNodeValidationError{}
+var _ error = ListFolderHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1842,47 +4057,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FolderTreeNodeValidationError{}
+} = ListFolderHistoryRequestValidationError{}
 
-// Validate checks the field values on GetFolderTreeResponse with the rules
+// Validate checks the field values on ListFolderHistoryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFolderTreeResponse) Validate() error {
+func (m *ListFolderHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFolderTreeResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListFolderHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetFolderTreeResponseMultiError, or nil if none found.
-func (m *GetFolderTreeResponse) ValidateAll() error {
+// ListFolderHistoryResponseMultiError, or nil if none found.
+func (m *ListFolderHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFolderTreeResponse) validate(all bool) error {
+func (m *ListFolderHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRoots() {
+	for idx, item := range m.GetChanges() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetFolderTreeResponseValidationError{
-						field:  fmt.Sprintf("Roots[%v]", idx),
+					errors = append(errors, ListFolderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetFolderTreeResponseValidationError{
-						field:  fmt.Sprintf("Roots[%v]", idx),
+					errors = append(errors, ListFolderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1890,8 +4105,8 @@ func (m *GetFolderTreeResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetFolderTreeResponseValidationError{
-					field:  fmt.Sprintf("Roots[%v]", idx),
+				return ListFolderHistoryResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1900,20 +4115,22 @@ func (m *GetFolderTreeResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for Total
+
 	if len(errors) > 0 {
-		return GetFolderTreeResponseMultiError(errors)
+		return ListFolderHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFolderTreeResponseMultiError is an error wrapping multiple validation
-// errors returned by GetFolderTreeResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetFolderTreeResponseMultiError []error
+// ListFolderHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFolderHistoryResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListFolderHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFolderTreeResponseMultiError) Error() string {
+func (m ListFolderHistoryResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1922,11 +4139,11 @@ func (m GetFolderTreeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFolderTreeResponseMultiError) AllErrors() []error { return m }
+func (m ListFolderHistoryResponseMultiError) AllErrors() []error { return m }
 
-// GetFolderTreeResponseValidationError is the validation error returned by
-// GetFolderTreeResponse.Validate if the designated constraints aren't met.
-type GetFolderTreeResponseValidationError struct {
+// ListFolderHistoryResponseValidationError is the validation error returned by
+// ListFolderHistoryResponse.Validate if the designated constraints aren't met.
+type ListFolderHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1934,24 +4151,24 @@ type GetFolderTreeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFolderTreeResponseValidationError) Field() string { return e.field }
+func (e ListFolderHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFolderTreeResponseValidationError) Reason() string { return e.reason }
+func (e ListFolderHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFolderTreeResponseValidationError) Cause() error { return e.cause }
+func (e ListFolderHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFolderTreeResponseValidationError) Key() bool { return e.key }
+func (e ListFolderHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFolderTreeResponseValidationError) ErrorName() string {
-	return "GetFolderTreeResponseValidationError"
+func (e ListFolderHistoryResponseValidationError) ErrorName() string {
+	return "ListFolderHistoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetFolderTreeResponseValidationError) Error() string {
+func (e ListFolderHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1963,14 +4180,14 @@ func (e GetFolderTreeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFolderTreeResponse.%s: %s%s",
+		"invalid %sListFolderHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFolderTreeResponseValidationError{}
+var _ error = ListFolderHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1978,4 +4195,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFolderTreeResponseValidationError{}
+} = ListFolderHistoryResponseValidationError{}
@@ -20,13 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WardenFolderService_CreateFolder_FullMethodName  = "/warden.service.v1.WardenFolderService/CreateFolder"
-	WardenFolderService_GetFolder_FullMethodName     = "/warden.service.v1.WardenFolderService/GetFolder"
-	WardenFolderService_ListFolders_FullMethodName   = "/warden.service.v1.WardenFolderService/ListFolders"
-	WardenFolderService_UpdateFolder_FullMethodName  = "/warden.service.v1.WardenFolderService/UpdateFolder"
-	WardenFolderService_DeleteFolder_FullMethodName  = "/warden.service.v1.WardenFolderService/DeleteFolder"
-	WardenFolderService_MoveFolder_FullMethodName    = "/warden.service.v1.WardenFolderService/MoveFolder"
-	WardenFolderService_GetFolderTree_FullMethodName = "/warden.service.v1.WardenFolderService/GetFolderTree"
+	WardenFolderService_CreateFolder_FullMethodName                = "/warden.service.v1.WardenFolderService/CreateFolder"
+	WardenFolderService_GetFolder_FullMethodName                   = "/warden.service.v1.WardenFolderService/GetFolder"
+	WardenFolderService_ListFolders_FullMethodName                 = "/warden.service.v1.WardenFolderService/ListFolders"
+	WardenFolderService_UpdateFolder_FullMethodName                = "/warden.service.v1.WardenFolderService/UpdateFolder"
+	WardenFolderService_DeleteFolder_FullMethodName                = "/warden.service.v1.WardenFolderService/DeleteFolder"
+	WardenFolderService_RestoreFolder_FullMethodName               = "/warden.service.v1.WardenFolderService/RestoreFolder"
+	WardenFolderService_MoveFolder_FullMethodName                  = "/warden.service.v1.WardenFolderService/MoveFolder"
+	WardenFolderService_SetFolderMetadataSchema_FullMethodName     = "/warden.service.v1.WardenFolderService/SetFolderMetadataSchema"
+	WardenFolderService_SetFolderDefaultPermissions_FullMethodName = "/warden.service.v1.WardenFolderService/SetFolderDefaultPermissions"
+	WardenFolderService_SetFolderAccessPolicy_FullMethodName       = "/warden.service.v1.WardenFolderService/SetFolderAccessPolicy"
+	WardenFolderService_SetFolderLegalHold_FullMethodName          = "/warden.service.v1.WardenFolderService/SetFolderLegalHold"
+	WardenFolderService_GetFolderUsage_FullMethodName              = "/warden.service.v1.WardenFolderService/GetFolderUsage"
+	WardenFolderService_GetFolderTree_FullMethodName               = "/warden.service.v1.WardenFolderService/GetFolderTree"
+	WardenFolderService_ListFolderHistory_FullMethodName           = "/warden.service.v1.WardenFolderService/ListFolderHistory"
 )
 
 // WardenFolderServiceClient is the client API for WardenFolderService service.
@@ -43,12 +50,30 @@ type WardenFolderServiceClient interface {
 	ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error)
 	// Update folder metadata
 	UpdateFolder(ctx context.Context, in *UpdateFolderRequest, opts ...grpc.CallOption) (*UpdateFolderResponse, error)
-	// Delete a folder (must be empty)
+	// Move a folder to the trash, or delete it permanently (must be empty
+	// unless forced)
 	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Restore a folder from the trash, with everything deleted along with it
+	RestoreFolder(ctx context.Context, in *RestoreFolderRequest, opts ...grpc.CallOption) (*RestoreFolderResponse, error)
 	// Move a folder to a new parent
 	MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error)
+	// Set or clear the JSON Schema secret metadata in the folder must satisfy
+	SetFolderMetadataSchema(ctx context.Context, in *SetFolderMetadataSchemaRequest, opts ...grpc.CallOption) (*SetFolderMetadataSchemaResponse, error)
+	// Set the permissions granted on every secret created in the folder
+	SetFolderDefaultPermissions(ctx context.Context, in *SetFolderDefaultPermissionsRequest, opts ...grpc.CallOption) (*SetFolderDefaultPermissionsResponse, error)
+	// Set the network and time restrictions on access to a folder and
+	// everything in it (owner only)
+	SetFolderAccessPolicy(ctx context.Context, in *SetFolderAccessPolicyRequest, opts ...grpc.CallOption) (*SetFolderAccessPolicyResponse, error)
+	// Place or lift a legal hold on a folder and everything in it (platform
+	// admins only)
+	SetFolderLegalHold(ctx context.Context, in *SetFolderLegalHoldRequest, opts ...grpc.CallOption) (*SetFolderLegalHoldResponse, error)
+	// Get the read and write counts of the secrets in a folder, least used
+	// first, to find credentials that are no longer needed
+	GetFolderUsage(ctx context.Context, in *GetFolderUsageRequest, opts ...grpc.CallOption) (*GetFolderUsageResponse, error)
 	// Get the folder tree structure
 	GetFolderTree(ctx context.Context, in *GetFolderTreeRequest, opts ...grpc.CallOption) (*GetFolderTreeResponse, error)
+	// List who created, renamed, moved and deleted folders, newest first
+	ListFolderHistory(ctx context.Context, in *ListFolderHistoryRequest, opts ...grpc.CallOption) (*ListFolderHistoryResponse, error)
 }
 
 type wardenFolderServiceClient struct {
@@ -109,6 +134,16 @@ func (c *wardenFolderServiceClient) DeleteFolder(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *wardenFolderServiceClient) RestoreFolder(ctx context.Context, in *RestoreFolderRequest, opts ...grpc.CallOption) (*RestoreFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreFolderResponse)
+	err := c.cc.Invoke(ctx, WardenFolderService_RestoreFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *wardenFolderServiceClient) MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MoveFolderResponse)
@@ -119,6 +154,56 @@ func (c *wardenFolderServiceClient) MoveFolder(ctx context.Context, in *MoveFold
 	return out, nil
 }
 
+func (c *wardenFolderServiceClient) SetFolderMetadataSchema(ctx context.Context, in *SetFolderMetadataSchemaRequest, opts ...grpc.CallOption) (*SetFolderMetadataSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFolderMetadataSchemaResponse)
+	err := c.cc.Invoke(ctx, WardenFolderService_SetFolderMetadataSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenFolderServiceClient) SetFolderDefaultPermissions(ctx context.Context, in *SetFolderDefaultPermissionsRequest, opts ...grpc.CallOption) (*SetFolderDefaultPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFolderDefaultPermissionsResponse)
+	err := c.cc.Invoke(ctx, WardenFolderService_SetFolderDefaultPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenFolderServiceClient) SetFolderAccessPolicy(ctx context.Context, in *SetFolderAccessPolicyRequest, opts ...grpc.CallOption) (*SetFolderAccessPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFolderAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, WardenFolderService_SetFolderAccessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenFolderServiceClient) SetFolderLegalHold(ctx context.Context, in *SetFolderLegalHoldRequest, opts ...grpc.CallOption) (*SetFolderLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFolderLegalHoldResponse)
+	err := c.cc.Invoke(ctx, WardenFolderService_SetFolderLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenFolderServiceClient) GetFolderUsage(ctx context.Context, in *GetFolderUsageRequest, opts ...grpc.CallOption) (*GetFolderUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFolderUsageResponse)
+	err := c.cc.Invoke(ctx, WardenFolderService_GetFolderUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *wardenFolderServiceClient) GetFolderTree(ctx context.Context, in *GetFolderTreeRequest, opts ...grpc.CallOption) (*GetFolderTreeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetFolderTreeResponse)
@@ -129,6 +214,16 @@ func (c *wardenFolderServiceClient) GetFolderTree(ctx context.Context, in *GetFo
 	return out, nil
 }
 
+func (c *wardenFolderServiceClient) ListFolderHistory(ctx context.Context, in *ListFolderHistoryRequest, opts ...grpc.CallOption) (*ListFolderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFolderHistoryResponse)
+	err := c.cc.Invoke(ctx, WardenFolderService_ListFolderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WardenFolderServiceServer is the server API for WardenFolderService service.
 // All implementations must embed UnimplementedWardenFolderServiceServer
 // for forward compatibility.
@@ -143,12 +238,30 @@ type WardenFolderServiceServer interface {
 	ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error)
 	// Update folder metadata
 	UpdateFolder(context.Context, *UpdateFolderRequest) (*UpdateFolderResponse, error)
-	// Delete a folder (must be empty)
+	// Move a folder to the trash, or delete it permanently (must be empty
+	// unless forced)
 	DeleteFolder(context.Context, *DeleteFolderRequest) (*emptypb.Empty, error)
+	// Restore a folder from the trash, with everything deleted along with it
+	RestoreFolder(context.Context, *RestoreFolderRequest) (*RestoreFolderResponse, error)
 	// Move a folder to a new parent
 	MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error)
+	// Set or clear the JSON Schema secret metadata in the folder must satisfy
+	SetFolderMetadataSchema(context.Context, *SetFolderMetadataSchemaRequest) (*SetFolderMetadataSchemaResponse, error)
+	// Set the permissions granted on every secret created in the folder
+	SetFolderDefaultPermissions(context.Context, *SetFolderDefaultPermissionsRequest) (*SetFolderDefaultPermissionsResponse, error)
+	// Set the network and time restrictions on access to a folder and
+	// everything in it (owner only)
+	SetFolderAccessPolicy(context.Context, *SetFolderAccessPolicyRequest) (*SetFolderAccessPolicyResponse, error)
+	// Place or lift a legal hold on a folder and everything in it (platform
+	// admins only)
+	SetFolderLegalHold(context.Context, *SetFolderLegalHoldRequest) (*SetFolderLegalHoldResponse, error)
+	// Get the read and write counts of the secrets in a folder, least used
+	// first, to find credentials that are no longer needed
+	GetFolderUsage(context.Context, *GetFolderUsageRequest) (*GetFolderUsageResponse, error)
 	// Get the folder tree structure
 	GetFolderTree(context.Context, *GetFolderTreeRequest) (*GetFolderTreeResponse, error)
+	// List who created, renamed, moved and deleted folders, newest first
+	ListFolderHistory(context.Context, *ListFolderHistoryRequest) (*ListFolderHistoryResponse, error)
 	mustEmbedUnimplementedWardenFolderServiceServer()
 }
 
@@ -174,12 +287,33 @@ func (UnimplementedWardenFolderServiceServer) UpdateFolder(context.Context, *Upd
 func (UnimplementedWardenFolderServiceServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteFolder not implemented")
 }
+func (UnimplementedWardenFolderServiceServer) RestoreFolder(context.Context, *RestoreFolderRequest) (*RestoreFolderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreFolder not implemented")
+}
 func (UnimplementedWardenFolderServiceServer) MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method MoveFolder not implemented")
 }
+func (UnimplementedWardenFolderServiceServer) SetFolderMetadataSchema(context.Context, *SetFolderMetadataSchemaRequest) (*SetFolderMetadataSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFolderMetadataSchema not implemented")
+}
+func (UnimplementedWardenFolderServiceServer) SetFolderDefaultPermissions(context.Context, *SetFolderDefaultPermissionsRequest) (*SetFolderDefaultPermissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFolderDefaultPermissions not implemented")
+}
+func (UnimplementedWardenFolderServiceServer) SetFolderAccessPolicy(context.Context, *SetFolderAccessPolicyRequest) (*SetFolderAccessPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFolderAccessPolicy not implemented")
+}
+func (UnimplementedWardenFolderServiceServer) SetFolderLegalHold(context.Context, *SetFolderLegalHoldRequest) (*SetFolderLegalHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFolderLegalHold not implemented")
+}
+func (UnimplementedWardenFolderServiceServer) GetFolderUsage(context.Context, *GetFolderUsageRequest) (*GetFolderUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFolderUsage not implemented")
+}
 func (UnimplementedWardenFolderServiceServer) GetFolderTree(context.Context, *GetFolderTreeRequest) (*GetFolderTreeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFolderTree not implemented")
 }
+func (UnimplementedWardenFolderServiceServer) ListFolderHistory(context.Context, *ListFolderHistoryRequest) (*ListFolderHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFolderHistory not implemented")
+}
 func (UnimplementedWardenFolderServiceServer) mustEmbedUnimplementedWardenFolderServiceServer() {}
 func (UnimplementedWardenFolderServiceServer) testEmbeddedByValue()                             {}
 
@@ -291,6 +425,24 @@ func _WardenFolderService_DeleteFolder_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WardenFolderService_RestoreFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenFolderServiceServer).RestoreFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenFolderService_RestoreFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenFolderServiceServer).RestoreFolder(ctx, req.(*RestoreFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WardenFolderService_MoveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MoveFolderRequest)
 	if err := dec(in); err != nil {
@@ -309,6 +461,96 @@ func _WardenFolderService_MoveFolder_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WardenFolderService_SetFolderMetadataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFolderMetadataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenFolderServiceServer).SetFolderMetadataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenFolderService_SetFolderMetadataSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenFolderServiceServer).SetFolderMetadataSchema(ctx, req.(*SetFolderMetadataSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenFolderService_SetFolderDefaultPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFolderDefaultPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenFolderServiceServer).SetFolderDefaultPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenFolderService_SetFolderDefaultPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenFolderServiceServer).SetFolderDefaultPermissions(ctx, req.(*SetFolderDefaultPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenFolderService_SetFolderAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFolderAccessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenFolderServiceServer).SetFolderAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenFolderService_SetFolderAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenFolderServiceServer).SetFolderAccessPolicy(ctx, req.(*SetFolderAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenFolderService_SetFolderLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFolderLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenFolderServiceServer).SetFolderLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenFolderService_SetFolderLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenFolderServiceServer).SetFolderLegalHold(ctx, req.(*SetFolderLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenFolderService_GetFolderUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFolderUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenFolderServiceServer).GetFolderUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenFolderService_GetFolderUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenFolderServiceServer).GetFolderUsage(ctx, req.(*GetFolderUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WardenFolderService_GetFolderTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFolderTreeRequest)
 	if err := dec(in); err != nil {
@@ -327,6 +569,24 @@ func _WardenFolderService_GetFolderTree_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WardenFolderService_ListFolderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFolderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenFolderServiceServer).ListFolderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenFolderService_ListFolderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenFolderServiceServer).ListFolderHistory(ctx, req.(*ListFolderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WardenFolderService_ServiceDesc is the grpc.ServiceDesc for WardenFolderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -354,14 +614,42 @@ var WardenFolderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFolder",
 			Handler:    _WardenFolderService_DeleteFolder_Handler,
 		},
+		{
+			MethodName: "RestoreFolder",
+			Handler:    _WardenFolderService_RestoreFolder_Handler,
+		},
 		{
 			MethodName: "MoveFolder",
 			Handler:    _WardenFolderService_MoveFolder_Handler,
 		},
+		{
+			MethodName: "SetFolderMetadataSchema",
+			Handler:    _WardenFolderService_SetFolderMetadataSchema_Handler,
+		},
+		{
+			MethodName: "SetFolderDefaultPermissions",
+			Handler:    _WardenFolderService_SetFolderDefaultPermissions_Handler,
+		},
+		{
+			MethodName: "SetFolderAccessPolicy",
+			Handler:    _WardenFolderService_SetFolderAccessPolicy_Handler,
+		},
+		{
+			MethodName: "SetFolderLegalHold",
+			Handler:    _WardenFolderService_SetFolderLegalHold_Handler,
+		},
+		{
+			MethodName: "GetFolderUsage",
+			Handler:    _WardenFolderService_GetFolderUsage_Handler,
+		},
 		{
 			MethodName: "GetFolderTree",
 			Handler:    _WardenFolderService_GetFolderTree_Handler,
 		},
+		{
+			MethodName: "ListFolderHistory",
+			Handler:    _WardenFolderService_ListFolderHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "warden/service/v1/folder.proto",
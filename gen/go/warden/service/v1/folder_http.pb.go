@@ -24,23 +24,48 @@ const OperationWardenFolderServiceCreateFolder = "/warden.service.v1.WardenFolde
 const OperationWardenFolderServiceDeleteFolder = "/warden.service.v1.WardenFolderService/DeleteFolder"
 const OperationWardenFolderServiceGetFolder = "/warden.service.v1.WardenFolderService/GetFolder"
 const OperationWardenFolderServiceGetFolderTree = "/warden.service.v1.WardenFolderService/GetFolderTree"
+const OperationWardenFolderServiceGetFolderUsage = "/warden.service.v1.WardenFolderService/GetFolderUsage"
+const OperationWardenFolderServiceListFolderHistory = "/warden.service.v1.WardenFolderService/ListFolderHistory"
 const OperationWardenFolderServiceListFolders = "/warden.service.v1.WardenFolderService/ListFolders"
 const OperationWardenFolderServiceMoveFolder = "/warden.service.v1.WardenFolderService/MoveFolder"
+const OperationWardenFolderServiceRestoreFolder = "/warden.service.v1.WardenFolderService/RestoreFolder"
+const OperationWardenFolderServiceSetFolderAccessPolicy = "/warden.service.v1.WardenFolderService/SetFolderAccessPolicy"
+const OperationWardenFolderServiceSetFolderDefaultPermissions = "/warden.service.v1.WardenFolderService/SetFolderDefaultPermissions"
+const OperationWardenFolderServiceSetFolderLegalHold = "/warden.service.v1.WardenFolderService/SetFolderLegalHold"
+const OperationWardenFolderServiceSetFolderMetadataSchema = "/warden.service.v1.WardenFolderService/SetFolderMetadataSchema"
 const OperationWardenFolderServiceUpdateFolder = "/warden.service.v1.WardenFolderService/UpdateFolder"
 
 type WardenFolderServiceHTTPServer interface {
 	// CreateFolder Create a new folder
 	CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error)
-	// DeleteFolder Delete a folder (must be empty)
+	// DeleteFolder Move a folder to the trash, or delete it permanently (must be empty
+	// unless forced)
 	DeleteFolder(context.Context, *DeleteFolderRequest) (*emptypb.Empty, error)
 	// GetFolder Get a folder by ID
 	GetFolder(context.Context, *GetFolderRequest) (*GetFolderResponse, error)
 	// GetFolderTree Get the folder tree structure
 	GetFolderTree(context.Context, *GetFolderTreeRequest) (*GetFolderTreeResponse, error)
+	// GetFolderUsage Get the read and write counts of the secrets in a folder, least used
+	// first, to find credentials that are no longer needed
+	GetFolderUsage(context.Context, *GetFolderUsageRequest) (*GetFolderUsageResponse, error)
+	// ListFolderHistory List who created, renamed, moved and deleted folders, newest first
+	ListFolderHistory(context.Context, *ListFolderHistoryRequest) (*ListFolderHistoryResponse, error)
 	// ListFolders List folders in a parent folder (or root if no parent specified)
 	ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error)
 	// MoveFolder Move a folder to a new parent
 	MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error)
+	// RestoreFolder Restore a folder from the trash, with everything deleted along with it
+	RestoreFolder(context.Context, *RestoreFolderRequest) (*RestoreFolderResponse, error)
+	// SetFolderAccessPolicy Set the network and time restrictions on access to a folder and
+	// everything in it (owner only)
+	SetFolderAccessPolicy(context.Context, *SetFolderAccessPolicyRequest) (*SetFolderAccessPolicyResponse, error)
+	// SetFolderDefaultPermissions Set the permissions granted on every secret created in the folder
+	SetFolderDefaultPermissions(context.Context, *SetFolderDefaultPermissionsRequest) (*SetFolderDefaultPermissionsResponse, error)
+	// SetFolderLegalHold Place or lift a legal hold on a folder and everything in it (platform
+	// admins only)
+	SetFolderLegalHold(context.Context, *SetFolderLegalHoldRequest) (*SetFolderLegalHoldResponse, error)
+	// SetFolderMetadataSchema Set or clear the JSON Schema secret metadata in the folder must satisfy
+	SetFolderMetadataSchema(context.Context, *SetFolderMetadataSchemaRequest) (*SetFolderMetadataSchemaResponse, error)
 	// UpdateFolder Update folder metadata
 	UpdateFolder(context.Context, *UpdateFolderRequest) (*UpdateFolderResponse, error)
 }
@@ -52,8 +77,15 @@ func RegisterWardenFolderServiceHTTPServer(s *http.Server, srv WardenFolderServi
 	r.GET("/v1/folders", _WardenFolderService_ListFolders0_HTTP_Handler(srv))
 	r.PUT("/v1/folders/{id}", _WardenFolderService_UpdateFolder0_HTTP_Handler(srv))
 	r.DELETE("/v1/folders/{id}", _WardenFolderService_DeleteFolder0_HTTP_Handler(srv))
+	r.POST("/v1/folders/{id}/restore", _WardenFolderService_RestoreFolder0_HTTP_Handler(srv))
 	r.POST("/v1/folders/{id}/move", _WardenFolderService_MoveFolder0_HTTP_Handler(srv))
+	r.PUT("/v1/folders/{id}/metadata-schema", _WardenFolderService_SetFolderMetadataSchema0_HTTP_Handler(srv))
+	r.PUT("/v1/folders/{id}/default-permissions", _WardenFolderService_SetFolderDefaultPermissions0_HTTP_Handler(srv))
+	r.PUT("/v1/folders/{id}/access-policy", _WardenFolderService_SetFolderAccessPolicy0_HTTP_Handler(srv))
+	r.PUT("/v1/folders/{id}/legal-hold", _WardenFolderService_SetFolderLegalHold0_HTTP_Handler(srv))
+	r.GET("/v1/folders/{id}/usage", _WardenFolderService_GetFolderUsage0_HTTP_Handler(srv))
 	r.GET("/v1/folders/tree", _WardenFolderService_GetFolderTree0_HTTP_Handler(srv))
+	r.GET("/v1/folders/history", _WardenFolderService_ListFolderHistory0_HTTP_Handler(srv))
 }
 
 func _WardenFolderService_CreateFolder0_HTTP_Handler(srv WardenFolderServiceHTTPServer) func(ctx http.Context) error {
@@ -166,6 +198,31 @@ func _WardenFolderService_DeleteFolder0_HTTP_Handler(srv WardenFolderServiceHTTP
 	}
 }
 
+func _WardenFolderService_RestoreFolder0_HTTP_Handler(srv WardenFolderServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreFolderRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenFolderServiceRestoreFolder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreFolder(ctx, req.(*RestoreFolderRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestoreFolderResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _WardenFolderService_MoveFolder0_HTTP_Handler(srv WardenFolderServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in MoveFolderRequest
@@ -191,6 +248,128 @@ func _WardenFolderService_MoveFolder0_HTTP_Handler(srv WardenFolderServiceHTTPSe
 	}
 }
 
+func _WardenFolderService_SetFolderMetadataSchema0_HTTP_Handler(srv WardenFolderServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetFolderMetadataSchemaRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenFolderServiceSetFolderMetadataSchema)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetFolderMetadataSchema(ctx, req.(*SetFolderMetadataSchemaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetFolderMetadataSchemaResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenFolderService_SetFolderDefaultPermissions0_HTTP_Handler(srv WardenFolderServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetFolderDefaultPermissionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenFolderServiceSetFolderDefaultPermissions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetFolderDefaultPermissions(ctx, req.(*SetFolderDefaultPermissionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetFolderDefaultPermissionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenFolderService_SetFolderAccessPolicy0_HTTP_Handler(srv WardenFolderServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetFolderAccessPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenFolderServiceSetFolderAccessPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetFolderAccessPolicy(ctx, req.(*SetFolderAccessPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetFolderAccessPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenFolderService_SetFolderLegalHold0_HTTP_Handler(srv WardenFolderServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetFolderLegalHoldRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenFolderServiceSetFolderLegalHold)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetFolderLegalHold(ctx, req.(*SetFolderLegalHoldRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetFolderLegalHoldResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenFolderService_GetFolderUsage0_HTTP_Handler(srv WardenFolderServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetFolderUsageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenFolderServiceGetFolderUsage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetFolderUsage(ctx, req.(*GetFolderUsageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetFolderUsageResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _WardenFolderService_GetFolderTree0_HTTP_Handler(srv WardenFolderServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetFolderTreeRequest
@@ -210,19 +389,56 @@ func _WardenFolderService_GetFolderTree0_HTTP_Handler(srv WardenFolderServiceHTT
 	}
 }
 
+func _WardenFolderService_ListFolderHistory0_HTTP_Handler(srv WardenFolderServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFolderHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenFolderServiceListFolderHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFolderHistory(ctx, req.(*ListFolderHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListFolderHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type WardenFolderServiceHTTPClient interface {
 	// CreateFolder Create a new folder
 	CreateFolder(ctx context.Context, req *CreateFolderRequest, opts ...http.CallOption) (rsp *CreateFolderResponse, err error)
-	// DeleteFolder Delete a folder (must be empty)
+	// DeleteFolder Move a folder to the trash, or delete it permanently (must be empty
+	// unless forced)
 	DeleteFolder(ctx context.Context, req *DeleteFolderRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// GetFolder Get a folder by ID
 	GetFolder(ctx context.Context, req *GetFolderRequest, opts ...http.CallOption) (rsp *GetFolderResponse, err error)
 	// GetFolderTree Get the folder tree structure
 	GetFolderTree(ctx context.Context, req *GetFolderTreeRequest, opts ...http.CallOption) (rsp *GetFolderTreeResponse, err error)
+	// GetFolderUsage Get the read and write counts of the secrets in a folder, least used
+	// first, to find credentials that are no longer needed
+	GetFolderUsage(ctx context.Context, req *GetFolderUsageRequest, opts ...http.CallOption) (rsp *GetFolderUsageResponse, err error)
+	// ListFolderHistory List who created, renamed, moved and deleted folders, newest first
+	ListFolderHistory(ctx context.Context, req *ListFolderHistoryRequest, opts ...http.CallOption) (rsp *ListFolderHistoryResponse, err error)
 	// ListFolders List folders in a parent folder (or root if no parent specified)
 	ListFolders(ctx context.Context, req *ListFoldersRequest, opts ...http.CallOption) (rsp *ListFoldersResponse, err error)
 	// MoveFolder Move a folder to a new parent
 	MoveFolder(ctx context.Context, req *MoveFolderRequest, opts ...http.CallOption) (rsp *MoveFolderResponse, err error)
+	// RestoreFolder Restore a folder from the trash, with everything deleted along with it
+	RestoreFolder(ctx context.Context, req *RestoreFolderRequest, opts ...http.CallOption) (rsp *RestoreFolderResponse, err error)
+	// SetFolderAccessPolicy Set the network and time restrictions on access to a folder and
+	// everything in it (owner only)
+	SetFolderAccessPolicy(ctx context.Context, req *SetFolderAccessPolicyRequest, opts ...http.CallOption) (rsp *SetFolderAccessPolicyResponse, err error)
+	// SetFolderDefaultPermissions Set the permissions granted on every secret created in the folder
+	SetFolderDefaultPermissions(ctx context.Context, req *SetFolderDefaultPermissionsRequest, opts ...http.CallOption) (rsp *SetFolderDefaultPermissionsResponse, err error)
+	// SetFolderLegalHold Place or lift a legal hold on a folder and everything in it (platform
+	// admins only)
+	SetFolderLegalHold(ctx context.Context, req *SetFolderLegalHoldRequest, opts ...http.CallOption) (rsp *SetFolderLegalHoldResponse, err error)
+	// SetFolderMetadataSchema Set or clear the JSON Schema secret metadata in the folder must satisfy
+	SetFolderMetadataSchema(ctx context.Context, req *SetFolderMetadataSchemaRequest, opts ...http.CallOption) (rsp *SetFolderMetadataSchemaResponse, err error)
 	// UpdateFolder Update folder metadata
 	UpdateFolder(ctx context.Context, req *UpdateFolderRequest, opts ...http.CallOption) (rsp *UpdateFolderResponse, err error)
 }
@@ -249,7 +465,8 @@ func (c *WardenFolderServiceHTTPClientImpl) CreateFolder(ctx context.Context, in
 	return &out, nil
 }
 
-// DeleteFolder Delete a folder (must be empty)
+// DeleteFolder Move a folder to the trash, or delete it permanently (must be empty
+// unless forced)
 func (c *WardenFolderServiceHTTPClientImpl) DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/folders/{id}"
@@ -291,6 +508,35 @@ func (c *WardenFolderServiceHTTPClientImpl) GetFolderTree(ctx context.Context, i
 	return &out, nil
 }
 
+// GetFolderUsage Get the read and write counts of the secrets in a folder, least used
+// first, to find credentials that are no longer needed
+func (c *WardenFolderServiceHTTPClientImpl) GetFolderUsage(ctx context.Context, in *GetFolderUsageRequest, opts ...http.CallOption) (*GetFolderUsageResponse, error) {
+	var out GetFolderUsageResponse
+	pattern := "/v1/folders/{id}/usage"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenFolderServiceGetFolderUsage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListFolderHistory List who created, renamed, moved and deleted folders, newest first
+func (c *WardenFolderServiceHTTPClientImpl) ListFolderHistory(ctx context.Context, in *ListFolderHistoryRequest, opts ...http.CallOption) (*ListFolderHistoryResponse, error) {
+	var out ListFolderHistoryResponse
+	pattern := "/v1/folders/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenFolderServiceListFolderHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListFolders List folders in a parent folder (or root if no parent specified)
 func (c *WardenFolderServiceHTTPClientImpl) ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...http.CallOption) (*ListFoldersResponse, error) {
 	var out ListFoldersResponse
@@ -319,6 +565,78 @@ func (c *WardenFolderServiceHTTPClientImpl) MoveFolder(ctx context.Context, in *
 	return &out, nil
 }
 
+// RestoreFolder Restore a folder from the trash, with everything deleted along with it
+func (c *WardenFolderServiceHTTPClientImpl) RestoreFolder(ctx context.Context, in *RestoreFolderRequest, opts ...http.CallOption) (*RestoreFolderResponse, error) {
+	var out RestoreFolderResponse
+	pattern := "/v1/folders/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenFolderServiceRestoreFolder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetFolderAccessPolicy Set the network and time restrictions on access to a folder and
+// everything in it (owner only)
+func (c *WardenFolderServiceHTTPClientImpl) SetFolderAccessPolicy(ctx context.Context, in *SetFolderAccessPolicyRequest, opts ...http.CallOption) (*SetFolderAccessPolicyResponse, error) {
+	var out SetFolderAccessPolicyResponse
+	pattern := "/v1/folders/{id}/access-policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenFolderServiceSetFolderAccessPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetFolderDefaultPermissions Set the permissions granted on every secret created in the folder
+func (c *WardenFolderServiceHTTPClientImpl) SetFolderDefaultPermissions(ctx context.Context, in *SetFolderDefaultPermissionsRequest, opts ...http.CallOption) (*SetFolderDefaultPermissionsResponse, error) {
+	var out SetFolderDefaultPermissionsResponse
+	pattern := "/v1/folders/{id}/default-permissions"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenFolderServiceSetFolderDefaultPermissions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetFolderLegalHold Place or lift a legal hold on a folder and everything in it (platform
+// admins only)
+func (c *WardenFolderServiceHTTPClientImpl) SetFolderLegalHold(ctx context.Context, in *SetFolderLegalHoldRequest, opts ...http.CallOption) (*SetFolderLegalHoldResponse, error) {
+	var out SetFolderLegalHoldResponse
+	pattern := "/v1/folders/{id}/legal-hold"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenFolderServiceSetFolderLegalHold))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetFolderMetadataSchema Set or clear the JSON Schema secret metadata in the folder must satisfy
+func (c *WardenFolderServiceHTTPClientImpl) SetFolderMetadataSchema(ctx context.Context, in *SetFolderMetadataSchemaRequest, opts ...http.CallOption) (*SetFolderMetadataSchemaResponse, error) {
+	var out SetFolderMetadataSchemaResponse
+	pattern := "/v1/folders/{id}/metadata-schema"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenFolderServiceSetFolderMetadataSchema))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateFolder Update folder metadata
 func (c *WardenFolderServiceHTTPClientImpl) UpdateFolder(ctx context.Context, in *UpdateFolderRequest, opts ...http.CallOption) (*UpdateFolderResponse, error) {
 	var out UpdateFolderResponse
@@ -0,0 +1,270 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/geo_policy.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Password reads from outside the allowed countries are denied; platform
+// admins are let through and audited
+type GeoPolicy struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// ISO 3166-1 alpha-2 country codes; empty allows every country
+	AllowedCountries []string               `protobuf:"bytes,2,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	UpdateTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GeoPolicy) Reset() {
+	*x = GeoPolicy{}
+	mi := &file_warden_service_v1_geo_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPolicy) ProtoMessage() {}
+
+func (x *GeoPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_geo_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPolicy.ProtoReflect.Descriptor instead.
+func (*GeoPolicy) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_geo_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GeoPolicy) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *GeoPolicy) GetAllowedCountries() []string {
+	if x != nil {
+		return x.AllowedCountries
+	}
+	return nil
+}
+
+func (x *GeoPolicy) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type GetGeoPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant to read (defaults to the caller's tenant; other tenants require platform admin)
+	TenantId      *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGeoPolicyRequest) Reset() {
+	*x = GetGeoPolicyRequest{}
+	mi := &file_warden_service_v1_geo_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGeoPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeoPolicyRequest) ProtoMessage() {}
+
+func (x *GetGeoPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_geo_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeoPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetGeoPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_geo_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGeoPolicyRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type SetGeoPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant to configure (defaults to the caller's tenant)
+	TenantId         *uint32  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	AllowedCountries []string `protobuf:"bytes,2,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetGeoPolicyRequest) Reset() {
+	*x = SetGeoPolicyRequest{}
+	mi := &file_warden_service_v1_geo_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGeoPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGeoPolicyRequest) ProtoMessage() {}
+
+func (x *SetGeoPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_geo_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGeoPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetGeoPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_geo_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetGeoPolicyRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *SetGeoPolicyRequest) GetAllowedCountries() []string {
+	if x != nil {
+		return x.AllowedCountries
+	}
+	return nil
+}
+
+var File_warden_service_v1_geo_policy_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_geo_policy_proto_rawDesc = "" +
+	"\n" +
+	"\"warden/service/v1/geo_policy.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa7\x01\n" +
+	"\tGeoPolicy\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12+\n" +
+	"\x11allowed_countries\x18\x02 \x03(\tR\x10allowedCountries\x12@\n" +
+	"\vupdate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"updateTime\x88\x01\x01B\x0e\n" +
+	"\f_update_time\"E\n" +
+	"\x13GetGeoPolicyRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"\x90\x01\n" +
+	"\x13SetGeoPolicyRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12I\n" +
+	"\x11allowed_countries\x18\x02 \x03(\tB\x1c\xbaH\x19\x92\x01\x16\x10\xfa\x01\"\x11r\x0f2\r^[A-Za-z]{2}$R\x10allowedCountriesB\f\n" +
+	"\n" +
+	"_tenant_id2\xf7\x01\n" +
+	"\x16WardenGeoPolicyService\x12l\n" +
+	"\fGetGeoPolicy\x12&.warden.service.v1.GetGeoPolicyRequest\x1a\x1c.warden.service.v1.GeoPolicy\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/geo-policy\x12o\n" +
+	"\fSetGeoPolicy\x12&.warden.service.v1.SetGeoPolicyRequest\x1a\x1c.warden.service.v1.GeoPolicy\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/geo-policyB\xd6\x01\n" +
+	"\x15com.warden.service.v1B\x0eGeoPolicyProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_geo_policy_proto_rawDescOnce sync.Once
+	file_warden_service_v1_geo_policy_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_geo_policy_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_geo_policy_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_geo_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_geo_policy_proto_rawDesc), len(file_warden_service_v1_geo_policy_proto_rawDesc)))
+	})
+	return file_warden_service_v1_geo_policy_proto_rawDescData
+}
+
+var file_warden_service_v1_geo_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_warden_service_v1_geo_policy_proto_goTypes = []any{
+	(*GeoPolicy)(nil),             // 0: warden.service.v1.GeoPolicy
+	(*GetGeoPolicyRequest)(nil),   // 1: warden.service.v1.GetGeoPolicyRequest
+	(*SetGeoPolicyRequest)(nil),   // 2: warden.service.v1.SetGeoPolicyRequest
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_warden_service_v1_geo_policy_proto_depIdxs = []int32{
+	3, // 0: warden.service.v1.GeoPolicy.update_time:type_name -> google.protobuf.Timestamp
+	1, // 1: warden.service.v1.WardenGeoPolicyService.GetGeoPolicy:input_type -> warden.service.v1.GetGeoPolicyRequest
+	2, // 2: warden.service.v1.WardenGeoPolicyService.SetGeoPolicy:input_type -> warden.service.v1.SetGeoPolicyRequest
+	0, // 3: warden.service.v1.WardenGeoPolicyService.GetGeoPolicy:output_type -> warden.service.v1.GeoPolicy
+	0, // 4: warden.service.v1.WardenGeoPolicyService.SetGeoPolicy:output_type -> warden.service.v1.GeoPolicy
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_geo_policy_proto_init() }
+func file_warden_service_v1_geo_policy_proto_init() {
+	if File_warden_service_v1_geo_policy_proto != nil {
+		return
+	}
+	file_warden_service_v1_geo_policy_proto_msgTypes[0].OneofWrappers = []any{}
+	file_warden_service_v1_geo_policy_proto_msgTypes[1].OneofWrappers = []any{}
+	file_warden_service_v1_geo_policy_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_geo_policy_proto_rawDesc), len(file_warden_service_v1_geo_policy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_geo_policy_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_geo_policy_proto_depIdxs,
+		MessageInfos:      file_warden_service_v1_geo_policy_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_geo_policy_proto = out.File
+	file_warden_service_v1_geo_policy_proto_goTypes = nil
+	file_warden_service_v1_geo_policy_proto_depIdxs = nil
+}
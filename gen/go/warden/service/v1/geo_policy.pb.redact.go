@@ -0,0 +1,101 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/geo_policy.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ timestamppb.Timestamp
+)
+
+// RegisterRedactedWardenGeoPolicyServiceServer wraps the WardenGeoPolicyServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenGeoPolicyServiceServer(s grpc.ServiceRegistrar, srv WardenGeoPolicyServiceServer, bypass redact.Bypass) {
+	RegisterWardenGeoPolicyServiceServer(s, RedactedWardenGeoPolicyServiceServer(srv, bypass))
+}
+
+func RedactedWardenGeoPolicyServiceServer(srv WardenGeoPolicyServiceServer, bypass redact.Bypass) WardenGeoPolicyServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenGeoPolicyServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenGeoPolicyServiceServer struct {
+	UnsafeWardenGeoPolicyServiceServer
+	srv    WardenGeoPolicyServiceServer
+	bypass redact.Bypass
+}
+
+// GetGeoPolicy is the redacted wrapper for the actual WardenGeoPolicyServiceServer.GetGeoPolicy method
+// Unary RPC
+func (s *redactedWardenGeoPolicyServiceServer) GetGeoPolicy(ctx context.Context, in *GetGeoPolicyRequest) (*GeoPolicy, error) {
+	res, err := s.srv.GetGeoPolicy(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SetGeoPolicy is the redacted wrapper for the actual WardenGeoPolicyServiceServer.SetGeoPolicy method
+// Unary RPC
+func (s *redactedWardenGeoPolicyServiceServer) SetGeoPolicy(ctx context.Context, in *SetGeoPolicyRequest) (*GeoPolicy, error) {
+	res, err := s.srv.SetGeoPolicy(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for GeoPolicy
+func (x *GeoPolicy) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: AllowedCountries
+
+	// Safe field: UpdateTime
+	return x.String()
+}
+
+// Redact method implementation for GetGeoPolicyRequest
+func (x *GetGeoPolicyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+	return x.String()
+}
+
+// Redact method implementation for SetGeoPolicyRequest
+func (x *SetGeoPolicyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: AllowedCountries
+	return x.String()
+}
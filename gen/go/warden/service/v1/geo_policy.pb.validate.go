@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/geo_policy.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GeoPolicy with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GeoPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GeoPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GeoPolicyMultiError, or nil
+// if none found.
+func (m *GeoPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GeoPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	if m.UpdateTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdateTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GeoPolicyValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GeoPolicyValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GeoPolicyValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GeoPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GeoPolicyMultiError is an error wrapping multiple validation errors returned
+// by GeoPolicy.ValidateAll() if the designated constraints aren't met.
+type GeoPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GeoPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GeoPolicyMultiError) AllErrors() []error { return m }
+
+// GeoPolicyValidationError is the validation error returned by
+// GeoPolicy.Validate if the designated constraints aren't met.
+type GeoPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GeoPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GeoPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GeoPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GeoPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GeoPolicyValidationError) ErrorName() string { return "GeoPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GeoPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGeoPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GeoPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GeoPolicyValidationError{}
+
+// Validate checks the field values on GetGeoPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGeoPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetGeoPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGeoPolicyRequestMultiError, or nil if none found.
+func (m *GetGeoPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetGeoPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return GetGeoPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetGeoPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by GetGeoPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetGeoPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetGeoPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetGeoPolicyRequestMultiError) AllErrors() []error { return m }
+
+// GetGeoPolicyRequestValidationError is the validation error returned by
+// GetGeoPolicyRequest.Validate if the designated constraints aren't met.
+type GetGeoPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGeoPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGeoPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGeoPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGeoPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGeoPolicyRequestValidationError) ErrorName() string {
+	return "GetGeoPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGeoPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGeoPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGeoPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGeoPolicyRequestValidationError{}
+
+// Validate checks the field values on SetGeoPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetGeoPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetGeoPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetGeoPolicyRequestMultiError, or nil if none found.
+func (m *SetGeoPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetGeoPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return SetGeoPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetGeoPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by SetGeoPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetGeoPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetGeoPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetGeoPolicyRequestMultiError) AllErrors() []error { return m }
+
+// SetGeoPolicyRequestValidationError is the validation error returned by
+// SetGeoPolicyRequest.Validate if the designated constraints aren't met.
+type SetGeoPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetGeoPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetGeoPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetGeoPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetGeoPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetGeoPolicyRequestValidationError) ErrorName() string {
+	return "SetGeoPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetGeoPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetGeoPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetGeoPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetGeoPolicyRequestValidationError{}
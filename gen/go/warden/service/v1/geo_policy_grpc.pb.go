@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/geo_policy.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenGeoPolicyService_GetGeoPolicy_FullMethodName = "/warden.service.v1.WardenGeoPolicyService/GetGeoPolicy"
+	WardenGeoPolicyService_SetGeoPolicy_FullMethodName = "/warden.service.v1.WardenGeoPolicyService/SetGeoPolicy"
+)
+
+// WardenGeoPolicyServiceClient is the client API for WardenGeoPolicyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Geo Policy Service - per-tenant countries passwords may be read from
+type WardenGeoPolicyServiceClient interface {
+	// Get the geo policy of a tenant
+	GetGeoPolicy(ctx context.Context, in *GetGeoPolicyRequest, opts ...grpc.CallOption) (*GeoPolicy, error)
+	// Replace the geo policy of a tenant (platform admin only)
+	SetGeoPolicy(ctx context.Context, in *SetGeoPolicyRequest, opts ...grpc.CallOption) (*GeoPolicy, error)
+}
+
+type wardenGeoPolicyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenGeoPolicyServiceClient(cc grpc.ClientConnInterface) WardenGeoPolicyServiceClient {
+	return &wardenGeoPolicyServiceClient{cc}
+}
+
+func (c *wardenGeoPolicyServiceClient) GetGeoPolicy(ctx context.Context, in *GetGeoPolicyRequest, opts ...grpc.CallOption) (*GeoPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeoPolicy)
+	err := c.cc.Invoke(ctx, WardenGeoPolicyService_GetGeoPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenGeoPolicyServiceClient) SetGeoPolicy(ctx context.Context, in *SetGeoPolicyRequest, opts ...grpc.CallOption) (*GeoPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeoPolicy)
+	err := c.cc.Invoke(ctx, WardenGeoPolicyService_SetGeoPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenGeoPolicyServiceServer is the server API for WardenGeoPolicyService service.
+// All implementations must embed UnimplementedWardenGeoPolicyServiceServer
+// for forward compatibility.
+//
+// Geo Policy Service - per-tenant countries passwords may be read from
+type WardenGeoPolicyServiceServer interface {
+	// Get the geo policy of a tenant
+	GetGeoPolicy(context.Context, *GetGeoPolicyRequest) (*GeoPolicy, error)
+	// Replace the geo policy of a tenant (platform admin only)
+	SetGeoPolicy(context.Context, *SetGeoPolicyRequest) (*GeoPolicy, error)
+	mustEmbedUnimplementedWardenGeoPolicyServiceServer()
+}
+
+// UnimplementedWardenGeoPolicyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenGeoPolicyServiceServer struct{}
+
+func (UnimplementedWardenGeoPolicyServiceServer) GetGeoPolicy(context.Context, *GetGeoPolicyRequest) (*GeoPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGeoPolicy not implemented")
+}
+func (UnimplementedWardenGeoPolicyServiceServer) SetGeoPolicy(context.Context, *SetGeoPolicyRequest) (*GeoPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGeoPolicy not implemented")
+}
+func (UnimplementedWardenGeoPolicyServiceServer) mustEmbedUnimplementedWardenGeoPolicyServiceServer() {
+}
+func (UnimplementedWardenGeoPolicyServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWardenGeoPolicyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenGeoPolicyServiceServer will
+// result in compilation errors.
+type UnsafeWardenGeoPolicyServiceServer interface {
+	mustEmbedUnimplementedWardenGeoPolicyServiceServer()
+}
+
+func RegisterWardenGeoPolicyServiceServer(s grpc.ServiceRegistrar, srv WardenGeoPolicyServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenGeoPolicyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenGeoPolicyService_ServiceDesc, srv)
+}
+
+func _WardenGeoPolicyService_GetGeoPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGeoPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenGeoPolicyServiceServer).GetGeoPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenGeoPolicyService_GetGeoPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenGeoPolicyServiceServer).GetGeoPolicy(ctx, req.(*GetGeoPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenGeoPolicyService_SetGeoPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGeoPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenGeoPolicyServiceServer).SetGeoPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenGeoPolicyService_SetGeoPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenGeoPolicyServiceServer).SetGeoPolicy(ctx, req.(*SetGeoPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenGeoPolicyService_ServiceDesc is the grpc.ServiceDesc for WardenGeoPolicyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenGeoPolicyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenGeoPolicyService",
+	HandlerType: (*WardenGeoPolicyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGeoPolicy",
+			Handler:    _WardenGeoPolicyService_GetGeoPolicy_Handler,
+		},
+		{
+			MethodName: "SetGeoPolicy",
+			Handler:    _WardenGeoPolicyService_SetGeoPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/geo_policy.proto",
+}
@@ -0,0 +1,120 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/geo_policy.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenGeoPolicyServiceGetGeoPolicy = "/warden.service.v1.WardenGeoPolicyService/GetGeoPolicy"
+const OperationWardenGeoPolicyServiceSetGeoPolicy = "/warden.service.v1.WardenGeoPolicyService/SetGeoPolicy"
+
+type WardenGeoPolicyServiceHTTPServer interface {
+	// GetGeoPolicy Get the geo policy of a tenant
+	GetGeoPolicy(context.Context, *GetGeoPolicyRequest) (*GeoPolicy, error)
+	// SetGeoPolicy Replace the geo policy of a tenant (platform admin only)
+	SetGeoPolicy(context.Context, *SetGeoPolicyRequest) (*GeoPolicy, error)
+}
+
+func RegisterWardenGeoPolicyServiceHTTPServer(s *http.Server, srv WardenGeoPolicyServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/geo-policy", _WardenGeoPolicyService_GetGeoPolicy0_HTTP_Handler(srv))
+	r.PUT("/v1/geo-policy", _WardenGeoPolicyService_SetGeoPolicy0_HTTP_Handler(srv))
+}
+
+func _WardenGeoPolicyService_GetGeoPolicy0_HTTP_Handler(srv WardenGeoPolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetGeoPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenGeoPolicyServiceGetGeoPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetGeoPolicy(ctx, req.(*GetGeoPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GeoPolicy)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenGeoPolicyService_SetGeoPolicy0_HTTP_Handler(srv WardenGeoPolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetGeoPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenGeoPolicyServiceSetGeoPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetGeoPolicy(ctx, req.(*SetGeoPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GeoPolicy)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenGeoPolicyServiceHTTPClient interface {
+	// GetGeoPolicy Get the geo policy of a tenant
+	GetGeoPolicy(ctx context.Context, req *GetGeoPolicyRequest, opts ...http.CallOption) (rsp *GeoPolicy, err error)
+	// SetGeoPolicy Replace the geo policy of a tenant (platform admin only)
+	SetGeoPolicy(ctx context.Context, req *SetGeoPolicyRequest, opts ...http.CallOption) (rsp *GeoPolicy, err error)
+}
+
+type WardenGeoPolicyServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenGeoPolicyServiceHTTPClient(client *http.Client) WardenGeoPolicyServiceHTTPClient {
+	return &WardenGeoPolicyServiceHTTPClientImpl{client}
+}
+
+// GetGeoPolicy Get the geo policy of a tenant
+func (c *WardenGeoPolicyServiceHTTPClientImpl) GetGeoPolicy(ctx context.Context, in *GetGeoPolicyRequest, opts ...http.CallOption) (*GeoPolicy, error) {
+	var out GeoPolicy
+	pattern := "/v1/geo-policy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenGeoPolicyServiceGetGeoPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetGeoPolicy Replace the geo policy of a tenant (platform admin only)
+func (c *WardenGeoPolicyServiceHTTPClientImpl) SetGeoPolicy(ctx context.Context, in *SetGeoPolicyRequest, opts ...http.CallOption) (*GeoPolicy, error) {
+	var out GeoPolicy
+	pattern := "/v1/geo-policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenGeoPolicyServiceSetGeoPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
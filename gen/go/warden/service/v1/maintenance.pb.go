@@ -0,0 +1,1447 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/maintenance.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CleanupOrphansRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restrict to one tenant (all tenants when unset)
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Only report what would be deleted
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CleanupOrphansRequest) Reset() {
+	*x = CleanupOrphansRequest{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CleanupOrphansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupOrphansRequest) ProtoMessage() {}
+
+func (x *CleanupOrphansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupOrphansRequest.ProtoReflect.Descriptor instead.
+func (*CleanupOrphansRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CleanupOrphansRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *CleanupOrphansRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CleanupOrphansResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Permissions on folders that no longer exist
+	FolderPermissions uint32 `protobuf:"varint,1,opt,name=folder_permissions,json=folderPermissions,proto3" json:"folder_permissions,omitempty"`
+	// Permissions on secrets that no longer exist
+	SecretPermissions uint32 `protobuf:"varint,2,opt,name=secret_permissions,json=secretPermissions,proto3" json:"secret_permissions,omitempty"`
+	DryRun            bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CleanupOrphansResponse) Reset() {
+	*x = CleanupOrphansResponse{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CleanupOrphansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupOrphansResponse) ProtoMessage() {}
+
+func (x *CleanupOrphansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupOrphansResponse.ProtoReflect.Descriptor instead.
+func (*CleanupOrphansResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CleanupOrphansResponse) GetFolderPermissions() uint32 {
+	if x != nil {
+		return x.FolderPermissions
+	}
+	return 0
+}
+
+func (x *CleanupOrphansResponse) GetSecretPermissions() uint32 {
+	if x != nil {
+		return x.SecretPermissions
+	}
+	return 0
+}
+
+func (x *CleanupOrphansResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RepairFolderPathsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restrict to one tenant (all tenants when unset)
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Only report what would be changed
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepairFolderPathsRequest) Reset() {
+	*x = RepairFolderPathsRequest{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairFolderPathsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairFolderPathsRequest) ProtoMessage() {}
+
+func (x *RepairFolderPathsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairFolderPathsRequest.ProtoReflect.Descriptor instead.
+func (*RepairFolderPathsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RepairFolderPathsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *RepairFolderPathsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RepairFolderPathsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FoldersChecked uint32                 `protobuf:"varint,1,opt,name=folders_checked,json=foldersChecked,proto3" json:"folders_checked,omitempty"`
+	// Folders whose stored path or depth did not match the parent chain
+	RepairedFolderIds []string `protobuf:"bytes,2,rep,name=repaired_folder_ids,json=repairedFolderIds,proto3" json:"repaired_folder_ids,omitempty"`
+	// Folders whose parent chain is broken or cyclic; left untouched
+	UnresolvedFolderIds []string `protobuf:"bytes,3,rep,name=unresolved_folder_ids,json=unresolvedFolderIds,proto3" json:"unresolved_folder_ids,omitempty"`
+	DryRun              bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RepairFolderPathsResponse) Reset() {
+	*x = RepairFolderPathsResponse{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairFolderPathsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairFolderPathsResponse) ProtoMessage() {}
+
+func (x *RepairFolderPathsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairFolderPathsResponse.ProtoReflect.Descriptor instead.
+func (*RepairFolderPathsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RepairFolderPathsResponse) GetFoldersChecked() uint32 {
+	if x != nil {
+		return x.FoldersChecked
+	}
+	return 0
+}
+
+func (x *RepairFolderPathsResponse) GetRepairedFolderIds() []string {
+	if x != nil {
+		return x.RepairedFolderIds
+	}
+	return nil
+}
+
+func (x *RepairFolderPathsResponse) GetUnresolvedFolderIds() []string {
+	if x != nil {
+		return x.UnresolvedFolderIds
+	}
+	return nil
+}
+
+func (x *RepairFolderPathsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RecomputeStatisticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeStatisticsRequest) Reset() {
+	*x = RecomputeStatisticsRequest{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeStatisticsRequest) ProtoMessage() {}
+
+func (x *RecomputeStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{4}
+}
+
+type RecomputeStatisticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeStatisticsResponse) Reset() {
+	*x = RecomputeStatisticsResponse{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeStatisticsResponse) ProtoMessage() {}
+
+func (x *RecomputeStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{5}
+}
+
+type PurgeTrashRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restrict to one tenant (all tenants when unset)
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Only purge secrets and folders deleted at least this many days ago
+	// (default 30, 0 purges everything)
+	OlderThanDays *uint32 `protobuf:"varint,2,opt,name=older_than_days,json=olderThanDays,proto3,oneof" json:"older_than_days,omitempty"`
+	// Only report what would be purged
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashRequest) Reset() {
+	*x = PurgeTrashRequest{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashRequest) ProtoMessage() {}
+
+func (x *PurgeTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTrashRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PurgeTrashRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *PurgeTrashRequest) GetOlderThanDays() uint32 {
+	if x != nil && x.OlderThanDays != nil {
+		return *x.OlderThanDays
+	}
+	return 0
+}
+
+func (x *PurgeTrashRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PurgeTrashResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PurgedSecretIds []string               `protobuf:"bytes,1,rep,name=purged_secret_ids,json=purgedSecretIds,proto3" json:"purged_secret_ids,omitempty"`
+	// Secrets that could not be deleted; their Vault data may already be gone
+	FailedSecretIds []string `protobuf:"bytes,2,rep,name=failed_secret_ids,json=failedSecretIds,proto3" json:"failed_secret_ids,omitempty"`
+	DryRun          bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Folders deleted from the trash, with the folders deleted along with them
+	PurgedFolderIds []string `protobuf:"bytes,4,rep,name=purged_folder_ids,json=purgedFolderIds,proto3" json:"purged_folder_ids,omitempty"`
+	FailedFolderIds []string `protobuf:"bytes,5,rep,name=failed_folder_ids,json=failedFolderIds,proto3" json:"failed_folder_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PurgeTrashResponse) Reset() {
+	*x = PurgeTrashResponse{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashResponse) ProtoMessage() {}
+
+func (x *PurgeTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTrashResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PurgeTrashResponse) GetPurgedSecretIds() []string {
+	if x != nil {
+		return x.PurgedSecretIds
+	}
+	return nil
+}
+
+func (x *PurgeTrashResponse) GetFailedSecretIds() []string {
+	if x != nil {
+		return x.FailedSecretIds
+	}
+	return nil
+}
+
+func (x *PurgeTrashResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *PurgeTrashResponse) GetPurgedFolderIds() []string {
+	if x != nil {
+		return x.PurgedFolderIds
+	}
+	return nil
+}
+
+func (x *PurgeTrashResponse) GetFailedFolderIds() []string {
+	if x != nil {
+		return x.FailedFolderIds
+	}
+	return nil
+}
+
+type SyncVersionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restrict to one tenant (all tenants when unset)
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Restrict to one secret
+	SecretId *string `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3,oneof" json:"secret_id,omitempty"`
+	// Only report what would be changed
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncVersionsRequest) Reset() {
+	*x = SyncVersionsRequest{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncVersionsRequest) ProtoMessage() {}
+
+func (x *SyncVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncVersionsRequest.ProtoReflect.Descriptor instead.
+func (*SyncVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SyncVersionsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *SyncVersionsRequest) GetSecretId() string {
+	if x != nil && x.SecretId != nil {
+		return *x.SecretId
+	}
+	return ""
+}
+
+func (x *SyncVersionsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SyncVersionsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SecretsChecked uint32                 `protobuf:"varint,1,opt,name=secrets_checked,json=secretsChecked,proto3" json:"secrets_checked,omitempty"`
+	// Secrets whose version records were changed
+	RepairedSecretIds []string `protobuf:"bytes,2,rep,name=repaired_secret_ids,json=repairedSecretIds,proto3" json:"repaired_secret_ids,omitempty"`
+	// Records created for readable Vault versions that had none
+	VersionsCreated uint32 `protobuf:"varint,3,opt,name=versions_created,json=versionsCreated,proto3" json:"versions_created,omitempty"`
+	// Records removed because their Vault version is gone or destroyed
+	VersionsRemoved uint32 `protobuf:"varint,4,opt,name=versions_removed,json=versionsRemoved,proto3" json:"versions_removed,omitempty"`
+	// Secrets whose Vault metadata could not be read; left untouched
+	FailedSecretIds []string `protobuf:"bytes,5,rep,name=failed_secret_ids,json=failedSecretIds,proto3" json:"failed_secret_ids,omitempty"`
+	DryRun          bool     `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SyncVersionsResponse) Reset() {
+	*x = SyncVersionsResponse{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncVersionsResponse) ProtoMessage() {}
+
+func (x *SyncVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncVersionsResponse.ProtoReflect.Descriptor instead.
+func (*SyncVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SyncVersionsResponse) GetSecretsChecked() uint32 {
+	if x != nil {
+		return x.SecretsChecked
+	}
+	return 0
+}
+
+func (x *SyncVersionsResponse) GetRepairedSecretIds() []string {
+	if x != nil {
+		return x.RepairedSecretIds
+	}
+	return nil
+}
+
+func (x *SyncVersionsResponse) GetVersionsCreated() uint32 {
+	if x != nil {
+		return x.VersionsCreated
+	}
+	return 0
+}
+
+func (x *SyncVersionsResponse) GetVersionsRemoved() uint32 {
+	if x != nil {
+		return x.VersionsRemoved
+	}
+	return 0
+}
+
+func (x *SyncVersionsResponse) GetFailedSecretIds() []string {
+	if x != nil {
+		return x.FailedSecretIds
+	}
+	return nil
+}
+
+func (x *SyncVersionsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type MigrateChecksumsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restrict to one tenant (all tenants when unset)
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Restrict to one secret
+	SecretId *string `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3,oneof" json:"secret_id,omitempty"`
+	// Maximum number of versions to migrate in this run (default 1000)
+	Limit *uint32 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Only report what would be migrated
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateChecksumsRequest) Reset() {
+	*x = MigrateChecksumsRequest{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateChecksumsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateChecksumsRequest) ProtoMessage() {}
+
+func (x *MigrateChecksumsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateChecksumsRequest.ProtoReflect.Descriptor instead.
+func (*MigrateChecksumsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MigrateChecksumsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *MigrateChecksumsRequest) GetSecretId() string {
+	if x != nil && x.SecretId != nil {
+		return *x.SecretId
+	}
+	return ""
+}
+
+func (x *MigrateChecksumsRequest) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *MigrateChecksumsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type MigrateChecksumsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Algorithm the checksums were migrated to
+	Algorithm        string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	VersionsMigrated uint32 `protobuf:"varint,2,opt,name=versions_migrated,json=versionsMigrated,proto3" json:"versions_migrated,omitempty"`
+	// Versions whose password could not be read from Vault (deleted or
+	// destroyed); their checksums are left as they are
+	VersionsUnreadable uint32 `protobuf:"varint,3,opt,name=versions_unreadable,json=versionsUnreadable,proto3" json:"versions_unreadable,omitempty"`
+	// Versions whose checksum could not be updated
+	FailedVersionIds []uint32 `protobuf:"varint,4,rep,packed,name=failed_version_ids,json=failedVersionIds,proto3" json:"failed_version_ids,omitempty"`
+	// Versions still on another algorithm after this run, including the
+	// unreadable ones; run again while it drops
+	VersionsRemaining uint32 `protobuf:"varint,5,opt,name=versions_remaining,json=versionsRemaining,proto3" json:"versions_remaining,omitempty"`
+	DryRun            bool   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MigrateChecksumsResponse) Reset() {
+	*x = MigrateChecksumsResponse{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateChecksumsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateChecksumsResponse) ProtoMessage() {}
+
+func (x *MigrateChecksumsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateChecksumsResponse.ProtoReflect.Descriptor instead.
+func (*MigrateChecksumsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MigrateChecksumsResponse) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *MigrateChecksumsResponse) GetVersionsMigrated() uint32 {
+	if x != nil {
+		return x.VersionsMigrated
+	}
+	return 0
+}
+
+func (x *MigrateChecksumsResponse) GetVersionsUnreadable() uint32 {
+	if x != nil {
+		return x.VersionsUnreadable
+	}
+	return 0
+}
+
+func (x *MigrateChecksumsResponse) GetFailedVersionIds() []uint32 {
+	if x != nil {
+		return x.FailedVersionIds
+	}
+	return nil
+}
+
+func (x *MigrateChecksumsResponse) GetVersionsRemaining() uint32 {
+	if x != nil {
+		return x.VersionsRemaining
+	}
+	return 0
+}
+
+func (x *MigrateChecksumsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RotateFieldKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only wrap the existing field keys again, after the Transit key was
+	// rotated in Vault, without creating a new one
+	RewrapOnly    bool `protobuf:"varint,1,opt,name=rewrap_only,json=rewrapOnly,proto3" json:"rewrap_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateFieldKeyRequest) Reset() {
+	*x = RotateFieldKeyRequest{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateFieldKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateFieldKeyRequest) ProtoMessage() {}
+
+func (x *RotateFieldKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateFieldKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateFieldKeyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RotateFieldKeyRequest) GetRewrapOnly() bool {
+	if x != nil {
+		return x.RewrapOnly
+	}
+	return false
+}
+
+type RotateFieldKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Field key encrypting new values
+	KeyId uint32 `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// Field keys wrapped again with the latest Transit key version
+	KeysRewrapped uint32 `protobuf:"varint,2,opt,name=keys_rewrapped,json=keysRewrapped,proto3" json:"keys_rewrapped,omitempty"`
+	// Secrets under an older field key, to re-encrypt with ReencryptFields
+	SecretsOutdated uint32 `protobuf:"varint,3,opt,name=secrets_outdated,json=secretsOutdated,proto3" json:"secrets_outdated,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RotateFieldKeyResponse) Reset() {
+	*x = RotateFieldKeyResponse{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateFieldKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateFieldKeyResponse) ProtoMessage() {}
+
+func (x *RotateFieldKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateFieldKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateFieldKeyResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RotateFieldKeyResponse) GetKeyId() uint32 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *RotateFieldKeyResponse) GetKeysRewrapped() uint32 {
+	if x != nil {
+		return x.KeysRewrapped
+	}
+	return 0
+}
+
+func (x *RotateFieldKeyResponse) GetSecretsOutdated() uint32 {
+	if x != nil {
+		return x.SecretsOutdated
+	}
+	return 0
+}
+
+type ReencryptFieldsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restrict to one tenant (all tenants when unset)
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Maximum number of secrets to re-encrypt in this run (default 1000)
+	Limit *uint32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Only report what would be re-encrypted
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReencryptFieldsRequest) Reset() {
+	*x = ReencryptFieldsRequest{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReencryptFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReencryptFieldsRequest) ProtoMessage() {}
+
+func (x *ReencryptFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReencryptFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ReencryptFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReencryptFieldsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *ReencryptFieldsRequest) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ReencryptFieldsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ReencryptFieldsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Field key the secrets were encrypted with (0 when encryption of new
+	// values is off and they were decrypted)
+	KeyId              uint32   `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	SecretsReencrypted uint32   `protobuf:"varint,2,opt,name=secrets_reencrypted,json=secretsReencrypted,proto3" json:"secrets_reencrypted,omitempty"`
+	FailedSecretIds    []string `protobuf:"bytes,3,rep,name=failed_secret_ids,json=failedSecretIds,proto3" json:"failed_secret_ids,omitempty"`
+	// Secrets still under another key after this run; run again while it drops
+	SecretsRemaining uint32 `protobuf:"varint,4,opt,name=secrets_remaining,json=secretsRemaining,proto3" json:"secrets_remaining,omitempty"`
+	DryRun           bool   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReencryptFieldsResponse) Reset() {
+	*x = ReencryptFieldsResponse{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReencryptFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReencryptFieldsResponse) ProtoMessage() {}
+
+func (x *ReencryptFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReencryptFieldsResponse.ProtoReflect.Descriptor instead.
+func (*ReencryptFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReencryptFieldsResponse) GetKeyId() uint32 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *ReencryptFieldsResponse) GetSecretsReencrypted() uint32 {
+	if x != nil {
+		return x.SecretsReencrypted
+	}
+	return 0
+}
+
+func (x *ReencryptFieldsResponse) GetFailedSecretIds() []string {
+	if x != nil {
+		return x.FailedSecretIds
+	}
+	return nil
+}
+
+func (x *ReencryptFieldsResponse) GetSecretsRemaining() uint32 {
+	if x != nil {
+		return x.SecretsRemaining
+	}
+	return 0
+}
+
+func (x *ReencryptFieldsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PurgeTenantDataRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Must repeat tenant_id unless dry_run is set, so a purge is never run by mistake
+	ConfirmTenantId uint32 `protobuf:"varint,2,opt,name=confirm_tenant_id,json=confirmTenantId,proto3" json:"confirm_tenant_id,omitempty"`
+	// Only report what would be deleted
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTenantDataRequest) Reset() {
+	*x = PurgeTenantDataRequest{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTenantDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTenantDataRequest) ProtoMessage() {}
+
+func (x *PurgeTenantDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTenantDataRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTenantDataRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PurgeTenantDataRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *PurgeTenantDataRequest) GetConfirmTenantId() uint32 {
+	if x != nil {
+		return x.ConfirmTenantId
+	}
+	return 0
+}
+
+func (x *PurgeTenantDataRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PurgeTenantDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Steps in the order they ran; a failed step ends the purge
+	Steps []*PurgeTenantDataStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Every step succeeded and nothing of the tenant is left
+	Completed     bool `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTenantDataResponse) Reset() {
+	*x = PurgeTenantDataResponse{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTenantDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTenantDataResponse) ProtoMessage() {}
+
+func (x *PurgeTenantDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTenantDataResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTenantDataResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PurgeTenantDataResponse) GetSteps() []*PurgeTenantDataStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *PurgeTenantDataResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *PurgeTenantDataResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PurgeTenantDataStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Table purged, or "vault" for the passwords and TOTP seeds of the secrets
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Rows (or secrets in Vault) deleted, or that would be deleted
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Secrets whose Vault data could not be destroyed
+	Failed uint32 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Why the step failed, empty on success
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTenantDataStep) Reset() {
+	*x = PurgeTenantDataStep{}
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTenantDataStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTenantDataStep) ProtoMessage() {}
+
+func (x *PurgeTenantDataStep) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_maintenance_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTenantDataStep.ProtoReflect.Descriptor instead.
+func (*PurgeTenantDataStep) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_maintenance_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PurgeTenantDataStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PurgeTenantDataStep) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PurgeTenantDataStep) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *PurgeTenantDataStep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_warden_service_v1_maintenance_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_maintenance_proto_rawDesc = "" +
+	"\n" +
+	"#warden/service/v1/maintenance.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"`\n" +
+	"\x15CleanupOrphansRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRunB\f\n" +
+	"\n" +
+	"_tenant_id\"\x8f\x01\n" +
+	"\x16CleanupOrphansResponse\x12-\n" +
+	"\x12folder_permissions\x18\x01 \x01(\rR\x11folderPermissions\x12-\n" +
+	"\x12secret_permissions\x18\x02 \x01(\rR\x11secretPermissions\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"c\n" +
+	"\x18RepairFolderPathsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRunB\f\n" +
+	"\n" +
+	"_tenant_id\"\xc1\x01\n" +
+	"\x19RepairFolderPathsResponse\x12'\n" +
+	"\x0ffolders_checked\x18\x01 \x01(\rR\x0efoldersChecked\x12.\n" +
+	"\x13repaired_folder_ids\x18\x02 \x03(\tR\x11repairedFolderIds\x122\n" +
+	"\x15unresolved_folder_ids\x18\x03 \x03(\tR\x13unresolvedFolderIds\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\x1c\n" +
+	"\x1aRecomputeStatisticsRequest\"\x1d\n" +
+	"\x1bRecomputeStatisticsResponse\"\xa7\x01\n" +
+	"\x11PurgeTrashRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x125\n" +
+	"\x0folder_than_days\x18\x02 \x01(\rB\b\xbaH\x05*\x03\x18\xc2\x1cH\x01R\rolderThanDays\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRunB\f\n" +
+	"\n" +
+	"_tenant_idB\x12\n" +
+	"\x10_older_than_days\"\xdd\x01\n" +
+	"\x12PurgeTrashResponse\x12*\n" +
+	"\x11purged_secret_ids\x18\x01 \x03(\tR\x0fpurgedSecretIds\x12*\n" +
+	"\x11failed_secret_ids\x18\x02 \x03(\tR\x0ffailedSecretIds\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12*\n" +
+	"\x11purged_folder_ids\x18\x04 \x03(\tR\x0fpurgedFolderIds\x12*\n" +
+	"\x11failed_folder_ids\x18\x05 \x03(\tR\x0ffailedFolderIds\"\xa9\x01\n" +
+	"\x13SyncVersionsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12;\n" +
+	"\tsecret_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x01R\bsecretId\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRunB\f\n" +
+	"\n" +
+	"_tenant_idB\f\n" +
+	"\n" +
+	"_secret_id\"\x8a\x02\n" +
+	"\x14SyncVersionsResponse\x12'\n" +
+	"\x0fsecrets_checked\x18\x01 \x01(\rR\x0esecretsChecked\x12.\n" +
+	"\x13repaired_secret_ids\x18\x02 \x03(\tR\x11repairedSecretIds\x12)\n" +
+	"\x10versions_created\x18\x03 \x01(\rR\x0fversionsCreated\x12)\n" +
+	"\x10versions_removed\x18\x04 \x01(\rR\x0fversionsRemoved\x12*\n" +
+	"\x11failed_secret_ids\x18\x05 \x03(\tR\x0ffailedSecretIds\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"\xdf\x01\n" +
+	"\x17MigrateChecksumsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12;\n" +
+	"\tsecret_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x01R\bsecretId\x88\x01\x01\x12&\n" +
+	"\x05limit\x18\x03 \x01(\rB\v\xbaH\b*\x06\x18\xa0\x8d\x06(\x01H\x02R\x05limit\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRunB\f\n" +
+	"\n" +
+	"_tenant_idB\f\n" +
+	"\n" +
+	"_secret_idB\b\n" +
+	"\x06_limit\"\x8c\x02\n" +
+	"\x18MigrateChecksumsResponse\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12+\n" +
+	"\x11versions_migrated\x18\x02 \x01(\rR\x10versionsMigrated\x12/\n" +
+	"\x13versions_unreadable\x18\x03 \x01(\rR\x12versionsUnreadable\x12,\n" +
+	"\x12failed_version_ids\x18\x04 \x03(\rR\x10failedVersionIds\x12-\n" +
+	"\x12versions_remaining\x18\x05 \x01(\rR\x11versionsRemaining\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"8\n" +
+	"\x15RotateFieldKeyRequest\x12\x1f\n" +
+	"\vrewrap_only\x18\x01 \x01(\bR\n" +
+	"rewrapOnly\"\x81\x01\n" +
+	"\x16RotateFieldKeyResponse\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\rR\x05keyId\x12%\n" +
+	"\x0ekeys_rewrapped\x18\x02 \x01(\rR\rkeysRewrapped\x12)\n" +
+	"\x10secrets_outdated\x18\x03 \x01(\rR\x0fsecretsOutdated\"\x93\x01\n" +
+	"\x16ReencryptFieldsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12&\n" +
+	"\x05limit\x18\x02 \x01(\rB\v\xbaH\b*\x06\x18\xa0\x8d\x06(\x01H\x01R\x05limit\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRunB\f\n" +
+	"\n" +
+	"_tenant_idB\b\n" +
+	"\x06_limit\"\xd3\x01\n" +
+	"\x17ReencryptFieldsResponse\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\rR\x05keyId\x12/\n" +
+	"\x13secrets_reencrypted\x18\x02 \x01(\rR\x12secretsReencrypted\x12*\n" +
+	"\x11failed_secret_ids\x18\x03 \x03(\tR\x0ffailedSecretIds\x12+\n" +
+	"\x11secrets_remaining\x18\x04 \x01(\rR\x10secretsRemaining\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"\x83\x01\n" +
+	"\x16PurgeTenantDataRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\btenantId\x12*\n" +
+	"\x11confirm_tenant_id\x18\x02 \x01(\rR\x0fconfirmTenantId\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\x8e\x01\n" +
+	"\x17PurgeTenantDataResponse\x12<\n" +
+	"\x05steps\x18\x01 \x03(\v2&.warden.service.v1.PurgeTenantDataStepR\x05steps\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\bR\tcompleted\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"m\n" +
+	"\x13PurgeTenantDataStep\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\rR\x06failed\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error2\xf1\n" +
+	"\n" +
+	"\x18WardenMaintenanceService\x12\x91\x01\n" +
+	"\x0eCleanupOrphans\x12(.warden.service.v1.CleanupOrphansRequest\x1a).warden.service.v1.CleanupOrphansResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/maintenance/orphans:cleanup\x12\x99\x01\n" +
+	"\x11RepairFolderPaths\x12+.warden.service.v1.RepairFolderPathsRequest\x1a,.warden.service.v1.RepairFolderPathsResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/maintenance/folders:repair\x12\xa5\x01\n" +
+	"\x13RecomputeStatistics\x12-.warden.service.v1.RecomputeStatisticsRequest\x1a..warden.service.v1.RecomputeStatisticsResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/maintenance/statistics:recompute\x12\x81\x01\n" +
+	"\n" +
+	"PurgeTrash\x12$.warden.service.v1.PurgeTrashRequest\x1a%.warden.service.v1.PurgeTrashResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/maintenance/trash:purge\x12\x89\x01\n" +
+	"\fSyncVersions\x12&.warden.service.v1.SyncVersionsRequest\x1a'.warden.service.v1.SyncVersionsResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/maintenance/versions:sync\x12\x99\x01\n" +
+	"\x10MigrateChecksums\x12*.warden.service.v1.MigrateChecksumsRequest\x1a+.warden.service.v1.MigrateChecksumsResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/maintenance/checksums:migrate\x12\x93\x01\n" +
+	"\x0eRotateFieldKey\x12(.warden.service.v1.RotateFieldKeyRequest\x1a).warden.service.v1.RotateFieldKeyResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/maintenance/field-keys:rotate\x12\x99\x01\n" +
+	"\x0fReencryptFields\x12).warden.service.v1.ReencryptFieldsRequest\x1a*.warden.service.v1.ReencryptFieldsResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/maintenance/field-keys:reencrypt\x12\x9e\x01\n" +
+	"\x0fPurgeTenantData\x12).warden.service.v1.PurgeTenantDataRequest\x1a*.warden.service.v1.PurgeTenantDataResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/maintenance/tenants/{tenant_id}:purgeB\xd8\x01\n" +
+	"\x15com.warden.service.v1B\x10MaintenanceProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_maintenance_proto_rawDescOnce sync.Once
+	file_warden_service_v1_maintenance_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_maintenance_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_maintenance_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_maintenance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_maintenance_proto_rawDesc), len(file_warden_service_v1_maintenance_proto_rawDesc)))
+	})
+	return file_warden_service_v1_maintenance_proto_rawDescData
+}
+
+var file_warden_service_v1_maintenance_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_warden_service_v1_maintenance_proto_goTypes = []any{
+	(*CleanupOrphansRequest)(nil),       // 0: warden.service.v1.CleanupOrphansRequest
+	(*CleanupOrphansResponse)(nil),      // 1: warden.service.v1.CleanupOrphansResponse
+	(*RepairFolderPathsRequest)(nil),    // 2: warden.service.v1.RepairFolderPathsRequest
+	(*RepairFolderPathsResponse)(nil),   // 3: warden.service.v1.RepairFolderPathsResponse
+	(*RecomputeStatisticsRequest)(nil),  // 4: warden.service.v1.RecomputeStatisticsRequest
+	(*RecomputeStatisticsResponse)(nil), // 5: warden.service.v1.RecomputeStatisticsResponse
+	(*PurgeTrashRequest)(nil),           // 6: warden.service.v1.PurgeTrashRequest
+	(*PurgeTrashResponse)(nil),          // 7: warden.service.v1.PurgeTrashResponse
+	(*SyncVersionsRequest)(nil),         // 8: warden.service.v1.SyncVersionsRequest
+	(*SyncVersionsResponse)(nil),        // 9: warden.service.v1.SyncVersionsResponse
+	(*MigrateChecksumsRequest)(nil),     // 10: warden.service.v1.MigrateChecksumsRequest
+	(*MigrateChecksumsResponse)(nil),    // 11: warden.service.v1.MigrateChecksumsResponse
+	(*RotateFieldKeyRequest)(nil),       // 12: warden.service.v1.RotateFieldKeyRequest
+	(*RotateFieldKeyResponse)(nil),      // 13: warden.service.v1.RotateFieldKeyResponse
+	(*ReencryptFieldsRequest)(nil),      // 14: warden.service.v1.ReencryptFieldsRequest
+	(*ReencryptFieldsResponse)(nil),     // 15: warden.service.v1.ReencryptFieldsResponse
+	(*PurgeTenantDataRequest)(nil),      // 16: warden.service.v1.PurgeTenantDataRequest
+	(*PurgeTenantDataResponse)(nil),     // 17: warden.service.v1.PurgeTenantDataResponse
+	(*PurgeTenantDataStep)(nil),         // 18: warden.service.v1.PurgeTenantDataStep
+}
+var file_warden_service_v1_maintenance_proto_depIdxs = []int32{
+	18, // 0: warden.service.v1.PurgeTenantDataResponse.steps:type_name -> warden.service.v1.PurgeTenantDataStep
+	0,  // 1: warden.service.v1.WardenMaintenanceService.CleanupOrphans:input_type -> warden.service.v1.CleanupOrphansRequest
+	2,  // 2: warden.service.v1.WardenMaintenanceService.RepairFolderPaths:input_type -> warden.service.v1.RepairFolderPathsRequest
+	4,  // 3: warden.service.v1.WardenMaintenanceService.RecomputeStatistics:input_type -> warden.service.v1.RecomputeStatisticsRequest
+	6,  // 4: warden.service.v1.WardenMaintenanceService.PurgeTrash:input_type -> warden.service.v1.PurgeTrashRequest
+	8,  // 5: warden.service.v1.WardenMaintenanceService.SyncVersions:input_type -> warden.service.v1.SyncVersionsRequest
+	10, // 6: warden.service.v1.WardenMaintenanceService.MigrateChecksums:input_type -> warden.service.v1.MigrateChecksumsRequest
+	12, // 7: warden.service.v1.WardenMaintenanceService.RotateFieldKey:input_type -> warden.service.v1.RotateFieldKeyRequest
+	14, // 8: warden.service.v1.WardenMaintenanceService.ReencryptFields:input_type -> warden.service.v1.ReencryptFieldsRequest
+	16, // 9: warden.service.v1.WardenMaintenanceService.PurgeTenantData:input_type -> warden.service.v1.PurgeTenantDataRequest
+	1,  // 10: warden.service.v1.WardenMaintenanceService.CleanupOrphans:output_type -> warden.service.v1.CleanupOrphansResponse
+	3,  // 11: warden.service.v1.WardenMaintenanceService.RepairFolderPaths:output_type -> warden.service.v1.RepairFolderPathsResponse
+	5,  // 12: warden.service.v1.WardenMaintenanceService.RecomputeStatistics:output_type -> warden.service.v1.RecomputeStatisticsResponse
+	7,  // 13: warden.service.v1.WardenMaintenanceService.PurgeTrash:output_type -> warden.service.v1.PurgeTrashResponse
+	9,  // 14: warden.service.v1.WardenMaintenanceService.SyncVersions:output_type -> warden.service.v1.SyncVersionsResponse
+	11, // 15: warden.service.v1.WardenMaintenanceService.MigrateChecksums:output_type -> warden.service.v1.MigrateChecksumsResponse
+	13, // 16: warden.service.v1.WardenMaintenanceService.RotateFieldKey:output_type -> warden.service.v1.RotateFieldKeyResponse
+	15, // 17: warden.service.v1.WardenMaintenanceService.ReencryptFields:output_type -> warden.service.v1.ReencryptFieldsResponse
+	17, // 18: warden.service.v1.WardenMaintenanceService.PurgeTenantData:output_type -> warden.service.v1.PurgeTenantDataResponse
+	10, // [10:19] is the sub-list for method output_type
+	1,  // [1:10] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_maintenance_proto_init() }
+func file_warden_service_v1_maintenance_proto_init() {
+	if File_warden_service_v1_maintenance_proto != nil {
+		return
+	}
+	file_warden_service_v1_maintenance_proto_msgTypes[0].OneofWrappers = []any{}
+	file_warden_service_v1_maintenance_proto_msgTypes[2].OneofWrappers = []any{}
+	file_warden_service_v1_maintenance_proto_msgTypes[6].OneofWrappers = []any{}
+	file_warden_service_v1_maintenance_proto_msgTypes[8].OneofWrappers = []any{}
+	file_warden_service_v1_maintenance_proto_msgTypes[10].OneofWrappers = []any{}
+	file_warden_service_v1_maintenance_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_maintenance_proto_rawDesc), len(file_warden_service_v1_maintenance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_maintenance_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_maintenance_proto_depIdxs,
+		MessageInfos:      file_warden_service_v1_maintenance_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_maintenance_proto = out.File
+	file_warden_service_v1_maintenance_proto_goTypes = nil
+	file_warden_service_v1_maintenance_proto_depIdxs = nil
+}
@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/maintenance.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+)
+
+// RegisterRedactedWardenMaintenanceServiceServer wraps the WardenMaintenanceServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenMaintenanceServiceServer(s grpc.ServiceRegistrar, srv WardenMaintenanceServiceServer, bypass redact.Bypass) {
+	RegisterWardenMaintenanceServiceServer(s, RedactedWardenMaintenanceServiceServer(srv, bypass))
+}
+
+func RedactedWardenMaintenanceServiceServer(srv WardenMaintenanceServiceServer, bypass redact.Bypass) WardenMaintenanceServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenMaintenanceServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenMaintenanceServiceServer struct {
+	UnsafeWardenMaintenanceServiceServer
+	srv    WardenMaintenanceServiceServer
+	bypass redact.Bypass
+}
+
+// CleanupOrphans is the redacted wrapper for the actual WardenMaintenanceServiceServer.CleanupOrphans method
+// Unary RPC
+func (s *redactedWardenMaintenanceServiceServer) CleanupOrphans(ctx context.Context, in *CleanupOrphansRequest) (*CleanupOrphansResponse, error) {
+	res, err := s.srv.CleanupOrphans(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RepairFolderPaths is the redacted wrapper for the actual WardenMaintenanceServiceServer.RepairFolderPaths method
+// Unary RPC
+func (s *redactedWardenMaintenanceServiceServer) RepairFolderPaths(ctx context.Context, in *RepairFolderPathsRequest) (*RepairFolderPathsResponse, error) {
+	res, err := s.srv.RepairFolderPaths(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RecomputeStatistics is the redacted wrapper for the actual WardenMaintenanceServiceServer.RecomputeStatistics method
+// Unary RPC
+func (s *redactedWardenMaintenanceServiceServer) RecomputeStatistics(ctx context.Context, in *RecomputeStatisticsRequest) (*RecomputeStatisticsResponse, error) {
+	res, err := s.srv.RecomputeStatistics(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PurgeTrash is the redacted wrapper for the actual WardenMaintenanceServiceServer.PurgeTrash method
+// Unary RPC
+func (s *redactedWardenMaintenanceServiceServer) PurgeTrash(ctx context.Context, in *PurgeTrashRequest) (*PurgeTrashResponse, error) {
+	res, err := s.srv.PurgeTrash(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SyncVersions is the redacted wrapper for the actual WardenMaintenanceServiceServer.SyncVersions method
+// Unary RPC
+func (s *redactedWardenMaintenanceServiceServer) SyncVersions(ctx context.Context, in *SyncVersionsRequest) (*SyncVersionsResponse, error) {
+	res, err := s.srv.SyncVersions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// MigrateChecksums is the redacted wrapper for the actual WardenMaintenanceServiceServer.MigrateChecksums method
+// Unary RPC
+func (s *redactedWardenMaintenanceServiceServer) MigrateChecksums(ctx context.Context, in *MigrateChecksumsRequest) (*MigrateChecksumsResponse, error) {
+	res, err := s.srv.MigrateChecksums(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RotateFieldKey is the redacted wrapper for the actual WardenMaintenanceServiceServer.RotateFieldKey method
+// Unary RPC
+func (s *redactedWardenMaintenanceServiceServer) RotateFieldKey(ctx context.Context, in *RotateFieldKeyRequest) (*RotateFieldKeyResponse, error) {
+	res, err := s.srv.RotateFieldKey(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ReencryptFields is the redacted wrapper for the actual WardenMaintenanceServiceServer.ReencryptFields method
+// Unary RPC
+func (s *redactedWardenMaintenanceServiceServer) ReencryptFields(ctx context.Context, in *ReencryptFieldsRequest) (*ReencryptFieldsResponse, error) {
+	res, err := s.srv.ReencryptFields(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PurgeTenantData is the redacted wrapper for the actual WardenMaintenanceServiceServer.PurgeTenantData method
+// Unary RPC
+func (s *redactedWardenMaintenanceServiceServer) PurgeTenantData(ctx context.Context, in *PurgeTenantDataRequest) (*PurgeTenantDataResponse, error) {
+	res, err := s.srv.PurgeTenantData(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for CleanupOrphansRequest
+func (x *CleanupOrphansRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for CleanupOrphansResponse
+func (x *CleanupOrphansResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FolderPermissions
+
+	// Safe field: SecretPermissions
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for RepairFolderPathsRequest
+func (x *RepairFolderPathsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for RepairFolderPathsResponse
+func (x *RepairFolderPathsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FoldersChecked
+
+	// Safe field: RepairedFolderIds
+
+	// Safe field: UnresolvedFolderIds
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for RecomputeStatisticsRequest
+func (x *RecomputeStatisticsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// Redact method implementation for RecomputeStatisticsResponse
+func (x *RecomputeStatisticsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// Redact method implementation for PurgeTrashRequest
+func (x *PurgeTrashRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: OlderThanDays
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for PurgeTrashResponse
+func (x *PurgeTrashResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: PurgedSecretIds
+
+	// Safe field: FailedSecretIds
+
+	// Safe field: DryRun
+
+	// Safe field: PurgedFolderIds
+
+	// Safe field: FailedFolderIds
+	return x.String()
+}
+
+// Redact method implementation for SyncVersionsRequest
+func (x *SyncVersionsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: SecretId
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for SyncVersionsResponse
+func (x *SyncVersionsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretsChecked
+
+	// Safe field: RepairedSecretIds
+
+	// Safe field: VersionsCreated
+
+	// Safe field: VersionsRemoved
+
+	// Safe field: FailedSecretIds
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for MigrateChecksumsRequest
+func (x *MigrateChecksumsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: SecretId
+
+	// Safe field: Limit
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for MigrateChecksumsResponse
+func (x *MigrateChecksumsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Algorithm
+
+	// Safe field: VersionsMigrated
+
+	// Safe field: VersionsUnreadable
+
+	// Safe field: FailedVersionIds
+
+	// Safe field: VersionsRemaining
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for RotateFieldKeyRequest
+func (x *RotateFieldKeyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: RewrapOnly
+	return x.String()
+}
+
+// Redact method implementation for RotateFieldKeyResponse
+func (x *RotateFieldKeyResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: KeyId
+
+	// Safe field: KeysRewrapped
+
+	// Safe field: SecretsOutdated
+	return x.String()
+}
+
+// Redact method implementation for ReencryptFieldsRequest
+func (x *ReencryptFieldsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: Limit
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for ReencryptFieldsResponse
+func (x *ReencryptFieldsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: KeyId
+
+	// Safe field: SecretsReencrypted
+
+	// Safe field: FailedSecretIds
+
+	// Safe field: SecretsRemaining
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for PurgeTenantDataRequest
+func (x *PurgeTenantDataRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: ConfirmTenantId
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for PurgeTenantDataResponse
+func (x *PurgeTenantDataResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Steps
+
+	// Safe field: Completed
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for PurgeTenantDataStep
+func (x *PurgeTenantDataStep) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Name
+
+	// Safe field: Count
+
+	// Safe field: Failed
+
+	// Safe field: Error
+	return x.String()
+}
@@ -0,0 +1,2129 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/maintenance.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CleanupOrphansRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CleanupOrphansRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CleanupOrphansRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CleanupOrphansRequestMultiError, or nil if none found.
+func (m *CleanupOrphansRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CleanupOrphansRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return CleanupOrphansRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CleanupOrphansRequestMultiError is an error wrapping multiple validation
+// errors returned by CleanupOrphansRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CleanupOrphansRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CleanupOrphansRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CleanupOrphansRequestMultiError) AllErrors() []error { return m }
+
+// CleanupOrphansRequestValidationError is the validation error returned by
+// CleanupOrphansRequest.Validate if the designated constraints aren't met.
+type CleanupOrphansRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CleanupOrphansRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CleanupOrphansRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CleanupOrphansRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CleanupOrphansRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CleanupOrphansRequestValidationError) ErrorName() string {
+	return "CleanupOrphansRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CleanupOrphansRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCleanupOrphansRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CleanupOrphansRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CleanupOrphansRequestValidationError{}
+
+// Validate checks the field values on CleanupOrphansResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CleanupOrphansResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CleanupOrphansResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CleanupOrphansResponseMultiError, or nil if none found.
+func (m *CleanupOrphansResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CleanupOrphansResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FolderPermissions
+
+	// no validation rules for SecretPermissions
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return CleanupOrphansResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CleanupOrphansResponseMultiError is an error wrapping multiple validation
+// errors returned by CleanupOrphansResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CleanupOrphansResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CleanupOrphansResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CleanupOrphansResponseMultiError) AllErrors() []error { return m }
+
+// CleanupOrphansResponseValidationError is the validation error returned by
+// CleanupOrphansResponse.Validate if the designated constraints aren't met.
+type CleanupOrphansResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CleanupOrphansResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CleanupOrphansResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CleanupOrphansResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CleanupOrphansResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CleanupOrphansResponseValidationError) ErrorName() string {
+	return "CleanupOrphansResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CleanupOrphansResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCleanupOrphansResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CleanupOrphansResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CleanupOrphansResponseValidationError{}
+
+// Validate checks the field values on RepairFolderPathsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RepairFolderPathsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RepairFolderPathsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RepairFolderPathsRequestMultiError, or nil if none found.
+func (m *RepairFolderPathsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RepairFolderPathsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return RepairFolderPathsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RepairFolderPathsRequestMultiError is an error wrapping multiple validation
+// errors returned by RepairFolderPathsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RepairFolderPathsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RepairFolderPathsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RepairFolderPathsRequestMultiError) AllErrors() []error { return m }
+
+// RepairFolderPathsRequestValidationError is the validation error returned by
+// RepairFolderPathsRequest.Validate if the designated constraints aren't met.
+type RepairFolderPathsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RepairFolderPathsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RepairFolderPathsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RepairFolderPathsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RepairFolderPathsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RepairFolderPathsRequestValidationError) ErrorName() string {
+	return "RepairFolderPathsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RepairFolderPathsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRepairFolderPathsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RepairFolderPathsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RepairFolderPathsRequestValidationError{}
+
+// Validate checks the field values on RepairFolderPathsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RepairFolderPathsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RepairFolderPathsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RepairFolderPathsResponseMultiError, or nil if none found.
+func (m *RepairFolderPathsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RepairFolderPathsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FoldersChecked
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return RepairFolderPathsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RepairFolderPathsResponseMultiError is an error wrapping multiple validation
+// errors returned by RepairFolderPathsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RepairFolderPathsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RepairFolderPathsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RepairFolderPathsResponseMultiError) AllErrors() []error { return m }
+
+// RepairFolderPathsResponseValidationError is the validation error returned by
+// RepairFolderPathsResponse.Validate if the designated constraints aren't met.
+type RepairFolderPathsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RepairFolderPathsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RepairFolderPathsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RepairFolderPathsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RepairFolderPathsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RepairFolderPathsResponseValidationError) ErrorName() string {
+	return "RepairFolderPathsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RepairFolderPathsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRepairFolderPathsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RepairFolderPathsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RepairFolderPathsResponseValidationError{}
+
+// Validate checks the field values on RecomputeStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecomputeStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecomputeStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecomputeStatisticsRequestMultiError, or nil if none found.
+func (m *RecomputeStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecomputeStatisticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RecomputeStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecomputeStatisticsRequestMultiError is an error wrapping multiple
+// validation errors returned by RecomputeStatisticsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RecomputeStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecomputeStatisticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecomputeStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// RecomputeStatisticsRequestValidationError is the validation error returned
+// by RecomputeStatisticsRequest.Validate if the designated constraints aren't met.
+type RecomputeStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecomputeStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecomputeStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecomputeStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecomputeStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecomputeStatisticsRequestValidationError) ErrorName() string {
+	return "RecomputeStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecomputeStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecomputeStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecomputeStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecomputeStatisticsRequestValidationError{}
+
+// Validate checks the field values on RecomputeStatisticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecomputeStatisticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecomputeStatisticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecomputeStatisticsResponseMultiError, or nil if none found.
+func (m *RecomputeStatisticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecomputeStatisticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RecomputeStatisticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecomputeStatisticsResponseMultiError is an error wrapping multiple
+// validation errors returned by RecomputeStatisticsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RecomputeStatisticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecomputeStatisticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecomputeStatisticsResponseMultiError) AllErrors() []error { return m }
+
+// RecomputeStatisticsResponseValidationError is the validation error returned
+// by RecomputeStatisticsResponse.Validate if the designated constraints
+// aren't met.
+type RecomputeStatisticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecomputeStatisticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecomputeStatisticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecomputeStatisticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecomputeStatisticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecomputeStatisticsResponseValidationError) ErrorName() string {
+	return "RecomputeStatisticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecomputeStatisticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecomputeStatisticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecomputeStatisticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecomputeStatisticsResponseValidationError{}
+
+// Validate checks the field values on PurgeTrashRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeTrashRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeTrashRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeTrashRequestMultiError, or nil if none found.
+func (m *PurgeTrashRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeTrashRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.OlderThanDays != nil {
+		// no validation rules for OlderThanDays
+	}
+
+	if len(errors) > 0 {
+		return PurgeTrashRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeTrashRequestMultiError is an error wrapping multiple validation errors
+// returned by PurgeTrashRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeTrashRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeTrashRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeTrashRequestMultiError) AllErrors() []error { return m }
+
+// PurgeTrashRequestValidationError is the validation error returned by
+// PurgeTrashRequest.Validate if the designated constraints aren't met.
+type PurgeTrashRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeTrashRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeTrashRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeTrashRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeTrashRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeTrashRequestValidationError) ErrorName() string {
+	return "PurgeTrashRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeTrashRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeTrashRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeTrashRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeTrashRequestValidationError{}
+
+// Validate checks the field values on PurgeTrashResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeTrashResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeTrashResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeTrashResponseMultiError, or nil if none found.
+func (m *PurgeTrashResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeTrashResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return PurgeTrashResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeTrashResponseMultiError is an error wrapping multiple validation errors
+// returned by PurgeTrashResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeTrashResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeTrashResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeTrashResponseMultiError) AllErrors() []error { return m }
+
+// PurgeTrashResponseValidationError is the validation error returned by
+// PurgeTrashResponse.Validate if the designated constraints aren't met.
+type PurgeTrashResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeTrashResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeTrashResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeTrashResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeTrashResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeTrashResponseValidationError) ErrorName() string {
+	return "PurgeTrashResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeTrashResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeTrashResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeTrashResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeTrashResponseValidationError{}
+
+// Validate checks the field values on SyncVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncVersionsRequestMultiError, or nil if none found.
+func (m *SyncVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.SecretId != nil {
+		// no validation rules for SecretId
+	}
+
+	if len(errors) > 0 {
+		return SyncVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by SyncVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SyncVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncVersionsRequestMultiError) AllErrors() []error { return m }
+
+// SyncVersionsRequestValidationError is the validation error returned by
+// SyncVersionsRequest.Validate if the designated constraints aren't met.
+type SyncVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncVersionsRequestValidationError) ErrorName() string {
+	return "SyncVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncVersionsRequestValidationError{}
+
+// Validate checks the field values on SyncVersionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncVersionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncVersionsResponseMultiError, or nil if none found.
+func (m *SyncVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretsChecked
+
+	// no validation rules for VersionsCreated
+
+	// no validation rules for VersionsRemoved
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return SyncVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncVersionsResponseMultiError is an error wrapping multiple validation
+// errors returned by SyncVersionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SyncVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncVersionsResponseMultiError) AllErrors() []error { return m }
+
+// SyncVersionsResponseValidationError is the validation error returned by
+// SyncVersionsResponse.Validate if the designated constraints aren't met.
+type SyncVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncVersionsResponseValidationError) ErrorName() string {
+	return "SyncVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncVersionsResponseValidationError{}
+
+// Validate checks the field values on MigrateChecksumsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MigrateChecksumsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrateChecksumsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MigrateChecksumsRequestMultiError, or nil if none found.
+func (m *MigrateChecksumsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrateChecksumsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.SecretId != nil {
+		// no validation rules for SecretId
+	}
+
+	if m.Limit != nil {
+		// no validation rules for Limit
+	}
+
+	if len(errors) > 0 {
+		return MigrateChecksumsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrateChecksumsRequestMultiError is an error wrapping multiple validation
+// errors returned by MigrateChecksumsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MigrateChecksumsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrateChecksumsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrateChecksumsRequestMultiError) AllErrors() []error { return m }
+
+// MigrateChecksumsRequestValidationError is the validation error returned by
+// MigrateChecksumsRequest.Validate if the designated constraints aren't met.
+type MigrateChecksumsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrateChecksumsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrateChecksumsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrateChecksumsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrateChecksumsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrateChecksumsRequestValidationError) ErrorName() string {
+	return "MigrateChecksumsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MigrateChecksumsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrateChecksumsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrateChecksumsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrateChecksumsRequestValidationError{}
+
+// Validate checks the field values on MigrateChecksumsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MigrateChecksumsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrateChecksumsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MigrateChecksumsResponseMultiError, or nil if none found.
+func (m *MigrateChecksumsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrateChecksumsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Algorithm
+
+	// no validation rules for VersionsMigrated
+
+	// no validation rules for VersionsUnreadable
+
+	// no validation rules for VersionsRemaining
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return MigrateChecksumsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrateChecksumsResponseMultiError is an error wrapping multiple validation
+// errors returned by MigrateChecksumsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MigrateChecksumsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrateChecksumsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrateChecksumsResponseMultiError) AllErrors() []error { return m }
+
+// MigrateChecksumsResponseValidationError is the validation error returned by
+// MigrateChecksumsResponse.Validate if the designated constraints aren't met.
+type MigrateChecksumsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrateChecksumsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrateChecksumsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrateChecksumsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrateChecksumsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrateChecksumsResponseValidationError) ErrorName() string {
+	return "MigrateChecksumsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MigrateChecksumsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrateChecksumsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrateChecksumsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrateChecksumsResponseValidationError{}
+
+// Validate checks the field values on RotateFieldKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateFieldKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateFieldKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateFieldKeyRequestMultiError, or nil if none found.
+func (m *RotateFieldKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateFieldKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RewrapOnly
+
+	if len(errors) > 0 {
+		return RotateFieldKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateFieldKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RotateFieldKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RotateFieldKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateFieldKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateFieldKeyRequestMultiError) AllErrors() []error { return m }
+
+// RotateFieldKeyRequestValidationError is the validation error returned by
+// RotateFieldKeyRequest.Validate if the designated constraints aren't met.
+type RotateFieldKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateFieldKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateFieldKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateFieldKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateFieldKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateFieldKeyRequestValidationError) ErrorName() string {
+	return "RotateFieldKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateFieldKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateFieldKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateFieldKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateFieldKeyRequestValidationError{}
+
+// Validate checks the field values on RotateFieldKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateFieldKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateFieldKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateFieldKeyResponseMultiError, or nil if none found.
+func (m *RotateFieldKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateFieldKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeyId
+
+	// no validation rules for KeysRewrapped
+
+	// no validation rules for SecretsOutdated
+
+	if len(errors) > 0 {
+		return RotateFieldKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateFieldKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RotateFieldKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RotateFieldKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateFieldKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateFieldKeyResponseMultiError) AllErrors() []error { return m }
+
+// RotateFieldKeyResponseValidationError is the validation error returned by
+// RotateFieldKeyResponse.Validate if the designated constraints aren't met.
+type RotateFieldKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateFieldKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateFieldKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateFieldKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateFieldKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateFieldKeyResponseValidationError) ErrorName() string {
+	return "RotateFieldKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateFieldKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateFieldKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateFieldKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateFieldKeyResponseValidationError{}
+
+// Validate checks the field values on ReencryptFieldsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReencryptFieldsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReencryptFieldsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReencryptFieldsRequestMultiError, or nil if none found.
+func (m *ReencryptFieldsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReencryptFieldsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.Limit != nil {
+		// no validation rules for Limit
+	}
+
+	if len(errors) > 0 {
+		return ReencryptFieldsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReencryptFieldsRequestMultiError is an error wrapping multiple validation
+// errors returned by ReencryptFieldsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReencryptFieldsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReencryptFieldsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReencryptFieldsRequestMultiError) AllErrors() []error { return m }
+
+// ReencryptFieldsRequestValidationError is the validation error returned by
+// ReencryptFieldsRequest.Validate if the designated constraints aren't met.
+type ReencryptFieldsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReencryptFieldsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReencryptFieldsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReencryptFieldsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReencryptFieldsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReencryptFieldsRequestValidationError) ErrorName() string {
+	return "ReencryptFieldsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReencryptFieldsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReencryptFieldsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReencryptFieldsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReencryptFieldsRequestValidationError{}
+
+// Validate checks the field values on ReencryptFieldsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReencryptFieldsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReencryptFieldsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReencryptFieldsResponseMultiError, or nil if none found.
+func (m *ReencryptFieldsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReencryptFieldsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeyId
+
+	// no validation rules for SecretsReencrypted
+
+	// no validation rules for SecretsRemaining
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ReencryptFieldsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReencryptFieldsResponseMultiError is an error wrapping multiple validation
+// errors returned by ReencryptFieldsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReencryptFieldsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReencryptFieldsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReencryptFieldsResponseMultiError) AllErrors() []error { return m }
+
+// ReencryptFieldsResponseValidationError is the validation error returned by
+// ReencryptFieldsResponse.Validate if the designated constraints aren't met.
+type ReencryptFieldsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReencryptFieldsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReencryptFieldsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReencryptFieldsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReencryptFieldsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReencryptFieldsResponseValidationError) ErrorName() string {
+	return "ReencryptFieldsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReencryptFieldsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReencryptFieldsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReencryptFieldsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReencryptFieldsResponseValidationError{}
+
+// Validate checks the field values on PurgeTenantDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeTenantDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeTenantDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeTenantDataRequestMultiError, or nil if none found.
+func (m *PurgeTenantDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeTenantDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for ConfirmTenantId
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return PurgeTenantDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeTenantDataRequestMultiError is an error wrapping multiple validation
+// errors returned by PurgeTenantDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PurgeTenantDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeTenantDataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeTenantDataRequestMultiError) AllErrors() []error { return m }
+
+// PurgeTenantDataRequestValidationError is the validation error returned by
+// PurgeTenantDataRequest.Validate if the designated constraints aren't met.
+type PurgeTenantDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeTenantDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeTenantDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeTenantDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeTenantDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeTenantDataRequestValidationError) ErrorName() string {
+	return "PurgeTenantDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeTenantDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeTenantDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeTenantDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeTenantDataRequestValidationError{}
+
+// Validate checks the field values on PurgeTenantDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeTenantDataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeTenantDataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeTenantDataResponseMultiError, or nil if none found.
+func (m *PurgeTenantDataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeTenantDataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PurgeTenantDataResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PurgeTenantDataResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PurgeTenantDataResponseValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Completed
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return PurgeTenantDataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeTenantDataResponseMultiError is an error wrapping multiple validation
+// errors returned by PurgeTenantDataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PurgeTenantDataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeTenantDataResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeTenantDataResponseMultiError) AllErrors() []error { return m }
+
+// PurgeTenantDataResponseValidationError is the validation error returned by
+// PurgeTenantDataResponse.Validate if the designated constraints aren't met.
+type PurgeTenantDataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeTenantDataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeTenantDataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeTenantDataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeTenantDataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeTenantDataResponseValidationError) ErrorName() string {
+	return "PurgeTenantDataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeTenantDataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeTenantDataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeTenantDataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeTenantDataResponseValidationError{}
+
+// Validate checks the field values on PurgeTenantDataStep with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeTenantDataStep) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeTenantDataStep with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeTenantDataStepMultiError, or nil if none found.
+func (m *PurgeTenantDataStep) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeTenantDataStep) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Count
+
+	// no validation rules for Failed
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return PurgeTenantDataStepMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeTenantDataStepMultiError is an error wrapping multiple validation
+// errors returned by PurgeTenantDataStep.ValidateAll() if the designated
+// constraints aren't met.
+type PurgeTenantDataStepMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeTenantDataStepMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeTenantDataStepMultiError) AllErrors() []error { return m }
+
+// PurgeTenantDataStepValidationError is the validation error returned by
+// PurgeTenantDataStep.Validate if the designated constraints aren't met.
+type PurgeTenantDataStepValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeTenantDataStepValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeTenantDataStepValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeTenantDataStepValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeTenantDataStepValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeTenantDataStepValidationError) ErrorName() string {
+	return "PurgeTenantDataStepValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeTenantDataStepValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeTenantDataStep.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeTenantDataStepValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeTenantDataStepValidationError{}
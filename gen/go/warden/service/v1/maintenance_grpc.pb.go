@@ -0,0 +1,460 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/maintenance.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenMaintenanceService_CleanupOrphans_FullMethodName      = "/warden.service.v1.WardenMaintenanceService/CleanupOrphans"
+	WardenMaintenanceService_RepairFolderPaths_FullMethodName   = "/warden.service.v1.WardenMaintenanceService/RepairFolderPaths"
+	WardenMaintenanceService_RecomputeStatistics_FullMethodName = "/warden.service.v1.WardenMaintenanceService/RecomputeStatistics"
+	WardenMaintenanceService_PurgeTrash_FullMethodName          = "/warden.service.v1.WardenMaintenanceService/PurgeTrash"
+	WardenMaintenanceService_SyncVersions_FullMethodName        = "/warden.service.v1.WardenMaintenanceService/SyncVersions"
+	WardenMaintenanceService_MigrateChecksums_FullMethodName    = "/warden.service.v1.WardenMaintenanceService/MigrateChecksums"
+	WardenMaintenanceService_RotateFieldKey_FullMethodName      = "/warden.service.v1.WardenMaintenanceService/RotateFieldKey"
+	WardenMaintenanceService_ReencryptFields_FullMethodName     = "/warden.service.v1.WardenMaintenanceService/ReencryptFields"
+	WardenMaintenanceService_PurgeTenantData_FullMethodName     = "/warden.service.v1.WardenMaintenanceService/PurgeTenantData"
+)
+
+// WardenMaintenanceServiceClient is the client API for WardenMaintenanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Maintenance Service - data repair and cleanup tasks (platform admin only)
+type WardenMaintenanceServiceClient interface {
+	// Delete permission tuples whose folder or secret no longer exists
+	CleanupOrphans(ctx context.Context, in *CleanupOrphansRequest, opts ...grpc.CallOption) (*CleanupOrphansResponse, error)
+	// Recompute folder paths and depths from the parent links
+	RepairFolderPaths(ctx context.Context, in *RepairFolderPathsRequest, opts ...grpc.CallOption) (*RepairFolderPathsResponse, error)
+	// Recompute the Prometheus gauges from the database
+	RecomputeStatistics(ctx context.Context, in *RecomputeStatisticsRequest, opts ...grpc.CallOption) (*RecomputeStatisticsResponse, error)
+	// Permanently delete soft-deleted secrets, including their Vault data, and
+	// the folders in the trash once no secret is left in them
+	PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error)
+	// Reconcile the version records of secrets with the versions kept in Vault
+	SyncVersions(ctx context.Context, in *SyncVersionsRequest, opts ...grpc.CallOption) (*SyncVersionsResponse, error)
+	// Recompute the checksums of versions made with another algorithm or
+	// checksum key, reading their passwords from Vault
+	MigrateChecksums(ctx context.Context, in *MigrateChecksumsRequest, opts ...grpc.CallOption) (*MigrateChecksumsResponse, error)
+	// Create a new field key for the encrypted secret fields, wrapping the
+	// existing ones again with the latest version of the Transit key
+	RotateFieldKey(ctx context.Context, in *RotateFieldKeyRequest, opts ...grpc.CallOption) (*RotateFieldKeyResponse, error)
+	// Encrypt the username, URL and metadata of secrets stored under an older
+	// field key, or in plaintext, with the current one
+	ReencryptFields(ctx context.Context, in *ReencryptFieldsRequest, opts ...grpc.CallOption) (*ReencryptFieldsResponse, error)
+	// Delete all data of a tenant, including its Vault data and audit logs,
+	// for offboarding. The steps run in dependency order and are reported
+	// with their counts.
+	PurgeTenantData(ctx context.Context, in *PurgeTenantDataRequest, opts ...grpc.CallOption) (*PurgeTenantDataResponse, error)
+}
+
+type wardenMaintenanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenMaintenanceServiceClient(cc grpc.ClientConnInterface) WardenMaintenanceServiceClient {
+	return &wardenMaintenanceServiceClient{cc}
+}
+
+func (c *wardenMaintenanceServiceClient) CleanupOrphans(ctx context.Context, in *CleanupOrphansRequest, opts ...grpc.CallOption) (*CleanupOrphansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CleanupOrphansResponse)
+	err := c.cc.Invoke(ctx, WardenMaintenanceService_CleanupOrphans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenMaintenanceServiceClient) RepairFolderPaths(ctx context.Context, in *RepairFolderPathsRequest, opts ...grpc.CallOption) (*RepairFolderPathsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepairFolderPathsResponse)
+	err := c.cc.Invoke(ctx, WardenMaintenanceService_RepairFolderPaths_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenMaintenanceServiceClient) RecomputeStatistics(ctx context.Context, in *RecomputeStatisticsRequest, opts ...grpc.CallOption) (*RecomputeStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeStatisticsResponse)
+	err := c.cc.Invoke(ctx, WardenMaintenanceService_RecomputeStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenMaintenanceServiceClient) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTrashResponse)
+	err := c.cc.Invoke(ctx, WardenMaintenanceService_PurgeTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenMaintenanceServiceClient) SyncVersions(ctx context.Context, in *SyncVersionsRequest, opts ...grpc.CallOption) (*SyncVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncVersionsResponse)
+	err := c.cc.Invoke(ctx, WardenMaintenanceService_SyncVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenMaintenanceServiceClient) MigrateChecksums(ctx context.Context, in *MigrateChecksumsRequest, opts ...grpc.CallOption) (*MigrateChecksumsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MigrateChecksumsResponse)
+	err := c.cc.Invoke(ctx, WardenMaintenanceService_MigrateChecksums_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenMaintenanceServiceClient) RotateFieldKey(ctx context.Context, in *RotateFieldKeyRequest, opts ...grpc.CallOption) (*RotateFieldKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateFieldKeyResponse)
+	err := c.cc.Invoke(ctx, WardenMaintenanceService_RotateFieldKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenMaintenanceServiceClient) ReencryptFields(ctx context.Context, in *ReencryptFieldsRequest, opts ...grpc.CallOption) (*ReencryptFieldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReencryptFieldsResponse)
+	err := c.cc.Invoke(ctx, WardenMaintenanceService_ReencryptFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenMaintenanceServiceClient) PurgeTenantData(ctx context.Context, in *PurgeTenantDataRequest, opts ...grpc.CallOption) (*PurgeTenantDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTenantDataResponse)
+	err := c.cc.Invoke(ctx, WardenMaintenanceService_PurgeTenantData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenMaintenanceServiceServer is the server API for WardenMaintenanceService service.
+// All implementations must embed UnimplementedWardenMaintenanceServiceServer
+// for forward compatibility.
+//
+// Maintenance Service - data repair and cleanup tasks (platform admin only)
+type WardenMaintenanceServiceServer interface {
+	// Delete permission tuples whose folder or secret no longer exists
+	CleanupOrphans(context.Context, *CleanupOrphansRequest) (*CleanupOrphansResponse, error)
+	// Recompute folder paths and depths from the parent links
+	RepairFolderPaths(context.Context, *RepairFolderPathsRequest) (*RepairFolderPathsResponse, error)
+	// Recompute the Prometheus gauges from the database
+	RecomputeStatistics(context.Context, *RecomputeStatisticsRequest) (*RecomputeStatisticsResponse, error)
+	// Permanently delete soft-deleted secrets, including their Vault data, and
+	// the folders in the trash once no secret is left in them
+	PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error)
+	// Reconcile the version records of secrets with the versions kept in Vault
+	SyncVersions(context.Context, *SyncVersionsRequest) (*SyncVersionsResponse, error)
+	// Recompute the checksums of versions made with another algorithm or
+	// checksum key, reading their passwords from Vault
+	MigrateChecksums(context.Context, *MigrateChecksumsRequest) (*MigrateChecksumsResponse, error)
+	// Create a new field key for the encrypted secret fields, wrapping the
+	// existing ones again with the latest version of the Transit key
+	RotateFieldKey(context.Context, *RotateFieldKeyRequest) (*RotateFieldKeyResponse, error)
+	// Encrypt the username, URL and metadata of secrets stored under an older
+	// field key, or in plaintext, with the current one
+	ReencryptFields(context.Context, *ReencryptFieldsRequest) (*ReencryptFieldsResponse, error)
+	// Delete all data of a tenant, including its Vault data and audit logs,
+	// for offboarding. The steps run in dependency order and are reported
+	// with their counts.
+	PurgeTenantData(context.Context, *PurgeTenantDataRequest) (*PurgeTenantDataResponse, error)
+	mustEmbedUnimplementedWardenMaintenanceServiceServer()
+}
+
+// UnimplementedWardenMaintenanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenMaintenanceServiceServer struct{}
+
+func (UnimplementedWardenMaintenanceServiceServer) CleanupOrphans(context.Context, *CleanupOrphansRequest) (*CleanupOrphansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CleanupOrphans not implemented")
+}
+func (UnimplementedWardenMaintenanceServiceServer) RepairFolderPaths(context.Context, *RepairFolderPathsRequest) (*RepairFolderPathsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RepairFolderPaths not implemented")
+}
+func (UnimplementedWardenMaintenanceServiceServer) RecomputeStatistics(context.Context, *RecomputeStatisticsRequest) (*RecomputeStatisticsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecomputeStatistics not implemented")
+}
+func (UnimplementedWardenMaintenanceServiceServer) PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeTrash not implemented")
+}
+func (UnimplementedWardenMaintenanceServiceServer) SyncVersions(context.Context, *SyncVersionsRequest) (*SyncVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SyncVersions not implemented")
+}
+func (UnimplementedWardenMaintenanceServiceServer) MigrateChecksums(context.Context, *MigrateChecksumsRequest) (*MigrateChecksumsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MigrateChecksums not implemented")
+}
+func (UnimplementedWardenMaintenanceServiceServer) RotateFieldKey(context.Context, *RotateFieldKeyRequest) (*RotateFieldKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateFieldKey not implemented")
+}
+func (UnimplementedWardenMaintenanceServiceServer) ReencryptFields(context.Context, *ReencryptFieldsRequest) (*ReencryptFieldsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReencryptFields not implemented")
+}
+func (UnimplementedWardenMaintenanceServiceServer) PurgeTenantData(context.Context, *PurgeTenantDataRequest) (*PurgeTenantDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeTenantData not implemented")
+}
+func (UnimplementedWardenMaintenanceServiceServer) mustEmbedUnimplementedWardenMaintenanceServiceServer() {
+}
+func (UnimplementedWardenMaintenanceServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWardenMaintenanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenMaintenanceServiceServer will
+// result in compilation errors.
+type UnsafeWardenMaintenanceServiceServer interface {
+	mustEmbedUnimplementedWardenMaintenanceServiceServer()
+}
+
+func RegisterWardenMaintenanceServiceServer(s grpc.ServiceRegistrar, srv WardenMaintenanceServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenMaintenanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenMaintenanceService_ServiceDesc, srv)
+}
+
+func _WardenMaintenanceService_CleanupOrphans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupOrphansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenMaintenanceServiceServer).CleanupOrphans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenMaintenanceService_CleanupOrphans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenMaintenanceServiceServer).CleanupOrphans(ctx, req.(*CleanupOrphansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenMaintenanceService_RepairFolderPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairFolderPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenMaintenanceServiceServer).RepairFolderPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenMaintenanceService_RepairFolderPaths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenMaintenanceServiceServer).RepairFolderPaths(ctx, req.(*RepairFolderPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenMaintenanceService_RecomputeStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenMaintenanceServiceServer).RecomputeStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenMaintenanceService_RecomputeStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenMaintenanceServiceServer).RecomputeStatistics(ctx, req.(*RecomputeStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenMaintenanceService_PurgeTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenMaintenanceServiceServer).PurgeTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenMaintenanceService_PurgeTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenMaintenanceServiceServer).PurgeTrash(ctx, req.(*PurgeTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenMaintenanceService_SyncVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenMaintenanceServiceServer).SyncVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenMaintenanceService_SyncVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenMaintenanceServiceServer).SyncVersions(ctx, req.(*SyncVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenMaintenanceService_MigrateChecksums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateChecksumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenMaintenanceServiceServer).MigrateChecksums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenMaintenanceService_MigrateChecksums_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenMaintenanceServiceServer).MigrateChecksums(ctx, req.(*MigrateChecksumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenMaintenanceService_RotateFieldKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateFieldKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenMaintenanceServiceServer).RotateFieldKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenMaintenanceService_RotateFieldKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenMaintenanceServiceServer).RotateFieldKey(ctx, req.(*RotateFieldKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenMaintenanceService_ReencryptFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReencryptFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenMaintenanceServiceServer).ReencryptFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenMaintenanceService_ReencryptFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenMaintenanceServiceServer).ReencryptFields(ctx, req.(*ReencryptFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenMaintenanceService_PurgeTenantData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTenantDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenMaintenanceServiceServer).PurgeTenantData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenMaintenanceService_PurgeTenantData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenMaintenanceServiceServer).PurgeTenantData(ctx, req.(*PurgeTenantDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenMaintenanceService_ServiceDesc is the grpc.ServiceDesc for WardenMaintenanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenMaintenanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenMaintenanceService",
+	HandlerType: (*WardenMaintenanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CleanupOrphans",
+			Handler:    _WardenMaintenanceService_CleanupOrphans_Handler,
+		},
+		{
+			MethodName: "RepairFolderPaths",
+			Handler:    _WardenMaintenanceService_RepairFolderPaths_Handler,
+		},
+		{
+			MethodName: "RecomputeStatistics",
+			Handler:    _WardenMaintenanceService_RecomputeStatistics_Handler,
+		},
+		{
+			MethodName: "PurgeTrash",
+			Handler:    _WardenMaintenanceService_PurgeTrash_Handler,
+		},
+		{
+			MethodName: "SyncVersions",
+			Handler:    _WardenMaintenanceService_SyncVersions_Handler,
+		},
+		{
+			MethodName: "MigrateChecksums",
+			Handler:    _WardenMaintenanceService_MigrateChecksums_Handler,
+		},
+		{
+			MethodName: "RotateFieldKey",
+			Handler:    _WardenMaintenanceService_RotateFieldKey_Handler,
+		},
+		{
+			MethodName: "ReencryptFields",
+			Handler:    _WardenMaintenanceService_ReencryptFields_Handler,
+		},
+		{
+			MethodName: "PurgeTenantData",
+			Handler:    _WardenMaintenanceService_PurgeTenantData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/maintenance.proto",
+}
@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/maintenance.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenMaintenanceServiceCleanupOrphans = "/warden.service.v1.WardenMaintenanceService/CleanupOrphans"
+const OperationWardenMaintenanceServiceMigrateChecksums = "/warden.service.v1.WardenMaintenanceService/MigrateChecksums"
+const OperationWardenMaintenanceServicePurgeTenantData = "/warden.service.v1.WardenMaintenanceService/PurgeTenantData"
+const OperationWardenMaintenanceServicePurgeTrash = "/warden.service.v1.WardenMaintenanceService/PurgeTrash"
+const OperationWardenMaintenanceServiceRecomputeStatistics = "/warden.service.v1.WardenMaintenanceService/RecomputeStatistics"
+const OperationWardenMaintenanceServiceReencryptFields = "/warden.service.v1.WardenMaintenanceService/ReencryptFields"
+const OperationWardenMaintenanceServiceRepairFolderPaths = "/warden.service.v1.WardenMaintenanceService/RepairFolderPaths"
+const OperationWardenMaintenanceServiceRotateFieldKey = "/warden.service.v1.WardenMaintenanceService/RotateFieldKey"
+const OperationWardenMaintenanceServiceSyncVersions = "/warden.service.v1.WardenMaintenanceService/SyncVersions"
+
+type WardenMaintenanceServiceHTTPServer interface {
+	// CleanupOrphans Delete permission tuples whose folder or secret no longer exists
+	CleanupOrphans(context.Context, *CleanupOrphansRequest) (*CleanupOrphansResponse, error)
+	// MigrateChecksums Recompute the checksums of versions made with another algorithm or
+	// checksum key, reading their passwords from Vault
+	MigrateChecksums(context.Context, *MigrateChecksumsRequest) (*MigrateChecksumsResponse, error)
+	// PurgeTenantData Delete all data of a tenant, including its Vault data and audit logs,
+	// for offboarding. The steps run in dependency order and are reported
+	// with their counts.
+	PurgeTenantData(context.Context, *PurgeTenantDataRequest) (*PurgeTenantDataResponse, error)
+	// PurgeTrash Permanently delete soft-deleted secrets, including their Vault data, and
+	// the folders in the trash once no secret is left in them
+	PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error)
+	// RecomputeStatistics Recompute the Prometheus gauges from the database
+	RecomputeStatistics(context.Context, *RecomputeStatisticsRequest) (*RecomputeStatisticsResponse, error)
+	// ReencryptFields Encrypt the username, URL and metadata of secrets stored under an older
+	// field key, or in plaintext, with the current one
+	ReencryptFields(context.Context, *ReencryptFieldsRequest) (*ReencryptFieldsResponse, error)
+	// RepairFolderPaths Recompute folder paths and depths from the parent links
+	RepairFolderPaths(context.Context, *RepairFolderPathsRequest) (*RepairFolderPathsResponse, error)
+	// RotateFieldKey Create a new field key for the encrypted secret fields, wrapping the
+	// existing ones again with the latest version of the Transit key
+	RotateFieldKey(context.Context, *RotateFieldKeyRequest) (*RotateFieldKeyResponse, error)
+	// SyncVersions Reconcile the version records of secrets with the versions kept in Vault
+	SyncVersions(context.Context, *SyncVersionsRequest) (*SyncVersionsResponse, error)
+}
+
+func RegisterWardenMaintenanceServiceHTTPServer(s *http.Server, srv WardenMaintenanceServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/maintenance/orphans:cleanup", _WardenMaintenanceService_CleanupOrphans0_HTTP_Handler(srv))
+	r.POST("/v1/maintenance/folders:repair", _WardenMaintenanceService_RepairFolderPaths0_HTTP_Handler(srv))
+	r.POST("/v1/maintenance/statistics:recompute", _WardenMaintenanceService_RecomputeStatistics0_HTTP_Handler(srv))
+	r.POST("/v1/maintenance/trash:purge", _WardenMaintenanceService_PurgeTrash0_HTTP_Handler(srv))
+	r.POST("/v1/maintenance/versions:sync", _WardenMaintenanceService_SyncVersions0_HTTP_Handler(srv))
+	r.POST("/v1/maintenance/checksums:migrate", _WardenMaintenanceService_MigrateChecksums0_HTTP_Handler(srv))
+	r.POST("/v1/maintenance/field-keys:rotate", _WardenMaintenanceService_RotateFieldKey0_HTTP_Handler(srv))
+	r.POST("/v1/maintenance/field-keys:reencrypt", _WardenMaintenanceService_ReencryptFields0_HTTP_Handler(srv))
+	r.POST("/v1/maintenance/tenants/{tenant_id}:purge", _WardenMaintenanceService_PurgeTenantData0_HTTP_Handler(srv))
+}
+
+func _WardenMaintenanceService_CleanupOrphans0_HTTP_Handler(srv WardenMaintenanceServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CleanupOrphansRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenMaintenanceServiceCleanupOrphans)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CleanupOrphans(ctx, req.(*CleanupOrphansRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CleanupOrphansResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenMaintenanceService_RepairFolderPaths0_HTTP_Handler(srv WardenMaintenanceServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RepairFolderPathsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenMaintenanceServiceRepairFolderPaths)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RepairFolderPaths(ctx, req.(*RepairFolderPathsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RepairFolderPathsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenMaintenanceService_RecomputeStatistics0_HTTP_Handler(srv WardenMaintenanceServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RecomputeStatisticsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenMaintenanceServiceRecomputeStatistics)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RecomputeStatistics(ctx, req.(*RecomputeStatisticsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecomputeStatisticsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenMaintenanceService_PurgeTrash0_HTTP_Handler(srv WardenMaintenanceServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeTrashRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenMaintenanceServicePurgeTrash)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeTrash(ctx, req.(*PurgeTrashRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurgeTrashResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenMaintenanceService_SyncVersions0_HTTP_Handler(srv WardenMaintenanceServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SyncVersionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenMaintenanceServiceSyncVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SyncVersions(ctx, req.(*SyncVersionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SyncVersionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenMaintenanceService_MigrateChecksums0_HTTP_Handler(srv WardenMaintenanceServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MigrateChecksumsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenMaintenanceServiceMigrateChecksums)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MigrateChecksums(ctx, req.(*MigrateChecksumsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MigrateChecksumsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenMaintenanceService_RotateFieldKey0_HTTP_Handler(srv WardenMaintenanceServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RotateFieldKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenMaintenanceServiceRotateFieldKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RotateFieldKey(ctx, req.(*RotateFieldKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RotateFieldKeyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenMaintenanceService_ReencryptFields0_HTTP_Handler(srv WardenMaintenanceServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReencryptFieldsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenMaintenanceServiceReencryptFields)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReencryptFields(ctx, req.(*ReencryptFieldsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReencryptFieldsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenMaintenanceService_PurgeTenantData0_HTTP_Handler(srv WardenMaintenanceServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeTenantDataRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenMaintenanceServicePurgeTenantData)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeTenantData(ctx, req.(*PurgeTenantDataRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurgeTenantDataResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenMaintenanceServiceHTTPClient interface {
+	// CleanupOrphans Delete permission tuples whose folder or secret no longer exists
+	CleanupOrphans(ctx context.Context, req *CleanupOrphansRequest, opts ...http.CallOption) (rsp *CleanupOrphansResponse, err error)
+	// MigrateChecksums Recompute the checksums of versions made with another algorithm or
+	// checksum key, reading their passwords from Vault
+	MigrateChecksums(ctx context.Context, req *MigrateChecksumsRequest, opts ...http.CallOption) (rsp *MigrateChecksumsResponse, err error)
+	// PurgeTenantData Delete all data of a tenant, including its Vault data and audit logs,
+	// for offboarding. The steps run in dependency order and are reported
+	// with their counts.
+	PurgeTenantData(ctx context.Context, req *PurgeTenantDataRequest, opts ...http.CallOption) (rsp *PurgeTenantDataResponse, err error)
+	// PurgeTrash Permanently delete soft-deleted secrets, including their Vault data, and
+	// the folders in the trash once no secret is left in them
+	PurgeTrash(ctx context.Context, req *PurgeTrashRequest, opts ...http.CallOption) (rsp *PurgeTrashResponse, err error)
+	// RecomputeStatistics Recompute the Prometheus gauges from the database
+	RecomputeStatistics(ctx context.Context, req *RecomputeStatisticsRequest, opts ...http.CallOption) (rsp *RecomputeStatisticsResponse, err error)
+	// ReencryptFields Encrypt the username, URL and metadata of secrets stored under an older
+	// field key, or in plaintext, with the current one
+	ReencryptFields(ctx context.Context, req *ReencryptFieldsRequest, opts ...http.CallOption) (rsp *ReencryptFieldsResponse, err error)
+	// RepairFolderPaths Recompute folder paths and depths from the parent links
+	RepairFolderPaths(ctx context.Context, req *RepairFolderPathsRequest, opts ...http.CallOption) (rsp *RepairFolderPathsResponse, err error)
+	// RotateFieldKey Create a new field key for the encrypted secret fields, wrapping the
+	// existing ones again with the latest version of the Transit key
+	RotateFieldKey(ctx context.Context, req *RotateFieldKeyRequest, opts ...http.CallOption) (rsp *RotateFieldKeyResponse, err error)
+	// SyncVersions Reconcile the version records of secrets with the versions kept in Vault
+	SyncVersions(ctx context.Context, req *SyncVersionsRequest, opts ...http.CallOption) (rsp *SyncVersionsResponse, err error)
+}
+
+type WardenMaintenanceServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenMaintenanceServiceHTTPClient(client *http.Client) WardenMaintenanceServiceHTTPClient {
+	return &WardenMaintenanceServiceHTTPClientImpl{client}
+}
+
+// CleanupOrphans Delete permission tuples whose folder or secret no longer exists
+func (c *WardenMaintenanceServiceHTTPClientImpl) CleanupOrphans(ctx context.Context, in *CleanupOrphansRequest, opts ...http.CallOption) (*CleanupOrphansResponse, error) {
+	var out CleanupOrphansResponse
+	pattern := "/v1/maintenance/orphans:cleanup"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenMaintenanceServiceCleanupOrphans))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MigrateChecksums Recompute the checksums of versions made with another algorithm or
+// checksum key, reading their passwords from Vault
+func (c *WardenMaintenanceServiceHTTPClientImpl) MigrateChecksums(ctx context.Context, in *MigrateChecksumsRequest, opts ...http.CallOption) (*MigrateChecksumsResponse, error) {
+	var out MigrateChecksumsResponse
+	pattern := "/v1/maintenance/checksums:migrate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenMaintenanceServiceMigrateChecksums))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PurgeTenantData Delete all data of a tenant, including its Vault data and audit logs,
+// for offboarding. The steps run in dependency order and are reported
+// with their counts.
+func (c *WardenMaintenanceServiceHTTPClientImpl) PurgeTenantData(ctx context.Context, in *PurgeTenantDataRequest, opts ...http.CallOption) (*PurgeTenantDataResponse, error) {
+	var out PurgeTenantDataResponse
+	pattern := "/v1/maintenance/tenants/{tenant_id}:purge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenMaintenanceServicePurgeTenantData))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PurgeTrash Permanently delete soft-deleted secrets, including their Vault data, and
+// the folders in the trash once no secret is left in them
+func (c *WardenMaintenanceServiceHTTPClientImpl) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...http.CallOption) (*PurgeTrashResponse, error) {
+	var out PurgeTrashResponse
+	pattern := "/v1/maintenance/trash:purge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenMaintenanceServicePurgeTrash))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RecomputeStatistics Recompute the Prometheus gauges from the database
+func (c *WardenMaintenanceServiceHTTPClientImpl) RecomputeStatistics(ctx context.Context, in *RecomputeStatisticsRequest, opts ...http.CallOption) (*RecomputeStatisticsResponse, error) {
+	var out RecomputeStatisticsResponse
+	pattern := "/v1/maintenance/statistics:recompute"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenMaintenanceServiceRecomputeStatistics))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReencryptFields Encrypt the username, URL and metadata of secrets stored under an older
+// field key, or in plaintext, with the current one
+func (c *WardenMaintenanceServiceHTTPClientImpl) ReencryptFields(ctx context.Context, in *ReencryptFieldsRequest, opts ...http.CallOption) (*ReencryptFieldsResponse, error) {
+	var out ReencryptFieldsResponse
+	pattern := "/v1/maintenance/field-keys:reencrypt"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenMaintenanceServiceReencryptFields))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RepairFolderPaths Recompute folder paths and depths from the parent links
+func (c *WardenMaintenanceServiceHTTPClientImpl) RepairFolderPaths(ctx context.Context, in *RepairFolderPathsRequest, opts ...http.CallOption) (*RepairFolderPathsResponse, error) {
+	var out RepairFolderPathsResponse
+	pattern := "/v1/maintenance/folders:repair"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenMaintenanceServiceRepairFolderPaths))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RotateFieldKey Create a new field key for the encrypted secret fields, wrapping the
+// existing ones again with the latest version of the Transit key
+func (c *WardenMaintenanceServiceHTTPClientImpl) RotateFieldKey(ctx context.Context, in *RotateFieldKeyRequest, opts ...http.CallOption) (*RotateFieldKeyResponse, error) {
+	var out RotateFieldKeyResponse
+	pattern := "/v1/maintenance/field-keys:rotate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenMaintenanceServiceRotateFieldKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SyncVersions Reconcile the version records of secrets with the versions kept in Vault
+func (c *WardenMaintenanceServiceHTTPClientImpl) SyncVersions(ctx context.Context, in *SyncVersionsRequest, opts ...http.CallOption) (*SyncVersionsResponse, error) {
+	var out SyncVersionsResponse
+	pattern := "/v1/maintenance/versions:sync"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenMaintenanceServiceSyncVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,605 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/password_policy.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Enforced on CreateSecret and UpdateSecretPassword while enabled
+type PasswordPolicy struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Enabled  bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Minimum length in characters (0 = no minimum)
+	MinLength        uint32 `protobuf:"varint,3,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	RequireLowercase bool   `protobuf:"varint,4,opt,name=require_lowercase,json=requireLowercase,proto3" json:"require_lowercase,omitempty"`
+	RequireUppercase bool   `protobuf:"varint,5,opt,name=require_uppercase,json=requireUppercase,proto3" json:"require_uppercase,omitempty"`
+	RequireDigit     bool   `protobuf:"varint,6,opt,name=require_digit,json=requireDigit,proto3" json:"require_digit,omitempty"`
+	RequireSymbol    bool   `protobuf:"varint,7,opt,name=require_symbol,json=requireSymbol,proto3" json:"require_symbol,omitempty"`
+	// Words passwords must not contain (case-insensitive)
+	BannedWords []string `protobuf:"bytes,8,rep,name=banned_words,json=bannedWords,proto3" json:"banned_words,omitempty"`
+	// Days after which a password must be changed (0 = never)
+	MaxAgeDays uint32 `protobuf:"varint,9,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
+	// Number of previous passwords of a secret that may not be reused (0 = no check)
+	HistorySize   uint32                 `protobuf:"varint,10,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordPolicy) Reset() {
+	*x = PasswordPolicy{}
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicy) ProtoMessage() {}
+
+func (x *PasswordPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordPolicy.ProtoReflect.Descriptor instead.
+func (*PasswordPolicy) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_password_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PasswordPolicy) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetMinLength() uint32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetRequireLowercase() bool {
+	if x != nil {
+		return x.RequireLowercase
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireUppercase() bool {
+	if x != nil {
+		return x.RequireUppercase
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireDigit() bool {
+	if x != nil {
+		return x.RequireDigit
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireSymbol() bool {
+	if x != nil {
+		return x.RequireSymbol
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetBannedWords() []string {
+	if x != nil {
+		return x.BannedWords
+	}
+	return nil
+}
+
+func (x *PasswordPolicy) GetMaxAgeDays() uint32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetHistorySize() uint32 {
+	if x != nil {
+		return x.HistorySize
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type GetPasswordPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant to read (defaults to the caller's tenant; other tenants require platform admin)
+	TenantId      *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPasswordPolicyRequest) Reset() {
+	*x = GetPasswordPolicyRequest{}
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPasswordPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPasswordPolicyRequest) ProtoMessage() {}
+
+func (x *GetPasswordPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPasswordPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPasswordPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_password_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPasswordPolicyRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type SetPasswordPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant to configure (defaults to the caller's tenant)
+	TenantId         *uint32  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Enabled          bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MinLength        uint32   `protobuf:"varint,3,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	RequireLowercase bool     `protobuf:"varint,4,opt,name=require_lowercase,json=requireLowercase,proto3" json:"require_lowercase,omitempty"`
+	RequireUppercase bool     `protobuf:"varint,5,opt,name=require_uppercase,json=requireUppercase,proto3" json:"require_uppercase,omitempty"`
+	RequireDigit     bool     `protobuf:"varint,6,opt,name=require_digit,json=requireDigit,proto3" json:"require_digit,omitempty"`
+	RequireSymbol    bool     `protobuf:"varint,7,opt,name=require_symbol,json=requireSymbol,proto3" json:"require_symbol,omitempty"`
+	BannedWords      []string `protobuf:"bytes,8,rep,name=banned_words,json=bannedWords,proto3" json:"banned_words,omitempty"`
+	MaxAgeDays       uint32   `protobuf:"varint,9,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
+	HistorySize      uint32   `protobuf:"varint,10,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetPasswordPolicyRequest) Reset() {
+	*x = SetPasswordPolicyRequest{}
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPasswordPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPasswordPolicyRequest) ProtoMessage() {}
+
+func (x *SetPasswordPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPasswordPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetPasswordPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_password_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetPasswordPolicyRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *SetPasswordPolicyRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetPasswordPolicyRequest) GetMinLength() uint32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *SetPasswordPolicyRequest) GetRequireLowercase() bool {
+	if x != nil {
+		return x.RequireLowercase
+	}
+	return false
+}
+
+func (x *SetPasswordPolicyRequest) GetRequireUppercase() bool {
+	if x != nil {
+		return x.RequireUppercase
+	}
+	return false
+}
+
+func (x *SetPasswordPolicyRequest) GetRequireDigit() bool {
+	if x != nil {
+		return x.RequireDigit
+	}
+	return false
+}
+
+func (x *SetPasswordPolicyRequest) GetRequireSymbol() bool {
+	if x != nil {
+		return x.RequireSymbol
+	}
+	return false
+}
+
+func (x *SetPasswordPolicyRequest) GetBannedWords() []string {
+	if x != nil {
+		return x.BannedWords
+	}
+	return nil
+}
+
+func (x *SetPasswordPolicyRequest) GetMaxAgeDays() uint32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *SetPasswordPolicyRequest) GetHistorySize() uint32 {
+	if x != nil {
+		return x.HistorySize
+	}
+	return 0
+}
+
+type ValidateAgainstPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Password to check; leave unset to only check the age of secret_id's current password
+	Password *string `protobuf:"bytes,1,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Secret the password is meant for, enabling the reuse and age checks
+	SecretId      *string `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3,oneof" json:"secret_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateAgainstPolicyRequest) Reset() {
+	*x = ValidateAgainstPolicyRequest{}
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateAgainstPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateAgainstPolicyRequest) ProtoMessage() {}
+
+func (x *ValidateAgainstPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateAgainstPolicyRequest.ProtoReflect.Descriptor instead.
+func (*ValidateAgainstPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_password_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValidateAgainstPolicyRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *ValidateAgainstPolicyRequest) GetSecretId() string {
+	if x != nil && x.SecretId != nil {
+		return *x.SecretId
+	}
+	return ""
+}
+
+type PasswordPolicyViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// min_length, require_lowercase, require_uppercase, require_digit,
+	// require_symbol, banned_word, reused or max_age
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordPolicyViolation) Reset() {
+	*x = PasswordPolicyViolation{}
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordPolicyViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicyViolation) ProtoMessage() {}
+
+func (x *PasswordPolicyViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordPolicyViolation.ProtoReflect.Descriptor instead.
+func (*PasswordPolicyViolation) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_password_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PasswordPolicyViolation) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PasswordPolicyViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidateAgainstPolicyResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Valid         bool                       `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Violations    []*PasswordPolicyViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateAgainstPolicyResponse) Reset() {
+	*x = ValidateAgainstPolicyResponse{}
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateAgainstPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateAgainstPolicyResponse) ProtoMessage() {}
+
+func (x *ValidateAgainstPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_password_policy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateAgainstPolicyResponse.ProtoReflect.Descriptor instead.
+func (*ValidateAgainstPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_password_policy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ValidateAgainstPolicyResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateAgainstPolicyResponse) GetViolations() []*PasswordPolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+var File_warden_service_v1_password_policy_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_password_policy_proto_rawDesc = "" +
+	"\n" +
+	"'warden/service/v1/password_policy.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\"\xc6\x03\n" +
+	"\x0ePasswordPolicy\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x03 \x01(\rR\tminLength\x12+\n" +
+	"\x11require_lowercase\x18\x04 \x01(\bR\x10requireLowercase\x12+\n" +
+	"\x11require_uppercase\x18\x05 \x01(\bR\x10requireUppercase\x12#\n" +
+	"\rrequire_digit\x18\x06 \x01(\bR\frequireDigit\x12%\n" +
+	"\x0erequire_symbol\x18\a \x01(\bR\rrequireSymbol\x12!\n" +
+	"\fbanned_words\x18\b \x03(\tR\vbannedWords\x12 \n" +
+	"\fmax_age_days\x18\t \x01(\rR\n" +
+	"maxAgeDays\x12!\n" +
+	"\fhistory_size\x18\n" +
+	" \x01(\rR\vhistorySize\x12@\n" +
+	"\vupdate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"updateTime\x88\x01\x01B\x0e\n" +
+	"\f_update_time\"J\n" +
+	"\x18GetPasswordPolicyRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"\xc1\x03\n" +
+	"\x18SetPasswordPolicyRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12'\n" +
+	"\n" +
+	"min_length\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\x80\bR\tminLength\x12+\n" +
+	"\x11require_lowercase\x18\x04 \x01(\bR\x10requireLowercase\x12+\n" +
+	"\x11require_uppercase\x18\x05 \x01(\bR\x10requireUppercase\x12#\n" +
+	"\rrequire_digit\x18\x06 \x01(\bR\frequireDigit\x12%\n" +
+	"\x0erequire_symbol\x18\a \x01(\bR\rrequireSymbol\x124\n" +
+	"\fbanned_words\x18\b \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10\xf4\x03\"\x06r\x04\x10\x01\x18@R\vbannedWords\x12*\n" +
+	"\fmax_age_days\x18\t \x01(\rB\b\xbaH\x05*\x03\x18\xc2\x1cR\n" +
+	"maxAgeDays\x12*\n" +
+	"\fhistory_size\x18\n" +
+	" \x01(\rB\a\xbaH\x04*\x02\x18dR\vhistorySizeB\f\n" +
+	"\n" +
+	"_tenant_id\"\xab\x01\n" +
+	"\x1cValidateAgainstPolicyRequest\x123\n" +
+	"\bpassword\x18\x01 \x01(\tB\x12\xbaH\x06r\x04\x18\x80\x80\x04ڶ\x1a\x02z\x00\x80\x01\x01H\x00R\bpassword\x88\x01\x01\x12;\n" +
+	"\tsecret_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x01R\bsecretId\x88\x01\x01B\v\n" +
+	"\t_passwordB\f\n" +
+	"\n" +
+	"_secret_id\"G\n" +
+	"\x17PasswordPolicyViolation\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x81\x01\n" +
+	"\x1dValidateAgainstPolicyResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12J\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2*.warden.service.v1.PasswordPolicyViolationR\n" +
+	"violations2\xcc\x03\n" +
+	"\x1bWardenPasswordPolicyService\x12\x80\x01\n" +
+	"\x11GetPasswordPolicy\x12+.warden.service.v1.GetPasswordPolicyRequest\x1a!.warden.service.v1.PasswordPolicy\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/password-policy\x12\x83\x01\n" +
+	"\x11SetPasswordPolicy\x12+.warden.service.v1.SetPasswordPolicyRequest\x1a!.warden.service.v1.PasswordPolicy\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/password-policy\x12\xa3\x01\n" +
+	"\x15ValidateAgainstPolicy\x12/.warden.service.v1.ValidateAgainstPolicyRequest\x1a0.warden.service.v1.ValidateAgainstPolicyResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/password-policy:validateB\xdb\x01\n" +
+	"\x15com.warden.service.v1B\x13PasswordPolicyProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_password_policy_proto_rawDescOnce sync.Once
+	file_warden_service_v1_password_policy_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_password_policy_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_password_policy_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_password_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_password_policy_proto_rawDesc), len(file_warden_service_v1_password_policy_proto_rawDesc)))
+	})
+	return file_warden_service_v1_password_policy_proto_rawDescData
+}
+
+var file_warden_service_v1_password_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_warden_service_v1_password_policy_proto_goTypes = []any{
+	(*PasswordPolicy)(nil),                // 0: warden.service.v1.PasswordPolicy
+	(*GetPasswordPolicyRequest)(nil),      // 1: warden.service.v1.GetPasswordPolicyRequest
+	(*SetPasswordPolicyRequest)(nil),      // 2: warden.service.v1.SetPasswordPolicyRequest
+	(*ValidateAgainstPolicyRequest)(nil),  // 3: warden.service.v1.ValidateAgainstPolicyRequest
+	(*PasswordPolicyViolation)(nil),       // 4: warden.service.v1.PasswordPolicyViolation
+	(*ValidateAgainstPolicyResponse)(nil), // 5: warden.service.v1.ValidateAgainstPolicyResponse
+	(*timestamppb.Timestamp)(nil),         // 6: google.protobuf.Timestamp
+}
+var file_warden_service_v1_password_policy_proto_depIdxs = []int32{
+	6, // 0: warden.service.v1.PasswordPolicy.update_time:type_name -> google.protobuf.Timestamp
+	4, // 1: warden.service.v1.ValidateAgainstPolicyResponse.violations:type_name -> warden.service.v1.PasswordPolicyViolation
+	1, // 2: warden.service.v1.WardenPasswordPolicyService.GetPasswordPolicy:input_type -> warden.service.v1.GetPasswordPolicyRequest
+	2, // 3: warden.service.v1.WardenPasswordPolicyService.SetPasswordPolicy:input_type -> warden.service.v1.SetPasswordPolicyRequest
+	3, // 4: warden.service.v1.WardenPasswordPolicyService.ValidateAgainstPolicy:input_type -> warden.service.v1.ValidateAgainstPolicyRequest
+	0, // 5: warden.service.v1.WardenPasswordPolicyService.GetPasswordPolicy:output_type -> warden.service.v1.PasswordPolicy
+	0, // 6: warden.service.v1.WardenPasswordPolicyService.SetPasswordPolicy:output_type -> warden.service.v1.PasswordPolicy
+	5, // 7: warden.service.v1.WardenPasswordPolicyService.ValidateAgainstPolicy:output_type -> warden.service.v1.ValidateAgainstPolicyResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_password_policy_proto_init() }
+func file_warden_service_v1_password_policy_proto_init() {
+	if File_warden_service_v1_password_policy_proto != nil {
+		return
+	}
+	file_warden_service_v1_password_policy_proto_msgTypes[0].OneofWrappers = []any{}
+	file_warden_service_v1_password_policy_proto_msgTypes[1].OneofWrappers = []any{}
+	file_warden_service_v1_password_policy_proto_msgTypes[2].OneofWrappers = []any{}
+	file_warden_service_v1_password_policy_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_password_policy_proto_rawDesc), len(file_warden_service_v1_password_policy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_password_policy_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_password_policy_proto_depIdxs,
+		MessageInfos:      file_warden_service_v1_password_policy_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_password_policy_proto = out.File
+	file_warden_service_v1_password_policy_proto_goTypes = nil
+	file_warden_service_v1_password_policy_proto_depIdxs = nil
+}
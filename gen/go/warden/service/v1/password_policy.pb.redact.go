@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/password_policy.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ timestamppb.Timestamp
+	_ redact.FieldRules
+)
+
+// RegisterRedactedWardenPasswordPolicyServiceServer wraps the WardenPasswordPolicyServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenPasswordPolicyServiceServer(s grpc.ServiceRegistrar, srv WardenPasswordPolicyServiceServer, bypass redact.Bypass) {
+	RegisterWardenPasswordPolicyServiceServer(s, RedactedWardenPasswordPolicyServiceServer(srv, bypass))
+}
+
+func RedactedWardenPasswordPolicyServiceServer(srv WardenPasswordPolicyServiceServer, bypass redact.Bypass) WardenPasswordPolicyServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenPasswordPolicyServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenPasswordPolicyServiceServer struct {
+	UnsafeWardenPasswordPolicyServiceServer
+	srv    WardenPasswordPolicyServiceServer
+	bypass redact.Bypass
+}
+
+// GetPasswordPolicy is the redacted wrapper for the actual WardenPasswordPolicyServiceServer.GetPasswordPolicy method
+// Unary RPC
+func (s *redactedWardenPasswordPolicyServiceServer) GetPasswordPolicy(ctx context.Context, in *GetPasswordPolicyRequest) (*PasswordPolicy, error) {
+	res, err := s.srv.GetPasswordPolicy(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SetPasswordPolicy is the redacted wrapper for the actual WardenPasswordPolicyServiceServer.SetPasswordPolicy method
+// Unary RPC
+func (s *redactedWardenPasswordPolicyServiceServer) SetPasswordPolicy(ctx context.Context, in *SetPasswordPolicyRequest) (*PasswordPolicy, error) {
+	res, err := s.srv.SetPasswordPolicy(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ValidateAgainstPolicy is the redacted wrapper for the actual WardenPasswordPolicyServiceServer.ValidateAgainstPolicy method
+// Unary RPC
+func (s *redactedWardenPasswordPolicyServiceServer) ValidateAgainstPolicy(ctx context.Context, in *ValidateAgainstPolicyRequest) (*ValidateAgainstPolicyResponse, error) {
+	res, err := s.srv.ValidateAgainstPolicy(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for PasswordPolicy
+func (x *PasswordPolicy) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: Enabled
+
+	// Safe field: MinLength
+
+	// Safe field: RequireLowercase
+
+	// Safe field: RequireUppercase
+
+	// Safe field: RequireDigit
+
+	// Safe field: RequireSymbol
+
+	// Safe field: BannedWords
+
+	// Safe field: MaxAgeDays
+
+	// Safe field: HistorySize
+
+	// Safe field: UpdateTime
+	return x.String()
+}
+
+// Redact method implementation for GetPasswordPolicyRequest
+func (x *GetPasswordPolicyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+	return x.String()
+}
+
+// Redact method implementation for SetPasswordPolicyRequest
+func (x *SetPasswordPolicyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: Enabled
+
+	// Safe field: MinLength
+
+	// Safe field: RequireLowercase
+
+	// Safe field: RequireUppercase
+
+	// Safe field: RequireDigit
+
+	// Safe field: RequireSymbol
+
+	// Safe field: BannedWords
+
+	// Safe field: MaxAgeDays
+
+	// Safe field: HistorySize
+	return x.String()
+}
+
+// Redact method implementation for ValidateAgainstPolicyRequest
+func (x *ValidateAgainstPolicyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Redacting field: Password
+	PasswordTmp := ``
+	x.Password = &PasswordTmp
+
+	// Safe field: SecretId
+	return x.String()
+}
+
+// Redact method implementation for PasswordPolicyViolation
+func (x *PasswordPolicyViolation) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Code
+
+	// Safe field: Message
+	return x.String()
+}
+
+// Redact method implementation for ValidateAgainstPolicyResponse
+func (x *ValidateAgainstPolicyResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Valid
+
+	// Safe field: Violations
+	return x.String()
+}
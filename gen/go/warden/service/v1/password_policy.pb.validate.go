@@ -0,0 +1,771 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/password_policy.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on PasswordPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PasswordPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PasswordPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PasswordPolicyMultiError,
+// or nil if none found.
+func (m *PasswordPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PasswordPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Enabled
+
+	// no validation rules for MinLength
+
+	// no validation rules for RequireLowercase
+
+	// no validation rules for RequireUppercase
+
+	// no validation rules for RequireDigit
+
+	// no validation rules for RequireSymbol
+
+	// no validation rules for MaxAgeDays
+
+	// no validation rules for HistorySize
+
+	if m.UpdateTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdateTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PasswordPolicyValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PasswordPolicyValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PasswordPolicyValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PasswordPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PasswordPolicyMultiError is an error wrapping multiple validation errors
+// returned by PasswordPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type PasswordPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PasswordPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PasswordPolicyMultiError) AllErrors() []error { return m }
+
+// PasswordPolicyValidationError is the validation error returned by
+// PasswordPolicy.Validate if the designated constraints aren't met.
+type PasswordPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PasswordPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PasswordPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PasswordPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PasswordPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PasswordPolicyValidationError) ErrorName() string { return "PasswordPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PasswordPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPasswordPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PasswordPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PasswordPolicyValidationError{}
+
+// Validate checks the field values on GetPasswordPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPasswordPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPasswordPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPasswordPolicyRequestMultiError, or nil if none found.
+func (m *GetPasswordPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPasswordPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return GetPasswordPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPasswordPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPasswordPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPasswordPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPasswordPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPasswordPolicyRequestMultiError) AllErrors() []error { return m }
+
+// GetPasswordPolicyRequestValidationError is the validation error returned by
+// GetPasswordPolicyRequest.Validate if the designated constraints aren't met.
+type GetPasswordPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPasswordPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPasswordPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPasswordPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPasswordPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPasswordPolicyRequestValidationError) ErrorName() string {
+	return "GetPasswordPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPasswordPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPasswordPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPasswordPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPasswordPolicyRequestValidationError{}
+
+// Validate checks the field values on SetPasswordPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetPasswordPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPasswordPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPasswordPolicyRequestMultiError, or nil if none found.
+func (m *SetPasswordPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPasswordPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for MinLength
+
+	// no validation rules for RequireLowercase
+
+	// no validation rules for RequireUppercase
+
+	// no validation rules for RequireDigit
+
+	// no validation rules for RequireSymbol
+
+	// no validation rules for MaxAgeDays
+
+	// no validation rules for HistorySize
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return SetPasswordPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPasswordPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by SetPasswordPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetPasswordPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPasswordPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPasswordPolicyRequestMultiError) AllErrors() []error { return m }
+
+// SetPasswordPolicyRequestValidationError is the validation error returned by
+// SetPasswordPolicyRequest.Validate if the designated constraints aren't met.
+type SetPasswordPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPasswordPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPasswordPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPasswordPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPasswordPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPasswordPolicyRequestValidationError) ErrorName() string {
+	return "SetPasswordPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetPasswordPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPasswordPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPasswordPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPasswordPolicyRequestValidationError{}
+
+// Validate checks the field values on ValidateAgainstPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateAgainstPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateAgainstPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateAgainstPolicyRequestMultiError, or nil if none found.
+func (m *ValidateAgainstPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateAgainstPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.SecretId != nil {
+		// no validation rules for SecretId
+	}
+
+	if len(errors) > 0 {
+		return ValidateAgainstPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateAgainstPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by ValidateAgainstPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ValidateAgainstPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateAgainstPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateAgainstPolicyRequestMultiError) AllErrors() []error { return m }
+
+// ValidateAgainstPolicyRequestValidationError is the validation error returned
+// by ValidateAgainstPolicyRequest.Validate if the designated constraints
+// aren't met.
+type ValidateAgainstPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateAgainstPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateAgainstPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateAgainstPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateAgainstPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateAgainstPolicyRequestValidationError) ErrorName() string {
+	return "ValidateAgainstPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateAgainstPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateAgainstPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateAgainstPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateAgainstPolicyRequestValidationError{}
+
+// Validate checks the field values on PasswordPolicyViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PasswordPolicyViolation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PasswordPolicyViolation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PasswordPolicyViolationMultiError, or nil if none found.
+func (m *PasswordPolicyViolation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PasswordPolicyViolation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return PasswordPolicyViolationMultiError(errors)
+	}
+
+	return nil
+}
+
+// PasswordPolicyViolationMultiError is an error wrapping multiple validation
+// errors returned by PasswordPolicyViolation.ValidateAll() if the designated
+// constraints aren't met.
+type PasswordPolicyViolationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PasswordPolicyViolationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PasswordPolicyViolationMultiError) AllErrors() []error { return m }
+
+// PasswordPolicyViolationValidationError is the validation error returned by
+// PasswordPolicyViolation.Validate if the designated constraints aren't met.
+type PasswordPolicyViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PasswordPolicyViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PasswordPolicyViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PasswordPolicyViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PasswordPolicyViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PasswordPolicyViolationValidationError) ErrorName() string {
+	return "PasswordPolicyViolationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PasswordPolicyViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPasswordPolicyViolation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PasswordPolicyViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PasswordPolicyViolationValidationError{}
+
+// Validate checks the field values on ValidateAgainstPolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateAgainstPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateAgainstPolicyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ValidateAgainstPolicyResponseMultiError, or nil if none found.
+func (m *ValidateAgainstPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateAgainstPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Valid
+
+	for idx, item := range m.GetViolations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateAgainstPolicyResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateAgainstPolicyResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateAgainstPolicyResponseValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ValidateAgainstPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateAgainstPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by ValidateAgainstPolicyResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ValidateAgainstPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateAgainstPolicyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateAgainstPolicyResponseMultiError) AllErrors() []error { return m }
+
+// ValidateAgainstPolicyResponseValidationError is the validation error
+// returned by ValidateAgainstPolicyResponse.Validate if the designated
+// constraints aren't met.
+type ValidateAgainstPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateAgainstPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateAgainstPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateAgainstPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateAgainstPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateAgainstPolicyResponseValidationError) ErrorName() string {
+	return "ValidateAgainstPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateAgainstPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateAgainstPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateAgainstPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateAgainstPolicyResponseValidationError{}
@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/password_policy.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenPasswordPolicyService_GetPasswordPolicy_FullMethodName     = "/warden.service.v1.WardenPasswordPolicyService/GetPasswordPolicy"
+	WardenPasswordPolicyService_SetPasswordPolicy_FullMethodName     = "/warden.service.v1.WardenPasswordPolicyService/SetPasswordPolicy"
+	WardenPasswordPolicyService_ValidateAgainstPolicy_FullMethodName = "/warden.service.v1.WardenPasswordPolicyService/ValidateAgainstPolicy"
+)
+
+// WardenPasswordPolicyServiceClient is the client API for WardenPasswordPolicyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Password Policy Service - per-tenant rules for new secret passwords
+type WardenPasswordPolicyServiceClient interface {
+	// Get the password policy of a tenant
+	GetPasswordPolicy(ctx context.Context, in *GetPasswordPolicyRequest, opts ...grpc.CallOption) (*PasswordPolicy, error)
+	// Replace the password policy of a tenant (platform admin only)
+	SetPasswordPolicy(ctx context.Context, in *SetPasswordPolicyRequest, opts ...grpc.CallOption) (*PasswordPolicy, error)
+	// Check a password, or the current password age of a secret, against the caller's tenant policy
+	ValidateAgainstPolicy(ctx context.Context, in *ValidateAgainstPolicyRequest, opts ...grpc.CallOption) (*ValidateAgainstPolicyResponse, error)
+}
+
+type wardenPasswordPolicyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenPasswordPolicyServiceClient(cc grpc.ClientConnInterface) WardenPasswordPolicyServiceClient {
+	return &wardenPasswordPolicyServiceClient{cc}
+}
+
+func (c *wardenPasswordPolicyServiceClient) GetPasswordPolicy(ctx context.Context, in *GetPasswordPolicyRequest, opts ...grpc.CallOption) (*PasswordPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordPolicy)
+	err := c.cc.Invoke(ctx, WardenPasswordPolicyService_GetPasswordPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenPasswordPolicyServiceClient) SetPasswordPolicy(ctx context.Context, in *SetPasswordPolicyRequest, opts ...grpc.CallOption) (*PasswordPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordPolicy)
+	err := c.cc.Invoke(ctx, WardenPasswordPolicyService_SetPasswordPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenPasswordPolicyServiceClient) ValidateAgainstPolicy(ctx context.Context, in *ValidateAgainstPolicyRequest, opts ...grpc.CallOption) (*ValidateAgainstPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateAgainstPolicyResponse)
+	err := c.cc.Invoke(ctx, WardenPasswordPolicyService_ValidateAgainstPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenPasswordPolicyServiceServer is the server API for WardenPasswordPolicyService service.
+// All implementations must embed UnimplementedWardenPasswordPolicyServiceServer
+// for forward compatibility.
+//
+// Password Policy Service - per-tenant rules for new secret passwords
+type WardenPasswordPolicyServiceServer interface {
+	// Get the password policy of a tenant
+	GetPasswordPolicy(context.Context, *GetPasswordPolicyRequest) (*PasswordPolicy, error)
+	// Replace the password policy of a tenant (platform admin only)
+	SetPasswordPolicy(context.Context, *SetPasswordPolicyRequest) (*PasswordPolicy, error)
+	// Check a password, or the current password age of a secret, against the caller's tenant policy
+	ValidateAgainstPolicy(context.Context, *ValidateAgainstPolicyRequest) (*ValidateAgainstPolicyResponse, error)
+	mustEmbedUnimplementedWardenPasswordPolicyServiceServer()
+}
+
+// UnimplementedWardenPasswordPolicyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenPasswordPolicyServiceServer struct{}
+
+func (UnimplementedWardenPasswordPolicyServiceServer) GetPasswordPolicy(context.Context, *GetPasswordPolicyRequest) (*PasswordPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPasswordPolicy not implemented")
+}
+func (UnimplementedWardenPasswordPolicyServiceServer) SetPasswordPolicy(context.Context, *SetPasswordPolicyRequest) (*PasswordPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPasswordPolicy not implemented")
+}
+func (UnimplementedWardenPasswordPolicyServiceServer) ValidateAgainstPolicy(context.Context, *ValidateAgainstPolicyRequest) (*ValidateAgainstPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateAgainstPolicy not implemented")
+}
+func (UnimplementedWardenPasswordPolicyServiceServer) mustEmbedUnimplementedWardenPasswordPolicyServiceServer() {
+}
+func (UnimplementedWardenPasswordPolicyServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWardenPasswordPolicyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenPasswordPolicyServiceServer will
+// result in compilation errors.
+type UnsafeWardenPasswordPolicyServiceServer interface {
+	mustEmbedUnimplementedWardenPasswordPolicyServiceServer()
+}
+
+func RegisterWardenPasswordPolicyServiceServer(s grpc.ServiceRegistrar, srv WardenPasswordPolicyServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenPasswordPolicyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenPasswordPolicyService_ServiceDesc, srv)
+}
+
+func _WardenPasswordPolicyService_GetPasswordPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPasswordPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenPasswordPolicyServiceServer).GetPasswordPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenPasswordPolicyService_GetPasswordPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenPasswordPolicyServiceServer).GetPasswordPolicy(ctx, req.(*GetPasswordPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenPasswordPolicyService_SetPasswordPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPasswordPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenPasswordPolicyServiceServer).SetPasswordPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenPasswordPolicyService_SetPasswordPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenPasswordPolicyServiceServer).SetPasswordPolicy(ctx, req.(*SetPasswordPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenPasswordPolicyService_ValidateAgainstPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAgainstPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenPasswordPolicyServiceServer).ValidateAgainstPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenPasswordPolicyService_ValidateAgainstPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenPasswordPolicyServiceServer).ValidateAgainstPolicy(ctx, req.(*ValidateAgainstPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenPasswordPolicyService_ServiceDesc is the grpc.ServiceDesc for WardenPasswordPolicyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenPasswordPolicyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenPasswordPolicyService",
+	HandlerType: (*WardenPasswordPolicyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPasswordPolicy",
+			Handler:    _WardenPasswordPolicyService_GetPasswordPolicy_Handler,
+		},
+		{
+			MethodName: "SetPasswordPolicy",
+			Handler:    _WardenPasswordPolicyService_SetPasswordPolicy_Handler,
+		},
+		{
+			MethodName: "ValidateAgainstPolicy",
+			Handler:    _WardenPasswordPolicyService_ValidateAgainstPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/password_policy.proto",
+}
@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/password_policy.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenPasswordPolicyServiceGetPasswordPolicy = "/warden.service.v1.WardenPasswordPolicyService/GetPasswordPolicy"
+const OperationWardenPasswordPolicyServiceSetPasswordPolicy = "/warden.service.v1.WardenPasswordPolicyService/SetPasswordPolicy"
+const OperationWardenPasswordPolicyServiceValidateAgainstPolicy = "/warden.service.v1.WardenPasswordPolicyService/ValidateAgainstPolicy"
+
+type WardenPasswordPolicyServiceHTTPServer interface {
+	// GetPasswordPolicy Get the password policy of a tenant
+	GetPasswordPolicy(context.Context, *GetPasswordPolicyRequest) (*PasswordPolicy, error)
+	// SetPasswordPolicy Replace the password policy of a tenant (platform admin only)
+	SetPasswordPolicy(context.Context, *SetPasswordPolicyRequest) (*PasswordPolicy, error)
+	// ValidateAgainstPolicy Check a password, or the current password age of a secret, against the caller's tenant policy
+	ValidateAgainstPolicy(context.Context, *ValidateAgainstPolicyRequest) (*ValidateAgainstPolicyResponse, error)
+}
+
+func RegisterWardenPasswordPolicyServiceHTTPServer(s *http.Server, srv WardenPasswordPolicyServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/password-policy", _WardenPasswordPolicyService_GetPasswordPolicy0_HTTP_Handler(srv))
+	r.PUT("/v1/password-policy", _WardenPasswordPolicyService_SetPasswordPolicy0_HTTP_Handler(srv))
+	r.POST("/v1/password-policy:validate", _WardenPasswordPolicyService_ValidateAgainstPolicy0_HTTP_Handler(srv))
+}
+
+func _WardenPasswordPolicyService_GetPasswordPolicy0_HTTP_Handler(srv WardenPasswordPolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPasswordPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenPasswordPolicyServiceGetPasswordPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPasswordPolicy(ctx, req.(*GetPasswordPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PasswordPolicy)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenPasswordPolicyService_SetPasswordPolicy0_HTTP_Handler(srv WardenPasswordPolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetPasswordPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenPasswordPolicyServiceSetPasswordPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetPasswordPolicy(ctx, req.(*SetPasswordPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PasswordPolicy)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenPasswordPolicyService_ValidateAgainstPolicy0_HTTP_Handler(srv WardenPasswordPolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ValidateAgainstPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenPasswordPolicyServiceValidateAgainstPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ValidateAgainstPolicy(ctx, req.(*ValidateAgainstPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ValidateAgainstPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenPasswordPolicyServiceHTTPClient interface {
+	// GetPasswordPolicy Get the password policy of a tenant
+	GetPasswordPolicy(ctx context.Context, req *GetPasswordPolicyRequest, opts ...http.CallOption) (rsp *PasswordPolicy, err error)
+	// SetPasswordPolicy Replace the password policy of a tenant (platform admin only)
+	SetPasswordPolicy(ctx context.Context, req *SetPasswordPolicyRequest, opts ...http.CallOption) (rsp *PasswordPolicy, err error)
+	// ValidateAgainstPolicy Check a password, or the current password age of a secret, against the caller's tenant policy
+	ValidateAgainstPolicy(ctx context.Context, req *ValidateAgainstPolicyRequest, opts ...http.CallOption) (rsp *ValidateAgainstPolicyResponse, err error)
+}
+
+type WardenPasswordPolicyServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenPasswordPolicyServiceHTTPClient(client *http.Client) WardenPasswordPolicyServiceHTTPClient {
+	return &WardenPasswordPolicyServiceHTTPClientImpl{client}
+}
+
+// GetPasswordPolicy Get the password policy of a tenant
+func (c *WardenPasswordPolicyServiceHTTPClientImpl) GetPasswordPolicy(ctx context.Context, in *GetPasswordPolicyRequest, opts ...http.CallOption) (*PasswordPolicy, error) {
+	var out PasswordPolicy
+	pattern := "/v1/password-policy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenPasswordPolicyServiceGetPasswordPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetPasswordPolicy Replace the password policy of a tenant (platform admin only)
+func (c *WardenPasswordPolicyServiceHTTPClientImpl) SetPasswordPolicy(ctx context.Context, in *SetPasswordPolicyRequest, opts ...http.CallOption) (*PasswordPolicy, error) {
+	var out PasswordPolicy
+	pattern := "/v1/password-policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenPasswordPolicyServiceSetPasswordPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ValidateAgainstPolicy Check a password, or the current password age of a secret, against the caller's tenant policy
+func (c *WardenPasswordPolicyServiceHTTPClientImpl) ValidateAgainstPolicy(ctx context.Context, in *ValidateAgainstPolicyRequest, opts ...http.CallOption) (*ValidateAgainstPolicyResponse, error) {
+	var out ValidateAgainstPolicyResponse
+	pattern := "/v1/password-policy:validate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenPasswordPolicyServiceValidateAgainstPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -32,6 +32,7 @@ const (
 	ResourceType_RESOURCE_TYPE_UNSPECIFIED ResourceType = 0
 	ResourceType_RESOURCE_TYPE_FOLDER      ResourceType = 1
 	ResourceType_RESOURCE_TYPE_SECRET      ResourceType = 2
+	ResourceType_RESOURCE_TYPE_COLLECTION  ResourceType = 3
 )
 
 // Enum value maps for ResourceType.
@@ -40,11 +41,13 @@ var (
 		0: "RESOURCE_TYPE_UNSPECIFIED",
 		1: "RESOURCE_TYPE_FOLDER",
 		2: "RESOURCE_TYPE_SECRET",
+		3: "RESOURCE_TYPE_COLLECTION",
 	}
 	ResourceType_value = map[string]int32{
 		"RESOURCE_TYPE_UNSPECIFIED": 0,
 		"RESOURCE_TYPE_FOLDER":      1,
 		"RESOURCE_TYPE_SECRET":      2,
+		"RESOURCE_TYPE_COLLECTION":  3,
 	}
 )
 
@@ -586,8 +589,10 @@ type ListPermissionsRequest struct {
 	// Subject ID (optional - list for specific subject)
 	SubjectId *string `protobuf:"bytes,4,opt,name=subject_id,json=subjectId,proto3,oneof" json:"subject_id,omitempty"`
 	// Pagination
-	Page          *uint32 `protobuf:"varint,5,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize      *uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Page     *uint32 `protobuf:"varint,5,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Opaque next_cursor of the previous page; continues after it and ignores page
+	Cursor        *string `protobuf:"bytes,7,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -664,10 +669,19 @@ func (x *ListPermissionsRequest) GetPageSize() uint32 {
 	return 0
 }
 
+func (x *ListPermissionsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
 type ListPermissionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Permissions   []*PermissionTuple     `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Permissions []*PermissionTuple     `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Total       uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Cursor of the next page (empty on the last page)
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -716,6 +730,13 @@ func (x *ListPermissionsResponse) GetTotal() uint32 {
 	return 0
 }
 
+func (x *ListPermissionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 // Request to check access
 type CheckAccessRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1090,6 +1111,196 @@ func (x *GetEffectivePermissionsResponse) GetHighestRelation() Relation {
 	return Relation_RELATION_UNSPECIFIED
 }
 
+// Request to offboard a user
+type OffboardUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User leaving
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// User who becomes owner of every resource the departing user is the only
+	// owner of. Without it such resources are reported as orphaned.
+	SuccessorId *string `protobuf:"bytes,2,opt,name=successor_id,json=successorId,proto3,oneof" json:"successor_id,omitempty"`
+	// Revoke all permissions of the user; without it nothing is revoked
+	Revoke        bool `protobuf:"varint,3,opt,name=revoke,proto3" json:"revoke,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffboardUserRequest) Reset() {
+	*x = OffboardUserRequest{}
+	mi := &file_warden_service_v1_permission_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffboardUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffboardUserRequest) ProtoMessage() {}
+
+func (x *OffboardUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_permission_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffboardUserRequest.ProtoReflect.Descriptor instead.
+func (*OffboardUserRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_permission_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OffboardUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OffboardUserRequest) GetSuccessorId() string {
+	if x != nil && x.SuccessorId != nil {
+		return *x.SuccessorId
+	}
+	return ""
+}
+
+func (x *OffboardUserRequest) GetRevoke() bool {
+	if x != nil {
+		return x.Revoke
+	}
+	return false
+}
+
+// A resource the departing user is the only owner of
+type OffboardResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType  ResourceType           `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=warden.service.v1.ResourceType" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffboardResource) Reset() {
+	*x = OffboardResource{}
+	mi := &file_warden_service_v1_permission_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffboardResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffboardResource) ProtoMessage() {}
+
+func (x *OffboardResource) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_permission_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffboardResource.ProtoReflect.Descriptor instead.
+func (*OffboardResource) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_permission_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OffboardResource) GetResourceType() ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ResourceType_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *OffboardResource) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+type OffboardUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Permissions the user had, expired ones included
+	Permissions []*PermissionTuple `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Resources handed to the successor
+	Transferred []*OffboardResource `protobuf:"bytes,2,rep,name=transferred,proto3" json:"transferred,omitempty"`
+	// Resources left without any owner once the user's permissions are gone
+	Orphaned []*OffboardResource `protobuf:"bytes,3,rep,name=orphaned,proto3" json:"orphaned,omitempty"`
+	// Permissions revoked
+	RevokedCount  uint32 `protobuf:"varint,4,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffboardUserResponse) Reset() {
+	*x = OffboardUserResponse{}
+	mi := &file_warden_service_v1_permission_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffboardUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffboardUserResponse) ProtoMessage() {}
+
+func (x *OffboardUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_permission_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffboardUserResponse.ProtoReflect.Descriptor instead.
+func (*OffboardUserResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_permission_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OffboardUserResponse) GetPermissions() []*PermissionTuple {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *OffboardUserResponse) GetTransferred() []*OffboardResource {
+	if x != nil {
+		return x.Transferred
+	}
+	return nil
+}
+
+func (x *OffboardUserResponse) GetOrphaned() []*OffboardResource {
+	if x != nil {
+		return x.Orphaned
+	}
+	return nil
+}
+
+func (x *OffboardUserResponse) GetRevokedCount() uint32 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
 var File_warden_service_v1_permission_proto protoreflect.FileDescriptor
 
 const file_warden_service_v1_permission_proto_rawDesc = "" +
@@ -1137,7 +1348,7 @@ const file_warden_service_v1_permission_proto_rawDesc = "" +
 	"\fsubject_type\x18\x04 \x01(\x0e2\x1e.warden.service.v1.SubjectTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\vsubjectType\x12+\n" +
 	"\n" +
 	"subject_id\x18\x05 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\tsubjectIdB\v\n" +
-	"\t_relation\"\xad\x03\n" +
+	"\t_relation\"\xdf\x03\n" +
 	"\x16ListPermissionsRequest\x12I\n" +
 	"\rresource_type\x18\x01 \x01(\x0e2\x1f.warden.service.v1.ResourceTypeH\x00R\fresourceType\x88\x01\x01\x12?\n" +
 	"\vresource_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x01R\n" +
@@ -1146,17 +1357,21 @@ const file_warden_service_v1_permission_proto_rawDesc = "" +
 	"\n" +
 	"subject_id\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18$H\x03R\tsubjectId\x88\x01\x01\x12\x17\n" +
 	"\x04page\x18\x05 \x01(\rH\x04R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x06 \x01(\rH\x05R\bpageSize\x88\x01\x01B\x10\n" +
+	"\tpage_size\x18\x06 \x01(\rH\x05R\bpageSize\x88\x01\x01\x12%\n" +
+	"\x06cursor\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04H\x06R\x06cursor\x88\x01\x01B\x10\n" +
 	"\x0e_resource_typeB\x0e\n" +
 	"\f_resource_idB\x0f\n" +
 	"\r_subject_typeB\r\n" +
 	"\v_subject_idB\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
-	"_page_size\"u\n" +
+	"_page_sizeB\t\n" +
+	"\a_cursor\"\x96\x01\n" +
 	"\x17ListPermissionsResponse\x12D\n" +
 	"\vpermissions\x18\x01 \x03(\v2\".warden.service.v1.PermissionTupleR\vpermissions\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\x9f\x02\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\x9f\x02\n" +
 	"\x12CheckAccessRequest\x12%\n" +
 	"\auser_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x06userId\x12S\n" +
 	"\rresource_type\x18\x02 \x01(\x0e2\x1f.warden.service.v1.ResourceTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\fresourceType\x12?\n" +
@@ -1190,11 +1405,26 @@ const file_warden_service_v1_permission_proto_rawDesc = "" +
 	"resourceId\"\xaa\x01\n" +
 	"\x1fGetEffectivePermissionsResponse\x12?\n" +
 	"\vpermissions\x18\x01 \x03(\x0e2\x1d.warden.service.v1.PermissionR\vpermissions\x12F\n" +
-	"\x10highest_relation\x18\x02 \x01(\x0e2\x1b.warden.service.v1.RelationR\x0fhighestRelation*a\n" +
+	"\x10highest_relation\x18\x02 \x01(\x0e2\x1b.warden.service.v1.RelationR\x0fhighestRelation\"\x98\x01\n" +
+	"\x13OffboardUserRequest\x12%\n" +
+	"\auser_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x06userId\x121\n" +
+	"\fsuccessor_id\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18$H\x00R\vsuccessorId\x88\x01\x01\x12\x16\n" +
+	"\x06revoke\x18\x03 \x01(\bR\x06revokeB\x0f\n" +
+	"\r_successor_id\"y\n" +
+	"\x10OffboardResource\x12D\n" +
+	"\rresource_type\x18\x01 \x01(\x0e2\x1f.warden.service.v1.ResourceTypeR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\"\x89\x02\n" +
+	"\x14OffboardUserResponse\x12D\n" +
+	"\vpermissions\x18\x01 \x03(\v2\".warden.service.v1.PermissionTupleR\vpermissions\x12E\n" +
+	"\vtransferred\x18\x02 \x03(\v2#.warden.service.v1.OffboardResourceR\vtransferred\x12?\n" +
+	"\borphaned\x18\x03 \x03(\v2#.warden.service.v1.OffboardResourceR\borphaned\x12#\n" +
+	"\rrevoked_count\x18\x04 \x01(\rR\frevokedCount*\x7f\n" +
 	"\fResourceType\x12\x1d\n" +
 	"\x19RESOURCE_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14RESOURCE_TYPE_FOLDER\x10\x01\x12\x18\n" +
-	"\x14RESOURCE_TYPE_SECRET\x10\x02*w\n" +
+	"\x14RESOURCE_TYPE_SECRET\x10\x02\x12\x1c\n" +
+	"\x18RESOURCE_TYPE_COLLECTION\x10\x03*w\n" +
 	"\bRelation\x12\x18\n" +
 	"\x14RELATION_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eRELATION_OWNER\x10\x01\x12\x13\n" +
@@ -1212,14 +1442,15 @@ const file_warden_service_v1_permission_proto_rawDesc = "" +
 	"\x0fPERMISSION_READ\x10\x01\x12\x14\n" +
 	"\x10PERMISSION_WRITE\x10\x02\x12\x15\n" +
 	"\x11PERMISSION_DELETE\x10\x03\x12\x14\n" +
-	"\x10PERMISSION_SHARE\x10\x042\xcd\x06\n" +
+	"\x10PERMISSION_SHARE\x10\x042\xe4\a\n" +
 	"\x17WardenPermissionService\x12x\n" +
 	"\vGrantAccess\x12%.warden.service.v1.GrantAccessRequest\x1a&.warden.service.v1.GrantAccessResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/permissions\x12g\n" +
 	"\fRevokeAccess\x12&.warden.service.v1.RevokeAccessRequest\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/permissions\x12\x81\x01\n" +
 	"\x0fListPermissions\x12).warden.service.v1.ListPermissionsRequest\x1a*.warden.service.v1.ListPermissionsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/permissions\x12~\n" +
 	"\vCheckAccess\x12%.warden.service.v1.CheckAccessRequest\x1a&.warden.service.v1.CheckAccessResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/permissions/check\x12\xa4\x01\n" +
 	"\x17ListAccessibleResources\x121.warden.service.v1.ListAccessibleResourcesRequest\x1a2.warden.service.v1.ListAccessibleResourcesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/permissions/accessible\x12\xa3\x01\n" +
-	"\x17GetEffectivePermissions\x121.warden.service.v1.GetEffectivePermissionsRequest\x1a2.warden.service.v1.GetEffectivePermissionsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/permissions/effectiveB\xd7\x01\n" +
+	"\x17GetEffectivePermissions\x121.warden.service.v1.GetEffectivePermissionsRequest\x1a2.warden.service.v1.GetEffectivePermissionsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/permissions/effective\x12\x94\x01\n" +
+	"\fOffboardUser\x12&.warden.service.v1.OffboardUserRequest\x1a'.warden.service.v1.OffboardUserResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/permissions/users/{user_id}:offboardB\xd7\x01\n" +
 	"\x15com.warden.service.v1B\x0fPermissionProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
 
 var (
@@ -1235,7 +1466,7 @@ func file_warden_service_v1_permission_proto_rawDescGZIP() []byte {
 }
 
 var file_warden_service_v1_permission_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_warden_service_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_warden_service_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_warden_service_v1_permission_proto_goTypes = []any{
 	(ResourceType)(0),                       // 0: warden.service.v1.ResourceType
 	(Relation)(0),                           // 1: warden.service.v1.Relation
@@ -1253,19 +1484,22 @@ var file_warden_service_v1_permission_proto_goTypes = []any{
 	(*ListAccessibleResourcesResponse)(nil), // 13: warden.service.v1.ListAccessibleResourcesResponse
 	(*GetEffectivePermissionsRequest)(nil),  // 14: warden.service.v1.GetEffectivePermissionsRequest
 	(*GetEffectivePermissionsResponse)(nil), // 15: warden.service.v1.GetEffectivePermissionsResponse
-	(*timestamppb.Timestamp)(nil),           // 16: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                   // 17: google.protobuf.Empty
+	(*OffboardUserRequest)(nil),             // 16: warden.service.v1.OffboardUserRequest
+	(*OffboardResource)(nil),                // 17: warden.service.v1.OffboardResource
+	(*OffboardUserResponse)(nil),            // 18: warden.service.v1.OffboardUserResponse
+	(*timestamppb.Timestamp)(nil),           // 19: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 20: google.protobuf.Empty
 }
 var file_warden_service_v1_permission_proto_depIdxs = []int32{
 	0,  // 0: warden.service.v1.PermissionTuple.resource_type:type_name -> warden.service.v1.ResourceType
 	1,  // 1: warden.service.v1.PermissionTuple.relation:type_name -> warden.service.v1.Relation
 	2,  // 2: warden.service.v1.PermissionTuple.subject_type:type_name -> warden.service.v1.SubjectType
-	16, // 3: warden.service.v1.PermissionTuple.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 4: warden.service.v1.PermissionTuple.create_time:type_name -> google.protobuf.Timestamp
+	19, // 3: warden.service.v1.PermissionTuple.expires_at:type_name -> google.protobuf.Timestamp
+	19, // 4: warden.service.v1.PermissionTuple.create_time:type_name -> google.protobuf.Timestamp
 	0,  // 5: warden.service.v1.GrantAccessRequest.resource_type:type_name -> warden.service.v1.ResourceType
 	1,  // 6: warden.service.v1.GrantAccessRequest.relation:type_name -> warden.service.v1.Relation
 	2,  // 7: warden.service.v1.GrantAccessRequest.subject_type:type_name -> warden.service.v1.SubjectType
-	16, // 8: warden.service.v1.GrantAccessRequest.expires_at:type_name -> google.protobuf.Timestamp
+	19, // 8: warden.service.v1.GrantAccessRequest.expires_at:type_name -> google.protobuf.Timestamp
 	4,  // 9: warden.service.v1.GrantAccessResponse.permission:type_name -> warden.service.v1.PermissionTuple
 	0,  // 10: warden.service.v1.RevokeAccessRequest.resource_type:type_name -> warden.service.v1.ResourceType
 	1,  // 11: warden.service.v1.RevokeAccessRequest.relation:type_name -> warden.service.v1.Relation
@@ -1280,23 +1514,29 @@ var file_warden_service_v1_permission_proto_depIdxs = []int32{
 	0,  // 20: warden.service.v1.GetEffectivePermissionsRequest.resource_type:type_name -> warden.service.v1.ResourceType
 	3,  // 21: warden.service.v1.GetEffectivePermissionsResponse.permissions:type_name -> warden.service.v1.Permission
 	1,  // 22: warden.service.v1.GetEffectivePermissionsResponse.highest_relation:type_name -> warden.service.v1.Relation
-	5,  // 23: warden.service.v1.WardenPermissionService.GrantAccess:input_type -> warden.service.v1.GrantAccessRequest
-	7,  // 24: warden.service.v1.WardenPermissionService.RevokeAccess:input_type -> warden.service.v1.RevokeAccessRequest
-	8,  // 25: warden.service.v1.WardenPermissionService.ListPermissions:input_type -> warden.service.v1.ListPermissionsRequest
-	10, // 26: warden.service.v1.WardenPermissionService.CheckAccess:input_type -> warden.service.v1.CheckAccessRequest
-	12, // 27: warden.service.v1.WardenPermissionService.ListAccessibleResources:input_type -> warden.service.v1.ListAccessibleResourcesRequest
-	14, // 28: warden.service.v1.WardenPermissionService.GetEffectivePermissions:input_type -> warden.service.v1.GetEffectivePermissionsRequest
-	6,  // 29: warden.service.v1.WardenPermissionService.GrantAccess:output_type -> warden.service.v1.GrantAccessResponse
-	17, // 30: warden.service.v1.WardenPermissionService.RevokeAccess:output_type -> google.protobuf.Empty
-	9,  // 31: warden.service.v1.WardenPermissionService.ListPermissions:output_type -> warden.service.v1.ListPermissionsResponse
-	11, // 32: warden.service.v1.WardenPermissionService.CheckAccess:output_type -> warden.service.v1.CheckAccessResponse
-	13, // 33: warden.service.v1.WardenPermissionService.ListAccessibleResources:output_type -> warden.service.v1.ListAccessibleResourcesResponse
-	15, // 34: warden.service.v1.WardenPermissionService.GetEffectivePermissions:output_type -> warden.service.v1.GetEffectivePermissionsResponse
-	29, // [29:35] is the sub-list for method output_type
-	23, // [23:29] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	0,  // 23: warden.service.v1.OffboardResource.resource_type:type_name -> warden.service.v1.ResourceType
+	4,  // 24: warden.service.v1.OffboardUserResponse.permissions:type_name -> warden.service.v1.PermissionTuple
+	17, // 25: warden.service.v1.OffboardUserResponse.transferred:type_name -> warden.service.v1.OffboardResource
+	17, // 26: warden.service.v1.OffboardUserResponse.orphaned:type_name -> warden.service.v1.OffboardResource
+	5,  // 27: warden.service.v1.WardenPermissionService.GrantAccess:input_type -> warden.service.v1.GrantAccessRequest
+	7,  // 28: warden.service.v1.WardenPermissionService.RevokeAccess:input_type -> warden.service.v1.RevokeAccessRequest
+	8,  // 29: warden.service.v1.WardenPermissionService.ListPermissions:input_type -> warden.service.v1.ListPermissionsRequest
+	10, // 30: warden.service.v1.WardenPermissionService.CheckAccess:input_type -> warden.service.v1.CheckAccessRequest
+	12, // 31: warden.service.v1.WardenPermissionService.ListAccessibleResources:input_type -> warden.service.v1.ListAccessibleResourcesRequest
+	14, // 32: warden.service.v1.WardenPermissionService.GetEffectivePermissions:input_type -> warden.service.v1.GetEffectivePermissionsRequest
+	16, // 33: warden.service.v1.WardenPermissionService.OffboardUser:input_type -> warden.service.v1.OffboardUserRequest
+	6,  // 34: warden.service.v1.WardenPermissionService.GrantAccess:output_type -> warden.service.v1.GrantAccessResponse
+	20, // 35: warden.service.v1.WardenPermissionService.RevokeAccess:output_type -> google.protobuf.Empty
+	9,  // 36: warden.service.v1.WardenPermissionService.ListPermissions:output_type -> warden.service.v1.ListPermissionsResponse
+	11, // 37: warden.service.v1.WardenPermissionService.CheckAccess:output_type -> warden.service.v1.CheckAccessResponse
+	13, // 38: warden.service.v1.WardenPermissionService.ListAccessibleResources:output_type -> warden.service.v1.ListAccessibleResourcesResponse
+	15, // 39: warden.service.v1.WardenPermissionService.GetEffectivePermissions:output_type -> warden.service.v1.GetEffectivePermissionsResponse
+	18, // 40: warden.service.v1.WardenPermissionService.OffboardUser:output_type -> warden.service.v1.OffboardUserResponse
+	34, // [34:41] is the sub-list for method output_type
+	27, // [27:34] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_warden_service_v1_permission_proto_init() }
@@ -1310,13 +1550,14 @@ func file_warden_service_v1_permission_proto_init() {
 	file_warden_service_v1_permission_proto_msgTypes[4].OneofWrappers = []any{}
 	file_warden_service_v1_permission_proto_msgTypes[7].OneofWrappers = []any{}
 	file_warden_service_v1_permission_proto_msgTypes[8].OneofWrappers = []any{}
+	file_warden_service_v1_permission_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_permission_proto_rawDesc), len(file_warden_service_v1_permission_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   12,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
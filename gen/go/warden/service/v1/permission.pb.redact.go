@@ -112,6 +112,17 @@ func (s *redactedWardenPermissionServiceServer) GetEffectivePermissions(ctx cont
 	return res, err
 }
 
+// OffboardUser is the redacted wrapper for the actual WardenPermissionServiceServer.OffboardUser method
+// Unary RPC
+func (s *redactedWardenPermissionServiceServer) OffboardUser(ctx context.Context, in *OffboardUserRequest) (*OffboardUserResponse, error) {
+	res, err := s.srv.OffboardUser(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for PermissionTuple
 func (x *PermissionTuple) Redact() string {
 	if x == nil {
@@ -205,6 +216,8 @@ func (x *ListPermissionsRequest) Redact() string {
 	// Safe field: Page
 
 	// Safe field: PageSize
+
+	// Safe field: Cursor
 	return x.String()
 }
 
@@ -217,6 +230,8 @@ func (x *ListPermissionsResponse) Redact() string {
 	// Safe field: Permissions
 
 	// Safe field: Total
+
+	// Safe field: NextCursor
 	return x.String()
 }
 
@@ -303,3 +318,45 @@ func (x *GetEffectivePermissionsResponse) Redact() string {
 	// Safe field: HighestRelation
 	return x.String()
 }
+
+// Redact method implementation for OffboardUserRequest
+func (x *OffboardUserRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: UserId
+
+	// Safe field: SuccessorId
+
+	// Safe field: Revoke
+	return x.String()
+}
+
+// Redact method implementation for OffboardResource
+func (x *OffboardResource) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ResourceType
+
+	// Safe field: ResourceId
+	return x.String()
+}
+
+// Redact method implementation for OffboardUserResponse
+func (x *OffboardUserResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Permissions
+
+	// Safe field: Transferred
+
+	// Safe field: Orphaned
+
+	// Safe field: RevokedCount
+	return x.String()
+}
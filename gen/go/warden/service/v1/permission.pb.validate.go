@@ -651,6 +651,10 @@ func (m *ListPermissionsRequest) validate(all bool) error {
 		// no validation rules for PageSize
 	}
 
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
 	if len(errors) > 0 {
 		return ListPermissionsRequestMultiError(errors)
 	}
@@ -789,6 +793,8 @@ func (m *ListPermissionsResponse) validate(all bool) error {
 
 	// no validation rules for Total
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return ListPermissionsResponseMultiError(errors)
 	}
@@ -1522,3 +1528,423 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetEffectivePermissionsResponseValidationError{}
+
+// Validate checks the field values on OffboardUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OffboardUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OffboardUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OffboardUserRequestMultiError, or nil if none found.
+func (m *OffboardUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OffboardUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Revoke
+
+	if m.SuccessorId != nil {
+		// no validation rules for SuccessorId
+	}
+
+	if len(errors) > 0 {
+		return OffboardUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OffboardUserRequestMultiError is an error wrapping multiple validation
+// errors returned by OffboardUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type OffboardUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OffboardUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OffboardUserRequestMultiError) AllErrors() []error { return m }
+
+// OffboardUserRequestValidationError is the validation error returned by
+// OffboardUserRequest.Validate if the designated constraints aren't met.
+type OffboardUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OffboardUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OffboardUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OffboardUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OffboardUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OffboardUserRequestValidationError) ErrorName() string {
+	return "OffboardUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OffboardUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOffboardUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OffboardUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OffboardUserRequestValidationError{}
+
+// Validate checks the field values on OffboardResource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OffboardResource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OffboardResource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OffboardResourceMultiError, or nil if none found.
+func (m *OffboardResource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OffboardResource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceId
+
+	if len(errors) > 0 {
+		return OffboardResourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// OffboardResourceMultiError is an error wrapping multiple validation errors
+// returned by OffboardResource.ValidateAll() if the designated constraints
+// aren't met.
+type OffboardResourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OffboardResourceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OffboardResourceMultiError) AllErrors() []error { return m }
+
+// OffboardResourceValidationError is the validation error returned by
+// OffboardResource.Validate if the designated constraints aren't met.
+type OffboardResourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OffboardResourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OffboardResourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OffboardResourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OffboardResourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OffboardResourceValidationError) ErrorName() string { return "OffboardResourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OffboardResourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOffboardResource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OffboardResourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OffboardResourceValidationError{}
+
+// Validate checks the field values on OffboardUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OffboardUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OffboardUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OffboardUserResponseMultiError, or nil if none found.
+func (m *OffboardUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OffboardUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OffboardUserResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OffboardUserResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OffboardUserResponseValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTransferred() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OffboardUserResponseValidationError{
+						field:  fmt.Sprintf("Transferred[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OffboardUserResponseValidationError{
+						field:  fmt.Sprintf("Transferred[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OffboardUserResponseValidationError{
+					field:  fmt.Sprintf("Transferred[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetOrphaned() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OffboardUserResponseValidationError{
+						field:  fmt.Sprintf("Orphaned[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OffboardUserResponseValidationError{
+						field:  fmt.Sprintf("Orphaned[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OffboardUserResponseValidationError{
+					field:  fmt.Sprintf("Orphaned[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RevokedCount
+
+	if len(errors) > 0 {
+		return OffboardUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OffboardUserResponseMultiError is an error wrapping multiple validation
+// errors returned by OffboardUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OffboardUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OffboardUserResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OffboardUserResponseMultiError) AllErrors() []error { return m }
+
+// OffboardUserResponseValidationError is the validation error returned by
+// OffboardUserResponse.Validate if the designated constraints aren't met.
+type OffboardUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OffboardUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OffboardUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OffboardUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OffboardUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OffboardUserResponseValidationError) ErrorName() string {
+	return "OffboardUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OffboardUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOffboardUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OffboardUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OffboardUserResponseValidationError{}
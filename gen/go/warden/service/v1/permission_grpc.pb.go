@@ -26,6 +26,7 @@ const (
 	WardenPermissionService_CheckAccess_FullMethodName             = "/warden.service.v1.WardenPermissionService/CheckAccess"
 	WardenPermissionService_ListAccessibleResources_FullMethodName = "/warden.service.v1.WardenPermissionService/ListAccessibleResources"
 	WardenPermissionService_GetEffectivePermissions_FullMethodName = "/warden.service.v1.WardenPermissionService/GetEffectivePermissions"
+	WardenPermissionService_OffboardUser_FullMethodName            = "/warden.service.v1.WardenPermissionService/OffboardUser"
 )
 
 // WardenPermissionServiceClient is the client API for WardenPermissionService service.
@@ -46,6 +47,10 @@ type WardenPermissionServiceClient interface {
 	ListAccessibleResources(ctx context.Context, in *ListAccessibleResourcesRequest, opts ...grpc.CallOption) (*ListAccessibleResourcesResponse, error)
 	// Get effective permissions for a subject on a resource
 	GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest, opts ...grpc.CallOption) (*GetEffectivePermissionsResponse, error)
+	// Report and optionally clean up the permissions of a departing user:
+	// hand the resources only they own to a successor and revoke all their
+	// grants (platform admin only)
+	OffboardUser(ctx context.Context, in *OffboardUserRequest, opts ...grpc.CallOption) (*OffboardUserResponse, error)
 }
 
 type wardenPermissionServiceClient struct {
@@ -116,6 +121,16 @@ func (c *wardenPermissionServiceClient) GetEffectivePermissions(ctx context.Cont
 	return out, nil
 }
 
+func (c *wardenPermissionServiceClient) OffboardUser(ctx context.Context, in *OffboardUserRequest, opts ...grpc.CallOption) (*OffboardUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OffboardUserResponse)
+	err := c.cc.Invoke(ctx, WardenPermissionService_OffboardUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WardenPermissionServiceServer is the server API for WardenPermissionService service.
 // All implementations must embed UnimplementedWardenPermissionServiceServer
 // for forward compatibility.
@@ -134,6 +149,10 @@ type WardenPermissionServiceServer interface {
 	ListAccessibleResources(context.Context, *ListAccessibleResourcesRequest) (*ListAccessibleResourcesResponse, error)
 	// Get effective permissions for a subject on a resource
 	GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error)
+	// Report and optionally clean up the permissions of a departing user:
+	// hand the resources only they own to a successor and revoke all their
+	// grants (platform admin only)
+	OffboardUser(context.Context, *OffboardUserRequest) (*OffboardUserResponse, error)
 	mustEmbedUnimplementedWardenPermissionServiceServer()
 }
 
@@ -162,6 +181,9 @@ func (UnimplementedWardenPermissionServiceServer) ListAccessibleResources(contex
 func (UnimplementedWardenPermissionServiceServer) GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetEffectivePermissions not implemented")
 }
+func (UnimplementedWardenPermissionServiceServer) OffboardUser(context.Context, *OffboardUserRequest) (*OffboardUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OffboardUser not implemented")
+}
 func (UnimplementedWardenPermissionServiceServer) mustEmbedUnimplementedWardenPermissionServiceServer() {
 }
 func (UnimplementedWardenPermissionServiceServer) testEmbeddedByValue() {}
@@ -292,6 +314,24 @@ func _WardenPermissionService_GetEffectivePermissions_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WardenPermissionService_OffboardUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OffboardUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenPermissionServiceServer).OffboardUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenPermissionService_OffboardUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenPermissionServiceServer).OffboardUser(ctx, req.(*OffboardUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WardenPermissionService_ServiceDesc is the grpc.ServiceDesc for WardenPermissionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -323,6 +363,10 @@ var WardenPermissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEffectivePermissions",
 			Handler:    _WardenPermissionService_GetEffectivePermissions_Handler,
 		},
+		{
+			MethodName: "OffboardUser",
+			Handler:    _WardenPermissionService_OffboardUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "warden/service/v1/permission.proto",
@@ -25,6 +25,7 @@ const OperationWardenPermissionServiceGetEffectivePermissions = "/warden.service
 const OperationWardenPermissionServiceGrantAccess = "/warden.service.v1.WardenPermissionService/GrantAccess"
 const OperationWardenPermissionServiceListAccessibleResources = "/warden.service.v1.WardenPermissionService/ListAccessibleResources"
 const OperationWardenPermissionServiceListPermissions = "/warden.service.v1.WardenPermissionService/ListPermissions"
+const OperationWardenPermissionServiceOffboardUser = "/warden.service.v1.WardenPermissionService/OffboardUser"
 const OperationWardenPermissionServiceRevokeAccess = "/warden.service.v1.WardenPermissionService/RevokeAccess"
 
 type WardenPermissionServiceHTTPServer interface {
@@ -38,6 +39,10 @@ type WardenPermissionServiceHTTPServer interface {
 	ListAccessibleResources(context.Context, *ListAccessibleResourcesRequest) (*ListAccessibleResourcesResponse, error)
 	// ListPermissions List permissions on a resource
 	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	// OffboardUser Report and optionally clean up the permissions of a departing user:
+	// hand the resources only they own to a successor and revoke all their
+	// grants (platform admin only)
+	OffboardUser(context.Context, *OffboardUserRequest) (*OffboardUserResponse, error)
 	// RevokeAccess Revoke access from a resource
 	RevokeAccess(context.Context, *RevokeAccessRequest) (*emptypb.Empty, error)
 }
@@ -50,6 +55,7 @@ func RegisterWardenPermissionServiceHTTPServer(s *http.Server, srv WardenPermiss
 	r.POST("/v1/permissions/check", _WardenPermissionService_CheckAccess0_HTTP_Handler(srv))
 	r.GET("/v1/permissions/accessible", _WardenPermissionService_ListAccessibleResources0_HTTP_Handler(srv))
 	r.GET("/v1/permissions/effective", _WardenPermissionService_GetEffectivePermissions0_HTTP_Handler(srv))
+	r.POST("/v1/permissions/users/{user_id}:offboard", _WardenPermissionService_OffboardUser0_HTTP_Handler(srv))
 }
 
 func _WardenPermissionService_GrantAccess0_HTTP_Handler(srv WardenPermissionServiceHTTPServer) func(ctx http.Context) error {
@@ -172,6 +178,31 @@ func _WardenPermissionService_GetEffectivePermissions0_HTTP_Handler(srv WardenPe
 	}
 }
 
+func _WardenPermissionService_OffboardUser0_HTTP_Handler(srv WardenPermissionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OffboardUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenPermissionServiceOffboardUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OffboardUser(ctx, req.(*OffboardUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OffboardUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type WardenPermissionServiceHTTPClient interface {
 	// CheckAccess Check if a subject has access to a resource
 	CheckAccess(ctx context.Context, req *CheckAccessRequest, opts ...http.CallOption) (rsp *CheckAccessResponse, err error)
@@ -183,6 +214,10 @@ type WardenPermissionServiceHTTPClient interface {
 	ListAccessibleResources(ctx context.Context, req *ListAccessibleResourcesRequest, opts ...http.CallOption) (rsp *ListAccessibleResourcesResponse, err error)
 	// ListPermissions List permissions on a resource
 	ListPermissions(ctx context.Context, req *ListPermissionsRequest, opts ...http.CallOption) (rsp *ListPermissionsResponse, err error)
+	// OffboardUser Report and optionally clean up the permissions of a departing user:
+	// hand the resources only they own to a successor and revoke all their
+	// grants (platform admin only)
+	OffboardUser(ctx context.Context, req *OffboardUserRequest, opts ...http.CallOption) (rsp *OffboardUserResponse, err error)
 	// RevokeAccess Revoke access from a resource
 	RevokeAccess(ctx context.Context, req *RevokeAccessRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 }
@@ -265,6 +300,22 @@ func (c *WardenPermissionServiceHTTPClientImpl) ListPermissions(ctx context.Cont
 	return &out, nil
 }
 
+// OffboardUser Report and optionally clean up the permissions of a departing user:
+// hand the resources only they own to a successor and revoke all their
+// grants (platform admin only)
+func (c *WardenPermissionServiceHTTPClientImpl) OffboardUser(ctx context.Context, in *OffboardUserRequest, opts ...http.CallOption) (*OffboardUserResponse, error) {
+	var out OffboardUserResponse
+	pattern := "/v1/permissions/users/{user_id}:offboard"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenPermissionServiceOffboardUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // RevokeAccess Revoke access from a resource
 func (c *WardenPermissionServiceHTTPClientImpl) RevokeAccess(ctx context.Context, in *RevokeAccessRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
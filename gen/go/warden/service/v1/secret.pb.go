@@ -80,27 +80,328 @@ func (SecretStatus) EnumDescriptor() ([]byte, []int) {
 	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{0}
 }
 
+// Field to sort secret and folder lists by
+type ListSortField int32
+
+const (
+	ListSortField_LIST_SORT_FIELD_UNSPECIFIED   ListSortField = 0 // Name
+	ListSortField_LIST_SORT_FIELD_NAME          ListSortField = 1
+	ListSortField_LIST_SORT_FIELD_CREATE_TIME   ListSortField = 2
+	ListSortField_LIST_SORT_FIELD_UPDATE_TIME   ListSortField = 3
+	ListSortField_LIST_SORT_FIELD_LAST_ACCESSED ListSortField = 4 // Last password read; never read last
+)
+
+// Enum value maps for ListSortField.
+var (
+	ListSortField_name = map[int32]string{
+		0: "LIST_SORT_FIELD_UNSPECIFIED",
+		1: "LIST_SORT_FIELD_NAME",
+		2: "LIST_SORT_FIELD_CREATE_TIME",
+		3: "LIST_SORT_FIELD_UPDATE_TIME",
+		4: "LIST_SORT_FIELD_LAST_ACCESSED",
+	}
+	ListSortField_value = map[string]int32{
+		"LIST_SORT_FIELD_UNSPECIFIED":   0,
+		"LIST_SORT_FIELD_NAME":          1,
+		"LIST_SORT_FIELD_CREATE_TIME":   2,
+		"LIST_SORT_FIELD_UPDATE_TIME":   3,
+		"LIST_SORT_FIELD_LAST_ACCESSED": 4,
+	}
+)
+
+func (x ListSortField) Enum() *ListSortField {
+	p := new(ListSortField)
+	*p = x
+	return p
+}
+
+func (x ListSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_secret_proto_enumTypes[1].Descriptor()
+}
+
+func (ListSortField) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_secret_proto_enumTypes[1]
+}
+
+func (x ListSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListSortField.Descriptor instead.
+func (ListSortField) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{1}
+}
+
+// Sort direction; name defaults to ascending, timestamps to descending
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED SortOrder = 0
+	SortOrder_SORT_ORDER_ASC         SortOrder = 1
+	SortOrder_SORT_ORDER_DESC        SortOrder = 2
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "SORT_ORDER_ASC",
+		2: "SORT_ORDER_DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED": 0,
+		"SORT_ORDER_ASC":         1,
+		"SORT_ORDER_DESC":        2,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_secret_proto_enumTypes[2].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_secret_proto_enumTypes[2]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{2}
+}
+
+// How a stored password is to be interpreted
+type PasswordEncoding int32
+
+const (
+	PasswordEncoding_PASSWORD_ENCODING_UNSPECIFIED PasswordEncoding = 0 // Treated as TEXT
+	PasswordEncoding_PASSWORD_ENCODING_TEXT        PasswordEncoding = 1 // UTF-8 text without NUL characters
+	PasswordEncoding_PASSWORD_ENCODING_BASE64      PasswordEncoding = 2 // Standard base64 of binary data (keys, keystores)
+)
+
+// Enum value maps for PasswordEncoding.
+var (
+	PasswordEncoding_name = map[int32]string{
+		0: "PASSWORD_ENCODING_UNSPECIFIED",
+		1: "PASSWORD_ENCODING_TEXT",
+		2: "PASSWORD_ENCODING_BASE64",
+	}
+	PasswordEncoding_value = map[string]int32{
+		"PASSWORD_ENCODING_UNSPECIFIED": 0,
+		"PASSWORD_ENCODING_TEXT":        1,
+		"PASSWORD_ENCODING_BASE64":      2,
+	}
+)
+
+func (x PasswordEncoding) Enum() *PasswordEncoding {
+	p := new(PasswordEncoding)
+	*p = x
+	return p
+}
+
+func (x PasswordEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PasswordEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_secret_proto_enumTypes[3].Descriptor()
+}
+
+func (PasswordEncoding) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_secret_proto_enumTypes[3]
+}
+
+func (x PasswordEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PasswordEncoding.Descriptor instead.
+func (PasswordEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{3}
+}
+
+// Kind of a node of a secret graph
+type SecretGraphNodeKind int32
+
+const (
+	SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_UNSPECIFIED SecretGraphNodeKind = 0
+	SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_SECRET      SecretGraphNodeKind = 1
+	SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_FOLDER      SecretGraphNodeKind = 2
+	SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_COLLECTION  SecretGraphNodeKind = 3
+	SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_USER        SecretGraphNodeKind = 4
+	SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_ROLE        SecretGraphNodeKind = 5
+	SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_TENANT      SecretGraphNodeKind = 6
+)
+
+// Enum value maps for SecretGraphNodeKind.
+var (
+	SecretGraphNodeKind_name = map[int32]string{
+		0: "SECRET_GRAPH_NODE_KIND_UNSPECIFIED",
+		1: "SECRET_GRAPH_NODE_KIND_SECRET",
+		2: "SECRET_GRAPH_NODE_KIND_FOLDER",
+		3: "SECRET_GRAPH_NODE_KIND_COLLECTION",
+		4: "SECRET_GRAPH_NODE_KIND_USER",
+		5: "SECRET_GRAPH_NODE_KIND_ROLE",
+		6: "SECRET_GRAPH_NODE_KIND_TENANT",
+	}
+	SecretGraphNodeKind_value = map[string]int32{
+		"SECRET_GRAPH_NODE_KIND_UNSPECIFIED": 0,
+		"SECRET_GRAPH_NODE_KIND_SECRET":      1,
+		"SECRET_GRAPH_NODE_KIND_FOLDER":      2,
+		"SECRET_GRAPH_NODE_KIND_COLLECTION":  3,
+		"SECRET_GRAPH_NODE_KIND_USER":        4,
+		"SECRET_GRAPH_NODE_KIND_ROLE":        5,
+		"SECRET_GRAPH_NODE_KIND_TENANT":      6,
+	}
+)
+
+func (x SecretGraphNodeKind) Enum() *SecretGraphNodeKind {
+	p := new(SecretGraphNodeKind)
+	*p = x
+	return p
+}
+
+func (x SecretGraphNodeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecretGraphNodeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_secret_proto_enumTypes[4].Descriptor()
+}
+
+func (SecretGraphNodeKind) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_secret_proto_enumTypes[4]
+}
+
+func (x SecretGraphNodeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecretGraphNodeKind.Descriptor instead.
+func (SecretGraphNodeKind) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{4}
+}
+
+// Kind of an edge of a secret graph
+type SecretGraphEdgeKind int32
+
+const (
+	SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_UNSPECIFIED SecretGraphEdgeKind = 0
+	// Secret or folder to the folder it is in
+	SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_IN_FOLDER SecretGraphEdgeKind = 1
+	// Secret to a collection it is in
+	SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_IN_COLLECTION SecretGraphEdgeKind = 2
+	// Secret to a secret its metadata references
+	SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_REFERENCES SecretGraphEdgeKind = 3
+	// Subject to a resource it has a permission on; the label is the relation
+	SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_GRANTED SecretGraphEdgeKind = 4
+	// User to the secret whose password they read
+	SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_READ SecretGraphEdgeKind = 5
+)
+
+// Enum value maps for SecretGraphEdgeKind.
+var (
+	SecretGraphEdgeKind_name = map[int32]string{
+		0: "SECRET_GRAPH_EDGE_KIND_UNSPECIFIED",
+		1: "SECRET_GRAPH_EDGE_KIND_IN_FOLDER",
+		2: "SECRET_GRAPH_EDGE_KIND_IN_COLLECTION",
+		3: "SECRET_GRAPH_EDGE_KIND_REFERENCES",
+		4: "SECRET_GRAPH_EDGE_KIND_GRANTED",
+		5: "SECRET_GRAPH_EDGE_KIND_READ",
+	}
+	SecretGraphEdgeKind_value = map[string]int32{
+		"SECRET_GRAPH_EDGE_KIND_UNSPECIFIED":   0,
+		"SECRET_GRAPH_EDGE_KIND_IN_FOLDER":     1,
+		"SECRET_GRAPH_EDGE_KIND_IN_COLLECTION": 2,
+		"SECRET_GRAPH_EDGE_KIND_REFERENCES":    3,
+		"SECRET_GRAPH_EDGE_KIND_GRANTED":       4,
+		"SECRET_GRAPH_EDGE_KIND_READ":          5,
+	}
+)
+
+func (x SecretGraphEdgeKind) Enum() *SecretGraphEdgeKind {
+	p := new(SecretGraphEdgeKind)
+	*p = x
+	return p
+}
+
+func (x SecretGraphEdgeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecretGraphEdgeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_secret_proto_enumTypes[5].Descriptor()
+}
+
+func (SecretGraphEdgeKind) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_secret_proto_enumTypes[5]
+}
+
+func (x SecretGraphEdgeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecretGraphEdgeKind.Descriptor instead.
+func (SecretGraphEdgeKind) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{5}
+}
+
 // Secret entity (without password)
 type Secret struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId       uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	FolderId       *string                `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
-	FolderPath     string                 `protobuf:"bytes,4,opt,name=folder_path,json=folderPath,proto3" json:"folder_path,omitempty"`
-	Name           string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Username       string                 `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
-	HostUrl        string                 `protobuf:"bytes,7,opt,name=host_url,json=hostUrl,proto3" json:"host_url,omitempty"`
-	Description    string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	CurrentVersion int32                  `protobuf:"varint,9,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
-	Metadata       *structpb.Struct       `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Status         SecretStatus           `protobuf:"varint,11,opt,name=status,proto3,enum=warden.service.v1.SecretStatus" json:"status,omitempty"`
-	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	CreatedBy      *uint32                `protobuf:"varint,14,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
-	UpdatedBy      *uint32                `protobuf:"varint,15,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
-	HasTotp        bool                   `protobuf:"varint,16,opt,name=has_totp,json=hasTotp,proto3" json:"has_totp,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId         uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	FolderId         *string                `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	FolderPath       string                 `protobuf:"bytes,4,opt,name=folder_path,json=folderPath,proto3" json:"folder_path,omitempty"`
+	Name             string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Username         string                 `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	HostUrl          string                 `protobuf:"bytes,7,opt,name=host_url,json=hostUrl,proto3" json:"host_url,omitempty"`
+	Description      string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	CurrentVersion   int32                  `protobuf:"varint,9,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	Metadata         *structpb.Struct       `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Status           SecretStatus           `protobuf:"varint,11,opt,name=status,proto3,enum=warden.service.v1.SecretStatus" json:"status,omitempty"`
+	CreateTime       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	CreatedBy        *uint32                `protobuf:"varint,14,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy        *uint32                `protobuf:"varint,15,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	HasTotp          bool                   `protobuf:"varint,16,opt,name=has_totp,json=hasTotp,proto3" json:"has_totp,omitempty"`
+	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=last_accessed_time,json=lastAccessedTime,proto3,oneof" json:"last_accessed_time,omitempty"`
+	// Incremented on every change; pass it as expected_revision to update only
+	// the version that was read
+	Revision int64 `protobuf:"varint,18,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Password reads by anyone but an owner raise a secret.read webhook event,
+	// and with STEP_UP_MAX_AGE set they require a recent sign-in
+	Sensitive bool `protobuf:"varint,19,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	// Encoding of the current password
+	PasswordEncoding PasswordEncoding `protobuf:"varint,20,opt,name=password_encoding,json=passwordEncoding,proto3,enum=warden.service.v1.PasswordEncoding" json:"password_encoding,omitempty"`
+	// Network and time restrictions on access; unset when there are none
+	AccessPolicy *AccessPolicy `protobuf:"bytes,21,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy,omitempty"`
+	// Decoy secret whose password reads raise a high-severity security alert.
+	// Only reported to owners, so readers cannot tell a canary apart.
+	Canary bool `protobuf:"varint,22,opt,name=canary,proto3" json:"canary,omitempty"`
+	// Permanent deletion needs the approval of a second owner, through a
+	// deletion request. Also set when a folder above the secret is protected.
+	Protected bool `protobuf:"varint,23,opt,name=protected,proto3" json:"protected,omitempty"`
+	// Secrets referenced from the metadata with {{secret:<id>.<field>}}
+	ReferencedSecretIds []string `protobuf:"bytes,24,rep,name=referenced_secret_ids,json=referencedSecretIds,proto3" json:"referenced_secret_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Secret) Reset() {
@@ -245,34 +546,93 @@ func (x *Secret) GetHasTotp() bool {
 	return false
 }
 
-// Secret version
-type SecretVersion struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SecretId      string                 `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
-	VersionNumber int32                  `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	Checksum      string                 `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	CreatedBy     *uint32                `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+func (x *Secret) GetLastAccessedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedTime
+	}
+	return nil
+}
+
+func (x *Secret) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Secret) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *Secret) GetPasswordEncoding() PasswordEncoding {
+	if x != nil {
+		return x.PasswordEncoding
+	}
+	return PasswordEncoding_PASSWORD_ENCODING_UNSPECIFIED
+}
+
+func (x *Secret) GetAccessPolicy() *AccessPolicy {
+	if x != nil {
+		return x.AccessPolicy
+	}
+	return nil
+}
+
+func (x *Secret) GetCanary() bool {
+	if x != nil {
+		return x.Canary
+	}
+	return false
+}
+
+func (x *Secret) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *Secret) GetReferencedSecretIds() []string {
+	if x != nil {
+		return x.ReferencedSecretIds
+	}
+	return nil
+}
+
+// Where from and when a secret or folder can be accessed, on top of the
+// permissions granted on it. Empty lists do not restrict; a folder's policy
+// applies to everything below it.
+type AccessPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Networks requests must come from, e.g. 10.0.0.0/8
+	AllowedCidrs []string `protobuf:"bytes,1,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	// Days access is allowed on, 0 (Sunday) to 6 (Saturday)
+	Weekdays []int32 `protobuf:"varint,2,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// Times of day access is allowed in
+	TimeWindows []*TimeWindow `protobuf:"bytes,3,rep,name=time_windows,json=timeWindows,proto3" json:"time_windows,omitempty"`
+	// IANA timezone of weekdays and time_windows; UTC when empty
+	Timezone      string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SecretVersion) Reset() {
-	*x = SecretVersion{}
+func (x *AccessPolicy) Reset() {
+	*x = AccessPolicy{}
 	mi := &file_warden_service_v1_secret_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SecretVersion) String() string {
+func (x *AccessPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretVersion) ProtoMessage() {}
+func (*AccessPolicy) ProtoMessage() {}
 
-func (x *SecretVersion) ProtoReflect() protoreflect.Message {
+func (x *AccessPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_warden_service_v1_secret_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -284,84 +644,62 @@ func (x *SecretVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretVersion.ProtoReflect.Descriptor instead.
-func (*SecretVersion) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccessPolicy.ProtoReflect.Descriptor instead.
+func (*AccessPolicy) Descriptor() ([]byte, []int) {
 	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *SecretVersion) GetId() uint32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *SecretVersion) GetSecretId() string {
+func (x *AccessPolicy) GetAllowedCidrs() []string {
 	if x != nil {
-		return x.SecretId
+		return x.AllowedCidrs
 	}
-	return ""
+	return nil
 }
 
-func (x *SecretVersion) GetVersionNumber() int32 {
+func (x *AccessPolicy) GetWeekdays() []int32 {
 	if x != nil {
-		return x.VersionNumber
+		return x.Weekdays
 	}
-	return 0
+	return nil
 }
 
-func (x *SecretVersion) GetComment() string {
+func (x *AccessPolicy) GetTimeWindows() []*TimeWindow {
 	if x != nil {
-		return x.Comment
+		return x.TimeWindows
 	}
-	return ""
+	return nil
 }
 
-func (x *SecretVersion) GetChecksum() string {
+func (x *AccessPolicy) GetTimezone() string {
 	if x != nil {
-		return x.Checksum
+		return x.Timezone
 	}
 	return ""
 }
 
-func (x *SecretVersion) GetCreateTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreateTime
-	}
-	return nil
-}
-
-func (x *SecretVersion) GetCreatedBy() uint32 {
-	if x != nil && x.CreatedBy != nil {
-		return *x.CreatedBy
-	}
-	return 0
-}
-
-// Permission grant to apply during secret creation
-type InitialPermissionGrant struct {
+// Time of day range in HH:MM, end exclusive; an end before the start runs past midnight
+type TimeWindow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SubjectType   SubjectType            `protobuf:"varint,1,opt,name=subject_type,json=subjectType,proto3,enum=warden.service.v1.SubjectType" json:"subject_type,omitempty"`
-	SubjectId     string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
-	Relation      Relation               `protobuf:"varint,3,opt,name=relation,proto3,enum=warden.service.v1.Relation" json:"relation,omitempty"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InitialPermissionGrant) Reset() {
-	*x = InitialPermissionGrant{}
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
 	mi := &file_warden_service_v1_secret_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitialPermissionGrant) String() string {
+func (x *TimeWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitialPermissionGrant) ProtoMessage() {}
+func (*TimeWindow) ProtoMessage() {}
 
-func (x *InitialPermissionGrant) ProtoReflect() protoreflect.Message {
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
 	mi := &file_warden_service_v1_secret_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -373,36 +711,301 @@ func (x *InitialPermissionGrant) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitialPermissionGrant.ProtoReflect.Descriptor instead.
-func (*InitialPermissionGrant) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
 	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *InitialPermissionGrant) GetSubjectType() SubjectType {
+func (x *TimeWindow) GetStart() string {
 	if x != nil {
-		return x.SubjectType
+		return x.Start
 	}
-	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *InitialPermissionGrant) GetSubjectId() string {
+func (x *TimeWindow) GetEnd() string {
 	if x != nil {
-		return x.SubjectId
+		return x.End
 	}
 	return ""
 }
 
-func (x *InitialPermissionGrant) GetRelation() Relation {
-	if x != nil {
-		return x.Relation
-	}
-	return Relation_RELATION_UNSPECIFIED
+// Secret version
+type SecretVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SecretId      string                 `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	VersionNumber int32                  `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Checksum      string                 `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	CreatedBy     *uint32                `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	// Version this one was restored from; unset unless created by RestoreVersion
+	SourceVersion *int32 `protobuf:"varint,8,opt,name=source_version,json=sourceVersion,proto3,oneof" json:"source_version,omitempty"`
+	// State of the version in Vault; only set when requested
+	VaultState *VaultVersionState `protobuf:"bytes,9,opt,name=vault_state,json=vaultState,proto3,oneof" json:"vault_state,omitempty"`
+	// Algorithm of the checksum: "sha256", or "hmac-sha256:<key id>" for
+	// checksums keyed with the server's checksum key
+	ChecksumAlgorithm string `protobuf:"bytes,10,opt,name=checksum_algorithm,json=checksumAlgorithm,proto3" json:"checksum_algorithm,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-// Request to create a secret
-type CreateSecretRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Folder ID (null for root-level)
+func (x *SecretVersion) Reset() {
+	*x = SecretVersion{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretVersion) ProtoMessage() {}
+
+func (x *SecretVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretVersion.ProtoReflect.Descriptor instead.
+func (*SecretVersion) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SecretVersion) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SecretVersion) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *SecretVersion) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *SecretVersion) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SecretVersion) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *SecretVersion) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SecretVersion) GetCreatedBy() uint32 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *SecretVersion) GetSourceVersion() int32 {
+	if x != nil && x.SourceVersion != nil {
+		return *x.SourceVersion
+	}
+	return 0
+}
+
+func (x *SecretVersion) GetVaultState() *VaultVersionState {
+	if x != nil {
+		return x.VaultState
+	}
+	return nil
+}
+
+func (x *SecretVersion) GetChecksumAlgorithm() string {
+	if x != nil {
+		return x.ChecksumAlgorithm
+	}
+	return ""
+}
+
+// State of a secret version in Vault
+type VaultVersionState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the password of the version can be read
+	Retrievable bool `protobuf:"varint,1,opt,name=retrievable,proto3" json:"retrievable,omitempty"`
+	// Soft-deleted; can be undeleted
+	Deleted bool `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Permanently destroyed
+	Destroyed bool `protobuf:"varint,3,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
+	// No longer known to Vault, e.g. removed by version retention
+	Missing    bool                   `protobuf:"varint,4,opt,name=missing,proto3" json:"missing,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3,oneof" json:"create_time,omitempty"`
+	// When the version was or will be deleted (delete_version_after)
+	DeletionTime  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deletion_time,json=deletionTime,proto3,oneof" json:"deletion_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultVersionState) Reset() {
+	*x = VaultVersionState{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultVersionState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultVersionState) ProtoMessage() {}
+
+func (x *VaultVersionState) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultVersionState.ProtoReflect.Descriptor instead.
+func (*VaultVersionState) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VaultVersionState) GetRetrievable() bool {
+	if x != nil {
+		return x.Retrievable
+	}
+	return false
+}
+
+func (x *VaultVersionState) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *VaultVersionState) GetDestroyed() bool {
+	if x != nil {
+		return x.Destroyed
+	}
+	return false
+}
+
+func (x *VaultVersionState) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+func (x *VaultVersionState) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *VaultVersionState) GetDeletionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletionTime
+	}
+	return nil
+}
+
+// Permission grant to apply during secret creation
+type InitialPermissionGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubjectType   SubjectType            `protobuf:"varint,1,opt,name=subject_type,json=subjectType,proto3,enum=warden.service.v1.SubjectType" json:"subject_type,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Relation      Relation               `protobuf:"varint,3,opt,name=relation,proto3,enum=warden.service.v1.Relation" json:"relation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitialPermissionGrant) Reset() {
+	*x = InitialPermissionGrant{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitialPermissionGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitialPermissionGrant) ProtoMessage() {}
+
+func (x *InitialPermissionGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitialPermissionGrant.ProtoReflect.Descriptor instead.
+func (*InitialPermissionGrant) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InitialPermissionGrant) GetSubjectType() SubjectType {
+	if x != nil {
+		return x.SubjectType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *InitialPermissionGrant) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *InitialPermissionGrant) GetRelation() Relation {
+	if x != nil {
+		return x.Relation
+	}
+	return Relation_RELATION_UNSPECIFIED
+}
+
+// Request to create a secret
+type CreateSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Folder ID (null for root-level)
 	FolderId *string `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
 	// Secret name
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -421,14 +1024,18 @@ type CreateSecretRequest struct {
 	// Permissions to grant on the newly created secret
 	InitialPermissions []*InitialPermissionGrant `protobuf:"bytes,9,rep,name=initial_permissions,json=initialPermissions,proto3" json:"initial_permissions,omitempty"`
 	// TOTP authenticator URL (otpauth:// URI or base32 secret)
-	TotpUrl       string `protobuf:"bytes,10,opt,name=totp_url,json=totpUrl,proto3" json:"totp_url,omitempty"`
+	TotpUrl string `protobuf:"bytes,10,opt,name=totp_url,json=totpUrl,proto3" json:"totp_url,omitempty"`
+	// BASE64 to store binary data; the size limit applies to the decoded bytes
+	PasswordEncoding PasswordEncoding `protobuf:"varint,11,opt,name=password_encoding,json=passwordEncoding,proto3,enum=warden.service.v1.PasswordEncoding" json:"password_encoding,omitempty"`
+	// Create a canary (decoy) secret whose password reads raise a security alert
+	Canary        bool `protobuf:"varint,12,opt,name=canary,proto3" json:"canary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateSecretRequest) Reset() {
 	*x = CreateSecretRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[3]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -440,7 +1047,7 @@ func (x *CreateSecretRequest) String() string {
 func (*CreateSecretRequest) ProtoMessage() {}
 
 func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[3]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +1060,7 @@ func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSecretRequest.ProtoReflect.Descriptor instead.
 func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{3}
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateSecretRequest) GetFolderId() string {
@@ -526,6 +1133,20 @@ func (x *CreateSecretRequest) GetTotpUrl() string {
 	return ""
 }
 
+func (x *CreateSecretRequest) GetPasswordEncoding() PasswordEncoding {
+	if x != nil {
+		return x.PasswordEncoding
+	}
+	return PasswordEncoding_PASSWORD_ENCODING_UNSPECIFIED
+}
+
+func (x *CreateSecretRequest) GetCanary() bool {
+	if x != nil {
+		return x.Canary
+	}
+	return false
+}
+
 type CreateSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -535,7 +1156,7 @@ type CreateSecretResponse struct {
 
 func (x *CreateSecretResponse) Reset() {
 	*x = CreateSecretResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[4]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -547,7 +1168,7 @@ func (x *CreateSecretResponse) String() string {
 func (*CreateSecretResponse) ProtoMessage() {}
 
 func (x *CreateSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[4]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,7 +1181,7 @@ func (x *CreateSecretResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSecretResponse.ProtoReflect.Descriptor instead.
 func (*CreateSecretResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{4}
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateSecretResponse) GetSecret() *Secret {
@@ -572,15 +1193,18 @@ func (x *CreateSecretResponse) GetSecret() *Secret {
 
 // Request to get a secret
 type GetSecretRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Replace {{secret:<id>.<field>}} references in the metadata with the
+	// values of the referenced secrets the caller can read
+	ResolveReferences bool `protobuf:"varint,2,opt,name=resolve_references,json=resolveReferences,proto3" json:"resolve_references,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetSecretRequest) Reset() {
 	*x = GetSecretRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[5]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -592,7 +1216,7 @@ func (x *GetSecretRequest) String() string {
 func (*GetSecretRequest) ProtoMessage() {}
 
 func (x *GetSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[5]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,7 +1229,7 @@ func (x *GetSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSecretRequest.ProtoReflect.Descriptor instead.
 func (*GetSecretRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{5}
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetSecretRequest) GetId() string {
@@ -615,6 +1239,13 @@ func (x *GetSecretRequest) GetId() string {
 	return ""
 }
 
+func (x *GetSecretRequest) GetResolveReferences() bool {
+	if x != nil {
+		return x.ResolveReferences
+	}
+	return false
+}
+
 type GetSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -624,7 +1255,7 @@ type GetSecretResponse struct {
 
 func (x *GetSecretResponse) Reset() {
 	*x = GetSecretResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[6]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -636,7 +1267,7 @@ func (x *GetSecretResponse) String() string {
 func (*GetSecretResponse) ProtoMessage() {}
 
 func (x *GetSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[6]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +1280,7 @@ func (x *GetSecretResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSecretResponse.ProtoReflect.Descriptor instead.
 func (*GetSecretResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{6}
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetSecretResponse) GetSecret() *Secret {
@@ -664,14 +1295,20 @@ type GetSecretPasswordRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Specific version (null for current)
-	Version       *int32 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	Version *int32 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// Pin the caller to a version. Without pin_token a new pin to the
+	// requested (or current) version is created; with pin_token the pin
+	// advances to the current version.
+	Pin bool `protobuf:"varint,3,opt,name=pin,proto3" json:"pin,omitempty"`
+	// Token of an existing pin: reads the version it is pinned to
+	PinToken      *string `protobuf:"bytes,4,opt,name=pin_token,json=pinToken,proto3,oneof" json:"pin_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSecretPasswordRequest) Reset() {
 	*x = GetSecretPasswordRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[7]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -683,7 +1320,7 @@ func (x *GetSecretPasswordRequest) String() string {
 func (*GetSecretPasswordRequest) ProtoMessage() {}
 
 func (x *GetSecretPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[7]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -696,7 +1333,7 @@ func (x *GetSecretPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSecretPasswordRequest.ProtoReflect.Descriptor instead.
 func (*GetSecretPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{7}
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetSecretPasswordRequest) GetId() string {
@@ -713,17 +1350,37 @@ func (x *GetSecretPasswordRequest) GetVersion() int32 {
 	return 0
 }
 
+func (x *GetSecretPasswordRequest) GetPin() bool {
+	if x != nil {
+		return x.Pin
+	}
+	return false
+}
+
+func (x *GetSecretPasswordRequest) GetPinToken() string {
+	if x != nil && x.PinToken != nil {
+		return *x.PinToken
+	}
+	return ""
+}
+
 type GetSecretPasswordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Version  int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Encoding of the current password (BASE64 passwords are returned encoded)
+	Encoding PasswordEncoding `protobuf:"varint,3,opt,name=encoding,proto3,enum=warden.service.v1.PasswordEncoding" json:"encoding,omitempty"`
+	// Pin the password was read through, when pin or pin_token was set
+	Pin *VersionPin `protobuf:"bytes,4,opt,name=pin,proto3,oneof" json:"pin,omitempty"`
+	// Token of a newly created pin, returned only once
+	PinToken      *string `protobuf:"bytes,5,opt,name=pin_token,json=pinToken,proto3,oneof" json:"pin_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSecretPasswordResponse) Reset() {
 	*x = GetSecretPasswordResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[8]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -735,7 +1392,7 @@ func (x *GetSecretPasswordResponse) String() string {
 func (*GetSecretPasswordResponse) ProtoMessage() {}
 
 func (x *GetSecretPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[8]
+	mi := &file_warden_service_v1_secret_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +1405,7 @@ func (x *GetSecretPasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSecretPasswordResponse.ProtoReflect.Descriptor instead.
 func (*GetSecretPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{8}
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetSecretPasswordResponse) GetPassword() string {
@@ -765,37 +1422,61 @@ func (x *GetSecretPasswordResponse) GetVersion() int32 {
 	return 0
 }
 
-// Request to list secrets
-type ListSecretsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Folder ID (null for root-level)
-	FolderId *string `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
-	// Pagination
-	Page     *uint32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize *uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	// Filter by status
-	Status *SecretStatus `protobuf:"varint,4,opt,name=status,proto3,enum=warden.service.v1.SecretStatus,oneof" json:"status,omitempty"`
-	// Filter by name
-	NameFilter    *string `protobuf:"bytes,5,opt,name=name_filter,json=nameFilter,proto3,oneof" json:"name_filter,omitempty"`
+func (x *GetSecretPasswordResponse) GetEncoding() PasswordEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return PasswordEncoding_PASSWORD_ENCODING_UNSPECIFIED
+}
+
+func (x *GetSecretPasswordResponse) GetPin() *VersionPin {
+	if x != nil {
+		return x.Pin
+	}
+	return nil
+}
+
+func (x *GetSecretPasswordResponse) GetPinToken() string {
+	if x != nil && x.PinToken != nil {
+		return *x.PinToken
+	}
+	return ""
+}
+
+// A consumer pinned to a version of a secret. The pin stays on its version
+// across rotations until the consumer advances or releases it.
+type VersionPin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SecretId      string                 `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	VersionNumber int32                  `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	// User ID of the consumer that created the pin
+	Consumer string `protobuf:"bytes,4,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// Current version of the secret
+	CurrentVersion int32 `protobuf:"varint,5,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// Whether the pin is behind the current version
+	Stale         bool                   `protobuf:"varint,6,opt,name=stale,proto3" json:"stale,omitempty"`
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_time,json=lastUsedTime,proto3,oneof" json:"last_used_time,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3,oneof" json:"create_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSecretsRequest) Reset() {
-	*x = ListSecretsRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[9]
+func (x *VersionPin) Reset() {
+	*x = VersionPin{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSecretsRequest) String() string {
+func (x *VersionPin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSecretsRequest) ProtoMessage() {}
+func (*VersionPin) ProtoMessage() {}
 
-func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[9]
+func (x *VersionPin) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,69 +1487,92 @@ func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
-func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use VersionPin.ProtoReflect.Descriptor instead.
+func (*VersionPin) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListSecretsRequest) GetFolderId() string {
-	if x != nil && x.FolderId != nil {
-		return *x.FolderId
+func (x *VersionPin) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VersionPin) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
 	}
 	return ""
 }
 
-func (x *ListSecretsRequest) GetPage() uint32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
+func (x *VersionPin) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
 	}
 	return 0
 }
 
-func (x *ListSecretsRequest) GetPageSize() uint32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
+func (x *VersionPin) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *VersionPin) GetCurrentVersion() int32 {
+	if x != nil {
+		return x.CurrentVersion
 	}
 	return 0
 }
 
-func (x *ListSecretsRequest) GetStatus() SecretStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *VersionPin) GetStale() bool {
+	if x != nil {
+		return x.Stale
 	}
-	return SecretStatus_SECRET_STATUS_UNSPECIFIED
+	return false
 }
 
-func (x *ListSecretsRequest) GetNameFilter() string {
-	if x != nil && x.NameFilter != nil {
-		return *x.NameFilter
+func (x *VersionPin) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
 	}
-	return ""
+	return nil
 }
 
-type ListSecretsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secrets       []*Secret              `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+func (x *VersionPin) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// Request to get a hint of a password
+type GetSecretPasswordMaskedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Specific version (null for current)
+	Version       *int32 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSecretsResponse) Reset() {
-	*x = ListSecretsResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[10]
+func (x *GetSecretPasswordMaskedRequest) Reset() {
+	*x = GetSecretPasswordMaskedRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSecretsResponse) String() string {
+func (x *GetSecretPasswordMaskedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSecretsResponse) ProtoMessage() {}
+func (*GetSecretPasswordMaskedRequest) ProtoMessage() {}
 
-func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[10]
+func (x *GetSecretPasswordMaskedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,60 +1583,59 @@ func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
-func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetSecretPasswordMaskedRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretPasswordMaskedRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListSecretsResponse) GetSecrets() []*Secret {
+func (x *GetSecretPasswordMaskedRequest) GetId() string {
 	if x != nil {
-		return x.Secrets
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListSecretsResponse) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
+func (x *GetSecretPasswordMaskedRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return 0
 }
 
-// Request to update secret metadata
-type UpdateSecretRequest struct {
+type GetSecretPasswordMaskedResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// New name
-	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	// New username
-	Username *string `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// New host URL
-	HostUrl *string `protobuf:"bytes,4,opt,name=host_url,json=hostUrl,proto3,oneof" json:"host_url,omitempty"`
-	// New description
-	Description *string `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	// New metadata (replaces existing)
-	Metadata *structpb.Struct `protobuf:"bytes,6,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
-	// New status
-	Status        *SecretStatus `protobuf:"varint,7,opt,name=status,proto3,enum=warden.service.v1.SecretStatus,oneof" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateSecretRequest) Reset() {
-	*x = UpdateSecretRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[11]
+	// Characters of a text password, decoded bytes of a BASE64 one
+	Length int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	// First and last characters, only for text passwords of at least 8 characters
+	FirstChar string `protobuf:"bytes,2,opt,name=first_char,json=firstChar,proto3" json:"first_char,omitempty"`
+	LastChar  string `protobuf:"bytes,3,opt,name=last_char,json=lastChar,proto3" json:"last_char,omitempty"`
+	// First 8 hex digits of the checksum of the password as stored, the one a
+	// new version with the password would get
+	ChecksumPrefix string           `protobuf:"bytes,4,opt,name=checksum_prefix,json=checksumPrefix,proto3" json:"checksum_prefix,omitempty"`
+	Version        int32            `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Encoding       PasswordEncoding `protobuf:"varint,6,opt,name=encoding,proto3,enum=warden.service.v1.PasswordEncoding" json:"encoding,omitempty"`
+	// Algorithm of the checksum: "sha256", or "hmac-sha256:<key id>" for
+	// checksums keyed with the server's checksum key
+	ChecksumAlgorithm string `protobuf:"bytes,7,opt,name=checksum_algorithm,json=checksumAlgorithm,proto3" json:"checksum_algorithm,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetSecretPasswordMaskedResponse) Reset() {
+	*x = GetSecretPasswordMaskedResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSecretRequest) String() string {
+func (x *GetSecretPasswordMaskedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSecretRequest) ProtoMessage() {}
+func (*GetSecretPasswordMaskedResponse) ProtoMessage() {}
 
-func (x *UpdateSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[11]
+func (x *GetSecretPasswordMaskedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,82 +1646,87 @@ func (x *UpdateSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSecretRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSecretRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetSecretPasswordMaskedResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretPasswordMaskedResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateSecretRequest) GetId() string {
+func (x *GetSecretPasswordMaskedResponse) GetLength() int32 {
 	if x != nil {
-		return x.Id
+		return x.Length
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSecretRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *GetSecretPasswordMaskedResponse) GetFirstChar() string {
+	if x != nil {
+		return x.FirstChar
 	}
 	return ""
 }
 
-func (x *UpdateSecretRequest) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
+func (x *GetSecretPasswordMaskedResponse) GetLastChar() string {
+	if x != nil {
+		return x.LastChar
 	}
 	return ""
 }
 
-func (x *UpdateSecretRequest) GetHostUrl() string {
-	if x != nil && x.HostUrl != nil {
-		return *x.HostUrl
+func (x *GetSecretPasswordMaskedResponse) GetChecksumPrefix() string {
+	if x != nil {
+		return x.ChecksumPrefix
 	}
 	return ""
 }
 
-func (x *UpdateSecretRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *GetSecretPasswordMaskedResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSecretRequest) GetMetadata() *structpb.Struct {
+func (x *GetSecretPasswordMaskedResponse) GetEncoding() PasswordEncoding {
 	if x != nil {
-		return x.Metadata
+		return x.Encoding
 	}
-	return nil
+	return PasswordEncoding_PASSWORD_ENCODING_UNSPECIFIED
 }
 
-func (x *UpdateSecretRequest) GetStatus() SecretStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *GetSecretPasswordMaskedResponse) GetChecksumAlgorithm() string {
+	if x != nil {
+		return x.ChecksumAlgorithm
 	}
-	return SecretStatus_SECRET_STATUS_UNSPECIFIED
+	return ""
 }
 
-type UpdateSecretResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+// Request to issue a one-time retrieval token
+type CreateRetrievalTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Specific version (null for the current one at issue time)
+	Version *int32 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// Validity in seconds (default 60)
+	TtlSeconds    *uint32 `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3,oneof" json:"ttl_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSecretResponse) Reset() {
-	*x = UpdateSecretResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[12]
+func (x *CreateRetrievalTokenRequest) Reset() {
+	*x = CreateRetrievalTokenRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSecretResponse) String() string {
+func (x *CreateRetrievalTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSecretResponse) ProtoMessage() {}
+func (*CreateRetrievalTokenRequest) ProtoMessage() {}
 
-func (x *UpdateSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[12]
+func (x *CreateRetrievalTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,45 +1737,57 @@ func (x *UpdateSecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSecretResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSecretResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CreateRetrievalTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateRetrievalTokenRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateSecretResponse) GetSecret() *Secret {
+func (x *CreateRetrievalTokenRequest) GetId() string {
 	if x != nil {
-		return x.Secret
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// Request to update secret password
-type UpdateSecretPasswordRequest struct {
+func (x *CreateRetrievalTokenRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *CreateRetrievalTokenRequest) GetTtlSeconds() uint32 {
+	if x != nil && x.TtlSeconds != nil {
+		return *x.TtlSeconds
+	}
+	return 0
+}
+
+type CreateRetrievalTokenResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// New password
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	// Version comment
-	Comment       string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Version the token reads
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSecretPasswordRequest) Reset() {
-	*x = UpdateSecretPasswordRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[13]
+func (x *CreateRetrievalTokenResponse) Reset() {
+	*x = CreateRetrievalTokenResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSecretPasswordRequest) String() string {
+func (x *CreateRetrievalTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSecretPasswordRequest) ProtoMessage() {}
+func (*CreateRetrievalTokenResponse) ProtoMessage() {}
 
-func (x *UpdateSecretPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[13]
+func (x *CreateRetrievalTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,55 +1798,102 @@ func (x *UpdateSecretPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSecretPasswordRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSecretPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreateRetrievalTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateRetrievalTokenResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateSecretPasswordRequest) GetId() string {
+func (x *CreateRetrievalTokenResponse) GetToken() string {
 	if x != nil {
-		return x.Id
+		return x.Token
 	}
 	return ""
 }
 
-func (x *UpdateSecretPasswordRequest) GetPassword() string {
+func (x *CreateRetrievalTokenResponse) GetVersion() int32 {
 	if x != nil {
-		return x.Password
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSecretPasswordRequest) GetComment() string {
+func (x *CreateRetrievalTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Comment
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Request to redeem a retrieval token
+type RedeemRetrievalTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemRetrievalTokenRequest) Reset() {
+	*x = RedeemRetrievalTokenRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemRetrievalTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemRetrievalTokenRequest) ProtoMessage() {}
+
+func (x *RedeemRetrievalTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemRetrievalTokenRequest.ProtoReflect.Descriptor instead.
+func (*RedeemRetrievalTokenRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RedeemRetrievalTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
 	}
 	return ""
 }
 
-type UpdateSecretPasswordResponse struct {
+type RedeemRetrievalTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	Version       *SecretVersion         `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Encoding      PasswordEncoding       `protobuf:"varint,4,opt,name=encoding,proto3,enum=warden.service.v1.PasswordEncoding" json:"encoding,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSecretPasswordResponse) Reset() {
-	*x = UpdateSecretPasswordResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[14]
+func (x *RedeemRetrievalTokenResponse) Reset() {
+	*x = RedeemRetrievalTokenResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSecretPasswordResponse) String() string {
+func (x *RedeemRetrievalTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSecretPasswordResponse) ProtoMessage() {}
+func (*RedeemRetrievalTokenResponse) ProtoMessage() {}
 
-func (x *UpdateSecretPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[14]
+func (x *RedeemRetrievalTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,50 +1904,141 @@ func (x *UpdateSecretPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSecretPasswordResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSecretPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RedeemRetrievalTokenResponse.ProtoReflect.Descriptor instead.
+func (*RedeemRetrievalTokenResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpdateSecretPasswordResponse) GetSecret() *Secret {
+func (x *RedeemRetrievalTokenResponse) GetSecretId() string {
 	if x != nil {
-		return x.Secret
+		return x.SecretId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateSecretPasswordResponse) GetVersion() *SecretVersion {
+func (x *RedeemRetrievalTokenResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RedeemRetrievalTokenResponse) GetVersion() int32 {
 	if x != nil {
 		return x.Version
 	}
-	return nil
+	return 0
 }
 
-// Request to delete a secret
-type DeleteSecretRequest struct {
+func (x *RedeemRetrievalTokenResponse) GetEncoding() PasswordEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return PasswordEncoding_PASSWORD_ENCODING_UNSPECIFIED
+}
+
+// Request to read a secret by path
+type GetSecretByPathRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Permanently delete (skip soft-delete)
-	Permanent     bool `protobuf:"varint,2,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	// Folder path, e.g. "/Team/Infra" (empty or "/" for root-level secrets)
+	FolderPath string `protobuf:"bytes,1,opt,name=folder_path,json=folderPath,proto3" json:"folder_path,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Metadata keys to return (none when empty)
+	MetadataKeys []string `protobuf:"bytes,3,rep,name=metadata_keys,json=metadataKeys,proto3" json:"metadata_keys,omitempty"`
+	// Replace {{secret:<id>.<field>}} references in the returned metadata with
+	// the values of the referenced secrets the caller can read
+	ResolveReferences bool `protobuf:"varint,4,opt,name=resolve_references,json=resolveReferences,proto3" json:"resolve_references,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetSecretByPathRequest) Reset() {
+	*x = GetSecretByPathRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretByPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretByPathRequest) ProtoMessage() {}
+
+func (x *GetSecretByPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretByPathRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretByPathRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetSecretByPathRequest) GetFolderPath() string {
+	if x != nil {
+		return x.FolderPath
+	}
+	return ""
+}
+
+func (x *GetSecretByPathRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetSecretByPathRequest) GetMetadataKeys() []string {
+	if x != nil {
+		return x.MetadataKeys
+	}
+	return nil
+}
+
+func (x *GetSecretByPathRequest) GetResolveReferences() bool {
+	if x != nil {
+		return x.ResolveReferences
+	}
+	return false
+}
+
+type GetSecretByPathResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Version  int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Username string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	HostUrl  string                 `protobuf:"bytes,5,opt,name=host_url,json=hostUrl,proto3" json:"host_url,omitempty"`
+	// Requested metadata keys present on the secret; non-string values are JSON-encoded
+	Metadata      map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSecretRequest) Reset() {
-	*x = DeleteSecretRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[15]
+func (x *GetSecretByPathResponse) Reset() {
+	*x = GetSecretByPathResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSecretRequest) String() string {
+func (x *GetSecretByPathResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSecretRequest) ProtoMessage() {}
+func (*GetSecretByPathResponse) ProtoMessage() {}
 
-func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[15]
+func (x *GetSecretByPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1191,50 +2049,91 @@ func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetSecretByPathResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretByPathResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DeleteSecretRequest) GetId() string {
+func (x *GetSecretByPathResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteSecretRequest) GetPermanent() bool {
+func (x *GetSecretByPathResponse) GetPassword() string {
 	if x != nil {
-		return x.Permanent
+		return x.Password
 	}
-	return false
+	return ""
 }
 
-// Request to move a secret
-type MoveSecretRequest struct {
+func (x *GetSecretByPathResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetSecretByPathResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetSecretByPathResponse) GetHostUrl() string {
+	if x != nil {
+		return x.HostUrl
+	}
+	return ""
+}
+
+func (x *GetSecretByPathResponse) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetSecretByPathResponse) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Request to subscribe to secrets by path
+type FetchSecretsStreamRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// New folder ID (null to move to root)
-	NewFolderId   *string `protobuf:"bytes,2,opt,name=new_folder_id,json=newFolderId,proto3,oneof" json:"new_folder_id,omitempty"`
+	// Secret paths, e.g. "/Team/Infra/db-password" ("/name" for root-level secrets)
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Metadata keys to return (none when empty)
+	MetadataKeys []string `protobuf:"bytes,2,rep,name=metadata_keys,json=metadataKeys,proto3" json:"metadata_keys,omitempty"`
+	// Also subscribe to the secrets matching a label selector, with the syntax
+	// of ListSecrets. The matches are looked up again on every change, so
+	// secrets gaining the labels are added and secrets losing them are sent
+	// as missing. At most 100 secrets may match. Paths or a selector is required.
+	LabelSelector string `protobuf:"bytes,3,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveSecretRequest) Reset() {
-	*x = MoveSecretRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[16]
+func (x *FetchSecretsStreamRequest) Reset() {
+	*x = FetchSecretsStreamRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveSecretRequest) String() string {
+func (x *FetchSecretsStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveSecretRequest) ProtoMessage() {}
+func (*FetchSecretsStreamRequest) ProtoMessage() {}
 
-func (x *MoveSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[16]
+func (x *FetchSecretsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1245,47 +2144,2108 @@ func (x *MoveSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveSecretRequest.ProtoReflect.Descriptor instead.
-func (*MoveSecretRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use FetchSecretsStreamRequest.ProtoReflect.Descriptor instead.
+func (*FetchSecretsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FetchSecretsStreamRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *FetchSecretsStreamRequest) GetMetadataKeys() []string {
+	if x != nil {
+		return x.MetadataKeys
+	}
+	return nil
+}
+
+func (x *FetchSecretsStreamRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+// A secret sent on a stream, addressed by the path it was subscribed with
+type StreamedSecret struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Set when the secret does not exist or is not readable; all other
+	// fields are empty then
+	Missing  bool   `protobuf:"varint,2,opt,name=missing,proto3" json:"missing,omitempty"`
+	Id       string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Version  int32  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Username string `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	HostUrl  string `protobuf:"bytes,7,opt,name=host_url,json=hostUrl,proto3" json:"host_url,omitempty"`
+	// Requested metadata keys present on the secret; non-string values are JSON-encoded
+	Metadata      map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamedSecret) Reset() {
+	*x = StreamedSecret{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamedSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamedSecret) ProtoMessage() {}
+
+func (x *StreamedSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamedSecret.ProtoReflect.Descriptor instead.
+func (*StreamedSecret) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StreamedSecret) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *StreamedSecret) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+func (x *StreamedSecret) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamedSecret) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *StreamedSecret) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *StreamedSecret) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *StreamedSecret) GetHostUrl() string {
+	if x != nil {
+		return x.HostUrl
+	}
+	return ""
+}
+
+func (x *StreamedSecret) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *StreamedSecret) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type FetchSecretsStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set on the first message, which holds every subscribed path. Later
+	// messages hold the changed paths only.
+	Initial       bool              `protobuf:"varint,1,opt,name=initial,proto3" json:"initial,omitempty"`
+	Secrets       []*StreamedSecret `protobuf:"bytes,2,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchSecretsStreamResponse) Reset() {
+	*x = FetchSecretsStreamResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchSecretsStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchSecretsStreamResponse) ProtoMessage() {}
+
+func (x *FetchSecretsStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchSecretsStreamResponse.ProtoReflect.Descriptor instead.
+func (*FetchSecretsStreamResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FetchSecretsStreamResponse) GetInitial() bool {
+	if x != nil {
+		return x.Initial
+	}
+	return false
+}
+
+func (x *FetchSecretsStreamResponse) GetSecrets() []*StreamedSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+// Request to list secrets
+type ListSecretsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Folder ID (null for root-level)
+	FolderId *string `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	// Pagination
+	Page     *uint32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Filter by status. Deleted secrets are only listed when filtering for
+	// SECRET_STATUS_DELETED.
+	Status *SecretStatus `protobuf:"varint,4,opt,name=status,proto3,enum=warden.service.v1.SecretStatus,oneof" json:"status,omitempty"`
+	// Filter by name
+	NameFilter *string `protobuf:"bytes,5,opt,name=name_filter,json=nameFilter,proto3,oneof" json:"name_filter,omitempty"`
+	// Opaque next_cursor of the previous page; continues after it and ignores page
+	Cursor *string `protobuf:"bytes,6,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// Sort order; a cursor only continues the order it was returned for
+	SortBy    *ListSortField `protobuf:"varint,7,opt,name=sort_by,json=sortBy,proto3,enum=warden.service.v1.ListSortField,oneof" json:"sort_by,omitempty"`
+	SortOrder *SortOrder     `protobuf:"varint,8,opt,name=sort_order,json=sortOrder,proto3,enum=warden.service.v1.SortOrder,oneof" json:"sort_order,omitempty"`
+	// Only secrets whose password was not read since this time, including
+	// secrets never read (finds stale credentials)
+	NotAccessedSince *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=not_accessed_since,json=notAccessedSince,proto3,oneof" json:"not_accessed_since,omitempty"`
+	// Only list secrets the caller can read, granted directly or through a
+	// folder. Pages are always full and total counts only those secrets.
+	// Otherwise unreadable secrets are left out of each page after paginating,
+	// and total still counts them.
+	AccessibleOnly bool `protobuf:"varint,10,opt,name=accessible_only,json=accessibleOnly,proto3" json:"accessible_only,omitempty"`
+	// Return the IDs of the page in ids instead of full secrets
+	IdsOnly bool `protobuf:"varint,11,opt,name=ids_only,json=idsOnly,proto3" json:"ids_only,omitempty"`
+	// Only return total, without any secrets or IDs
+	CountOnly bool `protobuf:"varint,12,opt,name=count_only,json=countOnly,proto3" json:"count_only,omitempty"`
+	// Only secrets matching a label selector, e.g. "app=payments,env=prod".
+	// Terms are key=value, key!=value, key (set) and !key (not set), all of
+	// which must hold. A label is a metadata key with a string value or a
+	// "key=value" entry of the tags list.
+	LabelSelector *string `protobuf:"bytes,13,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsRequest) Reset() {
+	*x = ListSecretsRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsRequest) ProtoMessage() {}
+
+func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListSecretsRequest) GetFolderId() string {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return ""
+}
+
+func (x *ListSecretsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListSecretsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSecretsRequest) GetStatus() SecretStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return SecretStatus_SECRET_STATUS_UNSPECIFIED
+}
+
+func (x *ListSecretsRequest) GetNameFilter() string {
+	if x != nil && x.NameFilter != nil {
+		return *x.NameFilter
+	}
+	return ""
+}
+
+func (x *ListSecretsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListSecretsRequest) GetSortBy() ListSortField {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return ListSortField_LIST_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListSecretsRequest) GetSortOrder() SortOrder {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *ListSecretsRequest) GetNotAccessedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAccessedSince
+	}
+	return nil
+}
+
+func (x *ListSecretsRequest) GetAccessibleOnly() bool {
+	if x != nil {
+		return x.AccessibleOnly
+	}
+	return false
+}
+
+func (x *ListSecretsRequest) GetIdsOnly() bool {
+	if x != nil {
+		return x.IdsOnly
+	}
+	return false
+}
+
+func (x *ListSecretsRequest) GetCountOnly() bool {
+	if x != nil {
+		return x.CountOnly
+	}
+	return false
+}
+
+func (x *ListSecretsRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
+type ListSecretsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Secrets []*Secret              `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Total   uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Cursor of the next page (empty on the last page)
+	NextCursor string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Secret IDs of the page, filled instead of secrets with ids_only
+	Ids           []string `protobuf:"bytes,4,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsResponse) Reset() {
+	*x = ListSecretsResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsResponse) ProtoMessage() {}
+
+func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListSecretsResponse) GetSecrets() []*Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ListSecretsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSecretsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListSecretsResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Request to update secret metadata
+type UpdateSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New name
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// New username
+	Username *string `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// New host URL
+	HostUrl *string `protobuf:"bytes,4,opt,name=host_url,json=hostUrl,proto3,oneof" json:"host_url,omitempty"`
+	// New description
+	Description *string `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// New metadata (replaces existing)
+	Metadata *structpb.Struct `protobuf:"bytes,6,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	// New status
+	Status *SecretStatus `protobuf:"varint,7,opt,name=status,proto3,enum=warden.service.v1.SecretStatus,oneof" json:"status,omitempty"`
+	// Revision the client read; the update fails with PRECONDITION_FAILED when
+	// the secret changed since
+	ExpectedRevision *int64 `protobuf:"varint,8,opt,name=expected_revision,json=expectedRevision,proto3,oneof" json:"expected_revision,omitempty"`
+	// Mark the secret sensitive (owners only)
+	Sensitive *bool `protobuf:"varint,9,opt,name=sensitive,proto3,oneof" json:"sensitive,omitempty"`
+	// Mark the secret a canary (owners only)
+	Canary *bool `protobuf:"varint,10,opt,name=canary,proto3,oneof" json:"canary,omitempty"`
+	// Require a second owner's approval for permanent deletion (owners only;
+	// only platform admins can lift it)
+	Protected     *bool `protobuf:"varint,11,opt,name=protected,proto3,oneof" json:"protected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretRequest) Reset() {
+	*x = UpdateSecretRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretRequest) ProtoMessage() {}
+
+func (x *UpdateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateSecretRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetHostUrl() string {
+	if x != nil && x.HostUrl != nil {
+		return *x.HostUrl
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UpdateSecretRequest) GetStatus() SecretStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return SecretStatus_SECRET_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateSecretRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+func (x *UpdateSecretRequest) GetSensitive() bool {
+	if x != nil && x.Sensitive != nil {
+		return *x.Sensitive
+	}
+	return false
+}
+
+func (x *UpdateSecretRequest) GetCanary() bool {
+	if x != nil && x.Canary != nil {
+		return *x.Canary
+	}
+	return false
+}
+
+func (x *UpdateSecretRequest) GetProtected() bool {
+	if x != nil && x.Protected != nil {
+		return *x.Protected
+	}
+	return false
+}
+
+type UpdateSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretResponse) Reset() {
+	*x = UpdateSecretResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretResponse) ProtoMessage() {}
+
+func (x *UpdateSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSecretResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateSecretResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+// Request to update secret password
+type UpdateSecretPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New password
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Version comment
+	Comment string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// BASE64 to store binary data; the size limit applies to the decoded bytes
+	PasswordEncoding PasswordEncoding `protobuf:"varint,4,opt,name=password_encoding,json=passwordEncoding,proto3,enum=warden.service.v1.PasswordEncoding" json:"password_encoding,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateSecretPasswordRequest) Reset() {
+	*x = UpdateSecretPasswordRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretPasswordRequest) ProtoMessage() {}
+
+func (x *UpdateSecretPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretPasswordRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecretPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateSecretPasswordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSecretPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UpdateSecretPasswordRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *UpdateSecretPasswordRequest) GetPasswordEncoding() PasswordEncoding {
+	if x != nil {
+		return x.PasswordEncoding
+	}
+	return PasswordEncoding_PASSWORD_ENCODING_UNSPECIFIED
+}
+
+type UpdateSecretPasswordResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Secret  *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Version *SecretVersion         `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Consumers still pinned to an earlier version after the update
+	StalePins     []*VersionPin `protobuf:"bytes,3,rep,name=stale_pins,json=stalePins,proto3" json:"stale_pins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretPasswordResponse) Reset() {
+	*x = UpdateSecretPasswordResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretPasswordResponse) ProtoMessage() {}
+
+func (x *UpdateSecretPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretPasswordResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSecretPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateSecretPasswordResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *UpdateSecretPasswordResponse) GetVersion() *SecretVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *UpdateSecretPasswordResponse) GetStalePins() []*VersionPin {
+	if x != nil {
+		return x.StalePins
+	}
+	return nil
+}
+
+// Request to delete a secret
+type DeleteSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Permanently delete (skip soft-delete)
+	Permanent bool `protobuf:"varint,2,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	// Delete even when the metadata of other secrets references this one;
+	// without it the call fails with SECRET_REFERENCED
+	Force         bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretRequest) Reset() {
+	*x = DeleteSecretRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretRequest) ProtoMessage() {}
+
+func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteSecretRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+func (x *DeleteSecretRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// Request to move a secret
+type MoveSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New folder ID (null to move to root)
+	NewFolderId   *string `protobuf:"bytes,2,opt,name=new_folder_id,json=newFolderId,proto3,oneof" json:"new_folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveSecretRequest) Reset() {
+	*x = MoveSecretRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveSecretRequest) ProtoMessage() {}
+
+func (x *MoveSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveSecretRequest.ProtoReflect.Descriptor instead.
+func (*MoveSecretRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MoveSecretRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveSecretRequest) GetNewFolderId() string {
+	if x != nil && x.NewFolderId != nil {
+		return *x.NewFolderId
+	}
+	return ""
+}
+
+type MoveSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveSecretResponse) Reset() {
+	*x = MoveSecretResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveSecretResponse) ProtoMessage() {}
+
+func (x *MoveSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveSecretResponse.ProtoReflect.Descriptor instead.
+func (*MoveSecretResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MoveSecretResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+// Request to list versions
+type ListVersionsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SecretId string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	// Pagination
+	Page     *uint32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Opaque next_cursor of the previous page; continues after it and ignores page
+	Cursor *string `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// Only return versions created by RestoreVersion
+	RestoredOnly *bool `protobuf:"varint,5,opt,name=restored_only,json=restoredOnly,proto3,oneof" json:"restored_only,omitempty"`
+	// Add the Vault state of each version (deleted and destroyed flags, Vault timestamps)
+	IncludeVaultState *bool `protobuf:"varint,6,opt,name=include_vault_state,json=includeVaultState,proto3,oneof" json:"include_vault_state,omitempty"`
+	// Only return versions whose password can still be read from Vault
+	// (implies include_vault_state)
+	RetrievableOnly *bool `protobuf:"varint,7,opt,name=retrievable_only,json=retrievableOnly,proto3,oneof" json:"retrievable_only,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListVersionsRequest) Reset() {
+	*x = ListVersionsRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVersionsRequest) ProtoMessage() {}
+
+func (x *ListVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListVersionsRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *ListVersionsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListVersionsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListVersionsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListVersionsRequest) GetRestoredOnly() bool {
+	if x != nil && x.RestoredOnly != nil {
+		return *x.RestoredOnly
+	}
+	return false
+}
+
+func (x *ListVersionsRequest) GetIncludeVaultState() bool {
+	if x != nil && x.IncludeVaultState != nil {
+		return *x.IncludeVaultState
+	}
+	return false
+}
+
+func (x *ListVersionsRequest) GetRetrievableOnly() bool {
+	if x != nil && x.RetrievableOnly != nil {
+		return *x.RetrievableOnly
+	}
+	return false
+}
+
+type ListVersionsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Versions []*SecretVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	Total    uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Cursor of the next page (empty on the last page)
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVersionsResponse) Reset() {
+	*x = ListVersionsResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVersionsResponse) ProtoMessage() {}
+
+func (x *ListVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListVersionsResponse) GetVersions() []*SecretVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListVersionsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListVersionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Request to get a specific version
+type GetVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	VersionNumber int32                  `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	// Include password in response
+	IncludePassword bool `protobuf:"varint,3,opt,name=include_password,json=includePassword,proto3" json:"include_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetVersionRequest) Reset() {
+	*x = GetVersionRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionRequest) ProtoMessage() {}
+
+func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetVersionRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *GetVersionRequest) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *GetVersionRequest) GetIncludePassword() bool {
+	if x != nil {
+		return x.IncludePassword
+	}
+	return false
+}
+
+type GetVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *SecretVersion         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Password      *string                `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVersionResponse) Reset() {
+	*x = GetVersionResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionResponse) ProtoMessage() {}
+
+func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetVersionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetVersionResponse) GetVersion() *SecretVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *GetVersionResponse) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+// Request to restore a version
+type RestoreVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	VersionNumber int32                  `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	// Comment for the new version created by restore
+	Comment       string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreVersionRequest) Reset() {
+	*x = RestoreVersionRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVersionRequest) ProtoMessage() {}
+
+func (x *RestoreVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreVersionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RestoreVersionRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *RestoreVersionRequest) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *RestoreVersionRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type RestoreVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	NewVersion    *SecretVersion         `protobuf:"bytes,2,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreVersionResponse) Reset() {
+	*x = RestoreVersionResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVersionResponse) ProtoMessage() {}
+
+func (x *RestoreVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVersionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreVersionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RestoreVersionResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *RestoreVersionResponse) GetNewVersion() *SecretVersion {
+	if x != nil {
+		return x.NewVersion
+	}
+	return nil
+}
+
+type DeleteVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	VersionNumber int32                  `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVersionRequest) Reset() {
+	*x = DeleteVersionRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVersionRequest) ProtoMessage() {}
+
+func (x *DeleteVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVersionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVersionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteVersionRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *DeleteVersionRequest) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+type DeleteVersionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version with its Vault state after the change
+	Version       *SecretVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVersionResponse) Reset() {
+	*x = DeleteVersionResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVersionResponse) ProtoMessage() {}
+
+func (x *DeleteVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVersionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVersionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteVersionResponse) GetVersion() *SecretVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type DestroyVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	VersionNumber int32                  `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroyVersionRequest) Reset() {
+	*x = DestroyVersionRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroyVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyVersionRequest) ProtoMessage() {}
+
+func (x *DestroyVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyVersionRequest.ProtoReflect.Descriptor instead.
+func (*DestroyVersionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DestroyVersionRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *DestroyVersionRequest) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+type DestroyVersionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version with its Vault state after the change
+	Version       *SecretVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroyVersionResponse) Reset() {
+	*x = DestroyVersionResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroyVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyVersionResponse) ProtoMessage() {}
+
+func (x *DestroyVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyVersionResponse.ProtoReflect.Descriptor instead.
+func (*DestroyVersionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DestroyVersionResponse) GetVersion() *SecretVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type UndeleteVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	VersionNumber int32                  `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteVersionRequest) Reset() {
+	*x = UndeleteVersionRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteVersionRequest) ProtoMessage() {}
+
+func (x *UndeleteVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteVersionRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteVersionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UndeleteVersionRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *UndeleteVersionRequest) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+type UndeleteVersionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version with its Vault state after the change
+	Version       *SecretVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteVersionResponse) Reset() {
+	*x = UndeleteVersionResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteVersionResponse) ProtoMessage() {}
+
+func (x *UndeleteVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteVersionResponse.ProtoReflect.Descriptor instead.
+func (*UndeleteVersionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UndeleteVersionResponse) GetVersion() *SecretVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// Request to list the version pins of a secret
+type ListVersionPinsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SecretId string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	// Only pins behind the current version
+	StaleOnly     bool `protobuf:"varint,2,opt,name=stale_only,json=staleOnly,proto3" json:"stale_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVersionPinsRequest) Reset() {
+	*x = ListVersionPinsRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVersionPinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVersionPinsRequest) ProtoMessage() {}
+
+func (x *ListVersionPinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVersionPinsRequest.ProtoReflect.Descriptor instead.
+func (*ListVersionPinsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListVersionPinsRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *ListVersionPinsRequest) GetStaleOnly() bool {
+	if x != nil {
+		return x.StaleOnly
+	}
+	return false
+}
+
+type ListVersionPinsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pins          []*VersionPin          `protobuf:"bytes,1,rep,name=pins,proto3" json:"pins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVersionPinsResponse) Reset() {
+	*x = ListVersionPinsResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVersionPinsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVersionPinsResponse) ProtoMessage() {}
+
+func (x *ListVersionPinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVersionPinsResponse.ProtoReflect.Descriptor instead.
+func (*ListVersionPinsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListVersionPinsResponse) GetPins() []*VersionPin {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+// Request to release a version pin
+type DeleteVersionPinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	PinId         uint32                 `protobuf:"varint,2,opt,name=pin_id,json=pinId,proto3" json:"pin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVersionPinRequest) Reset() {
+	*x = DeleteVersionPinRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVersionPinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVersionPinRequest) ProtoMessage() {}
+
+func (x *DeleteVersionPinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVersionPinRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVersionPinRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteVersionPinRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *DeleteVersionPinRequest) GetPinId() uint32 {
+	if x != nil {
+		return x.PinId
+	}
+	return 0
+}
+
+// Request to search secrets
+type SearchSecretsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Search query (searches name, username, host_url, description, tags and custom field values)
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Limit search to folder and subfolders (null for all)
+	FolderId *string `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	// Also search every descendant of folder_id
+	IncludeSubfolders bool `protobuf:"varint,3,opt,name=include_subfolders,json=includeSubfolders,proto3" json:"include_subfolders,omitempty"`
+	// Pagination
+	Page     *uint32 `protobuf:"varint,4,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *uint32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Filter by status. Deleted secrets are only searched when filtering for
+	// SECRET_STATUS_DELETED.
+	Status *SecretStatus `protobuf:"varint,6,opt,name=status,proto3,enum=warden.service.v1.SecretStatus,oneof" json:"status,omitempty"`
+	// Return the IDs of the page in ids instead of full secrets and hits
+	IdsOnly bool `protobuf:"varint,7,opt,name=ids_only,json=idsOnly,proto3" json:"ids_only,omitempty"`
+	// Only return total, counting every readable match across all pages
+	CountOnly     bool `protobuf:"varint,8,opt,name=count_only,json=countOnly,proto3" json:"count_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSecretsRequest) Reset() {
+	*x = SearchSecretsRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSecretsRequest) ProtoMessage() {}
+
+func (x *SearchSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSecretsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SearchSecretsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchSecretsRequest) GetFolderId() string {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return ""
+}
+
+func (x *SearchSecretsRequest) GetIncludeSubfolders() bool {
+	if x != nil {
+		return x.IncludeSubfolders
+	}
+	return false
+}
+
+func (x *SearchSecretsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *SearchSecretsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchSecretsRequest) GetStatus() SecretStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return SecretStatus_SECRET_STATUS_UNSPECIFIED
+}
+
+func (x *SearchSecretsRequest) GetIdsOnly() bool {
+	if x != nil {
+		return x.IdsOnly
+	}
+	return false
+}
+
+func (x *SearchSecretsRequest) GetCountOnly() bool {
+	if x != nil {
+		return x.CountOnly
+	}
+	return false
+}
+
+type SearchSecretsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Best matches first
+	Secrets []*Secret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Total   uint32    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Matched fields of each returned secret, in the same order as secrets
+	Hits []*SecretSearchHit `protobuf:"bytes,3,rep,name=hits,proto3" json:"hits,omitempty"`
+	// Secret IDs of the page, best matches first, filled instead of secrets with ids_only
+	Ids           []string `protobuf:"bytes,4,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSecretsResponse) Reset() {
+	*x = SearchSecretsResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSecretsResponse) ProtoMessage() {}
+
+func (x *SearchSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSecretsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SearchSecretsResponse) GetSecrets() []*Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *SearchSecretsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchSecretsResponse) GetHits() []*SecretSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchSecretsResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type SecretSearchHit struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SecretId string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	// Matching fields (name, username, host_url, description, tags, metadata.<key>)
+	// with matched terms wrapped in <mark></mark>; long values are trimmed around the first match
+	Highlights    map[string]string `protobuf:"bytes,2,rep,name=highlights,proto3" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretSearchHit) Reset() {
+	*x = SecretSearchHit{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretSearchHit) ProtoMessage() {}
+
+func (x *SecretSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretSearchHit.ProtoReflect.Descriptor instead.
+func (*SecretSearchHit) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SecretSearchHit) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *SecretSearchHit) GetHighlights() map[string]string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type GetSecretTotpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretTotpRequest) Reset() {
+	*x = GetSecretTotpRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretTotpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretTotpRequest) ProtoMessage() {}
+
+func (x *GetSecretTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretTotpRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretTotpRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetSecretTotpRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSecretTotpResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The TOTP URL (otpauth:// URI or base32 secret)
+	TotpUrl string `protobuf:"bytes,1,opt,name=totp_url,json=totpUrl,proto3" json:"totp_url,omitempty"`
+	// Current 6-digit TOTP code
+	CurrentCode string `protobuf:"bytes,2,opt,name=current_code,json=currentCode,proto3" json:"current_code,omitempty"`
+	// Seconds remaining before code rotates
+	RemainingSeconds int32 `protobuf:"varint,3,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	// TOTP period in seconds (typically 30)
+	Period        int32 `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretTotpResponse) Reset() {
+	*x = GetSecretTotpResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretTotpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretTotpResponse) ProtoMessage() {}
+
+func (x *GetSecretTotpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretTotpResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretTotpResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetSecretTotpResponse) GetTotpUrl() string {
+	if x != nil {
+		return x.TotpUrl
+	}
+	return ""
 }
 
-func (x *MoveSecretRequest) GetId() string {
+func (x *GetSecretTotpResponse) GetCurrentCode() string {
 	if x != nil {
-		return x.Id
+		return x.CurrentCode
 	}
 	return ""
 }
 
-func (x *MoveSecretRequest) GetNewFolderId() string {
-	if x != nil && x.NewFolderId != nil {
-		return *x.NewFolderId
+func (x *GetSecretTotpResponse) GetRemainingSeconds() int32 {
+	if x != nil {
+		return x.RemainingSeconds
 	}
-	return ""
+	return 0
 }
 
-type MoveSecretResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+func (x *GetSecretTotpResponse) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+type SetSecretTotpRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// TOTP URL (otpauth:// URI or base32 secret)
+	TotpUrl       string `protobuf:"bytes,2,opt,name=totp_url,json=totpUrl,proto3" json:"totp_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveSecretResponse) Reset() {
-	*x = MoveSecretResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[17]
+func (x *SetSecretTotpRequest) Reset() {
+	*x = SetSecretTotpRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveSecretResponse) String() string {
+func (x *SetSecretTotpRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveSecretResponse) ProtoMessage() {}
+func (*SetSecretTotpRequest) ProtoMessage() {}
 
-func (x *MoveSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[17]
+func (x *SetSecretTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,44 +4256,49 @@ func (x *MoveSecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveSecretResponse.ProtoReflect.Descriptor instead.
-func (*MoveSecretResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SetSecretTotpRequest.ProtoReflect.Descriptor instead.
+func (*SetSecretTotpRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *MoveSecretResponse) GetSecret() *Secret {
+func (x *SetSecretTotpRequest) GetId() string {
 	if x != nil {
-		return x.Secret
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// Request to list versions
-type ListVersionsRequest struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	SecretId string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
-	// Pagination
-	Page          *uint32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize      *uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SetSecretTotpRequest) GetTotpUrl() string {
+	if x != nil {
+		return x.TotpUrl
+	}
+	return ""
 }
 
-func (x *ListVersionsRequest) Reset() {
-	*x = ListVersionsRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[18]
+type SetSecretTotpResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Secret *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Verification code to confirm TOTP was configured correctly
+	VerificationCode string `protobuf:"bytes,2,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetSecretTotpResponse) Reset() {
+	*x = SetSecretTotpResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVersionsRequest) String() string {
+func (x *SetSecretTotpResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVersionsRequest) ProtoMessage() {}
+func (*SetSecretTotpResponse) ProtoMessage() {}
 
-func (x *ListVersionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[18]
+func (x *SetSecretTotpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1344,55 +4309,47 @@ func (x *ListVersionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVersionsRequest.ProtoReflect.Descriptor instead.
-func (*ListVersionsRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SetSecretTotpResponse.ProtoReflect.Descriptor instead.
+func (*SetSecretTotpResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ListVersionsRequest) GetSecretId() string {
+func (x *SetSecretTotpResponse) GetSecret() *Secret {
 	if x != nil {
-		return x.SecretId
-	}
-	return ""
-}
-
-func (x *ListVersionsRequest) GetPage() uint32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
+		return x.Secret
 	}
-	return 0
+	return nil
 }
 
-func (x *ListVersionsRequest) GetPageSize() uint32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
+func (x *SetSecretTotpResponse) GetVerificationCode() string {
+	if x != nil {
+		return x.VerificationCode
 	}
-	return 0
+	return ""
 }
 
-type ListVersionsResponse struct {
+type DeleteSecretTotpRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Versions      []*SecretVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVersionsResponse) Reset() {
-	*x = ListVersionsResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[19]
+func (x *DeleteSecretTotpRequest) Reset() {
+	*x = DeleteSecretTotpRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVersionsResponse) String() string {
+func (x *DeleteSecretTotpRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVersionsResponse) ProtoMessage() {}
+func (*DeleteSecretTotpRequest) ProtoMessage() {}
 
-func (x *ListVersionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[19]
+func (x *DeleteSecretTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,51 +4360,42 @@ func (x *ListVersionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVersionsResponse.ProtoReflect.Descriptor instead.
-func (*ListVersionsResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ListVersionsResponse) GetVersions() []*SecretVersion {
-	if x != nil {
-		return x.Versions
-	}
-	return nil
+// Deprecated: Use DeleteSecretTotpRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretTotpRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ListVersionsResponse) GetTotal() uint32 {
+func (x *DeleteSecretTotpRequest) GetId() string {
 	if x != nil {
-		return x.Total
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-// Request to get a specific version
-type GetVersionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
-	VersionNumber int32                  `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
-	// Include password in response
-	IncludePassword bool `protobuf:"varint,3,opt,name=include_password,json=includePassword,proto3" json:"include_password,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SetSecretAccessPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Replaces the current policy; unset or empty removes it
+	Policy        *AccessPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVersionRequest) Reset() {
-	*x = GetVersionRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[20]
+func (x *SetSecretAccessPolicyRequest) Reset() {
+	*x = SetSecretAccessPolicyRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVersionRequest) String() string {
+func (x *SetSecretAccessPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVersionRequest) ProtoMessage() {}
+func (*SetSecretAccessPolicyRequest) ProtoMessage() {}
 
-func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[20]
+func (x *SetSecretAccessPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,55 +4406,47 @@ func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
-func (*GetVersionRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SetSecretAccessPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetSecretAccessPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetVersionRequest) GetSecretId() string {
+func (x *SetSecretAccessPolicyRequest) GetId() string {
 	if x != nil {
-		return x.SecretId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetVersionRequest) GetVersionNumber() int32 {
-	if x != nil {
-		return x.VersionNumber
-	}
-	return 0
-}
-
-func (x *GetVersionRequest) GetIncludePassword() bool {
+func (x *SetSecretAccessPolicyRequest) GetPolicy() *AccessPolicy {
 	if x != nil {
-		return x.IncludePassword
+		return x.Policy
 	}
-	return false
+	return nil
 }
 
-type GetVersionResponse struct {
+type SetSecretAccessPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       *SecretVersion         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	Password      *string                `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVersionResponse) Reset() {
-	*x = GetVersionResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[21]
+func (x *SetSecretAccessPolicyResponse) Reset() {
+	*x = SetSecretAccessPolicyResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVersionResponse) String() string {
+func (x *SetSecretAccessPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVersionResponse) ProtoMessage() {}
+func (*SetSecretAccessPolicyResponse) ProtoMessage() {}
 
-func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[21]
+func (x *SetSecretAccessPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,51 +4457,43 @@ func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
-func (*GetVersionResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SetSecretAccessPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetSecretAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetVersionResponse) GetVersion() *SecretVersion {
+func (x *SetSecretAccessPolicyResponse) GetSecret() *Secret {
 	if x != nil {
-		return x.Version
+		return x.Secret
 	}
 	return nil
 }
 
-func (x *GetVersionResponse) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
-	}
-	return ""
-}
-
-// Request to restore a version
-type RestoreVersionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
-	VersionNumber int32                  `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
-	// Comment for the new version created by restore
-	Comment       string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+// Request to get the usage of a secret
+type GetSecretUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Length of the period in days, ending today (default 90)
+	Days          *uint32 `protobuf:"varint,2,opt,name=days,proto3,oneof" json:"days,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestoreVersionRequest) Reset() {
-	*x = RestoreVersionRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[22]
+func (x *GetSecretUsageRequest) Reset() {
+	*x = GetSecretUsageRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestoreVersionRequest) String() string {
+func (x *GetSecretUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreVersionRequest) ProtoMessage() {}
+func (*GetSecretUsageRequest) ProtoMessage() {}
 
-func (x *RestoreVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[22]
+func (x *GetSecretUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1572,55 +4504,49 @@ func (x *RestoreVersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreVersionRequest.ProtoReflect.Descriptor instead.
-func (*RestoreVersionRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetSecretUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretUsageRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *RestoreVersionRequest) GetSecretId() string {
+func (x *GetSecretUsageRequest) GetId() string {
 	if x != nil {
-		return x.SecretId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RestoreVersionRequest) GetVersionNumber() int32 {
-	if x != nil {
-		return x.VersionNumber
+func (x *GetSecretUsageRequest) GetDays() uint32 {
+	if x != nil && x.Days != nil {
+		return *x.Days
 	}
 	return 0
 }
 
-func (x *RestoreVersionRequest) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
-}
-
-type RestoreVersionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	NewVersion    *SecretVersion         `protobuf:"bytes,2,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+type GetSecretUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Usage *SecretUsage           `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	// One entry per UTC day, oldest first, days without activity included
+	Daily         []*DailyUsage `protobuf:"bytes,2,rep,name=daily,proto3" json:"daily,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestoreVersionResponse) Reset() {
-	*x = RestoreVersionResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[23]
+func (x *GetSecretUsageResponse) Reset() {
+	*x = GetSecretUsageResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestoreVersionResponse) String() string {
+func (x *GetSecretUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreVersionResponse) ProtoMessage() {}
+func (*GetSecretUsageResponse) ProtoMessage() {}
 
-func (x *RestoreVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[23]
+func (x *GetSecretUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1631,58 +4557,56 @@ func (x *RestoreVersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreVersionResponse.ProtoReflect.Descriptor instead.
-func (*RestoreVersionResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetSecretUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretUsageResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *RestoreVersionResponse) GetSecret() *Secret {
+func (x *GetSecretUsageResponse) GetUsage() *SecretUsage {
 	if x != nil {
-		return x.Secret
+		return x.Usage
 	}
 	return nil
 }
 
-func (x *RestoreVersionResponse) GetNewVersion() *SecretVersion {
+func (x *GetSecretUsageResponse) GetDaily() []*DailyUsage {
 	if x != nil {
-		return x.NewVersion
+		return x.Daily
 	}
 	return nil
 }
 
-// Request to search secrets
-type SearchSecretsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Search query (searches name, username, host_url, description)
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	// Limit search to folder and subfolders (null for all)
-	FolderId *string `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
-	// Include subfolders in search
-	IncludeSubfolders bool `protobuf:"varint,3,opt,name=include_subfolders,json=includeSubfolders,proto3" json:"include_subfolders,omitempty"`
-	// Pagination
-	Page     *uint32 `protobuf:"varint,4,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize *uint32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	// Filter by status
-	Status        *SecretStatus `protobuf:"varint,6,opt,name=status,proto3,enum=warden.service.v1.SecretStatus,oneof" json:"status,omitempty"`
+// Activity of a secret over a period. Reads are password reads; writes are
+// updates, password changes and version restores. Counts are aggregated
+// nightly, so today and the last hours of yesterday are not included yet.
+type SecretUsage struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SecretId string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FolderId *string                `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	Reads    int64                  `protobuf:"varint,4,opt,name=reads,proto3" json:"reads,omitempty"`
+	Writes   int64                  `protobuf:"varint,5,opt,name=writes,proto3" json:"writes,omitempty"`
+	// Last UTC day (YYYY-MM-DD) with any activity in the period, unset when unused
+	LastUsedDate  *string `protobuf:"bytes,6,opt,name=last_used_date,json=lastUsedDate,proto3,oneof" json:"last_used_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchSecretsRequest) Reset() {
-	*x = SearchSecretsRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[24]
+func (x *SecretUsage) Reset() {
+	*x = SecretUsage{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchSecretsRequest) String() string {
+func (x *SecretUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchSecretsRequest) ProtoMessage() {}
+func (*SecretUsage) ProtoMessage() {}
 
-func (x *SearchSecretsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[24]
+func (x *SecretUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1693,76 +4617,78 @@ func (x *SearchSecretsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchSecretsRequest.ProtoReflect.Descriptor instead.
-func (*SearchSecretsRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SecretUsage.ProtoReflect.Descriptor instead.
+func (*SecretUsage) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SearchSecretsRequest) GetQuery() string {
+func (x *SecretUsage) GetSecretId() string {
 	if x != nil {
-		return x.Query
+		return x.SecretId
 	}
 	return ""
 }
 
-func (x *SearchSecretsRequest) GetFolderId() string {
-	if x != nil && x.FolderId != nil {
-		return *x.FolderId
+func (x *SecretUsage) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SearchSecretsRequest) GetIncludeSubfolders() bool {
-	if x != nil {
-		return x.IncludeSubfolders
+func (x *SecretUsage) GetFolderId() string {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
 	}
-	return false
+	return ""
 }
 
-func (x *SearchSecretsRequest) GetPage() uint32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
+func (x *SecretUsage) GetReads() int64 {
+	if x != nil {
+		return x.Reads
 	}
 	return 0
 }
 
-func (x *SearchSecretsRequest) GetPageSize() uint32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
+func (x *SecretUsage) GetWrites() int64 {
+	if x != nil {
+		return x.Writes
 	}
 	return 0
 }
 
-func (x *SearchSecretsRequest) GetStatus() SecretStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *SecretUsage) GetLastUsedDate() string {
+	if x != nil && x.LastUsedDate != nil {
+		return *x.LastUsedDate
 	}
-	return SecretStatus_SECRET_STATUS_UNSPECIFIED
+	return ""
 }
 
-type SearchSecretsResponse struct {
+// Activity of a secret on one UTC day
+type DailyUsage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secrets       []*Secret              `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Reads         int64                  `protobuf:"varint,2,opt,name=reads,proto3" json:"reads,omitempty"`
+	Writes        int64                  `protobuf:"varint,3,opt,name=writes,proto3" json:"writes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchSecretsResponse) Reset() {
-	*x = SearchSecretsResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[25]
+func (x *DailyUsage) Reset() {
+	*x = DailyUsage{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchSecretsResponse) String() string {
+func (x *DailyUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchSecretsResponse) ProtoMessage() {}
+func (*DailyUsage) ProtoMessage() {}
 
-func (x *SearchSecretsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[25]
+func (x *DailyUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1773,47 +4699,56 @@ func (x *SearchSecretsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchSecretsResponse.ProtoReflect.Descriptor instead.
-func (*SearchSecretsResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DailyUsage.ProtoReflect.Descriptor instead.
+func (*DailyUsage) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SearchSecretsResponse) GetSecrets() []*Secret {
+func (x *DailyUsage) GetDate() string {
 	if x != nil {
-		return x.Secrets
+		return x.Date
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchSecretsResponse) GetTotal() uint32 {
+func (x *DailyUsage) GetReads() int64 {
 	if x != nil {
-		return x.Total
+		return x.Reads
 	}
 	return 0
 }
 
-type GetSecretTotpRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *DailyUsage) GetWrites() int64 {
+	if x != nil {
+		return x.Writes
+	}
+	return 0
+}
+
+type GetSecretGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Password reads of the last days to include readers from (default 30)
+	ReaderDays    *uint32 `protobuf:"varint,2,opt,name=reader_days,json=readerDays,proto3,oneof" json:"reader_days,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSecretTotpRequest) Reset() {
-	*x = GetSecretTotpRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[26]
+func (x *GetSecretGraphRequest) Reset() {
+	*x = GetSecretGraphRequest{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSecretTotpRequest) String() string {
+func (x *GetSecretGraphRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSecretTotpRequest) ProtoMessage() {}
+func (*GetSecretGraphRequest) ProtoMessage() {}
 
-func (x *GetSecretTotpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[26]
+func (x *GetSecretGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1824,47 +4759,53 @@ func (x *GetSecretTotpRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSecretTotpRequest.ProtoReflect.Descriptor instead.
-func (*GetSecretTotpRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetSecretGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretGraphRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetSecretTotpRequest) GetId() string {
+func (x *GetSecretGraphRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetSecretTotpResponse struct {
+func (x *GetSecretGraphRequest) GetReaderDays() uint32 {
+	if x != nil && x.ReaderDays != nil {
+		return *x.ReaderDays
+	}
+	return 0
+}
+
+type GetSecretGraphResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The TOTP URL (otpauth:// URI or base32 secret)
-	TotpUrl string `protobuf:"bytes,1,opt,name=totp_url,json=totpUrl,proto3" json:"totp_url,omitempty"`
-	// Current 6-digit TOTP code
-	CurrentCode string `protobuf:"bytes,2,opt,name=current_code,json=currentCode,proto3" json:"current_code,omitempty"`
-	// Seconds remaining before code rotates
-	RemainingSeconds int32 `protobuf:"varint,3,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
-	// TOTP period in seconds (typically 30)
-	Period        int32 `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Node of the requested secret
+	RootNodeId string             `protobuf:"bytes,1,opt,name=root_node_id,json=rootNodeId,proto3" json:"root_node_id,omitempty"`
+	Nodes      []*SecretGraphNode `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges      []*SecretGraphEdge `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Secrets referencing this one that the caller cannot read, so are not
+	// in the graph
+	HiddenDependents uint32 `protobuf:"varint,4,opt,name=hidden_dependents,json=hiddenDependents,proto3" json:"hidden_dependents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetSecretTotpResponse) Reset() {
-	*x = GetSecretTotpResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[27]
+func (x *GetSecretGraphResponse) Reset() {
+	*x = GetSecretGraphResponse{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSecretTotpResponse) String() string {
+func (x *GetSecretGraphResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSecretTotpResponse) ProtoMessage() {}
+func (*GetSecretGraphResponse) ProtoMessage() {}
 
-func (x *GetSecretTotpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[27]
+func (x *GetSecretGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,63 +4816,66 @@ func (x *GetSecretTotpResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSecretTotpResponse.ProtoReflect.Descriptor instead.
-func (*GetSecretTotpResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetSecretGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretGraphResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetSecretTotpResponse) GetTotpUrl() string {
+func (x *GetSecretGraphResponse) GetRootNodeId() string {
 	if x != nil {
-		return x.TotpUrl
+		return x.RootNodeId
 	}
 	return ""
 }
 
-func (x *GetSecretTotpResponse) GetCurrentCode() string {
+func (x *GetSecretGraphResponse) GetNodes() []*SecretGraphNode {
 	if x != nil {
-		return x.CurrentCode
+		return x.Nodes
 	}
-	return ""
+	return nil
 }
 
-func (x *GetSecretTotpResponse) GetRemainingSeconds() int32 {
+func (x *GetSecretGraphResponse) GetEdges() []*SecretGraphEdge {
 	if x != nil {
-		return x.RemainingSeconds
+		return x.Edges
 	}
-	return 0
+	return nil
 }
 
-func (x *GetSecretTotpResponse) GetPeriod() int32 {
+func (x *GetSecretGraphResponse) GetHiddenDependents() uint32 {
 	if x != nil {
-		return x.Period
+		return x.HiddenDependents
 	}
 	return 0
 }
 
-type SetSecretTotpRequest struct {
+type SecretGraphNode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// TOTP URL (otpauth:// URI or base32 secret)
-	TotpUrl       string `protobuf:"bytes,2,opt,name=totp_url,json=totpUrl,proto3" json:"totp_url,omitempty"`
+	// Unique within the graph, "<kind>:<resource or subject id>"
+	Id         string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind       SecretGraphNodeKind `protobuf:"varint,2,opt,name=kind,proto3,enum=warden.service.v1.SecretGraphNodeKind" json:"kind,omitempty"`
+	ResourceId string              `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Name of a secret or collection, path of a folder, ID of a subject
+	Label         string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetSecretTotpRequest) Reset() {
-	*x = SetSecretTotpRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[28]
+func (x *SecretGraphNode) Reset() {
+	*x = SecretGraphNode{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetSecretTotpRequest) String() string {
+func (x *SecretGraphNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSecretTotpRequest) ProtoMessage() {}
+func (*SecretGraphNode) ProtoMessage() {}
 
-func (x *SetSecretTotpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[28]
+func (x *SecretGraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,49 +4886,71 @@ func (x *SetSecretTotpRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSecretTotpRequest.ProtoReflect.Descriptor instead.
-func (*SetSecretTotpRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SecretGraphNode.ProtoReflect.Descriptor instead.
+func (*SecretGraphNode) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *SetSecretTotpRequest) GetId() string {
+func (x *SecretGraphNode) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *SetSecretTotpRequest) GetTotpUrl() string {
+func (x *SecretGraphNode) GetKind() SecretGraphNodeKind {
 	if x != nil {
-		return x.TotpUrl
+		return x.Kind
+	}
+	return SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_UNSPECIFIED
+}
+
+func (x *SecretGraphNode) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
 	}
 	return ""
 }
 
-type SetSecretTotpResponse struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	Secret *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	// Verification code to confirm TOTP was configured correctly
-	VerificationCode string `protobuf:"bytes,2,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *SecretGraphNode) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
 }
 
-func (x *SetSecretTotpResponse) Reset() {
-	*x = SetSecretTotpResponse{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[29]
+type SecretGraphEdge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Kind  SecretGraphEdgeKind    `protobuf:"varint,3,opt,name=kind,proto3,enum=warden.service.v1.SecretGraphEdgeKind" json:"kind,omitempty"`
+	// Relation of a GRANTED edge
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	// Expiry of a GRANTED edge
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	// Number of reads of a READ edge
+	Count int64 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// Last read of a READ edge
+	LastTime      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_time,json=lastTime,proto3,oneof" json:"last_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretGraphEdge) Reset() {
+	*x = SecretGraphEdge{}
+	mi := &file_warden_service_v1_secret_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetSecretTotpResponse) String() string {
+func (x *SecretGraphEdge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSecretTotpResponse) ProtoMessage() {}
+func (*SecretGraphEdge) ProtoMessage() {}
 
-func (x *SetSecretTotpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[29]
+func (x *SecretGraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_secret_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1995,74 +4961,65 @@ func (x *SetSecretTotpResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSecretTotpResponse.ProtoReflect.Descriptor instead.
-func (*SetSecretTotpResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use SecretGraphEdge.ProtoReflect.Descriptor instead.
+func (*SecretGraphEdge) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *SetSecretTotpResponse) GetSecret() *Secret {
+func (x *SecretGraphEdge) GetFrom() string {
 	if x != nil {
-		return x.Secret
+		return x.From
 	}
-	return nil
+	return ""
 }
 
-func (x *SetSecretTotpResponse) GetVerificationCode() string {
+func (x *SecretGraphEdge) GetTo() string {
 	if x != nil {
-		return x.VerificationCode
+		return x.To
 	}
 	return ""
 }
 
-type DeleteSecretTotpRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteSecretTotpRequest) Reset() {
-	*x = DeleteSecretTotpRequest{}
-	mi := &file_warden_service_v1_secret_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SecretGraphEdge) GetKind() SecretGraphEdgeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_UNSPECIFIED
 }
 
-func (x *DeleteSecretTotpRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SecretGraphEdge) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
 }
 
-func (*DeleteSecretTotpRequest) ProtoMessage() {}
-
-func (x *DeleteSecretTotpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_secret_proto_msgTypes[30]
+func (x *SecretGraphEdge) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ExpiresAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteSecretTotpRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSecretTotpRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_secret_proto_rawDescGZIP(), []int{30}
+func (x *SecretGraphEdge) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
-func (x *DeleteSecretTotpRequest) GetId() string {
+func (x *SecretGraphEdge) GetLastTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.LastTime
 	}
-	return ""
+	return nil
 }
 
 var File_warden_service_v1_secret_proto protoreflect.FileDescriptor
 
 const file_warden_service_v1_secret_proto_rawDesc = "" +
 	"\n" +
-	"\x1ewarden/service/v1/secret.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\x1a\"warden/service/v1/permission.proto\"\x85\x05\n" +
+	"\x1ewarden/service/v1/secret.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\x1a\"warden/service/v1/permission.proto\"\xa7\b\n" +
 	"\x06Secret\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12 \n" +
@@ -2085,11 +5042,31 @@ const file_warden_service_v1_secret_proto_rawDesc = "" +
 	"created_by\x18\x0e \x01(\rH\x01R\tcreatedBy\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"updated_by\x18\x0f \x01(\rH\x02R\tupdatedBy\x88\x01\x01\x12\x19\n" +
-	"\bhas_totp\x18\x10 \x01(\bR\ahasTotpB\f\n" +
+	"\bhas_totp\x18\x10 \x01(\bR\ahasTotp\x12M\n" +
+	"\x12last_accessed_time\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x10lastAccessedTime\x88\x01\x01\x12\x1a\n" +
+	"\brevision\x18\x12 \x01(\x03R\brevision\x12\x1c\n" +
+	"\tsensitive\x18\x13 \x01(\bR\tsensitive\x12P\n" +
+	"\x11password_encoding\x18\x14 \x01(\x0e2#.warden.service.v1.PasswordEncodingR\x10passwordEncoding\x12D\n" +
+	"\raccess_policy\x18\x15 \x01(\v2\x1f.warden.service.v1.AccessPolicyR\faccessPolicy\x12\x16\n" +
+	"\x06canary\x18\x16 \x01(\bR\x06canary\x12\x1c\n" +
+	"\tprotected\x18\x17 \x01(\bR\tprotected\x122\n" +
+	"\x15referenced_secret_ids\x18\x18 \x03(\tR\x13referencedSecretIdsB\f\n" +
 	"\n" +
 	"_folder_idB\r\n" +
 	"\v_created_byB\r\n" +
-	"\v_updated_by\"\x89\x02\n" +
+	"\v_updated_byB\x15\n" +
+	"\x13_last_accessed_time\"\xdc\x01\n" +
+	"\fAccessPolicy\x12-\n" +
+	"\rallowed_cidrs\x18\x01 \x03(\tB\b\xbaH\x05\x92\x01\x02\x102R\fallowedCidrs\x12,\n" +
+	"\bweekdays\x18\x02 \x03(\x05B\x10\xbaH\r\x92\x01\n" +
+	"\x10\a\"\x06\x1a\x04\x18\x06(\x00R\bweekdays\x12J\n" +
+	"\ftime_windows\x18\x03 \x03(\v2\x1d.warden.service.v1.TimeWindowB\b\xbaH\x05\x92\x01\x02\x10\n" +
+	"R\vtimeWindows\x12#\n" +
+	"\btimezone\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18@R\btimezone\"\x84\x01\n" +
+	"\n" +
+	"TimeWindow\x12<\n" +
+	"\x05start\x18\x01 \x01(\tB&\xbaH#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x05start\x128\n" +
+	"\x03end\x18\x02 \x01(\tB&\xbaH#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x03end\"\xd3\x03\n" +
 	"\rSecretVersion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
 	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\x12%\n" +
@@ -2099,58 +5076,195 @@ const file_warden_service_v1_secret_proto_rawDesc = "" +
 	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"createTime\x12\"\n" +
 	"\n" +
-	"created_by\x18\a \x01(\rH\x00R\tcreatedBy\x88\x01\x01B\r\n" +
-	"\v_created_by\"\xb3\x01\n" +
+	"created_by\x18\a \x01(\rH\x00R\tcreatedBy\x88\x01\x01\x12*\n" +
+	"\x0esource_version\x18\b \x01(\x05H\x01R\rsourceVersion\x88\x01\x01\x12J\n" +
+	"\vvault_state\x18\t \x01(\v2$.warden.service.v1.VaultVersionStateH\x02R\n" +
+	"vaultState\x88\x01\x01\x12-\n" +
+	"\x12checksum_algorithm\x18\n" +
+	" \x01(\tR\x11checksumAlgorithmB\r\n" +
+	"\v_created_byB\x11\n" +
+	"\x0f_source_versionB\x0e\n" +
+	"\f_vault_state\"\xb1\x02\n" +
+	"\x11VaultVersionState\x12 \n" +
+	"\vretrievable\x18\x01 \x01(\bR\vretrievable\x12\x18\n" +
+	"\adeleted\x18\x02 \x01(\bR\adeleted\x12\x1c\n" +
+	"\tdestroyed\x18\x03 \x01(\bR\tdestroyed\x12\x18\n" +
+	"\amissing\x18\x04 \x01(\bR\amissing\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"createTime\x88\x01\x01\x12D\n" +
+	"\rdeletion_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\fdeletionTime\x88\x01\x01B\x0e\n" +
+	"\f_create_timeB\x10\n" +
+	"\x0e_deletion_time\"\xb3\x01\n" +
 	"\x16InitialPermissionGrant\x12A\n" +
 	"\fsubject_type\x18\x01 \x01(\x0e2\x1e.warden.service.v1.SubjectTypeR\vsubjectType\x12\x1d\n" +
 	"\n" +
 	"subject_id\x18\x02 \x01(\tR\tsubjectId\x127\n" +
-	"\brelation\x18\x03 \x01(\x0e2\x1b.warden.service.v1.RelationR\brelation\"\xbd\x04\n" +
+	"\brelation\x18\x03 \x01(\x0e2\x1b.warden.service.v1.RelationR\brelation\"\xb7\x05\n" +
 	"\x13CreateSecretRequest\x12;\n" +
 	"\tfolder_id\x18\x01 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\bfolderId\x88\x01\x01\x12C\n" +
 	"\x04name\x18\x02 \x01(\tB/\xe0A\x02\xbaH)r'\x10\x01\x18\xff\x012 ^[a-zA-Z0-9][a-zA-Z0-9\\-_\\.\\s]*$R\x04name\x12$\n" +
-	"\busername\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\busername\x120\n" +
-	"\bpassword\x18\x04 \x01(\tB\x14\xe0A\x02\xbaH\br\x06\x10\x01\x18\x80\x80\x04ڶ\x1a\x02z\x00R\bpassword\x12#\n" +
+	"\busername\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\busername\x123\n" +
+	"\bpassword\x18\x04 \x01(\tB\x17\xe0A\x02\xbaH\br\x06\x10\x01\x18\x80\x80@ڶ\x1a\x02z\x00\x80\x01\x01R\bpassword\x12#\n" +
 	"\bhost_url\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\ahostUrl\x12*\n" +
 	"\vdescription\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\vdescription\x123\n" +
 	"\bmetadata\x18\a \x01(\v2\x17.google.protobuf.StructR\bmetadata\x121\n" +
 	"\x0fversion_comment\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\x0eversionComment\x12Z\n" +
-	"\x13initial_permissions\x18\t \x03(\v2).warden.service.v1.InitialPermissionGrantR\x12initialPermissions\x12)\n" +
+	"\x13initial_permissions\x18\t \x03(\v2).warden.service.v1.InitialPermissionGrantR\x12initialPermissions\x12,\n" +
 	"\btotp_url\x18\n" +
-	" \x01(\tB\x0e\xbaH\x05r\x03\x18\x80\bڶ\x1a\x02z\x00R\atotpUrlB\f\n" +
+	" \x01(\tB\x11\xbaH\x05r\x03\x18\x80\bڶ\x1a\x02z\x00\x80\x01\x01R\atotpUrl\x12Z\n" +
+	"\x11password_encoding\x18\v \x01(\x0e2#.warden.service.v1.PasswordEncodingB\b\xbaH\x05\x82\x01\x02\x10\x01R\x10passwordEncoding\x12\x16\n" +
+	"\x06canary\x18\f \x01(\bR\x06canaryB\f\n" +
 	"\n" +
 	"_folder_id\"I\n" +
 	"\x14CreateSecretResponse\x121\n" +
-	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\"B\n" +
+	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\"q\n" +
 	"\x10GetSecretRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"F\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12-\n" +
+	"\x12resolve_references\x18\x02 \x01(\bR\x11resolveReferences\"F\n" +
 	"\x11GetSecretResponse\x121\n" +
-	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\"u\n" +
+	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\"\xc9\x01\n" +
 	"\x18GetSecretPasswordRequest\x12.\n" +
 	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\x05H\x00R\aversion\x88\x01\x01\x12\x10\n" +
+	"\x03pin\x18\x03 \x01(\bR\x03pin\x122\n" +
+	"\tpin_token\x18\x04 \x01(\tB\x10\xbaH\x04r\x02\x18@ڶ\x1a\x02z\x00\x80\x01\x01H\x01R\bpinToken\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_pin_token\"\x96\x02\n" +
+	"\x19GetSecretPasswordResponse\x12%\n" +
+	"\bpassword\x18\x01 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\bpassword\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12?\n" +
+	"\bencoding\x18\x03 \x01(\x0e2#.warden.service.v1.PasswordEncodingR\bencoding\x124\n" +
+	"\x03pin\x18\x04 \x01(\v2\x1d.warden.service.v1.VersionPinH\x00R\x03pin\x88\x01\x01\x12+\n" +
+	"\tpin_token\x18\x05 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01H\x01R\bpinToken\x88\x01\x01B\x06\n" +
+	"\x04_pinB\f\n" +
+	"\n" +
+	"_pin_token\"\xe7\x02\n" +
+	"\n" +
+	"VersionPin\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\x12%\n" +
+	"\x0eversion_number\x18\x03 \x01(\x05R\rversionNumber\x12\x1a\n" +
+	"\bconsumer\x18\x04 \x01(\tR\bconsumer\x12'\n" +
+	"\x0fcurrent_version\x18\x05 \x01(\x05R\x0ecurrentVersion\x12\x14\n" +
+	"\x05stale\x18\x06 \x01(\bR\x05stale\x12E\n" +
+	"\x0elast_used_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\flastUsedTime\x88\x01\x01\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"createTime\x88\x01\x01B\x11\n" +
+	"\x0f_last_used_timeB\x0e\n" +
+	"\f_create_time\"{\n" +
+	"\x1eGetSecretPasswordMaskedRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12\x1d\n" +
 	"\aversion\x18\x02 \x01(\x05H\x00R\aversion\x88\x01\x01B\n" +
 	"\n" +
-	"\b_version\"Y\n" +
-	"\x19GetSecretPasswordResponse\x12\"\n" +
-	"\bpassword\x18\x01 \x01(\tB\x06ڶ\x1a\x02z\x00R\bpassword\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\x05R\aversion\"\xb0\x02\n" +
+	"\b_version\"\xa8\x02\n" +
+	"\x1fGetSecretPasswordMaskedResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x05R\x06length\x12\x1d\n" +
+	"\n" +
+	"first_char\x18\x02 \x01(\tR\tfirstChar\x12\x1b\n" +
+	"\tlast_char\x18\x03 \x01(\tR\blastChar\x12'\n" +
+	"\x0fchecksum_prefix\x18\x04 \x01(\tR\x0echecksumPrefix\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x12?\n" +
+	"\bencoding\x18\x06 \x01(\x0e2#.warden.service.v1.PasswordEncodingR\bencoding\x12-\n" +
+	"\x12checksum_algorithm\x18\a \x01(\tR\x11checksumAlgorithm\"\xba\x01\n" +
+	"\x1bCreateRetrievalTokenRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\x05H\x00R\aversion\x88\x01\x01\x120\n" +
+	"\vttl_seconds\x18\x03 \x01(\rB\n" +
+	"\xbaH\a*\x05\x18\xac\x02(\x05H\x01R\n" +
+	"ttlSeconds\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\x0e\n" +
+	"\f_ttl_seconds\"\x94\x01\n" +
+	"\x1cCreateRetrievalTokenResponse\x12\x1f\n" +
+	"\x05token\x18\x01 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\x05token\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"K\n" +
+	"\x1bRedeemRetrievalTokenRequest\x12,\n" +
+	"\x05token\x18\x01 \x01(\tB\x16\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\x01ڶ\x1a\x02z\x00\x80\x01\x01R\x05token\"\xbd\x01\n" +
+	"\x1cRedeemRetrievalTokenResponse\x12\x1b\n" +
+	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12%\n" +
+	"\bpassword\x18\x02 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\bpassword\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12?\n" +
+	"\bencoding\x18\x04 \x01(\x0e2#.warden.service.v1.PasswordEncodingR\bencoding\"\xc4\x01\n" +
+	"\x16GetSecretByPathRequest\x12)\n" +
+	"\vfolder_path\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\n" +
+	"folderPath\x12!\n" +
+	"\x04name\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12-\n" +
+	"\rmetadata_keys\x18\x03 \x03(\tB\b\xbaH\x05\x92\x01\x02\x102R\fmetadataKeys\x12-\n" +
+	"\x12resolve_references\x18\x04 \x01(\bR\x11resolveReferences\"\xf1\x02\n" +
+	"\x17GetSecretByPathResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\bpassword\x18\x02 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\bpassword\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x19\n" +
+	"\bhost_url\x18\x05 \x01(\tR\ahostUrl\x12T\n" +
+	"\bmetadata\x18\x06 \x03(\v28.warden.service.v1.GetSecretByPathResponse.MetadataEntryR\bmetadata\x12;\n" +
+	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa4\x01\n" +
+	"\x19FetchSecretsStreamRequest\x12'\n" +
+	"\x05paths\x18\x01 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10d\"\ar\x05\x10\x01\x18\xff!R\x05paths\x12-\n" +
+	"\rmetadata_keys\x18\x02 \x03(\tB\b\xbaH\x05\x92\x01\x02\x102R\fmetadataKeys\x12/\n" +
+	"\x0elabel_selector\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\rlabelSelector\"\x8d\x03\n" +
+	"\x0eStreamedSecret\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\amissing\x18\x02 \x01(\bR\amissing\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12%\n" +
+	"\bpassword\x18\x04 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\bpassword\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x12\x1a\n" +
+	"\busername\x18\x06 \x01(\tR\busername\x12\x19\n" +
+	"\bhost_url\x18\a \x01(\tR\ahostUrl\x12K\n" +
+	"\bmetadata\x18\b \x03(\v2/.warden.service.v1.StreamedSecret.MetadataEntryR\bmetadata\x12;\n" +
+	"\vupdate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"s\n" +
+	"\x1aFetchSecretsStreamResponse\x12\x18\n" +
+	"\ainitial\x18\x01 \x01(\bR\ainitial\x12;\n" +
+	"\asecrets\x18\x02 \x03(\v2!.warden.service.v1.StreamedSecretR\asecrets\"\x91\x06\n" +
 	"\x12ListSecretsRequest\x12;\n" +
 	"\tfolder_id\x18\x01 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\bfolderId\x88\x01\x01\x12\x17\n" +
 	"\x04page\x18\x02 \x01(\rH\x01R\x04page\x88\x01\x01\x12 \n" +
 	"\tpage_size\x18\x03 \x01(\rH\x02R\bpageSize\x88\x01\x01\x12<\n" +
 	"\x06status\x18\x04 \x01(\x0e2\x1f.warden.service.v1.SecretStatusH\x03R\x06status\x88\x01\x01\x12$\n" +
 	"\vname_filter\x18\x05 \x01(\tH\x04R\n" +
-	"nameFilter\x88\x01\x01B\f\n" +
+	"nameFilter\x88\x01\x01\x12%\n" +
+	"\x06cursor\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04H\x05R\x06cursor\x88\x01\x01\x12>\n" +
+	"\asort_by\x18\a \x01(\x0e2 .warden.service.v1.ListSortFieldH\x06R\x06sortBy\x88\x01\x01\x12@\n" +
+	"\n" +
+	"sort_order\x18\b \x01(\x0e2\x1c.warden.service.v1.SortOrderH\aR\tsortOrder\x88\x01\x01\x12M\n" +
+	"\x12not_accessed_since\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\bR\x10notAccessedSince\x88\x01\x01\x12'\n" +
+	"\x0faccessible_only\x18\n" +
+	" \x01(\bR\x0eaccessibleOnly\x12\x19\n" +
+	"\bids_only\x18\v \x01(\bR\aidsOnly\x12\x1d\n" +
+	"\n" +
+	"count_only\x18\f \x01(\bR\tcountOnly\x124\n" +
+	"\x0elabel_selector\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\tR\rlabelSelector\x88\x01\x01B\f\n" +
 	"\n" +
 	"_folder_idB\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
 	"_page_sizeB\t\n" +
 	"\a_statusB\x0e\n" +
-	"\f_name_filter\"`\n" +
+	"\f_name_filterB\t\n" +
+	"\a_cursorB\n" +
+	"\n" +
+	"\b_sort_byB\r\n" +
+	"\v_sort_orderB\x15\n" +
+	"\x13_not_accessed_sinceB\x11\n" +
+	"\x0f_label_selector\"\x93\x01\n" +
 	"\x13ListSecretsResponse\x123\n" +
 	"\asecrets\x18\x01 \x03(\v2\x19.warden.service.v1.SecretR\asecrets\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\xd5\x03\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x10\n" +
+	"\x03ids\x18\x04 \x03(\tR\x03ids\"\xa7\x05\n" +
 	"\x13UpdateSecretRequest\x12.\n" +
 	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12E\n" +
 	"\x04name\x18\x02 \x01(\tB,\xbaH)r'\x10\x01\x18\xff\x012 ^[a-zA-Z0-9][a-zA-Z0-9\\-_\\.\\s]*$H\x00R\x04name\x88\x01\x01\x12)\n" +
@@ -2158,49 +5272,74 @@ const file_warden_service_v1_secret_proto_rawDesc = "" +
 	"\bhost_url\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10H\x02R\ahostUrl\x88\x01\x01\x12/\n" +
 	"\vdescription\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x03R\vdescription\x88\x01\x01\x128\n" +
 	"\bmetadata\x18\x06 \x01(\v2\x17.google.protobuf.StructH\x04R\bmetadata\x88\x01\x01\x12<\n" +
-	"\x06status\x18\a \x01(\x0e2\x1f.warden.service.v1.SecretStatusH\x05R\x06status\x88\x01\x01B\a\n" +
+	"\x06status\x18\a \x01(\x0e2\x1f.warden.service.v1.SecretStatusH\x05R\x06status\x88\x01\x01\x120\n" +
+	"\x11expected_revision\x18\b \x01(\x03H\x06R\x10expectedRevision\x88\x01\x01\x12!\n" +
+	"\tsensitive\x18\t \x01(\bH\aR\tsensitive\x88\x01\x01\x12\x1b\n" +
+	"\x06canary\x18\n" +
+	" \x01(\bH\bR\x06canary\x88\x01\x01\x12!\n" +
+	"\tprotected\x18\v \x01(\bH\tR\tprotected\x88\x01\x01B\a\n" +
 	"\x05_nameB\v\n" +
 	"\t_usernameB\v\n" +
 	"\t_host_urlB\x0e\n" +
 	"\f_descriptionB\v\n" +
 	"\t_metadataB\t\n" +
-	"\a_status\"I\n" +
+	"\a_statusB\x14\n" +
+	"\x12_expected_revisionB\f\n" +
+	"\n" +
+	"_sensitiveB\t\n" +
+	"\a_canaryB\f\n" +
+	"\n" +
+	"_protected\"I\n" +
 	"\x14UpdateSecretResponse\x121\n" +
-	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\"\xa3\x01\n" +
+	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\"\x82\x02\n" +
 	"\x1bUpdateSecretPasswordRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x120\n" +
-	"\bpassword\x18\x02 \x01(\tB\x14\xe0A\x02\xbaH\br\x06\x10\x01\x18\x80\x80\x04ڶ\x1a\x02z\x00R\bpassword\x12\"\n" +
-	"\acomment\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\acomment\"\x8d\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x123\n" +
+	"\bpassword\x18\x02 \x01(\tB\x17\xe0A\x02\xbaH\br\x06\x10\x01\x18\x80\x80@ڶ\x1a\x02z\x00\x80\x01\x01R\bpassword\x12\"\n" +
+	"\acomment\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\acomment\x12Z\n" +
+	"\x11password_encoding\x18\x04 \x01(\x0e2#.warden.service.v1.PasswordEncodingB\b\xbaH\x05\x82\x01\x02\x10\x01R\x10passwordEncoding\"\xcb\x01\n" +
 	"\x1cUpdateSecretPasswordResponse\x121\n" +
 	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\x12:\n" +
-	"\aversion\x18\x02 \x01(\v2 .warden.service.v1.SecretVersionR\aversion\"c\n" +
+	"\aversion\x18\x02 \x01(\v2 .warden.service.v1.SecretVersionR\aversion\x12<\n" +
+	"\n" +
+	"stale_pins\x18\x03 \x03(\v2\x1d.warden.service.v1.VersionPinR\tstalePins\"y\n" +
 	"\x13DeleteSecretRequest\x12.\n" +
 	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12\x1c\n" +
-	"\tpermanent\x18\x02 \x01(\bR\tpermanent\"\x99\x01\n" +
+	"\tpermanent\x18\x02 \x01(\bR\tpermanent\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\x99\x01\n" +
 	"\x11MoveSecretRequest\x12.\n" +
 	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12B\n" +
 	"\rnew_folder_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\vnewFolderId\x88\x01\x01B\x10\n" +
 	"\x0e_new_folder_id\"G\n" +
 	"\x12MoveSecretResponse\x121\n" +
-	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\"\xa4\x01\n" +
+	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\"\xa4\x03\n" +
 	"\x13ListVersionsRequest\x12;\n" +
 	"\tsecret_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\bsecretId\x12\x17\n" +
 	"\x04page\x18\x02 \x01(\rH\x00R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x03 \x01(\rH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\tpage_size\x18\x03 \x01(\rH\x01R\bpageSize\x88\x01\x01\x12%\n" +
+	"\x06cursor\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04H\x02R\x06cursor\x88\x01\x01\x12(\n" +
+	"\rrestored_only\x18\x05 \x01(\bH\x03R\frestoredOnly\x88\x01\x01\x123\n" +
+	"\x13include_vault_state\x18\x06 \x01(\bH\x04R\x11includeVaultState\x88\x01\x01\x12.\n" +
+	"\x10retrievable_only\x18\a \x01(\bH\x05R\x0fretrievableOnly\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
-	"_page_size\"j\n" +
+	"_page_sizeB\t\n" +
+	"\a_cursorB\x10\n" +
+	"\x0e_restored_onlyB\x16\n" +
+	"\x14_include_vault_stateB\x13\n" +
+	"\x11_retrievable_only\"\x8b\x01\n" +
 	"\x14ListVersionsResponse\x12<\n" +
 	"\bversions\x18\x01 \x03(\v2 .warden.service.v1.SecretVersionR\bversions\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\xae\x01\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xae\x01\n" +
 	"\x11GetVersionRequest\x12;\n" +
 	"\tsecret_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\bsecretId\x121\n" +
 	"\x0eversion_number\x18\x02 \x01(\x05B\n" +
 	"\xe0A\x02\xbaH\x04\x1a\x02(\x01R\rversionNumber\x12)\n" +
-	"\x10include_password\x18\x03 \x01(\bR\x0fincludePassword\"\x86\x01\n" +
+	"\x10include_password\x18\x03 \x01(\bR\x0fincludePassword\"\x89\x01\n" +
 	"\x12GetVersionResponse\x12:\n" +
-	"\aversion\x18\x01 \x01(\v2 .warden.service.v1.SecretVersionR\aversion\x12'\n" +
-	"\bpassword\x18\x02 \x01(\tB\x06ڶ\x1a\x02z\x00H\x00R\bpassword\x88\x01\x01B\v\n" +
+	"\aversion\x18\x01 \x01(\v2 .warden.service.v1.SecretVersionR\aversion\x12*\n" +
+	"\bpassword\x18\x02 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01H\x00R\bpassword\x88\x01\x01B\v\n" +
 	"\t_password\"\xab\x01\n" +
 	"\x15RestoreVersionRequest\x12;\n" +
 	"\tsecret_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\bsecretId\x121\n" +
@@ -2210,47 +5349,180 @@ const file_warden_service_v1_secret_proto_rawDesc = "" +
 	"\x16RestoreVersionResponse\x121\n" +
 	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\x12A\n" +
 	"\vnew_version\x18\x02 \x01(\v2 .warden.service.v1.SecretVersionR\n" +
-	"newVersion\"\xd0\x02\n" +
+	"newVersion\"\x86\x01\n" +
+	"\x14DeleteVersionRequest\x12;\n" +
+	"\tsecret_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\bsecretId\x121\n" +
+	"\x0eversion_number\x18\x02 \x01(\x05B\n" +
+	"\xe0A\x02\xbaH\x04\x1a\x02(\x01R\rversionNumber\"S\n" +
+	"\x15DeleteVersionResponse\x12:\n" +
+	"\aversion\x18\x01 \x01(\v2 .warden.service.v1.SecretVersionR\aversion\"\x87\x01\n" +
+	"\x15DestroyVersionRequest\x12;\n" +
+	"\tsecret_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\bsecretId\x121\n" +
+	"\x0eversion_number\x18\x02 \x01(\x05B\n" +
+	"\xe0A\x02\xbaH\x04\x1a\x02(\x01R\rversionNumber\"T\n" +
+	"\x16DestroyVersionResponse\x12:\n" +
+	"\aversion\x18\x01 \x01(\v2 .warden.service.v1.SecretVersionR\aversion\"\x88\x01\n" +
+	"\x16UndeleteVersionRequest\x12;\n" +
+	"\tsecret_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\bsecretId\x121\n" +
+	"\x0eversion_number\x18\x02 \x01(\x05B\n" +
+	"\xe0A\x02\xbaH\x04\x1a\x02(\x01R\rversionNumber\"U\n" +
+	"\x17UndeleteVersionResponse\x12:\n" +
+	"\aversion\x18\x01 \x01(\v2 .warden.service.v1.SecretVersionR\aversion\"t\n" +
+	"\x16ListVersionPinsRequest\x12;\n" +
+	"\tsecret_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\bsecretId\x12\x1d\n" +
+	"\n" +
+	"stale_only\x18\x02 \x01(\bR\tstaleOnly\"L\n" +
+	"\x17ListVersionPinsResponse\x121\n" +
+	"\x04pins\x18\x01 \x03(\v2\x1d.warden.service.v1.VersionPinR\x04pins\"y\n" +
+	"\x17DeleteVersionPinRequest\x12;\n" +
+	"\tsecret_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\bsecretId\x12!\n" +
+	"\x06pin_id\x18\x02 \x01(\rB\n" +
+	"\xe0A\x02\xbaH\x04*\x02 \x00R\x05pinId\"\x8a\x03\n" +
 	"\x14SearchSecretsRequest\x12#\n" +
 	"\x05query\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05query\x12;\n" +
 	"\tfolder_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\bfolderId\x88\x01\x01\x12-\n" +
 	"\x12include_subfolders\x18\x03 \x01(\bR\x11includeSubfolders\x12\x17\n" +
 	"\x04page\x18\x04 \x01(\rH\x01R\x04page\x88\x01\x01\x12 \n" +
 	"\tpage_size\x18\x05 \x01(\rH\x02R\bpageSize\x88\x01\x01\x12<\n" +
-	"\x06status\x18\x06 \x01(\x0e2\x1f.warden.service.v1.SecretStatusH\x03R\x06status\x88\x01\x01B\f\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1f.warden.service.v1.SecretStatusH\x03R\x06status\x88\x01\x01\x12\x19\n" +
+	"\bids_only\x18\a \x01(\bR\aidsOnly\x12\x1d\n" +
+	"\n" +
+	"count_only\x18\b \x01(\bR\tcountOnlyB\f\n" +
 	"\n" +
 	"_folder_idB\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
 	"_page_sizeB\t\n" +
-	"\a_status\"b\n" +
+	"\a_status\"\xac\x01\n" +
 	"\x15SearchSecretsResponse\x123\n" +
 	"\asecrets\x18\x01 \x03(\v2\x19.warden.service.v1.SecretR\asecrets\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"F\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x126\n" +
+	"\x04hits\x18\x03 \x03(\v2\".warden.service.v1.SecretSearchHitR\x04hits\x12\x10\n" +
+	"\x03ids\x18\x04 \x03(\tR\x03ids\"\xc1\x01\n" +
+	"\x0fSecretSearchHit\x12\x1b\n" +
+	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12R\n" +
+	"\n" +
+	"highlights\x18\x02 \x03(\v22.warden.service.v1.SecretSearchHit.HighlightsEntryR\n" +
+	"highlights\x1a=\n" +
+	"\x0fHighlightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
 	"\x14GetSecretTotpRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"\xa2\x01\n" +
-	"\x15GetSecretTotpResponse\x12!\n" +
-	"\btotp_url\x18\x01 \x01(\tB\x06ڶ\x1a\x02z\x00R\atotpUrl\x12!\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"\xa5\x01\n" +
+	"\x15GetSecretTotpResponse\x12$\n" +
+	"\btotp_url\x18\x01 \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\atotpUrl\x12!\n" +
 	"\fcurrent_code\x18\x02 \x01(\tR\vcurrentCode\x12+\n" +
 	"\x11remaining_seconds\x18\x03 \x01(\x05R\x10remainingSeconds\x12\x16\n" +
-	"\x06period\x18\x04 \x01(\x05R\x06period\"v\n" +
+	"\x06period\x18\x04 \x01(\x05R\x06period\"y\n" +
 	"\x14SetSecretTotpRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12.\n" +
-	"\btotp_url\x18\x02 \x01(\tB\x13\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\bڶ\x1a\x02z\x00R\atotpUrl\"w\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x121\n" +
+	"\btotp_url\x18\x02 \x01(\tB\x16\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\bڶ\x1a\x02z\x00\x80\x01\x01R\atotpUrl\"w\n" +
 	"\x15SetSecretTotpResponse\x121\n" +
 	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\x12+\n" +
 	"\x11verification_code\x18\x02 \x01(\tR\x10verificationCode\"I\n" +
 	"\x17DeleteSecretTotpRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id*~\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"\x87\x01\n" +
+	"\x1cSetSecretAccessPolicyRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x127\n" +
+	"\x06policy\x18\x02 \x01(\v2\x1f.warden.service.v1.AccessPolicyR\x06policy\"R\n" +
+	"\x1dSetSecretAccessPolicyResponse\x121\n" +
+	"\x06secret\x18\x01 \x01(\v2\x19.warden.service.v1.SecretR\x06secret\"u\n" +
+	"\x15GetSecretUsageRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12#\n" +
+	"\x04days\x18\x02 \x01(\rB\n" +
+	"\xbaH\a*\x05\x18\xed\x02(\x01H\x00R\x04days\x88\x01\x01B\a\n" +
+	"\x05_days\"\x83\x01\n" +
+	"\x16GetSecretUsageResponse\x124\n" +
+	"\x05usage\x18\x01 \x01(\v2\x1e.warden.service.v1.SecretUsageR\x05usage\x123\n" +
+	"\x05daily\x18\x02 \x03(\v2\x1d.warden.service.v1.DailyUsageR\x05daily\"\xda\x01\n" +
+	"\vSecretUsage\x12\x1b\n" +
+	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\tfolder_id\x18\x03 \x01(\tH\x00R\bfolderId\x88\x01\x01\x12\x14\n" +
+	"\x05reads\x18\x04 \x01(\x03R\x05reads\x12\x16\n" +
+	"\x06writes\x18\x05 \x01(\x03R\x06writes\x12)\n" +
+	"\x0elast_used_date\x18\x06 \x01(\tH\x01R\flastUsedDate\x88\x01\x01B\f\n" +
+	"\n" +
+	"_folder_idB\x11\n" +
+	"\x0f_last_used_date\"N\n" +
+	"\n" +
+	"DailyUsage\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05reads\x18\x02 \x01(\x03R\x05reads\x12\x16\n" +
+	"\x06writes\x18\x03 \x01(\x03R\x06writes\"\x89\x01\n" +
+	"\x15GetSecretGraphRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x120\n" +
+	"\vreader_days\x18\x02 \x01(\rB\n" +
+	"\xbaH\a*\x05\x18\xed\x02(\x01H\x00R\n" +
+	"readerDays\x88\x01\x01B\x0e\n" +
+	"\f_reader_days\"\xdb\x01\n" +
+	"\x16GetSecretGraphResponse\x12 \n" +
+	"\froot_node_id\x18\x01 \x01(\tR\n" +
+	"rootNodeId\x128\n" +
+	"\x05nodes\x18\x02 \x03(\v2\".warden.service.v1.SecretGraphNodeR\x05nodes\x128\n" +
+	"\x05edges\x18\x03 \x03(\v2\".warden.service.v1.SecretGraphEdgeR\x05edges\x12+\n" +
+	"\x11hidden_dependents\x18\x04 \x01(\rR\x10hiddenDependents\"\x94\x01\n" +
+	"\x0fSecretGraphNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12:\n" +
+	"\x04kind\x18\x02 \x01(\x0e2&.warden.service.v1.SecretGraphNodeKindR\x04kind\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\tR\n" +
+	"resourceId\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\"\xb8\x02\n" +
+	"\x0fSecretGraphEdge\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12:\n" +
+	"\x04kind\x18\x03 \x01(\x0e2&.warden.service.v1.SecretGraphEdgeKindR\x04kind\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12>\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x03R\x05count\x12<\n" +
+	"\tlast_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\blastTime\x88\x01\x01B\r\n" +
+	"\v_expires_atB\f\n" +
+	"\n" +
+	"_last_time*~\n" +
 	"\fSecretStatus\x12\x1d\n" +
 	"\x19SECRET_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14SECRET_STATUS_ACTIVE\x10\x01\x12\x1a\n" +
 	"\x16SECRET_STATUS_ARCHIVED\x10\x02\x12\x19\n" +
-	"\x15SECRET_STATUS_DELETED\x10\x032\xdc\x0f\n" +
+	"\x15SECRET_STATUS_DELETED\x10\x03*\xaf\x01\n" +
+	"\rListSortField\x12\x1f\n" +
+	"\x1bLIST_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14LIST_SORT_FIELD_NAME\x10\x01\x12\x1f\n" +
+	"\x1bLIST_SORT_FIELD_CREATE_TIME\x10\x02\x12\x1f\n" +
+	"\x1bLIST_SORT_FIELD_UPDATE_TIME\x10\x03\x12!\n" +
+	"\x1dLIST_SORT_FIELD_LAST_ACCESSED\x10\x04*P\n" +
+	"\tSortOrder\x12\x1a\n" +
+	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x02*o\n" +
+	"\x10PasswordEncoding\x12!\n" +
+	"\x1dPASSWORD_ENCODING_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PASSWORD_ENCODING_TEXT\x10\x01\x12\x1c\n" +
+	"\x18PASSWORD_ENCODING_BASE64\x10\x02*\x8f\x02\n" +
+	"\x13SecretGraphNodeKind\x12&\n" +
+	"\"SECRET_GRAPH_NODE_KIND_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dSECRET_GRAPH_NODE_KIND_SECRET\x10\x01\x12!\n" +
+	"\x1dSECRET_GRAPH_NODE_KIND_FOLDER\x10\x02\x12%\n" +
+	"!SECRET_GRAPH_NODE_KIND_COLLECTION\x10\x03\x12\x1f\n" +
+	"\x1bSECRET_GRAPH_NODE_KIND_USER\x10\x04\x12\x1f\n" +
+	"\x1bSECRET_GRAPH_NODE_KIND_ROLE\x10\x05\x12!\n" +
+	"\x1dSECRET_GRAPH_NODE_KIND_TENANT\x10\x06*\xf9\x01\n" +
+	"\x13SecretGraphEdgeKind\x12&\n" +
+	"\"SECRET_GRAPH_EDGE_KIND_UNSPECIFIED\x10\x00\x12$\n" +
+	" SECRET_GRAPH_EDGE_KIND_IN_FOLDER\x10\x01\x12(\n" +
+	"$SECRET_GRAPH_EDGE_KIND_IN_COLLECTION\x10\x02\x12%\n" +
+	"!SECRET_GRAPH_EDGE_KIND_REFERENCES\x10\x03\x12\"\n" +
+	"\x1eSECRET_GRAPH_EDGE_KIND_GRANTED\x10\x04\x12\x1f\n" +
+	"\x1bSECRET_GRAPH_EDGE_KIND_READ\x10\x052\xa2\x1f\n" +
 	"\x13WardenSecretService\x12w\n" +
 	"\fCreateSecret\x12&.warden.service.v1.CreateSecretRequest\x1a'.warden.service.v1.CreateSecretResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/secrets\x12p\n" +
 	"\tGetSecret\x12#.warden.service.v1.GetSecretRequest\x1a$.warden.service.v1.GetSecretResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/secrets/{id}\x12\x91\x01\n" +
-	"\x11GetSecretPassword\x12+.warden.service.v1.GetSecretPasswordRequest\x1a,.warden.service.v1.GetSecretPasswordResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/secrets/{id}/password\x12q\n" +
+	"\x11GetSecretPassword\x12+.warden.service.v1.GetSecretPasswordRequest\x1a,.warden.service.v1.GetSecretPasswordResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/secrets/{id}/password\x12\xaa\x01\n" +
+	"\x17GetSecretPasswordMasked\x121.warden.service.v1.GetSecretPasswordMaskedRequest\x1a2.warden.service.v1.GetSecretPasswordMaskedResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/secrets/{id}/password/masked\x12\xa5\x01\n" +
+	"\x14CreateRetrievalToken\x12..warden.service.v1.CreateRetrievalTokenRequest\x1a/.warden.service.v1.CreateRetrievalTokenResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/secrets/{id}/retrieval-tokens\x12\x9f\x01\n" +
+	"\x14RedeemRetrievalToken\x12..warden.service.v1.RedeemRetrievalTokenRequest\x1a/.warden.service.v1.RedeemRetrievalTokenResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/retrieval-tokens:redeem\x12\x85\x01\n" +
+	"\x0fGetSecretByPath\x12).warden.service.v1.GetSecretByPathRequest\x1a*.warden.service.v1.GetSecretByPathResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/secrets:by-path\x12s\n" +
+	"\x12FetchSecretsStream\x12,.warden.service.v1.FetchSecretsStreamRequest\x1a-.warden.service.v1.FetchSecretsStreamResponse0\x01\x12q\n" +
 	"\vListSecrets\x12%.warden.service.v1.ListSecretsRequest\x1a&.warden.service.v1.ListSecretsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/secrets\x12|\n" +
 	"\fUpdateSecret\x12&.warden.service.v1.UpdateSecretRequest\x1a'.warden.service.v1.UpdateSecretResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/secrets/{id}\x12\x9d\x01\n" +
 	"\x14UpdateSecretPassword\x12..warden.service.v1.UpdateSecretPasswordRequest\x1a/.warden.service.v1.UpdateSecretPasswordResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/secrets/{id}/password\x12h\n" +
@@ -2260,11 +5532,19 @@ const file_warden_service_v1_secret_proto_rawDesc = "" +
 	"\fListVersions\x12&.warden.service.v1.ListVersionsRequest\x1a'.warden.service.v1.ListVersionsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/secrets/{secret_id}/versions\x12\x94\x01\n" +
 	"\n" +
 	"GetVersion\x12$.warden.service.v1.GetVersionRequest\x1a%.warden.service.v1.GetVersionResponse\"9\x82\xd3\xe4\x93\x023\x121/v1/secrets/{secret_id}/versions/{version_number}\x12\xa8\x01\n" +
-	"\x0eRestoreVersion\x12(.warden.service.v1.RestoreVersionRequest\x1a).warden.service.v1.RestoreVersionResponse\"A\x82\xd3\xe4\x93\x02;\"9/v1/secrets/{secret_id}/versions/{version_number}/restore\x12~\n" +
+	"\x0eRestoreVersion\x12(.warden.service.v1.RestoreVersionRequest\x1a).warden.service.v1.RestoreVersionResponse\"A\x82\xd3\xe4\x93\x02;\"9/v1/secrets/{secret_id}/versions/{version_number}/restore\x12\xa4\x01\n" +
+	"\rDeleteVersion\x12'.warden.service.v1.DeleteVersionRequest\x1a(.warden.service.v1.DeleteVersionResponse\"@\x82\xd3\xe4\x93\x02:\"8/v1/secrets/{secret_id}/versions/{version_number}/delete\x12\xa8\x01\n" +
+	"\x0eDestroyVersion\x12(.warden.service.v1.DestroyVersionRequest\x1a).warden.service.v1.DestroyVersionResponse\"A\x82\xd3\xe4\x93\x02;\"9/v1/secrets/{secret_id}/versions/{version_number}/destroy\x12\xac\x01\n" +
+	"\x0fUndeleteVersion\x12).warden.service.v1.UndeleteVersionRequest\x1a*.warden.service.v1.UndeleteVersionResponse\"B\x82\xd3\xe4\x93\x02<\":/v1/secrets/{secret_id}/versions/{version_number}/undelete\x12\x8e\x01\n" +
+	"\x0fListVersionPins\x12).warden.service.v1.ListVersionPinsRequest\x1a*.warden.service.v1.ListVersionPinsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/secrets/{secret_id}/pins\x12\x85\x01\n" +
+	"\x10DeleteVersionPin\x12*.warden.service.v1.DeleteVersionPinRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02'*%/v1/secrets/{secret_id}/pins/{pin_id}\x12~\n" +
 	"\rSearchSecrets\x12'.warden.service.v1.SearchSecretsRequest\x1a(.warden.service.v1.SearchSecretsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/secrets/search\x12\x81\x01\n" +
 	"\rGetSecretTotp\x12'.warden.service.v1.GetSecretTotpRequest\x1a(.warden.service.v1.GetSecretTotpResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/secrets/{id}/totp\x12\x84\x01\n" +
 	"\rSetSecretTotp\x12'.warden.service.v1.SetSecretTotpRequest\x1a(.warden.service.v1.SetSecretTotpResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/secrets/{id}/totp\x12u\n" +
-	"\x10DeleteSecretTotp\x12*.warden.service.v1.DeleteSecretTotpRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/secrets/{id}/totpB\xd3\x01\n" +
+	"\x10DeleteSecretTotp\x12*.warden.service.v1.DeleteSecretTotpRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/secrets/{id}/totp\x12\xa5\x01\n" +
+	"\x15SetSecretAccessPolicy\x12/.warden.service.v1.SetSecretAccessPolicyRequest\x1a0.warden.service.v1.SetSecretAccessPolicyResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/v1/secrets/{id}/access-policy\x12\x85\x01\n" +
+	"\x0eGetSecretUsage\x12(.warden.service.v1.GetSecretUsageRequest\x1a).warden.service.v1.GetSecretUsageResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/secrets/{id}/usage\x12\x85\x01\n" +
+	"\x0eGetSecretGraph\x12(.warden.service.v1.GetSecretGraphRequest\x1a).warden.service.v1.GetSecretGraphResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/secrets/{id}/graphB\xd3\x01\n" +
 	"\x15com.warden.service.v1B\vSecretProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
 
 var (
@@ -2279,109 +5559,219 @@ func file_warden_service_v1_secret_proto_rawDescGZIP() []byte {
 	return file_warden_service_v1_secret_proto_rawDescData
 }
 
-var file_warden_service_v1_secret_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_warden_service_v1_secret_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_warden_service_v1_secret_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_warden_service_v1_secret_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_warden_service_v1_secret_proto_goTypes = []any{
-	(SecretStatus)(0),                    // 0: warden.service.v1.SecretStatus
-	(*Secret)(nil),                       // 1: warden.service.v1.Secret
-	(*SecretVersion)(nil),                // 2: warden.service.v1.SecretVersion
-	(*InitialPermissionGrant)(nil),       // 3: warden.service.v1.InitialPermissionGrant
-	(*CreateSecretRequest)(nil),          // 4: warden.service.v1.CreateSecretRequest
-	(*CreateSecretResponse)(nil),         // 5: warden.service.v1.CreateSecretResponse
-	(*GetSecretRequest)(nil),             // 6: warden.service.v1.GetSecretRequest
-	(*GetSecretResponse)(nil),            // 7: warden.service.v1.GetSecretResponse
-	(*GetSecretPasswordRequest)(nil),     // 8: warden.service.v1.GetSecretPasswordRequest
-	(*GetSecretPasswordResponse)(nil),    // 9: warden.service.v1.GetSecretPasswordResponse
-	(*ListSecretsRequest)(nil),           // 10: warden.service.v1.ListSecretsRequest
-	(*ListSecretsResponse)(nil),          // 11: warden.service.v1.ListSecretsResponse
-	(*UpdateSecretRequest)(nil),          // 12: warden.service.v1.UpdateSecretRequest
-	(*UpdateSecretResponse)(nil),         // 13: warden.service.v1.UpdateSecretResponse
-	(*UpdateSecretPasswordRequest)(nil),  // 14: warden.service.v1.UpdateSecretPasswordRequest
-	(*UpdateSecretPasswordResponse)(nil), // 15: warden.service.v1.UpdateSecretPasswordResponse
-	(*DeleteSecretRequest)(nil),          // 16: warden.service.v1.DeleteSecretRequest
-	(*MoveSecretRequest)(nil),            // 17: warden.service.v1.MoveSecretRequest
-	(*MoveSecretResponse)(nil),           // 18: warden.service.v1.MoveSecretResponse
-	(*ListVersionsRequest)(nil),          // 19: warden.service.v1.ListVersionsRequest
-	(*ListVersionsResponse)(nil),         // 20: warden.service.v1.ListVersionsResponse
-	(*GetVersionRequest)(nil),            // 21: warden.service.v1.GetVersionRequest
-	(*GetVersionResponse)(nil),           // 22: warden.service.v1.GetVersionResponse
-	(*RestoreVersionRequest)(nil),        // 23: warden.service.v1.RestoreVersionRequest
-	(*RestoreVersionResponse)(nil),       // 24: warden.service.v1.RestoreVersionResponse
-	(*SearchSecretsRequest)(nil),         // 25: warden.service.v1.SearchSecretsRequest
-	(*SearchSecretsResponse)(nil),        // 26: warden.service.v1.SearchSecretsResponse
-	(*GetSecretTotpRequest)(nil),         // 27: warden.service.v1.GetSecretTotpRequest
-	(*GetSecretTotpResponse)(nil),        // 28: warden.service.v1.GetSecretTotpResponse
-	(*SetSecretTotpRequest)(nil),         // 29: warden.service.v1.SetSecretTotpRequest
-	(*SetSecretTotpResponse)(nil),        // 30: warden.service.v1.SetSecretTotpResponse
-	(*DeleteSecretTotpRequest)(nil),      // 31: warden.service.v1.DeleteSecretTotpRequest
-	(*structpb.Struct)(nil),              // 32: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),        // 33: google.protobuf.Timestamp
-	(SubjectType)(0),                     // 34: warden.service.v1.SubjectType
-	(Relation)(0),                        // 35: warden.service.v1.Relation
-	(*emptypb.Empty)(nil),                // 36: google.protobuf.Empty
+	(SecretStatus)(0),                       // 0: warden.service.v1.SecretStatus
+	(ListSortField)(0),                      // 1: warden.service.v1.ListSortField
+	(SortOrder)(0),                          // 2: warden.service.v1.SortOrder
+	(PasswordEncoding)(0),                   // 3: warden.service.v1.PasswordEncoding
+	(SecretGraphNodeKind)(0),                // 4: warden.service.v1.SecretGraphNodeKind
+	(SecretGraphEdgeKind)(0),                // 5: warden.service.v1.SecretGraphEdgeKind
+	(*Secret)(nil),                          // 6: warden.service.v1.Secret
+	(*AccessPolicy)(nil),                    // 7: warden.service.v1.AccessPolicy
+	(*TimeWindow)(nil),                      // 8: warden.service.v1.TimeWindow
+	(*SecretVersion)(nil),                   // 9: warden.service.v1.SecretVersion
+	(*VaultVersionState)(nil),               // 10: warden.service.v1.VaultVersionState
+	(*InitialPermissionGrant)(nil),          // 11: warden.service.v1.InitialPermissionGrant
+	(*CreateSecretRequest)(nil),             // 12: warden.service.v1.CreateSecretRequest
+	(*CreateSecretResponse)(nil),            // 13: warden.service.v1.CreateSecretResponse
+	(*GetSecretRequest)(nil),                // 14: warden.service.v1.GetSecretRequest
+	(*GetSecretResponse)(nil),               // 15: warden.service.v1.GetSecretResponse
+	(*GetSecretPasswordRequest)(nil),        // 16: warden.service.v1.GetSecretPasswordRequest
+	(*GetSecretPasswordResponse)(nil),       // 17: warden.service.v1.GetSecretPasswordResponse
+	(*VersionPin)(nil),                      // 18: warden.service.v1.VersionPin
+	(*GetSecretPasswordMaskedRequest)(nil),  // 19: warden.service.v1.GetSecretPasswordMaskedRequest
+	(*GetSecretPasswordMaskedResponse)(nil), // 20: warden.service.v1.GetSecretPasswordMaskedResponse
+	(*CreateRetrievalTokenRequest)(nil),     // 21: warden.service.v1.CreateRetrievalTokenRequest
+	(*CreateRetrievalTokenResponse)(nil),    // 22: warden.service.v1.CreateRetrievalTokenResponse
+	(*RedeemRetrievalTokenRequest)(nil),     // 23: warden.service.v1.RedeemRetrievalTokenRequest
+	(*RedeemRetrievalTokenResponse)(nil),    // 24: warden.service.v1.RedeemRetrievalTokenResponse
+	(*GetSecretByPathRequest)(nil),          // 25: warden.service.v1.GetSecretByPathRequest
+	(*GetSecretByPathResponse)(nil),         // 26: warden.service.v1.GetSecretByPathResponse
+	(*FetchSecretsStreamRequest)(nil),       // 27: warden.service.v1.FetchSecretsStreamRequest
+	(*StreamedSecret)(nil),                  // 28: warden.service.v1.StreamedSecret
+	(*FetchSecretsStreamResponse)(nil),      // 29: warden.service.v1.FetchSecretsStreamResponse
+	(*ListSecretsRequest)(nil),              // 30: warden.service.v1.ListSecretsRequest
+	(*ListSecretsResponse)(nil),             // 31: warden.service.v1.ListSecretsResponse
+	(*UpdateSecretRequest)(nil),             // 32: warden.service.v1.UpdateSecretRequest
+	(*UpdateSecretResponse)(nil),            // 33: warden.service.v1.UpdateSecretResponse
+	(*UpdateSecretPasswordRequest)(nil),     // 34: warden.service.v1.UpdateSecretPasswordRequest
+	(*UpdateSecretPasswordResponse)(nil),    // 35: warden.service.v1.UpdateSecretPasswordResponse
+	(*DeleteSecretRequest)(nil),             // 36: warden.service.v1.DeleteSecretRequest
+	(*MoveSecretRequest)(nil),               // 37: warden.service.v1.MoveSecretRequest
+	(*MoveSecretResponse)(nil),              // 38: warden.service.v1.MoveSecretResponse
+	(*ListVersionsRequest)(nil),             // 39: warden.service.v1.ListVersionsRequest
+	(*ListVersionsResponse)(nil),            // 40: warden.service.v1.ListVersionsResponse
+	(*GetVersionRequest)(nil),               // 41: warden.service.v1.GetVersionRequest
+	(*GetVersionResponse)(nil),              // 42: warden.service.v1.GetVersionResponse
+	(*RestoreVersionRequest)(nil),           // 43: warden.service.v1.RestoreVersionRequest
+	(*RestoreVersionResponse)(nil),          // 44: warden.service.v1.RestoreVersionResponse
+	(*DeleteVersionRequest)(nil),            // 45: warden.service.v1.DeleteVersionRequest
+	(*DeleteVersionResponse)(nil),           // 46: warden.service.v1.DeleteVersionResponse
+	(*DestroyVersionRequest)(nil),           // 47: warden.service.v1.DestroyVersionRequest
+	(*DestroyVersionResponse)(nil),          // 48: warden.service.v1.DestroyVersionResponse
+	(*UndeleteVersionRequest)(nil),          // 49: warden.service.v1.UndeleteVersionRequest
+	(*UndeleteVersionResponse)(nil),         // 50: warden.service.v1.UndeleteVersionResponse
+	(*ListVersionPinsRequest)(nil),          // 51: warden.service.v1.ListVersionPinsRequest
+	(*ListVersionPinsResponse)(nil),         // 52: warden.service.v1.ListVersionPinsResponse
+	(*DeleteVersionPinRequest)(nil),         // 53: warden.service.v1.DeleteVersionPinRequest
+	(*SearchSecretsRequest)(nil),            // 54: warden.service.v1.SearchSecretsRequest
+	(*SearchSecretsResponse)(nil),           // 55: warden.service.v1.SearchSecretsResponse
+	(*SecretSearchHit)(nil),                 // 56: warden.service.v1.SecretSearchHit
+	(*GetSecretTotpRequest)(nil),            // 57: warden.service.v1.GetSecretTotpRequest
+	(*GetSecretTotpResponse)(nil),           // 58: warden.service.v1.GetSecretTotpResponse
+	(*SetSecretTotpRequest)(nil),            // 59: warden.service.v1.SetSecretTotpRequest
+	(*SetSecretTotpResponse)(nil),           // 60: warden.service.v1.SetSecretTotpResponse
+	(*DeleteSecretTotpRequest)(nil),         // 61: warden.service.v1.DeleteSecretTotpRequest
+	(*SetSecretAccessPolicyRequest)(nil),    // 62: warden.service.v1.SetSecretAccessPolicyRequest
+	(*SetSecretAccessPolicyResponse)(nil),   // 63: warden.service.v1.SetSecretAccessPolicyResponse
+	(*GetSecretUsageRequest)(nil),           // 64: warden.service.v1.GetSecretUsageRequest
+	(*GetSecretUsageResponse)(nil),          // 65: warden.service.v1.GetSecretUsageResponse
+	(*SecretUsage)(nil),                     // 66: warden.service.v1.SecretUsage
+	(*DailyUsage)(nil),                      // 67: warden.service.v1.DailyUsage
+	(*GetSecretGraphRequest)(nil),           // 68: warden.service.v1.GetSecretGraphRequest
+	(*GetSecretGraphResponse)(nil),          // 69: warden.service.v1.GetSecretGraphResponse
+	(*SecretGraphNode)(nil),                 // 70: warden.service.v1.SecretGraphNode
+	(*SecretGraphEdge)(nil),                 // 71: warden.service.v1.SecretGraphEdge
+	nil,                                     // 72: warden.service.v1.GetSecretByPathResponse.MetadataEntry
+	nil,                                     // 73: warden.service.v1.StreamedSecret.MetadataEntry
+	nil,                                     // 74: warden.service.v1.SecretSearchHit.HighlightsEntry
+	(*structpb.Struct)(nil),                 // 75: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),           // 76: google.protobuf.Timestamp
+	(SubjectType)(0),                        // 77: warden.service.v1.SubjectType
+	(Relation)(0),                           // 78: warden.service.v1.Relation
+	(*emptypb.Empty)(nil),                   // 79: google.protobuf.Empty
 }
 var file_warden_service_v1_secret_proto_depIdxs = []int32{
-	32, // 0: warden.service.v1.Secret.metadata:type_name -> google.protobuf.Struct
+	75, // 0: warden.service.v1.Secret.metadata:type_name -> google.protobuf.Struct
 	0,  // 1: warden.service.v1.Secret.status:type_name -> warden.service.v1.SecretStatus
-	33, // 2: warden.service.v1.Secret.create_time:type_name -> google.protobuf.Timestamp
-	33, // 3: warden.service.v1.Secret.update_time:type_name -> google.protobuf.Timestamp
-	33, // 4: warden.service.v1.SecretVersion.create_time:type_name -> google.protobuf.Timestamp
-	34, // 5: warden.service.v1.InitialPermissionGrant.subject_type:type_name -> warden.service.v1.SubjectType
-	35, // 6: warden.service.v1.InitialPermissionGrant.relation:type_name -> warden.service.v1.Relation
-	32, // 7: warden.service.v1.CreateSecretRequest.metadata:type_name -> google.protobuf.Struct
-	3,  // 8: warden.service.v1.CreateSecretRequest.initial_permissions:type_name -> warden.service.v1.InitialPermissionGrant
-	1,  // 9: warden.service.v1.CreateSecretResponse.secret:type_name -> warden.service.v1.Secret
-	1,  // 10: warden.service.v1.GetSecretResponse.secret:type_name -> warden.service.v1.Secret
-	0,  // 11: warden.service.v1.ListSecretsRequest.status:type_name -> warden.service.v1.SecretStatus
-	1,  // 12: warden.service.v1.ListSecretsResponse.secrets:type_name -> warden.service.v1.Secret
-	32, // 13: warden.service.v1.UpdateSecretRequest.metadata:type_name -> google.protobuf.Struct
-	0,  // 14: warden.service.v1.UpdateSecretRequest.status:type_name -> warden.service.v1.SecretStatus
-	1,  // 15: warden.service.v1.UpdateSecretResponse.secret:type_name -> warden.service.v1.Secret
-	1,  // 16: warden.service.v1.UpdateSecretPasswordResponse.secret:type_name -> warden.service.v1.Secret
-	2,  // 17: warden.service.v1.UpdateSecretPasswordResponse.version:type_name -> warden.service.v1.SecretVersion
-	1,  // 18: warden.service.v1.MoveSecretResponse.secret:type_name -> warden.service.v1.Secret
-	2,  // 19: warden.service.v1.ListVersionsResponse.versions:type_name -> warden.service.v1.SecretVersion
-	2,  // 20: warden.service.v1.GetVersionResponse.version:type_name -> warden.service.v1.SecretVersion
-	1,  // 21: warden.service.v1.RestoreVersionResponse.secret:type_name -> warden.service.v1.Secret
-	2,  // 22: warden.service.v1.RestoreVersionResponse.new_version:type_name -> warden.service.v1.SecretVersion
-	0,  // 23: warden.service.v1.SearchSecretsRequest.status:type_name -> warden.service.v1.SecretStatus
-	1,  // 24: warden.service.v1.SearchSecretsResponse.secrets:type_name -> warden.service.v1.Secret
-	1,  // 25: warden.service.v1.SetSecretTotpResponse.secret:type_name -> warden.service.v1.Secret
-	4,  // 26: warden.service.v1.WardenSecretService.CreateSecret:input_type -> warden.service.v1.CreateSecretRequest
-	6,  // 27: warden.service.v1.WardenSecretService.GetSecret:input_type -> warden.service.v1.GetSecretRequest
-	8,  // 28: warden.service.v1.WardenSecretService.GetSecretPassword:input_type -> warden.service.v1.GetSecretPasswordRequest
-	10, // 29: warden.service.v1.WardenSecretService.ListSecrets:input_type -> warden.service.v1.ListSecretsRequest
-	12, // 30: warden.service.v1.WardenSecretService.UpdateSecret:input_type -> warden.service.v1.UpdateSecretRequest
-	14, // 31: warden.service.v1.WardenSecretService.UpdateSecretPassword:input_type -> warden.service.v1.UpdateSecretPasswordRequest
-	16, // 32: warden.service.v1.WardenSecretService.DeleteSecret:input_type -> warden.service.v1.DeleteSecretRequest
-	17, // 33: warden.service.v1.WardenSecretService.MoveSecret:input_type -> warden.service.v1.MoveSecretRequest
-	19, // 34: warden.service.v1.WardenSecretService.ListVersions:input_type -> warden.service.v1.ListVersionsRequest
-	21, // 35: warden.service.v1.WardenSecretService.GetVersion:input_type -> warden.service.v1.GetVersionRequest
-	23, // 36: warden.service.v1.WardenSecretService.RestoreVersion:input_type -> warden.service.v1.RestoreVersionRequest
-	25, // 37: warden.service.v1.WardenSecretService.SearchSecrets:input_type -> warden.service.v1.SearchSecretsRequest
-	27, // 38: warden.service.v1.WardenSecretService.GetSecretTotp:input_type -> warden.service.v1.GetSecretTotpRequest
-	29, // 39: warden.service.v1.WardenSecretService.SetSecretTotp:input_type -> warden.service.v1.SetSecretTotpRequest
-	31, // 40: warden.service.v1.WardenSecretService.DeleteSecretTotp:input_type -> warden.service.v1.DeleteSecretTotpRequest
-	5,  // 41: warden.service.v1.WardenSecretService.CreateSecret:output_type -> warden.service.v1.CreateSecretResponse
-	7,  // 42: warden.service.v1.WardenSecretService.GetSecret:output_type -> warden.service.v1.GetSecretResponse
-	9,  // 43: warden.service.v1.WardenSecretService.GetSecretPassword:output_type -> warden.service.v1.GetSecretPasswordResponse
-	11, // 44: warden.service.v1.WardenSecretService.ListSecrets:output_type -> warden.service.v1.ListSecretsResponse
-	13, // 45: warden.service.v1.WardenSecretService.UpdateSecret:output_type -> warden.service.v1.UpdateSecretResponse
-	15, // 46: warden.service.v1.WardenSecretService.UpdateSecretPassword:output_type -> warden.service.v1.UpdateSecretPasswordResponse
-	36, // 47: warden.service.v1.WardenSecretService.DeleteSecret:output_type -> google.protobuf.Empty
-	18, // 48: warden.service.v1.WardenSecretService.MoveSecret:output_type -> warden.service.v1.MoveSecretResponse
-	20, // 49: warden.service.v1.WardenSecretService.ListVersions:output_type -> warden.service.v1.ListVersionsResponse
-	22, // 50: warden.service.v1.WardenSecretService.GetVersion:output_type -> warden.service.v1.GetVersionResponse
-	24, // 51: warden.service.v1.WardenSecretService.RestoreVersion:output_type -> warden.service.v1.RestoreVersionResponse
-	26, // 52: warden.service.v1.WardenSecretService.SearchSecrets:output_type -> warden.service.v1.SearchSecretsResponse
-	28, // 53: warden.service.v1.WardenSecretService.GetSecretTotp:output_type -> warden.service.v1.GetSecretTotpResponse
-	30, // 54: warden.service.v1.WardenSecretService.SetSecretTotp:output_type -> warden.service.v1.SetSecretTotpResponse
-	36, // 55: warden.service.v1.WardenSecretService.DeleteSecretTotp:output_type -> google.protobuf.Empty
-	41, // [41:56] is the sub-list for method output_type
-	26, // [26:41] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	76, // 2: warden.service.v1.Secret.create_time:type_name -> google.protobuf.Timestamp
+	76, // 3: warden.service.v1.Secret.update_time:type_name -> google.protobuf.Timestamp
+	76, // 4: warden.service.v1.Secret.last_accessed_time:type_name -> google.protobuf.Timestamp
+	3,  // 5: warden.service.v1.Secret.password_encoding:type_name -> warden.service.v1.PasswordEncoding
+	7,  // 6: warden.service.v1.Secret.access_policy:type_name -> warden.service.v1.AccessPolicy
+	8,  // 7: warden.service.v1.AccessPolicy.time_windows:type_name -> warden.service.v1.TimeWindow
+	76, // 8: warden.service.v1.SecretVersion.create_time:type_name -> google.protobuf.Timestamp
+	10, // 9: warden.service.v1.SecretVersion.vault_state:type_name -> warden.service.v1.VaultVersionState
+	76, // 10: warden.service.v1.VaultVersionState.create_time:type_name -> google.protobuf.Timestamp
+	76, // 11: warden.service.v1.VaultVersionState.deletion_time:type_name -> google.protobuf.Timestamp
+	77, // 12: warden.service.v1.InitialPermissionGrant.subject_type:type_name -> warden.service.v1.SubjectType
+	78, // 13: warden.service.v1.InitialPermissionGrant.relation:type_name -> warden.service.v1.Relation
+	75, // 14: warden.service.v1.CreateSecretRequest.metadata:type_name -> google.protobuf.Struct
+	11, // 15: warden.service.v1.CreateSecretRequest.initial_permissions:type_name -> warden.service.v1.InitialPermissionGrant
+	3,  // 16: warden.service.v1.CreateSecretRequest.password_encoding:type_name -> warden.service.v1.PasswordEncoding
+	6,  // 17: warden.service.v1.CreateSecretResponse.secret:type_name -> warden.service.v1.Secret
+	6,  // 18: warden.service.v1.GetSecretResponse.secret:type_name -> warden.service.v1.Secret
+	3,  // 19: warden.service.v1.GetSecretPasswordResponse.encoding:type_name -> warden.service.v1.PasswordEncoding
+	18, // 20: warden.service.v1.GetSecretPasswordResponse.pin:type_name -> warden.service.v1.VersionPin
+	76, // 21: warden.service.v1.VersionPin.last_used_time:type_name -> google.protobuf.Timestamp
+	76, // 22: warden.service.v1.VersionPin.create_time:type_name -> google.protobuf.Timestamp
+	3,  // 23: warden.service.v1.GetSecretPasswordMaskedResponse.encoding:type_name -> warden.service.v1.PasswordEncoding
+	76, // 24: warden.service.v1.CreateRetrievalTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 25: warden.service.v1.RedeemRetrievalTokenResponse.encoding:type_name -> warden.service.v1.PasswordEncoding
+	72, // 26: warden.service.v1.GetSecretByPathResponse.metadata:type_name -> warden.service.v1.GetSecretByPathResponse.MetadataEntry
+	76, // 27: warden.service.v1.GetSecretByPathResponse.update_time:type_name -> google.protobuf.Timestamp
+	73, // 28: warden.service.v1.StreamedSecret.metadata:type_name -> warden.service.v1.StreamedSecret.MetadataEntry
+	76, // 29: warden.service.v1.StreamedSecret.update_time:type_name -> google.protobuf.Timestamp
+	28, // 30: warden.service.v1.FetchSecretsStreamResponse.secrets:type_name -> warden.service.v1.StreamedSecret
+	0,  // 31: warden.service.v1.ListSecretsRequest.status:type_name -> warden.service.v1.SecretStatus
+	1,  // 32: warden.service.v1.ListSecretsRequest.sort_by:type_name -> warden.service.v1.ListSortField
+	2,  // 33: warden.service.v1.ListSecretsRequest.sort_order:type_name -> warden.service.v1.SortOrder
+	76, // 34: warden.service.v1.ListSecretsRequest.not_accessed_since:type_name -> google.protobuf.Timestamp
+	6,  // 35: warden.service.v1.ListSecretsResponse.secrets:type_name -> warden.service.v1.Secret
+	75, // 36: warden.service.v1.UpdateSecretRequest.metadata:type_name -> google.protobuf.Struct
+	0,  // 37: warden.service.v1.UpdateSecretRequest.status:type_name -> warden.service.v1.SecretStatus
+	6,  // 38: warden.service.v1.UpdateSecretResponse.secret:type_name -> warden.service.v1.Secret
+	3,  // 39: warden.service.v1.UpdateSecretPasswordRequest.password_encoding:type_name -> warden.service.v1.PasswordEncoding
+	6,  // 40: warden.service.v1.UpdateSecretPasswordResponse.secret:type_name -> warden.service.v1.Secret
+	9,  // 41: warden.service.v1.UpdateSecretPasswordResponse.version:type_name -> warden.service.v1.SecretVersion
+	18, // 42: warden.service.v1.UpdateSecretPasswordResponse.stale_pins:type_name -> warden.service.v1.VersionPin
+	6,  // 43: warden.service.v1.MoveSecretResponse.secret:type_name -> warden.service.v1.Secret
+	9,  // 44: warden.service.v1.ListVersionsResponse.versions:type_name -> warden.service.v1.SecretVersion
+	9,  // 45: warden.service.v1.GetVersionResponse.version:type_name -> warden.service.v1.SecretVersion
+	6,  // 46: warden.service.v1.RestoreVersionResponse.secret:type_name -> warden.service.v1.Secret
+	9,  // 47: warden.service.v1.RestoreVersionResponse.new_version:type_name -> warden.service.v1.SecretVersion
+	9,  // 48: warden.service.v1.DeleteVersionResponse.version:type_name -> warden.service.v1.SecretVersion
+	9,  // 49: warden.service.v1.DestroyVersionResponse.version:type_name -> warden.service.v1.SecretVersion
+	9,  // 50: warden.service.v1.UndeleteVersionResponse.version:type_name -> warden.service.v1.SecretVersion
+	18, // 51: warden.service.v1.ListVersionPinsResponse.pins:type_name -> warden.service.v1.VersionPin
+	0,  // 52: warden.service.v1.SearchSecretsRequest.status:type_name -> warden.service.v1.SecretStatus
+	6,  // 53: warden.service.v1.SearchSecretsResponse.secrets:type_name -> warden.service.v1.Secret
+	56, // 54: warden.service.v1.SearchSecretsResponse.hits:type_name -> warden.service.v1.SecretSearchHit
+	74, // 55: warden.service.v1.SecretSearchHit.highlights:type_name -> warden.service.v1.SecretSearchHit.HighlightsEntry
+	6,  // 56: warden.service.v1.SetSecretTotpResponse.secret:type_name -> warden.service.v1.Secret
+	7,  // 57: warden.service.v1.SetSecretAccessPolicyRequest.policy:type_name -> warden.service.v1.AccessPolicy
+	6,  // 58: warden.service.v1.SetSecretAccessPolicyResponse.secret:type_name -> warden.service.v1.Secret
+	66, // 59: warden.service.v1.GetSecretUsageResponse.usage:type_name -> warden.service.v1.SecretUsage
+	67, // 60: warden.service.v1.GetSecretUsageResponse.daily:type_name -> warden.service.v1.DailyUsage
+	70, // 61: warden.service.v1.GetSecretGraphResponse.nodes:type_name -> warden.service.v1.SecretGraphNode
+	71, // 62: warden.service.v1.GetSecretGraphResponse.edges:type_name -> warden.service.v1.SecretGraphEdge
+	4,  // 63: warden.service.v1.SecretGraphNode.kind:type_name -> warden.service.v1.SecretGraphNodeKind
+	5,  // 64: warden.service.v1.SecretGraphEdge.kind:type_name -> warden.service.v1.SecretGraphEdgeKind
+	76, // 65: warden.service.v1.SecretGraphEdge.expires_at:type_name -> google.protobuf.Timestamp
+	76, // 66: warden.service.v1.SecretGraphEdge.last_time:type_name -> google.protobuf.Timestamp
+	12, // 67: warden.service.v1.WardenSecretService.CreateSecret:input_type -> warden.service.v1.CreateSecretRequest
+	14, // 68: warden.service.v1.WardenSecretService.GetSecret:input_type -> warden.service.v1.GetSecretRequest
+	16, // 69: warden.service.v1.WardenSecretService.GetSecretPassword:input_type -> warden.service.v1.GetSecretPasswordRequest
+	19, // 70: warden.service.v1.WardenSecretService.GetSecretPasswordMasked:input_type -> warden.service.v1.GetSecretPasswordMaskedRequest
+	21, // 71: warden.service.v1.WardenSecretService.CreateRetrievalToken:input_type -> warden.service.v1.CreateRetrievalTokenRequest
+	23, // 72: warden.service.v1.WardenSecretService.RedeemRetrievalToken:input_type -> warden.service.v1.RedeemRetrievalTokenRequest
+	25, // 73: warden.service.v1.WardenSecretService.GetSecretByPath:input_type -> warden.service.v1.GetSecretByPathRequest
+	27, // 74: warden.service.v1.WardenSecretService.FetchSecretsStream:input_type -> warden.service.v1.FetchSecretsStreamRequest
+	30, // 75: warden.service.v1.WardenSecretService.ListSecrets:input_type -> warden.service.v1.ListSecretsRequest
+	32, // 76: warden.service.v1.WardenSecretService.UpdateSecret:input_type -> warden.service.v1.UpdateSecretRequest
+	34, // 77: warden.service.v1.WardenSecretService.UpdateSecretPassword:input_type -> warden.service.v1.UpdateSecretPasswordRequest
+	36, // 78: warden.service.v1.WardenSecretService.DeleteSecret:input_type -> warden.service.v1.DeleteSecretRequest
+	37, // 79: warden.service.v1.WardenSecretService.MoveSecret:input_type -> warden.service.v1.MoveSecretRequest
+	39, // 80: warden.service.v1.WardenSecretService.ListVersions:input_type -> warden.service.v1.ListVersionsRequest
+	41, // 81: warden.service.v1.WardenSecretService.GetVersion:input_type -> warden.service.v1.GetVersionRequest
+	43, // 82: warden.service.v1.WardenSecretService.RestoreVersion:input_type -> warden.service.v1.RestoreVersionRequest
+	45, // 83: warden.service.v1.WardenSecretService.DeleteVersion:input_type -> warden.service.v1.DeleteVersionRequest
+	47, // 84: warden.service.v1.WardenSecretService.DestroyVersion:input_type -> warden.service.v1.DestroyVersionRequest
+	49, // 85: warden.service.v1.WardenSecretService.UndeleteVersion:input_type -> warden.service.v1.UndeleteVersionRequest
+	51, // 86: warden.service.v1.WardenSecretService.ListVersionPins:input_type -> warden.service.v1.ListVersionPinsRequest
+	53, // 87: warden.service.v1.WardenSecretService.DeleteVersionPin:input_type -> warden.service.v1.DeleteVersionPinRequest
+	54, // 88: warden.service.v1.WardenSecretService.SearchSecrets:input_type -> warden.service.v1.SearchSecretsRequest
+	57, // 89: warden.service.v1.WardenSecretService.GetSecretTotp:input_type -> warden.service.v1.GetSecretTotpRequest
+	59, // 90: warden.service.v1.WardenSecretService.SetSecretTotp:input_type -> warden.service.v1.SetSecretTotpRequest
+	61, // 91: warden.service.v1.WardenSecretService.DeleteSecretTotp:input_type -> warden.service.v1.DeleteSecretTotpRequest
+	62, // 92: warden.service.v1.WardenSecretService.SetSecretAccessPolicy:input_type -> warden.service.v1.SetSecretAccessPolicyRequest
+	64, // 93: warden.service.v1.WardenSecretService.GetSecretUsage:input_type -> warden.service.v1.GetSecretUsageRequest
+	68, // 94: warden.service.v1.WardenSecretService.GetSecretGraph:input_type -> warden.service.v1.GetSecretGraphRequest
+	13, // 95: warden.service.v1.WardenSecretService.CreateSecret:output_type -> warden.service.v1.CreateSecretResponse
+	15, // 96: warden.service.v1.WardenSecretService.GetSecret:output_type -> warden.service.v1.GetSecretResponse
+	17, // 97: warden.service.v1.WardenSecretService.GetSecretPassword:output_type -> warden.service.v1.GetSecretPasswordResponse
+	20, // 98: warden.service.v1.WardenSecretService.GetSecretPasswordMasked:output_type -> warden.service.v1.GetSecretPasswordMaskedResponse
+	22, // 99: warden.service.v1.WardenSecretService.CreateRetrievalToken:output_type -> warden.service.v1.CreateRetrievalTokenResponse
+	24, // 100: warden.service.v1.WardenSecretService.RedeemRetrievalToken:output_type -> warden.service.v1.RedeemRetrievalTokenResponse
+	26, // 101: warden.service.v1.WardenSecretService.GetSecretByPath:output_type -> warden.service.v1.GetSecretByPathResponse
+	29, // 102: warden.service.v1.WardenSecretService.FetchSecretsStream:output_type -> warden.service.v1.FetchSecretsStreamResponse
+	31, // 103: warden.service.v1.WardenSecretService.ListSecrets:output_type -> warden.service.v1.ListSecretsResponse
+	33, // 104: warden.service.v1.WardenSecretService.UpdateSecret:output_type -> warden.service.v1.UpdateSecretResponse
+	35, // 105: warden.service.v1.WardenSecretService.UpdateSecretPassword:output_type -> warden.service.v1.UpdateSecretPasswordResponse
+	79, // 106: warden.service.v1.WardenSecretService.DeleteSecret:output_type -> google.protobuf.Empty
+	38, // 107: warden.service.v1.WardenSecretService.MoveSecret:output_type -> warden.service.v1.MoveSecretResponse
+	40, // 108: warden.service.v1.WardenSecretService.ListVersions:output_type -> warden.service.v1.ListVersionsResponse
+	42, // 109: warden.service.v1.WardenSecretService.GetVersion:output_type -> warden.service.v1.GetVersionResponse
+	44, // 110: warden.service.v1.WardenSecretService.RestoreVersion:output_type -> warden.service.v1.RestoreVersionResponse
+	46, // 111: warden.service.v1.WardenSecretService.DeleteVersion:output_type -> warden.service.v1.DeleteVersionResponse
+	48, // 112: warden.service.v1.WardenSecretService.DestroyVersion:output_type -> warden.service.v1.DestroyVersionResponse
+	50, // 113: warden.service.v1.WardenSecretService.UndeleteVersion:output_type -> warden.service.v1.UndeleteVersionResponse
+	52, // 114: warden.service.v1.WardenSecretService.ListVersionPins:output_type -> warden.service.v1.ListVersionPinsResponse
+	79, // 115: warden.service.v1.WardenSecretService.DeleteVersionPin:output_type -> google.protobuf.Empty
+	55, // 116: warden.service.v1.WardenSecretService.SearchSecrets:output_type -> warden.service.v1.SearchSecretsResponse
+	58, // 117: warden.service.v1.WardenSecretService.GetSecretTotp:output_type -> warden.service.v1.GetSecretTotpResponse
+	60, // 118: warden.service.v1.WardenSecretService.SetSecretTotp:output_type -> warden.service.v1.SetSecretTotpResponse
+	79, // 119: warden.service.v1.WardenSecretService.DeleteSecretTotp:output_type -> google.protobuf.Empty
+	63, // 120: warden.service.v1.WardenSecretService.SetSecretAccessPolicy:output_type -> warden.service.v1.SetSecretAccessPolicyResponse
+	65, // 121: warden.service.v1.WardenSecretService.GetSecretUsage:output_type -> warden.service.v1.GetSecretUsageResponse
+	69, // 122: warden.service.v1.WardenSecretService.GetSecretGraph:output_type -> warden.service.v1.GetSecretGraphResponse
+	95, // [95:123] is the sub-list for method output_type
+	67, // [67:95] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_warden_service_v1_secret_proto_init() }
@@ -2391,22 +5781,31 @@ func file_warden_service_v1_secret_proto_init() {
 	}
 	file_warden_service_v1_permission_proto_init()
 	file_warden_service_v1_secret_proto_msgTypes[0].OneofWrappers = []any{}
-	file_warden_service_v1_secret_proto_msgTypes[1].OneofWrappers = []any{}
 	file_warden_service_v1_secret_proto_msgTypes[3].OneofWrappers = []any{}
-	file_warden_service_v1_secret_proto_msgTypes[7].OneofWrappers = []any{}
-	file_warden_service_v1_secret_proto_msgTypes[9].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[4].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[6].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[10].OneofWrappers = []any{}
 	file_warden_service_v1_secret_proto_msgTypes[11].OneofWrappers = []any{}
-	file_warden_service_v1_secret_proto_msgTypes[16].OneofWrappers = []any{}
-	file_warden_service_v1_secret_proto_msgTypes[18].OneofWrappers = []any{}
-	file_warden_service_v1_secret_proto_msgTypes[21].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[12].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[13].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[15].OneofWrappers = []any{}
 	file_warden_service_v1_secret_proto_msgTypes[24].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[26].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[31].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[33].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[36].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[48].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[58].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[60].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[62].OneofWrappers = []any{}
+	file_warden_service_v1_secret_proto_msgTypes[65].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_secret_proto_rawDesc), len(file_warden_service_v1_secret_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   31,
+			NumEnums:      6,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
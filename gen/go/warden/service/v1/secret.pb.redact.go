@@ -82,6 +82,58 @@ func (s *redactedWardenSecretServiceServer) GetSecretPassword(ctx context.Contex
 	return res, err
 }
 
+// GetSecretPasswordMasked is the redacted wrapper for the actual WardenSecretServiceServer.GetSecretPasswordMasked method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) GetSecretPasswordMasked(ctx context.Context, in *GetSecretPasswordMaskedRequest) (*GetSecretPasswordMaskedResponse, error) {
+	res, err := s.srv.GetSecretPasswordMasked(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// CreateRetrievalToken is the redacted wrapper for the actual WardenSecretServiceServer.CreateRetrievalToken method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) CreateRetrievalToken(ctx context.Context, in *CreateRetrievalTokenRequest) (*CreateRetrievalTokenResponse, error) {
+	res, err := s.srv.CreateRetrievalToken(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RedeemRetrievalToken is the redacted wrapper for the actual WardenSecretServiceServer.RedeemRetrievalToken method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) RedeemRetrievalToken(ctx context.Context, in *RedeemRetrievalTokenRequest) (*RedeemRetrievalTokenResponse, error) {
+	res, err := s.srv.RedeemRetrievalToken(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetSecretByPath is the redacted wrapper for the actual WardenSecretServiceServer.GetSecretByPath method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) GetSecretByPath(ctx context.Context, in *GetSecretByPathRequest) (*GetSecretByPathResponse, error) {
+	res, err := s.srv.GetSecretByPath(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// FetchSecretsStream is the redacted wrapper for the actual WardenSecretServiceServer.FetchSecretsStream method
+// Server streaming
+func (s *redactedWardenSecretServiceServer) FetchSecretsStream(in *FetchSecretsStreamRequest, stream grpc.ServerStreamingServer[FetchSecretsStreamResponse]) error {
+	// Note: Redaction for server streaming is not fully implemented
+	// Streaming methods pass through without redaction
+	return s.srv.FetchSecretsStream(in, stream)
+}
+
 // ListSecrets is the redacted wrapper for the actual WardenSecretServiceServer.ListSecrets method
 // Unary RPC
 func (s *redactedWardenSecretServiceServer) ListSecrets(ctx context.Context, in *ListSecretsRequest) (*ListSecretsResponse, error) {
@@ -170,6 +222,61 @@ func (s *redactedWardenSecretServiceServer) RestoreVersion(ctx context.Context,
 	return res, err
 }
 
+// DeleteVersion is the redacted wrapper for the actual WardenSecretServiceServer.DeleteVersion method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) DeleteVersion(ctx context.Context, in *DeleteVersionRequest) (*DeleteVersionResponse, error) {
+	res, err := s.srv.DeleteVersion(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// DestroyVersion is the redacted wrapper for the actual WardenSecretServiceServer.DestroyVersion method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) DestroyVersion(ctx context.Context, in *DestroyVersionRequest) (*DestroyVersionResponse, error) {
+	res, err := s.srv.DestroyVersion(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// UndeleteVersion is the redacted wrapper for the actual WardenSecretServiceServer.UndeleteVersion method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) UndeleteVersion(ctx context.Context, in *UndeleteVersionRequest) (*UndeleteVersionResponse, error) {
+	res, err := s.srv.UndeleteVersion(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListVersionPins is the redacted wrapper for the actual WardenSecretServiceServer.ListVersionPins method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) ListVersionPins(ctx context.Context, in *ListVersionPinsRequest) (*ListVersionPinsResponse, error) {
+	res, err := s.srv.ListVersionPins(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// DeleteVersionPin is the redacted wrapper for the actual WardenSecretServiceServer.DeleteVersionPin method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) DeleteVersionPin(ctx context.Context, in *DeleteVersionPinRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.DeleteVersionPin(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // SearchSecrets is the redacted wrapper for the actual WardenSecretServiceServer.SearchSecrets method
 // Unary RPC
 func (s *redactedWardenSecretServiceServer) SearchSecrets(ctx context.Context, in *SearchSecretsRequest) (*SearchSecretsResponse, error) {
@@ -214,6 +321,39 @@ func (s *redactedWardenSecretServiceServer) DeleteSecretTotp(ctx context.Context
 	return res, err
 }
 
+// SetSecretAccessPolicy is the redacted wrapper for the actual WardenSecretServiceServer.SetSecretAccessPolicy method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) SetSecretAccessPolicy(ctx context.Context, in *SetSecretAccessPolicyRequest) (*SetSecretAccessPolicyResponse, error) {
+	res, err := s.srv.SetSecretAccessPolicy(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetSecretUsage is the redacted wrapper for the actual WardenSecretServiceServer.GetSecretUsage method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) GetSecretUsage(ctx context.Context, in *GetSecretUsageRequest) (*GetSecretUsageResponse, error) {
+	res, err := s.srv.GetSecretUsage(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetSecretGraph is the redacted wrapper for the actual WardenSecretServiceServer.GetSecretGraph method
+// Unary RPC
+func (s *redactedWardenSecretServiceServer) GetSecretGraph(ctx context.Context, in *GetSecretGraphRequest) (*GetSecretGraphResponse, error) {
+	res, err := s.srv.GetSecretGraph(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for Secret
 func (x *Secret) Redact() string {
 	if x == nil {
@@ -251,6 +391,50 @@ func (x *Secret) Redact() string {
 	// Safe field: UpdatedBy
 
 	// Safe field: HasTotp
+
+	// Safe field: LastAccessedTime
+
+	// Safe field: Revision
+
+	// Safe field: Sensitive
+
+	// Safe field: PasswordEncoding
+
+	// Safe field: AccessPolicy
+
+	// Safe field: Canary
+
+	// Safe field: Protected
+
+	// Safe field: ReferencedSecretIds
+	return x.String()
+}
+
+// Redact method implementation for AccessPolicy
+func (x *AccessPolicy) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: AllowedCidrs
+
+	// Safe field: Weekdays
+
+	// Safe field: TimeWindows
+
+	// Safe field: Timezone
+	return x.String()
+}
+
+// Redact method implementation for TimeWindow
+func (x *TimeWindow) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Start
+
+	// Safe field: End
 	return x.String()
 }
 
@@ -273,6 +457,32 @@ func (x *SecretVersion) Redact() string {
 	// Safe field: CreateTime
 
 	// Safe field: CreatedBy
+
+	// Safe field: SourceVersion
+
+	// Safe field: VaultState
+
+	// Safe field: ChecksumAlgorithm
+	return x.String()
+}
+
+// Redact method implementation for VaultVersionState
+func (x *VaultVersionState) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Retrievable
+
+	// Safe field: Deleted
+
+	// Safe field: Destroyed
+
+	// Safe field: Missing
+
+	// Safe field: CreateTime
+
+	// Safe field: DeletionTime
 	return x.String()
 }
 
@@ -317,6 +527,10 @@ func (x *CreateSecretRequest) Redact() string {
 
 	// Redacting field: TotpUrl
 	x.TotpUrl = ``
+
+	// Safe field: PasswordEncoding
+
+	// Safe field: Canary
 	return x.String()
 }
 
@@ -337,6 +551,8 @@ func (x *GetSecretRequest) Redact() string {
 	}
 
 	// Safe field: Id
+
+	// Safe field: ResolveReferences
 	return x.String()
 }
 
@@ -359,6 +575,12 @@ func (x *GetSecretPasswordRequest) Redact() string {
 	// Safe field: Id
 
 	// Safe field: Version
+
+	// Safe field: Pin
+
+	// Redacting field: PinToken
+	PinTokenTmp := ``
+	x.PinToken = &PinTokenTmp
 	return x.String()
 }
 
@@ -372,179 +594,438 @@ func (x *GetSecretPasswordResponse) Redact() string {
 	x.Password = ``
 
 	// Safe field: Version
+
+	// Safe field: Encoding
+
+	// Safe field: Pin
+
+	// Redacting field: PinToken
+	PinTokenTmp := ``
+	x.PinToken = &PinTokenTmp
 	return x.String()
 }
 
-// Redact method implementation for ListSecretsRequest
-func (x *ListSecretsRequest) Redact() string {
+// Redact method implementation for VersionPin
+func (x *VersionPin) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: FolderId
+	// Safe field: Id
 
-	// Safe field: Page
+	// Safe field: SecretId
 
-	// Safe field: PageSize
+	// Safe field: VersionNumber
 
-	// Safe field: Status
+	// Safe field: Consumer
 
-	// Safe field: NameFilter
+	// Safe field: CurrentVersion
+
+	// Safe field: Stale
+
+	// Safe field: LastUsedTime
+
+	// Safe field: CreateTime
 	return x.String()
 }
 
-// Redact method implementation for ListSecretsResponse
-func (x *ListSecretsResponse) Redact() string {
+// Redact method implementation for GetSecretPasswordMaskedRequest
+func (x *GetSecretPasswordMaskedRequest) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: Secrets
+	// Safe field: Id
 
-	// Safe field: Total
+	// Safe field: Version
 	return x.String()
 }
 
-// Redact method implementation for UpdateSecretRequest
-func (x *UpdateSecretRequest) Redact() string {
+// Redact method implementation for GetSecretPasswordMaskedResponse
+func (x *GetSecretPasswordMaskedResponse) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: Id
-
-	// Safe field: Name
-
-	// Safe field: Username
+	// Safe field: Length
 
-	// Safe field: HostUrl
+	// Safe field: FirstChar
 
-	// Safe field: Description
+	// Safe field: LastChar
 
-	// Safe field: Metadata
+	// Safe field: ChecksumPrefix
 
-	// Safe field: Status
-	return x.String()
-}
+	// Safe field: Version
 
-// Redact method implementation for UpdateSecretResponse
-func (x *UpdateSecretResponse) Redact() string {
-	if x == nil {
-		return ""
-	}
+	// Safe field: Encoding
 
-	// Safe field: Secret
+	// Safe field: ChecksumAlgorithm
 	return x.String()
 }
 
-// Redact method implementation for UpdateSecretPasswordRequest
-func (x *UpdateSecretPasswordRequest) Redact() string {
+// Redact method implementation for CreateRetrievalTokenRequest
+func (x *CreateRetrievalTokenRequest) Redact() string {
 	if x == nil {
 		return ""
 	}
 
 	// Safe field: Id
 
-	// Redacting field: Password
-	x.Password = ``
+	// Safe field: Version
 
-	// Safe field: Comment
+	// Safe field: TtlSeconds
 	return x.String()
 }
 
-// Redact method implementation for UpdateSecretPasswordResponse
-func (x *UpdateSecretPasswordResponse) Redact() string {
+// Redact method implementation for CreateRetrievalTokenResponse
+func (x *CreateRetrievalTokenResponse) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: Secret
+	// Redacting field: Token
+	x.Token = ``
 
 	// Safe field: Version
+
+	// Safe field: ExpiresAt
 	return x.String()
 }
 
-// Redact method implementation for DeleteSecretRequest
-func (x *DeleteSecretRequest) Redact() string {
+// Redact method implementation for RedeemRetrievalTokenRequest
+func (x *RedeemRetrievalTokenRequest) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: Id
-
-	// Safe field: Permanent
+	// Redacting field: Token
+	x.Token = ``
 	return x.String()
 }
 
-// Redact method implementation for MoveSecretRequest
-func (x *MoveSecretRequest) Redact() string {
+// Redact method implementation for RedeemRetrievalTokenResponse
+func (x *RedeemRetrievalTokenResponse) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: Id
+	// Safe field: SecretId
 
-	// Safe field: NewFolderId
-	return x.String()
-}
+	// Redacting field: Password
+	x.Password = ``
 
-// Redact method implementation for MoveSecretResponse
-func (x *MoveSecretResponse) Redact() string {
-	if x == nil {
-		return ""
-	}
+	// Safe field: Version
 
-	// Safe field: Secret
+	// Safe field: Encoding
 	return x.String()
 }
 
-// Redact method implementation for ListVersionsRequest
-func (x *ListVersionsRequest) Redact() string {
+// Redact method implementation for GetSecretByPathRequest
+func (x *GetSecretByPathRequest) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: SecretId
+	// Safe field: FolderPath
 
-	// Safe field: Page
+	// Safe field: Name
 
-	// Safe field: PageSize
+	// Safe field: MetadataKeys
+
+	// Safe field: ResolveReferences
 	return x.String()
 }
 
-// Redact method implementation for ListVersionsResponse
-func (x *ListVersionsResponse) Redact() string {
+// Redact method implementation for GetSecretByPathResponse
+func (x *GetSecretByPathResponse) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: Versions
+	// Safe field: Id
 
-	// Safe field: Total
+	// Redacting field: Password
+	x.Password = ``
+
+	// Safe field: Version
+
+	// Safe field: Username
+
+	// Safe field: HostUrl
+
+	// Safe field: Metadata
+
+	// Safe field: UpdateTime
 	return x.String()
 }
 
-// Redact method implementation for GetVersionRequest
-func (x *GetVersionRequest) Redact() string {
+// Redact method implementation for FetchSecretsStreamRequest
+func (x *FetchSecretsStreamRequest) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: SecretId
+	// Safe field: Paths
 
-	// Safe field: VersionNumber
+	// Safe field: MetadataKeys
 
-	// Safe field: IncludePassword
+	// Safe field: LabelSelector
 	return x.String()
 }
 
-// Redact method implementation for GetVersionResponse
-func (x *GetVersionResponse) Redact() string {
+// Redact method implementation for StreamedSecret
+func (x *StreamedSecret) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: Version
+	// Safe field: Path
+
+	// Safe field: Missing
+
+	// Safe field: Id
+
+	// Redacting field: Password
+	x.Password = ``
+
+	// Safe field: Version
+
+	// Safe field: Username
+
+	// Safe field: HostUrl
+
+	// Safe field: Metadata
+
+	// Safe field: UpdateTime
+	return x.String()
+}
+
+// Redact method implementation for FetchSecretsStreamResponse
+func (x *FetchSecretsStreamResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Initial
+
+	// Safe field: Secrets
+	return x.String()
+}
+
+// Redact method implementation for ListSecretsRequest
+func (x *ListSecretsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FolderId
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+
+	// Safe field: Status
+
+	// Safe field: NameFilter
+
+	// Safe field: Cursor
+
+	// Safe field: SortBy
+
+	// Safe field: SortOrder
+
+	// Safe field: NotAccessedSince
+
+	// Safe field: AccessibleOnly
+
+	// Safe field: IdsOnly
+
+	// Safe field: CountOnly
+
+	// Safe field: LabelSelector
+	return x.String()
+}
+
+// Redact method implementation for ListSecretsResponse
+func (x *ListSecretsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Secrets
+
+	// Safe field: Total
+
+	// Safe field: NextCursor
+
+	// Safe field: Ids
+	return x.String()
+}
+
+// Redact method implementation for UpdateSecretRequest
+func (x *UpdateSecretRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Name
+
+	// Safe field: Username
+
+	// Safe field: HostUrl
+
+	// Safe field: Description
+
+	// Safe field: Metadata
+
+	// Safe field: Status
+
+	// Safe field: ExpectedRevision
+
+	// Safe field: Sensitive
+
+	// Safe field: Canary
+
+	// Safe field: Protected
+	return x.String()
+}
+
+// Redact method implementation for UpdateSecretResponse
+func (x *UpdateSecretResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Secret
+	return x.String()
+}
+
+// Redact method implementation for UpdateSecretPasswordRequest
+func (x *UpdateSecretPasswordRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Redacting field: Password
+	x.Password = ``
+
+	// Safe field: Comment
+
+	// Safe field: PasswordEncoding
+	return x.String()
+}
+
+// Redact method implementation for UpdateSecretPasswordResponse
+func (x *UpdateSecretPasswordResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Secret
+
+	// Safe field: Version
+
+	// Safe field: StalePins
+	return x.String()
+}
+
+// Redact method implementation for DeleteSecretRequest
+func (x *DeleteSecretRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Permanent
+
+	// Safe field: Force
+	return x.String()
+}
+
+// Redact method implementation for MoveSecretRequest
+func (x *MoveSecretRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: NewFolderId
+	return x.String()
+}
+
+// Redact method implementation for MoveSecretResponse
+func (x *MoveSecretResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Secret
+	return x.String()
+}
+
+// Redact method implementation for ListVersionsRequest
+func (x *ListVersionsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+
+	// Safe field: Cursor
+
+	// Safe field: RestoredOnly
+
+	// Safe field: IncludeVaultState
+
+	// Safe field: RetrievableOnly
+	return x.String()
+}
+
+// Redact method implementation for ListVersionsResponse
+func (x *ListVersionsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Versions
+
+	// Safe field: Total
+
+	// Safe field: NextCursor
+	return x.String()
+}
+
+// Redact method implementation for GetVersionRequest
+func (x *GetVersionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: VersionNumber
+
+	// Safe field: IncludePassword
+	return x.String()
+}
+
+// Redact method implementation for GetVersionResponse
+func (x *GetVersionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Version
 
 	// Redacting field: Password
 	PasswordTmp := ``
@@ -578,6 +1059,106 @@ func (x *RestoreVersionResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for DeleteVersionRequest
+func (x *DeleteVersionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: VersionNumber
+	return x.String()
+}
+
+// Redact method implementation for DeleteVersionResponse
+func (x *DeleteVersionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Version
+	return x.String()
+}
+
+// Redact method implementation for DestroyVersionRequest
+func (x *DestroyVersionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: VersionNumber
+	return x.String()
+}
+
+// Redact method implementation for DestroyVersionResponse
+func (x *DestroyVersionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Version
+	return x.String()
+}
+
+// Redact method implementation for UndeleteVersionRequest
+func (x *UndeleteVersionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: VersionNumber
+	return x.String()
+}
+
+// Redact method implementation for UndeleteVersionResponse
+func (x *UndeleteVersionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Version
+	return x.String()
+}
+
+// Redact method implementation for ListVersionPinsRequest
+func (x *ListVersionPinsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: StaleOnly
+	return x.String()
+}
+
+// Redact method implementation for ListVersionPinsResponse
+func (x *ListVersionPinsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Pins
+	return x.String()
+}
+
+// Redact method implementation for DeleteVersionPinRequest
+func (x *DeleteVersionPinRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: PinId
+	return x.String()
+}
+
 // Redact method implementation for SearchSecretsRequest
 func (x *SearchSecretsRequest) Redact() string {
 	if x == nil {
@@ -595,6 +1176,10 @@ func (x *SearchSecretsRequest) Redact() string {
 	// Safe field: PageSize
 
 	// Safe field: Status
+
+	// Safe field: IdsOnly
+
+	// Safe field: CountOnly
 	return x.String()
 }
 
@@ -607,6 +1192,22 @@ func (x *SearchSecretsResponse) Redact() string {
 	// Safe field: Secrets
 
 	// Safe field: Total
+
+	// Safe field: Hits
+
+	// Safe field: Ids
+	return x.String()
+}
+
+// Redact method implementation for SecretSearchHit
+func (x *SecretSearchHit) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: Highlights
 	return x.String()
 }
 
@@ -671,3 +1272,149 @@ func (x *DeleteSecretTotpRequest) Redact() string {
 	// Safe field: Id
 	return x.String()
 }
+
+// Redact method implementation for SetSecretAccessPolicyRequest
+func (x *SetSecretAccessPolicyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Policy
+	return x.String()
+}
+
+// Redact method implementation for SetSecretAccessPolicyResponse
+func (x *SetSecretAccessPolicyResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Secret
+	return x.String()
+}
+
+// Redact method implementation for GetSecretUsageRequest
+func (x *GetSecretUsageRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Days
+	return x.String()
+}
+
+// Redact method implementation for GetSecretUsageResponse
+func (x *GetSecretUsageResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Usage
+
+	// Safe field: Daily
+	return x.String()
+}
+
+// Redact method implementation for SecretUsage
+func (x *SecretUsage) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: Name
+
+	// Safe field: FolderId
+
+	// Safe field: Reads
+
+	// Safe field: Writes
+
+	// Safe field: LastUsedDate
+	return x.String()
+}
+
+// Redact method implementation for DailyUsage
+func (x *DailyUsage) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Date
+
+	// Safe field: Reads
+
+	// Safe field: Writes
+	return x.String()
+}
+
+// Redact method implementation for GetSecretGraphRequest
+func (x *GetSecretGraphRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: ReaderDays
+	return x.String()
+}
+
+// Redact method implementation for GetSecretGraphResponse
+func (x *GetSecretGraphResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: RootNodeId
+
+	// Safe field: Nodes
+
+	// Safe field: Edges
+
+	// Safe field: HiddenDependents
+	return x.String()
+}
+
+// Redact method implementation for SecretGraphNode
+func (x *SecretGraphNode) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Kind
+
+	// Safe field: ResourceId
+
+	// Safe field: Label
+	return x.String()
+}
+
+// Redact method implementation for SecretGraphEdge
+func (x *SecretGraphEdge) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: From
+
+	// Safe field: To
+
+	// Safe field: Kind
+
+	// Safe field: Label
+
+	// Safe field: ExpiresAt
+
+	// Safe field: Count
+
+	// Safe field: LastTime
+	return x.String()
+}
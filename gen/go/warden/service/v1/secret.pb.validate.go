@@ -163,6 +163,45 @@ func (m *Secret) validate(all bool) error {
 
 	// no validation rules for HasTotp
 
+	// no validation rules for Revision
+
+	// no validation rules for Sensitive
+
+	// no validation rules for PasswordEncoding
+
+	if all {
+		switch v := interface{}(m.GetAccessPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretValidationError{
+					field:  "AccessPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretValidationError{
+					field:  "AccessPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccessPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretValidationError{
+				field:  "AccessPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Canary
+
+	// no validation rules for Protected
+
 	if m.FolderId != nil {
 		// no validation rules for FolderId
 	}
@@ -175,6 +214,39 @@ func (m *Secret) validate(all bool) error {
 		// no validation rules for UpdatedBy
 	}
 
+	if m.LastAccessedTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastAccessedTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecretValidationError{
+						field:  "LastAccessedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecretValidationError{
+						field:  "LastAccessedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastAccessedTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecretValidationError{
+					field:  "LastAccessedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SecretMultiError(errors)
 	}
@@ -252,85 +324,77 @@ var _ interface {
 	ErrorName() string
 } = SecretValidationError{}
 
-// Validate checks the field values on SecretVersion with the rules defined in
+// Validate checks the field values on AccessPolicy with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *SecretVersion) Validate() error {
+func (m *AccessPolicy) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecretVersion with the rules defined
+// ValidateAll checks the field values on AccessPolicy with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SecretVersionMultiError, or
+// result is a list of violation errors wrapped in AccessPolicyMultiError, or
 // nil if none found.
-func (m *SecretVersion) ValidateAll() error {
+func (m *AccessPolicy) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecretVersion) validate(all bool) error {
+func (m *AccessPolicy) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for SecretId
-
-	// no validation rules for VersionNumber
-
-	// no validation rules for Comment
-
-	// no validation rules for Checksum
+	for idx, item := range m.GetTimeWindows() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetCreateTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SecretVersionValidationError{
-					field:  "CreateTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessPolicyValidationError{
+						field:  fmt.Sprintf("TimeWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessPolicyValidationError{
+						field:  fmt.Sprintf("TimeWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SecretVersionValidationError{
-					field:  "CreateTime",
+				return AccessPolicyValidationError{
+					field:  fmt.Sprintf("TimeWindows[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SecretVersionValidationError{
-				field:  "CreateTime",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if m.CreatedBy != nil {
-		// no validation rules for CreatedBy
 	}
 
+	// no validation rules for Timezone
+
 	if len(errors) > 0 {
-		return SecretVersionMultiError(errors)
+		return AccessPolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecretVersionMultiError is an error wrapping multiple validation errors
-// returned by SecretVersion.ValidateAll() if the designated constraints
-// aren't met.
-type SecretVersionMultiError []error
+// AccessPolicyMultiError is an error wrapping multiple validation errors
+// returned by AccessPolicy.ValidateAll() if the designated constraints aren't met.
+type AccessPolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecretVersionMultiError) Error() string {
+func (m AccessPolicyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -339,11 +403,11 @@ func (m SecretVersionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecretVersionMultiError) AllErrors() []error { return m }
+func (m AccessPolicyMultiError) AllErrors() []error { return m }
 
-// SecretVersionValidationError is the validation error returned by
-// SecretVersion.Validate if the designated constraints aren't met.
-type SecretVersionValidationError struct {
+// AccessPolicyValidationError is the validation error returned by
+// AccessPolicy.Validate if the designated constraints aren't met.
+type AccessPolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -351,22 +415,22 @@ type SecretVersionValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecretVersionValidationError) Field() string { return e.field }
+func (e AccessPolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecretVersionValidationError) Reason() string { return e.reason }
+func (e AccessPolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecretVersionValidationError) Cause() error { return e.cause }
+func (e AccessPolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecretVersionValidationError) Key() bool { return e.key }
+func (e AccessPolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecretVersionValidationError) ErrorName() string { return "SecretVersionValidationError" }
+func (e AccessPolicyValidationError) ErrorName() string { return "AccessPolicyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SecretVersionValidationError) Error() string {
+func (e AccessPolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -378,14 +442,14 @@ func (e SecretVersionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecretVersion.%s: %s%s",
+		"invalid %sAccessPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecretVersionValidationError{}
+var _ error = AccessPolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -393,50 +457,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecretVersionValidationError{}
+} = AccessPolicyValidationError{}
 
-// Validate checks the field values on InitialPermissionGrant with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InitialPermissionGrant) Validate() error {
+// Validate checks the field values on TimeWindow with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimeWindow) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InitialPermissionGrant with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// InitialPermissionGrantMultiError, or nil if none found.
-func (m *InitialPermissionGrant) ValidateAll() error {
+// ValidateAll checks the field values on TimeWindow with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimeWindowMultiError, or
+// nil if none found.
+func (m *TimeWindow) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitialPermissionGrant) validate(all bool) error {
+func (m *TimeWindow) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SubjectType
-
-	// no validation rules for SubjectId
+	// no validation rules for Start
 
-	// no validation rules for Relation
+	// no validation rules for End
 
 	if len(errors) > 0 {
-		return InitialPermissionGrantMultiError(errors)
+		return TimeWindowMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitialPermissionGrantMultiError is an error wrapping multiple validation
-// errors returned by InitialPermissionGrant.ValidateAll() if the designated
-// constraints aren't met.
-type InitialPermissionGrantMultiError []error
+// TimeWindowMultiError is an error wrapping multiple validation errors
+// returned by TimeWindow.ValidateAll() if the designated constraints aren't met.
+type TimeWindowMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitialPermissionGrantMultiError) Error() string {
+func (m TimeWindowMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -445,11 +506,11 @@ func (m InitialPermissionGrantMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitialPermissionGrantMultiError) AllErrors() []error { return m }
+func (m TimeWindowMultiError) AllErrors() []error { return m }
 
-// InitialPermissionGrantValidationError is the validation error returned by
-// InitialPermissionGrant.Validate if the designated constraints aren't met.
-type InitialPermissionGrantValidationError struct {
+// TimeWindowValidationError is the validation error returned by
+// TimeWindow.Validate if the designated constraints aren't met.
+type TimeWindowValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -457,24 +518,22 @@ type InitialPermissionGrantValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitialPermissionGrantValidationError) Field() string { return e.field }
+func (e TimeWindowValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitialPermissionGrantValidationError) Reason() string { return e.reason }
+func (e TimeWindowValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitialPermissionGrantValidationError) Cause() error { return e.cause }
+func (e TimeWindowValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitialPermissionGrantValidationError) Key() bool { return e.key }
+func (e TimeWindowValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitialPermissionGrantValidationError) ErrorName() string {
-	return "InitialPermissionGrantValidationError"
-}
+func (e TimeWindowValidationError) ErrorName() string { return "TimeWindowValidationError" }
 
 // Error satisfies the builtin error interface
-func (e InitialPermissionGrantValidationError) Error() string {
+func (e TimeWindowValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -486,14 +545,14 @@ func (e InitialPermissionGrantValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitialPermissionGrant.%s: %s%s",
+		"invalid %sTimeWindow.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitialPermissionGrantValidationError{}
+var _ error = TimeWindowValidationError{}
 
 var _ interface {
 	Field() string
@@ -501,97 +560,104 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitialPermissionGrantValidationError{}
+} = TimeWindowValidationError{}
 
-// Validate checks the field values on CreateSecretRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSecretRequest) Validate() error {
+// Validate checks the field values on SecretVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretVersion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSecretRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateSecretRequestMultiError, or nil if none found.
-func (m *CreateSecretRequest) ValidateAll() error {
+// ValidateAll checks the field values on SecretVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretVersionMultiError, or
+// nil if none found.
+func (m *SecretVersion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSecretRequest) validate(all bool) error {
+func (m *SecretVersion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Id
 
-	// no validation rules for Username
+	// no validation rules for SecretId
 
-	// no validation rules for Password
+	// no validation rules for VersionNumber
 
-	// no validation rules for HostUrl
+	// no validation rules for Comment
 
-	// no validation rules for Description
+	// no validation rules for Checksum
 
 	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
+		switch v := interface{}(m.GetCreateTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateSecretRequestValidationError{
-					field:  "Metadata",
+				errors = append(errors, SecretVersionValidationError{
+					field:  "CreateTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateSecretRequestValidationError{
-					field:  "Metadata",
+				errors = append(errors, SecretVersionValidationError{
+					field:  "CreateTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateSecretRequestValidationError{
-				field:  "Metadata",
+			return SecretVersionValidationError{
+				field:  "CreateTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for VersionComment
+	// no validation rules for ChecksumAlgorithm
 
-	for idx, item := range m.GetInitialPermissions() {
-		_, _ = idx, item
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.SourceVersion != nil {
+		// no validation rules for SourceVersion
+	}
+
+	if m.VaultState != nil {
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetVaultState()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateSecretRequestValidationError{
-						field:  fmt.Sprintf("InitialPermissions[%v]", idx),
+					errors = append(errors, SecretVersionValidationError{
+						field:  "VaultState",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateSecretRequestValidationError{
-						field:  fmt.Sprintf("InitialPermissions[%v]", idx),
+					errors = append(errors, SecretVersionValidationError{
+						field:  "VaultState",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetVaultState()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateSecretRequestValidationError{
-					field:  fmt.Sprintf("InitialPermissions[%v]", idx),
+				return SecretVersionValidationError{
+					field:  "VaultState",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -600,26 +666,20 @@ func (m *CreateSecretRequest) validate(all bool) error {
 
 	}
 
-	// no validation rules for TotpUrl
-
-	if m.FolderId != nil {
-		// no validation rules for FolderId
-	}
-
 	if len(errors) > 0 {
-		return CreateSecretRequestMultiError(errors)
+		return SecretVersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSecretRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateSecretRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateSecretRequestMultiError []error
+// SecretVersionMultiError is an error wrapping multiple validation errors
+// returned by SecretVersion.ValidateAll() if the designated constraints
+// aren't met.
+type SecretVersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSecretRequestMultiError) Error() string {
+func (m SecretVersionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -628,11 +688,11 @@ func (m CreateSecretRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSecretRequestMultiError) AllErrors() []error { return m }
+func (m SecretVersionMultiError) AllErrors() []error { return m }
 
-// CreateSecretRequestValidationError is the validation error returned by
-// CreateSecretRequest.Validate if the designated constraints aren't met.
-type CreateSecretRequestValidationError struct {
+// SecretVersionValidationError is the validation error returned by
+// SecretVersion.Validate if the designated constraints aren't met.
+type SecretVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -640,24 +700,22 @@ type CreateSecretRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSecretRequestValidationError) Field() string { return e.field }
+func (e SecretVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSecretRequestValidationError) Reason() string { return e.reason }
+func (e SecretVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSecretRequestValidationError) Cause() error { return e.cause }
+func (e SecretVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSecretRequestValidationError) Key() bool { return e.key }
+func (e SecretVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSecretRequestValidationError) ErrorName() string {
-	return "CreateSecretRequestValidationError"
-}
+func (e SecretVersionValidationError) ErrorName() string { return "SecretVersionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateSecretRequestValidationError) Error() string {
+func (e SecretVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -669,14 +727,14 @@ func (e CreateSecretRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSecretRequest.%s: %s%s",
+		"invalid %sSecretVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSecretRequestValidationError{}
+var _ error = SecretVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -684,73 +742,118 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSecretRequestValidationError{}
+} = SecretVersionValidationError{}
 
-// Validate checks the field values on CreateSecretResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSecretResponse) Validate() error {
+// Validate checks the field values on VaultVersionState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VaultVersionState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSecretResponse with the rules
+// ValidateAll checks the field values on VaultVersionState with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSecretResponseMultiError, or nil if none found.
-func (m *CreateSecretResponse) ValidateAll() error {
+// VaultVersionStateMultiError, or nil if none found.
+func (m *VaultVersionState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSecretResponse) validate(all bool) error {
+func (m *VaultVersionState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateSecretResponseValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Retrievable
+
+	// no validation rules for Deleted
+
+	// no validation rules for Destroyed
+
+	// no validation rules for Missing
+
+	if m.CreateTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreateTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VaultVersionStateValidationError{
+						field:  "CreateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VaultVersionStateValidationError{
+						field:  "CreateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateSecretResponseValidationError{
-					field:  "Secret",
+				return VaultVersionStateValidationError{
+					field:  "CreateTime",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateSecretResponseValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	}
+
+	if m.DeletionTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetDeletionTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VaultVersionStateValidationError{
+						field:  "DeletionTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VaultVersionStateValidationError{
+						field:  "DeletionTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeletionTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VaultVersionStateValidationError{
+					field:  "DeletionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateSecretResponseMultiError(errors)
+		return VaultVersionStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSecretResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateSecretResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateSecretResponseMultiError []error
+// VaultVersionStateMultiError is an error wrapping multiple validation errors
+// returned by VaultVersionState.ValidateAll() if the designated constraints
+// aren't met.
+type VaultVersionStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSecretResponseMultiError) Error() string {
+func (m VaultVersionStateMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -759,11 +862,11 @@ func (m CreateSecretResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSecretResponseMultiError) AllErrors() []error { return m }
+func (m VaultVersionStateMultiError) AllErrors() []error { return m }
 
-// CreateSecretResponseValidationError is the validation error returned by
-// CreateSecretResponse.Validate if the designated constraints aren't met.
-type CreateSecretResponseValidationError struct {
+// VaultVersionStateValidationError is the validation error returned by
+// VaultVersionState.Validate if the designated constraints aren't met.
+type VaultVersionStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -771,24 +874,24 @@ type CreateSecretResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSecretResponseValidationError) Field() string { return e.field }
+func (e VaultVersionStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSecretResponseValidationError) Reason() string { return e.reason }
+func (e VaultVersionStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSecretResponseValidationError) Cause() error { return e.cause }
+func (e VaultVersionStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSecretResponseValidationError) Key() bool { return e.key }
+func (e VaultVersionStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSecretResponseValidationError) ErrorName() string {
-	return "CreateSecretResponseValidationError"
+func (e VaultVersionStateValidationError) ErrorName() string {
+	return "VaultVersionStateValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSecretResponseValidationError) Error() string {
+func (e VaultVersionStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -800,14 +903,14 @@ func (e CreateSecretResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSecretResponse.%s: %s%s",
+		"invalid %sVaultVersionState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSecretResponseValidationError{}
+var _ error = VaultVersionStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -815,46 +918,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSecretResponseValidationError{}
+} = VaultVersionStateValidationError{}
 
-// Validate checks the field values on GetSecretRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetSecretRequest) Validate() error {
+// Validate checks the field values on InitialPermissionGrant with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitialPermissionGrant) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSecretRequest with the rules
+// ValidateAll checks the field values on InitialPermissionGrant with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSecretRequestMultiError, or nil if none found.
-func (m *GetSecretRequest) ValidateAll() error {
+// InitialPermissionGrantMultiError, or nil if none found.
+func (m *InitialPermissionGrant) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSecretRequest) validate(all bool) error {
+func (m *InitialPermissionGrant) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for SubjectType
+
+	// no validation rules for SubjectId
+
+	// no validation rules for Relation
 
 	if len(errors) > 0 {
-		return GetSecretRequestMultiError(errors)
+		return InitialPermissionGrantMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSecretRequestMultiError is an error wrapping multiple validation errors
-// returned by GetSecretRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetSecretRequestMultiError []error
+// InitialPermissionGrantMultiError is an error wrapping multiple validation
+// errors returned by InitialPermissionGrant.ValidateAll() if the designated
+// constraints aren't met.
+type InitialPermissionGrantMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSecretRequestMultiError) Error() string {
+func (m InitialPermissionGrantMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -863,11 +970,11 @@ func (m GetSecretRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSecretRequestMultiError) AllErrors() []error { return m }
+func (m InitialPermissionGrantMultiError) AllErrors() []error { return m }
 
-// GetSecretRequestValidationError is the validation error returned by
-// GetSecretRequest.Validate if the designated constraints aren't met.
-type GetSecretRequestValidationError struct {
+// InitialPermissionGrantValidationError is the validation error returned by
+// InitialPermissionGrant.Validate if the designated constraints aren't met.
+type InitialPermissionGrantValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -875,22 +982,24 @@ type GetSecretRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSecretRequestValidationError) Field() string { return e.field }
+func (e InitialPermissionGrantValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSecretRequestValidationError) Reason() string { return e.reason }
+func (e InitialPermissionGrantValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSecretRequestValidationError) Cause() error { return e.cause }
+func (e InitialPermissionGrantValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSecretRequestValidationError) Key() bool { return e.key }
+func (e InitialPermissionGrantValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSecretRequestValidationError) ErrorName() string { return "GetSecretRequestValidationError" }
+func (e InitialPermissionGrantValidationError) ErrorName() string {
+	return "InitialPermissionGrantValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetSecretRequestValidationError) Error() string {
+func (e InitialPermissionGrantValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -902,14 +1011,14 @@ func (e GetSecretRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSecretRequest.%s: %s%s",
+		"invalid %sInitialPermissionGrant.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSecretRequestValidationError{}
+var _ error = InitialPermissionGrantValidationError{}
 
 var _ interface {
 	Field() string
@@ -917,73 +1026,129 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSecretRequestValidationError{}
+} = InitialPermissionGrantValidationError{}
 
-// Validate checks the field values on GetSecretResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetSecretResponse) Validate() error {
+// Validate checks the field values on CreateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSecretRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSecretResponse with the rules
+// ValidateAll checks the field values on CreateSecretRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSecretResponseMultiError, or nil if none found.
-func (m *GetSecretResponse) ValidateAll() error {
+// CreateSecretRequestMultiError, or nil if none found.
+func (m *CreateSecretRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSecretResponse) validate(all bool) error {
+func (m *CreateSecretRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for HostUrl
+
+	// no validation rules for Description
+
 	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
+		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetSecretResponseValidationError{
-					field:  "Secret",
+				errors = append(errors, CreateSecretRequestValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetSecretResponseValidationError{
-					field:  "Secret",
+				errors = append(errors, CreateSecretRequestValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetSecretResponseValidationError{
-				field:  "Secret",
+			return CreateSecretRequestValidationError{
+				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if len(errors) > 0 {
-		return GetSecretResponseMultiError(errors)
+	// no validation rules for VersionComment
+
+	for idx, item := range m.GetInitialPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateSecretRequestValidationError{
+						field:  fmt.Sprintf("InitialPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateSecretRequestValidationError{
+						field:  fmt.Sprintf("InitialPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateSecretRequestValidationError{
+					field:  fmt.Sprintf("InitialPermissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	return nil
-}
+	// no validation rules for TotpUrl
 
-// GetSecretResponseMultiError is an error wrapping multiple validation errors
-// returned by GetSecretResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetSecretResponseMultiError []error
+	// no validation rules for PasswordEncoding
+
+	// no validation rules for Canary
+
+	if m.FolderId != nil {
+		// no validation rules for FolderId
+	}
+
+	if len(errors) > 0 {
+		return CreateSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSecretRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSecretResponseMultiError) Error() string {
+func (m CreateSecretRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -992,11 +1157,11 @@ func (m GetSecretResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSecretResponseMultiError) AllErrors() []error { return m }
+func (m CreateSecretRequestMultiError) AllErrors() []error { return m }
 
-// GetSecretResponseValidationError is the validation error returned by
-// GetSecretResponse.Validate if the designated constraints aren't met.
-type GetSecretResponseValidationError struct {
+// CreateSecretRequestValidationError is the validation error returned by
+// CreateSecretRequest.Validate if the designated constraints aren't met.
+type CreateSecretRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1004,24 +1169,24 @@ type GetSecretResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSecretResponseValidationError) Field() string { return e.field }
+func (e CreateSecretRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSecretResponseValidationError) Reason() string { return e.reason }
+func (e CreateSecretRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSecretResponseValidationError) Cause() error { return e.cause }
+func (e CreateSecretRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSecretResponseValidationError) Key() bool { return e.key }
+func (e CreateSecretRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSecretResponseValidationError) ErrorName() string {
-	return "GetSecretResponseValidationError"
+func (e CreateSecretRequestValidationError) ErrorName() string {
+	return "CreateSecretRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSecretResponseValidationError) Error() string {
+func (e CreateSecretRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1033,14 +1198,14 @@ func (e GetSecretResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSecretResponse.%s: %s%s",
+		"invalid %sCreateSecretRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSecretResponseValidationError{}
+var _ error = CreateSecretRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1048,50 +1213,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSecretResponseValidationError{}
+} = CreateSecretRequestValidationError{}
 
-// Validate checks the field values on GetSecretPasswordRequest with the rules
+// Validate checks the field values on CreateSecretResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSecretPasswordRequest) Validate() error {
+func (m *CreateSecretResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSecretPasswordRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateSecretResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSecretPasswordRequestMultiError, or nil if none found.
-func (m *GetSecretPasswordRequest) ValidateAll() error {
+// CreateSecretResponseMultiError, or nil if none found.
+func (m *CreateSecretResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSecretPasswordRequest) validate(all bool) error {
+func (m *CreateSecretResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if m.Version != nil {
-		// no validation rules for Version
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSecretResponseValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return GetSecretPasswordRequestMultiError(errors)
+		return CreateSecretResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSecretPasswordRequestMultiError is an error wrapping multiple validation
-// errors returned by GetSecretPasswordRequest.ValidateAll() if the designated
+// CreateSecretResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSecretResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetSecretPasswordRequestMultiError []error
+type CreateSecretResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSecretPasswordRequestMultiError) Error() string {
+func (m CreateSecretResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1100,11 +1288,11 @@ func (m GetSecretPasswordRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSecretPasswordRequestMultiError) AllErrors() []error { return m }
+func (m CreateSecretResponseMultiError) AllErrors() []error { return m }
 
-// GetSecretPasswordRequestValidationError is the validation error returned by
-// GetSecretPasswordRequest.Validate if the designated constraints aren't met.
-type GetSecretPasswordRequestValidationError struct {
+// CreateSecretResponseValidationError is the validation error returned by
+// CreateSecretResponse.Validate if the designated constraints aren't met.
+type CreateSecretResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1112,24 +1300,24 @@ type GetSecretPasswordRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSecretPasswordRequestValidationError) Field() string { return e.field }
+func (e CreateSecretResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSecretPasswordRequestValidationError) Reason() string { return e.reason }
+func (e CreateSecretResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSecretPasswordRequestValidationError) Cause() error { return e.cause }
+func (e CreateSecretResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSecretPasswordRequestValidationError) Key() bool { return e.key }
+func (e CreateSecretResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSecretPasswordRequestValidationError) ErrorName() string {
-	return "GetSecretPasswordRequestValidationError"
+func (e CreateSecretResponseValidationError) ErrorName() string {
+	return "CreateSecretResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSecretPasswordRequestValidationError) Error() string {
+func (e CreateSecretResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1141,14 +1329,14 @@ func (e GetSecretPasswordRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSecretPasswordRequest.%s: %s%s",
+		"invalid %sCreateSecretResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSecretPasswordRequestValidationError{}
+var _ error = CreateSecretResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1156,48 +1344,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSecretPasswordRequestValidationError{}
+} = CreateSecretResponseValidationError{}
 
-// Validate checks the field values on GetSecretPasswordResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSecretPasswordResponse) Validate() error {
+// Validate checks the field values on GetSecretRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSecretRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSecretPasswordResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSecretPasswordResponseMultiError, or nil if none found.
-func (m *GetSecretPasswordResponse) ValidateAll() error {
+// GetSecretRequestMultiError, or nil if none found.
+func (m *GetSecretRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSecretPasswordResponse) validate(all bool) error {
+func (m *GetSecretRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Password
+	// no validation rules for Id
 
-	// no validation rules for Version
+	// no validation rules for ResolveReferences
 
 	if len(errors) > 0 {
-		return GetSecretPasswordResponseMultiError(errors)
+		return GetSecretRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSecretPasswordResponseMultiError is an error wrapping multiple validation
-// errors returned by GetSecretPasswordResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetSecretPasswordResponseMultiError []error
+// GetSecretRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSecretRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSecretRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSecretPasswordResponseMultiError) Error() string {
+func (m GetSecretRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1206,11 +1394,11 @@ func (m GetSecretPasswordResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSecretPasswordResponseMultiError) AllErrors() []error { return m }
+func (m GetSecretRequestMultiError) AllErrors() []error { return m }
 
-// GetSecretPasswordResponseValidationError is the validation error returned by
-// GetSecretPasswordResponse.Validate if the designated constraints aren't met.
-type GetSecretPasswordResponseValidationError struct {
+// GetSecretRequestValidationError is the validation error returned by
+// GetSecretRequest.Validate if the designated constraints aren't met.
+type GetSecretRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1218,24 +1406,22 @@ type GetSecretPasswordResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSecretPasswordResponseValidationError) Field() string { return e.field }
+func (e GetSecretRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSecretPasswordResponseValidationError) Reason() string { return e.reason }
+func (e GetSecretRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSecretPasswordResponseValidationError) Cause() error { return e.cause }
+func (e GetSecretRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSecretPasswordResponseValidationError) Key() bool { return e.key }
+func (e GetSecretRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSecretPasswordResponseValidationError) ErrorName() string {
-	return "GetSecretPasswordResponseValidationError"
-}
+func (e GetSecretRequestValidationError) ErrorName() string { return "GetSecretRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetSecretPasswordResponseValidationError) Error() string {
+func (e GetSecretRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1247,14 +1433,14 @@ func (e GetSecretPasswordResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSecretPasswordResponse.%s: %s%s",
+		"invalid %sGetSecretRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSecretPasswordResponseValidationError{}
+var _ error = GetSecretRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1262,64 +1448,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSecretPasswordResponseValidationError{}
+} = GetSecretRequestValidationError{}
 
-// Validate checks the field values on ListSecretsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSecretsRequest) Validate() error {
+// Validate checks the field values on GetSecretResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSecretResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSecretsRequest with the rules
+// ValidateAll checks the field values on GetSecretResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSecretsRequestMultiError, or nil if none found.
-func (m *ListSecretsRequest) ValidateAll() error {
+// GetSecretResponseMultiError, or nil if none found.
+func (m *GetSecretResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSecretsRequest) validate(all bool) error {
+func (m *GetSecretResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.FolderId != nil {
-		// no validation rules for FolderId
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSecretResponseValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	if m.Page != nil {
-		// no validation rules for Page
+	if len(errors) > 0 {
+		return GetSecretResponseMultiError(errors)
 	}
 
-	if m.PageSize != nil {
-		// no validation rules for PageSize
-	}
+	return nil
+}
 
-	if m.Status != nil {
-		// no validation rules for Status
-	}
-
-	if m.NameFilter != nil {
-		// no validation rules for NameFilter
-	}
-
-	if len(errors) > 0 {
-		return ListSecretsRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// ListSecretsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListSecretsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListSecretsRequestMultiError []error
+// GetSecretResponseMultiError is an error wrapping multiple validation errors
+// returned by GetSecretResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetSecretResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSecretsRequestMultiError) Error() string {
+func (m GetSecretResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1328,11 +1523,11 @@ func (m ListSecretsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSecretsRequestMultiError) AllErrors() []error { return m }
+func (m GetSecretResponseMultiError) AllErrors() []error { return m }
 
-// ListSecretsRequestValidationError is the validation error returned by
-// ListSecretsRequest.Validate if the designated constraints aren't met.
-type ListSecretsRequestValidationError struct {
+// GetSecretResponseValidationError is the validation error returned by
+// GetSecretResponse.Validate if the designated constraints aren't met.
+type GetSecretResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1340,24 +1535,24 @@ type ListSecretsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSecretsRequestValidationError) Field() string { return e.field }
+func (e GetSecretResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSecretsRequestValidationError) Reason() string { return e.reason }
+func (e GetSecretResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSecretsRequestValidationError) Cause() error { return e.cause }
+func (e GetSecretResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSecretsRequestValidationError) Key() bool { return e.key }
+func (e GetSecretResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSecretsRequestValidationError) ErrorName() string {
-	return "ListSecretsRequestValidationError"
+func (e GetSecretResponseValidationError) ErrorName() string {
+	return "GetSecretResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSecretsRequestValidationError) Error() string {
+func (e GetSecretResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1369,14 +1564,14 @@ func (e ListSecretsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSecretsRequest.%s: %s%s",
+		"invalid %sGetSecretResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSecretsRequestValidationError{}
+var _ error = GetSecretResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1384,80 +1579,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSecretsRequestValidationError{}
+} = GetSecretResponseValidationError{}
 
-// Validate checks the field values on ListSecretsResponse with the rules
+// Validate checks the field values on GetSecretPasswordRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSecretsResponse) Validate() error {
+func (m *GetSecretPasswordRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSecretsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetSecretPasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSecretsResponseMultiError, or nil if none found.
-func (m *ListSecretsResponse) ValidateAll() error {
+// GetSecretPasswordRequestMultiError, or nil if none found.
+func (m *GetSecretPasswordRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSecretsResponse) validate(all bool) error {
+func (m *GetSecretPasswordRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSecrets() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListSecretsResponseValidationError{
-						field:  fmt.Sprintf("Secrets[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListSecretsResponseValidationError{
-						field:  fmt.Sprintf("Secrets[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListSecretsResponseValidationError{
-					field:  fmt.Sprintf("Secrets[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Pin
 
+	if m.Version != nil {
+		// no validation rules for Version
 	}
 
-	// no validation rules for Total
+	if m.PinToken != nil {
+		// no validation rules for PinToken
+	}
 
 	if len(errors) > 0 {
-		return ListSecretsResponseMultiError(errors)
+		return GetSecretPasswordRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSecretsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListSecretsResponse.ValidateAll() if the designated
+// GetSecretPasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSecretPasswordRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListSecretsResponseMultiError []error
+type GetSecretPasswordRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSecretsResponseMultiError) Error() string {
+func (m GetSecretPasswordRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1466,11 +1637,11 @@ func (m ListSecretsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSecretsResponseMultiError) AllErrors() []error { return m }
+func (m GetSecretPasswordRequestMultiError) AllErrors() []error { return m }
 
-// ListSecretsResponseValidationError is the validation error returned by
-// ListSecretsResponse.Validate if the designated constraints aren't met.
-type ListSecretsResponseValidationError struct {
+// GetSecretPasswordRequestValidationError is the validation error returned by
+// GetSecretPasswordRequest.Validate if the designated constraints aren't met.
+type GetSecretPasswordRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1478,24 +1649,24 @@ type ListSecretsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSecretsResponseValidationError) Field() string { return e.field }
+func (e GetSecretPasswordRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSecretsResponseValidationError) Reason() string { return e.reason }
+func (e GetSecretPasswordRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSecretsResponseValidationError) Cause() error { return e.cause }
+func (e GetSecretPasswordRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSecretsResponseValidationError) Key() bool { return e.key }
+func (e GetSecretPasswordRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSecretsResponseValidationError) ErrorName() string {
-	return "ListSecretsResponseValidationError"
+func (e GetSecretPasswordRequestValidationError) ErrorName() string {
+	return "GetSecretPasswordRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSecretsResponseValidationError) Error() string {
+func (e GetSecretPasswordRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1507,14 +1678,14 @@ func (e ListSecretsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSecretsResponse.%s: %s%s",
+		"invalid %sGetSecretPasswordRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSecretsResponseValidationError{}
+var _ error = GetSecretPasswordRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1522,73 +1693,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSecretsResponseValidationError{}
+} = GetSecretPasswordRequestValidationError{}
 
-// Validate checks the field values on UpdateSecretRequest with the rules
+// Validate checks the field values on GetSecretPasswordResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateSecretRequest) Validate() error {
+func (m *GetSecretPasswordResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateSecretRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetSecretPasswordResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateSecretRequestMultiError, or nil if none found.
-func (m *UpdateSecretRequest) ValidateAll() error {
+// GetSecretPasswordResponseMultiError, or nil if none found.
+func (m *GetSecretPasswordResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateSecretRequest) validate(all bool) error {
+func (m *GetSecretPasswordResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if m.Name != nil {
-		// no validation rules for Name
-	}
-
-	if m.Username != nil {
-		// no validation rules for Username
-	}
+	// no validation rules for Password
 
-	if m.HostUrl != nil {
-		// no validation rules for HostUrl
-	}
+	// no validation rules for Version
 
-	if m.Description != nil {
-		// no validation rules for Description
-	}
+	// no validation rules for Encoding
 
-	if m.Metadata != nil {
+	if m.Pin != nil {
 
 		if all {
-			switch v := interface{}(m.GetMetadata()).(type) {
+			switch v := interface{}(m.GetPin()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateSecretRequestValidationError{
-						field:  "Metadata",
+					errors = append(errors, GetSecretPasswordResponseValidationError{
+						field:  "Pin",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateSecretRequestValidationError{
-						field:  "Metadata",
+					errors = append(errors, GetSecretPasswordResponseValidationError{
+						field:  "Pin",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetPin()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return UpdateSecretRequestValidationError{
-					field:  "Metadata",
+				return GetSecretPasswordResponseValidationError{
+					field:  "Pin",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1597,24 +1756,24 @@ func (m *UpdateSecretRequest) validate(all bool) error {
 
 	}
 
-	if m.Status != nil {
-		// no validation rules for Status
+	if m.PinToken != nil {
+		// no validation rules for PinToken
 	}
 
 	if len(errors) > 0 {
-		return UpdateSecretRequestMultiError(errors)
+		return GetSecretPasswordResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateSecretRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateSecretRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateSecretRequestMultiError []error
+// GetSecretPasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSecretPasswordResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetSecretPasswordResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateSecretRequestMultiError) Error() string {
+func (m GetSecretPasswordResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1623,11 +1782,11 @@ func (m UpdateSecretRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateSecretRequestMultiError) AllErrors() []error { return m }
+func (m GetSecretPasswordResponseMultiError) AllErrors() []error { return m }
 
-// UpdateSecretRequestValidationError is the validation error returned by
-// UpdateSecretRequest.Validate if the designated constraints aren't met.
-type UpdateSecretRequestValidationError struct {
+// GetSecretPasswordResponseValidationError is the validation error returned by
+// GetSecretPasswordResponse.Validate if the designated constraints aren't met.
+type GetSecretPasswordResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1635,24 +1794,24 @@ type UpdateSecretRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateSecretRequestValidationError) Field() string { return e.field }
+func (e GetSecretPasswordResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateSecretRequestValidationError) Reason() string { return e.reason }
+func (e GetSecretPasswordResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateSecretRequestValidationError) Cause() error { return e.cause }
+func (e GetSecretPasswordResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateSecretRequestValidationError) Key() bool { return e.key }
+func (e GetSecretPasswordResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateSecretRequestValidationError) ErrorName() string {
-	return "UpdateSecretRequestValidationError"
+func (e GetSecretPasswordResponseValidationError) ErrorName() string {
+	return "GetSecretPasswordResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateSecretRequestValidationError) Error() string {
+func (e GetSecretPasswordResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1664,14 +1823,14 @@ func (e UpdateSecretRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateSecretRequest.%s: %s%s",
+		"invalid %sGetSecretPasswordResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateSecretRequestValidationError{}
+var _ error = GetSecretPasswordResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1679,73 +1838,121 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateSecretRequestValidationError{}
+} = GetSecretPasswordResponseValidationError{}
 
-// Validate checks the field values on UpdateSecretResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateSecretResponse) Validate() error {
+// Validate checks the field values on VersionPin with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VersionPin) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateSecretResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateSecretResponseMultiError, or nil if none found.
-func (m *UpdateSecretResponse) ValidateAll() error {
+// ValidateAll checks the field values on VersionPin with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VersionPinMultiError, or
+// nil if none found.
+func (m *VersionPin) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateSecretResponse) validate(all bool) error {
+func (m *VersionPin) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateSecretResponseValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateSecretResponseValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateSecretResponseValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
+
+	// no validation rules for SecretId
+
+	// no validation rules for VersionNumber
+
+	// no validation rules for Consumer
+
+	// no validation rules for CurrentVersion
+
+	// no validation rules for Stale
+
+	if m.LastUsedTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastUsedTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VersionPinValidationError{
+						field:  "LastUsedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VersionPinValidationError{
+						field:  "LastUsedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastUsedTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VersionPinValidationError{
+					field:  "LastUsedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CreateTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreateTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VersionPinValidationError{
+						field:  "CreateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VersionPinValidationError{
+						field:  "CreateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VersionPinValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return UpdateSecretResponseMultiError(errors)
+		return VersionPinMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateSecretResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateSecretResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateSecretResponseMultiError []error
+// VersionPinMultiError is an error wrapping multiple validation errors
+// returned by VersionPin.ValidateAll() if the designated constraints aren't met.
+type VersionPinMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateSecretResponseMultiError) Error() string {
+func (m VersionPinMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1754,11 +1961,11 @@ func (m UpdateSecretResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateSecretResponseMultiError) AllErrors() []error { return m }
+func (m VersionPinMultiError) AllErrors() []error { return m }
 
-// UpdateSecretResponseValidationError is the validation error returned by
-// UpdateSecretResponse.Validate if the designated constraints aren't met.
-type UpdateSecretResponseValidationError struct {
+// VersionPinValidationError is the validation error returned by
+// VersionPin.Validate if the designated constraints aren't met.
+type VersionPinValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1766,24 +1973,22 @@ type UpdateSecretResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateSecretResponseValidationError) Field() string { return e.field }
+func (e VersionPinValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateSecretResponseValidationError) Reason() string { return e.reason }
+func (e VersionPinValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateSecretResponseValidationError) Cause() error { return e.cause }
+func (e VersionPinValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateSecretResponseValidationError) Key() bool { return e.key }
+func (e VersionPinValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateSecretResponseValidationError) ErrorName() string {
-	return "UpdateSecretResponseValidationError"
-}
+func (e VersionPinValidationError) ErrorName() string { return "VersionPinValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateSecretResponseValidationError) Error() string {
+func (e VersionPinValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1795,14 +2000,14 @@ func (e UpdateSecretResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateSecretResponse.%s: %s%s",
+		"invalid %sVersionPin.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateSecretResponseValidationError{}
+var _ error = VersionPinValidationError{}
 
 var _ interface {
 	Field() string
@@ -1810,24 +2015,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateSecretResponseValidationError{}
+} = VersionPinValidationError{}
 
-// Validate checks the field values on UpdateSecretPasswordRequest with the
+// Validate checks the field values on GetSecretPasswordMaskedRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateSecretPasswordRequest) Validate() error {
+func (m *GetSecretPasswordMaskedRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateSecretPasswordRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateSecretPasswordRequestMultiError, or nil if none found.
-func (m *UpdateSecretPasswordRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetSecretPasswordMaskedRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSecretPasswordMaskedRequestMultiError, or nil if none found.
+func (m *GetSecretPasswordMaskedRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateSecretPasswordRequest) validate(all bool) error {
+func (m *GetSecretPasswordMaskedRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1836,24 +2041,24 @@ func (m *UpdateSecretPasswordRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Password
-
-	// no validation rules for Comment
+	if m.Version != nil {
+		// no validation rules for Version
+	}
 
 	if len(errors) > 0 {
-		return UpdateSecretPasswordRequestMultiError(errors)
+		return GetSecretPasswordMaskedRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateSecretPasswordRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateSecretPasswordRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateSecretPasswordRequestMultiError []error
+// GetSecretPasswordMaskedRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSecretPasswordMaskedRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetSecretPasswordMaskedRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateSecretPasswordRequestMultiError) Error() string {
+func (m GetSecretPasswordMaskedRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1862,12 +2067,12 @@ func (m UpdateSecretPasswordRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateSecretPasswordRequestMultiError) AllErrors() []error { return m }
+func (m GetSecretPasswordMaskedRequestMultiError) AllErrors() []error { return m }
 
-// UpdateSecretPasswordRequestValidationError is the validation error returned
-// by UpdateSecretPasswordRequest.Validate if the designated constraints
-// aren't met.
-type UpdateSecretPasswordRequestValidationError struct {
+// GetSecretPasswordMaskedRequestValidationError is the validation error
+// returned by GetSecretPasswordMaskedRequest.Validate if the designated
+// constraints aren't met.
+type GetSecretPasswordMaskedRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1875,24 +2080,24 @@ type UpdateSecretPasswordRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateSecretPasswordRequestValidationError) Field() string { return e.field }
+func (e GetSecretPasswordMaskedRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateSecretPasswordRequestValidationError) Reason() string { return e.reason }
+func (e GetSecretPasswordMaskedRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateSecretPasswordRequestValidationError) Cause() error { return e.cause }
+func (e GetSecretPasswordMaskedRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateSecretPasswordRequestValidationError) Key() bool { return e.key }
+func (e GetSecretPasswordMaskedRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateSecretPasswordRequestValidationError) ErrorName() string {
-	return "UpdateSecretPasswordRequestValidationError"
+func (e GetSecretPasswordMaskedRequestValidationError) ErrorName() string {
+	return "GetSecretPasswordMaskedRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateSecretPasswordRequestValidationError) Error() string {
+func (e GetSecretPasswordMaskedRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1904,14 +2109,14 @@ func (e UpdateSecretPasswordRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateSecretPasswordRequest.%s: %s%s",
+		"invalid %sGetSecretPasswordMaskedRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateSecretPasswordRequestValidationError{}
+var _ error = GetSecretPasswordMaskedRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1919,102 +2124,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateSecretPasswordRequestValidationError{}
+} = GetSecretPasswordMaskedRequestValidationError{}
 
-// Validate checks the field values on UpdateSecretPasswordResponse with the
+// Validate checks the field values on GetSecretPasswordMaskedResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateSecretPasswordResponse) Validate() error {
+func (m *GetSecretPasswordMaskedResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateSecretPasswordResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateSecretPasswordResponseMultiError, or nil if none found.
-func (m *UpdateSecretPasswordResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetSecretPasswordMaskedResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSecretPasswordMaskedResponseMultiError, or nil if none found.
+func (m *GetSecretPasswordMaskedResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateSecretPasswordResponse) validate(all bool) error {
+func (m *GetSecretPasswordMaskedResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateSecretPasswordResponseValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateSecretPasswordResponseValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateSecretPasswordResponseValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Length
 
-	if all {
-		switch v := interface{}(m.GetVersion()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateSecretPasswordResponseValidationError{
-					field:  "Version",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateSecretPasswordResponseValidationError{
-					field:  "Version",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateSecretPasswordResponseValidationError{
-				field:  "Version",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for FirstChar
+
+	// no validation rules for LastChar
+
+	// no validation rules for ChecksumPrefix
+
+	// no validation rules for Version
+
+	// no validation rules for Encoding
+
+	// no validation rules for ChecksumAlgorithm
 
 	if len(errors) > 0 {
-		return UpdateSecretPasswordResponseMultiError(errors)
+		return GetSecretPasswordMaskedResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateSecretPasswordResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateSecretPasswordResponse.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateSecretPasswordResponseMultiError []error
+// GetSecretPasswordMaskedResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSecretPasswordMaskedResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetSecretPasswordMaskedResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateSecretPasswordResponseMultiError) Error() string {
+func (m GetSecretPasswordMaskedResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2023,12 +2184,12 @@ func (m UpdateSecretPasswordResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateSecretPasswordResponseMultiError) AllErrors() []error { return m }
+func (m GetSecretPasswordMaskedResponseMultiError) AllErrors() []error { return m }
 
-// UpdateSecretPasswordResponseValidationError is the validation error returned
-// by UpdateSecretPasswordResponse.Validate if the designated constraints
-// aren't met.
-type UpdateSecretPasswordResponseValidationError struct {
+// GetSecretPasswordMaskedResponseValidationError is the validation error
+// returned by GetSecretPasswordMaskedResponse.Validate if the designated
+// constraints aren't met.
+type GetSecretPasswordMaskedResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2036,24 +2197,24 @@ type UpdateSecretPasswordResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateSecretPasswordResponseValidationError) Field() string { return e.field }
+func (e GetSecretPasswordMaskedResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateSecretPasswordResponseValidationError) Reason() string { return e.reason }
+func (e GetSecretPasswordMaskedResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateSecretPasswordResponseValidationError) Cause() error { return e.cause }
+func (e GetSecretPasswordMaskedResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateSecretPasswordResponseValidationError) Key() bool { return e.key }
+func (e GetSecretPasswordMaskedResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateSecretPasswordResponseValidationError) ErrorName() string {
-	return "UpdateSecretPasswordResponseValidationError"
+func (e GetSecretPasswordMaskedResponseValidationError) ErrorName() string {
+	return "GetSecretPasswordMaskedResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateSecretPasswordResponseValidationError) Error() string {
+func (e GetSecretPasswordMaskedResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2065,14 +2226,14 @@ func (e UpdateSecretPasswordResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateSecretPasswordResponse.%s: %s%s",
+		"invalid %sGetSecretPasswordMaskedResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateSecretPasswordResponseValidationError{}
+var _ error = GetSecretPasswordMaskedResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2080,24 +2241,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateSecretPasswordResponseValidationError{}
+} = GetSecretPasswordMaskedResponseValidationError{}
 
-// Validate checks the field values on DeleteSecretRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateRetrievalTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSecretRequest) Validate() error {
+func (m *CreateRetrievalTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSecretRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateRetrievalTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteSecretRequestMultiError, or nil if none found.
-func (m *DeleteSecretRequest) ValidateAll() error {
+// CreateRetrievalTokenRequestMultiError, or nil if none found.
+func (m *CreateRetrievalTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSecretRequest) validate(all bool) error {
+func (m *CreateRetrievalTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2106,22 +2267,28 @@ func (m *DeleteSecretRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Permanent
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if m.TtlSeconds != nil {
+		// no validation rules for TtlSeconds
+	}
 
 	if len(errors) > 0 {
-		return DeleteSecretRequestMultiError(errors)
+		return CreateRetrievalTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteSecretRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteSecretRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteSecretRequestMultiError []error
+// CreateRetrievalTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateRetrievalTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateRetrievalTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSecretRequestMultiError) Error() string {
+func (m CreateRetrievalTokenRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2130,11 +2297,12 @@ func (m DeleteSecretRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSecretRequestMultiError) AllErrors() []error { return m }
+func (m CreateRetrievalTokenRequestMultiError) AllErrors() []error { return m }
 
-// DeleteSecretRequestValidationError is the validation error returned by
-// DeleteSecretRequest.Validate if the designated constraints aren't met.
-type DeleteSecretRequestValidationError struct {
+// CreateRetrievalTokenRequestValidationError is the validation error returned
+// by CreateRetrievalTokenRequest.Validate if the designated constraints
+// aren't met.
+type CreateRetrievalTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2142,24 +2310,24 @@ type DeleteSecretRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSecretRequestValidationError) Field() string { return e.field }
+func (e CreateRetrievalTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSecretRequestValidationError) Reason() string { return e.reason }
+func (e CreateRetrievalTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSecretRequestValidationError) Cause() error { return e.cause }
+func (e CreateRetrievalTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSecretRequestValidationError) Key() bool { return e.key }
+func (e CreateRetrievalTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSecretRequestValidationError) ErrorName() string {
-	return "DeleteSecretRequestValidationError"
+func (e CreateRetrievalTokenRequestValidationError) ErrorName() string {
+	return "CreateRetrievalTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteSecretRequestValidationError) Error() string {
+func (e CreateRetrievalTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2171,14 +2339,14 @@ func (e DeleteSecretRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSecretRequest.%s: %s%s",
+		"invalid %sCreateRetrievalTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSecretRequestValidationError{}
+var _ error = CreateRetrievalTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2186,50 +2354,182 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSecretRequestValidationError{}
+} = CreateRetrievalTokenRequestValidationError{}
 
-// Validate checks the field values on MoveSecretRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MoveSecretRequest) Validate() error {
+// Validate checks the field values on CreateRetrievalTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRetrievalTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MoveSecretRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateRetrievalTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MoveSecretRequestMultiError, or nil if none found.
-func (m *MoveSecretRequest) ValidateAll() error {
+// CreateRetrievalTokenResponseMultiError, or nil if none found.
+func (m *CreateRetrievalTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MoveSecretRequest) validate(all bool) error {
+func (m *CreateRetrievalTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Token
 
-	if m.NewFolderId != nil {
-		// no validation rules for NewFolderId
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRetrievalTokenResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRetrievalTokenResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRetrievalTokenResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return MoveSecretRequestMultiError(errors)
+		return CreateRetrievalTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MoveSecretRequestMultiError is an error wrapping multiple validation errors
-// returned by MoveSecretRequest.ValidateAll() if the designated constraints
+// CreateRetrievalTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateRetrievalTokenResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateRetrievalTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRetrievalTokenResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRetrievalTokenResponseMultiError) AllErrors() []error { return m }
+
+// CreateRetrievalTokenResponseValidationError is the validation error returned
+// by CreateRetrievalTokenResponse.Validate if the designated constraints
 // aren't met.
-type MoveSecretRequestMultiError []error
+type CreateRetrievalTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRetrievalTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRetrievalTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRetrievalTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRetrievalTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRetrievalTokenResponseValidationError) ErrorName() string {
+	return "CreateRetrievalTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRetrievalTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRetrievalTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRetrievalTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRetrievalTokenResponseValidationError{}
+
+// Validate checks the field values on RedeemRetrievalTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RedeemRetrievalTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedeemRetrievalTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedeemRetrievalTokenRequestMultiError, or nil if none found.
+func (m *RedeemRetrievalTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedeemRetrievalTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return RedeemRetrievalTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedeemRetrievalTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RedeemRetrievalTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RedeemRetrievalTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MoveSecretRequestMultiError) Error() string {
+func (m RedeemRetrievalTokenRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2238,11 +2538,12 @@ func (m MoveSecretRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MoveSecretRequestMultiError) AllErrors() []error { return m }
+func (m RedeemRetrievalTokenRequestMultiError) AllErrors() []error { return m }
 
-// MoveSecretRequestValidationError is the validation error returned by
-// MoveSecretRequest.Validate if the designated constraints aren't met.
-type MoveSecretRequestValidationError struct {
+// RedeemRetrievalTokenRequestValidationError is the validation error returned
+// by RedeemRetrievalTokenRequest.Validate if the designated constraints
+// aren't met.
+type RedeemRetrievalTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2250,24 +2551,4183 @@ type MoveSecretRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MoveSecretRequestValidationError) Field() string { return e.field }
+func (e RedeemRetrievalTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MoveSecretRequestValidationError) Reason() string { return e.reason }
+func (e RedeemRetrievalTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MoveSecretRequestValidationError) Cause() error { return e.cause }
+func (e RedeemRetrievalTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MoveSecretRequestValidationError) Key() bool { return e.key }
+func (e RedeemRetrievalTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MoveSecretRequestValidationError) ErrorName() string {
-	return "MoveSecretRequestValidationError"
+func (e RedeemRetrievalTokenRequestValidationError) ErrorName() string {
+	return "RedeemRetrievalTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RedeemRetrievalTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedeemRetrievalTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedeemRetrievalTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedeemRetrievalTokenRequestValidationError{}
+
+// Validate checks the field values on RedeemRetrievalTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RedeemRetrievalTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedeemRetrievalTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedeemRetrievalTokenResponseMultiError, or nil if none found.
+func (m *RedeemRetrievalTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedeemRetrievalTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	// no validation rules for Password
+
+	// no validation rules for Version
+
+	// no validation rules for Encoding
+
+	if len(errors) > 0 {
+		return RedeemRetrievalTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedeemRetrievalTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RedeemRetrievalTokenResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RedeemRetrievalTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedeemRetrievalTokenResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedeemRetrievalTokenResponseMultiError) AllErrors() []error { return m }
+
+// RedeemRetrievalTokenResponseValidationError is the validation error returned
+// by RedeemRetrievalTokenResponse.Validate if the designated constraints
+// aren't met.
+type RedeemRetrievalTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedeemRetrievalTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedeemRetrievalTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedeemRetrievalTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedeemRetrievalTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedeemRetrievalTokenResponseValidationError) ErrorName() string {
+	return "RedeemRetrievalTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RedeemRetrievalTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedeemRetrievalTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedeemRetrievalTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedeemRetrievalTokenResponseValidationError{}
+
+// Validate checks the field values on GetSecretByPathRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSecretByPathRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSecretByPathRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSecretByPathRequestMultiError, or nil if none found.
+func (m *GetSecretByPathRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSecretByPathRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FolderPath
+
+	// no validation rules for Name
+
+	// no validation rules for ResolveReferences
+
+	if len(errors) > 0 {
+		return GetSecretByPathRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSecretByPathRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSecretByPathRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSecretByPathRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSecretByPathRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSecretByPathRequestMultiError) AllErrors() []error { return m }
+
+// GetSecretByPathRequestValidationError is the validation error returned by
+// GetSecretByPathRequest.Validate if the designated constraints aren't met.
+type GetSecretByPathRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSecretByPathRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSecretByPathRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSecretByPathRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSecretByPathRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSecretByPathRequestValidationError) ErrorName() string {
+	return "GetSecretByPathRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSecretByPathRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSecretByPathRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSecretByPathRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSecretByPathRequestValidationError{}
+
+// Validate checks the field values on GetSecretByPathResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSecretByPathResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSecretByPathResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSecretByPathResponseMultiError, or nil if none found.
+func (m *GetSecretByPathResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSecretByPathResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Password
+
+	// no validation rules for Version
+
+	// no validation rules for Username
+
+	// no validation rules for HostUrl
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSecretByPathResponseValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSecretByPathResponseValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSecretByPathResponseValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSecretByPathResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSecretByPathResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSecretByPathResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSecretByPathResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSecretByPathResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSecretByPathResponseMultiError) AllErrors() []error { return m }
+
+// GetSecretByPathResponseValidationError is the validation error returned by
+// GetSecretByPathResponse.Validate if the designated constraints aren't met.
+type GetSecretByPathResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSecretByPathResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSecretByPathResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSecretByPathResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSecretByPathResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSecretByPathResponseValidationError) ErrorName() string {
+	return "GetSecretByPathResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSecretByPathResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSecretByPathResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSecretByPathResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSecretByPathResponseValidationError{}
+
+// Validate checks the field values on FetchSecretsStreamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FetchSecretsStreamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FetchSecretsStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FetchSecretsStreamRequestMultiError, or nil if none found.
+func (m *FetchSecretsStreamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FetchSecretsStreamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LabelSelector
+
+	if len(errors) > 0 {
+		return FetchSecretsStreamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FetchSecretsStreamRequestMultiError is an error wrapping multiple validation
+// errors returned by FetchSecretsStreamRequest.ValidateAll() if the
+// designated constraints aren't met.
+type FetchSecretsStreamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FetchSecretsStreamRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FetchSecretsStreamRequestMultiError) AllErrors() []error { return m }
+
+// FetchSecretsStreamRequestValidationError is the validation error returned by
+// FetchSecretsStreamRequest.Validate if the designated constraints aren't met.
+type FetchSecretsStreamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FetchSecretsStreamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FetchSecretsStreamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FetchSecretsStreamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FetchSecretsStreamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FetchSecretsStreamRequestValidationError) ErrorName() string {
+	return "FetchSecretsStreamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FetchSecretsStreamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFetchSecretsStreamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FetchSecretsStreamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FetchSecretsStreamRequestValidationError{}
+
+// Validate checks the field values on StreamedSecret with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StreamedSecret) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamedSecret with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StreamedSecretMultiError,
+// or nil if none found.
+func (m *StreamedSecret) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamedSecret) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for Missing
+
+	// no validation rules for Id
+
+	// no validation rules for Password
+
+	// no validation rules for Version
+
+	// no validation rules for Username
+
+	// no validation rules for HostUrl
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamedSecretValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamedSecretValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamedSecretValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StreamedSecretMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamedSecretMultiError is an error wrapping multiple validation errors
+// returned by StreamedSecret.ValidateAll() if the designated constraints
+// aren't met.
+type StreamedSecretMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamedSecretMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamedSecretMultiError) AllErrors() []error { return m }
+
+// StreamedSecretValidationError is the validation error returned by
+// StreamedSecret.Validate if the designated constraints aren't met.
+type StreamedSecretValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamedSecretValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamedSecretValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamedSecretValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamedSecretValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamedSecretValidationError) ErrorName() string { return "StreamedSecretValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StreamedSecretValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamedSecret.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamedSecretValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamedSecretValidationError{}
+
+// Validate checks the field values on FetchSecretsStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FetchSecretsStreamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FetchSecretsStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FetchSecretsStreamResponseMultiError, or nil if none found.
+func (m *FetchSecretsStreamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FetchSecretsStreamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Initial
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FetchSecretsStreamResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FetchSecretsStreamResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FetchSecretsStreamResponseValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FetchSecretsStreamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FetchSecretsStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by FetchSecretsStreamResponse.ValidateAll() if
+// the designated constraints aren't met.
+type FetchSecretsStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FetchSecretsStreamResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FetchSecretsStreamResponseMultiError) AllErrors() []error { return m }
+
+// FetchSecretsStreamResponseValidationError is the validation error returned
+// by FetchSecretsStreamResponse.Validate if the designated constraints aren't met.
+type FetchSecretsStreamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FetchSecretsStreamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FetchSecretsStreamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FetchSecretsStreamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FetchSecretsStreamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FetchSecretsStreamResponseValidationError) ErrorName() string {
+	return "FetchSecretsStreamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FetchSecretsStreamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFetchSecretsStreamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FetchSecretsStreamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FetchSecretsStreamResponseValidationError{}
+
+// Validate checks the field values on ListSecretsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSecretsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecretsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecretsRequestMultiError, or nil if none found.
+func (m *ListSecretsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecretsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccessibleOnly
+
+	// no validation rules for IdsOnly
+
+	// no validation rules for CountOnly
+
+	if m.FolderId != nil {
+		// no validation rules for FolderId
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.NameFilter != nil {
+		// no validation rules for NameFilter
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if m.SortBy != nil {
+		// no validation rules for SortBy
+	}
+
+	if m.SortOrder != nil {
+		// no validation rules for SortOrder
+	}
+
+	if m.NotAccessedSince != nil {
+
+		if all {
+			switch v := interface{}(m.GetNotAccessedSince()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSecretsRequestValidationError{
+						field:  "NotAccessedSince",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSecretsRequestValidationError{
+						field:  "NotAccessedSince",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNotAccessedSince()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSecretsRequestValidationError{
+					field:  "NotAccessedSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.LabelSelector != nil {
+		// no validation rules for LabelSelector
+	}
+
+	if len(errors) > 0 {
+		return ListSecretsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecretsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListSecretsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListSecretsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecretsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecretsRequestMultiError) AllErrors() []error { return m }
+
+// ListSecretsRequestValidationError is the validation error returned by
+// ListSecretsRequest.Validate if the designated constraints aren't met.
+type ListSecretsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecretsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecretsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecretsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecretsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecretsRequestValidationError) ErrorName() string {
+	return "ListSecretsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSecretsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecretsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecretsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecretsRequestValidationError{}
+
+// Validate checks the field values on ListSecretsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSecretsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecretsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecretsResponseMultiError, or nil if none found.
+func (m *ListSecretsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecretsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSecretsResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSecretsResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSecretsResponseValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListSecretsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecretsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSecretsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSecretsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecretsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecretsResponseMultiError) AllErrors() []error { return m }
+
+// ListSecretsResponseValidationError is the validation error returned by
+// ListSecretsResponse.Validate if the designated constraints aren't met.
+type ListSecretsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecretsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecretsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecretsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecretsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecretsResponseValidationError) ErrorName() string {
+	return "ListSecretsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSecretsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecretsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecretsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecretsResponseValidationError{}
+
+// Validate checks the field values on UpdateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSecretRequestMultiError, or nil if none found.
+func (m *UpdateSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.HostUrl != nil {
+		// no validation rules for HostUrl
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.Metadata != nil {
+
+		if all {
+			switch v := interface{}(m.GetMetadata()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateSecretRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateSecretRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateSecretRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.ExpectedRevision != nil {
+		// no validation rules for ExpectedRevision
+	}
+
+	if m.Sensitive != nil {
+		// no validation rules for Sensitive
+	}
+
+	if m.Canary != nil {
+		// no validation rules for Canary
+	}
+
+	if m.Protected != nil {
+		// no validation rules for Protected
+	}
+
+	if len(errors) > 0 {
+		return UpdateSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSecretRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSecretRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSecretRequestValidationError is the validation error returned by
+// UpdateSecretRequest.Validate if the designated constraints aren't met.
+type UpdateSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSecretRequestValidationError) ErrorName() string {
+	return "UpdateSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSecretRequestValidationError{}
+
+// Validate checks the field values on UpdateSecretResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSecretResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSecretResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSecretResponseMultiError, or nil if none found.
+func (m *UpdateSecretResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSecretResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSecretResponseValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateSecretResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSecretResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateSecretResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSecretResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSecretResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSecretResponseMultiError) AllErrors() []error { return m }
+
+// UpdateSecretResponseValidationError is the validation error returned by
+// UpdateSecretResponse.Validate if the designated constraints aren't met.
+type UpdateSecretResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSecretResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSecretResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSecretResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSecretResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSecretResponseValidationError) ErrorName() string {
+	return "UpdateSecretResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSecretResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSecretResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSecretResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSecretResponseValidationError{}
+
+// Validate checks the field values on UpdateSecretPasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSecretPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSecretPasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSecretPasswordRequestMultiError, or nil if none found.
+func (m *UpdateSecretPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSecretPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Password
+
+	// no validation rules for Comment
+
+	// no validation rules for PasswordEncoding
+
+	if len(errors) > 0 {
+		return UpdateSecretPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSecretPasswordRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateSecretPasswordRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateSecretPasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSecretPasswordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSecretPasswordRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSecretPasswordRequestValidationError is the validation error returned
+// by UpdateSecretPasswordRequest.Validate if the designated constraints
+// aren't met.
+type UpdateSecretPasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSecretPasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSecretPasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSecretPasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSecretPasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSecretPasswordRequestValidationError) ErrorName() string {
+	return "UpdateSecretPasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSecretPasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSecretPasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSecretPasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSecretPasswordRequestValidationError{}
+
+// Validate checks the field values on UpdateSecretPasswordResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSecretPasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSecretPasswordResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSecretPasswordResponseMultiError, or nil if none found.
+func (m *UpdateSecretPasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSecretPasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSecretPasswordResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSecretPasswordResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSecretPasswordResponseValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSecretPasswordResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSecretPasswordResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSecretPasswordResponseValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetStalePins() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateSecretPasswordResponseValidationError{
+						field:  fmt.Sprintf("StalePins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateSecretPasswordResponseValidationError{
+						field:  fmt.Sprintf("StalePins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateSecretPasswordResponseValidationError{
+					field:  fmt.Sprintf("StalePins[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateSecretPasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSecretPasswordResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateSecretPasswordResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateSecretPasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSecretPasswordResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSecretPasswordResponseMultiError) AllErrors() []error { return m }
+
+// UpdateSecretPasswordResponseValidationError is the validation error returned
+// by UpdateSecretPasswordResponse.Validate if the designated constraints
+// aren't met.
+type UpdateSecretPasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSecretPasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSecretPasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSecretPasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSecretPasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSecretPasswordResponseValidationError) ErrorName() string {
+	return "UpdateSecretPasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSecretPasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSecretPasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSecretPasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSecretPasswordResponseValidationError{}
+
+// Validate checks the field values on DeleteSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSecretRequestMultiError, or nil if none found.
+func (m *DeleteSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Permanent
+
+	// no validation rules for Force
+
+	if len(errors) > 0 {
+		return DeleteSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSecretRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSecretRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSecretRequestValidationError is the validation error returned by
+// DeleteSecretRequest.Validate if the designated constraints aren't met.
+type DeleteSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSecretRequestValidationError) ErrorName() string {
+	return "DeleteSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSecretRequestValidationError{}
+
+// Validate checks the field values on MoveSecretRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveSecretRequestMultiError, or nil if none found.
+func (m *MoveSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.NewFolderId != nil {
+		// no validation rules for NewFolderId
+	}
+
+	if len(errors) > 0 {
+		return MoveSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveSecretRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveSecretRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveSecretRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveSecretRequestMultiError) AllErrors() []error { return m }
+
+// MoveSecretRequestValidationError is the validation error returned by
+// MoveSecretRequest.Validate if the designated constraints aren't met.
+type MoveSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveSecretRequestValidationError) ErrorName() string {
+	return "MoveSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveSecretRequestValidationError{}
+
+// Validate checks the field values on MoveSecretResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoveSecretResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveSecretResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveSecretResponseMultiError, or nil if none found.
+func (m *MoveSecretResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveSecretResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MoveSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MoveSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MoveSecretResponseValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MoveSecretResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveSecretResponseMultiError is an error wrapping multiple validation errors
+// returned by MoveSecretResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MoveSecretResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveSecretResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveSecretResponseMultiError) AllErrors() []error { return m }
+
+// MoveSecretResponseValidationError is the validation error returned by
+// MoveSecretResponse.Validate if the designated constraints aren't met.
+type MoveSecretResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveSecretResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveSecretResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveSecretResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveSecretResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveSecretResponseValidationError) ErrorName() string {
+	return "MoveSecretResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveSecretResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveSecretResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveSecretResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveSecretResponseValidationError{}
+
+// Validate checks the field values on ListVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVersionsRequestMultiError, or nil if none found.
+func (m *ListVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if m.RestoredOnly != nil {
+		// no validation rules for RestoredOnly
+	}
+
+	if m.IncludeVaultState != nil {
+		// no validation rules for IncludeVaultState
+	}
+
+	if m.RetrievableOnly != nil {
+		// no validation rules for RetrievableOnly
+	}
+
+	if len(errors) > 0 {
+		return ListVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVersionsRequestMultiError) AllErrors() []error { return m }
+
+// ListVersionsRequestValidationError is the validation error returned by
+// ListVersionsRequest.Validate if the designated constraints aren't met.
+type ListVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVersionsRequestValidationError) ErrorName() string {
+	return "ListVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVersionsRequestValidationError{}
+
+// Validate checks the field values on ListVersionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVersionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVersionsResponseMultiError, or nil if none found.
+func (m *ListVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListVersionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListVersionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVersionsResponseMultiError) AllErrors() []error { return m }
+
+// ListVersionsResponseValidationError is the validation error returned by
+// ListVersionsResponse.Validate if the designated constraints aren't met.
+type ListVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVersionsResponseValidationError) ErrorName() string {
+	return "ListVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVersionsResponseValidationError{}
+
+// Validate checks the field values on GetVersionRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVersionRequestMultiError, or nil if none found.
+func (m *GetVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	// no validation rules for VersionNumber
+
+	// no validation rules for IncludePassword
+
+	if len(errors) > 0 {
+		return GetVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVersionRequestMultiError is an error wrapping multiple validation errors
+// returned by GetVersionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVersionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVersionRequestMultiError) AllErrors() []error { return m }
+
+// GetVersionRequestValidationError is the validation error returned by
+// GetVersionRequest.Validate if the designated constraints aren't met.
+type GetVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVersionRequestValidationError) ErrorName() string {
+	return "GetVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVersionRequestValidationError{}
+
+// Validate checks the field values on GetVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVersionResponseMultiError, or nil if none found.
+func (m *GetVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetVersionResponseValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if len(errors) > 0 {
+		return GetVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVersionResponseMultiError is an error wrapping multiple validation errors
+// returned by GetVersionResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVersionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVersionResponseMultiError) AllErrors() []error { return m }
+
+// GetVersionResponseValidationError is the validation error returned by
+// GetVersionResponse.Validate if the designated constraints aren't met.
+type GetVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVersionResponseValidationError) ErrorName() string {
+	return "GetVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVersionResponseValidationError{}
+
+// Validate checks the field values on RestoreVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreVersionRequestMultiError, or nil if none found.
+func (m *RestoreVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	// no validation rules for VersionNumber
+
+	// no validation rules for Comment
+
+	if len(errors) > 0 {
+		return RestoreVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreVersionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreVersionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreVersionRequestMultiError) AllErrors() []error { return m }
+
+// RestoreVersionRequestValidationError is the validation error returned by
+// RestoreVersionRequest.Validate if the designated constraints aren't met.
+type RestoreVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreVersionRequestValidationError) ErrorName() string {
+	return "RestoreVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreVersionRequestValidationError{}
+
+// Validate checks the field values on RestoreVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreVersionResponseMultiError, or nil if none found.
+func (m *RestoreVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreVersionResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreVersionResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreVersionResponseValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNewVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreVersionResponseValidationError{
+					field:  "NewVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreVersionResponseValidationError{
+					field:  "NewVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNewVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreVersionResponseValidationError{
+				field:  "NewVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreVersionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreVersionResponseMultiError) AllErrors() []error { return m }
+
+// RestoreVersionResponseValidationError is the validation error returned by
+// RestoreVersionResponse.Validate if the designated constraints aren't met.
+type RestoreVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreVersionResponseValidationError) ErrorName() string {
+	return "RestoreVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreVersionResponseValidationError{}
+
+// Validate checks the field values on DeleteVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteVersionRequestMultiError, or nil if none found.
+func (m *DeleteVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	// no validation rules for VersionNumber
+
+	if len(errors) > 0 {
+		return DeleteVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteVersionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteVersionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteVersionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteVersionRequestValidationError is the validation error returned by
+// DeleteVersionRequest.Validate if the designated constraints aren't met.
+type DeleteVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteVersionRequestValidationError) ErrorName() string {
+	return "DeleteVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteVersionRequestValidationError{}
+
+// Validate checks the field values on DeleteVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteVersionResponseMultiError, or nil if none found.
+func (m *DeleteVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteVersionResponseValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteVersionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteVersionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteVersionResponseValidationError is the validation error returned by
+// DeleteVersionResponse.Validate if the designated constraints aren't met.
+type DeleteVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteVersionResponseValidationError) ErrorName() string {
+	return "DeleteVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteVersionResponseValidationError{}
+
+// Validate checks the field values on DestroyVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DestroyVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DestroyVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DestroyVersionRequestMultiError, or nil if none found.
+func (m *DestroyVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DestroyVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	// no validation rules for VersionNumber
+
+	if len(errors) > 0 {
+		return DestroyVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DestroyVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by DestroyVersionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DestroyVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DestroyVersionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DestroyVersionRequestMultiError) AllErrors() []error { return m }
+
+// DestroyVersionRequestValidationError is the validation error returned by
+// DestroyVersionRequest.Validate if the designated constraints aren't met.
+type DestroyVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DestroyVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DestroyVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DestroyVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DestroyVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DestroyVersionRequestValidationError) ErrorName() string {
+	return "DestroyVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DestroyVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDestroyVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DestroyVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DestroyVersionRequestValidationError{}
+
+// Validate checks the field values on DestroyVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DestroyVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DestroyVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DestroyVersionResponseMultiError, or nil if none found.
+func (m *DestroyVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DestroyVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DestroyVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DestroyVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DestroyVersionResponseValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DestroyVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DestroyVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by DestroyVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DestroyVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DestroyVersionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DestroyVersionResponseMultiError) AllErrors() []error { return m }
+
+// DestroyVersionResponseValidationError is the validation error returned by
+// DestroyVersionResponse.Validate if the designated constraints aren't met.
+type DestroyVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DestroyVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DestroyVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DestroyVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DestroyVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DestroyVersionResponseValidationError) ErrorName() string {
+	return "DestroyVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DestroyVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDestroyVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DestroyVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DestroyVersionResponseValidationError{}
+
+// Validate checks the field values on UndeleteVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteVersionRequestMultiError, or nil if none found.
+func (m *UndeleteVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	// no validation rules for VersionNumber
+
+	if len(errors) > 0 {
+		return UndeleteVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteVersionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteVersionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteVersionRequestMultiError) AllErrors() []error { return m }
+
+// UndeleteVersionRequestValidationError is the validation error returned by
+// UndeleteVersionRequest.Validate if the designated constraints aren't met.
+type UndeleteVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteVersionRequestValidationError) ErrorName() string {
+	return "UndeleteVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteVersionRequestValidationError{}
+
+// Validate checks the field values on UndeleteVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteVersionResponseMultiError, or nil if none found.
+func (m *UndeleteVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteVersionResponseValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by UndeleteVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteVersionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteVersionResponseMultiError) AllErrors() []error { return m }
+
+// UndeleteVersionResponseValidationError is the validation error returned by
+// UndeleteVersionResponse.Validate if the designated constraints aren't met.
+type UndeleteVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteVersionResponseValidationError) ErrorName() string {
+	return "UndeleteVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteVersionResponseValidationError{}
+
+// Validate checks the field values on ListVersionPinsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVersionPinsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVersionPinsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVersionPinsRequestMultiError, or nil if none found.
+func (m *ListVersionPinsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVersionPinsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	// no validation rules for StaleOnly
+
+	if len(errors) > 0 {
+		return ListVersionPinsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListVersionPinsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListVersionPinsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListVersionPinsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVersionPinsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVersionPinsRequestMultiError) AllErrors() []error { return m }
+
+// ListVersionPinsRequestValidationError is the validation error returned by
+// ListVersionPinsRequest.Validate if the designated constraints aren't met.
+type ListVersionPinsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVersionPinsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVersionPinsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVersionPinsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVersionPinsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVersionPinsRequestValidationError) ErrorName() string {
+	return "ListVersionPinsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVersionPinsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVersionPinsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVersionPinsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVersionPinsRequestValidationError{}
+
+// Validate checks the field values on ListVersionPinsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVersionPinsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVersionPinsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVersionPinsResponseMultiError, or nil if none found.
+func (m *ListVersionPinsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVersionPinsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPins() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListVersionPinsResponseValidationError{
+						field:  fmt.Sprintf("Pins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListVersionPinsResponseValidationError{
+						field:  fmt.Sprintf("Pins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListVersionPinsResponseValidationError{
+					field:  fmt.Sprintf("Pins[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListVersionPinsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListVersionPinsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListVersionPinsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListVersionPinsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVersionPinsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVersionPinsResponseMultiError) AllErrors() []error { return m }
+
+// ListVersionPinsResponseValidationError is the validation error returned by
+// ListVersionPinsResponse.Validate if the designated constraints aren't met.
+type ListVersionPinsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVersionPinsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVersionPinsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVersionPinsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVersionPinsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVersionPinsResponseValidationError) ErrorName() string {
+	return "ListVersionPinsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVersionPinsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVersionPinsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVersionPinsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVersionPinsResponseValidationError{}
+
+// Validate checks the field values on DeleteVersionPinRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteVersionPinRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteVersionPinRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteVersionPinRequestMultiError, or nil if none found.
+func (m *DeleteVersionPinRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteVersionPinRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	// no validation rules for PinId
+
+	if len(errors) > 0 {
+		return DeleteVersionPinRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteVersionPinRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteVersionPinRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteVersionPinRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteVersionPinRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteVersionPinRequestMultiError) AllErrors() []error { return m }
+
+// DeleteVersionPinRequestValidationError is the validation error returned by
+// DeleteVersionPinRequest.Validate if the designated constraints aren't met.
+type DeleteVersionPinRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteVersionPinRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteVersionPinRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteVersionPinRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteVersionPinRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteVersionPinRequestValidationError) ErrorName() string {
+	return "DeleteVersionPinRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteVersionPinRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteVersionPinRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteVersionPinRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteVersionPinRequestValidationError{}
+
+// Validate checks the field values on SearchSecretsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchSecretsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchSecretsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchSecretsRequestMultiError, or nil if none found.
+func (m *SearchSecretsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchSecretsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for IncludeSubfolders
+
+	// no validation rules for IdsOnly
+
+	// no validation rules for CountOnly
+
+	if m.FolderId != nil {
+		// no validation rules for FolderId
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if len(errors) > 0 {
+		return SearchSecretsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchSecretsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchSecretsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchSecretsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchSecretsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchSecretsRequestMultiError) AllErrors() []error { return m }
+
+// SearchSecretsRequestValidationError is the validation error returned by
+// SearchSecretsRequest.Validate if the designated constraints aren't met.
+type SearchSecretsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchSecretsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchSecretsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchSecretsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchSecretsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchSecretsRequestValidationError) ErrorName() string {
+	return "SearchSecretsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchSecretsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchSecretsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchSecretsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchSecretsRequestValidationError{}
+
+// Validate checks the field values on SearchSecretsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchSecretsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchSecretsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchSecretsResponseMultiError, or nil if none found.
+func (m *SearchSecretsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchSecretsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchSecretsResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchSecretsResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchSecretsResponseValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchSecretsResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchSecretsResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchSecretsResponseValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchSecretsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchSecretsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchSecretsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchSecretsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchSecretsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchSecretsResponseMultiError) AllErrors() []error { return m }
+
+// SearchSecretsResponseValidationError is the validation error returned by
+// SearchSecretsResponse.Validate if the designated constraints aren't met.
+type SearchSecretsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchSecretsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchSecretsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchSecretsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchSecretsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchSecretsResponseValidationError) ErrorName() string {
+	return "SearchSecretsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MoveSecretRequestValidationError) Error() string {
+func (e SearchSecretsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2279,14 +6739,14 @@ func (e MoveSecretRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMoveSecretRequest.%s: %s%s",
+		"invalid %sSearchSecretsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MoveSecretRequestValidationError{}
+var _ error = SearchSecretsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2294,73 +6754,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MoveSecretRequestValidationError{}
+} = SearchSecretsResponseValidationError{}
 
-// Validate checks the field values on MoveSecretResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MoveSecretResponse) Validate() error {
+// Validate checks the field values on SecretSearchHit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SecretSearchHit) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MoveSecretResponse with the rules
+// ValidateAll checks the field values on SecretSearchHit with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MoveSecretResponseMultiError, or nil if none found.
-func (m *MoveSecretResponse) ValidateAll() error {
+// SecretSearchHitMultiError, or nil if none found.
+func (m *SecretSearchHit) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MoveSecretResponse) validate(all bool) error {
+func (m *SecretSearchHit) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MoveSecretResponseValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MoveSecretResponseValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MoveSecretResponseValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for SecretId
+
+	// no validation rules for Highlights
 
 	if len(errors) > 0 {
-		return MoveSecretResponseMultiError(errors)
+		return SecretSearchHitMultiError(errors)
 	}
 
 	return nil
 }
 
-// MoveSecretResponseMultiError is an error wrapping multiple validation errors
-// returned by MoveSecretResponse.ValidateAll() if the designated constraints
+// SecretSearchHitMultiError is an error wrapping multiple validation errors
+// returned by SecretSearchHit.ValidateAll() if the designated constraints
 // aren't met.
-type MoveSecretResponseMultiError []error
+type SecretSearchHitMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MoveSecretResponseMultiError) Error() string {
+func (m SecretSearchHitMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2369,11 +6804,11 @@ func (m MoveSecretResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MoveSecretResponseMultiError) AllErrors() []error { return m }
+func (m SecretSearchHitMultiError) AllErrors() []error { return m }
 
-// MoveSecretResponseValidationError is the validation error returned by
-// MoveSecretResponse.Validate if the designated constraints aren't met.
-type MoveSecretResponseValidationError struct {
+// SecretSearchHitValidationError is the validation error returned by
+// SecretSearchHit.Validate if the designated constraints aren't met.
+type SecretSearchHitValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2381,24 +6816,22 @@ type MoveSecretResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MoveSecretResponseValidationError) Field() string { return e.field }
+func (e SecretSearchHitValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MoveSecretResponseValidationError) Reason() string { return e.reason }
+func (e SecretSearchHitValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MoveSecretResponseValidationError) Cause() error { return e.cause }
+func (e SecretSearchHitValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MoveSecretResponseValidationError) Key() bool { return e.key }
+func (e SecretSearchHitValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MoveSecretResponseValidationError) ErrorName() string {
-	return "MoveSecretResponseValidationError"
-}
+func (e SecretSearchHitValidationError) ErrorName() string { return "SecretSearchHitValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MoveSecretResponseValidationError) Error() string {
+func (e SecretSearchHitValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2410,14 +6843,14 @@ func (e MoveSecretResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMoveSecretResponse.%s: %s%s",
+		"invalid %sSecretSearchHit.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MoveSecretResponseValidationError{}
+var _ error = SecretSearchHitValidationError{}
 
 var _ interface {
 	Field() string
@@ -2425,54 +6858,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MoveSecretResponseValidationError{}
+} = SecretSearchHitValidationError{}
 
-// Validate checks the field values on ListVersionsRequest with the rules
+// Validate checks the field values on GetSecretTotpRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListVersionsRequest) Validate() error {
+func (m *GetSecretTotpRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListVersionsRequest with the rules
+// ValidateAll checks the field values on GetSecretTotpRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListVersionsRequestMultiError, or nil if none found.
-func (m *ListVersionsRequest) ValidateAll() error {
+// GetSecretTotpRequestMultiError, or nil if none found.
+func (m *GetSecretTotpRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListVersionsRequest) validate(all bool) error {
+func (m *GetSecretTotpRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SecretId
-
-	if m.Page != nil {
-		// no validation rules for Page
-	}
-
-	if m.PageSize != nil {
-		// no validation rules for PageSize
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return ListVersionsRequestMultiError(errors)
+		return GetSecretTotpRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListVersionsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListVersionsRequest.ValidateAll() if the designated
+// GetSecretTotpRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSecretTotpRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListVersionsRequestMultiError []error
+type GetSecretTotpRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListVersionsRequestMultiError) Error() string {
+func (m GetSecretTotpRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2481,11 +6906,11 @@ func (m ListVersionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListVersionsRequestMultiError) AllErrors() []error { return m }
+func (m GetSecretTotpRequestMultiError) AllErrors() []error { return m }
 
-// ListVersionsRequestValidationError is the validation error returned by
-// ListVersionsRequest.Validate if the designated constraints aren't met.
-type ListVersionsRequestValidationError struct {
+// GetSecretTotpRequestValidationError is the validation error returned by
+// GetSecretTotpRequest.Validate if the designated constraints aren't met.
+type GetSecretTotpRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2493,24 +6918,24 @@ type ListVersionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListVersionsRequestValidationError) Field() string { return e.field }
+func (e GetSecretTotpRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListVersionsRequestValidationError) Reason() string { return e.reason }
+func (e GetSecretTotpRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListVersionsRequestValidationError) Cause() error { return e.cause }
+func (e GetSecretTotpRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListVersionsRequestValidationError) Key() bool { return e.key }
+func (e GetSecretTotpRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListVersionsRequestValidationError) ErrorName() string {
-	return "ListVersionsRequestValidationError"
+func (e GetSecretTotpRequestValidationError) ErrorName() string {
+	return "GetSecretTotpRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListVersionsRequestValidationError) Error() string {
+func (e GetSecretTotpRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2522,14 +6947,14 @@ func (e ListVersionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListVersionsRequest.%s: %s%s",
+		"invalid %sGetSecretTotpRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListVersionsRequestValidationError{}
+var _ error = GetSecretTotpRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2537,80 +6962,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListVersionsRequestValidationError{}
+} = GetSecretTotpRequestValidationError{}
 
-// Validate checks the field values on ListVersionsResponse with the rules
+// Validate checks the field values on GetSecretTotpResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListVersionsResponse) Validate() error {
+func (m *GetSecretTotpResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListVersionsResponse with the rules
+// ValidateAll checks the field values on GetSecretTotpResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListVersionsResponseMultiError, or nil if none found.
-func (m *ListVersionsResponse) ValidateAll() error {
+// GetSecretTotpResponseMultiError, or nil if none found.
+func (m *GetSecretTotpResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListVersionsResponse) validate(all bool) error {
+func (m *GetSecretTotpResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetVersions() {
-		_, _ = idx, item
+	// no validation rules for TotpUrl
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListVersionsResponseValidationError{
-						field:  fmt.Sprintf("Versions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListVersionsResponseValidationError{
-						field:  fmt.Sprintf("Versions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListVersionsResponseValidationError{
-					field:  fmt.Sprintf("Versions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for CurrentCode
 
-	}
+	// no validation rules for RemainingSeconds
 
-	// no validation rules for Total
+	// no validation rules for Period
 
 	if len(errors) > 0 {
-		return ListVersionsResponseMultiError(errors)
+		return GetSecretTotpResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListVersionsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListVersionsResponse.ValidateAll() if the designated
+// GetSecretTotpResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSecretTotpResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListVersionsResponseMultiError []error
+type GetSecretTotpResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListVersionsResponseMultiError) Error() string {
+func (m GetSecretTotpResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2619,11 +7016,11 @@ func (m ListVersionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListVersionsResponseMultiError) AllErrors() []error { return m }
+func (m GetSecretTotpResponseMultiError) AllErrors() []error { return m }
 
-// ListVersionsResponseValidationError is the validation error returned by
-// ListVersionsResponse.Validate if the designated constraints aren't met.
-type ListVersionsResponseValidationError struct {
+// GetSecretTotpResponseValidationError is the validation error returned by
+// GetSecretTotpResponse.Validate if the designated constraints aren't met.
+type GetSecretTotpResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2631,24 +7028,24 @@ type ListVersionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListVersionsResponseValidationError) Field() string { return e.field }
+func (e GetSecretTotpResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListVersionsResponseValidationError) Reason() string { return e.reason }
+func (e GetSecretTotpResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListVersionsResponseValidationError) Cause() error { return e.cause }
+func (e GetSecretTotpResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListVersionsResponseValidationError) Key() bool { return e.key }
+func (e GetSecretTotpResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListVersionsResponseValidationError) ErrorName() string {
-	return "ListVersionsResponseValidationError"
+func (e GetSecretTotpResponseValidationError) ErrorName() string {
+	return "GetSecretTotpResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListVersionsResponseValidationError) Error() string {
+func (e GetSecretTotpResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2660,14 +7057,14 @@ func (e ListVersionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListVersionsResponse.%s: %s%s",
+		"invalid %sGetSecretTotpResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListVersionsResponseValidationError{}
+var _ error = GetSecretTotpResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2675,50 +7072,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListVersionsResponseValidationError{}
+} = GetSecretTotpResponseValidationError{}
 
-// Validate checks the field values on GetVersionRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetVersionRequest) Validate() error {
+// Validate checks the field values on SetSecretTotpRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSecretTotpRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetVersionRequest with the rules
+// ValidateAll checks the field values on SetSecretTotpRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetVersionRequestMultiError, or nil if none found.
-func (m *GetVersionRequest) ValidateAll() error {
+// SetSecretTotpRequestMultiError, or nil if none found.
+func (m *SetSecretTotpRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetVersionRequest) validate(all bool) error {
+func (m *SetSecretTotpRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SecretId
-
-	// no validation rules for VersionNumber
+	// no validation rules for Id
 
-	// no validation rules for IncludePassword
+	// no validation rules for TotpUrl
 
 	if len(errors) > 0 {
-		return GetVersionRequestMultiError(errors)
+		return SetSecretTotpRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetVersionRequestMultiError is an error wrapping multiple validation errors
-// returned by GetVersionRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetVersionRequestMultiError []error
+// SetSecretTotpRequestMultiError is an error wrapping multiple validation
+// errors returned by SetSecretTotpRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetSecretTotpRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetVersionRequestMultiError) Error() string {
+func (m SetSecretTotpRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2727,11 +7122,11 @@ func (m GetVersionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetVersionRequestMultiError) AllErrors() []error { return m }
+func (m SetSecretTotpRequestMultiError) AllErrors() []error { return m }
 
-// GetVersionRequestValidationError is the validation error returned by
-// GetVersionRequest.Validate if the designated constraints aren't met.
-type GetVersionRequestValidationError struct {
+// SetSecretTotpRequestValidationError is the validation error returned by
+// SetSecretTotpRequest.Validate if the designated constraints aren't met.
+type SetSecretTotpRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2739,24 +7134,24 @@ type GetVersionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetVersionRequestValidationError) Field() string { return e.field }
+func (e SetSecretTotpRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetVersionRequestValidationError) Reason() string { return e.reason }
+func (e SetSecretTotpRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetVersionRequestValidationError) Cause() error { return e.cause }
+func (e SetSecretTotpRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetVersionRequestValidationError) Key() bool { return e.key }
+func (e SetSecretTotpRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetVersionRequestValidationError) ErrorName() string {
-	return "GetVersionRequestValidationError"
+func (e SetSecretTotpRequestValidationError) ErrorName() string {
+	return "SetSecretTotpRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetVersionRequestValidationError) Error() string {
+func (e SetSecretTotpRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2768,14 +7163,14 @@ func (e GetVersionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetVersionRequest.%s: %s%s",
+		"invalid %sSetSecretTotpRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetVersionRequestValidationError{}
+var _ error = SetSecretTotpRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2783,24 +7178,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetVersionRequestValidationError{}
+} = SetSecretTotpRequestValidationError{}
 
-// Validate checks the field values on GetVersionResponse with the rules
+// Validate checks the field values on SetSecretTotpResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetVersionResponse) Validate() error {
+func (m *SetSecretTotpResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetVersionResponse with the rules
+// ValidateAll checks the field values on SetSecretTotpResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetVersionResponseMultiError, or nil if none found.
-func (m *GetVersionResponse) ValidateAll() error {
+// SetSecretTotpResponseMultiError, or nil if none found.
+func (m *SetSecretTotpResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetVersionResponse) validate(all bool) error {
+func (m *SetSecretTotpResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2808,52 +7203,50 @@ func (m *GetVersionResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetVersion()).(type) {
+		switch v := interface{}(m.GetSecret()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetVersionResponseValidationError{
-					field:  "Version",
+				errors = append(errors, SetSecretTotpResponseValidationError{
+					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetVersionResponseValidationError{
-					field:  "Version",
+				errors = append(errors, SetSecretTotpResponseValidationError{
+					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetVersionResponseValidationError{
-				field:  "Version",
+			return SetSecretTotpResponseValidationError{
+				field:  "Secret",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.Password != nil {
-		// no validation rules for Password
-	}
+	// no validation rules for VerificationCode
 
 	if len(errors) > 0 {
-		return GetVersionResponseMultiError(errors)
+		return SetSecretTotpResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetVersionResponseMultiError is an error wrapping multiple validation errors
-// returned by GetVersionResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetVersionResponseMultiError []error
+// SetSecretTotpResponseMultiError is an error wrapping multiple validation
+// errors returned by SetSecretTotpResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetSecretTotpResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetVersionResponseMultiError) Error() string {
+func (m SetSecretTotpResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2862,11 +7255,11 @@ func (m GetVersionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetVersionResponseMultiError) AllErrors() []error { return m }
+func (m SetSecretTotpResponseMultiError) AllErrors() []error { return m }
 
-// GetVersionResponseValidationError is the validation error returned by
-// GetVersionResponse.Validate if the designated constraints aren't met.
-type GetVersionResponseValidationError struct {
+// SetSecretTotpResponseValidationError is the validation error returned by
+// SetSecretTotpResponse.Validate if the designated constraints aren't met.
+type SetSecretTotpResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2874,24 +7267,24 @@ type GetVersionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetVersionResponseValidationError) Field() string { return e.field }
+func (e SetSecretTotpResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetVersionResponseValidationError) Reason() string { return e.reason }
+func (e SetSecretTotpResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetVersionResponseValidationError) Cause() error { return e.cause }
+func (e SetSecretTotpResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetVersionResponseValidationError) Key() bool { return e.key }
+func (e SetSecretTotpResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetVersionResponseValidationError) ErrorName() string {
-	return "GetVersionResponseValidationError"
+func (e SetSecretTotpResponseValidationError) ErrorName() string {
+	return "SetSecretTotpResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetVersionResponseValidationError) Error() string {
+func (e SetSecretTotpResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2903,14 +7296,14 @@ func (e GetVersionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetVersionResponse.%s: %s%s",
+		"invalid %sSetSecretTotpResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetVersionResponseValidationError{}
+var _ error = SetSecretTotpResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2918,50 +7311,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetVersionResponseValidationError{}
+} = SetSecretTotpResponseValidationError{}
 
-// Validate checks the field values on RestoreVersionRequest with the rules
+// Validate checks the field values on DeleteSecretTotpRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RestoreVersionRequest) Validate() error {
+func (m *DeleteSecretTotpRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RestoreVersionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteSecretTotpRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RestoreVersionRequestMultiError, or nil if none found.
-func (m *RestoreVersionRequest) ValidateAll() error {
+// DeleteSecretTotpRequestMultiError, or nil if none found.
+func (m *DeleteSecretTotpRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RestoreVersionRequest) validate(all bool) error {
+func (m *DeleteSecretTotpRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SecretId
-
-	// no validation rules for VersionNumber
-
-	// no validation rules for Comment
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return RestoreVersionRequestMultiError(errors)
+		return DeleteSecretTotpRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RestoreVersionRequestMultiError is an error wrapping multiple validation
-// errors returned by RestoreVersionRequest.ValidateAll() if the designated
+// DeleteSecretTotpRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSecretTotpRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RestoreVersionRequestMultiError []error
+type DeleteSecretTotpRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RestoreVersionRequestMultiError) Error() string {
+func (m DeleteSecretTotpRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2970,11 +7359,11 @@ func (m RestoreVersionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RestoreVersionRequestMultiError) AllErrors() []error { return m }
+func (m DeleteSecretTotpRequestMultiError) AllErrors() []error { return m }
 
-// RestoreVersionRequestValidationError is the validation error returned by
-// RestoreVersionRequest.Validate if the designated constraints aren't met.
-type RestoreVersionRequestValidationError struct {
+// DeleteSecretTotpRequestValidationError is the validation error returned by
+// DeleteSecretTotpRequest.Validate if the designated constraints aren't met.
+type DeleteSecretTotpRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2982,24 +7371,24 @@ type RestoreVersionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RestoreVersionRequestValidationError) Field() string { return e.field }
+func (e DeleteSecretTotpRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RestoreVersionRequestValidationError) Reason() string { return e.reason }
+func (e DeleteSecretTotpRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RestoreVersionRequestValidationError) Cause() error { return e.cause }
+func (e DeleteSecretTotpRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RestoreVersionRequestValidationError) Key() bool { return e.key }
+func (e DeleteSecretTotpRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RestoreVersionRequestValidationError) ErrorName() string {
-	return "RestoreVersionRequestValidationError"
+func (e DeleteSecretTotpRequestValidationError) ErrorName() string {
+	return "DeleteSecretTotpRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RestoreVersionRequestValidationError) Error() string {
+func (e DeleteSecretTotpRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3011,14 +7400,14 @@ func (e RestoreVersionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRestoreVersionRequest.%s: %s%s",
+		"invalid %sDeleteSecretTotpRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RestoreVersionRequestValidationError{}
+var _ error = DeleteSecretTotpRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3026,82 +7415,187 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RestoreVersionRequestValidationError{}
+} = DeleteSecretTotpRequestValidationError{}
 
-// Validate checks the field values on RestoreVersionResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetSecretAccessPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RestoreVersionResponse) Validate() error {
+func (m *SetSecretAccessPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RestoreVersionResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetSecretAccessPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RestoreVersionResponseMultiError, or nil if none found.
-func (m *RestoreVersionResponse) ValidateAll() error {
+// SetSecretAccessPolicyRequestMultiError, or nil if none found.
+func (m *SetSecretAccessPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RestoreVersionResponse) validate(all bool) error {
+func (m *SetSecretAccessPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
+		switch v := interface{}(m.GetPolicy()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RestoreVersionResponseValidationError{
-					field:  "Secret",
+				errors = append(errors, SetSecretAccessPolicyRequestValidationError{
+					field:  "Policy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RestoreVersionResponseValidationError{
-					field:  "Secret",
+				errors = append(errors, SetSecretAccessPolicyRequestValidationError{
+					field:  "Policy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RestoreVersionResponseValidationError{
-				field:  "Secret",
+			return SetSecretAccessPolicyRequestValidationError{
+				field:  "Policy",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if len(errors) > 0 {
+		return SetSecretAccessPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetSecretAccessPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by SetSecretAccessPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetSecretAccessPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSecretAccessPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSecretAccessPolicyRequestMultiError) AllErrors() []error { return m }
+
+// SetSecretAccessPolicyRequestValidationError is the validation error returned
+// by SetSecretAccessPolicyRequest.Validate if the designated constraints
+// aren't met.
+type SetSecretAccessPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSecretAccessPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSecretAccessPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSecretAccessPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSecretAccessPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSecretAccessPolicyRequestValidationError) ErrorName() string {
+	return "SetSecretAccessPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSecretAccessPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSecretAccessPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSecretAccessPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSecretAccessPolicyRequestValidationError{}
+
+// Validate checks the field values on SetSecretAccessPolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSecretAccessPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSecretAccessPolicyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetSecretAccessPolicyResponseMultiError, or nil if none found.
+func (m *SetSecretAccessPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSecretAccessPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetNewVersion()).(type) {
+		switch v := interface{}(m.GetSecret()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RestoreVersionResponseValidationError{
-					field:  "NewVersion",
+				errors = append(errors, SetSecretAccessPolicyResponseValidationError{
+					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RestoreVersionResponseValidationError{
-					field:  "NewVersion",
+				errors = append(errors, SetSecretAccessPolicyResponseValidationError{
+					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNewVersion()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RestoreVersionResponseValidationError{
-				field:  "NewVersion",
+			return SetSecretAccessPolicyResponseValidationError{
+				field:  "Secret",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3109,19 +7603,19 @@ func (m *RestoreVersionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RestoreVersionResponseMultiError(errors)
+		return SetSecretAccessPolicyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RestoreVersionResponseMultiError is an error wrapping multiple validation
-// errors returned by RestoreVersionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RestoreVersionResponseMultiError []error
+// SetSecretAccessPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by SetSecretAccessPolicyResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetSecretAccessPolicyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RestoreVersionResponseMultiError) Error() string {
+func (m SetSecretAccessPolicyResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3130,11 +7624,12 @@ func (m RestoreVersionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RestoreVersionResponseMultiError) AllErrors() []error { return m }
+func (m SetSecretAccessPolicyResponseMultiError) AllErrors() []error { return m }
 
-// RestoreVersionResponseValidationError is the validation error returned by
-// RestoreVersionResponse.Validate if the designated constraints aren't met.
-type RestoreVersionResponseValidationError struct {
+// SetSecretAccessPolicyResponseValidationError is the validation error
+// returned by SetSecretAccessPolicyResponse.Validate if the designated
+// constraints aren't met.
+type SetSecretAccessPolicyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3142,24 +7637,24 @@ type RestoreVersionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RestoreVersionResponseValidationError) Field() string { return e.field }
+func (e SetSecretAccessPolicyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RestoreVersionResponseValidationError) Reason() string { return e.reason }
+func (e SetSecretAccessPolicyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RestoreVersionResponseValidationError) Cause() error { return e.cause }
+func (e SetSecretAccessPolicyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RestoreVersionResponseValidationError) Key() bool { return e.key }
+func (e SetSecretAccessPolicyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RestoreVersionResponseValidationError) ErrorName() string {
-	return "RestoreVersionResponseValidationError"
+func (e SetSecretAccessPolicyResponseValidationError) ErrorName() string {
+	return "SetSecretAccessPolicyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RestoreVersionResponseValidationError) Error() string {
+func (e SetSecretAccessPolicyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3171,14 +7666,14 @@ func (e RestoreVersionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRestoreVersionResponse.%s: %s%s",
+		"invalid %sSetSecretAccessPolicyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RestoreVersionResponseValidationError{}
+var _ error = SetSecretAccessPolicyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3186,64 +7681,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RestoreVersionResponseValidationError{}
+} = SetSecretAccessPolicyResponseValidationError{}
 
-// Validate checks the field values on SearchSecretsRequest with the rules
+// Validate checks the field values on GetSecretUsageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchSecretsRequest) Validate() error {
+func (m *GetSecretUsageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchSecretsRequest with the rules
+// ValidateAll checks the field values on GetSecretUsageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SearchSecretsRequestMultiError, or nil if none found.
-func (m *SearchSecretsRequest) ValidateAll() error {
+// GetSecretUsageRequestMultiError, or nil if none found.
+func (m *GetSecretUsageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchSecretsRequest) validate(all bool) error {
+func (m *GetSecretUsageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Query
-
-	// no validation rules for IncludeSubfolders
-
-	if m.FolderId != nil {
-		// no validation rules for FolderId
-	}
-
-	if m.Page != nil {
-		// no validation rules for Page
-	}
-
-	if m.PageSize != nil {
-		// no validation rules for PageSize
-	}
+	// no validation rules for Id
 
-	if m.Status != nil {
-		// no validation rules for Status
+	if m.Days != nil {
+		// no validation rules for Days
 	}
 
 	if len(errors) > 0 {
-		return SearchSecretsRequestMultiError(errors)
+		return GetSecretUsageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchSecretsRequestMultiError is an error wrapping multiple validation
-// errors returned by SearchSecretsRequest.ValidateAll() if the designated
+// GetSecretUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSecretUsageRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SearchSecretsRequestMultiError []error
+type GetSecretUsageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchSecretsRequestMultiError) Error() string {
+func (m GetSecretUsageRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3252,11 +7733,11 @@ func (m SearchSecretsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchSecretsRequestMultiError) AllErrors() []error { return m }
+func (m GetSecretUsageRequestMultiError) AllErrors() []error { return m }
 
-// SearchSecretsRequestValidationError is the validation error returned by
-// SearchSecretsRequest.Validate if the designated constraints aren't met.
-type SearchSecretsRequestValidationError struct {
+// GetSecretUsageRequestValidationError is the validation error returned by
+// GetSecretUsageRequest.Validate if the designated constraints aren't met.
+type GetSecretUsageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3264,24 +7745,24 @@ type SearchSecretsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchSecretsRequestValidationError) Field() string { return e.field }
+func (e GetSecretUsageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchSecretsRequestValidationError) Reason() string { return e.reason }
+func (e GetSecretUsageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchSecretsRequestValidationError) Cause() error { return e.cause }
+func (e GetSecretUsageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchSecretsRequestValidationError) Key() bool { return e.key }
+func (e GetSecretUsageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchSecretsRequestValidationError) ErrorName() string {
-	return "SearchSecretsRequestValidationError"
+func (e GetSecretUsageRequestValidationError) ErrorName() string {
+	return "GetSecretUsageRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchSecretsRequestValidationError) Error() string {
+func (e GetSecretUsageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3293,14 +7774,14 @@ func (e SearchSecretsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchSecretsRequest.%s: %s%s",
+		"invalid %sGetSecretUsageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchSecretsRequestValidationError{}
+var _ error = GetSecretUsageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3308,47 +7789,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchSecretsRequestValidationError{}
+} = GetSecretUsageRequestValidationError{}
 
-// Validate checks the field values on SearchSecretsResponse with the rules
+// Validate checks the field values on GetSecretUsageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchSecretsResponse) Validate() error {
+func (m *GetSecretUsageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchSecretsResponse with the rules
+// ValidateAll checks the field values on GetSecretUsageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SearchSecretsResponseMultiError, or nil if none found.
-func (m *SearchSecretsResponse) ValidateAll() error {
+// GetSecretUsageResponseMultiError, or nil if none found.
+func (m *GetSecretUsageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchSecretsResponse) validate(all bool) error {
+func (m *GetSecretUsageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSecrets() {
+	if all {
+		switch v := interface{}(m.GetUsage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSecretUsageResponseValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSecretUsageResponseValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSecretUsageResponseValidationError{
+				field:  "Usage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDaily() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SearchSecretsResponseValidationError{
-						field:  fmt.Sprintf("Secrets[%v]", idx),
+					errors = append(errors, GetSecretUsageResponseValidationError{
+						field:  fmt.Sprintf("Daily[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, SearchSecretsResponseValidationError{
-						field:  fmt.Sprintf("Secrets[%v]", idx),
+					errors = append(errors, GetSecretUsageResponseValidationError{
+						field:  fmt.Sprintf("Daily[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3356,8 +7866,8 @@ func (m *SearchSecretsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SearchSecretsResponseValidationError{
-					field:  fmt.Sprintf("Secrets[%v]", idx),
+				return GetSecretUsageResponseValidationError{
+					field:  fmt.Sprintf("Daily[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3366,22 +7876,20 @@ func (m *SearchSecretsResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for Total
-
 	if len(errors) > 0 {
-		return SearchSecretsResponseMultiError(errors)
+		return GetSecretUsageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchSecretsResponseMultiError is an error wrapping multiple validation
-// errors returned by SearchSecretsResponse.ValidateAll() if the designated
+// GetSecretUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSecretUsageResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SearchSecretsResponseMultiError []error
+type GetSecretUsageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchSecretsResponseMultiError) Error() string {
+func (m GetSecretUsageResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3390,11 +7898,11 @@ func (m SearchSecretsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchSecretsResponseMultiError) AllErrors() []error { return m }
+func (m GetSecretUsageResponseMultiError) AllErrors() []error { return m }
 
-// SearchSecretsResponseValidationError is the validation error returned by
-// SearchSecretsResponse.Validate if the designated constraints aren't met.
-type SearchSecretsResponseValidationError struct {
+// GetSecretUsageResponseValidationError is the validation error returned by
+// GetSecretUsageResponse.Validate if the designated constraints aren't met.
+type GetSecretUsageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3402,24 +7910,24 @@ type SearchSecretsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchSecretsResponseValidationError) Field() string { return e.field }
+func (e GetSecretUsageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchSecretsResponseValidationError) Reason() string { return e.reason }
+func (e GetSecretUsageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchSecretsResponseValidationError) Cause() error { return e.cause }
+func (e GetSecretUsageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchSecretsResponseValidationError) Key() bool { return e.key }
+func (e GetSecretUsageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchSecretsResponseValidationError) ErrorName() string {
-	return "SearchSecretsResponseValidationError"
+func (e GetSecretUsageResponseValidationError) ErrorName() string {
+	return "GetSecretUsageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchSecretsResponseValidationError) Error() string {
+func (e GetSecretUsageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3431,14 +7939,14 @@ func (e SearchSecretsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchSecretsResponse.%s: %s%s",
+		"invalid %sGetSecretUsageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchSecretsResponseValidationError{}
+var _ error = GetSecretUsageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3446,46 +7954,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchSecretsResponseValidationError{}
+} = GetSecretUsageResponseValidationError{}
 
-// Validate checks the field values on GetSecretTotpRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSecretTotpRequest) Validate() error {
+// Validate checks the field values on SecretUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretUsage) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSecretTotpRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetSecretTotpRequestMultiError, or nil if none found.
-func (m *GetSecretTotpRequest) ValidateAll() error {
+// ValidateAll checks the field values on SecretUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretUsageMultiError, or
+// nil if none found.
+func (m *SecretUsage) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSecretTotpRequest) validate(all bool) error {
+func (m *SecretUsage) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for SecretId
+
+	// no validation rules for Name
+
+	// no validation rules for Reads
+
+	// no validation rules for Writes
+
+	if m.FolderId != nil {
+		// no validation rules for FolderId
+	}
+
+	if m.LastUsedDate != nil {
+		// no validation rules for LastUsedDate
+	}
 
 	if len(errors) > 0 {
-		return GetSecretTotpRequestMultiError(errors)
+		return SecretUsageMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSecretTotpRequestMultiError is an error wrapping multiple validation
-// errors returned by GetSecretTotpRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetSecretTotpRequestMultiError []error
+// SecretUsageMultiError is an error wrapping multiple validation errors
+// returned by SecretUsage.ValidateAll() if the designated constraints aren't met.
+type SecretUsageMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSecretTotpRequestMultiError) Error() string {
+func (m SecretUsageMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3494,11 +8015,11 @@ func (m GetSecretTotpRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSecretTotpRequestMultiError) AllErrors() []error { return m }
+func (m SecretUsageMultiError) AllErrors() []error { return m }
 
-// GetSecretTotpRequestValidationError is the validation error returned by
-// GetSecretTotpRequest.Validate if the designated constraints aren't met.
-type GetSecretTotpRequestValidationError struct {
+// SecretUsageValidationError is the validation error returned by
+// SecretUsage.Validate if the designated constraints aren't met.
+type SecretUsageValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3506,24 +8027,127 @@ type GetSecretTotpRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSecretTotpRequestValidationError) Field() string { return e.field }
+func (e SecretUsageValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSecretTotpRequestValidationError) Reason() string { return e.reason }
+func (e SecretUsageValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSecretTotpRequestValidationError) Cause() error { return e.cause }
+func (e SecretUsageValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSecretTotpRequestValidationError) Key() bool { return e.key }
+func (e SecretUsageValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSecretTotpRequestValidationError) ErrorName() string {
-	return "GetSecretTotpRequestValidationError"
+func (e SecretUsageValidationError) ErrorName() string { return "SecretUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretUsageValidationError{}
+
+// Validate checks the field values on DailyUsage with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DailyUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DailyUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DailyUsageMultiError, or
+// nil if none found.
+func (m *DailyUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DailyUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Reads
+
+	// no validation rules for Writes
+
+	if len(errors) > 0 {
+		return DailyUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// DailyUsageMultiError is an error wrapping multiple validation errors
+// returned by DailyUsage.ValidateAll() if the designated constraints aren't met.
+type DailyUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DailyUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DailyUsageMultiError) AllErrors() []error { return m }
+
+// DailyUsageValidationError is the validation error returned by
+// DailyUsage.Validate if the designated constraints aren't met.
+type DailyUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
 }
 
+// Field function returns field value.
+func (e DailyUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DailyUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DailyUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DailyUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DailyUsageValidationError) ErrorName() string { return "DailyUsageValidationError" }
+
 // Error satisfies the builtin error interface
-func (e GetSecretTotpRequestValidationError) Error() string {
+func (e DailyUsageValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3535,14 +8159,14 @@ func (e GetSecretTotpRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSecretTotpRequest.%s: %s%s",
+		"invalid %sDailyUsage.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSecretTotpRequestValidationError{}
+var _ error = DailyUsageValidationError{}
 
 var _ interface {
 	Field() string
@@ -3550,52 +8174,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSecretTotpRequestValidationError{}
+} = DailyUsageValidationError{}
 
-// Validate checks the field values on GetSecretTotpResponse with the rules
+// Validate checks the field values on GetSecretGraphRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSecretTotpResponse) Validate() error {
+func (m *GetSecretGraphRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSecretTotpResponse with the rules
+// ValidateAll checks the field values on GetSecretGraphRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSecretTotpResponseMultiError, or nil if none found.
-func (m *GetSecretTotpResponse) ValidateAll() error {
+// GetSecretGraphRequestMultiError, or nil if none found.
+func (m *GetSecretGraphRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSecretTotpResponse) validate(all bool) error {
+func (m *GetSecretGraphRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for TotpUrl
-
-	// no validation rules for CurrentCode
+	var errors []error
 
-	// no validation rules for RemainingSeconds
+	// no validation rules for Id
 
-	// no validation rules for Period
+	if m.ReaderDays != nil {
+		// no validation rules for ReaderDays
+	}
 
 	if len(errors) > 0 {
-		return GetSecretTotpResponseMultiError(errors)
+		return GetSecretGraphRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSecretTotpResponseMultiError is an error wrapping multiple validation
-// errors returned by GetSecretTotpResponse.ValidateAll() if the designated
+// GetSecretGraphRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSecretGraphRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetSecretTotpResponseMultiError []error
+type GetSecretGraphRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSecretTotpResponseMultiError) Error() string {
+func (m GetSecretGraphRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3604,11 +8226,11 @@ func (m GetSecretTotpResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSecretTotpResponseMultiError) AllErrors() []error { return m }
+func (m GetSecretGraphRequestMultiError) AllErrors() []error { return m }
 
-// GetSecretTotpResponseValidationError is the validation error returned by
-// GetSecretTotpResponse.Validate if the designated constraints aren't met.
-type GetSecretTotpResponseValidationError struct {
+// GetSecretGraphRequestValidationError is the validation error returned by
+// GetSecretGraphRequest.Validate if the designated constraints aren't met.
+type GetSecretGraphRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3616,24 +8238,24 @@ type GetSecretTotpResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSecretTotpResponseValidationError) Field() string { return e.field }
+func (e GetSecretGraphRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSecretTotpResponseValidationError) Reason() string { return e.reason }
+func (e GetSecretGraphRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSecretTotpResponseValidationError) Cause() error { return e.cause }
+func (e GetSecretGraphRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSecretTotpResponseValidationError) Key() bool { return e.key }
+func (e GetSecretGraphRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSecretTotpResponseValidationError) ErrorName() string {
-	return "GetSecretTotpResponseValidationError"
+func (e GetSecretGraphRequestValidationError) ErrorName() string {
+	return "GetSecretGraphRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSecretTotpResponseValidationError) Error() string {
+func (e GetSecretGraphRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3645,14 +8267,14 @@ func (e GetSecretTotpResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSecretTotpResponse.%s: %s%s",
+		"invalid %sGetSecretGraphRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSecretTotpResponseValidationError{}
+var _ error = GetSecretGraphRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3660,48 +8282,116 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSecretTotpResponseValidationError{}
+} = GetSecretGraphRequestValidationError{}
 
-// Validate checks the field values on SetSecretTotpRequest with the rules
+// Validate checks the field values on GetSecretGraphResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetSecretTotpRequest) Validate() error {
+func (m *GetSecretGraphResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetSecretTotpRequest with the rules
+// ValidateAll checks the field values on GetSecretGraphResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetSecretTotpRequestMultiError, or nil if none found.
-func (m *SetSecretTotpRequest) ValidateAll() error {
+// GetSecretGraphResponseMultiError, or nil if none found.
+func (m *GetSecretGraphResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetSecretTotpRequest) validate(all bool) error {
+func (m *GetSecretGraphResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for RootNodeId
 
-	// no validation rules for TotpUrl
+	for idx, item := range m.GetNodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSecretGraphResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSecretGraphResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSecretGraphResponseValidationError{
+					field:  fmt.Sprintf("Nodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEdges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSecretGraphResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSecretGraphResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSecretGraphResponseValidationError{
+					field:  fmt.Sprintf("Edges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HiddenDependents
 
 	if len(errors) > 0 {
-		return SetSecretTotpRequestMultiError(errors)
+		return GetSecretGraphResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetSecretTotpRequestMultiError is an error wrapping multiple validation
-// errors returned by SetSecretTotpRequest.ValidateAll() if the designated
+// GetSecretGraphResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSecretGraphResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SetSecretTotpRequestMultiError []error
+type GetSecretGraphResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetSecretTotpRequestMultiError) Error() string {
+func (m GetSecretGraphResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3710,11 +8400,11 @@ func (m SetSecretTotpRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetSecretTotpRequestMultiError) AllErrors() []error { return m }
+func (m GetSecretGraphResponseMultiError) AllErrors() []error { return m }
 
-// SetSecretTotpRequestValidationError is the validation error returned by
-// SetSecretTotpRequest.Validate if the designated constraints aren't met.
-type SetSecretTotpRequestValidationError struct {
+// GetSecretGraphResponseValidationError is the validation error returned by
+// GetSecretGraphResponse.Validate if the designated constraints aren't met.
+type GetSecretGraphResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3722,24 +8412,24 @@ type SetSecretTotpRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetSecretTotpRequestValidationError) Field() string { return e.field }
+func (e GetSecretGraphResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetSecretTotpRequestValidationError) Reason() string { return e.reason }
+func (e GetSecretGraphResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetSecretTotpRequestValidationError) Cause() error { return e.cause }
+func (e GetSecretGraphResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetSecretTotpRequestValidationError) Key() bool { return e.key }
+func (e GetSecretGraphResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetSecretTotpRequestValidationError) ErrorName() string {
-	return "SetSecretTotpRequestValidationError"
+func (e GetSecretGraphResponseValidationError) ErrorName() string {
+	return "GetSecretGraphResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetSecretTotpRequestValidationError) Error() string {
+func (e GetSecretGraphResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3751,14 +8441,14 @@ func (e SetSecretTotpRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetSecretTotpRequest.%s: %s%s",
+		"invalid %sGetSecretGraphResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetSecretTotpRequestValidationError{}
+var _ error = GetSecretGraphResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3766,75 +8456,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetSecretTotpRequestValidationError{}
+} = GetSecretGraphResponseValidationError{}
 
-// Validate checks the field values on SetSecretTotpResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetSecretTotpResponse) Validate() error {
+// Validate checks the field values on SecretGraphNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SecretGraphNode) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetSecretTotpResponse with the rules
+// ValidateAll checks the field values on SecretGraphNode with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetSecretTotpResponseMultiError, or nil if none found.
-func (m *SetSecretTotpResponse) ValidateAll() error {
+// SecretGraphNodeMultiError, or nil if none found.
+func (m *SecretGraphNode) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetSecretTotpResponse) validate(all bool) error {
+func (m *SecretGraphNode) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetSecretTotpResponseValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetSecretTotpResponseValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetSecretTotpResponseValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
 
-	// no validation rules for VerificationCode
+	// no validation rules for Kind
+
+	// no validation rules for ResourceId
+
+	// no validation rules for Label
 
 	if len(errors) > 0 {
-		return SetSecretTotpResponseMultiError(errors)
+		return SecretGraphNodeMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetSecretTotpResponseMultiError is an error wrapping multiple validation
-// errors returned by SetSecretTotpResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SetSecretTotpResponseMultiError []error
+// SecretGraphNodeMultiError is an error wrapping multiple validation errors
+// returned by SecretGraphNode.ValidateAll() if the designated constraints
+// aren't met.
+type SecretGraphNodeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetSecretTotpResponseMultiError) Error() string {
+func (m SecretGraphNodeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3843,11 +8510,11 @@ func (m SetSecretTotpResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetSecretTotpResponseMultiError) AllErrors() []error { return m }
+func (m SecretGraphNodeMultiError) AllErrors() []error { return m }
 
-// SetSecretTotpResponseValidationError is the validation error returned by
-// SetSecretTotpResponse.Validate if the designated constraints aren't met.
-type SetSecretTotpResponseValidationError struct {
+// SecretGraphNodeValidationError is the validation error returned by
+// SecretGraphNode.Validate if the designated constraints aren't met.
+type SecretGraphNodeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3855,24 +8522,22 @@ type SetSecretTotpResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetSecretTotpResponseValidationError) Field() string { return e.field }
+func (e SecretGraphNodeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetSecretTotpResponseValidationError) Reason() string { return e.reason }
+func (e SecretGraphNodeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetSecretTotpResponseValidationError) Cause() error { return e.cause }
+func (e SecretGraphNodeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetSecretTotpResponseValidationError) Key() bool { return e.key }
+func (e SecretGraphNodeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetSecretTotpResponseValidationError) ErrorName() string {
-	return "SetSecretTotpResponseValidationError"
-}
+func (e SecretGraphNodeValidationError) ErrorName() string { return "SecretGraphNodeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetSecretTotpResponseValidationError) Error() string {
+func (e SecretGraphNodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3884,14 +8549,14 @@ func (e SetSecretTotpResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetSecretTotpResponse.%s: %s%s",
+		"invalid %sSecretGraphNode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetSecretTotpResponseValidationError{}
+var _ error = SecretGraphNodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -3899,46 +8564,120 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetSecretTotpResponseValidationError{}
+} = SecretGraphNodeValidationError{}
 
-// Validate checks the field values on DeleteSecretTotpRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSecretTotpRequest) Validate() error {
+// Validate checks the field values on SecretGraphEdge with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SecretGraphEdge) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSecretTotpRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SecretGraphEdge with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteSecretTotpRequestMultiError, or nil if none found.
-func (m *DeleteSecretTotpRequest) ValidateAll() error {
+// SecretGraphEdgeMultiError, or nil if none found.
+func (m *SecretGraphEdge) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSecretTotpRequest) validate(all bool) error {
+func (m *SecretGraphEdge) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Kind
+
+	// no validation rules for Label
+
+	// no validation rules for Count
+
+	if m.ExpiresAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiresAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecretGraphEdgeValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecretGraphEdgeValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecretGraphEdgeValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.LastTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecretGraphEdgeValidationError{
+						field:  "LastTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecretGraphEdgeValidationError{
+						field:  "LastTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecretGraphEdgeValidationError{
+					field:  "LastTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteSecretTotpRequestMultiError(errors)
+		return SecretGraphEdgeMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteSecretTotpRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteSecretTotpRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteSecretTotpRequestMultiError []error
+// SecretGraphEdgeMultiError is an error wrapping multiple validation errors
+// returned by SecretGraphEdge.ValidateAll() if the designated constraints
+// aren't met.
+type SecretGraphEdgeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSecretTotpRequestMultiError) Error() string {
+func (m SecretGraphEdgeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3947,11 +8686,11 @@ func (m DeleteSecretTotpRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSecretTotpRequestMultiError) AllErrors() []error { return m }
+func (m SecretGraphEdgeMultiError) AllErrors() []error { return m }
 
-// DeleteSecretTotpRequestValidationError is the validation error returned by
-// DeleteSecretTotpRequest.Validate if the designated constraints aren't met.
-type DeleteSecretTotpRequestValidationError struct {
+// SecretGraphEdgeValidationError is the validation error returned by
+// SecretGraphEdge.Validate if the designated constraints aren't met.
+type SecretGraphEdgeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3959,24 +8698,22 @@ type DeleteSecretTotpRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSecretTotpRequestValidationError) Field() string { return e.field }
+func (e SecretGraphEdgeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSecretTotpRequestValidationError) Reason() string { return e.reason }
+func (e SecretGraphEdgeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSecretTotpRequestValidationError) Cause() error { return e.cause }
+func (e SecretGraphEdgeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSecretTotpRequestValidationError) Key() bool { return e.key }
+func (e SecretGraphEdgeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSecretTotpRequestValidationError) ErrorName() string {
-	return "DeleteSecretTotpRequestValidationError"
-}
+func (e SecretGraphEdgeValidationError) ErrorName() string { return "SecretGraphEdgeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteSecretTotpRequestValidationError) Error() string {
+func (e SecretGraphEdgeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3988,14 +8725,14 @@ func (e DeleteSecretTotpRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSecretTotpRequest.%s: %s%s",
+		"invalid %sSecretGraphEdge.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSecretTotpRequestValidationError{}
+var _ error = SecretGraphEdgeValidationError{}
 
 var _ interface {
 	Field() string
@@ -4003,4 +8740,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSecretTotpRequestValidationError{}
+} = SecretGraphEdgeValidationError{}
@@ -20,21 +20,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WardenSecretService_CreateSecret_FullMethodName         = "/warden.service.v1.WardenSecretService/CreateSecret"
-	WardenSecretService_GetSecret_FullMethodName            = "/warden.service.v1.WardenSecretService/GetSecret"
-	WardenSecretService_GetSecretPassword_FullMethodName    = "/warden.service.v1.WardenSecretService/GetSecretPassword"
-	WardenSecretService_ListSecrets_FullMethodName          = "/warden.service.v1.WardenSecretService/ListSecrets"
-	WardenSecretService_UpdateSecret_FullMethodName         = "/warden.service.v1.WardenSecretService/UpdateSecret"
-	WardenSecretService_UpdateSecretPassword_FullMethodName = "/warden.service.v1.WardenSecretService/UpdateSecretPassword"
-	WardenSecretService_DeleteSecret_FullMethodName         = "/warden.service.v1.WardenSecretService/DeleteSecret"
-	WardenSecretService_MoveSecret_FullMethodName           = "/warden.service.v1.WardenSecretService/MoveSecret"
-	WardenSecretService_ListVersions_FullMethodName         = "/warden.service.v1.WardenSecretService/ListVersions"
-	WardenSecretService_GetVersion_FullMethodName           = "/warden.service.v1.WardenSecretService/GetVersion"
-	WardenSecretService_RestoreVersion_FullMethodName       = "/warden.service.v1.WardenSecretService/RestoreVersion"
-	WardenSecretService_SearchSecrets_FullMethodName        = "/warden.service.v1.WardenSecretService/SearchSecrets"
-	WardenSecretService_GetSecretTotp_FullMethodName        = "/warden.service.v1.WardenSecretService/GetSecretTotp"
-	WardenSecretService_SetSecretTotp_FullMethodName        = "/warden.service.v1.WardenSecretService/SetSecretTotp"
-	WardenSecretService_DeleteSecretTotp_FullMethodName     = "/warden.service.v1.WardenSecretService/DeleteSecretTotp"
+	WardenSecretService_CreateSecret_FullMethodName            = "/warden.service.v1.WardenSecretService/CreateSecret"
+	WardenSecretService_GetSecret_FullMethodName               = "/warden.service.v1.WardenSecretService/GetSecret"
+	WardenSecretService_GetSecretPassword_FullMethodName       = "/warden.service.v1.WardenSecretService/GetSecretPassword"
+	WardenSecretService_GetSecretPasswordMasked_FullMethodName = "/warden.service.v1.WardenSecretService/GetSecretPasswordMasked"
+	WardenSecretService_CreateRetrievalToken_FullMethodName    = "/warden.service.v1.WardenSecretService/CreateRetrievalToken"
+	WardenSecretService_RedeemRetrievalToken_FullMethodName    = "/warden.service.v1.WardenSecretService/RedeemRetrievalToken"
+	WardenSecretService_GetSecretByPath_FullMethodName         = "/warden.service.v1.WardenSecretService/GetSecretByPath"
+	WardenSecretService_FetchSecretsStream_FullMethodName      = "/warden.service.v1.WardenSecretService/FetchSecretsStream"
+	WardenSecretService_ListSecrets_FullMethodName             = "/warden.service.v1.WardenSecretService/ListSecrets"
+	WardenSecretService_UpdateSecret_FullMethodName            = "/warden.service.v1.WardenSecretService/UpdateSecret"
+	WardenSecretService_UpdateSecretPassword_FullMethodName    = "/warden.service.v1.WardenSecretService/UpdateSecretPassword"
+	WardenSecretService_DeleteSecret_FullMethodName            = "/warden.service.v1.WardenSecretService/DeleteSecret"
+	WardenSecretService_MoveSecret_FullMethodName              = "/warden.service.v1.WardenSecretService/MoveSecret"
+	WardenSecretService_ListVersions_FullMethodName            = "/warden.service.v1.WardenSecretService/ListVersions"
+	WardenSecretService_GetVersion_FullMethodName              = "/warden.service.v1.WardenSecretService/GetVersion"
+	WardenSecretService_RestoreVersion_FullMethodName          = "/warden.service.v1.WardenSecretService/RestoreVersion"
+	WardenSecretService_DeleteVersion_FullMethodName           = "/warden.service.v1.WardenSecretService/DeleteVersion"
+	WardenSecretService_DestroyVersion_FullMethodName          = "/warden.service.v1.WardenSecretService/DestroyVersion"
+	WardenSecretService_UndeleteVersion_FullMethodName         = "/warden.service.v1.WardenSecretService/UndeleteVersion"
+	WardenSecretService_ListVersionPins_FullMethodName         = "/warden.service.v1.WardenSecretService/ListVersionPins"
+	WardenSecretService_DeleteVersionPin_FullMethodName        = "/warden.service.v1.WardenSecretService/DeleteVersionPin"
+	WardenSecretService_SearchSecrets_FullMethodName           = "/warden.service.v1.WardenSecretService/SearchSecrets"
+	WardenSecretService_GetSecretTotp_FullMethodName           = "/warden.service.v1.WardenSecretService/GetSecretTotp"
+	WardenSecretService_SetSecretTotp_FullMethodName           = "/warden.service.v1.WardenSecretService/SetSecretTotp"
+	WardenSecretService_DeleteSecretTotp_FullMethodName        = "/warden.service.v1.WardenSecretService/DeleteSecretTotp"
+	WardenSecretService_SetSecretAccessPolicy_FullMethodName   = "/warden.service.v1.WardenSecretService/SetSecretAccessPolicy"
+	WardenSecretService_GetSecretUsage_FullMethodName          = "/warden.service.v1.WardenSecretService/GetSecretUsage"
+	WardenSecretService_GetSecretGraph_FullMethodName          = "/warden.service.v1.WardenSecretService/GetSecretGraph"
 )
 
 // WardenSecretServiceClient is the client API for WardenSecretService service.
@@ -49,6 +62,21 @@ type WardenSecretServiceClient interface {
 	GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error)
 	// Retrieve the password for a secret
 	GetSecretPassword(ctx context.Context, in *GetSecretPasswordRequest, opts ...grpc.CallOption) (*GetSecretPasswordResponse, error)
+	// Get a hint of a password (length, first and last characters, checksum
+	// prefix) without the password itself
+	GetSecretPasswordMasked(ctx context.Context, in *GetSecretPasswordMaskedRequest, opts ...grpc.CallOption) (*GetSecretPasswordMaskedResponse, error)
+	// Issue a short-lived token that RedeemRetrievalToken exchanges for a
+	// version of the password once
+	CreateRetrievalToken(ctx context.Context, in *CreateRetrievalTokenRequest, opts ...grpc.CallOption) (*CreateRetrievalTokenResponse, error)
+	// Exchange a retrieval token for the password it was issued for
+	RedeemRetrievalToken(ctx context.Context, in *RedeemRetrievalTokenRequest, opts ...grpc.CallOption) (*RedeemRetrievalTokenResponse, error)
+	// Get the password and selected metadata of a secret by folder path and
+	// name in one call (External Secrets Operator, Terraform)
+	GetSecretByPath(ctx context.Context, in *GetSecretByPathRequest, opts ...grpc.CallOption) (*GetSecretByPathResponse, error)
+	// Subscribe to secrets by path: the current values are sent first, then
+	// each change of a secret (rotation, restore, update, move, deletion or
+	// lost access) is pushed as it happens. gRPC only.
+	FetchSecretsStream(ctx context.Context, in *FetchSecretsStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FetchSecretsStreamResponse], error)
 	// List secrets in a folder
 	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
 	// Update secret metadata
@@ -65,6 +93,16 @@ type WardenSecretServiceClient interface {
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
 	// Restore a previous version as current
 	RestoreVersion(ctx context.Context, in *RestoreVersionRequest, opts ...grpc.CallOption) (*RestoreVersionResponse, error)
+	// Soft-delete a previous version in Vault (owners only)
+	DeleteVersion(ctx context.Context, in *DeleteVersionRequest, opts ...grpc.CallOption) (*DeleteVersionResponse, error)
+	// Permanently destroy a previous version in Vault (owners only)
+	DestroyVersion(ctx context.Context, in *DestroyVersionRequest, opts ...grpc.CallOption) (*DestroyVersionResponse, error)
+	// Recover a soft-deleted version (owners only)
+	UndeleteVersion(ctx context.Context, in *UndeleteVersionRequest, opts ...grpc.CallOption) (*UndeleteVersionResponse, error)
+	// List the consumers pinned to versions of a secret
+	ListVersionPins(ctx context.Context, in *ListVersionPinsRequest, opts ...grpc.CallOption) (*ListVersionPinsResponse, error)
+	// Release a version pin (the consumer that created it or an owner)
+	DeleteVersionPin(ctx context.Context, in *DeleteVersionPinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Search secrets across folders
 	SearchSecrets(ctx context.Context, in *SearchSecretsRequest, opts ...grpc.CallOption) (*SearchSecretsResponse, error)
 	// Get TOTP code for a secret (returns current code + remaining seconds)
@@ -73,6 +111,15 @@ type WardenSecretServiceClient interface {
 	SetSecretTotp(ctx context.Context, in *SetSecretTotpRequest, opts ...grpc.CallOption) (*SetSecretTotpResponse, error)
 	// Remove the TOTP authenticator from a secret
 	DeleteSecretTotp(ctx context.Context, in *DeleteSecretTotpRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Set the network and time restrictions on access to a secret (owner only)
+	SetSecretAccessPolicy(ctx context.Context, in *SetSecretAccessPolicyRequest, opts ...grpc.CallOption) (*SetSecretAccessPolicyResponse, error)
+	// Get the daily read and write counts of a secret, as aggregated nightly
+	// from the audit log
+	GetSecretUsage(ctx context.Context, in *GetSecretUsageRequest, opts ...grpc.CallOption) (*GetSecretUsageResponse, error)
+	// Get what a secret is connected to (folders, collections, references,
+	// permissions and recent readers) as a graph, to see what rotating or
+	// deleting it affects
+	GetSecretGraph(ctx context.Context, in *GetSecretGraphRequest, opts ...grpc.CallOption) (*GetSecretGraphResponse, error)
 }
 
 type wardenSecretServiceClient struct {
@@ -113,6 +160,65 @@ func (c *wardenSecretServiceClient) GetSecretPassword(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *wardenSecretServiceClient) GetSecretPasswordMasked(ctx context.Context, in *GetSecretPasswordMaskedRequest, opts ...grpc.CallOption) (*GetSecretPasswordMaskedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSecretPasswordMaskedResponse)
+	err := c.cc.Invoke(ctx, WardenSecretService_GetSecretPasswordMasked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSecretServiceClient) CreateRetrievalToken(ctx context.Context, in *CreateRetrievalTokenRequest, opts ...grpc.CallOption) (*CreateRetrievalTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRetrievalTokenResponse)
+	err := c.cc.Invoke(ctx, WardenSecretService_CreateRetrievalToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSecretServiceClient) RedeemRetrievalToken(ctx context.Context, in *RedeemRetrievalTokenRequest, opts ...grpc.CallOption) (*RedeemRetrievalTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemRetrievalTokenResponse)
+	err := c.cc.Invoke(ctx, WardenSecretService_RedeemRetrievalToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSecretServiceClient) GetSecretByPath(ctx context.Context, in *GetSecretByPathRequest, opts ...grpc.CallOption) (*GetSecretByPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSecretByPathResponse)
+	err := c.cc.Invoke(ctx, WardenSecretService_GetSecretByPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSecretServiceClient) FetchSecretsStream(ctx context.Context, in *FetchSecretsStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FetchSecretsStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WardenSecretService_ServiceDesc.Streams[0], WardenSecretService_FetchSecretsStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[FetchSecretsStreamRequest, FetchSecretsStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WardenSecretService_FetchSecretsStreamClient = grpc.ServerStreamingClient[FetchSecretsStreamResponse]
+
 func (c *wardenSecretServiceClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSecretsResponse)
@@ -193,6 +299,56 @@ func (c *wardenSecretServiceClient) RestoreVersion(ctx context.Context, in *Rest
 	return out, nil
 }
 
+func (c *wardenSecretServiceClient) DeleteVersion(ctx context.Context, in *DeleteVersionRequest, opts ...grpc.CallOption) (*DeleteVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVersionResponse)
+	err := c.cc.Invoke(ctx, WardenSecretService_DeleteVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSecretServiceClient) DestroyVersion(ctx context.Context, in *DestroyVersionRequest, opts ...grpc.CallOption) (*DestroyVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DestroyVersionResponse)
+	err := c.cc.Invoke(ctx, WardenSecretService_DestroyVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSecretServiceClient) UndeleteVersion(ctx context.Context, in *UndeleteVersionRequest, opts ...grpc.CallOption) (*UndeleteVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndeleteVersionResponse)
+	err := c.cc.Invoke(ctx, WardenSecretService_UndeleteVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSecretServiceClient) ListVersionPins(ctx context.Context, in *ListVersionPinsRequest, opts ...grpc.CallOption) (*ListVersionPinsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVersionPinsResponse)
+	err := c.cc.Invoke(ctx, WardenSecretService_ListVersionPins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSecretServiceClient) DeleteVersionPin(ctx context.Context, in *DeleteVersionPinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WardenSecretService_DeleteVersionPin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *wardenSecretServiceClient) SearchSecrets(ctx context.Context, in *SearchSecretsRequest, opts ...grpc.CallOption) (*SearchSecretsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchSecretsResponse)
@@ -233,6 +389,36 @@ func (c *wardenSecretServiceClient) DeleteSecretTotp(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *wardenSecretServiceClient) SetSecretAccessPolicy(ctx context.Context, in *SetSecretAccessPolicyRequest, opts ...grpc.CallOption) (*SetSecretAccessPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSecretAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, WardenSecretService_SetSecretAccessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSecretServiceClient) GetSecretUsage(ctx context.Context, in *GetSecretUsageRequest, opts ...grpc.CallOption) (*GetSecretUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSecretUsageResponse)
+	err := c.cc.Invoke(ctx, WardenSecretService_GetSecretUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSecretServiceClient) GetSecretGraph(ctx context.Context, in *GetSecretGraphRequest, opts ...grpc.CallOption) (*GetSecretGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSecretGraphResponse)
+	err := c.cc.Invoke(ctx, WardenSecretService_GetSecretGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WardenSecretServiceServer is the server API for WardenSecretService service.
 // All implementations must embed UnimplementedWardenSecretServiceServer
 // for forward compatibility.
@@ -245,6 +431,21 @@ type WardenSecretServiceServer interface {
 	GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error)
 	// Retrieve the password for a secret
 	GetSecretPassword(context.Context, *GetSecretPasswordRequest) (*GetSecretPasswordResponse, error)
+	// Get a hint of a password (length, first and last characters, checksum
+	// prefix) without the password itself
+	GetSecretPasswordMasked(context.Context, *GetSecretPasswordMaskedRequest) (*GetSecretPasswordMaskedResponse, error)
+	// Issue a short-lived token that RedeemRetrievalToken exchanges for a
+	// version of the password once
+	CreateRetrievalToken(context.Context, *CreateRetrievalTokenRequest) (*CreateRetrievalTokenResponse, error)
+	// Exchange a retrieval token for the password it was issued for
+	RedeemRetrievalToken(context.Context, *RedeemRetrievalTokenRequest) (*RedeemRetrievalTokenResponse, error)
+	// Get the password and selected metadata of a secret by folder path and
+	// name in one call (External Secrets Operator, Terraform)
+	GetSecretByPath(context.Context, *GetSecretByPathRequest) (*GetSecretByPathResponse, error)
+	// Subscribe to secrets by path: the current values are sent first, then
+	// each change of a secret (rotation, restore, update, move, deletion or
+	// lost access) is pushed as it happens. gRPC only.
+	FetchSecretsStream(*FetchSecretsStreamRequest, grpc.ServerStreamingServer[FetchSecretsStreamResponse]) error
 	// List secrets in a folder
 	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
 	// Update secret metadata
@@ -261,6 +462,16 @@ type WardenSecretServiceServer interface {
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
 	// Restore a previous version as current
 	RestoreVersion(context.Context, *RestoreVersionRequest) (*RestoreVersionResponse, error)
+	// Soft-delete a previous version in Vault (owners only)
+	DeleteVersion(context.Context, *DeleteVersionRequest) (*DeleteVersionResponse, error)
+	// Permanently destroy a previous version in Vault (owners only)
+	DestroyVersion(context.Context, *DestroyVersionRequest) (*DestroyVersionResponse, error)
+	// Recover a soft-deleted version (owners only)
+	UndeleteVersion(context.Context, *UndeleteVersionRequest) (*UndeleteVersionResponse, error)
+	// List the consumers pinned to versions of a secret
+	ListVersionPins(context.Context, *ListVersionPinsRequest) (*ListVersionPinsResponse, error)
+	// Release a version pin (the consumer that created it or an owner)
+	DeleteVersionPin(context.Context, *DeleteVersionPinRequest) (*emptypb.Empty, error)
 	// Search secrets across folders
 	SearchSecrets(context.Context, *SearchSecretsRequest) (*SearchSecretsResponse, error)
 	// Get TOTP code for a secret (returns current code + remaining seconds)
@@ -269,6 +480,15 @@ type WardenSecretServiceServer interface {
 	SetSecretTotp(context.Context, *SetSecretTotpRequest) (*SetSecretTotpResponse, error)
 	// Remove the TOTP authenticator from a secret
 	DeleteSecretTotp(context.Context, *DeleteSecretTotpRequest) (*emptypb.Empty, error)
+	// Set the network and time restrictions on access to a secret (owner only)
+	SetSecretAccessPolicy(context.Context, *SetSecretAccessPolicyRequest) (*SetSecretAccessPolicyResponse, error)
+	// Get the daily read and write counts of a secret, as aggregated nightly
+	// from the audit log
+	GetSecretUsage(context.Context, *GetSecretUsageRequest) (*GetSecretUsageResponse, error)
+	// Get what a secret is connected to (folders, collections, references,
+	// permissions and recent readers) as a graph, to see what rotating or
+	// deleting it affects
+	GetSecretGraph(context.Context, *GetSecretGraphRequest) (*GetSecretGraphResponse, error)
 	mustEmbedUnimplementedWardenSecretServiceServer()
 }
 
@@ -288,6 +508,21 @@ func (UnimplementedWardenSecretServiceServer) GetSecret(context.Context, *GetSec
 func (UnimplementedWardenSecretServiceServer) GetSecretPassword(context.Context, *GetSecretPasswordRequest) (*GetSecretPasswordResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSecretPassword not implemented")
 }
+func (UnimplementedWardenSecretServiceServer) GetSecretPasswordMasked(context.Context, *GetSecretPasswordMaskedRequest) (*GetSecretPasswordMaskedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSecretPasswordMasked not implemented")
+}
+func (UnimplementedWardenSecretServiceServer) CreateRetrievalToken(context.Context, *CreateRetrievalTokenRequest) (*CreateRetrievalTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRetrievalToken not implemented")
+}
+func (UnimplementedWardenSecretServiceServer) RedeemRetrievalToken(context.Context, *RedeemRetrievalTokenRequest) (*RedeemRetrievalTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedeemRetrievalToken not implemented")
+}
+func (UnimplementedWardenSecretServiceServer) GetSecretByPath(context.Context, *GetSecretByPathRequest) (*GetSecretByPathResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSecretByPath not implemented")
+}
+func (UnimplementedWardenSecretServiceServer) FetchSecretsStream(*FetchSecretsStreamRequest, grpc.ServerStreamingServer[FetchSecretsStreamResponse]) error {
+	return status.Error(codes.Unimplemented, "method FetchSecretsStream not implemented")
+}
 func (UnimplementedWardenSecretServiceServer) ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListSecrets not implemented")
 }
@@ -312,6 +547,21 @@ func (UnimplementedWardenSecretServiceServer) GetVersion(context.Context, *GetVe
 func (UnimplementedWardenSecretServiceServer) RestoreVersion(context.Context, *RestoreVersionRequest) (*RestoreVersionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RestoreVersion not implemented")
 }
+func (UnimplementedWardenSecretServiceServer) DeleteVersion(context.Context, *DeleteVersionRequest) (*DeleteVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVersion not implemented")
+}
+func (UnimplementedWardenSecretServiceServer) DestroyVersion(context.Context, *DestroyVersionRequest) (*DestroyVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DestroyVersion not implemented")
+}
+func (UnimplementedWardenSecretServiceServer) UndeleteVersion(context.Context, *UndeleteVersionRequest) (*UndeleteVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UndeleteVersion not implemented")
+}
+func (UnimplementedWardenSecretServiceServer) ListVersionPins(context.Context, *ListVersionPinsRequest) (*ListVersionPinsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVersionPins not implemented")
+}
+func (UnimplementedWardenSecretServiceServer) DeleteVersionPin(context.Context, *DeleteVersionPinRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVersionPin not implemented")
+}
 func (UnimplementedWardenSecretServiceServer) SearchSecrets(context.Context, *SearchSecretsRequest) (*SearchSecretsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SearchSecrets not implemented")
 }
@@ -324,6 +574,15 @@ func (UnimplementedWardenSecretServiceServer) SetSecretTotp(context.Context, *Se
 func (UnimplementedWardenSecretServiceServer) DeleteSecretTotp(context.Context, *DeleteSecretTotpRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteSecretTotp not implemented")
 }
+func (UnimplementedWardenSecretServiceServer) SetSecretAccessPolicy(context.Context, *SetSecretAccessPolicyRequest) (*SetSecretAccessPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSecretAccessPolicy not implemented")
+}
+func (UnimplementedWardenSecretServiceServer) GetSecretUsage(context.Context, *GetSecretUsageRequest) (*GetSecretUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSecretUsage not implemented")
+}
+func (UnimplementedWardenSecretServiceServer) GetSecretGraph(context.Context, *GetSecretGraphRequest) (*GetSecretGraphResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSecretGraph not implemented")
+}
 func (UnimplementedWardenSecretServiceServer) mustEmbedUnimplementedWardenSecretServiceServer() {}
 func (UnimplementedWardenSecretServiceServer) testEmbeddedByValue()                             {}
 
@@ -399,6 +658,89 @@ func _WardenSecretService_GetSecretPassword_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WardenSecretService_GetSecretPasswordMasked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretPasswordMaskedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).GetSecretPasswordMasked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_GetSecretPasswordMasked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).GetSecretPasswordMasked(ctx, req.(*GetSecretPasswordMaskedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSecretService_CreateRetrievalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRetrievalTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).CreateRetrievalToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_CreateRetrievalToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).CreateRetrievalToken(ctx, req.(*CreateRetrievalTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSecretService_RedeemRetrievalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemRetrievalTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).RedeemRetrievalToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_RedeemRetrievalToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).RedeemRetrievalToken(ctx, req.(*RedeemRetrievalTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSecretService_GetSecretByPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretByPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).GetSecretByPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_GetSecretByPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).GetSecretByPath(ctx, req.(*GetSecretByPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSecretService_FetchSecretsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FetchSecretsStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WardenSecretServiceServer).FetchSecretsStream(m, &grpc.GenericServerStream[FetchSecretsStreamRequest, FetchSecretsStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WardenSecretService_FetchSecretsStreamServer = grpc.ServerStreamingServer[FetchSecretsStreamResponse]
+
 func _WardenSecretService_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSecretsRequest)
 	if err := dec(in); err != nil {
@@ -543,6 +885,96 @@ func _WardenSecretService_RestoreVersion_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WardenSecretService_DeleteVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).DeleteVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_DeleteVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).DeleteVersion(ctx, req.(*DeleteVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSecretService_DestroyVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroyVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).DestroyVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_DestroyVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).DestroyVersion(ctx, req.(*DestroyVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSecretService_UndeleteVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).UndeleteVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_UndeleteVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).UndeleteVersion(ctx, req.(*UndeleteVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSecretService_ListVersionPins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVersionPinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).ListVersionPins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_ListVersionPins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).ListVersionPins(ctx, req.(*ListVersionPinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSecretService_DeleteVersionPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVersionPinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).DeleteVersionPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_DeleteVersionPin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).DeleteVersionPin(ctx, req.(*DeleteVersionPinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WardenSecretService_SearchSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchSecretsRequest)
 	if err := dec(in); err != nil {
@@ -615,6 +1047,60 @@ func _WardenSecretService_DeleteSecretTotp_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WardenSecretService_SetSecretAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSecretAccessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).SetSecretAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_SetSecretAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).SetSecretAccessPolicy(ctx, req.(*SetSecretAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSecretService_GetSecretUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).GetSecretUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_GetSecretUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).GetSecretUsage(ctx, req.(*GetSecretUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSecretService_GetSecretGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSecretServiceServer).GetSecretGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSecretService_GetSecretGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSecretServiceServer).GetSecretGraph(ctx, req.(*GetSecretGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WardenSecretService_ServiceDesc is the grpc.ServiceDesc for WardenSecretService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -634,6 +1120,22 @@ var WardenSecretService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSecretPassword",
 			Handler:    _WardenSecretService_GetSecretPassword_Handler,
 		},
+		{
+			MethodName: "GetSecretPasswordMasked",
+			Handler:    _WardenSecretService_GetSecretPasswordMasked_Handler,
+		},
+		{
+			MethodName: "CreateRetrievalToken",
+			Handler:    _WardenSecretService_CreateRetrievalToken_Handler,
+		},
+		{
+			MethodName: "RedeemRetrievalToken",
+			Handler:    _WardenSecretService_RedeemRetrievalToken_Handler,
+		},
+		{
+			MethodName: "GetSecretByPath",
+			Handler:    _WardenSecretService_GetSecretByPath_Handler,
+		},
 		{
 			MethodName: "ListSecrets",
 			Handler:    _WardenSecretService_ListSecrets_Handler,
@@ -666,6 +1168,26 @@ var WardenSecretService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RestoreVersion",
 			Handler:    _WardenSecretService_RestoreVersion_Handler,
 		},
+		{
+			MethodName: "DeleteVersion",
+			Handler:    _WardenSecretService_DeleteVersion_Handler,
+		},
+		{
+			MethodName: "DestroyVersion",
+			Handler:    _WardenSecretService_DestroyVersion_Handler,
+		},
+		{
+			MethodName: "UndeleteVersion",
+			Handler:    _WardenSecretService_UndeleteVersion_Handler,
+		},
+		{
+			MethodName: "ListVersionPins",
+			Handler:    _WardenSecretService_ListVersionPins_Handler,
+		},
+		{
+			MethodName: "DeleteVersionPin",
+			Handler:    _WardenSecretService_DeleteVersionPin_Handler,
+		},
 		{
 			MethodName: "SearchSecrets",
 			Handler:    _WardenSecretService_SearchSecrets_Handler,
@@ -682,7 +1204,25 @@ var WardenSecretService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSecretTotp",
 			Handler:    _WardenSecretService_DeleteSecretTotp_Handler,
 		},
+		{
+			MethodName: "SetSecretAccessPolicy",
+			Handler:    _WardenSecretService_SetSecretAccessPolicy_Handler,
+		},
+		{
+			MethodName: "GetSecretUsage",
+			Handler:    _WardenSecretService_GetSecretUsage_Handler,
+		},
+		{
+			MethodName: "GetSecretGraph",
+			Handler:    _WardenSecretService_GetSecretGraph_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "FetchSecretsStream",
+			Handler:       _WardenSecretService_FetchSecretsStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "warden/service/v1/secret.proto",
 }
@@ -20,49 +20,91 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationWardenSecretServiceCreateRetrievalToken = "/warden.service.v1.WardenSecretService/CreateRetrievalToken"
 const OperationWardenSecretServiceCreateSecret = "/warden.service.v1.WardenSecretService/CreateSecret"
 const OperationWardenSecretServiceDeleteSecret = "/warden.service.v1.WardenSecretService/DeleteSecret"
 const OperationWardenSecretServiceDeleteSecretTotp = "/warden.service.v1.WardenSecretService/DeleteSecretTotp"
+const OperationWardenSecretServiceDeleteVersion = "/warden.service.v1.WardenSecretService/DeleteVersion"
+const OperationWardenSecretServiceDeleteVersionPin = "/warden.service.v1.WardenSecretService/DeleteVersionPin"
+const OperationWardenSecretServiceDestroyVersion = "/warden.service.v1.WardenSecretService/DestroyVersion"
 const OperationWardenSecretServiceGetSecret = "/warden.service.v1.WardenSecretService/GetSecret"
+const OperationWardenSecretServiceGetSecretByPath = "/warden.service.v1.WardenSecretService/GetSecretByPath"
+const OperationWardenSecretServiceGetSecretGraph = "/warden.service.v1.WardenSecretService/GetSecretGraph"
 const OperationWardenSecretServiceGetSecretPassword = "/warden.service.v1.WardenSecretService/GetSecretPassword"
+const OperationWardenSecretServiceGetSecretPasswordMasked = "/warden.service.v1.WardenSecretService/GetSecretPasswordMasked"
 const OperationWardenSecretServiceGetSecretTotp = "/warden.service.v1.WardenSecretService/GetSecretTotp"
+const OperationWardenSecretServiceGetSecretUsage = "/warden.service.v1.WardenSecretService/GetSecretUsage"
 const OperationWardenSecretServiceGetVersion = "/warden.service.v1.WardenSecretService/GetVersion"
 const OperationWardenSecretServiceListSecrets = "/warden.service.v1.WardenSecretService/ListSecrets"
+const OperationWardenSecretServiceListVersionPins = "/warden.service.v1.WardenSecretService/ListVersionPins"
 const OperationWardenSecretServiceListVersions = "/warden.service.v1.WardenSecretService/ListVersions"
 const OperationWardenSecretServiceMoveSecret = "/warden.service.v1.WardenSecretService/MoveSecret"
+const OperationWardenSecretServiceRedeemRetrievalToken = "/warden.service.v1.WardenSecretService/RedeemRetrievalToken"
 const OperationWardenSecretServiceRestoreVersion = "/warden.service.v1.WardenSecretService/RestoreVersion"
 const OperationWardenSecretServiceSearchSecrets = "/warden.service.v1.WardenSecretService/SearchSecrets"
+const OperationWardenSecretServiceSetSecretAccessPolicy = "/warden.service.v1.WardenSecretService/SetSecretAccessPolicy"
 const OperationWardenSecretServiceSetSecretTotp = "/warden.service.v1.WardenSecretService/SetSecretTotp"
+const OperationWardenSecretServiceUndeleteVersion = "/warden.service.v1.WardenSecretService/UndeleteVersion"
 const OperationWardenSecretServiceUpdateSecret = "/warden.service.v1.WardenSecretService/UpdateSecret"
 const OperationWardenSecretServiceUpdateSecretPassword = "/warden.service.v1.WardenSecretService/UpdateSecretPassword"
 
 type WardenSecretServiceHTTPServer interface {
+	// CreateRetrievalToken Issue a short-lived token that RedeemRetrievalToken exchanges for a
+	// version of the password once
+	CreateRetrievalToken(context.Context, *CreateRetrievalTokenRequest) (*CreateRetrievalTokenResponse, error)
 	// CreateSecret Create a new secret
 	CreateSecret(context.Context, *CreateSecretRequest) (*CreateSecretResponse, error)
 	// DeleteSecret Delete a secret
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error)
 	// DeleteSecretTotp Remove the TOTP authenticator from a secret
 	DeleteSecretTotp(context.Context, *DeleteSecretTotpRequest) (*emptypb.Empty, error)
+	// DeleteVersion Soft-delete a previous version in Vault (owners only)
+	DeleteVersion(context.Context, *DeleteVersionRequest) (*DeleteVersionResponse, error)
+	// DeleteVersionPin Release a version pin (the consumer that created it or an owner)
+	DeleteVersionPin(context.Context, *DeleteVersionPinRequest) (*emptypb.Empty, error)
+	// DestroyVersion Permanently destroy a previous version in Vault (owners only)
+	DestroyVersion(context.Context, *DestroyVersionRequest) (*DestroyVersionResponse, error)
 	// GetSecret Get a secret by ID (returns metadata, not password)
 	GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error)
+	// GetSecretByPath Get the password and selected metadata of a secret by folder path and
+	// name in one call (External Secrets Operator, Terraform)
+	GetSecretByPath(context.Context, *GetSecretByPathRequest) (*GetSecretByPathResponse, error)
+	// GetSecretGraph Get what a secret is connected to (folders, collections, references,
+	// permissions and recent readers) as a graph, to see what rotating or
+	// deleting it affects
+	GetSecretGraph(context.Context, *GetSecretGraphRequest) (*GetSecretGraphResponse, error)
 	// GetSecretPassword Retrieve the password for a secret
 	GetSecretPassword(context.Context, *GetSecretPasswordRequest) (*GetSecretPasswordResponse, error)
+	// GetSecretPasswordMasked Get a hint of a password (length, first and last characters, checksum
+	// prefix) without the password itself
+	GetSecretPasswordMasked(context.Context, *GetSecretPasswordMaskedRequest) (*GetSecretPasswordMaskedResponse, error)
 	// GetSecretTotp Get TOTP code for a secret (returns current code + remaining seconds)
 	GetSecretTotp(context.Context, *GetSecretTotpRequest) (*GetSecretTotpResponse, error)
+	// GetSecretUsage Get the daily read and write counts of a secret, as aggregated nightly
+	// from the audit log
+	GetSecretUsage(context.Context, *GetSecretUsageRequest) (*GetSecretUsageResponse, error)
 	// GetVersion Get a specific version
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
 	// ListSecrets List secrets in a folder
 	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
+	// ListVersionPins List the consumers pinned to versions of a secret
+	ListVersionPins(context.Context, *ListVersionPinsRequest) (*ListVersionPinsResponse, error)
 	// ListVersions List all versions of a secret
 	ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error)
 	// MoveSecret Move secret to a different folder
 	MoveSecret(context.Context, *MoveSecretRequest) (*MoveSecretResponse, error)
+	// RedeemRetrievalToken Exchange a retrieval token for the password it was issued for
+	RedeemRetrievalToken(context.Context, *RedeemRetrievalTokenRequest) (*RedeemRetrievalTokenResponse, error)
 	// RestoreVersion Restore a previous version as current
 	RestoreVersion(context.Context, *RestoreVersionRequest) (*RestoreVersionResponse, error)
 	// SearchSecrets Search secrets across folders
 	SearchSecrets(context.Context, *SearchSecretsRequest) (*SearchSecretsResponse, error)
+	// SetSecretAccessPolicy Set the network and time restrictions on access to a secret (owner only)
+	SetSecretAccessPolicy(context.Context, *SetSecretAccessPolicyRequest) (*SetSecretAccessPolicyResponse, error)
 	// SetSecretTotp Set or update the TOTP authenticator for a secret
 	SetSecretTotp(context.Context, *SetSecretTotpRequest) (*SetSecretTotpResponse, error)
+	// UndeleteVersion Recover a soft-deleted version (owners only)
+	UndeleteVersion(context.Context, *UndeleteVersionRequest) (*UndeleteVersionResponse, error)
 	// UpdateSecret Update secret metadata
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*UpdateSecretResponse, error)
 	// UpdateSecretPassword Update secret password (creates new version)
@@ -74,6 +116,10 @@ func RegisterWardenSecretServiceHTTPServer(s *http.Server, srv WardenSecretServi
 	r.POST("/v1/secrets", _WardenSecretService_CreateSecret0_HTTP_Handler(srv))
 	r.GET("/v1/secrets/{id}", _WardenSecretService_GetSecret0_HTTP_Handler(srv))
 	r.GET("/v1/secrets/{id}/password", _WardenSecretService_GetSecretPassword0_HTTP_Handler(srv))
+	r.GET("/v1/secrets/{id}/password/masked", _WardenSecretService_GetSecretPasswordMasked0_HTTP_Handler(srv))
+	r.POST("/v1/secrets/{id}/retrieval-tokens", _WardenSecretService_CreateRetrievalToken0_HTTP_Handler(srv))
+	r.POST("/v1/retrieval-tokens:redeem", _WardenSecretService_RedeemRetrievalToken0_HTTP_Handler(srv))
+	r.GET("/v1/secrets:by-path", _WardenSecretService_GetSecretByPath0_HTTP_Handler(srv))
 	r.GET("/v1/secrets", _WardenSecretService_ListSecrets0_HTTP_Handler(srv))
 	r.PUT("/v1/secrets/{id}", _WardenSecretService_UpdateSecret0_HTTP_Handler(srv))
 	r.PUT("/v1/secrets/{id}/password", _WardenSecretService_UpdateSecretPassword0_HTTP_Handler(srv))
@@ -82,10 +128,18 @@ func RegisterWardenSecretServiceHTTPServer(s *http.Server, srv WardenSecretServi
 	r.GET("/v1/secrets/{secret_id}/versions", _WardenSecretService_ListVersions0_HTTP_Handler(srv))
 	r.GET("/v1/secrets/{secret_id}/versions/{version_number}", _WardenSecretService_GetVersion0_HTTP_Handler(srv))
 	r.POST("/v1/secrets/{secret_id}/versions/{version_number}/restore", _WardenSecretService_RestoreVersion0_HTTP_Handler(srv))
+	r.POST("/v1/secrets/{secret_id}/versions/{version_number}/delete", _WardenSecretService_DeleteVersion0_HTTP_Handler(srv))
+	r.POST("/v1/secrets/{secret_id}/versions/{version_number}/destroy", _WardenSecretService_DestroyVersion0_HTTP_Handler(srv))
+	r.POST("/v1/secrets/{secret_id}/versions/{version_number}/undelete", _WardenSecretService_UndeleteVersion0_HTTP_Handler(srv))
+	r.GET("/v1/secrets/{secret_id}/pins", _WardenSecretService_ListVersionPins0_HTTP_Handler(srv))
+	r.DELETE("/v1/secrets/{secret_id}/pins/{pin_id}", _WardenSecretService_DeleteVersionPin0_HTTP_Handler(srv))
 	r.GET("/v1/secrets/search", _WardenSecretService_SearchSecrets0_HTTP_Handler(srv))
 	r.GET("/v1/secrets/{id}/totp", _WardenSecretService_GetSecretTotp0_HTTP_Handler(srv))
 	r.PUT("/v1/secrets/{id}/totp", _WardenSecretService_SetSecretTotp0_HTTP_Handler(srv))
 	r.DELETE("/v1/secrets/{id}/totp", _WardenSecretService_DeleteSecretTotp0_HTTP_Handler(srv))
+	r.PUT("/v1/secrets/{id}/access-policy", _WardenSecretService_SetSecretAccessPolicy0_HTTP_Handler(srv))
+	r.GET("/v1/secrets/{id}/usage", _WardenSecretService_GetSecretUsage0_HTTP_Handler(srv))
+	r.GET("/v1/secrets/{id}/graph", _WardenSecretService_GetSecretGraph0_HTTP_Handler(srv))
 }
 
 func _WardenSecretService_CreateSecret0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
@@ -154,6 +208,94 @@ func _WardenSecretService_GetSecretPassword0_HTTP_Handler(srv WardenSecretServic
 	}
 }
 
+func _WardenSecretService_GetSecretPasswordMasked0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSecretPasswordMaskedRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceGetSecretPasswordMasked)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSecretPasswordMasked(ctx, req.(*GetSecretPasswordMaskedRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSecretPasswordMaskedResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenSecretService_CreateRetrievalToken0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateRetrievalTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceCreateRetrievalToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateRetrievalToken(ctx, req.(*CreateRetrievalTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateRetrievalTokenResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenSecretService_RedeemRetrievalToken0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RedeemRetrievalTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceRedeemRetrievalToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RedeemRetrievalToken(ctx, req.(*RedeemRetrievalTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RedeemRetrievalTokenResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenSecretService_GetSecretByPath0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSecretByPathRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceGetSecretByPath)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSecretByPath(ctx, req.(*GetSecretByPathRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSecretByPathResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _WardenSecretService_ListSecrets0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListSecretsRequest
@@ -336,6 +478,116 @@ func _WardenSecretService_RestoreVersion0_HTTP_Handler(srv WardenSecretServiceHT
 	}
 }
 
+func _WardenSecretService_DeleteVersion0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteVersionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceDeleteVersion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteVersion(ctx, req.(*DeleteVersionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteVersionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenSecretService_DestroyVersion0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DestroyVersionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceDestroyVersion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DestroyVersion(ctx, req.(*DestroyVersionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DestroyVersionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenSecretService_UndeleteVersion0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UndeleteVersionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceUndeleteVersion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UndeleteVersion(ctx, req.(*UndeleteVersionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UndeleteVersionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenSecretService_ListVersionPins0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListVersionPinsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceListVersionPins)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListVersionPins(ctx, req.(*ListVersionPinsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListVersionPinsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenSecretService_DeleteVersionPin0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteVersionPinRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceDeleteVersionPin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteVersionPin(ctx, req.(*DeleteVersionPinRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _WardenSecretService_SearchSecrets0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SearchSecretsRequest
@@ -424,33 +676,132 @@ func _WardenSecretService_DeleteSecretTotp0_HTTP_Handler(srv WardenSecretService
 	}
 }
 
+func _WardenSecretService_SetSecretAccessPolicy0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetSecretAccessPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceSetSecretAccessPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetSecretAccessPolicy(ctx, req.(*SetSecretAccessPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetSecretAccessPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenSecretService_GetSecretUsage0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSecretUsageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceGetSecretUsage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSecretUsage(ctx, req.(*GetSecretUsageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSecretUsageResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenSecretService_GetSecretGraph0_HTTP_Handler(srv WardenSecretServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSecretGraphRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSecretServiceGetSecretGraph)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSecretGraph(ctx, req.(*GetSecretGraphRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSecretGraphResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type WardenSecretServiceHTTPClient interface {
+	// CreateRetrievalToken Issue a short-lived token that RedeemRetrievalToken exchanges for a
+	// version of the password once
+	CreateRetrievalToken(ctx context.Context, req *CreateRetrievalTokenRequest, opts ...http.CallOption) (rsp *CreateRetrievalTokenResponse, err error)
 	// CreateSecret Create a new secret
 	CreateSecret(ctx context.Context, req *CreateSecretRequest, opts ...http.CallOption) (rsp *CreateSecretResponse, err error)
 	// DeleteSecret Delete a secret
 	DeleteSecret(ctx context.Context, req *DeleteSecretRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// DeleteSecretTotp Remove the TOTP authenticator from a secret
 	DeleteSecretTotp(ctx context.Context, req *DeleteSecretTotpRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteVersion Soft-delete a previous version in Vault (owners only)
+	DeleteVersion(ctx context.Context, req *DeleteVersionRequest, opts ...http.CallOption) (rsp *DeleteVersionResponse, err error)
+	// DeleteVersionPin Release a version pin (the consumer that created it or an owner)
+	DeleteVersionPin(ctx context.Context, req *DeleteVersionPinRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DestroyVersion Permanently destroy a previous version in Vault (owners only)
+	DestroyVersion(ctx context.Context, req *DestroyVersionRequest, opts ...http.CallOption) (rsp *DestroyVersionResponse, err error)
 	// GetSecret Get a secret by ID (returns metadata, not password)
 	GetSecret(ctx context.Context, req *GetSecretRequest, opts ...http.CallOption) (rsp *GetSecretResponse, err error)
+	// GetSecretByPath Get the password and selected metadata of a secret by folder path and
+	// name in one call (External Secrets Operator, Terraform)
+	GetSecretByPath(ctx context.Context, req *GetSecretByPathRequest, opts ...http.CallOption) (rsp *GetSecretByPathResponse, err error)
+	// GetSecretGraph Get what a secret is connected to (folders, collections, references,
+	// permissions and recent readers) as a graph, to see what rotating or
+	// deleting it affects
+	GetSecretGraph(ctx context.Context, req *GetSecretGraphRequest, opts ...http.CallOption) (rsp *GetSecretGraphResponse, err error)
 	// GetSecretPassword Retrieve the password for a secret
 	GetSecretPassword(ctx context.Context, req *GetSecretPasswordRequest, opts ...http.CallOption) (rsp *GetSecretPasswordResponse, err error)
+	// GetSecretPasswordMasked Get a hint of a password (length, first and last characters, checksum
+	// prefix) without the password itself
+	GetSecretPasswordMasked(ctx context.Context, req *GetSecretPasswordMaskedRequest, opts ...http.CallOption) (rsp *GetSecretPasswordMaskedResponse, err error)
 	// GetSecretTotp Get TOTP code for a secret (returns current code + remaining seconds)
 	GetSecretTotp(ctx context.Context, req *GetSecretTotpRequest, opts ...http.CallOption) (rsp *GetSecretTotpResponse, err error)
+	// GetSecretUsage Get the daily read and write counts of a secret, as aggregated nightly
+	// from the audit log
+	GetSecretUsage(ctx context.Context, req *GetSecretUsageRequest, opts ...http.CallOption) (rsp *GetSecretUsageResponse, err error)
 	// GetVersion Get a specific version
 	GetVersion(ctx context.Context, req *GetVersionRequest, opts ...http.CallOption) (rsp *GetVersionResponse, err error)
 	// ListSecrets List secrets in a folder
 	ListSecrets(ctx context.Context, req *ListSecretsRequest, opts ...http.CallOption) (rsp *ListSecretsResponse, err error)
+	// ListVersionPins List the consumers pinned to versions of a secret
+	ListVersionPins(ctx context.Context, req *ListVersionPinsRequest, opts ...http.CallOption) (rsp *ListVersionPinsResponse, err error)
 	// ListVersions List all versions of a secret
 	ListVersions(ctx context.Context, req *ListVersionsRequest, opts ...http.CallOption) (rsp *ListVersionsResponse, err error)
 	// MoveSecret Move secret to a different folder
 	MoveSecret(ctx context.Context, req *MoveSecretRequest, opts ...http.CallOption) (rsp *MoveSecretResponse, err error)
+	// RedeemRetrievalToken Exchange a retrieval token for the password it was issued for
+	RedeemRetrievalToken(ctx context.Context, req *RedeemRetrievalTokenRequest, opts ...http.CallOption) (rsp *RedeemRetrievalTokenResponse, err error)
 	// RestoreVersion Restore a previous version as current
 	RestoreVersion(ctx context.Context, req *RestoreVersionRequest, opts ...http.CallOption) (rsp *RestoreVersionResponse, err error)
 	// SearchSecrets Search secrets across folders
 	SearchSecrets(ctx context.Context, req *SearchSecretsRequest, opts ...http.CallOption) (rsp *SearchSecretsResponse, err error)
+	// SetSecretAccessPolicy Set the network and time restrictions on access to a secret (owner only)
+	SetSecretAccessPolicy(ctx context.Context, req *SetSecretAccessPolicyRequest, opts ...http.CallOption) (rsp *SetSecretAccessPolicyResponse, err error)
 	// SetSecretTotp Set or update the TOTP authenticator for a secret
 	SetSecretTotp(ctx context.Context, req *SetSecretTotpRequest, opts ...http.CallOption) (rsp *SetSecretTotpResponse, err error)
+	// UndeleteVersion Recover a soft-deleted version (owners only)
+	UndeleteVersion(ctx context.Context, req *UndeleteVersionRequest, opts ...http.CallOption) (rsp *UndeleteVersionResponse, err error)
 	// UpdateSecret Update secret metadata
 	UpdateSecret(ctx context.Context, req *UpdateSecretRequest, opts ...http.CallOption) (rsp *UpdateSecretResponse, err error)
 	// UpdateSecretPassword Update secret password (creates new version)
@@ -465,6 +816,21 @@ func NewWardenSecretServiceHTTPClient(client *http.Client) WardenSecretServiceHT
 	return &WardenSecretServiceHTTPClientImpl{client}
 }
 
+// CreateRetrievalToken Issue a short-lived token that RedeemRetrievalToken exchanges for a
+// version of the password once
+func (c *WardenSecretServiceHTTPClientImpl) CreateRetrievalToken(ctx context.Context, in *CreateRetrievalTokenRequest, opts ...http.CallOption) (*CreateRetrievalTokenResponse, error) {
+	var out CreateRetrievalTokenResponse
+	pattern := "/v1/secrets/{id}/retrieval-tokens"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceCreateRetrievalToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateSecret Create a new secret
 func (c *WardenSecretServiceHTTPClientImpl) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...http.CallOption) (*CreateSecretResponse, error) {
 	var out CreateSecretResponse
@@ -507,6 +873,48 @@ func (c *WardenSecretServiceHTTPClientImpl) DeleteSecretTotp(ctx context.Context
 	return &out, nil
 }
 
+// DeleteVersion Soft-delete a previous version in Vault (owners only)
+func (c *WardenSecretServiceHTTPClientImpl) DeleteVersion(ctx context.Context, in *DeleteVersionRequest, opts ...http.CallOption) (*DeleteVersionResponse, error) {
+	var out DeleteVersionResponse
+	pattern := "/v1/secrets/{secret_id}/versions/{version_number}/delete"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceDeleteVersion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteVersionPin Release a version pin (the consumer that created it or an owner)
+func (c *WardenSecretServiceHTTPClientImpl) DeleteVersionPin(ctx context.Context, in *DeleteVersionPinRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/secrets/{secret_id}/pins/{pin_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceDeleteVersionPin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DestroyVersion Permanently destroy a previous version in Vault (owners only)
+func (c *WardenSecretServiceHTTPClientImpl) DestroyVersion(ctx context.Context, in *DestroyVersionRequest, opts ...http.CallOption) (*DestroyVersionResponse, error) {
+	var out DestroyVersionResponse
+	pattern := "/v1/secrets/{secret_id}/versions/{version_number}/destroy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceDestroyVersion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetSecret Get a secret by ID (returns metadata, not password)
 func (c *WardenSecretServiceHTTPClientImpl) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...http.CallOption) (*GetSecretResponse, error) {
 	var out GetSecretResponse
@@ -521,6 +929,37 @@ func (c *WardenSecretServiceHTTPClientImpl) GetSecret(ctx context.Context, in *G
 	return &out, nil
 }
 
+// GetSecretByPath Get the password and selected metadata of a secret by folder path and
+// name in one call (External Secrets Operator, Terraform)
+func (c *WardenSecretServiceHTTPClientImpl) GetSecretByPath(ctx context.Context, in *GetSecretByPathRequest, opts ...http.CallOption) (*GetSecretByPathResponse, error) {
+	var out GetSecretByPathResponse
+	pattern := "/v1/secrets:by-path"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceGetSecretByPath))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSecretGraph Get what a secret is connected to (folders, collections, references,
+// permissions and recent readers) as a graph, to see what rotating or
+// deleting it affects
+func (c *WardenSecretServiceHTTPClientImpl) GetSecretGraph(ctx context.Context, in *GetSecretGraphRequest, opts ...http.CallOption) (*GetSecretGraphResponse, error) {
+	var out GetSecretGraphResponse
+	pattern := "/v1/secrets/{id}/graph"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceGetSecretGraph))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetSecretPassword Retrieve the password for a secret
 func (c *WardenSecretServiceHTTPClientImpl) GetSecretPassword(ctx context.Context, in *GetSecretPasswordRequest, opts ...http.CallOption) (*GetSecretPasswordResponse, error) {
 	var out GetSecretPasswordResponse
@@ -535,6 +974,21 @@ func (c *WardenSecretServiceHTTPClientImpl) GetSecretPassword(ctx context.Contex
 	return &out, nil
 }
 
+// GetSecretPasswordMasked Get a hint of a password (length, first and last characters, checksum
+// prefix) without the password itself
+func (c *WardenSecretServiceHTTPClientImpl) GetSecretPasswordMasked(ctx context.Context, in *GetSecretPasswordMaskedRequest, opts ...http.CallOption) (*GetSecretPasswordMaskedResponse, error) {
+	var out GetSecretPasswordMaskedResponse
+	pattern := "/v1/secrets/{id}/password/masked"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceGetSecretPasswordMasked))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetSecretTotp Get TOTP code for a secret (returns current code + remaining seconds)
 func (c *WardenSecretServiceHTTPClientImpl) GetSecretTotp(ctx context.Context, in *GetSecretTotpRequest, opts ...http.CallOption) (*GetSecretTotpResponse, error) {
 	var out GetSecretTotpResponse
@@ -549,6 +1003,21 @@ func (c *WardenSecretServiceHTTPClientImpl) GetSecretTotp(ctx context.Context, i
 	return &out, nil
 }
 
+// GetSecretUsage Get the daily read and write counts of a secret, as aggregated nightly
+// from the audit log
+func (c *WardenSecretServiceHTTPClientImpl) GetSecretUsage(ctx context.Context, in *GetSecretUsageRequest, opts ...http.CallOption) (*GetSecretUsageResponse, error) {
+	var out GetSecretUsageResponse
+	pattern := "/v1/secrets/{id}/usage"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceGetSecretUsage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetVersion Get a specific version
 func (c *WardenSecretServiceHTTPClientImpl) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...http.CallOption) (*GetVersionResponse, error) {
 	var out GetVersionResponse
@@ -577,6 +1046,20 @@ func (c *WardenSecretServiceHTTPClientImpl) ListSecrets(ctx context.Context, in
 	return &out, nil
 }
 
+// ListVersionPins List the consumers pinned to versions of a secret
+func (c *WardenSecretServiceHTTPClientImpl) ListVersionPins(ctx context.Context, in *ListVersionPinsRequest, opts ...http.CallOption) (*ListVersionPinsResponse, error) {
+	var out ListVersionPinsResponse
+	pattern := "/v1/secrets/{secret_id}/pins"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceListVersionPins))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListVersions List all versions of a secret
 func (c *WardenSecretServiceHTTPClientImpl) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...http.CallOption) (*ListVersionsResponse, error) {
 	var out ListVersionsResponse
@@ -605,6 +1088,20 @@ func (c *WardenSecretServiceHTTPClientImpl) MoveSecret(ctx context.Context, in *
 	return &out, nil
 }
 
+// RedeemRetrievalToken Exchange a retrieval token for the password it was issued for
+func (c *WardenSecretServiceHTTPClientImpl) RedeemRetrievalToken(ctx context.Context, in *RedeemRetrievalTokenRequest, opts ...http.CallOption) (*RedeemRetrievalTokenResponse, error) {
+	var out RedeemRetrievalTokenResponse
+	pattern := "/v1/retrieval-tokens:redeem"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceRedeemRetrievalToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // RestoreVersion Restore a previous version as current
 func (c *WardenSecretServiceHTTPClientImpl) RestoreVersion(ctx context.Context, in *RestoreVersionRequest, opts ...http.CallOption) (*RestoreVersionResponse, error) {
 	var out RestoreVersionResponse
@@ -633,6 +1130,20 @@ func (c *WardenSecretServiceHTTPClientImpl) SearchSecrets(ctx context.Context, i
 	return &out, nil
 }
 
+// SetSecretAccessPolicy Set the network and time restrictions on access to a secret (owner only)
+func (c *WardenSecretServiceHTTPClientImpl) SetSecretAccessPolicy(ctx context.Context, in *SetSecretAccessPolicyRequest, opts ...http.CallOption) (*SetSecretAccessPolicyResponse, error) {
+	var out SetSecretAccessPolicyResponse
+	pattern := "/v1/secrets/{id}/access-policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceSetSecretAccessPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // SetSecretTotp Set or update the TOTP authenticator for a secret
 func (c *WardenSecretServiceHTTPClientImpl) SetSecretTotp(ctx context.Context, in *SetSecretTotpRequest, opts ...http.CallOption) (*SetSecretTotpResponse, error) {
 	var out SetSecretTotpResponse
@@ -647,6 +1158,20 @@ func (c *WardenSecretServiceHTTPClientImpl) SetSecretTotp(ctx context.Context, i
 	return &out, nil
 }
 
+// UndeleteVersion Recover a soft-deleted version (owners only)
+func (c *WardenSecretServiceHTTPClientImpl) UndeleteVersion(ctx context.Context, in *UndeleteVersionRequest, opts ...http.CallOption) (*UndeleteVersionResponse, error) {
+	var out UndeleteVersionResponse
+	pattern := "/v1/secrets/{secret_id}/versions/{version_number}/undelete"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSecretServiceUndeleteVersion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateSecret Update secret metadata
 func (c *WardenSecretServiceHTTPClientImpl) UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...http.CallOption) (*UpdateSecretResponse, error) {
 	var out UpdateSecretResponse
@@ -7,10 +7,12 @@
 package wardenpb
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -76,6 +78,58 @@ func (HealthStatus) EnumDescriptor() ([]byte, []int) {
 	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{0}
 }
 
+// API schema formats
+type ApiSchemaFormat int32
+
+const (
+	ApiSchemaFormat_API_SCHEMA_FORMAT_UNSPECIFIED ApiSchemaFormat = 0
+	// OpenAPI document (YAML)
+	ApiSchemaFormat_API_SCHEMA_FORMAT_OPENAPI ApiSchemaFormat = 1
+	// Serialized google.protobuf.FileDescriptorSet
+	ApiSchemaFormat_API_SCHEMA_FORMAT_DESCRIPTOR ApiSchemaFormat = 2
+)
+
+// Enum value maps for ApiSchemaFormat.
+var (
+	ApiSchemaFormat_name = map[int32]string{
+		0: "API_SCHEMA_FORMAT_UNSPECIFIED",
+		1: "API_SCHEMA_FORMAT_OPENAPI",
+		2: "API_SCHEMA_FORMAT_DESCRIPTOR",
+	}
+	ApiSchemaFormat_value = map[string]int32{
+		"API_SCHEMA_FORMAT_UNSPECIFIED": 0,
+		"API_SCHEMA_FORMAT_OPENAPI":     1,
+		"API_SCHEMA_FORMAT_DESCRIPTOR":  2,
+	}
+)
+
+func (x ApiSchemaFormat) Enum() *ApiSchemaFormat {
+	p := new(ApiSchemaFormat)
+	*p = x
+	return p
+}
+
+func (x ApiSchemaFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApiSchemaFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_system_proto_enumTypes[1].Descriptor()
+}
+
+func (ApiSchemaFormat) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_system_proto_enumTypes[1]
+}
+
+func (x ApiSchemaFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApiSchemaFormat.Descriptor instead.
+func (ApiSchemaFormat) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{1}
+}
+
 // Policy type for share restrictions
 type SharePolicyType int32
 
@@ -110,11 +164,11 @@ func (x SharePolicyType) String() string {
 }
 
 func (SharePolicyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_warden_service_v1_system_proto_enumTypes[1].Descriptor()
+	return file_warden_service_v1_system_proto_enumTypes[2].Descriptor()
 }
 
 func (SharePolicyType) Type() protoreflect.EnumType {
-	return &file_warden_service_v1_system_proto_enumTypes[1]
+	return &file_warden_service_v1_system_proto_enumTypes[2]
 }
 
 func (x SharePolicyType) Number() protoreflect.EnumNumber {
@@ -123,7 +177,7 @@ func (x SharePolicyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SharePolicyType.Descriptor instead.
 func (SharePolicyType) EnumDescriptor() ([]byte, []int) {
-	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{1}
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{2}
 }
 
 // Policy method for share restrictions
@@ -172,11 +226,11 @@ func (x SharePolicyMethod) String() string {
 }
 
 func (SharePolicyMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_warden_service_v1_system_proto_enumTypes[2].Descriptor()
+	return file_warden_service_v1_system_proto_enumTypes[3].Descriptor()
 }
 
 func (SharePolicyMethod) Type() protoreflect.EnumType {
-	return &file_warden_service_v1_system_proto_enumTypes[2]
+	return &file_warden_service_v1_system_proto_enumTypes[3]
 }
 
 func (x SharePolicyMethod) Number() protoreflect.EnumNumber {
@@ -185,7 +239,7 @@ func (x SharePolicyMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SharePolicyMethod.Descriptor instead.
 func (SharePolicyMethod) EnumDescriptor() ([]byte, []int) {
-	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{2}
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{3}
 }
 
 type HealthResponse struct {
@@ -368,6 +422,257 @@ func (x *GetInfoResponse) GetGitCommit() string {
 	return ""
 }
 
+type GetCapabilitiesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Version string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Secret storage backend, "vault-kv-v2"
+	VaultBackend string `protobuf:"bytes,2,opt,name=vault_backend,json=vaultBackend,proto3" json:"vault_backend,omitempty"`
+	// Formats accepted by the import RPCs ("bitwarden", "csv")
+	ImportFormats []string `protobuf:"bytes,3,rep,name=import_formats,json=importFormats,proto3" json:"import_formats,omitempty"`
+	// Formats produced by the export RPCs ("bitwarden", "csv")
+	ExportFormats []string `protobuf:"bytes,4,rep,name=export_formats,json=exportFormats,proto3" json:"export_formats,omitempty"`
+	// Whether passwords are rotated automatically; expiry is always tracked
+	PasswordRotation bool `protobuf:"varint,5,opt,name=password_rotation,json=passwordRotation,proto3" json:"password_rotation,omitempty"`
+	Totp             bool `protobuf:"varint,6,opt,name=totp,proto3" json:"totp,omitempty"`
+	// Limits enforced on imports and backup restores
+	ImportMaxBytes    int64 `protobuf:"varint,7,opt,name=import_max_bytes,json=importMaxBytes,proto3" json:"import_max_bytes,omitempty"`
+	ImportMaxItems    int64 `protobuf:"varint,8,opt,name=import_max_items,json=importMaxItems,proto3" json:"import_max_items,omitempty"`
+	BackupMaxBytes    int64 `protobuf:"varint,9,opt,name=backup_max_bytes,json=backupMaxBytes,proto3" json:"backup_max_bytes,omitempty"`
+	BackupMaxEntities int64 `protobuf:"varint,10,opt,name=backup_max_entities,json=backupMaxEntities,proto3" json:"backup_max_entities,omitempty"`
+	// SHA-256 of the schemas served by GetApiSchema, to detect changes without fetching them
+	OpenapiSha256    string `protobuf:"bytes,11,opt,name=openapi_sha256,json=openapiSha256,proto3" json:"openapi_sha256,omitempty"`
+	DescriptorSha256 string `protobuf:"bytes,12,opt,name=descriptor_sha256,json=descriptorSha256,proto3" json:"descriptor_sha256,omitempty"`
+	// Largest password accepted (decoded size for BASE64 passwords)
+	PasswordMaxBytes int64 `protobuf:"varint,13,opt,name=password_max_bytes,json=passwordMaxBytes,proto3" json:"password_max_bytes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesResponse) Reset() {
+	*x = GetCapabilitiesResponse{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesResponse) ProtoMessage() {}
+
+func (x *GetCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCapabilitiesResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetCapabilitiesResponse) GetVaultBackend() string {
+	if x != nil {
+		return x.VaultBackend
+	}
+	return ""
+}
+
+func (x *GetCapabilitiesResponse) GetImportFormats() []string {
+	if x != nil {
+		return x.ImportFormats
+	}
+	return nil
+}
+
+func (x *GetCapabilitiesResponse) GetExportFormats() []string {
+	if x != nil {
+		return x.ExportFormats
+	}
+	return nil
+}
+
+func (x *GetCapabilitiesResponse) GetPasswordRotation() bool {
+	if x != nil {
+		return x.PasswordRotation
+	}
+	return false
+}
+
+func (x *GetCapabilitiesResponse) GetTotp() bool {
+	if x != nil {
+		return x.Totp
+	}
+	return false
+}
+
+func (x *GetCapabilitiesResponse) GetImportMaxBytes() int64 {
+	if x != nil {
+		return x.ImportMaxBytes
+	}
+	return 0
+}
+
+func (x *GetCapabilitiesResponse) GetImportMaxItems() int64 {
+	if x != nil {
+		return x.ImportMaxItems
+	}
+	return 0
+}
+
+func (x *GetCapabilitiesResponse) GetBackupMaxBytes() int64 {
+	if x != nil {
+		return x.BackupMaxBytes
+	}
+	return 0
+}
+
+func (x *GetCapabilitiesResponse) GetBackupMaxEntities() int64 {
+	if x != nil {
+		return x.BackupMaxEntities
+	}
+	return 0
+}
+
+func (x *GetCapabilitiesResponse) GetOpenapiSha256() string {
+	if x != nil {
+		return x.OpenapiSha256
+	}
+	return ""
+}
+
+func (x *GetCapabilitiesResponse) GetDescriptorSha256() string {
+	if x != nil {
+		return x.DescriptorSha256
+	}
+	return ""
+}
+
+func (x *GetCapabilitiesResponse) GetPasswordMaxBytes() int64 {
+	if x != nil {
+		return x.PasswordMaxBytes
+	}
+	return 0
+}
+
+type GetApiSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        ApiSchemaFormat        `protobuf:"varint,1,opt,name=format,proto3,enum=warden.service.v1.ApiSchemaFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApiSchemaRequest) Reset() {
+	*x = GetApiSchemaRequest{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiSchemaRequest) ProtoMessage() {}
+
+func (x *GetApiSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetApiSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetApiSchemaRequest) GetFormat() ApiSchemaFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ApiSchemaFormat_API_SCHEMA_FORMAT_UNSPECIFIED
+}
+
+type GetApiSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Sha256        string                 `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApiSchemaResponse) Reset() {
+	*x = GetApiSchemaResponse{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiSchemaResponse) ProtoMessage() {}
+
+func (x *GetApiSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetApiSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetApiSchemaResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetApiSchemaResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetApiSchemaResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
 type CheckVaultResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Connected     bool                   `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
@@ -380,7 +685,7 @@ type CheckVaultResponse struct {
 
 func (x *CheckVaultResponse) Reset() {
 	*x = CheckVaultResponse{}
-	mi := &file_warden_service_v1_system_proto_msgTypes[3]
+	mi := &file_warden_service_v1_system_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -392,7 +697,7 @@ func (x *CheckVaultResponse) String() string {
 func (*CheckVaultResponse) ProtoMessage() {}
 
 func (x *CheckVaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_system_proto_msgTypes[3]
+	mi := &file_warden_service_v1_system_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,7 +710,7 @@ func (x *CheckVaultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckVaultResponse.ProtoReflect.Descriptor instead.
 func (*CheckVaultResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{3}
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CheckVaultResponse) GetConnected() bool {
@@ -437,15 +742,21 @@ func (x *CheckVaultResponse) GetMessage() string {
 }
 
 type GetStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      *uint32                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId *uint32                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Length of the daily series in days, ending today (default 30)
+	Days *uint32 `protobuf:"varint,2,opt,name=days,proto3,oneof" json:"days,omitempty"`
+	// Number of most accessed secrets to return (default 10)
+	TopLimit *uint32 `protobuf:"varint,3,opt,name=top_limit,json=topLimit,proto3,oneof" json:"top_limit,omitempty"`
+	// Secrets expiring within this many days count as due for rotation (default 30)
+	RotationWindowDays *uint32 `protobuf:"varint,4,opt,name=rotation_window_days,json=rotationWindowDays,proto3,oneof" json:"rotation_window_days,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetStatsRequest) Reset() {
 	*x = GetStatsRequest{}
-	mi := &file_warden_service_v1_system_proto_msgTypes[4]
+	mi := &file_warden_service_v1_system_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +768,7 @@ func (x *GetStatsRequest) String() string {
 func (*GetStatsRequest) ProtoMessage() {}
 
 func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_system_proto_msgTypes[4]
+	mi := &file_warden_service_v1_system_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +781,7 @@ func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{4}
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetStatsRequest) GetTenantId() uint32 {
@@ -480,6 +791,27 @@ func (x *GetStatsRequest) GetTenantId() uint32 {
 	return 0
 }
 
+func (x *GetStatsRequest) GetDays() uint32 {
+	if x != nil && x.Days != nil {
+		return *x.Days
+	}
+	return 0
+}
+
+func (x *GetStatsRequest) GetTopLimit() uint32 {
+	if x != nil && x.TopLimit != nil {
+		return *x.TopLimit
+	}
+	return 0
+}
+
+func (x *GetStatsRequest) GetRotationWindowDays() uint32 {
+	if x != nil && x.RotationWindowDays != nil {
+		return *x.RotationWindowDays
+	}
+	return 0
+}
+
 // Policy input for creating a share
 type SharePolicyInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -493,7 +825,7 @@ type SharePolicyInput struct {
 
 func (x *SharePolicyInput) Reset() {
 	*x = SharePolicyInput{}
-	mi := &file_warden_service_v1_system_proto_msgTypes[5]
+	mi := &file_warden_service_v1_system_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -505,7 +837,7 @@ func (x *SharePolicyInput) String() string {
 func (*SharePolicyInput) ProtoMessage() {}
 
 func (x *SharePolicyInput) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_system_proto_msgTypes[5]
+	mi := &file_warden_service_v1_system_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +850,7 @@ func (x *SharePolicyInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SharePolicyInput.ProtoReflect.Descriptor instead.
 func (*SharePolicyInput) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{5}
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SharePolicyInput) GetType() SharePolicyType {
@@ -562,7 +894,7 @@ type CreateShareSecretRequest struct {
 
 func (x *CreateShareSecretRequest) Reset() {
 	*x = CreateShareSecretRequest{}
-	mi := &file_warden_service_v1_system_proto_msgTypes[6]
+	mi := &file_warden_service_v1_system_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -574,7 +906,7 @@ func (x *CreateShareSecretRequest) String() string {
 func (*CreateShareSecretRequest) ProtoMessage() {}
 
 func (x *CreateShareSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_system_proto_msgTypes[6]
+	mi := &file_warden_service_v1_system_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,7 +919,7 @@ func (x *CreateShareSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateShareSecretRequest.ProtoReflect.Descriptor instead.
 func (*CreateShareSecretRequest) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{6}
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateShareSecretRequest) GetResourceId() string {
@@ -628,7 +960,7 @@ type CreateShareSecretResponse struct {
 
 func (x *CreateShareSecretResponse) Reset() {
 	*x = CreateShareSecretResponse{}
-	mi := &file_warden_service_v1_system_proto_msgTypes[7]
+	mi := &file_warden_service_v1_system_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -640,7 +972,7 @@ func (x *CreateShareSecretResponse) String() string {
 func (*CreateShareSecretResponse) ProtoMessage() {}
 
 func (x *CreateShareSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_system_proto_msgTypes[7]
+	mi := &file_warden_service_v1_system_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +985,7 @@ func (x *CreateShareSecretResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateShareSecretResponse.ProtoReflect.Descriptor instead.
 func (*CreateShareSecretResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{7}
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateShareSecretResponse) GetShareId() string {
@@ -678,13 +1010,20 @@ type GetStatsResponse struct {
 	TotalFolders         int64                  `protobuf:"varint,4,opt,name=total_folders,json=totalFolders,proto3" json:"total_folders,omitempty"`
 	TotalVersions        int64                  `protobuf:"varint,5,opt,name=total_versions,json=totalVersions,proto3" json:"total_versions,omitempty"`
 	AvgVersionsPerSecret float64                `protobuf:"fixed64,6,opt,name=avg_versions_per_secret,json=avgVersionsPerSecret,proto3" json:"avg_versions_per_secret,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// One entry per UTC day, oldest first, days without activity included
+	SecretsCreatedPerDay []*DailyCount `protobuf:"bytes,7,rep,name=secrets_created_per_day,json=secretsCreatedPerDay,proto3" json:"secrets_created_per_day,omitempty"`
+	PasswordReadsPerDay  []*DailyCount `protobuf:"bytes,8,rep,name=password_reads_per_day,json=passwordReadsPerDay,proto3" json:"password_reads_per_day,omitempty"`
+	// Secrets with the most password reads in the same period, most read first
+	TopAccessedSecrets []*SecretAccessCount `protobuf:"bytes,9,rep,name=top_accessed_secrets,json=topAccessedSecrets,proto3" json:"top_accessed_secrets,omitempty"`
+	// Active secrets whose expiry falls within the rotation window (expired ones included), soonest first
+	SecretsDueForRotation []*SecretRotationDue `protobuf:"bytes,10,rep,name=secrets_due_for_rotation,json=secretsDueForRotation,proto3" json:"secrets_due_for_rotation,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *GetStatsResponse) Reset() {
 	*x = GetStatsResponse{}
-	mi := &file_warden_service_v1_system_proto_msgTypes[8]
+	mi := &file_warden_service_v1_system_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -696,7 +1035,7 @@ func (x *GetStatsResponse) String() string {
 func (*GetStatsResponse) ProtoMessage() {}
 
 func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_warden_service_v1_system_proto_msgTypes[8]
+	mi := &file_warden_service_v1_system_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,7 +1048,7 @@ func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{8}
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetStatsResponse) GetTotalSecrets() int64 {
@@ -754,16 +1093,787 @@ func (x *GetStatsResponse) GetAvgVersionsPerSecret() float64 {
 	return 0
 }
 
-var File_warden_service_v1_system_proto protoreflect.FileDescriptor
+func (x *GetStatsResponse) GetSecretsCreatedPerDay() []*DailyCount {
+	if x != nil {
+		return x.SecretsCreatedPerDay
+	}
+	return nil
+}
 
-const file_warden_service_v1_system_proto_rawDesc = "" +
-	"\n" +
-	"\x1ewarden/service/v1/system.proto\x12\x11warden.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x99\x02\n" +
-	"\x0eHealthResponse\x127\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x1f.warden.service.v1.HealthStatusR\x06status\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12Q\n" +
-	"\n" +
-	"components\x18\x03 \x03(\v21.warden.service.v1.HealthResponse.ComponentsEntryR\n" +
+func (x *GetStatsResponse) GetPasswordReadsPerDay() []*DailyCount {
+	if x != nil {
+		return x.PasswordReadsPerDay
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetTopAccessedSecrets() []*SecretAccessCount {
+	if x != nil {
+		return x.TopAccessedSecrets
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetSecretsDueForRotation() []*SecretRotationDue {
+	if x != nil {
+		return x.SecretsDueForRotation
+	}
+	return nil
+}
+
+type DailyCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UTC day as YYYY-MM-DD
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Count         int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyCount) Reset() {
+	*x = DailyCount{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyCount) ProtoMessage() {}
+
+func (x *DailyCount) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyCount.ProtoReflect.Descriptor instead.
+func (*DailyCount) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DailyCount) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SecretAccessCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretAccessCount) Reset() {
+	*x = SecretAccessCount{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretAccessCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretAccessCount) ProtoMessage() {}
+
+func (x *SecretAccessCount) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretAccessCount.ProtoReflect.Descriptor instead.
+func (*SecretAccessCount) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SecretAccessCount) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *SecretAccessCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretAccessCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SecretRotationDue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FolderId      *string                `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretRotationDue) Reset() {
+	*x = SecretRotationDue{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretRotationDue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretRotationDue) ProtoMessage() {}
+
+func (x *SecretRotationDue) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretRotationDue.ProtoReflect.Descriptor instead.
+func (*SecretRotationDue) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SecretRotationDue) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *SecretRotationDue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretRotationDue) GetFolderId() string {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return ""
+}
+
+func (x *SecretRotationDue) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type GetStaleSecretsReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Report on another tenant (platform admin only)
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Report secrets whose password is older than this many days (default 90, 0 disables)
+	PasswordAgeDays *uint32 `protobuf:"varint,2,opt,name=password_age_days,json=passwordAgeDays,proto3,oneof" json:"password_age_days,omitempty"`
+	// Report secrets whose password was not read for this many days (default 90, 0 disables)
+	UnreadDays    *uint32 `protobuf:"varint,3,opt,name=unread_days,json=unreadDays,proto3,oneof" json:"unread_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStaleSecretsReportRequest) Reset() {
+	*x = GetStaleSecretsReportRequest{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStaleSecretsReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaleSecretsReportRequest) ProtoMessage() {}
+
+func (x *GetStaleSecretsReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaleSecretsReportRequest.ProtoReflect.Descriptor instead.
+func (*GetStaleSecretsReportRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetStaleSecretsReportRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *GetStaleSecretsReportRequest) GetPasswordAgeDays() uint32 {
+	if x != nil && x.PasswordAgeDays != nil {
+		return *x.PasswordAgeDays
+	}
+	return 0
+}
+
+func (x *GetStaleSecretsReportRequest) GetUnreadDays() uint32 {
+	if x != nil && x.UnreadDays != nil {
+		return *x.UnreadDays
+	}
+	return 0
+}
+
+type GetStaleSecretsReportResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TotalCount         int32                  `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PasswordStaleCount int32                  `protobuf:"varint,2,opt,name=password_stale_count,json=passwordStaleCount,proto3" json:"password_stale_count,omitempty"`
+	UnreadCount        int32                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	// Stale secrets per folder, ordered by path (root-level secrets first)
+	Folders []*StaleSecretFolderGroup `protobuf:"bytes,4,rep,name=folders,proto3" json:"folders,omitempty"`
+	// Stale secrets per direct owner, most stale secrets first. Secrets with
+	// several owners are listed under each; secrets without a direct user
+	// owner are grouped under an empty owner ID.
+	Owners        []*StaleSecretOwnerGroup `protobuf:"bytes,5,rep,name=owners,proto3" json:"owners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStaleSecretsReportResponse) Reset() {
+	*x = GetStaleSecretsReportResponse{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStaleSecretsReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaleSecretsReportResponse) ProtoMessage() {}
+
+func (x *GetStaleSecretsReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaleSecretsReportResponse.ProtoReflect.Descriptor instead.
+func (*GetStaleSecretsReportResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetStaleSecretsReportResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetStaleSecretsReportResponse) GetPasswordStaleCount() int32 {
+	if x != nil {
+		return x.PasswordStaleCount
+	}
+	return 0
+}
+
+func (x *GetStaleSecretsReportResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GetStaleSecretsReportResponse) GetFolders() []*StaleSecretFolderGroup {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+func (x *GetStaleSecretsReportResponse) GetOwners() []*StaleSecretOwnerGroup {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+type StaleSecret struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SecretId string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// When the current password was set
+	PasswordChangedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=password_changed_time,json=passwordChangedTime,proto3,oneof" json:"password_changed_time,omitempty"`
+	// When the password was last read, unset when never read
+	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_accessed_time,json=lastAccessedTime,proto3,oneof" json:"last_accessed_time,omitempty"`
+	// The password is older than password_age_days
+	PasswordStale bool `protobuf:"varint,5,opt,name=password_stale,json=passwordStale,proto3" json:"password_stale,omitempty"`
+	// The password was not read for unread_days
+	Unread        bool     `protobuf:"varint,6,opt,name=unread,proto3" json:"unread,omitempty"`
+	OwnerIds      []string `protobuf:"bytes,7,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaleSecret) Reset() {
+	*x = StaleSecret{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleSecret) ProtoMessage() {}
+
+func (x *StaleSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleSecret.ProtoReflect.Descriptor instead.
+func (*StaleSecret) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StaleSecret) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *StaleSecret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StaleSecret) GetPasswordChangedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PasswordChangedTime
+	}
+	return nil
+}
+
+func (x *StaleSecret) GetLastAccessedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedTime
+	}
+	return nil
+}
+
+func (x *StaleSecret) GetPasswordStale() bool {
+	if x != nil {
+		return x.PasswordStale
+	}
+	return false
+}
+
+func (x *StaleSecret) GetUnread() bool {
+	if x != nil {
+		return x.Unread
+	}
+	return false
+}
+
+func (x *StaleSecret) GetOwnerIds() []string {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+type StaleSecretFolderGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset for root-level secrets
+	FolderId      *string        `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	Path          string         `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Secrets       []*StaleSecret `protobuf:"bytes,3,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaleSecretFolderGroup) Reset() {
+	*x = StaleSecretFolderGroup{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleSecretFolderGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleSecretFolderGroup) ProtoMessage() {}
+
+func (x *StaleSecretFolderGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleSecretFolderGroup.ProtoReflect.Descriptor instead.
+func (*StaleSecretFolderGroup) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StaleSecretFolderGroup) GetFolderId() string {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return ""
+}
+
+func (x *StaleSecretFolderGroup) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *StaleSecretFolderGroup) GetSecrets() []*StaleSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+type StaleSecretOwnerGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	SecretIds     []string               `protobuf:"bytes,2,rep,name=secret_ids,json=secretIds,proto3" json:"secret_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaleSecretOwnerGroup) Reset() {
+	*x = StaleSecretOwnerGroup{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleSecretOwnerGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleSecretOwnerGroup) ProtoMessage() {}
+
+func (x *StaleSecretOwnerGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleSecretOwnerGroup.ProtoReflect.Descriptor instead.
+func (*StaleSecretOwnerGroup) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StaleSecretOwnerGroup) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *StaleSecretOwnerGroup) GetSecretIds() []string {
+	if x != nil {
+		return x.SecretIds
+	}
+	return nil
+}
+
+type ListTenantUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Limit the report to one tenant
+	TenantId      *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantUsageRequest) Reset() {
+	*x = ListTenantUsageRequest{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantUsageRequest) ProtoMessage() {}
+
+func (x *ListTenantUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantUsageRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantUsageRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListTenantUsageRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type TenantUsage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TenantId        uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	TotalSecrets    int64                  `protobuf:"varint,2,opt,name=total_secrets,json=totalSecrets,proto3" json:"total_secrets,omitempty"`
+	ActiveSecrets   int64                  `protobuf:"varint,3,opt,name=active_secrets,json=activeSecrets,proto3" json:"active_secrets,omitempty"`
+	ArchivedSecrets int64                  `protobuf:"varint,4,opt,name=archived_secrets,json=archivedSecrets,proto3" json:"archived_secrets,omitempty"`
+	// Secrets in the trash
+	DeletedSecrets int64 `protobuf:"varint,5,opt,name=deleted_secrets,json=deletedSecrets,proto3" json:"deleted_secrets,omitempty"`
+	TotalFolders   int64 `protobuf:"varint,6,opt,name=total_folders,json=totalFolders,proto3" json:"total_folders,omitempty"`
+	TotalVersions  int64 `protobuf:"varint,7,opt,name=total_versions,json=totalVersions,proto3" json:"total_versions,omitempty"`
+	// Approximate Vault KV storage, derived from the version count
+	EstimatedVaultBytes int64 `protobuf:"varint,8,opt,name=estimated_vault_bytes,json=estimatedVaultBytes,proto3" json:"estimated_vault_bytes,omitempty"`
+	// Time of the tenant's most recent audited request
+	LastActivityTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_activity_time,json=lastActivityTime,proto3,oneof" json:"last_activity_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TenantUsage) Reset() {
+	*x = TenantUsage{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUsage) ProtoMessage() {}
+
+func (x *TenantUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUsage.ProtoReflect.Descriptor instead.
+func (*TenantUsage) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TenantUsage) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetTotalSecrets() int64 {
+	if x != nil {
+		return x.TotalSecrets
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetActiveSecrets() int64 {
+	if x != nil {
+		return x.ActiveSecrets
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetArchivedSecrets() int64 {
+	if x != nil {
+		return x.ArchivedSecrets
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetDeletedSecrets() int64 {
+	if x != nil {
+		return x.DeletedSecrets
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetTotalFolders() int64 {
+	if x != nil {
+		return x.TotalFolders
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetTotalVersions() int64 {
+	if x != nil {
+		return x.TotalVersions
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetEstimatedVaultBytes() int64 {
+	if x != nil {
+		return x.EstimatedVaultBytes
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetLastActivityTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivityTime
+	}
+	return nil
+}
+
+type ListTenantUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by tenant ID
+	Tenants []*TenantUsage `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	// Sums over all listed tenants (tenant_id and last_activity_time unset)
+	Total         *TenantUsage `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantUsageResponse) Reset() {
+	*x = ListTenantUsageResponse{}
+	mi := &file_warden_service_v1_system_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantUsageResponse) ProtoMessage() {}
+
+func (x *ListTenantUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_system_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantUsageResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantUsageResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_system_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListTenantUsageResponse) GetTenants() []*TenantUsage {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *ListTenantUsageResponse) GetTotal() *TenantUsage {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+var File_warden_service_v1_system_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_system_proto_rawDesc = "" +
+	"\n" +
+	"\x1ewarden/service/v1/system.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x99\x02\n" +
+	"\x0eHealthResponse\x127\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1f.warden.service.v1.HealthStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12Q\n" +
+	"\n" +
+	"components\x18\x03 \x03(\v21.warden.service.v1.HealthResponse.ComponentsEntryR\n" +
 	"components\x1aa\n" +
 	"\x0fComponentsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x128\n" +
@@ -778,16 +1888,45 @@ const file_warden_service_v1_system_proto_rawDesc = "" +
 	"\n" +
 	"go_version\x18\x03 \x01(\tR\tgoVersion\x12\x1d\n" +
 	"\n" +
-	"git_commit\x18\x04 \x01(\tR\tgitCommit\"\x89\x01\n" +
+	"git_commit\x18\x04 \x01(\tR\tgitCommit\"\x97\x04\n" +
+	"\x17GetCapabilitiesResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12#\n" +
+	"\rvault_backend\x18\x02 \x01(\tR\fvaultBackend\x12%\n" +
+	"\x0eimport_formats\x18\x03 \x03(\tR\rimportFormats\x12%\n" +
+	"\x0eexport_formats\x18\x04 \x03(\tR\rexportFormats\x12+\n" +
+	"\x11password_rotation\x18\x05 \x01(\bR\x10passwordRotation\x12\x12\n" +
+	"\x04totp\x18\x06 \x01(\bR\x04totp\x12(\n" +
+	"\x10import_max_bytes\x18\a \x01(\x03R\x0eimportMaxBytes\x12(\n" +
+	"\x10import_max_items\x18\b \x01(\x03R\x0eimportMaxItems\x12(\n" +
+	"\x10backup_max_bytes\x18\t \x01(\x03R\x0ebackupMaxBytes\x12.\n" +
+	"\x13backup_max_entities\x18\n" +
+	" \x01(\x03R\x11backupMaxEntities\x12%\n" +
+	"\x0eopenapi_sha256\x18\v \x01(\tR\ropenapiSha256\x12+\n" +
+	"\x11descriptor_sha256\x18\f \x01(\tR\x10descriptorSha256\x12,\n" +
+	"\x12password_max_bytes\x18\r \x01(\x03R\x10passwordMaxBytes\"]\n" +
+	"\x13GetApiSchemaRequest\x12F\n" +
+	"\x06format\x18\x01 \x01(\x0e2\".warden.service.v1.ApiSchemaFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\"e\n" +
+	"\x14GetApiSchemaResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256\"\x89\x01\n" +
 	"\x12CheckVaultResponse\x12\x1c\n" +
 	"\tconnected\x18\x01 \x01(\bR\tconnected\x12#\n" +
 	"\rvault_version\x18\x02 \x01(\tR\fvaultVersion\x12\x16\n" +
 	"\x06sealed\x18\x03 \x01(\bR\x06sealed\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"A\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x83\x02\n" +
 	"\x0fGetStatsRequest\x12 \n" +
-	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12\"\n" +
+	"\x04days\x18\x02 \x01(\rB\t\xbaH\x06*\x04\x18Z(\x01H\x01R\x04days\x88\x01\x01\x12+\n" +
+	"\ttop_limit\x18\x03 \x01(\rB\t\xbaH\x06*\x04\x182(\x01H\x02R\btopLimit\x88\x01\x01\x12?\n" +
+	"\x14rotation_window_days\x18\x04 \x01(\rB\b\xbaH\x05*\x03\x18\xed\x02H\x03R\x12rotationWindowDays\x88\x01\x01B\f\n" +
 	"\n" +
-	"_tenant_id\"\xb6\x01\n" +
+	"_tenant_idB\a\n" +
+	"\x05_daysB\f\n" +
+	"\n" +
+	"_top_limitB\x17\n" +
+	"\x15_rotation_window_days\"\xb6\x01\n" +
 	"\x10SharePolicyInput\x126\n" +
 	"\x04type\x18\x01 \x01(\x0e2\".warden.service.v1.SharePolicyTypeR\x04type\x12<\n" +
 	"\x06method\x18\x02 \x01(\x0e2$.warden.service.v1.SharePolicyMethodR\x06method\x12\x14\n" +
@@ -802,19 +1941,98 @@ const file_warden_service_v1_system_proto_rawDesc = "" +
 	"\x19CreateShareSecretResponse\x12\x19\n" +
 	"\bshare_id\x18\x01 \x01(\tR\ashareId\x12\x1d\n" +
 	"\n" +
-	"share_link\x18\x02 \x01(\tR\tshareLink\"\x8c\x02\n" +
+	"share_link\x18\x02 \x01(\tR\tshareLink\"\xed\x04\n" +
 	"\x10GetStatsResponse\x12#\n" +
 	"\rtotal_secrets\x18\x01 \x01(\x03R\ftotalSecrets\x12%\n" +
 	"\x0eactive_secrets\x18\x02 \x01(\x03R\ractiveSecrets\x12)\n" +
 	"\x10archived_secrets\x18\x03 \x01(\x03R\x0farchivedSecrets\x12#\n" +
 	"\rtotal_folders\x18\x04 \x01(\x03R\ftotalFolders\x12%\n" +
 	"\x0etotal_versions\x18\x05 \x01(\x03R\rtotalVersions\x125\n" +
-	"\x17avg_versions_per_secret\x18\x06 \x01(\x01R\x14avgVersionsPerSecret*\x81\x01\n" +
+	"\x17avg_versions_per_secret\x18\x06 \x01(\x01R\x14avgVersionsPerSecret\x12T\n" +
+	"\x17secrets_created_per_day\x18\a \x03(\v2\x1d.warden.service.v1.DailyCountR\x14secretsCreatedPerDay\x12R\n" +
+	"\x16password_reads_per_day\x18\b \x03(\v2\x1d.warden.service.v1.DailyCountR\x13passwordReadsPerDay\x12V\n" +
+	"\x14top_accessed_secrets\x18\t \x03(\v2$.warden.service.v1.SecretAccessCountR\x12topAccessedSecrets\x12]\n" +
+	"\x18secrets_due_for_rotation\x18\n" +
+	" \x03(\v2$.warden.service.v1.SecretRotationDueR\x15secretsDueForRotation\"6\n" +
+	"\n" +
+	"DailyCount\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"Z\n" +
+	"\x11SecretAccessCount\x12\x1b\n" +
+	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\xaf\x01\n" +
+	"\x11SecretRotationDue\x12\x1b\n" +
+	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\tfolder_id\x18\x03 \x01(\tH\x00R\bfolderId\x88\x01\x01\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAtB\f\n" +
+	"\n" +
+	"_folder_id\"\xdf\x01\n" +
+	"\x1cGetStaleSecretsReportRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x129\n" +
+	"\x11password_age_days\x18\x02 \x01(\rB\b\xbaH\x05*\x03\x18\xc2\x1cH\x01R\x0fpasswordAgeDays\x88\x01\x01\x12.\n" +
+	"\vunread_days\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xc2\x1cH\x02R\n" +
+	"unreadDays\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\x14\n" +
+	"\x12_password_age_daysB\x0e\n" +
+	"\f_unread_days\"\x9c\x02\n" +
+	"\x1dGetStaleSecretsReportResponse\x12\x1f\n" +
+	"\vtotal_count\x18\x01 \x01(\x05R\n" +
+	"totalCount\x120\n" +
+	"\x14password_stale_count\x18\x02 \x01(\x05R\x12passwordStaleCount\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\x12C\n" +
+	"\afolders\x18\x04 \x03(\v2).warden.service.v1.StaleSecretFolderGroupR\afolders\x12@\n" +
+	"\x06owners\x18\x05 \x03(\v2(.warden.service.v1.StaleSecretOwnerGroupR\x06owners\"\xef\x02\n" +
+	"\vStaleSecret\x12\x1b\n" +
+	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12S\n" +
+	"\x15password_changed_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x13passwordChangedTime\x88\x01\x01\x12M\n" +
+	"\x12last_accessed_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x10lastAccessedTime\x88\x01\x01\x12%\n" +
+	"\x0epassword_stale\x18\x05 \x01(\bR\rpasswordStale\x12\x16\n" +
+	"\x06unread\x18\x06 \x01(\bR\x06unread\x12\x1b\n" +
+	"\towner_ids\x18\a \x03(\tR\bownerIdsB\x18\n" +
+	"\x16_password_changed_timeB\x15\n" +
+	"\x13_last_accessed_time\"\x96\x01\n" +
+	"\x16StaleSecretFolderGroup\x12 \n" +
+	"\tfolder_id\x18\x01 \x01(\tH\x00R\bfolderId\x88\x01\x01\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x128\n" +
+	"\asecrets\x18\x03 \x03(\v2\x1e.warden.service.v1.StaleSecretR\asecretsB\f\n" +
+	"\n" +
+	"_folder_id\"Q\n" +
+	"\x15StaleSecretOwnerGroup\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x1d\n" +
+	"\n" +
+	"secret_ids\x18\x02 \x03(\tR\tsecretIds\"H\n" +
+	"\x16ListTenantUsageRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"\xb0\x03\n" +
+	"\vTenantUsage\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12#\n" +
+	"\rtotal_secrets\x18\x02 \x01(\x03R\ftotalSecrets\x12%\n" +
+	"\x0eactive_secrets\x18\x03 \x01(\x03R\ractiveSecrets\x12)\n" +
+	"\x10archived_secrets\x18\x04 \x01(\x03R\x0farchivedSecrets\x12'\n" +
+	"\x0fdeleted_secrets\x18\x05 \x01(\x03R\x0edeletedSecrets\x12#\n" +
+	"\rtotal_folders\x18\x06 \x01(\x03R\ftotalFolders\x12%\n" +
+	"\x0etotal_versions\x18\a \x01(\x03R\rtotalVersions\x122\n" +
+	"\x15estimated_vault_bytes\x18\b \x01(\x03R\x13estimatedVaultBytes\x12M\n" +
+	"\x12last_activity_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x10lastActivityTime\x88\x01\x01B\x15\n" +
+	"\x13_last_activity_time\"\x89\x01\n" +
+	"\x17ListTenantUsageResponse\x128\n" +
+	"\atenants\x18\x01 \x03(\v2\x1e.warden.service.v1.TenantUsageR\atenants\x124\n" +
+	"\x05total\x18\x02 \x01(\v2\x1e.warden.service.v1.TenantUsageR\x05total*\x81\x01\n" +
 	"\fHealthStatus\x12\x1d\n" +
 	"\x19HEALTH_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15HEALTH_STATUS_HEALTHY\x10\x01\x12\x1a\n" +
 	"\x16HEALTH_STATUS_DEGRADED\x10\x02\x12\x1b\n" +
-	"\x17HEALTH_STATUS_UNHEALTHY\x10\x03*v\n" +
+	"\x17HEALTH_STATUS_UNHEALTHY\x10\x03*u\n" +
+	"\x0fApiSchemaFormat\x12!\n" +
+	"\x1dAPI_SCHEMA_FORMAT_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19API_SCHEMA_FORMAT_OPENAPI\x10\x01\x12 \n" +
+	"\x1cAPI_SCHEMA_FORMAT_DESCRIPTOR\x10\x02*v\n" +
 	"\x0fSharePolicyType\x12!\n" +
 	"\x1dSHARE_POLICY_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bSHARE_POLICY_TYPE_BLACKLIST\x10\x01\x12\x1f\n" +
@@ -826,15 +2044,19 @@ const file_warden_service_v1_system_proto_rawDesc = "" +
 	"\x1aSHARE_POLICY_METHOD_REGION\x10\x03\x12\x1c\n" +
 	"\x18SHARE_POLICY_METHOD_TIME\x10\x04\x12\x1e\n" +
 	"\x1aSHARE_POLICY_METHOD_DEVICE\x10\x05\x12\x1f\n" +
-	"\x1bSHARE_POLICY_METHOD_NETWORK\x10\x062\x9d\x04\n" +
+	"\x1bSHARE_POLICY_METHOD_NETWORK\x10\x062\xb5\b\n" +
 	"\x13WardenSystemService\x12W\n" +
 	"\x06Health\x12\x16.google.protobuf.Empty\x1a!.warden.service.v1.HealthResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/health\x12W\n" +
 	"\aGetInfo\x12\x16.google.protobuf.Empty\x1a\".warden.service.v1.GetInfoResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
-	"\x12\b/v1/info\x12d\n" +
+	"\x12\b/v1/info\x12o\n" +
+	"\x0fGetCapabilities\x12\x16.google.protobuf.Empty\x1a*.warden.service.v1.GetCapabilitiesResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/capabilities\x12\x80\x01\n" +
+	"\fGetApiSchema\x12&.warden.service.v1.GetApiSchemaRequest\x1a'.warden.service.v1.GetApiSchemaResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/capabilities/schema\x12d\n" +
 	"\n" +
 	"CheckVault\x12\x16.google.protobuf.Empty\x1a%.warden.service.v1.CheckVaultResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/vault/check\x12f\n" +
-	"\bGetStats\x12\".warden.service.v1.GetStatsRequest\x1a#.warden.service.v1.GetStatsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/stats\x12\x85\x01\n" +
+	"\bGetStats\x12\".warden.service.v1.GetStatsRequest\x1a#.warden.service.v1.GetStatsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/stats\x12\x83\x01\n" +
+	"\x0fListTenantUsage\x12).warden.service.v1.ListTenantUsageRequest\x1a*.warden.service.v1.ListTenantUsageResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/stats/tenants\x12\x9b\x01\n" +
+	"\x15GetStaleSecretsReport\x12/.warden.service.v1.GetStaleSecretsReportRequest\x1a0.warden.service.v1.GetStaleSecretsReportResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/stats/stale-secrets\x12\x85\x01\n" +
 	"\x11CreateShareSecret\x12+.warden.service.v1.CreateShareSecretRequest\x1a,.warden.service.v1.CreateShareSecretResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v1/sharesB\xd3\x01\n" +
 	"\x15com.warden.service.v1B\vSystemProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
@@ -851,47 +2073,85 @@ func file_warden_service_v1_system_proto_rawDescGZIP() []byte {
 	return file_warden_service_v1_system_proto_rawDescData
 }
 
-var file_warden_service_v1_system_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_warden_service_v1_system_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_warden_service_v1_system_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_warden_service_v1_system_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_warden_service_v1_system_proto_goTypes = []any{
-	(HealthStatus)(0),                 // 0: warden.service.v1.HealthStatus
-	(SharePolicyType)(0),              // 1: warden.service.v1.SharePolicyType
-	(SharePolicyMethod)(0),            // 2: warden.service.v1.SharePolicyMethod
-	(*HealthResponse)(nil),            // 3: warden.service.v1.HealthResponse
-	(*ComponentHealth)(nil),           // 4: warden.service.v1.ComponentHealth
-	(*GetInfoResponse)(nil),           // 5: warden.service.v1.GetInfoResponse
-	(*CheckVaultResponse)(nil),        // 6: warden.service.v1.CheckVaultResponse
-	(*GetStatsRequest)(nil),           // 7: warden.service.v1.GetStatsRequest
-	(*SharePolicyInput)(nil),          // 8: warden.service.v1.SharePolicyInput
-	(*CreateShareSecretRequest)(nil),  // 9: warden.service.v1.CreateShareSecretRequest
-	(*CreateShareSecretResponse)(nil), // 10: warden.service.v1.CreateShareSecretResponse
-	(*GetStatsResponse)(nil),          // 11: warden.service.v1.GetStatsResponse
-	nil,                               // 12: warden.service.v1.HealthResponse.ComponentsEntry
-	(*emptypb.Empty)(nil),             // 13: google.protobuf.Empty
+	(HealthStatus)(0),                     // 0: warden.service.v1.HealthStatus
+	(ApiSchemaFormat)(0),                  // 1: warden.service.v1.ApiSchemaFormat
+	(SharePolicyType)(0),                  // 2: warden.service.v1.SharePolicyType
+	(SharePolicyMethod)(0),                // 3: warden.service.v1.SharePolicyMethod
+	(*HealthResponse)(nil),                // 4: warden.service.v1.HealthResponse
+	(*ComponentHealth)(nil),               // 5: warden.service.v1.ComponentHealth
+	(*GetInfoResponse)(nil),               // 6: warden.service.v1.GetInfoResponse
+	(*GetCapabilitiesResponse)(nil),       // 7: warden.service.v1.GetCapabilitiesResponse
+	(*GetApiSchemaRequest)(nil),           // 8: warden.service.v1.GetApiSchemaRequest
+	(*GetApiSchemaResponse)(nil),          // 9: warden.service.v1.GetApiSchemaResponse
+	(*CheckVaultResponse)(nil),            // 10: warden.service.v1.CheckVaultResponse
+	(*GetStatsRequest)(nil),               // 11: warden.service.v1.GetStatsRequest
+	(*SharePolicyInput)(nil),              // 12: warden.service.v1.SharePolicyInput
+	(*CreateShareSecretRequest)(nil),      // 13: warden.service.v1.CreateShareSecretRequest
+	(*CreateShareSecretResponse)(nil),     // 14: warden.service.v1.CreateShareSecretResponse
+	(*GetStatsResponse)(nil),              // 15: warden.service.v1.GetStatsResponse
+	(*DailyCount)(nil),                    // 16: warden.service.v1.DailyCount
+	(*SecretAccessCount)(nil),             // 17: warden.service.v1.SecretAccessCount
+	(*SecretRotationDue)(nil),             // 18: warden.service.v1.SecretRotationDue
+	(*GetStaleSecretsReportRequest)(nil),  // 19: warden.service.v1.GetStaleSecretsReportRequest
+	(*GetStaleSecretsReportResponse)(nil), // 20: warden.service.v1.GetStaleSecretsReportResponse
+	(*StaleSecret)(nil),                   // 21: warden.service.v1.StaleSecret
+	(*StaleSecretFolderGroup)(nil),        // 22: warden.service.v1.StaleSecretFolderGroup
+	(*StaleSecretOwnerGroup)(nil),         // 23: warden.service.v1.StaleSecretOwnerGroup
+	(*ListTenantUsageRequest)(nil),        // 24: warden.service.v1.ListTenantUsageRequest
+	(*TenantUsage)(nil),                   // 25: warden.service.v1.TenantUsage
+	(*ListTenantUsageResponse)(nil),       // 26: warden.service.v1.ListTenantUsageResponse
+	nil,                                   // 27: warden.service.v1.HealthResponse.ComponentsEntry
+	(*timestamppb.Timestamp)(nil),         // 28: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 29: google.protobuf.Empty
 }
 var file_warden_service_v1_system_proto_depIdxs = []int32{
 	0,  // 0: warden.service.v1.HealthResponse.status:type_name -> warden.service.v1.HealthStatus
-	12, // 1: warden.service.v1.HealthResponse.components:type_name -> warden.service.v1.HealthResponse.ComponentsEntry
+	27, // 1: warden.service.v1.HealthResponse.components:type_name -> warden.service.v1.HealthResponse.ComponentsEntry
 	0,  // 2: warden.service.v1.ComponentHealth.status:type_name -> warden.service.v1.HealthStatus
-	1,  // 3: warden.service.v1.SharePolicyInput.type:type_name -> warden.service.v1.SharePolicyType
-	2,  // 4: warden.service.v1.SharePolicyInput.method:type_name -> warden.service.v1.SharePolicyMethod
-	8,  // 5: warden.service.v1.CreateShareSecretRequest.policies:type_name -> warden.service.v1.SharePolicyInput
-	4,  // 6: warden.service.v1.HealthResponse.ComponentsEntry.value:type_name -> warden.service.v1.ComponentHealth
-	13, // 7: warden.service.v1.WardenSystemService.Health:input_type -> google.protobuf.Empty
-	13, // 8: warden.service.v1.WardenSystemService.GetInfo:input_type -> google.protobuf.Empty
-	13, // 9: warden.service.v1.WardenSystemService.CheckVault:input_type -> google.protobuf.Empty
-	7,  // 10: warden.service.v1.WardenSystemService.GetStats:input_type -> warden.service.v1.GetStatsRequest
-	9,  // 11: warden.service.v1.WardenSystemService.CreateShareSecret:input_type -> warden.service.v1.CreateShareSecretRequest
-	3,  // 12: warden.service.v1.WardenSystemService.Health:output_type -> warden.service.v1.HealthResponse
-	5,  // 13: warden.service.v1.WardenSystemService.GetInfo:output_type -> warden.service.v1.GetInfoResponse
-	6,  // 14: warden.service.v1.WardenSystemService.CheckVault:output_type -> warden.service.v1.CheckVaultResponse
-	11, // 15: warden.service.v1.WardenSystemService.GetStats:output_type -> warden.service.v1.GetStatsResponse
-	10, // 16: warden.service.v1.WardenSystemService.CreateShareSecret:output_type -> warden.service.v1.CreateShareSecretResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	1,  // 3: warden.service.v1.GetApiSchemaRequest.format:type_name -> warden.service.v1.ApiSchemaFormat
+	2,  // 4: warden.service.v1.SharePolicyInput.type:type_name -> warden.service.v1.SharePolicyType
+	3,  // 5: warden.service.v1.SharePolicyInput.method:type_name -> warden.service.v1.SharePolicyMethod
+	12, // 6: warden.service.v1.CreateShareSecretRequest.policies:type_name -> warden.service.v1.SharePolicyInput
+	16, // 7: warden.service.v1.GetStatsResponse.secrets_created_per_day:type_name -> warden.service.v1.DailyCount
+	16, // 8: warden.service.v1.GetStatsResponse.password_reads_per_day:type_name -> warden.service.v1.DailyCount
+	17, // 9: warden.service.v1.GetStatsResponse.top_accessed_secrets:type_name -> warden.service.v1.SecretAccessCount
+	18, // 10: warden.service.v1.GetStatsResponse.secrets_due_for_rotation:type_name -> warden.service.v1.SecretRotationDue
+	28, // 11: warden.service.v1.SecretRotationDue.expires_at:type_name -> google.protobuf.Timestamp
+	22, // 12: warden.service.v1.GetStaleSecretsReportResponse.folders:type_name -> warden.service.v1.StaleSecretFolderGroup
+	23, // 13: warden.service.v1.GetStaleSecretsReportResponse.owners:type_name -> warden.service.v1.StaleSecretOwnerGroup
+	28, // 14: warden.service.v1.StaleSecret.password_changed_time:type_name -> google.protobuf.Timestamp
+	28, // 15: warden.service.v1.StaleSecret.last_accessed_time:type_name -> google.protobuf.Timestamp
+	21, // 16: warden.service.v1.StaleSecretFolderGroup.secrets:type_name -> warden.service.v1.StaleSecret
+	28, // 17: warden.service.v1.TenantUsage.last_activity_time:type_name -> google.protobuf.Timestamp
+	25, // 18: warden.service.v1.ListTenantUsageResponse.tenants:type_name -> warden.service.v1.TenantUsage
+	25, // 19: warden.service.v1.ListTenantUsageResponse.total:type_name -> warden.service.v1.TenantUsage
+	5,  // 20: warden.service.v1.HealthResponse.ComponentsEntry.value:type_name -> warden.service.v1.ComponentHealth
+	29, // 21: warden.service.v1.WardenSystemService.Health:input_type -> google.protobuf.Empty
+	29, // 22: warden.service.v1.WardenSystemService.GetInfo:input_type -> google.protobuf.Empty
+	29, // 23: warden.service.v1.WardenSystemService.GetCapabilities:input_type -> google.protobuf.Empty
+	8,  // 24: warden.service.v1.WardenSystemService.GetApiSchema:input_type -> warden.service.v1.GetApiSchemaRequest
+	29, // 25: warden.service.v1.WardenSystemService.CheckVault:input_type -> google.protobuf.Empty
+	11, // 26: warden.service.v1.WardenSystemService.GetStats:input_type -> warden.service.v1.GetStatsRequest
+	24, // 27: warden.service.v1.WardenSystemService.ListTenantUsage:input_type -> warden.service.v1.ListTenantUsageRequest
+	19, // 28: warden.service.v1.WardenSystemService.GetStaleSecretsReport:input_type -> warden.service.v1.GetStaleSecretsReportRequest
+	13, // 29: warden.service.v1.WardenSystemService.CreateShareSecret:input_type -> warden.service.v1.CreateShareSecretRequest
+	4,  // 30: warden.service.v1.WardenSystemService.Health:output_type -> warden.service.v1.HealthResponse
+	6,  // 31: warden.service.v1.WardenSystemService.GetInfo:output_type -> warden.service.v1.GetInfoResponse
+	7,  // 32: warden.service.v1.WardenSystemService.GetCapabilities:output_type -> warden.service.v1.GetCapabilitiesResponse
+	9,  // 33: warden.service.v1.WardenSystemService.GetApiSchema:output_type -> warden.service.v1.GetApiSchemaResponse
+	10, // 34: warden.service.v1.WardenSystemService.CheckVault:output_type -> warden.service.v1.CheckVaultResponse
+	15, // 35: warden.service.v1.WardenSystemService.GetStats:output_type -> warden.service.v1.GetStatsResponse
+	26, // 36: warden.service.v1.WardenSystemService.ListTenantUsage:output_type -> warden.service.v1.ListTenantUsageResponse
+	20, // 37: warden.service.v1.WardenSystemService.GetStaleSecretsReport:output_type -> warden.service.v1.GetStaleSecretsReportResponse
+	14, // 38: warden.service.v1.WardenSystemService.CreateShareSecret:output_type -> warden.service.v1.CreateShareSecretResponse
+	30, // [30:39] is the sub-list for method output_type
+	21, // [21:30] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_warden_service_v1_system_proto_init() }
@@ -899,14 +2159,20 @@ func file_warden_service_v1_system_proto_init() {
 	if File_warden_service_v1_system_proto != nil {
 		return
 	}
-	file_warden_service_v1_system_proto_msgTypes[4].OneofWrappers = []any{}
+	file_warden_service_v1_system_proto_msgTypes[7].OneofWrappers = []any{}
+	file_warden_service_v1_system_proto_msgTypes[14].OneofWrappers = []any{}
+	file_warden_service_v1_system_proto_msgTypes[15].OneofWrappers = []any{}
+	file_warden_service_v1_system_proto_msgTypes[17].OneofWrappers = []any{}
+	file_warden_service_v1_system_proto_msgTypes[18].OneofWrappers = []any{}
+	file_warden_service_v1_system_proto_msgTypes[20].OneofWrappers = []any{}
+	file_warden_service_v1_system_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_system_proto_rawDesc), len(file_warden_service_v1_system_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   10,
+			NumEnums:      4,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -4,12 +4,14 @@
 package wardenpb
 
 import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	context "context"
 	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -19,7 +21,9 @@ var (
 	_ redact.Redactor
 	_ codes.Code
 	_ status.Status
+	_ validate.Rule
 	_ emptypb.Empty
+	_ timestamppb.Timestamp
 )
 
 // RegisterRedactedWardenSystemServiceServer wraps the WardenSystemServiceServer with the redacted server and registers the service in GRPC
@@ -62,6 +66,28 @@ func (s *redactedWardenSystemServiceServer) GetInfo(ctx context.Context, in *emp
 	return res, err
 }
 
+// GetCapabilities is the redacted wrapper for the actual WardenSystemServiceServer.GetCapabilities method
+// Unary RPC
+func (s *redactedWardenSystemServiceServer) GetCapabilities(ctx context.Context, in *emptypb.Empty) (*GetCapabilitiesResponse, error) {
+	res, err := s.srv.GetCapabilities(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetApiSchema is the redacted wrapper for the actual WardenSystemServiceServer.GetApiSchema method
+// Unary RPC
+func (s *redactedWardenSystemServiceServer) GetApiSchema(ctx context.Context, in *GetApiSchemaRequest) (*GetApiSchemaResponse, error) {
+	res, err := s.srv.GetApiSchema(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // CheckVault is the redacted wrapper for the actual WardenSystemServiceServer.CheckVault method
 // Unary RPC
 func (s *redactedWardenSystemServiceServer) CheckVault(ctx context.Context, in *emptypb.Empty) (*CheckVaultResponse, error) {
@@ -84,6 +110,28 @@ func (s *redactedWardenSystemServiceServer) GetStats(ctx context.Context, in *Ge
 	return res, err
 }
 
+// ListTenantUsage is the redacted wrapper for the actual WardenSystemServiceServer.ListTenantUsage method
+// Unary RPC
+func (s *redactedWardenSystemServiceServer) ListTenantUsage(ctx context.Context, in *ListTenantUsageRequest) (*ListTenantUsageResponse, error) {
+	res, err := s.srv.ListTenantUsage(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetStaleSecretsReport is the redacted wrapper for the actual WardenSystemServiceServer.GetStaleSecretsReport method
+// Unary RPC
+func (s *redactedWardenSystemServiceServer) GetStaleSecretsReport(ctx context.Context, in *GetStaleSecretsReportRequest) (*GetStaleSecretsReportResponse, error) {
+	res, err := s.srv.GetStaleSecretsReport(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // CreateShareSecret is the redacted wrapper for the actual WardenSystemServiceServer.CreateShareSecret method
 // Unary RPC
 func (s *redactedWardenSystemServiceServer) CreateShareSecret(ctx context.Context, in *CreateShareSecretRequest) (*CreateShareSecretResponse, error) {
@@ -137,6 +185,64 @@ func (x *GetInfoResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for GetCapabilitiesResponse
+func (x *GetCapabilitiesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Version
+
+	// Safe field: VaultBackend
+
+	// Safe field: ImportFormats
+
+	// Safe field: ExportFormats
+
+	// Safe field: PasswordRotation
+
+	// Safe field: Totp
+
+	// Safe field: ImportMaxBytes
+
+	// Safe field: ImportMaxItems
+
+	// Safe field: BackupMaxBytes
+
+	// Safe field: BackupMaxEntities
+
+	// Safe field: OpenapiSha256
+
+	// Safe field: DescriptorSha256
+
+	// Safe field: PasswordMaxBytes
+	return x.String()
+}
+
+// Redact method implementation for GetApiSchemaRequest
+func (x *GetApiSchemaRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Format
+	return x.String()
+}
+
+// Redact method implementation for GetApiSchemaResponse
+func (x *GetApiSchemaResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Data
+
+	// Safe field: ContentType
+
+	// Safe field: Sha256
+	return x.String()
+}
+
 // Redact method implementation for CheckVaultResponse
 func (x *CheckVaultResponse) Redact() string {
 	if x == nil {
@@ -160,6 +266,12 @@ func (x *GetStatsRequest) Redact() string {
 	}
 
 	// Safe field: TenantId
+
+	// Safe field: Days
+
+	// Safe field: TopLimit
+
+	// Safe field: RotationWindowDays
 	return x.String()
 }
 
@@ -224,5 +336,183 @@ func (x *GetStatsResponse) Redact() string {
 	// Safe field: TotalVersions
 
 	// Safe field: AvgVersionsPerSecret
+
+	// Safe field: SecretsCreatedPerDay
+
+	// Safe field: PasswordReadsPerDay
+
+	// Safe field: TopAccessedSecrets
+
+	// Safe field: SecretsDueForRotation
+	return x.String()
+}
+
+// Redact method implementation for DailyCount
+func (x *DailyCount) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Date
+
+	// Safe field: Count
+	return x.String()
+}
+
+// Redact method implementation for SecretAccessCount
+func (x *SecretAccessCount) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: Name
+
+	// Safe field: Count
+	return x.String()
+}
+
+// Redact method implementation for SecretRotationDue
+func (x *SecretRotationDue) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: Name
+
+	// Safe field: FolderId
+
+	// Safe field: ExpiresAt
+	return x.String()
+}
+
+// Redact method implementation for GetStaleSecretsReportRequest
+func (x *GetStaleSecretsReportRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: PasswordAgeDays
+
+	// Safe field: UnreadDays
+	return x.String()
+}
+
+// Redact method implementation for GetStaleSecretsReportResponse
+func (x *GetStaleSecretsReportResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TotalCount
+
+	// Safe field: PasswordStaleCount
+
+	// Safe field: UnreadCount
+
+	// Safe field: Folders
+
+	// Safe field: Owners
+	return x.String()
+}
+
+// Redact method implementation for StaleSecret
+func (x *StaleSecret) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SecretId
+
+	// Safe field: Name
+
+	// Safe field: PasswordChangedTime
+
+	// Safe field: LastAccessedTime
+
+	// Safe field: PasswordStale
+
+	// Safe field: Unread
+
+	// Safe field: OwnerIds
+	return x.String()
+}
+
+// Redact method implementation for StaleSecretFolderGroup
+func (x *StaleSecretFolderGroup) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FolderId
+
+	// Safe field: Path
+
+	// Safe field: Secrets
+	return x.String()
+}
+
+// Redact method implementation for StaleSecretOwnerGroup
+func (x *StaleSecretOwnerGroup) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: OwnerId
+
+	// Safe field: SecretIds
+	return x.String()
+}
+
+// Redact method implementation for ListTenantUsageRequest
+func (x *ListTenantUsageRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+	return x.String()
+}
+
+// Redact method implementation for TenantUsage
+func (x *TenantUsage) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: TotalSecrets
+
+	// Safe field: ActiveSecrets
+
+	// Safe field: ArchivedSecrets
+
+	// Safe field: DeletedSecrets
+
+	// Safe field: TotalFolders
+
+	// Safe field: TotalVersions
+
+	// Safe field: EstimatedVaultBytes
+
+	// Safe field: LastActivityTime
+	return x.String()
+}
+
+// Redact method implementation for ListTenantUsageResponse
+func (x *ListTenantUsageResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Tenants
+
+	// Safe field: Total
 	return x.String()
 }
@@ -397,6 +397,342 @@ var _ interface {
 	ErrorName() string
 } = GetInfoResponseValidationError{}
 
+// Validate checks the field values on GetCapabilitiesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCapabilitiesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCapabilitiesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCapabilitiesResponseMultiError, or nil if none found.
+func (m *GetCapabilitiesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCapabilitiesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for VaultBackend
+
+	// no validation rules for PasswordRotation
+
+	// no validation rules for Totp
+
+	// no validation rules for ImportMaxBytes
+
+	// no validation rules for ImportMaxItems
+
+	// no validation rules for BackupMaxBytes
+
+	// no validation rules for BackupMaxEntities
+
+	// no validation rules for OpenapiSha256
+
+	// no validation rules for DescriptorSha256
+
+	// no validation rules for PasswordMaxBytes
+
+	if len(errors) > 0 {
+		return GetCapabilitiesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCapabilitiesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCapabilitiesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCapabilitiesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCapabilitiesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCapabilitiesResponseMultiError) AllErrors() []error { return m }
+
+// GetCapabilitiesResponseValidationError is the validation error returned by
+// GetCapabilitiesResponse.Validate if the designated constraints aren't met.
+type GetCapabilitiesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCapabilitiesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCapabilitiesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCapabilitiesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCapabilitiesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCapabilitiesResponseValidationError) ErrorName() string {
+	return "GetCapabilitiesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCapabilitiesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCapabilitiesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCapabilitiesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCapabilitiesResponseValidationError{}
+
+// Validate checks the field values on GetApiSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetApiSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetApiSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetApiSchemaRequestMultiError, or nil if none found.
+func (m *GetApiSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetApiSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return GetApiSchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetApiSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetApiSchemaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetApiSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetApiSchemaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetApiSchemaRequestMultiError) AllErrors() []error { return m }
+
+// GetApiSchemaRequestValidationError is the validation error returned by
+// GetApiSchemaRequest.Validate if the designated constraints aren't met.
+type GetApiSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApiSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApiSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApiSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApiSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApiSchemaRequestValidationError) ErrorName() string {
+	return "GetApiSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApiSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApiSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApiSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApiSchemaRequestValidationError{}
+
+// Validate checks the field values on GetApiSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetApiSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetApiSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetApiSchemaResponseMultiError, or nil if none found.
+func (m *GetApiSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetApiSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for ContentType
+
+	// no validation rules for Sha256
+
+	if len(errors) > 0 {
+		return GetApiSchemaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetApiSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetApiSchemaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetApiSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetApiSchemaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetApiSchemaResponseMultiError) AllErrors() []error { return m }
+
+// GetApiSchemaResponseValidationError is the validation error returned by
+// GetApiSchemaResponse.Validate if the designated constraints aren't met.
+type GetApiSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApiSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApiSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApiSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApiSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApiSchemaResponseValidationError) ErrorName() string {
+	return "GetApiSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApiSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApiSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApiSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApiSchemaResponseValidationError{}
+
 // Validate checks the field values on CheckVaultResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -533,6 +869,18 @@ func (m *GetStatsRequest) validate(all bool) error {
 		// no validation rules for TenantId
 	}
 
+	if m.Days != nil {
+		// no validation rules for Days
+	}
+
+	if m.TopLimit != nil {
+		// no validation rules for TopLimit
+	}
+
+	if m.RotationWindowDays != nil {
+		// no validation rules for RotationWindowDays
+	}
+
 	if len(errors) > 0 {
 		return GetStatsRequestMultiError(errors)
 	}
@@ -1001,9 +1349,145 @@ func (m *GetStatsResponse) validate(all bool) error {
 
 	// no validation rules for AvgVersionsPerSecret
 
-	if len(errors) > 0 {
-		return GetStatsResponseMultiError(errors)
-	}
+	for idx, item := range m.GetSecretsCreatedPerDay() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetStatsResponseValidationError{
+						field:  fmt.Sprintf("SecretsCreatedPerDay[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetStatsResponseValidationError{
+						field:  fmt.Sprintf("SecretsCreatedPerDay[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetStatsResponseValidationError{
+					field:  fmt.Sprintf("SecretsCreatedPerDay[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPasswordReadsPerDay() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetStatsResponseValidationError{
+						field:  fmt.Sprintf("PasswordReadsPerDay[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetStatsResponseValidationError{
+						field:  fmt.Sprintf("PasswordReadsPerDay[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetStatsResponseValidationError{
+					field:  fmt.Sprintf("PasswordReadsPerDay[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTopAccessedSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetStatsResponseValidationError{
+						field:  fmt.Sprintf("TopAccessedSecrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetStatsResponseValidationError{
+						field:  fmt.Sprintf("TopAccessedSecrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetStatsResponseValidationError{
+					field:  fmt.Sprintf("TopAccessedSecrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSecretsDueForRotation() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetStatsResponseValidationError{
+						field:  fmt.Sprintf("SecretsDueForRotation[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetStatsResponseValidationError{
+						field:  fmt.Sprintf("SecretsDueForRotation[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetStatsResponseValidationError{
+					field:  fmt.Sprintf("SecretsDueForRotation[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetStatsResponseMultiError(errors)
+	}
 
 	return nil
 }
@@ -1078,3 +1562,1483 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetStatsResponseValidationError{}
+
+// Validate checks the field values on DailyCount with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DailyCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DailyCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DailyCountMultiError, or
+// nil if none found.
+func (m *DailyCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DailyCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return DailyCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// DailyCountMultiError is an error wrapping multiple validation errors
+// returned by DailyCount.ValidateAll() if the designated constraints aren't met.
+type DailyCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DailyCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DailyCountMultiError) AllErrors() []error { return m }
+
+// DailyCountValidationError is the validation error returned by
+// DailyCount.Validate if the designated constraints aren't met.
+type DailyCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DailyCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DailyCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DailyCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DailyCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DailyCountValidationError) ErrorName() string { return "DailyCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DailyCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDailyCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DailyCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DailyCountValidationError{}
+
+// Validate checks the field values on SecretAccessCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SecretAccessCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretAccessCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecretAccessCountMultiError, or nil if none found.
+func (m *SecretAccessCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretAccessCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	// no validation rules for Name
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return SecretAccessCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretAccessCountMultiError is an error wrapping multiple validation errors
+// returned by SecretAccessCount.ValidateAll() if the designated constraints
+// aren't met.
+type SecretAccessCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretAccessCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretAccessCountMultiError) AllErrors() []error { return m }
+
+// SecretAccessCountValidationError is the validation error returned by
+// SecretAccessCount.Validate if the designated constraints aren't met.
+type SecretAccessCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretAccessCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretAccessCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretAccessCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretAccessCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretAccessCountValidationError) ErrorName() string {
+	return "SecretAccessCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SecretAccessCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretAccessCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretAccessCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretAccessCountValidationError{}
+
+// Validate checks the field values on SecretRotationDue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SecretRotationDue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretRotationDue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecretRotationDueMultiError, or nil if none found.
+func (m *SecretRotationDue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretRotationDue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretRotationDueValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretRotationDueValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretRotationDueValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.FolderId != nil {
+		// no validation rules for FolderId
+	}
+
+	if len(errors) > 0 {
+		return SecretRotationDueMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretRotationDueMultiError is an error wrapping multiple validation errors
+// returned by SecretRotationDue.ValidateAll() if the designated constraints
+// aren't met.
+type SecretRotationDueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretRotationDueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretRotationDueMultiError) AllErrors() []error { return m }
+
+// SecretRotationDueValidationError is the validation error returned by
+// SecretRotationDue.Validate if the designated constraints aren't met.
+type SecretRotationDueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretRotationDueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretRotationDueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretRotationDueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretRotationDueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretRotationDueValidationError) ErrorName() string {
+	return "SecretRotationDueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SecretRotationDueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretRotationDue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretRotationDueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretRotationDueValidationError{}
+
+// Validate checks the field values on GetStaleSecretsReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStaleSecretsReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStaleSecretsReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStaleSecretsReportRequestMultiError, or nil if none found.
+func (m *GetStaleSecretsReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStaleSecretsReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.PasswordAgeDays != nil {
+		// no validation rules for PasswordAgeDays
+	}
+
+	if m.UnreadDays != nil {
+		// no validation rules for UnreadDays
+	}
+
+	if len(errors) > 0 {
+		return GetStaleSecretsReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStaleSecretsReportRequestMultiError is an error wrapping multiple
+// validation errors returned by GetStaleSecretsReportRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetStaleSecretsReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStaleSecretsReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStaleSecretsReportRequestMultiError) AllErrors() []error { return m }
+
+// GetStaleSecretsReportRequestValidationError is the validation error returned
+// by GetStaleSecretsReportRequest.Validate if the designated constraints
+// aren't met.
+type GetStaleSecretsReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStaleSecretsReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStaleSecretsReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStaleSecretsReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStaleSecretsReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStaleSecretsReportRequestValidationError) ErrorName() string {
+	return "GetStaleSecretsReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStaleSecretsReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStaleSecretsReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStaleSecretsReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStaleSecretsReportRequestValidationError{}
+
+// Validate checks the field values on GetStaleSecretsReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStaleSecretsReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStaleSecretsReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetStaleSecretsReportResponseMultiError, or nil if none found.
+func (m *GetStaleSecretsReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStaleSecretsReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	// no validation rules for PasswordStaleCount
+
+	// no validation rules for UnreadCount
+
+	for idx, item := range m.GetFolders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetStaleSecretsReportResponseValidationError{
+						field:  fmt.Sprintf("Folders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetStaleSecretsReportResponseValidationError{
+						field:  fmt.Sprintf("Folders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetStaleSecretsReportResponseValidationError{
+					field:  fmt.Sprintf("Folders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetOwners() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetStaleSecretsReportResponseValidationError{
+						field:  fmt.Sprintf("Owners[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetStaleSecretsReportResponseValidationError{
+						field:  fmt.Sprintf("Owners[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetStaleSecretsReportResponseValidationError{
+					field:  fmt.Sprintf("Owners[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetStaleSecretsReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStaleSecretsReportResponseMultiError is an error wrapping multiple
+// validation errors returned by GetStaleSecretsReportResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetStaleSecretsReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStaleSecretsReportResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStaleSecretsReportResponseMultiError) AllErrors() []error { return m }
+
+// GetStaleSecretsReportResponseValidationError is the validation error
+// returned by GetStaleSecretsReportResponse.Validate if the designated
+// constraints aren't met.
+type GetStaleSecretsReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStaleSecretsReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStaleSecretsReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStaleSecretsReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStaleSecretsReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStaleSecretsReportResponseValidationError) ErrorName() string {
+	return "GetStaleSecretsReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStaleSecretsReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStaleSecretsReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStaleSecretsReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStaleSecretsReportResponseValidationError{}
+
+// Validate checks the field values on StaleSecret with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StaleSecret) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StaleSecret with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StaleSecretMultiError, or
+// nil if none found.
+func (m *StaleSecret) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StaleSecret) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretId
+
+	// no validation rules for Name
+
+	// no validation rules for PasswordStale
+
+	// no validation rules for Unread
+
+	if m.PasswordChangedTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetPasswordChangedTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StaleSecretValidationError{
+						field:  "PasswordChangedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StaleSecretValidationError{
+						field:  "PasswordChangedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPasswordChangedTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StaleSecretValidationError{
+					field:  "PasswordChangedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.LastAccessedTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastAccessedTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StaleSecretValidationError{
+						field:  "LastAccessedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StaleSecretValidationError{
+						field:  "LastAccessedTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastAccessedTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StaleSecretValidationError{
+					field:  "LastAccessedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StaleSecretMultiError(errors)
+	}
+
+	return nil
+}
+
+// StaleSecretMultiError is an error wrapping multiple validation errors
+// returned by StaleSecret.ValidateAll() if the designated constraints aren't met.
+type StaleSecretMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StaleSecretMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StaleSecretMultiError) AllErrors() []error { return m }
+
+// StaleSecretValidationError is the validation error returned by
+// StaleSecret.Validate if the designated constraints aren't met.
+type StaleSecretValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StaleSecretValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StaleSecretValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StaleSecretValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StaleSecretValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StaleSecretValidationError) ErrorName() string { return "StaleSecretValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StaleSecretValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStaleSecret.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StaleSecretValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StaleSecretValidationError{}
+
+// Validate checks the field values on StaleSecretFolderGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StaleSecretFolderGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StaleSecretFolderGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StaleSecretFolderGroupMultiError, or nil if none found.
+func (m *StaleSecretFolderGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StaleSecretFolderGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StaleSecretFolderGroupValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StaleSecretFolderGroupValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StaleSecretFolderGroupValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.FolderId != nil {
+		// no validation rules for FolderId
+	}
+
+	if len(errors) > 0 {
+		return StaleSecretFolderGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// StaleSecretFolderGroupMultiError is an error wrapping multiple validation
+// errors returned by StaleSecretFolderGroup.ValidateAll() if the designated
+// constraints aren't met.
+type StaleSecretFolderGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StaleSecretFolderGroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StaleSecretFolderGroupMultiError) AllErrors() []error { return m }
+
+// StaleSecretFolderGroupValidationError is the validation error returned by
+// StaleSecretFolderGroup.Validate if the designated constraints aren't met.
+type StaleSecretFolderGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StaleSecretFolderGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StaleSecretFolderGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StaleSecretFolderGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StaleSecretFolderGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StaleSecretFolderGroupValidationError) ErrorName() string {
+	return "StaleSecretFolderGroupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StaleSecretFolderGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStaleSecretFolderGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StaleSecretFolderGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StaleSecretFolderGroupValidationError{}
+
+// Validate checks the field values on StaleSecretOwnerGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StaleSecretOwnerGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StaleSecretOwnerGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StaleSecretOwnerGroupMultiError, or nil if none found.
+func (m *StaleSecretOwnerGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StaleSecretOwnerGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OwnerId
+
+	if len(errors) > 0 {
+		return StaleSecretOwnerGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// StaleSecretOwnerGroupMultiError is an error wrapping multiple validation
+// errors returned by StaleSecretOwnerGroup.ValidateAll() if the designated
+// constraints aren't met.
+type StaleSecretOwnerGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StaleSecretOwnerGroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StaleSecretOwnerGroupMultiError) AllErrors() []error { return m }
+
+// StaleSecretOwnerGroupValidationError is the validation error returned by
+// StaleSecretOwnerGroup.Validate if the designated constraints aren't met.
+type StaleSecretOwnerGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StaleSecretOwnerGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StaleSecretOwnerGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StaleSecretOwnerGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StaleSecretOwnerGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StaleSecretOwnerGroupValidationError) ErrorName() string {
+	return "StaleSecretOwnerGroupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StaleSecretOwnerGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStaleSecretOwnerGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StaleSecretOwnerGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StaleSecretOwnerGroupValidationError{}
+
+// Validate checks the field values on ListTenantUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantUsageRequestMultiError, or nil if none found.
+func (m *ListTenantUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return ListTenantUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTenantUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTenantUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantUsageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantUsageRequestMultiError) AllErrors() []error { return m }
+
+// ListTenantUsageRequestValidationError is the validation error returned by
+// ListTenantUsageRequest.Validate if the designated constraints aren't met.
+type ListTenantUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantUsageRequestValidationError) ErrorName() string {
+	return "ListTenantUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantUsageRequestValidationError{}
+
+// Validate checks the field values on TenantUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantUsageMultiError, or
+// nil if none found.
+func (m *TenantUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for TotalSecrets
+
+	// no validation rules for ActiveSecrets
+
+	// no validation rules for ArchivedSecrets
+
+	// no validation rules for DeletedSecrets
+
+	// no validation rules for TotalFolders
+
+	// no validation rules for TotalVersions
+
+	// no validation rules for EstimatedVaultBytes
+
+	if m.LastActivityTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastActivityTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TenantUsageValidationError{
+						field:  "LastActivityTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TenantUsageValidationError{
+						field:  "LastActivityTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastActivityTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TenantUsageValidationError{
+					field:  "LastActivityTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TenantUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantUsageMultiError is an error wrapping multiple validation errors
+// returned by TenantUsage.ValidateAll() if the designated constraints aren't met.
+type TenantUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantUsageMultiError) AllErrors() []error { return m }
+
+// TenantUsageValidationError is the validation error returned by
+// TenantUsage.Validate if the designated constraints aren't met.
+type TenantUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantUsageValidationError) ErrorName() string { return "TenantUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantUsageValidationError{}
+
+// Validate checks the field values on ListTenantUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantUsageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantUsageResponseMultiError, or nil if none found.
+func (m *ListTenantUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTenants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTenantUsageResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTenantUsageResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTenantUsageResponseValidationError{
+					field:  fmt.Sprintf("Tenants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListTenantUsageResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListTenantUsageResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListTenantUsageResponseValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListTenantUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTenantUsageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTenantUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantUsageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantUsageResponseMultiError) AllErrors() []error { return m }
+
+// ListTenantUsageResponseValidationError is the validation error returned by
+// ListTenantUsageResponse.Validate if the designated constraints aren't met.
+type ListTenantUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantUsageResponseValidationError) ErrorName() string {
+	return "ListTenantUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantUsageResponseValidationError{}
@@ -20,11 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WardenSystemService_Health_FullMethodName            = "/warden.service.v1.WardenSystemService/Health"
-	WardenSystemService_GetInfo_FullMethodName           = "/warden.service.v1.WardenSystemService/GetInfo"
-	WardenSystemService_CheckVault_FullMethodName        = "/warden.service.v1.WardenSystemService/CheckVault"
-	WardenSystemService_GetStats_FullMethodName          = "/warden.service.v1.WardenSystemService/GetStats"
-	WardenSystemService_CreateShareSecret_FullMethodName = "/warden.service.v1.WardenSystemService/CreateShareSecret"
+	WardenSystemService_Health_FullMethodName                = "/warden.service.v1.WardenSystemService/Health"
+	WardenSystemService_GetInfo_FullMethodName               = "/warden.service.v1.WardenSystemService/GetInfo"
+	WardenSystemService_GetCapabilities_FullMethodName       = "/warden.service.v1.WardenSystemService/GetCapabilities"
+	WardenSystemService_GetApiSchema_FullMethodName          = "/warden.service.v1.WardenSystemService/GetApiSchema"
+	WardenSystemService_CheckVault_FullMethodName            = "/warden.service.v1.WardenSystemService/CheckVault"
+	WardenSystemService_GetStats_FullMethodName              = "/warden.service.v1.WardenSystemService/GetStats"
+	WardenSystemService_ListTenantUsage_FullMethodName       = "/warden.service.v1.WardenSystemService/ListTenantUsage"
+	WardenSystemService_GetStaleSecretsReport_FullMethodName = "/warden.service.v1.WardenSystemService/GetStaleSecretsReport"
+	WardenSystemService_CreateShareSecret_FullMethodName     = "/warden.service.v1.WardenSystemService/CreateShareSecret"
 )
 
 // WardenSystemServiceClient is the client API for WardenSystemService service.
@@ -37,10 +41,19 @@ type WardenSystemServiceClient interface {
 	Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error)
 	// Get service info
 	GetInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// Features enabled in this deployment, for clients adapting to the server
+	GetCapabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error)
+	// Get the OpenAPI document or protobuf descriptor embedded in the server
+	GetApiSchema(ctx context.Context, in *GetApiSchemaRequest, opts ...grpc.CallOption) (*GetApiSchemaResponse, error)
 	// Check Vault connectivity
 	CheckVault(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CheckVaultResponse, error)
 	// Get statistics for dashboard
 	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	// Per-tenant resource usage and last activity (platform admin only)
+	ListTenantUsage(ctx context.Context, in *ListTenantUsageRequest, opts ...grpc.CallOption) (*ListTenantUsageResponse, error)
+	// Active secrets whose password was not changed or not read for too long,
+	// grouped by folder and owner, for rotation and clean-up campaigns
+	GetStaleSecretsReport(ctx context.Context, in *GetStaleSecretsReportRequest, opts ...grpc.CallOption) (*GetStaleSecretsReportResponse, error)
 	// Create a share link for a secret (proxied to sharing module)
 	CreateShareSecret(ctx context.Context, in *CreateShareSecretRequest, opts ...grpc.CallOption) (*CreateShareSecretResponse, error)
 }
@@ -73,6 +86,26 @@ func (c *wardenSystemServiceClient) GetInfo(ctx context.Context, in *emptypb.Emp
 	return out, nil
 }
 
+func (c *wardenSystemServiceClient) GetCapabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, WardenSystemService_GetCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSystemServiceClient) GetApiSchema(ctx context.Context, in *GetApiSchemaRequest, opts ...grpc.CallOption) (*GetApiSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApiSchemaResponse)
+	err := c.cc.Invoke(ctx, WardenSystemService_GetApiSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *wardenSystemServiceClient) CheckVault(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CheckVaultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckVaultResponse)
@@ -93,6 +126,26 @@ func (c *wardenSystemServiceClient) GetStats(ctx context.Context, in *GetStatsRe
 	return out, nil
 }
 
+func (c *wardenSystemServiceClient) ListTenantUsage(ctx context.Context, in *ListTenantUsageRequest, opts ...grpc.CallOption) (*ListTenantUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantUsageResponse)
+	err := c.cc.Invoke(ctx, WardenSystemService_ListTenantUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenSystemServiceClient) GetStaleSecretsReport(ctx context.Context, in *GetStaleSecretsReportRequest, opts ...grpc.CallOption) (*GetStaleSecretsReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStaleSecretsReportResponse)
+	err := c.cc.Invoke(ctx, WardenSystemService_GetStaleSecretsReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *wardenSystemServiceClient) CreateShareSecret(ctx context.Context, in *CreateShareSecretRequest, opts ...grpc.CallOption) (*CreateShareSecretResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateShareSecretResponse)
@@ -113,10 +166,19 @@ type WardenSystemServiceServer interface {
 	Health(context.Context, *emptypb.Empty) (*HealthResponse, error)
 	// Get service info
 	GetInfo(context.Context, *emptypb.Empty) (*GetInfoResponse, error)
+	// Features enabled in this deployment, for clients adapting to the server
+	GetCapabilities(context.Context, *emptypb.Empty) (*GetCapabilitiesResponse, error)
+	// Get the OpenAPI document or protobuf descriptor embedded in the server
+	GetApiSchema(context.Context, *GetApiSchemaRequest) (*GetApiSchemaResponse, error)
 	// Check Vault connectivity
 	CheckVault(context.Context, *emptypb.Empty) (*CheckVaultResponse, error)
 	// Get statistics for dashboard
 	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	// Per-tenant resource usage and last activity (platform admin only)
+	ListTenantUsage(context.Context, *ListTenantUsageRequest) (*ListTenantUsageResponse, error)
+	// Active secrets whose password was not changed or not read for too long,
+	// grouped by folder and owner, for rotation and clean-up campaigns
+	GetStaleSecretsReport(context.Context, *GetStaleSecretsReportRequest) (*GetStaleSecretsReportResponse, error)
 	// Create a share link for a secret (proxied to sharing module)
 	CreateShareSecret(context.Context, *CreateShareSecretRequest) (*CreateShareSecretResponse, error)
 	mustEmbedUnimplementedWardenSystemServiceServer()
@@ -135,12 +197,24 @@ func (UnimplementedWardenSystemServiceServer) Health(context.Context, *emptypb.E
 func (UnimplementedWardenSystemServiceServer) GetInfo(context.Context, *emptypb.Empty) (*GetInfoResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetInfo not implemented")
 }
+func (UnimplementedWardenSystemServiceServer) GetCapabilities(context.Context, *emptypb.Empty) (*GetCapabilitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (UnimplementedWardenSystemServiceServer) GetApiSchema(context.Context, *GetApiSchemaRequest) (*GetApiSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetApiSchema not implemented")
+}
 func (UnimplementedWardenSystemServiceServer) CheckVault(context.Context, *emptypb.Empty) (*CheckVaultResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CheckVault not implemented")
 }
 func (UnimplementedWardenSystemServiceServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetStats not implemented")
 }
+func (UnimplementedWardenSystemServiceServer) ListTenantUsage(context.Context, *ListTenantUsageRequest) (*ListTenantUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTenantUsage not implemented")
+}
+func (UnimplementedWardenSystemServiceServer) GetStaleSecretsReport(context.Context, *GetStaleSecretsReportRequest) (*GetStaleSecretsReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStaleSecretsReport not implemented")
+}
 func (UnimplementedWardenSystemServiceServer) CreateShareSecret(context.Context, *CreateShareSecretRequest) (*CreateShareSecretResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateShareSecret not implemented")
 }
@@ -201,6 +275,42 @@ func _WardenSystemService_GetInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WardenSystemService_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSystemServiceServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSystemService_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSystemServiceServer).GetCapabilities(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSystemService_GetApiSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSystemServiceServer).GetApiSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSystemService_GetApiSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSystemServiceServer).GetApiSchema(ctx, req.(*GetApiSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WardenSystemService_CheckVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -237,6 +347,42 @@ func _WardenSystemService_GetStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WardenSystemService_ListTenantUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSystemServiceServer).ListTenantUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSystemService_ListTenantUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSystemServiceServer).ListTenantUsage(ctx, req.(*ListTenantUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenSystemService_GetStaleSecretsReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStaleSecretsReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenSystemServiceServer).GetStaleSecretsReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenSystemService_GetStaleSecretsReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenSystemServiceServer).GetStaleSecretsReport(ctx, req.(*GetStaleSecretsReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WardenSystemService_CreateShareSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateShareSecretRequest)
 	if err := dec(in); err != nil {
@@ -270,6 +416,14 @@ var WardenSystemService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInfo",
 			Handler:    _WardenSystemService_GetInfo_Handler,
 		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _WardenSystemService_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "GetApiSchema",
+			Handler:    _WardenSystemService_GetApiSchema_Handler,
+		},
 		{
 			MethodName: "CheckVault",
 			Handler:    _WardenSystemService_CheckVault_Handler,
@@ -278,6 +432,14 @@ var WardenSystemService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStats",
 			Handler:    _WardenSystemService_GetStats_Handler,
 		},
+		{
+			MethodName: "ListTenantUsage",
+			Handler:    _WardenSystemService_ListTenantUsage_Handler,
+		},
+		{
+			MethodName: "GetStaleSecretsReport",
+			Handler:    _WardenSystemService_GetStaleSecretsReport_Handler,
+		},
 		{
 			MethodName: "CreateShareSecret",
 			Handler:    _WardenSystemService_CreateShareSecret_Handler,
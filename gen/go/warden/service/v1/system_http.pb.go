@@ -22,29 +22,46 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationWardenSystemServiceCheckVault = "/warden.service.v1.WardenSystemService/CheckVault"
 const OperationWardenSystemServiceCreateShareSecret = "/warden.service.v1.WardenSystemService/CreateShareSecret"
+const OperationWardenSystemServiceGetApiSchema = "/warden.service.v1.WardenSystemService/GetApiSchema"
+const OperationWardenSystemServiceGetCapabilities = "/warden.service.v1.WardenSystemService/GetCapabilities"
 const OperationWardenSystemServiceGetInfo = "/warden.service.v1.WardenSystemService/GetInfo"
+const OperationWardenSystemServiceGetStaleSecretsReport = "/warden.service.v1.WardenSystemService/GetStaleSecretsReport"
 const OperationWardenSystemServiceGetStats = "/warden.service.v1.WardenSystemService/GetStats"
 const OperationWardenSystemServiceHealth = "/warden.service.v1.WardenSystemService/Health"
+const OperationWardenSystemServiceListTenantUsage = "/warden.service.v1.WardenSystemService/ListTenantUsage"
 
 type WardenSystemServiceHTTPServer interface {
 	// CheckVault Check Vault connectivity
 	CheckVault(context.Context, *emptypb.Empty) (*CheckVaultResponse, error)
 	// CreateShareSecret Create a share link for a secret (proxied to sharing module)
 	CreateShareSecret(context.Context, *CreateShareSecretRequest) (*CreateShareSecretResponse, error)
+	// GetApiSchema Get the OpenAPI document or protobuf descriptor embedded in the server
+	GetApiSchema(context.Context, *GetApiSchemaRequest) (*GetApiSchemaResponse, error)
+	// GetCapabilities Features enabled in this deployment, for clients adapting to the server
+	GetCapabilities(context.Context, *emptypb.Empty) (*GetCapabilitiesResponse, error)
 	// GetInfo Get service info
 	GetInfo(context.Context, *emptypb.Empty) (*GetInfoResponse, error)
+	// GetStaleSecretsReport Active secrets whose password was not changed or not read for too long,
+	// grouped by folder and owner, for rotation and clean-up campaigns
+	GetStaleSecretsReport(context.Context, *GetStaleSecretsReportRequest) (*GetStaleSecretsReportResponse, error)
 	// GetStats Get statistics for dashboard
 	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
 	// Health Health check
 	Health(context.Context, *emptypb.Empty) (*HealthResponse, error)
+	// ListTenantUsage Per-tenant resource usage and last activity (platform admin only)
+	ListTenantUsage(context.Context, *ListTenantUsageRequest) (*ListTenantUsageResponse, error)
 }
 
 func RegisterWardenSystemServiceHTTPServer(s *http.Server, srv WardenSystemServiceHTTPServer) {
 	r := s.Route("/")
 	r.GET("/v1/health", _WardenSystemService_Health0_HTTP_Handler(srv))
 	r.GET("/v1/info", _WardenSystemService_GetInfo0_HTTP_Handler(srv))
+	r.GET("/v1/capabilities", _WardenSystemService_GetCapabilities0_HTTP_Handler(srv))
+	r.GET("/v1/capabilities/schema", _WardenSystemService_GetApiSchema0_HTTP_Handler(srv))
 	r.GET("/v1/vault/check", _WardenSystemService_CheckVault0_HTTP_Handler(srv))
 	r.GET("/v1/stats", _WardenSystemService_GetStats0_HTTP_Handler(srv))
+	r.GET("/v1/stats/tenants", _WardenSystemService_ListTenantUsage0_HTTP_Handler(srv))
+	r.GET("/v1/stats/stale-secrets", _WardenSystemService_GetStaleSecretsReport0_HTTP_Handler(srv))
 	r.POST("/v1/shares", _WardenSystemService_CreateShareSecret0_HTTP_Handler(srv))
 }
 
@@ -86,6 +103,44 @@ func _WardenSystemService_GetInfo0_HTTP_Handler(srv WardenSystemServiceHTTPServe
 	}
 }
 
+func _WardenSystemService_GetCapabilities0_HTTP_Handler(srv WardenSystemServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSystemServiceGetCapabilities)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCapabilities(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCapabilitiesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenSystemService_GetApiSchema0_HTTP_Handler(srv WardenSystemServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetApiSchemaRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSystemServiceGetApiSchema)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetApiSchema(ctx, req.(*GetApiSchemaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetApiSchemaResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _WardenSystemService_CheckVault0_HTTP_Handler(srv WardenSystemServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in emptypb.Empty
@@ -124,6 +179,44 @@ func _WardenSystemService_GetStats0_HTTP_Handler(srv WardenSystemServiceHTTPServ
 	}
 }
 
+func _WardenSystemService_ListTenantUsage0_HTTP_Handler(srv WardenSystemServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTenantUsageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSystemServiceListTenantUsage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantUsage(ctx, req.(*ListTenantUsageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantUsageResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenSystemService_GetStaleSecretsReport0_HTTP_Handler(srv WardenSystemServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetStaleSecretsReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenSystemServiceGetStaleSecretsReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetStaleSecretsReport(ctx, req.(*GetStaleSecretsReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetStaleSecretsReportResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _WardenSystemService_CreateShareSecret0_HTTP_Handler(srv WardenSystemServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreateShareSecretRequest
@@ -151,12 +244,21 @@ type WardenSystemServiceHTTPClient interface {
 	CheckVault(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *CheckVaultResponse, err error)
 	// CreateShareSecret Create a share link for a secret (proxied to sharing module)
 	CreateShareSecret(ctx context.Context, req *CreateShareSecretRequest, opts ...http.CallOption) (rsp *CreateShareSecretResponse, err error)
+	// GetApiSchema Get the OpenAPI document or protobuf descriptor embedded in the server
+	GetApiSchema(ctx context.Context, req *GetApiSchemaRequest, opts ...http.CallOption) (rsp *GetApiSchemaResponse, err error)
+	// GetCapabilities Features enabled in this deployment, for clients adapting to the server
+	GetCapabilities(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetCapabilitiesResponse, err error)
 	// GetInfo Get service info
 	GetInfo(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetInfoResponse, err error)
+	// GetStaleSecretsReport Active secrets whose password was not changed or not read for too long,
+	// grouped by folder and owner, for rotation and clean-up campaigns
+	GetStaleSecretsReport(ctx context.Context, req *GetStaleSecretsReportRequest, opts ...http.CallOption) (rsp *GetStaleSecretsReportResponse, err error)
 	// GetStats Get statistics for dashboard
 	GetStats(ctx context.Context, req *GetStatsRequest, opts ...http.CallOption) (rsp *GetStatsResponse, err error)
 	// Health Health check
 	Health(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *HealthResponse, err error)
+	// ListTenantUsage Per-tenant resource usage and last activity (platform admin only)
+	ListTenantUsage(ctx context.Context, req *ListTenantUsageRequest, opts ...http.CallOption) (rsp *ListTenantUsageResponse, err error)
 }
 
 type WardenSystemServiceHTTPClientImpl struct {
@@ -195,6 +297,34 @@ func (c *WardenSystemServiceHTTPClientImpl) CreateShareSecret(ctx context.Contex
 	return &out, nil
 }
 
+// GetApiSchema Get the OpenAPI document or protobuf descriptor embedded in the server
+func (c *WardenSystemServiceHTTPClientImpl) GetApiSchema(ctx context.Context, in *GetApiSchemaRequest, opts ...http.CallOption) (*GetApiSchemaResponse, error) {
+	var out GetApiSchemaResponse
+	pattern := "/v1/capabilities/schema"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSystemServiceGetApiSchema))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCapabilities Features enabled in this deployment, for clients adapting to the server
+func (c *WardenSystemServiceHTTPClientImpl) GetCapabilities(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetCapabilitiesResponse, error) {
+	var out GetCapabilitiesResponse
+	pattern := "/v1/capabilities"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSystemServiceGetCapabilities))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetInfo Get service info
 func (c *WardenSystemServiceHTTPClientImpl) GetInfo(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetInfoResponse, error) {
 	var out GetInfoResponse
@@ -209,6 +339,21 @@ func (c *WardenSystemServiceHTTPClientImpl) GetInfo(ctx context.Context, in *emp
 	return &out, nil
 }
 
+// GetStaleSecretsReport Active secrets whose password was not changed or not read for too long,
+// grouped by folder and owner, for rotation and clean-up campaigns
+func (c *WardenSystemServiceHTTPClientImpl) GetStaleSecretsReport(ctx context.Context, in *GetStaleSecretsReportRequest, opts ...http.CallOption) (*GetStaleSecretsReportResponse, error) {
+	var out GetStaleSecretsReportResponse
+	pattern := "/v1/stats/stale-secrets"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSystemServiceGetStaleSecretsReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetStats Get statistics for dashboard
 func (c *WardenSystemServiceHTTPClientImpl) GetStats(ctx context.Context, in *GetStatsRequest, opts ...http.CallOption) (*GetStatsResponse, error) {
 	var out GetStatsResponse
@@ -236,3 +381,17 @@ func (c *WardenSystemServiceHTTPClientImpl) Health(ctx context.Context, in *empt
 	}
 	return &out, nil
 }
+
+// ListTenantUsage Per-tenant resource usage and last activity (platform admin only)
+func (c *WardenSystemServiceHTTPClientImpl) ListTenantUsage(ctx context.Context, in *ListTenantUsageRequest, opts ...http.CallOption) (*ListTenantUsageResponse, error) {
+	var out ListTenantUsageResponse
+	pattern := "/v1/stats/tenants"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenSystemServiceListTenantUsage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,790 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/tenant_transfer.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Folder of a subtree bundle
+type TransferFolder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Source folder ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Source parent ID (unset for the subtree root)
+	ParentId      *string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Name          string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferFolder) Reset() {
+	*x = TransferFolder{}
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferFolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferFolder) ProtoMessage() {}
+
+func (x *TransferFolder) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferFolder.ProtoReflect.Descriptor instead.
+func (*TransferFolder) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_tenant_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferFolder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransferFolder) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *TransferFolder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransferFolder) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Secret of a subtree bundle, including its current Vault data
+type TransferSecret struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Source secret ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Source folder ID
+	FolderId      string            `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Name          string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Username      string            `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	HostUrl       string            `protobuf:"bytes,5,opt,name=host_url,json=hostUrl,proto3" json:"host_url,omitempty"`
+	Description   string            `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Metadata      *structpb.Struct  `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Password      string            `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`
+	VaultMetadata map[string]string `protobuf:"bytes,9,rep,name=vault_metadata,json=vaultMetadata,proto3" json:"vault_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TotpUrl       string            `protobuf:"bytes,10,opt,name=totp_url,json=totpUrl,proto3" json:"totp_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferSecret) Reset() {
+	*x = TransferSecret{}
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferSecret) ProtoMessage() {}
+
+func (x *TransferSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferSecret.ProtoReflect.Descriptor instead.
+func (*TransferSecret) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_tenant_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferSecret) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransferSecret) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *TransferSecret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransferSecret) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TransferSecret) GetHostUrl() string {
+	if x != nil {
+		return x.HostUrl
+	}
+	return ""
+}
+
+func (x *TransferSecret) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TransferSecret) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TransferSecret) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *TransferSecret) GetVaultMetadata() map[string]string {
+	if x != nil {
+		return x.VaultMetadata
+	}
+	return nil
+}
+
+func (x *TransferSecret) GetTotpUrl() string {
+	if x != nil {
+		return x.TotpUrl
+	}
+	return ""
+}
+
+// Direct permission on a folder or secret of the bundle
+type TransferPermission struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// Source resource ID
+	ResourceId    string                 `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Relation      string                 `protobuf:"bytes,3,opt,name=relation,proto3" json:"relation,omitempty"`
+	SubjectType   string                 `protobuf:"bytes,4,opt,name=subject_type,json=subjectType,proto3" json:"subject_type,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,5,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferPermission) Reset() {
+	*x = TransferPermission{}
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPermission) ProtoMessage() {}
+
+func (x *TransferPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPermission.ProtoReflect.Descriptor instead.
+func (*TransferPermission) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_tenant_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransferPermission) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *TransferPermission) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *TransferPermission) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *TransferPermission) GetSubjectType() string {
+	if x != nil {
+		return x.SubjectType
+	}
+	return ""
+}
+
+func (x *TransferPermission) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *TransferPermission) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Self-contained copy of a folder subtree
+type FolderTreeBundle struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SourceTenantId uint32                 `protobuf:"varint,1,opt,name=source_tenant_id,json=sourceTenantId,proto3" json:"source_tenant_id,omitempty"`
+	// Folders, parents before children; the first one is the subtree root
+	Folders       []*TransferFolder     `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders,omitempty"`
+	Secrets       []*TransferSecret     `protobuf:"bytes,3,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Permissions   []*TransferPermission `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FolderTreeBundle) Reset() {
+	*x = FolderTreeBundle{}
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FolderTreeBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderTreeBundle) ProtoMessage() {}
+
+func (x *FolderTreeBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderTreeBundle.ProtoReflect.Descriptor instead.
+func (*FolderTreeBundle) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_tenant_transfer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FolderTreeBundle) GetSourceTenantId() uint32 {
+	if x != nil {
+		return x.SourceTenantId
+	}
+	return 0
+}
+
+func (x *FolderTreeBundle) GetFolders() []*TransferFolder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+func (x *FolderTreeBundle) GetSecrets() []*TransferSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *FolderTreeBundle) GetPermissions() []*TransferPermission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type MigrateFolderTreeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Root of the subtree to copy
+	FolderId string `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	// Tenant owning the subtree (defaults to the caller's tenant)
+	SourceTenantId *uint32 `protobuf:"varint,2,opt,name=source_tenant_id,json=sourceTenantId,proto3,oneof" json:"source_tenant_id,omitempty"`
+	// Tenant receiving the copy
+	TargetTenantId uint32 `protobuf:"varint,3,opt,name=target_tenant_id,json=targetTenantId,proto3" json:"target_tenant_id,omitempty"`
+	// Folder of the target tenant to copy into (null for root)
+	TargetParentFolderId *string `protobuf:"bytes,4,opt,name=target_parent_folder_id,json=targetParentFolderId,proto3,oneof" json:"target_parent_folder_id,omitempty"`
+	// Copy direct permissions, remapped to the new folder and secret IDs
+	IncludePermissions bool `protobuf:"varint,5,opt,name=include_permissions,json=includePermissions,proto3" json:"include_permissions,omitempty"`
+	// gRPC endpoint (host:port) of a remote Warden instance; must be listed in
+	// WARDEN_MIGRATION_ENDPOINTS. Unset migrates within this instance.
+	RemoteEndpoint *string `protobuf:"bytes,6,opt,name=remote_endpoint,json=remoteEndpoint,proto3,oneof" json:"remote_endpoint,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MigrateFolderTreeRequest) Reset() {
+	*x = MigrateFolderTreeRequest{}
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateFolderTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateFolderTreeRequest) ProtoMessage() {}
+
+func (x *MigrateFolderTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateFolderTreeRequest.ProtoReflect.Descriptor instead.
+func (*MigrateFolderTreeRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_tenant_transfer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MigrateFolderTreeRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *MigrateFolderTreeRequest) GetSourceTenantId() uint32 {
+	if x != nil && x.SourceTenantId != nil {
+		return *x.SourceTenantId
+	}
+	return 0
+}
+
+func (x *MigrateFolderTreeRequest) GetTargetTenantId() uint32 {
+	if x != nil {
+		return x.TargetTenantId
+	}
+	return 0
+}
+
+func (x *MigrateFolderTreeRequest) GetTargetParentFolderId() string {
+	if x != nil && x.TargetParentFolderId != nil {
+		return *x.TargetParentFolderId
+	}
+	return ""
+}
+
+func (x *MigrateFolderTreeRequest) GetIncludePermissions() bool {
+	if x != nil {
+		return x.IncludePermissions
+	}
+	return false
+}
+
+func (x *MigrateFolderTreeRequest) GetRemoteEndpoint() string {
+	if x != nil && x.RemoteEndpoint != nil {
+		return *x.RemoteEndpoint
+	}
+	return ""
+}
+
+type ImportFolderTreeRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Bundle               *FolderTreeBundle      `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	TargetTenantId       uint32                 `protobuf:"varint,2,opt,name=target_tenant_id,json=targetTenantId,proto3" json:"target_tenant_id,omitempty"`
+	TargetParentFolderId *string                `protobuf:"bytes,3,opt,name=target_parent_folder_id,json=targetParentFolderId,proto3,oneof" json:"target_parent_folder_id,omitempty"`
+	IncludePermissions   bool                   `protobuf:"varint,4,opt,name=include_permissions,json=includePermissions,proto3" json:"include_permissions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ImportFolderTreeRequest) Reset() {
+	*x = ImportFolderTreeRequest{}
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFolderTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFolderTreeRequest) ProtoMessage() {}
+
+func (x *ImportFolderTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFolderTreeRequest.ProtoReflect.Descriptor instead.
+func (*ImportFolderTreeRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_tenant_transfer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImportFolderTreeRequest) GetBundle() *FolderTreeBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ImportFolderTreeRequest) GetTargetTenantId() uint32 {
+	if x != nil {
+		return x.TargetTenantId
+	}
+	return 0
+}
+
+func (x *ImportFolderTreeRequest) GetTargetParentFolderId() string {
+	if x != nil && x.TargetParentFolderId != nil {
+		return *x.TargetParentFolderId
+	}
+	return ""
+}
+
+func (x *ImportFolderTreeRequest) GetIncludePermissions() bool {
+	if x != nil {
+		return x.IncludePermissions
+	}
+	return false
+}
+
+type MigrateFolderTreeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the copied subtree root in the target tenant
+	RootFolderId       string `protobuf:"bytes,1,opt,name=root_folder_id,json=rootFolderId,proto3" json:"root_folder_id,omitempty"`
+	FoldersCreated     int32  `protobuf:"varint,2,opt,name=folders_created,json=foldersCreated,proto3" json:"folders_created,omitempty"`
+	SecretsCreated     int32  `protobuf:"varint,3,opt,name=secrets_created,json=secretsCreated,proto3" json:"secrets_created,omitempty"`
+	SecretsFailed      int32  `protobuf:"varint,4,opt,name=secrets_failed,json=secretsFailed,proto3" json:"secrets_failed,omitempty"`
+	PermissionsCreated int32  `protobuf:"varint,5,opt,name=permissions_created,json=permissionsCreated,proto3" json:"permissions_created,omitempty"`
+	// Source ID -> target ID
+	FolderIdMapping map[string]string `protobuf:"bytes,6,rep,name=folder_id_mapping,json=folderIdMapping,proto3" json:"folder_id_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SecretIdMapping map[string]string `protobuf:"bytes,7,rep,name=secret_id_mapping,json=secretIdMapping,proto3" json:"secret_id_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Secrets and permissions that could not be copied
+	Errors        []string `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateFolderTreeResponse) Reset() {
+	*x = MigrateFolderTreeResponse{}
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateFolderTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateFolderTreeResponse) ProtoMessage() {}
+
+func (x *MigrateFolderTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_tenant_transfer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateFolderTreeResponse.ProtoReflect.Descriptor instead.
+func (*MigrateFolderTreeResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_tenant_transfer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MigrateFolderTreeResponse) GetRootFolderId() string {
+	if x != nil {
+		return x.RootFolderId
+	}
+	return ""
+}
+
+func (x *MigrateFolderTreeResponse) GetFoldersCreated() int32 {
+	if x != nil {
+		return x.FoldersCreated
+	}
+	return 0
+}
+
+func (x *MigrateFolderTreeResponse) GetSecretsCreated() int32 {
+	if x != nil {
+		return x.SecretsCreated
+	}
+	return 0
+}
+
+func (x *MigrateFolderTreeResponse) GetSecretsFailed() int32 {
+	if x != nil {
+		return x.SecretsFailed
+	}
+	return 0
+}
+
+func (x *MigrateFolderTreeResponse) GetPermissionsCreated() int32 {
+	if x != nil {
+		return x.PermissionsCreated
+	}
+	return 0
+}
+
+func (x *MigrateFolderTreeResponse) GetFolderIdMapping() map[string]string {
+	if x != nil {
+		return x.FolderIdMapping
+	}
+	return nil
+}
+
+func (x *MigrateFolderTreeResponse) GetSecretIdMapping() map[string]string {
+	if x != nil {
+		return x.SecretIdMapping
+	}
+	return nil
+}
+
+func (x *MigrateFolderTreeResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+var File_warden_service_v1_tenant_transfer_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_tenant_transfer_proto_rawDesc = "" +
+	"\n" +
+	"'warden/service/v1/tenant_transfer.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\"\x86\x01\n" +
+	"\x0eTransferFolder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\tparent_id\x18\x02 \x01(\tH\x00R\bparentId\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescriptionB\f\n" +
+	"\n" +
+	"_parent_id\"\xd9\x03\n" +
+	"\x0eTransferSecret\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tfolder_id\x18\x02 \x01(\tR\bfolderId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x19\n" +
+	"\bhost_url\x18\x05 \x01(\tR\ahostUrl\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x123\n" +
+	"\bmetadata\x18\a \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12%\n" +
+	"\bpassword\x18\b \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\bpassword\x12i\n" +
+	"\x0evault_metadata\x18\t \x03(\v24.warden.service.v1.TransferSecret.VaultMetadataEntryB\fڶ\x1a\x05\xa2\x01\x02\b\x01\x80\x01\x01R\rvaultMetadata\x12$\n" +
+	"\btotp_url\x18\n" +
+	" \x01(\tB\tڶ\x1a\x02z\x00\x80\x01\x01R\atotpUrl\x1a@\n" +
+	"\x12VaultMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x02\n" +
+	"\x12TransferPermission\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\x12\x1a\n" +
+	"\brelation\x18\x03 \x01(\tR\brelation\x12!\n" +
+	"\fsubject_type\x18\x04 \x01(\tR\vsubjectType\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x05 \x01(\tR\tsubjectId\x12>\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"\xff\x01\n" +
+	"\x10FolderTreeBundle\x12(\n" +
+	"\x10source_tenant_id\x18\x01 \x01(\rR\x0esourceTenantId\x12;\n" +
+	"\afolders\x18\x02 \x03(\v2!.warden.service.v1.TransferFolderR\afolders\x12;\n" +
+	"\asecrets\x18\x03 \x03(\v2!.warden.service.v1.TransferSecretR\asecrets\x12G\n" +
+	"\vpermissions\x18\x04 \x03(\v2%.warden.service.v1.TransferPermissionR\vpermissions\"\xbb\x03\n" +
+	"\x18MigrateFolderTreeRequest\x128\n" +
+	"\tfolder_id\x18\x01 \x01(\tB\x1b\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]*$R\bfolderId\x12-\n" +
+	"\x10source_tenant_id\x18\x02 \x01(\rH\x00R\x0esourceTenantId\x88\x01\x01\x121\n" +
+	"\x10target_tenant_id\x18\x03 \x01(\rB\a\xbaH\x04*\x02 \x00R\x0etargetTenantId\x12U\n" +
+	"\x17target_parent_folder_id\x18\x04 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x01R\x14targetParentFolderId\x88\x01\x01\x12/\n" +
+	"\x13include_permissions\x18\x05 \x01(\bR\x12includePermissions\x126\n" +
+	"\x0fremote_endpoint\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x02R\x0eremoteEndpoint\x88\x01\x01B\x13\n" +
+	"\x11_source_tenant_idB\x1a\n" +
+	"\x18_target_parent_folder_idB\x12\n" +
+	"\x10_remote_endpoint\"\xb5\x02\n" +
+	"\x17ImportFolderTreeRequest\x12C\n" +
+	"\x06bundle\x18\x01 \x01(\v2#.warden.service.v1.FolderTreeBundleB\x06\xbaH\x03\xc8\x01\x01R\x06bundle\x121\n" +
+	"\x10target_tenant_id\x18\x02 \x01(\rB\a\xbaH\x04*\x02 \x00R\x0etargetTenantId\x12U\n" +
+	"\x17target_parent_folder_id\x18\x03 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\x14targetParentFolderId\x88\x01\x01\x12/\n" +
+	"\x13include_permissions\x18\x04 \x01(\bR\x12includePermissionsB\x1a\n" +
+	"\x18_target_parent_folder_id\"\xe9\x04\n" +
+	"\x19MigrateFolderTreeResponse\x12$\n" +
+	"\x0eroot_folder_id\x18\x01 \x01(\tR\frootFolderId\x12'\n" +
+	"\x0ffolders_created\x18\x02 \x01(\x05R\x0efoldersCreated\x12'\n" +
+	"\x0fsecrets_created\x18\x03 \x01(\x05R\x0esecretsCreated\x12%\n" +
+	"\x0esecrets_failed\x18\x04 \x01(\x05R\rsecretsFailed\x12/\n" +
+	"\x13permissions_created\x18\x05 \x01(\x05R\x12permissionsCreated\x12m\n" +
+	"\x11folder_id_mapping\x18\x06 \x03(\v2A.warden.service.v1.MigrateFolderTreeResponse.FolderIdMappingEntryR\x0ffolderIdMapping\x12m\n" +
+	"\x11secret_id_mapping\x18\a \x03(\v2A.warden.service.v1.MigrateFolderTreeResponse.SecretIdMappingEntryR\x0fsecretIdMapping\x12\x16\n" +
+	"\x06errors\x18\b \x03(\tR\x06errors\x1aB\n" +
+	"\x14FolderIdMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aB\n" +
+	"\x14SecretIdMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xbe\x02\n" +
+	"\x1bWardenTenantTransferService\x12\x8f\x01\n" +
+	"\x11MigrateFolderTree\x12+.warden.service.v1.MigrateFolderTreeRequest\x1a,.warden.service.v1.MigrateFolderTreeResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/transfer/migrate\x12\x8c\x01\n" +
+	"\x10ImportFolderTree\x12*.warden.service.v1.ImportFolderTreeRequest\x1a,.warden.service.v1.MigrateFolderTreeResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/transfer/importB\xdb\x01\n" +
+	"\x15com.warden.service.v1B\x13TenantTransferProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_tenant_transfer_proto_rawDescOnce sync.Once
+	file_warden_service_v1_tenant_transfer_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_tenant_transfer_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_tenant_transfer_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_tenant_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_tenant_transfer_proto_rawDesc), len(file_warden_service_v1_tenant_transfer_proto_rawDesc)))
+	})
+	return file_warden_service_v1_tenant_transfer_proto_rawDescData
+}
+
+var file_warden_service_v1_tenant_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_warden_service_v1_tenant_transfer_proto_goTypes = []any{
+	(*TransferFolder)(nil),            // 0: warden.service.v1.TransferFolder
+	(*TransferSecret)(nil),            // 1: warden.service.v1.TransferSecret
+	(*TransferPermission)(nil),        // 2: warden.service.v1.TransferPermission
+	(*FolderTreeBundle)(nil),          // 3: warden.service.v1.FolderTreeBundle
+	(*MigrateFolderTreeRequest)(nil),  // 4: warden.service.v1.MigrateFolderTreeRequest
+	(*ImportFolderTreeRequest)(nil),   // 5: warden.service.v1.ImportFolderTreeRequest
+	(*MigrateFolderTreeResponse)(nil), // 6: warden.service.v1.MigrateFolderTreeResponse
+	nil,                               // 7: warden.service.v1.TransferSecret.VaultMetadataEntry
+	nil,                               // 8: warden.service.v1.MigrateFolderTreeResponse.FolderIdMappingEntry
+	nil,                               // 9: warden.service.v1.MigrateFolderTreeResponse.SecretIdMappingEntry
+	(*structpb.Struct)(nil),           // 10: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),     // 11: google.protobuf.Timestamp
+}
+var file_warden_service_v1_tenant_transfer_proto_depIdxs = []int32{
+	10, // 0: warden.service.v1.TransferSecret.metadata:type_name -> google.protobuf.Struct
+	7,  // 1: warden.service.v1.TransferSecret.vault_metadata:type_name -> warden.service.v1.TransferSecret.VaultMetadataEntry
+	11, // 2: warden.service.v1.TransferPermission.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: warden.service.v1.FolderTreeBundle.folders:type_name -> warden.service.v1.TransferFolder
+	1,  // 4: warden.service.v1.FolderTreeBundle.secrets:type_name -> warden.service.v1.TransferSecret
+	2,  // 5: warden.service.v1.FolderTreeBundle.permissions:type_name -> warden.service.v1.TransferPermission
+	3,  // 6: warden.service.v1.ImportFolderTreeRequest.bundle:type_name -> warden.service.v1.FolderTreeBundle
+	8,  // 7: warden.service.v1.MigrateFolderTreeResponse.folder_id_mapping:type_name -> warden.service.v1.MigrateFolderTreeResponse.FolderIdMappingEntry
+	9,  // 8: warden.service.v1.MigrateFolderTreeResponse.secret_id_mapping:type_name -> warden.service.v1.MigrateFolderTreeResponse.SecretIdMappingEntry
+	4,  // 9: warden.service.v1.WardenTenantTransferService.MigrateFolderTree:input_type -> warden.service.v1.MigrateFolderTreeRequest
+	5,  // 10: warden.service.v1.WardenTenantTransferService.ImportFolderTree:input_type -> warden.service.v1.ImportFolderTreeRequest
+	6,  // 11: warden.service.v1.WardenTenantTransferService.MigrateFolderTree:output_type -> warden.service.v1.MigrateFolderTreeResponse
+	6,  // 12: warden.service.v1.WardenTenantTransferService.ImportFolderTree:output_type -> warden.service.v1.MigrateFolderTreeResponse
+	11, // [11:13] is the sub-list for method output_type
+	9,  // [9:11] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_tenant_transfer_proto_init() }
+func file_warden_service_v1_tenant_transfer_proto_init() {
+	if File_warden_service_v1_tenant_transfer_proto != nil {
+		return
+	}
+	file_warden_service_v1_tenant_transfer_proto_msgTypes[0].OneofWrappers = []any{}
+	file_warden_service_v1_tenant_transfer_proto_msgTypes[2].OneofWrappers = []any{}
+	file_warden_service_v1_tenant_transfer_proto_msgTypes[4].OneofWrappers = []any{}
+	file_warden_service_v1_tenant_transfer_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_tenant_transfer_proto_rawDesc), len(file_warden_service_v1_tenant_transfer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_tenant_transfer_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_tenant_transfer_proto_depIdxs,
+		MessageInfos:      file_warden_service_v1_tenant_transfer_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_tenant_transfer_proto = out.File
+	file_warden_service_v1_tenant_transfer_proto_goTypes = nil
+	file_warden_service_v1_tenant_transfer_proto_depIdxs = nil
+}
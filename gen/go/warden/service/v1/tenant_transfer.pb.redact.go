@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/tenant_transfer.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ structpb.Struct
+	_ timestamppb.Timestamp
+	_ redact.FieldRules
+)
+
+// RegisterRedactedWardenTenantTransferServiceServer wraps the WardenTenantTransferServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenTenantTransferServiceServer(s grpc.ServiceRegistrar, srv WardenTenantTransferServiceServer, bypass redact.Bypass) {
+	RegisterWardenTenantTransferServiceServer(s, RedactedWardenTenantTransferServiceServer(srv, bypass))
+}
+
+func RedactedWardenTenantTransferServiceServer(srv WardenTenantTransferServiceServer, bypass redact.Bypass) WardenTenantTransferServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenTenantTransferServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenTenantTransferServiceServer struct {
+	UnsafeWardenTenantTransferServiceServer
+	srv    WardenTenantTransferServiceServer
+	bypass redact.Bypass
+}
+
+// MigrateFolderTree is the redacted wrapper for the actual WardenTenantTransferServiceServer.MigrateFolderTree method
+// Unary RPC
+func (s *redactedWardenTenantTransferServiceServer) MigrateFolderTree(ctx context.Context, in *MigrateFolderTreeRequest) (*MigrateFolderTreeResponse, error) {
+	res, err := s.srv.MigrateFolderTree(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ImportFolderTree is the redacted wrapper for the actual WardenTenantTransferServiceServer.ImportFolderTree method
+// Unary RPC
+func (s *redactedWardenTenantTransferServiceServer) ImportFolderTree(ctx context.Context, in *ImportFolderTreeRequest) (*MigrateFolderTreeResponse, error) {
+	res, err := s.srv.ImportFolderTree(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for TransferFolder
+func (x *TransferFolder) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: ParentId
+
+	// Safe field: Name
+
+	// Safe field: Description
+	return x.String()
+}
+
+// Redact method implementation for TransferSecret
+func (x *TransferSecret) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: FolderId
+
+	// Safe field: Name
+
+	// Safe field: Username
+
+	// Safe field: HostUrl
+
+	// Safe field: Description
+
+	// Safe field: Metadata
+
+	// Redacting field: Password
+	x.Password = ``
+
+	// Redacting field: VaultMetadata
+	x.VaultMetadata = map[string]string{}
+
+	// Redacting field: TotpUrl
+	x.TotpUrl = ``
+	return x.String()
+}
+
+// Redact method implementation for TransferPermission
+func (x *TransferPermission) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ResourceType
+
+	// Safe field: ResourceId
+
+	// Safe field: Relation
+
+	// Safe field: SubjectType
+
+	// Safe field: SubjectId
+
+	// Safe field: ExpiresAt
+	return x.String()
+}
+
+// Redact method implementation for FolderTreeBundle
+func (x *FolderTreeBundle) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SourceTenantId
+
+	// Safe field: Folders
+
+	// Safe field: Secrets
+
+	// Safe field: Permissions
+	return x.String()
+}
+
+// Redact method implementation for MigrateFolderTreeRequest
+func (x *MigrateFolderTreeRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FolderId
+
+	// Safe field: SourceTenantId
+
+	// Safe field: TargetTenantId
+
+	// Safe field: TargetParentFolderId
+
+	// Safe field: IncludePermissions
+
+	// Safe field: RemoteEndpoint
+	return x.String()
+}
+
+// Redact method implementation for ImportFolderTreeRequest
+func (x *ImportFolderTreeRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Bundle
+
+	// Safe field: TargetTenantId
+
+	// Safe field: TargetParentFolderId
+
+	// Safe field: IncludePermissions
+	return x.String()
+}
+
+// Redact method implementation for MigrateFolderTreeResponse
+func (x *MigrateFolderTreeResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: RootFolderId
+
+	// Safe field: FoldersCreated
+
+	// Safe field: SecretsCreated
+
+	// Safe field: SecretsFailed
+
+	// Safe field: PermissionsCreated
+
+	// Safe field: FolderIdMapping
+
+	// Safe field: SecretIdMapping
+
+	// Safe field: Errors
+	return x.String()
+}
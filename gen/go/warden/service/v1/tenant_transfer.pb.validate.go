@@ -0,0 +1,1017 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/tenant_transfer.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on TransferFolder with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransferFolder) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferFolder with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransferFolderMultiError,
+// or nil if none found.
+func (m *TransferFolder) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferFolder) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if m.ParentId != nil {
+		// no validation rules for ParentId
+	}
+
+	if len(errors) > 0 {
+		return TransferFolderMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferFolderMultiError is an error wrapping multiple validation errors
+// returned by TransferFolder.ValidateAll() if the designated constraints
+// aren't met.
+type TransferFolderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferFolderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferFolderMultiError) AllErrors() []error { return m }
+
+// TransferFolderValidationError is the validation error returned by
+// TransferFolder.Validate if the designated constraints aren't met.
+type TransferFolderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferFolderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferFolderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferFolderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferFolderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferFolderValidationError) ErrorName() string { return "TransferFolderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransferFolderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferFolder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferFolderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferFolderValidationError{}
+
+// Validate checks the field values on TransferSecret with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransferSecret) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferSecret with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransferSecretMultiError,
+// or nil if none found.
+func (m *TransferSecret) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferSecret) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FolderId
+
+	// no validation rules for Name
+
+	// no validation rules for Username
+
+	// no validation rules for HostUrl
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferSecretValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferSecretValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferSecretValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Password
+
+	// no validation rules for VaultMetadata
+
+	// no validation rules for TotpUrl
+
+	if len(errors) > 0 {
+		return TransferSecretMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferSecretMultiError is an error wrapping multiple validation errors
+// returned by TransferSecret.ValidateAll() if the designated constraints
+// aren't met.
+type TransferSecretMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferSecretMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferSecretMultiError) AllErrors() []error { return m }
+
+// TransferSecretValidationError is the validation error returned by
+// TransferSecret.Validate if the designated constraints aren't met.
+type TransferSecretValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferSecretValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferSecretValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferSecretValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferSecretValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferSecretValidationError) ErrorName() string { return "TransferSecretValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransferSecretValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferSecret.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferSecretValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferSecretValidationError{}
+
+// Validate checks the field values on TransferPermission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferPermission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferPermission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferPermissionMultiError, or nil if none found.
+func (m *TransferPermission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferPermission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceId
+
+	// no validation rules for Relation
+
+	// no validation rules for SubjectType
+
+	// no validation rules for SubjectId
+
+	if m.ExpiresAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiresAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransferPermissionValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransferPermissionValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransferPermissionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TransferPermissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferPermissionMultiError is an error wrapping multiple validation errors
+// returned by TransferPermission.ValidateAll() if the designated constraints
+// aren't met.
+type TransferPermissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferPermissionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferPermissionMultiError) AllErrors() []error { return m }
+
+// TransferPermissionValidationError is the validation error returned by
+// TransferPermission.Validate if the designated constraints aren't met.
+type TransferPermissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferPermissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferPermissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferPermissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferPermissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferPermissionValidationError) ErrorName() string {
+	return "TransferPermissionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferPermissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferPermission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferPermissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferPermissionValidationError{}
+
+// Validate checks the field values on FolderTreeBundle with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FolderTreeBundle) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FolderTreeBundle with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FolderTreeBundleMultiError, or nil if none found.
+func (m *FolderTreeBundle) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FolderTreeBundle) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SourceTenantId
+
+	for idx, item := range m.GetFolders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FolderTreeBundleValidationError{
+						field:  fmt.Sprintf("Folders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FolderTreeBundleValidationError{
+						field:  fmt.Sprintf("Folders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FolderTreeBundleValidationError{
+					field:  fmt.Sprintf("Folders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FolderTreeBundleValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FolderTreeBundleValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FolderTreeBundleValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FolderTreeBundleValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FolderTreeBundleValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FolderTreeBundleValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FolderTreeBundleMultiError(errors)
+	}
+
+	return nil
+}
+
+// FolderTreeBundleMultiError is an error wrapping multiple validation errors
+// returned by FolderTreeBundle.ValidateAll() if the designated constraints
+// aren't met.
+type FolderTreeBundleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FolderTreeBundleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FolderTreeBundleMultiError) AllErrors() []error { return m }
+
+// FolderTreeBundleValidationError is the validation error returned by
+// FolderTreeBundle.Validate if the designated constraints aren't met.
+type FolderTreeBundleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FolderTreeBundleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FolderTreeBundleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FolderTreeBundleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FolderTreeBundleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FolderTreeBundleValidationError) ErrorName() string { return "FolderTreeBundleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FolderTreeBundleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFolderTreeBundle.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FolderTreeBundleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FolderTreeBundleValidationError{}
+
+// Validate checks the field values on MigrateFolderTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MigrateFolderTreeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrateFolderTreeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MigrateFolderTreeRequestMultiError, or nil if none found.
+func (m *MigrateFolderTreeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrateFolderTreeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FolderId
+
+	// no validation rules for TargetTenantId
+
+	// no validation rules for IncludePermissions
+
+	if m.SourceTenantId != nil {
+		// no validation rules for SourceTenantId
+	}
+
+	if m.TargetParentFolderId != nil {
+		// no validation rules for TargetParentFolderId
+	}
+
+	if m.RemoteEndpoint != nil {
+		// no validation rules for RemoteEndpoint
+	}
+
+	if len(errors) > 0 {
+		return MigrateFolderTreeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrateFolderTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by MigrateFolderTreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MigrateFolderTreeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrateFolderTreeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrateFolderTreeRequestMultiError) AllErrors() []error { return m }
+
+// MigrateFolderTreeRequestValidationError is the validation error returned by
+// MigrateFolderTreeRequest.Validate if the designated constraints aren't met.
+type MigrateFolderTreeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrateFolderTreeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrateFolderTreeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrateFolderTreeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrateFolderTreeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrateFolderTreeRequestValidationError) ErrorName() string {
+	return "MigrateFolderTreeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MigrateFolderTreeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrateFolderTreeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrateFolderTreeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrateFolderTreeRequestValidationError{}
+
+// Validate checks the field values on ImportFolderTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportFolderTreeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportFolderTreeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportFolderTreeRequestMultiError, or nil if none found.
+func (m *ImportFolderTreeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportFolderTreeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBundle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportFolderTreeRequestValidationError{
+					field:  "Bundle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportFolderTreeRequestValidationError{
+					field:  "Bundle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBundle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportFolderTreeRequestValidationError{
+				field:  "Bundle",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TargetTenantId
+
+	// no validation rules for IncludePermissions
+
+	if m.TargetParentFolderId != nil {
+		// no validation rules for TargetParentFolderId
+	}
+
+	if len(errors) > 0 {
+		return ImportFolderTreeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportFolderTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportFolderTreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportFolderTreeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportFolderTreeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportFolderTreeRequestMultiError) AllErrors() []error { return m }
+
+// ImportFolderTreeRequestValidationError is the validation error returned by
+// ImportFolderTreeRequest.Validate if the designated constraints aren't met.
+type ImportFolderTreeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportFolderTreeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportFolderTreeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportFolderTreeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportFolderTreeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportFolderTreeRequestValidationError) ErrorName() string {
+	return "ImportFolderTreeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportFolderTreeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportFolderTreeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportFolderTreeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportFolderTreeRequestValidationError{}
+
+// Validate checks the field values on MigrateFolderTreeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MigrateFolderTreeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrateFolderTreeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MigrateFolderTreeResponseMultiError, or nil if none found.
+func (m *MigrateFolderTreeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrateFolderTreeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RootFolderId
+
+	// no validation rules for FoldersCreated
+
+	// no validation rules for SecretsCreated
+
+	// no validation rules for SecretsFailed
+
+	// no validation rules for PermissionsCreated
+
+	// no validation rules for FolderIdMapping
+
+	// no validation rules for SecretIdMapping
+
+	if len(errors) > 0 {
+		return MigrateFolderTreeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrateFolderTreeResponseMultiError is an error wrapping multiple validation
+// errors returned by MigrateFolderTreeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type MigrateFolderTreeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrateFolderTreeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrateFolderTreeResponseMultiError) AllErrors() []error { return m }
+
+// MigrateFolderTreeResponseValidationError is the validation error returned by
+// MigrateFolderTreeResponse.Validate if the designated constraints aren't met.
+type MigrateFolderTreeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrateFolderTreeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrateFolderTreeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrateFolderTreeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrateFolderTreeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrateFolderTreeResponseValidationError) ErrorName() string {
+	return "MigrateFolderTreeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MigrateFolderTreeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrateFolderTreeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrateFolderTreeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrateFolderTreeResponseValidationError{}
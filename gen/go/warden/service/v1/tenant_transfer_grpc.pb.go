@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/tenant_transfer.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenTenantTransferService_MigrateFolderTree_FullMethodName = "/warden.service.v1.WardenTenantTransferService/MigrateFolderTree"
+	WardenTenantTransferService_ImportFolderTree_FullMethodName  = "/warden.service.v1.WardenTenantTransferService/ImportFolderTree"
+)
+
+// WardenTenantTransferServiceClient is the client API for WardenTenantTransferService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Tenant Transfer Service - copies folder subtrees between tenants and Warden instances
+type WardenTenantTransferServiceClient interface {
+	// Copy a folder subtree into another tenant, locally or on a remote Warden instance
+	MigrateFolderTree(ctx context.Context, in *MigrateFolderTreeRequest, opts ...grpc.CallOption) (*MigrateFolderTreeResponse, error)
+	// Import a folder subtree bundle (called by the source instance of a remote migration)
+	ImportFolderTree(ctx context.Context, in *ImportFolderTreeRequest, opts ...grpc.CallOption) (*MigrateFolderTreeResponse, error)
+}
+
+type wardenTenantTransferServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenTenantTransferServiceClient(cc grpc.ClientConnInterface) WardenTenantTransferServiceClient {
+	return &wardenTenantTransferServiceClient{cc}
+}
+
+func (c *wardenTenantTransferServiceClient) MigrateFolderTree(ctx context.Context, in *MigrateFolderTreeRequest, opts ...grpc.CallOption) (*MigrateFolderTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MigrateFolderTreeResponse)
+	err := c.cc.Invoke(ctx, WardenTenantTransferService_MigrateFolderTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenTenantTransferServiceClient) ImportFolderTree(ctx context.Context, in *ImportFolderTreeRequest, opts ...grpc.CallOption) (*MigrateFolderTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MigrateFolderTreeResponse)
+	err := c.cc.Invoke(ctx, WardenTenantTransferService_ImportFolderTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenTenantTransferServiceServer is the server API for WardenTenantTransferService service.
+// All implementations must embed UnimplementedWardenTenantTransferServiceServer
+// for forward compatibility.
+//
+// Tenant Transfer Service - copies folder subtrees between tenants and Warden instances
+type WardenTenantTransferServiceServer interface {
+	// Copy a folder subtree into another tenant, locally or on a remote Warden instance
+	MigrateFolderTree(context.Context, *MigrateFolderTreeRequest) (*MigrateFolderTreeResponse, error)
+	// Import a folder subtree bundle (called by the source instance of a remote migration)
+	ImportFolderTree(context.Context, *ImportFolderTreeRequest) (*MigrateFolderTreeResponse, error)
+	mustEmbedUnimplementedWardenTenantTransferServiceServer()
+}
+
+// UnimplementedWardenTenantTransferServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenTenantTransferServiceServer struct{}
+
+func (UnimplementedWardenTenantTransferServiceServer) MigrateFolderTree(context.Context, *MigrateFolderTreeRequest) (*MigrateFolderTreeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MigrateFolderTree not implemented")
+}
+func (UnimplementedWardenTenantTransferServiceServer) ImportFolderTree(context.Context, *ImportFolderTreeRequest) (*MigrateFolderTreeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportFolderTree not implemented")
+}
+func (UnimplementedWardenTenantTransferServiceServer) mustEmbedUnimplementedWardenTenantTransferServiceServer() {
+}
+func (UnimplementedWardenTenantTransferServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWardenTenantTransferServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenTenantTransferServiceServer will
+// result in compilation errors.
+type UnsafeWardenTenantTransferServiceServer interface {
+	mustEmbedUnimplementedWardenTenantTransferServiceServer()
+}
+
+func RegisterWardenTenantTransferServiceServer(s grpc.ServiceRegistrar, srv WardenTenantTransferServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenTenantTransferServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenTenantTransferService_ServiceDesc, srv)
+}
+
+func _WardenTenantTransferService_MigrateFolderTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateFolderTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenTenantTransferServiceServer).MigrateFolderTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenTenantTransferService_MigrateFolderTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenTenantTransferServiceServer).MigrateFolderTree(ctx, req.(*MigrateFolderTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenTenantTransferService_ImportFolderTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFolderTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenTenantTransferServiceServer).ImportFolderTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenTenantTransferService_ImportFolderTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenTenantTransferServiceServer).ImportFolderTree(ctx, req.(*ImportFolderTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenTenantTransferService_ServiceDesc is the grpc.ServiceDesc for WardenTenantTransferService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenTenantTransferService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenTenantTransferService",
+	HandlerType: (*WardenTenantTransferServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MigrateFolderTree",
+			Handler:    _WardenTenantTransferService_MigrateFolderTree_Handler,
+		},
+		{
+			MethodName: "ImportFolderTree",
+			Handler:    _WardenTenantTransferService_ImportFolderTree_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/tenant_transfer.proto",
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/tenant_transfer.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenTenantTransferServiceImportFolderTree = "/warden.service.v1.WardenTenantTransferService/ImportFolderTree"
+const OperationWardenTenantTransferServiceMigrateFolderTree = "/warden.service.v1.WardenTenantTransferService/MigrateFolderTree"
+
+type WardenTenantTransferServiceHTTPServer interface {
+	// ImportFolderTree Import a folder subtree bundle (called by the source instance of a remote migration)
+	ImportFolderTree(context.Context, *ImportFolderTreeRequest) (*MigrateFolderTreeResponse, error)
+	// MigrateFolderTree Copy a folder subtree into another tenant, locally or on a remote Warden instance
+	MigrateFolderTree(context.Context, *MigrateFolderTreeRequest) (*MigrateFolderTreeResponse, error)
+}
+
+func RegisterWardenTenantTransferServiceHTTPServer(s *http.Server, srv WardenTenantTransferServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/transfer/migrate", _WardenTenantTransferService_MigrateFolderTree0_HTTP_Handler(srv))
+	r.POST("/v1/transfer/import", _WardenTenantTransferService_ImportFolderTree0_HTTP_Handler(srv))
+}
+
+func _WardenTenantTransferService_MigrateFolderTree0_HTTP_Handler(srv WardenTenantTransferServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MigrateFolderTreeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenTenantTransferServiceMigrateFolderTree)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MigrateFolderTree(ctx, req.(*MigrateFolderTreeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MigrateFolderTreeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenTenantTransferService_ImportFolderTree0_HTTP_Handler(srv WardenTenantTransferServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportFolderTreeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenTenantTransferServiceImportFolderTree)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportFolderTree(ctx, req.(*ImportFolderTreeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MigrateFolderTreeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenTenantTransferServiceHTTPClient interface {
+	// ImportFolderTree Import a folder subtree bundle (called by the source instance of a remote migration)
+	ImportFolderTree(ctx context.Context, req *ImportFolderTreeRequest, opts ...http.CallOption) (rsp *MigrateFolderTreeResponse, err error)
+	// MigrateFolderTree Copy a folder subtree into another tenant, locally or on a remote Warden instance
+	MigrateFolderTree(ctx context.Context, req *MigrateFolderTreeRequest, opts ...http.CallOption) (rsp *MigrateFolderTreeResponse, err error)
+}
+
+type WardenTenantTransferServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenTenantTransferServiceHTTPClient(client *http.Client) WardenTenantTransferServiceHTTPClient {
+	return &WardenTenantTransferServiceHTTPClientImpl{client}
+}
+
+// ImportFolderTree Import a folder subtree bundle (called by the source instance of a remote migration)
+func (c *WardenTenantTransferServiceHTTPClientImpl) ImportFolderTree(ctx context.Context, in *ImportFolderTreeRequest, opts ...http.CallOption) (*MigrateFolderTreeResponse, error) {
+	var out MigrateFolderTreeResponse
+	pattern := "/v1/transfer/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenTenantTransferServiceImportFolderTree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MigrateFolderTree Copy a folder subtree into another tenant, locally or on a remote Warden instance
+func (c *WardenTenantTransferServiceHTTPClientImpl) MigrateFolderTree(ctx context.Context, in *MigrateFolderTreeRequest, opts ...http.CallOption) (*MigrateFolderTreeResponse, error) {
+	var out MigrateFolderTreeResponse
+	pattern := "/v1/transfer/migrate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenTenantTransferServiceMigrateFolderTree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
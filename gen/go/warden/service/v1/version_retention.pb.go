@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/version_retention.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Enforced by Vault through the KV v2 metadata of every secret of the tenant
+type VersionRetention struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Password versions Vault keeps per secret; older ones are removed on the
+	// next write (0 = the mount's max_versions)
+	MaxVersions uint32 `protobuf:"varint,2,opt,name=max_versions,json=maxVersions,proto3" json:"max_versions,omitempty"`
+	// Days after which Vault deletes a password version, the current one
+	// included (0 = never)
+	DeleteAfterDays uint32                 `protobuf:"varint,3,opt,name=delete_after_days,json=deleteAfterDays,proto3" json:"delete_after_days,omitempty"`
+	UpdateTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VersionRetention) Reset() {
+	*x = VersionRetention{}
+	mi := &file_warden_service_v1_version_retention_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionRetention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionRetention) ProtoMessage() {}
+
+func (x *VersionRetention) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_version_retention_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionRetention.ProtoReflect.Descriptor instead.
+func (*VersionRetention) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_version_retention_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VersionRetention) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *VersionRetention) GetMaxVersions() uint32 {
+	if x != nil {
+		return x.MaxVersions
+	}
+	return 0
+}
+
+func (x *VersionRetention) GetDeleteAfterDays() uint32 {
+	if x != nil {
+		return x.DeleteAfterDays
+	}
+	return 0
+}
+
+func (x *VersionRetention) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type GetVersionRetentionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant to read (defaults to the caller's tenant; other tenants require platform admin)
+	TenantId      *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVersionRetentionRequest) Reset() {
+	*x = GetVersionRetentionRequest{}
+	mi := &file_warden_service_v1_version_retention_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVersionRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionRetentionRequest) ProtoMessage() {}
+
+func (x *GetVersionRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_version_retention_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionRetentionRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_version_retention_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetVersionRetentionRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type SetVersionRetentionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant to configure (defaults to the caller's tenant)
+	TenantId        *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	MaxVersions     uint32  `protobuf:"varint,2,opt,name=max_versions,json=maxVersions,proto3" json:"max_versions,omitempty"`
+	DeleteAfterDays uint32  `protobuf:"varint,3,opt,name=delete_after_days,json=deleteAfterDays,proto3" json:"delete_after_days,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetVersionRetentionRequest) Reset() {
+	*x = SetVersionRetentionRequest{}
+	mi := &file_warden_service_v1_version_retention_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVersionRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVersionRetentionRequest) ProtoMessage() {}
+
+func (x *SetVersionRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_version_retention_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVersionRetentionRequest.ProtoReflect.Descriptor instead.
+func (*SetVersionRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_version_retention_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetVersionRetentionRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *SetVersionRetentionRequest) GetMaxVersions() uint32 {
+	if x != nil {
+		return x.MaxVersions
+	}
+	return 0
+}
+
+func (x *SetVersionRetentionRequest) GetDeleteAfterDays() uint32 {
+	if x != nil {
+		return x.DeleteAfterDays
+	}
+	return 0
+}
+
+type SetVersionRetentionResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Retention *VersionRetention      `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty"`
+	// Secrets whose Vault metadata was updated
+	SecretsUpdated uint32 `protobuf:"varint,2,opt,name=secrets_updated,json=secretsUpdated,proto3" json:"secrets_updated,omitempty"`
+	// Secrets whose Vault metadata could not be updated; setting the retention
+	// again retries them
+	FailedSecretIds []string `protobuf:"bytes,3,rep,name=failed_secret_ids,json=failedSecretIds,proto3" json:"failed_secret_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetVersionRetentionResponse) Reset() {
+	*x = SetVersionRetentionResponse{}
+	mi := &file_warden_service_v1_version_retention_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVersionRetentionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVersionRetentionResponse) ProtoMessage() {}
+
+func (x *SetVersionRetentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_version_retention_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVersionRetentionResponse.ProtoReflect.Descriptor instead.
+func (*SetVersionRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_version_retention_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetVersionRetentionResponse) GetRetention() *VersionRetention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *SetVersionRetentionResponse) GetSecretsUpdated() uint32 {
+	if x != nil {
+		return x.SecretsUpdated
+	}
+	return 0
+}
+
+func (x *SetVersionRetentionResponse) GetFailedSecretIds() []string {
+	if x != nil {
+		return x.FailedSecretIds
+	}
+	return nil
+}
+
+var File_warden_service_v1_version_retention_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_version_retention_proto_rawDesc = "" +
+	"\n" +
+	")warden/service/v1/version_retention.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd0\x01\n" +
+	"\x10VersionRetention\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12!\n" +
+	"\fmax_versions\x18\x02 \x01(\rR\vmaxVersions\x12*\n" +
+	"\x11delete_after_days\x18\x03 \x01(\rR\x0fdeleteAfterDays\x12@\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"updateTime\x88\x01\x01B\x0e\n" +
+	"\f_update_time\"L\n" +
+	"\x1aGetVersionRetentionRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"\xb0\x01\n" +
+	"\x1aSetVersionRetentionRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12+\n" +
+	"\fmax_versions\x18\x02 \x01(\rB\b\xbaH\x05*\x03\x18\xe8\aR\vmaxVersions\x125\n" +
+	"\x11delete_after_days\x18\x03 \x01(\rB\t\xbaH\x06*\x04\x18\x94\x9d\x02R\x0fdeleteAfterDaysB\f\n" +
+	"\n" +
+	"_tenant_id\"\xb5\x01\n" +
+	"\x1bSetVersionRetentionResponse\x12A\n" +
+	"\tretention\x18\x01 \x01(\v2#.warden.service.v1.VersionRetentionR\tretention\x12'\n" +
+	"\x0fsecrets_updated\x18\x02 \x01(\rR\x0esecretsUpdated\x12*\n" +
+	"\x11failed_secret_ids\x18\x03 \x03(\tR\x0ffailedSecretIds2\xc3\x02\n" +
+	"\x1dWardenVersionRetentionService\x12\x88\x01\n" +
+	"\x13GetVersionRetention\x12-.warden.service.v1.GetVersionRetentionRequest\x1a#.warden.service.v1.VersionRetention\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/version-retention\x12\x96\x01\n" +
+	"\x13SetVersionRetention\x12-.warden.service.v1.SetVersionRetentionRequest\x1a..warden.service.v1.SetVersionRetentionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/version-retentionB\xdd\x01\n" +
+	"\x15com.warden.service.v1B\x15VersionRetentionProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_version_retention_proto_rawDescOnce sync.Once
+	file_warden_service_v1_version_retention_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_version_retention_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_version_retention_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_version_retention_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_version_retention_proto_rawDesc), len(file_warden_service_v1_version_retention_proto_rawDesc)))
+	})
+	return file_warden_service_v1_version_retention_proto_rawDescData
+}
+
+var file_warden_service_v1_version_retention_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_warden_service_v1_version_retention_proto_goTypes = []any{
+	(*VersionRetention)(nil),            // 0: warden.service.v1.VersionRetention
+	(*GetVersionRetentionRequest)(nil),  // 1: warden.service.v1.GetVersionRetentionRequest
+	(*SetVersionRetentionRequest)(nil),  // 2: warden.service.v1.SetVersionRetentionRequest
+	(*SetVersionRetentionResponse)(nil), // 3: warden.service.v1.SetVersionRetentionResponse
+	(*timestamppb.Timestamp)(nil),       // 4: google.protobuf.Timestamp
+}
+var file_warden_service_v1_version_retention_proto_depIdxs = []int32{
+	4, // 0: warden.service.v1.VersionRetention.update_time:type_name -> google.protobuf.Timestamp
+	0, // 1: warden.service.v1.SetVersionRetentionResponse.retention:type_name -> warden.service.v1.VersionRetention
+	1, // 2: warden.service.v1.WardenVersionRetentionService.GetVersionRetention:input_type -> warden.service.v1.GetVersionRetentionRequest
+	2, // 3: warden.service.v1.WardenVersionRetentionService.SetVersionRetention:input_type -> warden.service.v1.SetVersionRetentionRequest
+	0, // 4: warden.service.v1.WardenVersionRetentionService.GetVersionRetention:output_type -> warden.service.v1.VersionRetention
+	3, // 5: warden.service.v1.WardenVersionRetentionService.SetVersionRetention:output_type -> warden.service.v1.SetVersionRetentionResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_version_retention_proto_init() }
+func file_warden_service_v1_version_retention_proto_init() {
+	if File_warden_service_v1_version_retention_proto != nil {
+		return
+	}
+	file_warden_service_v1_version_retention_proto_msgTypes[0].OneofWrappers = []any{}
+	file_warden_service_v1_version_retention_proto_msgTypes[1].OneofWrappers = []any{}
+	file_warden_service_v1_version_retention_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_version_retention_proto_rawDesc), len(file_warden_service_v1_version_retention_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_version_retention_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_version_retention_proto_depIdxs,
+		MessageInfos:      file_warden_service_v1_version_retention_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_version_retention_proto = out.File
+	file_warden_service_v1_version_retention_proto_goTypes = nil
+	file_warden_service_v1_version_retention_proto_depIdxs = nil
+}
@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/version_retention.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ timestamppb.Timestamp
+)
+
+// RegisterRedactedWardenVersionRetentionServiceServer wraps the WardenVersionRetentionServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenVersionRetentionServiceServer(s grpc.ServiceRegistrar, srv WardenVersionRetentionServiceServer, bypass redact.Bypass) {
+	RegisterWardenVersionRetentionServiceServer(s, RedactedWardenVersionRetentionServiceServer(srv, bypass))
+}
+
+func RedactedWardenVersionRetentionServiceServer(srv WardenVersionRetentionServiceServer, bypass redact.Bypass) WardenVersionRetentionServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenVersionRetentionServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenVersionRetentionServiceServer struct {
+	UnsafeWardenVersionRetentionServiceServer
+	srv    WardenVersionRetentionServiceServer
+	bypass redact.Bypass
+}
+
+// GetVersionRetention is the redacted wrapper for the actual WardenVersionRetentionServiceServer.GetVersionRetention method
+// Unary RPC
+func (s *redactedWardenVersionRetentionServiceServer) GetVersionRetention(ctx context.Context, in *GetVersionRetentionRequest) (*VersionRetention, error) {
+	res, err := s.srv.GetVersionRetention(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SetVersionRetention is the redacted wrapper for the actual WardenVersionRetentionServiceServer.SetVersionRetention method
+// Unary RPC
+func (s *redactedWardenVersionRetentionServiceServer) SetVersionRetention(ctx context.Context, in *SetVersionRetentionRequest) (*SetVersionRetentionResponse, error) {
+	res, err := s.srv.SetVersionRetention(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for VersionRetention
+func (x *VersionRetention) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: MaxVersions
+
+	// Safe field: DeleteAfterDays
+
+	// Safe field: UpdateTime
+	return x.String()
+}
+
+// Redact method implementation for GetVersionRetentionRequest
+func (x *GetVersionRetentionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+	return x.String()
+}
+
+// Redact method implementation for SetVersionRetentionRequest
+func (x *SetVersionRetentionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: MaxVersions
+
+	// Safe field: DeleteAfterDays
+	return x.String()
+}
+
+// Redact method implementation for SetVersionRetentionResponse
+func (x *SetVersionRetentionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Retention
+
+	// Safe field: SecretsUpdated
+
+	// Safe field: FailedSecretIds
+	return x.String()
+}
@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: warden/service/v1/version_retention.proto
+
+package wardenpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on VersionRetention with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VersionRetention) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VersionRetention with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VersionRetentionMultiError, or nil if none found.
+func (m *VersionRetention) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VersionRetention) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for MaxVersions
+
+	// no validation rules for DeleteAfterDays
+
+	if m.UpdateTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdateTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VersionRetentionValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VersionRetentionValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VersionRetentionValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VersionRetentionMultiError(errors)
+	}
+
+	return nil
+}
+
+// VersionRetentionMultiError is an error wrapping multiple validation errors
+// returned by VersionRetention.ValidateAll() if the designated constraints
+// aren't met.
+type VersionRetentionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VersionRetentionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VersionRetentionMultiError) AllErrors() []error { return m }
+
+// VersionRetentionValidationError is the validation error returned by
+// VersionRetention.Validate if the designated constraints aren't met.
+type VersionRetentionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VersionRetentionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VersionRetentionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VersionRetentionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VersionRetentionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VersionRetentionValidationError) ErrorName() string { return "VersionRetentionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VersionRetentionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVersionRetention.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VersionRetentionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VersionRetentionValidationError{}
+
+// Validate checks the field values on GetVersionRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVersionRetentionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVersionRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVersionRetentionRequestMultiError, or nil if none found.
+func (m *GetVersionRetentionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVersionRetentionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return GetVersionRetentionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVersionRetentionRequestMultiError is an error wrapping multiple
+// validation errors returned by GetVersionRetentionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetVersionRetentionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVersionRetentionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVersionRetentionRequestMultiError) AllErrors() []error { return m }
+
+// GetVersionRetentionRequestValidationError is the validation error returned
+// by GetVersionRetentionRequest.Validate if the designated constraints aren't met.
+type GetVersionRetentionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVersionRetentionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVersionRetentionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVersionRetentionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVersionRetentionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVersionRetentionRequestValidationError) ErrorName() string {
+	return "GetVersionRetentionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVersionRetentionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVersionRetentionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVersionRetentionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVersionRetentionRequestValidationError{}
+
+// Validate checks the field values on SetVersionRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetVersionRetentionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetVersionRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetVersionRetentionRequestMultiError, or nil if none found.
+func (m *SetVersionRetentionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetVersionRetentionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxVersions
+
+	// no validation rules for DeleteAfterDays
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return SetVersionRetentionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetVersionRetentionRequestMultiError is an error wrapping multiple
+// validation errors returned by SetVersionRetentionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetVersionRetentionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetVersionRetentionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetVersionRetentionRequestMultiError) AllErrors() []error { return m }
+
+// SetVersionRetentionRequestValidationError is the validation error returned
+// by SetVersionRetentionRequest.Validate if the designated constraints aren't met.
+type SetVersionRetentionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetVersionRetentionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetVersionRetentionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetVersionRetentionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetVersionRetentionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetVersionRetentionRequestValidationError) ErrorName() string {
+	return "SetVersionRetentionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetVersionRetentionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetVersionRetentionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetVersionRetentionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetVersionRetentionRequestValidationError{}
+
+// Validate checks the field values on SetVersionRetentionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetVersionRetentionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetVersionRetentionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetVersionRetentionResponseMultiError, or nil if none found.
+func (m *SetVersionRetentionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetVersionRetentionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRetention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetVersionRetentionResponseValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetVersionRetentionResponseValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetVersionRetentionResponseValidationError{
+				field:  "Retention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SecretsUpdated
+
+	if len(errors) > 0 {
+		return SetVersionRetentionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetVersionRetentionResponseMultiError is an error wrapping multiple
+// validation errors returned by SetVersionRetentionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetVersionRetentionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetVersionRetentionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetVersionRetentionResponseMultiError) AllErrors() []error { return m }
+
+// SetVersionRetentionResponseValidationError is the validation error returned
+// by SetVersionRetentionResponse.Validate if the designated constraints
+// aren't met.
+type SetVersionRetentionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetVersionRetentionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetVersionRetentionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetVersionRetentionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetVersionRetentionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetVersionRetentionResponseValidationError) ErrorName() string {
+	return "SetVersionRetentionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetVersionRetentionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetVersionRetentionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetVersionRetentionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetVersionRetentionResponseValidationError{}
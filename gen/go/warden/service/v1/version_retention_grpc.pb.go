@@ -0,0 +1,170 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: warden/service/v1/version_retention.proto
+
+package wardenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WardenVersionRetentionService_GetVersionRetention_FullMethodName = "/warden.service.v1.WardenVersionRetentionService/GetVersionRetention"
+	WardenVersionRetentionService_SetVersionRetention_FullMethodName = "/warden.service.v1.WardenVersionRetentionService/SetVersionRetention"
+)
+
+// WardenVersionRetentionServiceClient is the client API for WardenVersionRetentionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Version Retention Service - per-tenant caps on the password versions Vault keeps
+type WardenVersionRetentionServiceClient interface {
+	// Get the version retention of a tenant
+	GetVersionRetention(ctx context.Context, in *GetVersionRetentionRequest, opts ...grpc.CallOption) (*VersionRetention, error)
+	// Replace the version retention of a tenant and apply it to its existing
+	// secrets (platform admin only)
+	SetVersionRetention(ctx context.Context, in *SetVersionRetentionRequest, opts ...grpc.CallOption) (*SetVersionRetentionResponse, error)
+}
+
+type wardenVersionRetentionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWardenVersionRetentionServiceClient(cc grpc.ClientConnInterface) WardenVersionRetentionServiceClient {
+	return &wardenVersionRetentionServiceClient{cc}
+}
+
+func (c *wardenVersionRetentionServiceClient) GetVersionRetention(ctx context.Context, in *GetVersionRetentionRequest, opts ...grpc.CallOption) (*VersionRetention, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VersionRetention)
+	err := c.cc.Invoke(ctx, WardenVersionRetentionService_GetVersionRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wardenVersionRetentionServiceClient) SetVersionRetention(ctx context.Context, in *SetVersionRetentionRequest, opts ...grpc.CallOption) (*SetVersionRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVersionRetentionResponse)
+	err := c.cc.Invoke(ctx, WardenVersionRetentionService_SetVersionRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WardenVersionRetentionServiceServer is the server API for WardenVersionRetentionService service.
+// All implementations must embed UnimplementedWardenVersionRetentionServiceServer
+// for forward compatibility.
+//
+// Version Retention Service - per-tenant caps on the password versions Vault keeps
+type WardenVersionRetentionServiceServer interface {
+	// Get the version retention of a tenant
+	GetVersionRetention(context.Context, *GetVersionRetentionRequest) (*VersionRetention, error)
+	// Replace the version retention of a tenant and apply it to its existing
+	// secrets (platform admin only)
+	SetVersionRetention(context.Context, *SetVersionRetentionRequest) (*SetVersionRetentionResponse, error)
+	mustEmbedUnimplementedWardenVersionRetentionServiceServer()
+}
+
+// UnimplementedWardenVersionRetentionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWardenVersionRetentionServiceServer struct{}
+
+func (UnimplementedWardenVersionRetentionServiceServer) GetVersionRetention(context.Context, *GetVersionRetentionRequest) (*VersionRetention, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVersionRetention not implemented")
+}
+func (UnimplementedWardenVersionRetentionServiceServer) SetVersionRetention(context.Context, *SetVersionRetentionRequest) (*SetVersionRetentionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetVersionRetention not implemented")
+}
+func (UnimplementedWardenVersionRetentionServiceServer) mustEmbedUnimplementedWardenVersionRetentionServiceServer() {
+}
+func (UnimplementedWardenVersionRetentionServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWardenVersionRetentionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WardenVersionRetentionServiceServer will
+// result in compilation errors.
+type UnsafeWardenVersionRetentionServiceServer interface {
+	mustEmbedUnimplementedWardenVersionRetentionServiceServer()
+}
+
+func RegisterWardenVersionRetentionServiceServer(s grpc.ServiceRegistrar, srv WardenVersionRetentionServiceServer) {
+	// If the following call panics, it indicates UnimplementedWardenVersionRetentionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WardenVersionRetentionService_ServiceDesc, srv)
+}
+
+func _WardenVersionRetentionService_GetVersionRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenVersionRetentionServiceServer).GetVersionRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenVersionRetentionService_GetVersionRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenVersionRetentionServiceServer).GetVersionRetention(ctx, req.(*GetVersionRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WardenVersionRetentionService_SetVersionRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVersionRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WardenVersionRetentionServiceServer).SetVersionRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WardenVersionRetentionService_SetVersionRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WardenVersionRetentionServiceServer).SetVersionRetention(ctx, req.(*SetVersionRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WardenVersionRetentionService_ServiceDesc is the grpc.ServiceDesc for WardenVersionRetentionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WardenVersionRetentionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.service.v1.WardenVersionRetentionService",
+	HandlerType: (*WardenVersionRetentionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVersionRetention",
+			Handler:    _WardenVersionRetentionService_GetVersionRetention_Handler,
+		},
+		{
+			MethodName: "SetVersionRetention",
+			Handler:    _WardenVersionRetentionService_SetVersionRetention_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/service/v1/version_retention.proto",
+}
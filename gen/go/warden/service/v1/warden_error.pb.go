@@ -34,24 +34,40 @@ const (
 	WardenErrorReason_FOLDER_NOT_EMPTY          WardenErrorReason = 5
 	WardenErrorReason_INVALID_PERMISSION        WardenErrorReason = 6
 	WardenErrorReason_INVALID_FORMAT            WardenErrorReason = 7
+	WardenErrorReason_PASSWORD_POLICY_VIOLATION WardenErrorReason = 8
+	WardenErrorReason_INVALID_PASSWORD_ENCODING WardenErrorReason = 9
+	WardenErrorReason_METADATA_SCHEMA_VIOLATION WardenErrorReason = 10
 	// 401 - Unauthorized
-	WardenErrorReason_UNAUTHORIZED  WardenErrorReason = 100
-	WardenErrorReason_INVALID_TOKEN WardenErrorReason = 101
+	WardenErrorReason_UNAUTHORIZED              WardenErrorReason = 100
+	WardenErrorReason_INVALID_TOKEN             WardenErrorReason = 101
+	WardenErrorReason_REAUTHENTICATION_REQUIRED WardenErrorReason = 102
 	// 403 - Forbidden
 	WardenErrorReason_FORBIDDEN                WardenErrorReason = 300
 	WardenErrorReason_ACCESS_DENIED            WardenErrorReason = 301
 	WardenErrorReason_INSUFFICIENT_PERMISSIONS WardenErrorReason = 302
+	WardenErrorReason_GEO_RESTRICTED           WardenErrorReason = 303
 	// 404 - Not Found
-	WardenErrorReason_NOT_FOUND            WardenErrorReason = 400
-	WardenErrorReason_FOLDER_NOT_FOUND     WardenErrorReason = 401
-	WardenErrorReason_SECRET_NOT_FOUND     WardenErrorReason = 402
-	WardenErrorReason_VERSION_NOT_FOUND    WardenErrorReason = 403
-	WardenErrorReason_PERMISSION_NOT_FOUND WardenErrorReason = 404
+	WardenErrorReason_NOT_FOUND                 WardenErrorReason = 400
+	WardenErrorReason_FOLDER_NOT_FOUND          WardenErrorReason = 401
+	WardenErrorReason_SECRET_NOT_FOUND          WardenErrorReason = 402
+	WardenErrorReason_VERSION_NOT_FOUND         WardenErrorReason = 403
+	WardenErrorReason_PERMISSION_NOT_FOUND      WardenErrorReason = 404
+	WardenErrorReason_RETRIEVAL_TOKEN_NOT_FOUND WardenErrorReason = 405
+	WardenErrorReason_COLLECTION_NOT_FOUND      WardenErrorReason = 406
 	// 409 - Conflict
 	WardenErrorReason_CONFLICT                  WardenErrorReason = 900
 	WardenErrorReason_FOLDER_ALREADY_EXISTS     WardenErrorReason = 901
 	WardenErrorReason_SECRET_ALREADY_EXISTS     WardenErrorReason = 902
 	WardenErrorReason_PERMISSION_ALREADY_EXISTS WardenErrorReason = 903
+	WardenErrorReason_COLLECTION_ALREADY_EXISTS WardenErrorReason = 904
+	// 412 - Precondition Failed
+	WardenErrorReason_PRECONDITION_FAILED        WardenErrorReason = 1200
+	WardenErrorReason_DELETION_APPROVAL_REQUIRED WardenErrorReason = 1201
+	WardenErrorReason_LEGAL_HOLD_ACTIVE          WardenErrorReason = 1202
+	WardenErrorReason_SECRET_REFERENCED          WardenErrorReason = 1203
+	// 413 - Payload Too Large
+	WardenErrorReason_PAYLOAD_TOO_LARGE  WardenErrorReason = 1300
+	WardenErrorReason_PASSWORD_TOO_LARGE WardenErrorReason = 1301
 	// 500 - Internal Server Error
 	WardenErrorReason_INTERNAL_SERVER_ERROR  WardenErrorReason = 2000
 	WardenErrorReason_VAULT_CONNECTION_ERROR WardenErrorReason = 2001
@@ -73,20 +89,34 @@ var (
 		5:    "FOLDER_NOT_EMPTY",
 		6:    "INVALID_PERMISSION",
 		7:    "INVALID_FORMAT",
+		8:    "PASSWORD_POLICY_VIOLATION",
+		9:    "INVALID_PASSWORD_ENCODING",
+		10:   "METADATA_SCHEMA_VIOLATION",
 		100:  "UNAUTHORIZED",
 		101:  "INVALID_TOKEN",
+		102:  "REAUTHENTICATION_REQUIRED",
 		300:  "FORBIDDEN",
 		301:  "ACCESS_DENIED",
 		302:  "INSUFFICIENT_PERMISSIONS",
+		303:  "GEO_RESTRICTED",
 		400:  "NOT_FOUND",
 		401:  "FOLDER_NOT_FOUND",
 		402:  "SECRET_NOT_FOUND",
 		403:  "VERSION_NOT_FOUND",
 		404:  "PERMISSION_NOT_FOUND",
+		405:  "RETRIEVAL_TOKEN_NOT_FOUND",
+		406:  "COLLECTION_NOT_FOUND",
 		900:  "CONFLICT",
 		901:  "FOLDER_ALREADY_EXISTS",
 		902:  "SECRET_ALREADY_EXISTS",
 		903:  "PERMISSION_ALREADY_EXISTS",
+		904:  "COLLECTION_ALREADY_EXISTS",
+		1200: "PRECONDITION_FAILED",
+		1201: "DELETION_APPROVAL_REQUIRED",
+		1202: "LEGAL_HOLD_ACTIVE",
+		1203: "SECRET_REFERENCED",
+		1300: "PAYLOAD_TOO_LARGE",
+		1301: "PASSWORD_TOO_LARGE",
 		2000: "INTERNAL_SERVER_ERROR",
 		2001: "VAULT_CONNECTION_ERROR",
 		2002: "VAULT_OPERATION_ERROR",
@@ -95,34 +125,48 @@ var (
 		2301: "VAULT_UNAVAILABLE",
 	}
 	WardenErrorReason_value = map[string]int32{
-		"BAD_REQUEST":               0,
-		"INVALID_FOLDER_PATH":       1,
-		"INVALID_SECRET_NAME":       2,
-		"INVALID_PASSWORD":          3,
-		"CIRCULAR_FOLDER_REFERENCE": 4,
-		"FOLDER_NOT_EMPTY":          5,
-		"INVALID_PERMISSION":        6,
-		"INVALID_FORMAT":            7,
-		"UNAUTHORIZED":              100,
-		"INVALID_TOKEN":             101,
-		"FORBIDDEN":                 300,
-		"ACCESS_DENIED":             301,
-		"INSUFFICIENT_PERMISSIONS":  302,
-		"NOT_FOUND":                 400,
-		"FOLDER_NOT_FOUND":          401,
-		"SECRET_NOT_FOUND":          402,
-		"VERSION_NOT_FOUND":         403,
-		"PERMISSION_NOT_FOUND":      404,
-		"CONFLICT":                  900,
-		"FOLDER_ALREADY_EXISTS":     901,
-		"SECRET_ALREADY_EXISTS":     902,
-		"PERMISSION_ALREADY_EXISTS": 903,
-		"INTERNAL_SERVER_ERROR":     2000,
-		"VAULT_CONNECTION_ERROR":    2001,
-		"VAULT_OPERATION_ERROR":     2002,
-		"DATABASE_ERROR":            2003,
-		"SERVICE_UNAVAILABLE":       2300,
-		"VAULT_UNAVAILABLE":         2301,
+		"BAD_REQUEST":                0,
+		"INVALID_FOLDER_PATH":        1,
+		"INVALID_SECRET_NAME":        2,
+		"INVALID_PASSWORD":           3,
+		"CIRCULAR_FOLDER_REFERENCE":  4,
+		"FOLDER_NOT_EMPTY":           5,
+		"INVALID_PERMISSION":         6,
+		"INVALID_FORMAT":             7,
+		"PASSWORD_POLICY_VIOLATION":  8,
+		"INVALID_PASSWORD_ENCODING":  9,
+		"METADATA_SCHEMA_VIOLATION":  10,
+		"UNAUTHORIZED":               100,
+		"INVALID_TOKEN":              101,
+		"REAUTHENTICATION_REQUIRED":  102,
+		"FORBIDDEN":                  300,
+		"ACCESS_DENIED":              301,
+		"INSUFFICIENT_PERMISSIONS":   302,
+		"GEO_RESTRICTED":             303,
+		"NOT_FOUND":                  400,
+		"FOLDER_NOT_FOUND":           401,
+		"SECRET_NOT_FOUND":           402,
+		"VERSION_NOT_FOUND":          403,
+		"PERMISSION_NOT_FOUND":       404,
+		"RETRIEVAL_TOKEN_NOT_FOUND":  405,
+		"COLLECTION_NOT_FOUND":       406,
+		"CONFLICT":                   900,
+		"FOLDER_ALREADY_EXISTS":      901,
+		"SECRET_ALREADY_EXISTS":      902,
+		"PERMISSION_ALREADY_EXISTS":  903,
+		"COLLECTION_ALREADY_EXISTS":  904,
+		"PRECONDITION_FAILED":        1200,
+		"DELETION_APPROVAL_REQUIRED": 1201,
+		"LEGAL_HOLD_ACTIVE":          1202,
+		"SECRET_REFERENCED":          1203,
+		"PAYLOAD_TOO_LARGE":          1300,
+		"PASSWORD_TOO_LARGE":         1301,
+		"INTERNAL_SERVER_ERROR":      2000,
+		"VAULT_CONNECTION_ERROR":     2001,
+		"VAULT_OPERATION_ERROR":      2002,
+		"DATABASE_ERROR":             2003,
+		"SERVICE_UNAVAILABLE":        2300,
+		"VAULT_UNAVAILABLE":          2301,
 	}
 )
 
@@ -157,7 +201,8 @@ var File_warden_service_v1_warden_error_proto protoreflect.FileDescriptor
 
 const file_warden_service_v1_warden_error_proto_rawDesc = "" +
 	"\n" +
-	"$warden/service/v1/warden_error.proto\x12\x11warden.service.v1\x1a\x13errors/errors.proto*\xd7\x06\n" +
+	"$warden/service/v1/warden_error.proto\x12\x11warden.service.v1\x1a\x13errors/errors.proto*\xb3\n" +
+	"\n" +
 	"\x11WardenErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1d\n" +
 	"\x13INVALID_FOLDER_PATH\x10\x01\x1a\x04\xa8E\x90\x03\x12\x1d\n" +
@@ -166,21 +211,38 @@ const file_warden_service_v1_warden_error_proto_rawDesc = "" +
 	"\x19CIRCULAR_FOLDER_REFERENCE\x10\x04\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
 	"\x10FOLDER_NOT_EMPTY\x10\x05\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
 	"\x12INVALID_PERMISSION\x10\x06\x1a\x04\xa8E\x90\x03\x12\x18\n" +
-	"\x0eINVALID_FORMAT\x10\a\x1a\x04\xa8E\x90\x03\x12\x16\n" +
+	"\x0eINVALID_FORMAT\x10\a\x1a\x04\xa8E\x90\x03\x12#\n" +
+	"\x19PASSWORD_POLICY_VIOLATION\x10\b\x1a\x04\xa8E\x90\x03\x12#\n" +
+	"\x19INVALID_PASSWORD_ENCODING\x10\t\x1a\x04\xa8E\x90\x03\x12#\n" +
+	"\x19METADATA_SCHEMA_VIOLATION\x10\n" +
+	"\x1a\x04\xa8E\x90\x03\x12\x16\n" +
 	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12\x17\n" +
-	"\rINVALID_TOKEN\x10e\x1a\x04\xa8E\x91\x03\x12\x14\n" +
+	"\rINVALID_TOKEN\x10e\x1a\x04\xa8E\x91\x03\x12#\n" +
+	"\x19REAUTHENTICATION_REQUIRED\x10f\x1a\x04\xa8E\x91\x03\x12\x14\n" +
 	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x18\n" +
 	"\rACCESS_DENIED\x10\xad\x02\x1a\x04\xa8E\x93\x03\x12#\n" +
-	"\x18INSUFFICIENT_PERMISSIONS\x10\xae\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
+	"\x18INSUFFICIENT_PERMISSIONS\x10\xae\x02\x1a\x04\xa8E\x93\x03\x12\x19\n" +
+	"\x0eGEO_RESTRICTED\x10\xaf\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
 	"\tNOT_FOUND\x10\x90\x03\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
 	"\x10FOLDER_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
 	"\x10SECRET_NOT_FOUND\x10\x92\x03\x1a\x04\xa8E\x94\x03\x12\x1c\n" +
 	"\x11VERSION_NOT_FOUND\x10\x93\x03\x1a\x04\xa8E\x94\x03\x12\x1f\n" +
-	"\x14PERMISSION_NOT_FOUND\x10\x94\x03\x1a\x04\xa8E\x94\x03\x12\x13\n" +
+	"\x14PERMISSION_NOT_FOUND\x10\x94\x03\x1a\x04\xa8E\x94\x03\x12$\n" +
+	"\x19RETRIEVAL_TOKEN_NOT_FOUND\x10\x95\x03\x1a\x04\xa8E\x94\x03\x12\x1f\n" +
+	"\x14COLLECTION_NOT_FOUND\x10\x96\x03\x1a\x04\xa8E\x94\x03\x12\x13\n" +
 	"\bCONFLICT\x10\x84\a\x1a\x04\xa8E\x99\x03\x12 \n" +
 	"\x15FOLDER_ALREADY_EXISTS\x10\x85\a\x1a\x04\xa8E\x99\x03\x12 \n" +
 	"\x15SECRET_ALREADY_EXISTS\x10\x86\a\x1a\x04\xa8E\x99\x03\x12$\n" +
-	"\x19PERMISSION_ALREADY_EXISTS\x10\x87\a\x1a\x04\xa8E\x99\x03\x12 \n" +
+	"\x19PERMISSION_ALREADY_EXISTS\x10\x87\a\x1a\x04\xa8E\x99\x03\x12$\n" +
+	"\x19COLLECTION_ALREADY_EXISTS\x10\x88\a\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
+	"\x13PRECONDITION_FAILED\x10\xb0\t\x1a\x04\xa8E\x9c\x03\x12%\n" +
+	"\x1aDELETION_APPROVAL_REQUIRED\x10\xb1\t\x1a\x04\xa8E\x9c\x03\x12\x1c\n" +
+	"\x11LEGAL_HOLD_ACTIVE\x10\xb2\t\x1a\x04\xa8E\x9c\x03\x12\x1c\n" +
+	"\x11SECRET_REFERENCED\x10\xb3\t\x1a\x04\xa8E\x9c\x03\x12\x1c\n" +
+	"\x11PAYLOAD_TOO_LARGE\x10\x94\n" +
+	"\x1a\x04\xa8E\x9d\x03\x12\x1d\n" +
+	"\x12PASSWORD_TOO_LARGE\x10\x95\n" +
+	"\x1a\x04\xa8E\x9d\x03\x12 \n" +
 	"\x15INTERNAL_SERVER_ERROR\x10\xd0\x0f\x1a\x04\xa8E\xf4\x03\x12!\n" +
 	"\x16VAULT_CONNECTION_ERROR\x10\xd1\x0f\x1a\x04\xa8E\xf4\x03\x12 \n" +
 	"\x15VAULT_OPERATION_ERROR\x10\xd2\x0f\x1a\x04\xa8E\xf4\x03\x12\x19\n" +
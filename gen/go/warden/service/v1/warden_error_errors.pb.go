@@ -109,6 +109,42 @@ func ErrorInvalidFormat(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, WardenErrorReason_INVALID_FORMAT.String(), fmt.Sprintf(format, args...))
 }
 
+func IsPasswordPolicyViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_PASSWORD_POLICY_VIOLATION.String() && e.Code == 400
+}
+
+func ErrorPasswordPolicyViolation(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, WardenErrorReason_PASSWORD_POLICY_VIOLATION.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidPasswordEncoding(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_INVALID_PASSWORD_ENCODING.String() && e.Code == 400
+}
+
+func ErrorInvalidPasswordEncoding(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, WardenErrorReason_INVALID_PASSWORD_ENCODING.String(), fmt.Sprintf(format, args...))
+}
+
+func IsMetadataSchemaViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_METADATA_SCHEMA_VIOLATION.String() && e.Code == 400
+}
+
+func ErrorMetadataSchemaViolation(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, WardenErrorReason_METADATA_SCHEMA_VIOLATION.String(), fmt.Sprintf(format, args...))
+}
+
 // 401 - Unauthorized
 func IsUnauthorized(err error) bool {
 	if err == nil {
@@ -135,6 +171,18 @@ func ErrorInvalidToken(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, WardenErrorReason_INVALID_TOKEN.String(), fmt.Sprintf(format, args...))
 }
 
+func IsReauthenticationRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_REAUTHENTICATION_REQUIRED.String() && e.Code == 401
+}
+
+func ErrorReauthenticationRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, WardenErrorReason_REAUTHENTICATION_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
 // 403 - Forbidden
 func IsForbidden(err error) bool {
 	if err == nil {
@@ -173,6 +221,18 @@ func ErrorInsufficientPermissions(format string, args ...interface{}) *errors.Er
 	return errors.New(403, WardenErrorReason_INSUFFICIENT_PERMISSIONS.String(), fmt.Sprintf(format, args...))
 }
 
+func IsGeoRestricted(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_GEO_RESTRICTED.String() && e.Code == 403
+}
+
+func ErrorGeoRestricted(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, WardenErrorReason_GEO_RESTRICTED.String(), fmt.Sprintf(format, args...))
+}
+
 // 404 - Not Found
 func IsNotFound(err error) bool {
 	if err == nil {
@@ -235,6 +295,30 @@ func ErrorPermissionNotFound(format string, args ...interface{}) *errors.Error {
 	return errors.New(404, WardenErrorReason_PERMISSION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
 }
 
+func IsRetrievalTokenNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_RETRIEVAL_TOKEN_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorRetrievalTokenNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, WardenErrorReason_RETRIEVAL_TOKEN_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCollectionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_COLLECTION_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorCollectionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, WardenErrorReason_COLLECTION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
 // 409 - Conflict
 func IsConflict(err error) bool {
 	if err == nil {
@@ -285,6 +369,94 @@ func ErrorPermissionAlreadyExists(format string, args ...interface{}) *errors.Er
 	return errors.New(409, WardenErrorReason_PERMISSION_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
 }
 
+func IsCollectionAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_COLLECTION_ALREADY_EXISTS.String() && e.Code == 409
+}
+
+func ErrorCollectionAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, WardenErrorReason_COLLECTION_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+// 412 - Precondition Failed
+func IsPreconditionFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_PRECONDITION_FAILED.String() && e.Code == 412
+}
+
+// 412 - Precondition Failed
+func ErrorPreconditionFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(412, WardenErrorReason_PRECONDITION_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsDeletionApprovalRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_DELETION_APPROVAL_REQUIRED.String() && e.Code == 412
+}
+
+func ErrorDeletionApprovalRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(412, WardenErrorReason_DELETION_APPROVAL_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsLegalHoldActive(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_LEGAL_HOLD_ACTIVE.String() && e.Code == 412
+}
+
+func ErrorLegalHoldActive(format string, args ...interface{}) *errors.Error {
+	return errors.New(412, WardenErrorReason_LEGAL_HOLD_ACTIVE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSecretReferenced(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_SECRET_REFERENCED.String() && e.Code == 412
+}
+
+func ErrorSecretReferenced(format string, args ...interface{}) *errors.Error {
+	return errors.New(412, WardenErrorReason_SECRET_REFERENCED.String(), fmt.Sprintf(format, args...))
+}
+
+// 413 - Payload Too Large
+func IsPayloadTooLarge(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_PAYLOAD_TOO_LARGE.String() && e.Code == 413
+}
+
+// 413 - Payload Too Large
+func ErrorPayloadTooLarge(format string, args ...interface{}) *errors.Error {
+	return errors.New(413, WardenErrorReason_PAYLOAD_TOO_LARGE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsPasswordTooLarge(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == WardenErrorReason_PASSWORD_TOO_LARGE.String() && e.Code == 413
+}
+
+func ErrorPasswordTooLarge(format string, args ...interface{}) *errors.Error {
+	return errors.New(413, WardenErrorReason_PASSWORD_TOO_LARGE.String(), fmt.Sprintf(format, args...))
+}
+
 // 500 - Internal Server Error
 func IsInternalServerError(err error) bool {
 	if err == nil {
@@ -0,0 +1,1350 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warden/service/v1/webhook.proto
+
+package wardenpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Event types a webhook can subscribe to
+type WebhookEventType int32
+
+const (
+	WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED        WebhookEventType = 0
+	WebhookEventType_WEBHOOK_EVENT_TYPE_SECRET_EXPIRING    WebhookEventType = 1 // A secret's metadata expires_at is near or past
+	WebhookEventType_WEBHOOK_EVENT_TYPE_PERMISSION_GRANTED WebhookEventType = 2 // Access to a folder or secret was granted
+	WebhookEventType_WEBHOOK_EVENT_TYPE_IMPORT_FINISHED    WebhookEventType = 3 // A Bitwarden or backup import finished
+	WebhookEventType_WEBHOOK_EVENT_TYPE_BACKUP_COMPLETED   WebhookEventType = 4 // A backup export completed
+	WebhookEventType_WEBHOOK_EVENT_TYPE_SECRET_READ        WebhookEventType = 5 // A non-owner read the password of a sensitive secret
+	WebhookEventType_WEBHOOK_EVENT_TYPE_CANARY_READ        WebhookEventType = 6 // Someone read the password of a canary secret
+)
+
+// Enum value maps for WebhookEventType.
+var (
+	WebhookEventType_name = map[int32]string{
+		0: "WEBHOOK_EVENT_TYPE_UNSPECIFIED",
+		1: "WEBHOOK_EVENT_TYPE_SECRET_EXPIRING",
+		2: "WEBHOOK_EVENT_TYPE_PERMISSION_GRANTED",
+		3: "WEBHOOK_EVENT_TYPE_IMPORT_FINISHED",
+		4: "WEBHOOK_EVENT_TYPE_BACKUP_COMPLETED",
+		5: "WEBHOOK_EVENT_TYPE_SECRET_READ",
+		6: "WEBHOOK_EVENT_TYPE_CANARY_READ",
+	}
+	WebhookEventType_value = map[string]int32{
+		"WEBHOOK_EVENT_TYPE_UNSPECIFIED":        0,
+		"WEBHOOK_EVENT_TYPE_SECRET_EXPIRING":    1,
+		"WEBHOOK_EVENT_TYPE_PERMISSION_GRANTED": 2,
+		"WEBHOOK_EVENT_TYPE_IMPORT_FINISHED":    3,
+		"WEBHOOK_EVENT_TYPE_BACKUP_COMPLETED":   4,
+		"WEBHOOK_EVENT_TYPE_SECRET_READ":        5,
+		"WEBHOOK_EVENT_TYPE_CANARY_READ":        6,
+	}
+)
+
+func (x WebhookEventType) Enum() *WebhookEventType {
+	p := new(WebhookEventType)
+	*p = x
+	return p
+}
+
+func (x WebhookEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_webhook_proto_enumTypes[0].Descriptor()
+}
+
+func (WebhookEventType) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_webhook_proto_enumTypes[0]
+}
+
+func (x WebhookEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookEventType.Descriptor instead.
+func (WebhookEventType) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+// Delivery state
+type DeliveryStatus int32
+
+const (
+	DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED DeliveryStatus = 0
+	DeliveryStatus_DELIVERY_STATUS_PENDING     DeliveryStatus = 1 // Waiting for the first attempt or a retry
+	DeliveryStatus_DELIVERY_STATUS_DELIVERED   DeliveryStatus = 2 // Endpoint answered with 2xx
+	DeliveryStatus_DELIVERY_STATUS_FAILED      DeliveryStatus = 3 // Retries exhausted
+)
+
+// Enum value maps for DeliveryStatus.
+var (
+	DeliveryStatus_name = map[int32]string{
+		0: "DELIVERY_STATUS_UNSPECIFIED",
+		1: "DELIVERY_STATUS_PENDING",
+		2: "DELIVERY_STATUS_DELIVERED",
+		3: "DELIVERY_STATUS_FAILED",
+	}
+	DeliveryStatus_value = map[string]int32{
+		"DELIVERY_STATUS_UNSPECIFIED": 0,
+		"DELIVERY_STATUS_PENDING":     1,
+		"DELIVERY_STATUS_DELIVERED":   2,
+		"DELIVERY_STATUS_FAILED":      3,
+	}
+)
+
+func (x DeliveryStatus) Enum() *DeliveryStatus {
+	p := new(DeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x DeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_warden_service_v1_webhook_proto_enumTypes[1].Descriptor()
+}
+
+func (DeliveryStatus) Type() protoreflect.EnumType {
+	return &file_warden_service_v1_webhook_proto_enumTypes[1]
+}
+
+func (x DeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryStatus.Descriptor instead.
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{1}
+}
+
+// Webhook entity
+type Webhook struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name     string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Url      string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// Whether payloads are HMAC-signed (the secret itself is never returned)
+	HasSecret     bool                   `protobuf:"varint,5,opt,name=has_secret,json=hasSecret,proto3" json:"has_secret,omitempty"`
+	EventTypes    []WebhookEventType     `protobuf:"varint,6,rep,packed,name=event_types,json=eventTypes,proto3,enum=warden.service.v1.WebhookEventType" json:"event_types,omitempty"`
+	Enabled       bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	CreatedBy     *uint32                `protobuf:"varint,11,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Webhook) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *Webhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetHasSecret() bool {
+	if x != nil {
+		return x.HasSecret
+	}
+	return false
+}
+
+func (x *Webhook) GetEventTypes() []WebhookEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Webhook) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Webhook) GetCreatedBy() uint32 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+type CreateWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Endpoint URL; https, on a public address
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Shared secret for the X-Warden-Signature header (HMAC-SHA256 of the body)
+	Secret        string             `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	EventTypes    []WebhookEventType `protobuf:"varint,4,rep,packed,name=event_types,json=eventTypes,proto3,enum=warden.service.v1.WebhookEventType" json:"event_types,omitempty"`
+	Enabled       bool               `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Description   string             `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []WebhookEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *CreateWebhookRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type GetWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRequest) ProtoMessage() {}
+
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetWebhookRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookResponse) Reset() {
+	*x = GetWebhookResponse{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookResponse) ProtoMessage() {}
+
+func (x *GetWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *uint32                `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListWebhooksRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhooksRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type UpdateWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// New endpoint URL; https, on a public address
+	Url *string `protobuf:"bytes,3,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	// New shared secret (empty string removes signing)
+	Secret *string `protobuf:"bytes,4,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	// Replaces the subscribed event types when non-empty
+	EventTypes    []WebhookEventType `protobuf:"varint,5,rep,packed,name=event_types,json=eventTypes,proto3,enum=warden.service.v1.WebhookEventType" json:"event_types,omitempty"`
+	Enabled       *bool              `protobuf:"varint,6,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Description   *string            `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateWebhookRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateWebhookRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEventTypes() []WebhookEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type UpdateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteWebhookRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// One event queued for one webhook
+type WebhookDelivery struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId uint32                 `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId   string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType WebhookEventType       `protobuf:"varint,4,opt,name=event_type,json=eventType,proto3,enum=warden.service.v1.WebhookEventType" json:"event_type,omitempty"`
+	Status    DeliveryStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=warden.service.v1.DeliveryStatus" json:"status,omitempty"`
+	// JSON body sent to the endpoint
+	Payload       string                 `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	Attempts      int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ResponseCode  *int32                 `protobuf:"varint,8,opt,name=response_code,json=responseCode,proto3,oneof" json:"response_code,omitempty"`
+	LastError     string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_attempt_at,json=nextAttemptAt,proto3,oneof" json:"next_attempt_at,omitempty"`
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=delivered_at,json=deliveredAt,proto3,oneof" json:"delivered_at,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WebhookDelivery) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookId() uint32 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() WebhookEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseCode() int32 {
+	if x != nil && x.ResponseCode != nil {
+		return *x.ResponseCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     uint32                 `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Status        *DeliveryStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=warden.service.v1.DeliveryStatus,oneof" json:"status,omitempty"`
+	Page          *uint32                `protobuf:"varint,3,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32                `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() uint32 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() DeliveryStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    uint32                 `protobuf:"varint,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() uint32 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+type RedeliverWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warden_service_v1_webhook_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_warden_service_v1_webhook_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RedeliverWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+var File_warden_service_v1_webhook_proto protoreflect.FileDescriptor
+
+const file_warden_service_v1_webhook_proto_rawDesc = "" +
+	"\n" +
+	"\x1fwarden/service/v1/webhook.proto\x12\x11warden.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x03\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"has_secret\x18\x05 \x01(\bR\thasSecret\x12D\n" +
+	"\vevent_types\x18\x06 \x03(\x0e2#.warden.service.v1.WebhookEventTypeR\n" +
+	"eventTypes\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12;\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\"\n" +
+	"\n" +
+	"created_by\x18\v \x01(\rH\x00R\tcreatedBy\x88\x01\x01B\r\n" +
+	"\v_created_by\"\x93\x02\n" +
+	"\x14CreateWebhookRequest\x12!\n" +
+	"\x04name\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12 \n" +
+	"\x03url\x18\x02 \x01(\tB\x0e\xe0A\x02\xbaH\br\x06\x18\x80\x10\x88\x01\x01R\x03url\x12 \n" +
+	"\x06secret\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x06secret\x12N\n" +
+	"\vevent_types\x18\x04 \x03(\x0e2#.warden.service.v1.WebhookEventTypeB\b\xbaH\x05\x92\x01\x02\b\x01R\n" +
+	"eventTypes\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12*\n" +
+	"\vdescription\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\vdescription\"M\n" +
+	"\x15CreateWebhookResponse\x124\n" +
+	"\awebhook\x18\x01 \x01(\v2\x1a.warden.service.v1.WebhookR\awebhook\",\n" +
+	"\x11GetWebhookRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x02id\"J\n" +
+	"\x12GetWebhookResponse\x124\n" +
+	"\awebhook\x18\x01 \x01(\v2\x1a.warden.service.v1.WebhookR\awebhook\"p\n" +
+	"\x13ListWebhooksRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\rH\x00R\x04page\x88\x01\x01\x12)\n" +
+	"\tpage_size\x18\x02 \x01(\rB\a\xbaH\x04*\x02\x18dH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"d\n" +
+	"\x14ListWebhooksResponse\x126\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x1a.warden.service.v1.WebhookR\bwebhooks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xed\x02\n" +
+	"\x14UpdateWebhookRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x02id\x12#\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12\"\n" +
+	"\x03url\x18\x03 \x01(\tB\v\xbaH\br\x06\x18\x80\x10\x88\x01\x01H\x01R\x03url\x88\x01\x01\x12%\n" +
+	"\x06secret\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x02R\x06secret\x88\x01\x01\x12D\n" +
+	"\vevent_types\x18\x05 \x03(\x0e2#.warden.service.v1.WebhookEventTypeR\n" +
+	"eventTypes\x12\x1d\n" +
+	"\aenabled\x18\x06 \x01(\bH\x03R\aenabled\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x04R\vdescription\x88\x01\x01B\a\n" +
+	"\x05_nameB\x06\n" +
+	"\x04_urlB\t\n" +
+	"\a_secretB\n" +
+	"\n" +
+	"\b_enabledB\x0e\n" +
+	"\f_description\"M\n" +
+	"\x15UpdateWebhookResponse\x124\n" +
+	"\awebhook\x18\x01 \x01(\v2\x1a.warden.service.v1.WebhookR\awebhook\"/\n" +
+	"\x14DeleteWebhookRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x02id\"\xda\x04\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\rR\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12B\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\x0e2#.warden.service.v1.WebhookEventTypeR\teventType\x129\n" +
+	"\x06status\x18\x05 \x01(\x0e2!.warden.service.v1.DeliveryStatusR\x06status\x12\x18\n" +
+	"\apayload\x18\x06 \x01(\tR\apayload\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12(\n" +
+	"\rresponse_code\x18\b \x01(\x05H\x00R\fresponseCode\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12G\n" +
+	"\x0fnext_attempt_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x01R\rnextAttemptAt\x88\x01\x01\x12B\n" +
+	"\fdelivered_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vdeliveredAt\x88\x01\x01\x12;\n" +
+	"\vcreate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTimeB\x10\n" +
+	"\x0e_response_codeB\x12\n" +
+	"\x10_next_attempt_atB\x0f\n" +
+	"\r_delivered_at\"\xec\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12&\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\twebhookId\x12>\n" +
+	"\x06status\x18\x02 \x01(\x0e2!.warden.service.v1.DeliveryStatusH\x00R\x06status\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x03 \x01(\rH\x01R\x04page\x88\x01\x01\x12)\n" +
+	"\tpage_size\x18\x04 \x01(\rB\a\xbaH\x04*\x02\x18dH\x02R\bpageSize\x88\x01\x01B\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"y\n" +
+	"\x1dListWebhookDeliveriesResponse\x12B\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\".warden.service.v1.WebhookDeliveryR\n" +
+	"deliveries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"C\n" +
+	"\x17RedeliverWebhookRequest\x12(\n" +
+	"\vdelivery_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\n" +
+	"deliveryId\"Z\n" +
+	"\x18RedeliverWebhookResponse\x12>\n" +
+	"\bdelivery\x18\x01 \x01(\v2\".warden.service.v1.WebhookDeliveryR\bdelivery*\xa2\x02\n" +
+	"\x10WebhookEventType\x12\"\n" +
+	"\x1eWEBHOOK_EVENT_TYPE_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"WEBHOOK_EVENT_TYPE_SECRET_EXPIRING\x10\x01\x12)\n" +
+	"%WEBHOOK_EVENT_TYPE_PERMISSION_GRANTED\x10\x02\x12&\n" +
+	"\"WEBHOOK_EVENT_TYPE_IMPORT_FINISHED\x10\x03\x12'\n" +
+	"#WEBHOOK_EVENT_TYPE_BACKUP_COMPLETED\x10\x04\x12\"\n" +
+	"\x1eWEBHOOK_EVENT_TYPE_SECRET_READ\x10\x05\x12\"\n" +
+	"\x1eWEBHOOK_EVENT_TYPE_CANARY_READ\x10\x06*\x89\x01\n" +
+	"\x0eDeliveryStatus\x12\x1f\n" +
+	"\x1bDELIVERY_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17DELIVERY_STATUS_PENDING\x10\x01\x12\x1d\n" +
+	"\x19DELIVERY_STATUS_DELIVERED\x10\x02\x12\x1a\n" +
+	"\x16DELIVERY_STATUS_FAILED\x10\x032\xc5\a\n" +
+	"\x14WardenWebhookService\x12{\n" +
+	"\rCreateWebhook\x12'.warden.service.v1.CreateWebhookRequest\x1a(.warden.service.v1.CreateWebhookResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/webhooks\x12t\n" +
+	"\n" +
+	"GetWebhook\x12$.warden.service.v1.GetWebhookRequest\x1a%.warden.service.v1.GetWebhookResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/webhooks/{id}\x12u\n" +
+	"\fListWebhooks\x12&.warden.service.v1.ListWebhooksRequest\x1a'.warden.service.v1.ListWebhooksResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/webhooks\x12\x80\x01\n" +
+	"\rUpdateWebhook\x12'.warden.service.v1.UpdateWebhookRequest\x1a(.warden.service.v1.UpdateWebhookResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v1/webhooks/{id}\x12k\n" +
+	"\rDeleteWebhook\x12'.warden.service.v1.DeleteWebhookRequest\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/webhooks/{id}\x12\xa8\x01\n" +
+	"\x15ListWebhookDeliveries\x12/.warden.service.v1.ListWebhookDeliveriesRequest\x1a0.warden.service.v1.ListWebhookDeliveriesResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/webhooks/{webhook_id}/deliveries\x12\xa7\x01\n" +
+	"\x10RedeliverWebhook\x12*.warden.service.v1.RedeliverWebhookRequest\x1a+.warden.service.v1.RedeliverWebhookResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//v1/webhooks/deliveries/{delivery_id}/redeliverB\xd4\x01\n" +
+	"\x15com.warden.service.v1B\fWebhookProtoP\x01ZGgithub.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1;wardenpb\xa2\x02\x03WSX\xaa\x02\x11Warden.Service.V1\xca\x02\x11Warden\\Service\\V1\xe2\x02\x1dWarden\\Service\\V1\\GPBMetadata\xea\x02\x13Warden::Service::V1b\x06proto3"
+
+var (
+	file_warden_service_v1_webhook_proto_rawDescOnce sync.Once
+	file_warden_service_v1_webhook_proto_rawDescData []byte
+)
+
+func file_warden_service_v1_webhook_proto_rawDescGZIP() []byte {
+	file_warden_service_v1_webhook_proto_rawDescOnce.Do(func() {
+		file_warden_service_v1_webhook_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warden_service_v1_webhook_proto_rawDesc), len(file_warden_service_v1_webhook_proto_rawDesc)))
+	})
+	return file_warden_service_v1_webhook_proto_rawDescData
+}
+
+var file_warden_service_v1_webhook_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_warden_service_v1_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_warden_service_v1_webhook_proto_goTypes = []any{
+	(WebhookEventType)(0),                 // 0: warden.service.v1.WebhookEventType
+	(DeliveryStatus)(0),                   // 1: warden.service.v1.DeliveryStatus
+	(*Webhook)(nil),                       // 2: warden.service.v1.Webhook
+	(*CreateWebhookRequest)(nil),          // 3: warden.service.v1.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),         // 4: warden.service.v1.CreateWebhookResponse
+	(*GetWebhookRequest)(nil),             // 5: warden.service.v1.GetWebhookRequest
+	(*GetWebhookResponse)(nil),            // 6: warden.service.v1.GetWebhookResponse
+	(*ListWebhooksRequest)(nil),           // 7: warden.service.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),          // 8: warden.service.v1.ListWebhooksResponse
+	(*UpdateWebhookRequest)(nil),          // 9: warden.service.v1.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),         // 10: warden.service.v1.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),          // 11: warden.service.v1.DeleteWebhookRequest
+	(*WebhookDelivery)(nil),               // 12: warden.service.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),  // 13: warden.service.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil), // 14: warden.service.v1.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),       // 15: warden.service.v1.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),      // 16: warden.service.v1.RedeliverWebhookResponse
+	(*timestamppb.Timestamp)(nil),         // 17: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 18: google.protobuf.Empty
+}
+var file_warden_service_v1_webhook_proto_depIdxs = []int32{
+	0,  // 0: warden.service.v1.Webhook.event_types:type_name -> warden.service.v1.WebhookEventType
+	17, // 1: warden.service.v1.Webhook.create_time:type_name -> google.protobuf.Timestamp
+	17, // 2: warden.service.v1.Webhook.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 3: warden.service.v1.CreateWebhookRequest.event_types:type_name -> warden.service.v1.WebhookEventType
+	2,  // 4: warden.service.v1.CreateWebhookResponse.webhook:type_name -> warden.service.v1.Webhook
+	2,  // 5: warden.service.v1.GetWebhookResponse.webhook:type_name -> warden.service.v1.Webhook
+	2,  // 6: warden.service.v1.ListWebhooksResponse.webhooks:type_name -> warden.service.v1.Webhook
+	0,  // 7: warden.service.v1.UpdateWebhookRequest.event_types:type_name -> warden.service.v1.WebhookEventType
+	2,  // 8: warden.service.v1.UpdateWebhookResponse.webhook:type_name -> warden.service.v1.Webhook
+	0,  // 9: warden.service.v1.WebhookDelivery.event_type:type_name -> warden.service.v1.WebhookEventType
+	1,  // 10: warden.service.v1.WebhookDelivery.status:type_name -> warden.service.v1.DeliveryStatus
+	17, // 11: warden.service.v1.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	17, // 12: warden.service.v1.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	17, // 13: warden.service.v1.WebhookDelivery.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 14: warden.service.v1.ListWebhookDeliveriesRequest.status:type_name -> warden.service.v1.DeliveryStatus
+	12, // 15: warden.service.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> warden.service.v1.WebhookDelivery
+	12, // 16: warden.service.v1.RedeliverWebhookResponse.delivery:type_name -> warden.service.v1.WebhookDelivery
+	3,  // 17: warden.service.v1.WardenWebhookService.CreateWebhook:input_type -> warden.service.v1.CreateWebhookRequest
+	5,  // 18: warden.service.v1.WardenWebhookService.GetWebhook:input_type -> warden.service.v1.GetWebhookRequest
+	7,  // 19: warden.service.v1.WardenWebhookService.ListWebhooks:input_type -> warden.service.v1.ListWebhooksRequest
+	9,  // 20: warden.service.v1.WardenWebhookService.UpdateWebhook:input_type -> warden.service.v1.UpdateWebhookRequest
+	11, // 21: warden.service.v1.WardenWebhookService.DeleteWebhook:input_type -> warden.service.v1.DeleteWebhookRequest
+	13, // 22: warden.service.v1.WardenWebhookService.ListWebhookDeliveries:input_type -> warden.service.v1.ListWebhookDeliveriesRequest
+	15, // 23: warden.service.v1.WardenWebhookService.RedeliverWebhook:input_type -> warden.service.v1.RedeliverWebhookRequest
+	4,  // 24: warden.service.v1.WardenWebhookService.CreateWebhook:output_type -> warden.service.v1.CreateWebhookResponse
+	6,  // 25: warden.service.v1.WardenWebhookService.GetWebhook:output_type -> warden.service.v1.GetWebhookResponse
+	8,  // 26: warden.service.v1.WardenWebhookService.ListWebhooks:output_type -> warden.service.v1.ListWebhooksResponse
+	10, // 27: warden.service.v1.WardenWebhookService.UpdateWebhook:output_type -> warden.service.v1.UpdateWebhookResponse
+	18, // 28: warden.service.v1.WardenWebhookService.DeleteWebhook:output_type -> google.protobuf.Empty
+	14, // 29: warden.service.v1.WardenWebhookService.ListWebhookDeliveries:output_type -> warden.service.v1.ListWebhookDeliveriesResponse
+	16, // 30: warden.service.v1.WardenWebhookService.RedeliverWebhook:output_type -> warden.service.v1.RedeliverWebhookResponse
+	24, // [24:31] is the sub-list for method output_type
+	17, // [17:24] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_warden_service_v1_webhook_proto_init() }
+func file_warden_service_v1_webhook_proto_init() {
+	if File_warden_service_v1_webhook_proto != nil {
+		return
+	}
+	file_warden_service_v1_webhook_proto_msgTypes[0].OneofWrappers = []any{}
+	file_warden_service_v1_webhook_proto_msgTypes[5].OneofWrappers = []any{}
+	file_warden_service_v1_webhook_proto_msgTypes[7].OneofWrappers = []any{}
+	file_warden_service_v1_webhook_proto_msgTypes[10].OneofWrappers = []any{}
+	file_warden_service_v1_webhook_proto_msgTypes[11].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warden_service_v1_webhook_proto_rawDesc), len(file_warden_service_v1_webhook_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warden_service_v1_webhook_proto_goTypes,
+		DependencyIndexes: file_warden_service_v1_webhook_proto_depIdxs,
+		EnumInfos:         file_warden_service_v1_webhook_proto_enumTypes,
+		MessageInfos:      file_warden_service_v1_webhook_proto_msgTypes,
+	}.Build()
+	File_warden_service_v1_webhook_proto = out.File
+	file_warden_service_v1_webhook_proto_goTypes = nil
+	file_warden_service_v1_webhook_proto_depIdxs = nil
+}
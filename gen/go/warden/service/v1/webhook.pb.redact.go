@@ -0,0 +1,350 @@
+// Code generated by protoc-gen-redact. DO NOT EDIT.
+// source: warden/service/v1/webhook.proto
+
+package wardenpb
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	context "context"
+	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	annotations "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ grpc.Server
+	_ context.Context
+	_ redact.Redactor
+	_ codes.Code
+	_ status.Status
+	_ validate.Rule
+	_ annotations.FieldBehavior
+	_ emptypb.Empty
+	_ timestamppb.Timestamp
+)
+
+// RegisterRedactedWardenWebhookServiceServer wraps the WardenWebhookServiceServer with the redacted server and registers the service in GRPC
+func RegisterRedactedWardenWebhookServiceServer(s grpc.ServiceRegistrar, srv WardenWebhookServiceServer, bypass redact.Bypass) {
+	RegisterWardenWebhookServiceServer(s, RedactedWardenWebhookServiceServer(srv, bypass))
+}
+
+func RedactedWardenWebhookServiceServer(srv WardenWebhookServiceServer, bypass redact.Bypass) WardenWebhookServiceServer {
+	if bypass == nil {
+		bypass = redact.Falsy
+	}
+	return &redactedWardenWebhookServiceServer{srv: srv, bypass: bypass}
+}
+
+type redactedWardenWebhookServiceServer struct {
+	UnsafeWardenWebhookServiceServer
+	srv    WardenWebhookServiceServer
+	bypass redact.Bypass
+}
+
+// CreateWebhook is the redacted wrapper for the actual WardenWebhookServiceServer.CreateWebhook method
+// Unary RPC
+func (s *redactedWardenWebhookServiceServer) CreateWebhook(ctx context.Context, in *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	res, err := s.srv.CreateWebhook(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetWebhook is the redacted wrapper for the actual WardenWebhookServiceServer.GetWebhook method
+// Unary RPC
+func (s *redactedWardenWebhookServiceServer) GetWebhook(ctx context.Context, in *GetWebhookRequest) (*GetWebhookResponse, error) {
+	res, err := s.srv.GetWebhook(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListWebhooks is the redacted wrapper for the actual WardenWebhookServiceServer.ListWebhooks method
+// Unary RPC
+func (s *redactedWardenWebhookServiceServer) ListWebhooks(ctx context.Context, in *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	res, err := s.srv.ListWebhooks(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// UpdateWebhook is the redacted wrapper for the actual WardenWebhookServiceServer.UpdateWebhook method
+// Unary RPC
+func (s *redactedWardenWebhookServiceServer) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest) (*UpdateWebhookResponse, error) {
+	res, err := s.srv.UpdateWebhook(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// DeleteWebhook is the redacted wrapper for the actual WardenWebhookServiceServer.DeleteWebhook method
+// Unary RPC
+func (s *redactedWardenWebhookServiceServer) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.DeleteWebhook(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListWebhookDeliveries is the redacted wrapper for the actual WardenWebhookServiceServer.ListWebhookDeliveries method
+// Unary RPC
+func (s *redactedWardenWebhookServiceServer) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	res, err := s.srv.ListWebhookDeliveries(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RedeliverWebhook is the redacted wrapper for the actual WardenWebhookServiceServer.RedeliverWebhook method
+// Unary RPC
+func (s *redactedWardenWebhookServiceServer) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error) {
+	res, err := s.srv.RedeliverWebhook(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for Webhook
+func (x *Webhook) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TenantId
+
+	// Safe field: Name
+
+	// Safe field: Url
+
+	// Safe field: HasSecret
+
+	// Safe field: EventTypes
+
+	// Safe field: Enabled
+
+	// Safe field: Description
+
+	// Safe field: CreateTime
+
+	// Safe field: UpdateTime
+
+	// Safe field: CreatedBy
+	return x.String()
+}
+
+// Redact method implementation for CreateWebhookRequest
+func (x *CreateWebhookRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Name
+
+	// Safe field: Url
+
+	// Safe field: Secret
+
+	// Safe field: EventTypes
+
+	// Safe field: Enabled
+
+	// Safe field: Description
+	return x.String()
+}
+
+// Redact method implementation for CreateWebhookResponse
+func (x *CreateWebhookResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Webhook
+	return x.String()
+}
+
+// Redact method implementation for GetWebhookRequest
+func (x *GetWebhookRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for GetWebhookResponse
+func (x *GetWebhookResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Webhook
+	return x.String()
+}
+
+// Redact method implementation for ListWebhooksRequest
+func (x *ListWebhooksRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListWebhooksResponse
+func (x *ListWebhooksResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Webhooks
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for UpdateWebhookRequest
+func (x *UpdateWebhookRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Name
+
+	// Safe field: Url
+
+	// Safe field: Secret
+
+	// Safe field: EventTypes
+
+	// Safe field: Enabled
+
+	// Safe field: Description
+	return x.String()
+}
+
+// Redact method implementation for UpdateWebhookResponse
+func (x *UpdateWebhookResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Webhook
+	return x.String()
+}
+
+// Redact method implementation for DeleteWebhookRequest
+func (x *DeleteWebhookRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for WebhookDelivery
+func (x *WebhookDelivery) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: WebhookId
+
+	// Safe field: EventId
+
+	// Safe field: EventType
+
+	// Safe field: Status
+
+	// Safe field: Payload
+
+	// Safe field: Attempts
+
+	// Safe field: ResponseCode
+
+	// Safe field: LastError
+
+	// Safe field: NextAttemptAt
+
+	// Safe field: DeliveredAt
+
+	// Safe field: CreateTime
+	return x.String()
+}
+
+// Redact method implementation for ListWebhookDeliveriesRequest
+func (x *ListWebhookDeliveriesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: WebhookId
+
+	// Safe field: Status
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListWebhookDeliveriesResponse
+func (x *ListWebhookDeliveriesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Deliveries
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for RedeliverWebhookRequest
+func (x *RedeliverWebhookRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: DeliveryId
+	return x.String()
+}
+
+// Redact method implementation for RedeliverWebhookResponse
+func (x *RedeliverWebhookResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Delivery
+	return x.String()
+}
@@ -0,0 +1,337 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             (unknown)
+// source: warden/service/v1/webhook.proto
+
+package wardenpb
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationWardenWebhookServiceCreateWebhook = "/warden.service.v1.WardenWebhookService/CreateWebhook"
+const OperationWardenWebhookServiceDeleteWebhook = "/warden.service.v1.WardenWebhookService/DeleteWebhook"
+const OperationWardenWebhookServiceGetWebhook = "/warden.service.v1.WardenWebhookService/GetWebhook"
+const OperationWardenWebhookServiceListWebhookDeliveries = "/warden.service.v1.WardenWebhookService/ListWebhookDeliveries"
+const OperationWardenWebhookServiceListWebhooks = "/warden.service.v1.WardenWebhookService/ListWebhooks"
+const OperationWardenWebhookServiceRedeliverWebhook = "/warden.service.v1.WardenWebhookService/RedeliverWebhook"
+const OperationWardenWebhookServiceUpdateWebhook = "/warden.service.v1.WardenWebhookService/UpdateWebhook"
+
+type WardenWebhookServiceHTTPServer interface {
+	// CreateWebhook Register a webhook
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	// DeleteWebhook Delete a webhook and its delivery log
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error)
+	// GetWebhook Get a webhook by ID
+	GetWebhook(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error)
+	// ListWebhookDeliveries List the delivery log of a webhook
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// ListWebhooks List the webhooks of the caller's tenant
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// RedeliverWebhook Requeue a delivery for immediate sending
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error)
+	// UpdateWebhook Update a webhook
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+}
+
+func RegisterWardenWebhookServiceHTTPServer(s *http.Server, srv WardenWebhookServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/webhooks", _WardenWebhookService_CreateWebhook0_HTTP_Handler(srv))
+	r.GET("/v1/webhooks/{id}", _WardenWebhookService_GetWebhook0_HTTP_Handler(srv))
+	r.GET("/v1/webhooks", _WardenWebhookService_ListWebhooks0_HTTP_Handler(srv))
+	r.PUT("/v1/webhooks/{id}", _WardenWebhookService_UpdateWebhook0_HTTP_Handler(srv))
+	r.DELETE("/v1/webhooks/{id}", _WardenWebhookService_DeleteWebhook0_HTTP_Handler(srv))
+	r.GET("/v1/webhooks/{webhook_id}/deliveries", _WardenWebhookService_ListWebhookDeliveries0_HTTP_Handler(srv))
+	r.POST("/v1/webhooks/deliveries/{delivery_id}/redeliver", _WardenWebhookService_RedeliverWebhook0_HTTP_Handler(srv))
+}
+
+func _WardenWebhookService_CreateWebhook0_HTTP_Handler(srv WardenWebhookServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateWebhookRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenWebhookServiceCreateWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateWebhook(ctx, req.(*CreateWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateWebhookResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenWebhookService_GetWebhook0_HTTP_Handler(srv WardenWebhookServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetWebhookRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenWebhookServiceGetWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetWebhook(ctx, req.(*GetWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetWebhookResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenWebhookService_ListWebhooks0_HTTP_Handler(srv WardenWebhookServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWebhooksRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenWebhookServiceListWebhooks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWebhooks(ctx, req.(*ListWebhooksRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListWebhooksResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenWebhookService_UpdateWebhook0_HTTP_Handler(srv WardenWebhookServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateWebhookRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenWebhookServiceUpdateWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateWebhookResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenWebhookService_DeleteWebhook0_HTTP_Handler(srv WardenWebhookServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteWebhookRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenWebhookServiceDeleteWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenWebhookService_ListWebhookDeliveries0_HTTP_Handler(srv WardenWebhookServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWebhookDeliveriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenWebhookServiceListWebhookDeliveries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListWebhookDeliveriesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _WardenWebhookService_RedeliverWebhook0_HTTP_Handler(srv WardenWebhookServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RedeliverWebhookRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationWardenWebhookServiceRedeliverWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RedeliverWebhookResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type WardenWebhookServiceHTTPClient interface {
+	// CreateWebhook Register a webhook
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest, opts ...http.CallOption) (rsp *CreateWebhookResponse, err error)
+	// DeleteWebhook Delete a webhook and its delivery log
+	DeleteWebhook(ctx context.Context, req *DeleteWebhookRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// GetWebhook Get a webhook by ID
+	GetWebhook(ctx context.Context, req *GetWebhookRequest, opts ...http.CallOption) (rsp *GetWebhookResponse, err error)
+	// ListWebhookDeliveries List the delivery log of a webhook
+	ListWebhookDeliveries(ctx context.Context, req *ListWebhookDeliveriesRequest, opts ...http.CallOption) (rsp *ListWebhookDeliveriesResponse, err error)
+	// ListWebhooks List the webhooks of the caller's tenant
+	ListWebhooks(ctx context.Context, req *ListWebhooksRequest, opts ...http.CallOption) (rsp *ListWebhooksResponse, err error)
+	// RedeliverWebhook Requeue a delivery for immediate sending
+	RedeliverWebhook(ctx context.Context, req *RedeliverWebhookRequest, opts ...http.CallOption) (rsp *RedeliverWebhookResponse, err error)
+	// UpdateWebhook Update a webhook
+	UpdateWebhook(ctx context.Context, req *UpdateWebhookRequest, opts ...http.CallOption) (rsp *UpdateWebhookResponse, err error)
+}
+
+type WardenWebhookServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewWardenWebhookServiceHTTPClient(client *http.Client) WardenWebhookServiceHTTPClient {
+	return &WardenWebhookServiceHTTPClientImpl{client}
+}
+
+// CreateWebhook Register a webhook
+func (c *WardenWebhookServiceHTTPClientImpl) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...http.CallOption) (*CreateWebhookResponse, error) {
+	var out CreateWebhookResponse
+	pattern := "/v1/webhooks"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenWebhookServiceCreateWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteWebhook Delete a webhook and its delivery log
+func (c *WardenWebhookServiceHTTPClientImpl) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/webhooks/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenWebhookServiceDeleteWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetWebhook Get a webhook by ID
+func (c *WardenWebhookServiceHTTPClientImpl) GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...http.CallOption) (*GetWebhookResponse, error) {
+	var out GetWebhookResponse
+	pattern := "/v1/webhooks/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenWebhookServiceGetWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListWebhookDeliveries List the delivery log of a webhook
+func (c *WardenWebhookServiceHTTPClientImpl) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...http.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	var out ListWebhookDeliveriesResponse
+	pattern := "/v1/webhooks/{webhook_id}/deliveries"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenWebhookServiceListWebhookDeliveries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListWebhooks List the webhooks of the caller's tenant
+func (c *WardenWebhookServiceHTTPClientImpl) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...http.CallOption) (*ListWebhooksResponse, error) {
+	var out ListWebhooksResponse
+	pattern := "/v1/webhooks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationWardenWebhookServiceListWebhooks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RedeliverWebhook Requeue a delivery for immediate sending
+func (c *WardenWebhookServiceHTTPClientImpl) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...http.CallOption) (*RedeliverWebhookResponse, error) {
+	var out RedeliverWebhookResponse
+	pattern := "/v1/webhooks/deliveries/{delivery_id}/redeliver"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenWebhookServiceRedeliverWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateWebhook Update a webhook
+func (c *WardenWebhookServiceHTTPClientImpl) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...http.CallOption) (*UpdateWebhookResponse, error) {
+	var out UpdateWebhookResponse
+	pattern := "/v1/webhooks/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationWardenWebhookServiceUpdateWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,235 @@
+// Package auditevent lets service handlers attach semantic domain events
+// (secret.created, secret.password_read, ...) to the audit log entry of the
+// current request. The audit middleware only knows the RPC path; events add
+// what happened and to which resource.
+package auditevent
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+	"github.com/go-tangra/go-tangra-common/middleware/audit"
+)
+
+// Domain event types
+const (
+	SecretCreated         = "secret.created"
+	SecretRead            = "secret.read"
+	SecretUpdated         = "secret.updated"
+	SecretPasswordRead    = "secret.password_read"
+	SecretPasswordPeeked  = "secret.password_peeked"
+	SecretPasswordUpdated = "secret.password_updated"
+	SecretDeleted         = "secret.deleted"
+	SecretMoved           = "secret.moved"
+	SecretVersionRead     = "secret.version_read"
+	SecretVersionRestored = "secret.version_restored"
+	SecretTotpRead        = "secret.totp_read"
+	SecretTotpUpdated     = "secret.totp_updated"
+	SecretTotpDeleted     = "secret.totp_deleted"
+
+	SecretAccessPolicyChanged = "secret.access_policy_changed"
+	SecretCanaryRead          = "secret.canary_read"
+
+	SecretVersionDeleted   = "secret.version_deleted"
+	SecretVersionDestroyed = "secret.version_destroyed"
+	SecretVersionUndeleted = "secret.version_undeleted"
+
+	SecretVersionPinned      = "secret.version_pinned"
+	SecretVersionPinReleased = "secret.version_pin_released"
+
+	FolderCreated  = "folder.created"
+	FolderUpdated  = "folder.updated"
+	FolderDeleted  = "folder.deleted"
+	FolderMoved    = "folder.moved"
+	FolderRestored = "folder.restored"
+
+	FolderAccessPolicyChanged = "folder.access_policy_changed"
+
+	CollectionCreated        = "collection.created"
+	CollectionUpdated        = "collection.updated"
+	CollectionDeleted        = "collection.deleted"
+	CollectionSecretsAdded   = "collection.secrets_added"
+	CollectionSecretsRemoved = "collection.secrets_removed"
+
+	FolderLegalHoldPlaced = "folder.legal_hold_placed"
+	FolderLegalHoldLifted = "folder.legal_hold_lifted"
+
+	PermissionGranted = "permission.granted"
+	PermissionRevoked = "permission.revoked"
+
+	EmergencyAccessCreated   = "emergency_access.created"
+	EmergencyAccessRequested = "emergency_access.requested"
+	EmergencyAccessRejected  = "emergency_access.rejected"
+	EmergencyAccessGranted   = "emergency_access.granted"
+	EmergencyAccessDeleted   = "emergency_access.deleted"
+
+	DeletionRequested = "deletion_request.created"
+	DeletionApproved  = "deletion_request.approved"
+	DeletionRejected  = "deletion_request.rejected"
+	DeletionWithdrawn = "deletion_request.withdrawn"
+
+	TenantImpersonated = "tenant.impersonated"
+	TenantPurged       = "tenant.purged"
+
+	GeoRestrictionDenied     = "geo_restriction.denied"
+	GeoRestrictionOverridden = "geo_restriction.overridden"
+)
+
+// Resource types
+const (
+	ResourceSecret     = "secret"
+	ResourceFolder     = "folder"
+	ResourceCollection = "collection"
+	ResourceTenant     = "tenant"
+)
+
+// Metadata keys written into the audit entry
+const (
+	MetadataEvent        = "event"
+	MetadataResourceType = "resource_type"
+	MetadataResourceID   = "resource_id"
+	MetadataEvents       = "events"
+	MetadataUserID       = "user_id"
+	MetadataImpersonator = "impersonator_tenant_id"
+)
+
+// Event is a semantic event raised while handling a request.
+type Event struct {
+	Type         string            `json:"type"`
+	ResourceType string            `json:"resource_type"`
+	ResourceID   string            `json:"resource_id"`
+	Attrs        map[string]string `json:"attrs,omitempty"`
+}
+
+type recorderKey struct{}
+
+type impersonationKey struct{}
+
+type emitterKey struct{}
+
+type recorder struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+// Middleware installs an event recorder on the request context. It must run
+// outside the audit middleware so the audit writer sees the recorded events.
+func Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			return handler(context.WithValue(ctx, recorderKey{}, &recorder{}), req)
+		}
+	}
+}
+
+// Record adds a domain event to the current request. attrs are key/value
+// pairs. It is a no-op when the context carries no recorder.
+func Record(ctx context.Context, eventType, resourceType, resourceID string, attrs ...string) {
+	rec, ok := ctx.Value(recorderKey{}).(*recorder)
+	if !ok {
+		return
+	}
+
+	ev := newEvent(eventType, resourceType, resourceID, attrs)
+	rec.mu.Lock()
+	rec.events = append(rec.events, ev)
+	rec.mu.Unlock()
+}
+
+func newEvent(eventType, resourceType, resourceID string, attrs []string) Event {
+	ev := Event{Type: eventType, ResourceType: resourceType, ResourceID: resourceID}
+	if len(attrs) > 1 {
+		ev.Attrs = make(map[string]string, len(attrs)/2)
+		for i := 0; i+1 < len(attrs); i += 2 {
+			ev.Attrs[attrs[i]] = attrs[i+1]
+		}
+	}
+	return ev
+}
+
+// Emitter writes an event as an audit entry of its own
+type Emitter func(ctx context.Context, ev Event)
+
+// WithEmitter installs the emitter Emit writes through. Long-running calls
+// such as streams use it, so each event gets its own entry instead of all
+// of them ending up in the entry of the call.
+func WithEmitter(ctx context.Context, emit Emitter) context.Context {
+	return context.WithValue(ctx, emitterKey{}, emit)
+}
+
+// Emit writes a domain event as an audit entry of its own through the
+// emitter of the context. Without an emitter it is recorded like Record.
+func Emit(ctx context.Context, eventType, resourceType, resourceID string, attrs ...string) {
+	emit, ok := ctx.Value(emitterKey{}).(Emitter)
+	if !ok {
+		Record(ctx, eventType, resourceType, resourceID, attrs...)
+		return
+	}
+	emit(ctx, newEvent(eventType, resourceType, resourceID, attrs))
+}
+
+// WithEvent returns a context whose recorder holds only ev, for annotating
+// the entry an emitter writes
+func WithEvent(ctx context.Context, ev Event) context.Context {
+	return context.WithValue(ctx, recorderKey{}, &recorder{events: []Event{ev}})
+}
+
+// WithImpersonation marks the request as run by a platform admin from
+// originalTenantID on behalf of the tenant in the request metadata.
+func WithImpersonation(ctx context.Context, originalTenantID uint32) context.Context {
+	return context.WithValue(ctx, impersonationKey{}, originalTenantID)
+}
+
+// Impersonator returns the admin's own tenant when the request impersonates
+// another tenant.
+func Impersonator(ctx context.Context) (uint32, bool) {
+	tenantID, ok := ctx.Value(impersonationKey{}).(uint32)
+	return tenantID, ok
+}
+
+// Events returns the events recorded for the current request.
+func Events(ctx context.Context) []Event {
+	rec, ok := ctx.Value(recorderKey{}).(*recorder)
+	if !ok {
+		return nil
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return append([]Event(nil), rec.events...)
+}
+
+// Annotate copies the caller, any impersonation and the recorded events into
+// the audit entry metadata. The first event is flattened into indexed keys;
+// all events are kept as JSON.
+func Annotate(ctx context.Context, entry *audit.AuditLogEntry) {
+	userID := grpcx.GetUserIDFromContext(ctx)
+	events := Events(ctx)
+	impersonator, impersonated := Impersonator(ctx)
+	if userID == "" && len(events) == 0 && !impersonated {
+		return
+	}
+	if entry.Metadata == nil {
+		entry.Metadata = make(map[string]string, 6)
+	}
+	if userID != "" {
+		entry.Metadata[MetadataUserID] = userID
+	}
+	if impersonated {
+		entry.Metadata[MetadataImpersonator] = strconv.FormatUint(uint64(impersonator), 10)
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	entry.Metadata[MetadataEvent] = events[0].Type
+	entry.Metadata[MetadataResourceType] = events[0].ResourceType
+	entry.Metadata[MetadataResourceID] = events[0].ResourceID
+	if b, err := json.Marshal(events); err == nil {
+		entry.Metadata[MetadataEvents] = string(b)
+	}
+}
@@ -0,0 +1,315 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/metadata"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/grpc/credentials"
+	grpcMD "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// Authentication modes selected with AUTH_MODE
+const (
+	// ModeMetadata trusts the x-md-global-* identity headers as sent
+	ModeMetadata = "metadata"
+	// ModeJWT requires a bearer token and takes the identity from its claims
+	ModeJWT = "jwt"
+	// ModeMTLSOrJWT uses the bearer token when present and otherwise only
+	// trusts the identity headers on connections with a verified client certificate
+	ModeMTLSOrJWT = "mtls_or_jwt"
+)
+
+// MDAuthTime carries when the caller last authenticated interactively, in
+// Unix seconds. Sensitive reads require it to be recent.
+const MDAuthTime = "x-md-global-auth-time"
+
+const (
+	defaultJWKSRefresh = 10 * time.Minute
+	jwtLeeway          = 30 * time.Second
+)
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+var publicOperations = map[string]bool{
+	"/grpc.health.v1.Health/Check": true,
+	"/grpc.health.v1.Health/Watch": true,
+}
+
+// Authenticator establishes the caller's identity before the handlers read
+// it from the x-md-global-* metadata. In the jwt modes the identity headers
+// are replaced with the claims of a bearer token issued by the admin gateway
+// and verified against its JWKS, so a client that reaches Warden directly
+// cannot impersonate another tenant or user by setting the headers itself.
+type Authenticator struct {
+	log  *log.Helper
+	mode string
+
+	issuer   string
+	audience string
+
+	tenantClaim   string
+	userClaim     string
+	usernameClaim string
+	rolesClaim    string
+	authTimeClaim string
+
+	jwks *jwksCache
+}
+
+// NewAuthenticator creates the authenticator from the AUTH_* environment
+// variables. AUTH_JWKS_URL is required unless AUTH_MODE is metadata.
+func NewAuthenticator(ctx *bootstrap.Context) (*Authenticator, error) {
+	l := ctx.NewLoggerHelper("warden/authn")
+
+	a := &Authenticator{
+		log:           l,
+		mode:          ModeMetadata,
+		issuer:        os.Getenv("AUTH_JWT_ISSUER"),
+		audience:      os.Getenv("AUTH_JWT_AUDIENCE"),
+		tenantClaim:   envOr("AUTH_JWT_TENANT_CLAIM", "tenant_id"),
+		userClaim:     envOr("AUTH_JWT_USER_CLAIM", "sub"),
+		usernameClaim: envOr("AUTH_JWT_USERNAME_CLAIM", "username"),
+		rolesClaim:    envOr("AUTH_JWT_ROLES_CLAIM", "roles"),
+		authTimeClaim: envOr("AUTH_JWT_AUTH_TIME_CLAIM", "auth_time"),
+	}
+
+	if v := os.Getenv("AUTH_MODE"); v != "" {
+		a.mode = strings.ToLower(v)
+	}
+
+	switch a.mode {
+	case ModeMetadata:
+		l.Info("Client authentication mode: metadata")
+		return a, nil
+	case ModeJWT, ModeMTLSOrJWT:
+	default:
+		return nil, fmt.Errorf("invalid AUTH_MODE %q: expected %s, %s or %s", a.mode, ModeMetadata, ModeJWT, ModeMTLSOrJWT)
+	}
+
+	jwksURL := os.Getenv("AUTH_JWKS_URL")
+	if jwksURL == "" {
+		return nil, fmt.Errorf("AUTH_JWKS_URL is required when AUTH_MODE is %s", a.mode)
+	}
+
+	refresh := defaultJWKSRefresh
+	if v := os.Getenv("AUTH_JWKS_REFRESH"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			refresh = d
+		} else {
+			l.Warnf("Invalid AUTH_JWKS_REFRESH %q, using %s", v, refresh)
+		}
+	}
+	a.jwks = newJWKSCache(jwksURL, refresh)
+
+	if a.issuer == "" {
+		l.Warn("AUTH_JWT_ISSUER not set, token issuer is not checked")
+	}
+	if a.audience == "" {
+		l.Warn("AUTH_JWT_AUDIENCE not set, token audience is not checked")
+	}
+
+	l.Infof("Client authentication mode: %s (jwks=%s)", a.mode, jwksURL)
+	return a, nil
+}
+
+// Enabled reports whether requests are authenticated beyond trusting metadata
+func (a *Authenticator) Enabled() bool {
+	return a != nil && a.mode != ModeMetadata
+}
+
+// Middleware authenticates each request and rewrites the identity metadata
+// from the verified token. It must run after metadata.Server.
+func (a *Authenticator) Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || publicOperations[tr.Operation()] {
+				return handler(ctx, req)
+			}
+
+			token := bearerToken(tr.RequestHeader().Get("authorization"))
+			if token == "" {
+				if a.mode == ModeMTLSOrJWT && HasVerifiedClientCert(ctx) {
+					return handler(ctx, req)
+				}
+				return nil, wardenV1.ErrorUnauthorized("authentication required")
+			}
+
+			identity, err := a.verify(ctx, token)
+			if err != nil {
+				a.log.Warnf("Rejected token for %s: %v", tr.Operation(), err)
+				return nil, wardenV1.ErrorInvalidToken("invalid token")
+			}
+
+			return handler(SetIdentity(ctx, identity), req)
+		}
+	}
+}
+
+// verify checks the token signature and registered claims and returns the
+// identity headers derived from its claims
+func (a *Authenticator) verify(ctx context.Context, token string) (map[string]string, error) {
+	tok, err := jwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("parse token: %w", err)
+	}
+	if len(tok.Headers) == 0 {
+		return nil, errors.New("token has no signature header")
+	}
+
+	keys, err := a.jwks.key(ctx, tok.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var registered jwt.Claims
+	var claims map[string]interface{}
+	verified := false
+	for _, key := range keys {
+		if err := tok.Claims(key.Key, &registered, &claims); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.New("signature verification failed")
+	}
+
+	expected := jwt.Expected{Issuer: a.issuer, Time: time.Now()}
+	if a.audience != "" {
+		expected.AnyAudience = jwt.Audience{a.audience}
+	}
+	if err := registered.ValidateWithLeeway(expected, jwtLeeway); err != nil {
+		return nil, err
+	}
+	if registered.Expiry == nil {
+		return nil, errors.New("token has no expiry")
+	}
+
+	identity := map[string]string{
+		grpcx.MDTenantID: claimString(claims[a.tenantClaim]),
+		grpcx.MDUserID:   claimString(claims[a.userClaim]),
+		grpcx.MDUsername: claimString(claims[a.usernameClaim]),
+		grpcx.MDRoles:    claimString(claims[a.rolesClaim]),
+		// Always set so a client cannot send its own auth time with a token
+		MDAuthTime: claimString(claims[a.authTimeClaim]),
+	}
+	if identity[grpcx.MDTenantID] == "" || identity[grpcx.MDUserID] == "" {
+		return nil, fmt.Errorf("token is missing the %s or %s claim", a.tenantClaim, a.userClaim)
+	}
+	return identity, nil
+}
+
+// SetIdentity replaces the given identity headers in the Kratos server
+// metadata, the transport header and the incoming gRPC metadata, so every
+// reader sees the new values and never the ones sent by the client. Empty
+// values remove the header.
+func SetIdentity(ctx context.Context, identity map[string]string) context.Context {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		for key, value := range identity {
+			tr.RequestHeader().Set(key, value)
+		}
+	}
+
+	md, ok := metadata.FromServerContext(ctx)
+	if ok {
+		md = md.Clone()
+	} else {
+		md = metadata.New()
+	}
+	for key, value := range identity {
+		if value == "" {
+			delete(md, key)
+			continue
+		}
+		md.Set(key, value)
+	}
+	ctx = metadata.NewServerContext(ctx, md)
+
+	in, ok := grpcMD.FromIncomingContext(ctx)
+	if ok {
+		in = in.Copy()
+	} else {
+		in = grpcMD.MD{}
+	}
+	for key, value := range identity {
+		if value == "" {
+			in.Delete(key)
+			continue
+		}
+		in.Set(key, value)
+	}
+	return grpcMD.NewIncomingContext(ctx, in)
+}
+
+// HasVerifiedClientCert reports whether the connection presented a client
+// certificate that chains to the configured CA
+func HasVerifiedClientCert(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return false
+	}
+	return len(info.State.VerifiedChains) > 0
+}
+
+func bearerToken(header string) string {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// claimString converts a claim to its header form; arrays are joined with commas
+func claimString(v interface{}) string {
+	switch c := v.(type) {
+	case string:
+		return c
+	case float64:
+		return strconv.FormatFloat(c, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(c)
+	case []interface{}:
+		parts := make([]string, 0, len(c))
+		for _, item := range c {
+			if s := claimString(item); s != "" {
+				parts = append(parts, s)
+			}
+		}
+		return strings.Join(parts, ",")
+	default:
+		return ""
+	}
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
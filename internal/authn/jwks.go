@@ -0,0 +1,149 @@
+package authn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	jwksFetchTimeout = 10 * time.Second
+	// jwksMinRefetch bounds how often an unknown key ID or an unreachable
+	// gateway triggers a refetch
+	jwksMinRefetch = time.Minute
+	jwksMaxBytes   = 1 << 20
+)
+
+// jwksCache holds the gateway's signing keys, refreshed after ttl and when a
+// token names a key ID that is not cached yet. Fetches happen outside the
+// lock, one at a time, and at most once per jwksMinRefetch whether they
+// succeed or not, so an unreachable gateway does not stall every request.
+type jwksCache struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+	group  singleflight.Group
+
+	mu        sync.Mutex
+	keys      *jose.JSONWebKeySet
+	fetchedAt time.Time
+	// attemptedAt and fetchErr are the time and error of the last fetch,
+	// successful or not
+	attemptedAt time.Time
+	fetchErr    error
+}
+
+func newJWKSCache(url string, ttl time.Duration) *jwksCache {
+	return &jwksCache{
+		url:    url,
+		ttl:    ttl,
+		client: &http.Client{Timeout: jwksFetchTimeout},
+	}
+}
+
+// key returns the verification keys for a key ID (all keys when kid is empty)
+func (c *jwksCache) key(ctx context.Context, kid string) ([]jose.JSONWebKey, error) {
+	c.mu.Lock()
+	keys := c.lookup(kid)
+	stale := c.keys == nil || time.Since(c.fetchedAt) > c.ttl
+	backOff := time.Since(c.attemptedAt) < jwksMinRefetch
+	cached, fetchErr := c.keys != nil, c.fetchErr
+	c.mu.Unlock()
+
+	if (!stale && len(keys) > 0) || backOff {
+		if len(keys) > 0 {
+			return keys, nil
+		}
+		if !cached && fetchErr != nil {
+			return nil, fetchErr
+		}
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	// Callers share one fetch, which outlives any one caller's context
+	done := c.group.DoChan("jwks", func() (interface{}, error) {
+		return nil, c.fetch(context.WithoutCancel(ctx))
+	})
+	var err error
+	select {
+	case res := <-done:
+		err = res.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	c.mu.Lock()
+	keys = c.lookup(kid)
+	cached = c.keys != nil
+	c.mu.Unlock()
+
+	if len(keys) > 0 {
+		return keys, nil
+	}
+	// Keep verifying with the previous keys while the gateway is unreachable
+	if err != nil && !cached {
+		return nil, err
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+func (c *jwksCache) lookup(kid string) []jose.JSONWebKey {
+	if c.keys == nil {
+		return nil
+	}
+	if kid == "" {
+		return c.keys.Keys
+	}
+	return c.keys.Key(kid)
+}
+
+// fetch downloads the key set and records the attempt, keeping the previous
+// keys when it fails
+func (c *jwksCache) fetch(ctx context.Context) error {
+	keys, err := c.download(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.attemptedAt = time.Now()
+	c.fetchErr = err
+	if err == nil {
+		c.keys = keys
+		c.fetchedAt = c.attemptedAt
+	}
+	return err
+}
+
+func (c *jwksCache) download(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build JWKS request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch JWKS: unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, jwksMaxBytes))
+	if err != nil {
+		return nil, fmt.Errorf("read JWKS: %w", err)
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(body, &keys); err != nil {
+		return nil, fmt.Errorf("parse JWKS: %w", err)
+	}
+	return &keys, nil
+}
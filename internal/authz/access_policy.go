@@ -0,0 +1,155 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+// AccessPolicy restricts where from and when a secret or folder can be
+// accessed, on top of the permissions granted on it. Empty lists do not
+// restrict. A folder's policy applies to everything below it.
+type AccessPolicy struct {
+	// AllowedCIDRs are the networks requests must come from
+	AllowedCIDRs []string `json:"allowed_cidrs,omitempty"`
+	// Weekdays are the days access is allowed on, 0 (Sunday) to 6 (Saturday)
+	Weekdays []int `json:"weekdays,omitempty"`
+	// TimeWindows are the times of day access is allowed in
+	TimeWindows []TimeWindow `json:"time_windows,omitempty"`
+	// Timezone is the IANA zone of Weekdays and TimeWindows (UTC when empty)
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// TimeWindow is a time of day range in HH:MM, end exclusive. A window whose
+// end is before its start runs past midnight.
+type TimeWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Validate checks that every rule of the policy can be evaluated
+func (p *AccessPolicy) Validate() error {
+	for _, c := range p.AllowedCIDRs {
+		if _, err := netip.ParsePrefix(c); err != nil {
+			return fmt.Errorf("invalid CIDR %q", c)
+		}
+	}
+	for _, d := range p.Weekdays {
+		if d < 0 || d > 6 {
+			return fmt.Errorf("invalid weekday %d, expected 0 (Sunday) to 6 (Saturday)", d)
+		}
+	}
+	for _, w := range p.TimeWindows {
+		start, err := parseClock(w.Start)
+		if err != nil {
+			return err
+		}
+		end, err := parseClock(w.End)
+		if err != nil {
+			return err
+		}
+		if start == end {
+			return fmt.Errorf("time window %s-%s is empty", w.Start, w.End)
+		}
+	}
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		return fmt.Errorf("unknown timezone %q", p.Timezone)
+	}
+	return nil
+}
+
+// IsEmpty reports whether the policy restricts nothing
+func (p *AccessPolicy) IsEmpty() bool {
+	return p == nil || (len(p.AllowedCIDRs) == 0 && len(p.Weekdays) == 0 && len(p.TimeWindows) == 0)
+}
+
+// Allows reports whether a request from addr at now satisfies the policy,
+// and which rule it breaks if not. An unknown address fails a CIDR rule.
+func (p *AccessPolicy) Allows(addr netip.Addr, now time.Time) (bool, string) {
+	if p.IsEmpty() {
+		return true, ""
+	}
+
+	if len(p.AllowedCIDRs) > 0 {
+		allowed := false
+		if addr.IsValid() {
+			for _, c := range p.AllowedCIDRs {
+				if prefix, err := netip.ParsePrefix(c); err == nil && prefix.Contains(addr.Unmap()) {
+					allowed = true
+					break
+				}
+			}
+		}
+		if !allowed {
+			return false, "client address not allowed by access policy"
+		}
+	}
+
+	if loc, err := time.LoadLocation(p.Timezone); err == nil {
+		now = now.In(loc)
+	}
+
+	if len(p.Weekdays) > 0 {
+		allowed := false
+		for _, d := range p.Weekdays {
+			if int(now.Weekday()) == d {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false, "weekday not allowed by access policy"
+		}
+	}
+
+	if len(p.TimeWindows) > 0 {
+		minute := now.Hour()*60 + now.Minute()
+		allowed := false
+		for _, w := range p.TimeWindows {
+			start, errStart := parseClock(w.Start)
+			end, errEnd := parseClock(w.End)
+			if errStart != nil || errEnd != nil {
+				continue
+			}
+			if start < end {
+				allowed = minute >= start && minute < end
+			} else {
+				allowed = minute >= start || minute < end
+			}
+			if allowed {
+				break
+			}
+		}
+		if !allowed {
+			return false, "time of day not allowed by access policy"
+		}
+	}
+
+	return true, ""
+}
+
+// parseClock returns the minutes after midnight of an HH:MM time
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+type clientAddrKey struct{}
+
+// WithClientAddr returns a context carrying the address of the client, for
+// the network rules of access policies
+func WithClientAddr(ctx context.Context, addr netip.Addr) context.Context {
+	return context.WithValue(ctx, clientAddrKey{}, addr)
+}
+
+// ClientAddrFromContext returns the client address set with WithClientAddr
+// (the zero Addr when unknown)
+func ClientAddrFromContext(ctx context.Context) netip.Addr {
+	addr, _ := ctx.Value(clientAddrKey{}).(netip.Addr)
+	return addr
+}
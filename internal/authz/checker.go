@@ -136,6 +136,26 @@ func (c *Checker) CanShareSecret(ctx context.Context, tenantID uint32, userID st
 	return c.CanShare(ctx, tenantID, userID, ResourceTypeSecret, secretID)
 }
 
+// CanReadCollection is a convenience method for collection read checks
+func (c *Checker) CanReadCollection(ctx context.Context, tenantID uint32, userID string, collectionID string) error {
+	return c.CanRead(ctx, tenantID, userID, ResourceTypeCollection, collectionID)
+}
+
+// CanWriteCollection is a convenience method for collection write checks
+func (c *Checker) CanWriteCollection(ctx context.Context, tenantID uint32, userID string, collectionID string) error {
+	return c.CanWrite(ctx, tenantID, userID, ResourceTypeCollection, collectionID)
+}
+
+// CanDeleteCollection is a convenience method for collection delete checks
+func (c *Checker) CanDeleteCollection(ctx context.Context, tenantID uint32, userID string, collectionID string) error {
+	return c.CanDelete(ctx, tenantID, userID, ResourceTypeCollection, collectionID)
+}
+
+// CanShareCollection is a convenience method for collection share checks
+func (c *Checker) CanShareCollection(ctx context.Context, tenantID uint32, userID string, collectionID string) error {
+	return c.CanShare(ctx, tenantID, userID, ResourceTypeCollection, collectionID)
+}
+
 // GetEffectivePermissions returns all effective permissions for a user on a resource
 func (c *Checker) GetEffectivePermissions(ctx context.Context, tenantID uint32, userID string, resourceType ResourceType, resourceID string) ([]Permission, Relation) {
 	return c.engine.GetEffectivePermissions(ctx, CheckContext{
@@ -155,3 +175,8 @@ func (c *Checker) ListAccessibleFolders(ctx context.Context, tenantID uint32, us
 func (c *Checker) ListAccessibleSecrets(ctx context.Context, tenantID uint32, userID string) ([]string, error) {
 	return c.engine.ListAccessibleResources(ctx, tenantID, userID, ResourceTypeSecret, PermissionRead)
 }
+
+// ListAccessibleCollections lists all collections accessible by a user
+func (c *Checker) ListAccessibleCollections(ctx context.Context, tenantID uint32, userID string) ([]string, error) {
+	return c.engine.ListAccessibleResources(ctx, tenantID, userID, ResourceTypeCollection, PermissionRead)
+}
@@ -5,8 +5,13 @@ import (
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/go-tangra/go-tangra-warden/internal/authz")
+
 // PermissionTuple represents a permission relationship in the system
 type PermissionTuple struct {
 	ID           uint32
@@ -27,8 +32,12 @@ type ResourceLookup interface {
 	GetFolderParentID(ctx context.Context, tenantID uint32, folderID string) (*string, error)
 	// GetSecretFolderID returns the folder ID for a secret
 	GetSecretFolderID(ctx context.Context, tenantID uint32, secretID string) (*string, error)
+	// GetSecretCollectionIDs returns the IDs of the collections a secret belongs to
+	GetSecretCollectionIDs(ctx context.Context, tenantID uint32, secretID string) ([]string, error)
 	// GetUserRoleIDs returns the role IDs for a user
 	GetUserRoleIDs(ctx context.Context, tenantID uint32, userID string) ([]string, error)
+	// GetAccessPolicies returns the access policies of a resource and the folders above it
+	GetAccessPolicies(ctx context.Context, tenantID uint32, resourceType ResourceType, resourceID string) ([]*AccessPolicy, error)
 }
 
 // PermissionStore provides methods to store and retrieve permissions
@@ -47,11 +56,17 @@ type PermissionStore interface {
 	ListResourcesBySubject(ctx context.Context, tenantID uint32, subjectType SubjectType, subjectID string, resourceType ResourceType) ([]string, error)
 }
 
+// Metrics receives the duration and result of every permission check
+type Metrics interface {
+	ObserveAuthzCheck(resourceType string, allowed bool, duration time.Duration)
+}
+
 // Engine implements Zanzibar-like permission checking
 type Engine struct {
-	store  PermissionStore
-	lookup ResourceLookup
-	log    *log.Helper
+	store   PermissionStore
+	lookup  ResourceLookup
+	log     *log.Helper
+	metrics Metrics
 }
 
 // NewEngine creates a new authorization engine
@@ -63,6 +78,11 @@ func NewEngine(store PermissionStore, lookup ResourceLookup, logger log.Logger)
 	}
 }
 
+// SetMetrics sets the receiver of permission check metrics
+func (e *Engine) SetMetrics(m Metrics) {
+	e.metrics = m
+}
+
 // CheckContext contains context for permission checks
 type CheckContext struct {
 	TenantID     uint32
@@ -70,6 +90,9 @@ type CheckContext struct {
 	ResourceType ResourceType
 	ResourceID   string
 	Permission   Permission
+	// IgnoreAccessPolicies evaluates permissions only, without the network
+	// and time restrictions of access policies
+	IgnoreAccessPolicies bool
 }
 
 // CheckResult represents the result of a permission check
@@ -83,9 +106,34 @@ type CheckResult struct {
 // 1. Check direct permission on resource
 // 2. If resource is Secret, check parent Folder permissions
 // 3. If Folder has parent, recursively check parent permissions
-// 4. Check user's roles for indirect permissions
-// 5. Check tenant-level permissions
+// 4. If resource is Secret, check the permissions of its collections
+// 5. Check user's roles for indirect permissions
+// 6. Check tenant-level permissions
 func (e *Engine) Check(ctx context.Context, check CheckContext) CheckResult {
+	ctx, span := tracer.Start(ctx, "authz.Check", trace.WithAttributes(
+		attribute.Int64("warden.tenant_id", int64(check.TenantID)),
+		attribute.String("warden.resource_type", string(check.ResourceType)),
+		attribute.String("warden.resource_id", check.ResourceID),
+		attribute.String("authz.permission", string(check.Permission)),
+	))
+	defer span.End()
+
+	start := time.Now()
+	result := e.check(ctx, check)
+	if result.Allowed && !check.IgnoreAccessPolicies {
+		if allowed, reason := e.checkAccessPolicies(ctx, check); !allowed {
+			result = CheckResult{Allowed: false, Reason: reason}
+		}
+	}
+
+	span.SetAttributes(attribute.Bool("authz.allowed", result.Allowed))
+	if e.metrics != nil {
+		e.metrics.ObserveAuthzCheck(string(check.ResourceType), result.Allowed, time.Since(start))
+	}
+	return result
+}
+
+func (e *Engine) check(ctx context.Context, check CheckContext) CheckResult {
 	// Step 1: Check direct user permission on resource
 	if result := e.checkDirectPermission(ctx, check, SubjectTypeUser, check.UserID); result.Allowed {
 		return result
@@ -119,6 +167,25 @@ func (e *Engine) Check(ctx context.Context, check CheckContext) CheckResult {
 	}
 }
 
+// checkAccessPolicies checks the request against the access policies of the
+// resource and its folders. Policies that cannot be loaded deny access.
+func (e *Engine) checkAccessPolicies(ctx context.Context, check CheckContext) (bool, string) {
+	policies, err := e.lookup.GetAccessPolicies(ctx, check.TenantID, check.ResourceType, check.ResourceID)
+	if err != nil {
+		e.log.Warnf("Failed to get access policies: %v", err)
+		return false, "error checking access policy"
+	}
+
+	addr := ClientAddrFromContext(ctx)
+	now := time.Now()
+	for _, p := range policies {
+		if allowed, reason := p.Allows(addr, now); !allowed {
+			return false, reason
+		}
+	}
+	return true, ""
+}
+
 // checkDirectPermission checks for a direct permission on a resource
 func (e *Engine) checkDirectPermission(ctx context.Context, check CheckContext, subjectType SubjectType, subjectID string) CheckResult {
 	tuple, err := e.store.HasPermission(ctx, check.TenantID, check.ResourceType, check.ResourceID, subjectType, subjectID)
@@ -220,6 +287,47 @@ func (e *Engine) checkHierarchy(ctx context.Context, check CheckContext, roleIDs
 		parentFolderID = nextParent
 	}
 
+	if check.ResourceType == ResourceTypeSecret {
+		return e.checkCollections(ctx, check, roleIDs)
+	}
+
+	return CheckResult{Allowed: false, Reason: "no inherited permission"}
+}
+
+// checkCollections checks the permissions on the collections of a secret.
+// Collections are flat, so there is no chain to walk.
+func (e *Engine) checkCollections(ctx context.Context, check CheckContext, roleIDs []string) CheckResult {
+	collectionIDs, err := e.lookup.GetSecretCollectionIDs(ctx, check.TenantID, check.ResourceID)
+	if err != nil {
+		e.log.Warnf("Failed to get secret collections: %v", err)
+		return CheckResult{Allowed: false, Reason: "error getting secret collections"}
+	}
+
+	for _, collectionID := range collectionIDs {
+		collectionCheck := CheckContext{
+			TenantID:     check.TenantID,
+			UserID:       check.UserID,
+			ResourceType: ResourceTypeCollection,
+			ResourceID:   collectionID,
+			Permission:   check.Permission,
+		}
+
+		if result := e.checkDirectPermission(ctx, collectionCheck, SubjectTypeUser, check.UserID); result.Allowed {
+			result.Reason = "inherited from collection"
+			return result
+		}
+		for _, roleID := range roleIDs {
+			if result := e.checkDirectPermission(ctx, collectionCheck, SubjectTypeRole, roleID); result.Allowed {
+				result.Reason = "inherited from collection via role"
+				return result
+			}
+		}
+		if result := e.checkDirectPermission(ctx, collectionCheck, SubjectTypeTenant, "all"); result.Allowed {
+			result.Reason = "inherited from collection via tenant"
+			return result
+		}
+	}
+
 	return CheckResult{Allowed: false, Reason: "no inherited permission"}
 }
 
@@ -284,7 +392,9 @@ func (e *Engine) ListAccessibleResources(ctx context.Context, tenantID uint32, u
 	return result, nil
 }
 
-// GetEffectivePermissions returns all permissions a user has on a resource
+// GetEffectivePermissions returns all permissions a user has on a resource.
+// Access policies are not applied, so owners can always manage a resource
+// even where its policy currently denies them access.
 func (e *Engine) GetEffectivePermissions(ctx context.Context, check CheckContext) ([]Permission, Relation) {
 	var highestRelation Relation
 	permissions := make(map[Permission]bool)
@@ -293,6 +403,7 @@ func (e *Engine) GetEffectivePermissions(ctx context.Context, check CheckContext
 	for _, perm := range []Permission{PermissionRead, PermissionWrite, PermissionDelete, PermissionShare} {
 		checkWithPerm := check
 		checkWithPerm.Permission = perm
+		checkWithPerm.IgnoreAccessPolicies = true
 		result := e.Check(ctx, checkWithPerm)
 		if result.Allowed {
 			permissions[perm] = true
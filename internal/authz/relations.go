@@ -36,6 +36,8 @@ const (
 	ResourceTypeFolder ResourceType = "RESOURCE_TYPE_FOLDER"
 	// ResourceTypeSecret represents a secret resource
 	ResourceTypeSecret ResourceType = "RESOURCE_TYPE_SECRET"
+	// ResourceTypeCollection represents a collection resource
+	ResourceTypeCollection ResourceType = "RESOURCE_TYPE_COLLECTION"
 )
 
 // SubjectType represents the type of entity being granted access
@@ -0,0 +1,355 @@
+package cert
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+)
+
+const (
+	defaultCertReloadInterval = time.Minute
+	defaultCertExpiryWarning  = 14 * 24 * time.Hour
+)
+
+// Metrics receives the expiry of the loaded certificates
+type Metrics interface {
+	SetCertificateExpiry(certificate string, notAfter time.Time)
+}
+
+// ExpiryState describes the loaded certificate that expires first
+type ExpiryState struct {
+	Enabled      bool
+	Certificate  string
+	NotAfter     time.Time
+	Expired      bool
+	ExpiringSoon bool
+}
+
+// Reloader re-reads the server certificate, key and CA bundle from CERTS_DIR
+// so rotated files take effect on the next TLS handshake without a restart.
+// Files are checked every CERT_RELOAD_INTERVAL; a rotation that fails to
+// parse keeps the previous certificates. Certificates expiring within
+// CERT_EXPIRY_WARNING are logged and reported as expiring soon.
+type Reloader struct {
+	log     *log.Helper
+	metrics Metrics
+
+	certFile string
+	keyFile  string
+	caFile   string
+
+	interval   time.Duration
+	warnBefore time.Duration
+
+	mu             sync.RWMutex
+	enabled        bool
+	contents       []byte
+	base           *tls.Config
+	current        *tls.Config
+	serverCert     *tls.Certificate
+	caPool         *x509.CertPool
+	serverNotAfter time.Time
+	caNotAfter     time.Time
+	warned         bool
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewReloader creates the certificate reloader. It is disabled when TLS is
+// not enabled or the certificate files cannot be found.
+func NewReloader(ctx *bootstrap.Context, certManager *CertManager, m Metrics) *Reloader {
+	r := newReloader(ctx)
+	r.metrics = m
+
+	if certManager == nil || !certManager.IsTLSEnabled() {
+		return r
+	}
+
+	if _, err := r.reload(); err != nil {
+		r.log.Warnf("Certificate reloading disabled: %v", err)
+		return r
+	}
+	r.enabled = true
+
+	return r
+}
+
+// Check loads the certificate files once, the way the reloader does, and
+// reports the certificate that expires first. The error wraps
+// os.ErrNotExist when there is no server certificate.
+func Check(ctx *bootstrap.Context) (ExpiryState, error) {
+	r := newReloader(ctx)
+	if _, err := r.reload(); err != nil {
+		return ExpiryState{}, err
+	}
+	r.enabled = true
+	return r.Expiry(time.Now()), nil
+}
+
+// newReloader reads the configuration and locates the certificate files
+func newReloader(ctx *bootstrap.Context) *Reloader {
+	l := ctx.NewLoggerHelper("warden/cert/reloader")
+
+	r := &Reloader{
+		log:        l,
+		interval:   defaultCertReloadInterval,
+		warnBefore: defaultCertExpiryWarning,
+	}
+
+	if v := os.Getenv("CERT_RELOAD_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			r.interval = d
+		} else {
+			l.Warnf("Invalid CERT_RELOAD_INTERVAL %q, using %s", v, r.interval)
+		}
+	}
+	if v := os.Getenv("CERT_EXPIRY_WARNING"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			r.warnBefore = d
+		} else {
+			l.Warnf("Invalid CERT_EXPIRY_WARNING %q, using %s", v, r.warnBefore)
+		}
+	}
+
+	certsDir := os.Getenv("CERTS_DIR")
+	if certsDir == "" {
+		certsDir = "/app/certs"
+	}
+	r.caFile = filepath.Join(certsDir, "ca", "ca.crt")
+	r.certFile = filepath.Join(certsDir, "server", "server.crt")
+	r.keyFile = filepath.Join(certsDir, "server", "server.key")
+
+	// Fall back to the legacy server cert layout
+	if _, err := os.Stat(r.certFile); os.IsNotExist(err) {
+		r.certFile = filepath.Join(certsDir, "warden-server", "server.crt")
+		r.keyFile = filepath.Join(certsDir, "warden-server", "server.key")
+	}
+
+	return r
+}
+
+// ServerTLSConfig returns a copy of base that serves the most recently loaded
+// certificate and verifies clients against the most recently loaded CA.
+// base is returned unchanged when the reloader is disabled.
+func (r *Reloader) ServerTLSConfig(base *tls.Config) *tls.Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.enabled {
+		return base
+	}
+
+	r.base = base.Clone()
+	// GetConfigForClient replaces the config negotiated by grpc, so it
+	// must advertise h2 itself
+	if !slices.Contains(r.base.NextProtos, "h2") {
+		r.base.NextProtos = append(r.base.NextProtos, "h2")
+	}
+	r.rebuildLocked()
+
+	cfg := base.Clone()
+	cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		return r.current, nil
+	}
+	return cfg
+}
+
+// Expiry reports the loaded certificate that expires first
+func (r *Reloader) Expiry(now time.Time) ExpiryState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if !r.enabled {
+		return ExpiryState{}
+	}
+	return r.expiryLocked(now)
+}
+
+// Start implements transport.Server and launches the reload loop
+func (r *Reloader) Start(_ context.Context) error {
+	if !r.enabled {
+		return nil
+	}
+
+	r.stopCh = make(chan struct{})
+	r.wg.Add(1)
+	go r.loop()
+	r.log.Infof("Certificate reloader started: cert=%s interval=%s", r.certFile, r.interval)
+	return nil
+}
+
+// Stop implements transport.Server
+func (r *Reloader) Stop(_ context.Context) error {
+	if r.stopCh != nil {
+		close(r.stopCh)
+		r.wg.Wait()
+	}
+	return nil
+}
+
+func (r *Reloader) loop() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+			changed, err := r.reload()
+			if err != nil {
+				r.log.Errorf("Certificate reload failed, keeping previous certificates: %v", err)
+			} else if changed {
+				r.log.Infof("Certificates reloaded: cert=%s", r.certFile)
+			}
+			r.checkExpiry()
+		}
+	}
+}
+
+// reload reads the certificate files and swaps them in when their content changed
+func (r *Reloader) reload() (bool, error) {
+	certPEM, err := os.ReadFile(r.certFile)
+	if err != nil {
+		return false, fmt.Errorf("read server certificate: %w", err)
+	}
+	keyPEM, err := os.ReadFile(r.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("read server key: %w", err)
+	}
+	caPEM, err := os.ReadFile(r.caFile)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("read CA certificate: %w", err)
+	}
+
+	contents := bytes.Join([][]byte{certPEM, keyPEM, caPEM}, []byte{0})
+
+	r.mu.RLock()
+	unchanged := bytes.Equal(contents, r.contents)
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	serverCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return false, fmt.Errorf("parse server certificate: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(serverCert.Certificate[0])
+	if err != nil {
+		return false, fmt.Errorf("parse server certificate: %w", err)
+	}
+
+	var caPool *x509.CertPool
+	var caNotAfter time.Time
+	if len(caPEM) > 0 {
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caPEM) {
+			return false, errors.New("parse CA certificate: no certificates found")
+		}
+		caNotAfter = earliestNotAfter(caPEM)
+	}
+
+	r.mu.Lock()
+	r.contents = contents
+	r.serverCert = &serverCert
+	r.caPool = caPool
+	r.serverNotAfter = leaf.NotAfter
+	r.caNotAfter = caNotAfter
+	r.warned = false
+	if r.base != nil {
+		r.rebuildLocked()
+	}
+	r.mu.Unlock()
+
+	if r.metrics != nil {
+		r.metrics.SetCertificateExpiry("server", leaf.NotAfter)
+		if !caNotAfter.IsZero() {
+			r.metrics.SetCertificateExpiry("ca", caNotAfter)
+		}
+	}
+	r.checkExpiry()
+
+	return true, nil
+}
+
+// rebuildLocked derives the per-handshake config from base and the loaded files
+func (r *Reloader) rebuildLocked() {
+	cfg := r.base.Clone()
+	cfg.Certificates = []tls.Certificate{*r.serverCert}
+	cfg.GetCertificate = nil
+	if r.caPool != nil {
+		cfg.ClientCAs = r.caPool
+	}
+	r.current = cfg
+}
+
+// checkExpiry logs once per loaded certificate set when it is close to expiry
+func (r *Reloader) checkExpiry() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.warned {
+		return
+	}
+	state := r.expiryLocked(time.Now())
+	if !state.Expired && !state.ExpiringSoon {
+		return
+	}
+	r.warned = true
+
+	if state.Expired {
+		r.log.Errorf("The %s certificate expired at %s", state.Certificate, state.NotAfter.Format(time.RFC3339))
+	} else {
+		r.log.Warnf("The %s certificate expires at %s", state.Certificate, state.NotAfter.Format(time.RFC3339))
+	}
+}
+
+func (r *Reloader) expiryLocked(now time.Time) ExpiryState {
+	state := ExpiryState{Enabled: true, Certificate: "server", NotAfter: r.serverNotAfter}
+	if !r.caNotAfter.IsZero() && r.caNotAfter.Before(state.NotAfter) {
+		state.Certificate, state.NotAfter = "ca", r.caNotAfter
+	}
+	state.Expired = !now.Before(state.NotAfter)
+	state.ExpiringSoon = !state.Expired && state.NotAfter.Sub(now) < r.warnBefore
+	return state
+}
+
+// earliestNotAfter returns the earliest expiry of the certificates in a PEM bundle
+func earliestNotAfter(bundle []byte) time.Time {
+	var earliest time.Time
+	for {
+		var block *pem.Block
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			return earliest
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		if earliest.IsZero() || c.NotAfter.Before(earliest) {
+			earliest = c.NotAfter
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/go-tangra/go-tangra-warden/internal/cert"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// WardenClient calls other Warden instances, e.g. to import a migrated folder
+// subtree. Only endpoints listed in WARDEN_MIGRATION_ENDPOINTS can be dialed.
+type WardenClient struct {
+	log         *log.Helper
+	certManager *cert.CertManager
+	endpoints   []string
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+// NewWardenClient creates a new WardenClient. Connections are opened on first use.
+func NewWardenClient(ctx *bootstrap.Context, certManager *cert.CertManager) (*WardenClient, func(), error) {
+	l := ctx.NewLoggerHelper("warden/client/warden")
+
+	var endpoints []string
+	for _, e := range strings.Split(os.Getenv("WARDEN_MIGRATION_ENDPOINTS"), ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			endpoints = append(endpoints, e)
+		}
+	}
+
+	c := &WardenClient{
+		log:         l,
+		certManager: certManager,
+		endpoints:   endpoints,
+		conns:       make(map[string]*grpc.ClientConn),
+	}
+
+	cleanup := func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for _, conn := range c.conns {
+			conn.Close()
+		}
+	}
+
+	return c, cleanup, nil
+}
+
+// ImportFolderTree sends a subtree bundle to a remote Warden instance
+func (c *WardenClient) ImportFolderTree(ctx context.Context, endpoint string, req *wardenV1.ImportFolderTreeRequest) (*wardenV1.MigrateFolderTreeResponse, error) {
+	conn, err := c.conn(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := wardenV1.NewWardenTenantTransferServiceClient(conn).ImportFolderTree(forwardAuthMetadata(ctx), req)
+	if err != nil {
+		c.log.Errorf("Failed to import folder tree on %s: %v", endpoint, err)
+		return nil, fmt.Errorf("import folder tree on %s: %w", endpoint, err)
+	}
+	return resp, nil
+}
+
+func (c *WardenClient) conn(endpoint string) (*grpc.ClientConn, error) {
+	if !slices.Contains(c.endpoints, endpoint) {
+		return nil, fmt.Errorf("endpoint %s is not listed in WARDEN_MIGRATION_ENDPOINTS", endpoint)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if conn, ok := c.conns[endpoint]; ok {
+		return conn, nil
+	}
+
+	var transportCreds grpc.DialOption
+	if c.certManager != nil && c.certManager.IsTLSEnabled() {
+		tlsCreds, err := loadWardenClientTLS(c.log)
+		if err != nil {
+			// Migrations carry plaintext passwords, never send them unencrypted
+			return nil, fmt.Errorf("load mTLS credentials for warden client: %w", err)
+		}
+		transportCreds = grpc.WithTransportCredentials(tlsCreds)
+	} else {
+		c.log.Warnf("TLS disabled, dialing Warden instance %s in plaintext (dev only)", endpoint)
+		transportCreds = grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+
+	conn, err := grpc.NewClient(endpoint, transportCreds)
+	if err != nil {
+		return nil, fmt.Errorf("create warden gRPC client: %w", err)
+	}
+	c.conns[endpoint] = conn
+
+	c.log.Infof("Warden gRPC client configured for endpoint: %s", endpoint)
+	return conn, nil
+}
+
+// loadWardenClientTLS loads mTLS credentials for calling other Warden instances.
+func loadWardenClientTLS(l *log.Helper) (credentials.TransportCredentials, error) {
+	certsDir := os.Getenv("CERTS_DIR")
+	if certsDir == "" {
+		certsDir = "/app/certs"
+	}
+
+	caCertPath := filepath.Join(certsDir, "ca", "ca.crt")
+
+	clientCertPath := filepath.Join(certsDir, "client", "client.crt")
+	clientKeyPath := filepath.Join(certsDir, "client", "client.key")
+
+	if _, err := os.Stat(clientCertPath); os.IsNotExist(err) {
+		clientCertPath = filepath.Join(certsDir, "warden", "warden.crt")
+		clientKeyPath = filepath.Join(certsDir, "warden", "warden.key")
+	}
+
+	caCert, err := os.ReadFile(caCertPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA cert: %w", err)
+	}
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("failed to parse CA certificate")
+	}
+
+	clientCert, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client cert: %w", err)
+	}
+
+	serverName := os.Getenv("WARDEN_MIGRATION_SERVER_NAME")
+	if serverName == "" {
+		serverName = "warden-service"
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      caCertPool,
+		ServerName:   serverName,
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	l.Infof("Loaded mTLS credentials for Warden instances: CA=%s, Cert=%s", caCertPath, clientCertPath)
+	return credentials.NewTLS(tlsConfig), nil
+}
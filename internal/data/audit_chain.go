@@ -0,0 +1,235 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditchainhead"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+const auditChainBatchSize = 1000
+
+// AuditChainIssueKind classifies a hash chain verification failure
+type AuditChainIssueKind string
+
+const (
+	// AuditChainIssueGap means one or more entries are missing between two rows
+	AuditChainIssueGap AuditChainIssueKind = "GAP"
+	// AuditChainIssueBrokenLink means prev_hash does not match the previous entry
+	AuditChainIssueBrokenLink AuditChainIssueKind = "BROKEN_LINK"
+	// AuditChainIssueModified means the stored chain hash does not match the row content
+	AuditChainIssueModified AuditChainIssueKind = "MODIFIED"
+)
+
+// AuditChainIssue describes a single verification failure
+type AuditChainIssue struct {
+	Kind     AuditChainIssueKind
+	AuditID  string
+	ChainSeq int64
+	Message  string
+}
+
+// AuditChainReport is the result of verifying a tenant's audit chain
+type AuditChainReport struct {
+	Checked   int64
+	Unchained int64
+	FirstSeq  int64
+	LastSeq   int64
+	Issues    []AuditChainIssue
+}
+
+// ComputeAuditChainHash returns the chain hash of an audit log entry. The hash
+// covers the previous entry's chain hash, the chain position and every stored
+// column, so any modification, reordering or removal is detectable.
+func ComputeAuditChainHash(e *ent.AuditLog) string {
+	h := sha256.New()
+	write := func(v string) {
+		h.Write([]byte(strconv.Itoa(len(v))))
+		h.Write([]byte{':'})
+		h.Write([]byte(v))
+	}
+
+	var tenantID uint32
+	if e.TenantID != nil {
+		tenantID = *e.TenantID
+	}
+	var errorCode int32
+	if e.ErrorCode != nil {
+		errorCode = *e.ErrorCode
+	}
+	var createTime int64
+	if e.CreateTime != nil {
+		createTime = e.CreateTime.UTC().UnixMicro()
+	}
+	// json.Marshal sorts map keys, giving a canonical encoding
+	geo, _ := json.Marshal(e.GeoLocation)
+	meta, _ := json.Marshal(e.Metadata)
+
+	write(e.PrevHash)
+	write(strconv.FormatInt(e.ChainSeq, 10))
+	write(strconv.FormatUint(uint64(tenantID), 10))
+	write(e.AuditID)
+	write(e.RequestID)
+	write(e.Operation)
+	write(e.ServiceName)
+	write(e.ClientID)
+	write(e.ClientCommonName)
+	write(e.ClientOrganization)
+	write(e.ClientSerialNumber)
+	write(strconv.FormatBool(e.IsAuthenticated))
+	write(strconv.FormatBool(e.Success))
+	write(strconv.FormatInt(int64(errorCode), 10))
+	write(e.ErrorMessage)
+	write(strconv.FormatInt(e.LatencyMs, 10))
+	write(e.PeerAddress)
+	write(string(geo))
+	write(e.LogHash)
+	write(hex.EncodeToString(e.Signature))
+	write(string(meta))
+	write(e.ActorID)
+	write(e.EventType)
+	write(e.ResourceType)
+	write(e.ResourceID)
+	write(strconv.FormatInt(createTime, 10))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// tenantChainPredicate selects the chain of a tenant (0 = entries without tenant)
+func tenantChainPredicate(tenantID uint32) predicate.AuditLog {
+	if tenantID == 0 {
+		return auditlog.TenantIDIsNil()
+	}
+	return auditlog.TenantIDEQ(tenantID)
+}
+
+// advanceAuditChainHead moves the head of a tenant's chain on by one entry
+// and returns it, holding the sequence of the new entry and the hash of the
+// previous one. The update locks the head row until tx ends, which serializes
+// appends across instances. The first append of a tenant creates the head,
+// from the last entry chained before heads existed when there is one.
+func advanceAuditChainHead(ctx context.Context, tx *ent.Tx, tenantID uint32) (*ent.AuditChainHead, error) {
+	for created := false; ; created = true {
+		n, err := tx.AuditChainHead.Update().
+			Where(auditchainhead.TenantIDEQ(tenantID)).
+			AddSeq(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 {
+			return tx.AuditChainHead.Query().
+				Where(auditchainhead.TenantIDEQ(tenantID)).
+				Only(ctx)
+		}
+		if created {
+			return nil, fmt.Errorf("audit chain head of tenant %d not found", tenantID)
+		}
+
+		head := tx.AuditChainHead.Create().SetTenantID(tenantID)
+		last, err := tx.AuditLog.Query().
+			Where(tenantChainPredicate(tenantID), auditlog.ChainSeqGT(0)).
+			Order(ent.Desc(auditlog.FieldChainSeq)).
+			First(ctx)
+		switch {
+		case err == nil:
+			head.SetSeq(last.ChainSeq).SetHash(last.ChainHash)
+		case !ent.IsNotFound(err):
+			return nil, err
+		}
+		// Another instance creating the head first is fine, the update then
+		// waits for its append
+		if err := head.OnConflictColumns(auditchainhead.FieldTenantID).Ignore().Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// VerifyChain walks a tenant's audit chain in order and reports gaps, broken
+// links and modified rows. The first entry in range is trusted as the anchor,
+// since retention pruning legitimately removes the start of the chain.
+func (r *AuditLogRepo) VerifyChain(ctx context.Context, tenantID uint32, startTime, endTime *time.Time) (*AuditChainReport, error) {
+	base := r.entClient.Client().AuditLog.Query().
+		Where(tenantChainPredicate(tenantID))
+	if startTime != nil {
+		base = base.Where(auditlog.CreateTimeGTE(*startTime))
+	}
+	if endTime != nil {
+		base = base.Where(auditlog.CreateTimeLTE(*endTime))
+	}
+
+	unchained, err := base.Clone().Where(auditlog.ChainSeqEQ(0)).Count(ctx)
+	if err != nil {
+		r.log.Errorf("count unchained audit logs failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("verify audit chain failed")
+	}
+
+	report := &AuditChainReport{Unchained: int64(unchained)}
+
+	var prev *ent.AuditLog
+	var lastSeq int64
+	for {
+		batch, err := base.Clone().
+			Where(auditlog.ChainSeqGT(lastSeq)).
+			Order(ent.Asc(auditlog.FieldChainSeq)).
+			Limit(auditChainBatchSize).
+			All(ctx)
+		if err != nil {
+			r.log.Errorf("list audit chain failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("verify audit chain failed")
+		}
+
+		for _, e := range batch {
+			report.Checked++
+			if report.FirstSeq == 0 {
+				report.FirstSeq = e.ChainSeq
+			}
+			report.LastSeq = e.ChainSeq
+
+			if ComputeAuditChainHash(e) != e.ChainHash {
+				report.Issues = append(report.Issues, AuditChainIssue{
+					Kind:     AuditChainIssueModified,
+					AuditID:  e.AuditID,
+					ChainSeq: e.ChainSeq,
+					Message:  "entry content does not match its chain hash",
+				})
+			}
+
+			if prev != nil {
+				if e.ChainSeq != prev.ChainSeq+1 {
+					report.Issues = append(report.Issues, AuditChainIssue{
+						Kind:     AuditChainIssueGap,
+						AuditID:  e.AuditID,
+						ChainSeq: e.ChainSeq,
+						Message:  "missing entries " + strconv.FormatInt(prev.ChainSeq+1, 10) + "-" + strconv.FormatInt(e.ChainSeq-1, 10),
+					})
+				} else if e.PrevHash != prev.ChainHash {
+					report.Issues = append(report.Issues, AuditChainIssue{
+						Kind:     AuditChainIssueBrokenLink,
+						AuditID:  e.AuditID,
+						ChainSeq: e.ChainSeq,
+						Message:  "previous hash does not match entry " + strconv.FormatInt(prev.ChainSeq, 10),
+					})
+				}
+			}
+			prev = e
+		}
+
+		if len(batch) < auditChainBatchSize {
+			break
+		}
+		lastSeq = batch[len(batch)-1].ChainSeq
+	}
+
+	return report, nil
+}
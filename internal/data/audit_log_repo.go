@@ -5,10 +5,13 @@ import (
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	entCrud "github.com/tx7do/go-crud/entgo"
 
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditlog"
 
@@ -19,77 +22,183 @@ import (
 // AuditLogRepo implements audit.AuditLogRepository for warden
 type AuditLogRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
+	replica   *ReadReplica
 	log       *log.Helper
 }
 
 // NewAuditLogRepo creates a new AuditLogRepo
-func NewAuditLogRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *AuditLogRepo {
+func NewAuditLogRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], replica *ReadReplica) *AuditLogRepo {
 	return &AuditLogRepo{
 		log:       ctx.NewLoggerHelper("warden/audit_log_repo"),
 		entClient: entClient,
+		replica:   replica,
 	}
 }
 
-// CreateFromEntry implements audit.AuditLogRepository
+// CreateFromEntry implements audit.AuditLogRepository. Each entry is appended
+// to its tenant's hash chain.
 func (r *AuditLogRepo) CreateFromEntry(ctx context.Context, entry *audit.AuditLogEntry) error {
-	builder := r.entClient.Client().AuditLog.Create().
-		SetAuditID(entry.AuditID).
-		SetOperation(entry.Operation).
-		SetServiceName(entry.ServiceName).
-		SetSuccess(entry.Success).
-		SetIsAuthenticated(entry.IsAuthenticated).
-		SetLatencyMs(entry.LatencyMs).
-		SetCreateTime(entry.Timestamp)
-
+	record := &ent.AuditLog{
+		AuditID:            entry.AuditID,
+		RequestID:          entry.RequestID,
+		Operation:          entry.Operation,
+		ServiceName:        entry.ServiceName,
+		ClientID:           entry.ClientID,
+		ClientCommonName:   entry.ClientCommonName,
+		ClientOrganization: entry.ClientOrganization,
+		ClientSerialNumber: entry.ClientSerialNumber,
+		IsAuthenticated:    entry.IsAuthenticated,
+		Success:            entry.Success,
+		ErrorMessage:       entry.ErrorMessage,
+		LatencyMs:          entry.LatencyMs,
+		PeerAddress:        entry.PeerAddress,
+		GeoLocation:        entry.GeoLocation,
+		LogHash:            entry.LogHash,
+		Signature:          entry.Signature,
+		Metadata:           entry.Metadata,
+	}
+	if entry.Metadata != nil {
+		record.ActorID = entry.Metadata[auditevent.MetadataUserID]
+		record.EventType = entry.Metadata[auditevent.MetadataEvent]
+		record.ResourceType = entry.Metadata[auditevent.MetadataResourceType]
+		record.ResourceID = entry.Metadata[auditevent.MetadataResourceID]
+	}
+	// Databases store microsecond precision; truncate so the hash stays verifiable
+	createTime := entry.Timestamp.Truncate(time.Microsecond)
+	record.CreateTime = &createTime
 	if entry.TenantID > 0 {
-		builder.SetTenantID(entry.TenantID)
+		record.TenantID = &entry.TenantID
 	}
-	if entry.RequestID != "" {
-		builder.SetRequestID(entry.RequestID)
+	if entry.ErrorCode != 0 {
+		record.ErrorCode = &entry.ErrorCode
 	}
-	if entry.ClientID != "" {
-		builder.SetClientID(entry.ClientID)
+
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return err
 	}
-	if entry.ClientCommonName != "" {
-		builder.SetClientCommonName(entry.ClientCommonName)
+
+	// Appends to a chain must not interleave, on this instance or any other:
+	// advancing the head locks it until the transaction ends
+	head, err := advanceAuditChainHead(ctx, tx, entry.TenantID)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		r.log.Errorf("advance audit chain failed: %s", err.Error())
+		return err
 	}
-	if entry.ClientOrganization != "" {
-		builder.SetClientOrganization(entry.ClientOrganization)
+
+	record.ChainSeq = head.Seq
+	record.PrevHash = head.Hash
+	record.ChainHash = ComputeAuditChainHash(record)
+
+	builder := tx.AuditLog.Create().
+		SetAuditID(record.AuditID).
+		SetOperation(record.Operation).
+		SetServiceName(record.ServiceName).
+		SetSuccess(record.Success).
+		SetIsAuthenticated(record.IsAuthenticated).
+		SetLatencyMs(record.LatencyMs).
+		SetCreateTime(createTime).
+		SetNillableTenantID(record.TenantID).
+		SetNillableErrorCode(record.ErrorCode).
+		SetChainSeq(record.ChainSeq).
+		SetChainHash(record.ChainHash)
+
+	if record.PrevHash != "" {
+		builder.SetPrevHash(record.PrevHash)
 	}
-	if entry.ClientSerialNumber != "" {
-		builder.SetClientSerialNumber(entry.ClientSerialNumber)
+	if record.RequestID != "" {
+		builder.SetRequestID(record.RequestID)
 	}
-	if entry.ErrorCode != 0 {
-		builder.SetErrorCode(entry.ErrorCode)
+	if record.ClientID != "" {
+		builder.SetClientID(record.ClientID)
 	}
-	if entry.ErrorMessage != "" {
-		builder.SetErrorMessage(entry.ErrorMessage)
+	if record.ClientCommonName != "" {
+		builder.SetClientCommonName(record.ClientCommonName)
 	}
-	if entry.PeerAddress != "" {
-		builder.SetPeerAddress(entry.PeerAddress)
+	if record.ClientOrganization != "" {
+		builder.SetClientOrganization(record.ClientOrganization)
 	}
-	if entry.GeoLocation != nil {
-		builder.SetGeoLocation(entry.GeoLocation)
+	if record.ClientSerialNumber != "" {
+		builder.SetClientSerialNumber(record.ClientSerialNumber)
 	}
-	if entry.LogHash != "" {
-		builder.SetLogHash(entry.LogHash)
+	if record.ErrorMessage != "" {
+		builder.SetErrorMessage(record.ErrorMessage)
 	}
-	if entry.Signature != nil {
-		builder.SetSignature(entry.Signature)
+	if record.PeerAddress != "" {
+		builder.SetPeerAddress(record.PeerAddress)
 	}
-	if entry.Metadata != nil {
-		builder.SetMetadata(entry.Metadata)
+	if record.GeoLocation != nil {
+		builder.SetGeoLocation(record.GeoLocation)
+	}
+	if record.LogHash != "" {
+		builder.SetLogHash(record.LogHash)
+	}
+	if record.Signature != nil {
+		builder.SetSignature(record.Signature)
+	}
+	if record.Metadata != nil {
+		builder.SetMetadata(record.Metadata)
+	}
+	if record.ActorID != "" {
+		builder.SetActorID(record.ActorID)
+	}
+	if record.EventType != "" {
+		builder.SetEventType(record.EventType).
+			SetResourceType(record.ResourceType).
+			SetResourceID(record.ResourceID)
 	}
 
-	_, err := builder.Save(ctx)
-	if err != nil {
+	if _, err := builder.Save(ctx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
 		r.log.Errorf("create audit log failed: %s", err.Error())
 		return err
 	}
 
+	if err := tx.AuditChainHead.UpdateOneID(head.ID).SetHash(record.ChainHash).Exec(ctx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		r.log.Errorf("update audit chain head failed: %s", err.Error())
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return err
+	}
+
 	return nil
 }
 
+// RecordSystemEvent appends an audit entry for a domain event raised by a
+// background job rather than a request. actorID is the user the event
+// concerns, if any.
+func (r *AuditLogRepo) RecordSystemEvent(ctx context.Context, operation string, tenantID uint32, actorID, eventType, resourceType, resourceID string) error {
+	entry := &audit.AuditLogEntry{
+		AuditID:     uuid.New().String(),
+		Operation:   operation,
+		ServiceName: "warden-service",
+		Success:     true,
+		Timestamp:   time.Now(),
+		TenantID:    tenantID,
+		Metadata: map[string]string{
+			auditevent.MetadataEvent:        eventType,
+			auditevent.MetadataResourceType: resourceType,
+			auditevent.MetadataResourceID:   resourceID,
+		},
+	}
+	if actorID != "" {
+		entry.Metadata[auditevent.MetadataUserID] = actorID
+	}
+	return r.CreateFromEntry(ctx, entry)
+}
+
 // GetByAuditID retrieves an audit log by its audit ID
 func (r *AuditLogRepo) GetByAuditID(ctx context.Context, auditID string) (*ent.AuditLog, error) {
 	entity, err := r.entClient.Client().AuditLog.Query().
@@ -120,20 +229,25 @@ func (r *AuditLogRepo) GetByID(ctx context.Context, id uint32) (*ent.AuditLog, e
 
 // AuditLogListOptions contains options for listing audit logs
 type AuditLogListOptions struct {
-	TenantID    *uint32
-	ClientID    *string
-	Operation   *string
-	Success     *bool
-	PeerAddress *string
-	StartTime   *time.Time
-	EndTime     *time.Time
-	Limit       int
-	Offset      int
+	TenantID     *uint32
+	ClientID     *string
+	Operation    *string
+	Success      *bool
+	PeerAddress  *string
+	EventType    *string
+	ResourceType *string
+	ResourceID   *string
+	StartTime    *time.Time
+	EndTime      *time.Time
+	Limit        int
+	Offset       int
+	// After continues after the given entry; Offset is ignored when set
+	After *Cursor
 }
 
 // List retrieves audit logs with filtering options
 func (r *AuditLogRepo) List(ctx context.Context, opts *AuditLogListOptions) ([]*ent.AuditLog, int, error) {
-	query := r.entClient.Client().AuditLog.Query()
+	query := r.replica.readClient(ctx, r.entClient).AuditLog.Query()
 
 	if opts != nil {
 		if opts.TenantID != nil {
@@ -151,6 +265,15 @@ func (r *AuditLogRepo) List(ctx context.Context, opts *AuditLogListOptions) ([]*
 		if opts.PeerAddress != nil {
 			query = query.Where(auditlog.PeerAddressEQ(*opts.PeerAddress))
 		}
+		if opts.EventType != nil {
+			query = query.Where(auditlog.EventTypeEQ(*opts.EventType))
+		}
+		if opts.ResourceType != nil {
+			query = query.Where(auditlog.ResourceTypeEQ(*opts.ResourceType))
+		}
+		if opts.ResourceID != nil {
+			query = query.Where(auditlog.ResourceIDEQ(*opts.ResourceID))
+		}
 		if opts.StartTime != nil {
 			query = query.Where(auditlog.CreateTimeGTE(*opts.StartTime))
 		}
@@ -165,12 +288,25 @@ func (r *AuditLogRepo) List(ctx context.Context, opts *AuditLogListOptions) ([]*
 		return nil, 0, wardenV1.ErrorInternalServerError("count audit logs failed")
 	}
 
-	query = query.Order(ent.Desc(auditlog.FieldCreateTime))
+	query = query.Order(ent.Desc(auditlog.FieldCreateTime), ent.Desc(auditlog.FieldID))
 	if opts != nil {
 		if opts.Limit > 0 {
 			query = query.Limit(opts.Limit)
 		}
-		if opts.Offset > 0 {
+		if opts.After != nil {
+			createTime, err := opts.After.timeKey()
+			if err != nil {
+				return nil, 0, err
+			}
+			id, err := opts.After.intID()
+			if err != nil {
+				return nil, 0, err
+			}
+			query = query.Where(auditlog.Or(
+				auditlog.CreateTimeLT(createTime),
+				auditlog.And(auditlog.CreateTimeEQ(createTime), auditlog.IDLT(uint32(id))),
+			))
+		} else if opts.Offset > 0 {
 			query = query.Offset(opts.Offset)
 		}
 	}
@@ -195,3 +331,134 @@ func (r *AuditLogRepo) DeleteOlderThan(ctx context.Context, before time.Time) (i
 	}
 	return deleted, nil
 }
+
+// DeleteOlderThanForTenant deletes a single tenant's audit logs older than the specified time
+func (r *AuditLogRepo) DeleteOlderThanForTenant(ctx context.Context, tenantID uint32, before time.Time) (int, error) {
+	deleted, err := r.entClient.Client().AuditLog.Delete().
+		Where(
+			auditlog.TenantIDEQ(tenantID),
+			auditlog.CreateTimeLT(before),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete old tenant audit logs failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("delete old audit logs failed")
+	}
+	return deleted, nil
+}
+
+// DeleteOlderThanExcludingTenants deletes audit logs older than the specified time,
+// leaving the listed tenants untouched (they are pruned by their own retention)
+func (r *AuditLogRepo) DeleteOlderThanExcludingTenants(ctx context.Context, before time.Time, excluded []uint32) (int, error) {
+	query := r.entClient.Client().AuditLog.Delete().
+		Where(auditlog.CreateTimeLT(before))
+	if len(excluded) > 0 {
+		query = query.Where(auditlog.Or(
+			auditlog.TenantIDIsNil(),
+			auditlog.TenantIDNotIn(excluded...),
+		))
+	}
+	deleted, err := query.Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete old audit logs failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("delete old audit logs failed")
+	}
+	return deleted, nil
+}
+
+// ToProto converts an ent.AuditLog to wardenV1.AuditLog
+func (r *AuditLogRepo) ToProto(entity *ent.AuditLog) *wardenV1.AuditLog {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &wardenV1.AuditLog{
+		Id:           entity.ID,
+		TenantId:     derefUint32(entity.TenantID),
+		AuditId:      entity.AuditID,
+		RequestId:    entity.RequestID,
+		Operation:    entity.Operation,
+		ClientId:     entity.ClientID,
+		Success:      entity.Success,
+		ErrorCode:    entity.ErrorCode,
+		ErrorMessage: entity.ErrorMessage,
+		LatencyMs:    entity.LatencyMs,
+		PeerAddress:  entity.PeerAddress,
+		EventType:    entity.EventType,
+		ResourceType: entity.ResourceType,
+		ResourceId:   entity.ResourceID,
+		Metadata:     entity.Metadata,
+	}
+
+	if entity.CreateTime != nil {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
+
+// ActorEventCount is the number of events of one actor
+type ActorEventCount struct {
+	TenantID uint32 `json:"tenant_id"`
+	ActorID  string `json:"actor_id"`
+	Count    int    `json:"count"`
+}
+
+// CountEventsByActor counts events of a type per tenant and actor since the given time
+func (r *AuditLogRepo) CountEventsByActor(ctx context.Context, eventType string, since time.Time) ([]ActorEventCount, error) {
+	var counts []ActorEventCount
+	err := r.entClient.Client().AuditLog.Query().
+		Where(
+			auditlog.EventTypeEQ(eventType),
+			auditlog.CreateTimeGTE(since),
+			auditlog.TenantIDNotNil(),
+			auditlog.ActorIDNEQ(""),
+		).
+		GroupBy(auditlog.FieldTenantID, auditlog.FieldActorID).
+		Aggregate(ent.As(ent.Count(), "count")).
+		Scan(ctx, &counts)
+	if err != nil {
+		r.log.Errorf("count audit events by actor failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("count audit events failed")
+	}
+	return counts, nil
+}
+
+// ListEventsBetween retrieves events of a type created in [from, to)
+func (r *AuditLogRepo) ListEventsBetween(ctx context.Context, eventType string, from, to time.Time, limit int) ([]*ent.AuditLog, error) {
+	entities, err := r.entClient.Client().AuditLog.Query().
+		Where(
+			auditlog.EventTypeEQ(eventType),
+			auditlog.CreateTimeGTE(from),
+			auditlog.CreateTimeLT(to),
+			auditlog.ActorIDNEQ(""),
+		).
+		Order(ent.Asc(auditlog.FieldCreateTime)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list audit events failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list audit events failed")
+	}
+	return entities, nil
+}
+
+// ListActorHistory retrieves the peer addresses and locations an actor used in [from, to)
+func (r *AuditLogRepo) ListActorHistory(ctx context.Context, tenantID uint32, actorID string, from, to time.Time, limit int) ([]*ent.AuditLog, error) {
+	entities, err := r.entClient.Client().AuditLog.Query().
+		Where(
+			auditlog.TenantIDEQ(tenantID),
+			auditlog.ActorIDEQ(actorID),
+			auditlog.CreateTimeGTE(from),
+			auditlog.CreateTimeLT(to),
+		).
+		Select(auditlog.FieldPeerAddress, auditlog.FieldGeoLocation).
+		Order(ent.Desc(auditlog.FieldCreateTime)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list actor history failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list actor history failed")
+	}
+	return entities, nil
+}
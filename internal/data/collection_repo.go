@@ -0,0 +1,345 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/collection"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// CollectionRepo stores collections and which secrets are in them
+type CollectionRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	replica   *ReadReplica
+	log       *log.Helper
+}
+
+// NewCollectionRepo creates a new CollectionRepo
+func NewCollectionRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], replica *ReadReplica) *CollectionRepo {
+	return &CollectionRepo{
+		log:       ctx.NewLoggerHelper("collection/repo"),
+		entClient: entClient,
+		replica:   replica,
+	}
+}
+
+// withLiveSecretIDs loads the IDs of the non-deleted secrets of a collection,
+// which ToProto counts
+func withLiveSecretIDs(q *ent.SecretQuery) {
+	q.Where(secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED)).Select(secret.FieldID)
+}
+
+// Create creates a new collection. externalID is the ID of the collection in
+// the system it was imported from, if any.
+func (r *CollectionRepo) Create(ctx context.Context, tenantID uint32, name, description, externalID string, createdBy *uint32) (*ent.Collection, error) {
+	builder := dbClient(ctx, r.entClient).Collection.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetCreateTime(time.Now())
+
+	if description != "" {
+		builder.SetDescription(description)
+	}
+	if externalID != "" {
+		builder.SetExternalID(externalID)
+	}
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, wardenV1.ErrorCollectionAlreadyExists("collection with this name already exists")
+		}
+		r.log.Errorf("create collection failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("create collection failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a collection by ID within a tenant, with the IDs of its
+// secrets. A collection of another tenant is reported as not found.
+func (r *CollectionRepo) GetByID(ctx context.Context, tenantID uint32, id string) (*ent.Collection, error) {
+	entity, err := r.replica.readClient(ctx, r.entClient).Collection.Query().
+		Where(collection.IDEQ(id), collection.TenantIDEQ(tenantID)).
+		WithSecrets(withLiveSecretIDs).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get collection failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get collection failed")
+	}
+	return entity, nil
+}
+
+// GetByName retrieves a collection of a tenant by name
+func (r *CollectionRepo) GetByName(ctx context.Context, tenantID uint32, name string) (*ent.Collection, error) {
+	entity, err := dbClient(ctx, r.entClient).Collection.Query().
+		Where(collection.TenantIDEQ(tenantID), collection.NameEQ(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get collection by name failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get collection failed")
+	}
+	return entity, nil
+}
+
+// List retrieves the collections of a tenant by name. A non-nil ids limits
+// them to those collections, and secretID to the collections of a secret.
+func (r *CollectionRepo) List(ctx context.Context, tenantID uint32, ids []string, nameFilter, secretID *string, page, pageSize uint32) ([]*ent.Collection, int, error) {
+	query := r.replica.readClient(ctx, r.entClient).Collection.Query().
+		Where(collection.TenantIDEQ(tenantID))
+
+	if ids != nil {
+		query = query.Where(collection.IDIn(ids...))
+	}
+	if nameFilter != nil && *nameFilter != "" {
+		query = query.Where(collection.NameContainsFold(*nameFilter))
+	}
+	if secretID != nil && *secretID != "" {
+		query = query.Where(collection.HasSecretsWith(secret.IDEQ(*secretID)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count collections failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("count collections failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		WithSecrets(withLiveSecretIDs).
+		Order(ent.Asc(collection.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list collections failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("list collections failed")
+	}
+	return entities, total, nil
+}
+
+// ListAll retrieves every collection of a tenant with the IDs of its
+// non-deleted secrets, for exports
+func (r *CollectionRepo) ListAll(ctx context.Context, tenantID uint32) ([]*ent.Collection, error) {
+	entities, err := r.replica.readClient(ctx, r.entClient).Collection.Query().
+		Where(collection.TenantIDEQ(tenantID)).
+		WithSecrets(withLiveSecretIDs).
+		Order(ent.Asc(collection.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list collections failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list collections failed")
+	}
+	return entities, nil
+}
+
+// Update renames a collection or changes its description
+func (r *CollectionRepo) Update(ctx context.Context, tenantID uint32, id string, name, description *string) (*ent.Collection, error) {
+	builder := dbClient(ctx, r.entClient).Collection.Update().
+		Where(collection.IDEQ(id), collection.TenantIDEQ(tenantID)).
+		SetUpdateTime(time.Now())
+
+	if name != nil {
+		builder.SetName(*name)
+	}
+	if description != nil {
+		builder.SetDescription(*description)
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, wardenV1.ErrorCollectionAlreadyExists("collection with this name already exists")
+		}
+		r.log.Errorf("update collection failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("update collection failed")
+	}
+	if affected == 0 {
+		return nil, wardenV1.ErrorCollectionNotFound("collection not found")
+	}
+
+	return r.GetByID(WithPrimary(ctx), tenantID, id)
+}
+
+// Delete deletes a collection. Its secrets are left alone, only their
+// membership goes with it.
+func (r *CollectionRepo) Delete(ctx context.Context, tenantID uint32, id string) error {
+	affected, err := dbClient(ctx, r.entClient).Collection.Delete().
+		Where(collection.IDEQ(id), collection.TenantIDEQ(tenantID)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete collection failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete collection failed")
+	}
+	if affected == 0 {
+		return wardenV1.ErrorCollectionNotFound("collection not found")
+	}
+	return nil
+}
+
+// AddSecrets puts secrets of the tenant in a collection and returns how many
+// were added; secrets already in it are skipped
+func (r *CollectionRepo) AddSecrets(ctx context.Context, tenantID uint32, id string, secretIDs []string) (int, error) {
+	client := dbClient(ctx, r.entClient)
+
+	present, err := client.Collection.Query().
+		Where(collection.IDEQ(id), collection.TenantIDEQ(tenantID)).
+		QuerySecrets().
+		Where(secret.IDIn(secretIDs...)).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("list collection secrets failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("add secrets to collection failed")
+	}
+	skip := make(map[string]bool, len(present))
+	for _, secretID := range present {
+		skip[secretID] = true
+	}
+
+	var add []string
+	for _, secretID := range secretIDs {
+		if !skip[secretID] {
+			skip[secretID] = true
+			add = append(add, secretID)
+		}
+	}
+	if len(add) == 0 {
+		return 0, nil
+	}
+
+	err = client.Collection.UpdateOneID(id).
+		Where(collection.TenantIDEQ(tenantID)).
+		AddSecretIDs(add...).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, wardenV1.ErrorCollectionNotFound("collection not found")
+		}
+		r.log.Errorf("add secrets to collection failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("add secrets to collection failed")
+	}
+	return len(add), nil
+}
+
+// RemoveSecrets takes secrets out of a collection and returns how many were
+// in it
+func (r *CollectionRepo) RemoveSecrets(ctx context.Context, tenantID uint32, id string, secretIDs []string) (int, error) {
+	client := dbClient(ctx, r.entClient)
+
+	present, err := client.Collection.Query().
+		Where(collection.IDEQ(id), collection.TenantIDEQ(tenantID)).
+		QuerySecrets().
+		Where(secret.IDIn(secretIDs...)).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("list collection secrets failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("remove secrets from collection failed")
+	}
+	if len(present) == 0 {
+		return 0, nil
+	}
+
+	err = client.Collection.UpdateOneID(id).
+		Where(collection.TenantIDEQ(tenantID)).
+		RemoveSecretIDs(present...).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("remove secrets from collection failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("remove secrets from collection failed")
+	}
+	return len(present), nil
+}
+
+// ListSecrets retrieves one page of the non-deleted secrets in a collection,
+// with their folders, in name order
+func (r *CollectionRepo) ListSecrets(ctx context.Context, tenantID uint32, id string, page, pageSize uint32) ([]*ent.Secret, int, error) {
+	query := r.replica.readClient(ctx, r.entClient).Collection.Query().
+		Where(collection.IDEQ(id), collection.TenantIDEQ(tenantID)).
+		QuerySecrets().
+		Where(secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count collection secrets failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("list collection secrets failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		WithFolder().
+		Order(ent.Asc(secret.FieldName), ent.Asc(secret.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list collection secrets failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("list collection secrets failed")
+	}
+	return entities, total, nil
+}
+
+// GetSecretCollectionIDs returns the IDs of the collections a secret is in
+// (implements ResourceLookup interface). Authorization must not act on stale
+// data, so it always reads the primary.
+func (r *CollectionRepo) GetSecretCollectionIDs(ctx context.Context, tenantID uint32, secretID string) ([]string, error) {
+	ids, err := dbClient(ctx, r.entClient).Collection.Query().
+		Where(
+			collection.TenantIDEQ(tenantID),
+			collection.HasSecretsWith(secret.IDEQ(secretID)),
+		).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("get secret collections failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get secret collections failed")
+	}
+	return ids, nil
+}
+
+// ToProto converts an ent.Collection to wardenV1.Collection. The secret count
+// is only set when the secrets were loaded.
+func (r *CollectionRepo) ToProto(entity *ent.Collection) *wardenV1.Collection {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &wardenV1.Collection{
+		Id:          entity.ID,
+		TenantId:    derefUint32(entity.TenantID),
+		Name:        entity.Name,
+		Description: entity.Description,
+		ExternalId:  entity.ExternalID,
+		SecretCount: int32(len(entity.Edges.Secrets)),
+		CreatedBy:   entity.CreateBy,
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	return proto
+}
@@ -0,0 +1,242 @@
+package data
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// Cursor is the sort key and ID of the last row of a page. Lists given a
+// cursor continue right after that row (keyset pagination), so pages stay
+// stable under concurrent inserts and deep pages cost the same as the first.
+type Cursor struct {
+	Key string `json:"k"`
+	ID  string `json:"i,omitempty"`
+	// Sort is the list order the cursor was created for (empty for the default)
+	Sort string `json:"s,omitempty"`
+	// Null marks a row whose sort key is NULL
+	Null bool `json:"n,omitempty"`
+}
+
+// EncodeCursor returns the opaque form of a cursor handed to clients
+func EncodeCursor(key, id string) string {
+	return encodeCursor(Cursor{Key: key, ID: id})
+}
+
+func encodeCursor(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor parses a cursor returned by EncodeCursor. An empty string
+// yields nil, meaning the first page.
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, wardenV1.ErrorBadRequest("invalid cursor")
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, wardenV1.ErrorBadRequest("invalid cursor")
+	}
+	return &c, nil
+}
+
+// SecretCursor returns the cursor after a secret in the given list order
+func SecretCursor(e *ent.Secret, order ListSort) string {
+	return sortCursor(order, e.ID, e.Name, e.CreateTime, e.UpdateTime, e.LastAccessedTime)
+}
+
+// FolderCursor returns the cursor after a folder in the given list order
+func FolderCursor(e *ent.Folder, order ListSort) string {
+	return sortCursor(order, e.ID, e.Name, e.CreateTime, e.UpdateTime, e.LastAccessedTime)
+}
+
+func sortCursor(order ListSort, id, name string, createTime, updateTime, lastAccessed *time.Time) string {
+	c := Cursor{ID: id, Sort: order.String()}
+	var t *time.Time
+	switch order.Field {
+	case SortByCreateTime:
+		t = createTime
+	case SortByUpdateTime:
+		t = updateTime
+	case SortByLastAccessed:
+		t = lastAccessed
+	default:
+		c.Key = name
+		return encodeCursor(c)
+	}
+	if t == nil {
+		c.Null = true
+	} else {
+		c.Key = cursorTime(t)
+	}
+	return encodeCursor(c)
+}
+
+// VersionCursor returns the cursor after a version in descending version order
+func VersionCursor(e *ent.SecretVersion) string {
+	return EncodeCursor(strconv.Itoa(int(e.VersionNumber)), "")
+}
+
+// PermissionCursor returns the cursor after a permission, newest first
+func PermissionCursor(e *ent.Permission) string {
+	return EncodeCursor(cursorTime(e.CreateTime), strconv.Itoa(e.ID))
+}
+
+// AuditLogCursor returns the cursor after an audit log entry, newest first
+func AuditLogCursor(e *ent.AuditLog) string {
+	return EncodeCursor(cursorTime(e.CreateTime), strconv.FormatUint(uint64(e.ID), 10))
+}
+
+func cursorTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// timeKey parses the key of a cursor created by cursorTime
+func (c *Cursor) timeKey() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, c.Key)
+	if err != nil {
+		return time.Time{}, wardenV1.ErrorBadRequest("invalid cursor")
+	}
+	return t, nil
+}
+
+// intKey parses the key of a cursor holding a number
+func (c *Cursor) intKey() (int, error) {
+	n, err := strconv.Atoi(c.Key)
+	if err != nil {
+		return 0, wardenV1.ErrorBadRequest("invalid cursor")
+	}
+	return n, nil
+}
+
+// intID parses the ID of a cursor over integer primary keys
+func (c *Cursor) intID() (uint64, error) {
+	n, err := strconv.ParseUint(c.ID, 10, 32)
+	if err != nil {
+		return 0, wardenV1.ErrorBadRequest("invalid cursor")
+	}
+	return n, nil
+}
+
+// Sort fields of secret and folder lists, named after their columns
+const (
+	SortByName         = "name"
+	SortByCreateTime   = "create_time"
+	SortByUpdateTime   = "update_time"
+	SortByLastAccessed = "last_accessed_time"
+)
+
+// ListSort is the order of a secret or folder list. The zero value sorts by
+// name ascending. Rows with the same key are ordered by ID, and rows never
+// updated or accessed come last.
+type ListSort struct {
+	Field string
+	Desc  bool
+}
+
+func (o ListSort) String() string {
+	if o.Field == "" || (o.Field == SortByName && !o.Desc) {
+		return ""
+	}
+	if o.Desc {
+		return o.Field + ":desc"
+	}
+	return o.Field + ":asc"
+}
+
+func (o ListSort) field() string {
+	if o.Field == "" {
+		return SortByName
+	}
+	return o.Field
+}
+
+// keyColumns are the columns an ID-only page loads: the ID and the sort key
+// the cursor after its last row is built from
+func (o ListSort) keyColumns(idField string) []string {
+	return []string{idField, o.field()}
+}
+
+// ListProjection is how much of the matching rows a list query loads
+type ListProjection int
+
+const (
+	// ProjectRows loads full rows with their edges
+	ProjectRows ListProjection = iota
+	// ProjectIDs loads only the ID and sort key of each row
+	ProjectIDs
+	// ProjectCount only counts the matching rows and loads none
+	ProjectCount
+)
+
+// orderSelector orders by the sort field with NULLs last, then by ID
+func (o ListSort) orderSelector(idField string) func(*sql.Selector) {
+	field := o.field()
+	return func(s *sql.Selector) {
+		if field != SortByName {
+			s.OrderExprFunc(func(b *sql.Builder) {
+				b.WriteString("CASE WHEN ").Ident(s.C(field)).WriteString(" IS NULL THEN 1 ELSE 0 END")
+			})
+		}
+		if o.Desc {
+			s.OrderBy(sql.Desc(s.C(field)), sql.Desc(s.C(idField)))
+		} else {
+			s.OrderBy(sql.Asc(s.C(field)), sql.Asc(s.C(idField)))
+		}
+	}
+}
+
+// afterSelector restricts a list in this order to the rows after the cursor
+func (o ListSort) afterSelector(after *Cursor, idField string) (func(*sql.Selector), error) {
+	if after.Sort != o.String() {
+		return nil, wardenV1.ErrorBadRequest("cursor belongs to a different sort order")
+	}
+
+	field := o.field()
+	gt, idGT := sql.GT, sql.GT
+	if o.Desc {
+		gt, idGT = sql.LT, sql.LT
+	}
+
+	if after.Null {
+		// Only the NULL tail remains, ordered by ID
+		return func(s *sql.Selector) {
+			s.Where(sql.And(sql.IsNull(s.C(field)), idGT(s.C(idField), after.ID)))
+		}, nil
+	}
+
+	var key any = after.Key
+	if field != SortByName {
+		t, err := after.timeKey()
+		if err != nil {
+			return nil, err
+		}
+		key = t
+	}
+
+	return func(s *sql.Selector) {
+		preds := []*sql.Predicate{
+			gt(s.C(field), key),
+			sql.And(sql.EQ(s.C(field), key), idGT(s.C(idField), after.ID)),
+		}
+		if field != SortByName {
+			preds = append(preds, sql.IsNull(s.C(field)))
+		}
+		s.Where(sql.Or(preds...))
+	}, nil
+}
@@ -1,8 +1,13 @@
 package data
 
 import (
+	"context"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/redis/go-redis/v9"
 
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
@@ -38,10 +43,20 @@ type VaultConfig struct {
 	Namespace string `json:"namespace" yaml:"namespace"`
 }
 
-// NewVaultClient creates a HashiCorp Vault client
+// NewVaultClient creates a HashiCorp Vault client. With VAULT_DEV_MODE=true
+// and VAULT_ADDR unset, secrets are kept in memory instead, for local
+// development and integration tests. VAULT_RATE_LIMIT and VAULT_RATE_BURST
+// cap the requests per second it sends to Vault.
 func NewVaultClient(ctx *bootstrap.Context) (*vault.Client, func(), error) {
 	l := ctx.NewLoggerHelper("vault/data/warden-service")
 
+	if os.Getenv("VAULT_ADDR") == "" {
+		if devMode, _ := strconv.ParseBool(os.Getenv("VAULT_DEV_MODE")); devMode {
+			l.Warn("VAULT_DEV_MODE: storing secrets in memory, they are lost on restart. Never use this in production")
+			return vault.NewMemoryClient(ctx.GetLogger()), func() {}, nil
+		}
+	}
+
 	// Get Vault config from environment or config file
 	cfg := &vault.Config{
 		Address:   getEnvOrDefault("VAULT_ADDR", "http://localhost:8200"),
@@ -50,6 +65,14 @@ func NewVaultClient(ctx *bootstrap.Context) (*vault.Client, func(), error) {
 		MountPath: getEnvOrDefault("VAULT_MOUNT_PATH", "secret"),
 		Namespace: getEnvOrDefault("VAULT_NAMESPACE", ""),
 	}
+	// Vault's own "rate:burst" form of VAULT_RATE_LIMIT is read by the
+	// Vault client itself
+	if n, err := strconv.ParseFloat(getEnvOrDefault("VAULT_RATE_LIMIT", ""), 64); err == nil {
+		cfg.RateLimit = n
+	}
+	if n, err := strconv.Atoi(getEnvOrDefault("VAULT_RATE_BURST", "")); err == nil {
+		cfg.RateBurst = n
+	}
 
 	client, err := vault.NewClient(cfg, ctx.GetLogger())
 	if err != nil {
@@ -57,6 +80,8 @@ func NewVaultClient(ctx *bootstrap.Context) (*vault.Client, func(), error) {
 		return nil, func() {}, err
 	}
 
+	checkVaultCapabilities(client, l)
+
 	return client, func() {
 		if err := client.Close(); err != nil {
 			l.Error(err)
@@ -64,9 +89,89 @@ func NewVaultClient(ctx *bootstrap.Context) (*vault.Client, func(), error) {
 	}, nil
 }
 
-// NewVaultKVStore creates a Vault KV store
-func NewVaultKVStore(client *vault.Client) *vault.KVStore {
-	return vault.NewKVStore(client)
+// checkVaultCapabilities logs the capabilities the Vault token lacks on the
+// KV paths of the service, which would otherwise only show up as permission
+// denied errors once a request needs them, and the ones it does not need.
+// VAULT_CAPABILITY_CHECK=false skips the check.
+func checkVaultCapabilities(client *vault.Client, l *log.Helper) {
+	if check, err := strconv.ParseBool(getEnvOrDefault("VAULT_CAPABILITY_CHECK", "true")); err == nil && !check {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mismatches, err := client.CheckCapabilities(ctx, vault.PathPrefix)
+	if err != nil {
+		l.Warnf("Vault capability check failed: %v", err)
+		return
+	}
+	for _, m := range mismatches {
+		if len(m.Missing) > 0 {
+			l.Errorf("Vault token lacks capabilities [%s] on %s, requests needing them will fail", strings.Join(m.Missing, ", "), m.Path)
+		}
+		if len(m.Excess) > 0 {
+			l.Warnf("Vault token has capabilities [%s] on %s the service does not need", strings.Join(m.Excess, ", "), m.Path)
+		}
+	}
+	if len(mismatches) == 0 {
+		l.Infof("Vault token has exactly the capabilities the service needs")
+	}
+}
+
+// NewVaultKVStore creates a Vault KV store reporting operation metrics to m
+// and applying the version retention of tenants to new secrets.
+// VAULT_CONCURRENCY sets how many Vault requests bulk operations run at once,
+// VAULT_WRITE_RATE how many writes per second they send and
+// VAULT_SLOW_THRESHOLD how long an operation takes before it is logged.
+// Password checksums are keyed as loadChecksumKey says.
+func NewVaultKVStore(ctx *bootstrap.Context, client *vault.Client, m vault.Metrics, settings *TenantSettingRepo) (*vault.KVStore, error) {
+	kv := vault.NewKVStore(client)
+	kv.SetMetrics(m)
+	kv.SetRetentionPolicy(settings)
+	if n, err := strconv.Atoi(getEnvOrDefault("VAULT_CONCURRENCY", "")); err == nil {
+		kv.SetConcurrency(n)
+	}
+	if n, err := strconv.Atoi(getEnvOrDefault("VAULT_WRITE_RATE", strconv.Itoa(vault.DefaultWriteRate))); err == nil {
+		kv.SetWriteRate(n)
+	}
+	if v := os.Getenv("VAULT_SLOW_THRESHOLD"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			kv.SetSlowThreshold(d)
+		}
+	}
+
+	key, err := loadChecksumKey(kv)
+	if err != nil {
+		ctx.NewLoggerHelper("vault/data/warden-service").Errorf("failed to load the password checksum key: %v", err)
+		return nil, err
+	}
+	kv.SetChecksumKey(key)
+	return kv, nil
+}
+
+// loadChecksumKey returns the key of the HMAC-SHA-256 password checksums:
+// CHECKSUM_KEY or the file at CHECKSUM_KEY_FILE (base64, at least 16 bytes),
+// otherwise the key Vault holds for the service, generated on first start.
+// CHECKSUM_ALGORITHM=sha256 returns none, for plain SHA-256 checksums.
+func loadChecksumKey(kv *vault.KVStore) ([]byte, error) {
+	if strings.EqualFold(os.Getenv("CHECKSUM_ALGORITHM"), vault.ChecksumAlgorithmSHA256) {
+		return nil, nil
+	}
+	if encoded := os.Getenv("CHECKSUM_KEY"); encoded != "" {
+		return vault.ParseChecksumKey(encoded)
+	}
+	if file := os.Getenv("CHECKSUM_KEY_FILE"); file != "" {
+		encoded, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		return vault.ParseChecksumKey(string(encoded))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	return kv.LoadChecksumKey(ctx)
 }
 
 // getEnvOrDefault gets an environment variable or returns a default value
@@ -0,0 +1,194 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/deletionrequest"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// DeletionRequestRepo stores requests to permanently delete protected
+// secrets and folders. A pending request past its expiry counts as expired.
+type DeletionRequestRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewDeletionRequestRepo creates a new DeletionRequestRepo
+func NewDeletionRequestRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *DeletionRequestRepo {
+	return &DeletionRequestRepo{
+		log:       ctx.NewLoggerHelper("warden/deletion_request_repo"),
+		entClient: entClient,
+	}
+}
+
+// Create stores a pending deletion request
+func (r *DeletionRequestRepo) Create(ctx context.Context, tenantID uint32, resourceType deletionrequest.ResourceType, resourceID, resourceName string, force bool, reason, requestedBy string, expiresAt time.Time) (*ent.DeletionRequest, error) {
+	entity, err := r.entClient.Client().DeletionRequest.Create().
+		SetTenantID(tenantID).
+		SetResourceType(resourceType).
+		SetResourceID(resourceID).
+		SetResourceName(resourceName).
+		SetForce(force).
+		SetReason(reason).
+		SetRequestedBy(requestedBy).
+		SetExpiresAt(expiresAt).
+		SetCreateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("create deletion request failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("create deletion request failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a deletion request of a tenant
+func (r *DeletionRequestRepo) GetByID(ctx context.Context, tenantID, id uint32) (*ent.DeletionRequest, error) {
+	entity, err := r.entClient.Client().DeletionRequest.Query().
+		Where(deletionrequest.IDEQ(id), deletionrequest.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get deletion request failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get deletion request failed")
+	}
+	return entity, nil
+}
+
+// HasPending reports whether a resource has a pending, unexpired request
+func (r *DeletionRequestRepo) HasPending(ctx context.Context, tenantID uint32, resourceID string) (bool, error) {
+	exists, err := r.entClient.Client().DeletionRequest.Query().
+		Where(
+			deletionrequest.TenantIDEQ(tenantID),
+			deletionrequest.ResourceIDEQ(resourceID),
+			deletionrequest.StatusEQ(deletionrequest.StatusDELETION_REQUEST_STATUS_PENDING),
+			deletionrequest.ExpiresAtGT(time.Now()),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("check pending deletion request failed: %s", err.Error())
+		return false, wardenV1.ErrorInternalServerError("get deletion request failed")
+	}
+	return exists, nil
+}
+
+// List returns the deletion requests of a tenant, newest first, optionally
+// only those in one state or for one resource
+func (r *DeletionRequestRepo) List(ctx context.Context, tenantID uint32, status *wardenV1.DeletionRequestStatus, resourceID *string) ([]*ent.DeletionRequest, error) {
+	query := r.entClient.Client().DeletionRequest.Query().
+		Where(deletionrequest.TenantIDEQ(tenantID))
+	if resourceID != nil && *resourceID != "" {
+		query = query.Where(deletionrequest.ResourceIDEQ(*resourceID))
+	}
+	if status != nil && *status != wardenV1.DeletionRequestStatus_DELETION_REQUEST_STATUS_UNSPECIFIED {
+		now := time.Now()
+		pending := deletionrequest.StatusEQ(deletionrequest.StatusDELETION_REQUEST_STATUS_PENDING)
+		switch *status {
+		case wardenV1.DeletionRequestStatus_DELETION_REQUEST_STATUS_PENDING:
+			query = query.Where(pending, deletionrequest.ExpiresAtGT(now))
+		case wardenV1.DeletionRequestStatus_DELETION_REQUEST_STATUS_EXPIRED:
+			query = query.Where(deletionrequest.Or(
+				deletionrequest.StatusEQ(deletionrequest.StatusDELETION_REQUEST_STATUS_EXPIRED),
+				deletionrequest.And(pending, deletionrequest.ExpiresAtLTE(now)),
+			))
+		default:
+			query = query.Where(deletionrequest.StatusEQ(deletionrequest.Status(status.String())))
+		}
+	}
+
+	entities, err := query.
+		Order(ent.Desc(deletionrequest.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list deletion requests failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list deletion requests failed")
+	}
+	return entities, nil
+}
+
+// Decide moves a pending, unexpired request to status, recording who decided
+func (r *DeletionRequestRepo) Decide(ctx context.Context, tenantID, id uint32, status deletionrequest.Status, decidedBy string) (*ent.DeletionRequest, error) {
+	now := time.Now()
+	entity, err := r.entClient.Client().DeletionRequest.UpdateOneID(id).
+		Where(
+			deletionrequest.TenantIDEQ(tenantID),
+			deletionrequest.StatusEQ(deletionrequest.StatusDELETION_REQUEST_STATUS_PENDING),
+			deletionrequest.ExpiresAtGT(now),
+		).
+		SetStatus(status).
+		SetDecidedBy(decidedBy).
+		SetDecidedAt(now).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, wardenV1.ErrorConflict("deletion request is no longer pending")
+		}
+		r.log.Errorf("update deletion request failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("update deletion request failed")
+	}
+	return entity, nil
+}
+
+// Reopen returns an approved request to pending, when deleting the resource
+// failed after the approval was recorded
+func (r *DeletionRequestRepo) Reopen(ctx context.Context, tenantID, id uint32) error {
+	_, err := r.entClient.Client().DeletionRequest.Update().
+		Where(
+			deletionrequest.IDEQ(id),
+			deletionrequest.TenantIDEQ(tenantID),
+			deletionrequest.StatusEQ(deletionrequest.StatusDELETION_REQUEST_STATUS_APPROVED),
+		).
+		SetStatus(deletionrequest.StatusDELETION_REQUEST_STATUS_PENDING).
+		ClearDecidedBy().
+		ClearDecidedAt().
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("reopen deletion request failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("update deletion request failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.DeletionRequest to wardenV1.DeletionRequest
+func (r *DeletionRequestRepo) ToProto(entity *ent.DeletionRequest) *wardenV1.DeletionRequest {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &wardenV1.DeletionRequest{
+		Id:           entity.ID,
+		ResourceType: wardenV1.DeletionResourceType(wardenV1.DeletionResourceType_value[string(entity.ResourceType)]),
+		ResourceId:   entity.ResourceID,
+		ResourceName: entity.ResourceName,
+		Force:        entity.Force,
+		Reason:       entity.Reason,
+		Status:       wardenV1.DeletionRequestStatus(wardenV1.DeletionRequestStatus_value[string(entity.Status)]),
+		RequestedBy:  entity.RequestedBy,
+		DecidedBy:    entity.DecidedBy,
+		ExpiresAt:    timestamppb.New(entity.ExpiresAt),
+	}
+	if entity.Status == deletionrequest.StatusDELETION_REQUEST_STATUS_PENDING && !entity.ExpiresAt.After(time.Now()) {
+		proto.Status = wardenV1.DeletionRequestStatus_DELETION_REQUEST_STATUS_EXPIRED
+	}
+	if entity.DecidedAt != nil {
+		proto.DecidedAt = timestamppb.New(*entity.DecidedAt)
+	}
+	if entity.CreateTime != nil {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
@@ -0,0 +1,297 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/emergencyaccess"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// EmergencyAccessRepo stores emergency access designations and grants the
+// folder permissions they lead to
+type EmergencyAccessRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewEmergencyAccessRepo creates a new EmergencyAccessRepo
+func NewEmergencyAccessRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *EmergencyAccessRepo {
+	return &EmergencyAccessRepo{
+		log:       ctx.NewLoggerHelper("warden/emergency_access_repo"),
+		entClient: entClient,
+	}
+}
+
+// Create designates a grantee for a folder of the grantor
+func (r *EmergencyAccessRepo) Create(ctx context.Context, tenantID uint32, grantorID, granteeID, folderID string, waitDays int32) (*ent.EmergencyAccess, error) {
+	entity, err := r.entClient.Client().EmergencyAccess.Create().
+		SetTenantID(tenantID).
+		SetGrantorID(grantorID).
+		SetGranteeID(granteeID).
+		SetFolderID(folderID).
+		SetWaitDays(waitDays).
+		SetCreateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, wardenV1.ErrorConflict("this user is already an emergency contact")
+		}
+		r.log.Errorf("create emergency access failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("create emergency access failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves an emergency access of a tenant
+func (r *EmergencyAccessRepo) GetByID(ctx context.Context, tenantID, id uint32) (*ent.EmergencyAccess, error) {
+	entity, err := r.entClient.Client().EmergencyAccess.Query().
+		Where(emergencyaccess.IDEQ(id), emergencyaccess.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get emergency access failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get emergency access failed")
+	}
+	return entity, nil
+}
+
+// ListByGrantor lists the emergency contacts a user designated
+func (r *EmergencyAccessRepo) ListByGrantor(ctx context.Context, tenantID uint32, grantorID string) ([]*ent.EmergencyAccess, error) {
+	return r.list(ctx, emergencyaccess.TenantIDEQ(tenantID), emergencyaccess.GrantorIDEQ(grantorID))
+}
+
+// ListByGrantee lists the emergency accesses a user was designated for
+func (r *EmergencyAccessRepo) ListByGrantee(ctx context.Context, tenantID uint32, granteeID string) ([]*ent.EmergencyAccess, error) {
+	return r.list(ctx, emergencyaccess.TenantIDEQ(tenantID), emergencyaccess.GranteeIDEQ(granteeID))
+}
+
+func (r *EmergencyAccessRepo) list(ctx context.Context, ps ...predicate.EmergencyAccess) ([]*ent.EmergencyAccess, error) {
+	entities, err := r.entClient.Client().EmergencyAccess.Query().
+		Where(ps...).
+		Order(ent.Asc(emergencyaccess.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list emergency access failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list emergency access failed")
+	}
+	return entities, nil
+}
+
+// Request starts the waiting period of an idle emergency access
+func (r *EmergencyAccessRepo) Request(ctx context.Context, tenantID, id uint32) (*ent.EmergencyAccess, error) {
+	return r.transition(ctx, tenantID, id, emergencyaccess.StatusEMERGENCY_ACCESS_STATUS_IDLE, func(u *ent.EmergencyAccessUpdateOne) {
+		u.SetStatus(emergencyaccess.StatusEMERGENCY_ACCESS_STATUS_REQUESTED).
+			SetRequestedAt(time.Now())
+	})
+}
+
+// Reject cancels a pending request, returning the emergency access to idle
+func (r *EmergencyAccessRepo) Reject(ctx context.Context, tenantID, id uint32) (*ent.EmergencyAccess, error) {
+	return r.transition(ctx, tenantID, id, emergencyaccess.StatusEMERGENCY_ACCESS_STATUS_REQUESTED, func(u *ent.EmergencyAccessUpdateOne) {
+		u.SetStatus(emergencyaccess.StatusEMERGENCY_ACCESS_STATUS_IDLE).
+			ClearRequestedAt()
+	})
+}
+
+// transition applies update only while the emergency access is in status from,
+// so concurrent requests, rejections and grants cannot overwrite each other
+func (r *EmergencyAccessRepo) transition(ctx context.Context, tenantID, id uint32, from emergencyaccess.Status, update func(*ent.EmergencyAccessUpdateOne)) (*ent.EmergencyAccess, error) {
+	builder := r.entClient.Client().EmergencyAccess.UpdateOneID(id).
+		Where(emergencyaccess.TenantIDEQ(tenantID), emergencyaccess.StatusEQ(from)).
+		SetUpdateTime(time.Now())
+	update(builder)
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, wardenV1.ErrorConflict("emergency access is not %s", statusLabel(from))
+		}
+		r.log.Errorf("update emergency access failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("update emergency access failed")
+	}
+	return entity, nil
+}
+
+func statusLabel(s emergencyaccess.Status) string {
+	switch s {
+	case emergencyaccess.StatusEMERGENCY_ACCESS_STATUS_IDLE:
+		return "idle"
+	case emergencyaccess.StatusEMERGENCY_ACCESS_STATUS_REQUESTED:
+		return "requested"
+	default:
+		return "granted"
+	}
+}
+
+// Grant gives the grantee VIEWER on the folder and marks a requested
+// emergency access granted, in one transaction
+func (r *EmergencyAccessRepo) Grant(ctx context.Context, ea *ent.EmergencyAccess) (*ent.EmergencyAccess, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("grant emergency access failed")
+	}
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	now := time.Now()
+	entity, err := tx.EmergencyAccess.UpdateOneID(ea.ID).
+		Where(emergencyaccess.StatusEQ(emergencyaccess.StatusEMERGENCY_ACCESS_STATUS_REQUESTED)).
+		SetStatus(emergencyaccess.StatusEMERGENCY_ACCESS_STATUS_GRANTED).
+		SetGrantedAt(now).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		rollback()
+		if ent.IsNotFound(err) {
+			return nil, wardenV1.ErrorConflict("emergency access is not requested")
+		}
+		r.log.Errorf("update emergency access failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("grant emergency access failed")
+	}
+
+	exists, err := tx.Permission.Query().
+		Where(
+			permission.TenantIDEQ(derefUint32(ea.TenantID)),
+			permission.ResourceTypeEQ(permission.ResourceTypeRESOURCE_TYPE_FOLDER),
+			permission.ResourceIDEQ(ea.FolderID),
+			permission.RelationEQ(permission.RelationRELATION_VIEWER),
+			permission.SubjectTypeEQ(permission.SubjectTypeSUBJECT_TYPE_USER),
+			permission.SubjectIDEQ(ea.GranteeID),
+		).
+		Exist(ctx)
+	if err == nil && !exists {
+		err = tx.Permission.Create().
+			SetTenantID(derefUint32(ea.TenantID)).
+			SetResourceType(permission.ResourceTypeRESOURCE_TYPE_FOLDER).
+			SetResourceID(ea.FolderID).
+			SetRelation(permission.RelationRELATION_VIEWER).
+			SetSubjectType(permission.SubjectTypeSUBJECT_TYPE_USER).
+			SetSubjectID(ea.GranteeID).
+			SetCreateTime(now).
+			Exec(ctx)
+	}
+	if err != nil {
+		rollback()
+		r.log.Errorf("create emergency access permission failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("grant emergency access failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("grant emergency access failed")
+	}
+	return entity, nil
+}
+
+// Delete removes an emergency access and, if it was granted, the grantee's
+// VIEWER permission on the folder
+func (r *EmergencyAccessRepo) Delete(ctx context.Context, ea *ent.EmergencyAccess) error {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete emergency access failed")
+	}
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	if err := tx.EmergencyAccess.DeleteOneID(ea.ID).Exec(ctx); err != nil {
+		rollback()
+		if ent.IsNotFound(err) {
+			return wardenV1.ErrorNotFound("emergency access not found")
+		}
+		r.log.Errorf("delete emergency access failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete emergency access failed")
+	}
+
+	if ea.Status == emergencyaccess.StatusEMERGENCY_ACCESS_STATUS_GRANTED {
+		if _, err := tx.Permission.Delete().
+			Where(
+				permission.TenantIDEQ(derefUint32(ea.TenantID)),
+				permission.ResourceTypeEQ(permission.ResourceTypeRESOURCE_TYPE_FOLDER),
+				permission.ResourceIDEQ(ea.FolderID),
+				permission.RelationEQ(permission.RelationRELATION_VIEWER),
+				permission.SubjectTypeEQ(permission.SubjectTypeSUBJECT_TYPE_USER),
+				permission.SubjectIDEQ(ea.GranteeID),
+			).
+			Exec(ctx); err != nil {
+			rollback()
+			r.log.Errorf("delete emergency access permission failed: %s", err.Error())
+			return wardenV1.ErrorInternalServerError("delete emergency access failed")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete emergency access failed")
+	}
+	return nil
+}
+
+// ListDue returns requested emergency accesses whose waiting period is over
+func (r *EmergencyAccessRepo) ListDue(ctx context.Context, now time.Time) ([]*ent.EmergencyAccess, error) {
+	entities, err := r.entClient.Client().EmergencyAccess.Query().
+		Where(emergencyaccess.StatusEQ(emergencyaccess.StatusEMERGENCY_ACCESS_STATUS_REQUESTED)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list requested emergency access failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list emergency access failed")
+	}
+
+	due := entities[:0]
+	for _, e := range entities {
+		if e.RequestedAt != nil && !now.Before(e.RequestedAt.AddDate(0, 0, int(e.WaitDays))) {
+			due = append(due, e)
+		}
+	}
+	return due, nil
+}
+
+// ToProto converts an ent.EmergencyAccess to wardenV1.EmergencyAccess
+func (r *EmergencyAccessRepo) ToProto(entity *ent.EmergencyAccess) *wardenV1.EmergencyAccess {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &wardenV1.EmergencyAccess{
+		Id:        entity.ID,
+		TenantId:  derefUint32(entity.TenantID),
+		GrantorId: entity.GrantorID,
+		GranteeId: entity.GranteeID,
+		FolderId:  entity.FolderID,
+		WaitDays:  uint32(entity.WaitDays),
+		Status:    wardenV1.EmergencyAccessStatus(wardenV1.EmergencyAccessStatus_value[string(entity.Status)]),
+	}
+
+	if entity.RequestedAt != nil {
+		proto.RequestedAt = timestamppb.New(*entity.RequestedAt)
+		proto.GrantableAt = timestamppb.New(entity.RequestedAt.AddDate(0, 0, int(entity.WaitDays)))
+	}
+	if entity.GrantedAt != nil {
+		proto.GrantedAt = timestamppb.New(*entity.GrantedAt)
+	}
+	if entity.CreateTime != nil {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
@@ -0,0 +1,810 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditchainhead"
+)
+
+// AuditChainHeadCreate is the builder for creating a AuditChainHead entity.
+type AuditChainHeadCreate struct {
+	config
+	mutation *AuditChainHeadMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *AuditChainHeadCreate) SetCreateTime(v time.Time) *AuditChainHeadCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *AuditChainHeadCreate) SetNillableCreateTime(v *time.Time) *AuditChainHeadCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *AuditChainHeadCreate) SetUpdateTime(v time.Time) *AuditChainHeadCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *AuditChainHeadCreate) SetNillableUpdateTime(v *time.Time) *AuditChainHeadCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *AuditChainHeadCreate) SetDeleteTime(v time.Time) *AuditChainHeadCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *AuditChainHeadCreate) SetNillableDeleteTime(v *time.Time) *AuditChainHeadCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *AuditChainHeadCreate) SetTenantID(v uint32) *AuditChainHeadCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetSeq sets the "seq" field.
+func (_c *AuditChainHeadCreate) SetSeq(v int64) *AuditChainHeadCreate {
+	_c.mutation.SetSeq(v)
+	return _c
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_c *AuditChainHeadCreate) SetNillableSeq(v *int64) *AuditChainHeadCreate {
+	if v != nil {
+		_c.SetSeq(*v)
+	}
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *AuditChainHeadCreate) SetHash(v string) *AuditChainHeadCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_c *AuditChainHeadCreate) SetNillableHash(v *string) *AuditChainHeadCreate {
+	if v != nil {
+		_c.SetHash(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AuditChainHeadCreate) SetID(v uint32) *AuditChainHeadCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AuditChainHeadMutation object of the builder.
+func (_c *AuditChainHeadCreate) Mutation() *AuditChainHeadMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditChainHead in the database.
+func (_c *AuditChainHeadCreate) Save(ctx context.Context) (*AuditChainHead, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditChainHeadCreate) SaveX(ctx context.Context) *AuditChainHead {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditChainHeadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditChainHeadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuditChainHeadCreate) defaults() {
+	if _, ok := _c.mutation.Seq(); !ok {
+		v := auditchainhead.DefaultSeq
+		_c.mutation.SetSeq(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditChainHeadCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "AuditChainHead.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "AuditChainHead.seq"`)}
+	}
+	if v, ok := _c.mutation.Seq(); ok {
+		if err := auditchainhead.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "AuditChainHead.seq": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := auditchainhead.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AuditChainHead.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AuditChainHeadCreate) sqlSave(ctx context.Context) (*AuditChainHead, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditChainHeadCreate) createSpec() (*AuditChainHead, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditChainHead{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auditchainhead.Table, sqlgraph.NewFieldSpec(auditchainhead.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(auditchainhead.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(auditchainhead.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(auditchainhead.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(auditchainhead.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Seq(); ok {
+		_spec.SetField(auditchainhead.FieldSeq, field.TypeInt64, value)
+		_node.Seq = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(auditchainhead.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditChainHead.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditChainHeadUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuditChainHeadCreate) OnConflict(opts ...sql.ConflictOption) *AuditChainHeadUpsertOne {
+	_c.conflict = opts
+	return &AuditChainHeadUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditChainHead.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuditChainHeadCreate) OnConflictColumns(columns ...string) *AuditChainHeadUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuditChainHeadUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AuditChainHeadUpsertOne is the builder for "upsert"-ing
+	//  one AuditChainHead node.
+	AuditChainHeadUpsertOne struct {
+		create *AuditChainHeadCreate
+	}
+
+	// AuditChainHeadUpsert is the "OnConflict" setter.
+	AuditChainHeadUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AuditChainHeadUpsert) SetUpdateTime(v time.Time) *AuditChainHeadUpsert {
+	u.Set(auditchainhead.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AuditChainHeadUpsert) UpdateUpdateTime() *AuditChainHeadUpsert {
+	u.SetExcluded(auditchainhead.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AuditChainHeadUpsert) ClearUpdateTime() *AuditChainHeadUpsert {
+	u.SetNull(auditchainhead.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *AuditChainHeadUpsert) SetDeleteTime(v time.Time) *AuditChainHeadUpsert {
+	u.Set(auditchainhead.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AuditChainHeadUpsert) UpdateDeleteTime() *AuditChainHeadUpsert {
+	u.SetExcluded(auditchainhead.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AuditChainHeadUpsert) ClearDeleteTime() *AuditChainHeadUpsert {
+	u.SetNull(auditchainhead.FieldDeleteTime)
+	return u
+}
+
+// SetSeq sets the "seq" field.
+func (u *AuditChainHeadUpsert) SetSeq(v int64) *AuditChainHeadUpsert {
+	u.Set(auditchainhead.FieldSeq, v)
+	return u
+}
+
+// UpdateSeq sets the "seq" field to the value that was provided on create.
+func (u *AuditChainHeadUpsert) UpdateSeq() *AuditChainHeadUpsert {
+	u.SetExcluded(auditchainhead.FieldSeq)
+	return u
+}
+
+// AddSeq adds v to the "seq" field.
+func (u *AuditChainHeadUpsert) AddSeq(v int64) *AuditChainHeadUpsert {
+	u.Add(auditchainhead.FieldSeq, v)
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *AuditChainHeadUpsert) SetHash(v string) *AuditChainHeadUpsert {
+	u.Set(auditchainhead.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *AuditChainHeadUpsert) UpdateHash() *AuditChainHeadUpsert {
+	u.SetExcluded(auditchainhead.FieldHash)
+	return u
+}
+
+// ClearHash clears the value of the "hash" field.
+func (u *AuditChainHeadUpsert) ClearHash() *AuditChainHeadUpsert {
+	u.SetNull(auditchainhead.FieldHash)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditChainHead.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditchainhead.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditChainHeadUpsertOne) UpdateNewValues() *AuditChainHeadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditchainhead.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(auditchainhead.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(auditchainhead.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditChainHead.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditChainHeadUpsertOne) Ignore() *AuditChainHeadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditChainHeadUpsertOne) DoNothing() *AuditChainHeadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditChainHeadCreate.OnConflict
+// documentation for more info.
+func (u *AuditChainHeadUpsertOne) Update(set func(*AuditChainHeadUpsert)) *AuditChainHeadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditChainHeadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AuditChainHeadUpsertOne) SetUpdateTime(v time.Time) *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AuditChainHeadUpsertOne) UpdateUpdateTime() *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AuditChainHeadUpsertOne) ClearUpdateTime() *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *AuditChainHeadUpsertOne) SetDeleteTime(v time.Time) *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AuditChainHeadUpsertOne) UpdateDeleteTime() *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AuditChainHeadUpsertOne) ClearDeleteTime() *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetSeq sets the "seq" field.
+func (u *AuditChainHeadUpsertOne) SetSeq(v int64) *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.SetSeq(v)
+	})
+}
+
+// AddSeq adds v to the "seq" field.
+func (u *AuditChainHeadUpsertOne) AddSeq(v int64) *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.AddSeq(v)
+	})
+}
+
+// UpdateSeq sets the "seq" field to the value that was provided on create.
+func (u *AuditChainHeadUpsertOne) UpdateSeq() *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.UpdateSeq()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *AuditChainHeadUpsertOne) SetHash(v string) *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *AuditChainHeadUpsertOne) UpdateHash() *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// ClearHash clears the value of the "hash" field.
+func (u *AuditChainHeadUpsertOne) ClearHash() *AuditChainHeadUpsertOne {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.ClearHash()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditChainHeadUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditChainHeadCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditChainHeadUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditChainHeadUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditChainHeadUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditChainHeadCreateBulk is the builder for creating many AuditChainHead entities in bulk.
+type AuditChainHeadCreateBulk struct {
+	config
+	err      error
+	builders []*AuditChainHeadCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditChainHead entities in the database.
+func (_c *AuditChainHeadCreateBulk) Save(ctx context.Context) ([]*AuditChainHead, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditChainHead, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditChainHeadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditChainHeadCreateBulk) SaveX(ctx context.Context) []*AuditChainHead {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditChainHeadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditChainHeadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditChainHead.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditChainHeadUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuditChainHeadCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditChainHeadUpsertBulk {
+	_c.conflict = opts
+	return &AuditChainHeadUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditChainHead.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuditChainHeadCreateBulk) OnConflictColumns(columns ...string) *AuditChainHeadUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuditChainHeadUpsertBulk{
+		create: _c,
+	}
+}
+
+// AuditChainHeadUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditChainHead nodes.
+type AuditChainHeadUpsertBulk struct {
+	create *AuditChainHeadCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditChainHead.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditchainhead.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditChainHeadUpsertBulk) UpdateNewValues() *AuditChainHeadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditchainhead.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(auditchainhead.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(auditchainhead.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditChainHead.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditChainHeadUpsertBulk) Ignore() *AuditChainHeadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditChainHeadUpsertBulk) DoNothing() *AuditChainHeadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditChainHeadCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditChainHeadUpsertBulk) Update(set func(*AuditChainHeadUpsert)) *AuditChainHeadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditChainHeadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AuditChainHeadUpsertBulk) SetUpdateTime(v time.Time) *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AuditChainHeadUpsertBulk) UpdateUpdateTime() *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AuditChainHeadUpsertBulk) ClearUpdateTime() *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *AuditChainHeadUpsertBulk) SetDeleteTime(v time.Time) *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AuditChainHeadUpsertBulk) UpdateDeleteTime() *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AuditChainHeadUpsertBulk) ClearDeleteTime() *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetSeq sets the "seq" field.
+func (u *AuditChainHeadUpsertBulk) SetSeq(v int64) *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.SetSeq(v)
+	})
+}
+
+// AddSeq adds v to the "seq" field.
+func (u *AuditChainHeadUpsertBulk) AddSeq(v int64) *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.AddSeq(v)
+	})
+}
+
+// UpdateSeq sets the "seq" field to the value that was provided on create.
+func (u *AuditChainHeadUpsertBulk) UpdateSeq() *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.UpdateSeq()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *AuditChainHeadUpsertBulk) SetHash(v string) *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *AuditChainHeadUpsertBulk) UpdateHash() *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// ClearHash clears the value of the "hash" field.
+func (u *AuditChainHeadUpsertBulk) ClearHash() *AuditChainHeadUpsertBulk {
+	return u.Update(func(s *AuditChainHeadUpsert) {
+		s.ClearHash()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditChainHeadUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditChainHeadCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditChainHeadCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditChainHeadUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditchainhead"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
+)
+
+// AuditChainHeadUpdate is the builder for updating AuditChainHead entities.
+type AuditChainHeadUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditChainHeadMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditChainHeadUpdate builder.
+func (_u *AuditChainHeadUpdate) Where(ps ...predicate.AuditChainHead) *AuditChainHeadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *AuditChainHeadUpdate) SetUpdateTime(v time.Time) *AuditChainHeadUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *AuditChainHeadUpdate) SetNillableUpdateTime(v *time.Time) *AuditChainHeadUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *AuditChainHeadUpdate) ClearUpdateTime() *AuditChainHeadUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *AuditChainHeadUpdate) SetDeleteTime(v time.Time) *AuditChainHeadUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *AuditChainHeadUpdate) SetNillableDeleteTime(v *time.Time) *AuditChainHeadUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *AuditChainHeadUpdate) ClearDeleteTime() *AuditChainHeadUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetSeq sets the "seq" field.
+func (_u *AuditChainHeadUpdate) SetSeq(v int64) *AuditChainHeadUpdate {
+	_u.mutation.ResetSeq()
+	_u.mutation.SetSeq(v)
+	return _u
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_u *AuditChainHeadUpdate) SetNillableSeq(v *int64) *AuditChainHeadUpdate {
+	if v != nil {
+		_u.SetSeq(*v)
+	}
+	return _u
+}
+
+// AddSeq adds value to the "seq" field.
+func (_u *AuditChainHeadUpdate) AddSeq(v int64) *AuditChainHeadUpdate {
+	_u.mutation.AddSeq(v)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *AuditChainHeadUpdate) SetHash(v string) *AuditChainHeadUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AuditChainHeadUpdate) SetNillableHash(v *string) *AuditChainHeadUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// ClearHash clears the value of the "hash" field.
+func (_u *AuditChainHeadUpdate) ClearHash() *AuditChainHeadUpdate {
+	_u.mutation.ClearHash()
+	return _u
+}
+
+// Mutation returns the AuditChainHeadMutation object of the builder.
+func (_u *AuditChainHeadUpdate) Mutation() *AuditChainHeadMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditChainHeadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditChainHeadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditChainHeadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditChainHeadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditChainHeadUpdate) check() error {
+	if v, ok := _u.mutation.Seq(); ok {
+		if err := auditchainhead.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "AuditChainHead.seq": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditChainHeadUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditChainHeadUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditChainHeadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditchainhead.Table, auditchainhead.Columns, sqlgraph.NewFieldSpec(auditchainhead.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(auditchainhead.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(auditchainhead.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(auditchainhead.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(auditchainhead.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(auditchainhead.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Seq(); ok {
+		_spec.SetField(auditchainhead.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeq(); ok {
+		_spec.AddField(auditchainhead.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(auditchainhead.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.HashCleared() {
+		_spec.ClearField(auditchainhead.FieldHash, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditchainhead.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditChainHeadUpdateOne is the builder for updating a single AuditChainHead entity.
+type AuditChainHeadUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditChainHeadMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *AuditChainHeadUpdateOne) SetUpdateTime(v time.Time) *AuditChainHeadUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *AuditChainHeadUpdateOne) SetNillableUpdateTime(v *time.Time) *AuditChainHeadUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *AuditChainHeadUpdateOne) ClearUpdateTime() *AuditChainHeadUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *AuditChainHeadUpdateOne) SetDeleteTime(v time.Time) *AuditChainHeadUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *AuditChainHeadUpdateOne) SetNillableDeleteTime(v *time.Time) *AuditChainHeadUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *AuditChainHeadUpdateOne) ClearDeleteTime() *AuditChainHeadUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetSeq sets the "seq" field.
+func (_u *AuditChainHeadUpdateOne) SetSeq(v int64) *AuditChainHeadUpdateOne {
+	_u.mutation.ResetSeq()
+	_u.mutation.SetSeq(v)
+	return _u
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_u *AuditChainHeadUpdateOne) SetNillableSeq(v *int64) *AuditChainHeadUpdateOne {
+	if v != nil {
+		_u.SetSeq(*v)
+	}
+	return _u
+}
+
+// AddSeq adds value to the "seq" field.
+func (_u *AuditChainHeadUpdateOne) AddSeq(v int64) *AuditChainHeadUpdateOne {
+	_u.mutation.AddSeq(v)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *AuditChainHeadUpdateOne) SetHash(v string) *AuditChainHeadUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AuditChainHeadUpdateOne) SetNillableHash(v *string) *AuditChainHeadUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// ClearHash clears the value of the "hash" field.
+func (_u *AuditChainHeadUpdateOne) ClearHash() *AuditChainHeadUpdateOne {
+	_u.mutation.ClearHash()
+	return _u
+}
+
+// Mutation returns the AuditChainHeadMutation object of the builder.
+func (_u *AuditChainHeadUpdateOne) Mutation() *AuditChainHeadMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditChainHeadUpdate builder.
+func (_u *AuditChainHeadUpdateOne) Where(ps ...predicate.AuditChainHead) *AuditChainHeadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditChainHeadUpdateOne) Select(field string, fields ...string) *AuditChainHeadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditChainHead entity.
+func (_u *AuditChainHeadUpdateOne) Save(ctx context.Context) (*AuditChainHead, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditChainHeadUpdateOne) SaveX(ctx context.Context) *AuditChainHead {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditChainHeadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditChainHeadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditChainHeadUpdateOne) check() error {
+	if v, ok := _u.mutation.Seq(); ok {
+		if err := auditchainhead.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "AuditChainHead.seq": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditChainHeadUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditChainHeadUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditChainHeadUpdateOne) sqlSave(ctx context.Context) (_node *AuditChainHead, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditchainhead.Table, auditchainhead.Columns, sqlgraph.NewFieldSpec(auditchainhead.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditChainHead.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditchainhead.FieldID)
+		for _, f := range fields {
+			if !auditchainhead.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditchainhead.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(auditchainhead.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(auditchainhead.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(auditchainhead.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(auditchainhead.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(auditchainhead.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Seq(); ok {
+		_spec.SetField(auditchainhead.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeq(); ok {
+		_spec.AddField(auditchainhead.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(auditchainhead.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.HashCleared() {
+		_spec.ClearField(auditchainhead.FieldHash, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AuditChainHead{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditchainhead.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -62,7 +62,21 @@ type AuditLog struct {
 	// ECDSA signature for integrity verification
 	Signature []byte `json:"signature,omitempty"`
 	// Additional metadata
-	Metadata     map[string]string `json:"metadata,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// User ID of the caller
+	ActorID string `json:"actor_id,omitempty"`
+	// Semantic domain event (e.g. secret.password_read)
+	EventType string `json:"event_type,omitempty"`
+	// Type of the resource the event refers to
+	ResourceType string `json:"resource_type,omitempty"`
+	// ID of the resource the event refers to
+	ResourceID string `json:"resource_id,omitempty"`
+	// Per-tenant position in the hash chain (0 = not chained)
+	ChainSeq int64 `json:"chain_seq,omitempty"`
+	// Chain hash of the previous entry of the same tenant
+	PrevHash string `json:"prev_hash,omitempty"`
+	// SHA-256 over the previous chain hash and this entry's content
+	ChainHash    string `json:"chain_hash,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -75,9 +89,9 @@ func (*AuditLog) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case auditlog.FieldIsAuthenticated, auditlog.FieldSuccess:
 			values[i] = new(sql.NullBool)
-		case auditlog.FieldID, auditlog.FieldTenantID, auditlog.FieldErrorCode, auditlog.FieldLatencyMs:
+		case auditlog.FieldID, auditlog.FieldTenantID, auditlog.FieldErrorCode, auditlog.FieldLatencyMs, auditlog.FieldChainSeq:
 			values[i] = new(sql.NullInt64)
-		case auditlog.FieldAuditID, auditlog.FieldRequestID, auditlog.FieldOperation, auditlog.FieldServiceName, auditlog.FieldClientID, auditlog.FieldClientCommonName, auditlog.FieldClientOrganization, auditlog.FieldClientSerialNumber, auditlog.FieldErrorMessage, auditlog.FieldPeerAddress, auditlog.FieldLogHash:
+		case auditlog.FieldAuditID, auditlog.FieldRequestID, auditlog.FieldOperation, auditlog.FieldServiceName, auditlog.FieldClientID, auditlog.FieldClientCommonName, auditlog.FieldClientOrganization, auditlog.FieldClientSerialNumber, auditlog.FieldErrorMessage, auditlog.FieldPeerAddress, auditlog.FieldLogHash, auditlog.FieldActorID, auditlog.FieldEventType, auditlog.FieldResourceType, auditlog.FieldResourceID, auditlog.FieldPrevHash, auditlog.FieldChainHash:
 			values[i] = new(sql.NullString)
 		case auditlog.FieldCreateTime, auditlog.FieldUpdateTime, auditlog.FieldDeleteTime:
 			values[i] = new(sql.NullTime)
@@ -243,6 +257,48 @@ func (_m *AuditLog) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case auditlog.FieldActorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				_m.ActorID = value.String
+			}
+		case auditlog.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = value.String
+			}
+		case auditlog.FieldResourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_type", values[i])
+			} else if value.Valid {
+				_m.ResourceType = value.String
+			}
+		case auditlog.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case auditlog.FieldChainSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chain_seq", values[i])
+			} else if value.Valid {
+				_m.ChainSeq = value.Int64
+			}
+		case auditlog.FieldPrevHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prev_hash", values[i])
+			} else if value.Valid {
+				_m.PrevHash = value.String
+			}
+		case auditlog.FieldChainHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chain_hash", values[i])
+			} else if value.Valid {
+				_m.ChainHash = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -354,6 +410,27 @@ func (_m *AuditLog) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(_m.ActorID)
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(_m.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("resource_type=")
+	builder.WriteString(_m.ResourceType)
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("chain_seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChainSeq))
+	builder.WriteString(", ")
+	builder.WriteString("prev_hash=")
+	builder.WriteString(_m.PrevHash)
+	builder.WriteString(", ")
+	builder.WriteString("chain_hash=")
+	builder.WriteString(_m.ChainHash)
 	builder.WriteByte(')')
 	return builder.String()
 }
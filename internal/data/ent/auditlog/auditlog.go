@@ -56,6 +56,20 @@ const (
 	FieldSignature = "signature"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
+	// FieldActorID holds the string denoting the actor_id field in the database.
+	FieldActorID = "actor_id"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldResourceType holds the string denoting the resource_type field in the database.
+	FieldResourceType = "resource_type"
+	// FieldResourceID holds the string denoting the resource_id field in the database.
+	FieldResourceID = "resource_id"
+	// FieldChainSeq holds the string denoting the chain_seq field in the database.
+	FieldChainSeq = "chain_seq"
+	// FieldPrevHash holds the string denoting the prev_hash field in the database.
+	FieldPrevHash = "prev_hash"
+	// FieldChainHash holds the string denoting the chain_hash field in the database.
+	FieldChainHash = "chain_hash"
 	// Table holds the table name of the auditlog in the database.
 	Table = "warden_audit_logs"
 )
@@ -85,6 +99,13 @@ var Columns = []string{
 	FieldLogHash,
 	FieldSignature,
 	FieldMetadata,
+	FieldActorID,
+	FieldEventType,
+	FieldResourceType,
+	FieldResourceID,
+	FieldChainSeq,
+	FieldPrevHash,
+	FieldChainHash,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -119,6 +140,8 @@ var (
 	DefaultSuccess bool
 	// DefaultLatencyMs holds the default value on creation for the "latency_ms" field.
 	DefaultLatencyMs int64
+	// DefaultChainSeq holds the default value on creation for the "chain_seq" field.
+	DefaultChainSeq int64
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(uint32) error
 )
@@ -225,3 +248,38 @@ func ByPeerAddress(opts ...sql.OrderTermOption) OrderOption {
 func ByLogHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLogHash, opts...).ToFunc()
 }
+
+// ByActorID orders the results by the actor_id field.
+func ByActorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorID, opts...).ToFunc()
+}
+
+// ByEventType orders the results by the event_type field.
+func ByEventType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventType, opts...).ToFunc()
+}
+
+// ByResourceType orders the results by the resource_type field.
+func ByResourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceType, opts...).ToFunc()
+}
+
+// ByResourceID orders the results by the resource_id field.
+func ByResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceID, opts...).ToFunc()
+}
+
+// ByChainSeq orders the results by the chain_seq field.
+func ByChainSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChainSeq, opts...).ToFunc()
+}
+
+// ByPrevHash orders the results by the prev_hash field.
+func ByPrevHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrevHash, opts...).ToFunc()
+}
+
+// ByChainHash orders the results by the chain_hash field.
+func ByChainHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChainHash, opts...).ToFunc()
+}
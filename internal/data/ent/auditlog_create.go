@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditlog"
@@ -18,6 +19,7 @@ type AuditLogCreate struct {
 	config
 	mutation *AuditLogMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateTime sets the "create_time" field.
@@ -288,6 +290,104 @@ func (_c *AuditLogCreate) SetMetadata(v map[string]string) *AuditLogCreate {
 	return _c
 }
 
+// SetActorID sets the "actor_id" field.
+func (_c *AuditLogCreate) SetActorID(v string) *AuditLogCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableActorID(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *AuditLogCreate) SetEventType(v string) *AuditLogCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableEventType(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetEventType(*v)
+	}
+	return _c
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_c *AuditLogCreate) SetResourceType(v string) *AuditLogCreate {
+	_c.mutation.SetResourceType(v)
+	return _c
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableResourceType(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetResourceType(*v)
+	}
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *AuditLogCreate) SetResourceID(v string) *AuditLogCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableResourceID(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetResourceID(*v)
+	}
+	return _c
+}
+
+// SetChainSeq sets the "chain_seq" field.
+func (_c *AuditLogCreate) SetChainSeq(v int64) *AuditLogCreate {
+	_c.mutation.SetChainSeq(v)
+	return _c
+}
+
+// SetNillableChainSeq sets the "chain_seq" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableChainSeq(v *int64) *AuditLogCreate {
+	if v != nil {
+		_c.SetChainSeq(*v)
+	}
+	return _c
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (_c *AuditLogCreate) SetPrevHash(v string) *AuditLogCreate {
+	_c.mutation.SetPrevHash(v)
+	return _c
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillablePrevHash(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetPrevHash(*v)
+	}
+	return _c
+}
+
+// SetChainHash sets the "chain_hash" field.
+func (_c *AuditLogCreate) SetChainHash(v string) *AuditLogCreate {
+	_c.mutation.SetChainHash(v)
+	return _c
+}
+
+// SetNillableChainHash sets the "chain_hash" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableChainHash(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetChainHash(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AuditLogCreate) SetID(v uint32) *AuditLogCreate {
 	_c.mutation.SetID(v)
@@ -351,6 +451,10 @@ func (_c *AuditLogCreate) defaults() error {
 		v := auditlog.DefaultLatencyMs
 		_c.mutation.SetLatencyMs(v)
 	}
+	if _, ok := _c.mutation.ChainSeq(); !ok {
+		v := auditlog.DefaultChainSeq
+		_c.mutation.SetChainSeq(v)
+	}
 	return nil
 }
 
@@ -384,6 +488,9 @@ func (_c *AuditLogCreate) check() error {
 	if _, ok := _c.mutation.LatencyMs(); !ok {
 		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "AuditLog.latency_ms"`)}
 	}
+	if _, ok := _c.mutation.ChainSeq(); !ok {
+		return &ValidationError{Name: "chain_seq", err: errors.New(`ent: missing required field "AuditLog.chain_seq"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := auditlog.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AuditLog.id": %w`, err)}
@@ -417,6 +524,7 @@ func (_c *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
 		_node = &AuditLog{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint32))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -509,93 +617,1919 @@ func (_c *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(auditlog.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeString, value)
+		_node.ActorID = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(auditlog.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.ResourceType(); ok {
+		_spec.SetField(auditlog.FieldResourceType, field.TypeString, value)
+		_node.ResourceType = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(auditlog.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.ChainSeq(); ok {
+		_spec.SetField(auditlog.FieldChainSeq, field.TypeInt64, value)
+		_node.ChainSeq = value
+	}
+	if value, ok := _c.mutation.PrevHash(); ok {
+		_spec.SetField(auditlog.FieldPrevHash, field.TypeString, value)
+		_node.PrevHash = value
+	}
+	if value, ok := _c.mutation.ChainHash(); ok {
+		_spec.SetField(auditlog.FieldChainHash, field.TypeString, value)
+		_node.ChainHash = value
+	}
 	return _node, _spec
 }
 
-// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
-type AuditLogCreateBulk struct {
-	config
-	err      error
-	builders []*AuditLogCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuditLogCreate) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertOne {
+	_c.conflict = opts
+	return &AuditLogUpsertOne{
+		create: _c,
+	}
 }
 
-// Save creates the AuditLog entities in the database.
-func (_c *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
-	if _c.err != nil {
-		return nil, _c.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuditLogCreate) OnConflictColumns(columns ...string) *AuditLogUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertOne{
+		create: _c,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*AuditLog, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*AuditLogMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = uint32(id)
-				}
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// AuditLogUpsertOne is the builder for "upsert"-ing
+	//  one AuditLog node.
+	AuditLogUpsertOne struct {
+		create *AuditLogCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// AuditLogUpsert is the "OnConflict" setter.
+	AuditLogUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AuditLogUpsert) SetUpdateTime(v time.Time) *AuditLogUpsert {
+	u.Set(auditlog.FieldUpdateTime, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateUpdateTime() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldUpdateTime)
+	return u
 }
 
-// Exec executes the query.
-func (_c *AuditLogCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AuditLogUpsert) ClearUpdateTime() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldUpdateTime)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *AuditLogCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
+// SetDeleteTime sets the "delete_time" field.
+func (u *AuditLogUpsert) SetDeleteTime(v time.Time) *AuditLogUpsert {
+	u.Set(auditlog.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateDeleteTime() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AuditLogUpsert) ClearDeleteTime() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldDeleteTime)
+	return u
+}
+
+// SetAuditID sets the "audit_id" field.
+func (u *AuditLogUpsert) SetAuditID(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldAuditID, v)
+	return u
+}
+
+// UpdateAuditID sets the "audit_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateAuditID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldAuditID)
+	return u
+}
+
+// SetRequestID sets the "request_id" field.
+func (u *AuditLogUpsert) SetRequestID(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldRequestID, v)
+	return u
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateRequestID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldRequestID)
+	return u
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *AuditLogUpsert) ClearRequestID() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldRequestID)
+	return u
+}
+
+// SetOperation sets the "operation" field.
+func (u *AuditLogUpsert) SetOperation(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldOperation, v)
+	return u
+}
+
+// UpdateOperation sets the "operation" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateOperation() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldOperation)
+	return u
+}
+
+// SetServiceName sets the "service_name" field.
+func (u *AuditLogUpsert) SetServiceName(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldServiceName, v)
+	return u
+}
+
+// UpdateServiceName sets the "service_name" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateServiceName() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldServiceName)
+	return u
+}
+
+// SetClientID sets the "client_id" field.
+func (u *AuditLogUpsert) SetClientID(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldClientID, v)
+	return u
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateClientID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldClientID)
+	return u
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (u *AuditLogUpsert) ClearClientID() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldClientID)
+	return u
+}
+
+// SetClientCommonName sets the "client_common_name" field.
+func (u *AuditLogUpsert) SetClientCommonName(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldClientCommonName, v)
+	return u
+}
+
+// UpdateClientCommonName sets the "client_common_name" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateClientCommonName() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldClientCommonName)
+	return u
+}
+
+// ClearClientCommonName clears the value of the "client_common_name" field.
+func (u *AuditLogUpsert) ClearClientCommonName() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldClientCommonName)
+	return u
+}
+
+// SetClientOrganization sets the "client_organization" field.
+func (u *AuditLogUpsert) SetClientOrganization(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldClientOrganization, v)
+	return u
+}
+
+// UpdateClientOrganization sets the "client_organization" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateClientOrganization() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldClientOrganization)
+	return u
+}
+
+// ClearClientOrganization clears the value of the "client_organization" field.
+func (u *AuditLogUpsert) ClearClientOrganization() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldClientOrganization)
+	return u
+}
+
+// SetClientSerialNumber sets the "client_serial_number" field.
+func (u *AuditLogUpsert) SetClientSerialNumber(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldClientSerialNumber, v)
+	return u
+}
+
+// UpdateClientSerialNumber sets the "client_serial_number" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateClientSerialNumber() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldClientSerialNumber)
+	return u
+}
+
+// ClearClientSerialNumber clears the value of the "client_serial_number" field.
+func (u *AuditLogUpsert) ClearClientSerialNumber() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldClientSerialNumber)
+	return u
+}
+
+// SetIsAuthenticated sets the "is_authenticated" field.
+func (u *AuditLogUpsert) SetIsAuthenticated(v bool) *AuditLogUpsert {
+	u.Set(auditlog.FieldIsAuthenticated, v)
+	return u
+}
+
+// UpdateIsAuthenticated sets the "is_authenticated" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateIsAuthenticated() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldIsAuthenticated)
+	return u
+}
+
+// SetSuccess sets the "success" field.
+func (u *AuditLogUpsert) SetSuccess(v bool) *AuditLogUpsert {
+	u.Set(auditlog.FieldSuccess, v)
+	return u
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateSuccess() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldSuccess)
+	return u
+}
+
+// SetErrorCode sets the "error_code" field.
+func (u *AuditLogUpsert) SetErrorCode(v int32) *AuditLogUpsert {
+	u.Set(auditlog.FieldErrorCode, v)
+	return u
+}
+
+// UpdateErrorCode sets the "error_code" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateErrorCode() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldErrorCode)
+	return u
+}
+
+// AddErrorCode adds v to the "error_code" field.
+func (u *AuditLogUpsert) AddErrorCode(v int32) *AuditLogUpsert {
+	u.Add(auditlog.FieldErrorCode, v)
+	return u
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (u *AuditLogUpsert) ClearErrorCode() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldErrorCode)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AuditLogUpsert) SetErrorMessage(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateErrorMessage() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AuditLogUpsert) ClearErrorMessage() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldErrorMessage)
+	return u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *AuditLogUpsert) SetLatencyMs(v int64) *AuditLogUpsert {
+	u.Set(auditlog.FieldLatencyMs, v)
+	return u
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateLatencyMs() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldLatencyMs)
+	return u
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *AuditLogUpsert) AddLatencyMs(v int64) *AuditLogUpsert {
+	u.Add(auditlog.FieldLatencyMs, v)
+	return u
+}
+
+// SetPeerAddress sets the "peer_address" field.
+func (u *AuditLogUpsert) SetPeerAddress(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldPeerAddress, v)
+	return u
+}
+
+// UpdatePeerAddress sets the "peer_address" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdatePeerAddress() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldPeerAddress)
+	return u
+}
+
+// ClearPeerAddress clears the value of the "peer_address" field.
+func (u *AuditLogUpsert) ClearPeerAddress() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldPeerAddress)
+	return u
+}
+
+// SetGeoLocation sets the "geo_location" field.
+func (u *AuditLogUpsert) SetGeoLocation(v map[string]string) *AuditLogUpsert {
+	u.Set(auditlog.FieldGeoLocation, v)
+	return u
+}
+
+// UpdateGeoLocation sets the "geo_location" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateGeoLocation() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldGeoLocation)
+	return u
+}
+
+// ClearGeoLocation clears the value of the "geo_location" field.
+func (u *AuditLogUpsert) ClearGeoLocation() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldGeoLocation)
+	return u
+}
+
+// SetLogHash sets the "log_hash" field.
+func (u *AuditLogUpsert) SetLogHash(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldLogHash, v)
+	return u
+}
+
+// UpdateLogHash sets the "log_hash" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateLogHash() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldLogHash)
+	return u
+}
+
+// ClearLogHash clears the value of the "log_hash" field.
+func (u *AuditLogUpsert) ClearLogHash() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldLogHash)
+	return u
+}
+
+// SetSignature sets the "signature" field.
+func (u *AuditLogUpsert) SetSignature(v []byte) *AuditLogUpsert {
+	u.Set(auditlog.FieldSignature, v)
+	return u
+}
+
+// UpdateSignature sets the "signature" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateSignature() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldSignature)
+	return u
+}
+
+// ClearSignature clears the value of the "signature" field.
+func (u *AuditLogUpsert) ClearSignature() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldSignature)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AuditLogUpsert) SetMetadata(v map[string]string) *AuditLogUpsert {
+	u.Set(auditlog.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateMetadata() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AuditLogUpsert) ClearMetadata() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldMetadata)
+	return u
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditLogUpsert) SetActorID(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldActorID, v)
+	return u
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateActorID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldActorID)
+	return u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditLogUpsert) ClearActorID() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldActorID)
+	return u
+}
+
+// SetEventType sets the "event_type" field.
+func (u *AuditLogUpsert) SetEventType(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldEventType, v)
+	return u
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateEventType() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldEventType)
+	return u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (u *AuditLogUpsert) ClearEventType() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldEventType)
+	return u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *AuditLogUpsert) SetResourceType(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldResourceType, v)
+	return u
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateResourceType() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldResourceType)
+	return u
+}
+
+// ClearResourceType clears the value of the "resource_type" field.
+func (u *AuditLogUpsert) ClearResourceType() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldResourceType)
+	return u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *AuditLogUpsert) SetResourceID(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldResourceID, v)
+	return u
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateResourceID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldResourceID)
+	return u
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (u *AuditLogUpsert) ClearResourceID() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldResourceID)
+	return u
+}
+
+// SetChainSeq sets the "chain_seq" field.
+func (u *AuditLogUpsert) SetChainSeq(v int64) *AuditLogUpsert {
+	u.Set(auditlog.FieldChainSeq, v)
+	return u
+}
+
+// UpdateChainSeq sets the "chain_seq" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateChainSeq() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldChainSeq)
+	return u
+}
+
+// AddChainSeq adds v to the "chain_seq" field.
+func (u *AuditLogUpsert) AddChainSeq(v int64) *AuditLogUpsert {
+	u.Add(auditlog.FieldChainSeq, v)
+	return u
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (u *AuditLogUpsert) SetPrevHash(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldPrevHash, v)
+	return u
+}
+
+// UpdatePrevHash sets the "prev_hash" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdatePrevHash() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldPrevHash)
+	return u
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (u *AuditLogUpsert) ClearPrevHash() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldPrevHash)
+	return u
+}
+
+// SetChainHash sets the "chain_hash" field.
+func (u *AuditLogUpsert) SetChainHash(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldChainHash, v)
+	return u
+}
+
+// UpdateChainHash sets the "chain_hash" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateChainHash() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldChainHash)
+	return u
+}
+
+// ClearChainHash clears the value of the "chain_hash" field.
+func (u *AuditLogUpsert) ClearChainHash() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldChainHash)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertOne) UpdateNewValues() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditlog.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(auditlog.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(auditlog.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditLogUpsertOne) Ignore() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertOne) DoNothing() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreate.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertOne) Update(set func(*AuditLogUpsert)) *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AuditLogUpsertOne) SetUpdateTime(v time.Time) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateUpdateTime() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AuditLogUpsertOne) ClearUpdateTime() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *AuditLogUpsertOne) SetDeleteTime(v time.Time) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateDeleteTime() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AuditLogUpsertOne) ClearDeleteTime() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetAuditID sets the "audit_id" field.
+func (u *AuditLogUpsertOne) SetAuditID(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetAuditID(v)
+	})
+}
+
+// UpdateAuditID sets the "audit_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateAuditID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateAuditID()
+	})
+}
+
+// SetRequestID sets the "request_id" field.
+func (u *AuditLogUpsertOne) SetRequestID(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRequestID(v)
+	})
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateRequestID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRequestID()
+	})
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *AuditLogUpsertOne) ClearRequestID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearRequestID()
+	})
+}
+
+// SetOperation sets the "operation" field.
+func (u *AuditLogUpsertOne) SetOperation(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetOperation(v)
+	})
+}
+
+// UpdateOperation sets the "operation" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateOperation() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateOperation()
+	})
+}
+
+// SetServiceName sets the "service_name" field.
+func (u *AuditLogUpsertOne) SetServiceName(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetServiceName(v)
+	})
+}
+
+// UpdateServiceName sets the "service_name" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateServiceName() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateServiceName()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *AuditLogUpsertOne) SetClientID(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateClientID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (u *AuditLogUpsertOne) ClearClientID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearClientID()
+	})
+}
+
+// SetClientCommonName sets the "client_common_name" field.
+func (u *AuditLogUpsertOne) SetClientCommonName(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetClientCommonName(v)
+	})
+}
+
+// UpdateClientCommonName sets the "client_common_name" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateClientCommonName() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateClientCommonName()
+	})
+}
+
+// ClearClientCommonName clears the value of the "client_common_name" field.
+func (u *AuditLogUpsertOne) ClearClientCommonName() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearClientCommonName()
+	})
+}
+
+// SetClientOrganization sets the "client_organization" field.
+func (u *AuditLogUpsertOne) SetClientOrganization(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetClientOrganization(v)
+	})
+}
+
+// UpdateClientOrganization sets the "client_organization" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateClientOrganization() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateClientOrganization()
+	})
+}
+
+// ClearClientOrganization clears the value of the "client_organization" field.
+func (u *AuditLogUpsertOne) ClearClientOrganization() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearClientOrganization()
+	})
+}
+
+// SetClientSerialNumber sets the "client_serial_number" field.
+func (u *AuditLogUpsertOne) SetClientSerialNumber(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetClientSerialNumber(v)
+	})
+}
+
+// UpdateClientSerialNumber sets the "client_serial_number" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateClientSerialNumber() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateClientSerialNumber()
+	})
+}
+
+// ClearClientSerialNumber clears the value of the "client_serial_number" field.
+func (u *AuditLogUpsertOne) ClearClientSerialNumber() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearClientSerialNumber()
+	})
+}
+
+// SetIsAuthenticated sets the "is_authenticated" field.
+func (u *AuditLogUpsertOne) SetIsAuthenticated(v bool) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetIsAuthenticated(v)
+	})
+}
+
+// UpdateIsAuthenticated sets the "is_authenticated" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateIsAuthenticated() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateIsAuthenticated()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *AuditLogUpsertOne) SetSuccess(v bool) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateSuccess() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// SetErrorCode sets the "error_code" field.
+func (u *AuditLogUpsertOne) SetErrorCode(v int32) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetErrorCode(v)
+	})
+}
+
+// AddErrorCode adds v to the "error_code" field.
+func (u *AuditLogUpsertOne) AddErrorCode(v int32) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddErrorCode(v)
+	})
+}
+
+// UpdateErrorCode sets the "error_code" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateErrorCode() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateErrorCode()
+	})
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (u *AuditLogUpsertOne) ClearErrorCode() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearErrorCode()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AuditLogUpsertOne) SetErrorMessage(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateErrorMessage() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AuditLogUpsertOne) ClearErrorMessage() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *AuditLogUpsertOne) SetLatencyMs(v int64) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetLatencyMs(v)
+	})
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *AuditLogUpsertOne) AddLatencyMs(v int64) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddLatencyMs(v)
+	})
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateLatencyMs() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateLatencyMs()
+	})
+}
+
+// SetPeerAddress sets the "peer_address" field.
+func (u *AuditLogUpsertOne) SetPeerAddress(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPeerAddress(v)
+	})
+}
+
+// UpdatePeerAddress sets the "peer_address" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdatePeerAddress() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePeerAddress()
+	})
+}
+
+// ClearPeerAddress clears the value of the "peer_address" field.
+func (u *AuditLogUpsertOne) ClearPeerAddress() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPeerAddress()
+	})
+}
+
+// SetGeoLocation sets the "geo_location" field.
+func (u *AuditLogUpsertOne) SetGeoLocation(v map[string]string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetGeoLocation(v)
+	})
+}
+
+// UpdateGeoLocation sets the "geo_location" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateGeoLocation() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateGeoLocation()
+	})
+}
+
+// ClearGeoLocation clears the value of the "geo_location" field.
+func (u *AuditLogUpsertOne) ClearGeoLocation() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearGeoLocation()
+	})
+}
+
+// SetLogHash sets the "log_hash" field.
+func (u *AuditLogUpsertOne) SetLogHash(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetLogHash(v)
+	})
+}
+
+// UpdateLogHash sets the "log_hash" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateLogHash() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateLogHash()
+	})
+}
+
+// ClearLogHash clears the value of the "log_hash" field.
+func (u *AuditLogUpsertOne) ClearLogHash() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearLogHash()
+	})
+}
+
+// SetSignature sets the "signature" field.
+func (u *AuditLogUpsertOne) SetSignature(v []byte) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetSignature(v)
+	})
+}
+
+// UpdateSignature sets the "signature" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateSignature() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateSignature()
+	})
+}
+
+// ClearSignature clears the value of the "signature" field.
+func (u *AuditLogUpsertOne) ClearSignature() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearSignature()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AuditLogUpsertOne) SetMetadata(v map[string]string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateMetadata() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AuditLogUpsertOne) ClearMetadata() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditLogUpsertOne) SetActorID(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetActorID(v)
+	})
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateActorID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateActorID()
+	})
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditLogUpsertOne) ClearActorID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearActorID()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *AuditLogUpsertOne) SetEventType(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateEventType() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (u *AuditLogUpsertOne) ClearEventType() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearEventType()
+	})
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *AuditLogUpsertOne) SetResourceType(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetResourceType(v)
+	})
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateResourceType() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateResourceType()
+	})
+}
+
+// ClearResourceType clears the value of the "resource_type" field.
+func (u *AuditLogUpsertOne) ClearResourceType() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearResourceType()
+	})
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *AuditLogUpsertOne) SetResourceID(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetResourceID(v)
+	})
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateResourceID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateResourceID()
+	})
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (u *AuditLogUpsertOne) ClearResourceID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearResourceID()
+	})
+}
+
+// SetChainSeq sets the "chain_seq" field.
+func (u *AuditLogUpsertOne) SetChainSeq(v int64) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetChainSeq(v)
+	})
+}
+
+// AddChainSeq adds v to the "chain_seq" field.
+func (u *AuditLogUpsertOne) AddChainSeq(v int64) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddChainSeq(v)
+	})
+}
+
+// UpdateChainSeq sets the "chain_seq" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateChainSeq() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateChainSeq()
+	})
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (u *AuditLogUpsertOne) SetPrevHash(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPrevHash(v)
+	})
+}
+
+// UpdatePrevHash sets the "prev_hash" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdatePrevHash() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePrevHash()
+	})
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (u *AuditLogUpsertOne) ClearPrevHash() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPrevHash()
+	})
+}
+
+// SetChainHash sets the "chain_hash" field.
+func (u *AuditLogUpsertOne) SetChainHash(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetChainHash(v)
+	})
+}
+
+// UpdateChainHash sets the "chain_hash" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateChainHash() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateChainHash()
+	})
+}
+
+// ClearChainHash clears the value of the "chain_hash" field.
+func (u *AuditLogUpsertOne) ClearChainHash() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearChainHash()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditLogUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditLogUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditLog entities in the database.
+func (_c *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertBulk {
+	_c.conflict = opts
+	return &AuditLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuditLogCreateBulk) OnConflictColumns(columns ...string) *AuditLogUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// AuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditLog nodes.
+type AuditLogUpsertBulk struct {
+	create *AuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) UpdateNewValues() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditlog.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(auditlog.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(auditlog.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) Ignore() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertBulk) DoNothing() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertBulk) Update(set func(*AuditLogUpsert)) *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AuditLogUpsertBulk) SetUpdateTime(v time.Time) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateUpdateTime() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AuditLogUpsertBulk) ClearUpdateTime() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *AuditLogUpsertBulk) SetDeleteTime(v time.Time) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateDeleteTime() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AuditLogUpsertBulk) ClearDeleteTime() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetAuditID sets the "audit_id" field.
+func (u *AuditLogUpsertBulk) SetAuditID(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetAuditID(v)
+	})
+}
+
+// UpdateAuditID sets the "audit_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateAuditID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateAuditID()
+	})
+}
+
+// SetRequestID sets the "request_id" field.
+func (u *AuditLogUpsertBulk) SetRequestID(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRequestID(v)
+	})
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateRequestID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRequestID()
+	})
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *AuditLogUpsertBulk) ClearRequestID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearRequestID()
+	})
+}
+
+// SetOperation sets the "operation" field.
+func (u *AuditLogUpsertBulk) SetOperation(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetOperation(v)
+	})
+}
+
+// UpdateOperation sets the "operation" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateOperation() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateOperation()
+	})
+}
+
+// SetServiceName sets the "service_name" field.
+func (u *AuditLogUpsertBulk) SetServiceName(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetServiceName(v)
+	})
+}
+
+// UpdateServiceName sets the "service_name" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateServiceName() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateServiceName()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *AuditLogUpsertBulk) SetClientID(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateClientID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (u *AuditLogUpsertBulk) ClearClientID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearClientID()
+	})
+}
+
+// SetClientCommonName sets the "client_common_name" field.
+func (u *AuditLogUpsertBulk) SetClientCommonName(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetClientCommonName(v)
+	})
+}
+
+// UpdateClientCommonName sets the "client_common_name" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateClientCommonName() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateClientCommonName()
+	})
+}
+
+// ClearClientCommonName clears the value of the "client_common_name" field.
+func (u *AuditLogUpsertBulk) ClearClientCommonName() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearClientCommonName()
+	})
+}
+
+// SetClientOrganization sets the "client_organization" field.
+func (u *AuditLogUpsertBulk) SetClientOrganization(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetClientOrganization(v)
+	})
+}
+
+// UpdateClientOrganization sets the "client_organization" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateClientOrganization() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateClientOrganization()
+	})
+}
+
+// ClearClientOrganization clears the value of the "client_organization" field.
+func (u *AuditLogUpsertBulk) ClearClientOrganization() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearClientOrganization()
+	})
+}
+
+// SetClientSerialNumber sets the "client_serial_number" field.
+func (u *AuditLogUpsertBulk) SetClientSerialNumber(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetClientSerialNumber(v)
+	})
+}
+
+// UpdateClientSerialNumber sets the "client_serial_number" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateClientSerialNumber() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateClientSerialNumber()
+	})
+}
+
+// ClearClientSerialNumber clears the value of the "client_serial_number" field.
+func (u *AuditLogUpsertBulk) ClearClientSerialNumber() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearClientSerialNumber()
+	})
+}
+
+// SetIsAuthenticated sets the "is_authenticated" field.
+func (u *AuditLogUpsertBulk) SetIsAuthenticated(v bool) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetIsAuthenticated(v)
+	})
+}
+
+// UpdateIsAuthenticated sets the "is_authenticated" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateIsAuthenticated() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateIsAuthenticated()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *AuditLogUpsertBulk) SetSuccess(v bool) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateSuccess() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// SetErrorCode sets the "error_code" field.
+func (u *AuditLogUpsertBulk) SetErrorCode(v int32) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetErrorCode(v)
+	})
+}
+
+// AddErrorCode adds v to the "error_code" field.
+func (u *AuditLogUpsertBulk) AddErrorCode(v int32) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddErrorCode(v)
+	})
+}
+
+// UpdateErrorCode sets the "error_code" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateErrorCode() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateErrorCode()
+	})
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (u *AuditLogUpsertBulk) ClearErrorCode() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearErrorCode()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AuditLogUpsertBulk) SetErrorMessage(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateErrorMessage() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AuditLogUpsertBulk) ClearErrorMessage() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *AuditLogUpsertBulk) SetLatencyMs(v int64) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetLatencyMs(v)
+	})
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *AuditLogUpsertBulk) AddLatencyMs(v int64) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddLatencyMs(v)
+	})
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateLatencyMs() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateLatencyMs()
+	})
+}
+
+// SetPeerAddress sets the "peer_address" field.
+func (u *AuditLogUpsertBulk) SetPeerAddress(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPeerAddress(v)
+	})
+}
+
+// UpdatePeerAddress sets the "peer_address" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdatePeerAddress() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePeerAddress()
+	})
+}
+
+// ClearPeerAddress clears the value of the "peer_address" field.
+func (u *AuditLogUpsertBulk) ClearPeerAddress() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPeerAddress()
+	})
+}
+
+// SetGeoLocation sets the "geo_location" field.
+func (u *AuditLogUpsertBulk) SetGeoLocation(v map[string]string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetGeoLocation(v)
+	})
+}
+
+// UpdateGeoLocation sets the "geo_location" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateGeoLocation() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateGeoLocation()
+	})
+}
+
+// ClearGeoLocation clears the value of the "geo_location" field.
+func (u *AuditLogUpsertBulk) ClearGeoLocation() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearGeoLocation()
+	})
+}
+
+// SetLogHash sets the "log_hash" field.
+func (u *AuditLogUpsertBulk) SetLogHash(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetLogHash(v)
+	})
+}
+
+// UpdateLogHash sets the "log_hash" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateLogHash() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateLogHash()
+	})
+}
+
+// ClearLogHash clears the value of the "log_hash" field.
+func (u *AuditLogUpsertBulk) ClearLogHash() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearLogHash()
+	})
+}
+
+// SetSignature sets the "signature" field.
+func (u *AuditLogUpsertBulk) SetSignature(v []byte) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetSignature(v)
+	})
+}
+
+// UpdateSignature sets the "signature" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateSignature() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateSignature()
+	})
+}
+
+// ClearSignature clears the value of the "signature" field.
+func (u *AuditLogUpsertBulk) ClearSignature() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearSignature()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AuditLogUpsertBulk) SetMetadata(v map[string]string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateMetadata() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AuditLogUpsertBulk) ClearMetadata() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditLogUpsertBulk) SetActorID(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetActorID(v)
+	})
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateActorID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateActorID()
+	})
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditLogUpsertBulk) ClearActorID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearActorID()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *AuditLogUpsertBulk) SetEventType(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateEventType() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (u *AuditLogUpsertBulk) ClearEventType() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearEventType()
+	})
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *AuditLogUpsertBulk) SetResourceType(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetResourceType(v)
+	})
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateResourceType() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateResourceType()
+	})
+}
+
+// ClearResourceType clears the value of the "resource_type" field.
+func (u *AuditLogUpsertBulk) ClearResourceType() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearResourceType()
+	})
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *AuditLogUpsertBulk) SetResourceID(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetResourceID(v)
+	})
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateResourceID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateResourceID()
+	})
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (u *AuditLogUpsertBulk) ClearResourceID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearResourceID()
+	})
+}
+
+// SetChainSeq sets the "chain_seq" field.
+func (u *AuditLogUpsertBulk) SetChainSeq(v int64) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetChainSeq(v)
+	})
+}
+
+// AddChainSeq adds v to the "chain_seq" field.
+func (u *AuditLogUpsertBulk) AddChainSeq(v int64) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddChainSeq(v)
+	})
+}
+
+// UpdateChainSeq sets the "chain_seq" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateChainSeq() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateChainSeq()
+	})
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (u *AuditLogUpsertBulk) SetPrevHash(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPrevHash(v)
+	})
+}
+
+// UpdatePrevHash sets the "prev_hash" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdatePrevHash() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePrevHash()
+	})
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (u *AuditLogUpsertBulk) ClearPrevHash() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPrevHash()
+	})
+}
+
+// SetChainHash sets the "chain_hash" field.
+func (u *AuditLogUpsertBulk) SetChainHash(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetChainHash(v)
+	})
+}
+
+// UpdateChainHash sets the "chain_hash" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateChainHash() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateChainHash()
+	})
+}
+
+// ClearChainHash clears the value of the "chain_hash" field.
+func (u *AuditLogUpsertBulk) ClearChainHash() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearChainHash()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
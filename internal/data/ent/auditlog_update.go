@@ -18,8 +18,9 @@ import (
 // AuditLogUpdate is the builder for updating AuditLog entities.
 type AuditLogUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AuditLogMutation
+	hooks     []Hook
+	mutation  *AuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AuditLogUpdate builder.
@@ -382,6 +383,147 @@ func (_u *AuditLogUpdate) ClearMetadata() *AuditLogUpdate {
 	return _u
 }
 
+// SetActorID sets the "actor_id" field.
+func (_u *AuditLogUpdate) SetActorID(v string) *AuditLogUpdate {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableActorID(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *AuditLogUpdate) ClearActorID() *AuditLogUpdate {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *AuditLogUpdate) SetEventType(v string) *AuditLogUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableEventType(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *AuditLogUpdate) ClearEventType() *AuditLogUpdate {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_u *AuditLogUpdate) SetResourceType(v string) *AuditLogUpdate {
+	_u.mutation.SetResourceType(v)
+	return _u
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableResourceType(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetResourceType(*v)
+	}
+	return _u
+}
+
+// ClearResourceType clears the value of the "resource_type" field.
+func (_u *AuditLogUpdate) ClearResourceType() *AuditLogUpdate {
+	_u.mutation.ClearResourceType()
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *AuditLogUpdate) SetResourceID(v string) *AuditLogUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableResourceID(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (_u *AuditLogUpdate) ClearResourceID() *AuditLogUpdate {
+	_u.mutation.ClearResourceID()
+	return _u
+}
+
+// SetChainSeq sets the "chain_seq" field.
+func (_u *AuditLogUpdate) SetChainSeq(v int64) *AuditLogUpdate {
+	_u.mutation.ResetChainSeq()
+	_u.mutation.SetChainSeq(v)
+	return _u
+}
+
+// SetNillableChainSeq sets the "chain_seq" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableChainSeq(v *int64) *AuditLogUpdate {
+	if v != nil {
+		_u.SetChainSeq(*v)
+	}
+	return _u
+}
+
+// AddChainSeq adds value to the "chain_seq" field.
+func (_u *AuditLogUpdate) AddChainSeq(v int64) *AuditLogUpdate {
+	_u.mutation.AddChainSeq(v)
+	return _u
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (_u *AuditLogUpdate) SetPrevHash(v string) *AuditLogUpdate {
+	_u.mutation.SetPrevHash(v)
+	return _u
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillablePrevHash(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetPrevHash(*v)
+	}
+	return _u
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (_u *AuditLogUpdate) ClearPrevHash() *AuditLogUpdate {
+	_u.mutation.ClearPrevHash()
+	return _u
+}
+
+// SetChainHash sets the "chain_hash" field.
+func (_u *AuditLogUpdate) SetChainHash(v string) *AuditLogUpdate {
+	_u.mutation.SetChainHash(v)
+	return _u
+}
+
+// SetNillableChainHash sets the "chain_hash" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableChainHash(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetChainHash(*v)
+	}
+	return _u
+}
+
+// ClearChainHash clears the value of the "chain_hash" field.
+func (_u *AuditLogUpdate) ClearChainHash() *AuditLogUpdate {
+	_u.mutation.ClearChainHash()
+	return _u
+}
+
 // Mutation returns the AuditLogMutation object of the builder.
 func (_u *AuditLogUpdate) Mutation() *AuditLogMutation {
 	return _u.mutation
@@ -429,6 +571,12 @@ func (_u *AuditLogUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -555,6 +703,49 @@ func (_u *AuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(auditlog.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeString, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(auditlog.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(auditlog.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResourceType(); ok {
+		_spec.SetField(auditlog.FieldResourceType, field.TypeString, value)
+	}
+	if _u.mutation.ResourceTypeCleared() {
+		_spec.ClearField(auditlog.FieldResourceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(auditlog.FieldResourceID, field.TypeString, value)
+	}
+	if _u.mutation.ResourceIDCleared() {
+		_spec.ClearField(auditlog.FieldResourceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChainSeq(); ok {
+		_spec.SetField(auditlog.FieldChainSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChainSeq(); ok {
+		_spec.AddField(auditlog.FieldChainSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PrevHash(); ok {
+		_spec.SetField(auditlog.FieldPrevHash, field.TypeString, value)
+	}
+	if _u.mutation.PrevHashCleared() {
+		_spec.ClearField(auditlog.FieldPrevHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChainHash(); ok {
+		_spec.SetField(auditlog.FieldChainHash, field.TypeString, value)
+	}
+	if _u.mutation.ChainHashCleared() {
+		_spec.ClearField(auditlog.FieldChainHash, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{auditlog.Label}
@@ -570,9 +761,10 @@ func (_u *AuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // AuditLogUpdateOne is the builder for updating a single AuditLog entity.
 type AuditLogUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AuditLogMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUpdateTime sets the "update_time" field.
@@ -929,6 +1121,147 @@ func (_u *AuditLogUpdateOne) ClearMetadata() *AuditLogUpdateOne {
 	return _u
 }
 
+// SetActorID sets the "actor_id" field.
+func (_u *AuditLogUpdateOne) SetActorID(v string) *AuditLogUpdateOne {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableActorID(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *AuditLogUpdateOne) ClearActorID() *AuditLogUpdateOne {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *AuditLogUpdateOne) SetEventType(v string) *AuditLogUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableEventType(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *AuditLogUpdateOne) ClearEventType() *AuditLogUpdateOne {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_u *AuditLogUpdateOne) SetResourceType(v string) *AuditLogUpdateOne {
+	_u.mutation.SetResourceType(v)
+	return _u
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableResourceType(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetResourceType(*v)
+	}
+	return _u
+}
+
+// ClearResourceType clears the value of the "resource_type" field.
+func (_u *AuditLogUpdateOne) ClearResourceType() *AuditLogUpdateOne {
+	_u.mutation.ClearResourceType()
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *AuditLogUpdateOne) SetResourceID(v string) *AuditLogUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableResourceID(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (_u *AuditLogUpdateOne) ClearResourceID() *AuditLogUpdateOne {
+	_u.mutation.ClearResourceID()
+	return _u
+}
+
+// SetChainSeq sets the "chain_seq" field.
+func (_u *AuditLogUpdateOne) SetChainSeq(v int64) *AuditLogUpdateOne {
+	_u.mutation.ResetChainSeq()
+	_u.mutation.SetChainSeq(v)
+	return _u
+}
+
+// SetNillableChainSeq sets the "chain_seq" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableChainSeq(v *int64) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetChainSeq(*v)
+	}
+	return _u
+}
+
+// AddChainSeq adds value to the "chain_seq" field.
+func (_u *AuditLogUpdateOne) AddChainSeq(v int64) *AuditLogUpdateOne {
+	_u.mutation.AddChainSeq(v)
+	return _u
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (_u *AuditLogUpdateOne) SetPrevHash(v string) *AuditLogUpdateOne {
+	_u.mutation.SetPrevHash(v)
+	return _u
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillablePrevHash(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetPrevHash(*v)
+	}
+	return _u
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (_u *AuditLogUpdateOne) ClearPrevHash() *AuditLogUpdateOne {
+	_u.mutation.ClearPrevHash()
+	return _u
+}
+
+// SetChainHash sets the "chain_hash" field.
+func (_u *AuditLogUpdateOne) SetChainHash(v string) *AuditLogUpdateOne {
+	_u.mutation.SetChainHash(v)
+	return _u
+}
+
+// SetNillableChainHash sets the "chain_hash" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableChainHash(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetChainHash(*v)
+	}
+	return _u
+}
+
+// ClearChainHash clears the value of the "chain_hash" field.
+func (_u *AuditLogUpdateOne) ClearChainHash() *AuditLogUpdateOne {
+	_u.mutation.ClearChainHash()
+	return _u
+}
+
 // Mutation returns the AuditLogMutation object of the builder.
 func (_u *AuditLogUpdateOne) Mutation() *AuditLogMutation {
 	return _u.mutation
@@ -989,6 +1322,12 @@ func (_u *AuditLogUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1132,6 +1471,49 @@ func (_u *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(auditlog.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeString, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(auditlog.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(auditlog.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResourceType(); ok {
+		_spec.SetField(auditlog.FieldResourceType, field.TypeString, value)
+	}
+	if _u.mutation.ResourceTypeCleared() {
+		_spec.ClearField(auditlog.FieldResourceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(auditlog.FieldResourceID, field.TypeString, value)
+	}
+	if _u.mutation.ResourceIDCleared() {
+		_spec.ClearField(auditlog.FieldResourceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChainSeq(); ok {
+		_spec.SetField(auditlog.FieldChainSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChainSeq(); ok {
+		_spec.AddField(auditlog.FieldChainSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PrevHash(); ok {
+		_spec.SetField(auditlog.FieldPrevHash, field.TypeString, value)
+	}
+	if _u.mutation.PrevHashCleared() {
+		_spec.ClearField(auditlog.FieldPrevHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChainHash(); ok {
+		_spec.SetField(auditlog.FieldChainHash, field.TypeString, value)
+	}
+	if _u.mutation.ChainHashCleared() {
+		_spec.ClearField(auditlog.FieldChainHash, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AuditLog{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
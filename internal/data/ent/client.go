@@ -15,11 +15,24 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditchainhead"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/collection"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/deletionrequest"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/emergencyaccess"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/fieldkey"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folderchangelog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/pendingoperation"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretusage"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretversion"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/securityalert"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/tenantsetting"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/versionpin"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhook"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhookdelivery"
 )
 
 // Client is the client that holds all ent builders.
@@ -27,16 +40,42 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditChainHead is the client for interacting with the AuditChainHead builders.
+	AuditChainHead *AuditChainHeadClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// Collection is the client for interacting with the Collection builders.
+	Collection *CollectionClient
+	// DeletionRequest is the client for interacting with the DeletionRequest builders.
+	DeletionRequest *DeletionRequestClient
+	// EmergencyAccess is the client for interacting with the EmergencyAccess builders.
+	EmergencyAccess *EmergencyAccessClient
+	// FieldKey is the client for interacting with the FieldKey builders.
+	FieldKey *FieldKeyClient
 	// Folder is the client for interacting with the Folder builders.
 	Folder *FolderClient
+	// FolderChangeLog is the client for interacting with the FolderChangeLog builders.
+	FolderChangeLog *FolderChangeLogClient
+	// PendingOperation is the client for interacting with the PendingOperation builders.
+	PendingOperation *PendingOperationClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
 	// Secret is the client for interacting with the Secret builders.
 	Secret *SecretClient
+	// SecretUsage is the client for interacting with the SecretUsage builders.
+	SecretUsage *SecretUsageClient
 	// SecretVersion is the client for interacting with the SecretVersion builders.
 	SecretVersion *SecretVersionClient
+	// SecurityAlert is the client for interacting with the SecurityAlert builders.
+	SecurityAlert *SecurityAlertClient
+	// TenantSetting is the client for interacting with the TenantSetting builders.
+	TenantSetting *TenantSettingClient
+	// VersionPin is the client for interacting with the VersionPin builders.
+	VersionPin *VersionPinClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -48,11 +87,24 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditChainHead = NewAuditChainHeadClient(c.config)
 	c.AuditLog = NewAuditLogClient(c.config)
+	c.Collection = NewCollectionClient(c.config)
+	c.DeletionRequest = NewDeletionRequestClient(c.config)
+	c.EmergencyAccess = NewEmergencyAccessClient(c.config)
+	c.FieldKey = NewFieldKeyClient(c.config)
 	c.Folder = NewFolderClient(c.config)
+	c.FolderChangeLog = NewFolderChangeLogClient(c.config)
+	c.PendingOperation = NewPendingOperationClient(c.config)
 	c.Permission = NewPermissionClient(c.config)
 	c.Secret = NewSecretClient(c.config)
+	c.SecretUsage = NewSecretUsageClient(c.config)
 	c.SecretVersion = NewSecretVersionClient(c.config)
+	c.SecurityAlert = NewSecurityAlertClient(c.config)
+	c.TenantSetting = NewTenantSettingClient(c.config)
+	c.VersionPin = NewVersionPinClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 type (
@@ -143,13 +195,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		AuditLog:      NewAuditLogClient(cfg),
-		Folder:        NewFolderClient(cfg),
-		Permission:    NewPermissionClient(cfg),
-		Secret:        NewSecretClient(cfg),
-		SecretVersion: NewSecretVersionClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		AuditChainHead:   NewAuditChainHeadClient(cfg),
+		AuditLog:         NewAuditLogClient(cfg),
+		Collection:       NewCollectionClient(cfg),
+		DeletionRequest:  NewDeletionRequestClient(cfg),
+		EmergencyAccess:  NewEmergencyAccessClient(cfg),
+		FieldKey:         NewFieldKeyClient(cfg),
+		Folder:           NewFolderClient(cfg),
+		FolderChangeLog:  NewFolderChangeLogClient(cfg),
+		PendingOperation: NewPendingOperationClient(cfg),
+		Permission:       NewPermissionClient(cfg),
+		Secret:           NewSecretClient(cfg),
+		SecretUsage:      NewSecretUsageClient(cfg),
+		SecretVersion:    NewSecretVersionClient(cfg),
+		SecurityAlert:    NewSecurityAlertClient(cfg),
+		TenantSetting:    NewTenantSettingClient(cfg),
+		VersionPin:       NewVersionPinClient(cfg),
+		Webhook:          NewWebhookClient(cfg),
+		WebhookDelivery:  NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -167,20 +232,33 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		AuditLog:      NewAuditLogClient(cfg),
-		Folder:        NewFolderClient(cfg),
-		Permission:    NewPermissionClient(cfg),
-		Secret:        NewSecretClient(cfg),
-		SecretVersion: NewSecretVersionClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		AuditChainHead:   NewAuditChainHeadClient(cfg),
+		AuditLog:         NewAuditLogClient(cfg),
+		Collection:       NewCollectionClient(cfg),
+		DeletionRequest:  NewDeletionRequestClient(cfg),
+		EmergencyAccess:  NewEmergencyAccessClient(cfg),
+		FieldKey:         NewFieldKeyClient(cfg),
+		Folder:           NewFolderClient(cfg),
+		FolderChangeLog:  NewFolderChangeLogClient(cfg),
+		PendingOperation: NewPendingOperationClient(cfg),
+		Permission:       NewPermissionClient(cfg),
+		Secret:           NewSecretClient(cfg),
+		SecretUsage:      NewSecretUsageClient(cfg),
+		SecretVersion:    NewSecretVersionClient(cfg),
+		SecurityAlert:    NewSecurityAlertClient(cfg),
+		TenantSetting:    NewTenantSettingClient(cfg),
+		VersionPin:       NewVersionPinClient(cfg),
+		Webhook:          NewWebhookClient(cfg),
+		WebhookDelivery:  NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AuditLog.
+//		AuditChainHead.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -202,41 +280,206 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.AuditLog.Use(hooks...)
-	c.Folder.Use(hooks...)
-	c.Permission.Use(hooks...)
-	c.Secret.Use(hooks...)
-	c.SecretVersion.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AuditChainHead, c.AuditLog, c.Collection, c.DeletionRequest,
+		c.EmergencyAccess, c.FieldKey, c.Folder, c.FolderChangeLog, c.PendingOperation,
+		c.Permission, c.Secret, c.SecretUsage, c.SecretVersion, c.SecurityAlert,
+		c.TenantSetting, c.VersionPin, c.Webhook, c.WebhookDelivery,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.AuditLog.Intercept(interceptors...)
-	c.Folder.Intercept(interceptors...)
-	c.Permission.Intercept(interceptors...)
-	c.Secret.Intercept(interceptors...)
-	c.SecretVersion.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AuditChainHead, c.AuditLog, c.Collection, c.DeletionRequest,
+		c.EmergencyAccess, c.FieldKey, c.Folder, c.FolderChangeLog, c.PendingOperation,
+		c.Permission, c.Secret, c.SecretUsage, c.SecretVersion, c.SecurityAlert,
+		c.TenantSetting, c.VersionPin, c.Webhook, c.WebhookDelivery,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditChainHeadMutation:
+		return c.AuditChainHead.mutate(ctx, m)
 	case *AuditLogMutation:
 		return c.AuditLog.mutate(ctx, m)
+	case *CollectionMutation:
+		return c.Collection.mutate(ctx, m)
+	case *DeletionRequestMutation:
+		return c.DeletionRequest.mutate(ctx, m)
+	case *EmergencyAccessMutation:
+		return c.EmergencyAccess.mutate(ctx, m)
+	case *FieldKeyMutation:
+		return c.FieldKey.mutate(ctx, m)
 	case *FolderMutation:
 		return c.Folder.mutate(ctx, m)
+	case *FolderChangeLogMutation:
+		return c.FolderChangeLog.mutate(ctx, m)
+	case *PendingOperationMutation:
+		return c.PendingOperation.mutate(ctx, m)
 	case *PermissionMutation:
 		return c.Permission.mutate(ctx, m)
 	case *SecretMutation:
 		return c.Secret.mutate(ctx, m)
+	case *SecretUsageMutation:
+		return c.SecretUsage.mutate(ctx, m)
 	case *SecretVersionMutation:
 		return c.SecretVersion.mutate(ctx, m)
+	case *SecurityAlertMutation:
+		return c.SecurityAlert.mutate(ctx, m)
+	case *TenantSettingMutation:
+		return c.TenantSetting.mutate(ctx, m)
+	case *VersionPinMutation:
+		return c.VersionPin.mutate(ctx, m)
+	case *WebhookMutation:
+		return c.Webhook.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AuditChainHeadClient is a client for the AuditChainHead schema.
+type AuditChainHeadClient struct {
+	config
+}
+
+// NewAuditChainHeadClient returns a client for the AuditChainHead from the given config.
+func NewAuditChainHeadClient(c config) *AuditChainHeadClient {
+	return &AuditChainHeadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditchainhead.Hooks(f(g(h())))`.
+func (c *AuditChainHeadClient) Use(hooks ...Hook) {
+	c.hooks.AuditChainHead = append(c.hooks.AuditChainHead, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditchainhead.Intercept(f(g(h())))`.
+func (c *AuditChainHeadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditChainHead = append(c.inters.AuditChainHead, interceptors...)
+}
+
+// Create returns a builder for creating a AuditChainHead entity.
+func (c *AuditChainHeadClient) Create() *AuditChainHeadCreate {
+	mutation := newAuditChainHeadMutation(c.config, OpCreate)
+	return &AuditChainHeadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditChainHead entities.
+func (c *AuditChainHeadClient) CreateBulk(builders ...*AuditChainHeadCreate) *AuditChainHeadCreateBulk {
+	return &AuditChainHeadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditChainHeadClient) MapCreateBulk(slice any, setFunc func(*AuditChainHeadCreate, int)) *AuditChainHeadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditChainHeadCreateBulk{err: fmt.Errorf("calling to AuditChainHeadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditChainHeadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditChainHeadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditChainHead.
+func (c *AuditChainHeadClient) Update() *AuditChainHeadUpdate {
+	mutation := newAuditChainHeadMutation(c.config, OpUpdate)
+	return &AuditChainHeadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditChainHeadClient) UpdateOne(_m *AuditChainHead) *AuditChainHeadUpdateOne {
+	mutation := newAuditChainHeadMutation(c.config, OpUpdateOne, withAuditChainHead(_m))
+	return &AuditChainHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditChainHeadClient) UpdateOneID(id uint32) *AuditChainHeadUpdateOne {
+	mutation := newAuditChainHeadMutation(c.config, OpUpdateOne, withAuditChainHeadID(id))
+	return &AuditChainHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditChainHead.
+func (c *AuditChainHeadClient) Delete() *AuditChainHeadDelete {
+	mutation := newAuditChainHeadMutation(c.config, OpDelete)
+	return &AuditChainHeadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditChainHeadClient) DeleteOne(_m *AuditChainHead) *AuditChainHeadDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditChainHeadClient) DeleteOneID(id uint32) *AuditChainHeadDeleteOne {
+	builder := c.Delete().Where(auditchainhead.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditChainHeadDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditChainHead.
+func (c *AuditChainHeadClient) Query() *AuditChainHeadQuery {
+	return &AuditChainHeadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditChainHead},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditChainHead entity by its id.
+func (c *AuditChainHeadClient) Get(ctx context.Context, id uint32) (*AuditChainHead, error) {
+	return c.Query().Where(auditchainhead.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditChainHeadClient) GetX(ctx context.Context, id uint32) *AuditChainHead {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditChainHeadClient) Hooks() []Hook {
+	return c.hooks.AuditChainHead
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditChainHeadClient) Interceptors() []Interceptor {
+	return c.inters.AuditChainHead
+}
+
+func (c *AuditChainHeadClient) mutate(ctx context.Context, m *AuditChainHeadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditChainHeadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditChainHeadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditChainHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditChainHeadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditChainHead mutation op: %q", m.Op())
+	}
+}
+
 // AuditLogClient is a client for the AuditLog schema.
 type AuditLogClient struct {
 	config
@@ -371,107 +614,107 @@ func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value
 	}
 }
 
-// FolderClient is a client for the Folder schema.
-type FolderClient struct {
+// CollectionClient is a client for the Collection schema.
+type CollectionClient struct {
 	config
 }
 
-// NewFolderClient returns a client for the Folder from the given config.
-func NewFolderClient(c config) *FolderClient {
-	return &FolderClient{config: c}
+// NewCollectionClient returns a client for the Collection from the given config.
+func NewCollectionClient(c config) *CollectionClient {
+	return &CollectionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `folder.Hooks(f(g(h())))`.
-func (c *FolderClient) Use(hooks ...Hook) {
-	c.hooks.Folder = append(c.hooks.Folder, hooks...)
+// A call to `Use(f, g, h)` equals to `collection.Hooks(f(g(h())))`.
+func (c *CollectionClient) Use(hooks ...Hook) {
+	c.hooks.Collection = append(c.hooks.Collection, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `folder.Intercept(f(g(h())))`.
-func (c *FolderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Folder = append(c.inters.Folder, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `collection.Intercept(f(g(h())))`.
+func (c *CollectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Collection = append(c.inters.Collection, interceptors...)
 }
 
-// Create returns a builder for creating a Folder entity.
-func (c *FolderClient) Create() *FolderCreate {
-	mutation := newFolderMutation(c.config, OpCreate)
-	return &FolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Collection entity.
+func (c *CollectionClient) Create() *CollectionCreate {
+	mutation := newCollectionMutation(c.config, OpCreate)
+	return &CollectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Folder entities.
-func (c *FolderClient) CreateBulk(builders ...*FolderCreate) *FolderCreateBulk {
-	return &FolderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Collection entities.
+func (c *CollectionClient) CreateBulk(builders ...*CollectionCreate) *CollectionCreateBulk {
+	return &CollectionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FolderClient) MapCreateBulk(slice any, setFunc func(*FolderCreate, int)) *FolderCreateBulk {
+func (c *CollectionClient) MapCreateBulk(slice any, setFunc func(*CollectionCreate, int)) *CollectionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FolderCreateBulk{err: fmt.Errorf("calling to FolderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CollectionCreateBulk{err: fmt.Errorf("calling to CollectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FolderCreate, rv.Len())
+	builders := make([]*CollectionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FolderCreateBulk{config: c.config, builders: builders}
+	return &CollectionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Folder.
-func (c *FolderClient) Update() *FolderUpdate {
-	mutation := newFolderMutation(c.config, OpUpdate)
-	return &FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Collection.
+func (c *CollectionClient) Update() *CollectionUpdate {
+	mutation := newCollectionMutation(c.config, OpUpdate)
+	return &CollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FolderClient) UpdateOne(_m *Folder) *FolderUpdateOne {
-	mutation := newFolderMutation(c.config, OpUpdateOne, withFolder(_m))
-	return &FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CollectionClient) UpdateOne(_m *Collection) *CollectionUpdateOne {
+	mutation := newCollectionMutation(c.config, OpUpdateOne, withCollection(_m))
+	return &CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FolderClient) UpdateOneID(id string) *FolderUpdateOne {
-	mutation := newFolderMutation(c.config, OpUpdateOne, withFolderID(id))
-	return &FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CollectionClient) UpdateOneID(id string) *CollectionUpdateOne {
+	mutation := newCollectionMutation(c.config, OpUpdateOne, withCollectionID(id))
+	return &CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Folder.
-func (c *FolderClient) Delete() *FolderDelete {
-	mutation := newFolderMutation(c.config, OpDelete)
-	return &FolderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Collection.
+func (c *CollectionClient) Delete() *CollectionDelete {
+	mutation := newCollectionMutation(c.config, OpDelete)
+	return &CollectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FolderClient) DeleteOne(_m *Folder) *FolderDeleteOne {
+func (c *CollectionClient) DeleteOne(_m *Collection) *CollectionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FolderClient) DeleteOneID(id string) *FolderDeleteOne {
-	builder := c.Delete().Where(folder.ID(id))
+func (c *CollectionClient) DeleteOneID(id string) *CollectionDeleteOne {
+	builder := c.Delete().Where(collection.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FolderDeleteOne{builder}
+	return &CollectionDeleteOne{builder}
 }
 
-// Query returns a query builder for Folder.
-func (c *FolderClient) Query() *FolderQuery {
-	return &FolderQuery{
+// Query returns a query builder for Collection.
+func (c *CollectionClient) Query() *CollectionQuery {
+	return &CollectionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFolder},
+		ctx:    &QueryContext{Type: TypeCollection},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Folder entity by its id.
-func (c *FolderClient) Get(ctx context.Context, id string) (*Folder, error) {
-	return c.Query().Where(folder.ID(id)).Only(ctx)
+// Get returns a Collection entity by its id.
+func (c *CollectionClient) Get(ctx context.Context, id string) (*Collection, error) {
+	return c.Query().Where(collection.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FolderClient) GetX(ctx context.Context, id string) *Folder {
+func (c *CollectionClient) GetX(ctx context.Context, id string) *Collection {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -479,63 +722,15 @@ func (c *FolderClient) GetX(ctx context.Context, id string) *Folder {
 	return obj
 }
 
-// QueryParent queries the parent edge of a Folder.
-func (c *FolderClient) QueryParent(_m *Folder) *FolderQuery {
-	query := (&FolderClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(folder.Table, folder.FieldID, id),
-			sqlgraph.To(folder.Table, folder.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, folder.ParentTable, folder.ParentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryChildren queries the children edge of a Folder.
-func (c *FolderClient) QueryChildren(_m *Folder) *FolderQuery {
-	query := (&FolderClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(folder.Table, folder.FieldID, id),
-			sqlgraph.To(folder.Table, folder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, folder.ChildrenTable, folder.ChildrenColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QuerySecrets queries the secrets edge of a Folder.
-func (c *FolderClient) QuerySecrets(_m *Folder) *SecretQuery {
+// QuerySecrets queries the secrets edge of a Collection.
+func (c *CollectionClient) QuerySecrets(_m *Collection) *SecretQuery {
 	query := (&SecretClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.From(collection.Table, collection.FieldID, id),
 			sqlgraph.To(secret.Table, secret.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, folder.SecretsTable, folder.SecretsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPermissions queries the permissions edge of a Folder.
-func (c *FolderClient) QueryPermissions(_m *Folder) *PermissionQuery {
-	query := (&PermissionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(folder.Table, folder.FieldID, id),
-			sqlgraph.To(permission.Table, permission.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, folder.PermissionsTable, folder.PermissionsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, collection.SecretsTable, collection.SecretsPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -544,132 +739,132 @@ func (c *FolderClient) QueryPermissions(_m *Folder) *PermissionQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *FolderClient) Hooks() []Hook {
-	hooks := c.hooks.Folder
-	return append(hooks[:len(hooks):len(hooks)], folder.Hooks[:]...)
+func (c *CollectionClient) Hooks() []Hook {
+	hooks := c.hooks.Collection
+	return append(hooks[:len(hooks):len(hooks)], collection.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *FolderClient) Interceptors() []Interceptor {
-	return c.inters.Folder
+func (c *CollectionClient) Interceptors() []Interceptor {
+	return c.inters.Collection
 }
 
-func (c *FolderClient) mutate(ctx context.Context, m *FolderMutation) (Value, error) {
+func (c *CollectionClient) mutate(ctx context.Context, m *CollectionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FolderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FolderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CollectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Folder mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Collection mutation op: %q", m.Op())
 	}
 }
 
-// PermissionClient is a client for the Permission schema.
-type PermissionClient struct {
+// DeletionRequestClient is a client for the DeletionRequest schema.
+type DeletionRequestClient struct {
 	config
 }
 
-// NewPermissionClient returns a client for the Permission from the given config.
-func NewPermissionClient(c config) *PermissionClient {
-	return &PermissionClient{config: c}
+// NewDeletionRequestClient returns a client for the DeletionRequest from the given config.
+func NewDeletionRequestClient(c config) *DeletionRequestClient {
+	return &DeletionRequestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `permission.Hooks(f(g(h())))`.
-func (c *PermissionClient) Use(hooks ...Hook) {
-	c.hooks.Permission = append(c.hooks.Permission, hooks...)
+// A call to `Use(f, g, h)` equals to `deletionrequest.Hooks(f(g(h())))`.
+func (c *DeletionRequestClient) Use(hooks ...Hook) {
+	c.hooks.DeletionRequest = append(c.hooks.DeletionRequest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `permission.Intercept(f(g(h())))`.
-func (c *PermissionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Permission = append(c.inters.Permission, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `deletionrequest.Intercept(f(g(h())))`.
+func (c *DeletionRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeletionRequest = append(c.inters.DeletionRequest, interceptors...)
 }
 
-// Create returns a builder for creating a Permission entity.
-func (c *PermissionClient) Create() *PermissionCreate {
-	mutation := newPermissionMutation(c.config, OpCreate)
-	return &PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeletionRequest entity.
+func (c *DeletionRequestClient) Create() *DeletionRequestCreate {
+	mutation := newDeletionRequestMutation(c.config, OpCreate)
+	return &DeletionRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Permission entities.
-func (c *PermissionClient) CreateBulk(builders ...*PermissionCreate) *PermissionCreateBulk {
-	return &PermissionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeletionRequest entities.
+func (c *DeletionRequestClient) CreateBulk(builders ...*DeletionRequestCreate) *DeletionRequestCreateBulk {
+	return &DeletionRequestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PermissionClient) MapCreateBulk(slice any, setFunc func(*PermissionCreate, int)) *PermissionCreateBulk {
+func (c *DeletionRequestClient) MapCreateBulk(slice any, setFunc func(*DeletionRequestCreate, int)) *DeletionRequestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PermissionCreateBulk{err: fmt.Errorf("calling to PermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeletionRequestCreateBulk{err: fmt.Errorf("calling to DeletionRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PermissionCreate, rv.Len())
+	builders := make([]*DeletionRequestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PermissionCreateBulk{config: c.config, builders: builders}
+	return &DeletionRequestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Permission.
-func (c *PermissionClient) Update() *PermissionUpdate {
-	mutation := newPermissionMutation(c.config, OpUpdate)
-	return &PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeletionRequest.
+func (c *DeletionRequestClient) Update() *DeletionRequestUpdate {
+	mutation := newDeletionRequestMutation(c.config, OpUpdate)
+	return &DeletionRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PermissionClient) UpdateOne(_m *Permission) *PermissionUpdateOne {
-	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermission(_m))
-	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeletionRequestClient) UpdateOne(_m *DeletionRequest) *DeletionRequestUpdateOne {
+	mutation := newDeletionRequestMutation(c.config, OpUpdateOne, withDeletionRequest(_m))
+	return &DeletionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PermissionClient) UpdateOneID(id int) *PermissionUpdateOne {
-	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermissionID(id))
-	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeletionRequestClient) UpdateOneID(id uint32) *DeletionRequestUpdateOne {
+	mutation := newDeletionRequestMutation(c.config, OpUpdateOne, withDeletionRequestID(id))
+	return &DeletionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Permission.
-func (c *PermissionClient) Delete() *PermissionDelete {
-	mutation := newPermissionMutation(c.config, OpDelete)
-	return &PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeletionRequest.
+func (c *DeletionRequestClient) Delete() *DeletionRequestDelete {
+	mutation := newDeletionRequestMutation(c.config, OpDelete)
+	return &DeletionRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PermissionClient) DeleteOne(_m *Permission) *PermissionDeleteOne {
+func (c *DeletionRequestClient) DeleteOne(_m *DeletionRequest) *DeletionRequestDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PermissionClient) DeleteOneID(id int) *PermissionDeleteOne {
-	builder := c.Delete().Where(permission.ID(id))
+func (c *DeletionRequestClient) DeleteOneID(id uint32) *DeletionRequestDeleteOne {
+	builder := c.Delete().Where(deletionrequest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PermissionDeleteOne{builder}
+	return &DeletionRequestDeleteOne{builder}
 }
 
-// Query returns a query builder for Permission.
-func (c *PermissionClient) Query() *PermissionQuery {
-	return &PermissionQuery{
+// Query returns a query builder for DeletionRequest.
+func (c *DeletionRequestClient) Query() *DeletionRequestQuery {
+	return &DeletionRequestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePermission},
+		ctx:    &QueryContext{Type: TypeDeletionRequest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Permission entity by its id.
-func (c *PermissionClient) Get(ctx context.Context, id int) (*Permission, error) {
-	return c.Query().Where(permission.ID(id)).Only(ctx)
+// Get returns a DeletionRequest entity by its id.
+func (c *DeletionRequestClient) Get(ctx context.Context, id uint32) (*DeletionRequest, error) {
+	return c.Query().Where(deletionrequest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PermissionClient) GetX(ctx context.Context, id int) *Permission {
+func (c *DeletionRequestClient) GetX(ctx context.Context, id uint32) *DeletionRequest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -677,165 +872,1781 @@ func (c *PermissionClient) GetX(ctx context.Context, id int) *Permission {
 	return obj
 }
 
-// QueryFolder queries the folder edge of a Permission.
-func (c *PermissionClient) QueryFolder(_m *Permission) *FolderQuery {
-	query := (&FolderClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(permission.Table, permission.FieldID, id),
-			sqlgraph.To(folder.Table, folder.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, permission.FolderTable, permission.FolderColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *DeletionRequestClient) Hooks() []Hook {
+	hooks := c.hooks.DeletionRequest
+	return append(hooks[:len(hooks):len(hooks)], deletionrequest.Hooks[:]...)
 }
 
-// QuerySecret queries the secret edge of a Permission.
-func (c *PermissionClient) QuerySecret(_m *Permission) *SecretQuery {
-	query := (&SecretClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
+// Interceptors returns the client interceptors.
+func (c *DeletionRequestClient) Interceptors() []Interceptor {
+	return c.inters.DeletionRequest
+}
+
+func (c *DeletionRequestClient) mutate(ctx context.Context, m *DeletionRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeletionRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeletionRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeletionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeletionRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeletionRequest mutation op: %q", m.Op())
+	}
+}
+
+// EmergencyAccessClient is a client for the EmergencyAccess schema.
+type EmergencyAccessClient struct {
+	config
+}
+
+// NewEmergencyAccessClient returns a client for the EmergencyAccess from the given config.
+func NewEmergencyAccessClient(c config) *EmergencyAccessClient {
+	return &EmergencyAccessClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emergencyaccess.Hooks(f(g(h())))`.
+func (c *EmergencyAccessClient) Use(hooks ...Hook) {
+	c.hooks.EmergencyAccess = append(c.hooks.EmergencyAccess, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emergencyaccess.Intercept(f(g(h())))`.
+func (c *EmergencyAccessClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmergencyAccess = append(c.inters.EmergencyAccess, interceptors...)
+}
+
+// Create returns a builder for creating a EmergencyAccess entity.
+func (c *EmergencyAccessClient) Create() *EmergencyAccessCreate {
+	mutation := newEmergencyAccessMutation(c.config, OpCreate)
+	return &EmergencyAccessCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmergencyAccess entities.
+func (c *EmergencyAccessClient) CreateBulk(builders ...*EmergencyAccessCreate) *EmergencyAccessCreateBulk {
+	return &EmergencyAccessCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmergencyAccessClient) MapCreateBulk(slice any, setFunc func(*EmergencyAccessCreate, int)) *EmergencyAccessCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmergencyAccessCreateBulk{err: fmt.Errorf("calling to EmergencyAccessClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmergencyAccessCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmergencyAccessCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmergencyAccess.
+func (c *EmergencyAccessClient) Update() *EmergencyAccessUpdate {
+	mutation := newEmergencyAccessMutation(c.config, OpUpdate)
+	return &EmergencyAccessUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmergencyAccessClient) UpdateOne(_m *EmergencyAccess) *EmergencyAccessUpdateOne {
+	mutation := newEmergencyAccessMutation(c.config, OpUpdateOne, withEmergencyAccess(_m))
+	return &EmergencyAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmergencyAccessClient) UpdateOneID(id uint32) *EmergencyAccessUpdateOne {
+	mutation := newEmergencyAccessMutation(c.config, OpUpdateOne, withEmergencyAccessID(id))
+	return &EmergencyAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmergencyAccess.
+func (c *EmergencyAccessClient) Delete() *EmergencyAccessDelete {
+	mutation := newEmergencyAccessMutation(c.config, OpDelete)
+	return &EmergencyAccessDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmergencyAccessClient) DeleteOne(_m *EmergencyAccess) *EmergencyAccessDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmergencyAccessClient) DeleteOneID(id uint32) *EmergencyAccessDeleteOne {
+	builder := c.Delete().Where(emergencyaccess.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmergencyAccessDeleteOne{builder}
+}
+
+// Query returns a query builder for EmergencyAccess.
+func (c *EmergencyAccessClient) Query() *EmergencyAccessQuery {
+	return &EmergencyAccessQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmergencyAccess},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmergencyAccess entity by its id.
+func (c *EmergencyAccessClient) Get(ctx context.Context, id uint32) (*EmergencyAccess, error) {
+	return c.Query().Where(emergencyaccess.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmergencyAccessClient) GetX(ctx context.Context, id uint32) *EmergencyAccess {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmergencyAccessClient) Hooks() []Hook {
+	hooks := c.hooks.EmergencyAccess
+	return append(hooks[:len(hooks):len(hooks)], emergencyaccess.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmergencyAccessClient) Interceptors() []Interceptor {
+	return c.inters.EmergencyAccess
+}
+
+func (c *EmergencyAccessClient) mutate(ctx context.Context, m *EmergencyAccessMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmergencyAccessCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmergencyAccessUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmergencyAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmergencyAccessDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmergencyAccess mutation op: %q", m.Op())
+	}
+}
+
+// FieldKeyClient is a client for the FieldKey schema.
+type FieldKeyClient struct {
+	config
+}
+
+// NewFieldKeyClient returns a client for the FieldKey from the given config.
+func NewFieldKeyClient(c config) *FieldKeyClient {
+	return &FieldKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fieldkey.Hooks(f(g(h())))`.
+func (c *FieldKeyClient) Use(hooks ...Hook) {
+	c.hooks.FieldKey = append(c.hooks.FieldKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fieldkey.Intercept(f(g(h())))`.
+func (c *FieldKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FieldKey = append(c.inters.FieldKey, interceptors...)
+}
+
+// Create returns a builder for creating a FieldKey entity.
+func (c *FieldKeyClient) Create() *FieldKeyCreate {
+	mutation := newFieldKeyMutation(c.config, OpCreate)
+	return &FieldKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FieldKey entities.
+func (c *FieldKeyClient) CreateBulk(builders ...*FieldKeyCreate) *FieldKeyCreateBulk {
+	return &FieldKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FieldKeyClient) MapCreateBulk(slice any, setFunc func(*FieldKeyCreate, int)) *FieldKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FieldKeyCreateBulk{err: fmt.Errorf("calling to FieldKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FieldKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FieldKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FieldKey.
+func (c *FieldKeyClient) Update() *FieldKeyUpdate {
+	mutation := newFieldKeyMutation(c.config, OpUpdate)
+	return &FieldKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FieldKeyClient) UpdateOne(_m *FieldKey) *FieldKeyUpdateOne {
+	mutation := newFieldKeyMutation(c.config, OpUpdateOne, withFieldKey(_m))
+	return &FieldKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FieldKeyClient) UpdateOneID(id uint32) *FieldKeyUpdateOne {
+	mutation := newFieldKeyMutation(c.config, OpUpdateOne, withFieldKeyID(id))
+	return &FieldKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FieldKey.
+func (c *FieldKeyClient) Delete() *FieldKeyDelete {
+	mutation := newFieldKeyMutation(c.config, OpDelete)
+	return &FieldKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FieldKeyClient) DeleteOne(_m *FieldKey) *FieldKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FieldKeyClient) DeleteOneID(id uint32) *FieldKeyDeleteOne {
+	builder := c.Delete().Where(fieldkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FieldKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for FieldKey.
+func (c *FieldKeyClient) Query() *FieldKeyQuery {
+	return &FieldKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFieldKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FieldKey entity by its id.
+func (c *FieldKeyClient) Get(ctx context.Context, id uint32) (*FieldKey, error) {
+	return c.Query().Where(fieldkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FieldKeyClient) GetX(ctx context.Context, id uint32) *FieldKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FieldKeyClient) Hooks() []Hook {
+	return c.hooks.FieldKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *FieldKeyClient) Interceptors() []Interceptor {
+	return c.inters.FieldKey
+}
+
+func (c *FieldKeyClient) mutate(ctx context.Context, m *FieldKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FieldKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FieldKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FieldKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FieldKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FieldKey mutation op: %q", m.Op())
+	}
+}
+
+// FolderClient is a client for the Folder schema.
+type FolderClient struct {
+	config
+}
+
+// NewFolderClient returns a client for the Folder from the given config.
+func NewFolderClient(c config) *FolderClient {
+	return &FolderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `folder.Hooks(f(g(h())))`.
+func (c *FolderClient) Use(hooks ...Hook) {
+	c.hooks.Folder = append(c.hooks.Folder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `folder.Intercept(f(g(h())))`.
+func (c *FolderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Folder = append(c.inters.Folder, interceptors...)
+}
+
+// Create returns a builder for creating a Folder entity.
+func (c *FolderClient) Create() *FolderCreate {
+	mutation := newFolderMutation(c.config, OpCreate)
+	return &FolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Folder entities.
+func (c *FolderClient) CreateBulk(builders ...*FolderCreate) *FolderCreateBulk {
+	return &FolderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FolderClient) MapCreateBulk(slice any, setFunc func(*FolderCreate, int)) *FolderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FolderCreateBulk{err: fmt.Errorf("calling to FolderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FolderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FolderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Folder.
+func (c *FolderClient) Update() *FolderUpdate {
+	mutation := newFolderMutation(c.config, OpUpdate)
+	return &FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FolderClient) UpdateOne(_m *Folder) *FolderUpdateOne {
+	mutation := newFolderMutation(c.config, OpUpdateOne, withFolder(_m))
+	return &FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FolderClient) UpdateOneID(id string) *FolderUpdateOne {
+	mutation := newFolderMutation(c.config, OpUpdateOne, withFolderID(id))
+	return &FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Folder.
+func (c *FolderClient) Delete() *FolderDelete {
+	mutation := newFolderMutation(c.config, OpDelete)
+	return &FolderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FolderClient) DeleteOne(_m *Folder) *FolderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FolderClient) DeleteOneID(id string) *FolderDeleteOne {
+	builder := c.Delete().Where(folder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FolderDeleteOne{builder}
+}
+
+// Query returns a query builder for Folder.
+func (c *FolderClient) Query() *FolderQuery {
+	return &FolderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFolder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Folder entity by its id.
+func (c *FolderClient) Get(ctx context.Context, id string) (*Folder, error) {
+	return c.Query().Where(folder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FolderClient) GetX(ctx context.Context, id string) *Folder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryParent queries the parent edge of a Folder.
+func (c *FolderClient) QueryParent(_m *Folder) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, folder.ParentTable, folder.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Folder.
+func (c *FolderClient) QueryChildren(_m *Folder) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, folder.ChildrenTable, folder.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySecrets queries the secrets edge of a Folder.
+func (c *FolderClient) QuerySecrets(_m *Folder) *SecretQuery {
+	query := (&SecretClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(secret.Table, secret.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, folder.SecretsTable, folder.SecretsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermissions queries the permissions edge of a Folder.
+func (c *FolderClient) QueryPermissions(_m *Folder) *PermissionQuery {
+	query := (&PermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(permission.Table, permission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, folder.PermissionsTable, folder.PermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FolderClient) Hooks() []Hook {
+	hooks := c.hooks.Folder
+	return append(hooks[:len(hooks):len(hooks)], folder.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FolderClient) Interceptors() []Interceptor {
+	return c.inters.Folder
+}
+
+func (c *FolderClient) mutate(ctx context.Context, m *FolderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FolderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FolderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Folder mutation op: %q", m.Op())
+	}
+}
+
+// FolderChangeLogClient is a client for the FolderChangeLog schema.
+type FolderChangeLogClient struct {
+	config
+}
+
+// NewFolderChangeLogClient returns a client for the FolderChangeLog from the given config.
+func NewFolderChangeLogClient(c config) *FolderChangeLogClient {
+	return &FolderChangeLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `folderchangelog.Hooks(f(g(h())))`.
+func (c *FolderChangeLogClient) Use(hooks ...Hook) {
+	c.hooks.FolderChangeLog = append(c.hooks.FolderChangeLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `folderchangelog.Intercept(f(g(h())))`.
+func (c *FolderChangeLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FolderChangeLog = append(c.inters.FolderChangeLog, interceptors...)
+}
+
+// Create returns a builder for creating a FolderChangeLog entity.
+func (c *FolderChangeLogClient) Create() *FolderChangeLogCreate {
+	mutation := newFolderChangeLogMutation(c.config, OpCreate)
+	return &FolderChangeLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FolderChangeLog entities.
+func (c *FolderChangeLogClient) CreateBulk(builders ...*FolderChangeLogCreate) *FolderChangeLogCreateBulk {
+	return &FolderChangeLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FolderChangeLogClient) MapCreateBulk(slice any, setFunc func(*FolderChangeLogCreate, int)) *FolderChangeLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FolderChangeLogCreateBulk{err: fmt.Errorf("calling to FolderChangeLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FolderChangeLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FolderChangeLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FolderChangeLog.
+func (c *FolderChangeLogClient) Update() *FolderChangeLogUpdate {
+	mutation := newFolderChangeLogMutation(c.config, OpUpdate)
+	return &FolderChangeLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FolderChangeLogClient) UpdateOne(_m *FolderChangeLog) *FolderChangeLogUpdateOne {
+	mutation := newFolderChangeLogMutation(c.config, OpUpdateOne, withFolderChangeLog(_m))
+	return &FolderChangeLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FolderChangeLogClient) UpdateOneID(id uint32) *FolderChangeLogUpdateOne {
+	mutation := newFolderChangeLogMutation(c.config, OpUpdateOne, withFolderChangeLogID(id))
+	return &FolderChangeLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FolderChangeLog.
+func (c *FolderChangeLogClient) Delete() *FolderChangeLogDelete {
+	mutation := newFolderChangeLogMutation(c.config, OpDelete)
+	return &FolderChangeLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FolderChangeLogClient) DeleteOne(_m *FolderChangeLog) *FolderChangeLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FolderChangeLogClient) DeleteOneID(id uint32) *FolderChangeLogDeleteOne {
+	builder := c.Delete().Where(folderchangelog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FolderChangeLogDeleteOne{builder}
+}
+
+// Query returns a query builder for FolderChangeLog.
+func (c *FolderChangeLogClient) Query() *FolderChangeLogQuery {
+	return &FolderChangeLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFolderChangeLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FolderChangeLog entity by its id.
+func (c *FolderChangeLogClient) Get(ctx context.Context, id uint32) (*FolderChangeLog, error) {
+	return c.Query().Where(folderchangelog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FolderChangeLogClient) GetX(ctx context.Context, id uint32) *FolderChangeLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FolderChangeLogClient) Hooks() []Hook {
+	hooks := c.hooks.FolderChangeLog
+	return append(hooks[:len(hooks):len(hooks)], folderchangelog.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FolderChangeLogClient) Interceptors() []Interceptor {
+	return c.inters.FolderChangeLog
+}
+
+func (c *FolderChangeLogClient) mutate(ctx context.Context, m *FolderChangeLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FolderChangeLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FolderChangeLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FolderChangeLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FolderChangeLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FolderChangeLog mutation op: %q", m.Op())
+	}
+}
+
+// PendingOperationClient is a client for the PendingOperation schema.
+type PendingOperationClient struct {
+	config
+}
+
+// NewPendingOperationClient returns a client for the PendingOperation from the given config.
+func NewPendingOperationClient(c config) *PendingOperationClient {
+	return &PendingOperationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pendingoperation.Hooks(f(g(h())))`.
+func (c *PendingOperationClient) Use(hooks ...Hook) {
+	c.hooks.PendingOperation = append(c.hooks.PendingOperation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pendingoperation.Intercept(f(g(h())))`.
+func (c *PendingOperationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PendingOperation = append(c.inters.PendingOperation, interceptors...)
+}
+
+// Create returns a builder for creating a PendingOperation entity.
+func (c *PendingOperationClient) Create() *PendingOperationCreate {
+	mutation := newPendingOperationMutation(c.config, OpCreate)
+	return &PendingOperationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PendingOperation entities.
+func (c *PendingOperationClient) CreateBulk(builders ...*PendingOperationCreate) *PendingOperationCreateBulk {
+	return &PendingOperationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PendingOperationClient) MapCreateBulk(slice any, setFunc func(*PendingOperationCreate, int)) *PendingOperationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PendingOperationCreateBulk{err: fmt.Errorf("calling to PendingOperationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PendingOperationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PendingOperationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PendingOperation.
+func (c *PendingOperationClient) Update() *PendingOperationUpdate {
+	mutation := newPendingOperationMutation(c.config, OpUpdate)
+	return &PendingOperationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PendingOperationClient) UpdateOne(_m *PendingOperation) *PendingOperationUpdateOne {
+	mutation := newPendingOperationMutation(c.config, OpUpdateOne, withPendingOperation(_m))
+	return &PendingOperationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PendingOperationClient) UpdateOneID(id uint32) *PendingOperationUpdateOne {
+	mutation := newPendingOperationMutation(c.config, OpUpdateOne, withPendingOperationID(id))
+	return &PendingOperationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PendingOperation.
+func (c *PendingOperationClient) Delete() *PendingOperationDelete {
+	mutation := newPendingOperationMutation(c.config, OpDelete)
+	return &PendingOperationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PendingOperationClient) DeleteOne(_m *PendingOperation) *PendingOperationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PendingOperationClient) DeleteOneID(id uint32) *PendingOperationDeleteOne {
+	builder := c.Delete().Where(pendingoperation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PendingOperationDeleteOne{builder}
+}
+
+// Query returns a query builder for PendingOperation.
+func (c *PendingOperationClient) Query() *PendingOperationQuery {
+	return &PendingOperationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePendingOperation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PendingOperation entity by its id.
+func (c *PendingOperationClient) Get(ctx context.Context, id uint32) (*PendingOperation, error) {
+	return c.Query().Where(pendingoperation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PendingOperationClient) GetX(ctx context.Context, id uint32) *PendingOperation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PendingOperationClient) Hooks() []Hook {
+	hooks := c.hooks.PendingOperation
+	return append(hooks[:len(hooks):len(hooks)], pendingoperation.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PendingOperationClient) Interceptors() []Interceptor {
+	return c.inters.PendingOperation
+}
+
+func (c *PendingOperationClient) mutate(ctx context.Context, m *PendingOperationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PendingOperationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PendingOperationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PendingOperationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PendingOperationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PendingOperation mutation op: %q", m.Op())
+	}
+}
+
+// PermissionClient is a client for the Permission schema.
+type PermissionClient struct {
+	config
+}
+
+// NewPermissionClient returns a client for the Permission from the given config.
+func NewPermissionClient(c config) *PermissionClient {
+	return &PermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `permission.Hooks(f(g(h())))`.
+func (c *PermissionClient) Use(hooks ...Hook) {
+	c.hooks.Permission = append(c.hooks.Permission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `permission.Intercept(f(g(h())))`.
+func (c *PermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Permission = append(c.inters.Permission, interceptors...)
+}
+
+// Create returns a builder for creating a Permission entity.
+func (c *PermissionClient) Create() *PermissionCreate {
+	mutation := newPermissionMutation(c.config, OpCreate)
+	return &PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Permission entities.
+func (c *PermissionClient) CreateBulk(builders ...*PermissionCreate) *PermissionCreateBulk {
+	return &PermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PermissionClient) MapCreateBulk(slice any, setFunc func(*PermissionCreate, int)) *PermissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PermissionCreateBulk{err: fmt.Errorf("calling to PermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PermissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Permission.
+func (c *PermissionClient) Update() *PermissionUpdate {
+	mutation := newPermissionMutation(c.config, OpUpdate)
+	return &PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PermissionClient) UpdateOne(_m *Permission) *PermissionUpdateOne {
+	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermission(_m))
+	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PermissionClient) UpdateOneID(id int) *PermissionUpdateOne {
+	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermissionID(id))
+	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Permission.
+func (c *PermissionClient) Delete() *PermissionDelete {
+	mutation := newPermissionMutation(c.config, OpDelete)
+	return &PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PermissionClient) DeleteOne(_m *Permission) *PermissionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PermissionClient) DeleteOneID(id int) *PermissionDeleteOne {
+	builder := c.Delete().Where(permission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PermissionDeleteOne{builder}
+}
+
+// Query returns a query builder for Permission.
+func (c *PermissionClient) Query() *PermissionQuery {
+	return &PermissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePermission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Permission entity by its id.
+func (c *PermissionClient) Get(ctx context.Context, id int) (*Permission, error) {
+	return c.Query().Where(permission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PermissionClient) GetX(ctx context.Context, id int) *Permission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFolder queries the folder edge of a Permission.
+func (c *PermissionClient) QueryFolder(_m *Permission) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permission.Table, permission.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, permission.FolderTable, permission.FolderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySecret queries the secret edge of a Permission.
+func (c *PermissionClient) QuerySecret(_m *Permission) *SecretQuery {
+	query := (&SecretClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permission.Table, permission.FieldID, id),
+			sqlgraph.To(secret.Table, secret.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, permission.SecretTable, permission.SecretColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PermissionClient) Hooks() []Hook {
+	hooks := c.hooks.Permission
+	return append(hooks[:len(hooks):len(hooks)], permission.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PermissionClient) Interceptors() []Interceptor {
+	return c.inters.Permission
+}
+
+func (c *PermissionClient) mutate(ctx context.Context, m *PermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Permission mutation op: %q", m.Op())
+	}
+}
+
+// SecretClient is a client for the Secret schema.
+type SecretClient struct {
+	config
+}
+
+// NewSecretClient returns a client for the Secret from the given config.
+func NewSecretClient(c config) *SecretClient {
+	return &SecretClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `secret.Hooks(f(g(h())))`.
+func (c *SecretClient) Use(hooks ...Hook) {
+	c.hooks.Secret = append(c.hooks.Secret, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `secret.Intercept(f(g(h())))`.
+func (c *SecretClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Secret = append(c.inters.Secret, interceptors...)
+}
+
+// Create returns a builder for creating a Secret entity.
+func (c *SecretClient) Create() *SecretCreate {
+	mutation := newSecretMutation(c.config, OpCreate)
+	return &SecretCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Secret entities.
+func (c *SecretClient) CreateBulk(builders ...*SecretCreate) *SecretCreateBulk {
+	return &SecretCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecretClient) MapCreateBulk(slice any, setFunc func(*SecretCreate, int)) *SecretCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecretCreateBulk{err: fmt.Errorf("calling to SecretClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecretCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecretCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Secret.
+func (c *SecretClient) Update() *SecretUpdate {
+	mutation := newSecretMutation(c.config, OpUpdate)
+	return &SecretUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecretClient) UpdateOne(_m *Secret) *SecretUpdateOne {
+	mutation := newSecretMutation(c.config, OpUpdateOne, withSecret(_m))
+	return &SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecretClient) UpdateOneID(id string) *SecretUpdateOne {
+	mutation := newSecretMutation(c.config, OpUpdateOne, withSecretID(id))
+	return &SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Secret.
+func (c *SecretClient) Delete() *SecretDelete {
+	mutation := newSecretMutation(c.config, OpDelete)
+	return &SecretDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecretClient) DeleteOne(_m *Secret) *SecretDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecretClient) DeleteOneID(id string) *SecretDeleteOne {
+	builder := c.Delete().Where(secret.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecretDeleteOne{builder}
+}
+
+// Query returns a query builder for Secret.
+func (c *SecretClient) Query() *SecretQuery {
+	return &SecretQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecret},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Secret entity by its id.
+func (c *SecretClient) Get(ctx context.Context, id string) (*Secret, error) {
+	return c.Query().Where(secret.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecretClient) GetX(ctx context.Context, id string) *Secret {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFolder queries the folder edge of a Secret.
+func (c *SecretClient) QueryFolder(_m *Secret) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(secret.Table, secret.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, secret.FolderTable, secret.FolderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVersions queries the versions edge of a Secret.
+func (c *SecretClient) QueryVersions(_m *Secret) *SecretVersionQuery {
+	query := (&SecretVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(secret.Table, secret.FieldID, id),
+			sqlgraph.To(secretversion.Table, secretversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, secret.VersionsTable, secret.VersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermissions queries the permissions edge of a Secret.
+func (c *SecretClient) QueryPermissions(_m *Secret) *PermissionQuery {
+	query := (&PermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(secret.Table, secret.FieldID, id),
+			sqlgraph.To(permission.Table, permission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, secret.PermissionsTable, secret.PermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCollections queries the collections edge of a Secret.
+func (c *SecretClient) QueryCollections(_m *Secret) *CollectionQuery {
+	query := (&CollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(secret.Table, secret.FieldID, id),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, secret.CollectionsTable, secret.CollectionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SecretClient) Hooks() []Hook {
+	hooks := c.hooks.Secret
+	return append(hooks[:len(hooks):len(hooks)], secret.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SecretClient) Interceptors() []Interceptor {
+	return c.inters.Secret
+}
+
+func (c *SecretClient) mutate(ctx context.Context, m *SecretMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SecretCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SecretUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SecretDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Secret mutation op: %q", m.Op())
+	}
+}
+
+// SecretUsageClient is a client for the SecretUsage schema.
+type SecretUsageClient struct {
+	config
+}
+
+// NewSecretUsageClient returns a client for the SecretUsage from the given config.
+func NewSecretUsageClient(c config) *SecretUsageClient {
+	return &SecretUsageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `secretusage.Hooks(f(g(h())))`.
+func (c *SecretUsageClient) Use(hooks ...Hook) {
+	c.hooks.SecretUsage = append(c.hooks.SecretUsage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `secretusage.Intercept(f(g(h())))`.
+func (c *SecretUsageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SecretUsage = append(c.inters.SecretUsage, interceptors...)
+}
+
+// Create returns a builder for creating a SecretUsage entity.
+func (c *SecretUsageClient) Create() *SecretUsageCreate {
+	mutation := newSecretUsageMutation(c.config, OpCreate)
+	return &SecretUsageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SecretUsage entities.
+func (c *SecretUsageClient) CreateBulk(builders ...*SecretUsageCreate) *SecretUsageCreateBulk {
+	return &SecretUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecretUsageClient) MapCreateBulk(slice any, setFunc func(*SecretUsageCreate, int)) *SecretUsageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecretUsageCreateBulk{err: fmt.Errorf("calling to SecretUsageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecretUsageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecretUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SecretUsage.
+func (c *SecretUsageClient) Update() *SecretUsageUpdate {
+	mutation := newSecretUsageMutation(c.config, OpUpdate)
+	return &SecretUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecretUsageClient) UpdateOne(_m *SecretUsage) *SecretUsageUpdateOne {
+	mutation := newSecretUsageMutation(c.config, OpUpdateOne, withSecretUsage(_m))
+	return &SecretUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecretUsageClient) UpdateOneID(id uint32) *SecretUsageUpdateOne {
+	mutation := newSecretUsageMutation(c.config, OpUpdateOne, withSecretUsageID(id))
+	return &SecretUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SecretUsage.
+func (c *SecretUsageClient) Delete() *SecretUsageDelete {
+	mutation := newSecretUsageMutation(c.config, OpDelete)
+	return &SecretUsageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecretUsageClient) DeleteOne(_m *SecretUsage) *SecretUsageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecretUsageClient) DeleteOneID(id uint32) *SecretUsageDeleteOne {
+	builder := c.Delete().Where(secretusage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecretUsageDeleteOne{builder}
+}
+
+// Query returns a query builder for SecretUsage.
+func (c *SecretUsageClient) Query() *SecretUsageQuery {
+	return &SecretUsageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecretUsage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SecretUsage entity by its id.
+func (c *SecretUsageClient) Get(ctx context.Context, id uint32) (*SecretUsage, error) {
+	return c.Query().Where(secretusage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecretUsageClient) GetX(ctx context.Context, id uint32) *SecretUsage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SecretUsageClient) Hooks() []Hook {
+	hooks := c.hooks.SecretUsage
+	return append(hooks[:len(hooks):len(hooks)], secretusage.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SecretUsageClient) Interceptors() []Interceptor {
+	return c.inters.SecretUsage
+}
+
+func (c *SecretUsageClient) mutate(ctx context.Context, m *SecretUsageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SecretUsageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SecretUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SecretUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SecretUsageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SecretUsage mutation op: %q", m.Op())
+	}
+}
+
+// SecretVersionClient is a client for the SecretVersion schema.
+type SecretVersionClient struct {
+	config
+}
+
+// NewSecretVersionClient returns a client for the SecretVersion from the given config.
+func NewSecretVersionClient(c config) *SecretVersionClient {
+	return &SecretVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `secretversion.Hooks(f(g(h())))`.
+func (c *SecretVersionClient) Use(hooks ...Hook) {
+	c.hooks.SecretVersion = append(c.hooks.SecretVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `secretversion.Intercept(f(g(h())))`.
+func (c *SecretVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SecretVersion = append(c.inters.SecretVersion, interceptors...)
+}
+
+// Create returns a builder for creating a SecretVersion entity.
+func (c *SecretVersionClient) Create() *SecretVersionCreate {
+	mutation := newSecretVersionMutation(c.config, OpCreate)
+	return &SecretVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SecretVersion entities.
+func (c *SecretVersionClient) CreateBulk(builders ...*SecretVersionCreate) *SecretVersionCreateBulk {
+	return &SecretVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecretVersionClient) MapCreateBulk(slice any, setFunc func(*SecretVersionCreate, int)) *SecretVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecretVersionCreateBulk{err: fmt.Errorf("calling to SecretVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecretVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecretVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SecretVersion.
+func (c *SecretVersionClient) Update() *SecretVersionUpdate {
+	mutation := newSecretVersionMutation(c.config, OpUpdate)
+	return &SecretVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecretVersionClient) UpdateOne(_m *SecretVersion) *SecretVersionUpdateOne {
+	mutation := newSecretVersionMutation(c.config, OpUpdateOne, withSecretVersion(_m))
+	return &SecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecretVersionClient) UpdateOneID(id int) *SecretVersionUpdateOne {
+	mutation := newSecretVersionMutation(c.config, OpUpdateOne, withSecretVersionID(id))
+	return &SecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SecretVersion.
+func (c *SecretVersionClient) Delete() *SecretVersionDelete {
+	mutation := newSecretVersionMutation(c.config, OpDelete)
+	return &SecretVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecretVersionClient) DeleteOne(_m *SecretVersion) *SecretVersionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecretVersionClient) DeleteOneID(id int) *SecretVersionDeleteOne {
+	builder := c.Delete().Where(secretversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecretVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for SecretVersion.
+func (c *SecretVersionClient) Query() *SecretVersionQuery {
+	return &SecretVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecretVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SecretVersion entity by its id.
+func (c *SecretVersionClient) Get(ctx context.Context, id int) (*SecretVersion, error) {
+	return c.Query().Where(secretversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecretVersionClient) GetX(ctx context.Context, id int) *SecretVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySecret queries the secret edge of a SecretVersion.
+func (c *SecretVersionClient) QuerySecret(_m *SecretVersion) *SecretQuery {
+	query := (&SecretClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(permission.Table, permission.FieldID, id),
+			sqlgraph.From(secretversion.Table, secretversion.FieldID, id),
 			sqlgraph.To(secret.Table, secret.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, permission.SecretTable, permission.SecretColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, secretversion.SecretTable, secretversion.SecretColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SecretVersionClient) Hooks() []Hook {
+	return c.hooks.SecretVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *SecretVersionClient) Interceptors() []Interceptor {
+	return c.inters.SecretVersion
+}
+
+func (c *SecretVersionClient) mutate(ctx context.Context, m *SecretVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SecretVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SecretVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SecretVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SecretVersion mutation op: %q", m.Op())
+	}
+}
+
+// SecurityAlertClient is a client for the SecurityAlert schema.
+type SecurityAlertClient struct {
+	config
+}
+
+// NewSecurityAlertClient returns a client for the SecurityAlert from the given config.
+func NewSecurityAlertClient(c config) *SecurityAlertClient {
+	return &SecurityAlertClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `securityalert.Hooks(f(g(h())))`.
+func (c *SecurityAlertClient) Use(hooks ...Hook) {
+	c.hooks.SecurityAlert = append(c.hooks.SecurityAlert, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `securityalert.Intercept(f(g(h())))`.
+func (c *SecurityAlertClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SecurityAlert = append(c.inters.SecurityAlert, interceptors...)
+}
+
+// Create returns a builder for creating a SecurityAlert entity.
+func (c *SecurityAlertClient) Create() *SecurityAlertCreate {
+	mutation := newSecurityAlertMutation(c.config, OpCreate)
+	return &SecurityAlertCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SecurityAlert entities.
+func (c *SecurityAlertClient) CreateBulk(builders ...*SecurityAlertCreate) *SecurityAlertCreateBulk {
+	return &SecurityAlertCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecurityAlertClient) MapCreateBulk(slice any, setFunc func(*SecurityAlertCreate, int)) *SecurityAlertCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecurityAlertCreateBulk{err: fmt.Errorf("calling to SecurityAlertClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecurityAlertCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecurityAlertCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SecurityAlert.
+func (c *SecurityAlertClient) Update() *SecurityAlertUpdate {
+	mutation := newSecurityAlertMutation(c.config, OpUpdate)
+	return &SecurityAlertUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecurityAlertClient) UpdateOne(_m *SecurityAlert) *SecurityAlertUpdateOne {
+	mutation := newSecurityAlertMutation(c.config, OpUpdateOne, withSecurityAlert(_m))
+	return &SecurityAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecurityAlertClient) UpdateOneID(id uint32) *SecurityAlertUpdateOne {
+	mutation := newSecurityAlertMutation(c.config, OpUpdateOne, withSecurityAlertID(id))
+	return &SecurityAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SecurityAlert.
+func (c *SecurityAlertClient) Delete() *SecurityAlertDelete {
+	mutation := newSecurityAlertMutation(c.config, OpDelete)
+	return &SecurityAlertDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecurityAlertClient) DeleteOne(_m *SecurityAlert) *SecurityAlertDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecurityAlertClient) DeleteOneID(id uint32) *SecurityAlertDeleteOne {
+	builder := c.Delete().Where(securityalert.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecurityAlertDeleteOne{builder}
+}
+
+// Query returns a query builder for SecurityAlert.
+func (c *SecurityAlertClient) Query() *SecurityAlertQuery {
+	return &SecurityAlertQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecurityAlert},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SecurityAlert entity by its id.
+func (c *SecurityAlertClient) Get(ctx context.Context, id uint32) (*SecurityAlert, error) {
+	return c.Query().Where(securityalert.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecurityAlertClient) GetX(ctx context.Context, id uint32) *SecurityAlert {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SecurityAlertClient) Hooks() []Hook {
+	hooks := c.hooks.SecurityAlert
+	return append(hooks[:len(hooks):len(hooks)], securityalert.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SecurityAlertClient) Interceptors() []Interceptor {
+	return c.inters.SecurityAlert
+}
+
+func (c *SecurityAlertClient) mutate(ctx context.Context, m *SecurityAlertMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SecurityAlertCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SecurityAlertUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SecurityAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SecurityAlertDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SecurityAlert mutation op: %q", m.Op())
+	}
+}
+
+// TenantSettingClient is a client for the TenantSetting schema.
+type TenantSettingClient struct {
+	config
+}
+
+// NewTenantSettingClient returns a client for the TenantSetting from the given config.
+func NewTenantSettingClient(c config) *TenantSettingClient {
+	return &TenantSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenantsetting.Hooks(f(g(h())))`.
+func (c *TenantSettingClient) Use(hooks ...Hook) {
+	c.hooks.TenantSetting = append(c.hooks.TenantSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenantsetting.Intercept(f(g(h())))`.
+func (c *TenantSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantSetting = append(c.inters.TenantSetting, interceptors...)
+}
+
+// Create returns a builder for creating a TenantSetting entity.
+func (c *TenantSettingClient) Create() *TenantSettingCreate {
+	mutation := newTenantSettingMutation(c.config, OpCreate)
+	return &TenantSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TenantSetting entities.
+func (c *TenantSettingClient) CreateBulk(builders ...*TenantSettingCreate) *TenantSettingCreateBulk {
+	return &TenantSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantSettingClient) MapCreateBulk(slice any, setFunc func(*TenantSettingCreate, int)) *TenantSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantSettingCreateBulk{err: fmt.Errorf("calling to TenantSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TenantSetting.
+func (c *TenantSettingClient) Update() *TenantSettingUpdate {
+	mutation := newTenantSettingMutation(c.config, OpUpdate)
+	return &TenantSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantSettingClient) UpdateOne(_m *TenantSetting) *TenantSettingUpdateOne {
+	mutation := newTenantSettingMutation(c.config, OpUpdateOne, withTenantSetting(_m))
+	return &TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantSettingClient) UpdateOneID(id uint32) *TenantSettingUpdateOne {
+	mutation := newTenantSettingMutation(c.config, OpUpdateOne, withTenantSettingID(id))
+	return &TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TenantSetting.
+func (c *TenantSettingClient) Delete() *TenantSettingDelete {
+	mutation := newTenantSettingMutation(c.config, OpDelete)
+	return &TenantSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantSettingClient) DeleteOne(_m *TenantSetting) *TenantSettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantSettingClient) DeleteOneID(id uint32) *TenantSettingDeleteOne {
+	builder := c.Delete().Where(tenantsetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for TenantSetting.
+func (c *TenantSettingClient) Query() *TenantSettingQuery {
+	return &TenantSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenantSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TenantSetting entity by its id.
+func (c *TenantSettingClient) Get(ctx context.Context, id uint32) (*TenantSetting, error) {
+	return c.Query().Where(tenantsetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantSettingClient) GetX(ctx context.Context, id uint32) *TenantSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *PermissionClient) Hooks() []Hook {
-	hooks := c.hooks.Permission
-	return append(hooks[:len(hooks):len(hooks)], permission.Hooks[:]...)
+func (c *TenantSettingClient) Hooks() []Hook {
+	hooks := c.hooks.TenantSetting
+	return append(hooks[:len(hooks):len(hooks)], tenantsetting.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PermissionClient) Interceptors() []Interceptor {
-	return c.inters.Permission
+func (c *TenantSettingClient) Interceptors() []Interceptor {
+	return c.inters.TenantSetting
 }
 
-func (c *PermissionClient) mutate(ctx context.Context, m *PermissionMutation) (Value, error) {
+func (c *TenantSettingClient) mutate(ctx context.Context, m *TenantSettingMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TenantSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Permission mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TenantSetting mutation op: %q", m.Op())
 	}
 }
 
-// SecretClient is a client for the Secret schema.
-type SecretClient struct {
+// VersionPinClient is a client for the VersionPin schema.
+type VersionPinClient struct {
 	config
 }
 
-// NewSecretClient returns a client for the Secret from the given config.
-func NewSecretClient(c config) *SecretClient {
-	return &SecretClient{config: c}
+// NewVersionPinClient returns a client for the VersionPin from the given config.
+func NewVersionPinClient(c config) *VersionPinClient {
+	return &VersionPinClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `secret.Hooks(f(g(h())))`.
-func (c *SecretClient) Use(hooks ...Hook) {
-	c.hooks.Secret = append(c.hooks.Secret, hooks...)
+// A call to `Use(f, g, h)` equals to `versionpin.Hooks(f(g(h())))`.
+func (c *VersionPinClient) Use(hooks ...Hook) {
+	c.hooks.VersionPin = append(c.hooks.VersionPin, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `secret.Intercept(f(g(h())))`.
-func (c *SecretClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Secret = append(c.inters.Secret, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `versionpin.Intercept(f(g(h())))`.
+func (c *VersionPinClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VersionPin = append(c.inters.VersionPin, interceptors...)
 }
 
-// Create returns a builder for creating a Secret entity.
-func (c *SecretClient) Create() *SecretCreate {
-	mutation := newSecretMutation(c.config, OpCreate)
-	return &SecretCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a VersionPin entity.
+func (c *VersionPinClient) Create() *VersionPinCreate {
+	mutation := newVersionPinMutation(c.config, OpCreate)
+	return &VersionPinCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Secret entities.
-func (c *SecretClient) CreateBulk(builders ...*SecretCreate) *SecretCreateBulk {
-	return &SecretCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of VersionPin entities.
+func (c *VersionPinClient) CreateBulk(builders ...*VersionPinCreate) *VersionPinCreateBulk {
+	return &VersionPinCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SecretClient) MapCreateBulk(slice any, setFunc func(*SecretCreate, int)) *SecretCreateBulk {
+func (c *VersionPinClient) MapCreateBulk(slice any, setFunc func(*VersionPinCreate, int)) *VersionPinCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SecretCreateBulk{err: fmt.Errorf("calling to SecretClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &VersionPinCreateBulk{err: fmt.Errorf("calling to VersionPinClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SecretCreate, rv.Len())
+	builders := make([]*VersionPinCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SecretCreateBulk{config: c.config, builders: builders}
+	return &VersionPinCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Secret.
-func (c *SecretClient) Update() *SecretUpdate {
-	mutation := newSecretMutation(c.config, OpUpdate)
-	return &SecretUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for VersionPin.
+func (c *VersionPinClient) Update() *VersionPinUpdate {
+	mutation := newVersionPinMutation(c.config, OpUpdate)
+	return &VersionPinUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SecretClient) UpdateOne(_m *Secret) *SecretUpdateOne {
-	mutation := newSecretMutation(c.config, OpUpdateOne, withSecret(_m))
-	return &SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VersionPinClient) UpdateOne(_m *VersionPin) *VersionPinUpdateOne {
+	mutation := newVersionPinMutation(c.config, OpUpdateOne, withVersionPin(_m))
+	return &VersionPinUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SecretClient) UpdateOneID(id string) *SecretUpdateOne {
-	mutation := newSecretMutation(c.config, OpUpdateOne, withSecretID(id))
-	return &SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VersionPinClient) UpdateOneID(id uint32) *VersionPinUpdateOne {
+	mutation := newVersionPinMutation(c.config, OpUpdateOne, withVersionPinID(id))
+	return &VersionPinUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Secret.
-func (c *SecretClient) Delete() *SecretDelete {
-	mutation := newSecretMutation(c.config, OpDelete)
-	return &SecretDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for VersionPin.
+func (c *VersionPinClient) Delete() *VersionPinDelete {
+	mutation := newVersionPinMutation(c.config, OpDelete)
+	return &VersionPinDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SecretClient) DeleteOne(_m *Secret) *SecretDeleteOne {
+func (c *VersionPinClient) DeleteOne(_m *VersionPin) *VersionPinDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SecretClient) DeleteOneID(id string) *SecretDeleteOne {
-	builder := c.Delete().Where(secret.ID(id))
+func (c *VersionPinClient) DeleteOneID(id uint32) *VersionPinDeleteOne {
+	builder := c.Delete().Where(versionpin.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SecretDeleteOne{builder}
+	return &VersionPinDeleteOne{builder}
 }
 
-// Query returns a query builder for Secret.
-func (c *SecretClient) Query() *SecretQuery {
-	return &SecretQuery{
+// Query returns a query builder for VersionPin.
+func (c *VersionPinClient) Query() *VersionPinQuery {
+	return &VersionPinQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSecret},
+		ctx:    &QueryContext{Type: TypeVersionPin},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Secret entity by its id.
-func (c *SecretClient) Get(ctx context.Context, id string) (*Secret, error) {
-	return c.Query().Where(secret.ID(id)).Only(ctx)
+// Get returns a VersionPin entity by its id.
+func (c *VersionPinClient) Get(ctx context.Context, id uint32) (*VersionPin, error) {
+	return c.Query().Where(versionpin.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SecretClient) GetX(ctx context.Context, id string) *Secret {
+func (c *VersionPinClient) GetX(ctx context.Context, id uint32) *VersionPin {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -843,181 +2654,267 @@ func (c *SecretClient) GetX(ctx context.Context, id string) *Secret {
 	return obj
 }
 
-// QueryFolder queries the folder edge of a Secret.
-func (c *SecretClient) QueryFolder(_m *Secret) *FolderQuery {
-	query := (&FolderClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(secret.Table, secret.FieldID, id),
-			sqlgraph.To(folder.Table, folder.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, secret.FolderTable, secret.FolderColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *VersionPinClient) Hooks() []Hook {
+	hooks := c.hooks.VersionPin
+	return append(hooks[:len(hooks):len(hooks)], versionpin.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *VersionPinClient) Interceptors() []Interceptor {
+	return c.inters.VersionPin
+}
+
+func (c *VersionPinClient) mutate(ctx context.Context, m *VersionPinMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VersionPinCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VersionPinUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VersionPinUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VersionPinDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VersionPin mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryVersions queries the versions edge of a Secret.
-func (c *SecretClient) QueryVersions(_m *Secret) *SecretVersionQuery {
-	query := (&SecretVersionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(secret.Table, secret.FieldID, id),
-			sqlgraph.To(secretversion.Table, secretversion.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, secret.VersionsTable, secret.VersionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhook.Intercept(f(g(h())))`.
+func (c *WebhookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Webhook = append(c.inters.Webhook, interceptors...)
+}
+
+// Create returns a builder for creating a Webhook entity.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookClient) MapCreateBulk(slice any, setFunc func(*WebhookCreate, int)) *WebhookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookCreateBulk{err: fmt.Errorf("calling to WebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*WebhookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryPermissions queries the permissions edge of a Secret.
-func (c *SecretClient) QueryPermissions(_m *Secret) *PermissionQuery {
-	query := (&PermissionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(secret.Table, secret.FieldID, id),
-			sqlgraph.To(permission.Table, permission.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, secret.PermissionsTable, secret.PermissionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(_m *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(_m))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id uint32) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookClient) DeleteOne(_m *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookClient) DeleteOneID(id uint32) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhook},
+		inters: c.Interceptors(),
 	}
-	return query
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id uint32) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id uint32) *Webhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *SecretClient) Hooks() []Hook {
-	hooks := c.hooks.Secret
-	return append(hooks[:len(hooks):len(hooks)], secret.Hooks[:]...)
+func (c *WebhookClient) Hooks() []Hook {
+	hooks := c.hooks.Webhook
+	return append(hooks[:len(hooks):len(hooks)], webhook.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *SecretClient) Interceptors() []Interceptor {
-	return c.inters.Secret
+func (c *WebhookClient) Interceptors() []Interceptor {
+	return c.inters.Webhook
 }
 
-func (c *SecretClient) mutate(ctx context.Context, m *SecretMutation) (Value, error) {
+func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SecretCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SecretUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SecretDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Secret mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Webhook mutation op: %q", m.Op())
 	}
 }
 
-// SecretVersionClient is a client for the SecretVersion schema.
-type SecretVersionClient struct {
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
 	config
 }
 
-// NewSecretVersionClient returns a client for the SecretVersion from the given config.
-func NewSecretVersionClient(c config) *SecretVersionClient {
-	return &SecretVersionClient{config: c}
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `secretversion.Hooks(f(g(h())))`.
-func (c *SecretVersionClient) Use(hooks ...Hook) {
-	c.hooks.SecretVersion = append(c.hooks.SecretVersion, hooks...)
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `secretversion.Intercept(f(g(h())))`.
-func (c *SecretVersionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SecretVersion = append(c.inters.SecretVersion, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
 }
 
-// Create returns a builder for creating a SecretVersion entity.
-func (c *SecretVersionClient) Create() *SecretVersionCreate {
-	mutation := newSecretVersionMutation(c.config, OpCreate)
-	return &SecretVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SecretVersion entities.
-func (c *SecretVersionClient) CreateBulk(builders ...*SecretVersionCreate) *SecretVersionCreateBulk {
-	return &SecretVersionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SecretVersionClient) MapCreateBulk(slice any, setFunc func(*SecretVersionCreate, int)) *SecretVersionCreateBulk {
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SecretVersionCreateBulk{err: fmt.Errorf("calling to SecretVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SecretVersionCreate, rv.Len())
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SecretVersionCreateBulk{config: c.config, builders: builders}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SecretVersion.
-func (c *SecretVersionClient) Update() *SecretVersionUpdate {
-	mutation := newSecretVersionMutation(c.config, OpUpdate)
-	return &SecretVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SecretVersionClient) UpdateOne(_m *SecretVersion) *SecretVersionUpdateOne {
-	mutation := newSecretVersionMutation(c.config, OpUpdateOne, withSecretVersion(_m))
-	return &SecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SecretVersionClient) UpdateOneID(id int) *SecretVersionUpdateOne {
-	mutation := newSecretVersionMutation(c.config, OpUpdateOne, withSecretVersionID(id))
-	return &SecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOneID(id uint32) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SecretVersion.
-func (c *SecretVersionClient) Delete() *SecretVersionDelete {
-	mutation := newSecretVersionMutation(c.config, OpDelete)
-	return &SecretVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SecretVersionClient) DeleteOne(_m *SecretVersion) *SecretVersionDeleteOne {
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SecretVersionClient) DeleteOneID(id int) *SecretVersionDeleteOne {
-	builder := c.Delete().Where(secretversion.ID(id))
+func (c *WebhookDeliveryClient) DeleteOneID(id uint32) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SecretVersionDeleteOne{builder}
+	return &WebhookDeliveryDeleteOne{builder}
 }
 
-// Query returns a query builder for SecretVersion.
-func (c *SecretVersionClient) Query() *SecretVersionQuery {
-	return &SecretVersionQuery{
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSecretVersion},
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SecretVersion entity by its id.
-func (c *SecretVersionClient) Get(ctx context.Context, id int) (*SecretVersion, error) {
-	return c.Query().Where(secretversion.ID(id)).Only(ctx)
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uint32) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SecretVersionClient) GetX(ctx context.Context, id int) *SecretVersion {
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uint32) *WebhookDelivery {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1025,53 +2922,44 @@ func (c *SecretVersionClient) GetX(ctx context.Context, id int) *SecretVersion {
 	return obj
 }
 
-// QuerySecret queries the secret edge of a SecretVersion.
-func (c *SecretVersionClient) QuerySecret(_m *SecretVersion) *SecretQuery {
-	query := (&SecretClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(secretversion.Table, secretversion.FieldID, id),
-			sqlgraph.To(secret.Table, secret.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, secretversion.SecretTable, secretversion.SecretColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *SecretVersionClient) Hooks() []Hook {
-	return c.hooks.SecretVersion
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookDelivery
+	return append(hooks[:len(hooks):len(hooks)], webhookdelivery.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *SecretVersionClient) Interceptors() []Interceptor {
-	return c.inters.SecretVersion
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
 }
 
-func (c *SecretVersionClient) mutate(ctx context.Context, m *SecretVersionMutation) (Value, error) {
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SecretVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SecretVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SecretVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SecretVersion mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuditLog, Folder, Permission, Secret, SecretVersion []ent.Hook
+		AuditChainHead, AuditLog, Collection, DeletionRequest, EmergencyAccess,
+		FieldKey, Folder, FolderChangeLog, PendingOperation, Permission, Secret,
+		SecretUsage, SecretVersion, SecurityAlert, TenantSetting, VersionPin, Webhook,
+		WebhookDelivery []ent.Hook
 	}
 	inters struct {
-		AuditLog, Folder, Permission, Secret, SecretVersion []ent.Interceptor
+		AuditChainHead, AuditLog, Collection, DeletionRequest, EmergencyAccess,
+		FieldKey, Folder, FolderChangeLog, PendingOperation, Permission, Secret,
+		SecretUsage, SecretVersion, SecurityAlert, TenantSetting, VersionPin, Webhook,
+		WebhookDelivery []ent.Interceptor
 	}
 )
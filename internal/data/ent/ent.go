@@ -12,11 +12,24 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditchainhead"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/collection"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/deletionrequest"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/emergencyaccess"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/fieldkey"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folderchangelog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/pendingoperation"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretusage"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretversion"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/securityalert"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/tenantsetting"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/versionpin"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhook"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +90,24 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			auditlog.Table:      auditlog.ValidColumn,
-			folder.Table:        folder.ValidColumn,
-			permission.Table:    permission.ValidColumn,
-			secret.Table:        secret.ValidColumn,
-			secretversion.Table: secretversion.ValidColumn,
+			auditchainhead.Table:   auditchainhead.ValidColumn,
+			auditlog.Table:         auditlog.ValidColumn,
+			collection.Table:       collection.ValidColumn,
+			deletionrequest.Table:  deletionrequest.ValidColumn,
+			emergencyaccess.Table:  emergencyaccess.ValidColumn,
+			fieldkey.Table:         fieldkey.ValidColumn,
+			folder.Table:           folder.ValidColumn,
+			folderchangelog.Table:  folderchangelog.ValidColumn,
+			pendingoperation.Table: pendingoperation.ValidColumn,
+			permission.Table:       permission.ValidColumn,
+			secret.Table:           secret.ValidColumn,
+			secretusage.Table:      secretusage.ValidColumn,
+			secretversion.Table:    secretversion.ValidColumn,
+			securityalert.Table:    securityalert.ValidColumn,
+			tenantsetting.Table:    tenantsetting.ValidColumn,
+			versionpin.Table:       versionpin.ValidColumn,
+			webhook.Table:          webhook.ValidColumn,
+			webhookdelivery.Table:  webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -3,13 +3,16 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/schema"
 )
 
 // Folder is the model entity for the Folder schema.
@@ -38,6 +41,36 @@ type Folder struct {
 	Description string `json:"description,omitempty"`
 	// Nesting depth level (0 for root folders)
 	Depth int32 `json:"depth,omitempty"`
+	// When a password of a secret in this folder was last read
+	LastAccessedTime *time.Time `json:"last_accessed_time,omitempty"`
+	// Number of non-deleted secrets directly in this folder (denormalized)
+	SecretCount int32 `json:"secret_count,omitempty"`
+	// Number of direct child folders (denormalized)
+	SubfolderCount int32 `json:"subfolder_count,omitempty"`
+	// Incremented on every change, for optimistic concurrency
+	Revision int64 `json:"revision,omitempty"`
+	// JSON Schema the metadata of secrets in this folder must satisfy
+	MetadataSchema map[string]interface{} `json:"metadata_schema,omitempty"`
+	// Permissions granted on every secret created in this folder
+	DefaultPermissions []schema.FolderDefaultPermission `json:"default_permissions,omitempty"`
+	// Network and time restrictions on access to this folder and everything in it
+	AccessPolicy *authz.AccessPolicy `json:"access_policy,omitempty"`
+	// Whether deleting this folder or anything in it permanently needs the approval of a second owner
+	Protected bool `json:"protected,omitempty"`
+	// Whether this folder and everything in it is under legal hold and cannot be permanently deleted or destroyed
+	LegalHold bool `json:"legal_hold,omitempty"`
+	// Why the legal hold was placed
+	LegalHoldReason string `json:"legal_hold_reason,omitempty"`
+	// Folder status; deleted folders are in the trash until restored or purged
+	Status folder.Status `json:"status,omitempty"`
+	// When the folder was moved to the trash
+	DeletedTime *time.Time `json:"deleted_time,omitempty"`
+	// The folder whose deletion moved this one to the trash (itself for the deleted folder)
+	TrashedWithFolderID *string `json:"trashed_with_folder_id,omitempty"`
+	// Parent of the deleted folder before it was moved to the trash
+	OriginalParentID *string `json:"original_parent_id,omitempty"`
+	// Path of the deleted folder before it was moved to the trash
+	OriginalPath string `json:"original_path,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FolderQuery when eager-loading is set.
 	Edges        FolderEdges `json:"edges"`
@@ -102,11 +135,15 @@ func (*Folder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case folder.FieldCreateBy, folder.FieldTenantID, folder.FieldDepth:
+		case folder.FieldMetadataSchema, folder.FieldDefaultPermissions, folder.FieldAccessPolicy:
+			values[i] = new([]byte)
+		case folder.FieldProtected, folder.FieldLegalHold:
+			values[i] = new(sql.NullBool)
+		case folder.FieldCreateBy, folder.FieldTenantID, folder.FieldDepth, folder.FieldSecretCount, folder.FieldSubfolderCount, folder.FieldRevision:
 			values[i] = new(sql.NullInt64)
-		case folder.FieldID, folder.FieldParentID, folder.FieldName, folder.FieldPath, folder.FieldDescription:
+		case folder.FieldID, folder.FieldParentID, folder.FieldName, folder.FieldPath, folder.FieldDescription, folder.FieldLegalHoldReason, folder.FieldStatus, folder.FieldTrashedWithFolderID, folder.FieldOriginalParentID, folder.FieldOriginalPath:
 			values[i] = new(sql.NullString)
-		case folder.FieldCreateTime, folder.FieldUpdateTime, folder.FieldDeleteTime:
+		case folder.FieldCreateTime, folder.FieldUpdateTime, folder.FieldDeleteTime, folder.FieldLastAccessedTime, folder.FieldDeletedTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -195,6 +232,106 @@ func (_m *Folder) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Depth = int32(value.Int64)
 			}
+		case folder.FieldLastAccessedTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_accessed_time", values[i])
+			} else if value.Valid {
+				_m.LastAccessedTime = new(time.Time)
+				*_m.LastAccessedTime = value.Time
+			}
+		case folder.FieldSecretCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_count", values[i])
+			} else if value.Valid {
+				_m.SecretCount = int32(value.Int64)
+			}
+		case folder.FieldSubfolderCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subfolder_count", values[i])
+			} else if value.Valid {
+				_m.SubfolderCount = int32(value.Int64)
+			}
+		case folder.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = value.Int64
+			}
+		case folder.FieldMetadataSchema:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata_schema", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MetadataSchema); err != nil {
+					return fmt.Errorf("unmarshal field metadata_schema: %w", err)
+				}
+			}
+		case folder.FieldDefaultPermissions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field default_permissions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DefaultPermissions); err != nil {
+					return fmt.Errorf("unmarshal field default_permissions: %w", err)
+				}
+			}
+		case folder.FieldAccessPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field access_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AccessPolicy); err != nil {
+					return fmt.Errorf("unmarshal field access_policy: %w", err)
+				}
+			}
+		case folder.FieldProtected:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field protected", values[i])
+			} else if value.Valid {
+				_m.Protected = value.Bool
+			}
+		case folder.FieldLegalHold:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field legal_hold", values[i])
+			} else if value.Valid {
+				_m.LegalHold = value.Bool
+			}
+		case folder.FieldLegalHoldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field legal_hold_reason", values[i])
+			} else if value.Valid {
+				_m.LegalHoldReason = value.String
+			}
+		case folder.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = folder.Status(value.String)
+			}
+		case folder.FieldDeletedTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_time", values[i])
+			} else if value.Valid {
+				_m.DeletedTime = new(time.Time)
+				*_m.DeletedTime = value.Time
+			}
+		case folder.FieldTrashedWithFolderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trashed_with_folder_id", values[i])
+			} else if value.Valid {
+				_m.TrashedWithFolderID = new(string)
+				*_m.TrashedWithFolderID = value.String
+			}
+		case folder.FieldOriginalParentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_parent_id", values[i])
+			} else if value.Valid {
+				_m.OriginalParentID = new(string)
+				*_m.OriginalParentID = value.String
+			}
+		case folder.FieldOriginalPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_path", values[i])
+			} else if value.Valid {
+				_m.OriginalPath = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -292,6 +429,59 @@ func (_m *Folder) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("depth=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Depth))
+	builder.WriteString(", ")
+	if v := _m.LastAccessedTime; v != nil {
+		builder.WriteString("last_accessed_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("secret_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SecretCount))
+	builder.WriteString(", ")
+	builder.WriteString("subfolder_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubfolderCount))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("metadata_schema=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MetadataSchema))
+	builder.WriteString(", ")
+	builder.WriteString("default_permissions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultPermissions))
+	builder.WriteString(", ")
+	builder.WriteString("access_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccessPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("protected=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Protected))
+	builder.WriteString(", ")
+	builder.WriteString("legal_hold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LegalHold))
+	builder.WriteString(", ")
+	builder.WriteString("legal_hold_reason=")
+	builder.WriteString(_m.LegalHoldReason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.DeletedTime; v != nil {
+		builder.WriteString("deleted_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrashedWithFolderID; v != nil {
+		builder.WriteString("trashed_with_folder_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.OriginalParentID; v != nil {
+		builder.WriteString("original_parent_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("original_path=")
+	builder.WriteString(_m.OriginalPath)
 	builder.WriteByte(')')
 	return builder.String()
 }
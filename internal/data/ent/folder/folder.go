@@ -3,6 +3,8 @@
 package folder
 
 import (
+	"fmt"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -33,6 +35,36 @@ const (
 	FieldDescription = "description"
 	// FieldDepth holds the string denoting the depth field in the database.
 	FieldDepth = "depth"
+	// FieldLastAccessedTime holds the string denoting the last_accessed_time field in the database.
+	FieldLastAccessedTime = "last_accessed_time"
+	// FieldSecretCount holds the string denoting the secret_count field in the database.
+	FieldSecretCount = "secret_count"
+	// FieldSubfolderCount holds the string denoting the subfolder_count field in the database.
+	FieldSubfolderCount = "subfolder_count"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldMetadataSchema holds the string denoting the metadata_schema field in the database.
+	FieldMetadataSchema = "metadata_schema"
+	// FieldDefaultPermissions holds the string denoting the default_permissions field in the database.
+	FieldDefaultPermissions = "default_permissions"
+	// FieldAccessPolicy holds the string denoting the access_policy field in the database.
+	FieldAccessPolicy = "access_policy"
+	// FieldProtected holds the string denoting the protected field in the database.
+	FieldProtected = "protected"
+	// FieldLegalHold holds the string denoting the legal_hold field in the database.
+	FieldLegalHold = "legal_hold"
+	// FieldLegalHoldReason holds the string denoting the legal_hold_reason field in the database.
+	FieldLegalHoldReason = "legal_hold_reason"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDeletedTime holds the string denoting the deleted_time field in the database.
+	FieldDeletedTime = "deleted_time"
+	// FieldTrashedWithFolderID holds the string denoting the trashed_with_folder_id field in the database.
+	FieldTrashedWithFolderID = "trashed_with_folder_id"
+	// FieldOriginalParentID holds the string denoting the original_parent_id field in the database.
+	FieldOriginalParentID = "original_parent_id"
+	// FieldOriginalPath holds the string denoting the original_path field in the database.
+	FieldOriginalPath = "original_path"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
@@ -80,6 +112,21 @@ var Columns = []string{
 	FieldPath,
 	FieldDescription,
 	FieldDepth,
+	FieldLastAccessedTime,
+	FieldSecretCount,
+	FieldSubfolderCount,
+	FieldRevision,
+	FieldMetadataSchema,
+	FieldDefaultPermissions,
+	FieldAccessPolicy,
+	FieldProtected,
+	FieldLegalHold,
+	FieldLegalHoldReason,
+	FieldStatus,
+	FieldDeletedTime,
+	FieldTrashedWithFolderID,
+	FieldOriginalParentID,
+	FieldOriginalPath,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -110,10 +157,50 @@ var (
 	DescriptionValidator func(string) error
 	// DefaultDepth holds the default value on creation for the "depth" field.
 	DefaultDepth int32
+	// DefaultSecretCount holds the default value on creation for the "secret_count" field.
+	DefaultSecretCount int32
+	// DefaultSubfolderCount holds the default value on creation for the "subfolder_count" field.
+	DefaultSubfolderCount int32
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision int64
+	// DefaultProtected holds the default value on creation for the "protected" field.
+	DefaultProtected bool
+	// DefaultLegalHold holds the default value on creation for the "legal_hold" field.
+	DefaultLegalHold bool
+	// LegalHoldReasonValidator is a validator for the "legal_hold_reason" field. It is called by the builders before save.
+	LegalHoldReasonValidator func(string) error
+	// OriginalPathValidator is a validator for the "original_path" field. It is called by the builders before save.
+	OriginalPathValidator func(string) error
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusFOLDER_STATUS_ACTIVE is the default value of the Status enum.
+const DefaultStatus = StatusFOLDER_STATUS_ACTIVE
+
+// Status values.
+const (
+	StatusFOLDER_STATUS_ACTIVE  Status = "FOLDER_STATUS_ACTIVE"
+	StatusFOLDER_STATUS_DELETED Status = "FOLDER_STATUS_DELETED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusFOLDER_STATUS_ACTIVE, StatusFOLDER_STATUS_DELETED:
+		return nil
+	default:
+		return fmt.Errorf("folder: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Folder queries.
 type OrderOption func(*sql.Selector)
 
@@ -172,6 +259,66 @@ func ByDepth(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDepth, opts...).ToFunc()
 }
 
+// ByLastAccessedTime orders the results by the last_accessed_time field.
+func ByLastAccessedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastAccessedTime, opts...).ToFunc()
+}
+
+// BySecretCount orders the results by the secret_count field.
+func BySecretCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecretCount, opts...).ToFunc()
+}
+
+// BySubfolderCount orders the results by the subfolder_count field.
+func BySubfolderCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubfolderCount, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// ByProtected orders the results by the protected field.
+func ByProtected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProtected, opts...).ToFunc()
+}
+
+// ByLegalHold orders the results by the legal_hold field.
+func ByLegalHold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLegalHold, opts...).ToFunc()
+}
+
+// ByLegalHoldReason orders the results by the legal_hold_reason field.
+func ByLegalHoldReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLegalHoldReason, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDeletedTime orders the results by the deleted_time field.
+func ByDeletedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedTime, opts...).ToFunc()
+}
+
+// ByTrashedWithFolderID orders the results by the trashed_with_folder_id field.
+func ByTrashedWithFolderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrashedWithFolderID, opts...).ToFunc()
+}
+
+// ByOriginalParentID orders the results by the original_parent_id field.
+func ByOriginalParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalParentID, opts...).ToFunc()
+}
+
+// ByOriginalPath orders the results by the original_path field.
+func ByOriginalPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalPath, opts...).ToFunc()
+}
+
 // ByParentField orders the results by parent field.
 func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -115,6 +115,61 @@ func Depth(v int32) predicate.Folder {
 	return predicate.Folder(sql.FieldEQ(FieldDepth, v))
 }
 
+// LastAccessedTime applies equality check predicate on the "last_accessed_time" field. It's identical to LastAccessedTimeEQ.
+func LastAccessedTime(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldLastAccessedTime, v))
+}
+
+// SecretCount applies equality check predicate on the "secret_count" field. It's identical to SecretCountEQ.
+func SecretCount(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldSecretCount, v))
+}
+
+// SubfolderCount applies equality check predicate on the "subfolder_count" field. It's identical to SubfolderCountEQ.
+func SubfolderCount(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldSubfolderCount, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int64) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldRevision, v))
+}
+
+// Protected applies equality check predicate on the "protected" field. It's identical to ProtectedEQ.
+func Protected(v bool) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldProtected, v))
+}
+
+// LegalHold applies equality check predicate on the "legal_hold" field. It's identical to LegalHoldEQ.
+func LegalHold(v bool) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldLegalHold, v))
+}
+
+// LegalHoldReason applies equality check predicate on the "legal_hold_reason" field. It's identical to LegalHoldReasonEQ.
+func LegalHoldReason(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldLegalHoldReason, v))
+}
+
+// DeletedTime applies equality check predicate on the "deleted_time" field. It's identical to DeletedTimeEQ.
+func DeletedTime(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldDeletedTime, v))
+}
+
+// TrashedWithFolderID applies equality check predicate on the "trashed_with_folder_id" field. It's identical to TrashedWithFolderIDEQ.
+func TrashedWithFolderID(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldTrashedWithFolderID, v))
+}
+
+// OriginalParentID applies equality check predicate on the "original_parent_id" field. It's identical to OriginalParentIDEQ.
+func OriginalParentID(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldOriginalParentID, v))
+}
+
+// OriginalPath applies equality check predicate on the "original_path" field. It's identical to OriginalPathEQ.
+func OriginalPath(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldOriginalPath, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.Folder {
 	return predicate.Folder(sql.FieldEQ(FieldCreateBy, v))
@@ -685,6 +740,596 @@ func DepthLTE(v int32) predicate.Folder {
 	return predicate.Folder(sql.FieldLTE(FieldDepth, v))
 }
 
+// LastAccessedTimeEQ applies the EQ predicate on the "last_accessed_time" field.
+func LastAccessedTimeEQ(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeNEQ applies the NEQ predicate on the "last_accessed_time" field.
+func LastAccessedTimeNEQ(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeIn applies the In predicate on the "last_accessed_time" field.
+func LastAccessedTimeIn(vs ...time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldIn(FieldLastAccessedTime, vs...))
+}
+
+// LastAccessedTimeNotIn applies the NotIn predicate on the "last_accessed_time" field.
+func LastAccessedTimeNotIn(vs ...time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldNotIn(FieldLastAccessedTime, vs...))
+}
+
+// LastAccessedTimeGT applies the GT predicate on the "last_accessed_time" field.
+func LastAccessedTimeGT(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldGT(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeGTE applies the GTE predicate on the "last_accessed_time" field.
+func LastAccessedTimeGTE(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldGTE(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeLT applies the LT predicate on the "last_accessed_time" field.
+func LastAccessedTimeLT(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldLT(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeLTE applies the LTE predicate on the "last_accessed_time" field.
+func LastAccessedTimeLTE(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldLTE(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeIsNil applies the IsNil predicate on the "last_accessed_time" field.
+func LastAccessedTimeIsNil() predicate.Folder {
+	return predicate.Folder(sql.FieldIsNull(FieldLastAccessedTime))
+}
+
+// LastAccessedTimeNotNil applies the NotNil predicate on the "last_accessed_time" field.
+func LastAccessedTimeNotNil() predicate.Folder {
+	return predicate.Folder(sql.FieldNotNull(FieldLastAccessedTime))
+}
+
+// SecretCountEQ applies the EQ predicate on the "secret_count" field.
+func SecretCountEQ(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldSecretCount, v))
+}
+
+// SecretCountNEQ applies the NEQ predicate on the "secret_count" field.
+func SecretCountNEQ(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldSecretCount, v))
+}
+
+// SecretCountIn applies the In predicate on the "secret_count" field.
+func SecretCountIn(vs ...int32) predicate.Folder {
+	return predicate.Folder(sql.FieldIn(FieldSecretCount, vs...))
+}
+
+// SecretCountNotIn applies the NotIn predicate on the "secret_count" field.
+func SecretCountNotIn(vs ...int32) predicate.Folder {
+	return predicate.Folder(sql.FieldNotIn(FieldSecretCount, vs...))
+}
+
+// SecretCountGT applies the GT predicate on the "secret_count" field.
+func SecretCountGT(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldGT(FieldSecretCount, v))
+}
+
+// SecretCountGTE applies the GTE predicate on the "secret_count" field.
+func SecretCountGTE(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldGTE(FieldSecretCount, v))
+}
+
+// SecretCountLT applies the LT predicate on the "secret_count" field.
+func SecretCountLT(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldLT(FieldSecretCount, v))
+}
+
+// SecretCountLTE applies the LTE predicate on the "secret_count" field.
+func SecretCountLTE(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldLTE(FieldSecretCount, v))
+}
+
+// SubfolderCountEQ applies the EQ predicate on the "subfolder_count" field.
+func SubfolderCountEQ(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldSubfolderCount, v))
+}
+
+// SubfolderCountNEQ applies the NEQ predicate on the "subfolder_count" field.
+func SubfolderCountNEQ(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldSubfolderCount, v))
+}
+
+// SubfolderCountIn applies the In predicate on the "subfolder_count" field.
+func SubfolderCountIn(vs ...int32) predicate.Folder {
+	return predicate.Folder(sql.FieldIn(FieldSubfolderCount, vs...))
+}
+
+// SubfolderCountNotIn applies the NotIn predicate on the "subfolder_count" field.
+func SubfolderCountNotIn(vs ...int32) predicate.Folder {
+	return predicate.Folder(sql.FieldNotIn(FieldSubfolderCount, vs...))
+}
+
+// SubfolderCountGT applies the GT predicate on the "subfolder_count" field.
+func SubfolderCountGT(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldGT(FieldSubfolderCount, v))
+}
+
+// SubfolderCountGTE applies the GTE predicate on the "subfolder_count" field.
+func SubfolderCountGTE(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldGTE(FieldSubfolderCount, v))
+}
+
+// SubfolderCountLT applies the LT predicate on the "subfolder_count" field.
+func SubfolderCountLT(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldLT(FieldSubfolderCount, v))
+}
+
+// SubfolderCountLTE applies the LTE predicate on the "subfolder_count" field.
+func SubfolderCountLTE(v int32) predicate.Folder {
+	return predicate.Folder(sql.FieldLTE(FieldSubfolderCount, v))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int64) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int64) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int64) predicate.Folder {
+	return predicate.Folder(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int64) predicate.Folder {
+	return predicate.Folder(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int64) predicate.Folder {
+	return predicate.Folder(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int64) predicate.Folder {
+	return predicate.Folder(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int64) predicate.Folder {
+	return predicate.Folder(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int64) predicate.Folder {
+	return predicate.Folder(sql.FieldLTE(FieldRevision, v))
+}
+
+// MetadataSchemaIsNil applies the IsNil predicate on the "metadata_schema" field.
+func MetadataSchemaIsNil() predicate.Folder {
+	return predicate.Folder(sql.FieldIsNull(FieldMetadataSchema))
+}
+
+// MetadataSchemaNotNil applies the NotNil predicate on the "metadata_schema" field.
+func MetadataSchemaNotNil() predicate.Folder {
+	return predicate.Folder(sql.FieldNotNull(FieldMetadataSchema))
+}
+
+// DefaultPermissionsIsNil applies the IsNil predicate on the "default_permissions" field.
+func DefaultPermissionsIsNil() predicate.Folder {
+	return predicate.Folder(sql.FieldIsNull(FieldDefaultPermissions))
+}
+
+// DefaultPermissionsNotNil applies the NotNil predicate on the "default_permissions" field.
+func DefaultPermissionsNotNil() predicate.Folder {
+	return predicate.Folder(sql.FieldNotNull(FieldDefaultPermissions))
+}
+
+// AccessPolicyIsNil applies the IsNil predicate on the "access_policy" field.
+func AccessPolicyIsNil() predicate.Folder {
+	return predicate.Folder(sql.FieldIsNull(FieldAccessPolicy))
+}
+
+// AccessPolicyNotNil applies the NotNil predicate on the "access_policy" field.
+func AccessPolicyNotNil() predicate.Folder {
+	return predicate.Folder(sql.FieldNotNull(FieldAccessPolicy))
+}
+
+// ProtectedEQ applies the EQ predicate on the "protected" field.
+func ProtectedEQ(v bool) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldProtected, v))
+}
+
+// ProtectedNEQ applies the NEQ predicate on the "protected" field.
+func ProtectedNEQ(v bool) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldProtected, v))
+}
+
+// LegalHoldEQ applies the EQ predicate on the "legal_hold" field.
+func LegalHoldEQ(v bool) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldLegalHold, v))
+}
+
+// LegalHoldNEQ applies the NEQ predicate on the "legal_hold" field.
+func LegalHoldNEQ(v bool) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldLegalHold, v))
+}
+
+// LegalHoldReasonEQ applies the EQ predicate on the "legal_hold_reason" field.
+func LegalHoldReasonEQ(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldLegalHoldReason, v))
+}
+
+// LegalHoldReasonNEQ applies the NEQ predicate on the "legal_hold_reason" field.
+func LegalHoldReasonNEQ(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldLegalHoldReason, v))
+}
+
+// LegalHoldReasonIn applies the In predicate on the "legal_hold_reason" field.
+func LegalHoldReasonIn(vs ...string) predicate.Folder {
+	return predicate.Folder(sql.FieldIn(FieldLegalHoldReason, vs...))
+}
+
+// LegalHoldReasonNotIn applies the NotIn predicate on the "legal_hold_reason" field.
+func LegalHoldReasonNotIn(vs ...string) predicate.Folder {
+	return predicate.Folder(sql.FieldNotIn(FieldLegalHoldReason, vs...))
+}
+
+// LegalHoldReasonGT applies the GT predicate on the "legal_hold_reason" field.
+func LegalHoldReasonGT(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldGT(FieldLegalHoldReason, v))
+}
+
+// LegalHoldReasonGTE applies the GTE predicate on the "legal_hold_reason" field.
+func LegalHoldReasonGTE(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldGTE(FieldLegalHoldReason, v))
+}
+
+// LegalHoldReasonLT applies the LT predicate on the "legal_hold_reason" field.
+func LegalHoldReasonLT(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldLT(FieldLegalHoldReason, v))
+}
+
+// LegalHoldReasonLTE applies the LTE predicate on the "legal_hold_reason" field.
+func LegalHoldReasonLTE(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldLTE(FieldLegalHoldReason, v))
+}
+
+// LegalHoldReasonContains applies the Contains predicate on the "legal_hold_reason" field.
+func LegalHoldReasonContains(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldContains(FieldLegalHoldReason, v))
+}
+
+// LegalHoldReasonHasPrefix applies the HasPrefix predicate on the "legal_hold_reason" field.
+func LegalHoldReasonHasPrefix(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldHasPrefix(FieldLegalHoldReason, v))
+}
+
+// LegalHoldReasonHasSuffix applies the HasSuffix predicate on the "legal_hold_reason" field.
+func LegalHoldReasonHasSuffix(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldHasSuffix(FieldLegalHoldReason, v))
+}
+
+// LegalHoldReasonIsNil applies the IsNil predicate on the "legal_hold_reason" field.
+func LegalHoldReasonIsNil() predicate.Folder {
+	return predicate.Folder(sql.FieldIsNull(FieldLegalHoldReason))
+}
+
+// LegalHoldReasonNotNil applies the NotNil predicate on the "legal_hold_reason" field.
+func LegalHoldReasonNotNil() predicate.Folder {
+	return predicate.Folder(sql.FieldNotNull(FieldLegalHoldReason))
+}
+
+// LegalHoldReasonEqualFold applies the EqualFold predicate on the "legal_hold_reason" field.
+func LegalHoldReasonEqualFold(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEqualFold(FieldLegalHoldReason, v))
+}
+
+// LegalHoldReasonContainsFold applies the ContainsFold predicate on the "legal_hold_reason" field.
+func LegalHoldReasonContainsFold(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldContainsFold(FieldLegalHoldReason, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Folder {
+	return predicate.Folder(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Folder {
+	return predicate.Folder(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// DeletedTimeEQ applies the EQ predicate on the "deleted_time" field.
+func DeletedTimeEQ(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldDeletedTime, v))
+}
+
+// DeletedTimeNEQ applies the NEQ predicate on the "deleted_time" field.
+func DeletedTimeNEQ(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldDeletedTime, v))
+}
+
+// DeletedTimeIn applies the In predicate on the "deleted_time" field.
+func DeletedTimeIn(vs ...time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldIn(FieldDeletedTime, vs...))
+}
+
+// DeletedTimeNotIn applies the NotIn predicate on the "deleted_time" field.
+func DeletedTimeNotIn(vs ...time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldNotIn(FieldDeletedTime, vs...))
+}
+
+// DeletedTimeGT applies the GT predicate on the "deleted_time" field.
+func DeletedTimeGT(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldGT(FieldDeletedTime, v))
+}
+
+// DeletedTimeGTE applies the GTE predicate on the "deleted_time" field.
+func DeletedTimeGTE(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldGTE(FieldDeletedTime, v))
+}
+
+// DeletedTimeLT applies the LT predicate on the "deleted_time" field.
+func DeletedTimeLT(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldLT(FieldDeletedTime, v))
+}
+
+// DeletedTimeLTE applies the LTE predicate on the "deleted_time" field.
+func DeletedTimeLTE(v time.Time) predicate.Folder {
+	return predicate.Folder(sql.FieldLTE(FieldDeletedTime, v))
+}
+
+// DeletedTimeIsNil applies the IsNil predicate on the "deleted_time" field.
+func DeletedTimeIsNil() predicate.Folder {
+	return predicate.Folder(sql.FieldIsNull(FieldDeletedTime))
+}
+
+// DeletedTimeNotNil applies the NotNil predicate on the "deleted_time" field.
+func DeletedTimeNotNil() predicate.Folder {
+	return predicate.Folder(sql.FieldNotNull(FieldDeletedTime))
+}
+
+// TrashedWithFolderIDEQ applies the EQ predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDEQ(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDNEQ applies the NEQ predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDNEQ(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDIn applies the In predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDIn(vs ...string) predicate.Folder {
+	return predicate.Folder(sql.FieldIn(FieldTrashedWithFolderID, vs...))
+}
+
+// TrashedWithFolderIDNotIn applies the NotIn predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDNotIn(vs ...string) predicate.Folder {
+	return predicate.Folder(sql.FieldNotIn(FieldTrashedWithFolderID, vs...))
+}
+
+// TrashedWithFolderIDGT applies the GT predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDGT(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldGT(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDGTE applies the GTE predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDGTE(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldGTE(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDLT applies the LT predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDLT(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldLT(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDLTE applies the LTE predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDLTE(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldLTE(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDContains applies the Contains predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDContains(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldContains(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDHasPrefix applies the HasPrefix predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDHasPrefix(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldHasPrefix(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDHasSuffix applies the HasSuffix predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDHasSuffix(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldHasSuffix(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDIsNil applies the IsNil predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDIsNil() predicate.Folder {
+	return predicate.Folder(sql.FieldIsNull(FieldTrashedWithFolderID))
+}
+
+// TrashedWithFolderIDNotNil applies the NotNil predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDNotNil() predicate.Folder {
+	return predicate.Folder(sql.FieldNotNull(FieldTrashedWithFolderID))
+}
+
+// TrashedWithFolderIDEqualFold applies the EqualFold predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDEqualFold(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEqualFold(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDContainsFold applies the ContainsFold predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDContainsFold(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldContainsFold(FieldTrashedWithFolderID, v))
+}
+
+// OriginalParentIDEQ applies the EQ predicate on the "original_parent_id" field.
+func OriginalParentIDEQ(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldOriginalParentID, v))
+}
+
+// OriginalParentIDNEQ applies the NEQ predicate on the "original_parent_id" field.
+func OriginalParentIDNEQ(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldOriginalParentID, v))
+}
+
+// OriginalParentIDIn applies the In predicate on the "original_parent_id" field.
+func OriginalParentIDIn(vs ...string) predicate.Folder {
+	return predicate.Folder(sql.FieldIn(FieldOriginalParentID, vs...))
+}
+
+// OriginalParentIDNotIn applies the NotIn predicate on the "original_parent_id" field.
+func OriginalParentIDNotIn(vs ...string) predicate.Folder {
+	return predicate.Folder(sql.FieldNotIn(FieldOriginalParentID, vs...))
+}
+
+// OriginalParentIDGT applies the GT predicate on the "original_parent_id" field.
+func OriginalParentIDGT(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldGT(FieldOriginalParentID, v))
+}
+
+// OriginalParentIDGTE applies the GTE predicate on the "original_parent_id" field.
+func OriginalParentIDGTE(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldGTE(FieldOriginalParentID, v))
+}
+
+// OriginalParentIDLT applies the LT predicate on the "original_parent_id" field.
+func OriginalParentIDLT(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldLT(FieldOriginalParentID, v))
+}
+
+// OriginalParentIDLTE applies the LTE predicate on the "original_parent_id" field.
+func OriginalParentIDLTE(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldLTE(FieldOriginalParentID, v))
+}
+
+// OriginalParentIDContains applies the Contains predicate on the "original_parent_id" field.
+func OriginalParentIDContains(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldContains(FieldOriginalParentID, v))
+}
+
+// OriginalParentIDHasPrefix applies the HasPrefix predicate on the "original_parent_id" field.
+func OriginalParentIDHasPrefix(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldHasPrefix(FieldOriginalParentID, v))
+}
+
+// OriginalParentIDHasSuffix applies the HasSuffix predicate on the "original_parent_id" field.
+func OriginalParentIDHasSuffix(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldHasSuffix(FieldOriginalParentID, v))
+}
+
+// OriginalParentIDIsNil applies the IsNil predicate on the "original_parent_id" field.
+func OriginalParentIDIsNil() predicate.Folder {
+	return predicate.Folder(sql.FieldIsNull(FieldOriginalParentID))
+}
+
+// OriginalParentIDNotNil applies the NotNil predicate on the "original_parent_id" field.
+func OriginalParentIDNotNil() predicate.Folder {
+	return predicate.Folder(sql.FieldNotNull(FieldOriginalParentID))
+}
+
+// OriginalParentIDEqualFold applies the EqualFold predicate on the "original_parent_id" field.
+func OriginalParentIDEqualFold(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEqualFold(FieldOriginalParentID, v))
+}
+
+// OriginalParentIDContainsFold applies the ContainsFold predicate on the "original_parent_id" field.
+func OriginalParentIDContainsFold(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldContainsFold(FieldOriginalParentID, v))
+}
+
+// OriginalPathEQ applies the EQ predicate on the "original_path" field.
+func OriginalPathEQ(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEQ(FieldOriginalPath, v))
+}
+
+// OriginalPathNEQ applies the NEQ predicate on the "original_path" field.
+func OriginalPathNEQ(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldNEQ(FieldOriginalPath, v))
+}
+
+// OriginalPathIn applies the In predicate on the "original_path" field.
+func OriginalPathIn(vs ...string) predicate.Folder {
+	return predicate.Folder(sql.FieldIn(FieldOriginalPath, vs...))
+}
+
+// OriginalPathNotIn applies the NotIn predicate on the "original_path" field.
+func OriginalPathNotIn(vs ...string) predicate.Folder {
+	return predicate.Folder(sql.FieldNotIn(FieldOriginalPath, vs...))
+}
+
+// OriginalPathGT applies the GT predicate on the "original_path" field.
+func OriginalPathGT(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldGT(FieldOriginalPath, v))
+}
+
+// OriginalPathGTE applies the GTE predicate on the "original_path" field.
+func OriginalPathGTE(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldGTE(FieldOriginalPath, v))
+}
+
+// OriginalPathLT applies the LT predicate on the "original_path" field.
+func OriginalPathLT(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldLT(FieldOriginalPath, v))
+}
+
+// OriginalPathLTE applies the LTE predicate on the "original_path" field.
+func OriginalPathLTE(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldLTE(FieldOriginalPath, v))
+}
+
+// OriginalPathContains applies the Contains predicate on the "original_path" field.
+func OriginalPathContains(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldContains(FieldOriginalPath, v))
+}
+
+// OriginalPathHasPrefix applies the HasPrefix predicate on the "original_path" field.
+func OriginalPathHasPrefix(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldHasPrefix(FieldOriginalPath, v))
+}
+
+// OriginalPathHasSuffix applies the HasSuffix predicate on the "original_path" field.
+func OriginalPathHasSuffix(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldHasSuffix(FieldOriginalPath, v))
+}
+
+// OriginalPathIsNil applies the IsNil predicate on the "original_path" field.
+func OriginalPathIsNil() predicate.Folder {
+	return predicate.Folder(sql.FieldIsNull(FieldOriginalPath))
+}
+
+// OriginalPathNotNil applies the NotNil predicate on the "original_path" field.
+func OriginalPathNotNil() predicate.Folder {
+	return predicate.Folder(sql.FieldNotNull(FieldOriginalPath))
+}
+
+// OriginalPathEqualFold applies the EqualFold predicate on the "original_path" field.
+func OriginalPathEqualFold(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldEqualFold(FieldOriginalPath, v))
+}
+
+// OriginalPathContainsFold applies the ContainsFold predicate on the "original_path" field.
+func OriginalPathContainsFold(v string) predicate.Folder {
+	return predicate.Folder(sql.FieldContainsFold(FieldOriginalPath, v))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Folder {
 	return predicate.Folder(func(s *sql.Selector) {
@@ -8,10 +8,14 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
 )
 
@@ -20,6 +24,7 @@ type FolderCreate struct {
 	config
 	mutation *FolderMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateBy sets the "create_by" field.
@@ -146,6 +151,192 @@ func (_c *FolderCreate) SetNillableDepth(v *int32) *FolderCreate {
 	return _c
 }
 
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (_c *FolderCreate) SetLastAccessedTime(v time.Time) *FolderCreate {
+	_c.mutation.SetLastAccessedTime(v)
+	return _c
+}
+
+// SetNillableLastAccessedTime sets the "last_accessed_time" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableLastAccessedTime(v *time.Time) *FolderCreate {
+	if v != nil {
+		_c.SetLastAccessedTime(*v)
+	}
+	return _c
+}
+
+// SetSecretCount sets the "secret_count" field.
+func (_c *FolderCreate) SetSecretCount(v int32) *FolderCreate {
+	_c.mutation.SetSecretCount(v)
+	return _c
+}
+
+// SetNillableSecretCount sets the "secret_count" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableSecretCount(v *int32) *FolderCreate {
+	if v != nil {
+		_c.SetSecretCount(*v)
+	}
+	return _c
+}
+
+// SetSubfolderCount sets the "subfolder_count" field.
+func (_c *FolderCreate) SetSubfolderCount(v int32) *FolderCreate {
+	_c.mutation.SetSubfolderCount(v)
+	return _c
+}
+
+// SetNillableSubfolderCount sets the "subfolder_count" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableSubfolderCount(v *int32) *FolderCreate {
+	if v != nil {
+		_c.SetSubfolderCount(*v)
+	}
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *FolderCreate) SetRevision(v int64) *FolderCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableRevision(v *int64) *FolderCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
+// SetMetadataSchema sets the "metadata_schema" field.
+func (_c *FolderCreate) SetMetadataSchema(v map[string]interface{}) *FolderCreate {
+	_c.mutation.SetMetadataSchema(v)
+	return _c
+}
+
+// SetDefaultPermissions sets the "default_permissions" field.
+func (_c *FolderCreate) SetDefaultPermissions(v []schema.FolderDefaultPermission) *FolderCreate {
+	_c.mutation.SetDefaultPermissions(v)
+	return _c
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (_c *FolderCreate) SetAccessPolicy(v *authz.AccessPolicy) *FolderCreate {
+	_c.mutation.SetAccessPolicy(v)
+	return _c
+}
+
+// SetProtected sets the "protected" field.
+func (_c *FolderCreate) SetProtected(v bool) *FolderCreate {
+	_c.mutation.SetProtected(v)
+	return _c
+}
+
+// SetNillableProtected sets the "protected" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableProtected(v *bool) *FolderCreate {
+	if v != nil {
+		_c.SetProtected(*v)
+	}
+	return _c
+}
+
+// SetLegalHold sets the "legal_hold" field.
+func (_c *FolderCreate) SetLegalHold(v bool) *FolderCreate {
+	_c.mutation.SetLegalHold(v)
+	return _c
+}
+
+// SetNillableLegalHold sets the "legal_hold" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableLegalHold(v *bool) *FolderCreate {
+	if v != nil {
+		_c.SetLegalHold(*v)
+	}
+	return _c
+}
+
+// SetLegalHoldReason sets the "legal_hold_reason" field.
+func (_c *FolderCreate) SetLegalHoldReason(v string) *FolderCreate {
+	_c.mutation.SetLegalHoldReason(v)
+	return _c
+}
+
+// SetNillableLegalHoldReason sets the "legal_hold_reason" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableLegalHoldReason(v *string) *FolderCreate {
+	if v != nil {
+		_c.SetLegalHoldReason(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *FolderCreate) SetStatus(v folder.Status) *FolderCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableStatus(v *folder.Status) *FolderCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetDeletedTime sets the "deleted_time" field.
+func (_c *FolderCreate) SetDeletedTime(v time.Time) *FolderCreate {
+	_c.mutation.SetDeletedTime(v)
+	return _c
+}
+
+// SetNillableDeletedTime sets the "deleted_time" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableDeletedTime(v *time.Time) *FolderCreate {
+	if v != nil {
+		_c.SetDeletedTime(*v)
+	}
+	return _c
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (_c *FolderCreate) SetTrashedWithFolderID(v string) *FolderCreate {
+	_c.mutation.SetTrashedWithFolderID(v)
+	return _c
+}
+
+// SetNillableTrashedWithFolderID sets the "trashed_with_folder_id" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableTrashedWithFolderID(v *string) *FolderCreate {
+	if v != nil {
+		_c.SetTrashedWithFolderID(*v)
+	}
+	return _c
+}
+
+// SetOriginalParentID sets the "original_parent_id" field.
+func (_c *FolderCreate) SetOriginalParentID(v string) *FolderCreate {
+	_c.mutation.SetOriginalParentID(v)
+	return _c
+}
+
+// SetNillableOriginalParentID sets the "original_parent_id" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableOriginalParentID(v *string) *FolderCreate {
+	if v != nil {
+		_c.SetOriginalParentID(*v)
+	}
+	return _c
+}
+
+// SetOriginalPath sets the "original_path" field.
+func (_c *FolderCreate) SetOriginalPath(v string) *FolderCreate {
+	_c.mutation.SetOriginalPath(v)
+	return _c
+}
+
+// SetNillableOriginalPath sets the "original_path" field if the given value is not nil.
+func (_c *FolderCreate) SetNillableOriginalPath(v *string) *FolderCreate {
+	if v != nil {
+		_c.SetOriginalPath(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FolderCreate) SetID(v string) *FolderCreate {
 	_c.mutation.SetID(v)
@@ -247,6 +438,30 @@ func (_c *FolderCreate) defaults() error {
 		v := folder.DefaultDepth
 		_c.mutation.SetDepth(v)
 	}
+	if _, ok := _c.mutation.SecretCount(); !ok {
+		v := folder.DefaultSecretCount
+		_c.mutation.SetSecretCount(v)
+	}
+	if _, ok := _c.mutation.SubfolderCount(); !ok {
+		v := folder.DefaultSubfolderCount
+		_c.mutation.SetSubfolderCount(v)
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		v := folder.DefaultRevision
+		_c.mutation.SetRevision(v)
+	}
+	if _, ok := _c.mutation.Protected(); !ok {
+		v := folder.DefaultProtected
+		_c.mutation.SetProtected(v)
+	}
+	if _, ok := _c.mutation.LegalHold(); !ok {
+		v := folder.DefaultLegalHold
+		_c.mutation.SetLegalHold(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := folder.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
 	return nil
 }
 
@@ -276,6 +491,44 @@ func (_c *FolderCreate) check() error {
 	if _, ok := _c.mutation.Depth(); !ok {
 		return &ValidationError{Name: "depth", err: errors.New(`ent: missing required field "Folder.depth"`)}
 	}
+	if _, ok := _c.mutation.SecretCount(); !ok {
+		return &ValidationError{Name: "secret_count", err: errors.New(`ent: missing required field "Folder.secret_count"`)}
+	}
+	if _, ok := _c.mutation.SubfolderCount(); !ok {
+		return &ValidationError{Name: "subfolder_count", err: errors.New(`ent: missing required field "Folder.subfolder_count"`)}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "Folder.revision"`)}
+	}
+	if v, ok := _c.mutation.AccessPolicy(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "access_policy", err: fmt.Errorf(`ent: validator failed for field "Folder.access_policy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Protected(); !ok {
+		return &ValidationError{Name: "protected", err: errors.New(`ent: missing required field "Folder.protected"`)}
+	}
+	if _, ok := _c.mutation.LegalHold(); !ok {
+		return &ValidationError{Name: "legal_hold", err: errors.New(`ent: missing required field "Folder.legal_hold"`)}
+	}
+	if v, ok := _c.mutation.LegalHoldReason(); ok {
+		if err := folder.LegalHoldReasonValidator(v); err != nil {
+			return &ValidationError{Name: "legal_hold_reason", err: fmt.Errorf(`ent: validator failed for field "Folder.legal_hold_reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Folder.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := folder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Folder.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.OriginalPath(); ok {
+		if err := folder.OriginalPathValidator(v); err != nil {
+			return &ValidationError{Name: "original_path", err: fmt.Errorf(`ent: validator failed for field "Folder.original_path": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := folder.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Folder.id": %w`, err)}
@@ -312,6 +565,7 @@ func (_c *FolderCreate) createSpec() (*Folder, *sqlgraph.CreateSpec) {
 		_node = &Folder{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(folder.Table, sqlgraph.NewFieldSpec(folder.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -352,6 +606,66 @@ func (_c *FolderCreate) createSpec() (*Folder, *sqlgraph.CreateSpec) {
 		_spec.SetField(folder.FieldDepth, field.TypeInt32, value)
 		_node.Depth = value
 	}
+	if value, ok := _c.mutation.LastAccessedTime(); ok {
+		_spec.SetField(folder.FieldLastAccessedTime, field.TypeTime, value)
+		_node.LastAccessedTime = &value
+	}
+	if value, ok := _c.mutation.SecretCount(); ok {
+		_spec.SetField(folder.FieldSecretCount, field.TypeInt32, value)
+		_node.SecretCount = value
+	}
+	if value, ok := _c.mutation.SubfolderCount(); ok {
+		_spec.SetField(folder.FieldSubfolderCount, field.TypeInt32, value)
+		_node.SubfolderCount = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(folder.FieldRevision, field.TypeInt64, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.MetadataSchema(); ok {
+		_spec.SetField(folder.FieldMetadataSchema, field.TypeJSON, value)
+		_node.MetadataSchema = value
+	}
+	if value, ok := _c.mutation.DefaultPermissions(); ok {
+		_spec.SetField(folder.FieldDefaultPermissions, field.TypeJSON, value)
+		_node.DefaultPermissions = value
+	}
+	if value, ok := _c.mutation.AccessPolicy(); ok {
+		_spec.SetField(folder.FieldAccessPolicy, field.TypeJSON, value)
+		_node.AccessPolicy = value
+	}
+	if value, ok := _c.mutation.Protected(); ok {
+		_spec.SetField(folder.FieldProtected, field.TypeBool, value)
+		_node.Protected = value
+	}
+	if value, ok := _c.mutation.LegalHold(); ok {
+		_spec.SetField(folder.FieldLegalHold, field.TypeBool, value)
+		_node.LegalHold = value
+	}
+	if value, ok := _c.mutation.LegalHoldReason(); ok {
+		_spec.SetField(folder.FieldLegalHoldReason, field.TypeString, value)
+		_node.LegalHoldReason = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(folder.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.DeletedTime(); ok {
+		_spec.SetField(folder.FieldDeletedTime, field.TypeTime, value)
+		_node.DeletedTime = &value
+	}
+	if value, ok := _c.mutation.TrashedWithFolderID(); ok {
+		_spec.SetField(folder.FieldTrashedWithFolderID, field.TypeString, value)
+		_node.TrashedWithFolderID = &value
+	}
+	if value, ok := _c.mutation.OriginalParentID(); ok {
+		_spec.SetField(folder.FieldOriginalParentID, field.TypeString, value)
+		_node.OriginalParentID = &value
+	}
+	if value, ok := _c.mutation.OriginalPath(); ok {
+		_spec.SetField(folder.FieldOriginalPath, field.TypeString, value)
+		_node.OriginalPath = value
+	}
 	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -420,86 +734,1649 @@ func (_c *FolderCreate) createSpec() (*Folder, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
-// FolderCreateBulk is the builder for creating many Folder entities in bulk.
-type FolderCreateBulk struct {
-	config
-	err      error
-	builders []*FolderCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Folder.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FolderUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FolderCreate) OnConflict(opts ...sql.ConflictOption) *FolderUpsertOne {
+	_c.conflict = opts
+	return &FolderUpsertOne{
+		create: _c,
+	}
 }
 
-// Save creates the Folder entities in the database.
-func (_c *FolderCreateBulk) Save(ctx context.Context) ([]*Folder, error) {
-	if _c.err != nil {
-		return nil, _c.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Folder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FolderCreate) OnConflictColumns(columns ...string) *FolderUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FolderUpsertOne{
+		create: _c,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Folder, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*FolderMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// FolderUpsertOne is the builder for "upsert"-ing
+	//  one Folder node.
+	FolderUpsertOne struct {
+		create *FolderCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// FolderUpsert is the "OnConflict" setter.
+	FolderUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *FolderUpsert) SetCreateBy(v uint32) *FolderUpsert {
+	u.Set(folder.FieldCreateBy, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *FolderCreateBulk) SaveX(ctx context.Context) []*Folder {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateCreateBy() *FolderUpsert {
+	u.SetExcluded(folder.FieldCreateBy)
+	return u
 }
 
-// Exec executes the query.
-func (_c *FolderCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// AddCreateBy adds v to the "create_by" field.
+func (u *FolderUpsert) AddCreateBy(v uint32) *FolderUpsert {
+	u.Add(folder.FieldCreateBy, v)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *FolderCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *FolderUpsert) ClearCreateBy() *FolderUpsert {
+	u.SetNull(folder.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FolderUpsert) SetUpdateTime(v time.Time) *FolderUpsert {
+	u.Set(folder.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateUpdateTime() *FolderUpsert {
+	u.SetExcluded(folder.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *FolderUpsert) ClearUpdateTime() *FolderUpsert {
+	u.SetNull(folder.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *FolderUpsert) SetDeleteTime(v time.Time) *FolderUpsert {
+	u.Set(folder.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateDeleteTime() *FolderUpsert {
+	u.SetExcluded(folder.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *FolderUpsert) ClearDeleteTime() *FolderUpsert {
+	u.SetNull(folder.FieldDeleteTime)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *FolderUpsert) SetParentID(v string) *FolderUpsert {
+	u.Set(folder.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateParentID() *FolderUpsert {
+	u.SetExcluded(folder.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *FolderUpsert) ClearParentID() *FolderUpsert {
+	u.SetNull(folder.FieldParentID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *FolderUpsert) SetName(v string) *FolderUpsert {
+	u.Set(folder.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateName() *FolderUpsert {
+	u.SetExcluded(folder.FieldName)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *FolderUpsert) SetPath(v string) *FolderUpsert {
+	u.Set(folder.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *FolderUpsert) UpdatePath() *FolderUpsert {
+	u.SetExcluded(folder.FieldPath)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *FolderUpsert) SetDescription(v string) *FolderUpsert {
+	u.Set(folder.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateDescription() *FolderUpsert {
+	u.SetExcluded(folder.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *FolderUpsert) ClearDescription() *FolderUpsert {
+	u.SetNull(folder.FieldDescription)
+	return u
+}
+
+// SetDepth sets the "depth" field.
+func (u *FolderUpsert) SetDepth(v int32) *FolderUpsert {
+	u.Set(folder.FieldDepth, v)
+	return u
+}
+
+// UpdateDepth sets the "depth" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateDepth() *FolderUpsert {
+	u.SetExcluded(folder.FieldDepth)
+	return u
+}
+
+// AddDepth adds v to the "depth" field.
+func (u *FolderUpsert) AddDepth(v int32) *FolderUpsert {
+	u.Add(folder.FieldDepth, v)
+	return u
+}
+
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (u *FolderUpsert) SetLastAccessedTime(v time.Time) *FolderUpsert {
+	u.Set(folder.FieldLastAccessedTime, v)
+	return u
+}
+
+// UpdateLastAccessedTime sets the "last_accessed_time" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateLastAccessedTime() *FolderUpsert {
+	u.SetExcluded(folder.FieldLastAccessedTime)
+	return u
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (u *FolderUpsert) ClearLastAccessedTime() *FolderUpsert {
+	u.SetNull(folder.FieldLastAccessedTime)
+	return u
+}
+
+// SetSecretCount sets the "secret_count" field.
+func (u *FolderUpsert) SetSecretCount(v int32) *FolderUpsert {
+	u.Set(folder.FieldSecretCount, v)
+	return u
+}
+
+// UpdateSecretCount sets the "secret_count" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateSecretCount() *FolderUpsert {
+	u.SetExcluded(folder.FieldSecretCount)
+	return u
+}
+
+// AddSecretCount adds v to the "secret_count" field.
+func (u *FolderUpsert) AddSecretCount(v int32) *FolderUpsert {
+	u.Add(folder.FieldSecretCount, v)
+	return u
+}
+
+// SetSubfolderCount sets the "subfolder_count" field.
+func (u *FolderUpsert) SetSubfolderCount(v int32) *FolderUpsert {
+	u.Set(folder.FieldSubfolderCount, v)
+	return u
+}
+
+// UpdateSubfolderCount sets the "subfolder_count" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateSubfolderCount() *FolderUpsert {
+	u.SetExcluded(folder.FieldSubfolderCount)
+	return u
+}
+
+// AddSubfolderCount adds v to the "subfolder_count" field.
+func (u *FolderUpsert) AddSubfolderCount(v int32) *FolderUpsert {
+	u.Add(folder.FieldSubfolderCount, v)
+	return u
+}
+
+// SetRevision sets the "revision" field.
+func (u *FolderUpsert) SetRevision(v int64) *FolderUpsert {
+	u.Set(folder.FieldRevision, v)
+	return u
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateRevision() *FolderUpsert {
+	u.SetExcluded(folder.FieldRevision)
+	return u
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *FolderUpsert) AddRevision(v int64) *FolderUpsert {
+	u.Add(folder.FieldRevision, v)
+	return u
+}
+
+// SetMetadataSchema sets the "metadata_schema" field.
+func (u *FolderUpsert) SetMetadataSchema(v map[string]interface{}) *FolderUpsert {
+	u.Set(folder.FieldMetadataSchema, v)
+	return u
+}
+
+// UpdateMetadataSchema sets the "metadata_schema" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateMetadataSchema() *FolderUpsert {
+	u.SetExcluded(folder.FieldMetadataSchema)
+	return u
+}
+
+// ClearMetadataSchema clears the value of the "metadata_schema" field.
+func (u *FolderUpsert) ClearMetadataSchema() *FolderUpsert {
+	u.SetNull(folder.FieldMetadataSchema)
+	return u
+}
+
+// SetDefaultPermissions sets the "default_permissions" field.
+func (u *FolderUpsert) SetDefaultPermissions(v []schema.FolderDefaultPermission) *FolderUpsert {
+	u.Set(folder.FieldDefaultPermissions, v)
+	return u
+}
+
+// UpdateDefaultPermissions sets the "default_permissions" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateDefaultPermissions() *FolderUpsert {
+	u.SetExcluded(folder.FieldDefaultPermissions)
+	return u
+}
+
+// ClearDefaultPermissions clears the value of the "default_permissions" field.
+func (u *FolderUpsert) ClearDefaultPermissions() *FolderUpsert {
+	u.SetNull(folder.FieldDefaultPermissions)
+	return u
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (u *FolderUpsert) SetAccessPolicy(v *authz.AccessPolicy) *FolderUpsert {
+	u.Set(folder.FieldAccessPolicy, v)
+	return u
+}
+
+// UpdateAccessPolicy sets the "access_policy" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateAccessPolicy() *FolderUpsert {
+	u.SetExcluded(folder.FieldAccessPolicy)
+	return u
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (u *FolderUpsert) ClearAccessPolicy() *FolderUpsert {
+	u.SetNull(folder.FieldAccessPolicy)
+	return u
+}
+
+// SetProtected sets the "protected" field.
+func (u *FolderUpsert) SetProtected(v bool) *FolderUpsert {
+	u.Set(folder.FieldProtected, v)
+	return u
+}
+
+// UpdateProtected sets the "protected" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateProtected() *FolderUpsert {
+	u.SetExcluded(folder.FieldProtected)
+	return u
+}
+
+// SetLegalHold sets the "legal_hold" field.
+func (u *FolderUpsert) SetLegalHold(v bool) *FolderUpsert {
+	u.Set(folder.FieldLegalHold, v)
+	return u
+}
+
+// UpdateLegalHold sets the "legal_hold" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateLegalHold() *FolderUpsert {
+	u.SetExcluded(folder.FieldLegalHold)
+	return u
+}
+
+// SetLegalHoldReason sets the "legal_hold_reason" field.
+func (u *FolderUpsert) SetLegalHoldReason(v string) *FolderUpsert {
+	u.Set(folder.FieldLegalHoldReason, v)
+	return u
+}
+
+// UpdateLegalHoldReason sets the "legal_hold_reason" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateLegalHoldReason() *FolderUpsert {
+	u.SetExcluded(folder.FieldLegalHoldReason)
+	return u
+}
+
+// ClearLegalHoldReason clears the value of the "legal_hold_reason" field.
+func (u *FolderUpsert) ClearLegalHoldReason() *FolderUpsert {
+	u.SetNull(folder.FieldLegalHoldReason)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *FolderUpsert) SetStatus(v folder.Status) *FolderUpsert {
+	u.Set(folder.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateStatus() *FolderUpsert {
+	u.SetExcluded(folder.FieldStatus)
+	return u
+}
+
+// SetDeletedTime sets the "deleted_time" field.
+func (u *FolderUpsert) SetDeletedTime(v time.Time) *FolderUpsert {
+	u.Set(folder.FieldDeletedTime, v)
+	return u
+}
+
+// UpdateDeletedTime sets the "deleted_time" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateDeletedTime() *FolderUpsert {
+	u.SetExcluded(folder.FieldDeletedTime)
+	return u
+}
+
+// ClearDeletedTime clears the value of the "deleted_time" field.
+func (u *FolderUpsert) ClearDeletedTime() *FolderUpsert {
+	u.SetNull(folder.FieldDeletedTime)
+	return u
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (u *FolderUpsert) SetTrashedWithFolderID(v string) *FolderUpsert {
+	u.Set(folder.FieldTrashedWithFolderID, v)
+	return u
+}
+
+// UpdateTrashedWithFolderID sets the "trashed_with_folder_id" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateTrashedWithFolderID() *FolderUpsert {
+	u.SetExcluded(folder.FieldTrashedWithFolderID)
+	return u
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (u *FolderUpsert) ClearTrashedWithFolderID() *FolderUpsert {
+	u.SetNull(folder.FieldTrashedWithFolderID)
+	return u
+}
+
+// SetOriginalParentID sets the "original_parent_id" field.
+func (u *FolderUpsert) SetOriginalParentID(v string) *FolderUpsert {
+	u.Set(folder.FieldOriginalParentID, v)
+	return u
+}
+
+// UpdateOriginalParentID sets the "original_parent_id" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateOriginalParentID() *FolderUpsert {
+	u.SetExcluded(folder.FieldOriginalParentID)
+	return u
+}
+
+// ClearOriginalParentID clears the value of the "original_parent_id" field.
+func (u *FolderUpsert) ClearOriginalParentID() *FolderUpsert {
+	u.SetNull(folder.FieldOriginalParentID)
+	return u
+}
+
+// SetOriginalPath sets the "original_path" field.
+func (u *FolderUpsert) SetOriginalPath(v string) *FolderUpsert {
+	u.Set(folder.FieldOriginalPath, v)
+	return u
+}
+
+// UpdateOriginalPath sets the "original_path" field to the value that was provided on create.
+func (u *FolderUpsert) UpdateOriginalPath() *FolderUpsert {
+	u.SetExcluded(folder.FieldOriginalPath)
+	return u
+}
+
+// ClearOriginalPath clears the value of the "original_path" field.
+func (u *FolderUpsert) ClearOriginalPath() *FolderUpsert {
+	u.SetNull(folder.FieldOriginalPath)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Folder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(folder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FolderUpsertOne) UpdateNewValues() *FolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(folder.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(folder.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(folder.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Folder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FolderUpsertOne) Ignore() *FolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FolderUpsertOne) DoNothing() *FolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FolderCreate.OnConflict
+// documentation for more info.
+func (u *FolderUpsertOne) Update(set func(*FolderUpsert)) *FolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FolderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *FolderUpsertOne) SetCreateBy(v uint32) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *FolderUpsertOne) AddCreateBy(v uint32) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateCreateBy() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *FolderUpsertOne) ClearCreateBy() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FolderUpsertOne) SetUpdateTime(v time.Time) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateUpdateTime() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *FolderUpsertOne) ClearUpdateTime() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *FolderUpsertOne) SetDeleteTime(v time.Time) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateDeleteTime() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *FolderUpsertOne) ClearDeleteTime() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *FolderUpsertOne) SetParentID(v string) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateParentID() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *FolderUpsertOne) ClearParentID() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *FolderUpsertOne) SetName(v string) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateName() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *FolderUpsertOne) SetPath(v string) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdatePath() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *FolderUpsertOne) SetDescription(v string) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateDescription() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *FolderUpsertOne) ClearDescription() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDepth sets the "depth" field.
+func (u *FolderUpsertOne) SetDepth(v int32) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetDepth(v)
+	})
+}
+
+// AddDepth adds v to the "depth" field.
+func (u *FolderUpsertOne) AddDepth(v int32) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.AddDepth(v)
+	})
+}
+
+// UpdateDepth sets the "depth" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateDepth() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateDepth()
+	})
+}
+
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (u *FolderUpsertOne) SetLastAccessedTime(v time.Time) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetLastAccessedTime(v)
+	})
+}
+
+// UpdateLastAccessedTime sets the "last_accessed_time" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateLastAccessedTime() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateLastAccessedTime()
+	})
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (u *FolderUpsertOne) ClearLastAccessedTime() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearLastAccessedTime()
+	})
+}
+
+// SetSecretCount sets the "secret_count" field.
+func (u *FolderUpsertOne) SetSecretCount(v int32) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetSecretCount(v)
+	})
+}
+
+// AddSecretCount adds v to the "secret_count" field.
+func (u *FolderUpsertOne) AddSecretCount(v int32) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.AddSecretCount(v)
+	})
+}
+
+// UpdateSecretCount sets the "secret_count" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateSecretCount() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateSecretCount()
+	})
+}
+
+// SetSubfolderCount sets the "subfolder_count" field.
+func (u *FolderUpsertOne) SetSubfolderCount(v int32) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetSubfolderCount(v)
+	})
+}
+
+// AddSubfolderCount adds v to the "subfolder_count" field.
+func (u *FolderUpsertOne) AddSubfolderCount(v int32) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.AddSubfolderCount(v)
+	})
+}
+
+// UpdateSubfolderCount sets the "subfolder_count" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateSubfolderCount() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateSubfolderCount()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *FolderUpsertOne) SetRevision(v int64) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *FolderUpsertOne) AddRevision(v int64) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateRevision() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// SetMetadataSchema sets the "metadata_schema" field.
+func (u *FolderUpsertOne) SetMetadataSchema(v map[string]interface{}) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetMetadataSchema(v)
+	})
+}
+
+// UpdateMetadataSchema sets the "metadata_schema" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateMetadataSchema() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateMetadataSchema()
+	})
+}
+
+// ClearMetadataSchema clears the value of the "metadata_schema" field.
+func (u *FolderUpsertOne) ClearMetadataSchema() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearMetadataSchema()
+	})
+}
+
+// SetDefaultPermissions sets the "default_permissions" field.
+func (u *FolderUpsertOne) SetDefaultPermissions(v []schema.FolderDefaultPermission) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetDefaultPermissions(v)
+	})
+}
+
+// UpdateDefaultPermissions sets the "default_permissions" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateDefaultPermissions() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateDefaultPermissions()
+	})
+}
+
+// ClearDefaultPermissions clears the value of the "default_permissions" field.
+func (u *FolderUpsertOne) ClearDefaultPermissions() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearDefaultPermissions()
+	})
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (u *FolderUpsertOne) SetAccessPolicy(v *authz.AccessPolicy) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetAccessPolicy(v)
+	})
+}
+
+// UpdateAccessPolicy sets the "access_policy" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateAccessPolicy() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateAccessPolicy()
+	})
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (u *FolderUpsertOne) ClearAccessPolicy() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearAccessPolicy()
+	})
+}
+
+// SetProtected sets the "protected" field.
+func (u *FolderUpsertOne) SetProtected(v bool) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetProtected(v)
+	})
+}
+
+// UpdateProtected sets the "protected" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateProtected() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateProtected()
+	})
+}
+
+// SetLegalHold sets the "legal_hold" field.
+func (u *FolderUpsertOne) SetLegalHold(v bool) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetLegalHold(v)
+	})
+}
+
+// UpdateLegalHold sets the "legal_hold" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateLegalHold() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateLegalHold()
+	})
+}
+
+// SetLegalHoldReason sets the "legal_hold_reason" field.
+func (u *FolderUpsertOne) SetLegalHoldReason(v string) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetLegalHoldReason(v)
+	})
+}
+
+// UpdateLegalHoldReason sets the "legal_hold_reason" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateLegalHoldReason() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateLegalHoldReason()
+	})
+}
+
+// ClearLegalHoldReason clears the value of the "legal_hold_reason" field.
+func (u *FolderUpsertOne) ClearLegalHoldReason() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearLegalHoldReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *FolderUpsertOne) SetStatus(v folder.Status) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateStatus() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDeletedTime sets the "deleted_time" field.
+func (u *FolderUpsertOne) SetDeletedTime(v time.Time) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetDeletedTime(v)
+	})
+}
+
+// UpdateDeletedTime sets the "deleted_time" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateDeletedTime() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateDeletedTime()
+	})
+}
+
+// ClearDeletedTime clears the value of the "deleted_time" field.
+func (u *FolderUpsertOne) ClearDeletedTime() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearDeletedTime()
+	})
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (u *FolderUpsertOne) SetTrashedWithFolderID(v string) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetTrashedWithFolderID(v)
+	})
+}
+
+// UpdateTrashedWithFolderID sets the "trashed_with_folder_id" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateTrashedWithFolderID() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateTrashedWithFolderID()
+	})
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (u *FolderUpsertOne) ClearTrashedWithFolderID() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearTrashedWithFolderID()
+	})
+}
+
+// SetOriginalParentID sets the "original_parent_id" field.
+func (u *FolderUpsertOne) SetOriginalParentID(v string) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetOriginalParentID(v)
+	})
+}
+
+// UpdateOriginalParentID sets the "original_parent_id" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateOriginalParentID() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateOriginalParentID()
+	})
+}
+
+// ClearOriginalParentID clears the value of the "original_parent_id" field.
+func (u *FolderUpsertOne) ClearOriginalParentID() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearOriginalParentID()
+	})
+}
+
+// SetOriginalPath sets the "original_path" field.
+func (u *FolderUpsertOne) SetOriginalPath(v string) *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetOriginalPath(v)
+	})
+}
+
+// UpdateOriginalPath sets the "original_path" field to the value that was provided on create.
+func (u *FolderUpsertOne) UpdateOriginalPath() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateOriginalPath()
+	})
+}
+
+// ClearOriginalPath clears the value of the "original_path" field.
+func (u *FolderUpsertOne) ClearOriginalPath() *FolderUpsertOne {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearOriginalPath()
+	})
+}
+
+// Exec executes the query.
+func (u *FolderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FolderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FolderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FolderUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: FolderUpsertOne.ID is not supported by MySQL driver. Use FolderUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FolderUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FolderCreateBulk is the builder for creating many Folder entities in bulk.
+type FolderCreateBulk struct {
+	config
+	err      error
+	builders []*FolderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Folder entities in the database.
+func (_c *FolderCreateBulk) Save(ctx context.Context) ([]*Folder, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Folder, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FolderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FolderCreateBulk) SaveX(ctx context.Context) []*Folder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FolderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FolderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Folder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FolderUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FolderCreateBulk) OnConflict(opts ...sql.ConflictOption) *FolderUpsertBulk {
+	_c.conflict = opts
+	return &FolderUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Folder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FolderCreateBulk) OnConflictColumns(columns ...string) *FolderUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FolderUpsertBulk{
+		create: _c,
+	}
+}
+
+// FolderUpsertBulk is the builder for "upsert"-ing
+// a bulk of Folder nodes.
+type FolderUpsertBulk struct {
+	create *FolderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Folder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(folder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FolderUpsertBulk) UpdateNewValues() *FolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(folder.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(folder.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(folder.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Folder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FolderUpsertBulk) Ignore() *FolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FolderUpsertBulk) DoNothing() *FolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FolderCreateBulk.OnConflict
+// documentation for more info.
+func (u *FolderUpsertBulk) Update(set func(*FolderUpsert)) *FolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FolderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *FolderUpsertBulk) SetCreateBy(v uint32) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *FolderUpsertBulk) AddCreateBy(v uint32) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateCreateBy() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *FolderUpsertBulk) ClearCreateBy() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FolderUpsertBulk) SetUpdateTime(v time.Time) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateUpdateTime() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *FolderUpsertBulk) ClearUpdateTime() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *FolderUpsertBulk) SetDeleteTime(v time.Time) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateDeleteTime() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *FolderUpsertBulk) ClearDeleteTime() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *FolderUpsertBulk) SetParentID(v string) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateParentID() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *FolderUpsertBulk) ClearParentID() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *FolderUpsertBulk) SetName(v string) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateName() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *FolderUpsertBulk) SetPath(v string) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdatePath() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *FolderUpsertBulk) SetDescription(v string) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateDescription() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *FolderUpsertBulk) ClearDescription() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDepth sets the "depth" field.
+func (u *FolderUpsertBulk) SetDepth(v int32) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetDepth(v)
+	})
+}
+
+// AddDepth adds v to the "depth" field.
+func (u *FolderUpsertBulk) AddDepth(v int32) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.AddDepth(v)
+	})
+}
+
+// UpdateDepth sets the "depth" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateDepth() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateDepth()
+	})
+}
+
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (u *FolderUpsertBulk) SetLastAccessedTime(v time.Time) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetLastAccessedTime(v)
+	})
+}
+
+// UpdateLastAccessedTime sets the "last_accessed_time" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateLastAccessedTime() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateLastAccessedTime()
+	})
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (u *FolderUpsertBulk) ClearLastAccessedTime() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearLastAccessedTime()
+	})
+}
+
+// SetSecretCount sets the "secret_count" field.
+func (u *FolderUpsertBulk) SetSecretCount(v int32) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetSecretCount(v)
+	})
+}
+
+// AddSecretCount adds v to the "secret_count" field.
+func (u *FolderUpsertBulk) AddSecretCount(v int32) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.AddSecretCount(v)
+	})
+}
+
+// UpdateSecretCount sets the "secret_count" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateSecretCount() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateSecretCount()
+	})
+}
+
+// SetSubfolderCount sets the "subfolder_count" field.
+func (u *FolderUpsertBulk) SetSubfolderCount(v int32) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetSubfolderCount(v)
+	})
+}
+
+// AddSubfolderCount adds v to the "subfolder_count" field.
+func (u *FolderUpsertBulk) AddSubfolderCount(v int32) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.AddSubfolderCount(v)
+	})
+}
+
+// UpdateSubfolderCount sets the "subfolder_count" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateSubfolderCount() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateSubfolderCount()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *FolderUpsertBulk) SetRevision(v int64) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *FolderUpsertBulk) AddRevision(v int64) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateRevision() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// SetMetadataSchema sets the "metadata_schema" field.
+func (u *FolderUpsertBulk) SetMetadataSchema(v map[string]interface{}) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetMetadataSchema(v)
+	})
+}
+
+// UpdateMetadataSchema sets the "metadata_schema" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateMetadataSchema() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateMetadataSchema()
+	})
+}
+
+// ClearMetadataSchema clears the value of the "metadata_schema" field.
+func (u *FolderUpsertBulk) ClearMetadataSchema() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearMetadataSchema()
+	})
+}
+
+// SetDefaultPermissions sets the "default_permissions" field.
+func (u *FolderUpsertBulk) SetDefaultPermissions(v []schema.FolderDefaultPermission) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetDefaultPermissions(v)
+	})
+}
+
+// UpdateDefaultPermissions sets the "default_permissions" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateDefaultPermissions() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateDefaultPermissions()
+	})
+}
+
+// ClearDefaultPermissions clears the value of the "default_permissions" field.
+func (u *FolderUpsertBulk) ClearDefaultPermissions() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearDefaultPermissions()
+	})
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (u *FolderUpsertBulk) SetAccessPolicy(v *authz.AccessPolicy) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetAccessPolicy(v)
+	})
+}
+
+// UpdateAccessPolicy sets the "access_policy" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateAccessPolicy() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateAccessPolicy()
+	})
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (u *FolderUpsertBulk) ClearAccessPolicy() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearAccessPolicy()
+	})
+}
+
+// SetProtected sets the "protected" field.
+func (u *FolderUpsertBulk) SetProtected(v bool) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetProtected(v)
+	})
+}
+
+// UpdateProtected sets the "protected" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateProtected() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateProtected()
+	})
+}
+
+// SetLegalHold sets the "legal_hold" field.
+func (u *FolderUpsertBulk) SetLegalHold(v bool) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetLegalHold(v)
+	})
+}
+
+// UpdateLegalHold sets the "legal_hold" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateLegalHold() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateLegalHold()
+	})
+}
+
+// SetLegalHoldReason sets the "legal_hold_reason" field.
+func (u *FolderUpsertBulk) SetLegalHoldReason(v string) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetLegalHoldReason(v)
+	})
+}
+
+// UpdateLegalHoldReason sets the "legal_hold_reason" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateLegalHoldReason() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateLegalHoldReason()
+	})
+}
+
+// ClearLegalHoldReason clears the value of the "legal_hold_reason" field.
+func (u *FolderUpsertBulk) ClearLegalHoldReason() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearLegalHoldReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *FolderUpsertBulk) SetStatus(v folder.Status) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateStatus() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDeletedTime sets the "deleted_time" field.
+func (u *FolderUpsertBulk) SetDeletedTime(v time.Time) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetDeletedTime(v)
+	})
+}
+
+// UpdateDeletedTime sets the "deleted_time" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateDeletedTime() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateDeletedTime()
+	})
+}
+
+// ClearDeletedTime clears the value of the "deleted_time" field.
+func (u *FolderUpsertBulk) ClearDeletedTime() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearDeletedTime()
+	})
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (u *FolderUpsertBulk) SetTrashedWithFolderID(v string) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetTrashedWithFolderID(v)
+	})
+}
+
+// UpdateTrashedWithFolderID sets the "trashed_with_folder_id" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateTrashedWithFolderID() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateTrashedWithFolderID()
+	})
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (u *FolderUpsertBulk) ClearTrashedWithFolderID() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearTrashedWithFolderID()
+	})
+}
+
+// SetOriginalParentID sets the "original_parent_id" field.
+func (u *FolderUpsertBulk) SetOriginalParentID(v string) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetOriginalParentID(v)
+	})
+}
+
+// UpdateOriginalParentID sets the "original_parent_id" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateOriginalParentID() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateOriginalParentID()
+	})
+}
+
+// ClearOriginalParentID clears the value of the "original_parent_id" field.
+func (u *FolderUpsertBulk) ClearOriginalParentID() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearOriginalParentID()
+	})
+}
+
+// SetOriginalPath sets the "original_path" field.
+func (u *FolderUpsertBulk) SetOriginalPath(v string) *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.SetOriginalPath(v)
+	})
+}
+
+// UpdateOriginalPath sets the "original_path" field to the value that was provided on create.
+func (u *FolderUpsertBulk) UpdateOriginalPath() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.UpdateOriginalPath()
+	})
+}
+
+// ClearOriginalPath clears the value of the "original_path" field.
+func (u *FolderUpsertBulk) ClearOriginalPath() *FolderUpsertBulk {
+	return u.Update(func(s *FolderUpsert) {
+		s.ClearOriginalPath()
+	})
+}
+
+// Exec executes the query.
+func (u *FolderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FolderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FolderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FolderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
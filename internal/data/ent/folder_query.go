@@ -353,8 +353,9 @@ func (_q *FolderQuery) Clone() *FolderQuery {
 		withSecrets:     _q.withSecrets.Clone(),
 		withPermissions: _q.withPermissions.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -790,6 +791,12 @@ func (_q *FolderQuery) ForShare(opts ...sql.LockOption) *FolderQuery {
 	return _q
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *FolderQuery) Modify(modifiers ...func(s *sql.Selector)) *FolderSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // FolderGroupBy is the group-by builder for Folder entities.
 type FolderGroupBy struct {
 	selector
@@ -879,3 +886,9 @@ func (_s *FolderSelect) sqlScan(ctx context.Context, root *FolderQuery, v any) e
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *FolderSelect) Modify(modifiers ...func(s *sql.Selector)) *FolderSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
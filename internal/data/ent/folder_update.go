@@ -10,18 +10,22 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
 )
 
 // FolderUpdate is the builder for updating Folder entities.
 type FolderUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FolderMutation
+	hooks     []Hook
+	mutation  *FolderMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FolderUpdate builder.
@@ -186,6 +190,273 @@ func (_u *FolderUpdate) AddDepth(v int32) *FolderUpdate {
 	return _u
 }
 
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (_u *FolderUpdate) SetLastAccessedTime(v time.Time) *FolderUpdate {
+	_u.mutation.SetLastAccessedTime(v)
+	return _u
+}
+
+// SetNillableLastAccessedTime sets the "last_accessed_time" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableLastAccessedTime(v *time.Time) *FolderUpdate {
+	if v != nil {
+		_u.SetLastAccessedTime(*v)
+	}
+	return _u
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (_u *FolderUpdate) ClearLastAccessedTime() *FolderUpdate {
+	_u.mutation.ClearLastAccessedTime()
+	return _u
+}
+
+// SetSecretCount sets the "secret_count" field.
+func (_u *FolderUpdate) SetSecretCount(v int32) *FolderUpdate {
+	_u.mutation.ResetSecretCount()
+	_u.mutation.SetSecretCount(v)
+	return _u
+}
+
+// SetNillableSecretCount sets the "secret_count" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableSecretCount(v *int32) *FolderUpdate {
+	if v != nil {
+		_u.SetSecretCount(*v)
+	}
+	return _u
+}
+
+// AddSecretCount adds value to the "secret_count" field.
+func (_u *FolderUpdate) AddSecretCount(v int32) *FolderUpdate {
+	_u.mutation.AddSecretCount(v)
+	return _u
+}
+
+// SetSubfolderCount sets the "subfolder_count" field.
+func (_u *FolderUpdate) SetSubfolderCount(v int32) *FolderUpdate {
+	_u.mutation.ResetSubfolderCount()
+	_u.mutation.SetSubfolderCount(v)
+	return _u
+}
+
+// SetNillableSubfolderCount sets the "subfolder_count" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableSubfolderCount(v *int32) *FolderUpdate {
+	if v != nil {
+		_u.SetSubfolderCount(*v)
+	}
+	return _u
+}
+
+// AddSubfolderCount adds value to the "subfolder_count" field.
+func (_u *FolderUpdate) AddSubfolderCount(v int32) *FolderUpdate {
+	_u.mutation.AddSubfolderCount(v)
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *FolderUpdate) SetRevision(v int64) *FolderUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableRevision(v *int64) *FolderUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *FolderUpdate) AddRevision(v int64) *FolderUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetMetadataSchema sets the "metadata_schema" field.
+func (_u *FolderUpdate) SetMetadataSchema(v map[string]interface{}) *FolderUpdate {
+	_u.mutation.SetMetadataSchema(v)
+	return _u
+}
+
+// ClearMetadataSchema clears the value of the "metadata_schema" field.
+func (_u *FolderUpdate) ClearMetadataSchema() *FolderUpdate {
+	_u.mutation.ClearMetadataSchema()
+	return _u
+}
+
+// SetDefaultPermissions sets the "default_permissions" field.
+func (_u *FolderUpdate) SetDefaultPermissions(v []schema.FolderDefaultPermission) *FolderUpdate {
+	_u.mutation.SetDefaultPermissions(v)
+	return _u
+}
+
+// AppendDefaultPermissions appends value to the "default_permissions" field.
+func (_u *FolderUpdate) AppendDefaultPermissions(v []schema.FolderDefaultPermission) *FolderUpdate {
+	_u.mutation.AppendDefaultPermissions(v)
+	return _u
+}
+
+// ClearDefaultPermissions clears the value of the "default_permissions" field.
+func (_u *FolderUpdate) ClearDefaultPermissions() *FolderUpdate {
+	_u.mutation.ClearDefaultPermissions()
+	return _u
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (_u *FolderUpdate) SetAccessPolicy(v *authz.AccessPolicy) *FolderUpdate {
+	_u.mutation.SetAccessPolicy(v)
+	return _u
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (_u *FolderUpdate) ClearAccessPolicy() *FolderUpdate {
+	_u.mutation.ClearAccessPolicy()
+	return _u
+}
+
+// SetProtected sets the "protected" field.
+func (_u *FolderUpdate) SetProtected(v bool) *FolderUpdate {
+	_u.mutation.SetProtected(v)
+	return _u
+}
+
+// SetNillableProtected sets the "protected" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableProtected(v *bool) *FolderUpdate {
+	if v != nil {
+		_u.SetProtected(*v)
+	}
+	return _u
+}
+
+// SetLegalHold sets the "legal_hold" field.
+func (_u *FolderUpdate) SetLegalHold(v bool) *FolderUpdate {
+	_u.mutation.SetLegalHold(v)
+	return _u
+}
+
+// SetNillableLegalHold sets the "legal_hold" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableLegalHold(v *bool) *FolderUpdate {
+	if v != nil {
+		_u.SetLegalHold(*v)
+	}
+	return _u
+}
+
+// SetLegalHoldReason sets the "legal_hold_reason" field.
+func (_u *FolderUpdate) SetLegalHoldReason(v string) *FolderUpdate {
+	_u.mutation.SetLegalHoldReason(v)
+	return _u
+}
+
+// SetNillableLegalHoldReason sets the "legal_hold_reason" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableLegalHoldReason(v *string) *FolderUpdate {
+	if v != nil {
+		_u.SetLegalHoldReason(*v)
+	}
+	return _u
+}
+
+// ClearLegalHoldReason clears the value of the "legal_hold_reason" field.
+func (_u *FolderUpdate) ClearLegalHoldReason() *FolderUpdate {
+	_u.mutation.ClearLegalHoldReason()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *FolderUpdate) SetStatus(v folder.Status) *FolderUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableStatus(v *folder.Status) *FolderUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDeletedTime sets the "deleted_time" field.
+func (_u *FolderUpdate) SetDeletedTime(v time.Time) *FolderUpdate {
+	_u.mutation.SetDeletedTime(v)
+	return _u
+}
+
+// SetNillableDeletedTime sets the "deleted_time" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableDeletedTime(v *time.Time) *FolderUpdate {
+	if v != nil {
+		_u.SetDeletedTime(*v)
+	}
+	return _u
+}
+
+// ClearDeletedTime clears the value of the "deleted_time" field.
+func (_u *FolderUpdate) ClearDeletedTime() *FolderUpdate {
+	_u.mutation.ClearDeletedTime()
+	return _u
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (_u *FolderUpdate) SetTrashedWithFolderID(v string) *FolderUpdate {
+	_u.mutation.SetTrashedWithFolderID(v)
+	return _u
+}
+
+// SetNillableTrashedWithFolderID sets the "trashed_with_folder_id" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableTrashedWithFolderID(v *string) *FolderUpdate {
+	if v != nil {
+		_u.SetTrashedWithFolderID(*v)
+	}
+	return _u
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (_u *FolderUpdate) ClearTrashedWithFolderID() *FolderUpdate {
+	_u.mutation.ClearTrashedWithFolderID()
+	return _u
+}
+
+// SetOriginalParentID sets the "original_parent_id" field.
+func (_u *FolderUpdate) SetOriginalParentID(v string) *FolderUpdate {
+	_u.mutation.SetOriginalParentID(v)
+	return _u
+}
+
+// SetNillableOriginalParentID sets the "original_parent_id" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableOriginalParentID(v *string) *FolderUpdate {
+	if v != nil {
+		_u.SetOriginalParentID(*v)
+	}
+	return _u
+}
+
+// ClearOriginalParentID clears the value of the "original_parent_id" field.
+func (_u *FolderUpdate) ClearOriginalParentID() *FolderUpdate {
+	_u.mutation.ClearOriginalParentID()
+	return _u
+}
+
+// SetOriginalPath sets the "original_path" field.
+func (_u *FolderUpdate) SetOriginalPath(v string) *FolderUpdate {
+	_u.mutation.SetOriginalPath(v)
+	return _u
+}
+
+// SetNillableOriginalPath sets the "original_path" field if the given value is not nil.
+func (_u *FolderUpdate) SetNillableOriginalPath(v *string) *FolderUpdate {
+	if v != nil {
+		_u.SetOriginalPath(*v)
+	}
+	return _u
+}
+
+// ClearOriginalPath clears the value of the "original_path" field.
+func (_u *FolderUpdate) ClearOriginalPath() *FolderUpdate {
+	_u.mutation.ClearOriginalPath()
+	return _u
+}
+
 // SetParent sets the "parent" edge to the Folder entity.
 func (_u *FolderUpdate) SetParent(v *Folder) *FolderUpdate {
 	return _u.SetParentID(v.ID)
@@ -354,9 +625,35 @@ func (_u *FolderUpdate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Folder.description": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AccessPolicy(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "access_policy", err: fmt.Errorf(`ent: validator failed for field "Folder.access_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LegalHoldReason(); ok {
+		if err := folder.LegalHoldReasonValidator(v); err != nil {
+			return &ValidationError{Name: "legal_hold_reason", err: fmt.Errorf(`ent: validator failed for field "Folder.legal_hold_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := folder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Folder.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OriginalPath(); ok {
+		if err := folder.OriginalPathValidator(v); err != nil {
+			return &ValidationError{Name: "original_path", err: fmt.Errorf(`ent: validator failed for field "Folder.original_path": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FolderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FolderUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FolderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -414,6 +711,92 @@ func (_u *FolderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedDepth(); ok {
 		_spec.AddField(folder.FieldDepth, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.LastAccessedTime(); ok {
+		_spec.SetField(folder.FieldLastAccessedTime, field.TypeTime, value)
+	}
+	if _u.mutation.LastAccessedTimeCleared() {
+		_spec.ClearField(folder.FieldLastAccessedTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SecretCount(); ok {
+		_spec.SetField(folder.FieldSecretCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedSecretCount(); ok {
+		_spec.AddField(folder.FieldSecretCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.SubfolderCount(); ok {
+		_spec.SetField(folder.FieldSubfolderCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedSubfolderCount(); ok {
+		_spec.AddField(folder.FieldSubfolderCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(folder.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(folder.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MetadataSchema(); ok {
+		_spec.SetField(folder.FieldMetadataSchema, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataSchemaCleared() {
+		_spec.ClearField(folder.FieldMetadataSchema, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultPermissions(); ok {
+		_spec.SetField(folder.FieldDefaultPermissions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultPermissions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, folder.FieldDefaultPermissions, value)
+		})
+	}
+	if _u.mutation.DefaultPermissionsCleared() {
+		_spec.ClearField(folder.FieldDefaultPermissions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccessPolicy(); ok {
+		_spec.SetField(folder.FieldAccessPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessPolicyCleared() {
+		_spec.ClearField(folder.FieldAccessPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Protected(); ok {
+		_spec.SetField(folder.FieldProtected, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LegalHold(); ok {
+		_spec.SetField(folder.FieldLegalHold, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LegalHoldReason(); ok {
+		_spec.SetField(folder.FieldLegalHoldReason, field.TypeString, value)
+	}
+	if _u.mutation.LegalHoldReasonCleared() {
+		_spec.ClearField(folder.FieldLegalHoldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(folder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DeletedTime(); ok {
+		_spec.SetField(folder.FieldDeletedTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedTimeCleared() {
+		_spec.ClearField(folder.FieldDeletedTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TrashedWithFolderID(); ok {
+		_spec.SetField(folder.FieldTrashedWithFolderID, field.TypeString, value)
+	}
+	if _u.mutation.TrashedWithFolderIDCleared() {
+		_spec.ClearField(folder.FieldTrashedWithFolderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OriginalParentID(); ok {
+		_spec.SetField(folder.FieldOriginalParentID, field.TypeString, value)
+	}
+	if _u.mutation.OriginalParentIDCleared() {
+		_spec.ClearField(folder.FieldOriginalParentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OriginalPath(); ok {
+		_spec.SetField(folder.FieldOriginalPath, field.TypeString, value)
+	}
+	if _u.mutation.OriginalPathCleared() {
+		_spec.ClearField(folder.FieldOriginalPath, field.TypeString)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -578,6 +961,7 @@ func (_u *FolderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{folder.Label}
@@ -593,9 +977,10 @@ func (_u *FolderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // FolderUpdateOne is the builder for updating a single Folder entity.
 type FolderUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FolderMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FolderMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCreateBy sets the "create_by" field.
@@ -754,6 +1139,273 @@ func (_u *FolderUpdateOne) AddDepth(v int32) *FolderUpdateOne {
 	return _u
 }
 
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (_u *FolderUpdateOne) SetLastAccessedTime(v time.Time) *FolderUpdateOne {
+	_u.mutation.SetLastAccessedTime(v)
+	return _u
+}
+
+// SetNillableLastAccessedTime sets the "last_accessed_time" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableLastAccessedTime(v *time.Time) *FolderUpdateOne {
+	if v != nil {
+		_u.SetLastAccessedTime(*v)
+	}
+	return _u
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (_u *FolderUpdateOne) ClearLastAccessedTime() *FolderUpdateOne {
+	_u.mutation.ClearLastAccessedTime()
+	return _u
+}
+
+// SetSecretCount sets the "secret_count" field.
+func (_u *FolderUpdateOne) SetSecretCount(v int32) *FolderUpdateOne {
+	_u.mutation.ResetSecretCount()
+	_u.mutation.SetSecretCount(v)
+	return _u
+}
+
+// SetNillableSecretCount sets the "secret_count" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableSecretCount(v *int32) *FolderUpdateOne {
+	if v != nil {
+		_u.SetSecretCount(*v)
+	}
+	return _u
+}
+
+// AddSecretCount adds value to the "secret_count" field.
+func (_u *FolderUpdateOne) AddSecretCount(v int32) *FolderUpdateOne {
+	_u.mutation.AddSecretCount(v)
+	return _u
+}
+
+// SetSubfolderCount sets the "subfolder_count" field.
+func (_u *FolderUpdateOne) SetSubfolderCount(v int32) *FolderUpdateOne {
+	_u.mutation.ResetSubfolderCount()
+	_u.mutation.SetSubfolderCount(v)
+	return _u
+}
+
+// SetNillableSubfolderCount sets the "subfolder_count" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableSubfolderCount(v *int32) *FolderUpdateOne {
+	if v != nil {
+		_u.SetSubfolderCount(*v)
+	}
+	return _u
+}
+
+// AddSubfolderCount adds value to the "subfolder_count" field.
+func (_u *FolderUpdateOne) AddSubfolderCount(v int32) *FolderUpdateOne {
+	_u.mutation.AddSubfolderCount(v)
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *FolderUpdateOne) SetRevision(v int64) *FolderUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableRevision(v *int64) *FolderUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *FolderUpdateOne) AddRevision(v int64) *FolderUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetMetadataSchema sets the "metadata_schema" field.
+func (_u *FolderUpdateOne) SetMetadataSchema(v map[string]interface{}) *FolderUpdateOne {
+	_u.mutation.SetMetadataSchema(v)
+	return _u
+}
+
+// ClearMetadataSchema clears the value of the "metadata_schema" field.
+func (_u *FolderUpdateOne) ClearMetadataSchema() *FolderUpdateOne {
+	_u.mutation.ClearMetadataSchema()
+	return _u
+}
+
+// SetDefaultPermissions sets the "default_permissions" field.
+func (_u *FolderUpdateOne) SetDefaultPermissions(v []schema.FolderDefaultPermission) *FolderUpdateOne {
+	_u.mutation.SetDefaultPermissions(v)
+	return _u
+}
+
+// AppendDefaultPermissions appends value to the "default_permissions" field.
+func (_u *FolderUpdateOne) AppendDefaultPermissions(v []schema.FolderDefaultPermission) *FolderUpdateOne {
+	_u.mutation.AppendDefaultPermissions(v)
+	return _u
+}
+
+// ClearDefaultPermissions clears the value of the "default_permissions" field.
+func (_u *FolderUpdateOne) ClearDefaultPermissions() *FolderUpdateOne {
+	_u.mutation.ClearDefaultPermissions()
+	return _u
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (_u *FolderUpdateOne) SetAccessPolicy(v *authz.AccessPolicy) *FolderUpdateOne {
+	_u.mutation.SetAccessPolicy(v)
+	return _u
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (_u *FolderUpdateOne) ClearAccessPolicy() *FolderUpdateOne {
+	_u.mutation.ClearAccessPolicy()
+	return _u
+}
+
+// SetProtected sets the "protected" field.
+func (_u *FolderUpdateOne) SetProtected(v bool) *FolderUpdateOne {
+	_u.mutation.SetProtected(v)
+	return _u
+}
+
+// SetNillableProtected sets the "protected" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableProtected(v *bool) *FolderUpdateOne {
+	if v != nil {
+		_u.SetProtected(*v)
+	}
+	return _u
+}
+
+// SetLegalHold sets the "legal_hold" field.
+func (_u *FolderUpdateOne) SetLegalHold(v bool) *FolderUpdateOne {
+	_u.mutation.SetLegalHold(v)
+	return _u
+}
+
+// SetNillableLegalHold sets the "legal_hold" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableLegalHold(v *bool) *FolderUpdateOne {
+	if v != nil {
+		_u.SetLegalHold(*v)
+	}
+	return _u
+}
+
+// SetLegalHoldReason sets the "legal_hold_reason" field.
+func (_u *FolderUpdateOne) SetLegalHoldReason(v string) *FolderUpdateOne {
+	_u.mutation.SetLegalHoldReason(v)
+	return _u
+}
+
+// SetNillableLegalHoldReason sets the "legal_hold_reason" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableLegalHoldReason(v *string) *FolderUpdateOne {
+	if v != nil {
+		_u.SetLegalHoldReason(*v)
+	}
+	return _u
+}
+
+// ClearLegalHoldReason clears the value of the "legal_hold_reason" field.
+func (_u *FolderUpdateOne) ClearLegalHoldReason() *FolderUpdateOne {
+	_u.mutation.ClearLegalHoldReason()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *FolderUpdateOne) SetStatus(v folder.Status) *FolderUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableStatus(v *folder.Status) *FolderUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDeletedTime sets the "deleted_time" field.
+func (_u *FolderUpdateOne) SetDeletedTime(v time.Time) *FolderUpdateOne {
+	_u.mutation.SetDeletedTime(v)
+	return _u
+}
+
+// SetNillableDeletedTime sets the "deleted_time" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableDeletedTime(v *time.Time) *FolderUpdateOne {
+	if v != nil {
+		_u.SetDeletedTime(*v)
+	}
+	return _u
+}
+
+// ClearDeletedTime clears the value of the "deleted_time" field.
+func (_u *FolderUpdateOne) ClearDeletedTime() *FolderUpdateOne {
+	_u.mutation.ClearDeletedTime()
+	return _u
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (_u *FolderUpdateOne) SetTrashedWithFolderID(v string) *FolderUpdateOne {
+	_u.mutation.SetTrashedWithFolderID(v)
+	return _u
+}
+
+// SetNillableTrashedWithFolderID sets the "trashed_with_folder_id" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableTrashedWithFolderID(v *string) *FolderUpdateOne {
+	if v != nil {
+		_u.SetTrashedWithFolderID(*v)
+	}
+	return _u
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (_u *FolderUpdateOne) ClearTrashedWithFolderID() *FolderUpdateOne {
+	_u.mutation.ClearTrashedWithFolderID()
+	return _u
+}
+
+// SetOriginalParentID sets the "original_parent_id" field.
+func (_u *FolderUpdateOne) SetOriginalParentID(v string) *FolderUpdateOne {
+	_u.mutation.SetOriginalParentID(v)
+	return _u
+}
+
+// SetNillableOriginalParentID sets the "original_parent_id" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableOriginalParentID(v *string) *FolderUpdateOne {
+	if v != nil {
+		_u.SetOriginalParentID(*v)
+	}
+	return _u
+}
+
+// ClearOriginalParentID clears the value of the "original_parent_id" field.
+func (_u *FolderUpdateOne) ClearOriginalParentID() *FolderUpdateOne {
+	_u.mutation.ClearOriginalParentID()
+	return _u
+}
+
+// SetOriginalPath sets the "original_path" field.
+func (_u *FolderUpdateOne) SetOriginalPath(v string) *FolderUpdateOne {
+	_u.mutation.SetOriginalPath(v)
+	return _u
+}
+
+// SetNillableOriginalPath sets the "original_path" field if the given value is not nil.
+func (_u *FolderUpdateOne) SetNillableOriginalPath(v *string) *FolderUpdateOne {
+	if v != nil {
+		_u.SetOriginalPath(*v)
+	}
+	return _u
+}
+
+// ClearOriginalPath clears the value of the "original_path" field.
+func (_u *FolderUpdateOne) ClearOriginalPath() *FolderUpdateOne {
+	_u.mutation.ClearOriginalPath()
+	return _u
+}
+
 // SetParent sets the "parent" edge to the Folder entity.
 func (_u *FolderUpdateOne) SetParent(v *Folder) *FolderUpdateOne {
 	return _u.SetParentID(v.ID)
@@ -935,9 +1587,35 @@ func (_u *FolderUpdateOne) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Folder.description": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AccessPolicy(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "access_policy", err: fmt.Errorf(`ent: validator failed for field "Folder.access_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LegalHoldReason(); ok {
+		if err := folder.LegalHoldReasonValidator(v); err != nil {
+			return &ValidationError{Name: "legal_hold_reason", err: fmt.Errorf(`ent: validator failed for field "Folder.legal_hold_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := folder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Folder.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OriginalPath(); ok {
+		if err := folder.OriginalPathValidator(v); err != nil {
+			return &ValidationError{Name: "original_path", err: fmt.Errorf(`ent: validator failed for field "Folder.original_path": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FolderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FolderUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FolderUpdateOne) sqlSave(ctx context.Context) (_node *Folder, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1012,6 +1690,92 @@ func (_u *FolderUpdateOne) sqlSave(ctx context.Context) (_node *Folder, err erro
 	if value, ok := _u.mutation.AddedDepth(); ok {
 		_spec.AddField(folder.FieldDepth, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.LastAccessedTime(); ok {
+		_spec.SetField(folder.FieldLastAccessedTime, field.TypeTime, value)
+	}
+	if _u.mutation.LastAccessedTimeCleared() {
+		_spec.ClearField(folder.FieldLastAccessedTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SecretCount(); ok {
+		_spec.SetField(folder.FieldSecretCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedSecretCount(); ok {
+		_spec.AddField(folder.FieldSecretCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.SubfolderCount(); ok {
+		_spec.SetField(folder.FieldSubfolderCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedSubfolderCount(); ok {
+		_spec.AddField(folder.FieldSubfolderCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(folder.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(folder.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MetadataSchema(); ok {
+		_spec.SetField(folder.FieldMetadataSchema, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataSchemaCleared() {
+		_spec.ClearField(folder.FieldMetadataSchema, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultPermissions(); ok {
+		_spec.SetField(folder.FieldDefaultPermissions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultPermissions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, folder.FieldDefaultPermissions, value)
+		})
+	}
+	if _u.mutation.DefaultPermissionsCleared() {
+		_spec.ClearField(folder.FieldDefaultPermissions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccessPolicy(); ok {
+		_spec.SetField(folder.FieldAccessPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessPolicyCleared() {
+		_spec.ClearField(folder.FieldAccessPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Protected(); ok {
+		_spec.SetField(folder.FieldProtected, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LegalHold(); ok {
+		_spec.SetField(folder.FieldLegalHold, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LegalHoldReason(); ok {
+		_spec.SetField(folder.FieldLegalHoldReason, field.TypeString, value)
+	}
+	if _u.mutation.LegalHoldReasonCleared() {
+		_spec.ClearField(folder.FieldLegalHoldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(folder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DeletedTime(); ok {
+		_spec.SetField(folder.FieldDeletedTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedTimeCleared() {
+		_spec.ClearField(folder.FieldDeletedTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TrashedWithFolderID(); ok {
+		_spec.SetField(folder.FieldTrashedWithFolderID, field.TypeString, value)
+	}
+	if _u.mutation.TrashedWithFolderIDCleared() {
+		_spec.ClearField(folder.FieldTrashedWithFolderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OriginalParentID(); ok {
+		_spec.SetField(folder.FieldOriginalParentID, field.TypeString, value)
+	}
+	if _u.mutation.OriginalParentIDCleared() {
+		_spec.ClearField(folder.FieldOriginalParentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OriginalPath(); ok {
+		_spec.SetField(folder.FieldOriginalPath, field.TypeString, value)
+	}
+	if _u.mutation.OriginalPathCleared() {
+		_spec.ClearField(folder.FieldOriginalPath, field.TypeString)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1176,6 +1940,7 @@ func (_u *FolderUpdateOne) sqlSave(ctx context.Context) (_node *Folder, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Folder{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
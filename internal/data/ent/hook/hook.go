@@ -9,6 +9,18 @@ import (
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
 )
 
+// The AuditChainHeadFunc type is an adapter to allow the use of ordinary
+// function as AuditChainHead mutator.
+type AuditChainHeadFunc func(context.Context, *ent.AuditChainHeadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditChainHeadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditChainHeadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditChainHeadMutation", m)
+}
+
 // The AuditLogFunc type is an adapter to allow the use of ordinary
 // function as AuditLog mutator.
 type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
@@ -21,6 +33,54 @@ func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
 }
 
+// The CollectionFunc type is an adapter to allow the use of ordinary
+// function as Collection mutator.
+type CollectionFunc func(context.Context, *ent.CollectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CollectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CollectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollectionMutation", m)
+}
+
+// The DeletionRequestFunc type is an adapter to allow the use of ordinary
+// function as DeletionRequest mutator.
+type DeletionRequestFunc func(context.Context, *ent.DeletionRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeletionRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeletionRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeletionRequestMutation", m)
+}
+
+// The EmergencyAccessFunc type is an adapter to allow the use of ordinary
+// function as EmergencyAccess mutator.
+type EmergencyAccessFunc func(context.Context, *ent.EmergencyAccessMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmergencyAccessFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmergencyAccessMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmergencyAccessMutation", m)
+}
+
+// The FieldKeyFunc type is an adapter to allow the use of ordinary
+// function as FieldKey mutator.
+type FieldKeyFunc func(context.Context, *ent.FieldKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FieldKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FieldKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FieldKeyMutation", m)
+}
+
 // The FolderFunc type is an adapter to allow the use of ordinary
 // function as Folder mutator.
 type FolderFunc func(context.Context, *ent.FolderMutation) (ent.Value, error)
@@ -33,6 +93,30 @@ func (f FolderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FolderMutation", m)
 }
 
+// The FolderChangeLogFunc type is an adapter to allow the use of ordinary
+// function as FolderChangeLog mutator.
+type FolderChangeLogFunc func(context.Context, *ent.FolderChangeLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FolderChangeLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FolderChangeLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FolderChangeLogMutation", m)
+}
+
+// The PendingOperationFunc type is an adapter to allow the use of ordinary
+// function as PendingOperation mutator.
+type PendingOperationFunc func(context.Context, *ent.PendingOperationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PendingOperationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PendingOperationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PendingOperationMutation", m)
+}
+
 // The PermissionFunc type is an adapter to allow the use of ordinary
 // function as Permission mutator.
 type PermissionFunc func(context.Context, *ent.PermissionMutation) (ent.Value, error)
@@ -57,6 +141,18 @@ func (f SecretFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SecretMutation", m)
 }
 
+// The SecretUsageFunc type is an adapter to allow the use of ordinary
+// function as SecretUsage mutator.
+type SecretUsageFunc func(context.Context, *ent.SecretUsageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SecretUsageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SecretUsageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SecretUsageMutation", m)
+}
+
 // The SecretVersionFunc type is an adapter to allow the use of ordinary
 // function as SecretVersion mutator.
 type SecretVersionFunc func(context.Context, *ent.SecretVersionMutation) (ent.Value, error)
@@ -69,6 +165,66 @@ func (f SecretVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SecretVersionMutation", m)
 }
 
+// The SecurityAlertFunc type is an adapter to allow the use of ordinary
+// function as SecurityAlert mutator.
+type SecurityAlertFunc func(context.Context, *ent.SecurityAlertMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SecurityAlertFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SecurityAlertMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SecurityAlertMutation", m)
+}
+
+// The TenantSettingFunc type is an adapter to allow the use of ordinary
+// function as TenantSetting mutator.
+type TenantSettingFunc func(context.Context, *ent.TenantSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TenantSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TenantSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantSettingMutation", m)
+}
+
+// The VersionPinFunc type is an adapter to allow the use of ordinary
+// function as VersionPin mutator.
+type VersionPinFunc func(context.Context, *ent.VersionPinMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VersionPinFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VersionPinMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VersionPinMutation", m)
+}
+
+// The WebhookFunc type is an adapter to allow the use of ordinary
+// function as Webhook mutator.
+type WebhookFunc func(context.Context, *ent.WebhookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDeliveryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
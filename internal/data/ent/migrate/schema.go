@@ -9,6 +9,22 @@ import (
 )
 
 var (
+	// WardenAuditChainHeadsColumns holds the columns for the "warden_audit_chain_heads" table.
+	WardenAuditChainHeadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Unique: true, Comment: "Tenant of the chain (0 = entries without a tenant)"},
+		{Name: "seq", Type: field.TypeInt64, Comment: "Chain sequence of the last entry", Default: 0},
+		{Name: "hash", Type: field.TypeString, Nullable: true, Comment: "Chain hash of the last entry"},
+	}
+	// WardenAuditChainHeadsTable holds the schema information for the "warden_audit_chain_heads" table.
+	WardenAuditChainHeadsTable = &schema.Table{
+		Name:       "warden_audit_chain_heads",
+		Columns:    WardenAuditChainHeadsColumns,
+		PrimaryKey: []*schema.Column{WardenAuditChainHeadsColumns[0]},
+	}
 	// WardenAuditLogsColumns holds the columns for the "warden_audit_logs" table.
 	WardenAuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -34,6 +50,13 @@ var (
 		{Name: "log_hash", Type: field.TypeString, Nullable: true, Comment: "SHA-256 hash of the log content"},
 		{Name: "signature", Type: field.TypeBytes, Nullable: true, Comment: "ECDSA signature for integrity verification"},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, Comment: "Additional metadata"},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true, Comment: "User ID of the caller"},
+		{Name: "event_type", Type: field.TypeString, Nullable: true, Comment: "Semantic domain event (e.g. secret.password_read)"},
+		{Name: "resource_type", Type: field.TypeString, Nullable: true, Comment: "Type of the resource the event refers to"},
+		{Name: "resource_id", Type: field.TypeString, Nullable: true, Comment: "ID of the resource the event refers to"},
+		{Name: "chain_seq", Type: field.TypeInt64, Comment: "Per-tenant position in the hash chain (0 = not chained)", Default: 0},
+		{Name: "prev_hash", Type: field.TypeString, Nullable: true, Comment: "Chain hash of the previous entry of the same tenant"},
+		{Name: "chain_hash", Type: field.TypeString, Nullable: true, Comment: "SHA-256 over the previous chain hash and this entry's content"},
 	}
 	// WardenAuditLogsTable holds the schema information for the "warden_audit_logs" table.
 	WardenAuditLogsTable = &schema.Table{
@@ -81,8 +104,152 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{WardenAuditLogsColumns[18]},
 			},
+			{
+				Name:    "warden_auditlog_tenant_chain_seq",
+				Unique:  false,
+				Columns: []*schema.Column{WardenAuditLogsColumns[4], WardenAuditLogsColumns[27]},
+			},
+			{
+				Name:    "warden_auditlog_tenant_event_type",
+				Unique:  false,
+				Columns: []*schema.Column{WardenAuditLogsColumns[4], WardenAuditLogsColumns[24]},
+			},
+			{
+				Name:    "warden_auditlog_tenant_actor_event",
+				Unique:  false,
+				Columns: []*schema.Column{WardenAuditLogsColumns[4], WardenAuditLogsColumns[23], WardenAuditLogsColumns[24]},
+			},
+			{
+				Name:    "warden_auditlog_tenant_resource_event",
+				Unique:  false,
+				Columns: []*schema.Column{WardenAuditLogsColumns[4], WardenAuditLogsColumns[26], WardenAuditLogsColumns[24]},
+			},
+		},
+	}
+	// WardenCollectionsColumns holds the columns for the "warden_collections" table.
+	WardenCollectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Collection name"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Optional description"},
+		{Name: "external_id", Type: field.TypeString, Nullable: true, Size: 255, Comment: "ID of the collection in the system it was imported from"},
+	}
+	// WardenCollectionsTable holds the schema information for the "warden_collections" table.
+	WardenCollectionsTable = &schema.Table{
+		Name:       "warden_collections",
+		Columns:    WardenCollectionsColumns,
+		PrimaryKey: []*schema.Column{WardenCollectionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "collection_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{WardenCollectionsColumns[5], WardenCollectionsColumns[6]},
+			},
+			{
+				Name:    "collection_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{WardenCollectionsColumns[5]},
+			},
+			{
+				Name:    "collection_tenant_id_external_id",
+				Unique:  false,
+				Columns: []*schema.Column{WardenCollectionsColumns[5], WardenCollectionsColumns[8]},
+			},
+		},
+	}
+	// WardenDeletionRequestsColumns holds the columns for the "warden_deletion_requests" table.
+	WardenDeletionRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "resource_type", Type: field.TypeEnum, Comment: "Kind of resource to delete", Enums: []string{"DELETION_RESOURCE_TYPE_SECRET", "DELETION_RESOURCE_TYPE_FOLDER"}},
+		{Name: "resource_id", Type: field.TypeString, Size: 36, Comment: "Secret or folder to delete"},
+		{Name: "resource_name", Type: field.TypeString, Nullable: true, Size: 4096, Comment: "Secret name or folder path when the request was made"},
+		{Name: "force", Type: field.TypeBool, Comment: "Folder deletions: delete the folder with everything in it", Default: false},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the resource should be deleted"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Pending, or how the request was decided", Enums: []string{"DELETION_REQUEST_STATUS_PENDING", "DELETION_REQUEST_STATUS_APPROVED", "DELETION_REQUEST_STATUS_REJECTED", "DELETION_REQUEST_STATUS_WITHDRAWN", "DELETION_REQUEST_STATUS_EXPIRED"}, Default: "DELETION_REQUEST_STATUS_PENDING"},
+		{Name: "requested_by", Type: field.TypeString, Size: 36, Comment: "Owner who requested the deletion"},
+		{Name: "decided_by", Type: field.TypeString, Nullable: true, Size: 36, Comment: "Owner who approved or rejected the request"},
+		{Name: "decided_at", Type: field.TypeTime, Nullable: true, Comment: "When the request was approved, rejected or withdrawn"},
+		{Name: "expires_at", Type: field.TypeTime, Comment: "When a pending request can no longer be approved"},
+	}
+	// WardenDeletionRequestsTable holds the schema information for the "warden_deletion_requests" table.
+	WardenDeletionRequestsTable = &schema.Table{
+		Name:       "warden_deletion_requests",
+		Columns:    WardenDeletionRequestsColumns,
+		PrimaryKey: []*schema.Column{WardenDeletionRequestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warden_deletion_requests_resource",
+				Unique:  false,
+				Columns: []*schema.Column{WardenDeletionRequestsColumns[4], WardenDeletionRequestsColumns[6], WardenDeletionRequestsColumns[10]},
+			},
+			{
+				Name:    "warden_deletion_requests_tenant_status",
+				Unique:  false,
+				Columns: []*schema.Column{WardenDeletionRequestsColumns[4], WardenDeletionRequestsColumns[10]},
+			},
+		},
+	}
+	// WardenEmergencyAccessColumns holds the columns for the "warden_emergency_access" table.
+	WardenEmergencyAccessColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "grantor_id", Type: field.TypeString, Size: 36, Comment: "User whose folder can be accessed"},
+		{Name: "grantee_id", Type: field.TypeString, Size: 36, Comment: "Trusted user who can request access"},
+		{Name: "folder_id", Type: field.TypeString, Size: 36, Comment: "Folder the grantee receives VIEWER on"},
+		{Name: "wait_days", Type: field.TypeInt32, Comment: "Days a request waits for rejection before access is granted"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Idle, requested (waiting period running) or granted", Enums: []string{"EMERGENCY_ACCESS_STATUS_UNSPECIFIED", "EMERGENCY_ACCESS_STATUS_IDLE", "EMERGENCY_ACCESS_STATUS_REQUESTED", "EMERGENCY_ACCESS_STATUS_GRANTED"}, Default: "EMERGENCY_ACCESS_STATUS_IDLE"},
+		{Name: "requested_at", Type: field.TypeTime, Nullable: true, Comment: "When the pending request was made"},
+		{Name: "granted_at", Type: field.TypeTime, Nullable: true, Comment: "When access was granted"},
+	}
+	// WardenEmergencyAccessTable holds the schema information for the "warden_emergency_access" table.
+	WardenEmergencyAccessTable = &schema.Table{
+		Name:       "warden_emergency_access",
+		Columns:    WardenEmergencyAccessColumns,
+		PrimaryKey: []*schema.Column{WardenEmergencyAccessColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warden_emergency_access_pair",
+				Unique:  true,
+				Columns: []*schema.Column{WardenEmergencyAccessColumns[4], WardenEmergencyAccessColumns[5], WardenEmergencyAccessColumns[6]},
+			},
+			{
+				Name:    "warden_emergency_access_grantee",
+				Unique:  false,
+				Columns: []*schema.Column{WardenEmergencyAccessColumns[4], WardenEmergencyAccessColumns[6]},
+			},
+			{
+				Name:    "warden_emergency_access_due",
+				Unique:  false,
+				Columns: []*schema.Column{WardenEmergencyAccessColumns[9], WardenEmergencyAccessColumns[10]},
+			},
 		},
 	}
+	// WardenFieldKeysColumns holds the columns for the "warden_field_keys" table.
+	WardenFieldKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "transit_key", Type: field.TypeString, Size: 255, Comment: "Name of the Vault Transit key wrapping the data key"},
+		{Name: "wrapped_key", Type: field.TypeString, Comment: "Data key encrypted by the Transit key"},
+	}
+	// WardenFieldKeysTable holds the schema information for the "warden_field_keys" table.
+	WardenFieldKeysTable = &schema.Table{
+		Name:       "warden_field_keys",
+		Columns:    WardenFieldKeysColumns,
+		PrimaryKey: []*schema.Column{WardenFieldKeysColumns[0]},
+	}
 	// WardenFoldersColumns holds the columns for the "warden_folders" table.
 	WardenFoldersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
@@ -95,6 +262,21 @@ var (
 		{Name: "path", Type: field.TypeString, Size: 4096, Comment: "Materialized path (e.g., /root/sub/current)"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Optional description"},
 		{Name: "depth", Type: field.TypeInt32, Comment: "Nesting depth level (0 for root folders)", Default: 0},
+		{Name: "last_accessed_time", Type: field.TypeTime, Nullable: true, Comment: "When a password of a secret in this folder was last read"},
+		{Name: "secret_count", Type: field.TypeInt32, Comment: "Number of non-deleted secrets directly in this folder (denormalized)", Default: 0},
+		{Name: "subfolder_count", Type: field.TypeInt32, Comment: "Number of direct child folders (denormalized)", Default: 0},
+		{Name: "revision", Type: field.TypeInt64, Comment: "Incremented on every change, for optimistic concurrency", Default: 0},
+		{Name: "metadata_schema", Type: field.TypeJSON, Nullable: true, Comment: "JSON Schema the metadata of secrets in this folder must satisfy"},
+		{Name: "default_permissions", Type: field.TypeJSON, Nullable: true, Comment: "Permissions granted on every secret created in this folder"},
+		{Name: "access_policy", Type: field.TypeJSON, Nullable: true, Comment: "Network and time restrictions on access to this folder and everything in it"},
+		{Name: "protected", Type: field.TypeBool, Comment: "Whether deleting this folder or anything in it permanently needs the approval of a second owner", Default: false},
+		{Name: "legal_hold", Type: field.TypeBool, Comment: "Whether this folder and everything in it is under legal hold and cannot be permanently deleted or destroyed", Default: false},
+		{Name: "legal_hold_reason", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the legal hold was placed"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Folder status; deleted folders are in the trash until restored or purged", Enums: []string{"FOLDER_STATUS_ACTIVE", "FOLDER_STATUS_DELETED"}, Default: "FOLDER_STATUS_ACTIVE"},
+		{Name: "deleted_time", Type: field.TypeTime, Nullable: true, Comment: "When the folder was moved to the trash"},
+		{Name: "trashed_with_folder_id", Type: field.TypeString, Nullable: true, Comment: "The folder whose deletion moved this one to the trash (itself for the deleted folder)"},
+		{Name: "original_parent_id", Type: field.TypeString, Nullable: true, Comment: "Parent of the deleted folder before it was moved to the trash"},
+		{Name: "original_path", Type: field.TypeString, Nullable: true, Size: 4096, Comment: "Path of the deleted folder before it was moved to the trash"},
 		{Name: "parent_id", Type: field.TypeString, Nullable: true, Comment: "Parent folder ID (null for root-level folders)"},
 	}
 	// WardenFoldersTable holds the schema information for the "warden_folders" table.
@@ -105,7 +287,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "warden_folders_warden_folders_children",
-				Columns:    []*schema.Column{WardenFoldersColumns[10]},
+				Columns:    []*schema.Column{WardenFoldersColumns[25]},
 				RefColumns: []*schema.Column{WardenFoldersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -114,7 +296,7 @@ var (
 			{
 				Name:    "folder_tenant_id_parent_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{WardenFoldersColumns[5], WardenFoldersColumns[10], WardenFoldersColumns[6]},
+				Columns: []*schema.Column{WardenFoldersColumns[5], WardenFoldersColumns[25], WardenFoldersColumns[6]},
 			},
 			{
 				Name:    "folder_tenant_id_path",
@@ -129,13 +311,105 @@ var (
 			{
 				Name:    "folder_parent_id",
 				Unique:  false,
-				Columns: []*schema.Column{WardenFoldersColumns[10]},
+				Columns: []*schema.Column{WardenFoldersColumns[25]},
 			},
 			{
 				Name:    "folder_path",
 				Unique:  false,
 				Columns: []*schema.Column{WardenFoldersColumns[7]},
 			},
+			{
+				Name:    "folder_tenant_id_parent_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{WardenFoldersColumns[5], WardenFoldersColumns[25], WardenFoldersColumns[2]},
+			},
+			{
+				Name:    "folder_tenant_id_parent_id_update_time",
+				Unique:  false,
+				Columns: []*schema.Column{WardenFoldersColumns[5], WardenFoldersColumns[25], WardenFoldersColumns[3]},
+			},
+			{
+				Name:    "folder_tenant_id_parent_id_last_accessed_time",
+				Unique:  false,
+				Columns: []*schema.Column{WardenFoldersColumns[5], WardenFoldersColumns[25], WardenFoldersColumns[10]},
+			},
+			{
+				Name:    "folder_trashed_with_folder_id",
+				Unique:  false,
+				Columns: []*schema.Column{WardenFoldersColumns[22]},
+			},
+			{
+				Name:    "folder_status_deleted_time",
+				Unique:  false,
+				Columns: []*schema.Column{WardenFoldersColumns[20], WardenFoldersColumns[21]},
+			},
+		},
+	}
+	// WardenFolderChangeLogsColumns holds the columns for the "warden_folder_change_logs" table.
+	WardenFolderChangeLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "folder_id", Type: field.TypeString, Comment: "Folder that changed"},
+		{Name: "action", Type: field.TypeEnum, Comment: "Kind of change", Enums: []string{"FOLDER_CHANGE_ACTION_CREATED", "FOLDER_CHANGE_ACTION_RENAMED", "FOLDER_CHANGE_ACTION_MOVED", "FOLDER_CHANGE_ACTION_DELETED", "FOLDER_CHANGE_ACTION_TRASHED", "FOLDER_CHANGE_ACTION_RESTORED"}},
+		{Name: "old_path", Type: field.TypeString, Nullable: true, Comment: "Path before the change"},
+		{Name: "new_path", Type: field.TypeString, Nullable: true, Comment: "Path after the change"},
+		{Name: "old_parent_id", Type: field.TypeString, Nullable: true, Comment: "Parent folder before the change"},
+		{Name: "new_parent_id", Type: field.TypeString, Nullable: true, Comment: "Parent folder after the change"},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true, Comment: "User who made the change"},
+		{Name: "details", Type: field.TypeJSON, Nullable: true, Comment: "Extra details of the change"},
+	}
+	// WardenFolderChangeLogsTable holds the schema information for the "warden_folder_change_logs" table.
+	WardenFolderChangeLogsTable = &schema.Table{
+		Name:       "warden_folder_change_logs",
+		Columns:    WardenFolderChangeLogsColumns,
+		PrimaryKey: []*schema.Column{WardenFolderChangeLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warden_folder_change_logs_tenant_folder",
+				Unique:  false,
+				Columns: []*schema.Column{WardenFolderChangeLogsColumns[4], WardenFolderChangeLogsColumns[5]},
+			},
+			{
+				Name:    "warden_folder_change_logs_tenant_time",
+				Unique:  false,
+				Columns: []*schema.Column{WardenFolderChangeLogsColumns[4], WardenFolderChangeLogsColumns[1]},
+			},
+		},
+	}
+	// WardenPendingOperationsColumns holds the columns for the "warden_pending_operations" table.
+	WardenPendingOperationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "kind", Type: field.TypeEnum, Comment: "Operation to carry out", Enums: []string{"OPERATION_KIND_UNSPECIFIED", "OPERATION_KIND_DESTROY_VAULT_DATA"}, Default: "OPERATION_KIND_DESTROY_VAULT_DATA"},
+		{Name: "secret_id", Type: field.TypeString, Size: 36, Comment: "Secret the Vault data belongs to"},
+		{Name: "vault_path", Type: field.TypeString, Size: 512, Comment: "Vault path of the password"},
+		{Name: "totp_path", Type: field.TypeString, Nullable: true, Size: 512, Comment: "Vault path of the TOTP secret, if any"},
+		{Name: "attempts", Type: field.TypeInt32, Comment: "Number of failed attempts", Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Error of the last failed attempt"},
+		{Name: "next_attempt_at", Type: field.TypeTime, Comment: "When the worker may carry out the operation"},
+	}
+	// WardenPendingOperationsTable holds the schema information for the "warden_pending_operations" table.
+	WardenPendingOperationsTable = &schema.Table{
+		Name:       "warden_pending_operations",
+		Columns:    WardenPendingOperationsColumns,
+		PrimaryKey: []*schema.Column{WardenPendingOperationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warden_pending_operations_next",
+				Unique:  false,
+				Columns: []*schema.Column{WardenPendingOperationsColumns[11]},
+			},
+			{
+				Name:    "warden_pending_operations_vault_path",
+				Unique:  false,
+				Columns: []*schema.Column{WardenPendingOperationsColumns[7]},
+			},
 		},
 	}
 	// WardenPermissionsColumns holds the columns for the "warden_permissions" table.
@@ -145,8 +419,8 @@ var (
 		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
 		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
-		{Name: "resource_type", Type: field.TypeEnum, Comment: "Type of resource (folder or secret)", Enums: []string{"RESOURCE_TYPE_UNSPECIFIED", "RESOURCE_TYPE_FOLDER", "RESOURCE_TYPE_SECRET"}},
-		{Name: "resource_id", Type: field.TypeString, Size: 36, Comment: "ID of the folder or secret"},
+		{Name: "resource_type", Type: field.TypeEnum, Comment: "Type of resource (folder, secret or collection)", Enums: []string{"RESOURCE_TYPE_UNSPECIFIED", "RESOURCE_TYPE_FOLDER", "RESOURCE_TYPE_SECRET", "RESOURCE_TYPE_COLLECTION"}},
+		{Name: "resource_id", Type: field.TypeString, Size: 36, Comment: "ID of the folder, secret or collection"},
 		{Name: "relation", Type: field.TypeEnum, Comment: "Permission level (owner, editor, viewer, sharer)", Enums: []string{"RELATION_UNSPECIFIED", "RELATION_OWNER", "RELATION_EDITOR", "RELATION_VIEWER", "RELATION_SHARER"}},
 		{Name: "subject_type", Type: field.TypeEnum, Comment: "Type of subject (user, role, or tenant)", Enums: []string{"SUBJECT_TYPE_UNSPECIFIED", "SUBJECT_TYPE_USER", "SUBJECT_TYPE_ROLE", "SUBJECT_TYPE_TENANT"}},
 		{Name: "subject_id", Type: field.TypeString, Size: 36, Comment: "ID of the user, role, or tenant"},
@@ -212,14 +486,25 @@ var (
 		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
 		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Secret name"},
-		{Name: "username", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Associated username"},
-		{Name: "host_url", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Host/URL associated with the secret"},
+		{Name: "username", Type: field.TypeString, Nullable: true, Size: 512, Comment: "Associated username"},
+		{Name: "host_url", Type: field.TypeString, Nullable: true, Size: 4096, Comment: "Host/URL associated with the secret"},
 		{Name: "vault_path", Type: field.TypeString, Comment: "Reference path to HashiCorp Vault"},
 		{Name: "current_version", Type: field.TypeInt32, Comment: "Current active version number", Default: 1},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, Comment: "Custom fields, notes, tags (JSON)"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 4096, Comment: "Description"},
 		{Name: "status", Type: field.TypeEnum, Comment: "Secret status", Enums: []string{"SECRET_STATUS_UNSPECIFIED", "SECRET_STATUS_ACTIVE", "SECRET_STATUS_ARCHIVED", "SECRET_STATUS_DELETED"}, Default: "SECRET_STATUS_ACTIVE"},
 		{Name: "has_totp", Type: field.TypeBool, Comment: "Whether this secret has a TOTP authenticator configured", Default: false},
+		{Name: "last_accessed_time", Type: field.TypeTime, Nullable: true, Comment: "When the password was last read"},
+		{Name: "revision", Type: field.TypeInt64, Comment: "Incremented on every change, for optimistic concurrency", Default: 0},
+		{Name: "sensitive", Type: field.TypeBool, Comment: "Whether password reads by non-owners raise secret.read webhook events", Default: false},
+		{Name: "password_encoding", Type: field.TypeEnum, Comment: "Whether the current password is text or base64-encoded binary", Enums: []string{"PASSWORD_ENCODING_TEXT", "PASSWORD_ENCODING_BASE64"}, Default: "PASSWORD_ENCODING_TEXT"},
+		{Name: "canary", Type: field.TypeBool, Comment: "Decoy secret whose password reads raise a security alert", Default: false},
+		{Name: "access_policy", Type: field.TypeJSON, Nullable: true, Comment: "Network and time restrictions on access to this secret"},
+		{Name: "protected", Type: field.TypeBool, Comment: "Whether permanent deletion needs the approval of a second owner", Default: false},
+		{Name: "referenced_secret_ids", Type: field.TypeJSON, Nullable: true, Comment: "Secrets referenced from the metadata, kept in step with it"},
+		{Name: "trashed_with_folder_id", Type: field.TypeString, Nullable: true, Comment: "The deleted folder that moved this secret to the trash, restored with it"},
+		{Name: "status_before_trash", Type: field.TypeEnum, Nullable: true, Comment: "Status to restore when the folder that moved this secret to the trash is restored", Enums: []string{"SECRET_STATUS_ACTIVE", "SECRET_STATUS_ARCHIVED"}},
+		{Name: "field_key_id", Type: field.TypeUint32, Comment: "Field key encrypting username, host_url and metadata (0 when stored in plaintext)", Default: 0},
 		{Name: "folder_id", Type: field.TypeString, Nullable: true, Comment: "Parent folder ID (null for root-level secrets)"},
 	}
 	// WardenSecretsTable holds the schema information for the "warden_secrets" table.
@@ -230,7 +515,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "warden_secrets_warden_folders_secrets",
-				Columns:    []*schema.Column{WardenSecretsColumns[16]},
+				Columns:    []*schema.Column{WardenSecretsColumns[27]},
 				RefColumns: []*schema.Column{WardenFoldersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -239,7 +524,7 @@ var (
 			{
 				Name:    "secret_tenant_id_folder_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{WardenSecretsColumns[6], WardenSecretsColumns[16], WardenSecretsColumns[7]},
+				Columns: []*schema.Column{WardenSecretsColumns[6], WardenSecretsColumns[27], WardenSecretsColumns[7]},
 			},
 			{
 				Name:    "secret_tenant_id",
@@ -249,7 +534,7 @@ var (
 			{
 				Name:    "secret_folder_id",
 				Unique:  false,
-				Columns: []*schema.Column{WardenSecretsColumns[16]},
+				Columns: []*schema.Column{WardenSecretsColumns[27]},
 			},
 			{
 				Name:    "secret_tenant_id_name",
@@ -271,6 +556,58 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{WardenSecretsColumns[10]},
 			},
+			{
+				Name:    "secret_tenant_id_folder_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{WardenSecretsColumns[6], WardenSecretsColumns[27], WardenSecretsColumns[3]},
+			},
+			{
+				Name:    "secret_tenant_id_folder_id_update_time",
+				Unique:  false,
+				Columns: []*schema.Column{WardenSecretsColumns[6], WardenSecretsColumns[27], WardenSecretsColumns[4]},
+			},
+			{
+				Name:    "secret_tenant_id_folder_id_last_accessed_time",
+				Unique:  false,
+				Columns: []*schema.Column{WardenSecretsColumns[6], WardenSecretsColumns[27], WardenSecretsColumns[16]},
+			},
+			{
+				Name:    "secret_trashed_with_folder_id",
+				Unique:  false,
+				Columns: []*schema.Column{WardenSecretsColumns[24]},
+			},
+			{
+				Name:    "secret_field_key_id",
+				Unique:  false,
+				Columns: []*schema.Column{WardenSecretsColumns[26]},
+			},
+		},
+	}
+	// WardenSecretUsageColumns holds the columns for the "warden_secret_usage" table.
+	WardenSecretUsageColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "secret_id", Type: field.TypeString, Comment: "Secret the counts belong to"},
+		{Name: "day", Type: field.TypeTime, Comment: "Midnight UTC of the counted day"},
+		{Name: "reads", Type: field.TypeInt64, Comment: "Password reads on the day", Default: 0},
+		{Name: "writes", Type: field.TypeInt64, Comment: "Updates, password changes and version restores on the day", Default: 0},
+	}
+	// WardenSecretUsageTable holds the schema information for the "warden_secret_usage" table.
+	WardenSecretUsageTable = &schema.Table{
+		Name:       "warden_secret_usage",
+		Columns:    WardenSecretUsageColumns,
+		PrimaryKey: []*schema.Column{WardenSecretUsageColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warden_secret_usage_secret_day",
+				Unique:  true,
+				Columns: []*schema.Column{WardenSecretUsageColumns[1], WardenSecretUsageColumns[2], WardenSecretUsageColumns[3]},
+			},
+			{
+				Name:    "warden_secret_usage_day",
+				Unique:  false,
+				Columns: []*schema.Column{WardenSecretUsageColumns[3]},
+			},
 		},
 	}
 	// WardenSecretVersionsColumns holds the columns for the "warden_secret_versions" table.
@@ -283,7 +620,9 @@ var (
 		{Name: "version_number", Type: field.TypeInt32, Comment: "Version number (1, 2, 3...)"},
 		{Name: "vault_path", Type: field.TypeString, Comment: "Vault path for this version"},
 		{Name: "comment", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Version comment describing the change"},
-		{Name: "checksum", Type: field.TypeString, Size: 64, Comment: "SHA-256 checksum of the password"},
+		{Name: "checksum", Type: field.TypeString, Size: 64, Comment: "Checksum of the password, made with checksum_algorithm"},
+		{Name: "checksum_algorithm", Type: field.TypeString, Size: 32, Comment: "Algorithm of the checksum: sha256, or hmac-sha256:<key id>", Default: "sha256"},
+		{Name: "source_version", Type: field.TypeInt32, Nullable: true, Comment: "Version this one was restored from, if created by a restore"},
 		{Name: "secret_id", Type: field.TypeString, Comment: "Parent secret ID"},
 	}
 	// WardenSecretVersionsTable holds the schema information for the "warden_secret_versions" table.
@@ -294,7 +633,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "warden_secret_versions_warden_secrets_versions",
-				Columns:    []*schema.Column{WardenSecretVersionsColumns[9]},
+				Columns:    []*schema.Column{WardenSecretVersionsColumns[11]},
 				RefColumns: []*schema.Column{WardenSecretsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -303,38 +642,281 @@ var (
 			{
 				Name:    "secretversion_secret_id_version_number",
 				Unique:  true,
-				Columns: []*schema.Column{WardenSecretVersionsColumns[9], WardenSecretVersionsColumns[5]},
+				Columns: []*schema.Column{WardenSecretVersionsColumns[11], WardenSecretVersionsColumns[5]},
 			},
 			{
 				Name:    "secretversion_secret_id",
 				Unique:  false,
-				Columns: []*schema.Column{WardenSecretVersionsColumns[9]},
+				Columns: []*schema.Column{WardenSecretVersionsColumns[11]},
 			},
 			{
 				Name:    "secretversion_vault_path",
 				Unique:  false,
 				Columns: []*schema.Column{WardenSecretVersionsColumns[6]},
 			},
+			{
+				Name:    "secretversion_checksum_algorithm",
+				Unique:  false,
+				Columns: []*schema.Column{WardenSecretVersionsColumns[9]},
+			},
+		},
+	}
+	// WardenSecurityAlertsColumns holds the columns for the "warden_security_alerts" table.
+	WardenSecurityAlertsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "kind", Type: field.TypeEnum, Comment: "Detected anomaly", Enums: []string{"ALERT_KIND_UNSPECIFIED", "ALERT_KIND_EXCESSIVE_READS", "ALERT_KIND_NEW_PEER_ADDRESS", "ALERT_KIND_NEW_GEO_LOCATION", "ALERT_KIND_CANARY_READ"}},
+		{Name: "severity", Type: field.TypeEnum, Comment: "Alert severity", Enums: []string{"ALERT_SEVERITY_UNSPECIFIED", "ALERT_SEVERITY_LOW", "ALERT_SEVERITY_MEDIUM", "ALERT_SEVERITY_HIGH"}, Default: "ALERT_SEVERITY_MEDIUM"},
+		{Name: "user_id", Type: field.TypeString, Nullable: true, Comment: "User whose activity triggered the alert"},
+		{Name: "resource_id", Type: field.TypeString, Nullable: true, Comment: "Secret involved, if the alert concerns a single secret"},
+		{Name: "message", Type: field.TypeString, Comment: "Human-readable description"},
+		{Name: "details", Type: field.TypeJSON, Nullable: true, Comment: "Detection details (counts, window, peer address, location)"},
+		{Name: "acknowledged", Type: field.TypeBool, Comment: "Whether an operator acknowledged the alert", Default: false},
+		{Name: "acknowledged_by", Type: field.TypeUint32, Nullable: true, Comment: "User ID who acknowledged the alert"},
+		{Name: "acknowledged_at", Type: field.TypeTime, Nullable: true, Comment: "When the alert was acknowledged"},
+	}
+	// WardenSecurityAlertsTable holds the schema information for the "warden_security_alerts" table.
+	WardenSecurityAlertsTable = &schema.Table{
+		Name:       "warden_security_alerts",
+		Columns:    WardenSecurityAlertsColumns,
+		PrimaryKey: []*schema.Column{WardenSecurityAlertsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warden_security_alerts_tenant_ack",
+				Unique:  false,
+				Columns: []*schema.Column{WardenSecurityAlertsColumns[4], WardenSecurityAlertsColumns[11]},
+			},
+			{
+				Name:    "warden_security_alerts_tenant_user_kind",
+				Unique:  false,
+				Columns: []*schema.Column{WardenSecurityAlertsColumns[4], WardenSecurityAlertsColumns[7], WardenSecurityAlertsColumns[5]},
+			},
+		},
+	}
+	// WardenTenantSettingsColumns holds the columns for the "warden_tenant_settings" table.
+	WardenTenantSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "audit_retention_days", Type: field.TypeInt32, Comment: "Audit log retention in days (0 = deployment default)", Default: 0},
+		{Name: "export_excluded_tags", Type: field.TypeJSON, Nullable: true, Comment: "Secrets tagged with any of these tags are never exported"},
+		{Name: "export_excluded_folder_ids", Type: field.TypeJSON, Nullable: true, Comment: "Folders whose subtrees are never exported"},
+		{Name: "password_policy_enabled", Type: field.TypeBool, Comment: "Whether new passwords are checked against the policy", Default: false},
+		{Name: "password_min_length", Type: field.TypeInt32, Comment: "Minimum password length (0 = no minimum)", Default: 0},
+		{Name: "password_require_lowercase", Type: field.TypeBool, Comment: "Passwords must contain a lowercase letter", Default: false},
+		{Name: "password_require_uppercase", Type: field.TypeBool, Comment: "Passwords must contain an uppercase letter", Default: false},
+		{Name: "password_require_digit", Type: field.TypeBool, Comment: "Passwords must contain a digit", Default: false},
+		{Name: "password_require_symbol", Type: field.TypeBool, Comment: "Passwords must contain a symbol", Default: false},
+		{Name: "password_banned_words", Type: field.TypeJSON, Nullable: true, Comment: "Words passwords must not contain (case-insensitive)"},
+		{Name: "password_max_age_days", Type: field.TypeInt32, Comment: "Days after which a password must be changed (0 = never)", Default: 0},
+		{Name: "password_history_size", Type: field.TypeInt32, Comment: "Number of previous passwords of a secret that may not be reused", Default: 0},
+		{Name: "geo_allowed_countries", Type: field.TypeJSON, Nullable: true, Comment: "ISO 3166-1 alpha-2 countries passwords may be read from (empty = anywhere)"},
+		{Name: "version_max_count", Type: field.TypeInt32, Comment: "Password versions Vault keeps per secret (0 = mount default)", Default: 0},
+		{Name: "version_delete_after_days", Type: field.TypeInt32, Comment: "Days after which Vault deletes password versions (0 = never)", Default: 0},
+	}
+	// WardenTenantSettingsTable holds the schema information for the "warden_tenant_settings" table.
+	WardenTenantSettingsTable = &schema.Table{
+		Name:       "warden_tenant_settings",
+		Columns:    WardenTenantSettingsColumns,
+		PrimaryKey: []*schema.Column{WardenTenantSettingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warden_tenant_settings_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{WardenTenantSettingsColumns[5]},
+			},
+		},
+	}
+	// WardenVersionPinsColumns holds the columns for the "warden_version_pins" table.
+	WardenVersionPinsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "secret_id", Type: field.TypeString, Comment: "Pinned secret"},
+		{Name: "version_number", Type: field.TypeInt32, Comment: "Version the consumer is pinned to"},
+		{Name: "token_hash", Type: field.TypeString, Comment: "SHA-256 of the pin token, hex encoded"},
+		{Name: "consumer", Type: field.TypeString, Comment: "User ID of the consumer that created the pin"},
+		{Name: "last_used_time", Type: field.TypeTime, Nullable: true, Comment: "Last time the pin token was used to read the password"},
+	}
+	// WardenVersionPinsTable holds the schema information for the "warden_version_pins" table.
+	WardenVersionPinsTable = &schema.Table{
+		Name:       "warden_version_pins",
+		Columns:    WardenVersionPinsColumns,
+		PrimaryKey: []*schema.Column{WardenVersionPinsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warden_version_pins_token",
+				Unique:  true,
+				Columns: []*schema.Column{WardenVersionPinsColumns[7]},
+			},
+			{
+				Name:    "warden_version_pins_tenant_secret",
+				Unique:  false,
+				Columns: []*schema.Column{WardenVersionPinsColumns[4], WardenVersionPinsColumns[5]},
+			},
+		},
+	}
+	// WardenWebhooksColumns holds the columns for the "warden_webhooks" table.
+	WardenWebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Display name"},
+		{Name: "url", Type: field.TypeString, Size: 2048, Comment: "Endpoint receiving the POST requests"},
+		{Name: "secret", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Shared secret used to HMAC-sign payloads"},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true, Comment: "Subscribed event types (e.g. permission.granted)"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether deliveries are sent", Default: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Optional description"},
+	}
+	// WardenWebhooksTable holds the schema information for the "warden_webhooks" table.
+	WardenWebhooksTable = &schema.Table{
+		Name:       "warden_webhooks",
+		Columns:    WardenWebhooksColumns,
+		PrimaryKey: []*schema.Column{WardenWebhooksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warden_webhooks_tenant_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{WardenWebhooksColumns[6], WardenWebhooksColumns[11]},
+			},
+			{
+				Name:    "warden_webhooks_tenant_name",
+				Unique:  true,
+				Columns: []*schema.Column{WardenWebhooksColumns[6], WardenWebhooksColumns[7]},
+			},
+		},
+	}
+	// WardenWebhookDeliveriesColumns holds the columns for the "warden_webhook_deliveries" table.
+	WardenWebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "webhook_id", Type: field.TypeUint32, Comment: "Target webhook"},
+		{Name: "event_id", Type: field.TypeString, Size: 255, Comment: "Event identifier (also used to avoid duplicate deliveries)"},
+		{Name: "event_type", Type: field.TypeString, Size: 64, Comment: "Event type"},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647, Comment: "JSON body sent to the endpoint"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Delivery state", Enums: []string{"DELIVERY_STATUS_UNSPECIFIED", "DELIVERY_STATUS_PENDING", "DELIVERY_STATUS_DELIVERED", "DELIVERY_STATUS_FAILED"}, Default: "DELIVERY_STATUS_PENDING"},
+		{Name: "attempts", Type: field.TypeInt32, Comment: "Number of delivery attempts made", Default: 0},
+		{Name: "response_code", Type: field.TypeInt32, Nullable: true, Comment: "HTTP status of the last attempt"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Error of the last failed attempt"},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true, Comment: "When the next attempt is due (pending deliveries only)"},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true, Comment: "When the endpoint acknowledged the event"},
+	}
+	// WardenWebhookDeliveriesTable holds the schema information for the "warden_webhook_deliveries" table.
+	WardenWebhookDeliveriesTable = &schema.Table{
+		Name:       "warden_webhook_deliveries",
+		Columns:    WardenWebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WardenWebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warden_webhook_deliveries_webhook_event",
+				Unique:  true,
+				Columns: []*schema.Column{WardenWebhookDeliveriesColumns[5], WardenWebhookDeliveriesColumns[6]},
+			},
+			{
+				Name:    "warden_webhook_deliveries_status_next",
+				Unique:  false,
+				Columns: []*schema.Column{WardenWebhookDeliveriesColumns[9], WardenWebhookDeliveriesColumns[13]},
+			},
+			{
+				Name:    "warden_webhook_deliveries_webhook_time",
+				Unique:  false,
+				Columns: []*schema.Column{WardenWebhookDeliveriesColumns[5], WardenWebhookDeliveriesColumns[1]},
+			},
+		},
+	}
+	// WardenCollectionSecretsColumns holds the columns for the "warden_collection_secrets" table.
+	WardenCollectionSecretsColumns = []*schema.Column{
+		{Name: "collection_id", Type: field.TypeString},
+		{Name: "secret_id", Type: field.TypeString},
+	}
+	// WardenCollectionSecretsTable holds the schema information for the "warden_collection_secrets" table.
+	WardenCollectionSecretsTable = &schema.Table{
+		Name:       "warden_collection_secrets",
+		Columns:    WardenCollectionSecretsColumns,
+		PrimaryKey: []*schema.Column{WardenCollectionSecretsColumns[0], WardenCollectionSecretsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "warden_collection_secrets_collection_id",
+				Columns:    []*schema.Column{WardenCollectionSecretsColumns[0]},
+				RefColumns: []*schema.Column{WardenCollectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "warden_collection_secrets_secret_id",
+				Columns:    []*schema.Column{WardenCollectionSecretsColumns[1]},
+				RefColumns: []*schema.Column{WardenSecretsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		WardenAuditChainHeadsTable,
 		WardenAuditLogsTable,
+		WardenCollectionsTable,
+		WardenDeletionRequestsTable,
+		WardenEmergencyAccessTable,
+		WardenFieldKeysTable,
 		WardenFoldersTable,
+		WardenFolderChangeLogsTable,
+		WardenPendingOperationsTable,
 		WardenPermissionsTable,
 		WardenSecretsTable,
+		WardenSecretUsageTable,
 		WardenSecretVersionsTable,
+		WardenSecurityAlertsTable,
+		WardenTenantSettingsTable,
+		WardenVersionPinsTable,
+		WardenWebhooksTable,
+		WardenWebhookDeliveriesTable,
+		WardenCollectionSecretsTable,
 	}
 )
 
 func init() {
+	WardenAuditChainHeadsTable.Annotation = &entsql.Annotation{
+		Table: "warden_audit_chain_heads",
+	}
 	WardenAuditLogsTable.Annotation = &entsql.Annotation{
 		Table: "warden_audit_logs",
 	}
+	WardenCollectionsTable.Annotation = &entsql.Annotation{
+		Table: "warden_collections",
+	}
+	WardenDeletionRequestsTable.Annotation = &entsql.Annotation{
+		Table: "warden_deletion_requests",
+	}
+	WardenEmergencyAccessTable.Annotation = &entsql.Annotation{
+		Table: "warden_emergency_access",
+	}
+	WardenFieldKeysTable.Annotation = &entsql.Annotation{
+		Table: "warden_field_keys",
+	}
 	WardenFoldersTable.ForeignKeys[0].RefTable = WardenFoldersTable
 	WardenFoldersTable.Annotation = &entsql.Annotation{
 		Table: "warden_folders",
 	}
+	WardenFolderChangeLogsTable.Annotation = &entsql.Annotation{
+		Table: "warden_folder_change_logs",
+	}
+	WardenPendingOperationsTable.Annotation = &entsql.Annotation{
+		Table: "warden_pending_operations",
+	}
 	WardenPermissionsTable.ForeignKeys[0].RefTable = WardenFoldersTable
 	WardenPermissionsTable.ForeignKeys[1].RefTable = WardenSecretsTable
 	WardenPermissionsTable.Annotation = &entsql.Annotation{
@@ -344,8 +926,28 @@ func init() {
 	WardenSecretsTable.Annotation = &entsql.Annotation{
 		Table: "warden_secrets",
 	}
+	WardenSecretUsageTable.Annotation = &entsql.Annotation{
+		Table: "warden_secret_usage",
+	}
 	WardenSecretVersionsTable.ForeignKeys[0].RefTable = WardenSecretsTable
 	WardenSecretVersionsTable.Annotation = &entsql.Annotation{
 		Table: "warden_secret_versions",
 	}
+	WardenSecurityAlertsTable.Annotation = &entsql.Annotation{
+		Table: "warden_security_alerts",
+	}
+	WardenTenantSettingsTable.Annotation = &entsql.Annotation{
+		Table: "warden_tenant_settings",
+	}
+	WardenVersionPinsTable.Annotation = &entsql.Annotation{
+		Table: "warden_version_pins",
+	}
+	WardenWebhooksTable.Annotation = &entsql.Annotation{
+		Table: "warden_webhooks",
+	}
+	WardenWebhookDeliveriesTable.Annotation = &entsql.Annotation{
+		Table: "warden_webhook_deliveries",
+	}
+	WardenCollectionSecretsTable.ForeignKeys[0].RefTable = WardenCollectionsTable
+	WardenCollectionSecretsTable.ForeignKeys[1].RefTable = WardenSecretsTable
 }
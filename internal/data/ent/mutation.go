@@ -11,12 +11,27 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditchainhead"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/collection"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/deletionrequest"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/emergencyaccess"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/fieldkey"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folderchangelog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/pendingoperation"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretusage"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretversion"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/securityalert"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/tenantsetting"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/versionpin"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhook"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhookdelivery"
 )
 
 const (
@@ -28,61 +43,57 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAuditLog      = "AuditLog"
-	TypeFolder        = "Folder"
-	TypePermission    = "Permission"
-	TypeSecret        = "Secret"
-	TypeSecretVersion = "SecretVersion"
+	TypeAuditChainHead   = "AuditChainHead"
+	TypeAuditLog         = "AuditLog"
+	TypeCollection       = "Collection"
+	TypeDeletionRequest  = "DeletionRequest"
+	TypeEmergencyAccess  = "EmergencyAccess"
+	TypeFieldKey         = "FieldKey"
+	TypeFolder           = "Folder"
+	TypeFolderChangeLog  = "FolderChangeLog"
+	TypePendingOperation = "PendingOperation"
+	TypePermission       = "Permission"
+	TypeSecret           = "Secret"
+	TypeSecretUsage      = "SecretUsage"
+	TypeSecretVersion    = "SecretVersion"
+	TypeSecurityAlert    = "SecurityAlert"
+	TypeTenantSetting    = "TenantSetting"
+	TypeVersionPin       = "VersionPin"
+	TypeWebhook          = "Webhook"
+	TypeWebhookDelivery  = "WebhookDelivery"
 )
 
-// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
-type AuditLogMutation struct {
+// AuditChainHeadMutation represents an operation that mutates the AuditChainHead nodes in the graph.
+type AuditChainHeadMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uint32
-	create_time          *time.Time
-	update_time          *time.Time
-	delete_time          *time.Time
-	tenant_id            *uint32
-	addtenant_id         *int32
-	audit_id             *string
-	request_id           *string
-	operation            *string
-	service_name         *string
-	client_id            *string
-	client_common_name   *string
-	client_organization  *string
-	client_serial_number *string
-	is_authenticated     *bool
-	success              *bool
-	error_code           *int32
-	adderror_code        *int32
-	error_message        *string
-	latency_ms           *int64
-	addlatency_ms        *int64
-	peer_address         *string
-	geo_location         *map[string]string
-	log_hash             *string
-	signature            *[]byte
-	metadata             *map[string]string
-	clearedFields        map[string]struct{}
-	done                 bool
-	oldValue             func(context.Context) (*AuditLog, error)
-	predicates           []predicate.AuditLog
+	op            Op
+	typ           string
+	id            *uint32
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	tenant_id     *uint32
+	addtenant_id  *int32
+	seq           *int64
+	addseq        *int64
+	hash          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditChainHead, error)
+	predicates    []predicate.AuditChainHead
 }
 
-var _ ent.Mutation = (*AuditLogMutation)(nil)
+var _ ent.Mutation = (*AuditChainHeadMutation)(nil)
 
-// auditlogOption allows management of the mutation configuration using functional options.
-type auditlogOption func(*AuditLogMutation)
+// auditchainheadOption allows management of the mutation configuration using functional options.
+type auditchainheadOption func(*AuditChainHeadMutation)
 
-// newAuditLogMutation creates new mutation for the AuditLog entity.
-func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
-	m := &AuditLogMutation{
+// newAuditChainHeadMutation creates new mutation for the AuditChainHead entity.
+func newAuditChainHeadMutation(c config, op Op, opts ...auditchainheadOption) *AuditChainHeadMutation {
+	m := &AuditChainHeadMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuditLog,
+		typ:           TypeAuditChainHead,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -91,20 +102,20 @@ func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutat
 	return m
 }
 
-// withAuditLogID sets the ID field of the mutation.
-func withAuditLogID(id uint32) auditlogOption {
-	return func(m *AuditLogMutation) {
+// withAuditChainHeadID sets the ID field of the mutation.
+func withAuditChainHeadID(id uint32) auditchainheadOption {
+	return func(m *AuditChainHeadMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuditLog
+			value *AuditChainHead
 		)
-		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
+		m.oldValue = func(ctx context.Context) (*AuditChainHead, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuditLog.Get(ctx, id)
+					value, err = m.Client().AuditChainHead.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -113,10 +124,10 @@ func withAuditLogID(id uint32) auditlogOption {
 	}
 }
 
-// withAuditLog sets the old AuditLog of the mutation.
-func withAuditLog(node *AuditLog) auditlogOption {
-	return func(m *AuditLogMutation) {
-		m.oldValue = func(context.Context) (*AuditLog, error) {
+// withAuditChainHead sets the old AuditChainHead of the mutation.
+func withAuditChainHead(node *AuditChainHead) auditchainheadOption {
+	return func(m *AuditChainHeadMutation) {
+		m.oldValue = func(context.Context) (*AuditChainHead, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -125,7 +136,7 @@ func withAuditLog(node *AuditLog) auditlogOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuditLogMutation) Client() *Client {
+func (m AuditChainHeadMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -133,7 +144,7 @@ func (m AuditLogMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuditLogMutation) Tx() (*Tx, error) {
+func (m AuditChainHeadMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -143,14 +154,14 @@ func (m AuditLogMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuditLog entities.
-func (m *AuditLogMutation) SetID(id uint32) {
+// operation is only accepted on creation of AuditChainHead entities.
+func (m *AuditChainHeadMutation) SetID(id uint32) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuditLogMutation) ID() (id uint32, exists bool) {
+func (m *AuditChainHeadMutation) ID() (id uint32, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -161,7 +172,7 @@ func (m *AuditLogMutation) ID() (id uint32, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuditLogMutation) IDs(ctx context.Context) ([]uint32, error) {
+func (m *AuditChainHeadMutation) IDs(ctx context.Context) ([]uint32, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -170,19 +181,19 @@ func (m *AuditLogMutation) IDs(ctx context.Context) ([]uint32, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditChainHead.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *AuditLogMutation) SetCreateTime(t time.Time) {
+func (m *AuditChainHeadMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *AuditLogMutation) CreateTime() (r time.Time, exists bool) {
+func (m *AuditChainHeadMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -190,10 +201,10 @@ func (m *AuditLogMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the AuditChainHead entity.
+// If the AuditChainHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *AuditChainHeadMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -208,30 +219,30 @@ func (m *AuditLogMutation) OldCreateTime(ctx context.Context) (v *time.Time, err
 }
 
 // ClearCreateTime clears the value of the "create_time" field.
-func (m *AuditLogMutation) ClearCreateTime() {
+func (m *AuditChainHeadMutation) ClearCreateTime() {
 	m.create_time = nil
-	m.clearedFields[auditlog.FieldCreateTime] = struct{}{}
+	m.clearedFields[auditchainhead.FieldCreateTime] = struct{}{}
 }
 
 // CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *AuditLogMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldCreateTime]
+func (m *AuditChainHeadMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[auditchainhead.FieldCreateTime]
 	return ok
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *AuditLogMutation) ResetCreateTime() {
+func (m *AuditChainHeadMutation) ResetCreateTime() {
 	m.create_time = nil
-	delete(m.clearedFields, auditlog.FieldCreateTime)
+	delete(m.clearedFields, auditchainhead.FieldCreateTime)
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *AuditLogMutation) SetUpdateTime(t time.Time) {
+func (m *AuditChainHeadMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *AuditLogMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *AuditChainHeadMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -239,10 +250,10 @@ func (m *AuditLogMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the AuditChainHead entity.
+// If the AuditChainHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *AuditChainHeadMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -257,30 +268,30 @@ func (m *AuditLogMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err
 }
 
 // ClearUpdateTime clears the value of the "update_time" field.
-func (m *AuditLogMutation) ClearUpdateTime() {
+func (m *AuditChainHeadMutation) ClearUpdateTime() {
 	m.update_time = nil
-	m.clearedFields[auditlog.FieldUpdateTime] = struct{}{}
+	m.clearedFields[auditchainhead.FieldUpdateTime] = struct{}{}
 }
 
 // UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *AuditLogMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldUpdateTime]
+func (m *AuditChainHeadMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[auditchainhead.FieldUpdateTime]
 	return ok
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *AuditLogMutation) ResetUpdateTime() {
+func (m *AuditChainHeadMutation) ResetUpdateTime() {
 	m.update_time = nil
-	delete(m.clearedFields, auditlog.FieldUpdateTime)
+	delete(m.clearedFields, auditchainhead.FieldUpdateTime)
 }
 
 // SetDeleteTime sets the "delete_time" field.
-func (m *AuditLogMutation) SetDeleteTime(t time.Time) {
+func (m *AuditChainHeadMutation) SetDeleteTime(t time.Time) {
 	m.delete_time = &t
 }
 
 // DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *AuditLogMutation) DeleteTime() (r time.Time, exists bool) {
+func (m *AuditChainHeadMutation) DeleteTime() (r time.Time, exists bool) {
 	v := m.delete_time
 	if v == nil {
 		return
@@ -288,10 +299,10 @@ func (m *AuditLogMutation) DeleteTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleteTime returns the old "delete_time" field's value of the AuditChainHead entity.
+// If the AuditChainHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+func (m *AuditChainHeadMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
@@ -306,31 +317,31 @@ func (m *AuditLogMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err
 }
 
 // ClearDeleteTime clears the value of the "delete_time" field.
-func (m *AuditLogMutation) ClearDeleteTime() {
+func (m *AuditChainHeadMutation) ClearDeleteTime() {
 	m.delete_time = nil
-	m.clearedFields[auditlog.FieldDeleteTime] = struct{}{}
+	m.clearedFields[auditchainhead.FieldDeleteTime] = struct{}{}
 }
 
 // DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *AuditLogMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldDeleteTime]
+func (m *AuditChainHeadMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[auditchainhead.FieldDeleteTime]
 	return ok
 }
 
 // ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *AuditLogMutation) ResetDeleteTime() {
+func (m *AuditChainHeadMutation) ResetDeleteTime() {
 	m.delete_time = nil
-	delete(m.clearedFields, auditlog.FieldDeleteTime)
+	delete(m.clearedFields, auditchainhead.FieldDeleteTime)
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *AuditLogMutation) SetTenantID(u uint32) {
+func (m *AuditChainHeadMutation) SetTenantID(u uint32) {
 	m.tenant_id = &u
 	m.addtenant_id = nil
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *AuditLogMutation) TenantID() (r uint32, exists bool) {
+func (m *AuditChainHeadMutation) TenantID() (r uint32, exists bool) {
 	v := m.tenant_id
 	if v == nil {
 		return
@@ -338,10 +349,10 @@ func (m *AuditLogMutation) TenantID() (r uint32, exists bool) {
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the AuditChainHead entity.
+// If the AuditChainHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+func (m *AuditChainHeadMutation) OldTenantID(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -356,7 +367,7 @@ func (m *AuditLogMutation) OldTenantID(ctx context.Context) (v *uint32, err erro
 }
 
 // AddTenantID adds u to the "tenant_id" field.
-func (m *AuditLogMutation) AddTenantID(u int32) {
+func (m *AuditChainHeadMutation) AddTenantID(u int32) {
 	if m.addtenant_id != nil {
 		*m.addtenant_id += u
 	} else {
@@ -365,7 +376,7 @@ func (m *AuditLogMutation) AddTenantID(u int32) {
 }
 
 // AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
-func (m *AuditLogMutation) AddedTenantID() (r int32, exists bool) {
+func (m *AuditChainHeadMutation) AddedTenantID() (r int32, exists bool) {
 	v := m.addtenant_id
 	if v == nil {
 		return
@@ -373,971 +384,16592 @@ func (m *AuditLogMutation) AddedTenantID() (r int32, exists bool) {
 	return *v, true
 }
 
-// ClearTenantID clears the value of the "tenant_id" field.
-func (m *AuditLogMutation) ClearTenantID() {
-	m.tenant_id = nil
-	m.addtenant_id = nil
-	m.clearedFields[auditlog.FieldTenantID] = struct{}{}
-}
-
-// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
-func (m *AuditLogMutation) TenantIDCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldTenantID]
-	return ok
-}
-
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *AuditLogMutation) ResetTenantID() {
+func (m *AuditChainHeadMutation) ResetTenantID() {
 	m.tenant_id = nil
 	m.addtenant_id = nil
-	delete(m.clearedFields, auditlog.FieldTenantID)
 }
 
-// SetAuditID sets the "audit_id" field.
-func (m *AuditLogMutation) SetAuditID(s string) {
-	m.audit_id = &s
+// SetSeq sets the "seq" field.
+func (m *AuditChainHeadMutation) SetSeq(i int64) {
+	m.seq = &i
+	m.addseq = nil
 }
 
-// AuditID returns the value of the "audit_id" field in the mutation.
-func (m *AuditLogMutation) AuditID() (r string, exists bool) {
-	v := m.audit_id
+// Seq returns the value of the "seq" field in the mutation.
+func (m *AuditChainHeadMutation) Seq() (r int64, exists bool) {
+	v := m.seq
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuditID returns the old "audit_id" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldSeq returns the old "seq" field's value of the AuditChainHead entity.
+// If the AuditChainHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldAuditID(ctx context.Context) (v string, err error) {
+func (m *AuditChainHeadMutation) OldSeq(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuditID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuditID requires an ID field in the mutation")
+		return v, errors.New("OldSeq requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuditID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
 	}
-	return oldValue.AuditID, nil
+	return oldValue.Seq, nil
 }
 
-// ResetAuditID resets all changes to the "audit_id" field.
-func (m *AuditLogMutation) ResetAuditID() {
-	m.audit_id = nil
+// AddSeq adds i to the "seq" field.
+func (m *AuditChainHeadMutation) AddSeq(i int64) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
 }
 
-// SetRequestID sets the "request_id" field.
-func (m *AuditLogMutation) SetRequestID(s string) {
-	m.request_id = &s
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *AuditChainHeadMutation) AddedSeq() (r int64, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RequestID returns the value of the "request_id" field in the mutation.
-func (m *AuditLogMutation) RequestID() (r string, exists bool) {
-	v := m.request_id
+// ResetSeq resets all changes to the "seq" field.
+func (m *AuditChainHeadMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *AuditChainHeadMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *AuditChainHeadMutation) Hash() (r string, exists bool) {
+	v := m.hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRequestID returns the old "request_id" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the AuditChainHead entity.
+// If the AuditChainHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldRequestID(ctx context.Context) (v string, err error) {
+func (m *AuditChainHeadMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequestID requires an ID field in the mutation")
+		return v, errors.New("OldHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
-	return oldValue.RequestID, nil
+	return oldValue.Hash, nil
 }
 
-// ClearRequestID clears the value of the "request_id" field.
-func (m *AuditLogMutation) ClearRequestID() {
-	m.request_id = nil
-	m.clearedFields[auditlog.FieldRequestID] = struct{}{}
+// ClearHash clears the value of the "hash" field.
+func (m *AuditChainHeadMutation) ClearHash() {
+	m.hash = nil
+	m.clearedFields[auditchainhead.FieldHash] = struct{}{}
 }
 
-// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
-func (m *AuditLogMutation) RequestIDCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldRequestID]
+// HashCleared returns if the "hash" field was cleared in this mutation.
+func (m *AuditChainHeadMutation) HashCleared() bool {
+	_, ok := m.clearedFields[auditchainhead.FieldHash]
 	return ok
 }
 
-// ResetRequestID resets all changes to the "request_id" field.
-func (m *AuditLogMutation) ResetRequestID() {
-	m.request_id = nil
-	delete(m.clearedFields, auditlog.FieldRequestID)
+// ResetHash resets all changes to the "hash" field.
+func (m *AuditChainHeadMutation) ResetHash() {
+	m.hash = nil
+	delete(m.clearedFields, auditchainhead.FieldHash)
 }
 
-// SetOperation sets the "operation" field.
-func (m *AuditLogMutation) SetOperation(s string) {
-	m.operation = &s
+// Where appends a list predicates to the AuditChainHeadMutation builder.
+func (m *AuditChainHeadMutation) Where(ps ...predicate.AuditChainHead) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Operation returns the value of the "operation" field in the mutation.
-func (m *AuditLogMutation) Operation() (r string, exists bool) {
-	v := m.operation
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AuditChainHeadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditChainHeadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditChainHead, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldOperation returns the old "operation" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldOperation(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOperation requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
-	}
-	return oldValue.Operation, nil
+// Op returns the operation name.
+func (m *AuditChainHeadMutation) Op() Op {
+	return m.op
 }
 
-// ResetOperation resets all changes to the "operation" field.
-func (m *AuditLogMutation) ResetOperation() {
-	m.operation = nil
+// SetOp allows setting the mutation operation.
+func (m *AuditChainHeadMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetServiceName sets the "service_name" field.
-func (m *AuditLogMutation) SetServiceName(s string) {
-	m.service_name = &s
+// Type returns the node type of this mutation (AuditChainHead).
+func (m *AuditChainHeadMutation) Type() string {
+	return m.typ
 }
 
-// ServiceName returns the value of the "service_name" field in the mutation.
-func (m *AuditLogMutation) ServiceName() (r string, exists bool) {
-	v := m.service_name
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditChainHeadMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, auditchainhead.FieldCreateTime)
 	}
-	return *v, true
-}
-
-// OldServiceName returns the old "service_name" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldServiceName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldServiceName is only allowed on UpdateOne operations")
+	if m.update_time != nil {
+		fields = append(fields, auditchainhead.FieldUpdateTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldServiceName requires an ID field in the mutation")
+	if m.delete_time != nil {
+		fields = append(fields, auditchainhead.FieldDeleteTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldServiceName: %w", err)
+	if m.tenant_id != nil {
+		fields = append(fields, auditchainhead.FieldTenantID)
 	}
-	return oldValue.ServiceName, nil
+	if m.seq != nil {
+		fields = append(fields, auditchainhead.FieldSeq)
+	}
+	if m.hash != nil {
+		fields = append(fields, auditchainhead.FieldHash)
+	}
+	return fields
 }
 
-// ResetServiceName resets all changes to the "service_name" field.
-func (m *AuditLogMutation) ResetServiceName() {
-	m.service_name = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditChainHeadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditchainhead.FieldCreateTime:
+		return m.CreateTime()
+	case auditchainhead.FieldUpdateTime:
+		return m.UpdateTime()
+	case auditchainhead.FieldDeleteTime:
+		return m.DeleteTime()
+	case auditchainhead.FieldTenantID:
+		return m.TenantID()
+	case auditchainhead.FieldSeq:
+		return m.Seq()
+	case auditchainhead.FieldHash:
+		return m.Hash()
+	}
+	return nil, false
 }
 
-// SetClientID sets the "client_id" field.
-func (m *AuditLogMutation) SetClientID(s string) {
-	m.client_id = &s
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditChainHeadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditchainhead.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case auditchainhead.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case auditchainhead.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case auditchainhead.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case auditchainhead.FieldSeq:
+		return m.OldSeq(ctx)
+	case auditchainhead.FieldHash:
+		return m.OldHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditChainHead field %s", name)
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *AuditLogMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditChainHeadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditchainhead.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case auditchainhead.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case auditchainhead.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case auditchainhead.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case auditchainhead.FieldSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeq(v)
+		return nil
+	case auditchainhead.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditChainHead field %s", name)
 }
 
-// OldClientID returns the old "client_id" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldClientID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditChainHeadMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, auditchainhead.FieldTenantID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	if m.addseq != nil {
+		fields = append(fields, auditchainhead.FieldSeq)
 	}
-	return oldValue.ClientID, nil
-}
-
-// ClearClientID clears the value of the "client_id" field.
-func (m *AuditLogMutation) ClearClientID() {
-	m.client_id = nil
-	m.clearedFields[auditlog.FieldClientID] = struct{}{}
-}
-
-// ClientIDCleared returns if the "client_id" field was cleared in this mutation.
-func (m *AuditLogMutation) ClientIDCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldClientID]
-	return ok
-}
-
-// ResetClientID resets all changes to the "client_id" field.
-func (m *AuditLogMutation) ResetClientID() {
-	m.client_id = nil
-	delete(m.clearedFields, auditlog.FieldClientID)
+	return fields
 }
 
-// SetClientCommonName sets the "client_common_name" field.
-func (m *AuditLogMutation) SetClientCommonName(s string) {
-	m.client_common_name = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditChainHeadMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditchainhead.FieldTenantID:
+		return m.AddedTenantID()
+	case auditchainhead.FieldSeq:
+		return m.AddedSeq()
+	}
+	return nil, false
 }
 
-// ClientCommonName returns the value of the "client_common_name" field in the mutation.
-func (m *AuditLogMutation) ClientCommonName() (r string, exists bool) {
-	v := m.client_common_name
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditChainHeadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditchainhead.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case auditchainhead.FieldSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeq(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditChainHead numeric field %s", name)
 }
 
-// OldClientCommonName returns the old "client_common_name" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldClientCommonName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientCommonName is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditChainHeadMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditchainhead.FieldCreateTime) {
+		fields = append(fields, auditchainhead.FieldCreateTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientCommonName requires an ID field in the mutation")
+	if m.FieldCleared(auditchainhead.FieldUpdateTime) {
+		fields = append(fields, auditchainhead.FieldUpdateTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientCommonName: %w", err)
+	if m.FieldCleared(auditchainhead.FieldDeleteTime) {
+		fields = append(fields, auditchainhead.FieldDeleteTime)
 	}
-	return oldValue.ClientCommonName, nil
+	if m.FieldCleared(auditchainhead.FieldHash) {
+		fields = append(fields, auditchainhead.FieldHash)
+	}
+	return fields
 }
 
-// ClearClientCommonName clears the value of the "client_common_name" field.
-func (m *AuditLogMutation) ClearClientCommonName() {
-	m.client_common_name = nil
-	m.clearedFields[auditlog.FieldClientCommonName] = struct{}{}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditChainHeadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ClientCommonNameCleared returns if the "client_common_name" field was cleared in this mutation.
-func (m *AuditLogMutation) ClientCommonNameCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldClientCommonName]
-	return ok
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditChainHeadMutation) ClearField(name string) error {
+	switch name {
+	case auditchainhead.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case auditchainhead.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case auditchainhead.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case auditchainhead.FieldHash:
+		m.ClearHash()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditChainHead nullable field %s", name)
 }
 
-// ResetClientCommonName resets all changes to the "client_common_name" field.
-func (m *AuditLogMutation) ResetClientCommonName() {
-	m.client_common_name = nil
-	delete(m.clearedFields, auditlog.FieldClientCommonName)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditChainHeadMutation) ResetField(name string) error {
+	switch name {
+	case auditchainhead.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case auditchainhead.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case auditchainhead.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case auditchainhead.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case auditchainhead.FieldSeq:
+		m.ResetSeq()
+		return nil
+	case auditchainhead.FieldHash:
+		m.ResetHash()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditChainHead field %s", name)
 }
 
-// SetClientOrganization sets the "client_organization" field.
-func (m *AuditLogMutation) SetClientOrganization(s string) {
-	m.client_organization = &s
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditChainHeadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClientOrganization returns the value of the "client_organization" field in the mutation.
-func (m *AuditLogMutation) ClientOrganization() (r string, exists bool) {
-	v := m.client_organization
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditChainHeadMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldClientOrganization returns the old "client_organization" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldClientOrganization(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientOrganization is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientOrganization requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientOrganization: %w", err)
-	}
-	return oldValue.ClientOrganization, nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditChainHeadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearClientOrganization clears the value of the "client_organization" field.
-func (m *AuditLogMutation) ClearClientOrganization() {
-	m.client_organization = nil
-	m.clearedFields[auditlog.FieldClientOrganization] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditChainHeadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClientOrganizationCleared returns if the "client_organization" field was cleared in this mutation.
-func (m *AuditLogMutation) ClientOrganizationCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldClientOrganization]
-	return ok
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditChainHeadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetClientOrganization resets all changes to the "client_organization" field.
-func (m *AuditLogMutation) ResetClientOrganization() {
-	m.client_organization = nil
-	delete(m.clearedFields, auditlog.FieldClientOrganization)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditChainHeadMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetClientSerialNumber sets the "client_serial_number" field.
-func (m *AuditLogMutation) SetClientSerialNumber(s string) {
-	m.client_serial_number = &s
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditChainHeadMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditChainHead unique edge %s", name)
 }
 
-// ClientSerialNumber returns the value of the "client_serial_number" field in the mutation.
-func (m *AuditLogMutation) ClientSerialNumber() (r string, exists bool) {
-	v := m.client_serial_number
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditChainHeadMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditChainHead edge %s", name)
 }
 
-// OldClientSerialNumber returns the old "client_serial_number" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldClientSerialNumber(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientSerialNumber is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientSerialNumber requires an ID field in the mutation")
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uint32
+	create_time          *time.Time
+	update_time          *time.Time
+	delete_time          *time.Time
+	tenant_id            *uint32
+	addtenant_id         *int32
+	audit_id             *string
+	request_id           *string
+	operation            *string
+	service_name         *string
+	client_id            *string
+	client_common_name   *string
+	client_organization  *string
+	client_serial_number *string
+	is_authenticated     *bool
+	success              *bool
+	error_code           *int32
+	adderror_code        *int32
+	error_message        *string
+	latency_ms           *int64
+	addlatency_ms        *int64
+	peer_address         *string
+	geo_location         *map[string]string
+	log_hash             *string
+	signature            *[]byte
+	metadata             *map[string]string
+	actor_id             *string
+	event_type           *string
+	resource_type        *string
+	resource_id          *string
+	chain_seq            *int64
+	addchain_seq         *int64
+	prev_hash            *string
+	chain_hash           *string
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*AuditLog, error)
+	predicates           []predicate.AuditLog
+}
+
+var _ ent.Mutation = (*AuditLogMutation)(nil)
+
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
+
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditLog,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientSerialNumber: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.ClientSerialNumber, nil
+	return m
 }
 
-// ClearClientSerialNumber clears the value of the "client_serial_number" field.
-func (m *AuditLogMutation) ClearClientSerialNumber() {
-	m.client_serial_number = nil
-	m.clearedFields[auditlog.FieldClientSerialNumber] = struct{}{}
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id uint32) auditlogOption {
+	return func(m *AuditLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditLog
+		)
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// ClientSerialNumberCleared returns if the "client_serial_number" field was cleared in this mutation.
-func (m *AuditLogMutation) ClientSerialNumberCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldClientSerialNumber]
-	return ok
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetClientSerialNumber resets all changes to the "client_serial_number" field.
-func (m *AuditLogMutation) ResetClientSerialNumber() {
-	m.client_serial_number = nil
-	delete(m.clearedFields, auditlog.FieldClientSerialNumber)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetIsAuthenticated sets the "is_authenticated" field.
-func (m *AuditLogMutation) SetIsAuthenticated(b bool) {
-	m.is_authenticated = &b
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// IsAuthenticated returns the value of the "is_authenticated" field in the mutation.
-func (m *AuditLogMutation) IsAuthenticated() (r bool, exists bool) {
-	v := m.is_authenticated
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuditLog entities.
+func (m *AuditLogMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditLogMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *AuditLogMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *AuditLogMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsAuthenticated returns the old "is_authenticated" field's value of the AuditLog entity.
+// OldCreateTime returns the old "create_time" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldIsAuthenticated(ctx context.Context) (v bool, err error) {
+func (m *AuditLogMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsAuthenticated is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsAuthenticated requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsAuthenticated: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.IsAuthenticated, nil
+	return oldValue.CreateTime, nil
 }
 
-// ResetIsAuthenticated resets all changes to the "is_authenticated" field.
-func (m *AuditLogMutation) ResetIsAuthenticated() {
-	m.is_authenticated = nil
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *AuditLogMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[auditlog.FieldCreateTime] = struct{}{}
 }
 
-// SetSuccess sets the "success" field.
-func (m *AuditLogMutation) SetSuccess(b bool) {
-	m.success = &b
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *AuditLogMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldCreateTime]
+	return ok
 }
 
-// Success returns the value of the "success" field in the mutation.
-func (m *AuditLogMutation) Success() (r bool, exists bool) {
-	v := m.success
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *AuditLogMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, auditlog.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *AuditLogMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *AuditLogMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuccess returns the old "success" field's value of the AuditLog entity.
+// OldUpdateTime returns the old "update_time" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+func (m *AuditLogMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuccess requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.Success, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ResetSuccess resets all changes to the "success" field.
-func (m *AuditLogMutation) ResetSuccess() {
-	m.success = nil
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *AuditLogMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[auditlog.FieldUpdateTime] = struct{}{}
 }
 
-// SetErrorCode sets the "error_code" field.
-func (m *AuditLogMutation) SetErrorCode(i int32) {
-	m.error_code = &i
-	m.adderror_code = nil
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *AuditLogMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldUpdateTime]
+	return ok
 }
 
-// ErrorCode returns the value of the "error_code" field in the mutation.
-func (m *AuditLogMutation) ErrorCode() (r int32, exists bool) {
-	v := m.error_code
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *AuditLogMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, auditlog.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *AuditLogMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *AuditLogMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorCode returns the old "error_code" field's value of the AuditLog entity.
+// OldDeleteTime returns the old "delete_time" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldErrorCode(ctx context.Context) (v *int32, err error) {
+func (m *AuditLogMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorCode requires an ID field in the mutation")
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
-	}
-	return oldValue.ErrorCode, nil
-}
-
-// AddErrorCode adds i to the "error_code" field.
-func (m *AuditLogMutation) AddErrorCode(i int32) {
-	if m.adderror_code != nil {
-		*m.adderror_code += i
-	} else {
-		m.adderror_code = &i
-	}
-}
-
-// AddedErrorCode returns the value that was added to the "error_code" field in this mutation.
-func (m *AuditLogMutation) AddedErrorCode() (r int32, exists bool) {
-	v := m.adderror_code
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
 	}
-	return *v, true
+	return oldValue.DeleteTime, nil
 }
 
-// ClearErrorCode clears the value of the "error_code" field.
-func (m *AuditLogMutation) ClearErrorCode() {
-	m.error_code = nil
-	m.adderror_code = nil
-	m.clearedFields[auditlog.FieldErrorCode] = struct{}{}
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *AuditLogMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[auditlog.FieldDeleteTime] = struct{}{}
 }
 
-// ErrorCodeCleared returns if the "error_code" field was cleared in this mutation.
-func (m *AuditLogMutation) ErrorCodeCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldErrorCode]
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *AuditLogMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldDeleteTime]
 	return ok
 }
 
-// ResetErrorCode resets all changes to the "error_code" field.
-func (m *AuditLogMutation) ResetErrorCode() {
-	m.error_code = nil
-	m.adderror_code = nil
-	delete(m.clearedFields, auditlog.FieldErrorCode)
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *AuditLogMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, auditlog.FieldDeleteTime)
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *AuditLogMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *AuditLogMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *AuditLogMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *AuditLogMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the AuditLog entity.
+// OldTenantID returns the old "tenant_id" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
+	return oldValue.TenantID, nil
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *AuditLogMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[auditlog.FieldErrorMessage] = struct{}{}
+// AddTenantID adds u to the "tenant_id" field.
+func (m *AuditLogMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *AuditLogMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldErrorMessage]
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *AuditLogMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *AuditLogMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[auditlog.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *AuditLogMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTenantID]
 	return ok
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *AuditLogMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, auditlog.FieldErrorMessage)
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *AuditLogMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, auditlog.FieldTenantID)
 }
 
-// SetLatencyMs sets the "latency_ms" field.
-func (m *AuditLogMutation) SetLatencyMs(i int64) {
-	m.latency_ms = &i
-	m.addlatency_ms = nil
+// SetAuditID sets the "audit_id" field.
+func (m *AuditLogMutation) SetAuditID(s string) {
+	m.audit_id = &s
 }
 
-// LatencyMs returns the value of the "latency_ms" field in the mutation.
-func (m *AuditLogMutation) LatencyMs() (r int64, exists bool) {
-	v := m.latency_ms
+// AuditID returns the value of the "audit_id" field in the mutation.
+func (m *AuditLogMutation) AuditID() (r string, exists bool) {
+	v := m.audit_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLatencyMs returns the old "latency_ms" field's value of the AuditLog entity.
+// OldAuditID returns the old "audit_id" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
+func (m *AuditLogMutation) OldAuditID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuditID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+		return v, errors.New("OldAuditID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
-	}
-	return oldValue.LatencyMs, nil
-}
-
-// AddLatencyMs adds i to the "latency_ms" field.
-func (m *AuditLogMutation) AddLatencyMs(i int64) {
-	if m.addlatency_ms != nil {
-		*m.addlatency_ms += i
-	} else {
-		m.addlatency_ms = &i
-	}
-}
-
-// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
-func (m *AuditLogMutation) AddedLatencyMs() (r int64, exists bool) {
-	v := m.addlatency_ms
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAuditID: %w", err)
 	}
-	return *v, true
+	return oldValue.AuditID, nil
 }
 
-// ResetLatencyMs resets all changes to the "latency_ms" field.
-func (m *AuditLogMutation) ResetLatencyMs() {
-	m.latency_ms = nil
-	m.addlatency_ms = nil
+// ResetAuditID resets all changes to the "audit_id" field.
+func (m *AuditLogMutation) ResetAuditID() {
+	m.audit_id = nil
 }
 
-// SetPeerAddress sets the "peer_address" field.
-func (m *AuditLogMutation) SetPeerAddress(s string) {
-	m.peer_address = &s
+// SetRequestID sets the "request_id" field.
+func (m *AuditLogMutation) SetRequestID(s string) {
+	m.request_id = &s
 }
 
-// PeerAddress returns the value of the "peer_address" field in the mutation.
-func (m *AuditLogMutation) PeerAddress() (r string, exists bool) {
-	v := m.peer_address
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *AuditLogMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPeerAddress returns the old "peer_address" field's value of the AuditLog entity.
+// OldRequestID returns the old "request_id" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldPeerAddress(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldRequestID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPeerAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPeerAddress requires an ID field in the mutation")
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPeerAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
 	}
-	return oldValue.PeerAddress, nil
+	return oldValue.RequestID, nil
 }
 
-// ClearPeerAddress clears the value of the "peer_address" field.
-func (m *AuditLogMutation) ClearPeerAddress() {
-	m.peer_address = nil
-	m.clearedFields[auditlog.FieldPeerAddress] = struct{}{}
+// ClearRequestID clears the value of the "request_id" field.
+func (m *AuditLogMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[auditlog.FieldRequestID] = struct{}{}
 }
 
-// PeerAddressCleared returns if the "peer_address" field was cleared in this mutation.
-func (m *AuditLogMutation) PeerAddressCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldPeerAddress]
+// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
+func (m *AuditLogMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldRequestID]
 	return ok
 }
 
-// ResetPeerAddress resets all changes to the "peer_address" field.
-func (m *AuditLogMutation) ResetPeerAddress() {
-	m.peer_address = nil
-	delete(m.clearedFields, auditlog.FieldPeerAddress)
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *AuditLogMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, auditlog.FieldRequestID)
 }
 
-// SetGeoLocation sets the "geo_location" field.
-func (m *AuditLogMutation) SetGeoLocation(value map[string]string) {
-	m.geo_location = &value
+// SetOperation sets the "operation" field.
+func (m *AuditLogMutation) SetOperation(s string) {
+	m.operation = &s
 }
 
-// GeoLocation returns the value of the "geo_location" field in the mutation.
-func (m *AuditLogMutation) GeoLocation() (r map[string]string, exists bool) {
-	v := m.geo_location
+// Operation returns the value of the "operation" field in the mutation.
+func (m *AuditLogMutation) Operation() (r string, exists bool) {
+	v := m.operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGeoLocation returns the old "geo_location" field's value of the AuditLog entity.
+// OldOperation returns the old "operation" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldGeoLocation(ctx context.Context) (v map[string]string, err error) {
+func (m *AuditLogMutation) OldOperation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGeoLocation is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGeoLocation requires an ID field in the mutation")
+		return v, errors.New("OldOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGeoLocation: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
 	}
-	return oldValue.GeoLocation, nil
-}
-
-// ClearGeoLocation clears the value of the "geo_location" field.
-func (m *AuditLogMutation) ClearGeoLocation() {
-	m.geo_location = nil
-	m.clearedFields[auditlog.FieldGeoLocation] = struct{}{}
+	return oldValue.Operation, nil
 }
 
-// GeoLocationCleared returns if the "geo_location" field was cleared in this mutation.
-func (m *AuditLogMutation) GeoLocationCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldGeoLocation]
-	return ok
+// ResetOperation resets all changes to the "operation" field.
+func (m *AuditLogMutation) ResetOperation() {
+	m.operation = nil
 }
 
-// ResetGeoLocation resets all changes to the "geo_location" field.
-func (m *AuditLogMutation) ResetGeoLocation() {
-	m.geo_location = nil
-	delete(m.clearedFields, auditlog.FieldGeoLocation)
+// SetServiceName sets the "service_name" field.
+func (m *AuditLogMutation) SetServiceName(s string) {
+	m.service_name = &s
 }
 
-// SetLogHash sets the "log_hash" field.
-func (m *AuditLogMutation) SetLogHash(s string) {
-	m.log_hash = &s
+// ServiceName returns the value of the "service_name" field in the mutation.
+func (m *AuditLogMutation) ServiceName() (r string, exists bool) {
+	v := m.service_name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// LogHash returns the value of the "log_hash" field in the mutation.
-func (m *AuditLogMutation) LogHash() (r string, exists bool) {
-	v := m.log_hash
+// OldServiceName returns the old "service_name" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldServiceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceName: %w", err)
+	}
+	return oldValue.ServiceName, nil
+}
+
+// ResetServiceName resets all changes to the "service_name" field.
+func (m *AuditLogMutation) ResetServiceName() {
+	m.service_name = nil
+}
+
+// SetClientID sets the "client_id" field.
+func (m *AuditLogMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *AuditLogMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLogHash returns the old "log_hash" field's value of the AuditLog entity.
+// OldClientID returns the old "client_id" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldLogHash(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLogHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLogHash requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLogHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return oldValue.LogHash, nil
+	return oldValue.ClientID, nil
 }
 
-// ClearLogHash clears the value of the "log_hash" field.
-func (m *AuditLogMutation) ClearLogHash() {
-	m.log_hash = nil
-	m.clearedFields[auditlog.FieldLogHash] = struct{}{}
+// ClearClientID clears the value of the "client_id" field.
+func (m *AuditLogMutation) ClearClientID() {
+	m.client_id = nil
+	m.clearedFields[auditlog.FieldClientID] = struct{}{}
 }
 
-// LogHashCleared returns if the "log_hash" field was cleared in this mutation.
-func (m *AuditLogMutation) LogHashCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldLogHash]
+// ClientIDCleared returns if the "client_id" field was cleared in this mutation.
+func (m *AuditLogMutation) ClientIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldClientID]
 	return ok
 }
 
-// ResetLogHash resets all changes to the "log_hash" field.
-func (m *AuditLogMutation) ResetLogHash() {
-	m.log_hash = nil
-	delete(m.clearedFields, auditlog.FieldLogHash)
+// ResetClientID resets all changes to the "client_id" field.
+func (m *AuditLogMutation) ResetClientID() {
+	m.client_id = nil
+	delete(m.clearedFields, auditlog.FieldClientID)
 }
 
-// SetSignature sets the "signature" field.
-func (m *AuditLogMutation) SetSignature(b []byte) {
-	m.signature = &b
+// SetClientCommonName sets the "client_common_name" field.
+func (m *AuditLogMutation) SetClientCommonName(s string) {
+	m.client_common_name = &s
 }
 
-// Signature returns the value of the "signature" field in the mutation.
-func (m *AuditLogMutation) Signature() (r []byte, exists bool) {
-	v := m.signature
+// ClientCommonName returns the value of the "client_common_name" field in the mutation.
+func (m *AuditLogMutation) ClientCommonName() (r string, exists bool) {
+	v := m.client_common_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSignature returns the old "signature" field's value of the AuditLog entity.
+// OldClientCommonName returns the old "client_common_name" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldSignature(ctx context.Context) (v []byte, err error) {
+func (m *AuditLogMutation) OldClientCommonName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientCommonName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSignature requires an ID field in the mutation")
+		return v, errors.New("OldClientCommonName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientCommonName: %w", err)
 	}
-	return oldValue.Signature, nil
+	return oldValue.ClientCommonName, nil
 }
 
-// ClearSignature clears the value of the "signature" field.
-func (m *AuditLogMutation) ClearSignature() {
-	m.signature = nil
-	m.clearedFields[auditlog.FieldSignature] = struct{}{}
+// ClearClientCommonName clears the value of the "client_common_name" field.
+func (m *AuditLogMutation) ClearClientCommonName() {
+	m.client_common_name = nil
+	m.clearedFields[auditlog.FieldClientCommonName] = struct{}{}
 }
 
-// SignatureCleared returns if the "signature" field was cleared in this mutation.
-func (m *AuditLogMutation) SignatureCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldSignature]
+// ClientCommonNameCleared returns if the "client_common_name" field was cleared in this mutation.
+func (m *AuditLogMutation) ClientCommonNameCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldClientCommonName]
 	return ok
 }
 
-// ResetSignature resets all changes to the "signature" field.
-func (m *AuditLogMutation) ResetSignature() {
-	m.signature = nil
-	delete(m.clearedFields, auditlog.FieldSignature)
+// ResetClientCommonName resets all changes to the "client_common_name" field.
+func (m *AuditLogMutation) ResetClientCommonName() {
+	m.client_common_name = nil
+	delete(m.clearedFields, auditlog.FieldClientCommonName)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *AuditLogMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetClientOrganization sets the "client_organization" field.
+func (m *AuditLogMutation) SetClientOrganization(s string) {
+	m.client_organization = &s
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *AuditLogMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// ClientOrganization returns the value of the "client_organization" field in the mutation.
+func (m *AuditLogMutation) ClientOrganization() (r string, exists bool) {
+	v := m.client_organization
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the AuditLog entity.
+// OldClientOrganization returns the old "client_organization" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *AuditLogMutation) OldClientOrganization(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientOrganization is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldClientOrganization requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientOrganization: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.ClientOrganization, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *AuditLogMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[auditlog.FieldMetadata] = struct{}{}
+// ClearClientOrganization clears the value of the "client_organization" field.
+func (m *AuditLogMutation) ClearClientOrganization() {
+	m.client_organization = nil
+	m.clearedFields[auditlog.FieldClientOrganization] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *AuditLogMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldMetadata]
+// ClientOrganizationCleared returns if the "client_organization" field was cleared in this mutation.
+func (m *AuditLogMutation) ClientOrganizationCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldClientOrganization]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *AuditLogMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, auditlog.FieldMetadata)
+// ResetClientOrganization resets all changes to the "client_organization" field.
+func (m *AuditLogMutation) ResetClientOrganization() {
+	m.client_organization = nil
+	delete(m.clearedFields, auditlog.FieldClientOrganization)
 }
 
-// Where appends a list predicates to the AuditLogMutation builder.
-func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
-	m.predicates = append(m.predicates, ps...)
+// SetClientSerialNumber sets the "client_serial_number" field.
+func (m *AuditLogMutation) SetClientSerialNumber(s string) {
+	m.client_serial_number = &s
 }
 
-// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuditLog, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ClientSerialNumber returns the value of the "client_serial_number" field in the mutation.
+func (m *AuditLogMutation) ClientSerialNumber() (r string, exists bool) {
+	v := m.client_serial_number
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *AuditLogMutation) Op() Op {
-	return m.op
+// OldClientSerialNumber returns the old "client_serial_number" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldClientSerialNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientSerialNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientSerialNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientSerialNumber: %w", err)
+	}
+	return oldValue.ClientSerialNumber, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *AuditLogMutation) SetOp(op Op) {
-	m.op = op
+// ClearClientSerialNumber clears the value of the "client_serial_number" field.
+func (m *AuditLogMutation) ClearClientSerialNumber() {
+	m.client_serial_number = nil
+	m.clearedFields[auditlog.FieldClientSerialNumber] = struct{}{}
 }
 
-// Type returns the node type of this mutation (AuditLog).
-func (m *AuditLogMutation) Type() string {
-	return m.typ
+// ClientSerialNumberCleared returns if the "client_serial_number" field was cleared in this mutation.
+func (m *AuditLogMutation) ClientSerialNumberCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldClientSerialNumber]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *AuditLogMutation) Fields() []string {
-	fields := make([]string, 0, 22)
-	if m.create_time != nil {
-		fields = append(fields, auditlog.FieldCreateTime)
+// ResetClientSerialNumber resets all changes to the "client_serial_number" field.
+func (m *AuditLogMutation) ResetClientSerialNumber() {
+	m.client_serial_number = nil
+	delete(m.clearedFields, auditlog.FieldClientSerialNumber)
+}
+
+// SetIsAuthenticated sets the "is_authenticated" field.
+func (m *AuditLogMutation) SetIsAuthenticated(b bool) {
+	m.is_authenticated = &b
+}
+
+// IsAuthenticated returns the value of the "is_authenticated" field in the mutation.
+func (m *AuditLogMutation) IsAuthenticated() (r bool, exists bool) {
+	v := m.is_authenticated
+	if v == nil {
+		return
 	}
-	if m.update_time != nil {
-		fields = append(fields, auditlog.FieldUpdateTime)
+	return *v, true
+}
+
+// OldIsAuthenticated returns the old "is_authenticated" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldIsAuthenticated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAuthenticated is only allowed on UpdateOne operations")
 	}
-	if m.delete_time != nil {
-		fields = append(fields, auditlog.FieldDeleteTime)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAuthenticated requires an ID field in the mutation")
 	}
-	if m.tenant_id != nil {
-		fields = append(fields, auditlog.FieldTenantID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAuthenticated: %w", err)
+	}
+	return oldValue.IsAuthenticated, nil
+}
+
+// ResetIsAuthenticated resets all changes to the "is_authenticated" field.
+func (m *AuditLogMutation) ResetIsAuthenticated() {
+	m.is_authenticated = nil
+}
+
+// SetSuccess sets the "success" field.
+func (m *AuditLogMutation) SetSuccess(b bool) {
+	m.success = &b
+}
+
+// Success returns the value of the "success" field in the mutation.
+func (m *AuditLogMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccess returns the old "success" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
+}
+
+// ResetSuccess resets all changes to the "success" field.
+func (m *AuditLogMutation) ResetSuccess() {
+	m.success = nil
+}
+
+// SetErrorCode sets the "error_code" field.
+func (m *AuditLogMutation) SetErrorCode(i int32) {
+	m.error_code = &i
+	m.adderror_code = nil
+}
+
+// ErrorCode returns the value of the "error_code" field in the mutation.
+func (m *AuditLogMutation) ErrorCode() (r int32, exists bool) {
+	v := m.error_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCode returns the old "error_code" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldErrorCode(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
+	}
+	return oldValue.ErrorCode, nil
+}
+
+// AddErrorCode adds i to the "error_code" field.
+func (m *AuditLogMutation) AddErrorCode(i int32) {
+	if m.adderror_code != nil {
+		*m.adderror_code += i
+	} else {
+		m.adderror_code = &i
+	}
+}
+
+// AddedErrorCode returns the value that was added to the "error_code" field in this mutation.
+func (m *AuditLogMutation) AddedErrorCode() (r int32, exists bool) {
+	v := m.adderror_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (m *AuditLogMutation) ClearErrorCode() {
+	m.error_code = nil
+	m.adderror_code = nil
+	m.clearedFields[auditlog.FieldErrorCode] = struct{}{}
+}
+
+// ErrorCodeCleared returns if the "error_code" field was cleared in this mutation.
+func (m *AuditLogMutation) ErrorCodeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldErrorCode]
+	return ok
+}
+
+// ResetErrorCode resets all changes to the "error_code" field.
+func (m *AuditLogMutation) ResetErrorCode() {
+	m.error_code = nil
+	m.adderror_code = nil
+	delete(m.clearedFields, auditlog.FieldErrorCode)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *AuditLogMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *AuditLogMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *AuditLogMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[auditlog.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *AuditLogMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *AuditLogMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, auditlog.FieldErrorMessage)
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (m *AuditLogMutation) SetLatencyMs(i int64) {
+	m.latency_ms = &i
+	m.addlatency_ms = nil
+}
+
+// LatencyMs returns the value of the "latency_ms" field in the mutation.
+func (m *AuditLogMutation) LatencyMs() (r int64, exists bool) {
+	v := m.latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyMs returns the old "latency_ms" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
+	}
+	return oldValue.LatencyMs, nil
+}
+
+// AddLatencyMs adds i to the "latency_ms" field.
+func (m *AuditLogMutation) AddLatencyMs(i int64) {
+	if m.addlatency_ms != nil {
+		*m.addlatency_ms += i
+	} else {
+		m.addlatency_ms = &i
+	}
+}
+
+// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
+func (m *AuditLogMutation) AddedLatencyMs() (r int64, exists bool) {
+	v := m.addlatency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatencyMs resets all changes to the "latency_ms" field.
+func (m *AuditLogMutation) ResetLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
+}
+
+// SetPeerAddress sets the "peer_address" field.
+func (m *AuditLogMutation) SetPeerAddress(s string) {
+	m.peer_address = &s
+}
+
+// PeerAddress returns the value of the "peer_address" field in the mutation.
+func (m *AuditLogMutation) PeerAddress() (r string, exists bool) {
+	v := m.peer_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeerAddress returns the old "peer_address" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldPeerAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeerAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeerAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeerAddress: %w", err)
+	}
+	return oldValue.PeerAddress, nil
+}
+
+// ClearPeerAddress clears the value of the "peer_address" field.
+func (m *AuditLogMutation) ClearPeerAddress() {
+	m.peer_address = nil
+	m.clearedFields[auditlog.FieldPeerAddress] = struct{}{}
+}
+
+// PeerAddressCleared returns if the "peer_address" field was cleared in this mutation.
+func (m *AuditLogMutation) PeerAddressCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldPeerAddress]
+	return ok
+}
+
+// ResetPeerAddress resets all changes to the "peer_address" field.
+func (m *AuditLogMutation) ResetPeerAddress() {
+	m.peer_address = nil
+	delete(m.clearedFields, auditlog.FieldPeerAddress)
+}
+
+// SetGeoLocation sets the "geo_location" field.
+func (m *AuditLogMutation) SetGeoLocation(value map[string]string) {
+	m.geo_location = &value
+}
+
+// GeoLocation returns the value of the "geo_location" field in the mutation.
+func (m *AuditLogMutation) GeoLocation() (r map[string]string, exists bool) {
+	v := m.geo_location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeoLocation returns the old "geo_location" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldGeoLocation(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeoLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeoLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeoLocation: %w", err)
+	}
+	return oldValue.GeoLocation, nil
+}
+
+// ClearGeoLocation clears the value of the "geo_location" field.
+func (m *AuditLogMutation) ClearGeoLocation() {
+	m.geo_location = nil
+	m.clearedFields[auditlog.FieldGeoLocation] = struct{}{}
+}
+
+// GeoLocationCleared returns if the "geo_location" field was cleared in this mutation.
+func (m *AuditLogMutation) GeoLocationCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldGeoLocation]
+	return ok
+}
+
+// ResetGeoLocation resets all changes to the "geo_location" field.
+func (m *AuditLogMutation) ResetGeoLocation() {
+	m.geo_location = nil
+	delete(m.clearedFields, auditlog.FieldGeoLocation)
+}
+
+// SetLogHash sets the "log_hash" field.
+func (m *AuditLogMutation) SetLogHash(s string) {
+	m.log_hash = &s
+}
+
+// LogHash returns the value of the "log_hash" field in the mutation.
+func (m *AuditLogMutation) LogHash() (r string, exists bool) {
+	v := m.log_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogHash returns the old "log_hash" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldLogHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogHash: %w", err)
+	}
+	return oldValue.LogHash, nil
+}
+
+// ClearLogHash clears the value of the "log_hash" field.
+func (m *AuditLogMutation) ClearLogHash() {
+	m.log_hash = nil
+	m.clearedFields[auditlog.FieldLogHash] = struct{}{}
+}
+
+// LogHashCleared returns if the "log_hash" field was cleared in this mutation.
+func (m *AuditLogMutation) LogHashCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldLogHash]
+	return ok
+}
+
+// ResetLogHash resets all changes to the "log_hash" field.
+func (m *AuditLogMutation) ResetLogHash() {
+	m.log_hash = nil
+	delete(m.clearedFields, auditlog.FieldLogHash)
+}
+
+// SetSignature sets the "signature" field.
+func (m *AuditLogMutation) SetSignature(b []byte) {
+	m.signature = &b
+}
+
+// Signature returns the value of the "signature" field in the mutation.
+func (m *AuditLogMutation) Signature() (r []byte, exists bool) {
+	v := m.signature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignature returns the old "signature" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldSignature(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+	}
+	return oldValue.Signature, nil
+}
+
+// ClearSignature clears the value of the "signature" field.
+func (m *AuditLogMutation) ClearSignature() {
+	m.signature = nil
+	m.clearedFields[auditlog.FieldSignature] = struct{}{}
+}
+
+// SignatureCleared returns if the "signature" field was cleared in this mutation.
+func (m *AuditLogMutation) SignatureCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldSignature]
+	return ok
+}
+
+// ResetSignature resets all changes to the "signature" field.
+func (m *AuditLogMutation) ResetSignature() {
+	m.signature = nil
+	delete(m.clearedFields, auditlog.FieldSignature)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *AuditLogMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *AuditLogMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *AuditLogMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[auditlog.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *AuditLogMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *AuditLogMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, auditlog.FieldMetadata)
+}
+
+// SetActorID sets the "actor_id" field.
+func (m *AuditLogMutation) SetActorID(s string) {
+	m.actor_id = &s
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *AuditLogMutation) ActorID() (r string, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldActorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (m *AuditLogMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[auditlog.FieldActorID] = struct{}{}
+}
+
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *AuditLogMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldActorID]
+	return ok
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *AuditLogMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, auditlog.FieldActorID)
+}
+
+// SetEventType sets the "event_type" field.
+func (m *AuditLogMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *AuditLogMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (m *AuditLogMutation) ClearEventType() {
+	m.event_type = nil
+	m.clearedFields[auditlog.FieldEventType] = struct{}{}
+}
+
+// EventTypeCleared returns if the "event_type" field was cleared in this mutation.
+func (m *AuditLogMutation) EventTypeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldEventType]
+	return ok
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *AuditLogMutation) ResetEventType() {
+	m.event_type = nil
+	delete(m.clearedFields, auditlog.FieldEventType)
+}
+
+// SetResourceType sets the "resource_type" field.
+func (m *AuditLogMutation) SetResourceType(s string) {
+	m.resource_type = &s
+}
+
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *AuditLogMutation) ResourceType() (r string, exists bool) {
+	v := m.resource_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceType returns the old "resource_type" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldResourceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+	}
+	return oldValue.ResourceType, nil
+}
+
+// ClearResourceType clears the value of the "resource_type" field.
+func (m *AuditLogMutation) ClearResourceType() {
+	m.resource_type = nil
+	m.clearedFields[auditlog.FieldResourceType] = struct{}{}
+}
+
+// ResourceTypeCleared returns if the "resource_type" field was cleared in this mutation.
+func (m *AuditLogMutation) ResourceTypeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldResourceType]
+	return ok
+}
+
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *AuditLogMutation) ResetResourceType() {
+	m.resource_type = nil
+	delete(m.clearedFields, auditlog.FieldResourceType)
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *AuditLogMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *AuditLogMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (m *AuditLogMutation) ClearResourceID() {
+	m.resource_id = nil
+	m.clearedFields[auditlog.FieldResourceID] = struct{}{}
+}
+
+// ResourceIDCleared returns if the "resource_id" field was cleared in this mutation.
+func (m *AuditLogMutation) ResourceIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldResourceID]
+	return ok
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *AuditLogMutation) ResetResourceID() {
+	m.resource_id = nil
+	delete(m.clearedFields, auditlog.FieldResourceID)
+}
+
+// SetChainSeq sets the "chain_seq" field.
+func (m *AuditLogMutation) SetChainSeq(i int64) {
+	m.chain_seq = &i
+	m.addchain_seq = nil
+}
+
+// ChainSeq returns the value of the "chain_seq" field in the mutation.
+func (m *AuditLogMutation) ChainSeq() (r int64, exists bool) {
+	v := m.chain_seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChainSeq returns the old "chain_seq" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldChainSeq(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChainSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChainSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChainSeq: %w", err)
+	}
+	return oldValue.ChainSeq, nil
+}
+
+// AddChainSeq adds i to the "chain_seq" field.
+func (m *AuditLogMutation) AddChainSeq(i int64) {
+	if m.addchain_seq != nil {
+		*m.addchain_seq += i
+	} else {
+		m.addchain_seq = &i
+	}
+}
+
+// AddedChainSeq returns the value that was added to the "chain_seq" field in this mutation.
+func (m *AuditLogMutation) AddedChainSeq() (r int64, exists bool) {
+	v := m.addchain_seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChainSeq resets all changes to the "chain_seq" field.
+func (m *AuditLogMutation) ResetChainSeq() {
+	m.chain_seq = nil
+	m.addchain_seq = nil
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (m *AuditLogMutation) SetPrevHash(s string) {
+	m.prev_hash = &s
+}
+
+// PrevHash returns the value of the "prev_hash" field in the mutation.
+func (m *AuditLogMutation) PrevHash() (r string, exists bool) {
+	v := m.prev_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrevHash returns the old "prev_hash" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldPrevHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrevHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrevHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrevHash: %w", err)
+	}
+	return oldValue.PrevHash, nil
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (m *AuditLogMutation) ClearPrevHash() {
+	m.prev_hash = nil
+	m.clearedFields[auditlog.FieldPrevHash] = struct{}{}
+}
+
+// PrevHashCleared returns if the "prev_hash" field was cleared in this mutation.
+func (m *AuditLogMutation) PrevHashCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldPrevHash]
+	return ok
+}
+
+// ResetPrevHash resets all changes to the "prev_hash" field.
+func (m *AuditLogMutation) ResetPrevHash() {
+	m.prev_hash = nil
+	delete(m.clearedFields, auditlog.FieldPrevHash)
+}
+
+// SetChainHash sets the "chain_hash" field.
+func (m *AuditLogMutation) SetChainHash(s string) {
+	m.chain_hash = &s
+}
+
+// ChainHash returns the value of the "chain_hash" field in the mutation.
+func (m *AuditLogMutation) ChainHash() (r string, exists bool) {
+	v := m.chain_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChainHash returns the old "chain_hash" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldChainHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChainHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChainHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChainHash: %w", err)
+	}
+	return oldValue.ChainHash, nil
+}
+
+// ClearChainHash clears the value of the "chain_hash" field.
+func (m *AuditLogMutation) ClearChainHash() {
+	m.chain_hash = nil
+	m.clearedFields[auditlog.FieldChainHash] = struct{}{}
+}
+
+// ChainHashCleared returns if the "chain_hash" field was cleared in this mutation.
+func (m *AuditLogMutation) ChainHashCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldChainHash]
+	return ok
+}
+
+// ResetChainHash resets all changes to the "chain_hash" field.
+func (m *AuditLogMutation) ResetChainHash() {
+	m.chain_hash = nil
+	delete(m.clearedFields, auditlog.FieldChainHash)
+}
+
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 29)
+	if m.create_time != nil {
+		fields = append(fields, auditlog.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, auditlog.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, auditlog.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, auditlog.FieldTenantID)
+	}
+	if m.audit_id != nil {
+		fields = append(fields, auditlog.FieldAuditID)
+	}
+	if m.request_id != nil {
+		fields = append(fields, auditlog.FieldRequestID)
+	}
+	if m.operation != nil {
+		fields = append(fields, auditlog.FieldOperation)
+	}
+	if m.service_name != nil {
+		fields = append(fields, auditlog.FieldServiceName)
+	}
+	if m.client_id != nil {
+		fields = append(fields, auditlog.FieldClientID)
+	}
+	if m.client_common_name != nil {
+		fields = append(fields, auditlog.FieldClientCommonName)
+	}
+	if m.client_organization != nil {
+		fields = append(fields, auditlog.FieldClientOrganization)
+	}
+	if m.client_serial_number != nil {
+		fields = append(fields, auditlog.FieldClientSerialNumber)
+	}
+	if m.is_authenticated != nil {
+		fields = append(fields, auditlog.FieldIsAuthenticated)
+	}
+	if m.success != nil {
+		fields = append(fields, auditlog.FieldSuccess)
+	}
+	if m.error_code != nil {
+		fields = append(fields, auditlog.FieldErrorCode)
+	}
+	if m.error_message != nil {
+		fields = append(fields, auditlog.FieldErrorMessage)
+	}
+	if m.latency_ms != nil {
+		fields = append(fields, auditlog.FieldLatencyMs)
+	}
+	if m.peer_address != nil {
+		fields = append(fields, auditlog.FieldPeerAddress)
+	}
+	if m.geo_location != nil {
+		fields = append(fields, auditlog.FieldGeoLocation)
+	}
+	if m.log_hash != nil {
+		fields = append(fields, auditlog.FieldLogHash)
+	}
+	if m.signature != nil {
+		fields = append(fields, auditlog.FieldSignature)
+	}
+	if m.metadata != nil {
+		fields = append(fields, auditlog.FieldMetadata)
+	}
+	if m.actor_id != nil {
+		fields = append(fields, auditlog.FieldActorID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, auditlog.FieldEventType)
+	}
+	if m.resource_type != nil {
+		fields = append(fields, auditlog.FieldResourceType)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, auditlog.FieldResourceID)
+	}
+	if m.chain_seq != nil {
+		fields = append(fields, auditlog.FieldChainSeq)
+	}
+	if m.prev_hash != nil {
+		fields = append(fields, auditlog.FieldPrevHash)
+	}
+	if m.chain_hash != nil {
+		fields = append(fields, auditlog.FieldChainHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldCreateTime:
+		return m.CreateTime()
+	case auditlog.FieldUpdateTime:
+		return m.UpdateTime()
+	case auditlog.FieldDeleteTime:
+		return m.DeleteTime()
+	case auditlog.FieldTenantID:
+		return m.TenantID()
+	case auditlog.FieldAuditID:
+		return m.AuditID()
+	case auditlog.FieldRequestID:
+		return m.RequestID()
+	case auditlog.FieldOperation:
+		return m.Operation()
+	case auditlog.FieldServiceName:
+		return m.ServiceName()
+	case auditlog.FieldClientID:
+		return m.ClientID()
+	case auditlog.FieldClientCommonName:
+		return m.ClientCommonName()
+	case auditlog.FieldClientOrganization:
+		return m.ClientOrganization()
+	case auditlog.FieldClientSerialNumber:
+		return m.ClientSerialNumber()
+	case auditlog.FieldIsAuthenticated:
+		return m.IsAuthenticated()
+	case auditlog.FieldSuccess:
+		return m.Success()
+	case auditlog.FieldErrorCode:
+		return m.ErrorCode()
+	case auditlog.FieldErrorMessage:
+		return m.ErrorMessage()
+	case auditlog.FieldLatencyMs:
+		return m.LatencyMs()
+	case auditlog.FieldPeerAddress:
+		return m.PeerAddress()
+	case auditlog.FieldGeoLocation:
+		return m.GeoLocation()
+	case auditlog.FieldLogHash:
+		return m.LogHash()
+	case auditlog.FieldSignature:
+		return m.Signature()
+	case auditlog.FieldMetadata:
+		return m.Metadata()
+	case auditlog.FieldActorID:
+		return m.ActorID()
+	case auditlog.FieldEventType:
+		return m.EventType()
+	case auditlog.FieldResourceType:
+		return m.ResourceType()
+	case auditlog.FieldResourceID:
+		return m.ResourceID()
+	case auditlog.FieldChainSeq:
+		return m.ChainSeq()
+	case auditlog.FieldPrevHash:
+		return m.PrevHash()
+	case auditlog.FieldChainHash:
+		return m.ChainHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditlog.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case auditlog.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case auditlog.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case auditlog.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case auditlog.FieldAuditID:
+		return m.OldAuditID(ctx)
+	case auditlog.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case auditlog.FieldOperation:
+		return m.OldOperation(ctx)
+	case auditlog.FieldServiceName:
+		return m.OldServiceName(ctx)
+	case auditlog.FieldClientID:
+		return m.OldClientID(ctx)
+	case auditlog.FieldClientCommonName:
+		return m.OldClientCommonName(ctx)
+	case auditlog.FieldClientOrganization:
+		return m.OldClientOrganization(ctx)
+	case auditlog.FieldClientSerialNumber:
+		return m.OldClientSerialNumber(ctx)
+	case auditlog.FieldIsAuthenticated:
+		return m.OldIsAuthenticated(ctx)
+	case auditlog.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case auditlog.FieldErrorCode:
+		return m.OldErrorCode(ctx)
+	case auditlog.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case auditlog.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case auditlog.FieldPeerAddress:
+		return m.OldPeerAddress(ctx)
+	case auditlog.FieldGeoLocation:
+		return m.OldGeoLocation(ctx)
+	case auditlog.FieldLogHash:
+		return m.OldLogHash(ctx)
+	case auditlog.FieldSignature:
+		return m.OldSignature(ctx)
+	case auditlog.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case auditlog.FieldActorID:
+		return m.OldActorID(ctx)
+	case auditlog.FieldEventType:
+		return m.OldEventType(ctx)
+	case auditlog.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case auditlog.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case auditlog.FieldChainSeq:
+		return m.OldChainSeq(ctx)
+	case auditlog.FieldPrevHash:
+		return m.OldPrevHash(ctx)
+	case auditlog.FieldChainHash:
+		return m.OldChainHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case auditlog.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case auditlog.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case auditlog.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case auditlog.FieldAuditID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuditID(v)
+		return nil
+	case auditlog.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case auditlog.FieldOperation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case auditlog.FieldServiceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceName(v)
+		return nil
+	case auditlog.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case auditlog.FieldClientCommonName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientCommonName(v)
+		return nil
+	case auditlog.FieldClientOrganization:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientOrganization(v)
+		return nil
+	case auditlog.FieldClientSerialNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientSerialNumber(v)
+		return nil
+	case auditlog.FieldIsAuthenticated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAuthenticated(v)
+		return nil
+	case auditlog.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case auditlog.FieldErrorCode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCode(v)
+		return nil
+	case auditlog.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case auditlog.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyMs(v)
+		return nil
+	case auditlog.FieldPeerAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeerAddress(v)
+		return nil
+	case auditlog.FieldGeoLocation:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeoLocation(v)
+		return nil
+	case auditlog.FieldLogHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogHash(v)
+		return nil
+	case auditlog.FieldSignature:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignature(v)
+		return nil
+	case auditlog.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case auditlog.FieldActorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
+	case auditlog.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case auditlog.FieldResourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceType(v)
+		return nil
+	case auditlog.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case auditlog.FieldChainSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChainSeq(v)
+		return nil
+	case auditlog.FieldPrevHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrevHash(v)
+		return nil
+	case auditlog.FieldChainHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChainHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, auditlog.FieldTenantID)
+	}
+	if m.adderror_code != nil {
+		fields = append(fields, auditlog.FieldErrorCode)
+	}
+	if m.addlatency_ms != nil {
+		fields = append(fields, auditlog.FieldLatencyMs)
+	}
+	if m.addchain_seq != nil {
+		fields = append(fields, auditlog.FieldChainSeq)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldTenantID:
+		return m.AddedTenantID()
+	case auditlog.FieldErrorCode:
+		return m.AddedErrorCode()
+	case auditlog.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	case auditlog.FieldChainSeq:
+		return m.AddedChainSeq()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case auditlog.FieldErrorCode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddErrorCode(v)
+		return nil
+	case auditlog.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyMs(v)
+		return nil
+	case auditlog.FieldChainSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChainSeq(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldCreateTime) {
+		fields = append(fields, auditlog.FieldCreateTime)
+	}
+	if m.FieldCleared(auditlog.FieldUpdateTime) {
+		fields = append(fields, auditlog.FieldUpdateTime)
+	}
+	if m.FieldCleared(auditlog.FieldDeleteTime) {
+		fields = append(fields, auditlog.FieldDeleteTime)
+	}
+	if m.FieldCleared(auditlog.FieldTenantID) {
+		fields = append(fields, auditlog.FieldTenantID)
+	}
+	if m.FieldCleared(auditlog.FieldRequestID) {
+		fields = append(fields, auditlog.FieldRequestID)
+	}
+	if m.FieldCleared(auditlog.FieldClientID) {
+		fields = append(fields, auditlog.FieldClientID)
+	}
+	if m.FieldCleared(auditlog.FieldClientCommonName) {
+		fields = append(fields, auditlog.FieldClientCommonName)
+	}
+	if m.FieldCleared(auditlog.FieldClientOrganization) {
+		fields = append(fields, auditlog.FieldClientOrganization)
+	}
+	if m.FieldCleared(auditlog.FieldClientSerialNumber) {
+		fields = append(fields, auditlog.FieldClientSerialNumber)
+	}
+	if m.FieldCleared(auditlog.FieldErrorCode) {
+		fields = append(fields, auditlog.FieldErrorCode)
+	}
+	if m.FieldCleared(auditlog.FieldErrorMessage) {
+		fields = append(fields, auditlog.FieldErrorMessage)
+	}
+	if m.FieldCleared(auditlog.FieldPeerAddress) {
+		fields = append(fields, auditlog.FieldPeerAddress)
+	}
+	if m.FieldCleared(auditlog.FieldGeoLocation) {
+		fields = append(fields, auditlog.FieldGeoLocation)
+	}
+	if m.FieldCleared(auditlog.FieldLogHash) {
+		fields = append(fields, auditlog.FieldLogHash)
+	}
+	if m.FieldCleared(auditlog.FieldSignature) {
+		fields = append(fields, auditlog.FieldSignature)
+	}
+	if m.FieldCleared(auditlog.FieldMetadata) {
+		fields = append(fields, auditlog.FieldMetadata)
+	}
+	if m.FieldCleared(auditlog.FieldActorID) {
+		fields = append(fields, auditlog.FieldActorID)
+	}
+	if m.FieldCleared(auditlog.FieldEventType) {
+		fields = append(fields, auditlog.FieldEventType)
+	}
+	if m.FieldCleared(auditlog.FieldResourceType) {
+		fields = append(fields, auditlog.FieldResourceType)
+	}
+	if m.FieldCleared(auditlog.FieldResourceID) {
+		fields = append(fields, auditlog.FieldResourceID)
+	}
+	if m.FieldCleared(auditlog.FieldPrevHash) {
+		fields = append(fields, auditlog.FieldPrevHash)
+	}
+	if m.FieldCleared(auditlog.FieldChainHash) {
+		fields = append(fields, auditlog.FieldChainHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case auditlog.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case auditlog.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case auditlog.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case auditlog.FieldRequestID:
+		m.ClearRequestID()
+		return nil
+	case auditlog.FieldClientID:
+		m.ClearClientID()
+		return nil
+	case auditlog.FieldClientCommonName:
+		m.ClearClientCommonName()
+		return nil
+	case auditlog.FieldClientOrganization:
+		m.ClearClientOrganization()
+		return nil
+	case auditlog.FieldClientSerialNumber:
+		m.ClearClientSerialNumber()
+		return nil
+	case auditlog.FieldErrorCode:
+		m.ClearErrorCode()
+		return nil
+	case auditlog.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case auditlog.FieldPeerAddress:
+		m.ClearPeerAddress()
+		return nil
+	case auditlog.FieldGeoLocation:
+		m.ClearGeoLocation()
+		return nil
+	case auditlog.FieldLogHash:
+		m.ClearLogHash()
+		return nil
+	case auditlog.FieldSignature:
+		m.ClearSignature()
+		return nil
+	case auditlog.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case auditlog.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case auditlog.FieldEventType:
+		m.ClearEventType()
+		return nil
+	case auditlog.FieldResourceType:
+		m.ClearResourceType()
+		return nil
+	case auditlog.FieldResourceID:
+		m.ClearResourceID()
+		return nil
+	case auditlog.FieldPrevHash:
+		m.ClearPrevHash()
+		return nil
+	case auditlog.FieldChainHash:
+		m.ClearChainHash()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditLogMutation) ResetField(name string) error {
+	switch name {
+	case auditlog.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case auditlog.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case auditlog.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case auditlog.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case auditlog.FieldAuditID:
+		m.ResetAuditID()
+		return nil
+	case auditlog.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case auditlog.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case auditlog.FieldServiceName:
+		m.ResetServiceName()
+		return nil
+	case auditlog.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case auditlog.FieldClientCommonName:
+		m.ResetClientCommonName()
+		return nil
+	case auditlog.FieldClientOrganization:
+		m.ResetClientOrganization()
+		return nil
+	case auditlog.FieldClientSerialNumber:
+		m.ResetClientSerialNumber()
+		return nil
+	case auditlog.FieldIsAuthenticated:
+		m.ResetIsAuthenticated()
+		return nil
+	case auditlog.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case auditlog.FieldErrorCode:
+		m.ResetErrorCode()
+		return nil
+	case auditlog.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case auditlog.FieldLatencyMs:
+		m.ResetLatencyMs()
+		return nil
+	case auditlog.FieldPeerAddress:
+		m.ResetPeerAddress()
+		return nil
+	case auditlog.FieldGeoLocation:
+		m.ResetGeoLocation()
+		return nil
+	case auditlog.FieldLogHash:
+		m.ResetLogHash()
+		return nil
+	case auditlog.FieldSignature:
+		m.ResetSignature()
+		return nil
+	case auditlog.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case auditlog.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case auditlog.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case auditlog.FieldResourceType:
+		m.ResetResourceType()
+		return nil
+	case auditlog.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case auditlog.FieldChainSeq:
+		m.ResetChainSeq()
+		return nil
+	case auditlog.FieldPrevHash:
+		m.ResetPrevHash()
+		return nil
+	case auditlog.FieldChainHash:
+		m.ResetChainHash()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
+}
+
+// CollectionMutation represents an operation that mutates the Collection nodes in the graph.
+type CollectionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	create_by      *uint32
+	addcreate_by   *int32
+	create_time    *time.Time
+	update_time    *time.Time
+	delete_time    *time.Time
+	tenant_id      *uint32
+	addtenant_id   *int32
+	name           *string
+	description    *string
+	external_id    *string
+	clearedFields  map[string]struct{}
+	secrets        map[string]struct{}
+	removedsecrets map[string]struct{}
+	clearedsecrets bool
+	done           bool
+	oldValue       func(context.Context) (*Collection, error)
+	predicates     []predicate.Collection
+}
+
+var _ ent.Mutation = (*CollectionMutation)(nil)
+
+// collectionOption allows management of the mutation configuration using functional options.
+type collectionOption func(*CollectionMutation)
+
+// newCollectionMutation creates new mutation for the Collection entity.
+func newCollectionMutation(c config, op Op, opts ...collectionOption) *CollectionMutation {
+	m := &CollectionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCollection,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCollectionID sets the ID field of the mutation.
+func withCollectionID(id string) collectionOption {
+	return func(m *CollectionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Collection
+		)
+		m.oldValue = func(ctx context.Context) (*Collection, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Collection.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCollection sets the old Collection of the mutation.
+func withCollection(node *Collection) collectionOption {
+	return func(m *CollectionMutation) {
+		m.oldValue = func(context.Context) (*Collection, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CollectionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CollectionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Collection entities.
+func (m *CollectionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CollectionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CollectionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Collection.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *CollectionMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *CollectionMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// AddCreateBy adds u to the "create_by" field.
+func (m *CollectionMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
+	}
+}
+
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *CollectionMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *CollectionMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[collection.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *CollectionMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[collection.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *CollectionMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, collection.FieldCreateBy)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *CollectionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *CollectionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *CollectionMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[collection.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *CollectionMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[collection.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *CollectionMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, collection.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *CollectionMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *CollectionMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *CollectionMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[collection.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *CollectionMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[collection.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *CollectionMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, collection.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *CollectionMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *CollectionMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *CollectionMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[collection.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *CollectionMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[collection.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *CollectionMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, collection.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *CollectionMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *CollectionMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *CollectionMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *CollectionMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *CollectionMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[collection.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *CollectionMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[collection.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *CollectionMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, collection.FieldTenantID)
+}
+
+// SetName sets the "name" field.
+func (m *CollectionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CollectionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CollectionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *CollectionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *CollectionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *CollectionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[collection.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CollectionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[collection.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *CollectionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, collection.FieldDescription)
+}
+
+// SetExternalID sets the "external_id" field.
+func (m *CollectionMutation) SetExternalID(s string) {
+	m.external_id = &s
+}
+
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *CollectionMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "external_id" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldExternalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (m *CollectionMutation) ClearExternalID() {
+	m.external_id = nil
+	m.clearedFields[collection.FieldExternalID] = struct{}{}
+}
+
+// ExternalIDCleared returns if the "external_id" field was cleared in this mutation.
+func (m *CollectionMutation) ExternalIDCleared() bool {
+	_, ok := m.clearedFields[collection.FieldExternalID]
+	return ok
+}
+
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *CollectionMutation) ResetExternalID() {
+	m.external_id = nil
+	delete(m.clearedFields, collection.FieldExternalID)
+}
+
+// AddSecretIDs adds the "secrets" edge to the Secret entity by ids.
+func (m *CollectionMutation) AddSecretIDs(ids ...string) {
+	if m.secrets == nil {
+		m.secrets = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.secrets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSecrets clears the "secrets" edge to the Secret entity.
+func (m *CollectionMutation) ClearSecrets() {
+	m.clearedsecrets = true
+}
+
+// SecretsCleared reports if the "secrets" edge to the Secret entity was cleared.
+func (m *CollectionMutation) SecretsCleared() bool {
+	return m.clearedsecrets
+}
+
+// RemoveSecretIDs removes the "secrets" edge to the Secret entity by IDs.
+func (m *CollectionMutation) RemoveSecretIDs(ids ...string) {
+	if m.removedsecrets == nil {
+		m.removedsecrets = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.secrets, ids[i])
+		m.removedsecrets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSecrets returns the removed IDs of the "secrets" edge to the Secret entity.
+func (m *CollectionMutation) RemovedSecretsIDs() (ids []string) {
+	for id := range m.removedsecrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SecretsIDs returns the "secrets" edge IDs in the mutation.
+func (m *CollectionMutation) SecretsIDs() (ids []string) {
+	for id := range m.secrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSecrets resets all changes to the "secrets" edge.
+func (m *CollectionMutation) ResetSecrets() {
+	m.secrets = nil
+	m.clearedsecrets = false
+	m.removedsecrets = nil
+}
+
+// Where appends a list predicates to the CollectionMutation builder.
+func (m *CollectionMutation) Where(ps ...predicate.Collection) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CollectionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CollectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Collection, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CollectionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CollectionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Collection).
+func (m *CollectionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CollectionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_by != nil {
+		fields = append(fields, collection.FieldCreateBy)
+	}
+	if m.create_time != nil {
+		fields = append(fields, collection.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, collection.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, collection.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, collection.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, collection.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, collection.FieldDescription)
+	}
+	if m.external_id != nil {
+		fields = append(fields, collection.FieldExternalID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CollectionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case collection.FieldCreateBy:
+		return m.CreateBy()
+	case collection.FieldCreateTime:
+		return m.CreateTime()
+	case collection.FieldUpdateTime:
+		return m.UpdateTime()
+	case collection.FieldDeleteTime:
+		return m.DeleteTime()
+	case collection.FieldTenantID:
+		return m.TenantID()
+	case collection.FieldName:
+		return m.Name()
+	case collection.FieldDescription:
+		return m.Description()
+	case collection.FieldExternalID:
+		return m.ExternalID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CollectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case collection.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case collection.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case collection.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case collection.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case collection.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case collection.FieldName:
+		return m.OldName(ctx)
+	case collection.FieldDescription:
+		return m.OldDescription(ctx)
+	case collection.FieldExternalID:
+		return m.OldExternalID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Collection field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CollectionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case collection.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case collection.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case collection.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case collection.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case collection.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case collection.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case collection.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case collection.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Collection field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CollectionMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, collection.FieldCreateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, collection.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CollectionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case collection.FieldCreateBy:
+		return m.AddedCreateBy()
+	case collection.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CollectionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case collection.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case collection.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Collection numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CollectionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(collection.FieldCreateBy) {
+		fields = append(fields, collection.FieldCreateBy)
+	}
+	if m.FieldCleared(collection.FieldCreateTime) {
+		fields = append(fields, collection.FieldCreateTime)
+	}
+	if m.FieldCleared(collection.FieldUpdateTime) {
+		fields = append(fields, collection.FieldUpdateTime)
+	}
+	if m.FieldCleared(collection.FieldDeleteTime) {
+		fields = append(fields, collection.FieldDeleteTime)
+	}
+	if m.FieldCleared(collection.FieldTenantID) {
+		fields = append(fields, collection.FieldTenantID)
+	}
+	if m.FieldCleared(collection.FieldDescription) {
+		fields = append(fields, collection.FieldDescription)
+	}
+	if m.FieldCleared(collection.FieldExternalID) {
+		fields = append(fields, collection.FieldExternalID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CollectionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CollectionMutation) ClearField(name string) error {
+	switch name {
+	case collection.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case collection.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case collection.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case collection.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case collection.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case collection.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case collection.FieldExternalID:
+		m.ClearExternalID()
+		return nil
+	}
+	return fmt.Errorf("unknown Collection nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CollectionMutation) ResetField(name string) error {
+	switch name {
+	case collection.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case collection.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case collection.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case collection.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case collection.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case collection.FieldName:
+		m.ResetName()
+		return nil
+	case collection.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case collection.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	}
+	return fmt.Errorf("unknown Collection field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CollectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.secrets != nil {
+		edges = append(edges, collection.EdgeSecrets)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CollectionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case collection.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.secrets))
+		for id := range m.secrets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CollectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedsecrets != nil {
+		edges = append(edges, collection.EdgeSecrets)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CollectionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case collection.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.removedsecrets))
+		for id := range m.removedsecrets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CollectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsecrets {
+		edges = append(edges, collection.EdgeSecrets)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CollectionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case collection.EdgeSecrets:
+		return m.clearedsecrets
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CollectionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Collection unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CollectionMutation) ResetEdge(name string) error {
+	switch name {
+	case collection.EdgeSecrets:
+		m.ResetSecrets()
+		return nil
+	}
+	return fmt.Errorf("unknown Collection edge %s", name)
+}
+
+// DeletionRequestMutation represents an operation that mutates the DeletionRequest nodes in the graph.
+type DeletionRequestMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint32
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	tenant_id     *uint32
+	addtenant_id  *int32
+	resource_type *deletionrequest.ResourceType
+	resource_id   *string
+	resource_name *string
+	force         *bool
+	reason        *string
+	status        *deletionrequest.Status
+	requested_by  *string
+	decided_by    *string
+	decided_at    *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DeletionRequest, error)
+	predicates    []predicate.DeletionRequest
+}
+
+var _ ent.Mutation = (*DeletionRequestMutation)(nil)
+
+// deletionrequestOption allows management of the mutation configuration using functional options.
+type deletionrequestOption func(*DeletionRequestMutation)
+
+// newDeletionRequestMutation creates new mutation for the DeletionRequest entity.
+func newDeletionRequestMutation(c config, op Op, opts ...deletionrequestOption) *DeletionRequestMutation {
+	m := &DeletionRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeletionRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeletionRequestID sets the ID field of the mutation.
+func withDeletionRequestID(id uint32) deletionrequestOption {
+	return func(m *DeletionRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeletionRequest
+		)
+		m.oldValue = func(ctx context.Context) (*DeletionRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeletionRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeletionRequest sets the old DeletionRequest of the mutation.
+func withDeletionRequest(node *DeletionRequest) deletionrequestOption {
+	return func(m *DeletionRequestMutation) {
+		m.oldValue = func(context.Context) (*DeletionRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeletionRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeletionRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeletionRequest entities.
+func (m *DeletionRequestMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeletionRequestMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeletionRequestMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeletionRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DeletionRequestMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DeletionRequestMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *DeletionRequestMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[deletionrequest.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *DeletionRequestMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DeletionRequestMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, deletionrequest.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DeletionRequestMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DeletionRequestMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *DeletionRequestMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[deletionrequest.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *DeletionRequestMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DeletionRequestMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, deletionrequest.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *DeletionRequestMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *DeletionRequestMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *DeletionRequestMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[deletionrequest.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *DeletionRequestMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *DeletionRequestMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, deletionrequest.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *DeletionRequestMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *DeletionRequestMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *DeletionRequestMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *DeletionRequestMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *DeletionRequestMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[deletionrequest.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *DeletionRequestMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *DeletionRequestMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, deletionrequest.FieldTenantID)
+}
+
+// SetResourceType sets the "resource_type" field.
+func (m *DeletionRequestMutation) SetResourceType(dt deletionrequest.ResourceType) {
+	m.resource_type = &dt
+}
+
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *DeletionRequestMutation) ResourceType() (r deletionrequest.ResourceType, exists bool) {
+	v := m.resource_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceType returns the old "resource_type" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldResourceType(ctx context.Context) (v deletionrequest.ResourceType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+	}
+	return oldValue.ResourceType, nil
+}
+
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *DeletionRequestMutation) ResetResourceType() {
+	m.resource_type = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *DeletionRequestMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *DeletionRequestMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *DeletionRequestMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetResourceName sets the "resource_name" field.
+func (m *DeletionRequestMutation) SetResourceName(s string) {
+	m.resource_name = &s
+}
+
+// ResourceName returns the value of the "resource_name" field in the mutation.
+func (m *DeletionRequestMutation) ResourceName() (r string, exists bool) {
+	v := m.resource_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceName returns the old "resource_name" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldResourceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceName: %w", err)
+	}
+	return oldValue.ResourceName, nil
+}
+
+// ClearResourceName clears the value of the "resource_name" field.
+func (m *DeletionRequestMutation) ClearResourceName() {
+	m.resource_name = nil
+	m.clearedFields[deletionrequest.FieldResourceName] = struct{}{}
+}
+
+// ResourceNameCleared returns if the "resource_name" field was cleared in this mutation.
+func (m *DeletionRequestMutation) ResourceNameCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldResourceName]
+	return ok
+}
+
+// ResetResourceName resets all changes to the "resource_name" field.
+func (m *DeletionRequestMutation) ResetResourceName() {
+	m.resource_name = nil
+	delete(m.clearedFields, deletionrequest.FieldResourceName)
+}
+
+// SetForce sets the "force" field.
+func (m *DeletionRequestMutation) SetForce(b bool) {
+	m.force = &b
+}
+
+// Force returns the value of the "force" field in the mutation.
+func (m *DeletionRequestMutation) Force() (r bool, exists bool) {
+	v := m.force
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForce returns the old "force" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldForce(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForce: %w", err)
+	}
+	return oldValue.Force, nil
+}
+
+// ResetForce resets all changes to the "force" field.
+func (m *DeletionRequestMutation) ResetForce() {
+	m.force = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *DeletionRequestMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *DeletionRequestMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *DeletionRequestMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[deletionrequest.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *DeletionRequestMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *DeletionRequestMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, deletionrequest.FieldReason)
+}
+
+// SetStatus sets the "status" field.
+func (m *DeletionRequestMutation) SetStatus(d deletionrequest.Status) {
+	m.status = &d
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DeletionRequestMutation) Status() (r deletionrequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldStatus(ctx context.Context) (v deletionrequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DeletionRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (m *DeletionRequestMutation) SetRequestedBy(s string) {
+	m.requested_by = &s
+}
+
+// RequestedBy returns the value of the "requested_by" field in the mutation.
+func (m *DeletionRequestMutation) RequestedBy() (r string, exists bool) {
+	v := m.requested_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedBy returns the old "requested_by" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldRequestedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedBy: %w", err)
+	}
+	return oldValue.RequestedBy, nil
+}
+
+// ResetRequestedBy resets all changes to the "requested_by" field.
+func (m *DeletionRequestMutation) ResetRequestedBy() {
+	m.requested_by = nil
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (m *DeletionRequestMutation) SetDecidedBy(s string) {
+	m.decided_by = &s
+}
+
+// DecidedBy returns the value of the "decided_by" field in the mutation.
+func (m *DeletionRequestMutation) DecidedBy() (r string, exists bool) {
+	v := m.decided_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecidedBy returns the old "decided_by" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldDecidedBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedBy: %w", err)
+	}
+	return oldValue.DecidedBy, nil
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (m *DeletionRequestMutation) ClearDecidedBy() {
+	m.decided_by = nil
+	m.clearedFields[deletionrequest.FieldDecidedBy] = struct{}{}
+}
+
+// DecidedByCleared returns if the "decided_by" field was cleared in this mutation.
+func (m *DeletionRequestMutation) DecidedByCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldDecidedBy]
+	return ok
+}
+
+// ResetDecidedBy resets all changes to the "decided_by" field.
+func (m *DeletionRequestMutation) ResetDecidedBy() {
+	m.decided_by = nil
+	delete(m.clearedFields, deletionrequest.FieldDecidedBy)
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (m *DeletionRequestMutation) SetDecidedAt(t time.Time) {
+	m.decided_at = &t
+}
+
+// DecidedAt returns the value of the "decided_at" field in the mutation.
+func (m *DeletionRequestMutation) DecidedAt() (r time.Time, exists bool) {
+	v := m.decided_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecidedAt returns the old "decided_at" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldDecidedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedAt: %w", err)
+	}
+	return oldValue.DecidedAt, nil
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (m *DeletionRequestMutation) ClearDecidedAt() {
+	m.decided_at = nil
+	m.clearedFields[deletionrequest.FieldDecidedAt] = struct{}{}
+}
+
+// DecidedAtCleared returns if the "decided_at" field was cleared in this mutation.
+func (m *DeletionRequestMutation) DecidedAtCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldDecidedAt]
+	return ok
+}
+
+// ResetDecidedAt resets all changes to the "decided_at" field.
+func (m *DeletionRequestMutation) ResetDecidedAt() {
+	m.decided_at = nil
+	delete(m.clearedFields, deletionrequest.FieldDecidedAt)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *DeletionRequestMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *DeletionRequestMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *DeletionRequestMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the DeletionRequestMutation builder.
+func (m *DeletionRequestMutation) Where(ps ...predicate.DeletionRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeletionRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeletionRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeletionRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeletionRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeletionRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeletionRequest).
+func (m *DeletionRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeletionRequestMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_time != nil {
+		fields = append(fields, deletionrequest.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, deletionrequest.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, deletionrequest.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, deletionrequest.FieldTenantID)
+	}
+	if m.resource_type != nil {
+		fields = append(fields, deletionrequest.FieldResourceType)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, deletionrequest.FieldResourceID)
+	}
+	if m.resource_name != nil {
+		fields = append(fields, deletionrequest.FieldResourceName)
+	}
+	if m.force != nil {
+		fields = append(fields, deletionrequest.FieldForce)
+	}
+	if m.reason != nil {
+		fields = append(fields, deletionrequest.FieldReason)
+	}
+	if m.status != nil {
+		fields = append(fields, deletionrequest.FieldStatus)
+	}
+	if m.requested_by != nil {
+		fields = append(fields, deletionrequest.FieldRequestedBy)
+	}
+	if m.decided_by != nil {
+		fields = append(fields, deletionrequest.FieldDecidedBy)
+	}
+	if m.decided_at != nil {
+		fields = append(fields, deletionrequest.FieldDecidedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, deletionrequest.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeletionRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deletionrequest.FieldCreateTime:
+		return m.CreateTime()
+	case deletionrequest.FieldUpdateTime:
+		return m.UpdateTime()
+	case deletionrequest.FieldDeleteTime:
+		return m.DeleteTime()
+	case deletionrequest.FieldTenantID:
+		return m.TenantID()
+	case deletionrequest.FieldResourceType:
+		return m.ResourceType()
+	case deletionrequest.FieldResourceID:
+		return m.ResourceID()
+	case deletionrequest.FieldResourceName:
+		return m.ResourceName()
+	case deletionrequest.FieldForce:
+		return m.Force()
+	case deletionrequest.FieldReason:
+		return m.Reason()
+	case deletionrequest.FieldStatus:
+		return m.Status()
+	case deletionrequest.FieldRequestedBy:
+		return m.RequestedBy()
+	case deletionrequest.FieldDecidedBy:
+		return m.DecidedBy()
+	case deletionrequest.FieldDecidedAt:
+		return m.DecidedAt()
+	case deletionrequest.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeletionRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deletionrequest.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case deletionrequest.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case deletionrequest.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case deletionrequest.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case deletionrequest.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case deletionrequest.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case deletionrequest.FieldResourceName:
+		return m.OldResourceName(ctx)
+	case deletionrequest.FieldForce:
+		return m.OldForce(ctx)
+	case deletionrequest.FieldReason:
+		return m.OldReason(ctx)
+	case deletionrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case deletionrequest.FieldRequestedBy:
+		return m.OldRequestedBy(ctx)
+	case deletionrequest.FieldDecidedBy:
+		return m.OldDecidedBy(ctx)
+	case deletionrequest.FieldDecidedAt:
+		return m.OldDecidedAt(ctx)
+	case deletionrequest.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeletionRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeletionRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deletionrequest.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case deletionrequest.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case deletionrequest.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case deletionrequest.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case deletionrequest.FieldResourceType:
+		v, ok := value.(deletionrequest.ResourceType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceType(v)
+		return nil
+	case deletionrequest.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case deletionrequest.FieldResourceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceName(v)
+		return nil
+	case deletionrequest.FieldForce:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForce(v)
+		return nil
+	case deletionrequest.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case deletionrequest.FieldStatus:
+		v, ok := value.(deletionrequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case deletionrequest.FieldRequestedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedBy(v)
+		return nil
+	case deletionrequest.FieldDecidedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecidedBy(v)
+		return nil
+	case deletionrequest.FieldDecidedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecidedAt(v)
+		return nil
+	case deletionrequest.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeletionRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, deletionrequest.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeletionRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deletionrequest.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeletionRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deletionrequest.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeletionRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deletionrequest.FieldCreateTime) {
+		fields = append(fields, deletionrequest.FieldCreateTime)
+	}
+	if m.FieldCleared(deletionrequest.FieldUpdateTime) {
+		fields = append(fields, deletionrequest.FieldUpdateTime)
+	}
+	if m.FieldCleared(deletionrequest.FieldDeleteTime) {
+		fields = append(fields, deletionrequest.FieldDeleteTime)
+	}
+	if m.FieldCleared(deletionrequest.FieldTenantID) {
+		fields = append(fields, deletionrequest.FieldTenantID)
+	}
+	if m.FieldCleared(deletionrequest.FieldResourceName) {
+		fields = append(fields, deletionrequest.FieldResourceName)
+	}
+	if m.FieldCleared(deletionrequest.FieldReason) {
+		fields = append(fields, deletionrequest.FieldReason)
+	}
+	if m.FieldCleared(deletionrequest.FieldDecidedBy) {
+		fields = append(fields, deletionrequest.FieldDecidedBy)
+	}
+	if m.FieldCleared(deletionrequest.FieldDecidedAt) {
+		fields = append(fields, deletionrequest.FieldDecidedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeletionRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeletionRequestMutation) ClearField(name string) error {
+	switch name {
+	case deletionrequest.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case deletionrequest.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case deletionrequest.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case deletionrequest.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case deletionrequest.FieldResourceName:
+		m.ClearResourceName()
+		return nil
+	case deletionrequest.FieldReason:
+		m.ClearReason()
+		return nil
+	case deletionrequest.FieldDecidedBy:
+		m.ClearDecidedBy()
+		return nil
+	case deletionrequest.FieldDecidedAt:
+		m.ClearDecidedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeletionRequestMutation) ResetField(name string) error {
+	switch name {
+	case deletionrequest.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case deletionrequest.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case deletionrequest.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case deletionrequest.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case deletionrequest.FieldResourceType:
+		m.ResetResourceType()
+		return nil
+	case deletionrequest.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case deletionrequest.FieldResourceName:
+		m.ResetResourceName()
+		return nil
+	case deletionrequest.FieldForce:
+		m.ResetForce()
+		return nil
+	case deletionrequest.FieldReason:
+		m.ResetReason()
+		return nil
+	case deletionrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case deletionrequest.FieldRequestedBy:
+		m.ResetRequestedBy()
+		return nil
+	case deletionrequest.FieldDecidedBy:
+		m.ResetDecidedBy()
+		return nil
+	case deletionrequest.FieldDecidedAt:
+		m.ResetDecidedAt()
+		return nil
+	case deletionrequest.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeletionRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeletionRequestMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeletionRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeletionRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeletionRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeletionRequestMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeletionRequestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeletionRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeletionRequestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeletionRequest edge %s", name)
+}
+
+// EmergencyAccessMutation represents an operation that mutates the EmergencyAccess nodes in the graph.
+type EmergencyAccessMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint32
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	tenant_id     *uint32
+	addtenant_id  *int32
+	grantor_id    *string
+	grantee_id    *string
+	folder_id     *string
+	wait_days     *int32
+	addwait_days  *int32
+	status        *emergencyaccess.Status
+	requested_at  *time.Time
+	granted_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EmergencyAccess, error)
+	predicates    []predicate.EmergencyAccess
+}
+
+var _ ent.Mutation = (*EmergencyAccessMutation)(nil)
+
+// emergencyaccessOption allows management of the mutation configuration using functional options.
+type emergencyaccessOption func(*EmergencyAccessMutation)
+
+// newEmergencyAccessMutation creates new mutation for the EmergencyAccess entity.
+func newEmergencyAccessMutation(c config, op Op, opts ...emergencyaccessOption) *EmergencyAccessMutation {
+	m := &EmergencyAccessMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmergencyAccess,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmergencyAccessID sets the ID field of the mutation.
+func withEmergencyAccessID(id uint32) emergencyaccessOption {
+	return func(m *EmergencyAccessMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmergencyAccess
+		)
+		m.oldValue = func(ctx context.Context) (*EmergencyAccess, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmergencyAccess.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmergencyAccess sets the old EmergencyAccess of the mutation.
+func withEmergencyAccess(node *EmergencyAccess) emergencyaccessOption {
+	return func(m *EmergencyAccessMutation) {
+		m.oldValue = func(context.Context) (*EmergencyAccess, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmergencyAccessMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmergencyAccessMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EmergencyAccess entities.
+func (m *EmergencyAccessMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmergencyAccessMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmergencyAccessMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmergencyAccess.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *EmergencyAccessMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *EmergencyAccessMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the EmergencyAccess entity.
+// If the EmergencyAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmergencyAccessMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *EmergencyAccessMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[emergencyaccess.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *EmergencyAccessMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[emergencyaccess.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *EmergencyAccessMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, emergencyaccess.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *EmergencyAccessMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *EmergencyAccessMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the EmergencyAccess entity.
+// If the EmergencyAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmergencyAccessMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *EmergencyAccessMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[emergencyaccess.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *EmergencyAccessMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[emergencyaccess.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *EmergencyAccessMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, emergencyaccess.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *EmergencyAccessMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *EmergencyAccessMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the EmergencyAccess entity.
+// If the EmergencyAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmergencyAccessMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *EmergencyAccessMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[emergencyaccess.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *EmergencyAccessMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[emergencyaccess.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *EmergencyAccessMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, emergencyaccess.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *EmergencyAccessMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *EmergencyAccessMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the EmergencyAccess entity.
+// If the EmergencyAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmergencyAccessMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *EmergencyAccessMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *EmergencyAccessMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *EmergencyAccessMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[emergencyaccess.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *EmergencyAccessMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[emergencyaccess.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *EmergencyAccessMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, emergencyaccess.FieldTenantID)
+}
+
+// SetGrantorID sets the "grantor_id" field.
+func (m *EmergencyAccessMutation) SetGrantorID(s string) {
+	m.grantor_id = &s
+}
+
+// GrantorID returns the value of the "grantor_id" field in the mutation.
+func (m *EmergencyAccessMutation) GrantorID() (r string, exists bool) {
+	v := m.grantor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantorID returns the old "grantor_id" field's value of the EmergencyAccess entity.
+// If the EmergencyAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmergencyAccessMutation) OldGrantorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantorID: %w", err)
+	}
+	return oldValue.GrantorID, nil
+}
+
+// ResetGrantorID resets all changes to the "grantor_id" field.
+func (m *EmergencyAccessMutation) ResetGrantorID() {
+	m.grantor_id = nil
+}
+
+// SetGranteeID sets the "grantee_id" field.
+func (m *EmergencyAccessMutation) SetGranteeID(s string) {
+	m.grantee_id = &s
+}
+
+// GranteeID returns the value of the "grantee_id" field in the mutation.
+func (m *EmergencyAccessMutation) GranteeID() (r string, exists bool) {
+	v := m.grantee_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGranteeID returns the old "grantee_id" field's value of the EmergencyAccess entity.
+// If the EmergencyAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmergencyAccessMutation) OldGranteeID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGranteeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGranteeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGranteeID: %w", err)
+	}
+	return oldValue.GranteeID, nil
+}
+
+// ResetGranteeID resets all changes to the "grantee_id" field.
+func (m *EmergencyAccessMutation) ResetGranteeID() {
+	m.grantee_id = nil
+}
+
+// SetFolderID sets the "folder_id" field.
+func (m *EmergencyAccessMutation) SetFolderID(s string) {
+	m.folder_id = &s
+}
+
+// FolderID returns the value of the "folder_id" field in the mutation.
+func (m *EmergencyAccessMutation) FolderID() (r string, exists bool) {
+	v := m.folder_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFolderID returns the old "folder_id" field's value of the EmergencyAccess entity.
+// If the EmergencyAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmergencyAccessMutation) OldFolderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFolderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFolderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFolderID: %w", err)
+	}
+	return oldValue.FolderID, nil
+}
+
+// ResetFolderID resets all changes to the "folder_id" field.
+func (m *EmergencyAccessMutation) ResetFolderID() {
+	m.folder_id = nil
+}
+
+// SetWaitDays sets the "wait_days" field.
+func (m *EmergencyAccessMutation) SetWaitDays(i int32) {
+	m.wait_days = &i
+	m.addwait_days = nil
+}
+
+// WaitDays returns the value of the "wait_days" field in the mutation.
+func (m *EmergencyAccessMutation) WaitDays() (r int32, exists bool) {
+	v := m.wait_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWaitDays returns the old "wait_days" field's value of the EmergencyAccess entity.
+// If the EmergencyAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmergencyAccessMutation) OldWaitDays(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWaitDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWaitDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWaitDays: %w", err)
+	}
+	return oldValue.WaitDays, nil
+}
+
+// AddWaitDays adds i to the "wait_days" field.
+func (m *EmergencyAccessMutation) AddWaitDays(i int32) {
+	if m.addwait_days != nil {
+		*m.addwait_days += i
+	} else {
+		m.addwait_days = &i
+	}
+}
+
+// AddedWaitDays returns the value that was added to the "wait_days" field in this mutation.
+func (m *EmergencyAccessMutation) AddedWaitDays() (r int32, exists bool) {
+	v := m.addwait_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWaitDays resets all changes to the "wait_days" field.
+func (m *EmergencyAccessMutation) ResetWaitDays() {
+	m.wait_days = nil
+	m.addwait_days = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *EmergencyAccessMutation) SetStatus(e emergencyaccess.Status) {
+	m.status = &e
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EmergencyAccessMutation) Status() (r emergencyaccess.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the EmergencyAccess entity.
+// If the EmergencyAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmergencyAccessMutation) OldStatus(ctx context.Context) (v emergencyaccess.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *EmergencyAccessMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (m *EmergencyAccessMutation) SetRequestedAt(t time.Time) {
+	m.requested_at = &t
+}
+
+// RequestedAt returns the value of the "requested_at" field in the mutation.
+func (m *EmergencyAccessMutation) RequestedAt() (r time.Time, exists bool) {
+	v := m.requested_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedAt returns the old "requested_at" field's value of the EmergencyAccess entity.
+// If the EmergencyAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmergencyAccessMutation) OldRequestedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedAt: %w", err)
+	}
+	return oldValue.RequestedAt, nil
+}
+
+// ClearRequestedAt clears the value of the "requested_at" field.
+func (m *EmergencyAccessMutation) ClearRequestedAt() {
+	m.requested_at = nil
+	m.clearedFields[emergencyaccess.FieldRequestedAt] = struct{}{}
+}
+
+// RequestedAtCleared returns if the "requested_at" field was cleared in this mutation.
+func (m *EmergencyAccessMutation) RequestedAtCleared() bool {
+	_, ok := m.clearedFields[emergencyaccess.FieldRequestedAt]
+	return ok
+}
+
+// ResetRequestedAt resets all changes to the "requested_at" field.
+func (m *EmergencyAccessMutation) ResetRequestedAt() {
+	m.requested_at = nil
+	delete(m.clearedFields, emergencyaccess.FieldRequestedAt)
+}
+
+// SetGrantedAt sets the "granted_at" field.
+func (m *EmergencyAccessMutation) SetGrantedAt(t time.Time) {
+	m.granted_at = &t
+}
+
+// GrantedAt returns the value of the "granted_at" field in the mutation.
+func (m *EmergencyAccessMutation) GrantedAt() (r time.Time, exists bool) {
+	v := m.granted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantedAt returns the old "granted_at" field's value of the EmergencyAccess entity.
+// If the EmergencyAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmergencyAccessMutation) OldGrantedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantedAt: %w", err)
+	}
+	return oldValue.GrantedAt, nil
+}
+
+// ClearGrantedAt clears the value of the "granted_at" field.
+func (m *EmergencyAccessMutation) ClearGrantedAt() {
+	m.granted_at = nil
+	m.clearedFields[emergencyaccess.FieldGrantedAt] = struct{}{}
+}
+
+// GrantedAtCleared returns if the "granted_at" field was cleared in this mutation.
+func (m *EmergencyAccessMutation) GrantedAtCleared() bool {
+	_, ok := m.clearedFields[emergencyaccess.FieldGrantedAt]
+	return ok
+}
+
+// ResetGrantedAt resets all changes to the "granted_at" field.
+func (m *EmergencyAccessMutation) ResetGrantedAt() {
+	m.granted_at = nil
+	delete(m.clearedFields, emergencyaccess.FieldGrantedAt)
+}
+
+// Where appends a list predicates to the EmergencyAccessMutation builder.
+func (m *EmergencyAccessMutation) Where(ps ...predicate.EmergencyAccess) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmergencyAccessMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmergencyAccessMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmergencyAccess, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmergencyAccessMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmergencyAccessMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmergencyAccess).
+func (m *EmergencyAccessMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmergencyAccessMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, emergencyaccess.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, emergencyaccess.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, emergencyaccess.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, emergencyaccess.FieldTenantID)
+	}
+	if m.grantor_id != nil {
+		fields = append(fields, emergencyaccess.FieldGrantorID)
+	}
+	if m.grantee_id != nil {
+		fields = append(fields, emergencyaccess.FieldGranteeID)
+	}
+	if m.folder_id != nil {
+		fields = append(fields, emergencyaccess.FieldFolderID)
+	}
+	if m.wait_days != nil {
+		fields = append(fields, emergencyaccess.FieldWaitDays)
+	}
+	if m.status != nil {
+		fields = append(fields, emergencyaccess.FieldStatus)
+	}
+	if m.requested_at != nil {
+		fields = append(fields, emergencyaccess.FieldRequestedAt)
+	}
+	if m.granted_at != nil {
+		fields = append(fields, emergencyaccess.FieldGrantedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmergencyAccessMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case emergencyaccess.FieldCreateTime:
+		return m.CreateTime()
+	case emergencyaccess.FieldUpdateTime:
+		return m.UpdateTime()
+	case emergencyaccess.FieldDeleteTime:
+		return m.DeleteTime()
+	case emergencyaccess.FieldTenantID:
+		return m.TenantID()
+	case emergencyaccess.FieldGrantorID:
+		return m.GrantorID()
+	case emergencyaccess.FieldGranteeID:
+		return m.GranteeID()
+	case emergencyaccess.FieldFolderID:
+		return m.FolderID()
+	case emergencyaccess.FieldWaitDays:
+		return m.WaitDays()
+	case emergencyaccess.FieldStatus:
+		return m.Status()
+	case emergencyaccess.FieldRequestedAt:
+		return m.RequestedAt()
+	case emergencyaccess.FieldGrantedAt:
+		return m.GrantedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmergencyAccessMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case emergencyaccess.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case emergencyaccess.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case emergencyaccess.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case emergencyaccess.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case emergencyaccess.FieldGrantorID:
+		return m.OldGrantorID(ctx)
+	case emergencyaccess.FieldGranteeID:
+		return m.OldGranteeID(ctx)
+	case emergencyaccess.FieldFolderID:
+		return m.OldFolderID(ctx)
+	case emergencyaccess.FieldWaitDays:
+		return m.OldWaitDays(ctx)
+	case emergencyaccess.FieldStatus:
+		return m.OldStatus(ctx)
+	case emergencyaccess.FieldRequestedAt:
+		return m.OldRequestedAt(ctx)
+	case emergencyaccess.FieldGrantedAt:
+		return m.OldGrantedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmergencyAccess field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmergencyAccessMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case emergencyaccess.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case emergencyaccess.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case emergencyaccess.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case emergencyaccess.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case emergencyaccess.FieldGrantorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantorID(v)
+		return nil
+	case emergencyaccess.FieldGranteeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGranteeID(v)
+		return nil
+	case emergencyaccess.FieldFolderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFolderID(v)
+		return nil
+	case emergencyaccess.FieldWaitDays:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWaitDays(v)
+		return nil
+	case emergencyaccess.FieldStatus:
+		v, ok := value.(emergencyaccess.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case emergencyaccess.FieldRequestedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedAt(v)
+		return nil
+	case emergencyaccess.FieldGrantedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmergencyAccess field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmergencyAccessMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, emergencyaccess.FieldTenantID)
+	}
+	if m.addwait_days != nil {
+		fields = append(fields, emergencyaccess.FieldWaitDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmergencyAccessMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case emergencyaccess.FieldTenantID:
+		return m.AddedTenantID()
+	case emergencyaccess.FieldWaitDays:
+		return m.AddedWaitDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmergencyAccessMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case emergencyaccess.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case emergencyaccess.FieldWaitDays:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWaitDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmergencyAccess numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmergencyAccessMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(emergencyaccess.FieldCreateTime) {
+		fields = append(fields, emergencyaccess.FieldCreateTime)
+	}
+	if m.FieldCleared(emergencyaccess.FieldUpdateTime) {
+		fields = append(fields, emergencyaccess.FieldUpdateTime)
+	}
+	if m.FieldCleared(emergencyaccess.FieldDeleteTime) {
+		fields = append(fields, emergencyaccess.FieldDeleteTime)
+	}
+	if m.FieldCleared(emergencyaccess.FieldTenantID) {
+		fields = append(fields, emergencyaccess.FieldTenantID)
+	}
+	if m.FieldCleared(emergencyaccess.FieldRequestedAt) {
+		fields = append(fields, emergencyaccess.FieldRequestedAt)
+	}
+	if m.FieldCleared(emergencyaccess.FieldGrantedAt) {
+		fields = append(fields, emergencyaccess.FieldGrantedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmergencyAccessMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmergencyAccessMutation) ClearField(name string) error {
+	switch name {
+	case emergencyaccess.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case emergencyaccess.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case emergencyaccess.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case emergencyaccess.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case emergencyaccess.FieldRequestedAt:
+		m.ClearRequestedAt()
+		return nil
+	case emergencyaccess.FieldGrantedAt:
+		m.ClearGrantedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmergencyAccess nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmergencyAccessMutation) ResetField(name string) error {
+	switch name {
+	case emergencyaccess.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case emergencyaccess.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case emergencyaccess.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case emergencyaccess.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case emergencyaccess.FieldGrantorID:
+		m.ResetGrantorID()
+		return nil
+	case emergencyaccess.FieldGranteeID:
+		m.ResetGranteeID()
+		return nil
+	case emergencyaccess.FieldFolderID:
+		m.ResetFolderID()
+		return nil
+	case emergencyaccess.FieldWaitDays:
+		m.ResetWaitDays()
+		return nil
+	case emergencyaccess.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case emergencyaccess.FieldRequestedAt:
+		m.ResetRequestedAt()
+		return nil
+	case emergencyaccess.FieldGrantedAt:
+		m.ResetGrantedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmergencyAccess field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmergencyAccessMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmergencyAccessMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmergencyAccessMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmergencyAccessMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmergencyAccessMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmergencyAccessMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmergencyAccessMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmergencyAccess unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmergencyAccessMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmergencyAccess edge %s", name)
+}
+
+// FieldKeyMutation represents an operation that mutates the FieldKey nodes in the graph.
+type FieldKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint32
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	transit_key   *string
+	wrapped_key   *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FieldKey, error)
+	predicates    []predicate.FieldKey
+}
+
+var _ ent.Mutation = (*FieldKeyMutation)(nil)
+
+// fieldkeyOption allows management of the mutation configuration using functional options.
+type fieldkeyOption func(*FieldKeyMutation)
+
+// newFieldKeyMutation creates new mutation for the FieldKey entity.
+func newFieldKeyMutation(c config, op Op, opts ...fieldkeyOption) *FieldKeyMutation {
+	m := &FieldKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFieldKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFieldKeyID sets the ID field of the mutation.
+func withFieldKeyID(id uint32) fieldkeyOption {
+	return func(m *FieldKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FieldKey
+		)
+		m.oldValue = func(ctx context.Context) (*FieldKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FieldKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFieldKey sets the old FieldKey of the mutation.
+func withFieldKey(node *FieldKey) fieldkeyOption {
+	return func(m *FieldKeyMutation) {
+		m.oldValue = func(context.Context) (*FieldKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FieldKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FieldKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FieldKey entities.
+func (m *FieldKeyMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FieldKeyMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FieldKeyMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FieldKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *FieldKeyMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *FieldKeyMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the FieldKey entity.
+// If the FieldKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldKeyMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *FieldKeyMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[fieldkey.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *FieldKeyMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[fieldkey.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *FieldKeyMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, fieldkey.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *FieldKeyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *FieldKeyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the FieldKey entity.
+// If the FieldKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldKeyMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *FieldKeyMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[fieldkey.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *FieldKeyMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[fieldkey.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *FieldKeyMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, fieldkey.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *FieldKeyMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *FieldKeyMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the FieldKey entity.
+// If the FieldKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldKeyMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *FieldKeyMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[fieldkey.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *FieldKeyMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[fieldkey.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *FieldKeyMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, fieldkey.FieldDeleteTime)
+}
+
+// SetTransitKey sets the "transit_key" field.
+func (m *FieldKeyMutation) SetTransitKey(s string) {
+	m.transit_key = &s
+}
+
+// TransitKey returns the value of the "transit_key" field in the mutation.
+func (m *FieldKeyMutation) TransitKey() (r string, exists bool) {
+	v := m.transit_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransitKey returns the old "transit_key" field's value of the FieldKey entity.
+// If the FieldKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldKeyMutation) OldTransitKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransitKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransitKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransitKey: %w", err)
+	}
+	return oldValue.TransitKey, nil
+}
+
+// ResetTransitKey resets all changes to the "transit_key" field.
+func (m *FieldKeyMutation) ResetTransitKey() {
+	m.transit_key = nil
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (m *FieldKeyMutation) SetWrappedKey(s string) {
+	m.wrapped_key = &s
+}
+
+// WrappedKey returns the value of the "wrapped_key" field in the mutation.
+func (m *FieldKeyMutation) WrappedKey() (r string, exists bool) {
+	v := m.wrapped_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWrappedKey returns the old "wrapped_key" field's value of the FieldKey entity.
+// If the FieldKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldKeyMutation) OldWrappedKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWrappedKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWrappedKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWrappedKey: %w", err)
+	}
+	return oldValue.WrappedKey, nil
+}
+
+// ResetWrappedKey resets all changes to the "wrapped_key" field.
+func (m *FieldKeyMutation) ResetWrappedKey() {
+	m.wrapped_key = nil
+}
+
+// Where appends a list predicates to the FieldKeyMutation builder.
+func (m *FieldKeyMutation) Where(ps ...predicate.FieldKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FieldKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FieldKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FieldKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FieldKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FieldKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FieldKey).
+func (m *FieldKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FieldKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, fieldkey.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, fieldkey.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, fieldkey.FieldDeleteTime)
+	}
+	if m.transit_key != nil {
+		fields = append(fields, fieldkey.FieldTransitKey)
+	}
+	if m.wrapped_key != nil {
+		fields = append(fields, fieldkey.FieldWrappedKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FieldKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fieldkey.FieldCreateTime:
+		return m.CreateTime()
+	case fieldkey.FieldUpdateTime:
+		return m.UpdateTime()
+	case fieldkey.FieldDeleteTime:
+		return m.DeleteTime()
+	case fieldkey.FieldTransitKey:
+		return m.TransitKey()
+	case fieldkey.FieldWrappedKey:
+		return m.WrappedKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FieldKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fieldkey.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case fieldkey.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case fieldkey.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case fieldkey.FieldTransitKey:
+		return m.OldTransitKey(ctx)
+	case fieldkey.FieldWrappedKey:
+		return m.OldWrappedKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown FieldKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FieldKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fieldkey.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case fieldkey.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case fieldkey.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case fieldkey.FieldTransitKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransitKey(v)
+		return nil
+	case fieldkey.FieldWrappedKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWrappedKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FieldKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FieldKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FieldKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FieldKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FieldKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FieldKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fieldkey.FieldCreateTime) {
+		fields = append(fields, fieldkey.FieldCreateTime)
+	}
+	if m.FieldCleared(fieldkey.FieldUpdateTime) {
+		fields = append(fields, fieldkey.FieldUpdateTime)
+	}
+	if m.FieldCleared(fieldkey.FieldDeleteTime) {
+		fields = append(fields, fieldkey.FieldDeleteTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FieldKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FieldKeyMutation) ClearField(name string) error {
+	switch name {
+	case fieldkey.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case fieldkey.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case fieldkey.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	}
+	return fmt.Errorf("unknown FieldKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FieldKeyMutation) ResetField(name string) error {
+	switch name {
+	case fieldkey.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case fieldkey.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case fieldkey.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case fieldkey.FieldTransitKey:
+		m.ResetTransitKey()
+		return nil
+	case fieldkey.FieldWrappedKey:
+		m.ResetWrappedKey()
+		return nil
+	}
+	return fmt.Errorf("unknown FieldKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FieldKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FieldKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FieldKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FieldKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FieldKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FieldKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FieldKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FieldKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FieldKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FieldKey edge %s", name)
+}
+
+// FolderMutation represents an operation that mutates the Folder nodes in the graph.
+type FolderMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *string
+	create_by                 *uint32
+	addcreate_by              *int32
+	create_time               *time.Time
+	update_time               *time.Time
+	delete_time               *time.Time
+	tenant_id                 *uint32
+	addtenant_id              *int32
+	name                      *string
+	_path                     *string
+	description               *string
+	depth                     *int32
+	adddepth                  *int32
+	last_accessed_time        *time.Time
+	secret_count              *int32
+	addsecret_count           *int32
+	subfolder_count           *int32
+	addsubfolder_count        *int32
+	revision                  *int64
+	addrevision               *int64
+	metadata_schema           *map[string]interface{}
+	default_permissions       *[]schema.FolderDefaultPermission
+	appenddefault_permissions []schema.FolderDefaultPermission
+	access_policy             **authz.AccessPolicy
+	protected                 *bool
+	legal_hold                *bool
+	legal_hold_reason         *string
+	status                    *folder.Status
+	deleted_time              *time.Time
+	trashed_with_folder_id    *string
+	original_parent_id        *string
+	original_path             *string
+	clearedFields             map[string]struct{}
+	parent                    *string
+	clearedparent             bool
+	children                  map[string]struct{}
+	removedchildren           map[string]struct{}
+	clearedchildren           bool
+	secrets                   map[string]struct{}
+	removedsecrets            map[string]struct{}
+	clearedsecrets            bool
+	permissions               map[int]struct{}
+	removedpermissions        map[int]struct{}
+	clearedpermissions        bool
+	done                      bool
+	oldValue                  func(context.Context) (*Folder, error)
+	predicates                []predicate.Folder
+}
+
+var _ ent.Mutation = (*FolderMutation)(nil)
+
+// folderOption allows management of the mutation configuration using functional options.
+type folderOption func(*FolderMutation)
+
+// newFolderMutation creates new mutation for the Folder entity.
+func newFolderMutation(c config, op Op, opts ...folderOption) *FolderMutation {
+	m := &FolderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFolder,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFolderID sets the ID field of the mutation.
+func withFolderID(id string) folderOption {
+	return func(m *FolderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Folder
+		)
+		m.oldValue = func(ctx context.Context) (*Folder, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Folder.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFolder sets the old Folder of the mutation.
+func withFolder(node *Folder) folderOption {
+	return func(m *FolderMutation) {
+		m.oldValue = func(context.Context) (*Folder, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FolderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FolderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Folder entities.
+func (m *FolderMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FolderMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FolderMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Folder.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *FolderMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *FolderMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// AddCreateBy adds u to the "create_by" field.
+func (m *FolderMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
+	}
+}
+
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *FolderMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *FolderMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[folder.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *FolderMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[folder.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *FolderMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, folder.FieldCreateBy)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *FolderMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *FolderMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *FolderMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[folder.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *FolderMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[folder.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *FolderMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, folder.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *FolderMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *FolderMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *FolderMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[folder.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *FolderMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[folder.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *FolderMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, folder.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *FolderMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *FolderMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *FolderMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[folder.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *FolderMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[folder.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *FolderMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, folder.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *FolderMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *FolderMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *FolderMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *FolderMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *FolderMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[folder.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *FolderMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[folder.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *FolderMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, folder.FieldTenantID)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *FolderMutation) SetParentID(s string) {
+	m.parent = &s
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *FolderMutation) ParentID() (r string, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldParentID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *FolderMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[folder.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *FolderMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[folder.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *FolderMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, folder.FieldParentID)
+}
+
+// SetName sets the "name" field.
+func (m *FolderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FolderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FolderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPath sets the "path" field.
+func (m *FolderMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *FolderMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *FolderMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *FolderMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *FolderMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *FolderMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[folder.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *FolderMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[folder.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *FolderMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, folder.FieldDescription)
+}
+
+// SetDepth sets the "depth" field.
+func (m *FolderMutation) SetDepth(i int32) {
+	m.depth = &i
+	m.adddepth = nil
+}
+
+// Depth returns the value of the "depth" field in the mutation.
+func (m *FolderMutation) Depth() (r int32, exists bool) {
+	v := m.depth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDepth returns the old "depth" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldDepth(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepth: %w", err)
+	}
+	return oldValue.Depth, nil
+}
+
+// AddDepth adds i to the "depth" field.
+func (m *FolderMutation) AddDepth(i int32) {
+	if m.adddepth != nil {
+		*m.adddepth += i
+	} else {
+		m.adddepth = &i
+	}
+}
+
+// AddedDepth returns the value that was added to the "depth" field in this mutation.
+func (m *FolderMutation) AddedDepth() (r int32, exists bool) {
+	v := m.adddepth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDepth resets all changes to the "depth" field.
+func (m *FolderMutation) ResetDepth() {
+	m.depth = nil
+	m.adddepth = nil
+}
+
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (m *FolderMutation) SetLastAccessedTime(t time.Time) {
+	m.last_accessed_time = &t
+}
+
+// LastAccessedTime returns the value of the "last_accessed_time" field in the mutation.
+func (m *FolderMutation) LastAccessedTime() (r time.Time, exists bool) {
+	v := m.last_accessed_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastAccessedTime returns the old "last_accessed_time" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldLastAccessedTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastAccessedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastAccessedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastAccessedTime: %w", err)
+	}
+	return oldValue.LastAccessedTime, nil
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (m *FolderMutation) ClearLastAccessedTime() {
+	m.last_accessed_time = nil
+	m.clearedFields[folder.FieldLastAccessedTime] = struct{}{}
+}
+
+// LastAccessedTimeCleared returns if the "last_accessed_time" field was cleared in this mutation.
+func (m *FolderMutation) LastAccessedTimeCleared() bool {
+	_, ok := m.clearedFields[folder.FieldLastAccessedTime]
+	return ok
+}
+
+// ResetLastAccessedTime resets all changes to the "last_accessed_time" field.
+func (m *FolderMutation) ResetLastAccessedTime() {
+	m.last_accessed_time = nil
+	delete(m.clearedFields, folder.FieldLastAccessedTime)
+}
+
+// SetSecretCount sets the "secret_count" field.
+func (m *FolderMutation) SetSecretCount(i int32) {
+	m.secret_count = &i
+	m.addsecret_count = nil
+}
+
+// SecretCount returns the value of the "secret_count" field in the mutation.
+func (m *FolderMutation) SecretCount() (r int32, exists bool) {
+	v := m.secret_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretCount returns the old "secret_count" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldSecretCount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretCount: %w", err)
+	}
+	return oldValue.SecretCount, nil
+}
+
+// AddSecretCount adds i to the "secret_count" field.
+func (m *FolderMutation) AddSecretCount(i int32) {
+	if m.addsecret_count != nil {
+		*m.addsecret_count += i
+	} else {
+		m.addsecret_count = &i
+	}
+}
+
+// AddedSecretCount returns the value that was added to the "secret_count" field in this mutation.
+func (m *FolderMutation) AddedSecretCount() (r int32, exists bool) {
+	v := m.addsecret_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSecretCount resets all changes to the "secret_count" field.
+func (m *FolderMutation) ResetSecretCount() {
+	m.secret_count = nil
+	m.addsecret_count = nil
+}
+
+// SetSubfolderCount sets the "subfolder_count" field.
+func (m *FolderMutation) SetSubfolderCount(i int32) {
+	m.subfolder_count = &i
+	m.addsubfolder_count = nil
+}
+
+// SubfolderCount returns the value of the "subfolder_count" field in the mutation.
+func (m *FolderMutation) SubfolderCount() (r int32, exists bool) {
+	v := m.subfolder_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubfolderCount returns the old "subfolder_count" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldSubfolderCount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubfolderCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubfolderCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubfolderCount: %w", err)
+	}
+	return oldValue.SubfolderCount, nil
+}
+
+// AddSubfolderCount adds i to the "subfolder_count" field.
+func (m *FolderMutation) AddSubfolderCount(i int32) {
+	if m.addsubfolder_count != nil {
+		*m.addsubfolder_count += i
+	} else {
+		m.addsubfolder_count = &i
+	}
+}
+
+// AddedSubfolderCount returns the value that was added to the "subfolder_count" field in this mutation.
+func (m *FolderMutation) AddedSubfolderCount() (r int32, exists bool) {
+	v := m.addsubfolder_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubfolderCount resets all changes to the "subfolder_count" field.
+func (m *FolderMutation) ResetSubfolderCount() {
+	m.subfolder_count = nil
+	m.addsubfolder_count = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *FolderMutation) SetRevision(i int64) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *FolderMutation) Revision() (r int64, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldRevision(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *FolderMutation) AddRevision(i int64) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *FolderMutation) AddedRevision() (r int64, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *FolderMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetMetadataSchema sets the "metadata_schema" field.
+func (m *FolderMutation) SetMetadataSchema(value map[string]interface{}) {
+	m.metadata_schema = &value
+}
+
+// MetadataSchema returns the value of the "metadata_schema" field in the mutation.
+func (m *FolderMutation) MetadataSchema() (r map[string]interface{}, exists bool) {
+	v := m.metadata_schema
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadataSchema returns the old "metadata_schema" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldMetadataSchema(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadataSchema is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadataSchema requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadataSchema: %w", err)
+	}
+	return oldValue.MetadataSchema, nil
+}
+
+// ClearMetadataSchema clears the value of the "metadata_schema" field.
+func (m *FolderMutation) ClearMetadataSchema() {
+	m.metadata_schema = nil
+	m.clearedFields[folder.FieldMetadataSchema] = struct{}{}
+}
+
+// MetadataSchemaCleared returns if the "metadata_schema" field was cleared in this mutation.
+func (m *FolderMutation) MetadataSchemaCleared() bool {
+	_, ok := m.clearedFields[folder.FieldMetadataSchema]
+	return ok
+}
+
+// ResetMetadataSchema resets all changes to the "metadata_schema" field.
+func (m *FolderMutation) ResetMetadataSchema() {
+	m.metadata_schema = nil
+	delete(m.clearedFields, folder.FieldMetadataSchema)
+}
+
+// SetDefaultPermissions sets the "default_permissions" field.
+func (m *FolderMutation) SetDefaultPermissions(sdp []schema.FolderDefaultPermission) {
+	m.default_permissions = &sdp
+	m.appenddefault_permissions = nil
+}
+
+// DefaultPermissions returns the value of the "default_permissions" field in the mutation.
+func (m *FolderMutation) DefaultPermissions() (r []schema.FolderDefaultPermission, exists bool) {
+	v := m.default_permissions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultPermissions returns the old "default_permissions" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldDefaultPermissions(ctx context.Context) (v []schema.FolderDefaultPermission, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultPermissions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultPermissions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultPermissions: %w", err)
+	}
+	return oldValue.DefaultPermissions, nil
+}
+
+// AppendDefaultPermissions adds sdp to the "default_permissions" field.
+func (m *FolderMutation) AppendDefaultPermissions(sdp []schema.FolderDefaultPermission) {
+	m.appenddefault_permissions = append(m.appenddefault_permissions, sdp...)
+}
+
+// AppendedDefaultPermissions returns the list of values that were appended to the "default_permissions" field in this mutation.
+func (m *FolderMutation) AppendedDefaultPermissions() ([]schema.FolderDefaultPermission, bool) {
+	if len(m.appenddefault_permissions) == 0 {
+		return nil, false
+	}
+	return m.appenddefault_permissions, true
+}
+
+// ClearDefaultPermissions clears the value of the "default_permissions" field.
+func (m *FolderMutation) ClearDefaultPermissions() {
+	m.default_permissions = nil
+	m.appenddefault_permissions = nil
+	m.clearedFields[folder.FieldDefaultPermissions] = struct{}{}
+}
+
+// DefaultPermissionsCleared returns if the "default_permissions" field was cleared in this mutation.
+func (m *FolderMutation) DefaultPermissionsCleared() bool {
+	_, ok := m.clearedFields[folder.FieldDefaultPermissions]
+	return ok
+}
+
+// ResetDefaultPermissions resets all changes to the "default_permissions" field.
+func (m *FolderMutation) ResetDefaultPermissions() {
+	m.default_permissions = nil
+	m.appenddefault_permissions = nil
+	delete(m.clearedFields, folder.FieldDefaultPermissions)
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (m *FolderMutation) SetAccessPolicy(ap *authz.AccessPolicy) {
+	m.access_policy = &ap
+}
+
+// AccessPolicy returns the value of the "access_policy" field in the mutation.
+func (m *FolderMutation) AccessPolicy() (r *authz.AccessPolicy, exists bool) {
+	v := m.access_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessPolicy returns the old "access_policy" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldAccessPolicy(ctx context.Context) (v *authz.AccessPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessPolicy: %w", err)
+	}
+	return oldValue.AccessPolicy, nil
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (m *FolderMutation) ClearAccessPolicy() {
+	m.access_policy = nil
+	m.clearedFields[folder.FieldAccessPolicy] = struct{}{}
+}
+
+// AccessPolicyCleared returns if the "access_policy" field was cleared in this mutation.
+func (m *FolderMutation) AccessPolicyCleared() bool {
+	_, ok := m.clearedFields[folder.FieldAccessPolicy]
+	return ok
+}
+
+// ResetAccessPolicy resets all changes to the "access_policy" field.
+func (m *FolderMutation) ResetAccessPolicy() {
+	m.access_policy = nil
+	delete(m.clearedFields, folder.FieldAccessPolicy)
+}
+
+// SetProtected sets the "protected" field.
+func (m *FolderMutation) SetProtected(b bool) {
+	m.protected = &b
+}
+
+// Protected returns the value of the "protected" field in the mutation.
+func (m *FolderMutation) Protected() (r bool, exists bool) {
+	v := m.protected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtected returns the old "protected" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldProtected(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtected is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtected requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtected: %w", err)
+	}
+	return oldValue.Protected, nil
+}
+
+// ResetProtected resets all changes to the "protected" field.
+func (m *FolderMutation) ResetProtected() {
+	m.protected = nil
+}
+
+// SetLegalHold sets the "legal_hold" field.
+func (m *FolderMutation) SetLegalHold(b bool) {
+	m.legal_hold = &b
+}
+
+// LegalHold returns the value of the "legal_hold" field in the mutation.
+func (m *FolderMutation) LegalHold() (r bool, exists bool) {
+	v := m.legal_hold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegalHold returns the old "legal_hold" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldLegalHold(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegalHold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegalHold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegalHold: %w", err)
+	}
+	return oldValue.LegalHold, nil
+}
+
+// ResetLegalHold resets all changes to the "legal_hold" field.
+func (m *FolderMutation) ResetLegalHold() {
+	m.legal_hold = nil
+}
+
+// SetLegalHoldReason sets the "legal_hold_reason" field.
+func (m *FolderMutation) SetLegalHoldReason(s string) {
+	m.legal_hold_reason = &s
+}
+
+// LegalHoldReason returns the value of the "legal_hold_reason" field in the mutation.
+func (m *FolderMutation) LegalHoldReason() (r string, exists bool) {
+	v := m.legal_hold_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegalHoldReason returns the old "legal_hold_reason" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldLegalHoldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegalHoldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegalHoldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegalHoldReason: %w", err)
+	}
+	return oldValue.LegalHoldReason, nil
+}
+
+// ClearLegalHoldReason clears the value of the "legal_hold_reason" field.
+func (m *FolderMutation) ClearLegalHoldReason() {
+	m.legal_hold_reason = nil
+	m.clearedFields[folder.FieldLegalHoldReason] = struct{}{}
+}
+
+// LegalHoldReasonCleared returns if the "legal_hold_reason" field was cleared in this mutation.
+func (m *FolderMutation) LegalHoldReasonCleared() bool {
+	_, ok := m.clearedFields[folder.FieldLegalHoldReason]
+	return ok
+}
+
+// ResetLegalHoldReason resets all changes to the "legal_hold_reason" field.
+func (m *FolderMutation) ResetLegalHoldReason() {
+	m.legal_hold_reason = nil
+	delete(m.clearedFields, folder.FieldLegalHoldReason)
+}
+
+// SetStatus sets the "status" field.
+func (m *FolderMutation) SetStatus(f folder.Status) {
+	m.status = &f
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *FolderMutation) Status() (r folder.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldStatus(ctx context.Context) (v folder.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *FolderMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDeletedTime sets the "deleted_time" field.
+func (m *FolderMutation) SetDeletedTime(t time.Time) {
+	m.deleted_time = &t
+}
+
+// DeletedTime returns the value of the "deleted_time" field in the mutation.
+func (m *FolderMutation) DeletedTime() (r time.Time, exists bool) {
+	v := m.deleted_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedTime returns the old "deleted_time" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldDeletedTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedTime: %w", err)
+	}
+	return oldValue.DeletedTime, nil
+}
+
+// ClearDeletedTime clears the value of the "deleted_time" field.
+func (m *FolderMutation) ClearDeletedTime() {
+	m.deleted_time = nil
+	m.clearedFields[folder.FieldDeletedTime] = struct{}{}
+}
+
+// DeletedTimeCleared returns if the "deleted_time" field was cleared in this mutation.
+func (m *FolderMutation) DeletedTimeCleared() bool {
+	_, ok := m.clearedFields[folder.FieldDeletedTime]
+	return ok
+}
+
+// ResetDeletedTime resets all changes to the "deleted_time" field.
+func (m *FolderMutation) ResetDeletedTime() {
+	m.deleted_time = nil
+	delete(m.clearedFields, folder.FieldDeletedTime)
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (m *FolderMutation) SetTrashedWithFolderID(s string) {
+	m.trashed_with_folder_id = &s
+}
+
+// TrashedWithFolderID returns the value of the "trashed_with_folder_id" field in the mutation.
+func (m *FolderMutation) TrashedWithFolderID() (r string, exists bool) {
+	v := m.trashed_with_folder_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrashedWithFolderID returns the old "trashed_with_folder_id" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldTrashedWithFolderID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrashedWithFolderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrashedWithFolderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrashedWithFolderID: %w", err)
+	}
+	return oldValue.TrashedWithFolderID, nil
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (m *FolderMutation) ClearTrashedWithFolderID() {
+	m.trashed_with_folder_id = nil
+	m.clearedFields[folder.FieldTrashedWithFolderID] = struct{}{}
+}
+
+// TrashedWithFolderIDCleared returns if the "trashed_with_folder_id" field was cleared in this mutation.
+func (m *FolderMutation) TrashedWithFolderIDCleared() bool {
+	_, ok := m.clearedFields[folder.FieldTrashedWithFolderID]
+	return ok
+}
+
+// ResetTrashedWithFolderID resets all changes to the "trashed_with_folder_id" field.
+func (m *FolderMutation) ResetTrashedWithFolderID() {
+	m.trashed_with_folder_id = nil
+	delete(m.clearedFields, folder.FieldTrashedWithFolderID)
+}
+
+// SetOriginalParentID sets the "original_parent_id" field.
+func (m *FolderMutation) SetOriginalParentID(s string) {
+	m.original_parent_id = &s
+}
+
+// OriginalParentID returns the value of the "original_parent_id" field in the mutation.
+func (m *FolderMutation) OriginalParentID() (r string, exists bool) {
+	v := m.original_parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginalParentID returns the old "original_parent_id" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldOriginalParentID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalParentID: %w", err)
+	}
+	return oldValue.OriginalParentID, nil
+}
+
+// ClearOriginalParentID clears the value of the "original_parent_id" field.
+func (m *FolderMutation) ClearOriginalParentID() {
+	m.original_parent_id = nil
+	m.clearedFields[folder.FieldOriginalParentID] = struct{}{}
+}
+
+// OriginalParentIDCleared returns if the "original_parent_id" field was cleared in this mutation.
+func (m *FolderMutation) OriginalParentIDCleared() bool {
+	_, ok := m.clearedFields[folder.FieldOriginalParentID]
+	return ok
+}
+
+// ResetOriginalParentID resets all changes to the "original_parent_id" field.
+func (m *FolderMutation) ResetOriginalParentID() {
+	m.original_parent_id = nil
+	delete(m.clearedFields, folder.FieldOriginalParentID)
+}
+
+// SetOriginalPath sets the "original_path" field.
+func (m *FolderMutation) SetOriginalPath(s string) {
+	m.original_path = &s
+}
+
+// OriginalPath returns the value of the "original_path" field in the mutation.
+func (m *FolderMutation) OriginalPath() (r string, exists bool) {
+	v := m.original_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginalPath returns the old "original_path" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldOriginalPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalPath: %w", err)
+	}
+	return oldValue.OriginalPath, nil
+}
+
+// ClearOriginalPath clears the value of the "original_path" field.
+func (m *FolderMutation) ClearOriginalPath() {
+	m.original_path = nil
+	m.clearedFields[folder.FieldOriginalPath] = struct{}{}
+}
+
+// OriginalPathCleared returns if the "original_path" field was cleared in this mutation.
+func (m *FolderMutation) OriginalPathCleared() bool {
+	_, ok := m.clearedFields[folder.FieldOriginalPath]
+	return ok
+}
+
+// ResetOriginalPath resets all changes to the "original_path" field.
+func (m *FolderMutation) ResetOriginalPath() {
+	m.original_path = nil
+	delete(m.clearedFields, folder.FieldOriginalPath)
+}
+
+// ClearParent clears the "parent" edge to the Folder entity.
+func (m *FolderMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[folder.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Folder entity was cleared.
+func (m *FolderMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *FolderMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *FolderMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Folder entity by ids.
+func (m *FolderMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Folder entity.
+func (m *FolderMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Folder entity was cleared.
+func (m *FolderMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Folder entity by IDs.
+func (m *FolderMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Folder entity.
+func (m *FolderMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *FolderMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *FolderMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddSecretIDs adds the "secrets" edge to the Secret entity by ids.
+func (m *FolderMutation) AddSecretIDs(ids ...string) {
+	if m.secrets == nil {
+		m.secrets = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.secrets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSecrets clears the "secrets" edge to the Secret entity.
+func (m *FolderMutation) ClearSecrets() {
+	m.clearedsecrets = true
+}
+
+// SecretsCleared reports if the "secrets" edge to the Secret entity was cleared.
+func (m *FolderMutation) SecretsCleared() bool {
+	return m.clearedsecrets
+}
+
+// RemoveSecretIDs removes the "secrets" edge to the Secret entity by IDs.
+func (m *FolderMutation) RemoveSecretIDs(ids ...string) {
+	if m.removedsecrets == nil {
+		m.removedsecrets = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.secrets, ids[i])
+		m.removedsecrets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSecrets returns the removed IDs of the "secrets" edge to the Secret entity.
+func (m *FolderMutation) RemovedSecretsIDs() (ids []string) {
+	for id := range m.removedsecrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SecretsIDs returns the "secrets" edge IDs in the mutation.
+func (m *FolderMutation) SecretsIDs() (ids []string) {
+	for id := range m.secrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSecrets resets all changes to the "secrets" edge.
+func (m *FolderMutation) ResetSecrets() {
+	m.secrets = nil
+	m.clearedsecrets = false
+	m.removedsecrets = nil
+}
+
+// AddPermissionIDs adds the "permissions" edge to the Permission entity by ids.
+func (m *FolderMutation) AddPermissionIDs(ids ...int) {
+	if m.permissions == nil {
+		m.permissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.permissions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPermissions clears the "permissions" edge to the Permission entity.
+func (m *FolderMutation) ClearPermissions() {
+	m.clearedpermissions = true
+}
+
+// PermissionsCleared reports if the "permissions" edge to the Permission entity was cleared.
+func (m *FolderMutation) PermissionsCleared() bool {
+	return m.clearedpermissions
+}
+
+// RemovePermissionIDs removes the "permissions" edge to the Permission entity by IDs.
+func (m *FolderMutation) RemovePermissionIDs(ids ...int) {
+	if m.removedpermissions == nil {
+		m.removedpermissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.permissions, ids[i])
+		m.removedpermissions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPermissions returns the removed IDs of the "permissions" edge to the Permission entity.
+func (m *FolderMutation) RemovedPermissionsIDs() (ids []int) {
+	for id := range m.removedpermissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PermissionsIDs returns the "permissions" edge IDs in the mutation.
+func (m *FolderMutation) PermissionsIDs() (ids []int) {
+	for id := range m.permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPermissions resets all changes to the "permissions" edge.
+func (m *FolderMutation) ResetPermissions() {
+	m.permissions = nil
+	m.clearedpermissions = false
+	m.removedpermissions = nil
+}
+
+// Where appends a list predicates to the FolderMutation builder.
+func (m *FolderMutation) Where(ps ...predicate.Folder) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FolderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FolderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Folder, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FolderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FolderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Folder).
+func (m *FolderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FolderMutation) Fields() []string {
+	fields := make([]string, 0, 25)
+	if m.create_by != nil {
+		fields = append(fields, folder.FieldCreateBy)
+	}
+	if m.create_time != nil {
+		fields = append(fields, folder.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, folder.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, folder.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, folder.FieldTenantID)
+	}
+	if m.parent != nil {
+		fields = append(fields, folder.FieldParentID)
+	}
+	if m.name != nil {
+		fields = append(fields, folder.FieldName)
+	}
+	if m._path != nil {
+		fields = append(fields, folder.FieldPath)
+	}
+	if m.description != nil {
+		fields = append(fields, folder.FieldDescription)
+	}
+	if m.depth != nil {
+		fields = append(fields, folder.FieldDepth)
+	}
+	if m.last_accessed_time != nil {
+		fields = append(fields, folder.FieldLastAccessedTime)
+	}
+	if m.secret_count != nil {
+		fields = append(fields, folder.FieldSecretCount)
+	}
+	if m.subfolder_count != nil {
+		fields = append(fields, folder.FieldSubfolderCount)
+	}
+	if m.revision != nil {
+		fields = append(fields, folder.FieldRevision)
+	}
+	if m.metadata_schema != nil {
+		fields = append(fields, folder.FieldMetadataSchema)
+	}
+	if m.default_permissions != nil {
+		fields = append(fields, folder.FieldDefaultPermissions)
+	}
+	if m.access_policy != nil {
+		fields = append(fields, folder.FieldAccessPolicy)
+	}
+	if m.protected != nil {
+		fields = append(fields, folder.FieldProtected)
+	}
+	if m.legal_hold != nil {
+		fields = append(fields, folder.FieldLegalHold)
+	}
+	if m.legal_hold_reason != nil {
+		fields = append(fields, folder.FieldLegalHoldReason)
+	}
+	if m.status != nil {
+		fields = append(fields, folder.FieldStatus)
+	}
+	if m.deleted_time != nil {
+		fields = append(fields, folder.FieldDeletedTime)
+	}
+	if m.trashed_with_folder_id != nil {
+		fields = append(fields, folder.FieldTrashedWithFolderID)
+	}
+	if m.original_parent_id != nil {
+		fields = append(fields, folder.FieldOriginalParentID)
+	}
+	if m.original_path != nil {
+		fields = append(fields, folder.FieldOriginalPath)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FolderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case folder.FieldCreateBy:
+		return m.CreateBy()
+	case folder.FieldCreateTime:
+		return m.CreateTime()
+	case folder.FieldUpdateTime:
+		return m.UpdateTime()
+	case folder.FieldDeleteTime:
+		return m.DeleteTime()
+	case folder.FieldTenantID:
+		return m.TenantID()
+	case folder.FieldParentID:
+		return m.ParentID()
+	case folder.FieldName:
+		return m.Name()
+	case folder.FieldPath:
+		return m.Path()
+	case folder.FieldDescription:
+		return m.Description()
+	case folder.FieldDepth:
+		return m.Depth()
+	case folder.FieldLastAccessedTime:
+		return m.LastAccessedTime()
+	case folder.FieldSecretCount:
+		return m.SecretCount()
+	case folder.FieldSubfolderCount:
+		return m.SubfolderCount()
+	case folder.FieldRevision:
+		return m.Revision()
+	case folder.FieldMetadataSchema:
+		return m.MetadataSchema()
+	case folder.FieldDefaultPermissions:
+		return m.DefaultPermissions()
+	case folder.FieldAccessPolicy:
+		return m.AccessPolicy()
+	case folder.FieldProtected:
+		return m.Protected()
+	case folder.FieldLegalHold:
+		return m.LegalHold()
+	case folder.FieldLegalHoldReason:
+		return m.LegalHoldReason()
+	case folder.FieldStatus:
+		return m.Status()
+	case folder.FieldDeletedTime:
+		return m.DeletedTime()
+	case folder.FieldTrashedWithFolderID:
+		return m.TrashedWithFolderID()
+	case folder.FieldOriginalParentID:
+		return m.OriginalParentID()
+	case folder.FieldOriginalPath:
+		return m.OriginalPath()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FolderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case folder.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case folder.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case folder.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case folder.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case folder.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case folder.FieldParentID:
+		return m.OldParentID(ctx)
+	case folder.FieldName:
+		return m.OldName(ctx)
+	case folder.FieldPath:
+		return m.OldPath(ctx)
+	case folder.FieldDescription:
+		return m.OldDescription(ctx)
+	case folder.FieldDepth:
+		return m.OldDepth(ctx)
+	case folder.FieldLastAccessedTime:
+		return m.OldLastAccessedTime(ctx)
+	case folder.FieldSecretCount:
+		return m.OldSecretCount(ctx)
+	case folder.FieldSubfolderCount:
+		return m.OldSubfolderCount(ctx)
+	case folder.FieldRevision:
+		return m.OldRevision(ctx)
+	case folder.FieldMetadataSchema:
+		return m.OldMetadataSchema(ctx)
+	case folder.FieldDefaultPermissions:
+		return m.OldDefaultPermissions(ctx)
+	case folder.FieldAccessPolicy:
+		return m.OldAccessPolicy(ctx)
+	case folder.FieldProtected:
+		return m.OldProtected(ctx)
+	case folder.FieldLegalHold:
+		return m.OldLegalHold(ctx)
+	case folder.FieldLegalHoldReason:
+		return m.OldLegalHoldReason(ctx)
+	case folder.FieldStatus:
+		return m.OldStatus(ctx)
+	case folder.FieldDeletedTime:
+		return m.OldDeletedTime(ctx)
+	case folder.FieldTrashedWithFolderID:
+		return m.OldTrashedWithFolderID(ctx)
+	case folder.FieldOriginalParentID:
+		return m.OldOriginalParentID(ctx)
+	case folder.FieldOriginalPath:
+		return m.OldOriginalPath(ctx)
+	}
+	return nil, fmt.Errorf("unknown Folder field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FolderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case folder.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case folder.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case folder.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case folder.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case folder.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case folder.FieldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case folder.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case folder.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case folder.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case folder.FieldDepth:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepth(v)
+		return nil
+	case folder.FieldLastAccessedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAccessedTime(v)
+		return nil
+	case folder.FieldSecretCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretCount(v)
+		return nil
+	case folder.FieldSubfolderCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubfolderCount(v)
+		return nil
+	case folder.FieldRevision:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case folder.FieldMetadataSchema:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadataSchema(v)
+		return nil
+	case folder.FieldDefaultPermissions:
+		v, ok := value.([]schema.FolderDefaultPermission)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultPermissions(v)
+		return nil
+	case folder.FieldAccessPolicy:
+		v, ok := value.(*authz.AccessPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessPolicy(v)
+		return nil
+	case folder.FieldProtected:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtected(v)
+		return nil
+	case folder.FieldLegalHold:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegalHold(v)
+		return nil
+	case folder.FieldLegalHoldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegalHoldReason(v)
+		return nil
+	case folder.FieldStatus:
+		v, ok := value.(folder.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case folder.FieldDeletedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedTime(v)
+		return nil
+	case folder.FieldTrashedWithFolderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrashedWithFolderID(v)
+		return nil
+	case folder.FieldOriginalParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalParentID(v)
+		return nil
+	case folder.FieldOriginalPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalPath(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Folder field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FolderMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, folder.FieldCreateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, folder.FieldTenantID)
+	}
+	if m.adddepth != nil {
+		fields = append(fields, folder.FieldDepth)
+	}
+	if m.addsecret_count != nil {
+		fields = append(fields, folder.FieldSecretCount)
+	}
+	if m.addsubfolder_count != nil {
+		fields = append(fields, folder.FieldSubfolderCount)
+	}
+	if m.addrevision != nil {
+		fields = append(fields, folder.FieldRevision)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FolderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case folder.FieldCreateBy:
+		return m.AddedCreateBy()
+	case folder.FieldTenantID:
+		return m.AddedTenantID()
+	case folder.FieldDepth:
+		return m.AddedDepth()
+	case folder.FieldSecretCount:
+		return m.AddedSecretCount()
+	case folder.FieldSubfolderCount:
+		return m.AddedSubfolderCount()
+	case folder.FieldRevision:
+		return m.AddedRevision()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FolderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case folder.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case folder.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case folder.FieldDepth:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDepth(v)
+		return nil
+	case folder.FieldSecretCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSecretCount(v)
+		return nil
+	case folder.FieldSubfolderCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubfolderCount(v)
+		return nil
+	case folder.FieldRevision:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Folder numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FolderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(folder.FieldCreateBy) {
+		fields = append(fields, folder.FieldCreateBy)
+	}
+	if m.FieldCleared(folder.FieldCreateTime) {
+		fields = append(fields, folder.FieldCreateTime)
+	}
+	if m.FieldCleared(folder.FieldUpdateTime) {
+		fields = append(fields, folder.FieldUpdateTime)
+	}
+	if m.FieldCleared(folder.FieldDeleteTime) {
+		fields = append(fields, folder.FieldDeleteTime)
+	}
+	if m.FieldCleared(folder.FieldTenantID) {
+		fields = append(fields, folder.FieldTenantID)
+	}
+	if m.FieldCleared(folder.FieldParentID) {
+		fields = append(fields, folder.FieldParentID)
+	}
+	if m.FieldCleared(folder.FieldDescription) {
+		fields = append(fields, folder.FieldDescription)
+	}
+	if m.FieldCleared(folder.FieldLastAccessedTime) {
+		fields = append(fields, folder.FieldLastAccessedTime)
+	}
+	if m.FieldCleared(folder.FieldMetadataSchema) {
+		fields = append(fields, folder.FieldMetadataSchema)
+	}
+	if m.FieldCleared(folder.FieldDefaultPermissions) {
+		fields = append(fields, folder.FieldDefaultPermissions)
+	}
+	if m.FieldCleared(folder.FieldAccessPolicy) {
+		fields = append(fields, folder.FieldAccessPolicy)
+	}
+	if m.FieldCleared(folder.FieldLegalHoldReason) {
+		fields = append(fields, folder.FieldLegalHoldReason)
+	}
+	if m.FieldCleared(folder.FieldDeletedTime) {
+		fields = append(fields, folder.FieldDeletedTime)
+	}
+	if m.FieldCleared(folder.FieldTrashedWithFolderID) {
+		fields = append(fields, folder.FieldTrashedWithFolderID)
+	}
+	if m.FieldCleared(folder.FieldOriginalParentID) {
+		fields = append(fields, folder.FieldOriginalParentID)
+	}
+	if m.FieldCleared(folder.FieldOriginalPath) {
+		fields = append(fields, folder.FieldOriginalPath)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FolderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FolderMutation) ClearField(name string) error {
+	switch name {
+	case folder.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case folder.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case folder.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case folder.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case folder.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case folder.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case folder.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case folder.FieldLastAccessedTime:
+		m.ClearLastAccessedTime()
+		return nil
+	case folder.FieldMetadataSchema:
+		m.ClearMetadataSchema()
+		return nil
+	case folder.FieldDefaultPermissions:
+		m.ClearDefaultPermissions()
+		return nil
+	case folder.FieldAccessPolicy:
+		m.ClearAccessPolicy()
+		return nil
+	case folder.FieldLegalHoldReason:
+		m.ClearLegalHoldReason()
+		return nil
+	case folder.FieldDeletedTime:
+		m.ClearDeletedTime()
+		return nil
+	case folder.FieldTrashedWithFolderID:
+		m.ClearTrashedWithFolderID()
+		return nil
+	case folder.FieldOriginalParentID:
+		m.ClearOriginalParentID()
+		return nil
+	case folder.FieldOriginalPath:
+		m.ClearOriginalPath()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FolderMutation) ResetField(name string) error {
+	switch name {
+	case folder.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case folder.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case folder.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case folder.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case folder.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case folder.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case folder.FieldName:
+		m.ResetName()
+		return nil
+	case folder.FieldPath:
+		m.ResetPath()
+		return nil
+	case folder.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case folder.FieldDepth:
+		m.ResetDepth()
+		return nil
+	case folder.FieldLastAccessedTime:
+		m.ResetLastAccessedTime()
+		return nil
+	case folder.FieldSecretCount:
+		m.ResetSecretCount()
+		return nil
+	case folder.FieldSubfolderCount:
+		m.ResetSubfolderCount()
+		return nil
+	case folder.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case folder.FieldMetadataSchema:
+		m.ResetMetadataSchema()
+		return nil
+	case folder.FieldDefaultPermissions:
+		m.ResetDefaultPermissions()
+		return nil
+	case folder.FieldAccessPolicy:
+		m.ResetAccessPolicy()
+		return nil
+	case folder.FieldProtected:
+		m.ResetProtected()
+		return nil
+	case folder.FieldLegalHold:
+		m.ResetLegalHold()
+		return nil
+	case folder.FieldLegalHoldReason:
+		m.ResetLegalHoldReason()
+		return nil
+	case folder.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case folder.FieldDeletedTime:
+		m.ResetDeletedTime()
+		return nil
+	case folder.FieldTrashedWithFolderID:
+		m.ResetTrashedWithFolderID()
+		return nil
+	case folder.FieldOriginalParentID:
+		m.ResetOriginalParentID()
+		return nil
+	case folder.FieldOriginalPath:
+		m.ResetOriginalPath()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FolderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.parent != nil {
+		edges = append(edges, folder.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.secrets != nil {
+		edges = append(edges, folder.EdgeSecrets)
+	}
+	if m.permissions != nil {
+		edges = append(edges, folder.EdgePermissions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FolderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case folder.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.secrets))
+		for id := range m.secrets {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.permissions))
+		for id := range m.permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FolderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.removedsecrets != nil {
+		edges = append(edges, folder.EdgeSecrets)
+	}
+	if m.removedpermissions != nil {
+		edges = append(edges, folder.EdgePermissions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FolderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.removedsecrets))
+		for id := range m.removedsecrets {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.removedpermissions))
+		for id := range m.removedpermissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FolderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedparent {
+		edges = append(edges, folder.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.clearedsecrets {
+		edges = append(edges, folder.EdgeSecrets)
+	}
+	if m.clearedpermissions {
+		edges = append(edges, folder.EdgePermissions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FolderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case folder.EdgeParent:
+		return m.clearedparent
+	case folder.EdgeChildren:
+		return m.clearedchildren
+	case folder.EdgeSecrets:
+		return m.clearedsecrets
+	case folder.EdgePermissions:
+		return m.clearedpermissions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FolderMutation) ClearEdge(name string) error {
+	switch name {
+	case folder.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FolderMutation) ResetEdge(name string) error {
+	switch name {
+	case folder.EdgeParent:
+		m.ResetParent()
+		return nil
+	case folder.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case folder.EdgeSecrets:
+		m.ResetSecrets()
+		return nil
+	case folder.EdgePermissions:
+		m.ResetPermissions()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder edge %s", name)
+}
+
+// FolderChangeLogMutation represents an operation that mutates the FolderChangeLog nodes in the graph.
+type FolderChangeLogMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint32
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	tenant_id     *uint32
+	addtenant_id  *int32
+	folder_id     *string
+	action        *folderchangelog.Action
+	old_path      *string
+	new_path      *string
+	old_parent_id *string
+	new_parent_id *string
+	actor_id      *string
+	details       *map[string]string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FolderChangeLog, error)
+	predicates    []predicate.FolderChangeLog
+}
+
+var _ ent.Mutation = (*FolderChangeLogMutation)(nil)
+
+// folderchangelogOption allows management of the mutation configuration using functional options.
+type folderchangelogOption func(*FolderChangeLogMutation)
+
+// newFolderChangeLogMutation creates new mutation for the FolderChangeLog entity.
+func newFolderChangeLogMutation(c config, op Op, opts ...folderchangelogOption) *FolderChangeLogMutation {
+	m := &FolderChangeLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFolderChangeLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFolderChangeLogID sets the ID field of the mutation.
+func withFolderChangeLogID(id uint32) folderchangelogOption {
+	return func(m *FolderChangeLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FolderChangeLog
+		)
+		m.oldValue = func(ctx context.Context) (*FolderChangeLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FolderChangeLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFolderChangeLog sets the old FolderChangeLog of the mutation.
+func withFolderChangeLog(node *FolderChangeLog) folderchangelogOption {
+	return func(m *FolderChangeLogMutation) {
+		m.oldValue = func(context.Context) (*FolderChangeLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FolderChangeLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FolderChangeLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FolderChangeLog entities.
+func (m *FolderChangeLogMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FolderChangeLogMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FolderChangeLogMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FolderChangeLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *FolderChangeLogMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *FolderChangeLogMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *FolderChangeLogMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[folderchangelog.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *FolderChangeLogMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[folderchangelog.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *FolderChangeLogMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, folderchangelog.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *FolderChangeLogMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *FolderChangeLogMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *FolderChangeLogMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[folderchangelog.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *FolderChangeLogMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[folderchangelog.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *FolderChangeLogMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, folderchangelog.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *FolderChangeLogMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *FolderChangeLogMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *FolderChangeLogMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[folderchangelog.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *FolderChangeLogMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[folderchangelog.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *FolderChangeLogMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, folderchangelog.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *FolderChangeLogMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *FolderChangeLogMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *FolderChangeLogMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *FolderChangeLogMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *FolderChangeLogMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[folderchangelog.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *FolderChangeLogMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[folderchangelog.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *FolderChangeLogMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, folderchangelog.FieldTenantID)
+}
+
+// SetFolderID sets the "folder_id" field.
+func (m *FolderChangeLogMutation) SetFolderID(s string) {
+	m.folder_id = &s
+}
+
+// FolderID returns the value of the "folder_id" field in the mutation.
+func (m *FolderChangeLogMutation) FolderID() (r string, exists bool) {
+	v := m.folder_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFolderID returns the old "folder_id" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldFolderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFolderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFolderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFolderID: %w", err)
+	}
+	return oldValue.FolderID, nil
+}
+
+// ResetFolderID resets all changes to the "folder_id" field.
+func (m *FolderChangeLogMutation) ResetFolderID() {
+	m.folder_id = nil
+}
+
+// SetAction sets the "action" field.
+func (m *FolderChangeLogMutation) SetAction(f folderchangelog.Action) {
+	m.action = &f
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *FolderChangeLogMutation) Action() (r folderchangelog.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldAction(ctx context.Context) (v folderchangelog.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *FolderChangeLogMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetOldPath sets the "old_path" field.
+func (m *FolderChangeLogMutation) SetOldPath(s string) {
+	m.old_path = &s
+}
+
+// OldPath returns the value of the "old_path" field in the mutation.
+func (m *FolderChangeLogMutation) OldPath() (r string, exists bool) {
+	v := m.old_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldPath returns the old "old_path" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldOldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldPath: %w", err)
+	}
+	return oldValue.OldPath, nil
+}
+
+// ClearOldPath clears the value of the "old_path" field.
+func (m *FolderChangeLogMutation) ClearOldPath() {
+	m.old_path = nil
+	m.clearedFields[folderchangelog.FieldOldPath] = struct{}{}
+}
+
+// OldPathCleared returns if the "old_path" field was cleared in this mutation.
+func (m *FolderChangeLogMutation) OldPathCleared() bool {
+	_, ok := m.clearedFields[folderchangelog.FieldOldPath]
+	return ok
+}
+
+// ResetOldPath resets all changes to the "old_path" field.
+func (m *FolderChangeLogMutation) ResetOldPath() {
+	m.old_path = nil
+	delete(m.clearedFields, folderchangelog.FieldOldPath)
+}
+
+// SetNewPath sets the "new_path" field.
+func (m *FolderChangeLogMutation) SetNewPath(s string) {
+	m.new_path = &s
+}
+
+// NewPath returns the value of the "new_path" field in the mutation.
+func (m *FolderChangeLogMutation) NewPath() (r string, exists bool) {
+	v := m.new_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewPath returns the old "new_path" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldNewPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewPath: %w", err)
+	}
+	return oldValue.NewPath, nil
+}
+
+// ClearNewPath clears the value of the "new_path" field.
+func (m *FolderChangeLogMutation) ClearNewPath() {
+	m.new_path = nil
+	m.clearedFields[folderchangelog.FieldNewPath] = struct{}{}
+}
+
+// NewPathCleared returns if the "new_path" field was cleared in this mutation.
+func (m *FolderChangeLogMutation) NewPathCleared() bool {
+	_, ok := m.clearedFields[folderchangelog.FieldNewPath]
+	return ok
+}
+
+// ResetNewPath resets all changes to the "new_path" field.
+func (m *FolderChangeLogMutation) ResetNewPath() {
+	m.new_path = nil
+	delete(m.clearedFields, folderchangelog.FieldNewPath)
+}
+
+// SetOldParentID sets the "old_parent_id" field.
+func (m *FolderChangeLogMutation) SetOldParentID(s string) {
+	m.old_parent_id = &s
+}
+
+// OldParentID returns the value of the "old_parent_id" field in the mutation.
+func (m *FolderChangeLogMutation) OldParentID() (r string, exists bool) {
+	v := m.old_parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldParentID returns the old "old_parent_id" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldOldParentID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldParentID: %w", err)
+	}
+	return oldValue.OldParentID, nil
+}
+
+// ClearOldParentID clears the value of the "old_parent_id" field.
+func (m *FolderChangeLogMutation) ClearOldParentID() {
+	m.old_parent_id = nil
+	m.clearedFields[folderchangelog.FieldOldParentID] = struct{}{}
+}
+
+// OldParentIDCleared returns if the "old_parent_id" field was cleared in this mutation.
+func (m *FolderChangeLogMutation) OldParentIDCleared() bool {
+	_, ok := m.clearedFields[folderchangelog.FieldOldParentID]
+	return ok
+}
+
+// ResetOldParentID resets all changes to the "old_parent_id" field.
+func (m *FolderChangeLogMutation) ResetOldParentID() {
+	m.old_parent_id = nil
+	delete(m.clearedFields, folderchangelog.FieldOldParentID)
+}
+
+// SetNewParentID sets the "new_parent_id" field.
+func (m *FolderChangeLogMutation) SetNewParentID(s string) {
+	m.new_parent_id = &s
+}
+
+// NewParentID returns the value of the "new_parent_id" field in the mutation.
+func (m *FolderChangeLogMutation) NewParentID() (r string, exists bool) {
+	v := m.new_parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewParentID returns the old "new_parent_id" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldNewParentID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewParentID: %w", err)
+	}
+	return oldValue.NewParentID, nil
+}
+
+// ClearNewParentID clears the value of the "new_parent_id" field.
+func (m *FolderChangeLogMutation) ClearNewParentID() {
+	m.new_parent_id = nil
+	m.clearedFields[folderchangelog.FieldNewParentID] = struct{}{}
+}
+
+// NewParentIDCleared returns if the "new_parent_id" field was cleared in this mutation.
+func (m *FolderChangeLogMutation) NewParentIDCleared() bool {
+	_, ok := m.clearedFields[folderchangelog.FieldNewParentID]
+	return ok
+}
+
+// ResetNewParentID resets all changes to the "new_parent_id" field.
+func (m *FolderChangeLogMutation) ResetNewParentID() {
+	m.new_parent_id = nil
+	delete(m.clearedFields, folderchangelog.FieldNewParentID)
+}
+
+// SetActorID sets the "actor_id" field.
+func (m *FolderChangeLogMutation) SetActorID(s string) {
+	m.actor_id = &s
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *FolderChangeLogMutation) ActorID() (r string, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldActorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (m *FolderChangeLogMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[folderchangelog.FieldActorID] = struct{}{}
+}
+
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *FolderChangeLogMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[folderchangelog.FieldActorID]
+	return ok
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *FolderChangeLogMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, folderchangelog.FieldActorID)
+}
+
+// SetDetails sets the "details" field.
+func (m *FolderChangeLogMutation) SetDetails(value map[string]string) {
+	m.details = &value
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *FolderChangeLogMutation) Details() (r map[string]string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the FolderChangeLog entity.
+// If the FolderChangeLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderChangeLogMutation) OldDetails(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *FolderChangeLogMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[folderchangelog.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *FolderChangeLogMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[folderchangelog.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *FolderChangeLogMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, folderchangelog.FieldDetails)
+}
+
+// Where appends a list predicates to the FolderChangeLogMutation builder.
+func (m *FolderChangeLogMutation) Where(ps ...predicate.FolderChangeLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FolderChangeLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FolderChangeLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FolderChangeLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FolderChangeLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FolderChangeLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FolderChangeLog).
+func (m *FolderChangeLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FolderChangeLogMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.create_time != nil {
+		fields = append(fields, folderchangelog.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, folderchangelog.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, folderchangelog.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, folderchangelog.FieldTenantID)
+	}
+	if m.folder_id != nil {
+		fields = append(fields, folderchangelog.FieldFolderID)
+	}
+	if m.action != nil {
+		fields = append(fields, folderchangelog.FieldAction)
+	}
+	if m.old_path != nil {
+		fields = append(fields, folderchangelog.FieldOldPath)
+	}
+	if m.new_path != nil {
+		fields = append(fields, folderchangelog.FieldNewPath)
+	}
+	if m.old_parent_id != nil {
+		fields = append(fields, folderchangelog.FieldOldParentID)
+	}
+	if m.new_parent_id != nil {
+		fields = append(fields, folderchangelog.FieldNewParentID)
+	}
+	if m.actor_id != nil {
+		fields = append(fields, folderchangelog.FieldActorID)
+	}
+	if m.details != nil {
+		fields = append(fields, folderchangelog.FieldDetails)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FolderChangeLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case folderchangelog.FieldCreateTime:
+		return m.CreateTime()
+	case folderchangelog.FieldUpdateTime:
+		return m.UpdateTime()
+	case folderchangelog.FieldDeleteTime:
+		return m.DeleteTime()
+	case folderchangelog.FieldTenantID:
+		return m.TenantID()
+	case folderchangelog.FieldFolderID:
+		return m.FolderID()
+	case folderchangelog.FieldAction:
+		return m.Action()
+	case folderchangelog.FieldOldPath:
+		return m.OldPath()
+	case folderchangelog.FieldNewPath:
+		return m.NewPath()
+	case folderchangelog.FieldOldParentID:
+		return m.OldParentID()
+	case folderchangelog.FieldNewParentID:
+		return m.NewParentID()
+	case folderchangelog.FieldActorID:
+		return m.ActorID()
+	case folderchangelog.FieldDetails:
+		return m.Details()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FolderChangeLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case folderchangelog.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case folderchangelog.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case folderchangelog.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case folderchangelog.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case folderchangelog.FieldFolderID:
+		return m.OldFolderID(ctx)
+	case folderchangelog.FieldAction:
+		return m.OldAction(ctx)
+	case folderchangelog.FieldOldPath:
+		return m.OldOldPath(ctx)
+	case folderchangelog.FieldNewPath:
+		return m.OldNewPath(ctx)
+	case folderchangelog.FieldOldParentID:
+		return m.OldOldParentID(ctx)
+	case folderchangelog.FieldNewParentID:
+		return m.OldNewParentID(ctx)
+	case folderchangelog.FieldActorID:
+		return m.OldActorID(ctx)
+	case folderchangelog.FieldDetails:
+		return m.OldDetails(ctx)
+	}
+	return nil, fmt.Errorf("unknown FolderChangeLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FolderChangeLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case folderchangelog.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case folderchangelog.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case folderchangelog.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case folderchangelog.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case folderchangelog.FieldFolderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFolderID(v)
+		return nil
+	case folderchangelog.FieldAction:
+		v, ok := value.(folderchangelog.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case folderchangelog.FieldOldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldPath(v)
+		return nil
+	case folderchangelog.FieldNewPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewPath(v)
+		return nil
+	case folderchangelog.FieldOldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldParentID(v)
+		return nil
+	case folderchangelog.FieldNewParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewParentID(v)
+		return nil
+	case folderchangelog.FieldActorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
+	case folderchangelog.FieldDetails:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FolderChangeLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FolderChangeLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, folderchangelog.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FolderChangeLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case folderchangelog.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FolderChangeLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case folderchangelog.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FolderChangeLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FolderChangeLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(folderchangelog.FieldCreateTime) {
+		fields = append(fields, folderchangelog.FieldCreateTime)
+	}
+	if m.FieldCleared(folderchangelog.FieldUpdateTime) {
+		fields = append(fields, folderchangelog.FieldUpdateTime)
+	}
+	if m.FieldCleared(folderchangelog.FieldDeleteTime) {
+		fields = append(fields, folderchangelog.FieldDeleteTime)
+	}
+	if m.FieldCleared(folderchangelog.FieldTenantID) {
+		fields = append(fields, folderchangelog.FieldTenantID)
+	}
+	if m.FieldCleared(folderchangelog.FieldOldPath) {
+		fields = append(fields, folderchangelog.FieldOldPath)
+	}
+	if m.FieldCleared(folderchangelog.FieldNewPath) {
+		fields = append(fields, folderchangelog.FieldNewPath)
+	}
+	if m.FieldCleared(folderchangelog.FieldOldParentID) {
+		fields = append(fields, folderchangelog.FieldOldParentID)
+	}
+	if m.FieldCleared(folderchangelog.FieldNewParentID) {
+		fields = append(fields, folderchangelog.FieldNewParentID)
+	}
+	if m.FieldCleared(folderchangelog.FieldActorID) {
+		fields = append(fields, folderchangelog.FieldActorID)
+	}
+	if m.FieldCleared(folderchangelog.FieldDetails) {
+		fields = append(fields, folderchangelog.FieldDetails)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FolderChangeLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FolderChangeLogMutation) ClearField(name string) error {
+	switch name {
+	case folderchangelog.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case folderchangelog.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case folderchangelog.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case folderchangelog.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case folderchangelog.FieldOldPath:
+		m.ClearOldPath()
+		return nil
+	case folderchangelog.FieldNewPath:
+		m.ClearNewPath()
+		return nil
+	case folderchangelog.FieldOldParentID:
+		m.ClearOldParentID()
+		return nil
+	case folderchangelog.FieldNewParentID:
+		m.ClearNewParentID()
+		return nil
+	case folderchangelog.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case folderchangelog.FieldDetails:
+		m.ClearDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown FolderChangeLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FolderChangeLogMutation) ResetField(name string) error {
+	switch name {
+	case folderchangelog.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case folderchangelog.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case folderchangelog.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case folderchangelog.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case folderchangelog.FieldFolderID:
+		m.ResetFolderID()
+		return nil
+	case folderchangelog.FieldAction:
+		m.ResetAction()
+		return nil
+	case folderchangelog.FieldOldPath:
+		m.ResetOldPath()
+		return nil
+	case folderchangelog.FieldNewPath:
+		m.ResetNewPath()
+		return nil
+	case folderchangelog.FieldOldParentID:
+		m.ResetOldParentID()
+		return nil
+	case folderchangelog.FieldNewParentID:
+		m.ResetNewParentID()
+		return nil
+	case folderchangelog.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case folderchangelog.FieldDetails:
+		m.ResetDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown FolderChangeLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FolderChangeLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FolderChangeLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FolderChangeLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FolderChangeLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FolderChangeLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FolderChangeLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FolderChangeLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FolderChangeLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FolderChangeLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FolderChangeLog edge %s", name)
+}
+
+// PendingOperationMutation represents an operation that mutates the PendingOperation nodes in the graph.
+type PendingOperationMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uint32
+	create_time     *time.Time
+	update_time     *time.Time
+	delete_time     *time.Time
+	tenant_id       *uint32
+	addtenant_id    *int32
+	kind            *pendingoperation.Kind
+	secret_id       *string
+	vault_path      *string
+	totp_path       *string
+	attempts        *int32
+	addattempts     *int32
+	last_error      *string
+	next_attempt_at *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*PendingOperation, error)
+	predicates      []predicate.PendingOperation
+}
+
+var _ ent.Mutation = (*PendingOperationMutation)(nil)
+
+// pendingoperationOption allows management of the mutation configuration using functional options.
+type pendingoperationOption func(*PendingOperationMutation)
+
+// newPendingOperationMutation creates new mutation for the PendingOperation entity.
+func newPendingOperationMutation(c config, op Op, opts ...pendingoperationOption) *PendingOperationMutation {
+	m := &PendingOperationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePendingOperation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPendingOperationID sets the ID field of the mutation.
+func withPendingOperationID(id uint32) pendingoperationOption {
+	return func(m *PendingOperationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PendingOperation
+		)
+		m.oldValue = func(ctx context.Context) (*PendingOperation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PendingOperation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPendingOperation sets the old PendingOperation of the mutation.
+func withPendingOperation(node *PendingOperation) pendingoperationOption {
+	return func(m *PendingOperationMutation) {
+		m.oldValue = func(context.Context) (*PendingOperation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PendingOperationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PendingOperationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PendingOperation entities.
+func (m *PendingOperationMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PendingOperationMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PendingOperationMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PendingOperation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *PendingOperationMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *PendingOperationMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the PendingOperation entity.
+// If the PendingOperation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingOperationMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *PendingOperationMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[pendingoperation.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *PendingOperationMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[pendingoperation.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *PendingOperationMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, pendingoperation.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *PendingOperationMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *PendingOperationMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the PendingOperation entity.
+// If the PendingOperation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingOperationMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *PendingOperationMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[pendingoperation.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *PendingOperationMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[pendingoperation.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *PendingOperationMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, pendingoperation.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *PendingOperationMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *PendingOperationMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the PendingOperation entity.
+// If the PendingOperation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingOperationMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *PendingOperationMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[pendingoperation.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *PendingOperationMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[pendingoperation.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *PendingOperationMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, pendingoperation.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *PendingOperationMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *PendingOperationMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the PendingOperation entity.
+// If the PendingOperation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingOperationMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *PendingOperationMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *PendingOperationMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *PendingOperationMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[pendingoperation.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *PendingOperationMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[pendingoperation.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *PendingOperationMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, pendingoperation.FieldTenantID)
+}
+
+// SetKind sets the "kind" field.
+func (m *PendingOperationMutation) SetKind(pe pendingoperation.Kind) {
+	m.kind = &pe
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *PendingOperationMutation) Kind() (r pendingoperation.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the PendingOperation entity.
+// If the PendingOperation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingOperationMutation) OldKind(ctx context.Context) (v pendingoperation.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *PendingOperationMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetSecretID sets the "secret_id" field.
+func (m *PendingOperationMutation) SetSecretID(s string) {
+	m.secret_id = &s
+}
+
+// SecretID returns the value of the "secret_id" field in the mutation.
+func (m *PendingOperationMutation) SecretID() (r string, exists bool) {
+	v := m.secret_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretID returns the old "secret_id" field's value of the PendingOperation entity.
+// If the PendingOperation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingOperationMutation) OldSecretID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretID: %w", err)
+	}
+	return oldValue.SecretID, nil
+}
+
+// ResetSecretID resets all changes to the "secret_id" field.
+func (m *PendingOperationMutation) ResetSecretID() {
+	m.secret_id = nil
+}
+
+// SetVaultPath sets the "vault_path" field.
+func (m *PendingOperationMutation) SetVaultPath(s string) {
+	m.vault_path = &s
+}
+
+// VaultPath returns the value of the "vault_path" field in the mutation.
+func (m *PendingOperationMutation) VaultPath() (r string, exists bool) {
+	v := m.vault_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVaultPath returns the old "vault_path" field's value of the PendingOperation entity.
+// If the PendingOperation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingOperationMutation) OldVaultPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVaultPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVaultPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVaultPath: %w", err)
+	}
+	return oldValue.VaultPath, nil
+}
+
+// ResetVaultPath resets all changes to the "vault_path" field.
+func (m *PendingOperationMutation) ResetVaultPath() {
+	m.vault_path = nil
+}
+
+// SetTotpPath sets the "totp_path" field.
+func (m *PendingOperationMutation) SetTotpPath(s string) {
+	m.totp_path = &s
+}
+
+// TotpPath returns the value of the "totp_path" field in the mutation.
+func (m *PendingOperationMutation) TotpPath() (r string, exists bool) {
+	v := m.totp_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotpPath returns the old "totp_path" field's value of the PendingOperation entity.
+// If the PendingOperation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingOperationMutation) OldTotpPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotpPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpPath: %w", err)
+	}
+	return oldValue.TotpPath, nil
+}
+
+// ClearTotpPath clears the value of the "totp_path" field.
+func (m *PendingOperationMutation) ClearTotpPath() {
+	m.totp_path = nil
+	m.clearedFields[pendingoperation.FieldTotpPath] = struct{}{}
+}
+
+// TotpPathCleared returns if the "totp_path" field was cleared in this mutation.
+func (m *PendingOperationMutation) TotpPathCleared() bool {
+	_, ok := m.clearedFields[pendingoperation.FieldTotpPath]
+	return ok
+}
+
+// ResetTotpPath resets all changes to the "totp_path" field.
+func (m *PendingOperationMutation) ResetTotpPath() {
+	m.totp_path = nil
+	delete(m.clearedFields, pendingoperation.FieldTotpPath)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *PendingOperationMutation) SetAttempts(i int32) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *PendingOperationMutation) Attempts() (r int32, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the PendingOperation entity.
+// If the PendingOperation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingOperationMutation) OldAttempts(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *PendingOperationMutation) AddAttempts(i int32) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *PendingOperationMutation) AddedAttempts() (r int32, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *PendingOperationMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *PendingOperationMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *PendingOperationMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the PendingOperation entity.
+// If the PendingOperation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingOperationMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *PendingOperationMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[pendingoperation.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *PendingOperationMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[pendingoperation.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *PendingOperationMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, pendingoperation.FieldLastError)
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *PendingOperationMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *PendingOperationMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the PendingOperation entity.
+// If the PendingOperation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingOperationMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *PendingOperationMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// Where appends a list predicates to the PendingOperationMutation builder.
+func (m *PendingOperationMutation) Where(ps ...predicate.PendingOperation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PendingOperationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PendingOperationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PendingOperation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PendingOperationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PendingOperationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PendingOperation).
+func (m *PendingOperationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PendingOperationMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, pendingoperation.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, pendingoperation.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, pendingoperation.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, pendingoperation.FieldTenantID)
+	}
+	if m.kind != nil {
+		fields = append(fields, pendingoperation.FieldKind)
+	}
+	if m.secret_id != nil {
+		fields = append(fields, pendingoperation.FieldSecretID)
+	}
+	if m.vault_path != nil {
+		fields = append(fields, pendingoperation.FieldVaultPath)
+	}
+	if m.totp_path != nil {
+		fields = append(fields, pendingoperation.FieldTotpPath)
+	}
+	if m.attempts != nil {
+		fields = append(fields, pendingoperation.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, pendingoperation.FieldLastError)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, pendingoperation.FieldNextAttemptAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PendingOperationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pendingoperation.FieldCreateTime:
+		return m.CreateTime()
+	case pendingoperation.FieldUpdateTime:
+		return m.UpdateTime()
+	case pendingoperation.FieldDeleteTime:
+		return m.DeleteTime()
+	case pendingoperation.FieldTenantID:
+		return m.TenantID()
+	case pendingoperation.FieldKind:
+		return m.Kind()
+	case pendingoperation.FieldSecretID:
+		return m.SecretID()
+	case pendingoperation.FieldVaultPath:
+		return m.VaultPath()
+	case pendingoperation.FieldTotpPath:
+		return m.TotpPath()
+	case pendingoperation.FieldAttempts:
+		return m.Attempts()
+	case pendingoperation.FieldLastError:
+		return m.LastError()
+	case pendingoperation.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PendingOperationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pendingoperation.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case pendingoperation.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case pendingoperation.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case pendingoperation.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case pendingoperation.FieldKind:
+		return m.OldKind(ctx)
+	case pendingoperation.FieldSecretID:
+		return m.OldSecretID(ctx)
+	case pendingoperation.FieldVaultPath:
+		return m.OldVaultPath(ctx)
+	case pendingoperation.FieldTotpPath:
+		return m.OldTotpPath(ctx)
+	case pendingoperation.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case pendingoperation.FieldLastError:
+		return m.OldLastError(ctx)
+	case pendingoperation.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PendingOperation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PendingOperationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pendingoperation.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case pendingoperation.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case pendingoperation.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case pendingoperation.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case pendingoperation.FieldKind:
+		v, ok := value.(pendingoperation.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case pendingoperation.FieldSecretID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretID(v)
+		return nil
+	case pendingoperation.FieldVaultPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVaultPath(v)
+		return nil
+	case pendingoperation.FieldTotpPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpPath(v)
+		return nil
+	case pendingoperation.FieldAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case pendingoperation.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case pendingoperation.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PendingOperation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PendingOperationMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, pendingoperation.FieldTenantID)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, pendingoperation.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PendingOperationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pendingoperation.FieldTenantID:
+		return m.AddedTenantID()
+	case pendingoperation.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PendingOperationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pendingoperation.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case pendingoperation.FieldAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PendingOperation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PendingOperationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pendingoperation.FieldCreateTime) {
+		fields = append(fields, pendingoperation.FieldCreateTime)
+	}
+	if m.FieldCleared(pendingoperation.FieldUpdateTime) {
+		fields = append(fields, pendingoperation.FieldUpdateTime)
+	}
+	if m.FieldCleared(pendingoperation.FieldDeleteTime) {
+		fields = append(fields, pendingoperation.FieldDeleteTime)
+	}
+	if m.FieldCleared(pendingoperation.FieldTenantID) {
+		fields = append(fields, pendingoperation.FieldTenantID)
+	}
+	if m.FieldCleared(pendingoperation.FieldTotpPath) {
+		fields = append(fields, pendingoperation.FieldTotpPath)
+	}
+	if m.FieldCleared(pendingoperation.FieldLastError) {
+		fields = append(fields, pendingoperation.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PendingOperationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PendingOperationMutation) ClearField(name string) error {
+	switch name {
+	case pendingoperation.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case pendingoperation.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case pendingoperation.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case pendingoperation.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case pendingoperation.FieldTotpPath:
+		m.ClearTotpPath()
+		return nil
+	case pendingoperation.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown PendingOperation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PendingOperationMutation) ResetField(name string) error {
+	switch name {
+	case pendingoperation.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case pendingoperation.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case pendingoperation.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case pendingoperation.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case pendingoperation.FieldKind:
+		m.ResetKind()
+		return nil
+	case pendingoperation.FieldSecretID:
+		m.ResetSecretID()
+		return nil
+	case pendingoperation.FieldVaultPath:
+		m.ResetVaultPath()
+		return nil
+	case pendingoperation.FieldTotpPath:
+		m.ResetTotpPath()
+		return nil
+	case pendingoperation.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case pendingoperation.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case pendingoperation.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PendingOperation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PendingOperationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PendingOperationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PendingOperationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PendingOperationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PendingOperationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PendingOperationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PendingOperationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PendingOperation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PendingOperationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PendingOperation edge %s", name)
+}
+
+// PermissionMutation represents an operation that mutates the Permission nodes in the graph.
+type PermissionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	tenant_id     *uint32
+	addtenant_id  *int32
+	resource_type *permission.ResourceType
+	resource_id   *string
+	relation      *permission.Relation
+	subject_type  *permission.SubjectType
+	subject_id    *string
+	granted_by    *uint32
+	addgranted_by *int32
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	folder        *string
+	clearedfolder bool
+	secret        *string
+	clearedsecret bool
+	done          bool
+	oldValue      func(context.Context) (*Permission, error)
+	predicates    []predicate.Permission
+}
+
+var _ ent.Mutation = (*PermissionMutation)(nil)
+
+// permissionOption allows management of the mutation configuration using functional options.
+type permissionOption func(*PermissionMutation)
+
+// newPermissionMutation creates new mutation for the Permission entity.
+func newPermissionMutation(c config, op Op, opts ...permissionOption) *PermissionMutation {
+	m := &PermissionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePermission,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPermissionID sets the ID field of the mutation.
+func withPermissionID(id int) permissionOption {
+	return func(m *PermissionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Permission
+		)
+		m.oldValue = func(ctx context.Context) (*Permission, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Permission.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPermission sets the old Permission of the mutation.
+func withPermission(node *Permission) permissionOption {
+	return func(m *PermissionMutation) {
+		m.oldValue = func(context.Context) (*Permission, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PermissionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PermissionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PermissionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PermissionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Permission.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *PermissionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *PermissionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *PermissionMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[permission.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *PermissionMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[permission.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *PermissionMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, permission.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *PermissionMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *PermissionMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *PermissionMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[permission.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *PermissionMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[permission.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *PermissionMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, permission.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *PermissionMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *PermissionMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *PermissionMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[permission.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *PermissionMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[permission.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *PermissionMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, permission.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *PermissionMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *PermissionMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *PermissionMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *PermissionMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *PermissionMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[permission.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *PermissionMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[permission.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *PermissionMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, permission.FieldTenantID)
+}
+
+// SetResourceType sets the "resource_type" field.
+func (m *PermissionMutation) SetResourceType(pt permission.ResourceType) {
+	m.resource_type = &pt
+}
+
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *PermissionMutation) ResourceType() (r permission.ResourceType, exists bool) {
+	v := m.resource_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceType returns the old "resource_type" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldResourceType(ctx context.Context) (v permission.ResourceType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+	}
+	return oldValue.ResourceType, nil
+}
+
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *PermissionMutation) ResetResourceType() {
+	m.resource_type = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *PermissionMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *PermissionMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *PermissionMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetRelation sets the "relation" field.
+func (m *PermissionMutation) SetRelation(pe permission.Relation) {
+	m.relation = &pe
+}
+
+// Relation returns the value of the "relation" field in the mutation.
+func (m *PermissionMutation) Relation() (r permission.Relation, exists bool) {
+	v := m.relation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRelation returns the old "relation" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldRelation(ctx context.Context) (v permission.Relation, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRelation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRelation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRelation: %w", err)
+	}
+	return oldValue.Relation, nil
+}
+
+// ResetRelation resets all changes to the "relation" field.
+func (m *PermissionMutation) ResetRelation() {
+	m.relation = nil
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (m *PermissionMutation) SetSubjectType(pt permission.SubjectType) {
+	m.subject_type = &pt
+}
+
+// SubjectType returns the value of the "subject_type" field in the mutation.
+func (m *PermissionMutation) SubjectType() (r permission.SubjectType, exists bool) {
+	v := m.subject_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectType returns the old "subject_type" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldSubjectType(ctx context.Context) (v permission.SubjectType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectType: %w", err)
+	}
+	return oldValue.SubjectType, nil
+}
+
+// ResetSubjectType resets all changes to the "subject_type" field.
+func (m *PermissionMutation) ResetSubjectType() {
+	m.subject_type = nil
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (m *PermissionMutation) SetSubjectID(s string) {
+	m.subject_id = &s
+}
+
+// SubjectID returns the value of the "subject_id" field in the mutation.
+func (m *PermissionMutation) SubjectID() (r string, exists bool) {
+	v := m.subject_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectID returns the old "subject_id" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldSubjectID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectID: %w", err)
+	}
+	return oldValue.SubjectID, nil
+}
+
+// ResetSubjectID resets all changes to the "subject_id" field.
+func (m *PermissionMutation) ResetSubjectID() {
+	m.subject_id = nil
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (m *PermissionMutation) SetGrantedBy(u uint32) {
+	m.granted_by = &u
+	m.addgranted_by = nil
+}
+
+// GrantedBy returns the value of the "granted_by" field in the mutation.
+func (m *PermissionMutation) GrantedBy() (r uint32, exists bool) {
+	v := m.granted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantedBy returns the old "granted_by" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldGrantedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantedBy: %w", err)
+	}
+	return oldValue.GrantedBy, nil
+}
+
+// AddGrantedBy adds u to the "granted_by" field.
+func (m *PermissionMutation) AddGrantedBy(u int32) {
+	if m.addgranted_by != nil {
+		*m.addgranted_by += u
+	} else {
+		m.addgranted_by = &u
+	}
+}
+
+// AddedGrantedBy returns the value that was added to the "granted_by" field in this mutation.
+func (m *PermissionMutation) AddedGrantedBy() (r int32, exists bool) {
+	v := m.addgranted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (m *PermissionMutation) ClearGrantedBy() {
+	m.granted_by = nil
+	m.addgranted_by = nil
+	m.clearedFields[permission.FieldGrantedBy] = struct{}{}
+}
+
+// GrantedByCleared returns if the "granted_by" field was cleared in this mutation.
+func (m *PermissionMutation) GrantedByCleared() bool {
+	_, ok := m.clearedFields[permission.FieldGrantedBy]
+	return ok
+}
+
+// ResetGrantedBy resets all changes to the "granted_by" field.
+func (m *PermissionMutation) ResetGrantedBy() {
+	m.granted_by = nil
+	m.addgranted_by = nil
+	delete(m.clearedFields, permission.FieldGrantedBy)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PermissionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PermissionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *PermissionMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[permission.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *PermissionMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[permission.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PermissionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, permission.FieldExpiresAt)
+}
+
+// SetFolderID sets the "folder" edge to the Folder entity by id.
+func (m *PermissionMutation) SetFolderID(id string) {
+	m.folder = &id
+}
+
+// ClearFolder clears the "folder" edge to the Folder entity.
+func (m *PermissionMutation) ClearFolder() {
+	m.clearedfolder = true
+}
+
+// FolderCleared reports if the "folder" edge to the Folder entity was cleared.
+func (m *PermissionMutation) FolderCleared() bool {
+	return m.clearedfolder
+}
+
+// FolderID returns the "folder" edge ID in the mutation.
+func (m *PermissionMutation) FolderID() (id string, exists bool) {
+	if m.folder != nil {
+		return *m.folder, true
+	}
+	return
+}
+
+// FolderIDs returns the "folder" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FolderID instead. It exists only for internal usage by the builders.
+func (m *PermissionMutation) FolderIDs() (ids []string) {
+	if id := m.folder; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFolder resets all changes to the "folder" edge.
+func (m *PermissionMutation) ResetFolder() {
+	m.folder = nil
+	m.clearedfolder = false
+}
+
+// SetSecretID sets the "secret" edge to the Secret entity by id.
+func (m *PermissionMutation) SetSecretID(id string) {
+	m.secret = &id
+}
+
+// ClearSecret clears the "secret" edge to the Secret entity.
+func (m *PermissionMutation) ClearSecret() {
+	m.clearedsecret = true
+}
+
+// SecretCleared reports if the "secret" edge to the Secret entity was cleared.
+func (m *PermissionMutation) SecretCleared() bool {
+	return m.clearedsecret
+}
+
+// SecretID returns the "secret" edge ID in the mutation.
+func (m *PermissionMutation) SecretID() (id string, exists bool) {
+	if m.secret != nil {
+		return *m.secret, true
+	}
+	return
+}
+
+// SecretIDs returns the "secret" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SecretID instead. It exists only for internal usage by the builders.
+func (m *PermissionMutation) SecretIDs() (ids []string) {
+	if id := m.secret; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSecret resets all changes to the "secret" edge.
+func (m *PermissionMutation) ResetSecret() {
+	m.secret = nil
+	m.clearedsecret = false
+}
+
+// Where appends a list predicates to the PermissionMutation builder.
+func (m *PermissionMutation) Where(ps ...predicate.Permission) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PermissionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Permission, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PermissionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PermissionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Permission).
+func (m *PermissionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PermissionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, permission.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, permission.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, permission.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, permission.FieldTenantID)
+	}
+	if m.resource_type != nil {
+		fields = append(fields, permission.FieldResourceType)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, permission.FieldResourceID)
+	}
+	if m.relation != nil {
+		fields = append(fields, permission.FieldRelation)
+	}
+	if m.subject_type != nil {
+		fields = append(fields, permission.FieldSubjectType)
+	}
+	if m.subject_id != nil {
+		fields = append(fields, permission.FieldSubjectID)
+	}
+	if m.granted_by != nil {
+		fields = append(fields, permission.FieldGrantedBy)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, permission.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case permission.FieldCreateTime:
+		return m.CreateTime()
+	case permission.FieldUpdateTime:
+		return m.UpdateTime()
+	case permission.FieldDeleteTime:
+		return m.DeleteTime()
+	case permission.FieldTenantID:
+		return m.TenantID()
+	case permission.FieldResourceType:
+		return m.ResourceType()
+	case permission.FieldResourceID:
+		return m.ResourceID()
+	case permission.FieldRelation:
+		return m.Relation()
+	case permission.FieldSubjectType:
+		return m.SubjectType()
+	case permission.FieldSubjectID:
+		return m.SubjectID()
+	case permission.FieldGrantedBy:
+		return m.GrantedBy()
+	case permission.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case permission.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case permission.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case permission.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case permission.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case permission.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case permission.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case permission.FieldRelation:
+		return m.OldRelation(ctx)
+	case permission.FieldSubjectType:
+		return m.OldSubjectType(ctx)
+	case permission.FieldSubjectID:
+		return m.OldSubjectID(ctx)
+	case permission.FieldGrantedBy:
+		return m.OldGrantedBy(ctx)
+	case permission.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Permission field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PermissionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case permission.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case permission.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case permission.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case permission.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case permission.FieldResourceType:
+		v, ok := value.(permission.ResourceType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceType(v)
+		return nil
+	case permission.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case permission.FieldRelation:
+		v, ok := value.(permission.Relation)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRelation(v)
+		return nil
+	case permission.FieldSubjectType:
+		v, ok := value.(permission.SubjectType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectType(v)
+		return nil
+	case permission.FieldSubjectID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectID(v)
+		return nil
+	case permission.FieldGrantedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantedBy(v)
+		return nil
+	case permission.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Permission field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PermissionMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, permission.FieldTenantID)
+	}
+	if m.addgranted_by != nil {
+		fields = append(fields, permission.FieldGrantedBy)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PermissionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case permission.FieldTenantID:
+		return m.AddedTenantID()
+	case permission.FieldGrantedBy:
+		return m.AddedGrantedBy()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PermissionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case permission.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case permission.FieldGrantedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGrantedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Permission numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PermissionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(permission.FieldCreateTime) {
+		fields = append(fields, permission.FieldCreateTime)
+	}
+	if m.FieldCleared(permission.FieldUpdateTime) {
+		fields = append(fields, permission.FieldUpdateTime)
+	}
+	if m.FieldCleared(permission.FieldDeleteTime) {
+		fields = append(fields, permission.FieldDeleteTime)
+	}
+	if m.FieldCleared(permission.FieldTenantID) {
+		fields = append(fields, permission.FieldTenantID)
+	}
+	if m.FieldCleared(permission.FieldGrantedBy) {
+		fields = append(fields, permission.FieldGrantedBy)
+	}
+	if m.FieldCleared(permission.FieldExpiresAt) {
+		fields = append(fields, permission.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PermissionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PermissionMutation) ClearField(name string) error {
+	switch name {
+	case permission.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case permission.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case permission.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case permission.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case permission.FieldGrantedBy:
+		m.ClearGrantedBy()
+		return nil
+	case permission.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Permission nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PermissionMutation) ResetField(name string) error {
+	switch name {
+	case permission.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case permission.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case permission.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case permission.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case permission.FieldResourceType:
+		m.ResetResourceType()
+		return nil
+	case permission.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case permission.FieldRelation:
+		m.ResetRelation()
+		return nil
+	case permission.FieldSubjectType:
+		m.ResetSubjectType()
+		return nil
+	case permission.FieldSubjectID:
+		m.ResetSubjectID()
+		return nil
+	case permission.FieldGrantedBy:
+		m.ResetGrantedBy()
+		return nil
+	case permission.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Permission field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PermissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.folder != nil {
+		edges = append(edges, permission.EdgeFolder)
+	}
+	if m.secret != nil {
+		edges = append(edges, permission.EdgeSecret)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case permission.EdgeFolder:
+		if id := m.folder; id != nil {
+			return []ent.Value{*id}
+		}
+	case permission.EdgeSecret:
+		if id := m.secret; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PermissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PermissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedfolder {
+		edges = append(edges, permission.EdgeFolder)
+	}
+	if m.clearedsecret {
+		edges = append(edges, permission.EdgeSecret)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PermissionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case permission.EdgeFolder:
+		return m.clearedfolder
+	case permission.EdgeSecret:
+		return m.clearedsecret
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PermissionMutation) ClearEdge(name string) error {
+	switch name {
+	case permission.EdgeFolder:
+		m.ClearFolder()
+		return nil
+	case permission.EdgeSecret:
+		m.ClearSecret()
+		return nil
+	}
+	return fmt.Errorf("unknown Permission unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PermissionMutation) ResetEdge(name string) error {
+	switch name {
+	case permission.EdgeFolder:
+		m.ResetFolder()
+		return nil
+	case permission.EdgeSecret:
+		m.ResetSecret()
+		return nil
+	}
+	return fmt.Errorf("unknown Permission edge %s", name)
+}
+
+// SecretMutation represents an operation that mutates the Secret nodes in the graph.
+type SecretMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *string
+	create_by                   *uint32
+	addcreate_by                *int32
+	update_by                   *uint32
+	addupdate_by                *int32
+	create_time                 *time.Time
+	update_time                 *time.Time
+	delete_time                 *time.Time
+	tenant_id                   *uint32
+	addtenant_id                *int32
+	name                        *string
+	username                    *string
+	host_url                    *string
+	vault_path                  *string
+	current_version             *int32
+	addcurrent_version          *int32
+	metadata                    *map[string]interface{}
+	description                 *string
+	status                      *secret.Status
+	has_totp                    *bool
+	last_accessed_time          *time.Time
+	revision                    *int64
+	addrevision                 *int64
+	sensitive                   *bool
+	password_encoding           *secret.PasswordEncoding
+	canary                      *bool
+	access_policy               **authz.AccessPolicy
+	protected                   *bool
+	referenced_secret_ids       *[]string
+	appendreferenced_secret_ids []string
+	trashed_with_folder_id      *string
+	status_before_trash         *secret.StatusBeforeTrash
+	field_key_id                *uint32
+	addfield_key_id             *int32
+	clearedFields               map[string]struct{}
+	folder                      *string
+	clearedfolder               bool
+	versions                    map[int]struct{}
+	removedversions             map[int]struct{}
+	clearedversions             bool
+	permissions                 map[int]struct{}
+	removedpermissions          map[int]struct{}
+	clearedpermissions          bool
+	collections                 map[string]struct{}
+	removedcollections          map[string]struct{}
+	clearedcollections          bool
+	done                        bool
+	oldValue                    func(context.Context) (*Secret, error)
+	predicates                  []predicate.Secret
+}
+
+var _ ent.Mutation = (*SecretMutation)(nil)
+
+// secretOption allows management of the mutation configuration using functional options.
+type secretOption func(*SecretMutation)
+
+// newSecretMutation creates new mutation for the Secret entity.
+func newSecretMutation(c config, op Op, opts ...secretOption) *SecretMutation {
+	m := &SecretMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSecret,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSecretID sets the ID field of the mutation.
+func withSecretID(id string) secretOption {
+	return func(m *SecretMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Secret
+		)
+		m.oldValue = func(ctx context.Context) (*Secret, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Secret.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSecret sets the old Secret of the mutation.
+func withSecret(node *Secret) secretOption {
+	return func(m *SecretMutation) {
+		m.oldValue = func(context.Context) (*Secret, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SecretMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SecretMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Secret entities.
+func (m *SecretMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SecretMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SecretMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Secret.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SecretMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SecretMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// AddCreateBy adds u to the "create_by" field.
+func (m *SecretMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
+	}
+}
+
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *SecretMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SecretMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[secret.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SecretMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[secret.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SecretMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, secret.FieldCreateBy)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *SecretMutation) SetUpdateBy(u uint32) {
+	m.update_by = &u
+	m.addupdate_by = nil
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *SecretMutation) UpdateBy() (r uint32, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldUpdateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// AddUpdateBy adds u to the "update_by" field.
+func (m *SecretMutation) AddUpdateBy(u int32) {
+	if m.addupdate_by != nil {
+		*m.addupdate_by += u
+	} else {
+		m.addupdate_by = &u
+	}
+}
+
+// AddedUpdateBy returns the value that was added to the "update_by" field in this mutation.
+func (m *SecretMutation) AddedUpdateBy() (r int32, exists bool) {
+	v := m.addupdate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *SecretMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	m.clearedFields[secret.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *SecretMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[secret.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *SecretMutation) ResetUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	delete(m.clearedFields, secret.FieldUpdateBy)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SecretMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SecretMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *SecretMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[secret.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *SecretMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[secret.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SecretMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, secret.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SecretMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SecretMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *SecretMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[secret.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *SecretMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[secret.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SecretMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, secret.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *SecretMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *SecretMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *SecretMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[secret.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *SecretMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[secret.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *SecretMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, secret.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SecretMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SecretMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *SecretMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *SecretMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *SecretMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[secret.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *SecretMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[secret.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SecretMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, secret.FieldTenantID)
+}
+
+// SetFolderID sets the "folder_id" field.
+func (m *SecretMutation) SetFolderID(s string) {
+	m.folder = &s
+}
+
+// FolderID returns the value of the "folder_id" field in the mutation.
+func (m *SecretMutation) FolderID() (r string, exists bool) {
+	v := m.folder
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFolderID returns the old "folder_id" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldFolderID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFolderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFolderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFolderID: %w", err)
+	}
+	return oldValue.FolderID, nil
+}
+
+// ClearFolderID clears the value of the "folder_id" field.
+func (m *SecretMutation) ClearFolderID() {
+	m.folder = nil
+	m.clearedFields[secret.FieldFolderID] = struct{}{}
+}
+
+// FolderIDCleared returns if the "folder_id" field was cleared in this mutation.
+func (m *SecretMutation) FolderIDCleared() bool {
+	_, ok := m.clearedFields[secret.FieldFolderID]
+	return ok
+}
+
+// ResetFolderID resets all changes to the "folder_id" field.
+func (m *SecretMutation) ResetFolderID() {
+	m.folder = nil
+	delete(m.clearedFields, secret.FieldFolderID)
+}
+
+// SetName sets the "name" field.
+func (m *SecretMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SecretMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SecretMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *SecretMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *SecretMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ClearUsername clears the value of the "username" field.
+func (m *SecretMutation) ClearUsername() {
+	m.username = nil
+	m.clearedFields[secret.FieldUsername] = struct{}{}
+}
+
+// UsernameCleared returns if the "username" field was cleared in this mutation.
+func (m *SecretMutation) UsernameCleared() bool {
+	_, ok := m.clearedFields[secret.FieldUsername]
+	return ok
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *SecretMutation) ResetUsername() {
+	m.username = nil
+	delete(m.clearedFields, secret.FieldUsername)
+}
+
+// SetHostURL sets the "host_url" field.
+func (m *SecretMutation) SetHostURL(s string) {
+	m.host_url = &s
+}
+
+// HostURL returns the value of the "host_url" field in the mutation.
+func (m *SecretMutation) HostURL() (r string, exists bool) {
+	v := m.host_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostURL returns the old "host_url" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldHostURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostURL: %w", err)
+	}
+	return oldValue.HostURL, nil
+}
+
+// ClearHostURL clears the value of the "host_url" field.
+func (m *SecretMutation) ClearHostURL() {
+	m.host_url = nil
+	m.clearedFields[secret.FieldHostURL] = struct{}{}
+}
+
+// HostURLCleared returns if the "host_url" field was cleared in this mutation.
+func (m *SecretMutation) HostURLCleared() bool {
+	_, ok := m.clearedFields[secret.FieldHostURL]
+	return ok
+}
+
+// ResetHostURL resets all changes to the "host_url" field.
+func (m *SecretMutation) ResetHostURL() {
+	m.host_url = nil
+	delete(m.clearedFields, secret.FieldHostURL)
+}
+
+// SetVaultPath sets the "vault_path" field.
+func (m *SecretMutation) SetVaultPath(s string) {
+	m.vault_path = &s
+}
+
+// VaultPath returns the value of the "vault_path" field in the mutation.
+func (m *SecretMutation) VaultPath() (r string, exists bool) {
+	v := m.vault_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVaultPath returns the old "vault_path" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldVaultPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVaultPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVaultPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVaultPath: %w", err)
+	}
+	return oldValue.VaultPath, nil
+}
+
+// ResetVaultPath resets all changes to the "vault_path" field.
+func (m *SecretMutation) ResetVaultPath() {
+	m.vault_path = nil
+}
+
+// SetCurrentVersion sets the "current_version" field.
+func (m *SecretMutation) SetCurrentVersion(i int32) {
+	m.current_version = &i
+	m.addcurrent_version = nil
+}
+
+// CurrentVersion returns the value of the "current_version" field in the mutation.
+func (m *SecretMutation) CurrentVersion() (r int32, exists bool) {
+	v := m.current_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentVersion returns the old "current_version" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldCurrentVersion(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentVersion: %w", err)
+	}
+	return oldValue.CurrentVersion, nil
+}
+
+// AddCurrentVersion adds i to the "current_version" field.
+func (m *SecretMutation) AddCurrentVersion(i int32) {
+	if m.addcurrent_version != nil {
+		*m.addcurrent_version += i
+	} else {
+		m.addcurrent_version = &i
+	}
+}
+
+// AddedCurrentVersion returns the value that was added to the "current_version" field in this mutation.
+func (m *SecretMutation) AddedCurrentVersion() (r int32, exists bool) {
+	v := m.addcurrent_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCurrentVersion resets all changes to the "current_version" field.
+func (m *SecretMutation) ResetCurrentVersion() {
+	m.current_version = nil
+	m.addcurrent_version = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *SecretMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SecretMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *SecretMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[secret.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *SecretMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[secret.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SecretMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, secret.FieldMetadata)
+}
+
+// SetDescription sets the "description" field.
+func (m *SecretMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *SecretMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *SecretMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[secret.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *SecretMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[secret.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *SecretMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, secret.FieldDescription)
+}
+
+// SetStatus sets the "status" field.
+func (m *SecretMutation) SetStatus(s secret.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SecretMutation) Status() (r secret.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldStatus(ctx context.Context) (v secret.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SecretMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetHasTotp sets the "has_totp" field.
+func (m *SecretMutation) SetHasTotp(b bool) {
+	m.has_totp = &b
+}
+
+// HasTotp returns the value of the "has_totp" field in the mutation.
+func (m *SecretMutation) HasTotp() (r bool, exists bool) {
+	v := m.has_totp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasTotp returns the old "has_totp" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldHasTotp(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasTotp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasTotp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasTotp: %w", err)
+	}
+	return oldValue.HasTotp, nil
+}
+
+// ResetHasTotp resets all changes to the "has_totp" field.
+func (m *SecretMutation) ResetHasTotp() {
+	m.has_totp = nil
+}
+
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (m *SecretMutation) SetLastAccessedTime(t time.Time) {
+	m.last_accessed_time = &t
+}
+
+// LastAccessedTime returns the value of the "last_accessed_time" field in the mutation.
+func (m *SecretMutation) LastAccessedTime() (r time.Time, exists bool) {
+	v := m.last_accessed_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastAccessedTime returns the old "last_accessed_time" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldLastAccessedTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastAccessedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastAccessedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastAccessedTime: %w", err)
+	}
+	return oldValue.LastAccessedTime, nil
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (m *SecretMutation) ClearLastAccessedTime() {
+	m.last_accessed_time = nil
+	m.clearedFields[secret.FieldLastAccessedTime] = struct{}{}
+}
+
+// LastAccessedTimeCleared returns if the "last_accessed_time" field was cleared in this mutation.
+func (m *SecretMutation) LastAccessedTimeCleared() bool {
+	_, ok := m.clearedFields[secret.FieldLastAccessedTime]
+	return ok
+}
+
+// ResetLastAccessedTime resets all changes to the "last_accessed_time" field.
+func (m *SecretMutation) ResetLastAccessedTime() {
+	m.last_accessed_time = nil
+	delete(m.clearedFields, secret.FieldLastAccessedTime)
+}
+
+// SetRevision sets the "revision" field.
+func (m *SecretMutation) SetRevision(i int64) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *SecretMutation) Revision() (r int64, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldRevision(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *SecretMutation) AddRevision(i int64) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *SecretMutation) AddedRevision() (r int64, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *SecretMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetSensitive sets the "sensitive" field.
+func (m *SecretMutation) SetSensitive(b bool) {
+	m.sensitive = &b
+}
+
+// Sensitive returns the value of the "sensitive" field in the mutation.
+func (m *SecretMutation) Sensitive() (r bool, exists bool) {
+	v := m.sensitive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSensitive returns the old "sensitive" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldSensitive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSensitive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSensitive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSensitive: %w", err)
+	}
+	return oldValue.Sensitive, nil
+}
+
+// ResetSensitive resets all changes to the "sensitive" field.
+func (m *SecretMutation) ResetSensitive() {
+	m.sensitive = nil
+}
+
+// SetPasswordEncoding sets the "password_encoding" field.
+func (m *SecretMutation) SetPasswordEncoding(se secret.PasswordEncoding) {
+	m.password_encoding = &se
+}
+
+// PasswordEncoding returns the value of the "password_encoding" field in the mutation.
+func (m *SecretMutation) PasswordEncoding() (r secret.PasswordEncoding, exists bool) {
+	v := m.password_encoding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordEncoding returns the old "password_encoding" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldPasswordEncoding(ctx context.Context) (v secret.PasswordEncoding, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordEncoding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordEncoding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordEncoding: %w", err)
+	}
+	return oldValue.PasswordEncoding, nil
+}
+
+// ResetPasswordEncoding resets all changes to the "password_encoding" field.
+func (m *SecretMutation) ResetPasswordEncoding() {
+	m.password_encoding = nil
+}
+
+// SetCanary sets the "canary" field.
+func (m *SecretMutation) SetCanary(b bool) {
+	m.canary = &b
+}
+
+// Canary returns the value of the "canary" field in the mutation.
+func (m *SecretMutation) Canary() (r bool, exists bool) {
+	v := m.canary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanary returns the old "canary" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldCanary(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanary: %w", err)
+	}
+	return oldValue.Canary, nil
+}
+
+// ResetCanary resets all changes to the "canary" field.
+func (m *SecretMutation) ResetCanary() {
+	m.canary = nil
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (m *SecretMutation) SetAccessPolicy(ap *authz.AccessPolicy) {
+	m.access_policy = &ap
+}
+
+// AccessPolicy returns the value of the "access_policy" field in the mutation.
+func (m *SecretMutation) AccessPolicy() (r *authz.AccessPolicy, exists bool) {
+	v := m.access_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessPolicy returns the old "access_policy" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldAccessPolicy(ctx context.Context) (v *authz.AccessPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessPolicy: %w", err)
+	}
+	return oldValue.AccessPolicy, nil
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (m *SecretMutation) ClearAccessPolicy() {
+	m.access_policy = nil
+	m.clearedFields[secret.FieldAccessPolicy] = struct{}{}
+}
+
+// AccessPolicyCleared returns if the "access_policy" field was cleared in this mutation.
+func (m *SecretMutation) AccessPolicyCleared() bool {
+	_, ok := m.clearedFields[secret.FieldAccessPolicy]
+	return ok
+}
+
+// ResetAccessPolicy resets all changes to the "access_policy" field.
+func (m *SecretMutation) ResetAccessPolicy() {
+	m.access_policy = nil
+	delete(m.clearedFields, secret.FieldAccessPolicy)
+}
+
+// SetProtected sets the "protected" field.
+func (m *SecretMutation) SetProtected(b bool) {
+	m.protected = &b
+}
+
+// Protected returns the value of the "protected" field in the mutation.
+func (m *SecretMutation) Protected() (r bool, exists bool) {
+	v := m.protected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtected returns the old "protected" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldProtected(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtected is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtected requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtected: %w", err)
+	}
+	return oldValue.Protected, nil
+}
+
+// ResetProtected resets all changes to the "protected" field.
+func (m *SecretMutation) ResetProtected() {
+	m.protected = nil
+}
+
+// SetReferencedSecretIds sets the "referenced_secret_ids" field.
+func (m *SecretMutation) SetReferencedSecretIds(s []string) {
+	m.referenced_secret_ids = &s
+	m.appendreferenced_secret_ids = nil
+}
+
+// ReferencedSecretIds returns the value of the "referenced_secret_ids" field in the mutation.
+func (m *SecretMutation) ReferencedSecretIds() (r []string, exists bool) {
+	v := m.referenced_secret_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferencedSecretIds returns the old "referenced_secret_ids" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldReferencedSecretIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferencedSecretIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferencedSecretIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferencedSecretIds: %w", err)
+	}
+	return oldValue.ReferencedSecretIds, nil
+}
+
+// AppendReferencedSecretIds adds s to the "referenced_secret_ids" field.
+func (m *SecretMutation) AppendReferencedSecretIds(s []string) {
+	m.appendreferenced_secret_ids = append(m.appendreferenced_secret_ids, s...)
+}
+
+// AppendedReferencedSecretIds returns the list of values that were appended to the "referenced_secret_ids" field in this mutation.
+func (m *SecretMutation) AppendedReferencedSecretIds() ([]string, bool) {
+	if len(m.appendreferenced_secret_ids) == 0 {
+		return nil, false
+	}
+	return m.appendreferenced_secret_ids, true
+}
+
+// ClearReferencedSecretIds clears the value of the "referenced_secret_ids" field.
+func (m *SecretMutation) ClearReferencedSecretIds() {
+	m.referenced_secret_ids = nil
+	m.appendreferenced_secret_ids = nil
+	m.clearedFields[secret.FieldReferencedSecretIds] = struct{}{}
+}
+
+// ReferencedSecretIdsCleared returns if the "referenced_secret_ids" field was cleared in this mutation.
+func (m *SecretMutation) ReferencedSecretIdsCleared() bool {
+	_, ok := m.clearedFields[secret.FieldReferencedSecretIds]
+	return ok
+}
+
+// ResetReferencedSecretIds resets all changes to the "referenced_secret_ids" field.
+func (m *SecretMutation) ResetReferencedSecretIds() {
+	m.referenced_secret_ids = nil
+	m.appendreferenced_secret_ids = nil
+	delete(m.clearedFields, secret.FieldReferencedSecretIds)
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (m *SecretMutation) SetTrashedWithFolderID(s string) {
+	m.trashed_with_folder_id = &s
+}
+
+// TrashedWithFolderID returns the value of the "trashed_with_folder_id" field in the mutation.
+func (m *SecretMutation) TrashedWithFolderID() (r string, exists bool) {
+	v := m.trashed_with_folder_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrashedWithFolderID returns the old "trashed_with_folder_id" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldTrashedWithFolderID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrashedWithFolderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrashedWithFolderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrashedWithFolderID: %w", err)
+	}
+	return oldValue.TrashedWithFolderID, nil
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (m *SecretMutation) ClearTrashedWithFolderID() {
+	m.trashed_with_folder_id = nil
+	m.clearedFields[secret.FieldTrashedWithFolderID] = struct{}{}
+}
+
+// TrashedWithFolderIDCleared returns if the "trashed_with_folder_id" field was cleared in this mutation.
+func (m *SecretMutation) TrashedWithFolderIDCleared() bool {
+	_, ok := m.clearedFields[secret.FieldTrashedWithFolderID]
+	return ok
+}
+
+// ResetTrashedWithFolderID resets all changes to the "trashed_with_folder_id" field.
+func (m *SecretMutation) ResetTrashedWithFolderID() {
+	m.trashed_with_folder_id = nil
+	delete(m.clearedFields, secret.FieldTrashedWithFolderID)
+}
+
+// SetStatusBeforeTrash sets the "status_before_trash" field.
+func (m *SecretMutation) SetStatusBeforeTrash(sbt secret.StatusBeforeTrash) {
+	m.status_before_trash = &sbt
+}
+
+// StatusBeforeTrash returns the value of the "status_before_trash" field in the mutation.
+func (m *SecretMutation) StatusBeforeTrash() (r secret.StatusBeforeTrash, exists bool) {
+	v := m.status_before_trash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusBeforeTrash returns the old "status_before_trash" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldStatusBeforeTrash(ctx context.Context) (v *secret.StatusBeforeTrash, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusBeforeTrash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusBeforeTrash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusBeforeTrash: %w", err)
+	}
+	return oldValue.StatusBeforeTrash, nil
+}
+
+// ClearStatusBeforeTrash clears the value of the "status_before_trash" field.
+func (m *SecretMutation) ClearStatusBeforeTrash() {
+	m.status_before_trash = nil
+	m.clearedFields[secret.FieldStatusBeforeTrash] = struct{}{}
+}
+
+// StatusBeforeTrashCleared returns if the "status_before_trash" field was cleared in this mutation.
+func (m *SecretMutation) StatusBeforeTrashCleared() bool {
+	_, ok := m.clearedFields[secret.FieldStatusBeforeTrash]
+	return ok
+}
+
+// ResetStatusBeforeTrash resets all changes to the "status_before_trash" field.
+func (m *SecretMutation) ResetStatusBeforeTrash() {
+	m.status_before_trash = nil
+	delete(m.clearedFields, secret.FieldStatusBeforeTrash)
+}
+
+// SetFieldKeyID sets the "field_key_id" field.
+func (m *SecretMutation) SetFieldKeyID(u uint32) {
+	m.field_key_id = &u
+	m.addfield_key_id = nil
+}
+
+// FieldKeyID returns the value of the "field_key_id" field in the mutation.
+func (m *SecretMutation) FieldKeyID() (r uint32, exists bool) {
+	v := m.field_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFieldKeyID returns the old "field_key_id" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldFieldKeyID(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFieldKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFieldKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFieldKeyID: %w", err)
+	}
+	return oldValue.FieldKeyID, nil
+}
+
+// AddFieldKeyID adds u to the "field_key_id" field.
+func (m *SecretMutation) AddFieldKeyID(u int32) {
+	if m.addfield_key_id != nil {
+		*m.addfield_key_id += u
+	} else {
+		m.addfield_key_id = &u
+	}
+}
+
+// AddedFieldKeyID returns the value that was added to the "field_key_id" field in this mutation.
+func (m *SecretMutation) AddedFieldKeyID() (r int32, exists bool) {
+	v := m.addfield_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFieldKeyID resets all changes to the "field_key_id" field.
+func (m *SecretMutation) ResetFieldKeyID() {
+	m.field_key_id = nil
+	m.addfield_key_id = nil
+}
+
+// ClearFolder clears the "folder" edge to the Folder entity.
+func (m *SecretMutation) ClearFolder() {
+	m.clearedfolder = true
+	m.clearedFields[secret.FieldFolderID] = struct{}{}
+}
+
+// FolderCleared reports if the "folder" edge to the Folder entity was cleared.
+func (m *SecretMutation) FolderCleared() bool {
+	return m.FolderIDCleared() || m.clearedfolder
+}
+
+// FolderIDs returns the "folder" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FolderID instead. It exists only for internal usage by the builders.
+func (m *SecretMutation) FolderIDs() (ids []string) {
+	if id := m.folder; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFolder resets all changes to the "folder" edge.
+func (m *SecretMutation) ResetFolder() {
+	m.folder = nil
+	m.clearedfolder = false
+}
+
+// AddVersionIDs adds the "versions" edge to the SecretVersion entity by ids.
+func (m *SecretMutation) AddVersionIDs(ids ...int) {
+	if m.versions == nil {
+		m.versions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.versions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVersions clears the "versions" edge to the SecretVersion entity.
+func (m *SecretMutation) ClearVersions() {
+	m.clearedversions = true
+}
+
+// VersionsCleared reports if the "versions" edge to the SecretVersion entity was cleared.
+func (m *SecretMutation) VersionsCleared() bool {
+	return m.clearedversions
+}
+
+// RemoveVersionIDs removes the "versions" edge to the SecretVersion entity by IDs.
+func (m *SecretMutation) RemoveVersionIDs(ids ...int) {
+	if m.removedversions == nil {
+		m.removedversions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.versions, ids[i])
+		m.removedversions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVersions returns the removed IDs of the "versions" edge to the SecretVersion entity.
+func (m *SecretMutation) RemovedVersionsIDs() (ids []int) {
+	for id := range m.removedversions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VersionsIDs returns the "versions" edge IDs in the mutation.
+func (m *SecretMutation) VersionsIDs() (ids []int) {
+	for id := range m.versions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVersions resets all changes to the "versions" edge.
+func (m *SecretMutation) ResetVersions() {
+	m.versions = nil
+	m.clearedversions = false
+	m.removedversions = nil
+}
+
+// AddPermissionIDs adds the "permissions" edge to the Permission entity by ids.
+func (m *SecretMutation) AddPermissionIDs(ids ...int) {
+	if m.permissions == nil {
+		m.permissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.permissions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPermissions clears the "permissions" edge to the Permission entity.
+func (m *SecretMutation) ClearPermissions() {
+	m.clearedpermissions = true
+}
+
+// PermissionsCleared reports if the "permissions" edge to the Permission entity was cleared.
+func (m *SecretMutation) PermissionsCleared() bool {
+	return m.clearedpermissions
+}
+
+// RemovePermissionIDs removes the "permissions" edge to the Permission entity by IDs.
+func (m *SecretMutation) RemovePermissionIDs(ids ...int) {
+	if m.removedpermissions == nil {
+		m.removedpermissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.permissions, ids[i])
+		m.removedpermissions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPermissions returns the removed IDs of the "permissions" edge to the Permission entity.
+func (m *SecretMutation) RemovedPermissionsIDs() (ids []int) {
+	for id := range m.removedpermissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PermissionsIDs returns the "permissions" edge IDs in the mutation.
+func (m *SecretMutation) PermissionsIDs() (ids []int) {
+	for id := range m.permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPermissions resets all changes to the "permissions" edge.
+func (m *SecretMutation) ResetPermissions() {
+	m.permissions = nil
+	m.clearedpermissions = false
+	m.removedpermissions = nil
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by ids.
+func (m *SecretMutation) AddCollectionIDs(ids ...string) {
+	if m.collections == nil {
+		m.collections = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.collections[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCollections clears the "collections" edge to the Collection entity.
+func (m *SecretMutation) ClearCollections() {
+	m.clearedcollections = true
+}
+
+// CollectionsCleared reports if the "collections" edge to the Collection entity was cleared.
+func (m *SecretMutation) CollectionsCleared() bool {
+	return m.clearedcollections
+}
+
+// RemoveCollectionIDs removes the "collections" edge to the Collection entity by IDs.
+func (m *SecretMutation) RemoveCollectionIDs(ids ...string) {
+	if m.removedcollections == nil {
+		m.removedcollections = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.collections, ids[i])
+		m.removedcollections[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCollections returns the removed IDs of the "collections" edge to the Collection entity.
+func (m *SecretMutation) RemovedCollectionsIDs() (ids []string) {
+	for id := range m.removedcollections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CollectionsIDs returns the "collections" edge IDs in the mutation.
+func (m *SecretMutation) CollectionsIDs() (ids []string) {
+	for id := range m.collections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCollections resets all changes to the "collections" edge.
+func (m *SecretMutation) ResetCollections() {
+	m.collections = nil
+	m.clearedcollections = false
+	m.removedcollections = nil
+}
+
+// Where appends a list predicates to the SecretMutation builder.
+func (m *SecretMutation) Where(ps ...predicate.Secret) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SecretMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SecretMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Secret, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SecretMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SecretMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Secret).
+func (m *SecretMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SecretMutation) Fields() []string {
+	fields := make([]string, 0, 27)
+	if m.create_by != nil {
+		fields = append(fields, secret.FieldCreateBy)
+	}
+	if m.update_by != nil {
+		fields = append(fields, secret.FieldUpdateBy)
+	}
+	if m.create_time != nil {
+		fields = append(fields, secret.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, secret.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, secret.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, secret.FieldTenantID)
+	}
+	if m.folder != nil {
+		fields = append(fields, secret.FieldFolderID)
+	}
+	if m.name != nil {
+		fields = append(fields, secret.FieldName)
+	}
+	if m.username != nil {
+		fields = append(fields, secret.FieldUsername)
+	}
+	if m.host_url != nil {
+		fields = append(fields, secret.FieldHostURL)
+	}
+	if m.vault_path != nil {
+		fields = append(fields, secret.FieldVaultPath)
+	}
+	if m.current_version != nil {
+		fields = append(fields, secret.FieldCurrentVersion)
+	}
+	if m.metadata != nil {
+		fields = append(fields, secret.FieldMetadata)
+	}
+	if m.description != nil {
+		fields = append(fields, secret.FieldDescription)
+	}
+	if m.status != nil {
+		fields = append(fields, secret.FieldStatus)
+	}
+	if m.has_totp != nil {
+		fields = append(fields, secret.FieldHasTotp)
+	}
+	if m.last_accessed_time != nil {
+		fields = append(fields, secret.FieldLastAccessedTime)
+	}
+	if m.revision != nil {
+		fields = append(fields, secret.FieldRevision)
+	}
+	if m.sensitive != nil {
+		fields = append(fields, secret.FieldSensitive)
+	}
+	if m.password_encoding != nil {
+		fields = append(fields, secret.FieldPasswordEncoding)
+	}
+	if m.canary != nil {
+		fields = append(fields, secret.FieldCanary)
+	}
+	if m.access_policy != nil {
+		fields = append(fields, secret.FieldAccessPolicy)
+	}
+	if m.protected != nil {
+		fields = append(fields, secret.FieldProtected)
+	}
+	if m.referenced_secret_ids != nil {
+		fields = append(fields, secret.FieldReferencedSecretIds)
+	}
+	if m.trashed_with_folder_id != nil {
+		fields = append(fields, secret.FieldTrashedWithFolderID)
+	}
+	if m.status_before_trash != nil {
+		fields = append(fields, secret.FieldStatusBeforeTrash)
+	}
+	if m.field_key_id != nil {
+		fields = append(fields, secret.FieldFieldKeyID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SecretMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case secret.FieldCreateBy:
+		return m.CreateBy()
+	case secret.FieldUpdateBy:
+		return m.UpdateBy()
+	case secret.FieldCreateTime:
+		return m.CreateTime()
+	case secret.FieldUpdateTime:
+		return m.UpdateTime()
+	case secret.FieldDeleteTime:
+		return m.DeleteTime()
+	case secret.FieldTenantID:
+		return m.TenantID()
+	case secret.FieldFolderID:
+		return m.FolderID()
+	case secret.FieldName:
+		return m.Name()
+	case secret.FieldUsername:
+		return m.Username()
+	case secret.FieldHostURL:
+		return m.HostURL()
+	case secret.FieldVaultPath:
+		return m.VaultPath()
+	case secret.FieldCurrentVersion:
+		return m.CurrentVersion()
+	case secret.FieldMetadata:
+		return m.Metadata()
+	case secret.FieldDescription:
+		return m.Description()
+	case secret.FieldStatus:
+		return m.Status()
+	case secret.FieldHasTotp:
+		return m.HasTotp()
+	case secret.FieldLastAccessedTime:
+		return m.LastAccessedTime()
+	case secret.FieldRevision:
+		return m.Revision()
+	case secret.FieldSensitive:
+		return m.Sensitive()
+	case secret.FieldPasswordEncoding:
+		return m.PasswordEncoding()
+	case secret.FieldCanary:
+		return m.Canary()
+	case secret.FieldAccessPolicy:
+		return m.AccessPolicy()
+	case secret.FieldProtected:
+		return m.Protected()
+	case secret.FieldReferencedSecretIds:
+		return m.ReferencedSecretIds()
+	case secret.FieldTrashedWithFolderID:
+		return m.TrashedWithFolderID()
+	case secret.FieldStatusBeforeTrash:
+		return m.StatusBeforeTrash()
+	case secret.FieldFieldKeyID:
+		return m.FieldKeyID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SecretMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case secret.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case secret.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case secret.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case secret.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case secret.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case secret.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case secret.FieldFolderID:
+		return m.OldFolderID(ctx)
+	case secret.FieldName:
+		return m.OldName(ctx)
+	case secret.FieldUsername:
+		return m.OldUsername(ctx)
+	case secret.FieldHostURL:
+		return m.OldHostURL(ctx)
+	case secret.FieldVaultPath:
+		return m.OldVaultPath(ctx)
+	case secret.FieldCurrentVersion:
+		return m.OldCurrentVersion(ctx)
+	case secret.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case secret.FieldDescription:
+		return m.OldDescription(ctx)
+	case secret.FieldStatus:
+		return m.OldStatus(ctx)
+	case secret.FieldHasTotp:
+		return m.OldHasTotp(ctx)
+	case secret.FieldLastAccessedTime:
+		return m.OldLastAccessedTime(ctx)
+	case secret.FieldRevision:
+		return m.OldRevision(ctx)
+	case secret.FieldSensitive:
+		return m.OldSensitive(ctx)
+	case secret.FieldPasswordEncoding:
+		return m.OldPasswordEncoding(ctx)
+	case secret.FieldCanary:
+		return m.OldCanary(ctx)
+	case secret.FieldAccessPolicy:
+		return m.OldAccessPolicy(ctx)
+	case secret.FieldProtected:
+		return m.OldProtected(ctx)
+	case secret.FieldReferencedSecretIds:
+		return m.OldReferencedSecretIds(ctx)
+	case secret.FieldTrashedWithFolderID:
+		return m.OldTrashedWithFolderID(ctx)
+	case secret.FieldStatusBeforeTrash:
+		return m.OldStatusBeforeTrash(ctx)
+	case secret.FieldFieldKeyID:
+		return m.OldFieldKeyID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Secret field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecretMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case secret.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case secret.FieldUpdateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case secret.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case secret.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case secret.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case secret.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case secret.FieldFolderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFolderID(v)
+		return nil
+	case secret.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case secret.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case secret.FieldHostURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostURL(v)
+		return nil
+	case secret.FieldVaultPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVaultPath(v)
+		return nil
+	case secret.FieldCurrentVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentVersion(v)
+		return nil
+	case secret.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case secret.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case secret.FieldStatus:
+		v, ok := value.(secret.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case secret.FieldHasTotp:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasTotp(v)
+		return nil
+	case secret.FieldLastAccessedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAccessedTime(v)
+		return nil
+	case secret.FieldRevision:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case secret.FieldSensitive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSensitive(v)
+		return nil
+	case secret.FieldPasswordEncoding:
+		v, ok := value.(secret.PasswordEncoding)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordEncoding(v)
+		return nil
+	case secret.FieldCanary:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanary(v)
+		return nil
+	case secret.FieldAccessPolicy:
+		v, ok := value.(*authz.AccessPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessPolicy(v)
+		return nil
+	case secret.FieldProtected:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtected(v)
+		return nil
+	case secret.FieldReferencedSecretIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferencedSecretIds(v)
+		return nil
+	case secret.FieldTrashedWithFolderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrashedWithFolderID(v)
+		return nil
+	case secret.FieldStatusBeforeTrash:
+		v, ok := value.(secret.StatusBeforeTrash)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusBeforeTrash(v)
+		return nil
+	case secret.FieldFieldKeyID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldKeyID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Secret field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SecretMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, secret.FieldCreateBy)
+	}
+	if m.addupdate_by != nil {
+		fields = append(fields, secret.FieldUpdateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, secret.FieldTenantID)
+	}
+	if m.addcurrent_version != nil {
+		fields = append(fields, secret.FieldCurrentVersion)
+	}
+	if m.addrevision != nil {
+		fields = append(fields, secret.FieldRevision)
+	}
+	if m.addfield_key_id != nil {
+		fields = append(fields, secret.FieldFieldKeyID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SecretMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case secret.FieldCreateBy:
+		return m.AddedCreateBy()
+	case secret.FieldUpdateBy:
+		return m.AddedUpdateBy()
+	case secret.FieldTenantID:
+		return m.AddedTenantID()
+	case secret.FieldCurrentVersion:
+		return m.AddedCurrentVersion()
+	case secret.FieldRevision:
+		return m.AddedRevision()
+	case secret.FieldFieldKeyID:
+		return m.AddedFieldKeyID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecretMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case secret.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case secret.FieldUpdateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdateBy(v)
+		return nil
+	case secret.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case secret.FieldCurrentVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurrentVersion(v)
+		return nil
+	case secret.FieldRevision:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	case secret.FieldFieldKeyID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFieldKeyID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Secret numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SecretMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(secret.FieldCreateBy) {
+		fields = append(fields, secret.FieldCreateBy)
+	}
+	if m.FieldCleared(secret.FieldUpdateBy) {
+		fields = append(fields, secret.FieldUpdateBy)
+	}
+	if m.FieldCleared(secret.FieldCreateTime) {
+		fields = append(fields, secret.FieldCreateTime)
+	}
+	if m.FieldCleared(secret.FieldUpdateTime) {
+		fields = append(fields, secret.FieldUpdateTime)
+	}
+	if m.FieldCleared(secret.FieldDeleteTime) {
+		fields = append(fields, secret.FieldDeleteTime)
+	}
+	if m.FieldCleared(secret.FieldTenantID) {
+		fields = append(fields, secret.FieldTenantID)
+	}
+	if m.FieldCleared(secret.FieldFolderID) {
+		fields = append(fields, secret.FieldFolderID)
+	}
+	if m.FieldCleared(secret.FieldUsername) {
+		fields = append(fields, secret.FieldUsername)
+	}
+	if m.FieldCleared(secret.FieldHostURL) {
+		fields = append(fields, secret.FieldHostURL)
+	}
+	if m.FieldCleared(secret.FieldMetadata) {
+		fields = append(fields, secret.FieldMetadata)
+	}
+	if m.FieldCleared(secret.FieldDescription) {
+		fields = append(fields, secret.FieldDescription)
+	}
+	if m.FieldCleared(secret.FieldLastAccessedTime) {
+		fields = append(fields, secret.FieldLastAccessedTime)
+	}
+	if m.FieldCleared(secret.FieldAccessPolicy) {
+		fields = append(fields, secret.FieldAccessPolicy)
+	}
+	if m.FieldCleared(secret.FieldReferencedSecretIds) {
+		fields = append(fields, secret.FieldReferencedSecretIds)
+	}
+	if m.FieldCleared(secret.FieldTrashedWithFolderID) {
+		fields = append(fields, secret.FieldTrashedWithFolderID)
+	}
+	if m.FieldCleared(secret.FieldStatusBeforeTrash) {
+		fields = append(fields, secret.FieldStatusBeforeTrash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SecretMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SecretMutation) ClearField(name string) error {
+	switch name {
+	case secret.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case secret.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case secret.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case secret.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case secret.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case secret.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case secret.FieldFolderID:
+		m.ClearFolderID()
+		return nil
+	case secret.FieldUsername:
+		m.ClearUsername()
+		return nil
+	case secret.FieldHostURL:
+		m.ClearHostURL()
+		return nil
+	case secret.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case secret.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case secret.FieldLastAccessedTime:
+		m.ClearLastAccessedTime()
+		return nil
+	case secret.FieldAccessPolicy:
+		m.ClearAccessPolicy()
+		return nil
+	case secret.FieldReferencedSecretIds:
+		m.ClearReferencedSecretIds()
+		return nil
+	case secret.FieldTrashedWithFolderID:
+		m.ClearTrashedWithFolderID()
+		return nil
+	case secret.FieldStatusBeforeTrash:
+		m.ClearStatusBeforeTrash()
+		return nil
+	}
+	return fmt.Errorf("unknown Secret nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SecretMutation) ResetField(name string) error {
+	switch name {
+	case secret.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case secret.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case secret.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case secret.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case secret.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case secret.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case secret.FieldFolderID:
+		m.ResetFolderID()
+		return nil
+	case secret.FieldName:
+		m.ResetName()
+		return nil
+	case secret.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case secret.FieldHostURL:
+		m.ResetHostURL()
+		return nil
+	case secret.FieldVaultPath:
+		m.ResetVaultPath()
+		return nil
+	case secret.FieldCurrentVersion:
+		m.ResetCurrentVersion()
+		return nil
+	case secret.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case secret.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case secret.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case secret.FieldHasTotp:
+		m.ResetHasTotp()
+		return nil
+	case secret.FieldLastAccessedTime:
+		m.ResetLastAccessedTime()
+		return nil
+	case secret.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case secret.FieldSensitive:
+		m.ResetSensitive()
+		return nil
+	case secret.FieldPasswordEncoding:
+		m.ResetPasswordEncoding()
+		return nil
+	case secret.FieldCanary:
+		m.ResetCanary()
+		return nil
+	case secret.FieldAccessPolicy:
+		m.ResetAccessPolicy()
+		return nil
+	case secret.FieldProtected:
+		m.ResetProtected()
+		return nil
+	case secret.FieldReferencedSecretIds:
+		m.ResetReferencedSecretIds()
+		return nil
+	case secret.FieldTrashedWithFolderID:
+		m.ResetTrashedWithFolderID()
+		return nil
+	case secret.FieldStatusBeforeTrash:
+		m.ResetStatusBeforeTrash()
+		return nil
+	case secret.FieldFieldKeyID:
+		m.ResetFieldKeyID()
+		return nil
+	}
+	return fmt.Errorf("unknown Secret field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SecretMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.folder != nil {
+		edges = append(edges, secret.EdgeFolder)
+	}
+	if m.versions != nil {
+		edges = append(edges, secret.EdgeVersions)
+	}
+	if m.permissions != nil {
+		edges = append(edges, secret.EdgePermissions)
+	}
+	if m.collections != nil {
+		edges = append(edges, secret.EdgeCollections)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SecretMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case secret.EdgeFolder:
+		if id := m.folder; id != nil {
+			return []ent.Value{*id}
+		}
+	case secret.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.versions))
+		for id := range m.versions {
+			ids = append(ids, id)
+		}
+		return ids
+	case secret.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.permissions))
+		for id := range m.permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	case secret.EdgeCollections:
+		ids := make([]ent.Value, 0, len(m.collections))
+		for id := range m.collections {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SecretMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedversions != nil {
+		edges = append(edges, secret.EdgeVersions)
+	}
+	if m.removedpermissions != nil {
+		edges = append(edges, secret.EdgePermissions)
+	}
+	if m.removedcollections != nil {
+		edges = append(edges, secret.EdgeCollections)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SecretMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case secret.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.removedversions))
+		for id := range m.removedversions {
+			ids = append(ids, id)
+		}
+		return ids
+	case secret.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.removedpermissions))
+		for id := range m.removedpermissions {
+			ids = append(ids, id)
+		}
+		return ids
+	case secret.EdgeCollections:
+		ids := make([]ent.Value, 0, len(m.removedcollections))
+		for id := range m.removedcollections {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SecretMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedfolder {
+		edges = append(edges, secret.EdgeFolder)
+	}
+	if m.clearedversions {
+		edges = append(edges, secret.EdgeVersions)
+	}
+	if m.clearedpermissions {
+		edges = append(edges, secret.EdgePermissions)
+	}
+	if m.clearedcollections {
+		edges = append(edges, secret.EdgeCollections)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SecretMutation) EdgeCleared(name string) bool {
+	switch name {
+	case secret.EdgeFolder:
+		return m.clearedfolder
+	case secret.EdgeVersions:
+		return m.clearedversions
+	case secret.EdgePermissions:
+		return m.clearedpermissions
+	case secret.EdgeCollections:
+		return m.clearedcollections
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SecretMutation) ClearEdge(name string) error {
+	switch name {
+	case secret.EdgeFolder:
+		m.ClearFolder()
+		return nil
+	}
+	return fmt.Errorf("unknown Secret unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SecretMutation) ResetEdge(name string) error {
+	switch name {
+	case secret.EdgeFolder:
+		m.ResetFolder()
+		return nil
+	case secret.EdgeVersions:
+		m.ResetVersions()
+		return nil
+	case secret.EdgePermissions:
+		m.ResetPermissions()
+		return nil
+	case secret.EdgeCollections:
+		m.ResetCollections()
+		return nil
+	}
+	return fmt.Errorf("unknown Secret edge %s", name)
+}
+
+// SecretUsageMutation represents an operation that mutates the SecretUsage nodes in the graph.
+type SecretUsageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint32
+	tenant_id     *uint32
+	addtenant_id  *int32
+	secret_id     *string
+	day           *time.Time
+	reads         *int64
+	addreads      *int64
+	writes        *int64
+	addwrites     *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SecretUsage, error)
+	predicates    []predicate.SecretUsage
+}
+
+var _ ent.Mutation = (*SecretUsageMutation)(nil)
+
+// secretusageOption allows management of the mutation configuration using functional options.
+type secretusageOption func(*SecretUsageMutation)
+
+// newSecretUsageMutation creates new mutation for the SecretUsage entity.
+func newSecretUsageMutation(c config, op Op, opts ...secretusageOption) *SecretUsageMutation {
+	m := &SecretUsageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSecretUsage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSecretUsageID sets the ID field of the mutation.
+func withSecretUsageID(id uint32) secretusageOption {
+	return func(m *SecretUsageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SecretUsage
+		)
+		m.oldValue = func(ctx context.Context) (*SecretUsage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SecretUsage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSecretUsage sets the old SecretUsage of the mutation.
+func withSecretUsage(node *SecretUsage) secretusageOption {
+	return func(m *SecretUsageMutation) {
+		m.oldValue = func(context.Context) (*SecretUsage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SecretUsageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SecretUsageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SecretUsage entities.
+func (m *SecretUsageMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SecretUsageMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SecretUsageMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SecretUsage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SecretUsageMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SecretUsageMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SecretUsage entity.
+// If the SecretUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretUsageMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *SecretUsageMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *SecretUsageMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *SecretUsageMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[secretusage.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *SecretUsageMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[secretusage.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SecretUsageMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, secretusage.FieldTenantID)
+}
+
+// SetSecretID sets the "secret_id" field.
+func (m *SecretUsageMutation) SetSecretID(s string) {
+	m.secret_id = &s
+}
+
+// SecretID returns the value of the "secret_id" field in the mutation.
+func (m *SecretUsageMutation) SecretID() (r string, exists bool) {
+	v := m.secret_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretID returns the old "secret_id" field's value of the SecretUsage entity.
+// If the SecretUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretUsageMutation) OldSecretID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretID: %w", err)
+	}
+	return oldValue.SecretID, nil
+}
+
+// ResetSecretID resets all changes to the "secret_id" field.
+func (m *SecretUsageMutation) ResetSecretID() {
+	m.secret_id = nil
+}
+
+// SetDay sets the "day" field.
+func (m *SecretUsageMutation) SetDay(t time.Time) {
+	m.day = &t
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *SecretUsageMutation) Day() (r time.Time, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the SecretUsage entity.
+// If the SecretUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretUsageMutation) OldDay(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *SecretUsageMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetReads sets the "reads" field.
+func (m *SecretUsageMutation) SetReads(i int64) {
+	m.reads = &i
+	m.addreads = nil
+}
+
+// Reads returns the value of the "reads" field in the mutation.
+func (m *SecretUsageMutation) Reads() (r int64, exists bool) {
+	v := m.reads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReads returns the old "reads" field's value of the SecretUsage entity.
+// If the SecretUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretUsageMutation) OldReads(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReads: %w", err)
+	}
+	return oldValue.Reads, nil
+}
+
+// AddReads adds i to the "reads" field.
+func (m *SecretUsageMutation) AddReads(i int64) {
+	if m.addreads != nil {
+		*m.addreads += i
+	} else {
+		m.addreads = &i
+	}
+}
+
+// AddedReads returns the value that was added to the "reads" field in this mutation.
+func (m *SecretUsageMutation) AddedReads() (r int64, exists bool) {
+	v := m.addreads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReads resets all changes to the "reads" field.
+func (m *SecretUsageMutation) ResetReads() {
+	m.reads = nil
+	m.addreads = nil
+}
+
+// SetWrites sets the "writes" field.
+func (m *SecretUsageMutation) SetWrites(i int64) {
+	m.writes = &i
+	m.addwrites = nil
+}
+
+// Writes returns the value of the "writes" field in the mutation.
+func (m *SecretUsageMutation) Writes() (r int64, exists bool) {
+	v := m.writes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWrites returns the old "writes" field's value of the SecretUsage entity.
+// If the SecretUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretUsageMutation) OldWrites(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWrites is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWrites requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWrites: %w", err)
+	}
+	return oldValue.Writes, nil
+}
+
+// AddWrites adds i to the "writes" field.
+func (m *SecretUsageMutation) AddWrites(i int64) {
+	if m.addwrites != nil {
+		*m.addwrites += i
+	} else {
+		m.addwrites = &i
+	}
+}
+
+// AddedWrites returns the value that was added to the "writes" field in this mutation.
+func (m *SecretUsageMutation) AddedWrites() (r int64, exists bool) {
+	v := m.addwrites
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWrites resets all changes to the "writes" field.
+func (m *SecretUsageMutation) ResetWrites() {
+	m.writes = nil
+	m.addwrites = nil
+}
+
+// Where appends a list predicates to the SecretUsageMutation builder.
+func (m *SecretUsageMutation) Where(ps ...predicate.SecretUsage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SecretUsageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SecretUsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SecretUsage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SecretUsageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SecretUsageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SecretUsage).
+func (m *SecretUsageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SecretUsageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant_id != nil {
+		fields = append(fields, secretusage.FieldTenantID)
+	}
+	if m.secret_id != nil {
+		fields = append(fields, secretusage.FieldSecretID)
+	}
+	if m.day != nil {
+		fields = append(fields, secretusage.FieldDay)
+	}
+	if m.reads != nil {
+		fields = append(fields, secretusage.FieldReads)
+	}
+	if m.writes != nil {
+		fields = append(fields, secretusage.FieldWrites)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SecretUsageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case secretusage.FieldTenantID:
+		return m.TenantID()
+	case secretusage.FieldSecretID:
+		return m.SecretID()
+	case secretusage.FieldDay:
+		return m.Day()
+	case secretusage.FieldReads:
+		return m.Reads()
+	case secretusage.FieldWrites:
+		return m.Writes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SecretUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case secretusage.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case secretusage.FieldSecretID:
+		return m.OldSecretID(ctx)
+	case secretusage.FieldDay:
+		return m.OldDay(ctx)
+	case secretusage.FieldReads:
+		return m.OldReads(ctx)
+	case secretusage.FieldWrites:
+		return m.OldWrites(ctx)
+	}
+	return nil, fmt.Errorf("unknown SecretUsage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecretUsageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case secretusage.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case secretusage.FieldSecretID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretID(v)
+		return nil
+	case secretusage.FieldDay:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case secretusage.FieldReads:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReads(v)
+		return nil
+	case secretusage.FieldWrites:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWrites(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SecretUsage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SecretUsageMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, secretusage.FieldTenantID)
+	}
+	if m.addreads != nil {
+		fields = append(fields, secretusage.FieldReads)
+	}
+	if m.addwrites != nil {
+		fields = append(fields, secretusage.FieldWrites)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SecretUsageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case secretusage.FieldTenantID:
+		return m.AddedTenantID()
+	case secretusage.FieldReads:
+		return m.AddedReads()
+	case secretusage.FieldWrites:
+		return m.AddedWrites()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecretUsageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case secretusage.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case secretusage.FieldReads:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReads(v)
+		return nil
+	case secretusage.FieldWrites:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWrites(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SecretUsage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SecretUsageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(secretusage.FieldTenantID) {
+		fields = append(fields, secretusage.FieldTenantID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SecretUsageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SecretUsageMutation) ClearField(name string) error {
+	switch name {
+	case secretusage.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	}
+	return fmt.Errorf("unknown SecretUsage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SecretUsageMutation) ResetField(name string) error {
+	switch name {
+	case secretusage.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case secretusage.FieldSecretID:
+		m.ResetSecretID()
+		return nil
+	case secretusage.FieldDay:
+		m.ResetDay()
+		return nil
+	case secretusage.FieldReads:
+		m.ResetReads()
+		return nil
+	case secretusage.FieldWrites:
+		m.ResetWrites()
+		return nil
+	}
+	return fmt.Errorf("unknown SecretUsage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SecretUsageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SecretUsageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SecretUsageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SecretUsageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SecretUsageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SecretUsageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SecretUsageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SecretUsage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SecretUsageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SecretUsage edge %s", name)
+}
+
+// SecretVersionMutation represents an operation that mutates the SecretVersion nodes in the graph.
+type SecretVersionMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	create_by          *uint32
+	addcreate_by       *int32
+	create_time        *time.Time
+	update_time        *time.Time
+	delete_time        *time.Time
+	version_number     *int32
+	addversion_number  *int32
+	vault_path         *string
+	comment            *string
+	checksum           *string
+	checksum_algorithm *string
+	source_version     *int32
+	addsource_version  *int32
+	clearedFields      map[string]struct{}
+	secret             *string
+	clearedsecret      bool
+	done               bool
+	oldValue           func(context.Context) (*SecretVersion, error)
+	predicates         []predicate.SecretVersion
+}
+
+var _ ent.Mutation = (*SecretVersionMutation)(nil)
+
+// secretversionOption allows management of the mutation configuration using functional options.
+type secretversionOption func(*SecretVersionMutation)
+
+// newSecretVersionMutation creates new mutation for the SecretVersion entity.
+func newSecretVersionMutation(c config, op Op, opts ...secretversionOption) *SecretVersionMutation {
+	m := &SecretVersionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSecretVersion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSecretVersionID sets the ID field of the mutation.
+func withSecretVersionID(id int) secretversionOption {
+	return func(m *SecretVersionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SecretVersion
+		)
+		m.oldValue = func(ctx context.Context) (*SecretVersion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SecretVersion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSecretVersion sets the old SecretVersion of the mutation.
+func withSecretVersion(node *SecretVersion) secretversionOption {
+	return func(m *SecretVersionMutation) {
+		m.oldValue = func(context.Context) (*SecretVersion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SecretVersionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SecretVersionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SecretVersionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SecretVersionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SecretVersion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SecretVersionMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SecretVersionMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// AddCreateBy adds u to the "create_by" field.
+func (m *SecretVersionMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
+	}
+}
+
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *SecretVersionMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SecretVersionMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[secretversion.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SecretVersionMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[secretversion.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SecretVersionMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, secretversion.FieldCreateBy)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SecretVersionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SecretVersionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *SecretVersionMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[secretversion.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *SecretVersionMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[secretversion.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SecretVersionMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, secretversion.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SecretVersionMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SecretVersionMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *SecretVersionMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[secretversion.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *SecretVersionMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[secretversion.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SecretVersionMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, secretversion.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *SecretVersionMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *SecretVersionMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *SecretVersionMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[secretversion.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *SecretVersionMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[secretversion.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *SecretVersionMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, secretversion.FieldDeleteTime)
+}
+
+// SetSecretID sets the "secret_id" field.
+func (m *SecretVersionMutation) SetSecretID(s string) {
+	m.secret = &s
+}
+
+// SecretID returns the value of the "secret_id" field in the mutation.
+func (m *SecretVersionMutation) SecretID() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
 	}
-	if m.audit_id != nil {
-		fields = append(fields, auditlog.FieldAuditID)
+	return *v, true
+}
+
+// OldSecretID returns the old "secret_id" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldSecretID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretID is only allowed on UpdateOne operations")
 	}
-	if m.request_id != nil {
-		fields = append(fields, auditlog.FieldRequestID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretID requires an ID field in the mutation")
 	}
-	if m.operation != nil {
-		fields = append(fields, auditlog.FieldOperation)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretID: %w", err)
+	}
+	return oldValue.SecretID, nil
+}
+
+// ResetSecretID resets all changes to the "secret_id" field.
+func (m *SecretVersionMutation) ResetSecretID() {
+	m.secret = nil
+}
+
+// SetVersionNumber sets the "version_number" field.
+func (m *SecretVersionMutation) SetVersionNumber(i int32) {
+	m.version_number = &i
+	m.addversion_number = nil
+}
+
+// VersionNumber returns the value of the "version_number" field in the mutation.
+func (m *SecretVersionMutation) VersionNumber() (r int32, exists bool) {
+	v := m.version_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionNumber returns the old "version_number" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldVersionNumber(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionNumber: %w", err)
+	}
+	return oldValue.VersionNumber, nil
+}
+
+// AddVersionNumber adds i to the "version_number" field.
+func (m *SecretVersionMutation) AddVersionNumber(i int32) {
+	if m.addversion_number != nil {
+		*m.addversion_number += i
+	} else {
+		m.addversion_number = &i
+	}
+}
+
+// AddedVersionNumber returns the value that was added to the "version_number" field in this mutation.
+func (m *SecretVersionMutation) AddedVersionNumber() (r int32, exists bool) {
+	v := m.addversion_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersionNumber resets all changes to the "version_number" field.
+func (m *SecretVersionMutation) ResetVersionNumber() {
+	m.version_number = nil
+	m.addversion_number = nil
+}
+
+// SetVaultPath sets the "vault_path" field.
+func (m *SecretVersionMutation) SetVaultPath(s string) {
+	m.vault_path = &s
+}
+
+// VaultPath returns the value of the "vault_path" field in the mutation.
+func (m *SecretVersionMutation) VaultPath() (r string, exists bool) {
+	v := m.vault_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVaultPath returns the old "vault_path" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldVaultPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVaultPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVaultPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVaultPath: %w", err)
+	}
+	return oldValue.VaultPath, nil
+}
+
+// ResetVaultPath resets all changes to the "vault_path" field.
+func (m *SecretVersionMutation) ResetVaultPath() {
+	m.vault_path = nil
+}
+
+// SetComment sets the "comment" field.
+func (m *SecretVersionMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *SecretVersionMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComment returns the old "comment" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+	}
+	return oldValue.Comment, nil
+}
+
+// ClearComment clears the value of the "comment" field.
+func (m *SecretVersionMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[secretversion.FieldComment] = struct{}{}
+}
+
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *SecretVersionMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[secretversion.FieldComment]
+	return ok
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *SecretVersionMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, secretversion.FieldComment)
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *SecretVersionMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *SecretVersionMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *SecretVersionMutation) ResetChecksum() {
+	m.checksum = nil
+}
+
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (m *SecretVersionMutation) SetChecksumAlgorithm(s string) {
+	m.checksum_algorithm = &s
+}
+
+// ChecksumAlgorithm returns the value of the "checksum_algorithm" field in the mutation.
+func (m *SecretVersionMutation) ChecksumAlgorithm() (r string, exists bool) {
+	v := m.checksum_algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksumAlgorithm returns the old "checksum_algorithm" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldChecksumAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksumAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksumAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksumAlgorithm: %w", err)
+	}
+	return oldValue.ChecksumAlgorithm, nil
+}
+
+// ResetChecksumAlgorithm resets all changes to the "checksum_algorithm" field.
+func (m *SecretVersionMutation) ResetChecksumAlgorithm() {
+	m.checksum_algorithm = nil
+}
+
+// SetSourceVersion sets the "source_version" field.
+func (m *SecretVersionMutation) SetSourceVersion(i int32) {
+	m.source_version = &i
+	m.addsource_version = nil
+}
+
+// SourceVersion returns the value of the "source_version" field in the mutation.
+func (m *SecretVersionMutation) SourceVersion() (r int32, exists bool) {
+	v := m.source_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceVersion returns the old "source_version" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldSourceVersion(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceVersion: %w", err)
 	}
-	if m.service_name != nil {
-		fields = append(fields, auditlog.FieldServiceName)
+	return oldValue.SourceVersion, nil
+}
+
+// AddSourceVersion adds i to the "source_version" field.
+func (m *SecretVersionMutation) AddSourceVersion(i int32) {
+	if m.addsource_version != nil {
+		*m.addsource_version += i
+	} else {
+		m.addsource_version = &i
 	}
-	if m.client_id != nil {
-		fields = append(fields, auditlog.FieldClientID)
+}
+
+// AddedSourceVersion returns the value that was added to the "source_version" field in this mutation.
+func (m *SecretVersionMutation) AddedSourceVersion() (r int32, exists bool) {
+	v := m.addsource_version
+	if v == nil {
+		return
 	}
-	if m.client_common_name != nil {
-		fields = append(fields, auditlog.FieldClientCommonName)
+	return *v, true
+}
+
+// ClearSourceVersion clears the value of the "source_version" field.
+func (m *SecretVersionMutation) ClearSourceVersion() {
+	m.source_version = nil
+	m.addsource_version = nil
+	m.clearedFields[secretversion.FieldSourceVersion] = struct{}{}
+}
+
+// SourceVersionCleared returns if the "source_version" field was cleared in this mutation.
+func (m *SecretVersionMutation) SourceVersionCleared() bool {
+	_, ok := m.clearedFields[secretversion.FieldSourceVersion]
+	return ok
+}
+
+// ResetSourceVersion resets all changes to the "source_version" field.
+func (m *SecretVersionMutation) ResetSourceVersion() {
+	m.source_version = nil
+	m.addsource_version = nil
+	delete(m.clearedFields, secretversion.FieldSourceVersion)
+}
+
+// ClearSecret clears the "secret" edge to the Secret entity.
+func (m *SecretVersionMutation) ClearSecret() {
+	m.clearedsecret = true
+	m.clearedFields[secretversion.FieldSecretID] = struct{}{}
+}
+
+// SecretCleared reports if the "secret" edge to the Secret entity was cleared.
+func (m *SecretVersionMutation) SecretCleared() bool {
+	return m.clearedsecret
+}
+
+// SecretIDs returns the "secret" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SecretID instead. It exists only for internal usage by the builders.
+func (m *SecretVersionMutation) SecretIDs() (ids []string) {
+	if id := m.secret; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.client_organization != nil {
-		fields = append(fields, auditlog.FieldClientOrganization)
+	return
+}
+
+// ResetSecret resets all changes to the "secret" edge.
+func (m *SecretVersionMutation) ResetSecret() {
+	m.secret = nil
+	m.clearedsecret = false
+}
+
+// Where appends a list predicates to the SecretVersionMutation builder.
+func (m *SecretVersionMutation) Where(ps ...predicate.SecretVersion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SecretVersionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SecretVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SecretVersion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	if m.client_serial_number != nil {
-		fields = append(fields, auditlog.FieldClientSerialNumber)
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SecretVersionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SecretVersionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SecretVersion).
+func (m *SecretVersionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SecretVersionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_by != nil {
+		fields = append(fields, secretversion.FieldCreateBy)
 	}
-	if m.is_authenticated != nil {
-		fields = append(fields, auditlog.FieldIsAuthenticated)
+	if m.create_time != nil {
+		fields = append(fields, secretversion.FieldCreateTime)
 	}
-	if m.success != nil {
-		fields = append(fields, auditlog.FieldSuccess)
+	if m.update_time != nil {
+		fields = append(fields, secretversion.FieldUpdateTime)
 	}
-	if m.error_code != nil {
-		fields = append(fields, auditlog.FieldErrorCode)
+	if m.delete_time != nil {
+		fields = append(fields, secretversion.FieldDeleteTime)
 	}
-	if m.error_message != nil {
-		fields = append(fields, auditlog.FieldErrorMessage)
+	if m.secret != nil {
+		fields = append(fields, secretversion.FieldSecretID)
 	}
-	if m.latency_ms != nil {
-		fields = append(fields, auditlog.FieldLatencyMs)
+	if m.version_number != nil {
+		fields = append(fields, secretversion.FieldVersionNumber)
 	}
-	if m.peer_address != nil {
-		fields = append(fields, auditlog.FieldPeerAddress)
+	if m.vault_path != nil {
+		fields = append(fields, secretversion.FieldVaultPath)
 	}
-	if m.geo_location != nil {
-		fields = append(fields, auditlog.FieldGeoLocation)
+	if m.comment != nil {
+		fields = append(fields, secretversion.FieldComment)
 	}
-	if m.log_hash != nil {
-		fields = append(fields, auditlog.FieldLogHash)
+	if m.checksum != nil {
+		fields = append(fields, secretversion.FieldChecksum)
 	}
-	if m.signature != nil {
-		fields = append(fields, auditlog.FieldSignature)
+	if m.checksum_algorithm != nil {
+		fields = append(fields, secretversion.FieldChecksumAlgorithm)
 	}
-	if m.metadata != nil {
-		fields = append(fields, auditlog.FieldMetadata)
+	if m.source_version != nil {
+		fields = append(fields, secretversion.FieldSourceVersion)
 	}
 	return fields
 }
@@ -1345,52 +16977,30 @@ func (m *AuditLogMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
+func (m *SecretVersionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case auditlog.FieldCreateTime:
+	case secretversion.FieldCreateBy:
+		return m.CreateBy()
+	case secretversion.FieldCreateTime:
 		return m.CreateTime()
-	case auditlog.FieldUpdateTime:
+	case secretversion.FieldUpdateTime:
 		return m.UpdateTime()
-	case auditlog.FieldDeleteTime:
+	case secretversion.FieldDeleteTime:
 		return m.DeleteTime()
-	case auditlog.FieldTenantID:
-		return m.TenantID()
-	case auditlog.FieldAuditID:
-		return m.AuditID()
-	case auditlog.FieldRequestID:
-		return m.RequestID()
-	case auditlog.FieldOperation:
-		return m.Operation()
-	case auditlog.FieldServiceName:
-		return m.ServiceName()
-	case auditlog.FieldClientID:
-		return m.ClientID()
-	case auditlog.FieldClientCommonName:
-		return m.ClientCommonName()
-	case auditlog.FieldClientOrganization:
-		return m.ClientOrganization()
-	case auditlog.FieldClientSerialNumber:
-		return m.ClientSerialNumber()
-	case auditlog.FieldIsAuthenticated:
-		return m.IsAuthenticated()
-	case auditlog.FieldSuccess:
-		return m.Success()
-	case auditlog.FieldErrorCode:
-		return m.ErrorCode()
-	case auditlog.FieldErrorMessage:
-		return m.ErrorMessage()
-	case auditlog.FieldLatencyMs:
-		return m.LatencyMs()
-	case auditlog.FieldPeerAddress:
-		return m.PeerAddress()
-	case auditlog.FieldGeoLocation:
-		return m.GeoLocation()
-	case auditlog.FieldLogHash:
-		return m.LogHash()
-	case auditlog.FieldSignature:
-		return m.Signature()
-	case auditlog.FieldMetadata:
-		return m.Metadata()
+	case secretversion.FieldSecretID:
+		return m.SecretID()
+	case secretversion.FieldVersionNumber:
+		return m.VersionNumber()
+	case secretversion.FieldVaultPath:
+		return m.VaultPath()
+	case secretversion.FieldComment:
+		return m.Comment()
+	case secretversion.FieldChecksum:
+		return m.Checksum()
+	case secretversion.FieldChecksumAlgorithm:
+		return m.ChecksumAlgorithm()
+	case secretversion.FieldSourceVersion:
+		return m.SourceVersion()
 	}
 	return nil, false
 }
@@ -1398,231 +17008,132 @@ func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SecretVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case auditlog.FieldCreateTime:
+	case secretversion.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case secretversion.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case auditlog.FieldUpdateTime:
+	case secretversion.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case auditlog.FieldDeleteTime:
+	case secretversion.FieldDeleteTime:
 		return m.OldDeleteTime(ctx)
-	case auditlog.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case auditlog.FieldAuditID:
-		return m.OldAuditID(ctx)
-	case auditlog.FieldRequestID:
-		return m.OldRequestID(ctx)
-	case auditlog.FieldOperation:
-		return m.OldOperation(ctx)
-	case auditlog.FieldServiceName:
-		return m.OldServiceName(ctx)
-	case auditlog.FieldClientID:
-		return m.OldClientID(ctx)
-	case auditlog.FieldClientCommonName:
-		return m.OldClientCommonName(ctx)
-	case auditlog.FieldClientOrganization:
-		return m.OldClientOrganization(ctx)
-	case auditlog.FieldClientSerialNumber:
-		return m.OldClientSerialNumber(ctx)
-	case auditlog.FieldIsAuthenticated:
-		return m.OldIsAuthenticated(ctx)
-	case auditlog.FieldSuccess:
-		return m.OldSuccess(ctx)
-	case auditlog.FieldErrorCode:
-		return m.OldErrorCode(ctx)
-	case auditlog.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case auditlog.FieldLatencyMs:
-		return m.OldLatencyMs(ctx)
-	case auditlog.FieldPeerAddress:
-		return m.OldPeerAddress(ctx)
-	case auditlog.FieldGeoLocation:
-		return m.OldGeoLocation(ctx)
-	case auditlog.FieldLogHash:
-		return m.OldLogHash(ctx)
-	case auditlog.FieldSignature:
-		return m.OldSignature(ctx)
-	case auditlog.FieldMetadata:
-		return m.OldMetadata(ctx)
+	case secretversion.FieldSecretID:
+		return m.OldSecretID(ctx)
+	case secretversion.FieldVersionNumber:
+		return m.OldVersionNumber(ctx)
+	case secretversion.FieldVaultPath:
+		return m.OldVaultPath(ctx)
+	case secretversion.FieldComment:
+		return m.OldComment(ctx)
+	case secretversion.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case secretversion.FieldChecksumAlgorithm:
+		return m.OldChecksumAlgorithm(ctx)
+	case secretversion.FieldSourceVersion:
+		return m.OldSourceVersion(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuditLog field %s", name)
+	return nil, fmt.Errorf("unknown SecretVersion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
+func (m *SecretVersionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case auditlog.FieldCreateTime:
+	case secretversion.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case secretversion.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case auditlog.FieldUpdateTime:
+	case secretversion.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case auditlog.FieldDeleteTime:
+	case secretversion.FieldDeleteTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeleteTime(v)
 		return nil
-	case auditlog.FieldTenantID:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTenantID(v)
-		return nil
-	case auditlog.FieldAuditID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuditID(v)
-		return nil
-	case auditlog.FieldRequestID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRequestID(v)
-		return nil
-	case auditlog.FieldOperation:
+	case secretversion.FieldSecretID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOperation(v)
+		m.SetSecretID(v)
 		return nil
-	case auditlog.FieldServiceName:
-		v, ok := value.(string)
+	case secretversion.FieldVersionNumber:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetServiceName(v)
+		m.SetVersionNumber(v)
 		return nil
-	case auditlog.FieldClientID:
+	case secretversion.FieldVaultPath:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetVaultPath(v)
 		return nil
-	case auditlog.FieldClientCommonName:
+	case secretversion.FieldComment:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientCommonName(v)
+		m.SetComment(v)
 		return nil
-	case auditlog.FieldClientOrganization:
+	case secretversion.FieldChecksum:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientOrganization(v)
+		m.SetChecksum(v)
 		return nil
-	case auditlog.FieldClientSerialNumber:
+	case secretversion.FieldChecksumAlgorithm:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientSerialNumber(v)
-		return nil
-	case auditlog.FieldIsAuthenticated:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsAuthenticated(v)
+		m.SetChecksumAlgorithm(v)
 		return nil
-	case auditlog.FieldSuccess:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuccess(v)
-		return nil
-	case auditlog.FieldErrorCode:
+	case secretversion.FieldSourceVersion:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorCode(v)
-		return nil
-	case auditlog.FieldErrorMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorMessage(v)
-		return nil
-	case auditlog.FieldLatencyMs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLatencyMs(v)
-		return nil
-	case auditlog.FieldPeerAddress:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPeerAddress(v)
-		return nil
-	case auditlog.FieldGeoLocation:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGeoLocation(v)
-		return nil
-	case auditlog.FieldLogHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLogHash(v)
-		return nil
-	case auditlog.FieldSignature:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSignature(v)
-		return nil
-	case auditlog.FieldMetadata:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
+		m.SetSourceVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLog field %s", name)
+	return fmt.Errorf("unknown SecretVersion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuditLogMutation) AddedFields() []string {
+func (m *SecretVersionMutation) AddedFields() []string {
 	var fields []string
-	if m.addtenant_id != nil {
-		fields = append(fields, auditlog.FieldTenantID)
+	if m.addcreate_by != nil {
+		fields = append(fields, secretversion.FieldCreateBy)
 	}
-	if m.adderror_code != nil {
-		fields = append(fields, auditlog.FieldErrorCode)
+	if m.addversion_number != nil {
+		fields = append(fields, secretversion.FieldVersionNumber)
 	}
-	if m.addlatency_ms != nil {
-		fields = append(fields, auditlog.FieldLatencyMs)
+	if m.addsource_version != nil {
+		fields = append(fields, secretversion.FieldSourceVersion)
 	}
 	return fields
 }
@@ -1630,14 +17141,14 @@ func (m *AuditLogMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SecretVersionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case auditlog.FieldTenantID:
-		return m.AddedTenantID()
-	case auditlog.FieldErrorCode:
-		return m.AddedErrorCode()
-	case auditlog.FieldLatencyMs:
-		return m.AddedLatencyMs()
+	case secretversion.FieldCreateBy:
+		return m.AddedCreateBy()
+	case secretversion.FieldVersionNumber:
+		return m.AddedVersionNumber()
+	case secretversion.FieldSourceVersion:
+		return m.AddedSourceVersion()
 	}
 	return nil, false
 }
@@ -1645,319 +17156,244 @@ func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+func (m *SecretVersionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case auditlog.FieldTenantID:
+	case secretversion.FieldCreateBy:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTenantID(v)
+		m.AddCreateBy(v)
 		return nil
-	case auditlog.FieldErrorCode:
+	case secretversion.FieldVersionNumber:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddErrorCode(v)
+		m.AddVersionNumber(v)
 		return nil
-	case auditlog.FieldLatencyMs:
-		v, ok := value.(int64)
+	case secretversion.FieldSourceVersion:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLatencyMs(v)
+		m.AddSourceVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLog numeric field %s", name)
+	return fmt.Errorf("unknown SecretVersion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuditLogMutation) ClearedFields() []string {
+func (m *SecretVersionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(auditlog.FieldCreateTime) {
-		fields = append(fields, auditlog.FieldCreateTime)
-	}
-	if m.FieldCleared(auditlog.FieldUpdateTime) {
-		fields = append(fields, auditlog.FieldUpdateTime)
-	}
-	if m.FieldCleared(auditlog.FieldDeleteTime) {
-		fields = append(fields, auditlog.FieldDeleteTime)
-	}
-	if m.FieldCleared(auditlog.FieldTenantID) {
-		fields = append(fields, auditlog.FieldTenantID)
-	}
-	if m.FieldCleared(auditlog.FieldRequestID) {
-		fields = append(fields, auditlog.FieldRequestID)
-	}
-	if m.FieldCleared(auditlog.FieldClientID) {
-		fields = append(fields, auditlog.FieldClientID)
-	}
-	if m.FieldCleared(auditlog.FieldClientCommonName) {
-		fields = append(fields, auditlog.FieldClientCommonName)
-	}
-	if m.FieldCleared(auditlog.FieldClientOrganization) {
-		fields = append(fields, auditlog.FieldClientOrganization)
-	}
-	if m.FieldCleared(auditlog.FieldClientSerialNumber) {
-		fields = append(fields, auditlog.FieldClientSerialNumber)
-	}
-	if m.FieldCleared(auditlog.FieldErrorCode) {
-		fields = append(fields, auditlog.FieldErrorCode)
-	}
-	if m.FieldCleared(auditlog.FieldErrorMessage) {
-		fields = append(fields, auditlog.FieldErrorMessage)
+	if m.FieldCleared(secretversion.FieldCreateBy) {
+		fields = append(fields, secretversion.FieldCreateBy)
 	}
-	if m.FieldCleared(auditlog.FieldPeerAddress) {
-		fields = append(fields, auditlog.FieldPeerAddress)
+	if m.FieldCleared(secretversion.FieldCreateTime) {
+		fields = append(fields, secretversion.FieldCreateTime)
 	}
-	if m.FieldCleared(auditlog.FieldGeoLocation) {
-		fields = append(fields, auditlog.FieldGeoLocation)
+	if m.FieldCleared(secretversion.FieldUpdateTime) {
+		fields = append(fields, secretversion.FieldUpdateTime)
 	}
-	if m.FieldCleared(auditlog.FieldLogHash) {
-		fields = append(fields, auditlog.FieldLogHash)
+	if m.FieldCleared(secretversion.FieldDeleteTime) {
+		fields = append(fields, secretversion.FieldDeleteTime)
 	}
-	if m.FieldCleared(auditlog.FieldSignature) {
-		fields = append(fields, auditlog.FieldSignature)
+	if m.FieldCleared(secretversion.FieldComment) {
+		fields = append(fields, secretversion.FieldComment)
 	}
-	if m.FieldCleared(auditlog.FieldMetadata) {
-		fields = append(fields, auditlog.FieldMetadata)
+	if m.FieldCleared(secretversion.FieldSourceVersion) {
+		fields = append(fields, secretversion.FieldSourceVersion)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuditLogMutation) FieldCleared(name string) bool {
+func (m *SecretVersionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuditLogMutation) ClearField(name string) error {
+func (m *SecretVersionMutation) ClearField(name string) error {
 	switch name {
-	case auditlog.FieldCreateTime:
+	case secretversion.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case secretversion.FieldCreateTime:
 		m.ClearCreateTime()
 		return nil
-	case auditlog.FieldUpdateTime:
+	case secretversion.FieldUpdateTime:
 		m.ClearUpdateTime()
 		return nil
-	case auditlog.FieldDeleteTime:
-		m.ClearDeleteTime()
-		return nil
-	case auditlog.FieldTenantID:
-		m.ClearTenantID()
-		return nil
-	case auditlog.FieldRequestID:
-		m.ClearRequestID()
-		return nil
-	case auditlog.FieldClientID:
-		m.ClearClientID()
-		return nil
-	case auditlog.FieldClientCommonName:
-		m.ClearClientCommonName()
-		return nil
-	case auditlog.FieldClientOrganization:
-		m.ClearClientOrganization()
-		return nil
-	case auditlog.FieldClientSerialNumber:
-		m.ClearClientSerialNumber()
-		return nil
-	case auditlog.FieldErrorCode:
-		m.ClearErrorCode()
-		return nil
-	case auditlog.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	case auditlog.FieldPeerAddress:
-		m.ClearPeerAddress()
-		return nil
-	case auditlog.FieldGeoLocation:
-		m.ClearGeoLocation()
-		return nil
-	case auditlog.FieldLogHash:
-		m.ClearLogHash()
+	case secretversion.FieldDeleteTime:
+		m.ClearDeleteTime()
 		return nil
-	case auditlog.FieldSignature:
-		m.ClearSignature()
+	case secretversion.FieldComment:
+		m.ClearComment()
 		return nil
-	case auditlog.FieldMetadata:
-		m.ClearMetadata()
+	case secretversion.FieldSourceVersion:
+		m.ClearSourceVersion()
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLog nullable field %s", name)
+	return fmt.Errorf("unknown SecretVersion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuditLogMutation) ResetField(name string) error {
+func (m *SecretVersionMutation) ResetField(name string) error {
 	switch name {
-	case auditlog.FieldCreateTime:
+	case secretversion.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case secretversion.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case auditlog.FieldUpdateTime:
+	case secretversion.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case auditlog.FieldDeleteTime:
+	case secretversion.FieldDeleteTime:
 		m.ResetDeleteTime()
 		return nil
-	case auditlog.FieldTenantID:
-		m.ResetTenantID()
-		return nil
-	case auditlog.FieldAuditID:
-		m.ResetAuditID()
-		return nil
-	case auditlog.FieldRequestID:
-		m.ResetRequestID()
-		return nil
-	case auditlog.FieldOperation:
-		m.ResetOperation()
-		return nil
-	case auditlog.FieldServiceName:
-		m.ResetServiceName()
-		return nil
-	case auditlog.FieldClientID:
-		m.ResetClientID()
-		return nil
-	case auditlog.FieldClientCommonName:
-		m.ResetClientCommonName()
-		return nil
-	case auditlog.FieldClientOrganization:
-		m.ResetClientOrganization()
-		return nil
-	case auditlog.FieldClientSerialNumber:
-		m.ResetClientSerialNumber()
-		return nil
-	case auditlog.FieldIsAuthenticated:
-		m.ResetIsAuthenticated()
-		return nil
-	case auditlog.FieldSuccess:
-		m.ResetSuccess()
-		return nil
-	case auditlog.FieldErrorCode:
-		m.ResetErrorCode()
-		return nil
-	case auditlog.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case secretversion.FieldSecretID:
+		m.ResetSecretID()
 		return nil
-	case auditlog.FieldLatencyMs:
-		m.ResetLatencyMs()
+	case secretversion.FieldVersionNumber:
+		m.ResetVersionNumber()
 		return nil
-	case auditlog.FieldPeerAddress:
-		m.ResetPeerAddress()
+	case secretversion.FieldVaultPath:
+		m.ResetVaultPath()
 		return nil
-	case auditlog.FieldGeoLocation:
-		m.ResetGeoLocation()
+	case secretversion.FieldComment:
+		m.ResetComment()
 		return nil
-	case auditlog.FieldLogHash:
-		m.ResetLogHash()
+	case secretversion.FieldChecksum:
+		m.ResetChecksum()
 		return nil
-	case auditlog.FieldSignature:
-		m.ResetSignature()
+	case secretversion.FieldChecksumAlgorithm:
+		m.ResetChecksumAlgorithm()
 		return nil
-	case auditlog.FieldMetadata:
-		m.ResetMetadata()
+	case secretversion.FieldSourceVersion:
+		m.ResetSourceVersion()
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLog field %s", name)
+	return fmt.Errorf("unknown SecretVersion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuditLogMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SecretVersionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.secret != nil {
+		edges = append(edges, secretversion.EdgeSecret)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+func (m *SecretVersionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case secretversion.EdgeSecret:
+		if id := m.secret; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuditLogMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SecretVersionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+func (m *SecretVersionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuditLogMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SecretVersionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsecret {
+		edges = append(edges, secretversion.EdgeSecret)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuditLogMutation) EdgeCleared(name string) bool {
+func (m *SecretVersionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case secretversion.EdgeSecret:
+		return m.clearedsecret
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuditLogMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+func (m *SecretVersionMutation) ClearEdge(name string) error {
+	switch name {
+	case secretversion.EdgeSecret:
+		m.ClearSecret()
+		return nil
+	}
+	return fmt.Errorf("unknown SecretVersion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuditLogMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuditLog edge %s", name)
+func (m *SecretVersionMutation) ResetEdge(name string) error {
+	switch name {
+	case secretversion.EdgeSecret:
+		m.ResetSecret()
+		return nil
+	}
+	return fmt.Errorf("unknown SecretVersion edge %s", name)
 }
 
-// FolderMutation represents an operation that mutates the Folder nodes in the graph.
-type FolderMutation struct {
+// SecurityAlertMutation represents an operation that mutates the SecurityAlert nodes in the graph.
+type SecurityAlertMutation struct {
 	config
 	op                 Op
 	typ                string
-	id                 *string
-	create_by          *uint32
-	addcreate_by       *int32
+	id                 *uint32
 	create_time        *time.Time
 	update_time        *time.Time
 	delete_time        *time.Time
 	tenant_id          *uint32
 	addtenant_id       *int32
-	name               *string
-	_path              *string
-	description        *string
-	depth              *int32
-	adddepth           *int32
+	kind               *securityalert.Kind
+	severity           *securityalert.Severity
+	user_id            *string
+	resource_id        *string
+	message            *string
+	details            *map[string]string
+	acknowledged       *bool
+	acknowledged_by    *uint32
+	addacknowledged_by *int32
+	acknowledged_at    *time.Time
 	clearedFields      map[string]struct{}
-	parent             *string
-	clearedparent      bool
-	children           map[string]struct{}
-	removedchildren    map[string]struct{}
-	clearedchildren    bool
-	secrets            map[string]struct{}
-	removedsecrets     map[string]struct{}
-	clearedsecrets     bool
-	permissions        map[int]struct{}
-	removedpermissions map[int]struct{}
-	clearedpermissions bool
 	done               bool
-	oldValue           func(context.Context) (*Folder, error)
-	predicates         []predicate.Folder
+	oldValue           func(context.Context) (*SecurityAlert, error)
+	predicates         []predicate.SecurityAlert
 }
 
-var _ ent.Mutation = (*FolderMutation)(nil)
+var _ ent.Mutation = (*SecurityAlertMutation)(nil)
 
-// folderOption allows management of the mutation configuration using functional options.
-type folderOption func(*FolderMutation)
+// securityalertOption allows management of the mutation configuration using functional options.
+type securityalertOption func(*SecurityAlertMutation)
 
-// newFolderMutation creates new mutation for the Folder entity.
-func newFolderMutation(c config, op Op, opts ...folderOption) *FolderMutation {
-	m := &FolderMutation{
+// newSecurityAlertMutation creates new mutation for the SecurityAlert entity.
+func newSecurityAlertMutation(c config, op Op, opts ...securityalertOption) *SecurityAlertMutation {
+	m := &SecurityAlertMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFolder,
+		typ:           TypeSecurityAlert,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1966,20 +17402,20 @@ func newFolderMutation(c config, op Op, opts ...folderOption) *FolderMutation {
 	return m
 }
 
-// withFolderID sets the ID field of the mutation.
-func withFolderID(id string) folderOption {
-	return func(m *FolderMutation) {
+// withSecurityAlertID sets the ID field of the mutation.
+func withSecurityAlertID(id uint32) securityalertOption {
+	return func(m *SecurityAlertMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Folder
+			value *SecurityAlert
 		)
-		m.oldValue = func(ctx context.Context) (*Folder, error) {
+		m.oldValue = func(ctx context.Context) (*SecurityAlert, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Folder.Get(ctx, id)
+					value, err = m.Client().SecurityAlert.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1988,10 +17424,10 @@ func withFolderID(id string) folderOption {
 	}
 }
 
-// withFolder sets the old Folder of the mutation.
-func withFolder(node *Folder) folderOption {
-	return func(m *FolderMutation) {
-		m.oldValue = func(context.Context) (*Folder, error) {
+// withSecurityAlert sets the old SecurityAlert of the mutation.
+func withSecurityAlert(node *SecurityAlert) securityalertOption {
+	return func(m *SecurityAlertMutation) {
+		m.oldValue = func(context.Context) (*SecurityAlert, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2000,7 +17436,7 @@ func withFolder(node *Folder) folderOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FolderMutation) Client() *Client {
+func (m SecurityAlertMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2008,7 +17444,7 @@ func (m FolderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FolderMutation) Tx() (*Tx, error) {
+func (m SecurityAlertMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2018,14 +17454,14 @@ func (m FolderMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Folder entities.
-func (m *FolderMutation) SetID(id string) {
+// operation is only accepted on creation of SecurityAlert entities.
+func (m *SecurityAlertMutation) SetID(id uint32) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FolderMutation) ID() (id string, exists bool) {
+func (m *SecurityAlertMutation) ID() (id uint32, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2036,98 +17472,28 @@ func (m *FolderMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FolderMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *SecurityAlertMutation) IDs(ctx context.Context) ([]uint32, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []uint32{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Folder.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SecurityAlert.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreateBy sets the "create_by" field.
-func (m *FolderMutation) SetCreateBy(u uint32) {
-	m.create_by = &u
-	m.addcreate_by = nil
-}
-
-// CreateBy returns the value of the "create_by" field in the mutation.
-func (m *FolderMutation) CreateBy() (r uint32, exists bool) {
-	v := m.create_by
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreateBy returns the old "create_by" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateBy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
-	}
-	return oldValue.CreateBy, nil
-}
-
-// AddCreateBy adds u to the "create_by" field.
-func (m *FolderMutation) AddCreateBy(u int32) {
-	if m.addcreate_by != nil {
-		*m.addcreate_by += u
-	} else {
-		m.addcreate_by = &u
-	}
-}
-
-// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
-func (m *FolderMutation) AddedCreateBy() (r int32, exists bool) {
-	v := m.addcreate_by
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearCreateBy clears the value of the "create_by" field.
-func (m *FolderMutation) ClearCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	m.clearedFields[folder.FieldCreateBy] = struct{}{}
-}
-
-// CreateByCleared returns if the "create_by" field was cleared in this mutation.
-func (m *FolderMutation) CreateByCleared() bool {
-	_, ok := m.clearedFields[folder.FieldCreateBy]
-	return ok
-}
-
-// ResetCreateBy resets all changes to the "create_by" field.
-func (m *FolderMutation) ResetCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	delete(m.clearedFields, folder.FieldCreateBy)
-}
-
 // SetCreateTime sets the "create_time" field.
-func (m *FolderMutation) SetCreateTime(t time.Time) {
+func (m *SecurityAlertMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *FolderMutation) CreateTime() (r time.Time, exists bool) {
+func (m *SecurityAlertMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -2135,10 +17501,10 @@ func (m *FolderMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *SecurityAlertMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -2153,30 +17519,30 @@ func (m *FolderMutation) OldCreateTime(ctx context.Context) (v *time.Time, err e
 }
 
 // ClearCreateTime clears the value of the "create_time" field.
-func (m *FolderMutation) ClearCreateTime() {
+func (m *SecurityAlertMutation) ClearCreateTime() {
 	m.create_time = nil
-	m.clearedFields[folder.FieldCreateTime] = struct{}{}
+	m.clearedFields[securityalert.FieldCreateTime] = struct{}{}
 }
 
 // CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *FolderMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[folder.FieldCreateTime]
+func (m *SecurityAlertMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[securityalert.FieldCreateTime]
 	return ok
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *FolderMutation) ResetCreateTime() {
+func (m *SecurityAlertMutation) ResetCreateTime() {
 	m.create_time = nil
-	delete(m.clearedFields, folder.FieldCreateTime)
+	delete(m.clearedFields, securityalert.FieldCreateTime)
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *FolderMutation) SetUpdateTime(t time.Time) {
+func (m *SecurityAlertMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *FolderMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *SecurityAlertMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -2184,10 +17550,10 @@ func (m *FolderMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *SecurityAlertMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -2202,30 +17568,30 @@ func (m *FolderMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err e
 }
 
 // ClearUpdateTime clears the value of the "update_time" field.
-func (m *FolderMutation) ClearUpdateTime() {
+func (m *SecurityAlertMutation) ClearUpdateTime() {
 	m.update_time = nil
-	m.clearedFields[folder.FieldUpdateTime] = struct{}{}
+	m.clearedFields[securityalert.FieldUpdateTime] = struct{}{}
 }
 
-// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *FolderMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[folder.FieldUpdateTime]
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *SecurityAlertMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[securityalert.FieldUpdateTime]
 	return ok
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *FolderMutation) ResetUpdateTime() {
+func (m *SecurityAlertMutation) ResetUpdateTime() {
 	m.update_time = nil
-	delete(m.clearedFields, folder.FieldUpdateTime)
+	delete(m.clearedFields, securityalert.FieldUpdateTime)
 }
 
 // SetDeleteTime sets the "delete_time" field.
-func (m *FolderMutation) SetDeleteTime(t time.Time) {
+func (m *SecurityAlertMutation) SetDeleteTime(t time.Time) {
 	m.delete_time = &t
 }
 
 // DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *FolderMutation) DeleteTime() (r time.Time, exists bool) {
+func (m *SecurityAlertMutation) DeleteTime() (r time.Time, exists bool) {
 	v := m.delete_time
 	if v == nil {
 		return
@@ -2233,10 +17599,10 @@ func (m *FolderMutation) DeleteTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleteTime returns the old "delete_time" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+func (m *SecurityAlertMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
@@ -2251,31 +17617,31 @@ func (m *FolderMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err e
 }
 
 // ClearDeleteTime clears the value of the "delete_time" field.
-func (m *FolderMutation) ClearDeleteTime() {
+func (m *SecurityAlertMutation) ClearDeleteTime() {
 	m.delete_time = nil
-	m.clearedFields[folder.FieldDeleteTime] = struct{}{}
+	m.clearedFields[securityalert.FieldDeleteTime] = struct{}{}
 }
 
 // DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *FolderMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[folder.FieldDeleteTime]
+func (m *SecurityAlertMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[securityalert.FieldDeleteTime]
 	return ok
 }
 
 // ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *FolderMutation) ResetDeleteTime() {
+func (m *SecurityAlertMutation) ResetDeleteTime() {
 	m.delete_time = nil
-	delete(m.clearedFields, folder.FieldDeleteTime)
+	delete(m.clearedFields, securityalert.FieldDeleteTime)
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *FolderMutation) SetTenantID(u uint32) {
+func (m *SecurityAlertMutation) SetTenantID(u uint32) {
 	m.tenant_id = &u
 	m.addtenant_id = nil
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *FolderMutation) TenantID() (r uint32, exists bool) {
+func (m *SecurityAlertMutation) TenantID() (r uint32, exists bool) {
 	v := m.tenant_id
 	if v == nil {
 		return
@@ -2283,10 +17649,10 @@ func (m *FolderMutation) TenantID() (r uint32, exists bool) {
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+func (m *SecurityAlertMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -2301,7 +17667,7 @@ func (m *FolderMutation) OldTenantID(ctx context.Context) (v *uint32, err error)
 }
 
 // AddTenantID adds u to the "tenant_id" field.
-func (m *FolderMutation) AddTenantID(u int32) {
+func (m *SecurityAlertMutation) AddTenantID(u int32) {
 	if m.addtenant_id != nil {
 		*m.addtenant_id += u
 	} else {
@@ -2310,7 +17676,7 @@ func (m *FolderMutation) AddTenantID(u int32) {
 }
 
 // AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
-func (m *FolderMutation) AddedTenantID() (r int32, exists bool) {
+func (m *SecurityAlertMutation) AddedTenantID() (r int32, exists bool) {
 	v := m.addtenant_id
 	if v == nil {
 		return
@@ -2319,449 +17685,444 @@ func (m *FolderMutation) AddedTenantID() (r int32, exists bool) {
 }
 
 // ClearTenantID clears the value of the "tenant_id" field.
-func (m *FolderMutation) ClearTenantID() {
+func (m *SecurityAlertMutation) ClearTenantID() {
 	m.tenant_id = nil
 	m.addtenant_id = nil
-	m.clearedFields[folder.FieldTenantID] = struct{}{}
+	m.clearedFields[securityalert.FieldTenantID] = struct{}{}
 }
 
 // TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
-func (m *FolderMutation) TenantIDCleared() bool {
-	_, ok := m.clearedFields[folder.FieldTenantID]
+func (m *SecurityAlertMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[securityalert.FieldTenantID]
 	return ok
 }
 
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *FolderMutation) ResetTenantID() {
+func (m *SecurityAlertMutation) ResetTenantID() {
 	m.tenant_id = nil
 	m.addtenant_id = nil
-	delete(m.clearedFields, folder.FieldTenantID)
+	delete(m.clearedFields, securityalert.FieldTenantID)
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *FolderMutation) SetParentID(s string) {
-	m.parent = &s
+// SetKind sets the "kind" field.
+func (m *SecurityAlertMutation) SetKind(s securityalert.Kind) {
+	m.kind = &s
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *FolderMutation) ParentID() (r string, exists bool) {
-	v := m.parent
+// Kind returns the value of the "kind" field in the mutation.
+func (m *SecurityAlertMutation) Kind() (r securityalert.Kind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldParentID(ctx context.Context) (v *string, err error) {
+func (m *SecurityAlertMutation) OldKind(ctx context.Context) (v securityalert.Kind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.ParentID, nil
-}
-
-// ClearParentID clears the value of the "parent_id" field.
-func (m *FolderMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[folder.FieldParentID] = struct{}{}
-}
-
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *FolderMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[folder.FieldParentID]
-	return ok
+	return oldValue.Kind, nil
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *FolderMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, folder.FieldParentID)
+// ResetKind resets all changes to the "kind" field.
+func (m *SecurityAlertMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetName sets the "name" field.
-func (m *FolderMutation) SetName(s string) {
-	m.name = &s
+// SetSeverity sets the "severity" field.
+func (m *SecurityAlertMutation) SetSeverity(s securityalert.Severity) {
+	m.severity = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FolderMutation) Name() (r string, exists bool) {
-	v := m.name
+// Severity returns the value of the "severity" field in the mutation.
+func (m *SecurityAlertMutation) Severity() (r securityalert.Severity, exists bool) {
+	v := m.severity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldSeverity returns the old "severity" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *SecurityAlertMutation) OldSeverity(ctx context.Context) (v securityalert.Severity, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Severity, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FolderMutation) ResetName() {
-	m.name = nil
+// ResetSeverity resets all changes to the "severity" field.
+func (m *SecurityAlertMutation) ResetSeverity() {
+	m.severity = nil
 }
 
-// SetPath sets the "path" field.
-func (m *FolderMutation) SetPath(s string) {
-	m._path = &s
+// SetUserID sets the "user_id" field.
+func (m *SecurityAlertMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *FolderMutation) Path() (r string, exists bool) {
-	v := m._path
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SecurityAlertMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldPath(ctx context.Context) (v string, err error) {
+func (m *SecurityAlertMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Path, nil
+	return oldValue.UserID, nil
 }
 
-// ResetPath resets all changes to the "path" field.
-func (m *FolderMutation) ResetPath() {
-	m._path = nil
+// ClearUserID clears the value of the "user_id" field.
+func (m *SecurityAlertMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[securityalert.FieldUserID] = struct{}{}
 }
 
-// SetDescription sets the "description" field.
-func (m *FolderMutation) SetDescription(s string) {
-	m.description = &s
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *SecurityAlertMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[securityalert.FieldUserID]
+	return ok
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *FolderMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SecurityAlertMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, securityalert.FieldUserID)
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *SecurityAlertMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *SecurityAlertMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceID returns the old "resource_id" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *SecurityAlertMutation) OldResourceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.ResourceID, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *FolderMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[folder.FieldDescription] = struct{}{}
+// ClearResourceID clears the value of the "resource_id" field.
+func (m *SecurityAlertMutation) ClearResourceID() {
+	m.resource_id = nil
+	m.clearedFields[securityalert.FieldResourceID] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *FolderMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[folder.FieldDescription]
+// ResourceIDCleared returns if the "resource_id" field was cleared in this mutation.
+func (m *SecurityAlertMutation) ResourceIDCleared() bool {
+	_, ok := m.clearedFields[securityalert.FieldResourceID]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *FolderMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, folder.FieldDescription)
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *SecurityAlertMutation) ResetResourceID() {
+	m.resource_id = nil
+	delete(m.clearedFields, securityalert.FieldResourceID)
 }
 
-// SetDepth sets the "depth" field.
-func (m *FolderMutation) SetDepth(i int32) {
-	m.depth = &i
-	m.adddepth = nil
+// SetMessage sets the "message" field.
+func (m *SecurityAlertMutation) SetMessage(s string) {
+	m.message = &s
 }
 
-// Depth returns the value of the "depth" field in the mutation.
-func (m *FolderMutation) Depth() (r int32, exists bool) {
-	v := m.depth
+// Message returns the value of the "message" field in the mutation.
+func (m *SecurityAlertMutation) Message() (r string, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDepth returns the old "depth" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldDepth(ctx context.Context) (v int32, err error) {
+func (m *SecurityAlertMutation) OldMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDepth is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDepth requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDepth: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return oldValue.Depth, nil
+	return oldValue.Message, nil
 }
 
-// AddDepth adds i to the "depth" field.
-func (m *FolderMutation) AddDepth(i int32) {
-	if m.adddepth != nil {
-		*m.adddepth += i
-	} else {
-		m.adddepth = &i
-	}
+// ResetMessage resets all changes to the "message" field.
+func (m *SecurityAlertMutation) ResetMessage() {
+	m.message = nil
 }
 
-// AddedDepth returns the value that was added to the "depth" field in this mutation.
-func (m *FolderMutation) AddedDepth() (r int32, exists bool) {
-	v := m.adddepth
+// SetDetails sets the "details" field.
+func (m *SecurityAlertMutation) SetDetails(value map[string]string) {
+	m.details = &value
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *SecurityAlertMutation) Details() (r map[string]string, exists bool) {
+	v := m.details
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDepth resets all changes to the "depth" field.
-func (m *FolderMutation) ResetDepth() {
-	m.depth = nil
-	m.adddepth = nil
+// OldDetails returns the old "details" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityAlertMutation) OldDetails(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
 }
 
-// ClearParent clears the "parent" edge to the Folder entity.
-func (m *FolderMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[folder.FieldParentID] = struct{}{}
+// ClearDetails clears the value of the "details" field.
+func (m *SecurityAlertMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[securityalert.FieldDetails] = struct{}{}
 }
 
-// ParentCleared reports if the "parent" edge to the Folder entity was cleared.
-func (m *FolderMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *SecurityAlertMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[securityalert.FieldDetails]
+	return ok
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *FolderMutation) ParentIDs() (ids []string) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetDetails resets all changes to the "details" field.
+func (m *SecurityAlertMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, securityalert.FieldDetails)
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *FolderMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// SetAcknowledged sets the "acknowledged" field.
+func (m *SecurityAlertMutation) SetAcknowledged(b bool) {
+	m.acknowledged = &b
 }
 
-// AddChildIDs adds the "children" edge to the Folder entity by ids.
-func (m *FolderMutation) AddChildIDs(ids ...string) {
-	if m.children == nil {
-		m.children = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+// Acknowledged returns the value of the "acknowledged" field in the mutation.
+func (m *SecurityAlertMutation) Acknowledged() (r bool, exists bool) {
+	v := m.acknowledged
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearChildren clears the "children" edge to the Folder entity.
-func (m *FolderMutation) ClearChildren() {
-	m.clearedchildren = true
-}
-
-// ChildrenCleared reports if the "children" edge to the Folder entity was cleared.
-func (m *FolderMutation) ChildrenCleared() bool {
-	return m.clearedchildren
-}
-
-// RemoveChildIDs removes the "children" edge to the Folder entity by IDs.
-func (m *FolderMutation) RemoveChildIDs(ids ...string) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[string]struct{})
+// OldAcknowledged returns the old "acknowledged" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityAlertMutation) OldAcknowledged(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcknowledged is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcknowledged requires an ID field in the mutation")
 	}
-}
-
-// RemovedChildren returns the removed IDs of the "children" edge to the Folder entity.
-func (m *FolderMutation) RemovedChildrenIDs() (ids []string) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcknowledged: %w", err)
 	}
-	return
+	return oldValue.Acknowledged, nil
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *FolderMutation) ChildrenIDs() (ids []string) {
-	for id := range m.children {
-		ids = append(ids, id)
-	}
-	return
+// ResetAcknowledged resets all changes to the "acknowledged" field.
+func (m *SecurityAlertMutation) ResetAcknowledged() {
+	m.acknowledged = nil
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *FolderMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (m *SecurityAlertMutation) SetAcknowledgedBy(u uint32) {
+	m.acknowledged_by = &u
+	m.addacknowledged_by = nil
 }
 
-// AddSecretIDs adds the "secrets" edge to the Secret entity by ids.
-func (m *FolderMutation) AddSecretIDs(ids ...string) {
-	if m.secrets == nil {
-		m.secrets = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.secrets[ids[i]] = struct{}{}
+// AcknowledgedBy returns the value of the "acknowledged_by" field in the mutation.
+func (m *SecurityAlertMutation) AcknowledgedBy() (r uint32, exists bool) {
+	v := m.acknowledged_by
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearSecrets clears the "secrets" edge to the Secret entity.
-func (m *FolderMutation) ClearSecrets() {
-	m.clearedsecrets = true
-}
-
-// SecretsCleared reports if the "secrets" edge to the Secret entity was cleared.
-func (m *FolderMutation) SecretsCleared() bool {
-	return m.clearedsecrets
-}
-
-// RemoveSecretIDs removes the "secrets" edge to the Secret entity by IDs.
-func (m *FolderMutation) RemoveSecretIDs(ids ...string) {
-	if m.removedsecrets == nil {
-		m.removedsecrets = make(map[string]struct{})
+// OldAcknowledgedBy returns the old "acknowledged_by" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityAlertMutation) OldAcknowledgedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcknowledgedBy is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.secrets, ids[i])
-		m.removedsecrets[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcknowledgedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcknowledgedBy: %w", err)
 	}
+	return oldValue.AcknowledgedBy, nil
 }
 
-// RemovedSecrets returns the removed IDs of the "secrets" edge to the Secret entity.
-func (m *FolderMutation) RemovedSecretsIDs() (ids []string) {
-	for id := range m.removedsecrets {
-		ids = append(ids, id)
+// AddAcknowledgedBy adds u to the "acknowledged_by" field.
+func (m *SecurityAlertMutation) AddAcknowledgedBy(u int32) {
+	if m.addacknowledged_by != nil {
+		*m.addacknowledged_by += u
+	} else {
+		m.addacknowledged_by = &u
 	}
-	return
 }
 
-// SecretsIDs returns the "secrets" edge IDs in the mutation.
-func (m *FolderMutation) SecretsIDs() (ids []string) {
-	for id := range m.secrets {
-		ids = append(ids, id)
+// AddedAcknowledgedBy returns the value that was added to the "acknowledged_by" field in this mutation.
+func (m *SecurityAlertMutation) AddedAcknowledgedBy() (r int32, exists bool) {
+	v := m.addacknowledged_by
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetSecrets resets all changes to the "secrets" edge.
-func (m *FolderMutation) ResetSecrets() {
-	m.secrets = nil
-	m.clearedsecrets = false
-	m.removedsecrets = nil
+// ClearAcknowledgedBy clears the value of the "acknowledged_by" field.
+func (m *SecurityAlertMutation) ClearAcknowledgedBy() {
+	m.acknowledged_by = nil
+	m.addacknowledged_by = nil
+	m.clearedFields[securityalert.FieldAcknowledgedBy] = struct{}{}
 }
 
-// AddPermissionIDs adds the "permissions" edge to the Permission entity by ids.
-func (m *FolderMutation) AddPermissionIDs(ids ...int) {
-	if m.permissions == nil {
-		m.permissions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.permissions[ids[i]] = struct{}{}
-	}
+// AcknowledgedByCleared returns if the "acknowledged_by" field was cleared in this mutation.
+func (m *SecurityAlertMutation) AcknowledgedByCleared() bool {
+	_, ok := m.clearedFields[securityalert.FieldAcknowledgedBy]
+	return ok
 }
 
-// ClearPermissions clears the "permissions" edge to the Permission entity.
-func (m *FolderMutation) ClearPermissions() {
-	m.clearedpermissions = true
+// ResetAcknowledgedBy resets all changes to the "acknowledged_by" field.
+func (m *SecurityAlertMutation) ResetAcknowledgedBy() {
+	m.acknowledged_by = nil
+	m.addacknowledged_by = nil
+	delete(m.clearedFields, securityalert.FieldAcknowledgedBy)
 }
 
-// PermissionsCleared reports if the "permissions" edge to the Permission entity was cleared.
-func (m *FolderMutation) PermissionsCleared() bool {
-	return m.clearedpermissions
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (m *SecurityAlertMutation) SetAcknowledgedAt(t time.Time) {
+	m.acknowledged_at = &t
 }
 
-// RemovePermissionIDs removes the "permissions" edge to the Permission entity by IDs.
-func (m *FolderMutation) RemovePermissionIDs(ids ...int) {
-	if m.removedpermissions == nil {
-		m.removedpermissions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.permissions, ids[i])
-		m.removedpermissions[ids[i]] = struct{}{}
+// AcknowledgedAt returns the value of the "acknowledged_at" field in the mutation.
+func (m *SecurityAlertMutation) AcknowledgedAt() (r time.Time, exists bool) {
+	v := m.acknowledged_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedPermissions returns the removed IDs of the "permissions" edge to the Permission entity.
-func (m *FolderMutation) RemovedPermissionsIDs() (ids []int) {
-	for id := range m.removedpermissions {
-		ids = append(ids, id)
+// OldAcknowledgedAt returns the old "acknowledged_at" field's value of the SecurityAlert entity.
+// If the SecurityAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityAlertMutation) OldAcknowledgedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcknowledgedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcknowledgedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcknowledgedAt: %w", err)
+	}
+	return oldValue.AcknowledgedAt, nil
 }
 
-// PermissionsIDs returns the "permissions" edge IDs in the mutation.
-func (m *FolderMutation) PermissionsIDs() (ids []int) {
-	for id := range m.permissions {
-		ids = append(ids, id)
-	}
-	return
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (m *SecurityAlertMutation) ClearAcknowledgedAt() {
+	m.acknowledged_at = nil
+	m.clearedFields[securityalert.FieldAcknowledgedAt] = struct{}{}
 }
 
-// ResetPermissions resets all changes to the "permissions" edge.
-func (m *FolderMutation) ResetPermissions() {
-	m.permissions = nil
-	m.clearedpermissions = false
-	m.removedpermissions = nil
+// AcknowledgedAtCleared returns if the "acknowledged_at" field was cleared in this mutation.
+func (m *SecurityAlertMutation) AcknowledgedAtCleared() bool {
+	_, ok := m.clearedFields[securityalert.FieldAcknowledgedAt]
+	return ok
 }
 
-// Where appends a list predicates to the FolderMutation builder.
-func (m *FolderMutation) Where(ps ...predicate.Folder) {
+// ResetAcknowledgedAt resets all changes to the "acknowledged_at" field.
+func (m *SecurityAlertMutation) ResetAcknowledgedAt() {
+	m.acknowledged_at = nil
+	delete(m.clearedFields, securityalert.FieldAcknowledgedAt)
+}
+
+// Where appends a list predicates to the SecurityAlertMutation builder.
+func (m *SecurityAlertMutation) Where(ps ...predicate.SecurityAlert) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FolderMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SecurityAlertMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FolderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Folder, len(ps))
+func (m *SecurityAlertMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SecurityAlert, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2769,54 +18130,63 @@ func (m *FolderMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FolderMutation) Op() Op {
+func (m *SecurityAlertMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FolderMutation) SetOp(op Op) {
+func (m *SecurityAlertMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Folder).
-func (m *FolderMutation) Type() string {
+// Type returns the node type of this mutation (SecurityAlert).
+func (m *SecurityAlertMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FolderMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.create_by != nil {
-		fields = append(fields, folder.FieldCreateBy)
-	}
+func (m *SecurityAlertMutation) Fields() []string {
+	fields := make([]string, 0, 13)
 	if m.create_time != nil {
-		fields = append(fields, folder.FieldCreateTime)
+		fields = append(fields, securityalert.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, folder.FieldUpdateTime)
+		fields = append(fields, securityalert.FieldUpdateTime)
 	}
 	if m.delete_time != nil {
-		fields = append(fields, folder.FieldDeleteTime)
+		fields = append(fields, securityalert.FieldDeleteTime)
 	}
 	if m.tenant_id != nil {
-		fields = append(fields, folder.FieldTenantID)
+		fields = append(fields, securityalert.FieldTenantID)
 	}
-	if m.parent != nil {
-		fields = append(fields, folder.FieldParentID)
+	if m.kind != nil {
+		fields = append(fields, securityalert.FieldKind)
 	}
-	if m.name != nil {
-		fields = append(fields, folder.FieldName)
+	if m.severity != nil {
+		fields = append(fields, securityalert.FieldSeverity)
 	}
-	if m._path != nil {
-		fields = append(fields, folder.FieldPath)
+	if m.user_id != nil {
+		fields = append(fields, securityalert.FieldUserID)
 	}
-	if m.description != nil {
-		fields = append(fields, folder.FieldDescription)
+	if m.resource_id != nil {
+		fields = append(fields, securityalert.FieldResourceID)
 	}
-	if m.depth != nil {
-		fields = append(fields, folder.FieldDepth)
+	if m.message != nil {
+		fields = append(fields, securityalert.FieldMessage)
+	}
+	if m.details != nil {
+		fields = append(fields, securityalert.FieldDetails)
+	}
+	if m.acknowledged != nil {
+		fields = append(fields, securityalert.FieldAcknowledged)
+	}
+	if m.acknowledged_by != nil {
+		fields = append(fields, securityalert.FieldAcknowledgedBy)
+	}
+	if m.acknowledged_at != nil {
+		fields = append(fields, securityalert.FieldAcknowledgedAt)
 	}
 	return fields
 }
@@ -2824,28 +18194,34 @@ func (m *FolderMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FolderMutation) Field(name string) (ent.Value, bool) {
+func (m *SecurityAlertMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case folder.FieldCreateBy:
-		return m.CreateBy()
-	case folder.FieldCreateTime:
+	case securityalert.FieldCreateTime:
 		return m.CreateTime()
-	case folder.FieldUpdateTime:
+	case securityalert.FieldUpdateTime:
 		return m.UpdateTime()
-	case folder.FieldDeleteTime:
+	case securityalert.FieldDeleteTime:
 		return m.DeleteTime()
-	case folder.FieldTenantID:
+	case securityalert.FieldTenantID:
 		return m.TenantID()
-	case folder.FieldParentID:
-		return m.ParentID()
-	case folder.FieldName:
-		return m.Name()
-	case folder.FieldPath:
-		return m.Path()
-	case folder.FieldDescription:
-		return m.Description()
-	case folder.FieldDepth:
-		return m.Depth()
+	case securityalert.FieldKind:
+		return m.Kind()
+	case securityalert.FieldSeverity:
+		return m.Severity()
+	case securityalert.FieldUserID:
+		return m.UserID()
+	case securityalert.FieldResourceID:
+		return m.ResourceID()
+	case securityalert.FieldMessage:
+		return m.Message()
+	case securityalert.FieldDetails:
+		return m.Details()
+	case securityalert.FieldAcknowledged:
+		return m.Acknowledged()
+	case securityalert.FieldAcknowledgedBy:
+		return m.AcknowledgedBy()
+	case securityalert.FieldAcknowledgedAt:
+		return m.AcknowledgedAt()
 	}
 	return nil, false
 }
@@ -2853,123 +18229,147 @@ func (m *FolderMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FolderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SecurityAlertMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case folder.FieldCreateBy:
-		return m.OldCreateBy(ctx)
-	case folder.FieldCreateTime:
+	case securityalert.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case folder.FieldUpdateTime:
+	case securityalert.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case folder.FieldDeleteTime:
+	case securityalert.FieldDeleteTime:
 		return m.OldDeleteTime(ctx)
-	case folder.FieldTenantID:
+	case securityalert.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case folder.FieldParentID:
-		return m.OldParentID(ctx)
-	case folder.FieldName:
-		return m.OldName(ctx)
-	case folder.FieldPath:
-		return m.OldPath(ctx)
-	case folder.FieldDescription:
-		return m.OldDescription(ctx)
-	case folder.FieldDepth:
-		return m.OldDepth(ctx)
+	case securityalert.FieldKind:
+		return m.OldKind(ctx)
+	case securityalert.FieldSeverity:
+		return m.OldSeverity(ctx)
+	case securityalert.FieldUserID:
+		return m.OldUserID(ctx)
+	case securityalert.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case securityalert.FieldMessage:
+		return m.OldMessage(ctx)
+	case securityalert.FieldDetails:
+		return m.OldDetails(ctx)
+	case securityalert.FieldAcknowledged:
+		return m.OldAcknowledged(ctx)
+	case securityalert.FieldAcknowledgedBy:
+		return m.OldAcknowledgedBy(ctx)
+	case securityalert.FieldAcknowledgedAt:
+		return m.OldAcknowledgedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Folder field %s", name)
+	return nil, fmt.Errorf("unknown SecurityAlert field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FolderMutation) SetField(name string, value ent.Value) error {
+func (m *SecurityAlertMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case folder.FieldCreateBy:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateBy(v)
-		return nil
-	case folder.FieldCreateTime:
+	case securityalert.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case folder.FieldUpdateTime:
+	case securityalert.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case folder.FieldDeleteTime:
+	case securityalert.FieldDeleteTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeleteTime(v)
 		return nil
-	case folder.FieldTenantID:
+	case securityalert.FieldTenantID:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case folder.FieldParentID:
-		v, ok := value.(string)
+	case securityalert.FieldKind:
+		v, ok := value.(securityalert.Kind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
+		m.SetKind(v)
 		return nil
-	case folder.FieldName:
+	case securityalert.FieldSeverity:
+		v, ok := value.(securityalert.Severity)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverity(v)
+		return nil
+	case securityalert.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetUserID(v)
 		return nil
-	case folder.FieldPath:
+	case securityalert.FieldResourceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPath(v)
+		m.SetResourceID(v)
 		return nil
-	case folder.FieldDescription:
+	case securityalert.FieldMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetMessage(v)
 		return nil
-	case folder.FieldDepth:
-		v, ok := value.(int32)
+	case securityalert.FieldDetails:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDepth(v)
+		m.SetDetails(v)
+		return nil
+	case securityalert.FieldAcknowledged:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcknowledged(v)
+		return nil
+	case securityalert.FieldAcknowledgedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcknowledgedBy(v)
+		return nil
+	case securityalert.FieldAcknowledgedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcknowledgedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Folder field %s", name)
+	return fmt.Errorf("unknown SecurityAlert field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FolderMutation) AddedFields() []string {
+func (m *SecurityAlertMutation) AddedFields() []string {
 	var fields []string
-	if m.addcreate_by != nil {
-		fields = append(fields, folder.FieldCreateBy)
-	}
 	if m.addtenant_id != nil {
-		fields = append(fields, folder.FieldTenantID)
+		fields = append(fields, securityalert.FieldTenantID)
 	}
-	if m.adddepth != nil {
-		fields = append(fields, folder.FieldDepth)
+	if m.addacknowledged_by != nil {
+		fields = append(fields, securityalert.FieldAcknowledgedBy)
 	}
 	return fields
 }
@@ -2977,14 +18377,12 @@ func (m *FolderMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FolderMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SecurityAlertMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case folder.FieldCreateBy:
-		return m.AddedCreateBy()
-	case folder.FieldTenantID:
+	case securityalert.FieldTenantID:
 		return m.AddedTenantID()
-	case folder.FieldDepth:
-		return m.AddedDepth()
+	case securityalert.FieldAcknowledgedBy:
+		return m.AddedAcknowledgedBy()
 	}
 	return nil, false
 }
@@ -2992,329 +18390,252 @@ func (m *FolderMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FolderMutation) AddField(name string, value ent.Value) error {
+func (m *SecurityAlertMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case folder.FieldCreateBy:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCreateBy(v)
-		return nil
-	case folder.FieldTenantID:
+	case securityalert.FieldTenantID:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddTenantID(v)
 		return nil
-	case folder.FieldDepth:
+	case securityalert.FieldAcknowledgedBy:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDepth(v)
+		m.AddAcknowledgedBy(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Folder numeric field %s", name)
+	return fmt.Errorf("unknown SecurityAlert numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FolderMutation) ClearedFields() []string {
+func (m *SecurityAlertMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(folder.FieldCreateBy) {
-		fields = append(fields, folder.FieldCreateBy)
+	if m.FieldCleared(securityalert.FieldCreateTime) {
+		fields = append(fields, securityalert.FieldCreateTime)
 	}
-	if m.FieldCleared(folder.FieldCreateTime) {
-		fields = append(fields, folder.FieldCreateTime)
+	if m.FieldCleared(securityalert.FieldUpdateTime) {
+		fields = append(fields, securityalert.FieldUpdateTime)
 	}
-	if m.FieldCleared(folder.FieldUpdateTime) {
-		fields = append(fields, folder.FieldUpdateTime)
+	if m.FieldCleared(securityalert.FieldDeleteTime) {
+		fields = append(fields, securityalert.FieldDeleteTime)
 	}
-	if m.FieldCleared(folder.FieldDeleteTime) {
-		fields = append(fields, folder.FieldDeleteTime)
+	if m.FieldCleared(securityalert.FieldTenantID) {
+		fields = append(fields, securityalert.FieldTenantID)
 	}
-	if m.FieldCleared(folder.FieldTenantID) {
-		fields = append(fields, folder.FieldTenantID)
+	if m.FieldCleared(securityalert.FieldUserID) {
+		fields = append(fields, securityalert.FieldUserID)
 	}
-	if m.FieldCleared(folder.FieldParentID) {
-		fields = append(fields, folder.FieldParentID)
+	if m.FieldCleared(securityalert.FieldResourceID) {
+		fields = append(fields, securityalert.FieldResourceID)
 	}
-	if m.FieldCleared(folder.FieldDescription) {
-		fields = append(fields, folder.FieldDescription)
+	if m.FieldCleared(securityalert.FieldDetails) {
+		fields = append(fields, securityalert.FieldDetails)
+	}
+	if m.FieldCleared(securityalert.FieldAcknowledgedBy) {
+		fields = append(fields, securityalert.FieldAcknowledgedBy)
+	}
+	if m.FieldCleared(securityalert.FieldAcknowledgedAt) {
+		fields = append(fields, securityalert.FieldAcknowledgedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FolderMutation) FieldCleared(name string) bool {
+func (m *SecurityAlertMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FolderMutation) ClearField(name string) error {
+func (m *SecurityAlertMutation) ClearField(name string) error {
 	switch name {
-	case folder.FieldCreateBy:
-		m.ClearCreateBy()
-		return nil
-	case folder.FieldCreateTime:
+	case securityalert.FieldCreateTime:
 		m.ClearCreateTime()
 		return nil
-	case folder.FieldUpdateTime:
+	case securityalert.FieldUpdateTime:
 		m.ClearUpdateTime()
 		return nil
-	case folder.FieldDeleteTime:
+	case securityalert.FieldDeleteTime:
 		m.ClearDeleteTime()
 		return nil
-	case folder.FieldTenantID:
+	case securityalert.FieldTenantID:
 		m.ClearTenantID()
 		return nil
-	case folder.FieldParentID:
-		m.ClearParentID()
+	case securityalert.FieldUserID:
+		m.ClearUserID()
 		return nil
-	case folder.FieldDescription:
-		m.ClearDescription()
+	case securityalert.FieldResourceID:
+		m.ClearResourceID()
+		return nil
+	case securityalert.FieldDetails:
+		m.ClearDetails()
+		return nil
+	case securityalert.FieldAcknowledgedBy:
+		m.ClearAcknowledgedBy()
+		return nil
+	case securityalert.FieldAcknowledgedAt:
+		m.ClearAcknowledgedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Folder nullable field %s", name)
+	return fmt.Errorf("unknown SecurityAlert nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FolderMutation) ResetField(name string) error {
+func (m *SecurityAlertMutation) ResetField(name string) error {
 	switch name {
-	case folder.FieldCreateBy:
-		m.ResetCreateBy()
-		return nil
-	case folder.FieldCreateTime:
+	case securityalert.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case folder.FieldUpdateTime:
+	case securityalert.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case folder.FieldDeleteTime:
+	case securityalert.FieldDeleteTime:
 		m.ResetDeleteTime()
 		return nil
-	case folder.FieldTenantID:
+	case securityalert.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case folder.FieldParentID:
-		m.ResetParentID()
+	case securityalert.FieldKind:
+		m.ResetKind()
 		return nil
-	case folder.FieldName:
-		m.ResetName()
+	case securityalert.FieldSeverity:
+		m.ResetSeverity()
 		return nil
-	case folder.FieldPath:
-		m.ResetPath()
+	case securityalert.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case folder.FieldDescription:
-		m.ResetDescription()
+	case securityalert.FieldResourceID:
+		m.ResetResourceID()
 		return nil
-	case folder.FieldDepth:
-		m.ResetDepth()
+	case securityalert.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case securityalert.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case securityalert.FieldAcknowledged:
+		m.ResetAcknowledged()
+		return nil
+	case securityalert.FieldAcknowledgedBy:
+		m.ResetAcknowledgedBy()
+		return nil
+	case securityalert.FieldAcknowledgedAt:
+		m.ResetAcknowledgedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Folder field %s", name)
+	return fmt.Errorf("unknown SecurityAlert field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FolderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.parent != nil {
-		edges = append(edges, folder.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, folder.EdgeChildren)
-	}
-	if m.secrets != nil {
-		edges = append(edges, folder.EdgeSecrets)
-	}
-	if m.permissions != nil {
-		edges = append(edges, folder.EdgePermissions)
-	}
+func (m *SecurityAlertMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FolderMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case folder.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case folder.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case folder.EdgeSecrets:
-		ids := make([]ent.Value, 0, len(m.secrets))
-		for id := range m.secrets {
-			ids = append(ids, id)
-		}
-		return ids
-	case folder.EdgePermissions:
-		ids := make([]ent.Value, 0, len(m.permissions))
-		for id := range m.permissions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SecurityAlertMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FolderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedchildren != nil {
-		edges = append(edges, folder.EdgeChildren)
-	}
-	if m.removedsecrets != nil {
-		edges = append(edges, folder.EdgeSecrets)
-	}
-	if m.removedpermissions != nil {
-		edges = append(edges, folder.EdgePermissions)
-	}
+func (m *SecurityAlertMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FolderMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case folder.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	case folder.EdgeSecrets:
-		ids := make([]ent.Value, 0, len(m.removedsecrets))
-		for id := range m.removedsecrets {
-			ids = append(ids, id)
-		}
-		return ids
-	case folder.EdgePermissions:
-		ids := make([]ent.Value, 0, len(m.removedpermissions))
-		for id := range m.removedpermissions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SecurityAlertMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FolderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedparent {
-		edges = append(edges, folder.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, folder.EdgeChildren)
-	}
-	if m.clearedsecrets {
-		edges = append(edges, folder.EdgeSecrets)
-	}
-	if m.clearedpermissions {
-		edges = append(edges, folder.EdgePermissions)
-	}
+func (m *SecurityAlertMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FolderMutation) EdgeCleared(name string) bool {
-	switch name {
-	case folder.EdgeParent:
-		return m.clearedparent
-	case folder.EdgeChildren:
-		return m.clearedchildren
-	case folder.EdgeSecrets:
-		return m.clearedsecrets
-	case folder.EdgePermissions:
-		return m.clearedpermissions
-	}
+func (m *SecurityAlertMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FolderMutation) ClearEdge(name string) error {
-	switch name {
-	case folder.EdgeParent:
-		m.ClearParent()
-		return nil
-	}
-	return fmt.Errorf("unknown Folder unique edge %s", name)
+func (m *SecurityAlertMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SecurityAlert unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FolderMutation) ResetEdge(name string) error {
-	switch name {
-	case folder.EdgeParent:
-		m.ResetParent()
-		return nil
-	case folder.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case folder.EdgeSecrets:
-		m.ResetSecrets()
-		return nil
-	case folder.EdgePermissions:
-		m.ResetPermissions()
-		return nil
-	}
-	return fmt.Errorf("unknown Folder edge %s", name)
+func (m *SecurityAlertMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SecurityAlert edge %s", name)
 }
 
-// PermissionMutation represents an operation that mutates the Permission nodes in the graph.
-type PermissionMutation struct {
+// TenantSettingMutation represents an operation that mutates the TenantSetting nodes in the graph.
+type TenantSettingMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	create_time   *time.Time
-	update_time   *time.Time
-	delete_time   *time.Time
-	tenant_id     *uint32
-	addtenant_id  *int32
-	resource_type *permission.ResourceType
-	resource_id   *string
-	relation      *permission.Relation
-	subject_type  *permission.SubjectType
-	subject_id    *string
-	granted_by    *uint32
-	addgranted_by *int32
-	expires_at    *time.Time
-	clearedFields map[string]struct{}
-	folder        *string
-	clearedfolder bool
-	secret        *string
-	clearedsecret bool
-	done          bool
-	oldValue      func(context.Context) (*Permission, error)
-	predicates    []predicate.Permission
-}
-
-var _ ent.Mutation = (*PermissionMutation)(nil)
-
-// permissionOption allows management of the mutation configuration using functional options.
-type permissionOption func(*PermissionMutation)
-
-// newPermissionMutation creates new mutation for the Permission entity.
-func newPermissionMutation(c config, op Op, opts ...permissionOption) *PermissionMutation {
-	m := &PermissionMutation{
+	op                               Op
+	typ                              string
+	id                               *uint32
+	update_by                        *uint32
+	addupdate_by                     *int32
+	create_time                      *time.Time
+	update_time                      *time.Time
+	delete_time                      *time.Time
+	tenant_id                        *uint32
+	addtenant_id                     *int32
+	audit_retention_days             *int32
+	addaudit_retention_days          *int32
+	export_excluded_tags             *[]string
+	appendexport_excluded_tags       []string
+	export_excluded_folder_ids       *[]string
+	appendexport_excluded_folder_ids []string
+	password_policy_enabled          *bool
+	password_min_length              *int32
+	addpassword_min_length           *int32
+	password_require_lowercase       *bool
+	password_require_uppercase       *bool
+	password_require_digit           *bool
+	password_require_symbol          *bool
+	password_banned_words            *[]string
+	appendpassword_banned_words      []string
+	password_max_age_days            *int32
+	addpassword_max_age_days         *int32
+	password_history_size            *int32
+	addpassword_history_size         *int32
+	geo_allowed_countries            *[]string
+	appendgeo_allowed_countries      []string
+	version_max_count                *int32
+	addversion_max_count             *int32
+	version_delete_after_days        *int32
+	addversion_delete_after_days     *int32
+	clearedFields                    map[string]struct{}
+	done                             bool
+	oldValue                         func(context.Context) (*TenantSetting, error)
+	predicates                       []predicate.TenantSetting
+}
+
+var _ ent.Mutation = (*TenantSettingMutation)(nil)
+
+// tenantsettingOption allows management of the mutation configuration using functional options.
+type tenantsettingOption func(*TenantSettingMutation)
+
+// newTenantSettingMutation creates new mutation for the TenantSetting entity.
+func newTenantSettingMutation(c config, op Op, opts ...tenantsettingOption) *TenantSettingMutation {
+	m := &TenantSettingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePermission,
+		typ:           TypeTenantSetting,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3323,20 +18644,20 @@ func newPermissionMutation(c config, op Op, opts ...permissionOption) *Permissio
 	return m
 }
 
-// withPermissionID sets the ID field of the mutation.
-func withPermissionID(id int) permissionOption {
-	return func(m *PermissionMutation) {
+// withTenantSettingID sets the ID field of the mutation.
+func withTenantSettingID(id uint32) tenantsettingOption {
+	return func(m *TenantSettingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Permission
+			value *TenantSetting
 		)
-		m.oldValue = func(ctx context.Context) (*Permission, error) {
+		m.oldValue = func(ctx context.Context) (*TenantSetting, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Permission.Get(ctx, id)
+					value, err = m.Client().TenantSetting.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3345,10 +18666,10 @@ func withPermissionID(id int) permissionOption {
 	}
 }
 
-// withPermission sets the old Permission of the mutation.
-func withPermission(node *Permission) permissionOption {
-	return func(m *PermissionMutation) {
-		m.oldValue = func(context.Context) (*Permission, error) {
+// withTenantSetting sets the old TenantSetting of the mutation.
+func withTenantSetting(node *TenantSetting) tenantsettingOption {
+	return func(m *TenantSettingMutation) {
+		m.oldValue = func(context.Context) (*TenantSetting, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3357,58 +18678,134 @@ func withPermission(node *Permission) permissionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PermissionMutation) Client() *Client {
+func (m TenantSettingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PermissionMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TenantSettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TenantSetting entities.
+func (m *TenantSettingMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TenantSettingMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TenantSettingMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TenantSetting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *TenantSettingMutation) SetUpdateBy(u uint32) {
+	m.update_by = &u
+	m.addupdate_by = nil
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *TenantSettingMutation) UpdateBy() (r uint32, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldUpdateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// AddUpdateBy adds u to the "update_by" field.
+func (m *TenantSettingMutation) AddUpdateBy(u int32) {
+	if m.addupdate_by != nil {
+		*m.addupdate_by += u
+	} else {
+		m.addupdate_by = &u
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PermissionMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedUpdateBy returns the value that was added to the "update_by" field in this mutation.
+func (m *TenantSettingMutation) AddedUpdateBy() (r int32, exists bool) {
+	v := m.addupdate_by
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PermissionMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Permission.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *TenantSettingMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	m.clearedFields[tenantsetting.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *TenantSettingMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *TenantSettingMutation) ResetUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	delete(m.clearedFields, tenantsetting.FieldUpdateBy)
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *PermissionMutation) SetCreateTime(t time.Time) {
+func (m *TenantSettingMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *PermissionMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TenantSettingMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -3416,10 +18813,10 @@ func (m *PermissionMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *TenantSettingMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -3434,30 +18831,30 @@ func (m *PermissionMutation) OldCreateTime(ctx context.Context) (v *time.Time, e
 }
 
 // ClearCreateTime clears the value of the "create_time" field.
-func (m *PermissionMutation) ClearCreateTime() {
+func (m *TenantSettingMutation) ClearCreateTime() {
 	m.create_time = nil
-	m.clearedFields[permission.FieldCreateTime] = struct{}{}
+	m.clearedFields[tenantsetting.FieldCreateTime] = struct{}{}
 }
 
 // CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *PermissionMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[permission.FieldCreateTime]
+func (m *TenantSettingMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldCreateTime]
 	return ok
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *PermissionMutation) ResetCreateTime() {
+func (m *TenantSettingMutation) ResetCreateTime() {
 	m.create_time = nil
-	delete(m.clearedFields, permission.FieldCreateTime)
+	delete(m.clearedFields, tenantsetting.FieldCreateTime)
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *PermissionMutation) SetUpdateTime(t time.Time) {
+func (m *TenantSettingMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *PermissionMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TenantSettingMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -3465,10 +18862,10 @@ func (m *PermissionMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *TenantSettingMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -3483,30 +18880,30 @@ func (m *PermissionMutation) OldUpdateTime(ctx context.Context) (v *time.Time, e
 }
 
 // ClearUpdateTime clears the value of the "update_time" field.
-func (m *PermissionMutation) ClearUpdateTime() {
+func (m *TenantSettingMutation) ClearUpdateTime() {
 	m.update_time = nil
-	m.clearedFields[permission.FieldUpdateTime] = struct{}{}
+	m.clearedFields[tenantsetting.FieldUpdateTime] = struct{}{}
 }
 
 // UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *PermissionMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[permission.FieldUpdateTime]
+func (m *TenantSettingMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldUpdateTime]
 	return ok
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *PermissionMutation) ResetUpdateTime() {
+func (m *TenantSettingMutation) ResetUpdateTime() {
 	m.update_time = nil
-	delete(m.clearedFields, permission.FieldUpdateTime)
+	delete(m.clearedFields, tenantsetting.FieldUpdateTime)
 }
 
 // SetDeleteTime sets the "delete_time" field.
-func (m *PermissionMutation) SetDeleteTime(t time.Time) {
+func (m *TenantSettingMutation) SetDeleteTime(t time.Time) {
 	m.delete_time = &t
 }
 
 // DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *PermissionMutation) DeleteTime() (r time.Time, exists bool) {
+func (m *TenantSettingMutation) DeleteTime() (r time.Time, exists bool) {
 	v := m.delete_time
 	if v == nil {
 		return
@@ -3514,10 +18911,10 @@ func (m *PermissionMutation) DeleteTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleteTime returns the old "delete_time" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+func (m *TenantSettingMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
@@ -3532,479 +18929,878 @@ func (m *PermissionMutation) OldDeleteTime(ctx context.Context) (v *time.Time, e
 }
 
 // ClearDeleteTime clears the value of the "delete_time" field.
-func (m *PermissionMutation) ClearDeleteTime() {
+func (m *TenantSettingMutation) ClearDeleteTime() {
 	m.delete_time = nil
-	m.clearedFields[permission.FieldDeleteTime] = struct{}{}
+	m.clearedFields[tenantsetting.FieldDeleteTime] = struct{}{}
 }
 
 // DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *PermissionMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[permission.FieldDeleteTime]
+func (m *TenantSettingMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldDeleteTime]
 	return ok
 }
 
 // ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *PermissionMutation) ResetDeleteTime() {
+func (m *TenantSettingMutation) ResetDeleteTime() {
 	m.delete_time = nil
-	delete(m.clearedFields, permission.FieldDeleteTime)
+	delete(m.clearedFields, tenantsetting.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *TenantSettingMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TenantSettingMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *TenantSettingMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *TenantSettingMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *TenantSettingMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[tenantsetting.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *TenantSettingMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TenantSettingMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, tenantsetting.FieldTenantID)
+}
+
+// SetAuditRetentionDays sets the "audit_retention_days" field.
+func (m *TenantSettingMutation) SetAuditRetentionDays(i int32) {
+	m.audit_retention_days = &i
+	m.addaudit_retention_days = nil
+}
+
+// AuditRetentionDays returns the value of the "audit_retention_days" field in the mutation.
+func (m *TenantSettingMutation) AuditRetentionDays() (r int32, exists bool) {
+	v := m.audit_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuditRetentionDays returns the old "audit_retention_days" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldAuditRetentionDays(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuditRetentionDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuditRetentionDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuditRetentionDays: %w", err)
+	}
+	return oldValue.AuditRetentionDays, nil
+}
+
+// AddAuditRetentionDays adds i to the "audit_retention_days" field.
+func (m *TenantSettingMutation) AddAuditRetentionDays(i int32) {
+	if m.addaudit_retention_days != nil {
+		*m.addaudit_retention_days += i
+	} else {
+		m.addaudit_retention_days = &i
+	}
+}
+
+// AddedAuditRetentionDays returns the value that was added to the "audit_retention_days" field in this mutation.
+func (m *TenantSettingMutation) AddedAuditRetentionDays() (r int32, exists bool) {
+	v := m.addaudit_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAuditRetentionDays resets all changes to the "audit_retention_days" field.
+func (m *TenantSettingMutation) ResetAuditRetentionDays() {
+	m.audit_retention_days = nil
+	m.addaudit_retention_days = nil
+}
+
+// SetExportExcludedTags sets the "export_excluded_tags" field.
+func (m *TenantSettingMutation) SetExportExcludedTags(s []string) {
+	m.export_excluded_tags = &s
+	m.appendexport_excluded_tags = nil
+}
+
+// ExportExcludedTags returns the value of the "export_excluded_tags" field in the mutation.
+func (m *TenantSettingMutation) ExportExcludedTags() (r []string, exists bool) {
+	v := m.export_excluded_tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExportExcludedTags returns the old "export_excluded_tags" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldExportExcludedTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExportExcludedTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExportExcludedTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExportExcludedTags: %w", err)
+	}
+	return oldValue.ExportExcludedTags, nil
+}
+
+// AppendExportExcludedTags adds s to the "export_excluded_tags" field.
+func (m *TenantSettingMutation) AppendExportExcludedTags(s []string) {
+	m.appendexport_excluded_tags = append(m.appendexport_excluded_tags, s...)
+}
+
+// AppendedExportExcludedTags returns the list of values that were appended to the "export_excluded_tags" field in this mutation.
+func (m *TenantSettingMutation) AppendedExportExcludedTags() ([]string, bool) {
+	if len(m.appendexport_excluded_tags) == 0 {
+		return nil, false
+	}
+	return m.appendexport_excluded_tags, true
+}
+
+// ClearExportExcludedTags clears the value of the "export_excluded_tags" field.
+func (m *TenantSettingMutation) ClearExportExcludedTags() {
+	m.export_excluded_tags = nil
+	m.appendexport_excluded_tags = nil
+	m.clearedFields[tenantsetting.FieldExportExcludedTags] = struct{}{}
+}
+
+// ExportExcludedTagsCleared returns if the "export_excluded_tags" field was cleared in this mutation.
+func (m *TenantSettingMutation) ExportExcludedTagsCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldExportExcludedTags]
+	return ok
+}
+
+// ResetExportExcludedTags resets all changes to the "export_excluded_tags" field.
+func (m *TenantSettingMutation) ResetExportExcludedTags() {
+	m.export_excluded_tags = nil
+	m.appendexport_excluded_tags = nil
+	delete(m.clearedFields, tenantsetting.FieldExportExcludedTags)
+}
+
+// SetExportExcludedFolderIds sets the "export_excluded_folder_ids" field.
+func (m *TenantSettingMutation) SetExportExcludedFolderIds(s []string) {
+	m.export_excluded_folder_ids = &s
+	m.appendexport_excluded_folder_ids = nil
+}
+
+// ExportExcludedFolderIds returns the value of the "export_excluded_folder_ids" field in the mutation.
+func (m *TenantSettingMutation) ExportExcludedFolderIds() (r []string, exists bool) {
+	v := m.export_excluded_folder_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExportExcludedFolderIds returns the old "export_excluded_folder_ids" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldExportExcludedFolderIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExportExcludedFolderIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExportExcludedFolderIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExportExcludedFolderIds: %w", err)
+	}
+	return oldValue.ExportExcludedFolderIds, nil
+}
+
+// AppendExportExcludedFolderIds adds s to the "export_excluded_folder_ids" field.
+func (m *TenantSettingMutation) AppendExportExcludedFolderIds(s []string) {
+	m.appendexport_excluded_folder_ids = append(m.appendexport_excluded_folder_ids, s...)
+}
+
+// AppendedExportExcludedFolderIds returns the list of values that were appended to the "export_excluded_folder_ids" field in this mutation.
+func (m *TenantSettingMutation) AppendedExportExcludedFolderIds() ([]string, bool) {
+	if len(m.appendexport_excluded_folder_ids) == 0 {
+		return nil, false
+	}
+	return m.appendexport_excluded_folder_ids, true
+}
+
+// ClearExportExcludedFolderIds clears the value of the "export_excluded_folder_ids" field.
+func (m *TenantSettingMutation) ClearExportExcludedFolderIds() {
+	m.export_excluded_folder_ids = nil
+	m.appendexport_excluded_folder_ids = nil
+	m.clearedFields[tenantsetting.FieldExportExcludedFolderIds] = struct{}{}
+}
+
+// ExportExcludedFolderIdsCleared returns if the "export_excluded_folder_ids" field was cleared in this mutation.
+func (m *TenantSettingMutation) ExportExcludedFolderIdsCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldExportExcludedFolderIds]
+	return ok
+}
+
+// ResetExportExcludedFolderIds resets all changes to the "export_excluded_folder_ids" field.
+func (m *TenantSettingMutation) ResetExportExcludedFolderIds() {
+	m.export_excluded_folder_ids = nil
+	m.appendexport_excluded_folder_ids = nil
+	delete(m.clearedFields, tenantsetting.FieldExportExcludedFolderIds)
+}
+
+// SetPasswordPolicyEnabled sets the "password_policy_enabled" field.
+func (m *TenantSettingMutation) SetPasswordPolicyEnabled(b bool) {
+	m.password_policy_enabled = &b
+}
+
+// PasswordPolicyEnabled returns the value of the "password_policy_enabled" field in the mutation.
+func (m *TenantSettingMutation) PasswordPolicyEnabled() (r bool, exists bool) {
+	v := m.password_policy_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordPolicyEnabled returns the old "password_policy_enabled" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldPasswordPolicyEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordPolicyEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordPolicyEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordPolicyEnabled: %w", err)
+	}
+	return oldValue.PasswordPolicyEnabled, nil
+}
+
+// ResetPasswordPolicyEnabled resets all changes to the "password_policy_enabled" field.
+func (m *TenantSettingMutation) ResetPasswordPolicyEnabled() {
+	m.password_policy_enabled = nil
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *PermissionMutation) SetTenantID(u uint32) {
-	m.tenant_id = &u
-	m.addtenant_id = nil
+// SetPasswordMinLength sets the "password_min_length" field.
+func (m *TenantSettingMutation) SetPasswordMinLength(i int32) {
+	m.password_min_length = &i
+	m.addpassword_min_length = nil
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *PermissionMutation) TenantID() (r uint32, exists bool) {
-	v := m.tenant_id
+// PasswordMinLength returns the value of the "password_min_length" field in the mutation.
+func (m *TenantSettingMutation) PasswordMinLength() (r int32, exists bool) {
+	v := m.password_min_length
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordMinLength returns the old "password_min_length" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+func (m *TenantSettingMutation) OldPasswordMinLength(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordMinLength is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+		return v, errors.New("OldPasswordMinLength requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordMinLength: %w", err)
 	}
-	return oldValue.TenantID, nil
+	return oldValue.PasswordMinLength, nil
 }
 
-// AddTenantID adds u to the "tenant_id" field.
-func (m *PermissionMutation) AddTenantID(u int32) {
-	if m.addtenant_id != nil {
-		*m.addtenant_id += u
+// AddPasswordMinLength adds i to the "password_min_length" field.
+func (m *TenantSettingMutation) AddPasswordMinLength(i int32) {
+	if m.addpassword_min_length != nil {
+		*m.addpassword_min_length += i
 	} else {
-		m.addtenant_id = &u
+		m.addpassword_min_length = &i
 	}
 }
 
-// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
-func (m *PermissionMutation) AddedTenantID() (r int32, exists bool) {
-	v := m.addtenant_id
+// AddedPasswordMinLength returns the value that was added to the "password_min_length" field in this mutation.
+func (m *TenantSettingMutation) AddedPasswordMinLength() (r int32, exists bool) {
+	v := m.addpassword_min_length
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearTenantID clears the value of the "tenant_id" field.
-func (m *PermissionMutation) ClearTenantID() {
-	m.tenant_id = nil
-	m.addtenant_id = nil
-	m.clearedFields[permission.FieldTenantID] = struct{}{}
-}
-
-// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
-func (m *PermissionMutation) TenantIDCleared() bool {
-	_, ok := m.clearedFields[permission.FieldTenantID]
-	return ok
-}
-
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *PermissionMutation) ResetTenantID() {
-	m.tenant_id = nil
-	m.addtenant_id = nil
-	delete(m.clearedFields, permission.FieldTenantID)
+// ResetPasswordMinLength resets all changes to the "password_min_length" field.
+func (m *TenantSettingMutation) ResetPasswordMinLength() {
+	m.password_min_length = nil
+	m.addpassword_min_length = nil
 }
 
-// SetResourceType sets the "resource_type" field.
-func (m *PermissionMutation) SetResourceType(pt permission.ResourceType) {
-	m.resource_type = &pt
+// SetPasswordRequireLowercase sets the "password_require_lowercase" field.
+func (m *TenantSettingMutation) SetPasswordRequireLowercase(b bool) {
+	m.password_require_lowercase = &b
 }
 
-// ResourceType returns the value of the "resource_type" field in the mutation.
-func (m *PermissionMutation) ResourceType() (r permission.ResourceType, exists bool) {
-	v := m.resource_type
+// PasswordRequireLowercase returns the value of the "password_require_lowercase" field in the mutation.
+func (m *TenantSettingMutation) PasswordRequireLowercase() (r bool, exists bool) {
+	v := m.password_require_lowercase
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceType returns the old "resource_type" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordRequireLowercase returns the old "password_require_lowercase" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldResourceType(ctx context.Context) (v permission.ResourceType, err error) {
+func (m *TenantSettingMutation) OldPasswordRequireLowercase(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordRequireLowercase is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceType requires an ID field in the mutation")
+		return v, errors.New("OldPasswordRequireLowercase requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordRequireLowercase: %w", err)
 	}
-	return oldValue.ResourceType, nil
+	return oldValue.PasswordRequireLowercase, nil
 }
 
-// ResetResourceType resets all changes to the "resource_type" field.
-func (m *PermissionMutation) ResetResourceType() {
-	m.resource_type = nil
+// ResetPasswordRequireLowercase resets all changes to the "password_require_lowercase" field.
+func (m *TenantSettingMutation) ResetPasswordRequireLowercase() {
+	m.password_require_lowercase = nil
 }
 
-// SetResourceID sets the "resource_id" field.
-func (m *PermissionMutation) SetResourceID(s string) {
-	m.resource_id = &s
+// SetPasswordRequireUppercase sets the "password_require_uppercase" field.
+func (m *TenantSettingMutation) SetPasswordRequireUppercase(b bool) {
+	m.password_require_uppercase = &b
 }
 
-// ResourceID returns the value of the "resource_id" field in the mutation.
-func (m *PermissionMutation) ResourceID() (r string, exists bool) {
-	v := m.resource_id
+// PasswordRequireUppercase returns the value of the "password_require_uppercase" field in the mutation.
+func (m *TenantSettingMutation) PasswordRequireUppercase() (r bool, exists bool) {
+	v := m.password_require_uppercase
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceID returns the old "resource_id" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordRequireUppercase returns the old "password_require_uppercase" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldResourceID(ctx context.Context) (v string, err error) {
+func (m *TenantSettingMutation) OldPasswordRequireUppercase(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordRequireUppercase is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceID requires an ID field in the mutation")
+		return v, errors.New("OldPasswordRequireUppercase requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordRequireUppercase: %w", err)
 	}
-	return oldValue.ResourceID, nil
+	return oldValue.PasswordRequireUppercase, nil
 }
 
-// ResetResourceID resets all changes to the "resource_id" field.
-func (m *PermissionMutation) ResetResourceID() {
-	m.resource_id = nil
+// ResetPasswordRequireUppercase resets all changes to the "password_require_uppercase" field.
+func (m *TenantSettingMutation) ResetPasswordRequireUppercase() {
+	m.password_require_uppercase = nil
 }
 
-// SetRelation sets the "relation" field.
-func (m *PermissionMutation) SetRelation(pe permission.Relation) {
-	m.relation = &pe
+// SetPasswordRequireDigit sets the "password_require_digit" field.
+func (m *TenantSettingMutation) SetPasswordRequireDigit(b bool) {
+	m.password_require_digit = &b
 }
 
-// Relation returns the value of the "relation" field in the mutation.
-func (m *PermissionMutation) Relation() (r permission.Relation, exists bool) {
-	v := m.relation
+// PasswordRequireDigit returns the value of the "password_require_digit" field in the mutation.
+func (m *TenantSettingMutation) PasswordRequireDigit() (r bool, exists bool) {
+	v := m.password_require_digit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRelation returns the old "relation" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordRequireDigit returns the old "password_require_digit" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldRelation(ctx context.Context) (v permission.Relation, err error) {
+func (m *TenantSettingMutation) OldPasswordRequireDigit(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRelation is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordRequireDigit is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRelation requires an ID field in the mutation")
+		return v, errors.New("OldPasswordRequireDigit requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRelation: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordRequireDigit: %w", err)
 	}
-	return oldValue.Relation, nil
+	return oldValue.PasswordRequireDigit, nil
 }
 
-// ResetRelation resets all changes to the "relation" field.
-func (m *PermissionMutation) ResetRelation() {
-	m.relation = nil
+// ResetPasswordRequireDigit resets all changes to the "password_require_digit" field.
+func (m *TenantSettingMutation) ResetPasswordRequireDigit() {
+	m.password_require_digit = nil
 }
 
-// SetSubjectType sets the "subject_type" field.
-func (m *PermissionMutation) SetSubjectType(pt permission.SubjectType) {
-	m.subject_type = &pt
+// SetPasswordRequireSymbol sets the "password_require_symbol" field.
+func (m *TenantSettingMutation) SetPasswordRequireSymbol(b bool) {
+	m.password_require_symbol = &b
 }
 
-// SubjectType returns the value of the "subject_type" field in the mutation.
-func (m *PermissionMutation) SubjectType() (r permission.SubjectType, exists bool) {
-	v := m.subject_type
+// PasswordRequireSymbol returns the value of the "password_require_symbol" field in the mutation.
+func (m *TenantSettingMutation) PasswordRequireSymbol() (r bool, exists bool) {
+	v := m.password_require_symbol
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubjectType returns the old "subject_type" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordRequireSymbol returns the old "password_require_symbol" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldSubjectType(ctx context.Context) (v permission.SubjectType, err error) {
+func (m *TenantSettingMutation) OldPasswordRequireSymbol(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubjectType is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordRequireSymbol is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubjectType requires an ID field in the mutation")
+		return v, errors.New("OldPasswordRequireSymbol requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubjectType: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordRequireSymbol: %w", err)
 	}
-	return oldValue.SubjectType, nil
+	return oldValue.PasswordRequireSymbol, nil
 }
 
-// ResetSubjectType resets all changes to the "subject_type" field.
-func (m *PermissionMutation) ResetSubjectType() {
-	m.subject_type = nil
+// ResetPasswordRequireSymbol resets all changes to the "password_require_symbol" field.
+func (m *TenantSettingMutation) ResetPasswordRequireSymbol() {
+	m.password_require_symbol = nil
 }
 
-// SetSubjectID sets the "subject_id" field.
-func (m *PermissionMutation) SetSubjectID(s string) {
-	m.subject_id = &s
+// SetPasswordBannedWords sets the "password_banned_words" field.
+func (m *TenantSettingMutation) SetPasswordBannedWords(s []string) {
+	m.password_banned_words = &s
+	m.appendpassword_banned_words = nil
 }
 
-// SubjectID returns the value of the "subject_id" field in the mutation.
-func (m *PermissionMutation) SubjectID() (r string, exists bool) {
-	v := m.subject_id
+// PasswordBannedWords returns the value of the "password_banned_words" field in the mutation.
+func (m *TenantSettingMutation) PasswordBannedWords() (r []string, exists bool) {
+	v := m.password_banned_words
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubjectID returns the old "subject_id" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordBannedWords returns the old "password_banned_words" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldSubjectID(ctx context.Context) (v string, err error) {
+func (m *TenantSettingMutation) OldPasswordBannedWords(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubjectID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordBannedWords is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubjectID requires an ID field in the mutation")
+		return v, errors.New("OldPasswordBannedWords requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubjectID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordBannedWords: %w", err)
 	}
-	return oldValue.SubjectID, nil
+	return oldValue.PasswordBannedWords, nil
 }
 
-// ResetSubjectID resets all changes to the "subject_id" field.
-func (m *PermissionMutation) ResetSubjectID() {
-	m.subject_id = nil
+// AppendPasswordBannedWords adds s to the "password_banned_words" field.
+func (m *TenantSettingMutation) AppendPasswordBannedWords(s []string) {
+	m.appendpassword_banned_words = append(m.appendpassword_banned_words, s...)
 }
 
-// SetGrantedBy sets the "granted_by" field.
-func (m *PermissionMutation) SetGrantedBy(u uint32) {
-	m.granted_by = &u
-	m.addgranted_by = nil
+// AppendedPasswordBannedWords returns the list of values that were appended to the "password_banned_words" field in this mutation.
+func (m *TenantSettingMutation) AppendedPasswordBannedWords() ([]string, bool) {
+	if len(m.appendpassword_banned_words) == 0 {
+		return nil, false
+	}
+	return m.appendpassword_banned_words, true
 }
 
-// GrantedBy returns the value of the "granted_by" field in the mutation.
-func (m *PermissionMutation) GrantedBy() (r uint32, exists bool) {
-	v := m.granted_by
+// ClearPasswordBannedWords clears the value of the "password_banned_words" field.
+func (m *TenantSettingMutation) ClearPasswordBannedWords() {
+	m.password_banned_words = nil
+	m.appendpassword_banned_words = nil
+	m.clearedFields[tenantsetting.FieldPasswordBannedWords] = struct{}{}
+}
+
+// PasswordBannedWordsCleared returns if the "password_banned_words" field was cleared in this mutation.
+func (m *TenantSettingMutation) PasswordBannedWordsCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldPasswordBannedWords]
+	return ok
+}
+
+// ResetPasswordBannedWords resets all changes to the "password_banned_words" field.
+func (m *TenantSettingMutation) ResetPasswordBannedWords() {
+	m.password_banned_words = nil
+	m.appendpassword_banned_words = nil
+	delete(m.clearedFields, tenantsetting.FieldPasswordBannedWords)
+}
+
+// SetPasswordMaxAgeDays sets the "password_max_age_days" field.
+func (m *TenantSettingMutation) SetPasswordMaxAgeDays(i int32) {
+	m.password_max_age_days = &i
+	m.addpassword_max_age_days = nil
+}
+
+// PasswordMaxAgeDays returns the value of the "password_max_age_days" field in the mutation.
+func (m *TenantSettingMutation) PasswordMaxAgeDays() (r int32, exists bool) {
+	v := m.password_max_age_days
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGrantedBy returns the old "granted_by" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordMaxAgeDays returns the old "password_max_age_days" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldGrantedBy(ctx context.Context) (v *uint32, err error) {
+func (m *TenantSettingMutation) OldPasswordMaxAgeDays(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGrantedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordMaxAgeDays is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGrantedBy requires an ID field in the mutation")
+		return v, errors.New("OldPasswordMaxAgeDays requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGrantedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordMaxAgeDays: %w", err)
 	}
-	return oldValue.GrantedBy, nil
+	return oldValue.PasswordMaxAgeDays, nil
 }
 
-// AddGrantedBy adds u to the "granted_by" field.
-func (m *PermissionMutation) AddGrantedBy(u int32) {
-	if m.addgranted_by != nil {
-		*m.addgranted_by += u
+// AddPasswordMaxAgeDays adds i to the "password_max_age_days" field.
+func (m *TenantSettingMutation) AddPasswordMaxAgeDays(i int32) {
+	if m.addpassword_max_age_days != nil {
+		*m.addpassword_max_age_days += i
 	} else {
-		m.addgranted_by = &u
+		m.addpassword_max_age_days = &i
 	}
 }
 
-// AddedGrantedBy returns the value that was added to the "granted_by" field in this mutation.
-func (m *PermissionMutation) AddedGrantedBy() (r int32, exists bool) {
-	v := m.addgranted_by
+// AddedPasswordMaxAgeDays returns the value that was added to the "password_max_age_days" field in this mutation.
+func (m *TenantSettingMutation) AddedPasswordMaxAgeDays() (r int32, exists bool) {
+	v := m.addpassword_max_age_days
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearGrantedBy clears the value of the "granted_by" field.
-func (m *PermissionMutation) ClearGrantedBy() {
-	m.granted_by = nil
-	m.addgranted_by = nil
-	m.clearedFields[permission.FieldGrantedBy] = struct{}{}
+// ResetPasswordMaxAgeDays resets all changes to the "password_max_age_days" field.
+func (m *TenantSettingMutation) ResetPasswordMaxAgeDays() {
+	m.password_max_age_days = nil
+	m.addpassword_max_age_days = nil
 }
 
-// GrantedByCleared returns if the "granted_by" field was cleared in this mutation.
-func (m *PermissionMutation) GrantedByCleared() bool {
-	_, ok := m.clearedFields[permission.FieldGrantedBy]
-	return ok
+// SetPasswordHistorySize sets the "password_history_size" field.
+func (m *TenantSettingMutation) SetPasswordHistorySize(i int32) {
+	m.password_history_size = &i
+	m.addpassword_history_size = nil
 }
 
-// ResetGrantedBy resets all changes to the "granted_by" field.
-func (m *PermissionMutation) ResetGrantedBy() {
-	m.granted_by = nil
-	m.addgranted_by = nil
-	delete(m.clearedFields, permission.FieldGrantedBy)
+// PasswordHistorySize returns the value of the "password_history_size" field in the mutation.
+func (m *TenantSettingMutation) PasswordHistorySize() (r int32, exists bool) {
+	v := m.password_history_size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *PermissionMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// OldPasswordHistorySize returns the old "password_history_size" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldPasswordHistorySize(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHistorySize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHistorySize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHistorySize: %w", err)
+	}
+	return oldValue.PasswordHistorySize, nil
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *PermissionMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// AddPasswordHistorySize adds i to the "password_history_size" field.
+func (m *TenantSettingMutation) AddPasswordHistorySize(i int32) {
+	if m.addpassword_history_size != nil {
+		*m.addpassword_history_size += i
+	} else {
+		m.addpassword_history_size = &i
+	}
+}
+
+// AddedPasswordHistorySize returns the value that was added to the "password_history_size" field in this mutation.
+func (m *TenantSettingMutation) AddedPasswordHistorySize() (r int32, exists bool) {
+	v := m.addpassword_history_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// ResetPasswordHistorySize resets all changes to the "password_history_size" field.
+func (m *TenantSettingMutation) ResetPasswordHistorySize() {
+	m.password_history_size = nil
+	m.addpassword_history_size = nil
+}
+
+// SetGeoAllowedCountries sets the "geo_allowed_countries" field.
+func (m *TenantSettingMutation) SetGeoAllowedCountries(s []string) {
+	m.geo_allowed_countries = &s
+	m.appendgeo_allowed_countries = nil
+}
+
+// GeoAllowedCountries returns the value of the "geo_allowed_countries" field in the mutation.
+func (m *TenantSettingMutation) GeoAllowedCountries() (r []string, exists bool) {
+	v := m.geo_allowed_countries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeoAllowedCountries returns the old "geo_allowed_countries" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+func (m *TenantSettingMutation) OldGeoAllowedCountries(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldGeoAllowedCountries is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldGeoAllowedCountries requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldGeoAllowedCountries: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.GeoAllowedCountries, nil
 }
 
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (m *PermissionMutation) ClearExpiresAt() {
-	m.expires_at = nil
-	m.clearedFields[permission.FieldExpiresAt] = struct{}{}
+// AppendGeoAllowedCountries adds s to the "geo_allowed_countries" field.
+func (m *TenantSettingMutation) AppendGeoAllowedCountries(s []string) {
+	m.appendgeo_allowed_countries = append(m.appendgeo_allowed_countries, s...)
 }
 
-// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
-func (m *PermissionMutation) ExpiresAtCleared() bool {
-	_, ok := m.clearedFields[permission.FieldExpiresAt]
-	return ok
+// AppendedGeoAllowedCountries returns the list of values that were appended to the "geo_allowed_countries" field in this mutation.
+func (m *TenantSettingMutation) AppendedGeoAllowedCountries() ([]string, bool) {
+	if len(m.appendgeo_allowed_countries) == 0 {
+		return nil, false
+	}
+	return m.appendgeo_allowed_countries, true
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *PermissionMutation) ResetExpiresAt() {
-	m.expires_at = nil
-	delete(m.clearedFields, permission.FieldExpiresAt)
+// ClearGeoAllowedCountries clears the value of the "geo_allowed_countries" field.
+func (m *TenantSettingMutation) ClearGeoAllowedCountries() {
+	m.geo_allowed_countries = nil
+	m.appendgeo_allowed_countries = nil
+	m.clearedFields[tenantsetting.FieldGeoAllowedCountries] = struct{}{}
 }
 
-// SetFolderID sets the "folder" edge to the Folder entity by id.
-func (m *PermissionMutation) SetFolderID(id string) {
-	m.folder = &id
+// GeoAllowedCountriesCleared returns if the "geo_allowed_countries" field was cleared in this mutation.
+func (m *TenantSettingMutation) GeoAllowedCountriesCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldGeoAllowedCountries]
+	return ok
 }
 
-// ClearFolder clears the "folder" edge to the Folder entity.
-func (m *PermissionMutation) ClearFolder() {
-	m.clearedfolder = true
+// ResetGeoAllowedCountries resets all changes to the "geo_allowed_countries" field.
+func (m *TenantSettingMutation) ResetGeoAllowedCountries() {
+	m.geo_allowed_countries = nil
+	m.appendgeo_allowed_countries = nil
+	delete(m.clearedFields, tenantsetting.FieldGeoAllowedCountries)
 }
 
-// FolderCleared reports if the "folder" edge to the Folder entity was cleared.
-func (m *PermissionMutation) FolderCleared() bool {
-	return m.clearedfolder
+// SetVersionMaxCount sets the "version_max_count" field.
+func (m *TenantSettingMutation) SetVersionMaxCount(i int32) {
+	m.version_max_count = &i
+	m.addversion_max_count = nil
 }
 
-// FolderID returns the "folder" edge ID in the mutation.
-func (m *PermissionMutation) FolderID() (id string, exists bool) {
-	if m.folder != nil {
-		return *m.folder, true
+// VersionMaxCount returns the value of the "version_max_count" field in the mutation.
+func (m *TenantSettingMutation) VersionMaxCount() (r int32, exists bool) {
+	v := m.version_max_count
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// FolderIDs returns the "folder" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FolderID instead. It exists only for internal usage by the builders.
-func (m *PermissionMutation) FolderIDs() (ids []string) {
-	if id := m.folder; id != nil {
-		ids = append(ids, *id)
+// OldVersionMaxCount returns the old "version_max_count" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldVersionMaxCount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionMaxCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionMaxCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionMaxCount: %w", err)
+	}
+	return oldValue.VersionMaxCount, nil
+}
+
+// AddVersionMaxCount adds i to the "version_max_count" field.
+func (m *TenantSettingMutation) AddVersionMaxCount(i int32) {
+	if m.addversion_max_count != nil {
+		*m.addversion_max_count += i
+	} else {
+		m.addversion_max_count = &i
+	}
+}
+
+// AddedVersionMaxCount returns the value that was added to the "version_max_count" field in this mutation.
+func (m *TenantSettingMutation) AddedVersionMaxCount() (r int32, exists bool) {
+	v := m.addversion_max_count
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetFolder resets all changes to the "folder" edge.
-func (m *PermissionMutation) ResetFolder() {
-	m.folder = nil
-	m.clearedfolder = false
+// ResetVersionMaxCount resets all changes to the "version_max_count" field.
+func (m *TenantSettingMutation) ResetVersionMaxCount() {
+	m.version_max_count = nil
+	m.addversion_max_count = nil
 }
 
-// SetSecretID sets the "secret" edge to the Secret entity by id.
-func (m *PermissionMutation) SetSecretID(id string) {
-	m.secret = &id
+// SetVersionDeleteAfterDays sets the "version_delete_after_days" field.
+func (m *TenantSettingMutation) SetVersionDeleteAfterDays(i int32) {
+	m.version_delete_after_days = &i
+	m.addversion_delete_after_days = nil
 }
 
-// ClearSecret clears the "secret" edge to the Secret entity.
-func (m *PermissionMutation) ClearSecret() {
-	m.clearedsecret = true
+// VersionDeleteAfterDays returns the value of the "version_delete_after_days" field in the mutation.
+func (m *TenantSettingMutation) VersionDeleteAfterDays() (r int32, exists bool) {
+	v := m.version_delete_after_days
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SecretCleared reports if the "secret" edge to the Secret entity was cleared.
-func (m *PermissionMutation) SecretCleared() bool {
-	return m.clearedsecret
+// OldVersionDeleteAfterDays returns the old "version_delete_after_days" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldVersionDeleteAfterDays(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionDeleteAfterDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionDeleteAfterDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionDeleteAfterDays: %w", err)
+	}
+	return oldValue.VersionDeleteAfterDays, nil
 }
 
-// SecretID returns the "secret" edge ID in the mutation.
-func (m *PermissionMutation) SecretID() (id string, exists bool) {
-	if m.secret != nil {
-		return *m.secret, true
+// AddVersionDeleteAfterDays adds i to the "version_delete_after_days" field.
+func (m *TenantSettingMutation) AddVersionDeleteAfterDays(i int32) {
+	if m.addversion_delete_after_days != nil {
+		*m.addversion_delete_after_days += i
+	} else {
+		m.addversion_delete_after_days = &i
 	}
-	return
 }
 
-// SecretIDs returns the "secret" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SecretID instead. It exists only for internal usage by the builders.
-func (m *PermissionMutation) SecretIDs() (ids []string) {
-	if id := m.secret; id != nil {
-		ids = append(ids, *id)
+// AddedVersionDeleteAfterDays returns the value that was added to the "version_delete_after_days" field in this mutation.
+func (m *TenantSettingMutation) AddedVersionDeleteAfterDays() (r int32, exists bool) {
+	v := m.addversion_delete_after_days
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetSecret resets all changes to the "secret" edge.
-func (m *PermissionMutation) ResetSecret() {
-	m.secret = nil
-	m.clearedsecret = false
+// ResetVersionDeleteAfterDays resets all changes to the "version_delete_after_days" field.
+func (m *TenantSettingMutation) ResetVersionDeleteAfterDays() {
+	m.version_delete_after_days = nil
+	m.addversion_delete_after_days = nil
 }
 
-// Where appends a list predicates to the PermissionMutation builder.
-func (m *PermissionMutation) Where(ps ...predicate.Permission) {
+// Where appends a list predicates to the TenantSettingMutation builder.
+func (m *TenantSettingMutation) Where(ps ...predicate.TenantSetting) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PermissionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TenantSettingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Permission, len(ps))
+func (m *TenantSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TenantSetting, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4012,57 +19808,84 @@ func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PermissionMutation) Op() Op {
+func (m *TenantSettingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PermissionMutation) SetOp(op Op) {
+func (m *TenantSettingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Permission).
-func (m *PermissionMutation) Type() string {
+// Type returns the node type of this mutation (TenantSetting).
+func (m *TenantSettingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PermissionMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *TenantSettingMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.update_by != nil {
+		fields = append(fields, tenantsetting.FieldUpdateBy)
+	}
 	if m.create_time != nil {
-		fields = append(fields, permission.FieldCreateTime)
+		fields = append(fields, tenantsetting.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, permission.FieldUpdateTime)
+		fields = append(fields, tenantsetting.FieldUpdateTime)
 	}
 	if m.delete_time != nil {
-		fields = append(fields, permission.FieldDeleteTime)
+		fields = append(fields, tenantsetting.FieldDeleteTime)
 	}
 	if m.tenant_id != nil {
-		fields = append(fields, permission.FieldTenantID)
+		fields = append(fields, tenantsetting.FieldTenantID)
 	}
-	if m.resource_type != nil {
-		fields = append(fields, permission.FieldResourceType)
+	if m.audit_retention_days != nil {
+		fields = append(fields, tenantsetting.FieldAuditRetentionDays)
 	}
-	if m.resource_id != nil {
-		fields = append(fields, permission.FieldResourceID)
+	if m.export_excluded_tags != nil {
+		fields = append(fields, tenantsetting.FieldExportExcludedTags)
 	}
-	if m.relation != nil {
-		fields = append(fields, permission.FieldRelation)
+	if m.export_excluded_folder_ids != nil {
+		fields = append(fields, tenantsetting.FieldExportExcludedFolderIds)
 	}
-	if m.subject_type != nil {
-		fields = append(fields, permission.FieldSubjectType)
+	if m.password_policy_enabled != nil {
+		fields = append(fields, tenantsetting.FieldPasswordPolicyEnabled)
 	}
-	if m.subject_id != nil {
-		fields = append(fields, permission.FieldSubjectID)
+	if m.password_min_length != nil {
+		fields = append(fields, tenantsetting.FieldPasswordMinLength)
 	}
-	if m.granted_by != nil {
-		fields = append(fields, permission.FieldGrantedBy)
+	if m.password_require_lowercase != nil {
+		fields = append(fields, tenantsetting.FieldPasswordRequireLowercase)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, permission.FieldExpiresAt)
+	if m.password_require_uppercase != nil {
+		fields = append(fields, tenantsetting.FieldPasswordRequireUppercase)
+	}
+	if m.password_require_digit != nil {
+		fields = append(fields, tenantsetting.FieldPasswordRequireDigit)
+	}
+	if m.password_require_symbol != nil {
+		fields = append(fields, tenantsetting.FieldPasswordRequireSymbol)
+	}
+	if m.password_banned_words != nil {
+		fields = append(fields, tenantsetting.FieldPasswordBannedWords)
+	}
+	if m.password_max_age_days != nil {
+		fields = append(fields, tenantsetting.FieldPasswordMaxAgeDays)
+	}
+	if m.password_history_size != nil {
+		fields = append(fields, tenantsetting.FieldPasswordHistorySize)
+	}
+	if m.geo_allowed_countries != nil {
+		fields = append(fields, tenantsetting.FieldGeoAllowedCountries)
+	}
+	if m.version_max_count != nil {
+		fields = append(fields, tenantsetting.FieldVersionMaxCount)
+	}
+	if m.version_delete_after_days != nil {
+		fields = append(fields, tenantsetting.FieldVersionDeleteAfterDays)
 	}
 	return fields
 }
@@ -4070,30 +19893,48 @@ func (m *PermissionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
+func (m *TenantSettingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case permission.FieldCreateTime:
+	case tenantsetting.FieldUpdateBy:
+		return m.UpdateBy()
+	case tenantsetting.FieldCreateTime:
 		return m.CreateTime()
-	case permission.FieldUpdateTime:
+	case tenantsetting.FieldUpdateTime:
 		return m.UpdateTime()
-	case permission.FieldDeleteTime:
+	case tenantsetting.FieldDeleteTime:
 		return m.DeleteTime()
-	case permission.FieldTenantID:
+	case tenantsetting.FieldTenantID:
 		return m.TenantID()
-	case permission.FieldResourceType:
-		return m.ResourceType()
-	case permission.FieldResourceID:
-		return m.ResourceID()
-	case permission.FieldRelation:
-		return m.Relation()
-	case permission.FieldSubjectType:
-		return m.SubjectType()
-	case permission.FieldSubjectID:
-		return m.SubjectID()
-	case permission.FieldGrantedBy:
-		return m.GrantedBy()
-	case permission.FieldExpiresAt:
-		return m.ExpiresAt()
+	case tenantsetting.FieldAuditRetentionDays:
+		return m.AuditRetentionDays()
+	case tenantsetting.FieldExportExcludedTags:
+		return m.ExportExcludedTags()
+	case tenantsetting.FieldExportExcludedFolderIds:
+		return m.ExportExcludedFolderIds()
+	case tenantsetting.FieldPasswordPolicyEnabled:
+		return m.PasswordPolicyEnabled()
+	case tenantsetting.FieldPasswordMinLength:
+		return m.PasswordMinLength()
+	case tenantsetting.FieldPasswordRequireLowercase:
+		return m.PasswordRequireLowercase()
+	case tenantsetting.FieldPasswordRequireUppercase:
+		return m.PasswordRequireUppercase()
+	case tenantsetting.FieldPasswordRequireDigit:
+		return m.PasswordRequireDigit()
+	case tenantsetting.FieldPasswordRequireSymbol:
+		return m.PasswordRequireSymbol()
+	case tenantsetting.FieldPasswordBannedWords:
+		return m.PasswordBannedWords()
+	case tenantsetting.FieldPasswordMaxAgeDays:
+		return m.PasswordMaxAgeDays()
+	case tenantsetting.FieldPasswordHistorySize:
+		return m.PasswordHistorySize()
+	case tenantsetting.FieldGeoAllowedCountries:
+		return m.GeoAllowedCountries()
+	case tenantsetting.FieldVersionMaxCount:
+		return m.VersionMaxCount()
+	case tenantsetting.FieldVersionDeleteAfterDays:
+		return m.VersionDeleteAfterDays()
 	}
 	return nil, false
 }
@@ -4101,1449 +19942,2215 @@ func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TenantSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case permission.FieldCreateTime:
+	case tenantsetting.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case tenantsetting.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case permission.FieldUpdateTime:
+	case tenantsetting.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case permission.FieldDeleteTime:
+	case tenantsetting.FieldDeleteTime:
 		return m.OldDeleteTime(ctx)
-	case permission.FieldTenantID:
+	case tenantsetting.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case permission.FieldResourceType:
-		return m.OldResourceType(ctx)
-	case permission.FieldResourceID:
-		return m.OldResourceID(ctx)
-	case permission.FieldRelation:
-		return m.OldRelation(ctx)
-	case permission.FieldSubjectType:
-		return m.OldSubjectType(ctx)
-	case permission.FieldSubjectID:
-		return m.OldSubjectID(ctx)
-	case permission.FieldGrantedBy:
-		return m.OldGrantedBy(ctx)
-	case permission.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Permission field %s", name)
+	case tenantsetting.FieldAuditRetentionDays:
+		return m.OldAuditRetentionDays(ctx)
+	case tenantsetting.FieldExportExcludedTags:
+		return m.OldExportExcludedTags(ctx)
+	case tenantsetting.FieldExportExcludedFolderIds:
+		return m.OldExportExcludedFolderIds(ctx)
+	case tenantsetting.FieldPasswordPolicyEnabled:
+		return m.OldPasswordPolicyEnabled(ctx)
+	case tenantsetting.FieldPasswordMinLength:
+		return m.OldPasswordMinLength(ctx)
+	case tenantsetting.FieldPasswordRequireLowercase:
+		return m.OldPasswordRequireLowercase(ctx)
+	case tenantsetting.FieldPasswordRequireUppercase:
+		return m.OldPasswordRequireUppercase(ctx)
+	case tenantsetting.FieldPasswordRequireDigit:
+		return m.OldPasswordRequireDigit(ctx)
+	case tenantsetting.FieldPasswordRequireSymbol:
+		return m.OldPasswordRequireSymbol(ctx)
+	case tenantsetting.FieldPasswordBannedWords:
+		return m.OldPasswordBannedWords(ctx)
+	case tenantsetting.FieldPasswordMaxAgeDays:
+		return m.OldPasswordMaxAgeDays(ctx)
+	case tenantsetting.FieldPasswordHistorySize:
+		return m.OldPasswordHistorySize(ctx)
+	case tenantsetting.FieldGeoAllowedCountries:
+		return m.OldGeoAllowedCountries(ctx)
+	case tenantsetting.FieldVersionMaxCount:
+		return m.OldVersionMaxCount(ctx)
+	case tenantsetting.FieldVersionDeleteAfterDays:
+		return m.OldVersionDeleteAfterDays(ctx)
+	}
+	return nil, fmt.Errorf("unknown TenantSetting field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PermissionMutation) SetField(name string, value ent.Value) error {
+func (m *TenantSettingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case permission.FieldCreateTime:
+	case tenantsetting.FieldUpdateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case tenantsetting.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case permission.FieldUpdateTime:
+	case tenantsetting.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case permission.FieldDeleteTime:
+	case tenantsetting.FieldDeleteTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeleteTime(v)
 		return nil
-	case permission.FieldTenantID:
+	case tenantsetting.FieldTenantID:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case permission.FieldResourceType:
-		v, ok := value.(permission.ResourceType)
+	case tenantsetting.FieldAuditRetentionDays:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResourceType(v)
+		m.SetAuditRetentionDays(v)
+		return nil
+	case tenantsetting.FieldExportExcludedTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExportExcludedTags(v)
+		return nil
+	case tenantsetting.FieldExportExcludedFolderIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExportExcludedFolderIds(v)
+		return nil
+	case tenantsetting.FieldPasswordPolicyEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordPolicyEnabled(v)
+		return nil
+	case tenantsetting.FieldPasswordMinLength:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordMinLength(v)
+		return nil
+	case tenantsetting.FieldPasswordRequireLowercase:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordRequireLowercase(v)
+		return nil
+	case tenantsetting.FieldPasswordRequireUppercase:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordRequireUppercase(v)
+		return nil
+	case tenantsetting.FieldPasswordRequireDigit:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordRequireDigit(v)
+		return nil
+	case tenantsetting.FieldPasswordRequireSymbol:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordRequireSymbol(v)
+		return nil
+	case tenantsetting.FieldPasswordBannedWords:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordBannedWords(v)
+		return nil
+	case tenantsetting.FieldPasswordMaxAgeDays:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordMaxAgeDays(v)
+		return nil
+	case tenantsetting.FieldPasswordHistorySize:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHistorySize(v)
+		return nil
+	case tenantsetting.FieldGeoAllowedCountries:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeoAllowedCountries(v)
+		return nil
+	case tenantsetting.FieldVersionMaxCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionMaxCount(v)
+		return nil
+	case tenantsetting.FieldVersionDeleteAfterDays:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionDeleteAfterDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TenantSetting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TenantSettingMutation) AddedFields() []string {
+	var fields []string
+	if m.addupdate_by != nil {
+		fields = append(fields, tenantsetting.FieldUpdateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, tenantsetting.FieldTenantID)
+	}
+	if m.addaudit_retention_days != nil {
+		fields = append(fields, tenantsetting.FieldAuditRetentionDays)
+	}
+	if m.addpassword_min_length != nil {
+		fields = append(fields, tenantsetting.FieldPasswordMinLength)
+	}
+	if m.addpassword_max_age_days != nil {
+		fields = append(fields, tenantsetting.FieldPasswordMaxAgeDays)
+	}
+	if m.addpassword_history_size != nil {
+		fields = append(fields, tenantsetting.FieldPasswordHistorySize)
+	}
+	if m.addversion_max_count != nil {
+		fields = append(fields, tenantsetting.FieldVersionMaxCount)
+	}
+	if m.addversion_delete_after_days != nil {
+		fields = append(fields, tenantsetting.FieldVersionDeleteAfterDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TenantSettingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tenantsetting.FieldUpdateBy:
+		return m.AddedUpdateBy()
+	case tenantsetting.FieldTenantID:
+		return m.AddedTenantID()
+	case tenantsetting.FieldAuditRetentionDays:
+		return m.AddedAuditRetentionDays()
+	case tenantsetting.FieldPasswordMinLength:
+		return m.AddedPasswordMinLength()
+	case tenantsetting.FieldPasswordMaxAgeDays:
+		return m.AddedPasswordMaxAgeDays()
+	case tenantsetting.FieldPasswordHistorySize:
+		return m.AddedPasswordHistorySize()
+	case tenantsetting.FieldVersionMaxCount:
+		return m.AddedVersionMaxCount()
+	case tenantsetting.FieldVersionDeleteAfterDays:
+		return m.AddedVersionDeleteAfterDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TenantSettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tenantsetting.FieldUpdateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdateBy(v)
+		return nil
+	case tenantsetting.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
 		return nil
-	case permission.FieldResourceID:
-		v, ok := value.(string)
+	case tenantsetting.FieldAuditRetentionDays:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResourceID(v)
+		m.AddAuditRetentionDays(v)
 		return nil
-	case permission.FieldRelation:
-		v, ok := value.(permission.Relation)
+	case tenantsetting.FieldPasswordMinLength:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRelation(v)
+		m.AddPasswordMinLength(v)
 		return nil
-	case permission.FieldSubjectType:
-		v, ok := value.(permission.SubjectType)
+	case tenantsetting.FieldPasswordMaxAgeDays:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubjectType(v)
+		m.AddPasswordMaxAgeDays(v)
 		return nil
-	case permission.FieldSubjectID:
-		v, ok := value.(string)
+	case tenantsetting.FieldPasswordHistorySize:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubjectID(v)
+		m.AddPasswordHistorySize(v)
 		return nil
-	case permission.FieldGrantedBy:
-		v, ok := value.(uint32)
+	case tenantsetting.FieldVersionMaxCount:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGrantedBy(v)
+		m.AddVersionMaxCount(v)
 		return nil
-	case permission.FieldExpiresAt:
-		v, ok := value.(time.Time)
+	case tenantsetting.FieldVersionDeleteAfterDays:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.AddVersionDeleteAfterDays(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Permission field %s", name)
+	return fmt.Errorf("unknown TenantSetting numeric field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PermissionMutation) AddedFields() []string {
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TenantSettingMutation) ClearedFields() []string {
 	var fields []string
-	if m.addtenant_id != nil {
-		fields = append(fields, permission.FieldTenantID)
+	if m.FieldCleared(tenantsetting.FieldUpdateBy) {
+		fields = append(fields, tenantsetting.FieldUpdateBy)
 	}
-	if m.addgranted_by != nil {
-		fields = append(fields, permission.FieldGrantedBy)
+	if m.FieldCleared(tenantsetting.FieldCreateTime) {
+		fields = append(fields, tenantsetting.FieldCreateTime)
+	}
+	if m.FieldCleared(tenantsetting.FieldUpdateTime) {
+		fields = append(fields, tenantsetting.FieldUpdateTime)
+	}
+	if m.FieldCleared(tenantsetting.FieldDeleteTime) {
+		fields = append(fields, tenantsetting.FieldDeleteTime)
+	}
+	if m.FieldCleared(tenantsetting.FieldTenantID) {
+		fields = append(fields, tenantsetting.FieldTenantID)
+	}
+	if m.FieldCleared(tenantsetting.FieldExportExcludedTags) {
+		fields = append(fields, tenantsetting.FieldExportExcludedTags)
+	}
+	if m.FieldCleared(tenantsetting.FieldExportExcludedFolderIds) {
+		fields = append(fields, tenantsetting.FieldExportExcludedFolderIds)
+	}
+	if m.FieldCleared(tenantsetting.FieldPasswordBannedWords) {
+		fields = append(fields, tenantsetting.FieldPasswordBannedWords)
+	}
+	if m.FieldCleared(tenantsetting.FieldGeoAllowedCountries) {
+		fields = append(fields, tenantsetting.FieldGeoAllowedCountries)
 	}
 	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PermissionMutation) AddedField(name string) (ent.Value, bool) {
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TenantSettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TenantSettingMutation) ClearField(name string) error {
 	switch name {
-	case permission.FieldTenantID:
-		return m.AddedTenantID()
-	case permission.FieldGrantedBy:
-		return m.AddedGrantedBy()
+	case tenantsetting.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case tenantsetting.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case tenantsetting.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case tenantsetting.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case tenantsetting.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case tenantsetting.FieldExportExcludedTags:
+		m.ClearExportExcludedTags()
+		return nil
+	case tenantsetting.FieldExportExcludedFolderIds:
+		m.ClearExportExcludedFolderIds()
+		return nil
+	case tenantsetting.FieldPasswordBannedWords:
+		m.ClearPasswordBannedWords()
+		return nil
+	case tenantsetting.FieldGeoAllowedCountries:
+		m.ClearGeoAllowedCountries()
+		return nil
 	}
-	return nil, false
+	return fmt.Errorf("unknown TenantSetting nullable field %s", name)
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PermissionMutation) AddField(name string, value ent.Value) error {
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TenantSettingMutation) ResetField(name string) error {
 	switch name {
-	case permission.FieldTenantID:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTenantID(v)
+	case tenantsetting.FieldUpdateBy:
+		m.ResetUpdateBy()
 		return nil
-	case permission.FieldGrantedBy:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddGrantedBy(v)
+	case tenantsetting.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case tenantsetting.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case tenantsetting.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case tenantsetting.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case tenantsetting.FieldAuditRetentionDays:
+		m.ResetAuditRetentionDays()
+		return nil
+	case tenantsetting.FieldExportExcludedTags:
+		m.ResetExportExcludedTags()
+		return nil
+	case tenantsetting.FieldExportExcludedFolderIds:
+		m.ResetExportExcludedFolderIds()
+		return nil
+	case tenantsetting.FieldPasswordPolicyEnabled:
+		m.ResetPasswordPolicyEnabled()
+		return nil
+	case tenantsetting.FieldPasswordMinLength:
+		m.ResetPasswordMinLength()
+		return nil
+	case tenantsetting.FieldPasswordRequireLowercase:
+		m.ResetPasswordRequireLowercase()
+		return nil
+	case tenantsetting.FieldPasswordRequireUppercase:
+		m.ResetPasswordRequireUppercase()
+		return nil
+	case tenantsetting.FieldPasswordRequireDigit:
+		m.ResetPasswordRequireDigit()
+		return nil
+	case tenantsetting.FieldPasswordRequireSymbol:
+		m.ResetPasswordRequireSymbol()
+		return nil
+	case tenantsetting.FieldPasswordBannedWords:
+		m.ResetPasswordBannedWords()
+		return nil
+	case tenantsetting.FieldPasswordMaxAgeDays:
+		m.ResetPasswordMaxAgeDays()
+		return nil
+	case tenantsetting.FieldPasswordHistorySize:
+		m.ResetPasswordHistorySize()
+		return nil
+	case tenantsetting.FieldGeoAllowedCountries:
+		m.ResetGeoAllowedCountries()
+		return nil
+	case tenantsetting.FieldVersionMaxCount:
+		m.ResetVersionMaxCount()
+		return nil
+	case tenantsetting.FieldVersionDeleteAfterDays:
+		m.ResetVersionDeleteAfterDays()
 		return nil
 	}
-	return fmt.Errorf("unknown Permission numeric field %s", name)
+	return fmt.Errorf("unknown TenantSetting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TenantSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TenantSettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TenantSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TenantSettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TenantSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TenantSettingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TenantSettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TenantSetting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TenantSettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TenantSetting edge %s", name)
+}
+
+// VersionPinMutation represents an operation that mutates the VersionPin nodes in the graph.
+type VersionPinMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uint32
+	create_time       *time.Time
+	update_time       *time.Time
+	delete_time       *time.Time
+	tenant_id         *uint32
+	addtenant_id      *int32
+	secret_id         *string
+	version_number    *int32
+	addversion_number *int32
+	token_hash        *string
+	consumer          *string
+	last_used_time    *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*VersionPin, error)
+	predicates        []predicate.VersionPin
+}
+
+var _ ent.Mutation = (*VersionPinMutation)(nil)
+
+// versionpinOption allows management of the mutation configuration using functional options.
+type versionpinOption func(*VersionPinMutation)
+
+// newVersionPinMutation creates new mutation for the VersionPin entity.
+func newVersionPinMutation(c config, op Op, opts ...versionpinOption) *VersionPinMutation {
+	m := &VersionPinMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVersionPin,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVersionPinID sets the ID field of the mutation.
+func withVersionPinID(id uint32) versionpinOption {
+	return func(m *VersionPinMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VersionPin
+		)
+		m.oldValue = func(ctx context.Context) (*VersionPin, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VersionPin.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVersionPin sets the old VersionPin of the mutation.
+func withVersionPin(node *VersionPin) versionpinOption {
+	return func(m *VersionPinMutation) {
+		m.oldValue = func(context.Context) (*VersionPin, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VersionPinMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VersionPinMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of VersionPin entities.
+func (m *VersionPinMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VersionPinMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VersionPinMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VersionPin.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *VersionPinMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *VersionPinMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PermissionMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(permission.FieldCreateTime) {
-		fields = append(fields, permission.FieldCreateTime)
-	}
-	if m.FieldCleared(permission.FieldUpdateTime) {
-		fields = append(fields, permission.FieldUpdateTime)
-	}
-	if m.FieldCleared(permission.FieldDeleteTime) {
-		fields = append(fields, permission.FieldDeleteTime)
-	}
-	if m.FieldCleared(permission.FieldTenantID) {
-		fields = append(fields, permission.FieldTenantID)
+// OldCreateTime returns the old "create_time" field's value of the VersionPin entity.
+// If the VersionPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VersionPinMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(permission.FieldGrantedBy) {
-		fields = append(fields, permission.FieldGrantedBy)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
-	if m.FieldCleared(permission.FieldExpiresAt) {
-		fields = append(fields, permission.FieldExpiresAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return fields
+	return oldValue.CreateTime, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PermissionMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *VersionPinMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[versionpin.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *VersionPinMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[versionpin.FieldCreateTime]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PermissionMutation) ClearField(name string) error {
-	switch name {
-	case permission.FieldCreateTime:
-		m.ClearCreateTime()
-		return nil
-	case permission.FieldUpdateTime:
-		m.ClearUpdateTime()
-		return nil
-	case permission.FieldDeleteTime:
-		m.ClearDeleteTime()
-		return nil
-	case permission.FieldTenantID:
-		m.ClearTenantID()
-		return nil
-	case permission.FieldGrantedBy:
-		m.ClearGrantedBy()
-		return nil
-	case permission.FieldExpiresAt:
-		m.ClearExpiresAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Permission nullable field %s", name)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *VersionPinMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, versionpin.FieldCreateTime)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PermissionMutation) ResetField(name string) error {
-	switch name {
-	case permission.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case permission.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case permission.FieldDeleteTime:
-		m.ResetDeleteTime()
-		return nil
-	case permission.FieldTenantID:
-		m.ResetTenantID()
-		return nil
-	case permission.FieldResourceType:
-		m.ResetResourceType()
-		return nil
-	case permission.FieldResourceID:
-		m.ResetResourceID()
-		return nil
-	case permission.FieldRelation:
-		m.ResetRelation()
-		return nil
-	case permission.FieldSubjectType:
-		m.ResetSubjectType()
-		return nil
-	case permission.FieldSubjectID:
-		m.ResetSubjectID()
-		return nil
-	case permission.FieldGrantedBy:
-		m.ResetGrantedBy()
-		return nil
-	case permission.FieldExpiresAt:
-		m.ResetExpiresAt()
-		return nil
+// SetUpdateTime sets the "update_time" field.
+func (m *VersionPinMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *VersionPinMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Permission field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PermissionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.folder != nil {
-		edges = append(edges, permission.EdgeFolder)
+// OldUpdateTime returns the old "update_time" field's value of the VersionPin entity.
+// If the VersionPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VersionPinMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
-	if m.secret != nil {
-		edges = append(edges, permission.EdgeSecret)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case permission.EdgeFolder:
-		if id := m.folder; id != nil {
-			return []ent.Value{*id}
-		}
-	case permission.EdgeSecret:
-		if id := m.secret; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *VersionPinMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[versionpin.FieldUpdateTime] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PermissionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *VersionPinMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[versionpin.FieldUpdateTime]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *VersionPinMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, versionpin.FieldUpdateTime)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PermissionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedfolder {
-		edges = append(edges, permission.EdgeFolder)
-	}
-	if m.clearedsecret {
-		edges = append(edges, permission.EdgeSecret)
-	}
-	return edges
+// SetDeleteTime sets the "delete_time" field.
+func (m *VersionPinMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PermissionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case permission.EdgeFolder:
-		return m.clearedfolder
-	case permission.EdgeSecret:
-		return m.clearedsecret
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *VersionPinMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PermissionMutation) ClearEdge(name string) error {
-	switch name {
-	case permission.EdgeFolder:
-		m.ClearFolder()
-		return nil
-	case permission.EdgeSecret:
-		m.ClearSecret()
-		return nil
+// OldDeleteTime returns the old "delete_time" field's value of the VersionPin entity.
+// If the VersionPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VersionPinMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Permission unique edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PermissionMutation) ResetEdge(name string) error {
-	switch name {
-	case permission.EdgeFolder:
-		m.ResetFolder()
-		return nil
-	case permission.EdgeSecret:
-		m.ResetSecret()
-		return nil
-	}
-	return fmt.Errorf("unknown Permission edge %s", name)
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *VersionPinMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[versionpin.FieldDeleteTime] = struct{}{}
 }
 
-// SecretMutation represents an operation that mutates the Secret nodes in the graph.
-type SecretMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *string
-	create_by          *uint32
-	addcreate_by       *int32
-	update_by          *uint32
-	addupdate_by       *int32
-	create_time        *time.Time
-	update_time        *time.Time
-	delete_time        *time.Time
-	tenant_id          *uint32
-	addtenant_id       *int32
-	name               *string
-	username           *string
-	host_url           *string
-	vault_path         *string
-	current_version    *int32
-	addcurrent_version *int32
-	metadata           *map[string]interface{}
-	description        *string
-	status             *secret.Status
-	has_totp           *bool
-	clearedFields      map[string]struct{}
-	folder             *string
-	clearedfolder      bool
-	versions           map[int]struct{}
-	removedversions    map[int]struct{}
-	clearedversions    bool
-	permissions        map[int]struct{}
-	removedpermissions map[int]struct{}
-	clearedpermissions bool
-	done               bool
-	oldValue           func(context.Context) (*Secret, error)
-	predicates         []predicate.Secret
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *VersionPinMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[versionpin.FieldDeleteTime]
+	return ok
 }
 
-var _ ent.Mutation = (*SecretMutation)(nil)
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *VersionPinMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, versionpin.FieldDeleteTime)
+}
 
-// secretOption allows management of the mutation configuration using functional options.
-type secretOption func(*SecretMutation)
+// SetTenantID sets the "tenant_id" field.
+func (m *VersionPinMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
 
-// newSecretMutation creates new mutation for the Secret entity.
-func newSecretMutation(c config, op Op, opts ...secretOption) *SecretMutation {
-	m := &SecretMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSecret,
-		clearedFields: make(map[string]struct{}),
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *VersionPinMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the VersionPin entity.
+// If the VersionPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VersionPinMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
-	return m
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
 }
 
-// withSecretID sets the ID field of the mutation.
-func withSecretID(id string) secretOption {
-	return func(m *SecretMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Secret
-		)
-		m.oldValue = func(ctx context.Context) (*Secret, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Secret.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddTenantID adds u to the "tenant_id" field.
+func (m *VersionPinMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
 	}
 }
 
-// withSecret sets the old Secret of the mutation.
-func withSecret(node *Secret) secretOption {
-	return func(m *SecretMutation) {
-		m.oldValue = func(context.Context) (*Secret, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *VersionPinMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SecretMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *VersionPinMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[versionpin.FieldTenantID] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SecretMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *VersionPinMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[versionpin.FieldTenantID]
+	return ok
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Secret entities.
-func (m *SecretMutation) SetID(id string) {
-	m.id = &id
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *VersionPinMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, versionpin.FieldTenantID)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SecretMutation) ID() (id string, exists bool) {
-	if m.id == nil {
+// SetSecretID sets the "secret_id" field.
+func (m *VersionPinMutation) SetSecretID(s string) {
+	m.secret_id = &s
+}
+
+// SecretID returns the value of the "secret_id" field in the mutation.
+func (m *VersionPinMutation) SecretID() (r string, exists bool) {
+	v := m.secret_id
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SecretMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Secret.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldSecretID returns the old "secret_id" field's value of the VersionPin entity.
+// If the VersionPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VersionPinMutation) OldSecretID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretID is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretID: %w", err)
+	}
+	return oldValue.SecretID, nil
 }
 
-// SetCreateBy sets the "create_by" field.
-func (m *SecretMutation) SetCreateBy(u uint32) {
-	m.create_by = &u
-	m.addcreate_by = nil
+// ResetSecretID resets all changes to the "secret_id" field.
+func (m *VersionPinMutation) ResetSecretID() {
+	m.secret_id = nil
 }
 
-// CreateBy returns the value of the "create_by" field in the mutation.
-func (m *SecretMutation) CreateBy() (r uint32, exists bool) {
-	v := m.create_by
+// SetVersionNumber sets the "version_number" field.
+func (m *VersionPinMutation) SetVersionNumber(i int32) {
+	m.version_number = &i
+	m.addversion_number = nil
+}
+
+// VersionNumber returns the value of the "version_number" field in the mutation.
+func (m *VersionPinMutation) VersionNumber() (r int32, exists bool) {
+	v := m.version_number
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateBy returns the old "create_by" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldVersionNumber returns the old "version_number" field's value of the VersionPin entity.
+// If the VersionPin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+func (m *VersionPinMutation) OldVersionNumber(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersionNumber is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+		return v, errors.New("OldVersionNumber requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersionNumber: %w", err)
 	}
-	return oldValue.CreateBy, nil
+	return oldValue.VersionNumber, nil
 }
 
-// AddCreateBy adds u to the "create_by" field.
-func (m *SecretMutation) AddCreateBy(u int32) {
-	if m.addcreate_by != nil {
-		*m.addcreate_by += u
+// AddVersionNumber adds i to the "version_number" field.
+func (m *VersionPinMutation) AddVersionNumber(i int32) {
+	if m.addversion_number != nil {
+		*m.addversion_number += i
 	} else {
-		m.addcreate_by = &u
+		m.addversion_number = &i
 	}
 }
 
-// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
-func (m *SecretMutation) AddedCreateBy() (r int32, exists bool) {
-	v := m.addcreate_by
+// AddedVersionNumber returns the value that was added to the "version_number" field in this mutation.
+func (m *VersionPinMutation) AddedVersionNumber() (r int32, exists bool) {
+	v := m.addversion_number
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearCreateBy clears the value of the "create_by" field.
-func (m *SecretMutation) ClearCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	m.clearedFields[secret.FieldCreateBy] = struct{}{}
+// ResetVersionNumber resets all changes to the "version_number" field.
+func (m *VersionPinMutation) ResetVersionNumber() {
+	m.version_number = nil
+	m.addversion_number = nil
 }
 
-// CreateByCleared returns if the "create_by" field was cleared in this mutation.
-func (m *SecretMutation) CreateByCleared() bool {
-	_, ok := m.clearedFields[secret.FieldCreateBy]
-	return ok
+// SetTokenHash sets the "token_hash" field.
+func (m *VersionPinMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// ResetCreateBy resets all changes to the "create_by" field.
-func (m *SecretMutation) ResetCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	delete(m.clearedFields, secret.FieldCreateBy)
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *VersionPinMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetUpdateBy sets the "update_by" field.
-func (m *SecretMutation) SetUpdateBy(u uint32) {
-	m.update_by = &u
-	m.addupdate_by = nil
+// OldTokenHash returns the old "token_hash" field's value of the VersionPin entity.
+// If the VersionPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VersionPinMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
 }
 
-// UpdateBy returns the value of the "update_by" field in the mutation.
-func (m *SecretMutation) UpdateBy() (r uint32, exists bool) {
-	v := m.update_by
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *VersionPinMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetConsumer sets the "consumer" field.
+func (m *VersionPinMutation) SetConsumer(s string) {
+	m.consumer = &s
+}
+
+// Consumer returns the value of the "consumer" field in the mutation.
+func (m *VersionPinMutation) Consumer() (r string, exists bool) {
+	v := m.consumer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateBy returns the old "update_by" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldConsumer returns the old "consumer" field's value of the VersionPin entity.
+// If the VersionPin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldUpdateBy(ctx context.Context) (v *uint32, err error) {
+func (m *VersionPinMutation) OldConsumer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldConsumer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+		return v, errors.New("OldConsumer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldConsumer: %w", err)
 	}
-	return oldValue.UpdateBy, nil
+	return oldValue.Consumer, nil
 }
 
-// AddUpdateBy adds u to the "update_by" field.
-func (m *SecretMutation) AddUpdateBy(u int32) {
-	if m.addupdate_by != nil {
-		*m.addupdate_by += u
-	} else {
-		m.addupdate_by = &u
-	}
+// ResetConsumer resets all changes to the "consumer" field.
+func (m *VersionPinMutation) ResetConsumer() {
+	m.consumer = nil
 }
 
-// AddedUpdateBy returns the value that was added to the "update_by" field in this mutation.
-func (m *SecretMutation) AddedUpdateBy() (r int32, exists bool) {
-	v := m.addupdate_by
+// SetLastUsedTime sets the "last_used_time" field.
+func (m *VersionPinMutation) SetLastUsedTime(t time.Time) {
+	m.last_used_time = &t
+}
+
+// LastUsedTime returns the value of the "last_used_time" field in the mutation.
+func (m *VersionPinMutation) LastUsedTime() (r time.Time, exists bool) {
+	v := m.last_used_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearUpdateBy clears the value of the "update_by" field.
-func (m *SecretMutation) ClearUpdateBy() {
-	m.update_by = nil
-	m.addupdate_by = nil
-	m.clearedFields[secret.FieldUpdateBy] = struct{}{}
+// OldLastUsedTime returns the old "last_used_time" field's value of the VersionPin entity.
+// If the VersionPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VersionPinMutation) OldLastUsedTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedTime: %w", err)
+	}
+	return oldValue.LastUsedTime, nil
 }
 
-// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
-func (m *SecretMutation) UpdateByCleared() bool {
-	_, ok := m.clearedFields[secret.FieldUpdateBy]
+// ClearLastUsedTime clears the value of the "last_used_time" field.
+func (m *VersionPinMutation) ClearLastUsedTime() {
+	m.last_used_time = nil
+	m.clearedFields[versionpin.FieldLastUsedTime] = struct{}{}
+}
+
+// LastUsedTimeCleared returns if the "last_used_time" field was cleared in this mutation.
+func (m *VersionPinMutation) LastUsedTimeCleared() bool {
+	_, ok := m.clearedFields[versionpin.FieldLastUsedTime]
 	return ok
 }
 
-// ResetUpdateBy resets all changes to the "update_by" field.
-func (m *SecretMutation) ResetUpdateBy() {
-	m.update_by = nil
-	m.addupdate_by = nil
-	delete(m.clearedFields, secret.FieldUpdateBy)
+// ResetLastUsedTime resets all changes to the "last_used_time" field.
+func (m *VersionPinMutation) ResetLastUsedTime() {
+	m.last_used_time = nil
+	delete(m.clearedFields, versionpin.FieldLastUsedTime)
+}
+
+// Where appends a list predicates to the VersionPinMutation builder.
+func (m *VersionPinMutation) Where(ps ...predicate.VersionPin) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VersionPinMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VersionPinMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VersionPin, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VersionPinMutation) Op() Op {
+	return m.op
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *SecretMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// SetOp allows setting the mutation operation.
+func (m *VersionPinMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *SecretMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (VersionPin).
+func (m *VersionPinMutation) Type() string {
+	return m.typ
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VersionPinMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, versionpin.FieldCreateTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	if m.update_time != nil {
+		fields = append(fields, versionpin.FieldUpdateTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	if m.delete_time != nil {
+		fields = append(fields, versionpin.FieldDeleteTime)
 	}
-	return oldValue.CreateTime, nil
+	if m.tenant_id != nil {
+		fields = append(fields, versionpin.FieldTenantID)
+	}
+	if m.secret_id != nil {
+		fields = append(fields, versionpin.FieldSecretID)
+	}
+	if m.version_number != nil {
+		fields = append(fields, versionpin.FieldVersionNumber)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, versionpin.FieldTokenHash)
+	}
+	if m.consumer != nil {
+		fields = append(fields, versionpin.FieldConsumer)
+	}
+	if m.last_used_time != nil {
+		fields = append(fields, versionpin.FieldLastUsedTime)
+	}
+	return fields
 }
 
-// ClearCreateTime clears the value of the "create_time" field.
-func (m *SecretMutation) ClearCreateTime() {
-	m.create_time = nil
-	m.clearedFields[secret.FieldCreateTime] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VersionPinMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case versionpin.FieldCreateTime:
+		return m.CreateTime()
+	case versionpin.FieldUpdateTime:
+		return m.UpdateTime()
+	case versionpin.FieldDeleteTime:
+		return m.DeleteTime()
+	case versionpin.FieldTenantID:
+		return m.TenantID()
+	case versionpin.FieldSecretID:
+		return m.SecretID()
+	case versionpin.FieldVersionNumber:
+		return m.VersionNumber()
+	case versionpin.FieldTokenHash:
+		return m.TokenHash()
+	case versionpin.FieldConsumer:
+		return m.Consumer()
+	case versionpin.FieldLastUsedTime:
+		return m.LastUsedTime()
+	}
+	return nil, false
 }
 
-// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *SecretMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[secret.FieldCreateTime]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VersionPinMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case versionpin.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case versionpin.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case versionpin.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case versionpin.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case versionpin.FieldSecretID:
+		return m.OldSecretID(ctx)
+	case versionpin.FieldVersionNumber:
+		return m.OldVersionNumber(ctx)
+	case versionpin.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case versionpin.FieldConsumer:
+		return m.OldConsumer(ctx)
+	case versionpin.FieldLastUsedTime:
+		return m.OldLastUsedTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown VersionPin field %s", name)
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *SecretMutation) ResetCreateTime() {
-	m.create_time = nil
-	delete(m.clearedFields, secret.FieldCreateTime)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VersionPinMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case versionpin.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case versionpin.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case versionpin.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case versionpin.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case versionpin.FieldSecretID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretID(v)
+		return nil
+	case versionpin.FieldVersionNumber:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionNumber(v)
+		return nil
+	case versionpin.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case versionpin.FieldConsumer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsumer(v)
+		return nil
+	case versionpin.FieldLastUsedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VersionPin field %s", name)
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *SecretMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VersionPinMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, versionpin.FieldTenantID)
+	}
+	if m.addversion_number != nil {
+		fields = append(fields, versionpin.FieldVersionNumber)
+	}
+	return fields
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *SecretMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VersionPinMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case versionpin.FieldTenantID:
+		return m.AddedTenantID()
+	case versionpin.FieldVersionNumber:
+		return m.AddedVersionNumber()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VersionPinMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case versionpin.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case versionpin.FieldVersionNumber:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersionNumber(v)
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	return fmt.Errorf("unknown VersionPin numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VersionPinMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(versionpin.FieldCreateTime) {
+		fields = append(fields, versionpin.FieldCreateTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	if m.FieldCleared(versionpin.FieldUpdateTime) {
+		fields = append(fields, versionpin.FieldUpdateTime)
 	}
-	return oldValue.UpdateTime, nil
+	if m.FieldCleared(versionpin.FieldDeleteTime) {
+		fields = append(fields, versionpin.FieldDeleteTime)
+	}
+	if m.FieldCleared(versionpin.FieldTenantID) {
+		fields = append(fields, versionpin.FieldTenantID)
+	}
+	if m.FieldCleared(versionpin.FieldLastUsedTime) {
+		fields = append(fields, versionpin.FieldLastUsedTime)
+	}
+	return fields
 }
 
-// ClearUpdateTime clears the value of the "update_time" field.
-func (m *SecretMutation) ClearUpdateTime() {
-	m.update_time = nil
-	m.clearedFields[secret.FieldUpdateTime] = struct{}{}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VersionPinMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *SecretMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[secret.FieldUpdateTime]
-	return ok
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VersionPinMutation) ClearField(name string) error {
+	switch name {
+	case versionpin.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case versionpin.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case versionpin.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case versionpin.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case versionpin.FieldLastUsedTime:
+		m.ClearLastUsedTime()
+		return nil
+	}
+	return fmt.Errorf("unknown VersionPin nullable field %s", name)
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *SecretMutation) ResetUpdateTime() {
-	m.update_time = nil
-	delete(m.clearedFields, secret.FieldUpdateTime)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VersionPinMutation) ResetField(name string) error {
+	switch name {
+	case versionpin.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case versionpin.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case versionpin.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case versionpin.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case versionpin.FieldSecretID:
+		m.ResetSecretID()
+		return nil
+	case versionpin.FieldVersionNumber:
+		m.ResetVersionNumber()
+		return nil
+	case versionpin.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case versionpin.FieldConsumer:
+		m.ResetConsumer()
+		return nil
+	case versionpin.FieldLastUsedTime:
+		m.ResetLastUsedTime()
+		return nil
+	}
+	return fmt.Errorf("unknown VersionPin field %s", name)
 }
 
-// SetDeleteTime sets the "delete_time" field.
-func (m *SecretMutation) SetDeleteTime(t time.Time) {
-	m.delete_time = &t
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VersionPinMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *SecretMutation) DeleteTime() (r time.Time, exists bool) {
-	v := m.delete_time
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VersionPinMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
-	}
-	return oldValue.DeleteTime, nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VersionPinMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearDeleteTime clears the value of the "delete_time" field.
-func (m *SecretMutation) ClearDeleteTime() {
-	m.delete_time = nil
-	m.clearedFields[secret.FieldDeleteTime] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VersionPinMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *SecretMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[secret.FieldDeleteTime]
-	return ok
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VersionPinMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *SecretMutation) ResetDeleteTime() {
-	m.delete_time = nil
-	delete(m.clearedFields, secret.FieldDeleteTime)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VersionPinMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *SecretMutation) SetTenantID(u uint32) {
-	m.tenant_id = &u
-	m.addtenant_id = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VersionPinMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VersionPin unique edge %s", name)
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *SecretMutation) TenantID() (r uint32, exists bool) {
-	v := m.tenant_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VersionPinMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VersionPin edge %s", name)
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uint32
+	create_by         *uint32
+	addcreate_by      *int32
+	update_by         *uint32
+	addupdate_by      *int32
+	create_time       *time.Time
+	update_time       *time.Time
+	delete_time       *time.Time
+	tenant_id         *uint32
+	addtenant_id      *int32
+	name              *string
+	url               *string
+	secret            *string
+	event_types       *[]string
+	appendevent_types []string
+	enabled           *bool
+	description       *string
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Webhook, error)
+	predicates        []predicate.Webhook
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
+
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhook,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	return m
+}
+
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id uint32) webhookOption {
+	return func(m *WebhookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Webhook
+		)
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Webhook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.TenantID, nil
 }
 
-// AddTenantID adds u to the "tenant_id" field.
-func (m *SecretMutation) AddTenantID(u int32) {
-	if m.addtenant_id != nil {
-		*m.addtenant_id += u
-	} else {
-		m.addtenant_id = &u
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
-func (m *SecretMutation) AddedTenantID() (r int32, exists bool) {
-	v := m.addtenant_id
-	if v == nil {
-		return
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearTenantID clears the value of the "tenant_id" field.
-func (m *SecretMutation) ClearTenantID() {
-	m.tenant_id = nil
-	m.addtenant_id = nil
-	m.clearedFields[secret.FieldTenantID] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Webhook entities.
+func (m *WebhookMutation) SetID(id uint32) {
+	m.id = &id
 }
 
-// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
-func (m *SecretMutation) TenantIDCleared() bool {
-	_, ok := m.clearedFields[secret.FieldTenantID]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *SecretMutation) ResetTenantID() {
-	m.tenant_id = nil
-	m.addtenant_id = nil
-	delete(m.clearedFields, secret.FieldTenantID)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetFolderID sets the "folder_id" field.
-func (m *SecretMutation) SetFolderID(s string) {
-	m.folder = &s
+// SetCreateBy sets the "create_by" field.
+func (m *WebhookMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
 }
 
-// FolderID returns the value of the "folder_id" field in the mutation.
-func (m *SecretMutation) FolderID() (r string, exists bool) {
-	v := m.folder
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *WebhookMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFolderID returns the old "folder_id" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateBy returns the old "create_by" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldFolderID(ctx context.Context) (v *string, err error) {
+func (m *WebhookMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFolderID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFolderID requires an ID field in the mutation")
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFolderID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
 	}
-	return oldValue.FolderID, nil
-}
-
-// ClearFolderID clears the value of the "folder_id" field.
-func (m *SecretMutation) ClearFolderID() {
-	m.folder = nil
-	m.clearedFields[secret.FieldFolderID] = struct{}{}
-}
-
-// FolderIDCleared returns if the "folder_id" field was cleared in this mutation.
-func (m *SecretMutation) FolderIDCleared() bool {
-	_, ok := m.clearedFields[secret.FieldFolderID]
-	return ok
-}
-
-// ResetFolderID resets all changes to the "folder_id" field.
-func (m *SecretMutation) ResetFolderID() {
-	m.folder = nil
-	delete(m.clearedFields, secret.FieldFolderID)
+	return oldValue.CreateBy, nil
 }
 
-// SetName sets the "name" field.
-func (m *SecretMutation) SetName(s string) {
-	m.name = &s
+// AddCreateBy adds u to the "create_by" field.
+func (m *WebhookMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
+	}
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *SecretMutation) Name() (r string, exists bool) {
-	v := m.name
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *WebhookMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *WebhookMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[webhook.FieldCreateBy] = struct{}{}
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *SecretMutation) ResetName() {
-	m.name = nil
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *WebhookMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldCreateBy]
+	return ok
 }
 
-// SetUsername sets the "username" field.
-func (m *SecretMutation) SetUsername(s string) {
-	m.username = &s
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *WebhookMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, webhook.FieldCreateBy)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *WebhookMutation) SetUpdateBy(u uint32) {
+	m.update_by = &u
+	m.addupdate_by = nil
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *SecretMutation) Username() (r string, exists bool) {
-	v := m.username
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *WebhookMutation) UpdateBy() (r uint32, exists bool) {
+	v := m.update_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateBy returns the old "update_by" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldUpdateBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.UpdateBy, nil
 }
 
-// ClearUsername clears the value of the "username" field.
-func (m *SecretMutation) ClearUsername() {
-	m.username = nil
-	m.clearedFields[secret.FieldUsername] = struct{}{}
+// AddUpdateBy adds u to the "update_by" field.
+func (m *WebhookMutation) AddUpdateBy(u int32) {
+	if m.addupdate_by != nil {
+		*m.addupdate_by += u
+	} else {
+		m.addupdate_by = &u
+	}
 }
 
-// UsernameCleared returns if the "username" field was cleared in this mutation.
-func (m *SecretMutation) UsernameCleared() bool {
-	_, ok := m.clearedFields[secret.FieldUsername]
+// AddedUpdateBy returns the value that was added to the "update_by" field in this mutation.
+func (m *WebhookMutation) AddedUpdateBy() (r int32, exists bool) {
+	v := m.addupdate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *WebhookMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	m.clearedFields[webhook.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *WebhookMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldUpdateBy]
 	return ok
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *SecretMutation) ResetUsername() {
-	m.username = nil
-	delete(m.clearedFields, secret.FieldUsername)
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *WebhookMutation) ResetUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	delete(m.clearedFields, webhook.FieldUpdateBy)
 }
 
-// SetHostURL sets the "host_url" field.
-func (m *SecretMutation) SetHostURL(s string) {
-	m.host_url = &s
+// SetCreateTime sets the "create_time" field.
+func (m *WebhookMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// HostURL returns the value of the "host_url" field in the mutation.
-func (m *SecretMutation) HostURL() (r string, exists bool) {
-	v := m.host_url
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WebhookMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHostURL returns the old "host_url" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldHostURL(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHostURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHostURL requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHostURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.HostURL, nil
+	return oldValue.CreateTime, nil
 }
 
-// ClearHostURL clears the value of the "host_url" field.
-func (m *SecretMutation) ClearHostURL() {
-	m.host_url = nil
-	m.clearedFields[secret.FieldHostURL] = struct{}{}
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *WebhookMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[webhook.FieldCreateTime] = struct{}{}
 }
 
-// HostURLCleared returns if the "host_url" field was cleared in this mutation.
-func (m *SecretMutation) HostURLCleared() bool {
-	_, ok := m.clearedFields[secret.FieldHostURL]
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *WebhookMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldCreateTime]
 	return ok
 }
 
-// ResetHostURL resets all changes to the "host_url" field.
-func (m *SecretMutation) ResetHostURL() {
-	m.host_url = nil
-	delete(m.clearedFields, secret.FieldHostURL)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WebhookMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, webhook.FieldCreateTime)
 }
 
-// SetVaultPath sets the "vault_path" field.
-func (m *SecretMutation) SetVaultPath(s string) {
-	m.vault_path = &s
+// SetUpdateTime sets the "update_time" field.
+func (m *WebhookMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// VaultPath returns the value of the "vault_path" field in the mutation.
-func (m *SecretMutation) VaultPath() (r string, exists bool) {
-	v := m.vault_path
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WebhookMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVaultPath returns the old "vault_path" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldVaultPath(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVaultPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVaultPath requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVaultPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.VaultPath, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ResetVaultPath resets all changes to the "vault_path" field.
-func (m *SecretMutation) ResetVaultPath() {
-	m.vault_path = nil
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *WebhookMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[webhook.FieldUpdateTime] = struct{}{}
 }
 
-// SetCurrentVersion sets the "current_version" field.
-func (m *SecretMutation) SetCurrentVersion(i int32) {
-	m.current_version = &i
-	m.addcurrent_version = nil
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *WebhookMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldUpdateTime]
+	return ok
 }
 
-// CurrentVersion returns the value of the "current_version" field in the mutation.
-func (m *SecretMutation) CurrentVersion() (r int32, exists bool) {
-	v := m.current_version
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WebhookMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, webhook.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *WebhookMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *WebhookMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrentVersion returns the old "current_version" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleteTime returns the old "delete_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldCurrentVersion(ctx context.Context) (v int32, err error) {
+func (m *WebhookMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentVersion requires an ID field in the mutation")
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
 	}
-	return oldValue.CurrentVersion, nil
+	return oldValue.DeleteTime, nil
 }
 
-// AddCurrentVersion adds i to the "current_version" field.
-func (m *SecretMutation) AddCurrentVersion(i int32) {
-	if m.addcurrent_version != nil {
-		*m.addcurrent_version += i
-	} else {
-		m.addcurrent_version = &i
-	}
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *WebhookMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[webhook.FieldDeleteTime] = struct{}{}
 }
 
-// AddedCurrentVersion returns the value that was added to the "current_version" field in this mutation.
-func (m *SecretMutation) AddedCurrentVersion() (r int32, exists bool) {
-	v := m.addcurrent_version
-	if v == nil {
-		return
-	}
-	return *v, true
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *WebhookMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldDeleteTime]
+	return ok
 }
 
-// ResetCurrentVersion resets all changes to the "current_version" field.
-func (m *SecretMutation) ResetCurrentVersion() {
-	m.current_version = nil
-	m.addcurrent_version = nil
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *WebhookMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, webhook.FieldDeleteTime)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *SecretMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetTenantID sets the "tenant_id" field.
+func (m *WebhookMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *SecretMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *WebhookMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *WebhookMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.TenantID, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *SecretMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[secret.FieldMetadata] = struct{}{}
+// AddTenantID adds u to the "tenant_id" field.
+func (m *WebhookMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *SecretMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[secret.FieldMetadata]
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *WebhookMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *WebhookMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[webhook.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *WebhookMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldTenantID]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *SecretMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, secret.FieldMetadata)
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *WebhookMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, webhook.FieldTenantID)
 }
 
-// SetDescription sets the "description" field.
-func (m *SecretMutation) SetDescription(s string) {
-	m.description = &s
+// SetName sets the "name" field.
+func (m *WebhookMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *SecretMutation) Description() (r string, exists bool) {
-	v := m.description
+// Name returns the value of the "name" field in the mutation.
+func (m *WebhookMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *SecretMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[secret.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *SecretMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[secret.FieldDescription]
-	return ok
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *SecretMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, secret.FieldDescription)
+// ResetName resets all changes to the "name" field.
+func (m *WebhookMutation) ResetName() {
+	m.name = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *SecretMutation) SetStatus(s secret.Status) {
-	m.status = &s
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *SecretMutation) Status() (r secret.Status, exists bool) {
-	v := m.status
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldStatus(ctx context.Context) (v secret.Status, err error) {
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.URL, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SecretMutation) ResetStatus() {
-	m.status = nil
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetHasTotp sets the "has_totp" field.
-func (m *SecretMutation) SetHasTotp(b bool) {
-	m.has_totp = &b
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// HasTotp returns the value of the "has_totp" field in the mutation.
-func (m *SecretMutation) HasTotp() (r bool, exists bool) {
-	v := m.has_totp
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHasTotp returns the old "has_totp" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldHasTotp(ctx context.Context) (v bool, err error) {
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHasTotp is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHasTotp requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHasTotp: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.HasTotp, nil
+	return oldValue.Secret, nil
 }
 
-// ResetHasTotp resets all changes to the "has_totp" field.
-func (m *SecretMutation) ResetHasTotp() {
-	m.has_totp = nil
+// ClearSecret clears the value of the "secret" field.
+func (m *WebhookMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[webhook.FieldSecret] = struct{}{}
 }
 
-// ClearFolder clears the "folder" edge to the Folder entity.
-func (m *SecretMutation) ClearFolder() {
-	m.clearedfolder = true
-	m.clearedFields[secret.FieldFolderID] = struct{}{}
+// SecretCleared returns if the "secret" field was cleared in this mutation.
+func (m *WebhookMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldSecret]
+	return ok
 }
 
-// FolderCleared reports if the "folder" edge to the Folder entity was cleared.
-func (m *SecretMutation) FolderCleared() bool {
-	return m.FolderIDCleared() || m.clearedfolder
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, webhook.FieldSecret)
 }
 
-// FolderIDs returns the "folder" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FolderID instead. It exists only for internal usage by the builders.
-func (m *SecretMutation) FolderIDs() (ids []string) {
-	if id := m.folder; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetEventTypes sets the "event_types" field.
+func (m *WebhookMutation) SetEventTypes(s []string) {
+	m.event_types = &s
+	m.appendevent_types = nil
 }
 
-// ResetFolder resets all changes to the "folder" edge.
-func (m *SecretMutation) ResetFolder() {
-	m.folder = nil
-	m.clearedfolder = false
+// EventTypes returns the value of the "event_types" field in the mutation.
+func (m *WebhookMutation) EventTypes() (r []string, exists bool) {
+	v := m.event_types
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddVersionIDs adds the "versions" edge to the SecretVersion entity by ids.
-func (m *SecretMutation) AddVersionIDs(ids ...int) {
-	if m.versions == nil {
-		m.versions = make(map[int]struct{})
+// OldEventTypes returns the old "event_types" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventTypes is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.versions[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
 	}
+	return oldValue.EventTypes, nil
 }
 
-// ClearVersions clears the "versions" edge to the SecretVersion entity.
-func (m *SecretMutation) ClearVersions() {
-	m.clearedversions = true
+// AppendEventTypes adds s to the "event_types" field.
+func (m *WebhookMutation) AppendEventTypes(s []string) {
+	m.appendevent_types = append(m.appendevent_types, s...)
 }
 
-// VersionsCleared reports if the "versions" edge to the SecretVersion entity was cleared.
-func (m *SecretMutation) VersionsCleared() bool {
-	return m.clearedversions
+// AppendedEventTypes returns the list of values that were appended to the "event_types" field in this mutation.
+func (m *WebhookMutation) AppendedEventTypes() ([]string, bool) {
+	if len(m.appendevent_types) == 0 {
+		return nil, false
+	}
+	return m.appendevent_types, true
 }
 
-// RemoveVersionIDs removes the "versions" edge to the SecretVersion entity by IDs.
-func (m *SecretMutation) RemoveVersionIDs(ids ...int) {
-	if m.removedversions == nil {
-		m.removedversions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.versions, ids[i])
-		m.removedversions[ids[i]] = struct{}{}
-	}
+// ClearEventTypes clears the value of the "event_types" field.
+func (m *WebhookMutation) ClearEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	m.clearedFields[webhook.FieldEventTypes] = struct{}{}
 }
 
-// RemovedVersions returns the removed IDs of the "versions" edge to the SecretVersion entity.
-func (m *SecretMutation) RemovedVersionsIDs() (ids []int) {
-	for id := range m.removedversions {
-		ids = append(ids, id)
-	}
-	return
+// EventTypesCleared returns if the "event_types" field was cleared in this mutation.
+func (m *WebhookMutation) EventTypesCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldEventTypes]
+	return ok
 }
 
-// VersionsIDs returns the "versions" edge IDs in the mutation.
-func (m *SecretMutation) VersionsIDs() (ids []int) {
-	for id := range m.versions {
-		ids = append(ids, id)
-	}
-	return
+// ResetEventTypes resets all changes to the "event_types" field.
+func (m *WebhookMutation) ResetEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	delete(m.clearedFields, webhook.FieldEventTypes)
 }
 
-// ResetVersions resets all changes to the "versions" edge.
-func (m *SecretMutation) ResetVersions() {
-	m.versions = nil
-	m.clearedversions = false
-	m.removedversions = nil
+// SetEnabled sets the "enabled" field.
+func (m *WebhookMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// AddPermissionIDs adds the "permissions" edge to the Permission entity by ids.
-func (m *SecretMutation) AddPermissionIDs(ids ...int) {
-	if m.permissions == nil {
-		m.permissions = make(map[int]struct{})
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.permissions[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
 }
 
-// ClearPermissions clears the "permissions" edge to the Permission entity.
-func (m *SecretMutation) ClearPermissions() {
-	m.clearedpermissions = true
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// PermissionsCleared reports if the "permissions" edge to the Permission entity was cleared.
-func (m *SecretMutation) PermissionsCleared() bool {
-	return m.clearedpermissions
+// SetDescription sets the "description" field.
+func (m *WebhookMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// RemovePermissionIDs removes the "permissions" edge to the Permission entity by IDs.
-func (m *SecretMutation) RemovePermissionIDs(ids ...int) {
-	if m.removedpermissions == nil {
-		m.removedpermissions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.permissions, ids[i])
-		m.removedpermissions[ids[i]] = struct{}{}
+// Description returns the value of the "description" field in the mutation.
+func (m *WebhookMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedPermissions returns the removed IDs of the "permissions" edge to the Permission entity.
-func (m *SecretMutation) RemovedPermissionsIDs() (ids []int) {
-	for id := range m.removedpermissions {
-		ids = append(ids, id)
+// OldDescription returns the old "description" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// PermissionsIDs returns the "permissions" edge IDs in the mutation.
-func (m *SecretMutation) PermissionsIDs() (ids []int) {
-	for id := range m.permissions {
-		ids = append(ids, id)
-	}
-	return
+// ClearDescription clears the value of the "description" field.
+func (m *WebhookMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[webhook.FieldDescription] = struct{}{}
 }
 
-// ResetPermissions resets all changes to the "permissions" edge.
-func (m *SecretMutation) ResetPermissions() {
-	m.permissions = nil
-	m.clearedpermissions = false
-	m.removedpermissions = nil
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *WebhookMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldDescription]
+	return ok
 }
 
-// Where appends a list predicates to the SecretMutation builder.
-func (m *SecretMutation) Where(ps ...predicate.Secret) {
+// ResetDescription resets all changes to the "description" field.
+func (m *WebhookMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, webhook.FieldDescription)
+}
+
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SecretMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SecretMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Secret, len(ps))
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5551,72 +22158,60 @@ func (m *SecretMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SecretMutation) Op() Op {
+func (m *WebhookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SecretMutation) SetOp(op Op) {
+func (m *WebhookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Secret).
-func (m *SecretMutation) Type() string {
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SecretMutation) Fields() []string {
-	fields := make([]string, 0, 16)
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.create_by != nil {
-		fields = append(fields, secret.FieldCreateBy)
+		fields = append(fields, webhook.FieldCreateBy)
 	}
 	if m.update_by != nil {
-		fields = append(fields, secret.FieldUpdateBy)
+		fields = append(fields, webhook.FieldUpdateBy)
 	}
 	if m.create_time != nil {
-		fields = append(fields, secret.FieldCreateTime)
+		fields = append(fields, webhook.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, secret.FieldUpdateTime)
+		fields = append(fields, webhook.FieldUpdateTime)
 	}
 	if m.delete_time != nil {
-		fields = append(fields, secret.FieldDeleteTime)
+		fields = append(fields, webhook.FieldDeleteTime)
 	}
 	if m.tenant_id != nil {
-		fields = append(fields, secret.FieldTenantID)
-	}
-	if m.folder != nil {
-		fields = append(fields, secret.FieldFolderID)
+		fields = append(fields, webhook.FieldTenantID)
 	}
 	if m.name != nil {
-		fields = append(fields, secret.FieldName)
-	}
-	if m.username != nil {
-		fields = append(fields, secret.FieldUsername)
+		fields = append(fields, webhook.FieldName)
 	}
-	if m.host_url != nil {
-		fields = append(fields, secret.FieldHostURL)
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
 	}
-	if m.vault_path != nil {
-		fields = append(fields, secret.FieldVaultPath)
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
 	}
-	if m.current_version != nil {
-		fields = append(fields, secret.FieldCurrentVersion)
+	if m.event_types != nil {
+		fields = append(fields, webhook.FieldEventTypes)
 	}
-	if m.metadata != nil {
-		fields = append(fields, secret.FieldMetadata)
+	if m.enabled != nil {
+		fields = append(fields, webhook.FieldEnabled)
 	}
 	if m.description != nil {
-		fields = append(fields, secret.FieldDescription)
-	}
-	if m.status != nil {
-		fields = append(fields, secret.FieldStatus)
-	}
-	if m.has_totp != nil {
-		fields = append(fields, secret.FieldHasTotp)
+		fields = append(fields, webhook.FieldDescription)
 	}
 	return fields
 }
@@ -5624,40 +22219,32 @@ func (m *SecretMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SecretMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case secret.FieldCreateBy:
+	case webhook.FieldCreateBy:
 		return m.CreateBy()
-	case secret.FieldUpdateBy:
+	case webhook.FieldUpdateBy:
 		return m.UpdateBy()
-	case secret.FieldCreateTime:
+	case webhook.FieldCreateTime:
 		return m.CreateTime()
-	case secret.FieldUpdateTime:
+	case webhook.FieldUpdateTime:
 		return m.UpdateTime()
-	case secret.FieldDeleteTime:
+	case webhook.FieldDeleteTime:
 		return m.DeleteTime()
-	case secret.FieldTenantID:
+	case webhook.FieldTenantID:
 		return m.TenantID()
-	case secret.FieldFolderID:
-		return m.FolderID()
-	case secret.FieldName:
+	case webhook.FieldName:
 		return m.Name()
-	case secret.FieldUsername:
-		return m.Username()
-	case secret.FieldHostURL:
-		return m.HostURL()
-	case secret.FieldVaultPath:
-		return m.VaultPath()
-	case secret.FieldCurrentVersion:
-		return m.CurrentVersion()
-	case secret.FieldMetadata:
-		return m.Metadata()
-	case secret.FieldDescription:
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEventTypes:
+		return m.EventTypes()
+	case webhook.FieldEnabled:
+		return m.Enabled()
+	case webhook.FieldDescription:
 		return m.Description()
-	case secret.FieldStatus:
-		return m.Status()
-	case secret.FieldHasTotp:
-		return m.HasTotp()
 	}
 	return nil, false
 }
@@ -5665,180 +22252,141 @@ func (m *SecretMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SecretMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case secret.FieldCreateBy:
+	case webhook.FieldCreateBy:
 		return m.OldCreateBy(ctx)
-	case secret.FieldUpdateBy:
+	case webhook.FieldUpdateBy:
 		return m.OldUpdateBy(ctx)
-	case secret.FieldCreateTime:
+	case webhook.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case secret.FieldUpdateTime:
+	case webhook.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case secret.FieldDeleteTime:
+	case webhook.FieldDeleteTime:
 		return m.OldDeleteTime(ctx)
-	case secret.FieldTenantID:
+	case webhook.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case secret.FieldFolderID:
-		return m.OldFolderID(ctx)
-	case secret.FieldName:
+	case webhook.FieldName:
 		return m.OldName(ctx)
-	case secret.FieldUsername:
-		return m.OldUsername(ctx)
-	case secret.FieldHostURL:
-		return m.OldHostURL(ctx)
-	case secret.FieldVaultPath:
-		return m.OldVaultPath(ctx)
-	case secret.FieldCurrentVersion:
-		return m.OldCurrentVersion(ctx)
-	case secret.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case secret.FieldDescription:
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case webhook.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case webhook.FieldDescription:
 		return m.OldDescription(ctx)
-	case secret.FieldStatus:
-		return m.OldStatus(ctx)
-	case secret.FieldHasTotp:
-		return m.OldHasTotp(ctx)
 	}
-	return nil, fmt.Errorf("unknown Secret field %s", name)
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SecretMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case secret.FieldCreateBy:
+	case webhook.FieldCreateBy:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateBy(v)
 		return nil
-	case secret.FieldUpdateBy:
+	case webhook.FieldUpdateBy:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateBy(v)
 		return nil
-	case secret.FieldCreateTime:
+	case webhook.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case secret.FieldUpdateTime:
+	case webhook.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case secret.FieldDeleteTime:
+	case webhook.FieldDeleteTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeleteTime(v)
 		return nil
-	case secret.FieldTenantID:
+	case webhook.FieldTenantID:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case secret.FieldFolderID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFolderID(v)
-		return nil
-	case secret.FieldName:
+	case webhook.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case secret.FieldUsername:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsername(v)
-		return nil
-	case secret.FieldHostURL:
+	case webhook.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHostURL(v)
+		m.SetURL(v)
 		return nil
-	case secret.FieldVaultPath:
+	case webhook.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVaultPath(v)
+		m.SetSecret(v)
 		return nil
-	case secret.FieldCurrentVersion:
-		v, ok := value.(int32)
+	case webhook.FieldEventTypes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrentVersion(v)
+		m.SetEventTypes(v)
 		return nil
-	case secret.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case webhook.FieldEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetEnabled(v)
 		return nil
-	case secret.FieldDescription:
+	case webhook.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case secret.FieldStatus:
-		v, ok := value.(secret.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case secret.FieldHasTotp:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHasTotp(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Secret field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SecretMutation) AddedFields() []string {
+func (m *WebhookMutation) AddedFields() []string {
 	var fields []string
 	if m.addcreate_by != nil {
-		fields = append(fields, secret.FieldCreateBy)
+		fields = append(fields, webhook.FieldCreateBy)
 	}
 	if m.addupdate_by != nil {
-		fields = append(fields, secret.FieldUpdateBy)
+		fields = append(fields, webhook.FieldUpdateBy)
 	}
 	if m.addtenant_id != nil {
-		fields = append(fields, secret.FieldTenantID)
-	}
-	if m.addcurrent_version != nil {
-		fields = append(fields, secret.FieldCurrentVersion)
+		fields = append(fields, webhook.FieldTenantID)
 	}
 	return fields
 }
@@ -5846,16 +22394,14 @@ func (m *SecretMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SecretMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case secret.FieldCreateBy:
+	case webhook.FieldCreateBy:
 		return m.AddedCreateBy()
-	case secret.FieldUpdateBy:
+	case webhook.FieldUpdateBy:
 		return m.AddedUpdateBy()
-	case secret.FieldTenantID:
+	case webhook.FieldTenantID:
 		return m.AddedTenantID()
-	case secret.FieldCurrentVersion:
-		return m.AddedCurrentVersion()
 	}
 	return nil, false
 }
@@ -5863,347 +22409,242 @@ func (m *SecretMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SecretMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case secret.FieldCreateBy:
+	case webhook.FieldCreateBy:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreateBy(v)
 		return nil
-	case secret.FieldUpdateBy:
+	case webhook.FieldUpdateBy:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddUpdateBy(v)
 		return nil
-	case secret.FieldTenantID:
+	case webhook.FieldTenantID:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddTenantID(v)
 		return nil
-	case secret.FieldCurrentVersion:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCurrentVersion(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Secret numeric field %s", name)
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SecretMutation) ClearedFields() []string {
+func (m *WebhookMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(secret.FieldCreateBy) {
-		fields = append(fields, secret.FieldCreateBy)
-	}
-	if m.FieldCleared(secret.FieldUpdateBy) {
-		fields = append(fields, secret.FieldUpdateBy)
-	}
-	if m.FieldCleared(secret.FieldCreateTime) {
-		fields = append(fields, secret.FieldCreateTime)
+	if m.FieldCleared(webhook.FieldCreateBy) {
+		fields = append(fields, webhook.FieldCreateBy)
 	}
-	if m.FieldCleared(secret.FieldUpdateTime) {
-		fields = append(fields, secret.FieldUpdateTime)
+	if m.FieldCleared(webhook.FieldUpdateBy) {
+		fields = append(fields, webhook.FieldUpdateBy)
 	}
-	if m.FieldCleared(secret.FieldDeleteTime) {
-		fields = append(fields, secret.FieldDeleteTime)
+	if m.FieldCleared(webhook.FieldCreateTime) {
+		fields = append(fields, webhook.FieldCreateTime)
 	}
-	if m.FieldCleared(secret.FieldTenantID) {
-		fields = append(fields, secret.FieldTenantID)
+	if m.FieldCleared(webhook.FieldUpdateTime) {
+		fields = append(fields, webhook.FieldUpdateTime)
 	}
-	if m.FieldCleared(secret.FieldFolderID) {
-		fields = append(fields, secret.FieldFolderID)
+	if m.FieldCleared(webhook.FieldDeleteTime) {
+		fields = append(fields, webhook.FieldDeleteTime)
 	}
-	if m.FieldCleared(secret.FieldUsername) {
-		fields = append(fields, secret.FieldUsername)
+	if m.FieldCleared(webhook.FieldTenantID) {
+		fields = append(fields, webhook.FieldTenantID)
 	}
-	if m.FieldCleared(secret.FieldHostURL) {
-		fields = append(fields, secret.FieldHostURL)
+	if m.FieldCleared(webhook.FieldSecret) {
+		fields = append(fields, webhook.FieldSecret)
 	}
-	if m.FieldCleared(secret.FieldMetadata) {
-		fields = append(fields, secret.FieldMetadata)
+	if m.FieldCleared(webhook.FieldEventTypes) {
+		fields = append(fields, webhook.FieldEventTypes)
 	}
-	if m.FieldCleared(secret.FieldDescription) {
-		fields = append(fields, secret.FieldDescription)
+	if m.FieldCleared(webhook.FieldDescription) {
+		fields = append(fields, webhook.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SecretMutation) FieldCleared(name string) bool {
+func (m *WebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SecretMutation) ClearField(name string) error {
+func (m *WebhookMutation) ClearField(name string) error {
 	switch name {
-	case secret.FieldCreateBy:
+	case webhook.FieldCreateBy:
 		m.ClearCreateBy()
 		return nil
-	case secret.FieldUpdateBy:
+	case webhook.FieldUpdateBy:
 		m.ClearUpdateBy()
 		return nil
-	case secret.FieldCreateTime:
+	case webhook.FieldCreateTime:
 		m.ClearCreateTime()
 		return nil
-	case secret.FieldUpdateTime:
+	case webhook.FieldUpdateTime:
 		m.ClearUpdateTime()
 		return nil
-	case secret.FieldDeleteTime:
+	case webhook.FieldDeleteTime:
 		m.ClearDeleteTime()
 		return nil
-	case secret.FieldTenantID:
+	case webhook.FieldTenantID:
 		m.ClearTenantID()
 		return nil
-	case secret.FieldFolderID:
-		m.ClearFolderID()
-		return nil
-	case secret.FieldUsername:
-		m.ClearUsername()
-		return nil
-	case secret.FieldHostURL:
-		m.ClearHostURL()
+	case webhook.FieldSecret:
+		m.ClearSecret()
 		return nil
-	case secret.FieldMetadata:
-		m.ClearMetadata()
+	case webhook.FieldEventTypes:
+		m.ClearEventTypes()
 		return nil
-	case secret.FieldDescription:
+	case webhook.FieldDescription:
 		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown Secret nullable field %s", name)
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SecretMutation) ResetField(name string) error {
+func (m *WebhookMutation) ResetField(name string) error {
 	switch name {
-	case secret.FieldCreateBy:
+	case webhook.FieldCreateBy:
 		m.ResetCreateBy()
 		return nil
-	case secret.FieldUpdateBy:
+	case webhook.FieldUpdateBy:
 		m.ResetUpdateBy()
 		return nil
-	case secret.FieldCreateTime:
+	case webhook.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case secret.FieldUpdateTime:
+	case webhook.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case secret.FieldDeleteTime:
+	case webhook.FieldDeleteTime:
 		m.ResetDeleteTime()
 		return nil
-	case secret.FieldTenantID:
+	case webhook.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case secret.FieldFolderID:
-		m.ResetFolderID()
-		return nil
-	case secret.FieldName:
+	case webhook.FieldName:
 		m.ResetName()
 		return nil
-	case secret.FieldUsername:
-		m.ResetUsername()
-		return nil
-	case secret.FieldHostURL:
-		m.ResetHostURL()
+	case webhook.FieldURL:
+		m.ResetURL()
 		return nil
-	case secret.FieldVaultPath:
-		m.ResetVaultPath()
+	case webhook.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case secret.FieldCurrentVersion:
-		m.ResetCurrentVersion()
+	case webhook.FieldEventTypes:
+		m.ResetEventTypes()
 		return nil
-	case secret.FieldMetadata:
-		m.ResetMetadata()
+	case webhook.FieldEnabled:
+		m.ResetEnabled()
 		return nil
-	case secret.FieldDescription:
+	case webhook.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case secret.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case secret.FieldHasTotp:
-		m.ResetHasTotp()
-		return nil
 	}
-	return fmt.Errorf("unknown Secret field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SecretMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.folder != nil {
-		edges = append(edges, secret.EdgeFolder)
-	}
-	if m.versions != nil {
-		edges = append(edges, secret.EdgeVersions)
-	}
-	if m.permissions != nil {
-		edges = append(edges, secret.EdgePermissions)
-	}
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SecretMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case secret.EdgeFolder:
-		if id := m.folder; id != nil {
-			return []ent.Value{*id}
-		}
-	case secret.EdgeVersions:
-		ids := make([]ent.Value, 0, len(m.versions))
-		for id := range m.versions {
-			ids = append(ids, id)
-		}
-		return ids
-	case secret.EdgePermissions:
-		ids := make([]ent.Value, 0, len(m.permissions))
-		for id := range m.permissions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SecretMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedversions != nil {
-		edges = append(edges, secret.EdgeVersions)
-	}
-	if m.removedpermissions != nil {
-		edges = append(edges, secret.EdgePermissions)
-	}
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SecretMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case secret.EdgeVersions:
-		ids := make([]ent.Value, 0, len(m.removedversions))
-		for id := range m.removedversions {
-			ids = append(ids, id)
-		}
-		return ids
-	case secret.EdgePermissions:
-		ids := make([]ent.Value, 0, len(m.removedpermissions))
-		for id := range m.removedpermissions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SecretMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedfolder {
-		edges = append(edges, secret.EdgeFolder)
-	}
-	if m.clearedversions {
-		edges = append(edges, secret.EdgeVersions)
-	}
-	if m.clearedpermissions {
-		edges = append(edges, secret.EdgePermissions)
-	}
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SecretMutation) EdgeCleared(name string) bool {
-	switch name {
-	case secret.EdgeFolder:
-		return m.clearedfolder
-	case secret.EdgeVersions:
-		return m.clearedversions
-	case secret.EdgePermissions:
-		return m.clearedpermissions
-	}
+func (m *WebhookMutation) EdgeCleared(name string) bool {
 	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *SecretMutation) ClearEdge(name string) error {
-	switch name {
-	case secret.EdgeFolder:
-		m.ClearFolder()
-		return nil
-	}
-	return fmt.Errorf("unknown Secret unique edge %s", name)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SecretMutation) ResetEdge(name string) error {
-	switch name {
-	case secret.EdgeFolder:
-		m.ResetFolder()
-		return nil
-	case secret.EdgeVersions:
-		m.ResetVersions()
-		return nil
-	case secret.EdgePermissions:
-		m.ResetPermissions()
-		return nil
-	}
-	return fmt.Errorf("unknown Secret edge %s", name)
+func (m *WebhookMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-// SecretVersionMutation represents an operation that mutates the SecretVersion nodes in the graph.
-type SecretVersionMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	create_by         *uint32
-	addcreate_by      *int32
-	create_time       *time.Time
-	update_time       *time.Time
-	delete_time       *time.Time
-	version_number    *int32
-	addversion_number *int32
-	vault_path        *string
-	comment           *string
-	checksum          *string
-	clearedFields     map[string]struct{}
-	secret            *string
-	clearedsecret     bool
-	done              bool
-	oldValue          func(context.Context) (*SecretVersion, error)
-	predicates        []predicate.SecretVersion
-}
-
-var _ ent.Mutation = (*SecretVersionMutation)(nil)
-
-// secretversionOption allows management of the mutation configuration using functional options.
-type secretversionOption func(*SecretVersionMutation)
-
-// newSecretVersionMutation creates new mutation for the SecretVersion entity.
-func newSecretVersionMutation(c config, op Op, opts ...secretversionOption) *SecretVersionMutation {
-	m := &SecretVersionMutation{
+	op               Op
+	typ              string
+	id               *uint32
+	create_time      *time.Time
+	update_time      *time.Time
+	delete_time      *time.Time
+	tenant_id        *uint32
+	addtenant_id     *int32
+	webhook_id       *uint32
+	addwebhook_id    *int32
+	event_id         *string
+	event_type       *string
+	payload          *string
+	status           *webhookdelivery.Status
+	attempts         *int32
+	addattempts      *int32
+	response_code    *int32
+	addresponse_code *int32
+	last_error       *string
+	next_attempt_at  *time.Time
+	delivered_at     *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*WebhookDelivery, error)
+	predicates       []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSecretVersion,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6212,20 +22653,20 @@ func newSecretVersionMutation(c config, op Op, opts ...secretversionOption) *Sec
 	return m
 }
 
-// withSecretVersionID sets the ID field of the mutation.
-func withSecretVersionID(id int) secretversionOption {
-	return func(m *SecretVersionMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uint32) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SecretVersion
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*SecretVersion, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SecretVersion.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6234,10 +22675,10 @@ func withSecretVersionID(id int) secretversionOption {
 	}
 }
 
-// withSecretVersion sets the old SecretVersion of the mutation.
-func withSecretVersion(node *SecretVersion) secretversionOption {
-	return func(m *SecretVersionMutation) {
-		m.oldValue = func(context.Context) (*SecretVersion, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6246,7 +22687,7 @@ func withSecretVersion(node *SecretVersion) secretversionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SecretVersionMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6254,7 +22695,7 @@ func (m SecretVersionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SecretVersionMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6263,9 +22704,15 @@ func (m SecretVersionMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uint32) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SecretVersionMutation) ID() (id int, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uint32, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6276,487 +22723,720 @@ func (m *SecretVersionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SecretVersionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uint32, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uint32{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SecretVersion.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreateBy sets the "create_by" field.
-func (m *SecretVersionMutation) SetCreateBy(u uint32) {
-	m.create_by = &u
-	m.addcreate_by = nil
+// SetCreateTime sets the "create_time" field.
+func (m *WebhookDeliveryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// CreateBy returns the value of the "create_by" field in the mutation.
-func (m *SecretVersionMutation) CreateBy() (r uint32, exists bool) {
-	v := m.create_by
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WebhookDeliveryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateBy returns the old "create_by" field's value of the SecretVersion entity.
-// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretVersionMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+func (m *WebhookDeliveryMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.CreateBy, nil
+	return oldValue.CreateTime, nil
 }
 
-// AddCreateBy adds u to the "create_by" field.
-func (m *SecretVersionMutation) AddCreateBy(u int32) {
-	if m.addcreate_by != nil {
-		*m.addcreate_by += u
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *WebhookDeliveryMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[webhookdelivery.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WebhookDeliveryMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, webhookdelivery.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *WebhookDeliveryMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *WebhookDeliveryMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[webhookdelivery.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WebhookDeliveryMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, webhookdelivery.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *WebhookDeliveryMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *WebhookDeliveryMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *WebhookDeliveryMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[webhookdelivery.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *WebhookDeliveryMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *WebhookDeliveryMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *WebhookDeliveryMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *WebhookDeliveryMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
 	} else {
-		m.addcreate_by = &u
+		m.addtenant_id = &u
 	}
 }
 
-// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
-func (m *SecretVersionMutation) AddedCreateBy() (r int32, exists bool) {
-	v := m.addcreate_by
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearCreateBy clears the value of the "create_by" field.
-func (m *SecretVersionMutation) ClearCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	m.clearedFields[secretversion.FieldCreateBy] = struct{}{}
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *WebhookDeliveryMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[webhookdelivery.FieldTenantID] = struct{}{}
 }
 
-// CreateByCleared returns if the "create_by" field was cleared in this mutation.
-func (m *SecretVersionMutation) CreateByCleared() bool {
-	_, ok := m.clearedFields[secretversion.FieldCreateBy]
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldTenantID]
 	return ok
 }
 
-// ResetCreateBy resets all changes to the "create_by" field.
-func (m *SecretVersionMutation) ResetCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	delete(m.clearedFields, secretversion.FieldCreateBy)
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *WebhookDeliveryMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, webhookdelivery.FieldTenantID)
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliveryMutation) SetWebhookID(u uint32) {
+	m.webhook_id = &u
+	m.addwebhook_id = nil
+}
+
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (r uint32, exists bool) {
+	v := m.webhook_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldWebhookID(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
+	}
+	return oldValue.WebhookID, nil
+}
+
+// AddWebhookID adds u to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) AddWebhookID(u int32) {
+	if m.addwebhook_id != nil {
+		*m.addwebhook_id += u
+	} else {
+		m.addwebhook_id = &u
+	}
+}
+
+// AddedWebhookID returns the value that was added to the "webhook_id" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedWebhookID() (r int32, exists bool) {
+	v := m.addwebhook_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) ResetWebhookID() {
+	m.webhook_id = nil
+	m.addwebhook_id = nil
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *SecretVersionMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(s string) {
+	m.event_id = &s
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *SecretVersionMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r string, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the SecretVersion entity.
-// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretVersionMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.CreateTime, nil
-}
-
-// ClearCreateTime clears the value of the "create_time" field.
-func (m *SecretVersionMutation) ClearCreateTime() {
-	m.create_time = nil
-	m.clearedFields[secretversion.FieldCreateTime] = struct{}{}
-}
-
-// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *SecretVersionMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[secretversion.FieldCreateTime]
-	return ok
+	return oldValue.EventID, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *SecretVersionMutation) ResetCreateTime() {
-	m.create_time = nil
-	delete(m.clearedFields, secretversion.FieldCreateTime)
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *SecretVersionMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *SecretVersionMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the SecretVersion entity.
-// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretVersionMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.EventType, nil
 }
 
-// ClearUpdateTime clears the value of the "update_time" field.
-func (m *SecretVersionMutation) ClearUpdateTime() {
-	m.update_time = nil
-	m.clearedFields[secretversion.FieldUpdateTime] = struct{}{}
-}
-
-// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *SecretVersionMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[secretversion.FieldUpdateTime]
-	return ok
-}
-
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *SecretVersionMutation) ResetUpdateTime() {
-	m.update_time = nil
-	delete(m.clearedFields, secretversion.FieldUpdateTime)
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// SetDeleteTime sets the "delete_time" field.
-func (m *SecretVersionMutation) SetDeleteTime(t time.Time) {
-	m.delete_time = &t
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *SecretVersionMutation) DeleteTime() (r time.Time, exists bool) {
-	v := m.delete_time
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the SecretVersion entity.
-// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretVersionMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.DeleteTime, nil
-}
-
-// ClearDeleteTime clears the value of the "delete_time" field.
-func (m *SecretVersionMutation) ClearDeleteTime() {
-	m.delete_time = nil
-	m.clearedFields[secretversion.FieldDeleteTime] = struct{}{}
-}
-
-// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *SecretVersionMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[secretversion.FieldDeleteTime]
-	return ok
+	return oldValue.Payload, nil
 }
 
-// ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *SecretVersionMutation) ResetDeleteTime() {
-	m.delete_time = nil
-	delete(m.clearedFields, secretversion.FieldDeleteTime)
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetSecretID sets the "secret_id" field.
-func (m *SecretVersionMutation) SetSecretID(s string) {
-	m.secret = &s
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
 }
 
-// SecretID returns the value of the "secret_id" field in the mutation.
-func (m *SecretVersionMutation) SecretID() (r string, exists bool) {
-	v := m.secret
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSecretID returns the old "secret_id" field's value of the SecretVersion entity.
-// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretVersionMutation) OldSecretID(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v webhookdelivery.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecretID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecretID requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecretID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.SecretID, nil
+	return oldValue.Status, nil
 }
 
-// ResetSecretID resets all changes to the "secret_id" field.
-func (m *SecretVersionMutation) ResetSecretID() {
-	m.secret = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetVersionNumber sets the "version_number" field.
-func (m *SecretVersionMutation) SetVersionNumber(i int32) {
-	m.version_number = &i
-	m.addversion_number = nil
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int32) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// VersionNumber returns the value of the "version_number" field in the mutation.
-func (m *SecretVersionMutation) VersionNumber() (r int32, exists bool) {
-	v := m.version_number
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int32, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersionNumber returns the old "version_number" field's value of the SecretVersion entity.
-// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretVersionMutation) OldVersionNumber(ctx context.Context) (v int32, err error) {
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersionNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersionNumber requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersionNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.VersionNumber, nil
+	return oldValue.Attempts, nil
 }
 
-// AddVersionNumber adds i to the "version_number" field.
-func (m *SecretVersionMutation) AddVersionNumber(i int32) {
-	if m.addversion_number != nil {
-		*m.addversion_number += i
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int32) {
+	if m.addattempts != nil {
+		*m.addattempts += i
 	} else {
-		m.addversion_number = &i
+		m.addattempts = &i
 	}
 }
 
-// AddedVersionNumber returns the value that was added to the "version_number" field in this mutation.
-func (m *SecretVersionMutation) AddedVersionNumber() (r int32, exists bool) {
-	v := m.addversion_number
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int32, exists bool) {
+	v := m.addattempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetVersionNumber resets all changes to the "version_number" field.
-func (m *SecretVersionMutation) ResetVersionNumber() {
-	m.version_number = nil
-	m.addversion_number = nil
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetVaultPath sets the "vault_path" field.
-func (m *SecretVersionMutation) SetVaultPath(s string) {
-	m.vault_path = &s
+// SetResponseCode sets the "response_code" field.
+func (m *WebhookDeliveryMutation) SetResponseCode(i int32) {
+	m.response_code = &i
+	m.addresponse_code = nil
 }
 
-// VaultPath returns the value of the "vault_path" field in the mutation.
-func (m *SecretVersionMutation) VaultPath() (r string, exists bool) {
-	v := m.vault_path
+// ResponseCode returns the value of the "response_code" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseCode() (r int32, exists bool) {
+	v := m.response_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVaultPath returns the old "vault_path" field's value of the SecretVersion entity.
-// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseCode returns the old "response_code" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretVersionMutation) OldVaultPath(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldResponseCode(ctx context.Context) (v *int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVaultPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVaultPath requires an ID field in the mutation")
+		return v, errors.New("OldResponseCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVaultPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseCode: %w", err)
 	}
-	return oldValue.VaultPath, nil
+	return oldValue.ResponseCode, nil
 }
 
-// ResetVaultPath resets all changes to the "vault_path" field.
-func (m *SecretVersionMutation) ResetVaultPath() {
-	m.vault_path = nil
+// AddResponseCode adds i to the "response_code" field.
+func (m *WebhookDeliveryMutation) AddResponseCode(i int32) {
+	if m.addresponse_code != nil {
+		*m.addresponse_code += i
+	} else {
+		m.addresponse_code = &i
+	}
 }
 
-// SetComment sets the "comment" field.
-func (m *SecretVersionMutation) SetComment(s string) {
-	m.comment = &s
+// AddedResponseCode returns the value that was added to the "response_code" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseCode() (r int32, exists bool) {
+	v := m.addresponse_code
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Comment returns the value of the "comment" field in the mutation.
-func (m *SecretVersionMutation) Comment() (r string, exists bool) {
-	v := m.comment
+// ClearResponseCode clears the value of the "response_code" field.
+func (m *WebhookDeliveryMutation) ClearResponseCode() {
+	m.response_code = nil
+	m.addresponse_code = nil
+	m.clearedFields[webhookdelivery.FieldResponseCode] = struct{}{}
+}
+
+// ResponseCodeCleared returns if the "response_code" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseCodeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseCode]
+	return ok
+}
+
+// ResetResponseCode resets all changes to the "response_code" field.
+func (m *WebhookDeliveryMutation) ResetResponseCode() {
+	m.response_code = nil
+	m.addresponse_code = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseCode)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldComment returns the old "comment" field's value of the SecretVersion entity.
-// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretVersionMutation) OldComment(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldComment requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.Comment, nil
+	return oldValue.LastError, nil
 }
 
-// ClearComment clears the value of the "comment" field.
-func (m *SecretVersionMutation) ClearComment() {
-	m.comment = nil
-	m.clearedFields[secretversion.FieldComment] = struct{}{}
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
 }
 
-// CommentCleared returns if the "comment" field was cleared in this mutation.
-func (m *SecretVersionMutation) CommentCleared() bool {
-	_, ok := m.clearedFields[secretversion.FieldComment]
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
 	return ok
 }
 
-// ResetComment resets all changes to the "comment" field.
-func (m *SecretVersionMutation) ResetComment() {
-	m.comment = nil
-	delete(m.clearedFields, secretversion.FieldComment)
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
 }
 
-// SetChecksum sets the "checksum" field.
-func (m *SecretVersionMutation) SetChecksum(s string) {
-	m.checksum = &s
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
 }
 
-// Checksum returns the value of the "checksum" field in the mutation.
-func (m *SecretVersionMutation) Checksum() (r string, exists bool) {
-	v := m.checksum
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChecksum returns the old "checksum" field's value of the SecretVersion entity.
-// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretVersionMutation) OldChecksum(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChecksum requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.Checksum, nil
+	return oldValue.NextAttemptAt, nil
 }
 
-// ResetChecksum resets all changes to the "checksum" field.
-func (m *SecretVersionMutation) ResetChecksum() {
-	m.checksum = nil
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[webhookdelivery.FieldNextAttemptAt] = struct{}{}
 }
 
-// ClearSecret clears the "secret" edge to the Secret entity.
-func (m *SecretVersionMutation) ClearSecret() {
-	m.clearedsecret = true
-	m.clearedFields[secretversion.FieldSecretID] = struct{}{}
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldNextAttemptAt]
+	return ok
 }
 
-// SecretCleared reports if the "secret" edge to the Secret entity was cleared.
-func (m *SecretVersionMutation) SecretCleared() bool {
-	return m.clearedsecret
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldNextAttemptAt)
 }
 
-// SecretIDs returns the "secret" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SecretID instead. It exists only for internal usage by the builders.
-func (m *SecretVersionMutation) SecretIDs() (ids []string) {
-	if id := m.secret; id != nil {
-		ids = append(ids, *id)
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetSecret resets all changes to the "secret" edge.
-func (m *SecretVersionMutation) ResetSecret() {
-	m.secret = nil
-	m.clearedsecret = false
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
 }
 
-// Where appends a list predicates to the SecretVersionMutation builder.
-func (m *SecretVersionMutation) Where(ps ...predicate.SecretVersion) {
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
+}
+
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
+}
+
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SecretVersionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SecretVersionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SecretVersion, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6764,51 +23444,66 @@ func (m *SecretVersionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SecretVersionMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SecretVersionMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SecretVersion).
-func (m *SecretVersionMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SecretVersionMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.create_by != nil {
-		fields = append(fields, secretversion.FieldCreateBy)
-	}
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 14)
 	if m.create_time != nil {
-		fields = append(fields, secretversion.FieldCreateTime)
+		fields = append(fields, webhookdelivery.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, secretversion.FieldUpdateTime)
+		fields = append(fields, webhookdelivery.FieldUpdateTime)
 	}
 	if m.delete_time != nil {
-		fields = append(fields, secretversion.FieldDeleteTime)
+		fields = append(fields, webhookdelivery.FieldDeleteTime)
 	}
-	if m.secret != nil {
-		fields = append(fields, secretversion.FieldSecretID)
+	if m.tenant_id != nil {
+		fields = append(fields, webhookdelivery.FieldTenantID)
 	}
-	if m.version_number != nil {
-		fields = append(fields, secretversion.FieldVersionNumber)
+	if m.webhook_id != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
 	}
-	if m.vault_path != nil {
-		fields = append(fields, secretversion.FieldVaultPath)
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
 	}
-	if m.comment != nil {
-		fields = append(fields, secretversion.FieldComment)
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
 	}
-	if m.checksum != nil {
-		fields = append(fields, secretversion.FieldChecksum)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.response_code != nil {
+		fields = append(fields, webhookdelivery.FieldResponseCode)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
 	return fields
 }
@@ -6816,26 +23511,36 @@ func (m *SecretVersionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SecretVersionMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case secretversion.FieldCreateBy:
-		return m.CreateBy()
-	case secretversion.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.CreateTime()
-	case secretversion.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.UpdateTime()
-	case secretversion.FieldDeleteTime:
+	case webhookdelivery.FieldDeleteTime:
 		return m.DeleteTime()
-	case secretversion.FieldSecretID:
-		return m.SecretID()
-	case secretversion.FieldVersionNumber:
-		return m.VersionNumber()
-	case secretversion.FieldVaultPath:
-		return m.VaultPath()
-	case secretversion.FieldComment:
-		return m.Comment()
-	case secretversion.FieldChecksum:
-		return m.Checksum()
+	case webhookdelivery.FieldTenantID:
+		return m.TenantID()
+	case webhookdelivery.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldResponseCode:
+		return m.ResponseCode()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
 	}
 	return nil, false
 }
@@ -6843,111 +23548,162 @@ func (m *SecretVersionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SecretVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case secretversion.FieldCreateBy:
-		return m.OldCreateBy(ctx)
-	case secretversion.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case secretversion.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case secretversion.FieldDeleteTime:
+	case webhookdelivery.FieldDeleteTime:
 		return m.OldDeleteTime(ctx)
-	case secretversion.FieldSecretID:
-		return m.OldSecretID(ctx)
-	case secretversion.FieldVersionNumber:
-		return m.OldVersionNumber(ctx)
-	case secretversion.FieldVaultPath:
-		return m.OldVaultPath(ctx)
-	case secretversion.FieldComment:
-		return m.OldComment(ctx)
-	case secretversion.FieldChecksum:
-		return m.OldChecksum(ctx)
+	case webhookdelivery.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case webhookdelivery.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldResponseCode:
+		return m.OldResponseCode(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SecretVersion field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SecretVersionMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case secretversion.FieldCreateBy:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateBy(v)
-		return nil
-	case secretversion.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case secretversion.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case secretversion.FieldDeleteTime:
+	case webhookdelivery.FieldDeleteTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeleteTime(v)
 		return nil
-	case secretversion.FieldSecretID:
-		v, ok := value.(string)
+	case webhookdelivery.FieldTenantID:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSecretID(v)
+		m.SetTenantID(v)
 		return nil
-	case secretversion.FieldVersionNumber:
-		v, ok := value.(int32)
+	case webhookdelivery.FieldWebhookID:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersionNumber(v)
+		m.SetWebhookID(v)
 		return nil
-	case secretversion.FieldVaultPath:
+	case webhookdelivery.FieldEventID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVaultPath(v)
+		m.SetEventID(v)
 		return nil
-	case secretversion.FieldComment:
+	case webhookdelivery.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetComment(v)
+		m.SetEventType(v)
 		return nil
-	case secretversion.FieldChecksum:
+	case webhookdelivery.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChecksum(v)
+		m.SetPayload(v)
+		return nil
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseCode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseCode(v)
+		return nil
+	case webhookdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SecretVersion field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SecretVersionMutation) AddedFields() []string {
+func (m *WebhookDeliveryMutation) AddedFields() []string {
 	var fields []string
-	if m.addcreate_by != nil {
-		fields = append(fields, secretversion.FieldCreateBy)
+	if m.addtenant_id != nil {
+		fields = append(fields, webhookdelivery.FieldTenantID)
 	}
-	if m.addversion_number != nil {
-		fields = append(fields, secretversion.FieldVersionNumber)
+	if m.addwebhook_id != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_code != nil {
+		fields = append(fields, webhookdelivery.FieldResponseCode)
 	}
 	return fields
 }
@@ -6955,12 +23711,16 @@ func (m *SecretVersionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SecretVersionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case secretversion.FieldCreateBy:
-		return m.AddedCreateBy()
-	case secretversion.FieldVersionNumber:
-		return m.AddedVersionNumber()
+	case webhookdelivery.FieldTenantID:
+		return m.AddedTenantID()
+	case webhookdelivery.FieldWebhookID:
+		return m.AddedWebhookID()
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseCode:
+		return m.AddedResponseCode()
 	}
 	return nil, false
 }
@@ -6968,183 +23728,204 @@ func (m *SecretVersionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SecretVersionMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case secretversion.FieldCreateBy:
+	case webhookdelivery.FieldTenantID:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCreateBy(v)
+		m.AddTenantID(v)
 		return nil
-	case secretversion.FieldVersionNumber:
+	case webhookdelivery.FieldWebhookID:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddVersionNumber(v)
+		m.AddWebhookID(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseCode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseCode(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SecretVersion numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SecretVersionMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(secretversion.FieldCreateBy) {
-		fields = append(fields, secretversion.FieldCreateBy)
+	if m.FieldCleared(webhookdelivery.FieldCreateTime) {
+		fields = append(fields, webhookdelivery.FieldCreateTime)
 	}
-	if m.FieldCleared(secretversion.FieldCreateTime) {
-		fields = append(fields, secretversion.FieldCreateTime)
+	if m.FieldCleared(webhookdelivery.FieldUpdateTime) {
+		fields = append(fields, webhookdelivery.FieldUpdateTime)
 	}
-	if m.FieldCleared(secretversion.FieldUpdateTime) {
-		fields = append(fields, secretversion.FieldUpdateTime)
+	if m.FieldCleared(webhookdelivery.FieldDeleteTime) {
+		fields = append(fields, webhookdelivery.FieldDeleteTime)
 	}
-	if m.FieldCleared(secretversion.FieldDeleteTime) {
-		fields = append(fields, secretversion.FieldDeleteTime)
+	if m.FieldCleared(webhookdelivery.FieldTenantID) {
+		fields = append(fields, webhookdelivery.FieldTenantID)
 	}
-	if m.FieldCleared(secretversion.FieldComment) {
-		fields = append(fields, secretversion.FieldComment)
+	if m.FieldCleared(webhookdelivery.FieldResponseCode) {
+		fields = append(fields, webhookdelivery.FieldResponseCode)
+	}
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldNextAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SecretVersionMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SecretVersionMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case secretversion.FieldCreateBy:
-		m.ClearCreateBy()
-		return nil
-	case secretversion.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		m.ClearCreateTime()
 		return nil
-	case secretversion.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		m.ClearUpdateTime()
 		return nil
-	case secretversion.FieldDeleteTime:
+	case webhookdelivery.FieldDeleteTime:
 		m.ClearDeleteTime()
 		return nil
-	case secretversion.FieldComment:
-		m.ClearComment()
+	case webhookdelivery.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case webhookdelivery.FieldResponseCode:
+		m.ClearResponseCode()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SecretVersion nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SecretVersionMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case secretversion.FieldCreateBy:
-		m.ResetCreateBy()
-		return nil
-	case secretversion.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case secretversion.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case secretversion.FieldDeleteTime:
+	case webhookdelivery.FieldDeleteTime:
 		m.ResetDeleteTime()
 		return nil
-	case secretversion.FieldSecretID:
-		m.ResetSecretID()
+	case webhookdelivery.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case secretversion.FieldVersionNumber:
-		m.ResetVersionNumber()
+	case webhookdelivery.FieldWebhookID:
+		m.ResetWebhookID()
 		return nil
-	case secretversion.FieldVaultPath:
-		m.ResetVaultPath()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
 		return nil
-	case secretversion.FieldComment:
-		m.ResetComment()
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case secretversion.FieldChecksum:
-		m.ResetChecksum()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldResponseCode:
+		m.ResetResponseCode()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SecretVersion field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SecretVersionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.secret != nil {
-		edges = append(edges, secretversion.EdgeSecret)
-	}
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SecretVersionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case secretversion.EdgeSecret:
-		if id := m.secret; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SecretVersionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SecretVersionMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SecretVersionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedsecret {
-		edges = append(edges, secretversion.EdgeSecret)
-	}
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SecretVersionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case secretversion.EdgeSecret:
-		return m.clearedsecret
-	}
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SecretVersionMutation) ClearEdge(name string) error {
-	switch name {
-	case secretversion.EdgeSecret:
-		m.ClearSecret()
-		return nil
-	}
-	return fmt.Errorf("unknown SecretVersion unique edge %s", name)
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SecretVersionMutation) ResetEdge(name string) error {
-	switch name {
-	case secretversion.EdgeSecret:
-		m.ResetSecret()
-		return nil
-	}
-	return fmt.Errorf("unknown SecretVersion edge %s", name)
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
@@ -27,9 +27,9 @@ type Permission struct {
 	DeleteTime *time.Time `json:"delete_time,omitempty"`
 	// 租户ID
 	TenantID *uint32 `json:"tenant_id,omitempty"`
-	// Type of resource (folder or secret)
+	// Type of resource (folder, secret or collection)
 	ResourceType permission.ResourceType `json:"resource_type,omitempty"`
-	// ID of the folder or secret
+	// ID of the folder, secret or collection
 	ResourceID string `json:"resource_id,omitempty"`
 	// Permission level (owner, editor, viewer, sharer)
 	Relation permission.Relation `json:"relation,omitempty"`
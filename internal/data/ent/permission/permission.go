@@ -121,6 +121,7 @@ const (
 	ResourceTypeRESOURCE_TYPE_UNSPECIFIED ResourceType = "RESOURCE_TYPE_UNSPECIFIED"
 	ResourceTypeRESOURCE_TYPE_FOLDER      ResourceType = "RESOURCE_TYPE_FOLDER"
 	ResourceTypeRESOURCE_TYPE_SECRET      ResourceType = "RESOURCE_TYPE_SECRET"
+	ResourceTypeRESOURCE_TYPE_COLLECTION  ResourceType = "RESOURCE_TYPE_COLLECTION"
 )
 
 func (rt ResourceType) String() string {
@@ -130,7 +131,7 @@ func (rt ResourceType) String() string {
 // ResourceTypeValidator is a validator for the "resource_type" field enum values. It is called by the builders before save.
 func ResourceTypeValidator(rt ResourceType) error {
 	switch rt {
-	case ResourceTypeRESOURCE_TYPE_UNSPECIFIED, ResourceTypeRESOURCE_TYPE_FOLDER, ResourceTypeRESOURCE_TYPE_SECRET:
+	case ResourceTypeRESOURCE_TYPE_UNSPECIFIED, ResourceTypeRESOURCE_TYPE_FOLDER, ResourceTypeRESOURCE_TYPE_SECRET, ResourceTypeRESOURCE_TYPE_COLLECTION:
 		return nil
 	default:
 		return fmt.Errorf("permission: invalid enum value for resource_type field: %q", rt)
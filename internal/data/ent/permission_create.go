@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
@@ -20,6 +21,7 @@ type PermissionCreate struct {
 	config
 	mutation *PermissionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateTime sets the "create_time" field.
@@ -286,6 +288,7 @@ func (_c *PermissionCreate) createSpec() (*Permission, *sqlgraph.CreateSpec) {
 		_node = &Permission{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(permission.Table, sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.CreateTime(); ok {
 		_spec.SetField(permission.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = &value
@@ -367,11 +370,441 @@ func (_c *PermissionCreate) createSpec() (*Permission, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Permission.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PermissionUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PermissionCreate) OnConflict(opts ...sql.ConflictOption) *PermissionUpsertOne {
+	_c.conflict = opts
+	return &PermissionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Permission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PermissionCreate) OnConflictColumns(columns ...string) *PermissionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PermissionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PermissionUpsertOne is the builder for "upsert"-ing
+	//  one Permission node.
+	PermissionUpsertOne struct {
+		create *PermissionCreate
+	}
+
+	// PermissionUpsert is the "OnConflict" setter.
+	PermissionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PermissionUpsert) SetUpdateTime(v time.Time) *PermissionUpsert {
+	u.Set(permission.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PermissionUpsert) UpdateUpdateTime() *PermissionUpsert {
+	u.SetExcluded(permission.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *PermissionUpsert) ClearUpdateTime() *PermissionUpsert {
+	u.SetNull(permission.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *PermissionUpsert) SetDeleteTime(v time.Time) *PermissionUpsert {
+	u.Set(permission.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *PermissionUpsert) UpdateDeleteTime() *PermissionUpsert {
+	u.SetExcluded(permission.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *PermissionUpsert) ClearDeleteTime() *PermissionUpsert {
+	u.SetNull(permission.FieldDeleteTime)
+	return u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *PermissionUpsert) SetResourceType(v permission.ResourceType) *PermissionUpsert {
+	u.Set(permission.FieldResourceType, v)
+	return u
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *PermissionUpsert) UpdateResourceType() *PermissionUpsert {
+	u.SetExcluded(permission.FieldResourceType)
+	return u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *PermissionUpsert) SetResourceID(v string) *PermissionUpsert {
+	u.Set(permission.FieldResourceID, v)
+	return u
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *PermissionUpsert) UpdateResourceID() *PermissionUpsert {
+	u.SetExcluded(permission.FieldResourceID)
+	return u
+}
+
+// SetRelation sets the "relation" field.
+func (u *PermissionUpsert) SetRelation(v permission.Relation) *PermissionUpsert {
+	u.Set(permission.FieldRelation, v)
+	return u
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *PermissionUpsert) UpdateRelation() *PermissionUpsert {
+	u.SetExcluded(permission.FieldRelation)
+	return u
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (u *PermissionUpsert) SetSubjectType(v permission.SubjectType) *PermissionUpsert {
+	u.Set(permission.FieldSubjectType, v)
+	return u
+}
+
+// UpdateSubjectType sets the "subject_type" field to the value that was provided on create.
+func (u *PermissionUpsert) UpdateSubjectType() *PermissionUpsert {
+	u.SetExcluded(permission.FieldSubjectType)
+	return u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *PermissionUpsert) SetSubjectID(v string) *PermissionUpsert {
+	u.Set(permission.FieldSubjectID, v)
+	return u
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *PermissionUpsert) UpdateSubjectID() *PermissionUpsert {
+	u.SetExcluded(permission.FieldSubjectID)
+	return u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *PermissionUpsert) SetGrantedBy(v uint32) *PermissionUpsert {
+	u.Set(permission.FieldGrantedBy, v)
+	return u
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *PermissionUpsert) UpdateGrantedBy() *PermissionUpsert {
+	u.SetExcluded(permission.FieldGrantedBy)
+	return u
+}
+
+// AddGrantedBy adds v to the "granted_by" field.
+func (u *PermissionUpsert) AddGrantedBy(v uint32) *PermissionUpsert {
+	u.Add(permission.FieldGrantedBy, v)
+	return u
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (u *PermissionUpsert) ClearGrantedBy() *PermissionUpsert {
+	u.SetNull(permission.FieldGrantedBy)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PermissionUpsert) SetExpiresAt(v time.Time) *PermissionUpsert {
+	u.Set(permission.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PermissionUpsert) UpdateExpiresAt() *PermissionUpsert {
+	u.SetExcluded(permission.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PermissionUpsert) ClearExpiresAt() *PermissionUpsert {
+	u.SetNull(permission.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Permission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PermissionUpsertOne) UpdateNewValues() *PermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(permission.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(permission.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Permission.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PermissionUpsertOne) Ignore() *PermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PermissionUpsertOne) DoNothing() *PermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PermissionCreate.OnConflict
+// documentation for more info.
+func (u *PermissionUpsertOne) Update(set func(*PermissionUpsert)) *PermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PermissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PermissionUpsertOne) SetUpdateTime(v time.Time) *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PermissionUpsertOne) UpdateUpdateTime() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *PermissionUpsertOne) ClearUpdateTime() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *PermissionUpsertOne) SetDeleteTime(v time.Time) *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *PermissionUpsertOne) UpdateDeleteTime() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *PermissionUpsertOne) ClearDeleteTime() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *PermissionUpsertOne) SetResourceType(v permission.ResourceType) *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetResourceType(v)
+	})
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *PermissionUpsertOne) UpdateResourceType() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateResourceType()
+	})
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *PermissionUpsertOne) SetResourceID(v string) *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetResourceID(v)
+	})
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *PermissionUpsertOne) UpdateResourceID() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateResourceID()
+	})
+}
+
+// SetRelation sets the "relation" field.
+func (u *PermissionUpsertOne) SetRelation(v permission.Relation) *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetRelation(v)
+	})
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *PermissionUpsertOne) UpdateRelation() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateRelation()
+	})
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (u *PermissionUpsertOne) SetSubjectType(v permission.SubjectType) *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetSubjectType(v)
+	})
+}
+
+// UpdateSubjectType sets the "subject_type" field to the value that was provided on create.
+func (u *PermissionUpsertOne) UpdateSubjectType() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateSubjectType()
+	})
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *PermissionUpsertOne) SetSubjectID(v string) *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *PermissionUpsertOne) UpdateSubjectID() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *PermissionUpsertOne) SetGrantedBy(v uint32) *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetGrantedBy(v)
+	})
+}
+
+// AddGrantedBy adds v to the "granted_by" field.
+func (u *PermissionUpsertOne) AddGrantedBy(v uint32) *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.AddGrantedBy(v)
+	})
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *PermissionUpsertOne) UpdateGrantedBy() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateGrantedBy()
+	})
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (u *PermissionUpsertOne) ClearGrantedBy() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.ClearGrantedBy()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PermissionUpsertOne) SetExpiresAt(v time.Time) *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PermissionUpsertOne) UpdateExpiresAt() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PermissionUpsertOne) ClearExpiresAt() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PermissionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PermissionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PermissionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PermissionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PermissionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PermissionCreateBulk is the builder for creating many Permission entities in bulk.
 type PermissionCreateBulk struct {
 	config
 	err      error
 	builders []*PermissionCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Permission entities in the database.
@@ -401,6 +834,7 @@ func (_c *PermissionCreateBulk) Save(ctx context.Context) ([]*Permission, error)
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -454,3 +888,278 @@ func (_c *PermissionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Permission.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PermissionUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PermissionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PermissionUpsertBulk {
+	_c.conflict = opts
+	return &PermissionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Permission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PermissionCreateBulk) OnConflictColumns(columns ...string) *PermissionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PermissionUpsertBulk{
+		create: _c,
+	}
+}
+
+// PermissionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Permission nodes.
+type PermissionUpsertBulk struct {
+	create *PermissionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Permission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PermissionUpsertBulk) UpdateNewValues() *PermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(permission.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(permission.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Permission.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PermissionUpsertBulk) Ignore() *PermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PermissionUpsertBulk) DoNothing() *PermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PermissionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PermissionUpsertBulk) Update(set func(*PermissionUpsert)) *PermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PermissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PermissionUpsertBulk) SetUpdateTime(v time.Time) *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PermissionUpsertBulk) UpdateUpdateTime() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *PermissionUpsertBulk) ClearUpdateTime() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *PermissionUpsertBulk) SetDeleteTime(v time.Time) *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *PermissionUpsertBulk) UpdateDeleteTime() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *PermissionUpsertBulk) ClearDeleteTime() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *PermissionUpsertBulk) SetResourceType(v permission.ResourceType) *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetResourceType(v)
+	})
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *PermissionUpsertBulk) UpdateResourceType() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateResourceType()
+	})
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *PermissionUpsertBulk) SetResourceID(v string) *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetResourceID(v)
+	})
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *PermissionUpsertBulk) UpdateResourceID() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateResourceID()
+	})
+}
+
+// SetRelation sets the "relation" field.
+func (u *PermissionUpsertBulk) SetRelation(v permission.Relation) *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetRelation(v)
+	})
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *PermissionUpsertBulk) UpdateRelation() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateRelation()
+	})
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (u *PermissionUpsertBulk) SetSubjectType(v permission.SubjectType) *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetSubjectType(v)
+	})
+}
+
+// UpdateSubjectType sets the "subject_type" field to the value that was provided on create.
+func (u *PermissionUpsertBulk) UpdateSubjectType() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateSubjectType()
+	})
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *PermissionUpsertBulk) SetSubjectID(v string) *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *PermissionUpsertBulk) UpdateSubjectID() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *PermissionUpsertBulk) SetGrantedBy(v uint32) *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetGrantedBy(v)
+	})
+}
+
+// AddGrantedBy adds v to the "granted_by" field.
+func (u *PermissionUpsertBulk) AddGrantedBy(v uint32) *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.AddGrantedBy(v)
+	})
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *PermissionUpsertBulk) UpdateGrantedBy() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateGrantedBy()
+	})
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (u *PermissionUpsertBulk) ClearGrantedBy() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.ClearGrantedBy()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PermissionUpsertBulk) SetExpiresAt(v time.Time) *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PermissionUpsertBulk) UpdateExpiresAt() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PermissionUpsertBulk) ClearExpiresAt() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PermissionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PermissionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PermissionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PermissionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -305,8 +305,9 @@ func (_q *PermissionQuery) Clone() *PermissionQuery {
 		withFolder: _q.withFolder.Clone(),
 		withSecret: _q.withSecret.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -642,6 +643,12 @@ func (_q *PermissionQuery) ForShare(opts ...sql.LockOption) *PermissionQuery {
 	return _q
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *PermissionQuery) Modify(modifiers ...func(s *sql.Selector)) *PermissionSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // PermissionGroupBy is the group-by builder for Permission entities.
 type PermissionGroupBy struct {
 	selector
@@ -731,3 +738,9 @@ func (_s *PermissionSelect) sqlScan(ctx context.Context, root *PermissionQuery,
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *PermissionSelect) Modify(modifiers ...func(s *sql.Selector)) *PermissionSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -6,17 +6,56 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditChainHead is the predicate function for auditchainhead builders.
+type AuditChainHead func(*sql.Selector)
+
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
+// Collection is the predicate function for collection builders.
+type Collection func(*sql.Selector)
+
+// DeletionRequest is the predicate function for deletionrequest builders.
+type DeletionRequest func(*sql.Selector)
+
+// EmergencyAccess is the predicate function for emergencyaccess builders.
+type EmergencyAccess func(*sql.Selector)
+
+// FieldKey is the predicate function for fieldkey builders.
+type FieldKey func(*sql.Selector)
+
 // Folder is the predicate function for folder builders.
 type Folder func(*sql.Selector)
 
+// FolderChangeLog is the predicate function for folderchangelog builders.
+type FolderChangeLog func(*sql.Selector)
+
+// PendingOperation is the predicate function for pendingoperation builders.
+type PendingOperation func(*sql.Selector)
+
 // Permission is the predicate function for permission builders.
 type Permission func(*sql.Selector)
 
 // Secret is the predicate function for secret builders.
 type Secret func(*sql.Selector)
 
+// SecretUsage is the predicate function for secretusage builders.
+type SecretUsage func(*sql.Selector)
+
 // SecretVersion is the predicate function for secretversion builders.
 type SecretVersion func(*sql.Selector)
+
+// SecurityAlert is the predicate function for securityalert builders.
+type SecurityAlert func(*sql.Selector)
+
+// TenantSetting is the predicate function for tenantsetting builders.
+type TenantSetting func(*sql.Selector)
+
+// VersionPin is the predicate function for versionpin builders.
+type VersionPin func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
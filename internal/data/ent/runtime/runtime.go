@@ -5,12 +5,25 @@ package runtime
 import (
 	"context"
 
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditchainhead"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/collection"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/deletionrequest"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/emergencyaccess"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/fieldkey"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folderchangelog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/pendingoperation"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretusage"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretversion"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/securityalert"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/tenantsetting"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/versionpin"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhook"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhookdelivery"
 
 	"entgo.io/ent"
 	"entgo.io/ent/privacy"
@@ -20,6 +33,21 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditchainheadMixin := schema.AuditChainHead{}.Mixin()
+	auditchainheadMixinFields0 := auditchainheadMixin[0].Fields()
+	_ = auditchainheadMixinFields0
+	auditchainheadFields := schema.AuditChainHead{}.Fields()
+	_ = auditchainheadFields
+	// auditchainheadDescSeq is the schema descriptor for seq field.
+	auditchainheadDescSeq := auditchainheadFields[1].Descriptor()
+	// auditchainhead.DefaultSeq holds the default value on creation for the seq field.
+	auditchainhead.DefaultSeq = auditchainheadDescSeq.Default.(int64)
+	// auditchainhead.SeqValidator is a validator for the "seq" field. It is called by the builders before save.
+	auditchainhead.SeqValidator = auditchainheadDescSeq.Validators[0].(func(int64) error)
+	// auditchainheadDescID is the schema descriptor for id field.
+	auditchainheadDescID := auditchainheadMixinFields0[0].Descriptor()
+	// auditchainhead.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditchainhead.IDValidator = auditchainheadDescID.Validators[0].(func(uint32) error)
 	auditlogMixin := schema.AuditLog{}.Mixin()
 	auditlog.Policy = privacy.NewPolicies(auditlogMixin[2], schema.AuditLog{})
 	auditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -64,10 +92,251 @@ func init() {
 	auditlogDescLatencyMs := auditlogFields[12].Descriptor()
 	// auditlog.DefaultLatencyMs holds the default value on creation for the latency_ms field.
 	auditlog.DefaultLatencyMs = auditlogDescLatencyMs.Default.(int64)
+	// auditlogDescChainSeq is the schema descriptor for chain_seq field.
+	auditlogDescChainSeq := auditlogFields[22].Descriptor()
+	// auditlog.DefaultChainSeq holds the default value on creation for the chain_seq field.
+	auditlog.DefaultChainSeq = auditlogDescChainSeq.Default.(int64)
 	// auditlogDescID is the schema descriptor for id field.
 	auditlogDescID := auditlogMixinFields0[0].Descriptor()
 	// auditlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	auditlog.IDValidator = auditlogDescID.Validators[0].(func(uint32) error)
+	collectionMixin := schema.Collection{}.Mixin()
+	collection.Policy = privacy.NewPolicies(collectionMixin[2], schema.Collection{})
+	collection.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := collection.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	collectionMixinFields2 := collectionMixin[2].Fields()
+	_ = collectionMixinFields2
+	collectionFields := schema.Collection{}.Fields()
+	_ = collectionFields
+	// collectionDescTenantID is the schema descriptor for tenant_id field.
+	collectionDescTenantID := collectionMixinFields2[0].Descriptor()
+	// collection.DefaultTenantID holds the default value on creation for the tenant_id field.
+	collection.DefaultTenantID = collectionDescTenantID.Default.(uint32)
+	// collectionDescName is the schema descriptor for name field.
+	collectionDescName := collectionFields[1].Descriptor()
+	// collection.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	collection.NameValidator = func() func(string) error {
+		validators := collectionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// collectionDescDescription is the schema descriptor for description field.
+	collectionDescDescription := collectionFields[2].Descriptor()
+	// collection.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	collection.DescriptionValidator = collectionDescDescription.Validators[0].(func(string) error)
+	// collectionDescExternalID is the schema descriptor for external_id field.
+	collectionDescExternalID := collectionFields[3].Descriptor()
+	// collection.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	collection.ExternalIDValidator = collectionDescExternalID.Validators[0].(func(string) error)
+	// collectionDescID is the schema descriptor for id field.
+	collectionDescID := collectionFields[0].Descriptor()
+	// collection.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	collection.IDValidator = collectionDescID.Validators[0].(func(string) error)
+	deletionrequestMixin := schema.DeletionRequest{}.Mixin()
+	deletionrequest.Policy = privacy.NewPolicies(deletionrequestMixin[2], schema.DeletionRequest{})
+	deletionrequest.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := deletionrequest.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	deletionrequestMixinFields0 := deletionrequestMixin[0].Fields()
+	_ = deletionrequestMixinFields0
+	deletionrequestMixinFields2 := deletionrequestMixin[2].Fields()
+	_ = deletionrequestMixinFields2
+	deletionrequestFields := schema.DeletionRequest{}.Fields()
+	_ = deletionrequestFields
+	// deletionrequestDescTenantID is the schema descriptor for tenant_id field.
+	deletionrequestDescTenantID := deletionrequestMixinFields2[0].Descriptor()
+	// deletionrequest.DefaultTenantID holds the default value on creation for the tenant_id field.
+	deletionrequest.DefaultTenantID = deletionrequestDescTenantID.Default.(uint32)
+	// deletionrequestDescResourceID is the schema descriptor for resource_id field.
+	deletionrequestDescResourceID := deletionrequestFields[1].Descriptor()
+	// deletionrequest.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	deletionrequest.ResourceIDValidator = func() func(string) error {
+		validators := deletionrequestDescResourceID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(resource_id string) error {
+			for _, fn := range fns {
+				if err := fn(resource_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// deletionrequestDescResourceName is the schema descriptor for resource_name field.
+	deletionrequestDescResourceName := deletionrequestFields[2].Descriptor()
+	// deletionrequest.ResourceNameValidator is a validator for the "resource_name" field. It is called by the builders before save.
+	deletionrequest.ResourceNameValidator = deletionrequestDescResourceName.Validators[0].(func(string) error)
+	// deletionrequestDescForce is the schema descriptor for force field.
+	deletionrequestDescForce := deletionrequestFields[3].Descriptor()
+	// deletionrequest.DefaultForce holds the default value on creation for the force field.
+	deletionrequest.DefaultForce = deletionrequestDescForce.Default.(bool)
+	// deletionrequestDescReason is the schema descriptor for reason field.
+	deletionrequestDescReason := deletionrequestFields[4].Descriptor()
+	// deletionrequest.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	deletionrequest.ReasonValidator = deletionrequestDescReason.Validators[0].(func(string) error)
+	// deletionrequestDescRequestedBy is the schema descriptor for requested_by field.
+	deletionrequestDescRequestedBy := deletionrequestFields[6].Descriptor()
+	// deletionrequest.RequestedByValidator is a validator for the "requested_by" field. It is called by the builders before save.
+	deletionrequest.RequestedByValidator = func() func(string) error {
+		validators := deletionrequestDescRequestedBy.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(requested_by string) error {
+			for _, fn := range fns {
+				if err := fn(requested_by); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// deletionrequestDescDecidedBy is the schema descriptor for decided_by field.
+	deletionrequestDescDecidedBy := deletionrequestFields[7].Descriptor()
+	// deletionrequest.DecidedByValidator is a validator for the "decided_by" field. It is called by the builders before save.
+	deletionrequest.DecidedByValidator = deletionrequestDescDecidedBy.Validators[0].(func(string) error)
+	// deletionrequestDescID is the schema descriptor for id field.
+	deletionrequestDescID := deletionrequestMixinFields0[0].Descriptor()
+	// deletionrequest.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	deletionrequest.IDValidator = deletionrequestDescID.Validators[0].(func(uint32) error)
+	emergencyaccessMixin := schema.EmergencyAccess{}.Mixin()
+	emergencyaccess.Policy = privacy.NewPolicies(emergencyaccessMixin[2], schema.EmergencyAccess{})
+	emergencyaccess.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := emergencyaccess.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	emergencyaccessMixinFields0 := emergencyaccessMixin[0].Fields()
+	_ = emergencyaccessMixinFields0
+	emergencyaccessMixinFields2 := emergencyaccessMixin[2].Fields()
+	_ = emergencyaccessMixinFields2
+	emergencyaccessFields := schema.EmergencyAccess{}.Fields()
+	_ = emergencyaccessFields
+	// emergencyaccessDescTenantID is the schema descriptor for tenant_id field.
+	emergencyaccessDescTenantID := emergencyaccessMixinFields2[0].Descriptor()
+	// emergencyaccess.DefaultTenantID holds the default value on creation for the tenant_id field.
+	emergencyaccess.DefaultTenantID = emergencyaccessDescTenantID.Default.(uint32)
+	// emergencyaccessDescGrantorID is the schema descriptor for grantor_id field.
+	emergencyaccessDescGrantorID := emergencyaccessFields[0].Descriptor()
+	// emergencyaccess.GrantorIDValidator is a validator for the "grantor_id" field. It is called by the builders before save.
+	emergencyaccess.GrantorIDValidator = func() func(string) error {
+		validators := emergencyaccessDescGrantorID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(grantor_id string) error {
+			for _, fn := range fns {
+				if err := fn(grantor_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// emergencyaccessDescGranteeID is the schema descriptor for grantee_id field.
+	emergencyaccessDescGranteeID := emergencyaccessFields[1].Descriptor()
+	// emergencyaccess.GranteeIDValidator is a validator for the "grantee_id" field. It is called by the builders before save.
+	emergencyaccess.GranteeIDValidator = func() func(string) error {
+		validators := emergencyaccessDescGranteeID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(grantee_id string) error {
+			for _, fn := range fns {
+				if err := fn(grantee_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// emergencyaccessDescFolderID is the schema descriptor for folder_id field.
+	emergencyaccessDescFolderID := emergencyaccessFields[2].Descriptor()
+	// emergencyaccess.FolderIDValidator is a validator for the "folder_id" field. It is called by the builders before save.
+	emergencyaccess.FolderIDValidator = func() func(string) error {
+		validators := emergencyaccessDescFolderID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(folder_id string) error {
+			for _, fn := range fns {
+				if err := fn(folder_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// emergencyaccessDescWaitDays is the schema descriptor for wait_days field.
+	emergencyaccessDescWaitDays := emergencyaccessFields[3].Descriptor()
+	// emergencyaccess.WaitDaysValidator is a validator for the "wait_days" field. It is called by the builders before save.
+	emergencyaccess.WaitDaysValidator = emergencyaccessDescWaitDays.Validators[0].(func(int32) error)
+	// emergencyaccessDescID is the schema descriptor for id field.
+	emergencyaccessDescID := emergencyaccessMixinFields0[0].Descriptor()
+	// emergencyaccess.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	emergencyaccess.IDValidator = emergencyaccessDescID.Validators[0].(func(uint32) error)
+	fieldkeyMixin := schema.FieldKey{}.Mixin()
+	fieldkeyMixinFields0 := fieldkeyMixin[0].Fields()
+	_ = fieldkeyMixinFields0
+	fieldkeyFields := schema.FieldKey{}.Fields()
+	_ = fieldkeyFields
+	// fieldkeyDescTransitKey is the schema descriptor for transit_key field.
+	fieldkeyDescTransitKey := fieldkeyFields[0].Descriptor()
+	// fieldkey.TransitKeyValidator is a validator for the "transit_key" field. It is called by the builders before save.
+	fieldkey.TransitKeyValidator = func() func(string) error {
+		validators := fieldkeyDescTransitKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(transit_key string) error {
+			for _, fn := range fns {
+				if err := fn(transit_key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fieldkeyDescWrappedKey is the schema descriptor for wrapped_key field.
+	fieldkeyDescWrappedKey := fieldkeyFields[1].Descriptor()
+	// fieldkey.WrappedKeyValidator is a validator for the "wrapped_key" field. It is called by the builders before save.
+	fieldkey.WrappedKeyValidator = fieldkeyDescWrappedKey.Validators[0].(func(string) error)
+	// fieldkeyDescID is the schema descriptor for id field.
+	fieldkeyDescID := fieldkeyMixinFields0[0].Descriptor()
+	// fieldkey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	fieldkey.IDValidator = fieldkeyDescID.Validators[0].(func(uint32) error)
 	folderMixin := schema.Folder{}.Mixin()
 	folder.Policy = privacy.NewPolicies(folderMixin[2], schema.Folder{})
 	folder.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -130,10 +399,124 @@ func init() {
 	folderDescDepth := folderFields[5].Descriptor()
 	// folder.DefaultDepth holds the default value on creation for the depth field.
 	folder.DefaultDepth = folderDescDepth.Default.(int32)
+	// folderDescSecretCount is the schema descriptor for secret_count field.
+	folderDescSecretCount := folderFields[7].Descriptor()
+	// folder.DefaultSecretCount holds the default value on creation for the secret_count field.
+	folder.DefaultSecretCount = folderDescSecretCount.Default.(int32)
+	// folderDescSubfolderCount is the schema descriptor for subfolder_count field.
+	folderDescSubfolderCount := folderFields[8].Descriptor()
+	// folder.DefaultSubfolderCount holds the default value on creation for the subfolder_count field.
+	folder.DefaultSubfolderCount = folderDescSubfolderCount.Default.(int32)
+	// folderDescRevision is the schema descriptor for revision field.
+	folderDescRevision := folderFields[9].Descriptor()
+	// folder.DefaultRevision holds the default value on creation for the revision field.
+	folder.DefaultRevision = folderDescRevision.Default.(int64)
+	// folderDescProtected is the schema descriptor for protected field.
+	folderDescProtected := folderFields[13].Descriptor()
+	// folder.DefaultProtected holds the default value on creation for the protected field.
+	folder.DefaultProtected = folderDescProtected.Default.(bool)
+	// folderDescLegalHold is the schema descriptor for legal_hold field.
+	folderDescLegalHold := folderFields[14].Descriptor()
+	// folder.DefaultLegalHold holds the default value on creation for the legal_hold field.
+	folder.DefaultLegalHold = folderDescLegalHold.Default.(bool)
+	// folderDescLegalHoldReason is the schema descriptor for legal_hold_reason field.
+	folderDescLegalHoldReason := folderFields[15].Descriptor()
+	// folder.LegalHoldReasonValidator is a validator for the "legal_hold_reason" field. It is called by the builders before save.
+	folder.LegalHoldReasonValidator = folderDescLegalHoldReason.Validators[0].(func(string) error)
+	// folderDescOriginalPath is the schema descriptor for original_path field.
+	folderDescOriginalPath := folderFields[20].Descriptor()
+	// folder.OriginalPathValidator is a validator for the "original_path" field. It is called by the builders before save.
+	folder.OriginalPathValidator = folderDescOriginalPath.Validators[0].(func(string) error)
 	// folderDescID is the schema descriptor for id field.
 	folderDescID := folderFields[0].Descriptor()
 	// folder.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	folder.IDValidator = folderDescID.Validators[0].(func(string) error)
+	folderchangelogMixin := schema.FolderChangeLog{}.Mixin()
+	folderchangelog.Policy = privacy.NewPolicies(folderchangelogMixin[2], schema.FolderChangeLog{})
+	folderchangelog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := folderchangelog.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	folderchangelogMixinFields0 := folderchangelogMixin[0].Fields()
+	_ = folderchangelogMixinFields0
+	folderchangelogMixinFields2 := folderchangelogMixin[2].Fields()
+	_ = folderchangelogMixinFields2
+	folderchangelogFields := schema.FolderChangeLog{}.Fields()
+	_ = folderchangelogFields
+	// folderchangelogDescTenantID is the schema descriptor for tenant_id field.
+	folderchangelogDescTenantID := folderchangelogMixinFields2[0].Descriptor()
+	// folderchangelog.DefaultTenantID holds the default value on creation for the tenant_id field.
+	folderchangelog.DefaultTenantID = folderchangelogDescTenantID.Default.(uint32)
+	// folderchangelogDescFolderID is the schema descriptor for folder_id field.
+	folderchangelogDescFolderID := folderchangelogFields[0].Descriptor()
+	// folderchangelog.FolderIDValidator is a validator for the "folder_id" field. It is called by the builders before save.
+	folderchangelog.FolderIDValidator = folderchangelogDescFolderID.Validators[0].(func(string) error)
+	// folderchangelogDescID is the schema descriptor for id field.
+	folderchangelogDescID := folderchangelogMixinFields0[0].Descriptor()
+	// folderchangelog.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	folderchangelog.IDValidator = folderchangelogDescID.Validators[0].(func(uint32) error)
+	pendingoperationMixin := schema.PendingOperation{}.Mixin()
+	pendingoperation.Policy = privacy.NewPolicies(pendingoperationMixin[2], schema.PendingOperation{})
+	pendingoperation.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := pendingoperation.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	pendingoperationMixinFields0 := pendingoperationMixin[0].Fields()
+	_ = pendingoperationMixinFields0
+	pendingoperationMixinFields2 := pendingoperationMixin[2].Fields()
+	_ = pendingoperationMixinFields2
+	pendingoperationFields := schema.PendingOperation{}.Fields()
+	_ = pendingoperationFields
+	// pendingoperationDescTenantID is the schema descriptor for tenant_id field.
+	pendingoperationDescTenantID := pendingoperationMixinFields2[0].Descriptor()
+	// pendingoperation.DefaultTenantID holds the default value on creation for the tenant_id field.
+	pendingoperation.DefaultTenantID = pendingoperationDescTenantID.Default.(uint32)
+	// pendingoperationDescSecretID is the schema descriptor for secret_id field.
+	pendingoperationDescSecretID := pendingoperationFields[1].Descriptor()
+	// pendingoperation.SecretIDValidator is a validator for the "secret_id" field. It is called by the builders before save.
+	pendingoperation.SecretIDValidator = pendingoperationDescSecretID.Validators[0].(func(string) error)
+	// pendingoperationDescVaultPath is the schema descriptor for vault_path field.
+	pendingoperationDescVaultPath := pendingoperationFields[2].Descriptor()
+	// pendingoperation.VaultPathValidator is a validator for the "vault_path" field. It is called by the builders before save.
+	pendingoperation.VaultPathValidator = func() func(string) error {
+		validators := pendingoperationDescVaultPath.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(vault_path string) error {
+			for _, fn := range fns {
+				if err := fn(vault_path); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// pendingoperationDescTotpPath is the schema descriptor for totp_path field.
+	pendingoperationDescTotpPath := pendingoperationFields[3].Descriptor()
+	// pendingoperation.TotpPathValidator is a validator for the "totp_path" field. It is called by the builders before save.
+	pendingoperation.TotpPathValidator = pendingoperationDescTotpPath.Validators[0].(func(string) error)
+	// pendingoperationDescAttempts is the schema descriptor for attempts field.
+	pendingoperationDescAttempts := pendingoperationFields[4].Descriptor()
+	// pendingoperation.DefaultAttempts holds the default value on creation for the attempts field.
+	pendingoperation.DefaultAttempts = pendingoperationDescAttempts.Default.(int32)
+	// pendingoperationDescLastError is the schema descriptor for last_error field.
+	pendingoperationDescLastError := pendingoperationFields[5].Descriptor()
+	// pendingoperation.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	pendingoperation.LastErrorValidator = pendingoperationDescLastError.Validators[0].(func(string) error)
+	// pendingoperationDescID is the schema descriptor for id field.
+	pendingoperationDescID := pendingoperationMixinFields0[0].Descriptor()
+	// pendingoperation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	pendingoperation.IDValidator = pendingoperationDescID.Validators[0].(func(uint32) error)
 	permissionMixin := schema.Permission{}.Mixin()
 	permission.Policy = privacy.NewPolicies(permissionMixin[1], schema.Permission{})
 	permission.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -248,10 +631,70 @@ func init() {
 	secretDescHasTotp := secretFields[10].Descriptor()
 	// secret.DefaultHasTotp holds the default value on creation for the has_totp field.
 	secret.DefaultHasTotp = secretDescHasTotp.Default.(bool)
+	// secretDescRevision is the schema descriptor for revision field.
+	secretDescRevision := secretFields[12].Descriptor()
+	// secret.DefaultRevision holds the default value on creation for the revision field.
+	secret.DefaultRevision = secretDescRevision.Default.(int64)
+	// secretDescSensitive is the schema descriptor for sensitive field.
+	secretDescSensitive := secretFields[13].Descriptor()
+	// secret.DefaultSensitive holds the default value on creation for the sensitive field.
+	secret.DefaultSensitive = secretDescSensitive.Default.(bool)
+	// secretDescCanary is the schema descriptor for canary field.
+	secretDescCanary := secretFields[15].Descriptor()
+	// secret.DefaultCanary holds the default value on creation for the canary field.
+	secret.DefaultCanary = secretDescCanary.Default.(bool)
+	// secretDescProtected is the schema descriptor for protected field.
+	secretDescProtected := secretFields[17].Descriptor()
+	// secret.DefaultProtected holds the default value on creation for the protected field.
+	secret.DefaultProtected = secretDescProtected.Default.(bool)
+	// secretDescFieldKeyID is the schema descriptor for field_key_id field.
+	secretDescFieldKeyID := secretFields[21].Descriptor()
+	// secret.DefaultFieldKeyID holds the default value on creation for the field_key_id field.
+	secret.DefaultFieldKeyID = secretDescFieldKeyID.Default.(uint32)
 	// secretDescID is the schema descriptor for id field.
 	secretDescID := secretFields[0].Descriptor()
 	// secret.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	secret.IDValidator = secretDescID.Validators[0].(func(string) error)
+	secretusageMixin := schema.SecretUsage{}.Mixin()
+	secretusage.Policy = privacy.NewPolicies(secretusageMixin[1], schema.SecretUsage{})
+	secretusage.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := secretusage.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	secretusageMixinFields0 := secretusageMixin[0].Fields()
+	_ = secretusageMixinFields0
+	secretusageMixinFields1 := secretusageMixin[1].Fields()
+	_ = secretusageMixinFields1
+	secretusageFields := schema.SecretUsage{}.Fields()
+	_ = secretusageFields
+	// secretusageDescTenantID is the schema descriptor for tenant_id field.
+	secretusageDescTenantID := secretusageMixinFields1[0].Descriptor()
+	// secretusage.DefaultTenantID holds the default value on creation for the tenant_id field.
+	secretusage.DefaultTenantID = secretusageDescTenantID.Default.(uint32)
+	// secretusageDescSecretID is the schema descriptor for secret_id field.
+	secretusageDescSecretID := secretusageFields[0].Descriptor()
+	// secretusage.SecretIDValidator is a validator for the "secret_id" field. It is called by the builders before save.
+	secretusage.SecretIDValidator = secretusageDescSecretID.Validators[0].(func(string) error)
+	// secretusageDescReads is the schema descriptor for reads field.
+	secretusageDescReads := secretusageFields[2].Descriptor()
+	// secretusage.DefaultReads holds the default value on creation for the reads field.
+	secretusage.DefaultReads = secretusageDescReads.Default.(int64)
+	// secretusage.ReadsValidator is a validator for the "reads" field. It is called by the builders before save.
+	secretusage.ReadsValidator = secretusageDescReads.Validators[0].(func(int64) error)
+	// secretusageDescWrites is the schema descriptor for writes field.
+	secretusageDescWrites := secretusageFields[3].Descriptor()
+	// secretusage.DefaultWrites holds the default value on creation for the writes field.
+	secretusage.DefaultWrites = secretusageDescWrites.Default.(int64)
+	// secretusage.WritesValidator is a validator for the "writes" field. It is called by the builders before save.
+	secretusage.WritesValidator = secretusageDescWrites.Validators[0].(func(int64) error)
+	// secretusageDescID is the schema descriptor for id field.
+	secretusageDescID := secretusageMixinFields0[0].Descriptor()
+	// secretusage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	secretusage.IDValidator = secretusageDescID.Validators[0].(func(uint32) error)
 	secretversionFields := schema.SecretVersion{}.Fields()
 	_ = secretversionFields
 	// secretversionDescSecretID is the schema descriptor for secret_id field.
@@ -288,6 +731,308 @@ func init() {
 			return nil
 		}
 	}()
+	// secretversionDescChecksumAlgorithm is the schema descriptor for checksum_algorithm field.
+	secretversionDescChecksumAlgorithm := secretversionFields[5].Descriptor()
+	// secretversion.DefaultChecksumAlgorithm holds the default value on creation for the checksum_algorithm field.
+	secretversion.DefaultChecksumAlgorithm = secretversionDescChecksumAlgorithm.Default.(string)
+	// secretversion.ChecksumAlgorithmValidator is a validator for the "checksum_algorithm" field. It is called by the builders before save.
+	secretversion.ChecksumAlgorithmValidator = secretversionDescChecksumAlgorithm.Validators[0].(func(string) error)
+	// secretversionDescSourceVersion is the schema descriptor for source_version field.
+	secretversionDescSourceVersion := secretversionFields[6].Descriptor()
+	// secretversion.SourceVersionValidator is a validator for the "source_version" field. It is called by the builders before save.
+	secretversion.SourceVersionValidator = secretversionDescSourceVersion.Validators[0].(func(int32) error)
+	securityalertMixin := schema.SecurityAlert{}.Mixin()
+	securityalert.Policy = privacy.NewPolicies(securityalertMixin[2], schema.SecurityAlert{})
+	securityalert.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := securityalert.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	securityalertMixinFields0 := securityalertMixin[0].Fields()
+	_ = securityalertMixinFields0
+	securityalertMixinFields2 := securityalertMixin[2].Fields()
+	_ = securityalertMixinFields2
+	securityalertFields := schema.SecurityAlert{}.Fields()
+	_ = securityalertFields
+	// securityalertDescTenantID is the schema descriptor for tenant_id field.
+	securityalertDescTenantID := securityalertMixinFields2[0].Descriptor()
+	// securityalert.DefaultTenantID holds the default value on creation for the tenant_id field.
+	securityalert.DefaultTenantID = securityalertDescTenantID.Default.(uint32)
+	// securityalertDescMessage is the schema descriptor for message field.
+	securityalertDescMessage := securityalertFields[4].Descriptor()
+	// securityalert.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	securityalert.MessageValidator = securityalertDescMessage.Validators[0].(func(string) error)
+	// securityalertDescAcknowledged is the schema descriptor for acknowledged field.
+	securityalertDescAcknowledged := securityalertFields[6].Descriptor()
+	// securityalert.DefaultAcknowledged holds the default value on creation for the acknowledged field.
+	securityalert.DefaultAcknowledged = securityalertDescAcknowledged.Default.(bool)
+	// securityalertDescID is the schema descriptor for id field.
+	securityalertDescID := securityalertMixinFields0[0].Descriptor()
+	// securityalert.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	securityalert.IDValidator = securityalertDescID.Validators[0].(func(uint32) error)
+	tenantsettingMixin := schema.TenantSetting{}.Mixin()
+	tenantsetting.Policy = privacy.NewPolicies(tenantsettingMixin[3], schema.TenantSetting{})
+	tenantsetting.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tenantsetting.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tenantsettingMixinFields0 := tenantsettingMixin[0].Fields()
+	_ = tenantsettingMixinFields0
+	tenantsettingMixinFields3 := tenantsettingMixin[3].Fields()
+	_ = tenantsettingMixinFields3
+	tenantsettingFields := schema.TenantSetting{}.Fields()
+	_ = tenantsettingFields
+	// tenantsettingDescTenantID is the schema descriptor for tenant_id field.
+	tenantsettingDescTenantID := tenantsettingMixinFields3[0].Descriptor()
+	// tenantsetting.DefaultTenantID holds the default value on creation for the tenant_id field.
+	tenantsetting.DefaultTenantID = tenantsettingDescTenantID.Default.(uint32)
+	// tenantsettingDescAuditRetentionDays is the schema descriptor for audit_retention_days field.
+	tenantsettingDescAuditRetentionDays := tenantsettingFields[0].Descriptor()
+	// tenantsetting.DefaultAuditRetentionDays holds the default value on creation for the audit_retention_days field.
+	tenantsetting.DefaultAuditRetentionDays = tenantsettingDescAuditRetentionDays.Default.(int32)
+	// tenantsetting.AuditRetentionDaysValidator is a validator for the "audit_retention_days" field. It is called by the builders before save.
+	tenantsetting.AuditRetentionDaysValidator = tenantsettingDescAuditRetentionDays.Validators[0].(func(int32) error)
+	// tenantsettingDescPasswordPolicyEnabled is the schema descriptor for password_policy_enabled field.
+	tenantsettingDescPasswordPolicyEnabled := tenantsettingFields[3].Descriptor()
+	// tenantsetting.DefaultPasswordPolicyEnabled holds the default value on creation for the password_policy_enabled field.
+	tenantsetting.DefaultPasswordPolicyEnabled = tenantsettingDescPasswordPolicyEnabled.Default.(bool)
+	// tenantsettingDescPasswordMinLength is the schema descriptor for password_min_length field.
+	tenantsettingDescPasswordMinLength := tenantsettingFields[4].Descriptor()
+	// tenantsetting.DefaultPasswordMinLength holds the default value on creation for the password_min_length field.
+	tenantsetting.DefaultPasswordMinLength = tenantsettingDescPasswordMinLength.Default.(int32)
+	// tenantsetting.PasswordMinLengthValidator is a validator for the "password_min_length" field. It is called by the builders before save.
+	tenantsetting.PasswordMinLengthValidator = tenantsettingDescPasswordMinLength.Validators[0].(func(int32) error)
+	// tenantsettingDescPasswordRequireLowercase is the schema descriptor for password_require_lowercase field.
+	tenantsettingDescPasswordRequireLowercase := tenantsettingFields[5].Descriptor()
+	// tenantsetting.DefaultPasswordRequireLowercase holds the default value on creation for the password_require_lowercase field.
+	tenantsetting.DefaultPasswordRequireLowercase = tenantsettingDescPasswordRequireLowercase.Default.(bool)
+	// tenantsettingDescPasswordRequireUppercase is the schema descriptor for password_require_uppercase field.
+	tenantsettingDescPasswordRequireUppercase := tenantsettingFields[6].Descriptor()
+	// tenantsetting.DefaultPasswordRequireUppercase holds the default value on creation for the password_require_uppercase field.
+	tenantsetting.DefaultPasswordRequireUppercase = tenantsettingDescPasswordRequireUppercase.Default.(bool)
+	// tenantsettingDescPasswordRequireDigit is the schema descriptor for password_require_digit field.
+	tenantsettingDescPasswordRequireDigit := tenantsettingFields[7].Descriptor()
+	// tenantsetting.DefaultPasswordRequireDigit holds the default value on creation for the password_require_digit field.
+	tenantsetting.DefaultPasswordRequireDigit = tenantsettingDescPasswordRequireDigit.Default.(bool)
+	// tenantsettingDescPasswordRequireSymbol is the schema descriptor for password_require_symbol field.
+	tenantsettingDescPasswordRequireSymbol := tenantsettingFields[8].Descriptor()
+	// tenantsetting.DefaultPasswordRequireSymbol holds the default value on creation for the password_require_symbol field.
+	tenantsetting.DefaultPasswordRequireSymbol = tenantsettingDescPasswordRequireSymbol.Default.(bool)
+	// tenantsettingDescPasswordMaxAgeDays is the schema descriptor for password_max_age_days field.
+	tenantsettingDescPasswordMaxAgeDays := tenantsettingFields[10].Descriptor()
+	// tenantsetting.DefaultPasswordMaxAgeDays holds the default value on creation for the password_max_age_days field.
+	tenantsetting.DefaultPasswordMaxAgeDays = tenantsettingDescPasswordMaxAgeDays.Default.(int32)
+	// tenantsetting.PasswordMaxAgeDaysValidator is a validator for the "password_max_age_days" field. It is called by the builders before save.
+	tenantsetting.PasswordMaxAgeDaysValidator = tenantsettingDescPasswordMaxAgeDays.Validators[0].(func(int32) error)
+	// tenantsettingDescPasswordHistorySize is the schema descriptor for password_history_size field.
+	tenantsettingDescPasswordHistorySize := tenantsettingFields[11].Descriptor()
+	// tenantsetting.DefaultPasswordHistorySize holds the default value on creation for the password_history_size field.
+	tenantsetting.DefaultPasswordHistorySize = tenantsettingDescPasswordHistorySize.Default.(int32)
+	// tenantsetting.PasswordHistorySizeValidator is a validator for the "password_history_size" field. It is called by the builders before save.
+	tenantsetting.PasswordHistorySizeValidator = tenantsettingDescPasswordHistorySize.Validators[0].(func(int32) error)
+	// tenantsettingDescVersionMaxCount is the schema descriptor for version_max_count field.
+	tenantsettingDescVersionMaxCount := tenantsettingFields[13].Descriptor()
+	// tenantsetting.DefaultVersionMaxCount holds the default value on creation for the version_max_count field.
+	tenantsetting.DefaultVersionMaxCount = tenantsettingDescVersionMaxCount.Default.(int32)
+	// tenantsetting.VersionMaxCountValidator is a validator for the "version_max_count" field. It is called by the builders before save.
+	tenantsetting.VersionMaxCountValidator = tenantsettingDescVersionMaxCount.Validators[0].(func(int32) error)
+	// tenantsettingDescVersionDeleteAfterDays is the schema descriptor for version_delete_after_days field.
+	tenantsettingDescVersionDeleteAfterDays := tenantsettingFields[14].Descriptor()
+	// tenantsetting.DefaultVersionDeleteAfterDays holds the default value on creation for the version_delete_after_days field.
+	tenantsetting.DefaultVersionDeleteAfterDays = tenantsettingDescVersionDeleteAfterDays.Default.(int32)
+	// tenantsetting.VersionDeleteAfterDaysValidator is a validator for the "version_delete_after_days" field. It is called by the builders before save.
+	tenantsetting.VersionDeleteAfterDaysValidator = tenantsettingDescVersionDeleteAfterDays.Validators[0].(func(int32) error)
+	// tenantsettingDescID is the schema descriptor for id field.
+	tenantsettingDescID := tenantsettingMixinFields0[0].Descriptor()
+	// tenantsetting.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenantsetting.IDValidator = tenantsettingDescID.Validators[0].(func(uint32) error)
+	versionpinMixin := schema.VersionPin{}.Mixin()
+	versionpin.Policy = privacy.NewPolicies(versionpinMixin[2], schema.VersionPin{})
+	versionpin.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := versionpin.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	versionpinMixinFields0 := versionpinMixin[0].Fields()
+	_ = versionpinMixinFields0
+	versionpinMixinFields2 := versionpinMixin[2].Fields()
+	_ = versionpinMixinFields2
+	versionpinFields := schema.VersionPin{}.Fields()
+	_ = versionpinFields
+	// versionpinDescTenantID is the schema descriptor for tenant_id field.
+	versionpinDescTenantID := versionpinMixinFields2[0].Descriptor()
+	// versionpin.DefaultTenantID holds the default value on creation for the tenant_id field.
+	versionpin.DefaultTenantID = versionpinDescTenantID.Default.(uint32)
+	// versionpinDescSecretID is the schema descriptor for secret_id field.
+	versionpinDescSecretID := versionpinFields[0].Descriptor()
+	// versionpin.SecretIDValidator is a validator for the "secret_id" field. It is called by the builders before save.
+	versionpin.SecretIDValidator = versionpinDescSecretID.Validators[0].(func(string) error)
+	// versionpinDescVersionNumber is the schema descriptor for version_number field.
+	versionpinDescVersionNumber := versionpinFields[1].Descriptor()
+	// versionpin.VersionNumberValidator is a validator for the "version_number" field. It is called by the builders before save.
+	versionpin.VersionNumberValidator = versionpinDescVersionNumber.Validators[0].(func(int32) error)
+	// versionpinDescTokenHash is the schema descriptor for token_hash field.
+	versionpinDescTokenHash := versionpinFields[2].Descriptor()
+	// versionpin.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	versionpin.TokenHashValidator = versionpinDescTokenHash.Validators[0].(func(string) error)
+	// versionpinDescConsumer is the schema descriptor for consumer field.
+	versionpinDescConsumer := versionpinFields[3].Descriptor()
+	// versionpin.ConsumerValidator is a validator for the "consumer" field. It is called by the builders before save.
+	versionpin.ConsumerValidator = versionpinDescConsumer.Validators[0].(func(string) error)
+	// versionpinDescID is the schema descriptor for id field.
+	versionpinDescID := versionpinMixinFields0[0].Descriptor()
+	// versionpin.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	versionpin.IDValidator = versionpinDescID.Validators[0].(func(uint32) error)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhook.Policy = privacy.NewPolicies(webhookMixin[4], schema.Webhook{})
+	webhook.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhook.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookMixinFields4 := webhookMixin[4].Fields()
+	_ = webhookMixinFields4
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescTenantID is the schema descriptor for tenant_id field.
+	webhookDescTenantID := webhookMixinFields4[0].Descriptor()
+	// webhook.DefaultTenantID holds the default value on creation for the tenant_id field.
+	webhook.DefaultTenantID = webhookDescTenantID.Default.(uint32)
+	// webhookDescName is the schema descriptor for name field.
+	webhookDescName := webhookFields[0].Descriptor()
+	// webhook.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhook.NameValidator = func() func(string) error {
+		validators := webhookDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[1].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = func() func(string) error {
+		validators := webhookDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[2].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[4].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	// webhookDescDescription is the schema descriptor for description field.
+	webhookDescDescription := webhookFields[5].Descriptor()
+	// webhook.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	webhook.DescriptionValidator = webhookDescDescription.Validators[0].(func(string) error)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookMixinFields0[0].Descriptor()
+	// webhook.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhook.IDValidator = webhookDescID.Validators[0].(func(uint32) error)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdelivery.Policy = privacy.NewPolicies(webhookdeliveryMixin[2], schema.WebhookDelivery{})
+	webhookdelivery.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhookdelivery.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryMixinFields2 := webhookdeliveryMixin[2].Fields()
+	_ = webhookdeliveryMixinFields2
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescTenantID is the schema descriptor for tenant_id field.
+	webhookdeliveryDescTenantID := webhookdeliveryMixinFields2[0].Descriptor()
+	// webhookdelivery.DefaultTenantID holds the default value on creation for the tenant_id field.
+	webhookdelivery.DefaultTenantID = webhookdeliveryDescTenantID.Default.(uint32)
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = func() func(string) error {
+		validators := webhookdeliveryDescEventID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_id string) error {
+			for _, fn := range fns {
+				if err := fn(event_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = func() func(string) error {
+		validators := webhookdeliveryDescEventType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_type string) error {
+			for _, fn := range fns {
+				if err := fn(event_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int32)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	webhookdelivery.LastErrorValidator = webhookdeliveryDescLastError.Validators[0].(func(string) error)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhookdelivery.IDValidator = webhookdeliveryDescID.Validators[0].(func(uint32) error)
 }
 
 const (
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// AuditChainHead holds the schema definition for the AuditChainHead entity.
+// It records the end of a tenant's audit hash chain. Appending an entry locks
+// the head row, so entries are chained one at a time across all instances.
+type AuditChainHead struct {
+	ent.Schema
+}
+
+// Annotations of the AuditChainHead.
+func (AuditChainHead) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_audit_chain_heads"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the AuditChainHead.
+func (AuditChainHead) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("tenant_id").
+			Unique().
+			Immutable().
+			Comment("Tenant of the chain (0 = entries without a tenant)"),
+
+		field.Int64("seq").
+			Default(0).
+			NonNegative().
+			Comment("Chain sequence of the last entry"),
+
+		field.String("hash").
+			Optional().
+			Comment("Chain hash of the last entry"),
+	}
+}
+
+// Edges of the AuditChainHead.
+func (AuditChainHead) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the AuditChainHead.
+func (AuditChainHead) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+	}
+}
@@ -82,6 +82,27 @@ func (AuditLog) Fields() []ent.Field {
 		field.JSON("metadata", map[string]string{}).
 			Optional().
 			Comment("Additional metadata"),
+		field.String("actor_id").
+			Optional().
+			Comment("User ID of the caller"),
+		field.String("event_type").
+			Optional().
+			Comment("Semantic domain event (e.g. secret.password_read)"),
+		field.String("resource_type").
+			Optional().
+			Comment("Type of the resource the event refers to"),
+		field.String("resource_id").
+			Optional().
+			Comment("ID of the resource the event refers to"),
+		field.Int64("chain_seq").
+			Default(0).
+			Comment("Per-tenant position in the hash chain (0 = not chained)"),
+		field.String("prev_hash").
+			Optional().
+			Comment("Chain hash of the previous entry of the same tenant"),
+		field.String("chain_hash").
+			Optional().
+			Comment("SHA-256 over the previous chain hash and this entry's content"),
 	}
 }
 
@@ -110,5 +131,9 @@ func (AuditLog) Indexes() []ent.Index {
 		index.Fields("client_id").StorageKey("warden_auditlog_client_id"),
 		index.Fields("success").StorageKey("warden_auditlog_success"),
 		index.Fields("peer_address").StorageKey("warden_auditlog_peer_address"),
+		index.Fields("tenant_id", "chain_seq").StorageKey("warden_auditlog_tenant_chain_seq"),
+		index.Fields("tenant_id", "event_type").StorageKey("warden_auditlog_tenant_event_type"),
+		index.Fields("tenant_id", "actor_id", "event_type").StorageKey("warden_auditlog_tenant_actor_event"),
+		index.Fields("tenant_id", "resource_id", "event_type").StorageKey("warden_auditlog_tenant_resource_event"),
 	}
 }
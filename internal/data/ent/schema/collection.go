@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Collection holds the schema definition for the Collection entity.
+// Collections are flat, shareable groupings of secrets. A secret belongs to
+// one folder but may be in any number of collections.
+type Collection struct {
+	ent.Schema
+}
+
+// Annotations of the Collection.
+func (Collection) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_collections"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the Collection.
+func (Collection) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Collection name"),
+
+		field.String("description").
+			Optional().
+			MaxLen(1024).
+			Comment("Optional description"),
+
+		field.String("external_id").
+			Optional().
+			MaxLen(255).
+			Comment("ID of the collection in the system it was imported from"),
+	}
+}
+
+// Edges of the Collection.
+func (Collection) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Secrets in this collection
+		edge.To("secrets", Secret.Type).
+			StorageKey(edge.Table("warden_collection_secrets"), edge.Columns("collection_id", "secret_id")).
+			Comment("Secrets in this collection"),
+	}
+}
+
+// Mixin of the Collection.
+func (Collection) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the Collection.
+func (Collection) Indexes() []ent.Index {
+	return []ent.Index{
+		// Unique constraint on tenant + name
+		index.Fields("tenant_id", "name").Unique(),
+		// For listing collections by tenant
+		index.Fields("tenant_id"),
+		// For matching imported collections
+		index.Fields("tenant_id", "external_id"),
+	}
+}
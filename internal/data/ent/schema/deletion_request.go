@@ -0,0 +1,105 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// DeletionRequest holds the schema definition for the DeletionRequest entity.
+// Permanently deleting a protected secret or folder takes a request by one
+// owner and the approval of a second.
+type DeletionRequest struct {
+	ent.Schema
+}
+
+// Annotations of the DeletionRequest.
+func (DeletionRequest) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_deletion_requests"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the DeletionRequest.
+func (DeletionRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("resource_type").
+			Values("DELETION_RESOURCE_TYPE_SECRET", "DELETION_RESOURCE_TYPE_FOLDER").
+			Comment("Kind of resource to delete"),
+
+		field.String("resource_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("Secret or folder to delete"),
+
+		field.String("resource_name").
+			Optional().
+			MaxLen(4096).
+			Comment("Secret name or folder path when the request was made"),
+
+		field.Bool("force").
+			Default(false).
+			Comment("Folder deletions: delete the folder with everything in it"),
+
+		field.String("reason").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the resource should be deleted"),
+
+		field.Enum("status").
+			Values(
+				"DELETION_REQUEST_STATUS_PENDING",
+				"DELETION_REQUEST_STATUS_APPROVED",
+				"DELETION_REQUEST_STATUS_REJECTED",
+				"DELETION_REQUEST_STATUS_WITHDRAWN",
+				"DELETION_REQUEST_STATUS_EXPIRED",
+			).
+			Default("DELETION_REQUEST_STATUS_PENDING").
+			Comment("Pending, or how the request was decided"),
+
+		field.String("requested_by").
+			NotEmpty().
+			MaxLen(36).
+			Comment("Owner who requested the deletion"),
+
+		field.String("decided_by").
+			Optional().
+			Nillable().
+			MaxLen(36).
+			Comment("Owner who approved or rejected the request"),
+
+		field.Time("decided_at").
+			Optional().
+			Nillable().
+			Comment("When the request was approved, rejected or withdrawn"),
+
+		field.Time("expires_at").
+			Comment("When a pending request can no longer be approved"),
+	}
+}
+
+// Edges of the DeletionRequest.
+func (DeletionRequest) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the DeletionRequest.
+func (DeletionRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the DeletionRequest.
+func (DeletionRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "resource_id", "status").StorageKey("warden_deletion_requests_resource"),
+		index.Fields("tenant_id", "status").StorageKey("warden_deletion_requests_tenant_status"),
+	}
+}
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// EmergencyAccess holds the schema definition for the EmergencyAccess entity.
+// A grantor names a trusted grantee who may request access to one of the
+// grantor's folders; unless the grantor rejects the request within the
+// waiting period, the grantee is granted VIEWER on the folder.
+type EmergencyAccess struct {
+	ent.Schema
+}
+
+// Annotations of the EmergencyAccess.
+func (EmergencyAccess) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_emergency_access"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the EmergencyAccess.
+func (EmergencyAccess) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("grantor_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("User whose folder can be accessed"),
+
+		field.String("grantee_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("Trusted user who can request access"),
+
+		field.String("folder_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("Folder the grantee receives VIEWER on"),
+
+		field.Int32("wait_days").
+			Positive().
+			Comment("Days a request waits for rejection before access is granted"),
+
+		field.Enum("status").
+			Values(
+				"EMERGENCY_ACCESS_STATUS_UNSPECIFIED",
+				"EMERGENCY_ACCESS_STATUS_IDLE",
+				"EMERGENCY_ACCESS_STATUS_REQUESTED",
+				"EMERGENCY_ACCESS_STATUS_GRANTED",
+			).
+			Default("EMERGENCY_ACCESS_STATUS_IDLE").
+			Comment("Idle, requested (waiting period running) or granted"),
+
+		field.Time("requested_at").
+			Optional().
+			Nillable().
+			Comment("When the pending request was made"),
+
+		field.Time("granted_at").
+			Optional().
+			Nillable().
+			Comment("When access was granted"),
+	}
+}
+
+// Edges of the EmergencyAccess.
+func (EmergencyAccess) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the EmergencyAccess.
+func (EmergencyAccess) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the EmergencyAccess.
+func (EmergencyAccess) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "grantor_id", "grantee_id").Unique().StorageKey("warden_emergency_access_pair"),
+		index.Fields("tenant_id", "grantee_id").StorageKey("warden_emergency_access_grantee"),
+		// For the job granting requests whose waiting period is over
+		index.Fields("status", "requested_at").StorageKey("warden_emergency_access_due"),
+	}
+}
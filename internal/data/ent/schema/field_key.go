@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// FieldKey holds the schema definition for the FieldKey entity.
+// A field key is a data key encrypting the username, URL and metadata of
+// secrets, stored wrapped by a Vault Transit key. The newest one encrypts new
+// values; older ones are kept to read what they encrypted.
+type FieldKey struct {
+	ent.Schema
+}
+
+// Annotations of the FieldKey.
+func (FieldKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_field_keys"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the FieldKey.
+func (FieldKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("transit_key").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Name of the Vault Transit key wrapping the data key"),
+
+		field.String("wrapped_key").
+			NotEmpty().
+			Sensitive().
+			Comment("Data key encrypted by the Transit key"),
+	}
+}
+
+// Edges of the FieldKey.
+func (FieldKey) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the FieldKey.
+func (FieldKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+	}
+}
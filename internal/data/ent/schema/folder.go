@@ -8,8 +8,17 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
 )
 
+// FolderDefaultPermission is a grant applied to every secret created in a folder
+type FolderDefaultPermission struct {
+	SubjectType string `json:"subject_type"`
+	SubjectID   string `json:"subject_id"`
+	Relation    string `json:"relation"`
+}
+
 // Folder holds the schema definition for the Folder entity.
 // Folders organize secrets in a hierarchical file-system-like structure.
 type Folder struct {
@@ -55,6 +64,73 @@ func (Folder) Fields() []ent.Field {
 		field.Int32("depth").
 			Default(0).
 			Comment("Nesting depth level (0 for root folders)"),
+
+		field.Time("last_accessed_time").
+			Optional().
+			Nillable().
+			Comment("When a password of a secret in this folder was last read"),
+
+		field.Int32("secret_count").
+			Default(0).
+			Comment("Number of non-deleted secrets directly in this folder (denormalized)"),
+
+		field.Int32("subfolder_count").
+			Default(0).
+			Comment("Number of direct child folders (denormalized)"),
+
+		field.Int64("revision").
+			Default(0).
+			Comment("Incremented on every change, for optimistic concurrency"),
+
+		field.JSON("metadata_schema", map[string]any{}).
+			Optional().
+			Comment("JSON Schema the metadata of secrets in this folder must satisfy"),
+
+		field.JSON("default_permissions", []FolderDefaultPermission{}).
+			Optional().
+			Comment("Permissions granted on every secret created in this folder"),
+
+		field.JSON("access_policy", &authz.AccessPolicy{}).
+			Optional().
+			Comment("Network and time restrictions on access to this folder and everything in it"),
+
+		field.Bool("protected").
+			Default(false).
+			Comment("Whether deleting this folder or anything in it permanently needs the approval of a second owner"),
+
+		field.Bool("legal_hold").
+			Default(false).
+			Comment("Whether this folder and everything in it is under legal hold and cannot be permanently deleted or destroyed"),
+
+		field.String("legal_hold_reason").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the legal hold was placed"),
+
+		field.Enum("status").
+			Values("FOLDER_STATUS_ACTIVE", "FOLDER_STATUS_DELETED").
+			Default("FOLDER_STATUS_ACTIVE").
+			Comment("Folder status; deleted folders are in the trash until restored or purged"),
+
+		field.Time("deleted_time").
+			Optional().
+			Nillable().
+			Comment("When the folder was moved to the trash"),
+
+		field.String("trashed_with_folder_id").
+			Optional().
+			Nillable().
+			Comment("The folder whose deletion moved this one to the trash (itself for the deleted folder)"),
+
+		field.String("original_parent_id").
+			Optional().
+			Nillable().
+			Comment("Parent of the deleted folder before it was moved to the trash"),
+
+		field.String("original_path").
+			Optional().
+			MaxLen(4096).
+			Comment("Path of the deleted folder before it was moved to the trash"),
 	}
 }
 
@@ -100,5 +176,12 @@ func (Folder) Indexes() []ent.Index {
 		index.Fields("parent_id"),
 		// For path-based queries
 		index.Fields("path"),
+		// For "recently created/updated/accessed" listings
+		index.Fields("tenant_id", "parent_id", "create_time"),
+		index.Fields("tenant_id", "parent_id", "update_time"),
+		index.Fields("tenant_id", "parent_id", "last_accessed_time"),
+		// For the folders trashed together and purging the trash
+		index.Fields("trashed_with_folder_id"),
+		index.Fields("status", "deleted_time"),
 	}
 }
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// FolderChangeLog holds the schema definition for the FolderChangeLog entity.
+// Entries record creations, renames, moves and deletions of folders and are
+// kept after the folder is gone.
+type FolderChangeLog struct {
+	ent.Schema
+}
+
+// Annotations of the FolderChangeLog.
+func (FolderChangeLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_folder_change_logs"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the FolderChangeLog.
+func (FolderChangeLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("folder_id").
+			NotEmpty().
+			Comment("Folder that changed"),
+
+		field.Enum("action").
+			Values("FOLDER_CHANGE_ACTION_CREATED", "FOLDER_CHANGE_ACTION_RENAMED", "FOLDER_CHANGE_ACTION_MOVED", "FOLDER_CHANGE_ACTION_DELETED", "FOLDER_CHANGE_ACTION_TRASHED", "FOLDER_CHANGE_ACTION_RESTORED").
+			Comment("Kind of change"),
+
+		field.String("old_path").
+			Optional().
+			Comment("Path before the change"),
+
+		field.String("new_path").
+			Optional().
+			Comment("Path after the change"),
+
+		field.String("old_parent_id").
+			Optional().
+			Nillable().
+			Comment("Parent folder before the change"),
+
+		field.String("new_parent_id").
+			Optional().
+			Nillable().
+			Comment("Parent folder after the change"),
+
+		field.String("actor_id").
+			Optional().
+			Comment("User who made the change"),
+
+		field.JSON("details", map[string]string{}).
+			Optional().
+			Comment("Extra details of the change"),
+	}
+}
+
+// Edges of the FolderChangeLog.
+func (FolderChangeLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the FolderChangeLog.
+func (FolderChangeLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the FolderChangeLog.
+func (FolderChangeLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "folder_id").StorageKey("warden_folder_change_logs_tenant_folder"),
+		index.Fields("tenant_id", "create_time").StorageKey("warden_folder_change_logs_tenant_time"),
+	}
+}
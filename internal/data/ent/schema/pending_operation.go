@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// PendingOperation holds the schema definition for the PendingOperation entity.
+// Each row is a Vault side effect that must follow a database change: written
+// before Vault data is created and removed in the transaction that references
+// it, or written in the transaction that drops the reference. Rows left behind
+// are carried out by the outbox worker.
+type PendingOperation struct {
+	ent.Schema
+}
+
+// Annotations of the PendingOperation.
+func (PendingOperation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_pending_operations"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the PendingOperation.
+func (PendingOperation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			Values("OPERATION_KIND_UNSPECIFIED", "OPERATION_KIND_DESTROY_VAULT_DATA").
+			Default("OPERATION_KIND_DESTROY_VAULT_DATA").
+			Comment("Operation to carry out"),
+
+		field.String("secret_id").
+			MaxLen(36).
+			Comment("Secret the Vault data belongs to"),
+
+		field.String("vault_path").
+			NotEmpty().
+			MaxLen(512).
+			Comment("Vault path of the password"),
+
+		field.String("totp_path").
+			Optional().
+			MaxLen(512).
+			Comment("Vault path of the TOTP secret, if any"),
+
+		field.Int32("attempts").
+			Default(0).
+			Comment("Number of failed attempts"),
+
+		field.String("last_error").
+			Optional().
+			MaxLen(1024).
+			Comment("Error of the last failed attempt"),
+
+		field.Time("next_attempt_at").
+			Comment("When the worker may carry out the operation"),
+	}
+}
+
+// Edges of the PendingOperation.
+func (PendingOperation) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the PendingOperation.
+func (PendingOperation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the PendingOperation.
+func (PendingOperation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("next_attempt_at").StorageKey("warden_pending_operations_next"),
+		index.Fields("vault_path").StorageKey("warden_pending_operations_vault_path"),
+	}
+}
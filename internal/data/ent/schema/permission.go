@@ -28,13 +28,13 @@ func (Permission) Annotations() []schema.Annotation {
 func (Permission) Fields() []ent.Field {
 	return []ent.Field{
 		field.Enum("resource_type").
-			Values("RESOURCE_TYPE_UNSPECIFIED", "RESOURCE_TYPE_FOLDER", "RESOURCE_TYPE_SECRET").
-			Comment("Type of resource (folder or secret)"),
+			Values("RESOURCE_TYPE_UNSPECIFIED", "RESOURCE_TYPE_FOLDER", "RESOURCE_TYPE_SECRET", "RESOURCE_TYPE_COLLECTION").
+			Comment("Type of resource (folder, secret or collection)"),
 
 		field.String("resource_id").
 			NotEmpty().
 			MaxLen(36).
-			Comment("ID of the folder or secret"),
+			Comment("ID of the folder, secret or collection"),
 
 		field.Enum("relation").
 			Values("RELATION_UNSPECIFIED", "RELATION_OWNER", "RELATION_EDITOR", "RELATION_VIEWER", "RELATION_SHARER").
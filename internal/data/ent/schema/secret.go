@@ -8,6 +8,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
 )
 
 // Secret holds the schema definition for the Secret entity.
@@ -42,14 +44,16 @@ func (Secret) Fields() []ent.Field {
 			MaxLen(255).
 			Comment("Secret name"),
 
+		// Username, URL and metadata are encrypted when field encryption is
+		// on, which needs room beyond the plaintext limits of the API
 		field.String("username").
 			Optional().
-			MaxLen(255).
+			MaxLen(512).
 			Comment("Associated username"),
 
 		field.String("host_url").
 			Optional().
-			MaxLen(2048).
+			MaxLen(4096).
 			Comment("Host/URL associated with the secret"),
 
 		field.String("vault_path").
@@ -77,6 +81,55 @@ func (Secret) Fields() []ent.Field {
 		field.Bool("has_totp").
 			Default(false).
 			Comment("Whether this secret has a TOTP authenticator configured"),
+
+		field.Time("last_accessed_time").
+			Optional().
+			Nillable().
+			Comment("When the password was last read"),
+
+		field.Int64("revision").
+			Default(0).
+			Comment("Incremented on every change, for optimistic concurrency"),
+
+		field.Bool("sensitive").
+			Default(false).
+			Comment("Whether password reads by non-owners raise secret.read webhook events"),
+
+		field.Enum("password_encoding").
+			Values("PASSWORD_ENCODING_TEXT", "PASSWORD_ENCODING_BASE64").
+			Default("PASSWORD_ENCODING_TEXT").
+			Comment("Whether the current password is text or base64-encoded binary"),
+
+		field.Bool("canary").
+			Default(false).
+			Comment("Decoy secret whose password reads raise a security alert"),
+
+		field.JSON("access_policy", &authz.AccessPolicy{}).
+			Optional().
+			Comment("Network and time restrictions on access to this secret"),
+
+		field.Bool("protected").
+			Default(false).
+			Comment("Whether permanent deletion needs the approval of a second owner"),
+
+		field.Strings("referenced_secret_ids").
+			Optional().
+			Comment("Secrets referenced from the metadata, kept in step with it"),
+
+		field.String("trashed_with_folder_id").
+			Optional().
+			Nillable().
+			Comment("The deleted folder that moved this secret to the trash, restored with it"),
+
+		field.Enum("status_before_trash").
+			Values("SECRET_STATUS_ACTIVE", "SECRET_STATUS_ARCHIVED").
+			Optional().
+			Nillable().
+			Comment("Status to restore when the folder that moved this secret to the trash is restored"),
+
+		field.Uint32("field_key_id").
+			Default(0).
+			Comment("Field key encrypting username, host_url and metadata (0 when stored in plaintext)"),
 	}
 }
 
@@ -97,6 +150,11 @@ func (Secret) Edges() []ent.Edge {
 		// Permissions on this secret
 		edge.To("permissions", Permission.Type).
 			Comment("Permissions on this secret"),
+
+		// Collections this secret is in
+		edge.From("collections", Collection.Type).
+			Ref("secrets").
+			Comment("Collections this secret is in"),
 	}
 }
 
@@ -127,5 +185,13 @@ func (Secret) Indexes() []ent.Index {
 		index.Fields("status"),
 		// For Vault path lookups
 		index.Fields("vault_path").Unique(),
+		// For "recently created/updated/accessed" listings
+		index.Fields("tenant_id", "folder_id", "create_time"),
+		index.Fields("tenant_id", "folder_id", "update_time"),
+		index.Fields("tenant_id", "folder_id", "last_accessed_time"),
+		// For restoring the secrets trashed with a folder
+		index.Fields("trashed_with_folder_id"),
+		// For re-encrypting the secrets of an older field key
+		index.Fields("field_key_id"),
 	}
 }
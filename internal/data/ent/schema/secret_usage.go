@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// SecretUsage holds the schema definition for the SecretUsage entity.
+// Each row counts the reads and writes of one secret on one UTC day, as
+// aggregated from the audit log by the usage aggregation job.
+type SecretUsage struct {
+	ent.Schema
+}
+
+// Annotations of the SecretUsage.
+func (SecretUsage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_secret_usage"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the SecretUsage.
+func (SecretUsage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("secret_id").
+			NotEmpty().
+			Comment("Secret the counts belong to"),
+
+		field.Time("day").
+			Comment("Midnight UTC of the counted day"),
+
+		field.Int64("reads").
+			Default(0).
+			NonNegative().
+			Comment("Password reads on the day"),
+
+		field.Int64("writes").
+			Default(0).
+			NonNegative().
+			Comment("Updates, password changes and version restores on the day"),
+	}
+}
+
+// Edges of the SecretUsage.
+func (SecretUsage) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the SecretUsage.
+func (SecretUsage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the SecretUsage.
+func (SecretUsage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "secret_id", "day").Unique().StorageKey("warden_secret_usage_secret_day"),
+		// For replacing a day when it is aggregated again
+		index.Fields("day").StorageKey("warden_secret_usage_day"),
+	}
+}
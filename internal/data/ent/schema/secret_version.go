@@ -47,7 +47,18 @@ func (SecretVersion) Fields() []ent.Field {
 		field.String("checksum").
 			NotEmpty().
 			MaxLen(64).
-			Comment("SHA-256 checksum of the password"),
+			Comment("Checksum of the password, made with checksum_algorithm"),
+
+		field.String("checksum_algorithm").
+			Default("sha256").
+			MaxLen(32).
+			Comment("Algorithm of the checksum: sha256, or hmac-sha256:<key id>"),
+
+		field.Int32("source_version").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Version this one was restored from, if created by a restore"),
 	}
 }
 
@@ -84,5 +95,7 @@ func (SecretVersion) Indexes() []ent.Index {
 		// one path, keyed by version number), so a unique constraint here
 		// makes the 2nd password update of any secret fail with a conflict.
 		index.Fields("vault_path"),
+		// For finding checksums to migrate to the current algorithm
+		index.Fields("checksum_algorithm"),
 	}
 }
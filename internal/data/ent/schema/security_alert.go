@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// SecurityAlert holds the schema definition for the SecurityAlert entity.
+// Alerts are raised by the anomaly detection job for unusual access patterns.
+type SecurityAlert struct {
+	ent.Schema
+}
+
+// Annotations of the SecurityAlert.
+func (SecurityAlert) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_security_alerts"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the SecurityAlert.
+func (SecurityAlert) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			Values("ALERT_KIND_UNSPECIFIED", "ALERT_KIND_EXCESSIVE_READS", "ALERT_KIND_NEW_PEER_ADDRESS", "ALERT_KIND_NEW_GEO_LOCATION", "ALERT_KIND_CANARY_READ").
+			Comment("Detected anomaly"),
+
+		field.Enum("severity").
+			Values("ALERT_SEVERITY_UNSPECIFIED", "ALERT_SEVERITY_LOW", "ALERT_SEVERITY_MEDIUM", "ALERT_SEVERITY_HIGH").
+			Default("ALERT_SEVERITY_MEDIUM").
+			Comment("Alert severity"),
+
+		field.String("user_id").
+			Optional().
+			Comment("User whose activity triggered the alert"),
+
+		field.String("resource_id").
+			Optional().
+			Comment("Secret involved, if the alert concerns a single secret"),
+
+		field.String("message").
+			NotEmpty().
+			Comment("Human-readable description"),
+
+		field.JSON("details", map[string]string{}).
+			Optional().
+			Comment("Detection details (counts, window, peer address, location)"),
+
+		field.Bool("acknowledged").
+			Default(false).
+			Comment("Whether an operator acknowledged the alert"),
+
+		field.Uint32("acknowledged_by").
+			Optional().
+			Nillable().
+			Comment("User ID who acknowledged the alert"),
+
+		field.Time("acknowledged_at").
+			Optional().
+			Nillable().
+			Comment("When the alert was acknowledged"),
+	}
+}
+
+// Edges of the SecurityAlert.
+func (SecurityAlert) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the SecurityAlert.
+func (SecurityAlert) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the SecurityAlert.
+func (SecurityAlert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "acknowledged").StorageKey("warden_security_alerts_tenant_ack"),
+		index.Fields("tenant_id", "user_id", "kind").StorageKey("warden_security_alerts_tenant_user_kind"),
+	}
+}
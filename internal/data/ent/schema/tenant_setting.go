@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// TenantSetting holds the schema definition for the TenantSetting entity.
+// It stores per-tenant overrides of deployment-wide Warden defaults.
+type TenantSetting struct {
+	ent.Schema
+}
+
+// Annotations of the TenantSetting.
+func (TenantSetting) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_tenant_settings"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the TenantSetting.
+func (TenantSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int32("audit_retention_days").
+			Default(0).
+			NonNegative().
+			Comment("Audit log retention in days (0 = deployment default)"),
+
+		field.Strings("export_excluded_tags").
+			Optional().
+			Comment("Secrets tagged with any of these tags are never exported"),
+
+		field.Strings("export_excluded_folder_ids").
+			Optional().
+			Comment("Folders whose subtrees are never exported"),
+
+		field.Bool("password_policy_enabled").
+			Default(false).
+			Comment("Whether new passwords are checked against the policy"),
+
+		field.Int32("password_min_length").
+			Default(0).
+			NonNegative().
+			Comment("Minimum password length (0 = no minimum)"),
+
+		field.Bool("password_require_lowercase").
+			Default(false).
+			Comment("Passwords must contain a lowercase letter"),
+
+		field.Bool("password_require_uppercase").
+			Default(false).
+			Comment("Passwords must contain an uppercase letter"),
+
+		field.Bool("password_require_digit").
+			Default(false).
+			Comment("Passwords must contain a digit"),
+
+		field.Bool("password_require_symbol").
+			Default(false).
+			Comment("Passwords must contain a symbol"),
+
+		field.Strings("password_banned_words").
+			Optional().
+			Comment("Words passwords must not contain (case-insensitive)"),
+
+		field.Int32("password_max_age_days").
+			Default(0).
+			NonNegative().
+			Comment("Days after which a password must be changed (0 = never)"),
+
+		field.Int32("password_history_size").
+			Default(0).
+			NonNegative().
+			Comment("Number of previous passwords of a secret that may not be reused"),
+
+		field.Strings("geo_allowed_countries").
+			Optional().
+			Comment("ISO 3166-1 alpha-2 countries passwords may be read from (empty = anywhere)"),
+
+		field.Int32("version_max_count").
+			Default(0).
+			NonNegative().
+			Comment("Password versions Vault keeps per secret (0 = mount default)"),
+
+		field.Int32("version_delete_after_days").
+			Default(0).
+			NonNegative().
+			Comment("Days after which Vault deletes password versions (0 = never)"),
+	}
+}
+
+// Edges of the TenantSetting.
+func (TenantSetting) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the TenantSetting.
+func (TenantSetting) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the TenantSetting.
+func (TenantSetting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id").Unique().StorageKey("warden_tenant_settings_tenant_id"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// VersionPin holds the schema definition for the VersionPin entity.
+// A pin holds an automation consumer on one version of a secret's password
+// until it advances or releases the pin, so rotations can tell which
+// consumers still read an old version.
+type VersionPin struct {
+	ent.Schema
+}
+
+// Annotations of the VersionPin.
+func (VersionPin) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_version_pins"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the VersionPin.
+func (VersionPin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("secret_id").
+			NotEmpty().
+			Comment("Pinned secret"),
+
+		field.Int32("version_number").
+			Positive().
+			Comment("Version the consumer is pinned to"),
+
+		field.String("token_hash").
+			NotEmpty().
+			Sensitive().
+			Comment("SHA-256 of the pin token, hex encoded"),
+
+		field.String("consumer").
+			NotEmpty().
+			Comment("User ID of the consumer that created the pin"),
+
+		field.Time("last_used_time").
+			Optional().
+			Nillable().
+			Comment("Last time the pin token was used to read the password"),
+	}
+}
+
+// Edges of the VersionPin.
+func (VersionPin) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the VersionPin.
+func (VersionPin) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the VersionPin.
+func (VersionPin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token_hash").Unique().StorageKey("warden_version_pins_token"),
+		index.Fields("tenant_id", "secret_id").StorageKey("warden_version_pins_tenant_secret"),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// Tenants register endpoints that receive signed notifications for Warden events.
+type Webhook struct {
+	ent.Schema
+}
+
+// Annotations of the Webhook.
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_webhooks"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Display name"),
+
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048).
+			Comment("Endpoint receiving the POST requests"),
+
+		field.String("secret").
+			Optional().
+			Sensitive().
+			MaxLen(255).
+			Comment("Shared secret used to HMAC-sign payloads"),
+
+		field.Strings("event_types").
+			Optional().
+			Comment("Subscribed event types (e.g. permission.granted)"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether deliveries are sent"),
+
+		field.String("description").
+			Optional().
+			MaxLen(1024).
+			Comment("Optional description"),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the Webhook.
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the Webhook.
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "enabled").StorageKey("warden_webhooks_tenant_enabled"),
+		index.Fields("tenant_id", "name").Unique().StorageKey("warden_webhooks_tenant_name"),
+	}
+}
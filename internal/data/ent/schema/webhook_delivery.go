@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// Each row is one event queued for one webhook, together with its delivery state.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Annotations of the WebhookDelivery.
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warden_webhook_deliveries"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("webhook_id").
+			Comment("Target webhook"),
+
+		field.String("event_id").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Event identifier (also used to avoid duplicate deliveries)"),
+
+		field.String("event_type").
+			NotEmpty().
+			MaxLen(64).
+			Comment("Event type"),
+
+		field.Text("payload").
+			Comment("JSON body sent to the endpoint"),
+
+		field.Enum("status").
+			Values("DELIVERY_STATUS_UNSPECIFIED", "DELIVERY_STATUS_PENDING", "DELIVERY_STATUS_DELIVERED", "DELIVERY_STATUS_FAILED").
+			Default("DELIVERY_STATUS_PENDING").
+			Comment("Delivery state"),
+
+		field.Int32("attempts").
+			Default(0).
+			Comment("Number of delivery attempts made"),
+
+		field.Int32("response_code").
+			Optional().
+			Nillable().
+			Comment("HTTP status of the last attempt"),
+
+		field.String("last_error").
+			Optional().
+			MaxLen(1024).
+			Comment("Error of the last failed attempt"),
+
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("When the next attempt is due (pending deliveries only)"),
+
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("When the endpoint acknowledged the event"),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the WebhookDelivery.
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "event_id").Unique().StorageKey("warden_webhook_deliveries_webhook_event"),
+		index.Fields("status", "next_attempt_at").StorageKey("warden_webhook_deliveries_status_next"),
+		index.Fields("webhook_id", "create_time").StorageKey("warden_webhook_deliveries_webhook_time"),
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
 )
@@ -52,6 +53,28 @@ type Secret struct {
 	Status secret.Status `json:"status,omitempty"`
 	// Whether this secret has a TOTP authenticator configured
 	HasTotp bool `json:"has_totp,omitempty"`
+	// When the password was last read
+	LastAccessedTime *time.Time `json:"last_accessed_time,omitempty"`
+	// Incremented on every change, for optimistic concurrency
+	Revision int64 `json:"revision,omitempty"`
+	// Whether password reads by non-owners raise secret.read webhook events
+	Sensitive bool `json:"sensitive,omitempty"`
+	// Whether the current password is text or base64-encoded binary
+	PasswordEncoding secret.PasswordEncoding `json:"password_encoding,omitempty"`
+	// Decoy secret whose password reads raise a security alert
+	Canary bool `json:"canary,omitempty"`
+	// Network and time restrictions on access to this secret
+	AccessPolicy *authz.AccessPolicy `json:"access_policy,omitempty"`
+	// Whether permanent deletion needs the approval of a second owner
+	Protected bool `json:"protected,omitempty"`
+	// Secrets referenced from the metadata, kept in step with it
+	ReferencedSecretIds []string `json:"referenced_secret_ids,omitempty"`
+	// The deleted folder that moved this secret to the trash, restored with it
+	TrashedWithFolderID *string `json:"trashed_with_folder_id,omitempty"`
+	// Status to restore when the folder that moved this secret to the trash is restored
+	StatusBeforeTrash *secret.StatusBeforeTrash `json:"status_before_trash,omitempty"`
+	// Field key encrypting username, host_url and metadata (0 when stored in plaintext)
+	FieldKeyID uint32 `json:"field_key_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SecretQuery when eager-loading is set.
 	Edges        SecretEdges `json:"edges"`
@@ -66,9 +89,11 @@ type SecretEdges struct {
 	Versions []*SecretVersion `json:"versions,omitempty"`
 	// Permissions on this secret
 	Permissions []*Permission `json:"permissions,omitempty"`
+	// Collections this secret is in
+	Collections []*Collection `json:"collections,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // FolderOrErr returns the Folder value or an error if the edge
@@ -100,20 +125,29 @@ func (e SecretEdges) PermissionsOrErr() ([]*Permission, error) {
 	return nil, &NotLoadedError{edge: "permissions"}
 }
 
+// CollectionsOrErr returns the Collections value or an error if the edge
+// was not loaded in eager-loading.
+func (e SecretEdges) CollectionsOrErr() ([]*Collection, error) {
+	if e.loadedTypes[3] {
+		return e.Collections, nil
+	}
+	return nil, &NotLoadedError{edge: "collections"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Secret) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case secret.FieldMetadata:
+		case secret.FieldMetadata, secret.FieldAccessPolicy, secret.FieldReferencedSecretIds:
 			values[i] = new([]byte)
-		case secret.FieldHasTotp:
+		case secret.FieldHasTotp, secret.FieldSensitive, secret.FieldCanary, secret.FieldProtected:
 			values[i] = new(sql.NullBool)
-		case secret.FieldCreateBy, secret.FieldUpdateBy, secret.FieldTenantID, secret.FieldCurrentVersion:
+		case secret.FieldCreateBy, secret.FieldUpdateBy, secret.FieldTenantID, secret.FieldCurrentVersion, secret.FieldRevision, secret.FieldFieldKeyID:
 			values[i] = new(sql.NullInt64)
-		case secret.FieldID, secret.FieldFolderID, secret.FieldName, secret.FieldUsername, secret.FieldHostURL, secret.FieldVaultPath, secret.FieldDescription, secret.FieldStatus:
+		case secret.FieldID, secret.FieldFolderID, secret.FieldName, secret.FieldUsername, secret.FieldHostURL, secret.FieldVaultPath, secret.FieldDescription, secret.FieldStatus, secret.FieldPasswordEncoding, secret.FieldTrashedWithFolderID, secret.FieldStatusBeforeTrash:
 			values[i] = new(sql.NullString)
-		case secret.FieldCreateTime, secret.FieldUpdateTime, secret.FieldDeleteTime:
+		case secret.FieldCreateTime, secret.FieldUpdateTime, secret.FieldDeleteTime, secret.FieldLastAccessedTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -241,6 +275,79 @@ func (_m *Secret) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.HasTotp = value.Bool
 			}
+		case secret.FieldLastAccessedTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_accessed_time", values[i])
+			} else if value.Valid {
+				_m.LastAccessedTime = new(time.Time)
+				*_m.LastAccessedTime = value.Time
+			}
+		case secret.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = value.Int64
+			}
+		case secret.FieldSensitive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sensitive", values[i])
+			} else if value.Valid {
+				_m.Sensitive = value.Bool
+			}
+		case secret.FieldPasswordEncoding:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_encoding", values[i])
+			} else if value.Valid {
+				_m.PasswordEncoding = secret.PasswordEncoding(value.String)
+			}
+		case secret.FieldCanary:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field canary", values[i])
+			} else if value.Valid {
+				_m.Canary = value.Bool
+			}
+		case secret.FieldAccessPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field access_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AccessPolicy); err != nil {
+					return fmt.Errorf("unmarshal field access_policy: %w", err)
+				}
+			}
+		case secret.FieldProtected:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field protected", values[i])
+			} else if value.Valid {
+				_m.Protected = value.Bool
+			}
+		case secret.FieldReferencedSecretIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field referenced_secret_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ReferencedSecretIds); err != nil {
+					return fmt.Errorf("unmarshal field referenced_secret_ids: %w", err)
+				}
+			}
+		case secret.FieldTrashedWithFolderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trashed_with_folder_id", values[i])
+			} else if value.Valid {
+				_m.TrashedWithFolderID = new(string)
+				*_m.TrashedWithFolderID = value.String
+			}
+		case secret.FieldStatusBeforeTrash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_before_trash", values[i])
+			} else if value.Valid {
+				_m.StatusBeforeTrash = new(secret.StatusBeforeTrash)
+				*_m.StatusBeforeTrash = secret.StatusBeforeTrash(value.String)
+			}
+		case secret.FieldFieldKeyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field field_key_id", values[i])
+			} else if value.Valid {
+				_m.FieldKeyID = uint32(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -269,6 +376,11 @@ func (_m *Secret) QueryPermissions() *PermissionQuery {
 	return NewSecretClient(_m.config).QueryPermissions(_m)
 }
 
+// QueryCollections queries the "collections" edge of the Secret entity.
+func (_m *Secret) QueryCollections() *CollectionQuery {
+	return NewSecretClient(_m.config).QueryCollections(_m)
+}
+
 // Update returns a builder for updating this Secret.
 // Note that you need to call Secret.Unwrap() before calling this method if this Secret
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -353,6 +465,45 @@ func (_m *Secret) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("has_totp=")
 	builder.WriteString(fmt.Sprintf("%v", _m.HasTotp))
+	builder.WriteString(", ")
+	if v := _m.LastAccessedTime; v != nil {
+		builder.WriteString("last_accessed_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("sensitive=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sensitive))
+	builder.WriteString(", ")
+	builder.WriteString("password_encoding=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PasswordEncoding))
+	builder.WriteString(", ")
+	builder.WriteString("canary=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Canary))
+	builder.WriteString(", ")
+	builder.WriteString("access_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccessPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("protected=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Protected))
+	builder.WriteString(", ")
+	builder.WriteString("referenced_secret_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReferencedSecretIds))
+	builder.WriteString(", ")
+	if v := _m.TrashedWithFolderID; v != nil {
+		builder.WriteString("trashed_with_folder_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.StatusBeforeTrash; v != nil {
+		builder.WriteString("status_before_trash=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("field_key_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FieldKeyID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -47,12 +47,36 @@ const (
 	FieldStatus = "status"
 	// FieldHasTotp holds the string denoting the has_totp field in the database.
 	FieldHasTotp = "has_totp"
+	// FieldLastAccessedTime holds the string denoting the last_accessed_time field in the database.
+	FieldLastAccessedTime = "last_accessed_time"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldSensitive holds the string denoting the sensitive field in the database.
+	FieldSensitive = "sensitive"
+	// FieldPasswordEncoding holds the string denoting the password_encoding field in the database.
+	FieldPasswordEncoding = "password_encoding"
+	// FieldCanary holds the string denoting the canary field in the database.
+	FieldCanary = "canary"
+	// FieldAccessPolicy holds the string denoting the access_policy field in the database.
+	FieldAccessPolicy = "access_policy"
+	// FieldProtected holds the string denoting the protected field in the database.
+	FieldProtected = "protected"
+	// FieldReferencedSecretIds holds the string denoting the referenced_secret_ids field in the database.
+	FieldReferencedSecretIds = "referenced_secret_ids"
+	// FieldTrashedWithFolderID holds the string denoting the trashed_with_folder_id field in the database.
+	FieldTrashedWithFolderID = "trashed_with_folder_id"
+	// FieldStatusBeforeTrash holds the string denoting the status_before_trash field in the database.
+	FieldStatusBeforeTrash = "status_before_trash"
+	// FieldFieldKeyID holds the string denoting the field_key_id field in the database.
+	FieldFieldKeyID = "field_key_id"
 	// EdgeFolder holds the string denoting the folder edge name in mutations.
 	EdgeFolder = "folder"
 	// EdgeVersions holds the string denoting the versions edge name in mutations.
 	EdgeVersions = "versions"
 	// EdgePermissions holds the string denoting the permissions edge name in mutations.
 	EdgePermissions = "permissions"
+	// EdgeCollections holds the string denoting the collections edge name in mutations.
+	EdgeCollections = "collections"
 	// Table holds the table name of the secret in the database.
 	Table = "warden_secrets"
 	// FolderTable is the table that holds the folder relation/edge.
@@ -76,6 +100,11 @@ const (
 	PermissionsInverseTable = "warden_permissions"
 	// PermissionsColumn is the table column denoting the permissions relation/edge.
 	PermissionsColumn = "secret_permissions"
+	// CollectionsTable is the table that holds the collections relation/edge. The primary key declared below.
+	CollectionsTable = "warden_collection_secrets"
+	// CollectionsInverseTable is the table name for the Collection entity.
+	// It exists in this package in order to avoid circular dependency with the "collection" package.
+	CollectionsInverseTable = "warden_collections"
 )
 
 // Columns holds all SQL columns for secret fields.
@@ -97,8 +126,25 @@ var Columns = []string{
 	FieldDescription,
 	FieldStatus,
 	FieldHasTotp,
+	FieldLastAccessedTime,
+	FieldRevision,
+	FieldSensitive,
+	FieldPasswordEncoding,
+	FieldCanary,
+	FieldAccessPolicy,
+	FieldProtected,
+	FieldReferencedSecretIds,
+	FieldTrashedWithFolderID,
+	FieldStatusBeforeTrash,
+	FieldFieldKeyID,
 }
 
+var (
+	// CollectionsPrimaryKey and CollectionsColumn2 are the table columns denoting the
+	// primary key for the collections relation (M2M).
+	CollectionsPrimaryKey = []string{"collection_id", "secret_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -133,6 +179,16 @@ var (
 	DescriptionValidator func(string) error
 	// DefaultHasTotp holds the default value on creation for the "has_totp" field.
 	DefaultHasTotp bool
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision int64
+	// DefaultSensitive holds the default value on creation for the "sensitive" field.
+	DefaultSensitive bool
+	// DefaultCanary holds the default value on creation for the "canary" field.
+	DefaultCanary bool
+	// DefaultProtected holds the default value on creation for the "protected" field.
+	DefaultProtected bool
+	// DefaultFieldKeyID holds the default value on creation for the "field_key_id" field.
+	DefaultFieldKeyID uint32
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -165,6 +221,55 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// PasswordEncoding defines the type for the "password_encoding" enum field.
+type PasswordEncoding string
+
+// PasswordEncodingPASSWORD_ENCODING_TEXT is the default value of the PasswordEncoding enum.
+const DefaultPasswordEncoding = PasswordEncodingPASSWORD_ENCODING_TEXT
+
+// PasswordEncoding values.
+const (
+	PasswordEncodingPASSWORD_ENCODING_TEXT   PasswordEncoding = "PASSWORD_ENCODING_TEXT"
+	PasswordEncodingPASSWORD_ENCODING_BASE64 PasswordEncoding = "PASSWORD_ENCODING_BASE64"
+)
+
+func (pe PasswordEncoding) String() string {
+	return string(pe)
+}
+
+// PasswordEncodingValidator is a validator for the "password_encoding" field enum values. It is called by the builders before save.
+func PasswordEncodingValidator(pe PasswordEncoding) error {
+	switch pe {
+	case PasswordEncodingPASSWORD_ENCODING_TEXT, PasswordEncodingPASSWORD_ENCODING_BASE64:
+		return nil
+	default:
+		return fmt.Errorf("secret: invalid enum value for password_encoding field: %q", pe)
+	}
+}
+
+// StatusBeforeTrash defines the type for the "status_before_trash" enum field.
+type StatusBeforeTrash string
+
+// StatusBeforeTrash values.
+const (
+	StatusBeforeTrashSECRET_STATUS_ACTIVE   StatusBeforeTrash = "SECRET_STATUS_ACTIVE"
+	StatusBeforeTrashSECRET_STATUS_ARCHIVED StatusBeforeTrash = "SECRET_STATUS_ARCHIVED"
+)
+
+func (sbt StatusBeforeTrash) String() string {
+	return string(sbt)
+}
+
+// StatusBeforeTrashValidator is a validator for the "status_before_trash" field enum values. It is called by the builders before save.
+func StatusBeforeTrashValidator(sbt StatusBeforeTrash) error {
+	switch sbt {
+	case StatusBeforeTrashSECRET_STATUS_ACTIVE, StatusBeforeTrashSECRET_STATUS_ARCHIVED:
+		return nil
+	default:
+		return fmt.Errorf("secret: invalid enum value for status_before_trash field: %q", sbt)
+	}
+}
+
 // OrderOption defines the ordering options for the Secret queries.
 type OrderOption func(*sql.Selector)
 
@@ -248,6 +353,51 @@ func ByHasTotp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHasTotp, opts...).ToFunc()
 }
 
+// ByLastAccessedTime orders the results by the last_accessed_time field.
+func ByLastAccessedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastAccessedTime, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// BySensitive orders the results by the sensitive field.
+func BySensitive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSensitive, opts...).ToFunc()
+}
+
+// ByPasswordEncoding orders the results by the password_encoding field.
+func ByPasswordEncoding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordEncoding, opts...).ToFunc()
+}
+
+// ByCanary orders the results by the canary field.
+func ByCanary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanary, opts...).ToFunc()
+}
+
+// ByProtected orders the results by the protected field.
+func ByProtected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProtected, opts...).ToFunc()
+}
+
+// ByTrashedWithFolderID orders the results by the trashed_with_folder_id field.
+func ByTrashedWithFolderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrashedWithFolderID, opts...).ToFunc()
+}
+
+// ByStatusBeforeTrash orders the results by the status_before_trash field.
+func ByStatusBeforeTrash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusBeforeTrash, opts...).ToFunc()
+}
+
+// ByFieldKeyID orders the results by the field_key_id field.
+func ByFieldKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFieldKeyID, opts...).ToFunc()
+}
+
 // ByFolderField orders the results by folder field.
 func ByFolderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -282,6 +432,20 @@ func ByPermissions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPermissionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCollectionsCount orders the results by collections count.
+func ByCollectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCollectionsStep(), opts...)
+	}
+}
+
+// ByCollections orders the results by collections terms.
+func ByCollections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCollectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newFolderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -303,3 +467,10 @@ func newPermissionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PermissionsTable, PermissionsColumn),
 	)
 }
+func newCollectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CollectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, CollectionsTable, CollectionsPrimaryKey...),
+	)
+}
@@ -135,6 +135,41 @@ func HasTotp(v bool) predicate.Secret {
 	return predicate.Secret(sql.FieldEQ(FieldHasTotp, v))
 }
 
+// LastAccessedTime applies equality check predicate on the "last_accessed_time" field. It's identical to LastAccessedTimeEQ.
+func LastAccessedTime(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldLastAccessedTime, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int64) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldRevision, v))
+}
+
+// Sensitive applies equality check predicate on the "sensitive" field. It's identical to SensitiveEQ.
+func Sensitive(v bool) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldSensitive, v))
+}
+
+// Canary applies equality check predicate on the "canary" field. It's identical to CanaryEQ.
+func Canary(v bool) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldCanary, v))
+}
+
+// Protected applies equality check predicate on the "protected" field. It's identical to ProtectedEQ.
+func Protected(v bool) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldProtected, v))
+}
+
+// TrashedWithFolderID applies equality check predicate on the "trashed_with_folder_id" field. It's identical to TrashedWithFolderIDEQ.
+func TrashedWithFolderID(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldTrashedWithFolderID, v))
+}
+
+// FieldKeyID applies equality check predicate on the "field_key_id" field. It's identical to FieldKeyIDEQ.
+func FieldKeyID(v uint32) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldFieldKeyID, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.Secret {
 	return predicate.Secret(sql.FieldEQ(FieldCreateBy, v))
@@ -945,6 +980,311 @@ func HasTotpNEQ(v bool) predicate.Secret {
 	return predicate.Secret(sql.FieldNEQ(FieldHasTotp, v))
 }
 
+// LastAccessedTimeEQ applies the EQ predicate on the "last_accessed_time" field.
+func LastAccessedTimeEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeNEQ applies the NEQ predicate on the "last_accessed_time" field.
+func LastAccessedTimeNEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeIn applies the In predicate on the "last_accessed_time" field.
+func LastAccessedTimeIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldLastAccessedTime, vs...))
+}
+
+// LastAccessedTimeNotIn applies the NotIn predicate on the "last_accessed_time" field.
+func LastAccessedTimeNotIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldLastAccessedTime, vs...))
+}
+
+// LastAccessedTimeGT applies the GT predicate on the "last_accessed_time" field.
+func LastAccessedTimeGT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeGTE applies the GTE predicate on the "last_accessed_time" field.
+func LastAccessedTimeGTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeLT applies the LT predicate on the "last_accessed_time" field.
+func LastAccessedTimeLT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeLTE applies the LTE predicate on the "last_accessed_time" field.
+func LastAccessedTimeLTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldLastAccessedTime, v))
+}
+
+// LastAccessedTimeIsNil applies the IsNil predicate on the "last_accessed_time" field.
+func LastAccessedTimeIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldLastAccessedTime))
+}
+
+// LastAccessedTimeNotNil applies the NotNil predicate on the "last_accessed_time" field.
+func LastAccessedTimeNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldLastAccessedTime))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int64) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int64) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int64) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int64) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int64) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int64) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int64) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int64) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldRevision, v))
+}
+
+// SensitiveEQ applies the EQ predicate on the "sensitive" field.
+func SensitiveEQ(v bool) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldSensitive, v))
+}
+
+// SensitiveNEQ applies the NEQ predicate on the "sensitive" field.
+func SensitiveNEQ(v bool) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldSensitive, v))
+}
+
+// PasswordEncodingEQ applies the EQ predicate on the "password_encoding" field.
+func PasswordEncodingEQ(v PasswordEncoding) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldPasswordEncoding, v))
+}
+
+// PasswordEncodingNEQ applies the NEQ predicate on the "password_encoding" field.
+func PasswordEncodingNEQ(v PasswordEncoding) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldPasswordEncoding, v))
+}
+
+// PasswordEncodingIn applies the In predicate on the "password_encoding" field.
+func PasswordEncodingIn(vs ...PasswordEncoding) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldPasswordEncoding, vs...))
+}
+
+// PasswordEncodingNotIn applies the NotIn predicate on the "password_encoding" field.
+func PasswordEncodingNotIn(vs ...PasswordEncoding) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldPasswordEncoding, vs...))
+}
+
+// CanaryEQ applies the EQ predicate on the "canary" field.
+func CanaryEQ(v bool) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldCanary, v))
+}
+
+// CanaryNEQ applies the NEQ predicate on the "canary" field.
+func CanaryNEQ(v bool) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldCanary, v))
+}
+
+// AccessPolicyIsNil applies the IsNil predicate on the "access_policy" field.
+func AccessPolicyIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldAccessPolicy))
+}
+
+// AccessPolicyNotNil applies the NotNil predicate on the "access_policy" field.
+func AccessPolicyNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldAccessPolicy))
+}
+
+// ProtectedEQ applies the EQ predicate on the "protected" field.
+func ProtectedEQ(v bool) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldProtected, v))
+}
+
+// ProtectedNEQ applies the NEQ predicate on the "protected" field.
+func ProtectedNEQ(v bool) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldProtected, v))
+}
+
+// ReferencedSecretIdsIsNil applies the IsNil predicate on the "referenced_secret_ids" field.
+func ReferencedSecretIdsIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldReferencedSecretIds))
+}
+
+// ReferencedSecretIdsNotNil applies the NotNil predicate on the "referenced_secret_ids" field.
+func ReferencedSecretIdsNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldReferencedSecretIds))
+}
+
+// TrashedWithFolderIDEQ applies the EQ predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDNEQ applies the NEQ predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDNEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDIn applies the In predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldTrashedWithFolderID, vs...))
+}
+
+// TrashedWithFolderIDNotIn applies the NotIn predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDNotIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldTrashedWithFolderID, vs...))
+}
+
+// TrashedWithFolderIDGT applies the GT predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDGT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDGTE applies the GTE predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDGTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDLT applies the LT predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDLT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDLTE applies the LTE predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDLTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDContains applies the Contains predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDContains(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContains(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDHasPrefix applies the HasPrefix predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDHasPrefix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasPrefix(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDHasSuffix applies the HasSuffix predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDHasSuffix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasSuffix(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDIsNil applies the IsNil predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldTrashedWithFolderID))
+}
+
+// TrashedWithFolderIDNotNil applies the NotNil predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldTrashedWithFolderID))
+}
+
+// TrashedWithFolderIDEqualFold applies the EqualFold predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDEqualFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEqualFold(FieldTrashedWithFolderID, v))
+}
+
+// TrashedWithFolderIDContainsFold applies the ContainsFold predicate on the "trashed_with_folder_id" field.
+func TrashedWithFolderIDContainsFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContainsFold(FieldTrashedWithFolderID, v))
+}
+
+// StatusBeforeTrashEQ applies the EQ predicate on the "status_before_trash" field.
+func StatusBeforeTrashEQ(v StatusBeforeTrash) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldStatusBeforeTrash, v))
+}
+
+// StatusBeforeTrashNEQ applies the NEQ predicate on the "status_before_trash" field.
+func StatusBeforeTrashNEQ(v StatusBeforeTrash) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldStatusBeforeTrash, v))
+}
+
+// StatusBeforeTrashIn applies the In predicate on the "status_before_trash" field.
+func StatusBeforeTrashIn(vs ...StatusBeforeTrash) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldStatusBeforeTrash, vs...))
+}
+
+// StatusBeforeTrashNotIn applies the NotIn predicate on the "status_before_trash" field.
+func StatusBeforeTrashNotIn(vs ...StatusBeforeTrash) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldStatusBeforeTrash, vs...))
+}
+
+// StatusBeforeTrashIsNil applies the IsNil predicate on the "status_before_trash" field.
+func StatusBeforeTrashIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldStatusBeforeTrash))
+}
+
+// StatusBeforeTrashNotNil applies the NotNil predicate on the "status_before_trash" field.
+func StatusBeforeTrashNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldStatusBeforeTrash))
+}
+
+// FieldKeyIDEQ applies the EQ predicate on the "field_key_id" field.
+func FieldKeyIDEQ(v uint32) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldFieldKeyID, v))
+}
+
+// FieldKeyIDNEQ applies the NEQ predicate on the "field_key_id" field.
+func FieldKeyIDNEQ(v uint32) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldFieldKeyID, v))
+}
+
+// FieldKeyIDIn applies the In predicate on the "field_key_id" field.
+func FieldKeyIDIn(vs ...uint32) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldFieldKeyID, vs...))
+}
+
+// FieldKeyIDNotIn applies the NotIn predicate on the "field_key_id" field.
+func FieldKeyIDNotIn(vs ...uint32) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldFieldKeyID, vs...))
+}
+
+// FieldKeyIDGT applies the GT predicate on the "field_key_id" field.
+func FieldKeyIDGT(v uint32) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldFieldKeyID, v))
+}
+
+// FieldKeyIDGTE applies the GTE predicate on the "field_key_id" field.
+func FieldKeyIDGTE(v uint32) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldFieldKeyID, v))
+}
+
+// FieldKeyIDLT applies the LT predicate on the "field_key_id" field.
+func FieldKeyIDLT(v uint32) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldFieldKeyID, v))
+}
+
+// FieldKeyIDLTE applies the LTE predicate on the "field_key_id" field.
+func FieldKeyIDLTE(v uint32) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldFieldKeyID, v))
+}
+
 // HasFolder applies the HasEdge predicate on the "folder" edge.
 func HasFolder() predicate.Secret {
 	return predicate.Secret(func(s *sql.Selector) {
@@ -1014,6 +1354,29 @@ func HasPermissionsWith(preds ...predicate.Permission) predicate.Secret {
 	})
 }
 
+// HasCollections applies the HasEdge predicate on the "collections" edge.
+func HasCollections() predicate.Secret {
+	return predicate.Secret(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, CollectionsTable, CollectionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollectionsWith applies the HasEdge predicate on the "collections" edge with a given conditions (other predicates).
+func HasCollectionsWith(preds ...predicate.Collection) predicate.Secret {
+	return predicate.Secret(func(s *sql.Selector) {
+		step := newCollectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Secret) predicate.Secret {
 	return predicate.Secret(sql.AndPredicates(predicates...))
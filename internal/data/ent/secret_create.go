@@ -8,8 +8,12 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/collection"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
@@ -21,6 +25,7 @@ type SecretCreate struct {
 	config
 	mutation *SecretMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateBy sets the "create_by" field.
@@ -223,6 +228,144 @@ func (_c *SecretCreate) SetNillableHasTotp(v *bool) *SecretCreate {
 	return _c
 }
 
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (_c *SecretCreate) SetLastAccessedTime(v time.Time) *SecretCreate {
+	_c.mutation.SetLastAccessedTime(v)
+	return _c
+}
+
+// SetNillableLastAccessedTime sets the "last_accessed_time" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableLastAccessedTime(v *time.Time) *SecretCreate {
+	if v != nil {
+		_c.SetLastAccessedTime(*v)
+	}
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *SecretCreate) SetRevision(v int64) *SecretCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableRevision(v *int64) *SecretCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
+// SetSensitive sets the "sensitive" field.
+func (_c *SecretCreate) SetSensitive(v bool) *SecretCreate {
+	_c.mutation.SetSensitive(v)
+	return _c
+}
+
+// SetNillableSensitive sets the "sensitive" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableSensitive(v *bool) *SecretCreate {
+	if v != nil {
+		_c.SetSensitive(*v)
+	}
+	return _c
+}
+
+// SetPasswordEncoding sets the "password_encoding" field.
+func (_c *SecretCreate) SetPasswordEncoding(v secret.PasswordEncoding) *SecretCreate {
+	_c.mutation.SetPasswordEncoding(v)
+	return _c
+}
+
+// SetNillablePasswordEncoding sets the "password_encoding" field if the given value is not nil.
+func (_c *SecretCreate) SetNillablePasswordEncoding(v *secret.PasswordEncoding) *SecretCreate {
+	if v != nil {
+		_c.SetPasswordEncoding(*v)
+	}
+	return _c
+}
+
+// SetCanary sets the "canary" field.
+func (_c *SecretCreate) SetCanary(v bool) *SecretCreate {
+	_c.mutation.SetCanary(v)
+	return _c
+}
+
+// SetNillableCanary sets the "canary" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableCanary(v *bool) *SecretCreate {
+	if v != nil {
+		_c.SetCanary(*v)
+	}
+	return _c
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (_c *SecretCreate) SetAccessPolicy(v *authz.AccessPolicy) *SecretCreate {
+	_c.mutation.SetAccessPolicy(v)
+	return _c
+}
+
+// SetProtected sets the "protected" field.
+func (_c *SecretCreate) SetProtected(v bool) *SecretCreate {
+	_c.mutation.SetProtected(v)
+	return _c
+}
+
+// SetNillableProtected sets the "protected" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableProtected(v *bool) *SecretCreate {
+	if v != nil {
+		_c.SetProtected(*v)
+	}
+	return _c
+}
+
+// SetReferencedSecretIds sets the "referenced_secret_ids" field.
+func (_c *SecretCreate) SetReferencedSecretIds(v []string) *SecretCreate {
+	_c.mutation.SetReferencedSecretIds(v)
+	return _c
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (_c *SecretCreate) SetTrashedWithFolderID(v string) *SecretCreate {
+	_c.mutation.SetTrashedWithFolderID(v)
+	return _c
+}
+
+// SetNillableTrashedWithFolderID sets the "trashed_with_folder_id" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableTrashedWithFolderID(v *string) *SecretCreate {
+	if v != nil {
+		_c.SetTrashedWithFolderID(*v)
+	}
+	return _c
+}
+
+// SetStatusBeforeTrash sets the "status_before_trash" field.
+func (_c *SecretCreate) SetStatusBeforeTrash(v secret.StatusBeforeTrash) *SecretCreate {
+	_c.mutation.SetStatusBeforeTrash(v)
+	return _c
+}
+
+// SetNillableStatusBeforeTrash sets the "status_before_trash" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableStatusBeforeTrash(v *secret.StatusBeforeTrash) *SecretCreate {
+	if v != nil {
+		_c.SetStatusBeforeTrash(*v)
+	}
+	return _c
+}
+
+// SetFieldKeyID sets the "field_key_id" field.
+func (_c *SecretCreate) SetFieldKeyID(v uint32) *SecretCreate {
+	_c.mutation.SetFieldKeyID(v)
+	return _c
+}
+
+// SetNillableFieldKeyID sets the "field_key_id" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableFieldKeyID(v *uint32) *SecretCreate {
+	if v != nil {
+		_c.SetFieldKeyID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *SecretCreate) SetID(v string) *SecretCreate {
 	_c.mutation.SetID(v)
@@ -264,6 +407,21 @@ func (_c *SecretCreate) AddPermissions(v ...*Permission) *SecretCreate {
 	return _c.AddPermissionIDs(ids...)
 }
 
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (_c *SecretCreate) AddCollectionIDs(ids ...string) *SecretCreate {
+	_c.mutation.AddCollectionIDs(ids...)
+	return _c
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (_c *SecretCreate) AddCollections(v ...*Collection) *SecretCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the SecretMutation object of the builder.
 func (_c *SecretCreate) Mutation() *SecretMutation {
 	return _c.mutation
@@ -317,6 +475,30 @@ func (_c *SecretCreate) defaults() error {
 		v := secret.DefaultHasTotp
 		_c.mutation.SetHasTotp(v)
 	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		v := secret.DefaultRevision
+		_c.mutation.SetRevision(v)
+	}
+	if _, ok := _c.mutation.Sensitive(); !ok {
+		v := secret.DefaultSensitive
+		_c.mutation.SetSensitive(v)
+	}
+	if _, ok := _c.mutation.PasswordEncoding(); !ok {
+		v := secret.DefaultPasswordEncoding
+		_c.mutation.SetPasswordEncoding(v)
+	}
+	if _, ok := _c.mutation.Canary(); !ok {
+		v := secret.DefaultCanary
+		_c.mutation.SetCanary(v)
+	}
+	if _, ok := _c.mutation.Protected(); !ok {
+		v := secret.DefaultProtected
+		_c.mutation.SetProtected(v)
+	}
+	if _, ok := _c.mutation.FieldKeyID(); !ok {
+		v := secret.DefaultFieldKeyID
+		_c.mutation.SetFieldKeyID(v)
+	}
 	return nil
 }
 
@@ -367,6 +549,39 @@ func (_c *SecretCreate) check() error {
 	if _, ok := _c.mutation.HasTotp(); !ok {
 		return &ValidationError{Name: "has_totp", err: errors.New(`ent: missing required field "Secret.has_totp"`)}
 	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "Secret.revision"`)}
+	}
+	if _, ok := _c.mutation.Sensitive(); !ok {
+		return &ValidationError{Name: "sensitive", err: errors.New(`ent: missing required field "Secret.sensitive"`)}
+	}
+	if _, ok := _c.mutation.PasswordEncoding(); !ok {
+		return &ValidationError{Name: "password_encoding", err: errors.New(`ent: missing required field "Secret.password_encoding"`)}
+	}
+	if v, ok := _c.mutation.PasswordEncoding(); ok {
+		if err := secret.PasswordEncodingValidator(v); err != nil {
+			return &ValidationError{Name: "password_encoding", err: fmt.Errorf(`ent: validator failed for field "Secret.password_encoding": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Canary(); !ok {
+		return &ValidationError{Name: "canary", err: errors.New(`ent: missing required field "Secret.canary"`)}
+	}
+	if v, ok := _c.mutation.AccessPolicy(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "access_policy", err: fmt.Errorf(`ent: validator failed for field "Secret.access_policy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Protected(); !ok {
+		return &ValidationError{Name: "protected", err: errors.New(`ent: missing required field "Secret.protected"`)}
+	}
+	if v, ok := _c.mutation.StatusBeforeTrash(); ok {
+		if err := secret.StatusBeforeTrashValidator(v); err != nil {
+			return &ValidationError{Name: "status_before_trash", err: fmt.Errorf(`ent: validator failed for field "Secret.status_before_trash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FieldKeyID(); !ok {
+		return &ValidationError{Name: "field_key_id", err: errors.New(`ent: missing required field "Secret.field_key_id"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := secret.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Secret.id": %w`, err)}
@@ -403,6 +618,7 @@ func (_c *SecretCreate) createSpec() (*Secret, *sqlgraph.CreateSpec) {
 		_node = &Secret{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(secret.Table, sqlgraph.NewFieldSpec(secret.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -467,6 +683,50 @@ func (_c *SecretCreate) createSpec() (*Secret, *sqlgraph.CreateSpec) {
 		_spec.SetField(secret.FieldHasTotp, field.TypeBool, value)
 		_node.HasTotp = value
 	}
+	if value, ok := _c.mutation.LastAccessedTime(); ok {
+		_spec.SetField(secret.FieldLastAccessedTime, field.TypeTime, value)
+		_node.LastAccessedTime = &value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(secret.FieldRevision, field.TypeInt64, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.Sensitive(); ok {
+		_spec.SetField(secret.FieldSensitive, field.TypeBool, value)
+		_node.Sensitive = value
+	}
+	if value, ok := _c.mutation.PasswordEncoding(); ok {
+		_spec.SetField(secret.FieldPasswordEncoding, field.TypeEnum, value)
+		_node.PasswordEncoding = value
+	}
+	if value, ok := _c.mutation.Canary(); ok {
+		_spec.SetField(secret.FieldCanary, field.TypeBool, value)
+		_node.Canary = value
+	}
+	if value, ok := _c.mutation.AccessPolicy(); ok {
+		_spec.SetField(secret.FieldAccessPolicy, field.TypeJSON, value)
+		_node.AccessPolicy = value
+	}
+	if value, ok := _c.mutation.Protected(); ok {
+		_spec.SetField(secret.FieldProtected, field.TypeBool, value)
+		_node.Protected = value
+	}
+	if value, ok := _c.mutation.ReferencedSecretIds(); ok {
+		_spec.SetField(secret.FieldReferencedSecretIds, field.TypeJSON, value)
+		_node.ReferencedSecretIds = value
+	}
+	if value, ok := _c.mutation.TrashedWithFolderID(); ok {
+		_spec.SetField(secret.FieldTrashedWithFolderID, field.TypeString, value)
+		_node.TrashedWithFolderID = &value
+	}
+	if value, ok := _c.mutation.StatusBeforeTrash(); ok {
+		_spec.SetField(secret.FieldStatusBeforeTrash, field.TypeEnum, value)
+		_node.StatusBeforeTrash = &value
+	}
+	if value, ok := _c.mutation.FieldKeyID(); ok {
+		_spec.SetField(secret.FieldFieldKeyID, field.TypeUint32, value)
+		_node.FieldKeyID = value
+	}
 	if nodes := _c.mutation.FolderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -516,89 +776,1748 @@ func (_c *SecretCreate) createSpec() (*Secret, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   secret.CollectionsTable,
+			Columns: secret.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
-// SecretCreateBulk is the builder for creating many Secret entities in bulk.
-type SecretCreateBulk struct {
-	config
-	err      error
-	builders []*SecretCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Secret.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecretUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SecretCreate) OnConflict(opts ...sql.ConflictOption) *SecretUpsertOne {
+	_c.conflict = opts
+	return &SecretUpsertOne{
+		create: _c,
+	}
 }
 
-// Save creates the Secret entities in the database.
-func (_c *SecretCreateBulk) Save(ctx context.Context) ([]*Secret, error) {
-	if _c.err != nil {
-		return nil, _c.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SecretCreate) OnConflictColumns(columns ...string) *SecretUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SecretUpsertOne{
+		create: _c,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Secret, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*SecretMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// SecretUpsertOne is the builder for "upsert"-ing
+	//  one Secret node.
+	SecretUpsertOne struct {
+		create *SecretCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// SecretUpsert is the "OnConflict" setter.
+	SecretUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *SecretUpsert) SetCreateBy(v uint32) *SecretUpsert {
+	u.Set(secret.FieldCreateBy, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *SecretCreateBulk) SaveX(ctx context.Context) []*Secret {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateCreateBy() *SecretUpsert {
+	u.SetExcluded(secret.FieldCreateBy)
+	return u
 }
 
-// Exec executes the query.
-func (_c *SecretCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// AddCreateBy adds v to the "create_by" field.
+func (u *SecretUpsert) AddCreateBy(v uint32) *SecretUpsert {
+	u.Add(secret.FieldCreateBy, v)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *SecretCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SecretUpsert) ClearCreateBy() *SecretUpsert {
+	u.SetNull(secret.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *SecretUpsert) SetUpdateBy(v uint32) *SecretUpsert {
+	u.Set(secret.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateUpdateBy() *SecretUpsert {
+	u.SetExcluded(secret.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *SecretUpsert) AddUpdateBy(v uint32) *SecretUpsert {
+	u.Add(secret.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *SecretUpsert) ClearUpdateBy() *SecretUpsert {
+	u.SetNull(secret.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SecretUpsert) SetUpdateTime(v time.Time) *SecretUpsert {
+	u.Set(secret.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateUpdateTime() *SecretUpsert {
+	u.SetExcluded(secret.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SecretUpsert) ClearUpdateTime() *SecretUpsert {
+	u.SetNull(secret.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SecretUpsert) SetDeleteTime(v time.Time) *SecretUpsert {
+	u.Set(secret.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateDeleteTime() *SecretUpsert {
+	u.SetExcluded(secret.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SecretUpsert) ClearDeleteTime() *SecretUpsert {
+	u.SetNull(secret.FieldDeleteTime)
+	return u
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *SecretUpsert) SetFolderID(v string) *SecretUpsert {
+	u.Set(secret.FieldFolderID, v)
+	return u
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateFolderID() *SecretUpsert {
+	u.SetExcluded(secret.FieldFolderID)
+	return u
+}
+
+// ClearFolderID clears the value of the "folder_id" field.
+func (u *SecretUpsert) ClearFolderID() *SecretUpsert {
+	u.SetNull(secret.FieldFolderID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SecretUpsert) SetName(v string) *SecretUpsert {
+	u.Set(secret.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateName() *SecretUpsert {
+	u.SetExcluded(secret.FieldName)
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *SecretUpsert) SetUsername(v string) *SecretUpsert {
+	u.Set(secret.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateUsername() *SecretUpsert {
+	u.SetExcluded(secret.FieldUsername)
+	return u
+}
+
+// ClearUsername clears the value of the "username" field.
+func (u *SecretUpsert) ClearUsername() *SecretUpsert {
+	u.SetNull(secret.FieldUsername)
+	return u
+}
+
+// SetHostURL sets the "host_url" field.
+func (u *SecretUpsert) SetHostURL(v string) *SecretUpsert {
+	u.Set(secret.FieldHostURL, v)
+	return u
+}
+
+// UpdateHostURL sets the "host_url" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateHostURL() *SecretUpsert {
+	u.SetExcluded(secret.FieldHostURL)
+	return u
+}
+
+// ClearHostURL clears the value of the "host_url" field.
+func (u *SecretUpsert) ClearHostURL() *SecretUpsert {
+	u.SetNull(secret.FieldHostURL)
+	return u
+}
+
+// SetVaultPath sets the "vault_path" field.
+func (u *SecretUpsert) SetVaultPath(v string) *SecretUpsert {
+	u.Set(secret.FieldVaultPath, v)
+	return u
+}
+
+// UpdateVaultPath sets the "vault_path" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateVaultPath() *SecretUpsert {
+	u.SetExcluded(secret.FieldVaultPath)
+	return u
+}
+
+// SetCurrentVersion sets the "current_version" field.
+func (u *SecretUpsert) SetCurrentVersion(v int32) *SecretUpsert {
+	u.Set(secret.FieldCurrentVersion, v)
+	return u
+}
+
+// UpdateCurrentVersion sets the "current_version" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateCurrentVersion() *SecretUpsert {
+	u.SetExcluded(secret.FieldCurrentVersion)
+	return u
+}
+
+// AddCurrentVersion adds v to the "current_version" field.
+func (u *SecretUpsert) AddCurrentVersion(v int32) *SecretUpsert {
+	u.Add(secret.FieldCurrentVersion, v)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SecretUpsert) SetMetadata(v map[string]interface{}) *SecretUpsert {
+	u.Set(secret.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateMetadata() *SecretUpsert {
+	u.SetExcluded(secret.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *SecretUpsert) ClearMetadata() *SecretUpsert {
+	u.SetNull(secret.FieldMetadata)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *SecretUpsert) SetDescription(v string) *SecretUpsert {
+	u.Set(secret.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateDescription() *SecretUpsert {
+	u.SetExcluded(secret.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecretUpsert) ClearDescription() *SecretUpsert {
+	u.SetNull(secret.FieldDescription)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SecretUpsert) SetStatus(v secret.Status) *SecretUpsert {
+	u.Set(secret.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateStatus() *SecretUpsert {
+	u.SetExcluded(secret.FieldStatus)
+	return u
+}
+
+// SetHasTotp sets the "has_totp" field.
+func (u *SecretUpsert) SetHasTotp(v bool) *SecretUpsert {
+	u.Set(secret.FieldHasTotp, v)
+	return u
+}
+
+// UpdateHasTotp sets the "has_totp" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateHasTotp() *SecretUpsert {
+	u.SetExcluded(secret.FieldHasTotp)
+	return u
+}
+
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (u *SecretUpsert) SetLastAccessedTime(v time.Time) *SecretUpsert {
+	u.Set(secret.FieldLastAccessedTime, v)
+	return u
+}
+
+// UpdateLastAccessedTime sets the "last_accessed_time" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateLastAccessedTime() *SecretUpsert {
+	u.SetExcluded(secret.FieldLastAccessedTime)
+	return u
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (u *SecretUpsert) ClearLastAccessedTime() *SecretUpsert {
+	u.SetNull(secret.FieldLastAccessedTime)
+	return u
+}
+
+// SetRevision sets the "revision" field.
+func (u *SecretUpsert) SetRevision(v int64) *SecretUpsert {
+	u.Set(secret.FieldRevision, v)
+	return u
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateRevision() *SecretUpsert {
+	u.SetExcluded(secret.FieldRevision)
+	return u
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *SecretUpsert) AddRevision(v int64) *SecretUpsert {
+	u.Add(secret.FieldRevision, v)
+	return u
+}
+
+// SetSensitive sets the "sensitive" field.
+func (u *SecretUpsert) SetSensitive(v bool) *SecretUpsert {
+	u.Set(secret.FieldSensitive, v)
+	return u
+}
+
+// UpdateSensitive sets the "sensitive" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateSensitive() *SecretUpsert {
+	u.SetExcluded(secret.FieldSensitive)
+	return u
+}
+
+// SetPasswordEncoding sets the "password_encoding" field.
+func (u *SecretUpsert) SetPasswordEncoding(v secret.PasswordEncoding) *SecretUpsert {
+	u.Set(secret.FieldPasswordEncoding, v)
+	return u
+}
+
+// UpdatePasswordEncoding sets the "password_encoding" field to the value that was provided on create.
+func (u *SecretUpsert) UpdatePasswordEncoding() *SecretUpsert {
+	u.SetExcluded(secret.FieldPasswordEncoding)
+	return u
+}
+
+// SetCanary sets the "canary" field.
+func (u *SecretUpsert) SetCanary(v bool) *SecretUpsert {
+	u.Set(secret.FieldCanary, v)
+	return u
+}
+
+// UpdateCanary sets the "canary" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateCanary() *SecretUpsert {
+	u.SetExcluded(secret.FieldCanary)
+	return u
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (u *SecretUpsert) SetAccessPolicy(v *authz.AccessPolicy) *SecretUpsert {
+	u.Set(secret.FieldAccessPolicy, v)
+	return u
+}
+
+// UpdateAccessPolicy sets the "access_policy" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateAccessPolicy() *SecretUpsert {
+	u.SetExcluded(secret.FieldAccessPolicy)
+	return u
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (u *SecretUpsert) ClearAccessPolicy() *SecretUpsert {
+	u.SetNull(secret.FieldAccessPolicy)
+	return u
+}
+
+// SetProtected sets the "protected" field.
+func (u *SecretUpsert) SetProtected(v bool) *SecretUpsert {
+	u.Set(secret.FieldProtected, v)
+	return u
+}
+
+// UpdateProtected sets the "protected" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateProtected() *SecretUpsert {
+	u.SetExcluded(secret.FieldProtected)
+	return u
+}
+
+// SetReferencedSecretIds sets the "referenced_secret_ids" field.
+func (u *SecretUpsert) SetReferencedSecretIds(v []string) *SecretUpsert {
+	u.Set(secret.FieldReferencedSecretIds, v)
+	return u
+}
+
+// UpdateReferencedSecretIds sets the "referenced_secret_ids" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateReferencedSecretIds() *SecretUpsert {
+	u.SetExcluded(secret.FieldReferencedSecretIds)
+	return u
+}
+
+// ClearReferencedSecretIds clears the value of the "referenced_secret_ids" field.
+func (u *SecretUpsert) ClearReferencedSecretIds() *SecretUpsert {
+	u.SetNull(secret.FieldReferencedSecretIds)
+	return u
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (u *SecretUpsert) SetTrashedWithFolderID(v string) *SecretUpsert {
+	u.Set(secret.FieldTrashedWithFolderID, v)
+	return u
+}
+
+// UpdateTrashedWithFolderID sets the "trashed_with_folder_id" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateTrashedWithFolderID() *SecretUpsert {
+	u.SetExcluded(secret.FieldTrashedWithFolderID)
+	return u
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (u *SecretUpsert) ClearTrashedWithFolderID() *SecretUpsert {
+	u.SetNull(secret.FieldTrashedWithFolderID)
+	return u
+}
+
+// SetStatusBeforeTrash sets the "status_before_trash" field.
+func (u *SecretUpsert) SetStatusBeforeTrash(v secret.StatusBeforeTrash) *SecretUpsert {
+	u.Set(secret.FieldStatusBeforeTrash, v)
+	return u
+}
+
+// UpdateStatusBeforeTrash sets the "status_before_trash" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateStatusBeforeTrash() *SecretUpsert {
+	u.SetExcluded(secret.FieldStatusBeforeTrash)
+	return u
+}
+
+// ClearStatusBeforeTrash clears the value of the "status_before_trash" field.
+func (u *SecretUpsert) ClearStatusBeforeTrash() *SecretUpsert {
+	u.SetNull(secret.FieldStatusBeforeTrash)
+	return u
+}
+
+// SetFieldKeyID sets the "field_key_id" field.
+func (u *SecretUpsert) SetFieldKeyID(v uint32) *SecretUpsert {
+	u.Set(secret.FieldFieldKeyID, v)
+	return u
+}
+
+// UpdateFieldKeyID sets the "field_key_id" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateFieldKeyID() *SecretUpsert {
+	u.SetExcluded(secret.FieldFieldKeyID)
+	return u
+}
+
+// AddFieldKeyID adds v to the "field_key_id" field.
+func (u *SecretUpsert) AddFieldKeyID(v uint32) *SecretUpsert {
+	u.Add(secret.FieldFieldKeyID, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(secret.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecretUpsertOne) UpdateNewValues() *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(secret.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(secret.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(secret.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SecretUpsertOne) Ignore() *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecretUpsertOne) DoNothing() *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecretCreate.OnConflict
+// documentation for more info.
+func (u *SecretUpsertOne) Update(set func(*SecretUpsert)) *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecretUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *SecretUpsertOne) SetCreateBy(v uint32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SecretUpsertOne) AddCreateBy(v uint32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateCreateBy() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SecretUpsertOne) ClearCreateBy() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *SecretUpsertOne) SetUpdateBy(v uint32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *SecretUpsertOne) AddUpdateBy(v uint32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateUpdateBy() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *SecretUpsertOne) ClearUpdateBy() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SecretUpsertOne) SetUpdateTime(v time.Time) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateUpdateTime() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SecretUpsertOne) ClearUpdateTime() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SecretUpsertOne) SetDeleteTime(v time.Time) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateDeleteTime() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SecretUpsertOne) ClearDeleteTime() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *SecretUpsertOne) SetFolderID(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetFolderID(v)
+	})
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateFolderID() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateFolderID()
+	})
+}
+
+// ClearFolderID clears the value of the "folder_id" field.
+func (u *SecretUpsertOne) ClearFolderID() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearFolderID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SecretUpsertOne) SetName(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateName() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *SecretUpsertOne) SetUsername(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateUsername() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// ClearUsername clears the value of the "username" field.
+func (u *SecretUpsertOne) ClearUsername() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearUsername()
+	})
+}
+
+// SetHostURL sets the "host_url" field.
+func (u *SecretUpsertOne) SetHostURL(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetHostURL(v)
+	})
+}
+
+// UpdateHostURL sets the "host_url" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateHostURL() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateHostURL()
+	})
+}
+
+// ClearHostURL clears the value of the "host_url" field.
+func (u *SecretUpsertOne) ClearHostURL() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearHostURL()
+	})
+}
+
+// SetVaultPath sets the "vault_path" field.
+func (u *SecretUpsertOne) SetVaultPath(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetVaultPath(v)
+	})
+}
+
+// UpdateVaultPath sets the "vault_path" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateVaultPath() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateVaultPath()
+	})
+}
+
+// SetCurrentVersion sets the "current_version" field.
+func (u *SecretUpsertOne) SetCurrentVersion(v int32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCurrentVersion(v)
+	})
+}
+
+// AddCurrentVersion adds v to the "current_version" field.
+func (u *SecretUpsertOne) AddCurrentVersion(v int32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddCurrentVersion(v)
+	})
+}
+
+// UpdateCurrentVersion sets the "current_version" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateCurrentVersion() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCurrentVersion()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SecretUpsertOne) SetMetadata(v map[string]interface{}) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateMetadata() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *SecretUpsertOne) ClearMetadata() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SecretUpsertOne) SetDescription(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateDescription() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecretUpsertOne) ClearDescription() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SecretUpsertOne) SetStatus(v secret.Status) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateStatus() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHasTotp sets the "has_totp" field.
+func (u *SecretUpsertOne) SetHasTotp(v bool) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetHasTotp(v)
+	})
+}
+
+// UpdateHasTotp sets the "has_totp" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateHasTotp() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateHasTotp()
+	})
+}
+
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (u *SecretUpsertOne) SetLastAccessedTime(v time.Time) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetLastAccessedTime(v)
+	})
+}
+
+// UpdateLastAccessedTime sets the "last_accessed_time" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateLastAccessedTime() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateLastAccessedTime()
+	})
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (u *SecretUpsertOne) ClearLastAccessedTime() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearLastAccessedTime()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *SecretUpsertOne) SetRevision(v int64) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *SecretUpsertOne) AddRevision(v int64) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateRevision() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// SetSensitive sets the "sensitive" field.
+func (u *SecretUpsertOne) SetSensitive(v bool) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetSensitive(v)
+	})
+}
+
+// UpdateSensitive sets the "sensitive" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateSensitive() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateSensitive()
+	})
+}
+
+// SetPasswordEncoding sets the "password_encoding" field.
+func (u *SecretUpsertOne) SetPasswordEncoding(v secret.PasswordEncoding) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetPasswordEncoding(v)
+	})
+}
+
+// UpdatePasswordEncoding sets the "password_encoding" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdatePasswordEncoding() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdatePasswordEncoding()
+	})
+}
+
+// SetCanary sets the "canary" field.
+func (u *SecretUpsertOne) SetCanary(v bool) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCanary(v)
+	})
+}
+
+// UpdateCanary sets the "canary" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateCanary() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCanary()
+	})
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (u *SecretUpsertOne) SetAccessPolicy(v *authz.AccessPolicy) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetAccessPolicy(v)
+	})
+}
+
+// UpdateAccessPolicy sets the "access_policy" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateAccessPolicy() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateAccessPolicy()
+	})
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (u *SecretUpsertOne) ClearAccessPolicy() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearAccessPolicy()
+	})
+}
+
+// SetProtected sets the "protected" field.
+func (u *SecretUpsertOne) SetProtected(v bool) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetProtected(v)
+	})
+}
+
+// UpdateProtected sets the "protected" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateProtected() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateProtected()
+	})
+}
+
+// SetReferencedSecretIds sets the "referenced_secret_ids" field.
+func (u *SecretUpsertOne) SetReferencedSecretIds(v []string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetReferencedSecretIds(v)
+	})
+}
+
+// UpdateReferencedSecretIds sets the "referenced_secret_ids" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateReferencedSecretIds() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateReferencedSecretIds()
+	})
+}
+
+// ClearReferencedSecretIds clears the value of the "referenced_secret_ids" field.
+func (u *SecretUpsertOne) ClearReferencedSecretIds() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearReferencedSecretIds()
+	})
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (u *SecretUpsertOne) SetTrashedWithFolderID(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetTrashedWithFolderID(v)
+	})
+}
+
+// UpdateTrashedWithFolderID sets the "trashed_with_folder_id" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateTrashedWithFolderID() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateTrashedWithFolderID()
+	})
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (u *SecretUpsertOne) ClearTrashedWithFolderID() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearTrashedWithFolderID()
+	})
+}
+
+// SetStatusBeforeTrash sets the "status_before_trash" field.
+func (u *SecretUpsertOne) SetStatusBeforeTrash(v secret.StatusBeforeTrash) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetStatusBeforeTrash(v)
+	})
+}
+
+// UpdateStatusBeforeTrash sets the "status_before_trash" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateStatusBeforeTrash() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateStatusBeforeTrash()
+	})
+}
+
+// ClearStatusBeforeTrash clears the value of the "status_before_trash" field.
+func (u *SecretUpsertOne) ClearStatusBeforeTrash() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearStatusBeforeTrash()
+	})
+}
+
+// SetFieldKeyID sets the "field_key_id" field.
+func (u *SecretUpsertOne) SetFieldKeyID(v uint32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetFieldKeyID(v)
+	})
+}
+
+// AddFieldKeyID adds v to the "field_key_id" field.
+func (u *SecretUpsertOne) AddFieldKeyID(v uint32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddFieldKeyID(v)
+	})
+}
+
+// UpdateFieldKeyID sets the "field_key_id" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateFieldKeyID() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateFieldKeyID()
+	})
+}
+
+// Exec executes the query.
+func (u *SecretUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SecretCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecretUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SecretUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SecretUpsertOne.ID is not supported by MySQL driver. Use SecretUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SecretUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SecretCreateBulk is the builder for creating many Secret entities in bulk.
+type SecretCreateBulk struct {
+	config
+	err      error
+	builders []*SecretCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Secret entities in the database.
+func (_c *SecretCreateBulk) Save(ctx context.Context) ([]*Secret, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Secret, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SecretMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SecretCreateBulk) SaveX(ctx context.Context) []*Secret {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SecretCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SecretCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Secret.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecretUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SecretCreateBulk) OnConflict(opts ...sql.ConflictOption) *SecretUpsertBulk {
+	_c.conflict = opts
+	return &SecretUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SecretCreateBulk) OnConflictColumns(columns ...string) *SecretUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SecretUpsertBulk{
+		create: _c,
+	}
+}
+
+// SecretUpsertBulk is the builder for "upsert"-ing
+// a bulk of Secret nodes.
+type SecretUpsertBulk struct {
+	create *SecretCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(secret.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecretUpsertBulk) UpdateNewValues() *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(secret.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(secret.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(secret.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SecretUpsertBulk) Ignore() *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecretUpsertBulk) DoNothing() *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecretCreateBulk.OnConflict
+// documentation for more info.
+func (u *SecretUpsertBulk) Update(set func(*SecretUpsert)) *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecretUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *SecretUpsertBulk) SetCreateBy(v uint32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SecretUpsertBulk) AddCreateBy(v uint32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateCreateBy() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SecretUpsertBulk) ClearCreateBy() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *SecretUpsertBulk) SetUpdateBy(v uint32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *SecretUpsertBulk) AddUpdateBy(v uint32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateUpdateBy() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *SecretUpsertBulk) ClearUpdateBy() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SecretUpsertBulk) SetUpdateTime(v time.Time) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateUpdateTime() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SecretUpsertBulk) ClearUpdateTime() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SecretUpsertBulk) SetDeleteTime(v time.Time) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateDeleteTime() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SecretUpsertBulk) ClearDeleteTime() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *SecretUpsertBulk) SetFolderID(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetFolderID(v)
+	})
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateFolderID() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateFolderID()
+	})
+}
+
+// ClearFolderID clears the value of the "folder_id" field.
+func (u *SecretUpsertBulk) ClearFolderID() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearFolderID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SecretUpsertBulk) SetName(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateName() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *SecretUpsertBulk) SetUsername(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateUsername() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// ClearUsername clears the value of the "username" field.
+func (u *SecretUpsertBulk) ClearUsername() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearUsername()
+	})
+}
+
+// SetHostURL sets the "host_url" field.
+func (u *SecretUpsertBulk) SetHostURL(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetHostURL(v)
+	})
+}
+
+// UpdateHostURL sets the "host_url" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateHostURL() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateHostURL()
+	})
+}
+
+// ClearHostURL clears the value of the "host_url" field.
+func (u *SecretUpsertBulk) ClearHostURL() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearHostURL()
+	})
+}
+
+// SetVaultPath sets the "vault_path" field.
+func (u *SecretUpsertBulk) SetVaultPath(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetVaultPath(v)
+	})
+}
+
+// UpdateVaultPath sets the "vault_path" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateVaultPath() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateVaultPath()
+	})
+}
+
+// SetCurrentVersion sets the "current_version" field.
+func (u *SecretUpsertBulk) SetCurrentVersion(v int32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCurrentVersion(v)
+	})
+}
+
+// AddCurrentVersion adds v to the "current_version" field.
+func (u *SecretUpsertBulk) AddCurrentVersion(v int32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddCurrentVersion(v)
+	})
+}
+
+// UpdateCurrentVersion sets the "current_version" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateCurrentVersion() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCurrentVersion()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SecretUpsertBulk) SetMetadata(v map[string]interface{}) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateMetadata() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *SecretUpsertBulk) ClearMetadata() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SecretUpsertBulk) SetDescription(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateDescription() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecretUpsertBulk) ClearDescription() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SecretUpsertBulk) SetStatus(v secret.Status) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateStatus() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHasTotp sets the "has_totp" field.
+func (u *SecretUpsertBulk) SetHasTotp(v bool) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetHasTotp(v)
+	})
+}
+
+// UpdateHasTotp sets the "has_totp" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateHasTotp() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateHasTotp()
+	})
+}
+
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (u *SecretUpsertBulk) SetLastAccessedTime(v time.Time) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetLastAccessedTime(v)
+	})
+}
+
+// UpdateLastAccessedTime sets the "last_accessed_time" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateLastAccessedTime() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateLastAccessedTime()
+	})
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (u *SecretUpsertBulk) ClearLastAccessedTime() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearLastAccessedTime()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *SecretUpsertBulk) SetRevision(v int64) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *SecretUpsertBulk) AddRevision(v int64) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateRevision() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// SetSensitive sets the "sensitive" field.
+func (u *SecretUpsertBulk) SetSensitive(v bool) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetSensitive(v)
+	})
+}
+
+// UpdateSensitive sets the "sensitive" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateSensitive() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateSensitive()
+	})
+}
+
+// SetPasswordEncoding sets the "password_encoding" field.
+func (u *SecretUpsertBulk) SetPasswordEncoding(v secret.PasswordEncoding) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetPasswordEncoding(v)
+	})
+}
+
+// UpdatePasswordEncoding sets the "password_encoding" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdatePasswordEncoding() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdatePasswordEncoding()
+	})
+}
+
+// SetCanary sets the "canary" field.
+func (u *SecretUpsertBulk) SetCanary(v bool) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCanary(v)
+	})
+}
+
+// UpdateCanary sets the "canary" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateCanary() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCanary()
+	})
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (u *SecretUpsertBulk) SetAccessPolicy(v *authz.AccessPolicy) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetAccessPolicy(v)
+	})
+}
+
+// UpdateAccessPolicy sets the "access_policy" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateAccessPolicy() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateAccessPolicy()
+	})
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (u *SecretUpsertBulk) ClearAccessPolicy() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearAccessPolicy()
+	})
+}
+
+// SetProtected sets the "protected" field.
+func (u *SecretUpsertBulk) SetProtected(v bool) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetProtected(v)
+	})
+}
+
+// UpdateProtected sets the "protected" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateProtected() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateProtected()
+	})
+}
+
+// SetReferencedSecretIds sets the "referenced_secret_ids" field.
+func (u *SecretUpsertBulk) SetReferencedSecretIds(v []string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetReferencedSecretIds(v)
+	})
+}
+
+// UpdateReferencedSecretIds sets the "referenced_secret_ids" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateReferencedSecretIds() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateReferencedSecretIds()
+	})
+}
+
+// ClearReferencedSecretIds clears the value of the "referenced_secret_ids" field.
+func (u *SecretUpsertBulk) ClearReferencedSecretIds() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearReferencedSecretIds()
+	})
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (u *SecretUpsertBulk) SetTrashedWithFolderID(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetTrashedWithFolderID(v)
+	})
+}
+
+// UpdateTrashedWithFolderID sets the "trashed_with_folder_id" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateTrashedWithFolderID() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateTrashedWithFolderID()
+	})
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (u *SecretUpsertBulk) ClearTrashedWithFolderID() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearTrashedWithFolderID()
+	})
+}
+
+// SetStatusBeforeTrash sets the "status_before_trash" field.
+func (u *SecretUpsertBulk) SetStatusBeforeTrash(v secret.StatusBeforeTrash) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetStatusBeforeTrash(v)
+	})
+}
+
+// UpdateStatusBeforeTrash sets the "status_before_trash" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateStatusBeforeTrash() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateStatusBeforeTrash()
+	})
+}
+
+// ClearStatusBeforeTrash clears the value of the "status_before_trash" field.
+func (u *SecretUpsertBulk) ClearStatusBeforeTrash() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearStatusBeforeTrash()
+	})
+}
+
+// SetFieldKeyID sets the "field_key_id" field.
+func (u *SecretUpsertBulk) SetFieldKeyID(v uint32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetFieldKeyID(v)
+	})
+}
+
+// AddFieldKeyID adds v to the "field_key_id" field.
+func (u *SecretUpsertBulk) AddFieldKeyID(v uint32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddFieldKeyID(v)
+	})
+}
+
+// UpdateFieldKeyID sets the "field_key_id" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateFieldKeyID() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateFieldKeyID()
+	})
+}
+
+// Exec executes the query.
+func (u *SecretUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SecretCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SecretCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecretUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
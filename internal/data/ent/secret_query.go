@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/collection"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
@@ -31,6 +32,7 @@ type SecretQuery struct {
 	withFolder      *FolderQuery
 	withVersions    *SecretVersionQuery
 	withPermissions *PermissionQuery
+	withCollections *CollectionQuery
 	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -134,6 +136,28 @@ func (_q *SecretQuery) QueryPermissions() *PermissionQuery {
 	return query
 }
 
+// QueryCollections chains the current query on the "collections" edge.
+func (_q *SecretQuery) QueryCollections() *CollectionQuery {
+	query := (&CollectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(secret.Table, secret.FieldID, selector),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, secret.CollectionsTable, secret.CollectionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Secret entity from the query.
 // Returns a *NotFoundError when no Secret was found.
 func (_q *SecretQuery) First(ctx context.Context) (*Secret, error) {
@@ -329,9 +353,11 @@ func (_q *SecretQuery) Clone() *SecretQuery {
 		withFolder:      _q.withFolder.Clone(),
 		withVersions:    _q.withVersions.Clone(),
 		withPermissions: _q.withPermissions.Clone(),
+		withCollections: _q.withCollections.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -368,6 +394,17 @@ func (_q *SecretQuery) WithPermissions(opts ...func(*PermissionQuery)) *SecretQu
 	return _q
 }
 
+// WithCollections tells the query-builder to eager-load the nodes that are connected to
+// the "collections" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SecretQuery) WithCollections(opts ...func(*CollectionQuery)) *SecretQuery {
+	query := (&CollectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCollections = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -452,10 +489,11 @@ func (_q *SecretQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Secre
 	var (
 		nodes       = []*Secret{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withFolder != nil,
 			_q.withVersions != nil,
 			_q.withPermissions != nil,
+			_q.withCollections != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -499,6 +537,13 @@ func (_q *SecretQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Secre
 			return nil, err
 		}
 	}
+	if query := _q.withCollections; query != nil {
+		if err := _q.loadCollections(ctx, query, nodes,
+			func(n *Secret) { n.Edges.Collections = []*Collection{} },
+			func(n *Secret, e *Collection) { n.Edges.Collections = append(n.Edges.Collections, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -595,6 +640,67 @@ func (_q *SecretQuery) loadPermissions(ctx context.Context, query *PermissionQue
 	}
 	return nil
 }
+func (_q *SecretQuery) loadCollections(ctx context.Context, query *CollectionQuery, nodes []*Secret, init func(*Secret), assign func(*Secret, *Collection)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*Secret)
+	nids := make(map[string]map[*Secret]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(secret.CollectionsTable)
+		s.Join(joinT).On(s.C(collection.FieldID), joinT.C(secret.CollectionsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(secret.CollectionsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(secret.CollectionsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Secret]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Collection](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "collections" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *SecretQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -712,6 +818,12 @@ func (_q *SecretQuery) ForShare(opts ...sql.LockOption) *SecretQuery {
 	return _q
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *SecretQuery) Modify(modifiers ...func(s *sql.Selector)) *SecretSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // SecretGroupBy is the group-by builder for Secret entities.
 type SecretGroupBy struct {
 	selector
@@ -801,3 +913,9 @@ func (_s *SecretSelect) sqlScan(ctx context.Context, root *SecretQuery, v any) e
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *SecretSelect) Modify(modifiers ...func(s *sql.Selector)) *SecretSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
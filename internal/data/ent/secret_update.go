@@ -10,7 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/collection"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
@@ -21,8 +24,9 @@ import (
 // SecretUpdate is the builder for updating Secret entities.
 type SecretUpdate struct {
 	config
-	hooks    []Hook
-	mutation *SecretMutation
+	hooks     []Hook
+	mutation  *SecretMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the SecretUpdate builder.
@@ -294,6 +298,194 @@ func (_u *SecretUpdate) SetNillableHasTotp(v *bool) *SecretUpdate {
 	return _u
 }
 
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (_u *SecretUpdate) SetLastAccessedTime(v time.Time) *SecretUpdate {
+	_u.mutation.SetLastAccessedTime(v)
+	return _u
+}
+
+// SetNillableLastAccessedTime sets the "last_accessed_time" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableLastAccessedTime(v *time.Time) *SecretUpdate {
+	if v != nil {
+		_u.SetLastAccessedTime(*v)
+	}
+	return _u
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (_u *SecretUpdate) ClearLastAccessedTime() *SecretUpdate {
+	_u.mutation.ClearLastAccessedTime()
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *SecretUpdate) SetRevision(v int64) *SecretUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableRevision(v *int64) *SecretUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *SecretUpdate) AddRevision(v int64) *SecretUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetSensitive sets the "sensitive" field.
+func (_u *SecretUpdate) SetSensitive(v bool) *SecretUpdate {
+	_u.mutation.SetSensitive(v)
+	return _u
+}
+
+// SetNillableSensitive sets the "sensitive" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableSensitive(v *bool) *SecretUpdate {
+	if v != nil {
+		_u.SetSensitive(*v)
+	}
+	return _u
+}
+
+// SetPasswordEncoding sets the "password_encoding" field.
+func (_u *SecretUpdate) SetPasswordEncoding(v secret.PasswordEncoding) *SecretUpdate {
+	_u.mutation.SetPasswordEncoding(v)
+	return _u
+}
+
+// SetNillablePasswordEncoding sets the "password_encoding" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillablePasswordEncoding(v *secret.PasswordEncoding) *SecretUpdate {
+	if v != nil {
+		_u.SetPasswordEncoding(*v)
+	}
+	return _u
+}
+
+// SetCanary sets the "canary" field.
+func (_u *SecretUpdate) SetCanary(v bool) *SecretUpdate {
+	_u.mutation.SetCanary(v)
+	return _u
+}
+
+// SetNillableCanary sets the "canary" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableCanary(v *bool) *SecretUpdate {
+	if v != nil {
+		_u.SetCanary(*v)
+	}
+	return _u
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (_u *SecretUpdate) SetAccessPolicy(v *authz.AccessPolicy) *SecretUpdate {
+	_u.mutation.SetAccessPolicy(v)
+	return _u
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (_u *SecretUpdate) ClearAccessPolicy() *SecretUpdate {
+	_u.mutation.ClearAccessPolicy()
+	return _u
+}
+
+// SetProtected sets the "protected" field.
+func (_u *SecretUpdate) SetProtected(v bool) *SecretUpdate {
+	_u.mutation.SetProtected(v)
+	return _u
+}
+
+// SetNillableProtected sets the "protected" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableProtected(v *bool) *SecretUpdate {
+	if v != nil {
+		_u.SetProtected(*v)
+	}
+	return _u
+}
+
+// SetReferencedSecretIds sets the "referenced_secret_ids" field.
+func (_u *SecretUpdate) SetReferencedSecretIds(v []string) *SecretUpdate {
+	_u.mutation.SetReferencedSecretIds(v)
+	return _u
+}
+
+// AppendReferencedSecretIds appends value to the "referenced_secret_ids" field.
+func (_u *SecretUpdate) AppendReferencedSecretIds(v []string) *SecretUpdate {
+	_u.mutation.AppendReferencedSecretIds(v)
+	return _u
+}
+
+// ClearReferencedSecretIds clears the value of the "referenced_secret_ids" field.
+func (_u *SecretUpdate) ClearReferencedSecretIds() *SecretUpdate {
+	_u.mutation.ClearReferencedSecretIds()
+	return _u
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (_u *SecretUpdate) SetTrashedWithFolderID(v string) *SecretUpdate {
+	_u.mutation.SetTrashedWithFolderID(v)
+	return _u
+}
+
+// SetNillableTrashedWithFolderID sets the "trashed_with_folder_id" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableTrashedWithFolderID(v *string) *SecretUpdate {
+	if v != nil {
+		_u.SetTrashedWithFolderID(*v)
+	}
+	return _u
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (_u *SecretUpdate) ClearTrashedWithFolderID() *SecretUpdate {
+	_u.mutation.ClearTrashedWithFolderID()
+	return _u
+}
+
+// SetStatusBeforeTrash sets the "status_before_trash" field.
+func (_u *SecretUpdate) SetStatusBeforeTrash(v secret.StatusBeforeTrash) *SecretUpdate {
+	_u.mutation.SetStatusBeforeTrash(v)
+	return _u
+}
+
+// SetNillableStatusBeforeTrash sets the "status_before_trash" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableStatusBeforeTrash(v *secret.StatusBeforeTrash) *SecretUpdate {
+	if v != nil {
+		_u.SetStatusBeforeTrash(*v)
+	}
+	return _u
+}
+
+// ClearStatusBeforeTrash clears the value of the "status_before_trash" field.
+func (_u *SecretUpdate) ClearStatusBeforeTrash() *SecretUpdate {
+	_u.mutation.ClearStatusBeforeTrash()
+	return _u
+}
+
+// SetFieldKeyID sets the "field_key_id" field.
+func (_u *SecretUpdate) SetFieldKeyID(v uint32) *SecretUpdate {
+	_u.mutation.ResetFieldKeyID()
+	_u.mutation.SetFieldKeyID(v)
+	return _u
+}
+
+// SetNillableFieldKeyID sets the "field_key_id" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableFieldKeyID(v *uint32) *SecretUpdate {
+	if v != nil {
+		_u.SetFieldKeyID(*v)
+	}
+	return _u
+}
+
+// AddFieldKeyID adds value to the "field_key_id" field.
+func (_u *SecretUpdate) AddFieldKeyID(v int32) *SecretUpdate {
+	_u.mutation.AddFieldKeyID(v)
+	return _u
+}
+
 // SetFolder sets the "folder" edge to the Folder entity.
 func (_u *SecretUpdate) SetFolder(v *Folder) *SecretUpdate {
 	return _u.SetFolderID(v.ID)
@@ -329,6 +521,21 @@ func (_u *SecretUpdate) AddPermissions(v ...*Permission) *SecretUpdate {
 	return _u.AddPermissionIDs(ids...)
 }
 
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (_u *SecretUpdate) AddCollectionIDs(ids ...string) *SecretUpdate {
+	_u.mutation.AddCollectionIDs(ids...)
+	return _u
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (_u *SecretUpdate) AddCollections(v ...*Collection) *SecretUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the SecretMutation object of the builder.
 func (_u *SecretUpdate) Mutation() *SecretMutation {
 	return _u.mutation
@@ -382,6 +589,27 @@ func (_u *SecretUpdate) RemovePermissions(v ...*Permission) *SecretUpdate {
 	return _u.RemovePermissionIDs(ids...)
 }
 
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (_u *SecretUpdate) ClearCollections() *SecretUpdate {
+	_u.mutation.ClearCollections()
+	return _u
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (_u *SecretUpdate) RemoveCollectionIDs(ids ...string) *SecretUpdate {
+	_u.mutation.RemoveCollectionIDs(ids...)
+	return _u
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (_u *SecretUpdate) RemoveCollections(v ...*Collection) *SecretUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCollectionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SecretUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -441,9 +669,30 @@ func (_u *SecretUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Secret.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PasswordEncoding(); ok {
+		if err := secret.PasswordEncodingValidator(v); err != nil {
+			return &ValidationError{Name: "password_encoding", err: fmt.Errorf(`ent: validator failed for field "Secret.password_encoding": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccessPolicy(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "access_policy", err: fmt.Errorf(`ent: validator failed for field "Secret.access_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatusBeforeTrash(); ok {
+		if err := secret.StatusBeforeTrashValidator(v); err != nil {
+			return &ValidationError{Name: "status_before_trash", err: fmt.Errorf(`ent: validator failed for field "Secret.status_before_trash": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SecretUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecretUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SecretUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -534,6 +783,65 @@ func (_u *SecretUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.HasTotp(); ok {
 		_spec.SetField(secret.FieldHasTotp, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.LastAccessedTime(); ok {
+		_spec.SetField(secret.FieldLastAccessedTime, field.TypeTime, value)
+	}
+	if _u.mutation.LastAccessedTimeCleared() {
+		_spec.ClearField(secret.FieldLastAccessedTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(secret.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(secret.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Sensitive(); ok {
+		_spec.SetField(secret.FieldSensitive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PasswordEncoding(); ok {
+		_spec.SetField(secret.FieldPasswordEncoding, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Canary(); ok {
+		_spec.SetField(secret.FieldCanary, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessPolicy(); ok {
+		_spec.SetField(secret.FieldAccessPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessPolicyCleared() {
+		_spec.ClearField(secret.FieldAccessPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Protected(); ok {
+		_spec.SetField(secret.FieldProtected, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReferencedSecretIds(); ok {
+		_spec.SetField(secret.FieldReferencedSecretIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReferencedSecretIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, secret.FieldReferencedSecretIds, value)
+		})
+	}
+	if _u.mutation.ReferencedSecretIdsCleared() {
+		_spec.ClearField(secret.FieldReferencedSecretIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TrashedWithFolderID(); ok {
+		_spec.SetField(secret.FieldTrashedWithFolderID, field.TypeString, value)
+	}
+	if _u.mutation.TrashedWithFolderIDCleared() {
+		_spec.ClearField(secret.FieldTrashedWithFolderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatusBeforeTrash(); ok {
+		_spec.SetField(secret.FieldStatusBeforeTrash, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusBeforeTrashCleared() {
+		_spec.ClearField(secret.FieldStatusBeforeTrash, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.FieldKeyID(); ok {
+		_spec.SetField(secret.FieldFieldKeyID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedFieldKeyID(); ok {
+		_spec.AddField(secret.FieldFieldKeyID, field.TypeUint32, value)
+	}
 	if _u.mutation.FolderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -653,6 +961,52 @@ func (_u *SecretUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   secret.CollectionsTable,
+			Columns: secret.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   secret.CollectionsTable,
+			Columns: secret.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   secret.CollectionsTable,
+			Columns: secret.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{secret.Label}
@@ -668,9 +1022,10 @@ func (_u *SecretUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // SecretUpdateOne is the builder for updating a single Secret entity.
 type SecretUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *SecretMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *SecretMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCreateBy sets the "create_by" field.
@@ -936,6 +1291,194 @@ func (_u *SecretUpdateOne) SetNillableHasTotp(v *bool) *SecretUpdateOne {
 	return _u
 }
 
+// SetLastAccessedTime sets the "last_accessed_time" field.
+func (_u *SecretUpdateOne) SetLastAccessedTime(v time.Time) *SecretUpdateOne {
+	_u.mutation.SetLastAccessedTime(v)
+	return _u
+}
+
+// SetNillableLastAccessedTime sets the "last_accessed_time" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableLastAccessedTime(v *time.Time) *SecretUpdateOne {
+	if v != nil {
+		_u.SetLastAccessedTime(*v)
+	}
+	return _u
+}
+
+// ClearLastAccessedTime clears the value of the "last_accessed_time" field.
+func (_u *SecretUpdateOne) ClearLastAccessedTime() *SecretUpdateOne {
+	_u.mutation.ClearLastAccessedTime()
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *SecretUpdateOne) SetRevision(v int64) *SecretUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableRevision(v *int64) *SecretUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *SecretUpdateOne) AddRevision(v int64) *SecretUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetSensitive sets the "sensitive" field.
+func (_u *SecretUpdateOne) SetSensitive(v bool) *SecretUpdateOne {
+	_u.mutation.SetSensitive(v)
+	return _u
+}
+
+// SetNillableSensitive sets the "sensitive" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableSensitive(v *bool) *SecretUpdateOne {
+	if v != nil {
+		_u.SetSensitive(*v)
+	}
+	return _u
+}
+
+// SetPasswordEncoding sets the "password_encoding" field.
+func (_u *SecretUpdateOne) SetPasswordEncoding(v secret.PasswordEncoding) *SecretUpdateOne {
+	_u.mutation.SetPasswordEncoding(v)
+	return _u
+}
+
+// SetNillablePasswordEncoding sets the "password_encoding" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillablePasswordEncoding(v *secret.PasswordEncoding) *SecretUpdateOne {
+	if v != nil {
+		_u.SetPasswordEncoding(*v)
+	}
+	return _u
+}
+
+// SetCanary sets the "canary" field.
+func (_u *SecretUpdateOne) SetCanary(v bool) *SecretUpdateOne {
+	_u.mutation.SetCanary(v)
+	return _u
+}
+
+// SetNillableCanary sets the "canary" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableCanary(v *bool) *SecretUpdateOne {
+	if v != nil {
+		_u.SetCanary(*v)
+	}
+	return _u
+}
+
+// SetAccessPolicy sets the "access_policy" field.
+func (_u *SecretUpdateOne) SetAccessPolicy(v *authz.AccessPolicy) *SecretUpdateOne {
+	_u.mutation.SetAccessPolicy(v)
+	return _u
+}
+
+// ClearAccessPolicy clears the value of the "access_policy" field.
+func (_u *SecretUpdateOne) ClearAccessPolicy() *SecretUpdateOne {
+	_u.mutation.ClearAccessPolicy()
+	return _u
+}
+
+// SetProtected sets the "protected" field.
+func (_u *SecretUpdateOne) SetProtected(v bool) *SecretUpdateOne {
+	_u.mutation.SetProtected(v)
+	return _u
+}
+
+// SetNillableProtected sets the "protected" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableProtected(v *bool) *SecretUpdateOne {
+	if v != nil {
+		_u.SetProtected(*v)
+	}
+	return _u
+}
+
+// SetReferencedSecretIds sets the "referenced_secret_ids" field.
+func (_u *SecretUpdateOne) SetReferencedSecretIds(v []string) *SecretUpdateOne {
+	_u.mutation.SetReferencedSecretIds(v)
+	return _u
+}
+
+// AppendReferencedSecretIds appends value to the "referenced_secret_ids" field.
+func (_u *SecretUpdateOne) AppendReferencedSecretIds(v []string) *SecretUpdateOne {
+	_u.mutation.AppendReferencedSecretIds(v)
+	return _u
+}
+
+// ClearReferencedSecretIds clears the value of the "referenced_secret_ids" field.
+func (_u *SecretUpdateOne) ClearReferencedSecretIds() *SecretUpdateOne {
+	_u.mutation.ClearReferencedSecretIds()
+	return _u
+}
+
+// SetTrashedWithFolderID sets the "trashed_with_folder_id" field.
+func (_u *SecretUpdateOne) SetTrashedWithFolderID(v string) *SecretUpdateOne {
+	_u.mutation.SetTrashedWithFolderID(v)
+	return _u
+}
+
+// SetNillableTrashedWithFolderID sets the "trashed_with_folder_id" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableTrashedWithFolderID(v *string) *SecretUpdateOne {
+	if v != nil {
+		_u.SetTrashedWithFolderID(*v)
+	}
+	return _u
+}
+
+// ClearTrashedWithFolderID clears the value of the "trashed_with_folder_id" field.
+func (_u *SecretUpdateOne) ClearTrashedWithFolderID() *SecretUpdateOne {
+	_u.mutation.ClearTrashedWithFolderID()
+	return _u
+}
+
+// SetStatusBeforeTrash sets the "status_before_trash" field.
+func (_u *SecretUpdateOne) SetStatusBeforeTrash(v secret.StatusBeforeTrash) *SecretUpdateOne {
+	_u.mutation.SetStatusBeforeTrash(v)
+	return _u
+}
+
+// SetNillableStatusBeforeTrash sets the "status_before_trash" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableStatusBeforeTrash(v *secret.StatusBeforeTrash) *SecretUpdateOne {
+	if v != nil {
+		_u.SetStatusBeforeTrash(*v)
+	}
+	return _u
+}
+
+// ClearStatusBeforeTrash clears the value of the "status_before_trash" field.
+func (_u *SecretUpdateOne) ClearStatusBeforeTrash() *SecretUpdateOne {
+	_u.mutation.ClearStatusBeforeTrash()
+	return _u
+}
+
+// SetFieldKeyID sets the "field_key_id" field.
+func (_u *SecretUpdateOne) SetFieldKeyID(v uint32) *SecretUpdateOne {
+	_u.mutation.ResetFieldKeyID()
+	_u.mutation.SetFieldKeyID(v)
+	return _u
+}
+
+// SetNillableFieldKeyID sets the "field_key_id" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableFieldKeyID(v *uint32) *SecretUpdateOne {
+	if v != nil {
+		_u.SetFieldKeyID(*v)
+	}
+	return _u
+}
+
+// AddFieldKeyID adds value to the "field_key_id" field.
+func (_u *SecretUpdateOne) AddFieldKeyID(v int32) *SecretUpdateOne {
+	_u.mutation.AddFieldKeyID(v)
+	return _u
+}
+
 // SetFolder sets the "folder" edge to the Folder entity.
 func (_u *SecretUpdateOne) SetFolder(v *Folder) *SecretUpdateOne {
 	return _u.SetFolderID(v.ID)
@@ -971,6 +1514,21 @@ func (_u *SecretUpdateOne) AddPermissions(v ...*Permission) *SecretUpdateOne {
 	return _u.AddPermissionIDs(ids...)
 }
 
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (_u *SecretUpdateOne) AddCollectionIDs(ids ...string) *SecretUpdateOne {
+	_u.mutation.AddCollectionIDs(ids...)
+	return _u
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (_u *SecretUpdateOne) AddCollections(v ...*Collection) *SecretUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the SecretMutation object of the builder.
 func (_u *SecretUpdateOne) Mutation() *SecretMutation {
 	return _u.mutation
@@ -1024,6 +1582,27 @@ func (_u *SecretUpdateOne) RemovePermissions(v ...*Permission) *SecretUpdateOne
 	return _u.RemovePermissionIDs(ids...)
 }
 
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (_u *SecretUpdateOne) ClearCollections() *SecretUpdateOne {
+	_u.mutation.ClearCollections()
+	return _u
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (_u *SecretUpdateOne) RemoveCollectionIDs(ids ...string) *SecretUpdateOne {
+	_u.mutation.RemoveCollectionIDs(ids...)
+	return _u
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (_u *SecretUpdateOne) RemoveCollections(v ...*Collection) *SecretUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCollectionIDs(ids...)
+}
+
 // Where appends a list predicates to the SecretUpdate builder.
 func (_u *SecretUpdateOne) Where(ps ...predicate.Secret) *SecretUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1096,9 +1675,30 @@ func (_u *SecretUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Secret.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PasswordEncoding(); ok {
+		if err := secret.PasswordEncodingValidator(v); err != nil {
+			return &ValidationError{Name: "password_encoding", err: fmt.Errorf(`ent: validator failed for field "Secret.password_encoding": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccessPolicy(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "access_policy", err: fmt.Errorf(`ent: validator failed for field "Secret.access_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatusBeforeTrash(); ok {
+		if err := secret.StatusBeforeTrashValidator(v); err != nil {
+			return &ValidationError{Name: "status_before_trash", err: fmt.Errorf(`ent: validator failed for field "Secret.status_before_trash": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SecretUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecretUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SecretUpdateOne) sqlSave(ctx context.Context) (_node *Secret, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1206,6 +1806,65 @@ func (_u *SecretUpdateOne) sqlSave(ctx context.Context) (_node *Secret, err erro
 	if value, ok := _u.mutation.HasTotp(); ok {
 		_spec.SetField(secret.FieldHasTotp, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.LastAccessedTime(); ok {
+		_spec.SetField(secret.FieldLastAccessedTime, field.TypeTime, value)
+	}
+	if _u.mutation.LastAccessedTimeCleared() {
+		_spec.ClearField(secret.FieldLastAccessedTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(secret.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(secret.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Sensitive(); ok {
+		_spec.SetField(secret.FieldSensitive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PasswordEncoding(); ok {
+		_spec.SetField(secret.FieldPasswordEncoding, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Canary(); ok {
+		_spec.SetField(secret.FieldCanary, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessPolicy(); ok {
+		_spec.SetField(secret.FieldAccessPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessPolicyCleared() {
+		_spec.ClearField(secret.FieldAccessPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Protected(); ok {
+		_spec.SetField(secret.FieldProtected, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReferencedSecretIds(); ok {
+		_spec.SetField(secret.FieldReferencedSecretIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReferencedSecretIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, secret.FieldReferencedSecretIds, value)
+		})
+	}
+	if _u.mutation.ReferencedSecretIdsCleared() {
+		_spec.ClearField(secret.FieldReferencedSecretIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TrashedWithFolderID(); ok {
+		_spec.SetField(secret.FieldTrashedWithFolderID, field.TypeString, value)
+	}
+	if _u.mutation.TrashedWithFolderIDCleared() {
+		_spec.ClearField(secret.FieldTrashedWithFolderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatusBeforeTrash(); ok {
+		_spec.SetField(secret.FieldStatusBeforeTrash, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusBeforeTrashCleared() {
+		_spec.ClearField(secret.FieldStatusBeforeTrash, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.FieldKeyID(); ok {
+		_spec.SetField(secret.FieldFieldKeyID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedFieldKeyID(); ok {
+		_spec.AddField(secret.FieldFieldKeyID, field.TypeUint32, value)
+	}
 	if _u.mutation.FolderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1325,6 +1984,52 @@ func (_u *SecretUpdateOne) sqlSave(ctx context.Context) (_node *Secret, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   secret.CollectionsTable,
+			Columns: secret.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   secret.CollectionsTable,
+			Columns: secret.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   secret.CollectionsTable,
+			Columns: secret.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Secret{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
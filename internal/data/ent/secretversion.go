@@ -34,8 +34,12 @@ type SecretVersion struct {
 	VaultPath string `json:"vault_path,omitempty"`
 	// Version comment describing the change
 	Comment string `json:"comment,omitempty"`
-	// SHA-256 checksum of the password
+	// Checksum of the password, made with checksum_algorithm
 	Checksum string `json:"checksum,omitempty"`
+	// Algorithm of the checksum: sha256, or hmac-sha256:<key id>
+	ChecksumAlgorithm string `json:"checksum_algorithm,omitempty"`
+	// Version this one was restored from, if created by a restore
+	SourceVersion *int32 `json:"source_version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SecretVersionQuery when eager-loading is set.
 	Edges        SecretVersionEdges `json:"edges"`
@@ -67,9 +71,9 @@ func (*SecretVersion) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case secretversion.FieldID, secretversion.FieldCreateBy, secretversion.FieldVersionNumber:
+		case secretversion.FieldID, secretversion.FieldCreateBy, secretversion.FieldVersionNumber, secretversion.FieldSourceVersion:
 			values[i] = new(sql.NullInt64)
-		case secretversion.FieldSecretID, secretversion.FieldVaultPath, secretversion.FieldComment, secretversion.FieldChecksum:
+		case secretversion.FieldSecretID, secretversion.FieldVaultPath, secretversion.FieldComment, secretversion.FieldChecksum, secretversion.FieldChecksumAlgorithm:
 			values[i] = new(sql.NullString)
 		case secretversion.FieldCreateTime, secretversion.FieldUpdateTime, secretversion.FieldDeleteTime:
 			values[i] = new(sql.NullTime)
@@ -152,6 +156,19 @@ func (_m *SecretVersion) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Checksum = value.String
 			}
+		case secretversion.FieldChecksumAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum_algorithm", values[i])
+			} else if value.Valid {
+				_m.ChecksumAlgorithm = value.String
+			}
+		case secretversion.FieldSourceVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_version", values[i])
+			} else if value.Valid {
+				_m.SourceVersion = new(int32)
+				*_m.SourceVersion = int32(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -227,6 +244,14 @@ func (_m *SecretVersion) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("checksum=")
 	builder.WriteString(_m.Checksum)
+	builder.WriteString(", ")
+	builder.WriteString("checksum_algorithm=")
+	builder.WriteString(_m.ChecksumAlgorithm)
+	builder.WriteString(", ")
+	if v := _m.SourceVersion; v != nil {
+		builder.WriteString("source_version=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
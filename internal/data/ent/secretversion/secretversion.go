@@ -30,6 +30,10 @@ const (
 	FieldComment = "comment"
 	// FieldChecksum holds the string denoting the checksum field in the database.
 	FieldChecksum = "checksum"
+	// FieldChecksumAlgorithm holds the string denoting the checksum_algorithm field in the database.
+	FieldChecksumAlgorithm = "checksum_algorithm"
+	// FieldSourceVersion holds the string denoting the source_version field in the database.
+	FieldSourceVersion = "source_version"
 	// EdgeSecret holds the string denoting the secret edge name in mutations.
 	EdgeSecret = "secret"
 	// Table holds the table name of the secretversion in the database.
@@ -55,6 +59,8 @@ var Columns = []string{
 	FieldVaultPath,
 	FieldComment,
 	FieldChecksum,
+	FieldChecksumAlgorithm,
+	FieldSourceVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -78,6 +84,12 @@ var (
 	CommentValidator func(string) error
 	// ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
 	ChecksumValidator func(string) error
+	// DefaultChecksumAlgorithm holds the default value on creation for the "checksum_algorithm" field.
+	DefaultChecksumAlgorithm string
+	// ChecksumAlgorithmValidator is a validator for the "checksum_algorithm" field. It is called by the builders before save.
+	ChecksumAlgorithmValidator func(string) error
+	// SourceVersionValidator is a validator for the "source_version" field. It is called by the builders before save.
+	SourceVersionValidator func(int32) error
 )
 
 // OrderOption defines the ordering options for the SecretVersion queries.
@@ -133,6 +145,16 @@ func ByChecksum(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldChecksum, opts...).ToFunc()
 }
 
+// ByChecksumAlgorithm orders the results by the checksum_algorithm field.
+func ByChecksumAlgorithm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksumAlgorithm, opts...).ToFunc()
+}
+
+// BySourceVersion orders the results by the source_version field.
+func BySourceVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceVersion, opts...).ToFunc()
+}
+
 // BySecretField orders the results by secret field.
 func BySecretField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -100,6 +100,16 @@ func Checksum(v string) predicate.SecretVersion {
 	return predicate.SecretVersion(sql.FieldEQ(FieldChecksum, v))
 }
 
+// ChecksumAlgorithm applies equality check predicate on the "checksum_algorithm" field. It's identical to ChecksumAlgorithmEQ.
+func ChecksumAlgorithm(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldChecksumAlgorithm, v))
+}
+
+// SourceVersion applies equality check predicate on the "source_version" field. It's identical to SourceVersionEQ.
+func SourceVersion(v int32) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldSourceVersion, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.SecretVersion {
 	return predicate.SecretVersion(sql.FieldEQ(FieldCreateBy, v))
@@ -610,6 +620,121 @@ func ChecksumContainsFold(v string) predicate.SecretVersion {
 	return predicate.SecretVersion(sql.FieldContainsFold(FieldChecksum, v))
 }
 
+// ChecksumAlgorithmEQ applies the EQ predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmEQ(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmNEQ applies the NEQ predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmNEQ(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNEQ(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmIn applies the In predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmIn(vs ...string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldIn(FieldChecksumAlgorithm, vs...))
+}
+
+// ChecksumAlgorithmNotIn applies the NotIn predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmNotIn(vs ...string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNotIn(FieldChecksumAlgorithm, vs...))
+}
+
+// ChecksumAlgorithmGT applies the GT predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmGT(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGT(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmGTE applies the GTE predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmGTE(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGTE(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmLT applies the LT predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmLT(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLT(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmLTE applies the LTE predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmLTE(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLTE(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmContains applies the Contains predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmContains(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldContains(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmHasPrefix applies the HasPrefix predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmHasPrefix(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldHasPrefix(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmHasSuffix applies the HasSuffix predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmHasSuffix(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldHasSuffix(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmEqualFold applies the EqualFold predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmEqualFold(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEqualFold(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmContainsFold applies the ContainsFold predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmContainsFold(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldContainsFold(FieldChecksumAlgorithm, v))
+}
+
+// SourceVersionEQ applies the EQ predicate on the "source_version" field.
+func SourceVersionEQ(v int32) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldSourceVersion, v))
+}
+
+// SourceVersionNEQ applies the NEQ predicate on the "source_version" field.
+func SourceVersionNEQ(v int32) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNEQ(FieldSourceVersion, v))
+}
+
+// SourceVersionIn applies the In predicate on the "source_version" field.
+func SourceVersionIn(vs ...int32) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldIn(FieldSourceVersion, vs...))
+}
+
+// SourceVersionNotIn applies the NotIn predicate on the "source_version" field.
+func SourceVersionNotIn(vs ...int32) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNotIn(FieldSourceVersion, vs...))
+}
+
+// SourceVersionGT applies the GT predicate on the "source_version" field.
+func SourceVersionGT(v int32) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGT(FieldSourceVersion, v))
+}
+
+// SourceVersionGTE applies the GTE predicate on the "source_version" field.
+func SourceVersionGTE(v int32) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGTE(FieldSourceVersion, v))
+}
+
+// SourceVersionLT applies the LT predicate on the "source_version" field.
+func SourceVersionLT(v int32) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLT(FieldSourceVersion, v))
+}
+
+// SourceVersionLTE applies the LTE predicate on the "source_version" field.
+func SourceVersionLTE(v int32) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLTE(FieldSourceVersion, v))
+}
+
+// SourceVersionIsNil applies the IsNil predicate on the "source_version" field.
+func SourceVersionIsNil() predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldIsNull(FieldSourceVersion))
+}
+
+// SourceVersionNotNil applies the NotNil predicate on the "source_version" field.
+func SourceVersionNotNil() predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNotNull(FieldSourceVersion))
+}
+
 // HasSecret applies the HasEdge predicate on the "secret" edge.
 func HasSecret() predicate.SecretVersion {
 	return predicate.SecretVersion(func(s *sql.Selector) {
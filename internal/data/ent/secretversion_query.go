@@ -278,8 +278,9 @@ func (_q *SecretVersionQuery) Clone() *SecretVersionQuery {
 		predicates: append([]predicate.SecretVersion{}, _q.predicates...),
 		withSecret: _q.withSecret.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -552,6 +553,12 @@ func (_q *SecretVersionQuery) ForShare(opts ...sql.LockOption) *SecretVersionQue
 	return _q
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *SecretVersionQuery) Modify(modifiers ...func(s *sql.Selector)) *SecretVersionSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // SecretVersionGroupBy is the group-by builder for SecretVersion entities.
 type SecretVersionGroupBy struct {
 	selector
@@ -641,3 +648,9 @@ func (_s *SecretVersionSelect) sqlScan(ctx context.Context, root *SecretVersionQ
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *SecretVersionSelect) Modify(modifiers ...func(s *sql.Selector)) *SecretVersionSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -19,8 +19,9 @@ import (
 // SecretVersionUpdate is the builder for updating SecretVersion entities.
 type SecretVersionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *SecretVersionMutation
+	hooks     []Hook
+	mutation  *SecretVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the SecretVersionUpdate builder.
@@ -179,6 +180,47 @@ func (_u *SecretVersionUpdate) SetNillableChecksum(v *string) *SecretVersionUpda
 	return _u
 }
 
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (_u *SecretVersionUpdate) SetChecksumAlgorithm(v string) *SecretVersionUpdate {
+	_u.mutation.SetChecksumAlgorithm(v)
+	return _u
+}
+
+// SetNillableChecksumAlgorithm sets the "checksum_algorithm" field if the given value is not nil.
+func (_u *SecretVersionUpdate) SetNillableChecksumAlgorithm(v *string) *SecretVersionUpdate {
+	if v != nil {
+		_u.SetChecksumAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetSourceVersion sets the "source_version" field.
+func (_u *SecretVersionUpdate) SetSourceVersion(v int32) *SecretVersionUpdate {
+	_u.mutation.ResetSourceVersion()
+	_u.mutation.SetSourceVersion(v)
+	return _u
+}
+
+// SetNillableSourceVersion sets the "source_version" field if the given value is not nil.
+func (_u *SecretVersionUpdate) SetNillableSourceVersion(v *int32) *SecretVersionUpdate {
+	if v != nil {
+		_u.SetSourceVersion(*v)
+	}
+	return _u
+}
+
+// AddSourceVersion adds value to the "source_version" field.
+func (_u *SecretVersionUpdate) AddSourceVersion(v int32) *SecretVersionUpdate {
+	_u.mutation.AddSourceVersion(v)
+	return _u
+}
+
+// ClearSourceVersion clears the value of the "source_version" field.
+func (_u *SecretVersionUpdate) ClearSourceVersion() *SecretVersionUpdate {
+	_u.mutation.ClearSourceVersion()
+	return _u
+}
+
 // SetSecret sets the "secret" edge to the Secret entity.
 func (_u *SecretVersionUpdate) SetSecret(v *Secret) *SecretVersionUpdate {
 	return _u.SetSecretID(v.ID)
@@ -249,12 +291,28 @@ func (_u *SecretVersionUpdate) check() error {
 			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "SecretVersion.checksum": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ChecksumAlgorithm(); ok {
+		if err := secretversion.ChecksumAlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "checksum_algorithm", err: fmt.Errorf(`ent: validator failed for field "SecretVersion.checksum_algorithm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceVersion(); ok {
+		if err := secretversion.SourceVersionValidator(v); err != nil {
+			return &ValidationError{Name: "source_version", err: fmt.Errorf(`ent: validator failed for field "SecretVersion.source_version": %w`, err)}
+		}
+	}
 	if _u.mutation.SecretCleared() && len(_u.mutation.SecretIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "SecretVersion.secret"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SecretVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecretVersionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SecretVersionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -309,6 +367,18 @@ func (_u *SecretVersionUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.Checksum(); ok {
 		_spec.SetField(secretversion.FieldChecksum, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ChecksumAlgorithm(); ok {
+		_spec.SetField(secretversion.FieldChecksumAlgorithm, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceVersion(); ok {
+		_spec.SetField(secretversion.FieldSourceVersion, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedSourceVersion(); ok {
+		_spec.AddField(secretversion.FieldSourceVersion, field.TypeInt32, value)
+	}
+	if _u.mutation.SourceVersionCleared() {
+		_spec.ClearField(secretversion.FieldSourceVersion, field.TypeInt32)
+	}
 	if _u.mutation.SecretCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -338,6 +408,7 @@ func (_u *SecretVersionUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{secretversion.Label}
@@ -353,9 +424,10 @@ func (_u *SecretVersionUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // SecretVersionUpdateOne is the builder for updating a single SecretVersion entity.
 type SecretVersionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *SecretVersionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *SecretVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCreateBy sets the "create_by" field.
@@ -508,6 +580,47 @@ func (_u *SecretVersionUpdateOne) SetNillableChecksum(v *string) *SecretVersionU
 	return _u
 }
 
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (_u *SecretVersionUpdateOne) SetChecksumAlgorithm(v string) *SecretVersionUpdateOne {
+	_u.mutation.SetChecksumAlgorithm(v)
+	return _u
+}
+
+// SetNillableChecksumAlgorithm sets the "checksum_algorithm" field if the given value is not nil.
+func (_u *SecretVersionUpdateOne) SetNillableChecksumAlgorithm(v *string) *SecretVersionUpdateOne {
+	if v != nil {
+		_u.SetChecksumAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetSourceVersion sets the "source_version" field.
+func (_u *SecretVersionUpdateOne) SetSourceVersion(v int32) *SecretVersionUpdateOne {
+	_u.mutation.ResetSourceVersion()
+	_u.mutation.SetSourceVersion(v)
+	return _u
+}
+
+// SetNillableSourceVersion sets the "source_version" field if the given value is not nil.
+func (_u *SecretVersionUpdateOne) SetNillableSourceVersion(v *int32) *SecretVersionUpdateOne {
+	if v != nil {
+		_u.SetSourceVersion(*v)
+	}
+	return _u
+}
+
+// AddSourceVersion adds value to the "source_version" field.
+func (_u *SecretVersionUpdateOne) AddSourceVersion(v int32) *SecretVersionUpdateOne {
+	_u.mutation.AddSourceVersion(v)
+	return _u
+}
+
+// ClearSourceVersion clears the value of the "source_version" field.
+func (_u *SecretVersionUpdateOne) ClearSourceVersion() *SecretVersionUpdateOne {
+	_u.mutation.ClearSourceVersion()
+	return _u
+}
+
 // SetSecret sets the "secret" edge to the Secret entity.
 func (_u *SecretVersionUpdateOne) SetSecret(v *Secret) *SecretVersionUpdateOne {
 	return _u.SetSecretID(v.ID)
@@ -591,12 +704,28 @@ func (_u *SecretVersionUpdateOne) check() error {
 			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "SecretVersion.checksum": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ChecksumAlgorithm(); ok {
+		if err := secretversion.ChecksumAlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "checksum_algorithm", err: fmt.Errorf(`ent: validator failed for field "SecretVersion.checksum_algorithm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceVersion(); ok {
+		if err := secretversion.SourceVersionValidator(v); err != nil {
+			return &ValidationError{Name: "source_version", err: fmt.Errorf(`ent: validator failed for field "SecretVersion.source_version": %w`, err)}
+		}
+	}
 	if _u.mutation.SecretCleared() && len(_u.mutation.SecretIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "SecretVersion.secret"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SecretVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecretVersionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SecretVersionUpdateOne) sqlSave(ctx context.Context) (_node *SecretVersion, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -668,6 +797,18 @@ func (_u *SecretVersionUpdateOne) sqlSave(ctx context.Context) (_node *SecretVer
 	if value, ok := _u.mutation.Checksum(); ok {
 		_spec.SetField(secretversion.FieldChecksum, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ChecksumAlgorithm(); ok {
+		_spec.SetField(secretversion.FieldChecksumAlgorithm, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceVersion(); ok {
+		_spec.SetField(secretversion.FieldSourceVersion, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedSourceVersion(); ok {
+		_spec.AddField(secretversion.FieldSourceVersion, field.TypeInt32, value)
+	}
+	if _u.mutation.SourceVersionCleared() {
+		_spec.ClearField(secretversion.FieldSourceVersion, field.TypeInt32)
+	}
 	if _u.mutation.SecretCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -697,6 +838,7 @@ func (_u *SecretVersionUpdateOne) sqlSave(ctx context.Context) (_node *SecretVer
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &SecretVersion{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -12,16 +12,42 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditChainHead is the client for interacting with the AuditChainHead builders.
+	AuditChainHead *AuditChainHeadClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// Collection is the client for interacting with the Collection builders.
+	Collection *CollectionClient
+	// DeletionRequest is the client for interacting with the DeletionRequest builders.
+	DeletionRequest *DeletionRequestClient
+	// EmergencyAccess is the client for interacting with the EmergencyAccess builders.
+	EmergencyAccess *EmergencyAccessClient
+	// FieldKey is the client for interacting with the FieldKey builders.
+	FieldKey *FieldKeyClient
 	// Folder is the client for interacting with the Folder builders.
 	Folder *FolderClient
+	// FolderChangeLog is the client for interacting with the FolderChangeLog builders.
+	FolderChangeLog *FolderChangeLogClient
+	// PendingOperation is the client for interacting with the PendingOperation builders.
+	PendingOperation *PendingOperationClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
 	// Secret is the client for interacting with the Secret builders.
 	Secret *SecretClient
+	// SecretUsage is the client for interacting with the SecretUsage builders.
+	SecretUsage *SecretUsageClient
 	// SecretVersion is the client for interacting with the SecretVersion builders.
 	SecretVersion *SecretVersionClient
+	// SecurityAlert is the client for interacting with the SecurityAlert builders.
+	SecurityAlert *SecurityAlertClient
+	// TenantSetting is the client for interacting with the TenantSetting builders.
+	TenantSetting *TenantSettingClient
+	// VersionPin is the client for interacting with the VersionPin builders.
+	VersionPin *VersionPinClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -153,11 +179,24 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditChainHead = NewAuditChainHeadClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.Collection = NewCollectionClient(tx.config)
+	tx.DeletionRequest = NewDeletionRequestClient(tx.config)
+	tx.EmergencyAccess = NewEmergencyAccessClient(tx.config)
+	tx.FieldKey = NewFieldKeyClient(tx.config)
 	tx.Folder = NewFolderClient(tx.config)
+	tx.FolderChangeLog = NewFolderChangeLogClient(tx.config)
+	tx.PendingOperation = NewPendingOperationClient(tx.config)
 	tx.Permission = NewPermissionClient(tx.config)
 	tx.Secret = NewSecretClient(tx.config)
+	tx.SecretUsage = NewSecretUsageClient(tx.config)
 	tx.SecretVersion = NewSecretVersionClient(tx.config)
+	tx.SecurityAlert = NewSecurityAlertClient(tx.config)
+	tx.TenantSetting = NewTenantSettingClient(tx.config)
+	tx.VersionPin = NewVersionPinClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -167,7 +206,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AuditLog.QueryXXX(), the query will be executed
+// applies a query, for example: AuditChainHead.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
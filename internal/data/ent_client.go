@@ -1,8 +1,12 @@
 package data
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"strings"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -43,11 +47,24 @@ func NewEntClient(ctx *bootstrap.Context) (*entCrud.EntClient[*ent.Client], func
 			return nil
 		}
 
+		client.Intercept(entTracingInterceptor())
+		client.Use(entTracingHook())
+
+		migrator, err := NewMigrator(drv, l)
+		if err != nil {
+			l.Fatalf("failed loading schema migrations: %v", err)
+			return nil
+		}
+
 		// Run database migrations
 		if cfg.Data.Database.GetMigrate() {
-			if err := client.Schema.Create(context.Background(), migrate.WithForeignKeys(true)); err != nil {
-				l.Fatalf("failed creating schema resources: %v", err)
+			if _, err := migrator.Up(context.Background(), 0); err != nil {
+				l.Fatalf("failed migrating schema: %v", err)
 			}
+		} else if status, err := migrator.Status(context.Background()); err != nil {
+			l.Warnf("failed reading schema migration status: %v", err)
+		} else if !status.Tracked || status.Pending > 0 {
+			l.Warnf("database schema is behind this build (%d pending migrations), run `server migrate up`", status.Pending)
 		}
 
 		return client
@@ -59,3 +76,75 @@ func NewEntClient(ctx *bootstrap.Context) (*entCrud.EntClient[*ent.Client], func
 		}
 	}, nil
 }
+
+// OpenDriver connects to the configured database without migrating it, for
+// tools that must leave the schema alone or manage it themselves
+func OpenDriver(ctx *bootstrap.Context) (*sql.Driver, error) {
+	cfg := ctx.GetConfig()
+	if cfg == nil || cfg.Data == nil || cfg.Data.Database == nil {
+		return nil, errors.New("no database config")
+	}
+	return sql.Open(entDialect(cfg.Data.Database.GetDriver()), cfg.Data.Database.GetSource())
+}
+
+// PendingSchemaChanges returns the statements migrating the database would
+// run, none when the schema matches this build
+func PendingSchemaChanges(ctx context.Context, client *ent.Client) ([]string, error) {
+	var buf bytes.Buffer
+	if err := client.Schema.WriteTo(ctx, &buf, migrate.WithForeignKeys(true)); err != nil {
+		return nil, err
+	}
+
+	var stmts []string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line == "BEGIN;" || line == "COMMIT;" {
+			continue
+		}
+		stmts = append(stmts, line)
+	}
+	return stmts, nil
+}
+
+// entDialect maps a configured database driver to its ent dialect
+func entDialect(driver string) string {
+	switch {
+	case isPostgresDriver(driver):
+		return dialect.Postgres
+	case isSQLiteDriver(driver):
+		return dialect.SQLite
+	}
+	return dialect.MySQL
+}
+
+// isSQLiteDriver reports whether the configured database driver is SQLite.
+// The driver is only compiled in with the sqlite build tag.
+func isSQLiteDriver(driver string) bool {
+	driver = strings.ToLower(driver)
+	return driver == "sqlite3" || driver == "sqlite"
+}
+
+// supportsRowLocks reports whether the configured database has SELECT ...
+// FOR UPDATE. SQLite does not; it serializes write transactions instead.
+func supportsRowLocks(ctx *bootstrap.Context) bool {
+	cfg := ctx.GetConfig()
+	if cfg == nil || cfg.Data == nil || cfg.Data.Database == nil {
+		return true
+	}
+	return !isSQLiteDriver(cfg.Data.Database.GetDriver())
+}
+
+// forUpdate locks the rows a query selects until the transaction ends, when
+// the database supports row locks
+func forUpdate[Q interface{ ForUpdate(...sql.LockOption) Q }](q Q, rowLocks bool, opts ...sql.LockOption) Q {
+	if !rowLocks {
+		return q
+	}
+	return q.ForUpdate(opts...)
+}
+
+// PingDatabase checks that the database answers a trivial query
+func PingDatabase(ctx context.Context, entClient *entCrud.EntClient[*ent.Client]) error {
+	_, err := entClient.Client().Folder.Query().Limit(1).IDs(ctx)
+	return err
+}
@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"strings"
+
+	entgo "entgo.io/ent"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+)
+
+var entTracer = otel.Tracer("github.com/go-tangra/go-tangra-warden/internal/data")
+
+// entTracingInterceptor starts a span for every ent query, e.g. "ent.Secret.All"
+func entTracingInterceptor() ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			name, entityType, op := "ent.query", "", ""
+			if qc := entgo.QueryFromContext(ctx); qc != nil {
+				entityType, op = qc.Type, qc.Op
+				name = "ent." + entityType + "." + op
+			}
+
+			ctx, span := startEntSpan(ctx, name, entityType, op)
+			v, err := next.Query(ctx, q)
+			endEntSpan(span, err)
+			return v, err
+		})
+	})
+}
+
+// entTracingHook starts a span for every ent mutation, e.g. "ent.Secret.Create"
+func entTracingHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			op := strings.TrimPrefix(m.Op().String(), "Op")
+
+			ctx, span := startEntSpan(ctx, "ent."+m.Type()+"."+op, m.Type(), op)
+			v, err := next.Mutate(ctx, m)
+			endEntSpan(span, err)
+			return v, err
+		})
+	}
+}
+
+func startEntSpan(ctx context.Context, name, entityType, op string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("db.system", "ent"),
+		attribute.String("db.operation", op),
+		attribute.String("warden.resource_type", entityType),
+	}
+	if tenantID := grpcx.GetTenantIDFromContext(ctx); tenantID != 0 {
+		attrs = append(attrs, attribute.Int64("warden.tenant_id", int64(tenantID)))
+	}
+	return entTracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+func endEntSpan(span trace.Span, err error) {
+	if err != nil && !ent.IsNotFound(err) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,104 @@
+package data
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// ExportPolicy decides which secrets of a tenant must never leave Warden in
+// Bitwarden, CSV or backup exports. A nil policy excludes nothing.
+type ExportPolicy struct {
+	excludedTags    map[string]bool
+	excludedFolders map[string]bool
+}
+
+// Excludes reports whether the secret is tagged with an excluded tag or lives
+// in the subtree of an excluded folder
+func (p *ExportPolicy) Excludes(s *ent.Secret) bool {
+	if p == nil {
+		return false
+	}
+	if s.FolderID != nil && p.excludedFolders[*s.FolderID] {
+		return true
+	}
+	for _, tag := range SecretTags(s.Metadata) {
+		if p.excludedTags[strings.ToLower(tag)] {
+			return true
+		}
+	}
+	return false
+}
+
+// GetExportPolicy loads the export policy of a tenant, resolving excluded
+// folders to their whole subtrees. Returns nil when the tenant has no policy.
+func (r *TenantSettingRepo) GetExportPolicy(ctx context.Context, tenantID uint32) (*ExportPolicy, error) {
+	setting, err := r.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil || (len(setting.ExportExcludedTags) == 0 && len(setting.ExportExcludedFolderIds) == 0) {
+		return nil, nil
+	}
+
+	policy := &ExportPolicy{
+		excludedTags:    make(map[string]bool, len(setting.ExportExcludedTags)),
+		excludedFolders: make(map[string]bool),
+	}
+	for _, tag := range setting.ExportExcludedTags {
+		policy.excludedTags[strings.ToLower(tag)] = true
+	}
+
+	if len(setting.ExportExcludedFolderIds) > 0 {
+		roots, err := r.entClient.Client().Folder.Query().
+			Where(folder.TenantIDEQ(tenantID), folder.IDIn(setting.ExportExcludedFolderIds...)).
+			All(ctx)
+		if err != nil {
+			r.log.Errorf("resolve export policy folders failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("get export policy failed")
+		}
+
+		for _, root := range roots {
+			policy.excludedFolders[root.ID] = true
+
+			descendants, err := r.entClient.Client().Folder.Query().
+				Where(folder.TenantIDEQ(tenantID), folder.PathHasPrefix(root.Path+"/")).
+				Select(folder.FieldID).
+				All(ctx)
+			if err != nil {
+				r.log.Errorf("resolve export policy subfolders failed: %s", err.Error())
+				return nil, wardenV1.ErrorInternalServerError("get export policy failed")
+			}
+			for _, d := range descendants {
+				policy.excludedFolders[d.ID] = true
+			}
+		}
+	}
+
+	return policy, nil
+}
+
+// SecretTags reads the tags from secret metadata, accepting a list or a single string
+func SecretTags(metadata map[string]any) []string {
+	switch v := metadata[SecretTagsKey].(type) {
+	case []any:
+		tags := make([]string, 0, len(v))
+		for _, t := range v {
+			if tag, ok := t.(string); ok && tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+		return tags
+	case []string:
+		return v
+	case string:
+		if v != "" {
+			return []string{v}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,579 @@
+package data
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/fieldkey"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/hook"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+const (
+	// fieldCiphertextPrefix starts encrypted values, followed by the ID of
+	// the field key and a colon ("wfe:3:...")
+	fieldCiphertextPrefix = "wfe:"
+
+	// sealedMetadataKey holds the encrypted metadata beside the keys that
+	// stay in plaintext for queries
+	sealedMetadataKey = "_sealed"
+
+	// fieldKeyRefreshInterval is how often the field keys are reloaded, so
+	// instances pick up a key another instance rotated to
+	fieldKeyRefreshInterval = 5 * time.Minute
+)
+
+// plaintextMetadataKeys stay readable in encrypted metadata: tag selectors,
+// the expiry listing and item type filters query them in the database
+var plaintextMetadataKeys = []string{SecretTagsKey, SecretExpiresAtKey, SecretItemTypeKey}
+
+// FieldCipher encrypts the username, URL and metadata of secrets at rest with
+// AES-256-GCM under data keys wrapped by a Vault Transit key, so the database
+// alone does not tell which systems the credentials belong to. It hooks into
+// the ent clients: values are encrypted when saved and decrypted when loaded,
+// so repositories and services see plaintext. It is enabled by naming the
+// Transit key in FIELD_ENCRYPTION_KEY.
+type FieldCipher struct {
+	log        *log.Helper
+	entClient  *entCrud.EntClient[*ent.Client]
+	vault      *vault.Client
+	mount      string
+	transitKey string
+	// writes is unset to store new values in plaintext while still reading
+	// encrypted ones, for turning encryption off
+	writes bool
+
+	mu     sync.RWMutex
+	keys   map[uint32]cipher.AEAD
+	active uint32
+	loaded time.Time
+}
+
+// NewFieldCipher loads the field keys, creating the first one when there is
+// none, and registers the encryption on the primary and replica clients.
+// VAULT_TRANSIT_MOUNT sets the Transit mount (default "transit") and
+// FIELD_ENCRYPTION_WRITES=false stops encrypting new values.
+func NewFieldCipher(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], replica *ReadReplica, vaultClient *vault.Client) (*FieldCipher, error) {
+	c := &FieldCipher{
+		log:        ctx.NewLoggerHelper("warden/field_cipher"),
+		entClient:  entClient,
+		vault:      vaultClient,
+		mount:      getEnvOrDefault("VAULT_TRANSIT_MOUNT", vault.DefaultTransitMount),
+		transitKey: os.Getenv("FIELD_ENCRYPTION_KEY"),
+		writes:     true,
+		keys:       make(map[uint32]cipher.AEAD),
+	}
+	if writes, err := strconv.ParseBool(getEnvOrDefault("FIELD_ENCRYPTION_WRITES", "true")); err == nil {
+		c.writes = writes
+	}
+
+	loadCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if c.transitKey == "" {
+		// Encrypted values cannot be read without the keys, which is worth
+		// more than a failed request each time one is loaded
+		if n, err := entClient.Client().FieldKey.Query().Count(loadCtx); err == nil && n > 0 {
+			c.log.Errorf("FIELD_ENCRYPTION_KEY is unset but %d field keys exist: encrypted usernames, URLs and metadata cannot be read", n)
+		}
+		return c, nil
+	}
+
+	if err := c.reload(loadCtx); err != nil {
+		return nil, err
+	}
+	if c.writes && c.active == 0 {
+		if _, err := c.Rotate(loadCtx, false); err != nil {
+			return nil, err
+		}
+	}
+
+	clients := []*ent.Client{entClient.Client()}
+	if replica != nil && replica.client != nil {
+		clients = append(clients, replica.client)
+	}
+	for _, client := range clients {
+		client.Secret.Use(c.hook())
+		client.Secret.Intercept(c.interceptor())
+	}
+
+	c.log.Infof("Field encryption enabled with Transit key %s/%s, field key %d", c.mount, c.transitKey, c.ActiveKeyID())
+	return c, nil
+}
+
+// Enabled reports whether field encryption is configured
+func (c *FieldCipher) Enabled() bool {
+	return c.transitKey != ""
+}
+
+// ActiveKeyID returns the ID of the field key encrypting new values, 0 when
+// they are stored in plaintext
+func (c *FieldCipher) ActiveKeyID() uint32 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.active
+}
+
+// reload loads the field keys not loaded yet, unwrapping them with Transit,
+// and makes the newest the active one
+func (c *FieldCipher) reload(ctx context.Context) error {
+	entities, err := c.entClient.Client().FieldKey.Query().
+		Order(ent.Asc(fieldkey.FieldID)).
+		All(ctx)
+	if err != nil {
+		c.log.Errorf("list field keys failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("list field keys failed")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, entity := range entities {
+		if _, ok := c.keys[entity.ID]; !ok {
+			plaintext, err := c.vault.UnwrapDataKey(ctx, c.mount, entity.TransitKey, entity.WrappedKey)
+			if err != nil {
+				return fmt.Errorf("failed to unwrap field key %d: %w", entity.ID, err)
+			}
+			aead, err := newFieldAEAD(plaintext)
+			if err != nil {
+				return fmt.Errorf("invalid field key %d: %w", entity.ID, err)
+			}
+			c.keys[entity.ID] = aead
+		}
+		if c.writes {
+			c.active = entity.ID
+		}
+	}
+	c.loaded = time.Now()
+	return nil
+}
+
+// Rotate creates a new field key from Transit, which encrypts new values from
+// then on, after wrapping the existing ones again with the latest version of
+// the Transit key. With rewrapOnly no key is created. It returns how many
+// keys were rewrapped.
+func (c *FieldCipher) Rotate(ctx context.Context, rewrapOnly bool) (int, error) {
+	if !c.Enabled() {
+		return 0, wardenV1.ErrorBadRequest("field encryption is not enabled")
+	}
+
+	client := c.entClient.Client()
+	entities, err := client.FieldKey.Query().All(ctx)
+	if err != nil {
+		c.log.Errorf("list field keys failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("list field keys failed")
+	}
+
+	rewrapped := 0
+	for _, entity := range entities {
+		wrapped, err := c.vault.RewrapDataKey(ctx, c.mount, entity.TransitKey, entity.WrappedKey)
+		if err != nil {
+			return rewrapped, wardenV1.ErrorVaultOperationError("rewrap field key %d failed", entity.ID)
+		}
+		if wrapped == entity.WrappedKey {
+			continue
+		}
+		if err := client.FieldKey.UpdateOneID(entity.ID).SetWrappedKey(wrapped).Exec(ctx); err != nil {
+			c.log.Errorf("update field key failed: %s", err.Error())
+			return rewrapped, wardenV1.ErrorInternalServerError("update field key failed")
+		}
+		rewrapped++
+	}
+	if rewrapOnly {
+		return rewrapped, nil
+	}
+
+	key, err := c.vault.GenerateDataKey(ctx, c.mount, c.transitKey)
+	if err != nil {
+		c.log.Errorf("generate field key failed: %v", err)
+		return rewrapped, wardenV1.ErrorVaultOperationError("generate field key failed")
+	}
+	aead, err := newFieldAEAD(key.Plaintext)
+	if err != nil {
+		return rewrapped, err
+	}
+	entity, err := client.FieldKey.Create().
+		SetTransitKey(c.transitKey).
+		SetWrappedKey(key.Wrapped).
+		Save(ctx)
+	if err != nil {
+		c.log.Errorf("create field key failed: %s", err.Error())
+		return rewrapped, wardenV1.ErrorInternalServerError("create field key failed")
+	}
+
+	c.mu.Lock()
+	c.keys[entity.ID] = aead
+	if c.writes {
+		c.active = entity.ID
+	}
+	c.mu.Unlock()
+
+	c.log.Infof("Field key %d created with Transit key %s", entity.ID, c.transitKey)
+	return rewrapped, nil
+}
+
+// activeKey returns the key encrypting new values, nil for plaintext,
+// reloading the keys now and then to follow rotations by other instances
+func (c *FieldCipher) activeKey(ctx context.Context) (uint32, cipher.AEAD) {
+	c.mu.RLock()
+	stale := time.Since(c.loaded) > fieldKeyRefreshInterval
+	c.mu.RUnlock()
+	if stale {
+		if err := c.reload(ctx); err != nil {
+			c.log.Warnf("Reloading field keys failed: %v", err)
+		}
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.active == 0 {
+		return 0, nil
+	}
+	return c.active, c.keys[c.active]
+}
+
+// key returns a key by ID, reloading the keys once when it is unknown
+func (c *FieldCipher) key(ctx context.Context, id uint32) (cipher.AEAD, error) {
+	c.mu.RLock()
+	aead, ok := c.keys[id]
+	c.mu.RUnlock()
+	if ok {
+		return aead, nil
+	}
+
+	if err := c.reload(ctx); err != nil {
+		return nil, err
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if aead, ok = c.keys[id]; !ok {
+		return nil, fmt.Errorf("unknown field key %d", id)
+	}
+	return aead, nil
+}
+
+// encryptField encrypts a value, binding it to the field it is stored in
+func encryptField(keyID uint32, aead cipher.AEAD, field string, plaintext []byte) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, plaintext, []byte(field))
+	return fieldCiphertextPrefix + strconv.FormatUint(uint64(keyID), 10) + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decrypt decrypts a value of a field, returning plaintext values as they are
+func (c *FieldCipher) decrypt(ctx context.Context, field, value string) ([]byte, bool, error) {
+	rest, ok := strings.CutPrefix(value, fieldCiphertextPrefix)
+	if !ok {
+		return nil, false, nil
+	}
+	id, encoded, ok := strings.Cut(rest, ":")
+	keyID, err := strconv.ParseUint(id, 10, 32)
+	if !ok || err != nil {
+		return nil, false, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	aead, err := c.key(ctx, uint32(keyID))
+	if err != nil {
+		return nil, true, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, true, fmt.Errorf("truncated %s ciphertext", field)
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(field))
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to decrypt %s: %w", field, err)
+	}
+	return plaintext, true, nil
+}
+
+// hook encrypts the username, URL and metadata set by a secret mutation with
+// the active key. An update of some of them re-encrypts the others too, so a
+// secret is always under a single key recorded in field_key_id.
+func (c *FieldCipher) hook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.SecretFunc(func(ctx context.Context, m *ent.SecretMutation) (ent.Value, error) {
+			if err := c.sealMutation(ctx, m); err != nil {
+				c.log.Errorf("encrypt secret fields failed: %v", err)
+				return nil, wardenV1.ErrorInternalServerError("encrypt secret fields failed")
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			// The saved entity carries the values as stored
+			if s, ok := v.(*ent.Secret); ok {
+				if err := c.open(ctx, s); err != nil {
+					c.log.Errorf("decrypt secret fields failed: %v", err)
+					return nil, wardenV1.ErrorInternalServerError("decrypt secret fields failed")
+				}
+			}
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpUpdateOne)
+}
+
+func (c *FieldCipher) sealMutation(ctx context.Context, m *ent.SecretMutation) error {
+	username, hasUsername := m.Username()
+	hostURL, hasHostURL := m.HostURL()
+	metadata, hasMetadata := m.Metadata()
+	if !hasUsername && !hasHostURL && !hasMetadata {
+		return nil
+	}
+
+	if m.Op().Is(ent.OpUpdateOne) {
+		var err error
+		if !hasUsername {
+			if username, err = m.OldUsername(ctx); err != nil {
+				return err
+			}
+		}
+		if !hasHostURL {
+			if hostURL, err = m.OldHostURL(ctx); err != nil {
+				return err
+			}
+		}
+		if !hasMetadata {
+			if metadata, err = m.OldMetadata(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	keyID, aead := c.activeKey(ctx)
+	m.SetFieldKeyID(keyID)
+	if aead == nil {
+		m.SetUsername(username)
+		m.SetHostURL(hostURL)
+		if metadata != nil {
+			m.SetMetadata(metadata)
+		}
+		return nil
+	}
+
+	for _, f := range []struct {
+		name  string
+		value string
+		set   func(string)
+	}{
+		{secret.FieldUsername, username, m.SetUsername},
+		{secret.FieldHostURL, hostURL, m.SetHostURL},
+	} {
+		if f.value == "" {
+			f.set("")
+			continue
+		}
+		sealed, err := encryptField(keyID, aead, f.name, []byte(f.value))
+		if err != nil {
+			return err
+		}
+		f.set(sealed)
+	}
+
+	if len(metadata) == 0 {
+		return nil
+	}
+	stored := make(map[string]any, len(plaintextMetadataKeys)+1)
+	private := make(map[string]any, len(metadata))
+	for k, v := range metadata {
+		if isPlaintextMetadataKey(k) {
+			stored[k] = v
+		} else {
+			private[k] = v
+		}
+	}
+	if len(private) > 0 {
+		plaintext, err := json.Marshal(private)
+		if err != nil {
+			return err
+		}
+		sealed, err := encryptField(keyID, aead, secret.FieldMetadata, plaintext)
+		if err != nil {
+			return err
+		}
+		stored[sealedMetadataKey] = sealed
+	}
+	m.SetMetadata(stored)
+	return nil
+}
+
+func isPlaintextMetadataKey(key string) bool {
+	for _, k := range plaintextMetadataKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// open decrypts the username, URL and metadata of a loaded secret in place
+func (c *FieldCipher) open(ctx context.Context, s *ent.Secret) error {
+	for _, f := range []struct {
+		name  string
+		value *string
+	}{
+		{secret.FieldUsername, &s.Username},
+		{secret.FieldHostURL, &s.HostURL},
+	} {
+		plaintext, encrypted, err := c.decrypt(ctx, f.name, *f.value)
+		if err != nil {
+			return err
+		}
+		if encrypted {
+			*f.value = string(plaintext)
+		}
+	}
+
+	sealed, ok := s.Metadata[sealedMetadataKey].(string)
+	if !ok {
+		return nil
+	}
+	plaintext, encrypted, err := c.decrypt(ctx, secret.FieldMetadata, sealed)
+	if err != nil || !encrypted {
+		return err
+	}
+	private := make(map[string]any)
+	if err := json.Unmarshal(plaintext, &private); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+	for k, v := range s.Metadata {
+		if k != sealedMetadataKey {
+			private[k] = v
+		}
+	}
+	s.Metadata = private
+	return nil
+}
+
+// interceptor decrypts the secrets returned by queries, including the ones
+// loaded along with other entities
+func (c *FieldCipher) interceptor() ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			v, err := next.Query(ctx, q)
+			if err != nil {
+				return v, err
+			}
+			secrets, ok := v.([]*ent.Secret)
+			if !ok {
+				return v, nil
+			}
+			for _, s := range secrets {
+				if err := c.open(ctx, s); err != nil {
+					c.log.Errorf("decrypt fields of secret %s failed: %v", s.ID, err)
+					return nil, wardenV1.ErrorInternalServerError("decrypt secret fields failed")
+				}
+			}
+			return v, nil
+		})
+	})
+}
+
+// Reencrypt encrypts the username, URL and metadata of up to limit secrets
+// stored under another key than the active one (or in plaintext) again with
+// the active one, or stores them in plaintext when encryption of new values
+// is off. It returns the secrets re-encrypted and the ones that failed.
+func (c *FieldCipher) Reencrypt(ctx context.Context, tenantID *uint32, limit int, dryRun bool) (int, []string, error) {
+	if !c.Enabled() {
+		return 0, nil, wardenV1.ErrorBadRequest("field encryption is not enabled")
+	}
+	active := c.ActiveKeyID()
+	client := c.entClient.Client()
+
+	var (
+		done   int
+		failed []string
+		after  string
+	)
+	for done+len(failed) < limit {
+		query := client.Secret.Query().
+			Where(secret.FieldKeyIDNEQ(active), secret.IDGT(after))
+		if tenantID != nil {
+			query = query.Where(secret.TenantIDEQ(*tenantID))
+		}
+		secrets, err := query.
+			Order(ent.Asc(secret.FieldID)).
+			Limit(min(100, limit-done-len(failed))).
+			All(ctx)
+		if err != nil {
+			c.log.Errorf("list secrets to re-encrypt failed: %s", err.Error())
+			return done, failed, wardenV1.ErrorInternalServerError("list secrets failed")
+		}
+		if len(secrets) == 0 {
+			break
+		}
+
+		for _, s := range secrets {
+			after = s.ID
+			if dryRun {
+				done++
+				continue
+			}
+			// Keeping the update time leaves "recently updated" listings
+			// and sync cursors alone
+			err := client.Secret.UpdateOneID(s.ID).
+				SetUsername(s.Username).
+				SetHostURL(s.HostURL).
+				SetMetadata(s.Metadata).
+				SetNillableUpdateTime(s.UpdateTime).
+				Exec(ctx)
+			if err != nil {
+				c.log.Errorf("re-encrypt secret %s failed: %v", s.ID, err)
+				failed = append(failed, s.ID)
+				continue
+			}
+			done++
+		}
+	}
+	return done, failed, nil
+}
+
+// CountOutdated counts the secrets not stored under the active key
+func (c *FieldCipher) CountOutdated(ctx context.Context, tenantID *uint32) (int, error) {
+	query := c.entClient.Client().Secret.Query().
+		Where(secret.FieldKeyIDNEQ(c.ActiveKeyID()))
+	if tenantID != nil {
+		query = query.Where(secret.TenantIDEQ(*tenantID))
+	}
+	n, err := query.Count(ctx)
+	if err != nil {
+		c.log.Errorf("count secrets to re-encrypt failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("count secrets failed")
+	}
+	return n, nil
+}
+
+func newFieldAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("field keys are 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
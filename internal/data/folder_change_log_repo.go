@@ -0,0 +1,169 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folderchangelog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// FolderChangeLogRepo stores the history of the folder structure
+type FolderChangeLogRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	replica   *ReadReplica
+	log       *log.Helper
+}
+
+// NewFolderChangeLogRepo creates a new FolderChangeLogRepo
+func NewFolderChangeLogRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], replica *ReadReplica) *FolderChangeLogRepo {
+	return &FolderChangeLogRepo{
+		log:       ctx.NewLoggerHelper("warden/folder_change_log_repo"),
+		entClient: entClient,
+		replica:   replica,
+	}
+}
+
+// FolderChange describes one change to the folder structure
+type FolderChange struct {
+	FolderID    string
+	Action      folderchangelog.Action
+	OldPath     string
+	NewPath     string
+	OldParentID *string
+	NewParentID *string
+	ActorID     string
+	Details     map[string]string
+}
+
+// Record stores a change, inside the caller's transaction if there is one
+func (r *FolderChangeLogRepo) Record(ctx context.Context, tenantID uint32, change FolderChange) error {
+	builder := dbClient(ctx, r.entClient).FolderChangeLog.Create().
+		SetTenantID(tenantID).
+		SetFolderID(change.FolderID).
+		SetAction(change.Action).
+		SetCreateTime(time.Now())
+
+	if change.OldPath != "" {
+		builder.SetOldPath(change.OldPath)
+	}
+	if change.NewPath != "" {
+		builder.SetNewPath(change.NewPath)
+	}
+	if change.OldParentID != nil && *change.OldParentID != "" {
+		builder.SetOldParentID(*change.OldParentID)
+	}
+	if change.NewParentID != nil && *change.NewParentID != "" {
+		builder.SetNewParentID(*change.NewParentID)
+	}
+	if change.ActorID != "" {
+		builder.SetActorID(change.ActorID)
+	}
+	if len(change.Details) > 0 {
+		builder.SetDetails(change.Details)
+	}
+
+	if _, err := builder.Save(ctx); err != nil {
+		r.log.Errorf("record folder change failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("record folder change failed")
+	}
+	return nil
+}
+
+// FolderChangeListOptions contains options for listing folder changes
+type FolderChangeListOptions struct {
+	TenantID uint32
+	FolderID *string
+	// PathPrefix also matches changes of the folders below this path, by
+	// their path before or after the change
+	PathPrefix *string
+	ActorID    *string
+	Action     *folderchangelog.Action
+	StartTime  *time.Time
+	EndTime    *time.Time
+	Page       uint32
+	PageSize   uint32
+}
+
+// List retrieves folder changes, newest first
+func (r *FolderChangeLogRepo) List(ctx context.Context, opts *FolderChangeListOptions) ([]*ent.FolderChangeLog, int, error) {
+	query := r.replica.readClient(ctx, r.entClient).FolderChangeLog.Query().
+		Where(folderchangelog.TenantIDEQ(opts.TenantID))
+
+	var folderPreds []predicate.FolderChangeLog
+	if opts.FolderID != nil {
+		folderPreds = append(folderPreds, folderchangelog.FolderIDEQ(*opts.FolderID))
+	}
+	if opts.PathPrefix != nil {
+		prefix := *opts.PathPrefix + "/"
+		folderPreds = append(folderPreds,
+			folderchangelog.OldPathHasPrefix(prefix),
+			folderchangelog.NewPathHasPrefix(prefix),
+		)
+	}
+	if len(folderPreds) > 0 {
+		query = query.Where(folderchangelog.Or(folderPreds...))
+	}
+	if opts.ActorID != nil {
+		query = query.Where(folderchangelog.ActorIDEQ(*opts.ActorID))
+	}
+	if opts.Action != nil {
+		query = query.Where(folderchangelog.ActionEQ(*opts.Action))
+	}
+	if opts.StartTime != nil {
+		query = query.Where(folderchangelog.CreateTimeGTE(*opts.StartTime))
+	}
+	if opts.EndTime != nil {
+		query = query.Where(folderchangelog.CreateTimeLTE(*opts.EndTime))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count folder changes failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("count folder changes failed")
+	}
+
+	if opts.Page > 0 && opts.PageSize > 0 {
+		query = query.Offset(int((opts.Page - 1) * opts.PageSize)).Limit(int(opts.PageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(folderchangelog.FieldCreateTime), ent.Desc(folderchangelog.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list folder changes failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("list folder changes failed")
+	}
+
+	return entities, total, nil
+}
+
+// ToProto converts an ent.FolderChangeLog to wardenV1.FolderChange
+func (r *FolderChangeLogRepo) ToProto(entity *ent.FolderChangeLog) *wardenV1.FolderChange {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &wardenV1.FolderChange{
+		Id:          entity.ID,
+		FolderId:    entity.FolderID,
+		Action:      wardenV1.FolderChangeAction(wardenV1.FolderChangeAction_value[string(entity.Action)]),
+		OldPath:     entity.OldPath,
+		NewPath:     entity.NewPath,
+		OldParentId: entity.OldParentID,
+		NewParentId: entity.NewParentID,
+		ActorId:     entity.ActorID,
+		Details:     entity.Details,
+	}
+	if entity.CreateTime != nil {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	return proto
+}
@@ -0,0 +1,44 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+)
+
+// Folders carry denormalized secret_count and subfolder_count columns so
+// listings and trees do not run two COUNT queries per folder. The repos
+// adjust them in the same statement sequence (and transaction, when there is
+// one) as the row change they count; writes that bypass the repos, such as
+// backup restores, are corrected by the reconciliation job.
+
+// isLiveSecret reports whether a secret with the given status is counted
+func isLiveSecret(status secret.Status) bool {
+	return status != secret.StatusSECRET_STATUS_DELETED
+}
+
+// addFolderSecretCount adds delta to the secret counter of a folder.
+// Root-level secrets (nil or empty folderID) are not counted.
+func addFolderSecretCount(ctx context.Context, client *ent.Client, tenantID uint32, folderID *string, delta int32) error {
+	if folderID == nil || *folderID == "" || delta == 0 {
+		return nil
+	}
+	return client.Folder.Update().
+		Where(folder.IDEQ(*folderID), folder.TenantIDEQ(tenantID)).
+		AddSecretCount(delta).
+		Exec(ctx)
+}
+
+// addFolderSubfolderCount adds delta to the subfolder counter of a folder.
+// Root folders (nil or empty parentID) have no parent to count them.
+func addFolderSubfolderCount(ctx context.Context, client *ent.Client, tenantID uint32, parentID *string, delta int32) error {
+	if parentID == nil || *parentID == "" || delta == 0 {
+		return nil
+	}
+	return client.Folder.Update().
+		Where(folder.IDEQ(*parentID), folder.TenantIDEQ(tenantID)).
+		AddSubfolderCount(delta).
+		Exec(ctx)
+}
@@ -8,12 +8,15 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	entCrud "github.com/tx7do/go-crud/entgo"
 
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
 
 	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
@@ -29,13 +32,17 @@ func derefUint32(p *uint32) uint32 {
 
 type FolderRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
+	replica   *ReadReplica
 	log       *log.Helper
+	rowLocks  bool
 }
 
-func NewFolderRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *FolderRepo {
+func NewFolderRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], replica *ReadReplica) *FolderRepo {
 	return &FolderRepo{
 		log:       ctx.NewLoggerHelper("folder/repo"),
 		entClient: entClient,
+		replica:   replica,
+		rowLocks:  supportsRowLocks(ctx),
 	}
 }
 
@@ -48,7 +55,7 @@ func (r *FolderRepo) Create(ctx context.Context, tenantID uint32, parentID *stri
 	depth := int32(0)
 
 	if parentID != nil && *parentID != "" {
-		parent, err := r.GetByIDAndTenant(ctx, tenantID, *parentID)
+		parent, err := r.GetByID(WithPrimary(ctx), tenantID, *parentID)
 		if err != nil {
 			return nil, err
 		}
@@ -59,7 +66,7 @@ func (r *FolderRepo) Create(ctx context.Context, tenantID uint32, parentID *stri
 		depth = parent.Depth + 1
 	}
 
-	builder := r.entClient.Client().Folder.Create().
+	builder := dbClient(ctx, r.entClient).Folder.Create().
 		SetID(id).
 		SetTenantID(tenantID).
 		SetName(name).
@@ -86,27 +93,19 @@ func (r *FolderRepo) Create(ctx context.Context, tenantID uint32, parentID *stri
 		return nil, wardenV1.ErrorInternalServerError("create folder failed")
 	}
 
-	return entity, nil
-}
-
-// GetByID retrieves a folder by ID
-func (r *FolderRepo) GetByID(ctx context.Context, id string) (*ent.Folder, error) {
-	entity, err := r.entClient.Client().Folder.Get(ctx, id)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, nil
-		}
-		r.log.Errorf("get folder failed: %s", err.Error())
-		return nil, wardenV1.ErrorInternalServerError("get folder failed")
+	if err := addFolderSubfolderCount(ctx, dbClient(ctx, r.entClient), tenantID, parentID, 1); err != nil {
+		r.log.Errorf("update parent subfolder count failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("create folder failed")
 	}
+
 	return entity, nil
 }
 
-// GetByIDAndTenant retrieves a folder by ID with tenant isolation enforced.
-// Use this in service-layer calls where tenant context is available.
-func (r *FolderRepo) GetByIDAndTenant(ctx context.Context, tenantID uint32, id string) (*ent.Folder, error) {
-	entity, err := r.entClient.Client().Folder.Query().
-		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID)).
+// GetByID retrieves a folder by ID within a tenant. A folder of another
+// tenant or in the trash is reported as not found.
+func (r *FolderRepo) GetByID(ctx context.Context, tenantID uint32, id string) (*ent.Folder, error) {
+	entity, err := r.replica.readClient(ctx, r.entClient).Folder.Query().
+		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID), folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE)).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -120,10 +119,11 @@ func (r *FolderRepo) GetByIDAndTenant(ctx context.Context, tenantID uint32, id s
 
 // GetByTenantAndPath retrieves a folder by tenant ID and path
 func (r *FolderRepo) GetByTenantAndPath(ctx context.Context, tenantID uint32, path string) (*ent.Folder, error) {
-	entity, err := r.entClient.Client().Folder.Query().
+	entity, err := dbClient(ctx, r.entClient).Folder.Query().
 		Where(
 			folder.TenantIDEQ(tenantID),
 			folder.PathEQ(path),
+			folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE),
 		).
 		Only(ctx)
 	if err != nil {
@@ -136,10 +136,12 @@ func (r *FolderRepo) GetByTenantAndPath(ctx context.Context, tenantID uint32, pa
 	return entity, nil
 }
 
-// List lists folders with optional parent filter
-func (r *FolderRepo) List(ctx context.Context, tenantID uint32, parentID *string, nameFilter *string, page, pageSize uint32) ([]*ent.Folder, int, error) {
-	query := r.entClient.Client().Folder.Query().
-		Where(folder.TenantIDEQ(tenantID))
+// List lists folders with optional parent filter in name order. With a
+// cursor the page starts after the cursor's folder and page is ignored.
+// projection limits what is loaded of each row.
+func (r *FolderRepo) List(ctx context.Context, tenantID uint32, parentID *string, nameFilter *string, order ListSort, after *Cursor, page, pageSize uint32, projection ListProjection) ([]*ent.Folder, int, error) {
+	query := r.replica.readClient(ctx, r.entClient).Folder.Query().
+		Where(folder.TenantIDEQ(tenantID), folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE))
 
 	if parentID != nil {
 		if *parentID == "" {
@@ -160,14 +162,30 @@ func (r *FolderRepo) List(ctx context.Context, tenantID uint32, parentID *string
 		r.log.Errorf("count folders failed: %s", err.Error())
 		return nil, 0, wardenV1.ErrorInternalServerError("count folders failed")
 	}
+	if projection == ProjectCount {
+		return nil, total, nil
+	}
 
 	// Apply pagination
-	if page > 0 && pageSize > 0 {
+	if after != nil {
+		afterRow, err := order.afterSelector(after, folder.FieldID)
+		if err != nil {
+			return nil, 0, err
+		}
+		query = query.Where(afterRow)
+		if pageSize > 0 {
+			query = query.Limit(int(pageSize))
+		}
+	} else if page > 0 && pageSize > 0 {
 		offset := int((page - 1) * pageSize)
 		query = query.Offset(offset).Limit(int(pageSize))
 	}
 
-	entities, err := query.Order(ent.Asc(folder.FieldName)).All(ctx)
+	if projection == ProjectIDs {
+		query = query.Select(order.keyColumns(folder.FieldID)...).FolderQuery
+	}
+
+	entities, err := query.Order(order.orderSelector(folder.FieldID)).All(ctx)
 	if err != nil {
 		r.log.Errorf("list folders failed: %s", err.Error())
 		return nil, 0, wardenV1.ErrorInternalServerError("list folders failed")
@@ -178,10 +196,11 @@ func (r *FolderRepo) List(ctx context.Context, tenantID uint32, parentID *string
 
 // ListByParentID lists child folders
 func (r *FolderRepo) ListByParentID(ctx context.Context, tenantID uint32, parentID string) ([]*ent.Folder, error) {
-	entities, err := r.entClient.Client().Folder.Query().
+	entities, err := r.replica.readClient(ctx, r.entClient).Folder.Query().
 		Where(
 			folder.TenantIDEQ(tenantID),
 			folder.ParentIDEQ(parentID),
+			folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE),
 		).
 		Order(ent.Asc(folder.FieldName)).
 		All(ctx)
@@ -193,11 +212,13 @@ func (r *FolderRepo) ListByParentID(ctx context.Context, tenantID uint32, parent
 }
 
 // Update updates a folder (tenant-scoped). When name changes, path and descendant
-// paths are updated within a transaction for atomicity.
-func (r *FolderRepo) Update(ctx context.Context, tenantID uint32, id string, name, description *string) (*ent.Folder, error) {
+// paths are updated within a transaction for atomicity. With expectedRevision
+// set, the update fails with PRECONDITION_FAILED once the folder was changed
+// since that revision.
+func (r *FolderRepo) Update(ctx context.Context, tenantID uint32, id string, name, description *string, expectedRevision *int64) (*ent.Folder, error) {
 	// Fetch with tenant filter to determine if name is changing
-	f, err := r.entClient.Client().Folder.Query().
-		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID)).
+	f, err := dbClient(ctx, r.entClient).Folder.Query().
+		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID), folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE)).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -207,15 +228,23 @@ func (r *FolderRepo) Update(ctx context.Context, tenantID uint32, id string, nam
 		return nil, wardenV1.ErrorInternalServerError("update folder failed")
 	}
 
+	if expectedRevision != nil && f.Revision != *expectedRevision {
+		return nil, wardenV1.ErrorPreconditionFailed("folder was changed since revision %d", *expectedRevision)
+	}
+
 	nameChanged := name != nil && *name != f.Name
 
 	// When name changes, use a transaction so folder + descendant path updates are atomic
 	if nameChanged {
-		return r.updateWithRename(ctx, tenantID, f, *name, description)
+		return r.updateWithRename(ctx, tenantID, f, *name, description, expectedRevision)
 	}
 
 	// Simple update (no path changes needed)
-	builder := f.Update().SetUpdateTime(time.Now())
+	builder := f.Update().AddRevision(1).SetUpdateTime(time.Now())
+	if expectedRevision != nil {
+		// Guards against a change committed after the read above
+		builder.Where(folder.RevisionEQ(*expectedRevision))
+	}
 	if name != nil {
 		builder.SetName(*name)
 	}
@@ -228,6 +257,9 @@ func (r *FolderRepo) Update(ctx context.Context, tenantID uint32, id string, nam
 		if ent.IsConstraintError(saveErr) {
 			return nil, wardenV1.ErrorFolderAlreadyExists("folder with this name already exists")
 		}
+		if ent.IsNotFound(saveErr) && expectedRevision != nil {
+			return nil, wardenV1.ErrorPreconditionFailed("folder was changed since revision %d", *expectedRevision)
+		}
 		r.log.Errorf("update folder failed: %s", saveErr.Error())
 		return nil, wardenV1.ErrorInternalServerError("update folder failed")
 	}
@@ -235,7 +267,7 @@ func (r *FolderRepo) Update(ctx context.Context, tenantID uint32, id string, nam
 }
 
 // updateWithRename handles folder rename within a transaction to keep paths consistent.
-func (r *FolderRepo) updateWithRename(ctx context.Context, tenantID uint32, f *ent.Folder, newName string, description *string) (*ent.Folder, error) {
+func (r *FolderRepo) updateWithRename(ctx context.Context, tenantID uint32, f *ent.Folder, newName string, description *string, expectedRevision *int64) (*ent.Folder, error) {
 	tx, err := r.entClient.Client().Tx(ctx)
 	if err != nil {
 		r.log.Errorf("begin transaction failed: %s", err.Error())
@@ -247,9 +279,13 @@ func (r *FolderRepo) updateWithRename(ctx context.Context, tenantID uint32, f *e
 	newPath := parentPath + "/" + newName
 
 	builder := tx.Folder.UpdateOneID(f.ID).
+		AddRevision(1).
 		SetUpdateTime(time.Now()).
 		SetName(newName).
 		SetPath(newPath)
+	if expectedRevision != nil {
+		builder.Where(folder.RevisionEQ(*expectedRevision))
+	}
 	if description != nil {
 		builder.SetDescription(*description)
 	}
@@ -262,6 +298,9 @@ func (r *FolderRepo) updateWithRename(ctx context.Context, tenantID uint32, f *e
 		if ent.IsConstraintError(saveErr) {
 			return nil, wardenV1.ErrorFolderAlreadyExists("folder with this name already exists")
 		}
+		if ent.IsNotFound(saveErr) && expectedRevision != nil {
+			return nil, wardenV1.ErrorPreconditionFailed("folder was changed since revision %d", *expectedRevision)
+		}
 		r.log.Errorf("update folder failed: %s", saveErr.Error())
 		return nil, wardenV1.ErrorInternalServerError("update folder failed")
 	}
@@ -279,7 +318,7 @@ func (r *FolderRepo) updateWithRename(ctx context.Context, tenantID uint32, f *e
 	}
 	for _, d := range descendants {
 		descNewPath := strings.Replace(d.Path, oldPath, newPath, 1)
-		if _, dErr := tx.Folder.UpdateOneID(d.ID).SetPath(descNewPath).SetUpdateTime(time.Now()).Save(ctx); dErr != nil {
+		if _, dErr := tx.Folder.UpdateOneID(d.ID).SetPath(descNewPath).AddRevision(1).SetUpdateTime(time.Now()).Save(ctx); dErr != nil {
 			if rbErr := tx.Rollback(); rbErr != nil {
 				r.log.Errorf("rollback failed: %s", rbErr.Error())
 			}
@@ -307,7 +346,7 @@ func (r *FolderRepo) Move(ctx context.Context, tenantID uint32, id string, newPa
 	}
 
 	// Get the folder within the transaction with a lock (tenant-scoped)
-	f, err := tx.Folder.Query().Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID)).ForUpdate().Only(ctx)
+	f, err := forUpdate(tx.Folder.Query().Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID), folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE)), r.rowLocks).Only(ctx)
 	if err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
 			r.log.Errorf("rollback failed: %s", rbErr.Error())
@@ -333,7 +372,7 @@ func (r *FolderRepo) Move(ctx context.Context, tenantID uint32, id string, newPa
 		}
 
 		// Lock the parent too (tenant-scoped), to prevent concurrent moves from creating cycles
-		parent, err := tx.Folder.Query().Where(folder.IDEQ(*newParentID), folder.TenantIDEQ(tenantID)).ForUpdate().Only(ctx)
+		parent, err := forUpdate(tx.Folder.Query().Where(folder.IDEQ(*newParentID), folder.TenantIDEQ(tenantID), folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE)), r.rowLocks).Only(ctx)
 		if err != nil {
 			if rbErr := tx.Rollback(); rbErr != nil {
 				r.log.Errorf("rollback failed: %s", rbErr.Error())
@@ -361,6 +400,7 @@ func (r *FolderRepo) Move(ctx context.Context, tenantID uint32, id string, newPa
 	builder := tx.Folder.UpdateOneID(id).
 		SetPath(newPath).
 		SetDepth(newDepth).
+		AddRevision(1).
 		SetUpdateTime(time.Now())
 
 	if newParentID != nil && *newParentID != "" {
@@ -381,6 +421,19 @@ func (r *FolderRepo) Move(ctx context.Context, tenantID uint32, id string, newPa
 		return nil, wardenV1.ErrorInternalServerError("move folder failed")
 	}
 
+	// Move the folder between its old and new parent's subfolder counts
+	countErr := addFolderSubfolderCount(ctx, tx.Client(), tenantID, f.ParentID, -1)
+	if countErr == nil {
+		countErr = addFolderSubfolderCount(ctx, tx.Client(), tenantID, newParentID, 1)
+	}
+	if countErr != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		r.log.Errorf("update subfolder counts failed: %s", countErr.Error())
+		return nil, wardenV1.ErrorInternalServerError("move folder failed")
+	}
+
 	// Update paths of all descendant folders within the same transaction (tenant-scoped)
 	descendants, descErr := tx.Folder.Query().
 		Where(folder.TenantIDEQ(tenantID), folder.PathHasPrefix(f.Path+"/")).
@@ -394,7 +447,7 @@ func (r *FolderRepo) Move(ctx context.Context, tenantID uint32, id string, newPa
 	}
 	for _, d := range descendants {
 		descNewPath := strings.Replace(d.Path, f.Path, newPath, 1)
-		if _, descErr := tx.Folder.UpdateOneID(d.ID).SetPath(descNewPath).SetUpdateTime(time.Now()).Save(ctx); descErr != nil {
+		if _, descErr := tx.Folder.UpdateOneID(d.ID).SetPath(descNewPath).AddRevision(1).SetUpdateTime(time.Now()).Save(ctx); descErr != nil {
 			if rbErr := tx.Rollback(); rbErr != nil {
 				r.log.Errorf("rollback failed: %s", rbErr.Error())
 			}
@@ -411,11 +464,10 @@ func (r *FolderRepo) Move(ctx context.Context, tenantID uint32, id string, newPa
 	return entity, nil
 }
 
-
 // Delete deletes a folder (tenant-scoped)
 func (r *FolderRepo) Delete(ctx context.Context, tenantID uint32, id string, force bool) error {
 	// Check if folder has children (tenant-scoped)
-	childCount, err := r.entClient.Client().Folder.Query().
+	childCount, err := dbClient(ctx, r.entClient).Folder.Query().
 		Where(folder.ParentIDEQ(id), folder.TenantIDEQ(tenantID)).
 		Count(ctx)
 	if err != nil {
@@ -427,7 +479,7 @@ func (r *FolderRepo) Delete(ctx context.Context, tenantID uint32, id string, for
 	}
 
 	// Check if folder has active secrets (tenant-scoped)
-	secretCount, err := r.entClient.Client().Secret.Query().
+	secretCount, err := dbClient(ctx, r.entClient).Secret.Query().
 		Where(
 			secret.FolderIDEQ(id),
 			secret.TenantIDEQ(tenantID),
@@ -503,6 +555,14 @@ func (r *FolderRepo) Delete(ctx context.Context, tenantID uint32, id string, for
 			return wardenV1.ErrorInternalServerError("delete folder failed")
 		}
 
+		if err := addFolderSubfolderCount(ctx, tx.Client(), tenantID, f.ParentID, -1); err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				r.log.Errorf("rollback failed: %s", rbErr.Error())
+			}
+			r.log.Errorf("update parent subfolder count failed: %s", err.Error())
+			return wardenV1.ErrorInternalServerError("delete folder failed")
+		}
+
 		if err := tx.Commit(); err != nil {
 			r.log.Errorf("commit folder delete failed: %s", err.Error())
 			return wardenV1.ErrorInternalServerError("delete folder failed")
@@ -511,7 +571,18 @@ func (r *FolderRepo) Delete(ctx context.Context, tenantID uint32, id string, for
 	}
 
 	// Non-force: simple delete of the folder itself (tenant-scoped)
-	delCount, err := r.entClient.Client().Folder.Delete().
+	f, err := dbClient(ctx, r.entClient).Folder.Query().
+		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return wardenV1.ErrorFolderNotFound("folder not found")
+		}
+		r.log.Errorf("get folder for delete failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete folder failed")
+	}
+
+	delCount, err := dbClient(ctx, r.entClient).Folder.Delete().
 		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID)).
 		Exec(ctx)
 	if err != nil {
@@ -521,36 +592,17 @@ func (r *FolderRepo) Delete(ctx context.Context, tenantID uint32, id string, for
 	if delCount == 0 {
 		return wardenV1.ErrorFolderNotFound("folder not found")
 	}
-	return nil
-}
 
-// CountSecrets counts secrets in a folder
-func (r *FolderRepo) CountSecrets(ctx context.Context, tenantID uint32, folderID string) (int, error) {
-	count, err := r.entClient.Client().Secret.Query().
-		Where(secret.FolderIDEQ(folderID), secret.TenantIDEQ(tenantID)).
-		Count(ctx)
-	if err != nil {
-		r.log.Errorf("count secrets failed: %s", err.Error())
-		return 0, wardenV1.ErrorInternalServerError("count secrets failed")
-	}
-	return count, nil
-}
-
-// CountSubfolders counts subfolders in a folder
-func (r *FolderRepo) CountSubfolders(ctx context.Context, tenantID uint32, folderID string) (int, error) {
-	count, err := r.entClient.Client().Folder.Query().
-		Where(folder.ParentIDEQ(folderID), folder.TenantIDEQ(tenantID)).
-		Count(ctx)
-	if err != nil {
-		r.log.Errorf("count subfolders failed: %s", err.Error())
-		return 0, wardenV1.ErrorInternalServerError("count subfolders failed")
+	if err := addFolderSubfolderCount(ctx, dbClient(ctx, r.entClient), tenantID, f.ParentID, -1); err != nil {
+		r.log.Errorf("update parent subfolder count failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete folder failed")
 	}
-	return count, nil
+	return nil
 }
 
 // ListDescendantIDs returns all descendant folder IDs for a folder (excluding itself)
 func (r *FolderRepo) ListDescendantIDs(ctx context.Context, tenantID uint32, folderID string) ([]string, error) {
-	f, err := r.GetByIDAndTenant(ctx, tenantID, folderID)
+	f, err := r.GetByID(WithPrimary(ctx), tenantID, folderID)
 	if err != nil {
 		return nil, err
 	}
@@ -558,7 +610,7 @@ func (r *FolderRepo) ListDescendantIDs(ctx context.Context, tenantID uint32, fol
 		return nil, nil
 	}
 
-	descendants, err := r.entClient.Client().Folder.Query().
+	descendants, err := dbClient(ctx, r.entClient).Folder.Query().
 		Where(folder.TenantIDEQ(tenantID), folder.PathHasPrefix(f.Path+"/")).
 		Select(folder.FieldID).
 		All(ctx)
@@ -574,18 +626,277 @@ func (r *FolderRepo) ListDescendantIDs(ctx context.Context, tenantID uint32, fol
 	return ids, nil
 }
 
-// GetParentID returns the parent folder ID (implements ResourceLookup interface)
-func (r *FolderRepo) GetFolderParentID(ctx context.Context, tenantID uint32, folderID string) (*string, error) {
-	f, err := r.GetByIDAndTenant(ctx, tenantID, folderID)
+// ListSubtree returns a folder and all its descendants, parents before children
+func (r *FolderRepo) ListSubtree(ctx context.Context, tenantID uint32, folderID string) ([]*ent.Folder, error) {
+	f, err := r.GetByID(ctx, tenantID, folderID)
 	if err != nil {
 		return nil, err
 	}
 	if f == nil {
 		return nil, nil
 	}
+
+	descendants, err := r.replica.readClient(ctx, r.entClient).Folder.Query().
+		Where(folder.TenantIDEQ(tenantID), folder.PathHasPrefix(f.Path+"/")).
+		Order(ent.Asc(folder.FieldDepth), ent.Asc(folder.FieldPath)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list folder subtree failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list folder subtree failed")
+	}
+
+	return append([]*ent.Folder{f}, descendants...), nil
+}
+
+// GetParentID returns the parent folder ID (implements ResourceLookup interface).
+// Authorization must not act on stale data, so it always reads the primary.
+// Folders in the trash have parents too, so permissions still apply to them.
+func (r *FolderRepo) GetFolderParentID(ctx context.Context, tenantID uint32, folderID string) (*string, error) {
+	f, err := dbClient(ctx, r.entClient).Folder.Query().
+		Where(folder.IDEQ(folderID), folder.TenantIDEQ(tenantID)).
+		Select(folder.FieldParentID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get folder parent failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get folder failed")
+	}
 	return f.ParentID, nil
 }
 
+// GetAccessPolicies returns the access policies of a folder and its
+// ancestors, read from the primary like the rest of authorization
+func (r *FolderRepo) GetAccessPolicies(ctx context.Context, tenantID uint32, folderID string) ([]*authz.AccessPolicy, error) {
+	f, paths, err := r.getWithAncestorPaths(ctx, tenantID, folderID)
+	if err != nil || f == nil {
+		return nil, err
+	}
+
+	policies := make([]*authz.AccessPolicy, 0, len(paths)+1)
+	if !f.AccessPolicy.IsEmpty() {
+		policies = append(policies, f.AccessPolicy)
+	}
+	if len(paths) == 0 {
+		return policies, nil
+	}
+
+	ancestors, err := dbClient(ctx, r.entClient).Folder.Query().
+		Where(
+			folder.TenantIDEQ(tenantID),
+			folder.PathIn(paths...),
+			folder.AccessPolicyNotNil(),
+		).
+		Select(folder.FieldAccessPolicy).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("get folder access policies failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get folder failed")
+	}
+	for _, a := range ancestors {
+		if !a.AccessPolicy.IsEmpty() {
+			policies = append(policies, a.AccessPolicy)
+		}
+	}
+	return policies, nil
+}
+
+// SetAccessPolicy sets the access policy of a folder (nil removes it)
+func (r *FolderRepo) SetAccessPolicy(ctx context.Context, tenantID uint32, id string, policy *authz.AccessPolicy) (*ent.Folder, error) {
+	builder := r.entClient.Client().Folder.Update().
+		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID)).
+		AddRevision(1).
+		SetUpdateTime(time.Now())
+	if policy.IsEmpty() {
+		builder.ClearAccessPolicy()
+	} else {
+		builder.SetAccessPolicy(policy)
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("set folder access policy failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("update folder failed")
+	}
+	if affected == 0 {
+		return nil, wardenV1.ErrorFolderNotFound("folder not found")
+	}
+
+	return r.GetByID(WithPrimary(ctx), tenantID, id)
+}
+
+// SetProtected sets whether deleting a folder or anything in it needs a
+// second owner's approval. The flag is not part of the folder's content, so
+// the revision is left alone.
+func (r *FolderRepo) SetProtected(ctx context.Context, tenantID uint32, id string, protected bool) (*ent.Folder, error) {
+	affected, err := r.entClient.Client().Folder.Update().
+		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID)).
+		SetProtected(protected).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("set folder protected failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("update folder failed")
+	}
+	if affected == 0 {
+		return nil, wardenV1.ErrorFolderNotFound("folder not found")
+	}
+
+	return r.GetByID(WithPrimary(ctx), tenantID, id)
+}
+
+// IsProtected reports whether a folder or one of its ancestors is protected
+func (r *FolderRepo) IsProtected(ctx context.Context, tenantID uint32, folderID string) (bool, error) {
+	f, paths, err := r.getWithAncestorPaths(ctx, tenantID, folderID)
+	if err != nil || f == nil {
+		return false, err
+	}
+	if f.Protected {
+		return true, nil
+	}
+	if len(paths) == 0 {
+		return false, nil
+	}
+
+	protected, err := dbClient(ctx, r.entClient).Folder.Query().
+		Where(
+			folder.TenantIDEQ(tenantID),
+			folder.PathIn(paths...),
+			folder.ProtectedEQ(true),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("check folder protection failed: %s", err.Error())
+		return false, wardenV1.ErrorInternalServerError("get folder failed")
+	}
+	return protected, nil
+}
+
+// HasProtectedContent reports whether a folder below the given one, or a
+// secret anywhere in its tree, is protected
+func (r *FolderRepo) HasProtectedContent(ctx context.Context, tenantID uint32, folderID string) (bool, error) {
+	ctx = WithPrimary(ctx)
+	f, err := r.GetByID(ctx, tenantID, folderID)
+	if err != nil || f == nil {
+		return false, err
+	}
+
+	client := dbClient(ctx, r.entClient)
+	protected, err := client.Folder.Query().
+		Where(
+			folder.TenantIDEQ(tenantID),
+			folder.PathHasPrefix(f.Path+"/"),
+			folder.ProtectedEQ(true),
+		).
+		Exist(ctx)
+	if err == nil && !protected {
+		protected, err = client.Secret.Query().
+			Where(
+				secret.TenantIDEQ(tenantID),
+				secret.ProtectedEQ(true),
+				secret.Or(
+					secret.FolderIDEQ(f.ID),
+					secret.HasFolderWith(folder.PathHasPrefix(f.Path+"/")),
+				),
+			).
+			Exist(ctx)
+	}
+	if err != nil {
+		r.log.Errorf("check folder content protection failed: %s", err.Error())
+		return false, wardenV1.ErrorInternalServerError("get folder failed")
+	}
+	return protected, nil
+}
+
+// SetLegalHold places or lifts the legal hold on a folder. The reason is kept
+// while the hold is in place.
+func (r *FolderRepo) SetLegalHold(ctx context.Context, tenantID uint32, id string, hold bool, reason string) (*ent.Folder, error) {
+	builder := r.entClient.Client().Folder.Update().
+		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID)).
+		SetLegalHold(hold)
+	if hold {
+		builder = builder.SetLegalHoldReason(reason)
+	} else {
+		builder = builder.ClearLegalHoldReason()
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("set folder legal hold failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("update folder failed")
+	}
+	if affected == 0 {
+		return nil, wardenV1.ErrorFolderNotFound("folder not found")
+	}
+
+	return r.GetByID(WithPrimary(ctx), tenantID, id)
+}
+
+// IsUnderLegalHold reports whether a folder or one of its ancestors is under
+// legal hold
+func (r *FolderRepo) IsUnderLegalHold(ctx context.Context, tenantID uint32, folderID string) (bool, error) {
+	f, paths, err := r.getWithAncestorPaths(ctx, tenantID, folderID)
+	if err != nil || f == nil {
+		return false, err
+	}
+	if f.LegalHold {
+		return true, nil
+	}
+	if len(paths) == 0 {
+		return false, nil
+	}
+
+	held, err := dbClient(ctx, r.entClient).Folder.Query().
+		Where(
+			folder.TenantIDEQ(tenantID),
+			folder.PathIn(paths...),
+			folder.LegalHoldEQ(true),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("check folder legal hold failed: %s", err.Error())
+		return false, wardenV1.ErrorInternalServerError("get folder failed")
+	}
+	return held, nil
+}
+
+// ListHeldFolderIDs returns the folders below the given one that are under a
+// legal hold placed on themselves or on a folder between them and it
+func (r *FolderRepo) ListHeldFolderIDs(ctx context.Context, tenantID uint32, folderID string) ([]string, error) {
+	ctx = WithPrimary(ctx)
+	f, err := r.GetByID(ctx, tenantID, folderID)
+	if err != nil || f == nil {
+		return nil, err
+	}
+
+	client := dbClient(ctx, r.entClient)
+	held, err := client.Folder.Query().
+		Where(
+			folder.TenantIDEQ(tenantID),
+			folder.PathHasPrefix(f.Path+"/"),
+			folder.LegalHoldEQ(true),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list held folders failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list folders failed")
+	}
+
+	var ids []string
+	for _, h := range held {
+		subtree, err := client.Folder.Query().
+			Where(folder.TenantIDEQ(tenantID), folder.PathHasPrefix(h.Path+"/")).
+			IDs(ctx)
+		if err != nil {
+			r.log.Errorf("list held folders failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("list folders failed")
+		}
+		ids = append(ids, h.ID)
+		ids = append(ids, subtree...)
+	}
+	return ids, nil
+}
+
 // ToProto converts an ent.Folder to wardenV1.Folder
 func (r *FolderRepo) ToProto(entity *ent.Folder) *wardenV1.Folder {
 	if entity == nil {
@@ -599,6 +910,7 @@ func (r *FolderRepo) ToProto(entity *ent.Folder) *wardenV1.Folder {
 		Path:        entity.Path,
 		Description: entity.Description,
 		Depth:       entity.Depth,
+		Revision:    entity.Revision,
 	}
 
 	if entity.ParentID != nil {
@@ -613,30 +925,93 @@ func (r *FolderRepo) ToProto(entity *ent.Folder) *wardenV1.Folder {
 	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
 		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
 	}
+	if entity.LastAccessedTime != nil {
+		proto.LastAccessedTime = timestamppb.New(*entity.LastAccessedTime)
+	}
+	if entity.MetadataSchema != nil {
+		if schema, err := structpb.NewStruct(entity.MetadataSchema); err == nil {
+			proto.MetadataSchema = schema
+		}
+	}
+	for _, p := range entity.DefaultPermissions {
+		proto.DefaultPermissions = append(proto.DefaultPermissions, &wardenV1.InitialPermissionGrant{
+			SubjectType: wardenV1.SubjectType(wardenV1.SubjectType_value[p.SubjectType]),
+			SubjectId:   p.SubjectID,
+			Relation:    wardenV1.Relation(wardenV1.Relation_value[p.Relation]),
+		})
+	}
+	proto.AccessPolicy = AccessPolicyToProto(entity.AccessPolicy)
+	proto.Protected = entity.Protected
+	proto.LegalHold = entity.LegalHold
+	proto.LegalHoldReason = entity.LegalHoldReason
+	proto.Status = wardenV1.FolderStatus(wardenV1.FolderStatus_value[string(entity.Status)])
+	if entity.DeletedTime != nil {
+		proto.DeletedTime = timestamppb.New(*entity.DeletedTime)
+	}
+	proto.OriginalPath = entity.OriginalPath
 
 	return proto
 }
 
-// ToProtoWithCounts converts an ent.Folder to wardenV1.Folder with counts
-func (r *FolderRepo) ToProtoWithCounts(ctx context.Context, tenantID uint32, entity *ent.Folder) (*wardenV1.Folder, error) {
-	proto := r.ToProto(entity)
-	if proto == nil {
-		return nil, nil
+// SetMetadataSchema sets the metadata schema of a folder (nil removes it)
+func (r *FolderRepo) SetMetadataSchema(ctx context.Context, tenantID uint32, id string, schema map[string]any) (*ent.Folder, error) {
+	builder := r.entClient.Client().Folder.Update().
+		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID)).
+		AddRevision(1).
+		SetUpdateTime(time.Now())
+	if schema == nil {
+		builder.ClearMetadataSchema()
+	} else {
+		builder.SetMetadataSchema(schema)
 	}
 
-	secretCount, err := r.CountSecrets(ctx, tenantID, entity.ID)
+	affected, err := builder.Save(ctx)
 	if err != nil {
-		return nil, err
+		r.log.Errorf("set folder metadata schema failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("update folder failed")
+	}
+	if affected == 0 {
+		return nil, wardenV1.ErrorFolderNotFound("folder not found")
 	}
-	proto.SecretCount = int32(secretCount)
 
-	subfolderCount, err := r.CountSubfolders(ctx, tenantID, entity.ID)
+	return r.GetByID(WithPrimary(ctx), tenantID, id)
+}
+
+// SetDefaultPermissions replaces the permissions granted on new secrets in a
+// folder (empty removes them)
+func (r *FolderRepo) SetDefaultPermissions(ctx context.Context, tenantID uint32, id string, rules []schema.FolderDefaultPermission) (*ent.Folder, error) {
+	builder := r.entClient.Client().Folder.Update().
+		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID)).
+		AddRevision(1).
+		SetUpdateTime(time.Now())
+	if len(rules) == 0 {
+		builder.ClearDefaultPermissions()
+	} else {
+		builder.SetDefaultPermissions(rules)
+	}
+
+	affected, err := builder.Save(ctx)
 	if err != nil {
-		return nil, err
+		r.log.Errorf("set folder default permissions failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("update folder failed")
+	}
+	if affected == 0 {
+		return nil, wardenV1.ErrorFolderNotFound("folder not found")
 	}
-	proto.SubfolderCount = int32(subfolderCount)
 
-	return proto, nil
+	return r.GetByID(WithPrimary(ctx), tenantID, id)
+}
+
+// ToProtoWithCounts converts an ent.Folder to wardenV1.Folder with its
+// denormalized secret and subfolder counts
+func (r *FolderRepo) ToProtoWithCounts(entity *ent.Folder) *wardenV1.Folder {
+	proto := r.ToProto(entity)
+	if proto == nil {
+		return nil
+	}
+	proto.SecretCount = entity.SecretCount
+	proto.SubfolderCount = entity.SubfolderCount
+	return proto
 }
 
 // BuildTree builds a folder tree starting from root folders or a specific folder
@@ -645,7 +1020,7 @@ func (r *FolderRepo) BuildTree(ctx context.Context, tenantID uint32, rootID *str
 	var err error
 
 	if rootID != nil && *rootID != "" {
-		root, err := r.GetByIDAndTenant(ctx, tenantID, *rootID)
+		root, err := r.GetByID(ctx, tenantID, *rootID)
 		if err != nil {
 			return nil, err
 		}
@@ -654,10 +1029,11 @@ func (r *FolderRepo) BuildTree(ctx context.Context, tenantID uint32, rootID *str
 		}
 		roots = []*ent.Folder{root}
 	} else {
-		roots, err = r.entClient.Client().Folder.Query().
+		roots, err = r.replica.readClient(ctx, r.entClient).Folder.Query().
 			Where(
 				folder.TenantIDEQ(tenantID),
 				folder.ParentIDIsNil(),
+				folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE),
 			).
 			Order(ent.Asc(folder.FieldName)).
 			All(ctx)
@@ -680,16 +1056,9 @@ func (r *FolderRepo) BuildTree(ctx context.Context, tenantID uint32, rootID *str
 }
 
 func (r *FolderRepo) buildTreeNode(ctx context.Context, f *ent.Folder, currentDepth, maxDepth int32, includeCounts bool) (*wardenV1.FolderTreeNode, error) {
-	var folderProto *wardenV1.Folder
-	var err error
-
+	folderProto := r.ToProto(f)
 	if includeCounts {
-		folderProto, err = r.ToProtoWithCounts(ctx, *f.TenantID, f)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		folderProto = r.ToProto(f)
+		folderProto = r.ToProtoWithCounts(f)
 	}
 
 	node := &wardenV1.FolderTreeNode{
@@ -721,7 +1090,7 @@ func (r *FolderRepo) buildTreeNode(ctx context.Context, f *ent.Folder, currentDe
 
 // GetAllDescendantIDs returns all descendant folder IDs
 func (r *FolderRepo) GetAllDescendantIDs(ctx context.Context, tenantID uint32, folderID string) ([]string, error) {
-	f, err := r.GetByIDAndTenant(ctx, tenantID, folderID)
+	f, err := r.GetByID(ctx, tenantID, folderID)
 	if err != nil {
 		return nil, err
 	}
@@ -729,7 +1098,7 @@ func (r *FolderRepo) GetAllDescendantIDs(ctx context.Context, tenantID uint32, f
 		return nil, nil
 	}
 
-	descendants, err := r.entClient.Client().Folder.Query().
+	descendants, err := r.replica.readClient(ctx, r.entClient).Folder.Query().
 		Where(
 			folder.TenantIDEQ(tenantID),
 			folder.PathHasPrefix(f.Path+"/"),
@@ -748,4 +1117,3 @@ func (r *FolderRepo) GetAllDescendantIDs(ctx context.Context, tenantID uint32, f
 
 	return ids, nil
 }
-
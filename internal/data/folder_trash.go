@@ -0,0 +1,401 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// A folder moved to the trash leaves its parent for the root level under a
+// tombstone name, so its name and path are free again and path-prefix queries
+// on the live tree never reach it. Its subfolders go along under it, and the
+// live secrets in them are soft-deleted, remembering the folder that took
+// them and their status so a restore brings back exactly those. Secrets
+// already in the trash stay there.
+
+// Trash moves a folder with its subfolders and their live secrets to the
+// trash. Without force the folder must be empty. Run it in a transaction.
+func (r *FolderRepo) Trash(ctx context.Context, tenantID uint32, id string, force bool) (*ent.Folder, error) {
+	client := dbClient(ctx, r.entClient)
+
+	f, err := forUpdate(client.Folder.Query().Where(
+		folder.IDEQ(id),
+		folder.TenantIDEQ(tenantID),
+		folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE),
+	), r.rowLocks).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, wardenV1.ErrorFolderNotFound("folder not found")
+		}
+		r.log.Errorf("get folder for trash failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("delete folder failed")
+	}
+
+	if !force {
+		childCount, err := client.Folder.Query().
+			Where(folder.ParentIDEQ(id), folder.TenantIDEQ(tenantID)).
+			Count(ctx)
+		if err != nil {
+			r.log.Errorf("count child folders failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("delete folder failed")
+		}
+		if childCount > 0 {
+			return nil, wardenV1.ErrorFolderNotEmpty("folder has child folders")
+		}
+		secretCount, err := client.Secret.Query().
+			Where(
+				secret.FolderIDEQ(id),
+				secret.TenantIDEQ(tenantID),
+				secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED),
+			).
+			Count(ctx)
+		if err != nil {
+			r.log.Errorf("count secrets failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("delete folder failed")
+		}
+		if secretCount > 0 {
+			return nil, wardenV1.ErrorFolderNotEmpty("folder contains secrets")
+		}
+	}
+
+	descendants, err := client.Folder.Query().
+		Where(folder.TenantIDEQ(tenantID), folder.PathHasPrefix(f.Path+"/")).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query descendant folders failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("delete folder failed")
+	}
+
+	folderIDs := []string{f.ID}
+	for _, d := range descendants {
+		folderIDs = append(folderIDs, d.ID)
+	}
+
+	now := time.Now()
+
+	// Archived secrets come back archived, everything else live comes back active
+	for _, before := range []secret.StatusBeforeTrash{secret.StatusBeforeTrashSECRET_STATUS_ARCHIVED, secret.StatusBeforeTrashSECRET_STATUS_ACTIVE} {
+		if _, err := client.Secret.Update().
+			Where(
+				secret.TenantIDEQ(tenantID),
+				secret.FolderIDIn(folderIDs...),
+				secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED),
+				trashedStatusPredicate(before),
+			).
+			SetStatus(secret.StatusSECRET_STATUS_DELETED).
+			SetStatusBeforeTrash(before).
+			SetTrashedWithFolderID(f.ID).
+			AddRevision(1).
+			SetUpdateTime(now).
+			Save(ctx); err != nil {
+			r.log.Errorf("trash secrets in folder tree failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("delete folder failed")
+		}
+	}
+
+	trashedPath := "/" + tombstoneName(f.Name, f.ID)
+	for _, d := range descendants {
+		if _, err := client.Folder.UpdateOneID(d.ID).
+			SetPath(trashedPath + strings.TrimPrefix(d.Path, f.Path)).
+			SetDepth(d.Depth - f.Depth).
+			SetStatus(folder.StatusFOLDER_STATUS_DELETED).
+			SetDeletedTime(now).
+			SetTrashedWithFolderID(f.ID).
+			SetSecretCount(0).
+			AddRevision(1).
+			SetUpdateTime(now).
+			Save(ctx); err != nil {
+			r.log.Errorf("trash descendant folder failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("delete folder failed")
+		}
+	}
+
+	entity, err := client.Folder.UpdateOneID(f.ID).
+		SetName(tombstoneName(f.Name, f.ID)).
+		SetPath(trashedPath).
+		SetDepth(0).
+		ClearParentID().
+		SetNillableOriginalParentID(f.ParentID).
+		SetOriginalPath(f.Path).
+		SetStatus(folder.StatusFOLDER_STATUS_DELETED).
+		SetDeletedTime(now).
+		SetTrashedWithFolderID(f.ID).
+		SetSecretCount(0).
+		AddRevision(1).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("trash folder failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("delete folder failed")
+	}
+
+	if err := addFolderSubfolderCount(ctx, client, tenantID, f.ParentID, -1); err != nil {
+		r.log.Errorf("update parent subfolder count failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("delete folder failed")
+	}
+
+	return entity, nil
+}
+
+// trashedStatusPredicate matches the live secrets that come back from the
+// trash with the given status
+func trashedStatusPredicate(before secret.StatusBeforeTrash) predicate.Secret {
+	if before == secret.StatusBeforeTrashSECRET_STATUS_ARCHIVED {
+		return secret.StatusEQ(secret.StatusSECRET_STATUS_ARCHIVED)
+	}
+	return secret.StatusNEQ(secret.StatusSECRET_STATUS_ARCHIVED)
+}
+
+// Restore brings a folder back from the trash under name in parentID (nil
+// for the root level), with the subfolders and secrets trashed along with it.
+// Run it in a transaction.
+func (r *FolderRepo) Restore(ctx context.Context, tenantID uint32, id, name string, parentID *string) (*ent.Folder, error) {
+	client := dbClient(ctx, r.entClient)
+
+	f, err := forUpdate(client.Folder.Query().Where(
+		folder.IDEQ(id),
+		folder.TenantIDEQ(tenantID),
+		folder.StatusEQ(folder.StatusFOLDER_STATUS_DELETED),
+		folder.TrashedWithFolderIDEQ(id),
+	), r.rowLocks).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, wardenV1.ErrorFolderNotFound("folder not found in the trash")
+		}
+		r.log.Errorf("get folder for restore failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("restore folder failed")
+	}
+
+	newPath := "/" + name
+	newDepth := int32(0)
+	if parentID != nil {
+		parent, err := forUpdate(client.Folder.Query().Where(
+			folder.IDEQ(*parentID),
+			folder.TenantIDEQ(tenantID),
+			folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE),
+		), r.rowLocks).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, wardenV1.ErrorFolderNotFound("parent folder not found")
+			}
+			r.log.Errorf("get parent folder for restore failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("restore folder failed")
+		}
+		newPath = parent.Path + "/" + name
+		newDepth = parent.Depth + 1
+	}
+
+	now := time.Now()
+
+	entity, err := client.Folder.UpdateOneID(f.ID).
+		SetName(name).
+		SetPath(newPath).
+		SetDepth(newDepth).
+		SetNillableParentID(parentID).
+		ClearOriginalParentID().
+		ClearOriginalPath().
+		SetStatus(folder.StatusFOLDER_STATUS_ACTIVE).
+		ClearDeletedTime().
+		ClearTrashedWithFolderID().
+		AddRevision(1).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, wardenV1.ErrorFolderAlreadyExists("a folder named %q already exists there, restore under another name", name)
+		}
+		r.log.Errorf("restore folder failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("restore folder failed")
+	}
+
+	descendants, err := client.Folder.Query().
+		Where(
+			folder.TenantIDEQ(tenantID),
+			folder.TrashedWithFolderIDEQ(f.ID),
+			folder.IDNEQ(f.ID),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query trashed descendant folders failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("restore folder failed")
+	}
+	for _, d := range descendants {
+		if _, err := client.Folder.UpdateOneID(d.ID).
+			SetPath(newPath + strings.TrimPrefix(d.Path, f.Path)).
+			SetDepth(d.Depth + newDepth).
+			SetStatus(folder.StatusFOLDER_STATUS_ACTIVE).
+			ClearDeletedTime().
+			ClearTrashedWithFolderID().
+			AddRevision(1).
+			SetUpdateTime(now).
+			Save(ctx); err != nil {
+			r.log.Errorf("restore descendant folder failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("restore folder failed")
+		}
+	}
+
+	secrets, err := client.Secret.Query().
+		Where(
+			secret.TenantIDEQ(tenantID),
+			secret.TrashedWithFolderIDEQ(f.ID),
+			secret.StatusEQ(secret.StatusSECRET_STATUS_DELETED),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query trashed secrets failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("restore folder failed")
+	}
+	restored := make(map[string]int32)
+	for _, s := range secrets {
+		status := secret.StatusSECRET_STATUS_ACTIVE
+		if s.StatusBeforeTrash != nil && *s.StatusBeforeTrash == secret.StatusBeforeTrashSECRET_STATUS_ARCHIVED {
+			status = secret.StatusSECRET_STATUS_ARCHIVED
+		}
+		if _, err := client.Secret.UpdateOneID(s.ID).
+			SetStatus(status).
+			ClearStatusBeforeTrash().
+			ClearTrashedWithFolderID().
+			AddRevision(1).
+			SetUpdateTime(now).
+			Save(ctx); err != nil {
+			r.log.Errorf("restore secret failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("restore folder failed")
+		}
+		if s.FolderID != nil {
+			restored[*s.FolderID]++
+		}
+	}
+	for folderID, n := range restored {
+		if err := addFolderSecretCount(ctx, client, tenantID, &folderID, n); err != nil {
+			r.log.Errorf("update folder secret count failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("restore folder failed")
+		}
+	}
+
+	if err := addFolderSubfolderCount(ctx, client, tenantID, parentID, 1); err != nil {
+		r.log.Errorf("update parent subfolder count failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("restore folder failed")
+	}
+
+	return entity, nil
+}
+
+// GetTrashed retrieves a folder that was deleted itself, rather than along
+// with a folder above it, and is still in the trash
+func (r *FolderRepo) GetTrashed(ctx context.Context, tenantID uint32, id string) (*ent.Folder, error) {
+	entity, err := dbClient(ctx, r.entClient).Folder.Query().
+		Where(
+			folder.IDEQ(id),
+			folder.TenantIDEQ(tenantID),
+			folder.StatusEQ(folder.StatusFOLDER_STATUS_DELETED),
+			folder.TrashedWithFolderIDEQ(id),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get trashed folder failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get folder failed")
+	}
+	return entity, nil
+}
+
+// IsTrashed reports whether a folder is in the trash, deleted itself or
+// along with a folder above it
+func (r *FolderRepo) IsTrashed(ctx context.Context, tenantID uint32, id string) (bool, error) {
+	exists, err := dbClient(ctx, r.entClient).Folder.Query().
+		Where(
+			folder.IDEQ(id),
+			folder.TenantIDEQ(tenantID),
+			folder.StatusEQ(folder.StatusFOLDER_STATUS_DELETED),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("check trashed folder failed: %s", err.Error())
+		return false, wardenV1.ErrorInternalServerError("get folder failed")
+	}
+	return exists, nil
+}
+
+// ListTrashed lists the folders deleted themselves, most recently deleted first
+func (r *FolderRepo) ListTrashed(ctx context.Context, tenantID uint32, nameFilter *string, page, pageSize uint32) ([]*ent.Folder, int, error) {
+	query := r.replica.readClient(ctx, r.entClient).Folder.Query().
+		Where(
+			folder.TenantIDEQ(tenantID),
+			folder.StatusEQ(folder.StatusFOLDER_STATUS_DELETED),
+			folder.ParentIDIsNil(),
+		)
+	if nameFilter != nil && *nameFilter != "" {
+		query = query.Where(folder.OriginalPathContainsFold(*nameFilter))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count trashed folders failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("count folders failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(folder.FieldDeletedTime), ent.Asc(folder.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list trashed folders failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("list folders failed")
+	}
+	return entities, total, nil
+}
+
+// getWithAncestorPaths loads a folder, in the trash or not, from the primary
+// with the paths of the folders above it. A folder in the trash stays under
+// the folders it was deleted from, so access policies, protection and holds
+// placed on them still apply to it.
+func (r *FolderRepo) getWithAncestorPaths(ctx context.Context, tenantID uint32, id string) (*ent.Folder, []string, error) {
+	client := dbClient(ctx, r.entClient)
+	f, err := client.Folder.Query().
+		Where(folder.IDEQ(id), folder.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		r.log.Errorf("get folder failed: %s", err.Error())
+		return nil, nil, wardenV1.ErrorInternalServerError("get folder failed")
+	}
+
+	paths := ancestorPaths(f.Path)
+	if f.TrashedWithFolderID != nil {
+		root := f
+		if *f.TrashedWithFolderID != f.ID {
+			root, err = client.Folder.Query().
+				Where(folder.IDEQ(*f.TrashedWithFolderID), folder.TenantIDEQ(tenantID)).
+				Only(ctx)
+			if err != nil {
+				r.log.Errorf("get trashed folder failed: %s", err.Error())
+				return nil, nil, wardenV1.ErrorInternalServerError("get folder failed")
+			}
+		}
+		paths = append(paths, ancestorPaths(root.OriginalPath)...)
+	}
+	return f, paths, nil
+}
+
+// ancestorPaths returns the paths of the folders above a folder path
+func ancestorPaths(path string) []string {
+	var paths []string
+	for i := 1; i < len(path); i++ {
+		if path[i] == '/' {
+			paths = append(paths, path[:i])
+		}
+	}
+	return paths
+}
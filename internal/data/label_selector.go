@@ -0,0 +1,150 @@
+package data
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+const (
+	maxLabelRequirements = 20
+	maxLabelValueLen     = 256
+)
+
+// labelKeyPattern keeps label keys usable as JSON paths in every dialect
+var labelKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_.\-/]{1,128}$`)
+
+// LabelOperator is how a requirement of a label selector matches
+type LabelOperator int
+
+const (
+	LabelEquals LabelOperator = iota
+	LabelNotEquals
+	LabelExists
+	LabelNotExists
+)
+
+// LabelRequirement is one comma-separated term of a label selector
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Value    string
+}
+
+// LabelSelector matches secrets whose labels satisfy every requirement. A
+// label is a metadata key with a string value, or a "key=value" entry of the
+// tags list. A nil selector matches every secret.
+type LabelSelector []LabelRequirement
+
+// ParseLabelSelector parses a selector such as "app=payments,env!=dev,team".
+// Terms are key=value (or key==value), key!=value, key (the label is set)
+// and !key (it is not).
+func ParseLabelSelector(s string) (LabelSelector, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	terms := strings.Split(s, ",")
+	if len(terms) > maxLabelRequirements {
+		return nil, wardenV1.ErrorBadRequest("label selector has more than %d terms", maxLabelRequirements)
+	}
+
+	selector := make(LabelSelector, 0, len(terms))
+	for _, term := range terms {
+		req, err := parseLabelRequirement(strings.TrimSpace(term))
+		if err != nil {
+			return nil, wardenV1.ErrorBadRequest("invalid label selector term %q: %s", term, err)
+		}
+		selector = append(selector, req)
+	}
+	return selector, nil
+}
+
+func parseLabelRequirement(term string) (LabelRequirement, error) {
+	var req LabelRequirement
+	switch {
+	case strings.Contains(term, "!="):
+		req.Operator = LabelNotEquals
+		req.Key, req.Value, _ = strings.Cut(term, "!=")
+	case strings.Contains(term, "=="):
+		req.Operator = LabelEquals
+		req.Key, req.Value, _ = strings.Cut(term, "==")
+	case strings.Contains(term, "="):
+		req.Operator = LabelEquals
+		req.Key, req.Value, _ = strings.Cut(term, "=")
+	case strings.HasPrefix(term, "!"):
+		req.Operator = LabelNotExists
+		req.Key = term[1:]
+	default:
+		req.Operator = LabelExists
+		req.Key = term
+	}
+
+	req.Key = strings.TrimSpace(req.Key)
+	req.Value = strings.TrimSpace(req.Value)
+	if !labelKeyPattern.MatchString(req.Key) {
+		return req, fmt.Errorf("keys are 1 to 128 letters, digits, '_', '.', '-' or '/'")
+	}
+	if len(req.Value) > maxLabelValueLen {
+		return req, fmt.Errorf("values are at most %d bytes", maxLabelValueLen)
+	}
+	return req, nil
+}
+
+// predicate returns the SQL condition of the selector. Each JSON comparison
+// is guarded by a key check so that secrets without the label compare false
+// instead of NULL, which keeps != and !key matching them.
+func (s LabelSelector) predicate() predicate.Secret {
+	return func(sel *sql.Selector) {
+		preds := make([]*sql.Predicate, 0, len(s))
+		for _, req := range s {
+			var p *sql.Predicate
+			switch req.Operator {
+			case LabelEquals:
+				p = labelEquals(req.Key, req.Value)
+			case LabelNotEquals:
+				p = sql.Not(labelEquals(req.Key, req.Value))
+			case LabelExists:
+				p = labelExists(req.Key)
+			case LabelNotExists:
+				p = sql.Not(labelExists(req.Key))
+			}
+			preds = append(preds, p)
+		}
+		sel.Where(sql.And(preds...))
+	}
+}
+
+func labelEquals(key, value string) *sql.Predicate {
+	return sql.Or(
+		sql.And(
+			sqljson.HasKey(secret.FieldMetadata, sqljson.Path(key)),
+			sqljson.ValueEQ(secret.FieldMetadata, value, sqljson.Path(key)),
+		),
+		hasTag(key+"="+value),
+	)
+}
+
+func labelExists(key string) *sql.Predicate {
+	return sql.Or(
+		sqljson.HasKey(secret.FieldMetadata, sqljson.Path(key)),
+		hasTag(key),
+	)
+}
+
+// hasTag matches secrets whose tags list contains tag
+func hasTag(tag string) *sql.Predicate {
+	return sql.And(
+		sqljson.HasKey(secret.FieldMetadata, sqljson.Path(SecretTagsKey)),
+		sqljson.ValueContains(secret.FieldMetadata, tag, sqljson.Path(SecretTagsKey)),
+	)
+}
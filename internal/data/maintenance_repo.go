@@ -0,0 +1,621 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditchainhead"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/collection"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/deletionrequest"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/emergencyaccess"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folderchangelog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/pendingoperation"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretusage"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretversion"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/securityalert"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/tenantsetting"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/versionpin"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhook"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhookdelivery"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// MaintenanceRepo holds the cross-tenant queries behind the admin maintenance
+// tasks. A nil tenantID means all tenants.
+type MaintenanceRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewMaintenanceRepo creates a new MaintenanceRepo
+func NewMaintenanceRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *MaintenanceRepo {
+	return &MaintenanceRepo{
+		entClient: entClient,
+		log:       ctx.NewLoggerHelper("warden/maintenance/repo"),
+	}
+}
+
+// ListOrphanPermissions returns permissions whose folder, secret or collection no longer exists
+func (r *MaintenanceRepo) ListOrphanPermissions(ctx context.Context, tenantID *uint32) ([]*ent.Permission, error) {
+	q := r.entClient.Client().Permission.Query()
+	if tenantID != nil {
+		q = q.Where(permission.TenantIDEQ(*tenantID))
+	}
+	perms, err := q.All(ctx)
+	if err != nil {
+		r.log.Errorf("list permissions failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list permissions failed")
+	}
+
+	var folderIDs, secretIDs, collectionIDs []string
+	for _, p := range perms {
+		switch p.ResourceType {
+		case permission.ResourceTypeRESOURCE_TYPE_FOLDER:
+			folderIDs = append(folderIDs, p.ResourceID)
+		case permission.ResourceTypeRESOURCE_TYPE_SECRET:
+			secretIDs = append(secretIDs, p.ResourceID)
+		case permission.ResourceTypeRESOURCE_TYPE_COLLECTION:
+			collectionIDs = append(collectionIDs, p.ResourceID)
+		}
+	}
+
+	existingFolders := make(map[string]bool)
+	if len(folderIDs) > 0 {
+		ids, err := r.entClient.Client().Folder.Query().
+			Where(folder.IDIn(folderIDs...)).
+			IDs(ctx)
+		if err != nil {
+			r.log.Errorf("list folder ids failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("list permissions failed")
+		}
+		for _, id := range ids {
+			existingFolders[id] = true
+		}
+	}
+
+	existingSecrets := make(map[string]bool)
+	if len(secretIDs) > 0 {
+		ids, err := r.entClient.Client().Secret.Query().
+			Where(secret.IDIn(secretIDs...)).
+			IDs(ctx)
+		if err != nil {
+			r.log.Errorf("list secret ids failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("list permissions failed")
+		}
+		for _, id := range ids {
+			existingSecrets[id] = true
+		}
+	}
+
+	existingCollections := make(map[string]bool)
+	if len(collectionIDs) > 0 {
+		ids, err := r.entClient.Client().Collection.Query().
+			Where(collection.IDIn(collectionIDs...)).
+			IDs(ctx)
+		if err != nil {
+			r.log.Errorf("list collection ids failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("list permissions failed")
+		}
+		for _, id := range ids {
+			existingCollections[id] = true
+		}
+	}
+
+	var orphans []*ent.Permission
+	for _, p := range perms {
+		switch p.ResourceType {
+		case permission.ResourceTypeRESOURCE_TYPE_FOLDER:
+			if !existingFolders[p.ResourceID] {
+				orphans = append(orphans, p)
+			}
+		case permission.ResourceTypeRESOURCE_TYPE_SECRET:
+			if !existingSecrets[p.ResourceID] {
+				orphans = append(orphans, p)
+			}
+		case permission.ResourceTypeRESOURCE_TYPE_COLLECTION:
+			if !existingCollections[p.ResourceID] {
+				orphans = append(orphans, p)
+			}
+		}
+	}
+
+	return orphans, nil
+}
+
+// DeletePermissions deletes permissions by ID
+func (r *MaintenanceRepo) DeletePermissions(ctx context.Context, ids []int) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	n, err := r.entClient.Client().Permission.Delete().
+		Where(permission.IDIn(ids...)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete permissions failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("delete permissions failed")
+	}
+	return n, nil
+}
+
+// ListFolders returns every folder
+func (r *MaintenanceRepo) ListFolders(ctx context.Context, tenantID *uint32) ([]*ent.Folder, error) {
+	q := r.entClient.Client().Folder.Query()
+	if tenantID != nil {
+		q = q.Where(folder.TenantIDEQ(*tenantID))
+	}
+	folders, err := q.All(ctx)
+	if err != nil {
+		r.log.Errorf("list folders failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list folders failed")
+	}
+	return folders, nil
+}
+
+// SetFolderPath overwrites the materialized path and depth of a folder
+func (r *MaintenanceRepo) SetFolderPath(ctx context.Context, id, path string, depth int32) error {
+	err := r.entClient.Client().Folder.UpdateOneID(id).
+		SetPath(path).
+		SetDepth(depth).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update folder path failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("update folder path failed")
+	}
+	return nil
+}
+
+// ListTrashedSecrets returns soft-deleted secrets last updated before the
+// cutoff. Protected secrets stay in the trash until a deletion request for
+// them is approved, and secrets under legal hold until the hold is lifted.
+func (r *MaintenanceRepo) ListTrashedSecrets(ctx context.Context, tenantID *uint32, before time.Time) ([]*ent.Secret, error) {
+	q := r.entClient.Client().Secret.Query().
+		Where(
+			secret.StatusEQ(secret.StatusSECRET_STATUS_DELETED),
+			secret.UpdateTimeLT(before),
+			secret.ProtectedEQ(false),
+		)
+	if tenantID != nil {
+		q = q.Where(secret.TenantIDEQ(*tenantID))
+	}
+	held, err := r.ListHeldFolderIDs(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if len(held) > 0 {
+		q = q.Where(secret.Or(secret.FolderIDIsNil(), secret.FolderIDNotIn(held...)))
+	}
+	secrets, err := q.All(ctx)
+	if err != nil {
+		r.log.Errorf("list trashed secrets failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list trashed secrets failed")
+	}
+	return secrets, nil
+}
+
+// ListHeldFolderIDs returns the folders under legal hold, placed on
+// themselves or on one of their ancestors
+func (r *MaintenanceRepo) ListHeldFolderIDs(ctx context.Context, tenantID *uint32) ([]string, error) {
+	client := r.entClient.Client()
+	q := client.Folder.Query().Where(folder.LegalHoldEQ(true))
+	if tenantID != nil {
+		q = q.Where(folder.TenantIDEQ(*tenantID))
+	}
+	held, err := q.All(ctx)
+	if err != nil {
+		r.log.Errorf("list held folders failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list folders failed")
+	}
+
+	var ids []string
+	for _, f := range held {
+		subtree, err := client.Folder.Query().
+			Where(folder.TenantIDEQ(derefUint32(f.TenantID)), folder.PathHasPrefix(f.Path+"/")).
+			IDs(ctx)
+		if err != nil {
+			r.log.Errorf("list held folders failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("list folders failed")
+		}
+		ids = append(ids, f.ID)
+		ids = append(ids, subtree...)
+	}
+	return ids, nil
+}
+
+// ListTrashedFolders returns the folders deleted themselves before the
+// cutoff. Ones that are or contain a protected folder stay in the trash until
+// restored.
+func (r *MaintenanceRepo) ListTrashedFolders(ctx context.Context, tenantID *uint32, before time.Time) ([]*ent.Folder, error) {
+	client := r.entClient.Client()
+	pq := client.Folder.Query().
+		Where(folder.StatusEQ(folder.StatusFOLDER_STATUS_DELETED), folder.ProtectedEQ(true))
+	if tenantID != nil {
+		pq = pq.Where(folder.TenantIDEQ(*tenantID))
+	}
+	protected, err := pq.Select(folder.FieldTrashedWithFolderID).Strings(ctx)
+	if err != nil {
+		r.log.Errorf("list protected trashed folders failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list trashed folders failed")
+	}
+
+	q := client.Folder.Query().
+		Where(
+			folder.StatusEQ(folder.StatusFOLDER_STATUS_DELETED),
+			folder.ParentIDIsNil(),
+			folder.DeletedTimeLT(before),
+		)
+	if tenantID != nil {
+		q = q.Where(folder.TenantIDEQ(*tenantID))
+	}
+	if len(protected) > 0 {
+		q = q.Where(folder.IDNotIn(protected...))
+	}
+	folders, err := q.All(ctx)
+	if err != nil {
+		r.log.Errorf("list trashed folders failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list trashed folders failed")
+	}
+	return folders, nil
+}
+
+// ListTrashedFolderContents returns the folders deleted along with a trashed
+// folder, itself included, and the secrets still in any of them
+func (r *MaintenanceRepo) ListTrashedFolderContents(ctx context.Context, root *ent.Folder) ([]string, []string, error) {
+	client := r.entClient.Client()
+	folderIDs, err := client.Folder.Query().
+		Where(folder.TenantIDEQ(derefUint32(root.TenantID)), folder.TrashedWithFolderIDEQ(root.ID)).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("list trashed folder tree failed: %s", err.Error())
+		return nil, nil, wardenV1.ErrorInternalServerError("list trashed folders failed")
+	}
+	secretIDs, err := client.Secret.Query().
+		Where(secret.TenantIDEQ(derefUint32(root.TenantID)), secret.FolderIDIn(folderIDs...)).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("list secrets in trashed folder tree failed: %s", err.Error())
+		return nil, nil, wardenV1.ErrorInternalServerError("list trashed folders failed")
+	}
+	return folderIDs, secretIDs, nil
+}
+
+// DeleteTrashedFolder permanently deletes a trashed folder and the folders
+// deleted along with it. The secrets in them must be purged first.
+func (r *MaintenanceRepo) DeleteTrashedFolder(ctx context.Context, root *ent.Folder) error {
+	if _, err := r.entClient.Client().Folder.Delete().
+		Where(
+			folder.TenantIDEQ(derefUint32(root.TenantID)),
+			folder.TrashedWithFolderIDEQ(root.ID),
+			folder.StatusEQ(folder.StatusFOLDER_STATUS_DELETED),
+		).
+		Exec(ctx); err != nil {
+		r.log.Errorf("delete trashed folder failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete trashed folder failed")
+	}
+	return nil
+}
+
+// ListSecrets returns every secret, optionally only one, including deleted
+// ones whose Vault data is kept until they are purged
+func (r *MaintenanceRepo) ListSecrets(ctx context.Context, tenantID *uint32, secretID *string) ([]*ent.Secret, error) {
+	q := r.entClient.Client().Secret.Query()
+	if tenantID != nil {
+		q = q.Where(secret.TenantIDEQ(*tenantID))
+	}
+	if secretID != nil && *secretID != "" {
+		q = q.Where(secret.IDEQ(*secretID))
+	}
+	secrets, err := q.Order(ent.Asc(secret.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list secrets failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list secrets failed")
+	}
+	return secrets, nil
+}
+
+// ListSecretVersions returns all version records of a secret
+func (r *MaintenanceRepo) ListSecretVersions(ctx context.Context, secretID string) ([]*ent.SecretVersion, error) {
+	versions, err := r.entClient.Client().SecretVersion.Query().
+		Where(secretversion.SecretIDEQ(secretID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list secret versions failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list secret versions failed")
+	}
+	return versions, nil
+}
+
+// DeleteSecretVersions deletes version records by ID
+func (r *MaintenanceRepo) DeleteSecretVersions(ctx context.Context, ids []int) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	n, err := r.entClient.Client().SecretVersion.Delete().
+		Where(secretversion.IDIn(ids...)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete secret versions failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("delete secret versions failed")
+	}
+	return n, nil
+}
+
+// ReconcileFolderCounts recomputes the denormalized secret and subfolder
+// counts of every folder and corrects those that drifted. It returns the
+// number of folders corrected.
+func (r *MaintenanceRepo) ReconcileFolderCounts(ctx context.Context, tenantID *uint32) (int, error) {
+	client := r.entClient.Client()
+
+	var secretCounts []struct {
+		FolderID string `json:"folder_id"`
+		Count    int32  `json:"count"`
+	}
+	secretQuery := client.Secret.Query().
+		Where(
+			secret.FolderIDNotNil(),
+			secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED),
+		)
+	if tenantID != nil {
+		secretQuery = secretQuery.Where(secret.TenantIDEQ(*tenantID))
+	}
+	if err := secretQuery.
+		GroupBy(secret.FieldFolderID).
+		Aggregate(ent.As(ent.Count(), "count")).
+		Scan(ctx, &secretCounts); err != nil {
+		r.log.Errorf("count secrets per folder failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("reconcile folder counts failed")
+	}
+
+	var subfolderCounts []struct {
+		ParentID string `json:"parent_id"`
+		Count    int32  `json:"count"`
+	}
+	subfolderQuery := client.Folder.Query().Where(folder.ParentIDNotNil())
+	if tenantID != nil {
+		subfolderQuery = subfolderQuery.Where(folder.TenantIDEQ(*tenantID))
+	}
+	if err := subfolderQuery.
+		GroupBy(folder.FieldParentID).
+		Aggregate(ent.As(ent.Count(), "count")).
+		Scan(ctx, &subfolderCounts); err != nil {
+		r.log.Errorf("count subfolders per folder failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("reconcile folder counts failed")
+	}
+
+	secretsIn := make(map[string]int32, len(secretCounts))
+	for _, c := range secretCounts {
+		secretsIn[c.FolderID] = c.Count
+	}
+	subfoldersIn := make(map[string]int32, len(subfolderCounts))
+	for _, c := range subfolderCounts {
+		subfoldersIn[c.ParentID] = c.Count
+	}
+
+	folderQuery := client.Folder.Query()
+	if tenantID != nil {
+		folderQuery = folderQuery.Where(folder.TenantIDEQ(*tenantID))
+	}
+	folders, err := folderQuery.
+		Select(folder.FieldID, folder.FieldSecretCount, folder.FieldSubfolderCount).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list folder counts failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("reconcile folder counts failed")
+	}
+
+	fixed := 0
+	for _, f := range folders {
+		secrets, subfolders := secretsIn[f.ID], subfoldersIn[f.ID]
+		if f.SecretCount == secrets && f.SubfolderCount == subfolders {
+			continue
+		}
+		// Only overwrite the counters still holding the drifted values, so a
+		// concurrent adjustment made since they were read is not lost
+		n, err := client.Folder.Update().
+			Where(
+				folder.IDEQ(f.ID),
+				folder.SecretCountEQ(f.SecretCount),
+				folder.SubfolderCountEQ(f.SubfolderCount),
+			).
+			SetSecretCount(secrets).
+			SetSubfolderCount(subfolders).
+			Save(ctx)
+		if err != nil {
+			r.log.Errorf("correct folder counts failed: %s", err.Error())
+			return fixed, wardenV1.ErrorInternalServerError("reconcile folder counts failed")
+		}
+		fixed += n
+	}
+	return fixed, nil
+}
+
+// Tables holding tenant data, as named in purge reports
+const (
+	TenantTablePendingOperations = "pending_operations"
+	TenantTableSecretVersions    = "secret_versions"
+	TenantTablePermissions       = "permissions"
+	TenantTableSecretUsage       = "secret_usage"
+	TenantTableVersionPins       = "version_pins"
+	TenantTableDeletionRequests  = "deletion_requests"
+	TenantTableCollections       = "collections"
+	TenantTableSecrets           = "secrets"
+	TenantTableFolders           = "folders"
+	TenantTableFolderChanges     = "folder_changes"
+	TenantTableWebhookDeliveries = "webhook_deliveries"
+	TenantTableWebhooks          = "webhooks"
+	TenantTableSecurityAlerts    = "security_alerts"
+	TenantTableEmergencyAccess   = "emergency_access"
+	TenantTableSettings          = "tenant_settings"
+	TenantTableAuditLogs         = "audit_logs"
+	TenantTableAuditChainHeads   = "audit_chain_heads"
+)
+
+// TenantTables lists the tables holding tenant data in the order they must
+// be purged: rows before the rows they reference, and pending operations
+// first so the outbox cannot write to Vault again while the tenant is purged.
+// Audit logs and their chain heads go last so a failed purge leaves its
+// history in place.
+var TenantTables = []string{
+	TenantTablePendingOperations,
+	TenantTableSecretVersions,
+	TenantTablePermissions,
+	TenantTableSecretUsage,
+	TenantTableVersionPins,
+	TenantTableDeletionRequests,
+	TenantTableCollections,
+	TenantTableSecrets,
+	TenantTableFolders,
+	TenantTableFolderChanges,
+	TenantTableWebhookDeliveries,
+	TenantTableWebhooks,
+	TenantTableSecurityAlerts,
+	TenantTableEmergencyAccess,
+	TenantTableSettings,
+	TenantTableAuditLogs,
+	TenantTableAuditChainHeads,
+}
+
+// CountTenantRows counts the rows of a tenant in one of TenantTables
+func (r *MaintenanceRepo) CountTenantRows(ctx context.Context, tenantID uint32, table string) (int, error) {
+	client := r.entClient.Client()
+
+	var (
+		n   int
+		err error
+	)
+	switch table {
+	case TenantTablePendingOperations:
+		n, err = client.PendingOperation.Query().Where(pendingoperation.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableSecretVersions:
+		n, err = client.SecretVersion.Query().Where(secretversion.HasSecretWith(secret.TenantIDEQ(tenantID))).Count(ctx)
+	case TenantTablePermissions:
+		n, err = client.Permission.Query().Where(permission.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableSecretUsage:
+		n, err = client.SecretUsage.Query().Where(secretusage.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableVersionPins:
+		n, err = client.VersionPin.Query().Where(versionpin.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableDeletionRequests:
+		n, err = client.DeletionRequest.Query().Where(deletionrequest.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableCollections:
+		n, err = client.Collection.Query().Where(collection.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableSecrets:
+		n, err = client.Secret.Query().Where(secret.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableFolders:
+		n, err = client.Folder.Query().Where(folder.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableFolderChanges:
+		n, err = client.FolderChangeLog.Query().Where(folderchangelog.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableWebhookDeliveries:
+		n, err = client.WebhookDelivery.Query().Where(webhookdelivery.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableWebhooks:
+		n, err = client.Webhook.Query().Where(webhook.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableSecurityAlerts:
+		n, err = client.SecurityAlert.Query().Where(securityalert.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableEmergencyAccess:
+		n, err = client.EmergencyAccess.Query().Where(emergencyaccess.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableSettings:
+		n, err = client.TenantSetting.Query().Where(tenantsetting.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableAuditLogs:
+		n, err = client.AuditLog.Query().Where(auditlog.TenantIDEQ(tenantID)).Count(ctx)
+	case TenantTableAuditChainHeads:
+		n, err = client.AuditChainHead.Query().Where(auditchainhead.TenantIDEQ(tenantID)).Count(ctx)
+	default:
+		return 0, wardenV1.ErrorBadRequest("unknown tenant table %q", table)
+	}
+	if err != nil {
+		r.log.Errorf("count tenant %s failed: %s", table, err.Error())
+		return 0, wardenV1.ErrorInternalServerError("count tenant data failed")
+	}
+	return n, nil
+}
+
+// DeleteTenantRows deletes the rows of a tenant in one of TenantTables and
+// returns how many were deleted. Tables referenced by other tables must be
+// purged after them, in the order of TenantTables.
+func (r *MaintenanceRepo) DeleteTenantRows(ctx context.Context, tenantID uint32, table string) (int, error) {
+	client := r.entClient.Client()
+
+	var (
+		n   int
+		err error
+	)
+	switch table {
+	case TenantTablePendingOperations:
+		n, err = client.PendingOperation.Delete().Where(pendingoperation.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableSecretVersions:
+		n, err = client.SecretVersion.Delete().Where(secretversion.HasSecretWith(secret.TenantIDEQ(tenantID))).Exec(ctx)
+	case TenantTablePermissions:
+		n, err = client.Permission.Delete().Where(permission.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableSecretUsage:
+		n, err = client.SecretUsage.Delete().Where(secretusage.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableVersionPins:
+		n, err = client.VersionPin.Delete().Where(versionpin.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableDeletionRequests:
+		n, err = client.DeletionRequest.Delete().Where(deletionrequest.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableCollections:
+		n, err = client.Collection.Delete().Where(collection.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableSecrets:
+		n, err = client.Secret.Delete().Where(secret.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableFolders:
+		n, err = r.deleteTenantFolders(ctx, client, tenantID)
+	case TenantTableFolderChanges:
+		n, err = client.FolderChangeLog.Delete().Where(folderchangelog.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableWebhookDeliveries:
+		n, err = client.WebhookDelivery.Delete().Where(webhookdelivery.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableWebhooks:
+		n, err = client.Webhook.Delete().Where(webhook.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableSecurityAlerts:
+		n, err = client.SecurityAlert.Delete().Where(securityalert.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableEmergencyAccess:
+		n, err = client.EmergencyAccess.Delete().Where(emergencyaccess.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableSettings:
+		n, err = client.TenantSetting.Delete().Where(tenantsetting.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableAuditLogs:
+		n, err = client.AuditLog.Delete().Where(auditlog.TenantIDEQ(tenantID)).Exec(ctx)
+	case TenantTableAuditChainHeads:
+		n, err = client.AuditChainHead.Delete().Where(auditchainhead.TenantIDEQ(tenantID)).Exec(ctx)
+	default:
+		return 0, wardenV1.ErrorBadRequest("unknown tenant table %q", table)
+	}
+	if err != nil {
+		r.log.Errorf("delete tenant %s failed: %s", table, err.Error())
+		return n, wardenV1.ErrorInternalServerError("delete tenant data failed")
+	}
+	return n, nil
+}
+
+// deleteTenantFolders deletes the folders of a tenant deepest first, so no
+// statement removes a folder whose children still reference it
+func (r *MaintenanceRepo) deleteTenantFolders(ctx context.Context, client *ent.Client, tenantID uint32) (int, error) {
+	total := 0
+	for {
+		deepest, err := client.Folder.Query().
+			Where(folder.TenantIDEQ(tenantID)).
+			Order(ent.Desc(folder.FieldDepth)).
+			Select(folder.FieldDepth).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+
+		n, err := client.Folder.Delete().
+			Where(folder.TenantIDEQ(tenantID), folder.DepthEQ(deepest.Depth)).
+			Exec(ctx)
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+}
@@ -0,0 +1,146 @@
+//go:build sqlite
+
+package data
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/deletionrequest"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/emergencyaccess"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folderchangelog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/migrate"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/pendingoperation"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/securityalert"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhookdelivery"
+)
+
+// seedTenantRows writes a row of the tenant to every table holding tenant
+// data
+func seedTenantRows(t *testing.T, client *ent.Client, tenantID uint32) {
+	t.Helper()
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	now := time.Now()
+	must := func(what string, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("seed %s of tenant %d: %v", what, tenantID, err)
+		}
+	}
+
+	f, err := client.Folder.Create().SetID(fmt.Sprintf("folder-%d", tenantID)).SetTenantID(tenantID).
+		SetName("infra").SetPath("/infra").Save(ctx)
+	must("folder", err)
+	s, err := client.Secret.Create().SetID(fmt.Sprintf("secret-%d", tenantID)).SetTenantID(tenantID).
+		SetFolderID(f.ID).SetName("db").SetVaultPath(fmt.Sprintf("warden/%d/db", tenantID)).Save(ctx)
+	must("secret", err)
+	_, err = client.SecretVersion.Create().SetSecretID(s.ID).SetVersionNumber(1).
+		SetVaultPath(s.VaultPath).SetChecksum("checksum").Save(ctx)
+	must("secret version", err)
+	_, err = client.Collection.Create().SetID(fmt.Sprintf("collection-%d", tenantID)).SetTenantID(tenantID).
+		SetName("ops").AddSecretIDs(s.ID).Save(ctx)
+	must("collection", err)
+	_, err = client.Permission.Create().SetTenantID(tenantID).
+		SetResourceType(permission.ResourceTypeRESOURCE_TYPE_SECRET).SetResourceID(s.ID).
+		SetRelation(permission.RelationRELATION_OWNER).
+		SetSubjectType(permission.SubjectTypeSUBJECT_TYPE_USER).SetSubjectID("1").Save(ctx)
+	must("permission", err)
+	_, err = client.PendingOperation.Create().SetTenantID(tenantID).
+		SetKind(pendingoperation.KindOPERATION_KIND_DESTROY_VAULT_DATA).SetSecretID(s.ID).
+		SetVaultPath(s.VaultPath).SetNextAttemptAt(now).Save(ctx)
+	must("pending operation", err)
+	_, err = client.SecretUsage.Create().SetTenantID(tenantID).SetSecretID(s.ID).SetDay(now).Save(ctx)
+	must("secret usage", err)
+	_, err = client.VersionPin.Create().SetTenantID(tenantID).SetSecretID(s.ID).SetVersionNumber(1).
+		SetTokenHash(fmt.Sprintf("hash-%d", tenantID)).SetConsumer("deploy").Save(ctx)
+	must("version pin", err)
+	_, err = client.DeletionRequest.Create().SetTenantID(tenantID).
+		SetResourceType(deletionrequest.ResourceTypeDELETION_RESOURCE_TYPE_SECRET).SetResourceID(s.ID).
+		SetStatus(deletionrequest.StatusDELETION_REQUEST_STATUS_PENDING).SetRequestedBy("1").
+		SetExpiresAt(now.Add(time.Hour)).Save(ctx)
+	must("deletion request", err)
+	_, err = client.FolderChangeLog.Create().SetTenantID(tenantID).SetFolderID(f.ID).
+		SetAction(folderchangelog.ActionFOLDER_CHANGE_ACTION_CREATED).Save(ctx)
+	must("folder change", err)
+	hook, err := client.Webhook.Create().SetTenantID(tenantID).SetName("alerts").
+		SetURL("https://hooks.example.com").Save(ctx)
+	must("webhook", err)
+	_, err = client.WebhookDelivery.Create().SetTenantID(tenantID).SetWebhookID(hook.ID).
+		SetEventID(fmt.Sprintf("event-%d", tenantID)).SetEventType("secret.read").SetPayload("{}").
+		SetStatus(webhookdelivery.StatusDELIVERY_STATUS_PENDING).Save(ctx)
+	must("webhook delivery", err)
+	_, err = client.SecurityAlert.Create().SetTenantID(tenantID).
+		SetKind(securityalert.KindALERT_KIND_CANARY_READ).
+		SetSeverity(securityalert.SeverityALERT_SEVERITY_HIGH).SetMessage("canary read").Save(ctx)
+	must("security alert", err)
+	_, err = client.EmergencyAccess.Create().SetTenantID(tenantID).SetGrantorID("1").SetGranteeID("2").
+		SetFolderID(f.ID).SetWaitDays(7).SetStatus(emergencyaccess.StatusEMERGENCY_ACCESS_STATUS_IDLE).Save(ctx)
+	must("emergency access", err)
+	_, err = client.TenantSetting.Create().SetTenantID(tenantID).Save(ctx)
+	must("tenant setting", err)
+	_, err = client.AuditLog.Create().SetTenantID(tenantID).SetAuditID(fmt.Sprintf("audit-%d", tenantID)).
+		SetOperation("/warden.service.v1.WardenSecretService/GetSecretPassword").Save(ctx)
+	must("audit log", err)
+	_, err = client.AuditChainHead.Create().SetTenantID(tenantID).Save(ctx)
+	must("audit chain head", err)
+}
+
+// countTenantRowsByTable counts the rows of the tenant in every table with a
+// tenant_id column, so tables missing from TenantTables are caught too
+func countTenantRowsByTable(t *testing.T, db *stdsql.DB, tenantID uint32) map[string]int {
+	t.Helper()
+	counts := make(map[string]int)
+	for _, table := range migrate.Tables {
+		if _, ok := table.Column("tenant_id"); !ok {
+			continue
+		}
+		var n int
+		query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE tenant_id = ?", table.Name)
+		if err := db.QueryRow(query, tenantID).Scan(&n); err != nil {
+			t.Fatalf("count %s: %v", table.Name, err)
+		}
+		counts[table.Name] = n
+	}
+	return counts
+}
+
+func TestDeleteTenantRowsLeavesNoTenantData(t *testing.T) {
+	ctx, client := newTestEntClient(t)
+	repo := NewMaintenanceRepo(ctx, client)
+	seedTenantRows(t, client.Client(), 1)
+	seedTenantRows(t, client.Client(), 2)
+
+	for table, n := range countTenantRowsByTable(t, client.DB(), 1) {
+		if n == 0 {
+			t.Fatalf("no %s row seeded, add one to seedTenantRows", table)
+		}
+	}
+
+	sysCtx := appViewer.NewSystemViewerContext(context.Background())
+	for _, table := range TenantTables {
+		if _, err := repo.DeleteTenantRows(sysCtx, 1, table); err != nil {
+			t.Fatalf("delete tenant %s: %v", table, err)
+		}
+		if n, err := repo.CountTenantRows(sysCtx, 1, table); err != nil || n != 0 {
+			t.Fatalf("tenant %s after the delete: %d rows, err=%v", table, n, err)
+		}
+	}
+
+	for table, n := range countTenantRowsByTable(t, client.DB(), 1) {
+		if n != 0 {
+			t.Errorf("%s keeps %d rows of the purged tenant", table, n)
+		}
+	}
+	for table, n := range countTenantRowsByTable(t, client.DB(), 2) {
+		if n == 0 {
+			t.Errorf("%s lost the rows of the other tenant", table)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package data
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"unicode/utf8"
+)
+
+// MetadataViolation is one rule of a folder's metadata schema that a secret's
+// metadata breaks. Path is a JSON pointer to the offending value ("" for the
+// metadata object itself).
+type MetadataViolation struct {
+	Path    string
+	Message string
+}
+
+// MetadataSchema is the JSON Schema subset folders can require of the
+// metadata of their secrets: type, required, properties,
+// additionalProperties (boolean), enum, const, pattern, minLength,
+// maxLength, minimum, maximum, items, minItems and maxItems. Unknown
+// keywords are ignored.
+type MetadataSchema struct {
+	raw map[string]any
+}
+
+// ParseMetadataSchema checks that a schema only uses supported keywords with
+// valid values
+func ParseMetadataSchema(raw map[string]any) (*MetadataSchema, error) {
+	if err := checkSchemaNode(raw, ""); err != nil {
+		return nil, err
+	}
+	return &MetadataSchema{raw: raw}, nil
+}
+
+// Validate returns the rules the metadata breaks, ordered by path
+func (s *MetadataSchema) Validate(metadata map[string]any) []MetadataViolation {
+	if s == nil {
+		return nil
+	}
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	var violations []MetadataViolation
+	validateSchemaNode(s.raw, metadata, "", &violations)
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].Path < violations[j].Path })
+	return violations
+}
+
+var schemaTypes = []string{"object", "array", "string", "number", "integer", "boolean", "null"}
+
+func checkSchemaNode(node map[string]any, path string) error {
+	at := path
+	if at == "" {
+		at = "/"
+	}
+	if t, ok := node["type"]; ok {
+		name, isString := t.(string)
+		if !isString || !slices.Contains(schemaTypes, name) {
+			return fmt.Errorf("%s: type must be one of %v", at, schemaTypes)
+		}
+	}
+	if r, ok := node["required"]; ok {
+		list, isList := r.([]any)
+		if !isList {
+			return fmt.Errorf("%s: required must be an array of strings", at)
+		}
+		for _, v := range list {
+			if _, isString := v.(string); !isString {
+				return fmt.Errorf("%s: required must be an array of strings", at)
+			}
+		}
+	}
+	if p, ok := node["pattern"]; ok {
+		expr, isString := p.(string)
+		if !isString {
+			return fmt.Errorf("%s: pattern must be a string", at)
+		}
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("%s: invalid pattern: %v", at, err)
+		}
+	}
+	if e, ok := node["enum"]; ok {
+		if _, isList := e.([]any); !isList {
+			return fmt.Errorf("%s: enum must be an array", at)
+		}
+	}
+	if a, ok := node["additionalProperties"]; ok {
+		if _, isBool := a.(bool); !isBool {
+			return fmt.Errorf("%s: additionalProperties must be a boolean", at)
+		}
+	}
+	for _, key := range []string{"minLength", "maxLength", "minimum", "maximum", "minItems", "maxItems"} {
+		if v, ok := node[key]; ok {
+			if _, isNumber := v.(float64); !isNumber {
+				return fmt.Errorf("%s: %s must be a number", at, key)
+			}
+		}
+	}
+	if p, ok := node["properties"]; ok {
+		props, isObject := p.(map[string]any)
+		if !isObject {
+			return fmt.Errorf("%s: properties must be an object", at)
+		}
+		for name, sub := range props {
+			subNode, isObject := sub.(map[string]any)
+			if !isObject {
+				return fmt.Errorf("%s/%s: schema must be an object", path, name)
+			}
+			if err := checkSchemaNode(subNode, path+"/"+name); err != nil {
+				return err
+			}
+		}
+	}
+	if i, ok := node["items"]; ok {
+		itemNode, isObject := i.(map[string]any)
+		if !isObject {
+			return fmt.Errorf("%s: items must be an object", at)
+		}
+		if err := checkSchemaNode(itemNode, path+"/items"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSchemaNode(node map[string]any, value any, path string, violations *[]MetadataViolation) {
+	fail := func(format string, args ...any) {
+		*violations = append(*violations, MetadataViolation{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if t, ok := node["type"].(string); ok && !matchesSchemaType(t, value) {
+		fail("must be of type %s", t)
+		return
+	}
+	if c, ok := node["const"]; ok && !schemaValuesEqual(c, value) {
+		fail("must be %v", c)
+	}
+	if e, ok := node["enum"].([]any); ok && !slices.ContainsFunc(e, func(v any) bool { return schemaValuesEqual(v, value) }) {
+		fail("must be one of %v", e)
+	}
+
+	switch v := value.(type) {
+	case string:
+		length := float64(utf8.RuneCountInString(v))
+		if n, ok := node["minLength"].(float64); ok && length < n {
+			fail("must be at least %d characters", int(n))
+		}
+		if n, ok := node["maxLength"].(float64); ok && length > n {
+			fail("must be at most %d characters", int(n))
+		}
+		if p, ok := node["pattern"].(string); ok {
+			if re, err := regexp.Compile(p); err == nil && !re.MatchString(v) {
+				fail("must match pattern %s", p)
+			}
+		}
+	case float64:
+		if n, ok := node["minimum"].(float64); ok && v < n {
+			fail("must be at least %v", n)
+		}
+		if n, ok := node["maximum"].(float64); ok && v > n {
+			fail("must be at most %v", n)
+		}
+	case []any:
+		if n, ok := node["minItems"].(float64); ok && float64(len(v)) < n {
+			fail("must have at least %d items", int(n))
+		}
+		if n, ok := node["maxItems"].(float64); ok && float64(len(v)) > n {
+			fail("must have at most %d items", int(n))
+		}
+		if items, ok := node["items"].(map[string]any); ok {
+			for i, item := range v {
+				validateSchemaNode(items, item, fmt.Sprintf("%s/%d", path, i), violations)
+			}
+		}
+	case map[string]any:
+		if required, ok := node["required"].([]any); ok {
+			for _, r := range required {
+				name, _ := r.(string)
+				if _, present := v[name]; !present {
+					*violations = append(*violations, MetadataViolation{Path: path + "/" + name, Message: "is required"})
+				}
+			}
+		}
+		props, _ := node["properties"].(map[string]any)
+		for name, sub := range v {
+			if subNode, ok := props[name].(map[string]any); ok {
+				validateSchemaNode(subNode, sub, path+"/"+name, violations)
+			} else if allowed, ok := node["additionalProperties"].(bool); ok && !allowed {
+				*violations = append(*violations, MetadataViolation{Path: path + "/" + name, Message: "is not allowed"})
+			}
+		}
+	}
+}
+
+func matchesSchemaType(t string, value any) bool {
+	switch t {
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == math.Trunc(n)
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	}
+	return true
+}
+
+// schemaValuesEqual compares JSON scalars; arrays and objects never match
+func schemaValuesEqual(a, b any) bool {
+	switch a.(type) {
+	case []any, map[string]any:
+		return false
+	}
+	switch b.(type) {
+	case []any, map[string]any:
+		return false
+	}
+	return a == b
+}
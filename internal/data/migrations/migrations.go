@@ -0,0 +1,12 @@
+// Package migrations embeds the versioned schema migrations, one directory
+// per database dialect. Each migration is a pair of files,
+// NNNNNN_name.up.sql and NNNNNN_name.down.sql, numbered in the order they
+// apply. Statements end with a semicolon at the end of a line.
+package migrations
+
+import "embed"
+
+// FS holds the postgres, mysql and sqlite migration directories
+//
+//go:embed all:postgres all:mysql all:sqlite
+var FS embed.FS
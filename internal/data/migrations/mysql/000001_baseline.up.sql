@@ -0,0 +1,10 @@
+-- create "warden_audit_logs" table
+CREATE TABLE `warden_audit_logs` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `audit_id` varchar(255) NOT NULL COMMENT "Unique audit log identifier (UUID)", `request_id` varchar(255) NULL COMMENT "Request ID from metadata", `operation` varchar(255) NOT NULL COMMENT "gRPC operation path", `service_name` varchar(255) NOT NULL DEFAULT 'warden-service' COMMENT "Service name", `client_id` varchar(255) NULL COMMENT "Client ID from certificate CN", `client_common_name` varchar(255) NULL COMMENT "Client certificate common name", `client_organization` varchar(255) NULL COMMENT "Client certificate organization", `client_serial_number` varchar(255) NULL COMMENT "Client certificate serial number", `is_authenticated` bool NOT NULL DEFAULT false COMMENT "Whether the client was authenticated via mTLS", `success` bool NOT NULL DEFAULT true COMMENT "Whether the operation succeeded", `error_code` int NULL COMMENT "Error code if failed", `error_message` varchar(255) NULL COMMENT "Error message if failed", `latency_ms` bigint NOT NULL DEFAULT 0 COMMENT "Operation latency in milliseconds", `peer_address` varchar(255) NULL COMMENT "Client IP address", `geo_location` json NULL COMMENT "Geographic location info", `log_hash` varchar(255) NULL COMMENT "SHA-256 hash of the log content", `signature` blob NULL COMMENT "ECDSA signature for integrity verification", `metadata` json NULL COMMENT "Additional metadata", PRIMARY KEY (`id`), UNIQUE INDEX `audit_id` (`audit_id`), INDEX `warden_auditlog_tenant_id` (`tenant_id`), INDEX `warden_auditlog_tenant_client` (`tenant_id`, `client_id`), INDEX `warden_auditlog_tenant_operation` (`tenant_id`, `operation`), INDEX `warden_auditlog_tenant_success` (`tenant_id`, `success`), INDEX `warden_auditlog_operation` (`operation`), INDEX `warden_auditlog_client_id` (`client_id`), INDEX `warden_auditlog_success` (`success`), INDEX `warden_auditlog_peer_address` (`peer_address`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "warden_folders" table
+CREATE TABLE `warden_folders` (`id` varchar(255) NOT NULL COMMENT "UUID primary key", `create_by` int unsigned NULL COMMENT "创建者ID", `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `name` varchar(255) NOT NULL COMMENT "Folder name", `path` varchar(4096) NOT NULL COMMENT "Materialized path (e.g., /root/sub/current)", `description` varchar(1024) NULL COMMENT "Optional description", `depth` int NOT NULL DEFAULT 0 COMMENT "Nesting depth level (0 for root folders)", `parent_id` varchar(255) NULL COMMENT "Parent folder ID (null for root-level folders)", PRIMARY KEY (`id`), UNIQUE INDEX `folder_tenant_id_parent_id_name` (`tenant_id`, `parent_id`, `name`), UNIQUE INDEX `folder_tenant_id_path` (`tenant_id`, `path`), INDEX `folder_tenant_id` (`tenant_id`), INDEX `folder_parent_id` (`parent_id`), INDEX `folder_path` (`path`), CONSTRAINT `warden_folders_warden_folders_children` FOREIGN KEY (`parent_id`) REFERENCES `warden_folders` (`id`) ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "warden_secrets" table
+CREATE TABLE `warden_secrets` (`id` varchar(255) NOT NULL COMMENT "UUID primary key", `create_by` int unsigned NULL COMMENT "创建者ID", `update_by` int unsigned NULL COMMENT "更新者ID", `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `name` varchar(255) NOT NULL COMMENT "Secret name", `username` varchar(255) NULL COMMENT "Associated username", `host_url` varchar(2048) NULL COMMENT "Host/URL associated with the secret", `vault_path` varchar(255) NOT NULL COMMENT "Reference path to HashiCorp Vault", `current_version` int NOT NULL DEFAULT 1 COMMENT "Current active version number", `metadata` json NULL COMMENT "Custom fields, notes, tags (JSON)", `description` varchar(4096) NULL COMMENT "Description", `status` enum('SECRET_STATUS_UNSPECIFIED','SECRET_STATUS_ACTIVE','SECRET_STATUS_ARCHIVED','SECRET_STATUS_DELETED') NOT NULL DEFAULT 'SECRET_STATUS_ACTIVE' COMMENT "Secret status", `has_totp` bool NOT NULL DEFAULT false COMMENT "Whether this secret has a TOTP authenticator configured", `folder_id` varchar(255) NULL COMMENT "Parent folder ID (null for root-level secrets)", PRIMARY KEY (`id`), UNIQUE INDEX `secret_tenant_id_folder_id_name` (`tenant_id`, `folder_id`, `name`), INDEX `secret_tenant_id` (`tenant_id`), INDEX `secret_folder_id` (`folder_id`), INDEX `secret_tenant_id_name` (`tenant_id`, `name`), INDEX `secret_tenant_id_username` (`tenant_id`, `username`), INDEX `secret_status` (`status`), UNIQUE INDEX `secret_vault_path` (`vault_path`), CONSTRAINT `warden_secrets_warden_folders_secrets` FOREIGN KEY (`folder_id`) REFERENCES `warden_folders` (`id`) ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "warden_permissions" table
+CREATE TABLE `warden_permissions` (`id` bigint NOT NULL AUTO_INCREMENT, `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `resource_type` enum('RESOURCE_TYPE_UNSPECIFIED','RESOURCE_TYPE_FOLDER','RESOURCE_TYPE_SECRET') NOT NULL COMMENT "Type of resource (folder or secret)", `resource_id` varchar(36) NOT NULL COMMENT "ID of the folder or secret", `relation` enum('RELATION_UNSPECIFIED','RELATION_OWNER','RELATION_EDITOR','RELATION_VIEWER','RELATION_SHARER') NOT NULL COMMENT "Permission level (owner, editor, viewer, sharer)", `subject_type` enum('SUBJECT_TYPE_UNSPECIFIED','SUBJECT_TYPE_USER','SUBJECT_TYPE_ROLE','SUBJECT_TYPE_TENANT') NOT NULL COMMENT "Type of subject (user, role, or tenant)", `subject_id` varchar(36) NOT NULL COMMENT "ID of the user, role, or tenant", `granted_by` int unsigned NULL COMMENT "User ID who granted this permission", `expires_at` timestamp NULL COMMENT "Optional expiration time for temporary access", `folder_permissions` varchar(255) NULL, `secret_permissions` varchar(255) NULL, PRIMARY KEY (`id`), UNIQUE INDEX `permission_tenant_id_resource_t_6b7412ca300e43b4f618f7d81d9bd1dd` (`tenant_id`, `resource_type`, `resource_id`, `relation`, `subject_type`, `subject_id`), INDEX `permission_tenant_id_resource_type_resource_id` (`tenant_id`, `resource_type`, `resource_id`), INDEX `permission_subject_type_subject_id` (`subject_type`, `subject_id`), INDEX `permission_tenant_id` (`tenant_id`), INDEX `permission_expires_at` (`expires_at`), CONSTRAINT `warden_permissions_warden_folders_permissions` FOREIGN KEY (`folder_permissions`) REFERENCES `warden_folders` (`id`) ON DELETE SET NULL, CONSTRAINT `warden_permissions_warden_secrets_permissions` FOREIGN KEY (`secret_permissions`) REFERENCES `warden_secrets` (`id`) ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "warden_secret_versions" table
+CREATE TABLE `warden_secret_versions` (`id` bigint NOT NULL AUTO_INCREMENT, `create_by` int unsigned NULL COMMENT "创建者ID", `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `version_number` int NOT NULL COMMENT "Version number (1, 2, 3...)", `vault_path` varchar(255) NOT NULL COMMENT "Vault path for this version", `comment` varchar(1024) NULL COMMENT "Version comment describing the change", `checksum` varchar(64) NOT NULL COMMENT "SHA-256 checksum of the password", `secret_id` varchar(255) NOT NULL COMMENT "Parent secret ID", PRIMARY KEY (`id`), UNIQUE INDEX `secretversion_secret_id_version_number` (`secret_id`, `version_number`), INDEX `secretversion_secret_id` (`secret_id`), INDEX `secretversion_vault_path` (`vault_path`), CONSTRAINT `warden_secret_versions_warden_secrets_versions` FOREIGN KEY (`secret_id`) REFERENCES `warden_secrets` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
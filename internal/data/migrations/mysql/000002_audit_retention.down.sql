@@ -0,0 +1,2 @@
+-- drop "warden_tenant_settings" table
+DROP TABLE `warden_tenant_settings`;
@@ -0,0 +1,2 @@
+-- create "warden_tenant_settings" table
+CREATE TABLE `warden_tenant_settings` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `update_by` int unsigned NULL COMMENT "更新者ID", `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `audit_retention_days` int NOT NULL DEFAULT 0 COMMENT "Audit log retention in days (0 = deployment default)", PRIMARY KEY (`id`), UNIQUE INDEX `warden_tenant_settings_tenant_id` (`tenant_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
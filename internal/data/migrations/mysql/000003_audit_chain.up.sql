@@ -0,0 +1,2 @@
+-- modify "warden_audit_logs" table
+ALTER TABLE `warden_audit_logs` ADD COLUMN `chain_seq` bigint NOT NULL DEFAULT 0 COMMENT "Per-tenant position in the hash chain (0 = not chained)", ADD COLUMN `prev_hash` varchar(255) NULL COMMENT "Chain hash of the previous entry of the same tenant", ADD COLUMN `chain_hash` varchar(255) NULL COMMENT "SHA-256 over the previous chain hash and this entry's content", ADD INDEX `warden_auditlog_tenant_chain_seq` (`tenant_id`, `chain_seq`);
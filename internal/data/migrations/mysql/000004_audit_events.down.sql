@@ -0,0 +1,2 @@
+-- modify "warden_audit_logs" table
+ALTER TABLE `warden_audit_logs` DROP COLUMN `event_type`, DROP COLUMN `resource_type`, DROP COLUMN `resource_id`, DROP INDEX `warden_auditlog_tenant_event_type`, DROP INDEX `warden_auditlog_tenant_resource_event`;
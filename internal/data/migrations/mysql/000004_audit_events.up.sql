@@ -0,0 +1,2 @@
+-- modify "warden_audit_logs" table
+ALTER TABLE `warden_audit_logs` ADD COLUMN `event_type` varchar(255) NULL COMMENT "Semantic domain event (e.g. secret.password_read)", ADD COLUMN `resource_type` varchar(255) NULL COMMENT "Type of the resource the event refers to", ADD COLUMN `resource_id` varchar(255) NULL COMMENT "ID of the resource the event refers to", ADD INDEX `warden_auditlog_tenant_event_type` (`tenant_id`, `event_type`), ADD INDEX `warden_auditlog_tenant_resource_event` (`tenant_id`, `resource_id`, `event_type`);
@@ -0,0 +1,4 @@
+-- modify "warden_audit_logs" table
+ALTER TABLE `warden_audit_logs` ADD COLUMN `actor_id` varchar(255) NULL COMMENT "User ID of the caller", ADD INDEX `warden_auditlog_tenant_actor_event` (`tenant_id`, `actor_id`, `event_type`);
+-- create "warden_security_alerts" table
+CREATE TABLE `warden_security_alerts` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `kind` enum('ALERT_KIND_UNSPECIFIED','ALERT_KIND_EXCESSIVE_READS','ALERT_KIND_NEW_PEER_ADDRESS','ALERT_KIND_NEW_GEO_LOCATION') NOT NULL COMMENT "Detected anomaly", `severity` enum('ALERT_SEVERITY_UNSPECIFIED','ALERT_SEVERITY_LOW','ALERT_SEVERITY_MEDIUM','ALERT_SEVERITY_HIGH') NOT NULL DEFAULT 'ALERT_SEVERITY_MEDIUM' COMMENT "Alert severity", `user_id` varchar(255) NULL COMMENT "User whose activity triggered the alert", `resource_id` varchar(255) NULL COMMENT "Secret involved, if the alert concerns a single secret", `message` varchar(255) NOT NULL COMMENT "Human-readable description", `details` json NULL COMMENT "Detection details (counts, window, peer address, location)", `acknowledged` bool NOT NULL DEFAULT false COMMENT "Whether an operator acknowledged the alert", `acknowledged_by` int unsigned NULL COMMENT "User ID who acknowledged the alert", `acknowledged_at` timestamp NULL COMMENT "When the alert was acknowledged", PRIMARY KEY (`id`), INDEX `warden_security_alerts_tenant_ack` (`tenant_id`, `acknowledged`), INDEX `warden_security_alerts_tenant_user_kind` (`tenant_id`, `user_id`, `kind`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
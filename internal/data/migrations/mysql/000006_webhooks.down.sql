@@ -0,0 +1,4 @@
+-- drop "warden_webhooks" table
+DROP TABLE `warden_webhooks`;
+-- drop "warden_webhook_deliveries" table
+DROP TABLE `warden_webhook_deliveries`;
@@ -0,0 +1,4 @@
+-- create "warden_webhooks" table
+CREATE TABLE `warden_webhooks` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `create_by` int unsigned NULL COMMENT "创建者ID", `update_by` int unsigned NULL COMMENT "更新者ID", `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `name` varchar(255) NOT NULL COMMENT "Display name", `url` varchar(2048) NOT NULL COMMENT "Endpoint receiving the POST requests", `secret` varchar(255) NULL COMMENT "Shared secret used to HMAC-sign payloads", `event_types` json NULL COMMENT "Subscribed event types (e.g. permission.granted)", `enabled` bool NOT NULL DEFAULT true COMMENT "Whether deliveries are sent", `description` varchar(1024) NULL COMMENT "Optional description", PRIMARY KEY (`id`), INDEX `warden_webhooks_tenant_enabled` (`tenant_id`, `enabled`), UNIQUE INDEX `warden_webhooks_tenant_name` (`tenant_id`, `name`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "warden_webhook_deliveries" table
+CREATE TABLE `warden_webhook_deliveries` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `webhook_id` int unsigned NOT NULL COMMENT "Target webhook", `event_id` varchar(255) NOT NULL COMMENT "Event identifier (also used to avoid duplicate deliveries)", `event_type` varchar(64) NOT NULL COMMENT "Event type", `payload` longtext NOT NULL COMMENT "JSON body sent to the endpoint", `status` enum('DELIVERY_STATUS_UNSPECIFIED','DELIVERY_STATUS_PENDING','DELIVERY_STATUS_DELIVERED','DELIVERY_STATUS_FAILED') NOT NULL DEFAULT 'DELIVERY_STATUS_PENDING' COMMENT "Delivery state", `attempts` int NOT NULL DEFAULT 0 COMMENT "Number of delivery attempts made", `response_code` int NULL COMMENT "HTTP status of the last attempt", `last_error` varchar(1024) NULL COMMENT "Error of the last failed attempt", `next_attempt_at` timestamp NULL COMMENT "When the next attempt is due (pending deliveries only)", `delivered_at` timestamp NULL COMMENT "When the endpoint acknowledged the event", PRIMARY KEY (`id`), UNIQUE INDEX `warden_webhook_deliveries_webhook_event` (`webhook_id`, `event_id`), INDEX `warden_webhook_deliveries_status_next` (`status`, `next_attempt_at`), INDEX `warden_webhook_deliveries_webhook_time` (`webhook_id`, `create_time`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- modify "warden_tenant_settings" table
+ALTER TABLE `warden_tenant_settings` DROP COLUMN `export_excluded_tags`, DROP COLUMN `export_excluded_folder_ids`;
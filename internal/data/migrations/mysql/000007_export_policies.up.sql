@@ -0,0 +1,2 @@
+-- modify "warden_tenant_settings" table
+ALTER TABLE `warden_tenant_settings` ADD COLUMN `export_excluded_tags` json NULL COMMENT "Secrets tagged with any of these tags are never exported", ADD COLUMN `export_excluded_folder_ids` json NULL COMMENT "Folders whose subtrees are never exported";
@@ -0,0 +1,2 @@
+-- modify "warden_tenant_settings" table
+ALTER TABLE `warden_tenant_settings` ADD COLUMN `password_policy_enabled` bool NOT NULL DEFAULT false COMMENT "Whether new passwords are checked against the policy", ADD COLUMN `password_min_length` int NOT NULL DEFAULT 0 COMMENT "Minimum password length (0 = no minimum)", ADD COLUMN `password_require_lowercase` bool NOT NULL DEFAULT false COMMENT "Passwords must contain a lowercase letter", ADD COLUMN `password_require_uppercase` bool NOT NULL DEFAULT false COMMENT "Passwords must contain an uppercase letter", ADD COLUMN `password_require_digit` bool NOT NULL DEFAULT false COMMENT "Passwords must contain a digit", ADD COLUMN `password_require_symbol` bool NOT NULL DEFAULT false COMMENT "Passwords must contain a symbol", ADD COLUMN `password_banned_words` json NULL COMMENT "Words passwords must not contain (case-insensitive)", ADD COLUMN `password_max_age_days` int NOT NULL DEFAULT 0 COMMENT "Days after which a password must be changed (0 = never)", ADD COLUMN `password_history_size` int NOT NULL DEFAULT 0 COMMENT "Number of previous passwords of a secret that may not be reused";
@@ -0,0 +1,4 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` DROP COLUMN `last_accessed_time`, DROP INDEX `folder_tenant_id_parent_id_create_time`, DROP INDEX `folder_tenant_id_parent_id_update_time`, DROP INDEX `folder_tenant_id_parent_id_last_accessed_time`;
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` DROP COLUMN `last_accessed_time`, DROP INDEX `secret_tenant_id_folder_id_create_time`, DROP INDEX `secret_tenant_id_folder_id_update_time`, DROP INDEX `secret_tenant_id_folder_id_last_accessed_time`;
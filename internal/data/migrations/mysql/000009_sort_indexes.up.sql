@@ -0,0 +1,4 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` ADD COLUMN `last_accessed_time` timestamp NULL COMMENT "When a password of a secret in this folder was last read", ADD INDEX `folder_tenant_id_parent_id_create_time` (`tenant_id`, `parent_id`, `create_time`), ADD INDEX `folder_tenant_id_parent_id_update_time` (`tenant_id`, `parent_id`, `update_time`), ADD INDEX `folder_tenant_id_parent_id_last_accessed_time` (`tenant_id`, `parent_id`, `last_accessed_time`);
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` ADD COLUMN `last_accessed_time` timestamp NULL COMMENT "When the password was last read", ADD INDEX `secret_tenant_id_folder_id_create_time` (`tenant_id`, `folder_id`, `create_time`), ADD INDEX `secret_tenant_id_folder_id_update_time` (`tenant_id`, `folder_id`, `update_time`), ADD INDEX `secret_tenant_id_folder_id_last_accessed_time` (`tenant_id`, `folder_id`, `last_accessed_time`);
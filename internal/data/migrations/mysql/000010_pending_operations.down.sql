@@ -0,0 +1,2 @@
+-- drop "warden_pending_operations" table
+DROP TABLE `warden_pending_operations`;
@@ -0,0 +1,2 @@
+-- create "warden_pending_operations" table
+CREATE TABLE `warden_pending_operations` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `kind` enum('OPERATION_KIND_UNSPECIFIED','OPERATION_KIND_DESTROY_VAULT_DATA') NOT NULL DEFAULT 'OPERATION_KIND_DESTROY_VAULT_DATA' COMMENT "Operation to carry out", `secret_id` varchar(36) NOT NULL COMMENT "Secret the Vault data belongs to", `vault_path` varchar(512) NOT NULL COMMENT "Vault path of the password", `totp_path` varchar(512) NULL COMMENT "Vault path of the TOTP secret, if any", `attempts` int NOT NULL DEFAULT 0 COMMENT "Number of failed attempts", `last_error` varchar(1024) NULL COMMENT "Error of the last failed attempt", `next_attempt_at` timestamp NOT NULL COMMENT "When the worker may carry out the operation", PRIMARY KEY (`id`), INDEX `warden_pending_operations_next` (`next_attempt_at`), INDEX `warden_pending_operations_vault_path` (`vault_path`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` ADD COLUMN `secret_count` int NOT NULL DEFAULT 0 COMMENT "Number of non-deleted secrets directly in this folder (denormalized)", ADD COLUMN `subfolder_count` int NOT NULL DEFAULT 0 COMMENT "Number of direct child folders (denormalized)";
@@ -0,0 +1,4 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` DROP COLUMN `revision`;
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` DROP COLUMN `revision`;
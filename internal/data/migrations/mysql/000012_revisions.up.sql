@@ -0,0 +1,4 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` ADD COLUMN `revision` bigint NOT NULL DEFAULT 0 COMMENT "Incremented on every change, for optimistic concurrency";
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` ADD COLUMN `revision` bigint NOT NULL DEFAULT 0 COMMENT "Incremented on every change, for optimistic concurrency";
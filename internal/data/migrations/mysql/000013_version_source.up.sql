@@ -0,0 +1,2 @@
+-- modify "warden_secret_versions" table
+ALTER TABLE `warden_secret_versions` ADD COLUMN `source_version` int NULL COMMENT "Version this one was restored from, if created by a restore";
@@ -0,0 +1,2 @@
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` ADD COLUMN `sensitive` bool NOT NULL DEFAULT false COMMENT "Whether password reads by non-owners raise secret.read webhook events";
@@ -0,0 +1,2 @@
+-- drop "warden_emergency_access" table
+DROP TABLE `warden_emergency_access`;
@@ -0,0 +1,2 @@
+-- create "warden_emergency_access" table
+CREATE TABLE `warden_emergency_access` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `grantor_id` varchar(36) NOT NULL COMMENT "User whose folder can be accessed", `grantee_id` varchar(36) NOT NULL COMMENT "Trusted user who can request access", `folder_id` varchar(36) NOT NULL COMMENT "Folder the grantee receives VIEWER on", `wait_days` int NOT NULL COMMENT "Days a request waits for rejection before access is granted", `status` enum('EMERGENCY_ACCESS_STATUS_UNSPECIFIED','EMERGENCY_ACCESS_STATUS_IDLE','EMERGENCY_ACCESS_STATUS_REQUESTED','EMERGENCY_ACCESS_STATUS_GRANTED') NOT NULL DEFAULT 'EMERGENCY_ACCESS_STATUS_IDLE' COMMENT "Idle, requested (waiting period running) or granted", `requested_at` timestamp NULL COMMENT "When the pending request was made", `granted_at` timestamp NULL COMMENT "When access was granted", PRIMARY KEY (`id`), UNIQUE INDEX `warden_emergency_access_pair` (`tenant_id`, `grantor_id`, `grantee_id`), INDEX `warden_emergency_access_grantee` (`tenant_id`, `grantee_id`), INDEX `warden_emergency_access_due` (`status`, `requested_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` ADD COLUMN `password_encoding` enum('PASSWORD_ENCODING_TEXT','PASSWORD_ENCODING_BASE64') NOT NULL DEFAULT 'PASSWORD_ENCODING_TEXT' COMMENT "Whether the current password is text or base64-encoded binary";
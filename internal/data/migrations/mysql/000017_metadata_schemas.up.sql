@@ -0,0 +1,2 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` ADD COLUMN `metadata_schema` json NULL COMMENT "JSON Schema the metadata of secrets in this folder must satisfy";
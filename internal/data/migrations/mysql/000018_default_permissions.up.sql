@@ -0,0 +1,2 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` ADD COLUMN `default_permissions` json NULL COMMENT "Permissions granted on every secret created in this folder";
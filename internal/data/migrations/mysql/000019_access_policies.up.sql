@@ -0,0 +1,4 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` ADD COLUMN `access_policy` json NULL COMMENT "Network and time restrictions on access to this folder and everything in it";
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` ADD COLUMN `access_policy` json NULL COMMENT "Network and time restrictions on access to this secret";
@@ -0,0 +1,2 @@
+-- modify "warden_tenant_settings" table
+ALTER TABLE `warden_tenant_settings` ADD COLUMN `geo_allowed_countries` json NULL COMMENT "ISO 3166-1 alpha-2 countries passwords may be read from (empty = anywhere)";
@@ -0,0 +1,4 @@
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` DROP COLUMN `canary`;
+-- modify "warden_security_alerts" table
+ALTER TABLE `warden_security_alerts` MODIFY COLUMN `kind` enum('ALERT_KIND_UNSPECIFIED','ALERT_KIND_EXCESSIVE_READS','ALERT_KIND_NEW_PEER_ADDRESS','ALERT_KIND_NEW_GEO_LOCATION') NOT NULL COMMENT "Detected anomaly";
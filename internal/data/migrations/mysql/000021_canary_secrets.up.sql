@@ -0,0 +1,4 @@
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` ADD COLUMN `canary` bool NOT NULL DEFAULT false COMMENT "Decoy secret whose password reads raise a security alert";
+-- modify "warden_security_alerts" table
+ALTER TABLE `warden_security_alerts` MODIFY COLUMN `kind` enum('ALERT_KIND_UNSPECIFIED','ALERT_KIND_EXCESSIVE_READS','ALERT_KIND_NEW_PEER_ADDRESS','ALERT_KIND_NEW_GEO_LOCATION','ALERT_KIND_CANARY_READ') NOT NULL COMMENT "Detected anomaly";
@@ -0,0 +1,2 @@
+-- drop "warden_secret_usage" table
+DROP TABLE `warden_secret_usage`;
@@ -0,0 +1,2 @@
+-- create "warden_secret_usage" table
+CREATE TABLE `warden_secret_usage` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `secret_id` varchar(255) NOT NULL COMMENT "Secret the counts belong to", `day` timestamp NOT NULL COMMENT "Midnight UTC of the counted day", `reads` bigint NOT NULL DEFAULT 0 COMMENT "Password reads on the day", `writes` bigint NOT NULL DEFAULT 0 COMMENT "Updates, password changes and version restores on the day", PRIMARY KEY (`id`), UNIQUE INDEX `warden_secret_usage_secret_day` (`tenant_id`, `secret_id`, `day`), INDEX `warden_secret_usage_day` (`day`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- modify "warden_tenant_settings" table
+ALTER TABLE `warden_tenant_settings` DROP COLUMN `version_max_count`, DROP COLUMN `version_delete_after_days`;
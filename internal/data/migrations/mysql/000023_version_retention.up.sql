@@ -0,0 +1,2 @@
+-- modify "warden_tenant_settings" table
+ALTER TABLE `warden_tenant_settings` ADD COLUMN `version_max_count` int NOT NULL DEFAULT 0 COMMENT "Password versions Vault keeps per secret (0 = mount default)", ADD COLUMN `version_delete_after_days` int NOT NULL DEFAULT 0 COMMENT "Days after which Vault deletes password versions (0 = never)";
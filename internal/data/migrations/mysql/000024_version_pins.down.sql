@@ -0,0 +1,2 @@
+-- drop "warden_version_pins" table
+DROP TABLE `warden_version_pins`;
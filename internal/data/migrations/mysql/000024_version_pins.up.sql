@@ -0,0 +1,2 @@
+-- create "warden_version_pins" table
+CREATE TABLE `warden_version_pins` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `secret_id` varchar(255) NOT NULL COMMENT "Pinned secret", `version_number` int NOT NULL COMMENT "Version the consumer is pinned to", `token_hash` varchar(255) NOT NULL COMMENT "SHA-256 of the pin token, hex encoded", `consumer` varchar(255) NOT NULL COMMENT "User ID of the consumer that created the pin", `last_used_time` timestamp NULL COMMENT "Last time the pin token was used to read the password", PRIMARY KEY (`id`), UNIQUE INDEX `warden_version_pins_token` (`token_hash`), INDEX `warden_version_pins_tenant_secret` (`tenant_id`, `secret_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,6 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` ADD COLUMN `protected` bool NOT NULL DEFAULT false COMMENT "Whether deleting this folder or anything in it permanently needs the approval of a second owner";
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` ADD COLUMN `protected` bool NOT NULL DEFAULT false COMMENT "Whether permanent deletion needs the approval of a second owner";
+-- create "warden_deletion_requests" table
+CREATE TABLE `warden_deletion_requests` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `resource_type` enum('DELETION_RESOURCE_TYPE_SECRET','DELETION_RESOURCE_TYPE_FOLDER') NOT NULL COMMENT "Kind of resource to delete", `resource_id` varchar(36) NOT NULL COMMENT "Secret or folder to delete", `resource_name` varchar(4096) NULL COMMENT "Secret name or folder path when the request was made", `force` bool NOT NULL DEFAULT false COMMENT "Folder deletions: delete the folder with everything in it", `reason` varchar(1024) NULL COMMENT "Why the resource should be deleted", `status` enum('DELETION_REQUEST_STATUS_PENDING','DELETION_REQUEST_STATUS_APPROVED','DELETION_REQUEST_STATUS_REJECTED','DELETION_REQUEST_STATUS_WITHDRAWN','DELETION_REQUEST_STATUS_EXPIRED') NOT NULL DEFAULT 'DELETION_REQUEST_STATUS_PENDING' COMMENT "Pending, or how the request was decided", `requested_by` varchar(36) NOT NULL COMMENT "Owner who requested the deletion", `decided_by` varchar(36) NULL COMMENT "Owner who approved or rejected the request", `decided_at` timestamp NULL COMMENT "When the request was approved, rejected or withdrawn", `expires_at` timestamp NOT NULL COMMENT "When a pending request can no longer be approved", PRIMARY KEY (`id`), INDEX `warden_deletion_requests_resource` (`tenant_id`, `resource_id`, `status`), INDEX `warden_deletion_requests_tenant_status` (`tenant_id`, `status`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
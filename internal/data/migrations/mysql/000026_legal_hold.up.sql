@@ -0,0 +1,2 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` ADD COLUMN `legal_hold` bool NOT NULL DEFAULT false COMMENT "Whether this folder and everything in it is under legal hold and cannot be permanently deleted or destroyed", ADD COLUMN `legal_hold_reason` varchar(1024) NULL COMMENT "Why the legal hold was placed";
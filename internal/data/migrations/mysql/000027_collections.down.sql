@@ -0,0 +1,6 @@
+-- modify "warden_permissions" table
+ALTER TABLE `warden_permissions` MODIFY COLUMN `resource_type` enum('RESOURCE_TYPE_UNSPECIFIED','RESOURCE_TYPE_FOLDER','RESOURCE_TYPE_SECRET') NOT NULL COMMENT "Type of resource (folder or secret)", MODIFY COLUMN `resource_id` varchar(36) NOT NULL COMMENT "ID of the folder or secret";
+-- drop "warden_collection_secrets" table
+DROP TABLE `warden_collection_secrets`;
+-- drop "warden_collections" table
+DROP TABLE `warden_collections`;
@@ -0,0 +1,6 @@
+-- modify "warden_permissions" table
+ALTER TABLE `warden_permissions` MODIFY COLUMN `resource_type` enum('RESOURCE_TYPE_UNSPECIFIED','RESOURCE_TYPE_FOLDER','RESOURCE_TYPE_SECRET','RESOURCE_TYPE_COLLECTION') NOT NULL COMMENT "Type of resource (folder, secret or collection)", MODIFY COLUMN `resource_id` varchar(36) NOT NULL COMMENT "ID of the folder, secret or collection";
+-- create "warden_collections" table
+CREATE TABLE `warden_collections` (`id` varchar(255) NOT NULL COMMENT "UUID primary key", `create_by` int unsigned NULL COMMENT "创建者ID", `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `name` varchar(255) NOT NULL COMMENT "Collection name", `description` varchar(1024) NULL COMMENT "Optional description", `external_id` varchar(255) NULL COMMENT "ID of the collection in the system it was imported from", PRIMARY KEY (`id`), UNIQUE INDEX `collection_tenant_id_name` (`tenant_id`, `name`), INDEX `collection_tenant_id` (`tenant_id`), INDEX `collection_tenant_id_external_id` (`tenant_id`, `external_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "warden_collection_secrets" table
+CREATE TABLE `warden_collection_secrets` (`collection_id` varchar(255) NOT NULL, `secret_id` varchar(255) NOT NULL, PRIMARY KEY (`collection_id`, `secret_id`), CONSTRAINT `warden_collection_secrets_collection_id` FOREIGN KEY (`collection_id`) REFERENCES `warden_collections` (`id`) ON DELETE CASCADE, CONSTRAINT `warden_collection_secrets_secret_id` FOREIGN KEY (`secret_id`) REFERENCES `warden_secrets` (`id`) ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
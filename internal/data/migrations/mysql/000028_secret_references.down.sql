@@ -0,0 +1,2 @@
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` DROP COLUMN `referenced_secret_ids`;
@@ -0,0 +1,2 @@
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` ADD COLUMN `referenced_secret_ids` json NULL COMMENT "Secrets referenced from the metadata, kept in step with it";
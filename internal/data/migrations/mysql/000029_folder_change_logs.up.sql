@@ -0,0 +1,2 @@
+-- create "warden_folder_change_logs" table
+CREATE TABLE `warden_folder_change_logs` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NULL DEFAULT 0 COMMENT "租户ID", `folder_id` varchar(255) NOT NULL COMMENT "Folder that changed", `action` enum('FOLDER_CHANGE_ACTION_CREATED','FOLDER_CHANGE_ACTION_RENAMED','FOLDER_CHANGE_ACTION_MOVED','FOLDER_CHANGE_ACTION_DELETED') NOT NULL COMMENT "Kind of change", `old_path` varchar(255) NULL COMMENT "Path before the change", `new_path` varchar(255) NULL COMMENT "Path after the change", `old_parent_id` varchar(255) NULL COMMENT "Parent folder before the change", `new_parent_id` varchar(255) NULL COMMENT "Parent folder after the change", `actor_id` varchar(255) NULL COMMENT "User who made the change", `details` json NULL COMMENT "Extra details of the change", PRIMARY KEY (`id`), INDEX `warden_folder_change_logs_tenant_folder` (`tenant_id`, `folder_id`), INDEX `warden_folder_change_logs_tenant_time` (`tenant_id`, `create_time`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
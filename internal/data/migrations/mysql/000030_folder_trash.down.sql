@@ -0,0 +1,6 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` DROP COLUMN `status`, DROP COLUMN `deleted_time`, DROP COLUMN `trashed_with_folder_id`, DROP COLUMN `original_parent_id`, DROP COLUMN `original_path`;
+-- modify "warden_folder_change_logs" table
+ALTER TABLE `warden_folder_change_logs` MODIFY COLUMN `action` enum('FOLDER_CHANGE_ACTION_CREATED','FOLDER_CHANGE_ACTION_RENAMED','FOLDER_CHANGE_ACTION_MOVED','FOLDER_CHANGE_ACTION_DELETED') NOT NULL COMMENT "Kind of change";
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` DROP COLUMN `trashed_with_folder_id`, DROP COLUMN `status_before_trash`;
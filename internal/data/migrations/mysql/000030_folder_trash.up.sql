@@ -0,0 +1,6 @@
+-- modify "warden_folders" table
+ALTER TABLE `warden_folders` ADD COLUMN `status` enum('FOLDER_STATUS_ACTIVE','FOLDER_STATUS_DELETED') NOT NULL DEFAULT 'FOLDER_STATUS_ACTIVE' COMMENT "Folder status; deleted folders are in the trash until restored or purged", ADD COLUMN `deleted_time` timestamp NULL COMMENT "When the folder was moved to the trash", ADD COLUMN `trashed_with_folder_id` varchar(255) NULL COMMENT "The folder whose deletion moved this one to the trash (itself for the deleted folder)", ADD COLUMN `original_parent_id` varchar(255) NULL COMMENT "Parent of the deleted folder before it was moved to the trash", ADD COLUMN `original_path` varchar(4096) NULL COMMENT "Path of the deleted folder before it was moved to the trash", ADD INDEX `folder_trashed_with_folder_id` (`trashed_with_folder_id`), ADD INDEX `folder_status_deleted_time` (`status`, `deleted_time`);
+-- modify "warden_folder_change_logs" table
+ALTER TABLE `warden_folder_change_logs` MODIFY COLUMN `action` enum('FOLDER_CHANGE_ACTION_CREATED','FOLDER_CHANGE_ACTION_RENAMED','FOLDER_CHANGE_ACTION_MOVED','FOLDER_CHANGE_ACTION_DELETED','FOLDER_CHANGE_ACTION_TRASHED','FOLDER_CHANGE_ACTION_RESTORED') NOT NULL COMMENT "Kind of change";
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` ADD COLUMN `trashed_with_folder_id` varchar(255) NULL COMMENT "The deleted folder that moved this secret to the trash, restored with it", ADD COLUMN `status_before_trash` enum('SECRET_STATUS_ACTIVE','SECRET_STATUS_ARCHIVED') NULL COMMENT "Status to restore when the folder that moved this secret to the trash is restored", ADD INDEX `secret_trashed_with_folder_id` (`trashed_with_folder_id`);
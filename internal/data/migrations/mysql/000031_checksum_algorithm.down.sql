@@ -0,0 +1,2 @@
+-- modify "warden_secret_versions" table
+ALTER TABLE `warden_secret_versions` MODIFY COLUMN `checksum` varchar(64) NOT NULL COMMENT "SHA-256 checksum of the password", DROP COLUMN `checksum_algorithm`;
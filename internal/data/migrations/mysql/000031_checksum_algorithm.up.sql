@@ -0,0 +1,2 @@
+-- modify "warden_secret_versions" table
+ALTER TABLE `warden_secret_versions` MODIFY COLUMN `checksum` varchar(64) NOT NULL COMMENT "Checksum of the password, made with checksum_algorithm", ADD COLUMN `checksum_algorithm` varchar(32) NOT NULL DEFAULT 'sha256' COMMENT "Algorithm of the checksum: sha256, or hmac-sha256:<key id>", ADD INDEX `secretversion_checksum_algorithm` (`checksum_algorithm`);
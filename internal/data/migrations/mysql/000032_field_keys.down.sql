@@ -0,0 +1,4 @@
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` MODIFY COLUMN `username` varchar(255) NULL COMMENT "Associated username", MODIFY COLUMN `host_url` varchar(2048) NULL COMMENT "Host/URL associated with the secret", DROP COLUMN `field_key_id`;
+-- drop "warden_field_keys" table
+DROP TABLE `warden_field_keys`;
@@ -0,0 +1,4 @@
+-- modify "warden_secrets" table
+ALTER TABLE `warden_secrets` MODIFY COLUMN `username` varchar(512) NULL COMMENT "Associated username", MODIFY COLUMN `host_url` varchar(4096) NULL COMMENT "Host/URL associated with the secret", ADD COLUMN `field_key_id` int unsigned NOT NULL DEFAULT 0 COMMENT "Field key encrypting username, host_url and metadata (0 when stored in plaintext)", ADD INDEX `secret_field_key_id` (`field_key_id`);
+-- create "warden_field_keys" table
+CREATE TABLE `warden_field_keys` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `transit_key` varchar(255) NOT NULL COMMENT "Name of the Vault Transit key wrapping the data key", `wrapped_key` varchar(255) NOT NULL COMMENT "Data key encrypted by the Transit key", PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
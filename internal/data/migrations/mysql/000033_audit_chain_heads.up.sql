@@ -0,0 +1,2 @@
+-- create "warden_audit_chain_heads" table
+CREATE TABLE `warden_audit_chain_heads` (`id` int unsigned NOT NULL COMMENT "id" AUTO_INCREMENT, `create_time` timestamp NULL COMMENT "创建时间", `update_time` timestamp NULL COMMENT "更新时间", `delete_time` timestamp NULL COMMENT "删除时间", `tenant_id` int unsigned NOT NULL COMMENT "Tenant of the chain (0 = entries without a tenant)", `seq` bigint NOT NULL DEFAULT 0 COMMENT "Chain sequence of the last entry", `hash` varchar(255) NULL COMMENT "Chain hash of the last entry", PRIMARY KEY (`id`), UNIQUE INDEX `tenant_id` (`tenant_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,16 @@
+-- modify "warden_permissions" table
+ALTER TABLE "warden_permissions" DROP CONSTRAINT "warden_permissions_warden_folders_permissions", DROP CONSTRAINT "warden_permissions_warden_secrets_permissions";
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" DROP CONSTRAINT "warden_secrets_warden_folders_secrets";
+-- modify "warden_secret_versions" table
+ALTER TABLE "warden_secret_versions" DROP CONSTRAINT "warden_secret_versions_warden_secrets_versions";
+-- drop "warden_audit_logs" table
+DROP TABLE "warden_audit_logs";
+-- drop "warden_folders" table
+DROP TABLE "warden_folders";
+-- drop "warden_permissions" table
+DROP TABLE "warden_permissions";
+-- drop "warden_secrets" table
+DROP TABLE "warden_secrets";
+-- drop "warden_secret_versions" table
+DROP TABLE "warden_secret_versions";
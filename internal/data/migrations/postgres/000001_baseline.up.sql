@@ -0,0 +1,210 @@
+-- create "warden_audit_logs" table
+CREATE TABLE "warden_audit_logs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "audit_id" character varying NOT NULL, "request_id" character varying NULL, "operation" character varying NOT NULL, "service_name" character varying NOT NULL DEFAULT 'warden-service', "client_id" character varying NULL, "client_common_name" character varying NULL, "client_organization" character varying NULL, "client_serial_number" character varying NULL, "is_authenticated" boolean NOT NULL DEFAULT false, "success" boolean NOT NULL DEFAULT true, "error_code" integer NULL, "error_message" character varying NULL, "latency_ms" bigint NOT NULL DEFAULT 0, "peer_address" character varying NULL, "geo_location" jsonb NULL, "log_hash" character varying NULL, "signature" bytea NULL, "metadata" jsonb NULL, PRIMARY KEY ("id"));
+-- create index "warden_audit_logs_audit_id_key" to table: "warden_audit_logs"
+CREATE UNIQUE INDEX "warden_audit_logs_audit_id_key" ON "warden_audit_logs" ("audit_id");
+-- create index "warden_auditlog_tenant_id" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_tenant_id" ON "warden_audit_logs" ("tenant_id");
+-- create index "warden_auditlog_tenant_client" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_tenant_client" ON "warden_audit_logs" ("tenant_id", "client_id");
+-- create index "warden_auditlog_tenant_operation" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_tenant_operation" ON "warden_audit_logs" ("tenant_id", "operation");
+-- create index "warden_auditlog_tenant_success" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_tenant_success" ON "warden_audit_logs" ("tenant_id", "success");
+-- create index "warden_auditlog_operation" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_operation" ON "warden_audit_logs" ("operation");
+-- create index "warden_auditlog_client_id" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_client_id" ON "warden_audit_logs" ("client_id");
+-- create index "warden_auditlog_success" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_success" ON "warden_audit_logs" ("success");
+-- create index "warden_auditlog_peer_address" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_peer_address" ON "warden_audit_logs" ("peer_address");
+-- set comment to column: "id" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."id" IS 'id';
+-- set comment to column: "create_time" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."tenant_id" IS '租户ID';
+-- set comment to column: "audit_id" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."audit_id" IS 'Unique audit log identifier (UUID)';
+-- set comment to column: "request_id" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."request_id" IS 'Request ID from metadata';
+-- set comment to column: "operation" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."operation" IS 'gRPC operation path';
+-- set comment to column: "service_name" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."service_name" IS 'Service name';
+-- set comment to column: "client_id" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."client_id" IS 'Client ID from certificate CN';
+-- set comment to column: "client_common_name" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."client_common_name" IS 'Client certificate common name';
+-- set comment to column: "client_organization" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."client_organization" IS 'Client certificate organization';
+-- set comment to column: "client_serial_number" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."client_serial_number" IS 'Client certificate serial number';
+-- set comment to column: "is_authenticated" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."is_authenticated" IS 'Whether the client was authenticated via mTLS';
+-- set comment to column: "success" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."success" IS 'Whether the operation succeeded';
+-- set comment to column: "error_code" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."error_code" IS 'Error code if failed';
+-- set comment to column: "error_message" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."error_message" IS 'Error message if failed';
+-- set comment to column: "latency_ms" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."latency_ms" IS 'Operation latency in milliseconds';
+-- set comment to column: "peer_address" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."peer_address" IS 'Client IP address';
+-- set comment to column: "geo_location" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."geo_location" IS 'Geographic location info';
+-- set comment to column: "log_hash" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."log_hash" IS 'SHA-256 hash of the log content';
+-- set comment to column: "signature" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."signature" IS 'ECDSA signature for integrity verification';
+-- set comment to column: "metadata" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."metadata" IS 'Additional metadata';
+-- create "warden_folders" table
+CREATE TABLE "warden_folders" ("id" character varying NOT NULL, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "name" character varying NOT NULL, "path" character varying NOT NULL, "description" character varying NULL, "depth" integer NOT NULL DEFAULT 0, "parent_id" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "warden_folders_warden_folders_children" FOREIGN KEY ("parent_id") REFERENCES "warden_folders" ("id") ON DELETE SET NULL);
+-- create index "folder_tenant_id_parent_id_name" to table: "warden_folders"
+CREATE UNIQUE INDEX "folder_tenant_id_parent_id_name" ON "warden_folders" ("tenant_id", "parent_id", "name");
+-- create index "folder_tenant_id_path" to table: "warden_folders"
+CREATE UNIQUE INDEX "folder_tenant_id_path" ON "warden_folders" ("tenant_id", "path");
+-- create index "folder_tenant_id" to table: "warden_folders"
+CREATE INDEX "folder_tenant_id" ON "warden_folders" ("tenant_id");
+-- create index "folder_parent_id" to table: "warden_folders"
+CREATE INDEX "folder_parent_id" ON "warden_folders" ("parent_id");
+-- create index "folder_path" to table: "warden_folders"
+CREATE INDEX "folder_path" ON "warden_folders" ("path");
+-- set comment to column: "id" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."id" IS 'UUID primary key';
+-- set comment to column: "create_by" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."create_by" IS '创建者ID';
+-- set comment to column: "create_time" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."tenant_id" IS '租户ID';
+-- set comment to column: "name" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."name" IS 'Folder name';
+-- set comment to column: "path" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."path" IS 'Materialized path (e.g., /root/sub/current)';
+-- set comment to column: "description" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."description" IS 'Optional description';
+-- set comment to column: "depth" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."depth" IS 'Nesting depth level (0 for root folders)';
+-- set comment to column: "parent_id" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."parent_id" IS 'Parent folder ID (null for root-level folders)';
+-- create "warden_secrets" table
+CREATE TABLE "warden_secrets" ("id" character varying NOT NULL, "create_by" bigint NULL, "update_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "name" character varying NOT NULL, "username" character varying NULL, "host_url" character varying NULL, "vault_path" character varying NOT NULL, "current_version" integer NOT NULL DEFAULT 1, "metadata" jsonb NULL, "description" character varying NULL, "status" character varying NOT NULL DEFAULT 'SECRET_STATUS_ACTIVE', "has_totp" boolean NOT NULL DEFAULT false, "folder_id" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "warden_secrets_warden_folders_secrets" FOREIGN KEY ("folder_id") REFERENCES "warden_folders" ("id") ON DELETE SET NULL);
+-- create index "secret_tenant_id_folder_id_name" to table: "warden_secrets"
+CREATE UNIQUE INDEX "secret_tenant_id_folder_id_name" ON "warden_secrets" ("tenant_id", "folder_id", "name");
+-- create index "secret_tenant_id" to table: "warden_secrets"
+CREATE INDEX "secret_tenant_id" ON "warden_secrets" ("tenant_id");
+-- create index "secret_folder_id" to table: "warden_secrets"
+CREATE INDEX "secret_folder_id" ON "warden_secrets" ("folder_id");
+-- create index "secret_tenant_id_name" to table: "warden_secrets"
+CREATE INDEX "secret_tenant_id_name" ON "warden_secrets" ("tenant_id", "name");
+-- create index "secret_tenant_id_username" to table: "warden_secrets"
+CREATE INDEX "secret_tenant_id_username" ON "warden_secrets" ("tenant_id", "username");
+-- create index "secret_status" to table: "warden_secrets"
+CREATE INDEX "secret_status" ON "warden_secrets" ("status");
+-- create index "secret_vault_path" to table: "warden_secrets"
+CREATE UNIQUE INDEX "secret_vault_path" ON "warden_secrets" ("vault_path");
+-- set comment to column: "id" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."id" IS 'UUID primary key';
+-- set comment to column: "create_by" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."create_by" IS '创建者ID';
+-- set comment to column: "update_by" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."update_by" IS '更新者ID';
+-- set comment to column: "create_time" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."tenant_id" IS '租户ID';
+-- set comment to column: "name" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."name" IS 'Secret name';
+-- set comment to column: "username" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."username" IS 'Associated username';
+-- set comment to column: "host_url" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."host_url" IS 'Host/URL associated with the secret';
+-- set comment to column: "vault_path" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."vault_path" IS 'Reference path to HashiCorp Vault';
+-- set comment to column: "current_version" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."current_version" IS 'Current active version number';
+-- set comment to column: "metadata" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."metadata" IS 'Custom fields, notes, tags (JSON)';
+-- set comment to column: "description" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."description" IS 'Description';
+-- set comment to column: "status" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."status" IS 'Secret status';
+-- set comment to column: "has_totp" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."has_totp" IS 'Whether this secret has a TOTP authenticator configured';
+-- set comment to column: "folder_id" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."folder_id" IS 'Parent folder ID (null for root-level secrets)';
+-- create "warden_permissions" table
+CREATE TABLE "warden_permissions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "resource_type" character varying NOT NULL, "resource_id" character varying NOT NULL, "relation" character varying NOT NULL, "subject_type" character varying NOT NULL, "subject_id" character varying NOT NULL, "granted_by" bigint NULL, "expires_at" timestamptz NULL, "folder_permissions" character varying NULL, "secret_permissions" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "warden_permissions_warden_folders_permissions" FOREIGN KEY ("folder_permissions") REFERENCES "warden_folders" ("id") ON DELETE SET NULL, CONSTRAINT "warden_permissions_warden_secrets_permissions" FOREIGN KEY ("secret_permissions") REFERENCES "warden_secrets" ("id") ON DELETE SET NULL);
+-- create index "permission_tenant_id_resource__6b7412ca300e43b4f618f7d81d9bd1dd" to table: "warden_permissions"
+CREATE UNIQUE INDEX "permission_tenant_id_resource__6b7412ca300e43b4f618f7d81d9bd1dd" ON "warden_permissions" ("tenant_id", "resource_type", "resource_id", "relation", "subject_type", "subject_id");
+-- create index "permission_tenant_id_resource_type_resource_id" to table: "warden_permissions"
+CREATE INDEX "permission_tenant_id_resource_type_resource_id" ON "warden_permissions" ("tenant_id", "resource_type", "resource_id");
+-- create index "permission_subject_type_subject_id" to table: "warden_permissions"
+CREATE INDEX "permission_subject_type_subject_id" ON "warden_permissions" ("subject_type", "subject_id");
+-- create index "permission_tenant_id" to table: "warden_permissions"
+CREATE INDEX "permission_tenant_id" ON "warden_permissions" ("tenant_id");
+-- create index "permission_expires_at" to table: "warden_permissions"
+CREATE INDEX "permission_expires_at" ON "warden_permissions" ("expires_at");
+-- set comment to column: "create_time" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."tenant_id" IS '租户ID';
+-- set comment to column: "resource_type" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."resource_type" IS 'Type of resource (folder or secret)';
+-- set comment to column: "resource_id" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."resource_id" IS 'ID of the folder or secret';
+-- set comment to column: "relation" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."relation" IS 'Permission level (owner, editor, viewer, sharer)';
+-- set comment to column: "subject_type" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."subject_type" IS 'Type of subject (user, role, or tenant)';
+-- set comment to column: "subject_id" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."subject_id" IS 'ID of the user, role, or tenant';
+-- set comment to column: "granted_by" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."granted_by" IS 'User ID who granted this permission';
+-- set comment to column: "expires_at" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."expires_at" IS 'Optional expiration time for temporary access';
+-- create "warden_secret_versions" table
+CREATE TABLE "warden_secret_versions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "version_number" integer NOT NULL, "vault_path" character varying NOT NULL, "comment" character varying NULL, "checksum" character varying NOT NULL, "secret_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "warden_secret_versions_warden_secrets_versions" FOREIGN KEY ("secret_id") REFERENCES "warden_secrets" ("id") ON DELETE NO ACTION);
+-- create index "secretversion_secret_id_version_number" to table: "warden_secret_versions"
+CREATE UNIQUE INDEX "secretversion_secret_id_version_number" ON "warden_secret_versions" ("secret_id", "version_number");
+-- create index "secretversion_secret_id" to table: "warden_secret_versions"
+CREATE INDEX "secretversion_secret_id" ON "warden_secret_versions" ("secret_id");
+-- create index "secretversion_vault_path" to table: "warden_secret_versions"
+CREATE INDEX "secretversion_vault_path" ON "warden_secret_versions" ("vault_path");
+-- set comment to column: "create_by" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."create_by" IS '创建者ID';
+-- set comment to column: "create_time" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."delete_time" IS '删除时间';
+-- set comment to column: "version_number" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."version_number" IS 'Version number (1, 2, 3...)';
+-- set comment to column: "vault_path" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."vault_path" IS 'Vault path for this version';
+-- set comment to column: "comment" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."comment" IS 'Version comment describing the change';
+-- set comment to column: "checksum" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."checksum" IS 'SHA-256 checksum of the password';
+-- set comment to column: "secret_id" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."secret_id" IS 'Parent secret ID';
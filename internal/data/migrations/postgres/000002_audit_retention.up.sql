@@ -0,0 +1,18 @@
+-- create "warden_tenant_settings" table
+CREATE TABLE "warden_tenant_settings" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "update_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "audit_retention_days" integer NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- create index "warden_tenant_settings_tenant_id" to table: "warden_tenant_settings"
+CREATE UNIQUE INDEX "warden_tenant_settings_tenant_id" ON "warden_tenant_settings" ("tenant_id");
+-- set comment to column: "id" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."id" IS 'id';
+-- set comment to column: "update_by" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."update_by" IS '更新者ID';
+-- set comment to column: "create_time" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."tenant_id" IS '租户ID';
+-- set comment to column: "audit_retention_days" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."audit_retention_days" IS 'Audit log retention in days (0 = deployment default)';
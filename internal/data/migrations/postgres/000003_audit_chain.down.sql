@@ -0,0 +1,2 @@
+-- modify "warden_audit_logs" table
+ALTER TABLE "warden_audit_logs" DROP COLUMN "chain_seq", DROP COLUMN "prev_hash", DROP COLUMN "chain_hash";
@@ -0,0 +1,10 @@
+-- modify "warden_audit_logs" table
+ALTER TABLE "warden_audit_logs" ADD COLUMN "chain_seq" bigint NOT NULL DEFAULT 0, ADD COLUMN "prev_hash" character varying NULL, ADD COLUMN "chain_hash" character varying NULL;
+-- create index "warden_auditlog_tenant_chain_seq" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_tenant_chain_seq" ON "warden_audit_logs" ("tenant_id", "chain_seq");
+-- set comment to column: "chain_seq" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."chain_seq" IS 'Per-tenant position in the hash chain (0 = not chained)';
+-- set comment to column: "prev_hash" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."prev_hash" IS 'Chain hash of the previous entry of the same tenant';
+-- set comment to column: "chain_hash" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."chain_hash" IS 'SHA-256 over the previous chain hash and this entry''s content';
@@ -0,0 +1,2 @@
+-- modify "warden_audit_logs" table
+ALTER TABLE "warden_audit_logs" DROP COLUMN "event_type", DROP COLUMN "resource_type", DROP COLUMN "resource_id";
@@ -0,0 +1,12 @@
+-- modify "warden_audit_logs" table
+ALTER TABLE "warden_audit_logs" ADD COLUMN "event_type" character varying NULL, ADD COLUMN "resource_type" character varying NULL, ADD COLUMN "resource_id" character varying NULL;
+-- create index "warden_auditlog_tenant_event_type" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_tenant_event_type" ON "warden_audit_logs" ("tenant_id", "event_type");
+-- create index "warden_auditlog_tenant_resource_event" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_tenant_resource_event" ON "warden_audit_logs" ("tenant_id", "resource_id", "event_type");
+-- set comment to column: "event_type" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."event_type" IS 'Semantic domain event (e.g. secret.password_read)';
+-- set comment to column: "resource_type" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."resource_type" IS 'Type of the resource the event refers to';
+-- set comment to column: "resource_id" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."resource_id" IS 'ID of the resource the event refers to';
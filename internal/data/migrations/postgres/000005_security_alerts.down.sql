@@ -0,0 +1,4 @@
+-- modify "warden_audit_logs" table
+ALTER TABLE "warden_audit_logs" DROP COLUMN "actor_id";
+-- drop "warden_security_alerts" table
+DROP TABLE "warden_security_alerts";
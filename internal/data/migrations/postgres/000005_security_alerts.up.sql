@@ -0,0 +1,40 @@
+-- modify "warden_audit_logs" table
+ALTER TABLE "warden_audit_logs" ADD COLUMN "actor_id" character varying NULL;
+-- create index "warden_auditlog_tenant_actor_event" to table: "warden_audit_logs"
+CREATE INDEX "warden_auditlog_tenant_actor_event" ON "warden_audit_logs" ("tenant_id", "actor_id", "event_type");
+-- set comment to column: "actor_id" on table: "warden_audit_logs"
+COMMENT ON COLUMN "warden_audit_logs"."actor_id" IS 'User ID of the caller';
+-- create "warden_security_alerts" table
+CREATE TABLE "warden_security_alerts" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "kind" character varying NOT NULL, "severity" character varying NOT NULL DEFAULT 'ALERT_SEVERITY_MEDIUM', "user_id" character varying NULL, "resource_id" character varying NULL, "message" character varying NOT NULL, "details" jsonb NULL, "acknowledged" boolean NOT NULL DEFAULT false, "acknowledged_by" bigint NULL, "acknowledged_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "warden_security_alerts_tenant_ack" to table: "warden_security_alerts"
+CREATE INDEX "warden_security_alerts_tenant_ack" ON "warden_security_alerts" ("tenant_id", "acknowledged");
+-- create index "warden_security_alerts_tenant_user_kind" to table: "warden_security_alerts"
+CREATE INDEX "warden_security_alerts_tenant_user_kind" ON "warden_security_alerts" ("tenant_id", "user_id", "kind");
+-- set comment to column: "id" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."id" IS 'id';
+-- set comment to column: "create_time" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."tenant_id" IS '租户ID';
+-- set comment to column: "kind" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."kind" IS 'Detected anomaly';
+-- set comment to column: "severity" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."severity" IS 'Alert severity';
+-- set comment to column: "user_id" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."user_id" IS 'User whose activity triggered the alert';
+-- set comment to column: "resource_id" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."resource_id" IS 'Secret involved, if the alert concerns a single secret';
+-- set comment to column: "message" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."message" IS 'Human-readable description';
+-- set comment to column: "details" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."details" IS 'Detection details (counts, window, peer address, location)';
+-- set comment to column: "acknowledged" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."acknowledged" IS 'Whether an operator acknowledged the alert';
+-- set comment to column: "acknowledged_by" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."acknowledged_by" IS 'User ID who acknowledged the alert';
+-- set comment to column: "acknowledged_at" on table: "warden_security_alerts"
+COMMENT ON COLUMN "warden_security_alerts"."acknowledged_at" IS 'When the alert was acknowledged';
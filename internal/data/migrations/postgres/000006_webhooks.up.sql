@@ -0,0 +1,70 @@
+-- create "warden_webhooks" table
+CREATE TABLE "warden_webhooks" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_by" bigint NULL, "update_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "name" character varying NOT NULL, "url" character varying NOT NULL, "secret" character varying NULL, "event_types" jsonb NULL, "enabled" boolean NOT NULL DEFAULT true, "description" character varying NULL, PRIMARY KEY ("id"));
+-- create index "warden_webhooks_tenant_enabled" to table: "warden_webhooks"
+CREATE INDEX "warden_webhooks_tenant_enabled" ON "warden_webhooks" ("tenant_id", "enabled");
+-- create index "warden_webhooks_tenant_name" to table: "warden_webhooks"
+CREATE UNIQUE INDEX "warden_webhooks_tenant_name" ON "warden_webhooks" ("tenant_id", "name");
+-- set comment to column: "id" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."id" IS 'id';
+-- set comment to column: "create_by" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."create_by" IS '创建者ID';
+-- set comment to column: "update_by" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."update_by" IS '更新者ID';
+-- set comment to column: "create_time" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."tenant_id" IS '租户ID';
+-- set comment to column: "name" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."name" IS 'Display name';
+-- set comment to column: "url" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."url" IS 'Endpoint receiving the POST requests';
+-- set comment to column: "secret" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."secret" IS 'Shared secret used to HMAC-sign payloads';
+-- set comment to column: "event_types" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."event_types" IS 'Subscribed event types (e.g. permission.granted)';
+-- set comment to column: "enabled" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."enabled" IS 'Whether deliveries are sent';
+-- set comment to column: "description" on table: "warden_webhooks"
+COMMENT ON COLUMN "warden_webhooks"."description" IS 'Optional description';
+-- create "warden_webhook_deliveries" table
+CREATE TABLE "warden_webhook_deliveries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "webhook_id" bigint NOT NULL, "event_id" character varying NOT NULL, "event_type" character varying NOT NULL, "payload" text NOT NULL, "status" character varying NOT NULL DEFAULT 'DELIVERY_STATUS_PENDING', "attempts" integer NOT NULL DEFAULT 0, "response_code" integer NULL, "last_error" character varying NULL, "next_attempt_at" timestamptz NULL, "delivered_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "warden_webhook_deliveries_webhook_event" to table: "warden_webhook_deliveries"
+CREATE UNIQUE INDEX "warden_webhook_deliveries_webhook_event" ON "warden_webhook_deliveries" ("webhook_id", "event_id");
+-- create index "warden_webhook_deliveries_status_next" to table: "warden_webhook_deliveries"
+CREATE INDEX "warden_webhook_deliveries_status_next" ON "warden_webhook_deliveries" ("status", "next_attempt_at");
+-- create index "warden_webhook_deliveries_webhook_time" to table: "warden_webhook_deliveries"
+CREATE INDEX "warden_webhook_deliveries_webhook_time" ON "warden_webhook_deliveries" ("webhook_id", "create_time");
+-- set comment to column: "id" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."id" IS 'id';
+-- set comment to column: "create_time" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."tenant_id" IS '租户ID';
+-- set comment to column: "webhook_id" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."webhook_id" IS 'Target webhook';
+-- set comment to column: "event_id" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."event_id" IS 'Event identifier (also used to avoid duplicate deliveries)';
+-- set comment to column: "event_type" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."event_type" IS 'Event type';
+-- set comment to column: "payload" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."payload" IS 'JSON body sent to the endpoint';
+-- set comment to column: "status" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."status" IS 'Delivery state';
+-- set comment to column: "attempts" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."attempts" IS 'Number of delivery attempts made';
+-- set comment to column: "response_code" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."response_code" IS 'HTTP status of the last attempt';
+-- set comment to column: "last_error" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."last_error" IS 'Error of the last failed attempt';
+-- set comment to column: "next_attempt_at" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."next_attempt_at" IS 'When the next attempt is due (pending deliveries only)';
+-- set comment to column: "delivered_at" on table: "warden_webhook_deliveries"
+COMMENT ON COLUMN "warden_webhook_deliveries"."delivered_at" IS 'When the endpoint acknowledged the event';
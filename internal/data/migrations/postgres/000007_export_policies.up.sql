@@ -0,0 +1,6 @@
+-- modify "warden_tenant_settings" table
+ALTER TABLE "warden_tenant_settings" ADD COLUMN "export_excluded_tags" jsonb NULL, ADD COLUMN "export_excluded_folder_ids" jsonb NULL;
+-- set comment to column: "export_excluded_tags" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."export_excluded_tags" IS 'Secrets tagged with any of these tags are never exported';
+-- set comment to column: "export_excluded_folder_ids" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."export_excluded_folder_ids" IS 'Folders whose subtrees are never exported';
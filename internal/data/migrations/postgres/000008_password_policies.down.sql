@@ -0,0 +1,2 @@
+-- modify "warden_tenant_settings" table
+ALTER TABLE "warden_tenant_settings" DROP COLUMN "password_policy_enabled", DROP COLUMN "password_min_length", DROP COLUMN "password_require_lowercase", DROP COLUMN "password_require_uppercase", DROP COLUMN "password_require_digit", DROP COLUMN "password_require_symbol", DROP COLUMN "password_banned_words", DROP COLUMN "password_max_age_days", DROP COLUMN "password_history_size";
@@ -0,0 +1,20 @@
+-- modify "warden_tenant_settings" table
+ALTER TABLE "warden_tenant_settings" ADD COLUMN "password_policy_enabled" boolean NOT NULL DEFAULT false, ADD COLUMN "password_min_length" integer NOT NULL DEFAULT 0, ADD COLUMN "password_require_lowercase" boolean NOT NULL DEFAULT false, ADD COLUMN "password_require_uppercase" boolean NOT NULL DEFAULT false, ADD COLUMN "password_require_digit" boolean NOT NULL DEFAULT false, ADD COLUMN "password_require_symbol" boolean NOT NULL DEFAULT false, ADD COLUMN "password_banned_words" jsonb NULL, ADD COLUMN "password_max_age_days" integer NOT NULL DEFAULT 0, ADD COLUMN "password_history_size" integer NOT NULL DEFAULT 0;
+-- set comment to column: "password_policy_enabled" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."password_policy_enabled" IS 'Whether new passwords are checked against the policy';
+-- set comment to column: "password_min_length" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."password_min_length" IS 'Minimum password length (0 = no minimum)';
+-- set comment to column: "password_require_lowercase" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."password_require_lowercase" IS 'Passwords must contain a lowercase letter';
+-- set comment to column: "password_require_uppercase" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."password_require_uppercase" IS 'Passwords must contain an uppercase letter';
+-- set comment to column: "password_require_digit" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."password_require_digit" IS 'Passwords must contain a digit';
+-- set comment to column: "password_require_symbol" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."password_require_symbol" IS 'Passwords must contain a symbol';
+-- set comment to column: "password_banned_words" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."password_banned_words" IS 'Words passwords must not contain (case-insensitive)';
+-- set comment to column: "password_max_age_days" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."password_max_age_days" IS 'Days after which a password must be changed (0 = never)';
+-- set comment to column: "password_history_size" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."password_history_size" IS 'Number of previous passwords of a secret that may not be reused';
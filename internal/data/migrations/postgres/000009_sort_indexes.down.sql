@@ -0,0 +1,12 @@
+-- drop index "folder_tenant_id_parent_id_create_time" from table: "warden_folders"
+DROP INDEX "folder_tenant_id_parent_id_create_time";
+-- drop index "folder_tenant_id_parent_id_update_time" from table: "warden_folders"
+DROP INDEX "folder_tenant_id_parent_id_update_time";
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" DROP COLUMN "last_accessed_time";
+-- drop index "secret_tenant_id_folder_id_create_time" from table: "warden_secrets"
+DROP INDEX "secret_tenant_id_folder_id_create_time";
+-- drop index "secret_tenant_id_folder_id_update_time" from table: "warden_secrets"
+DROP INDEX "secret_tenant_id_folder_id_update_time";
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" DROP COLUMN "last_accessed_time";
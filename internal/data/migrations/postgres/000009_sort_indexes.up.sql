@@ -0,0 +1,20 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" ADD COLUMN "last_accessed_time" timestamptz NULL;
+-- create index "folder_tenant_id_parent_id_create_time" to table: "warden_folders"
+CREATE INDEX "folder_tenant_id_parent_id_create_time" ON "warden_folders" ("tenant_id", "parent_id", "create_time");
+-- create index "folder_tenant_id_parent_id_update_time" to table: "warden_folders"
+CREATE INDEX "folder_tenant_id_parent_id_update_time" ON "warden_folders" ("tenant_id", "parent_id", "update_time");
+-- create index "folder_tenant_id_parent_id_last_accessed_time" to table: "warden_folders"
+CREATE INDEX "folder_tenant_id_parent_id_last_accessed_time" ON "warden_folders" ("tenant_id", "parent_id", "last_accessed_time");
+-- set comment to column: "last_accessed_time" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."last_accessed_time" IS 'When a password of a secret in this folder was last read';
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" ADD COLUMN "last_accessed_time" timestamptz NULL;
+-- create index "secret_tenant_id_folder_id_create_time" to table: "warden_secrets"
+CREATE INDEX "secret_tenant_id_folder_id_create_time" ON "warden_secrets" ("tenant_id", "folder_id", "create_time");
+-- create index "secret_tenant_id_folder_id_update_time" to table: "warden_secrets"
+CREATE INDEX "secret_tenant_id_folder_id_update_time" ON "warden_secrets" ("tenant_id", "folder_id", "update_time");
+-- create index "secret_tenant_id_folder_id_last_accessed_time" to table: "warden_secrets"
+CREATE INDEX "secret_tenant_id_folder_id_last_accessed_time" ON "warden_secrets" ("tenant_id", "folder_id", "last_accessed_time");
+-- set comment to column: "last_accessed_time" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."last_accessed_time" IS 'When the password was last read';
@@ -0,0 +1,30 @@
+-- create "warden_pending_operations" table
+CREATE TABLE "warden_pending_operations" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "kind" character varying NOT NULL DEFAULT 'OPERATION_KIND_DESTROY_VAULT_DATA', "secret_id" character varying NOT NULL, "vault_path" character varying NOT NULL, "totp_path" character varying NULL, "attempts" integer NOT NULL DEFAULT 0, "last_error" character varying NULL, "next_attempt_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "warden_pending_operations_next" to table: "warden_pending_operations"
+CREATE INDEX "warden_pending_operations_next" ON "warden_pending_operations" ("next_attempt_at");
+-- create index "warden_pending_operations_vault_path" to table: "warden_pending_operations"
+CREATE INDEX "warden_pending_operations_vault_path" ON "warden_pending_operations" ("vault_path");
+-- set comment to column: "id" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."id" IS 'id';
+-- set comment to column: "create_time" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."tenant_id" IS '租户ID';
+-- set comment to column: "kind" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."kind" IS 'Operation to carry out';
+-- set comment to column: "secret_id" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."secret_id" IS 'Secret the Vault data belongs to';
+-- set comment to column: "vault_path" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."vault_path" IS 'Vault path of the password';
+-- set comment to column: "totp_path" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."totp_path" IS 'Vault path of the TOTP secret, if any';
+-- set comment to column: "attempts" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."attempts" IS 'Number of failed attempts';
+-- set comment to column: "last_error" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."last_error" IS 'Error of the last failed attempt';
+-- set comment to column: "next_attempt_at" on table: "warden_pending_operations"
+COMMENT ON COLUMN "warden_pending_operations"."next_attempt_at" IS 'When the worker may carry out the operation';
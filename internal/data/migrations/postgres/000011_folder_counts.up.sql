@@ -0,0 +1,6 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" ADD COLUMN "secret_count" integer NOT NULL DEFAULT 0, ADD COLUMN "subfolder_count" integer NOT NULL DEFAULT 0;
+-- set comment to column: "secret_count" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."secret_count" IS 'Number of non-deleted secrets directly in this folder (denormalized)';
+-- set comment to column: "subfolder_count" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."subfolder_count" IS 'Number of direct child folders (denormalized)';
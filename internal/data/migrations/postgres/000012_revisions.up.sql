@@ -0,0 +1,8 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" ADD COLUMN "revision" bigint NOT NULL DEFAULT 0;
+-- set comment to column: "revision" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."revision" IS 'Incremented on every change, for optimistic concurrency';
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" ADD COLUMN "revision" bigint NOT NULL DEFAULT 0;
+-- set comment to column: "revision" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."revision" IS 'Incremented on every change, for optimistic concurrency';
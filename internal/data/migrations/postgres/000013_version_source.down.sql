@@ -0,0 +1,2 @@
+-- modify "warden_secret_versions" table
+ALTER TABLE "warden_secret_versions" DROP COLUMN "source_version";
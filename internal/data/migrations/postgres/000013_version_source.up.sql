@@ -0,0 +1,4 @@
+-- modify "warden_secret_versions" table
+ALTER TABLE "warden_secret_versions" ADD COLUMN "source_version" integer NULL;
+-- set comment to column: "source_version" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."source_version" IS 'Version this one was restored from, if created by a restore';
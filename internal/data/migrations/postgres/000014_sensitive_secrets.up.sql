@@ -0,0 +1,4 @@
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" ADD COLUMN "sensitive" boolean NOT NULL DEFAULT false;
+-- set comment to column: "sensitive" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."sensitive" IS 'Whether password reads by non-owners raise secret.read webhook events';
@@ -0,0 +1,32 @@
+-- create "warden_emergency_access" table
+CREATE TABLE "warden_emergency_access" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "grantor_id" character varying NOT NULL, "grantee_id" character varying NOT NULL, "folder_id" character varying NOT NULL, "wait_days" integer NOT NULL, "status" character varying NOT NULL DEFAULT 'EMERGENCY_ACCESS_STATUS_IDLE', "requested_at" timestamptz NULL, "granted_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "warden_emergency_access_pair" to table: "warden_emergency_access"
+CREATE UNIQUE INDEX "warden_emergency_access_pair" ON "warden_emergency_access" ("tenant_id", "grantor_id", "grantee_id");
+-- create index "warden_emergency_access_grantee" to table: "warden_emergency_access"
+CREATE INDEX "warden_emergency_access_grantee" ON "warden_emergency_access" ("tenant_id", "grantee_id");
+-- create index "warden_emergency_access_due" to table: "warden_emergency_access"
+CREATE INDEX "warden_emergency_access_due" ON "warden_emergency_access" ("status", "requested_at");
+-- set comment to column: "id" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."id" IS 'id';
+-- set comment to column: "create_time" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."tenant_id" IS '租户ID';
+-- set comment to column: "grantor_id" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."grantor_id" IS 'User whose folder can be accessed';
+-- set comment to column: "grantee_id" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."grantee_id" IS 'Trusted user who can request access';
+-- set comment to column: "folder_id" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."folder_id" IS 'Folder the grantee receives VIEWER on';
+-- set comment to column: "wait_days" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."wait_days" IS 'Days a request waits for rejection before access is granted';
+-- set comment to column: "status" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."status" IS 'Idle, requested (waiting period running) or granted';
+-- set comment to column: "requested_at" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."requested_at" IS 'When the pending request was made';
+-- set comment to column: "granted_at" on table: "warden_emergency_access"
+COMMENT ON COLUMN "warden_emergency_access"."granted_at" IS 'When access was granted';
@@ -0,0 +1,4 @@
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" ADD COLUMN "password_encoding" character varying NOT NULL DEFAULT 'PASSWORD_ENCODING_TEXT';
+-- set comment to column: "password_encoding" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."password_encoding" IS 'Whether the current password is text or base64-encoded binary';
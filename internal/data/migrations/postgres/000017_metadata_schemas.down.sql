@@ -0,0 +1,2 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" DROP COLUMN "metadata_schema";
@@ -0,0 +1,4 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" ADD COLUMN "metadata_schema" jsonb NULL;
+-- set comment to column: "metadata_schema" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."metadata_schema" IS 'JSON Schema the metadata of secrets in this folder must satisfy';
@@ -0,0 +1,4 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" ADD COLUMN "default_permissions" jsonb NULL;
+-- set comment to column: "default_permissions" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."default_permissions" IS 'Permissions granted on every secret created in this folder';
@@ -0,0 +1,8 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" ADD COLUMN "access_policy" jsonb NULL;
+-- set comment to column: "access_policy" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."access_policy" IS 'Network and time restrictions on access to this folder and everything in it';
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" ADD COLUMN "access_policy" jsonb NULL;
+-- set comment to column: "access_policy" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."access_policy" IS 'Network and time restrictions on access to this secret';
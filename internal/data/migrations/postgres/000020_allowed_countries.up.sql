@@ -0,0 +1,4 @@
+-- modify "warden_tenant_settings" table
+ALTER TABLE "warden_tenant_settings" ADD COLUMN "geo_allowed_countries" jsonb NULL;
+-- set comment to column: "geo_allowed_countries" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."geo_allowed_countries" IS 'ISO 3166-1 alpha-2 countries passwords may be read from (empty = anywhere)';
@@ -0,0 +1,4 @@
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" ADD COLUMN "canary" boolean NOT NULL DEFAULT false;
+-- set comment to column: "canary" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."canary" IS 'Decoy secret whose password reads raise a security alert';
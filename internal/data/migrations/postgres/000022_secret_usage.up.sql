@@ -0,0 +1,18 @@
+-- create "warden_secret_usage" table
+CREATE TABLE "warden_secret_usage" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "tenant_id" bigint NULL DEFAULT 0, "secret_id" character varying NOT NULL, "day" timestamptz NOT NULL, "reads" bigint NOT NULL DEFAULT 0, "writes" bigint NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- create index "warden_secret_usage_secret_day" to table: "warden_secret_usage"
+CREATE UNIQUE INDEX "warden_secret_usage_secret_day" ON "warden_secret_usage" ("tenant_id", "secret_id", "day");
+-- create index "warden_secret_usage_day" to table: "warden_secret_usage"
+CREATE INDEX "warden_secret_usage_day" ON "warden_secret_usage" ("day");
+-- set comment to column: "id" on table: "warden_secret_usage"
+COMMENT ON COLUMN "warden_secret_usage"."id" IS 'id';
+-- set comment to column: "tenant_id" on table: "warden_secret_usage"
+COMMENT ON COLUMN "warden_secret_usage"."tenant_id" IS '租户ID';
+-- set comment to column: "secret_id" on table: "warden_secret_usage"
+COMMENT ON COLUMN "warden_secret_usage"."secret_id" IS 'Secret the counts belong to';
+-- set comment to column: "day" on table: "warden_secret_usage"
+COMMENT ON COLUMN "warden_secret_usage"."day" IS 'Midnight UTC of the counted day';
+-- set comment to column: "reads" on table: "warden_secret_usage"
+COMMENT ON COLUMN "warden_secret_usage"."reads" IS 'Password reads on the day';
+-- set comment to column: "writes" on table: "warden_secret_usage"
+COMMENT ON COLUMN "warden_secret_usage"."writes" IS 'Updates, password changes and version restores on the day';
@@ -0,0 +1,6 @@
+-- modify "warden_tenant_settings" table
+ALTER TABLE "warden_tenant_settings" ADD COLUMN "version_max_count" integer NOT NULL DEFAULT 0, ADD COLUMN "version_delete_after_days" integer NOT NULL DEFAULT 0;
+-- set comment to column: "version_max_count" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."version_max_count" IS 'Password versions Vault keeps per secret (0 = mount default)';
+-- set comment to column: "version_delete_after_days" on table: "warden_tenant_settings"
+COMMENT ON COLUMN "warden_tenant_settings"."version_delete_after_days" IS 'Days after which Vault deletes password versions (0 = never)';
@@ -0,0 +1,26 @@
+-- create "warden_version_pins" table
+CREATE TABLE "warden_version_pins" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "secret_id" character varying NOT NULL, "version_number" integer NOT NULL, "token_hash" character varying NOT NULL, "consumer" character varying NOT NULL, "last_used_time" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "warden_version_pins_token" to table: "warden_version_pins"
+CREATE UNIQUE INDEX "warden_version_pins_token" ON "warden_version_pins" ("token_hash");
+-- create index "warden_version_pins_tenant_secret" to table: "warden_version_pins"
+CREATE INDEX "warden_version_pins_tenant_secret" ON "warden_version_pins" ("tenant_id", "secret_id");
+-- set comment to column: "id" on table: "warden_version_pins"
+COMMENT ON COLUMN "warden_version_pins"."id" IS 'id';
+-- set comment to column: "create_time" on table: "warden_version_pins"
+COMMENT ON COLUMN "warden_version_pins"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_version_pins"
+COMMENT ON COLUMN "warden_version_pins"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_version_pins"
+COMMENT ON COLUMN "warden_version_pins"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_version_pins"
+COMMENT ON COLUMN "warden_version_pins"."tenant_id" IS '租户ID';
+-- set comment to column: "secret_id" on table: "warden_version_pins"
+COMMENT ON COLUMN "warden_version_pins"."secret_id" IS 'Pinned secret';
+-- set comment to column: "version_number" on table: "warden_version_pins"
+COMMENT ON COLUMN "warden_version_pins"."version_number" IS 'Version the consumer is pinned to';
+-- set comment to column: "token_hash" on table: "warden_version_pins"
+COMMENT ON COLUMN "warden_version_pins"."token_hash" IS 'SHA-256 of the pin token, hex encoded';
+-- set comment to column: "consumer" on table: "warden_version_pins"
+COMMENT ON COLUMN "warden_version_pins"."consumer" IS 'User ID of the consumer that created the pin';
+-- set comment to column: "last_used_time" on table: "warden_version_pins"
+COMMENT ON COLUMN "warden_version_pins"."last_used_time" IS 'Last time the pin token was used to read the password';
@@ -0,0 +1,6 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" DROP COLUMN "protected";
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" DROP COLUMN "protected";
+-- drop "warden_deletion_requests" table
+DROP TABLE "warden_deletion_requests";
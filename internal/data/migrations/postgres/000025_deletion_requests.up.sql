@@ -0,0 +1,44 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" ADD COLUMN "protected" boolean NOT NULL DEFAULT false;
+-- set comment to column: "protected" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."protected" IS 'Whether deleting this folder or anything in it permanently needs the approval of a second owner';
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" ADD COLUMN "protected" boolean NOT NULL DEFAULT false;
+-- set comment to column: "protected" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."protected" IS 'Whether permanent deletion needs the approval of a second owner';
+-- create "warden_deletion_requests" table
+CREATE TABLE "warden_deletion_requests" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "resource_type" character varying NOT NULL, "resource_id" character varying NOT NULL, "resource_name" character varying NULL, "force" boolean NOT NULL DEFAULT false, "reason" character varying NULL, "status" character varying NOT NULL DEFAULT 'DELETION_REQUEST_STATUS_PENDING', "requested_by" character varying NOT NULL, "decided_by" character varying NULL, "decided_at" timestamptz NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "warden_deletion_requests_resource" to table: "warden_deletion_requests"
+CREATE INDEX "warden_deletion_requests_resource" ON "warden_deletion_requests" ("tenant_id", "resource_id", "status");
+-- create index "warden_deletion_requests_tenant_status" to table: "warden_deletion_requests"
+CREATE INDEX "warden_deletion_requests_tenant_status" ON "warden_deletion_requests" ("tenant_id", "status");
+-- set comment to column: "id" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."id" IS 'id';
+-- set comment to column: "create_time" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."tenant_id" IS '租户ID';
+-- set comment to column: "resource_type" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."resource_type" IS 'Kind of resource to delete';
+-- set comment to column: "resource_id" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."resource_id" IS 'Secret or folder to delete';
+-- set comment to column: "resource_name" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."resource_name" IS 'Secret name or folder path when the request was made';
+-- set comment to column: "force" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."force" IS 'Folder deletions: delete the folder with everything in it';
+-- set comment to column: "reason" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."reason" IS 'Why the resource should be deleted';
+-- set comment to column: "status" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."status" IS 'Pending, or how the request was decided';
+-- set comment to column: "requested_by" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."requested_by" IS 'Owner who requested the deletion';
+-- set comment to column: "decided_by" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."decided_by" IS 'Owner who approved or rejected the request';
+-- set comment to column: "decided_at" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."decided_at" IS 'When the request was approved, rejected or withdrawn';
+-- set comment to column: "expires_at" on table: "warden_deletion_requests"
+COMMENT ON COLUMN "warden_deletion_requests"."expires_at" IS 'When a pending request can no longer be approved';
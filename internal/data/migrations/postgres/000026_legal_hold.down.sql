@@ -0,0 +1,2 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" DROP COLUMN "legal_hold", DROP COLUMN "legal_hold_reason";
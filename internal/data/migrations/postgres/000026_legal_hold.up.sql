@@ -0,0 +1,6 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" ADD COLUMN "legal_hold" boolean NOT NULL DEFAULT false, ADD COLUMN "legal_hold_reason" character varying NULL;
+-- set comment to column: "legal_hold" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."legal_hold" IS 'Whether this folder and everything in it is under legal hold and cannot be permanently deleted or destroyed';
+-- set comment to column: "legal_hold_reason" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."legal_hold_reason" IS 'Why the legal hold was placed';
@@ -0,0 +1,8 @@
+-- set comment to column: "resource_type" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."resource_type" IS 'Type of resource (folder or secret)';
+-- set comment to column: "resource_id" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."resource_id" IS 'ID of the folder or secret';
+-- drop "warden_collection_secrets" table
+DROP TABLE "warden_collection_secrets";
+-- drop "warden_collections" table
+DROP TABLE "warden_collections";
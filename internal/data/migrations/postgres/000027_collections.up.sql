@@ -0,0 +1,32 @@
+-- set comment to column: "resource_type" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."resource_type" IS 'Type of resource (folder, secret or collection)';
+-- set comment to column: "resource_id" on table: "warden_permissions"
+COMMENT ON COLUMN "warden_permissions"."resource_id" IS 'ID of the folder, secret or collection';
+-- create "warden_collections" table
+CREATE TABLE "warden_collections" ("id" character varying NOT NULL, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "name" character varying NOT NULL, "description" character varying NULL, "external_id" character varying NULL, PRIMARY KEY ("id"));
+-- create index "collection_tenant_id_name" to table: "warden_collections"
+CREATE UNIQUE INDEX "collection_tenant_id_name" ON "warden_collections" ("tenant_id", "name");
+-- create index "collection_tenant_id" to table: "warden_collections"
+CREATE INDEX "collection_tenant_id" ON "warden_collections" ("tenant_id");
+-- create index "collection_tenant_id_external_id" to table: "warden_collections"
+CREATE INDEX "collection_tenant_id_external_id" ON "warden_collections" ("tenant_id", "external_id");
+-- set comment to column: "id" on table: "warden_collections"
+COMMENT ON COLUMN "warden_collections"."id" IS 'UUID primary key';
+-- set comment to column: "create_by" on table: "warden_collections"
+COMMENT ON COLUMN "warden_collections"."create_by" IS '创建者ID';
+-- set comment to column: "create_time" on table: "warden_collections"
+COMMENT ON COLUMN "warden_collections"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_collections"
+COMMENT ON COLUMN "warden_collections"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_collections"
+COMMENT ON COLUMN "warden_collections"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_collections"
+COMMENT ON COLUMN "warden_collections"."tenant_id" IS '租户ID';
+-- set comment to column: "name" on table: "warden_collections"
+COMMENT ON COLUMN "warden_collections"."name" IS 'Collection name';
+-- set comment to column: "description" on table: "warden_collections"
+COMMENT ON COLUMN "warden_collections"."description" IS 'Optional description';
+-- set comment to column: "external_id" on table: "warden_collections"
+COMMENT ON COLUMN "warden_collections"."external_id" IS 'ID of the collection in the system it was imported from';
+-- create "warden_collection_secrets" table
+CREATE TABLE "warden_collection_secrets" ("collection_id" character varying NOT NULL, "secret_id" character varying NOT NULL, PRIMARY KEY ("collection_id", "secret_id"), CONSTRAINT "warden_collection_secrets_collection_id" FOREIGN KEY ("collection_id") REFERENCES "warden_collections" ("id") ON DELETE CASCADE, CONSTRAINT "warden_collection_secrets_secret_id" FOREIGN KEY ("secret_id") REFERENCES "warden_secrets" ("id") ON DELETE CASCADE);
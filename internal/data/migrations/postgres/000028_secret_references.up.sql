@@ -0,0 +1,4 @@
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" ADD COLUMN "referenced_secret_ids" jsonb NULL;
+-- set comment to column: "referenced_secret_ids" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."referenced_secret_ids" IS 'Secrets referenced from the metadata, kept in step with it';
@@ -0,0 +1,2 @@
+-- drop "warden_folder_change_logs" table
+DROP TABLE "warden_folder_change_logs";
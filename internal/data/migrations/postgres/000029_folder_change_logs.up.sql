@@ -0,0 +1,32 @@
+-- create "warden_folder_change_logs" table
+CREATE TABLE "warden_folder_change_logs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "folder_id" character varying NOT NULL, "action" character varying NOT NULL, "old_path" character varying NULL, "new_path" character varying NULL, "old_parent_id" character varying NULL, "new_parent_id" character varying NULL, "actor_id" character varying NULL, "details" jsonb NULL, PRIMARY KEY ("id"));
+-- create index "warden_folder_change_logs_tenant_folder" to table: "warden_folder_change_logs"
+CREATE INDEX "warden_folder_change_logs_tenant_folder" ON "warden_folder_change_logs" ("tenant_id", "folder_id");
+-- create index "warden_folder_change_logs_tenant_time" to table: "warden_folder_change_logs"
+CREATE INDEX "warden_folder_change_logs_tenant_time" ON "warden_folder_change_logs" ("tenant_id", "create_time");
+-- set comment to column: "id" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."id" IS 'id';
+-- set comment to column: "create_time" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."tenant_id" IS '租户ID';
+-- set comment to column: "folder_id" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."folder_id" IS 'Folder that changed';
+-- set comment to column: "action" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."action" IS 'Kind of change';
+-- set comment to column: "old_path" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."old_path" IS 'Path before the change';
+-- set comment to column: "new_path" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."new_path" IS 'Path after the change';
+-- set comment to column: "old_parent_id" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."old_parent_id" IS 'Parent folder before the change';
+-- set comment to column: "new_parent_id" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."new_parent_id" IS 'Parent folder after the change';
+-- set comment to column: "actor_id" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."actor_id" IS 'User who made the change';
+-- set comment to column: "details" on table: "warden_folder_change_logs"
+COMMENT ON COLUMN "warden_folder_change_logs"."details" IS 'Extra details of the change';
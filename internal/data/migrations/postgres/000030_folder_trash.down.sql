@@ -0,0 +1,4 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" DROP COLUMN "status", DROP COLUMN "deleted_time", DROP COLUMN "trashed_with_folder_id", DROP COLUMN "original_parent_id", DROP COLUMN "original_path";
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" DROP COLUMN "trashed_with_folder_id", DROP COLUMN "status_before_trash";
@@ -0,0 +1,24 @@
+-- modify "warden_folders" table
+ALTER TABLE "warden_folders" ADD COLUMN "status" character varying NOT NULL DEFAULT 'FOLDER_STATUS_ACTIVE', ADD COLUMN "deleted_time" timestamptz NULL, ADD COLUMN "trashed_with_folder_id" character varying NULL, ADD COLUMN "original_parent_id" character varying NULL, ADD COLUMN "original_path" character varying NULL;
+-- create index "folder_trashed_with_folder_id" to table: "warden_folders"
+CREATE INDEX "folder_trashed_with_folder_id" ON "warden_folders" ("trashed_with_folder_id");
+-- create index "folder_status_deleted_time" to table: "warden_folders"
+CREATE INDEX "folder_status_deleted_time" ON "warden_folders" ("status", "deleted_time");
+-- set comment to column: "status" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."status" IS 'Folder status; deleted folders are in the trash until restored or purged';
+-- set comment to column: "deleted_time" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."deleted_time" IS 'When the folder was moved to the trash';
+-- set comment to column: "trashed_with_folder_id" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."trashed_with_folder_id" IS 'The folder whose deletion moved this one to the trash (itself for the deleted folder)';
+-- set comment to column: "original_parent_id" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."original_parent_id" IS 'Parent of the deleted folder before it was moved to the trash';
+-- set comment to column: "original_path" on table: "warden_folders"
+COMMENT ON COLUMN "warden_folders"."original_path" IS 'Path of the deleted folder before it was moved to the trash';
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" ADD COLUMN "trashed_with_folder_id" character varying NULL, ADD COLUMN "status_before_trash" character varying NULL;
+-- create index "secret_trashed_with_folder_id" to table: "warden_secrets"
+CREATE INDEX "secret_trashed_with_folder_id" ON "warden_secrets" ("trashed_with_folder_id");
+-- set comment to column: "trashed_with_folder_id" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."trashed_with_folder_id" IS 'The deleted folder that moved this secret to the trash, restored with it';
+-- set comment to column: "status_before_trash" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."status_before_trash" IS 'Status to restore when the folder that moved this secret to the trash is restored';
@@ -0,0 +1,4 @@
+-- modify "warden_secret_versions" table
+ALTER TABLE "warden_secret_versions" DROP COLUMN "checksum_algorithm";
+-- set comment to column: "checksum" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."checksum" IS 'SHA-256 checksum of the password';
@@ -0,0 +1,8 @@
+-- modify "warden_secret_versions" table
+ALTER TABLE "warden_secret_versions" ADD COLUMN "checksum_algorithm" character varying NOT NULL DEFAULT 'sha256';
+-- create index "secretversion_checksum_algorithm" to table: "warden_secret_versions"
+CREATE INDEX "secretversion_checksum_algorithm" ON "warden_secret_versions" ("checksum_algorithm");
+-- set comment to column: "checksum" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."checksum" IS 'Checksum of the password, made with checksum_algorithm';
+-- set comment to column: "checksum_algorithm" on table: "warden_secret_versions"
+COMMENT ON COLUMN "warden_secret_versions"."checksum_algorithm" IS 'Algorithm of the checksum: sha256, or hmac-sha256:<key id>';
@@ -0,0 +1,4 @@
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" DROP COLUMN "field_key_id";
+-- drop "warden_field_keys" table
+DROP TABLE "warden_field_keys";
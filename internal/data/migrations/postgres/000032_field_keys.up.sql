@@ -0,0 +1,20 @@
+-- modify "warden_secrets" table
+ALTER TABLE "warden_secrets" ADD COLUMN "field_key_id" bigint NOT NULL DEFAULT 0;
+-- create index "secret_field_key_id" to table: "warden_secrets"
+CREATE INDEX "secret_field_key_id" ON "warden_secrets" ("field_key_id");
+-- set comment to column: "field_key_id" on table: "warden_secrets"
+COMMENT ON COLUMN "warden_secrets"."field_key_id" IS 'Field key encrypting username, host_url and metadata (0 when stored in plaintext)';
+-- create "warden_field_keys" table
+CREATE TABLE "warden_field_keys" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "transit_key" character varying NOT NULL, "wrapped_key" character varying NOT NULL, PRIMARY KEY ("id"));
+-- set comment to column: "id" on table: "warden_field_keys"
+COMMENT ON COLUMN "warden_field_keys"."id" IS 'id';
+-- set comment to column: "create_time" on table: "warden_field_keys"
+COMMENT ON COLUMN "warden_field_keys"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_field_keys"
+COMMENT ON COLUMN "warden_field_keys"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_field_keys"
+COMMENT ON COLUMN "warden_field_keys"."delete_time" IS '删除时间';
+-- set comment to column: "transit_key" on table: "warden_field_keys"
+COMMENT ON COLUMN "warden_field_keys"."transit_key" IS 'Name of the Vault Transit key wrapping the data key';
+-- set comment to column: "wrapped_key" on table: "warden_field_keys"
+COMMENT ON COLUMN "warden_field_keys"."wrapped_key" IS 'Data key encrypted by the Transit key';
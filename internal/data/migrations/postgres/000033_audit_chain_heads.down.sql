@@ -0,0 +1,2 @@
+-- drop "warden_audit_chain_heads" table
+DROP TABLE "warden_audit_chain_heads";
@@ -0,0 +1,18 @@
+-- create "warden_audit_chain_heads" table
+CREATE TABLE "warden_audit_chain_heads" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NOT NULL, "seq" bigint NOT NULL DEFAULT 0, "hash" character varying NULL, PRIMARY KEY ("id"));
+-- create index "warden_audit_chain_heads_tenant_id_key" to table: "warden_audit_chain_heads"
+CREATE UNIQUE INDEX "warden_audit_chain_heads_tenant_id_key" ON "warden_audit_chain_heads" ("tenant_id");
+-- set comment to column: "id" on table: "warden_audit_chain_heads"
+COMMENT ON COLUMN "warden_audit_chain_heads"."id" IS 'id';
+-- set comment to column: "create_time" on table: "warden_audit_chain_heads"
+COMMENT ON COLUMN "warden_audit_chain_heads"."create_time" IS '创建时间';
+-- set comment to column: "update_time" on table: "warden_audit_chain_heads"
+COMMENT ON COLUMN "warden_audit_chain_heads"."update_time" IS '更新时间';
+-- set comment to column: "delete_time" on table: "warden_audit_chain_heads"
+COMMENT ON COLUMN "warden_audit_chain_heads"."delete_time" IS '删除时间';
+-- set comment to column: "tenant_id" on table: "warden_audit_chain_heads"
+COMMENT ON COLUMN "warden_audit_chain_heads"."tenant_id" IS 'Tenant of the chain (0 = entries without a tenant)';
+-- set comment to column: "seq" on table: "warden_audit_chain_heads"
+COMMENT ON COLUMN "warden_audit_chain_heads"."seq" IS 'Chain sequence of the last entry';
+-- set comment to column: "hash" on table: "warden_audit_chain_heads"
+COMMENT ON COLUMN "warden_audit_chain_heads"."hash" IS 'Chain hash of the last entry';
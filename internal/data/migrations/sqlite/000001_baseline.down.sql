@@ -0,0 +1,14 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "warden_audit_logs" table
+DROP TABLE `warden_audit_logs`;
+-- drop "warden_folders" table
+DROP TABLE `warden_folders`;
+-- drop "warden_permissions" table
+DROP TABLE `warden_permissions`;
+-- drop "warden_secrets" table
+DROP TABLE `warden_secrets`;
+-- drop "warden_secret_versions" table
+DROP TABLE `warden_secret_versions`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,68 @@
+-- create "warden_audit_logs" table
+CREATE TABLE `warden_audit_logs` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `audit_id` text NOT NULL, `request_id` text NULL, `operation` text NOT NULL, `service_name` text NOT NULL DEFAULT ('warden-service'), `client_id` text NULL, `client_common_name` text NULL, `client_organization` text NULL, `client_serial_number` text NULL, `is_authenticated` bool NOT NULL DEFAULT (false), `success` bool NOT NULL DEFAULT (true), `error_code` integer NULL, `error_message` text NULL, `latency_ms` integer NOT NULL DEFAULT (0), `peer_address` text NULL, `geo_location` json NULL, `log_hash` text NULL, `signature` blob NULL, `metadata` json NULL);
+-- create index "warden_audit_logs_audit_id_key" to table: "warden_audit_logs"
+CREATE UNIQUE INDEX `warden_audit_logs_audit_id_key` ON `warden_audit_logs` (`audit_id`);
+-- create index "warden_auditlog_tenant_id" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_tenant_id` ON `warden_audit_logs` (`tenant_id`);
+-- create index "warden_auditlog_tenant_client" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_tenant_client` ON `warden_audit_logs` (`tenant_id`, `client_id`);
+-- create index "warden_auditlog_tenant_operation" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_tenant_operation` ON `warden_audit_logs` (`tenant_id`, `operation`);
+-- create index "warden_auditlog_tenant_success" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_tenant_success` ON `warden_audit_logs` (`tenant_id`, `success`);
+-- create index "warden_auditlog_operation" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_operation` ON `warden_audit_logs` (`operation`);
+-- create index "warden_auditlog_client_id" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_client_id` ON `warden_audit_logs` (`client_id`);
+-- create index "warden_auditlog_success" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_success` ON `warden_audit_logs` (`success`);
+-- create index "warden_auditlog_peer_address" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_peer_address` ON `warden_audit_logs` (`peer_address`);
+-- create "warden_folders" table
+CREATE TABLE `warden_folders` (`id` text NOT NULL, `create_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `name` text NOT NULL, `path` text NOT NULL, `description` text NULL, `depth` integer NOT NULL DEFAULT (0), `parent_id` text NULL, PRIMARY KEY (`id`), CONSTRAINT `warden_folders_warden_folders_children` FOREIGN KEY (`parent_id`) REFERENCES `warden_folders` (`id`) ON DELETE SET NULL);
+-- create index "folder_tenant_id_parent_id_name" to table: "warden_folders"
+CREATE UNIQUE INDEX `folder_tenant_id_parent_id_name` ON `warden_folders` (`tenant_id`, `parent_id`, `name`);
+-- create index "folder_tenant_id_path" to table: "warden_folders"
+CREATE UNIQUE INDEX `folder_tenant_id_path` ON `warden_folders` (`tenant_id`, `path`);
+-- create index "folder_tenant_id" to table: "warden_folders"
+CREATE INDEX `folder_tenant_id` ON `warden_folders` (`tenant_id`);
+-- create index "folder_parent_id" to table: "warden_folders"
+CREATE INDEX `folder_parent_id` ON `warden_folders` (`parent_id`);
+-- create index "folder_path" to table: "warden_folders"
+CREATE INDEX `folder_path` ON `warden_folders` (`path`);
+-- create "warden_permissions" table
+CREATE TABLE `warden_permissions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `resource_type` text NOT NULL, `resource_id` text NOT NULL, `relation` text NOT NULL, `subject_type` text NOT NULL, `subject_id` text NOT NULL, `granted_by` integer NULL, `expires_at` datetime NULL, `folder_permissions` text NULL, `secret_permissions` text NULL, CONSTRAINT `warden_permissions_warden_folders_permissions` FOREIGN KEY (`folder_permissions`) REFERENCES `warden_folders` (`id`) ON DELETE SET NULL, CONSTRAINT `warden_permissions_warden_secrets_permissions` FOREIGN KEY (`secret_permissions`) REFERENCES `warden_secrets` (`id`) ON DELETE SET NULL);
+-- create index "permission_tenant_id_resource_t_6b7412ca300e43b4f618f7d81d9bd1dd" to table: "warden_permissions"
+CREATE UNIQUE INDEX `permission_tenant_id_resource_t_6b7412ca300e43b4f618f7d81d9bd1dd` ON `warden_permissions` (`tenant_id`, `resource_type`, `resource_id`, `relation`, `subject_type`, `subject_id`);
+-- create index "permission_tenant_id_resource_type_resource_id" to table: "warden_permissions"
+CREATE INDEX `permission_tenant_id_resource_type_resource_id` ON `warden_permissions` (`tenant_id`, `resource_type`, `resource_id`);
+-- create index "permission_subject_type_subject_id" to table: "warden_permissions"
+CREATE INDEX `permission_subject_type_subject_id` ON `warden_permissions` (`subject_type`, `subject_id`);
+-- create index "permission_tenant_id" to table: "warden_permissions"
+CREATE INDEX `permission_tenant_id` ON `warden_permissions` (`tenant_id`);
+-- create index "permission_expires_at" to table: "warden_permissions"
+CREATE INDEX `permission_expires_at` ON `warden_permissions` (`expires_at`);
+-- create "warden_secrets" table
+CREATE TABLE `warden_secrets` (`id` text NOT NULL, `create_by` integer NULL, `update_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `name` text NOT NULL, `username` text NULL, `host_url` text NULL, `vault_path` text NOT NULL, `current_version` integer NOT NULL DEFAULT (1), `metadata` json NULL, `description` text NULL, `status` text NOT NULL DEFAULT ('SECRET_STATUS_ACTIVE'), `has_totp` bool NOT NULL DEFAULT (false), `folder_id` text NULL, PRIMARY KEY (`id`), CONSTRAINT `warden_secrets_warden_folders_secrets` FOREIGN KEY (`folder_id`) REFERENCES `warden_folders` (`id`) ON DELETE SET NULL);
+-- create index "secret_tenant_id_folder_id_name" to table: "warden_secrets"
+CREATE UNIQUE INDEX `secret_tenant_id_folder_id_name` ON `warden_secrets` (`tenant_id`, `folder_id`, `name`);
+-- create index "secret_tenant_id" to table: "warden_secrets"
+CREATE INDEX `secret_tenant_id` ON `warden_secrets` (`tenant_id`);
+-- create index "secret_folder_id" to table: "warden_secrets"
+CREATE INDEX `secret_folder_id` ON `warden_secrets` (`folder_id`);
+-- create index "secret_tenant_id_name" to table: "warden_secrets"
+CREATE INDEX `secret_tenant_id_name` ON `warden_secrets` (`tenant_id`, `name`);
+-- create index "secret_tenant_id_username" to table: "warden_secrets"
+CREATE INDEX `secret_tenant_id_username` ON `warden_secrets` (`tenant_id`, `username`);
+-- create index "secret_status" to table: "warden_secrets"
+CREATE INDEX `secret_status` ON `warden_secrets` (`status`);
+-- create index "secret_vault_path" to table: "warden_secrets"
+CREATE UNIQUE INDEX `secret_vault_path` ON `warden_secrets` (`vault_path`);
+-- create "warden_secret_versions" table
+CREATE TABLE `warden_secret_versions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `version_number` integer NOT NULL, `vault_path` text NOT NULL, `comment` text NULL, `checksum` text NOT NULL, `secret_id` text NOT NULL, CONSTRAINT `warden_secret_versions_warden_secrets_versions` FOREIGN KEY (`secret_id`) REFERENCES `warden_secrets` (`id`) ON DELETE NO ACTION);
+-- create index "secretversion_secret_id_version_number" to table: "warden_secret_versions"
+CREATE UNIQUE INDEX `secretversion_secret_id_version_number` ON `warden_secret_versions` (`secret_id`, `version_number`);
+-- create index "secretversion_secret_id" to table: "warden_secret_versions"
+CREATE INDEX `secretversion_secret_id` ON `warden_secret_versions` (`secret_id`);
+-- create index "secretversion_vault_path" to table: "warden_secret_versions"
+CREATE INDEX `secretversion_vault_path` ON `warden_secret_versions` (`vault_path`);
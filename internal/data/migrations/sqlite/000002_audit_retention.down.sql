@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "warden_tenant_settings" table
+DROP TABLE `warden_tenant_settings`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
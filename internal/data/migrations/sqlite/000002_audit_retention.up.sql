@@ -0,0 +1,4 @@
+-- create "warden_tenant_settings" table
+CREATE TABLE `warden_tenant_settings` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `update_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `audit_retention_days` integer NOT NULL DEFAULT (0));
+-- create index "warden_tenant_settings_tenant_id" to table: "warden_tenant_settings"
+CREATE UNIQUE INDEX `warden_tenant_settings_tenant_id` ON `warden_tenant_settings` (`tenant_id`);
@@ -0,0 +1,32 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_warden_audit_logs" table
+CREATE TABLE `new_warden_audit_logs` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `audit_id` text NOT NULL, `request_id` text NULL, `operation` text NOT NULL, `service_name` text NOT NULL DEFAULT ('warden-service'), `client_id` text NULL, `client_common_name` text NULL, `client_organization` text NULL, `client_serial_number` text NULL, `is_authenticated` bool NOT NULL DEFAULT (false), `success` bool NOT NULL DEFAULT (true), `error_code` integer NULL, `error_message` text NULL, `latency_ms` integer NOT NULL DEFAULT (0), `peer_address` text NULL, `geo_location` json NULL, `log_hash` text NULL, `signature` blob NULL, `metadata` json NULL, `chain_seq` integer NOT NULL DEFAULT (0), `prev_hash` text NULL, `chain_hash` text NULL);
+-- copy rows from old table "warden_audit_logs" to new temporary table "new_warden_audit_logs"
+INSERT INTO `new_warden_audit_logs` (`id`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `audit_id`, `request_id`, `operation`, `service_name`, `client_id`, `client_common_name`, `client_organization`, `client_serial_number`, `is_authenticated`, `success`, `error_code`, `error_message`, `latency_ms`, `peer_address`, `geo_location`, `log_hash`, `signature`, `metadata`, `chain_seq`, `prev_hash`, `chain_hash`) SELECT `id`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `audit_id`, `request_id`, `operation`, `service_name`, `client_id`, `client_common_name`, `client_organization`, `client_serial_number`, `is_authenticated`, `success`, `error_code`, `error_message`, `latency_ms`, `peer_address`, `geo_location`, `log_hash`, `signature`, `metadata`, `chain_seq`, `prev_hash`, `chain_hash` FROM `warden_audit_logs`;
+-- drop "warden_audit_logs" table after copying rows
+DROP TABLE `warden_audit_logs`;
+-- rename temporary table "new_warden_audit_logs" to "warden_audit_logs"
+ALTER TABLE `new_warden_audit_logs` RENAME TO `warden_audit_logs`;
+-- create index "warden_audit_logs_audit_id_key" to table: "warden_audit_logs"
+CREATE UNIQUE INDEX `warden_audit_logs_audit_id_key` ON `warden_audit_logs` (`audit_id`);
+-- create index "warden_auditlog_tenant_id" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_tenant_id` ON `warden_audit_logs` (`tenant_id`);
+-- create index "warden_auditlog_tenant_client" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_tenant_client` ON `warden_audit_logs` (`tenant_id`, `client_id`);
+-- create index "warden_auditlog_tenant_operation" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_tenant_operation` ON `warden_audit_logs` (`tenant_id`, `operation`);
+-- create index "warden_auditlog_tenant_success" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_tenant_success` ON `warden_audit_logs` (`tenant_id`, `success`);
+-- create index "warden_auditlog_operation" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_operation` ON `warden_audit_logs` (`operation`);
+-- create index "warden_auditlog_client_id" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_client_id` ON `warden_audit_logs` (`client_id`);
+-- create index "warden_auditlog_success" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_success` ON `warden_audit_logs` (`success`);
+-- create index "warden_auditlog_peer_address" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_peer_address` ON `warden_audit_logs` (`peer_address`);
+-- create index "warden_auditlog_tenant_chain_seq" to table: "warden_audit_logs"
+CREATE INDEX `warden_auditlog_tenant_chain_seq` ON `warden_audit_logs` (`tenant_id`, `chain_seq`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,8 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "warden_webhooks" table
+DROP TABLE `warden_webhooks`;
+-- drop "warden_webhook_deliveries" table
+DROP TABLE `warden_webhook_deliveries`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
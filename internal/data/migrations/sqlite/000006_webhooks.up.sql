@@ -0,0 +1,14 @@
+-- create "warden_webhooks" table
+CREATE TABLE `warden_webhooks` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_by` integer NULL, `update_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `name` text NOT NULL, `url` text NOT NULL, `secret` text NULL, `event_types` json NULL, `enabled` bool NOT NULL DEFAULT (true), `description` text NULL);
+-- create index "warden_webhooks_tenant_enabled" to table: "warden_webhooks"
+CREATE INDEX `warden_webhooks_tenant_enabled` ON `warden_webhooks` (`tenant_id`, `enabled`);
+-- create index "warden_webhooks_tenant_name" to table: "warden_webhooks"
+CREATE UNIQUE INDEX `warden_webhooks_tenant_name` ON `warden_webhooks` (`tenant_id`, `name`);
+-- create "warden_webhook_deliveries" table
+CREATE TABLE `warden_webhook_deliveries` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `webhook_id` integer NOT NULL, `event_id` text NOT NULL, `event_type` text NOT NULL, `payload` text NOT NULL, `status` text NOT NULL DEFAULT ('DELIVERY_STATUS_PENDING'), `attempts` integer NOT NULL DEFAULT (0), `response_code` integer NULL, `last_error` text NULL, `next_attempt_at` datetime NULL, `delivered_at` datetime NULL);
+-- create index "warden_webhook_deliveries_webhook_event" to table: "warden_webhook_deliveries"
+CREATE UNIQUE INDEX `warden_webhook_deliveries_webhook_event` ON `warden_webhook_deliveries` (`webhook_id`, `event_id`);
+-- create index "warden_webhook_deliveries_status_next" to table: "warden_webhook_deliveries"
+CREATE INDEX `warden_webhook_deliveries_status_next` ON `warden_webhook_deliveries` (`status`, `next_attempt_at`);
+-- create index "warden_webhook_deliveries_webhook_time" to table: "warden_webhook_deliveries"
+CREATE INDEX `warden_webhook_deliveries_webhook_time` ON `warden_webhook_deliveries` (`webhook_id`, `create_time`);
@@ -0,0 +1,14 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_warden_tenant_settings" table
+CREATE TABLE `new_warden_tenant_settings` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `update_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `audit_retention_days` integer NOT NULL DEFAULT (0));
+-- copy rows from old table "warden_tenant_settings" to new temporary table "new_warden_tenant_settings"
+INSERT INTO `new_warden_tenant_settings` (`id`, `update_by`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `audit_retention_days`) SELECT `id`, `update_by`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `audit_retention_days` FROM `warden_tenant_settings`;
+-- drop "warden_tenant_settings" table after copying rows
+DROP TABLE `warden_tenant_settings`;
+-- rename temporary table "new_warden_tenant_settings" to "warden_tenant_settings"
+ALTER TABLE `new_warden_tenant_settings` RENAME TO `warden_tenant_settings`;
+-- create index "warden_tenant_settings_tenant_id" to table: "warden_tenant_settings"
+CREATE UNIQUE INDEX `warden_tenant_settings_tenant_id` ON `warden_tenant_settings` (`tenant_id`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
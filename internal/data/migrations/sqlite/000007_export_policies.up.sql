@@ -0,0 +1,4 @@
+-- add column "export_excluded_tags" to table: "warden_tenant_settings"
+ALTER TABLE `warden_tenant_settings` ADD COLUMN `export_excluded_tags` json NULL;
+-- add column "export_excluded_folder_ids" to table: "warden_tenant_settings"
+ALTER TABLE `warden_tenant_settings` ADD COLUMN `export_excluded_folder_ids` json NULL;
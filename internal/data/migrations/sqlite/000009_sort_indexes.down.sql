@@ -0,0 +1,44 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_warden_folders" table
+CREATE TABLE `new_warden_folders` (`id` text NOT NULL, `create_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `name` text NOT NULL, `path` text NOT NULL, `description` text NULL, `depth` integer NOT NULL DEFAULT (0), `parent_id` text NULL, PRIMARY KEY (`id`), CONSTRAINT `warden_folders_warden_folders_children` FOREIGN KEY (`parent_id`) REFERENCES `warden_folders` (`id`) ON DELETE SET NULL);
+-- copy rows from old table "warden_folders" to new temporary table "new_warden_folders"
+INSERT INTO `new_warden_folders` (`id`, `create_by`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `name`, `path`, `description`, `depth`, `parent_id`) SELECT `id`, `create_by`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `name`, `path`, `description`, `depth`, `parent_id` FROM `warden_folders`;
+-- drop "warden_folders" table after copying rows
+DROP TABLE `warden_folders`;
+-- rename temporary table "new_warden_folders" to "warden_folders"
+ALTER TABLE `new_warden_folders` RENAME TO `warden_folders`;
+-- create index "folder_tenant_id_parent_id_name" to table: "warden_folders"
+CREATE UNIQUE INDEX `folder_tenant_id_parent_id_name` ON `warden_folders` (`tenant_id`, `parent_id`, `name`);
+-- create index "folder_tenant_id_path" to table: "warden_folders"
+CREATE UNIQUE INDEX `folder_tenant_id_path` ON `warden_folders` (`tenant_id`, `path`);
+-- create index "folder_tenant_id" to table: "warden_folders"
+CREATE INDEX `folder_tenant_id` ON `warden_folders` (`tenant_id`);
+-- create index "folder_parent_id" to table: "warden_folders"
+CREATE INDEX `folder_parent_id` ON `warden_folders` (`parent_id`);
+-- create index "folder_path" to table: "warden_folders"
+CREATE INDEX `folder_path` ON `warden_folders` (`path`);
+-- create "new_warden_secrets" table
+CREATE TABLE `new_warden_secrets` (`id` text NOT NULL, `create_by` integer NULL, `update_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `name` text NOT NULL, `username` text NULL, `host_url` text NULL, `vault_path` text NOT NULL, `current_version` integer NOT NULL DEFAULT (1), `metadata` json NULL, `description` text NULL, `status` text NOT NULL DEFAULT ('SECRET_STATUS_ACTIVE'), `has_totp` bool NOT NULL DEFAULT (false), `folder_id` text NULL, PRIMARY KEY (`id`), CONSTRAINT `warden_secrets_warden_folders_secrets` FOREIGN KEY (`folder_id`) REFERENCES `warden_folders` (`id`) ON DELETE SET NULL);
+-- copy rows from old table "warden_secrets" to new temporary table "new_warden_secrets"
+INSERT INTO `new_warden_secrets` (`id`, `create_by`, `update_by`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `name`, `username`, `host_url`, `vault_path`, `current_version`, `metadata`, `description`, `status`, `has_totp`, `folder_id`) SELECT `id`, `create_by`, `update_by`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `name`, `username`, `host_url`, `vault_path`, `current_version`, `metadata`, `description`, `status`, `has_totp`, `folder_id` FROM `warden_secrets`;
+-- drop "warden_secrets" table after copying rows
+DROP TABLE `warden_secrets`;
+-- rename temporary table "new_warden_secrets" to "warden_secrets"
+ALTER TABLE `new_warden_secrets` RENAME TO `warden_secrets`;
+-- create index "secret_tenant_id_folder_id_name" to table: "warden_secrets"
+CREATE UNIQUE INDEX `secret_tenant_id_folder_id_name` ON `warden_secrets` (`tenant_id`, `folder_id`, `name`);
+-- create index "secret_tenant_id" to table: "warden_secrets"
+CREATE INDEX `secret_tenant_id` ON `warden_secrets` (`tenant_id`);
+-- create index "secret_folder_id" to table: "warden_secrets"
+CREATE INDEX `secret_folder_id` ON `warden_secrets` (`folder_id`);
+-- create index "secret_tenant_id_name" to table: "warden_secrets"
+CREATE INDEX `secret_tenant_id_name` ON `warden_secrets` (`tenant_id`, `name`);
+-- create index "secret_tenant_id_username" to table: "warden_secrets"
+CREATE INDEX `secret_tenant_id_username` ON `warden_secrets` (`tenant_id`, `username`);
+-- create index "secret_status" to table: "warden_secrets"
+CREATE INDEX `secret_status` ON `warden_secrets` (`status`);
+-- create index "secret_vault_path" to table: "warden_secrets"
+CREATE UNIQUE INDEX `secret_vault_path` ON `warden_secrets` (`vault_path`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "warden_pending_operations" table
+DROP TABLE `warden_pending_operations`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- create "warden_pending_operations" table
+CREATE TABLE `warden_pending_operations` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `kind` text NOT NULL DEFAULT ('OPERATION_KIND_DESTROY_VAULT_DATA'), `secret_id` text NOT NULL, `vault_path` text NOT NULL, `totp_path` text NULL, `attempts` integer NOT NULL DEFAULT (0), `last_error` text NULL, `next_attempt_at` datetime NOT NULL);
+-- create index "warden_pending_operations_next" to table: "warden_pending_operations"
+CREATE INDEX `warden_pending_operations_next` ON `warden_pending_operations` (`next_attempt_at`);
+-- create index "warden_pending_operations_vault_path" to table: "warden_pending_operations"
+CREATE INDEX `warden_pending_operations_vault_path` ON `warden_pending_operations` (`vault_path`);
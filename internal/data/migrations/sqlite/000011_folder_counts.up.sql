@@ -0,0 +1,28 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_warden_folders" table
+CREATE TABLE `new_warden_folders` (`id` text NOT NULL, `create_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `name` text NOT NULL, `path` text NOT NULL, `description` text NULL, `depth` integer NOT NULL DEFAULT (0), `last_accessed_time` datetime NULL, `secret_count` integer NOT NULL DEFAULT (0), `subfolder_count` integer NOT NULL DEFAULT (0), `parent_id` text NULL, PRIMARY KEY (`id`), CONSTRAINT `warden_folders_warden_folders_children` FOREIGN KEY (`parent_id`) REFERENCES `warden_folders` (`id`) ON DELETE SET NULL);
+-- copy rows from old table "warden_folders" to new temporary table "new_warden_folders"
+INSERT INTO `new_warden_folders` (`id`, `create_by`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `name`, `path`, `description`, `depth`, `last_accessed_time`, `parent_id`) SELECT `id`, `create_by`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `name`, `path`, `description`, `depth`, `last_accessed_time`, `parent_id` FROM `warden_folders`;
+-- drop "warden_folders" table after copying rows
+DROP TABLE `warden_folders`;
+-- rename temporary table "new_warden_folders" to "warden_folders"
+ALTER TABLE `new_warden_folders` RENAME TO `warden_folders`;
+-- create index "folder_tenant_id_parent_id_name" to table: "warden_folders"
+CREATE UNIQUE INDEX `folder_tenant_id_parent_id_name` ON `warden_folders` (`tenant_id`, `parent_id`, `name`);
+-- create index "folder_tenant_id_path" to table: "warden_folders"
+CREATE UNIQUE INDEX `folder_tenant_id_path` ON `warden_folders` (`tenant_id`, `path`);
+-- create index "folder_tenant_id" to table: "warden_folders"
+CREATE INDEX `folder_tenant_id` ON `warden_folders` (`tenant_id`);
+-- create index "folder_parent_id" to table: "warden_folders"
+CREATE INDEX `folder_parent_id` ON `warden_folders` (`parent_id`);
+-- create index "folder_path" to table: "warden_folders"
+CREATE INDEX `folder_path` ON `warden_folders` (`path`);
+-- create index "folder_tenant_id_parent_id_create_time" to table: "warden_folders"
+CREATE INDEX `folder_tenant_id_parent_id_create_time` ON `warden_folders` (`tenant_id`, `parent_id`, `create_time`);
+-- create index "folder_tenant_id_parent_id_update_time" to table: "warden_folders"
+CREATE INDEX `folder_tenant_id_parent_id_update_time` ON `warden_folders` (`tenant_id`, `parent_id`, `update_time`);
+-- create index "folder_tenant_id_parent_id_last_accessed_time" to table: "warden_folders"
+CREATE INDEX `folder_tenant_id_parent_id_last_accessed_time` ON `warden_folders` (`tenant_id`, `parent_id`, `last_accessed_time`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
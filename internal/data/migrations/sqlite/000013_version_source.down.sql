@@ -0,0 +1,18 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_warden_secret_versions" table
+CREATE TABLE `new_warden_secret_versions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `version_number` integer NOT NULL, `vault_path` text NOT NULL, `comment` text NULL, `checksum` text NOT NULL, `secret_id` text NOT NULL, CONSTRAINT `warden_secret_versions_warden_secrets_versions` FOREIGN KEY (`secret_id`) REFERENCES `warden_secrets` (`id`) ON DELETE NO ACTION);
+-- copy rows from old table "warden_secret_versions" to new temporary table "new_warden_secret_versions"
+INSERT INTO `new_warden_secret_versions` (`id`, `create_by`, `create_time`, `update_time`, `delete_time`, `version_number`, `vault_path`, `comment`, `checksum`, `secret_id`) SELECT `id`, `create_by`, `create_time`, `update_time`, `delete_time`, `version_number`, `vault_path`, `comment`, `checksum`, `secret_id` FROM `warden_secret_versions`;
+-- drop "warden_secret_versions" table after copying rows
+DROP TABLE `warden_secret_versions`;
+-- rename temporary table "new_warden_secret_versions" to "warden_secret_versions"
+ALTER TABLE `new_warden_secret_versions` RENAME TO `warden_secret_versions`;
+-- create index "secretversion_secret_id_version_number" to table: "warden_secret_versions"
+CREATE UNIQUE INDEX `secretversion_secret_id_version_number` ON `warden_secret_versions` (`secret_id`, `version_number`);
+-- create index "secretversion_secret_id" to table: "warden_secret_versions"
+CREATE INDEX `secretversion_secret_id` ON `warden_secret_versions` (`secret_id`);
+-- create index "secretversion_vault_path" to table: "warden_secret_versions"
+CREATE INDEX `secretversion_vault_path` ON `warden_secret_versions` (`vault_path`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
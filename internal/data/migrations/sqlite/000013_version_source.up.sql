@@ -0,0 +1,2 @@
+-- add column "source_version" to table: "warden_secret_versions"
+ALTER TABLE `warden_secret_versions` ADD COLUMN `source_version` integer NULL;
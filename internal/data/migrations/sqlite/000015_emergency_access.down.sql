@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "warden_emergency_access" table
+DROP TABLE `warden_emergency_access`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
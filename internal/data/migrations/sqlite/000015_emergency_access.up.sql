@@ -0,0 +1,8 @@
+-- create "warden_emergency_access" table
+CREATE TABLE `warden_emergency_access` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `grantor_id` text NOT NULL, `grantee_id` text NOT NULL, `folder_id` text NOT NULL, `wait_days` integer NOT NULL, `status` text NOT NULL DEFAULT ('EMERGENCY_ACCESS_STATUS_IDLE'), `requested_at` datetime NULL, `granted_at` datetime NULL);
+-- create index "warden_emergency_access_pair" to table: "warden_emergency_access"
+CREATE UNIQUE INDEX `warden_emergency_access_pair` ON `warden_emergency_access` (`tenant_id`, `grantor_id`, `grantee_id`);
+-- create index "warden_emergency_access_grantee" to table: "warden_emergency_access"
+CREATE INDEX `warden_emergency_access_grantee` ON `warden_emergency_access` (`tenant_id`, `grantee_id`);
+-- create index "warden_emergency_access_due" to table: "warden_emergency_access"
+CREATE INDEX `warden_emergency_access_due` ON `warden_emergency_access` (`status`, `requested_at`);
@@ -0,0 +1,2 @@
+-- add column "metadata_schema" to table: "warden_folders"
+ALTER TABLE `warden_folders` ADD COLUMN `metadata_schema` json NULL;
@@ -0,0 +1,2 @@
+-- add column "default_permissions" to table: "warden_folders"
+ALTER TABLE `warden_folders` ADD COLUMN `default_permissions` json NULL;
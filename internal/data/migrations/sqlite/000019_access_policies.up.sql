@@ -0,0 +1,4 @@
+-- add column "access_policy" to table: "warden_folders"
+ALTER TABLE `warden_folders` ADD COLUMN `access_policy` json NULL;
+-- add column "access_policy" to table: "warden_secrets"
+ALTER TABLE `warden_secrets` ADD COLUMN `access_policy` json NULL;
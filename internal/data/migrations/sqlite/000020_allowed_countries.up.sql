@@ -0,0 +1,2 @@
+-- add column "geo_allowed_countries" to table: "warden_tenant_settings"
+ALTER TABLE `warden_tenant_settings` ADD COLUMN `geo_allowed_countries` json NULL;
@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "warden_secret_usage" table
+DROP TABLE `warden_secret_usage`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- create "warden_secret_usage" table
+CREATE TABLE `warden_secret_usage` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `tenant_id` integer NULL DEFAULT (0), `secret_id` text NOT NULL, `day` datetime NOT NULL, `reads` integer NOT NULL DEFAULT (0), `writes` integer NOT NULL DEFAULT (0));
+-- create index "warden_secret_usage_secret_day" to table: "warden_secret_usage"
+CREATE UNIQUE INDEX `warden_secret_usage_secret_day` ON `warden_secret_usage` (`tenant_id`, `secret_id`, `day`);
+-- create index "warden_secret_usage_day" to table: "warden_secret_usage"
+CREATE INDEX `warden_secret_usage_day` ON `warden_secret_usage` (`day`);
@@ -0,0 +1,14 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_warden_tenant_settings" table
+CREATE TABLE `new_warden_tenant_settings` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `update_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `audit_retention_days` integer NOT NULL DEFAULT (0), `export_excluded_tags` json NULL, `export_excluded_folder_ids` json NULL, `password_policy_enabled` bool NOT NULL DEFAULT (false), `password_min_length` integer NOT NULL DEFAULT (0), `password_require_lowercase` bool NOT NULL DEFAULT (false), `password_require_uppercase` bool NOT NULL DEFAULT (false), `password_require_digit` bool NOT NULL DEFAULT (false), `password_require_symbol` bool NOT NULL DEFAULT (false), `password_banned_words` json NULL, `password_max_age_days` integer NOT NULL DEFAULT (0), `password_history_size` integer NOT NULL DEFAULT (0), `geo_allowed_countries` json NULL);
+-- copy rows from old table "warden_tenant_settings" to new temporary table "new_warden_tenant_settings"
+INSERT INTO `new_warden_tenant_settings` (`id`, `update_by`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `audit_retention_days`, `export_excluded_tags`, `export_excluded_folder_ids`, `password_policy_enabled`, `password_min_length`, `password_require_lowercase`, `password_require_uppercase`, `password_require_digit`, `password_require_symbol`, `password_banned_words`, `password_max_age_days`, `password_history_size`, `geo_allowed_countries`) SELECT `id`, `update_by`, `create_time`, `update_time`, `delete_time`, `tenant_id`, `audit_retention_days`, `export_excluded_tags`, `export_excluded_folder_ids`, `password_policy_enabled`, `password_min_length`, `password_require_lowercase`, `password_require_uppercase`, `password_require_digit`, `password_require_symbol`, `password_banned_words`, `password_max_age_days`, `password_history_size`, `geo_allowed_countries` FROM `warden_tenant_settings`;
+-- drop "warden_tenant_settings" table after copying rows
+DROP TABLE `warden_tenant_settings`;
+-- rename temporary table "new_warden_tenant_settings" to "warden_tenant_settings"
+ALTER TABLE `new_warden_tenant_settings` RENAME TO `warden_tenant_settings`;
+-- create index "warden_tenant_settings_tenant_id" to table: "warden_tenant_settings"
+CREATE UNIQUE INDEX `warden_tenant_settings_tenant_id` ON `warden_tenant_settings` (`tenant_id`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "warden_version_pins" table
+DROP TABLE `warden_version_pins`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- create "warden_version_pins" table
+CREATE TABLE `warden_version_pins` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `secret_id` text NOT NULL, `version_number` integer NOT NULL, `token_hash` text NOT NULL, `consumer` text NOT NULL, `last_used_time` datetime NULL);
+-- create index "warden_version_pins_token" to table: "warden_version_pins"
+CREATE UNIQUE INDEX `warden_version_pins_token` ON `warden_version_pins` (`token_hash`);
+-- create index "warden_version_pins_tenant_secret" to table: "warden_version_pins"
+CREATE INDEX `warden_version_pins_tenant_secret` ON `warden_version_pins` (`tenant_id`, `secret_id`);
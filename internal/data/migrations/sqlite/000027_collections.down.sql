@@ -0,0 +1,8 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "warden_collections" table
+DROP TABLE `warden_collections`;
+-- drop "warden_collection_secrets" table
+DROP TABLE `warden_collection_secrets`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,10 @@
+-- create "warden_collections" table
+CREATE TABLE `warden_collections` (`id` text NOT NULL, `create_by` integer NULL, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `name` text NOT NULL, `description` text NULL, `external_id` text NULL, PRIMARY KEY (`id`));
+-- create index "collection_tenant_id_name" to table: "warden_collections"
+CREATE UNIQUE INDEX `collection_tenant_id_name` ON `warden_collections` (`tenant_id`, `name`);
+-- create index "collection_tenant_id" to table: "warden_collections"
+CREATE INDEX `collection_tenant_id` ON `warden_collections` (`tenant_id`);
+-- create index "collection_tenant_id_external_id" to table: "warden_collections"
+CREATE INDEX `collection_tenant_id_external_id` ON `warden_collections` (`tenant_id`, `external_id`);
+-- create "warden_collection_secrets" table
+CREATE TABLE `warden_collection_secrets` (`collection_id` text NOT NULL, `secret_id` text NOT NULL, PRIMARY KEY (`collection_id`, `secret_id`), CONSTRAINT `warden_collection_secrets_collection_id` FOREIGN KEY (`collection_id`) REFERENCES `warden_collections` (`id`) ON DELETE CASCADE, CONSTRAINT `warden_collection_secrets_secret_id` FOREIGN KEY (`secret_id`) REFERENCES `warden_secrets` (`id`) ON DELETE CASCADE);
@@ -0,0 +1,2 @@
+-- add column "referenced_secret_ids" to table: "warden_secrets"
+ALTER TABLE `warden_secrets` ADD COLUMN `referenced_secret_ids` json NULL;
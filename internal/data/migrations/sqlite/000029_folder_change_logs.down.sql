@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "warden_folder_change_logs" table
+DROP TABLE `warden_folder_change_logs`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- create "warden_folder_change_logs" table
+CREATE TABLE `warden_folder_change_logs` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NULL DEFAULT (0), `folder_id` text NOT NULL, `action` text NOT NULL, `old_path` text NULL, `new_path` text NULL, `old_parent_id` text NULL, `new_parent_id` text NULL, `actor_id` text NULL, `details` json NULL);
+-- create index "warden_folder_change_logs_tenant_folder" to table: "warden_folder_change_logs"
+CREATE INDEX `warden_folder_change_logs_tenant_folder` ON `warden_folder_change_logs` (`tenant_id`, `folder_id`);
+-- create index "warden_folder_change_logs_tenant_time" to table: "warden_folder_change_logs"
+CREATE INDEX `warden_folder_change_logs_tenant_time` ON `warden_folder_change_logs` (`tenant_id`, `create_time`);
@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "warden_audit_chain_heads" table
+DROP TABLE `warden_audit_chain_heads`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,4 @@
+-- create "warden_audit_chain_heads" table
+CREATE TABLE `warden_audit_chain_heads` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NULL, `update_time` datetime NULL, `delete_time` datetime NULL, `tenant_id` integer NOT NULL, `seq` integer NOT NULL DEFAULT (0), `hash` text NULL);
+-- create index "warden_audit_chain_heads_tenant_id_key" to table: "warden_audit_chain_heads"
+CREATE UNIQUE INDEX `warden_audit_chain_heads_tenant_id_key` ON `warden_audit_chain_heads` (`tenant_id`);
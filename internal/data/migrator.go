@@ -0,0 +1,582 @@
+package data
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/migrations"
+)
+
+const (
+	// schemaMigrationsTable records the applied migrations
+	schemaMigrationsTable = "warden_schema_migrations"
+	// migrationLockKey serializes migrator runs across instances
+	migrationLockKey     = 7365726
+	migrationLockTimeout = 60
+	// baselineVersion is the migration creating the schema of the last
+	// release that was auto-migrated
+	baselineVersion = 1
+)
+
+// Migration is one versioned schema change with the statements applying and
+// reverting it
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus is a migration and when it was applied, nil when pending.
+// Applied migrations this build does not know have an empty name.
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+}
+
+// SchemaStatus is the migration state of a database
+type SchemaStatus struct {
+	// Tracked is false until the migrator has run against the database
+	Tracked bool
+	// Version is the latest applied migration, 0 for none
+	Version    int64
+	Migrations []MigrationStatus
+	Pending    int
+}
+
+// Migrator applies the versioned migrations embedded in the binary and
+// records them in warden_schema_migrations. A database without Warden tables
+// is migrated from the baseline on. A database created by the ent
+// auto-migration of earlier releases already has the baseline schema, so it
+// is adopted at the baseline migration and migrated from there.
+type Migrator struct {
+	drv        *sql.Driver
+	log        *log.Helper
+	migrations []Migration
+}
+
+// NewMigrator loads the migrations of the driver's dialect
+func NewMigrator(drv *sql.Driver, l *log.Helper) (*Migrator, error) {
+	list, err := loadMigrations(migrationsDir(drv.Dialect()))
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{drv: drv, log: l, migrations: list}, nil
+}
+
+// Dir returns the directory of the migrations package holding the migrations
+// of the database's dialect
+func (m *Migrator) Dir() string {
+	return migrationsDir(m.drv.Dialect())
+}
+
+func migrationsDir(d string) string {
+	switch d {
+	case dialect.Postgres:
+		return "postgres"
+	case dialect.SQLite:
+		return "sqlite"
+	}
+	return "mysql"
+}
+
+// loadMigrations reads and orders the migration files of a directory
+func loadMigrations(dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(migrations.FS, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, e := range entries {
+		name := e.Name()
+		var direction string
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(name, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+
+		num, label, ok := strings.Cut(strings.TrimSuffix(name, "."+direction+".sql"), "_")
+		version, err := strconv.ParseInt(num, 10, 64)
+		if !ok || err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration file name %s", name)
+		}
+		content, err := fs.ReadFile(migrations.FS, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: label}
+			byVersion[version] = m
+		} else if m.Name != label {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, m.Name, label)
+		}
+		if direction == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	list := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down file", m.Version, m.Name)
+		}
+		list = append(list, *m)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	return list, nil
+}
+
+// Latest returns the version of the last migration of this build
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Status returns the state of every migration, oldest first
+func (m *Migrator) Status(ctx context.Context) (*SchemaStatus, error) {
+	tracked, err := m.tableExists(ctx, schemaMigrationsTable)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &SchemaStatus{Tracked: tracked}
+	applied := map[int64]time.Time{}
+	if tracked {
+		if applied, err = m.applied(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	known := make(map[int64]bool, len(m.migrations))
+	for _, mig := range m.migrations {
+		known[mig.Version] = true
+		s := MigrationStatus{Version: mig.Version, Name: mig.Name}
+		if at, ok := applied[mig.Version]; ok {
+			s.AppliedAt = &at
+		} else {
+			status.Pending++
+		}
+		status.Migrations = append(status.Migrations, s)
+	}
+	for version, at := range applied {
+		if !known[version] {
+			status.Migrations = append(status.Migrations, MigrationStatus{Version: version, AppliedAt: &at})
+		}
+		status.Version = max(status.Version, version)
+	}
+	sort.Slice(status.Migrations, func(i, j int) bool {
+		return status.Migrations[i].Version < status.Migrations[j].Version
+	})
+
+	return status, nil
+}
+
+// Up applies pending migrations in order, at most steps of them when steps
+// is positive, and returns how many were applied
+func (m *Migrator) Up(ctx context.Context, steps int) (int, error) {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	tracked, err := m.tableExists(ctx, schemaMigrationsTable)
+	if err != nil {
+		return 0, err
+	}
+	if !tracked {
+		if err := m.init(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, mig := range m.migrations {
+		if _, ok := applied[mig.Version]; ok {
+			continue
+		}
+		if steps > 0 && count == steps {
+			break
+		}
+		if err := m.apply(ctx, mig, true); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	m.ensureIndexes(ctx)
+	return count, nil
+}
+
+// Down reverts the latest applied migrations, steps of them (at least one),
+// and returns how many were reverted
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	if steps <= 0 {
+		steps = 1
+	}
+
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	tracked, err := m.tableExists(ctx, schemaMigrationsTable)
+	if err != nil || !tracked {
+		return 0, err
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	versions := make([]int64, 0, len(applied))
+	for version := range applied {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+
+	count := 0
+	for _, version := range versions {
+		if count == steps {
+			break
+		}
+		mig, ok := m.find(version)
+		if !ok {
+			return count, fmt.Errorf("migration %d was applied by a newer build and cannot be reverted by this one", version)
+		}
+		if err := m.apply(ctx, mig, false); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// Diff returns the migration taking the database, which must be at the
+// latest migration, to the ent schema of this build. Its down statements are
+// left for the author to write. It is empty when there is nothing to change.
+func (m *Migrator) Diff(ctx context.Context, name string) (*Migration, error) {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !status.Tracked || status.Pending > 0 {
+		return nil, errors.New("the database must be at the latest migration, run migrate up first")
+	}
+
+	stmts, err := PendingSchemaChanges(ctx, ent.NewClient(ent.Driver(m.drv)))
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) == 0 {
+		return nil, nil
+	}
+
+	return &Migration{
+		Version: m.Latest() + 1,
+		Name:    name,
+		Up:      strings.Join(stmts, "\n") + "\n",
+		Down:    "-- Statements reverting the up migration, in reverse order\n",
+	}, nil
+}
+
+// init brings an untracked database under versioned migrations. An empty
+// one is left for Up to migrate from the start, one created by
+// auto-migration is recorded at the baseline migration.
+func (m *Migrator) init(ctx context.Context) error {
+	existing, err := m.tableExists(ctx, folder.Table)
+	if err != nil {
+		return err
+	}
+	if err := m.createTable(ctx); err != nil {
+		return err
+	}
+	if !existing {
+		return nil
+	}
+
+	baseline, ok := m.find(baselineVersion)
+	if !ok {
+		return fmt.Errorf("baseline migration %d is missing", baselineVersion)
+	}
+	if err := m.record(ctx, m.drv.DB(), baseline.Version, baseline.Name); err != nil {
+		return err
+	}
+	m.log.Infof("Adopted the existing schema at migration %d_%s", baseline.Version, baseline.Name)
+	return nil
+}
+
+// apply runs one migration up or down in a transaction together with its
+// bookkeeping. MySQL commits DDL implicitly, so a failed MySQL migration may
+// be partly applied.
+func (m *Migrator) apply(ctx context.Context, mig Migration, up bool) error {
+	script, direction := mig.Up, "up"
+	if !up {
+		script, direction = mig.Down, "down"
+	}
+
+	conn, err := m.drv.DB().Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if m.drv.Dialect() == dialect.SQLite {
+		restore, err := disableForeignKeys(ctx, conn)
+		if err != nil {
+			return err
+		}
+		defer restore()
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range splitStatements(script) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("migration %d_%s %s: %w", mig.Version, mig.Name, direction, err)
+		}
+	}
+	if m.drv.Dialect() == dialect.SQLite {
+		if err := checkForeignKeys(ctx, tx); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("migration %d_%s %s: %w", mig.Version, mig.Name, direction, err)
+		}
+	}
+
+	if up {
+		err = m.record(ctx, tx, mig.Version, mig.Name)
+	} else {
+		query, args := sql.Dialect(m.drv.Dialect()).
+			Delete(schemaMigrationsTable).
+			Where(sql.EQ("version", mig.Version)).
+			Query()
+		_, err = tx.ExecContext(ctx, query, args...)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	m.log.Infof("Migration %d_%s %s applied", mig.Version, mig.Name, direction)
+	return nil
+}
+
+// disableForeignKeys turns SQLite's foreign key enforcement off on conn, so
+// migrations can rebuild tables other tables reference. It cannot change
+// inside a transaction, which makes the PRAGMA lines of the scripts no-ops.
+// The returned function turns it back on.
+func disableForeignKeys(ctx context.Context, conn *stdsql.Conn) (func(), error) {
+	var on bool
+	if err := conn.QueryRowContext(ctx, "PRAGMA foreign_keys").Scan(&on); err != nil {
+		return nil, err
+	}
+	if !on {
+		return func() {}, nil
+	}
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+		return nil, err
+	}
+	return func() {
+		_, _ = conn.ExecContext(context.Background(), "PRAGMA foreign_keys = ON")
+	}, nil
+}
+
+// checkForeignKeys fails when a SQLite migration, run without enforcement,
+// left rows referencing missing ones
+func checkForeignKeys(ctx context.Context, tx *stdsql.Tx) error {
+	rows, err := tx.QueryContext(ctx, "PRAGMA foreign_key_check")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var table string
+		var rowID stdsql.NullInt64
+		var parent string
+		var fkid int
+		if err := rows.Scan(&table, &rowID, &parent, &fkid); err != nil {
+			return err
+		}
+		return fmt.Errorf("rows of %s reference missing rows of %s", table, parent)
+	}
+	return rows.Err()
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error)
+}
+
+// record marks a migration as applied
+func (m *Migrator) record(ctx context.Context, db execer, version int64, name string) error {
+	query, args := sql.Dialect(m.drv.Dialect()).
+		Insert(schemaMigrationsTable).
+		Columns("version", "name", "applied_at").
+		Values(version, name, time.Now().Unix()).
+		Query()
+	_, err := db.ExecContext(ctx, query, args...)
+	return err
+}
+
+// applied returns the applied migrations with the time they were applied
+func (m *Migrator) applied(ctx context.Context) (map[int64]time.Time, error) {
+	rows, err := m.drv.DB().QueryContext(ctx, "SELECT version, applied_at FROM "+schemaMigrationsTable)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int64]time.Time)
+	for rows.Next() {
+		var version, at int64
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, err
+		}
+		applied[version] = time.Unix(at, 0)
+	}
+	return applied, rows.Err()
+}
+
+func (m *Migrator) createTable(ctx context.Context) error {
+	_, err := m.drv.DB().ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+schemaMigrationsTable+
+		" (version BIGINT NOT NULL PRIMARY KEY, name VARCHAR(255) NOT NULL, applied_at BIGINT NOT NULL)")
+	return err
+}
+
+// tableExists reports whether a table exists in the current schema
+func (m *Migrator) tableExists(ctx context.Context, table string) (bool, error) {
+	var query string
+	switch m.drv.Dialect() {
+	case dialect.Postgres:
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1"
+	case dialect.SQLite:
+		query = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	default:
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?"
+	}
+
+	var n int
+	if err := m.drv.DB().QueryRowContext(ctx, query, table).Scan(&n); err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// lock takes a database-wide lock so concurrent runs do not interleave. It
+// is held on a dedicated connection until the returned function is called.
+// SQLite databases are local to one process and are not locked.
+func (m *Migrator) lock(ctx context.Context) (func(), error) {
+	if m.drv.Dialect() == dialect.SQLite {
+		return func() {}, nil
+	}
+
+	conn, err := m.drv.DB().Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var lockQuery, unlockQuery string
+	var args []any
+	if m.drv.Dialect() == dialect.Postgres {
+		lockQuery = "SELECT pg_advisory_lock($1)"
+		unlockQuery = "SELECT pg_advisory_unlock($1)"
+		args = []any{migrationLockKey}
+	} else {
+		lockQuery = "SELECT GET_LOCK(?, ?)"
+		unlockQuery = "SELECT RELEASE_LOCK(?)"
+		args = []any{schemaMigrationsTable, migrationLockTimeout}
+	}
+
+	if _, err := conn.ExecContext(ctx, lockQuery, args...); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("lock migrations: %w", err)
+	}
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), unlockQuery, args[0]); err != nil {
+			m.log.Warnf("Failed to release the migration lock: %v", err)
+		}
+		_ = conn.Close()
+	}, nil
+}
+
+// ensureIndexes creates the indexes ent cannot describe
+func (m *Migrator) ensureIndexes(ctx context.Context) {
+	if m.drv.Dialect() != dialect.Postgres {
+		return
+	}
+	if err := ensureSearchIndex(ctx, m.drv); err != nil {
+		m.log.Errorf("failed creating secret search index, search will be slow: %v", err)
+	}
+}
+
+func (m *Migrator) find(version int64) (Migration, bool) {
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return mig, true
+		}
+	}
+	return Migration{}, false
+}
+
+// splitStatements splits a migration script into statements, each ending
+// with a semicolon at the end of a line. Comment lines are dropped.
+func splitStatements(script string) []string {
+	var (
+		stmts []string
+		b     strings.Builder
+	)
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSpace(b.String()))
+			b.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(b.String()); rest != "" {
+		stmts = append(stmts, rest)
+	}
+	return stmts
+}
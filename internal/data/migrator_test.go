@@ -0,0 +1,139 @@
+//go:build sqlite
+
+package data
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+)
+
+func newTestMigrator(t *testing.T, drv *sql.Driver) *Migrator {
+	t.Helper()
+	m, err := NewMigrator(drv, log.NewHelper(log.NewStdLogger(io.Discard)))
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	return m
+}
+
+func TestMigrationsBuildEntSchema(t *testing.T) {
+	ctx := context.Background()
+	drv := openSQLite(t)
+	m := newTestMigrator(t, drv)
+
+	n, err := m.Up(ctx, 0)
+	if err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	if n != len(m.migrations) {
+		t.Fatalf("applied %d migrations, want %d", n, len(m.migrations))
+	}
+
+	stmts, err := PendingSchemaChanges(ctx, ent.NewClient(ent.Driver(drv)))
+	if err != nil {
+		t.Fatalf("diff schema: %v", err)
+	}
+	if len(stmts) > 0 {
+		t.Fatalf("migrated schema differs from the ent schema, add a migration:\n%s", strings.Join(stmts, "\n"))
+	}
+}
+
+func TestMigrationsRevert(t *testing.T) {
+	ctx := context.Background()
+	drv := openSQLite(t)
+	m := newTestMigrator(t, drv)
+
+	if _, err := m.Up(ctx, 0); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	n, err := m.Down(ctx, len(m.migrations))
+	if err != nil {
+		t.Fatalf("migrate down: %v", err)
+	}
+	if n != len(m.migrations) {
+		t.Fatalf("reverted %d migrations, want %d", n, len(m.migrations))
+	}
+	if exists, err := m.tableExists(ctx, folder.Table); err != nil || exists {
+		t.Fatalf("folder table after reverting everything: exists=%v err=%v", exists, err)
+	}
+
+	if _, err := m.Up(ctx, 0); err != nil {
+		t.Fatalf("migrate up again: %v", err)
+	}
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if status.Pending != 0 || status.Version != m.Latest() {
+		t.Fatalf("status after up: version %d, %d pending", status.Version, status.Pending)
+	}
+}
+
+func TestMigratorAdoptsAutoMigratedSchema(t *testing.T) {
+	ctx := context.Background()
+	drv := openSQLite(t)
+	m := newTestMigrator(t, drv)
+
+	// The baseline is the schema the auto-migration of the last release
+	// without migrations left behind, with data written by that release
+	baseline, ok := m.find(baselineVersion)
+	if !ok {
+		t.Fatal("no baseline migration")
+	}
+	seed := append(splitStatements(baseline.Up),
+		"INSERT INTO `warden_folders` (`id`, `tenant_id`, `name`, `path`, `depth`) VALUES ('f1', 1, 'infra', '/infra', 0)",
+		"INSERT INTO `warden_secrets` (`id`, `tenant_id`, `name`, `vault_path`, `current_version`, `folder_id`) VALUES ('s1', 1, 'db', 'warden/1/db', 2, 'f1')",
+		"INSERT INTO `warden_secret_versions` (`version_number`, `vault_path`, `checksum`, `secret_id`) VALUES (2, 'warden/1/db', 'abc', 's1')",
+	)
+	for _, stmt := range seed {
+		if _, err := drv.DB().ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("create baseline schema: %v", err)
+		}
+	}
+
+	n, err := m.Up(ctx, 0)
+	if err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	if n != len(m.migrations)-1 {
+		t.Fatalf("applied %d migrations, want all %d after the baseline", n, len(m.migrations)-1)
+	}
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if status.Pending != 0 || status.Version != m.Latest() {
+		t.Fatalf("status after up: version %d, %d pending", status.Version, status.Pending)
+	}
+
+	client := ent.NewClient(ent.Driver(drv))
+	stmts, err := PendingSchemaChanges(ctx, client)
+	if err != nil {
+		t.Fatalf("diff schema: %v", err)
+	}
+	if len(stmts) > 0 {
+		t.Fatalf("adopted schema differs from the ent schema:\n%s", strings.Join(stmts, "\n"))
+	}
+
+	sysCtx := appViewer.NewSystemViewerContext(ctx)
+	s, err := client.Secret.Query().Where(secret.IDEQ("s1")).WithVersions().Only(sysCtx)
+	if err != nil {
+		t.Fatalf("read the old secret: %v", err)
+	}
+	if s.Name != "db" || s.CurrentVersion != 2 || len(s.Edges.Versions) != 1 {
+		t.Fatalf("old secret after the migrations: %+v", s)
+	}
+	if v := s.Edges.Versions[0]; v.Checksum != "abc" || v.ChecksumAlgorithm != "sha256" {
+		t.Fatalf("old version after the migrations: %+v", v)
+	}
+}
@@ -0,0 +1,163 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+)
+
+// Password policy violation codes
+const (
+	PasswordViolationMinLength  = "min_length"
+	PasswordViolationLowercase  = "require_lowercase"
+	PasswordViolationUppercase  = "require_uppercase"
+	PasswordViolationDigit      = "require_digit"
+	PasswordViolationSymbol     = "require_symbol"
+	PasswordViolationBannedWord = "banned_word"
+	PasswordViolationReused     = "reused"
+	PasswordViolationMaxAge     = "max_age"
+)
+
+// PasswordViolation is one rule of a password policy that a password breaks
+type PasswordViolation struct {
+	Code    string
+	Message string
+}
+
+// PasswordPolicy holds the password rules of a tenant
+type PasswordPolicy struct {
+	MinLength        int
+	RequireLowercase bool
+	RequireUppercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	BannedWords      []string
+	MaxAgeDays       int
+	HistorySize      int
+}
+
+// PasswordPolicyFromSetting extracts the password policy of a tenant setting.
+// Returns nil when the policy is not enabled.
+func PasswordPolicyFromSetting(setting *ent.TenantSetting) *PasswordPolicy {
+	if setting == nil || !setting.PasswordPolicyEnabled {
+		return nil
+	}
+	return &PasswordPolicy{
+		MinLength:        int(setting.PasswordMinLength),
+		RequireLowercase: setting.PasswordRequireLowercase,
+		RequireUppercase: setting.PasswordRequireUppercase,
+		RequireDigit:     setting.PasswordRequireDigit,
+		RequireSymbol:    setting.PasswordRequireSymbol,
+		BannedWords:      setting.PasswordBannedWords,
+		MaxAgeDays:       int(setting.PasswordMaxAgeDays),
+		HistorySize:      int(setting.PasswordHistorySize),
+	}
+}
+
+// GetPasswordPolicy loads the password policy of a tenant. Returns nil when
+// the tenant has no enabled policy.
+func (r *TenantSettingRepo) GetPasswordPolicy(ctx context.Context, tenantID uint32) (*PasswordPolicy, error) {
+	setting, err := r.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return PasswordPolicyFromSetting(setting), nil
+}
+
+// Check returns the length, character class and banned word rules the password breaks
+func (p *PasswordPolicy) Check(password string) []PasswordViolation {
+	if p == nil {
+		return nil
+	}
+
+	var violations []PasswordViolation
+
+	if p.MinLength > 0 && utf8.RuneCountInString(password) < p.MinLength {
+		violations = append(violations, PasswordViolation{
+			Code:    PasswordViolationMinLength,
+			Message: fmt.Sprintf("password must be at least %d characters long", p.MinLength),
+		})
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+	for _, c := range password {
+		switch {
+		case unicode.IsLower(c):
+			hasLower = true
+		case unicode.IsUpper(c):
+			hasUpper = true
+		case unicode.IsDigit(c):
+			hasDigit = true
+		case unicode.IsPunct(c) || unicode.IsSymbol(c) || unicode.IsSpace(c):
+			hasSymbol = true
+		}
+	}
+	if p.RequireLowercase && !hasLower {
+		violations = append(violations, PasswordViolation{Code: PasswordViolationLowercase, Message: "password must contain a lowercase letter"})
+	}
+	if p.RequireUppercase && !hasUpper {
+		violations = append(violations, PasswordViolation{Code: PasswordViolationUppercase, Message: "password must contain an uppercase letter"})
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, PasswordViolation{Code: PasswordViolationDigit, Message: "password must contain a digit"})
+	}
+	if p.RequireSymbol && !hasSymbol {
+		violations = append(violations, PasswordViolation{Code: PasswordViolationSymbol, Message: "password must contain a symbol"})
+	}
+
+	lower := strings.ToLower(password)
+	for _, word := range p.BannedWords {
+		if word != "" && strings.Contains(lower, strings.ToLower(word)) {
+			violations = append(violations, PasswordViolation{
+				Code:    PasswordViolationBannedWord,
+				Message: "password contains a banned word",
+			})
+			break
+		}
+	}
+
+	return violations
+}
+
+// CheckReuse reports a violation when the password matches one of the given
+// version checksums, newest first; only the last HistorySize are considered.
+// Checksums made with another checksum key cannot be checked and never match.
+func (p *PasswordPolicy) CheckReuse(password string, checksums []vault.Checksum, checksummer *vault.Checksummer) *PasswordViolation {
+	if p == nil || p.HistorySize <= 0 {
+		return nil
+	}
+	if len(checksums) > p.HistorySize {
+		checksums = checksums[:p.HistorySize]
+	}
+
+	for _, c := range checksums {
+		if checksummer.Matches(c, password) {
+			return &PasswordViolation{
+				Code:    PasswordViolationReused,
+				Message: fmt.Sprintf("password was used in the last %d versions", p.HistorySize),
+			}
+		}
+	}
+	return nil
+}
+
+// CheckAge reports a violation when a password last changed at the given
+// time is older than MaxAgeDays
+func (p *PasswordPolicy) CheckAge(changedAt, now time.Time) *PasswordViolation {
+	if p == nil || p.MaxAgeDays <= 0 {
+		return nil
+	}
+	if now.Sub(changedAt) > time.Duration(p.MaxAgeDays)*24*time.Hour {
+		return &PasswordViolation{
+			Code:    PasswordViolationMaxAge,
+			Message: fmt.Sprintf("password is older than %d days", p.MaxAgeDays),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/pendingoperation"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// PendingVaultWriteGrace is how long the intent of a Vault write stays
+// untouched before the worker treats the request that made it as lost. It
+// must exceed the longest request that writes Vault data before committing.
+const PendingVaultWriteGrace = 10 * time.Minute
+
+// PendingOperationRepo is the outbox keeping Vault and the database
+// consistent. Vault writes cannot join a database transaction, so:
+//
+//   - before new Vault data is written, BeginVaultWrite records an intent to
+//     destroy it, and CommitVaultWrite removes the intent in the transaction
+//     that inserts the referencing rows. A crash in between leaves the intent,
+//     and the worker destroys the unreferenced data.
+//   - ScheduleVaultDestroy records the destruction of a secret's Vault data in
+//     the transaction that deletes its rows, so the data cannot outlive them.
+type PendingOperationRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	kvStore   *vault.KVStore
+	log       *log.Helper
+	rowLocks  bool
+}
+
+// NewPendingOperationRepo creates a new PendingOperationRepo
+func NewPendingOperationRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], kvStore *vault.KVStore) *PendingOperationRepo {
+	return &PendingOperationRepo{
+		entClient: entClient,
+		kvStore:   kvStore,
+		log:       ctx.NewLoggerHelper("warden/pending_operation_repo"),
+		rowLocks:  supportsRowLocks(ctx),
+	}
+}
+
+// BeginVaultWrite records the intent to write new Vault data for a secret.
+// It must be called outside the transaction that references the data.
+func (r *PendingOperationRepo) BeginVaultWrite(ctx context.Context, tenantID uint32, secretID, vaultPath string) (*ent.PendingOperation, error) {
+	return r.create(ctx, r.entClient.Client(), tenantID, secretID, vaultPath, "", time.Now().Add(PendingVaultWriteGrace))
+}
+
+// CommitVaultWrite removes a write intent inside the transaction inserting the
+// rows that reference the data. It fails when the worker already destroyed
+// the data, which must roll the transaction back.
+func (r *PendingOperationRepo) CommitVaultWrite(ctx context.Context, op *ent.PendingOperation) error {
+	n, err := dbClient(ctx, r.entClient).PendingOperation.Delete().
+		Where(pendingoperation.IDEQ(op.ID)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("resolve pending operation failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("resolve pending operation failed")
+	}
+	if n == 0 {
+		r.log.Errorf("pending Vault write %d for %s was already cleaned up", op.ID, op.VaultPath)
+		return wardenV1.ErrorInternalServerError("vault data was cleaned up before commit")
+	}
+	return nil
+}
+
+// AbortVaultWrite destroys the data of a write intent whose transaction did
+// not commit. Failures are left to the worker.
+func (r *PendingOperationRepo) AbortVaultWrite(ctx context.Context, op *ent.PendingOperation) {
+	if err := r.Process(ctx, op); err != nil {
+		r.log.Warnf("Failed to clean up Vault path %s, leaving it to the outbox worker: %v", op.VaultPath, err)
+	}
+}
+
+// ScheduleVaultDestroy records the destruction of a deleted secret's Vault
+// data. It must be called in the transaction deleting the secret's rows.
+func (r *PendingOperationRepo) ScheduleVaultDestroy(ctx context.Context, tenantID uint32, secretID, vaultPath, totpPath string) (*ent.PendingOperation, error) {
+	return r.create(ctx, dbClient(ctx, r.entClient), tenantID, secretID, vaultPath, totpPath, time.Now())
+}
+
+func (r *PendingOperationRepo) create(ctx context.Context, client *ent.Client, tenantID uint32, secretID, vaultPath, totpPath string, notBefore time.Time) (*ent.PendingOperation, error) {
+	entity, err := client.PendingOperation.Create().
+		SetTenantID(tenantID).
+		SetKind(pendingoperation.KindOPERATION_KIND_DESTROY_VAULT_DATA).
+		SetSecretID(secretID).
+		SetVaultPath(vaultPath).
+		SetTotpPath(totpPath).
+		SetNextAttemptAt(notBefore).
+		SetCreateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("create pending operation failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("create pending operation failed")
+	}
+	return entity, nil
+}
+
+// Process carries out an operation unless it was resolved meanwhile. The row
+// stays locked until the Vault data is gone, so a concurrent CommitVaultWrite
+// waits and then fails instead of committing a reference to destroyed data.
+// Vault data still referenced by a secret is kept.
+func (r *PendingOperationRepo) Process(ctx context.Context, op *ent.PendingOperation) error {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	n, err := tx.PendingOperation.Delete().
+		Where(pendingoperation.IDEQ(op.ID)).
+		Exec(ctx)
+	if err != nil {
+		rollback()
+		return fmt.Errorf("resolve pending operation: %w", err)
+	}
+	if n == 0 {
+		rollback()
+		return nil
+	}
+
+	referenced, err := tx.Secret.Query().
+		Where(secret.VaultPathEQ(op.VaultPath)).
+		Exist(ctx)
+	if err != nil {
+		rollback()
+		return fmt.Errorf("check vault path references: %w", err)
+	}
+
+	if !referenced {
+		if err := r.kvStore.DestroyAllVersions(ctx, op.VaultPath); err != nil {
+			rollback()
+			return err
+		}
+		if op.TotpPath != "" {
+			if err := r.kvStore.DeleteTotp(ctx, op.TotpPath); err != nil {
+				rollback()
+				return err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ClaimDue locks due operations and pushes their next attempt out by lease,
+// so concurrent workers do not process the same operation twice
+func (r *PendingOperationRepo) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*ent.PendingOperation, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("claim pending operations failed")
+	}
+
+	query := tx.PendingOperation.Query().
+		Where(pendingoperation.NextAttemptAtLTE(now)).
+		Order(ent.Asc(pendingoperation.FieldNextAttemptAt)).
+		Limit(limit)
+	entities, err := forUpdate(query, r.rowLocks, sql.WithLockAction(sql.SkipLocked)).All(ctx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		r.log.Errorf("query due pending operations failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("claim pending operations failed")
+	}
+
+	if len(entities) > 0 {
+		ids := make([]uint32, 0, len(entities))
+		for _, e := range entities {
+			ids = append(ids, e.ID)
+		}
+		if err := tx.PendingOperation.Update().
+			Where(pendingoperation.IDIn(ids...)).
+			SetNextAttemptAt(now.Add(lease)).
+			Exec(ctx); err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				r.log.Errorf("rollback failed: %s", rbErr.Error())
+			}
+			r.log.Errorf("lease pending operations failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("claim pending operations failed")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("claim pending operations failed")
+	}
+	return entities, nil
+}
+
+// RecordFailure stores a failed attempt and when to retry
+func (r *PendingOperationRepo) RecordFailure(ctx context.Context, id uint32, attempts int32, attemptErr string, nextAttempt time.Time) error {
+	if err := r.entClient.Client().PendingOperation.UpdateOneID(id).
+		SetAttempts(attempts).
+		SetLastError(truncate(attemptErr, 1024)).
+		SetNextAttemptAt(nextAttempt).
+		SetUpdateTime(time.Now()).
+		Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		r.log.Errorf("record pending operation failure failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("record pending operation failure failed")
+	}
+	return nil
+}
@@ -31,7 +31,7 @@ func NewPermissionRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent
 
 // Create creates a new permission
 func (r *PermissionRepo) Create(ctx context.Context, tenantID uint32, resourceType, resourceID string, relation string, subjectType, subjectID string, grantedBy *uint32, expiresAt *time.Time) (*ent.Permission, error) {
-	builder := r.entClient.Client().Permission.Create().
+	builder := dbClient(ctx, r.entClient).Permission.Create().
 		SetTenantID(tenantID).
 		SetResourceType(permission.ResourceType(resourceType)).
 		SetResourceID(resourceID).
@@ -62,7 +62,7 @@ func (r *PermissionRepo) Create(ctx context.Context, tenantID uint32, resourceTy
 // GetDirectPermissions returns permissions directly on a resource, excluding expired ones
 func (r *PermissionRepo) GetDirectPermissions(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string) ([]authz.PermissionTuple, error) {
 	now := time.Now()
-	entities, err := r.entClient.Client().Permission.Query().
+	entities, err := dbClient(ctx, r.entClient).Permission.Query().
 		Where(
 			permission.TenantIDEQ(tenantID),
 			permission.ResourceTypeEQ(permission.ResourceType(resourceType)),
@@ -89,7 +89,7 @@ func (r *PermissionRepo) GetDirectPermissions(ctx context.Context, tenantID uint
 // GetSubjectPermissions returns all non-expired permissions for a subject
 func (r *PermissionRepo) GetSubjectPermissions(ctx context.Context, tenantID uint32, subjectType authz.SubjectType, subjectID string) ([]authz.PermissionTuple, error) {
 	now := time.Now()
-	entities, err := r.entClient.Client().Permission.Query().
+	entities, err := dbClient(ctx, r.entClient).Permission.Query().
 		Where(
 			permission.TenantIDEQ(tenantID),
 			permission.SubjectTypeEQ(permission.SubjectType(subjectType)),
@@ -116,7 +116,7 @@ func (r *PermissionRepo) GetSubjectPermissions(ctx context.Context, tenantID uin
 // HasPermission checks if a specific non-expired permission exists
 func (r *PermissionRepo) HasPermission(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string, subjectType authz.SubjectType, subjectID string) (*authz.PermissionTuple, error) {
 	now := time.Now()
-	entity, err := r.entClient.Client().Permission.Query().
+	entity, err := dbClient(ctx, r.entClient).Permission.Query().
 		Where(
 			permission.TenantIDEQ(tenantID),
 			permission.ResourceTypeEQ(permission.ResourceType(resourceType)),
@@ -154,7 +154,7 @@ func (r *PermissionRepo) CreatePermission(ctx context.Context, tuple authz.Permi
 
 // DeletePermission deletes a permission
 func (r *PermissionRepo) DeletePermission(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string, relation *authz.Relation, subjectType authz.SubjectType, subjectID string) error {
-	query := r.entClient.Client().Permission.Delete().
+	query := dbClient(ctx, r.entClient).Permission.Delete().
 		Where(
 			permission.TenantIDEQ(tenantID),
 			permission.ResourceTypeEQ(permission.ResourceType(resourceType)),
@@ -179,7 +179,7 @@ func (r *PermissionRepo) DeletePermission(ctx context.Context, tenantID uint32,
 // ListResourcesBySubject lists resources accessible by a subject, excluding expired permissions
 func (r *PermissionRepo) ListResourcesBySubject(ctx context.Context, tenantID uint32, subjectType authz.SubjectType, subjectID string, resourceType authz.ResourceType) ([]string, error) {
 	now := time.Now()
-	entities, err := r.entClient.Client().Permission.Query().
+	entities, err := dbClient(ctx, r.entClient).Permission.Query().
 		Where(
 			permission.TenantIDEQ(tenantID),
 			permission.SubjectTypeEQ(permission.SubjectType(subjectType)),
@@ -205,9 +205,10 @@ func (r *PermissionRepo) ListResourcesBySubject(ctx context.Context, tenantID ui
 	return ids, nil
 }
 
-// List lists permissions with optional filters
-func (r *PermissionRepo) List(ctx context.Context, tenantID uint32, resourceType *string, resourceID *string, subjectType *string, subjectID *string, page, pageSize uint32) ([]*ent.Permission, int, error) {
-	query := r.entClient.Client().Permission.Query().
+// List lists permissions with optional filters, newest first. With a cursor
+// the page starts after the cursor's permission and page is ignored.
+func (r *PermissionRepo) List(ctx context.Context, tenantID uint32, resourceType *string, resourceID *string, subjectType *string, subjectID *string, after *Cursor, page, pageSize uint32) ([]*ent.Permission, int, error) {
+	query := dbClient(ctx, r.entClient).Permission.Query().
 		Where(permission.TenantIDEQ(tenantID))
 
 	if resourceType != nil && *resourceType != "" {
@@ -231,13 +232,29 @@ func (r *PermissionRepo) List(ctx context.Context, tenantID uint32, resourceType
 	}
 
 	// Apply pagination
-	if page > 0 && pageSize > 0 {
+	if after != nil {
+		createTime, err := after.timeKey()
+		if err != nil {
+			return nil, 0, err
+		}
+		id, err := after.intID()
+		if err != nil {
+			return nil, 0, err
+		}
+		query = query.Where(permission.Or(
+			permission.CreateTimeLT(createTime),
+			permission.And(permission.CreateTimeEQ(createTime), permission.IDLT(int(id))),
+		))
+		if pageSize > 0 {
+			query = query.Limit(int(pageSize))
+		}
+	} else if page > 0 && pageSize > 0 {
 		offset := int((page - 1) * pageSize)
 		query = query.Offset(offset).Limit(int(pageSize))
 	}
 
 	entities, err := query.
-		Order(ent.Desc(permission.FieldCreateTime)).
+		Order(ent.Desc(permission.FieldCreateTime), ent.Desc(permission.FieldID)).
 		All(ctx)
 	if err != nil {
 		r.log.Errorf("list permissions failed: %s", err.Error())
@@ -249,7 +266,7 @@ func (r *PermissionRepo) List(ctx context.Context, tenantID uint32, resourceType
 
 // DeleteByResource deletes all permissions for a resource
 func (r *PermissionRepo) DeleteByResource(ctx context.Context, tenantID uint32, resourceType, resourceID string) error {
-	_, err := r.entClient.Client().Permission.Delete().
+	_, err := dbClient(ctx, r.entClient).Permission.Delete().
 		Where(
 			permission.TenantIDEQ(tenantID),
 			permission.ResourceTypeEQ(permission.ResourceType(resourceType)),
@@ -263,6 +280,39 @@ func (r *PermissionRepo) DeleteByResource(ctx context.Context, tenantID uint32,
 	return nil
 }
 
+// ListBySubject returns all permissions granted to a subject, expired ones included
+func (r *PermissionRepo) ListBySubject(ctx context.Context, tenantID uint32, subjectType authz.SubjectType, subjectID string) ([]*ent.Permission, error) {
+	entities, err := dbClient(ctx, r.entClient).Permission.Query().
+		Where(
+			permission.TenantIDEQ(tenantID),
+			permission.SubjectTypeEQ(permission.SubjectType(subjectType)),
+			permission.SubjectIDEQ(subjectID),
+		).
+		Order(ent.Asc(permission.FieldResourceType), ent.Asc(permission.FieldResourceID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list permissions by subject failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list permissions failed")
+	}
+	return entities, nil
+}
+
+// DeleteBySubject deletes all permissions granted to a subject and returns how many were deleted
+func (r *PermissionRepo) DeleteBySubject(ctx context.Context, tenantID uint32, subjectType authz.SubjectType, subjectID string) (int, error) {
+	n, err := dbClient(ctx, r.entClient).Permission.Delete().
+		Where(
+			permission.TenantIDEQ(tenantID),
+			permission.SubjectTypeEQ(permission.SubjectType(subjectType)),
+			permission.SubjectIDEQ(subjectID),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete permissions by subject failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("delete permissions failed")
+	}
+	return n, nil
+}
+
 // toAuthzTuple converts an ent.Permission to authz.PermissionTuple
 func (r *PermissionRepo) toAuthzTuple(entity *ent.Permission) authz.PermissionTuple {
 	tuple := authz.PermissionTuple{
@@ -301,6 +351,8 @@ func (r *PermissionRepo) ToProto(entity *ent.Permission) *wardenV1.PermissionTup
 		proto.ResourceType = wardenV1.ResourceType_RESOURCE_TYPE_FOLDER
 	case permission.ResourceTypeRESOURCE_TYPE_SECRET:
 		proto.ResourceType = wardenV1.ResourceType_RESOURCE_TYPE_SECRET
+	case permission.ResourceTypeRESOURCE_TYPE_COLLECTION:
+		proto.ResourceType = wardenV1.ResourceType_RESOURCE_TYPE_COLLECTION
 	default:
 		proto.ResourceType = wardenV1.ResourceType_RESOURCE_TYPE_UNSPECIFIED
 	}
@@ -17,12 +17,28 @@ import (
 var ProviderSet = wire.NewSet(
 	data.NewRedisClient,
 	data.NewEntClient,
+	data.NewReadReplica,
 	data.NewVaultClient,
 	data.NewVaultKVStore,
+	data.NewFieldCipher,
 	data.NewFolderRepo,
 	data.NewSecretRepo,
 	data.NewSecretVersionRepo,
 	data.NewPermissionRepo,
 	data.NewAuditLogRepo,
 	data.NewStatisticsRepo,
+	data.NewTenantSettingRepo,
+	data.NewSecurityAlertRepo,
+	data.NewWebhookRepo,
+	data.NewWebhookDeliveryRepo,
+	data.NewMaintenanceRepo,
+	data.NewTransactor,
+	data.NewPendingOperationRepo,
+	data.NewEmergencyAccessRepo,
+	data.NewRetrievalTokenStore,
+	data.NewSecretUsageRepo,
+	data.NewVersionPinRepo,
+	data.NewDeletionRequestRepo,
+	data.NewCollectionRepo,
+	data.NewFolderChangeLogRepo,
 )
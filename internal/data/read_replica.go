@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+	"os"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+)
+
+// ReadReplica is an optional read-only connection to a database replica.
+// Read-only repository methods (lists, searches, lookups by ID, statistics
+// and exports) use it so heavy read traffic stays off the primary; writes and
+// everything inside a transaction always go to the primary. Without a
+// replica configured every query goes to the primary.
+//
+// Replicas lag behind the primary, so code reading back what it just wrote
+// must use WithPrimary.
+type ReadReplica struct {
+	client *ent.Client
+	log    *log.Helper
+}
+
+type primaryCtxKey struct{}
+
+// WithPrimary returns a context whose reads go to the primary database
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryCtxKey{}, true)
+}
+
+// NewReadReplica connects to the replica at DATABASE_REPLICA_DSN using the
+// driver of the primary database. Without the variable no replica is used.
+func NewReadReplica(ctx *bootstrap.Context) (*ReadReplica, func(), error) {
+	l := ctx.NewLoggerHelper("ent/data/warden-replica")
+	rr := &ReadReplica{log: l}
+
+	dsn := os.Getenv("DATABASE_REPLICA_DSN")
+	if dsn == "" {
+		return rr, func() {}, nil
+	}
+
+	cfg := ctx.GetConfig()
+	if cfg == nil || cfg.Data == nil || cfg.Data.Database == nil {
+		l.Warn("DATABASE_REPLICA_DSN set without a database config, reading from the primary")
+		return rr, func() {}, nil
+	}
+
+	drv, err := sql.Open(entDialect(cfg.Data.Database.GetDriver()), dsn)
+	if err != nil {
+		l.Errorf("failed opening read replica: %v", err)
+		return nil, func() {}, err
+	}
+
+	rr.client = ent.NewClient(ent.Driver(drv))
+	rr.client.Intercept(entTracingInterceptor())
+	l.Info("Read replica enabled")
+
+	return rr, func() {
+		if err := rr.client.Close(); err != nil {
+			l.Error(err)
+		}
+	}, nil
+}
+
+// readClient returns the client for a read-only query: the transaction on
+// ctx if there is one, the primary if asked for by WithPrimary or without a
+// replica, and the replica otherwise
+func (rr *ReadReplica) readClient(ctx context.Context, primary *entCrud.EntClient[*ent.Client]) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	if rr == nil || rr.client == nil {
+		return primary.Client()
+	}
+	if forced, _ := ctx.Value(primaryCtxKey{}).(bool); forced {
+		return primary.Client()
+	}
+	return rr.client
+}
@@ -0,0 +1,99 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+const retrievalTokenKeyPrefix = "warden:retrieval_token:"
+
+// RetrievalToken is the secret version a one-time retrieval token reads and
+// the user it was issued to
+type RetrievalToken struct {
+	TenantID uint32 `json:"tenant_id"`
+	UserID   string `json:"user_id"`
+	SecretID string `json:"secret_id"`
+	Version  int32  `json:"version"`
+}
+
+// RetrievalTokenStore keeps one-time retrieval tokens in Redis until they are
+// redeemed or expire. Only a hash of each token is stored, so the keys cannot
+// be redeemed by someone reading Redis.
+type RetrievalTokenStore struct {
+	redis *redis.Client
+	log   *log.Helper
+}
+
+// NewRetrievalTokenStore creates a new RetrievalTokenStore
+func NewRetrievalTokenStore(ctx *bootstrap.Context, redisClient *redis.Client) *RetrievalTokenStore {
+	return &RetrievalTokenStore{
+		redis: redisClient,
+		log:   ctx.NewLoggerHelper("warden/retrieval_token_store"),
+	}
+}
+
+// Issue stores t under a new random token valid for ttl and returns the token
+func (s *RetrievalTokenStore) Issue(ctx context.Context, t RetrievalToken, ttl time.Duration) (string, error) {
+	if s.redis == nil {
+		return "", wardenV1.ErrorServiceUnavailable("retrieval tokens are not available")
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		s.log.Errorf("generate retrieval token failed: %s", err.Error())
+		return "", wardenV1.ErrorInternalServerError("issue retrieval token failed")
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	value, err := json.Marshal(t)
+	if err != nil {
+		return "", wardenV1.ErrorInternalServerError("issue retrieval token failed")
+	}
+	if err := s.redis.Set(ctx, retrievalTokenKey(token), value, ttl).Err(); err != nil {
+		s.log.Errorf("store retrieval token failed: %s", err.Error())
+		return "", wardenV1.ErrorInternalServerError("issue retrieval token failed")
+	}
+	return token, nil
+}
+
+// Redeem deletes a token and returns what it reads. It returns nil for
+// unknown, expired and already redeemed tokens; of concurrent redemptions
+// only one gets the token.
+func (s *RetrievalTokenStore) Redeem(ctx context.Context, token string) (*RetrievalToken, error) {
+	if s.redis == nil {
+		return nil, wardenV1.ErrorServiceUnavailable("retrieval tokens are not available")
+	}
+
+	value, err := s.redis.GetDel(ctx, retrievalTokenKey(token)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		s.log.Errorf("redeem retrieval token failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("redeem retrieval token failed")
+	}
+
+	var t RetrievalToken
+	if err := json.Unmarshal(value, &t); err != nil {
+		s.log.Errorf("decode retrieval token failed: %s", err.Error())
+		return nil, nil
+	}
+	return &t, nil
+}
+
+func retrievalTokenKey(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return retrievalTokenKeyPrefix + hex.EncodeToString(hash[:])
+}
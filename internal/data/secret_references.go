@@ -0,0 +1,101 @@
+package data
+
+import (
+	"regexp"
+	"sort"
+)
+
+// MaxSecretReferences is how many distinct secrets the metadata of one
+// secret may reference
+const MaxSecretReferences = 50
+
+// secretReferencePattern matches {{secret:<id>.<field>}}, spaces allowed
+// inside the braces
+var secretReferencePattern = regexp.MustCompile(`\{\{\s*secret:([A-Fa-f0-9\-]{36})\.(username|host_url|name)\s*\}\}`)
+
+// SecretReference is one {{secret:<id>.<field>}} found in metadata
+type SecretReference struct {
+	SecretID string
+	Field    string
+}
+
+// ParseSecretReferences returns the references in the string values of
+// metadata, nested maps and lists included
+func ParseSecretReferences(metadata map[string]any) []SecretReference {
+	var refs []SecretReference
+	walkMetadataStrings(metadata, func(s string) {
+		for _, m := range secretReferencePattern.FindAllStringSubmatch(s, -1) {
+			refs = append(refs, SecretReference{SecretID: m[1], Field: m[2]})
+		}
+	})
+	return refs
+}
+
+// ReferencedSecretIDs returns the sorted, distinct IDs of the secrets
+// referenced from metadata
+func ReferencedSecretIDs(metadata map[string]any) []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, ref := range ParseSecretReferences(metadata) {
+		if !seen[ref.SecretID] {
+			seen[ref.SecretID] = true
+			ids = append(ids, ref.SecretID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ResolveSecretReferences returns a copy of metadata with every reference
+// replaced by what resolve returns for it. References resolve declines are
+// left as they are.
+func ResolveSecretReferences(metadata map[string]any, resolve func(ref SecretReference) (string, bool)) map[string]any {
+	if metadata == nil {
+		return nil
+	}
+	return mapMetadataStrings(metadata, func(s string) string {
+		return secretReferencePattern.ReplaceAllStringFunc(s, func(match string) string {
+			m := secretReferencePattern.FindStringSubmatch(match)
+			if value, ok := resolve(SecretReference{SecretID: m[1], Field: m[2]}); ok {
+				return value
+			}
+			return match
+		})
+	}).(map[string]any)
+}
+
+func walkMetadataStrings(v any, fn func(string)) {
+	switch t := v.(type) {
+	case string:
+		fn(t)
+	case map[string]any:
+		for _, item := range t {
+			walkMetadataStrings(item, fn)
+		}
+	case []any:
+		for _, item := range t {
+			walkMetadataStrings(item, fn)
+		}
+	}
+}
+
+func mapMetadataStrings(v any, fn func(string) string) any {
+	switch t := v.(type) {
+	case string:
+		return fn(t)
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, item := range t {
+			out[k] = mapMetadataStrings(item, fn)
+		}
+		return out
+	case []any:
+		out := make([]any, len(t))
+		for i, item := range t {
+			out[i] = mapMetadataStrings(item, fn)
+		}
+		return out
+	default:
+		return v
+	}
+}
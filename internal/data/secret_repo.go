@@ -2,8 +2,12 @@ package data
 
 import (
 	"context"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
@@ -12,8 +16,11 @@ import (
 
 	entCrud "github.com/tx7do/go-crud/entgo"
 
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/collection"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
 
 	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
@@ -23,25 +30,39 @@ import (
 type SecretInfo struct {
 	ID        string
 	VaultPath string
+	FolderID  *string
+	HasTotp   bool
 }
 
 type SecretRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
+	replica   *ReadReplica
 	log       *log.Helper
+	// fullText enables PostgreSQL full-text search
+	fullText bool
 }
 
-func NewSecretRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *SecretRepo {
-	return &SecretRepo{
+func NewSecretRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], replica *ReadReplica) *SecretRepo {
+	repo := &SecretRepo{
 		log:       ctx.NewLoggerHelper("secret/repo"),
 		entClient: entClient,
+		replica:   replica,
 	}
+	if cfg := ctx.GetConfig(); cfg != nil && cfg.Data != nil && cfg.Data.Database != nil {
+		repo.fullText = isPostgresDriver(cfg.Data.Database.GetDriver())
+	}
+	return repo
 }
 
 // Create creates a new secret
 func (r *SecretRepo) Create(ctx context.Context, tenantID uint32, folderID *string, name, username, hostURL, vaultPath, description string, metadata map[string]any, createdBy *uint32) (*ent.Secret, error) {
 	id := uuid.New().String()
 
-	builder := r.entClient.Client().Secret.Create().
+	if err := r.releaseName(ctx, tenantID, folderID, name, id); err != nil {
+		return nil, err
+	}
+
+	builder := dbClient(ctx, r.entClient).Secret.Create().
 		SetID(id).
 		SetTenantID(tenantID).
 		SetName(name).
@@ -64,6 +85,9 @@ func (r *SecretRepo) Create(ctx context.Context, tenantID uint32, folderID *stri
 	}
 	if metadata != nil {
 		builder.SetMetadata(metadata)
+		if refs := ReferencedSecretIDs(metadata); len(refs) > 0 {
+			builder.SetReferencedSecretIds(refs)
+		}
 	}
 	if createdBy != nil {
 		builder.SetCreateBy(*createdBy)
@@ -78,13 +102,19 @@ func (r *SecretRepo) Create(ctx context.Context, tenantID uint32, folderID *stri
 		return nil, wardenV1.ErrorInternalServerError("create secret failed")
 	}
 
+	if err := addFolderSecretCount(ctx, dbClient(ctx, r.entClient), tenantID, folderID, 1); err != nil {
+		r.log.Errorf("update folder secret count failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("create secret failed")
+	}
+
 	return entity, nil
 }
 
-// GetByID retrieves a secret by ID
-func (r *SecretRepo) GetByID(ctx context.Context, id string) (*ent.Secret, error) {
-	entity, err := r.entClient.Client().Secret.Query().
-		Where(secret.IDEQ(id)).
+// GetByID retrieves a secret by ID within a tenant. A secret of another
+// tenant is reported as not found.
+func (r *SecretRepo) GetByID(ctx context.Context, tenantID uint32, id string) (*ent.Secret, error) {
+	entity, err := r.replica.readClient(ctx, r.entClient).Secret.Query().
+		Where(secret.IDEQ(id), secret.TenantIDEQ(tenantID)).
 		WithFolder().
 		Only(ctx)
 	if err != nil {
@@ -97,35 +127,52 @@ func (r *SecretRepo) GetByID(ctx context.Context, id string) (*ent.Secret, error
 	return entity, nil
 }
 
-// GetByIDAndTenant retrieves a secret by ID with tenant isolation enforced.
-// Use this in service-layer calls where tenant context is available.
-func (r *SecretRepo) GetByIDAndTenant(ctx context.Context, tenantID uint32, id string) (*ent.Secret, error) {
-	entity, err := r.entClient.Client().Secret.Query().
-		Where(secret.IDEQ(id), secret.TenantIDEQ(tenantID)).
-		WithFolder().
-		Only(ctx)
+// GetByTenantAndName retrieves a live (not deleted) secret by tenant ID,
+// folder ID, and name
+func (r *SecretRepo) GetByTenantAndName(ctx context.Context, tenantID uint32, folderID *string, name string) (*ent.Secret, error) {
+	query := dbClient(ctx, r.entClient).Secret.Query().
+		Where(
+			secret.TenantIDEQ(tenantID),
+			secret.NameEQ(name),
+			secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED),
+		)
+
+	if folderID != nil && *folderID != "" {
+		query = query.Where(secret.FolderIDEQ(*folderID))
+	} else {
+		query = query.Where(secret.FolderIDIsNil())
+	}
+
+	entity, err := query.Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, nil
 		}
-		r.log.Errorf("get secret failed: %s", err.Error())
+		r.log.Errorf("get secret by name failed: %s", err.Error())
 		return nil, wardenV1.ErrorInternalServerError("get secret failed")
 	}
 	return entity, nil
 }
 
-// GetByTenantAndName retrieves a secret by tenant ID, folder ID, and name
-func (r *SecretRepo) GetByTenantAndName(ctx context.Context, tenantID uint32, folderID *string, name string) (*ent.Secret, error) {
-	query := r.entClient.Client().Secret.Query().
+// GetByPath retrieves a live secret by its folder path ("/Team/Infra", empty
+// or "/" for the root) and name. The folder is matched in the same query
+// rather than resolved first.
+func (r *SecretRepo) GetByPath(ctx context.Context, tenantID uint32, folderPath, name string) (*ent.Secret, error) {
+	query := r.replica.readClient(ctx, r.entClient).Secret.Query().
 		Where(
 			secret.TenantIDEQ(tenantID),
 			secret.NameEQ(name),
+			secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED),
 		)
 
-	if folderID != nil && *folderID != "" {
-		query = query.Where(secret.FolderIDEQ(*folderID))
-	} else {
+	folderPath = strings.TrimSuffix(folderPath, "/")
+	if folderPath == "" {
 		query = query.Where(secret.FolderIDIsNil())
+	} else {
+		if !strings.HasPrefix(folderPath, "/") {
+			folderPath = "/" + folderPath
+		}
+		query = query.Where(secret.HasFolderWith(folder.TenantIDEQ(tenantID), folder.PathEQ(folderPath)))
 	}
 
 	entity, err := query.Only(ctx)
@@ -133,15 +180,88 @@ func (r *SecretRepo) GetByTenantAndName(ctx context.Context, tenantID uint32, fo
 		if ent.IsNotFound(err) {
 			return nil, nil
 		}
-		r.log.Errorf("get secret by name failed: %s", err.Error())
+		r.log.Errorf("get secret by path failed: %s", err.Error())
 		return nil, wardenV1.ErrorInternalServerError("get secret failed")
 	}
 	return entity, nil
 }
 
-// List lists secrets with optional filters
-func (r *SecretRepo) List(ctx context.Context, tenantID uint32, folderID *string, status *secret.Status, nameFilter *string, page, pageSize uint32) ([]*ent.Secret, int, error) {
-	query := r.entClient.Client().Secret.Query().
+// List lists secrets with optional filters in name order. Deleted secrets are
+// only listed when status asks for them; notAccessedSince keeps the secrets
+// whose password was not read since then, including never-read ones. With a
+// cursor the page starts after the cursor's secret and page is ignored.
+// projection limits what is loaded of each row.
+func (r *SecretRepo) List(ctx context.Context, tenantID uint32, folderID *string, status *secret.Status, nameFilter *string, notAccessedSince *time.Time, selector LabelSelector, order ListSort, after *Cursor, page, pageSize uint32, projection ListProjection) ([]*ent.Secret, int, error) {
+	query := r.listQuery(ctx, tenantID, folderID, status, nameFilter, notAccessedSince, selector)
+	return r.paginate(ctx, query, order, after, page, pageSize, projection)
+}
+
+// ListAccessible lists like List, restricted to secrets a subject can read:
+// the secrets in secretIDs, every secret in the folders of folderIDs and
+// their subfolders, and every secret in the collections of collectionIDs.
+// The restriction is applied before paginating, so pages
+// are full and the total counts only accessible secrets.
+func (r *SecretRepo) ListAccessible(ctx context.Context, tenantID uint32, secretIDs, folderIDs, collectionIDs []string, folderID *string, status *secret.Status, nameFilter *string, notAccessedSince *time.Time, selector LabelSelector, order ListSort, after *Cursor, page, pageSize uint32, projection ListProjection) ([]*ent.Secret, int, error) {
+	inherited, err := r.expandFolderIDs(ctx, tenantID, folderIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(secretIDs) == 0 && len(inherited) == 0 && len(collectionIDs) == 0 {
+		return []*ent.Secret{}, 0, nil
+	}
+
+	query := r.listQuery(ctx, tenantID, folderID, status, nameFilter, notAccessedSince, selector).
+		Where(secret.Or(
+			secret.IDIn(secretIDs...),
+			secret.FolderIDIn(inherited...),
+			secret.HasCollectionsWith(collection.TenantIDEQ(tenantID), collection.IDIn(collectionIDs...)),
+		))
+	return r.paginate(ctx, query, order, after, page, pageSize, projection)
+}
+
+// expandFolderIDs returns the given folders and all their descendants
+func (r *SecretRepo) expandFolderIDs(ctx context.Context, tenantID uint32, folderIDs []string) ([]string, error) {
+	if len(folderIDs) == 0 {
+		return nil, nil
+	}
+
+	client := r.replica.readClient(ctx, r.entClient)
+	granted, err := client.Folder.Query().
+		Where(folder.TenantIDEQ(tenantID), folder.IDIn(folderIDs...)).
+		Select(folder.FieldID, folder.FieldPath).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list accessible folders failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list secrets failed")
+	}
+	if len(granted) == 0 {
+		return nil, nil
+	}
+
+	prefixes := make([]predicate.Folder, 0, len(granted))
+	ids := make([]string, 0, len(granted))
+	for _, f := range granted {
+		ids = append(ids, f.ID)
+		prefixes = append(prefixes, folder.PathHasPrefix(f.Path+"/"))
+	}
+
+	descendants, err := client.Folder.Query().
+		Where(folder.TenantIDEQ(tenantID), folder.Or(prefixes...)).
+		Select(folder.FieldID).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list accessible subfolders failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list secrets failed")
+	}
+	for _, d := range descendants {
+		ids = append(ids, d.ID)
+	}
+	return ids, nil
+}
+
+// listQuery builds the filtered secret query shared by List and ListAccessible
+func (r *SecretRepo) listQuery(ctx context.Context, tenantID uint32, folderID *string, status *secret.Status, nameFilter *string, notAccessedSince *time.Time, selector LabelSelector) *ent.SecretQuery {
+	query := r.replica.readClient(ctx, r.entClient).Secret.Query().
 		Where(secret.TenantIDEQ(tenantID))
 
 	if folderID != nil {
@@ -155,28 +275,79 @@ func (r *SecretRepo) List(ctx context.Context, tenantID uint32, folderID *string
 
 	if status != nil {
 		query = query.Where(secret.StatusEQ(*status))
+	} else {
+		query = query.Where(secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED))
 	}
 
 	if nameFilter != nil && *nameFilter != "" {
 		query = query.Where(secret.NameContainsFold(*nameFilter))
 	}
 
+	if notAccessedSince != nil {
+		query = query.Where(secret.Or(
+			secret.LastAccessedTimeIsNil(),
+			secret.LastAccessedTimeLT(*notAccessedSince),
+		))
+	}
+
+	if len(selector) > 0 {
+		query = query.Where(selector.predicate())
+	}
+
+	return query
+}
+
+// ListBySelector returns the non-deleted secrets matching a label selector,
+// with their folders, in name order. At most limit+1 are returned so callers
+// can tell when there are more than limit.
+func (r *SecretRepo) ListBySelector(ctx context.Context, tenantID uint32, selector LabelSelector, limit int) ([]*ent.Secret, error) {
+	entities, err := r.listQuery(ctx, tenantID, nil, nil, nil, nil, selector).
+		WithFolder().
+		Order(ent.Asc(secret.FieldName), ent.Asc(secret.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list secrets by label selector failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list secrets failed")
+	}
+	return entities, nil
+}
+
+// paginate counts the matches of a query and loads one page of them
+func (r *SecretRepo) paginate(ctx context.Context, query *ent.SecretQuery, order ListSort, after *Cursor, page, pageSize uint32, projection ListProjection) ([]*ent.Secret, int, error) {
 	// Count total
 	total, err := query.Clone().Count(ctx)
 	if err != nil {
 		r.log.Errorf("count secrets failed: %s", err.Error())
 		return nil, 0, wardenV1.ErrorInternalServerError("count secrets failed")
 	}
+	if projection == ProjectCount {
+		return nil, total, nil
+	}
 
 	// Apply pagination
-	if page > 0 && pageSize > 0 {
+	if after != nil {
+		afterRow, err := order.afterSelector(after, secret.FieldID)
+		if err != nil {
+			return nil, 0, err
+		}
+		query = query.Where(afterRow)
+		if pageSize > 0 {
+			query = query.Limit(int(pageSize))
+		}
+	} else if page > 0 && pageSize > 0 {
 		offset := int((page - 1) * pageSize)
 		query = query.Offset(offset).Limit(int(pageSize))
 	}
 
+	if projection == ProjectIDs {
+		query = query.Select(order.keyColumns(secret.FieldID)...).SecretQuery
+	} else {
+		query = query.WithFolder()
+	}
+
 	entities, err := query.
-		WithFolder().
-		Order(ent.Asc(secret.FieldName)).
+		Order(order.orderSelector(secret.FieldID)).
 		All(ctx)
 	if err != nil {
 		r.log.Errorf("list secrets failed: %s", err.Error())
@@ -186,10 +357,12 @@ func (r *SecretRepo) List(ctx context.Context, tenantID uint32, folderID *string
 	return entities, total, nil
 }
 
-// Update updates a secret's metadata (tenant-scoped)
-func (r *SecretRepo) Update(ctx context.Context, tenantID uint32, id string, name, username, hostURL, description *string, metadata map[string]any, status *secret.Status, updatedBy *uint32) (*ent.Secret, error) {
+// Update updates a secret's metadata (tenant-scoped).
+// With expectedRevision set, the update only applies to that revision and
+// fails with PRECONDITION_FAILED once the secret was changed by someone else.
+func (r *SecretRepo) Update(ctx context.Context, tenantID uint32, id string, name, username, hostURL, description *string, metadata map[string]any, status *secret.Status, sensitive *bool, expectedRevision *int64, updatedBy *uint32) (*ent.Secret, error) {
 	// Use query-based update to enforce tenant isolation
-	entity, err := r.entClient.Client().Secret.Query().
+	entity, err := dbClient(ctx, r.entClient).Secret.Query().
 		Where(secret.IDEQ(id), secret.TenantIDEQ(tenantID)).
 		Only(ctx)
 	if err != nil {
@@ -200,9 +373,24 @@ func (r *SecretRepo) Update(ctx context.Context, tenantID uint32, id string, nam
 		return nil, wardenV1.ErrorInternalServerError("update secret failed")
 	}
 
+	if expectedRevision != nil && entity.Revision != *expectedRevision {
+		return nil, wardenV1.ErrorPreconditionFailed("secret was changed since revision %d", *expectedRevision)
+	}
+
+	if name != nil && *name != entity.Name {
+		if err := r.releaseName(ctx, tenantID, entity.FolderID, *name, id); err != nil {
+			return nil, err
+		}
+	}
+
 	builder := entity.Update().
+		AddRevision(1).
 		SetUpdateTime(time.Now())
 
+	if expectedRevision != nil {
+		// Guards against a change committed after the read above
+		builder.Where(secret.RevisionEQ(*expectedRevision))
+	}
 	if name != nil {
 		builder.SetName(*name)
 	}
@@ -217,10 +405,18 @@ func (r *SecretRepo) Update(ctx context.Context, tenantID uint32, id string, nam
 	}
 	if metadata != nil {
 		builder.SetMetadata(metadata)
+		if refs := ReferencedSecretIDs(metadata); len(refs) > 0 {
+			builder.SetReferencedSecretIds(refs)
+		} else {
+			builder.ClearReferencedSecretIds()
+		}
 	}
 	if status != nil {
 		builder.SetStatus(*status)
 	}
+	if sensitive != nil {
+		builder.SetSensitive(*sensitive)
+	}
 	if updatedBy != nil {
 		builder.SetUpdateBy(*updatedBy)
 	}
@@ -230,19 +426,69 @@ func (r *SecretRepo) Update(ctx context.Context, tenantID uint32, id string, nam
 		if ent.IsConstraintError(saveErr) {
 			return nil, wardenV1.ErrorSecretAlreadyExists("secret with this name already exists")
 		}
+		if ent.IsNotFound(saveErr) && expectedRevision != nil {
+			return nil, wardenV1.ErrorPreconditionFailed("secret was changed since revision %d", *expectedRevision)
+		}
 		r.log.Errorf("update secret failed: %s", saveErr.Error())
 		return nil, wardenV1.ErrorInternalServerError("update secret failed")
 	}
 
+	// Deleting or restoring through a status change moves the secret in or
+	// out of its folder's count
+	if wasLive, isLive := isLiveSecret(entity.Status), isLiveSecret(updated.Status); wasLive != isLive {
+		delta := int32(1)
+		if !isLive {
+			delta = -1
+		}
+		if err := addFolderSecretCount(ctx, dbClient(ctx, r.entClient), tenantID, updated.FolderID, delta); err != nil {
+			r.log.Errorf("update folder secret count failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("update secret failed")
+		}
+	}
+
 	return updated, nil
 }
 
+// TouchLastAccessed records that the passwords of secrets were read at the
+// given time, on the secrets and on their folders. Timestamps only move forward.
+func (r *SecretRepo) TouchLastAccessed(ctx context.Context, tenantID uint32, secretIDs, folderIDs []string, at time.Time) error {
+	if len(secretIDs) > 0 {
+		if err := dbClient(ctx, r.entClient).Secret.Update().
+			Where(
+				secret.IDIn(secretIDs...),
+				secret.TenantIDEQ(tenantID),
+				secret.Or(secret.LastAccessedTimeIsNil(), secret.LastAccessedTimeLT(at)),
+			).
+			SetLastAccessedTime(at).
+			Exec(ctx); err != nil {
+			r.log.Errorf("record secret access failed: %s", err.Error())
+			return wardenV1.ErrorInternalServerError("record secret access failed")
+		}
+	}
+
+	if len(folderIDs) > 0 {
+		if err := dbClient(ctx, r.entClient).Folder.Update().
+			Where(
+				folder.IDIn(folderIDs...),
+				folder.TenantIDEQ(tenantID),
+				folder.Or(folder.LastAccessedTimeIsNil(), folder.LastAccessedTimeLT(at)),
+			).
+			SetLastAccessedTime(at).
+			Exec(ctx); err != nil {
+			r.log.Errorf("record folder access failed: %s", err.Error())
+			return wardenV1.ErrorInternalServerError("record folder access failed")
+		}
+	}
+	return nil
+}
+
 // UpdateVersion updates the current version of a secret (tenant-scoped)
 // SetHasTotp updates the has_totp flag on a secret.
 func (r *SecretRepo) SetHasTotp(ctx context.Context, tenantID uint32, id string, hasTotp bool) error {
-	_, err := r.entClient.Client().Secret.Update().
+	_, err := dbClient(ctx, r.entClient).Secret.Update().
 		Where(secret.IDEQ(id), secret.TenantIDEQ(tenantID)).
 		SetHasTotp(hasTotp).
+		AddRevision(1).
 		SetUpdateTime(time.Now()).
 		Save(ctx)
 	if err != nil {
@@ -252,9 +498,50 @@ func (r *SecretRepo) SetHasTotp(ctx context.Context, tenantID uint32, id string,
 	return nil
 }
 
+// SetCanary marks a secret as a canary or back. The flag is not part of the
+// secret's content, so the revision is left alone.
+func (r *SecretRepo) SetCanary(ctx context.Context, tenantID uint32, id string, canary bool) error {
+	_, err := dbClient(ctx, r.entClient).Secret.Update().
+		Where(secret.IDEQ(id), secret.TenantIDEQ(tenantID)).
+		SetCanary(canary).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("set canary failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("update canary failed")
+	}
+	return nil
+}
+
+// SetProtected sets whether permanently deleting a secret needs a second
+// owner's approval. Like the canary flag it leaves the revision alone.
+func (r *SecretRepo) SetProtected(ctx context.Context, tenantID uint32, id string, protected bool) error {
+	_, err := dbClient(ctx, r.entClient).Secret.Update().
+		Where(secret.IDEQ(id), secret.TenantIDEQ(tenantID)).
+		SetProtected(protected).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("set protected failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("update protected failed")
+	}
+	return nil
+}
+
+// SetPasswordEncoding records how the current password of a secret is encoded
+func (r *SecretRepo) SetPasswordEncoding(ctx context.Context, tenantID uint32, id string, encoding secret.PasswordEncoding) error {
+	_, err := dbClient(ctx, r.entClient).Secret.Update().
+		Where(secret.IDEQ(id), secret.TenantIDEQ(tenantID)).
+		SetPasswordEncoding(encoding).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("set password encoding failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("update password encoding failed")
+	}
+	return nil
+}
+
 func (r *SecretRepo) UpdateVersion(ctx context.Context, tenantID uint32, id string, version int32, updatedBy *uint32) (*ent.Secret, error) {
 	// Verify secret belongs to tenant before updating
-	entity, err := r.entClient.Client().Secret.Query().
+	entity, err := dbClient(ctx, r.entClient).Secret.Query().
 		Where(secret.IDEQ(id), secret.TenantIDEQ(tenantID)).
 		Only(ctx)
 	if err != nil {
@@ -267,6 +554,7 @@ func (r *SecretRepo) UpdateVersion(ctx context.Context, tenantID uint32, id stri
 
 	builder := entity.Update().
 		SetCurrentVersion(version).
+		AddRevision(1).
 		SetUpdateTime(time.Now())
 
 	if updatedBy != nil {
@@ -285,7 +573,7 @@ func (r *SecretRepo) UpdateVersion(ctx context.Context, tenantID uint32, id stri
 // Move moves a secret to a different folder (tenant-scoped)
 func (r *SecretRepo) Move(ctx context.Context, tenantID uint32, id string, newFolderID *string, updatedBy *uint32) (*ent.Secret, error) {
 	// Verify secret belongs to tenant before updating
-	entity, err := r.entClient.Client().Secret.Query().
+	entity, err := dbClient(ctx, r.entClient).Secret.Query().
 		Where(secret.IDEQ(id), secret.TenantIDEQ(tenantID)).
 		Only(ctx)
 	if err != nil {
@@ -296,7 +584,12 @@ func (r *SecretRepo) Move(ctx context.Context, tenantID uint32, id string, newFo
 		return nil, wardenV1.ErrorInternalServerError("move secret failed")
 	}
 
+	if err := r.releaseName(ctx, tenantID, newFolderID, entity.Name, id); err != nil {
+		return nil, err
+	}
+
 	builder := entity.Update().
+		AddRevision(1).
 		SetUpdateTime(time.Now())
 
 	if newFolderID != nil && *newFolderID != "" {
@@ -318,13 +611,25 @@ func (r *SecretRepo) Move(ctx context.Context, tenantID uint32, id string, newFo
 		return nil, wardenV1.ErrorInternalServerError("move secret failed")
 	}
 
+	if isLiveSecret(entity.Status) {
+		client := dbClient(ctx, r.entClient)
+		if err := addFolderSecretCount(ctx, client, tenantID, entity.FolderID, -1); err != nil {
+			r.log.Errorf("update folder secret count failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("move secret failed")
+		}
+		if err := addFolderSecretCount(ctx, client, tenantID, moved.FolderID, 1); err != nil {
+			r.log.Errorf("update folder secret count failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("move secret failed")
+		}
+	}
+
 	return moved, nil
 }
 
 // Delete deletes a secret (soft or permanent, tenant-scoped)
 func (r *SecretRepo) Delete(ctx context.Context, tenantID uint32, id string, permanent bool) error {
 	// Verify secret belongs to tenant
-	entity, err := r.entClient.Client().Secret.Query().
+	entity, err := dbClient(ctx, r.entClient).Secret.Query().
 		Where(secret.IDEQ(id), secret.TenantIDEQ(tenantID)).
 		Only(ctx)
 	if err != nil {
@@ -336,41 +641,131 @@ func (r *SecretRepo) Delete(ctx context.Context, tenantID uint32, id string, per
 	}
 
 	if permanent {
-		if delErr := r.entClient.Client().Secret.DeleteOne(entity).Exec(ctx); delErr != nil {
+		if delErr := dbClient(ctx, r.entClient).Secret.DeleteOne(entity).Exec(ctx); delErr != nil {
 			r.log.Errorf("delete secret failed: %s", delErr.Error())
 			return wardenV1.ErrorInternalServerError("delete secret failed")
 		}
 	} else {
 		if _, softErr := entity.Update().
 			SetStatus(secret.StatusSECRET_STATUS_DELETED).
+			AddRevision(1).
 			SetUpdateTime(time.Now()).
 			Save(ctx); softErr != nil {
 			r.log.Errorf("soft delete secret failed: %s", softErr.Error())
 			return wardenV1.ErrorInternalServerError("delete secret failed")
 		}
 	}
+
+	if isLiveSecret(entity.Status) {
+		if err := addFolderSecretCount(ctx, dbClient(ctx, r.entClient), tenantID, entity.FolderID, -1); err != nil {
+			r.log.Errorf("update folder secret count failed: %s", err.Error())
+			return wardenV1.ErrorInternalServerError("delete secret failed")
+		}
+	}
 	return nil
 }
 
-// Search searches secrets by query
-func (r *SecretRepo) Search(ctx context.Context, tenantID uint32, query string, folderID *string, includeSubfolders bool, status *secret.Status, page, pageSize uint32) ([]*ent.Secret, int, error) {
-	q := r.entClient.Client().Secret.Query().
+// releaseName renames soft-deleted secrets holding a name in a folder, so a
+// live secret (other than exceptID) can take it without hitting the unique
+// (tenant, folder, name) index. The tombstones stay in the trash under a
+// suffixed name until they are purged.
+func (r *SecretRepo) releaseName(ctx context.Context, tenantID uint32, folderID *string, name, exceptID string) error {
+	query := dbClient(ctx, r.entClient).Secret.Query().
+		Where(
+			secret.TenantIDEQ(tenantID),
+			secret.NameEQ(name),
+			secret.StatusEQ(secret.StatusSECRET_STATUS_DELETED),
+			secret.IDNEQ(exceptID),
+		)
+	if folderID != nil && *folderID != "" {
+		query = query.Where(secret.FolderIDEQ(*folderID))
+	} else {
+		query = query.Where(secret.FolderIDIsNil())
+	}
+
+	tombstones, err := query.All(ctx)
+	if err != nil {
+		r.log.Errorf("query deleted secrets by name failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("release secret name failed")
+	}
+
+	for _, t := range tombstones {
+		if err := dbClient(ctx, r.entClient).Secret.Update().
+			Where(secret.IDEQ(t.ID), secret.TenantIDEQ(tenantID)).
+			SetName(tombstoneName(t.Name, t.ID)).
+			AddRevision(1).
+			Exec(ctx); err != nil {
+			r.log.Errorf("rename deleted secret %s failed: %s", t.ID, err.Error())
+			return wardenV1.ErrorInternalServerError("release secret name failed")
+		}
+	}
+	return nil
+}
+
+// tombstoneName suffixes the name of a deleted secret with the start of its
+// ID, trimming the name to stay within the column limit
+func tombstoneName(name, id string) string {
+	suffix := " (deleted " + id[:min(8, len(id))] + ")"
+	const maxLen = 255
+	if len(name)+len(suffix) > maxLen {
+		cut := maxLen - len(suffix)
+		for cut > 0 && !utf8.RuneStart(name[cut]) {
+			cut--
+		}
+		name = name[:cut]
+	}
+	return name + suffix
+}
+
+// Search searches secrets by query, best matches first. On PostgreSQL every
+// word of the query must prefix-match the name, username, URL, description,
+// tags or custom field values, ranked by field; other databases fall back to
+// substring matching ordered by name. Deleted secrets are only searched when
+// status asks for them.
+func (r *SecretRepo) Search(ctx context.Context, tenantID uint32, query string, folderID *string, includeSubfolders bool, status *secret.Status, page, pageSize uint32, projection ListProjection) ([]*ent.Secret, int, error) {
+	q := r.replica.readClient(ctx, r.entClient).Secret.Query().
 		Where(secret.TenantIDEQ(tenantID))
 
 	// Add search predicates
-	searchPredicate := secret.Or(
-		secret.NameContainsFold(query),
-		secret.UsernameContainsFold(query),
-		secret.HostURLContainsFold(query),
-		secret.DescriptionContainsFold(query),
-	)
-	q = q.Where(searchPredicate)
+	terms := searchTerms(query)
+	var rank secret.OrderOption
+	switch {
+	case len(terms) == 0:
+		q = q.Where(secret.Or(
+			secret.NameContainsFold(query),
+			secret.UsernameContainsFold(query),
+			secret.HostURLContainsFold(query),
+			secret.DescriptionContainsFold(query),
+		))
+	case r.fullText:
+		q = q.Where(fullTextPredicate(terms))
+		rank = fullTextRankOrder(terms)
+	default:
+		q = q.Where(substringPredicate(terms))
+	}
 
 	if folderID != nil && *folderID != "" {
 		if includeSubfolders {
-			// This would need path-based search if folders have paths
-			// For now, just search in the specified folder
-			q = q.Where(secret.FolderIDEQ(*folderID))
+			// Expand the subtree through the materialized path so the whole
+			// hierarchy is searched in a single query, however deep it is
+			root, err := r.replica.readClient(ctx, r.entClient).Folder.Query().
+				Where(folder.IDEQ(*folderID), folder.TenantIDEQ(tenantID)).
+				Select(folder.FieldPath).
+				Only(ctx)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					return []*ent.Secret{}, 0, nil
+				}
+				r.log.Errorf("get search folder failed: %s", err.Error())
+				return nil, 0, wardenV1.ErrorInternalServerError("search secrets failed")
+			}
+			q = q.Where(secret.HasFolderWith(
+				folder.TenantIDEQ(tenantID),
+				folder.Or(
+					folder.IDEQ(*folderID),
+					folder.PathHasPrefix(root.Path+"/"),
+				),
+			))
 		} else {
 			q = q.Where(secret.FolderIDEQ(*folderID))
 		}
@@ -378,6 +773,8 @@ func (r *SecretRepo) Search(ctx context.Context, tenantID uint32, query string,
 
 	if status != nil {
 		q = q.Where(secret.StatusEQ(*status))
+	} else {
+		q = q.Where(secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED))
 	}
 
 	// Count total
@@ -386,6 +783,9 @@ func (r *SecretRepo) Search(ctx context.Context, tenantID uint32, query string,
 		r.log.Errorf("count search results failed: %s", err.Error())
 		return nil, 0, wardenV1.ErrorInternalServerError("search secrets failed")
 	}
+	if projection == ProjectCount {
+		return nil, total, nil
+	}
 
 	// Apply pagination
 	if page > 0 && pageSize > 0 {
@@ -393,8 +793,17 @@ func (r *SecretRepo) Search(ctx context.Context, tenantID uint32, query string,
 		q = q.Offset(offset).Limit(int(pageSize))
 	}
 
+	if rank != nil {
+		q = q.Order(rank)
+	}
+
+	if projection == ProjectIDs {
+		q = q.Select(secret.FieldID, secret.FieldName).SecretQuery
+	} else {
+		q = q.WithFolder()
+	}
+
 	entities, err := q.
-		WithFolder().
 		Order(ent.Asc(secret.FieldName)).
 		All(ctx)
 	if err != nil {
@@ -405,9 +814,10 @@ func (r *SecretRepo) Search(ctx context.Context, tenantID uint32, query string,
 	return entities, total, nil
 }
 
-// GetSecretFolderID returns the folder ID for a secret (implements ResourceLookup interface)
+// GetSecretFolderID returns the folder ID for a secret (implements ResourceLookup interface).
+// Authorization must not act on stale data, so it always reads the primary.
 func (r *SecretRepo) GetSecretFolderID(ctx context.Context, tenantID uint32, secretID string) (*string, error) {
-	s, err := r.GetByIDAndTenant(ctx, tenantID, secretID)
+	s, err := r.GetByID(WithPrimary(ctx), tenantID, secretID)
 	if err != nil {
 		return nil, err
 	}
@@ -417,9 +827,59 @@ func (r *SecretRepo) GetSecretFolderID(ctx context.Context, tenantID uint32, sec
 	return s.FolderID, nil
 }
 
+// ListFolderIDsInCollections returns the folders holding secrets of the
+// given collections that are not in the trash
+func (r *SecretRepo) ListFolderIDsInCollections(ctx context.Context, tenantID uint32, collectionIDs []string) ([]string, error) {
+	if len(collectionIDs) == 0 {
+		return nil, nil
+	}
+	ids, err := r.replica.readClient(ctx, r.entClient).Secret.Query().
+		Where(
+			secret.TenantIDEQ(tenantID),
+			secret.FolderIDNotNil(),
+			secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED),
+			secret.HasCollectionsWith(collection.TenantIDEQ(tenantID), collection.IDIn(collectionIDs...)),
+		).
+		Unique(true).
+		Select(secret.FieldFolderID).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("list collection folders failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list collection folders failed")
+	}
+	return ids, nil
+}
+
+// SetAccessPolicy sets the access policy of a secret (nil removes it)
+func (r *SecretRepo) SetAccessPolicy(ctx context.Context, tenantID uint32, id string, policy *authz.AccessPolicy, updatedBy *uint32) (*ent.Secret, error) {
+	builder := r.entClient.Client().Secret.Update().
+		Where(secret.IDEQ(id), secret.TenantIDEQ(tenantID)).
+		AddRevision(1).
+		SetUpdateTime(time.Now())
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+	if policy.IsEmpty() {
+		builder.ClearAccessPolicy()
+	} else {
+		builder.SetAccessPolicy(policy)
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("set secret access policy failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("update secret failed")
+	}
+	if affected == 0 {
+		return nil, wardenV1.ErrorSecretNotFound("secret not found")
+	}
+
+	return r.GetByID(WithPrimary(ctx), tenantID, id)
+}
+
 // ListAll returns all secrets for a tenant (for export operations)
 func (r *SecretRepo) ListAll(ctx context.Context, tenantID uint32) ([]*ent.Secret, error) {
-	entities, err := r.entClient.Client().Secret.Query().
+	entities, err := r.replica.readClient(ctx, r.entClient).Secret.Query().
 		Where(secret.TenantIDEQ(tenantID)).
 		Where(secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED)).
 		WithFolder().
@@ -432,10 +892,32 @@ func (r *SecretRepo) ListAll(ctx context.Context, tenantID uint32) ([]*ent.Secre
 	return entities, nil
 }
 
+// ListDependents returns the secrets, deleted ones excepted, whose metadata
+// references the given secret
+func (r *SecretRepo) ListDependents(ctx context.Context, tenantID uint32, id string) ([]*ent.Secret, error) {
+	entities, err := r.replica.readClient(ctx, r.entClient).Secret.Query().
+		Where(
+			secret.TenantIDEQ(tenantID),
+			secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED),
+			secret.IDNEQ(id),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(secret.FieldReferencedSecretIds, id))
+			},
+		).
+		WithFolder().
+		Order(ent.Asc(secret.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list dependent secrets failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list dependent secrets failed")
+	}
+	return entities, nil
+}
+
 // ListAllInFolderTree returns all secrets in a folder and its subfolders
 func (r *SecretRepo) ListAllInFolderTree(ctx context.Context, tenantID uint32, folderID string) ([]*ent.Secret, error) {
 	// Get the folder to get its path (tenant-scoped)
-	f, err := r.entClient.Client().Folder.Query().
+	f, err := r.replica.readClient(ctx, r.entClient).Folder.Query().
 		Where(folder.IDEQ(folderID), folder.TenantIDEQ(tenantID)).
 		Only(ctx)
 	if err != nil {
@@ -450,7 +932,7 @@ func (r *SecretRepo) ListAllInFolderTree(ctx context.Context, tenantID uint32, f
 	folderIDs := []string{folderID}
 
 	// Get subfolders recursively using path prefix (tenant-scoped)
-	folders, err := r.entClient.Client().Folder.Query().
+	folders, err := r.replica.readClient(ctx, r.entClient).Folder.Query().
 		Where(folder.TenantIDEQ(tenantID), folder.PathHasPrefix(f.Path+"/")).
 		All(ctx)
 
@@ -460,7 +942,7 @@ func (r *SecretRepo) ListAllInFolderTree(ctx context.Context, tenantID uint32, f
 		}
 	}
 
-	entities, err := r.entClient.Client().Secret.Query().
+	entities, err := r.replica.readClient(ctx, r.entClient).Secret.Query().
 		Where(secret.TenantIDEQ(tenantID)).
 		Where(secret.StatusNEQ(secret.StatusSECRET_STATUS_DELETED)).
 		Where(secret.FolderIDIn(folderIDs...)).
@@ -474,6 +956,62 @@ func (r *SecretRepo) ListAllInFolderTree(ctx context.Context, tenantID uint32, f
 	return entities, nil
 }
 
+// SecretExpiresAtKey is the metadata key holding a secret's expiry (RFC 3339)
+const SecretExpiresAtKey = "expires_at"
+
+// SecretTagsKey is the metadata key holding a secret's tags (list of strings)
+const SecretTagsKey = "tags"
+
+// SecretItemTypeKey is the metadata key marking secrets that are not logins
+const SecretItemTypeKey = "item_type"
+
+// Item types stored under SecretItemTypeKey (absent means a login)
+const (
+	SecretItemTypeSecureNote = "secure_note"
+	SecretItemTypeCard       = "card"
+	SecretItemTypeIdentity   = "identity"
+)
+
+// ExpiringSecret is an active secret with an expiry date
+type ExpiringSecret struct {
+	Secret    *ent.Secret
+	ExpiresAt time.Time
+}
+
+// ListExpiring returns the active secrets of a tenant whose metadata expiry
+// falls before the given time (already expired secrets included)
+func (r *SecretRepo) ListExpiring(ctx context.Context, tenantID uint32, before time.Time) ([]ExpiringSecret, error) {
+	entities, err := r.replica.readClient(ctx, r.entClient).Secret.Query().
+		Where(
+			secret.TenantIDEQ(tenantID),
+			secret.StatusEQ(secret.StatusSECRET_STATUS_ACTIVE),
+			func(s *sql.Selector) {
+				s.Where(sqljson.HasKey(secret.FieldMetadata, sqljson.Path(SecretExpiresAtKey)))
+			},
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list expiring secrets failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list expiring secrets failed")
+	}
+
+	var result []ExpiringSecret
+	for _, e := range entities {
+		raw, ok := e.Metadata[SecretExpiresAtKey].(string)
+		if !ok {
+			continue
+		}
+		expiresAt, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			continue
+		}
+		if expiresAt.Before(before) {
+			result = append(result, ExpiringSecret{Secret: e, ExpiresAt: expiresAt})
+		}
+	}
+	return result, nil
+}
+
 // ToProto converts an ent.Secret to wardenV1.Secret
 func (r *SecretRepo) ToProto(entity *ent.Secret) *wardenV1.Secret {
 	if entity == nil {
@@ -532,8 +1070,70 @@ func (r *SecretRepo) ToProto(entity *ent.Secret) *wardenV1.Secret {
 	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
 		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
 	}
+	if entity.LastAccessedTime != nil {
+		proto.LastAccessedTime = timestamppb.New(*entity.LastAccessedTime)
+	}
 
 	proto.HasTotp = entity.HasTotp
+	proto.Revision = entity.Revision
+	proto.Sensitive = entity.Sensitive
+	proto.PasswordEncoding = PasswordEncodingToProto(entity.PasswordEncoding)
+	proto.AccessPolicy = AccessPolicyToProto(entity.AccessPolicy)
+	proto.Protected = entity.Protected
+	proto.ReferencedSecretIds = entity.ReferencedSecretIds
+
+	return proto
+}
 
+// AccessPolicyToProto maps a stored access policy to its proto form (nil when
+// the policy restricts nothing)
+func AccessPolicyToProto(policy *authz.AccessPolicy) *wardenV1.AccessPolicy {
+	if policy.IsEmpty() {
+		return nil
+	}
+	proto := &wardenV1.AccessPolicy{
+		AllowedCidrs: policy.AllowedCIDRs,
+		Timezone:     policy.Timezone,
+	}
+	for _, d := range policy.Weekdays {
+		proto.Weekdays = append(proto.Weekdays, int32(d))
+	}
+	for _, w := range policy.TimeWindows {
+		proto.TimeWindows = append(proto.TimeWindows, &wardenV1.TimeWindow{Start: w.Start, End: w.End})
+	}
 	return proto
 }
+
+// AccessPolicyFromProto maps a proto access policy to its stored form
+func AccessPolicyFromProto(proto *wardenV1.AccessPolicy) *authz.AccessPolicy {
+	if proto == nil {
+		return nil
+	}
+	policy := &authz.AccessPolicy{
+		AllowedCIDRs: proto.GetAllowedCidrs(),
+		Timezone:     proto.GetTimezone(),
+	}
+	for _, d := range proto.GetWeekdays() {
+		policy.Weekdays = append(policy.Weekdays, int(d))
+	}
+	for _, w := range proto.GetTimeWindows() {
+		policy.TimeWindows = append(policy.TimeWindows, authz.TimeWindow{Start: w.GetStart(), End: w.GetEnd()})
+	}
+	return policy
+}
+
+// PasswordEncodingToProto maps a stored password encoding to its proto enum
+func PasswordEncodingToProto(encoding secret.PasswordEncoding) wardenV1.PasswordEncoding {
+	if encoding == secret.PasswordEncodingPASSWORD_ENCODING_BASE64 {
+		return wardenV1.PasswordEncoding_PASSWORD_ENCODING_BASE64
+	}
+	return wardenV1.PasswordEncoding_PASSWORD_ENCODING_TEXT
+}
+
+// PasswordEncodingFromProto maps a proto password encoding to its stored form
+func PasswordEncodingFromProto(encoding wardenV1.PasswordEncoding) secret.PasswordEncoding {
+	if encoding == wardenV1.PasswordEncoding_PASSWORD_ENCODING_BASE64 {
+		return secret.PasswordEncodingPASSWORD_ENCODING_BASE64
+	}
+	return secret.PasswordEncodingPASSWORD_ENCODING_TEXT
+}
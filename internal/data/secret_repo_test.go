@@ -0,0 +1,134 @@
+//go:build sqlite
+
+package data
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+)
+
+// searchFixture is a tenant's folder chain /infra/prod/db/primary, with a
+// secret named "cred <folder>" in every folder, one at the root and one in
+// /infrastructure, whose path starts like /infra's
+type searchFixture struct {
+	folders []*ent.Folder
+}
+
+func seedSearchFixture(t *testing.T, folders *FolderRepo, secrets *SecretRepo, tenantID uint32) *searchFixture {
+	t.Helper()
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+
+	createSecret := func(folderID *string, name string) {
+		t.Helper()
+		vaultPath := fmt.Sprintf("warden/%d/%s", tenantID, name)
+		if _, err := secrets.Create(ctx, tenantID, folderID, name, "", "", vaultPath, "", nil, nil); err != nil {
+			t.Fatalf("create secret %q: %v", name, err)
+		}
+	}
+
+	f := &searchFixture{}
+	var parentID *string
+	for _, name := range []string{"infra", "prod", "db", "primary"} {
+		created, err := folders.Create(ctx, tenantID, parentID, name, "", nil)
+		if err != nil {
+			t.Fatalf("create folder %q: %v", name, err)
+		}
+		f.folders = append(f.folders, created)
+		parentID = &created.ID
+		createSecret(parentID, "cred "+name)
+	}
+
+	sibling, err := folders.Create(ctx, tenantID, nil, "infrastructure", "", nil)
+	if err != nil {
+		t.Fatalf("create folder: %v", err)
+	}
+	createSecret(&sibling.ID, "cred infrastructure")
+	createSecret(nil, "cred root")
+	return f
+}
+
+func searchNames(t *testing.T, secrets *SecretRepo, tenantID uint32, query string, folderID *string, includeSubfolders bool) []string {
+	t.Helper()
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	found, total, err := secrets.Search(ctx, tenantID, query, folderID, includeSubfolders, nil, 0, 0, ProjectRows)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if total != len(found) {
+		t.Fatalf("search counted %d secrets but returned %d", total, len(found))
+	}
+	names := make([]string, 0, len(found))
+	for _, s := range found {
+		names = append(names, s.Name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func TestSecretRepoSearchSubfolders(t *testing.T) {
+	ctx, client := newTestEntClient(t)
+	folders := NewFolderRepo(ctx, client, nil)
+	secrets := NewSecretRepo(ctx, client, nil)
+	f := seedSearchFixture(t, folders, secrets, 1)
+
+	tests := []struct {
+		name      string
+		folder    int
+		recursive bool
+		want      []string
+	}{
+		{"whole chain", 0, true, []string{"cred db", "cred infra", "cred primary", "cred prod"}},
+		{"from the middle", 1, true, []string{"cred db", "cred primary", "cred prod"}},
+		{"deepest folder", 3, true, []string{"cred primary"}},
+		{"direct only", 1, false, []string{"cred prod"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := searchNames(t, secrets, 1, "cred", &f.folders[tt.folder].ID, tt.recursive)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("found %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSecretRepoSearchTenantBoundary(t *testing.T) {
+	ctx, client := newTestEntClient(t)
+	folders := NewFolderRepo(ctx, client, nil)
+	secrets := NewSecretRepo(ctx, client, nil)
+	mine := seedSearchFixture(t, folders, secrets, 1)
+	theirs := seedSearchFixture(t, folders, secrets, 2)
+
+	if got := searchNames(t, secrets, 1, "cred", nil, false); len(got) != 6 {
+		t.Fatalf("tenant search found %d secrets, want the tenant's 6: %q", len(got), got)
+	}
+	if got := searchNames(t, secrets, 1, "cred", &mine.folders[0].ID, true); len(got) != 4 {
+		t.Fatalf("subtree search found %d secrets, want 4: %q", len(got), got)
+	}
+
+	// Both tenants have the same paths; a folder of the other tenant must
+	// neither match nor expand to this tenant's subtree
+	if got := searchNames(t, secrets, 1, "cred", &theirs.folders[0].ID, true); len(got) != 0 {
+		t.Fatalf("search in another tenant's folder found %q", got)
+	}
+	if got := searchNames(t, secrets, 1, "cred", &theirs.folders[3].ID, false); len(got) != 0 {
+		t.Fatalf("search in another tenant's folder found %q", got)
+	}
+}
+
+func TestHighlightEscapesHTML(t *testing.T) {
+	got, ok := highlight(`<img src=x onerror="alert(1)"> cred & co`, []string{"cred"})
+	if !ok {
+		t.Fatal("no match")
+	}
+	want := `&lt;img src=x onerror=&#34;alert(1)&#34;&gt; <mark>cred</mark> &amp; co`
+	if got != want {
+		t.Fatalf("highlighted %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,225 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+)
+
+// Search highlight markers wrapped around matched terms
+const (
+	HighlightStart = "<mark>"
+	HighlightEnd   = "</mark>"
+)
+
+const (
+	searchIndexName = "warden_secrets_search_idx"
+	// searchSnippetRadius is the context kept around the first match of long fields
+	searchSnippetRadius = 60
+	maxSearchTerms      = 16
+)
+
+// searchDocumentSQL is the weighted tsvector a secret is matched against.
+// Names rank above usernames and URLs, then tags and custom field values,
+// then descriptions. The GIN index is built on the same expression, so the
+// two must stay identical.
+const searchDocumentSQL = "(" +
+	"setweight(to_tsvector('simple', coalesce(name, '')), 'A') || " +
+	"setweight(to_tsvector('simple', coalesce(username, '') || ' ' || coalesce(host_url, '')), 'B') || " +
+	"setweight(jsonb_to_tsvector('simple', coalesce(metadata, '{}'::jsonb), '[\"string\"]'), 'C') || " +
+	"setweight(to_tsvector('simple', coalesce(description, '')), 'D')" +
+	")"
+
+// isPostgresDriver reports whether the configured database driver is PostgreSQL
+func isPostgresDriver(driver string) bool {
+	driver = strings.ToLower(driver)
+	return strings.HasPrefix(driver, "postgres") || driver == "pgx"
+}
+
+// ensureSearchIndex creates the GIN index backing full-text secret search
+func ensureSearchIndex(ctx context.Context, drv *sql.Driver) error {
+	stmt := fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING GIN (%s)", searchIndexName, secret.Table, searchDocumentSQL)
+	return drv.Exec(ctx, stmt, []any{}, nil)
+}
+
+// searchTerms splits a query into lower-cased words
+func searchTerms(query string) []string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	seen := make(map[string]bool, len(words))
+	for _, w := range words {
+		if !seen[w] {
+			seen[w] = true
+			terms = append(terms, w)
+		}
+		if len(terms) == maxSearchTerms {
+			break
+		}
+	}
+	return terms
+}
+
+// prefixTSQuery builds a tsquery matching documents that contain every term
+// as a word prefix, so partially typed words still match
+func prefixTSQuery(terms []string) string {
+	parts := make([]string, 0, len(terms))
+	for _, t := range terms {
+		parts = append(parts, t+":*")
+	}
+	return strings.Join(parts, " & ")
+}
+
+// fullTextPredicate matches secrets whose search document contains all terms
+func fullTextPredicate(terms []string) predicate.Secret {
+	tsquery := prefixTSQuery(terms)
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString(searchDocumentSQL).WriteString(" @@ to_tsquery('simple', ").Arg(tsquery).WriteString(")")
+		}))
+	}
+}
+
+// fullTextRankOrder orders secrets by relevance, best match first
+func fullTextRankOrder(terms []string) secret.OrderOption {
+	tsquery := prefixTSQuery(terms)
+	return func(s *sql.Selector) {
+		s.OrderExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_rank(").WriteString(searchDocumentSQL).
+				WriteString(", to_tsquery('simple', ").Arg(tsquery).WriteString(")) DESC")
+		})
+	}
+}
+
+// substringPredicate is the search used on databases without full-text
+// support: every term must appear in one of the searchable fields
+func substringPredicate(terms []string) predicate.Secret {
+	preds := make([]predicate.Secret, 0, len(terms))
+	for _, t := range terms {
+		term := t
+		preds = append(preds, secret.Or(
+			secret.NameContainsFold(term),
+			secret.UsernameContainsFold(term),
+			secret.HostURLContainsFold(term),
+			secret.DescriptionContainsFold(term),
+			func(s *sql.Selector) {
+				s.Where(sql.P(func(b *sql.Builder) {
+					b.WriteString("LOWER(CAST(").Ident(s.C(secret.FieldMetadata)).WriteString(" AS CHAR)) LIKE ").
+						Arg("%" + term + "%")
+				}))
+			},
+		))
+	}
+	return secret.And(preds...)
+}
+
+// HighlightSecret returns the searchable fields of a secret that match the
+// query, keyed by field name (name, username, host_url, description, tags or
+// metadata.<key>), with matched terms wrapped in HighlightStart/HighlightEnd
+func HighlightSecret(s *ent.Secret, query string) map[string]string {
+	terms := searchTerms(query)
+	highlights := make(map[string]string)
+	if s == nil || len(terms) == 0 {
+		return highlights
+	}
+
+	add := func(field, value string) {
+		if h, ok := highlight(value, terms); ok {
+			highlights[field] = h
+		}
+	}
+
+	add("name", s.Name)
+	add("username", s.Username)
+	add("host_url", s.HostURL)
+	add("description", s.Description)
+	if tags := SecretTags(s.Metadata); len(tags) > 0 {
+		add("tags", strings.Join(tags, ", "))
+	}
+
+	keys := make([]string, 0, len(s.Metadata))
+	for k := range s.Metadata {
+		if k != SecretTagsKey {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if v, ok := s.Metadata[k].(string); ok {
+			add("metadata."+k, v)
+		}
+	}
+
+	return highlights
+}
+
+// highlight marks every occurrence of the terms in value, trimming long values
+// to a snippet around the first match. The text is HTML-escaped, so only the
+// markers are markup. ok is false when nothing matches.
+func highlight(value string, terms []string) (string, bool) {
+	if value == "" {
+		return "", false
+	}
+
+	runes := []rune(value)
+	lower := []rune(strings.ToLower(value))
+	if len(lower) != len(runes) {
+		// Case folding changed the length; match on the original text
+		lower = runes
+	}
+
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, t := range terms {
+		term := []rune(t)
+		for i := 0; i+len(term) <= len(lower); i++ {
+			if string(lower[i:i+len(term)]) != t {
+				continue
+			}
+			for j := i; j < i+len(term); j++ {
+				marked[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+		}
+	}
+	if first < 0 {
+		return "", false
+	}
+
+	start, end := 0, len(runes)
+	if end-start > 2*searchSnippetRadius {
+		start = max(0, first-searchSnippetRadius)
+		end = min(len(runes), first+searchSnippetRadius)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	for i := start; i < end; i++ {
+		if marked[i] && (i == start || !marked[i-1]) {
+			b.WriteString(HighlightStart)
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+		if marked[i] && (i == end-1 || !marked[i+1]) {
+			b.WriteString(HighlightEnd)
+		}
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String(), true
+}
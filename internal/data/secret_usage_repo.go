@@ -0,0 +1,263 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretusage"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// usageBatchSize bounds the rows inserted by one statement
+const usageBatchSize = 500
+
+// UsageReadEvents and UsageWriteEvents are the audit events counted as reads
+// and writes of a secret
+var (
+	UsageReadEvents  = []string{auditevent.SecretPasswordRead}
+	UsageWriteEvents = []string{auditevent.SecretUpdated, auditevent.SecretPasswordUpdated, auditevent.SecretVersionRestored}
+)
+
+// SecretUsageRepo stores the daily read and write counts of secrets
+type SecretUsageRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	replica   *ReadReplica
+	log       *log.Helper
+}
+
+// NewSecretUsageRepo creates a new SecretUsageRepo. Usage is read from the
+// replica when one is configured.
+func NewSecretUsageRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], replica *ReadReplica) *SecretUsageRepo {
+	return &SecretUsageRepo{
+		log:       ctx.NewLoggerHelper("warden/secret_usage_repo"),
+		entClient: entClient,
+		replica:   replica,
+	}
+}
+
+// SecretUsage is the activity of one secret over a period. LastUsedDay is
+// the last day with any activity (nil when there was none).
+type SecretUsage struct {
+	SecretID    string
+	Reads       int64
+	Writes      int64
+	LastUsedDay *time.Time
+}
+
+// DailyUsage is the activity of one secret on one UTC day (YYYY-MM-DD)
+type DailyUsage struct {
+	Date   string
+	Reads  int64
+	Writes int64
+}
+
+type secretEventCount struct {
+	TenantID   uint32 `json:"tenant_id"`
+	ResourceID string `json:"resource_id"`
+	EventType  string `json:"event_type"`
+	Count      int64  `json:"count"`
+}
+
+// AggregateDay recomputes the counts of the UTC day starting at day from the
+// audit log, replacing what was stored for it, and returns the number of
+// secrets with activity. Running it again for the same day is harmless.
+func (r *SecretUsageRepo) AggregateDay(ctx context.Context, day time.Time) (int, error) {
+	var counts []secretEventCount
+	err := r.entClient.Client().AuditLog.Query().
+		Where(
+			auditlog.ResourceTypeEQ(auditevent.ResourceSecret),
+			auditlog.EventTypeIn(slices.Concat(UsageReadEvents, UsageWriteEvents)...),
+			auditlog.CreateTimeGTE(day),
+			auditlog.CreateTimeLT(day.AddDate(0, 0, 1)),
+			auditlog.TenantIDNotNil(),
+			auditlog.ResourceIDNEQ(""),
+		).
+		GroupBy(auditlog.FieldTenantID, auditlog.FieldResourceID, auditlog.FieldEventType).
+		Aggregate(ent.As(ent.Count(), "count")).
+		Scan(ctx, &counts)
+	if err != nil {
+		r.log.Errorf("count secret events failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("aggregate secret usage failed")
+	}
+
+	type usageKey struct {
+		tenantID uint32
+		secretID string
+	}
+	usage := make(map[usageKey]*ent.SecretUsage)
+	for _, c := range counts {
+		key := usageKey{c.TenantID, c.ResourceID}
+		u, ok := usage[key]
+		if !ok {
+			u = &ent.SecretUsage{SecretID: c.ResourceID}
+			usage[key] = u
+		}
+		if slices.Contains(UsageReadEvents, c.EventType) {
+			u.Reads += c.Count
+		} else {
+			u.Writes += c.Count
+		}
+	}
+
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("aggregate secret usage failed")
+	}
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	if _, err := tx.SecretUsage.Delete().Where(secretusage.DayEQ(day)).Exec(ctx); err != nil {
+		rollback()
+		r.log.Errorf("delete secret usage failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("aggregate secret usage failed")
+	}
+
+	builders := make([]*ent.SecretUsageCreate, 0, usageBatchSize)
+	flush := func() error {
+		if len(builders) == 0 {
+			return nil
+		}
+		if err := tx.SecretUsage.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+		builders = builders[:0]
+		return nil
+	}
+	for key, u := range usage {
+		builders = append(builders, tx.SecretUsage.Create().
+			SetTenantID(key.tenantID).
+			SetSecretID(u.SecretID).
+			SetDay(day).
+			SetReads(u.Reads).
+			SetWrites(u.Writes))
+		if len(builders) == usageBatchSize {
+			if err := flush(); err != nil {
+				rollback()
+				r.log.Errorf("create secret usage failed: %s", err.Error())
+				return 0, wardenV1.ErrorInternalServerError("aggregate secret usage failed")
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		rollback()
+		r.log.Errorf("create secret usage failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("aggregate secret usage failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("aggregate secret usage failed")
+	}
+	return len(usage), nil
+}
+
+// GetSecretUsage returns the activity of a secret over the given number of
+// days ending today, in total and per UTC day (oldest first, days without
+// activity included)
+func (r *SecretUsageRepo) GetSecretUsage(ctx context.Context, tenantID uint32, secretID string, days int) (*SecretUsage, []DailyUsage, error) {
+	since := dayStart(time.Now(), days)
+
+	entities, err := r.replica.readClient(ctx, r.entClient).SecretUsage.Query().
+		Where(
+			secretusage.TenantIDEQ(tenantID),
+			secretusage.SecretIDEQ(secretID),
+			secretusage.DayGTE(since),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("get secret usage failed: %s", err.Error())
+		return nil, nil, wardenV1.ErrorInternalServerError("get secret usage failed")
+	}
+
+	daily := make([]DailyUsage, days)
+	index := make(map[string]int, days)
+	for i := range daily {
+		date := since.AddDate(0, 0, i).Format(time.DateOnly)
+		daily[i].Date = date
+		index[date] = i
+	}
+
+	total := &SecretUsage{SecretID: secretID}
+	for _, e := range entities {
+		total.add(e)
+		if i, ok := index[e.Day.UTC().Format(time.DateOnly)]; ok {
+			daily[i].Reads += e.Reads
+			daily[i].Writes += e.Writes
+		}
+	}
+	return total, daily, nil
+}
+
+// GetUsageBySecret returns the activity of each of the given secrets over the
+// given number of days ending today. Every secret is present, those without
+// activity with zero counts.
+func (r *SecretUsageRepo) GetUsageBySecret(ctx context.Context, tenantID uint32, secretIDs []string, days int) (map[string]*SecretUsage, error) {
+	result := make(map[string]*SecretUsage, len(secretIDs))
+	for _, id := range secretIDs {
+		result[id] = &SecretUsage{SecretID: id}
+	}
+	if len(secretIDs) == 0 {
+		return result, nil
+	}
+
+	entities, err := r.replica.readClient(ctx, r.entClient).SecretUsage.Query().
+		Where(
+			secretusage.TenantIDEQ(tenantID),
+			secretusage.SecretIDIn(secretIDs...),
+			secretusage.DayGTE(dayStart(time.Now(), days)),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("get usage by secret failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get secret usage failed")
+	}
+
+	for _, e := range entities {
+		if u, ok := result[e.SecretID]; ok {
+			u.add(e)
+		}
+	}
+	return result, nil
+}
+
+// add counts one day of activity
+func (u *SecretUsage) add(e *ent.SecretUsage) {
+	u.Reads += e.Reads
+	u.Writes += e.Writes
+	if e.Reads+e.Writes > 0 && (u.LastUsedDay == nil || e.Day.After(*u.LastUsedDay)) {
+		day := e.Day
+		u.LastUsedDay = &day
+	}
+}
+
+// ToProto converts the usage of a secret to its proto form
+func (r *SecretUsageRepo) ToProto(u *SecretUsage, secretEntity *ent.Secret) *wardenV1.SecretUsage {
+	proto := &wardenV1.SecretUsage{
+		SecretId: u.SecretID,
+		Reads:    u.Reads,
+		Writes:   u.Writes,
+	}
+	if secretEntity != nil {
+		proto.Name = secretEntity.Name
+		proto.FolderId = secretEntity.FolderID
+	}
+	if u.LastUsedDay != nil {
+		date := u.LastUsedDay.UTC().Format(time.DateOnly)
+		proto.LastUsedDate = &date
+	}
+	return proto
+}
@@ -13,29 +13,35 @@ import (
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretversion"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
 
 	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
 )
 
 type SecretVersionRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
+	replica   *ReadReplica
 	log       *log.Helper
 }
 
-func NewSecretVersionRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *SecretVersionRepo {
+func NewSecretVersionRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], replica *ReadReplica) *SecretVersionRepo {
 	return &SecretVersionRepo{
 		log:       ctx.NewLoggerHelper("secret_version/repo"),
 		entClient: entClient,
+		replica:   replica,
 	}
 }
 
-// Create creates a new secret version
-func (r *SecretVersionRepo) Create(ctx context.Context, secretID string, versionNumber int32, vaultPath, comment, checksum string, createdBy *uint32) (*ent.SecretVersion, error) {
-	builder := r.entClient.Client().SecretVersion.Create().
+// Create creates a new secret version. sourceVersion is the version a restore
+// copied the password from, nil for any other change.
+func (r *SecretVersionRepo) Create(ctx context.Context, secretID string, versionNumber int32, vaultPath, comment string, checksum vault.Checksum, sourceVersion *int32, createdBy *uint32) (*ent.SecretVersion, error) {
+	builder := dbClient(ctx, r.entClient).SecretVersion.Create().
 		SetSecretID(secretID).
 		SetVersionNumber(versionNumber).
 		SetVaultPath(vaultPath).
-		SetChecksum(checksum).
+		SetChecksum(checksum.Value).
+		SetChecksumAlgorithm(checksum.Algorithm).
+		SetNillableSourceVersion(sourceVersion).
 		SetCreateTime(time.Now())
 
 	if comment != "" {
@@ -59,7 +65,7 @@ func (r *SecretVersionRepo) Create(ctx context.Context, secretID string, version
 
 // GetBySecretAndVersion retrieves a version by secret ID and version number (tenant-scoped via secret join)
 func (r *SecretVersionRepo) GetBySecretAndVersion(ctx context.Context, tenantID uint32, secretID string, versionNumber int32) (*ent.SecretVersion, error) {
-	entity, err := r.entClient.Client().SecretVersion.Query().
+	entity, err := dbClient(ctx, r.entClient).SecretVersion.Query().
 		Where(
 			secretversion.SecretIDEQ(secretID),
 			secretversion.VersionNumberEQ(versionNumber),
@@ -78,7 +84,7 @@ func (r *SecretVersionRepo) GetBySecretAndVersion(ctx context.Context, tenantID
 
 // GetLatestVersion retrieves the latest version for a secret
 func (r *SecretVersionRepo) GetLatestVersion(ctx context.Context, secretID string) (*ent.SecretVersion, error) {
-	entity, err := r.entClient.Client().SecretVersion.Query().
+	entity, err := dbClient(ctx, r.entClient).SecretVersion.Query().
 		Where(secretversion.SecretIDEQ(secretID)).
 		Order(ent.Desc(secretversion.FieldVersionNumber)).
 		First(ctx)
@@ -92,13 +98,28 @@ func (r *SecretVersionRepo) GetLatestVersion(ctx context.Context, secretID strin
 	return entity, nil
 }
 
-// List lists all versions for a secret (tenant-scoped via secret join)
-func (r *SecretVersionRepo) List(ctx context.Context, tenantID uint32, secretID string, page, pageSize uint32) ([]*ent.SecretVersion, int, error) {
-	query := r.entClient.Client().SecretVersion.Query().
+// VersionFilter narrows the versions of a secret that are listed
+type VersionFilter struct {
+	// RestoredOnly keeps the versions created by restores
+	RestoredOnly bool
+	// VersionNumbers keeps the versions with these numbers when not nil
+	VersionNumbers []int32
+}
+
+// List lists all versions for a secret (tenant-scoped via secret join), newest
+// first. With a cursor the page starts after the cursor's version.
+func (r *SecretVersionRepo) List(ctx context.Context, tenantID uint32, secretID string, filter VersionFilter, after *Cursor, page, pageSize uint32) ([]*ent.SecretVersion, int, error) {
+	query := r.replica.readClient(ctx, r.entClient).SecretVersion.Query().
 		Where(
 			secretversion.SecretIDEQ(secretID),
 			secretversion.HasSecretWith(secret.TenantIDEQ(tenantID)),
 		)
+	if filter.RestoredOnly {
+		query = query.Where(secretversion.SourceVersionNotNil())
+	}
+	if filter.VersionNumbers != nil {
+		query = query.Where(secretversion.VersionNumberIn(filter.VersionNumbers...))
+	}
 
 	// Count total
 	total, err := query.Clone().Count(ctx)
@@ -108,7 +129,16 @@ func (r *SecretVersionRepo) List(ctx context.Context, tenantID uint32, secretID
 	}
 
 	// Apply pagination
-	if page > 0 && pageSize > 0 {
+	if after != nil {
+		versionNumber, err := after.intKey()
+		if err != nil {
+			return nil, 0, err
+		}
+		query = query.Where(secretversion.VersionNumberLT(int32(versionNumber)))
+		if pageSize > 0 {
+			query = query.Limit(int(pageSize))
+		}
+	} else if page > 0 && pageSize > 0 {
 		offset := int((page - 1) * pageSize)
 		query = query.Offset(offset).Limit(int(pageSize))
 	}
@@ -136,9 +166,90 @@ func (r *SecretVersionRepo) GetNextVersionNumber(ctx context.Context, secretID s
 	return latest.VersionNumber + 1, nil
 }
 
+// ListRecentChecksums returns the checksums of the latest versions of a secret, newest first
+func (r *SecretVersionRepo) ListRecentChecksums(ctx context.Context, secretID string, limit int) ([]vault.Checksum, error) {
+	entities, err := dbClient(ctx, r.entClient).SecretVersion.Query().
+		Where(secretversion.SecretIDEQ(secretID)).
+		Order(ent.Desc(secretversion.FieldVersionNumber)).
+		Limit(limit).
+		Select(secretversion.FieldChecksum, secretversion.FieldChecksumAlgorithm).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list version checksums failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list secret versions failed")
+	}
+
+	checksums := make([]vault.Checksum, 0, len(entities))
+	for _, entity := range entities {
+		checksums = append(checksums, vault.Checksum{Algorithm: entity.ChecksumAlgorithm, Value: entity.Checksum})
+	}
+	return checksums, nil
+}
+
+// ListOutdatedChecksums returns up to limit versions whose checksum was not
+// made with the given algorithm, with their secret, oldest first. Deleted
+// secrets are included: their versions keep checksums too.
+func (r *SecretVersionRepo) ListOutdatedChecksums(ctx context.Context, tenantID *uint32, secretID *string, algorithm string, afterID, limit int) ([]*ent.SecretVersion, error) {
+	query := dbClient(ctx, r.entClient).SecretVersion.Query().
+		Where(
+			secretversion.ChecksumAlgorithmNEQ(algorithm),
+			secretversion.IDGT(afterID),
+		)
+	if tenantID != nil {
+		query = query.Where(secretversion.HasSecretWith(secret.TenantIDEQ(*tenantID)))
+	}
+	if secretID != nil {
+		query = query.Where(secretversion.SecretIDEQ(*secretID))
+	}
+
+	entities, err := query.
+		WithSecret().
+		Order(ent.Asc(secretversion.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list outdated checksums failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list secret versions failed")
+	}
+	return entities, nil
+}
+
+// CountOutdatedChecksums counts the versions whose checksum was not made with
+// the given algorithm
+func (r *SecretVersionRepo) CountOutdatedChecksums(ctx context.Context, tenantID *uint32, secretID *string, algorithm string) (int, error) {
+	query := dbClient(ctx, r.entClient).SecretVersion.Query().
+		Where(secretversion.ChecksumAlgorithmNEQ(algorithm))
+	if tenantID != nil {
+		query = query.Where(secretversion.HasSecretWith(secret.TenantIDEQ(*tenantID)))
+	}
+	if secretID != nil {
+		query = query.Where(secretversion.SecretIDEQ(*secretID))
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		r.log.Errorf("count outdated checksums failed: %s", err.Error())
+		return 0, wardenV1.ErrorInternalServerError("count secret versions failed")
+	}
+	return count, nil
+}
+
+// UpdateChecksum replaces the checksum of a version
+func (r *SecretVersionRepo) UpdateChecksum(ctx context.Context, id int, checksum vault.Checksum) error {
+	err := dbClient(ctx, r.entClient).SecretVersion.UpdateOneID(id).
+		SetChecksum(checksum.Value).
+		SetChecksumAlgorithm(checksum.Algorithm).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update version checksum failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("update secret version failed")
+	}
+	return nil
+}
+
 // DeleteBySecretID deletes all versions for a secret
 func (r *SecretVersionRepo) DeleteBySecretID(ctx context.Context, secretID string) error {
-	_, err := r.entClient.Client().SecretVersion.Delete().
+	_, err := dbClient(ctx, r.entClient).SecretVersion.Delete().
 		Where(secretversion.SecretIDEQ(secretID)).
 		Exec(ctx)
 	if err != nil {
@@ -155,11 +266,13 @@ func (r *SecretVersionRepo) ToProto(entity *ent.SecretVersion) *wardenV1.SecretV
 	}
 
 	proto := &wardenV1.SecretVersion{
-		Id:            uint32(entity.ID),
-		SecretId:      entity.SecretID,
-		VersionNumber: entity.VersionNumber,
-		Comment:       entity.Comment,
-		Checksum:      entity.Checksum,
+		Id:                uint32(entity.ID),
+		SecretId:          entity.SecretID,
+		VersionNumber:     entity.VersionNumber,
+		Comment:           entity.Comment,
+		Checksum:          entity.Checksum,
+		SourceVersion:     entity.SourceVersion,
+		ChecksumAlgorithm: entity.ChecksumAlgorithm,
 	}
 
 	if entity.CreateBy != nil {
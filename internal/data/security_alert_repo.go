@@ -0,0 +1,192 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/securityalert"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// SecurityAlertRepo stores alerts raised by anomaly detection
+type SecurityAlertRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewSecurityAlertRepo creates a new SecurityAlertRepo
+func NewSecurityAlertRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *SecurityAlertRepo {
+	return &SecurityAlertRepo{
+		log:       ctx.NewLoggerHelper("warden/security_alert_repo"),
+		entClient: entClient,
+	}
+}
+
+// Create stores a new alert
+func (r *SecurityAlertRepo) Create(ctx context.Context, tenantID uint32, kind securityalert.Kind, severity securityalert.Severity, userID, resourceID, message string, details map[string]string) (*ent.SecurityAlert, error) {
+	builder := r.entClient.Client().SecurityAlert.Create().
+		SetKind(kind).
+		SetSeverity(severity).
+		SetMessage(message).
+		SetCreateTime(time.Now())
+
+	if tenantID > 0 {
+		builder.SetTenantID(tenantID)
+	}
+	if userID != "" {
+		builder.SetUserID(userID)
+	}
+	if resourceID != "" {
+		builder.SetResourceID(resourceID)
+	}
+	if details != nil {
+		builder.SetDetails(details)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create security alert failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("create security alert failed")
+	}
+	return entity, nil
+}
+
+// ExistsSince reports whether an alert of the given kind was raised for the
+// user since the given time (used to avoid repeating the same alert)
+func (r *SecurityAlertRepo) ExistsSince(ctx context.Context, tenantID uint32, userID string, kind securityalert.Kind, since time.Time) (bool, error) {
+	exists, err := r.entClient.Client().SecurityAlert.Query().
+		Where(
+			securityalert.TenantIDEQ(tenantID),
+			securityalert.UserIDEQ(userID),
+			securityalert.KindEQ(kind),
+			securityalert.CreateTimeGTE(since),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query security alerts failed: %s", err.Error())
+		return false, wardenV1.ErrorInternalServerError("query security alerts failed")
+	}
+	return exists, nil
+}
+
+// SecurityAlertListOptions contains options for listing alerts
+type SecurityAlertListOptions struct {
+	TenantID     *uint32
+	Kind         *securityalert.Kind
+	UserID       *string
+	Acknowledged *bool
+	Page         uint32
+	PageSize     uint32
+}
+
+// List retrieves alerts, newest first
+func (r *SecurityAlertRepo) List(ctx context.Context, opts *SecurityAlertListOptions) ([]*ent.SecurityAlert, int, error) {
+	query := r.entClient.Client().SecurityAlert.Query()
+
+	if opts.TenantID != nil {
+		query = query.Where(securityalert.TenantIDEQ(*opts.TenantID))
+	}
+	if opts.Kind != nil {
+		query = query.Where(securityalert.KindEQ(*opts.Kind))
+	}
+	if opts.UserID != nil {
+		query = query.Where(securityalert.UserIDEQ(*opts.UserID))
+	}
+	if opts.Acknowledged != nil {
+		query = query.Where(securityalert.AcknowledgedEQ(*opts.Acknowledged))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count security alerts failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("count security alerts failed")
+	}
+
+	if opts.Page > 0 && opts.PageSize > 0 {
+		query = query.Offset(int((opts.Page - 1) * opts.PageSize)).Limit(int(opts.PageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(securityalert.FieldCreateTime)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list security alerts failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("list security alerts failed")
+	}
+
+	return entities, total, nil
+}
+
+// Acknowledge marks an alert as handled
+func (r *SecurityAlertRepo) Acknowledge(ctx context.Context, id uint32, acknowledgedBy *uint32) (*ent.SecurityAlert, error) {
+	entity, err := r.entClient.Client().SecurityAlert.UpdateOneID(id).
+		SetAcknowledged(true).
+		SetNillableAcknowledgedBy(acknowledgedBy).
+		SetAcknowledgedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, wardenV1.ErrorNotFound("security alert not found")
+		}
+		r.log.Errorf("acknowledge security alert failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("acknowledge security alert failed")
+	}
+	return entity, nil
+}
+
+// ToProto converts an ent.SecurityAlert to wardenV1.SecurityAlert
+func (r *SecurityAlertRepo) ToProto(entity *ent.SecurityAlert) *wardenV1.SecurityAlert {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &wardenV1.SecurityAlert{
+		Id:             entity.ID,
+		TenantId:       derefUint32(entity.TenantID),
+		UserId:         entity.UserID,
+		ResourceId:     entity.ResourceID,
+		Message:        entity.Message,
+		Details:        entity.Details,
+		Acknowledged:   entity.Acknowledged,
+		AcknowledgedBy: entity.AcknowledgedBy,
+	}
+
+	switch entity.Kind {
+	case securityalert.KindALERT_KIND_EXCESSIVE_READS:
+		proto.Kind = wardenV1.AlertKind_ALERT_KIND_EXCESSIVE_READS
+	case securityalert.KindALERT_KIND_NEW_PEER_ADDRESS:
+		proto.Kind = wardenV1.AlertKind_ALERT_KIND_NEW_PEER_ADDRESS
+	case securityalert.KindALERT_KIND_NEW_GEO_LOCATION:
+		proto.Kind = wardenV1.AlertKind_ALERT_KIND_NEW_GEO_LOCATION
+	case securityalert.KindALERT_KIND_CANARY_READ:
+		proto.Kind = wardenV1.AlertKind_ALERT_KIND_CANARY_READ
+	default:
+		proto.Kind = wardenV1.AlertKind_ALERT_KIND_UNSPECIFIED
+	}
+
+	switch entity.Severity {
+	case securityalert.SeverityALERT_SEVERITY_LOW:
+		proto.Severity = wardenV1.AlertSeverity_ALERT_SEVERITY_LOW
+	case securityalert.SeverityALERT_SEVERITY_MEDIUM:
+		proto.Severity = wardenV1.AlertSeverity_ALERT_SEVERITY_MEDIUM
+	case securityalert.SeverityALERT_SEVERITY_HIGH:
+		proto.Severity = wardenV1.AlertSeverity_ALERT_SEVERITY_HIGH
+	default:
+		proto.Severity = wardenV1.AlertSeverity_ALERT_SEVERITY_UNSPECIFIED
+	}
+
+	if entity.AcknowledgedAt != nil {
+		proto.AcknowledgedAt = timestamppb.New(*entity.AcknowledgedAt)
+	}
+	if entity.CreateTime != nil {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
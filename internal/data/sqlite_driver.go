@@ -0,0 +1,15 @@
+//go:build sqlite && !sqlite_cgo
+
+package data
+
+import (
+	stdsql "database/sql"
+
+	"modernc.org/sqlite"
+)
+
+// The pure Go driver registers itself as "sqlite". ent and the database
+// config know SQLite by the name of the cgo driver, so register it as that.
+func init() {
+	stdsql.Register("sqlite3", &sqlite.Driver{})
+}
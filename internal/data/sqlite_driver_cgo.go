@@ -0,0 +1,8 @@
+//go:build sqlite && sqlite_cgo
+
+package data
+
+import (
+	// Registers the cgo SQLite driver as "sqlite3"
+	_ "github.com/mattn/go-sqlite3"
+)
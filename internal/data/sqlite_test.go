@@ -0,0 +1,66 @@
+//go:build sqlite
+
+package data
+
+import (
+	"context"
+	stdsql "database/sql"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+)
+
+// sqliteDSN returns the DSN of a new database file with the options of the
+// sqlite config
+func sqliteDSN(t *testing.T) string {
+	t.Helper()
+	return "file:" + filepath.Join(t.TempDir(), "warden.db") +
+		"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_txlock=immediate"
+}
+
+// openSQLite opens an empty SQLite database
+func openSQLite(t *testing.T) *sql.Driver {
+	t.Helper()
+	db, err := stdsql.Open("sqlite3", sqliteDSN(t))
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return sql.OpenDB(dialect.SQLite, db)
+}
+
+// newTestEntClient migrates an empty SQLite database the way the server does
+// and returns a client on it with the context to build repositories from
+func newTestEntClient(t *testing.T) (*bootstrap.Context, *entCrud.EntClient[*ent.Client]) {
+	t.Helper()
+	cfg := &conf.Bootstrap{
+		Data: &conf.Data{
+			Database: &conf.Data_Database{
+				Driver:  "sqlite3",
+				Source:  sqliteDSN(t),
+				Migrate: true,
+			},
+		},
+	}
+	ctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{
+		Project: "warden",
+		AppId:   "warden-test",
+	}, cfg, log.NewStdLogger(io.Discard))
+
+	client, cleanup, err := NewEntClient(ctx)
+	if err != nil {
+		t.Fatalf("create ent client: %v", err)
+	}
+	t.Cleanup(cleanup)
+	return ctx, client
+}
@@ -2,6 +2,8 @@ package data
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	entCrud "github.com/tx7do/go-crud/entgo"
@@ -9,7 +11,10 @@ import (
 
 	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/auditlog"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/predicate"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretversion"
 )
@@ -17,20 +22,23 @@ import (
 // StatisticsRepo provides methods for collecting Warden statistics
 type StatisticsRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
+	replica   *ReadReplica
 	log       *log.Helper
 }
 
-// NewStatisticsRepo creates a new StatisticsRepo
-func NewStatisticsRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *StatisticsRepo {
+// NewStatisticsRepo creates a new StatisticsRepo. Statistics are read from
+// the replica when one is configured.
+func NewStatisticsRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], replica *ReadReplica) *StatisticsRepo {
 	return &StatisticsRepo{
 		entClient: entClient,
+		replica:   replica,
 		log:       ctx.NewLoggerHelper("warden/statistics/repo"),
 	}
 }
 
 // GetSecretCount returns the total number of secrets for a tenant
 func (r *StatisticsRepo) GetSecretCount(ctx context.Context, tenantID uint32) (int64, error) {
-	count, err := r.entClient.Client().Secret.Query().
+	count, err := r.replica.readClient(ctx, r.entClient).Secret.Query().
 		Where(secret.TenantIDEQ(tenantID)).
 		Count(ctx)
 	if err != nil {
@@ -42,7 +50,7 @@ func (r *StatisticsRepo) GetSecretCount(ctx context.Context, tenantID uint32) (i
 
 // GetSecretCountByStatus returns the count of secrets with the given status for a tenant
 func (r *StatisticsRepo) GetSecretCountByStatus(ctx context.Context, tenantID uint32, status secret.Status) (int64, error) {
-	count, err := r.entClient.Client().Secret.Query().
+	count, err := r.replica.readClient(ctx, r.entClient).Secret.Query().
 		Where(
 			secret.TenantIDEQ(tenantID),
 			secret.StatusEQ(status),
@@ -55,10 +63,10 @@ func (r *StatisticsRepo) GetSecretCountByStatus(ctx context.Context, tenantID ui
 	return int64(count), nil
 }
 
-// GetFolderCount returns the total number of folders for a tenant
+// GetFolderCount returns the number of folders of a tenant outside the trash
 func (r *StatisticsRepo) GetFolderCount(ctx context.Context, tenantID uint32) (int64, error) {
-	count, err := r.entClient.Client().Folder.Query().
-		Where(folder.TenantIDEQ(tenantID)).
+	count, err := r.replica.readClient(ctx, r.entClient).Folder.Query().
+		Where(folder.TenantIDEQ(tenantID), folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE)).
 		Count(ctx)
 	if err != nil {
 		r.log.Errorf("get folder count failed: %s", err.Error())
@@ -69,7 +77,7 @@ func (r *StatisticsRepo) GetFolderCount(ctx context.Context, tenantID uint32) (i
 
 // GetVersionCount returns the total number of secret versions for a tenant
 func (r *StatisticsRepo) GetVersionCount(ctx context.Context, tenantID uint32) (int64, error) {
-	count, err := r.entClient.Client().SecretVersion.Query().
+	count, err := r.replica.readClient(ctx, r.entClient).SecretVersion.Query().
 		Where(secretversion.HasSecretWith(secret.TenantIDEQ(tenantID))).
 		Count(ctx)
 	if err != nil {
@@ -89,7 +97,7 @@ func (r *StatisticsRepo) GetGlobalSecretCountByStatus(ctx context.Context) (map[
 		secret.StatusSECRET_STATUS_DELETED,
 	}
 	for _, status := range statuses {
-		count, err := r.entClient.Client().Secret.Query().
+		count, err := r.replica.readClient(ctx, r.entClient).Secret.Query().
 			Where(secret.StatusEQ(status)).
 			Count(ctx)
 		if err != nil {
@@ -103,9 +111,11 @@ func (r *StatisticsRepo) GetGlobalSecretCountByStatus(ctx context.Context) (map[
 	return result, nil
 }
 
-// GetGlobalFolderCount returns the total number of folders across all tenants.
+// GetGlobalFolderCount returns the number of folders outside the trash across all tenants.
 func (r *StatisticsRepo) GetGlobalFolderCount(ctx context.Context) (int64, error) {
-	count, err := r.entClient.Client().Folder.Query().Count(ctx)
+	count, err := r.replica.readClient(ctx, r.entClient).Folder.Query().
+		Where(folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE)).
+		Count(ctx)
 	if err != nil {
 		r.log.Errorf("get global folder count failed: %s", err.Error())
 		return 0, wardenV1.ErrorInternalServerError("get statistics failed")
@@ -115,10 +125,393 @@ func (r *StatisticsRepo) GetGlobalFolderCount(ctx context.Context) (int64, error
 
 // GetGlobalVersionCount returns the total number of secret versions across all tenants.
 func (r *StatisticsRepo) GetGlobalVersionCount(ctx context.Context) (int64, error) {
-	count, err := r.entClient.Client().SecretVersion.Query().Count(ctx)
+	count, err := r.replica.readClient(ctx, r.entClient).SecretVersion.Query().Count(ctx)
 	if err != nil {
 		r.log.Errorf("get global version count failed: %s", err.Error())
 		return 0, wardenV1.ErrorInternalServerError("get statistics failed")
 	}
 	return int64(count), nil
 }
+
+// DailyCount is the number of events on one UTC day (YYYY-MM-DD)
+type DailyCount struct {
+	Date  string
+	Count int64
+}
+
+// ResourceEventCount is the number of events on one resource
+type ResourceEventCount struct {
+	ResourceID string `json:"resource_id"`
+	Count      int    `json:"count"`
+}
+
+// GetSecretsCreatedPerDay returns the number of secrets created per UTC day
+// over the given number of days ending today
+func (r *StatisticsRepo) GetSecretsCreatedPerDay(ctx context.Context, tenantID uint32, days int) ([]DailyCount, error) {
+	since := dayStart(time.Now(), days)
+
+	entities, err := r.replica.readClient(ctx, r.entClient).Secret.Query().
+		Where(
+			secret.TenantIDEQ(tenantID),
+			secret.CreateTimeGTE(since),
+		).
+		Select(secret.FieldCreateTime).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("get secrets created per day failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get statistics failed")
+	}
+
+	times := make([]*time.Time, 0, len(entities))
+	for _, e := range entities {
+		times = append(times, e.CreateTime)
+	}
+	return bucketByDay(since, days, times), nil
+}
+
+// GetEventsPerDay returns the number of audit events of a type per UTC day
+// over the given number of days ending today
+func (r *StatisticsRepo) GetEventsPerDay(ctx context.Context, tenantID uint32, eventType string, days int) ([]DailyCount, error) {
+	since := dayStart(time.Now(), days)
+
+	entities, err := r.replica.readClient(ctx, r.entClient).AuditLog.Query().
+		Where(
+			auditlog.TenantIDEQ(tenantID),
+			auditlog.EventTypeEQ(eventType),
+			auditlog.CreateTimeGTE(since),
+		).
+		Select(auditlog.FieldCreateTime).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("get events per day failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get statistics failed")
+	}
+
+	times := make([]*time.Time, 0, len(entities))
+	for _, e := range entities {
+		times = append(times, e.CreateTime)
+	}
+	return bucketByDay(since, days, times), nil
+}
+
+// GetTopResourcesByEvent returns the resources with the most audit events of
+// a type over the given number of days, most events first
+func (r *StatisticsRepo) GetTopResourcesByEvent(ctx context.Context, tenantID uint32, eventType string, days, limit int) ([]ResourceEventCount, error) {
+	var counts []ResourceEventCount
+	err := r.replica.readClient(ctx, r.entClient).AuditLog.Query().
+		Where(
+			auditlog.TenantIDEQ(tenantID),
+			auditlog.EventTypeEQ(eventType),
+			auditlog.CreateTimeGTE(dayStart(time.Now(), days)),
+			auditlog.ResourceIDNEQ(""),
+		).
+		GroupBy(auditlog.FieldResourceID).
+		Aggregate(ent.As(ent.Count(), "count")).
+		Scan(ctx, &counts)
+	if err != nil {
+		r.log.Errorf("get top resources by event failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get statistics failed")
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].ResourceID < counts[j].ResourceID
+	})
+	if len(counts) > limit {
+		counts = counts[:limit]
+	}
+	return counts, nil
+}
+
+// GetSecretNames returns the names of the given secrets of a tenant by ID
+func (r *StatisticsRepo) GetSecretNames(ctx context.Context, tenantID uint32, ids []string) (map[string]string, error) {
+	names := make(map[string]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+
+	entities, err := r.replica.readClient(ctx, r.entClient).Secret.Query().
+		Where(
+			secret.TenantIDEQ(tenantID),
+			secret.IDIn(ids...),
+		).
+		Select(secret.FieldID, secret.FieldName).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("get secret names failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get statistics failed")
+	}
+
+	for _, e := range entities {
+		names[e.ID] = e.Name
+	}
+	return names, nil
+}
+
+// StaleSecret is an active secret whose password was not changed or not read
+// for too long. PasswordChangedTime is when its current password was set.
+type StaleSecret struct {
+	Secret              *ent.Secret
+	PasswordChangedTime *time.Time
+	OwnerIDs            []string
+	PasswordStale       bool
+	Unread              bool
+}
+
+// ListStaleSecrets returns the active secrets of a tenant whose password was
+// not changed since passwordBefore or not read since readBefore, sorted by
+// name. A nil time disables that criterion. Secrets created after a cutoff
+// do not meet it, so new secrets are not reported as never read.
+func (r *StatisticsRepo) ListStaleSecrets(ctx context.Context, tenantID uint32, passwordBefore, readBefore *time.Time) ([]*StaleSecret, error) {
+	if passwordBefore == nil && readBefore == nil {
+		return nil, nil
+	}
+	client := r.replica.readClient(ctx, r.entClient)
+
+	var criteria []predicate.Secret
+	if passwordBefore != nil {
+		criteria = append(criteria, secret.And(
+			secret.CreateTimeLT(*passwordBefore),
+			secret.Not(secret.HasVersionsWith(secretversion.CreateTimeGTE(*passwordBefore))),
+		))
+	}
+	if readBefore != nil {
+		criteria = append(criteria, secret.And(
+			secret.CreateTimeLT(*readBefore),
+			secret.Or(secret.LastAccessedTimeIsNil(), secret.LastAccessedTimeLT(*readBefore)),
+		))
+	}
+
+	entities, err := client.Secret.Query().
+		Where(
+			secret.TenantIDEQ(tenantID),
+			secret.StatusEQ(secret.StatusSECRET_STATUS_ACTIVE),
+			secret.Or(criteria...),
+		).
+		WithFolder().
+		Order(ent.Asc(secret.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list stale secrets failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get stale secrets report failed")
+	}
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(entities))
+	for i, e := range entities {
+		ids[i] = e.ID
+	}
+
+	versions, err := client.SecretVersion.Query().
+		Where(secretversion.SecretIDIn(ids...)).
+		Select(secretversion.FieldSecretID, secretversion.FieldCreateTime).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list stale secret versions failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get stale secrets report failed")
+	}
+	changed := make(map[string]*time.Time, len(entities))
+	for _, v := range versions {
+		if v.CreateTime != nil && (changed[v.SecretID] == nil || v.CreateTime.After(*changed[v.SecretID])) {
+			changed[v.SecretID] = v.CreateTime
+		}
+	}
+
+	owners, err := client.Permission.Query().
+		Where(
+			permission.TenantIDEQ(tenantID),
+			permission.ResourceTypeEQ(permission.ResourceTypeRESOURCE_TYPE_SECRET),
+			permission.ResourceIDIn(ids...),
+			permission.RelationEQ(permission.RelationRELATION_OWNER),
+			permission.SubjectTypeEQ(permission.SubjectTypeSUBJECT_TYPE_USER),
+		).
+		Select(permission.FieldResourceID, permission.FieldSubjectID).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list stale secret owners failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get stale secrets report failed")
+	}
+	ownerIDs := make(map[string][]string, len(entities))
+	for _, o := range owners {
+		ownerIDs[o.ResourceID] = append(ownerIDs[o.ResourceID], o.SubjectID)
+	}
+
+	result := make([]*StaleSecret, 0, len(entities))
+	for _, e := range entities {
+		item := &StaleSecret{
+			Secret:              e,
+			PasswordChangedTime: changed[e.ID],
+			OwnerIDs:            ownerIDs[e.ID],
+		}
+		sort.Strings(item.OwnerIDs)
+		if passwordBefore != nil && e.CreateTime != nil && e.CreateTime.Before(*passwordBefore) &&
+			(item.PasswordChangedTime == nil || item.PasswordChangedTime.Before(*passwordBefore)) {
+			item.PasswordStale = true
+		}
+		if readBefore != nil && e.CreateTime != nil && e.CreateTime.Before(*readBefore) &&
+			(e.LastAccessedTime == nil || e.LastAccessedTime.Before(*readBefore)) {
+			item.Unread = true
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// EstimatedVaultBytesPerVersion approximates the Vault KV storage of one
+// secret version (password, TOTP seed and KV v2 version metadata). Vault does
+// not report per-path sizes, so tenant storage is estimated from version counts.
+const EstimatedVaultBytesPerVersion = 512
+
+// TenantUsage aggregates the resources and activity of one tenant
+type TenantUsage struct {
+	TenantID        uint32
+	TotalSecrets    int64
+	ActiveSecrets   int64
+	ArchivedSecrets int64
+	DeletedSecrets  int64
+	TotalFolders    int64
+	TotalVersions   int64
+	LastActivity    *time.Time
+}
+
+// EstimatedVaultBytes returns the approximate Vault storage used by the tenant
+func (u *TenantUsage) EstimatedVaultBytes() int64 {
+	return u.TotalVersions * EstimatedVaultBytesPerVersion
+}
+
+// GetTenantUsage returns the usage of every tenant that has secrets, folders
+// or audit activity, ordered by tenant ID. tenantID limits the result to one
+// tenant.
+func (r *StatisticsRepo) GetTenantUsage(ctx context.Context, tenantID *uint32) ([]*TenantUsage, error) {
+	client := r.replica.readClient(ctx, r.entClient)
+	usage := make(map[uint32]*TenantUsage)
+	get := func(id uint32) *TenantUsage {
+		u, ok := usage[id]
+		if !ok {
+			u = &TenantUsage{TenantID: id}
+			usage[id] = u
+		}
+		return u
+	}
+
+	var secretCounts []struct {
+		TenantID uint32 `json:"tenant_id"`
+		Status   string `json:"status"`
+		Count    int64  `json:"count"`
+	}
+	secretQuery := client.Secret.Query().Where(secret.TenantIDNotNil())
+	if tenantID != nil {
+		secretQuery = secretQuery.Where(secret.TenantIDEQ(*tenantID))
+	}
+	if err := secretQuery.
+		GroupBy(secret.FieldTenantID, secret.FieldStatus).
+		Aggregate(ent.As(ent.Count(), "count")).
+		Scan(ctx, &secretCounts); err != nil {
+		r.log.Errorf("get tenant secret counts failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get tenant usage failed")
+	}
+	for _, c := range secretCounts {
+		u := get(c.TenantID)
+		u.TotalSecrets += c.Count
+		switch secret.Status(c.Status) {
+		case secret.StatusSECRET_STATUS_ACTIVE:
+			u.ActiveSecrets += c.Count
+		case secret.StatusSECRET_STATUS_ARCHIVED:
+			u.ArchivedSecrets += c.Count
+		case secret.StatusSECRET_STATUS_DELETED:
+			u.DeletedSecrets += c.Count
+		}
+	}
+
+	var folderCounts []struct {
+		TenantID uint32 `json:"tenant_id"`
+		Count    int64  `json:"count"`
+	}
+	folderQuery := client.Folder.Query().Where(folder.TenantIDNotNil())
+	if tenantID != nil {
+		folderQuery = folderQuery.Where(folder.TenantIDEQ(*tenantID))
+	}
+	if err := folderQuery.
+		GroupBy(folder.FieldTenantID).
+		Aggregate(ent.As(ent.Count(), "count")).
+		Scan(ctx, &folderCounts); err != nil {
+		r.log.Errorf("get tenant folder counts failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get tenant usage failed")
+	}
+	for _, c := range folderCounts {
+		get(c.TenantID).TotalFolders = c.Count
+	}
+
+	var activity []struct {
+		TenantID     uint32    `json:"tenant_id"`
+		LastActivity time.Time `json:"last_activity"`
+	}
+	auditQuery := client.AuditLog.Query().Where(auditlog.TenantIDNotNil())
+	if tenantID != nil {
+		auditQuery = auditQuery.Where(auditlog.TenantIDEQ(*tenantID))
+	}
+	if err := auditQuery.
+		GroupBy(auditlog.FieldTenantID).
+		Aggregate(ent.As(ent.Max(auditlog.FieldCreateTime), "last_activity")).
+		Scan(ctx, &activity); err != nil {
+		r.log.Errorf("get tenant activity failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get tenant usage failed")
+	}
+	for _, a := range activity {
+		if a.LastActivity.IsZero() {
+			continue
+		}
+		last := a.LastActivity
+		get(a.TenantID).LastActivity = &last
+	}
+
+	result := make([]*TenantUsage, 0, len(usage))
+	for _, u := range usage {
+		// Versions have no tenant column, so they are counted per tenant
+		// through the owning secret
+		if u.TotalSecrets > 0 {
+			versions, err := r.GetVersionCount(ctx, u.TenantID)
+			if err != nil {
+				return nil, err
+			}
+			u.TotalVersions = versions
+		}
+		result = append(result, u)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].TenantID < result[j].TenantID })
+	return result, nil
+}
+
+// dayStart returns midnight UTC of the first day of a series of the given
+// length ending on the day of now
+func dayStart(now time.Time, days int) time.Time {
+	y, m, d := now.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -(days - 1))
+}
+
+// bucketByDay counts timestamps per UTC day. Every day of the series is
+// present, so charts get a continuous axis.
+func bucketByDay(since time.Time, days int, times []*time.Time) []DailyCount {
+	result := make([]DailyCount, days)
+	index := make(map[string]int, days)
+	for i := range result {
+		date := since.AddDate(0, 0, i).Format(time.DateOnly)
+		result[i].Date = date
+		index[date] = i
+	}
+
+	for _, t := range times {
+		if t == nil {
+			continue
+		}
+		if i, ok := index[t.UTC().Format(time.DateOnly)]; ok {
+			result[i].Count++
+		}
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/tenantsetting"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// TenantSettingRepo stores per-tenant overrides of deployment defaults
+type TenantSettingRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewTenantSettingRepo creates a new TenantSettingRepo
+func NewTenantSettingRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TenantSettingRepo {
+	return &TenantSettingRepo{
+		log:       ctx.NewLoggerHelper("warden/tenant_setting_repo"),
+		entClient: entClient,
+	}
+}
+
+// Get retrieves the settings of a tenant, returning nil when none are stored
+func (r *TenantSettingRepo) Get(ctx context.Context, tenantID uint32) (*ent.TenantSetting, error) {
+	entity, err := r.entClient.Client().TenantSetting.Query().
+		Where(tenantsetting.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get tenant settings failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get tenant settings failed")
+	}
+	return entity, nil
+}
+
+// ListAll retrieves the settings of every tenant that has overrides
+func (r *TenantSettingRepo) ListAll(ctx context.Context) ([]*ent.TenantSetting, error) {
+	entities, err := r.entClient.Client().TenantSetting.Query().All(ctx)
+	if err != nil {
+		r.log.Errorf("list tenant settings failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list tenant settings failed")
+	}
+	return entities, nil
+}
+
+// SetAuditRetentionDays creates or updates the audit retention of a tenant
+func (r *TenantSettingRepo) SetAuditRetentionDays(ctx context.Context, tenantID uint32, days int32, updatedBy *uint32) (*ent.TenantSetting, error) {
+	err := r.entClient.Client().TenantSetting.Create().
+		SetTenantID(tenantID).
+		SetAuditRetentionDays(days).
+		SetNillableUpdateBy(updatedBy).
+		OnConflictColumns(tenantsetting.FieldTenantID).
+		UpdateAuditRetentionDays().
+		UpdateUpdateBy().
+		UpdateUpdateTime().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("set audit retention failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("set audit retention failed")
+	}
+	return r.Get(ctx, tenantID)
+}
+
+// SetExportPolicy creates or updates the export policy of a tenant
+func (r *TenantSettingRepo) SetExportPolicy(ctx context.Context, tenantID uint32, excludedTags, excludedFolderIDs []string, updatedBy *uint32) (*ent.TenantSetting, error) {
+	err := r.entClient.Client().TenantSetting.Create().
+		SetTenantID(tenantID).
+		SetExportExcludedTags(excludedTags).
+		SetExportExcludedFolderIds(excludedFolderIDs).
+		SetNillableUpdateBy(updatedBy).
+		OnConflictColumns(tenantsetting.FieldTenantID).
+		UpdateExportExcludedTags().
+		UpdateExportExcludedFolderIds().
+		UpdateUpdateBy().
+		UpdateUpdateTime().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("set export policy failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("set export policy failed")
+	}
+	return r.Get(ctx, tenantID)
+}
+
+// SetGeoPolicy creates or updates the countries passwords of a tenant may be
+// read from
+func (r *TenantSettingRepo) SetGeoPolicy(ctx context.Context, tenantID uint32, allowedCountries []string, updatedBy *uint32) (*ent.TenantSetting, error) {
+	err := r.entClient.Client().TenantSetting.Create().
+		SetTenantID(tenantID).
+		SetGeoAllowedCountries(allowedCountries).
+		SetNillableUpdateBy(updatedBy).
+		OnConflictColumns(tenantsetting.FieldTenantID).
+		UpdateGeoAllowedCountries().
+		UpdateUpdateBy().
+		UpdateUpdateTime().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("set geo policy failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("set geo policy failed")
+	}
+	return r.Get(ctx, tenantID)
+}
+
+// SetVersionRetention creates or updates the version retention of a tenant
+func (r *TenantSettingRepo) SetVersionRetention(ctx context.Context, tenantID uint32, retention VersionRetention, updatedBy *uint32) (*ent.TenantSetting, error) {
+	err := r.entClient.Client().TenantSetting.Create().
+		SetTenantID(tenantID).
+		SetVersionMaxCount(int32(retention.MaxVersions)).
+		SetVersionDeleteAfterDays(int32(retention.DeleteAfterDays)).
+		SetNillableUpdateBy(updatedBy).
+		OnConflictColumns(tenantsetting.FieldTenantID).
+		UpdateVersionMaxCount().
+		UpdateVersionDeleteAfterDays().
+		UpdateUpdateBy().
+		UpdateUpdateTime().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("set version retention failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("set version retention failed")
+	}
+	return r.Get(ctx, tenantID)
+}
+
+// SetPasswordPolicy creates or updates the password policy of a tenant
+func (r *TenantSettingRepo) SetPasswordPolicy(ctx context.Context, tenantID uint32, enabled bool, policy PasswordPolicy, updatedBy *uint32) (*ent.TenantSetting, error) {
+	err := r.entClient.Client().TenantSetting.Create().
+		SetTenantID(tenantID).
+		SetPasswordPolicyEnabled(enabled).
+		SetPasswordMinLength(int32(policy.MinLength)).
+		SetPasswordRequireLowercase(policy.RequireLowercase).
+		SetPasswordRequireUppercase(policy.RequireUppercase).
+		SetPasswordRequireDigit(policy.RequireDigit).
+		SetPasswordRequireSymbol(policy.RequireSymbol).
+		SetPasswordBannedWords(policy.BannedWords).
+		SetPasswordMaxAgeDays(int32(policy.MaxAgeDays)).
+		SetPasswordHistorySize(int32(policy.HistorySize)).
+		SetNillableUpdateBy(updatedBy).
+		OnConflictColumns(tenantsetting.FieldTenantID).
+		UpdatePasswordPolicyEnabled().
+		UpdatePasswordMinLength().
+		UpdatePasswordRequireLowercase().
+		UpdatePasswordRequireUppercase().
+		UpdatePasswordRequireDigit().
+		UpdatePasswordRequireSymbol().
+		UpdatePasswordBannedWords().
+		UpdatePasswordMaxAgeDays().
+		UpdatePasswordHistorySize().
+		UpdateUpdateBy().
+		UpdateUpdateTime().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("set password policy failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("set password policy failed")
+	}
+	return r.Get(ctx, tenantID)
+}
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// Transactor runs multi-entity writes in a single database transaction
+type Transactor struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewTransactor creates a new Transactor
+func NewTransactor(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *Transactor {
+	return &Transactor{
+		entClient: entClient,
+		log:       ctx.NewLoggerHelper("warden/tx"),
+	}
+}
+
+// WithTx calls fn with a context carrying a new transaction and commits it
+// when fn returns nil. Repository calls made with that context run inside the
+// transaction. An error or panic from fn rolls everything back; the error is
+// returned unchanged. Calls nested in an existing transaction join it.
+func (t *Transactor) WithTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := t.entClient.Client().Tx(ctx)
+	if err != nil {
+		t.log.Errorf("begin transaction failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("begin transaction failed")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				t.log.Errorf("rollback failed: %s", rbErr.Error())
+			}
+			panic(p)
+		}
+	}()
+
+	if err := fn(ent.NewTxContext(ctx, tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			t.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.log.Errorf("commit transaction failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("commit transaction failed")
+	}
+	return nil
+}
+
+// dbClient returns the client of the transaction started by WithTx on ctx,
+// or the shared client outside of one
+func dbClient(ctx context.Context, entClient *entCrud.EntClient[*ent.Client]) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return entClient.Client()
+}
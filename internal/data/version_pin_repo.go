@@ -0,0 +1,205 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/versionpin"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// VersionPinRepo stores the versions automation consumers are pinned to.
+// Only a hash of each pin token is stored.
+type VersionPinRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewVersionPinRepo creates a new VersionPinRepo
+func NewVersionPinRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *VersionPinRepo {
+	return &VersionPinRepo{
+		log:       ctx.NewLoggerHelper("warden/version_pin_repo"),
+		entClient: entClient,
+	}
+}
+
+// Create pins consumer to a version of a secret and returns the pin with its
+// token. The token is not stored and cannot be retrieved again.
+func (r *VersionPinRepo) Create(ctx context.Context, tenantID uint32, secretID string, version int32, consumer string) (*ent.VersionPin, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		r.log.Errorf("generate pin token failed: %s", err.Error())
+		return nil, "", wardenV1.ErrorInternalServerError("create version pin failed")
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	now := time.Now()
+	entity, err := r.entClient.Client().VersionPin.Create().
+		SetTenantID(tenantID).
+		SetSecretID(secretID).
+		SetVersionNumber(version).
+		SetTokenHash(pinTokenHash(token)).
+		SetConsumer(consumer).
+		SetLastUsedTime(now).
+		SetCreateTime(now).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("create version pin failed: %s", err.Error())
+		return nil, "", wardenV1.ErrorInternalServerError("create version pin failed")
+	}
+	return entity, token, nil
+}
+
+// Get returns a pin of a secret, nil when it does not exist
+func (r *VersionPinRepo) Get(ctx context.Context, tenantID uint32, secretID string, id uint32) (*ent.VersionPin, error) {
+	entity, err := r.entClient.Client().VersionPin.Query().
+		Where(
+			versionpin.IDEQ(id),
+			versionpin.TenantIDEQ(tenantID),
+			versionpin.SecretIDEQ(secretID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get version pin failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get version pin failed")
+	}
+	return entity, nil
+}
+
+// GetByToken returns the pin of a secret a token belongs to, nil when the
+// token is unknown or belongs to another secret
+func (r *VersionPinRepo) GetByToken(ctx context.Context, tenantID uint32, secretID, token string) (*ent.VersionPin, error) {
+	entity, err := r.entClient.Client().VersionPin.Query().
+		Where(
+			versionpin.TenantIDEQ(tenantID),
+			versionpin.SecretIDEQ(secretID),
+			versionpin.TokenHashEQ(pinTokenHash(token)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get version pin failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get version pin failed")
+	}
+	return entity, nil
+}
+
+// Touch records a read through a pin and moves it to version
+func (r *VersionPinRepo) Touch(ctx context.Context, id uint32, version int32) (*ent.VersionPin, error) {
+	now := time.Now()
+	entity, err := r.entClient.Client().VersionPin.UpdateOneID(id).
+		SetVersionNumber(version).
+		SetLastUsedTime(now).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, wardenV1.ErrorNotFound("version pin not found")
+		}
+		r.log.Errorf("update version pin failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("update version pin failed")
+	}
+	return entity, nil
+}
+
+// ListBySecret returns the pins of a secret, oldest version first. With
+// belowVersion set only pins to earlier versions are returned.
+func (r *VersionPinRepo) ListBySecret(ctx context.Context, tenantID uint32, secretID string, belowVersion int32) ([]*ent.VersionPin, error) {
+	query := r.entClient.Client().VersionPin.Query().
+		Where(
+			versionpin.TenantIDEQ(tenantID),
+			versionpin.SecretIDEQ(secretID),
+		)
+	if belowVersion > 0 {
+		query = query.Where(versionpin.VersionNumberLT(belowVersion))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(versionpin.FieldVersionNumber), ent.Asc(versionpin.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list version pins failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list version pins failed")
+	}
+	return entities, nil
+}
+
+// Delete releases a pin of a secret
+func (r *VersionPinRepo) Delete(ctx context.Context, tenantID uint32, secretID string, id uint32) error {
+	n, err := r.entClient.Client().VersionPin.Delete().
+		Where(
+			versionpin.IDEQ(id),
+			versionpin.TenantIDEQ(tenantID),
+			versionpin.SecretIDEQ(secretID),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete version pin failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete version pin failed")
+	}
+	if n == 0 {
+		return wardenV1.ErrorNotFound("version pin not found")
+	}
+	return nil
+}
+
+// DeleteBySecretID releases all pins of a secret
+func (r *VersionPinRepo) DeleteBySecretID(ctx context.Context, tenantID uint32, secretID string) error {
+	_, err := dbClient(ctx, r.entClient).VersionPin.Delete().
+		Where(
+			versionpin.TenantIDEQ(tenantID),
+			versionpin.SecretIDEQ(secretID),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete version pins failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete version pins failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.VersionPin to wardenV1.VersionPin. A pin to a
+// version before currentVersion is stale.
+func (r *VersionPinRepo) ToProto(entity *ent.VersionPin, currentVersion int32) *wardenV1.VersionPin {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &wardenV1.VersionPin{
+		Id:             entity.ID,
+		SecretId:       entity.SecretID,
+		VersionNumber:  entity.VersionNumber,
+		Consumer:       entity.Consumer,
+		CurrentVersion: currentVersion,
+		Stale:          entity.VersionNumber < currentVersion,
+	}
+	if entity.LastUsedTime != nil {
+		proto.LastUsedTime = timestamppb.New(*entity.LastUsedTime)
+	}
+	if entity.CreateTime != nil {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	return proto
+}
+
+func pinTokenHash(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
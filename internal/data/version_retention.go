@@ -0,0 +1,45 @@
+package data
+
+import (
+	"context"
+	"time"
+)
+
+// VersionRetention caps the password versions Vault keeps per secret. Zero
+// values leave the Vault defaults in place.
+type VersionRetention struct {
+	// MaxVersions is how many versions Vault keeps per secret
+	MaxVersions int
+	// DeleteAfterDays is the age after which Vault deletes a version
+	DeleteAfterDays int
+}
+
+// DeleteAfter returns the age after which versions are deleted
+func (v *VersionRetention) DeleteAfter() time.Duration {
+	return time.Duration(v.DeleteAfterDays) * 24 * time.Hour
+}
+
+// GetVersionRetention returns the version retention of a tenant, the zero
+// retention when it has none
+func (r *TenantSettingRepo) GetVersionRetention(ctx context.Context, tenantID uint32) (*VersionRetention, error) {
+	setting, err := r.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		return &VersionRetention{}, nil
+	}
+	return &VersionRetention{
+		MaxVersions:     int(setting.VersionMaxCount),
+		DeleteAfterDays: int(setting.VersionDeleteAfterDays),
+	}, nil
+}
+
+// VersionRetention implements vault.RetentionPolicy
+func (r *TenantSettingRepo) VersionRetention(ctx context.Context, tenantID uint32) (int, time.Duration, error) {
+	retention, err := r.GetVersionRetention(ctx, tenantID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return retention.MaxVersions, retention.DeleteAfter(), nil
+}
@@ -0,0 +1,240 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhookdelivery"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// WebhookDeliveryRepo stores queued webhook deliveries and their outcome
+type WebhookDeliveryRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+	rowLocks  bool
+}
+
+// NewWebhookDeliveryRepo creates a new WebhookDeliveryRepo
+func NewWebhookDeliveryRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *WebhookDeliveryRepo {
+	return &WebhookDeliveryRepo{
+		log:       ctx.NewLoggerHelper("warden/webhook_delivery_repo"),
+		entClient: entClient,
+		rowLocks:  supportsRowLocks(ctx),
+	}
+}
+
+// Create queues an event for a webhook. It returns false without error when
+// the event was already queued for that webhook.
+func (r *WebhookDeliveryRepo) Create(ctx context.Context, tenantID, webhookID uint32, eventID, eventType, payload string) (bool, error) {
+	now := time.Now()
+	_, err := r.entClient.Client().WebhookDelivery.Create().
+		SetTenantID(tenantID).
+		SetWebhookID(webhookID).
+		SetEventID(eventID).
+		SetEventType(eventType).
+		SetPayload(payload).
+		SetStatus(webhookdelivery.StatusDELIVERY_STATUS_PENDING).
+		SetNextAttemptAt(now).
+		SetCreateTime(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		r.log.Errorf("create webhook delivery failed: %s", err.Error())
+		return false, wardenV1.ErrorInternalServerError("create webhook delivery failed")
+	}
+	return true, nil
+}
+
+// ClaimDue locks pending deliveries that are due and pushes their next attempt
+// out by lease, so concurrent workers do not send the same delivery twice.
+func (r *WebhookDeliveryRepo) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*ent.WebhookDelivery, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("claim webhook deliveries failed")
+	}
+
+	query := tx.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusDELIVERY_STATUS_PENDING),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit)
+	entities, err := forUpdate(query, r.rowLocks, sql.WithLockAction(sql.SkipLocked)).All(ctx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		r.log.Errorf("query due webhook deliveries failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("claim webhook deliveries failed")
+	}
+
+	if len(entities) > 0 {
+		ids := make([]uint32, 0, len(entities))
+		for _, e := range entities {
+			ids = append(ids, e.ID)
+		}
+		if err := tx.WebhookDelivery.Update().
+			Where(webhookdelivery.IDIn(ids...)).
+			SetNextAttemptAt(now.Add(lease)).
+			Exec(ctx); err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				r.log.Errorf("rollback failed: %s", rbErr.Error())
+			}
+			r.log.Errorf("lease webhook deliveries failed: %s", err.Error())
+			return nil, wardenV1.ErrorInternalServerError("claim webhook deliveries failed")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("claim webhook deliveries failed")
+	}
+	return entities, nil
+}
+
+// RecordAttempt stores the outcome of a delivery attempt. A nil nextAttempt
+// after a failure marks the delivery as permanently failed.
+func (r *WebhookDeliveryRepo) RecordAttempt(ctx context.Context, id uint32, attempts int32, responseCode *int32, attemptErr string, nextAttempt *time.Time) error {
+	builder := r.entClient.Client().WebhookDelivery.UpdateOneID(id).
+		SetAttempts(attempts).
+		SetNillableResponseCode(responseCode).
+		SetUpdateTime(time.Now())
+
+	switch {
+	case attemptErr == "":
+		builder.SetStatus(webhookdelivery.StatusDELIVERY_STATUS_DELIVERED).
+			SetDeliveredAt(time.Now()).
+			ClearNextAttemptAt().
+			ClearLastError()
+	case nextAttempt != nil:
+		builder.SetLastError(truncate(attemptErr, 1024)).
+			SetNextAttemptAt(*nextAttempt)
+	default:
+		builder.SetStatus(webhookdelivery.StatusDELIVERY_STATUS_FAILED).
+			SetLastError(truncate(attemptErr, 1024)).
+			ClearNextAttemptAt()
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("record webhook delivery attempt failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("record webhook delivery failed")
+	}
+	return nil
+}
+
+// Redeliver requeues a delivery of a tenant for immediate sending
+func (r *WebhookDeliveryRepo) Redeliver(ctx context.Context, tenantID, id uint32) (*ent.WebhookDelivery, error) {
+	affected, err := r.entClient.Client().WebhookDelivery.Update().
+		Where(webhookdelivery.IDEQ(id), webhookdelivery.TenantIDEQ(tenantID)).
+		SetStatus(webhookdelivery.StatusDELIVERY_STATUS_PENDING).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("redeliver webhook delivery failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("redeliver webhook delivery failed")
+	}
+	if affected == 0 {
+		return nil, wardenV1.ErrorNotFound("webhook delivery not found")
+	}
+
+	entity, err := r.entClient.Client().WebhookDelivery.Get(ctx, id)
+	if err != nil {
+		r.log.Errorf("get webhook delivery failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get webhook delivery failed")
+	}
+	return entity, nil
+}
+
+// List retrieves the delivery log of a webhook, newest first
+func (r *WebhookDeliveryRepo) List(ctx context.Context, tenantID, webhookID uint32, status *webhookdelivery.Status, page, pageSize uint32) ([]*ent.WebhookDelivery, int, error) {
+	query := r.entClient.Client().WebhookDelivery.Query().
+		Where(
+			webhookdelivery.TenantIDEQ(tenantID),
+			webhookdelivery.WebhookIDEQ(webhookID),
+		)
+	if status != nil {
+		query = query.Where(webhookdelivery.StatusEQ(*status))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count webhook deliveries failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("count webhook deliveries failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(webhookdelivery.FieldCreateTime)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list webhook deliveries failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("list webhook deliveries failed")
+	}
+
+	return entities, total, nil
+}
+
+// ToProto converts an ent.WebhookDelivery to wardenV1.WebhookDelivery
+func (r *WebhookDeliveryRepo) ToProto(entity *ent.WebhookDelivery) *wardenV1.WebhookDelivery {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &wardenV1.WebhookDelivery{
+		Id:           entity.ID,
+		WebhookId:    entity.WebhookID,
+		EventId:      entity.EventID,
+		EventType:    WebhookEventTypeToProto(entity.EventType),
+		Payload:      entity.Payload,
+		Attempts:     entity.Attempts,
+		ResponseCode: entity.ResponseCode,
+		LastError:    entity.LastError,
+	}
+
+	switch entity.Status {
+	case webhookdelivery.StatusDELIVERY_STATUS_PENDING:
+		proto.Status = wardenV1.DeliveryStatus_DELIVERY_STATUS_PENDING
+	case webhookdelivery.StatusDELIVERY_STATUS_DELIVERED:
+		proto.Status = wardenV1.DeliveryStatus_DELIVERY_STATUS_DELIVERED
+	case webhookdelivery.StatusDELIVERY_STATUS_FAILED:
+		proto.Status = wardenV1.DeliveryStatus_DELIVERY_STATUS_FAILED
+	default:
+		proto.Status = wardenV1.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+	}
+
+	if entity.NextAttemptAt != nil {
+		proto.NextAttemptAt = timestamppb.New(*entity.NextAttemptAt)
+	}
+	if entity.DeliveredAt != nil {
+		proto.DeliveredAt = timestamppb.New(*entity.DeliveredAt)
+	}
+	if entity.CreateTime != nil {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
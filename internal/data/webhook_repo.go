@@ -0,0 +1,325 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhook"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhookdelivery"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// WebhookRepo stores tenant webhook registrations
+type WebhookRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewWebhookRepo creates a new WebhookRepo
+func NewWebhookRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *WebhookRepo {
+	return &WebhookRepo{
+		log:       ctx.NewLoggerHelper("warden/webhook_repo"),
+		entClient: entClient,
+	}
+}
+
+// Create registers a new webhook
+func (r *WebhookRepo) Create(ctx context.Context, tenantID uint32, name, url, secret, description string, eventTypes []string, enabled bool, createdBy *uint32) (*ent.Webhook, error) {
+	builder := r.entClient.Client().Webhook.Create().
+		SetTenantID(tenantID).
+		SetName(name).
+		SetURL(url).
+		SetEventTypes(eventTypes).
+		SetEnabled(enabled).
+		SetCreateTime(time.Now())
+
+	if secret != "" {
+		builder.SetSecret(secret)
+	}
+	if description != "" {
+		builder.SetDescription(description)
+	}
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, wardenV1.ErrorConflict("webhook with this name already exists")
+		}
+		r.log.Errorf("create webhook failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("create webhook failed")
+	}
+	return entity, nil
+}
+
+// GetByIDAndTenant retrieves a webhook of a tenant
+func (r *WebhookRepo) GetByIDAndTenant(ctx context.Context, tenantID, id uint32) (*ent.Webhook, error) {
+	entity, err := r.entClient.Client().Webhook.Query().
+		Where(webhook.IDEQ(id), webhook.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get webhook failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get webhook failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a webhook regardless of tenant (used by the delivery worker)
+func (r *WebhookRepo) GetByID(ctx context.Context, id uint32) (*ent.Webhook, error) {
+	entity, err := r.entClient.Client().Webhook.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get webhook failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("get webhook failed")
+	}
+	return entity, nil
+}
+
+// List retrieves the webhooks of a tenant
+func (r *WebhookRepo) List(ctx context.Context, tenantID uint32, page, pageSize uint32) ([]*ent.Webhook, int, error) {
+	query := r.entClient.Client().Webhook.Query().
+		Where(webhook.TenantIDEQ(tenantID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count webhooks failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("count webhooks failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.Order(ent.Asc(webhook.FieldName)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list webhooks failed: %s", err.Error())
+		return nil, 0, wardenV1.ErrorInternalServerError("list webhooks failed")
+	}
+
+	return entities, total, nil
+}
+
+// ListSubscribed retrieves the enabled webhooks of a tenant subscribed to an event type
+func (r *WebhookRepo) ListSubscribed(ctx context.Context, tenantID uint32, eventType string) ([]*ent.Webhook, error) {
+	entities, err := r.entClient.Client().Webhook.Query().
+		Where(webhook.TenantIDEQ(tenantID), webhook.EnabledEQ(true)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list subscribed webhooks failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list webhooks failed")
+	}
+
+	subscribed := entities[:0]
+	for _, e := range entities {
+		if slices.Contains(e.EventTypes, eventType) {
+			subscribed = append(subscribed, e)
+		}
+	}
+	return subscribed, nil
+}
+
+// ListTenantsSubscribed returns the tenants having an enabled webhook for an event type
+func (r *WebhookRepo) ListTenantsSubscribed(ctx context.Context, eventType string) ([]uint32, error) {
+	entities, err := r.entClient.Client().Webhook.Query().
+		Where(webhook.EnabledEQ(true), webhook.TenantIDNotNil()).
+		Select(webhook.FieldTenantID, webhook.FieldEventTypes).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list subscribed tenants failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("list webhooks failed")
+	}
+
+	var tenants []uint32
+	for _, e := range entities {
+		if e.TenantID != nil && slices.Contains(e.EventTypes, eventType) && !slices.Contains(tenants, *e.TenantID) {
+			tenants = append(tenants, *e.TenantID)
+		}
+	}
+	return tenants, nil
+}
+
+// Update updates a webhook of a tenant
+func (r *WebhookRepo) Update(ctx context.Context, tenantID, id uint32, name, url, secret, description *string, eventTypes []string, enabled *bool, updatedBy *uint32) (*ent.Webhook, error) {
+	builder := r.entClient.Client().Webhook.Update().
+		Where(webhook.IDEQ(id), webhook.TenantIDEQ(tenantID)).
+		SetUpdateTime(time.Now())
+
+	if name != nil {
+		builder.SetName(*name)
+	}
+	if url != nil {
+		builder.SetURL(*url)
+	}
+	if secret != nil {
+		if *secret == "" {
+			builder.ClearSecret()
+		} else {
+			builder.SetSecret(*secret)
+		}
+	}
+	if description != nil {
+		builder.SetDescription(*description)
+	}
+	if eventTypes != nil {
+		builder.SetEventTypes(eventTypes)
+	}
+	if enabled != nil {
+		builder.SetEnabled(*enabled)
+	}
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, wardenV1.ErrorConflict("webhook with this name already exists")
+		}
+		r.log.Errorf("update webhook failed: %s", err.Error())
+		return nil, wardenV1.ErrorInternalServerError("update webhook failed")
+	}
+	if affected == 0 {
+		return nil, wardenV1.ErrorNotFound("webhook not found")
+	}
+
+	return r.GetByIDAndTenant(ctx, tenantID, id)
+}
+
+// Delete removes a webhook of a tenant together with its delivery log
+func (r *WebhookRepo) Delete(ctx context.Context, tenantID, id uint32) error {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete webhook failed")
+	}
+
+	affected, err := tx.Webhook.Delete().
+		Where(webhook.IDEQ(id), webhook.TenantIDEQ(tenantID)).
+		Exec(ctx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		r.log.Errorf("delete webhook failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete webhook failed")
+	}
+	if affected == 0 {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		return wardenV1.ErrorNotFound("webhook not found")
+	}
+
+	if _, err := tx.WebhookDelivery.Delete().
+		Where(webhookdelivery.WebhookIDEQ(id)).
+		Exec(ctx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		r.log.Errorf("delete webhook deliveries failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete webhook failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return wardenV1.ErrorInternalServerError("delete webhook failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.Webhook to wardenV1.Webhook. The secret is never returned.
+func (r *WebhookRepo) ToProto(entity *ent.Webhook) *wardenV1.Webhook {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &wardenV1.Webhook{
+		Id:          entity.ID,
+		TenantId:    derefUint32(entity.TenantID),
+		Name:        entity.Name,
+		Url:         entity.URL,
+		HasSecret:   entity.Secret != "",
+		Enabled:     entity.Enabled,
+		Description: entity.Description,
+		CreatedBy:   entity.CreateBy,
+	}
+
+	for _, t := range entity.EventTypes {
+		proto.EventTypes = append(proto.EventTypes, WebhookEventTypeToProto(t))
+	}
+
+	if entity.CreateTime != nil {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
+
+// Webhook event types as stored in the database
+const (
+	WebhookEventSecretExpiring    = "secret.expiring"
+	WebhookEventPermissionGranted = "permission.granted"
+	WebhookEventImportFinished    = "import.finished"
+	WebhookEventBackupCompleted   = "backup.completed"
+	WebhookEventSecretRead        = "secret.read"
+	WebhookEventCanaryRead        = "secret.canary_read"
+)
+
+// WebhookEventTypeToProto maps a stored event type to its proto enum
+func WebhookEventTypeToProto(eventType string) wardenV1.WebhookEventType {
+	switch eventType {
+	case WebhookEventSecretExpiring:
+		return wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_SECRET_EXPIRING
+	case WebhookEventPermissionGranted:
+		return wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_PERMISSION_GRANTED
+	case WebhookEventImportFinished:
+		return wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_IMPORT_FINISHED
+	case WebhookEventBackupCompleted:
+		return wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_BACKUP_COMPLETED
+	case WebhookEventSecretRead:
+		return wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_SECRET_READ
+	case WebhookEventCanaryRead:
+		return wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_CANARY_READ
+	default:
+		return wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+// WebhookEventTypeFromProto maps a proto event type to its stored form ("" if unspecified)
+func WebhookEventTypeFromProto(eventType wardenV1.WebhookEventType) string {
+	switch eventType {
+	case wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_SECRET_EXPIRING:
+		return WebhookEventSecretExpiring
+	case wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_PERMISSION_GRANTED:
+		return WebhookEventPermissionGranted
+	case wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_IMPORT_FINISHED:
+		return WebhookEventImportFinished
+	case wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_BACKUP_COMPLETED:
+		return WebhookEventBackupCompleted
+	case wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_SECRET_READ:
+		return WebhookEventSecretRead
+	case wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_CANARY_READ:
+		return WebhookEventCanaryRead
+	default:
+		return ""
+	}
+}
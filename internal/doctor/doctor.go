@@ -0,0 +1,305 @@
+// Package doctor runs the preflight checks behind the `doctor` command: it
+// verifies everything the service depends on before it is started. Nothing
+// is changed except a probe secret written to and destroyed in Vault.
+package doctor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-warden/internal/cert"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+)
+
+// Status is the outcome of a check
+type Status string
+
+const (
+	StatusOK   Status = "ok"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+	StatusSkip Status = "skip"
+)
+
+// probePrefix is where the Vault probe secrets are written, outside the
+// paths of tenants
+const probePrefix = "warden-doctor"
+
+// Check is the result of one check
+type Check struct {
+	Name       string `json:"name"`
+	Status     Status `json:"status"`
+	Detail     string `json:"detail,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// Report holds the results of all checks, in the order they ran
+type Report struct {
+	Checks []Check `json:"checks"`
+	OK     bool    `json:"ok"`
+}
+
+// Failed reports whether any check failed
+func (r *Report) Failed() bool {
+	return !r.OK
+}
+
+// WriteText prints the report as a table
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHECK\tSTATUS\tTIME\tDETAIL")
+	for _, c := range r.Checks {
+		fmt.Fprintf(tw, "%s\t%s\t%dms\t%s\n", c.Name, c.Status, c.DurationMs, c.Detail)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	result := "all checks passed"
+	if r.Failed() {
+		result = "some checks failed"
+	}
+	_, err := fmt.Fprintln(w, "\n"+result)
+	return err
+}
+
+// WriteJSON prints the report as JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// Run checks the database and its schema, Vault and its KV mount, the
+// certificate files and Redis, each within timeout
+func Run(ctx *bootstrap.Context, timeout time.Duration) *Report {
+	r := &Report{OK: true}
+
+	run := func(name string, fn func(ctx context.Context) (Status, string)) Status {
+		c, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		start := time.Now()
+		status, detail := fn(c)
+		r.Checks = append(r.Checks, Check{
+			Name:       name,
+			Status:     status,
+			Detail:     detail,
+			DurationMs: time.Since(start).Milliseconds(),
+		})
+		if status == StatusFail {
+			r.OK = false
+		}
+		return status
+	}
+	skip := func(name, detail string) {
+		r.Checks = append(r.Checks, Check{Name: name, Status: StatusSkip, Detail: detail})
+	}
+
+	drv, err := data.OpenDriver(ctx)
+	if err != nil {
+		run("database", func(context.Context) (Status, string) {
+			return StatusFail, err.Error()
+		})
+		skip("database.schema", "database unavailable")
+	} else {
+		client := ent.NewClient(ent.Driver(drv))
+		defer client.Close()
+		if run("database", func(c context.Context) (Status, string) {
+			return checkDatabase(c, client)
+		}) == StatusOK {
+			run("database.schema", func(c context.Context) (Status, string) {
+				return checkSchema(c, ctx, drv, client)
+			})
+		} else {
+			skip("database.schema", "database unavailable")
+		}
+	}
+
+	vaultClient, closeVault, err := data.NewVaultClient(ctx)
+	if err != nil {
+		run("vault", func(context.Context) (Status, string) {
+			return StatusFail, err.Error()
+		})
+		skip("vault.capabilities", "vault unavailable")
+		skip("vault.mount", "vault unavailable")
+	} else {
+		defer closeVault()
+		if run("vault", func(c context.Context) (Status, string) {
+			return checkVault(c, vaultClient)
+		}) == StatusOK {
+			run("vault.capabilities", func(c context.Context) (Status, string) {
+				return checkVaultCapabilities(c, vaultClient)
+			})
+			run("vault.mount", func(c context.Context) (Status, string) {
+				return checkVaultMount(c, vaultClient)
+			})
+		} else {
+			skip("vault.capabilities", "vault unavailable")
+			skip("vault.mount", "vault unavailable")
+		}
+	}
+
+	run("certificates", func(context.Context) (Status, string) {
+		return checkCertificates(ctx)
+	})
+
+	if cfg := ctx.GetConfig(); cfg == nil || cfg.Data == nil || cfg.Data.Redis == nil {
+		skip("redis", "not configured")
+	} else {
+		run("redis", func(c context.Context) (Status, string) {
+			redisClient, cleanup, err := data.NewRedisClient(ctx)
+			if err != nil {
+				return StatusFail, err.Error()
+			}
+			defer cleanup()
+			if err := redisClient.Ping(c).Err(); err != nil {
+				return StatusFail, err.Error()
+			}
+			return StatusOK, "ping answered"
+		})
+	}
+
+	return r
+}
+
+func checkDatabase(ctx context.Context, client *ent.Client) (Status, string) {
+	if _, err := client.Folder.Query().Limit(1).IDs(ctx); err != nil {
+		return StatusFail, err.Error()
+	}
+	return StatusOK, "query answered"
+}
+
+// checkSchema reports the migration version of the database and whether the
+// schema matches this build. Pending migrations are only a warning when the
+// server migrates on start.
+func checkSchema(ctx context.Context, bctx *bootstrap.Context, drv *entsql.Driver, client *ent.Client) (Status, string) {
+	migrator, err := data.NewMigrator(drv, bctx.NewLoggerHelper("warden/doctor"))
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+	status, err := migrator.Status(ctx)
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+
+	if !status.Tracked || status.Pending > 0 {
+		detail := fmt.Sprintf("at version %d, %d pending migrations up to %d", status.Version, status.Pending, migrator.Latest())
+		if !status.Tracked {
+			detail = "not under versioned migrations, run `server migrate up`"
+		}
+		if bctx.GetConfig().Data.Database.GetMigrate() {
+			return StatusWarn, detail + " (applied on start)"
+		}
+		return StatusFail, detail
+	}
+
+	stmts, err := data.PendingSchemaChanges(ctx, client)
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+	if len(stmts) > 0 {
+		return StatusWarn, fmt.Sprintf("at version %d but %d changes differ from this build, first: %s", status.Version, len(stmts), stmts[0])
+	}
+	return StatusOK, fmt.Sprintf("at version %d", status.Version)
+}
+
+func checkVault(ctx context.Context, client *vault.Client) (Status, string) {
+	if client.IsMemory() {
+		return StatusWarn, "VAULT_DEV_MODE in-memory store, secrets are lost on restart"
+	}
+	sealed, err := client.IsSealed(ctx)
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+	if sealed {
+		return StatusFail, "vault is sealed"
+	}
+	if client.GetClient().Token() == "" {
+		return StatusFail, "not authenticated, set VAULT_ROLE_ID and VAULT_SECRET_ID"
+	}
+	return StatusOK, "unsealed and authenticated"
+}
+
+// checkVaultCapabilities compares the capabilities of the Vault token on the
+// KV paths with the ones the service needs: missing ones fail, extra ones
+// warn
+func checkVaultCapabilities(ctx context.Context, client *vault.Client) (Status, string) {
+	mismatches, err := client.CheckCapabilities(ctx, vault.PathPrefix)
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+
+	var missing, excess []string
+	for _, m := range mismatches {
+		if len(m.Missing) > 0 {
+			missing = append(missing, fmt.Sprintf("%s [%s]", m.Path, strings.Join(m.Missing, ", ")))
+		}
+		if len(m.Excess) > 0 {
+			excess = append(excess, fmt.Sprintf("%s [%s]", m.Path, strings.Join(m.Excess, ", ")))
+		}
+	}
+	switch {
+	case len(missing) > 0:
+		return StatusFail, "missing " + strings.Join(missing, "; ")
+	case len(excess) > 0:
+		return StatusWarn, "more than needed " + strings.Join(excess, "; ")
+	}
+	return StatusOK, fmt.Sprintf("exactly the required capabilities below %s/*/%s", client.GetMountPath(), vault.PathPrefix)
+}
+
+// checkVaultMount writes, reads back and destroys a probe secret in the KV
+// mount, the operations the service needs
+func checkVaultMount(ctx context.Context, client *vault.Client) (Status, string) {
+	kv := vault.NewKVStore(client)
+	path := probePrefix + "/" + uuid.NewString()
+	value := uuid.NewString()
+
+	if _, err := kv.StorePassword(ctx, path, value, nil); err != nil {
+		return StatusFail, "write: " + err.Error()
+	}
+	got, _, readErr := kv.GetPassword(ctx, path)
+	if err := kv.DestroyAllVersions(ctx, path); err != nil {
+		return StatusFail, fmt.Sprintf("delete %s/%s: %v", client.GetMountPath(), path, err)
+	}
+	if readErr != nil {
+		return StatusFail, "read: " + readErr.Error()
+	}
+	defer got.Wipe()
+	if string(got.Bytes()) != value {
+		return StatusFail, "read back a different value"
+	}
+	return StatusOK, fmt.Sprintf("write, read and delete on mount %q", client.GetMountPath())
+}
+
+func checkCertificates(ctx *bootstrap.Context) (Status, string) {
+	state, err := cert.Check(ctx)
+	if errors.Is(err, os.ErrNotExist) {
+		return StatusSkip, "no certificates in CERTS_DIR, TLS disabled"
+	}
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+
+	detail := fmt.Sprintf("%s certificate expires %s", state.Certificate, state.NotAfter.Format(time.RFC3339))
+	switch {
+	case state.Expired:
+		return StatusFail, detail
+	case state.ExpiringSoon:
+		return StatusWarn, detail
+	}
+	return StatusOK, detail
+}
@@ -0,0 +1,154 @@
+package job
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+)
+
+const defaultAccessFlushInterval = 30 * time.Second
+
+// tenantAccesses is the set of secrets and folders of one tenant read since
+// the last flush
+type tenantAccesses struct {
+	secrets map[string]struct{}
+	folders map[string]struct{}
+}
+
+// AccessTracker batches the last-accessed updates caused by password reads.
+// Reads are collected in memory and written once per flush interval, so a
+// secret read many times costs one UPDATE per interval instead of one per
+// read. Recorded timestamps are therefore accurate to the flush interval.
+type AccessTracker struct {
+	log  *log.Helper
+	repo *data.SecretRepo
+
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending map[uint32]*tenantAccesses
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewAccessTracker creates the tracker. ACCESS_FLUSH_INTERVAL sets how often
+// recorded reads are written; 0 writes every read immediately.
+func NewAccessTracker(ctx *bootstrap.Context, repo *data.SecretRepo) *AccessTracker {
+	l := ctx.NewLoggerHelper("warden/job/access-tracker")
+
+	t := &AccessTracker{
+		log:      l,
+		repo:     repo,
+		interval: defaultAccessFlushInterval,
+		pending:  make(map[uint32]*tenantAccesses),
+	}
+
+	if v := os.Getenv("ACCESS_FLUSH_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			t.interval = d
+		} else {
+			l.Warnf("Invalid ACCESS_FLUSH_INTERVAL %q, using %s", v, t.interval)
+		}
+	}
+
+	return t
+}
+
+// Record notes that the password of a secret was read. It never fails the read.
+func (t *AccessTracker) Record(ctx context.Context, tenantID uint32, secretID string, folderID *string) {
+	if t.interval == 0 {
+		var folderIDs []string
+		if folderID != nil && *folderID != "" {
+			folderIDs = []string{*folderID}
+		}
+		if err := t.repo.TouchLastAccessed(ctx, tenantID, []string{secretID}, folderIDs, time.Now()); err != nil {
+			t.log.Warnf("Record access to secret %s failed: %v", secretID, err)
+		}
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	acc, ok := t.pending[tenantID]
+	if !ok {
+		acc = &tenantAccesses{secrets: make(map[string]struct{}), folders: make(map[string]struct{})}
+		t.pending[tenantID] = acc
+	}
+	acc.secrets[secretID] = struct{}{}
+	if folderID != nil && *folderID != "" {
+		acc.folders[*folderID] = struct{}{}
+	}
+}
+
+// Start implements transport.Server and launches the flush loop.
+func (t *AccessTracker) Start(_ context.Context) error {
+	if t.interval == 0 {
+		t.log.Info("Access tracker writing through")
+		return nil
+	}
+	t.stopCh = make(chan struct{})
+	t.wg.Add(1)
+	go t.loop()
+	t.log.Infof("Access tracker started: interval=%s", t.interval)
+	return nil
+}
+
+// Stop implements transport.Server and writes the reads recorded so far.
+func (t *AccessTracker) Stop(_ context.Context) error {
+	if t.stopCh != nil {
+		close(t.stopCh)
+		t.wg.Wait()
+	}
+	t.Flush(appViewer.NewSystemViewerContext(context.Background()))
+	return nil
+}
+
+func (t *AccessTracker) loop() {
+	defer t.wg.Done()
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.stopCh:
+			return
+		case <-ticker.C:
+			t.Flush(appViewer.NewSystemViewerContext(context.Background()))
+		}
+	}
+}
+
+// Flush writes the recorded reads, one batch per tenant. A failed batch is
+// dropped: last-accessed times are advisory.
+func (t *AccessTracker) Flush(ctx context.Context) {
+	t.mu.Lock()
+	pending := t.pending
+	t.pending = make(map[uint32]*tenantAccesses)
+	t.mu.Unlock()
+
+	now := time.Now()
+	for tenantID, acc := range pending {
+		if err := t.repo.TouchLastAccessed(ctx, tenantID, setKeys(acc.secrets), setKeys(acc.folders), now); err != nil {
+			t.log.Warnf("Record access to %d secrets of tenant %d failed: %v", len(acc.secrets), tenantID, err)
+		}
+	}
+}
+
+func setKeys(m map[string]struct{}) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
@@ -0,0 +1,358 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/securityalert"
+)
+
+const (
+	defaultAnomalyInterval      = 5 * time.Minute
+	defaultAnomalyReadWindow    = time.Hour
+	defaultAnomalyReadThreshold = 50
+	defaultAnomalyHistoryDays   = 30
+
+	anomalyHistoryLimit = 5000
+	anomalyEventLimit   = 10000
+)
+
+// AnomalyDetectionJob periodically scans the audit log for unusual access
+// patterns and raises security alerts:
+//
+//   - a user reading more passwords than ANOMALY_READ_THRESHOLD within
+//     ANOMALY_READ_WINDOW
+//   - a user reading a password from a peer address or country not seen in
+//     the preceding ANOMALY_HISTORY_DAYS
+//
+// Alerts are stored and, when ANOMALY_WEBHOOK_URL is set, posted to a webhook.
+type AnomalyDetectionJob struct {
+	log          *log.Helper
+	auditLogRepo *data.AuditLogRepo
+	alertRepo    *data.SecurityAlertRepo
+
+	interval      time.Duration
+	readWindow    time.Duration
+	readThreshold int
+	historyDays   int
+
+	webhookURL    string
+	webhookSecret string
+	httpClient    *http.Client
+
+	lastRun time.Time
+	stopCh  chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewAnomalyDetectionJob creates the detection job from environment configuration.
+// ANOMALY_DETECTION_INTERVAL=0 disables it.
+func NewAnomalyDetectionJob(ctx *bootstrap.Context, auditLogRepo *data.AuditLogRepo, alertRepo *data.SecurityAlertRepo) *AnomalyDetectionJob {
+	l := ctx.NewLoggerHelper("warden/job/anomaly-detection")
+
+	j := &AnomalyDetectionJob{
+		log:           l,
+		auditLogRepo:  auditLogRepo,
+		alertRepo:     alertRepo,
+		interval:      defaultAnomalyInterval,
+		readWindow:    defaultAnomalyReadWindow,
+		readThreshold: defaultAnomalyReadThreshold,
+		historyDays:   defaultAnomalyHistoryDays,
+		webhookURL:    os.Getenv("ANOMALY_WEBHOOK_URL"),
+		webhookSecret: os.Getenv("ANOMALY_WEBHOOK_SECRET"),
+		httpClient:    &http.Client{Timeout: 10 * time.Second},
+	}
+
+	if v := os.Getenv("ANOMALY_DETECTION_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			j.interval = d
+		} else {
+			l.Warnf("Invalid ANOMALY_DETECTION_INTERVAL %q, using %s", v, j.interval)
+		}
+	}
+	if v := os.Getenv("ANOMALY_READ_WINDOW"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			j.readWindow = d
+		} else {
+			l.Warnf("Invalid ANOMALY_READ_WINDOW %q, using %s", v, j.readWindow)
+		}
+	}
+	if v := os.Getenv("ANOMALY_READ_THRESHOLD"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			j.readThreshold = n
+		} else {
+			l.Warnf("Invalid ANOMALY_READ_THRESHOLD %q, using %d", v, j.readThreshold)
+		}
+	}
+	if v := os.Getenv("ANOMALY_HISTORY_DAYS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			j.historyDays = n
+		} else {
+			l.Warnf("Invalid ANOMALY_HISTORY_DAYS %q, using %d", v, j.historyDays)
+		}
+	}
+
+	return j
+}
+
+// Start implements transport.Server and launches the detection loop.
+func (j *AnomalyDetectionJob) Start(_ context.Context) error {
+	if j.interval == 0 {
+		j.log.Info("Anomaly detection disabled")
+		return nil
+	}
+	j.lastRun = time.Now().Add(-j.interval)
+	j.stopCh = make(chan struct{})
+	j.wg.Add(1)
+	go j.loop()
+	j.log.Infof("Anomaly detection started: interval=%s window=%s threshold=%d", j.interval, j.readWindow, j.readThreshold)
+	return nil
+}
+
+// Stop implements transport.Server.
+func (j *AnomalyDetectionJob) Stop(_ context.Context) error {
+	if j.stopCh != nil {
+		close(j.stopCh)
+		j.wg.Wait()
+	}
+	return nil
+}
+
+func (j *AnomalyDetectionJob) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.stopCh:
+			return
+		case <-ticker.C:
+			now := time.Now()
+			ctx := appViewer.NewSystemViewerContext(context.Background())
+			raised, err := j.Detect(ctx, j.lastRun, now)
+			if err != nil {
+				j.log.Errorf("Anomaly detection failed: %v", err)
+				continue
+			}
+			j.lastRun = now
+			if raised > 0 {
+				j.log.Warnf("Anomaly detection raised %d security alerts", raised)
+			}
+		}
+	}
+}
+
+// Detect checks the activity in [since, now) and returns the number of alerts raised.
+func (j *AnomalyDetectionJob) Detect(ctx context.Context, since, now time.Time) (int, error) {
+	raised, err := j.detectExcessiveReads(ctx, now)
+	if err != nil {
+		return raised, err
+	}
+	n, err := j.detectNewLocations(ctx, since, now)
+	return raised + n, err
+}
+
+// detectExcessiveReads flags users whose password reads in the window exceed the threshold
+func (j *AnomalyDetectionJob) detectExcessiveReads(ctx context.Context, now time.Time) (int, error) {
+	windowStart := now.Add(-j.readWindow)
+	counts, err := j.auditLogRepo.CountEventsByActor(ctx, auditevent.SecretPasswordRead, windowStart)
+	if err != nil {
+		return 0, err
+	}
+
+	raised := 0
+	for _, c := range counts {
+		if c.Count <= j.readThreshold {
+			continue
+		}
+		// One alert per user and window
+		exists, err := j.alertRepo.ExistsSince(ctx, c.TenantID, c.ActorID, securityalert.KindALERT_KIND_EXCESSIVE_READS, windowStart)
+		if err != nil {
+			return raised, err
+		}
+		if exists {
+			continue
+		}
+
+		severity := securityalert.SeverityALERT_SEVERITY_MEDIUM
+		if c.Count > 2*j.readThreshold {
+			severity = securityalert.SeverityALERT_SEVERITY_HIGH
+		}
+
+		if err := j.raise(ctx, c.TenantID, securityalert.KindALERT_KIND_EXCESSIVE_READS, severity, c.ActorID, "",
+			fmt.Sprintf("User %s read %d passwords within %s", c.ActorID, c.Count, j.readWindow),
+			map[string]string{
+				"count":     strconv.Itoa(c.Count),
+				"threshold": strconv.Itoa(j.readThreshold),
+				"window":    j.readWindow.String(),
+			}); err != nil {
+			return raised, err
+		}
+		raised++
+	}
+	return raised, nil
+}
+
+type actorKey struct {
+	tenantID uint32
+	actorID  string
+}
+
+type actorHistory struct {
+	hosts     map[string]bool
+	countries map[string]bool
+}
+
+// detectNewLocations flags password reads from a peer address or country the
+// user has not used in the history window
+func (j *AnomalyDetectionJob) detectNewLocations(ctx context.Context, since, now time.Time) (int, error) {
+	events, err := j.auditLogRepo.ListEventsBetween(ctx, auditevent.SecretPasswordRead, since, now, anomalyEventLimit)
+	if err != nil {
+		return 0, err
+	}
+
+	historyStart := since.AddDate(0, 0, -j.historyDays)
+	histories := make(map[actorKey]*actorHistory)
+	raised := 0
+
+	for _, e := range events {
+		if e.TenantID == nil {
+			continue
+		}
+		key := actorKey{tenantID: *e.TenantID, actorID: e.ActorID}
+
+		hist, ok := histories[key]
+		if !ok {
+			past, err := j.auditLogRepo.ListActorHistory(ctx, key.tenantID, key.actorID, historyStart, since, anomalyHistoryLimit)
+			if err != nil {
+				return raised, err
+			}
+			hist = newActorHistory(past)
+			histories[key] = hist
+		}
+
+		// Without a baseline every address would be "new"; learn it instead
+		firstSeen := len(hist.hosts) == 0 && len(hist.countries) == 0
+
+		host := peerHost(e.PeerAddress)
+		if host != "" && !hist.hosts[host] {
+			hist.hosts[host] = true
+			if !firstSeen {
+				if err := j.raise(ctx, key.tenantID, securityalert.KindALERT_KIND_NEW_PEER_ADDRESS, securityalert.SeverityALERT_SEVERITY_LOW,
+					key.actorID, e.ResourceID,
+					fmt.Sprintf("User %s read a password from new address %s", key.actorID, host),
+					map[string]string{"peer_address": host, "audit_id": e.AuditID}); err != nil {
+					return raised, err
+				}
+				raised++
+			}
+		}
+
+		country := e.GeoLocation["country"]
+		if country != "" && !hist.countries[country] {
+			hist.countries[country] = true
+			if !firstSeen {
+				if err := j.raise(ctx, key.tenantID, securityalert.KindALERT_KIND_NEW_GEO_LOCATION, securityalert.SeverityALERT_SEVERITY_HIGH,
+					key.actorID, e.ResourceID,
+					fmt.Sprintf("User %s read a password from new location %s", key.actorID, country),
+					map[string]string{"country": country, "peer_address": host, "audit_id": e.AuditID}); err != nil {
+					return raised, err
+				}
+				raised++
+			}
+		}
+	}
+
+	return raised, nil
+}
+
+func newActorHistory(past []*ent.AuditLog) *actorHistory {
+	hist := &actorHistory{hosts: make(map[string]bool), countries: make(map[string]bool)}
+	for _, p := range past {
+		if host := peerHost(p.PeerAddress); host != "" {
+			hist.hosts[host] = true
+		}
+		if country := p.GeoLocation["country"]; country != "" {
+			hist.countries[country] = true
+		}
+	}
+	return hist
+}
+
+// peerHost strips the port from a peer address
+func peerHost(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+func (j *AnomalyDetectionJob) raise(ctx context.Context, tenantID uint32, kind securityalert.Kind, severity securityalert.Severity, userID, resourceID, message string, details map[string]string) error {
+	alert, err := j.alertRepo.Create(ctx, tenantID, kind, severity, userID, resourceID, message, details)
+	if err != nil {
+		return err
+	}
+	j.log.Warnf("Security alert: tenant=%d kind=%s user=%s: %s", tenantID, kind, userID, message)
+
+	if j.webhookURL != "" {
+		if err := j.notify(ctx, alert); err != nil {
+			j.log.Errorf("Security alert webhook failed: %v", err)
+		}
+	}
+	return nil
+}
+
+// notify posts the alert to the configured webhook, HMAC-signed when a secret is set
+func (j *AnomalyDetectionJob) notify(ctx context.Context, alert *ent.SecurityAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, j.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if j.webhookSecret != "" {
+		mac := hmac.New(sha256.New, []byte(j.webhookSecret))
+		mac.Write(body)
+		req.Header.Set("X-Warden-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := j.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
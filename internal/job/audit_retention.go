@@ -0,0 +1,194 @@
+package job
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+)
+
+const (
+	defaultAuditRetentionDays = 365
+	defaultAuditPruneInterval = 24 * time.Hour
+)
+
+// TenantPruneResult is the outcome of pruning one tenant (TenantID 0 covers
+// every tenant on the deployment default retention).
+type TenantPruneResult struct {
+	TenantID      uint32
+	RetentionDays int32
+	Deleted       int64
+}
+
+// PruneReport summarizes a pruning run.
+type PruneReport struct {
+	Results      []TenantPruneResult
+	TotalDeleted int64
+}
+
+// AuditRetentionJob periodically deletes audit logs that fall outside the
+// retention window. Tenants may override the deployment default through
+// their TenantSetting; a default of 0 disables pruning for everyone else.
+type AuditRetentionJob struct {
+	log          *log.Helper
+	auditLogRepo *data.AuditLogRepo
+	settingsRepo *data.TenantSettingRepo
+
+	defaultDays int32
+	interval    time.Duration
+
+	mu     sync.Mutex // serializes scheduled and manual runs
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewAuditRetentionJob creates the retention job. Configuration comes from
+// AUDIT_RETENTION_DAYS and AUDIT_PRUNE_INTERVAL.
+func NewAuditRetentionJob(ctx *bootstrap.Context, auditLogRepo *data.AuditLogRepo, settingsRepo *data.TenantSettingRepo) *AuditRetentionJob {
+	l := ctx.NewLoggerHelper("warden/job/audit-retention")
+
+	days := int32(defaultAuditRetentionDays)
+	if v := os.Getenv("AUDIT_RETENTION_DAYS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			days = int32(n)
+		} else {
+			l.Warnf("Invalid AUDIT_RETENTION_DAYS %q, using %d", v, days)
+		}
+	}
+
+	interval := defaultAuditPruneInterval
+	if v := os.Getenv("AUDIT_PRUNE_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			interval = d
+		} else {
+			l.Warnf("Invalid AUDIT_PRUNE_INTERVAL %q, using %s", v, interval)
+		}
+	}
+
+	return &AuditRetentionJob{
+		log:          l,
+		auditLogRepo: auditLogRepo,
+		settingsRepo: settingsRepo,
+		defaultDays:  days,
+		interval:     interval,
+	}
+}
+
+// DefaultRetentionDays returns the deployment-wide retention (0 = keep forever).
+func (j *AuditRetentionJob) DefaultRetentionDays() int32 {
+	return j.defaultDays
+}
+
+// Start implements transport.Server and launches the pruning loop.
+func (j *AuditRetentionJob) Start(_ context.Context) error {
+	j.stopCh = make(chan struct{})
+	j.wg.Add(1)
+	go j.loop()
+	j.log.Infof("Audit retention job started: default=%d days interval=%s", j.defaultDays, j.interval)
+	return nil
+}
+
+// Stop implements transport.Server and waits for an in-flight run to finish.
+func (j *AuditRetentionJob) Stop(_ context.Context) error {
+	if j.stopCh != nil {
+		close(j.stopCh)
+		j.wg.Wait()
+	}
+	return nil
+}
+
+func (j *AuditRetentionJob) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.stopCh:
+			return
+		case <-ticker.C:
+			ctx := appViewer.NewSystemViewerContext(context.Background())
+			report, err := j.Prune(ctx, nil)
+			if err != nil {
+				j.log.Errorf("Scheduled audit pruning failed: %v", err)
+				continue
+			}
+			j.log.Infof("Scheduled audit pruning deleted %d entries", report.TotalDeleted)
+		}
+	}
+}
+
+// Prune deletes expired audit logs. When tenantID is set only that tenant is
+// pruned, using its effective retention.
+func (j *AuditRetentionJob) Prune(ctx context.Context, tenantID *uint32) (*PruneReport, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	settings, err := j.settingsRepo.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	overrides := make(map[uint32]int32, len(settings))
+	for _, s := range settings {
+		if s.TenantID != nil && s.AuditRetentionDays > 0 {
+			overrides[*s.TenantID] = s.AuditRetentionDays
+		}
+	}
+
+	now := time.Now()
+	report := &PruneReport{}
+
+	if tenantID != nil {
+		days := j.defaultDays
+		if d, ok := overrides[*tenantID]; ok {
+			days = d
+		}
+		if days == 0 {
+			return report, nil
+		}
+		deleted, err := j.auditLogRepo.DeleteOlderThanForTenant(ctx, *tenantID, cutoff(now, days))
+		if err != nil {
+			return nil, err
+		}
+		report.add(*tenantID, days, deleted)
+		return report, nil
+	}
+
+	excluded := make([]uint32, 0, len(overrides))
+	for tid, days := range overrides {
+		excluded = append(excluded, tid)
+		deleted, err := j.auditLogRepo.DeleteOlderThanForTenant(ctx, tid, cutoff(now, days))
+		if err != nil {
+			return nil, err
+		}
+		report.add(tid, days, deleted)
+	}
+
+	if j.defaultDays > 0 {
+		deleted, err := j.auditLogRepo.DeleteOlderThanExcludingTenants(ctx, cutoff(now, j.defaultDays), excluded)
+		if err != nil {
+			return nil, err
+		}
+		report.add(0, j.defaultDays, deleted)
+	}
+
+	return report, nil
+}
+
+func (r *PruneReport) add(tenantID uint32, days int32, deleted int) {
+	r.Results = append(r.Results, TenantPruneResult{TenantID: tenantID, RetentionDays: days, Deleted: int64(deleted)})
+	r.TotalDeleted += int64(deleted)
+}
+
+func cutoff(now time.Time, days int32) time.Time {
+	return now.AddDate(0, 0, -int(days))
+}
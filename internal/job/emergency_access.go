@@ -0,0 +1,122 @@
+package job
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+)
+
+const defaultEmergencyAccessInterval = 5 * time.Minute
+
+// EmergencyAccessGranter periodically grants emergency access requests whose
+// waiting period ended without the grantor rejecting them.
+type EmergencyAccessGranter struct {
+	log       *log.Helper
+	repo      *data.EmergencyAccessRepo
+	auditRepo *data.AuditLogRepo
+
+	interval time.Duration
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewEmergencyAccessGranter creates the job. EMERGENCY_ACCESS_INTERVAL sets
+// how often pending requests are checked; 0 disables the job.
+func NewEmergencyAccessGranter(ctx *bootstrap.Context, repo *data.EmergencyAccessRepo, auditRepo *data.AuditLogRepo) *EmergencyAccessGranter {
+	l := ctx.NewLoggerHelper("warden/job/emergency-access")
+
+	j := &EmergencyAccessGranter{
+		log:       l,
+		repo:      repo,
+		auditRepo: auditRepo,
+		interval:  defaultEmergencyAccessInterval,
+	}
+
+	if v := os.Getenv("EMERGENCY_ACCESS_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			j.interval = d
+		} else {
+			l.Warnf("Invalid EMERGENCY_ACCESS_INTERVAL %q, using %s", v, j.interval)
+		}
+	}
+
+	return j
+}
+
+// Start implements transport.Server and launches the grant loop.
+func (j *EmergencyAccessGranter) Start(_ context.Context) error {
+	if j.interval == 0 {
+		j.log.Info("Emergency access granting disabled")
+		return nil
+	}
+	j.stopCh = make(chan struct{})
+	j.wg.Add(1)
+	go j.loop()
+	j.log.Infof("Emergency access granting started: interval=%s", j.interval)
+	return nil
+}
+
+// Stop implements transport.Server.
+func (j *EmergencyAccessGranter) Stop(_ context.Context) error {
+	if j.stopCh != nil {
+		close(j.stopCh)
+		j.wg.Wait()
+	}
+	return nil
+}
+
+func (j *EmergencyAccessGranter) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.stopCh:
+			return
+		case <-ticker.C:
+			j.RunOnce(appViewer.NewSystemViewerContext(context.Background()))
+		}
+	}
+}
+
+// RunOnce grants every request whose waiting period is over
+func (j *EmergencyAccessGranter) RunOnce(ctx context.Context) {
+	due, err := j.repo.ListDue(ctx, time.Now())
+	if err != nil {
+		j.log.Errorf("List due emergency access failed: %v", err)
+		return
+	}
+
+	for _, ea := range due {
+		// A grantor rejecting concurrently wins; Grant then reports a conflict
+		granted, err := j.repo.Grant(ctx, ea)
+		if err != nil {
+			j.log.Warnf("Grant emergency access %d failed: %v", ea.ID, err)
+			continue
+		}
+
+		var tenantID uint32
+		if granted.TenantID != nil {
+			tenantID = *granted.TenantID
+		}
+		if err := j.auditRepo.RecordSystemEvent(ctx, "job/emergency-access", tenantID, granted.GranteeID,
+			auditevent.EmergencyAccessGranted, auditevent.ResourceFolder, granted.FolderID); err != nil {
+			j.log.Errorf("Audit emergency access grant %d failed: %v", granted.ID, err)
+		}
+
+		j.log.Infof("Emergency access granted after waiting period: id=%d folder=%s grantee=%s",
+			granted.ID, granted.FolderID, granted.GranteeID)
+	}
+}
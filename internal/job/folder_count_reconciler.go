@@ -0,0 +1,102 @@
+package job
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+)
+
+const defaultFolderCountReconcileInterval = time.Hour
+
+// FolderCountReconciler periodically recomputes the denormalized secret and
+// subfolder counts of folders, correcting drift left by writes that bypass
+// the repositories (backup restores, manual SQL) or by failed requests.
+type FolderCountReconciler struct {
+	log  *log.Helper
+	repo *data.MaintenanceRepo
+
+	interval time.Duration
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewFolderCountReconciler creates the job. FOLDER_COUNT_RECONCILE_INTERVAL
+// sets how often counts are checked; 0 disables the job.
+func NewFolderCountReconciler(ctx *bootstrap.Context, repo *data.MaintenanceRepo) *FolderCountReconciler {
+	l := ctx.NewLoggerHelper("warden/job/folder-counts")
+
+	j := &FolderCountReconciler{
+		log:      l,
+		repo:     repo,
+		interval: defaultFolderCountReconcileInterval,
+	}
+
+	if v := os.Getenv("FOLDER_COUNT_RECONCILE_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			j.interval = d
+		} else {
+			l.Warnf("Invalid FOLDER_COUNT_RECONCILE_INTERVAL %q, using %s", v, j.interval)
+		}
+	}
+
+	return j
+}
+
+// Start implements transport.Server and launches the reconciliation loop.
+func (j *FolderCountReconciler) Start(_ context.Context) error {
+	if j.interval == 0 {
+		j.log.Info("Folder count reconciliation disabled")
+		return nil
+	}
+	j.stopCh = make(chan struct{})
+	j.wg.Add(1)
+	go j.loop()
+	j.log.Infof("Folder count reconciliation started: interval=%s", j.interval)
+	return nil
+}
+
+// Stop implements transport.Server.
+func (j *FolderCountReconciler) Stop(_ context.Context) error {
+	if j.stopCh != nil {
+		close(j.stopCh)
+		j.wg.Wait()
+	}
+	return nil
+}
+
+func (j *FolderCountReconciler) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.stopCh:
+			return
+		case <-ticker.C:
+			j.RunOnce(appViewer.NewSystemViewerContext(context.Background()))
+		}
+	}
+}
+
+// RunOnce reconciles the counts of all folders
+func (j *FolderCountReconciler) RunOnce(ctx context.Context) {
+	fixed, err := j.repo.ReconcileFolderCounts(ctx, nil)
+	if err != nil {
+		j.log.Errorf("Folder count reconciliation failed: %v", err)
+		return
+	}
+	if fixed > 0 {
+		j.log.Warnf("Corrected drifted counts of %d folders", fixed)
+	}
+}
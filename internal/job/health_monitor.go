@@ -0,0 +1,219 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+)
+
+const (
+	defaultHealthCheckInterval = 10 * time.Second
+	defaultHealthCheckTimeout  = 3 * time.Second
+)
+
+// Health service names of the individual dependencies. The overall status
+// ("" service) is SERVING only while every critical dependency is healthy.
+const (
+	HealthServiceDatabase = "warden.database"
+	HealthServiceVault    = "warden.vault"
+	HealthServiceRedis    = "warden.redis"
+)
+
+type dependencyCheck struct {
+	service  string
+	critical bool
+	check    func(ctx context.Context) error
+}
+
+// HealthMonitor backs the standard grpc.health.v1 service with periodic
+// dependency checks: a database query, the Vault seal and token state and a
+// Redis ping. The database and Vault are critical; Redis is reported on its
+// own service name only.
+type HealthMonitor struct {
+	log    *log.Helper
+	server *health.Server
+	checks []dependencyCheck
+
+	interval time.Duration
+	timeout  time.Duration
+
+	mu      sync.Mutex
+	healthy map[string]bool
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewHealthMonitor creates the health monitor. Configuration comes from
+// HEALTH_CHECK_INTERVAL and HEALTH_CHECK_TIMEOUT.
+func NewHealthMonitor(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], vaultClient *vault.Client, redisClient *redis.Client) *HealthMonitor {
+	l := ctx.NewLoggerHelper("warden/job/health-monitor")
+
+	interval := defaultHealthCheckInterval
+	if v := os.Getenv("HEALTH_CHECK_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			interval = d
+		} else {
+			l.Warnf("Invalid HEALTH_CHECK_INTERVAL %q, using %s", v, interval)
+		}
+	}
+
+	timeout := defaultHealthCheckTimeout
+	if v := os.Getenv("HEALTH_CHECK_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			timeout = d
+		} else {
+			l.Warnf("Invalid HEALTH_CHECK_TIMEOUT %q, using %s", v, timeout)
+		}
+	}
+
+	m := &HealthMonitor{
+		log:      l,
+		server:   health.NewServer(),
+		interval: interval,
+		timeout:  timeout,
+		healthy:  make(map[string]bool),
+	}
+
+	m.checks = append(m.checks, dependencyCheck{
+		service:  HealthServiceDatabase,
+		critical: true,
+		check: func(ctx context.Context) error {
+			return data.PingDatabase(ctx, entClient)
+		},
+	})
+	m.checks = append(m.checks, dependencyCheck{
+		service:  HealthServiceVault,
+		critical: true,
+		check: func(ctx context.Context) error {
+			if vaultClient == nil {
+				return errors.New("vault client not configured")
+			}
+			if vaultClient.IsTokenRenewalFailed() {
+				return errors.New("vault token renewal failed")
+			}
+			sealed, err := vaultClient.IsSealed(ctx)
+			if err != nil {
+				return err
+			}
+			if sealed {
+				return errors.New("vault is sealed")
+			}
+			return nil
+		},
+	})
+	if redisClient != nil {
+		m.checks = append(m.checks, dependencyCheck{
+			service: HealthServiceRedis,
+			check: func(ctx context.Context) error {
+				return redisClient.Ping(ctx).Err()
+			},
+		})
+	}
+
+	// Not serving until the first round of checks has passed
+	m.server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	for _, c := range m.checks {
+		m.server.SetServingStatus(c.service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	return m
+}
+
+// Server returns the grpc.health.v1 implementation to register on the gRPC server.
+func (m *HealthMonitor) Server() healthpb.HealthServer {
+	return m.server
+}
+
+// Start implements transport.Server, runs a first round of checks and
+// launches the check loop.
+func (m *HealthMonitor) Start(_ context.Context) error {
+	m.CheckNow()
+
+	m.stopCh = make(chan struct{})
+	m.wg.Add(1)
+	go m.loop()
+	m.log.Infof("Health monitor started: interval=%s timeout=%s", m.interval, m.timeout)
+	return nil
+}
+
+// Stop implements transport.Server. Every service is reported as NOT_SERVING
+// from here on so load balancers drain the instance during shutdown.
+func (m *HealthMonitor) Stop(_ context.Context) error {
+	if m.stopCh != nil {
+		close(m.stopCh)
+		m.wg.Wait()
+	}
+	m.server.Shutdown()
+	return nil
+}
+
+func (m *HealthMonitor) loop() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stopCh:
+			return
+		case <-ticker.C:
+			m.CheckNow()
+		}
+	}
+}
+
+// CheckNow runs every dependency check and updates the serving status.
+func (m *HealthMonitor) CheckNow() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	serving := true
+	for _, c := range m.checks {
+		ctx, cancel := context.WithTimeout(appViewer.NewSystemViewerContext(context.Background()), m.timeout)
+		err := c.check(ctx)
+		cancel()
+
+		healthy := err == nil
+		if was, seen := m.healthy[c.service]; !seen || was != healthy {
+			if healthy {
+				m.log.Infof("Dependency %s is healthy", c.service)
+			} else {
+				m.log.Errorf("Dependency %s is unhealthy: %v", c.service, err)
+			}
+		}
+		m.healthy[c.service] = healthy
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if !healthy {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			if c.critical {
+				serving = false
+			}
+		}
+		m.server.SetServingStatus(c.service, status)
+	}
+
+	if serving {
+		m.server.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	} else {
+		m.server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
@@ -0,0 +1,137 @@
+package job
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+)
+
+const (
+	defaultOutboxInterval = 30 * time.Second
+
+	outboxBatchSize  = 50
+	outboxLease      = 5 * time.Minute
+	outboxMinBackoff = 30 * time.Second
+	outboxMaxBackoff = time.Hour
+)
+
+// OutboxWorker carries out the pending Vault operations left behind by
+// requests that crashed or failed between Vault and the database, and retries
+// Vault deletions that failed. See data.PendingOperationRepo.
+type OutboxWorker struct {
+	log  *log.Helper
+	repo *data.PendingOperationRepo
+
+	interval time.Duration
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewOutboxWorker creates the worker. OUTBOX_INTERVAL sets how often pending
+// operations are polled; 0 disables the worker.
+func NewOutboxWorker(ctx *bootstrap.Context, repo *data.PendingOperationRepo) *OutboxWorker {
+	l := ctx.NewLoggerHelper("warden/job/outbox")
+
+	w := &OutboxWorker{
+		log:      l,
+		repo:     repo,
+		interval: defaultOutboxInterval,
+	}
+
+	if v := os.Getenv("OUTBOX_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			w.interval = d
+		} else {
+			l.Warnf("Invalid OUTBOX_INTERVAL %q, using %s", v, w.interval)
+		}
+	}
+
+	return w
+}
+
+// Start implements transport.Server and launches the polling loop.
+func (w *OutboxWorker) Start(_ context.Context) error {
+	if w.interval == 0 {
+		w.log.Info("Outbox worker disabled")
+		return nil
+	}
+	w.stopCh = make(chan struct{})
+	w.wg.Add(1)
+	go w.loop()
+	w.log.Infof("Outbox worker started: interval=%s", w.interval)
+	return nil
+}
+
+// Stop implements transport.Server.
+func (w *OutboxWorker) Stop(_ context.Context) error {
+	if w.stopCh != nil {
+		close(w.stopCh)
+		w.wg.Wait()
+	}
+	return nil
+}
+
+func (w *OutboxWorker) loop() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case <-ticker.C:
+			ctx := appViewer.NewSystemViewerContext(context.Background())
+			for {
+				n := w.RunOnce(ctx)
+				if n < outboxBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// RunOnce processes one batch of due operations and returns how many were claimed
+func (w *OutboxWorker) RunOnce(ctx context.Context) int {
+	now := time.Now()
+	ops, err := w.repo.ClaimDue(ctx, now, outboxLease, outboxBatchSize)
+	if err != nil {
+		w.log.Errorf("Claim pending operations failed: %v", err)
+		return 0
+	}
+
+	for _, op := range ops {
+		if err := w.repo.Process(ctx, op); err != nil {
+			attempts := op.Attempts + 1
+			next := now.Add(outboxBackoff(attempts))
+			w.log.Warnf("Pending operation %d on %s failed (attempt %d, retry at %s): %v",
+				op.ID, op.VaultPath, attempts, next.Format(time.RFC3339), err)
+			if recErr := w.repo.RecordFailure(ctx, op.ID, attempts, err.Error(), next); recErr != nil {
+				w.log.Errorf("Record pending operation failure failed: %v", recErr)
+			}
+			continue
+		}
+		w.log.Infof("Pending operation %d done: %s %s", op.ID, op.Kind, op.VaultPath)
+	}
+	return len(ops)
+}
+
+// outboxBackoff doubles the retry delay per attempt up to outboxMaxBackoff
+func outboxBackoff(attempts int32) time.Duration {
+	d := outboxMinBackoff
+	for i := int32(1); i < attempts && d < outboxMaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, outboxMaxBackoff)
+}
@@ -0,0 +1,122 @@
+package job
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+)
+
+const (
+	defaultUsageAggregationInterval = 24 * time.Hour
+	defaultUsageLookbackDays        = 2
+)
+
+// UsageAggregationJob periodically counts the reads and writes of every
+// secret per UTC day from the audit log into the usage statistics table.
+// Each run recomputes the last few complete days, so a run that was missed
+// or raced with late audit writes is corrected by the next one.
+type UsageAggregationJob struct {
+	log  *log.Helper
+	repo *data.SecretUsageRepo
+
+	interval     time.Duration
+	lookbackDays int
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewUsageAggregationJob creates the job. USAGE_AGGREGATION_INTERVAL sets how
+// often it runs (0 disables it) and USAGE_AGGREGATION_LOOKBACK_DAYS how many
+// complete days each run recomputes.
+func NewUsageAggregationJob(ctx *bootstrap.Context, repo *data.SecretUsageRepo) *UsageAggregationJob {
+	l := ctx.NewLoggerHelper("warden/job/usage-aggregation")
+
+	j := &UsageAggregationJob{
+		log:          l,
+		repo:         repo,
+		interval:     defaultUsageAggregationInterval,
+		lookbackDays: defaultUsageLookbackDays,
+	}
+
+	if v := os.Getenv("USAGE_AGGREGATION_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			j.interval = d
+		} else {
+			l.Warnf("Invalid USAGE_AGGREGATION_INTERVAL %q, using %s", v, j.interval)
+		}
+	}
+
+	if v := os.Getenv("USAGE_AGGREGATION_LOOKBACK_DAYS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			j.lookbackDays = n
+		} else {
+			l.Warnf("Invalid USAGE_AGGREGATION_LOOKBACK_DAYS %q, using %d", v, j.lookbackDays)
+		}
+	}
+
+	return j
+}
+
+// Start implements transport.Server and launches the aggregation loop.
+func (j *UsageAggregationJob) Start(_ context.Context) error {
+	if j.interval == 0 {
+		j.log.Info("Usage aggregation disabled")
+		return nil
+	}
+	j.stopCh = make(chan struct{})
+	j.wg.Add(1)
+	go j.loop()
+	j.log.Infof("Usage aggregation started: interval=%s lookback=%d days", j.interval, j.lookbackDays)
+	return nil
+}
+
+// Stop implements transport.Server.
+func (j *UsageAggregationJob) Stop(_ context.Context) error {
+	if j.stopCh != nil {
+		close(j.stopCh)
+		j.wg.Wait()
+	}
+	return nil
+}
+
+func (j *UsageAggregationJob) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.stopCh:
+			return
+		case <-ticker.C:
+			j.RunOnce(appViewer.NewSystemViewerContext(context.Background()))
+		}
+	}
+}
+
+// RunOnce aggregates the complete UTC days of the lookback window, oldest first
+func (j *UsageAggregationJob) RunOnce(ctx context.Context) {
+	y, m, d := time.Now().UTC().Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	for i := j.lookbackDays; i >= 1; i-- {
+		day := today.AddDate(0, 0, -i)
+		secrets, err := j.repo.AggregateDay(ctx, day)
+		if err != nil {
+			j.log.Errorf("Usage aggregation of %s failed: %v", day.Format(time.DateOnly), err)
+			continue
+		}
+		j.log.Debugf("Aggregated usage of %s: %d secrets", day.Format(time.DateOnly), secrets)
+	}
+}
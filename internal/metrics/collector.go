@@ -3,6 +3,7 @@ package metrics
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
@@ -32,6 +33,17 @@ type Collector struct {
 	// gRPC request metrics
 	RequestDuration *prometheus.HistogramVec
 	RequestsTotal   *prometheus.CounterVec
+
+	// Vault metrics
+	VaultOperationDuration *prometheus.HistogramVec
+	VaultOperationLatency  *prometheus.SummaryVec
+	VaultOperationErrors   *prometheus.CounterVec
+
+	// Authorization metrics
+	AuthzCheckDuration *prometheus.HistogramVec
+
+	// Certificate metrics
+	CertificateExpiry *prometheus.GaugeVec
 }
 
 // NewCollector creates and registers all warden Prometheus metrics.
@@ -74,6 +86,45 @@ func NewCollector(ctx *bootstrap.Context) *Collector {
 			Name:      "grpc_requests_total",
 			Help:      "Total number of gRPC requests by method and status.",
 		}, []string{"method", "status"}),
+
+		VaultOperationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "vault_operation_duration_seconds",
+			Help:      "Histogram of Vault KV operation durations in seconds.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation"}),
+
+		VaultOperationLatency: prometheus.NewSummaryVec(prometheus.SummaryOpts{
+			Namespace:  namespace,
+			Subsystem:  subsystem,
+			Name:       "vault_operation_latency_seconds",
+			Help:       "Latency percentiles of Vault KV operations over the last 10 minutes, in seconds.",
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+			MaxAge:     10 * time.Minute,
+		}, []string{"operation"}),
+
+		VaultOperationErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "vault_operation_errors_total",
+			Help:      "Total number of failed Vault KV operations by operation.",
+		}, []string{"operation"}),
+
+		AuthzCheckDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "authz_check_duration_seconds",
+			Help:      "Histogram of permission check durations in seconds by resource type and result.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"resource_type", "result"}),
+
+		CertificateExpiry: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "certificate_expiry_timestamp_seconds",
+			Help:      "Expiry of the loaded mTLS certificates as a Unix timestamp.",
+		}, []string{"certificate"}),
 	}
 
 	prometheus.MustRegister(
@@ -82,6 +133,11 @@ func NewCollector(ctx *bootstrap.Context) *Collector {
 		c.SecretVersionsTotal,
 		c.RequestDuration,
 		c.RequestsTotal,
+		c.VaultOperationDuration,
+		c.VaultOperationLatency,
+		c.VaultOperationErrors,
+		c.AuthzCheckDuration,
+		c.CertificateExpiry,
 	)
 
 	addr := os.Getenv("METRICS_ADDR")
@@ -148,3 +204,32 @@ func (c *Collector) FolderDeleted() {
 func (c *Collector) SecretVersionCreated() {
 	c.SecretVersionsTotal.Inc()
 }
+
+// --- Vault helpers ---
+
+// ObserveVaultOperation records the duration and outcome of a Vault KV operation.
+func (c *Collector) ObserveVaultOperation(operation string, duration time.Duration, err error) {
+	c.VaultOperationDuration.WithLabelValues(operation).Observe(duration.Seconds())
+	c.VaultOperationLatency.WithLabelValues(operation).Observe(duration.Seconds())
+	if err != nil {
+		c.VaultOperationErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+// --- Authorization helpers ---
+
+// ObserveAuthzCheck records the duration and result of a permission check.
+func (c *Collector) ObserveAuthzCheck(resourceType string, allowed bool, duration time.Duration) {
+	result := "denied"
+	if allowed {
+		result = "allowed"
+	}
+	c.AuthzCheckDuration.WithLabelValues(resourceType, result).Observe(duration.Seconds())
+}
+
+// --- Certificate helpers ---
+
+// SetCertificateExpiry records the expiry of a loaded certificate.
+func (c *Collector) SetCertificateExpiry(certificate string, notAfter time.Time) {
+	c.CertificateExpiry.WithLabelValues(certificate).Set(float64(notAfter.Unix()))
+}
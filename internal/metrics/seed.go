@@ -15,6 +15,7 @@ func (c *Collector) Seed(ctx context.Context, statsRepo *data.StatisticsRepo) {
 	if err != nil {
 		c.log.Errorf("Failed to seed secret stats: %v", err)
 	} else {
+		c.SecretsByStatus.Reset()
 		for status, count := range secretsByStatus {
 			c.SecretsByStatus.WithLabelValues(status).Set(float64(count))
 		}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/netip"
+
+	"github.com/go-kratos/kratos/v2/metadata"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/grpc/peer"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authn"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+)
+
+// clientAddrMiddleware records the client address for the network rules of
+// access policies. Callers with a verified client certificate are trusted
+// services such as the gateway, so the client IP they forward is used;
+// everyone else is judged by the address of the connection.
+func clientAddrMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if addr, ok := clientAddr(ctx); ok {
+				ctx = authz.WithClientAddr(ctx, addr)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+func clientAddr(ctx context.Context) (netip.Addr, bool) {
+	if authn.HasVerifiedClientCert(ctx) {
+		if md, ok := metadata.FromServerContext(ctx); ok {
+			if addr, err := netip.ParseAddr(md.Get(grpcx.MDClientIP)); err == nil {
+				return addr.Unmap(), true
+			}
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return netip.Addr{}, false
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/metadata"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/authn"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// MDGeoCountry is the request header carrying the ISO 3166-1 alpha-2 country
+// the gateway located the client in
+const MDGeoCountry = "x-md-global-geo-country"
+
+// passwordReadOperations return passwords or hints of them. GetVersion and
+// ExportToCsv only do when asked for the password.
+var passwordReadOperations = map[string]bool{
+	"/warden.service.v1.WardenSecretService/GetSecretPassword":              true,
+	"/warden.service.v1.WardenSecretService/GetSecretPasswordMasked":        true,
+	"/warden.service.v1.WardenSecretService/GetSecretByPath":                true,
+	"/warden.service.v1.WardenSecretService/FetchSecretsStream":             true,
+	"/warden.service.v1.WardenSecretService/CreateRetrievalToken":           true,
+	"/warden.service.v1.WardenSecretService/RedeemRetrievalToken":           true,
+	"/warden.service.v1.WardenSecretService/GetVersion":                     true,
+	"/warden.service.v1.WardenBitwardenTransferService/ExportToBitwarden":   true,
+	"/warden.service.v1.WardenCsvTransferService/ExportToCsv":               true,
+	"/warden.service.v1.WardenConfigExportService/ExportToEnvFile":          true,
+	"/warden.service.v1.WardenConfigExportService/ExportToKubernetesSecret": true,
+}
+
+// geoRestrictionMiddleware denies password reads from outside the countries
+// allowed by the tenant's geo policy. Platform admins are let through, with
+// the override recorded in the audit entry. It must run inside
+// auditevent.Middleware and the impersonation middleware.
+func geoRestrictionMiddleware(l *log.Helper, settingsRepo *data.TenantSettingRepo) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || !passwordReadOperations[tr.Operation()] || !readsPassword(req) {
+				return handler(ctx, req)
+			}
+
+			tenantID := grpcx.GetTenantIDFromContext(ctx)
+			setting, err := settingsRepo.Get(ctx, tenantID)
+			if err != nil {
+				return nil, err
+			}
+			if setting == nil || len(setting.GeoAllowedCountries) == 0 {
+				return handler(ctx, req)
+			}
+
+			country := geoCountry(ctx)
+			if country != "" && slices.Contains(setting.GeoAllowedCountries, country) {
+				return handler(ctx, req)
+			}
+
+			resourceType, resourceID := requestResource(tr.Operation(), req, tenantID)
+			userID := grpcx.GetUserIDFromContext(ctx)
+
+			if grpcx.IsPlatformAdmin(ctx) {
+				reply, err := handler(ctx, req)
+				if err == nil {
+					// After the handler, so its own event stays the indexed one
+					auditevent.Record(ctx, auditevent.GeoRestrictionOverridden, resourceType, resourceID,
+						"country", country, "operation", tr.Operation())
+					l.Warnf("Geo restriction overridden by platform admin: user=%s tenant=%d country=%q operation=%s", userID, tenantID, country, tr.Operation())
+				}
+				return reply, err
+			}
+
+			auditevent.Record(ctx, auditevent.GeoRestrictionDenied, resourceType, resourceID,
+				"country", country, "operation", tr.Operation())
+			l.Warnf("Password read denied by geo policy: user=%s tenant=%d country=%q operation=%s", userID, tenantID, country, tr.Operation())
+
+			return nil, wardenV1.ErrorGeoRestricted("passwords cannot be read from this location").
+				WithMetadata(map[string]string{"country": country})
+		}
+	}
+}
+
+// readsPassword reports whether a request of a password read operation
+// actually asks for a password
+func readsPassword(req interface{}) bool {
+	switch r := req.(type) {
+	case *wardenV1.GetVersionRequest:
+		return r.IncludePassword
+	case *wardenV1.ExportToCsvRequest:
+		return slices.Contains(r.Columns, wardenV1.CsvColumn_CSV_COLUMN_PASSWORD)
+	}
+	return true
+}
+
+// requestResource names the secret a request reads, or the tenant for
+// exports and token redemptions
+func requestResource(operation string, req interface{}, tenantID uint32) (string, string) {
+	if strings.HasPrefix(operation, "/warden.service.v1.WardenSecretService/") {
+		switch r := req.(type) {
+		case interface{ GetSecretId() string }:
+			return auditevent.ResourceSecret, r.GetSecretId()
+		case interface{ GetId() string }:
+			return auditevent.ResourceSecret, r.GetId()
+		}
+	}
+	return auditevent.ResourceTenant, strconv.FormatUint(uint64(tenantID), 10)
+}
+
+// geoCountry returns the country of the client. Like the forwarded client IP
+// it is only trusted from callers with a verified client certificate.
+func geoCountry(ctx context.Context) string {
+	if !authn.HasVerifiedClientCert(ctx) {
+		return ""
+	}
+	md, ok := metadata.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	return strings.ToUpper(strings.TrimSpace(md.Get(MDGeoCountry)))
+}
@@ -4,20 +4,24 @@ import (
 	"context"
 
 	"github.com/go-kratos/kratos/v2/middleware"
-	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/metadata"
-	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	grpcgo "google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	commonV1 "github.com/go-tangra/go-tangra-common/gen/go/common/service/v1"
 	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/authn"
 	"github.com/go-tangra/go-tangra-warden/internal/cert"
 	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/job"
 	"github.com/go-tangra/go-tangra-warden/internal/metrics"
 	"github.com/go-tangra/go-tangra-warden/internal/service"
+	"github.com/go-tangra/go-tangra-warden/internal/siem"
 
 	"github.com/go-tangra/go-tangra-common/middleware/audit"
 	"github.com/go-tangra/go-tangra-common/middleware/mtls"
@@ -39,8 +43,12 @@ func systemViewerMiddleware() middleware.Middleware {
 func NewGRPCServer(
 	ctx *bootstrap.Context,
 	certManager *cert.CertManager,
+	certReloader *cert.Reloader,
+	authenticator *authn.Authenticator,
 	collector *metrics.Collector,
 	auditLogRepo *data.AuditLogRepo,
+	forwarder *siem.Forwarder,
+	settingsRepo *data.TenantSettingRepo,
 	folderSvc *service.FolderService,
 	secretSvc *service.SecretService,
 	permissionSvc *service.PermissionService,
@@ -49,11 +57,42 @@ func NewGRPCServer(
 	backupSvc *service.BackupService,
 	sqlBackupSvc *service.SqlBackupService,
 	userSvc *service.UserService,
+	auditSvc *service.AuditService,
+	webhookSvc *service.WebhookService,
+	csvTransferSvc *service.CsvTransferService,
+	tenantTransferSvc *service.TenantTransferService,
+	exportPolicySvc *service.ExportPolicyService,
+	maintenanceSvc *service.MaintenanceService,
+	passwordPolicySvc *service.PasswordPolicyService,
+	emergencyAccessSvc *service.EmergencyAccessService,
+	configExportSvc *service.ConfigExportService,
+	geoPolicySvc *service.GeoPolicyService,
+	versionRetentionSvc *service.VersionRetentionService,
+	deletionRequestSvc *service.DeletionRequestService,
+	collectionSvc *service.CollectionService,
+	healthMonitor *job.HealthMonitor,
+	limits *service.PayloadLimits,
 ) *grpc.Server {
 	cfg := ctx.GetConfig()
 	l := ctx.NewLoggerHelper("warden/grpc")
 
-	var opts []grpc.ServerOption
+	// grpc.health.v1 is served by the health monitor, which tracks dependencies
+	opts := []grpc.ServerOption{grpc.CustomHealth()}
+
+	// Raise the 4 MiB default so the configured import and backup limits are reachable
+	opts = append(opts, grpc.Options(grpcgo.MaxRecvMsgSize(limits.MaxMessageBytes())))
+
+	// Kratos keeps only the last StreamInterceptor option, so the stream
+	// interceptors are collected and passed once
+	var streamInts []grpcgo.StreamServerInterceptor
+
+	// Reflection lets grpcurl explore the API; keep it off outside dev environments
+	if reflectionEnabled() {
+		l.Warn("gRPC reflection enabled")
+	} else {
+		opts = append(opts, grpc.UnaryInterceptor(reflectionUnaryGuard()))
+		streamInts = append(streamInts, reflectionStreamGuard())
+	}
 
 	// Get gRPC server configuration
 	if cfg.Server != nil && cfg.Server.Grpc != nil {
@@ -74,7 +113,8 @@ func NewGRPCServer(
 		if err != nil {
 			l.Warnf("Failed to get TLS config, running without TLS: %v", err)
 		} else {
-			opts = append(opts, grpc.TLSConfig(tlsConfig))
+			// Serve rotated certificates without a restart
+			opts = append(opts, grpc.TLSConfig(certReloader.ServerTLSConfig(tlsConfig)))
 			l.Info("gRPC server configured with mTLS")
 		}
 	} else {
@@ -83,12 +123,22 @@ func NewGRPCServer(
 
 	// Add middleware
 	var ms []middleware.Middleware
-	ms = append(ms, recovery.Recovery())
+	// Recovery and logging mask passwords and payloads of the requests they log
+	ms = append(ms, recoveryMiddleware())
 	ms = append(ms, collector.Middleware())
 	ms = append(ms, systemViewerMiddleware()) // Inject system viewer for ENT privacy
 	ms = append(ms, tracing.Server())
 	ms = append(ms, metadata.Server())
-	ms = append(ms, logging.Server(ctx.GetLogger()))
+
+	// Replace the identity metadata with verified token claims (AUTH_MODE)
+	if authenticator.Enabled() {
+		ms = append(ms, authenticator.Middleware())
+	}
+
+	// Reject requests without a tenant and user instead of defaulting to tenant 0
+	ms = append(ms, requireIdentityMiddleware())
+
+	ms = append(ms, loggingMiddleware(ctx.GetLogger()))
 
 	// Add mTLS middleware to extract client info from certificates
 	// Add mTLS middleware only when TLS is enabled
@@ -102,13 +152,33 @@ func NewGRPCServer(
 		))
 	}
 
+	// Record the client address for the network rules of access policies
+	ms = append(ms, clientAddrMiddleware())
+
+	// Record semantic domain events raised by handlers for the audit entry
+	ms = append(ms, auditevent.Middleware())
+
+	// Let platform admins act within another tenant, recorded in the audit entry
+	ms = append(ms, impersonationMiddleware(l))
+
+	// Deny password reads from outside the tenant's allowed countries
+	ms = append(ms, geoRestrictionMiddleware(l, settingsRepo))
+
 	// Add audit logging middleware
+	writeAuditLog := func(ctx context.Context, log *audit.AuditLog) error {
+		entry := log.ToEntry()
+		auditevent.Annotate(ctx, entry)
+		if err := auditLogRepo.CreateFromEntry(ctx, entry); err != nil {
+			return err
+		}
+		// Ship to the external SIEM asynchronously (no-op when not configured)
+		forwarder.Enqueue(entry)
+		return nil
+	}
 	ms = append(ms, audit.Server(
 		ctx.GetLogger(),
 		audit.WithServiceName("warden-service"),
-		audit.WithWriteAuditLogFunc(func(ctx context.Context, log *audit.AuditLog) error {
-			return auditLogRepo.CreateFromEntry(ctx, log.ToEntry())
-		}),
+		audit.WithWriteAuditLogFunc(writeAuditLog),
 		audit.WithSkipOperations(
 			"/grpc.health.v1.Health/Check",
 			"/grpc.health.v1.Health/Watch",
@@ -121,6 +191,10 @@ func NewGRPCServer(
 
 	opts = append(opts, grpc.Middleware(ms...))
 
+	// Run the same chain for the secret streams
+	streamInts = append(streamInts, streamMiddlewareInterceptor(l, writeAuditLog, ms...))
+	opts = append(opts, grpc.StreamInterceptor(streamInts...))
+
 	// Create gRPC server
 	srv := grpc.NewServer(opts...)
 
@@ -135,6 +209,20 @@ func NewGRPCServer(
 	// per-field warden.service.v1.BackupService, kept registered during transition.
 	commonV1.RegisterBackupServiceServer(srv, sqlBackupSvc)
 	wardenV1.RegisterRedactedWardenUserServiceServer(srv, userSvc, nil)
+	wardenV1.RegisterRedactedWardenAuditServiceServer(srv, auditSvc, nil)
+	wardenV1.RegisterRedactedWardenWebhookServiceServer(srv, webhookSvc, nil)
+	wardenV1.RegisterRedactedWardenCsvTransferServiceServer(srv, csvTransferSvc, nil)
+	wardenV1.RegisterRedactedWardenTenantTransferServiceServer(srv, tenantTransferSvc, nil)
+	wardenV1.RegisterRedactedWardenExportPolicyServiceServer(srv, exportPolicySvc, nil)
+	wardenV1.RegisterRedactedWardenMaintenanceServiceServer(srv, maintenanceSvc, nil)
+	wardenV1.RegisterRedactedWardenPasswordPolicyServiceServer(srv, passwordPolicySvc, nil)
+	wardenV1.RegisterRedactedWardenEmergencyAccessServiceServer(srv, emergencyAccessSvc, nil)
+	wardenV1.RegisterRedactedWardenConfigExportServiceServer(srv, configExportSvc, nil)
+	wardenV1.RegisterRedactedWardenGeoPolicyServiceServer(srv, geoPolicySvc, nil)
+	wardenV1.RegisterRedactedWardenVersionRetentionServiceServer(srv, versionRetentionSvc, nil)
+	wardenV1.RegisterRedactedWardenDeletionRequestServiceServer(srv, deletionRequestSvc, nil)
+	wardenV1.RegisterRedactedWardenCollectionServiceServer(srv, collectionSvc, nil)
+	healthpb.RegisterHealthServer(srv, healthMonitor.Server())
 
 	return srv
 }
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/metadata"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// anonymousOperations can be called without a tenant and user identity
+var anonymousOperations = map[string]bool{
+	"/grpc.health.v1.Health/Check":                      true,
+	"/grpc.health.v1.Health/Watch":                      true,
+	"/warden.service.v1.WardenSystemService/Health":     true,
+	"/warden.service.v1.WardenSystemService/GetInfo":    true,
+	"/warden.service.v1.WardenSystemService/CheckVault": true,
+}
+
+// requireIdentityMiddleware rejects requests that carry no tenant or user ID.
+// Without it a missing header silently becomes tenant 0 and an empty user,
+// which surfaces as confusing permission failures and risks crossing tenants.
+// Tenant 0 itself stays valid, it is the platform tenant.
+func requireIdentityMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok && anonymousOperations[tr.Operation()] {
+				return handler(ctx, req)
+			}
+
+			md, _ := metadata.FromServerContext(ctx)
+
+			tenantID := md.Get(grpcx.MDTenantID)
+			if tenantID == "" {
+				return nil, wardenV1.ErrorUnauthorized("tenant ID is missing from the request")
+			}
+			if _, err := strconv.ParseUint(tenantID, 10, 32); err != nil {
+				return nil, wardenV1.ErrorUnauthorized("tenant ID is invalid")
+			}
+			if md.Get(grpcx.MDUserID) == "" {
+				return nil, wardenV1.ErrorUnauthorized("user ID is missing from the request")
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
@@ -10,6 +10,7 @@ package providers
 import (
 	"github.com/google/wire"
 
+	"github.com/go-tangra/go-tangra-warden/internal/authn"
 	"github.com/go-tangra/go-tangra-warden/internal/cert"
 	"github.com/go-tangra/go-tangra-warden/internal/server"
 )
@@ -17,6 +18,8 @@ import (
 // ProviderSet is the Wire provider set for server layer
 var ProviderSet = wire.NewSet(
 	cert.NewCertManager,
+	cert.NewReloader,
+	authn.NewAuthenticator,
 	server.NewGRPCServer,
 	server.NewHTTPServer,
 )
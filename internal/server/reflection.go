@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reflectionServicePrefixes are the services describing the server's API.
+// Kratos registers gRPC reflection and its own kratos.api.Metadata service on
+// every server, so they are switched off by rejecting their calls.
+var reflectionServicePrefixes = []string{
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+	"/kratos.api.Metadata/",
+}
+
+// reflectionEnabled reports whether GRPC_REFLECTION_ENABLED turns on
+// reflection, for grpcurl and tooling on dev environments. Off by default.
+func reflectionEnabled() bool {
+	return os.Getenv("GRPC_REFLECTION_ENABLED") == "true"
+}
+
+func isReflectionMethod(fullMethod string) bool {
+	for _, prefix := range reflectionServicePrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// reflectionUnaryGuard rejects unary reflection calls (kratos.api.Metadata)
+func reflectionUnaryGuard() grpcgo.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpcgo.UnaryServerInfo, handler grpcgo.UnaryHandler) (interface{}, error) {
+		if isReflectionMethod(info.FullMethod) {
+			return nil, status.Error(codes.Unimplemented, "reflection is disabled")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// reflectionStreamGuard rejects the streaming ServerReflectionInfo call
+func reflectionStreamGuard() grpcgo.StreamServerInterceptor {
+	return func(srv interface{}, ss grpcgo.ServerStream, info *grpcgo.StreamServerInfo, handler grpcgo.StreamHandler) error {
+		if isReflectionMethod(info.FullMethod) {
+			return status.Error(codes.Unimplemented, "reflection is disabled")
+		}
+		return handler(srv, ss)
+	}
+}
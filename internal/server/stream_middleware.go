@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/google/uuid"
+	grpcgo "google.golang.org/grpc"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-tangra/go-tangra-common/middleware/audit"
+	"github.com/go-tangra/go-tangra-common/middleware/mtls"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+)
+
+// middlewareStreamRequests are the server-streaming calls that read secrets,
+// with the request message each starts with. Kratos only runs the middleware
+// chain for unary calls, so these get it through streamMiddlewareInterceptor.
+var middlewareStreamRequests = map[string]func() proto.Message{
+	"/warden.service.v1.WardenSecretService/FetchSecretsStream": func() proto.Message { return &wardenV1.FetchSecretsStreamRequest{} },
+}
+
+// streamMiddlewareInterceptor runs a server-streaming call inside the unary
+// middleware chain: identity, impersonation, geo restriction, audit and
+// validation see its request, recovery and logging cover the whole stream,
+// and the audit entry of the call is written when it ends. Events emitted
+// while streaming are written as entries of their own with write.
+func streamMiddlewareInterceptor(l *log.Helper, write audit.WriteAuditLogFunc, ms ...middleware.Middleware) grpcgo.StreamServerInterceptor {
+	chain := middleware.Chain(ms...)
+	return func(srv interface{}, ss grpcgo.ServerStream, info *grpcgo.StreamServerInfo, handler grpcgo.StreamHandler) error {
+		newRequest, ok := middlewareStreamRequests[info.FullMethod]
+		if !ok {
+			return handler(srv, ss)
+		}
+
+		req := newRequest()
+		if err := ss.RecvMsg(req); err != nil {
+			return err
+		}
+
+		_, err := chain(func(ctx context.Context, req interface{}) (interface{}, error) {
+			ctx = auditevent.WithEmitter(ctx, streamAuditEmitter(l, write, info.FullMethod))
+			return nil, handler(srv, &middlewareStream{ServerStream: ss, ctx: ctx, req: req.(proto.Message)})
+		})(ss.Context(), req)
+		return err
+	}
+}
+
+// middlewareStream hands the handler the context the chain produced and the
+// request the interceptor already received
+type middlewareStream struct {
+	grpcgo.ServerStream
+	ctx      context.Context
+	req      proto.Message
+	received bool
+}
+
+func (s *middlewareStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *middlewareStream) RecvMsg(m interface{}) error {
+	if s.received {
+		return s.ServerStream.RecvMsg(m)
+	}
+	s.received = true
+
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return wardenV1.ErrorInternalServerError("unexpected stream message %T", m)
+	}
+	proto.Merge(msg, s.req)
+	return nil
+}
+
+// streamAuditEmitter writes each emitted event as an audit entry of the
+// operation, built like the audit middleware builds the entry of a call, so
+// every password sent on a stream is audited like a unary read
+func streamAuditEmitter(l *log.Helper, write audit.WriteAuditLogFunc, operation string) auditevent.Emitter {
+	return func(ctx context.Context, ev auditevent.Event) {
+		entry := &audit.AuditLog{
+			ID:          uuid.New().String(),
+			Timestamp:   time.Now().UTC(),
+			Operation:   operation,
+			ServiceName: "warden-service",
+			Success:     true,
+		}
+		if md, ok := grpcmd.FromIncomingContext(ctx); ok {
+			if vals := md.Get("x-request-id"); len(vals) > 0 {
+				entry.RequestID = vals[0]
+			}
+		}
+		if clientInfo, ok := mtls.GetClientInfoFromContext(ctx); ok && clientInfo != nil {
+			entry.ClientID = clientInfo.CommonName
+			entry.ClientCommonName = clientInfo.CommonName
+			entry.ClientSerialNumber = clientInfo.SerialNumber
+			entry.IsAuthenticated = clientInfo.IsAuthenticated
+			entry.TenantID = clientInfo.TenantID
+			entry.ClientOrganization = strings.Join(clientInfo.Organizations, ", ")
+		}
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			entry.PeerAddress = p.Addr.String()
+			if host, _, err := net.SplitHostPort(entry.PeerAddress); err == nil {
+				entry.PeerAddress = host
+			}
+		}
+		entry.LogHash = audit.HashLog(entry)
+
+		if err := write(auditevent.WithEvent(ctx, ev), entry); err != nil {
+			l.Errorf("Failed to write stream audit log: %v", err)
+		}
+	}
+}
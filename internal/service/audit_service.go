@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/securityalert"
+	"github.com/go-tangra/go-tangra-warden/internal/job"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+type AuditService struct {
+	wardenV1.UnimplementedWardenAuditServiceServer
+
+	log          *log.Helper
+	auditLogRepo *data.AuditLogRepo
+	settingsRepo *data.TenantSettingRepo
+	retentionJob *job.AuditRetentionJob
+	alertRepo    *data.SecurityAlertRepo
+	checker      *authz.Checker
+}
+
+func NewAuditService(
+	ctx *bootstrap.Context,
+	auditLogRepo *data.AuditLogRepo,
+	settingsRepo *data.TenantSettingRepo,
+	retentionJob *job.AuditRetentionJob,
+	alertRepo *data.SecurityAlertRepo,
+	checker *authz.Checker,
+) *AuditService {
+	return &AuditService{
+		log:          ctx.NewLoggerHelper("warden/service/audit"),
+		auditLogRepo: auditLogRepo,
+		settingsRepo: settingsRepo,
+		retentionJob: retentionJob,
+		alertRepo:    alertRepo,
+		checker:      checker,
+	}
+}
+
+// ListAuditLogs lists audit log entries. Platform admins may query any tenant;
+// other callers may only query the events of a resource they own.
+func (s *AuditService) ListAuditLogs(ctx context.Context, req *wardenV1.ListAuditLogsRequest) (*wardenV1.ListAuditLogsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if !isPlatformAdmin(ctx) {
+		if req.TenantId != nil && *req.TenantId != tenantID {
+			return nil, wardenV1.ErrorAccessDenied("cannot view audit logs of another tenant")
+		}
+		if req.ResourceId == nil || *req.ResourceId == "" {
+			return nil, wardenV1.ErrorAccessDenied("only platform admins can list all audit logs")
+		}
+		resourceType := authz.ResourceTypeSecret
+		if req.GetResourceType() == auditevent.ResourceFolder {
+			resourceType = authz.ResourceTypeFolder
+		}
+		if err := s.checker.RequirePermission(ctx, tenantID, userID, resourceType, *req.ResourceId, authz.PermissionDelete); err != nil {
+			return nil, wardenV1.ErrorAccessDenied("no permission to view the audit trail of this resource")
+		}
+	} else if req.TenantId != nil {
+		tenantID = *req.TenantId
+	}
+
+	page := uint32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+	pageSize := uint32(20)
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	after, err := data.DecodeCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &data.AuditLogListOptions{
+		TenantID:     &tenantID,
+		Operation:    req.Operation,
+		Success:      req.Success,
+		EventType:    req.EventType,
+		ResourceType: req.ResourceType,
+		ResourceID:   req.ResourceId,
+		Limit:        int(pageSize),
+		Offset:       int((page - 1) * pageSize),
+		After:        after,
+	}
+	if req.StartTime != nil {
+		t := req.StartTime.AsTime()
+		opts.StartTime = &t
+	}
+	if req.EndTime != nil {
+		t := req.EndTime.AsTime()
+		opts.EndTime = &t
+	}
+
+	entities, total, err := s.auditLogRepo.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]*wardenV1.AuditLog, 0, len(entities))
+	for _, e := range entities {
+		logs = append(logs, s.auditLogRepo.ToProto(e))
+	}
+
+	resp := &wardenV1.ListAuditLogsResponse{
+		Logs:  logs,
+		Total: uint32(total),
+	}
+	if hasNextPage(len(entities), after != nil, page, pageSize, total) {
+		resp.NextCursor = data.AuditLogCursor(entities[len(entities)-1])
+	}
+	return resp, nil
+}
+
+// GetAuditRetention returns the audit retention of a tenant
+func (s *AuditService) GetAuditRetention(ctx context.Context, req *wardenV1.GetAuditRetentionRequest) (*wardenV1.AuditRetention, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil && *req.TenantId != tenantID {
+		if !isPlatformAdmin(ctx) {
+			return nil, wardenV1.ErrorAccessDenied("cannot view audit retention of another tenant")
+		}
+		tenantID = *req.TenantId
+	}
+
+	setting, err := s.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toRetentionProto(tenantID, setting), nil
+}
+
+// SetAuditRetention overrides the audit retention of a tenant
+func (s *AuditService) SetAuditRetention(ctx context.Context, req *wardenV1.SetAuditRetentionRequest) (*wardenV1.AuditRetention, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can change audit retention")
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil {
+		tenantID = *req.TenantId
+	}
+
+	setting, err := s.settingsRepo.SetAuditRetentionDays(ctx, tenantID, req.RetentionDays, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Audit retention updated: tenant=%d days=%d", tenantID, req.RetentionDays)
+
+	return s.toRetentionProto(tenantID, setting), nil
+}
+
+// PruneAuditLogs deletes expired audit logs immediately
+func (s *AuditService) PruneAuditLogs(ctx context.Context, req *wardenV1.PruneAuditLogsRequest) (*wardenV1.PruneAuditLogsResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can prune audit logs")
+	}
+
+	report, err := s.retentionJob.Prune(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*wardenV1.TenantPruneResult, 0, len(report.Results))
+	for _, r := range report.Results {
+		results = append(results, &wardenV1.TenantPruneResult{
+			TenantId:      r.TenantID,
+			RetentionDays: r.RetentionDays,
+			Deleted:       r.Deleted,
+		})
+	}
+
+	s.log.Infof("Audit logs pruned manually: deleted=%d", report.TotalDeleted)
+
+	return &wardenV1.PruneAuditLogsResponse{
+		Results:      results,
+		TotalDeleted: report.TotalDeleted,
+	}, nil
+}
+
+// VerifyAuditChain verifies the hash chain of a tenant's audit log
+func (s *AuditService) VerifyAuditChain(ctx context.Context, req *wardenV1.VerifyAuditChainRequest) (*wardenV1.VerifyAuditChainResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil && *req.TenantId != tenantID {
+		if !isPlatformAdmin(ctx) {
+			return nil, wardenV1.ErrorAccessDenied("cannot verify audit logs of another tenant")
+		}
+		tenantID = *req.TenantId
+	}
+
+	var startTime, endTime *time.Time
+	if req.StartTime != nil {
+		t := req.StartTime.AsTime()
+		startTime = &t
+	}
+	if req.EndTime != nil {
+		t := req.EndTime.AsTime()
+		endTime = &t
+	}
+
+	report, err := s.auditLogRepo.VerifyChain(ctx, tenantID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]*wardenV1.AuditChainIssue, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		issues = append(issues, &wardenV1.AuditChainIssue{
+			Kind:     mapAuditChainIssueKind(issue.Kind),
+			AuditId:  issue.AuditID,
+			ChainSeq: issue.ChainSeq,
+			Message:  issue.Message,
+		})
+	}
+
+	if len(issues) > 0 {
+		s.log.Warnf("Audit chain verification failed: tenant=%d issues=%d", tenantID, len(issues))
+	}
+
+	return &wardenV1.VerifyAuditChainResponse{
+		Valid:     len(issues) == 0,
+		Checked:   report.Checked,
+		Unchained: report.Unchained,
+		FirstSeq:  report.FirstSeq,
+		LastSeq:   report.LastSeq,
+		Issues:    issues,
+	}, nil
+}
+
+// ListSecurityAlerts lists alerts raised by anomaly detection
+func (s *AuditService) ListSecurityAlerts(ctx context.Context, req *wardenV1.ListSecurityAlertsRequest) (*wardenV1.ListSecurityAlertsResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can list security alerts")
+	}
+
+	page := uint32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+	pageSize := uint32(20)
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	opts := &data.SecurityAlertListOptions{
+		TenantID:     req.TenantId,
+		UserID:       req.UserId,
+		Acknowledged: req.Acknowledged,
+		Page:         page,
+		PageSize:     pageSize,
+	}
+	if req.Kind != nil && *req.Kind != wardenV1.AlertKind_ALERT_KIND_UNSPECIFIED {
+		kind := securityalert.Kind(req.Kind.String())
+		opts.Kind = &kind
+	}
+
+	entities, total, err := s.alertRepo.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]*wardenV1.SecurityAlert, 0, len(entities))
+	for _, e := range entities {
+		alerts = append(alerts, s.alertRepo.ToProto(e))
+	}
+
+	return &wardenV1.ListSecurityAlertsResponse{
+		Alerts: alerts,
+		Total:  uint32(total),
+	}, nil
+}
+
+// AcknowledgeSecurityAlert marks a security alert as handled
+func (s *AuditService) AcknowledgeSecurityAlert(ctx context.Context, req *wardenV1.AcknowledgeSecurityAlertRequest) (*wardenV1.SecurityAlert, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can acknowledge security alerts")
+	}
+
+	entity, err := s.alertRepo.Acknowledge(ctx, req.Id, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Security alert acknowledged: id=%d", req.Id)
+
+	return s.alertRepo.ToProto(entity), nil
+}
+
+func mapAuditChainIssueKind(k data.AuditChainIssueKind) wardenV1.AuditChainIssueKind {
+	switch k {
+	case data.AuditChainIssueGap:
+		return wardenV1.AuditChainIssueKind_AUDIT_CHAIN_ISSUE_KIND_GAP
+	case data.AuditChainIssueBrokenLink:
+		return wardenV1.AuditChainIssueKind_AUDIT_CHAIN_ISSUE_KIND_BROKEN_LINK
+	case data.AuditChainIssueModified:
+		return wardenV1.AuditChainIssueKind_AUDIT_CHAIN_ISSUE_KIND_MODIFIED
+	default:
+		return wardenV1.AuditChainIssueKind_AUDIT_CHAIN_ISSUE_KIND_UNSPECIFIED
+	}
+}
+
+func (s *AuditService) toRetentionProto(tenantID uint32, setting *ent.TenantSetting) *wardenV1.AuditRetention {
+	defaultDays := s.retentionJob.DefaultRetentionDays()
+	resp := &wardenV1.AuditRetention{
+		TenantId:               tenantID,
+		DefaultRetentionDays:   defaultDays,
+		EffectiveRetentionDays: defaultDays,
+	}
+	if setting != nil {
+		resp.RetentionDays = setting.AuditRetentionDays
+		if setting.AuditRetentionDays > 0 {
+			resp.EffectiveRetentionDays = setting.AuditRetentionDays
+		}
+		if setting.UpdateTime != nil {
+			resp.UpdateTime = timestamppb.New(*setting.UpdateTime)
+		}
+	}
+	return resp
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/go-tangra/go-tangra-common/backup"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
+)
+
+// restoreSelection narrows an ImportBackup to a subset of the archive:
+// specific entity types, folder subtrees and/or individual secrets.
+type restoreSelection struct {
+	entityTypes map[string]bool
+
+	// scoped is true when folder_paths or secret_ids were supplied.
+	scoped    bool
+	folderIDs map[string]bool // folders inside the selected subtrees
+	ancestors map[string]bool // folders restored only to attach the selection
+	secretIDs map[string]bool // secrets to restore (explicit or inside subtrees)
+}
+
+// newRestoreSelection builds the selection from the request filters and the
+// archive contents. Returns an error for unknown entity types.
+func newRestoreSelection(req *wardenV1.ImportBackupRequest, a *backup.Archive) (*restoreSelection, error) {
+	sel := &restoreSelection{
+		folderIDs: make(map[string]bool),
+		ancestors: make(map[string]bool),
+		secretIDs: make(map[string]bool),
+	}
+
+	if len(req.GetEntityTypes()) > 0 {
+		sel.entityTypes = make(map[string]bool, len(req.GetEntityTypes()))
+		for _, t := range req.GetEntityTypes() {
+			switch t {
+			case "folders", "secrets", "secretVersions", "permissions":
+				sel.entityTypes[t] = true
+			default:
+				return nil, wardenV1.ErrorBadRequest("unknown entity type: %s", t)
+			}
+		}
+	}
+
+	if len(req.GetFolderPaths()) == 0 && len(req.GetSecretIds()) == 0 {
+		return sel, nil
+	}
+	sel.scoped = true
+
+	folders, _ := backup.GetEntities[ent.Folder](a, "folders")
+	secrets, _ := backup.GetEntities[ent.Secret](a, "secrets")
+
+	parents := make(map[string]string, len(folders))
+	for _, f := range folders {
+		if f.ParentID != nil {
+			parents[f.ID] = *f.ParentID
+		}
+		if matchesFolderPrefix(f.Path, req.GetFolderPaths()) {
+			sel.folderIDs[f.ID] = true
+		}
+	}
+
+	for _, id := range req.GetSecretIds() {
+		sel.secretIDs[id] = true
+	}
+	for _, sec := range secrets {
+		if sec.FolderID != nil && sel.folderIDs[*sec.FolderID] {
+			sel.secretIDs[sec.ID] = true
+		}
+	}
+
+	// Walk up from every selected folder and secret so the restored items
+	// can be attached to their parents even on an empty target.
+	addAncestors := func(id string) {
+		for depth := 0; id != "" && depth < 50; depth++ {
+			if !sel.folderIDs[id] {
+				sel.ancestors[id] = true
+			}
+			id = parents[id]
+		}
+	}
+	for id := range sel.folderIDs {
+		addAncestors(parents[id])
+	}
+	for _, sec := range secrets {
+		if sel.secretIDs[sec.ID] && sec.FolderID != nil {
+			addAncestors(*sec.FolderID)
+		}
+	}
+
+	return sel, nil
+}
+
+// matchesFolderPrefix reports whether path equals one of the prefixes or lies
+// beneath it.
+func matchesFolderPrefix(path string, prefixes []string) bool {
+	for _, p := range prefixes {
+		p = "/" + strings.Trim(p, "/")
+		if p == "/" || path == p || strings.HasPrefix(path, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (sel *restoreSelection) includesType(entityType string) bool {
+	return sel.entityTypes == nil || sel.entityTypes[entityType]
+}
+
+func (sel *restoreSelection) includesFolder(id string) bool {
+	return !sel.scoped || sel.folderIDs[id] || sel.ancestors[id]
+}
+
+func (sel *restoreSelection) includesSecret(id string) bool {
+	return !sel.scoped || sel.secretIDs[id]
+}
+
+func (sel *restoreSelection) includesPermission(p *ent.Permission) bool {
+	if !sel.scoped {
+		return true
+	}
+	switch p.ResourceType {
+	case permission.ResourceTypeRESOURCE_TYPE_FOLDER:
+		// Ancestors are only structural; their grants are not part of the selection.
+		return sel.folderIDs[p.ResourceID]
+	case permission.ResourceTypeRESOURCE_TYPE_SECRET:
+		return sel.secretIDs[p.ResourceID]
+	}
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
@@ -15,11 +16,13 @@ import (
 	"github.com/go-tangra/go-tangra-common/grpcx"
 
 	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+	wardenData "github.com/go-tangra/go-tangra-warden/internal/data"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secretversion"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
 	"github.com/go-tangra/go-tangra-warden/pkg/vault"
 )
 
@@ -47,13 +50,19 @@ type BackupService struct {
 	log       *log.Helper
 	entClient *entCrud.EntClient[*ent.Client]
 	kvStore   *vault.KVStore
+	webhooks  *webhook.Dispatcher
+	settings  *wardenData.TenantSettingRepo
+	limits    *PayloadLimits
 }
 
-func NewBackupService(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], kvStore *vault.KVStore) *BackupService {
+func NewBackupService(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], kvStore *vault.KVStore, webhooks *webhook.Dispatcher, settings *wardenData.TenantSettingRepo, limits *PayloadLimits) *BackupService {
 	return &BackupService{
 		log:       ctx.NewLoggerHelper("warden/service/backup"),
 		entClient: entClient,
 		kvStore:   kvStore,
+		webhooks:  webhooks,
+		settings:  settings,
+		limits:    limits,
 	}
 }
 
@@ -98,6 +107,10 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *wardenV1.ExportBa
 	if err != nil {
 		return nil, fmt.Errorf("export secrets: %w", err)
 	}
+	secrets, excluded, err := s.applyExportPolicies(ctx, secrets)
+	if err != nil {
+		return nil, err
+	}
 	if err := backup.SetEntities(a, "secrets", secrets); err != nil {
 		return nil, fmt.Errorf("set secrets: %w", err)
 	}
@@ -111,6 +124,9 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *wardenV1.ExportBa
 	if err != nil {
 		return nil, fmt.Errorf("export secret versions: %w", err)
 	}
+	if len(excluded) > 0 {
+		versions = slices.DeleteFunc(versions, func(v *ent.SecretVersion) bool { return excluded[v.SecretID] })
+	}
 	if err := backup.SetEntities(a, "secretVersions", versions); err != nil {
 		return nil, fmt.Errorf("set secret versions: %w", err)
 	}
@@ -124,6 +140,11 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *wardenV1.ExportBa
 	if err != nil {
 		return nil, fmt.Errorf("export permissions: %w", err)
 	}
+	if len(excluded) > 0 {
+		permissions = slices.DeleteFunc(permissions, func(p *ent.Permission) bool {
+			return p.ResourceType == permission.ResourceTypeRESOURCE_TYPE_SECRET && excluded[p.ResourceID]
+		})
+	}
 	if err := backup.SetEntities(a, "permissions", permissions); err != nil {
 		return nil, fmt.Errorf("set permissions: %w", err)
 	}
@@ -133,27 +154,36 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *wardenV1.ExportBa
 		passwords := make(map[string]string, len(secrets))
 		totpSecrets := make(map[string]string)
 
-		for _, sec := range secrets {
+		fetches := make([]vault.FetchRequest, len(secrets))
+		for i, sec := range secrets {
+			fetches[i].Path = sec.VaultPath
+			if sec.HasTotp {
+				tid := tenantID
+				if full && sec.TenantID != nil {
+					tid = *sec.TenantID
+				}
+				fetches[i].TotpPath = s.kvStore.BuildTotpPath(tid, sec.ID)
+			}
+		}
+		fetched := s.kvStore.FetchSecrets(ctx, fetches)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for i, sec := range secrets {
 			// Password
-			pw, _, pwErr := s.kvStore.GetPassword(ctx, sec.VaultPath)
-			if pwErr != nil {
+			if pwErr := fetched[i].Err; pwErr != nil {
 				s.log.Warnf("failed to get password for secret %s: %v", sec.ID, pwErr)
 			} else {
-				passwords[sec.ID] = pw
+				passwords[sec.ID] = fetched[i].Data.Password
 			}
 
 			// TOTP
 			if sec.HasTotp {
-				tid := tenantID
-				if full && sec.TenantID != nil {
-					tid = *sec.TenantID
-				}
-				totpPath := s.kvStore.BuildTotpPath(tid, sec.ID)
-				totpURL, totpErr := s.kvStore.GetTotpURL(ctx, totpPath)
-				if totpErr != nil {
+				if totpErr := fetched[i].TotpErr; totpErr != nil {
 					s.log.Warnf("failed to get TOTP for secret %s: %v", sec.ID, totpErr)
 				} else {
-					totpSecrets[sec.ID] = totpURL
+					totpSecrets[sec.ID] = fetched[i].TotpURL
 				}
 			}
 		}
@@ -174,19 +204,60 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *wardenV1.ExportBa
 		return nil, fmt.Errorf("pack backup: %w", err)
 	}
 
-	s.log.Infof("exported backup: module=%s tenant=%d full=%v entities=%v", backupModule, tenantID, full, a.Manifest.EntityCounts)
+	s.log.Infof("exported backup: module=%s tenant=%d full=%v entities=%v excluded_by_policy=%d", backupModule, tenantID, full, a.Manifest.EntityCounts, len(excluded))
+
+	if !full {
+		s.webhooks.Publish(ctx, tenantID, webhook.EventBackupCompleted, map[string]any{
+			"module":         backupModule,
+			"schema_version": backupSchemaVersion,
+			"entity_counts":  a.Manifest.EntityCounts,
+			"size":           len(data),
+		})
+	}
 
 	return &wardenV1.ExportBackupResponse{
-		Data:          data,
-		Module:        backupModule,
-		Version:       fmt.Sprintf("%d", backupSchemaVersion),
-		ExportedAt:    timestamppb.New(a.Manifest.ExportedAt),
-		TenantId:      tenantID,
-		EntityCounts:  a.Manifest.EntityCounts,
-		SchemaVersion: int32(backupSchemaVersion),
+		Data:             data,
+		Module:           backupModule,
+		Version:          fmt.Sprintf("%d", backupSchemaVersion),
+		ExportedAt:       timestamppb.New(a.Manifest.ExportedAt),
+		TenantId:         tenantID,
+		EntityCounts:     a.Manifest.EntityCounts,
+		SchemaVersion:    int32(backupSchemaVersion),
+		ExcludedByPolicy: int32(len(excluded)),
 	}, nil
 }
 
+// applyExportPolicies drops secrets excluded by their tenant's export policy
+// and returns the IDs of the dropped secrets
+func (s *BackupService) applyExportPolicies(ctx context.Context, secrets []*ent.Secret) ([]*ent.Secret, map[string]bool, error) {
+	policies := make(map[uint32]*wardenData.ExportPolicy)
+	excluded := make(map[string]bool)
+
+	kept := secrets[:0]
+	for _, sec := range secrets {
+		var tid uint32
+		if sec.TenantID != nil {
+			tid = *sec.TenantID
+		}
+		policy, ok := policies[tid]
+		if !ok {
+			var err error
+			if policy, err = s.settings.GetExportPolicy(ctx, tid); err != nil {
+				return nil, nil, err
+			}
+			policies[tid] = policy
+		}
+
+		if policy.Excludes(sec) {
+			excluded[sec.ID] = true
+			continue
+		}
+		kept = append(kept, sec)
+	}
+
+	return kept, excluded, nil
+}
+
 // ImportBackup restores warden entities from a gzipped archive.
 func (s *BackupService) ImportBackup(ctx context.Context, req *wardenV1.ImportBackupRequest) (*wardenV1.ImportBackupResponse, error) {
 	if !grpcx.IsPlatformAdmin(ctx) {
@@ -196,6 +267,10 @@ func (s *BackupService) ImportBackup(ctx context.Context, req *wardenV1.ImportBa
 	tenantID := grpcx.GetTenantIDFromContext(ctx)
 	mode := mapRestoreMode(req.GetMode())
 
+	if err := s.limits.checkBackupSize(len(req.GetData())); err != nil {
+		return nil, err
+	}
+
 	// Unpack
 	a, err := backup.Unpack(req.GetData())
 	if err != nil {
@@ -206,6 +281,9 @@ func (s *BackupService) ImportBackup(ctx context.Context, req *wardenV1.ImportBa
 	if err := backup.Validate(a, backupModule, backupSchemaVersion); err != nil {
 		return nil, err
 	}
+	if err := s.limits.checkBackupEntities(a.Manifest.EntityCounts); err != nil {
+		return nil, err
+	}
 
 	// Full backups require platform admin
 	if a.Manifest.FullBackup && !grpcx.IsPlatformAdmin(ctx) {
@@ -223,6 +301,11 @@ func (s *BackupService) ImportBackup(ctx context.Context, req *wardenV1.ImportBa
 		tenantID = 0
 	}
 
+	sel, err := newRestoreSelection(req, a)
+	if err != nil {
+		return nil, err
+	}
+
 	client := s.entClient.Client()
 	result := backup.NewRestoreResult(sourceVersion, backupSchemaVersion, applied)
 
@@ -230,15 +313,31 @@ func (s *BackupService) ImportBackup(ctx context.Context, req *wardenV1.ImportBa
 	secretPasswords, _ := backup.GetExtra[map[string]string](a, "secretPasswords")
 	totpSecrets, _ := backup.GetExtra[map[string]string](a, "totpSecrets")
 
-	// Import in FK dependency order
-	s.importFolders(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
-	s.importSecrets(ctx, client, a, secretPasswords, totpSecrets, tenantID, a.Manifest.FullBackup, mode, result)
-	s.importSecretVersions(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
-	s.importPermissions(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	// Import in FK dependency order, honouring the selective restore filters
+	if sel.includesType("folders") {
+		s.importFolders(ctx, client, a, sel, tenantID, a.Manifest.FullBackup, mode, result)
+	}
+	if sel.includesType("secrets") {
+		s.importSecrets(ctx, client, a, sel, secretPasswords, totpSecrets, tenantID, a.Manifest.FullBackup, mode, result)
+	}
+	if sel.includesType("secretVersions") {
+		s.importSecretVersions(ctx, client, a, sel, tenantID, a.Manifest.FullBackup, mode, result)
+	}
+	if sel.includesType("permissions") {
+		s.importPermissions(ctx, client, a, sel, tenantID, a.Manifest.FullBackup, mode, result)
+	}
 
 	s.log.Infof("imported backup: module=%s tenant=%d migrations=%d results=%d",
 		backupModule, tenantID, applied, len(result.Results))
 
+	if !a.Manifest.FullBackup {
+		s.webhooks.Publish(ctx, tenantID, webhook.EventImportFinished, map[string]any{
+			"source":  "backup",
+			"success": result.Success,
+			"results": result.Results,
+		})
+	}
+
 	// Convert to proto
 	protoResults := make([]*wardenV1.EntityImportResult, len(result.Results))
 	for i, r := range result.Results {
@@ -301,14 +400,26 @@ func topologicalSortByParentID[T any](items []T, getID func(T) string, getParent
 	return sorted
 }
 
+// filterEntities returns the items for which keep reports true.
+func filterEntities[T any](items []T, keep func(T) bool) []T {
+	out := items[:0]
+	for _, item := range items {
+		if keep(item) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
 // --- Import helpers ---
 
-func (s *BackupService) importFolders(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+func (s *BackupService) importFolders(ctx context.Context, client *ent.Client, a *backup.Archive, sel *restoreSelection, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
 	folders, err := backup.GetEntities[ent.Folder](a, "folders")
 	if err != nil {
 		result.AddWarning(fmt.Sprintf("folders: unmarshal error: %v", err))
 		return
 	}
+	folders = filterEntities(folders, func(e ent.Folder) bool { return sel.includesFolder(e.ID) })
 	if len(folders) == 0 {
 		return
 	}
@@ -404,12 +515,13 @@ func (s *BackupService) importFolders(ctx context.Context, client *ent.Client, a
 	result.AddResult(er)
 }
 
-func (s *BackupService) importSecrets(ctx context.Context, client *ent.Client, a *backup.Archive, secretPasswords, totpSecrets map[string]string, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+func (s *BackupService) importSecrets(ctx context.Context, client *ent.Client, a *backup.Archive, sel *restoreSelection, secretPasswords, totpSecrets map[string]string, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
 	secrets, err := backup.GetEntities[ent.Secret](a, "secrets")
 	if err != nil {
 		result.AddWarning(fmt.Sprintf("secrets: unmarshal error: %v", err))
 		return
 	}
+	secrets = filterEntities(secrets, func(e ent.Secret) bool { return sel.includesSecret(e.ID) })
 	if len(secrets) == 0 {
 		return
 	}
@@ -418,6 +530,10 @@ func (s *BackupService) importSecrets(ctx context.Context, client *ent.Client, a
 	pwResult := backup.EntityResult{EntityType: "secretPasswords"}
 	totpResult := backup.EntityResult{EntityType: "totpSecrets"}
 
+	// Vault data is written concurrently once the rows are in place
+	var vaultIDs []string
+	var vaultReqs []vault.StoreRequest
+
 	for _, e := range secrets {
 		tid := tenantID
 		if full && e.TenantID != nil {
@@ -449,6 +565,7 @@ func (s *BackupService) importSecrets(ctx context.Context, client *ent.Client, a
 				SetDescription(e.Description).
 				SetStatus(e.Status).
 				SetHasTotp(e.HasTotp).
+				SetSensitive(e.Sensitive).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableUpdateBy(e.UpdateBy).
 				Save(ctx)
@@ -472,6 +589,7 @@ func (s *BackupService) importSecrets(ctx context.Context, client *ent.Client, a
 				SetDescription(e.Description).
 				SetStatus(e.Status).
 				SetHasTotp(e.HasTotp).
+				SetSensitive(e.Sensitive).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableUpdateBy(e.UpdateBy).
 				SetNillableCreateTime(e.CreateTime).
@@ -484,23 +602,35 @@ func (s *BackupService) importSecrets(ctx context.Context, client *ent.Client, a
 			er.Created++
 		}
 
-		// Restore password to Vault
+		// Queue password and TOTP for Vault
+		var req vault.StoreRequest
 		if pw, ok := secretPasswords[e.ID]; ok && pw != "" {
+			req.Path, req.Password = vaultPath, pw
+		}
+		if totpURL, ok := totpSecrets[e.ID]; ok && totpURL != "" {
+			req.TotpPath, req.TotpURL = s.kvStore.BuildTotpPath(tid, e.ID), totpURL
+		}
+		if req.Path != "" || req.TotpPath != "" {
+			vaultIDs = append(vaultIDs, e.ID)
+			vaultReqs = append(vaultReqs, req)
+		}
+	}
+
+	// Restore passwords and TOTP to Vault
+	for i, r := range s.kvStore.StoreSecrets(ctx, vaultReqs) {
+		if vaultReqs[i].Path != "" {
 			pwResult.Total++
-			if _, pwErr := s.kvStore.StorePassword(ctx, vaultPath, pw, nil); pwErr != nil {
-				result.AddWarning(fmt.Sprintf("secretPasswords: store %s: %v", e.ID, pwErr))
+			if r.Err != nil {
+				result.AddWarning(fmt.Sprintf("secretPasswords: store %s: %v", vaultIDs[i], r.Err))
 				pwResult.Failed++
 			} else {
 				pwResult.Created++
 			}
 		}
-
-		// Restore TOTP to Vault
-		if totpURL, ok := totpSecrets[e.ID]; ok && totpURL != "" {
+		if vaultReqs[i].TotpPath != "" {
 			totpResult.Total++
-			totpPath := s.kvStore.BuildTotpPath(tid, e.ID)
-			if totpErr := s.kvStore.StoreTotpURL(ctx, totpPath, totpURL); totpErr != nil {
-				result.AddWarning(fmt.Sprintf("totpSecrets: store %s: %v", e.ID, totpErr))
+			if r.TotpErr != nil {
+				result.AddWarning(fmt.Sprintf("totpSecrets: store %s: %v", vaultIDs[i], r.TotpErr))
 				totpResult.Failed++
 			} else {
 				totpResult.Created++
@@ -517,12 +647,13 @@ func (s *BackupService) importSecrets(ctx context.Context, client *ent.Client, a
 	}
 }
 
-func (s *BackupService) importSecretVersions(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+func (s *BackupService) importSecretVersions(ctx context.Context, client *ent.Client, a *backup.Archive, sel *restoreSelection, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
 	versions, err := backup.GetEntities[ent.SecretVersion](a, "secretVersions")
 	if err != nil {
 		result.AddWarning(fmt.Sprintf("secretVersions: unmarshal error: %v", err))
 		return
 	}
+	versions = filterEntities(versions, func(e ent.SecretVersion) bool { return sel.includesSecret(e.SecretID) })
 	if len(versions) == 0 {
 		return
 	}
@@ -546,6 +677,13 @@ func (s *BackupService) importSecretVersions(ctx context.Context, client *ent.Cl
 			continue
 		}
 
+		// Backups from before checksum algorithms were recorded hold plain
+		// SHA-256 checksums
+		checksumAlgorithm := e.ChecksumAlgorithm
+		if checksumAlgorithm == "" {
+			checksumAlgorithm = vault.ChecksumAlgorithmSHA256
+		}
+
 		if existing != nil {
 			if mode == backup.RestoreModeSkip {
 				er.Skipped++
@@ -557,6 +695,8 @@ func (s *BackupService) importSecretVersions(ctx context.Context, client *ent.Cl
 				SetVaultPath(vaultPath).
 				SetComment(e.Comment).
 				SetChecksum(e.Checksum).
+				SetChecksumAlgorithm(checksumAlgorithm).
+				SetNillableSourceVersion(e.SourceVersion).
 				SetNillableCreateBy(e.CreateBy).
 				Save(ctx)
 			if err != nil {
@@ -572,6 +712,8 @@ func (s *BackupService) importSecretVersions(ctx context.Context, client *ent.Cl
 				SetVaultPath(vaultPath).
 				SetComment(e.Comment).
 				SetChecksum(e.Checksum).
+				SetChecksumAlgorithm(checksumAlgorithm).
+				SetNillableSourceVersion(e.SourceVersion).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableCreateTime(e.CreateTime).
 				Save(ctx)
@@ -587,12 +729,13 @@ func (s *BackupService) importSecretVersions(ctx context.Context, client *ent.Cl
 	result.AddResult(er)
 }
 
-func (s *BackupService) importPermissions(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+func (s *BackupService) importPermissions(ctx context.Context, client *ent.Client, a *backup.Archive, sel *restoreSelection, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
 	permissions, err := backup.GetEntities[ent.Permission](a, "permissions")
 	if err != nil {
 		result.AddWarning(fmt.Sprintf("permissions: unmarshal error: %v", err))
 		return
 	}
+	permissions = filterEntities(permissions, func(e ent.Permission) bool { return sel.includesPermission(&e) })
 	if len(permissions) == 0 {
 		return
 	}
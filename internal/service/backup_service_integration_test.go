@@ -0,0 +1,55 @@
+//go:build integration
+
+package service_test
+
+import (
+	"slices"
+	"testing"
+
+	wardentesting "github.com/go-tangra/go-tangra-warden/internal/testing"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+func TestBackupRestoreOverwrites(t *testing.T) {
+	env := wardentesting.NewEnv(t, wardentesting.WithMemoryVault())
+	tenant := env.Tenant()
+	admin := tenant.User("admin", "platform:admin")
+
+	infra := env.CreateFolder(t, admin, nil, "infra")
+	secret := env.CreateSecret(t, admin, &infra.Id, "db", "s3cret-password")
+
+	backup, err := env.BackupService.ExportBackup(admin.Context(), &wardenV1.ExportBackupRequest{})
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if backup.TenantId != tenant.ID || backup.EntityCounts["folders"] != 1 || backup.EntityCounts["secrets"] != 1 {
+		t.Fatalf("backup of tenant %d counts %v", backup.TenantId, backup.EntityCounts)
+	}
+
+	renamed := "renamed"
+	if _, err := env.SecretService.UpdateSecret(admin.Context(), &wardenV1.UpdateSecretRequest{Id: secret.Id, Name: &renamed}); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+
+	if _, err := env.BackupService.ImportBackup(admin.Context(), &wardenV1.ImportBackupRequest{
+		Data: backup.Data,
+		Mode: wardenV1.RestoreMode_RESTORE_MODE_OVERWRITE,
+	}); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	want := []string{"/infra/db"}
+	if got := env.SecretPaths(t, tenant.ID); !slices.Equal(got, want) {
+		t.Fatalf("secrets after the restore are %q, want %q", got, want)
+	}
+}
+
+func TestBackupNeedsPlatformAdmin(t *testing.T) {
+	env := wardentesting.NewEnv(t, wardentesting.WithMemoryVault())
+	alice := env.Tenant().User("alice")
+
+	_, err := env.BackupService.ExportBackup(alice.Context(), &wardenV1.ExportBackupRequest{})
+	if !wardenV1.IsAccessDenied(err) {
+		t.Fatalf("export by a tenant user got %v, want ACCESS_DENIED", err)
+	}
+}
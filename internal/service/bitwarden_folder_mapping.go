@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// bitwardenFolderPlan says where an import puts a Bitwarden folder: the
+// folders in rest are found or created, in order, below an existing Warden
+// folder (or the root level)
+type bitwardenFolderPlan struct {
+	folder   bitwardenFolderJS
+	parentID *string
+	basePath string
+	rest     []string
+	// mapped is set when the folder mapping of the request chose the place
+	mapped bool
+}
+
+// path returns the Warden path of the folder
+func (p *bitwardenFolderPlan) path() string {
+	if len(p.rest) == 0 {
+		return p.basePath
+	}
+	return p.basePath + "/" + strings.Join(p.rest, "/")
+}
+
+// splitBitwardenFolderName splits a nested Bitwarden folder name ("a/b/c")
+// into its segments, trimmed, dropping empty ones
+func splitBitwardenFolderName(name string) []string {
+	var segments []string
+	for _, segment := range strings.Split(name, "/") {
+		if segment = strings.TrimSpace(segment); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// bitwardenFolderName returns the Bitwarden name of a Warden folder: its path
+// without the leading slash ("a/b"), which Bitwarden shows nested and the
+// import rebuilds as the same hierarchy
+func bitwardenFolderName(path string) string {
+	return strings.TrimPrefix(path, "/")
+}
+
+// resolveFolderMapping loads the Warden folders of a folder mapping
+// (Bitwarden folder ID -> Warden folder ID), which the caller needs write
+// permission on
+func (s *BitwardenTransferService) resolveFolderMapping(ctx context.Context, tenantID uint32, userID string, preserveFolders bool, folders []bitwardenFolderJS, mapping map[string]string) (map[string]*ent.Folder, error) {
+	if len(mapping) == 0 {
+		return nil, nil
+	}
+	if !preserveFolders {
+		return nil, wardenV1.ErrorBadRequest("folder mapping needs preserve_folders")
+	}
+
+	known := make(map[string]bool, len(folders))
+	for _, folder := range folders {
+		known[folder.ID] = true
+	}
+
+	mapped := make(map[string]*ent.Folder, len(mapping))
+	for bwID, folderID := range mapping {
+		if !known[bwID] {
+			return nil, wardenV1.ErrorBadRequest("folder mapping names Bitwarden folder %s, which is not in the export", bwID)
+		}
+		if err := s.checker.CanWriteFolder(ctx, tenantID, userID, folderID); err != nil {
+			return nil, wardenV1.ErrorAccessDenied("no permission to import into folder %s", folderID)
+		}
+		folder, err := s.folderRepo.GetByID(ctx, tenantID, folderID)
+		if err != nil {
+			return nil, err
+		}
+		if folder == nil {
+			return nil, wardenV1.ErrorFolderNotFound("folder %s of the folder mapping not found", folderID)
+		}
+		mapped[bwID] = folder
+	}
+	return mapped, nil
+}
+
+// planBitwardenFolders places the Bitwarden folders of an export. A mapped
+// folder goes into its Warden folder, and the folders nested below it by name
+// below that one; the others are rebuilt below the target folder. Folders
+// without a name are left out, their items go to the root level.
+func planBitwardenFolders(folders []bitwardenFolderJS, mapped map[string]*ent.Folder, target *ent.Folder) []*bitwardenFolderPlan {
+	// Mapped folders by their name, for the folders nested below them
+	mappedByName := make(map[string]*ent.Folder, len(mapped))
+	for _, folder := range folders {
+		if wardenFolder, ok := mapped[folder.ID]; ok {
+			mappedByName[strings.Join(splitBitwardenFolderName(folder.Name), "/")] = wardenFolder
+		}
+	}
+
+	var plans []*bitwardenFolderPlan
+	for _, folder := range folders {
+		if wardenFolder, ok := mapped[folder.ID]; ok {
+			plans = append(plans, &bitwardenFolderPlan{
+				folder:   folder,
+				parentID: &wardenFolder.ID,
+				basePath: wardenFolder.Path,
+				mapped:   true,
+			})
+			continue
+		}
+
+		segments := splitBitwardenFolderName(folder.Name)
+		if len(segments) == 0 {
+			continue
+		}
+
+		plan := &bitwardenFolderPlan{folder: folder, rest: segments}
+		if target != nil {
+			plan.parentID = &target.ID
+			plan.basePath = target.Path
+		}
+		// The longest mapped ancestor wins
+		for i := len(segments) - 1; i > 0; i-- {
+			if wardenFolder, ok := mappedByName[strings.Join(segments[:i], "/")]; ok {
+				plan.parentID = &wardenFolder.ID
+				plan.basePath = wardenFolder.Path
+				plan.rest = segments[i:]
+				break
+			}
+		}
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// previewImport fills the folder mappings and item assignments of a
+// validation with where the import would put each folder and item
+func (s *BitwardenTransferService) previewImport(ctx context.Context, tenantID uint32, export *bitwardenExportJSON, preserveFolders bool, plans []*bitwardenFolderPlan, target *ent.Folder, resp *wardenV1.ValidateBitwardenImportResponse) error {
+	byID := make(map[string]*wardenV1.BitwardenFolderMapping, len(plans))
+	for _, plan := range plans {
+		mapping := &wardenV1.BitwardenFolderMapping{
+			BitwardenFolderId: plan.folder.ID,
+			BitwardenName:     plan.folder.Name,
+			Path:              plan.path(),
+			Mapped:            plan.mapped,
+		}
+		if len(plan.rest) == 0 {
+			mapping.FolderId = plan.parentID
+		} else {
+			existing, err := s.folderRepo.GetByTenantAndPath(ctx, tenantID, mapping.Path)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				mapping.FolderId = &existing.ID
+			}
+		}
+		byID[plan.folder.ID] = mapping
+		resp.FolderMappings = append(resp.FolderMappings, mapping)
+	}
+
+	for i := range export.Items {
+		item := &export.Items[i]
+		if _, itemErr := bitwardenItemToSecret(item); itemErr != nil {
+			continue
+		}
+
+		assignment := &wardenV1.BitwardenItemAssignment{
+			BitwardenItemId: item.ID,
+			ItemName:        item.Name,
+		}
+		if preserveFolders && item.FolderID != nil {
+			assignment.BitwardenFolderId = item.FolderID
+			if mapping, ok := byID[*item.FolderID]; ok {
+				assignment.Path = mapping.Path
+				assignment.FolderId = mapping.FolderId
+				mapping.ItemCount++
+			}
+		} else if target != nil {
+			assignment.Path = target.Path
+			assignment.FolderId = &target.ID
+		}
+		resp.ItemAssignments = append(resp.ItemAssignments, assignment)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+)
+
+// Bitwarden item types
+const (
+	bitwardenTypeLogin      = 1
+	bitwardenTypeSecureNote = 2
+	bitwardenTypeCard       = 3
+	bitwardenTypeIdentity   = 4
+)
+
+// Metadata keys holding the non-sensitive card and identity fields
+const (
+	secretCardKey     = "card"
+	secretIdentityKey = "identity"
+)
+
+// Vault metadata keys holding the sensitive card and identity fields
+const (
+	vaultCardCodeKey       = "code"
+	vaultSSNKey            = "ssn"
+	vaultPassportNumberKey = "passport_number"
+	vaultLicenseNumberKey  = "license_number"
+)
+
+type bitwardenSecureNoteJSON struct {
+	Type int `json:"type"`
+}
+
+type bitwardenCardJSON struct {
+	CardholderName string `json:"cardholderName,omitempty"`
+	Brand          string `json:"brand,omitempty"`
+	Number         string `json:"number,omitempty"`
+	ExpMonth       string `json:"expMonth,omitempty"`
+	ExpYear        string `json:"expYear,omitempty"`
+	Code           string `json:"code,omitempty"`
+}
+
+type bitwardenIdentityJSON struct {
+	Title          string `json:"title,omitempty"`
+	FirstName      string `json:"firstName,omitempty"`
+	MiddleName     string `json:"middleName,omitempty"`
+	LastName       string `json:"lastName,omitempty"`
+	Address1       string `json:"address1,omitempty"`
+	Address2       string `json:"address2,omitempty"`
+	Address3       string `json:"address3,omitempty"`
+	City           string `json:"city,omitempty"`
+	State          string `json:"state,omitempty"`
+	PostalCode     string `json:"postalCode,omitempty"`
+	Country        string `json:"country,omitempty"`
+	Company        string `json:"company,omitempty"`
+	Email          string `json:"email,omitempty"`
+	Phone          string `json:"phone,omitempty"`
+	SSN            string `json:"ssn,omitempty"`
+	Username       string `json:"username,omitempty"`
+	PassportNumber string `json:"passportNumber,omitempty"`
+	LicenseNumber  string `json:"licenseNumber,omitempty"`
+}
+
+// bitwardenSecret is a Bitwarden item mapped onto Warden's secret model.
+// password and vaultMetadata go to Vault, everything else to the database.
+type bitwardenSecret struct {
+	username      string
+	hostURL       string
+	description   string
+	password      string
+	vaultMetadata map[string]string
+	metadata      map[string]any
+	totp          string
+}
+
+// bitwardenItemError explains why an item cannot be imported
+type bitwardenItemError struct {
+	errorType string
+	message   string
+}
+
+// bitwardenItemToSecret maps a login, secure note, card or identity item.
+// Secure note text is stored in Vault as the secret value; card numbers, card
+// codes and identity document numbers are stored in Vault as well.
+func bitwardenItemToSecret(item *bitwardenItemJSON) (*bitwardenSecret, *bitwardenItemError) {
+	result := &bitwardenSecret{}
+	if item.Notes != nil {
+		result.description = *item.Notes
+	}
+	if len(item.Fields) > 0 {
+		result.metadata = make(map[string]any)
+		for _, field := range item.Fields {
+			result.metadata[field.Name] = field.Value
+		}
+	}
+
+	setMetadata := func(key string, value any) {
+		if result.metadata == nil {
+			result.metadata = make(map[string]any)
+		}
+		result.metadata[key] = value
+	}
+	setVault := func(key, value string) {
+		if value == "" {
+			return
+		}
+		if result.vaultMetadata == nil {
+			result.vaultMetadata = make(map[string]string)
+		}
+		result.vaultMetadata[key] = value
+	}
+
+	switch item.Type {
+	case bitwardenTypeLogin:
+		if item.Login == nil {
+			return nil, &bitwardenItemError{errorType: "validation", message: "item has no login data"}
+		}
+		result.username = item.Login.Username
+		result.password = item.Login.Password
+		if len(item.Login.URIs) > 0 {
+			result.hostURL = item.Login.URIs[0].URI
+		}
+		if item.Login.TOTP != nil {
+			result.totp = strings.TrimSpace(*item.Login.TOTP)
+		}
+
+	case bitwardenTypeSecureNote:
+		result.password = result.description
+		result.description = ""
+		setMetadata(data.SecretItemTypeKey, data.SecretItemTypeSecureNote)
+
+	case bitwardenTypeCard:
+		if item.Card == nil {
+			return nil, &bitwardenItemError{errorType: "validation", message: "item has no card data"}
+		}
+		result.username = item.Card.CardholderName
+		result.password = item.Card.Number
+		setVault(vaultCardCodeKey, item.Card.Code)
+		setMetadata(data.SecretItemTypeKey, data.SecretItemTypeCard)
+		setMetadata(secretCardKey, map[string]any{
+			"cardholder_name": item.Card.CardholderName,
+			"brand":           item.Card.Brand,
+			"exp_month":       item.Card.ExpMonth,
+			"exp_year":        item.Card.ExpYear,
+		})
+
+	case bitwardenTypeIdentity:
+		if item.Identity == nil {
+			return nil, &bitwardenItemError{errorType: "validation", message: "item has no identity data"}
+		}
+		id := item.Identity
+		result.username = id.Username
+		setVault(vaultSSNKey, id.SSN)
+		setVault(vaultPassportNumberKey, id.PassportNumber)
+		setVault(vaultLicenseNumberKey, id.LicenseNumber)
+		setMetadata(data.SecretItemTypeKey, data.SecretItemTypeIdentity)
+		setMetadata(secretIdentityKey, map[string]any{
+			"title":       id.Title,
+			"first_name":  id.FirstName,
+			"middle_name": id.MiddleName,
+			"last_name":   id.LastName,
+			"address1":    id.Address1,
+			"address2":    id.Address2,
+			"address3":    id.Address3,
+			"city":        id.City,
+			"state":       id.State,
+			"postal_code": id.PostalCode,
+			"country":     id.Country,
+			"company":     id.Company,
+			"email":       id.Email,
+			"phone":       id.Phone,
+			"username":    id.Username,
+		})
+
+	default:
+		return nil, &bitwardenItemError{
+			errorType: "unsupported_type",
+			message:   fmt.Sprintf("item type %d is not supported, only login, secure note, card and identity items are supported", item.Type),
+		}
+	}
+
+	return result, nil
+}
+
+// secretToBitwardenItem fills the type-specific part of an exported item from
+// a secret's metadata and its Vault data. It returns the metadata keys that
+// were consumed and must not be exported as custom fields.
+func secretToBitwardenItem(item *bitwardenItemJSON, metadata map[string]any, username, hostURL, password string, vaultMetadata map[string]string) map[string]bool {
+	consumed := map[string]bool{data.SecretItemTypeKey: true}
+	itemType, _ := metadata[data.SecretItemTypeKey].(string)
+
+	switch itemType {
+	case data.SecretItemTypeSecureNote:
+		item.Type = bitwardenTypeSecureNote
+		item.SecureNote = &bitwardenSecureNoteJSON{Type: 0}
+		note := password
+		item.Notes = &note
+
+	case data.SecretItemTypeCard:
+		card, _ := metadata[secretCardKey].(map[string]any)
+		consumed[secretCardKey] = true
+		item.Type = bitwardenTypeCard
+		item.Card = &bitwardenCardJSON{
+			CardholderName: metadataString(card, "cardholder_name"),
+			Brand:          metadataString(card, "brand"),
+			Number:         password,
+			ExpMonth:       metadataString(card, "exp_month"),
+			ExpYear:        metadataString(card, "exp_year"),
+			Code:           vaultMetadata[vaultCardCodeKey],
+		}
+
+	case data.SecretItemTypeIdentity:
+		identity, _ := metadata[secretIdentityKey].(map[string]any)
+		consumed[secretIdentityKey] = true
+		item.Type = bitwardenTypeIdentity
+		item.Identity = &bitwardenIdentityJSON{
+			Title:          metadataString(identity, "title"),
+			FirstName:      metadataString(identity, "first_name"),
+			MiddleName:     metadataString(identity, "middle_name"),
+			LastName:       metadataString(identity, "last_name"),
+			Address1:       metadataString(identity, "address1"),
+			Address2:       metadataString(identity, "address2"),
+			Address3:       metadataString(identity, "address3"),
+			City:           metadataString(identity, "city"),
+			State:          metadataString(identity, "state"),
+			PostalCode:     metadataString(identity, "postal_code"),
+			Country:        metadataString(identity, "country"),
+			Company:        metadataString(identity, "company"),
+			Email:          metadataString(identity, "email"),
+			Phone:          metadataString(identity, "phone"),
+			SSN:            vaultMetadata[vaultSSNKey],
+			Username:       username,
+			PassportNumber: vaultMetadata[vaultPassportNumberKey],
+			LicenseNumber:  vaultMetadata[vaultLicenseNumberKey],
+		}
+
+	default:
+		item.Type = bitwardenTypeLogin
+		item.Login = &bitwardenLoginJSON{
+			Username: username,
+			Password: password,
+		}
+		if hostURL != "" {
+			item.Login.URIs = []bitwardenURIJSON{{URI: hostURL}}
+		}
+	}
+
+	return consumed
+}
+
+func metadataString(m map[string]any, key string) string {
+	if m == nil {
+		return ""
+	}
+	s, _ := m[key].(string)
+	return s
+}
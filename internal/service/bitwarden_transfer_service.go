@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/go-tangra/go-tangra-warden/internal/data"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
 	"github.com/go-tangra/go-tangra-warden/internal/metrics"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
 	"github.com/go-tangra/go-tangra-warden/pkg/vault"
 
 	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
@@ -24,14 +27,25 @@ import (
 type BitwardenTransferService struct {
 	wardenV1.UnimplementedWardenBitwardenTransferServiceServer
 
-	log         *log.Helper
-	secretRepo  *data.SecretRepo
-	folderRepo  *data.FolderRepo
-	versionRepo *data.SecretVersionRepo
-	permRepo    *data.PermissionRepo
-	kvStore     *vault.KVStore
-	checker     *authz.Checker
-	metrics     *metrics.Collector
+	log            *log.Helper
+	secretRepo     *data.SecretRepo
+	folderRepo     *data.FolderRepo
+	versionRepo    *data.SecretVersionRepo
+	permRepo       *data.PermissionRepo
+	collectionRepo *data.CollectionRepo
+	kvStore        *vault.KVStore
+	checker        *authz.Checker
+	metrics        *metrics.Collector
+	webhooks       *webhook.Dispatcher
+	settings       *data.TenantSettingRepo
+	limits         *PayloadLimits
+	tx             *data.Transactor
+	pendingOps     *data.PendingOperationRepo
+	stepUp         *StepUpPolicy
+	canary         *CanaryAlarm
+
+	// requireExportPassword rejects plaintext exports
+	requireExportPassword bool
 }
 
 // NewBitwardenTransferService creates a new BitwardenTransferService
@@ -41,19 +55,37 @@ func NewBitwardenTransferService(
 	folderRepo *data.FolderRepo,
 	versionRepo *data.SecretVersionRepo,
 	permRepo *data.PermissionRepo,
+	collectionRepo *data.CollectionRepo,
 	kvStore *vault.KVStore,
 	checker *authz.Checker,
 	metrics *metrics.Collector,
+	webhooks *webhook.Dispatcher,
+	settings *data.TenantSettingRepo,
+	limits *PayloadLimits,
+	tx *data.Transactor,
+	pendingOps *data.PendingOperationRepo,
+	stepUp *StepUpPolicy,
+	canary *CanaryAlarm,
 ) *BitwardenTransferService {
 	return &BitwardenTransferService{
-		log:         ctx.NewLoggerHelper("warden/service/bitwarden-transfer"),
-		secretRepo:  secretRepo,
-		folderRepo:  folderRepo,
-		versionRepo: versionRepo,
-		permRepo:    permRepo,
-		kvStore:     kvStore,
-		checker:     checker,
-		metrics:     metrics,
+		log:            ctx.NewLoggerHelper("warden/service/bitwarden-transfer"),
+		secretRepo:     secretRepo,
+		folderRepo:     folderRepo,
+		versionRepo:    versionRepo,
+		permRepo:       permRepo,
+		collectionRepo: collectionRepo,
+		kvStore:        kvStore,
+		checker:        checker,
+		metrics:        metrics,
+		webhooks:       webhooks,
+		settings:       settings,
+		limits:         limits,
+		tx:             tx,
+		pendingOps:     pendingOps,
+		stepUp:         stepUp,
+		canary:         canary,
+
+		requireExportPassword: os.Getenv("BITWARDEN_EXPORT_REQUIRE_PASSWORD") == "true",
 	}
 }
 
@@ -79,6 +111,9 @@ type bitwardenItemJSON struct {
 	Notes           *string                      `json:"notes,omitempty"`
 	Favorite        bool                         `json:"favorite"`
 	Login           *bitwardenLoginJSON          `json:"login,omitempty"`
+	SecureNote      *bitwardenSecureNoteJSON     `json:"secureNote,omitempty"`
+	Card            *bitwardenCardJSON           `json:"card,omitempty"`
+	Identity        *bitwardenIdentityJSON       `json:"identity,omitempty"`
 	Fields          []bitwardenFieldJSON         `json:"fields,omitempty"`
 	PasswordHistory []bitwardenPasswordHistoryJS `json:"passwordHistory,omitempty"`
 	CreationDate    string                       `json:"creationDate,omitempty"`
@@ -110,7 +145,11 @@ type bitwardenPasswordHistoryJS struct {
 
 // normalizeExport merges Bitwarden organization export fields (collections/collectionIds)
 // into the standard personal vault fields (folders/folderId) so downstream logic works unchanged.
-func normalizeExport(export *bitwardenExportJSON) {
+// Imports that keep collections as collections leave the export as it is.
+func normalizeExport(export *bitwardenExportJSON, importCollections bool) {
+	if importCollections {
+		return
+	}
 	export.Folders = append(export.Folders, export.Collections...)
 	export.Collections = nil
 
@@ -127,6 +166,11 @@ func (s *BitwardenTransferService) ExportToBitwarden(ctx context.Context, req *w
 	tenantID := getTenantIDFromContext(ctx)
 	userID := getUserIDFromContext(ctx)
 
+	passwordProtected := req.ExportPassword != nil && *req.ExportPassword != ""
+	if s.requireExportPassword && !passwordProtected {
+		return nil, wardenV1.ErrorBadRequest("an export password is required")
+	}
+
 	// Build the export structure
 	export := bitwardenExportJSON{
 		Encrypted: false,
@@ -152,7 +196,7 @@ func (s *BitwardenTransferService) ExportToBitwarden(ctx context.Context, req *w
 			secrets, err = s.secretRepo.ListAllInFolderTree(ctx, tenantID, *req.FolderId)
 		} else {
 			// Get only secrets in this folder
-			secretList, _, listErr := s.secretRepo.List(ctx, tenantID, req.FolderId, nil, nil, 1, 10000)
+			secretList, _, listErr := s.secretRepo.List(ctx, tenantID, req.FolderId, nil, nil, nil, nil, data.ListSort{}, nil, 1, 10000, data.ProjectRows)
 			if listErr != nil {
 				return nil, listErr
 			}
@@ -167,10 +211,17 @@ func (s *BitwardenTransferService) ExportToBitwarden(ctx context.Context, req *w
 		return nil, err
 	}
 
-	// Filter by permission and export
+	policy, err := s.settings.GetExportPolicy(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Filter by permission and export policy, then export
 	itemsExported := int32(0)
 	itemsSkipped := int32(0)
+	itemsExcluded := int32(0)
 
+	var eligible []*ent.Secret
 	for _, secret := range secrets {
 		// Check read permission
 		if err := s.checker.CanReadSecret(ctx, tenantID, userID, secret.ID); err != nil {
@@ -178,42 +229,64 @@ func (s *BitwardenTransferService) ExportToBitwarden(ctx context.Context, req *w
 			continue
 		}
 
+		if policy.Excludes(secret) {
+			itemsExcluded++
+			continue
+		}
+
+		// Sensitive secrets need a recent sign-in like single reads
+		if err := s.stepUp.check(ctx, secret); err != nil {
+			itemsSkipped++
+			continue
+		}
+
 		// Track folder for export
 		if secret.FolderID != nil && *secret.FolderID != "" {
 			folderIDSet[*secret.FolderID] = true
 		}
+		eligible = append(eligible, secret)
+	}
 
-		// Get password from Vault
-		password, _, err := s.kvStore.GetPassword(ctx, secret.VaultPath)
-		if err != nil {
-			s.log.Warnf("Failed to get password for secret %s: %v", secret.ID, err)
-			itemsSkipped++
-			continue
+	// Get passwords (and card/identity extras and TOTP) from Vault
+	fetches := make([]vault.FetchRequest, len(eligible))
+	for i, secret := range eligible {
+		fetches[i].Path = secret.VaultPath
+		if secret.HasTotp {
+			fetches[i].TotpPath = s.kvStore.BuildTotpPath(tenantID, secret.ID)
 		}
+	}
+	fetched := s.kvStore.FetchSecrets(ctx, fetches)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-		// Convert metadata to fields
-		var fields []bitwardenFieldJSON
-		if secret.Metadata != nil {
-			for key, value := range secret.Metadata {
-				fields = append(fields, bitwardenFieldJSON{
-					Name:  key,
-					Value: fmt.Sprintf("%v", value),
-					Type:  0, // Text
-				})
-			}
+	for i, secret := range eligible {
+		if fetched[i].Err != nil {
+			s.log.Warnf("Failed to get password for secret %s: %v", secret.ID, fetched[i].Err)
+			itemsSkipped++
+			continue
 		}
+		vaultData := fetched[i].Data
+		s.canary.trip(ctx, tenantID, secret, "ExportToBitwarden")
 
-		// Build item
+		// Build item with its type-specific data
 		item := bitwardenItemJSON{
 			ID:       secret.ID,
-			Type:     1, // Login type
 			Name:     secret.Name,
 			Favorite: false,
-			Login: &bitwardenLoginJSON{
-				Username: secret.Username,
-				Password: password,
-			},
-			Fields: fields,
+		}
+		consumed := secretToBitwardenItem(&item, secret.Metadata, secret.Username, secret.HostURL, vaultData.Password, vaultData.Metadata)
+
+		// Convert remaining metadata to fields
+		for key, value := range secret.Metadata {
+			if consumed[key] {
+				continue
+			}
+			item.Fields = append(item.Fields, bitwardenFieldJSON{
+				Name:  key,
+				Value: fmt.Sprintf("%v", value),
+				Type:  0, // Text
+			})
 		}
 
 		// Set creation/revision dates if available
@@ -229,22 +302,16 @@ func (s *BitwardenTransferService) ExportToBitwarden(ctx context.Context, req *w
 			item.FolderID = secret.FolderID
 		}
 
-		// Add notes from description
-		if secret.Description != "" {
+		// Add notes from description (secure notes carry their text already)
+		if secret.Description != "" && item.Notes == nil {
 			item.Notes = &secret.Description
 		}
 
-		// Add URI
-		if secret.HostURL != "" {
-			item.Login.URIs = []bitwardenURIJSON{
-				{URI: secret.HostURL},
-			}
-		}
-
 		// Add TOTP if configured
-		if secret.HasTotp {
-			totpPath := s.kvStore.BuildTotpPath(tenantID, secret.ID)
-			if totpURL, err := s.kvStore.GetTotpURL(ctx, totpPath); err == nil && totpURL != "" {
+		if secret.HasTotp && item.Login != nil {
+			if fetched[i].TotpErr != nil {
+				s.log.Warnf("Failed to get TOTP for secret %s, exporting it without: %v", secret.ID, fetched[i].TotpErr)
+			} else if totpURL := fetched[i].TotpURL; totpURL != "" {
 				item.Login.TOTP = &totpURL
 			}
 		}
@@ -253,17 +320,24 @@ func (s *BitwardenTransferService) ExportToBitwarden(ctx context.Context, req *w
 		itemsExported++
 	}
 
+	if req.IncludeCollections {
+		if err := s.exportCollections(ctx, tenantID, userID, &export); err != nil {
+			return nil, err
+		}
+	}
+
 	// Export folders (tenant-scoped)
 	for folderID := range folderIDSet {
-		folder, err := s.folderRepo.GetByIDAndTenant(ctx, tenantID, folderID)
+		folder, err := s.folderRepo.GetByID(ctx, tenantID, folderID)
 		if err != nil || folder == nil {
 			continue
 		}
 		export.Folders = append(export.Folders, bitwardenFolderJS{
 			ID:   folder.ID,
-			Name: folder.Path, // Use full path as name for proper hierarchy
+			Name: bitwardenFolderName(folder.Path),
 		})
 	}
+	sort.Slice(export.Folders, func(i, j int) bool { return export.Folders[i].Name < export.Folders[j].Name })
 
 	// Convert to JSON
 	jsonData, err := json.MarshalIndent(export, "", "  ")
@@ -271,33 +345,90 @@ func (s *BitwardenTransferService) ExportToBitwarden(ctx context.Context, req *w
 		return nil, wardenV1.ErrorInternalServerError("failed to generate JSON")
 	}
 
+	if passwordProtected {
+		jsonData, err = encryptBitwardenExport(jsonData, *req.ExportPassword)
+		if err != nil {
+			s.log.Errorf("failed to encrypt Bitwarden export: %v", err)
+			return nil, wardenV1.ErrorInternalServerError("failed to encrypt export")
+		}
+	}
+
 	// Generate filename
 	filename := fmt.Sprintf("warden-export-%s.json", time.Now().Format("2006-01-02"))
 
 	return &wardenV1.ExportToBitwardenResponse{
-		JsonData:          string(jsonData),
-		FoldersExported:   int32(len(export.Folders)),
-		ItemsExported:     itemsExported,
-		ItemsSkipped:      itemsSkipped,
-		SuggestedFilename: filename,
+		JsonData:              string(jsonData),
+		FoldersExported:       int32(len(export.Folders)),
+		ItemsExported:         itemsExported,
+		ItemsSkipped:          itemsSkipped,
+		SuggestedFilename:     filename,
+		PasswordProtected:     passwordProtected,
+		ItemsExcludedByPolicy: itemsExcluded,
+		CollectionsExported:   int32(len(export.Collections)),
 	}, nil
 }
 
+// exportCollections adds the collections the caller can read that hold
+// exported secrets to an export, and lists them on the items as an
+// organization export does
+func (s *BitwardenTransferService) exportCollections(ctx context.Context, tenantID uint32, userID string, export *bitwardenExportJSON) error {
+	collections, err := s.collectionRepo.ListAll(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+
+	itemIndex := make(map[string]int, len(export.Items))
+	for i, item := range export.Items {
+		itemIndex[item.ID] = i
+	}
+
+	export.Collections = []bitwardenFolderJS{}
+	for _, collection := range collections {
+		if err := s.checker.CanReadCollection(ctx, tenantID, userID, collection.ID); err != nil {
+			continue
+		}
+		exported := false
+		for _, secretEntity := range collection.Edges.Secrets {
+			if i, ok := itemIndex[secretEntity.ID]; ok {
+				export.Items[i].CollectionIDs = append(export.Items[i].CollectionIDs, collection.ID)
+				exported = true
+			}
+		}
+		if exported {
+			export.Collections = append(export.Collections, bitwardenFolderJS{
+				ID:   collection.ID,
+				Name: collection.Name,
+			})
+		}
+	}
+	return nil
+}
+
 // ImportFromBitwarden imports secrets from Bitwarden JSON format
 func (s *BitwardenTransferService) ImportFromBitwarden(ctx context.Context, req *wardenV1.ImportFromBitwardenRequest) (*wardenV1.ImportFromBitwardenResponse, error) {
+	// The import reads back folders and secrets it creates
+	ctx = data.WithPrimary(ctx)
+
 	tenantID := getTenantIDFromContext(ctx)
 	userID := getUserIDFromContext(ctx)
 	createdBy := getUserIDAsUint32(ctx)
 
+	if err := s.limits.checkImportSize(len(req.JsonData)); err != nil {
+		return nil, err
+	}
+
 	// Parse JSON
 	var export bitwardenExportJSON
 	if err := json.Unmarshal([]byte(req.JsonData), &export); err != nil {
 		s.log.Errorf("failed to parse Bitwarden JSON: %v", err)
 		return nil, wardenV1.ErrorInvalidFormat("invalid JSON format")
 	}
+	if err := s.limits.checkImportItems(len(export.Items)); err != nil {
+		return nil, err
+	}
 
 	// Normalize organization exports (collections -> folders)
-	normalizeExport(&export)
+	normalizeExport(&export, req.ImportCollections)
 
 	// Check if encrypted (not supported)
 	if export.Encrypted {
@@ -311,6 +442,12 @@ func (s *BitwardenTransferService) ImportFromBitwarden(ctx context.Context, req
 		}
 	}
 
+	// Resolve the folder mapping before making any changes
+	mapped, err := s.resolveFolderMapping(ctx, tenantID, userID, req.PreserveFolders, export.Folders, req.FolderMapping)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := &wardenV1.ImportFromBitwardenResponse{
 		FolderIdMapping: make(map[string]string),
 		ItemIdMapping:   make(map[string]string),
@@ -323,40 +460,33 @@ func (s *BitwardenTransferService) ImportFromBitwarden(ctx context.Context, req
 	pathToFolderID := make(map[string]string)
 
 	if req.PreserveFolders {
-		// Resolve target folder's path prefix for correct DB path lookups
-		var targetPathPrefix string
+		// Resolve the target folder for correct DB path lookups
+		var targetFolder *ent.Folder
 		if req.TargetFolderId != nil && *req.TargetFolderId != "" {
-			targetFolder, err := s.folderRepo.GetByIDAndTenant(ctx, tenantID, *req.TargetFolderId)
-			if err == nil && targetFolder != nil {
-				targetPathPrefix = targetFolder.Path
+			if folder, err := s.folderRepo.GetByID(ctx, tenantID, *req.TargetFolderId); err == nil {
+				targetFolder = folder
 			}
 		}
 
-		for _, bwFolder := range export.Folders {
-			// Parse path segments, filtering out empty parts (from leading slashes)
-			parts := strings.Split(bwFolder.Name, "/")
-			var segments []string
-			for _, p := range parts {
-				if p != "" {
-					segments = append(segments, p)
-				}
-			}
-			if len(segments) == 0 {
+		for _, plan := range planBitwardenFolders(export.Folders, mapped, targetFolder) {
+			bwFolder := plan.folder
+
+			// A mapped folder goes into an existing one
+			if len(plan.rest) == 0 {
+				bitwardenToWardenFolder[bwFolder.ID] = *plan.parentID
+				resp.FolderIdMapping[bwFolder.ID] = *plan.parentID
 				continue
 			}
 
 			// Walk the path, creating intermediate folders as needed (find-or-create)
-			var currentParentID *string
-			if req.TargetFolderId != nil && *req.TargetFolderId != "" {
-				currentParentID = req.TargetFolderId
-			}
+			currentParentID := plan.parentID
 
 			var leafFolderID string
 			failed := false
 
-			for i, segment := range segments {
+			for i, segment := range plan.rest {
 				// Compute the expected DB path for this folder
-				dbPath := targetPathPrefix + "/" + strings.Join(segments[:i+1], "/")
+				dbPath := plan.basePath + "/" + strings.Join(plan.rest[:i+1], "/")
 
 				// Check cache first
 				if cachedID, ok := pathToFolderID[dbPath]; ok {
@@ -387,8 +517,15 @@ func (s *BitwardenTransferService) ImportFromBitwarden(ctx context.Context, req
 					continue
 				}
 
-				// Create the folder
-				folder, err = s.folderRepo.Create(ctx, tenantID, currentParentID, segment, "", createdBy)
+				// Create the folder together with its permissions
+				err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+					var err error
+					folder, err = s.folderRepo.Create(ctx, tenantID, currentParentID, segment, "", createdBy)
+					if err != nil {
+						return err
+					}
+					return s.grantImportPermissions(ctx, tenantID, authz.ResourceTypeFolder, folder.ID, req.PermissionRules, userID, createdBy)
+				})
 				if err != nil {
 					s.log.Errorf("folder creation failed for %s: %v", bwFolder.ID, err)
 					resp.Errors = append(resp.Errors, &wardenV1.ImportError{
@@ -406,16 +543,6 @@ func (s *BitwardenTransferService) ImportFromBitwarden(ctx context.Context, req
 				leafFolderID = folder.ID
 				resp.FoldersCreated++
 				s.metrics.FolderCreated()
-
-				// Grant owner permission on newly created folder
-				if createdBy != nil {
-					if _, permErr := s.permRepo.Create(ctx, tenantID, string(authz.ResourceTypeFolder), folder.ID, string(authz.RelationOwner), string(authz.SubjectTypeUser), userID, createdBy, nil); permErr != nil {
-						s.log.Warnf("Failed to grant owner permission on imported folder %s: %v", folder.ID, permErr)
-					}
-				}
-
-				// Apply import permission rules
-				s.applyImportPermissionRules(ctx, tenantID, authz.ResourceTypeFolder, folder.ID, req.PermissionRules, createdBy)
 			}
 
 			if !failed && leafFolderID != "" {
@@ -425,227 +552,551 @@ func (s *BitwardenTransferService) ImportFromBitwarden(ctx context.Context, req
 		}
 	}
 
-	// Get existing secret names for duplicate detection (and IDs/paths for overwrite)
+	// Get existing secrets for duplicate detection (names for renames, IDs/paths for overwrite)
 	existingNames := make(map[string]bool)
-	existingSecretsByName := make(map[string]*data.SecretInfo) // for overwrite lookups
+	existingSecretsByKey := make(map[string][]*data.SecretInfo)
 	existingSecrets, err := s.secretRepo.ListAll(ctx, tenantID)
 	if err != nil {
 		return nil, wardenV1.ErrorInternalServerError("failed to list existing secrets for duplicate detection")
 	}
 	for _, sec := range existingSecrets {
-		nameLower := strings.ToLower(sec.Name)
-		existingNames[nameLower] = true
-		existingSecretsByName[nameLower] = &data.SecretInfo{ID: sec.ID, VaultPath: sec.VaultPath}
+		existingNames[strings.ToLower(sec.Name)] = true
+		key := duplicateKey(req.DuplicateMatch, sec.Name, sec.HostURL, sec.Username)
+		existingSecretsByKey[key] = append(existingSecretsByKey[key], &data.SecretInfo{ID: sec.ID, VaultPath: sec.VaultPath, FolderID: sec.FolderID, HasTotp: sec.HasTotp})
+	}
+
+	// New secrets waiting to be created, with their names and duplicate keys
+	var queued []*bitwardenCreate
+	queuedNames := make(map[string]bool)
+	queuedKeys := make(map[string]bool)
+	flush := func() {
+		if len(queued) == 0 {
+			return
+		}
+		s.createImportedSecrets(ctx, tenantID, userID, createdBy, req, queued, resp)
+		for _, c := range queued {
+			if c.secret == nil {
+				continue
+			}
+			existingNames[strings.ToLower(c.name)] = true
+			key := duplicateKey(req.DuplicateMatch, c.name, c.item.hostURL, c.item.username)
+			existingSecretsByKey[key] = append(existingSecretsByKey[key], &data.SecretInfo{ID: c.secret.ID, VaultPath: c.vaultPath, FolderID: c.targetFolderID, HasTotp: c.totpStored})
+		}
+		queued = nil
+		clear(queuedNames)
+		clear(queuedKeys)
 	}
 
 	// Import items
 	for _, bwItem := range export.Items {
-		// Only support login items
-		if bwItem.Type != 1 {
+		// Map the item onto the secret model, skipping unsupported types
+		item, itemErr := bitwardenItemToSecret(&bwItem)
+		if itemErr != nil {
 			resp.Errors = append(resp.Errors, &wardenV1.ImportError{
 				BitwardenId: bwItem.ID,
 				ItemName:    bwItem.Name,
-				ErrorType:   "unsupported_type",
-				Message:     fmt.Sprintf("item type %d is not supported, only login items (type 1) are supported", bwItem.Type),
+				ErrorType:   itemErr.errorType,
+				Message:     itemErr.message,
 			})
 			resp.ItemsSkipped++
 			continue
 		}
 
-		// Skip items without login data
-		if bwItem.Login == nil {
-			resp.Errors = append(resp.Errors, &wardenV1.ImportError{
-				BitwardenId: bwItem.ID,
-				ItemName:    bwItem.Name,
-				ErrorType:   "validation",
-				Message:     "item has no login data",
-			})
-			resp.ItemsSkipped++
-			continue
+		// Determine target folder
+		var targetFolderID *string
+		if req.PreserveFolders && bwItem.FolderID != nil {
+			if wardenFolderID, ok := bitwardenToWardenFolder[*bwItem.FolderID]; ok {
+				targetFolderID = &wardenFolderID
+			}
+		} else if req.TargetFolderId != nil && *req.TargetFolderId != "" {
+			targetFolderID = req.TargetFolderId
 		}
 
 		// Check for duplicates
 		name := bwItem.Name
-		nameLower := strings.ToLower(name)
+		key := duplicateKey(req.DuplicateMatch, name, item.hostURL, item.username)
+
+		// A queued item it duplicates is created first, to be matched like
+		// the existing secrets
+		if queuedKeys[key] {
+			flush()
+		}
 
-		if existingNames[nameLower] {
+		if candidates := existingSecretsByKey[key]; len(candidates) > 0 {
 			switch req.DuplicateHandling {
 			case wardenV1.DuplicateHandling_DUPLICATE_HANDLING_SKIP:
 				resp.Errors = append(resp.Errors, &wardenV1.ImportError{
 					BitwardenId: bwItem.ID,
 					ItemName:    bwItem.Name,
 					ErrorType:   "duplicate",
-					Message:     "item with same name already exists",
+					Message:     "matching item already exists",
 				})
 				resp.ItemsSkipped++
 				continue
 			case wardenV1.DuplicateHandling_DUPLICATE_HANDLING_RENAME:
 				// Find unique name (bounded to prevent infinite loop)
 				const maxRenameAttempts = 1000
-				for counter := 1; counter <= maxRenameAttempts; counter++ {
+				taken := func(n string) bool {
+					return existingNames[strings.ToLower(n)] || queuedNames[strings.ToLower(n)]
+				}
+				for counter := 1; taken(name) && counter <= maxRenameAttempts; counter++ {
 					name = fmt.Sprintf("%s (%d)", bwItem.Name, counter)
-					if !existingNames[strings.ToLower(name)] {
-						break
-					}
 				}
-			case wardenV1.DuplicateHandling_DUPLICATE_HANDLING_OVERWRITE:
-				// Delete existing secret so the import replaces it
-				if existing, ok := existingSecretsByName[nameLower]; ok {
-					// Check delete permission on existing secret
-					if err := s.checker.CanDeleteSecret(ctx, tenantID, userID, existing.ID); err != nil {
-						resp.Errors = append(resp.Errors, &wardenV1.ImportError{
-							BitwardenId: bwItem.ID,
-							ItemName:    bwItem.Name,
-							ErrorType:   "access_denied",
-							Message:     "no permission to overwrite existing secret",
-						})
-						resp.ItemsFailed++
-						continue
-					}
-					// Clean up Vault data
-					if delVaultErr := s.kvStore.DestroyAllVersions(ctx, existing.VaultPath); delVaultErr != nil {
-						s.log.Warnf("failed to destroy Vault data for overwrite of secret %s: %v", existing.ID, delVaultErr)
-					}
-					// Clean up permissions and version records for the existing secret
-					if permErr := s.permRepo.DeleteByResource(ctx, tenantID, "secret", existing.ID); permErr != nil {
-						s.log.Warnf("failed to delete permissions for overwritten secret %s: %v", existing.ID, permErr)
-					}
-					if verErr := s.versionRepo.DeleteBySecretID(ctx, existing.ID); verErr != nil {
-						s.log.Warnf("failed to delete versions for overwritten secret %s: %v", existing.ID, verErr)
-					}
-					if delErr := s.secretRepo.Delete(ctx, tenantID, existing.ID, true); delErr != nil {
-						s.log.Errorf("failed to delete existing secret for overwrite: %v", delErr)
-						resp.Errors = append(resp.Errors, &wardenV1.ImportError{
-							BitwardenId: bwItem.ID,
-							ItemName:    bwItem.Name,
-							ErrorType:   "overwrite_error",
-							Message:     "failed to delete existing secret for overwrite",
-						})
-						resp.ItemsFailed++
-						continue
+			case wardenV1.DuplicateHandling_DUPLICATE_HANDLING_OVERWRITE, wardenV1.DuplicateHandling_DUPLICATE_HANDLING_MERGE:
+				existing := matchExistingSecret(candidates, targetFolderID)
+				if err := s.checker.CanWriteSecret(ctx, tenantID, userID, existing.ID); err != nil {
+					resp.Errors = append(resp.Errors, &wardenV1.ImportError{
+						BitwardenId: bwItem.ID,
+						ItemName:    bwItem.Name,
+						ErrorType:   "access_denied",
+						Message:     "no permission to overwrite existing secret",
+					})
+					resp.ItemsFailed++
+					continue
+				}
+				var err error
+				if req.DuplicateHandling == wardenV1.DuplicateHandling_DUPLICATE_HANDLING_MERGE {
+					err = s.mergeSecret(ctx, tenantID, existing, item, createdBy)
+				} else {
+					err = s.overwriteSecret(ctx, tenantID, existing, item, createdBy)
+				}
+				if err != nil {
+					resp.Errors = append(resp.Errors, &wardenV1.ImportError{
+						BitwardenId: bwItem.ID,
+						ItemName:    bwItem.Name,
+						ErrorType:   "overwrite_error",
+						Message:     err.Error(),
+					})
+					resp.ItemsFailed++
+					continue
+				}
+				// A merge keeps the TOTP of the existing secret
+				if item.totp != "" && (req.DuplicateHandling == wardenV1.DuplicateHandling_DUPLICATE_HANDLING_OVERWRITE || !existing.HasTotp) {
+					if s.importTotp(ctx, tenantID, existing.ID, &bwItem, item.totp, resp) {
+						existing.HasTotp = true
 					}
-					delete(existingNames, nameLower)
-					delete(existingSecretsByName, nameLower)
 				}
+				resp.ItemIdMapping[bwItem.ID] = existing.ID
+				resp.ItemsUpdated++
+				continue
 			}
 		}
 
-		// Determine target folder
-		var targetFolderID *string
-		if req.PreserveFolders && bwItem.FolderID != nil {
-			if wardenFolderID, ok := bitwardenToWardenFolder[*bwItem.FolderID]; ok {
-				targetFolderID = &wardenFolderID
-			}
-		} else if req.TargetFolderId != nil && *req.TargetFolderId != "" {
-			targetFolderID = req.TargetFolderId
+		// Queue the secret; new secrets are created in batches
+		queued = append(queued, &bitwardenCreate{
+			bwItem:         &bwItem,
+			item:           item,
+			name:           name,
+			targetFolderID: targetFolderID,
+		})
+		queuedNames[strings.ToLower(name)] = true
+		queuedKeys[duplicateKey(req.DuplicateMatch, name, item.hostURL, item.username)] = true
+		if len(queued) == importBatchSize {
+			flush()
 		}
+	}
+	flush()
 
-		// Extract host URL
-		hostURL := ""
-		if len(bwItem.Login.URIs) > 0 {
-			hostURL = bwItem.Login.URIs[0].URI
-		}
+	if req.ImportCollections {
+		s.importCollections(ctx, tenantID, userID, createdBy, req, &export, resp)
+	}
+
+	s.webhooks.Publish(ctx, tenantID, webhook.EventImportFinished, map[string]any{
+		"source":              "bitwarden",
+		"user_id":             userID,
+		"folders_created":     resp.FoldersCreated,
+		"collections_created": resp.CollectionsCreated,
+		"items_imported":      resp.ItemsImported,
+		"items_updated":       resp.ItemsUpdated,
+		"items_skipped":       resp.ItemsSkipped,
+		"items_failed":        resp.ItemsFailed,
+	})
+
+	return resp, nil
+}
+
+// importCollections maps the collections of an organization export onto
+// Warden collections by name, creating the missing ones, and puts the
+// imported secrets in them. Existing collections need write permission, and
+// existing secrets updated by the import share permission, as when adding
+// secrets to a collection directly.
+func (s *BitwardenTransferService) importCollections(ctx context.Context, tenantID uint32, userID string, createdBy *uint32, req *wardenV1.ImportFromBitwardenRequest, export *bitwardenExportJSON, resp *wardenV1.ImportFromBitwardenResponse) {
+	resp.CollectionIdMapping = make(map[string]string)
+	fail := func(bwCollection bitwardenFolderJS, errorType, message string) {
+		resp.Errors = append(resp.Errors, &wardenV1.ImportError{
+			BitwardenId: bwCollection.ID,
+			ItemName:    bwCollection.Name,
+			ErrorType:   errorType,
+			Message:     message,
+		})
+	}
 
-		// Extract description
-		description := ""
-		if bwItem.Notes != nil {
-			description = *bwItem.Notes
+	for _, bwCollection := range export.Collections {
+		name := strings.TrimSpace(bwCollection.Name)
+		if name == "" {
+			continue
 		}
 
-		// Convert fields to metadata
-		var metadata map[string]any
-		if len(bwItem.Fields) > 0 {
-			metadata = make(map[string]any)
-			for _, field := range bwItem.Fields {
-				metadata[field.Name] = field.Value
+		existing, err := s.collectionRepo.GetByName(ctx, tenantID, name)
+		if err != nil {
+			fail(bwCollection, "collection_lookup", "failed to look up existing collection")
+			continue
+		}
+		if existing != nil {
+			if err := s.checker.CanWriteCollection(ctx, tenantID, userID, existing.ID); err != nil {
+				fail(bwCollection, "access_denied", "no permission to add secrets to existing collection")
+				continue
 			}
+			resp.CollectionIdMapping[bwCollection.ID] = existing.ID
+			continue
 		}
 
-		// Create the secret
-		secretID := uuid.New().String()
-		vaultPath := s.kvStore.BuildPath(tenantID, secretID)
-
-		// Store password in Vault
-		_, err := s.kvStore.StorePassword(ctx, vaultPath, bwItem.Login.Password, nil)
+		var created *ent.Collection
+		err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+			var err error
+			created, err = s.collectionRepo.Create(ctx, tenantID, name, "", bwCollection.ID, createdBy)
+			if err != nil {
+				return err
+			}
+			return s.grantImportPermissions(ctx, tenantID, authz.ResourceTypeCollection, created.ID, req.PermissionRules, userID, createdBy)
+		})
 		if err != nil {
-			s.log.Errorf("failed to store password in Vault for import item %s: %v", bwItem.ID, err)
-			resp.Errors = append(resp.Errors, &wardenV1.ImportError{
-				BitwardenId: bwItem.ID,
-				ItemName:    bwItem.Name,
-				ErrorType:   "vault_error",
-				Message:     "failed to store password in vault",
-			})
-			resp.ItemsFailed++
+			s.log.Errorf("collection creation failed for %s: %v", bwCollection.ID, err)
+			fail(bwCollection, "collection_creation", "failed to create collection")
 			continue
 		}
+		resp.CollectionIdMapping[bwCollection.ID] = created.ID
+		resp.CollectionsCreated++
+	}
 
-		// Create secret in database
-		secretEntity, err := s.secretRepo.Create(ctx, tenantID, targetFolderID, name, bwItem.Login.Username, hostURL, vaultPath, description, metadata, createdBy)
-		if err != nil {
-			// Cleanup Vault on failure
-			if cleanupErr := s.kvStore.DestroyAllVersions(ctx, vaultPath); cleanupErr != nil {
-				s.log.Warnf("Failed to clean up Vault path %s after import failure: %v", vaultPath, cleanupErr)
-			}
-			s.log.Errorf("secret creation failed for %s: %v", bwItem.ID, err)
+	// Group the imported secrets by Bitwarden collection
+	members := make(map[string][]string)
+	for _, bwItem := range export.Items {
+		secretID, ok := resp.ItemIdMapping[bwItem.ID]
+		if !ok || len(bwItem.CollectionIDs) == 0 {
+			continue
+		}
+		if err := s.checker.CanShareSecret(ctx, tenantID, userID, secretID); err != nil {
 			resp.Errors = append(resp.Errors, &wardenV1.ImportError{
 				BitwardenId: bwItem.ID,
 				ItemName:    bwItem.Name,
-				ErrorType:   "creation_error",
-				Message:     "failed to create secret",
+				ErrorType:   "access_denied",
+				Message:     "no permission to add existing secret to its collections",
 			})
-			resp.ItemsFailed++
 			continue
 		}
+		for _, bwCollectionID := range bwItem.CollectionIDs {
+			if _, ok := resp.CollectionIdMapping[bwCollectionID]; ok {
+				members[bwCollectionID] = append(members[bwCollectionID], secretID)
+			}
+		}
+	}
 
-		// Create initial version record
-		checksum := vault.CalculateChecksum(bwItem.Login.Password)
-		if _, versionErr := s.versionRepo.Create(ctx, secretEntity.ID, 1, vaultPath, "Imported from Bitwarden", checksum, createdBy); versionErr != nil {
-			s.log.Warnf("Failed to create version record for imported secret %s: %v", secretEntity.ID, versionErr)
+	for _, bwCollection := range export.Collections {
+		secretIDs := members[bwCollection.ID]
+		if len(secretIDs) == 0 {
+			continue
 		}
+		if _, err := s.collectionRepo.AddSecrets(ctx, tenantID, resp.CollectionIdMapping[bwCollection.ID], secretIDs); err != nil {
+			s.log.Errorf("adding imported secrets to collection %s failed: %v", bwCollection.ID, err)
+			fail(bwCollection, "collection_membership", "failed to add secrets to collection")
+		}
+		delete(members, bwCollection.ID)
+	}
+}
 
-		// Grant owner permission
-		if createdBy != nil {
-			if _, permErr := s.permRepo.Create(ctx, tenantID, string(authz.ResourceTypeSecret), secretEntity.ID, string(authz.RelationOwner), string(authz.SubjectTypeUser), userID, createdBy, nil); permErr != nil {
-				s.log.Warnf("Failed to grant owner permission on imported secret %s: %v", secretEntity.ID, permErr)
-			}
+// importBatchSize is how many new secrets an import writes to Vault at once
+// and inserts in one transaction
+const importBatchSize = 100
+
+// bitwardenCreate is an imported item to create as a new secret
+type bitwardenCreate struct {
+	bwItem         *bitwardenItemJSON
+	item           *bitwardenSecret
+	name           string
+	targetFolderID *string
+	vaultPath      string
+	pendingWrite   *ent.PendingOperation
+	// secret is set once the secret is created
+	secret *ent.Secret
+	// totpStored is set once the TOTP seed of the item is stored
+	totpStored bool
+}
+
+// createImportedSecrets creates a batch of imported items as new secrets.
+// Their passwords are written to Vault concurrently, then the rows of those
+// stored are inserted in import order in one transaction. If that fails each
+// secret is retried in its own, so one bad item does not fail the batch.
+func (s *BitwardenTransferService) createImportedSecrets(ctx context.Context, tenantID uint32, userID string, createdBy *uint32, req *wardenV1.ImportFromBitwardenRequest, batch []*bitwardenCreate, resp *wardenV1.ImportFromBitwardenResponse) {
+	fail := func(c *bitwardenCreate, errorType, message string) {
+		resp.Errors = append(resp.Errors, &wardenV1.ImportError{
+			BitwardenId: c.bwItem.ID,
+			ItemName:    c.bwItem.Name,
+			ErrorType:   errorType,
+			Message:     message,
+		})
+		resp.ItemsFailed++
+	}
+
+	// Record the write intents, then store the passwords
+	writing := make([]*bitwardenCreate, 0, len(batch))
+	reqs := make([]vault.StoreRequest, 0, len(batch))
+	for _, c := range batch {
+		secretID := uuid.New().String()
+		c.vaultPath = s.kvStore.BuildPath(tenantID, secretID)
+		pendingWrite, err := s.pendingOps.BeginVaultWrite(ctx, tenantID, secretID, c.vaultPath)
+		if err != nil {
+			fail(c, "creation_error", "failed to create secret")
+			continue
 		}
+		c.pendingWrite = pendingWrite
+		writing = append(writing, c)
+		reqs = append(reqs, vault.StoreRequest{Path: c.vaultPath, Password: c.item.password, Metadata: c.item.vaultMetadata})
+	}
 
-		// Apply import permission rules
-		s.applyImportPermissionRules(ctx, tenantID, authz.ResourceTypeSecret, secretEntity.ID, req.PermissionRules, createdBy)
+	stored := make([]*bitwardenCreate, 0, len(writing))
+	for i, r := range s.kvStore.StoreSecrets(ctx, reqs) {
+		c := writing[i]
+		if r.Err != nil {
+			s.log.Errorf("failed to store password in Vault for import item %s: %v", c.bwItem.ID, r.Err)
+			s.pendingOps.AbortVaultWrite(ctx, c.pendingWrite)
+			fail(c, "vault_error", "failed to store password in vault")
+			continue
+		}
+		stored = append(stored, c)
+	}
 
-		// Import TOTP if present
-		if bwItem.Login.TOTP != nil && *bwItem.Login.TOTP != "" {
-			totpPath := s.kvStore.BuildTotpPath(tenantID, secretEntity.ID)
-			if err := s.kvStore.StoreTotpURL(ctx, totpPath, *bwItem.Login.TOTP); err != nil {
-				s.log.Warnf("failed to store TOTP for imported secret %s: %v", secretEntity.ID, err)
-			} else {
-				_ = s.secretRepo.SetHasTotp(ctx, tenantID, secretEntity.ID, true)
+	// Create the secret, its version and its permissions
+	create := func(ctx context.Context, c *bitwardenCreate) error {
+		secretEntity, err := s.secretRepo.Create(ctx, tenantID, c.targetFolderID, c.name, c.item.username, c.item.hostURL, c.vaultPath, c.item.description, c.item.metadata, createdBy)
+		if err != nil {
+			return err
+		}
+		checksum := s.kvStore.Checksum(c.item.password)
+		if _, err := s.versionRepo.Create(ctx, secretEntity.ID, 1, c.vaultPath, "Imported from Bitwarden", checksum, nil, createdBy); err != nil {
+			return err
+		}
+		if err := s.grantImportPermissions(ctx, tenantID, authz.ResourceTypeSecret, secretEntity.ID, req.PermissionRules, userID, createdBy); err != nil {
+			return err
+		}
+		if err := s.pendingOps.CommitVaultWrite(ctx, c.pendingWrite); err != nil {
+			return err
+		}
+		c.secret = secretEntity
+		return nil
+	}
+
+	err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		for _, c := range stored {
+			if err := create(ctx, c); err != nil {
+				return err
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		s.log.Warnf("Inserting %d imported secrets at once failed, inserting them one by one: %v", len(stored), err)
+		for _, c := range stored {
+			c.secret = nil
+			if err := s.tx.WithTx(ctx, func(ctx context.Context) error { return create(ctx, c) }); err != nil {
+				c.secret = nil
+				// Cleanup Vault on failure
+				s.pendingOps.AbortVaultWrite(ctx, c.pendingWrite)
+				s.log.Errorf("secret creation failed for %s: %v", c.bwItem.ID, err)
+				fail(c, "creation_error", "failed to create secret")
+			}
+		}
+	}
 
-		s.metrics.SecretCreated(string(secretEntity.Status))
+	// Import TOTP where present
+	var withTotp []*bitwardenCreate
+	var totpReqs []vault.StoreRequest
+	for _, c := range stored {
+		if c.secret == nil || c.item.totp == "" {
+			continue
+		}
+		if !validImportTotp(c.item.totp) {
+			totpError(resp, c.bwItem, "TOTP seed cannot generate codes, imported without it")
+			continue
+		}
+		withTotp = append(withTotp, c)
+		totpReqs = append(totpReqs, vault.StoreRequest{TotpPath: s.kvStore.BuildTotpPath(tenantID, c.secret.ID), TotpURL: c.item.totp})
+	}
+	for i, r := range s.kvStore.StoreSecrets(ctx, totpReqs) {
+		c := withTotp[i]
+		if r.TotpErr != nil {
+			s.log.Warnf("failed to store TOTP for imported secret %s: %v", c.secret.ID, r.TotpErr)
+			totpError(resp, c.bwItem, "failed to store TOTP, imported without it")
+			continue
+		}
+		c.totpStored = true
+		_ = s.secretRepo.SetHasTotp(ctx, tenantID, c.secret.ID, true)
+	}
 
-		resp.ItemIdMapping[bwItem.ID] = secretEntity.ID
-		existingNames[strings.ToLower(name)] = true
+	for _, c := range stored {
+		if c.secret == nil {
+			continue
+		}
+		s.metrics.SecretCreated(string(c.secret.Status))
+		resp.ItemIdMapping[c.bwItem.ID] = c.secret.ID
 		resp.ItemsImported++
 	}
+}
 
-	return resp, nil
+// overwriteSecret updates an existing secret from an imported item. The imported
+// password is stored as a new version so the previous one stays restorable.
+func (s *BitwardenTransferService) overwriteSecret(ctx context.Context, tenantID uint32, existing *data.SecretInfo, item *bitwardenSecret, updatedBy *uint32) error {
+	newVersion, err := s.kvStore.StorePassword(ctx, existing.VaultPath, item.password, item.vaultMetadata)
+	if err != nil {
+		s.log.Errorf("failed to store password for overwrite of secret %s: %v", existing.ID, err)
+		return fmt.Errorf("failed to store password in vault")
+	}
+
+	checksum := s.kvStore.Checksum(item.password)
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := s.versionRepo.Create(ctx, existing.ID, int32(newVersion), existing.VaultPath, "Overwritten by Bitwarden import", checksum, nil, updatedBy); err != nil {
+			return fmt.Errorf("failed to create version record")
+		}
+		if _, err := s.secretRepo.Update(ctx, tenantID, existing.ID, nil, &item.username, &item.hostURL, &item.description, item.metadata, nil, nil, nil, updatedBy); err != nil {
+			return fmt.Errorf("failed to update existing secret")
+		}
+		if _, err := s.secretRepo.UpdateVersion(ctx, tenantID, existing.ID, int32(newVersion), updatedBy); err != nil {
+			return fmt.Errorf("failed to update secret version")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.metrics.SecretVersionCreated()
+	return nil
+}
+
+// importTotp stores the TOTP seed of an imported item for an existing secret,
+// reporting seeds that cannot generate codes (such as Steam's) and failures
+// instead of failing the item. It returns whether the seed was stored.
+func (s *BitwardenTransferService) importTotp(ctx context.Context, tenantID uint32, secretID string, bwItem *bitwardenItemJSON, totpURL string, resp *wardenV1.ImportFromBitwardenResponse) bool {
+	if !validImportTotp(totpURL) {
+		totpError(resp, bwItem, "TOTP seed cannot generate codes, imported without it")
+		return false
+	}
+	if err := s.kvStore.StoreTotpURL(ctx, s.kvStore.BuildTotpPath(tenantID, secretID), totpURL); err != nil {
+		s.log.Warnf("failed to store TOTP for updated secret %s: %v", secretID, err)
+		totpError(resp, bwItem, "failed to store TOTP, imported without it")
+		return false
+	}
+	_ = s.secretRepo.SetHasTotp(ctx, tenantID, secretID, true)
+	return true
+}
+
+// validImportTotp reports whether an imported TOTP seed, an otpauth:// URL or
+// a base32 secret, can generate codes
+func validImportTotp(totpURL string) bool {
+	_, _, _, err := generateTOTPCode(totpURL)
+	return err == nil
+}
+
+// totpError reports a TOTP seed left out of an imported item. The item
+// itself is imported, so it does not count as failed.
+func totpError(resp *wardenV1.ImportFromBitwardenResponse, bwItem *bitwardenItemJSON, message string) {
+	resp.Errors = append(resp.Errors, &wardenV1.ImportError{
+		BitwardenId: bwItem.ID,
+		ItemName:    bwItem.Name,
+		ErrorType:   "totp",
+		Message:     message,
+	})
+}
+
+// mergeSecret adds an imported password as a new version of an existing secret.
+// Name, username, URL, notes and metadata of the existing secret are kept, as
+// are Vault metadata keys the imported item does not set.
+func (s *BitwardenTransferService) mergeSecret(ctx context.Context, tenantID uint32, existing *data.SecretInfo, item *bitwardenSecret, updatedBy *uint32) error {
+	current, _, err := s.kvStore.GetSecretData(ctx, existing.VaultPath)
+	if err != nil {
+		s.log.Errorf("failed to read current password for merge into secret %s: %v", existing.ID, err)
+		return fmt.Errorf("failed to read password from vault")
+	}
+
+	vaultMetadata := current.Metadata
+	for k, v := range item.vaultMetadata {
+		if vaultMetadata == nil {
+			vaultMetadata = make(map[string]string)
+		}
+		vaultMetadata[k] = v
+	}
+
+	newVersion, err := s.kvStore.StorePassword(ctx, existing.VaultPath, item.password, vaultMetadata)
+	if err != nil {
+		s.log.Errorf("failed to store password for merge into secret %s: %v", existing.ID, err)
+		return fmt.Errorf("failed to store password in vault")
+	}
+
+	checksum := s.kvStore.Checksum(item.password)
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := s.versionRepo.Create(ctx, existing.ID, int32(newVersion), existing.VaultPath, "Merged from Bitwarden import", checksum, nil, updatedBy); err != nil {
+			return fmt.Errorf("failed to create version record")
+		}
+		if _, err := s.secretRepo.UpdateVersion(ctx, tenantID, existing.ID, int32(newVersion), updatedBy); err != nil {
+			return fmt.Errorf("failed to update secret version")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.metrics.SecretVersionCreated()
+	return nil
+}
+
+// matchExistingSecret picks the secret to update among matching secrets,
+// preferring the one in the import's target folder
+func matchExistingSecret(candidates []*data.SecretInfo, folderID *string) *data.SecretInfo {
+	for _, c := range candidates {
+		if (c.FolderID == nil && folderID == nil) || (c.FolderID != nil && folderID != nil && *c.FolderID == *folderID) {
+			return c
+		}
+	}
+	return candidates[0]
 }
 
-// applyImportPermissionRules grants the specified permission rules on a resource
-func (s *BitwardenTransferService) applyImportPermissionRules(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string, rules []*wardenV1.ImportPermissionRule, createdBy *uint32) {
+// grantImportPermissions grants the importing user ownership of an imported
+// resource plus the requested permission rules. Duplicate grants are skipped
+// since they would violate the unique index and abort the transaction.
+func (s *BitwardenTransferService) grantImportPermissions(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string, rules []*wardenV1.ImportPermissionRule, userID string, createdBy *uint32) error {
+	granted := make(map[string]bool, len(rules)+1)
+	grant := func(relation, subjectType, subjectID string) error {
+		key := relation + "|" + subjectType + "|" + subjectID
+		if granted[key] {
+			return nil
+		}
+		granted[key] = true
+		if _, err := s.permRepo.Create(ctx, tenantID, string(resourceType), resourceID, relation, subjectType, subjectID, createdBy, nil); err != nil {
+			s.log.Errorf("Failed to grant %s on %s %s to %s %s: %v", relation, resourceType, resourceID, subjectType, subjectID, err)
+			return err
+		}
+		return nil
+	}
+
+	if createdBy != nil {
+		if err := grant(string(authz.RelationOwner), string(authz.SubjectTypeUser), userID); err != nil {
+			return err
+		}
+	}
 	for _, rule := range rules {
 		if rule.SubjectType == wardenV1.SubjectType_SUBJECT_TYPE_UNSPECIFIED || rule.SubjectId == "" || rule.Relation == wardenV1.Relation_RELATION_UNSPECIFIED {
 			continue
 		}
-		if _, permErr := s.permRepo.Create(ctx, tenantID, string(resourceType), resourceID, rule.Relation.String(), rule.SubjectType.String(), rule.SubjectId, createdBy, nil); permErr != nil {
-			s.log.Warnf("Failed to apply import permission rule on %s %s: %v", resourceType, resourceID, permErr)
+		if err := grant(rule.Relation.String(), rule.SubjectType.String(), rule.SubjectId); err != nil {
+			return err
 		}
 	}
+	return nil
 }
 
 // ValidateBitwardenImport validates a Bitwarden import without making changes
+// and previews where it would put each folder and item
 func (s *BitwardenTransferService) ValidateBitwardenImport(ctx context.Context, req *wardenV1.ValidateBitwardenImportRequest) (*wardenV1.ValidateBitwardenImportResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
 	userID := getUserIDFromContext(ctx)
@@ -666,6 +1117,10 @@ func (s *BitwardenTransferService) ValidateBitwardenImport(ctx context.Context,
 		}
 	}
 
+	if err := s.limits.checkImportSize(len(req.JsonData)); err != nil {
+		return nil, err
+	}
+
 	// Parse JSON
 	var export bitwardenExportJSON
 	if err := json.Unmarshal([]byte(req.JsonData), &export); err != nil {
@@ -674,9 +1129,16 @@ func (s *BitwardenTransferService) ValidateBitwardenImport(ctx context.Context,
 		resp.Errors = append(resp.Errors, "Invalid JSON format")
 		return resp, nil
 	}
+	if len(export.Items) > s.limits.ImportMaxItems {
+		resp.IsValid = false
+		resp.Errors = append(resp.Errors, fmt.Sprintf("Export contains %d items, the import limit is %d", len(export.Items), s.limits.ImportMaxItems))
+		return resp, nil
+	}
+
+	resp.CollectionsFound = int32(len(export.Collections))
 
 	// Normalize organization exports (collections -> folders)
-	normalizeExport(&export)
+	normalizeExport(&export, req.ImportCollections)
 
 	// Check if encrypted
 	if export.Encrypted {
@@ -688,21 +1150,45 @@ func (s *BitwardenTransferService) ValidateBitwardenImport(ctx context.Context,
 	resp.FoldersFound = int32(len(export.Folders))
 
 	// Count item types
+	unsupported := 0
 	for _, item := range export.Items {
-		if item.Type == 1 {
+		switch item.Type {
+		case bitwardenTypeLogin:
 			resp.LoginItemsFound++
-		} else {
+		case bitwardenTypeSecureNote, bitwardenTypeCard, bitwardenTypeIdentity:
 			resp.OtherItemsFound++
+		default:
+			resp.OtherItemsFound++
+			unsupported++
 		}
 	}
 
 	// Check for unsupported types
-	if resp.OtherItemsFound > 0 {
-		resp.Warnings = append(resp.Warnings, fmt.Sprintf("%d items are not login type and will be skipped", resp.OtherItemsFound))
+	if unsupported > 0 {
+		resp.Warnings = append(resp.Warnings, fmt.Sprintf("%d items are of an unsupported type and will be skipped", unsupported))
 	}
 
-	// Get existing secret names for duplicate detection
-	existingNames := make(map[string]bool)
+	// Preview where the folders and items go
+	mapped, err := s.resolveFolderMapping(ctx, tenantID, userID, req.PreserveFolders, export.Folders, req.FolderMapping)
+	if err != nil {
+		return nil, err
+	}
+	var targetFolder *ent.Folder
+	if req.TargetFolderId != nil && *req.TargetFolderId != "" {
+		if targetFolder, err = s.folderRepo.GetByID(ctx, tenantID, *req.TargetFolderId); err != nil {
+			return nil, err
+		}
+	}
+	var plans []*bitwardenFolderPlan
+	if req.PreserveFolders {
+		plans = planBitwardenFolders(export.Folders, mapped, targetFolder)
+	}
+	if err := s.previewImport(ctx, tenantID, &export, req.PreserveFolders, plans, targetFolder, resp); err != nil {
+		return nil, err
+	}
+
+	// Get existing secrets for duplicate detection
+	existingKeys := make(map[string]bool)
 	existingSecrets, err := s.secretRepo.ListAll(ctx, tenantID)
 	if err != nil {
 		resp.IsValid = false
@@ -710,26 +1196,40 @@ func (s *BitwardenTransferService) ValidateBitwardenImport(ctx context.Context,
 		return resp, nil
 	}
 	for _, sec := range existingSecrets {
-		existingNames[strings.ToLower(sec.Name)] = true
+		existingKeys[duplicateKey(req.DuplicateMatch, sec.Name, sec.HostURL, sec.Username)] = true
 	}
 
 	// Check for duplicates
 	for _, item := range export.Items {
-		if item.Type != 1 {
+		mapped, itemErr := bitwardenItemToSecret(&item)
+		if itemErr != nil {
 			continue
 		}
-		if existingNames[strings.ToLower(item.Name)] {
+		if existingKeys[duplicateKey(req.DuplicateMatch, item.Name, mapped.hostURL, mapped.username)] {
 			resp.DuplicateNames = append(resp.DuplicateNames, item.Name)
 		}
 	}
 
 	if len(resp.DuplicateNames) > 0 {
-		resp.Warnings = append(resp.Warnings, fmt.Sprintf("%d items have names that already exist", len(resp.DuplicateNames)))
+		resp.Warnings = append(resp.Warnings, fmt.Sprintf("%d items match secrets that already exist", len(resp.DuplicateNames)))
+	}
+
+	// Check TOTP seeds
+	invalidTotp := 0
+	for _, item := range export.Items {
+		if item.Login != nil && item.Login.TOTP != nil {
+			if totpURL := strings.TrimSpace(*item.Login.TOTP); totpURL != "" && !validImportTotp(totpURL) {
+				invalidTotp++
+			}
+		}
+	}
+	if invalidTotp > 0 {
+		resp.Warnings = append(resp.Warnings, fmt.Sprintf("%d items have a TOTP seed that cannot generate codes and will be imported without it", invalidTotp))
 	}
 
 	// Validate items
 	for _, item := range export.Items {
-		if item.Type == 1 && item.Login == nil {
+		if item.Type == bitwardenTypeLogin && item.Login == nil {
 			resp.Warnings = append(resp.Warnings, fmt.Sprintf("Item '%s' is a login type but has no login data", item.Name))
 		}
 		if item.Name == "" {
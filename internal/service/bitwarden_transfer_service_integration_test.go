@@ -0,0 +1,84 @@
+//go:build integration
+
+package service_test
+
+import (
+	"slices"
+	"testing"
+
+	wardentesting "github.com/go-tangra/go-tangra-warden/internal/testing"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+func TestBitwardenRoundTripKeepsNestedFolders(t *testing.T) {
+	env := wardentesting.NewEnv(t)
+	source, target := env.Tenant(), env.Tenant()
+	alice, bob := source.User("alice"), target.User("bob")
+
+	// /apps has no secrets of its own, so it is only known from the names of
+	// the folders below it
+	infra := env.CreateFolder(t, alice, nil, "infra")
+	prod := env.CreateFolder(t, alice, &infra.Id, "prod")
+	db := env.CreateFolder(t, alice, &prod.Id, "db")
+	apps := env.CreateFolder(t, alice, nil, "apps")
+	web := env.CreateFolder(t, alice, &apps.Id, "web")
+
+	passwords := map[string]string{}
+	for _, s := range []struct {
+		folderID *string
+		name     string
+	}{
+		{&infra.Id, "ci token"},
+		{&prod.Id, "deploy key"},
+		{&db.Id, "primary"},
+		{&db.Id, "replica"},
+		{&web.Id, "session secret"},
+		{nil, "mail"},
+	} {
+		password := "password of " + s.name
+		secret := env.CreateSecret(t, alice, s.folderID, s.name, password)
+		passwords[secret.Id] = password
+	}
+
+	exported, err := env.BitwardenService.ExportToBitwarden(alice.Context(), &wardenV1.ExportToBitwardenRequest{})
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if exported.ItemsExported != int32(len(passwords)) {
+		t.Fatalf("exported %d items, want %d", exported.ItemsExported, len(passwords))
+	}
+
+	imported, err := env.BitwardenService.ImportFromBitwarden(bob.Context(), &wardenV1.ImportFromBitwardenRequest{
+		JsonData:        exported.JsonData,
+		PreserveFolders: true,
+	})
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if imported.ItemsImported != int32(len(passwords)) || imported.ItemsFailed != 0 {
+		t.Fatalf("imported %d items, %d failed: %v", imported.ItemsImported, imported.ItemsFailed, imported.Errors)
+	}
+
+	wantFolders := []string{"/apps", "/apps/web", "/infra", "/infra/prod", "/infra/prod/db"}
+	if got := env.FolderPaths(t, source.ID); !slices.Equal(got, wantFolders) {
+		t.Fatalf("source folders are %q, want %q", got, wantFolders)
+	}
+	if got := env.FolderPaths(t, target.ID); !slices.Equal(got, wantFolders) {
+		t.Fatalf("imported folders are %q, want %q", got, wantFolders)
+	}
+	wantSecrets := env.SecretPaths(t, source.ID)
+	if got := env.SecretPaths(t, target.ID); !slices.Equal(got, wantSecrets) {
+		t.Fatalf("imported secrets are %q, want %q", got, wantSecrets)
+	}
+
+	for id, password := range passwords {
+		importedID, ok := imported.ItemIdMapping[id]
+		if !ok {
+			t.Fatalf("secret %s is missing from the item mapping", id)
+		}
+		if got := env.Password(t, bob, importedID); got != password {
+			t.Fatalf("imported password is %q, want %q", got, password)
+		}
+	}
+}
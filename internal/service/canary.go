@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/securityalert"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
+)
+
+// CanaryAlarm raises a security alert whenever the password of a canary
+// secret is read. Canaries are decoys nobody has a reason to read, so every
+// read is reported, by owners too, without deduplication.
+type CanaryAlarm struct {
+	log       *log.Helper
+	alertRepo *data.SecurityAlertRepo
+	webhooks  *webhook.Dispatcher
+}
+
+// NewCanaryAlarm creates a new CanaryAlarm
+func NewCanaryAlarm(ctx *bootstrap.Context, alertRepo *data.SecurityAlertRepo, webhooks *webhook.Dispatcher) *CanaryAlarm {
+	return &CanaryAlarm{
+		log:       ctx.NewLoggerHelper("warden/service/canary"),
+		alertRepo: alertRepo,
+		webhooks:  webhooks,
+	}
+}
+
+// trip reports a password read of secretEntity if it is a canary. via names
+// the RPC that read it. The read itself goes ahead, so the reader is not
+// warned.
+func (a *CanaryAlarm) trip(ctx context.Context, tenantID uint32, secretEntity *ent.Secret, via string) {
+	if a == nil || !secretEntity.Canary {
+		return
+	}
+
+	userID := getUserIDFromContext(ctx)
+	username := getUsernameFromContext(ctx)
+	peerAddress := ""
+	if addr := authz.ClientAddrFromContext(ctx); addr.IsValid() {
+		peerAddress = addr.String()
+	}
+
+	details := map[string]string{
+		"secret_id":    secretEntity.ID,
+		"read_by":      userID,
+		"username":     username,
+		"peer_address": peerAddress,
+		"via":          via,
+	}
+	message := fmt.Sprintf("User %s read the password of canary secret %s from %s", userID, secretEntity.ID, peerAddress)
+	if _, err := a.alertRepo.Create(ctx, tenantID, securityalert.KindALERT_KIND_CANARY_READ, securityalert.SeverityALERT_SEVERITY_HIGH,
+		userID, secretEntity.ID, message, details); err != nil {
+		a.log.Errorf("Failed to store canary alert: %v", err)
+	}
+
+	auditevent.Record(ctx, auditevent.SecretCanaryRead, auditevent.ResourceSecret, secretEntity.ID,
+		"peer_address", peerAddress, "via", via)
+
+	a.webhooks.Publish(ctx, tenantID, webhook.EventCanaryRead, map[string]any{
+		"secret_id":    secretEntity.ID,
+		"folder_id":    secretEntity.FolderID,
+		"read_by":      userID,
+		"username":     username,
+		"peer_address": peerAddress,
+		"via":          via,
+	})
+
+	a.log.Warnf("Canary secret read: tenant=%d secret=%s user=%s peer=%s via=%s", tenantID, secretEntity.ID, userID, peerAddress, via)
+}
@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// CollectionService manages collections: flat groupings of secrets shared
+// as a whole. Permissions on a collection apply to every secret in it,
+// alongside the permissions inherited from the secret's folders.
+type CollectionService struct {
+	wardenV1.UnimplementedWardenCollectionServiceServer
+
+	log            *log.Helper
+	collectionRepo *data.CollectionRepo
+	secretRepo     *data.SecretRepo
+	permRepo       *data.PermissionRepo
+	checker        *authz.Checker
+	tx             *data.Transactor
+}
+
+// NewCollectionService creates a new CollectionService
+func NewCollectionService(
+	ctx *bootstrap.Context,
+	collectionRepo *data.CollectionRepo,
+	secretRepo *data.SecretRepo,
+	permRepo *data.PermissionRepo,
+	checker *authz.Checker,
+	tx *data.Transactor,
+) *CollectionService {
+	return &CollectionService{
+		log:            ctx.NewLoggerHelper("warden/service/collection"),
+		collectionRepo: collectionRepo,
+		secretRepo:     secretRepo,
+		permRepo:       permRepo,
+		checker:        checker,
+		tx:             tx,
+	}
+}
+
+// CreateCollection creates a collection owned by the caller, optionally with
+// secrets in it
+func (s *CollectionService) CreateCollection(ctx context.Context, req *wardenV1.CreateCollectionRequest) (*wardenV1.CreateCollectionResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checkShareSecrets(ctx, tenantID, userID, req.SecretIds); err != nil {
+		return nil, err
+	}
+
+	createdBy := getUserIDAsUint32(ctx)
+	var collectionID string
+	err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		collection, err := s.collectionRepo.Create(ctx, tenantID, req.Name, req.Description, "", createdBy)
+		if err != nil {
+			return err
+		}
+		collectionID = collection.ID
+
+		if createdBy != nil {
+			if _, err := s.permRepo.Create(ctx, tenantID, string(authz.ResourceTypeCollection), collection.ID, string(authz.RelationOwner), string(authz.SubjectTypeUser), userID, createdBy, nil); err != nil {
+				return err
+			}
+		}
+		if len(req.SecretIds) > 0 {
+			if _, err := s.collectionRepo.AddSecrets(ctx, tenantID, collection.ID, req.SecretIds); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := s.collectionRepo.GetByID(data.WithPrimary(ctx), tenantID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.CollectionCreated, auditevent.ResourceCollection, collection.ID,
+		"name", collection.Name, "secrets", strconv.Itoa(len(req.SecretIds)))
+
+	s.log.Infof("Collection created: id=%s name=%s secrets=%d user=%s", collection.ID, collection.Name, len(req.SecretIds), userID)
+
+	return &wardenV1.CreateCollectionResponse{
+		Collection: s.collectionRepo.ToProto(collection),
+	}, nil
+}
+
+// GetCollection gets a collection by ID
+func (s *CollectionService) GetCollection(ctx context.Context, req *wardenV1.GetCollectionRequest) (*wardenV1.GetCollectionResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadCollection(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to access this collection")
+	}
+
+	collection, err := s.collectionRepo.GetByID(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if collection == nil {
+		return nil, wardenV1.ErrorCollectionNotFound("collection not found")
+	}
+
+	return &wardenV1.GetCollectionResponse{
+		Collection: s.collectionRepo.ToProto(collection),
+	}, nil
+}
+
+// ListCollections lists the collections the caller can read
+func (s *CollectionService) ListCollections(ctx context.Context, req *wardenV1.ListCollectionsRequest) (*wardenV1.ListCollectionsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if req.SecretId != nil && *req.SecretId != "" {
+		if err := s.checker.CanReadSecret(ctx, tenantID, userID, *req.SecretId); err != nil {
+			return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
+		}
+	}
+
+	page := uint32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+	pageSize := uint32(20)
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	collections, total, err := s.collectionRepo.List(ctx, tenantID, nil, req.NameFilter, req.SecretId, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Total comes from the repo so the pager stays accurate; unreadable
+	// collections just drop off the page
+	resp := &wardenV1.ListCollectionsResponse{Total: uint32(total)}
+	for _, collection := range collections {
+		if err := s.checker.CanReadCollection(ctx, tenantID, userID, collection.ID); err != nil {
+			continue
+		}
+		resp.Collections = append(resp.Collections, s.collectionRepo.ToProto(collection))
+	}
+	return resp, nil
+}
+
+// UpdateCollection renames a collection or changes its description
+func (s *CollectionService) UpdateCollection(ctx context.Context, req *wardenV1.UpdateCollectionRequest) (*wardenV1.UpdateCollectionResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanWriteCollection(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to modify this collection")
+	}
+
+	collection, err := s.collectionRepo.Update(ctx, tenantID, req.Id, req.Name, req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.CollectionUpdated, auditevent.ResourceCollection, collection.ID, "name", collection.Name)
+
+	s.log.Infof("Collection updated: id=%s user=%s", collection.ID, userID)
+
+	return &wardenV1.UpdateCollectionResponse{
+		Collection: s.collectionRepo.ToProto(collection),
+	}, nil
+}
+
+// DeleteCollection deletes a collection and the permissions granted on it.
+// The secrets in it stay where they are.
+func (s *CollectionService) DeleteCollection(ctx context.Context, req *wardenV1.DeleteCollectionRequest) (*emptypb.Empty, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanDeleteCollection(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to delete this collection")
+	}
+
+	err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.collectionRepo.Delete(ctx, tenantID, req.Id); err != nil {
+			return err
+		}
+		return s.permRepo.DeleteByResource(ctx, tenantID, string(authz.ResourceTypeCollection), req.Id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.CollectionDeleted, auditevent.ResourceCollection, req.Id)
+
+	s.log.Infof("Collection deleted: id=%s user=%s", req.Id, userID)
+
+	return &emptypb.Empty{}, nil
+}
+
+// AddSecretsToCollection adds secrets to a collection. Everyone with access
+// to the collection gets access to them, so the caller must be able to share
+// each secret as well as modify the collection.
+func (s *CollectionService) AddSecretsToCollection(ctx context.Context, req *wardenV1.AddSecretsToCollectionRequest) (*wardenV1.AddSecretsToCollectionResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanWriteCollection(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to modify this collection")
+	}
+	if err := s.checkShareSecrets(ctx, tenantID, userID, req.SecretIds); err != nil {
+		return nil, err
+	}
+
+	added, err := s.collectionRepo.AddSecrets(ctx, tenantID, req.Id, req.SecretIds)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := s.collectionRepo.GetByID(data.WithPrimary(ctx), tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if collection == nil {
+		return nil, wardenV1.ErrorCollectionNotFound("collection not found")
+	}
+
+	if added > 0 {
+		auditevent.Record(ctx, auditevent.CollectionSecretsAdded, auditevent.ResourceCollection, req.Id, "count", strconv.Itoa(added))
+	}
+
+	s.log.Infof("Secrets added to collection: id=%s added=%d user=%s", req.Id, added, userID)
+
+	return &wardenV1.AddSecretsToCollectionResponse{
+		Collection:     s.collectionRepo.ToProto(collection),
+		AlreadyPresent: int32(len(uniqueStrings(req.SecretIds)) - added),
+	}, nil
+}
+
+// RemoveSecretsFromCollection takes secrets out of a collection, revoking
+// the access the collection gave to them
+func (s *CollectionService) RemoveSecretsFromCollection(ctx context.Context, req *wardenV1.RemoveSecretsFromCollectionRequest) (*wardenV1.RemoveSecretsFromCollectionResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanWriteCollection(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to modify this collection")
+	}
+
+	removed, err := s.collectionRepo.RemoveSecrets(ctx, tenantID, req.Id, req.SecretIds)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := s.collectionRepo.GetByID(data.WithPrimary(ctx), tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if collection == nil {
+		return nil, wardenV1.ErrorCollectionNotFound("collection not found")
+	}
+
+	if removed > 0 {
+		auditevent.Record(ctx, auditevent.CollectionSecretsRemoved, auditevent.ResourceCollection, req.Id, "count", strconv.Itoa(removed))
+	}
+
+	s.log.Infof("Secrets removed from collection: id=%s removed=%d user=%s", req.Id, removed, userID)
+
+	return &wardenV1.RemoveSecretsFromCollectionResponse{
+		Collection: s.collectionRepo.ToProto(collection),
+		Removed:    int32(removed),
+	}, nil
+}
+
+// ListCollectionSecrets lists the secrets in a collection the caller can read
+func (s *CollectionService) ListCollectionSecrets(ctx context.Context, req *wardenV1.ListCollectionSecretsRequest) (*wardenV1.ListCollectionSecretsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadCollection(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to access this collection")
+	}
+
+	page := uint32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+	pageSize := uint32(20)
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	secrets, total, err := s.collectionRepo.ListSecrets(ctx, tenantID, req.Id, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reading the collection grants reading its secrets, but the access
+	// policy of a secret can still deny it
+	resp := &wardenV1.ListCollectionSecretsResponse{Total: uint32(total)}
+	for _, secretEntity := range secrets {
+		if err := s.checker.CanReadSecret(ctx, tenantID, userID, secretEntity.ID); err != nil {
+			continue
+		}
+		resp.Secrets = append(resp.Secrets, s.secretRepo.ToProto(secretEntity))
+	}
+	return resp, nil
+}
+
+// checkShareSecrets checks that the secrets exist in the tenant and the
+// caller can share each of them
+func (s *CollectionService) checkShareSecrets(ctx context.Context, tenantID uint32, userID string, secretIDs []string) error {
+	for _, secretID := range secretIDs {
+		if err := s.checker.CanShareSecret(ctx, tenantID, userID, secretID); err != nil {
+			return wardenV1.ErrorAccessDenied("no permission to share secret %s", secretID)
+		}
+		secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, secretID)
+		if err != nil {
+			return err
+		}
+		if secretEntity == nil {
+			return wardenV1.ErrorSecretNotFound("secret %s not found", secretID)
+		}
+	}
+	return nil
+}
+
+// uniqueStrings returns values without duplicates, in their first order
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// ConfigExportService renders the secrets of a folder as dotenv files and
+// Kubernetes Secret manifests for deploy pipelines
+type ConfigExportService struct {
+	wardenV1.UnimplementedWardenConfigExportServiceServer
+
+	log        *log.Helper
+	secretRepo *data.SecretRepo
+	folderRepo *data.FolderRepo
+	kvStore    *vault.KVStore
+	checker    *authz.Checker
+	settings   *data.TenantSettingRepo
+	stepUp     *StepUpPolicy
+	canary     *CanaryAlarm
+}
+
+// NewConfigExportService creates a new ConfigExportService
+func NewConfigExportService(
+	ctx *bootstrap.Context,
+	secretRepo *data.SecretRepo,
+	folderRepo *data.FolderRepo,
+	kvStore *vault.KVStore,
+	checker *authz.Checker,
+	settings *data.TenantSettingRepo,
+	stepUp *StepUpPolicy,
+	canary *CanaryAlarm,
+) *ConfigExportService {
+	return &ConfigExportService{
+		log:        ctx.NewLoggerHelper("warden/service/config-export"),
+		secretRepo: secretRepo,
+		folderRepo: folderRepo,
+		kvStore:    kvStore,
+		checker:    checker,
+		settings:   settings,
+		stepUp:     stepUp,
+		canary:     canary,
+	}
+}
+
+// configEntries is the result of collecting the key/value pairs of a folder
+type configEntries struct {
+	values         map[string]string
+	duplicateKeys  []string
+	exported       int32
+	skipped        int32
+	excluded       int32
+	folderBaseName string
+}
+
+// sortedKeys returns the keys in lexical order so output is stable
+func (e *configEntries) sortedKeys() []string {
+	keys := make([]string, 0, len(e.values))
+	for k := range e.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ExportToEnvFile renders the passwords of a folder as KEY="value" lines
+func (s *ConfigExportService) ExportToEnvFile(ctx context.Context, req *wardenV1.ExportToEnvFileRequest) (*wardenV1.ExportToEnvFileResponse, error) {
+	entries, err := s.collect(ctx, req.FolderId, req.Recursive, req.Naming, wardenV1.KeyCase_KEY_CASE_UPPER, sanitizeEnvKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	for _, k := range entries.sortedKeys() {
+		b.WriteString(k)
+		b.WriteString("=")
+		b.WriteString(quoteEnvValue(entries.values[k]))
+		b.WriteString("\n")
+	}
+
+	s.log.Infof("Env file export finished: tenant=%d folder=%s exported=%d skipped=%d excluded=%d",
+		getTenantIDFromContext(ctx), req.FolderId, entries.exported, entries.skipped, entries.excluded)
+
+	return &wardenV1.ExportToEnvFileResponse{
+		EnvData:               b.String(),
+		ItemsExported:         entries.exported,
+		ItemsSkipped:          entries.skipped,
+		ItemsExcludedByPolicy: entries.excluded,
+		DuplicateKeys:         entries.duplicateKeys,
+		SuggestedFilename:     fmt.Sprintf("%s.env", entries.folderBaseName),
+	}, nil
+}
+
+// ExportToKubernetesSecret renders the passwords of a folder as an Opaque Secret manifest
+func (s *ConfigExportService) ExportToKubernetesSecret(ctx context.Context, req *wardenV1.ExportToKubernetesSecretRequest) (*wardenV1.ExportToKubernetesSecretResponse, error) {
+	entries, err := s.collect(ctx, req.FolderId, req.Recursive, req.Naming, wardenV1.KeyCase_KEY_CASE_PRESERVE, sanitizeKubernetesKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every scalar is JSON-quoted, which YAML reads as a double-quoted string
+	var b strings.Builder
+	b.WriteString("apiVersion: v1\n")
+	b.WriteString("kind: Secret\n")
+	b.WriteString("metadata:\n")
+	b.WriteString("  name: " + yamlQuote(req.SecretName) + "\n")
+	if req.Namespace != "" {
+		b.WriteString("  namespace: " + yamlQuote(req.Namespace) + "\n")
+	}
+	b.WriteString("type: Opaque\n")
+	if len(entries.values) == 0 {
+		b.WriteString("data: {}\n")
+	} else {
+		b.WriteString("data:\n")
+		for _, k := range entries.sortedKeys() {
+			b.WriteString("  " + yamlQuote(k) + ": " + yamlQuote(base64.StdEncoding.EncodeToString([]byte(entries.values[k]))) + "\n")
+		}
+	}
+
+	s.log.Infof("Kubernetes Secret export finished: tenant=%d folder=%s secret=%s exported=%d skipped=%d excluded=%d",
+		getTenantIDFromContext(ctx), req.FolderId, req.SecretName, entries.exported, entries.skipped, entries.excluded)
+
+	return &wardenV1.ExportToKubernetesSecretResponse{
+		Manifest:              b.String(),
+		ItemsExported:         entries.exported,
+		ItemsSkipped:          entries.skipped,
+		ItemsExcludedByPolicy: entries.excluded,
+		DuplicateKeys:         entries.duplicateKeys,
+		SuggestedFilename:     fmt.Sprintf("%s.secret.yaml", req.SecretName),
+	}, nil
+}
+
+// collect reads the readable, non-excluded secrets of a folder (and its
+// subfolders when recursive) and maps them to keys by the naming rules.
+// sanitize replaces characters the output format does not allow.
+func (s *ConfigExportService) collect(
+	ctx context.Context,
+	folderID string,
+	recursive bool,
+	naming *wardenV1.KeyNamingRules,
+	defaultCase wardenV1.KeyCase,
+	sanitize func(string) string,
+) (*configEntries, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadFolder(ctx, tenantID, userID, folderID); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to access this folder")
+	}
+
+	base, err := s.folderRepo.GetByID(ctx, tenantID, folderID)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		return nil, wardenV1.ErrorFolderNotFound("folder not found")
+	}
+
+	var secrets []*ent.Secret
+	if recursive {
+		secrets, err = s.secretRepo.ListAllInFolderTree(ctx, tenantID, folderID)
+	} else {
+		secrets, _, err = s.secretRepo.List(ctx, tenantID, &folderID, nil, nil, nil, nil, data.ListSort{}, nil, 1, 10000, data.ProjectRows)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.settings.GetExportPolicy(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if naming == nil {
+		naming = &wardenV1.KeyNamingRules{}
+	}
+	keyCase := naming.KeyCase
+	if keyCase == wardenV1.KeyCase_KEY_CASE_UNSPECIFIED {
+		keyCase = defaultCase
+	}
+	separator := "_"
+	if naming.Separator != nil {
+		separator = *naming.Separator
+	}
+
+	entries := &configEntries{
+		values:         make(map[string]string, len(secrets)),
+		folderBaseName: sanitizeKubernetesKey(base.Name),
+	}
+	// Folder ID -> path segments below the exported folder
+	relPaths := map[string][]string{folderID: nil}
+
+	add := func(key, value string) {
+		if _, exists := entries.values[key]; exists {
+			entries.duplicateKeys = append(entries.duplicateKeys, key)
+			return
+		}
+		entries.values[key] = value
+	}
+
+	var eligible []*ent.Secret
+	for _, sec := range secrets {
+		if err := s.checker.CanReadSecret(ctx, tenantID, userID, sec.ID); err != nil {
+			entries.skipped++
+			continue
+		}
+		if policy.Excludes(sec) {
+			entries.excluded++
+			continue
+		}
+		if err := s.stepUp.check(ctx, sec); err != nil {
+			entries.skipped++
+			continue
+		}
+		eligible = append(eligible, sec)
+	}
+
+	fetches := make([]vault.FetchRequest, len(eligible))
+	for i, sec := range eligible {
+		fetches[i].Path = sec.VaultPath
+	}
+	fetched := s.kvStore.FetchSecrets(ctx, fetches)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, sec := range eligible {
+		if err := fetched[i].Err; err != nil {
+			s.log.Warnf("Failed to get password for secret %s: %v", sec.ID, err)
+			entries.skipped++
+			continue
+		}
+		password := fetched[i].Data.Password
+		s.canary.trip(ctx, tenantID, sec, "ConfigExport")
+
+		name := sec.Name
+		if naming.NameMetadataKey != "" {
+			if v, ok := sec.Metadata[naming.NameMetadataKey].(string); ok && v != "" {
+				name = v
+			}
+		}
+
+		var parts []string
+		if naming.IncludeFolderPath && sec.FolderID != nil {
+			parts = append(parts, s.relativeFolderPath(ctx, tenantID, base, *sec.FolderID, relPaths)...)
+		}
+		parts = append(parts, name)
+		key := naming.Prefix + strings.Join(parts, separator)
+
+		add(applyKeyCase(sanitize(key), keyCase), password)
+		if naming.IncludeUsername && sec.Username != "" {
+			add(applyKeyCase(sanitize(key+separator+"USERNAME"), keyCase), sec.Username)
+		}
+		entries.exported++
+	}
+
+	return entries, nil
+}
+
+// relativeFolderPath returns the path segments of a folder below base
+func (s *ConfigExportService) relativeFolderPath(ctx context.Context, tenantID uint32, base *ent.Folder, folderID string, cache map[string][]string) []string {
+	if segments, ok := cache[folderID]; ok {
+		return segments
+	}
+
+	var segments []string
+	folder, err := s.folderRepo.GetByID(ctx, tenantID, folderID)
+	if err != nil {
+		s.log.Warnf("Failed to resolve folder %s for config export: %v", folderID, err)
+	} else if folder != nil {
+		rel := strings.TrimPrefix(strings.TrimPrefix(folder.Path, base.Path), "/")
+		if rel != "" {
+			segments = strings.Split(rel, "/")
+		}
+	}
+	cache[folderID] = segments
+	return segments
+}
+
+// applyKeyCase changes the letter case of a key
+func applyKeyCase(key string, keyCase wardenV1.KeyCase) string {
+	switch keyCase {
+	case wardenV1.KeyCase_KEY_CASE_UPPER:
+		return strings.ToUpper(key)
+	case wardenV1.KeyCase_KEY_CASE_LOWER:
+		return strings.ToLower(key)
+	default:
+		return key
+	}
+}
+
+// sanitizeEnvKey makes a valid shell variable name: [A-Za-z_][A-Za-z0-9_]*
+func sanitizeEnvKey(key string) string {
+	var b strings.Builder
+	for _, r := range key {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	out := b.String()
+	if out == "" || (out[0] >= '0' && out[0] <= '9') {
+		out = "_" + out
+	}
+	return out
+}
+
+// sanitizeKubernetesKey makes a valid Secret data key: [-._a-zA-Z0-9]+
+func sanitizeKubernetesKey(key string) string {
+	var b strings.Builder
+	for _, r := range key {
+		if r == '-' || r == '.' || r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	if b.Len() == 0 {
+		return "_"
+	}
+	return b.String()
+}
+
+// quoteEnvValue double-quotes a dotenv value, escaping what shells and
+// dotenv loaders would otherwise interpret
+func quoteEnvValue(v string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "`", "\\`", "\n", `\n`, "\r", `\r`)
+	return `"` + r.Replace(v) + `"`
+}
+
+// yamlQuote renders a string as a YAML double-quoted scalar
+func yamlQuote(v string) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
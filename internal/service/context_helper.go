@@ -1,6 +1,10 @@
 package service
 
-import "github.com/go-tangra/go-tangra-common/grpcx"
+import (
+	"context"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+)
 
 var (
 	getMetadataValue      = grpcx.GetMetadataValue
@@ -11,3 +15,20 @@ var (
 	getRolesFromContext   = grpcx.GetRolesFromContext
 	isPlatformAdmin       = grpcx.IsPlatformAdmin
 )
+
+// roleTenantManager is the role of a tenant's administrators
+const roleTenantManager = "tenant:manager"
+
+// isTenantAdmin reports whether the caller administers its own tenant,
+// which platform admins always do
+func isTenantAdmin(ctx context.Context) bool {
+	if isPlatformAdmin(ctx) {
+		return true
+	}
+	for _, role := range getRolesFromContext(ctx) {
+		if role == roleTenantManager {
+			return true
+		}
+	}
+	return false
+}
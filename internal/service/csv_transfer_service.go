@@ -0,0 +1,865 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/metrics"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// lastPassSecureNoteURL marks LastPass secure notes, which have no login data
+const lastPassSecureNoteURL = "http://sn"
+
+// csvColumnMappings are the named layouts of known CSV exports
+var csvColumnMappings = map[wardenV1.CsvFormat]*wardenV1.CsvColumnMapping{
+	wardenV1.CsvFormat_CSV_FORMAT_LASTPASS: {
+		Name:            "name",
+		Url:             "url",
+		Username:        "username",
+		Password:        "password",
+		Notes:           "extra",
+		Folder:          "grouping",
+		Totp:            "totp",
+		FolderSeparator: "\\",
+	},
+	wardenV1.CsvFormat_CSV_FORMAT_CHROMIUM: {
+		Name:     "name",
+		Url:      "url",
+		Username: "username",
+		Password: "password",
+		Notes:    "note",
+	},
+}
+
+// CsvTransferService imports secrets from CSV exports of other password managers
+// and exports secrets as CSV
+type CsvTransferService struct {
+	wardenV1.UnimplementedWardenCsvTransferServiceServer
+
+	log         *log.Helper
+	secretRepo  *data.SecretRepo
+	folderRepo  *data.FolderRepo
+	versionRepo *data.SecretVersionRepo
+	permRepo    *data.PermissionRepo
+	kvStore     *vault.KVStore
+	checker     *authz.Checker
+	metrics     *metrics.Collector
+	webhooks    *webhook.Dispatcher
+	settings    *data.TenantSettingRepo
+	limits      *PayloadLimits
+	tx          *data.Transactor
+	pendingOps  *data.PendingOperationRepo
+	stepUp      *StepUpPolicy
+	canary      *CanaryAlarm
+}
+
+// NewCsvTransferService creates a new CsvTransferService
+func NewCsvTransferService(
+	ctx *bootstrap.Context,
+	secretRepo *data.SecretRepo,
+	folderRepo *data.FolderRepo,
+	versionRepo *data.SecretVersionRepo,
+	permRepo *data.PermissionRepo,
+	kvStore *vault.KVStore,
+	checker *authz.Checker,
+	metrics *metrics.Collector,
+	webhooks *webhook.Dispatcher,
+	settings *data.TenantSettingRepo,
+	limits *PayloadLimits,
+	tx *data.Transactor,
+	pendingOps *data.PendingOperationRepo,
+	stepUp *StepUpPolicy,
+	canary *CanaryAlarm,
+) *CsvTransferService {
+	return &CsvTransferService{
+		log:         ctx.NewLoggerHelper("warden/service/csv-transfer"),
+		secretRepo:  secretRepo,
+		folderRepo:  folderRepo,
+		versionRepo: versionRepo,
+		permRepo:    permRepo,
+		kvStore:     kvStore,
+		checker:     checker,
+		metrics:     metrics,
+		webhooks:    webhooks,
+		settings:    settings,
+		limits:      limits,
+		tx:          tx,
+		pendingOps:  pendingOps,
+		stepUp:      stepUp,
+		canary:      canary,
+	}
+}
+
+// csvColumns holds the resolved column index of each field (-1 = absent)
+type csvColumns struct {
+	name, url, username, password, notes, folder, totp int
+	folderSeparator                                    string
+}
+
+// csvRow is one parsed record
+type csvRow struct {
+	line                                               int
+	name, url, username, password, notes, folder, totp string
+}
+
+// ImportFromCsv imports secrets from a CSV export
+func (s *CsvTransferService) ImportFromCsv(ctx context.Context, req *wardenV1.ImportFromCsvRequest) (*wardenV1.ImportFromCsvResponse, error) {
+	// The import reads back folders and secrets it creates
+	ctx = data.WithPrimary(ctx)
+
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	if err := s.limits.checkImportSize(len(req.CsvData)); err != nil {
+		return nil, err
+	}
+
+	mapping := csvColumnMappings[req.Format]
+	if req.Format == wardenV1.CsvFormat_CSV_FORMAT_CUSTOM {
+		mapping = req.ColumnMapping
+	}
+	if mapping == nil {
+		return nil, wardenV1.ErrorBadRequest("column mapping is required for custom CSV imports")
+	}
+
+	// Validate target folder permission if specified
+	var targetPathPrefix string
+	if req.TargetFolderId != nil && *req.TargetFolderId != "" {
+		if err := s.checker.CanWriteFolder(ctx, tenantID, userID, *req.TargetFolderId); err != nil {
+			return nil, wardenV1.ErrorAccessDenied("no permission to import into this folder")
+		}
+		targetFolder, err := s.folderRepo.GetByID(ctx, tenantID, *req.TargetFolderId)
+		if err != nil {
+			return nil, err
+		}
+		if targetFolder == nil {
+			return nil, wardenV1.ErrorFolderNotFound("target folder not found")
+		}
+		targetPathPrefix = targetFolder.Path
+	}
+
+	if err := s.limits.checkImportItems(countCsvRecords(req.CsvData) - 1); err != nil {
+		return nil, err
+	}
+
+	reader := newCsvReader(req.CsvData)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, wardenV1.ErrorInvalidFormat("CSV has no header row")
+	}
+
+	cols, err := resolveCsvColumns(header, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.ImportFromCsvResponse{
+		Errors:          []*wardenV1.CsvRowError{},
+		FolderIdMapping: make(map[string]string),
+	}
+
+	// Get existing secrets for duplicate detection
+	existingSecrets, err := s.secretRepo.ListAll(ctx, tenantID)
+	if err != nil {
+		return nil, wardenV1.ErrorInternalServerError("failed to list existing secrets for duplicate detection")
+	}
+	existingNames := make(map[string]bool, len(existingSecrets))
+	existingByKey := make(map[string]*data.SecretInfo, len(existingSecrets))
+	for _, sec := range existingSecrets {
+		existingNames[strings.ToLower(sec.Name)] = true
+		existingByKey[duplicateKey(req.DuplicateMatch, sec.Name, sec.HostURL, sec.Username)] = &data.SecretInfo{ID: sec.ID, VaultPath: sec.VaultPath}
+	}
+
+	// Cache of folders resolved or created during this import (DB path -> folder ID)
+	pathToFolderID := make(map[string]string)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var line int
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				line = parseErr.Line
+			}
+			resp.RowsTotal++
+			resp.ItemsFailed++
+			resp.Errors = append(resp.Errors, &wardenV1.CsvRowError{
+				Line:      int32(line),
+				ErrorType: "parse",
+				Message:   err.Error(),
+			})
+			// A broken quote swallows the rest of the input, so stop here
+			break
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := cols.row(record, line)
+		if row.isEmpty() {
+			continue
+		}
+		resp.RowsTotal++
+
+		s.importRow(ctx, req, row, cols.folderSeparator, targetPathPrefix, existingNames, existingByKey, pathToFolderID, resp, tenantID, userID, createdBy)
+	}
+
+	s.log.Infof("CSV import finished: tenant=%d format=%s rows=%d imported=%d updated=%d skipped=%d failed=%d",
+		tenantID, req.Format, resp.RowsTotal, resp.ItemsImported, resp.ItemsUpdated, resp.ItemsSkipped, resp.ItemsFailed)
+
+	s.webhooks.Publish(ctx, tenantID, webhook.EventImportFinished, map[string]any{
+		"source":          "csv",
+		"format":          req.Format.String(),
+		"user_id":         userID,
+		"rows_total":      resp.RowsTotal,
+		"folders_created": resp.FoldersCreated,
+		"items_imported":  resp.ItemsImported,
+		"items_updated":   resp.ItemsUpdated,
+		"items_skipped":   resp.ItemsSkipped,
+		"items_failed":    resp.ItemsFailed,
+	})
+
+	return resp, nil
+}
+
+// importRow imports a single CSV row, recording any problem in resp
+func (s *CsvTransferService) importRow(
+	ctx context.Context,
+	req *wardenV1.ImportFromCsvRequest,
+	row *csvRow,
+	folderSeparator, targetPathPrefix string,
+	existingNames map[string]bool,
+	existingByKey map[string]*data.SecretInfo,
+	pathToFolderID map[string]string,
+	resp *wardenV1.ImportFromCsvResponse,
+	tenantID uint32,
+	userID string,
+	createdBy *uint32,
+) {
+	rowError := func(errorType, message string) {
+		resp.Errors = append(resp.Errors, &wardenV1.CsvRowError{
+			Line:      int32(row.line),
+			ItemName:  row.name,
+			ErrorType: errorType,
+			Message:   message,
+		})
+	}
+
+	if req.Format == wardenV1.CsvFormat_CSV_FORMAT_LASTPASS && row.url == lastPassSecureNoteURL {
+		rowError("unsupported_type", "secure notes are not supported")
+		resp.ItemsSkipped++
+		return
+	}
+
+	name := row.name
+	if name == "" {
+		name = hostFromURL(row.url)
+	}
+	if name == "" {
+		rowError("validation", "row has neither a name nor a URL")
+		resp.ItemsSkipped++
+		return
+	}
+	if len(name) > 255 {
+		rowError("validation", "name is longer than 255 characters")
+		resp.ItemsSkipped++
+		return
+	}
+	if row.password == "" {
+		rowError("validation", "row has no password")
+		resp.ItemsSkipped++
+		return
+	}
+
+	// Determine target folder
+	targetFolderID := req.TargetFolderId
+	if targetFolderID != nil && *targetFolderID == "" {
+		targetFolderID = nil
+	}
+	if req.PreserveFolders && row.folder != "" {
+		folderID, err := s.ensureFolderPath(ctx, req, splitFolderPath(row.folder, folderSeparator), targetPathPrefix, pathToFolderID, resp, tenantID, userID, createdBy)
+		if err != nil {
+			rowError("folder_creation", "failed to create folder "+row.folder)
+			resp.ItemsFailed++
+			return
+		}
+		if folderID != "" {
+			targetFolderID = &folderID
+			resp.FolderIdMapping[row.folder] = folderID
+		}
+	}
+
+	// Check for duplicates
+	if existing, ok := existingByKey[duplicateKey(req.DuplicateMatch, name, row.url, row.username)]; ok {
+		switch req.DuplicateHandling {
+		case wardenV1.DuplicateHandling_DUPLICATE_HANDLING_RENAME:
+			const maxRenameAttempts = 1000
+			base := name
+			for counter := 1; existingNames[strings.ToLower(name)] && counter <= maxRenameAttempts; counter++ {
+				name = fmt.Sprintf("%s (%d)", base, counter)
+			}
+		case wardenV1.DuplicateHandling_DUPLICATE_HANDLING_OVERWRITE:
+			if err := s.overwriteSecret(ctx, existing, row, tenantID, userID, createdBy); err != nil {
+				rowError("overwrite_error", err.Error())
+				resp.ItemsFailed++
+				return
+			}
+			resp.ItemsUpdated++
+			return
+		case wardenV1.DuplicateHandling_DUPLICATE_HANDLING_MERGE:
+			if err := s.mergeSecret(ctx, existing, row, tenantID, userID, createdBy); err != nil {
+				rowError("merge_error", err.Error())
+				resp.ItemsFailed++
+				return
+			}
+			resp.ItemsUpdated++
+			return
+		default:
+			rowError("duplicate", "matching item already exists")
+			resp.ItemsSkipped++
+			return
+		}
+	}
+
+	secretID := uuid.New().String()
+	vaultPath := s.kvStore.BuildPath(tenantID, secretID)
+
+	pendingWrite, err := s.pendingOps.BeginVaultWrite(ctx, tenantID, secretID, vaultPath)
+	if err != nil {
+		rowError("creation_error", "failed to create secret")
+		resp.ItemsFailed++
+		return
+	}
+
+	if _, err := s.kvStore.StorePassword(ctx, vaultPath, row.password, nil); err != nil {
+		s.log.Errorf("failed to store password in Vault for CSV line %d: %v", row.line, err)
+		s.pendingOps.AbortVaultWrite(ctx, pendingWrite)
+		rowError("vault_error", "failed to store password in vault")
+		resp.ItemsFailed++
+		return
+	}
+
+	// Create the secret, its version and its permissions atomically
+	checksum := s.kvStore.Checksum(row.password)
+	var secretEntity *ent.Secret
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		secretEntity, err = s.secretRepo.Create(ctx, tenantID, targetFolderID, name, row.username, row.url, vaultPath, row.notes, nil, createdBy)
+		if err != nil {
+			return err
+		}
+		if _, err := s.versionRepo.Create(ctx, secretEntity.ID, 1, vaultPath, "Imported from CSV", checksum, nil, createdBy); err != nil {
+			return err
+		}
+		if err := s.grantImportPermissions(ctx, tenantID, authz.ResourceTypeSecret, secretEntity.ID, req.PermissionRules, userID, createdBy); err != nil {
+			return err
+		}
+		return s.pendingOps.CommitVaultWrite(ctx, pendingWrite)
+	})
+	if err != nil {
+		s.pendingOps.AbortVaultWrite(ctx, pendingWrite)
+		s.log.Errorf("secret creation failed for CSV line %d: %v", row.line, err)
+		rowError("creation_error", "failed to create secret")
+		resp.ItemsFailed++
+		return
+	}
+
+	if row.totp != "" {
+		totpPath := s.kvStore.BuildTotpPath(tenantID, secretEntity.ID)
+		if err := s.kvStore.StoreTotpURL(ctx, totpPath, row.totp); err != nil {
+			s.log.Warnf("failed to store TOTP for imported secret %s: %v", secretEntity.ID, err)
+		} else {
+			_ = s.secretRepo.SetHasTotp(ctx, tenantID, secretEntity.ID, true)
+		}
+	}
+
+	s.metrics.SecretCreated(string(secretEntity.Status))
+
+	existingNames[strings.ToLower(name)] = true
+	existingByKey[duplicateKey(req.DuplicateMatch, name, row.url, row.username)] = &data.SecretInfo{ID: secretEntity.ID, VaultPath: vaultPath}
+	resp.ItemsImported++
+}
+
+// overwriteSecret replaces an existing secret's login data, keeping its history
+// by storing the imported password as a new version
+func (s *CsvTransferService) overwriteSecret(ctx context.Context, existing *data.SecretInfo, row *csvRow, tenantID uint32, userID string, updatedBy *uint32) error {
+	if err := s.checker.CanWriteSecret(ctx, tenantID, userID, existing.ID); err != nil {
+		return fmt.Errorf("no permission to overwrite existing secret")
+	}
+
+	newVersion, err := s.kvStore.StorePassword(ctx, existing.VaultPath, row.password, nil)
+	if err != nil {
+		s.log.Errorf("failed to store password for overwrite of secret %s: %v", existing.ID, err)
+		return fmt.Errorf("failed to store password in vault")
+	}
+
+	checksum := s.kvStore.Checksum(row.password)
+	username, hostURL, description := row.username, row.url, row.notes
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := s.versionRepo.Create(ctx, existing.ID, int32(newVersion), existing.VaultPath, "Overwritten by CSV import", checksum, nil, updatedBy); err != nil {
+			return fmt.Errorf("failed to create version record")
+		}
+		if _, err := s.secretRepo.Update(ctx, tenantID, existing.ID, nil, &username, &hostURL, &description, nil, nil, nil, nil, updatedBy); err != nil {
+			return fmt.Errorf("failed to update existing secret")
+		}
+		if _, err := s.secretRepo.UpdateVersion(ctx, tenantID, existing.ID, int32(newVersion), updatedBy); err != nil {
+			return fmt.Errorf("failed to update secret version")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.metrics.SecretVersionCreated()
+	return nil
+}
+
+// mergeSecret adds the imported password as a new version of an existing
+// secret, leaving its other fields and Vault metadata unchanged
+func (s *CsvTransferService) mergeSecret(ctx context.Context, existing *data.SecretInfo, row *csvRow, tenantID uint32, userID string, updatedBy *uint32) error {
+	if err := s.checker.CanWriteSecret(ctx, tenantID, userID, existing.ID); err != nil {
+		return fmt.Errorf("no permission to update existing secret")
+	}
+
+	current, _, err := s.kvStore.GetSecretData(ctx, existing.VaultPath)
+	if err != nil {
+		s.log.Errorf("failed to read current password for merge into secret %s: %v", existing.ID, err)
+		return fmt.Errorf("failed to read password from vault")
+	}
+
+	newVersion, err := s.kvStore.StorePassword(ctx, existing.VaultPath, row.password, current.Metadata)
+	if err != nil {
+		s.log.Errorf("failed to store password for merge into secret %s: %v", existing.ID, err)
+		return fmt.Errorf("failed to store password in vault")
+	}
+
+	checksum := s.kvStore.Checksum(row.password)
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := s.versionRepo.Create(ctx, existing.ID, int32(newVersion), existing.VaultPath, "Merged from CSV import", checksum, nil, updatedBy); err != nil {
+			return fmt.Errorf("failed to create version record")
+		}
+		if _, err := s.secretRepo.UpdateVersion(ctx, tenantID, existing.ID, int32(newVersion), updatedBy); err != nil {
+			return fmt.Errorf("failed to update secret version")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.metrics.SecretVersionCreated()
+	return nil
+}
+
+// ensureFolderPath finds or creates each folder of the path below the target
+// folder and returns the ID of the last one
+func (s *CsvTransferService) ensureFolderPath(
+	ctx context.Context,
+	req *wardenV1.ImportFromCsvRequest,
+	segments []string,
+	targetPathPrefix string,
+	pathToFolderID map[string]string,
+	resp *wardenV1.ImportFromCsvResponse,
+	tenantID uint32,
+	userID string,
+	createdBy *uint32,
+) (string, error) {
+	var currentParentID *string
+	if req.TargetFolderId != nil && *req.TargetFolderId != "" {
+		currentParentID = req.TargetFolderId
+	}
+
+	var leafFolderID string
+	for i, segment := range segments {
+		dbPath := targetPathPrefix + "/" + strings.Join(segments[:i+1], "/")
+
+		if cachedID, ok := pathToFolderID[dbPath]; ok {
+			leafFolderID = cachedID
+			currentParentID = &cachedID
+			continue
+		}
+
+		folder, err := s.folderRepo.GetByTenantAndPath(ctx, tenantID, dbPath)
+		if err != nil {
+			return "", err
+		}
+		if folder == nil {
+			err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+				var err error
+				folder, err = s.folderRepo.Create(ctx, tenantID, currentParentID, segment, "", createdBy)
+				if err != nil {
+					return err
+				}
+				return s.grantImportPermissions(ctx, tenantID, authz.ResourceTypeFolder, folder.ID, req.PermissionRules, userID, createdBy)
+			})
+			if err != nil {
+				s.log.Errorf("folder creation failed for %s: %v", dbPath, err)
+				return "", err
+			}
+			resp.FoldersCreated++
+			s.metrics.FolderCreated()
+		}
+
+		folderID := folder.ID
+		pathToFolderID[dbPath] = folderID
+		leafFolderID = folderID
+		currentParentID = &folderID
+	}
+
+	return leafFolderID, nil
+}
+
+// grantImportPermissions grants the importing user ownership of an imported
+// resource plus the requested permission rules. Duplicate grants are skipped
+// since they would violate the unique index and abort the transaction.
+func (s *CsvTransferService) grantImportPermissions(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string, rules []*wardenV1.ImportPermissionRule, userID string, createdBy *uint32) error {
+	granted := make(map[string]bool, len(rules)+1)
+	grant := func(relation, subjectType, subjectID string) error {
+		key := relation + "|" + subjectType + "|" + subjectID
+		if granted[key] {
+			return nil
+		}
+		granted[key] = true
+		if _, err := s.permRepo.Create(ctx, tenantID, string(resourceType), resourceID, relation, subjectType, subjectID, createdBy, nil); err != nil {
+			s.log.Errorf("Failed to grant %s on %s %s to %s %s: %v", relation, resourceType, resourceID, subjectType, subjectID, err)
+			return err
+		}
+		return nil
+	}
+
+	if createdBy != nil {
+		if err := grant(string(authz.RelationOwner), string(authz.SubjectTypeUser), userID); err != nil {
+			return err
+		}
+	}
+	for _, rule := range rules {
+		if rule.SubjectType == wardenV1.SubjectType_SUBJECT_TYPE_UNSPECIFIED || rule.SubjectId == "" || rule.Relation == wardenV1.Relation_RELATION_UNSPECIFIED {
+			continue
+		}
+		if err := grant(rule.Relation.String(), rule.SubjectType.String(), rule.SubjectId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportToCsv exports the secrets of a folder, subtree or the whole tenant as CSV
+func (s *CsvTransferService) ExportToCsv(ctx context.Context, req *wardenV1.ExportToCsvRequest) (*wardenV1.ExportToCsvResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	delimiter := ','
+	if req.Delimiter != nil && *req.Delimiter != "" {
+		r, size := utf8.DecodeRuneInString(*req.Delimiter)
+		if size != len(*req.Delimiter) || r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError {
+			return nil, wardenV1.ErrorBadRequest("delimiter must be a single character other than a quote or line break")
+		}
+		delimiter = r
+	}
+
+	var secrets []*ent.Secret
+	var err error
+	switch req.Scope {
+	case wardenV1.CsvExportScope_CSV_EXPORT_SCOPE_FOLDER, wardenV1.CsvExportScope_CSV_EXPORT_SCOPE_SUBTREE:
+		if req.FolderId == nil || *req.FolderId == "" {
+			return nil, wardenV1.ErrorBadRequest("folder_id is required for folder and subtree exports")
+		}
+		if err := s.checker.CanReadFolder(ctx, tenantID, userID, *req.FolderId); err != nil {
+			return nil, wardenV1.ErrorAccessDenied("no permission to access this folder")
+		}
+		if req.Scope == wardenV1.CsvExportScope_CSV_EXPORT_SCOPE_SUBTREE {
+			secrets, err = s.secretRepo.ListAllInFolderTree(ctx, tenantID, *req.FolderId)
+		} else {
+			secrets, _, err = s.secretRepo.List(ctx, tenantID, req.FolderId, nil, nil, nil, nil, data.ListSort{}, nil, 1, 10000, data.ProjectRows)
+		}
+	case wardenV1.CsvExportScope_CSV_EXPORT_SCOPE_TENANT:
+		secrets, err = s.secretRepo.ListAll(ctx, tenantID)
+	default:
+		return nil, wardenV1.ErrorBadRequest("export scope is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.settings.GetExportPolicy(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]string, 0, len(req.Columns))
+	needPassword := false
+	for _, col := range req.Columns {
+		header = append(header, csvColumnHeader(col))
+		if col == wardenV1.CsvColumn_CSV_COLUMN_PASSWORD {
+			needPassword = true
+		}
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.Comma = delimiter
+	_ = writer.Write(header)
+
+	// Folder ID -> path, resolved once per folder
+	folderPaths := make(map[string]string)
+
+	var itemsExported, itemsSkipped, itemsExcluded int32
+	var eligible []*ent.Secret
+	for _, sec := range secrets {
+		if err := s.checker.CanReadSecret(ctx, tenantID, userID, sec.ID); err != nil {
+			itemsSkipped++
+			continue
+		}
+		if policy.Excludes(sec) {
+			itemsExcluded++
+			continue
+		}
+		if needPassword {
+			if err := s.stepUp.check(ctx, sec); err != nil {
+				itemsSkipped++
+				continue
+			}
+		}
+		eligible = append(eligible, sec)
+	}
+
+	var fetched []vault.FetchResult
+	if needPassword {
+		fetches := make([]vault.FetchRequest, len(eligible))
+		for i, sec := range eligible {
+			fetches[i].Path = sec.VaultPath
+		}
+		fetched = s.kvStore.FetchSecrets(ctx, fetches)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, sec := range eligible {
+		var password string
+		if needPassword {
+			if fetched[i].Err != nil {
+				s.log.Warnf("Failed to get password for secret %s: %v", sec.ID, fetched[i].Err)
+				itemsSkipped++
+				continue
+			}
+			password = fetched[i].Data.Password
+			s.canary.trip(ctx, tenantID, sec, "ExportToCsv")
+		}
+
+		record := make([]string, 0, len(req.Columns))
+		for _, col := range req.Columns {
+			switch col {
+			case wardenV1.CsvColumn_CSV_COLUMN_NAME:
+				record = append(record, sec.Name)
+			case wardenV1.CsvColumn_CSV_COLUMN_USERNAME:
+				record = append(record, sec.Username)
+			case wardenV1.CsvColumn_CSV_COLUMN_PASSWORD:
+				record = append(record, password)
+			case wardenV1.CsvColumn_CSV_COLUMN_URL:
+				record = append(record, sec.HostURL)
+			case wardenV1.CsvColumn_CSV_COLUMN_FOLDER_PATH:
+				record = append(record, s.folderPath(ctx, tenantID, sec.FolderID, folderPaths))
+			case wardenV1.CsvColumn_CSV_COLUMN_TAGS:
+				record = append(record, strings.Join(data.SecretTags(sec.Metadata), ";"))
+			case wardenV1.CsvColumn_CSV_COLUMN_NOTES:
+				record = append(record, sec.Description)
+			default:
+				record = append(record, "")
+			}
+		}
+		_ = writer.Write(record)
+		itemsExported++
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		s.log.Errorf("CSV export failed: %v", err)
+		return nil, wardenV1.ErrorInternalServerError("failed to generate CSV")
+	}
+
+	s.log.Infof("CSV export finished: tenant=%d scope=%s exported=%d skipped=%d excluded=%d", tenantID, req.Scope, itemsExported, itemsSkipped, itemsExcluded)
+
+	return &wardenV1.ExportToCsvResponse{
+		CsvData:               buf.String(),
+		ItemsExported:         itemsExported,
+		ItemsSkipped:          itemsSkipped,
+		SuggestedFilename:     fmt.Sprintf("warden-export-%s.csv", time.Now().Format("2006-01-02")),
+		ItemsExcludedByPolicy: itemsExcluded,
+	}, nil
+}
+
+// folderPath returns the folder's path without the leading slash, or "" for the root
+func (s *CsvTransferService) folderPath(ctx context.Context, tenantID uint32, folderID *string, cache map[string]string) string {
+	if folderID == nil || *folderID == "" {
+		return ""
+	}
+	if path, ok := cache[*folderID]; ok {
+		return path
+	}
+
+	var path string
+	folder, err := s.folderRepo.GetByID(ctx, tenantID, *folderID)
+	if err != nil {
+		s.log.Warnf("Failed to resolve folder %s for CSV export: %v", *folderID, err)
+	} else if folder != nil {
+		path = strings.TrimPrefix(folder.Path, "/")
+	}
+	cache[*folderID] = path
+	return path
+}
+
+// csvColumnHeader returns the header name of an export column
+func csvColumnHeader(col wardenV1.CsvColumn) string {
+	switch col {
+	case wardenV1.CsvColumn_CSV_COLUMN_NAME:
+		return "name"
+	case wardenV1.CsvColumn_CSV_COLUMN_USERNAME:
+		return "username"
+	case wardenV1.CsvColumn_CSV_COLUMN_PASSWORD:
+		return "password"
+	case wardenV1.CsvColumn_CSV_COLUMN_URL:
+		return "url"
+	case wardenV1.CsvColumn_CSV_COLUMN_FOLDER_PATH:
+		return "folder"
+	case wardenV1.CsvColumn_CSV_COLUMN_TAGS:
+		return "tags"
+	case wardenV1.CsvColumn_CSV_COLUMN_NOTES:
+		return "notes"
+	default:
+		return ""
+	}
+}
+
+// resolveCsvColumns maps the configured header names to column indexes
+func resolveCsvColumns(header []string, mapping *wardenV1.CsvColumnMapping) (*csvColumns, error) {
+	index := make(map[string]int, len(header))
+	for i, h := range header {
+		key := strings.ToLower(strings.TrimSpace(h))
+		if _, dup := index[key]; !dup {
+			index[key] = i
+		}
+	}
+
+	lookup := func(column string) int {
+		if column == "" {
+			return -1
+		}
+		if i, ok := index[strings.ToLower(strings.TrimSpace(column))]; ok {
+			return i
+		}
+		return -1
+	}
+
+	cols := &csvColumns{
+		name:            lookup(mapping.Name),
+		url:             lookup(mapping.Url),
+		username:        lookup(mapping.Username),
+		password:        lookup(mapping.Password),
+		notes:           lookup(mapping.Notes),
+		folder:          lookup(mapping.Folder),
+		totp:            lookup(mapping.Totp),
+		folderSeparator: mapping.FolderSeparator,
+	}
+	if cols.folderSeparator == "" {
+		cols.folderSeparator = "/"
+	}
+
+	if cols.password < 0 {
+		return nil, wardenV1.ErrorInvalidFormat("CSV header has no password column %q", mapping.Password)
+	}
+	if cols.name < 0 && cols.url < 0 {
+		return nil, wardenV1.ErrorInvalidFormat("CSV header needs a name or url column")
+	}
+	return cols, nil
+}
+
+func (c *csvColumns) row(record []string, line int) *csvRow {
+	field := func(i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return record[i]
+	}
+	return &csvRow{
+		line:     line,
+		name:     strings.TrimSpace(field(c.name)),
+		url:      strings.TrimSpace(field(c.url)),
+		username: strings.TrimSpace(field(c.username)),
+		password: field(c.password),
+		notes:    field(c.notes),
+		folder:   strings.TrimSpace(field(c.folder)),
+		totp:     strings.TrimSpace(field(c.totp)),
+	}
+}
+
+func (r *csvRow) isEmpty() bool {
+	return r.name == "" && r.url == "" && r.username == "" && r.password == "" && r.notes == ""
+}
+
+// splitFolderPath splits a grouping value into non-empty folder names
+func splitFolderPath(path, separator string) []string {
+	var segments []string
+	for _, p := range strings.Split(path, separator) {
+		if p = strings.TrimSpace(p); p != "" {
+			segments = append(segments, p)
+		}
+	}
+	return segments
+}
+
+func newCsvReader(csvData string) *csv.Reader {
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(csvData, "\ufeff")))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	return reader
+}
+
+// countCsvRecords counts the records of csvData (including the header)
+// without keeping them in memory. Parsing stops at the first malformed record.
+func countCsvRecords(csvData string) int {
+	reader := newCsvReader(csvData)
+	reader.ReuseRecord = true
+
+	n := 0
+	for {
+		if _, err := reader.Read(); err != nil {
+			return n
+		}
+		n++
+	}
+}
+
+// hostFromURL returns the host of a URL, used as a fallback secret name
+func hostFromURL(rawURL string) string {
+	host := rawURL
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	if i := strings.IndexAny(host, "/?#"); i >= 0 {
+		host = host[:i]
+	}
+	return host
+}
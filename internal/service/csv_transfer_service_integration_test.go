@@ -0,0 +1,66 @@
+//go:build integration
+
+package service_test
+
+import (
+	"slices"
+	"testing"
+
+	wardentesting "github.com/go-tangra/go-tangra-warden/internal/testing"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+func TestCsvImportLastPassGroupings(t *testing.T) {
+	env := wardentesting.NewEnv(t, wardentesting.WithMemoryVault())
+	tenant := env.Tenant()
+	alice := tenant.User("alice")
+
+	csv := "url,username,password,totp,extra,name,grouping,fav\n" +
+		"https://db.example.com,admin,db-password,,,db,Infra\\Prod,0\n" +
+		"https://ci.example.com,bot,ci-password,,,ci,Infra,0\n" +
+		"https://mail.example.com,me,mail-password,,,mail,,0\n"
+
+	resp, err := env.CsvService.ImportFromCsv(alice.Context(), &wardenV1.ImportFromCsvRequest{
+		CsvData:         csv,
+		Format:          wardenV1.CsvFormat_CSV_FORMAT_LASTPASS,
+		PreserveFolders: true,
+	})
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if resp.ItemsImported != 3 || resp.ItemsFailed != 0 {
+		t.Fatalf("imported %d items, %d failed: %v", resp.ItemsImported, resp.ItemsFailed, resp.Errors)
+	}
+
+	wantFolders := []string{"/Infra", "/Infra/Prod"}
+	if got := env.FolderPaths(t, tenant.ID); !slices.Equal(got, wantFolders) {
+		t.Fatalf("folders are %q, want %q", got, wantFolders)
+	}
+	wantSecrets := []string{"/Infra/Prod/db", "/Infra/ci", "/mail"}
+	if got := env.SecretPaths(t, tenant.ID); !slices.Equal(got, wantSecrets) {
+		t.Fatalf("secrets are %q, want %q", got, wantSecrets)
+	}
+}
+
+func TestCsvImportSkipsDuplicates(t *testing.T) {
+	env := wardentesting.NewEnv(t, wardentesting.WithMemoryVault())
+	tenant := env.Tenant()
+	alice := tenant.User("alice")
+	existing := env.CreateSecret(t, alice, nil, "mail", "original-password")
+
+	resp, err := env.CsvService.ImportFromCsv(alice.Context(), &wardenV1.ImportFromCsvRequest{
+		CsvData:           "name,url,username,password,note\nmail,https://mail.example.com,me,imported-password,\n",
+		Format:            wardenV1.CsvFormat_CSV_FORMAT_CHROMIUM,
+		DuplicateHandling: wardenV1.DuplicateHandling_DUPLICATE_HANDLING_SKIP,
+	})
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if resp.ItemsSkipped != 1 || resp.ItemsImported != 0 {
+		t.Fatalf("imported %d and skipped %d items, want the duplicate skipped", resp.ItemsImported, resp.ItemsSkipped)
+	}
+	if got := env.Password(t, alice, existing.Id); got != "original-password" {
+		t.Fatalf("password of the existing secret is %q", got)
+	}
+}
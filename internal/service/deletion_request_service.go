@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/deletionrequest"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// defaultDeletionRequestTTL is how long a deletion request can be approved
+const defaultDeletionRequestTTL = 7 * 24 * time.Hour
+
+// DeletionRequestService implements dual control over the permanent deletion
+// of protected secrets and folders: an owner requests it and a second owner
+// approves it, which carries out the deletion.
+type DeletionRequestService struct {
+	wardenV1.UnimplementedWardenDeletionRequestServiceServer
+
+	log         *log.Helper
+	requestRepo *data.DeletionRequestRepo
+	secretRepo  *data.SecretRepo
+	folderRepo  *data.FolderRepo
+	checker     *authz.Checker
+	secrets     *SecretService
+	folders     *FolderService
+
+	ttl time.Duration
+}
+
+// NewDeletionRequestService creates the service. DELETION_REQUEST_TTL sets
+// how long a request can be approved (default 168h).
+func NewDeletionRequestService(
+	ctx *bootstrap.Context,
+	requestRepo *data.DeletionRequestRepo,
+	secretRepo *data.SecretRepo,
+	folderRepo *data.FolderRepo,
+	checker *authz.Checker,
+	secrets *SecretService,
+	folders *FolderService,
+) *DeletionRequestService {
+	l := ctx.NewLoggerHelper("warden/service/deletion-request")
+
+	s := &DeletionRequestService{
+		log:         l,
+		requestRepo: requestRepo,
+		secretRepo:  secretRepo,
+		folderRepo:  folderRepo,
+		checker:     checker,
+		secrets:     secrets,
+		folders:     folders,
+		ttl:         defaultDeletionRequestTTL,
+	}
+	if v := os.Getenv("DELETION_REQUEST_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			s.ttl = d
+		} else {
+			l.Warnf("Invalid DELETION_REQUEST_TTL %q, using %s", v, s.ttl)
+		}
+	}
+	return s
+}
+
+// RequestDeletion records a request to permanently delete a protected secret
+// or folder, for a second owner to approve
+func (s *DeletionRequestService) RequestDeletion(ctx context.Context, req *wardenV1.RequestDeletionRequest) (*wardenV1.RequestDeletionResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.canDelete(ctx, req.ResourceType, req.ResourceId); err != nil {
+		return nil, err
+	}
+
+	var (
+		name      string
+		protected bool
+	)
+	switch req.ResourceType {
+	case wardenV1.DeletionResourceType_DELETION_RESOURCE_TYPE_SECRET:
+		secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		if secretEntity == nil {
+			return nil, wardenV1.ErrorSecretNotFound("secret not found")
+		}
+		if err := checkLegalHold(ctx, s.folderRepo, tenantID, secretEntity.FolderID); err != nil {
+			return nil, err
+		}
+		name = secretEntity.Name
+		protected, err = s.secrets.isProtected(ctx, tenantID, secretEntity)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		folderEntity, err := s.folderRepo.GetByID(ctx, tenantID, req.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		if folderEntity == nil {
+			return nil, wardenV1.ErrorFolderNotFound("folder not found")
+		}
+		if err := s.folders.checkDeleteHold(ctx, tenantID, req.ResourceId, req.Force); err != nil {
+			return nil, err
+		}
+		name = folderEntity.Path
+		protected, err = s.folders.isProtected(ctx, tenantID, req.ResourceId, req.Force)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !protected {
+		return nil, wardenV1.ErrorBadRequest("resource is not protected, delete it directly")
+	}
+
+	pending, err := s.requestRepo.HasPending(ctx, tenantID, req.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, wardenV1.ErrorConflict("a deletion request for this resource is already pending")
+	}
+
+	request, err := s.requestRepo.Create(ctx, tenantID, deletionrequest.ResourceType(req.ResourceType.String()), req.ResourceId, name, req.Force, req.Reason, userID, time.Now().Add(s.ttl))
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, auditevent.DeletionRequested, request)
+
+	s.log.Infof("Deletion requested: id=%d resource=%s user=%s", request.ID, req.ResourceId, userID)
+
+	return &wardenV1.RequestDeletionResponse{
+		DeletionRequest: s.requestRepo.ToProto(request),
+	}, nil
+}
+
+// ListDeletionRequests lists the requests the caller made and those for
+// resources the caller owns; platform admins see all requests of the tenant
+func (s *DeletionRequestService) ListDeletionRequests(ctx context.Context, req *wardenV1.ListDeletionRequestsRequest) (*wardenV1.ListDeletionRequestsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	requests, err := s.requestRepo.List(ctx, tenantID, req.Status, req.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.ListDeletionRequestsResponse{
+		DeletionRequests: make([]*wardenV1.DeletionRequest, 0, len(requests)),
+	}
+	admin := isPlatformAdmin(ctx)
+	for _, request := range requests {
+		proto := s.requestRepo.ToProto(request)
+		if !admin && request.RequestedBy != userID && s.canDelete(ctx, proto.ResourceType, request.ResourceID) != nil {
+			continue
+		}
+		resp.DeletionRequests = append(resp.DeletionRequests, proto)
+	}
+	return resp, nil
+}
+
+// ApproveDeletion approves a pending request as an owner other than the
+// requester and permanently deletes the resource
+func (s *DeletionRequestService) ApproveDeletion(ctx context.Context, req *wardenV1.ApproveDeletionRequest) (*wardenV1.ApproveDeletionResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	request, err := s.getRequest(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if request.RequestedBy == userID {
+		return nil, wardenV1.ErrorAccessDenied("a deletion must be approved by a second owner")
+	}
+	resourceType := wardenV1.DeletionResourceType(wardenV1.DeletionResourceType_value[string(request.ResourceType)])
+	if err := s.canDelete(ctx, resourceType, request.ResourceID); err != nil {
+		return nil, err
+	}
+
+	// Load the resource before recording the approval, so a request for a
+	// resource deleted meanwhile stays pending
+	var secretEntity *ent.Secret
+	if resourceType == wardenV1.DeletionResourceType_DELETION_RESOURCE_TYPE_SECRET {
+		if secretEntity, err = s.secretRepo.GetByID(ctx, tenantID, request.ResourceID); err != nil {
+			return nil, err
+		}
+		if secretEntity == nil {
+			return nil, wardenV1.ErrorSecretNotFound("secret not found")
+		}
+		err = checkLegalHold(ctx, s.folderRepo, tenantID, secretEntity.FolderID)
+	} else {
+		err = s.folders.checkDeleteHold(ctx, tenantID, request.ResourceID, request.Force)
+	}
+	// A hold placed after the request keeps it pending until the hold is lifted
+	if err != nil {
+		return nil, err
+	}
+
+	// Recording the approval first stops a concurrent approval or rejection
+	request, err = s.requestRepo.Decide(ctx, tenantID, req.Id, deletionrequest.StatusDELETION_REQUEST_STATUS_APPROVED, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if secretEntity != nil {
+		err = s.secrets.deleteSecret(ctx, secretEntity, true)
+	} else {
+		err = s.folders.deleteFolder(ctx, request.ResourceID, request.Force)
+	}
+	if err != nil {
+		if reopenErr := s.requestRepo.Reopen(ctx, tenantID, req.Id); reopenErr != nil {
+			s.log.Errorf("failed to reopen deletion request %d: %v", req.Id, reopenErr)
+		}
+		return nil, err
+	}
+
+	s.record(ctx, auditevent.DeletionApproved, request)
+
+	s.log.Infof("Deletion approved: id=%d resource=%s requester=%s approver=%s", request.ID, request.ResourceID, request.RequestedBy, userID)
+
+	return &wardenV1.ApproveDeletionResponse{
+		DeletionRequest: s.requestRepo.ToProto(request),
+	}, nil
+}
+
+// RejectDeletion rejects a pending request as another owner, or withdraws it
+// as the requester
+func (s *DeletionRequestService) RejectDeletion(ctx context.Context, req *wardenV1.RejectDeletionRequest) (*wardenV1.RejectDeletionResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	request, err := s.getRequest(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	status, event := deletionrequest.StatusDELETION_REQUEST_STATUS_WITHDRAWN, auditevent.DeletionWithdrawn
+	if request.RequestedBy != userID {
+		resourceType := wardenV1.DeletionResourceType(wardenV1.DeletionResourceType_value[string(request.ResourceType)])
+		if err := s.canDelete(ctx, resourceType, request.ResourceID); err != nil {
+			return nil, err
+		}
+		status, event = deletionrequest.StatusDELETION_REQUEST_STATUS_REJECTED, auditevent.DeletionRejected
+	}
+
+	request, err = s.requestRepo.Decide(ctx, tenantID, req.Id, status, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, event, request)
+
+	s.log.Infof("Deletion request closed: id=%d status=%s user=%s", request.ID, status, userID)
+
+	return &wardenV1.RejectDeletionResponse{
+		DeletionRequest: s.requestRepo.ToProto(request),
+	}, nil
+}
+
+// getRequest returns a deletion request, failing when it does not exist
+func (s *DeletionRequestService) getRequest(ctx context.Context, tenantID, id uint32) (*ent.DeletionRequest, error) {
+	request, err := s.requestRepo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, wardenV1.ErrorNotFound("deletion request not found")
+	}
+	return request, nil
+}
+
+// canDelete checks that the caller may delete the resource, which only
+// owners can
+func (s *DeletionRequestService) canDelete(ctx context.Context, resourceType wardenV1.DeletionResourceType, resourceID string) error {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	switch resourceType {
+	case wardenV1.DeletionResourceType_DELETION_RESOURCE_TYPE_SECRET:
+		if err := s.checker.CanDeleteSecret(ctx, tenantID, userID, resourceID); err != nil {
+			return wardenV1.ErrorAccessDenied("no permission to delete this secret")
+		}
+	case wardenV1.DeletionResourceType_DELETION_RESOURCE_TYPE_FOLDER:
+		if err := s.checker.CanDeleteFolder(ctx, tenantID, userID, resourceID); err != nil {
+			return wardenV1.ErrorAccessDenied("no permission to delete this folder")
+		}
+	default:
+		return wardenV1.ErrorBadRequest("unknown resource type")
+	}
+	return nil
+}
+
+// record audits a deletion request event on the resource it is for
+func (s *DeletionRequestService) record(ctx context.Context, event string, request *ent.DeletionRequest) {
+	resourceType := auditevent.ResourceSecret
+	if request.ResourceType == deletionrequest.ResourceTypeDELETION_RESOURCE_TYPE_FOLDER {
+		resourceType = auditevent.ResourceFolder
+	}
+	auditevent.Record(ctx, event, resourceType, request.ResourceID,
+		"deletion_request_id", strconv.FormatUint(uint64(request.ID), 10),
+		"requested_by", request.RequestedBy)
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+type EmergencyAccessService struct {
+	wardenV1.UnimplementedWardenEmergencyAccessServiceServer
+
+	log        *log.Helper
+	accessRepo *data.EmergencyAccessRepo
+	folderRepo *data.FolderRepo
+	checker    *authz.Checker
+}
+
+func NewEmergencyAccessService(
+	ctx *bootstrap.Context,
+	accessRepo *data.EmergencyAccessRepo,
+	folderRepo *data.FolderRepo,
+	checker *authz.Checker,
+) *EmergencyAccessService {
+	return &EmergencyAccessService{
+		log:        ctx.NewLoggerHelper("warden/service/emergency-access"),
+		accessRepo: accessRepo,
+		folderRepo: folderRepo,
+		checker:    checker,
+	}
+}
+
+// CreateEmergencyAccess designates a trusted contact for a folder the caller owns
+func (s *EmergencyAccessService) CreateEmergencyAccess(ctx context.Context, req *wardenV1.CreateEmergencyAccessRequest) (*wardenV1.CreateEmergencyAccessResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if req.GranteeId == userID {
+		return nil, wardenV1.ErrorBadRequest("cannot designate yourself as emergency contact")
+	}
+
+	folder, err := s.folderRepo.GetByID(ctx, tenantID, req.FolderId)
+	if err != nil {
+		return nil, err
+	}
+	if folder == nil {
+		return nil, wardenV1.ErrorFolderNotFound("folder not found")
+	}
+	if _, relation := s.checker.GetEffectivePermissions(ctx, tenantID, userID, authz.ResourceTypeFolder, req.FolderId); relation != authz.RelationOwner {
+		return nil, wardenV1.ErrorAccessDenied("only the folder owner can designate emergency contacts")
+	}
+
+	access, err := s.accessRepo.Create(ctx, tenantID, userID, req.GranteeId, req.FolderId, int32(req.WaitDays))
+	if err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.EmergencyAccessCreated, auditevent.ResourceFolder, req.FolderId,
+		"grantee_id", req.GranteeId, "wait_days", strconv.FormatUint(uint64(req.WaitDays), 10))
+
+	s.log.Infof("Emergency access created: id=%d folder=%s grantor=%s grantee=%s wait=%dd",
+		access.ID, req.FolderId, userID, req.GranteeId, req.WaitDays)
+
+	return &wardenV1.CreateEmergencyAccessResponse{
+		EmergencyAccess: s.accessRepo.ToProto(access),
+	}, nil
+}
+
+// ListEmergencyAccess lists the caller's trusted contacts and the accesses
+// where the caller is a contact
+func (s *EmergencyAccessService) ListEmergencyAccess(ctx context.Context, _ *wardenV1.ListEmergencyAccessRequest) (*wardenV1.ListEmergencyAccessResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	granted, err := s.accessRepo.ListByGrantor(ctx, tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	trusted, err := s.accessRepo.ListByGrantee(ctx, tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.ListEmergencyAccessResponse{
+		Granted: make([]*wardenV1.EmergencyAccess, 0, len(granted)),
+		Trusted: make([]*wardenV1.EmergencyAccess, 0, len(trusted)),
+	}
+	for _, a := range granted {
+		resp.Granted = append(resp.Granted, s.accessRepo.ToProto(a))
+	}
+	for _, a := range trusted {
+		resp.Trusted = append(resp.Trusted, s.accessRepo.ToProto(a))
+	}
+	return resp, nil
+}
+
+// RequestEmergencyAccess starts the waiting period as the trusted contact
+func (s *EmergencyAccessService) RequestEmergencyAccess(ctx context.Context, req *wardenV1.RequestEmergencyAccessRequest) (*wardenV1.RequestEmergencyAccessResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	access, err := s.getAccess(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if access.GranteeID != userID {
+		return nil, wardenV1.ErrorAccessDenied("only the emergency contact can request access")
+	}
+
+	access, err = s.accessRepo.Request(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.EmergencyAccessRequested, auditevent.ResourceFolder, access.FolderID,
+		"grantor_id", access.GrantorID)
+
+	s.log.Infof("Emergency access requested: id=%d folder=%s grantee=%s", access.ID, access.FolderID, userID)
+
+	return &wardenV1.RequestEmergencyAccessResponse{
+		EmergencyAccess: s.accessRepo.ToProto(access),
+	}, nil
+}
+
+// RejectEmergencyAccess rejects a pending request as the grantor
+func (s *EmergencyAccessService) RejectEmergencyAccess(ctx context.Context, req *wardenV1.RejectEmergencyAccessRequest) (*wardenV1.RejectEmergencyAccessResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	access, err := s.getAccess(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if access.GrantorID != userID {
+		return nil, wardenV1.ErrorAccessDenied("only the grantor can reject emergency access")
+	}
+
+	access, err = s.accessRepo.Reject(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.EmergencyAccessRejected, auditevent.ResourceFolder, access.FolderID,
+		"grantee_id", access.GranteeID)
+
+	s.log.Infof("Emergency access rejected: id=%d folder=%s grantor=%s", access.ID, access.FolderID, userID)
+
+	return &wardenV1.RejectEmergencyAccessResponse{
+		EmergencyAccess: s.accessRepo.ToProto(access),
+	}, nil
+}
+
+// ApproveEmergencyAccess grants a pending request before the waiting period ends
+func (s *EmergencyAccessService) ApproveEmergencyAccess(ctx context.Context, req *wardenV1.ApproveEmergencyAccessRequest) (*wardenV1.ApproveEmergencyAccessResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	access, err := s.getAccess(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if access.GrantorID != userID {
+		return nil, wardenV1.ErrorAccessDenied("only the grantor can approve emergency access")
+	}
+
+	access, err = s.accessRepo.Grant(ctx, access)
+	if err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.EmergencyAccessGranted, auditevent.ResourceFolder, access.FolderID,
+		"grantee_id", access.GranteeID, "approved_by", userID)
+
+	s.log.Infof("Emergency access approved: id=%d folder=%s grantee=%s", access.ID, access.FolderID, access.GranteeID)
+
+	return &wardenV1.ApproveEmergencyAccessResponse{
+		EmergencyAccess: s.accessRepo.ToProto(access),
+	}, nil
+}
+
+// DeleteEmergencyAccess removes a trusted contact and revokes access already granted
+func (s *EmergencyAccessService) DeleteEmergencyAccess(ctx context.Context, req *wardenV1.DeleteEmergencyAccessRequest) (*emptypb.Empty, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	access, err := s.getAccess(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if access.GrantorID != userID && access.GranteeID != userID {
+		return nil, wardenV1.ErrorNotFound("emergency access not found")
+	}
+
+	if err := s.accessRepo.Delete(ctx, access); err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.EmergencyAccessDeleted, auditevent.ResourceFolder, access.FolderID,
+		"grantor_id", access.GrantorID, "grantee_id", access.GranteeID, "status", string(access.Status))
+
+	s.log.Infof("Emergency access deleted: id=%d folder=%s user=%s", access.ID, access.FolderID, userID)
+
+	return &emptypb.Empty{}, nil
+}
+
+// getAccess loads an emergency access of the tenant or returns NotFound
+func (s *EmergencyAccessService) getAccess(ctx context.Context, tenantID, id uint32) (*ent.EmergencyAccess, error) {
+	access, err := s.accessRepo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if access == nil {
+		return nil, wardenV1.ErrorNotFound("emergency access not found")
+	}
+	return access, nil
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// ExportPolicyService manages the per-tenant rules for secrets that must never be exported
+type ExportPolicyService struct {
+	wardenV1.UnimplementedWardenExportPolicyServiceServer
+
+	log          *log.Helper
+	settingsRepo *data.TenantSettingRepo
+	folderRepo   *data.FolderRepo
+}
+
+// NewExportPolicyService creates a new ExportPolicyService
+func NewExportPolicyService(
+	ctx *bootstrap.Context,
+	settingsRepo *data.TenantSettingRepo,
+	folderRepo *data.FolderRepo,
+) *ExportPolicyService {
+	return &ExportPolicyService{
+		log:          ctx.NewLoggerHelper("warden/service/export-policy"),
+		settingsRepo: settingsRepo,
+		folderRepo:   folderRepo,
+	}
+}
+
+// GetExportPolicy returns the export policy of a tenant
+func (s *ExportPolicyService) GetExportPolicy(ctx context.Context, req *wardenV1.GetExportPolicyRequest) (*wardenV1.ExportPolicy, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil && *req.TenantId != tenantID {
+		if !isPlatformAdmin(ctx) {
+			return nil, wardenV1.ErrorAccessDenied("cannot view export policy of another tenant")
+		}
+		tenantID = *req.TenantId
+	}
+
+	setting, err := s.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toExportPolicyProto(tenantID, setting), nil
+}
+
+// SetExportPolicy replaces the export policy of a tenant
+func (s *ExportPolicyService) SetExportPolicy(ctx context.Context, req *wardenV1.SetExportPolicyRequest) (*wardenV1.ExportPolicy, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can change export policies")
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil {
+		tenantID = *req.TenantId
+	}
+
+	tags := make([]string, 0, len(req.ExcludedTags))
+	for _, tag := range req.ExcludedTags {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !slices.ContainsFunc(tags, func(t string) bool { return strings.EqualFold(t, tag) }) {
+			tags = append(tags, tag)
+		}
+	}
+
+	folderIDs := make([]string, 0, len(req.ExcludedFolderIds))
+	for _, id := range req.ExcludedFolderIds {
+		if slices.Contains(folderIDs, id) {
+			continue
+		}
+		f, err := s.folderRepo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return nil, err
+		}
+		if f == nil {
+			return nil, wardenV1.ErrorFolderNotFound("folder %s not found", id)
+		}
+		folderIDs = append(folderIDs, id)
+	}
+
+	setting, err := s.settingsRepo.SetExportPolicy(ctx, tenantID, tags, folderIDs, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Export policy updated: tenant=%d tags=%v folders=%v", tenantID, tags, folderIDs)
+
+	return toExportPolicyProto(tenantID, setting), nil
+}
+
+func toExportPolicyProto(tenantID uint32, setting *ent.TenantSetting) *wardenV1.ExportPolicy {
+	resp := &wardenV1.ExportPolicy{
+		TenantId:          tenantID,
+		ExcludedTags:      []string{},
+		ExcludedFolderIds: []string{},
+	}
+	if setting != nil {
+		if setting.ExportExcludedTags != nil {
+			resp.ExcludedTags = setting.ExportExcludedTags
+		}
+		if setting.ExportExcludedFolderIds != nil {
+			resp.ExcludedFolderIds = setting.ExportExcludedFolderIds
+		}
+		if setting.UpdateTime != nil {
+			resp.UpdateTime = timestamppb.New(*setting.UpdateTime)
+		}
+	}
+	return resp
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folderchangelog"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// recordChange adds a change to the folder history. The change itself is
+// already made, so a failure is only logged.
+func (s *FolderService) recordChange(ctx context.Context, tenantID uint32, change data.FolderChange) {
+	change.ActorID = getUserIDFromContext(ctx)
+	if err := s.changes.Record(ctx, tenantID, change); err != nil {
+		s.log.Warnf("failed to record %s of folder %s in its history: %v", change.Action, change.FolderID, err)
+	}
+}
+
+// ListFolderHistory lists the changes to the folder structure. Readers of a
+// folder may list its history; the history of deleted folders and of the
+// whole tenant is for platform admins.
+func (s *FolderService) ListFolderHistory(ctx context.Context, req *wardenV1.ListFolderHistoryRequest) (*wardenV1.ListFolderHistoryResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	opts := &data.FolderChangeListOptions{
+		TenantID: tenantID,
+		ActorID:  req.ActorId,
+		Page:     1,
+		PageSize: 20,
+	}
+
+	if req.GetFolderId() != "" {
+		opts.FolderID = req.FolderId
+
+		folder, err := s.folderRepo.GetByID(ctx, tenantID, *req.FolderId)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case folder != nil:
+			if err := s.checker.CanReadFolder(ctx, tenantID, userID, folder.ID); err != nil && !isPlatformAdmin(ctx) {
+				return nil, wardenV1.ErrorAccessDenied("no permission to access this folder")
+			}
+			if req.IncludeSubfolders {
+				opts.PathPrefix = &folder.Path
+			}
+		case !isPlatformAdmin(ctx):
+			return nil, wardenV1.ErrorAccessDenied("only platform admins can list the history of deleted folders")
+		}
+	} else if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("folder_id is required")
+	}
+
+	if req.Action != nil && *req.Action != wardenV1.FolderChangeAction_FOLDER_CHANGE_ACTION_UNSPECIFIED {
+		action := folderchangelog.Action(req.Action.String())
+		opts.Action = &action
+	}
+	if req.StartTime != nil {
+		t := req.StartTime.AsTime()
+		opts.StartTime = &t
+	}
+	if req.EndTime != nil {
+		t := req.EndTime.AsTime()
+		opts.EndTime = &t
+	}
+	if req.Page != nil {
+		opts.Page = *req.Page
+	}
+	if req.PageSize != nil {
+		opts.PageSize = *req.PageSize
+	}
+
+	entities, total, err := s.changes.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.ListFolderHistoryResponse{
+		Changes: make([]*wardenV1.FolderChange, 0, len(entities)),
+		Total:   uint32(total),
+	}
+	for _, e := range entities {
+		resp.Changes = append(resp.Changes, s.changes.ToProto(e))
+	}
+	return resp, nil
+}
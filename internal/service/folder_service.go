@@ -2,13 +2,19 @@ package service
 
 import (
 	"context"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
 	"github.com/go-tangra/go-tangra-warden/internal/authz"
 	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folderchangelog"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-warden/internal/metrics"
 	"github.com/go-tangra/go-tangra-warden/pkg/vault"
 
@@ -26,6 +32,10 @@ type FolderService struct {
 	kvStore     *vault.KVStore
 	checker     *authz.Checker
 	metrics     *metrics.Collector
+	usageRepo   *data.SecretUsageRepo
+	settings    *data.TenantSettingRepo
+	changes     *data.FolderChangeLogRepo
+	tx          *data.Transactor
 }
 
 func NewFolderService(
@@ -37,6 +47,10 @@ func NewFolderService(
 	kvStore *vault.KVStore,
 	checker *authz.Checker,
 	metrics *metrics.Collector,
+	usageRepo *data.SecretUsageRepo,
+	settings *data.TenantSettingRepo,
+	changes *data.FolderChangeLogRepo,
+	tx *data.Transactor,
 ) *FolderService {
 	return &FolderService{
 		log:         ctx.NewLoggerHelper("warden/service/folder"),
@@ -47,6 +61,10 @@ func NewFolderService(
 		kvStore:     kvStore,
 		checker:     checker,
 		metrics:     metrics,
+		usageRepo:   usageRepo,
+		settings:    settings,
+		changes:     changes,
+		tx:          tx,
 	}
 }
 
@@ -100,6 +118,15 @@ func (s *FolderService) CreateFolder(ctx context.Context, req *wardenV1.CreateFo
 
 	s.metrics.FolderCreated()
 
+	s.recordChange(ctx, tenantID, data.FolderChange{
+		FolderID:    folder.ID,
+		Action:      folderchangelog.ActionFOLDER_CHANGE_ACTION_CREATED,
+		NewPath:     folder.Path,
+		NewParentID: folder.ParentID,
+	})
+
+	auditevent.Record(ctx, auditevent.FolderCreated, auditevent.ResourceFolder, folder.ID, "path", folder.Path)
+
 	s.log.Infof("Folder created: id=%s parent=%v user=%s", folder.ID, req.ParentId, userID)
 
 	return &wardenV1.CreateFolderResponse{
@@ -117,7 +144,7 @@ func (s *FolderService) GetFolder(ctx context.Context, req *wardenV1.GetFolderRe
 		return nil, wardenV1.ErrorAccessDenied("no permission to access this folder")
 	}
 
-	folder, err := s.folderRepo.GetByIDAndTenant(ctx, tenantID, req.Id)
+	folder, err := s.folderRepo.GetByID(ctx, tenantID, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -125,14 +152,9 @@ func (s *FolderService) GetFolder(ctx context.Context, req *wardenV1.GetFolderRe
 		return nil, wardenV1.ErrorFolderNotFound("folder not found")
 	}
 
-	var folderProto *wardenV1.Folder
+	folderProto := s.folderRepo.ToProto(folder)
 	if req.IncludeCounts {
-		folderProto, err = s.folderRepo.ToProtoWithCounts(ctx, tenantID, folder)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		folderProto = s.folderRepo.ToProto(folder)
+		folderProto = s.folderRepo.ToProtoWithCounts(folder)
 	}
 
 	return &wardenV1.GetFolderResponse{
@@ -145,6 +167,10 @@ func (s *FolderService) ListFolders(ctx context.Context, req *wardenV1.ListFolde
 	tenantID := getTenantIDFromContext(ctx)
 	userID := getUserIDFromContext(ctx)
 
+	if req.Deleted {
+		return s.listTrashedFolders(ctx, req)
+	}
+
 	// If parent is specified, check permission on parent
 	if req.ParentId != nil && *req.ParentId != "" {
 		if err := s.checker.CanReadFolder(ctx, tenantID, userID, *req.ParentId); err != nil {
@@ -161,24 +187,42 @@ func (s *FolderService) ListFolders(ctx context.Context, req *wardenV1.ListFolde
 		pageSize = *req.PageSize
 	}
 
-	folders, total, err := s.folderRepo.List(ctx, tenantID, req.ParentId, req.NameFilter, page, pageSize)
+	after, err := data.DecodeCursor(req.GetCursor())
 	if err != nil {
 		return nil, err
 	}
 
+	order := listSort(req.GetSortBy(), req.GetSortOrder())
+
+	projection := listProjection(req.IdsOnly, req.CountOnly)
+	folders, total, err := s.folderRepo.List(ctx, tenantID, req.ParentId, req.NameFilter, order, after, page, pageSize, projection)
+	if err != nil {
+		return nil, err
+	}
+	if projection == data.ProjectCount {
+		return &wardenV1.ListFoldersResponse{Total: uint32(total)}, nil
+	}
+
 	// Filter folders by permission. Total comes from the repo so the pager
 	// stays accurate; permission-inaccessible rows just drop off the page.
-	accessibleFolders := make([]*wardenV1.Folder, 0, len(folders))
+	resp := &wardenV1.ListFoldersResponse{
+		Total: uint32(total),
+	}
 	for _, folder := range folders {
-		if err := s.checker.CanReadFolder(ctx, tenantID, userID, folder.ID); err == nil {
-			accessibleFolders = append(accessibleFolders, s.folderRepo.ToProto(folder))
+		if err := s.checker.CanReadFolder(ctx, tenantID, userID, folder.ID); err != nil {
+			continue
+		}
+		if projection == data.ProjectIDs {
+			resp.Ids = append(resp.Ids, folder.ID)
+		} else {
+			resp.Folders = append(resp.Folders, s.folderRepo.ToProto(folder))
 		}
 	}
 
-	return &wardenV1.ListFoldersResponse{
-		Folders: accessibleFolders,
-		Total:   uint32(total),
-	}, nil
+	if hasNextPage(len(folders), after != nil, page, pageSize, total) {
+		resp.NextCursor = data.FolderCursor(folders[len(folders)-1], order)
+	}
+	return resp, nil
 }
 
 // UpdateFolder updates folder metadata
@@ -191,11 +235,54 @@ func (s *FolderService) UpdateFolder(ctx context.Context, req *wardenV1.UpdateFo
 		return nil, wardenV1.ErrorAccessDenied("no permission to modify this folder")
 	}
 
-	folder, err := s.folderRepo.Update(ctx, tenantID, req.Id, req.Name, req.Description)
+	// Lifting protection would let a single owner delete the folder after all
+	if req.Protected != nil {
+		if *req.Protected {
+			if _, relation := s.checker.GetEffectivePermissions(ctx, tenantID, userID, authz.ResourceTypeFolder, req.Id); relation != authz.RelationOwner {
+				return nil, wardenV1.ErrorAccessDenied("only owners can protect a folder")
+			}
+		} else if !isPlatformAdmin(ctx) {
+			return nil, wardenV1.ErrorAccessDenied("only platform admins can lift the protection of a folder")
+		}
+	}
+
+	var oldPath string
+	if req.Name != nil {
+		existing, err := s.folderRepo.GetByID(ctx, tenantID, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			oldPath = existing.Path
+		}
+	}
+
+	folder, err := s.folderRepo.Update(ctx, tenantID, req.Id, req.Name, req.Description, req.ExpectedRevision)
 	if err != nil {
 		return nil, err
 	}
 
+	if oldPath != "" && oldPath != folder.Path {
+		s.recordChange(ctx, tenantID, data.FolderChange{
+			FolderID:    folder.ID,
+			Action:      folderchangelog.ActionFOLDER_CHANGE_ACTION_RENAMED,
+			OldPath:     oldPath,
+			NewPath:     folder.Path,
+			OldParentID: folder.ParentID,
+			NewParentID: folder.ParentID,
+		})
+	}
+
+	auditAttrs := []string{"path", folder.Path}
+	if req.Protected != nil {
+		if folder, err = s.folderRepo.SetProtected(ctx, tenantID, req.Id, *req.Protected); err != nil {
+			return nil, err
+		}
+		auditAttrs = append(auditAttrs, "protected", strconv.FormatBool(*req.Protected))
+	}
+
+	auditevent.Record(ctx, auditevent.FolderUpdated, auditevent.ResourceFolder, req.Id, auditAttrs...)
+
 	s.log.Infof("Folder updated: id=%s user=%s", req.Id, userID)
 
 	return &wardenV1.UpdateFolderResponse{
@@ -203,7 +290,317 @@ func (s *FolderService) UpdateFolder(ctx context.Context, req *wardenV1.UpdateFo
 	}, nil
 }
 
-// DeleteFolder deletes a folder
+// SetFolderMetadataSchema sets or removes the JSON Schema that the metadata of
+// secrets in the folder must satisfy. Only owners may change it.
+func (s *FolderService) SetFolderMetadataSchema(ctx context.Context, req *wardenV1.SetFolderMetadataSchemaRequest) (*wardenV1.SetFolderMetadataSchemaResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if _, relation := s.checker.GetEffectivePermissions(ctx, tenantID, userID, authz.ResourceTypeFolder, req.Id); relation != authz.RelationOwner {
+		return nil, wardenV1.ErrorAccessDenied("only folder owners can set the metadata schema")
+	}
+
+	var schema map[string]any
+	if req.Schema != nil && len(req.Schema.Fields) > 0 {
+		schema = req.Schema.AsMap()
+		if _, err := data.ParseMetadataSchema(schema); err != nil {
+			return nil, wardenV1.ErrorBadRequest("invalid metadata schema: %s", err.Error())
+		}
+	}
+
+	folder, err := s.folderRepo.SetMetadataSchema(ctx, tenantID, req.Id, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.FolderUpdated, auditevent.ResourceFolder, req.Id,
+		"path", folder.Path, "metadata_schema", strconv.FormatBool(schema != nil))
+
+	s.log.Infof("Folder metadata schema set: id=%s enabled=%t user=%s", req.Id, schema != nil, userID)
+
+	return &wardenV1.SetFolderMetadataSchemaResponse{
+		Folder: s.folderRepo.ToProto(folder),
+	}, nil
+}
+
+// SetFolderDefaultPermissions replaces the permissions granted on every secret
+// created in the folder. Only owners may change them; existing secrets keep
+// their permissions.
+func (s *FolderService) SetFolderDefaultPermissions(ctx context.Context, req *wardenV1.SetFolderDefaultPermissionsRequest) (*wardenV1.SetFolderDefaultPermissionsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if _, relation := s.checker.GetEffectivePermissions(ctx, tenantID, userID, authz.ResourceTypeFolder, req.Id); relation != authz.RelationOwner {
+		return nil, wardenV1.ErrorAccessDenied("only folder owners can set default permissions")
+	}
+
+	rules := make([]schema.FolderDefaultPermission, 0, len(req.Rules))
+	seen := make(map[schema.FolderDefaultPermission]bool, len(req.Rules))
+	for _, r := range req.Rules {
+		if r.SubjectId == "" {
+			return nil, wardenV1.ErrorBadRequest("default permission subject ID is required")
+		}
+		subjectType := mapProtoSubjectTypeToAuthz(r.SubjectType)
+		if subjectType == "" {
+			return nil, wardenV1.ErrorBadRequest("invalid default permission subject type")
+		}
+		relation := mapProtoRelationToAuthz(r.Relation)
+		if relation == "" {
+			return nil, wardenV1.ErrorBadRequest("invalid default permission relation")
+		}
+		rule := schema.FolderDefaultPermission{
+			SubjectType: string(subjectType),
+			SubjectID:   r.SubjectId,
+			Relation:    string(relation),
+		}
+		if seen[rule] {
+			continue
+		}
+		seen[rule] = true
+		rules = append(rules, rule)
+	}
+
+	folder, err := s.folderRepo.SetDefaultPermissions(ctx, tenantID, req.Id, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.FolderUpdated, auditevent.ResourceFolder, req.Id,
+		"path", folder.Path, "default_permissions", strconv.Itoa(len(rules)))
+
+	s.log.Infof("Folder default permissions set: id=%s rules=%d user=%s", req.Id, len(rules), userID)
+
+	return &wardenV1.SetFolderDefaultPermissionsResponse{
+		Folder: s.folderRepo.ToProto(folder),
+	}, nil
+}
+
+// SetFolderAccessPolicy sets the network and time restrictions on access to
+// a folder and everything in it. Only owners can change them, and they can
+// always do so, so a mistaken policy cannot lock a folder.
+func (s *FolderService) SetFolderAccessPolicy(ctx context.Context, req *wardenV1.SetFolderAccessPolicyRequest) (*wardenV1.SetFolderAccessPolicyResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if _, relation := s.checker.GetEffectivePermissions(ctx, tenantID, userID, authz.ResourceTypeFolder, req.Id); relation != authz.RelationOwner {
+		return nil, wardenV1.ErrorAccessDenied("only folder owners can set the access policy")
+	}
+
+	policy := data.AccessPolicyFromProto(req.Policy)
+	if policy != nil {
+		if err := policy.Validate(); err != nil {
+			return nil, wardenV1.ErrorBadRequest("invalid access policy: %s", err.Error())
+		}
+	}
+
+	folder, err := s.folderRepo.SetAccessPolicy(ctx, tenantID, req.Id, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.FolderAccessPolicyChanged, auditevent.ResourceFolder, req.Id,
+		"path", folder.Path, "cleared", strconv.FormatBool(policy.IsEmpty()))
+
+	s.log.Infof("Folder access policy set: id=%s cleared=%t user=%s", req.Id, policy.IsEmpty(), userID)
+
+	return &wardenV1.SetFolderAccessPolicyResponse{
+		Folder: s.folderRepo.ToProto(folder),
+	}, nil
+}
+
+// SetFolderLegalHold places or lifts a legal hold on a folder. While held,
+// nothing in the folder can be permanently deleted, destroyed in Vault or
+// moved out, and Vault keeps every version of its secrets: the tenant's
+// version retention is lifted from them and restored with the hold. Secrets
+// whose Vault metadata fails are reported.
+func (s *FolderService) SetFolderLegalHold(ctx context.Context, req *wardenV1.SetFolderLegalHoldRequest) (*wardenV1.SetFolderLegalHoldResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can place or lift legal holds")
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	folder, err := s.folderRepo.SetLegalHold(ctx, tenantID, req.Id, req.LegalHold, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	event := auditevent.FolderLegalHoldPlaced
+	if !req.LegalHold {
+		event = auditevent.FolderLegalHoldLifted
+	}
+	auditevent.Record(ctx, event, auditevent.ResourceFolder, req.Id, "path", folder.Path, "reason", req.Reason)
+
+	s.log.Infof("Folder legal hold set: id=%s legal_hold=%t user=%s", req.Id, req.LegalHold, userID)
+
+	resp := &wardenV1.SetFolderLegalHoldResponse{
+		Folder:          s.folderRepo.ToProto(folder),
+		FailedSecretIds: []string{},
+	}
+
+	retention := &data.VersionRetention{}
+	skip := map[string]bool{}
+	if !req.LegalHold {
+		// Secrets still covered by another hold keep all their versions
+		held, err := s.folderRepo.IsUnderLegalHold(ctx, tenantID, req.Id)
+		if err != nil || held {
+			return resp, err
+		}
+		heldBelow, err := s.folderRepo.ListHeldFolderIDs(ctx, tenantID, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range heldBelow {
+			skip[id] = true
+		}
+		if retention, err = s.settings.GetVersionRetention(ctx, tenantID); err != nil {
+			return nil, err
+		}
+	}
+
+	secrets, err := s.secretRepo.ListAllInFolderTree(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, sec := range secrets {
+		if sec.FolderID != nil && skip[*sec.FolderID] {
+			continue
+		}
+		if err := s.kvStore.PutMetadata(ctx, sec.VaultPath, retention.MaxVersions, retention.DeleteAfter()); err != nil {
+			s.log.Errorf("Failed to update version retention of secret %s: %v", sec.ID, err)
+			resp.FailedSecretIds = append(resp.FailedSecretIds, sec.ID)
+			continue
+		}
+		resp.SecretsUpdated++
+	}
+
+	return resp, nil
+}
+
+// retainMovedVersions lifts the version retention of the secrets of a folder
+// moved into a folder under legal hold
+func (s *FolderService) retainMovedVersions(ctx context.Context, tenantID uint32, id string, newParentID *string) error {
+	if newParentID == nil || *newParentID == "" {
+		return nil
+	}
+	held, err := s.folderRepo.IsUnderLegalHold(ctx, tenantID, *newParentID)
+	if err != nil || !held {
+		return err
+	}
+	secrets, err := s.secretRepo.ListAllInFolderTree(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	for _, sec := range secrets {
+		if err := s.kvStore.PutMetadata(ctx, sec.VaultPath, 0, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFolderUsage returns the read and write counts of the secrets in a
+// folder, least used first, so owners can spot credentials nobody uses
+func (s *FolderService) GetFolderUsage(ctx context.Context, req *wardenV1.GetFolderUsageRequest) (*wardenV1.GetFolderUsageResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadFolder(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to access this folder")
+	}
+
+	secrets, err := s.secretRepo.ListAllInFolderTree(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if secrets == nil {
+		return nil, wardenV1.ErrorFolderNotFound("folder not found")
+	}
+	if !req.IncludeSubfolders {
+		direct := secrets[:0]
+		for _, sec := range secrets {
+			if sec.FolderID != nil && *sec.FolderID == req.Id {
+				direct = append(direct, sec)
+			}
+		}
+		secrets = direct
+	}
+
+	days := defaultUsageDays
+	if req.Days != nil {
+		days = int(*req.Days)
+	}
+
+	ids := make([]string, len(secrets))
+	for i, sec := range secrets {
+		ids[i] = sec.ID
+	}
+	usage, err := s.usageRepo.GetUsageBySecret(ctx, tenantID, ids, days)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.GetFolderUsageResponse{
+		Secrets: make([]*wardenV1.SecretUsage, 0, len(secrets)),
+	}
+	for _, sec := range secrets {
+		u := usage[sec.ID]
+		resp.Reads += u.Reads
+		resp.Writes += u.Writes
+		if u.Reads+u.Writes == 0 {
+			resp.UnusedCount++
+		}
+		resp.Secrets = append(resp.Secrets, s.usageRepo.ToProto(u, sec))
+	}
+	sort.SliceStable(resp.Secrets, func(i, j int) bool {
+		a, b := resp.Secrets[i], resp.Secrets[j]
+		return a.Reads+a.Writes < b.Reads+b.Writes
+	})
+
+	return resp, nil
+}
+
+// enforceMetadataSchema rejects metadata that breaks the schema of the folder
+// it is stored in. Root-level secrets and folders without a schema accept any
+// metadata.
+func enforceMetadataSchema(ctx context.Context, folderRepo *data.FolderRepo, tenantID uint32, folderID *string, metadata map[string]any) error {
+	if folderID == nil || *folderID == "" {
+		return nil
+	}
+	folder, err := folderRepo.GetByID(ctx, tenantID, *folderID)
+	if err != nil {
+		return err
+	}
+	if folder == nil || folder.MetadataSchema == nil {
+		return nil
+	}
+
+	schema, err := data.ParseMetadataSchema(folder.MetadataSchema)
+	if err != nil {
+		// Stored schemas were checked when set; do not block writes on a bad one
+		return nil
+	}
+	violations := schema.Validate(metadata)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(violations))
+	details := make(map[string]string, len(violations))
+	for _, v := range violations {
+		path := v.Path
+		if path == "" {
+			path = "/"
+		}
+		messages = append(messages, path+" "+v.Message)
+		details[path] = v.Message
+	}
+	return wardenV1.ErrorMetadataSchemaViolation("metadata does not match the folder schema: %s", strings.Join(messages, "; ")).
+		WithMetadata(details)
+}
+
+// DeleteFolder moves a folder to the trash, or deletes it permanently
 func (s *FolderService) DeleteFolder(ctx context.Context, req *wardenV1.DeleteFolderRequest) (*emptypb.Empty, error) {
 	tenantID := getTenantIDFromContext(ctx)
 	userID := getUserIDFromContext(ctx)
@@ -213,12 +610,69 @@ func (s *FolderService) DeleteFolder(ctx context.Context, req *wardenV1.DeleteFo
 		return nil, wardenV1.ErrorAccessDenied("no permission to delete this folder")
 	}
 
+	if err := s.checkDeleteHold(ctx, tenantID, req.Id, req.Force); err != nil {
+		return nil, err
+	}
+
+	if !req.Permanent {
+		if err := s.trashFolder(ctx, req.Id, req.Force); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	protected, err := s.isProtected(ctx, tenantID, req.Id, req.Force)
+	if err != nil {
+		return nil, err
+	}
+	if protected {
+		return nil, wardenV1.ErrorDeletionApprovalRequired("folder is protected, request its deletion for a second owner to approve")
+	}
+
+	if err := s.deleteFolder(ctx, req.Id, req.Force); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// isProtected reports whether deleting a folder needs a second owner's
+// approval: when it or a folder above it is protected, or, for a forced
+// delete, anything in it is
+func (s *FolderService) isProtected(ctx context.Context, tenantID uint32, id string, force bool) (bool, error) {
+	protected, err := s.folderRepo.IsProtected(ctx, tenantID, id)
+	if err != nil || protected || !force {
+		return protected, err
+	}
+	return s.folderRepo.HasProtectedContent(ctx, tenantID, id)
+}
+
+// checkDeleteHold fails with LEGAL_HOLD_ACTIVE when a folder or one above it
+// is under legal hold, or, for a forced delete, a folder in it is
+func (s *FolderService) checkDeleteHold(ctx context.Context, tenantID uint32, id string, force bool) error {
+	if err := checkLegalHold(ctx, s.folderRepo, tenantID, &id); err != nil || !force {
+		return err
+	}
+	held, err := s.folderRepo.ListHeldFolderIDs(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	if len(held) > 0 {
+		return wardenV1.ErrorLegalHoldActive("a folder in this folder is under legal hold")
+	}
+	return nil
+}
+
+// deleteFolder deletes a folder once the caller has been authorized
+func (s *FolderService) deleteFolder(ctx context.Context, id string, force bool) error {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
 	// When force-deleting, clean up Vault data, permissions, and version records
 	// for all secrets and descendant folders before the repo cascade-deletes DB records.
-	if req.Force {
-		secrets, err := s.secretRepo.ListAllInFolderTree(ctx, tenantID, req.Id)
+	if force {
+		secrets, err := s.secretRepo.ListAllInFolderTree(ctx, tenantID, id)
 		if err != nil {
-			s.log.Warnf("Failed to list secrets for cleanup in folder %s: %v", req.Id, err)
+			s.log.Warnf("Failed to list secrets for cleanup in folder %s: %v", id, err)
 		} else {
 			for _, sec := range secrets {
 				// Q3: Clean up permissions for each secret
@@ -237,9 +691,9 @@ func (s *FolderService) DeleteFolder(ctx context.Context, req *wardenV1.DeleteFo
 		}
 
 		// Q4: Clean up permissions for descendant folders
-		descendantFolders, err := s.folderRepo.ListDescendantIDs(ctx, tenantID, req.Id)
+		descendantFolders, err := s.folderRepo.ListDescendantIDs(ctx, tenantID, id)
 		if err != nil {
-			s.log.Warnf("Failed to list descendant folders for permission cleanup in folder %s: %v", req.Id, err)
+			s.log.Warnf("Failed to list descendant folders for permission cleanup in folder %s: %v", id, err)
 		} else {
 			for _, folderID := range descendantFolders {
 				if err := s.permRepo.DeleteByResource(ctx, tenantID, string(authz.ResourceTypeFolder), folderID); err != nil {
@@ -249,20 +703,37 @@ func (s *FolderService) DeleteFolder(ctx context.Context, req *wardenV1.DeleteFo
 		}
 	}
 
-	if err := s.folderRepo.Delete(ctx, tenantID, req.Id, req.Force); err != nil {
-		return nil, err
+	existing, err := s.folderRepo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.folderRepo.Delete(ctx, tenantID, id, force); err != nil {
+		return err
+	}
+
+	if existing != nil {
+		s.recordChange(ctx, tenantID, data.FolderChange{
+			FolderID:    id,
+			Action:      folderchangelog.ActionFOLDER_CHANGE_ACTION_DELETED,
+			OldPath:     existing.Path,
+			OldParentID: existing.ParentID,
+			Details:     map[string]string{"force": strconv.FormatBool(force)},
+		})
 	}
 
 	// Delete permissions for the root folder itself
-	if err := s.permRepo.DeleteByResource(ctx, tenantID, string(authz.ResourceTypeFolder), req.Id); err != nil {
-		s.log.Warnf("Failed to delete permissions for folder %s: %v", req.Id, err)
+	if err := s.permRepo.DeleteByResource(ctx, tenantID, string(authz.ResourceTypeFolder), id); err != nil {
+		s.log.Warnf("Failed to delete permissions for folder %s: %v", id, err)
 	}
 
 	s.metrics.FolderDeleted()
 
-	s.log.Infof("Folder deleted: id=%s force=%v user=%s", req.Id, req.Force, userID)
+	auditevent.Record(ctx, auditevent.FolderDeleted, auditevent.ResourceFolder, id, "force", strconv.FormatBool(force))
 
-	return &emptypb.Empty{}, nil
+	s.log.Infof("Folder deleted: id=%s force=%v user=%s", id, force, userID)
+
+	return nil
 }
 
 // MoveFolder moves a folder to a new parent
@@ -282,11 +753,44 @@ func (s *FolderService) MoveFolder(ctx context.Context, req *wardenV1.MoveFolder
 		}
 	}
 
+	// A hold travels with the folder it is placed on, but moving a folder out
+	// of a held one would release it
+	parentID, err := s.folderRepo.GetFolderParentID(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLegalHold(ctx, s.folderRepo, tenantID, parentID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.folderRepo.GetByID(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	folder, err := s.folderRepo.Move(ctx, tenantID, req.Id, req.NewParentId)
 	if err != nil {
 		return nil, err
 	}
 
+	if existing != nil {
+		s.recordChange(ctx, tenantID, data.FolderChange{
+			FolderID:    folder.ID,
+			Action:      folderchangelog.ActionFOLDER_CHANGE_ACTION_MOVED,
+			OldPath:     existing.Path,
+			NewPath:     folder.Path,
+			OldParentID: parentID,
+			NewParentID: folder.ParentID,
+		})
+	}
+
+	if err := s.retainMovedVersions(ctx, tenantID, req.Id, req.NewParentId); err != nil {
+		s.log.Warnf("Failed to lift version retention of secrets moved under legal hold in folder %s: %v", req.Id, err)
+	}
+
+	auditevent.Record(ctx, auditevent.FolderMoved, auditevent.ResourceFolder, req.Id,
+		"parent_id", derefString(req.NewParentId), "path", folder.Path)
+
 	s.log.Infof("Folder moved: id=%s newParent=%v user=%s", req.Id, req.NewParentId, userID)
 
 	return &wardenV1.MoveFolderResponse{
@@ -328,11 +832,25 @@ func (s *FolderService) GetFolderTree(ctx context.Context, req *wardenV1.GetFold
 		accessibleSet[id] = true
 	}
 
+	// Folders holding secrets shared with the user through a collection are
+	// shown too, without access to the rest of the folder
+	var sharedIDs []string
+	collectionIDs, err := s.checker.ListAccessibleCollections(ctx, tenantID, userID)
+	if err != nil {
+		s.log.Warnf("failed to list accessible collections: %v", err)
+	} else if sharedIDs, err = s.secretRepo.ListFolderIDsInCollections(ctx, tenantID, collectionIDs); err != nil {
+		return nil, err
+	}
+	sharedSet := make(map[string]bool, len(sharedIDs))
+	for _, id := range sharedIDs {
+		sharedSet[id] = true
+	}
+
 	// Prune tree: only show folders the user can access.
 	// Zanzibar hierarchy means children of accessible folders are also accessible.
 	// Structural parent nodes are kept (with hidden secret counts) when needed
-	// to show the path to accessible descendants.
-	roots = pruneTreeByAccess(roots, accessibleSet, false)
+	// to show the path to accessible descendants or shared secrets.
+	roots = pruneTreeByAccess(roots, accessibleSet, sharedSet, false)
 
 	return &wardenV1.GetFolderTreeResponse{
 		Roots: roots,
@@ -343,18 +861,19 @@ func (s *FolderService) GetFolderTree(ctx context.Context, req *wardenV1.GetFold
 // A folder is accessible if it has a direct permission tuple OR its parent is accessible
 // (Zanzibar hierarchy: parent folder access implies child folder access).
 // Folders with no access are kept as structural nodes only if they have accessible
-// descendants, with their secret counts hidden.
-func pruneTreeByAccess(nodes []*wardenV1.FolderTreeNode, accessibleIDs map[string]bool, parentAccessible bool) []*wardenV1.FolderTreeNode {
+// descendants or hold secrets of sharedIDs, with their secret counts hidden.
+func pruneTreeByAccess(nodes []*wardenV1.FolderTreeNode, accessibleIDs, sharedIDs map[string]bool, parentAccessible bool) []*wardenV1.FolderTreeNode {
 	result := make([]*wardenV1.FolderTreeNode, 0, len(nodes))
 	for _, node := range nodes {
 		isDirectlyAccessible := accessibleIDs[node.Folder.Id]
 		isAccessible := isDirectlyAccessible || parentAccessible
 
 		// Recursively prune children, inheriting accessibility
-		node.Children = pruneTreeByAccess(node.Children, accessibleIDs, isAccessible)
+		node.Children = pruneTreeByAccess(node.Children, accessibleIDs, sharedIDs, isAccessible)
 
-		// Keep node if accessible or needed as structural path to accessible descendants
-		if isAccessible || len(node.Children) > 0 {
+		// Keep node if accessible, holding shared secrets or needed as
+		// structural path to accessible descendants
+		if isAccessible || sharedIDs[node.Folder.Id] || len(node.Children) > 0 {
 			// Update subfolder count to reflect only visible children
 			node.Folder.SubfolderCount = int32(len(node.Children))
 
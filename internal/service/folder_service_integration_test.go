@@ -0,0 +1,65 @@
+//go:build integration
+
+package service_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	wardentesting "github.com/go-tangra/go-tangra-warden/internal/testing"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+func TestFolderMoveRewritesSubtreePaths(t *testing.T) {
+	env := wardentesting.NewEnv(t, wardentesting.WithMemoryVault())
+	tenant := env.Tenant()
+	alice := tenant.User("alice")
+
+	infra := env.CreateFolder(t, alice, nil, "infra")
+	prod := env.CreateFolder(t, alice, &infra.Id, "prod")
+	db := env.CreateFolder(t, alice, &prod.Id, "db")
+	env.CreateSecret(t, alice, &db.Id, "primary", "s3cret-password")
+	platform := env.CreateFolder(t, alice, nil, "platform")
+
+	resp, err := env.FolderService.MoveFolder(alice.Context(), &wardenV1.MoveFolderRequest{
+		Id:          prod.Id,
+		NewParentId: &platform.Id,
+	})
+	if err != nil {
+		t.Fatalf("move folder: %v", err)
+	}
+	if resp.Folder.Path != "/platform/prod" || resp.Folder.Depth != 1 {
+		t.Fatalf("moved folder is at %q, depth %d", resp.Folder.Path, resp.Folder.Depth)
+	}
+
+	wantFolders := []string{"/infra", "/platform", "/platform/prod", "/platform/prod/db"}
+	if got := env.FolderPaths(t, tenant.ID); !slices.Equal(got, wantFolders) {
+		t.Fatalf("folders after the move are %q, want %q", got, wantFolders)
+	}
+	wantSecrets := []string{"/platform/prod/db/primary"}
+	if got := env.SecretPaths(t, tenant.ID); !slices.Equal(got, wantSecrets) {
+		t.Fatalf("secrets after the move are %q, want %q", got, wantSecrets)
+	}
+}
+
+func TestFolderViewerCannotCreateSubfolder(t *testing.T) {
+	env := wardentesting.NewEnv(t, wardentesting.WithMemoryVault())
+	tenant := env.Tenant()
+	alice, bob := tenant.User("alice"), tenant.User("bob")
+
+	infra := env.CreateFolder(t, alice, nil, "infra")
+	env.Grant(t, authz.ResourceTypeFolder, infra.Id, authz.RelationViewer, bob)
+
+	_, err := env.FolderService.CreateFolder(bob.Context(), &wardenV1.CreateFolderRequest{
+		ParentId: &infra.Id,
+		Name:     "rogue",
+	})
+	if !wardenV1.IsAccessDenied(err) {
+		t.Fatalf("viewer creating a subfolder got %v, want ACCESS_DENIED", err)
+	}
+	if got := env.FolderPaths(t, tenant.ID); !slices.Equal(got, []string{"/infra"}) {
+		t.Fatalf("folders are %q", got)
+	}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"path"
+	"strconv"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folderchangelog"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// trashFolder moves a folder to the trash once the caller has been authorized
+func (s *FolderService) trashFolder(ctx context.Context, id string, force bool) error {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	var trashed *ent.Folder
+	if err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		trashed, err = s.folderRepo.Trash(ctx, tenantID, id, force)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	s.recordChange(ctx, tenantID, data.FolderChange{
+		FolderID:    id,
+		Action:      folderchangelog.ActionFOLDER_CHANGE_ACTION_TRASHED,
+		OldPath:     trashed.OriginalPath,
+		NewPath:     trashed.Path,
+		OldParentID: trashed.OriginalParentID,
+		Details:     map[string]string{"force": strconv.FormatBool(force)},
+	})
+
+	auditevent.Record(ctx, auditevent.FolderDeleted, auditevent.ResourceFolder, id,
+		"force", strconv.FormatBool(force), "permanent", "false")
+
+	s.log.Infof("Folder moved to the trash: id=%s force=%v user=%s", id, force, userID)
+
+	return nil
+}
+
+// RestoreFolder brings a folder back from the trash with the subfolders and
+// secrets deleted along with it. It goes back into the folder it was deleted
+// from, or to the root level once that folder is gone for good; while that
+// folder is in the trash itself it has to be restored first.
+func (s *FolderService) RestoreFolder(ctx context.Context, req *wardenV1.RestoreFolderRequest) (*wardenV1.RestoreFolderResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	trashed, err := s.folderRepo.GetTrashed(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if trashed == nil {
+		return nil, wardenV1.ErrorFolderNotFound("folder not found in the trash")
+	}
+	if !s.canRestore(ctx, tenantID, userID, trashed) {
+		return nil, wardenV1.ErrorAccessDenied("no permission to restore this folder")
+	}
+
+	parentID := trashed.OriginalParentID
+	if parentID != nil {
+		parent, err := s.folderRepo.GetByID(data.WithPrimary(ctx), tenantID, *parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			inTrash, err := s.folderRepo.IsTrashed(ctx, tenantID, *parentID)
+			if err != nil {
+				return nil, err
+			}
+			if inTrash {
+				return nil, wardenV1.ErrorBadRequest("the folder it was deleted from is in the trash, restore that one first")
+			}
+			parentID = nil
+		}
+	}
+	if parentID != nil {
+		if err := s.checker.CanWriteFolder(ctx, tenantID, userID, *parentID); err != nil {
+			return nil, wardenV1.ErrorAccessDenied("no permission to restore a folder into the folder it was deleted from")
+		}
+	}
+
+	name := path.Base(trashed.OriginalPath)
+	if req.Name != nil {
+		name = *req.Name
+	}
+
+	var restored *ent.Folder
+	if err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		restored, err = s.folderRepo.Restore(ctx, tenantID, req.Id, name, parentID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	s.recordChange(ctx, tenantID, data.FolderChange{
+		FolderID:    restored.ID,
+		Action:      folderchangelog.ActionFOLDER_CHANGE_ACTION_RESTORED,
+		OldPath:     trashed.Path,
+		NewPath:     restored.Path,
+		NewParentID: restored.ParentID,
+	})
+
+	auditevent.Record(ctx, auditevent.FolderRestored, auditevent.ResourceFolder, req.Id, "path", restored.Path)
+
+	s.log.Infof("Folder restored from the trash: id=%s path=%s user=%s", req.Id, restored.Path, userID)
+
+	return &wardenV1.RestoreFolderResponse{
+		Folder: s.folderRepo.ToProto(restored),
+	}, nil
+}
+
+// canRestore reports whether the caller may restore a folder in the trash:
+// with delete permission on it or on the folder it was deleted from. In the
+// trash it no longer inherits from that folder, whose owners would otherwise
+// lose it.
+func (s *FolderService) canRestore(ctx context.Context, tenantID uint32, userID string, folder *ent.Folder) bool {
+	if s.checker.CanDeleteFolder(ctx, tenantID, userID, folder.ID) == nil {
+		return true
+	}
+	return folder.OriginalParentID != nil && s.checker.CanDeleteFolder(ctx, tenantID, userID, *folder.OriginalParentID) == nil
+}
+
+// listTrashedFolders lists the folders in the trash the caller may restore
+func (s *FolderService) listTrashedFolders(ctx context.Context, req *wardenV1.ListFoldersRequest) (*wardenV1.ListFoldersResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	page := uint32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+	pageSize := uint32(20)
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	folders, total, err := s.folderRepo.ListTrashed(ctx, tenantID, req.NameFilter, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.ListFoldersResponse{
+		Total: uint32(total),
+	}
+	if req.CountOnly {
+		return resp, nil
+	}
+	for _, folder := range folders {
+		if !s.canRestore(ctx, tenantID, userID, folder) {
+			continue
+		}
+		if req.IdsOnly {
+			resp.Ids = append(resp.Ids, folder.ID)
+		} else {
+			resp.Folders = append(resp.Folders, s.folderRepo.ToProto(folder))
+		}
+	}
+	return resp, nil
+}
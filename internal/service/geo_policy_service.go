@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// GeoPolicyService manages the per-tenant countries passwords may be read from
+type GeoPolicyService struct {
+	wardenV1.UnimplementedWardenGeoPolicyServiceServer
+
+	log          *log.Helper
+	settingsRepo *data.TenantSettingRepo
+}
+
+// NewGeoPolicyService creates a new GeoPolicyService
+func NewGeoPolicyService(
+	ctx *bootstrap.Context,
+	settingsRepo *data.TenantSettingRepo,
+) *GeoPolicyService {
+	return &GeoPolicyService{
+		log:          ctx.NewLoggerHelper("warden/service/geo-policy"),
+		settingsRepo: settingsRepo,
+	}
+}
+
+// GetGeoPolicy returns the geo policy of a tenant
+func (s *GeoPolicyService) GetGeoPolicy(ctx context.Context, req *wardenV1.GetGeoPolicyRequest) (*wardenV1.GeoPolicy, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil && *req.TenantId != tenantID {
+		if !isPlatformAdmin(ctx) {
+			return nil, wardenV1.ErrorAccessDenied("cannot view geo policy of another tenant")
+		}
+		tenantID = *req.TenantId
+	}
+
+	setting, err := s.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGeoPolicyProto(tenantID, setting), nil
+}
+
+// SetGeoPolicy replaces the geo policy of a tenant
+func (s *GeoPolicyService) SetGeoPolicy(ctx context.Context, req *wardenV1.SetGeoPolicyRequest) (*wardenV1.GeoPolicy, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can change geo policies")
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil {
+		tenantID = *req.TenantId
+	}
+
+	countries := make([]string, 0, len(req.AllowedCountries))
+	for _, c := range req.AllowedCountries {
+		c = strings.ToUpper(strings.TrimSpace(c))
+		if c != "" && !slices.Contains(countries, c) {
+			countries = append(countries, c)
+		}
+	}
+
+	setting, err := s.settingsRepo.SetGeoPolicy(ctx, tenantID, countries, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Geo policy updated: tenant=%d countries=%v", tenantID, countries)
+
+	return toGeoPolicyProto(tenantID, setting), nil
+}
+
+func toGeoPolicyProto(tenantID uint32, setting *ent.TenantSetting) *wardenV1.GeoPolicy {
+	resp := &wardenV1.GeoPolicy{
+		TenantId:         tenantID,
+		AllowedCountries: []string{},
+	}
+	if setting != nil {
+		if setting.GeoAllowedCountries != nil {
+			resp.AllowedCountries = setting.GeoAllowedCountries
+		}
+		if setting.UpdateTime != nil {
+			resp.UpdateTime = timestamppb.New(*setting.UpdateTime)
+		}
+	}
+	return resp
+}
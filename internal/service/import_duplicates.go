@@ -0,0 +1,36 @@
+package service
+
+import (
+	"net"
+	"strings"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// duplicateKey returns the key under which an imported item and an existing
+// secret are considered the same. DUPLICATE_MATCH_URL_USERNAME matches on the
+// normalized host and the case-insensitive username, so "GitHub" and
+// "github.com" logins for the same account collide; items without a URL fall
+// back to the name.
+func duplicateKey(match wardenV1.DuplicateMatch, name, hostURL, username string) string {
+	if match == wardenV1.DuplicateMatch_DUPLICATE_MATCH_URL_USERNAME {
+		if host := normalizeHost(hostURL); host != "" {
+			return "url:" + host + "|" + strings.ToLower(strings.TrimSpace(username))
+		}
+	}
+	return "name:" + strings.ToLower(name)
+}
+
+// normalizeHost reduces a URL to its lowercase host without credentials,
+// port and "www." prefix
+func normalizeHost(rawURL string) string {
+	host := strings.ToLower(strings.TrimSpace(hostFromURL(strings.TrimSpace(rawURL))))
+	if i := strings.LastIndex(host, "@"); i >= 0 {
+		host = host[i+1:]
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(host, ".")
+	return strings.TrimPrefix(host, "www.")
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// checkLegalHold fails with LEGAL_HOLD_ACTIVE when a folder or one above it
+// is under legal hold. Root-level secrets have no folder and are never held.
+func checkLegalHold(ctx context.Context, folderRepo *data.FolderRepo, tenantID uint32, folderID *string) error {
+	if folderID == nil || *folderID == "" {
+		return nil
+	}
+	held, err := folderRepo.IsUnderLegalHold(ctx, tenantID, *folderID)
+	if err != nil {
+		return err
+	}
+	if held {
+		return wardenV1.ErrorLegalHoldActive("folder is under legal hold")
+	}
+	return nil
+}
+
+// retainHeldVersions clears the version retention of the given secrets when
+// the folder they are now in is under legal hold, so Vault stops deleting
+// their old versions
+func retainHeldVersions(ctx context.Context, folderRepo *data.FolderRepo, kvStore *vault.KVStore, tenantID uint32, folderID *string, secrets ...*ent.Secret) error {
+	if folderID == nil || *folderID == "" || len(secrets) == 0 {
+		return nil
+	}
+	held, err := folderRepo.IsUnderLegalHold(ctx, tenantID, *folderID)
+	if err != nil || !held {
+		return err
+	}
+	for _, sec := range secrets {
+		if err := kvStore.PutMetadata(ctx, sec.VaultPath, 0, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+//go:build integration
+
+package service_test
+
+import (
+	"os"
+	"testing"
+
+	wardentesting "github.com/go-tangra/go-tangra-warden/internal/testing"
+)
+
+func TestMain(m *testing.M) { os.Exit(wardentesting.Main(m)) }
@@ -0,0 +1,733 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/permission"
+	"github.com/go-tangra/go-tangra-warden/internal/metrics"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+const defaultTrashRetentionDays = 30
+
+// MaintenanceService runs data repair and cleanup tasks across tenants (platform admin only)
+type MaintenanceService struct {
+	wardenV1.UnimplementedWardenMaintenanceServiceServer
+
+	log             *log.Helper
+	maintenanceRepo *data.MaintenanceRepo
+	secretRepo      *data.SecretRepo
+	versionRepo     *data.SecretVersionRepo
+	permRepo        *data.PermissionRepo
+	statsRepo       *data.StatisticsRepo
+	kvStore         *vault.KVStore
+	metrics         *metrics.Collector
+	fieldCipher     *data.FieldCipher
+}
+
+// NewMaintenanceService creates a new MaintenanceService
+func NewMaintenanceService(
+	ctx *bootstrap.Context,
+	maintenanceRepo *data.MaintenanceRepo,
+	secretRepo *data.SecretRepo,
+	versionRepo *data.SecretVersionRepo,
+	permRepo *data.PermissionRepo,
+	statsRepo *data.StatisticsRepo,
+	kvStore *vault.KVStore,
+	metrics *metrics.Collector,
+	fieldCipher *data.FieldCipher,
+) *MaintenanceService {
+	return &MaintenanceService{
+		log:             ctx.NewLoggerHelper("warden/service/maintenance"),
+		maintenanceRepo: maintenanceRepo,
+		secretRepo:      secretRepo,
+		versionRepo:     versionRepo,
+		permRepo:        permRepo,
+		statsRepo:       statsRepo,
+		kvStore:         kvStore,
+		metrics:         metrics,
+		fieldCipher:     fieldCipher,
+	}
+}
+
+// CleanupOrphans deletes permissions whose folder or secret no longer exists
+func (s *MaintenanceService) CleanupOrphans(ctx context.Context, req *wardenV1.CleanupOrphansRequest) (*wardenV1.CleanupOrphansResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can run maintenance tasks")
+	}
+
+	orphans, err := s.maintenanceRepo.ListOrphanPermissions(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.CleanupOrphansResponse{DryRun: req.DryRun}
+	ids := make([]int, 0, len(orphans))
+	for _, p := range orphans {
+		ids = append(ids, p.ID)
+		if p.ResourceType == permission.ResourceTypeRESOURCE_TYPE_FOLDER {
+			resp.FolderPermissions++
+		} else {
+			resp.SecretPermissions++
+		}
+	}
+
+	if !req.DryRun {
+		if _, err := s.maintenanceRepo.DeletePermissions(ctx, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	s.log.Infof("Orphan cleanup: folder_permissions=%d secret_permissions=%d dry_run=%v",
+		resp.FolderPermissions, resp.SecretPermissions, req.DryRun)
+
+	return resp, nil
+}
+
+// RepairFolderPaths recomputes every folder's path and depth from its parent chain
+func (s *MaintenanceService) RepairFolderPaths(ctx context.Context, req *wardenV1.RepairFolderPathsRequest) (*wardenV1.RepairFolderPathsResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can run maintenance tasks")
+	}
+
+	folders, err := s.maintenanceRepo.ListFolders(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.RepairFolderPathsResponse{
+		FoldersChecked:      uint32(len(folders)),
+		RepairedFolderIds:   []string{},
+		UnresolvedFolderIds: []string{},
+		DryRun:              req.DryRun,
+	}
+
+	resolver := newFolderPathResolver(folders)
+	for _, f := range folders {
+		path, depth, ok := resolver.resolve(f.ID)
+		if !ok {
+			resp.UnresolvedFolderIds = append(resp.UnresolvedFolderIds, f.ID)
+			continue
+		}
+		if path == f.Path && depth == f.Depth {
+			continue
+		}
+
+		if !req.DryRun {
+			if err := s.maintenanceRepo.SetFolderPath(ctx, f.ID, path, depth); err != nil {
+				return nil, err
+			}
+		}
+		s.log.Infof("Folder path repaired: id=%s path=%q->%q depth=%d->%d dry_run=%v", f.ID, f.Path, path, f.Depth, depth, req.DryRun)
+		resp.RepairedFolderIds = append(resp.RepairedFolderIds, f.ID)
+	}
+
+	if len(resp.UnresolvedFolderIds) > 0 {
+		s.log.Warnf("Folders with a broken parent chain: %v", resp.UnresolvedFolderIds)
+	}
+
+	return resp, nil
+}
+
+// RecomputeStatistics resets the Prometheus gauges to the counts in the database
+func (s *MaintenanceService) RecomputeStatistics(ctx context.Context, _ *wardenV1.RecomputeStatisticsRequest) (*wardenV1.RecomputeStatisticsResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can run maintenance tasks")
+	}
+
+	s.metrics.Seed(ctx, s.statsRepo)
+
+	return &wardenV1.RecomputeStatisticsResponse{}, nil
+}
+
+// PurgeTrash permanently deletes soft-deleted secrets older than the retention period
+func (s *MaintenanceService) PurgeTrash(ctx context.Context, req *wardenV1.PurgeTrashRequest) (*wardenV1.PurgeTrashResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can run maintenance tasks")
+	}
+
+	days := uint32(defaultTrashRetentionDays)
+	if req.OlderThanDays != nil {
+		days = *req.OlderThanDays
+	}
+	cutoff := time.Now().AddDate(0, 0, -int(days))
+
+	secrets, err := s.maintenanceRepo.ListTrashedSecrets(ctx, req.TenantId, cutoff)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.PurgeTrashResponse{
+		PurgedSecretIds: []string{},
+		FailedSecretIds: []string{},
+		DryRun:          req.DryRun,
+		PurgedFolderIds: []string{},
+		FailedFolderIds: []string{},
+	}
+
+	purged := make(map[string]bool, len(secrets))
+	for _, sec := range secrets {
+		if req.DryRun {
+			resp.PurgedSecretIds = append(resp.PurgedSecretIds, sec.ID)
+			purged[sec.ID] = true
+			continue
+		}
+		if err := s.purgeSecret(ctx, sec); err != nil {
+			s.log.Errorf("Failed to purge secret %s: %v", sec.ID, err)
+			resp.FailedSecretIds = append(resp.FailedSecretIds, sec.ID)
+			continue
+		}
+		resp.PurgedSecretIds = append(resp.PurgedSecretIds, sec.ID)
+		purged[sec.ID] = true
+	}
+
+	folders, err := s.maintenanceRepo.ListTrashedFolders(ctx, req.TenantId, cutoff)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range folders {
+		done, err := s.purgeFolder(ctx, f, purged, req.DryRun)
+		if err != nil {
+			s.log.Errorf("Failed to purge folder %s: %v", f.ID, err)
+			resp.FailedFolderIds = append(resp.FailedFolderIds, f.ID)
+			continue
+		}
+		if done {
+			resp.PurgedFolderIds = append(resp.PurgedFolderIds, f.ID)
+		}
+	}
+
+	s.log.Infof("Trash purge: older_than_days=%d purged=%d failed=%d purged_folders=%d failed_folders=%d dry_run=%v",
+		days, len(resp.PurgedSecretIds), len(resp.FailedSecretIds), len(resp.PurgedFolderIds), len(resp.FailedFolderIds), req.DryRun)
+
+	return resp, nil
+}
+
+// SyncVersions makes the version records of secrets match the versions kept
+// in Vault. Vault is the source of truth: records of versions Vault no longer
+// has (or destroyed) are removed, and readable Vault versions without a record
+// get one. Versions deleted but not destroyed in Vault keep their records and
+// get none, since their password cannot be read to compute the checksum.
+func (s *MaintenanceService) SyncVersions(ctx context.Context, req *wardenV1.SyncVersionsRequest) (*wardenV1.SyncVersionsResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can run maintenance tasks")
+	}
+
+	secrets, err := s.maintenanceRepo.ListSecrets(ctx, req.TenantId, req.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.SyncVersionsResponse{
+		SecretsChecked:    uint32(len(secrets)),
+		RepairedSecretIds: []string{},
+		FailedSecretIds:   []string{},
+		DryRun:            req.DryRun,
+	}
+
+	for _, sec := range secrets {
+		created, removed, err := s.syncSecretVersions(ctx, sec, req.DryRun)
+		if err != nil {
+			s.log.Errorf("Failed to sync versions of secret %s: %v", sec.ID, err)
+			resp.FailedSecretIds = append(resp.FailedSecretIds, sec.ID)
+			continue
+		}
+		if created+removed == 0 {
+			continue
+		}
+		s.log.Infof("Secret versions synced: id=%s created=%d removed=%d dry_run=%v", sec.ID, created, removed, req.DryRun)
+		resp.RepairedSecretIds = append(resp.RepairedSecretIds, sec.ID)
+		resp.VersionsCreated += uint32(created)
+		resp.VersionsRemoved += uint32(removed)
+	}
+
+	s.log.Infof("Version sync: checked=%d repaired=%d created=%d removed=%d failed=%d dry_run=%v",
+		resp.SecretsChecked, len(resp.RepairedSecretIds), resp.VersionsCreated, resp.VersionsRemoved, len(resp.FailedSecretIds), req.DryRun)
+
+	return resp, nil
+}
+
+const (
+	defaultChecksumMigrationLimit = 1000
+	checksumMigrationPageSize     = 100
+	defaultFieldReencryptionLimit = 1000
+)
+
+// MigrateChecksums recomputes the checksums of versions made with another
+// algorithm or checksum key than the current one, so password reuse checks
+// keep finding them. Passwords are read from Vault; versions it can no longer
+// return keep their checksums and are counted as unreadable.
+func (s *MaintenanceService) MigrateChecksums(ctx context.Context, req *wardenV1.MigrateChecksumsRequest) (*wardenV1.MigrateChecksumsResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can run maintenance tasks")
+	}
+
+	limit := defaultChecksumMigrationLimit
+	if req.Limit != nil {
+		limit = int(*req.Limit)
+	}
+	algorithm := s.kvStore.Checksums().Algorithm()
+
+	resp := &wardenV1.MigrateChecksumsResponse{
+		Algorithm:        algorithm,
+		FailedVersionIds: []uint32{},
+		DryRun:           req.DryRun,
+	}
+
+	afterID, processed := 0, 0
+	for processed < limit {
+		versions, err := s.versionRepo.ListOutdatedChecksums(ctx, req.TenantId, req.SecretId, algorithm, afterID, min(checksumMigrationPageSize, limit-processed))
+		if err != nil {
+			return nil, err
+		}
+		if len(versions) == 0 {
+			break
+		}
+
+		for _, v := range versions {
+			afterID = v.ID
+			processed++
+			if v.Edges.Secret == nil {
+				resp.VersionsUnreadable++
+				continue
+			}
+
+			password, err := s.kvStore.GetPasswordVersion(ctx, v.Edges.Secret.VaultPath, int(v.VersionNumber))
+			if err != nil {
+				s.log.Warnf("Cannot read version %d of secret %s for its checksum: %v", v.VersionNumber, v.SecretID, err)
+				resp.VersionsUnreadable++
+				continue
+			}
+			checksum := s.kvStore.ChecksumBuffer(password)
+			password.Wipe()
+			if req.DryRun {
+				resp.VersionsMigrated++
+				continue
+			}
+			if err := s.versionRepo.UpdateChecksum(ctx, v.ID, checksum); err != nil {
+				resp.FailedVersionIds = append(resp.FailedVersionIds, uint32(v.ID))
+				continue
+			}
+			resp.VersionsMigrated++
+		}
+	}
+
+	remaining, err := s.versionRepo.CountOutdatedChecksums(ctx, req.TenantId, req.SecretId, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		remaining -= int(resp.VersionsMigrated)
+	}
+	resp.VersionsRemaining = uint32(remaining)
+
+	s.log.Infof("Checksum migration: algorithm=%s migrated=%d unreadable=%d failed=%d remaining=%d dry_run=%v",
+		algorithm, resp.VersionsMigrated, resp.VersionsUnreadable, len(resp.FailedVersionIds), resp.VersionsRemaining, req.DryRun)
+
+	return resp, nil
+}
+
+// RotateFieldKey creates a new field key for the encrypted username, URL and
+// metadata of secrets, after wrapping the existing ones again with the latest
+// version of the Transit key. Secrets keep their old key until
+// ReencryptFields is run.
+func (s *MaintenanceService) RotateFieldKey(ctx context.Context, req *wardenV1.RotateFieldKeyRequest) (*wardenV1.RotateFieldKeyResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can run maintenance tasks")
+	}
+
+	rewrapped, err := s.fieldCipher.Rotate(ctx, req.RewrapOnly)
+	if err != nil {
+		return nil, err
+	}
+	outdated, err := s.fieldCipher.CountOutdated(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.RotateFieldKeyResponse{
+		KeyId:           s.fieldCipher.ActiveKeyID(),
+		KeysRewrapped:   uint32(rewrapped),
+		SecretsOutdated: uint32(outdated),
+	}
+
+	s.log.Infof("Field key rotation: key=%d rewrapped=%d outdated=%d rewrap_only=%v",
+		resp.KeyId, resp.KeysRewrapped, resp.SecretsOutdated, req.RewrapOnly)
+
+	return resp, nil
+}
+
+// ReencryptFields encrypts the username, URL and metadata of secrets stored
+// under an older field key, or in plaintext, with the current one. With
+// encryption of new values turned off it stores them in plaintext instead.
+func (s *MaintenanceService) ReencryptFields(ctx context.Context, req *wardenV1.ReencryptFieldsRequest) (*wardenV1.ReencryptFieldsResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can run maintenance tasks")
+	}
+
+	limit := defaultFieldReencryptionLimit
+	if req.Limit != nil {
+		limit = int(*req.Limit)
+	}
+
+	done, failed, err := s.fieldCipher.Reencrypt(ctx, req.TenantId, limit, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	remaining, err := s.fieldCipher.CountOutdated(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		remaining -= done
+	}
+
+	resp := &wardenV1.ReencryptFieldsResponse{
+		KeyId:              s.fieldCipher.ActiveKeyID(),
+		SecretsReencrypted: uint32(done),
+		FailedSecretIds:    failed,
+		SecretsRemaining:   uint32(remaining),
+		DryRun:             req.DryRun,
+	}
+	if resp.FailedSecretIds == nil {
+		resp.FailedSecretIds = []string{}
+	}
+
+	s.log.Infof("Field re-encryption: key=%d reencrypted=%d failed=%d remaining=%d dry_run=%v",
+		resp.KeyId, resp.SecretsReencrypted, len(resp.FailedSecretIds), resp.SecretsRemaining, req.DryRun)
+
+	return resp, nil
+}
+
+// purgeStepVault names the step destroying the Vault data of the secrets
+const purgeStepVault = "vault"
+
+// PurgeTenantData deletes everything a tenant has in Warden: Vault data,
+// folders, secrets, versions, permissions, settings and audit logs. Tables go
+// in the dependency order of data.TenantTables, and Vault data is destroyed
+// once pending operations are gone and while the secrets still point to it.
+// The first failed step ends the purge; running it again resumes it.
+func (s *MaintenanceService) PurgeTenantData(ctx context.Context, req *wardenV1.PurgeTenantDataRequest) (*wardenV1.PurgeTenantDataResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can run maintenance tasks")
+	}
+	if !req.DryRun && req.ConfirmTenantId != req.TenantId {
+		return nil, wardenV1.ErrorBadRequest("confirm_tenant_id must repeat tenant_id to purge a tenant")
+	}
+	tenantID := req.TenantId
+
+	held, err := s.maintenanceRepo.ListHeldFolderIDs(ctx, &tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if len(held) > 0 {
+		return nil, wardenV1.ErrorLegalHoldActive("tenant has folders under legal hold, lift the holds before purging it")
+	}
+
+	secrets, err := s.maintenanceRepo.ListSecrets(ctx, &tenantID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.PurgeTenantDataResponse{
+		Steps:  []*wardenV1.PurgeTenantDataStep{},
+		DryRun: req.DryRun,
+	}
+	total := len(data.TenantTables) + 1
+
+	run := func(name string, fn func() (int, int, error)) bool {
+		count, failed, err := fn()
+		step := &wardenV1.PurgeTenantDataStep{Name: name, Count: uint32(count), Failed: uint32(failed)}
+		if err != nil {
+			step.Error = err.Error()
+		}
+		resp.Steps = append(resp.Steps, step)
+		s.log.Infof("Tenant purge progress: tenant=%d step=%d/%d name=%s count=%d failed=%d dry_run=%v",
+			tenantID, len(resp.Steps), total, name, count, failed, req.DryRun)
+		if err != nil {
+			s.log.Errorf("Tenant purge stopped: tenant=%d step=%s: %v", tenantID, name, err)
+			return false
+		}
+		return true
+	}
+
+	resp.Completed = true
+	for _, table := range data.TenantTables {
+		ok := run(table, func() (int, int, error) {
+			if req.DryRun {
+				n, err := s.maintenanceRepo.CountTenantRows(ctx, tenantID, table)
+				return n, 0, err
+			}
+			n, err := s.maintenanceRepo.DeleteTenantRows(ctx, tenantID, table)
+			if err == nil {
+				s.recordPurgedRows(table, n, secrets)
+			}
+			return n, 0, err
+		})
+		if ok && table == data.TenantTablePendingOperations {
+			ok = run(purgeStepVault, func() (int, int, error) {
+				return s.destroyVaultData(ctx, secrets, req.DryRun)
+			})
+		}
+		if !ok {
+			resp.Completed = false
+			break
+		}
+	}
+
+	if !req.DryRun {
+		auditevent.Record(ctx, auditevent.TenantPurged, auditevent.ResourceTenant, strconv.FormatUint(uint64(tenantID), 10),
+			"completed", strconv.FormatBool(resp.Completed))
+	}
+
+	s.log.Infof("Tenant purge: tenant=%d secrets=%d completed=%v dry_run=%v", tenantID, len(secrets), resp.Completed, req.DryRun)
+
+	return resp, nil
+}
+
+// recordPurgedRows keeps the entity count gauges in step with a purge
+func (s *MaintenanceService) recordPurgedRows(table string, n int, secrets []*ent.Secret) {
+	switch table {
+	case data.TenantTableSecrets:
+		for _, sec := range secrets {
+			s.metrics.SecretDeleted(string(sec.Status))
+		}
+	case data.TenantTableFolders:
+		for i := 0; i < n; i++ {
+			s.metrics.FolderDeleted()
+		}
+	}
+}
+
+// destroyVaultData destroys all password versions and the TOTP seed of each
+// secret and returns how many secrets were cleaned and how many failed. Any
+// failure fails the step, since the secret rows are the only record of the
+// Vault paths left behind.
+func (s *MaintenanceService) destroyVaultData(ctx context.Context, secrets []*ent.Secret, dryRun bool) (int, int, error) {
+	if dryRun {
+		return len(secrets), 0, nil
+	}
+
+	done, failed := 0, 0
+	for _, sec := range secrets {
+		if err := s.kvStore.DestroyAllVersions(ctx, sec.VaultPath); err != nil {
+			s.log.Warnf("Failed to destroy password of secret %s in Vault: %v", sec.ID, err)
+			failed++
+			continue
+		}
+		if sec.HasTotp {
+			if err := s.kvStore.DeleteTotp(ctx, s.kvStore.BuildTotpPath(derefTenantID(sec.TenantID), sec.ID)); err != nil {
+				s.log.Warnf("Failed to delete TOTP of secret %s from Vault: %v", sec.ID, err)
+				failed++
+				continue
+			}
+		}
+		done++
+	}
+	if failed > 0 {
+		return done, failed, fmt.Errorf("%d secrets could not be destroyed in Vault", failed)
+	}
+	return done, 0, nil
+}
+
+// syncSecretVersions reconciles the version records of one secret and returns
+// how many records were (or would be) created and removed
+func (s *MaintenanceService) syncSecretVersions(ctx context.Context, sec *ent.Secret, dryRun bool) (int, int, error) {
+	vaultVersions, err := s.kvStore.ListVersions(ctx, sec.VaultPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	records, err := s.maintenanceRepo.ListSecretVersions(ctx, sec.ID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	inVault := make(map[int]vault.VersionInfo, len(vaultVersions))
+	for _, v := range vaultVersions {
+		inVault[v.Version] = v
+	}
+	recorded := make(map[int]bool, len(records))
+
+	var extra []int
+	for _, rec := range records {
+		recorded[int(rec.VersionNumber)] = true
+		if v, ok := inVault[int(rec.VersionNumber)]; !ok || v.Destroyed {
+			extra = append(extra, rec.ID)
+		}
+	}
+
+	var missing []int
+	for _, v := range vaultVersions {
+		if !recorded[v.Version] && !v.Destroyed && v.DeletedAt == "" {
+			missing = append(missing, v.Version)
+		}
+	}
+	sort.Ints(missing)
+
+	if dryRun {
+		return len(missing), len(extra), nil
+	}
+
+	if _, err := s.maintenanceRepo.DeleteSecretVersions(ctx, extra); err != nil {
+		return 0, 0, err
+	}
+	for i, version := range missing {
+		password, err := s.kvStore.GetPasswordVersion(ctx, sec.VaultPath, version)
+		if err != nil {
+			return i, len(extra), err
+		}
+		checksum := s.kvStore.ChecksumBuffer(password)
+		password.Wipe()
+		if _, err := s.versionRepo.Create(ctx, sec.ID, int32(version), sec.VaultPath, "Recovered from Vault", checksum, nil, nil); err != nil {
+			return i, len(extra), err
+		}
+	}
+
+	return len(missing), len(extra), nil
+}
+
+// purgeSecret removes a secret the same way a permanent DeleteSecret does
+func (s *MaintenanceService) purgeSecret(ctx context.Context, sec *ent.Secret) error {
+	tenantID := derefTenantID(sec.TenantID)
+
+	if err := s.kvStore.DestroyAllVersions(ctx, sec.VaultPath); err != nil {
+		s.log.Warnf("failed to destroy password in Vault: %v", err)
+	}
+	if sec.HasTotp {
+		if err := s.kvStore.DeleteTotp(ctx, s.kvStore.BuildTotpPath(tenantID, sec.ID)); err != nil {
+			s.log.Warnf("failed to delete TOTP from Vault: %v", err)
+		}
+	}
+	if err := s.versionRepo.DeleteBySecretID(ctx, sec.ID); err != nil {
+		s.log.Warnf("failed to delete version records: %v", err)
+	}
+
+	if err := s.secretRepo.Delete(ctx, tenantID, sec.ID, true); err != nil {
+		return err
+	}
+
+	if err := s.permRepo.DeleteByResource(ctx, tenantID, string(authz.ResourceTypeSecret), sec.ID); err != nil {
+		s.log.Warnf("Failed to delete permissions for secret %s: %v", sec.ID, err)
+	}
+
+	s.metrics.SecretDeleted(string(sec.Status))
+
+	auditevent.Record(ctx, auditevent.SecretDeleted, auditevent.ResourceSecret, sec.ID, "permanent", "true", "reason", "trash_purge")
+
+	return nil
+}
+
+// purgeFolder permanently deletes a trashed folder with the folders deleted
+// along with it, once every secret in them is purged. It reports false, and
+// leaves the folder in the trash, while a secret is kept, e.g. a protected one.
+func (s *MaintenanceService) purgeFolder(ctx context.Context, f *ent.Folder, purged map[string]bool, dryRun bool) (bool, error) {
+	folderIDs, secretIDs, err := s.maintenanceRepo.ListTrashedFolderContents(ctx, f)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range secretIDs {
+		if !purged[id] {
+			return false, nil
+		}
+	}
+	if dryRun {
+		return true, nil
+	}
+
+	if err := s.maintenanceRepo.DeleteTrashedFolder(ctx, f); err != nil {
+		return false, err
+	}
+
+	tenantID := derefTenantID(f.TenantID)
+	for _, id := range folderIDs {
+		if err := s.permRepo.DeleteByResource(ctx, tenantID, string(authz.ResourceTypeFolder), id); err != nil {
+			s.log.Warnf("Failed to delete permissions for folder %s: %v", id, err)
+		}
+	}
+
+	s.metrics.FolderDeleted()
+
+	auditevent.Record(ctx, auditevent.FolderDeleted, auditevent.ResourceFolder, f.ID, "permanent", "true", "reason", "trash_purge")
+
+	return true, nil
+}
+
+func derefTenantID(id *uint32) uint32 {
+	if id == nil {
+		return 0
+	}
+	return *id
+}
+
+// folderPathResolver rebuilds materialized paths from parent links, detecting
+// missing parents and cycles
+type folderPathResolver struct {
+	byID     map[string]*ent.Folder
+	paths    map[string]string
+	depths   map[string]int32
+	failed   map[string]bool
+	visiting map[string]bool
+}
+
+func newFolderPathResolver(folders []*ent.Folder) *folderPathResolver {
+	r := &folderPathResolver{
+		byID:     make(map[string]*ent.Folder, len(folders)),
+		paths:    make(map[string]string, len(folders)),
+		depths:   make(map[string]int32, len(folders)),
+		failed:   make(map[string]bool),
+		visiting: make(map[string]bool),
+	}
+	for _, f := range folders {
+		r.byID[f.ID] = f
+	}
+	return r
+}
+
+func (r *folderPathResolver) resolve(id string) (string, int32, bool) {
+	if path, ok := r.paths[id]; ok {
+		return path, r.depths[id], true
+	}
+	if r.failed[id] {
+		return "", 0, false
+	}
+
+	f, ok := r.byID[id]
+	if !ok || r.visiting[id] {
+		r.failed[id] = true
+		return "", 0, false
+	}
+
+	path, depth := "/"+f.Name, int32(0)
+	if f.ParentID != nil && *f.ParentID != "" {
+		parent, ok := r.byID[*f.ParentID]
+		if !ok || derefTenantID(parent.TenantID) != derefTenantID(f.TenantID) {
+			r.failed[id] = true
+			return "", 0, false
+		}
+
+		r.visiting[id] = true
+		parentPath, parentDepth, ok := r.resolve(parent.ID)
+		delete(r.visiting, id)
+		if !ok {
+			r.failed[id] = true
+			return "", 0, false
+		}
+		path, depth = parentPath+"/"+f.Name, parentDepth+1
+	}
+
+	r.paths[id], r.depths[id] = path, depth
+	return path, depth, true
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// hasNextPage reports whether a list page holding rows entities is followed
+// by another. Cursor pages only know they were full, so the last page of an
+// exact multiple of pageSize is followed by an empty one.
+func hasNextPage(rows int, cursorMode bool, page, pageSize uint32, total int) bool {
+	if pageSize == 0 || rows < int(pageSize) {
+		return false
+	}
+	if cursorMode {
+		return true
+	}
+	return int(page)*int(pageSize) < total
+}
+
+// listSort maps the sort fields of a list request to the repository order.
+// Name sorts ascending by default and the timestamps newest first.
+func listSort(by wardenV1.ListSortField, order wardenV1.SortOrder) data.ListSort {
+	var s data.ListSort
+	switch by {
+	case wardenV1.ListSortField_LIST_SORT_FIELD_CREATE_TIME:
+		s.Field = data.SortByCreateTime
+	case wardenV1.ListSortField_LIST_SORT_FIELD_UPDATE_TIME:
+		s.Field = data.SortByUpdateTime
+	case wardenV1.ListSortField_LIST_SORT_FIELD_LAST_ACCESSED:
+		s.Field = data.SortByLastAccessed
+	default:
+		s.Field = data.SortByName
+	}
+
+	switch order {
+	case wardenV1.SortOrder_SORT_ORDER_ASC:
+		s.Desc = false
+	case wardenV1.SortOrder_SORT_ORDER_DESC:
+		s.Desc = true
+	default:
+		s.Desc = s.Field != data.SortByName
+	}
+	return s
+}
+
+// listProjection maps the ids_only and count_only flags of a list request to
+// what the repository loads. count_only wins when both are set.
+func listProjection(idsOnly, countOnly bool) data.ListProjection {
+	switch {
+	case countOnly:
+		return data.ProjectCount
+	case idsOnly:
+		return data.ProjectIDs
+	default:
+		return data.ProjectRows
+	}
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// PasswordPolicyService manages the per-tenant password rules
+type PasswordPolicyService struct {
+	wardenV1.UnimplementedWardenPasswordPolicyServiceServer
+
+	log          *log.Helper
+	settingsRepo *data.TenantSettingRepo
+	secretRepo   *data.SecretRepo
+	versionRepo  *data.SecretVersionRepo
+	checker      *authz.Checker
+	kvStore      *vault.KVStore
+}
+
+// NewPasswordPolicyService creates a new PasswordPolicyService
+func NewPasswordPolicyService(
+	ctx *bootstrap.Context,
+	settingsRepo *data.TenantSettingRepo,
+	secretRepo *data.SecretRepo,
+	versionRepo *data.SecretVersionRepo,
+	checker *authz.Checker,
+	kvStore *vault.KVStore,
+) *PasswordPolicyService {
+	return &PasswordPolicyService{
+		log:          ctx.NewLoggerHelper("warden/service/password-policy"),
+		settingsRepo: settingsRepo,
+		secretRepo:   secretRepo,
+		versionRepo:  versionRepo,
+		checker:      checker,
+		kvStore:      kvStore,
+	}
+}
+
+// GetPasswordPolicy returns the password policy of a tenant
+func (s *PasswordPolicyService) GetPasswordPolicy(ctx context.Context, req *wardenV1.GetPasswordPolicyRequest) (*wardenV1.PasswordPolicy, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil && *req.TenantId != tenantID {
+		if !isPlatformAdmin(ctx) {
+			return nil, wardenV1.ErrorAccessDenied("cannot view password policy of another tenant")
+		}
+		tenantID = *req.TenantId
+	}
+
+	setting, err := s.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPasswordPolicyProto(tenantID, setting), nil
+}
+
+// SetPasswordPolicy replaces the password policy of a tenant
+func (s *PasswordPolicyService) SetPasswordPolicy(ctx context.Context, req *wardenV1.SetPasswordPolicyRequest) (*wardenV1.PasswordPolicy, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can change password policies")
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil {
+		tenantID = *req.TenantId
+	}
+
+	words := make([]string, 0, len(req.BannedWords))
+	for _, word := range req.BannedWords {
+		word = strings.TrimSpace(word)
+		if word != "" && !slices.ContainsFunc(words, func(w string) bool { return strings.EqualFold(w, word) }) {
+			words = append(words, word)
+		}
+	}
+
+	policy := data.PasswordPolicy{
+		MinLength:        int(req.MinLength),
+		RequireLowercase: req.RequireLowercase,
+		RequireUppercase: req.RequireUppercase,
+		RequireDigit:     req.RequireDigit,
+		RequireSymbol:    req.RequireSymbol,
+		BannedWords:      words,
+		MaxAgeDays:       int(req.MaxAgeDays),
+		HistorySize:      int(req.HistorySize),
+	}
+
+	setting, err := s.settingsRepo.SetPasswordPolicy(ctx, tenantID, req.Enabled, policy, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Password policy updated: tenant=%d enabled=%v min_length=%d history=%d max_age_days=%d",
+		tenantID, req.Enabled, req.MinLength, req.HistorySize, req.MaxAgeDays)
+
+	return toPasswordPolicyProto(tenantID, setting), nil
+}
+
+// ValidateAgainstPolicy checks a password, and with a secret ID also reuse and
+// the age of the secret's current password, against the caller's tenant policy
+func (s *PasswordPolicyService) ValidateAgainstPolicy(ctx context.Context, req *wardenV1.ValidateAgainstPolicyRequest) (*wardenV1.ValidateAgainstPolicyResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if req.Password == nil && (req.SecretId == nil || *req.SecretId == "") {
+		return nil, wardenV1.ErrorBadRequest("password or secret_id is required")
+	}
+
+	policy, err := s.settingsRepo.GetPasswordPolicy(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []data.PasswordViolation
+
+	if req.Password != nil {
+		violations = append(violations, policy.Check(*req.Password)...)
+	}
+
+	if req.SecretId != nil && *req.SecretId != "" {
+		if err := s.checker.CanReadSecret(ctx, tenantID, userID, *req.SecretId); err != nil {
+			return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
+		}
+		secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, *req.SecretId)
+		if err != nil {
+			return nil, err
+		}
+		if secretEntity == nil {
+			return nil, wardenV1.ErrorSecretNotFound("secret not found")
+		}
+
+		if req.Password != nil {
+			v, err := checkPasswordReuse(ctx, s.versionRepo, s.kvStore.Checksums(), policy, secretEntity.ID, *req.Password)
+			if err != nil {
+				return nil, err
+			}
+			if v != nil {
+				violations = append(violations, *v)
+			}
+		} else {
+			latest, err := s.versionRepo.GetLatestVersion(ctx, secretEntity.ID)
+			if err != nil {
+				return nil, err
+			}
+			if latest != nil && latest.CreateTime != nil {
+				if v := policy.CheckAge(*latest.CreateTime, time.Now()); v != nil {
+					violations = append(violations, *v)
+				}
+			}
+		}
+	}
+
+	resp := &wardenV1.ValidateAgainstPolicyResponse{
+		Valid:      len(violations) == 0,
+		Violations: make([]*wardenV1.PasswordPolicyViolation, 0, len(violations)),
+	}
+	for _, v := range violations {
+		resp.Violations = append(resp.Violations, &wardenV1.PasswordPolicyViolation{Code: v.Code, Message: v.Message})
+	}
+	return resp, nil
+}
+
+// enforcePasswordPolicy rejects a new password that breaks the tenant's policy.
+// secretID is empty for new secrets, which skips the reuse check. The error
+// metadata maps each violation code to its message.
+func enforcePasswordPolicy(ctx context.Context, settingsRepo *data.TenantSettingRepo, versionRepo *data.SecretVersionRepo, checksummer *vault.Checksummer, tenantID uint32, secretID, password string) error {
+	policy, err := settingsRepo.GetPasswordPolicy(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		return nil
+	}
+
+	violations := policy.Check(password)
+	if secretID != "" {
+		v, err := checkPasswordReuse(ctx, versionRepo, checksummer, policy, secretID, password)
+		if err != nil {
+			return err
+		}
+		if v != nil {
+			violations = append(violations, *v)
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(violations))
+	metadata := make(map[string]string, len(violations))
+	for _, v := range violations {
+		messages = append(messages, v.Message)
+		metadata[v.Code] = v.Message
+	}
+	return wardenV1.ErrorPasswordPolicyViolation("password does not meet the policy: %s", strings.Join(messages, "; ")).
+		WithMetadata(metadata)
+}
+
+func checkPasswordReuse(ctx context.Context, versionRepo *data.SecretVersionRepo, checksummer *vault.Checksummer, policy *data.PasswordPolicy, secretID, password string) (*data.PasswordViolation, error) {
+	if policy == nil || policy.HistorySize <= 0 {
+		return nil, nil
+	}
+	checksums, err := versionRepo.ListRecentChecksums(ctx, secretID, policy.HistorySize)
+	if err != nil {
+		return nil, err
+	}
+	return policy.CheckReuse(password, checksums, checksummer), nil
+}
+
+func toPasswordPolicyProto(tenantID uint32, setting *ent.TenantSetting) *wardenV1.PasswordPolicy {
+	resp := &wardenV1.PasswordPolicy{
+		TenantId:    tenantID,
+		BannedWords: []string{},
+	}
+	if setting != nil {
+		resp.Enabled = setting.PasswordPolicyEnabled
+		resp.MinLength = uint32(setting.PasswordMinLength)
+		resp.RequireLowercase = setting.PasswordRequireLowercase
+		resp.RequireUppercase = setting.PasswordRequireUppercase
+		resp.RequireDigit = setting.PasswordRequireDigit
+		resp.RequireSymbol = setting.PasswordRequireSymbol
+		if setting.PasswordBannedWords != nil {
+			resp.BannedWords = setting.PasswordBannedWords
+		}
+		resp.MaxAgeDays = uint32(setting.PasswordMaxAgeDays)
+		resp.HistorySize = uint32(setting.PasswordHistorySize)
+		if setting.UpdateTime != nil {
+			resp.UpdateTime = timestamppb.New(*setting.UpdateTime)
+		}
+	}
+	return resp
+}
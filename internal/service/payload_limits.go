@@ -0,0 +1,125 @@
+package service
+
+import (
+	"encoding/base64"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+const (
+	defaultImportMaxBytes    = 10 << 20 // 10 MiB
+	defaultImportMaxItems    = 10000
+	defaultBackupMaxBytes    = 64 << 20 // 64 MiB
+	defaultBackupMaxEntities = 500000
+	defaultPasswordMaxBytes  = 64 << 10 // 64 KiB
+
+	// grpcEnvelopeBytes is the headroom above the largest payload for the rest of the message
+	grpcEnvelopeBytes = 1 << 20
+)
+
+// PayloadLimits bounds import and restore requests and stored passwords.
+// Sizes are checked before a payload is parsed; item counts right after
+// parsing, before anything is written.
+type PayloadLimits struct {
+	ImportMaxBytes    int
+	ImportMaxItems    int
+	BackupMaxBytes    int
+	BackupMaxEntities int
+	PasswordMaxBytes  int
+}
+
+// NewPayloadLimits reads the limits from IMPORT_MAX_PAYLOAD_BYTES,
+// IMPORT_MAX_ITEMS, BACKUP_MAX_PAYLOAD_BYTES, BACKUP_MAX_ENTITIES and
+// PASSWORD_MAX_BYTES.
+func NewPayloadLimits(ctx *bootstrap.Context) *PayloadLimits {
+	l := ctx.NewLoggerHelper("warden/service/limits")
+
+	envInt := func(key string, def int) int {
+		v := os.Getenv(key)
+		if v == "" {
+			return def
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			l.Warnf("Invalid %s %q, using %d", key, v, def)
+			return def
+		}
+		return n
+	}
+
+	return &PayloadLimits{
+		ImportMaxBytes:    envInt("IMPORT_MAX_PAYLOAD_BYTES", defaultImportMaxBytes),
+		ImportMaxItems:    envInt("IMPORT_MAX_ITEMS", defaultImportMaxItems),
+		BackupMaxBytes:    envInt("BACKUP_MAX_PAYLOAD_BYTES", defaultBackupMaxBytes),
+		BackupMaxEntities: envInt("BACKUP_MAX_ENTITIES", defaultBackupMaxEntities),
+		PasswordMaxBytes:  envInt("PASSWORD_MAX_BYTES", defaultPasswordMaxBytes),
+	}
+}
+
+// MaxMessageBytes returns the gRPC receive limit needed for the largest allowed payload
+func (l *PayloadLimits) MaxMessageBytes() int {
+	return max(l.ImportMaxBytes, l.BackupMaxBytes) + grpcEnvelopeBytes
+}
+
+func (l *PayloadLimits) checkImportSize(size int) error {
+	if size > l.ImportMaxBytes {
+		return wardenV1.ErrorPayloadTooLarge("import payload is %d bytes, the limit is %d bytes", size, l.ImportMaxBytes)
+	}
+	return nil
+}
+
+func (l *PayloadLimits) checkImportItems(count int) error {
+	if count > l.ImportMaxItems {
+		return wardenV1.ErrorPayloadTooLarge("import contains more than %d items", l.ImportMaxItems)
+	}
+	return nil
+}
+
+func (l *PayloadLimits) checkBackupSize(size int) error {
+	if size > l.BackupMaxBytes {
+		return wardenV1.ErrorPayloadTooLarge("backup is %d bytes, the limit is %d bytes", size, l.BackupMaxBytes)
+	}
+	return nil
+}
+
+func (l *PayloadLimits) checkBackupEntities(counts map[string]int64) error {
+	var total int64
+	for _, n := range counts {
+		total += n
+	}
+	if total > int64(l.BackupMaxEntities) {
+		return wardenV1.ErrorPayloadTooLarge("backup contains %d entities, the limit is %d", total, l.BackupMaxEntities)
+	}
+	return nil
+}
+
+// checkPassword validates a password before it is written to Vault. TEXT
+// passwords must be UTF-8 without NUL characters; BASE64 passwords must be
+// standard base64 and are limited by their decoded size.
+func (l *PayloadLimits) checkPassword(password string, encoding wardenV1.PasswordEncoding) error {
+	size := len(password)
+	if encoding == wardenV1.PasswordEncoding_PASSWORD_ENCODING_BASE64 {
+		decoded, err := base64.StdEncoding.DecodeString(password)
+		if err != nil {
+			return wardenV1.ErrorInvalidPasswordEncoding("password is not valid base64")
+		}
+		size = len(decoded)
+	} else {
+		if !utf8.ValidString(password) {
+			return wardenV1.ErrorInvalidPassword("password is not valid UTF-8; store binary data with the BASE64 encoding")
+		}
+		if strings.ContainsRune(password, 0) {
+			return wardenV1.ErrorInvalidPassword("password contains a NUL character; store binary data with the BASE64 encoding")
+		}
+	}
+	if size > l.PasswordMaxBytes {
+		return wardenV1.ErrorPasswordTooLarge("password is %d bytes, the limit is %d bytes", size, l.PasswordMaxBytes)
+	}
+	return nil
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
 	"github.com/go-tangra/go-tangra-warden/internal/authz"
 	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
 
 	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
 )
@@ -17,12 +19,14 @@ import (
 type PermissionService struct {
 	wardenV1.UnimplementedWardenPermissionServiceServer
 
-	log        *log.Helper
-	permRepo   *data.PermissionRepo
-	folderRepo *data.FolderRepo
-	secretRepo *data.SecretRepo
-	engine     *authz.Engine
-	checker    *authz.Checker
+	log            *log.Helper
+	permRepo       *data.PermissionRepo
+	folderRepo     *data.FolderRepo
+	secretRepo     *data.SecretRepo
+	collectionRepo *data.CollectionRepo
+	engine         *authz.Engine
+	checker        *authz.Checker
+	webhooks       *webhook.Dispatcher
 }
 
 func NewPermissionService(
@@ -30,16 +34,20 @@ func NewPermissionService(
 	permRepo *data.PermissionRepo,
 	folderRepo *data.FolderRepo,
 	secretRepo *data.SecretRepo,
+	collectionRepo *data.CollectionRepo,
 	engine *authz.Engine,
 	checker *authz.Checker,
+	webhooks *webhook.Dispatcher,
 ) *PermissionService {
 	return &PermissionService{
-		log:        ctx.NewLoggerHelper("warden/service/permission"),
-		permRepo:   permRepo,
-		folderRepo: folderRepo,
-		secretRepo: secretRepo,
-		engine:     engine,
-		checker:    checker,
+		log:            ctx.NewLoggerHelper("warden/service/permission"),
+		permRepo:       permRepo,
+		folderRepo:     folderRepo,
+		secretRepo:     secretRepo,
+		collectionRepo: collectionRepo,
+		engine:         engine,
+		checker:        checker,
+		webhooks:       webhooks,
 	}
 }
 
@@ -56,7 +64,7 @@ func (s *PermissionService) GrantAccess(ctx context.Context, req *wardenV1.Grant
 
 	// Verify the resource exists (tenant-scoped)
 	if req.ResourceType == wardenV1.ResourceType_RESOURCE_TYPE_FOLDER {
-		folder, err := s.folderRepo.GetByIDAndTenant(ctx, tenantID, req.ResourceId)
+		folder, err := s.folderRepo.GetByID(ctx, tenantID, req.ResourceId)
 		if err != nil {
 			return nil, err
 		}
@@ -64,13 +72,21 @@ func (s *PermissionService) GrantAccess(ctx context.Context, req *wardenV1.Grant
 			return nil, wardenV1.ErrorFolderNotFound("folder not found")
 		}
 	} else if req.ResourceType == wardenV1.ResourceType_RESOURCE_TYPE_SECRET {
-		secret, err := s.secretRepo.GetByIDAndTenant(ctx, tenantID, req.ResourceId)
+		secret, err := s.secretRepo.GetByID(ctx, tenantID, req.ResourceId)
 		if err != nil {
 			return nil, err
 		}
 		if secret == nil {
 			return nil, wardenV1.ErrorSecretNotFound("secret not found")
 		}
+	} else if req.ResourceType == wardenV1.ResourceType_RESOURCE_TYPE_COLLECTION {
+		collection, err := s.collectionRepo.GetByID(ctx, tenantID, req.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		if collection == nil {
+			return nil, wardenV1.ErrorCollectionNotFound("collection not found")
+		}
 	}
 
 	grantedBy := getUserIDAsUint32(ctx)
@@ -95,9 +111,23 @@ func (s *PermissionService) GrantAccess(ctx context.Context, req *wardenV1.Grant
 		return nil, err
 	}
 
+	auditevent.Record(ctx, auditevent.PermissionGranted, auditResourceType(req.ResourceType), req.ResourceId,
+		"relation", req.Relation.String(), "subject_type", req.SubjectType.String(), "subject_id", req.SubjectId)
+
 	s.log.Infof("Access granted: resource=%s/%s relation=%s subject=%s/%s user=%s",
 		req.ResourceType, req.ResourceId, req.Relation, req.SubjectType, req.SubjectId, userID)
 
+	s.webhooks.Publish(ctx, tenantID, webhook.EventPermissionGranted, map[string]any{
+		"permission_id": permission.ID,
+		"resource_type": auditResourceType(req.ResourceType),
+		"resource_id":   req.ResourceId,
+		"relation":      req.Relation.String(),
+		"subject_type":  req.SubjectType.String(),
+		"subject_id":    req.SubjectId,
+		"granted_by":    userID,
+		"expires_at":    expiresAt,
+	})
+
 	return &wardenV1.GrantAccessResponse{
 		Permission: s.permRepo.ToProto(permission),
 	}, nil
@@ -133,6 +163,9 @@ func (s *PermissionService) RevokeAccess(ctx context.Context, req *wardenV1.Revo
 		return nil, err
 	}
 
+	auditevent.Record(ctx, auditevent.PermissionRevoked, auditResourceType(req.ResourceType), req.ResourceId,
+		"subject_type", req.SubjectType.String(), "subject_id", req.SubjectId)
+
 	s.log.Infof("Access revoked: resource=%s/%s subject=%s/%s user=%s",
 		req.ResourceType, req.ResourceId, req.SubjectType, req.SubjectId, userID)
 
@@ -174,7 +207,12 @@ func (s *PermissionService) ListPermissions(ctx context.Context, req *wardenV1.L
 		subjectType = &st
 	}
 
-	permissions, _, err := s.permRepo.List(ctx, tenantID, resourceType, req.ResourceId, subjectType, req.SubjectId, page, pageSize)
+	after, err := data.DecodeCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, total, err := s.permRepo.List(ctx, tenantID, resourceType, req.ResourceId, subjectType, req.SubjectId, after, page, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -190,10 +228,14 @@ func (s *PermissionService) ListPermissions(ctx context.Context, req *wardenV1.L
 		protoPermissions = append(protoPermissions, s.permRepo.ToProto(p))
 	}
 
-	return &wardenV1.ListPermissionsResponse{
+	resp := &wardenV1.ListPermissionsResponse{
 		Permissions: protoPermissions,
 		Total:       uint32(len(protoPermissions)), // Post-filter count reflects accessible permissions
-	}, nil
+	}
+	if hasNextPage(len(permissions), after != nil, page, pageSize, total) {
+		resp.NextCursor = data.PermissionCursor(permissions[len(permissions)-1])
+	}
+	return resp, nil
 }
 
 // CheckAccess checks if a subject has access to a resource.
@@ -299,6 +341,145 @@ func (s *PermissionService) GetEffectivePermissions(ctx context.Context, req *wa
 	}, nil
 }
 
+// OffboardUser reports the permissions of a departing user, hands the
+// resources only they own to a successor and, with revoke, removes all their
+// grants. A resource counts as owned by someone else when another subject
+// owns it or one of its parent folders.
+func (s *PermissionService) OffboardUser(ctx context.Context, req *wardenV1.OffboardUserRequest) (*wardenV1.OffboardUserResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can offboard users")
+	}
+	if req.SuccessorId != nil && *req.SuccessorId == req.UserId {
+		return nil, wardenV1.ErrorBadRequest("successor must be another user")
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	callerID := getUserIDFromContext(ctx)
+
+	permissions, err := s.permRepo.ListBySubject(ctx, tenantID, authz.SubjectTypeUser, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.OffboardUserResponse{
+		Permissions: make([]*wardenV1.PermissionTuple, 0, len(permissions)),
+		Transferred: []*wardenV1.OffboardResource{},
+		Orphaned:    []*wardenV1.OffboardResource{},
+	}
+
+	now := time.Now()
+	for _, p := range permissions {
+		tuple := s.permRepo.ToProto(p)
+		resp.Permissions = append(resp.Permissions, tuple)
+
+		if tuple.Relation != wardenV1.Relation_RELATION_OWNER || (p.ExpiresAt != nil && !p.ExpiresAt.After(now)) {
+			continue
+		}
+		resourceType := mapProtoResourceTypeToAuthz(tuple.ResourceType)
+		shared, err := s.hasOtherOwner(ctx, tenantID, resourceType, p.ResourceID, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if shared {
+			continue
+		}
+
+		resource := &wardenV1.OffboardResource{ResourceType: tuple.ResourceType, ResourceId: p.ResourceID}
+		if req.SuccessorId == nil {
+			resp.Orphaned = append(resp.Orphaned, resource)
+			continue
+		}
+
+		if _, err := s.permRepo.Create(ctx, tenantID, string(resourceType), p.ResourceID, string(authz.RelationOwner),
+			string(authz.SubjectTypeUser), *req.SuccessorId, getUserIDAsUint32(ctx), nil); err != nil {
+			return nil, err
+		}
+		resp.Transferred = append(resp.Transferred, resource)
+
+		auditevent.Record(ctx, auditevent.PermissionGranted, auditResourceType(tuple.ResourceType), p.ResourceID,
+			"relation", wardenV1.Relation_RELATION_OWNER.String(), "subject_type", wardenV1.SubjectType_SUBJECT_TYPE_USER.String(),
+			"subject_id", *req.SuccessorId, "reason", "offboarding")
+
+		s.webhooks.Publish(ctx, tenantID, webhook.EventPermissionGranted, map[string]any{
+			"resource_type": auditResourceType(tuple.ResourceType),
+			"resource_id":   p.ResourceID,
+			"relation":      wardenV1.Relation_RELATION_OWNER.String(),
+			"subject_type":  wardenV1.SubjectType_SUBJECT_TYPE_USER.String(),
+			"subject_id":    *req.SuccessorId,
+			"granted_by":    callerID,
+		})
+	}
+
+	if req.Revoke && len(permissions) > 0 {
+		n, err := s.permRepo.DeleteBySubject(ctx, tenantID, authz.SubjectTypeUser, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		resp.RevokedCount = uint32(n)
+
+		for _, tuple := range resp.Permissions {
+			auditevent.Record(ctx, auditevent.PermissionRevoked, auditResourceType(tuple.ResourceType), tuple.ResourceId,
+				"subject_type", tuple.SubjectType.String(), "subject_id", tuple.SubjectId, "reason", "offboarding")
+		}
+	}
+
+	s.log.Infof("User offboarded: user=%s permissions=%d transferred=%d orphaned=%d revoked=%d by=%s",
+		req.UserId, len(resp.Permissions), len(resp.Transferred), len(resp.Orphaned), resp.RevokedCount, callerID)
+
+	return resp, nil
+}
+
+// hasOtherOwner reports whether a subject other than the user owns the
+// resource or one of its parent folders
+func (s *PermissionService) hasOtherOwner(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID, userID string) (bool, error) {
+	if owned, err := s.ownedByOther(ctx, tenantID, resourceType, resourceID, userID); err != nil || owned {
+		return owned, err
+	}
+
+	var (
+		folderID *string
+		err      error
+	)
+	switch resourceType {
+	case authz.ResourceTypeSecret:
+		folderID, err = s.secretRepo.GetSecretFolderID(ctx, tenantID, resourceID)
+	case authz.ResourceTypeCollection:
+		// Collections have no parents
+		return false, nil
+	default:
+		folderID, err = s.folderRepo.GetFolderParentID(ctx, tenantID, resourceID)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for folderID != nil && *folderID != "" {
+		owned, err := s.ownedByOther(ctx, tenantID, authz.ResourceTypeFolder, *folderID, userID)
+		if err != nil || owned {
+			return owned, err
+		}
+		if folderID, err = s.folderRepo.GetFolderParentID(ctx, tenantID, *folderID); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// ownedByOther reports whether a subject other than the user holds a
+// non-expired owner permission directly on the resource
+func (s *PermissionService) ownedByOther(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID, userID string) (bool, error) {
+	tuples, err := s.permRepo.GetDirectPermissions(ctx, tenantID, resourceType, resourceID)
+	if err != nil {
+		return false, err
+	}
+	for _, t := range tuples {
+		if t.Relation == authz.RelationOwner && !(t.SubjectType == authz.SubjectTypeUser && t.SubjectID == userID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // Helper functions for type mapping
 
 func mapProtoResourceTypeToAuthz(rt wardenV1.ResourceType) authz.ResourceType {
@@ -307,6 +488,8 @@ func mapProtoResourceTypeToAuthz(rt wardenV1.ResourceType) authz.ResourceType {
 		return authz.ResourceTypeFolder
 	case wardenV1.ResourceType_RESOURCE_TYPE_SECRET:
 		return authz.ResourceTypeSecret
+	case wardenV1.ResourceType_RESOURCE_TYPE_COLLECTION:
+		return authz.ResourceTypeCollection
 	default:
 		return authz.ResourceType("")
 	}
@@ -384,3 +567,14 @@ func mapAuthzRelationToProto(r authz.Relation) wardenV1.Relation {
 		return wardenV1.Relation_RELATION_UNSPECIFIED
 	}
 }
+
+// auditResourceType maps a proto resource type to the audit event resource type
+func auditResourceType(rt wardenV1.ResourceType) string {
+	switch rt {
+	case wardenV1.ResourceType_RESOURCE_TYPE_FOLDER:
+		return auditevent.ResourceFolder
+	case wardenV1.ResourceType_RESOURCE_TYPE_COLLECTION:
+		return auditevent.ResourceCollection
+	}
+	return auditevent.ResourceSecret
+}
@@ -0,0 +1,127 @@
+//go:build integration
+
+package service_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	wardentesting "github.com/go-tangra/go-tangra-warden/internal/testing"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+func TestPermissionFolderGrantCoversSecrets(t *testing.T) {
+	env := wardentesting.NewEnv(t, wardentesting.WithMemoryVault())
+	tenant := env.Tenant()
+	alice, bob := tenant.User("alice"), tenant.User("bob")
+
+	infra := env.CreateFolder(t, alice, nil, "infra")
+	prod := env.CreateFolder(t, alice, &infra.Id, "prod")
+	secret := env.CreateSecret(t, alice, &prod.Id, "db", "s3cret-password")
+
+	read := func() error {
+		_, err := env.SecretService.GetSecretPassword(bob.Context(), &wardenV1.GetSecretPasswordRequest{Id: secret.Id})
+		return err
+	}
+	if err := read(); !wardenV1.IsAccessDenied(err) {
+		t.Fatalf("reading without a grant got %v, want ACCESS_DENIED", err)
+	}
+
+	bobID := strconv.FormatUint(uint64(bob.ID), 10)
+	if _, err := env.PermissionService.GrantAccess(alice.Context(), &wardenV1.GrantAccessRequest{
+		ResourceType: wardenV1.ResourceType_RESOURCE_TYPE_FOLDER,
+		ResourceId:   infra.Id,
+		Relation:     wardenV1.Relation_RELATION_VIEWER,
+		SubjectType:  wardenV1.SubjectType_SUBJECT_TYPE_USER,
+		SubjectId:    bobID,
+	}); err != nil {
+		t.Fatalf("grant viewer on the folder: %v", err)
+	}
+	if got := env.Password(t, bob, secret.Id); got != "s3cret-password" {
+		t.Fatalf("viewer of the parent folder reads %q", got)
+	}
+
+	if _, err := env.PermissionService.RevokeAccess(alice.Context(), &wardenV1.RevokeAccessRequest{
+		ResourceType: wardenV1.ResourceType_RESOURCE_TYPE_FOLDER,
+		ResourceId:   infra.Id,
+		SubjectType:  wardenV1.SubjectType_SUBJECT_TYPE_USER,
+		SubjectId:    bobID,
+	}); err != nil {
+		t.Fatalf("revoke the grant: %v", err)
+	}
+	if err := read(); !wardenV1.IsAccessDenied(err) {
+		t.Fatalf("reading after the revocation got %v, want ACCESS_DENIED", err)
+	}
+}
+
+func TestPermissionViewerCannotShare(t *testing.T) {
+	env := wardentesting.NewEnv(t, wardentesting.WithMemoryVault())
+	tenant := env.Tenant()
+	alice, bob, carol := tenant.User("alice"), tenant.User("bob"), tenant.User("carol")
+
+	secret := env.CreateSecret(t, alice, nil, "db", "s3cret-password")
+	grant := func(as, to *wardentesting.User) error {
+		_, err := env.PermissionService.GrantAccess(as.Context(), &wardenV1.GrantAccessRequest{
+			ResourceType: wardenV1.ResourceType_RESOURCE_TYPE_SECRET,
+			ResourceId:   secret.Id,
+			Relation:     wardenV1.Relation_RELATION_VIEWER,
+			SubjectType:  wardenV1.SubjectType_SUBJECT_TYPE_USER,
+			SubjectId:    strconv.FormatUint(uint64(to.ID), 10),
+		})
+		return err
+	}
+	if err := grant(alice, bob); err != nil {
+		t.Fatalf("owner sharing: %v", err)
+	}
+	if err := grant(bob, carol); !wardenV1.IsAccessDenied(err) {
+		t.Fatalf("viewer sharing got %v, want ACCESS_DENIED", err)
+	}
+}
+
+func TestPermissionCollectionGrantListsSecrets(t *testing.T) {
+	env := wardentesting.NewEnv(t, wardentesting.WithMemoryVault())
+	tenant := env.Tenant()
+	alice, bob := tenant.User("alice"), tenant.User("bob")
+
+	infra := env.CreateFolder(t, alice, nil, "infra")
+	prod := env.CreateFolder(t, alice, &infra.Id, "prod")
+	shared := env.CreateSecret(t, alice, &prod.Id, "db", "s3cret-password")
+	env.CreateSecret(t, alice, &prod.Id, "cache", "other-password")
+	env.CreateFolder(t, alice, nil, "platform")
+
+	collection, err := env.EntClient.Collection.Create().
+		SetID("collection-ops").
+		SetTenantID(tenant.ID).
+		SetName("ops").
+		AddSecretIDs(shared.Id).
+		Save(appViewer.NewSystemViewerContext(context.Background()))
+	if err != nil {
+		t.Fatalf("create collection: %v", err)
+	}
+	env.Grant(t, authz.ResourceTypeCollection, collection.ID, authz.RelationViewer, bob)
+
+	list, err := env.SecretService.ListSecrets(bob.Context(), &wardenV1.ListSecretsRequest{AccessibleOnly: true})
+	if err != nil {
+		t.Fatalf("list accessible secrets: %v", err)
+	}
+	if list.Total != 1 || len(list.Secrets) != 1 || list.Secrets[0].Id != shared.Id {
+		t.Fatalf("accessible secrets are %v (total %d), want only the shared one", list.Secrets, list.Total)
+	}
+
+	tree, err := env.FolderService.GetFolderTree(bob.Context(), &wardenV1.GetFolderTreeRequest{IncludeCounts: true})
+	if err != nil {
+		t.Fatalf("get folder tree: %v", err)
+	}
+	if len(tree.Roots) != 1 || tree.Roots[0].Folder.Id != infra.Id ||
+		len(tree.Roots[0].Children) != 1 || tree.Roots[0].Children[0].Folder.Id != prod.Id {
+		t.Fatalf("folder tree is %v, want /infra/prod only", tree.Roots)
+	}
+	if count := tree.Roots[0].Children[0].Folder.SecretCount; count != 0 {
+		t.Fatalf("folder holding a shared secret shows %d secrets", count)
+	}
+}
@@ -9,13 +9,15 @@ import (
 
 	"github.com/go-tangra/go-tangra-warden/internal/authz"
 	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/metrics"
 )
 
 // ProvideResourceLookup creates a ResourceLookup from repositories
-func ProvideResourceLookup(folderRepo *data.FolderRepo, secretRepo *data.SecretRepo) authz.ResourceLookup {
+func ProvideResourceLookup(folderRepo *data.FolderRepo, secretRepo *data.SecretRepo, collectionRepo *data.CollectionRepo) authz.ResourceLookup {
 	return &resourceLookupImpl{
-		folderRepo: folderRepo,
-		secretRepo: secretRepo,
+		folderRepo:     folderRepo,
+		secretRepo:     secretRepo,
+		collectionRepo: collectionRepo,
 	}
 }
 
@@ -25,8 +27,10 @@ func ProvidePermissionStore(permRepo *data.PermissionRepo) authz.PermissionStore
 }
 
 // ProvideAuthzEngine creates the authorization engine
-func ProvideAuthzEngine(store authz.PermissionStore, lookup authz.ResourceLookup, ctx *bootstrap.Context) *authz.Engine {
-	return authz.NewEngine(store, lookup, ctx.GetLogger())
+func ProvideAuthzEngine(store authz.PermissionStore, lookup authz.ResourceLookup, ctx *bootstrap.Context, collector *metrics.Collector) *authz.Engine {
+	engine := authz.NewEngine(store, lookup, ctx.GetLogger())
+	engine.SetMetrics(collector)
+	return engine
 }
 
 // ProvideAuthzChecker creates the authorization checker
@@ -36,8 +40,9 @@ func ProvideAuthzChecker(engine *authz.Engine) *authz.Checker {
 
 // resourceLookupImpl implements authz.ResourceLookup
 type resourceLookupImpl struct {
-	folderRepo *data.FolderRepo
-	secretRepo *data.SecretRepo
+	folderRepo     *data.FolderRepo
+	secretRepo     *data.SecretRepo
+	collectionRepo *data.CollectionRepo
 }
 
 func (r *resourceLookupImpl) GetFolderParentID(ctx context.Context, tenantID uint32, folderID string) (*string, error) {
@@ -48,6 +53,37 @@ func (r *resourceLookupImpl) GetSecretFolderID(ctx context.Context, tenantID uin
 	return r.secretRepo.GetSecretFolderID(ctx, tenantID, secretID)
 }
 
+func (r *resourceLookupImpl) GetSecretCollectionIDs(ctx context.Context, tenantID uint32, secretID string) ([]string, error) {
+	return r.collectionRepo.GetSecretCollectionIDs(ctx, tenantID, secretID)
+}
+
+func (r *resourceLookupImpl) GetAccessPolicies(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string) ([]*authz.AccessPolicy, error) {
+	switch resourceType {
+	case authz.ResourceTypeFolder:
+		return r.folderRepo.GetAccessPolicies(ctx, tenantID, resourceID)
+	case authz.ResourceTypeCollection:
+		// Collections carry no access policy of their own
+		return nil, nil
+	}
+
+	s, err := r.secretRepo.GetByID(data.WithPrimary(ctx), tenantID, resourceID)
+	if err != nil || s == nil {
+		return nil, err
+	}
+	var policies []*authz.AccessPolicy
+	if !s.AccessPolicy.IsEmpty() {
+		policies = append(policies, s.AccessPolicy)
+	}
+	if s.FolderID != nil {
+		folderPolicies, err := r.folderRepo.GetAccessPolicies(ctx, tenantID, *s.FolderID)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, folderPolicies...)
+	}
+	return policies, nil
+}
+
 func (r *resourceLookupImpl) GetUserRoleIDs(ctx context.Context, tenantID uint32, userID string) ([]string, error) {
 	// Extract roles from gRPC metadata (x-roles header sent by transcoder)
 	md, ok := metadata.FromServerContext(ctx)
@@ -13,9 +13,14 @@ package providers
 import (
 	"github.com/google/wire"
 
+	"github.com/go-tangra/go-tangra-warden/internal/cert"
 	"github.com/go-tangra/go-tangra-warden/internal/client"
+	"github.com/go-tangra/go-tangra-warden/internal/job"
 	"github.com/go-tangra/go-tangra-warden/internal/metrics"
 	"github.com/go-tangra/go-tangra-warden/internal/service"
+	"github.com/go-tangra/go-tangra-warden/internal/siem"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
 )
 
 // ProviderSet is the Wire provider set for service layer
@@ -28,9 +33,38 @@ var ProviderSet = wire.NewSet(
 	service.NewBackupService,
 	service.NewSqlBackupService,
 	service.NewUserService,
+	service.NewAuditService,
+	service.NewWebhookService,
+	service.NewCsvTransferService,
+	service.NewTenantTransferService,
+	service.NewExportPolicyService,
+	service.NewMaintenanceService,
+	service.NewPasswordPolicyService,
+	service.NewEmergencyAccessService,
+	service.NewConfigExportService,
+	service.NewGeoPolicyService,
+	service.NewVersionRetentionService,
+	service.NewDeletionRequestService,
+	service.NewCollectionService,
+	service.NewPayloadLimits,
+	service.NewStepUpPolicy,
+	service.NewCanaryAlarm,
 	client.NewAdminClient,
 	client.NewSharingClient,
+	client.NewWardenClient,
 	metrics.NewCollector,
+	wire.Bind(new(vault.Metrics), new(*metrics.Collector)),
+	wire.Bind(new(cert.Metrics), new(*metrics.Collector)),
+	job.NewAuditRetentionJob,
+	job.NewAnomalyDetectionJob,
+	job.NewHealthMonitor,
+	job.NewOutboxWorker,
+	job.NewAccessTracker,
+	job.NewFolderCountReconciler,
+	job.NewEmergencyAccessGranter,
+	job.NewUsageAggregationJob,
+	siem.NewForwarder,
+	webhook.NewDispatcher,
 	ProvideResourceLookup,
 	ProvidePermissionStore,
 	ProvideAuthzEngine,
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+const (
+	// defaultGraphReaderDays is the reader period when the request does not say
+	defaultGraphReaderDays = 30
+
+	// maxGraphReadEvents bounds the password reads scanned for readers
+	maxGraphReadEvents = 1000
+
+	// maxGraphCollections bounds the collections of the secret in the graph
+	maxGraphCollections = 100
+)
+
+// secretGraph collects the nodes and edges of a GetSecretGraph response,
+// adding each node once
+type secretGraph struct {
+	nodes map[string]bool
+	resp  *wardenV1.GetSecretGraphResponse
+}
+
+func newSecretGraph() *secretGraph {
+	return &secretGraph{nodes: make(map[string]bool), resp: &wardenV1.GetSecretGraphResponse{}}
+}
+
+func (g *secretGraph) node(kind wardenV1.SecretGraphNodeKind, prefix, resourceID, label string) string {
+	id := prefix + ":" + resourceID
+	if !g.nodes[id] {
+		g.nodes[id] = true
+		g.resp.Nodes = append(g.resp.Nodes, &wardenV1.SecretGraphNode{Id: id, Kind: kind, ResourceId: resourceID, Label: label})
+	}
+	return id
+}
+
+func (g *secretGraph) secret(secretEntity *ent.Secret) string {
+	return g.node(wardenV1.SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_SECRET, "secret", secretEntity.ID, secretEntity.Name)
+}
+
+func (g *secretGraph) edge(edge *wardenV1.SecretGraphEdge) {
+	g.resp.Edges = append(g.resp.Edges, edge)
+}
+
+// subject returns the node of a permission subject
+func (g *secretGraph) subject(subjectType authz.SubjectType, subjectID string) string {
+	switch subjectType {
+	case authz.SubjectTypeRole:
+		return g.node(wardenV1.SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_ROLE, "role", subjectID, subjectID)
+	case authz.SubjectTypeTenant:
+		return g.node(wardenV1.SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_TENANT, "tenant", subjectID, subjectID)
+	default:
+		return g.node(wardenV1.SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_USER, "user", subjectID, subjectID)
+	}
+}
+
+// GetSecretGraph returns what a secret is connected to: its folders up to
+// the root, its collections, the secrets it references and that reference
+// it, the permissions on all of them and the users who read its password
+// recently. Only resources the caller can read are included, and permissions
+// only on those.
+func (s *SecretService) GetSecretGraph(ctx context.Context, req *wardenV1.GetSecretGraphRequest) (*wardenV1.GetSecretGraphResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadSecret(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
+	}
+
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if secretEntity == nil {
+		return nil, wardenV1.ErrorSecretNotFound("secret not found")
+	}
+
+	g := newSecretGraph()
+	root := g.secret(secretEntity)
+	g.resp.RootNodeId = root
+	if err := s.graphPermissions(ctx, g, tenantID, authz.ResourceTypeSecret, secretEntity.ID, root); err != nil {
+		return nil, err
+	}
+
+	if err := s.graphFolders(ctx, g, tenantID, userID, secretEntity.FolderID, root); err != nil {
+		return nil, err
+	}
+	if err := s.graphCollections(ctx, g, tenantID, userID, secretEntity.ID, root); err != nil {
+		return nil, err
+	}
+	if err := s.graphReferences(ctx, g, tenantID, userID, secretEntity, root); err != nil {
+		return nil, err
+	}
+
+	days := defaultGraphReaderDays
+	if req.ReaderDays != nil {
+		days = int(*req.ReaderDays)
+	}
+	if err := s.graphReaders(ctx, g, tenantID, secretEntity.ID, days, root); err != nil {
+		return nil, err
+	}
+
+	return g.resp, nil
+}
+
+// graphPermissions adds the subjects with a permission on a resource
+func (s *SecretService) graphPermissions(ctx context.Context, g *secretGraph, tenantID uint32, resourceType authz.ResourceType, resourceID, node string) error {
+	tuples, err := s.permRepo.GetDirectPermissions(ctx, tenantID, resourceType, resourceID)
+	if err != nil {
+		return err
+	}
+	for _, tuple := range tuples {
+		edge := &wardenV1.SecretGraphEdge{
+			From:  g.subject(tuple.SubjectType, tuple.SubjectID),
+			To:    node,
+			Kind:  wardenV1.SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_GRANTED,
+			Label: string(tuple.Relation),
+		}
+		if tuple.ExpiresAt != nil {
+			edge.ExpiresAt = timestamppb.New(*tuple.ExpiresAt)
+		}
+		g.edge(edge)
+	}
+	return nil
+}
+
+// graphFolders adds the folders from the secret's up to the root. Every
+// folder is shown since its path is part of the secret's; permissions only on
+// the folders the caller can read.
+func (s *SecretService) graphFolders(ctx context.Context, g *secretGraph, tenantID uint32, userID string, folderID *string, child string) error {
+	for folderID != nil && *folderID != "" {
+		folder, err := s.folderRepo.GetByID(ctx, tenantID, *folderID)
+		if err != nil {
+			return err
+		}
+		if folder == nil {
+			return nil
+		}
+
+		node := g.node(wardenV1.SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_FOLDER, "folder", folder.ID, folder.Path)
+		g.edge(&wardenV1.SecretGraphEdge{From: child, To: node, Kind: wardenV1.SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_IN_FOLDER})
+		if s.checker.CanReadFolder(ctx, tenantID, userID, folder.ID) == nil {
+			if err := s.graphPermissions(ctx, g, tenantID, authz.ResourceTypeFolder, folder.ID, node); err != nil {
+				return err
+			}
+		}
+
+		child = node
+		folderID = folder.ParentID
+	}
+	return nil
+}
+
+// graphCollections adds the readable collections the secret is in
+func (s *SecretService) graphCollections(ctx context.Context, g *secretGraph, tenantID uint32, userID, secretID, node string) error {
+	collections, _, err := s.collections.List(ctx, tenantID, nil, nil, &secretID, 1, maxGraphCollections)
+	if err != nil {
+		return err
+	}
+	for _, collection := range collections {
+		if s.checker.CanReadCollection(ctx, tenantID, userID, collection.ID) != nil {
+			continue
+		}
+		collectionNode := g.node(wardenV1.SecretGraphNodeKind_SECRET_GRAPH_NODE_KIND_COLLECTION, "collection", collection.ID, collection.Name)
+		g.edge(&wardenV1.SecretGraphEdge{From: node, To: collectionNode, Kind: wardenV1.SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_IN_COLLECTION})
+		if err := s.graphPermissions(ctx, g, tenantID, authz.ResourceTypeCollection, collection.ID, collectionNode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// graphReferences adds the readable secrets the secret references and the
+// ones referencing it, counting the unreadable dependents
+func (s *SecretService) graphReferences(ctx context.Context, g *secretGraph, tenantID uint32, userID string, secretEntity *ent.Secret, node string) error {
+	for _, id := range secretEntity.ReferencedSecretIds {
+		if id == secretEntity.ID || s.checker.CanReadSecret(ctx, tenantID, userID, id) != nil {
+			continue
+		}
+		referenced, err := s.secretRepo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if referenced == nil {
+			continue
+		}
+		g.edge(&wardenV1.SecretGraphEdge{From: node, To: g.secret(referenced), Kind: wardenV1.SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_REFERENCES})
+	}
+
+	dependents, err := s.secretRepo.ListDependents(ctx, tenantID, secretEntity.ID)
+	if err != nil {
+		return err
+	}
+	for _, dependent := range dependents {
+		if s.checker.CanReadSecret(ctx, tenantID, userID, dependent.ID) != nil {
+			g.resp.HiddenDependents++
+			continue
+		}
+		g.edge(&wardenV1.SecretGraphEdge{From: g.secret(dependent), To: node, Kind: wardenV1.SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_REFERENCES})
+	}
+	return nil
+}
+
+// graphReaders adds the users who read the password in the last days, from
+// the most recent audit events
+func (s *SecretService) graphReaders(ctx context.Context, g *secretGraph, tenantID uint32, secretID string, days int, node string) error {
+	eventType := auditevent.SecretPasswordRead
+	resourceType := auditevent.ResourceSecret
+	since := time.Now().AddDate(0, 0, -days)
+	events, _, err := s.auditLogs.List(ctx, &data.AuditLogListOptions{
+		TenantID:     &tenantID,
+		EventType:    &eventType,
+		ResourceType: &resourceType,
+		ResourceID:   &secretID,
+		StartTime:    &since,
+		Limit:        maxGraphReadEvents,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Events come newest first, so the first one of a reader is its last read
+	edges := make(map[string]*wardenV1.SecretGraphEdge)
+	for _, event := range events {
+		if event.ActorID == "" {
+			continue
+		}
+		edge, ok := edges[event.ActorID]
+		if !ok {
+			edge = &wardenV1.SecretGraphEdge{
+				From: g.subject(authz.SubjectTypeUser, event.ActorID),
+				To:   node,
+				Kind: wardenV1.SecretGraphEdgeKind_SECRET_GRAPH_EDGE_KIND_READ,
+			}
+			if event.CreateTime != nil {
+				edge.LastTime = timestamppb.New(*event.CreateTime)
+			}
+			edges[event.ActorID] = edge
+			g.edge(edge)
+		}
+		edge.Count++
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// checkSecretReferences rejects metadata referencing secrets the caller
+// cannot read. Missing and unreadable secrets get the same error so IDs
+// cannot be probed. A secret may reference itself.
+func (s *SecretService) checkSecretReferences(ctx context.Context, tenantID uint32, userID, selfID string, metadata map[string]any) error {
+	ids := data.ReferencedSecretIDs(metadata)
+	if len(ids) > data.MaxSecretReferences {
+		return wardenV1.ErrorBadRequest("metadata references more than %d secrets", data.MaxSecretReferences)
+	}
+	for _, id := range ids {
+		if id == selfID {
+			continue
+		}
+		if err := s.checker.CanReadSecret(ctx, tenantID, userID, id); err != nil {
+			return wardenV1.ErrorBadRequest("metadata references secret %s, which does not exist or is not readable", id)
+		}
+		referenced, err := s.secretRepo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if referenced == nil || referenced.Status == secret.StatusSECRET_STATUS_DELETED {
+			return wardenV1.ErrorBadRequest("metadata references secret %s, which does not exist or is not readable", id)
+		}
+	}
+	return nil
+}
+
+// resolveSecretReferences returns the metadata of a secret with its
+// references replaced by the fields of the referenced secrets. References to
+// secrets the caller cannot read, or that are gone, are left as they are.
+func (s *SecretService) resolveSecretReferences(ctx context.Context, tenantID uint32, userID string, secretEntity *ent.Secret) map[string]any {
+	if len(secretEntity.ReferencedSecretIds) == 0 {
+		return secretEntity.Metadata
+	}
+
+	found := make(map[string]*ent.Secret, len(secretEntity.ReferencedSecretIds))
+	for _, id := range secretEntity.ReferencedSecretIds {
+		if id == secretEntity.ID {
+			found[id] = secretEntity
+			continue
+		}
+		if s.checker.CanReadSecret(ctx, tenantID, userID, id) != nil {
+			continue
+		}
+		referenced, err := s.secretRepo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			s.log.Warnf("failed to resolve reference from secret %s to %s: %v", secretEntity.ID, id, err)
+			continue
+		}
+		if referenced != nil && referenced.Status != secret.StatusSECRET_STATUS_DELETED {
+			found[id] = referenced
+		}
+	}
+
+	return data.ResolveSecretReferences(secretEntity.Metadata, func(ref data.SecretReference) (string, bool) {
+		referenced, ok := found[ref.SecretID]
+		if !ok {
+			return "", false
+		}
+		switch ref.Field {
+		case "username":
+			return referenced.Username, true
+		case "host_url":
+			return referenced.HostURL, true
+		case "name":
+			return referenced.Name, true
+		}
+		return "", false
+	})
+}
+
+// checkDependents fails when the metadata of other secrets references the
+// secret, listing the dependents the caller can read in the error metadata
+func (s *SecretService) checkDependents(ctx context.Context, tenantID uint32, userID, id string) error {
+	dependents, err := s.secretRepo.ListDependents(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	if len(dependents) == 0 {
+		return nil
+	}
+
+	var readable []string
+	for _, dependent := range dependents {
+		if s.checker.CanReadSecret(ctx, tenantID, userID, dependent.ID) == nil {
+			readable = append(readable, dependent.ID)
+		}
+	}
+	return wardenV1.ErrorSecretReferenced("secret is referenced by %d other secrets, delete with force to proceed", len(dependents)).
+		WithMetadata(map[string]string{"dependent_ids": strings.Join(readable, ",")})
+}
@@ -2,10 +2,13 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
@@ -13,11 +16,16 @@ import (
 	"github.com/pquerna/otp/totp"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
 	"github.com/go-tangra/go-tangra-warden/internal/authz"
 	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+	"github.com/go-tangra/go-tangra-warden/internal/job"
 	"github.com/go-tangra/go-tangra-warden/internal/metrics"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
 	"github.com/go-tangra/go-tangra-warden/pkg/vault"
 
 	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
@@ -40,11 +48,31 @@ type SecretService struct {
 	kvStore     *vault.KVStore
 	checker     *authz.Checker
 	metrics     *metrics.Collector
+	settings    *data.TenantSettingRepo
+	tx          *data.Transactor
+	pendingOps  *data.PendingOperationRepo
+	webhooks    *webhook.Dispatcher
+	limits      *PayloadLimits
+	tokens      *data.RetrievalTokenStore
+	stepUp      *StepUpPolicy
+	canary      *CanaryAlarm
+	usageRepo   *data.SecretUsageRepo
+	pins        *data.VersionPinRepo
+	collections *data.CollectionRepo
+	auditLogs   *data.AuditLogRepo
+
+	accessTracker *job.AccessTracker
+
+	// Wakes the open FetchSecretsStream calls of a tenant on changes
+	changes            *secretChanges
+	streamPollInterval time.Duration
 
 	// Rate limiter for password access: key = "userID:secretID"
 	pwAccessMu    sync.Mutex
 	pwAccessCache map[string]*passwordAccessEntry
-	stopCh        chan struct{} // signals the cleanup goroutine to stop
+	// Last secret.read event per "userID:secretID", guarded by pwAccessMu
+	readNotified map[string]time.Time
+	stopCh       chan struct{} // signals the cleanup goroutine to stop
 }
 
 func NewSecretService(
@@ -56,9 +84,23 @@ func NewSecretService(
 	kvStore *vault.KVStore,
 	checker *authz.Checker,
 	metrics *metrics.Collector,
+	settings *data.TenantSettingRepo,
+	tx *data.Transactor,
+	pendingOps *data.PendingOperationRepo,
+	accessTracker *job.AccessTracker,
+	webhooks *webhook.Dispatcher,
+	limits *PayloadLimits,
+	tokens *data.RetrievalTokenStore,
+	stepUp *StepUpPolicy,
+	canary *CanaryAlarm,
+	usageRepo *data.SecretUsageRepo,
+	pins *data.VersionPinRepo,
+	collections *data.CollectionRepo,
+	auditLogs *data.AuditLogRepo,
 ) *SecretService {
+	l := ctx.NewLoggerHelper("warden/service/secret")
 	svc := &SecretService{
-		log:           ctx.NewLoggerHelper("warden/service/secret"),
+		log:           l,
 		secretRepo:    secretRepo,
 		versionRepo:   versionRepo,
 		folderRepo:    folderRepo,
@@ -66,8 +108,25 @@ func NewSecretService(
 		kvStore:       kvStore,
 		checker:       checker,
 		pwAccessCache: make(map[string]*passwordAccessEntry),
+		readNotified:  make(map[string]time.Time),
 		metrics:       metrics,
+		settings:      settings,
+		tx:            tx,
+		pendingOps:    pendingOps,
+		accessTracker: accessTracker,
+		webhooks:      webhooks,
+		limits:        limits,
+		tokens:        tokens,
+		stepUp:        stepUp,
+		canary:        canary,
+		usageRepo:     usageRepo,
+		pins:          pins,
+		collections:   collections,
+		auditLogs:     auditLogs,
 		stopCh:        make(chan struct{}),
+
+		changes:            newSecretChanges(),
+		streamPollInterval: secretStreamPollInterval(l),
 	}
 
 	// Periodically clean up stale rate-limit entries to prevent unbounded growth.
@@ -95,6 +154,11 @@ func (s *SecretService) Close() {
 const (
 	pwRateLimitWindow = 1 * time.Minute
 	pwRateLimitMax    = 30
+
+	// sensitiveReadNotifyWindow is the minimum time between two secret.read
+	// events for the same reader and secret, so automation reading a secret
+	// in a loop does not flood the owners
+	sensitiveReadNotifyWindow = 15 * time.Minute
 )
 
 // checkPasswordAccessRate enforces per-user per-secret rate limiting on password retrieval.
@@ -129,6 +193,41 @@ func (s *SecretService) sweepStaleRateLimitEntries() {
 			delete(s.pwAccessCache, key)
 		}
 	}
+	for key, last := range s.readNotified {
+		if now.Sub(last) > sensitiveReadNotifyWindow {
+			delete(s.readNotified, key)
+		}
+	}
+}
+
+// notifySensitiveRead raises a secret.read event when someone other than an
+// owner read the password of a sensitive secret, at most once per reader and
+// secret per sensitiveReadNotifyWindow
+func (s *SecretService) notifySensitiveRead(ctx context.Context, tenantID uint32, userID string, secretEntity *ent.Secret, version int) {
+	if !secretEntity.Sensitive {
+		return
+	}
+	if _, relation := s.checker.GetEffectivePermissions(ctx, tenantID, userID, authz.ResourceTypeSecret, secretEntity.ID); relation == authz.RelationOwner {
+		return
+	}
+
+	key := userID + ":" + secretEntity.ID
+	now := time.Now()
+	s.pwAccessMu.Lock()
+	if last, ok := s.readNotified[key]; ok && now.Sub(last) < sensitiveReadNotifyWindow {
+		s.pwAccessMu.Unlock()
+		return
+	}
+	s.readNotified[key] = now
+	s.pwAccessMu.Unlock()
+
+	s.webhooks.Publish(ctx, tenantID, webhook.EventSecretRead, map[string]any{
+		"secret_id":  secretEntity.ID,
+		"folder_id":  secretEntity.FolderID,
+		"version":    version,
+		"read_by":    userID,
+		"created_by": secretEntity.CreateBy,
+	})
 }
 
 // CreateSecret creates a new secret
@@ -143,15 +242,15 @@ func (s *SecretService) CreateSecret(ctx context.Context, req *wardenV1.CreateSe
 		}
 	}
 
-	// Build vault path
-	secretID := generateUUID()
-	vaultPath := s.kvStore.BuildPath(tenantID, secretID)
-
-	// Store password in Vault (log full error server-side, return sanitized message)
-	_, err := s.kvStore.StorePassword(ctx, vaultPath, req.Password, nil)
-	if err != nil {
-		s.log.Errorf("failed to store password in Vault for path %s: %v", vaultPath, err)
-		return nil, wardenV1.ErrorVaultOperationError("failed to store password")
+	if err := s.limits.checkPassword(req.Password, req.PasswordEncoding); err != nil {
+		return nil, err
+	}
+	binary := req.PasswordEncoding == wardenV1.PasswordEncoding_PASSWORD_ENCODING_BASE64
+	// Composition rules make no sense for binary data
+	if !binary {
+		if err := enforcePasswordPolicy(ctx, s.settings, s.versionRepo, s.kvStore.Checksums(), tenantID, "", req.Password); err != nil {
+			return nil, err
+		}
 	}
 
 	// Convert metadata from proto struct to map
@@ -159,56 +258,112 @@ func (s *SecretService) CreateSecret(ctx context.Context, req *wardenV1.CreateSe
 	if req.Metadata != nil {
 		metadata = req.Metadata.AsMap()
 	}
-
-	// Create secret in database
-	createdBy := getUserIDAsUint32(ctx)
-	secretEntity, err := s.secretRepo.Create(ctx, tenantID, req.FolderId, req.Name, req.Username, req.HostUrl, vaultPath, req.Description, metadata, createdBy)
-	if err != nil {
-		// Try to clean up Vault on failure
-		if cleanupErr := s.kvStore.DestroyAllVersions(ctx, vaultPath); cleanupErr != nil {
-			s.log.Warnf("Failed to clean up Vault path %s after secret creation failure: %v", vaultPath, cleanupErr)
-		}
+	if err := enforceMetadataSchema(ctx, s.folderRepo, tenantID, req.FolderId, metadata); err != nil {
+		return nil, err
+	}
+	if err := s.checkSecretReferences(ctx, tenantID, userID, "", metadata); err != nil {
 		return nil, err
 	}
 
-	// Create initial version record
-	checksum := vault.CalculateChecksum(req.Password)
-	_, err = s.versionRepo.Create(ctx, secretEntity.ID, 1, vaultPath, req.VersionComment, checksum, createdBy)
-	if err != nil {
-		s.log.Errorf("failed to create version record for secret %s: %v", secretEntity.ID, err)
-		// Clean up: delete the DB secret and Vault data on version creation failure
-		if delErr := s.secretRepo.Delete(ctx, tenantID, secretEntity.ID, true); delErr != nil {
-			s.log.Warnf("failed to clean up secret after version creation failure: %v", delErr)
+	// The folder's default permissions are granted alongside the requested ones
+	grants := req.InitialPermissions
+	if req.FolderId != nil && *req.FolderId != "" {
+		folder, err := s.folderRepo.GetByID(ctx, tenantID, *req.FolderId)
+		if err != nil {
+			return nil, err
+		}
+		if folder == nil {
+			return nil, wardenV1.ErrorFolderNotFound("folder not found")
 		}
-		if cleanupErr := s.kvStore.DestroyAllVersions(ctx, vaultPath); cleanupErr != nil {
-			s.log.Warnf("failed to clean up Vault after version creation failure: %v", cleanupErr)
+		if len(folder.DefaultPermissions) > 0 {
+			grants = append(s.folderRepo.ToProto(folder).DefaultPermissions, grants...)
 		}
-		return nil, wardenV1.ErrorInternalServerError("failed to create secret version")
 	}
 
-	// Grant owner permission to creator
-	if createdBy != nil {
-		_, err = s.permRepo.Create(ctx, tenantID, string(authz.ResourceTypeSecret), secretEntity.ID, string(authz.RelationOwner), string(authz.SubjectTypeUser), userID, createdBy, nil)
+	// Build vault path
+	secretID := generateUUID()
+	vaultPath := s.kvStore.BuildPath(tenantID, secretID)
+
+	// Record the write so the Vault data is cleaned up if we never commit
+	pendingWrite, err := s.pendingOps.BeginVaultWrite(ctx, tenantID, secretID, vaultPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store password in Vault (log full error server-side, return sanitized message)
+	if _, err := s.kvStore.StorePassword(ctx, vaultPath, req.Password, nil); err != nil {
+		s.log.Errorf("failed to store password in Vault for path %s: %v", vaultPath, err)
+		s.pendingOps.AbortVaultWrite(ctx, pendingWrite)
+		return nil, wardenV1.ErrorVaultOperationError("failed to store password")
+	}
+
+	// Create the secret, its first version and its permissions atomically
+	createdBy := getUserIDAsUint32(ctx)
+	checksum := s.kvStore.Checksum(req.Password)
+	var secretEntity *ent.Secret
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		secretEntity, err = s.secretRepo.Create(ctx, tenantID, req.FolderId, req.Name, req.Username, req.HostUrl, vaultPath, req.Description, metadata, createdBy)
 		if err != nil {
-			s.log.Errorf("failed to grant owner permission for secret %s: %v", secretEntity.ID, err)
+			return err
 		}
-	}
 
-	// Grant initial permissions from request
-	for _, perm := range req.InitialPermissions {
-		if perm.SubjectId == "" || perm.SubjectType == wardenV1.SubjectType_SUBJECT_TYPE_UNSPECIFIED {
-			continue
+		if _, err := s.versionRepo.Create(ctx, secretEntity.ID, 1, vaultPath, req.VersionComment, checksum, nil, createdBy); err != nil {
+			s.log.Errorf("failed to create version record for secret %s: %v", secretEntity.ID, err)
+			return wardenV1.ErrorInternalServerError("failed to create secret version")
 		}
-		// Skip if same as creator (already OWNER)
-		if perm.SubjectType == wardenV1.SubjectType_SUBJECT_TYPE_USER && perm.SubjectId == userID {
-			continue
+
+		if binary {
+			if err := s.secretRepo.SetPasswordEncoding(ctx, tenantID, secretEntity.ID, data.PasswordEncodingFromProto(req.PasswordEncoding)); err != nil {
+				return err
+			}
+			secretEntity.PasswordEncoding = data.PasswordEncodingFromProto(req.PasswordEncoding)
 		}
-		relation := string(mapProtoRelationToAuthz(perm.Relation))
-		subjectType := string(mapProtoSubjectTypeToAuthz(perm.SubjectType))
-		_, err = s.permRepo.Create(ctx, tenantID, string(authz.ResourceTypeSecret), secretEntity.ID, relation, subjectType, perm.SubjectId, createdBy, nil)
-		if err != nil {
-			s.log.Warnf("failed to grant initial permission to %s/%s: %v", perm.SubjectType, perm.SubjectId, err)
+
+		if req.Canary {
+			if err := s.secretRepo.SetCanary(ctx, tenantID, secretEntity.ID, true); err != nil {
+				return err
+			}
+			secretEntity.Canary = true
+		}
+
+		// Grant owner permission to creator
+		if createdBy != nil {
+			if _, err := s.permRepo.Create(ctx, tenantID, string(authz.ResourceTypeSecret), secretEntity.ID, string(authz.RelationOwner), string(authz.SubjectTypeUser), userID, createdBy, nil); err != nil {
+				s.log.Errorf("failed to grant owner permission for secret %s: %v", secretEntity.ID, err)
+				return err
+			}
 		}
+
+		// Grant folder defaults and initial permissions from request
+		granted := make(map[string]bool, len(grants))
+		for _, perm := range grants {
+			if perm.SubjectId == "" || perm.SubjectType == wardenV1.SubjectType_SUBJECT_TYPE_UNSPECIFIED {
+				continue
+			}
+			// Skip if same as creator (already OWNER)
+			if perm.SubjectType == wardenV1.SubjectType_SUBJECT_TYPE_USER && perm.SubjectId == userID {
+				continue
+			}
+			relation := string(mapProtoRelationToAuthz(perm.Relation))
+			subjectType := string(mapProtoSubjectTypeToAuthz(perm.SubjectType))
+			// A duplicate grant would violate the unique index and abort the transaction
+			key := relation + "|" + subjectType + "|" + perm.SubjectId
+			if granted[key] {
+				continue
+			}
+			granted[key] = true
+			if _, err := s.permRepo.Create(ctx, tenantID, string(authz.ResourceTypeSecret), secretEntity.ID, relation, subjectType, perm.SubjectId, createdBy, nil); err != nil {
+				s.log.Errorf("failed to grant initial permission to %s/%s: %v", perm.SubjectType, perm.SubjectId, err)
+				return err
+			}
+		}
+		return s.pendingOps.CommitVaultWrite(ctx, pendingWrite)
+	})
+	if err != nil {
+		// Nothing references the Vault data once the transaction rolled back
+		s.pendingOps.AbortVaultWrite(ctx, pendingWrite)
+		return nil, err
 	}
 
 	// Store TOTP in Vault if provided
@@ -220,16 +375,26 @@ func (s *SecretService) CreateSecret(ctx context.Context, req *wardenV1.CreateSe
 			s.log.Warnf("failed to store TOTP in Vault: %v", err)
 		} else {
 			_ = s.secretRepo.SetHasTotp(ctx, tenantID, secretEntity.ID, true)
-			secretEntity, _ = s.secretRepo.GetByIDAndTenant(ctx, tenantID, secretEntity.ID)
+			secretEntity, _ = s.secretRepo.GetByID(data.WithPrimary(ctx), tenantID, secretEntity.ID)
 		}
 	}
 
+	if err := retainHeldVersions(ctx, s.folderRepo, s.kvStore, tenantID, req.FolderId, secretEntity); err != nil {
+		s.log.Warnf("failed to lift version retention of secret %s under legal hold: %v", secretEntity.ID, err)
+	}
+
 	s.metrics.SecretCreated(string(secret.StatusSECRET_STATUS_ACTIVE))
 
+	auditevent.Record(ctx, auditevent.SecretCreated, auditevent.ResourceSecret, secretEntity.ID)
+
 	s.log.Infof("Secret created: id=%s folder=%v user=%s", secretEntity.ID, req.FolderId, userID)
 
+	// The creator is the owner, so it may see the canary flag
+	resp := s.secretRepo.ToProto(secretEntity)
+	resp.Canary = secretEntity.Canary
+
 	return &wardenV1.CreateSecretResponse{
-		Secret: s.secretRepo.ToProto(secretEntity),
+		Secret: resp,
 	}, nil
 }
 
@@ -243,7 +408,7 @@ func (s *SecretService) GetSecret(ctx context.Context, req *wardenV1.GetSecretRe
 		return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
 	}
 
-	secretEntity, err := s.secretRepo.GetByIDAndTenant(ctx, tenantID, req.Id)
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -251,11 +416,29 @@ func (s *SecretService) GetSecret(ctx context.Context, req *wardenV1.GetSecretRe
 		return nil, wardenV1.ErrorSecretNotFound("secret not found")
 	}
 
+	auditevent.Record(ctx, auditevent.SecretRead, auditevent.ResourceSecret, req.Id)
+
+	if req.ResolveReferences {
+		secretEntity.Metadata = s.resolveSecretReferences(ctx, tenantID, userID, secretEntity)
+	}
+
 	return &wardenV1.GetSecretResponse{
-		Secret: s.secretRepo.ToProto(secretEntity),
+		Secret: s.toOwnerProto(ctx, tenantID, userID, secretEntity),
 	}, nil
 }
 
+// toOwnerProto converts a secret to its proto form, revealing the canary flag
+// only to owners so other readers cannot tell a decoy apart
+func (s *SecretService) toOwnerProto(ctx context.Context, tenantID uint32, userID string, secretEntity *ent.Secret) *wardenV1.Secret {
+	proto := s.secretRepo.ToProto(secretEntity)
+	if secretEntity.Canary {
+		if _, relation := s.checker.GetEffectivePermissions(ctx, tenantID, userID, authz.ResourceTypeSecret, secretEntity.ID); relation == authz.RelationOwner {
+			proto.Canary = true
+		}
+	}
+	return proto
+}
+
 // GetSecretPassword retrieves the password for a secret
 func (s *SecretService) GetSecretPassword(ctx context.Context, req *wardenV1.GetSecretPasswordRequest) (*wardenV1.GetSecretPasswordResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
@@ -266,7 +449,7 @@ func (s *SecretService) GetSecretPassword(ctx context.Context, req *wardenV1.Get
 		return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
 	}
 
-	secretEntity, err := s.secretRepo.GetByIDAndTenant(ctx, tenantID, req.Id)
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -274,47 +457,358 @@ func (s *SecretService) GetSecretPassword(ctx context.Context, req *wardenV1.Get
 		return nil, wardenV1.ErrorSecretNotFound("secret not found")
 	}
 
+	if err := s.stepUp.check(ctx, secretEntity); err != nil {
+		return nil, err
+	}
+
 	// Rate limit password access: max 30 requests per user per secret per minute
 	if err := s.checkPasswordAccessRate(userID, req.Id); err != nil {
 		return nil, err
 	}
 
+	// A pin token reads the pinned version, unless the pin is being advanced
+	requested := req.Version
+	var pin *ent.VersionPin
+	if req.PinToken != nil {
+		if req.Version != nil {
+			return nil, wardenV1.ErrorBadRequest("version and pin_token cannot be combined")
+		}
+		pin, err = s.pins.GetByToken(ctx, tenantID, req.Id, *req.PinToken)
+		if err != nil {
+			return nil, err
+		}
+		if pin == nil {
+			return nil, wardenV1.ErrorBadRequest("invalid pin token")
+		}
+		if !req.Pin {
+			requested = &pin.VersionNumber
+		}
+	}
+
 	// Audit: log password access (ID only, no name to minimize info disclosure in logs)
 	s.log.Infof("Password access: user=%s secret=%s", userID, req.Id)
 
-	var password string
-	var version int
+	password, version, err := s.readPassword(ctx, tenantID, secretEntity, requested)
+	if err != nil {
+		return nil, err
+	}
+	defer password.Wipe()
+
+	resp := &wardenV1.GetSecretPasswordResponse{
+		Password: password.Reveal(),
+		Version:  int32(version),
+		Encoding: data.PasswordEncodingToProto(secretEntity.PasswordEncoding),
+	}
 
-	if req.Version != nil && *req.Version > 0 {
-		// Get specific version
-		versionEntity, err := s.versionRepo.GetBySecretAndVersion(ctx, tenantID, req.Id, *req.Version)
+	auditArgs := []string{"version", strconv.Itoa(version)}
+	created := false
+	if req.Pin || pin != nil {
+		created = pin == nil
+		var token string
+		if created {
+			pin, token, err = s.pins.Create(ctx, tenantID, req.Id, int32(version), userID)
+		} else {
+			pin, err = s.pins.Touch(ctx, pin.ID, int32(version))
+		}
 		if err != nil {
 			return nil, err
 		}
+		resp.Pin = s.pins.ToProto(pin, secretEntity.CurrentVersion)
+		if created {
+			resp.PinToken = &token
+		}
+		auditArgs = append(auditArgs, "pin", strconv.FormatUint(uint64(pin.ID), 10))
+	}
+
+	// The read first: it is the event the audit entry is indexed by
+	auditevent.Record(ctx, auditevent.SecretPasswordRead, auditevent.ResourceSecret, req.Id, auditArgs...)
+	if created {
+		auditevent.Record(ctx, auditevent.SecretVersionPinned, auditevent.ResourceSecret, req.Id, "pin", strconv.FormatUint(uint64(pin.ID), 10), "version", strconv.Itoa(version))
+	}
+	s.accessTracker.Record(ctx, tenantID, req.Id, secretEntity.FolderID)
+	s.notifySensitiveRead(ctx, tenantID, userID, secretEntity, version)
+	s.canary.trip(ctx, tenantID, secretEntity, "GetSecretPassword")
+
+	return resp, nil
+}
+
+// readPassword reads a version of a secret's password from Vault, the
+// current one when version is unset. The caller wipes the buffer.
+func (s *SecretService) readPassword(ctx context.Context, tenantID uint32, secretEntity *ent.Secret, version *int32) (*vault.SecretBuffer, int, error) {
+	if version != nil && *version > 0 {
+		versionEntity, err := s.versionRepo.GetBySecretAndVersion(ctx, tenantID, secretEntity.ID, *version)
+		if err != nil {
+			return nil, 0, err
+		}
 		if versionEntity == nil {
-			return nil, wardenV1.ErrorVersionNotFound("version not found")
+			return nil, 0, wardenV1.ErrorVersionNotFound("version not found")
 		}
-		password, err = s.kvStore.GetPasswordVersion(ctx, secretEntity.VaultPath, int(*req.Version))
+		password, err := s.kvStore.GetPasswordVersion(ctx, secretEntity.VaultPath, int(*version))
 		if err != nil {
-			s.log.Errorf("failed to get password version %d from Vault: %v", *req.Version, err)
-			return nil, wardenV1.ErrorVaultOperationError("failed to retrieve password")
+			s.log.Errorf("failed to get password version %d from Vault: %v", *version, err)
+			return nil, 0, wardenV1.ErrorVaultOperationError("failed to retrieve password")
+		}
+		return password, int(*version), nil
+	}
+
+	password, current, err := s.kvStore.GetPassword(ctx, secretEntity.VaultPath)
+	if err != nil {
+		s.log.Errorf("failed to get password from Vault: %v", err)
+		return nil, 0, wardenV1.ErrorVaultOperationError("failed to retrieve password")
+	}
+	return password, current, nil
+}
+
+// maskedRevealMinLength is the shortest password whose first and last
+// characters are shown; for shorter ones they would give too much away
+const maskedRevealMinLength = 8
+
+// GetSecretPasswordMasked returns a hint of a password instead of the
+// password: its length, first and last characters and the start of its
+// checksum. The checksum is keyed like those of versions, so the prefix
+// cannot be checked against guessed passwords without the key.
+func (s *SecretService) GetSecretPasswordMasked(ctx context.Context, req *wardenV1.GetSecretPasswordMaskedRequest) (*wardenV1.GetSecretPasswordMaskedResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadSecret(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
+	}
+
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if secretEntity == nil {
+		return nil, wardenV1.ErrorSecretNotFound("secret not found")
+	}
+
+	if err := s.stepUp.check(ctx, secretEntity); err != nil {
+		return nil, err
+	}
+
+	// Hints of many versions add up, so they share the password rate limit
+	if err := s.checkPasswordAccessRate(userID, req.Id); err != nil {
+		return nil, err
+	}
+
+	buf, version, err := s.readPassword(ctx, tenantID, secretEntity, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	defer buf.Wipe()
+	password := buf.Bytes()
+
+	checksum := s.kvStore.ChecksumBuffer(buf)
+	resp := &wardenV1.GetSecretPasswordMaskedResponse{
+		Version:           int32(version),
+		Encoding:          data.PasswordEncodingToProto(secretEntity.PasswordEncoding),
+		ChecksumPrefix:    checksum.Value[:8],
+		ChecksumAlgorithm: checksum.Algorithm,
+	}
+	if secretEntity.PasswordEncoding == secret.PasswordEncodingPASSWORD_ENCODING_BASE64 {
+		// Characters of binary data mean nothing; report the decoded size
+		raw := make([]byte, base64.StdEncoding.DecodedLen(len(password)))
+		if n, err := base64.StdEncoding.Decode(raw, password); err == nil {
+			resp.Length = int32(n)
 		}
-		version = int(*req.Version)
+		clear(raw)
 	} else {
-		// Get current version
-		password, version, err = s.kvStore.GetPassword(ctx, secretEntity.VaultPath)
+		n := utf8.RuneCount(password)
+		resp.Length = int32(n)
+		if n >= maskedRevealMinLength {
+			first, _ := utf8.DecodeRune(password)
+			last, _ := utf8.DecodeLastRune(password)
+			resp.FirstChar = string(first)
+			resp.LastChar = string(last)
+		}
+	}
+
+	auditevent.Record(ctx, auditevent.SecretPasswordPeeked, auditevent.ResourceSecret, req.Id, "version", strconv.Itoa(version))
+	s.notifySensitiveRead(ctx, tenantID, userID, secretEntity, version)
+	s.canary.trip(ctx, tenantID, secretEntity, "GetSecretPasswordMasked")
+
+	return resp, nil
+}
+
+// defaultRetrievalTokenTTL is how long a retrieval token is valid when the
+// request does not say
+const defaultRetrievalTokenTTL = 60 * time.Second
+
+// CreateRetrievalToken issues a short-lived token that RedeemRetrievalToken
+// exchanges for a version of the password exactly once. Frontends pass the
+// token on instead of holding the password themselves.
+func (s *SecretService) CreateRetrievalToken(ctx context.Context, req *wardenV1.CreateRetrievalTokenRequest) (*wardenV1.CreateRetrievalTokenResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadSecret(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
+	}
+
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if secretEntity == nil {
+		return nil, wardenV1.ErrorSecretNotFound("secret not found")
+	}
+
+	if err := s.stepUp.check(ctx, secretEntity); err != nil {
+		return nil, err
+	}
+
+	// Pin the version so the token reads what was current when it was issued
+	version := secretEntity.CurrentVersion
+	if req.Version != nil && *req.Version > 0 {
+		versionEntity, err := s.versionRepo.GetBySecretAndVersion(ctx, tenantID, req.Id, *req.Version)
 		if err != nil {
-			s.log.Errorf("failed to get password from Vault: %v", err)
-			return nil, wardenV1.ErrorVaultOperationError("failed to retrieve password")
+			return nil, err
 		}
+		if versionEntity == nil {
+			return nil, wardenV1.ErrorVersionNotFound("version not found")
+		}
+		version = *req.Version
+	}
+
+	ttl := defaultRetrievalTokenTTL
+	if req.TtlSeconds != nil {
+		ttl = time.Duration(*req.TtlSeconds) * time.Second
 	}
 
-	return &wardenV1.GetSecretPasswordResponse{
-		Password: password,
+	token, err := s.tokens.Issue(ctx, data.RetrievalToken{
+		TenantID: tenantID,
+		UserID:   userID,
+		SecretID: req.Id,
+		Version:  version,
+	}, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Retrieval token issued: user=%s secret=%s version=%d ttl=%s", userID, req.Id, version, ttl)
+
+	return &wardenV1.CreateRetrievalTokenResponse{
+		Token:     token,
+		Version:   version,
+		ExpiresAt: timestamppb.New(time.Now().Add(ttl)),
+	}, nil
+}
+
+// RedeemRetrievalToken returns the password a retrieval token was issued for
+// and invalidates the token. Only the user the token was issued to can redeem
+// it, and only while they can still read the secret.
+func (s *SecretService) RedeemRetrievalToken(ctx context.Context, req *wardenV1.RedeemRetrievalTokenRequest) (*wardenV1.RedeemRetrievalTokenResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	t, err := s.tokens.Redeem(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	// A token of another user is burnt as well, so a leaked token reads nothing
+	if t == nil || t.TenantID != tenantID || t.UserID != userID {
+		return nil, wardenV1.ErrorRetrievalTokenNotFound("retrieval token is invalid, expired or already redeemed")
+	}
+
+	if err := s.checker.CanReadSecret(ctx, tenantID, userID, t.SecretID); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
+	}
+
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, t.SecretID)
+	if err != nil {
+		return nil, err
+	}
+	if secretEntity == nil {
+		return nil, wardenV1.ErrorSecretNotFound("secret not found")
+	}
+
+	if err := s.checkPasswordAccessRate(userID, t.SecretID); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Password access: user=%s secret=%s via=retrieval_token", userID, t.SecretID)
+
+	password, version, err := s.readPassword(ctx, tenantID, secretEntity, &t.Version)
+	if err != nil {
+		return nil, err
+	}
+	defer password.Wipe()
+
+	auditevent.Record(ctx, auditevent.SecretPasswordRead, auditevent.ResourceSecret, t.SecretID,
+		"version", strconv.Itoa(version), "retrieval_token", "true")
+	s.accessTracker.Record(ctx, tenantID, t.SecretID, secretEntity.FolderID)
+	s.notifySensitiveRead(ctx, tenantID, userID, secretEntity, version)
+	s.canary.trip(ctx, tenantID, secretEntity, "RedeemRetrievalToken")
+
+	return &wardenV1.RedeemRetrievalTokenResponse{
+		SecretId: t.SecretID,
+		Password: password.Reveal(),
 		Version:  int32(version),
+		Encoding: data.PasswordEncodingToProto(secretEntity.PasswordEncoding),
 	}, nil
 }
 
+// GetSecretByPath returns the current password and selected metadata of a
+// secret addressed by folder path and name. The secret is found with one
+// query and permission is checked once, for that secret only.
+func (s *SecretService) GetSecretByPath(ctx context.Context, req *wardenV1.GetSecretByPathRequest) (*wardenV1.GetSecretByPathResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	secretEntity, err := s.secretRepo.GetByPath(ctx, tenantID, req.FolderPath, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	// Same error for missing and unreadable secrets so paths cannot be probed
+	if secretEntity == nil {
+		return nil, wardenV1.ErrorSecretNotFound("secret not found")
+	}
+	if err := s.checker.CanReadSecret(ctx, tenantID, userID, secretEntity.ID); err != nil {
+		return nil, wardenV1.ErrorSecretNotFound("secret not found")
+	}
+
+	if err := s.stepUp.check(ctx, secretEntity); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPasswordAccessRate(userID, secretEntity.ID); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Password access by path: user=%s secret=%s", userID, secretEntity.ID)
+
+	password, version, err := s.kvStore.GetPassword(ctx, secretEntity.VaultPath)
+	if err != nil {
+		s.log.Errorf("failed to get password from Vault: %v", err)
+		return nil, wardenV1.ErrorVaultOperationError("failed to retrieve password")
+	}
+	defer password.Wipe()
+
+	auditevent.Record(ctx, auditevent.SecretPasswordRead, auditevent.ResourceSecret, secretEntity.ID, "version", strconv.Itoa(version))
+	s.accessTracker.Record(ctx, tenantID, secretEntity.ID, secretEntity.FolderID)
+	s.notifySensitiveRead(ctx, tenantID, userID, secretEntity, version)
+	s.canary.trip(ctx, tenantID, secretEntity, "GetSecretByPath")
+
+	if req.ResolveReferences {
+		secretEntity.Metadata = s.resolveSecretReferences(ctx, tenantID, userID, secretEntity)
+	}
+
+	resp := &wardenV1.GetSecretByPathResponse{
+		Id:       secretEntity.ID,
+		Password: password.Reveal(),
+		Version:  int32(version),
+		Username: secretEntity.Username,
+		HostUrl:  secretEntity.HostURL,
+		Metadata: selectMetadata(secretEntity, req.MetadataKeys),
+	}
+	if secretEntity.UpdateTime != nil {
+		resp.UpdateTime = timestamppb.New(*secretEntity.UpdateTime)
+	}
+
+	return resp, nil
+}
+
 // ListSecrets lists secrets in a folder
 func (s *SecretService) ListSecrets(ctx context.Context, req *wardenV1.ListSecretsRequest) (*wardenV1.ListSecretsResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
@@ -342,25 +836,78 @@ func (s *SecretService) ListSecrets(ctx context.Context, req *wardenV1.ListSecre
 		status = &s
 	}
 
-	secrets, total, err := s.secretRepo.List(ctx, tenantID, req.FolderId, status, req.NameFilter, page, pageSize)
+	after, err := data.DecodeCursor(req.GetCursor())
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter secrets by permission. The total reflects the unfiltered row
-	// count from the repo so the client's pager shows the right number of
-	// pages; permission-inaccessible rows simply don't appear on the page.
-	accessibleSecrets := make([]*wardenV1.Secret, 0, len(secrets))
+	order := listSort(req.GetSortBy(), req.GetSortOrder())
+
+	var notAccessedSince *time.Time
+	if req.NotAccessedSince != nil {
+		t := req.NotAccessedSince.AsTime()
+		notAccessedSince = &t
+	}
+
+	selector, err := data.ParseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	projection := listProjection(req.IdsOnly, req.CountOnly)
+
+	var secrets []*ent.Secret
+	var total int
+	if req.AccessibleOnly {
+		secretIDs, err := s.checker.ListAccessibleSecrets(ctx, tenantID, userID)
+		if err != nil {
+			return nil, err
+		}
+		folderIDs, err := s.checker.ListAccessibleFolders(ctx, tenantID, userID)
+		if err != nil {
+			return nil, err
+		}
+		collectionIDs, err := s.checker.ListAccessibleCollections(ctx, tenantID, userID)
+		if err != nil {
+			return nil, err
+		}
+		secrets, total, err = s.secretRepo.ListAccessible(ctx, tenantID, secretIDs, folderIDs, collectionIDs, req.FolderId, status, req.NameFilter, notAccessedSince, selector, order, after, page, pageSize, projection)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		secrets, total, err = s.secretRepo.List(ctx, tenantID, req.FolderId, status, req.NameFilter, notAccessedSince, selector, order, after, page, pageSize, projection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if projection == data.ProjectCount {
+		return &wardenV1.ListSecretsResponse{Total: uint32(total)}, nil
+	}
+
+	// Filter secrets by permission. Without accessible_only the total reflects
+	// the unfiltered row count from the repo so the client's pager shows the
+	// right number of pages; permission-inaccessible rows simply don't appear
+	// on the page.
+	resp := &wardenV1.ListSecretsResponse{
+		Total: uint32(total),
+	}
 	for _, sec := range secrets {
-		if err := s.checker.CanReadSecret(ctx, tenantID, userID, sec.ID); err == nil {
-			accessibleSecrets = append(accessibleSecrets, s.secretRepo.ToProto(sec))
+		if err := s.checker.CanReadSecret(ctx, tenantID, userID, sec.ID); err != nil {
+			continue
+		}
+		if projection == data.ProjectIDs {
+			resp.Ids = append(resp.Ids, sec.ID)
+		} else {
+			resp.Secrets = append(resp.Secrets, s.secretRepo.ToProto(sec))
 		}
 	}
 
-	return &wardenV1.ListSecretsResponse{
-		Secrets: accessibleSecrets,
-		Total:   uint32(total),
-	}, nil
+	if hasNextPage(len(secrets), after != nil, page, pageSize, total) {
+		resp.NextCursor = data.SecretCursor(secrets[len(secrets)-1], order)
+	}
+	return resp, nil
 }
 
 // UpdateSecret updates secret metadata
@@ -373,9 +920,42 @@ func (s *SecretService) UpdateSecret(ctx context.Context, req *wardenV1.UpdateSe
 		return nil, wardenV1.ErrorAccessDenied("no permission to modify this secret")
 	}
 
+	// Only owners decide whose reads they are notified about
+	if req.Sensitive != nil {
+		if _, relation := s.checker.GetEffectivePermissions(ctx, tenantID, userID, authz.ResourceTypeSecret, req.Id); relation != authz.RelationOwner {
+			return nil, wardenV1.ErrorAccessDenied("only owners can change whether a secret is sensitive")
+		}
+	}
+	if req.Canary != nil {
+		if _, relation := s.checker.GetEffectivePermissions(ctx, tenantID, userID, authz.ResourceTypeSecret, req.Id); relation != authz.RelationOwner {
+			return nil, wardenV1.ErrorAccessDenied("only owners can change whether a secret is a canary")
+		}
+	}
+	// Lifting protection would let a single owner delete the secret after all
+	if req.Protected != nil {
+		if *req.Protected {
+			if _, relation := s.checker.GetEffectivePermissions(ctx, tenantID, userID, authz.ResourceTypeSecret, req.Id); relation != authz.RelationOwner {
+				return nil, wardenV1.ErrorAccessDenied("only owners can protect a secret")
+			}
+		} else if !isPlatformAdmin(ctx) {
+			return nil, wardenV1.ErrorAccessDenied("only platform admins can lift the protection of a secret")
+		}
+	}
+
 	var metadata map[string]any
 	if req.Metadata != nil {
 		metadata = req.Metadata.AsMap()
+
+		folderID, err := s.secretRepo.GetSecretFolderID(ctx, tenantID, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if err := enforceMetadataSchema(ctx, s.folderRepo, tenantID, folderID, metadata); err != nil {
+			return nil, err
+		}
+		if err := s.checkSecretReferences(ctx, tenantID, userID, req.Id, metadata); err != nil {
+			return nil, err
+		}
 	}
 
 	var status *secret.Status
@@ -387,17 +967,22 @@ func (s *SecretService) UpdateSecret(ctx context.Context, req *wardenV1.UpdateSe
 	// Capture old status for metrics tracking
 	var oldStatus secret.Status
 	if status != nil {
-		existing, err := s.secretRepo.GetByIDAndTenant(ctx, tenantID, req.Id)
+		existing, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
 		if err != nil {
 			return nil, err
 		}
 		if existing != nil {
 			oldStatus = existing.Status
+			if oldStatus == secret.StatusSECRET_STATUS_DELETED && *status != secret.StatusSECRET_STATUS_DELETED {
+				if err := s.checkFolderNotTrashed(ctx, tenantID, existing); err != nil {
+					return nil, err
+				}
+			}
 		}
 	}
 
 	updatedBy := getUserIDAsUint32(ctx)
-	secretEntity, err := s.secretRepo.Update(ctx, tenantID, req.Id, req.Name, req.Username, req.HostUrl, req.Description, metadata, status, updatedBy)
+	secretEntity, err := s.secretRepo.Update(ctx, tenantID, req.Id, req.Name, req.Username, req.HostUrl, req.Description, metadata, status, req.Sensitive, req.ExpectedRevision, updatedBy)
 	if err != nil {
 		return nil, err
 	}
@@ -406,10 +991,29 @@ func (s *SecretService) UpdateSecret(ctx context.Context, req *wardenV1.UpdateSe
 		s.metrics.SecretStatusChanged(string(oldStatus), string(*status))
 	}
 
+	if req.Canary != nil {
+		if err := s.secretRepo.SetCanary(ctx, tenantID, req.Id, *req.Canary); err != nil {
+			return nil, err
+		}
+		secretEntity.Canary = *req.Canary
+	}
+
+	var auditAttrs []string
+	if req.Protected != nil {
+		if err := s.secretRepo.SetProtected(ctx, tenantID, req.Id, *req.Protected); err != nil {
+			return nil, err
+		}
+		secretEntity.Protected = *req.Protected
+		auditAttrs = append(auditAttrs, "protected", strconv.FormatBool(*req.Protected))
+	}
+
+	auditevent.Record(ctx, auditevent.SecretUpdated, auditevent.ResourceSecret, req.Id, auditAttrs...)
+	s.changes.notify(tenantID)
+
 	s.log.Infof("Secret updated: id=%s user=%s", req.Id, userID)
 
 	return &wardenV1.UpdateSecretResponse{
-		Secret: s.secretRepo.ToProto(secretEntity),
+		Secret: s.toOwnerProto(ctx, tenantID, userID, secretEntity),
 	}, nil
 }
 
@@ -423,7 +1027,7 @@ func (s *SecretService) UpdateSecretPassword(ctx context.Context, req *wardenV1.
 		return nil, wardenV1.ErrorAccessDenied("no permission to modify this secret")
 	}
 
-	secretEntity, err := s.secretRepo.GetByIDAndTenant(ctx, tenantID, req.Id)
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -431,38 +1035,75 @@ func (s *SecretService) UpdateSecretPassword(ctx context.Context, req *wardenV1.
 		return nil, wardenV1.ErrorSecretNotFound("secret not found")
 	}
 
+	if err := s.limits.checkPassword(req.Password, req.PasswordEncoding); err != nil {
+		return nil, err
+	}
+	encoding := data.PasswordEncodingFromProto(req.PasswordEncoding)
+	if req.PasswordEncoding != wardenV1.PasswordEncoding_PASSWORD_ENCODING_BASE64 {
+		if err := enforcePasswordPolicy(ctx, s.settings, s.versionRepo, s.kvStore.Checksums(), tenantID, secretEntity.ID, req.Password); err != nil {
+			return nil, err
+		}
+	}
+
 	// Store new password in Vault (creates new version)
 	newVersion, err := s.kvStore.StorePassword(ctx, secretEntity.VaultPath, req.Password, nil)
 	if err != nil {
 		return nil, wardenV1.ErrorVaultOperationError("failed to store password")
 	}
 
-	// Create version record
+	// Record the version and move the secret to it atomically
 	createdBy := getUserIDAsUint32(ctx)
-	checksum := vault.CalculateChecksum(req.Password)
-	versionEntity, err := s.versionRepo.Create(ctx, secretEntity.ID, int32(newVersion), secretEntity.VaultPath, req.Comment, checksum, createdBy)
-	if err != nil {
-		s.log.Errorf("failed to create version record for secret %s: %v", secretEntity.ID, err)
-		return nil, wardenV1.ErrorInternalServerError("failed to create version record")
-	}
+	checksum := s.kvStore.Checksum(req.Password)
+	var versionEntity *ent.SecretVersion
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		versionEntity, err = s.versionRepo.Create(ctx, secretEntity.ID, int32(newVersion), secretEntity.VaultPath, req.Comment, checksum, nil, createdBy)
+		if err != nil {
+			s.log.Errorf("failed to create version record for secret %s: %v", secretEntity.ID, err)
+			return wardenV1.ErrorInternalServerError("failed to create version record")
+		}
 
-	// Update secret's current version
-	secretEntity, err = s.secretRepo.UpdateVersion(ctx, tenantID, req.Id, int32(newVersion), createdBy)
+		if encoding != secretEntity.PasswordEncoding {
+			if err := s.secretRepo.SetPasswordEncoding(ctx, tenantID, req.Id, encoding); err != nil {
+				return err
+			}
+		}
+
+		secretEntity, err = s.secretRepo.UpdateVersion(ctx, tenantID, req.Id, int32(newVersion), createdBy)
+		return err
+	})
 	if err != nil {
+		s.log.Warnf("Vault version %d of secret %s has no version record", newVersion, req.Id)
 		return nil, err
 	}
 
 	s.metrics.SecretVersionCreated()
 
+	auditevent.Record(ctx, auditevent.SecretPasswordUpdated, auditevent.ResourceSecret, req.Id, "version", strconv.Itoa(newVersion))
+	s.changes.notify(tenantID)
+
 	s.log.Infof("Secret password updated: id=%s version=%d user=%s", req.Id, newVersion, userID)
 
-	return &wardenV1.UpdateSecretPasswordResponse{
+	resp := &wardenV1.UpdateSecretPasswordResponse{
 		Secret:  s.secretRepo.ToProto(secretEntity),
 		Version: s.versionRepo.ToProto(versionEntity),
-	}, nil
+	}
+
+	// Report the consumers the rotation left behind; the password is already
+	// stored, so a failure here only loses the report
+	stale, err := s.pins.ListBySecret(ctx, tenantID, req.Id, int32(newVersion))
+	if err != nil {
+		s.log.Warnf("failed to list version pins of secret %s: %v", req.Id, err)
+	}
+	for _, pin := range stale {
+		resp.StalePins = append(resp.StalePins, s.pins.ToProto(pin, int32(newVersion)))
+	}
+
+	return resp, nil
 }
 
-// DeleteSecret deletes a secret
+// DeleteSecret deletes a secret. Permanently deleting a protected secret
+// needs an approved deletion request instead.
 func (s *SecretService) DeleteSecret(ctx context.Context, req *wardenV1.DeleteSecretRequest) (*emptypb.Empty, error) {
 	tenantID := getTenantIDFromContext(ctx)
 	userID := getUserIDFromContext(ctx)
@@ -472,7 +1113,7 @@ func (s *SecretService) DeleteSecret(ctx context.Context, req *wardenV1.DeleteSe
 		return nil, wardenV1.ErrorAccessDenied("no permission to delete this secret")
 	}
 
-	secretEntity, err := s.secretRepo.GetByIDAndTenant(ctx, tenantID, req.Id)
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -481,39 +1122,116 @@ func (s *SecretService) DeleteSecret(ctx context.Context, req *wardenV1.DeleteSe
 	}
 
 	if req.Permanent {
-		// Delete from Vault
-		if err := s.kvStore.DestroyAllVersions(ctx, secretEntity.VaultPath); err != nil {
-			s.log.Warnf("failed to destroy password in Vault: %v", err)
+		if err := checkLegalHold(ctx, s.folderRepo, tenantID, secretEntity.FolderID); err != nil {
+			return nil, err
+		}
+
+		protected, err := s.isProtected(ctx, tenantID, secretEntity)
+		if err != nil {
+			return nil, err
+		}
+		if protected {
+			return nil, wardenV1.ErrorDeletionApprovalRequired("secret is protected, request its deletion for a second owner to approve")
+		}
+	}
+
+	if !req.Force {
+		if err := s.checkDependents(ctx, tenantID, userID, req.Id); err != nil {
+			return nil, err
 		}
+	}
+
+	if err := s.deleteSecret(ctx, secretEntity, req.Permanent); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// isProtected reports whether permanently deleting a secret needs a second
+// owner's approval, because it or a folder above it is protected
+func (s *SecretService) isProtected(ctx context.Context, tenantID uint32, secretEntity *ent.Secret) (bool, error) {
+	if secretEntity.Protected {
+		return true, nil
+	}
+	if secretEntity.FolderID == nil {
+		return false, nil
+	}
+	return s.folderRepo.IsProtected(ctx, tenantID, *secretEntity.FolderID)
+}
 
-		// Delete TOTP from Vault if configured
-		if secretEntity.HasTotp {
-			totpPath := s.kvStore.BuildTotpPath(tenantID, req.Id)
-			if err := s.kvStore.DeleteTotp(ctx, totpPath); err != nil {
-				s.log.Warnf("failed to delete TOTP from Vault: %v", err)
+// deleteSecret deletes a secret once the caller has been authorized
+func (s *SecretService) deleteSecret(ctx context.Context, secretEntity *ent.Secret, permanent bool) error {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+	id := secretEntity.ID
+
+	// Remove the rows and schedule the Vault cleanup atomically; the outbox
+	// worker retries the cleanup if it fails here
+	var pendingDestroy *ent.PendingOperation
+	err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		if permanent {
+			if err := s.versionRepo.DeleteBySecretID(ctx, id); err != nil {
+				return err
 			}
 		}
 
-		// Delete version records
-		if err := s.versionRepo.DeleteBySecretID(ctx, req.Id); err != nil {
-			s.log.Warnf("failed to delete version records: %v", err)
+		if err := s.secretRepo.Delete(ctx, tenantID, id, permanent); err != nil {
+			return err
 		}
-	}
 
-	if err := s.secretRepo.Delete(ctx, tenantID, req.Id, req.Permanent); err != nil {
-		return nil, err
+		if err := s.permRepo.DeleteByResource(ctx, tenantID, string(authz.ResourceTypeSecret), id); err != nil {
+			return err
+		}
+
+		if permanent {
+			if err := s.pins.DeleteBySecretID(ctx, tenantID, id); err != nil {
+				return err
+			}
+
+			var totpPath string
+			if secretEntity.HasTotp {
+				totpPath = s.kvStore.BuildTotpPath(tenantID, id)
+			}
+			var err error
+			pendingDestroy, err = s.pendingOps.ScheduleVaultDestroy(ctx, tenantID, id, secretEntity.VaultPath, totpPath)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	// Delete associated permissions
-	if err := s.permRepo.DeleteByResource(ctx, tenantID, string(authz.ResourceTypeSecret), req.Id); err != nil {
-		s.log.Warnf("Failed to delete permissions for secret %s: %v", req.Id, err)
+	if pendingDestroy != nil {
+		if err := s.pendingOps.Process(ctx, pendingDestroy); err != nil {
+			s.log.Warnf("failed to destroy Vault data of secret %s, leaving it to the outbox worker: %v", id, err)
+		}
 	}
 
 	s.metrics.SecretDeleted(string(secretEntity.Status))
 
-	s.log.Infof("Secret deleted: id=%s permanent=%v user=%s", req.Id, req.Permanent, userID)
+	auditevent.Record(ctx, auditevent.SecretDeleted, auditevent.ResourceSecret, id, "permanent", strconv.FormatBool(permanent))
+	s.changes.notify(tenantID)
 
-	return &emptypb.Empty{}, nil
+	s.log.Infof("Secret deleted: id=%s permanent=%v user=%s", id, permanent, userID)
+
+	return nil
+}
+
+// checkFolderNotTrashed fails for a secret in a folder in the trash, which
+// only comes back by restoring the folder
+func (s *SecretService) checkFolderNotTrashed(ctx context.Context, tenantID uint32, secretEntity *ent.Secret) error {
+	if secretEntity.FolderID == nil {
+		return nil
+	}
+	trashed, err := s.folderRepo.IsTrashed(ctx, tenantID, *secretEntity.FolderID)
+	if err != nil {
+		return err
+	}
+	if trashed {
+		return wardenV1.ErrorBadRequest("the folder of this secret is in the trash, restore the folder first")
+	}
+	return nil
 }
 
 // MoveSecret moves a secret to a different folder
@@ -533,12 +1251,43 @@ func (s *SecretService) MoveSecret(ctx context.Context, req *wardenV1.MoveSecret
 		}
 	}
 
+	// Moving a secret out of a held folder would release it from the hold
+	current, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, wardenV1.ErrorSecretNotFound("secret not found")
+	}
+	if err := checkLegalHold(ctx, s.folderRepo, tenantID, current.FolderID); err != nil {
+		return nil, err
+	}
+	if err := s.checkFolderNotTrashed(ctx, tenantID, current); err != nil {
+		return nil, err
+	}
+	if req.NewFolderId != nil && *req.NewFolderId != "" {
+		folder, err := s.folderRepo.GetByID(ctx, tenantID, *req.NewFolderId)
+		if err != nil {
+			return nil, err
+		}
+		if folder == nil {
+			return nil, wardenV1.ErrorFolderNotFound("folder not found")
+		}
+	}
+
 	updatedBy := getUserIDAsUint32(ctx)
 	secretEntity, err := s.secretRepo.Move(ctx, tenantID, req.Id, req.NewFolderId, updatedBy)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := retainHeldVersions(ctx, s.folderRepo, s.kvStore, tenantID, req.NewFolderId, secretEntity); err != nil {
+		s.log.Warnf("failed to lift version retention of secret %s under legal hold: %v", req.Id, err)
+	}
+
+	auditevent.Record(ctx, auditevent.SecretMoved, auditevent.ResourceSecret, req.Id, "folder_id", derefString(req.NewFolderId))
+	s.changes.notify(tenantID)
+
 	s.log.Infof("Secret moved: id=%s newFolder=%v user=%s", req.Id, req.NewFolderId, userID)
 
 	return &wardenV1.MoveSecretResponse{
@@ -565,20 +1314,85 @@ func (s *SecretService) ListVersions(ctx context.Context, req *wardenV1.ListVers
 		pageSize = *req.PageSize
 	}
 
-	versions, total, err := s.versionRepo.List(ctx, tenantID, req.SecretId, page, pageSize)
+	after, err := data.DecodeCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := data.VersionFilter{RestoredOnly: req.GetRestoredOnly()}
+
+	// Vault's view of the versions, merged into the rows when asked for
+	var vaultVersions map[int32]*vault.VersionInfo
+	now := time.Now()
+	if req.GetIncludeVaultState() || req.GetRetrievableOnly() {
+		secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.SecretId)
+		if err != nil {
+			return nil, err
+		}
+		if secretEntity == nil {
+			return nil, wardenV1.ErrorSecretNotFound("secret not found")
+		}
+		infos, err := s.kvStore.ListVersions(ctx, secretEntity.VaultPath)
+		if err != nil {
+			s.log.Errorf("failed to list versions of secret %s in Vault: %v", req.SecretId, err)
+			return nil, wardenV1.ErrorVaultOperationError("failed to read version metadata")
+		}
+		vaultVersions = make(map[int32]*vault.VersionInfo, len(infos))
+		for i := range infos {
+			vaultVersions[int32(infos[i].Version)] = &infos[i]
+		}
+		if req.GetRetrievableOnly() {
+			filter.VersionNumbers = []int32{}
+			for number, info := range vaultVersions {
+				if vaultVersionState(info, now).Retrievable {
+					filter.VersionNumbers = append(filter.VersionNumbers, number)
+				}
+			}
+		}
+	}
+
+	versions, total, err := s.versionRepo.List(ctx, tenantID, req.SecretId, filter, after, page, pageSize)
 	if err != nil {
 		return nil, err
 	}
 
 	protoVersions := make([]*wardenV1.SecretVersion, 0, len(versions))
 	for _, v := range versions {
-		protoVersions = append(protoVersions, s.versionRepo.ToProto(v))
+		proto := s.versionRepo.ToProto(v)
+		if vaultVersions != nil {
+			proto.VaultState = vaultVersionState(vaultVersions[v.VersionNumber], now)
+		}
+		protoVersions = append(protoVersions, proto)
 	}
 
-	return &wardenV1.ListVersionsResponse{
+	resp := &wardenV1.ListVersionsResponse{
 		Versions: protoVersions,
 		Total:    uint32(total),
-	}, nil
+	}
+	if hasNextPage(len(versions), after != nil, page, pageSize, total) {
+		resp.NextCursor = data.VersionCursor(versions[len(versions)-1])
+	}
+	return resp, nil
+}
+
+// vaultVersionState describes a version as Vault sees it at now; info is nil
+// when Vault no longer knows the version. Vault reports a deletion time in the
+// future for versions that delete_version_after will delete.
+func vaultVersionState(info *vault.VersionInfo, now time.Time) *wardenV1.VaultVersionState {
+	if info == nil {
+		return &wardenV1.VaultVersionState{Missing: true}
+	}
+
+	state := &wardenV1.VaultVersionState{Destroyed: info.Destroyed}
+	if t, err := time.Parse(time.RFC3339, info.CreatedAt); err == nil {
+		state.CreateTime = timestamppb.New(t)
+	}
+	if t, err := time.Parse(time.RFC3339, info.DeletedAt); err == nil {
+		state.DeletionTime = timestamppb.New(t)
+		state.Deleted = !t.After(now)
+	}
+	state.Retrievable = !state.Deleted && !state.Destroyed
+	return state
 }
 
 // GetVersion gets a specific version
@@ -604,6 +1418,16 @@ func (s *SecretService) GetVersion(ctx context.Context, req *wardenV1.GetVersion
 	}
 
 	if req.IncludePassword {
+		secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.SecretId)
+		if err != nil {
+			return nil, err
+		}
+		if secretEntity == nil {
+			return nil, wardenV1.ErrorSecretNotFound("secret not found")
+		}
+		if err := s.stepUp.check(ctx, secretEntity); err != nil {
+			return nil, err
+		}
 		if err := s.checkPasswordAccessRate(userID, req.SecretId); err != nil {
 			return nil, err
 		}
@@ -611,7 +1435,12 @@ func (s *SecretService) GetVersion(ctx context.Context, req *wardenV1.GetVersion
 		if err != nil {
 			s.log.Warnf("failed to get password from Vault: %v", err)
 		} else {
-			resp.Password = &password
+			revealed := password.Reveal()
+			password.Wipe()
+			resp.Password = &revealed
+			auditevent.Record(ctx, auditevent.SecretPasswordRead, auditevent.ResourceSecret, req.SecretId, "version", strconv.Itoa(int(req.VersionNumber)))
+			s.notifySensitiveRead(ctx, tenantID, userID, secretEntity, int(req.VersionNumber))
+			s.canary.trip(ctx, tenantID, secretEntity, "GetVersion")
 		}
 	}
 
@@ -628,7 +1457,7 @@ func (s *SecretService) RestoreVersion(ctx context.Context, req *wardenV1.Restor
 		return nil, wardenV1.ErrorAccessDenied("no permission to modify this secret")
 	}
 
-	secretEntity, err := s.secretRepo.GetByIDAndTenant(ctx, tenantID, req.SecretId)
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.SecretId)
 	if err != nil {
 		return nil, err
 	}
@@ -646,10 +1475,12 @@ func (s *SecretService) RestoreVersion(ctx context.Context, req *wardenV1.Restor
 	}
 
 	// Get password from the version to restore
-	password, err := s.kvStore.GetPasswordVersion(ctx, versionEntity.VaultPath, int(req.VersionNumber))
+	buf, err := s.kvStore.GetPasswordVersion(ctx, versionEntity.VaultPath, int(req.VersionNumber))
 	if err != nil {
 		return nil, wardenV1.ErrorVaultOperationError("failed to retrieve password from version")
 	}
+	defer buf.Wipe()
+	password := buf.Reveal()
 
 	// Create new version with the restored password
 	newVersion, err := s.kvStore.StorePassword(ctx, secretEntity.VaultPath, password, nil)
@@ -663,8 +1494,8 @@ func (s *SecretService) RestoreVersion(ctx context.Context, req *wardenV1.Restor
 	if comment == "" {
 		comment = fmt.Sprintf("Restored from version %d", req.VersionNumber)
 	}
-	checksum := vault.CalculateChecksum(password)
-	newVersionEntity, err := s.versionRepo.Create(ctx, secretEntity.ID, int32(newVersion), secretEntity.VaultPath, comment, checksum, createdBy)
+	checksum := s.kvStore.ChecksumBuffer(buf)
+	newVersionEntity, err := s.versionRepo.Create(ctx, secretEntity.ID, int32(newVersion), secretEntity.VaultPath, comment, checksum, &req.VersionNumber, createdBy)
 	if err != nil {
 		s.log.Errorf("failed to create version record for secret %s: %v", secretEntity.ID, err)
 		return nil, wardenV1.ErrorInternalServerError("failed to create version record")
@@ -678,6 +1509,10 @@ func (s *SecretService) RestoreVersion(ctx context.Context, req *wardenV1.Restor
 
 	s.metrics.SecretVersionCreated()
 
+	auditevent.Record(ctx, auditevent.SecretVersionRestored, auditevent.ResourceSecret, req.SecretId,
+		"from_version", strconv.Itoa(int(req.VersionNumber)), "version", strconv.Itoa(newVersion))
+	s.changes.notify(tenantID)
+
 	s.log.Infof("Secret version restored: secret=%s fromVersion=%d newVersion=%d user=%s", req.SecretId, req.VersionNumber, newVersion, userID)
 
 	return &wardenV1.RestoreVersionResponse{
@@ -686,6 +1521,215 @@ func (s *SecretService) RestoreVersion(ctx context.Context, req *wardenV1.Restor
 	}, nil
 }
 
+// DeleteVersion soft-deletes a previous version in Vault. It can be recovered
+// with UndeleteVersion.
+func (s *SecretService) DeleteVersion(ctx context.Context, req *wardenV1.DeleteVersionRequest) (*wardenV1.DeleteVersionResponse, error) {
+	secretEntity, versionEntity, state, err := s.getVersionForChange(ctx, req.SecretId, req.VersionNumber)
+	if err != nil {
+		return nil, err
+	}
+	if !state.Retrievable {
+		return nil, wardenV1.ErrorBadRequest("version is already deleted or destroyed")
+	}
+
+	if err := s.kvStore.DeletePasswordVersions(ctx, secretEntity.VaultPath, []int{int(req.VersionNumber)}); err != nil {
+		s.log.Errorf("failed to delete version %d of secret %s: %v", req.VersionNumber, req.SecretId, err)
+		return nil, wardenV1.ErrorVaultOperationError("failed to delete version")
+	}
+
+	auditevent.Record(ctx, auditevent.SecretVersionDeleted, auditevent.ResourceSecret, req.SecretId,
+		"version", strconv.Itoa(int(req.VersionNumber)))
+	s.log.Infof("Secret version deleted: secret=%s version=%d user=%s", req.SecretId, req.VersionNumber, getUserIDFromContext(ctx))
+
+	version, err := s.versionWithVaultState(ctx, secretEntity, versionEntity)
+	if err != nil {
+		return nil, err
+	}
+	return &wardenV1.DeleteVersionResponse{Version: version}, nil
+}
+
+// DestroyVersion permanently destroys a previous version in Vault. Its record
+// is kept, so the version history and password reuse checks still see it.
+func (s *SecretService) DestroyVersion(ctx context.Context, req *wardenV1.DestroyVersionRequest) (*wardenV1.DestroyVersionResponse, error) {
+	secretEntity, versionEntity, state, err := s.getVersionForChange(ctx, req.SecretId, req.VersionNumber)
+	if err != nil {
+		return nil, err
+	}
+	if state.Destroyed || state.Missing {
+		return nil, wardenV1.ErrorBadRequest("version is already destroyed")
+	}
+	if err := checkLegalHold(ctx, s.folderRepo, getTenantIDFromContext(ctx), secretEntity.FolderID); err != nil {
+		return nil, err
+	}
+
+	if err := s.kvStore.DestroyPassword(ctx, secretEntity.VaultPath, []int{int(req.VersionNumber)}); err != nil {
+		s.log.Errorf("failed to destroy version %d of secret %s: %v", req.VersionNumber, req.SecretId, err)
+		return nil, wardenV1.ErrorVaultOperationError("failed to destroy version")
+	}
+
+	auditevent.Record(ctx, auditevent.SecretVersionDestroyed, auditevent.ResourceSecret, req.SecretId,
+		"version", strconv.Itoa(int(req.VersionNumber)))
+	s.log.Infof("Secret version destroyed: secret=%s version=%d user=%s", req.SecretId, req.VersionNumber, getUserIDFromContext(ctx))
+
+	version, err := s.versionWithVaultState(ctx, secretEntity, versionEntity)
+	if err != nil {
+		return nil, err
+	}
+	return &wardenV1.DestroyVersionResponse{Version: version}, nil
+}
+
+// UndeleteVersion recovers a soft-deleted version
+func (s *SecretService) UndeleteVersion(ctx context.Context, req *wardenV1.UndeleteVersionRequest) (*wardenV1.UndeleteVersionResponse, error) {
+	secretEntity, versionEntity, state, err := s.getVersionForChange(ctx, req.SecretId, req.VersionNumber)
+	if err != nil {
+		return nil, err
+	}
+	if state.Destroyed || state.Missing {
+		return nil, wardenV1.ErrorBadRequest("version was destroyed and cannot be recovered")
+	}
+	if !state.Deleted {
+		return nil, wardenV1.ErrorBadRequest("version is not deleted")
+	}
+
+	if err := s.kvStore.UndeletePassword(ctx, secretEntity.VaultPath, []int{int(req.VersionNumber)}); err != nil {
+		s.log.Errorf("failed to undelete version %d of secret %s: %v", req.VersionNumber, req.SecretId, err)
+		return nil, wardenV1.ErrorVaultOperationError("failed to undelete version")
+	}
+
+	auditevent.Record(ctx, auditevent.SecretVersionUndeleted, auditevent.ResourceSecret, req.SecretId,
+		"version", strconv.Itoa(int(req.VersionNumber)))
+	s.log.Infof("Secret version undeleted: secret=%s version=%d user=%s", req.SecretId, req.VersionNumber, getUserIDFromContext(ctx))
+
+	version, err := s.versionWithVaultState(ctx, secretEntity, versionEntity)
+	if err != nil {
+		return nil, err
+	}
+	return &wardenV1.UndeleteVersionResponse{Version: version}, nil
+}
+
+// getVersionForChange loads a secret and one of its versions for deleting,
+// destroying or undeleting it in Vault, which needs owner rights. The current
+// version cannot be changed, as the secret's password would be lost.
+func (s *SecretService) getVersionForChange(ctx context.Context, secretID string, versionNumber int32) (*ent.Secret, *ent.SecretVersion, *wardenV1.VaultVersionState, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanDeleteSecret(ctx, tenantID, userID, secretID); err != nil {
+		return nil, nil, nil, wardenV1.ErrorAccessDenied("only owners can delete, destroy or undelete versions")
+	}
+
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, secretID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if secretEntity == nil {
+		return nil, nil, nil, wardenV1.ErrorSecretNotFound("secret not found")
+	}
+
+	versionEntity, err := s.versionRepo.GetBySecretAndVersion(ctx, tenantID, secretID, versionNumber)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if versionEntity == nil {
+		return nil, nil, nil, wardenV1.ErrorVersionNotFound("version not found")
+	}
+	if versionNumber == secretEntity.CurrentVersion {
+		return nil, nil, nil, wardenV1.ErrorBadRequest("the current version cannot be changed, store a new password or restore another version first")
+	}
+
+	version, err := s.versionWithVaultState(ctx, secretEntity, versionEntity)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return secretEntity, versionEntity, version.VaultState, nil
+}
+
+// versionWithVaultState returns a version with its current state in Vault
+func (s *SecretService) versionWithVaultState(ctx context.Context, secretEntity *ent.Secret, versionEntity *ent.SecretVersion) (*wardenV1.SecretVersion, error) {
+	infos, err := s.kvStore.ListVersions(ctx, secretEntity.VaultPath)
+	if err != nil {
+		s.log.Errorf("failed to list versions of secret %s in Vault: %v", secretEntity.ID, err)
+		return nil, wardenV1.ErrorVaultOperationError("failed to read version metadata")
+	}
+
+	var info *vault.VersionInfo
+	for i := range infos {
+		if infos[i].Version == int(versionEntity.VersionNumber) {
+			info = &infos[i]
+			break
+		}
+	}
+
+	version := s.versionRepo.ToProto(versionEntity)
+	version.VaultState = vaultVersionState(info, time.Now())
+	return version, nil
+}
+
+// ListVersionPins lists the consumers pinned to versions of a secret
+func (s *SecretService) ListVersionPins(ctx context.Context, req *wardenV1.ListVersionPinsRequest) (*wardenV1.ListVersionPinsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadSecret(ctx, tenantID, userID, req.SecretId); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
+	}
+
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.SecretId)
+	if err != nil {
+		return nil, err
+	}
+	if secretEntity == nil {
+		return nil, wardenV1.ErrorSecretNotFound("secret not found")
+	}
+
+	var belowVersion int32
+	if req.StaleOnly {
+		belowVersion = secretEntity.CurrentVersion
+	}
+	pins, err := s.pins.ListBySecret(ctx, tenantID, req.SecretId, belowVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	protoPins := make([]*wardenV1.VersionPin, 0, len(pins))
+	for _, pin := range pins {
+		protoPins = append(protoPins, s.pins.ToProto(pin, secretEntity.CurrentVersion))
+	}
+	return &wardenV1.ListVersionPinsResponse{Pins: protoPins}, nil
+}
+
+// DeleteVersionPin releases a version pin. Consumers release their own pins;
+// owners can release any pin of the secret.
+func (s *SecretService) DeleteVersionPin(ctx context.Context, req *wardenV1.DeleteVersionPinRequest) (*emptypb.Empty, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadSecret(ctx, tenantID, userID, req.SecretId); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
+	}
+
+	pin, err := s.pins.Get(ctx, tenantID, req.SecretId, req.PinId)
+	if err != nil {
+		return nil, err
+	}
+	if pin == nil {
+		return nil, wardenV1.ErrorNotFound("version pin not found")
+	}
+	if pin.Consumer != userID {
+		if err := s.checker.CanDeleteSecret(ctx, tenantID, userID, req.SecretId); err != nil {
+			return nil, wardenV1.ErrorAccessDenied("only the consumer or an owner can release a version pin")
+		}
+	}
+
+	if err := s.pins.Delete(ctx, tenantID, req.SecretId, req.PinId); err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.SecretVersionPinReleased, auditevent.ResourceSecret, req.SecretId, "pin", strconv.FormatUint(uint64(req.PinId), 10), "version", strconv.Itoa(int(pin.VersionNumber)))
+
+	return &emptypb.Empty{}, nil
+}
+
 // SearchSecrets searches secrets across folders
 func (s *SecretService) SearchSecrets(ctx context.Context, req *wardenV1.SearchSecretsRequest) (*wardenV1.SearchSecretsResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
@@ -706,22 +1750,57 @@ func (s *SecretService) SearchSecrets(ctx context.Context, req *wardenV1.SearchS
 		status = &s
 	}
 
-	secrets, _, err := s.secretRepo.Search(ctx, tenantID, req.Query, req.FolderId, req.IncludeSubfolders, status, page, pageSize)
+	if req.CountOnly {
+		// Total has to leave out unreadable matches, so check the IDs of all of them
+		matches, _, err := s.secretRepo.Search(ctx, tenantID, req.Query, req.FolderId, req.IncludeSubfolders, status, 0, 0, data.ProjectIDs)
+		if err != nil {
+			return nil, err
+		}
+		var total uint32
+		for _, sec := range matches {
+			if err := s.checker.CanReadSecret(ctx, tenantID, userID, sec.ID); err == nil {
+				total++
+			}
+		}
+		return &wardenV1.SearchSecretsResponse{Total: total}, nil
+	}
+
+	projection := listProjection(req.IdsOnly, false)
+	secrets, _, err := s.secretRepo.Search(ctx, tenantID, req.Query, req.FolderId, req.IncludeSubfolders, status, page, pageSize, projection)
 	if err != nil {
 		return nil, err
 	}
 
+	if projection == data.ProjectIDs {
+		ids := make([]string, 0, len(secrets))
+		for _, sec := range secrets {
+			if err := s.checker.CanReadSecret(ctx, tenantID, userID, sec.ID); err == nil {
+				ids = append(ids, sec.ID)
+			}
+		}
+		return &wardenV1.SearchSecretsResponse{
+			Total: uint32(len(ids)),
+			Ids:   ids,
+		}, nil
+	}
+
 	// Filter secrets by permission
 	accessibleSecrets := make([]*wardenV1.Secret, 0, len(secrets))
+	hits := make([]*wardenV1.SecretSearchHit, 0, len(secrets))
 	for _, sec := range secrets {
 		if err := s.checker.CanReadSecret(ctx, tenantID, userID, sec.ID); err == nil {
 			accessibleSecrets = append(accessibleSecrets, s.secretRepo.ToProto(sec))
+			hits = append(hits, &wardenV1.SecretSearchHit{
+				SecretId:   sec.ID,
+				Highlights: data.HighlightSecret(sec, req.Query),
+			})
 		}
 	}
 
 	return &wardenV1.SearchSecretsResponse{
 		Secrets: accessibleSecrets,
 		Total:   uint32(len(accessibleSecrets)),
+		Hits:    hits,
 	}, nil
 }
 
@@ -734,7 +1813,7 @@ func (s *SecretService) GetSecretTotp(ctx context.Context, req *wardenV1.GetSecr
 		return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
 	}
 
-	secretEntity, err := s.secretRepo.GetByIDAndTenant(ctx, tenantID, req.Id)
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -756,6 +1835,8 @@ func (s *SecretService) GetSecretTotp(ctx context.Context, req *wardenV1.GetSecr
 		return nil, wardenV1.ErrorInternalServerError("failed to generate TOTP code")
 	}
 
+	auditevent.Record(ctx, auditevent.SecretTotpRead, auditevent.ResourceSecret, req.Id)
+
 	return &wardenV1.GetSecretTotpResponse{
 		TotpUrl:          totpURL,
 		CurrentCode:      code,
@@ -773,7 +1854,7 @@ func (s *SecretService) SetSecretTotp(ctx context.Context, req *wardenV1.SetSecr
 		return nil, wardenV1.ErrorAccessDenied("no permission to modify this secret")
 	}
 
-	secretEntity, err := s.secretRepo.GetByIDAndTenant(ctx, tenantID, req.Id)
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -798,8 +1879,10 @@ func (s *SecretService) SetSecretTotp(ctx context.Context, req *wardenV1.SetSecr
 		s.log.Warnf("failed to set has_totp flag: %v", err)
 	}
 
+	auditevent.Record(ctx, auditevent.SecretTotpUpdated, auditevent.ResourceSecret, req.Id)
+
 	// Reload entity for response
-	secretEntity, _ = s.secretRepo.GetByIDAndTenant(ctx, tenantID, req.Id)
+	secretEntity, _ = s.secretRepo.GetByID(data.WithPrimary(ctx), tenantID, req.Id)
 
 	return &wardenV1.SetSecretTotpResponse{
 		Secret:           s.secretRepo.ToProto(secretEntity),
@@ -816,7 +1899,7 @@ func (s *SecretService) DeleteSecretTotp(ctx context.Context, req *wardenV1.Dele
 		return nil, wardenV1.ErrorAccessDenied("no permission to modify this secret")
 	}
 
-	secretEntity, err := s.secretRepo.GetByIDAndTenant(ctx, tenantID, req.Id)
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -824,6 +1907,10 @@ func (s *SecretService) DeleteSecretTotp(ctx context.Context, req *wardenV1.Dele
 		return nil, wardenV1.ErrorSecretNotFound("secret not found")
 	}
 
+	if err := checkLegalHold(ctx, s.folderRepo, tenantID, secretEntity.FolderID); err != nil {
+		return nil, err
+	}
+
 	// Delete from Vault
 	totpPath := s.kvStore.BuildTotpPath(tenantID, req.Id)
 	if err := s.kvStore.DeleteTotp(ctx, totpPath); err != nil {
@@ -835,9 +1922,84 @@ func (s *SecretService) DeleteSecretTotp(ctx context.Context, req *wardenV1.Dele
 		s.log.Warnf("failed to clear has_totp flag: %v", err)
 	}
 
+	auditevent.Record(ctx, auditevent.SecretTotpDeleted, auditevent.ResourceSecret, req.Id)
+
 	return &emptypb.Empty{}, nil
 }
 
+// SetSecretAccessPolicy sets the network and time restrictions on access to a
+// secret. Only owners can change them, and they can always do so, so a
+// mistaken policy cannot lock a secret.
+func (s *SecretService) SetSecretAccessPolicy(ctx context.Context, req *wardenV1.SetSecretAccessPolicyRequest) (*wardenV1.SetSecretAccessPolicyResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if _, relation := s.checker.GetEffectivePermissions(ctx, tenantID, userID, authz.ResourceTypeSecret, req.Id); relation != authz.RelationOwner {
+		return nil, wardenV1.ErrorAccessDenied("only secret owners can set the access policy")
+	}
+
+	policy := data.AccessPolicyFromProto(req.Policy)
+	if policy != nil {
+		if err := policy.Validate(); err != nil {
+			return nil, wardenV1.ErrorBadRequest("invalid access policy: %s", err.Error())
+		}
+	}
+
+	secretEntity, err := s.secretRepo.SetAccessPolicy(ctx, tenantID, req.Id, policy, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	auditevent.Record(ctx, auditevent.SecretAccessPolicyChanged, auditevent.ResourceSecret, req.Id,
+		"cleared", strconv.FormatBool(policy.IsEmpty()))
+
+	s.log.Infof("Secret access policy set: id=%s cleared=%t user=%s", req.Id, policy.IsEmpty(), userID)
+
+	return &wardenV1.SetSecretAccessPolicyResponse{
+		Secret: s.secretRepo.ToProto(secretEntity),
+	}, nil
+}
+
+// defaultUsageDays is the usage period when the request does not say
+const defaultUsageDays = 90
+
+// GetSecretUsage returns the daily read and write counts of a secret
+func (s *SecretService) GetSecretUsage(ctx context.Context, req *wardenV1.GetSecretUsageRequest) (*wardenV1.GetSecretUsageResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadSecret(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, wardenV1.ErrorAccessDenied("no permission to access this secret")
+	}
+
+	secretEntity, err := s.secretRepo.GetByID(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if secretEntity == nil {
+		return nil, wardenV1.ErrorSecretNotFound("secret not found")
+	}
+
+	days := defaultUsageDays
+	if req.Days != nil {
+		days = int(*req.Days)
+	}
+
+	usage, daily, err := s.usageRepo.GetSecretUsage(ctx, tenantID, req.Id, days)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.GetSecretUsageResponse{
+		Usage: s.usageRepo.ToProto(usage, secretEntity),
+		Daily: make([]*wardenV1.DailyUsage, 0, len(daily)),
+	}
+	for _, d := range daily {
+		resp.Daily = append(resp.Daily, &wardenV1.DailyUsage{Date: d.Date, Reads: d.Reads, Writes: d.Writes})
+	}
+	return resp, nil
+}
+
 // Helper functions
 
 func mapProtoStatusToEnt(status wardenV1.SecretStatus) secret.Status {
@@ -853,6 +2015,13 @@ func mapProtoStatusToEnt(status wardenV1.SecretStatus) secret.Status {
 	}
 }
 
+func derefString(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
 func generateUUID() string {
 	return uuid.New().String()
 }
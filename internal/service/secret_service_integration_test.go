@@ -0,0 +1,78 @@
+//go:build integration
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	wardentesting "github.com/go-tangra/go-tangra-warden/internal/testing"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+func TestSecretPasswordVersions(t *testing.T) {
+	env := wardentesting.NewEnv(t)
+	alice := env.Tenant().User("alice")
+	secret := env.CreateSecret(t, alice, nil, "db", "first-password")
+
+	if _, err := env.SecretService.UpdateSecretPassword(alice.Context(), &wardenV1.UpdateSecretPasswordRequest{
+		Id:       secret.Id,
+		Password: "second-password",
+	}); err != nil {
+		t.Fatalf("update password: %v", err)
+	}
+	if got := env.Password(t, alice, secret.Id); got != "second-password" {
+		t.Fatalf("current password is %q, want the updated one", got)
+	}
+
+	first := int32(1)
+	resp, err := env.SecretService.GetSecretPassword(alice.Context(), &wardenV1.GetSecretPasswordRequest{Id: secret.Id, Version: &first})
+	if err != nil {
+		t.Fatalf("read version 1: %v", err)
+	}
+	if resp.Password != "first-password" || resp.Version != 1 {
+		t.Fatalf("version 1 reads %q as version %d", resp.Password, resp.Version)
+	}
+
+	if _, err := env.SecretService.RestoreVersion(alice.Context(), &wardenV1.RestoreVersionRequest{SecretId: secret.Id, VersionNumber: 1}); err != nil {
+		t.Fatalf("restore version 1: %v", err)
+	}
+	if got := env.Password(t, alice, secret.Id); got != "first-password" {
+		t.Fatalf("password after restoring version 1 is %q", got)
+	}
+}
+
+func TestSecretViewerCannotChangePassword(t *testing.T) {
+	env := wardentesting.NewEnv(t)
+	tenant := env.Tenant()
+	alice, bob := tenant.User("alice"), tenant.User("bob")
+	secret := env.CreateSecret(t, alice, nil, "db", "s3cret-password")
+	env.Grant(t, authz.ResourceTypeSecret, secret.Id, authz.RelationViewer, bob)
+
+	if got := env.Password(t, bob, secret.Id); got != "s3cret-password" {
+		t.Fatalf("viewer reads %q", got)
+	}
+	_, err := env.SecretService.UpdateSecretPassword(bob.Context(), &wardenV1.UpdateSecretPasswordRequest{
+		Id:       secret.Id,
+		Password: "taken-over",
+	})
+	if !wardenV1.IsAccessDenied(err) {
+		t.Fatalf("viewer updating the password got %v, want ACCESS_DENIED", err)
+	}
+	if got := env.Password(t, alice, secret.Id); got != "s3cret-password" {
+		t.Fatalf("password changed to %q", got)
+	}
+}
+
+func TestSecretOtherTenantNotFound(t *testing.T) {
+	env := wardentesting.NewEnv(t)
+	alice := env.Tenant().User("alice", "platform:admin")
+	mallory := env.Tenant().User("mallory", "platform:admin")
+	secret := env.CreateSecret(t, alice, nil, "db", "s3cret-password")
+
+	_, err := env.SecretService.GetSecretPassword(mallory.Context(), &wardenV1.GetSecretPasswordRequest{Id: secret.Id})
+	if err == nil {
+		t.Fatal("a user of another tenant read the password")
+	}
+}
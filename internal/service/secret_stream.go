@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+const (
+	// defaultSecretStreamPollInterval is how often open secret streams look
+	// for changes made on other instances
+	defaultSecretStreamPollInterval = 15 * time.Second
+
+	// maxStreamSelectorMatches is how many secrets the label selector of a
+	// stream may match
+	maxStreamSelectorMatches = 100
+)
+
+// secretStreamPollInterval reads SECRET_STREAM_POLL_INTERVAL (a duration such
+// as 30s)
+func secretStreamPollInterval(l *log.Helper) time.Duration {
+	v := os.Getenv("SECRET_STREAM_POLL_INTERVAL")
+	if v == "" {
+		return defaultSecretStreamPollInterval
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < time.Second {
+		l.Warnf("Invalid SECRET_STREAM_POLL_INTERVAL %q, using %s", v, defaultSecretStreamPollInterval)
+		return defaultSecretStreamPollInterval
+	}
+	return d
+}
+
+// secretChanges wakes the open secret streams of a tenant when one of its
+// secrets changes on this instance. Changes made on other instances are
+// found by polling.
+type secretChanges struct {
+	mu   sync.Mutex
+	subs map[uint32]map[chan struct{}]struct{}
+}
+
+func newSecretChanges() *secretChanges {
+	return &secretChanges{subs: make(map[uint32]map[chan struct{}]struct{})}
+}
+
+// subscribe returns a channel signalled on changes in the tenant, and the
+// function ending the subscription
+func (c *secretChanges) subscribe(tenantID uint32) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	c.mu.Lock()
+	if c.subs[tenantID] == nil {
+		c.subs[tenantID] = make(map[chan struct{}]struct{})
+	}
+	c.subs[tenantID][ch] = struct{}{}
+	c.mu.Unlock()
+
+	return ch, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		delete(c.subs[tenantID], ch)
+		if len(c.subs[tenantID]) == 0 {
+			delete(c.subs, tenantID)
+		}
+	}
+}
+
+// notify signals the subscribers of the tenant without blocking; a pending
+// signal already covers the change
+func (c *secretChanges) notify(tenantID uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for ch := range c.subs[tenantID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// streamedState is what a stream last sent for a path, to tell whether the
+// secret changed since
+type streamedState struct {
+	id      string
+	version int32
+	updated time.Time
+	missing bool
+}
+
+// FetchSecretsStream sends the subscribed secrets, then every change of them
+// until the client goes away. Missing and unreadable secrets are reported
+// the same way, as GetSecretByPath does. Passwords are only read from Vault
+// when a secret changed, so the per-secret rate limit does not apply.
+func (s *SecretService) FetchSecretsStream(req *wardenV1.FetchSecretsStreamRequest, stream wardenV1.WardenSecretService_FetchSecretsStreamServer) error {
+	ctx := stream.Context()
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	selector, err := data.ParseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return err
+	}
+	if len(req.Paths) == 0 && len(selector) == 0 {
+		return wardenV1.ErrorBadRequest("paths or a label selector is required")
+	}
+
+	changes, unsubscribe := s.changes.subscribe(tenantID)
+	defer unsubscribe()
+
+	ticker := time.NewTicker(s.streamPollInterval)
+	defer ticker.Stop()
+
+	s.log.Infof("Secret stream opened: user=%s paths=%d selector=%q", userID, len(req.Paths), req.LabelSelector)
+
+	sent := make(map[string]streamedState, len(req.Paths))
+	initial := true
+	for {
+		targets, err := s.streamTargets(ctx, tenantID, userID, req.Paths, selector)
+		if err != nil {
+			return err
+		}
+
+		resp := &wardenV1.FetchSecretsStreamResponse{Initial: initial}
+		for _, target := range targets {
+			last, seen := sent[target.path]
+			item, state, err := s.streamSecret(ctx, tenantID, userID, target, req.MetadataKeys, last, seen)
+			if err != nil {
+				return err
+			}
+			if item != nil {
+				sent[target.path] = state
+				resp.Secrets = append(resp.Secrets, item)
+			}
+		}
+		// Selector matches that lost their labels or moved away
+		for path, last := range sent {
+			if !last.missing && !containsTarget(targets, path) {
+				sent[path] = streamedState{missing: true}
+				resp.Secrets = append(resp.Secrets, &wardenV1.StreamedSecret{Path: path, Missing: true})
+			}
+		}
+
+		if initial || len(resp.Secrets) > 0 {
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+		initial = false
+
+		select {
+		case <-ctx.Done():
+			s.log.Infof("Secret stream closed: user=%s", userID)
+			return nil
+		case <-changes:
+		case <-ticker.C:
+		}
+	}
+}
+
+// streamTarget is a path a stream sends, with the secret found there (nil
+// when there is none)
+type streamTarget struct {
+	path   string
+	secret *ent.Secret
+}
+
+// streamTargets looks up the subscribed paths, then adds the readable
+// secrets matching the selector that no path names; unreadable matches are
+// left out so selectors cannot probe for paths. Reads go to the primary so a
+// change notified on this instance is seen.
+func (s *SecretService) streamTargets(ctx context.Context, tenantID uint32, userID string, paths []string, selector data.LabelSelector) ([]streamTarget, error) {
+	ctx = data.WithPrimary(ctx)
+
+	targets := make([]streamTarget, 0, len(paths))
+	for _, path := range paths {
+		if containsTarget(targets, path) {
+			continue
+		}
+		folderPath, name := splitSecretPath(path)
+		secretEntity, err := s.secretRepo.GetByPath(ctx, tenantID, folderPath, name)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, streamTarget{path: path, secret: secretEntity})
+	}
+
+	if len(selector) == 0 {
+		return targets, nil
+	}
+	matches, err := s.secretRepo.ListBySelector(ctx, tenantID, selector, maxStreamSelectorMatches)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) > maxStreamSelectorMatches {
+		return nil, wardenV1.ErrorBadRequest("label selector matches more than %d secrets", maxStreamSelectorMatches)
+	}
+	for _, secretEntity := range matches {
+		path := secretPath(secretEntity)
+		if containsTarget(targets, path) || s.checker.CanReadSecret(ctx, tenantID, userID, secretEntity.ID) != nil {
+			continue
+		}
+		targets = append(targets, streamTarget{path: path, secret: secretEntity})
+	}
+	return targets, nil
+}
+
+func containsTarget(targets []streamTarget, path string) bool {
+	for _, t := range targets {
+		if t.path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// streamSecret returns the secret of a target when it differs from the state
+// last sent, nil otherwise
+func (s *SecretService) streamSecret(ctx context.Context, tenantID uint32, userID string, target streamTarget, metadataKeys []string, last streamedState, seen bool) (*wardenV1.StreamedSecret, streamedState, error) {
+	path, secretEntity := target.path, target.secret
+	if secretEntity == nil || s.checker.CanReadSecret(ctx, tenantID, userID, secretEntity.ID) != nil {
+		if seen && last.missing {
+			return nil, last, nil
+		}
+		return &wardenV1.StreamedSecret{Path: path, Missing: true}, streamedState{missing: true}, nil
+	}
+
+	state := streamedState{id: secretEntity.ID, version: secretEntity.CurrentVersion}
+	if secretEntity.UpdateTime != nil {
+		state.updated = *secretEntity.UpdateTime
+	}
+	if seen && state == last {
+		return nil, last, nil
+	}
+
+	if err := s.stepUp.check(ctx, secretEntity); err != nil {
+		return nil, last, err
+	}
+
+	password, version, err := s.kvStore.GetPassword(ctx, secretEntity.VaultPath)
+	if err != nil {
+		s.log.Errorf("failed to get password from Vault: %v", err)
+		return nil, last, wardenV1.ErrorVaultOperationError("failed to retrieve password")
+	}
+	defer password.Wipe()
+
+	// An entry per password sent, so stream reads show up in the audit log,
+	// anomaly detection and usage like unary reads
+	auditevent.Emit(ctx, auditevent.SecretPasswordRead, auditevent.ResourceSecret, secretEntity.ID, "version", strconv.Itoa(version))
+	s.accessTracker.Record(ctx, tenantID, secretEntity.ID, secretEntity.FolderID)
+	s.notifySensitiveRead(ctx, tenantID, userID, secretEntity, version)
+	s.canary.trip(ctx, tenantID, secretEntity, "FetchSecretsStream")
+
+	item := &wardenV1.StreamedSecret{
+		Path:     path,
+		Id:       secretEntity.ID,
+		Password: password.Reveal(),
+		Version:  int32(version),
+		Username: secretEntity.Username,
+		HostUrl:  secretEntity.HostURL,
+		Metadata: selectMetadata(secretEntity, metadataKeys),
+	}
+	if secretEntity.UpdateTime != nil {
+		item.UpdateTime = timestamppb.New(*secretEntity.UpdateTime)
+	}
+
+	return item, state, nil
+}
+
+// selectMetadata returns the requested metadata keys present on the secret,
+// with non-string values JSON-encoded
+func selectMetadata(secretEntity *ent.Secret, keys []string) map[string]string {
+	var out map[string]string
+	for _, key := range keys {
+		v, ok := secretEntity.Metadata[key]
+		if !ok {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string, len(keys))
+		}
+		if str, isString := v.(string); isString {
+			out[key] = str
+		} else if b, err := json.Marshal(v); err == nil {
+			out[key] = string(b)
+		}
+	}
+	return out
+}
+
+// secretPath returns the path of a secret loaded with its folder
+func secretPath(secretEntity *ent.Secret) string {
+	if secretEntity.Edges.Folder == nil {
+		return "/" + secretEntity.Name
+	}
+	return secretEntity.Edges.Folder.Path + "/" + secretEntity.Name
+}
+
+// splitSecretPath splits "/Team/Infra/db" into the folder path "/Team/Infra"
+// and the name "db"
+func splitSecretPath(path string) (string, string) {
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return "", path
+	}
+	return path[:i], path[i+1:]
+}
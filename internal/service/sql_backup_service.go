@@ -77,19 +77,28 @@ func (s *SqlBackupService) collectVaultSecrets(ctx context.Context) map[string][
 		s.log.Errorf("collect vault secrets: list secrets: %v", err)
 		return nil
 	}
+	fetches := make([]vault.FetchRequest, len(secrets))
+	for i, sec := range secrets {
+		fetches[i].Path = sec.VaultPath
+		if sec.HasTotp {
+			fetches[i].TotpPath = s.kvStore.BuildTotpPath(tenantOf(sec), sec.ID)
+		}
+	}
+	fetched := s.kvStore.FetchSecrets(sctx, fetches)
+
 	passwords := make(map[string]string)
 	totp := make(map[string]string)
-	for _, sec := range secrets {
-		if pw, _, err := s.kvStore.GetPassword(sctx, sec.VaultPath); err == nil {
-			passwords[sec.ID] = pw
+	for i, sec := range secrets {
+		if r := fetched[i]; r.Err == nil {
+			passwords[sec.ID] = r.Data.Password
 		} else {
-			s.log.Warnf("vault password for %s: %v", sec.ID, err)
+			s.log.Warnf("vault password for %s: %v", sec.ID, r.Err)
 		}
 		if sec.HasTotp {
-			if url, err := s.kvStore.GetTotpURL(sctx, s.kvStore.BuildTotpPath(tenantOf(sec), sec.ID)); err == nil {
-				totp[sec.ID] = url
+			if r := fetched[i]; r.TotpErr == nil {
+				totp[sec.ID] = r.TotpURL
 			} else {
-				s.log.Warnf("vault totp for %s: %v", sec.ID, err)
+				s.log.Warnf("vault totp for %s: %v", sec.ID, r.TotpErr)
 			}
 		}
 	}
@@ -160,17 +169,29 @@ func (s *SqlBackupService) restoreVaultSecrets(ctx context.Context, extras map[s
 	if err != nil {
 		return []string{"vault restore: list secrets: " + err.Error()}
 	}
-	var warns []string
+	var restored []*ent.Secret
+	var reqs []vault.StoreRequest
 	for _, sec := range secrets {
+		var req vault.StoreRequest
 		if pw, ok := passwords[sec.ID]; ok && pw != "" {
-			if _, err := s.kvStore.StorePassword(sctx, sec.VaultPath, pw, nil); err != nil {
-				warns = append(warns, "vault password "+sec.ID+": "+err.Error())
-			}
+			req.Path, req.Password = sec.VaultPath, pw
 		}
 		if url, ok := totp[sec.ID]; ok && url != "" {
-			if err := s.kvStore.StoreTotpURL(sctx, s.kvStore.BuildTotpPath(tenantOf(sec), sec.ID), url); err != nil {
-				warns = append(warns, "vault totp "+sec.ID+": "+err.Error())
-			}
+			req.TotpPath, req.TotpURL = s.kvStore.BuildTotpPath(tenantOf(sec), sec.ID), url
+		}
+		if req.Path != "" || req.TotpPath != "" {
+			restored = append(restored, sec)
+			reqs = append(reqs, req)
+		}
+	}
+
+	var warns []string
+	for i, r := range s.kvStore.StoreSecrets(sctx, reqs) {
+		if r.Err != nil {
+			warns = append(warns, "vault password "+restored[i].ID+": "+r.Err.Error())
+		}
+		if r.TotpErr != nil {
+			warns = append(warns, "vault totp "+restored[i].ID+": "+r.TotpErr.Error())
 		}
 	}
 	return warns
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/metadata"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authn"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// StepUpPolicy requires a recent authentication before passwords of
+// sensitive secrets are read. The time of the caller's last authentication
+// comes from the x-md-global-auth-time metadata, set from the auth_time
+// claim of the bearer token in the jwt modes.
+type StepUpPolicy struct {
+	// MaxAge is how long ago the caller may have authenticated; 0 disables the check
+	MaxAge time.Duration
+}
+
+// NewStepUpPolicy reads the maximum authentication age from STEP_UP_MAX_AGE
+// (a duration such as 5m). Unset or 0 disables step-up.
+func NewStepUpPolicy(ctx *bootstrap.Context) *StepUpPolicy {
+	l := ctx.NewLoggerHelper("warden/service/step-up")
+
+	p := &StepUpPolicy{}
+	if v := os.Getenv("STEP_UP_MAX_AGE"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			l.Warnf("Invalid STEP_UP_MAX_AGE %q, step-up is disabled", v)
+			return p
+		}
+		p.MaxAge = d
+	}
+	if p.MaxAge > 0 {
+		l.Infof("Sensitive secret reads require authentication within %s", p.MaxAge)
+	}
+	return p
+}
+
+// check rejects reading the password of a sensitive secret unless the caller
+// authenticated within MaxAge
+func (p *StepUpPolicy) check(ctx context.Context, secretEntity *ent.Secret) error {
+	if p == nil || p.MaxAge <= 0 || !secretEntity.Sensitive {
+		return nil
+	}
+
+	if authTime, ok := authTimeFromContext(ctx); ok && time.Since(authTime) <= p.MaxAge {
+		return nil
+	}
+
+	return wardenV1.ErrorReauthenticationRequired("this secret requires a recent sign-in, please re-authenticate").
+		WithMetadata(map[string]string{"max_age_seconds": strconv.FormatInt(int64(p.MaxAge/time.Second), 10)})
+}
+
+// authTimeFromContext returns when the caller last authenticated
+func authTimeFromContext(ctx context.Context) (time.Time, bool) {
+	md, ok := metadata.FromServerContext(ctx)
+	if !ok {
+		return time.Time{}, false
+	}
+	secs, err := strconv.ParseInt(md.Get(authn.MDAuthTime), 10, 64)
+	if err != nil || secs <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(secs, 0), true
+}
@@ -2,14 +2,22 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"runtime"
+	"sort"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	sharingpb "buf.build/gen/go/go-tangra/sharing/protocolbuffers/go/sharing/service/v1"
 
+	"github.com/go-tangra/go-tangra-warden/cmd/server/assets"
+	"github.com/go-tangra/go-tangra-warden/internal/auditevent"
+	"github.com/go-tangra/go-tangra-warden/internal/cert"
 	"github.com/go-tangra/go-tangra-warden/internal/client"
 	"github.com/go-tangra/go-tangra-warden/internal/data"
 	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
@@ -18,6 +26,13 @@ import (
 	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
 )
 
+const (
+	defaultStatsDays          = 30
+	defaultStatsTopLimit      = 10
+	defaultRotationWindowDays = 30
+	defaultStaleDays          = 90
+)
+
 var (
 	// Version is set at build time
 	Version = "dev"
@@ -33,23 +48,43 @@ type SystemService struct {
 	log           *log.Helper
 	vaultClient   *vault.Client
 	statsRepo     *data.StatisticsRepo
+	secretRepo    *data.SecretRepo
 	sharingClient *client.SharingClient
+	certReloader  *cert.Reloader
+	limits        *PayloadLimits
+
+	openapiSHA256    string
+	descriptorSHA256 string
 }
 
 func NewSystemService(
 	ctx *bootstrap.Context,
 	vaultClient *vault.Client,
 	statsRepo *data.StatisticsRepo,
+	secretRepo *data.SecretRepo,
 	sharingClient *client.SharingClient,
+	certReloader *cert.Reloader,
+	limits *PayloadLimits,
 ) *SystemService {
 	return &SystemService{
 		log:           ctx.NewLoggerHelper("warden/service/system"),
 		vaultClient:   vaultClient,
 		statsRepo:     statsRepo,
+		secretRepo:    secretRepo,
 		sharingClient: sharingClient,
+		certReloader:  certReloader,
+		limits:        limits,
+
+		openapiSHA256:    sha256Hex(assets.OpenApiData),
+		descriptorSHA256: sha256Hex(assets.DescriptorData),
 	}
 }
 
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
 // Health returns the health status of the service
 func (s *SystemService) Health(ctx context.Context, _ *emptypb.Empty) (*wardenV1.HealthResponse, error) {
 	components := make(map[string]*wardenV1.ComponentHealth)
@@ -78,6 +113,22 @@ func (s *SystemService) Health(ctx context.Context, _ *emptypb.Empty) (*wardenV1
 	}
 	components["vault"] = vaultHealth
 
+	// Check mTLS certificate expiry
+	if state := s.certReloader.Expiry(time.Now()); state.Enabled {
+		certHealth := &wardenV1.ComponentHealth{
+			Status:  wardenV1.HealthStatus_HEALTH_STATUS_HEALTHY,
+			Message: "valid until " + state.NotAfter.Format(time.RFC3339),
+		}
+		if state.Expired {
+			certHealth.Status = wardenV1.HealthStatus_HEALTH_STATUS_UNHEALTHY
+			certHealth.Message = "the " + state.Certificate + " certificate expired at " + state.NotAfter.Format(time.RFC3339)
+		} else if state.ExpiringSoon {
+			certHealth.Status = wardenV1.HealthStatus_HEALTH_STATUS_DEGRADED
+			certHealth.Message = "the " + state.Certificate + " certificate expires at " + state.NotAfter.Format(time.RFC3339)
+		}
+		components["certificates"] = certHealth
+	}
+
 	// Determine overall status
 	overallStatus := wardenV1.HealthStatus_HEALTH_STATUS_HEALTHY
 	overallMessage := "all systems operational"
@@ -157,16 +208,297 @@ func (s *SystemService) GetStats(ctx context.Context, req *wardenV1.GetStatsRequ
 		avgVersions = float64(totalVersions) / float64(totalSecrets)
 	}
 
-	return &wardenV1.GetStatsResponse{
+	resp := &wardenV1.GetStatsResponse{
 		TotalSecrets:         totalSecrets,
 		ActiveSecrets:        activeSecrets,
 		ArchivedSecrets:      archivedSecrets,
 		TotalFolders:         totalFolders,
 		TotalVersions:        totalVersions,
 		AvgVersionsPerSecret: avgVersions,
+	}
+
+	if err := s.fillTimeSeries(ctx, tenantID, req, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// fillTimeSeries adds the dashboard series: daily secret creations and
+// password reads, the most read secrets and the secrets due for rotation
+func (s *SystemService) fillTimeSeries(ctx context.Context, tenantID uint32, req *wardenV1.GetStatsRequest, resp *wardenV1.GetStatsResponse) error {
+	days := defaultStatsDays
+	if req.Days != nil {
+		days = int(*req.Days)
+	}
+	topLimit := defaultStatsTopLimit
+	if req.TopLimit != nil {
+		topLimit = int(*req.TopLimit)
+	}
+	rotationWindow := defaultRotationWindowDays
+	if req.RotationWindowDays != nil {
+		rotationWindow = int(*req.RotationWindowDays)
+	}
+
+	created, err := s.statsRepo.GetSecretsCreatedPerDay(ctx, tenantID, days)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("failed to get secrets created per day: %v", err)
+		return err
+	}
+	resp.SecretsCreatedPerDay = toDailyCountProtos(created)
+
+	reads, err := s.statsRepo.GetEventsPerDay(ctx, tenantID, auditevent.SecretPasswordRead, days)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("failed to get password reads per day: %v", err)
+		return err
+	}
+	resp.PasswordReadsPerDay = toDailyCountProtos(reads)
+
+	top, err := s.statsRepo.GetTopResourcesByEvent(ctx, tenantID, auditevent.SecretPasswordRead, days, topLimit)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("failed to get top accessed secrets: %v", err)
+		return err
+	}
+	ids := make([]string, 0, len(top))
+	for _, t := range top {
+		ids = append(ids, t.ResourceID)
+	}
+	names, err := s.statsRepo.GetSecretNames(ctx, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	resp.TopAccessedSecrets = make([]*wardenV1.SecretAccessCount, 0, len(top))
+	for _, t := range top {
+		resp.TopAccessedSecrets = append(resp.TopAccessedSecrets, &wardenV1.SecretAccessCount{
+			SecretId: t.ResourceID,
+			Name:     names[t.ResourceID],
+			Count:    int64(t.Count),
+		})
+	}
+
+	expiring, err := s.secretRepo.ListExpiring(ctx, tenantID, time.Now().AddDate(0, 0, rotationWindow))
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("failed to get secrets due for rotation: %v", err)
+		return err
+	}
+	sort.Slice(expiring, func(i, j int) bool {
+		return expiring[i].ExpiresAt.Before(expiring[j].ExpiresAt)
+	})
+	resp.SecretsDueForRotation = make([]*wardenV1.SecretRotationDue, 0, len(expiring))
+	for _, e := range expiring {
+		resp.SecretsDueForRotation = append(resp.SecretsDueForRotation, &wardenV1.SecretRotationDue{
+			SecretId:  e.Secret.ID,
+			Name:      e.Secret.Name,
+			FolderId:  e.Secret.FolderID,
+			ExpiresAt: timestamppb.New(e.ExpiresAt),
+		})
+	}
+
+	return nil
+}
+
+func toDailyCountProtos(counts []data.DailyCount) []*wardenV1.DailyCount {
+	result := make([]*wardenV1.DailyCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, &wardenV1.DailyCount{Date: c.Date, Count: c.Count})
+	}
+	return result
+}
+
+// ListTenantUsage reports the secrets, folders, versions, estimated Vault
+// storage and last activity of every tenant for capacity planning and billing
+func (s *SystemService) ListTenantUsage(ctx context.Context, req *wardenV1.ListTenantUsageRequest) (*wardenV1.ListTenantUsageResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can view tenant usage")
+	}
+
+	usage, err := s.statsRepo.GetTenantUsage(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.ListTenantUsageResponse{
+		Tenants: make([]*wardenV1.TenantUsage, 0, len(usage)),
+		Total:   &wardenV1.TenantUsage{},
+	}
+	for _, u := range usage {
+		item := &wardenV1.TenantUsage{
+			TenantId:            u.TenantID,
+			TotalSecrets:        u.TotalSecrets,
+			ActiveSecrets:       u.ActiveSecrets,
+			ArchivedSecrets:     u.ArchivedSecrets,
+			DeletedSecrets:      u.DeletedSecrets,
+			TotalFolders:        u.TotalFolders,
+			TotalVersions:       u.TotalVersions,
+			EstimatedVaultBytes: u.EstimatedVaultBytes(),
+		}
+		if u.LastActivity != nil {
+			item.LastActivityTime = timestamppb.New(*u.LastActivity)
+		}
+		resp.Tenants = append(resp.Tenants, item)
+
+		resp.Total.TotalSecrets += item.TotalSecrets
+		resp.Total.ActiveSecrets += item.ActiveSecrets
+		resp.Total.ArchivedSecrets += item.ArchivedSecrets
+		resp.Total.DeletedSecrets += item.DeletedSecrets
+		resp.Total.TotalFolders += item.TotalFolders
+		resp.Total.TotalVersions += item.TotalVersions
+		resp.Total.EstimatedVaultBytes += item.EstimatedVaultBytes
+	}
+
+	return resp, nil
+}
+
+// GetStaleSecretsReport lists the active secrets whose password was not
+// changed or not read for too long, grouped by folder and by owner
+func (s *SystemService) GetStaleSecretsReport(ctx context.Context, req *wardenV1.GetStaleSecretsReportRequest) (*wardenV1.GetStaleSecretsReportResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil && *req.TenantId != tenantID {
+		if !isPlatformAdmin(ctx) {
+			return nil, wardenV1.ErrorAccessDenied("cannot view the report of another tenant")
+		}
+		tenantID = *req.TenantId
+	}
+
+	passwordAgeDays := uint32(defaultStaleDays)
+	if req.PasswordAgeDays != nil {
+		passwordAgeDays = *req.PasswordAgeDays
+	}
+	unreadDays := uint32(defaultStaleDays)
+	if req.UnreadDays != nil {
+		unreadDays = *req.UnreadDays
+	}
+
+	now := time.Now()
+	var passwordBefore, readBefore *time.Time
+	if passwordAgeDays > 0 {
+		t := now.AddDate(0, 0, -int(passwordAgeDays))
+		passwordBefore = &t
+	}
+	if unreadDays > 0 {
+		t := now.AddDate(0, 0, -int(unreadDays))
+		readBefore = &t
+	}
+
+	stale, err := s.statsRepo.ListStaleSecrets(ctx, tenantID, passwordBefore, readBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wardenV1.GetStaleSecretsReportResponse{
+		TotalCount: int32(len(stale)),
+	}
+	folders := make(map[string]*wardenV1.StaleSecretFolderGroup)
+	owners := make(map[string]*wardenV1.StaleSecretOwnerGroup)
+	for _, st := range stale {
+		item := &wardenV1.StaleSecret{
+			SecretId:      st.Secret.ID,
+			Name:          st.Secret.Name,
+			PasswordStale: st.PasswordStale,
+			Unread:        st.Unread,
+			OwnerIds:      st.OwnerIDs,
+		}
+		if st.PasswordChangedTime != nil {
+			item.PasswordChangedTime = timestamppb.New(*st.PasswordChangedTime)
+		}
+		if st.Secret.LastAccessedTime != nil {
+			item.LastAccessedTime = timestamppb.New(*st.Secret.LastAccessedTime)
+		}
+		if st.PasswordStale {
+			resp.PasswordStaleCount++
+		}
+		if st.Unread {
+			resp.UnreadCount++
+		}
+
+		folderKey := ""
+		if st.Secret.FolderID != nil {
+			folderKey = *st.Secret.FolderID
+		}
+		group, ok := folders[folderKey]
+		if !ok {
+			group = &wardenV1.StaleSecretFolderGroup{FolderId: st.Secret.FolderID, Path: "/"}
+			if f := st.Secret.Edges.Folder; f != nil {
+				group.Path = f.Path
+			}
+			folders[folderKey] = group
+			resp.Folders = append(resp.Folders, group)
+		}
+		group.Secrets = append(group.Secrets, item)
+
+		ownerIDs := st.OwnerIDs
+		if len(ownerIDs) == 0 {
+			ownerIDs = []string{""}
+		}
+		for _, ownerID := range ownerIDs {
+			og, ok := owners[ownerID]
+			if !ok {
+				og = &wardenV1.StaleSecretOwnerGroup{OwnerId: ownerID}
+				owners[ownerID] = og
+				resp.Owners = append(resp.Owners, og)
+			}
+			og.SecretIds = append(og.SecretIds, st.Secret.ID)
+		}
+	}
+
+	sort.Slice(resp.Folders, func(i, j int) bool {
+		a, b := resp.Folders[i], resp.Folders[j]
+		if (a.FolderId == nil) != (b.FolderId == nil) {
+			return a.FolderId == nil
+		}
+		return a.Path < b.Path
+	})
+	sort.Slice(resp.Owners, func(i, j int) bool {
+		a, b := resp.Owners[i], resp.Owners[j]
+		if len(a.SecretIds) != len(b.SecretIds) {
+			return len(a.SecretIds) > len(b.SecretIds)
+		}
+		return a.OwnerId < b.OwnerId
+	})
+
+	return resp, nil
+}
+
+// GetCapabilities reports the features and limits of this deployment, so
+// clients such as the admin UI can adapt without being redeployed with Warden
+func (s *SystemService) GetCapabilities(_ context.Context, _ *emptypb.Empty) (*wardenV1.GetCapabilitiesResponse, error) {
+	return &wardenV1.GetCapabilitiesResponse{
+		Version:           Version,
+		VaultBackend:      "vault-kv-v2",
+		ImportFormats:     []string{"bitwarden", "csv"},
+		ExportFormats:     []string{"bitwarden", "csv"},
+		PasswordRotation:  false,
+		Totp:              true,
+		ImportMaxBytes:    int64(s.limits.ImportMaxBytes),
+		ImportMaxItems:    int64(s.limits.ImportMaxItems),
+		BackupMaxBytes:    int64(s.limits.BackupMaxBytes),
+		BackupMaxEntities: int64(s.limits.BackupMaxEntities),
+		OpenapiSha256:     s.openapiSHA256,
+		DescriptorSha256:  s.descriptorSHA256,
+		PasswordMaxBytes:  int64(s.limits.PasswordMaxBytes),
 	}, nil
 }
 
+// GetApiSchema returns the OpenAPI document or protobuf descriptor embedded in the server
+func (s *SystemService) GetApiSchema(_ context.Context, req *wardenV1.GetApiSchemaRequest) (*wardenV1.GetApiSchemaResponse, error) {
+	switch req.GetFormat() {
+	case wardenV1.ApiSchemaFormat_API_SCHEMA_FORMAT_OPENAPI:
+		return &wardenV1.GetApiSchemaResponse{
+			Data:        assets.OpenApiData,
+			ContentType: "application/yaml",
+			Sha256:      s.openapiSHA256,
+		}, nil
+	case wardenV1.ApiSchemaFormat_API_SCHEMA_FORMAT_DESCRIPTOR:
+		return &wardenV1.GetApiSchemaResponse{
+			Data:        assets.DescriptorData,
+			ContentType: "application/octet-stream",
+			Sha256:      s.descriptorSHA256,
+		}, nil
+	default:
+		return nil, wardenV1.ErrorBadRequest("unknown schema format")
+	}
+}
+
 // CheckVault checks Vault connectivity
 func (s *SystemService) CheckVault(ctx context.Context, _ *emptypb.Empty) (*wardenV1.CheckVaultResponse, error) {
 	if s.vaultClient == nil {
@@ -198,11 +530,16 @@ func (s *SystemService) CheckVault(ctx context.Context, _ *emptypb.Empty) (*ward
 		}, nil
 	}
 
+	message := "connection successful"
+	if s.vaultClient.IsMemory() {
+		message = "in-memory dev store, secrets are lost on restart"
+	}
+
 	return &wardenV1.CheckVaultResponse{
 		Connected:    true,
 		VaultVersion: health.Version,
 		Sealed:       health.Sealed,
-		Message:      "connection successful",
+		Message:      message,
 	}, nil
 }
 
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/client"
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/metrics"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// TenantTransferService copies folder subtrees, with their secrets and
+// permissions, into another tenant of this or a remote Warden instance
+type TenantTransferService struct {
+	wardenV1.UnimplementedWardenTenantTransferServiceServer
+
+	log          *log.Helper
+	secretRepo   *data.SecretRepo
+	folderRepo   *data.FolderRepo
+	versionRepo  *data.SecretVersionRepo
+	permRepo     *data.PermissionRepo
+	kvStore      *vault.KVStore
+	metrics      *metrics.Collector
+	webhooks     *webhook.Dispatcher
+	wardenClient *client.WardenClient
+}
+
+// NewTenantTransferService creates a new TenantTransferService
+func NewTenantTransferService(
+	ctx *bootstrap.Context,
+	secretRepo *data.SecretRepo,
+	folderRepo *data.FolderRepo,
+	versionRepo *data.SecretVersionRepo,
+	permRepo *data.PermissionRepo,
+	kvStore *vault.KVStore,
+	metrics *metrics.Collector,
+	webhooks *webhook.Dispatcher,
+	wardenClient *client.WardenClient,
+) *TenantTransferService {
+	return &TenantTransferService{
+		log:          ctx.NewLoggerHelper("warden/service/tenant-transfer"),
+		secretRepo:   secretRepo,
+		folderRepo:   folderRepo,
+		versionRepo:  versionRepo,
+		permRepo:     permRepo,
+		kvStore:      kvStore,
+		metrics:      metrics,
+		webhooks:     webhooks,
+		wardenClient: wardenClient,
+	}
+}
+
+// MigrateFolderTree copies a folder subtree into another tenant (platform admin only)
+func (s *TenantTransferService) MigrateFolderTree(ctx context.Context, req *wardenV1.MigrateFolderTreeRequest) (*wardenV1.MigrateFolderTreeResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can migrate folders between tenants")
+	}
+
+	sourceTenantID := getTenantIDFromContext(ctx)
+	if req.SourceTenantId != nil && *req.SourceTenantId > 0 {
+		sourceTenantID = *req.SourceTenantId
+	}
+
+	bundle, err := s.buildBundle(ctx, sourceTenantID, req.FolderId, req.IncludePermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	importReq := &wardenV1.ImportFolderTreeRequest{
+		Bundle:               bundle,
+		TargetTenantId:       req.TargetTenantId,
+		TargetParentFolderId: req.TargetParentFolderId,
+		IncludePermissions:   req.IncludePermissions,
+	}
+
+	var resp *wardenV1.MigrateFolderTreeResponse
+	if req.RemoteEndpoint != nil && *req.RemoteEndpoint != "" {
+		resp, err = s.wardenClient.ImportFolderTree(ctx, *req.RemoteEndpoint, importReq)
+		if err != nil {
+			return nil, wardenV1.ErrorInternalServerError("remote import failed")
+		}
+	} else {
+		resp, err = s.importBundle(ctx, importReq)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.log.Infof("Folder tree migrated: folder=%s source_tenant=%d target_tenant=%d remote=%v folders=%d secrets=%d failed=%d",
+		req.FolderId, sourceTenantID, req.TargetTenantId, req.RemoteEndpoint != nil, resp.FoldersCreated, resp.SecretsCreated, resp.SecretsFailed)
+
+	return resp, nil
+}
+
+// ImportFolderTree imports a subtree bundle sent by another Warden instance (platform admin only)
+func (s *TenantTransferService) ImportFolderTree(ctx context.Context, req *wardenV1.ImportFolderTreeRequest) (*wardenV1.MigrateFolderTreeResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can import folder trees")
+	}
+
+	resp, err := s.importBundle(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Folder tree imported: source_tenant=%d target_tenant=%d folders=%d secrets=%d failed=%d",
+		req.Bundle.GetSourceTenantId(), req.TargetTenantId, resp.FoldersCreated, resp.SecretsCreated, resp.SecretsFailed)
+
+	return resp, nil
+}
+
+// buildBundle reads a folder subtree with its secrets' current Vault data
+func (s *TenantTransferService) buildBundle(ctx context.Context, tenantID uint32, folderID string, includePermissions bool) (*wardenV1.FolderTreeBundle, error) {
+	folders, err := s.folderRepo.ListSubtree(ctx, tenantID, folderID)
+	if err != nil {
+		return nil, err
+	}
+	if len(folders) == 0 {
+		return nil, wardenV1.ErrorFolderNotFound("folder not found")
+	}
+
+	bundle := &wardenV1.FolderTreeBundle{SourceTenantId: tenantID}
+
+	for i, f := range folders {
+		tf := &wardenV1.TransferFolder{
+			Id:          f.ID,
+			Name:        f.Name,
+			Description: f.Description,
+		}
+		if i > 0 {
+			tf.ParentId = f.ParentID
+		}
+		bundle.Folders = append(bundle.Folders, tf)
+	}
+
+	secrets, err := s.secretRepo.ListAllInFolderTree(ctx, tenantID, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	fetches := make([]vault.FetchRequest, len(secrets))
+	for i, sec := range secrets {
+		fetches[i].Path = sec.VaultPath
+		if sec.HasTotp {
+			fetches[i].TotpPath = s.kvStore.BuildTotpPath(tenantID, sec.ID)
+		}
+	}
+	fetched := s.kvStore.FetchSecrets(ctx, fetches)
+
+	for i, sec := range secrets {
+		if err := fetched[i].Err; err != nil {
+			s.log.Errorf("failed to read secret %s for migration: %v", sec.ID, err)
+			return nil, wardenV1.ErrorVaultOperationError("failed to retrieve password")
+		}
+		vaultData := fetched[i].Data
+
+		ts := &wardenV1.TransferSecret{
+			Id:            sec.ID,
+			FolderId:      derefString(sec.FolderID),
+			Name:          sec.Name,
+			Username:      sec.Username,
+			HostUrl:       sec.HostURL,
+			Description:   sec.Description,
+			Password:      vaultData.Password,
+			VaultMetadata: vaultData.Metadata,
+		}
+		if sec.Metadata != nil {
+			if ts.Metadata, err = structpb.NewStruct(sec.Metadata); err != nil {
+				s.log.Warnf("Failed to convert metadata of secret %s for migration: %v", sec.ID, err)
+			}
+		}
+		if sec.HasTotp && fetched[i].TotpErr == nil {
+			ts.TotpUrl = fetched[i].TotpURL
+		}
+		bundle.Secrets = append(bundle.Secrets, ts)
+	}
+
+	if includePermissions {
+		addPermissions := func(resourceType authz.ResourceType, resourceID string) error {
+			tuples, err := s.permRepo.GetDirectPermissions(ctx, tenantID, resourceType, resourceID)
+			if err != nil {
+				return err
+			}
+			for _, t := range tuples {
+				tp := &wardenV1.TransferPermission{
+					ResourceType: string(t.ResourceType),
+					ResourceId:   t.ResourceID,
+					Relation:     string(t.Relation),
+					SubjectType:  string(t.SubjectType),
+					SubjectId:    t.SubjectID,
+				}
+				if t.ExpiresAt != nil {
+					tp.ExpiresAt = timestamppb.New(*t.ExpiresAt)
+				}
+				bundle.Permissions = append(bundle.Permissions, tp)
+			}
+			return nil
+		}
+		for _, f := range folders {
+			if err := addPermissions(authz.ResourceTypeFolder, f.ID); err != nil {
+				return nil, err
+			}
+		}
+		for _, sec := range secrets {
+			if err := addPermissions(authz.ResourceTypeSecret, sec.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return bundle, nil
+}
+
+// importBundle recreates a subtree bundle in the target tenant with new IDs.
+// Folders are created first; a failing folder aborts the import, while
+// failing secrets and permissions are reported and skipped.
+func (s *TenantTransferService) importBundle(ctx context.Context, req *wardenV1.ImportFolderTreeRequest) (*wardenV1.MigrateFolderTreeResponse, error) {
+	bundle := req.Bundle
+	if bundle == nil || len(bundle.Folders) == 0 {
+		return nil, wardenV1.ErrorBadRequest("bundle has no folders")
+	}
+
+	tenantID := req.TargetTenantId
+	userID := getUserIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	var targetParentID *string
+	if req.TargetParentFolderId != nil && *req.TargetParentFolderId != "" {
+		parent, err := s.folderRepo.GetByID(ctx, tenantID, *req.TargetParentFolderId)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return nil, wardenV1.ErrorFolderNotFound("target parent folder not found")
+		}
+		targetParentID = req.TargetParentFolderId
+	}
+
+	resp := &wardenV1.MigrateFolderTreeResponse{
+		FolderIdMapping: make(map[string]string),
+		SecretIdMapping: make(map[string]string),
+		Errors:          []string{},
+	}
+
+	// Folders (parents always precede their children in the bundle)
+	for i, f := range bundle.Folders {
+		parentID := targetParentID
+		if i > 0 {
+			newParentID, ok := resp.FolderIdMapping[f.GetParentId()]
+			if !ok {
+				return nil, wardenV1.ErrorBadRequest("folder %s precedes its parent in the bundle", f.Id)
+			}
+			parentID = &newParentID
+		}
+
+		created, err := s.folderRepo.Create(ctx, tenantID, parentID, f.Name, f.Description, createdBy)
+		if err != nil {
+			s.log.Errorf("folder creation failed during migration of %s: %v", f.Id, err)
+			return nil, err
+		}
+		resp.FolderIdMapping[f.Id] = created.ID
+		resp.FoldersCreated++
+		s.metrics.FolderCreated()
+	}
+	resp.RootFolderId = resp.FolderIdMapping[bundle.Folders[0].Id]
+
+	// The caller owns the copied subtree; everything below inherits from the root
+	if createdBy != nil {
+		if _, permErr := s.permRepo.Create(ctx, tenantID, string(authz.ResourceTypeFolder), resp.RootFolderId, string(authz.RelationOwner), string(authz.SubjectTypeUser), userID, createdBy, nil); permErr != nil {
+			s.log.Warnf("Failed to grant owner permission on migrated folder %s: %v", resp.RootFolderId, permErr)
+		}
+	}
+
+	comment := fmt.Sprintf("Migrated from tenant %d", bundle.SourceTenantId)
+	for _, sec := range bundle.Secrets {
+		folderID, ok := resp.FolderIdMapping[sec.FolderId]
+		if !ok {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("secret %q: folder %s is not part of the bundle", sec.Name, sec.FolderId))
+			resp.SecretsFailed++
+			continue
+		}
+
+		secretID := uuid.New().String()
+		vaultPath := s.kvStore.BuildPath(tenantID, secretID)
+		if _, err := s.kvStore.StorePassword(ctx, vaultPath, sec.Password, sec.VaultMetadata); err != nil {
+			s.log.Errorf("failed to store password in Vault for migrated secret %s: %v", sec.Id, err)
+			resp.Errors = append(resp.Errors, fmt.Sprintf("secret %q: failed to store password in vault", sec.Name))
+			resp.SecretsFailed++
+			continue
+		}
+
+		var metadata map[string]any
+		if sec.Metadata != nil {
+			metadata = sec.Metadata.AsMap()
+		}
+
+		created, err := s.secretRepo.Create(ctx, tenantID, &folderID, sec.Name, sec.Username, sec.HostUrl, vaultPath, sec.Description, metadata, createdBy)
+		if err != nil {
+			if cleanupErr := s.kvStore.DestroyAllVersions(ctx, vaultPath); cleanupErr != nil {
+				s.log.Warnf("Failed to clean up Vault path %s after migration failure: %v", vaultPath, cleanupErr)
+			}
+			s.log.Errorf("secret creation failed during migration of %s: %v", sec.Id, err)
+			resp.Errors = append(resp.Errors, fmt.Sprintf("secret %q: failed to create secret", sec.Name))
+			resp.SecretsFailed++
+			continue
+		}
+
+		checksum := s.kvStore.Checksum(sec.Password)
+		if _, versionErr := s.versionRepo.Create(ctx, created.ID, 1, vaultPath, comment, checksum, nil, createdBy); versionErr != nil {
+			s.log.Warnf("Failed to create version record for migrated secret %s: %v", created.ID, versionErr)
+		}
+
+		if sec.TotpUrl != "" {
+			totpPath := s.kvStore.BuildTotpPath(tenantID, created.ID)
+			if err := s.kvStore.StoreTotpURL(ctx, totpPath, sec.TotpUrl); err != nil {
+				s.log.Warnf("failed to store TOTP for migrated secret %s: %v", created.ID, err)
+			} else {
+				_ = s.secretRepo.SetHasTotp(ctx, tenantID, created.ID, true)
+			}
+		}
+
+		s.metrics.SecretCreated(string(created.Status))
+		resp.SecretIdMapping[sec.Id] = created.ID
+		resp.SecretsCreated++
+	}
+
+	if req.IncludePermissions {
+		for _, p := range bundle.Permissions {
+			var resourceID string
+			var ok bool
+			switch authz.ResourceType(p.ResourceType) {
+			case authz.ResourceTypeFolder:
+				resourceID, ok = resp.FolderIdMapping[p.ResourceId]
+			case authz.ResourceTypeSecret:
+				resourceID, ok = resp.SecretIdMapping[p.ResourceId]
+			}
+			if !ok {
+				continue
+			}
+
+			var expiresAt *time.Time
+			if p.ExpiresAt != nil {
+				t := p.ExpiresAt.AsTime()
+				expiresAt = &t
+			}
+			if _, err := s.permRepo.Create(ctx, tenantID, p.ResourceType, resourceID, p.Relation, p.SubjectType, p.SubjectId, createdBy, expiresAt); err != nil {
+				resp.Errors = append(resp.Errors, fmt.Sprintf("permission %s %s on %s: %v", p.SubjectType, p.SubjectId, p.ResourceType, err))
+				continue
+			}
+			resp.PermissionsCreated++
+		}
+	}
+
+	s.webhooks.Publish(ctx, tenantID, webhook.EventImportFinished, map[string]any{
+		"source":           "migration",
+		"source_tenant_id": bundle.SourceTenantId,
+		"user_id":          userID,
+		"root_folder_id":   resp.RootFolderId,
+		"folders_created":  resp.FoldersCreated,
+		"items_imported":   resp.SecretsCreated,
+		"items_failed":     resp.SecretsFailed,
+	})
+
+	return resp, nil
+}
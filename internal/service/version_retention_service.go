@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// VersionRetentionService manages the per-tenant caps on the password
+// versions Vault keeps. New secrets get them from the KV store when their
+// path is first written; setting them updates the existing secrets.
+type VersionRetentionService struct {
+	wardenV1.UnimplementedWardenVersionRetentionServiceServer
+
+	log             *log.Helper
+	settingsRepo    *data.TenantSettingRepo
+	maintenanceRepo *data.MaintenanceRepo
+	kvStore         *vault.KVStore
+}
+
+// NewVersionRetentionService creates a new VersionRetentionService
+func NewVersionRetentionService(
+	ctx *bootstrap.Context,
+	settingsRepo *data.TenantSettingRepo,
+	maintenanceRepo *data.MaintenanceRepo,
+	kvStore *vault.KVStore,
+) *VersionRetentionService {
+	return &VersionRetentionService{
+		log:             ctx.NewLoggerHelper("warden/service/version-retention"),
+		settingsRepo:    settingsRepo,
+		maintenanceRepo: maintenanceRepo,
+		kvStore:         kvStore,
+	}
+}
+
+// GetVersionRetention returns the version retention of a tenant
+func (s *VersionRetentionService) GetVersionRetention(ctx context.Context, req *wardenV1.GetVersionRetentionRequest) (*wardenV1.VersionRetention, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil && *req.TenantId != tenantID {
+		if !isPlatformAdmin(ctx) {
+			return nil, wardenV1.ErrorAccessDenied("cannot view version retention of another tenant")
+		}
+		tenantID = *req.TenantId
+	}
+
+	setting, err := s.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toVersionRetentionProto(tenantID, setting), nil
+}
+
+// SetVersionRetention replaces the version retention of a tenant and writes
+// it to the Vault metadata of each of its secrets, deleted ones included.
+// Secrets under legal hold keep every version and get it once the hold is
+// lifted. Secrets that fail are reported and keep their previous metadata.
+func (s *VersionRetentionService) SetVersionRetention(ctx context.Context, req *wardenV1.SetVersionRetentionRequest) (*wardenV1.SetVersionRetentionResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only platform admins can change version retention")
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	if req.TenantId != nil {
+		tenantID = *req.TenantId
+	}
+
+	retention := data.VersionRetention{
+		MaxVersions:     int(req.MaxVersions),
+		DeleteAfterDays: int(req.DeleteAfterDays),
+	}
+	setting, err := s.settingsRepo.SetVersionRetention(ctx, tenantID, retention, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := s.maintenanceRepo.ListSecrets(ctx, &tenantID, nil)
+	if err != nil {
+		return nil, err
+	}
+	heldFolders, err := s.maintenanceRepo.ListHeldFolderIDs(ctx, &tenantID)
+	if err != nil {
+		return nil, err
+	}
+	held := make(map[string]bool, len(heldFolders))
+	for _, id := range heldFolders {
+		held[id] = true
+	}
+
+	resp := &wardenV1.SetVersionRetentionResponse{
+		Retention:       toVersionRetentionProto(tenantID, setting),
+		FailedSecretIds: []string{},
+	}
+	for _, sec := range secrets {
+		if sec.FolderID != nil && held[*sec.FolderID] {
+			continue
+		}
+		if err := s.kvStore.PutMetadata(ctx, sec.VaultPath, retention.MaxVersions, retention.DeleteAfter()); err != nil {
+			s.log.Errorf("Failed to apply version retention to secret %s: %v", sec.ID, err)
+			resp.FailedSecretIds = append(resp.FailedSecretIds, sec.ID)
+			continue
+		}
+		resp.SecretsUpdated++
+	}
+
+	s.log.Infof("Version retention updated: tenant=%d max_versions=%d delete_after_days=%d secrets=%d failed=%d",
+		tenantID, retention.MaxVersions, retention.DeleteAfterDays, resp.SecretsUpdated, len(resp.FailedSecretIds))
+
+	return resp, nil
+}
+
+func toVersionRetentionProto(tenantID uint32, setting *ent.TenantSetting) *wardenV1.VersionRetention {
+	resp := &wardenV1.VersionRetention{TenantId: tenantID}
+	if setting != nil {
+		resp.MaxVersions = uint32(setting.VersionMaxCount)
+		resp.DeleteAfterDays = uint32(setting.VersionDeleteAfterDays)
+		if setting.UpdateTime != nil {
+			resp.UpdateTime = timestamppb.New(*setting.UpdateTime)
+		}
+	}
+	return resp
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/webhookdelivery"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+type WebhookService struct {
+	wardenV1.UnimplementedWardenWebhookServiceServer
+
+	log          *log.Helper
+	webhookRepo  *data.WebhookRepo
+	deliveryRepo *data.WebhookDeliveryRepo
+}
+
+func NewWebhookService(
+	ctx *bootstrap.Context,
+	webhookRepo *data.WebhookRepo,
+	deliveryRepo *data.WebhookDeliveryRepo,
+) *WebhookService {
+	return &WebhookService{
+		log:          ctx.NewLoggerHelper("warden/service/webhook"),
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// CreateWebhook registers a webhook for the caller's tenant (tenant admin only)
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *wardenV1.CreateWebhookRequest) (*wardenV1.CreateWebhookResponse, error) {
+	if !isTenantAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only tenant admins can create webhooks")
+	}
+	if err := webhook.ValidateURL(req.Url); err != nil {
+		return nil, wardenV1.ErrorBadRequest("%v", err)
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+
+	eventTypes, err := mapWebhookEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	hook, err := s.webhookRepo.Create(ctx, tenantID, req.Name, req.Url, req.Secret, req.Description, eventTypes, req.Enabled, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Webhook created: id=%d tenant=%d events=%v", hook.ID, tenantID, eventTypes)
+
+	return &wardenV1.CreateWebhookResponse{
+		Webhook: s.webhookRepo.ToProto(hook),
+	}, nil
+}
+
+// GetWebhook gets a webhook of the caller's tenant
+func (s *WebhookService) GetWebhook(ctx context.Context, req *wardenV1.GetWebhookRequest) (*wardenV1.GetWebhookResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	webhook, err := s.webhookRepo.GetByIDAndTenant(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil {
+		return nil, wardenV1.ErrorNotFound("webhook not found")
+	}
+
+	return &wardenV1.GetWebhookResponse{
+		Webhook: s.webhookRepo.ToProto(webhook),
+	}, nil
+}
+
+// ListWebhooks lists the webhooks of the caller's tenant
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *wardenV1.ListWebhooksRequest) (*wardenV1.ListWebhooksResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	page := uint32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+	pageSize := uint32(20)
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	webhooks, total, err := s.webhookRepo.List(ctx, tenantID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	protoWebhooks := make([]*wardenV1.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		protoWebhooks = append(protoWebhooks, s.webhookRepo.ToProto(w))
+	}
+
+	return &wardenV1.ListWebhooksResponse{
+		Webhooks: protoWebhooks,
+		Total:    uint32(total),
+	}, nil
+}
+
+// UpdateWebhook updates a webhook of the caller's tenant (tenant admin only)
+func (s *WebhookService) UpdateWebhook(ctx context.Context, req *wardenV1.UpdateWebhookRequest) (*wardenV1.UpdateWebhookResponse, error) {
+	if !isTenantAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only tenant admins can change webhooks")
+	}
+	if req.Url != nil {
+		if err := webhook.ValidateURL(*req.Url); err != nil {
+			return nil, wardenV1.ErrorBadRequest("%v", err)
+		}
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+
+	var eventTypes []string
+	if len(req.EventTypes) > 0 {
+		var err error
+		if eventTypes, err = mapWebhookEventTypes(req.EventTypes); err != nil {
+			return nil, err
+		}
+	}
+
+	hook, err := s.webhookRepo.Update(ctx, tenantID, req.Id, req.Name, req.Url, req.Secret, req.Description, eventTypes, req.Enabled, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Webhook updated: id=%d tenant=%d", req.Id, tenantID)
+
+	return &wardenV1.UpdateWebhookResponse{
+		Webhook: s.webhookRepo.ToProto(hook),
+	}, nil
+}
+
+// DeleteWebhook deletes a webhook of the caller's tenant (tenant admin only)
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *wardenV1.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	if !isTenantAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only tenant admins can delete webhooks")
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+
+	if err := s.webhookRepo.Delete(ctx, tenantID, req.Id); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Webhook deleted: id=%d tenant=%d", req.Id, tenantID)
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListWebhookDeliveries lists the delivery log of a webhook
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *wardenV1.ListWebhookDeliveriesRequest) (*wardenV1.ListWebhookDeliveriesResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	page := uint32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+	pageSize := uint32(20)
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	var status *webhookdelivery.Status
+	if req.Status != nil && *req.Status != wardenV1.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED {
+		st := webhookdelivery.Status(req.Status.String())
+		status = &st
+	}
+
+	deliveries, total, err := s.deliveryRepo.List(ctx, tenantID, req.WebhookId, status, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	protoDeliveries := make([]*wardenV1.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		protoDeliveries = append(protoDeliveries, s.deliveryRepo.ToProto(d))
+	}
+
+	return &wardenV1.ListWebhookDeliveriesResponse{
+		Deliveries: protoDeliveries,
+		Total:      uint32(total),
+	}, nil
+}
+
+// RedeliverWebhook requeues a delivery for immediate sending (tenant admin only)
+func (s *WebhookService) RedeliverWebhook(ctx context.Context, req *wardenV1.RedeliverWebhookRequest) (*wardenV1.RedeliverWebhookResponse, error) {
+	if !isTenantAdmin(ctx) {
+		return nil, wardenV1.ErrorAccessDenied("only tenant admins can redeliver webhooks")
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+
+	delivery, err := s.deliveryRepo.Redeliver(ctx, tenantID, req.DeliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Webhook delivery requeued: id=%d tenant=%d", req.DeliveryId, tenantID)
+
+	return &wardenV1.RedeliverWebhookResponse{
+		Delivery: s.deliveryRepo.ToProto(delivery),
+	}, nil
+}
+
+func mapWebhookEventTypes(types []wardenV1.WebhookEventType) ([]string, error) {
+	result := make([]string, 0, len(types))
+	for _, t := range types {
+		eventType := data.WebhookEventTypeFromProto(t)
+		if eventType == "" {
+			return nil, wardenV1.ErrorBadRequest("invalid webhook event type")
+		}
+		if !slices.Contains(result, eventType) {
+			result = append(result, eventType)
+		}
+	}
+	return result, nil
+}
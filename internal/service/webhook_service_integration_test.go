@@ -0,0 +1,52 @@
+//go:build integration
+
+package service_test
+
+import (
+	"testing"
+
+	wardentesting "github.com/go-tangra/go-tangra-warden/internal/testing"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+func TestWebhooksManagedByTenantAdmins(t *testing.T) {
+	env := wardentesting.NewEnv(t, wardentesting.WithMemoryVault())
+	tenant := env.Tenant()
+	manager := tenant.User("manager", "tenant:manager")
+	alice := tenant.User("alice")
+	other := env.Tenant().User("other-manager", "tenant:manager")
+
+	create := &wardenV1.CreateWebhookRequest{
+		Name:       "alerts",
+		Url:        "https://hooks.example.com/warden",
+		EventTypes: []wardenV1.WebhookEventType{wardenV1.WebhookEventType_WEBHOOK_EVENT_TYPE_PERMISSION_GRANTED},
+		Enabled:    true,
+	}
+	if _, err := env.WebhookService.CreateWebhook(alice.Context(), create); !wardenV1.IsAccessDenied(err) {
+		t.Fatalf("create by a tenant user got %v, want ACCESS_DENIED", err)
+	}
+
+	created, err := env.WebhookService.CreateWebhook(manager.Context(), create)
+	if err != nil {
+		t.Fatalf("create by a tenant admin: %v", err)
+	}
+	id := created.Webhook.Id
+
+	internal := "https://127.0.0.1/warden"
+	if _, err := env.WebhookService.UpdateWebhook(manager.Context(), &wardenV1.UpdateWebhookRequest{Id: id, Url: &internal}); !wardenV1.IsBadRequest(err) {
+		t.Fatalf("update to a loopback URL got %v, want BAD_REQUEST", err)
+	}
+	renamed := "renamed"
+	if _, err := env.WebhookService.UpdateWebhook(manager.Context(), &wardenV1.UpdateWebhookRequest{Id: id, Name: &renamed}); err != nil {
+		t.Fatalf("update by a tenant admin: %v", err)
+	}
+
+	// Tenant admins only reach the webhooks of their own tenant
+	if _, err := env.WebhookService.DeleteWebhook(other.Context(), &wardenV1.DeleteWebhookRequest{Id: id}); err == nil {
+		t.Fatal("admin of another tenant deleted the webhook")
+	}
+	if _, err := env.WebhookService.DeleteWebhook(manager.Context(), &wardenV1.DeleteWebhookRequest{Id: id}); err != nil {
+		t.Fatalf("delete by a tenant admin: %v", err)
+	}
+}
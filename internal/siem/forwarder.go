@@ -0,0 +1,289 @@
+package siem
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/middleware/audit"
+)
+
+const (
+	defaultBufferSize    = 10000
+	defaultBatchSize     = 100
+	defaultFlushInterval = 2 * time.Second
+	defaultMaxRetries    = 5
+	defaultBlockTimeout  = 50 * time.Millisecond
+)
+
+// Event is the wire format shipped to external sinks.
+type Event struct {
+	AuditID            string            `json:"audit_id"`
+	RequestID          string            `json:"request_id,omitempty"`
+	TenantID           uint32            `json:"tenant_id"`
+	Operation          string            `json:"operation"`
+	ServiceName        string            `json:"service_name"`
+	ClientID           string            `json:"client_id,omitempty"`
+	ClientCommonName   string            `json:"client_common_name,omitempty"`
+	ClientOrganization string            `json:"client_organization,omitempty"`
+	IsAuthenticated    bool              `json:"is_authenticated"`
+	Success            bool              `json:"success"`
+	ErrorCode          int32             `json:"error_code,omitempty"`
+	ErrorMessage       string            `json:"error_message,omitempty"`
+	LatencyMs          int64             `json:"latency_ms"`
+	PeerAddress        string            `json:"peer_address,omitempty"`
+	GeoLocation        map[string]string `json:"geo_location,omitempty"`
+	LogHash            string            `json:"log_hash,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	Timestamp          time.Time         `json:"timestamp"`
+}
+
+// Sink delivers batches of audit events to an external system.
+type Sink interface {
+	Name() string
+	Send(ctx context.Context, events []*Event) error
+	Close() error
+}
+
+// Stats are the forwarder counters since start.
+type Stats struct {
+	Enqueued  uint64
+	Forwarded uint64
+	Dropped   uint64
+	Failed    uint64
+}
+
+// Forwarder ships audit entries to an external SIEM asynchronously. Entries
+// are buffered in memory and sent in batches by a single worker; failed
+// batches are retried with exponential backoff. When the buffer is full,
+// Enqueue blocks for a short time and then drops the entry, so a slow sink
+// never stalls the request path.
+type Forwarder struct {
+	log  *log.Helper
+	sink Sink
+
+	queue         chan *Event
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    int
+	blockTimeout  time.Duration
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+
+	enqueued  atomic.Uint64
+	forwarded atomic.Uint64
+	dropped   atomic.Uint64
+	failed    atomic.Uint64
+}
+
+// NewForwarder creates the forwarder from environment configuration. When
+// AUDIT_FORWARD_SINK is unset the forwarder is disabled and Enqueue is a no-op.
+func NewForwarder(ctx *bootstrap.Context) *Forwarder {
+	l := ctx.NewLoggerHelper("warden/siem")
+
+	f := &Forwarder{
+		log:           l,
+		batchSize:     envInt("AUDIT_FORWARD_BATCH_SIZE", defaultBatchSize),
+		flushInterval: envDuration("AUDIT_FORWARD_FLUSH_INTERVAL", defaultFlushInterval),
+		maxRetries:    envInt("AUDIT_FORWARD_MAX_RETRIES", defaultMaxRetries),
+		blockTimeout:  envDuration("AUDIT_FORWARD_BLOCK_TIMEOUT", defaultBlockTimeout),
+	}
+
+	sink, err := newSinkFromEnv()
+	if err != nil {
+		l.Errorf("Audit forwarding disabled: %v", err)
+		return f
+	}
+	if sink == nil {
+		return f
+	}
+
+	f.sink = sink
+	f.queue = make(chan *Event, envInt("AUDIT_FORWARD_BUFFER_SIZE", defaultBufferSize))
+	return f
+}
+
+// Enabled reports whether a sink is configured.
+func (f *Forwarder) Enabled() bool {
+	return f != nil && f.sink != nil
+}
+
+// Enqueue queues an audit entry for forwarding.
+func (f *Forwarder) Enqueue(entry *audit.AuditLogEntry) {
+	if !f.Enabled() || entry == nil {
+		return
+	}
+
+	ev := &Event{
+		AuditID:            entry.AuditID,
+		RequestID:          entry.RequestID,
+		TenantID:           entry.TenantID,
+		Operation:          entry.Operation,
+		ServiceName:        entry.ServiceName,
+		ClientID:           entry.ClientID,
+		ClientCommonName:   entry.ClientCommonName,
+		ClientOrganization: entry.ClientOrganization,
+		IsAuthenticated:    entry.IsAuthenticated,
+		Success:            entry.Success,
+		ErrorCode:          entry.ErrorCode,
+		ErrorMessage:       entry.ErrorMessage,
+		LatencyMs:          entry.LatencyMs,
+		PeerAddress:        entry.PeerAddress,
+		GeoLocation:        entry.GeoLocation,
+		LogHash:            entry.LogHash,
+		Metadata:           entry.Metadata,
+		Timestamp:          entry.Timestamp,
+	}
+
+	select {
+	case f.queue <- ev:
+		f.enqueued.Add(1)
+		return
+	default:
+	}
+
+	// Buffer full: apply backpressure briefly, then shed load
+	timer := time.NewTimer(f.blockTimeout)
+	defer timer.Stop()
+	select {
+	case f.queue <- ev:
+		f.enqueued.Add(1)
+	case <-timer.C:
+		if f.dropped.Add(1)%1000 == 1 {
+			f.log.Warnf("Audit forward buffer full, dropping entries (dropped=%d)", f.dropped.Load())
+		}
+	}
+}
+
+// Stats returns the forwarder counters.
+func (f *Forwarder) Stats() Stats {
+	return Stats{
+		Enqueued:  f.enqueued.Load(),
+		Forwarded: f.forwarded.Load(),
+		Dropped:   f.dropped.Load(),
+		Failed:    f.failed.Load(),
+	}
+}
+
+// Start implements transport.Server and launches the delivery worker.
+func (f *Forwarder) Start(_ context.Context) error {
+	if !f.Enabled() {
+		return nil
+	}
+	f.stopCh = make(chan struct{})
+	f.wg.Add(1)
+	go f.run()
+	f.log.Infof("Audit forwarding to %s sink started", f.sink.Name())
+	return nil
+}
+
+// Stop implements transport.Server. Buffered entries are flushed before the
+// sink is closed.
+func (f *Forwarder) Stop(_ context.Context) error {
+	if !f.Enabled() || f.stopCh == nil {
+		return nil
+	}
+	close(f.stopCh)
+	f.wg.Wait()
+	s := f.Stats()
+	f.log.Infof("Audit forwarding stopped: forwarded=%d dropped=%d failed=%d", s.Forwarded, s.Dropped, s.Failed)
+	return f.sink.Close()
+}
+
+func (f *Forwarder) run() {
+	defer f.wg.Done()
+
+	ticker := time.NewTicker(f.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Event, 0, f.batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		f.deliver(batch)
+		batch = make([]*Event, 0, f.batchSize)
+	}
+
+	for {
+		select {
+		case ev := <-f.queue:
+			batch = append(batch, ev)
+			if len(batch) >= f.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-f.stopCh:
+			// Drain what is already buffered, then exit
+			for {
+				select {
+				case ev := <-f.queue:
+					batch = append(batch, ev)
+					if len(batch) >= f.batchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// deliver sends a batch with exponential backoff, giving up after maxRetries.
+func (f *Forwarder) deliver(batch []*Event) {
+	backoff := 500 * time.Millisecond
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := f.sink.Send(ctx, batch)
+		cancel()
+		if err == nil {
+			f.forwarded.Add(uint64(len(batch)))
+			return
+		}
+
+		if attempt >= f.maxRetries {
+			f.failed.Add(uint64(len(batch)))
+			f.log.Errorf("Audit forward to %s failed after %d attempts, discarding %d entries: %v",
+				f.sink.Name(), attempt+1, len(batch), err)
+			return
+		}
+
+		f.log.Warnf("Audit forward to %s failed (attempt %d): %v", f.sink.Name(), attempt+1, err)
+		select {
+		case <-time.After(backoff):
+		case <-f.stopCh:
+			// Shutting down: retry without waiting
+		}
+		if backoff < 30*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+func envInt(key string, def int) int {
+	if v := os.Getenv(key); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return def
+}
+
+func envDuration(key string, def time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d
+		}
+	}
+	return def
+}
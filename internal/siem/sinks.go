@@ -0,0 +1,208 @@
+package siem
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// newSinkFromEnv builds the sink selected by AUDIT_FORWARD_SINK:
+//
+//	webhook — POST a JSON array to AUDIT_FORWARD_URL (HMAC-signed when
+//	          AUDIT_FORWARD_SECRET is set)
+//	syslog  — RFC 5424 messages to AUDIT_FORWARD_URL (udp://host:port or tcp://host:port)
+//	kafka   — produce to AUDIT_FORWARD_TOPIC through a Kafka REST Proxy at AUDIT_FORWARD_URL
+//
+// Returns nil when forwarding is not configured.
+func newSinkFromEnv() (Sink, error) {
+	kind := strings.ToLower(os.Getenv("AUDIT_FORWARD_SINK"))
+	url := os.Getenv("AUDIT_FORWARD_URL")
+
+	switch kind {
+	case "":
+		return nil, nil
+	case "webhook":
+		if url == "" {
+			return nil, fmt.Errorf("AUDIT_FORWARD_URL is required for the webhook sink")
+		}
+		return &webhookSink{url: url, secret: os.Getenv("AUDIT_FORWARD_SECRET"), client: newHTTPClient()}, nil
+	case "syslog":
+		return newSyslogSink(url)
+	case "kafka":
+		topic := os.Getenv("AUDIT_FORWARD_TOPIC")
+		if url == "" || topic == "" {
+			return nil, fmt.Errorf("AUDIT_FORWARD_URL and AUDIT_FORWARD_TOPIC are required for the kafka sink")
+		}
+		return &kafkaRESTSink{url: strings.TrimRight(url, "/"), topic: topic, client: newHTTPClient()}, nil
+	default:
+		return nil, fmt.Errorf("unknown AUDIT_FORWARD_SINK %q", kind)
+	}
+}
+
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: 10 * time.Second}
+}
+
+// --- Webhook -----------------------------------------------------------------
+
+type webhookSink struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func (s *webhookSink) Name() string { return "webhook" }
+
+func (s *webhookSink) Send(ctx context.Context, events []*Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.secret != "" {
+		mac := hmac.New(sha256.New, []byte(s.secret))
+		mac.Write(body)
+		req.Header.Set("X-Warden-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return doRequest(s.client, req)
+}
+
+func (s *webhookSink) Close() error { return nil }
+
+// --- Kafka REST Proxy --------------------------------------------------------
+
+type kafkaRESTSink struct {
+	url    string
+	topic  string
+	client *http.Client
+}
+
+func (s *kafkaRESTSink) Name() string { return "kafka" }
+
+func (s *kafkaRESTSink) Send(ctx context.Context, events []*Event) error {
+	type record struct {
+		Key   string `json:"key"`
+		Value *Event `json:"value"`
+	}
+	records := make([]record, 0, len(events))
+	for _, ev := range events {
+		records = append(records, record{Key: fmt.Sprintf("%d", ev.TenantID), Value: ev})
+	}
+	body, err := json.Marshal(map[string]any{"records": records})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url+"/topics/"+s.topic, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	return doRequest(s.client, req)
+}
+
+func (s *kafkaRESTSink) Close() error { return nil }
+
+func doRequest(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// --- Syslog ------------------------------------------------------------------
+
+type syslogSink struct {
+	network  string
+	addr     string
+	hostname string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func newSyslogSink(url string) (*syslogSink, error) {
+	network, addr, ok := strings.Cut(url, "://")
+	if !ok || (network != "udp" && network != "tcp") || addr == "" {
+		return nil, fmt.Errorf("AUDIT_FORWARD_URL must be udp://host:port or tcp://host:port for the syslog sink")
+	}
+	hostname, _ := os.Hostname()
+	if hostname == "" {
+		hostname = "-"
+	}
+	return &syslogSink{network: network, addr: addr, hostname: hostname}, nil
+}
+
+func (s *syslogSink) Name() string { return "syslog" }
+
+func (s *syslogSink) Send(ctx context.Context, events []*Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, s.network, s.addr)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.conn.SetWriteDeadline(deadline)
+	}
+
+	for _, ev := range events {
+		payload, err := json.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		// Facility local4 (20), severity notice (5) or warning (4) for failures
+		pri := 20*8 + 5
+		if !ev.Success {
+			pri = 20*8 + 4
+		}
+		msg := fmt.Sprintf("<%d>1 %s %s warden - audit - %s",
+			pri, ev.Timestamp.UTC().Format(time.RFC3339Nano), s.hostname, payload)
+		if s.network == "tcp" {
+			// RFC 6587 octet counting framing
+			msg = fmt.Sprintf("%d %s", len(msg), msg)
+		}
+		if _, err := s.conn.Write([]byte(msg)); err != nil {
+			_ = s.conn.Close()
+			s.conn = nil
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *syslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn != nil {
+		err := s.conn.Close()
+		s.conn = nil
+		return err
+	}
+	return nil
+}
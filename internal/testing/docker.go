@@ -0,0 +1,100 @@
+package testing
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// containerLabel marks the containers of the harness, so leftovers of an
+// interrupted run can be removed with
+// docker rm -f $(docker ps -aq --filter label=warden-integration-test)
+const containerLabel = "warden-integration-test"
+
+var errNoDocker = errors.New("docker is not available")
+
+var (
+	containersMu sync.Mutex
+	containers   []string
+)
+
+// container is a running container with one published port
+type container struct {
+	id string
+	// addr is the host:port the port is published on
+	addr string
+}
+
+// dockerAvailable reports errNoDocker when the docker CLI or daemon is missing
+func dockerAvailable() error {
+	if _, err := docker("info", "--format", "{{.ServerVersion}}"); err != nil {
+		return fmt.Errorf("%w: %v", errNoDocker, err)
+	}
+	return nil
+}
+
+// runContainer starts image in the background with port published on a
+// random local port. The container is removed by Main.
+func runContainer(image, port string, env map[string]string, dockerArgs []string, cmd ...string) (*container, error) {
+	if err := dockerAvailable(); err != nil {
+		return nil, err
+	}
+
+	args := []string{"run", "-d", "--rm", "--label", containerLabel, "-p", "127.0.0.1::" + port}
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "-e", k+"="+env[k])
+	}
+	args = append(args, dockerArgs...)
+	args = append(args, image)
+	args = append(args, cmd...)
+
+	out, err := docker(args...)
+	if err != nil {
+		return nil, err
+	}
+	id := strings.TrimSpace(out)
+
+	containersMu.Lock()
+	containers = append(containers, id)
+	containersMu.Unlock()
+
+	out, err = docker("port", id, port)
+	if err != nil {
+		return nil, err
+	}
+	// One line per published address, e.g. 127.0.0.1:49153
+	addr, _, _ := strings.Cut(strings.TrimSpace(out), "\n")
+	return &container{id: id, addr: strings.TrimSpace(addr)}, nil
+}
+
+// removeContainers removes the containers started by this process
+func removeContainers() {
+	containersMu.Lock()
+	ids := containers
+	containers = nil
+	containersMu.Unlock()
+
+	if len(ids) > 0 {
+		_, _ = docker(append([]string{"rm", "-f"}, ids...)...)
+	}
+}
+
+func docker(args ...string) (string, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("docker", args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("docker %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return string(out), nil
+}
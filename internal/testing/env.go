@@ -0,0 +1,163 @@
+package testing
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+	"github.com/go-tangra/go-tangra-warden/internal/job"
+	"github.com/go-tangra/go-tangra-warden/internal/metrics"
+	"github.com/go-tangra/go-tangra-warden/internal/service"
+	"github.com/go-tangra/go-tangra-warden/internal/service/providers"
+	"github.com/go-tangra/go-tangra-warden/internal/webhook"
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+)
+
+var (
+	collectorOnce sync.Once
+	collector     *metrics.Collector
+)
+
+// Env is the data layer and the services wired the way the server wires
+// them, on the shared test database and Vault
+type Env struct {
+	Ctx *bootstrap.Context
+
+	EntClient   *ent.Client
+	VaultClient *vault.Client
+	KV          *vault.KVStore
+
+	Folders     *data.FolderRepo
+	Secrets     *data.SecretRepo
+	Versions    *data.SecretVersionRepo
+	Permissions *data.PermissionRepo
+	Settings    *data.TenantSettingRepo
+
+	FolderService     *service.FolderService
+	SecretService     *service.SecretService
+	PermissionService *service.PermissionService
+	BitwardenService  *service.BitwardenTransferService
+	CsvService        *service.CsvTransferService
+	BackupService     *service.BackupService
+	WebhookService    *service.WebhookService
+}
+
+type options struct {
+	memoryVault bool
+}
+
+// Option changes how NewEnv sets up the environment
+type Option func(*options)
+
+// WithMemoryVault stores passwords in the in-memory KV store instead of the
+// Vault container, for tests that only need the database
+func WithMemoryVault() Option {
+	return func(o *options) { o.memoryVault = true }
+}
+
+// NewEnv migrates the shared test database and builds the services on it.
+// Everything is closed when the test ends.
+func NewEnv(t TB, opts ...Option) *Env {
+	t.Helper()
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	logger := log.NewFilter(log.NewStdLogger(os.Stderr), log.FilterLevel(log.LevelWarn))
+	cfg := &conf.Bootstrap{
+		Data: &conf.Data{
+			Database: &conf.Data_Database{
+				Driver:  "postgres",
+				Source:  PostgresDSN(t),
+				Migrate: true,
+			},
+		},
+	}
+	ctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{
+		Project: "warden",
+		AppId:   "warden-integration-test",
+	}, cfg, logger)
+
+	var vaultClient *vault.Client
+	if o.memoryVault {
+		vaultClient = vault.NewMemoryClient(logger)
+	} else {
+		vaultClient = Vault(t).NewClient(t, logger)
+	}
+
+	collectorOnce.Do(func() {
+		collector = metrics.NewCollector(ctx)
+	})
+
+	entClient, cleanup, err := data.NewEntClient(ctx)
+	if err != nil {
+		t.Fatalf("create ent client: %v", err)
+	}
+	t.Cleanup(cleanup)
+	readReplica, cleanupReplica, err := data.NewReadReplica(ctx)
+	if err != nil {
+		t.Fatalf("create read replica: %v", err)
+	}
+	t.Cleanup(cleanupReplica)
+
+	folderRepo := data.NewFolderRepo(ctx, entClient, readReplica)
+	secretRepo := data.NewSecretRepo(ctx, entClient, readReplica)
+	secretVersionRepo := data.NewSecretVersionRepo(ctx, entClient, readReplica)
+	permissionRepo := data.NewPermissionRepo(ctx, entClient)
+	tenantSettingRepo := data.NewTenantSettingRepo(ctx, entClient)
+	kvStore, err := data.NewVaultKVStore(ctx, vaultClient, collector, tenantSettingRepo)
+	if err != nil {
+		t.Fatalf("create vault kv store: %v", err)
+	}
+	if _, err := data.NewFieldCipher(ctx, entClient, readReplica, vaultClient); err != nil {
+		t.Fatalf("create field cipher: %v", err)
+	}
+	pendingOperationRepo := data.NewPendingOperationRepo(ctx, entClient, kvStore)
+
+	permissionStore := providers.ProvidePermissionStore(permissionRepo)
+	collectionRepo := data.NewCollectionRepo(ctx, entClient, readReplica)
+	resourceLookup := providers.ProvideResourceLookup(folderRepo, secretRepo, collectionRepo)
+	engine := providers.ProvideAuthzEngine(permissionStore, resourceLookup, ctx, collector)
+	checker := providers.ProvideAuthzChecker(engine)
+
+	webhookRepo := data.NewWebhookRepo(ctx, entClient)
+	webhookDeliveryRepo := data.NewWebhookDeliveryRepo(ctx, entClient)
+	dispatcher := webhook.NewDispatcher(ctx, webhookRepo, webhookDeliveryRepo, secretRepo)
+	transactor := data.NewTransactor(ctx, entClient)
+	secretUsageRepo := data.NewSecretUsageRepo(ctx, entClient, readReplica)
+	versionPinRepo := data.NewVersionPinRepo(ctx, entClient)
+	accessTracker := job.NewAccessTracker(ctx, secretRepo)
+	payloadLimits := service.NewPayloadLimits(ctx)
+	retrievalTokenStore := data.NewRetrievalTokenStore(ctx, nil)
+	stepUpPolicy := service.NewStepUpPolicy(ctx)
+	canaryAlarm := service.NewCanaryAlarm(ctx, data.NewSecurityAlertRepo(ctx, entClient), dispatcher)
+
+	return &Env{
+		Ctx:         ctx,
+		EntClient:   entClient.Client(),
+		VaultClient: vaultClient,
+		KV:          kvStore,
+
+		Folders:     folderRepo,
+		Secrets:     secretRepo,
+		Versions:    secretVersionRepo,
+		Permissions: permissionRepo,
+		Settings:    tenantSettingRepo,
+
+		FolderService:     service.NewFolderService(ctx, folderRepo, secretRepo, secretVersionRepo, permissionRepo, kvStore, checker, collector, secretUsageRepo, tenantSettingRepo, data.NewFolderChangeLogRepo(ctx, entClient, readReplica), transactor),
+		SecretService:     service.NewSecretService(ctx, secretRepo, secretVersionRepo, folderRepo, permissionRepo, kvStore, checker, collector, tenantSettingRepo, transactor, pendingOperationRepo, accessTracker, dispatcher, payloadLimits, retrievalTokenStore, stepUpPolicy, canaryAlarm, secretUsageRepo, versionPinRepo, collectionRepo, data.NewAuditLogRepo(ctx, entClient, readReplica)),
+		PermissionService: service.NewPermissionService(ctx, permissionRepo, folderRepo, secretRepo, collectionRepo, engine, checker, dispatcher),
+		BitwardenService:  service.NewBitwardenTransferService(ctx, secretRepo, folderRepo, secretVersionRepo, permissionRepo, collectionRepo, kvStore, checker, collector, dispatcher, tenantSettingRepo, payloadLimits, transactor, pendingOperationRepo, stepUpPolicy, canaryAlarm),
+		CsvService:        service.NewCsvTransferService(ctx, secretRepo, folderRepo, secretVersionRepo, permissionRepo, kvStore, checker, collector, dispatcher, tenantSettingRepo, payloadLimits, transactor, pendingOperationRepo, stepUpPolicy, canaryAlarm),
+		BackupService:     service.NewBackupService(ctx, entClient, kvStore, dispatcher, tenantSettingRepo, payloadLimits),
+		WebhookService:    service.NewWebhookService(ctx, webhookRepo, webhookDeliveryRepo),
+	}
+}
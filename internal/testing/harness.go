@@ -0,0 +1,82 @@
+// Package testing is the harness of Warden's integration tests. It starts
+// PostgreSQL and a Vault dev server in Docker containers, wires the data
+// layer and the services the way the server does, and seeds tenants, users,
+// folders, secrets and permissions:
+//
+//	//go:build integration
+//
+//	func TestMain(m *testing.M) { os.Exit(wardentesting.Main(m)) }
+//
+//	func TestViewerCannotDelete(t *testing.T) {
+//		env := wardentesting.NewEnv(t)
+//		tenant := env.Tenant()
+//		alice, bob := tenant.User("alice"), tenant.User("bob")
+//		folder := env.CreateFolder(t, alice, nil, "infra")
+//		secret := env.CreateSecret(t, alice, &folder.Id, "db", "s3cret")
+//		env.Grant(t, authz.ResourceTypeSecret, secret.Id, authz.RelationViewer, bob)
+//		_, err := env.SecretService.DeleteSecret(bob.Context(), &wardenV1.DeleteSecretRequest{Id: secret.Id})
+//		...
+//	}
+//
+// The containers are started on first use and shared by the tests of a
+// package; Main removes them when the tests are done. Every Tenant gets an
+// ID of its own, so tests only see their own data and can run in parallel.
+// Set WARDEN_TEST_POSTGRES_DSN, or WARDEN_TEST_VAULT_ADDR together with
+// WARDEN_TEST_VAULT_TOKEN, to use running services instead of containers.
+// Tests are skipped when Docker is needed but not available.
+package testing
+
+import (
+	"errors"
+	"time"
+)
+
+// TB is the part of testing.TB the harness uses
+type TB interface {
+	Helper()
+	Logf(format string, args ...any)
+	Fatalf(format string, args ...any)
+	Skipf(format string, args ...any)
+	Cleanup(func())
+}
+
+// M is the part of testing.M Main uses
+type M interface {
+	Run() int
+}
+
+// Main runs the tests of a package and removes the containers they started.
+// Call it from TestMain.
+func Main(m M) int {
+	code := m.Run()
+	removeContainers()
+	return code
+}
+
+// waitFor calls fn until it succeeds or timeout passes, returning the last
+// error
+func waitFor(timeout time.Duration, fn func() error) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return errors.Join(errors.New("timed out"), err)
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+}
+
+// require skips the test when err says Docker is missing and fails it on any
+// other error
+func require(t TB, what string, err error) {
+	t.Helper()
+	if errors.Is(err, errNoDocker) {
+		t.Skipf("%s: %v", what, err)
+	}
+	if err != nil {
+		t.Fatalf("%s: %v", what, err)
+	}
+}
@@ -0,0 +1,61 @@
+package testing
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+const (
+	postgresImage        = "postgres:16-alpine"
+	postgresStartTimeout = 60 * time.Second
+)
+
+var (
+	postgresOnce sync.Once
+	postgresDSN  string
+	postgresErr  error
+)
+
+// PostgresDSN returns the DSN of the shared test database, starting its
+// container on first use, or WARDEN_TEST_POSTGRES_DSN when set
+func PostgresDSN(t TB) string {
+	t.Helper()
+	postgresOnce.Do(func() {
+		if dsn := os.Getenv("WARDEN_TEST_POSTGRES_DSN"); dsn != "" {
+			postgresDSN = dsn
+			return
+		}
+		postgresDSN, postgresErr = startPostgres()
+	})
+	require(t, "start postgres", postgresErr)
+	return postgresDSN
+}
+
+func startPostgres() (string, error) {
+	c, err := runContainer(postgresImage, "5432/tcp", map[string]string{
+		"POSTGRES_USER":     "warden",
+		"POSTGRES_PASSWORD": "warden",
+		"POSTGRES_DB":       "warden",
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	dsn := fmt.Sprintf("postgres://warden:warden@%s/warden?sslmode=disable", c.addr)
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	// The server only listens on TCP once initialization is complete
+	if err := waitFor(postgresStartTimeout, db.Ping); err != nil {
+		return "", fmt.Errorf("postgres did not become ready: %w", err)
+	}
+	return dsn, nil
+}
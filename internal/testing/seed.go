@@ -0,0 +1,168 @@
+package testing
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/metadata"
+	"github.com/go-tangra/go-tangra-common/grpcx"
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+	grpcMD "google.golang.org/grpc/metadata"
+
+	"github.com/go-tangra/go-tangra-warden/internal/authz"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/folder"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent/secret"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// tenantSeq hands out tenant IDs. It starts at a random offset, so tests of
+// different packages, and repeated runs against one database, do not share
+// tenants.
+var tenantSeq atomic.Uint32
+
+func init() {
+	tenantSeq.Store(1_000_000 + rand.Uint32N(1_000_000_000))
+}
+
+// Tenant is a tenant of its own for a test
+type Tenant struct {
+	ID uint32
+
+	userSeq atomic.Uint32
+}
+
+// Tenant returns a new tenant no other test uses
+func (e *Env) Tenant() *Tenant {
+	return &Tenant{ID: tenantSeq.Add(1)}
+}
+
+// User is a user of a tenant with its roles
+type User struct {
+	TenantID uint32
+	ID       uint32
+	Username string
+	Roles    []string
+}
+
+// User returns a new user of the tenant with the given roles, such as
+// "platform:admin"
+func (t *Tenant) User(username string, roles ...string) *User {
+	return &User{
+		TenantID: t.ID,
+		ID:       t.userSeq.Add(1),
+		Username: username,
+		Roles:    roles,
+	}
+}
+
+// Context returns a context carrying the user's identity in the metadata
+// the gateway sets, and the system viewer the server's middleware adds, to
+// call the services as the user
+func (u *User) Context() context.Context {
+	md := map[string]string{
+		grpcx.MDTenantID: strconv.FormatUint(uint64(u.TenantID), 10),
+		grpcx.MDUserID:   strconv.FormatUint(uint64(u.ID), 10),
+		grpcx.MDUsername: u.Username,
+	}
+	if len(u.Roles) > 0 {
+		md[grpcx.MDRoles] = strings.Join(u.Roles, ",")
+	}
+
+	ctx := grpcMD.NewIncomingContext(context.Background(), grpcMD.New(md))
+	serverMD := metadata.New()
+	for k, v := range md {
+		serverMD.Set(k, v)
+	}
+	return appViewer.NewSystemViewerContext(metadata.NewServerContext(ctx, serverMD))
+}
+
+// CreateFolder creates a folder as the user, at the root without parentID
+func (e *Env) CreateFolder(t TB, u *User, parentID *string, name string) *wardenV1.Folder {
+	t.Helper()
+	resp, err := e.FolderService.CreateFolder(u.Context(), &wardenV1.CreateFolderRequest{
+		ParentId: parentID,
+		Name:     name,
+	})
+	if err != nil {
+		t.Fatalf("create folder %q: %v", name, err)
+	}
+	return resp.Folder
+}
+
+// CreateSecret creates a secret with a password as the user, at the root
+// without folderID
+func (e *Env) CreateSecret(t TB, u *User, folderID *string, name, password string) *wardenV1.Secret {
+	t.Helper()
+	resp, err := e.SecretService.CreateSecret(u.Context(), &wardenV1.CreateSecretRequest{
+		FolderId: folderID,
+		Name:     name,
+		Password: password,
+	})
+	if err != nil {
+		t.Fatalf("create secret %q: %v", name, err)
+	}
+	return resp.Secret
+}
+
+// Grant gives the user a relation on a folder or secret directly in the
+// database, without the checks of PermissionService
+func (e *Env) Grant(t TB, resourceType authz.ResourceType, resourceID string, relation authz.Relation, u *User) {
+	t.Helper()
+	if _, err := e.Permissions.Create(appViewer.NewSystemViewerContext(context.Background()), u.TenantID,
+		string(resourceType), resourceID, string(relation),
+		string(authz.SubjectTypeUser), strconv.FormatUint(uint64(u.ID), 10), nil, nil); err != nil {
+		t.Fatalf("grant %s on %s to %s: %v", relation, resourceID, u.Username, err)
+	}
+}
+
+// Password reads the current password of a secret as the user
+func (e *Env) Password(t TB, u *User, secretID string) string {
+	t.Helper()
+	resp, err := e.SecretService.GetSecretPassword(u.Context(), &wardenV1.GetSecretPasswordRequest{Id: secretID})
+	if err != nil {
+		t.Fatalf("read password of %s: %v", secretID, err)
+	}
+	return resp.Password
+}
+
+// FolderPaths returns the paths of the tenant's active folders, sorted
+func (e *Env) FolderPaths(t TB, tenantID uint32) []string {
+	t.Helper()
+	paths, err := e.EntClient.Folder.Query().
+		Where(folder.TenantIDEQ(tenantID), folder.StatusEQ(folder.StatusFOLDER_STATUS_ACTIVE)).
+		Select(folder.FieldPath).
+		Strings(appViewer.NewSystemViewerContext(context.Background()))
+	if err != nil {
+		t.Fatalf("list folders of tenant %d: %v", tenantID, err)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// SecretPaths returns the tenant's active secrets as their folder path and
+// name ("/infra/db", "/db" at the root level), sorted
+func (e *Env) SecretPaths(t TB, tenantID uint32) []string {
+	t.Helper()
+	secrets, err := e.EntClient.Secret.Query().
+		Where(secret.TenantIDEQ(tenantID), secret.StatusEQ(secret.StatusSECRET_STATUS_ACTIVE)).
+		WithFolder().
+		All(appViewer.NewSystemViewerContext(context.Background()))
+	if err != nil {
+		t.Fatalf("list secrets of tenant %d: %v", tenantID, err)
+	}
+	paths := make([]string, 0, len(secrets))
+	for _, s := range secrets {
+		var folderPath string
+		if s.Edges.Folder != nil {
+			folderPath = s.Edges.Folder.Path
+		}
+		paths = append(paths, folderPath+"/"+s.Name)
+	}
+	slices.Sort(paths)
+	return paths
+}
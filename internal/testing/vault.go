@@ -0,0 +1,151 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	vaultapi "github.com/hashicorp/vault/api"
+
+	"github.com/go-tangra/go-tangra-warden/pkg/vault"
+)
+
+const (
+	vaultImage        = "hashicorp/vault:1.17"
+	vaultRootToken    = "warden-test-root"
+	vaultMountPath    = "secret"
+	vaultStartTimeout = 30 * time.Second
+
+	// vaultPolicy grants the AppRole of the tests the KV v2 operations
+	// Warden uses on the dev server's secret/ mount
+	vaultPolicy = `path "secret/*" { capabilities = ["create", "read", "update", "delete", "list", "patch"] }`
+)
+
+// VaultServer is the shared Vault dev server with an AppRole for Warden
+type VaultServer struct {
+	Addr     string
+	Token    string
+	RoleID   string
+	SecretID string
+}
+
+var (
+	vaultOnce   sync.Once
+	vaultServer *VaultServer
+	vaultErr    error
+)
+
+// Vault returns the shared Vault dev server, starting its container on first
+// use, or the server at WARDEN_TEST_VAULT_ADDR authenticated with
+// WARDEN_TEST_VAULT_TOKEN when set. An AppRole is set up on it so clients
+// log in the way the server does.
+func Vault(t TB) *VaultServer {
+	t.Helper()
+	vaultOnce.Do(func() {
+		vaultServer, vaultErr = startVault()
+	})
+	require(t, "start vault", vaultErr)
+	return vaultServer
+}
+
+// NewClient logs in to the server with its AppRole. The client is closed
+// when the test ends.
+func (v *VaultServer) NewClient(t TB, logger log.Logger) *vault.Client {
+	t.Helper()
+	client, err := vault.NewClient(&vault.Config{
+		Address:      v.Addr,
+		RoleID:       v.RoleID,
+		SecretID:     v.SecretID,
+		MountPath:    vaultMountPath,
+		RetryMax:     1,
+		RetryWaitMin: 100 * time.Millisecond,
+		RetryWaitMax: time.Second,
+		Timeout:      10 * time.Second,
+	}, logger)
+	if err != nil {
+		t.Fatalf("create vault client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func startVault() (*VaultServer, error) {
+	addr, token := os.Getenv("WARDEN_TEST_VAULT_ADDR"), os.Getenv("WARDEN_TEST_VAULT_TOKEN")
+	if addr == "" {
+		c, err := runContainer(vaultImage, "8200/tcp", map[string]string{
+			"VAULT_DEV_ROOT_TOKEN_ID":  vaultRootToken,
+			"VAULT_DEV_LISTEN_ADDRESS": "0.0.0.0:8200",
+		}, []string{"--cap-add", "IPC_LOCK"})
+		if err != nil {
+			return nil, err
+		}
+		addr, token = "http://"+c.addr, vaultRootToken
+	} else if token == "" {
+		return nil, errors.New("WARDEN_TEST_VAULT_ADDR is set without WARDEN_TEST_VAULT_TOKEN")
+	}
+
+	cfg := vaultapi.DefaultConfig()
+	cfg.Address = addr
+	client, err := vaultapi.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	client.SetToken(token)
+
+	if err := waitFor(vaultStartTimeout, func() error {
+		health, err := client.Sys().Health()
+		if err != nil {
+			return err
+		}
+		if health.Sealed {
+			return errors.New("vault is sealed")
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("vault did not become ready: %w", err)
+	}
+
+	roleID, secretID, err := setupAppRole(client)
+	if err != nil {
+		return nil, fmt.Errorf("set up approle: %w", err)
+	}
+	return &VaultServer{Addr: addr, Token: token, RoleID: roleID, SecretID: secretID}, nil
+}
+
+// setupAppRole enables AppRole auth and creates a role allowed to use the
+// KV mount, returning its credentials
+func setupAppRole(client *vaultapi.Client) (string, string, error) {
+	if err := client.Sys().EnableAuthWithOptions("approle", &vaultapi.EnableAuthOptions{Type: "approle"}); err != nil &&
+		!strings.Contains(err.Error(), "path is already in use") {
+		return "", "", err
+	}
+	if err := client.Sys().PutPolicy("warden-test", vaultPolicy); err != nil {
+		return "", "", err
+	}
+	if _, err := client.Logical().Write("auth/approle/role/warden-test", map[string]any{
+		"token_policies": "warden-test",
+		"token_ttl":      "1h",
+	}); err != nil {
+		return "", "", err
+	}
+
+	role, err := client.Logical().Read("auth/approle/role/warden-test/role-id")
+	if err != nil {
+		return "", "", err
+	}
+	secret, err := client.Logical().Write("auth/approle/role/warden-test/secret-id", nil)
+	if err != nil {
+		return "", "", err
+	}
+	if role == nil || secret == nil {
+		return "", "", errors.New("approle returned no credentials")
+	}
+
+	roleID, _ := role.Data["role_id"].(string)
+	secretID, _ := secret.Data["secret_id"].(string)
+	return roleID, secretID, nil
+}
@@ -0,0 +1,348 @@
+// Package webhook delivers Warden events to tenant-registered HTTP endpoints.
+// Events are persisted as delivery rows first and sent by a background worker,
+// so a slow or unreachable endpoint never blocks the request that raised them.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-warden/internal/data"
+	"github.com/go-tangra/go-tangra-warden/internal/data/ent"
+)
+
+const (
+	defaultPollInterval       = 10 * time.Second
+	defaultMaxAttempts        = 8
+	defaultExpiryScanInterval = time.Hour
+	defaultExpiryWindow       = 7 * 24 * time.Hour
+
+	claimBatchSize = 50
+	claimLease     = 2 * time.Minute
+	maxBackoff     = 6 * time.Hour
+)
+
+// Event types, as stored in webhook subscriptions
+const (
+	EventSecretExpiring    = data.WebhookEventSecretExpiring
+	EventPermissionGranted = data.WebhookEventPermissionGranted
+	EventImportFinished    = data.WebhookEventImportFinished
+	EventBackupCompleted   = data.WebhookEventBackupCompleted
+	EventSecretRead        = data.WebhookEventSecretRead
+	EventCanaryRead        = data.WebhookEventCanaryRead
+)
+
+// Payload is the JSON body posted to webhook endpoints.
+type Payload struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	TenantID   uint32         `json:"tenant_id"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	Data       map[string]any `json:"data,omitempty"`
+}
+
+// Dispatcher queues events for subscribed webhooks and runs the delivery
+// worker. Failed deliveries are retried with exponential backoff up to
+// WEBHOOK_MAX_ATTEMPTS; every attempt is recorded in the delivery log.
+// The worker also scans for secrets whose metadata expiry is approaching.
+type Dispatcher struct {
+	log          *log.Helper
+	webhookRepo  *data.WebhookRepo
+	deliveryRepo *data.WebhookDeliveryRepo
+	secretRepo   *data.SecretRepo
+	client       *http.Client
+
+	pollInterval       time.Duration
+	maxAttempts        int32
+	expiryScanInterval time.Duration
+	expiryWindow       time.Duration
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewDispatcher creates the dispatcher from environment configuration.
+func NewDispatcher(ctx *bootstrap.Context, webhookRepo *data.WebhookRepo, deliveryRepo *data.WebhookDeliveryRepo, secretRepo *data.SecretRepo) *Dispatcher {
+	return &Dispatcher{
+		log:                ctx.NewLoggerHelper("warden/webhook"),
+		webhookRepo:        webhookRepo,
+		deliveryRepo:       deliveryRepo,
+		secretRepo:         secretRepo,
+		client:             newHTTPClient(),
+		pollInterval:       envDuration("WEBHOOK_POLL_INTERVAL", defaultPollInterval),
+		maxAttempts:        int32(envInt("WEBHOOK_MAX_ATTEMPTS", defaultMaxAttempts)),
+		expiryScanInterval: envDuration("WEBHOOK_EXPIRY_SCAN_INTERVAL", defaultExpiryScanInterval),
+		expiryWindow:       envDuration("WEBHOOK_EXPIRY_WINDOW", defaultExpiryWindow),
+	}
+}
+
+// Publish queues an event for every enabled webhook of the tenant subscribed
+// to its type. Failures are logged and never returned, so callers can publish
+// after their own work has succeeded.
+func (d *Dispatcher) Publish(ctx context.Context, tenantID uint32, eventType string, eventData map[string]any) {
+	d.publish(ctx, tenantID, eventType, uuid.New().String(), eventData)
+}
+
+func (d *Dispatcher) publish(ctx context.Context, tenantID uint32, eventType, eventID string, eventData map[string]any) {
+	if d == nil {
+		return
+	}
+
+	hooks, err := d.webhookRepo.ListSubscribed(ctx, tenantID, eventType)
+	if err != nil {
+		d.log.Errorf("Publish %s for tenant %d failed: %v", eventType, tenantID, err)
+		return
+	}
+	if len(hooks) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(&Payload{
+		ID:         eventID,
+		Type:       eventType,
+		TenantID:   tenantID,
+		OccurredAt: time.Now().UTC(),
+		Data:       eventData,
+	})
+	if err != nil {
+		d.log.Errorf("Marshal %s event failed: %v", eventType, err)
+		return
+	}
+
+	for _, hook := range hooks {
+		if _, err := d.deliveryRepo.Create(ctx, tenantID, hook.ID, eventID, eventType, string(body)); err != nil {
+			d.log.Errorf("Queue %s for webhook %d failed: %v", eventType, hook.ID, err)
+		}
+	}
+}
+
+// Start implements transport.Server and launches the delivery worker.
+func (d *Dispatcher) Start(_ context.Context) error {
+	d.stopCh = make(chan struct{})
+	d.wg.Add(1)
+	go d.run()
+	d.log.Infof("Webhook dispatcher started: poll=%s max_attempts=%d", d.pollInterval, d.maxAttempts)
+	return nil
+}
+
+// Stop implements transport.Server and waits for in-flight deliveries.
+func (d *Dispatcher) Stop(_ context.Context) error {
+	if d.stopCh != nil {
+		close(d.stopCh)
+		d.wg.Wait()
+	}
+	return nil
+}
+
+func (d *Dispatcher) run() {
+	defer d.wg.Done()
+
+	poll := time.NewTicker(d.pollInterval)
+	defer poll.Stop()
+	scan := time.NewTicker(d.expiryScanInterval)
+	defer scan.Stop()
+
+	for {
+		select {
+		case <-d.stopCh:
+			return
+		case <-poll.C:
+			d.deliverDue()
+		case <-scan.C:
+			d.scanExpiringSecrets()
+		}
+	}
+}
+
+// deliverDue sends every due delivery, batch by batch
+func (d *Dispatcher) deliverDue() {
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+
+	for {
+		deliveries, err := d.deliveryRepo.ClaimDue(ctx, time.Now(), claimLease, claimBatchSize)
+		if err != nil {
+			d.log.Errorf("Claim webhook deliveries failed: %v", err)
+			return
+		}
+
+		hooks := make(map[uint32]*ent.Webhook)
+		for _, delivery := range deliveries {
+			select {
+			case <-d.stopCh:
+				// Unsent deliveries become due again once their lease expires
+				return
+			default:
+			}
+
+			hook, ok := hooks[delivery.WebhookID]
+			if !ok {
+				hook, err = d.webhookRepo.GetByID(ctx, delivery.WebhookID)
+				if err != nil {
+					return
+				}
+				hooks[delivery.WebhookID] = hook
+			}
+			d.attempt(ctx, hook, delivery)
+		}
+
+		if len(deliveries) < claimBatchSize {
+			return
+		}
+	}
+}
+
+// attempt sends one delivery and records the outcome
+func (d *Dispatcher) attempt(ctx context.Context, hook *ent.Webhook, delivery *ent.WebhookDelivery) {
+	attempts := delivery.Attempts + 1
+
+	var code *int32
+	var sendErr error
+	switch {
+	case hook == nil:
+		sendErr = fmt.Errorf("webhook deleted")
+		attempts = d.maxAttempts
+	case !hook.Enabled:
+		sendErr = fmt.Errorf("webhook disabled")
+		attempts = d.maxAttempts
+	default:
+		code, sendErr = d.send(ctx, hook, delivery)
+	}
+
+	if sendErr == nil {
+		if err := d.deliveryRepo.RecordAttempt(ctx, delivery.ID, attempts, code, "", nil); err != nil {
+			d.log.Errorf("Record webhook delivery %d failed: %v", delivery.ID, err)
+		}
+		return
+	}
+
+	var next *time.Time
+	if attempts < d.maxAttempts {
+		t := time.Now().Add(backoff(attempts))
+		next = &t
+	} else {
+		d.log.Warnf("Webhook delivery %d failed permanently after %d attempts: %v", delivery.ID, attempts, sendErr)
+	}
+	if err := d.deliveryRepo.RecordAttempt(ctx, delivery.ID, attempts, code, sendErr.Error(), next); err != nil {
+		d.log.Errorf("Record webhook delivery %d failed: %v", delivery.ID, err)
+	}
+}
+
+// send posts the payload, signing it with the webhook secret when one is set
+func (d *Dispatcher) send(ctx context.Context, hook *ent.Webhook, delivery *ent.WebhookDelivery) (*int32, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Webhooks stored before the URL rules, or edited in the database
+	if err := ValidateURL(hook.URL); err != nil {
+		return nil, err
+	}
+
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Warden-Event", delivery.EventType)
+	req.Header.Set("X-Warden-Delivery", strconv.FormatUint(uint64(delivery.ID), 10))
+	if hook.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(hook.Secret))
+		mac.Write(body)
+		req.Header.Set("X-Warden-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	code := int32(resp.StatusCode)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &code, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return &code, nil
+}
+
+// scanExpiringSecrets raises secret.expiring once per secret and expiry date
+// for tenants subscribed to it
+func (d *Dispatcher) scanExpiringSecrets() {
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+
+	tenants, err := d.webhookRepo.ListTenantsSubscribed(ctx, EventSecretExpiring)
+	if err != nil {
+		d.log.Errorf("Expiring secret scan failed: %v", err)
+		return
+	}
+
+	before := time.Now().Add(d.expiryWindow)
+	for _, tenantID := range tenants {
+		secrets, err := d.secretRepo.ListExpiring(ctx, tenantID, before)
+		if err != nil {
+			d.log.Errorf("Expiring secret scan for tenant %d failed: %v", tenantID, err)
+			continue
+		}
+		for _, s := range secrets {
+			// Deterministic ID: deliveries are unique per webhook and event ID
+			eventID := EventSecretExpiring + ":" + s.Secret.ID + ":" + s.ExpiresAt.UTC().Format(time.RFC3339)
+			eventData := map[string]any{
+				"secret_id":  s.Secret.ID,
+				"name":       s.Secret.Name,
+				"expires_at": s.ExpiresAt.UTC(),
+				"expired":    s.ExpiresAt.Before(time.Now()),
+			}
+			if s.Secret.FolderID != nil {
+				eventData["folder_id"] = *s.Secret.FolderID
+			}
+			d.publish(ctx, tenantID, EventSecretExpiring, eventID, eventData)
+		}
+	}
+}
+
+// backoff returns the wait before the next attempt: 30s, 1m, 2m, ... capped at 6h
+func backoff(attempts int32) time.Duration {
+	wait := 30 * time.Second
+	for i := int32(1); i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		wait = maxBackoff
+	}
+	return wait
+}
+
+func envInt(key string, def int) int {
+	if v := os.Getenv(key); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return def
+}
+
+func envDuration(key string, def time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d
+		}
+	}
+	return def
+}
@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned for webhook endpoints on loopback, private,
+// link-local (cloud metadata included) and other non-public addresses
+var ErrForbiddenAddress = errors.New("webhook endpoint address is not public")
+
+// nonPublicPrefixes are the special-purpose ranges netip has no predicate for
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"),  // carrier-grade NAT, some metadata services
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),  // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),    // reserved, broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64, reaches IPv4 addresses
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64
+	netip.MustParsePrefix("2001:db8::/32"),  // documentation
+	netip.MustParsePrefix("2002::/16"),      // 6to4, embeds IPv4 addresses
+	netip.MustParsePrefix("2001::/32"),      // Teredo, embeds IPv4 addresses
+}
+
+// allowPrivateNetworks lets webhooks post to private addresses, for receivers
+// inside the same network (WEBHOOK_ALLOW_PRIVATE_NETWORKS)
+func allowPrivateNetworks() bool {
+	allow, _ := strconv.ParseBool(os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS"))
+	return allow
+}
+
+// isPublicAddr reports whether addr may be reached by webhook deliveries
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsMulticast() ||
+		addr.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateURL checks a webhook endpoint: it must be an https URL with a host,
+// and when the host is an IP address, a public one. Host names are checked
+// when deliveries connect, against the addresses they resolve to.
+func ValidateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if u.Scheme != "https" {
+		return errors.New("webhook URL must use https")
+	}
+	if u.Hostname() == "" {
+		return errors.New("webhook URL has no host")
+	}
+	if u.User != nil {
+		return errors.New("webhook URL must not carry credentials")
+	}
+	if addr, err := netip.ParseAddr(u.Hostname()); err == nil && !allowPrivateNetworks() && !isPublicAddr(addr) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+	return nil
+}
+
+// newHTTPClient returns the client deliveries are posted with. It does not
+// follow redirects, ignores proxy settings and, unless private networks are
+// allowed, refuses to connect to non-public addresses. The check runs on the
+// address actually dialed, so a host name resolving (or re-resolving) to an
+// internal address is caught too.
+func newHTTPClient() *http.Client {
+	dialer := &net.Dialer{Timeout: 5 * time.Second, KeepAlive: 30 * time.Second}
+	if !allowPrivateNetworks() {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if !isPublicAddr(addr) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: transport,
+		// A redirect could point anywhere; the 3xx fails the attempt instead
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,183 @@
+// Package client is the Go SDK of Warden's gRPC API. It dials the service
+// with mTLS, sends the caller's tenant and user with every call, retries
+// reads while the service is unavailable and turns API errors into values
+// that can be matched with errors.Is:
+//
+//	c, err := client.NewClient(&client.Config{
+//		Endpoint: "warden-service:9300",
+//		CAFile:   "/app/certs/ca/ca.crt",
+//		CertFile: "/app/certs/client/client.crt",
+//		KeyFile:  "/app/certs/client/client.key",
+//		Identity: client.Identity{TenantID: 1, UserID: 42, Username: "deployer"},
+//	}, logger)
+//	...
+//	password, err := c.GetPasswordByPath(ctx, "/Team/Infra/db")
+//	if errors.Is(err, client.ErrNotFound) { ... }
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// Config holds the client configuration
+type Config struct {
+	// Endpoint is the gRPC address of the service, e.g. "warden-service:9300"
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+
+	// CAFile verifies the server certificate; the system roots are used
+	// when empty. CertFile and KeyFile are the client certificate for mTLS.
+	CAFile     string `json:"ca_file" yaml:"ca_file"`
+	CertFile   string `json:"cert_file" yaml:"cert_file"`
+	KeyFile    string `json:"key_file" yaml:"key_file"`
+	ServerName string `json:"server_name" yaml:"server_name"`
+	// Insecure dials without TLS (dev only)
+	Insecure bool `json:"insecure" yaml:"insecure"`
+
+	// Identity is sent with every call that does not carry one of its own
+	Identity Identity `json:"identity" yaml:"identity"`
+	// Token is sent as a bearer token, for servers with AUTH_MODE=jwt
+	Token string `json:"token" yaml:"token"`
+
+	RetryMax     int           `json:"retry_max" yaml:"retry_max"`
+	RetryWaitMin time.Duration `json:"retry_wait_min" yaml:"retry_wait_min"`
+	RetryWaitMax time.Duration `json:"retry_wait_max" yaml:"retry_wait_max"`
+	// Timeout applies to calls whose context has no deadline
+	Timeout time.Duration `json:"timeout" yaml:"timeout"`
+}
+
+// DefaultConfig returns default configuration
+func DefaultConfig() *Config {
+	return &Config{
+		Endpoint:     "localhost:9300",
+		ServerName:   "warden-service",
+		RetryMax:     3,
+		RetryWaitMin: 200 * time.Millisecond,
+		RetryWaitMax: 2 * time.Second,
+		Timeout:      30 * time.Second,
+	}
+}
+
+// Client is a connection to Warden with the stubs of its services
+type Client struct {
+	conn   *grpc.ClientConn
+	config *Config
+	log    *log.Helper
+
+	Folders     wardenV1.WardenFolderServiceClient
+	Secrets     wardenV1.WardenSecretServiceClient
+	Permissions wardenV1.WardenPermissionServiceClient
+	System      wardenV1.WardenSystemServiceClient
+	Bitwarden   wardenV1.WardenBitwardenTransferServiceClient
+	Csv         wardenV1.WardenCsvTransferServiceClient
+}
+
+// NewClient creates a client. The connection is established on the first
+// call.
+func NewClient(cfg *Config, logger log.Logger) (*Client, error) {
+	if cfg == nil {
+		cfg = DefaultConfig()
+	}
+	if cfg.Endpoint == "" {
+		return nil, errors.New("endpoint is required")
+	}
+
+	l := log.NewHelper(log.With(logger, "module", "warden/client"))
+
+	var transportCreds credentials.TransportCredentials
+	if cfg.Insecure {
+		if cfg.Token != "" {
+			l.Warn("sending the bearer token over a plaintext connection (dev only)")
+		}
+		transportCreds = insecure.NewCredentials()
+	} else {
+		tlsConfig, err := cfg.tlsConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS credentials: %w", err)
+		}
+		transportCreds = credentials.NewTLS(tlsConfig)
+	}
+
+	c := &Client{config: cfg, log: l}
+	conn, err := grpc.NewClient(cfg.Endpoint,
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithChainUnaryInterceptor(c.timeoutInterceptor, c.metadataInterceptor, c.retryInterceptor, errorInterceptor),
+		grpc.WithChainStreamInterceptor(c.streamMetadataInterceptor),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+
+	c.conn = conn
+	c.Folders = wardenV1.NewWardenFolderServiceClient(conn)
+	c.Secrets = wardenV1.NewWardenSecretServiceClient(conn)
+	c.Permissions = wardenV1.NewWardenPermissionServiceClient(conn)
+	c.System = wardenV1.NewWardenSystemServiceClient(conn)
+	c.Bitwarden = wardenV1.NewWardenBitwardenTransferServiceClient(conn)
+	c.Csv = wardenV1.NewWardenCsvTransferServiceClient(conn)
+
+	return c, nil
+}
+
+// Conn returns the underlying connection, for services without a field on
+// Client
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Close closes the connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (cfg *Config) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName: cfg.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg.CAFile != "" {
+		caCert, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA cert: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("failed to parse CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		clientCert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client cert: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
+
+// timeoutInterceptor applies the configured timeout to calls without a
+// deadline, across all their attempts
+func (c *Client) timeoutInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok && c.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.Timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/grpc"
+)
+
+// Kinds of API errors, to match with errors.Is
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrPreconditionFailed = errors.New("precondition failed")
+	ErrTooLarge           = errors.New("payload too large")
+	ErrUnavailable        = errors.New("service unavailable")
+)
+
+// Error is an error returned by the API. Reason is the WardenErrorReason,
+// e.g. "SECRET_NOT_FOUND", and Metadata carries its details.
+type Error struct {
+	Code     int
+	Reason   string
+	Message  string
+	Metadata map[string]string
+
+	kind  error
+	cause error
+}
+
+func (e *Error) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("warden: %s", e.Message)
+	}
+	return fmt.Sprintf("warden: %s: %s", e.Reason, e.Message)
+}
+
+// Unwrap returns the kind of the error and the gRPC status error, so
+// errors.Is matches the kinds and the generated wardenV1.IsXxx helpers keep
+// working
+func (e *Error) Unwrap() []error {
+	if e.kind == nil {
+		return []error{e.cause}
+	}
+	return []error{e.kind, e.cause}
+}
+
+// wrapError turns a gRPC status error into an *Error
+func wrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	se := kerrors.FromError(err)
+	return &Error{
+		Code:     int(se.Code),
+		Reason:   se.Reason,
+		Message:  se.Message,
+		Metadata: se.Metadata,
+		kind:     errorKind(int(se.Code)),
+		cause:    err,
+	}
+}
+
+func errorKind(code int) error {
+	switch code {
+	case http.StatusBadRequest:
+		return ErrInvalidArgument
+	case http.StatusUnauthorized:
+		return ErrUnauthenticated
+	case http.StatusForbidden:
+		return ErrPermissionDenied
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrAlreadyExists
+	case http.StatusPreconditionFailed:
+		return ErrPreconditionFailed
+	case http.StatusRequestEntityTooLarge:
+		return ErrTooLarge
+	case http.StatusServiceUnavailable:
+		return ErrUnavailable
+	}
+	return nil
+}
+
+func errorInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return wrapError(invoker(ctx, method, req, reply, cc, opts...))
+}
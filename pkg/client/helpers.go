@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
+)
+
+// folderPageSize is the page size used to look up folders by name
+const folderPageSize = 100
+
+// SplitPath splits a secret path such as "/Team/Infra/db" into the folder
+// path and the secret name
+func SplitPath(path string) (folderPath, name string) {
+	path = strings.TrimRight(path, "/")
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return "/", path
+	}
+	folderPath = path[:i]
+	if folderPath == "" {
+		folderPath = "/"
+	}
+	return folderPath, path[i+1:]
+}
+
+// GetSecretByPath reads a secret with its password by path, returning the
+// requested metadata keys
+func (c *Client) GetSecretByPath(ctx context.Context, path string, metadataKeys ...string) (*wardenV1.GetSecretByPathResponse, error) {
+	folderPath, name := SplitPath(path)
+	if name == "" {
+		return nil, fmt.Errorf("%w: secret path %q has no name", ErrInvalidArgument, path)
+	}
+	return c.Secrets.GetSecretByPath(ctx, &wardenV1.GetSecretByPathRequest{
+		FolderPath:   folderPath,
+		Name:         name,
+		MetadataKeys: metadataKeys,
+	})
+}
+
+// GetPasswordByPath reads the current password of the secret at a path
+// such as "/Team/Infra/db"
+func (c *Client) GetPasswordByPath(ctx context.Context, path string) (string, error) {
+	resp, err := c.GetSecretByPath(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	return resp.Password, nil
+}
+
+// FolderByPath returns the folder at a path such as "/Team/Infra". Missing
+// folders return an error matching ErrNotFound.
+func (c *Client) FolderByPath(ctx context.Context, path string) (*wardenV1.Folder, error) {
+	var folder *wardenV1.Folder
+	for _, name := range splitFolderPath(path) {
+		var parentID *string
+		if folder != nil {
+			parentID = &folder.Id
+		}
+		f, err := c.findFolder(ctx, parentID, name)
+		if err != nil {
+			return nil, err
+		}
+		if f == nil {
+			return nil, fmt.Errorf("%w: folder %q", ErrNotFound, path)
+		}
+		folder = f
+	}
+
+	if folder == nil {
+		return nil, fmt.Errorf("%w: folder path %q is empty", ErrInvalidArgument, path)
+	}
+	return folder, nil
+}
+
+// SecretByPath returns the secret at a path such as "/Team/Infra/db"
+// without reading its password. Missing secrets return an error matching
+// ErrNotFound.
+func (c *Client) SecretByPath(ctx context.Context, path string) (*wardenV1.Secret, error) {
+	folderPath, name := SplitPath(path)
+	if name == "" {
+		return nil, fmt.Errorf("%w: secret path %q has no name", ErrInvalidArgument, path)
+	}
+
+	var folderID *string
+	if len(splitFolderPath(folderPath)) > 0 {
+		folder, err := c.FolderByPath(ctx, folderPath)
+		if err != nil {
+			return nil, err
+		}
+		folderID = &folder.Id
+	}
+
+	pageSize := uint32(folderPageSize)
+	req := &wardenV1.ListSecretsRequest{
+		FolderId:   folderID,
+		NameFilter: &name,
+		PageSize:   &pageSize,
+	}
+	for {
+		resp, err := c.Secrets.ListSecrets(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range resp.Secrets {
+			if s.Name == name {
+				return s, nil
+			}
+		}
+		if resp.NextCursor == "" {
+			return nil, fmt.Errorf("%w: secret %q", ErrNotFound, path)
+		}
+		req.Cursor = &resp.NextCursor
+	}
+}
+
+// EnsureFolder returns the folder at a path such as "/Team/Infra", creating
+// it and any missing parents. Folders created concurrently by someone else
+// are used as they are.
+func (c *Client) EnsureFolder(ctx context.Context, path string) (*wardenV1.Folder, error) {
+	var (
+		folder   *wardenV1.Folder
+		parentID *string
+	)
+	for _, name := range splitFolderPath(path) {
+		f, err := c.findFolder(ctx, parentID, name)
+		if err != nil {
+			return nil, err
+		}
+		if f == nil {
+			resp, err := c.Folders.CreateFolder(ctx, &wardenV1.CreateFolderRequest{ParentId: parentID, Name: name})
+			switch {
+			case err == nil:
+				f = resp.Folder
+			case errors.Is(err, ErrAlreadyExists):
+				if f, err = c.findFolder(ctx, parentID, name); err != nil {
+					return nil, err
+				}
+				if f == nil {
+					return nil, fmt.Errorf("folder %q exists but is not visible", name)
+				}
+			default:
+				return nil, err
+			}
+		}
+
+		folder = f
+		parentID = &f.Id
+	}
+
+	if folder == nil {
+		return nil, fmt.Errorf("%w: folder path %q is empty", ErrInvalidArgument, path)
+	}
+	return folder, nil
+}
+
+// splitFolderPath returns the folder names of a path, without empty ones
+func splitFolderPath(path string) []string {
+	var names []string
+	for _, name := range strings.Split(path, "/") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// findFolder returns the child of parentID named name, or nil
+func (c *Client) findFolder(ctx context.Context, parentID *string, name string) (*wardenV1.Folder, error) {
+	pageSize := uint32(folderPageSize)
+	req := &wardenV1.ListFoldersRequest{
+		ParentId:   parentID,
+		NameFilter: &name,
+		PageSize:   &pageSize,
+	}
+	for {
+		resp, err := c.Folders.ListFolders(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range resp.Folders {
+			if f.Name == name {
+				return f, nil
+			}
+		}
+		if resp.NextCursor == "" {
+			return nil, nil
+		}
+		req.Cursor = &resp.NextCursor
+	}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys the service reads the caller's identity from
+const (
+	mdTenantID = "x-md-global-tenant-id"
+	mdUserID   = "x-md-global-user-id"
+	mdUsername = "x-md-global-username"
+	mdRoles    = "x-md-global-roles"
+)
+
+// Identity is the tenant and user calls are made as. With AUTH_MODE=jwt the
+// server takes them from the token instead.
+type Identity struct {
+	TenantID uint32   `json:"tenant_id" yaml:"tenant_id"`
+	UserID   uint32   `json:"user_id" yaml:"user_id"`
+	Username string   `json:"username" yaml:"username"`
+	Roles    []string `json:"roles" yaml:"roles"`
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a context whose calls are made as id instead of the
+// identity of the client's configuration, e.g. to act for the user of an
+// incoming request
+func WithIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// outgoing adds the identity and the bearer token to the outgoing metadata
+func (c *Client) outgoing(ctx context.Context) context.Context {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	if !ok {
+		id = c.config.Identity
+	}
+
+	pairs := make([]string, 0, 10)
+	if id.TenantID != 0 {
+		pairs = append(pairs, mdTenantID, strconv.FormatUint(uint64(id.TenantID), 10))
+	}
+	if id.UserID != 0 {
+		pairs = append(pairs, mdUserID, strconv.FormatUint(uint64(id.UserID), 10))
+	}
+	if id.Username != "" {
+		pairs = append(pairs, mdUsername, id.Username)
+	}
+	if len(id.Roles) > 0 {
+		pairs = append(pairs, mdRoles, strings.Join(id.Roles, ","))
+	}
+	if c.config.Token != "" {
+		pairs = append(pairs, "authorization", "Bearer "+c.config.Token)
+	}
+	if len(pairs) == 0 {
+		return ctx
+	}
+
+	// Replace what the caller may have set, so an identity is never sent twice
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	for i := 0; i < len(pairs); i += 2 {
+		md.Set(pairs[i], pairs[i+1])
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func (c *Client) metadataInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(c.outgoing(ctx), method, req, reply, cc, opts...)
+}
+
+func (c *Client) streamMetadataInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	s, err := streamer(c.outgoing(ctx), desc, cc, method, opts...)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return s, nil
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// idempotentPrefixes are the method name prefixes of calls that can be
+// retried without side effects. Writes are never retried: an unavailable
+// error may come after the service applied them.
+var idempotentPrefixes = []string{"Get", "List", "Search", "Check", "Health"}
+
+func isIdempotent(method string) bool {
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range idempotentPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryInterceptor retries reads that failed with an unavailable error up
+// to RetryMax times, with exponential backoff between RetryWaitMin and
+// RetryWaitMax
+func (c *Client) retryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if c.config.RetryMax <= 0 || !isIdempotent(method) {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	wait := c.config.RetryWaitMin
+	for attempt := 0; ; attempt++ {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil || !errors.Is(err, ErrUnavailable) || attempt >= c.config.RetryMax {
+			return err
+		}
+
+		c.log.Warnf("%s unavailable, retrying in %s (attempt %d of %d): %v", method, wait, attempt+1, c.config.RetryMax, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+
+		wait *= 2
+		if c.config.RetryWaitMax > 0 && wait > c.config.RetryWaitMax {
+			wait = c.config.RetryWaitMax
+		}
+	}
+}
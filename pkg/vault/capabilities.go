@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+)
+
+// PathPrefix is the prefix of every path the KV store writes below the mount
+const PathPrefix = "warden"
+
+// requiredCapabilities are the capabilities the KV store needs on each KV v2
+// API below the prefix: versions are written, read and deleted through data,
+// retention is set and paths removed through metadata, and versions are
+// deleted, undeleted and destroyed through the others
+var requiredCapabilities = []struct {
+	api          string
+	capabilities []string
+}{
+	{"data", []string{"create", "read", "update", "delete"}},
+	{"metadata", []string{"read", "update", "delete"}},
+	{"delete", []string{"update"}},
+	{"undelete", []string{"update"}},
+	{"destroy", []string{"update"}},
+}
+
+// CapabilityMismatch is a KV v2 path below the prefix on which the token
+// lacks capabilities the service needs, or has more than it needs
+type CapabilityMismatch struct {
+	Path    string
+	Missing []string
+	Excess  []string
+}
+
+// CheckCapabilities asks Vault which capabilities the client's token has on
+// the KV v2 paths below prefix and returns the paths where they differ from
+// what the KV store needs. sys/capabilities-self is allowed by Vault's
+// default policy, so the check needs no sudo. The in-memory store has every
+// capability.
+func (c *Client) CheckCapabilities(ctx context.Context, prefix string) ([]CapabilityMismatch, error) {
+	if c.memory != nil {
+		return nil, nil
+	}
+
+	var mismatches []CapabilityMismatch
+	for _, required := range requiredCapabilities {
+		// Any path below the prefix will do, the policy applies to all of them
+		p := path.Join(c.mountPath, required.api, prefix, "capability-check")
+		granted, err := c.client.Sys().CapabilitiesSelfWithContext(ctx, p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up capabilities on %s: %w", p, err)
+		}
+
+		mismatch := CapabilityMismatch{Path: p}
+		if slices.Contains(granted, "root") {
+			mismatch.Excess = []string{"root"}
+		} else {
+			for _, capability := range required.capabilities {
+				if !slices.Contains(granted, capability) {
+					mismatch.Missing = append(mismatch.Missing, capability)
+				}
+			}
+			for _, capability := range granted {
+				if capability != "deny" && !slices.Contains(required.capabilities, capability) {
+					mismatch.Excess = append(mismatch.Excess, capability)
+				}
+			}
+		}
+		if len(mismatch.Missing) > 0 || len(mismatch.Excess) > 0 {
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+	return mismatches, nil
+}
@@ -0,0 +1,142 @@
+package vault
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	// ChecksumAlgorithmSHA256 is a plain SHA-256 of the password
+	ChecksumAlgorithmSHA256 = "sha256"
+
+	// checksumAlgorithmHMAC prefixes the ID of the key of an HMAC-SHA-256
+	// checksum ("hmac-sha256:1a2b3c4d")
+	checksumAlgorithmHMAC = "hmac-sha256:"
+
+	// checksumKeyPath is where the checksum key is kept when Vault holds it,
+	// below the prefix but outside the paths of tenants
+	checksumKeyPath = PathPrefix + "/_system/checksum-key"
+
+	// checksumKeySize is the size of generated checksum keys
+	checksumKeySize = 32
+)
+
+// Checksum is the checksum of a password with the algorithm that made it
+type Checksum struct {
+	Algorithm string
+	Value     string
+}
+
+// Checksummer computes password checksums. With a key they are HMAC-SHA-256,
+// which cannot be checked against guessed passwords without the key when the
+// database leaks; without one they are plain SHA-256.
+type Checksummer struct {
+	key       []byte
+	algorithm string
+}
+
+// NewChecksummer creates a checksummer using key, plain SHA-256 when empty
+func NewChecksummer(key []byte) *Checksummer {
+	if len(key) == 0 {
+		return &Checksummer{algorithm: ChecksumAlgorithmSHA256}
+	}
+	id := sha256.Sum256(append([]byte("warden-checksum-key:"), key...))
+	return &Checksummer{key: key, algorithm: checksumAlgorithmHMAC + hex.EncodeToString(id[:4])}
+}
+
+// Algorithm returns the algorithm of new checksums, with the key ID for HMAC
+func (c *Checksummer) Algorithm() string {
+	return c.algorithm
+}
+
+// Sum returns the checksum of a password
+func (c *Checksummer) Sum(password string) Checksum {
+	return c.SumBytes([]byte(password))
+}
+
+// SumBytes returns the checksum of a password held in a buffer
+func (c *Checksummer) SumBytes(password []byte) Checksum {
+	if c.key == nil {
+		hash := sha256.Sum256(password)
+		return Checksum{Algorithm: c.algorithm, Value: hex.EncodeToString(hash[:])}
+	}
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write(password)
+	return Checksum{Algorithm: c.algorithm, Value: hex.EncodeToString(mac.Sum(nil))}
+}
+
+// Matches reports whether a checksum is the one of password. Plain SHA-256
+// checksums always can be checked; HMAC ones only with the key that made them.
+func (c *Checksummer) Matches(sum Checksum, password string) bool {
+	var computed string
+	switch sum.Algorithm {
+	case ChecksumAlgorithmSHA256, "":
+		computed = CalculateChecksum(password)
+	case c.algorithm:
+		computed = c.Sum(password).Value
+	default:
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(sum.Value)) == 1
+}
+
+// ParseChecksumKey decodes a base64 checksum key
+func ParseChecksumKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("checksum key is not valid base64: %w", err)
+	}
+	if len(key) < 16 {
+		return nil, fmt.Errorf("checksum key must be at least 16 bytes, got %d", len(key))
+	}
+	return key, nil
+}
+
+// LoadChecksumKey reads the checksum key Vault holds for the service,
+// generating and storing one on first use
+func (s *KVStore) LoadChecksumKey(ctx context.Context) (_ []byte, err error) {
+	ctx, end := s.instrument(ctx, "load_checksum_key", checksumKeyPath)
+	defer end(&err)
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	kv := s.client.kv()
+
+	secret, err := kv.Get(ctx, checksumKeyPath)
+	if err == nil && secret != nil && secret.Data != nil {
+		if encoded, ok := secret.Data["key"].(string); ok {
+			return ParseChecksumKey(encoded)
+		}
+	}
+	if err != nil && !errors.Is(err, vault.ErrSecretNotFound) {
+		return nil, fmt.Errorf("failed to read checksum key from Vault: %w", err)
+	}
+
+	key := make([]byte, checksumKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate checksum key: %w", err)
+	}
+	// Check-and-set on a new path, so concurrently starting instances agree
+	// on one key
+	if _, err := kv.Put(ctx, checksumKeyPath, map[string]any{"key": base64.StdEncoding.EncodeToString(key)}, vault.WithCheckAndSet(0)); err != nil {
+		secret, getErr := kv.Get(ctx, checksumKeyPath)
+		if getErr == nil && secret != nil && secret.Data != nil {
+			if encoded, ok := secret.Data["key"].(string); ok {
+				return ParseChecksumKey(encoded)
+			}
+		}
+		return nil, fmt.Errorf("failed to store checksum key in Vault: %w", err)
+	}
+	s.client.log.Infof("Generated the password checksum key in Vault at %s/%s", s.client.GetMountPath(), checksumKeyPath)
+	return key, nil
+}
@@ -12,21 +12,26 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	vault "github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/api/auth/approle"
+	"golang.org/x/time/rate"
 )
 
 // Config holds Vault client configuration
 type Config struct {
-	Address        string        `json:"address" yaml:"address"`
-	RoleID         string        `json:"role_id" yaml:"role_id"`
-	SecretID       string        `json:"secret_id" yaml:"secret_id"`
-	RoleIDFile     string        `json:"role_id_file" yaml:"role_id_file"`         // Path to file containing role ID
-	SecretIDFile   string        `json:"secret_id_file" yaml:"secret_id_file"`     // Path to file containing secret ID
-	MountPath      string        `json:"mount_path" yaml:"mount_path"`
-	Namespace      string        `json:"namespace" yaml:"namespace"`
-	RetryMax       int           `json:"retry_max" yaml:"retry_max"`
-	RetryWaitMin   time.Duration `json:"retry_wait_min" yaml:"retry_wait_min"`
-	RetryWaitMax   time.Duration `json:"retry_wait_max" yaml:"retry_wait_max"`
-	Timeout        time.Duration `json:"timeout" yaml:"timeout"`
+	Address      string        `json:"address" yaml:"address"`
+	RoleID       string        `json:"role_id" yaml:"role_id"`
+	SecretID     string        `json:"secret_id" yaml:"secret_id"`
+	RoleIDFile   string        `json:"role_id_file" yaml:"role_id_file"`     // Path to file containing role ID
+	SecretIDFile string        `json:"secret_id_file" yaml:"secret_id_file"` // Path to file containing secret ID
+	MountPath    string        `json:"mount_path" yaml:"mount_path"`
+	Namespace    string        `json:"namespace" yaml:"namespace"`
+	RetryMax     int           `json:"retry_max" yaml:"retry_max"`
+	RetryWaitMin time.Duration `json:"retry_wait_min" yaml:"retry_wait_min"`
+	RetryWaitMax time.Duration `json:"retry_wait_max" yaml:"retry_wait_max"`
+	Timeout      time.Duration `json:"timeout" yaml:"timeout"`
+	// RateLimit caps the requests per second sent to Vault, with bursts of
+	// RateBurst (default RateLimit); 0 leaves them unlimited
+	RateLimit float64 `json:"rate_limit" yaml:"rate_limit"`
+	RateBurst int     `json:"rate_burst" yaml:"rate_burst"`
 }
 
 // DefaultConfig returns default configuration
@@ -49,6 +54,7 @@ type Client struct {
 	mountPath     string
 	cancel        context.CancelFunc // stops the token renewal goroutine
 	renewalFailed atomic.Bool        // set when token renewal exhausts all retries
+	memory        *memoryKV          // in-memory store replacing Vault in dev mode
 }
 
 // NewClient creates a new Vault client with AppRole authentication
@@ -76,6 +82,14 @@ func NewClient(cfg *Config, logger log.Logger) (*Client, error) {
 	vaultConfig.MaxRetries = cfg.RetryMax
 	vaultConfig.MinRetryWait = cfg.RetryWaitMin
 	vaultConfig.MaxRetryWait = cfg.RetryWaitMax
+	if cfg.RateLimit > 0 {
+		burst := cfg.RateBurst
+		if burst <= 0 {
+			burst = max(int(cfg.RateLimit), 1)
+		}
+		vaultConfig.Limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit), burst)
+		l.Infof("Vault requests limited to %g per second, bursts of %d", cfg.RateLimit, burst)
+	}
 
 	// Create Vault client
 	client, err := vault.NewClient(vaultConfig)
@@ -298,8 +312,11 @@ func (c *Client) IsTokenRenewalFailed() bool {
 	return c.renewalFailed.Load()
 }
 
-// Health checks Vault health status
+// Health checks Vault health status. The in-memory store is always healthy.
 func (c *Client) Health(ctx context.Context) (*vault.HealthResponse, error) {
+	if c.memory != nil {
+		return &vault.HealthResponse{Initialized: true, Version: "memory"}, nil
+	}
 	return c.client.Sys().HealthWithContext(ctx)
 }
 
@@ -312,11 +329,25 @@ func (c *Client) IsSealed(ctx context.Context) (bool, error) {
 	return health.Sealed, nil
 }
 
-// GetClient returns the underlying Vault client
+// GetClient returns the underlying Vault client, nil for the in-memory store
 func (c *Client) GetClient() *vault.Client {
 	return c.client
 }
 
+// IsMemory reports whether the client stores secrets in memory instead of
+// Vault
+func (c *Client) IsMemory() bool {
+	return c.memory != nil
+}
+
+// kv returns the KV v2 API of the mount
+func (c *Client) kv() kvAPI {
+	if c.memory != nil {
+		return c.memory
+	}
+	return c.client.KVv2(c.mountPath)
+}
+
 // GetMountPath returns the configured mount path
 func (c *Client) GetMountPath() string {
 	return c.mountPath
@@ -6,11 +6,21 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
+
+	vault "github.com/hashicorp/vault/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
 )
 
 const vaultOpTimeout = 30 * time.Second
 
+var tracer = otel.Tracer("github.com/go-tangra/go-tangra-warden/pkg/vault")
+
 // withTimeout wraps a context with a timeout if it doesn't already have a deadline.
 func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
 	if _, ok := ctx.Deadline(); ok {
@@ -19,14 +29,148 @@ func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(ctx, vaultOpTimeout)
 }
 
+// Metrics receives the duration and outcome of every KV operation
+type Metrics interface {
+	ObserveVaultOperation(operation string, duration time.Duration, err error)
+}
+
+// RetentionPolicy supplies the version retention of a tenant: how many
+// versions Vault keeps per path and after how long it deletes them (zero
+// for the Vault defaults)
+type RetentionPolicy interface {
+	VersionRetention(ctx context.Context, tenantID uint32) (maxVersions int, deleteVersionAfter time.Duration, err error)
+}
+
 // KVStore provides KV v2 operations for password storage
 type KVStore struct {
-	client *Client
+	client      *Client
+	metrics     Metrics
+	retention   RetentionPolicy
+	concurrency int
+
+	writeLimiter *rate.Limiter
+	// slowThreshold is how long an operation takes before it is logged
+	slowThreshold time.Duration
+	checksums     *Checksummer
 }
 
 // NewKVStore creates a new KV store
 func NewKVStore(client *Client) *KVStore {
-	return &KVStore{client: client}
+	return &KVStore{
+		client:        client,
+		concurrency:   DefaultConcurrency,
+		writeLimiter:  rate.NewLimiter(DefaultWriteRate, DefaultConcurrency),
+		slowThreshold: DefaultSlowThreshold,
+		checksums:     NewChecksummer(nil),
+	}
+}
+
+// SetMetrics sets the receiver of operation metrics
+func (s *KVStore) SetMetrics(m Metrics) {
+	s.metrics = m
+}
+
+// SetConcurrency sets how many Vault requests bulk operations run at once
+func (s *KVStore) SetConcurrency(n int) {
+	if n > 0 {
+		s.concurrency = n
+	}
+}
+
+// SetWriteRate sets how many writes per second bulk operations send to
+// Vault; 0 removes the limit
+func (s *KVStore) SetWriteRate(perSecond int) {
+	if perSecond <= 0 {
+		s.writeLimiter = nil
+		return
+	}
+	s.writeLimiter = rate.NewLimiter(rate.Limit(perSecond), max(s.concurrency, 1))
+}
+
+// SetSlowThreshold sets how long an operation takes before it is logged as
+// slow; 0 disables the log
+func (s *KVStore) SetSlowThreshold(d time.Duration) {
+	s.slowThreshold = d
+}
+
+// SetChecksumKey sets the key of the HMAC-SHA-256 password checksums; nil
+// makes them plain SHA-256
+func (s *KVStore) SetChecksumKey(key []byte) {
+	s.checksums = NewChecksummer(key)
+}
+
+// Checksum returns the checksum of a password to store on its version
+func (s *KVStore) Checksum(password string) Checksum {
+	return s.checksums.Sum(password)
+}
+
+// ChecksumBuffer returns the checksum of a password read from Vault, without
+// copying it out of its buffer
+func (s *KVStore) ChecksumBuffer(password *SecretBuffer) Checksum {
+	return s.checksums.SumBytes(password.Bytes())
+}
+
+// Checksums returns the checksummer of the store, for checking stored
+// checksums
+func (s *KVStore) Checksums() *Checksummer {
+	return s.checksums
+}
+
+// SetRetentionPolicy sets the source of the version retention applied to
+// password paths when they are first written
+func (s *KVStore) SetRetentionPolicy(p RetentionPolicy) {
+	s.retention = p
+}
+
+// tenantFromPath returns the tenant of a warden/<tenant>/<secret>[/...] path
+func tenantFromPath(path string) (uint32, bool) {
+	parts := strings.SplitN(path, "/", 3)
+	if len(parts) < 2 {
+		return 0, false
+	}
+	tenantID, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(tenantID), true
+}
+
+// instrument starts a span for an operation on path. The returned function
+// ends the span, reports the operation's result (err) to the metrics and logs
+// the operation when it took longer than the slow threshold.
+func (s *KVStore) instrument(ctx context.Context, operation, path string) (context.Context, func(err *error)) {
+	attrs := []attribute.KeyValue{
+		attribute.String("vault.operation", operation),
+		attribute.String("vault.path", path),
+	}
+	if tenantID, ok := tenantFromPath(path); ok {
+		attrs = append(attrs, attribute.Int64("warden.tenant_id", int64(tenantID)))
+	}
+
+	start := time.Now()
+	ctx, span := tracer.Start(ctx, "vault."+operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+
+	return ctx, func(err *error) {
+		elapsed := time.Since(start)
+		slow := s.slowThreshold > 0 && elapsed >= s.slowThreshold
+
+		if *err != nil {
+			span.RecordError(*err)
+			span.SetStatus(codes.Error, (*err).Error())
+		}
+		if slow {
+			span.SetAttributes(attribute.Bool("vault.slow", true))
+		}
+		span.End()
+
+		if s.metrics != nil {
+			s.metrics.ObserveVaultOperation(operation, elapsed, *err)
+		}
+		if slow {
+			s.client.log.Warnf("Slow Vault operation %s on %s took %s (threshold %s, failed=%v)",
+				operation, path, elapsed.Round(time.Millisecond), s.slowThreshold, *err != nil)
+		}
+	}
 }
 
 // SecretData represents secret data stored in Vault
@@ -55,7 +199,9 @@ func (s *KVStore) BuildVersionPath(tenantID uint32, secretID string, version int
 
 // StorePassword stores a password in Vault KV v2
 // Returns the version number created
-func (s *KVStore) StorePassword(ctx context.Context, path, password string, metadata map[string]string) (int, error) {
+func (s *KVStore) StorePassword(ctx context.Context, path, password string, metadata map[string]string) (_ int, err error) {
+	ctx, end := s.instrument(ctx, "store_password", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
@@ -67,7 +213,7 @@ func (s *KVStore) StorePassword(ctx context.Context, path, password string, meta
 	}
 
 	// Use KV v2 API
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
 	secret, err := kv.Put(ctx, path, data)
 	if err != nil {
@@ -79,29 +225,124 @@ func (s *KVStore) StorePassword(ctx context.Context, path, password string, meta
 		version = secret.VersionMetadata.Version
 	}
 
+	// A new path starts without metadata; give it the tenant's retention.
+	// The password is stored either way, so a failure is only logged.
+	if version == 1 {
+		if err := s.applyRetention(ctx, path); err != nil {
+			s.client.log.Warnf("Failed to apply version retention: %v", err)
+		}
+	}
+
 	s.client.log.Debugf("Stored password, version %d", version)
 	return version, nil
 }
 
-// GetPassword retrieves the current password from Vault
-func (s *KVStore) GetPassword(ctx context.Context, path string) (string, int, error) {
+// applyRetention sets the retention of the path's tenant on the path, if the
+// tenant has one
+func (s *KVStore) applyRetention(ctx context.Context, path string) error {
+	if s.retention == nil {
+		return nil
+	}
+	tenantID, ok := tenantFromPath(path)
+	if !ok {
+		return nil
+	}
+	maxVersions, deleteAfter, err := s.retention.VersionRetention(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if maxVersions == 0 && deleteAfter == 0 {
+		return nil
+	}
+	return s.PutMetadata(ctx, path, maxVersions, deleteAfter)
+}
+
+// PutMetadata sets how many versions Vault keeps of a path and after how long
+// it deletes them. Zero values fall back to the mount's settings and never
+// deleting. Vault removes the oldest versions beyond maxVersions on the next
+// write.
+func (s *KVStore) PutMetadata(ctx context.Context, path string, maxVersions int, deleteVersionAfter time.Duration) (err error) {
+	ctx, end := s.instrument(ctx, "put_metadata", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
+
+	if err := kv.PutMetadata(ctx, path, vault.KVMetadataPutInput{
+		MaxVersions:        maxVersions,
+		DeleteVersionAfter: deleteVersionAfter,
+	}); err != nil {
+		return fmt.Errorf("failed to put metadata in Vault: %w", err)
+	}
+
+	s.client.log.Debugf("Put metadata: max_versions=%d delete_version_after=%s", maxVersions, deleteVersionAfter)
+	return nil
+}
+
+// GetPassword retrieves the current password from Vault. The caller wipes
+// the buffer once done with it.
+func (s *KVStore) GetPassword(ctx context.Context, path string) (_ *SecretBuffer, _ int, err error) {
+	ctx, end := s.instrument(ctx, "get_password", path)
+	defer end(&err)
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	kv := s.client.kv()
 
 	secret, err := kv.Get(ctx, path)
 	if err != nil {
-		return "", 0, fmt.Errorf("failed to get password from Vault: %w", err)
+		return nil, 0, fmt.Errorf("failed to get password from Vault: %w", err)
 	}
 
 	if secret == nil || secret.Data == nil {
-		return "", 0, fmt.Errorf("no secret data found at path: %s", path)
+		return nil, 0, fmt.Errorf("no secret data found at path: %s", path)
 	}
 
 	password, ok := secret.Data["password"].(string)
 	if !ok {
-		return "", 0, fmt.Errorf("password field not found or invalid type")
+		return nil, 0, fmt.Errorf("password field not found or invalid type")
+	}
+
+	version := 0
+	if secret.VersionMetadata != nil {
+		version = secret.VersionMetadata.Version
+	}
+
+	return NewSecretBufferString(password), version, nil
+}
+
+// GetSecretData retrieves the current password together with its metadata
+func (s *KVStore) GetSecretData(ctx context.Context, path string) (_ *SecretData, _ int, err error) {
+	ctx, end := s.instrument(ctx, "get_secret_data", path)
+	defer end(&err)
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	kv := s.client.kv()
+
+	secret, err := kv.Get(ctx, path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get password from Vault: %w", err)
+	}
+
+	if secret == nil || secret.Data == nil {
+		return nil, 0, fmt.Errorf("no secret data found at path: %s", path)
+	}
+
+	password, ok := secret.Data["password"].(string)
+	if !ok {
+		return nil, 0, fmt.Errorf("password field not found or invalid type")
+	}
+
+	result := &SecretData{Password: password}
+	if raw, ok := secret.Data["metadata"].(map[string]any); ok {
+		result.Metadata = make(map[string]string, len(raw))
+		for k, v := range raw {
+			if str, ok := v.(string); ok {
+				result.Metadata[k] = str
+			}
+		}
 	}
 
 	version := 0
@@ -109,39 +350,44 @@ func (s *KVStore) GetPassword(ctx context.Context, path string) (string, int, er
 		version = secret.VersionMetadata.Version
 	}
 
-	return password, version, nil
+	return result, version, nil
 }
 
-// GetPasswordVersion retrieves a specific version of the password from Vault
-func (s *KVStore) GetPasswordVersion(ctx context.Context, path string, version int) (string, error) {
+// GetPasswordVersion retrieves a specific version of the password from
+// Vault. The caller wipes the buffer once done with it.
+func (s *KVStore) GetPasswordVersion(ctx context.Context, path string, version int) (_ *SecretBuffer, err error) {
+	ctx, end := s.instrument(ctx, "get_password_version", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
 	secret, err := kv.GetVersion(ctx, path, version)
 	if err != nil {
-		return "", fmt.Errorf("failed to get password version %d from Vault: %w", version, err)
+		return nil, fmt.Errorf("failed to get password version %d from Vault: %w", version, err)
 	}
 
 	if secret == nil || secret.Data == nil {
-		return "", fmt.Errorf("no secret data found at path %s version %d", path, version)
+		return nil, fmt.Errorf("no secret data found at path %s version %d", path, version)
 	}
 
 	password, ok := secret.Data["password"].(string)
 	if !ok {
-		return "", fmt.Errorf("password field not found or invalid type")
+		return nil, fmt.Errorf("password field not found or invalid type")
 	}
 
-	return password, nil
+	return NewSecretBufferString(password), nil
 }
 
 // DeletePassword soft-deletes the latest version of a password
-func (s *KVStore) DeletePassword(ctx context.Context, path string) error {
+func (s *KVStore) DeletePassword(ctx context.Context, path string) (err error) {
+	ctx, end := s.instrument(ctx, "delete_password", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
 	if err := kv.Delete(ctx, path); err != nil {
 		return fmt.Errorf("failed to delete password from Vault: %w", err)
@@ -152,11 +398,13 @@ func (s *KVStore) DeletePassword(ctx context.Context, path string) error {
 }
 
 // DeletePasswordVersions soft-deletes specific versions
-func (s *KVStore) DeletePasswordVersions(ctx context.Context, path string, versions []int) error {
+func (s *KVStore) DeletePasswordVersions(ctx context.Context, path string, versions []int) (err error) {
+	ctx, end := s.instrument(ctx, "delete_password_versions", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
 	if err := kv.DeleteVersions(ctx, path, versions); err != nil {
 		return fmt.Errorf("failed to delete password versions from Vault: %w", err)
@@ -167,11 +415,13 @@ func (s *KVStore) DeletePasswordVersions(ctx context.Context, path string, versi
 }
 
 // DestroyPassword permanently destroys a password (cannot be recovered)
-func (s *KVStore) DestroyPassword(ctx context.Context, path string, versions []int) error {
+func (s *KVStore) DestroyPassword(ctx context.Context, path string, versions []int) (err error) {
+	ctx, end := s.instrument(ctx, "destroy_password", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
 	if err := kv.Destroy(ctx, path, versions); err != nil {
 		return fmt.Errorf("failed to destroy password in Vault: %w", err)
@@ -182,11 +432,13 @@ func (s *KVStore) DestroyPassword(ctx context.Context, path string, versions []i
 }
 
 // DestroyAllVersions permanently destroys all versions and metadata
-func (s *KVStore) DestroyAllVersions(ctx context.Context, path string) error {
+func (s *KVStore) DestroyAllVersions(ctx context.Context, path string) (err error) {
+	ctx, end := s.instrument(ctx, "destroy_all_versions", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
 	if err := kv.DeleteMetadata(ctx, path); err != nil {
 		return fmt.Errorf("failed to destroy all password versions in Vault: %w", err)
@@ -197,11 +449,13 @@ func (s *KVStore) DestroyAllVersions(ctx context.Context, path string) error {
 }
 
 // UndeletePassword recovers soft-deleted versions
-func (s *KVStore) UndeletePassword(ctx context.Context, path string, versions []int) error {
+func (s *KVStore) UndeletePassword(ctx context.Context, path string, versions []int) (err error) {
+	ctx, end := s.instrument(ctx, "undelete_password", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
 	if err := kv.Undelete(ctx, path, versions); err != nil {
 		return fmt.Errorf("failed to undelete password versions from Vault: %w", err)
@@ -212,11 +466,13 @@ func (s *KVStore) UndeletePassword(ctx context.Context, path string, versions []
 }
 
 // ListVersions returns version information for a secret
-func (s *KVStore) ListVersions(ctx context.Context, path string) ([]VersionInfo, error) {
+func (s *KVStore) ListVersions(ctx context.Context, path string) (_ []VersionInfo, err error) {
+	ctx, end := s.instrument(ctx, "list_versions", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
 	metadata, err := kv.GetMetadata(ctx, path)
 	if err != nil {
@@ -247,11 +503,13 @@ func (s *KVStore) ListVersions(ctx context.Context, path string) ([]VersionInfo,
 }
 
 // GetCurrentVersion returns the current version number for a secret
-func (s *KVStore) GetCurrentVersion(ctx context.Context, path string) (int, error) {
+func (s *KVStore) GetCurrentVersion(ctx context.Context, path string) (_ int, err error) {
+	ctx, end := s.instrument(ctx, "get_current_version", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
 	metadata, err := kv.GetMetadata(ctx, path)
 	if err != nil {
@@ -271,13 +529,15 @@ func (s *KVStore) BuildTotpPath(tenantID uint32, secretID string) string {
 }
 
 // StoreTotpURL stores a TOTP URL in Vault KV v2
-func (s *KVStore) StoreTotpURL(ctx context.Context, path, totpURL string) error {
+func (s *KVStore) StoreTotpURL(ctx context.Context, path, totpURL string) (err error) {
+	ctx, end := s.instrument(ctx, "store_totp_url", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
-	_, err := kv.Put(ctx, path, map[string]any{"totp_url": totpURL})
+	_, err = kv.Put(ctx, path, map[string]any{"totp_url": totpURL})
 	if err != nil {
 		return fmt.Errorf("failed to store TOTP in Vault: %w", err)
 	}
@@ -285,11 +545,13 @@ func (s *KVStore) StoreTotpURL(ctx context.Context, path, totpURL string) error
 }
 
 // GetTotpURL retrieves the TOTP URL from Vault
-func (s *KVStore) GetTotpURL(ctx context.Context, path string) (string, error) {
+func (s *KVStore) GetTotpURL(ctx context.Context, path string) (_ string, err error) {
+	ctx, end := s.instrument(ctx, "get_totp_url", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
 	secret, err := kv.Get(ctx, path)
 	if err != nil {
@@ -309,11 +571,13 @@ func (s *KVStore) GetTotpURL(ctx context.Context, path string) (string, error) {
 }
 
 // DeleteTotp deletes the TOTP data from Vault
-func (s *KVStore) DeleteTotp(ctx context.Context, path string) error {
+func (s *KVStore) DeleteTotp(ctx context.Context, path string) (err error) {
+	ctx, end := s.instrument(ctx, "delete_totp", path)
+	defer end(&err)
 	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
-	kv := s.client.GetClient().KVv2(s.client.GetMountPath())
+	kv := s.client.kv()
 
 	if err := kv.DeleteMetadata(ctx, path); err != nil {
 		return fmt.Errorf("failed to delete TOTP from Vault: %w", err)
@@ -321,7 +585,8 @@ func (s *KVStore) DeleteTotp(ctx context.Context, path string) error {
 	return nil
 }
 
-// CalculateChecksum calculates SHA-256 checksum of a password
+// CalculateChecksum calculates SHA-256 checksum of a password. New checksums
+// are made with KVStore.Checksum, which uses HMAC-SHA-256 when keyed.
 func CalculateChecksum(password string) string {
 	hash := sha256.Sum256([]byte(password))
 	return hex.EncodeToString(hash[:])
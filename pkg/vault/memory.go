@@ -0,0 +1,278 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// defaultMemoryMaxVersions is how many versions the in-memory store keeps
+// per path without metadata, the default of a Vault KV v2 mount
+const defaultMemoryMaxVersions = 10
+
+// kvAPI is the part of the Vault KV v2 API the KV store uses. It is served by
+// Vault, or by memoryKV in dev mode.
+type kvAPI interface {
+	Put(ctx context.Context, secretPath string, data map[string]any, opts ...vault.KVOption) (*vault.KVSecret, error)
+	Get(ctx context.Context, secretPath string) (*vault.KVSecret, error)
+	GetVersion(ctx context.Context, secretPath string, version int) (*vault.KVSecret, error)
+	GetMetadata(ctx context.Context, secretPath string) (*vault.KVMetadata, error)
+	PutMetadata(ctx context.Context, secretPath string, metadata vault.KVMetadataPutInput) error
+	Delete(ctx context.Context, secretPath string) error
+	DeleteVersions(ctx context.Context, secretPath string, versions []int) error
+	DeleteMetadata(ctx context.Context, secretPath string) error
+	Destroy(ctx context.Context, secretPath string, versions []int) error
+	Undelete(ctx context.Context, secretPath string, versions []int) error
+}
+
+// NewMemoryClient creates a client backed by an in-memory KV v2 store instead
+// of Vault, for local development and integration tests. Everything stored
+// is lost when the process exits.
+func NewMemoryClient(logger log.Logger) *Client {
+	return &Client{
+		log:       log.NewHelper(log.With(logger, "module", "vault/client")),
+		mountPath: "secret",
+		memory:    newMemoryKV(),
+	}
+}
+
+type memoryVersion struct {
+	data      []byte
+	created   time.Time
+	deleted   time.Time
+	destroyed bool
+}
+
+type memoryPath struct {
+	versions    map[int]*memoryVersion
+	current     int
+	maxVersions int
+	deleteAfter time.Duration
+	created     time.Time
+	updated     time.Time
+}
+
+// memoryKV emulates a KV v2 mount: versions with soft deletion, destruction
+// and undeletion, max_versions and delete_version_after. Data goes through
+// JSON like it does over the Vault API, so readers see the same types.
+type memoryKV struct {
+	mu    sync.Mutex
+	paths map[string]*memoryPath
+	// transitKeys stand in for the keys of a Transit mount
+	transitKeys map[string][]byte
+}
+
+func newMemoryKV() *memoryKV {
+	return &memoryKV{paths: make(map[string]*memoryPath), transitKeys: make(map[string][]byte)}
+}
+
+func notFound(secretPath string) error {
+	return fmt.Errorf("%w: at %s", vault.ErrSecretNotFound, secretPath)
+}
+
+// lookup returns a path with versions past delete_version_after deleted
+func (m *memoryKV) lookup(secretPath string) (*memoryPath, bool) {
+	p, ok := m.paths[secretPath]
+	if !ok {
+		return nil, false
+	}
+	if p.deleteAfter > 0 {
+		now := time.Now()
+		for _, v := range p.versions {
+			if v.deleted.IsZero() && now.Sub(v.created) > p.deleteAfter {
+				v.deleted = v.created.Add(p.deleteAfter)
+			}
+		}
+	}
+	return p, true
+}
+
+// prune removes the oldest versions beyond the path's max_versions
+func (p *memoryPath) prune() {
+	limit := p.maxVersions
+	if limit <= 0 {
+		limit = defaultMemoryMaxVersions
+	}
+	for version := range p.versions {
+		if version <= p.current-limit {
+			delete(p.versions, version)
+		}
+	}
+}
+
+func (m *memoryKV) Put(_ context.Context, secretPath string, data map[string]any, _ ...vault.KVOption) (*vault.KVSecret, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	p, ok := m.lookup(secretPath)
+	if !ok {
+		p = &memoryPath{versions: make(map[int]*memoryVersion), created: now}
+		m.paths[secretPath] = p
+	}
+	p.current++
+	p.updated = now
+	p.versions[p.current] = &memoryVersion{data: raw, created: now}
+	p.prune()
+
+	return &vault.KVSecret{VersionMetadata: versionMetadata(p.current, p.versions[p.current])}, nil
+}
+
+func (m *memoryKV) Get(ctx context.Context, secretPath string) (*vault.KVSecret, error) {
+	return m.GetVersion(ctx, secretPath, 0)
+}
+
+// GetVersion reads a version, the current one for 0. Deleted and destroyed
+// versions are returned without data, like Vault does.
+func (m *memoryKV) GetVersion(_ context.Context, secretPath string, version int) (*vault.KVSecret, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.lookup(secretPath)
+	if !ok {
+		return nil, notFound(secretPath)
+	}
+	if version == 0 {
+		version = p.current
+	}
+	v, ok := p.versions[version]
+	if !ok {
+		return nil, notFound(secretPath)
+	}
+
+	secret := &vault.KVSecret{VersionMetadata: versionMetadata(version, v)}
+	if v.deleted.IsZero() && !v.destroyed {
+		if err := json.Unmarshal(v.data, &secret.Data); err != nil {
+			return nil, err
+		}
+	}
+	return secret, nil
+}
+
+func (m *memoryKV) GetMetadata(_ context.Context, secretPath string) (*vault.KVMetadata, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.lookup(secretPath)
+	if !ok {
+		return nil, notFound(secretPath)
+	}
+
+	meta := &vault.KVMetadata{
+		CurrentVersion:     p.current,
+		MaxVersions:        p.maxVersions,
+		DeleteVersionAfter: p.deleteAfter,
+		CreatedTime:        p.created,
+		UpdatedTime:        p.updated,
+		Versions:           make(map[string]vault.KVVersionMetadata, len(p.versions)),
+	}
+	versions := make([]int, 0, len(p.versions))
+	for version, v := range p.versions {
+		meta.Versions[strconv.Itoa(version)] = *versionMetadata(version, v)
+		versions = append(versions, version)
+	}
+	if len(versions) > 0 {
+		sort.Ints(versions)
+		meta.OldestVersion = versions[0]
+	}
+	return meta, nil
+}
+
+func (m *memoryKV) PutMetadata(_ context.Context, secretPath string, metadata vault.KVMetadataPutInput) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	p, ok := m.lookup(secretPath)
+	if !ok {
+		p = &memoryPath{versions: make(map[int]*memoryVersion), created: now}
+		m.paths[secretPath] = p
+	}
+	p.maxVersions = metadata.MaxVersions
+	p.deleteAfter = metadata.DeleteVersionAfter
+	p.updated = now
+	return nil
+}
+
+func (m *memoryKV) Delete(_ context.Context, secretPath string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.lookup(secretPath)
+	if !ok {
+		return nil
+	}
+	if v, ok := p.versions[p.current]; ok && v.deleted.IsZero() {
+		v.deleted = time.Now()
+	}
+	return nil
+}
+
+func (m *memoryKV) DeleteVersions(_ context.Context, secretPath string, versions []int) error {
+	return m.update(secretPath, versions, func(v *memoryVersion) {
+		if v.deleted.IsZero() {
+			v.deleted = time.Now()
+		}
+	})
+}
+
+func (m *memoryKV) Undelete(_ context.Context, secretPath string, versions []int) error {
+	return m.update(secretPath, versions, func(v *memoryVersion) {
+		if !v.destroyed {
+			v.deleted = time.Time{}
+		}
+	})
+}
+
+func (m *memoryKV) Destroy(_ context.Context, secretPath string, versions []int) error {
+	return m.update(secretPath, versions, func(v *memoryVersion) {
+		v.destroyed = true
+		v.data = nil
+	})
+}
+
+func (m *memoryKV) DeleteMetadata(_ context.Context, secretPath string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.paths, secretPath)
+	return nil
+}
+
+// update applies fn to the given versions of a path that exist
+func (m *memoryKV) update(secretPath string, versions []int, fn func(v *memoryVersion)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.lookup(secretPath)
+	if !ok {
+		return nil
+	}
+	for _, version := range versions {
+		if v, ok := p.versions[version]; ok {
+			fn(v)
+		}
+	}
+	return nil
+}
+
+func versionMetadata(version int, v *memoryVersion) *vault.KVVersionMetadata {
+	return &vault.KVVersionMetadata{
+		Version:      version,
+		CreatedTime:  v.created,
+		DeletionTime: v.deleted,
+		Destroyed:    v.destroyed,
+	}
+}
@@ -0,0 +1,162 @@
+package vault
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultConcurrency is how many Vault requests a bulk operation runs at once
+	DefaultConcurrency = 8
+	// DefaultWriteRate is how many writes per second bulk operations send to Vault
+	DefaultWriteRate = 100
+	// DefaultSlowThreshold is how long an operation takes before it is logged
+	// as slow
+	DefaultSlowThreshold = time.Second
+)
+
+// RunPool calls fn for every index from 0 to n-1 on at most workers
+// goroutines and returns the error of each call, nil where it succeeded.
+// fn keeps its results in the caller's slices at index i. Once ctx is done
+// no further calls are started and the items left fail with ctx.Err().
+func RunPool(ctx context.Context, n, workers int, fn func(ctx context.Context, i int) error) []error {
+	errs := make([]error, n)
+	if n == 0 {
+		return errs
+	}
+	workers = max(1, min(workers, n))
+
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = fn(ctx, i)
+			}
+		}()
+	}
+
+feed:
+	for i := range n {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			for j := i; j < n; j++ {
+				errs[j] = ctx.Err()
+			}
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	return errs
+}
+
+// FetchRequest names the Vault data of one secret to read in bulk
+type FetchRequest struct {
+	Path string
+	// TotpPath is read as well when set
+	TotpPath string
+}
+
+// FetchResult is the Vault data of one secret read in bulk. Err is the
+// error of reading the password, TotpErr that of reading the TOTP URL.
+type FetchResult struct {
+	Data    *SecretData
+	Err     error
+	TotpURL string
+	TotpErr error
+}
+
+// FetchSecrets reads the current password, metadata and TOTP URL of many
+// secrets concurrently, returning the results in the order of reqs. Failed
+// reads are reported per secret; when ctx is done the secrets not read yet
+// fail with ctx.Err().
+func (s *KVStore) FetchSecrets(ctx context.Context, reqs []FetchRequest) []FetchResult {
+	results := make([]FetchResult, len(reqs))
+	errs := RunPool(ctx, len(reqs), s.concurrency, func(ctx context.Context, i int) error {
+		r := &results[i]
+		r.Data, _, r.Err = s.GetSecretData(ctx, reqs[i].Path)
+		if reqs[i].TotpPath != "" {
+			r.TotpURL, r.TotpErr = s.GetTotpURL(ctx, reqs[i].TotpPath)
+		}
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			results[i].Err = err
+			if reqs[i].TotpPath != "" {
+				results[i].TotpErr = err
+			}
+		}
+	}
+	return results
+}
+
+// RunWrites is RunPool for Vault writes: the calls are also limited to the
+// store's write rate, so a large import does not starve other clients of
+// Vault. Waiting for the limiter stops when ctx is done.
+func (s *KVStore) RunWrites(ctx context.Context, n int, fn func(ctx context.Context, i int) error) []error {
+	return RunPool(ctx, n, s.concurrency, func(ctx context.Context, i int) error {
+		if s.writeLimiter != nil {
+			if err := s.writeLimiter.Wait(ctx); err != nil {
+				return err
+			}
+		}
+		return fn(ctx, i)
+	})
+}
+
+// StoreRequest is the Vault data of one secret to write in bulk. The password
+// is written when Path is set and the TOTP URL when TotpPath is set.
+type StoreRequest struct {
+	Path     string
+	Password string
+	Metadata map[string]string
+	TotpPath string
+	TotpURL  string
+}
+
+// StoreResult is the outcome of writing one secret in bulk. Err is the error
+// of storing the password, TotpErr that of storing the TOTP URL.
+type StoreResult struct {
+	Version int
+	Err     error
+	TotpErr error
+}
+
+// StoreSecrets writes the passwords and TOTP URLs of many secrets
+// concurrently at the store's write rate, returning the results in the order
+// of reqs. When ctx is done the secrets not written yet fail with ctx.Err().
+func (s *KVStore) StoreSecrets(ctx context.Context, reqs []StoreRequest) []StoreResult {
+	results := make([]StoreResult, len(reqs))
+	errs := s.RunWrites(ctx, len(reqs), func(ctx context.Context, i int) error {
+		r := &results[i]
+		if reqs[i].Path != "" {
+			r.Version, r.Err = s.StorePassword(ctx, reqs[i].Path, reqs[i].Password, reqs[i].Metadata)
+		}
+		if reqs[i].TotpPath != "" {
+			r.TotpErr = s.StoreTotpURL(ctx, reqs[i].TotpPath, reqs[i].TotpURL)
+		}
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			if reqs[i].Path != "" {
+				results[i].Err = err
+			}
+			if reqs[i].TotpPath != "" {
+				results[i].TotpErr = err
+			}
+		}
+	}
+	return results
+}
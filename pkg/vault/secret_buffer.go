@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+)
+
+// redactedSecret is what a SecretBuffer prints as
+const redactedSecret = "[REDACTED]"
+
+// SecretBuffer holds a plaintext password in memory the service owns, so it
+// can be zeroed once it is no longer needed instead of lingering on the heap
+// until the garbage collector reuses it. It prints as [REDACTED] in logs,
+// format verbs and JSON, so it cannot leak by accident.
+//
+// Strings that came from elsewhere (Vault responses, requests) are copied in
+// and cannot be wiped themselves; the buffer keeps the copy the service hands
+// around from spreading further.
+type SecretBuffer struct {
+	mu sync.Mutex
+	b  []byte
+}
+
+// NewSecretBuffer takes ownership of b, which is zeroed by Wipe
+func NewSecretBuffer(b []byte) *SecretBuffer {
+	return &SecretBuffer{b: b}
+}
+
+// NewSecretBufferString copies s into a new buffer
+func NewSecretBufferString(s string) *SecretBuffer {
+	return &SecretBuffer{b: []byte(s)}
+}
+
+// Bytes returns the contents, valid until Wipe
+func (s *SecretBuffer) Bytes() []byte {
+	if s == nil {
+		return nil
+	}
+	return s.b
+}
+
+// Len returns the length of the contents
+func (s *SecretBuffer) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.b)
+}
+
+// Reveal returns a copy of the contents as a string, for response fields and
+// APIs that take strings. The copy is an ordinary string: Wipe does not reach
+// it, so reveal only what has to leave the service.
+func (s *SecretBuffer) Reveal() string {
+	if s == nil {
+		return ""
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return string(s.b)
+}
+
+// Wipe zeroes the contents. It is safe to call more than once.
+func (s *SecretBuffer) Wipe() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.b)
+	s.b = s.b[:0]
+}
+
+// String implements fmt.Stringer without revealing the contents
+func (s *SecretBuffer) String() string {
+	return redactedSecret
+}
+
+// GoString implements fmt.GoStringer without revealing the contents
+func (s *SecretBuffer) GoString() string {
+	return redactedSecret
+}
+
+// Format prints [REDACTED] for every verb, so %s, %v, %q and %x alike keep
+// the contents out of log lines
+func (s *SecretBuffer) Format(f fmt.State, _ rune) {
+	_, _ = f.Write([]byte(redactedSecret))
+}
+
+// MarshalJSON implements json.Marshaler without revealing the contents
+func (s *SecretBuffer) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + redactedSecret + `"`), nil
+}
+
+// LogValue implements slog.LogValuer without revealing the contents
+func (s *SecretBuffer) LogValue() slog.Value {
+	return slog.StringValue(redactedSecret)
+}
@@ -0,0 +1,154 @@
+package vault
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// DefaultTransitMount is the default mount path of the Transit secrets engine
+const DefaultTransitMount = "transit"
+
+// memoryTransitPrefix marks data keys wrapped by the in-memory store
+const memoryTransitPrefix = "memory:v1:"
+
+// DataKey is a data encryption key from Transit, in plaintext for use and
+// wrapped by the Transit key for storage
+type DataKey struct {
+	Plaintext []byte
+	Wrapped   string
+}
+
+// GenerateDataKey asks Transit for a new 256-bit data key wrapped by keyName
+func (c *Client) GenerateDataKey(ctx context.Context, mount, keyName string) (*DataKey, error) {
+	if c.memory != nil {
+		return c.memory.generateDataKey(keyName)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	secret, err := c.client.Logical().WriteWithContext(ctx, path.Join(mount, "datakey/plaintext", keyName), map[string]any{"bits": 256})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate data key with Transit key %s: %w", keyName, err)
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, fmt.Errorf("transit returned no data key for %s", keyName)
+	}
+	wrapped, _ := secret.Data["ciphertext"].(string)
+	encoded, _ := secret.Data["plaintext"].(string)
+	plaintext, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || wrapped == "" {
+		return nil, fmt.Errorf("transit returned a malformed data key for %s", keyName)
+	}
+	return &DataKey{Plaintext: plaintext, Wrapped: wrapped}, nil
+}
+
+// UnwrapDataKey decrypts a data key wrapped by keyName
+func (c *Client) UnwrapDataKey(ctx context.Context, mount, keyName, wrapped string) ([]byte, error) {
+	if c.memory != nil {
+		return c.memory.unwrapDataKey(keyName, wrapped)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	secret, err := c.client.Logical().WriteWithContext(ctx, path.Join(mount, "decrypt", keyName), map[string]any{"ciphertext": wrapped})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key with Transit key %s: %w", keyName, err)
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, fmt.Errorf("transit returned no plaintext for %s", keyName)
+	}
+	encoded, _ := secret.Data["plaintext"].(string)
+	plaintext, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("transit returned a malformed plaintext for %s", keyName)
+	}
+	return plaintext, nil
+}
+
+// RewrapDataKey wraps a data key again with the latest version of keyName,
+// after the Transit key was rotated, without the key leaving Vault
+func (c *Client) RewrapDataKey(ctx context.Context, mount, keyName, wrapped string) (string, error) {
+	if c.memory != nil {
+		// The in-memory store has a single key version
+		return wrapped, nil
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	secret, err := c.client.Logical().WriteWithContext(ctx, path.Join(mount, "rewrap", keyName), map[string]any{"ciphertext": wrapped})
+	if err != nil {
+		return "", fmt.Errorf("failed to rewrap data key with Transit key %s: %w", keyName, err)
+	}
+	if secret == nil || secret.Data == nil {
+		return "", fmt.Errorf("transit returned no ciphertext for %s", keyName)
+	}
+	rewrapped, _ := secret.Data["ciphertext"].(string)
+	if rewrapped == "" {
+		return "", fmt.Errorf("transit returned no ciphertext for %s", keyName)
+	}
+	return rewrapped, nil
+}
+
+// transitKey returns the AES-GCM cipher standing in for a Transit key,
+// creating the key on first use like Transit does
+func (m *memoryKV) transitKey(keyName string) (cipher.AEAD, error) {
+	m.mu.Lock()
+	key, ok := m.transitKeys[keyName]
+	if !ok {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			m.mu.Unlock()
+			return nil, err
+		}
+		m.transitKeys[keyName] = key
+	}
+	m.mu.Unlock()
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (m *memoryKV) generateDataKey(keyName string) (*DataKey, error) {
+	aead, err := m.transitKey(keyName)
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, 32)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(plaintext); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	sealed := aead.Seal(nonce, nonce, plaintext, nil)
+	return &DataKey{Plaintext: plaintext, Wrapped: memoryTransitPrefix + base64.StdEncoding.EncodeToString(sealed)}, nil
+}
+
+func (m *memoryKV) unwrapDataKey(keyName, wrapped string) ([]byte, error) {
+	aead, err := m.transitKey(keyName)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(wrapped, memoryTransitPrefix))
+	if err != nil || !strings.HasPrefix(wrapped, memoryTransitPrefix) || len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("malformed data key for %s", keyName)
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key with %s: %w", keyName, err)
+	}
+	return plaintext, nil
+}